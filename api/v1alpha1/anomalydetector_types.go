@@ -0,0 +1,239 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnomalyDetectorOwner identifies the component whose metrics are watched.
+type AnomalyDetectorOwner struct {
+	// ProjectName is the name of the project that owns the component.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the component.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ComponentName string `json:"componentName"`
+}
+
+// AnomalySignal is a component metric that is compared against its seasonal baseline.
+// +kubebuilder:validation:Enum=RequestRate;ErrorRatio;Latency
+type AnomalySignal string
+
+const (
+	// AnomalySignalRequestRate flags unusual spikes or drops in the request rate.
+	AnomalySignalRequestRate AnomalySignal = "RequestRate"
+	// AnomalySignalErrorRatio flags an unusually high share of failed requests.
+	AnomalySignalErrorRatio AnomalySignal = "ErrorRatio"
+	// AnomalySignalLatency flags an unusually high 90th percentile latency.
+	AnomalySignalLatency AnomalySignal = "Latency"
+)
+
+// AnomalySensitivity controls how far a signal must deviate from its baseline to be
+// reported. Low, Medium and High require 4, 3 and 2 standard deviations respectively.
+// +kubebuilder:validation:Enum=Low;Medium;High
+type AnomalySensitivity string
+
+const (
+	// AnomalySensitivityLow reports only large deviations.
+	AnomalySensitivityLow AnomalySensitivity = "Low"
+	// AnomalySensitivityMedium is the default sensitivity.
+	AnomalySensitivityMedium AnomalySensitivity = "Medium"
+	// AnomalySensitivityHigh reports smaller deviations.
+	AnomalySensitivityHigh AnomalySensitivity = "High"
+)
+
+// AnomalyDetectorIncident defines the incident raised for each anomaly.
+// +kubebuilder:validation:XValidation:rule="self.triggerAiRca == true ? self.enabled == true : true",message="Incident must be enabled to trigger AI RCA"
+type AnomalyDetectorIncident struct {
+	// Enabled toggles whether an incident is created for each anomaly.
+	// +optional
+	// +kubebuilder:default:=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// TriggerAiRca toggles whether the AI RCA agent analyzes the incident.
+	// To set this to true, Enabled must also be set to true.
+	// +optional
+	// +kubebuilder:default:=false
+	TriggerAiRca bool `json:"triggerAiRca,omitempty"`
+}
+
+// AnomalyDetectorActions defines how detected anomalies are raised. Anomalies are raised
+// through the observability plane alerting pipeline, so they are listed with alerts and
+// follow the alert suppression window.
+type AnomalyDetectorActions struct {
+	// Severity is the severity of the raised anomaly alerts.
+	// +optional
+	// +kubebuilder:default=warning
+	// +kubebuilder:validation:Enum=info;warning;critical
+	Severity ObservabilityAlertSeverity `json:"severity,omitempty"`
+
+	// NotificationChannels receive a notification for each anomaly.
+	// +optional
+	// +listType=set
+	NotificationChannels []NotificationChannelName `json:"notificationChannels,omitempty"`
+
+	// Incident defines the incident raised for each anomaly.
+	// +optional
+	Incident *AnomalyDetectorIncident `json:"incident,omitempty"`
+}
+
+// AnomalyDetectorSpec defines the desired state of AnomalyDetector.
+// +kubebuilder:validation:XValidation:rule="!has(self.seasonality) || !has(self.window) || duration(self.seasonality) >= duration(self.window)",message="seasonality must not be shorter than window"
+type AnomalyDetectorSpec struct {
+	// Owner identifies the component whose metrics are watched.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self.componentName == oldSelf.componentName",message="spec.owner.componentName is immutable"
+	Owner AnomalyDetectorOwner `json:"owner"`
+
+	// Environment is the environment whose metrics are watched.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Environment string `json:"environment"`
+
+	// Signals are the metrics compared against their baseline. Defaults to all signals.
+	// +optional
+	// +listType=set
+	Signals []AnomalySignal `json:"signals,omitempty"`
+
+	// Sensitivity controls how far a signal must deviate from its baseline.
+	// +optional
+	// +kubebuilder:default=Medium
+	Sensitivity AnomalySensitivity `json:"sensitivity,omitempty"`
+
+	// Seasonality is the period of the baseline. The latest window is compared with the
+	// same window one, two, ... periods earlier, so a weekly seasonality accounts for
+	// both daily and weekday traffic patterns.
+	// +optional
+	// +kubebuilder:default="168h"
+	Seasonality metav1.Duration `json:"seasonality,omitempty"`
+
+	// Seasons is the number of past periods the baseline is built from.
+	// +optional
+	// +kubebuilder:default=4
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=8
+	Seasons int32 `json:"seasons,omitempty"`
+
+	// Window is the trailing time range evaluated on each run.
+	// +optional
+	// +kubebuilder:default="10m"
+	Window metav1.Duration `json:"window,omitempty"`
+
+	// Interval is how often the detector runs.
+	// +optional
+	// +kubebuilder:default="5m"
+	Interval metav1.Duration `json:"interval,omitempty"`
+
+	// Actions defines how anomalies are raised. When unset, anomalies are only recorded
+	// in status.
+	// +optional
+	Actions *AnomalyDetectorActions `json:"actions,omitempty"`
+}
+
+// AnomalySignalStatus is the latest evaluation of one signal. Values are decimal strings
+// because CRDs do not carry floating point values.
+type AnomalySignalStatus struct {
+	// Signal is the evaluated signal.
+	Signal AnomalySignal `json:"signal"`
+
+	// Value is the mean of the signal over the latest window.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Baseline is the mean of the signal over the same window in past periods.
+	// +optional
+	Baseline string `json:"baseline,omitempty"`
+
+	// Score is the deviation of Value from Baseline in standard deviations.
+	// +optional
+	Score string `json:"score,omitempty"`
+
+	// Anomalous is true when the deviation exceeds the sensitivity threshold.
+	Anomalous bool `json:"anomalous"`
+
+	// InsufficientData is true when the baseline holds too few samples to evaluate.
+	// +optional
+	InsufficientData bool `json:"insufficientData,omitempty"`
+
+	// Since is when the signal became anomalous.
+	// +optional
+	Since *metav1.Time `json:"since,omitempty"`
+
+	// AlertID is the alert raised for the anomaly, if any.
+	// +optional
+	AlertID string `json:"alertId,omitempty"`
+}
+
+// AnomalyDetectorStatus defines the observed state of AnomalyDetector.
+type AnomalyDetectorStatus struct {
+	// ObservedGeneration is the generation last evaluated by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastEvaluationTime is the end of the last evaluated window.
+	// +optional
+	LastEvaluationTime *metav1.Time `json:"lastEvaluationTime,omitempty"`
+
+	// Signals holds the latest evaluation of each signal.
+	// +optional
+	// +listType=map
+	// +listMapKey=signal
+	Signals []AnomalySignalStatus `json:"signals,omitempty"`
+
+	// Conditions represent the latest available observations of the detector's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=ad;ads
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Environment",type=string,JSONPath=`.spec.environment`
+// +kubebuilder:printcolumn:name="Sensitivity",type=string,JSONPath=`.spec.sensitivity`
+// +kubebuilder:printcolumn:name="Anomalous",type=string,JSONPath=`.status.conditions[?(@.type=="Anomalous")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AnomalyDetector is the Schema for the anomalydetectors API.
+// It schedules anomaly detection on the request rate, error ratio and latency of a
+// component in an environment. The observability plane compares each signal with a
+// seasonal baseline built from the same window in past periods and raises anomalies
+// through its alerting pipeline, where they can create incidents and trigger the AI
+// RCA agent.
+type AnomalyDetector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AnomalyDetectorSpec   `json:"spec,omitempty"`
+	Status AnomalyDetectorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AnomalyDetectorList contains a list of AnomalyDetector.
+type AnomalyDetectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AnomalyDetector `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (in *AnomalyDetector) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (in *AnomalyDetector) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&AnomalyDetector{}, &AnomalyDetectorList{})
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// WorkflowBuildNodesSpec configures the nodes of a workflow plane that run the pods of workflow
+// runs. The placement is injected into every Argo Workflow rendered for the plane: nodeSelector
+// entries override the template's and tolerations are appended.
+type WorkflowBuildNodesSpec struct {
+	// NodeSelector restricts build pods to nodes carrying all of the given labels,
+	// for example a dedicated build node pool.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allow build pods to be scheduled onto tainted build nodes.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Karpenter provisions build nodes from a Karpenter NodePool.
+	// +optional
+	Karpenter *KarpenterBuildNodes `json:"karpenter,omitempty"`
+
+	// PreWarm keeps standby build nodes running during business hours so that builds do not
+	// wait for nodes to be provisioned. Outside of business hours no standby nodes are kept and
+	// the autoscaler can remove idle build nodes.
+	// +optional
+	PreWarm *BuildNodePreWarmSpec `json:"preWarm,omitempty"`
+}
+
+// KarpenterBuildNodes selects the Karpenter NodePool that provisions build nodes.
+type KarpenterBuildNodes struct {
+	// NodePool is the name of the Karpenter NodePool. Build pods select its nodes through the
+	// karpenter.sh/nodepool label.
+	// +kubebuilder:validation:MinLength=1
+	NodePool string `json:"nodePool"`
+
+	// DoNotDisrupt marks build pods with the karpenter.sh/do-not-disrupt annotation so that
+	// Karpenter does not consolidate a node while a build runs on it. Defaults to true.
+	// +optional
+	DoNotDisrupt *bool `json:"doNotDisrupt,omitempty"`
+}
+
+// BuildNodePreWarmSpec keeps standby build nodes warm during configured business hours.
+// Standby nodes are held by low-priority placeholder pods that build pods preempt; the cluster
+// autoscaler or Karpenter then provisions a replacement node for the evicted placeholder.
+type BuildNodePreWarmSpec struct {
+	// Nodes is the number of standby build nodes kept during business hours.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50
+	Nodes int32 `json:"nodes"`
+
+	// Resources are reserved by each placeholder pod. Size them close to the allocatable
+	// capacity of a build node so that every placeholder holds a node of its own.
+	Resources corev1.ResourceList `json:"resources"`
+
+	// Schedule lists the business hours during which standby nodes are kept.
+	// +kubebuilder:validation:MinItems=1
+	Schedule []PreWarmWindow `json:"schedule"`
+
+	// TimeZone is the IANA time zone the schedule is evaluated in, for example "Europe/Berlin".
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Namespace is the namespace on the workflow plane the placeholder pods run in.
+	// Defaults to openchoreo-workflow-plane.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Image is the container image of the placeholder pods. Defaults to registry.k8s.io/pause:3.10.
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// GetNamespace returns the namespace of the placeholder pods.
+func (p *BuildNodePreWarmSpec) GetNamespace() string {
+	if p.Namespace == "" {
+		return "openchoreo-workflow-plane"
+	}
+	return p.Namespace
+}
+
+// GetImage returns the container image of the placeholder pods.
+func (p *BuildNodePreWarmSpec) GetImage() string {
+	if p.Image == "" {
+		return "registry.k8s.io/pause:3.10"
+	}
+	return p.Image
+}
+
+// PreWarmWeekday is a day of the week of a pre-warm window.
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type PreWarmWeekday string
+
+// PreWarmWindow is a daily time window on a set of weekdays.
+type PreWarmWindow struct {
+	// Days are the weekdays the window applies to. The window applies to every day when empty.
+	// +optional
+	Days []PreWarmWeekday `json:"days,omitempty"`
+
+	// Start is the time of day the window opens, in HH:MM.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day the window closes, in HH:MM. A window that ends before it starts
+	// spans midnight and closes on the following day.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+}
+
+// StandbyBuildNodesStatus reports the standby build nodes kept warm on a workflow plane.
+type StandbyBuildNodesStatus struct {
+	// Desired is the number of standby build nodes wanted at the time of the last reconcile.
+	// It is 0 outside of business hours.
+	Desired int32 `json:"desired"`
+
+	// Ready is the number of placeholder pods that are running and hold a build node.
+	Ready int32 `json:"ready"`
+}
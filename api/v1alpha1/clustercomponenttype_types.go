@@ -8,13 +8,15 @@ import (
 )
 
 // ClusterComponentTypeSpec defines the desired state of ClusterComponentType.
-// +kubebuilder:validation:XValidation:rule="self.workloadType == 'proxy' || self.resources.exists(r, r.id == self.workloadType)",message="resources must contain a primary resource with id matching workloadType (unless workloadType is 'proxy')"
+// +kubebuilder:validation:XValidation:rule="self.workloadType in ['proxy', 'library'] || (has(self.resources) && self.resources.exists(r, r.id == self.workloadType))",message="resources must contain a primary resource with id matching workloadType (unless workloadType is 'proxy' or 'library')"
+// +kubebuilder:validation:XValidation:rule="self.workloadType == 'library' ? !has(self.resources) || size(self.resources) == 0 : has(self.resources) && size(self.resources) > 0",message="library component types must not define resources; all other component types require at least one resource"
 // +kubebuilder:validation:XValidation:rule="!(has(self.validations) && size(self.validations) > 0 && has(self.preRenderValidations) && size(self.preRenderValidations) > 0)",message="set only one of spec.validations or spec.preRenderValidations; validations is deprecated, use preRenderValidations"
 type ClusterComponentTypeSpec struct {
-	// WorkloadType must be one of: deployment, statefulset, cronjob, job, proxy
-	// This determines the primary workload resource type for this component type
+	// WorkloadType must be one of: deployment, statefulset, cronjob, job, proxy, library
+	// This determines the primary workload resource type for this component type.
+	// Components of a library type are built and published but never deployed.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=deployment;statefulset;cronjob;job;proxy
+	// +kubebuilder:validation:Enum=deployment;statefulset;cronjob;job;proxy;library
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.workloadType cannot be changed after creation"
 	WorkloadType string `json:"workloadType"`
 
@@ -66,11 +68,44 @@ type ClusterComponentTypeSpec struct {
 	PostRenderValidations []PostRenderValidation `json:"postRenderValidations,omitempty"`
 
 	// Resources are templates that generate Kubernetes resources dynamically.
-	// At least one resource template is required. For non-proxy workload types,
-	// one resource must have an id matching the workloadType. When workloadType
-	// is "proxy", a matching resource id is not required.
-	// +kubebuilder:validation:MinItems=1
-	Resources []ResourceTemplate `json:"resources"`
+	// At least one resource template is required, except for library types, which
+	// must not define any. For the other non-proxy workload types, one resource must
+	// have an id matching the workloadType. When workloadType is "proxy", a matching
+	// resource id is not required.
+	// +optional
+	Resources []ResourceTemplate `json:"resources,omitempty"`
+
+	// DisruptionBudget generates PodDisruptionBudgets for multi-replica workloads of this
+	// type when deployed to production environments.
+	// +optional
+	DisruptionBudget *DisruptionBudgetPolicy `json:"disruptionBudget,omitempty"`
+
+	// RequiredEnv declares the environment variables that components of this type must set.
+	// A release binding is not rendered until each of them is provided by the workload, the
+	// binding's workload overrides, or a dependency env binding.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=100
+	RequiredEnv []RequiredEnvVar `json:"requiredEnv,omitempty"`
+
+	// Hooks are Jobs run by the release controller around rolling out workload changes, such as
+	// schema migrations before the new workload starts or cache warmups after it is ready.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=20
+	Hooks []LifecycleHook `json:"hooks,omitempty"`
+
+	// QualityGate sets the static analysis thresholds that the latest workflow run of
+	// components of this type must meet before a release can be created.
+	// +optional
+	QualityGate *AnalysisQualityGate `json:"qualityGate,omitempty"`
+
+	// WorkloadIdentity binds the workloads of components of this type to a cloud identity
+	// through the workload identity provider of the data plane they are deployed to.
+	// +optional
+	WorkloadIdentity *WorkloadIdentityRequest `json:"workloadIdentity,omitempty"`
 }
 
 // EffectivePreRenderValidations returns the pre-render validation rules to apply.
@@ -122,6 +157,11 @@ func (s *ClusterComponentTypeSpec) ToComponentTypeSpec() ComponentTypeSpec {
 		PreRenderValidations:  s.PreRenderValidations,
 		PostRenderValidations: s.PostRenderValidations,
 		Resources:             s.Resources,
+		DisruptionBudget:      s.DisruptionBudget,
+		RequiredEnv:           s.RequiredEnv,
+		Hooks:                 s.Hooks,
+		QualityGate:           s.QualityGate,
+		WorkloadIdentity:      s.WorkloadIdentity,
 	}
 }
 
@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,6 +28,12 @@ type ClusterDataPlaneSpec struct {
 	// This field is mandatory - all data planes must use cluster agent communication
 	ClusterAgent ClusterAgentConfig `json:"clusterAgent"`
 
+	// Region is the region the data plane cluster runs in, e.g. "us-east-1". Environments
+	// on this data plane inherit it unless they set their own region.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Region string `json:"region,omitempty"`
+
 	// Gateway specifies the configuration for the API gateway in this DataPlane.
 	// +optional
 	Gateway GatewaySpec `json:"gateway,omitempty"`
@@ -35,6 +42,28 @@ type ClusterDataPlaneSpec struct {
 	// +optional
 	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
 
+	// RegistryCredentials lists private container registry credentials made available to every
+	// component deployed to this ClusterDataPlane. Requires secretStoreRef.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	RegistryCredentials []RegistryCredential `json:"registryCredentials,omitempty"`
+
+	// Scheduling is the default scheduling policy for components deployed to this ClusterDataPlane.
+	// Environments and ReleaseBindings can extend it.
+	// +optional
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+
+	// APIVersions pins the API versions of resources rendered to this ClusterDataPlane, so that the
+	// same ComponentType works across clusters running different Kubernetes versions.
+	// +optional
+	APIVersions []APIVersionPreference `json:"apiVersions,omitempty"`
+
+	// WorkloadIdentity configures the cloud workload identity federation of this ClusterDataPlane,
+	// which binds components that request a cloud identity to it without long-lived keys.
+	// +optional
+	WorkloadIdentity *WorkloadIdentityConfig `json:"workloadIdentity,omitempty"`
+
 	// ObservabilityPlaneRef specifies the ClusterObservabilityPlane for this ClusterDataPlane.
 	// Since this is a cluster-scoped resource, it can only reference cluster-scoped ClusterObservabilityPlane.
 	// Namespace-scoped ObservabilityPlane references are NOT supported for cluster-scoped resources.
@@ -47,6 +76,43 @@ type ClusterDataPlaneSpec struct {
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="!has(self.kind) || self.kind == 'ClusterObservabilityPlane'",message="ClusterDataPlane can only reference ClusterObservabilityPlane"
 	ObservabilityPlaneRef *ClusterObservabilityPlaneRef `json:"observabilityPlaneRef,omitempty"`
+
+	// Tenancy restricts which namespaces and projects may use this ClusterDataPlane.
+	// When not specified, environments of any namespace may reference it.
+	// +optional
+	Tenancy *TenancyPolicy `json:"tenancy,omitempty"`
+}
+
+// TenancyPolicy defines which tenants may use a shared data plane. Entries are glob
+// patterns as understood by path.Match, e.g. "team-*" or "payments/*". Deny rules take
+// precedence over allow rules, and an empty allow list allows everything not denied.
+type TenancyPolicy struct {
+	// AllowedNamespaces are the namespaces whose environments may reference the data plane.
+	// Enforced when an Environment is created or updated.
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+
+	// DeniedNamespaces are the namespaces whose environments may not reference the data plane.
+	// +optional
+	DeniedNamespaces []string `json:"deniedNamespaces,omitempty"`
+
+	// AllowedProjects are the projects, as "<namespace>/<project>", that may deploy to the
+	// data plane. Enforced when a ReleaseBinding is reconciled.
+	// +optional
+	AllowedProjects []string `json:"allowedProjects,omitempty"`
+
+	// DeniedProjects are the projects, as "<namespace>/<project>", that may not deploy to the data plane.
+	// +optional
+	DeniedProjects []string `json:"deniedProjects,omitempty"`
+}
+
+// TenantCapacityReservation is the capacity reserved for a tenant namespace on a shared data plane.
+type TenantCapacityReservation struct {
+	// Namespace is the tenant namespace the capacity is reserved for.
+	Namespace string `json:"namespace"`
+
+	// Resources is the reserved capacity, e.g. cpu and memory.
+	Resources corev1.ResourceList `json:"resources"`
 }
 
 // ClusterDataPlaneStatus defines the observed state of ClusterDataPlane.
@@ -63,6 +129,18 @@ type ClusterDataPlaneStatus struct {
 	// AgentConnection tracks the status of cluster agent connections to this data plane
 	// +optional
 	AgentConnection *AgentConnectionStatus `json:"agentConnection,omitempty"`
+
+	// NodeLabels are the node labels discovered on the data plane cluster. Scheduling
+	// policies are validated against them before deploying.
+	// +optional
+	NodeLabels []NodeLabel `json:"nodeLabels,omitempty"`
+
+	// CapacityReservations are the per-tenant capacity reservations parsed from the
+	// capacity-reservation.openchoreo.dev/<namespace> annotations.
+	// +optional
+	// +listType=map
+	// +listMapKey=namespace
+	CapacityReservations []TenantCapacityReservation `json:"capacityReservations,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -19,6 +19,12 @@ type ClusterWorkflowSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.workflowPlaneRef is immutable"
 	WorkflowPlaneRef *ClusterWorkflowPlaneRef `json:"workflowPlaneRef,omitempty"`
 
+	// WorkflowPlaneSelector schedules each run on one of the workflow planes whose labels
+	// match, for example by architecture or region. When set, it takes precedence over
+	// workflowPlaneRef and the chosen plane is recorded in the run's status.
+	// +optional
+	WorkflowPlaneSelector *WorkflowPlaneSelector `json:"workflowPlaneSelector,omitempty"`
+
 	// Parameters defines the developer-facing parameters that can be configured
 	// when creating a WorkflowRun instance.
 	// +optional
@@ -54,6 +60,36 @@ type ClusterWorkflowSpec struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
 	TTLAfterCompletion string `json:"ttlAfterCompletion,omitempty"`
+
+	// Tests declares the step of the run template that runs the component's tests.
+	// +optional
+	Tests *WorkflowTestSpec `json:"tests,omitempty"`
+
+	// Analysis declares the step of the run template that runs static analysis.
+	// +optional
+	Analysis *WorkflowAnalysisSpec `json:"analysis,omitempty"`
+
+	// SBOM declares the step of the run template that produces the software bill of materials
+	// of the built image. The SBOM of that step is collected into the WorkflowRun status.
+	// +optional
+	SBOM *WorkflowSBOMSpec `json:"sbom,omitempty"`
+
+	// Timeout is the maximum time a run may execute on the workflow plane, for example "1h" or
+	// "30m". Runs that exceed it are terminated and marked as timed out.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
+	Timeout string `json:"timeout,omitempty"`
+
+	// RetryPolicy resubmits runs that fail.
+	// +optional
+	RetryPolicy *WorkflowRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Credentials are short-lived credentials runs obtain from the control plane's credential
+	// broker instead of mounting static secrets.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Credentials []WorkflowCredential `json:"credentials,omitempty"`
 }
 
 // ClusterWorkflowStatus defines the observed state of ClusterWorkflow.
@@ -43,6 +43,17 @@ type ClusterWorkflowPlaneSpec struct {
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="!has(self.kind) || self.kind == 'ClusterObservabilityPlane'",message="ClusterWorkflowPlane can only reference ClusterObservabilityPlane"
 	ObservabilityPlaneRef *ClusterObservabilityPlaneRef `json:"observabilityPlaneRef,omitempty"`
+
+	// Scheduling configures the build queue of the workflow plane.
+	// When not specified, workflow runs start as soon as they are created.
+	// +optional
+	Scheduling *WorkflowSchedulingSpec `json:"scheduling,omitempty"`
+
+	// BuildNodes places the pods of workflow runs on dedicated build nodes and keeps standby
+	// build nodes warm during business hours. When not specified, build pods are scheduled
+	// like any other pod.
+	// +optional
+	BuildNodes *WorkflowBuildNodesSpec `json:"buildNodes,omitempty"`
 }
 
 // ClusterWorkflowPlaneStatus defines the observed state of ClusterWorkflowPlane.
@@ -59,6 +70,10 @@ type ClusterWorkflowPlaneStatus struct {
 	// AgentConnection tracks the status of cluster agent connections to this workflow plane
 	// +optional
 	AgentConnection *AgentConnectionStatus `json:"agentConnection,omitempty"`
+
+	// StandbyBuildNodes reports the standby build nodes kept warm on the workflow plane.
+	// +optional
+	StandbyBuildNodes *StandbyBuildNodesStatus `json:"standbyBuildNodes,omitempty"`
 }
 
 // +kubebuilder:object:root=true
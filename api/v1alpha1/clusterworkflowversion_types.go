@@ -0,0 +1,38 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=cwfv;cwfvs
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == self.spec.workflowName + '.' + self.spec.version",message="metadata.name must be <spec.workflowName>.<spec.version>"
+// +kubebuilder:printcolumn:name="Workflow",type=string,JSONPath=`.spec.workflowName`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.spec.version`
+// +kubebuilder:printcolumn:name="Deprecated",type=boolean,JSONPath=`.spec.deprecated`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterWorkflowVersion is the Schema for the clusterworkflowversions API.
+// ClusterWorkflowVersion is an immutable snapshot of a ClusterWorkflow template.
+type ClusterWorkflowVersion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WorkflowVersionSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterWorkflowVersionList contains a list of ClusterWorkflowVersion.
+type ClusterWorkflowVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterWorkflowVersion `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterWorkflowVersion{}, &ClusterWorkflowVersionList{})
+}
@@ -4,6 +4,8 @@
 package v1alpha1
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -37,7 +39,6 @@ type ComponentList struct {
 type ComponentSpec struct {
 	// Owner defines the ownership information for the component
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.owner is immutable"
 	Owner ComponentOwner `json:"owner"`
 
 	// ComponentType specifies the component type reference with kind and name.
@@ -75,6 +76,176 @@ type ComponentSpec struct {
 	// The Workflow must be in the allowedWorkflows list of the ComponentType.
 	// +optional
 	Workflow *ComponentWorkflowConfig `json:"workflow,omitempty"`
+
+	// Catalog holds service registry metadata for the component, such as the owning team,
+	// business domain and tier. Team, domain and tier are propagated as labels to the
+	// component and to the resources rendered for it.
+	// +optional
+	Catalog *ComponentCatalog `json:"catalog,omitempty"`
+
+	// LogParsing describes how the component's log lines are parsed when its logs are queried,
+	// for applications whose logs do not follow the default format.
+	// +optional
+	LogParsing *LogParsingProfile `json:"logParsing,omitempty"`
+
+	// Libraries are the versions of library components that this component depends on.
+	// Each referenced component must use a library component type and must have
+	// published the referenced version.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Libraries []LibraryDependency `json:"libraries,omitempty"`
+
+	// State is the lifecycle state of the component. An archived component keeps its
+	// definition, release history and metadata, but all its release bindings are undeployed
+	// and no builds or releases are created for it.
+	// When not specified, the component is Active.
+	// +optional
+	// +kubebuilder:validation:Enum=Active;Archived
+	State ComponentState `json:"state,omitempty"`
+}
+
+// ComponentState is the lifecycle state of a component.
+type ComponentState string
+
+const (
+	// ComponentStateActive is the state of a component that is built and deployed as usual.
+	ComponentStateActive ComponentState = "Active"
+	// ComponentStateArchived is the state of a component that is retained but not running.
+	ComponentStateArchived ComponentState = "Archived"
+)
+
+// IsLibrary reports whether the component uses a library component type and is therefore
+// built and published but never deployed.
+func (s *ComponentSpec) IsLibrary() bool {
+	return strings.HasPrefix(s.ComponentType.Name, WorkloadTypeLibrary+"/")
+}
+
+// IsArchived reports whether the component is archived.
+func (s *ComponentSpec) IsArchived() bool {
+	return s.State == ComponentStateArchived
+}
+
+// LogFormat is the format of a component's log lines.
+// +kubebuilder:validation:Enum=JSON;Regex
+type LogFormat string
+
+const (
+	// LogFormatJSON is the format of log lines that are JSON objects.
+	LogFormatJSON LogFormat = "JSON"
+	// LogFormatRegex is the format of log lines matched by a regular expression.
+	LogFormatRegex LogFormat = "Regex"
+)
+
+// LogParsingProfile describes how structured fields are extracted from a component's log lines.
+// +kubebuilder:validation:XValidation:rule="self.format != 'Regex' || (has(self.pattern) && size(self.pattern) > 0)",message="pattern is required when format is Regex"
+type LogParsingProfile struct {
+	// Format is the format of each log line. JSON lines are decoded as objects, Regex lines
+	// are matched against Pattern.
+	// +kubebuilder:validation:Required
+	Format LogFormat `json:"format"`
+
+	// Pattern is the regular expression, in RE2 syntax, that log lines are matched against
+	// when Format is Regex. Its named capture groups can be mapped to fields.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Pattern string `json:"pattern,omitempty"`
+
+	// Fields maps the names of parsed fields, such as level and logger, to the JSON key or
+	// capture group they are read from. Nested JSON keys are separated by dots.
+	// The level field sets the level of the log entry.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	Fields map[string]string `json:"fields,omitempty"`
+
+	// Multiline joins continuation lines, such as stack traces, to the entry they belong to.
+	// +optional
+	Multiline *LogMultilineConfig `json:"multiline,omitempty"`
+
+	// Severity extracts and normalizes the level of log entries.
+	// +optional
+	Severity *LogSeverityConfig `json:"severity,omitempty"`
+}
+
+// LogMultilineConfig describes how multiline log entries are recognized.
+type LogMultilineConfig struct {
+	// StartPattern is a regular expression that matches the first line of an entry. Lines
+	// that do not match it are appended to the preceding entry of the same container.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	StartPattern string `json:"startPattern"`
+
+	// MaxLines is the maximum number of lines joined into a single entry.
+	// Defaults to 500.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=5000
+	MaxLines int32 `json:"maxLines,omitempty"`
+}
+
+// LogSeverityConfig describes how the level of a log entry is extracted and normalized.
+type LogSeverityConfig struct {
+	// Pattern is a regular expression whose first capture group is the raw level. It is
+	// used when no level field is parsed from the entry.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Pattern string `json:"pattern,omitempty"`
+
+	// Mapping maps raw levels, compared case-insensitively, to DEBUG, INFO, WARN or ERROR.
+	// Raw levels that are not mapped are upper-cased.
+	// +optional
+	Mapping map[string]string `json:"mapping,omitempty"`
+}
+
+// LibraryDependency references a published version of a library component.
+type LibraryDependency struct {
+	// Project is the project of the library component. Defaults to the project of the
+	// depending component.
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// Component is the name of the library component.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Component string `json:"component"`
+
+	// Version is the published version of the library.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+}
+
+// ComponentCatalog describes who owns a component and where it fits in the organization.
+type ComponentCatalog struct {
+	// Team is the name of the team that owns the component.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Team string `json:"team,omitempty"`
+
+	// Domain is the business domain the component belongs to, for example "payments".
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Domain string `json:"domain,omitempty"`
+
+	// Tier is the criticality tier of the component, for example "tier-1".
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Tier string `json:"tier,omitempty"`
+
+	// OnCall is a link to the on-call schedule or escalation policy of the owning team.
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	OnCall string `json:"onCall,omitempty"`
+
+	// Repository is the URL of the component's source repository.
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	Repository string `json:"repository,omitempty"`
 }
 
 // ComponentWorkflowConfig defines the workflow configuration for a component.
@@ -92,6 +263,13 @@ type ComponentWorkflowConfig struct {
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
 
+	// Version pins the component to a published version of the workflow (for example "v2").
+	// Builds render from the WorkflowVersion or ClusterWorkflowVersion snapshot instead of the
+	// live workflow. When empty, builds use the current workflow template.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^v[0-9]+(\.[0-9]+){0,2}$`
+	Version string `json:"version,omitempty"`
+
 	// Parameters contains the developer-provided values for the flexible parameter schema
 	// defined in the referenced Workflow CR.
 	//
@@ -144,6 +322,39 @@ type ComponentStatus struct {
 	// deployed to the first environment, if the autoDeploy flag is set to true
 	// +optional
 	LatestRelease *LatestRelease `json:"latestRelease,omitempty"`
+
+	// LibraryVersions are the versions published by a library component, oldest first.
+	// Only the most recent versions are kept.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	LibraryVersions []LibraryVersion `json:"libraryVersions,omitempty"`
+}
+
+// LibraryVersion is a versioned artifact published by a library component.
+type LibraryVersion struct {
+	// Version of the library, such as 1.4.0.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// Artifact is the registry reference of the published artifact, such as an OCI
+	// reference or a package URL.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Artifact string `json:"artifact"`
+
+	// PublishedAt is when the version was published.
+	PublishedAt metav1.Time `json:"publishedAt"`
+}
+
+// FindLibraryVersion returns the published library version with the given version, or nil.
+func (s *ComponentStatus) FindLibraryVersion(version string) *LibraryVersion {
+	for i := range s.LibraryVersions {
+		if s.LibraryVersions[i].Version == version {
+			return &s.LibraryVersions[i]
+		}
+	}
+	return nil
 }
 
 // LatestRelease has name and generated hash of the latest ComponentRelease spec
@@ -17,6 +17,10 @@ const (
 	TargetPlaneObservabilityPlane = "observabilityplane"
 )
 
+// WorkloadTypeLibrary is the workload type of component types whose components are
+// shared libraries: they are built and publish versioned artifacts, but are never deployed.
+const WorkloadTypeLibrary = "library"
+
 // ValidationRule defines a CEL-based validation rule evaluated during rendering.
 type ValidationRule struct {
 	// Rule is a CEL expression wrapped in ${...} that must evaluate to true.
@@ -54,13 +58,15 @@ func (s *SchemaSection) IsOpenAPIV3() bool {
 }
 
 // ComponentTypeSpec defines the desired state of ComponentType.
-// +kubebuilder:validation:XValidation:rule="self.workloadType == 'proxy' || self.resources.exists(r, r.id == self.workloadType)",message="resources must contain a primary resource with id matching workloadType (unless workloadType is 'proxy')"
+// +kubebuilder:validation:XValidation:rule="self.workloadType in ['proxy', 'library'] || (has(self.resources) && self.resources.exists(r, r.id == self.workloadType))",message="resources must contain a primary resource with id matching workloadType (unless workloadType is 'proxy' or 'library')"
+// +kubebuilder:validation:XValidation:rule="self.workloadType == 'library' ? !has(self.resources) || size(self.resources) == 0 : has(self.resources) && size(self.resources) > 0",message="library component types must not define resources; all other component types require at least one resource"
 // +kubebuilder:validation:XValidation:rule="!(has(self.validations) && size(self.validations) > 0 && has(self.preRenderValidations) && size(self.preRenderValidations) > 0)",message="set only one of spec.validations or spec.preRenderValidations; validations is deprecated, use preRenderValidations"
 type ComponentTypeSpec struct {
-	// WorkloadType must be one of: deployment, statefulset, cronjob, job, proxy
-	// This determines the primary workload resource type for this component type
+	// WorkloadType must be one of: deployment, statefulset, cronjob, job, proxy, library
+	// This determines the primary workload resource type for this component type.
+	// Components of a library type are built and published but never deployed.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=deployment;statefulset;cronjob;job;proxy
+	// +kubebuilder:validation:Enum=deployment;statefulset;cronjob;job;proxy;library
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.workloadType cannot be changed after creation"
 	WorkloadType string `json:"workloadType"`
 
@@ -112,11 +118,130 @@ type ComponentTypeSpec struct {
 	PostRenderValidations []PostRenderValidation `json:"postRenderValidations,omitempty"`
 
 	// Resources are templates that generate Kubernetes resources dynamically.
-	// At least one resource template is required. For non-proxy workload types,
-	// one resource must have an id matching the workloadType. When workloadType
-	// is "proxy", a matching resource id is not required.
-	// +kubebuilder:validation:MinItems=1
-	Resources []ResourceTemplate `json:"resources"`
+	// At least one resource template is required, except for library types, which
+	// must not define any. For the other non-proxy workload types, one resource must
+	// have an id matching the workloadType. When workloadType is "proxy", a matching
+	// resource id is not required.
+	// +optional
+	Resources []ResourceTemplate `json:"resources,omitempty"`
+
+	// DisruptionBudget generates PodDisruptionBudgets for multi-replica workloads of this
+	// type when deployed to production environments.
+	// +optional
+	DisruptionBudget *DisruptionBudgetPolicy `json:"disruptionBudget,omitempty"`
+
+	// RequiredEnv declares the environment variables that components of this type must set.
+	// A release binding is not rendered until each of them is provided by the workload, the
+	// binding's workload overrides, or a dependency env binding.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=100
+	RequiredEnv []RequiredEnvVar `json:"requiredEnv,omitempty"`
+
+	// Hooks are Jobs run by the release controller around rolling out workload changes, such as
+	// schema migrations before the new workload starts or cache warmups after it is ready.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=20
+	Hooks []LifecycleHook `json:"hooks,omitempty"`
+
+	// QualityGate sets the static analysis thresholds that the latest workflow run of
+	// components of this type must meet before a release can be created.
+	// +optional
+	QualityGate *AnalysisQualityGate `json:"qualityGate,omitempty"`
+
+	// WorkloadIdentity binds the workloads of components of this type to a cloud identity
+	// through the workload identity provider of the data plane they are deployed to.
+	// +optional
+	WorkloadIdentity *WorkloadIdentityRequest `json:"workloadIdentity,omitempty"`
+}
+
+// RequiredEnvVar declares an environment variable that a component type requires at runtime.
+type RequiredEnvVar struct {
+	// Name is the environment variable name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	Name string `json:"name"`
+
+	// Description explains what the variable configures. It is shown when the variable is missing.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Secret requires the value to come from a secret, either a secretKeyRef env var or
+	// a resource dependency env binding, rather than a literal value.
+	// +optional
+	Secret bool `json:"secret,omitempty"`
+}
+
+// LifecycleHookPhase is the point in a rollout at which a lifecycle hook runs.
+// +kubebuilder:validation:Enum=PreDeploy;PostDeploy
+type LifecycleHookPhase string
+
+const (
+	// LifecycleHookPhasePreDeploy hooks run before workload changes are applied; the rollout
+	// waits for them to succeed.
+	LifecycleHookPhasePreDeploy LifecycleHookPhase = "PreDeploy"
+	// LifecycleHookPhasePostDeploy hooks run once the rolled out workload is ready.
+	LifecycleHookPhasePostDeploy LifecycleHookPhase = "PostDeploy"
+)
+
+// LifecycleHookFailurePolicy decides how a failed lifecycle hook affects the rollout.
+// +kubebuilder:validation:Enum=Fail;Ignore
+type LifecycleHookFailurePolicy string
+
+const (
+	// LifecycleHookFailurePolicyFail blocks the rollout (pre-deploy) or marks the binding
+	// not ready (post-deploy) when the hook fails.
+	LifecycleHookFailurePolicyFail LifecycleHookFailurePolicy = "Fail"
+	// LifecycleHookFailurePolicyIgnore reports the failure without affecting the rollout.
+	LifecycleHookFailurePolicyIgnore LifecycleHookFailurePolicy = "Ignore"
+)
+
+// LifecycleHook is a Job rendered for a release and run around the rollout of its workload.
+type LifecycleHook struct {
+	// Name identifies the hook within the component type. The rendered Job is named after the
+	// template's metadata.name with a suffix identifying the rollout it runs for.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Phase is when the hook runs.
+	// +kubebuilder:validation:Required
+	Phase LifecycleHookPhase `json:"phase"`
+
+	// IncludeWhen is a CEL expression that determines if the hook runs.
+	// If not specified, the hook always runs.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^\$\{[\s\S]+\}\s*$`
+	IncludeWhen string `json:"includeWhen,omitempty"`
+
+	// TimeoutSeconds bounds how long the hook Job may run. It is applied as the Job's
+	// activeDeadlineSeconds unless the template sets one.
+	// +optional
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+
+	// FailurePolicy decides how a failed hook affects the rollout.
+	// +optional
+	// +kubebuilder:default=Fail
+	FailurePolicy LifecycleHookFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// Template is the batch/v1 Job with CEL expressions, rendered with the same context as the
+	// component type's resources. The Job succeeds when it reports the Complete condition.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template *runtime.RawExtension `json:"template"`
+}
+
+// IsLibrary reports whether components of this type are non-deployable libraries.
+func (s *ComponentTypeSpec) IsLibrary() bool {
+	return s.WorkloadType == WorkloadTypeLibrary
 }
 
 // EffectivePreRenderValidations returns the pre-render validation rules to apply.
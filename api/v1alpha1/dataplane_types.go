@@ -92,6 +92,41 @@ type SecretStoreRef struct {
 	Name string `json:"name"`
 }
 
+// RegistryCredential references docker config JSON credentials for a private container registry.
+// The credentials are synced into every namespace the data plane deploys components to and
+// attached to the rendered workloads as an image pull secret.
+type RegistryCredential struct {
+	// Name identifies the credential. It is used to derive the name of the image pull
+	// secret created in each target namespace.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+
+	// SecretReferenceName is the name of a SecretReference of type kubernetes.io/dockerconfigjson
+	// holding the registry credentials. It is resolved in the namespace of the ReleaseBinding
+	// being deployed, and its values are read from the data plane's secretStoreRef.
+	// +kubebuilder:validation:MinLength=1
+	SecretReferenceName string `json:"secretReferenceName"`
+}
+
+// APIVersionPreference selects the API version of rendered resources of an API group, e.g.
+// autoscaling/v2beta2 for HorizontalPodAutoscalers on clusters that do not serve autoscaling/v2.
+type APIVersionPreference struct {
+	// Group is the API group of the resources, e.g. "autoscaling" or "gateway.networking.k8s.io".
+	// The empty string selects the core group.
+	Group string `json:"group"`
+
+	// Kind restricts the preference to resources of this kind. A preference for a kind takes
+	// precedence over a preference for the whole group.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Version is the API version to render the resources with, e.g. "v2beta2".
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+}
+
 // DataPlaneSpec defines the desired state of a DataPlane.
 type DataPlaneSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
@@ -113,6 +148,12 @@ type DataPlaneSpec struct {
 	// This field is mandatory - all data planes must use cluster agent communication
 	ClusterAgent ClusterAgentConfig `json:"clusterAgent"`
 
+	// Region is the region the data plane cluster runs in, e.g. "us-east-1". Environments
+	// on this data plane inherit it unless they set their own region.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Region string `json:"region,omitempty"`
+
 	// Gateway specifies the configuration for the API gateway in this DataPlane.
 	// +optional
 	Gateway GatewaySpec `json:"gateway,omitempty"`
@@ -121,6 +162,28 @@ type DataPlaneSpec struct {
 	// +optional
 	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
 
+	// RegistryCredentials lists private container registry credentials made available to every
+	// component deployed to this DataPlane. Requires secretStoreRef.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	RegistryCredentials []RegistryCredential `json:"registryCredentials,omitempty"`
+
+	// Scheduling is the default scheduling policy for components deployed to this DataPlane.
+	// Environments and ReleaseBindings can extend it.
+	// +optional
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+
+	// APIVersions pins the API versions of resources rendered to this DataPlane, so that the
+	// same ComponentType works across clusters running different Kubernetes versions.
+	// +optional
+	APIVersions []APIVersionPreference `json:"apiVersions,omitempty"`
+
+	// WorkloadIdentity configures the cloud workload identity federation of this DataPlane,
+	// which binds components that request a cloud identity to it without long-lived keys.
+	// +optional
+	WorkloadIdentity *WorkloadIdentityConfig `json:"workloadIdentity,omitempty"`
+
 	// ObservabilityPlaneRef specifies the ObservabilityPlane or ClusterObservabilityPlane for this DataPlane.
 	// If not specified, defaults to an ObservabilityPlane named "default" in the same namespace.
 	// +optional
@@ -150,6 +213,13 @@ type AgentConnectionStatus struct {
 	// Message provides additional information about the agent connection status
 	// +optional
 	Message string `json:"message,omitempty"`
+
+	// RevokedCertificates are the SHA-256 fingerprints of the agent client certificates the
+	// cluster gateway revoked when this plane was deleted. Agents presenting them are not
+	// authorized through this plane again; a plane created again under the same name starts
+	// without revocations.
+	// +optional
+	RevokedCertificates []string `json:"revokedCertificates,omitempty"`
 }
 
 // DataPlaneStatus defines the observed state of DataPlane.
@@ -162,6 +232,11 @@ type DataPlaneStatus struct {
 	// AgentConnection tracks the status of cluster agent connections to this data plane
 	// +optional
 	AgentConnection *AgentConnectionStatus `json:"agentConnection,omitempty"`
+
+	// NodeLabels are the node labels discovered on the data plane cluster. Scheduling
+	// policies are validated against them before deploying.
+	// +optional
+	NodeLabels []NodeLabel `json:"nodeLabels,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,40 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeploymentLock locks a component in an environment against deploys, promotions and
+// restarts, typically during incident response. While the lock is active, updates to the
+// ReleaseBinding may only change the lock itself; changes to the rest of the spec and to the
+// openchoreo.dev/restartedAt annotation are rejected, and auto deploy skips the binding.
+type DeploymentLock struct {
+	// Reason explains why the component is locked.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Reason string `json:"reason"`
+
+	// LockedBy identifies who placed the lock.
+	// +optional
+	LockedBy string `json:"lockedBy,omitempty"`
+
+	// LockedAt is when the lock was placed.
+	// +optional
+	LockedAt *metav1.Time `json:"lockedAt,omitempty"`
+
+	// ExpiresAt is when the lock stops being enforced. Expired locks are kept until removed
+	// but no longer block changes.
+	// +kubebuilder:validation:Required
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// Active reports whether the lock is enforced at the given time.
+func (l *DeploymentLock) Active(now time.Time) bool {
+	return l != nil && now.Before(l.ExpiresAt.Time)
+}
@@ -21,6 +21,23 @@ type TargetEnvironmentRef struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=63
 	Name string `json:"name"`
+	// Gate holds the checks the source environment must pass before a release is promoted
+	// to this target. Targets without a gate are promoted unconditionally.
+	// +optional
+	Gate *PromotionGate `json:"gate,omitempty"`
+}
+
+// PromotionGate defines the checks of a promotion to a single target environment.
+// Each target of a promotion path is gated independently, so a blocked target does not
+// hold back the other targets.
+type PromotionGate struct {
+	// RequireSourceReady requires the release binding of the source environment to be Ready.
+	// +optional
+	RequireSourceReady bool `json:"requireSourceReady,omitempty"`
+	// SoakTime is the minimum time the release must have been Ready in the source
+	// environment. Implies RequireSourceReady.
+	// +optional
+	SoakTime *metav1.Duration `json:"soakTime,omitempty"`
 }
 
 // PromotionPath defines a path for promoting between environments
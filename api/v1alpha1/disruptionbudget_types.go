@@ -0,0 +1,27 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// DisruptionBudgetPolicy controls the PodDisruptionBudgets generated for a component's
+// Deployments and StatefulSets so that voluntary disruptions such as node drains never take
+// down every replica at once. A ComponentType enables generation for production environments;
+// a ReleaseBinding can override the policy for its environment.
+type DisruptionBudgetPolicy struct {
+	// Enabled turns PodDisruptionBudget generation on or off and defaults to true when the
+	// policy is set. When set on a ReleaseBinding it applies to that environment regardless
+	// of whether the environment is production.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinAvailable is the number or percentage of pods that must stay available.
+	// Defaults to one less than the workload's replica count. Workloads with a single
+	// replica never get a PodDisruptionBudget.
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DomainMappingOwner identifies the component whose endpoint the domain is mapped to.
+type DomainMappingOwner struct {
+	// ProjectName is the name of the project that owns the component.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the component.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ComponentName string `json:"componentName"`
+}
+
+// DomainMappingSpec defines the desired state of DomainMapping.
+type DomainMappingSpec struct {
+	// Owner identifies the component whose endpoint the domain is mapped to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self.componentName == oldSelf.componentName",message="spec.owner.componentName is immutable"
+	Owner DomainMappingOwner `json:"owner"`
+
+	// Environment is the environment whose deployment of the component serves the domain.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.environment is immutable"
+	Environment string `json:"environment"`
+
+	// Endpoint is the name of the public endpoint of the component's workload.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+
+	// Domain is the fully qualified custom domain, e.g. "shop.example.com".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z]{2,}$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.domain is immutable"
+	Domain string `json:"domain"`
+}
+
+// DomainVerificationStatus reports the DNS TXT challenge that proves ownership of the domain.
+type DomainVerificationStatus struct {
+	// RecordName is the DNS name of the TXT record to create.
+	RecordName string `json:"recordName"`
+
+	// RecordValue is the value the TXT record must have.
+	RecordValue string `json:"recordValue"`
+
+	// VerifiedAt is when the TXT record was first found.
+	// +optional
+	VerifiedAt *metav1.Time `json:"verifiedAt,omitempty"`
+
+	// LastCheckedAt is when the TXT record was last looked up.
+	// +optional
+	LastCheckedAt *metav1.Time `json:"lastCheckedAt,omitempty"`
+}
+
+// DomainCertificateStatus reports the TLS certificate issued for the domain.
+type DomainCertificateStatus struct {
+	// SecretName is the name of the secret in the data plane that holds the certificate.
+	SecretName string `json:"secretName"`
+
+	// Ready is true once the certificate has been issued.
+	Ready bool `json:"ready"`
+
+	// NotAfter is when the issued certificate expires.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// Message explains why the certificate is not issued yet.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DomainMappingStatus defines the observed state of DomainMapping.
+type DomainMappingStatus struct {
+	// ObservedGeneration is the generation last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Verification reports the DNS TXT challenge of the domain.
+	// +optional
+	Verification *DomainVerificationStatus `json:"verification,omitempty"`
+
+	// Certificate reports the TLS certificate of the domain, once the domain is verified.
+	// +optional
+	Certificate *DomainCertificateStatus `json:"certificate,omitempty"`
+
+	// URL is the URL the endpoint is served at under the domain, once the domain is verified.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Conditions represent the latest available observations of the mapping's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=dm;dms
+// +kubebuilder:printcolumn:name="Domain",type=string,JSONPath=`.spec.domain`
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Environment",type=string,JSONPath=`.spec.environment`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DomainMapping is the Schema for the domainmappings API.
+// It attaches a custom domain to a public endpoint of a component in an environment.
+// Once ownership of the domain is proven with a DNS TXT record, the ReleaseBinding of
+// the component serves the endpoint under the domain with a certificate issued for it.
+type DomainMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainMappingSpec   `json:"spec,omitempty"`
+	Status DomainMappingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainMappingList contains a list of DomainMapping.
+type DomainMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DomainMapping `json:"items"`
+}
+
+// IsVerified reports whether ownership of the domain has been proven.
+func (in *DomainMapping) IsVerified() bool {
+	return in.Status.Verification != nil && in.Status.Verification.VerifiedAt != nil
+}
+
+// GetConditions returns the conditions from the status.
+func (in *DomainMapping) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (in *DomainMapping) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&DomainMapping{}, &DomainMappingList{})
+}
@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -11,14 +12,115 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // EnvironmentSpec defines the desired state of Environment.
-// +kubebuilder:validation:XValidation:rule="!has(oldSelf.dataPlaneRef) || oldSelf.dataPlaneRef == self.dataPlaneRef",message="dataPlaneRef is immutable once set"
 type EnvironmentSpec struct {
 	// DataPlaneRef references the DataPlane or ClusterDataPlane for this environment.
 	// If not specified, defaults to a DataPlane named "default" in the same namespace.
-	// Immutable once set.
+	// Immutable once set, except when the environment is migrated off a data plane that
+	// is being decommissioned.
 	DataPlaneRef *DataPlaneRef `json:"dataPlaneRef,omitempty"`
 	IsProduction bool          `json:"isProduction,omitempty"`
 	Gateway      GatewaySpec   `json:"gateway,omitempty"`
+
+	// Region is the region the environment runs in, exposed to templates as
+	// environment.region. Defaults to the region of the referenced data plane.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Region string `json:"region,omitempty"`
+
+	// DNSSuffix is the DNS suffix of hostnames generated for the environment, exposed to
+	// templates as environment.dnsSuffix. Defaults to the host of the effective external
+	// ingress gateway.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	DNSSuffix string `json:"dnsSuffix,omitempty"`
+
+	// Tier classifies the environment, e.g. "development" or "production", exposed to
+	// templates as environment.tier. Defaults to "production" when isProduction is set and
+	// "non-production" otherwise.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Tier string `json:"tier,omitempty"`
+
+	// Scheduling extends the data plane's scheduling policy for components deployed to
+	// this environment, for example to pin production to a dedicated node pool.
+	// +optional
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+
+	// AutoRollback is the default automatic rollback policy of the ReleaseBindings
+	// in this environment. A ReleaseBinding's own autoRollback takes precedence.
+	// +optional
+	AutoRollback *AutoRollbackPolicy `json:"autoRollback,omitempty"`
+
+	// Priority maps the environment to a PriorityClass on its data plane so that, for
+	// example, production pods out-rank preview pods under resource pressure.
+	// +optional
+	Priority *EnvironmentPriority `json:"priority,omitempty"`
+
+	// CustomDomains allows DomainMappings in this environment and configures how their
+	// certificates are issued. Without it, no custom domain can be mapped in the environment.
+	// +optional
+	CustomDomains *CustomDomainPolicy `json:"customDomains,omitempty"`
+
+	// NamespaceDeletionPolicy controls the namespaces of the environment on its data plane
+	// when the environment is deleted. Delete removes them once all bindings are undeployed;
+	// Retain leaves them, and anything in them not deployed by OpenChoreo, in place.
+	// +optional
+	// +kubebuilder:default=Delete
+	NamespaceDeletionPolicy NamespaceDeletionPolicy `json:"namespaceDeletionPolicy,omitempty"`
+}
+
+// NamespaceDeletionPolicy controls the data plane namespaces of a deleted environment.
+// +kubebuilder:validation:Enum=Delete;Retain
+type NamespaceDeletionPolicy string
+
+const (
+	// NamespaceDeletionPolicyDelete deletes the data plane namespaces of the environment.
+	NamespaceDeletionPolicyDelete NamespaceDeletionPolicy = "Delete"
+	// NamespaceDeletionPolicyRetain leaves the data plane namespaces of the environment in place.
+	NamespaceDeletionPolicyRetain NamespaceDeletionPolicy = "Retain"
+)
+
+// CustomDomainPolicy configures the custom domains of an environment.
+type CustomDomainPolicy struct {
+	// IssuerRef is the cert-manager issuer in the data plane that issues the certificates
+	// of custom domains.
+	IssuerRef CertificateIssuerRef `json:"issuerRef"`
+}
+
+// CertificateIssuerRef references a cert-manager Issuer or ClusterIssuer.
+type CertificateIssuerRef struct {
+	// Name is the name of the issuer.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Kind is the kind of the issuer. An Issuer must be in the namespace of the component.
+	// +optional
+	// +kubebuilder:default=ClusterIssuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	Kind string `json:"kind,omitempty"`
+}
+
+// EnvironmentPriority maps an Environment to a Kubernetes PriorityClass.
+type EnvironmentPriority struct {
+	// PriorityClassName is set as priorityClassName on every pod deployed to the environment,
+	// unless the rendered template already sets one.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
+	PriorityClassName string `json:"priorityClassName"`
+
+	// Value is the priority of the PriorityClass created on the data plane when it does not
+	// exist yet. An existing PriorityClass is never modified. When unset, the PriorityClass
+	// must already exist on the data plane.
+	// +optional
+	// +kubebuilder:validation:Maximum=1000000000
+	Value *int32 `json:"value,omitempty"`
+
+	// PreemptionPolicy of the created PriorityClass. Never lets pods queue ahead of lower
+	// priority pods without evicting them.
+	// +optional
+	// +kubebuilder:validation:Enum=PreemptLowerPriority;Never
+	PreemptionPolicy *corev1.PreemptionPolicy `json:"preemptionPolicy,omitempty"`
 }
 
 // EnvironmentStatus defines the observed state of Environment.
@@ -27,11 +129,54 @@ type EnvironmentStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+
+	// DataPlaneMigration is the data plane the environment is being migrated to while its
+	// current data plane is decommissioned. It is set by the data plane controller and is
+	// the only target dataPlaneRef may be changed to once set.
+	// +optional
+	DataPlaneMigration *DataPlaneRef `json:"dataPlaneMigration,omitempty"`
+
+	// Teardown reports the progress of deleting the environment. It is set once the
+	// environment is being deleted.
+	// +optional
+	Teardown *EnvironmentTeardown `json:"teardown,omitempty"`
+}
+
+// EnvironmentTeardownPhase is a step of deleting an environment.
+// +kubebuilder:validation:Enum=Blocked;UndeployingBindings;DeletingNamespaces
+type EnvironmentTeardownPhase string
+
+const (
+	// EnvironmentTeardownBlocked indicates the deletion waits for the environment to be removed
+	// from the DeploymentPipelines that reference it.
+	EnvironmentTeardownBlocked EnvironmentTeardownPhase = "Blocked"
+	// EnvironmentTeardownUndeployingBindings indicates the bindings of the environment are
+	// being deleted, which removes their resources from the data plane.
+	EnvironmentTeardownUndeployingBindings EnvironmentTeardownPhase = "UndeployingBindings"
+	// EnvironmentTeardownDeletingNamespaces indicates the data plane namespaces of the
+	// environment are being deleted.
+	EnvironmentTeardownDeletingNamespaces EnvironmentTeardownPhase = "DeletingNamespaces"
+)
+
+// EnvironmentTeardown is the progress of deleting an environment.
+type EnvironmentTeardown struct {
+	// Phase is the current step of the teardown.
+	Phase EnvironmentTeardownPhase `json:"phase"`
+
+	// PendingBindings is the number of ReleaseBindings, ProjectReleaseBindings and
+	// ResourceReleaseBindings of the environment that are still being undeployed.
+	// +optional
+	PendingBindings int32 `json:"pendingBindings,omitempty"`
+
+	// PendingNamespaces are the data plane namespaces that are still being deleted.
+	// +optional
+	PendingNamespaces []string `json:"pendingNamespaces,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,shortName=env;envs
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.spec) || !has(oldSelf.spec.dataPlaneRef) || (has(self.spec) && has(self.spec.dataPlaneRef) && (oldSelf.spec.dataPlaneRef == self.spec.dataPlaneRef || (has(self.status) && has(self.status.dataPlaneMigration) && self.status.dataPlaneMigration == self.spec.dataPlaneRef)))",message="dataPlaneRef is immutable once set"
 
 // Environment is the Schema for the environments API.
 type Environment struct {
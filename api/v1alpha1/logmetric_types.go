@@ -0,0 +1,173 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogMetricOwner identifies the component whose logs the metric is derived from.
+type LogMetricOwner struct {
+	// ProjectName is the name of the project that owns the component.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the component.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ComponentName string `json:"componentName"`
+}
+
+// LogMetricType is the kind of metric derived from log lines.
+// +kubebuilder:validation:Enum=Counter;Histogram
+type LogMetricType string
+
+const (
+	// LogMetricTypeCounter counts the log lines that match the pattern.
+	LogMetricTypeCounter LogMetricType = "Counter"
+	// LogMetricTypeHistogram observes the number captured by the pattern's "value" group.
+	LogMetricTypeHistogram LogMetricType = "Histogram"
+)
+
+// LogLevel is a log level recognized by the log store.
+// +kubebuilder:validation:Enum=DEBUG;INFO;WARN;ERROR
+type LogLevel string
+
+// LogMetricSpec defines the desired state of LogMetric.
+type LogMetricSpec struct {
+	// Owner identifies the component whose logs the metric is derived from.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self.componentName == oldSelf.componentName",message="spec.owner.componentName is immutable"
+	Owner LogMetricOwner `json:"owner"`
+
+	// Environment is the environment whose logs are evaluated.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Environment string `json:"environment"`
+
+	// Type is the kind of metric.
+	// +kubebuilder:validation:Required
+	Type LogMetricType `json:"type"`
+
+	// Pattern is an RE2 regular expression that log lines must match. Histogram
+	// patterns need a capture group named "value" that holds a number, for example
+	// `duration=(?P<value>[0-9.]+)s`.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Pattern string `json:"pattern"`
+
+	// SearchPhrase pre-filters the log lines in the log store before the pattern is
+	// applied. Setting it keeps the number of evaluated lines small.
+	// +optional
+	SearchPhrase string `json:"searchPhrase,omitempty"`
+
+	// LogLevels restricts the evaluation to log lines of the given levels.
+	// +optional
+	// +listType=set
+	LogLevels []LogLevel `json:"logLevels,omitempty"`
+
+	// Buckets are the histogram bucket upper bounds in ascending order, as decimal
+	// strings. Defaults to buckets suited to durations in seconds.
+	// +optional
+	Buckets []string `json:"buckets,omitempty"`
+
+	// Window is the trailing time range the recorded value covers.
+	// +optional
+	// +kubebuilder:default="5m"
+	Window metav1.Duration `json:"window,omitempty"`
+}
+
+// LogMetricValue is the most recent evaluation of a log metric.
+// Values are decimal strings because CRDs do not carry floating point values.
+type LogMetricValue struct {
+	// Count is the number of matching log lines in the window.
+	Count int64 `json:"count"`
+
+	// Sum is the sum of the extracted values in the window. Histograms only.
+	// +optional
+	Sum string `json:"sum,omitempty"`
+
+	// P50 is the median of the extracted values in the window. Histograms only.
+	// +optional
+	P50 string `json:"p50,omitempty"`
+
+	// P90 is the 90th percentile of the extracted values in the window. Histograms only.
+	// +optional
+	P90 string `json:"p90,omitempty"`
+
+	// P99 is the 99th percentile of the extracted values in the window. Histograms only.
+	// +optional
+	P99 string `json:"p99,omitempty"`
+
+	// Truncated is true when the window held more log lines than the observability
+	// plane evaluates per query, in which case the values are a lower bound.
+	Truncated bool `json:"truncated"`
+
+	// EvaluatedAt is the end of the evaluated window.
+	EvaluatedAt metav1.Time `json:"evaluatedAt"`
+}
+
+// LogMetricStatus defines the observed state of LogMetric.
+type LogMetricStatus struct {
+	// ObservedGeneration is the generation last evaluated by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Value is the most recent evaluation of the metric.
+	// +optional
+	Value *LogMetricValue `json:"value,omitempty"`
+
+	// Conditions represent the latest available observations of the metric's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=lm;lms
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Environment",type=string,JSONPath=`.spec.environment`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Count",type=integer,JSONPath=`.status.value.count`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// LogMetric is the Schema for the logmetrics API.
+// It declares a counter or histogram derived from the logs of a component in an
+// environment, giving lightweight custom metrics without instrumenting the
+// application. The observability plane evaluates the metric from the log store and
+// the latest value is recorded in status; arbitrary ranges can be queried through
+// the observer log metrics endpoint.
+type LogMetric struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogMetricSpec   `json:"spec,omitempty"`
+	Status LogMetricStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogMetricList contains a list of LogMetric.
+type LogMetricList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogMetric `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (in *LogMetric) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (in *LogMetric) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&LogMetric{}, &LogMetricList{})
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultMaintenanceStatusCode is the status of the direct response of a component in
+	// maintenance when none is set.
+	DefaultMaintenanceStatusCode = 503
+
+	// DefaultMaintenanceRedirectStatusCode is the status of the redirect of a component in
+	// maintenance when none is set.
+	DefaultMaintenanceRedirectStatusCode = 302
+)
+
+// MaintenanceMode takes a component out of service in an environment without undeploying
+// it. While set, the HTTPRoutes of the component answer every request at the gateway, either
+// with a fixed response or with a redirect, instead of routing it to the workload. The
+// workload keeps running, so ending the maintenance restores traffic at once.
+// +kubebuilder:validation:XValidation:rule="!has(self.redirectURL) || !has(self.body)",message="body cannot be set together with redirectURL"
+// +kubebuilder:validation:XValidation:rule="!has(self.redirectURL) || !has(self.statusCode) || self.statusCode in [301, 302]",message="statusCode must be 301 or 302 when redirectURL is set"
+type MaintenanceMode struct {
+	// StatusCode is the HTTP status of the response. Defaults to 503, or to 302 when
+	// RedirectURL is set.
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	// +optional
+	StatusCode int32 `json:"statusCode,omitempty"`
+
+	// Body is the body of the response, for example a maintenance page.
+	// +kubebuilder:validation:MaxLength=4096
+	// +optional
+	Body string `json:"body,omitempty"`
+
+	// RedirectURL is an absolute http or https URL requests are redirected to instead of
+	// being answered directly.
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	RedirectURL string `json:"redirectURL,omitempty"`
+
+	// Reason explains why the component is in maintenance.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// EnabledBy identifies who put the component in maintenance.
+	// +optional
+	EnabledBy string `json:"enabledBy,omitempty"`
+
+	// EnabledAt is when the component was put in maintenance.
+	// +optional
+	EnabledAt *metav1.Time `json:"enabledAt,omitempty"`
+}
+
+// ResponseStatusCode returns the status of the response, applying the defaults.
+func (m *MaintenanceMode) ResponseStatusCode() int32 {
+	switch {
+	case m.StatusCode != 0:
+		return m.StatusCode
+	case m.RedirectURL != "":
+		return DefaultMaintenanceRedirectStatusCode
+	default:
+		return DefaultMaintenanceStatusCode
+	}
+}
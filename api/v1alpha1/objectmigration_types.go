@@ -0,0 +1,149 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ObjectMigrationSpec defines the desired state of ObjectMigration.
+type ObjectMigrationSpec struct {
+	// Migration is the name of a migration registered with the controller manager
+	// (e.g. "validations-to-prerendervalidations").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.migration is immutable"
+	Migration string `json:"migration"`
+
+	// DryRun reports which objects would change without persisting any update.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// BatchSize is the page size used when listing stored objects.
+	// +optional
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	BatchSize int64 `json:"batchSize,omitempty"`
+
+	// RerunToken is an opaque value; changing it re-runs a completed migration.
+	// Migrations are idempotent, so re-running only touches objects that still
+	// need to be transformed.
+	// +optional
+	RerunToken string `json:"rerunToken,omitempty"`
+}
+
+// ObjectMigrationPhase represents the lifecycle phase of a migration run.
+type ObjectMigrationPhase string
+
+const (
+	// ObjectMigrationPhasePending means the migration has not started yet.
+	ObjectMigrationPhasePending ObjectMigrationPhase = "Pending"
+	// ObjectMigrationPhaseRunning means the migration is processing stored objects.
+	ObjectMigrationPhaseRunning ObjectMigrationPhase = "Running"
+	// ObjectMigrationPhaseSucceeded means every stored object was processed without error.
+	ObjectMigrationPhaseSucceeded ObjectMigrationPhase = "Succeeded"
+	// ObjectMigrationPhaseFailed means the migration is unknown or at least one object failed.
+	ObjectMigrationPhaseFailed ObjectMigrationPhase = "Failed"
+)
+
+// ObjectMigrationFailure records a stored object that could not be migrated.
+type ObjectMigrationFailure struct {
+	// Kind of the object that failed to migrate.
+	Kind string `json:"kind"`
+
+	// Namespace of the object. Empty for cluster-scoped objects.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the object that failed to migrate.
+	Name string `json:"name"`
+
+	// Message describes why the object could not be migrated.
+	Message string `json:"message"`
+}
+
+// ObjectMigrationStatus defines the observed state of ObjectMigration.
+type ObjectMigrationStatus struct {
+	// ObservedGeneration is the generation of the spec the last run was started for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is the lifecycle phase of the current or last run.
+	// +optional
+	Phase ObjectMigrationPhase `json:"phase,omitempty"`
+
+	// StartTime is when the current or last run started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the last run finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Scanned is the number of stored objects inspected so far.
+	// +optional
+	Scanned int64 `json:"scanned,omitempty"`
+
+	// Migrated is the number of stored objects that were (or in dry-run mode would be) updated.
+	// +optional
+	Migrated int64 `json:"migrated,omitempty"`
+
+	// Failed is the number of stored objects that could not be migrated.
+	// +optional
+	Failed int64 `json:"failed,omitempty"`
+
+	// Failures lists a bounded sample of the objects that could not be migrated.
+	// +optional
+	Failures []ObjectMigrationFailure `json:"failures,omitempty"`
+
+	// Conditions represent the latest available observations of the migration's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=objmig;objmigs
+// +kubebuilder:printcolumn:name="Migration",type=string,JSONPath=`.spec.migration`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Migrated",type=integer,JSONPath=`.status.migrated`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ObjectMigration is the Schema for the objectmigrations API.
+// It requests that a registered, idempotent migration is applied to the stored
+// OpenChoreo resources and tracks the progress of that run.
+type ObjectMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectMigrationSpec   `json:"spec,omitempty"`
+	Status ObjectMigrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectMigrationList contains a list of ObjectMigration.
+type ObjectMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObjectMigration `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (in *ObjectMigration) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (in *ObjectMigration) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&ObjectMigration{}, &ObjectMigrationList{})
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ObservabilityExportMode controls when logs are exported.
+// +kubebuilder:validation:Enum=Scheduled;Continuous
+type ObservabilityExportMode string
+
+const (
+	// ObservabilityExportModeScheduled exports the logs of each interval in one batch.
+	ObservabilityExportModeScheduled ObservabilityExportMode = "Scheduled"
+	// ObservabilityExportModeContinuous exports new logs every minute.
+	ObservabilityExportModeContinuous ObservabilityExportMode = "Continuous"
+)
+
+// ObservabilityExportSinkType identifies the destination of exported logs.
+// +kubebuilder:validation:Enum=s3;gcs;http
+type ObservabilityExportSinkType string
+
+const (
+	// ObservabilityExportSinkTypeS3 writes batches as objects to an S3 or S3-compatible bucket.
+	ObservabilityExportSinkTypeS3 ObservabilityExportSinkType = "s3"
+	// ObservabilityExportSinkTypeGCS writes batches as objects to a Google Cloud Storage bucket.
+	ObservabilityExportSinkTypeGCS ObservabilityExportSinkType = "gcs"
+	// ObservabilityExportSinkTypeHTTP posts batches to an HTTP endpoint.
+	ObservabilityExportSinkTypeHTTP ObservabilityExportSinkType = "http"
+)
+
+// ObservabilityExportCompression is the encoding of exported batches.
+// +kubebuilder:validation:Enum=gzip;none
+type ObservabilityExportCompression string
+
+const (
+	// ObservabilityExportCompressionGzip gzips each batch.
+	ObservabilityExportCompressionGzip ObservabilityExportCompression = "gzip"
+	// ObservabilityExportCompressionNone leaves batches uncompressed.
+	ObservabilityExportCompressionNone ObservabilityExportCompression = "none"
+)
+
+// ObservabilityExportFilter selects the logs that are exported. All logs of the namespace
+// are exported when no field is set.
+// +kubebuilder:validation:XValidation:rule="!has(self.componentName) || has(self.projectName)",message="projectName is required when componentName is set"
+type ObservabilityExportFilter struct {
+	// ProjectName limits the export to the logs of a project.
+	// +optional
+	ProjectName string `json:"projectName,omitempty"`
+
+	// ComponentName limits the export to the logs of a component of the project.
+	// +optional
+	ComponentName string `json:"componentName,omitempty"`
+
+	// Environment limits the export to the logs of an environment.
+	// +optional
+	Environment string `json:"environment,omitempty"`
+
+	// LogLevels limits the export to log entries of these levels.
+	// +optional
+	// +listType=set
+	LogLevels []string `json:"logLevels,omitempty"`
+
+	// SearchPhrase limits the export to log entries containing the phrase.
+	// +optional
+	SearchPhrase string `json:"searchPhrase,omitempty"`
+}
+
+// ObservabilityExportS3Sink writes batches to an S3 bucket. Requests are signed with AWS
+// Signature Version 4 using the referenced access key.
+type ObservabilityExportS3Sink struct {
+	// Bucket is the name of the bucket.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+
+	// Region is the region of the bucket.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Region string `json:"region"`
+
+	// Endpoint is the URL of S3-compatible storage. Objects are addressed path-style.
+	// +optional
+	// +kubebuilder:validation:Format=uri
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Prefix is prepended to the name of each object.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// AccessKeyID references the access key ID in a Secret of the namespace.
+	// +kubebuilder:validation:Required
+	AccessKeyID SecretKeyRef `json:"accessKeyId"`
+
+	// SecretAccessKey references the secret access key in a Secret of the namespace.
+	// +kubebuilder:validation:Required
+	SecretAccessKey SecretKeyRef `json:"secretAccessKey"`
+}
+
+// ObservabilityExportGCSSink writes batches to a Google Cloud Storage bucket.
+type ObservabilityExportGCSSink struct {
+	// Bucket is the name of the bucket.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+
+	// Prefix is prepended to the name of each object.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Credentials references a service account JSON key in a Secret of the namespace.
+	// The service account needs permission to create objects in the bucket.
+	// +kubebuilder:validation:Required
+	Credentials SecretKeyRef `json:"credentials"`
+}
+
+// ObservabilityExportHTTPSink posts batches as newline-delimited JSON to an HTTP endpoint.
+type ObservabilityExportHTTPSink struct {
+	// URL is the endpoint batches are posted to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Format=uri
+	URL string `json:"url"`
+
+	// Headers are added to each request. Values can be provided inline or via secret
+	// references in the namespace.
+	// +optional
+	Headers map[string]WebhookHeaderValue `json:"headers,omitempty"`
+}
+
+// ObservabilityExportSink is the destination of exported logs.
+// +kubebuilder:validation:XValidation:rule="self.type == 's3' ? has(self.s3) : !has(self.s3)",message="s3 must be set only when type is s3"
+// +kubebuilder:validation:XValidation:rule="self.type == 'gcs' ? has(self.gcs) : !has(self.gcs)",message="gcs must be set only when type is gcs"
+// +kubebuilder:validation:XValidation:rule="self.type == 'http' ? has(self.http) : !has(self.http)",message="http must be set only when type is http"
+type ObservabilityExportSink struct {
+	// Type is the kind of sink. The block of the same name must be set.
+	// +kubebuilder:validation:Required
+	Type ObservabilityExportSinkType `json:"type"`
+
+	// S3 configures an S3 sink.
+	// +optional
+	S3 *ObservabilityExportS3Sink `json:"s3,omitempty"`
+
+	// GCS configures a Google Cloud Storage sink.
+	// +optional
+	GCS *ObservabilityExportGCSSink `json:"gcs,omitempty"`
+
+	// HTTP configures an HTTP sink.
+	// +optional
+	HTTP *ObservabilityExportHTTPSink `json:"http,omitempty"`
+}
+
+// ObservabilityExportSpec defines the desired state of ObservabilityExport.
+// +kubebuilder:validation:XValidation:rule="!has(self.interval) || duration(self.interval) >= duration('5m')",message="interval must be at least 5m"
+type ObservabilityExportSpec struct {
+	// Filter selects the exported logs.
+	// +optional
+	Filter ObservabilityExportFilter `json:"filter,omitempty"`
+
+	// Mode controls when logs are exported. Scheduled exports write one batch per
+	// interval; Continuous exports write new logs every minute.
+	// +optional
+	// +kubebuilder:default=Scheduled
+	Mode ObservabilityExportMode `json:"mode,omitempty"`
+
+	// Interval is the time range of each batch of a Scheduled export.
+	// +optional
+	// +kubebuilder:default="1h"
+	Interval metav1.Duration `json:"interval,omitempty"`
+
+	// Sink is the destination of the exported logs.
+	// +kubebuilder:validation:Required
+	Sink ObservabilityExportSink `json:"sink"`
+
+	// Compression is the encoding of each batch.
+	// +optional
+	// +kubebuilder:default=gzip
+	Compression ObservabilityExportCompression `json:"compression,omitempty"`
+
+	// Suspend pauses the export. Logs of the paused period are exported when it resumes.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// ObservabilityExportStatus defines the observed state of ObservabilityExport.
+type ObservabilityExportStatus struct {
+	// ObservedGeneration is the generation last registered with the observer.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastExportTime is the time up to which logs have been exported. It is sent back to
+	// the observer so that exports resume where they stopped after an observer restart.
+	// +optional
+	LastExportTime *metav1.Time `json:"lastExportTime,omitempty"`
+
+	// LastRunTime is when the export last ran.
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// ExportedEntries is the number of log entries exported by the current observer instance.
+	// +optional
+	ExportedEntries int64 `json:"exportedEntries,omitempty"`
+
+	// Conditions represent the latest available observations of the export's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=oexp;oexps
+// +kubebuilder:printcolumn:name="Sink",type=string,JSONPath=`.spec.sink.type`
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.mode`
+// +kubebuilder:printcolumn:name="Last Export",type="date",JSONPath=`.status.lastExportTime`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ObservabilityExport is the Schema for the observabilityexports API.
+// It forwards the logs of a namespace (organization), optionally filtered by project,
+// component, environment, level and phrase, to an S3 bucket, a GCS bucket or an HTTP
+// endpoint. The observability plane controllers register the export with the observer,
+// which writes the logs as newline-delimited JSON batches.
+type ObservabilityExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObservabilityExportSpec   `json:"spec,omitempty"`
+	Status ObservabilityExportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObservabilityExportList contains a list of ObservabilityExport.
+type ObservabilityExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObservabilityExport `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (in *ObservabilityExport) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (in *ObservabilityExport) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&ObservabilityExport{}, &ObservabilityExportList{})
+}
@@ -42,6 +42,161 @@ type ObservabilityPlaneSpec struct {
 	// FinOpsAgentURL is the base URL of the FinOps Agent API in the observability plane cluster
 	// +optional
 	FinOpsAgentURL string `json:"finOpsAgentURL,omitempty"`
+
+	// Prometheus declares the Prometheus remote-write targets, recording rules and Alertmanager
+	// routes to provision in the observability plane cluster through the Prometheus Operator.
+	// When omitted, the observability plane is expected to be fully configured out of band.
+	// +optional
+	Prometheus *ObservabilityPlanePrometheus `json:"prometheus,omitempty"`
+}
+
+// ObservabilityPlanePrometheus defines the Prometheus Operator resources provisioned in the
+// observability plane cluster.
+type ObservabilityPlanePrometheus struct {
+	// Namespace in the observability plane cluster that holds the Prometheus resources.
+	// +optional
+	// +kubebuilder:default=openchoreo-observability-plane
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the Prometheus resource that receives the remote-write targets.
+	// The resource is created if it does not exist.
+	// +optional
+	// +kubebuilder:default=openchoreo-prometheus
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name,omitempty"`
+
+	// RemoteWrite lists the remote-write targets of the Prometheus resource.
+	// The list replaces any remote-write targets configured by other managers.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	RemoteWrite []PrometheusRemoteWrite `json:"remoteWrite,omitempty"`
+
+	// RecordingRuleGroups are provisioned as a single PrometheusRule resource.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	RecordingRuleGroups []PrometheusRecordingRuleGroup `json:"recordingRuleGroups,omitempty"`
+
+	// AlertmanagerRoutes are provisioned as a single AlertmanagerConfig resource.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	AlertmanagerRoutes []AlertmanagerRoute `json:"alertmanagerRoutes,omitempty"`
+}
+
+// PrometheusRemoteWrite defines a Prometheus remote-write target.
+type PrometheusRemoteWrite struct {
+	// Name uniquely identifies the remote-write target.
+	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// URL of the remote-write endpoint.
+	// +required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// BearerTokenSecretRef references a Secret key holding the bearer token sent to the endpoint.
+	// The Secret must exist in the Prometheus namespace of the observability plane cluster.
+	// +optional
+	BearerTokenSecretRef *SecretKeyRef `json:"bearerTokenSecretRef,omitempty"`
+
+	// Headers are custom HTTP headers sent with each remote-write request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// PrometheusRecordingRuleGroup defines a group of recording rules evaluated together.
+type PrometheusRecordingRuleGroup struct {
+	// Name of the rule group.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Interval is how often the rules in the group are evaluated.
+	// Defaults to the Prometheus global evaluation interval.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Rules are the recording rules of the group.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	Rules []PrometheusRecordingRule `json:"rules"`
+}
+
+// PrometheusRecordingRule records the result of a PromQL expression as a new series.
+type PrometheusRecordingRule struct {
+	// Record is the name of the series to record into.
+	// +required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_:][a-zA-Z0-9_:]*$`
+	Record string `json:"record"`
+
+	// Expr is the PromQL expression to evaluate.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Expr string `json:"expr"`
+
+	// Labels are added to the recorded series.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// AlertmanagerRoute routes matching alerts to a webhook receiver.
+type AlertmanagerRoute struct {
+	// Name of the route, also used as the receiver name.
+	// +required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Matchers select the alerts sent to this route.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	Matchers []AlertmanagerMatcher `json:"matchers"`
+
+	// WebhookURL is the endpoint that receives the routed alerts.
+	// +required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	WebhookURL string `json:"webhookURL"`
+
+	// GroupBy lists the labels used to group alerts into a single notification.
+	// +optional
+	GroupBy []string `json:"groupBy,omitempty"`
+
+	// RepeatInterval is how long to wait before re-sending a notification for a firing alert.
+	// +optional
+	RepeatInterval *metav1.Duration `json:"repeatInterval,omitempty"`
+}
+
+// AlertmanagerMatchType is the comparison applied by an Alertmanager matcher.
+// +kubebuilder:validation:Enum="=";"!=";"=~";"!~"
+type AlertmanagerMatchType string
+
+const (
+	AlertmanagerMatchEqual     AlertmanagerMatchType = "="
+	AlertmanagerMatchNotEqual  AlertmanagerMatchType = "!="
+	AlertmanagerMatchRegexp    AlertmanagerMatchType = "=~"
+	AlertmanagerMatchNotRegexp AlertmanagerMatchType = "!~"
+)
+
+// AlertmanagerMatcher matches an alert label.
+type AlertmanagerMatcher struct {
+	// Name of the alert label.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value to compare the label against.
+	// +required
+	Value string `json:"value"`
+
+	// MatchType is the comparison operator.
+	// +optional
+	// +kubebuilder:default="="
+	MatchType AlertmanagerMatchType `json:"matchType,omitempty"`
 }
 
 // ObservabilityPlaneStatus defines the observed state of ObservabilityPlane.
@@ -0,0 +1,215 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrphanCleanupPolicy selects what an OrphanScanner does with the orphaned resources it finds.
+// +kubebuilder:validation:Enum=Report;Delete
+type OrphanCleanupPolicy string
+
+const (
+	// OrphanCleanupPolicyReport records orphaned resources in status and leaves them in place.
+	OrphanCleanupPolicyReport OrphanCleanupPolicy = "Report"
+	// OrphanCleanupPolicyDelete records orphaned resources in status and deletes them from the
+	// data plane. Namespaces are never deleted.
+	OrphanCleanupPolicyDelete OrphanCleanupPolicy = "Delete"
+)
+
+// OrphanReason explains why a data plane resource is orphaned.
+// +kubebuilder:validation:Enum=ReleaseNotFound;NotInInventory
+type OrphanReason string
+
+const (
+	// OrphanReasonReleaseNotFound indicates the RenderedRelease named by the resource labels no
+	// longer exists, or was recreated with another UID.
+	OrphanReasonReleaseNotFound OrphanReason = "ReleaseNotFound"
+	// OrphanReasonNotInInventory indicates the RenderedRelease exists but neither renders nor
+	// reports the resource.
+	OrphanReasonNotInInventory OrphanReason = "NotInInventory"
+)
+
+// OrphanScannerSpec defines the desired state of OrphanScanner.
+type OrphanScannerSpec struct {
+	// Schedule is a five-field cron expression, evaluated in UTC, that decides when the
+	// data planes are scanned.
+	// +optional
+	// +kubebuilder:default="0 * * * *"
+	// +kubebuilder:validation:MinLength=9
+	Schedule string `json:"schedule,omitempty"`
+
+	// Planes are the data planes that are scanned. Only resources applied for the
+	// RenderedReleases of the scanner's namespace are inspected.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:XValidation:rule="self.all(p, p.kind == 'DataPlane' || p.kind == 'ClusterDataPlane')",message="planes must be DataPlanes or ClusterDataPlanes"
+	Planes []TargetPlaneRef `json:"planes"`
+
+	// CleanupPolicy selects whether orphaned resources are only reported or also deleted.
+	// +optional
+	// +kubebuilder:default=Report
+	CleanupPolicy OrphanCleanupPolicy `json:"cleanupPolicy,omitempty"`
+
+	// GracePeriod is how long after its creation a resource is exempt from the scan, so
+	// that resources of a release that is being applied are not reported.
+	// +optional
+	// +kubebuilder:default="1h"
+	GracePeriod metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// OrphanedResource is a data plane resource labeled as managed by OpenChoreo that no
+// RenderedRelease accounts for.
+type OrphanedResource struct {
+	// Plane is the data plane that holds the resource.
+	Plane TargetPlaneRef `json:"plane"`
+
+	// APIVersion is the API version of the resource.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the resource in the data plane. Empty for
+	// cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource in the data plane.
+	Name string `json:"name"`
+
+	// RenderedRelease is the RenderedRelease named by the resource labels.
+	RenderedRelease string `json:"renderedRelease"`
+
+	// Project is the project the resource was rendered for, if known.
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// Component is the component the resource was rendered for, if known.
+	// +optional
+	Component string `json:"component,omitempty"`
+
+	// Reason explains why the resource is orphaned.
+	Reason OrphanReason `json:"reason"`
+
+	// Deleted is true when the scan deleted the resource.
+	// +optional
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// MissingResource is a resource in the inventory of a RenderedRelease that no longer exists
+// in the data plane.
+type MissingResource struct {
+	// Plane is the data plane the release is applied to.
+	Plane TargetPlaneRef `json:"plane"`
+
+	// RenderedRelease is the RenderedRelease that reports the resource.
+	RenderedRelease string `json:"renderedRelease"`
+
+	// Project is the project of the release.
+	Project string `json:"project"`
+
+	// Component is the component of the release. Empty for project and resource releases.
+	// +optional
+	Component string `json:"component,omitempty"`
+
+	// Environment is the environment of the release.
+	Environment string `json:"environment"`
+
+	// APIVersion is the API version of the resource.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the resource in the data plane. Empty for
+	// cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource in the data plane.
+	Name string `json:"name"`
+}
+
+// OrphanScannerStatus defines the observed state of OrphanScanner.
+type OrphanScannerStatus struct {
+	// ObservedGeneration is the generation last scanned by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastScanTime is when the data planes were last scanned.
+	// +optional
+	LastScanTime *metav1.Time `json:"lastScanTime,omitempty"`
+
+	// NextScanTime is when the data planes are scanned next.
+	// +optional
+	NextScanTime *metav1.Time `json:"nextScanTime,omitempty"`
+
+	// ScannedResources is the number of OpenChoreo-managed resources inspected in the last scan.
+	// +optional
+	ScannedResources int32 `json:"scannedResources,omitempty"`
+
+	// OrphanedResources are the resources no RenderedRelease accounts for. At most 100 are
+	// recorded; the metrics cover all of them.
+	// +optional
+	OrphanedResources []OrphanedResource `json:"orphanedResources,omitempty"`
+
+	// MissingResources are the resources RenderedReleases report that no longer exist in the
+	// data plane. At most 100 are recorded; the metrics cover all of them.
+	// +optional
+	MissingResources []MissingResource `json:"missingResources,omitempty"`
+
+	// Conditions represent the latest available observations of the scanner's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=osc
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Cleanup",type=string,JSONPath=`.spec.cleanupPolicy`
+// +kubebuilder:printcolumn:name="Last Scan",type="date",JSONPath=`.status.lastScanTime`
+// +kubebuilder:printcolumn:name="Orphans",type=string,JSONPath=`.status.conditions[?(@.type=="OrphansFound")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// OrphanScanner is the Schema for the orphanscanners API.
+// It verifies on a cron schedule that the resources applied to data planes for the
+// RenderedReleases of its namespace are still accounted for. Resources labeled as managed
+// by OpenChoreo that no release renders or reports are orphaned, and resources a release
+// reports that no longer exist are missing. Both are recorded in status and as metrics on
+// the controller manager; orphaned resources are optionally deleted.
+type OrphanScanner struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrphanScannerSpec   `json:"spec,omitempty"`
+	Status OrphanScannerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrphanScannerList contains a list of OrphanScanner.
+type OrphanScannerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrphanScanner `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (in *OrphanScanner) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (in *OrphanScanner) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&OrphanScanner{}, &OrphanScannerList{})
+}
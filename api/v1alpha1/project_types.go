@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -31,6 +32,41 @@ type ProjectSpec struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+
+	// QualityGate sets the static analysis thresholds that the latest workflow run of
+	// components in this project must meet before a release can be created. It applies
+	// in addition to the quality gate of the component's type.
+	// +optional
+	QualityGate *AnalysisQualityGate `json:"qualityGate,omitempty"`
+
+	// Quota limits the components of the project, the environments they are deployed to and
+	// the CPU and memory requested by their releases. Limits that are not set are not enforced.
+	// +optional
+	Quota *ProjectQuota `json:"quota,omitempty"`
+}
+
+// ProjectQuota limits what the components of a project may use.
+type ProjectQuota struct {
+	// MaxComponents is the maximum number of components in the project.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxComponents *int32 `json:"maxComponents,omitempty"`
+
+	// MaxEnvironments is the maximum number of environments the components of the project
+	// are deployed to.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxEnvironments *int32 `json:"maxEnvironments,omitempty"`
+
+	// CPU is the budget for the CPU requested by the pods of all the releases of the project,
+	// across environments.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+
+	// Memory is the budget for the memory requested by the pods of all the releases of the
+	// project, across environments.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
 }
 
 // ProjectStatus defines the observed state of Project.
@@ -157,7 +157,7 @@ type WorkloadOverrideTemplateSpec struct {
 type ReleaseBindingSpec struct {
 	// Owner identifies the component and project this ReleaseBinding belongs to
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.owner is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.componentName == oldSelf.componentName",message="spec.owner.componentName is immutable"
 	Owner ReleaseBindingOwner `json:"owner"`
 
 	// EnvironmentName is the name of the environment this binds the ComponentRelease to
@@ -188,6 +188,36 @@ type ReleaseBindingSpec struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	WorkloadOverrides *WorkloadOverrideTemplateSpec `json:"workloadOverrides,omitempty"`
 
+	// Scheduling extends the environment's scheduling policy for this component only.
+	// +optional
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+
+	// DisruptionBudget overrides the ComponentType's PodDisruptionBudget policy for this
+	// environment.
+	// +optional
+	DisruptionBudget *DisruptionBudgetPolicy `json:"disruptionBudget,omitempty"`
+
+	// Lock locks the component in this environment against deploys, promotions and restarts.
+	// +optional
+	Lock *DeploymentLock `json:"lock,omitempty"`
+
+	// Maintenance takes the component in this environment out of service at the gateway
+	// without undeploying it.
+	// +optional
+	Maintenance *MaintenanceMode `json:"maintenance,omitempty"`
+
+	// AutoRollback verifies that each newly bound release becomes Ready and rolls back to the
+	// last healthy release when it does not, or when it degrades for too long after it became
+	// Ready. Overrides the environment's autoRollback policy.
+	// +optional
+	AutoRollback *AutoRollbackPolicy `json:"autoRollback,omitempty"`
+
+	// RolloutStrategy shifts traffic from the running release to a newly bound release
+	// gradually (Canary) or after a preview (BlueGreen). Without it, a newly bound release
+	// replaces the running one at once.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
 	// State controls the state of the Release created by this binding.
 	// Active: Resources are deployed normally
 	// Undeploy: Resources are removed from the data plane
@@ -197,6 +227,93 @@ type ReleaseBindingSpec struct {
 	State ReleaseState `json:"state,omitempty"`
 }
 
+// AutoRollbackPolicy configures the automatic rollback of a ReleaseBinding. A newly bound
+// release that fails its post-deploy health check is rolled back in status.rollback; a
+// release that passed it and later stays degraded is rolled back by re-pointing
+// spec.releaseName.
+type AutoRollbackPolicy struct {
+	// Enabled turns on the health check and automatic rollback.
+	Enabled bool `json:"enabled"`
+
+	// HealthCheckTimeout is how long a newly bound release may take to become Ready before
+	// it is rolled back. Defaults to 10m.
+	// +optional
+	HealthCheckTimeout *metav1.Duration `json:"healthCheckTimeout,omitempty"`
+
+	// DegradedTimeout is how long the resources of a release that became Ready may stay
+	// degraded before spec.releaseName is re-pointed to the previously healthy
+	// ComponentRelease. Degraded releases are not rolled back when unset.
+	// +optional
+	DegradedTimeout *metav1.Duration `json:"degradedTimeout,omitempty"`
+}
+
+// RolloutStrategyType is the way a newly bound release takes over the traffic of an environment.
+// +kubebuilder:validation:Enum=Canary;BlueGreen
+type RolloutStrategyType string
+
+const (
+	// RolloutStrategyCanary runs the new release next to the running one and sends it an
+	// increasing share of the traffic.
+	RolloutStrategyCanary RolloutStrategyType = "Canary"
+	// RolloutStrategyBlueGreen runs the new release next to the running one behind a preview
+	// endpoint and switches all traffic to it once it is promoted.
+	RolloutStrategyBlueGreen RolloutStrategyType = "BlueGreen"
+)
+
+// RolloutStrategy configures how a newly bound release replaces the running one. Both
+// strategies require a ComponentType with the deployment workload type; the traffic is
+// shifted by weighting the backends of the rendered HTTPRoutes and GRPCRoutes.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Canary' || has(self.canary)",message="canary is required when type is Canary"
+type RolloutStrategy struct {
+	// Type is the rollout strategy.
+	Type RolloutStrategyType `json:"type"`
+
+	// Canary configures the traffic steps of a Canary rollout.
+	// +optional
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+
+	// BlueGreen configures the preview and promotion of a BlueGreen rollout.
+	// +optional
+	BlueGreen *BlueGreenStrategy `json:"blueGreen,omitempty"`
+}
+
+// CanaryStrategy configures the traffic steps of a Canary rollout.
+type CanaryStrategy struct {
+	// Steps are run in order once the new release is ready. After the last step the new
+	// release receives all traffic.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	Steps []CanaryStep `json:"steps"`
+}
+
+// CanaryStep is a step of a Canary rollout.
+type CanaryStep struct {
+	// Weight is the percentage of the traffic sent to the new release during the step.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// Pause is how long the step lasts once the new release is ready. Without it the
+	// rollout waits at this step until it is promoted.
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+}
+
+// BlueGreenStrategy configures the preview and promotion of a BlueGreen rollout.
+type BlueGreenStrategy struct {
+	// PreviewHostnamePrefix is prepended to the hostnames of the routes of the new release
+	// to form its preview hostnames.
+	// +kubebuilder:default="preview-"
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*)?$`
+	// +optional
+	PreviewHostnamePrefix string `json:"previewHostnamePrefix,omitempty"`
+
+	// AutoPromotionDelay promotes the new release once it has been ready for this long.
+	// Without it the new release is only promoted manually.
+	// +optional
+	AutoPromotionDelay *metav1.Duration `json:"autoPromotionDelay,omitempty"`
+}
+
 // ReleaseBindingOwner identifies the component this ReleaseBinding belongs to
 type ReleaseBindingOwner struct {
 	// ProjectName is the name of the project that owns this component
@@ -212,7 +329,7 @@ type ReleaseBindingOwner struct {
 
 // EndpointURL represents a structured URL with its components.
 type EndpointURL struct {
-	// Scheme is the URL scheme (e.g., http, https, tcp, udp, ws, wss, grpc, grpcs, tls).
+	// Scheme is the URL scheme (e.g., http, https, tcp, udp, ws, wss, grpc, grpcs, tls, kafka, nats, amqp).
 	// +optional
 	Scheme string `json:"scheme,omitempty"`
 
@@ -228,6 +345,10 @@ type EndpointURL struct {
 	// Path is the URL path.
 	// +optional
 	Path string `json:"path,omitempty"`
+
+	// Channel is the Kafka topic, NATS subject or AMQP queue of an asynchronous endpoint.
+	// +optional
+	Channel string `json:"channel,omitempty"`
 }
 
 // EndpointGatewayURLs holds resolved gateway URLs for an endpoint, grouped by
@@ -259,7 +380,7 @@ type EndpointURLStatus struct {
 	// Name is the endpoint name as defined in the Workload spec.
 	Name string `json:"name"`
 
-	// Type is the endpoint type (HTTP, gRPC, GraphQL, Websocket, TCP, UDP).
+	// Type is the endpoint type (HTTP, gRPC, GraphQL, Websocket, TCP, UDP, Kafka, NATS, AMQP).
 	// +optional
 	Type EndpointType `json:"type,omitempty"`
 
@@ -329,6 +450,253 @@ type ReleaseBindingStatus struct {
 	// Used as an index source for finding affected ReleaseBindings when a SecretReference changes.
 	// +optional
 	SecretReferenceNames []string `json:"secretReferenceNames,omitempty"`
+
+	// Promotion records the most recent change of the ComponentRelease deployed to this environment,
+	// together with a changelog of what differs from the previously deployed release. It is updated
+	// once the resources of the newly bound release are ready.
+	// +optional
+	Promotion *ReleaseBindingPromotion `json:"promotion,omitempty"`
+
+	// Rollback records the automatic rollback of spec.releaseName. While it is set, the
+	// controller deploys Rollback.RolledBackTo instead of Rollback.FailedRelease; it is
+	// cleared when spec.releaseName changes.
+	// +optional
+	Rollback *ReleaseBindingRollback `json:"rollback,omitempty"`
+
+	// Rollout reports the progress of spec.rolloutStrategy.
+	// +optional
+	Rollout *ReleaseBindingRollout `json:"rollout,omitempty"`
+
+	// Diagnosis explains why the workload of the binding fails, when its pods hit a known
+	// failure mode such as an image pull error, a crash loop or an out-of-memory kill.
+	// +optional
+	Diagnosis *WorkloadDiagnosis `json:"diagnosis,omitempty"`
+
+	// Hooks reports the lifecycle hooks of the component type run for the current rollout.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Hooks []LifecycleHookStatus `json:"hooks,omitempty"`
+
+	// DeploymentHistory records the most recent deployments to this environment, oldest first.
+	// It is bounded and is used to derive delivery metrics such as deployment frequency,
+	// lead time, change failure rate and time to restore.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	DeploymentHistory []DeploymentRecord `json:"deploymentHistory,omitempty"`
+
+	// ConditionHistory records the most recent changes of the Ready condition, oldest first.
+	// Unlike Conditions, which only hold the latest state, it shows when the binding last
+	// became unhealthy and why.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+}
+
+// LifecycleHookState is the state of a lifecycle hook Job.
+// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+type LifecycleHookState string
+
+const (
+	// LifecycleHookStatePending means the hook Job has not been applied yet.
+	LifecycleHookStatePending LifecycleHookState = "Pending"
+	// LifecycleHookStateRunning means the hook Job is running.
+	LifecycleHookStateRunning LifecycleHookState = "Running"
+	// LifecycleHookStateSucceeded means the hook Job completed.
+	LifecycleHookStateSucceeded LifecycleHookState = "Succeeded"
+	// LifecycleHookStateFailed means the hook Job failed or exceeded its timeout.
+	LifecycleHookStateFailed LifecycleHookState = "Failed"
+)
+
+// LifecycleHookStatus reports a lifecycle hook run for the current rollout.
+type LifecycleHookStatus struct {
+	// Name is the name of the hook in the component type.
+	Name string `json:"name"`
+
+	// Phase is when the hook runs.
+	Phase LifecycleHookPhase `json:"phase"`
+
+	// JobName is the name of the Job run for the hook in the data plane.
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+
+	// State is the state of the hook Job.
+	State LifecycleHookState `json:"state"`
+
+	// Message explains a failed hook, e.g. the reason the Job failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DeploymentRecord captures one deployment of a ComponentRelease to an environment.
+type DeploymentRecord struct {
+	// Release is the name of the deployed ComponentRelease.
+	// +kubebuilder:validation:MinLength=1
+	Release string `json:"release"`
+
+	// ReleaseCreatedAt is the creation time of the ComponentRelease, used to compute lead time.
+	ReleaseCreatedAt metav1.Time `json:"releaseCreatedAt"`
+
+	// DeployedAt is when the release was bound to the environment.
+	DeployedAt metav1.Time `json:"deployedAt"`
+
+	// ReadyAt is when the ReleaseBinding first became Ready with this release.
+	// +optional
+	ReadyAt *metav1.Time `json:"readyAt,omitempty"`
+
+	// FailedAt is when the deployment first failed, either because its resources failed
+	// or because it was rolled back to an older release.
+	// +optional
+	FailedAt *metav1.Time `json:"failedAt,omitempty"`
+
+	// RolledBack is true when this deployment was replaced by an older ComponentRelease.
+	// +optional
+	RolledBack bool `json:"rolledBack,omitempty"`
+
+	// RestoredAt is when the ReleaseBinding became Ready again after FailedAt.
+	// +optional
+	RestoredAt *metav1.Time `json:"restoredAt,omitempty"`
+}
+
+// ReleaseBindingRollback records an automatic rollback after a failed health check.
+type ReleaseBindingRollback struct {
+	// FailedRelease is the ComponentRelease that failed its health check.
+	// +kubebuilder:validation:MinLength=1
+	FailedRelease string `json:"failedRelease"`
+
+	// RolledBackTo is the last healthy ComponentRelease that is deployed instead.
+	// +kubebuilder:validation:MinLength=1
+	RolledBackTo string `json:"rolledBackTo"`
+
+	// Reason describes why the health check failed.
+	Reason string `json:"reason"`
+
+	// RolledBackAt is when the rollback was decided.
+	RolledBackAt metav1.Time `json:"rolledBackAt"`
+}
+
+// RolloutPhase is the phase of a rollout.
+// +kubebuilder:validation:Enum=Progressing;Paused;Promoting;Completed
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing means the new release runs next to the stable one and the
+	// rollout moves on by itself.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePaused means the rollout waits to be promoted.
+	RolloutPhasePaused RolloutPhase = "Paused"
+	// RolloutPhasePromoting means the new release receives all traffic while it replaces
+	// the stable one.
+	RolloutPhasePromoting RolloutPhase = "Promoting"
+	// RolloutPhaseCompleted means the stable release serves all traffic.
+	RolloutPhaseCompleted RolloutPhase = "Completed"
+)
+
+// ReleaseBindingRollout reports the progress of a rollout strategy.
+type ReleaseBindingRollout struct {
+	// StableRelease is the ComponentRelease that serves the traffic not sent to the candidate.
+	// +optional
+	StableRelease string `json:"stableRelease,omitempty"`
+
+	// CandidateRelease is the ComponentRelease being rolled out. Empty when no rollout is
+	// in progress.
+	// +optional
+	CandidateRelease string `json:"candidateRelease,omitempty"`
+
+	// Phase is the phase of the rollout.
+	Phase RolloutPhase `json:"phase"`
+
+	// Step is the index of the current step of a Canary rollout.
+	// +optional
+	Step int32 `json:"step,omitempty"`
+
+	// CandidateWeight is the percentage of the traffic sent to the candidate release.
+	// +optional
+	CandidateWeight int32 `json:"candidateWeight,omitempty"`
+
+	// StepStartedAt is when the candidate release became ready at the current step.
+	// +optional
+	StepStartedAt *metav1.Time `json:"stepStartedAt,omitempty"`
+
+	// PreviewHostnames are the hostnames that route to the candidate release of a BlueGreen
+	// rollout.
+	// +optional
+	PreviewHostnames []string `json:"previewHostnames,omitempty"`
+}
+
+// ReleaseBindingPromotion records a change of the ComponentRelease bound to an environment.
+type ReleaseBindingPromotion struct {
+	// FromRelease is the previously bound ComponentRelease. Empty for the initial deployment.
+	// +optional
+	FromRelease string `json:"fromRelease,omitempty"`
+
+	// ToRelease is the ComponentRelease that was bound by this promotion.
+	// +kubebuilder:validation:MinLength=1
+	ToRelease string `json:"toRelease"`
+
+	// PromotedAt is when the controller observed the new release as deployed.
+	PromotedAt metav1.Time `json:"promotedAt"`
+
+	// Changelog describes what changed between FromRelease and ToRelease.
+	// +optional
+	Changelog ReleaseChangelog `json:"changelog,omitempty"`
+}
+
+// ReleaseChangeType is the kind of change recorded in a ReleaseChangelog entry.
+// +kubebuilder:validation:Enum=Added;Removed;Modified
+type ReleaseChangeType string
+
+const (
+	ReleaseChangeAdded    ReleaseChangeType = "Added"
+	ReleaseChangeRemoved  ReleaseChangeType = "Removed"
+	ReleaseChangeModified ReleaseChangeType = "Modified"
+)
+
+// ReleaseChangelog summarizes the differences between two ComponentReleases.
+type ReleaseChangelog struct {
+	// FromImage is the container image of the previously bound release.
+	// +optional
+	FromImage string `json:"fromImage,omitempty"`
+
+	// ToImage is the container image of the newly bound release.
+	// +optional
+	ToImage string `json:"toImage,omitempty"`
+
+	// FromCommit is the source commit the previously bound release was built from.
+	// +optional
+	FromCommit string `json:"fromCommit,omitempty"`
+
+	// ToCommit is the source commit the newly bound release was built from. Together with
+	// FromCommit it gives the range of commits the promotion deploys.
+	// +optional
+	ToCommit string `json:"toCommit,omitempty"`
+
+	// Changes lists the component type, parameter, trait and environment variable changes.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	Changes []ReleaseChange `json:"changes,omitempty"`
+
+	// Truncated is true when more changes were found than are recorded in Changes.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ReleaseChange is a single entry in a ReleaseChangelog.
+type ReleaseChange struct {
+	// Path identifies the changed value, e.g. "parameters.replicas",
+	// "traits.ingress.parameters.host" or "workload.container.env.LOG_LEVEL".
+	Path string `json:"path"`
+
+	// Type is the kind of change.
+	Type ReleaseChangeType `json:"type"`
+
+	// From is the previous value rendered as JSON. Empty for added values.
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// To is the new value rendered as JSON. Empty for removed values.
+	// +optional
+	To string `json:"to,omitempty"`
 }
 
 // +kubebuilder:object:root=true
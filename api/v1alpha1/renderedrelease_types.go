@@ -16,7 +16,7 @@ type RenderedReleaseSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.owner is immutable"
+	// +kubebuilder:validation:XValidation:rule="has(self.componentName) && has(oldSelf.componentName) ? self.componentName == oldSelf.componentName : self == oldSelf",message="spec.owner is immutable except for the projectName of a component release"
 	Owner RenderedReleaseOwner `json:"owner"`
 	// +kubebuilder:validation:MinLength=1
 	EnvironmentName string `json:"environmentName"`
@@ -46,8 +46,31 @@ type RenderedReleaseSpec struct {
 	// +kubebuilder:validation:Enum=dataplane;observabilityplane
 	// +kubebuilder:default=dataplane
 	TargetPlane string `json:"targetPlane,omitempty"`
+
+	// DriftPolicy selects how changes made to the applied resources in the target plane outside
+	// of OpenChoreo are handled. The resources are scanned for drift on the reconciles of the
+	// release, at most once per drift scan interval of the controller.
+	// Defaults to Revert if not specified.
+	// +kubebuilder:default=Revert
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
+// DriftPolicy selects how a RenderedRelease handles drift of its resources in the target plane.
+// +kubebuilder:validation:Enum=Ignore;Notify;Revert
+type DriftPolicy string
+
+const (
+	// DriftPolicyIgnore does not scan the resources for drift.
+	DriftPolicyIgnore DriftPolicy = "Ignore"
+	// DriftPolicyNotify reports drifted resources in the DriftDetected condition and leaves them
+	// as they are until the rendered resources change.
+	DriftPolicyNotify DriftPolicy = "Notify"
+	// DriftPolicyRevert reports drifted resources in the DriftDetected condition and re-applies
+	// the rendered resources.
+	DriftPolicyRevert DriftPolicy = "Revert"
+)
+
 // RenderedReleaseStatus defines the observed state of RenderedRelease.
 type RenderedReleaseStatus struct {
 	// Resources contain the list of resources that have been successfully applied to the data plane
@@ -57,6 +80,21 @@ type RenderedReleaseStatus struct {
 	// Conditions represent the latest available observations of the RenderedRelease's current state.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ConditionHistory records the most recent changes of the conditions, oldest first.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+
+	// AppliedDigest is the digest of the resources last applied to the target plane. Resources
+	// are only scanned for drift while the rendered resources match it.
+	// +optional
+	AppliedDigest string `json:"appliedDigest,omitempty"`
+
+	// LastDriftScanTime is when the resources were last scanned for drift, or applied after
+	// they changed.
+	// +optional
+	LastDriftScanTime *metav1.Time `json:"lastDriftScanTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -115,6 +153,60 @@ type RenderedManifest struct {
 	Object *runtime.RawExtension `json:"object"`
 }
 
+// WorkloadFailureReason classifies a failure of the pods of a workload.
+// +kubebuilder:validation:Enum=ImagePullFailed;CrashLoopBackOff;OOMKilled
+type WorkloadFailureReason string
+
+const (
+	// WorkloadFailureImagePullFailed means a container image cannot be pulled.
+	WorkloadFailureImagePullFailed WorkloadFailureReason = "ImagePullFailed"
+	// WorkloadFailureCrashLoopBackOff means a container keeps exiting and is restarted with back-off.
+	WorkloadFailureCrashLoopBackOff WorkloadFailureReason = "CrashLoopBackOff"
+	// WorkloadFailureOOMKilled means a container was killed for exceeding its memory limit.
+	WorkloadFailureOOMKilled WorkloadFailureReason = "OOMKilled"
+)
+
+// WorkloadDiagnosis is a human-readable diagnosis of a failing workload, derived from the
+// statuses of its pods and containers in the data plane.
+type WorkloadDiagnosis struct {
+	// Reason classifies the failure.
+	Reason WorkloadFailureReason `json:"reason"`
+
+	// Message describes the failure, e.g. the registry error or the last termination message.
+	Message string `json:"message"`
+
+	// Pod is the name of the pod the diagnosis was derived from.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// Container is the name of the failing container.
+	// +optional
+	Container string `json:"container,omitempty"`
+}
+
+// FieldManagerConflict records fields of a rendered resource that another field manager had
+// changed in the data plane and that were taken back when the resource was last applied.
+type FieldManagerConflict struct {
+	// Manager is the field manager that owned the fields, e.g. "argocd-controller".
+	Manager string `json:"manager"`
+
+	// Fields are the paths of the conflicting fields, e.g. ".spec.replicas".
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+}
+
+// ResourceDrift records how a resource in the target plane differs from the rendered resource.
+type ResourceDrift struct {
+	// Missing is true when the resource was deleted from the target plane.
+	// +optional
+	Missing bool `json:"missing,omitempty"`
+
+	// Fields are the paths of the fields whose live values differ from the rendered values,
+	// e.g. ".spec.replicas". At most 10 fields are listed.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+}
+
 // RenderedManifestStatus tracks a resource that was applied to the data plane.
 type RenderedManifestStatus struct {
 	// ID corresponds to the resource ID in spec.resources
@@ -153,6 +245,20 @@ type RenderedManifestStatus struct {
 	// +optional
 	HealthStatus HealthStatus `json:"healthStatus,omitempty"`
 
+	// Diagnosis explains why the pods of the resource fail, for workloads that are not healthy.
+	// +optional
+	Diagnosis *WorkloadDiagnosis `json:"diagnosis,omitempty"`
+
+	// FieldManagerConflicts lists the other field managers that changed fields of the resource
+	// managed by OpenChoreo, as found when the resource was last applied.
+	// +optional
+	FieldManagerConflicts []FieldManagerConflict `json:"fieldManagerConflicts,omitempty"`
+
+	// Drift describes how the resource in the target plane differs from the rendered resource,
+	// as found by the last drift scan.
+	// +optional
+	Drift *ResourceDrift `json:"drift,omitempty"`
+
 	// LastObservedTime stores the last time the status was observed
 	// +optional
 	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
@@ -0,0 +1,42 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SchedulingPolicy controls where the pods of deployed components are scheduled.
+// Policies can be set on a DataPlane or ClusterDataPlane, an Environment, and a ReleaseBinding,
+// and are merged in that order before being injected into every rendered pod spec:
+// nodeSelector entries are merged key by key, tolerations are accumulated, and a topology
+// spread constraint replaces any earlier constraint with the same topologyKey.
+type SchedulingPolicy struct {
+	// NodeSelector restricts pods to nodes carrying all of the given labels,
+	// for example a dedicated node pool for production.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allow pods to be scheduled onto tainted nodes, such as spot instances.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// TopologySpreadConstraints spread pods across topology domains such as zones.
+	// When labelSelector is omitted it defaults to the component's pod selector labels.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// NodeLabel is a node label key discovered on a data plane cluster together with the
+// values observed for it.
+type NodeLabel struct {
+	// Key is the label key.
+	Key string `json:"key"`
+
+	// Values are the distinct values observed for the key, sorted. Empty when the key has
+	// too many distinct values to track (for example kubernetes.io/hostname), in which
+	// case any value is accepted.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
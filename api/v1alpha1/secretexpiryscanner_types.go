@@ -0,0 +1,164 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretExpirySeverity classifies how close a certificate is to its expiry.
+// +kubebuilder:validation:Enum=Warning;Critical;Expired
+type SecretExpirySeverity string
+
+const (
+	// SecretExpirySeverityWarning indicates the certificate expires within the warning threshold.
+	SecretExpirySeverityWarning SecretExpirySeverity = "Warning"
+	// SecretExpirySeverityCritical indicates the certificate expires within the critical threshold.
+	SecretExpirySeverityCritical SecretExpirySeverity = "Critical"
+	// SecretExpirySeverityExpired indicates the certificate has already expired.
+	SecretExpirySeverityExpired SecretExpirySeverity = "Expired"
+)
+
+// SecretExpiryAlerting defines the alert rules provisioned for the scanner findings.
+type SecretExpiryAlerting struct {
+	// Labels are added to the PrometheusRule so that the Prometheus rule selector picks it up.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// SecretExpiryScannerSpec defines the desired state of SecretExpiryScanner.
+// +kubebuilder:validation:XValidation:rule="!has(self.criticalBefore) || !has(self.warningBefore) || duration(self.criticalBefore) <= duration(self.warningBefore)",message="criticalBefore must not be longer than warningBefore"
+type SecretExpiryScannerSpec struct {
+	// Schedule is a five-field cron expression, evaluated in UTC, that decides when the
+	// planes are scanned.
+	// +optional
+	// +kubebuilder:default="0 */6 * * *"
+	// +kubebuilder:validation:MinLength=9
+	Schedule string `json:"schedule,omitempty"`
+
+	// Planes are the data and workflow planes whose TLS secrets are scanned.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	Planes []TargetPlaneRef `json:"planes"`
+
+	// Namespaces limits the scan to these namespaces of the plane clusters. All namespaces
+	// are scanned when empty.
+	// +optional
+	// +listType=set
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// WarningBefore reports certificates that expire within this duration as Warning.
+	// +optional
+	// +kubebuilder:default="720h"
+	WarningBefore metav1.Duration `json:"warningBefore,omitempty"`
+
+	// CriticalBefore reports certificates that expire within this duration as Critical.
+	// +optional
+	// +kubebuilder:default="168h"
+	CriticalBefore metav1.Duration `json:"criticalBefore,omitempty"`
+
+	// Alerting provisions Prometheus alert rules on the expiry metrics of this scanner.
+	// When unset, findings are only recorded in status and exported as metrics.
+	// +optional
+	Alerting *SecretExpiryAlerting `json:"alerting,omitempty"`
+}
+
+// SecretExpiryFinding is a certificate that expires within the warning threshold.
+type SecretExpiryFinding struct {
+	// Plane is the plane that holds the secret.
+	Plane TargetPlaneRef `json:"plane"`
+
+	// Namespace is the namespace of the secret in the plane cluster.
+	Namespace string `json:"namespace"`
+
+	// SecretName is the name of the secret in the plane cluster.
+	SecretName string `json:"secretName"`
+
+	// SecretReference is the SecretReference the secret is synced from, if any.
+	// +optional
+	SecretReference string `json:"secretReference,omitempty"`
+
+	// Subject is the subject common name of the certificate.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// NotAfter is when the certificate expires.
+	NotAfter metav1.Time `json:"notAfter"`
+
+	// Severity classifies how close the certificate is to its expiry.
+	Severity SecretExpirySeverity `json:"severity"`
+}
+
+// SecretExpiryScannerStatus defines the observed state of SecretExpiryScanner.
+type SecretExpiryScannerStatus struct {
+	// ObservedGeneration is the generation last scanned by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastScanTime is when the planes were last scanned.
+	// +optional
+	LastScanTime *metav1.Time `json:"lastScanTime,omitempty"`
+
+	// NextScanTime is when the planes are scanned next.
+	// +optional
+	NextScanTime *metav1.Time `json:"nextScanTime,omitempty"`
+
+	// ScannedSecrets is the number of TLS secrets inspected in the last scan.
+	// +optional
+	ScannedSecrets int32 `json:"scannedSecrets,omitempty"`
+
+	// Findings are the certificates that expire within the warning threshold, soonest
+	// first. At most 100 findings are recorded; the metrics cover all of them.
+	// +optional
+	Findings []SecretExpiryFinding `json:"findings,omitempty"`
+
+	// Conditions represent the latest available observations of the scanner's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=ses
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Last Scan",type="date",JSONPath=`.status.lastScanTime`
+// +kubebuilder:printcolumn:name="Expiring",type=string,JSONPath=`.status.conditions[?(@.type=="ExpiringSecrets")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// SecretExpiryScanner is the Schema for the secretexpiryscanners API.
+// It scans the TLS secrets of data and workflow planes on a cron schedule, including
+// those synced from SecretReferences, and reports certificates that are about to expire
+// in status, as metrics on the controller manager and optionally through alert rules.
+type SecretExpiryScanner struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretExpiryScannerSpec   `json:"spec,omitempty"`
+	Status SecretExpiryScannerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretExpiryScannerList contains a list of SecretExpiryScanner.
+type SecretExpiryScannerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretExpiryScanner `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (in *SecretExpiryScanner) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (in *SecretExpiryScanner) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&SecretExpiryScanner{}, &SecretExpiryScannerList{})
+}
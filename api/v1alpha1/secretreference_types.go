@@ -72,7 +72,77 @@ type TargetPlaneRef struct {
 	Name string `json:"name"`
 }
 
+// VaultKVVersion is the version of a HashiCorp Vault KV secrets engine.
+// +kubebuilder:validation:Enum=v1;v2
+type VaultKVVersion string
+
+const (
+	VaultKVVersion1 VaultKVVersion = "v1"
+	VaultKVVersion2 VaultKVVersion = "v2"
+)
+
+// VaultDelivery selects how the values of a Vault-backed SecretReference reach the data plane.
+// +kubebuilder:validation:Enum=ExternalSecrets;CSI
+type VaultDelivery string
+
+const (
+	// VaultDeliveryExternalSecrets syncs the values with External Secrets Operator through a
+	// namespaced SecretStore generated for the component.
+	VaultDeliveryExternalSecrets VaultDelivery = "ExternalSecrets"
+	// VaultDeliveryCSI mounts the values with the Secrets Store CSI driver through a generated
+	// SecretProviderClass and syncs them into the Kubernetes Secret the workload consumes.
+	VaultDeliveryCSI VaultDelivery = "CSI"
+)
+
+// VaultAuth configures the Kubernetes auth method used to log in to Vault.
+type VaultAuth struct {
+	// Role is the Vault role bound to the service account of the workload
+	// +kubebuilder:validation:MinLength=1
+	Role string `json:"role"`
+
+	// MountPath is the path the Kubernetes auth method is mounted at
+	// +kubebuilder:default="kubernetes"
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// ServiceAccountName is the service account in the data plane namespace whose token is
+	// exchanged for a Vault token. Defaults to the service account of the secret syncer.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// VaultSource points a SecretReference directly at a HashiCorp Vault KV secrets engine.
+// The remoteRef.key of each data entry is the secret path within the engine and
+// remoteRef.property the field of the secret.
+type VaultSource struct {
+	// Server is the address of the Vault server (e.g., "https://vault.example.com:8200")
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Server string `json:"server"`
+
+	// Path is the mount path of the KV secrets engine (e.g., "secret")
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+
+	// Version of the KV secrets engine
+	// +kubebuilder:default="v2"
+	// +optional
+	Version VaultKVVersion `json:"version,omitempty"`
+
+	// Namespace is the Vault Enterprise namespace of the secrets engine
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Auth configures how the data plane authenticates to Vault
+	Auth VaultAuth `json:"auth"`
+
+	// Delivery selects how the values reach the data plane
+	// +kubebuilder:default="ExternalSecrets"
+	// +optional
+	Delivery VaultDelivery `json:"delivery,omitempty"`
+}
+
 // SecretReferenceSpec defines the desired state of SecretReference.
+// +kubebuilder:validation:XValidation:rule="!(has(self.vault) && has(self.targetPlane))",message="vault and targetPlane are mutually exclusive"
 type SecretReferenceSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
@@ -84,6 +154,11 @@ type SecretReferenceSpec struct {
 	// +optional
 	TargetPlane *TargetPlaneRef `json:"targetPlane,omitempty"`
 
+	// Vault reads the secret values directly from a HashiCorp Vault KV secrets engine
+	// instead of the external secret store of the data plane.
+	// +optional
+	Vault *VaultSource `json:"vault,omitempty"`
+
 	// Template defines the structure of the resulting Kubernetes Secret
 	Template SecretTemplate `json:"template"`
 
@@ -91,7 +166,8 @@ type SecretReferenceSpec struct {
 	// +kubebuilder:validation:MinItems=1
 	Data []SecretDataSource `json:"data"`
 
-	// RefreshInterval specifies how often to reconcile/refresh the secret
+	// RefreshInterval specifies how often to reconcile/refresh the secret.
+	// For Vault-backed references delivered through ExternalSecrets it is the rotation interval.
 	// +optional
 	// +kubebuilder:default="1h"
 	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
@@ -139,6 +215,16 @@ type SecretReference struct {
 	Status SecretReferenceStatus `json:"status,omitempty"`
 }
 
+// GetConditions returns the conditions from the status.
+func (in *SecretReference) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (in *SecretReference) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:root=true
 
 // SecretReferenceList contains a list of SecretReference.
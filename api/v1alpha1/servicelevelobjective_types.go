@@ -0,0 +1,203 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceLevelObjectiveOwner identifies the component the objective applies to.
+type ServiceLevelObjectiveOwner struct {
+	// ProjectName is the name of the project that owns the component.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the component.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ComponentName string `json:"componentName"`
+}
+
+// AvailabilityObjective is met when the share of successful requests is at least Target.
+type AvailabilityObjective struct {
+	// Target is the percentage of requests that must succeed (e.g. "99.9").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(100(\.0+)?|[0-9]{1,2}(\.[0-9]+)?)$`
+	Target string `json:"target"`
+}
+
+// LatencyPercentile is a latency percentile reported by the gateway metrics.
+// +kubebuilder:validation:Enum=p50;p90;p99
+type LatencyPercentile string
+
+const (
+	// LatencyPercentileP50 is the median request latency.
+	LatencyPercentileP50 LatencyPercentile = "p50"
+	// LatencyPercentileP90 is the 90th percentile request latency.
+	LatencyPercentileP90 LatencyPercentile = "p90"
+	// LatencyPercentileP99 is the 99th percentile request latency.
+	LatencyPercentileP99 LatencyPercentile = "p99"
+)
+
+// LatencyObjective is met when the observed latency percentile stays at or below Threshold.
+type LatencyObjective struct {
+	// Percentile is the latency percentile compared against Threshold.
+	// +optional
+	// +kubebuilder:default=p99
+	Percentile LatencyPercentile `json:"percentile,omitempty"`
+
+	// Threshold is the maximum allowed latency for the percentile.
+	// +kubebuilder:validation:Required
+	Threshold metav1.Duration `json:"threshold"`
+}
+
+// SLOPromotionPolicy controls whether the objective affects promotions.
+// +kubebuilder:validation:Enum=Allow;BlockWhenExhausted
+type SLOPromotionPolicy string
+
+const (
+	// SLOPromotionPolicyAllow never blocks promotions.
+	SLOPromotionPolicyAllow SLOPromotionPolicy = "Allow"
+	// SLOPromotionPolicyBlockWhenExhausted blocks promotions of the component into the
+	// environment while its error budget is exhausted.
+	SLOPromotionPolicyBlockWhenExhausted SLOPromotionPolicy = "BlockWhenExhausted"
+)
+
+// ServiceLevelObjectiveSpec defines the desired state of ServiceLevelObjective.
+// +kubebuilder:validation:XValidation:rule="has(self.availability) || has(self.latency)",message="at least one of availability or latency must be set"
+type ServiceLevelObjectiveSpec struct {
+	// Owner identifies the component the objective applies to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self.componentName == oldSelf.componentName",message="spec.owner.componentName is immutable"
+	Owner ServiceLevelObjectiveOwner `json:"owner"`
+
+	// Environment is the environment whose traffic is evaluated.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Environment string `json:"environment"`
+
+	// Endpoint is the name of the component endpoint whose gateway traffic is evaluated.
+	// When empty, all gateway traffic to the component is evaluated.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Availability is the availability objective. The error budget is derived from it.
+	// +optional
+	Availability *AvailabilityObjective `json:"availability,omitempty"`
+
+	// Latency is the latency objective.
+	// +optional
+	Latency *LatencyObjective `json:"latency,omitempty"`
+
+	// Window is the rolling compliance window.
+	// +optional
+	// +kubebuilder:default="720h"
+	Window metav1.Duration `json:"window,omitempty"`
+
+	// PromotionPolicy controls whether an exhausted error budget blocks promotions.
+	// +optional
+	// +kubebuilder:default=Allow
+	PromotionPolicy SLOPromotionPolicy `json:"promotionPolicy,omitempty"`
+}
+
+// ErrorBudgetStatus is the most recent error budget evaluation.
+// Ratios are decimal strings because CRDs do not carry floating point values.
+type ErrorBudgetStatus struct {
+	// TotalRequests is the number of requests observed in the window.
+	TotalRequests int64 `json:"totalRequests"`
+
+	// FailedRequests is the number of unsuccessful requests observed in the window.
+	FailedRequests int64 `json:"failedRequests"`
+
+	// Availability is the observed percentage of successful requests in the window.
+	// +optional
+	Availability string `json:"availability,omitempty"`
+
+	// Remaining is the fraction of the error budget left in the window (1 is untouched,
+	// 0 or less is exhausted).
+	// +optional
+	Remaining string `json:"remaining,omitempty"`
+
+	// BurnRate is how fast the budget is being consumed over the last hour, relative to
+	// the rate that would exactly exhaust it at the end of the window.
+	// +optional
+	BurnRate string `json:"burnRate,omitempty"`
+
+	// Exhausted is true when no error budget is left.
+	Exhausted bool `json:"exhausted"`
+
+	// ObservedLatency is the observed value of the latency percentile in the window.
+	// +optional
+	ObservedLatency *metav1.Duration `json:"observedLatency,omitempty"`
+
+	// LatencyMet reports whether the latency objective is met.
+	// +optional
+	LatencyMet *bool `json:"latencyMet,omitempty"`
+
+	// EvaluatedAt is when the observability plane evaluated the budget.
+	EvaluatedAt metav1.Time `json:"evaluatedAt"`
+}
+
+// ServiceLevelObjectiveStatus defines the observed state of ServiceLevelObjective.
+type ServiceLevelObjectiveStatus struct {
+	// ObservedGeneration is the generation last evaluated by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ErrorBudget is the most recent error budget evaluation.
+	// +optional
+	ErrorBudget *ErrorBudgetStatus `json:"errorBudget,omitempty"`
+
+	// Conditions represent the latest available observations of the objective's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=slo;slos
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Environment",type=string,JSONPath=`.spec.environment`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.availability.target`
+// +kubebuilder:printcolumn:name="Budget",type=string,JSONPath=`.status.errorBudget.remaining`
+// +kubebuilder:printcolumn:name="Exhausted",type=boolean,JSONPath=`.status.errorBudget.exhausted`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ServiceLevelObjective is the Schema for the servicelevelobjectives API.
+// It declares availability and latency targets for a component in an environment.
+// The observability plane evaluates them from gateway metrics and the result is
+// recorded as the objective's error budget.
+type ServiceLevelObjective struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceLevelObjectiveSpec   `json:"spec,omitempty"`
+	Status ServiceLevelObjectiveStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceLevelObjectiveList contains a list of ServiceLevelObjective.
+type ServiceLevelObjectiveList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceLevelObjective `json:"items"`
+}
+
+// GetConditions returns the conditions from the status.
+func (in *ServiceLevelObjective) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status.
+func (in *ServiceLevelObjective) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceLevelObjective{}, &ServiceLevelObjectiveList{})
+}
@@ -3,7 +3,11 @@
 
 package v1alpha1
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // This file contains common types shared across multiple OpenChoreo CRDs
 
@@ -153,6 +157,19 @@ type WorkflowPlaneRef struct {
 	Name string `json:"name"`
 }
 
+// WorkflowPlaneSelector selects the workflow planes a workflow's runs can be scheduled on.
+type WorkflowPlaneSelector struct {
+	// MatchLabels selects the workflow planes that have all of the given labels.
+	// An empty selector matches every workflow plane the workflow can use.
+	// +optional
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+
+	// StickyPerComponent schedules the runs of a component on the plane of its previous run
+	// while that plane still matches, so builds reuse the plane's caches.
+	// +optional
+	StickyPerComponent bool `json:"stickyPerComponent,omitempty"`
+}
+
 // ClusterWorkflowPlaneRefKind defines the kind for cluster-scoped workflow plane references.
 // Only ClusterWorkflowPlane is allowed since cluster-scoped resources can only reference
 // other cluster-scoped resources.
@@ -351,7 +368,7 @@ type ComponentTypeRef struct {
 
 	// Name is the component type reference in format: {workloadType}/{componentTypeName}
 	// +required
-	// +kubebuilder:validation:Pattern=`^(deployment|statefulset|cronjob|job|proxy)/[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:Pattern=`^(deployment|statefulset|cronjob|job|proxy|library)/[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
 	Name string `json:"name"`
 }
 
@@ -531,3 +548,26 @@ const (
 	// ResourceRetainPolicyRetain keeps the underlying provisioned data after deletion.
 	ResourceRetainPolicyRetain ResourceRetainPolicy = "Retain"
 )
+
+// ConditionTransition records one change of a status condition. Resources keep a bounded
+// history of transitions so that past failures remain visible after they recover.
+type ConditionTransition struct {
+	// Type is the type of the condition that changed, such as Ready.
+	// +kubebuilder:validation:MinLength=1
+	Type string `json:"type"`
+
+	// Status is the status the condition changed to.
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status metav1.ConditionStatus `json:"status"`
+
+	// Reason is the machine-readable reason of the condition after the change.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the human-readable message of the condition after the change.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// TransitionTime is when the change was observed.
+	TransitionTime metav1.Time `json:"transitionTime"`
+}
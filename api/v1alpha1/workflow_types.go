@@ -23,6 +23,12 @@ type WorkflowSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.workflowPlaneRef is immutable"
 	WorkflowPlaneRef *WorkflowPlaneRef `json:"workflowPlaneRef,omitempty"`
 
+	// WorkflowPlaneSelector schedules each run on one of the workflow planes whose labels
+	// match, for example by architecture or region. When set, it takes precedence over
+	// workflowPlaneRef and the chosen plane is recorded in the run's status.
+	// +optional
+	WorkflowPlaneSelector *WorkflowPlaneSelector `json:"workflowPlaneSelector,omitempty"`
+
 	// Parameters defines the developer-facing parameters that can be configured
 	// when creating a WorkflowRun instance.
 	// +optional
@@ -68,6 +74,206 @@ type WorkflowSpec struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
 	TTLAfterCompletion string `json:"ttlAfterCompletion,omitempty"`
+
+	// Tests declares the step of the run template that runs the component's tests.
+	// The JUnit report of that step is collected into the WorkflowRun status.
+	// +optional
+	Tests *WorkflowTestSpec `json:"tests,omitempty"`
+
+	// Analysis declares the step of the run template that runs static analysis.
+	// The SARIF report of that step is collected into the WorkflowRun status.
+	// +optional
+	Analysis *WorkflowAnalysisSpec `json:"analysis,omitempty"`
+
+	// SBOM declares the step of the run template that produces the software bill of materials
+	// of the built image. The SBOM of that step is collected into the WorkflowRun status.
+	// +optional
+	SBOM *WorkflowSBOMSpec `json:"sbom,omitempty"`
+
+	// Timeout is the maximum time a run may execute on the workflow plane, for example "1h" or
+	// "30m". Runs that exceed it are terminated and marked as timed out.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
+	Timeout string `json:"timeout,omitempty"`
+
+	// RetryPolicy resubmits runs that fail.
+	// +optional
+	RetryPolicy *WorkflowRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Credentials are short-lived credentials runs obtain from the control plane's credential
+	// broker instead of mounting static secrets.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Credentials []WorkflowCredential `json:"credentials,omitempty"`
+}
+
+// WorkflowTestFailurePolicy defines what happens when the tests of a workflow run fail.
+// +kubebuilder:validation:Enum=BlockRelease;Ignore
+type WorkflowTestFailurePolicy string
+
+const (
+	// WorkflowTestFailurePolicyBlockRelease prevents releases from being created from the
+	// component until a workflow run passes its tests.
+	WorkflowTestFailurePolicyBlockRelease WorkflowTestFailurePolicy = "BlockRelease"
+	// WorkflowTestFailurePolicyIgnore only reports failed tests.
+	WorkflowTestFailurePolicyIgnore WorkflowTestFailurePolicy = "Ignore"
+)
+
+// DefaultTestReportParameter is the output parameter read from the test step when
+// WorkflowTestSpec.ReportParameter is empty.
+const DefaultTestReportParameter = "junit-report"
+
+// WorkflowTestSpec declares how test results are collected from a workflow run.
+type WorkflowTestSpec struct {
+	// Step is the name of the workflow step that runs the tests.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Step string `json:"step"`
+
+	// ReportParameter is the output parameter of the test step that holds the JUnit XML report.
+	// Defaults to "junit-report".
+	// +optional
+	ReportParameter string `json:"reportParameter,omitempty"`
+
+	// FailurePolicy defines whether failed tests block release creation for the component.
+	// +optional
+	// +kubebuilder:default=BlockRelease
+	FailurePolicy WorkflowTestFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// GetReportParameter returns the output parameter holding the JUnit report.
+func (t *WorkflowTestSpec) GetReportParameter() string {
+	if t.ReportParameter == "" {
+		return DefaultTestReportParameter
+	}
+	return t.ReportParameter
+}
+
+// BlocksRelease reports whether failed tests block release creation.
+func (t *WorkflowTestSpec) BlocksRelease() bool {
+	return t.FailurePolicy != WorkflowTestFailurePolicyIgnore
+}
+
+// DefaultAnalysisReportParameter is the output parameter read from the analysis step when
+// WorkflowAnalysisSpec.ReportParameter is empty.
+const DefaultAnalysisReportParameter = "sarif-report"
+
+// WorkflowAnalysisSpec declares how static analysis findings are collected from a workflow run.
+// Any analyzer that emits SARIF 2.1.0 can be used, such as golangci-lint, semgrep or SonarQube.
+type WorkflowAnalysisSpec struct {
+	// Step is the name of the workflow step that runs the analysis.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Step string `json:"step"`
+
+	// ReportParameter is the output parameter of the analysis step that holds the SARIF report.
+	// Defaults to "sarif-report".
+	// +optional
+	ReportParameter string `json:"reportParameter,omitempty"`
+}
+
+// GetReportParameter returns the output parameter holding the SARIF report.
+func (a *WorkflowAnalysisSpec) GetReportParameter() string {
+	if a.ReportParameter == "" {
+		return DefaultAnalysisReportParameter
+	}
+	return a.ReportParameter
+}
+
+// DefaultSBOMReportParameter is the output parameter read from the SBOM step when
+// WorkflowSBOMSpec.ReportParameter is empty.
+const DefaultSBOMReportParameter = "sbom"
+
+// WorkflowSBOMSpec declares how the software bill of materials of the built image is collected
+// from a workflow run. The step must emit a CycloneDX or SPDX JSON document, such as the SBOM
+// Cloud Native Buildpacks write with pack build --sbom-output-dir.
+type WorkflowSBOMSpec struct {
+	// Step is the name of the workflow step that produces the SBOM.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Step string `json:"step"`
+
+	// ReportParameter is the output parameter of the SBOM step that holds the SBOM document.
+	// Defaults to "sbom".
+	// +optional
+	ReportParameter string `json:"reportParameter,omitempty"`
+}
+
+// GetReportParameter returns the output parameter holding the SBOM document.
+func (s *WorkflowSBOMSpec) GetReportParameter() string {
+	if s.ReportParameter == "" {
+		return DefaultSBOMReportParameter
+	}
+	return s.ReportParameter
+}
+
+// WorkflowRetryOn selects the failures a retry policy retries.
+// +kubebuilder:validation:Enum=InfrastructureErrors;AllFailures
+type WorkflowRetryOn string
+
+const (
+	// WorkflowRetryOnInfrastructureErrors retries runs that failed because a step could not be
+	// executed, for example because its pod was evicted or deleted, but not runs whose steps failed.
+	WorkflowRetryOnInfrastructureErrors WorkflowRetryOn = "InfrastructureErrors"
+	// WorkflowRetryOnAllFailures retries all failed runs.
+	WorkflowRetryOnAllFailures WorkflowRetryOn = "AllFailures"
+)
+
+// WorkflowRetryPolicy declares how failed workflow runs are retried. A retried run is
+// resubmitted to the workflow plane from the start. Cancelled and timed out runs are not retried.
+type WorkflowRetryPolicy struct {
+	// Limit is the maximum number of times a run is retried.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	Limit int32 `json:"limit"`
+
+	// RetryOn selects the failures that are retried.
+	// +optional
+	// +kubebuilder:default=InfrastructureErrors
+	RetryOn WorkflowRetryOn `json:"retryOn,omitempty"`
+}
+
+// RetriesAllFailures reports whether runs are retried regardless of why they failed.
+func (p *WorkflowRetryPolicy) RetriesAllFailures() bool {
+	return p.RetryOn == WorkflowRetryOnAllFailures
+}
+
+// AnalysisSeverity is the severity of a static analysis finding.
+// +kubebuilder:validation:Enum=Critical;High;Medium;Low;Info
+type AnalysisSeverity string
+
+const (
+	AnalysisSeverityCritical AnalysisSeverity = "Critical"
+	AnalysisSeverityHigh     AnalysisSeverity = "High"
+	AnalysisSeverityMedium   AnalysisSeverity = "Medium"
+	AnalysisSeverityLow      AnalysisSeverity = "Low"
+	AnalysisSeverityInfo     AnalysisSeverity = "Info"
+)
+
+// AnalysisQualityGate sets the maximum number of static analysis findings of each severity
+// that the latest workflow run of a component may report before release creation is blocked.
+// Thresholds that are not set are not enforced.
+type AnalysisQualityGate struct {
+	// MaxCritical is the maximum number of critical findings.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxCritical *int32 `json:"maxCritical,omitempty"`
+
+	// MaxHigh is the maximum number of high severity findings.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxHigh *int32 `json:"maxHigh,omitempty"`
+
+	// MaxMedium is the maximum number of medium severity findings.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxMedium *int32 `json:"maxMedium,omitempty"`
+
+	// MaxLow is the maximum number of low severity findings.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxLow *int32 `json:"maxLow,omitempty"`
 }
 
 // WorkflowResource defines a template for generating Kubernetes resources
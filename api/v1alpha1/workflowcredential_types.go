@@ -0,0 +1,69 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkflowCredentialType is the kind of short-lived credential a workflow run obtains from the
+// control plane's credential broker.
+// +kubebuilder:validation:Enum=GitRead;RegistryPush
+type WorkflowCredentialType string
+
+const (
+	// WorkflowCredentialTypeGitRead is a token that can clone a single git repository.
+	WorkflowCredentialTypeGitRead WorkflowCredentialType = "GitRead"
+	// WorkflowCredentialTypeRegistryPush is a registry token that can push to a single image repository.
+	WorkflowCredentialTypeRegistryPush WorkflowCredentialType = "RegistryPush"
+)
+
+// WorkflowCredentialsSecretSuffix is appended to the workflow run name to form the name of the
+// secret on the workflow plane that holds the run's broker token.
+const WorkflowCredentialsSecretSuffix = "-credentials"
+
+// WorkflowCredential declares a short-lived credential that runs of a workflow exchange at the
+// control plane instead of mounting a static secret. Each run receives a broker token in the
+// secret <workflowRunName>-credentials on the workflow plane; a step of the run exchanges it for
+// the credential while the run is active.
+type WorkflowCredential struct {
+	// Name identifies the credential when the run exchanges its broker token.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Type is the kind of credential.
+	// +kubebuilder:validation:Required
+	Type WorkflowCredentialType `json:"type"`
+
+	// Repository scopes the credential: the git repository URL for GitRead, or the image
+	// repository (for example "registry.example.com/acme/api") for RegistryPush.
+	// It may contain CEL expressions, for example ${parameters.repository.url}. Parameters are
+	// read from the component of the run, never from the run itself, so that creating a run
+	// cannot widen the credential to another repository.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Repository string `json:"repository"`
+}
+
+// WorkflowRunCredentialsStatus records the broker token issued to a workflow run.
+type WorkflowRunCredentialsStatus struct {
+	// SecretName is the secret on the workflow plane that holds the broker token.
+	SecretName string `json:"secretName"`
+
+	// TokenHash is the hex-encoded SHA-256 hash of the broker token. The token itself is only
+	// stored on the workflow plane.
+	TokenHash string `json:"tokenHash"`
+
+	// ExpiresAt is when the broker token expires. Tokens are also rejected once the run completes.
+	ExpiresAt metav1.Time `json:"expiresAt"`
+
+	// Credentials are the credentials the run may exchange its token for, with their
+	// repositories resolved.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Credentials []WorkflowCredential `json:"credentials,omitempty"`
+}
@@ -4,6 +4,8 @@
 package v1alpha1
 
 import (
+	"slices"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,6 +41,132 @@ type WorkflowPlaneSpec struct {
 	// If not specified, defaults to an ObservabilityPlane named "default" in the same namespace.
 	// +optional
 	ObservabilityPlaneRef *ObservabilityPlaneRef `json:"observabilityPlaneRef,omitempty"`
+
+	// Scheduling configures the build queue of the workflow plane.
+	// When not specified, workflow runs start as soon as they are created.
+	// +optional
+	Scheduling *WorkflowSchedulingSpec `json:"scheduling,omitempty"`
+
+	// BuildNodes places the pods of workflow runs on dedicated build nodes and keeps standby
+	// build nodes warm during business hours. When not specified, build pods are scheduled
+	// like any other pod.
+	// +optional
+	BuildNodes *WorkflowBuildNodesSpec `json:"buildNodes,omitempty"`
+}
+
+// WorkflowSchedulingSpec configures how workflow runs are queued on a workflow plane.
+type WorkflowSchedulingSpec struct {
+	// MaxConcurrentRuns is the maximum number of workflow runs executing on the plane at the
+	// same time. Runs created beyond the limit wait in the plane's build queue.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrentRuns *int32 `json:"maxConcurrentRuns,omitempty"`
+
+	// MaxQueuedRuns is the maximum number of component workflow runs waiting in the plane's
+	// build queue. New component builds are rejected while the queue is full.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxQueuedRuns *int32 `json:"maxQueuedRuns,omitempty"`
+
+	// NamespaceWeights sets the fair-share weights of namespaces. When runs of several namespaces
+	// are queued, the plane's capacity is shared between them in proportion to their weights.
+	// Namespaces that are not listed have a weight of 1.
+	// +optional
+	// +listType=map
+	// +listMapKey=namespace
+	NamespaceWeights []NamespaceWeight `json:"namespaceWeights,omitempty"`
+
+	// PriorityClasses defines the priority classes workflow runs can request.
+	// Queued runs of a higher priority are scheduled ahead of runs of a lower priority.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	PriorityClasses []WorkflowPriorityClass `json:"priorityClasses,omitempty"`
+
+	// DefaultPriorityClass is the priority class of runs that do not request one.
+	// +optional
+	DefaultPriorityClass string `json:"defaultPriorityClass,omitempty"`
+
+	// PreferredNamespaces lists the namespaces whose runs prefer this plane when a workflow
+	// selects among several planes.
+	// +optional
+	// +listType=set
+	PreferredNamespaces []string `json:"preferredNamespaces,omitempty"`
+}
+
+// NamespaceWeight is the fair-share weight of a namespace on a workflow plane.
+type NamespaceWeight struct {
+	// Namespace is the name of the namespace.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Weight is the share of the plane's capacity the namespace receives relative to other namespaces.
+	// +kubebuilder:validation:Minimum=1
+	Weight int32 `json:"weight"`
+}
+
+// WorkflowPriorityClass is a named priority that workflow runs can request, for example
+// "release" for release builds and "branch" for branch builds.
+type WorkflowPriorityClass struct {
+	// Name is the name runs use to request the priority class.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+
+	// Priority orders queued runs. Runs with a higher value are scheduled first.
+	Priority int32 `json:"priority"`
+}
+
+// GetMaxConcurrentRuns returns the maximum number of concurrent runs, or 0 when runs are not limited.
+func (s *WorkflowSchedulingSpec) GetMaxConcurrentRuns() int32 {
+	if s == nil || s.MaxConcurrentRuns == nil {
+		return 0
+	}
+	return *s.MaxConcurrentRuns
+}
+
+// GetMaxQueuedRuns returns the maximum number of queued runs, or 0 when the queue is not limited.
+func (s *WorkflowSchedulingSpec) GetMaxQueuedRuns() int32 {
+	if s == nil || s.MaxQueuedRuns == nil {
+		return 0
+	}
+	return *s.MaxQueuedRuns
+}
+
+// IsPreferredBy reports whether the plane lists the namespace as preferring it.
+func (s *WorkflowSchedulingSpec) IsPreferredBy(namespace string) bool {
+	return s != nil && slices.Contains(s.PreferredNamespaces, namespace)
+}
+
+// NamespaceWeight returns the fair-share weight of a namespace.
+func (s *WorkflowSchedulingSpec) NamespaceWeight(namespace string) int32 {
+	if s != nil {
+		for _, w := range s.NamespaceWeights {
+			if w.Namespace == namespace && w.Weight > 0 {
+				return w.Weight
+			}
+		}
+	}
+	return 1
+}
+
+// PriorityClass returns the priority class a run requesting the given name belongs to.
+// Runs that do not request a priority class belong to the default priority class.
+// The second result is false when the class is not defined.
+func (s *WorkflowSchedulingSpec) PriorityClass(name string) (WorkflowPriorityClass, bool) {
+	if s == nil {
+		return WorkflowPriorityClass{Name: name}, name == ""
+	}
+	if name == "" {
+		name = s.DefaultPriorityClass
+	}
+	for _, pc := range s.PriorityClasses {
+		if pc.Name == name {
+			return pc, true
+		}
+	}
+	return WorkflowPriorityClass{Name: name}, name == ""
 }
 
 // WorkflowPlaneStatus defines the observed state of WorkflowPlane.
@@ -57,6 +185,10 @@ type WorkflowPlaneStatus struct {
 	// AgentConnection tracks the status of cluster agent connections to this workflow plane
 	// +optional
 	AgentConnection *AgentConnectionStatus `json:"agentConnection,omitempty"`
+
+	// StandbyBuildNodes reports the standby build nodes kept warm on the workflow plane.
+	// +optional
+	StandbyBuildNodes *StandbyBuildNodesStatus `json:"standbyBuildNodes,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -26,6 +26,30 @@ type WorkflowRunSpec struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
 	TTLAfterCompletion string `json:"ttlAfterCompletion,omitempty"`
+
+	// PriorityClassName requests a priority class defined by the scheduling configuration of
+	// the workflow plane. When empty, the plane's default priority class applies.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="priorityClassName is immutable"
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// Cancellation requests the run to be cancelled. A running run is aborted on the workflow
+	// plane and marked as cancelled. The request cannot be changed once it is made.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="cancellation is immutable"
+	Cancellation *WorkflowRunCancellation `json:"cancellation,omitempty"`
+}
+
+// WorkflowRunCancellation records who cancelled a workflow run and why.
+type WorkflowRunCancellation struct {
+	// RequestedBy identifies the user or service account that cancelled the run.
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// Reason explains why the run was cancelled.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Reason string `json:"reason,omitempty"`
 }
 
 // WorkflowRunConfig defines the workflow configuration for execution.
@@ -43,6 +67,14 @@ type WorkflowRunConfig struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
 	Name string `json:"name"`
 
+	// Version selects a published version of the workflow (for example "v2"). The run renders
+	// from the WorkflowVersion or ClusterWorkflowVersion snapshot instead of the live workflow.
+	// When empty, the run uses the current workflow template.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^v[0-9]+(\.[0-9]+){0,2}$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="version is immutable"
+	Version string `json:"version,omitempty"`
+
 	// Parameters contains the developer-provided values for the flexible parameter schema
 	// defined in the referenced Workflow CR.
 	//
@@ -141,6 +173,320 @@ type WorkflowRunStatus struct {
 	// This is used together with TTLAfterCompletion to determine when to delete the workflow run.
 	// +optional
 	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// ResolvedTemplate records which workflow template produced this run.
+	// It is set when the run resource is rendered and does not change afterwards.
+	// +optional
+	ResolvedTemplate *ResolvedWorkflowTemplate `json:"resolvedTemplate,omitempty"`
+
+	// TestResults summarizes the JUnit report of the test step declared by the workflow.
+	// It is set once the test step finishes.
+	// +optional
+	TestResults *WorkflowTestResults `json:"testResults,omitempty"`
+
+	// AnalysisResults summarizes the SARIF report of the analysis step declared by the workflow.
+	// It is set once the analysis step finishes.
+	// +optional
+	AnalysisResults *WorkflowAnalysisResults `json:"analysisResults,omitempty"`
+
+	// SBOM summarizes the software bill of materials of the SBOM step declared by the workflow.
+	// It is set once the SBOM step finishes.
+	// +optional
+	SBOM *WorkflowSBOM `json:"sbom,omitempty"`
+
+	// WorkflowPlane is the plane the run was scheduled on when its workflow selects planes
+	// by label. Once set, the run stays on this plane.
+	// +optional
+	WorkflowPlane *WorkflowPlaneRef `json:"workflowPlane,omitempty"`
+
+	// Queue reports the run's place in the build queue of its workflow plane. It is only set
+	// for runs on planes that limit the number of concurrent runs.
+	// +optional
+	Queue *WorkflowRunQueueStatus `json:"queue,omitempty"`
+
+	// Retries is the number of times the run was resubmitted by the workflow's retry policy.
+	// +optional
+	Retries int32 `json:"retries,omitempty"`
+
+	// Credentials records the broker token issued to the run for the short-lived credentials
+	// declared by its workflow.
+	// +optional
+	Credentials *WorkflowRunCredentialsStatus `json:"credentials,omitempty"`
+}
+
+// WorkflowRunQueueStatus describes the place of a workflow run in the build queue of a workflow plane.
+type WorkflowRunQueueStatus struct {
+	// WorkflowPlane identifies the plane the run is queued on, as <kind>/<name>.
+	WorkflowPlane string `json:"workflowPlane"`
+
+	// PriorityClass is the priority class the run was queued with.
+	// +optional
+	PriorityClass string `json:"priorityClass,omitempty"`
+
+	// Priority is the priority of the run's priority class.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Position is the 1-based position of the run in the queue. It is 0 once the run is admitted.
+	// +optional
+	Position int32 `json:"position,omitempty"`
+
+	// QueuedAt is when the run entered the queue.
+	QueuedAt metav1.Time `json:"queuedAt"`
+
+	// AdmittedAt is when the run left the queue and started executing.
+	// +optional
+	AdmittedAt *metav1.Time `json:"admittedAt,omitempty"`
+}
+
+// WorkflowAnalysisResults summarizes the static analysis findings reported by a workflow run.
+type WorkflowAnalysisResults struct {
+	// Step is the name of the workflow step the findings were collected from.
+	Step string `json:"step"`
+
+	// Tools lists the analyzers that contributed findings to the report.
+	// +optional
+	Tools []string `json:"tools,omitempty"`
+
+	// Critical is the number of critical findings.
+	Critical int32 `json:"critical"`
+
+	// High is the number of high severity findings.
+	High int32 `json:"high"`
+
+	// Medium is the number of medium severity findings.
+	Medium int32 `json:"medium"`
+
+	// Low is the number of low severity findings.
+	Low int32 `json:"low"`
+
+	// Info is the number of informational findings.
+	Info int32 `json:"info"`
+
+	// Findings lists the findings ordered by severity, capped at 100 entries.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	Findings []AnalysisFinding `json:"findings,omitempty"`
+
+	// Message describes why the report could not be collected or parsed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// Count returns the number of findings of the given severity.
+func (r *WorkflowAnalysisResults) Count(severity AnalysisSeverity) int32 {
+	switch severity {
+	case AnalysisSeverityCritical:
+		return r.Critical
+	case AnalysisSeverityHigh:
+		return r.High
+	case AnalysisSeverityMedium:
+		return r.Medium
+	case AnalysisSeverityLow:
+		return r.Low
+	case AnalysisSeverityInfo:
+		return r.Info
+	}
+	return 0
+}
+
+// SBOMFormat is the format of a software bill of materials.
+// +kubebuilder:validation:Enum=CycloneDX;SPDX
+type SBOMFormat string
+
+const (
+	SBOMFormatCycloneDX SBOMFormat = "CycloneDX"
+	SBOMFormatSPDX      SBOMFormat = "SPDX"
+)
+
+// WorkflowSBOM summarizes the software bill of materials reported by a workflow run.
+type WorkflowSBOM struct {
+	// Step is the name of the workflow step the SBOM was collected from.
+	Step string `json:"step"`
+
+	// Format is the format of the SBOM document.
+	// +optional
+	Format SBOMFormat `json:"format,omitempty"`
+
+	// SpecVersion is the version of the format specification, such as "1.4" or "SPDX-2.3".
+	// +optional
+	SpecVersion string `json:"specVersion,omitempty"`
+
+	// Digest is the sha256 digest of the SBOM document, used to match it against the SBOM
+	// stored with the image.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// ComponentCount is the number of components listed in the SBOM.
+	ComponentCount int32 `json:"componentCount"`
+
+	// Components lists the components ordered by name, capped at 100 entries.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	Components []SBOMComponent `json:"components,omitempty"`
+
+	// Message describes why the SBOM could not be collected or parsed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// SBOMComponent describes a single component of a software bill of materials.
+type SBOMComponent struct {
+	// Name is the name of the component.
+	Name string `json:"name"`
+
+	// Version is the version of the component.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// PURL is the package URL of the component.
+	// +optional
+	PURL string `json:"purl,omitempty"`
+}
+
+// AnalysisFinding describes a single static analysis finding.
+type AnalysisFinding struct {
+	// Tool is the name of the analyzer that reported the finding.
+	Tool string `json:"tool"`
+
+	// RuleID identifies the rule that was violated.
+	// +optional
+	RuleID string `json:"ruleId,omitempty"`
+
+	// Severity is the severity of the finding.
+	Severity AnalysisSeverity `json:"severity"`
+
+	// Message describes the finding, truncated to 1024 characters.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// File is the path of the file the finding was reported in.
+	// +optional
+	File string `json:"file,omitempty"`
+
+	// Line is the line the finding starts at.
+	// +optional
+	Line int32 `json:"line,omitempty"`
+}
+
+// WorkflowTestResults summarizes the test results reported by a workflow run.
+type WorkflowTestResults struct {
+	// Step is the name of the workflow step the results were collected from.
+	Step string `json:"step"`
+
+	// Passed is true when no test failed or errored.
+	Passed bool `json:"passed"`
+
+	// BlocksRelease is true when the tests failed and the workflow's failure policy
+	// blocks release creation.
+	// +optional
+	BlocksRelease bool `json:"blocksRelease,omitempty"`
+
+	// Tests is the total number of test cases.
+	Tests int32 `json:"tests"`
+
+	// Failures is the number of failed test cases.
+	Failures int32 `json:"failures"`
+
+	// Errors is the number of test cases that errored.
+	Errors int32 `json:"errors"`
+
+	// Skipped is the number of skipped test cases.
+	Skipped int32 `json:"skipped"`
+
+	// DurationMillis is the total test time in milliseconds.
+	// +optional
+	DurationMillis int64 `json:"durationMillis,omitempty"`
+
+	// Suites lists the results of each test suite.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	Suites []TestSuiteResult `json:"suites,omitempty"`
+
+	// FailedCases lists the failed and errored test cases, capped at 50 entries.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	FailedCases []TestCaseFailure `json:"failedCases,omitempty"`
+
+	// Message describes why the report could not be collected or parsed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// TestSuiteResult summarizes a single test suite of a JUnit report.
+type TestSuiteResult struct {
+	// Name is the name of the test suite.
+	Name string `json:"name"`
+
+	// Tests is the number of test cases in the suite.
+	Tests int32 `json:"tests"`
+
+	// Failures is the number of failed test cases in the suite.
+	Failures int32 `json:"failures"`
+
+	// Errors is the number of test cases in the suite that errored.
+	Errors int32 `json:"errors"`
+
+	// Skipped is the number of skipped test cases in the suite.
+	Skipped int32 `json:"skipped"`
+
+	// DurationMillis is the suite's test time in milliseconds.
+	// +optional
+	DurationMillis int64 `json:"durationMillis,omitempty"`
+}
+
+// TestCaseFailure describes a failed or errored test case.
+type TestCaseFailure struct {
+	// Suite is the name of the suite the test case belongs to.
+	Suite string `json:"suite"`
+
+	// Name is the name of the test case, qualified by its class name when one is reported.
+	Name string `json:"name"`
+
+	// Error is true when the test case errored rather than failed an assertion.
+	// +optional
+	Error bool `json:"error,omitempty"`
+
+	// Message is the failure message, truncated to 1024 characters.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ResolvedWorkflowTemplate identifies the workflow template a run was rendered from.
+type ResolvedWorkflowTemplate struct {
+	// Version is the published workflow version the run was rendered from.
+	// Empty when the run used the live workflow template.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Generation is the generation of the live Workflow or ClusterWorkflow the run was
+	// rendered from. Only set when Version is empty.
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// Deprecated is true when the run was rendered from a deprecated version.
+	// +optional
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// Tests is the test configuration of the template, used to collect the run's test results.
+	// +optional
+	Tests *WorkflowTestSpec `json:"tests,omitempty"`
+
+	// Analysis is the static analysis configuration of the template, used to collect the run's findings.
+	// +optional
+	Analysis *WorkflowAnalysisSpec `json:"analysis,omitempty"`
+
+	// SBOM is the SBOM configuration of the template, used to collect the run's bill of materials.
+	// +optional
+	SBOM *WorkflowSBOMSpec `json:"sbom,omitempty"`
+
+	// Timeout is the execution timeout of the template.
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
+
+	// RetryPolicy is the retry policy of the template.
+	// +optional
+	RetryPolicy *WorkflowRetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,137 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// WorkflowTemplate is the frozen part of a Workflow or ClusterWorkflow spec that determines
+// what a workflow run renders. The workflow plane reference is not part of the template; runs
+// of a published version execute on the plane the workflow currently references.
+type WorkflowTemplate struct {
+	// Parameters defines the developer-facing parameters of this version.
+	// +optional
+	Parameters *SchemaSection `json:"parameters,omitempty"`
+
+	// RunTemplate is the Kubernetes resource template rendered for a workflow run.
+	// +required
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	RunTemplate *runtime.RawExtension `json:"runTemplate"`
+
+	// Resources are additional templates rendered alongside the workflow run.
+	// +optional
+	Resources []WorkflowResource `json:"resources,omitempty"`
+
+	// ExternalRefs declares references to external CRs that are resolved at runtime.
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	ExternalRefs []ExternalRef `json:"externalRefs,omitempty"`
+
+	// TTLAfterCompletion defines the time-to-live for WorkflowRun instances after completion.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
+	TTLAfterCompletion string `json:"ttlAfterCompletion,omitempty"`
+
+	// Tests declares the step of the run template that runs the component's tests.
+	// +optional
+	Tests *WorkflowTestSpec `json:"tests,omitempty"`
+
+	// Analysis declares the step of the run template that runs static analysis.
+	// +optional
+	Analysis *WorkflowAnalysisSpec `json:"analysis,omitempty"`
+
+	// SBOM declares the step of the run template that produces the software bill of materials
+	// of the built image. The SBOM of that step is collected into the WorkflowRun status.
+	// +optional
+	SBOM *WorkflowSBOMSpec `json:"sbom,omitempty"`
+
+	// Timeout is the maximum time a run may execute on the workflow plane, for example "1h" or
+	// "30m". Runs that exceed it are terminated and marked as timed out.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
+	Timeout string `json:"timeout,omitempty"`
+
+	// RetryPolicy resubmits runs that fail.
+	// +optional
+	RetryPolicy *WorkflowRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Credentials are short-lived credentials runs obtain from the control plane's credential
+	// broker instead of mounting static secrets.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Credentials []WorkflowCredential `json:"credentials,omitempty"`
+}
+
+// WorkflowVersionSpec defines a published, immutable version of a workflow template.
+type WorkflowVersionSpec struct {
+	// WorkflowName is the name of the Workflow (or, for a ClusterWorkflowVersion, the
+	// ClusterWorkflow) this version was published from.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.workflowName is immutable"
+	WorkflowName string `json:"workflowName"`
+
+	// Version is the version label, for example "v1" or "v1.2.0".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^v[0-9]+(\.[0-9]+){0,2}$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.version is immutable"
+	Version string `json:"version"`
+
+	// Template is a frozen snapshot of the workflow template at publish time.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.template is immutable"
+	Template WorkflowTemplate `json:"template"`
+
+	// Deprecated marks the version as deprecated. Deprecated versions keep working so that
+	// past runs can be reproduced, but should no longer be pinned by components.
+	// +optional
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// DeprecationMessage tells users why the version is deprecated and what to use instead.
+	// +optional
+	DeprecationMessage string `json:"deprecationMessage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=wfv;wfvs
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == self.spec.workflowName + '.' + self.spec.version",message="metadata.name must be <spec.workflowName>.<spec.version>"
+// +kubebuilder:printcolumn:name="Workflow",type=string,JSONPath=`.spec.workflowName`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.spec.version`
+// +kubebuilder:printcolumn:name="Deprecated",type=boolean,JSONPath=`.spec.deprecated`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// WorkflowVersion is the Schema for the workflowversions API.
+// WorkflowVersion is an immutable snapshot of a Workflow template. WorkflowRuns that pin
+// a version render from the snapshot instead of the live Workflow, so editing the Workflow
+// does not change how past builds are reproduced.
+type WorkflowVersion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WorkflowVersionSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowVersionList contains a list of WorkflowVersion.
+type WorkflowVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkflowVersion `json:"items"`
+}
+
+// WorkflowVersionName returns the object name of a WorkflowVersion or ClusterWorkflowVersion
+// for the given workflow and version.
+func WorkflowVersionName(workflowName, version string) string {
+	return workflowName + "." + version
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkflowVersion{}, &WorkflowVersionList{})
+}
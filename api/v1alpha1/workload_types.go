@@ -89,12 +89,23 @@ const (
 	EndpointTypeGRPC      EndpointType = "gRPC"
 	EndpointTypeTCP       EndpointType = "TCP"
 	EndpointTypeUDP       EndpointType = "UDP"
+
+	// Asynchronous endpoint types. They describe a message channel on a broker rather than
+	// a port served by the container, so they get no Service port or gateway route.
+	EndpointTypeKafka EndpointType = "Kafka"
+	EndpointTypeNATS  EndpointType = "NATS"
+	EndpointTypeAMQP  EndpointType = "AMQP"
 )
 
 func (e EndpointType) String() string {
 	return string(e)
 }
 
+// IsAsync reports whether the endpoint type is an asynchronous messaging protocol.
+func (e EndpointType) IsAsync() bool {
+	return e == EndpointTypeKafka || e == EndpointTypeNATS || e == EndpointTypeAMQP
+}
+
 // EndpointVisibility defines the visibility scope for an endpoint.
 // It determines which components can access the endpoint and how that access is enforced at runtime.
 // +kubebuilder:validation:Enum=project;namespace;internal;external
@@ -115,6 +126,8 @@ const (
 )
 
 // WorkloadEndpoint represents a simple network endpoint for basic exposure.
+// +kubebuilder:validation:XValidation:rule="(self.type in ['Kafka', 'NATS', 'AMQP']) == has(self.async)",message="async must be set for Kafka, NATS and AMQP endpoints and only for them"
+// +kubebuilder:validation:XValidation:rule="!has(self.async) || !has(self.async.kafkaTopic) || self.type == 'Kafka'",message="async.kafkaTopic is only valid for Kafka endpoints"
 type WorkloadEndpoint struct {
 	// Visibility is an array of additional endpoint visibilities beyond the implicit project visibility.
 	// Every endpoint always gets project visibility. This array adds extra scopes.
@@ -124,10 +137,11 @@ type WorkloadEndpoint struct {
 
 	// Type indicates the protocol/technology of the endpoint.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=HTTP;gRPC;GraphQL;Websocket;TCP;UDP
+	// +kubebuilder:validation:Enum=HTTP;gRPC;GraphQL;Websocket;TCP;UDP;Kafka;NATS;AMQP
 	Type EndpointType `json:"type"`
 
 	// Port exposed by the endpoint. If targetPort is not set, platform defaults to port for both.
+	// For asynchronous endpoints it is the port of the broker.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=65535
@@ -150,6 +164,56 @@ type WorkloadEndpoint struct {
 	// Schema for the endpoint API definition.
 	// +optional
 	Schema *Schema `json:"schema,omitempty"`
+
+	// Async describes the message channel of a Kafka, NATS or AMQP endpoint.
+	// +optional
+	Async *AsyncEndpoint `json:"async,omitempty"`
+}
+
+// AsyncEndpoint describes the message channel of an asynchronous endpoint and the broker
+// that carries it.
+type AsyncEndpoint struct {
+	// Channel is the Kafka topic, NATS subject or AMQP queue of the endpoint.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Channel string `json:"channel"`
+
+	// Broker is the host of the message broker, such as the Kafka bootstrap service.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Broker string `json:"broker"`
+
+	// KafkaTopic provisions the topic on the data plane as a Strimzi KafkaTopic.
+	// +optional
+	KafkaTopic *KafkaTopicProvisioning `json:"kafkaTopic,omitempty"`
+}
+
+// KafkaTopicProvisioning configures the Strimzi KafkaTopic rendered for a Kafka endpoint.
+type KafkaTopicProvisioning struct {
+	// Cluster is the name of the Strimzi Kafka cluster that owns the topic.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Cluster string `json:"cluster"`
+
+	// Namespace is the data plane namespace watched by the Strimzi topic operator.
+	// Defaults to the namespace of the component.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Partitions is the number of partitions of the topic.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Partitions *int32 `json:"partitions,omitempty"`
+
+	// Replicas is the replication factor of the topic.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32767
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Config holds Kafka topic configuration such as retention.ms.
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
 }
 
 // Schema defines the API definition for an endpoint.
@@ -190,7 +254,8 @@ type WorkloadConnection struct {
 type ConnectionEnvBindings struct {
 	// Address is the env var name for the protocol-appropriate connection string.
 	// For HTTP/HTTPS/WS/WSS: scheme://host:port/basePath
-	// For gRPC/TCP/UDP: host:port
+	// For gRPC/TCP/UDP/Kafka: host:port
+	// For NATS/AMQP: scheme://host:port
 	// +optional
 	Address string `json:"address,omitempty"`
 
@@ -205,6 +270,11 @@ type ConnectionEnvBindings struct {
 	// BasePath is the optional env var name for just the base path.
 	// +optional
 	BasePath string `json:"basePath,omitempty"`
+
+	// Channel is the optional env var name for the Kafka topic, NATS subject or AMQP queue
+	// of an asynchronous endpoint.
+	// +optional
+	Channel string `json:"channel,omitempty"`
 }
 
 // WorkloadDependencies defines the dependencies of a workload on other components' endpoints
@@ -292,7 +362,7 @@ type WorkloadOwner struct {
 }
 
 type WorkloadSpec struct {
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.owner is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.componentName == oldSelf.componentName",message="spec.owner.componentName is immutable"
 	Owner WorkloadOwner `json:"owner"`
 
 	// Inline *all* the template fields so they appear at top level.
@@ -0,0 +1,98 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// WorkloadIdentityProvider is the cloud mechanism that federates Kubernetes service accounts
+// with cloud identities.
+// +kubebuilder:validation:Enum=AWS;GCP;Azure
+type WorkloadIdentityProvider string
+
+const (
+	// WorkloadIdentityProviderAWS federates service accounts with IAM roles through IAM Roles
+	// for Service Accounts (IRSA) on EKS.
+	WorkloadIdentityProviderAWS WorkloadIdentityProvider = "AWS"
+	// WorkloadIdentityProviderGCP federates service accounts with Google service accounts
+	// through GKE Workload Identity.
+	WorkloadIdentityProviderGCP WorkloadIdentityProvider = "GCP"
+	// WorkloadIdentityProviderAzure federates service accounts with managed identities or
+	// app registrations through Microsoft Entra Workload ID.
+	WorkloadIdentityProviderAzure WorkloadIdentityProvider = "Azure"
+)
+
+// WorkloadIdentityConfig declares how workloads on a data plane obtain cloud credentials
+// without long-lived keys. Components whose ComponentType requests a workload identity get
+// a dedicated ServiceAccount annotated for the provider.
+// +kubebuilder:validation:XValidation:rule="self.provider != 'AWS' || has(self.aws)",message="aws is required when provider is AWS"
+// +kubebuilder:validation:XValidation:rule="self.provider != 'GCP' || has(self.gcp)",message="gcp is required when provider is GCP"
+type WorkloadIdentityConfig struct {
+	// Provider is the workload identity federation mechanism of the data plane cluster.
+	// +kubebuilder:validation:Required
+	Provider WorkloadIdentityProvider `json:"provider"`
+
+	// AWS configures IAM Roles for Service Accounts.
+	// +optional
+	AWS *AWSWorkloadIdentity `json:"aws,omitempty"`
+
+	// GCP configures GKE Workload Identity.
+	// +optional
+	GCP *GCPWorkloadIdentity `json:"gcp,omitempty"`
+
+	// Azure configures Microsoft Entra Workload ID.
+	// +optional
+	Azure *AzureWorkloadIdentity `json:"azure,omitempty"`
+}
+
+// AWSWorkloadIdentity configures IAM Roles for Service Accounts.
+type AWSWorkloadIdentity struct {
+	// AccountID is the AWS account of the IAM roles. Roles requested by name are looked up
+	// in this account, and role ARNs must belong to it.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9]{12}$`
+	AccountID string `json:"accountID"`
+
+	// Partition is the AWS partition of the account, e.g. "aws-cn" or "aws-us-gov".
+	// Defaults to "aws".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^aws(-[a-z]+)*$`
+	Partition string `json:"partition,omitempty"`
+}
+
+// GCPWorkloadIdentity configures GKE Workload Identity.
+type GCPWorkloadIdentity struct {
+	// ProjectID is the Google Cloud project of the Google service accounts. Service accounts
+	// requested by name are looked up in this project.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectID string `json:"projectID"`
+
+	// WorkloadPool is the workload identity pool of the cluster, e.g. "my-project.svc.id.goog".
+	// Defaults to the pool of ProjectID.
+	// +optional
+	WorkloadPool string `json:"workloadPool,omitempty"`
+}
+
+// AzureWorkloadIdentity configures Microsoft Entra Workload ID.
+type AzureWorkloadIdentity struct {
+	// TenantID is the Microsoft Entra tenant of the identities. Defaults to the tenant the
+	// workload identity webhook of the cluster is configured with.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+}
+
+// WorkloadIdentityRequest binds the workloads of a component to a cloud identity through the
+// workload identity provider of the data plane it is deployed to.
+type WorkloadIdentityRequest struct {
+	// Identity is the cloud identity the workloads assume: an IAM role name or ARN on AWS, a
+	// Google service account name or email on GCP, or the client ID of a managed identity on
+	// Azure. It may contain ${...} CEL expressions evaluated against the component context,
+	// e.g. "${metadata.componentName}-${metadata.environmentName}".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Identity string `json:"identity"`
+
+	// Optional lets components deploy to data planes without a workload identity provider,
+	// without a cloud identity. Otherwise such deployments are refused.
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
@@ -8,10 +8,42 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIVersionPreference) DeepCopyInto(out *APIVersionPreference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIVersionPreference.
+func (in *APIVersionPreference) DeepCopy() *APIVersionPreference {
+	if in == nil {
+		return nil
+	}
+	out := new(APIVersionPreference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSWorkloadIdentity) DeepCopyInto(out *AWSWorkloadIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSWorkloadIdentity.
+func (in *AWSWorkloadIdentity) DeepCopy() *AWSWorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSWorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentConnectionStatus) DeepCopyInto(out *AgentConnectionStatus) {
 	*out = *in
@@ -27,6 +59,11 @@ func (in *AgentConnectionStatus) DeepCopyInto(out *AgentConnectionStatus) {
 		in, out := &in.LastHeartbeatTime, &out.LastHeartbeatTime
 		*out = (*in).DeepCopy()
 	}
+	if in.RevokedCertificates != nil {
+		in, out := &in.RevokedCertificates, &out.RevokedCertificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentConnectionStatus.
@@ -40,71 +77,121 @@ func (in *AgentConnectionStatus) DeepCopy() *AgentConnectionStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthzCondition) DeepCopyInto(out *AuthzCondition) {
+func (in *AlertmanagerMatcher) DeepCopyInto(out *AlertmanagerMatcher) {
 	*out = *in
-	if in.Actions != nil {
-		in, out := &in.Actions, &out.Actions
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerMatcher.
+func (in *AlertmanagerMatcher) DeepCopy() *AlertmanagerMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerRoute) DeepCopyInto(out *AlertmanagerRoute) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]AlertmanagerMatcher, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RepeatInterval != nil {
+		in, out := &in.RepeatInterval, &out.RepeatInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzCondition.
-func (in *AuthzCondition) DeepCopy() *AuthzCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerRoute.
+func (in *AlertmanagerRoute) DeepCopy() *AlertmanagerRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthzCondition)
+	out := new(AlertmanagerRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthzRole) DeepCopyInto(out *AuthzRole) {
+func (in *AnalysisFinding) DeepCopyInto(out *AnalysisFinding) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRole.
-func (in *AuthzRole) DeepCopy() *AuthzRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisFinding.
+func (in *AnalysisFinding) DeepCopy() *AnalysisFinding {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthzRole)
+	out := new(AnalysisFinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AuthzRole) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalysisQualityGate) DeepCopyInto(out *AnalysisQualityGate) {
+	*out = *in
+	if in.MaxCritical != nil {
+		in, out := &in.MaxCritical, &out.MaxCritical
+		*out = new(int32)
+		**out = **in
 	}
-	return nil
+	if in.MaxHigh != nil {
+		in, out := &in.MaxHigh, &out.MaxHigh
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxMedium != nil {
+		in, out := &in.MaxMedium, &out.MaxMedium
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLow != nil {
+		in, out := &in.MaxLow, &out.MaxLow
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisQualityGate.
+func (in *AnalysisQualityGate) DeepCopy() *AnalysisQualityGate {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisQualityGate)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthzRoleBinding) DeepCopyInto(out *AuthzRoleBinding) {
+func (in *AnomalyDetector) DeepCopyInto(out *AnomalyDetector) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleBinding.
-func (in *AuthzRoleBinding) DeepCopy() *AuthzRoleBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetector.
+func (in *AnomalyDetector) DeepCopy() *AnomalyDetector {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthzRoleBinding)
+	out := new(AnomalyDetector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AuthzRoleBinding) DeepCopyObject() runtime.Object {
+func (in *AnomalyDetector) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -112,31 +199,71 @@ func (in *AuthzRoleBinding) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthzRoleBindingList) DeepCopyInto(out *AuthzRoleBindingList) {
+func (in *AnomalyDetectorActions) DeepCopyInto(out *AnomalyDetectorActions) {
+	*out = *in
+	if in.NotificationChannels != nil {
+		in, out := &in.NotificationChannels, &out.NotificationChannels
+		*out = make([]NotificationChannelName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Incident != nil {
+		in, out := &in.Incident, &out.Incident
+		*out = new(AnomalyDetectorIncident)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorActions.
+func (in *AnomalyDetectorActions) DeepCopy() *AnomalyDetectorActions {
+	if in == nil {
+		return nil
+	}
+	out := new(AnomalyDetectorActions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnomalyDetectorIncident) DeepCopyInto(out *AnomalyDetectorIncident) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorIncident.
+func (in *AnomalyDetectorIncident) DeepCopy() *AnomalyDetectorIncident {
+	if in == nil {
+		return nil
+	}
+	out := new(AnomalyDetectorIncident)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnomalyDetectorList) DeepCopyInto(out *AnomalyDetectorList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AuthzRoleBinding, len(*in))
+		*out = make([]AnomalyDetector, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleBindingList.
-func (in *AuthzRoleBindingList) DeepCopy() *AuthzRoleBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorList.
+func (in *AnomalyDetectorList) DeepCopy() *AnomalyDetectorList {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthzRoleBindingList)
+	out := new(AnomalyDetectorList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AuthzRoleBindingList) DeepCopyObject() runtime.Object {
+func (in *AnomalyDetectorList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -144,116 +271,161 @@ func (in *AuthzRoleBindingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthzRoleBindingSpec) DeepCopyInto(out *AuthzRoleBindingSpec) {
+func (in *AnomalyDetectorOwner) DeepCopyInto(out *AnomalyDetectorOwner) {
 	*out = *in
-	out.Entitlement = in.Entitlement
-	if in.RoleMappings != nil {
-		in, out := &in.RoleMappings, &out.RoleMappings
-		*out = make([]RoleMapping, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorOwner.
+func (in *AnomalyDetectorOwner) DeepCopy() *AnomalyDetectorOwner {
+	if in == nil {
+		return nil
 	}
+	out := new(AnomalyDetectorOwner)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleBindingSpec.
-func (in *AuthzRoleBindingSpec) DeepCopy() *AuthzRoleBindingSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnomalyDetectorSpec) DeepCopyInto(out *AnomalyDetectorSpec) {
+	*out = *in
+	out.Owner = in.Owner
+	if in.Signals != nil {
+		in, out := &in.Signals, &out.Signals
+		*out = make([]AnomalySignal, len(*in))
+		copy(*out, *in)
+	}
+	out.Seasonality = in.Seasonality
+	out.Window = in.Window
+	out.Interval = in.Interval
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = new(AnomalyDetectorActions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorSpec.
+func (in *AnomalyDetectorSpec) DeepCopy() *AnomalyDetectorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthzRoleBindingSpec)
+	out := new(AnomalyDetectorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthzRoleList) DeepCopyInto(out *AuthzRoleList) {
+func (in *AnomalyDetectorStatus) DeepCopyInto(out *AnomalyDetectorStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]AuthzRole, len(*in))
+	if in.LastEvaluationTime != nil {
+		in, out := &in.LastEvaluationTime, &out.LastEvaluationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Signals != nil {
+		in, out := &in.Signals, &out.Signals
+		*out = make([]AnomalySignalStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleList.
-func (in *AuthzRoleList) DeepCopy() *AuthzRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorStatus.
+func (in *AnomalyDetectorStatus) DeepCopy() *AnomalyDetectorStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthzRoleList)
+	out := new(AnomalyDetectorStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AuthzRoleList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnomalySignalStatus) DeepCopyInto(out *AnomalySignalStatus) {
+	*out = *in
+	if in.Since != nil {
+		in, out := &in.Since, &out.Since
+		*out = (*in).DeepCopy()
 	}
-	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalySignalStatus.
+func (in *AnomalySignalStatus) DeepCopy() *AnomalySignalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AnomalySignalStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthzRoleSpec) DeepCopyInto(out *AuthzRoleSpec) {
+func (in *AsyncEndpoint) DeepCopyInto(out *AsyncEndpoint) {
 	*out = *in
-	if in.Actions != nil {
-		in, out := &in.Actions, &out.Actions
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.KafkaTopic != nil {
+		in, out := &in.KafkaTopic, &out.KafkaTopic
+		*out = new(KafkaTopicProvisioning)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleSpec.
-func (in *AuthzRoleSpec) DeepCopy() *AuthzRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AsyncEndpoint.
+func (in *AsyncEndpoint) DeepCopy() *AsyncEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthzRoleSpec)
+	out := new(AsyncEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAgentConfig) DeepCopyInto(out *ClusterAgentConfig) {
+func (in *AuthzCondition) DeepCopyInto(out *AuthzCondition) {
 	*out = *in
-	in.ClientCA.DeepCopyInto(&out.ClientCA)
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentConfig.
-func (in *ClusterAgentConfig) DeepCopy() *ClusterAgentConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzCondition.
+func (in *AuthzCondition) DeepCopy() *AuthzCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAgentConfig)
+	out := new(AuthzCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAuthzRole) DeepCopyInto(out *ClusterAuthzRole) {
+func (in *AuthzRole) DeepCopyInto(out *AuthzRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRole.
-func (in *ClusterAuthzRole) DeepCopy() *ClusterAuthzRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRole.
+func (in *AuthzRole) DeepCopy() *AuthzRole {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAuthzRole)
+	out := new(AuthzRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterAuthzRole) DeepCopyObject() runtime.Object {
+func (in *AuthzRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -261,25 +433,25 @@ func (in *ClusterAuthzRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAuthzRoleBinding) DeepCopyInto(out *ClusterAuthzRoleBinding) {
+func (in *AuthzRoleBinding) DeepCopyInto(out *AuthzRoleBinding) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleBinding.
-func (in *ClusterAuthzRoleBinding) DeepCopy() *ClusterAuthzRoleBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleBinding.
+func (in *AuthzRoleBinding) DeepCopy() *AuthzRoleBinding {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAuthzRoleBinding)
+	out := new(AuthzRoleBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterAuthzRoleBinding) DeepCopyObject() runtime.Object {
+func (in *AuthzRoleBinding) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -287,31 +459,31 @@ func (in *ClusterAuthzRoleBinding) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAuthzRoleBindingList) DeepCopyInto(out *ClusterAuthzRoleBindingList) {
+func (in *AuthzRoleBindingList) DeepCopyInto(out *AuthzRoleBindingList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterAuthzRoleBinding, len(*in))
+		*out = make([]AuthzRoleBinding, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleBindingList.
-func (in *ClusterAuthzRoleBindingList) DeepCopy() *ClusterAuthzRoleBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleBindingList.
+func (in *AuthzRoleBindingList) DeepCopy() *AuthzRoleBindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAuthzRoleBindingList)
+	out := new(AuthzRoleBindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterAuthzRoleBindingList) DeepCopyObject() runtime.Object {
+func (in *AuthzRoleBindingList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -319,54 +491,54 @@ func (in *ClusterAuthzRoleBindingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAuthzRoleBindingSpec) DeepCopyInto(out *ClusterAuthzRoleBindingSpec) {
+func (in *AuthzRoleBindingSpec) DeepCopyInto(out *AuthzRoleBindingSpec) {
 	*out = *in
 	out.Entitlement = in.Entitlement
 	if in.RoleMappings != nil {
 		in, out := &in.RoleMappings, &out.RoleMappings
-		*out = make([]ClusterRoleMapping, len(*in))
+		*out = make([]RoleMapping, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleBindingSpec.
-func (in *ClusterAuthzRoleBindingSpec) DeepCopy() *ClusterAuthzRoleBindingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleBindingSpec.
+func (in *AuthzRoleBindingSpec) DeepCopy() *AuthzRoleBindingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAuthzRoleBindingSpec)
+	out := new(AuthzRoleBindingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAuthzRoleList) DeepCopyInto(out *ClusterAuthzRoleList) {
+func (in *AuthzRoleList) DeepCopyInto(out *AuthzRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterAuthzRole, len(*in))
+		*out = make([]AuthzRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleList.
-func (in *ClusterAuthzRoleList) DeepCopy() *ClusterAuthzRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleList.
+func (in *AuthzRoleList) DeepCopy() *AuthzRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAuthzRoleList)
+	out := new(AuthzRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterAuthzRoleList) DeepCopyObject() runtime.Object {
+func (in *AuthzRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -374,7 +546,7 @@ func (in *ClusterAuthzRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAuthzRoleSpec) DeepCopyInto(out *ClusterAuthzRoleSpec) {
+func (in *AuthzRoleSpec) DeepCopyInto(out *AuthzRoleSpec) {
 	*out = *in
 	if in.Actions != nil {
 		in, out := &in.Actions, &out.Actions
@@ -383,315 +555,239 @@ func (in *ClusterAuthzRoleSpec) DeepCopyInto(out *ClusterAuthzRoleSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleSpec.
-func (in *ClusterAuthzRoleSpec) DeepCopy() *ClusterAuthzRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthzRoleSpec.
+func (in *AuthzRoleSpec) DeepCopy() *AuthzRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAuthzRoleSpec)
+	out := new(AuthzRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterComponentType) DeepCopyInto(out *ClusterComponentType) {
+func (in *AutoRollbackPolicy) DeepCopyInto(out *AutoRollbackPolicy) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.HealthCheckTimeout != nil {
+		in, out := &in.HealthCheckTimeout, &out.HealthCheckTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DegradedTimeout != nil {
+		in, out := &in.DegradedTimeout, &out.DegradedTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentType.
-func (in *ClusterComponentType) DeepCopy() *ClusterComponentType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollbackPolicy.
+func (in *AutoRollbackPolicy) DeepCopy() *AutoRollbackPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterComponentType)
+	out := new(AutoRollbackPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterComponentType) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterComponentTypeList) DeepCopyInto(out *ClusterComponentTypeList) {
+func (in *AvailabilityObjective) DeepCopyInto(out *AvailabilityObjective) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ClusterComponentType, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTypeList.
-func (in *ClusterComponentTypeList) DeepCopy() *ClusterComponentTypeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityObjective.
+func (in *AvailabilityObjective) DeepCopy() *AvailabilityObjective {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterComponentTypeList)
+	out := new(AvailabilityObjective)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterComponentTypeList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterComponentTypeSpec) DeepCopyInto(out *ClusterComponentTypeSpec) {
+func (in *AzureWorkloadIdentity) DeepCopyInto(out *AzureWorkloadIdentity) {
 	*out = *in
-	if in.AllowedWorkflows != nil {
-		in, out := &in.AllowedWorkflows, &out.AllowedWorkflows
-		*out = make([]ClusterWorkflowRef, len(*in))
-		copy(*out, *in)
-	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EnvironmentConfigs != nil {
-		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Traits != nil {
-		in, out := &in.Traits, &out.Traits
-		*out = make([]ClusterComponentTypeTrait, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.AllowedTraits != nil {
-		in, out := &in.AllowedTraits, &out.AllowedTraits
-		*out = make([]ClusterTraitRef, len(*in))
-		copy(*out, *in)
-	}
-	if in.Validations != nil {
-		in, out := &in.Validations, &out.Validations
-		*out = make([]ValidationRule, len(*in))
-		copy(*out, *in)
-	}
-	if in.PreRenderValidations != nil {
-		in, out := &in.PreRenderValidations, &out.PreRenderValidations
-		*out = make([]ValidationRule, len(*in))
-		copy(*out, *in)
-	}
-	if in.PostRenderValidations != nil {
-		in, out := &in.PostRenderValidations, &out.PostRenderValidations
-		*out = make([]PostRenderValidation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]ResourceTemplate, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTypeSpec.
-func (in *ClusterComponentTypeSpec) DeepCopy() *ClusterComponentTypeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureWorkloadIdentity.
+func (in *AzureWorkloadIdentity) DeepCopy() *AzureWorkloadIdentity {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterComponentTypeSpec)
+	out := new(AzureWorkloadIdentity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterComponentTypeStatus) DeepCopyInto(out *ClusterComponentTypeStatus) {
+func (in *BlueGreenStrategy) DeepCopyInto(out *BlueGreenStrategy) {
 	*out = *in
+	if in.AutoPromotionDelay != nil {
+		in, out := &in.AutoPromotionDelay, &out.AutoPromotionDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTypeStatus.
-func (in *ClusterComponentTypeStatus) DeepCopy() *ClusterComponentTypeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenStrategy.
+func (in *BlueGreenStrategy) DeepCopy() *BlueGreenStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterComponentTypeStatus)
+	out := new(BlueGreenStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterComponentTypeTrait) DeepCopyInto(out *ClusterComponentTypeTrait) {
+func (in *BuildNodePreWarmSpec) DeepCopyInto(out *BuildNodePreWarmSpec) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
 	}
-	if in.EnvironmentConfigs != nil {
-		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = make([]PreWarmWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTypeTrait.
-func (in *ClusterComponentTypeTrait) DeepCopy() *ClusterComponentTypeTrait {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildNodePreWarmSpec.
+func (in *BuildNodePreWarmSpec) DeepCopy() *BuildNodePreWarmSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterComponentTypeTrait)
+	out := new(BuildNodePreWarmSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterDataPlane) DeepCopyInto(out *ClusterDataPlane) {
+func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDataPlane.
-func (in *ClusterDataPlane) DeepCopy() *ClusterDataPlane {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStep.
+func (in *CanaryStep) DeepCopy() *CanaryStep {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterDataPlane)
+	out := new(CanaryStep)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterDataPlane) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterDataPlaneList) DeepCopyInto(out *ClusterDataPlaneList) {
+func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ClusterDataPlane, len(*in))
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]CanaryStep, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDataPlaneList.
-func (in *ClusterDataPlaneList) DeepCopy() *ClusterDataPlaneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStrategy.
+func (in *CanaryStrategy) DeepCopy() *CanaryStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterDataPlaneList)
+	out := new(CanaryStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterDataPlaneList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuerRef) DeepCopyInto(out *CertificateIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuerRef.
+func (in *CertificateIssuerRef) DeepCopy() *CertificateIssuerRef {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CertificateIssuerRef)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterDataPlaneSpec) DeepCopyInto(out *ClusterDataPlaneSpec) {
+func (in *ClusterAgentConfig) DeepCopyInto(out *ClusterAgentConfig) {
 	*out = *in
-	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
-	in.Gateway.DeepCopyInto(&out.Gateway)
-	if in.SecretStoreRef != nil {
-		in, out := &in.SecretStoreRef, &out.SecretStoreRef
-		*out = new(SecretStoreRef)
-		**out = **in
-	}
-	if in.ObservabilityPlaneRef != nil {
-		in, out := &in.ObservabilityPlaneRef, &out.ObservabilityPlaneRef
-		*out = new(ClusterObservabilityPlaneRef)
-		**out = **in
-	}
+	in.ClientCA.DeepCopyInto(&out.ClientCA)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDataPlaneSpec.
-func (in *ClusterDataPlaneSpec) DeepCopy() *ClusterDataPlaneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentConfig.
+func (in *ClusterAgentConfig) DeepCopy() *ClusterAgentConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterDataPlaneSpec)
+	out := new(ClusterAgentConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterDataPlaneStatus) DeepCopyInto(out *ClusterDataPlaneStatus) {
+func (in *ClusterAuthzRole) DeepCopyInto(out *ClusterAuthzRole) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.AgentConnection != nil {
-		in, out := &in.AgentConnection, &out.AgentConnection
-		*out = new(AgentConnectionStatus)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDataPlaneStatus.
-func (in *ClusterDataPlaneStatus) DeepCopy() *ClusterDataPlaneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRole.
+func (in *ClusterAuthzRole) DeepCopy() *ClusterAuthzRole {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterDataPlaneStatus)
+	out := new(ClusterAuthzRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAuthzRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterObservabilityPlane) DeepCopyInto(out *ClusterObservabilityPlane) {
+func (in *ClusterAuthzRoleBinding) DeepCopyInto(out *ClusterAuthzRoleBinding) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlane.
-func (in *ClusterObservabilityPlane) DeepCopy() *ClusterObservabilityPlane {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleBinding.
+func (in *ClusterAuthzRoleBinding) DeepCopy() *ClusterAuthzRoleBinding {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterObservabilityPlane)
+	out := new(ClusterAuthzRoleBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterObservabilityPlane) DeepCopyObject() runtime.Object {
+func (in *ClusterAuthzRoleBinding) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -699,31 +795,31 @@ func (in *ClusterObservabilityPlane) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterObservabilityPlaneList) DeepCopyInto(out *ClusterObservabilityPlaneList) {
+func (in *ClusterAuthzRoleBindingList) DeepCopyInto(out *ClusterAuthzRoleBindingList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterObservabilityPlane, len(*in))
+		*out = make([]ClusterAuthzRoleBinding, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlaneList.
-func (in *ClusterObservabilityPlaneList) DeepCopy() *ClusterObservabilityPlaneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleBindingList.
+func (in *ClusterAuthzRoleBindingList) DeepCopy() *ClusterAuthzRoleBindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterObservabilityPlaneList)
+	out := new(ClusterAuthzRoleBindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterObservabilityPlaneList) DeepCopyObject() runtime.Object {
+func (in *ClusterAuthzRoleBindingList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -731,65 +827,82 @@ func (in *ClusterObservabilityPlaneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterObservabilityPlaneRef) DeepCopyInto(out *ClusterObservabilityPlaneRef) {
+func (in *ClusterAuthzRoleBindingSpec) DeepCopyInto(out *ClusterAuthzRoleBindingSpec) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlaneRef.
-func (in *ClusterObservabilityPlaneRef) DeepCopy() *ClusterObservabilityPlaneRef {
-	if in == nil {
-		return nil
+	out.Entitlement = in.Entitlement
+	if in.RoleMappings != nil {
+		in, out := &in.RoleMappings, &out.RoleMappings
+		*out = make([]ClusterRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(ClusterObservabilityPlaneRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleBindingSpec.
+func (in *ClusterAuthzRoleBindingSpec) DeepCopy() *ClusterAuthzRoleBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuthzRoleBindingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterObservabilityPlaneSpec) DeepCopyInto(out *ClusterObservabilityPlaneSpec) {
+func (in *ClusterAuthzRoleList) DeepCopyInto(out *ClusterAuthzRoleList) {
 	*out = *in
-	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAuthzRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlaneSpec.
-func (in *ClusterObservabilityPlaneSpec) DeepCopy() *ClusterObservabilityPlaneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleList.
+func (in *ClusterAuthzRoleList) DeepCopy() *ClusterAuthzRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterObservabilityPlaneSpec)
+	out := new(ClusterAuthzRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAuthzRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterObservabilityPlaneStatus) DeepCopyInto(out *ClusterObservabilityPlaneStatus) {
+func (in *ClusterAuthzRoleSpec) DeepCopyInto(out *ClusterAuthzRoleSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.AgentConnection != nil {
-		in, out := &in.AgentConnection, &out.AgentConnection
-		*out = new(AgentConnectionStatus)
-		(*in).DeepCopyInto(*out)
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlaneStatus.
-func (in *ClusterObservabilityPlaneStatus) DeepCopy() *ClusterObservabilityPlaneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuthzRoleSpec.
+func (in *ClusterAuthzRoleSpec) DeepCopy() *ClusterAuthzRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterObservabilityPlaneStatus)
+	out := new(ClusterAuthzRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterProjectType) DeepCopyInto(out *ClusterProjectType) {
+func (in *ClusterComponentType) DeepCopyInto(out *ClusterComponentType) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -797,18 +910,18 @@ func (in *ClusterProjectType) DeepCopyInto(out *ClusterProjectType) {
 	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProjectType.
-func (in *ClusterProjectType) DeepCopy() *ClusterProjectType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentType.
+func (in *ClusterComponentType) DeepCopy() *ClusterComponentType {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterProjectType)
+	out := new(ClusterComponentType)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterProjectType) DeepCopyObject() runtime.Object {
+func (in *ClusterComponentType) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -816,31 +929,31 @@ func (in *ClusterProjectType) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterProjectTypeList) DeepCopyInto(out *ClusterProjectTypeList) {
+func (in *ClusterComponentTypeList) DeepCopyInto(out *ClusterComponentTypeList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterProjectType, len(*in))
+		*out = make([]ClusterComponentType, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProjectTypeList.
-func (in *ClusterProjectTypeList) DeepCopy() *ClusterProjectTypeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTypeList.
+func (in *ClusterComponentTypeList) DeepCopy() *ClusterComponentTypeList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterProjectTypeList)
+	out := new(ClusterComponentTypeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterProjectTypeList) DeepCopyObject() runtime.Object {
+func (in *ClusterComponentTypeList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -848,8 +961,13 @@ func (in *ClusterProjectTypeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterProjectTypeSpec) DeepCopyInto(out *ClusterProjectTypeSpec) {
+func (in *ClusterComponentTypeSpec) DeepCopyInto(out *ClusterComponentTypeSpec) {
 	*out = *in
+	if in.AllowedWorkflows != nil {
+		in, out := &in.AllowedWorkflows, &out.AllowedWorkflows
+		*out = make([]ClusterWorkflowRef, len(*in))
+		copy(*out, *in)
+	}
 	if in.Parameters != nil {
 		in, out := &in.Parameters, &out.Parameters
 		*out = new(SchemaSection)
@@ -860,11 +978,35 @@ func (in *ClusterProjectTypeSpec) DeepCopyInto(out *ClusterProjectTypeSpec) {
 		*out = new(SchemaSection)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Traits != nil {
+		in, out := &in.Traits, &out.Traits
+		*out = make([]ClusterComponentTypeTrait, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedTraits != nil {
+		in, out := &in.AllowedTraits, &out.AllowedTraits
+		*out = make([]ClusterTraitRef, len(*in))
+		copy(*out, *in)
+	}
 	if in.Validations != nil {
 		in, out := &in.Validations, &out.Validations
 		*out = make([]ValidationRule, len(*in))
 		copy(*out, *in)
 	}
+	if in.PreRenderValidations != nil {
+		in, out := &in.PreRenderValidations, &out.PreRenderValidations
+		*out = make([]ValidationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostRenderValidations != nil {
+		in, out := &in.PostRenderValidations, &out.PostRenderValidations
+		*out = make([]PostRenderValidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = make([]ResourceTemplate, len(*in))
@@ -872,54 +1014,106 @@ func (in *ClusterProjectTypeSpec) DeepCopyInto(out *ClusterProjectTypeSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DisruptionBudget != nil {
+		in, out := &in.DisruptionBudget, &out.DisruptionBudget
+		*out = new(DisruptionBudgetPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredEnv != nil {
+		in, out := &in.RequiredEnv, &out.RequiredEnv
+		*out = make([]RequiredEnvVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]LifecycleHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QualityGate != nil {
+		in, out := &in.QualityGate, &out.QualityGate
+		*out = new(AnalysisQualityGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentityRequest)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProjectTypeSpec.
-func (in *ClusterProjectTypeSpec) DeepCopy() *ClusterProjectTypeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTypeSpec.
+func (in *ClusterComponentTypeSpec) DeepCopy() *ClusterComponentTypeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterProjectTypeSpec)
+	out := new(ClusterComponentTypeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterProjectTypeStatus) DeepCopyInto(out *ClusterProjectTypeStatus) {
+func (in *ClusterComponentTypeStatus) DeepCopyInto(out *ClusterComponentTypeStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProjectTypeStatus.
-func (in *ClusterProjectTypeStatus) DeepCopy() *ClusterProjectTypeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTypeStatus.
+func (in *ClusterComponentTypeStatus) DeepCopy() *ClusterComponentTypeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterProjectTypeStatus)
+	out := new(ClusterComponentTypeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceType) DeepCopyInto(out *ClusterResourceType) {
+func (in *ClusterComponentTypeTrait) DeepCopyInto(out *ClusterComponentTypeTrait) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvironmentConfigs != nil {
+		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTypeTrait.
+func (in *ClusterComponentTypeTrait) DeepCopy() *ClusterComponentTypeTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterComponentTypeTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDataPlane) DeepCopyInto(out *ClusterDataPlane) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceType.
-func (in *ClusterResourceType) DeepCopy() *ClusterResourceType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDataPlane.
+func (in *ClusterDataPlane) DeepCopy() *ClusterDataPlane {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceType)
+	out := new(ClusterDataPlane)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterResourceType) DeepCopyObject() runtime.Object {
+func (in *ClusterDataPlane) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -927,31 +1121,31 @@ func (in *ClusterResourceType) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceTypeList) DeepCopyInto(out *ClusterResourceTypeList) {
+func (in *ClusterDataPlaneList) DeepCopyInto(out *ClusterDataPlaneList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterResourceType, len(*in))
+		*out = make([]ClusterDataPlane, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceTypeList.
-func (in *ClusterResourceTypeList) DeepCopy() *ClusterResourceTypeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDataPlaneList.
+func (in *ClusterDataPlaneList) DeepCopy() *ClusterDataPlaneList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceTypeList)
+	out := new(ClusterDataPlaneList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterResourceTypeList) DeepCopyObject() runtime.Object {
+func (in *ClusterDataPlaneList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -959,119 +1153,119 @@ func (in *ClusterResourceTypeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceTypeSpec) DeepCopyInto(out *ClusterResourceTypeSpec) {
+func (in *ClusterDataPlaneSpec) DeepCopyInto(out *ClusterDataPlaneSpec) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
+	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
+	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.SecretStoreRef != nil {
+		in, out := &in.SecretStoreRef, &out.SecretStoreRef
+		*out = new(SecretStoreRef)
+		**out = **in
 	}
-	if in.EnvironmentConfigs != nil {
-		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
-		*out = new(SchemaSection)
+	if in.RegistryCredentials != nil {
+		in, out := &in.RegistryCredentials, &out.RegistryCredentials
+		*out = make([]RegistryCredential, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingPolicy)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Outputs != nil {
-		in, out := &in.Outputs, &out.Outputs
-		*out = make([]ResourceTypeOutput, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.APIVersions != nil {
+		in, out := &in.APIVersions, &out.APIVersions
+		*out = make([]APIVersionPreference, len(*in))
+		copy(*out, *in)
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]ResourceTypeManifest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentityConfig)
+		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceTypeSpec.
-func (in *ClusterResourceTypeSpec) DeepCopy() *ClusterResourceTypeSpec {
-	if in == nil {
-		return nil
+	if in.ObservabilityPlaneRef != nil {
+		in, out := &in.ObservabilityPlaneRef, &out.ObservabilityPlaneRef
+		*out = new(ClusterObservabilityPlaneRef)
+		**out = **in
+	}
+	if in.Tenancy != nil {
+		in, out := &in.Tenancy, &out.Tenancy
+		*out = new(TenancyPolicy)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ClusterResourceTypeSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceTypeStatus) DeepCopyInto(out *ClusterResourceTypeStatus) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceTypeStatus.
-func (in *ClusterResourceTypeStatus) DeepCopy() *ClusterResourceTypeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDataPlaneSpec.
+func (in *ClusterDataPlaneSpec) DeepCopy() *ClusterDataPlaneSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceTypeStatus)
+	out := new(ClusterDataPlaneSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterRoleMapping) DeepCopyInto(out *ClusterRoleMapping) {
+func (in *ClusterDataPlaneStatus) DeepCopyInto(out *ClusterDataPlaneStatus) {
 	*out = *in
-	out.RoleRef = in.RoleRef
-	out.Scope = in.Scope
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]AuthzCondition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRoleMapping.
-func (in *ClusterRoleMapping) DeepCopy() *ClusterRoleMapping {
-	if in == nil {
-		return nil
+	if in.AgentConnection != nil {
+		in, out := &in.AgentConnection, &out.AgentConnection
+		*out = new(AgentConnectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make([]NodeLabel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CapacityReservations != nil {
+		in, out := &in.CapacityReservations, &out.CapacityReservations
+		*out = make([]TenantCapacityReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(ClusterRoleMapping)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTargetScope) DeepCopyInto(out *ClusterTargetScope) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTargetScope.
-func (in *ClusterTargetScope) DeepCopy() *ClusterTargetScope {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDataPlaneStatus.
+func (in *ClusterDataPlaneStatus) DeepCopy() *ClusterDataPlaneStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTargetScope)
+	out := new(ClusterDataPlaneStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTrait) DeepCopyInto(out *ClusterTrait) {
+func (in *ClusterObservabilityPlane) DeepCopyInto(out *ClusterObservabilityPlane) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTrait.
-func (in *ClusterTrait) DeepCopy() *ClusterTrait {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlane.
+func (in *ClusterObservabilityPlane) DeepCopy() *ClusterObservabilityPlane {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTrait)
+	out := new(ClusterObservabilityPlane)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterTrait) DeepCopyObject() runtime.Object {
+func (in *ClusterObservabilityPlane) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1079,31 +1273,31 @@ func (in *ClusterTrait) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTraitList) DeepCopyInto(out *ClusterTraitList) {
+func (in *ClusterObservabilityPlaneList) DeepCopyInto(out *ClusterObservabilityPlaneList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterTrait, len(*in))
+		*out = make([]ClusterObservabilityPlane, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTraitList.
-func (in *ClusterTraitList) DeepCopy() *ClusterTraitList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlaneList.
+func (in *ClusterObservabilityPlaneList) DeepCopy() *ClusterObservabilityPlaneList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTraitList)
+	out := new(ClusterObservabilityPlaneList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterTraitList) DeepCopyObject() runtime.Object {
+func (in *ClusterObservabilityPlaneList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1111,117 +1305,84 @@ func (in *ClusterTraitList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTraitRef) DeepCopyInto(out *ClusterTraitRef) {
+func (in *ClusterObservabilityPlaneRef) DeepCopyInto(out *ClusterObservabilityPlaneRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTraitRef.
-func (in *ClusterTraitRef) DeepCopy() *ClusterTraitRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlaneRef.
+func (in *ClusterObservabilityPlaneRef) DeepCopy() *ClusterObservabilityPlaneRef {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTraitRef)
+	out := new(ClusterObservabilityPlaneRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTraitSpec) DeepCopyInto(out *ClusterTraitSpec) {
+func (in *ClusterObservabilityPlaneSpec) DeepCopyInto(out *ClusterObservabilityPlaneSpec) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EnvironmentConfigs != nil {
-		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Validations != nil {
-		in, out := &in.Validations, &out.Validations
-		*out = make([]ValidationRule, len(*in))
-		copy(*out, *in)
-	}
-	if in.PreRenderValidations != nil {
-		in, out := &in.PreRenderValidations, &out.PreRenderValidations
-		*out = make([]ValidationRule, len(*in))
-		copy(*out, *in)
-	}
-	if in.PostRenderValidations != nil {
-		in, out := &in.PostRenderValidations, &out.PostRenderValidations
-		*out = make([]PostRenderValidation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Creates != nil {
-		in, out := &in.Creates, &out.Creates
-		*out = make([]TraitCreate, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Patches != nil {
-		in, out := &in.Patches, &out.Patches
-		*out = make([]TraitPatch, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Removes != nil {
-		in, out := &in.Removes, &out.Removes
-		*out = make([]TraitRemove, len(*in))
-		copy(*out, *in)
-	}
+	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTraitSpec.
-func (in *ClusterTraitSpec) DeepCopy() *ClusterTraitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlaneSpec.
+func (in *ClusterObservabilityPlaneSpec) DeepCopy() *ClusterObservabilityPlaneSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTraitSpec)
+	out := new(ClusterObservabilityPlaneSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTraitStatus) DeepCopyInto(out *ClusterTraitStatus) {
+func (in *ClusterObservabilityPlaneStatus) DeepCopyInto(out *ClusterObservabilityPlaneStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AgentConnection != nil {
+		in, out := &in.AgentConnection, &out.AgentConnection
+		*out = new(AgentConnectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTraitStatus.
-func (in *ClusterTraitStatus) DeepCopy() *ClusterTraitStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservabilityPlaneStatus.
+func (in *ClusterObservabilityPlaneStatus) DeepCopy() *ClusterObservabilityPlaneStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTraitStatus)
+	out := new(ClusterObservabilityPlaneStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflow) DeepCopyInto(out *ClusterWorkflow) {
+func (in *ClusterProjectType) DeepCopyInto(out *ClusterProjectType) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflow.
-func (in *ClusterWorkflow) DeepCopy() *ClusterWorkflow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProjectType.
+func (in *ClusterProjectType) DeepCopy() *ClusterProjectType {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterWorkflow)
+	out := new(ClusterProjectType)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterWorkflow) DeepCopyObject() runtime.Object {
+func (in *ClusterProjectType) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1229,31 +1390,31 @@ func (in *ClusterWorkflow) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflowList) DeepCopyInto(out *ClusterWorkflowList) {
+func (in *ClusterProjectTypeList) DeepCopyInto(out *ClusterProjectTypeList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterWorkflow, len(*in))
+		*out = make([]ClusterProjectType, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowList.
-func (in *ClusterWorkflowList) DeepCopy() *ClusterWorkflowList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProjectTypeList.
+func (in *ClusterProjectTypeList) DeepCopy() *ClusterProjectTypeList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterWorkflowList)
+	out := new(ClusterProjectTypeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterWorkflowList) DeepCopyObject() runtime.Object {
+func (in *ClusterProjectTypeList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1261,26 +1422,78 @@ func (in *ClusterWorkflowList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflowPlane) DeepCopyInto(out *ClusterWorkflowPlane) {
+func (in *ClusterProjectTypeSpec) DeepCopyInto(out *ClusterProjectTypeSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvironmentConfigs != nil {
+		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validations != nil {
+		in, out := &in.Validations, &out.Validations
+		*out = make([]ValidationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProjectTypeSpec.
+func (in *ClusterProjectTypeSpec) DeepCopy() *ClusterProjectTypeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProjectTypeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProjectTypeStatus) DeepCopyInto(out *ClusterProjectTypeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProjectTypeStatus.
+func (in *ClusterProjectTypeStatus) DeepCopy() *ClusterProjectTypeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProjectTypeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceType) DeepCopyInto(out *ClusterResourceType) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlane.
-func (in *ClusterWorkflowPlane) DeepCopy() *ClusterWorkflowPlane {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceType.
+func (in *ClusterResourceType) DeepCopy() *ClusterResourceType {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterWorkflowPlane)
+	out := new(ClusterResourceType)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterWorkflowPlane) DeepCopyObject() runtime.Object {
+func (in *ClusterResourceType) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1288,31 +1501,31 @@ func (in *ClusterWorkflowPlane) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflowPlaneList) DeepCopyInto(out *ClusterWorkflowPlaneList) {
+func (in *ClusterResourceTypeList) DeepCopyInto(out *ClusterResourceTypeList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterWorkflowPlane, len(*in))
+		*out = make([]ClusterResourceType, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlaneList.
-func (in *ClusterWorkflowPlaneList) DeepCopy() *ClusterWorkflowPlaneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceTypeList.
+func (in *ClusterResourceTypeList) DeepCopy() *ClusterResourceTypeList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterWorkflowPlaneList)
+	out := new(ClusterResourceTypeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterWorkflowPlaneList) DeepCopyObject() runtime.Object {
+func (in *ClusterResourceTypeList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1320,173 +1533,119 @@ func (in *ClusterWorkflowPlaneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflowPlaneRef) DeepCopyInto(out *ClusterWorkflowPlaneRef) {
+func (in *ClusterResourceTypeSpec) DeepCopyInto(out *ClusterResourceTypeSpec) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlaneRef.
-func (in *ClusterWorkflowPlaneRef) DeepCopy() *ClusterWorkflowPlaneRef {
-	if in == nil {
-		return nil
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ClusterWorkflowPlaneRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflowPlaneSpec) DeepCopyInto(out *ClusterWorkflowPlaneSpec) {
-	*out = *in
-	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
-	if in.SecretStoreRef != nil {
-		in, out := &in.SecretStoreRef, &out.SecretStoreRef
-		*out = new(SecretStoreRef)
-		**out = **in
-	}
-	if in.ObservabilityPlaneRef != nil {
-		in, out := &in.ObservabilityPlaneRef, &out.ObservabilityPlaneRef
-		*out = new(ClusterObservabilityPlaneRef)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlaneSpec.
-func (in *ClusterWorkflowPlaneSpec) DeepCopy() *ClusterWorkflowPlaneSpec {
-	if in == nil {
-		return nil
+	if in.EnvironmentConfigs != nil {
+		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ClusterWorkflowPlaneSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflowPlaneStatus) DeepCopyInto(out *ClusterWorkflowPlaneStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]ResourceTypeOutput, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.AgentConnection != nil {
-		in, out := &in.AgentConnection, &out.AgentConnection
-		*out = new(AgentConnectionStatus)
-		(*in).DeepCopyInto(*out)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceTypeManifest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlaneStatus.
-func (in *ClusterWorkflowPlaneStatus) DeepCopy() *ClusterWorkflowPlaneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceTypeSpec.
+func (in *ClusterResourceTypeSpec) DeepCopy() *ClusterResourceTypeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterWorkflowPlaneStatus)
+	out := new(ClusterResourceTypeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflowRef) DeepCopyInto(out *ClusterWorkflowRef) {
+func (in *ClusterResourceTypeStatus) DeepCopyInto(out *ClusterResourceTypeStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowRef.
-func (in *ClusterWorkflowRef) DeepCopy() *ClusterWorkflowRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceTypeStatus.
+func (in *ClusterResourceTypeStatus) DeepCopy() *ClusterResourceTypeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterWorkflowRef)
+	out := new(ClusterResourceTypeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflowSpec) DeepCopyInto(out *ClusterWorkflowSpec) {
+func (in *ClusterRoleMapping) DeepCopyInto(out *ClusterRoleMapping) {
 	*out = *in
-	if in.WorkflowPlaneRef != nil {
-		in, out := &in.WorkflowPlaneRef, &out.WorkflowPlaneRef
-		*out = new(ClusterWorkflowPlaneRef)
-		**out = **in
-	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RunTemplate != nil {
-		in, out := &in.RunTemplate, &out.RunTemplate
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]WorkflowResource, len(*in))
+	out.RoleRef = in.RoleRef
+	out.Scope = in.Scope
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AuthzCondition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ExternalRefs != nil {
-		in, out := &in.ExternalRefs, &out.ExternalRefs
-		*out = make([]ExternalRef, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowSpec.
-func (in *ClusterWorkflowSpec) DeepCopy() *ClusterWorkflowSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRoleMapping.
+func (in *ClusterRoleMapping) DeepCopy() *ClusterRoleMapping {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterWorkflowSpec)
+	out := new(ClusterRoleMapping)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterWorkflowStatus) DeepCopyInto(out *ClusterWorkflowStatus) {
+func (in *ClusterTargetScope) DeepCopyInto(out *ClusterTargetScope) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowStatus.
-func (in *ClusterWorkflowStatus) DeepCopy() *ClusterWorkflowStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTargetScope.
+func (in *ClusterTargetScope) DeepCopy() *ClusterTargetScope {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterWorkflowStatus)
+	out := new(ClusterTargetScope)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Component) DeepCopyInto(out *Component) {
+func (in *ClusterTrait) DeepCopyInto(out *ClusterTrait) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
-func (in *Component) DeepCopy() *Component {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTrait.
+func (in *ClusterTrait) DeepCopy() *ClusterTrait {
 	if in == nil {
 		return nil
 	}
-	out := new(Component)
+	out := new(ClusterTrait)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Component) DeepCopyObject() runtime.Object {
+func (in *ClusterTrait) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1494,31 +1653,31 @@ func (in *Component) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentList) DeepCopyInto(out *ComponentList) {
+func (in *ClusterTraitList) DeepCopyInto(out *ClusterTraitList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Component, len(*in))
+		*out = make([]ClusterTrait, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentList.
-func (in *ComponentList) DeepCopy() *ComponentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTraitList.
+func (in *ClusterTraitList) DeepCopy() *ClusterTraitList {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentList)
+	out := new(ClusterTraitList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ComponentList) DeepCopyObject() runtime.Object {
+func (in *ClusterTraitList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1526,88 +1685,117 @@ func (in *ComponentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentOwner) DeepCopyInto(out *ComponentOwner) {
+func (in *ClusterTraitRef) DeepCopyInto(out *ClusterTraitRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentOwner.
-func (in *ComponentOwner) DeepCopy() *ComponentOwner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTraitRef.
+func (in *ClusterTraitRef) DeepCopy() *ClusterTraitRef {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentOwner)
+	out := new(ClusterTraitRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentProfile) DeepCopyInto(out *ComponentProfile) {
+func (in *ClusterTraitSpec) DeepCopyInto(out *ClusterTraitSpec) {
 	*out = *in
 	if in.Parameters != nil {
 		in, out := &in.Parameters, &out.Parameters
-		*out = new(runtime.RawExtension)
+		*out = new(SchemaSection)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Traits != nil {
-		in, out := &in.Traits, &out.Traits
-		*out = make([]ComponentProfileTrait, len(*in))
+	if in.EnvironmentConfigs != nil {
+		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validations != nil {
+		in, out := &in.Validations, &out.Validations
+		*out = make([]ValidationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreRenderValidations != nil {
+		in, out := &in.PreRenderValidations, &out.PreRenderValidations
+		*out = make([]ValidationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostRenderValidations != nil {
+		in, out := &in.PostRenderValidations, &out.PostRenderValidations
+		*out = make([]PostRenderValidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Creates != nil {
+		in, out := &in.Creates, &out.Creates
+		*out = make([]TraitCreate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]TraitPatch, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Removes != nil {
+		in, out := &in.Removes, &out.Removes
+		*out = make([]TraitRemove, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentProfile.
-func (in *ComponentProfile) DeepCopy() *ComponentProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTraitSpec.
+func (in *ClusterTraitSpec) DeepCopy() *ClusterTraitSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentProfile)
+	out := new(ClusterTraitSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentProfileTrait) DeepCopyInto(out *ComponentProfileTrait) {
+func (in *ClusterTraitStatus) DeepCopyInto(out *ClusterTraitStatus) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentProfileTrait.
-func (in *ComponentProfileTrait) DeepCopy() *ComponentProfileTrait {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTraitStatus.
+func (in *ClusterTraitStatus) DeepCopy() *ClusterTraitStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentProfileTrait)
+	out := new(ClusterTraitStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentRelease) DeepCopyInto(out *ComponentRelease) {
+func (in *ClusterWorkflow) DeepCopyInto(out *ClusterWorkflow) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentRelease.
-func (in *ComponentRelease) DeepCopy() *ComponentRelease {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflow.
+func (in *ClusterWorkflow) DeepCopy() *ClusterWorkflow {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentRelease)
+	out := new(ClusterWorkflow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ComponentRelease) DeepCopyObject() runtime.Object {
+func (in *ClusterWorkflow) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1615,47 +1803,31 @@ func (in *ComponentRelease) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentReleaseComponentType) DeepCopyInto(out *ComponentReleaseComponentType) {
-	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseComponentType.
-func (in *ComponentReleaseComponentType) DeepCopy() *ComponentReleaseComponentType {
-	if in == nil {
-		return nil
-	}
-	out := new(ComponentReleaseComponentType)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentReleaseList) DeepCopyInto(out *ComponentReleaseList) {
+func (in *ClusterWorkflowList) DeepCopyInto(out *ClusterWorkflowList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ComponentRelease, len(*in))
+		*out = make([]ClusterWorkflow, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseList.
-func (in *ComponentReleaseList) DeepCopy() *ComponentReleaseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowList.
+func (in *ClusterWorkflowList) DeepCopy() *ClusterWorkflowList {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentReleaseList)
+	out := new(ClusterWorkflowList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ComponentReleaseList) DeepCopyObject() runtime.Object {
+func (in *ClusterWorkflowList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1663,147 +1835,236 @@ func (in *ComponentReleaseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentReleaseOwner) DeepCopyInto(out *ComponentReleaseOwner) {
+func (in *ClusterWorkflowPlane) DeepCopyInto(out *ClusterWorkflowPlane) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseOwner.
-func (in *ComponentReleaseOwner) DeepCopy() *ComponentReleaseOwner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlane.
+func (in *ClusterWorkflowPlane) DeepCopy() *ClusterWorkflowPlane {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentReleaseOwner)
+	out := new(ClusterWorkflowPlane)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkflowPlane) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentReleaseSpec) DeepCopyInto(out *ComponentReleaseSpec) {
+func (in *ClusterWorkflowPlaneList) DeepCopyInto(out *ClusterWorkflowPlaneList) {
 	*out = *in
-	out.Owner = in.Owner
-	in.ComponentType.DeepCopyInto(&out.ComponentType)
-	if in.Traits != nil {
-		in, out := &in.Traits, &out.Traits
-		*out = make([]ComponentReleaseTrait, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterWorkflowPlane, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ComponentProfile != nil {
-		in, out := &in.ComponentProfile, &out.ComponentProfile
-		*out = new(ComponentProfile)
-		(*in).DeepCopyInto(*out)
-	}
-	in.Workload.DeepCopyInto(&out.Workload)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseSpec.
-func (in *ComponentReleaseSpec) DeepCopy() *ComponentReleaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlaneList.
+func (in *ClusterWorkflowPlaneList) DeepCopy() *ClusterWorkflowPlaneList {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentReleaseSpec)
+	out := new(ClusterWorkflowPlaneList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkflowPlaneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentReleaseStatus) DeepCopyInto(out *ComponentReleaseStatus) {
+func (in *ClusterWorkflowPlaneRef) DeepCopyInto(out *ClusterWorkflowPlaneRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseStatus.
-func (in *ComponentReleaseStatus) DeepCopy() *ComponentReleaseStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlaneRef.
+func (in *ClusterWorkflowPlaneRef) DeepCopy() *ClusterWorkflowPlaneRef {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentReleaseStatus)
+	out := new(ClusterWorkflowPlaneRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentReleaseTrait) DeepCopyInto(out *ComponentReleaseTrait) {
+func (in *ClusterWorkflowPlaneSpec) DeepCopyInto(out *ClusterWorkflowPlaneSpec) {
 	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
+	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
+	if in.SecretStoreRef != nil {
+		in, out := &in.SecretStoreRef, &out.SecretStoreRef
+		*out = new(SecretStoreRef)
+		**out = **in
+	}
+	if in.ObservabilityPlaneRef != nil {
+		in, out := &in.ObservabilityPlaneRef, &out.ObservabilityPlaneRef
+		*out = new(ClusterObservabilityPlaneRef)
+		**out = **in
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(WorkflowSchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BuildNodes != nil {
+		in, out := &in.BuildNodes, &out.BuildNodes
+		*out = new(WorkflowBuildNodesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseTrait.
-func (in *ComponentReleaseTrait) DeepCopy() *ComponentReleaseTrait {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlaneSpec.
+func (in *ClusterWorkflowPlaneSpec) DeepCopy() *ClusterWorkflowPlaneSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentReleaseTrait)
+	out := new(ClusterWorkflowPlaneSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentSource) DeepCopyInto(out *ComponentSource) {
+func (in *ClusterWorkflowPlaneStatus) DeepCopyInto(out *ClusterWorkflowPlaneStatus) {
 	*out = *in
-	if in.GitRepository != nil {
-		in, out := &in.GitRepository, &out.GitRepository
-		*out = new(GitRepository)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ContainerRegistry != nil {
-		in, out := &in.ContainerRegistry, &out.ContainerRegistry
-		*out = new(ContainerRegistry)
+	if in.AgentConnection != nil {
+		in, out := &in.AgentConnection, &out.AgentConnection
+		*out = new(AgentConnectionStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StandbyBuildNodes != nil {
+		in, out := &in.StandbyBuildNodes, &out.StandbyBuildNodes
+		*out = new(StandbyBuildNodesStatus)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSource.
-func (in *ComponentSource) DeepCopy() *ComponentSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlaneStatus.
+func (in *ClusterWorkflowPlaneStatus) DeepCopy() *ClusterWorkflowPlaneStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentSource)
+	out := new(ClusterWorkflowPlaneStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
+func (in *ClusterWorkflowRef) DeepCopyInto(out *ClusterWorkflowRef) {
 	*out = *in
-	out.Owner = in.Owner
-	out.ComponentType = in.ComponentType
-	if in.AutoBuild != nil {
-		in, out := &in.AutoBuild, &out.AutoBuild
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowRef.
+func (in *ClusterWorkflowRef) DeepCopy() *ClusterWorkflowRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkflowRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkflowSpec) DeepCopyInto(out *ClusterWorkflowSpec) {
+	*out = *in
+	if in.WorkflowPlaneRef != nil {
+		in, out := &in.WorkflowPlaneRef, &out.WorkflowPlaneRef
+		*out = new(ClusterWorkflowPlaneRef)
 		**out = **in
 	}
+	if in.WorkflowPlaneSelector != nil {
+		in, out := &in.WorkflowPlaneSelector, &out.WorkflowPlaneSelector
+		*out = new(WorkflowPlaneSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Parameters != nil {
 		in, out := &in.Parameters, &out.Parameters
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunTemplate != nil {
+		in, out := &in.RunTemplate, &out.RunTemplate
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Traits != nil {
-		in, out := &in.Traits, &out.Traits
-		*out = make([]ComponentTrait, len(*in))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]WorkflowResource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Workflow != nil {
-		in, out := &in.Workflow, &out.Workflow
-		*out = new(ComponentWorkflowConfig)
-		(*in).DeepCopyInto(*out)
+	if in.ExternalRefs != nil {
+		in, out := &in.ExternalRefs, &out.ExternalRefs
+		*out = make([]ExternalRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = new(WorkflowTestSpec)
+		**out = **in
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(WorkflowAnalysisSpec)
+		**out = **in
+	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(WorkflowSBOMSpec)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(WorkflowRetryPolicy)
+		**out = **in
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]WorkflowCredential, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSpec.
-func (in *ComponentSpec) DeepCopy() *ComponentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowSpec.
+func (in *ClusterWorkflowSpec) DeepCopy() *ClusterWorkflowSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentSpec)
+	out := new(ClusterWorkflowSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+func (in *ClusterWorkflowStatus) DeepCopyInto(out *ClusterWorkflowStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1812,378 +2073,2230 @@ func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.LatestRelease != nil {
-		in, out := &in.LatestRelease, &out.LatestRelease
-		*out = new(LatestRelease)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowStatus.
+func (in *ClusterWorkflowStatus) DeepCopy() *ClusterWorkflowStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(ClusterWorkflowStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
-func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkflowVersion) DeepCopyInto(out *ClusterWorkflowVersion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowVersion.
+func (in *ClusterWorkflowVersion) DeepCopy() *ClusterWorkflowVersion {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentStatus)
+	out := new(ClusterWorkflowVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkflowVersion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkflowVersionList) DeepCopyInto(out *ClusterWorkflowVersionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterWorkflowVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowVersionList.
+func (in *ClusterWorkflowVersionList) DeepCopy() *ClusterWorkflowVersionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkflowVersionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkflowVersionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentTrait) DeepCopyInto(out *ComponentTrait) {
+func (in *Component) DeepCopyInto(out *Component) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
+func (in *Component) DeepCopy() *Component {
+	if in == nil {
+		return nil
+	}
+	out := new(Component)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Component) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTrait.
-func (in *ComponentTrait) DeepCopy() *ComponentTrait {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentCatalog) DeepCopyInto(out *ComponentCatalog) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentCatalog.
+func (in *ComponentCatalog) DeepCopy() *ComponentCatalog {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentTrait)
+	out := new(ComponentCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentList) DeepCopyInto(out *ComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Component, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentList.
+func (in *ComponentList) DeepCopy() *ComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentOwner) DeepCopyInto(out *ComponentOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentOwner.
+func (in *ComponentOwner) DeepCopy() *ComponentOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentProfile) DeepCopyInto(out *ComponentProfile) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Traits != nil {
+		in, out := &in.Traits, &out.Traits
+		*out = make([]ComponentProfileTrait, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentProfile.
+func (in *ComponentProfile) DeepCopy() *ComponentProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentProfileTrait) DeepCopyInto(out *ComponentProfileTrait) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentProfileTrait.
+func (in *ComponentProfileTrait) DeepCopy() *ComponentProfileTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentProfileTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentRelease) DeepCopyInto(out *ComponentRelease) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentRelease.
+func (in *ComponentRelease) DeepCopy() *ComponentRelease {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentRelease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentRelease) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReleaseComponentType) DeepCopyInto(out *ComponentReleaseComponentType) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseComponentType.
+func (in *ComponentReleaseComponentType) DeepCopy() *ComponentReleaseComponentType {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReleaseComponentType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReleaseList) DeepCopyInto(out *ComponentReleaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComponentRelease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseList.
+func (in *ComponentReleaseList) DeepCopy() *ComponentReleaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReleaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentReleaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReleaseOwner) DeepCopyInto(out *ComponentReleaseOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseOwner.
+func (in *ComponentReleaseOwner) DeepCopy() *ComponentReleaseOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReleaseOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReleaseSpec) DeepCopyInto(out *ComponentReleaseSpec) {
+	*out = *in
+	out.Owner = in.Owner
+	in.ComponentType.DeepCopyInto(&out.ComponentType)
+	if in.Traits != nil {
+		in, out := &in.Traits, &out.Traits
+		*out = make([]ComponentReleaseTrait, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ComponentProfile != nil {
+		in, out := &in.ComponentProfile, &out.ComponentProfile
+		*out = new(ComponentProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Workload.DeepCopyInto(&out.Workload)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseSpec.
+func (in *ComponentReleaseSpec) DeepCopy() *ComponentReleaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReleaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReleaseStatus) DeepCopyInto(out *ComponentReleaseStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseStatus.
+func (in *ComponentReleaseStatus) DeepCopy() *ComponentReleaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReleaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReleaseTrait) DeepCopyInto(out *ComponentReleaseTrait) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReleaseTrait.
+func (in *ComponentReleaseTrait) DeepCopy() *ComponentReleaseTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReleaseTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentSource) DeepCopyInto(out *ComponentSource) {
+	*out = *in
+	if in.GitRepository != nil {
+		in, out := &in.GitRepository, &out.GitRepository
+		*out = new(GitRepository)
+		**out = **in
+	}
+	if in.ContainerRegistry != nil {
+		in, out := &in.ContainerRegistry, &out.ContainerRegistry
+		*out = new(ContainerRegistry)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSource.
+func (in *ComponentSource) DeepCopy() *ComponentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
+	*out = *in
+	out.Owner = in.Owner
+	out.ComponentType = in.ComponentType
+	if in.AutoBuild != nil {
+		in, out := &in.AutoBuild, &out.AutoBuild
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Traits != nil {
+		in, out := &in.Traits, &out.Traits
+		*out = make([]ComponentTrait, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Workflow != nil {
+		in, out := &in.Workflow, &out.Workflow
+		*out = new(ComponentWorkflowConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Catalog != nil {
+		in, out := &in.Catalog, &out.Catalog
+		*out = new(ComponentCatalog)
+		**out = **in
+	}
+	if in.LogParsing != nil {
+		in, out := &in.LogParsing, &out.LogParsing
+		*out = new(LogParsingProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Libraries != nil {
+		in, out := &in.Libraries, &out.Libraries
+		*out = make([]LibraryDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSpec.
+func (in *ComponentSpec) DeepCopy() *ComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LatestRelease != nil {
+		in, out := &in.LatestRelease, &out.LatestRelease
+		*out = new(LatestRelease)
+		**out = **in
+	}
+	if in.LibraryVersions != nil {
+		in, out := &in.LibraryVersions, &out.LibraryVersions
+		*out = make([]LibraryVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTrait) DeepCopyInto(out *ComponentTrait) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTrait.
+func (in *ComponentTrait) DeepCopy() *ComponentTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentType) DeepCopyInto(out *ComponentType) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentType.
+func (in *ComponentType) DeepCopy() *ComponentType {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentType) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTypeList) DeepCopyInto(out *ComponentTypeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComponentType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeList.
+func (in *ComponentTypeList) DeepCopy() *ComponentTypeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTypeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentTypeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTypeRef) DeepCopyInto(out *ComponentTypeRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeRef.
+func (in *ComponentTypeRef) DeepCopy() *ComponentTypeRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTypeRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTypeSpec) DeepCopyInto(out *ComponentTypeSpec) {
+	*out = *in
+	if in.AllowedWorkflows != nil {
+		in, out := &in.AllowedWorkflows, &out.AllowedWorkflows
+		*out = make([]WorkflowRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvironmentConfigs != nil {
+		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Traits != nil {
+		in, out := &in.Traits, &out.Traits
+		*out = make([]ComponentTypeTrait, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedTraits != nil {
+		in, out := &in.AllowedTraits, &out.AllowedTraits
+		*out = make([]TraitRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Validations != nil {
+		in, out := &in.Validations, &out.Validations
+		*out = make([]ValidationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreRenderValidations != nil {
+		in, out := &in.PreRenderValidations, &out.PreRenderValidations
+		*out = make([]ValidationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostRenderValidations != nil {
+		in, out := &in.PostRenderValidations, &out.PostRenderValidations
+		*out = make([]PostRenderValidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DisruptionBudget != nil {
+		in, out := &in.DisruptionBudget, &out.DisruptionBudget
+		*out = new(DisruptionBudgetPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredEnv != nil {
+		in, out := &in.RequiredEnv, &out.RequiredEnv
+		*out = make([]RequiredEnvVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]LifecycleHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QualityGate != nil {
+		in, out := &in.QualityGate, &out.QualityGate
+		*out = new(AnalysisQualityGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentityRequest)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeSpec.
+func (in *ComponentTypeSpec) DeepCopy() *ComponentTypeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTypeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTypeStatus) DeepCopyInto(out *ComponentTypeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeStatus.
+func (in *ComponentTypeStatus) DeepCopy() *ComponentTypeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTypeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTypeTrait) DeepCopyInto(out *ComponentTypeTrait) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvironmentConfigs != nil {
+		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeTrait.
+func (in *ComponentTypeTrait) DeepCopy() *ComponentTypeTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTypeTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentWorkflowConfig) DeepCopyInto(out *ComponentWorkflowConfig) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentWorkflowConfig.
+func (in *ComponentWorkflowConfig) DeepCopy() *ComponentWorkflowConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentWorkflowConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionTransition) DeepCopyInto(out *ConditionTransition) {
+	*out = *in
+	in.TransitionTime.DeepCopyInto(&out.TransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionTransition.
+func (in *ConditionTransition) DeepCopy() *ConditionTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyRef) DeepCopyInto(out *ConfigMapKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyRef.
+func (in *ConfigMapKeyRef) DeepCopy() *ConfigMapKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionEnvBindings) DeepCopyInto(out *ConnectionEnvBindings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionEnvBindings.
+func (in *ConnectionEnvBindings) DeepCopy() *ConnectionEnvBindings {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionEnvBindings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionTarget) DeepCopyInto(out *ConnectionTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionTarget.
+func (in *ConnectionTarget) DeepCopy() *ConnectionTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Container) DeepCopyInto(out *Container) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Container.
+func (in *Container) DeepCopy() *Container {
+	if in == nil {
+		return nil
+	}
+	out := new(Container)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerOverride) DeepCopyInto(out *ContainerOverride) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerOverride.
+func (in *ContainerOverride) DeepCopy() *ContainerOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRegistry) DeepCopyInto(out *ContainerRegistry) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(RegistryAuthentication)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRegistry.
+func (in *ContainerRegistry) DeepCopy() *ContainerRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDomainPolicy) DeepCopyInto(out *CustomDomainPolicy) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainPolicy.
+func (in *CustomDomainPolicy) DeepCopy() *CustomDomainPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDomainPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlane) DeepCopyInto(out *DataPlane) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlane.
+func (in *DataPlane) DeepCopy() *DataPlane {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlane)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataPlane) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneList) DeepCopyInto(out *DataPlaneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataPlane, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneList.
+func (in *DataPlaneList) DeepCopy() *DataPlaneList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataPlaneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneRef) DeepCopyInto(out *DataPlaneRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneRef.
+func (in *DataPlaneRef) DeepCopy() *DataPlaneRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneSpec) DeepCopyInto(out *DataPlaneSpec) {
+	*out = *in
+	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
+	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.SecretStoreRef != nil {
+		in, out := &in.SecretStoreRef, &out.SecretStoreRef
+		*out = new(SecretStoreRef)
+		**out = **in
+	}
+	if in.RegistryCredentials != nil {
+		in, out := &in.RegistryCredentials, &out.RegistryCredentials
+		*out = make([]RegistryCredential, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIVersions != nil {
+		in, out := &in.APIVersions, &out.APIVersions
+		*out = make([]APIVersionPreference, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObservabilityPlaneRef != nil {
+		in, out := &in.ObservabilityPlaneRef, &out.ObservabilityPlaneRef
+		*out = new(ObservabilityPlaneRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSpec.
+func (in *DataPlaneSpec) DeepCopy() *DataPlaneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneStatus) DeepCopyInto(out *DataPlaneStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AgentConnection != nil {
+		in, out := &in.AgentConnection, &out.AgentConnection
+		*out = new(AgentConnectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make([]NodeLabel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneStatus.
+func (in *DataPlaneStatus) DeepCopy() *DataPlaneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentLock) DeepCopyInto(out *DeploymentLock) {
+	*out = *in
+	if in.LockedAt != nil {
+		in, out := &in.LockedAt, &out.LockedAt
+		*out = (*in).DeepCopy()
+	}
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentLock.
+func (in *DeploymentLock) DeepCopy() *DeploymentLock {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPipeline) DeepCopyInto(out *DeploymentPipeline) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipeline.
+func (in *DeploymentPipeline) DeepCopy() *DeploymentPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeploymentPipeline) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPipelineList) DeepCopyInto(out *DeploymentPipelineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeploymentPipeline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineList.
+func (in *DeploymentPipelineList) DeepCopy() *DeploymentPipelineList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPipelineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeploymentPipelineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPipelineRef) DeepCopyInto(out *DeploymentPipelineRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineRef.
+func (in *DeploymentPipelineRef) DeepCopy() *DeploymentPipelineRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPipelineRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPipelineSpec) DeepCopyInto(out *DeploymentPipelineSpec) {
+	*out = *in
+	if in.PromotionPaths != nil {
+		in, out := &in.PromotionPaths, &out.PromotionPaths
+		*out = make([]PromotionPath, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineSpec.
+func (in *DeploymentPipelineSpec) DeepCopy() *DeploymentPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPipelineStatus) DeepCopyInto(out *DeploymentPipelineStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineStatus.
+func (in *DeploymentPipelineStatus) DeepCopy() *DeploymentPipelineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPipelineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentRecord) DeepCopyInto(out *DeploymentRecord) {
+	*out = *in
+	in.ReleaseCreatedAt.DeepCopyInto(&out.ReleaseCreatedAt)
+	in.DeployedAt.DeepCopyInto(&out.DeployedAt)
+	if in.ReadyAt != nil {
+		in, out := &in.ReadyAt, &out.ReadyAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FailedAt != nil {
+		in, out := &in.FailedAt, &out.FailedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RestoredAt != nil {
+		in, out := &in.RestoredAt, &out.RestoredAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentRecord.
+func (in *DeploymentRecord) DeepCopy() *DeploymentRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisruptionBudgetPolicy) DeepCopyInto(out *DisruptionBudgetPolicy) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptionBudgetPolicy.
+func (in *DisruptionBudgetPolicy) DeepCopy() *DisruptionBudgetPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DisruptionBudgetPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainCertificateStatus) DeepCopyInto(out *DomainCertificateStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCertificateStatus.
+func (in *DomainCertificateStatus) DeepCopy() *DomainCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMapping) DeepCopyInto(out *DomainMapping) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMapping.
+func (in *DomainMapping) DeepCopy() *DomainMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DomainMapping) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMappingList) DeepCopyInto(out *DomainMappingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DomainMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMappingList.
+func (in *DomainMappingList) DeepCopy() *DomainMappingList {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMappingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DomainMappingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMappingOwner) DeepCopyInto(out *DomainMappingOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMappingOwner.
+func (in *DomainMappingOwner) DeepCopy() *DomainMappingOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMappingOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMappingSpec) DeepCopyInto(out *DomainMappingSpec) {
+	*out = *in
+	out.Owner = in.Owner
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMappingSpec.
+func (in *DomainMappingSpec) DeepCopy() *DomainMappingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMappingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMappingStatus) DeepCopyInto(out *DomainMappingStatus) {
+	*out = *in
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(DomainVerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(DomainCertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMappingStatus.
+func (in *DomainMappingStatus) DeepCopy() *DomainMappingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMappingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainVerificationStatus) DeepCopyInto(out *DomainVerificationStatus) {
+	*out = *in
+	if in.VerifiedAt != nil {
+		in, out := &in.VerifiedAt, &out.VerifiedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCheckedAt != nil {
+		in, out := &in.LastCheckedAt, &out.LastCheckedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainVerificationStatus.
+func (in *DomainVerificationStatus) DeepCopy() *DomainVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailConfig) DeepCopyInto(out *EmailConfig) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.SMTP.DeepCopyInto(&out.SMTP)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(EmailTemplate)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailConfig.
+func (in *EmailConfig) DeepCopy() *EmailConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailTemplate) DeepCopyInto(out *EmailTemplate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailTemplate.
+func (in *EmailTemplate) DeepCopy() *EmailTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointAccess) DeepCopyInto(out *EndpointAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointAccess.
+func (in *EndpointAccess) DeepCopy() *EndpointAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointGatewayURLs) DeepCopyInto(out *EndpointGatewayURLs) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(EndpointURL)
+		**out = **in
+	}
+	if in.HTTPS != nil {
+		in, out := &in.HTTPS, &out.HTTPS
+		*out = new(EndpointURL)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(EndpointURL)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointGatewayURLs.
+func (in *EndpointGatewayURLs) DeepCopy() *EndpointGatewayURLs {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointGatewayURLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
+	*out = *in
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(EndpointAccess)
+		**out = **in
+	}
+	if in.Organization != nil {
+		in, out := &in.Organization, &out.Organization
+		*out = new(EndpointAccess)
+		**out = **in
+	}
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = new(EndpointAccess)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointStatus.
+func (in *EndpointStatus) DeepCopy() *EndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointURL) DeepCopyInto(out *EndpointURL) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointURL.
+func (in *EndpointURL) DeepCopy() *EndpointURL {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointURL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointURLStatus) DeepCopyInto(out *EndpointURLStatus) {
+	*out = *in
+	if in.ServiceURL != nil {
+		in, out := &in.ServiceURL, &out.ServiceURL
+		*out = new(EndpointURL)
+		**out = **in
+	}
+	if in.InternalURLs != nil {
+		in, out := &in.InternalURLs, &out.InternalURLs
+		*out = new(EndpointGatewayURLs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalURLs != nil {
+		in, out := &in.ExternalURLs, &out.ExternalURLs
+		*out = new(EndpointGatewayURLs)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointURLStatus.
+func (in *EndpointURLStatus) DeepCopy() *EndpointURLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointURLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntitlementClaim) DeepCopyInto(out *EntitlementClaim) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementClaim.
+func (in *EntitlementClaim) DeepCopy() *EntitlementClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(EntitlementClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvVar) DeepCopyInto(out *EnvVar) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(EnvVarValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar.
+func (in *EnvVar) DeepCopy() *EnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvVarValueFrom) DeepCopyInto(out *EnvVarValueFrom) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVarValueFrom.
+func (in *EnvVarValueFrom) DeepCopy() *EnvVarValueFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvVarValueFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Environment) DeepCopyInto(out *Environment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Environment.
+func (in *Environment) DeepCopy() *Environment {
+	if in == nil {
+		return nil
+	}
+	out := new(Environment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Environment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentList) DeepCopyInto(out *EnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Environment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentList.
+func (in *EnvironmentList) DeepCopy() *EnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentPriority) DeepCopyInto(out *EnvironmentPriority) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreemptionPolicy != nil {
+		in, out := &in.PreemptionPolicy, &out.PreemptionPolicy
+		*out = new(corev1.PreemptionPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentPriority.
+func (in *EnvironmentPriority) DeepCopy() *EnvironmentPriority {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentPriority)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentRef) DeepCopyInto(out *EnvironmentRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentRef.
+func (in *EnvironmentRef) DeepCopy() *EnvironmentRef {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+	*out = *in
+	if in.DataPlaneRef != nil {
+		in, out := &in.DataPlaneRef, &out.DataPlaneRef
+		*out = new(DataPlaneRef)
+		**out = **in
+	}
+	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollbackPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(EnvironmentPriority)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomDomains != nil {
+		in, out := &in.CustomDomains, &out.CustomDomains
+		*out = new(CustomDomainPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
+func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DataPlaneMigration != nil {
+		in, out := &in.DataPlaneMigration, &out.DataPlaneMigration
+		*out = new(DataPlaneRef)
+		**out = **in
+	}
+	if in.Teardown != nil {
+		in, out := &in.Teardown, &out.Teardown
+		*out = new(EnvironmentTeardown)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentStatus.
+func (in *EnvironmentStatus) DeepCopy() *EnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentTeardown) DeepCopyInto(out *EnvironmentTeardown) {
+	*out = *in
+	if in.PendingNamespaces != nil {
+		in, out := &in.PendingNamespaces, &out.PendingNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentTeardown.
+func (in *EnvironmentTeardown) DeepCopy() *EnvironmentTeardown {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentTeardown)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorBudgetStatus) DeepCopyInto(out *ErrorBudgetStatus) {
+	*out = *in
+	if in.ObservedLatency != nil {
+		in, out := &in.ObservedLatency, &out.ObservedLatency
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LatencyMet != nil {
+		in, out := &in.LatencyMet, &out.LatencyMet
+		*out = new(bool)
+		**out = **in
+	}
+	in.EvaluatedAt.DeepCopyInto(&out.EvaluatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorBudgetStatus.
+func (in *ErrorBudgetStatus) DeepCopy() *ErrorBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalRef) DeepCopyInto(out *ExternalRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalRef.
+func (in *ExternalRef) DeepCopy() *ExternalRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldManagerConflict) DeepCopyInto(out *FieldManagerConflict) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldManagerConflict.
+func (in *FieldManagerConflict) DeepCopy() *FieldManagerConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldManagerConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileVar) DeepCopyInto(out *FileVar) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(EnvVarValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileVar.
+func (in *FileVar) DeepCopy() *FileVar {
+	if in == nil {
+		return nil
+	}
+	out := new(FileVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPWorkloadIdentity) DeepCopyInto(out *GCPWorkloadIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPWorkloadIdentity.
+func (in *GCPWorkloadIdentity) DeepCopy() *GCPWorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPWorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayEndpointSpec) DeepCopyInto(out *GatewayEndpointSpec) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(GatewayListenerSpec)
+		**out = **in
+	}
+	if in.HTTPS != nil {
+		in, out := &in.HTTPS, &out.HTTPS
+		*out = new(GatewayListenerSpec)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(GatewayListenerSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayEndpointSpec.
+func (in *GatewayEndpointSpec) DeepCopy() *GatewayEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayListenerSpec) DeepCopyInto(out *GatewayListenerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayListenerSpec.
+func (in *GatewayListenerSpec) DeepCopy() *GatewayListenerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayListenerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayNetworkSpec) DeepCopyInto(out *GatewayNetworkSpec) {
+	*out = *in
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(GatewayEndpointSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(GatewayEndpointSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayNetworkSpec.
+func (in *GatewayNetworkSpec) DeepCopy() *GatewayNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewaySpec) DeepCopyInto(out *GatewaySpec) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(GatewayNetworkSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(GatewayNetworkSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewaySpec.
+func (in *GatewaySpec) DeepCopy() *GatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentType) DeepCopyInto(out *ComponentType) {
+func (in *GitAuthentication) DeepCopyInto(out *GitAuthentication) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentType.
-func (in *ComponentType) DeepCopy() *ComponentType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitAuthentication.
+func (in *GitAuthentication) DeepCopy() *GitAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentType)
+	out := new(GitAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ComponentType) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentTypeList) DeepCopyInto(out *ComponentTypeList) {
+func (in *GitRepository) DeepCopyInto(out *GitRepository) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ComponentType, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.Authentication = in.Authentication
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeList.
-func (in *ComponentTypeList) DeepCopy() *ComponentTypeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepository.
+func (in *GitRepository) DeepCopy() *GitRepository {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentTypeList)
+	out := new(GitRepository)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ComponentTypeList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentTypeRef) DeepCopyInto(out *ComponentTypeRef) {
+func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
 	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeRef.
-func (in *ComponentTypeRef) DeepCopy() *ComponentTypeRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPatchOperation.
+func (in *JSONPatchOperation) DeepCopy() *JSONPatchOperation {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentTypeRef)
+	out := new(JSONPatchOperation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentTypeSpec) DeepCopyInto(out *ComponentTypeSpec) {
+func (in *KafkaTopicProvisioning) DeepCopyInto(out *KafkaTopicProvisioning) {
 	*out = *in
-	if in.AllowedWorkflows != nil {
-		in, out := &in.AllowedWorkflows, &out.AllowedWorkflows
-		*out = make([]WorkflowRef, len(*in))
-		copy(*out, *in)
-	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EnvironmentConfigs != nil {
-		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Traits != nil {
-		in, out := &in.Traits, &out.Traits
-		*out = make([]ComponentTypeTrait, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.AllowedTraits != nil {
-		in, out := &in.AllowedTraits, &out.AllowedTraits
-		*out = make([]TraitRef, len(*in))
-		copy(*out, *in)
-	}
-	if in.Validations != nil {
-		in, out := &in.Validations, &out.Validations
-		*out = make([]ValidationRule, len(*in))
-		copy(*out, *in)
-	}
-	if in.PreRenderValidations != nil {
-		in, out := &in.PreRenderValidations, &out.PreRenderValidations
-		*out = make([]ValidationRule, len(*in))
-		copy(*out, *in)
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = new(int32)
+		**out = **in
 	}
-	if in.PostRenderValidations != nil {
-		in, out := &in.PostRenderValidations, &out.PostRenderValidations
-		*out = make([]PostRenderValidation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]ResourceTemplate, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeSpec.
-func (in *ComponentTypeSpec) DeepCopy() *ComponentTypeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicProvisioning.
+func (in *KafkaTopicProvisioning) DeepCopy() *KafkaTopicProvisioning {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentTypeSpec)
+	out := new(KafkaTopicProvisioning)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentTypeStatus) DeepCopyInto(out *ComponentTypeStatus) {
+func (in *KarpenterBuildNodes) DeepCopyInto(out *KarpenterBuildNodes) {
 	*out = *in
+	if in.DoNotDisrupt != nil {
+		in, out := &in.DoNotDisrupt, &out.DoNotDisrupt
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeStatus.
-func (in *ComponentTypeStatus) DeepCopy() *ComponentTypeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterBuildNodes.
+func (in *KarpenterBuildNodes) DeepCopy() *KarpenterBuildNodes {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentTypeStatus)
+	out := new(KarpenterBuildNodes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentTypeTrait) DeepCopyInto(out *ComponentTypeTrait) {
+func (in *LatencyObjective) DeepCopyInto(out *LatencyObjective) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EnvironmentConfigs != nil {
-		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
+	out.Threshold = in.Threshold
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTypeTrait.
-func (in *ComponentTypeTrait) DeepCopy() *ComponentTypeTrait {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyObjective.
+func (in *LatencyObjective) DeepCopy() *LatencyObjective {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentTypeTrait)
+	out := new(LatencyObjective)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComponentWorkflowConfig) DeepCopyInto(out *ComponentWorkflowConfig) {
+func (in *LatestProjectRelease) DeepCopyInto(out *LatestProjectRelease) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentWorkflowConfig.
-func (in *ComponentWorkflowConfig) DeepCopy() *ComponentWorkflowConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatestProjectRelease.
+func (in *LatestProjectRelease) DeepCopy() *LatestProjectRelease {
 	if in == nil {
 		return nil
 	}
-	out := new(ComponentWorkflowConfig)
+	out := new(LatestProjectRelease)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigMapKeyRef) DeepCopyInto(out *ConfigMapKeyRef) {
+func (in *LatestRelease) DeepCopyInto(out *LatestRelease) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyRef.
-func (in *ConfigMapKeyRef) DeepCopy() *ConfigMapKeyRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatestRelease.
+func (in *LatestRelease) DeepCopy() *LatestRelease {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigMapKeyRef)
+	out := new(LatestRelease)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionEnvBindings) DeepCopyInto(out *ConnectionEnvBindings) {
+func (in *LatestResourceRelease) DeepCopyInto(out *LatestResourceRelease) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionEnvBindings.
-func (in *ConnectionEnvBindings) DeepCopy() *ConnectionEnvBindings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatestResourceRelease.
+func (in *LatestResourceRelease) DeepCopy() *LatestResourceRelease {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionEnvBindings)
+	out := new(LatestResourceRelease)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionTarget) DeepCopyInto(out *ConnectionTarget) {
+func (in *LibraryDependency) DeepCopyInto(out *LibraryDependency) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionTarget.
-func (in *ConnectionTarget) DeepCopy() *ConnectionTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LibraryDependency.
+func (in *LibraryDependency) DeepCopy() *LibraryDependency {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionTarget)
+	out := new(LibraryDependency)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Container) DeepCopyInto(out *Container) {
+func (in *LibraryVersion) DeepCopyInto(out *LibraryVersion) {
 	*out = *in
-	if in.Command != nil {
-		in, out := &in.Command, &out.Command
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Args != nil {
-		in, out := &in.Args, &out.Args
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Files != nil {
-		in, out := &in.Files, &out.Files
-		*out = make([]FileVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.PublishedAt.DeepCopyInto(&out.PublishedAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Container.
-func (in *Container) DeepCopy() *Container {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LibraryVersion.
+func (in *LibraryVersion) DeepCopy() *LibraryVersion {
 	if in == nil {
 		return nil
 	}
-	out := new(Container)
+	out := new(LibraryVersion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerOverride) DeepCopyInto(out *ContainerOverride) {
+func (in *LifecycleHook) DeepCopyInto(out *LifecycleHook) {
 	*out = *in
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Files != nil {
-		in, out := &in.Files, &out.Files
-		*out = make([]FileVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerOverride.
-func (in *ContainerOverride) DeepCopy() *ContainerOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleHook.
+func (in *LifecycleHook) DeepCopy() *LifecycleHook {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerOverride)
+	out := new(LifecycleHook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerRegistry) DeepCopyInto(out *ContainerRegistry) {
+func (in *LifecycleHookStatus) DeepCopyInto(out *LifecycleHookStatus) {
 	*out = *in
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(RegistryAuthentication)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRegistry.
-func (in *ContainerRegistry) DeepCopy() *ContainerRegistry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleHookStatus.
+func (in *LifecycleHookStatus) DeepCopy() *LifecycleHookStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerRegistry)
+	out := new(LifecycleHookStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlane) DeepCopyInto(out *DataPlane) {
+func (in *LogMetric) DeepCopyInto(out *LogMetric) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2191,18 +4304,18 @@ func (in *DataPlane) DeepCopyInto(out *DataPlane) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlane.
-func (in *DataPlane) DeepCopy() *DataPlane {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetric.
+func (in *LogMetric) DeepCopy() *LogMetric {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlane)
+	out := new(LogMetric)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataPlane) DeepCopyObject() runtime.Object {
+func (in *LogMetric) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2210,31 +4323,31 @@ func (in *DataPlane) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlaneList) DeepCopyInto(out *DataPlaneList) {
+func (in *LogMetricList) DeepCopyInto(out *LogMetricList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DataPlane, len(*in))
+		*out = make([]LogMetric, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneList.
-func (in *DataPlaneList) DeepCopy() *DataPlaneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetricList.
+func (in *LogMetricList) DeepCopy() *LogMetricList {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlaneList)
+	out := new(LogMetricList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataPlaneList) DeepCopyObject() runtime.Object {
+func (in *LogMetricList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2242,50 +4355,55 @@ func (in *DataPlaneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlaneRef) DeepCopyInto(out *DataPlaneRef) {
+func (in *LogMetricOwner) DeepCopyInto(out *LogMetricOwner) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneRef.
-func (in *DataPlaneRef) DeepCopy() *DataPlaneRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetricOwner.
+func (in *LogMetricOwner) DeepCopy() *LogMetricOwner {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlaneRef)
+	out := new(LogMetricOwner)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlaneSpec) DeepCopyInto(out *DataPlaneSpec) {
+func (in *LogMetricSpec) DeepCopyInto(out *LogMetricSpec) {
 	*out = *in
-	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
-	in.Gateway.DeepCopyInto(&out.Gateway)
-	if in.SecretStoreRef != nil {
-		in, out := &in.SecretStoreRef, &out.SecretStoreRef
-		*out = new(SecretStoreRef)
-		**out = **in
+	out.Owner = in.Owner
+	if in.LogLevels != nil {
+		in, out := &in.LogLevels, &out.LogLevels
+		*out = make([]LogLevel, len(*in))
+		copy(*out, *in)
 	}
-	if in.ObservabilityPlaneRef != nil {
-		in, out := &in.ObservabilityPlaneRef, &out.ObservabilityPlaneRef
-		*out = new(ObservabilityPlaneRef)
-		**out = **in
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	out.Window = in.Window
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSpec.
-func (in *DataPlaneSpec) DeepCopy() *DataPlaneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetricSpec.
+func (in *LogMetricSpec) DeepCopy() *LogMetricSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlaneSpec)
+	out := new(LogMetricSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlaneStatus) DeepCopyInto(out *DataPlaneStatus) {
+func (in *LogMetricStatus) DeepCopyInto(out *LogMetricStatus) {
 	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(LogMetricValue)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -2293,359 +4411,404 @@ func (in *DataPlaneStatus) DeepCopyInto(out *DataPlaneStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.AgentConnection != nil {
-		in, out := &in.AgentConnection, &out.AgentConnection
-		*out = new(AgentConnectionStatus)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneStatus.
-func (in *DataPlaneStatus) DeepCopy() *DataPlaneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetricStatus.
+func (in *LogMetricStatus) DeepCopy() *LogMetricStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlaneStatus)
+	out := new(LogMetricStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentPipeline) DeepCopyInto(out *DeploymentPipeline) {
+func (in *LogMetricValue) DeepCopyInto(out *LogMetricValue) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.EvaluatedAt.DeepCopyInto(&out.EvaluatedAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipeline.
-func (in *DeploymentPipeline) DeepCopy() *DeploymentPipeline {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetricValue.
+func (in *LogMetricValue) DeepCopy() *LogMetricValue {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentPipeline)
+	out := new(LogMetricValue)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeploymentPipeline) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogMultilineConfig) DeepCopyInto(out *LogMultilineConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMultilineConfig.
+func (in *LogMultilineConfig) DeepCopy() *LogMultilineConfig {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(LogMultilineConfig)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentPipelineList) DeepCopyInto(out *DeploymentPipelineList) {
+func (in *LogParsingProfile) DeepCopyInto(out *LogParsingProfile) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DeploymentPipeline, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
+	if in.Multiline != nil {
+		in, out := &in.Multiline, &out.Multiline
+		*out = new(LogMultilineConfig)
+		**out = **in
+	}
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(LogSeverityConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineList.
-func (in *DeploymentPipelineList) DeepCopy() *DeploymentPipelineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogParsingProfile.
+func (in *LogParsingProfile) DeepCopy() *LogParsingProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentPipelineList)
+	out := new(LogParsingProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeploymentPipelineList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSeverityConfig) DeepCopyInto(out *LogSeverityConfig) {
+	*out = *in
+	if in.Mapping != nil {
+		in, out := &in.Mapping, &out.Mapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSeverityConfig.
+func (in *LogSeverityConfig) DeepCopy() *LogSeverityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSeverityConfig)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentPipelineRef) DeepCopyInto(out *DeploymentPipelineRef) {
+func (in *MaintenanceMode) DeepCopyInto(out *MaintenanceMode) {
 	*out = *in
+	if in.EnabledAt != nil {
+		in, out := &in.EnabledAt, &out.EnabledAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineRef.
-func (in *DeploymentPipelineRef) DeepCopy() *DeploymentPipelineRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceMode.
+func (in *MaintenanceMode) DeepCopy() *MaintenanceMode {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentPipelineRef)
+	out := new(MaintenanceMode)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentPipelineSpec) DeepCopyInto(out *DeploymentPipelineSpec) {
+func (in *MissingResource) DeepCopyInto(out *MissingResource) {
 	*out = *in
-	if in.PromotionPaths != nil {
-		in, out := &in.PromotionPaths, &out.PromotionPaths
-		*out = make([]PromotionPath, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.Plane = in.Plane
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineSpec.
-func (in *DeploymentPipelineSpec) DeepCopy() *DeploymentPipelineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissingResource.
+func (in *MissingResource) DeepCopy() *MissingResource {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentPipelineSpec)
+	out := new(MissingResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeploymentPipelineStatus) DeepCopyInto(out *DeploymentPipelineStatus) {
+func (in *NamespaceWeight) DeepCopyInto(out *NamespaceWeight) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPipelineStatus.
-func (in *DeploymentPipelineStatus) DeepCopy() *DeploymentPipelineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceWeight.
+func (in *NamespaceWeight) DeepCopy() *NamespaceWeight {
 	if in == nil {
 		return nil
 	}
-	out := new(DeploymentPipelineStatus)
+	out := new(NamespaceWeight)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EmailConfig) DeepCopyInto(out *EmailConfig) {
+func (in *NodeLabel) DeepCopyInto(out *NodeLabel) {
 	*out = *in
-	if in.To != nil {
-		in, out := &in.To, &out.To
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	in.SMTP.DeepCopyInto(&out.SMTP)
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(EmailTemplate)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailConfig.
-func (in *EmailConfig) DeepCopy() *EmailConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLabel.
+func (in *NodeLabel) DeepCopy() *NodeLabel {
 	if in == nil {
 		return nil
 	}
-	out := new(EmailConfig)
+	out := new(NodeLabel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EmailTemplate) DeepCopyInto(out *EmailTemplate) {
+func (in *NotificationChannelConfig) DeepCopyInto(out *NotificationChannelConfig) {
 	*out = *in
+	in.EmailConfig.DeepCopyInto(&out.EmailConfig)
+	in.WebhookConfig.DeepCopyInto(&out.WebhookConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailTemplate.
-func (in *EmailTemplate) DeepCopy() *EmailTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelConfig.
+func (in *NotificationChannelConfig) DeepCopy() *NotificationChannelConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EmailTemplate)
+	out := new(NotificationChannelConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointAccess) DeepCopyInto(out *EndpointAccess) {
+func (in *ObjectMigration) DeepCopyInto(out *ObjectMigration) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointAccess.
-func (in *EndpointAccess) DeepCopy() *EndpointAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectMigration.
+func (in *ObjectMigration) DeepCopy() *ObjectMigration {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointAccess)
+	out := new(ObjectMigration)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointGatewayURLs) DeepCopyInto(out *EndpointGatewayURLs) {
+func (in *ObjectMigrationFailure) DeepCopyInto(out *ObjectMigrationFailure) {
 	*out = *in
-	if in.HTTP != nil {
-		in, out := &in.HTTP, &out.HTTP
-		*out = new(EndpointURL)
-		**out = **in
-	}
-	if in.HTTPS != nil {
-		in, out := &in.HTTPS, &out.HTTPS
-		*out = new(EndpointURL)
-		**out = **in
-	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(EndpointURL)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointGatewayURLs.
-func (in *EndpointGatewayURLs) DeepCopy() *EndpointGatewayURLs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectMigrationFailure.
+func (in *ObjectMigrationFailure) DeepCopy() *ObjectMigrationFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointGatewayURLs)
+	out := new(ObjectMigrationFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
+func (in *ObjectMigrationList) DeepCopyInto(out *ObjectMigrationList) {
 	*out = *in
-	if in.Project != nil {
-		in, out := &in.Project, &out.Project
-		*out = new(EndpointAccess)
-		**out = **in
-	}
-	if in.Organization != nil {
-		in, out := &in.Organization, &out.Organization
-		*out = new(EndpointAccess)
-		**out = **in
-	}
-	if in.Public != nil {
-		in, out := &in.Public, &out.Public
-		*out = new(EndpointAccess)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointStatus.
-func (in *EndpointStatus) DeepCopy() *EndpointStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectMigrationList.
+func (in *ObjectMigrationList) DeepCopy() *ObjectMigrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointStatus)
+	out := new(ObjectMigrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointURL) DeepCopyInto(out *EndpointURL) {
+func (in *ObjectMigrationSpec) DeepCopyInto(out *ObjectMigrationSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointURL.
-func (in *EndpointURL) DeepCopy() *EndpointURL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectMigrationSpec.
+func (in *ObjectMigrationSpec) DeepCopy() *ObjectMigrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointURL)
+	out := new(ObjectMigrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointURLStatus) DeepCopyInto(out *EndpointURLStatus) {
+func (in *ObjectMigrationStatus) DeepCopyInto(out *ObjectMigrationStatus) {
 	*out = *in
-	if in.ServiceURL != nil {
-		in, out := &in.ServiceURL, &out.ServiceURL
-		*out = new(EndpointURL)
-		**out = **in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.InternalURLs != nil {
-		in, out := &in.InternalURLs, &out.InternalURLs
-		*out = new(EndpointGatewayURLs)
-		(*in).DeepCopyInto(*out)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
-	if in.ExternalURLs != nil {
-		in, out := &in.ExternalURLs, &out.ExternalURLs
-		*out = new(EndpointGatewayURLs)
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]ObjectMigrationFailure, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectMigrationStatus.
+func (in *ObjectMigrationStatus) DeepCopy() *ObjectMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityAlertActions) DeepCopyInto(out *ObservabilityAlertActions) {
+	*out = *in
+	in.Notifications.DeepCopyInto(&out.Notifications)
+	if in.Incident != nil {
+		in, out := &in.Incident, &out.Incident
+		*out = new(ObservabilityAlertIncident)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointURLStatus.
-func (in *EndpointURLStatus) DeepCopy() *EndpointURLStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertActions.
+func (in *ObservabilityAlertActions) DeepCopy() *ObservabilityAlertActions {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointURLStatus)
+	out := new(ObservabilityAlertActions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EntitlementClaim) DeepCopyInto(out *EntitlementClaim) {
+func (in *ObservabilityAlertCondition) DeepCopyInto(out *ObservabilityAlertCondition) {
 	*out = *in
+	out.Window = in.Window
+	out.Interval = in.Interval
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementClaim.
-func (in *EntitlementClaim) DeepCopy() *EntitlementClaim {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertCondition.
+func (in *ObservabilityAlertCondition) DeepCopy() *ObservabilityAlertCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(EntitlementClaim)
+	out := new(ObservabilityAlertCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvVar) DeepCopyInto(out *EnvVar) {
+func (in *ObservabilityAlertIncident) DeepCopyInto(out *ObservabilityAlertIncident) {
 	*out = *in
-	if in.ValueFrom != nil {
-		in, out := &in.ValueFrom, &out.ValueFrom
-		*out = new(EnvVarValueFrom)
-		(*in).DeepCopyInto(*out)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TriggerAiRca != nil {
+		in, out := &in.TriggerAiRca, &out.TriggerAiRca
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TriggerAiCostAnalysis != nil {
+		in, out := &in.TriggerAiCostAnalysis, &out.TriggerAiCostAnalysis
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar.
-func (in *EnvVar) DeepCopy() *EnvVar {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertIncident.
+func (in *ObservabilityAlertIncident) DeepCopy() *ObservabilityAlertIncident {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvVar)
+	out := new(ObservabilityAlertIncident)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvVarValueFrom) DeepCopyInto(out *EnvVarValueFrom) {
+func (in *ObservabilityAlertNotifications) DeepCopyInto(out *ObservabilityAlertNotifications) {
 	*out = *in
-	if in.SecretKeyRef != nil {
-		in, out := &in.SecretKeyRef, &out.SecretKeyRef
-		*out = new(SecretKeyRef)
-		**out = **in
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]NotificationChannelName, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVarValueFrom.
-func (in *EnvVarValueFrom) DeepCopy() *EnvVarValueFrom {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertNotifications.
+func (in *ObservabilityAlertNotifications) DeepCopy() *ObservabilityAlertNotifications {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvVarValueFrom)
+	out := new(ObservabilityAlertNotifications)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Environment) DeepCopyInto(out *Environment) {
+func (in *ObservabilityAlertRule) DeepCopyInto(out *ObservabilityAlertRule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2653,18 +4816,18 @@ func (in *Environment) DeepCopyInto(out *Environment) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Environment.
-func (in *Environment) DeepCopy() *Environment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertRule.
+func (in *ObservabilityAlertRule) DeepCopy() *ObservabilityAlertRule {
 	if in == nil {
 		return nil
 	}
-	out := new(Environment)
+	out := new(ObservabilityAlertRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Environment) DeepCopyObject() runtime.Object {
+func (in *ObservabilityAlertRule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2672,31 +4835,31 @@ func (in *Environment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentList) DeepCopyInto(out *EnvironmentList) {
+func (in *ObservabilityAlertRuleList) DeepCopyInto(out *ObservabilityAlertRuleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Environment, len(*in))
+		*out = make([]ObservabilityAlertRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentList.
-func (in *EnvironmentList) DeepCopy() *EnvironmentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertRuleList.
+func (in *ObservabilityAlertRuleList) DeepCopy() *ObservabilityAlertRuleList {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentList)
+	out := new(ObservabilityAlertRuleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EnvironmentList) DeepCopyObject() runtime.Object {
+func (in *ObservabilityAlertRuleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2704,44 +4867,39 @@ func (in *EnvironmentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentRef) DeepCopyInto(out *EnvironmentRef) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentRef.
-func (in *EnvironmentRef) DeepCopy() *EnvironmentRef {
-	if in == nil {
-		return nil
-	}
-	out := new(EnvironmentRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+func (in *ObservabilityAlertRuleSpec) DeepCopyInto(out *ObservabilityAlertRuleSpec) {
 	*out = *in
-	if in.DataPlaneRef != nil {
-		in, out := &in.DataPlaneRef, &out.DataPlaneRef
-		*out = new(DataPlaneRef)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	in.Gateway.DeepCopyInto(&out.Gateway)
+	out.Source = in.Source
+	out.Condition = in.Condition
+	in.Actions.DeepCopyInto(&out.Actions)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
-func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertRuleSpec.
+func (in *ObservabilityAlertRuleSpec) DeepCopy() *ObservabilityAlertRuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentSpec)
+	out := new(ObservabilityAlertRuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
+func (in *ObservabilityAlertRuleStatus) DeepCopyInto(out *ObservabilityAlertRuleStatus) {
 	*out = *in
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -2751,349 +4909,344 @@ func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentStatus.
-func (in *EnvironmentStatus) DeepCopy() *EnvironmentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertRuleStatus.
+func (in *ObservabilityAlertRuleStatus) DeepCopy() *ObservabilityAlertRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentStatus)
+	out := new(ObservabilityAlertRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalRef) DeepCopyInto(out *ExternalRef) {
+func (in *ObservabilityAlertSource) DeepCopyInto(out *ObservabilityAlertSource) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalRef.
-func (in *ExternalRef) DeepCopy() *ExternalRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertSource.
+func (in *ObservabilityAlertSource) DeepCopy() *ObservabilityAlertSource {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalRef)
+	out := new(ObservabilityAlertSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileVar) DeepCopyInto(out *FileVar) {
+func (in *ObservabilityAlertsNotificationChannel) DeepCopyInto(out *ObservabilityAlertsNotificationChannel) {
 	*out = *in
-	if in.ValueFrom != nil {
-		in, out := &in.ValueFrom, &out.ValueFrom
-		*out = new(EnvVarValueFrom)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileVar.
-func (in *FileVar) DeepCopy() *FileVar {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertsNotificationChannel.
+func (in *ObservabilityAlertsNotificationChannel) DeepCopy() *ObservabilityAlertsNotificationChannel {
 	if in == nil {
 		return nil
 	}
-	out := new(FileVar)
+	out := new(ObservabilityAlertsNotificationChannel)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityAlertsNotificationChannel) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayEndpointSpec) DeepCopyInto(out *GatewayEndpointSpec) {
+func (in *ObservabilityAlertsNotificationChannelList) DeepCopyInto(out *ObservabilityAlertsNotificationChannelList) {
 	*out = *in
-	if in.HTTP != nil {
-		in, out := &in.HTTP, &out.HTTP
-		*out = new(GatewayListenerSpec)
-		**out = **in
-	}
-	if in.HTTPS != nil {
-		in, out := &in.HTTPS, &out.HTTPS
-		*out = new(GatewayListenerSpec)
-		**out = **in
-	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(GatewayListenerSpec)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObservabilityAlertsNotificationChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayEndpointSpec.
-func (in *GatewayEndpointSpec) DeepCopy() *GatewayEndpointSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertsNotificationChannelList.
+func (in *ObservabilityAlertsNotificationChannelList) DeepCopy() *ObservabilityAlertsNotificationChannelList {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayEndpointSpec)
+	out := new(ObservabilityAlertsNotificationChannelList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayListenerSpec) DeepCopyInto(out *GatewayListenerSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayListenerSpec.
-func (in *GatewayListenerSpec) DeepCopy() *GatewayListenerSpec {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityAlertsNotificationChannelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(GatewayListenerSpec)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayNetworkSpec) DeepCopyInto(out *GatewayNetworkSpec) {
+func (in *ObservabilityAlertsNotificationChannelSpec) DeepCopyInto(out *ObservabilityAlertsNotificationChannelSpec) {
 	*out = *in
-	if in.External != nil {
-		in, out := &in.External, &out.External
-		*out = new(GatewayEndpointSpec)
+	if in.EmailConfig != nil {
+		in, out := &in.EmailConfig, &out.EmailConfig
+		*out = new(EmailConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Internal != nil {
-		in, out := &in.Internal, &out.Internal
-		*out = new(GatewayEndpointSpec)
+	if in.WebhookConfig != nil {
+		in, out := &in.WebhookConfig, &out.WebhookConfig
+		*out = new(WebhookConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayNetworkSpec.
-func (in *GatewayNetworkSpec) DeepCopy() *GatewayNetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertsNotificationChannelSpec.
+func (in *ObservabilityAlertsNotificationChannelSpec) DeepCopy() *ObservabilityAlertsNotificationChannelSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayNetworkSpec)
+	out := new(ObservabilityAlertsNotificationChannelSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewaySpec) DeepCopyInto(out *GatewaySpec) {
+func (in *ObservabilityAlertsNotificationChannelStatus) DeepCopyInto(out *ObservabilityAlertsNotificationChannelStatus) {
 	*out = *in
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(GatewayNetworkSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(GatewayNetworkSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewaySpec.
-func (in *GatewaySpec) DeepCopy() *GatewaySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertsNotificationChannelStatus.
+func (in *ObservabilityAlertsNotificationChannelStatus) DeepCopy() *ObservabilityAlertsNotificationChannelStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewaySpec)
+	out := new(ObservabilityAlertsNotificationChannelStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitAuthentication) DeepCopyInto(out *GitAuthentication) {
+func (in *ObservabilityExport) DeepCopyInto(out *ObservabilityExport) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitAuthentication.
-func (in *GitAuthentication) DeepCopy() *GitAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityExport.
+func (in *ObservabilityExport) DeepCopy() *ObservabilityExport {
 	if in == nil {
 		return nil
 	}
-	out := new(GitAuthentication)
+	out := new(ObservabilityExport)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitRepository) DeepCopyInto(out *GitRepository) {
-	*out = *in
-	out.Authentication = in.Authentication
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepository.
-func (in *GitRepository) DeepCopy() *GitRepository {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(GitRepository)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
-	*out = *in
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+func (in *ObservabilityExportFilter) DeepCopyInto(out *ObservabilityExportFilter) {
+	*out = *in
+	if in.LogLevels != nil {
+		in, out := &in.LogLevels, &out.LogLevels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPatchOperation.
-func (in *JSONPatchOperation) DeepCopy() *JSONPatchOperation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityExportFilter.
+func (in *ObservabilityExportFilter) DeepCopy() *ObservabilityExportFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(JSONPatchOperation)
+	out := new(ObservabilityExportFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LatestProjectRelease) DeepCopyInto(out *LatestProjectRelease) {
+func (in *ObservabilityExportGCSSink) DeepCopyInto(out *ObservabilityExportGCSSink) {
 	*out = *in
+	out.Credentials = in.Credentials
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatestProjectRelease.
-func (in *LatestProjectRelease) DeepCopy() *LatestProjectRelease {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityExportGCSSink.
+func (in *ObservabilityExportGCSSink) DeepCopy() *ObservabilityExportGCSSink {
 	if in == nil {
 		return nil
 	}
-	out := new(LatestProjectRelease)
+	out := new(ObservabilityExportGCSSink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LatestRelease) DeepCopyInto(out *LatestRelease) {
+func (in *ObservabilityExportHTTPSink) DeepCopyInto(out *ObservabilityExportHTTPSink) {
 	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]WebhookHeaderValue, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatestRelease.
-func (in *LatestRelease) DeepCopy() *LatestRelease {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityExportHTTPSink.
+func (in *ObservabilityExportHTTPSink) DeepCopy() *ObservabilityExportHTTPSink {
 	if in == nil {
 		return nil
 	}
-	out := new(LatestRelease)
+	out := new(ObservabilityExportHTTPSink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LatestResourceRelease) DeepCopyInto(out *LatestResourceRelease) {
+func (in *ObservabilityExportList) DeepCopyInto(out *ObservabilityExportList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObservabilityExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatestResourceRelease.
-func (in *LatestResourceRelease) DeepCopy() *LatestResourceRelease {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityExportList.
+func (in *ObservabilityExportList) DeepCopy() *ObservabilityExportList {
 	if in == nil {
 		return nil
 	}
-	out := new(LatestResourceRelease)
+	out := new(ObservabilityExportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotificationChannelConfig) DeepCopyInto(out *NotificationChannelConfig) {
+func (in *ObservabilityExportS3Sink) DeepCopyInto(out *ObservabilityExportS3Sink) {
 	*out = *in
-	in.EmailConfig.DeepCopyInto(&out.EmailConfig)
-	in.WebhookConfig.DeepCopyInto(&out.WebhookConfig)
+	out.AccessKeyID = in.AccessKeyID
+	out.SecretAccessKey = in.SecretAccessKey
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelConfig.
-func (in *NotificationChannelConfig) DeepCopy() *NotificationChannelConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityExportS3Sink.
+func (in *ObservabilityExportS3Sink) DeepCopy() *ObservabilityExportS3Sink {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationChannelConfig)
+	out := new(ObservabilityExportS3Sink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertActions) DeepCopyInto(out *ObservabilityAlertActions) {
+func (in *ObservabilityExportSink) DeepCopyInto(out *ObservabilityExportSink) {
 	*out = *in
-	in.Notifications.DeepCopyInto(&out.Notifications)
-	if in.Incident != nil {
-		in, out := &in.Incident, &out.Incident
-		*out = new(ObservabilityAlertIncident)
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ObservabilityExportS3Sink)
+		**out = **in
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(ObservabilityExportGCSSink)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ObservabilityExportHTTPSink)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertActions.
-func (in *ObservabilityAlertActions) DeepCopy() *ObservabilityAlertActions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityExportSink.
+func (in *ObservabilityExportSink) DeepCopy() *ObservabilityExportSink {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityAlertActions)
+	out := new(ObservabilityExportSink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertCondition) DeepCopyInto(out *ObservabilityAlertCondition) {
+func (in *ObservabilityExportSpec) DeepCopyInto(out *ObservabilityExportSpec) {
 	*out = *in
-	out.Window = in.Window
+	in.Filter.DeepCopyInto(&out.Filter)
 	out.Interval = in.Interval
+	in.Sink.DeepCopyInto(&out.Sink)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertCondition.
-func (in *ObservabilityAlertCondition) DeepCopy() *ObservabilityAlertCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityExportSpec.
+func (in *ObservabilityExportSpec) DeepCopy() *ObservabilityExportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityAlertCondition)
+	out := new(ObservabilityExportSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertIncident) DeepCopyInto(out *ObservabilityAlertIncident) {
+func (in *ObservabilityExportStatus) DeepCopyInto(out *ObservabilityExportStatus) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TriggerAiRca != nil {
-		in, out := &in.TriggerAiRca, &out.TriggerAiRca
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TriggerAiCostAnalysis != nil {
-		in, out := &in.TriggerAiCostAnalysis, &out.TriggerAiCostAnalysis
-		*out = new(bool)
-		**out = **in
+	if in.LastExportTime != nil {
+		in, out := &in.LastExportTime, &out.LastExportTime
+		*out = (*in).DeepCopy()
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertIncident.
-func (in *ObservabilityAlertIncident) DeepCopy() *ObservabilityAlertIncident {
-	if in == nil {
-		return nil
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
 	}
-	out := new(ObservabilityAlertIncident)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertNotifications) DeepCopyInto(out *ObservabilityAlertNotifications) {
-	*out = *in
-	if in.Channels != nil {
-		in, out := &in.Channels, &out.Channels
-		*out = make([]NotificationChannelName, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertNotifications.
-func (in *ObservabilityAlertNotifications) DeepCopy() *ObservabilityAlertNotifications {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityExportStatus.
+func (in *ObservabilityExportStatus) DeepCopy() *ObservabilityExportStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityAlertNotifications)
+	out := new(ObservabilityExportStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertRule) DeepCopyInto(out *ObservabilityAlertRule) {
+func (in *ObservabilityPlane) DeepCopyInto(out *ObservabilityPlane) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3101,18 +5254,18 @@ func (in *ObservabilityAlertRule) DeepCopyInto(out *ObservabilityAlertRule) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertRule.
-func (in *ObservabilityAlertRule) DeepCopy() *ObservabilityAlertRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlane.
+func (in *ObservabilityPlane) DeepCopy() *ObservabilityPlane {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityAlertRule)
+	out := new(ObservabilityPlane)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityAlertRule) DeepCopyObject() runtime.Object {
+func (in *ObservabilityPlane) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3120,31 +5273,31 @@ func (in *ObservabilityAlertRule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertRuleList) DeepCopyInto(out *ObservabilityAlertRuleList) {
+func (in *ObservabilityPlaneList) DeepCopyInto(out *ObservabilityPlaneList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObservabilityAlertRule, len(*in))
+		*out = make([]ObservabilityPlane, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertRuleList.
-func (in *ObservabilityAlertRuleList) DeepCopy() *ObservabilityAlertRuleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlaneList.
+func (in *ObservabilityPlaneList) DeepCopy() *ObservabilityPlaneList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityAlertRuleList)
+	out := new(ObservabilityPlaneList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityAlertRuleList) DeepCopyObject() runtime.Object {
+func (in *ObservabilityPlaneList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3152,174 +5305,106 @@ func (in *ObservabilityAlertRuleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertRuleSpec) DeepCopyInto(out *ObservabilityAlertRuleSpec) {
-	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	out.Source = in.Source
-	out.Condition = in.Condition
-	in.Actions.DeepCopyInto(&out.Actions)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertRuleSpec.
-func (in *ObservabilityAlertRuleSpec) DeepCopy() *ObservabilityAlertRuleSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(ObservabilityAlertRuleSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertRuleStatus) DeepCopyInto(out *ObservabilityAlertRuleStatus) {
+func (in *ObservabilityPlanePrometheus) DeepCopyInto(out *ObservabilityPlanePrometheus) {
 	*out = *in
-	if in.LastReconcileTime != nil {
-		in, out := &in.LastReconcileTime, &out.LastReconcileTime
-		*out = (*in).DeepCopy()
+	if in.RemoteWrite != nil {
+		in, out := &in.RemoteWrite, &out.RemoteWrite
+		*out = make([]PrometheusRemoteWrite, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.LastSyncTime != nil {
-		in, out := &in.LastSyncTime, &out.LastSyncTime
-		*out = (*in).DeepCopy()
+	if in.RecordingRuleGroups != nil {
+		in, out := &in.RecordingRuleGroups, &out.RecordingRuleGroups
+		*out = make([]PrometheusRecordingRuleGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	if in.AlertmanagerRoutes != nil {
+		in, out := &in.AlertmanagerRoutes, &out.AlertmanagerRoutes
+		*out = make([]AlertmanagerRoute, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertRuleStatus.
-func (in *ObservabilityAlertRuleStatus) DeepCopy() *ObservabilityAlertRuleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlanePrometheus.
+func (in *ObservabilityPlanePrometheus) DeepCopy() *ObservabilityPlanePrometheus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityAlertRuleStatus)
+	out := new(ObservabilityPlanePrometheus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertSource) DeepCopyInto(out *ObservabilityAlertSource) {
+func (in *ObservabilityPlaneRef) DeepCopyInto(out *ObservabilityPlaneRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertSource.
-func (in *ObservabilityAlertSource) DeepCopy() *ObservabilityAlertSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlaneRef.
+func (in *ObservabilityPlaneRef) DeepCopy() *ObservabilityPlaneRef {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityAlertSource)
+	out := new(ObservabilityPlaneRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertsNotificationChannel) DeepCopyInto(out *ObservabilityAlertsNotificationChannel) {
+func (in *ObservabilityPlaneSpec) DeepCopyInto(out *ObservabilityPlaneSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(ObservabilityPlanePrometheus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertsNotificationChannel.
-func (in *ObservabilityAlertsNotificationChannel) DeepCopy() *ObservabilityAlertsNotificationChannel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlaneSpec.
+func (in *ObservabilityPlaneSpec) DeepCopy() *ObservabilityPlaneSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityAlertsNotificationChannel)
+	out := new(ObservabilityPlaneSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityAlertsNotificationChannel) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertsNotificationChannelList) DeepCopyInto(out *ObservabilityAlertsNotificationChannelList) {
+func (in *ObservabilityPlaneStatus) DeepCopyInto(out *ObservabilityPlaneStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ObservabilityAlertsNotificationChannel, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertsNotificationChannelList.
-func (in *ObservabilityAlertsNotificationChannelList) DeepCopy() *ObservabilityAlertsNotificationChannelList {
-	if in == nil {
-		return nil
-	}
-	out := new(ObservabilityAlertsNotificationChannelList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityAlertsNotificationChannelList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertsNotificationChannelSpec) DeepCopyInto(out *ObservabilityAlertsNotificationChannelSpec) {
-	*out = *in
-	if in.EmailConfig != nil {
-		in, out := &in.EmailConfig, &out.EmailConfig
-		*out = new(EmailConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.WebhookConfig != nil {
-		in, out := &in.WebhookConfig, &out.WebhookConfig
-		*out = new(WebhookConfig)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertsNotificationChannelSpec.
-func (in *ObservabilityAlertsNotificationChannelSpec) DeepCopy() *ObservabilityAlertsNotificationChannelSpec {
-	if in == nil {
-		return nil
+	if in.AgentConnection != nil {
+		in, out := &in.AgentConnection, &out.AgentConnection
+		*out = new(AgentConnectionStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ObservabilityAlertsNotificationChannelSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityAlertsNotificationChannelStatus) DeepCopyInto(out *ObservabilityAlertsNotificationChannelStatus) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityAlertsNotificationChannelStatus.
-func (in *ObservabilityAlertsNotificationChannelStatus) DeepCopy() *ObservabilityAlertsNotificationChannelStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlaneStatus.
+func (in *ObservabilityPlaneStatus) DeepCopy() *ObservabilityPlaneStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityAlertsNotificationChannelStatus)
+	out := new(ObservabilityPlaneStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPlane) DeepCopyInto(out *ObservabilityPlane) {
+func (in *OrphanScanner) DeepCopyInto(out *OrphanScanner) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3327,18 +5412,18 @@ func (in *ObservabilityPlane) DeepCopyInto(out *ObservabilityPlane) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlane.
-func (in *ObservabilityPlane) DeepCopy() *ObservabilityPlane {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanScanner.
+func (in *OrphanScanner) DeepCopy() *OrphanScanner {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPlane)
+	out := new(OrphanScanner)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPlane) DeepCopyObject() runtime.Object {
+func (in *OrphanScanner) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3346,31 +5431,31 @@ func (in *ObservabilityPlane) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPlaneList) DeepCopyInto(out *ObservabilityPlaneList) {
+func (in *OrphanScannerList) DeepCopyInto(out *OrphanScannerList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObservabilityPlane, len(*in))
+		*out = make([]OrphanScanner, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlaneList.
-func (in *ObservabilityPlaneList) DeepCopy() *ObservabilityPlaneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanScannerList.
+func (in *OrphanScannerList) DeepCopy() *OrphanScannerList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPlaneList)
+	out := new(OrphanScannerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPlaneList) DeepCopyObject() runtime.Object {
+func (in *OrphanScannerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3378,59 +5463,78 @@ func (in *ObservabilityPlaneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPlaneRef) DeepCopyInto(out *ObservabilityPlaneRef) {
+func (in *OrphanScannerSpec) DeepCopyInto(out *OrphanScannerSpec) {
 	*out = *in
+	if in.Planes != nil {
+		in, out := &in.Planes, &out.Planes
+		*out = make([]TargetPlaneRef, len(*in))
+		copy(*out, *in)
+	}
+	out.GracePeriod = in.GracePeriod
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlaneRef.
-func (in *ObservabilityPlaneRef) DeepCopy() *ObservabilityPlaneRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanScannerSpec.
+func (in *OrphanScannerSpec) DeepCopy() *OrphanScannerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPlaneRef)
+	out := new(OrphanScannerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPlaneSpec) DeepCopyInto(out *ObservabilityPlaneSpec) {
+func (in *OrphanScannerStatus) DeepCopyInto(out *OrphanScannerStatus) {
 	*out = *in
-	in.ClusterAgent.DeepCopyInto(&out.ClusterAgent)
+	if in.LastScanTime != nil {
+		in, out := &in.LastScanTime, &out.LastScanTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScanTime != nil {
+		in, out := &in.NextScanTime, &out.NextScanTime
+		*out = (*in).DeepCopy()
+	}
+	if in.OrphanedResources != nil {
+		in, out := &in.OrphanedResources, &out.OrphanedResources
+		*out = make([]OrphanedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingResources != nil {
+		in, out := &in.MissingResources, &out.MissingResources
+		*out = make([]MissingResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlaneSpec.
-func (in *ObservabilityPlaneSpec) DeepCopy() *ObservabilityPlaneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanScannerStatus.
+func (in *OrphanScannerStatus) DeepCopy() *OrphanScannerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPlaneSpec)
+	out := new(OrphanScannerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPlaneStatus) DeepCopyInto(out *ObservabilityPlaneStatus) {
+func (in *OrphanedResource) DeepCopyInto(out *OrphanedResource) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.AgentConnection != nil {
-		in, out := &in.AgentConnection, &out.AgentConnection
-		*out = new(AgentConnectionStatus)
-		(*in).DeepCopyInto(*out)
-	}
+	out.Plane = in.Plane
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPlaneStatus.
-func (in *ObservabilityPlaneStatus) DeepCopy() *ObservabilityPlaneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResource.
+func (in *OrphanedResource) DeepCopy() *OrphanedResource {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPlaneStatus)
+	out := new(OrphanedResource)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3517,6 +5621,26 @@ func (in *PostRenderValidation) DeepCopy() *PostRenderValidation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreWarmWindow) DeepCopyInto(out *PreWarmWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]PreWarmWeekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreWarmWindow.
+func (in *PreWarmWindow) DeepCopy() *PreWarmWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(PreWarmWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -3576,6 +5700,41 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuota) DeepCopyInto(out *ProjectQuota) {
+	*out = *in
+	if in.MaxComponents != nil {
+		in, out := &in.MaxComponents, &out.MaxComponents
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxEnvironments != nil {
+		in, out := &in.MaxEnvironments, &out.MaxEnvironments
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuota.
+func (in *ProjectQuota) DeepCopy() *ProjectQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRelease) DeepCopyInto(out *ProjectRelease) {
 	*out = *in
@@ -3830,6 +5989,16 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QualityGate != nil {
+		in, out := &in.QualityGate, &out.QualityGate
+		*out = new(AnalysisQualityGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ProjectQuota)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
@@ -3995,6 +6164,102 @@ func (in *ProjectTypeStatus) DeepCopy() *ProjectTypeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRecordingRule) DeepCopyInto(out *PrometheusRecordingRule) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRecordingRule.
+func (in *PrometheusRecordingRule) DeepCopy() *PrometheusRecordingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRecordingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRecordingRuleGroup) DeepCopyInto(out *PrometheusRecordingRuleGroup) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PrometheusRecordingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRecordingRuleGroup.
+func (in *PrometheusRecordingRuleGroup) DeepCopy() *PrometheusRecordingRuleGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRecordingRuleGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWrite) DeepCopyInto(out *PrometheusRemoteWrite) {
+	*out = *in
+	if in.BearerTokenSecretRef != nil {
+		in, out := &in.BearerTokenSecretRef, &out.BearerTokenSecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWrite.
+func (in *PrometheusRemoteWrite) DeepCopy() *PrometheusRemoteWrite {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionGate) DeepCopyInto(out *PromotionGate) {
+	*out = *in
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionGate.
+func (in *PromotionGate) DeepCopy() *PromotionGate {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionPath) DeepCopyInto(out *PromotionPath) {
 	*out = *in
@@ -4002,7 +6267,9 @@ func (in *PromotionPath) DeepCopyInto(out *PromotionPath) {
 	if in.TargetEnvironmentRefs != nil {
 		in, out := &in.TargetEnvironmentRefs, &out.TargetEnvironmentRefs
 		*out = make([]TargetEnvironmentRef, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -4031,6 +6298,21 @@ func (in *RegistryAuthentication) DeepCopy() *RegistryAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryCredential) DeepCopyInto(out *RegistryCredential) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryCredential.
+func (in *RegistryCredential) DeepCopy() *RegistryCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleaseBinding) DeepCopyInto(out *ReleaseBinding) {
 	*out = *in
@@ -4105,6 +6387,63 @@ func (in *ReleaseBindingOwner) DeepCopy() *ReleaseBindingOwner {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseBindingPromotion) DeepCopyInto(out *ReleaseBindingPromotion) {
+	*out = *in
+	in.PromotedAt.DeepCopyInto(&out.PromotedAt)
+	in.Changelog.DeepCopyInto(&out.Changelog)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseBindingPromotion.
+func (in *ReleaseBindingPromotion) DeepCopy() *ReleaseBindingPromotion {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseBindingPromotion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseBindingRollback) DeepCopyInto(out *ReleaseBindingRollback) {
+	*out = *in
+	in.RolledBackAt.DeepCopyInto(&out.RolledBackAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseBindingRollback.
+func (in *ReleaseBindingRollback) DeepCopy() *ReleaseBindingRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseBindingRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseBindingRollout) DeepCopyInto(out *ReleaseBindingRollout) {
+	*out = *in
+	if in.StepStartedAt != nil {
+		in, out := &in.StepStartedAt, &out.StepStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviewHostnames != nil {
+		in, out := &in.PreviewHostnames, &out.PreviewHostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseBindingRollout.
+func (in *ReleaseBindingRollout) DeepCopy() *ReleaseBindingRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseBindingRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleaseBindingSpec) DeepCopyInto(out *ReleaseBindingSpec) {
 	*out = *in
@@ -4126,6 +6465,36 @@ func (in *ReleaseBindingSpec) DeepCopyInto(out *ReleaseBindingSpec) {
 		*out = new(WorkloadOverrideTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisruptionBudget != nil {
+		in, out := &in.DisruptionBudget, &out.DisruptionBudget
+		*out = new(DisruptionBudgetPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lock != nil {
+		in, out := &in.Lock, &out.Lock
+		*out = new(DeploymentLock)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceMode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollbackPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseBindingSpec.
@@ -4179,16 +6548,55 @@ func (in *ReleaseBindingStatus) DeepCopyInto(out *ReleaseBindingStatus) {
 		*out = make([]ResourceDependencyTarget, len(*in))
 		copy(*out, *in)
 	}
-	if in.PendingResourceDependencies != nil {
-		in, out := &in.PendingResourceDependencies, &out.PendingResourceDependencies
-		*out = make([]PendingResourceDependency, len(*in))
-		copy(*out, *in)
+	if in.PendingResourceDependencies != nil {
+		in, out := &in.PendingResourceDependencies, &out.PendingResourceDependencies
+		*out = make([]PendingResourceDependency, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretReferenceNames != nil {
+		in, out := &in.SecretReferenceNames, &out.SecretReferenceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Promotion != nil {
+		in, out := &in.Promotion, &out.Promotion
+		*out = new(ReleaseBindingPromotion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(ReleaseBindingRollback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ReleaseBindingRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Diagnosis != nil {
+		in, out := &in.Diagnosis, &out.Diagnosis
+		*out = new(WorkloadDiagnosis)
+		**out = **in
 	}
-	if in.SecretReferenceNames != nil {
-		in, out := &in.SecretReferenceNames, &out.SecretReferenceNames
-		*out = make([]string, len(*in))
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]LifecycleHookStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.DeploymentHistory != nil {
+		in, out := &in.DeploymentHistory, &out.DeploymentHistory
+		*out = make([]DeploymentRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConditionHistory != nil {
+		in, out := &in.ConditionHistory, &out.ConditionHistory
+		*out = make([]ConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseBindingStatus.
@@ -4201,6 +6609,41 @@ func (in *ReleaseBindingStatus) DeepCopy() *ReleaseBindingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseChange) DeepCopyInto(out *ReleaseChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseChange.
+func (in *ReleaseChange) DeepCopy() *ReleaseChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseChangelog) DeepCopyInto(out *ReleaseChangelog) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]ReleaseChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseChangelog.
+func (in *ReleaseChangelog) DeepCopy() *ReleaseChangelog {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseChangelog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteReference) DeepCopyInto(out *RemoteReference) {
 	*out = *in
@@ -4244,6 +6687,23 @@ func (in *RenderedManifestStatus) DeepCopyInto(out *RenderedManifestStatus) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Diagnosis != nil {
+		in, out := &in.Diagnosis, &out.Diagnosis
+		*out = new(WorkloadDiagnosis)
+		**out = **in
+	}
+	if in.FieldManagerConflicts != nil {
+		in, out := &in.FieldManagerConflicts, &out.FieldManagerConflicts
+		*out = make([]FieldManagerConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(ResourceDrift)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LastObservedTime != nil {
 		in, out := &in.LastObservedTime, &out.LastObservedTime
 		*out = (*in).DeepCopy()
@@ -4384,6 +6844,17 @@ func (in *RenderedReleaseStatus) DeepCopyInto(out *RenderedReleaseStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ConditionHistory != nil {
+		in, out := &in.ConditionHistory, &out.ConditionHistory
+		*out = make([]ConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastDriftScanTime != nil {
+		in, out := &in.LastDriftScanTime, &out.LastDriftScanTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderedReleaseStatus.
@@ -4396,6 +6867,21 @@ func (in *RenderedReleaseStatus) DeepCopy() *RenderedReleaseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequiredEnvVar) DeepCopyInto(out *RequiredEnvVar) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequiredEnvVar.
+func (in *RequiredEnvVar) DeepCopy() *RequiredEnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(RequiredEnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolvedConnection) DeepCopyInto(out *ResolvedConnection) {
 	*out = *in
@@ -4437,6 +6923,41 @@ func (in *ResolvedResourceOutput) DeepCopy() *ResolvedResourceOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedWorkflowTemplate) DeepCopyInto(out *ResolvedWorkflowTemplate) {
+	*out = *in
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = new(WorkflowTestSpec)
+		**out = **in
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(WorkflowAnalysisSpec)
+		**out = **in
+	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(WorkflowSBOMSpec)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(WorkflowRetryPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedWorkflowTemplate.
+func (in *ResolvedWorkflowTemplate) DeepCopy() *ResolvedWorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedWorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resource) DeepCopyInto(out *Resource) {
 	*out = *in
@@ -4479,6 +7000,26 @@ func (in *ResourceDependencyTarget) DeepCopy() *ResourceDependencyTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDrift) DeepCopyInto(out *ResourceDrift) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDrift.
+func (in *ResourceDrift) DeepCopy() *ResourceDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceList) DeepCopyInto(out *ResourceList) {
 	*out = *in
@@ -5073,6 +7614,46 @@ func (in *RoleRef) DeepCopy() *RoleRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
+func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SBOMComponent) DeepCopyInto(out *SBOMComponent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SBOMComponent.
+func (in *SBOMComponent) DeepCopy() *SBOMComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(SBOMComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SMTPAuth) DeepCopyInto(out *SMTPAuth) {
 	*out = *in
@@ -5088,103 +7669,306 @@ func (in *SMTPAuth) DeepCopyInto(out *SMTPAuth) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPAuth.
-func (in *SMTPAuth) DeepCopy() *SMTPAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPAuth.
+func (in *SMTPAuth) DeepCopy() *SMTPAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SMTPAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SMTPConfig) DeepCopyInto(out *SMTPConfig) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(SMTPAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(SMTPTLSConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPConfig.
+func (in *SMTPConfig) DeepCopy() *SMTPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SMTPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SMTPTLSConfig) DeepCopyInto(out *SMTPTLSConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPTLSConfig.
+func (in *SMTPTLSConfig) DeepCopy() *SMTPTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SMTPTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingPolicy) DeepCopyInto(out *SchedulingPolicy) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPolicy.
+func (in *SchedulingPolicy) DeepCopy() *SchedulingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schema) DeepCopyInto(out *Schema) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schema.
+func (in *Schema) DeepCopy() *Schema {
+	if in == nil {
+		return nil
+	}
+	out := new(Schema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaSection) DeepCopyInto(out *SchemaSection) {
+	*out = *in
+	if in.OpenAPIV3Schema != nil {
+		in, out := &in.OpenAPIV3Schema, &out.OpenAPIV3Schema
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaSection.
+func (in *SchemaSection) DeepCopy() *SchemaSection {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaSection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretDataSource) DeepCopyInto(out *SecretDataSource) {
+	*out = *in
+	out.RemoteRef = in.RemoteRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretDataSource.
+func (in *SecretDataSource) DeepCopy() *SecretDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretExpiryAlerting) DeepCopyInto(out *SecretExpiryAlerting) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretExpiryAlerting.
+func (in *SecretExpiryAlerting) DeepCopy() *SecretExpiryAlerting {
 	if in == nil {
 		return nil
 	}
-	out := new(SMTPAuth)
+	out := new(SecretExpiryAlerting)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SMTPConfig) DeepCopyInto(out *SMTPConfig) {
+func (in *SecretExpiryFinding) DeepCopyInto(out *SecretExpiryFinding) {
 	*out = *in
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = new(SMTPAuth)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(SMTPTLSConfig)
-		**out = **in
-	}
+	out.Plane = in.Plane
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPConfig.
-func (in *SMTPConfig) DeepCopy() *SMTPConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretExpiryFinding.
+func (in *SecretExpiryFinding) DeepCopy() *SecretExpiryFinding {
 	if in == nil {
 		return nil
 	}
-	out := new(SMTPConfig)
+	out := new(SecretExpiryFinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SMTPTLSConfig) DeepCopyInto(out *SMTPTLSConfig) {
+func (in *SecretExpiryScanner) DeepCopyInto(out *SecretExpiryScanner) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SMTPTLSConfig.
-func (in *SMTPTLSConfig) DeepCopy() *SMTPTLSConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretExpiryScanner.
+func (in *SecretExpiryScanner) DeepCopy() *SecretExpiryScanner {
 	if in == nil {
 		return nil
 	}
-	out := new(SMTPTLSConfig)
+	out := new(SecretExpiryScanner)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretExpiryScanner) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Schema) DeepCopyInto(out *Schema) {
+func (in *SecretExpiryScannerList) DeepCopyInto(out *SecretExpiryScannerList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretExpiryScanner, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schema.
-func (in *Schema) DeepCopy() *Schema {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretExpiryScannerList.
+func (in *SecretExpiryScannerList) DeepCopy() *SecretExpiryScannerList {
 	if in == nil {
 		return nil
 	}
-	out := new(Schema)
+	out := new(SecretExpiryScannerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretExpiryScannerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SchemaSection) DeepCopyInto(out *SchemaSection) {
+func (in *SecretExpiryScannerSpec) DeepCopyInto(out *SecretExpiryScannerSpec) {
 	*out = *in
-	if in.OpenAPIV3Schema != nil {
-		in, out := &in.OpenAPIV3Schema, &out.OpenAPIV3Schema
-		*out = new(runtime.RawExtension)
+	if in.Planes != nil {
+		in, out := &in.Planes, &out.Planes
+		*out = make([]TargetPlaneRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.WarningBefore = in.WarningBefore
+	out.CriticalBefore = in.CriticalBefore
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = new(SecretExpiryAlerting)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaSection.
-func (in *SchemaSection) DeepCopy() *SchemaSection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretExpiryScannerSpec.
+func (in *SecretExpiryScannerSpec) DeepCopy() *SecretExpiryScannerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SchemaSection)
+	out := new(SecretExpiryScannerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretDataSource) DeepCopyInto(out *SecretDataSource) {
+func (in *SecretExpiryScannerStatus) DeepCopyInto(out *SecretExpiryScannerStatus) {
 	*out = *in
-	out.RemoteRef = in.RemoteRef
+	if in.LastScanTime != nil {
+		in, out := &in.LastScanTime, &out.LastScanTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScanTime != nil {
+		in, out := &in.NextScanTime, &out.NextScanTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Findings != nil {
+		in, out := &in.Findings, &out.Findings
+		*out = make([]SecretExpiryFinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretDataSource.
-func (in *SecretDataSource) DeepCopy() *SecretDataSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretExpiryScannerStatus.
+func (in *SecretExpiryScannerStatus) DeepCopy() *SecretExpiryScannerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretDataSource)
+	out := new(SecretExpiryScannerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5315,6 +8099,11 @@ func (in *SecretReferenceSpec) DeepCopyInto(out *SecretReferenceSpec) {
 		*out = new(TargetPlaneRef)
 		**out = **in
 	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSource)
+		**out = **in
+	}
 	in.Template.DeepCopyInto(&out.Template)
 	if in.Data != nil {
 		in, out := &in.Data, &out.Data
@@ -5359,127 +8148,362 @@ func (in *SecretReferenceStatus) DeepCopyInto(out *SecretReferenceStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReferenceStatus.
-func (in *SecretReferenceStatus) DeepCopy() *SecretReferenceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReferenceStatus.
+func (in *SecretReferenceStatus) DeepCopy() *SecretReferenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReferenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretStoreRef) DeepCopyInto(out *SecretStoreRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreRef.
+func (in *SecretStoreRef) DeepCopy() *SecretStoreRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretStoreRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretStoreReference) DeepCopyInto(out *SecretStoreReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreReference.
+func (in *SecretStoreReference) DeepCopy() *SecretStoreReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretStoreReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretTemplate) DeepCopyInto(out *SecretTemplate) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(SecretMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTemplate.
+func (in *SecretTemplate) DeepCopy() *SecretTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretValueFrom) DeepCopyInto(out *SecretValueFrom) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretValueFrom.
+func (in *SecretValueFrom) DeepCopy() *SecretValueFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretValueFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjective) DeepCopyInto(out *ServiceLevelObjective) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjective.
+func (in *ServiceLevelObjective) DeepCopy() *ServiceLevelObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceLevelObjective) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveList) DeepCopyInto(out *ServiceLevelObjectiveList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceLevelObjective, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveList.
+func (in *ServiceLevelObjectiveList) DeepCopy() *ServiceLevelObjectiveList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceLevelObjectiveList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveOwner) DeepCopyInto(out *ServiceLevelObjectiveOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveOwner.
+func (in *ServiceLevelObjectiveOwner) DeepCopy() *ServiceLevelObjectiveOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveSpec) DeepCopyInto(out *ServiceLevelObjectiveSpec) {
+	*out = *in
+	out.Owner = in.Owner
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = new(AvailabilityObjective)
+		**out = **in
+	}
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(LatencyObjective)
+		**out = **in
+	}
+	out.Window = in.Window
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveSpec.
+func (in *ServiceLevelObjectiveSpec) DeepCopy() *ServiceLevelObjectiveSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveStatus) DeepCopyInto(out *ServiceLevelObjectiveStatus) {
+	*out = *in
+	if in.ErrorBudget != nil {
+		in, out := &in.ErrorBudget, &out.ErrorBudget
+		*out = new(ErrorBudgetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveStatus.
+func (in *ServiceLevelObjectiveStatus) DeepCopy() *ServiceLevelObjectiveStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretReferenceStatus)
+	out := new(ServiceLevelObjectiveStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretStoreRef) DeepCopyInto(out *SecretStoreRef) {
+func (in *StandbyBuildNodesStatus) DeepCopyInto(out *StandbyBuildNodesStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreRef.
-func (in *SecretStoreRef) DeepCopy() *SecretStoreRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandbyBuildNodesStatus.
+func (in *StandbyBuildNodesStatus) DeepCopy() *StandbyBuildNodesStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretStoreRef)
+	out := new(StandbyBuildNodesStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretStoreReference) DeepCopyInto(out *SecretStoreReference) {
+func (in *TargetEnvironmentRef) DeepCopyInto(out *TargetEnvironmentRef) {
 	*out = *in
+	if in.Gate != nil {
+		in, out := &in.Gate, &out.Gate
+		*out = new(PromotionGate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreReference.
-func (in *SecretStoreReference) DeepCopy() *SecretStoreReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetEnvironmentRef.
+func (in *TargetEnvironmentRef) DeepCopy() *TargetEnvironmentRef {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretStoreReference)
+	out := new(TargetEnvironmentRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretTemplate) DeepCopyInto(out *SecretTemplate) {
+func (in *TargetPlaneRef) DeepCopyInto(out *TargetPlaneRef) {
 	*out = *in
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = new(SecretMetadata)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetPlaneRef.
+func (in *TargetPlaneRef) DeepCopy() *TargetPlaneRef {
+	if in == nil {
+		return nil
 	}
+	out := new(TargetPlaneRef)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTemplate.
-func (in *SecretTemplate) DeepCopy() *SecretTemplate {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetScope) DeepCopyInto(out *TargetScope) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetScope.
+func (in *TargetScope) DeepCopy() *TargetScope {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretTemplate)
+	out := new(TargetScope)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretValueFrom) DeepCopyInto(out *SecretValueFrom) {
+func (in *TenancyPolicy) DeepCopyInto(out *TenancyPolicy) {
 	*out = *in
-	if in.SecretKeyRef != nil {
-		in, out := &in.SecretKeyRef, &out.SecretKeyRef
-		*out = new(SecretKeyRef)
-		**out = **in
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedNamespaces != nil {
+		in, out := &in.DeniedNamespaces, &out.DeniedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedProjects != nil {
+		in, out := &in.AllowedProjects, &out.AllowedProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedProjects != nil {
+		in, out := &in.DeniedProjects, &out.DeniedProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretValueFrom.
-func (in *SecretValueFrom) DeepCopy() *SecretValueFrom {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenancyPolicy.
+func (in *TenancyPolicy) DeepCopy() *TenancyPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretValueFrom)
+	out := new(TenancyPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetEnvironmentRef) DeepCopyInto(out *TargetEnvironmentRef) {
+func (in *TenantCapacityReservation) DeepCopyInto(out *TenantCapacityReservation) {
 	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetEnvironmentRef.
-func (in *TargetEnvironmentRef) DeepCopy() *TargetEnvironmentRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantCapacityReservation.
+func (in *TenantCapacityReservation) DeepCopy() *TenantCapacityReservation {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetEnvironmentRef)
+	out := new(TenantCapacityReservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetPlaneRef) DeepCopyInto(out *TargetPlaneRef) {
+func (in *TestCaseFailure) DeepCopyInto(out *TestCaseFailure) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetPlaneRef.
-func (in *TargetPlaneRef) DeepCopy() *TargetPlaneRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestCaseFailure.
+func (in *TestCaseFailure) DeepCopy() *TestCaseFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetPlaneRef)
+	out := new(TestCaseFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetScope) DeepCopyInto(out *TargetScope) {
+func (in *TestSuiteResult) DeepCopyInto(out *TestSuiteResult) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetScope.
-func (in *TargetScope) DeepCopy() *TargetScope {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSuiteResult.
+func (in *TestSuiteResult) DeepCopy() *TestSuiteResult {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetScope)
+	out := new(TestSuiteResult)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5728,6 +8752,37 @@ func (in *ValueFrom) DeepCopy() *ValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultAuth) DeepCopyInto(out *VaultAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultAuth.
+func (in *VaultAuth) DeepCopy() *VaultAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSource) DeepCopyInto(out *VaultSource) {
+	*out = *in
+	out.Auth = in.Auth
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSource.
+func (in *VaultSource) DeepCopy() *VaultSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 	*out = *in
@@ -5802,6 +8857,100 @@ func (in *Workflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowAnalysisResults) DeepCopyInto(out *WorkflowAnalysisResults) {
+	*out = *in
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Findings != nil {
+		in, out := &in.Findings, &out.Findings
+		*out = make([]AnalysisFinding, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowAnalysisResults.
+func (in *WorkflowAnalysisResults) DeepCopy() *WorkflowAnalysisResults {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowAnalysisResults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowAnalysisSpec) DeepCopyInto(out *WorkflowAnalysisSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowAnalysisSpec.
+func (in *WorkflowAnalysisSpec) DeepCopy() *WorkflowAnalysisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowAnalysisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowBuildNodesSpec) DeepCopyInto(out *WorkflowBuildNodesSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(KarpenterBuildNodes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreWarm != nil {
+		in, out := &in.PreWarm, &out.PreWarm
+		*out = new(BuildNodePreWarmSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowBuildNodesSpec.
+func (in *WorkflowBuildNodesSpec) DeepCopy() *WorkflowBuildNodesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowBuildNodesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowCredential) DeepCopyInto(out *WorkflowCredential) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowCredential.
+func (in *WorkflowCredential) DeepCopy() *WorkflowCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowList) DeepCopyInto(out *WorkflowList) {
 	*out = *in
@@ -5908,6 +9057,28 @@ func (in *WorkflowPlaneRef) DeepCopy() *WorkflowPlaneRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowPlaneSelector) DeepCopyInto(out *WorkflowPlaneSelector) {
+	*out = *in
+	if in.MatchLabels != nil {
+		in, out := &in.MatchLabels, &out.MatchLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPlaneSelector.
+func (in *WorkflowPlaneSelector) DeepCopy() *WorkflowPlaneSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowPlaneSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowPlaneSpec) DeepCopyInto(out *WorkflowPlaneSpec) {
 	*out = *in
@@ -5922,6 +9093,16 @@ func (in *WorkflowPlaneSpec) DeepCopyInto(out *WorkflowPlaneSpec) {
 		*out = new(ObservabilityPlaneRef)
 		**out = **in
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(WorkflowSchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BuildNodes != nil {
+		in, out := &in.BuildNodes, &out.BuildNodes
+		*out = new(WorkflowBuildNodesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPlaneSpec.
@@ -5949,6 +9130,11 @@ func (in *WorkflowPlaneStatus) DeepCopyInto(out *WorkflowPlaneStatus) {
 		*out = new(AgentConnectionStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StandbyBuildNodes != nil {
+		in, out := &in.StandbyBuildNodes, &out.StandbyBuildNodes
+		*out = new(StandbyBuildNodesStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPlaneStatus.
@@ -5961,6 +9147,21 @@ func (in *WorkflowPlaneStatus) DeepCopy() *WorkflowPlaneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowPriorityClass) DeepCopyInto(out *WorkflowPriorityClass) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPriorityClass.
+func (in *WorkflowPriorityClass) DeepCopy() *WorkflowPriorityClass {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowPriorityClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowRef) DeepCopyInto(out *WorkflowRef) {
 	*out = *in
@@ -5996,6 +9197,21 @@ func (in *WorkflowResource) DeepCopy() *WorkflowResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowRetryPolicy) DeepCopyInto(out *WorkflowRetryPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRetryPolicy.
+func (in *WorkflowRetryPolicy) DeepCopy() *WorkflowRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowRun) DeepCopyInto(out *WorkflowRun) {
 	*out = *in
@@ -6023,6 +9239,21 @@ func (in *WorkflowRun) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowRunCancellation) DeepCopyInto(out *WorkflowRunCancellation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRunCancellation.
+func (in *WorkflowRunCancellation) DeepCopy() *WorkflowRunCancellation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowRunCancellation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowRunConfig) DeepCopyInto(out *WorkflowRunConfig) {
 	*out = *in
@@ -6043,6 +9274,27 @@ func (in *WorkflowRunConfig) DeepCopy() *WorkflowRunConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowRunCredentialsStatus) DeepCopyInto(out *WorkflowRunCredentialsStatus) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]WorkflowCredential, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRunCredentialsStatus.
+func (in *WorkflowRunCredentialsStatus) DeepCopy() *WorkflowRunCredentialsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowRunCredentialsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowRunList) DeepCopyInto(out *WorkflowRunList) {
 	*out = *in
@@ -6057,28 +9309,53 @@ func (in *WorkflowRunList) DeepCopyInto(out *WorkflowRunList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRunList.
-func (in *WorkflowRunList) DeepCopy() *WorkflowRunList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRunList.
+func (in *WorkflowRunList) DeepCopy() *WorkflowRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowRunQueueStatus) DeepCopyInto(out *WorkflowRunQueueStatus) {
+	*out = *in
+	in.QueuedAt.DeepCopyInto(&out.QueuedAt)
+	if in.AdmittedAt != nil {
+		in, out := &in.AdmittedAt, &out.AdmittedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRunQueueStatus.
+func (in *WorkflowRunQueueStatus) DeepCopy() *WorkflowRunQueueStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(WorkflowRunList)
+	out := new(WorkflowRunQueueStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *WorkflowRunList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowRunSpec) DeepCopyInto(out *WorkflowRunSpec) {
 	*out = *in
 	in.Workflow.DeepCopyInto(&out.Workflow)
+	if in.Cancellation != nil {
+		in, out := &in.Cancellation, &out.Cancellation
+		*out = new(WorkflowRunCancellation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRunSpec.
@@ -6130,6 +9407,41 @@ func (in *WorkflowRunStatus) DeepCopyInto(out *WorkflowRunStatus) {
 		in, out := &in.CompletedAt, &out.CompletedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.ResolvedTemplate != nil {
+		in, out := &in.ResolvedTemplate, &out.ResolvedTemplate
+		*out = new(ResolvedWorkflowTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestResults != nil {
+		in, out := &in.TestResults, &out.TestResults
+		*out = new(WorkflowTestResults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AnalysisResults != nil {
+		in, out := &in.AnalysisResults, &out.AnalysisResults
+		*out = new(WorkflowAnalysisResults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(WorkflowSBOM)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkflowPlane != nil {
+		in, out := &in.WorkflowPlane, &out.WorkflowPlane
+		*out = new(WorkflowPlaneRef)
+		**out = **in
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(WorkflowRunQueueStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(WorkflowRunCredentialsStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRunStatus.
@@ -6142,6 +9454,81 @@ func (in *WorkflowRunStatus) DeepCopy() *WorkflowRunStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowSBOM) DeepCopyInto(out *WorkflowSBOM) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]SBOMComponent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSBOM.
+func (in *WorkflowSBOM) DeepCopy() *WorkflowSBOM {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowSBOM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowSBOMSpec) DeepCopyInto(out *WorkflowSBOMSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSBOMSpec.
+func (in *WorkflowSBOMSpec) DeepCopy() *WorkflowSBOMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowSBOMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowSchedulingSpec) DeepCopyInto(out *WorkflowSchedulingSpec) {
+	*out = *in
+	if in.MaxConcurrentRuns != nil {
+		in, out := &in.MaxConcurrentRuns, &out.MaxConcurrentRuns
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxQueuedRuns != nil {
+		in, out := &in.MaxQueuedRuns, &out.MaxQueuedRuns
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NamespaceWeights != nil {
+		in, out := &in.NamespaceWeights, &out.NamespaceWeights
+		*out = make([]NamespaceWeight, len(*in))
+		copy(*out, *in)
+	}
+	if in.PriorityClasses != nil {
+		in, out := &in.PriorityClasses, &out.PriorityClasses
+		*out = make([]WorkflowPriorityClass, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreferredNamespaces != nil {
+		in, out := &in.PreferredNamespaces, &out.PreferredNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSchedulingSpec.
+func (in *WorkflowSchedulingSpec) DeepCopy() *WorkflowSchedulingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowSchedulingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 	*out = *in
@@ -6150,6 +9537,11 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(WorkflowPlaneRef)
 		**out = **in
 	}
+	if in.WorkflowPlaneSelector != nil {
+		in, out := &in.WorkflowPlaneSelector, &out.WorkflowPlaneSelector
+		*out = new(WorkflowPlaneSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Parameters != nil {
 		in, out := &in.Parameters, &out.Parameters
 		*out = new(SchemaSection)
@@ -6172,6 +9564,31 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = make([]ExternalRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = new(WorkflowTestSpec)
+		**out = **in
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(WorkflowAnalysisSpec)
+		**out = **in
+	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(WorkflowSBOMSpec)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(WorkflowRetryPolicy)
+		**out = **in
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]WorkflowCredential, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
@@ -6229,6 +9646,182 @@ func (in *WorkflowTask) DeepCopy() *WorkflowTask {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplate) DeepCopyInto(out *WorkflowTemplate) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunTemplate != nil {
+		in, out := &in.RunTemplate, &out.RunTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]WorkflowResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalRefs != nil {
+		in, out := &in.ExternalRefs, &out.ExternalRefs
+		*out = make([]ExternalRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = new(WorkflowTestSpec)
+		**out = **in
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(WorkflowAnalysisSpec)
+		**out = **in
+	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(WorkflowSBOMSpec)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(WorkflowRetryPolicy)
+		**out = **in
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]WorkflowCredential, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplate.
+func (in *WorkflowTemplate) DeepCopy() *WorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTestResults) DeepCopyInto(out *WorkflowTestResults) {
+	*out = *in
+	if in.Suites != nil {
+		in, out := &in.Suites, &out.Suites
+		*out = make([]TestSuiteResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailedCases != nil {
+		in, out := &in.FailedCases, &out.FailedCases
+		*out = make([]TestCaseFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTestResults.
+func (in *WorkflowTestResults) DeepCopy() *WorkflowTestResults {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTestResults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTestSpec) DeepCopyInto(out *WorkflowTestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTestSpec.
+func (in *WorkflowTestSpec) DeepCopy() *WorkflowTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowVersion) DeepCopyInto(out *WorkflowVersion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowVersion.
+func (in *WorkflowVersion) DeepCopy() *WorkflowVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowVersion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowVersionList) DeepCopyInto(out *WorkflowVersionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowVersionList.
+func (in *WorkflowVersionList) DeepCopy() *WorkflowVersionList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowVersionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowVersionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowVersionSpec) DeepCopyInto(out *WorkflowVersionSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowVersionSpec.
+func (in *WorkflowVersionSpec) DeepCopy() *WorkflowVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workload) DeepCopyInto(out *Workload) {
 	*out = *in
@@ -6299,6 +9892,21 @@ func (in *WorkloadDependencies) DeepCopy() *WorkloadDependencies {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadDiagnosis) DeepCopyInto(out *WorkloadDiagnosis) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadDiagnosis.
+func (in *WorkloadDiagnosis) DeepCopy() *WorkloadDiagnosis {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadDiagnosis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadEndpoint) DeepCopyInto(out *WorkloadEndpoint) {
 	*out = *in
@@ -6312,6 +9920,11 @@ func (in *WorkloadEndpoint) DeepCopyInto(out *WorkloadEndpoint) {
 		*out = new(Schema)
 		**out = **in
 	}
+	if in.Async != nil {
+		in, out := &in.Async, &out.Async
+		*out = new(AsyncEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadEndpoint.
@@ -6324,6 +9937,51 @@ func (in *WorkloadEndpoint) DeepCopy() *WorkloadEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityConfig) DeepCopyInto(out *WorkloadIdentityConfig) {
+	*out = *in
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSWorkloadIdentity)
+		**out = **in
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPWorkloadIdentity)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureWorkloadIdentity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityConfig.
+func (in *WorkloadIdentityConfig) DeepCopy() *WorkloadIdentityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityRequest) DeepCopyInto(out *WorkloadIdentityRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityRequest.
+func (in *WorkloadIdentityRequest) DeepCopy() *WorkloadIdentityRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadList) DeepCopyInto(out *WorkloadList) {
 	*out = *in
@@ -21,6 +21,7 @@ const (
 	defaultReconnectDelay    = 5 * time.Second
 	defaultHeartbeatInterval = 30 * time.Second
 	defaultRequestTimeout    = 30 * time.Second
+	defaultApplyQueueMaxAge  = 10 * time.Minute
 )
 
 func main() {
@@ -35,6 +36,8 @@ func main() {
 		reconnectDelay    time.Duration
 		heartbeatInterval time.Duration
 		requestTimeout    time.Duration
+		applyQueueDir     string
+		applyQueueMaxAge  time.Duration
 		logLevel          string
 	)
 
@@ -63,6 +66,10 @@ func main() {
 	flag.DurationVar(&reconnectDelay, "reconnect-delay", defaultReconnectDelay, "Delay between reconnection attempts")
 	flag.DurationVar(&heartbeatInterval, "heartbeat-interval", defaultHeartbeatInterval, "Heartbeat message interval")
 	flag.DurationVar(&requestTimeout, "request-timeout", defaultRequestTimeout, "Request timeout duration")
+	flag.StringVar(&applyQueueDir, "apply-queue-dir", cmdutil.GetEnv("APPLY_QUEUE_DIR", ""),
+		"Directory to persist Kubernetes update and delete requests with a resourceVersion precondition in for replay after a disconnect (empty disables the queue)")
+	flag.DurationVar(&applyQueueMaxAge, "apply-queue-max-age", defaultApplyQueueMaxAge,
+		"How long a queued apply request stays eligible for replay")
 	flag.StringVar(&logLevel, "log-level", cmdutil.GetEnv("LOG_LEVEL", "info"), "Log level (debug, info, warn, error)")
 	flag.Parse()
 
@@ -92,6 +99,7 @@ func main() {
 		"clientKey", clientKeyPath,
 		"serverCA", serverCAPath,
 		"kubeconfig", kubeconfig,
+		"applyQueueDir", applyQueueDir,
 	)
 
 	// Create Kubernetes client (in-cluster or from kubeconfig)
@@ -119,6 +127,8 @@ func main() {
 		HeartbeatInterval: heartbeatInterval,
 		RequestTimeout:    requestTimeout,
 		Routes:            []agentclient.RouteConfig{}, // Empty for now, can be loaded from config file later
+		ApplyQueueDir:     applyQueueDir,
+		ApplyQueueMaxAge:  applyQueueMaxAge,
 	}
 
 	agent, err := agentclient.New(config, k8sClient, k8sConfig, logger)
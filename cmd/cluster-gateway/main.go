@@ -28,6 +28,9 @@ const (
 	defaultShutdownTimeout   = 30 * time.Second
 	defaultHeartbeatInterval = 30 * time.Second
 	defaultHeartbeatTimeout  = 90 * time.Second
+
+	defaultMaxStreamsPerAgent         = 64
+	defaultReservedInteractiveStreams = 8
 )
 
 var (
@@ -54,6 +57,8 @@ func main() {
 		shutdownTimeout      time.Duration
 		heartbeatInterval    time.Duration
 		heartbeatTimeout     time.Duration
+		maxStreamsPerAgent   int
+		reservedInteractive  int
 		logLevel             string
 	)
 
@@ -84,6 +89,12 @@ func main() {
 	flag.DurationVar(&shutdownTimeout, "shutdown-timeout", defaultShutdownTimeout, "Graceful shutdown timeout")
 	flag.DurationVar(&heartbeatInterval, "heartbeat-interval", defaultHeartbeatInterval, "Heartbeat ping interval")
 	flag.DurationVar(&heartbeatTimeout, "heartbeat-timeout", defaultHeartbeatTimeout, "Heartbeat timeout duration")
+	flag.IntVar(&maxStreamsPerAgent, "max-streams-per-agent",
+		cmdutil.GetEnvInt("MAX_STREAMS_PER_AGENT", defaultMaxStreamsPerAgent),
+		"Proxied requests multiplexed concurrently over one agent connection; further requests are queued (0 for unlimited)")
+	flag.IntVar(&reservedInteractive, "reserved-interactive-streams",
+		cmdutil.GetEnvInt("RESERVED_INTERACTIVE_STREAMS", defaultReservedInteractiveStreams),
+		"Streams of each agent connection that only interactive requests may use, so reconciles cannot starve them")
 	flag.StringVar(&logLevel, "log-level", cmdutil.GetEnv("LOG_LEVEL", "info"), "Log level (debug, info, warn, error)")
 	flag.Parse()
 
@@ -98,6 +109,8 @@ func main() {
 		"internalClientCA", internalClientCAPath,
 		"heartbeatInterval", heartbeatInterval,
 		"heartbeatTimeout", heartbeatTimeout,
+		"maxStreamsPerAgent", maxStreamsPerAgent,
+		"reservedInteractiveStreams", reservedInteractive,
 		"note", "Client CA certificates are loaded dynamically from DataPlane/WorkflowPlane/ObservabilityPlane CRs",
 	)
 
@@ -137,6 +150,9 @@ func main() {
 		ShutdownTimeout:      shutdownTimeout,
 		HeartbeatInterval:    heartbeatInterval,
 		HeartbeatTimeout:     heartbeatTimeout,
+
+		MaxStreamsPerAgent:         maxStreamsPerAgent,
+		ReservedInteractiveStreams: reservedInteractive,
 	}
 
 	srv := clustergateway.New(config, k8sClient, logger)
@@ -13,21 +13,30 @@ import (
 	"time"
 
 	// +kubebuilder:scaffold:imports
+	corev1 "k8s.io/api/core/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/airgap"
 	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/anomalydetector"
 	"github.com/openchoreo/openchoreo/internal/controller/clustercomponenttype"
 	"github.com/openchoreo/openchoreo/internal/controller/clusterdataplane"
 	"github.com/openchoreo/openchoreo/internal/controller/clusterobservabilityplane"
@@ -41,10 +50,16 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller/componenttype"
 	"github.com/openchoreo/openchoreo/internal/controller/dataplane"
 	"github.com/openchoreo/openchoreo/internal/controller/deploymentpipeline"
+	"github.com/openchoreo/openchoreo/internal/controller/domainmapping"
 	"github.com/openchoreo/openchoreo/internal/controller/environment"
+	"github.com/openchoreo/openchoreo/internal/controller/inventory"
+	"github.com/openchoreo/openchoreo/internal/controller/logmetric"
+	"github.com/openchoreo/openchoreo/internal/controller/objectmigration"
 	"github.com/openchoreo/openchoreo/internal/controller/observabilityalertrule"
 	"github.com/openchoreo/openchoreo/internal/controller/observabilityalertsnotificationchannel"
+	"github.com/openchoreo/openchoreo/internal/controller/observabilityexport"
 	"github.com/openchoreo/openchoreo/internal/controller/observabilityplane"
+	"github.com/openchoreo/openchoreo/internal/controller/orphanscanner"
 	"github.com/openchoreo/openchoreo/internal/controller/project"
 	"github.com/openchoreo/openchoreo/internal/controller/projectrelease"
 	"github.com/openchoreo/openchoreo/internal/controller/projectreleasebinding"
@@ -55,7 +70,9 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller/resourcerelease"
 	"github.com/openchoreo/openchoreo/internal/controller/resourcereleasebinding"
 	"github.com/openchoreo/openchoreo/internal/controller/resourcetype"
+	"github.com/openchoreo/openchoreo/internal/controller/secretexpiryscanner"
 	"github.com/openchoreo/openchoreo/internal/controller/secretreference"
+	"github.com/openchoreo/openchoreo/internal/controller/servicelevelobjective"
 	"github.com/openchoreo/openchoreo/internal/controller/trait"
 	"github.com/openchoreo/openchoreo/internal/controller/workflow"
 	"github.com/openchoreo/openchoreo/internal/controller/workflowplane"
@@ -65,24 +82,33 @@ import (
 	ciliumv2 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/cilium.io/v2"
 	esv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
 	csisecretv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/secretstorecsi/v1"
+	"github.com/openchoreo/openchoreo/internal/gitops"
+	"github.com/openchoreo/openchoreo/internal/labels"
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
 	"github.com/openchoreo/openchoreo/internal/version"
 	authzrolebindingwebhook "github.com/openchoreo/openchoreo/internal/webhook/authzrolebinding"
 	clusterauthzrolebindingwebhook "github.com/openchoreo/openchoreo/internal/webhook/clusterauthzrolebinding"
 	clustercomponenttypewebhook "github.com/openchoreo/openchoreo/internal/webhook/clustercomponenttype"
+	clusterdataplanewebhook "github.com/openchoreo/openchoreo/internal/webhook/clusterdataplane"
 	clusterresourcetypewebhook "github.com/openchoreo/openchoreo/internal/webhook/clusterresourcetype"
 	clustertraitwebhook "github.com/openchoreo/openchoreo/internal/webhook/clustertrait"
 	clusterworkflowwebhook "github.com/openchoreo/openchoreo/internal/webhook/clusterworkflow"
+	clusterworkflowplanewebhook "github.com/openchoreo/openchoreo/internal/webhook/clusterworkflowplane"
 	componentwebhook "github.com/openchoreo/openchoreo/internal/webhook/component"
+	componentownerwebhook "github.com/openchoreo/openchoreo/internal/webhook/componentowner"
 	componentreleasewebhook "github.com/openchoreo/openchoreo/internal/webhook/componentrelease"
 	componenttypewebhook "github.com/openchoreo/openchoreo/internal/webhook/componenttype"
+	dataplanewebhook "github.com/openchoreo/openchoreo/internal/webhook/dataplane"
+	environmentwebhook "github.com/openchoreo/openchoreo/internal/webhook/environment"
 	projectwebhook "github.com/openchoreo/openchoreo/internal/webhook/project"
 	releasebindingwebhook "github.com/openchoreo/openchoreo/internal/webhook/releasebinding"
 	resourcereleasewebhook "github.com/openchoreo/openchoreo/internal/webhook/resourcerelease"
 	resourcetypewebhook "github.com/openchoreo/openchoreo/internal/webhook/resourcetype"
 	traitwebhook "github.com/openchoreo/openchoreo/internal/webhook/trait"
 	workflowwebhook "github.com/openchoreo/openchoreo/internal/webhook/workflow"
+	workflowplanewebhook "github.com/openchoreo/openchoreo/internal/webhook/workflowplane"
+	workflowrunwebhook "github.com/openchoreo/openchoreo/internal/webhook/workflowrun"
 )
 
 const (
@@ -119,14 +145,22 @@ func setupControlPlaneControllers(
 	clusterGatewayURL string,
 	gwTLS gatewayClient.TLSConfig,
 	maxConcurrentReconciles int,
+	airGap *airgap.Policy,
+	gitOps *gitops.Policy,
+	renderLimits componentpipeline.Limits,
+	renderStages []componentpipeline.Stage,
+	credentialBroker *workflowrun.CredentialBroker,
+	quarantine controller.QuarantinePolicy,
+	driftScanInterval time.Duration,
 ) error {
 	// Create gateway client for plane lifecycle notifications
 	var gwClient *gatewayClient.Client
 	if clusterGatewayURL != "" {
 		var err error
 		gwClient, err = gatewayClient.NewClientWithConfig(&gatewayClient.Config{
-			BaseURL: clusterGatewayURL,
-			TLS:     gwTLS,
+			BaseURL:  clusterGatewayURL,
+			TLS:      gwTLS,
+			Priority: messaging.PriorityReconcile,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create cluster gateway client: %w", err)
@@ -156,32 +190,35 @@ func setupControlPlaneControllers(
 		&workload.Reconciler{Client: c, Scheme: s},
 		&environment.Reconciler{Client: c, PlaneClientProvider: planeClientProvider, Scheme: s},
 		&dataplane.Reconciler{
-			Client:        c,
-			Scheme:        s,
-			ClientMgr:     k8sClientMgr,
-			GatewayClient: gwClient,
-			CacheVersion:  "v2",
+			Client:              c,
+			Scheme:              s,
+			ClientMgr:           k8sClientMgr,
+			GatewayClient:       gwClient,
+			CacheVersion:        "v2",
+			PlaneClientProvider: planeClientProvider,
 		},
 		&clusterdataplane.Reconciler{
-			Client:        c,
-			Scheme:        s,
-			ClientMgr:     k8sClientMgr,
-			GatewayClient: gwClient,
-			CacheVersion:  "v2",
+			Client:              c,
+			Scheme:              s,
+			ClientMgr:           k8sClientMgr,
+			GatewayClient:       gwClient,
+			CacheVersion:        "v2",
+			PlaneClientProvider: planeClientProvider,
 		},
 		&clusterworkflowplane.Reconciler{
-			Client:        c,
-			Scheme:        s,
-			ClientMgr:     k8sClientMgr,
-			GatewayClient: gwClient,
-			CacheVersion:  "v2",
+			Client:              c,
+			Scheme:              s,
+			ClientMgr:           k8sClientMgr,
+			GatewayClient:       gwClient,
+			CacheVersion:        "v2",
+			PlaneClientProvider: planeClientProvider,
 		},
 		&project.Reconciler{Client: c, Scheme: s},
 		&clusterprojecttype.Reconciler{Client: c, Scheme: s},
 		&projecttype.Reconciler{Client: c, Scheme: s},
 		&projectrelease.Reconciler{Client: c, Scheme: s},
 		&projectreleasebinding.Reconciler{Client: c, Scheme: s},
-		&component.Reconciler{Client: c, Scheme: s},
+		&component.Reconciler{Client: c, Scheme: s, Quarantine: quarantine},
 		&componenttype.Reconciler{Client: c, Scheme: s},
 		&clustercomponenttype.Reconciler{Client: c, Scheme: s},
 		&trait.Reconciler{Client: c, Scheme: s},
@@ -191,15 +228,26 @@ func setupControlPlaneControllers(
 		// then consumer, immutable release snapshot, per-env binding.
 		&clusterresourcetype.Reconciler{Client: c, Scheme: s},
 		&resourcetype.Reconciler{Client: c, Scheme: s},
-		&resource.Reconciler{Client: c, Scheme: s},
+		&resource.Reconciler{Client: c, Scheme: s, Quarantine: quarantine},
 		&resourcerelease.Reconciler{Client: c, Scheme: s},
-		&resourcereleasebinding.Reconciler{Client: c, Scheme: s},
-		&releasebinding.Reconciler{Client: c, Scheme: s, Pipeline: componentpipeline.NewPipeline()},
+		&resourcereleasebinding.Reconciler{Client: c, Scheme: s, Quarantine: quarantine},
+		&releasebinding.Reconciler{
+			Client: c,
+			Scheme: s,
+			Pipeline: componentpipeline.NewPipeline(
+				componentpipeline.WithLimits(renderLimits),
+				componentpipeline.WithStages(renderStages...),
+			),
+			Quarantine: quarantine,
+		},
 		&renderedrelease.Reconciler{
 			Client:                  c,
 			PlaneClientProvider:     planeClientProvider,
 			Scheme:                  s,
 			MaxConcurrentReconciles: maxConcurrentReconciles,
+			AirGap:                  airGap,
+			GitOps:                  gitOps,
+			DriftScanInterval:       driftScanInterval,
 		},
 		&workflow.Reconciler{Client: c, Scheme: s},
 		&clusterworkflow.Reconciler{Client: c, Scheme: s},
@@ -208,21 +256,25 @@ func setupControlPlaneControllers(
 			Scheme:              s,
 			PlaneClientProvider: planeClientProvider,
 			Pipeline:            workflowpipeline.NewPipeline(),
+			AirGap:              airGap,
+			CredentialBroker:    credentialBroker,
 		},
 		&workflowplane.Reconciler{
-			Client:        c,
-			Scheme:        s,
-			ClientMgr:     k8sClientMgr,
-			GatewayClient: gwClient,
-			CacheVersion:  "v2",
+			Client:              c,
+			Scheme:              s,
+			ClientMgr:           k8sClientMgr,
+			GatewayClient:       gwClient,
+			CacheVersion:        "v2",
+			PlaneClientProvider: planeClientProvider,
 		},
 		&secretreference.Reconciler{Client: c, Scheme: s},
 		&observabilityplane.Reconciler{
-			Client:        c,
-			Scheme:        s,
-			ClientMgr:     k8sClientMgr,
-			GatewayClient: gwClient,
-			CacheVersion:  "v2",
+			Client:              c,
+			Scheme:              s,
+			ClientMgr:           k8sClientMgr,
+			GatewayClient:       gwClient,
+			CacheVersion:        "v2",
+			PlaneClientProvider: planeClientProvider,
 		},
 		&clusterobservabilityplane.Reconciler{
 			Client:        c,
@@ -232,6 +284,13 @@ func setupControlPlaneControllers(
 			CacheVersion:  "v2",
 		},
 		&observabilityalertsnotificationchannel.Reconciler{Client: c, PlaneClientProvider: planeClientProvider, Scheme: s},
+		&objectmigration.Reconciler{Client: c, Scheme: s},
+		&servicelevelobjective.Reconciler{Client: c, Scheme: s},
+		&logmetric.Reconciler{Client: c, Scheme: s},
+		&anomalydetector.Reconciler{Client: c, Scheme: s},
+		&secretexpiryscanner.Reconciler{Client: c, Scheme: s, PlaneClientProvider: planeClientProvider},
+		&orphanscanner.Reconciler{Client: c, Scheme: s, PlaneClientProvider: planeClientProvider},
+		&domainmapping.Reconciler{Client: c, Scheme: s},
 	}
 
 	for _, r := range reconcilers {
@@ -240,6 +299,11 @@ func setupControlPlaneControllers(
 		}
 	}
 
+	// The inventory gauges are computed from the cache on every scrape of the metrics endpoint.
+	if err := metrics.Registry.Register(inventory.NewCollector(c, ctrl.Log.WithName("inventory"))); err != nil {
+		return fmt.Errorf("failed to register inventory metrics: %w", err)
+	}
+
 	return nil
 }
 
@@ -249,6 +313,7 @@ func setupObservabilityPlaneControllers(mgr ctrl.Manager) error {
 
 	reconcilers := []controllerSetup{
 		&observabilityalertrule.Reconciler{Client: c, Scheme: s},
+		&observabilityexport.Reconciler{Client: c, Scheme: s},
 	}
 
 	for _, r := range reconcilers {
@@ -284,6 +349,16 @@ func main() {
 	var clusterGatewayInsecure bool
 	var deploymentPlane string
 	var maxConcurrentReconciles int
+	var airGapped bool
+	var airGapConfigPath string
+	var gitOpsInteropConfigPath string
+	var renderStagesConfigPath string
+	var maxReleasesPerComponent int
+	var componentTransferUser string
+	renderLimits := componentpipeline.DefaultLimits()
+	credentialBroker := &workflowrun.CredentialBroker{}
+	var quarantine controller.QuarantinePolicy
+	var driftScanInterval time.Duration
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -316,6 +391,43 @@ func main() {
 	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 0,
 		"Max concurrent reconciles for the renderedrelease and releasebinding controllers. "+
 			"0 uses the controller-runtime default (1).")
+	flag.BoolVar(&airGapped, "air-gapped", getEnvBool("AIR_GAPPED", false),
+		"Reject rendered workloads and workflow runs that reference external artifacts "+
+			"(images, chart repositories, URLs) not covered by a mirror in --air-gap-config.")
+	flag.StringVar(&airGapConfigPath, "air-gap-config", getEnv("AIR_GAP_CONFIG", ""),
+		"Path to a YAML file with the artifact mirror map and internal hosts used to resolve external references.")
+	flag.StringVar(&gitOpsInteropConfigPath, "gitops-interop-config", getEnv("GITOPS_INTEROP_CONFIG", ""),
+		"Path to a YAML file with the labels and annotations added to rendered resources so that external "+
+			"GitOps tools (Argo CD, Flux) ignore them, and the field managers whose conflicts are not reported.")
+	flag.IntVar(&renderLimits.MaxResources, "render-max-resources", renderLimits.MaxResources,
+		"Max number of resources a ReleaseBinding may render. 0 disables the limit.")
+	flag.IntVar(&renderLimits.MaxRenderedBytes, "render-max-bytes", renderLimits.MaxRenderedBytes,
+		"Max total JSON size in bytes of the resources a ReleaseBinding may render. 0 disables the limit.")
+	flag.IntVar(&renderLimits.MaxCustomResourceDefinitions, "render-max-crds", renderLimits.MaxCustomResourceDefinitions,
+		"Max number of CustomResourceDefinitions a ReleaseBinding may render. 0 disables the limit.")
+	flag.StringVar(&renderStagesConfigPath, "render-stages-config", getEnv("RENDER_STAGES_CONFIG", ""),
+		"Path to a YAML file with the webhook render stages that can adjust the resources of a ReleaseBinding "+
+			"after its traits are applied and before its release is written.")
+	flag.IntVar(&maxReleasesPerComponent, "max-component-releases", getEnvInt("MAX_COMPONENT_RELEASES", 0),
+		"Max number of ComponentReleases a component may have. Creating more is rejected until older releases "+
+			"are pruned. 0 disables the limit.")
+	flag.StringVar(&componentTransferUser, "component-transfer-user", getEnv("COMPONENT_TRANSFER_USER", ""),
+		"The user, typically the openchoreo-api service account, allowed to move components between projects. "+
+			"When empty, the project of a component cannot be changed.")
+	flag.StringVar(&credentialBroker.APIURL, "credential-broker-url", getEnv("CREDENTIAL_BROKER_URL", ""),
+		"The openchoreo-api URL at which workflow runs exchange their broker token for short-lived git and "+
+			"registry credentials. Runs of workflows that declare credentials fail when it is empty.")
+	flag.DurationVar(&credentialBroker.TokenTTL, "credential-broker-token-ttl", 3*time.Hour,
+		"Max lifetime of the broker token issued to a workflow run. Runs with a shorter timeout get a token "+
+			"that expires with the timeout.")
+	flag.IntVar(&quarantine.Threshold, "quarantine-threshold", 20,
+		"Consecutive failed reconciles after which a Component, ReleaseBinding, Resource or ResourceReleaseBinding "+
+			"is quarantined and no longer reconciled until its spec changes or a requeue is requested. 0 disables the quarantine.")
+	flag.DurationVar(&quarantine.Window, "quarantine-window", time.Hour,
+		"Minimum time the consecutive failed reconciles must span before an object is quarantined.")
+	flag.DurationVar(&driftScanInterval, "drift-scan-interval", 30*time.Minute,
+		"Minimum time between two drift scans of a RenderedRelease. Each scan reads every resource of the release "+
+			"from its target plane. 0 scans on every reconcile, including the periodic resync.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -331,6 +443,24 @@ func main() {
 
 	setupLog.Info("starting controller manager", append(version.GetLogKeyValues(), "deploymentPlane", deploymentPlane)...)
 
+	airGap, err := newAirGapPolicy(airGapped, airGapConfigPath)
+	if err != nil {
+		setupLog.Error(err, "invalid air-gap configuration")
+		os.Exit(1)
+	}
+
+	gitOps, err := newGitOpsPolicy(gitOpsInteropConfigPath)
+	if err != nil {
+		setupLog.Error(err, "invalid GitOps interop configuration")
+		os.Exit(1)
+	}
+
+	renderStages, err := newRenderStages(renderStagesConfigPath)
+	if err != nil {
+		setupLog.Error(err, "invalid render stages configuration")
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -372,8 +502,16 @@ func main() {
 		// this setup is not recommended for production.
 	}
 
+	managerCacheOptions, err := cacheOptions()
+	if err != nil {
+		setupLog.Error(err, "unable to configure the manager cache")
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		Cache:                  managerCacheOptions,
+		Client:                 clientOptions(),
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
@@ -407,6 +545,7 @@ func main() {
 		ClientKeyPath:  clusterGatewayClientKey,
 		Insecure:       clusterGatewayInsecure,
 	})
+	k8sClientMgr.ProxyRequestPriority = messaging.PriorityReconcile
 	setupLog.Info("Kubernetes client manager created with proxy TLS configuration",
 		"caCert", clusterGatewayCACert != "",
 		"clientCert", clusterGatewayClientCert != "",
@@ -429,7 +568,8 @@ func main() {
 			ClientCertFile:     clusterGatewayClientCert,
 			ClientKeyFile:      clusterGatewayClientKey,
 			InsecureSkipVerify: clusterGatewayInsecure,
-		}, maxConcurrentReconciles)
+		}, maxConcurrentReconciles, airGap, gitOps, renderLimits, renderStages, credentialBroker, quarantine,
+			driftScanInterval)
 		if err != nil {
 			setupLog.Error(err, "unable to setup control plane controllers")
 			os.Exit(1)
@@ -460,16 +600,27 @@ func main() {
 			{"Project", projectwebhook.SetupProjectWebhookWithManager},
 			{"ComponentType", componenttypewebhook.SetupComponentTypeWebhookWithManager},
 			{"ClusterComponentType", clustercomponenttypewebhook.SetupClusterComponentTypeWebhookWithManager},
-			{"Component", componentwebhook.SetupComponentWebhookWithManager},
+			{"Component", func(mgr ctrl.Manager) error {
+				return componentwebhook.SetupComponentWebhookWithManager(mgr, componentTransferUser)
+			}},
 			{"Trait", traitwebhook.SetupTraitWebhookWithManager},
 			{"ClusterTrait", clustertraitwebhook.SetupClusterTraitWebhookWithManager},
-			{"ComponentRelease", componentreleasewebhook.SetupComponentReleaseWebhookWithManager},
+			{"ComponentRelease", func(mgr ctrl.Manager) error {
+				return componentreleasewebhook.SetupComponentReleaseWebhookWithManager(mgr, maxReleasesPerComponent)
+			}},
 			{"ReleaseBinding", releasebindingwebhook.SetupReleaseBindingWebhookWithManager},
+			{"ComponentOwner", componentownerwebhook.SetupComponentOwnerWebhooksWithManager},
+			{"Environment", environmentwebhook.SetupEnvironmentWebhookWithManager},
+			{"DataPlane", dataplanewebhook.SetupDataPlaneWebhookWithManager},
+			{"ClusterDataPlane", clusterdataplanewebhook.SetupClusterDataPlaneWebhookWithManager},
+			{"WorkflowPlane", workflowplanewebhook.SetupWorkflowPlaneWebhookWithManager},
+			{"ClusterWorkflowPlane", clusterworkflowplanewebhook.SetupClusterWorkflowPlaneWebhookWithManager},
 			{"ResourceType", resourcetypewebhook.SetupResourceTypeWebhookWithManager},
 			{"ClusterResourceType", clusterresourcetypewebhook.SetupClusterResourceTypeWebhookWithManager},
 			{"ResourceRelease", resourcereleasewebhook.SetupResourceReleaseWebhookWithManager},
 			{"Workflow", workflowwebhook.SetupWorkflowWebhookWithManager},
 			{"ClusterWorkflow", clusterworkflowwebhook.SetupClusterWorkflowWebhookWithManager},
+			{"WorkflowRun", workflowrunwebhook.SetupWorkflowRunWebhookWithManager},
 			{"AuthzRoleBinding", authzrolebindingwebhook.SetupAuthzRoleBindingWebhookWithManager},
 			{"ClusterAuthzRoleBinding", clusterauthzrolebindingwebhook.SetupClusterAuthzRoleBindingWebhookWithManager},
 		}
@@ -497,6 +648,100 @@ func main() {
 	}
 }
 
+// cacheOptions configures the informer caches of the manager. Cached objects drop their
+// managed fields, which no controller reads and which are often larger than the spec.
+// Namespaces are only read when a project is finalized, to delete the namespaces its
+// releases created, which carry the project label. Only those are cached, instead of every
+// namespace of the cluster.
+func cacheOptions() (cache.Options, error) {
+	projectNamespaces, err := k8slabels.NewRequirement(labels.LabelKeyProjectName, selection.Exists, nil)
+	if err != nil {
+		return cache.Options{}, fmt.Errorf("invalid project namespace selector: %w", err)
+	}
+	return cache.Options{
+		DefaultTransform: cache.TransformStripManagedFields(),
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.Namespace{}: {Label: k8slabels.NewSelector().Add(*projectNamespaces)},
+		},
+	}, nil
+}
+
+// clientOptions configures the manager client. Secrets are only read when a plane or a
+// notification channel references one by name. Those secrets carry no OpenChoreo labels to
+// scope a cache by, so they are read from the API server instead of keeping every Secret of
+// the cluster in memory.
+func clientOptions() client.Options {
+	return client.Options{
+		Cache: &client.CacheOptions{
+			DisableFor: []client.Object{&corev1.Secret{}},
+		},
+	}
+}
+
+// newAirGapPolicy builds the artifact mirror policy shared by the controllers that render
+// resources for remote planes. It returns nil when neither air-gapped mode nor a mirror
+// config is set, which leaves rendered resources untouched.
+func newAirGapPolicy(airGapped bool, configPath string) (*airgap.Policy, error) {
+	var cfg airgap.Config
+	if configPath != "" {
+		var err error
+		if cfg, err = airgap.LoadConfig(configPath); err != nil {
+			return nil, err
+		}
+	}
+	if !airGapped && configPath == "" {
+		return nil, nil
+	}
+	policy, err := airgap.NewPolicy(airGapped, cfg)
+	if err != nil {
+		return nil, err
+	}
+	setupLog.Info("artifact mirror policy configured",
+		"airGapped", airGapped, "mirrors", len(cfg.Mirrors), "internalHosts", len(cfg.InternalHosts))
+	return policy, nil
+}
+
+// newGitOpsPolicy builds the policy that marks resources rendered to remote planes for external
+// GitOps tools. It returns nil when no config is set, which adds no markers and reports every
+// field manager conflict.
+func newGitOpsPolicy(configPath string) (*gitops.Policy, error) {
+	if configPath == "" {
+		return nil, nil
+	}
+	cfg, err := gitops.LoadConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := gitops.NewPolicy(cfg)
+	if err != nil {
+		return nil, err
+	}
+	setupLog.Info("GitOps interop policy configured",
+		"tools", cfg.Tools, "labels", len(cfg.Labels), "annotations", len(cfg.Annotations),
+		"ignoredFieldManagers", len(cfg.IgnoredFieldManagers))
+	return policy, nil
+}
+
+func newRenderStages(configPath string) ([]componentpipeline.Stage, error) {
+	if configPath == "" {
+		return nil, nil
+	}
+	cfg, err := componentpipeline.LoadStagesConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+	stages, err := componentpipeline.NewStages(cfg)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(stages))
+	for _, stage := range stages {
+		names = append(names, stage.Name())
+	}
+	setupLog.Info("render stages configured", "webhooks", names)
+	return stages, nil
+}
+
 // getEnv retrieves an environment variable value, returning a default if not set
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -518,3 +763,17 @@ func getEnvBool(key string, defaultValue bool) bool {
 	}
 	return parsed
 }
+
+// getEnvInt retrieves an integer environment variable, returning a default if
+// unset or unparseable.
+func getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return defaultValue
+	}
+	return parsed
+}
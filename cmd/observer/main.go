@@ -21,15 +21,19 @@ import (
 	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
 	k8s "github.com/openchoreo/openchoreo/internal/observer/clients"
 	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/export"
 	observermcp "github.com/openchoreo/openchoreo/internal/observer/mcp"
-	observermiddleware "github.com/openchoreo/openchoreo/internal/observer/middleware"
 	"github.com/openchoreo/openchoreo/internal/observer/service"
 	"github.com/openchoreo/openchoreo/internal/observer/store/alertentry"
 	"github.com/openchoreo/openchoreo/internal/observer/store/incidententry"
+	"github.com/openchoreo/openchoreo/internal/observer/store/savedquery"
 	apiconfig "github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
 	"github.com/openchoreo/openchoreo/internal/server/middleware"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/cors"
 	mcpmiddleware "github.com/openchoreo/openchoreo/internal/server/middleware/mcp"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/metrics"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/ratelimit"
 	"github.com/openchoreo/openchoreo/internal/server/oauth"
 	"github.com/openchoreo/openchoreo/pkg/observability"
 )
@@ -214,6 +218,73 @@ func main() {
 		tracesService, authzClient, logger.With("component", "authz-traces"))
 	authzAlertIncidentService := service.NewAlertIncidentServiceWithAuthz(
 		alertService, authzClient, logger.With("component", "authz-alerts-incidents"))
+	// The public SLO service evaluates budgets through the authz-wrapped metrics service,
+	// so callers need view access to the component metrics.
+	authzSLOService := service.NewSLOService(authzMetricsService, logger.With("component", "authz-slo"))
+	// Log metrics are evaluated over the authz-wrapped logs service for the same reason.
+	authzLogMetricsService := service.NewLogMetricsService(
+		authzLogsService, cfg.Logging.MaxLogLimit, logger.With("component", "authz-log-metrics"))
+	// Resource recommendations read usage through the authz-wrapped metrics service.
+	authzRecommendationService := service.NewRecommendationService(
+		authzMetricsService, logger.With("component", "authz-recommendations"))
+	// Correlations pivot across the authz-wrapped services, so each signal is authorized separately.
+	authzCorrelationService := service.NewCorrelationService(
+		authzLogsService, authzTracesService, authzMetricsService, logger.With("component", "authz-correlations"))
+	// Idle workload analysis runs in the background without a caller, so it queries the
+	// unwrapped metrics service; the report is filtered per component on read.
+	var idleDetector *service.IdleWorkloadDetector
+	var authzIdleWorkloadService service.IdleWorkloadReporter
+	if cfg.IdleDetection.Enabled {
+		idleDetector = service.NewIdleWorkloadDetector(
+			uidResolver, metricsService, &cfg.IdleDetection, logger.With("component", "idle-detection"))
+		authzIdleWorkloadService = service.NewIdleWorkloadsServiceWithAuthz(
+			idleDetector, authzClient, logger.With("component", "authz-idle-workloads"))
+	}
+	// Raw PromQL and OpenSearch queries bypass the adapters; they require the rawquery:execute action.
+	var authzRawQueryService service.RawQuerier
+	if cfg.RawQuery.Enabled {
+		authzRawQueryService = service.NewRawQueryServiceWithAuthz(
+			service.NewRawQueryService(&cfg.RawQuery, logger.With("component", "raw-query")),
+			authzClient, logger.With("component", "authz-raw-query"))
+	}
+
+	// Federated queries fan out to the observers of the planes serving a component's environments.
+	// The local plane is answered by the unwrapped services; the wrapper authorizes the caller once.
+	var authzFederationService service.FederatedQuerier
+	if cfg.Federation.Enabled {
+		federationService, federationErr := service.NewFederationService(
+			uidResolver, logsService, metricsService, &cfg.Federation, logger.With("component", "federation"))
+		if federationErr != nil {
+			logger.Error("Failed to initialize federation service", "error", federationErr)
+			os.Exit(1)
+		}
+		authzFederationService = service.NewFederationServiceWithAuthz(
+			federationService, authzClient, logger.With("component", "authz-federation"))
+	}
+
+	// Log exports run in the background for ObservabilityExport resources registered by the
+	// observability plane controllers. They query the unwrapped logs service and read sink
+	// credentials from Secrets in the namespace of each export.
+	var exportManager *export.Manager
+	var exportService service.LogExporter
+	if cfg.Export.Enabled {
+		exportManager = export.NewManager(logsService, k8sClient, &cfg.Export, logger.With("component", "log-export"))
+		exportService = exportManager
+	}
+
+	// Saved queries are stored in OpenSearch. Running one re-enters the logs or traces endpoint,
+	// so the rendered query is authorized like a direct query.
+	var authzSavedQueryService service.SavedQueryService
+	if cfg.SavedQueries.Enabled {
+		savedQueryStore := savedquery.NewOpenSearchStore(&cfg.SavedQueries, logger.With("component", "saved-query-store"))
+		if err := savedQueryStore.Initialize(context.Background()); err != nil {
+			log.Fatalf("Failed to initialize saved query store: %v", err)
+		}
+		authzSavedQueryService = service.NewSavedQueryServiceWithAuthz(
+			service.NewSavedQueryStoreService(
+				savedQueryStore, cfg.SavedQueries.MaxPerNamespace, logger.With("component", "saved-queries")),
+			authzClient, logger.With("component", "authz-saved-queries"))
+	}
 
 	// Initialize new API handler
 	newAPIHandler := apihandler.NewHandler(
@@ -223,23 +294,54 @@ func main() {
 		authzMetricsService,
 		authzAlertIncidentService,
 		authzTracesService,
+		authzSLOService,
+		authzLogMetricsService,
+		authzRecommendationService,
+		authzIdleWorkloadService,
+		authzCorrelationService,
+		authzRawQueryService,
+		authzFederationService,
+		authzSavedQueryService,
 		logger.With("component", "api-handler"),
 	)
 
-	// Initialize internal handler for alert CRUD and webhook (no auth, port 8081)
+	// Initialize internal handler for alert CRUD, webhook, SLO error budgets, log metrics,
+	// anomaly detection, promotion notifications and log exports (no auth, port 8081).
+	// Detected anomalies and promotions are sent through the alert service.
 	internalHandler := apihandler.NewInternalHandler(
 		alertService,
+		service.NewSLOService(metricsService, logger.With("component", "slo-service")),
+		service.NewLogMetricsService(logsService, cfg.Logging.MaxLogLimit, logger.With("component", "log-metrics")),
+		service.NewAnomalyService(metricsService, alertService, logger.With("component", "anomaly-detection")),
+		alertService,
+		exportService,
 		logger.With("component", "internal-handler"),
 	)
 
 	// ===== Initialize Middlewares =====
 
-	// Global middlewares - applies to all routes
-	loggerMiddleware := observermiddleware.Logger(logger)
-	recoveryMiddleware := observermiddleware.Recovery(logger)
+	// HTTP server metrics are shared by the public and internal servers
+	var serverMetrics *metrics.Metrics
+	if cfg.Server.MetricsEnabled {
+		serverMetrics = metrics.New("observer")
+	}
+
+	// Global middlewares - applies to all routes. Only the public server is rate limited.
+	publicMiddleware := middleware.Stack(middleware.StackConfig{
+		Logger:  logger,
+		Metrics: serverMetrics,
+		RateLimit: ratelimit.Config{
+			RequestsPerSecond: cfg.Server.RateLimitRequestsPerSecond,
+			Burst:             cfg.Server.RateLimitBurst,
+		},
+	})
+	internalMiddleware := middleware.Stack(middleware.StackConfig{
+		Logger:  logger,
+		Metrics: serverMetrics,
+	})
 
 	// Create route builder with global middleware
-	routes := middleware.NewRouteBuilder(mux).With(loggerMiddleware, recoveryMiddleware)
+	routes := middleware.NewRouteBuilder(mux).With(publicMiddleware...)
 
 	// ===== Public Routes (No Authentication Required) =====
 
@@ -266,10 +368,24 @@ func main() {
 	api.HandleFunc("POST /api/v1alpha1/metrics/runtime-topology", newAPIHandler.QueryRuntimeTopology)
 	api.HandleFunc("POST /api/v1alpha1/traces/query", newAPIHandler.QueryTraces)
 	api.HandleFunc("POST /api/v1alpha1/traces/{traceId}/spans/query", newAPIHandler.QuerySpansForTrace)
+	api.HandleFunc("POST /api/v1alpha1/correlations/query", newAPIHandler.QueryCorrelations)
 	api.HandleFunc("GET /api/v1alpha1/traces/{traceId}/spans/{spanId}", newAPIHandler.GetSpanDetailsForTrace)
 	api.HandleFunc("POST /api/v1alpha1/alerts/query", newAPIHandler.QueryAlerts)
 	api.HandleFunc("POST /api/v1alpha1/incidents/query", newAPIHandler.QueryIncidents)
 	api.HandleFunc("PUT /api/v1alpha1/incidents/{incidentId}", newAPIHandler.UpdateIncident)
+	api.HandleFunc("POST /api/v1alpha1/slos/error-budget", newAPIHandler.EvaluateErrorBudget)
+	api.HandleFunc("POST /api/v1alpha1/metrics/log-metrics/query", newAPIHandler.QueryLogMetric)
+	api.HandleFunc("POST /api/v1alpha1/metrics/resource-recommendations", newAPIHandler.RecommendResources)
+	api.HandleFunc("POST /api/v1alpha1/metrics/idle-workloads", newAPIHandler.IdleWorkloads)
+	api.HandleFunc("POST /api/v1alpha1/raw/promql/query", newAPIHandler.QueryPromQL)
+	api.HandleFunc("POST /api/v1alpha1/raw/opensearch/search", newAPIHandler.SearchOpenSearch)
+	api.HandleFunc("POST /api/v1alpha1/federation/logs/query", newAPIHandler.QueryFederatedLogs)
+	api.HandleFunc("POST /api/v1alpha1/federation/metrics/query", newAPIHandler.QueryFederatedMetrics)
+	api.HandleFunc("POST /api/v1alpha1/queries", newAPIHandler.CreateSavedQuery)
+	api.HandleFunc("GET /api/v1alpha1/queries/{namespace}", newAPIHandler.ListSavedQueries)
+	api.HandleFunc("GET /api/v1alpha1/queries/{namespace}/{name}", newAPIHandler.GetSavedQuery)
+	api.HandleFunc("DELETE /api/v1alpha1/queries/{namespace}/{name}", newAPIHandler.DeleteSavedQuery)
+	api.HandleFunc("POST /api/v1alpha1/queries/{namespace}/{name}/run", newAPIHandler.RunSavedQuery)
 
 	// Initialize new MCP handler backed by the authz-wrapped service layer
 	newMCPHandler, err := observermcp.NewMCPHandler(
@@ -279,6 +395,8 @@ func main() {
 		authzMetricsService,
 		authzAlertIncidentService,
 		authzTracesService,
+		authzSavedQueryService,
+		authzCorrelationService,
 		logger.With("component", "mcp-handler"),
 	)
 	if err != nil {
@@ -286,7 +404,7 @@ func main() {
 	}
 	newMCPServer := observermcp.NewHTTPServer(newMCPHandler)
 
-	// MCP endpoint with chained middleware (logger -> recovery -> auth401 -> jwt -> handler)
+	// MCP endpoint with chained middleware (standard stack -> auth401 -> jwt -> handler)
 	mcpMiddleware := initMCPMiddleware(logger)
 	mcpRoutes := routes.Group(mcpMiddleware, jwtAuth)
 	mcpRoutes.Handle("/mcp", newMCPServer)
@@ -297,14 +415,17 @@ func main() {
 	addr := fmt.Sprintf(":%d", cfg.Server.Port)
 	server := &http.Server{
 		Addr:         addr,
-		Handler:      observermiddleware.CORS(cfg.CORS.AllowedOrigins)(mux),
+		Handler:      cors.Middleware(cfg.CORS.AllowedOrigins)(mux),
 		ReadTimeout:  cfg.Server.ReadTimeout,
 		WriteTimeout: cfg.Server.WriteTimeout,
 	}
 
 	// ===== Internal Server (port 8081) — v1alpha1 alert CRUD =====
 	internalMux := http.NewServeMux()
-	internalRoutes := middleware.NewRouteBuilder(internalMux).With(loggerMiddleware, recoveryMiddleware)
+	internalRoutes := middleware.NewRouteBuilder(internalMux).With(internalMiddleware...)
+	if serverMetrics != nil {
+		internalMux.Handle("GET /metrics", serverMetrics.Handler())
+	}
 	internalRoutes.HandleFunc(
 		"POST /api/v1alpha1/alerts/sources/{sourceType}/rules", internalHandler.CreateAlertRule)
 	internalRoutes.HandleFunc(
@@ -317,6 +438,22 @@ func main() {
 	// ===== v1alpha1 Alert Webhook Endpoint  =====
 	internalRoutes.HandleFunc("POST /api/v1alpha1/alerts/webhook", internalHandler.HandleAlertWebhook)
 
+	// ===== v1alpha1 SLO Error Budget Endpoint (used by the control plane) =====
+	internalRoutes.HandleFunc("POST /api/v1alpha1/slos/error-budget", internalHandler.EvaluateErrorBudget)
+
+	// ===== v1alpha1 Log Metrics Endpoint (used by the control plane) =====
+	internalRoutes.HandleFunc("POST /api/v1alpha1/metrics/log-metrics/query", internalHandler.QueryLogMetric)
+
+	// ===== v1alpha1 Anomaly Detection Endpoint (used by the control plane) =====
+	internalRoutes.HandleFunc("POST /api/v1alpha1/anomalies/detect", internalHandler.DetectAnomalies)
+
+	// ===== v1alpha1 Promotion Notification Endpoint (used by the control plane) =====
+	internalRoutes.HandleFunc("POST /api/v1alpha1/notifications/promotions", internalHandler.NotifyPromotion)
+
+	// ===== v1alpha1 Log Export Endpoints (used by the observability plane controllers) =====
+	internalRoutes.HandleFunc("PUT /api/v1alpha1/exports/{namespace}/{name}", internalHandler.UpsertExport)
+	internalRoutes.HandleFunc("DELETE /api/v1alpha1/exports/{namespace}/{name}", internalHandler.DeleteExport)
+
 	internalAddr := fmt.Sprintf(":%d", cfg.Server.InternalPort)
 	internalServer := &http.Server{
 		Addr:         internalAddr,
@@ -345,6 +482,34 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	// Provision OpenSearch index lifecycle policies and correct drift until shutdown
+	if cfg.IndexLifecycle.Enabled {
+		logger.Info("Starting index lifecycle manager",
+			"opensearch_url", sanitizeURL(cfg.IndexLifecycle.OpenSearchURL),
+			"sync_interval", cfg.IndexLifecycle.SyncInterval,
+		)
+		indexLifecycleManager := service.NewIndexLifecycleManager(&cfg.IndexLifecycle, logger.With("component", "index-lifecycle"))
+		go indexLifecycleManager.Run(ctx)
+	}
+
+	// Flag idle workloads periodically until shutdown
+	if idleDetector != nil {
+		logger.Info("Starting idle workload detection",
+			"interval", cfg.IdleDetection.Interval,
+			"window", cfg.IdleDetection.Window,
+		)
+		go idleDetector.Run(ctx)
+	}
+
+	// Run registered log exports until shutdown
+	if exportManager != nil {
+		logger.Info("Starting log export",
+			"sync_interval", cfg.Export.SyncInterval,
+			"ingestion_delay", cfg.Export.IngestionDelay,
+		)
+		go exportManager.Run(ctx)
+	}
+
 	// Wait for interrupt signal
 	<-ctx.Done()
 
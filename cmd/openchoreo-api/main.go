@@ -25,6 +25,7 @@ import (
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
 	coreconfig "github.com/openchoreo/openchoreo/internal/config"
 	"github.com/openchoreo/openchoreo/internal/logging"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
@@ -34,13 +35,19 @@ import (
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/mcphandlers"
 	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	autobuildsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/autobuild"
+	directorysyncsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/directorysync"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	k8sresourcessvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/k8sresources"
+	sessiontokensvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sessiontoken"
+	workflowcredentialsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowcredential"
 	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
 	"github.com/openchoreo/openchoreo/internal/server"
 	"github.com/openchoreo/openchoreo/internal/server/middleware"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
-	apilogger "github.com/openchoreo/openchoreo/internal/server/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/session"
 	mcpmiddleware "github.com/openchoreo/openchoreo/internal/server/middleware/mcp"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/metrics"
 	"github.com/openchoreo/openchoreo/internal/version"
 	"github.com/openchoreo/openchoreo/pkg/mcp"
 	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
@@ -97,19 +104,13 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
-	// Create a Kubernetes client for the service layer and PAP.
-	k8sClient, err := k8s.NewK8sClient()
-	if err != nil {
-		logger.Error("Failed to create Kubernetes client", slog.Any("error", err))
-		os.Exit(1)
-	}
-
-	// Set up runtime
-	runtime, err := setupRuntime(ctx, &cfg, k8sClient, logger)
+	// Set up runtime: the Kubernetes client for the service layer and PAP, and authorization
+	runtime, err := setupRuntime(ctx, &cfg, logger)
 	if err != nil {
-		logger.Error("Failed to initialize authorization", slog.Any("error", err))
+		logger.Error("Failed to initialize runtime", slog.Any("error", err))
 		os.Exit(1)
 	}
+	k8sClient := runtime.k8sClient
 
 	// Initialize workflow plane client manager
 	planeK8sClientMgr := kubernetesClient.NewManagerWithProxyTLS(&kubernetesClient.ProxyTLSConfig{
@@ -118,6 +119,8 @@ func main() {
 		ClientKeyPath:  cfg.ClusterGateway.TLS.ClientKeyPath,
 		Insecure:       cfg.ClusterGateway.TLS.Insecure,
 	})
+	// API calls are made on behalf of waiting users, so the gateway serves them ahead of reconciles
+	planeK8sClientMgr.ProxyRequestPriority = messaging.PriorityInteractive
 	logger.Info("Workflow plane client manager created with proxy TLS configuration",
 		"caCert", cfg.ClusterGateway.TLS.CACertPath != "",
 		"clientCert", cfg.ClusterGateway.TLS.ClientCertPath != "",
@@ -143,7 +146,8 @@ func main() {
 		}
 		var err error
 		gwClient, err = gatewayClient.NewClientWithConfig(&gatewayClient.Config{
-			BaseURL: gatewayURL,
+			BaseURL:  gatewayURL,
+			Priority: messaging.PriorityInteractive,
 			TLS: gatewayClient.TLSConfig{
 				CAFile:             cfg.ClusterGateway.TLS.CACertPath,
 				ClientCertFile:     cfg.ClusterGateway.TLS.ClientCertPath,
@@ -162,9 +166,9 @@ func main() {
 			"insecure", cfg.ClusterGateway.TLS.Insecure)
 	}
 
-	// Start background processes (manager + cache sync when authz enabled)
+	// Start background processes (manager + cache sync when authz or the shared cache is enabled)
 	if err := runtime.start(ctx); err != nil {
-		logger.Error("Failed to start authorization runtime", slog.Any("error", err))
+		logger.Error("Failed to start runtime", slog.Any("error", err))
 		os.Exit(1)
 	}
 
@@ -182,18 +186,102 @@ func main() {
 
 	// Initialize all handler services
 	services := handlerservices.NewServices(
-		k8sClient, runtime.pap, runtime.pdp, planeClientProvider, logger, gwClient, webhookProcessor,
+		k8sClient, runtime.statusReader, runtime.pap, runtime.pdp, planeClientProvider, logger, gwClient, webhookProcessor,
 	)
 
+	// Delete debug credentials from the data planes once their tokens have expired.
+	if gwClient != nil {
+		go k8sresourcessvc.RunDebugCredentialSweeper(ctx, k8sClient, gwClient,
+			k8sresourcessvc.DebugCredentialSweepInterval, logger.With("service", "debug-credential-sweeper"))
+	}
+
+	// Start the directory sync (optional). The scheduler and the API share the same base
+	// service so that the status endpoint also reports scheduled runs.
+	if cfg.DirectorySync.Enabled {
+		dirSyncLogger := logger.With("service", "directory-sync")
+		baseDirSyncSvc := directorysyncsvc.NewService(
+			k8sClient, cfg.DirectorySync.NewDirectory(), cfg.DirectorySync.ToDirectorySyncConfig(), dirSyncLogger,
+		)
+		services.DirectorySyncService = directorysyncsvc.NewServiceWithAuthz(baseDirSyncSvc, runtime.pdp, dirSyncLogger)
+		go directorysyncsvc.RunPeriodically(ctx, baseDirSyncSvc, cfg.DirectorySync.Interval, dirSyncLogger)
+		logger.Info("Directory sync enabled", "source", cfg.DirectorySync.Source, "interval", cfg.DirectorySync.Interval)
+	}
+
+	// Create the session token issuer (optional). Session tokens are only accepted when
+	// security is enabled, since they carry authorization decisions.
+	var sessionIssuer *session.Issuer
+	if cfg.Security.Enabled && cfg.Security.SessionTokens.Enabled {
+		sessionIssuer, err = cfg.Security.SessionTokens.NewIssuer()
+		if err != nil {
+			logger.Error("Failed to create session token issuer", slog.Any("error", err))
+			os.Exit(1)
+		}
+		services.SessionTokenService = sessiontokensvc.NewService(
+			k8sClient, sessionIssuer, runtime.pdp, logger.With("service", "session-token"),
+		)
+		logger.Info("Session tokens enabled", "ttl", cfg.Security.SessionTokens.TTL)
+	} else if cfg.Security.SessionTokens.Enabled {
+		logger.Warn("Session tokens are ignored because security is disabled")
+	}
+
+	// Create the workflow credential broker (optional). Its endpoint is authenticated by the
+	// broker tokens of workflow runs, so it does not depend on security being enabled.
+	if cfg.WorkflowCredentials.Enabled {
+		registryIssuer, err := cfg.WorkflowCredentials.Registry.NewRegistryIssuer()
+		if err != nil {
+			logger.Error("Failed to create registry token issuer", slog.Any("error", err))
+			os.Exit(1)
+		}
+		gitHubAppIssuer, err := cfg.WorkflowCredentials.GitHubApp.NewGitHubAppIssuer()
+		if err != nil {
+			logger.Error("Failed to create GitHub App token issuer", slog.Any("error", err))
+			os.Exit(1)
+		}
+		var registry workflowcredentialsvc.RegistryTokenIssuer
+		if registryIssuer != nil {
+			registry = registryIssuer
+		}
+		var git workflowcredentialsvc.GitTokenIssuer
+		if gitHubAppIssuer != nil {
+			git = gitHubAppIssuer
+		}
+		services.WorkflowCredentialService = workflowcredentialsvc.NewService(
+			k8sClient, registry, git, logger.With("service", "workflow-credential"),
+		)
+		logger.Info("Workflow credential broker enabled",
+			"registryPush", registry != nil, "gitRead", git != nil)
+	}
+
 	// Initialize OpenAPI handlers
 	openapiHandler := openapihandlers.New(services, logger.With("component", "openapi-handlers"), &cfg)
-	strictHandler := gen.NewStrictHandler(openapiHandler, nil)
+	// Bodies cut off by the body limit middleware are answered with a 413 instead of the
+	// default 400 for undecodable requests.
+	strictHandler := gen.NewStrictHandlerWithOptions(openapiHandler, nil, gen.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: openapihandlers.RequestErrorHandler,
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	})
 
 	// Initialize JWT middleware
-	jwtMiddleware := openapihandlers.InitJWTMiddleware(&cfg, logger)
+	jwtMiddleware := openapihandlers.InitJWTMiddleware(&cfg, sessionIssuer, logger)
+
+	// HTTP server metrics are shared by the OpenAPI and MCP middleware stacks
+	var serverMetrics *metrics.Metrics
+	if cfg.Server.Middleware.Metrics.Enabled {
+		serverMetrics = metrics.New("openchoreo-api")
+	}
+	middlewareStack := func(component string) []middleware.Middleware {
+		return middleware.Stack(middleware.StackConfig{
+			Logger:    logger.With("component", component),
+			Metrics:   serverMetrics,
+			RateLimit: cfg.Server.Middleware.RateLimit.ToRateLimitConfig(),
+			BodyLimit: cfg.Server.Middleware.BodyLimit.ToBodyLimitConfig(),
+		})
+	}
 
 	// Initialize middlewares for OpenAPI handler
-	loggerMiddleware := apilogger.LoggerMiddleware(logger.With("component", "openapi"))
+	standardMiddleware := gen.MiddlewareFunc(middleware.Chain(middlewareStack("openapi")...))
 	authMiddleware := auth.OpenAPIAuth(jwtMiddleware, gen.BearerAuthScopes)
 
 	// Create base mux for the OpenAPI router.
@@ -208,25 +296,26 @@ func main() {
 		// Build MCP toolsets from config
 		toolsets := buildMCPToolsets(&cfg, services, mcpLogger)
 
-		// MCP middleware chain: logger → auth401 interceptor → JWT auth → handler
-		mcpLoggerMw := apilogger.LoggerMiddleware(mcpLogger)
+		// MCP middleware chain: standard stack → auth401 interceptor → JWT auth → handler
 		resourceMetadataURL := cfg.Server.PublicURL + "/.well-known/oauth-protected-resource"
 		mcpAuth401Mw := mcpmiddleware.Auth401Interceptor(resourceMetadataURL, cfg.Identity.MCPOAuthScopes)
-		mcpHandler := middleware.Chain(mcpLoggerMw, mcpAuth401Mw, jwtMiddleware)(mcp.NewHTTPServer(toolsets, runtime.pdp))
+		mcpChain := append(middlewareStack("mcp"), mcpAuth401Mw, jwtMiddleware)
+		mcpHandler := middleware.Chain(mcpChain...)(mcp.NewHTTPServer(toolsets, runtime.pdp))
 
 		baseMux.Handle("/mcp", mcpHandler)
 	}
 
-	// Create OpenAPI handler with middleware chain (order: logger → auth → webhookBody → handler)
+	// Create OpenAPI handler with middleware chain (order: standard → auth → webhookBody → handler)
 	// Middlewares are applied last-to-first (last entry becomes the outermost wrapper).
-	// Execution order: loggerMiddleware → authMiddleware → webhookRawBodyMiddleware → handler.
-	// loggerMiddleware must be outermost so it captures all responses, including 401s from auth.
+	// Execution order: standardMiddleware → authMiddleware → webhookRawBodyMiddleware → handler.
+	// standardMiddleware (request ID, access log, metrics, recovery, rate limit, body limit) must be
+	// outermost so it captures all responses, including 401s from auth.
 	// webhookRawBodyMiddleware must be innermost (before the strict handler decodes the body)
 	// so that HMAC signature validation can access the original raw bytes.
 	// The generated routes are registered on the baseMux alongside /mcp.
 	handler := gen.HandlerWithOptions(strictHandler, gen.StdHTTPServerOptions{
 		BaseRouter:  baseMux,
-		Middlewares: []gen.MiddlewareFunc{openapihandlers.WebhookRawBodyMiddleware, authMiddleware, loggerMiddleware},
+		Middlewares: []gen.MiddlewareFunc{openapihandlers.WebhookRawBodyMiddleware, authMiddleware, standardMiddleware},
 	})
 
 	// Exec WebSocket endpoint is registered on a top-level mux that wraps the
@@ -268,6 +357,19 @@ func main() {
 			"path", "/api/v1/namespaces/{namespace}/environments/{environment}/wirelogs")
 	}
 
+	// Metrics are served on a separate port, outside the API's routes and authentication,
+	// so that they are only reachable by in-cluster scrapers
+	if serverMetrics != nil {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("GET "+cfg.Server.Middleware.Metrics.Path, serverMetrics.Handler())
+		metricsSrv := server.New(cfg.Server.ToMetricsServerConfig(), metricsMux, logger.With("component", "metrics-server"))
+		go func() {
+			if err := metricsSrv.Run(ctx); err != nil {
+				logger.Error("Metrics server error", slog.Any("error", err))
+			}
+		}()
+	}
+
 	// Create server from configuration
 	srv := server.New(cfg.Server.ToServerConfig(), topHandler, logger)
 
@@ -305,9 +407,12 @@ func setupFlags() (*pflag.FlagSet, *cliFlags) {
 
 // runtime holds the components initialized at startup.
 type runtime struct {
-	pap authzcore.PAP
-	pdp authzcore.PDP
-	// start runs any background processes (manager, cache sync). No-op when authz disabled.
+	k8sClient client.Client
+	// statusReader serves project status; nil when the status model is disabled.
+	statusReader statusmodel.Reader
+	pap          authzcore.PAP
+	pdp          authzcore.PDP
+	// start runs any background processes (manager, cache sync). No-op when no manager is needed.
 	start func(context.Context) error
 }
 
@@ -351,34 +456,48 @@ func buildMCPToolsets(cfg *config.Config, svc *handlerservices.Services, logger
 	return toolsets
 }
 
-// setupRuntime bootstraps the authorization runtime. When authorization is
-// enabled it creates a controller-runtime manager with an informer-based cache
-// for the authz CRDs; when disabled the manager is left nil and
-// authz.Initialize returns a passthrough implementation.
-func setupRuntime(
-	ctx context.Context, cfg *config.Config, k8sClient client.Client, logger *slog.Logger,
-) (*runtime, error) {
+// setupRuntime bootstraps the Kubernetes client, the authorization runtime and the project
+// status model.
+// A controller-runtime manager is created when authorization or the shared informer cache
+// is enabled. Its cache holds the informers for the authz CRDs and, with kubernetes.cache
+// enabled, also serves the reads of the service layer, so each resource type is watched once
+// per replica. When neither is enabled the manager is left nil, reads go straight to the
+// API server and authz.Initialize returns a passthrough implementation.
+func setupRuntime(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*runtime, error) {
 	authzCfg := cfg.Security.Authorization
+	authzEnabled := cfg.Security.Enabled && authzCfg.Enabled
+	cacheCfg := cfg.Kubernetes.Cache
+
+	k8sClient, err := k8s.NewK8sClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
 	var mgr ctrl.Manager
+	if authzEnabled || cacheCfg.Enabled {
+		logger.Info("Setting up controller manager for informer cache",
+			"authz", authzEnabled, "sharedCache", cacheCfg.Enabled)
+		scheme, err := k8s.NewScheme()
+		if err != nil {
+			return nil, err
+		}
 
-	// When enabled, create a controller-runtime manager with informers for authz CRDs
-	if cfg.Security.Enabled && authzCfg.Enabled {
-		logger.Info("Setting up controller manager for authorization CRD informers")
-		cacheOpts := cache.Options{
-			ByObject: map[client.Object]cache.ByObject{
+		var cacheOpts cache.Options
+		if authzEnabled {
+			cacheOpts.ByObject = map[client.Object]cache.ByObject{
 				&openchoreov1alpha1.AuthzRole{}:               {},
 				&openchoreov1alpha1.ClusterAuthzRole{}:        {},
 				&openchoreov1alpha1.AuthzRoleBinding{}:        {},
 				&openchoreov1alpha1.ClusterAuthzRoleBinding{}: {},
-			},
+			}
 		}
-		if authzCfg.ResyncInterval > 0 {
-			cacheOpts.SyncPeriod = &authzCfg.ResyncInterval
-			logger.Info("Informer resync enabled", "interval", authzCfg.ResyncInterval)
+		if resync := informerResyncInterval(authzEnabled, authzCfg.ResyncInterval, cacheCfg); resync > 0 {
+			cacheOpts.SyncPeriod = &resync
+			logger.Info("Informer resync enabled", "interval", resync)
 		}
 
-		var err error
 		mgr, err = ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+			Scheme:         scheme,
 			LeaderElection: false,
 			Metrics:        metricsserver.Options{BindAddress: "0"},
 			Cache:          cacheOpts,
@@ -386,6 +505,14 @@ func setupRuntime(
 		if err != nil {
 			return nil, fmt.Errorf("failed to create controller manager: %w", err)
 		}
+
+		if cacheCfg.Enabled {
+			k8sClient, err = k8s.NewCachedK8sClient(mgr.GetConfig(), scheme, mgr.GetCache())
+			if err != nil {
+				return nil, fmt.Errorf("failed to create cached Kubernetes client: %w", err)
+			}
+			logger.Info("Serving Kubernetes reads from the shared informer cache")
+		}
 	}
 
 	pap, pdp, err := authz.Initialize(ctx, mgr, authzCfg.ToAuthzConfig(cfg.Security.Enabled), k8sClient, logger)
@@ -393,27 +520,81 @@ func setupRuntime(
 		return nil, fmt.Errorf("failed to initialize authorization: %w", err)
 	}
 
-	rt := &runtime{pap: pap, pdp: pdp, start: func(context.Context) error { return nil }}
-	if mgr != nil {
+	// The status model has a dedicated cache because it strips RenderedReleases down to
+	// the fields it needs, which would break other readers of a shared cache.
+	var statusReader statusmodel.Reader
+	var statusCache cache.Cache
+	if cfg.Kubernetes.StatusModel.Enabled {
+		scheme, err := k8s.NewScheme()
+		if err != nil {
+			return nil, err
+		}
+		statusCache, err = statusmodel.NewCache(ctrl.GetConfigOrDie(), scheme)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create status model cache: %w", err)
+		}
+		store := statusmodel.NewStore()
+		if err := store.Register(ctx, statusCache); err != nil {
+			return nil, fmt.Errorf("failed to register status model: %w", err)
+		}
+		statusReader = store
+		logger.Info("Serving project status from the in-memory status model")
+	}
+
+	rt := &runtime{
+		k8sClient:    k8sClient,
+		statusReader: statusReader,
+		pap:          pap,
+		pdp:          pdp,
+		start:        func(context.Context) error { return nil },
+	}
+	if mgr != nil || statusCache != nil {
 		rt.start = func(ctx context.Context) error {
-			go func() {
-				if err := mgr.Start(ctx); err != nil {
-					logger.Error("Controller manager error", slog.Any("error", err))
-				}
-			}()
+			if mgr != nil {
+				go func() {
+					if err := mgr.Start(ctx); err != nil {
+						logger.Error("Controller manager error", slog.Any("error", err))
+					}
+				}()
+			}
+			if statusCache != nil {
+				go func() {
+					if err := statusCache.Start(ctx); err != nil {
+						logger.Error("Status model cache error", slog.Any("error", err))
+					}
+				}()
+			}
 
 			// timeout to avoid blocking startup indefinitely
 			syncCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 			defer cancel()
 
-			// Wait for cache sync
-			if !mgr.GetCache().WaitForCacheSync(syncCtx) {
-				return fmt.Errorf("failed to sync authz cache")
+			// Wait for cache sync. Informers for the service layer are started lazily on
+			// first use, so only the authz and status model informers are waited for here.
+			if mgr != nil && !mgr.GetCache().WaitForCacheSync(syncCtx) {
+				return fmt.Errorf("failed to sync informer cache")
 			}
-			logger.Info("Authz cache synced - policies loaded")
+			if statusCache != nil && !statusCache.WaitForCacheSync(syncCtx) {
+				return fmt.Errorf("failed to sync status model cache")
+			}
+			logger.Info("Informer cache synced", "authz", authzEnabled, "statusModel", statusCache != nil)
 			return nil
 		}
 	}
 
 	return rt, nil
 }
+
+// informerResyncInterval returns the resync period of the shared manager cache. A cache has a
+// single resync period, so when both the authz informers and the shared cache are active the
+// shorter non-zero interval wins.
+func informerResyncInterval(authzEnabled bool, authzResync time.Duration, cacheCfg config.KubernetesCacheConfig) time.Duration {
+	var resync time.Duration
+	if authzEnabled {
+		resync = authzResync
+	}
+	if cacheCfg.Enabled && cacheCfg.ResyncInterval > 0 && (resync == 0 || cacheCfg.ResyncInterval < resync) {
+		resync = cacheCfg.ResyncInterval
+	}
+	return resync
+}
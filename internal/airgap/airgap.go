@@ -0,0 +1,408 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package airgap implements the air-gapped installation mode.
+//
+// Rendered resources (workloads, workflow runs, trait-generated resources) reference
+// external artifacts such as container images, buildpack builders and Helm chart
+// repositories. A Policy rewrites those references through a mirror map so that they
+// resolve to an internal registry, and in air-gapped mode reports every reference that
+// would still leave the installation.
+package airgap
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Mirror maps an external reference prefix to the internal prefix that serves the same artifacts.
+type Mirror struct {
+	// Source is the external prefix, for example "docker.io/paketobuildpacks" or
+	// "https://charts.bitnami.com/bitnami".
+	Source string `json:"source"`
+	// Target replaces Source, for example "registry.internal/paketobuildpacks".
+	Target string `json:"target"`
+}
+
+// Config is the mirror configuration loaded from the air-gap config file.
+type Config struct {
+	// Mirrors are applied to every rendered resource, longest source first.
+	Mirrors []Mirror `json:"mirrors,omitempty"`
+	// InternalHosts are hosts that are reachable without leaving the installation.
+	// An entry starting with "." matches every subdomain.
+	InternalHosts []string `json:"internalHosts,omitempty"`
+}
+
+// LoadConfig reads a Config from a YAML or JSON file.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read air-gap config: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse air-gap config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate checks that every mirror has a source and a target and that sources are unique.
+func (c Config) Validate() error {
+	seen := make(map[string]bool, len(c.Mirrors))
+	for i, m := range c.Mirrors {
+		if m.Source == "" || m.Target == "" {
+			return fmt.Errorf("mirrors[%d]: source and target are required", i)
+		}
+		if seen[m.Source] {
+			return fmt.Errorf("mirrors[%d]: duplicate source %q", i, m.Source)
+		}
+		seen[m.Source] = true
+	}
+	for i, h := range c.InternalHosts {
+		if strings.TrimPrefix(h, ".") == "" {
+			return fmt.Errorf("internalHosts[%d]: host must not be empty", i)
+		}
+	}
+	return nil
+}
+
+// Reference is an external artifact reference found in a resource.
+type Reference struct {
+	// Path is the location of the value in the resource, for example
+	// "spec.template.spec.containers[0].image".
+	Path string
+	// Value is the reference as it appears after mirror rewriting.
+	Value string
+	// Host is the host the reference would be fetched from.
+	Host string
+}
+
+func (r Reference) String() string {
+	return r.Path + ": " + r.Value
+}
+
+// ViolationError is returned by Enforce when a resource references artifacts outside the installation.
+type ViolationError struct {
+	References []Reference
+}
+
+func (e *ViolationError) Error() string {
+	const shown = 3
+	parts := make([]string, 0, shown)
+	for i, ref := range e.References {
+		if i == shown {
+			break
+		}
+		parts = append(parts, ref.String())
+	}
+	msg := "air-gapped mode forbids external references without a mirror: " + strings.Join(parts, ", ")
+	if extra := len(e.References) - shown; extra > 0 {
+		msg += " (and " + strconv.Itoa(extra) + " more)"
+	}
+	return msg
+}
+
+// Policy rewrites and checks external references. A nil Policy leaves resources untouched.
+type Policy struct {
+	enforce       bool
+	mirrors       []Mirror
+	internalHosts []string
+}
+
+// NewPolicy builds a Policy from cfg. When enforce is false, references are still rewritten
+// through the mirrors but Enforce never reports violations.
+func NewPolicy(enforce bool, cfg Config) (*Policy, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	mirrors := slices.Clone(cfg.Mirrors)
+	sort.SliceStable(mirrors, func(i, j int) bool { return len(mirrors[i].Source) > len(mirrors[j].Source) })
+
+	internalHosts := slices.Clone(cfg.InternalHosts)
+	for _, m := range mirrors {
+		if host := referenceHost(m.Target); host != "" && !slices.Contains(internalHosts, host) {
+			internalHosts = append(internalHosts, host)
+		}
+	}
+	return &Policy{enforce: enforce, mirrors: mirrors, internalHosts: internalHosts}, nil
+}
+
+// Enforced reports whether the policy rejects unmirrored external references.
+func (p *Policy) Enforced() bool {
+	return p != nil && p.enforce
+}
+
+// Resolve returns ref rewritten through the mirror with the longest matching source.
+// The boolean result reports whether a mirror matched.
+func (p *Policy) Resolve(ref string) (string, bool) {
+	if p == nil {
+		return ref, false
+	}
+	for _, m := range p.mirrors {
+		if matchesPrefix(ref, m.Source) {
+			return m.Target + ref[len(m.Source):], true
+		}
+	}
+	// Images without a registry are pulled from Docker Hub; match them against docker.io mirrors.
+	if canonical, ok := canonicalDockerHubImage(ref); ok {
+		for _, m := range p.mirrors {
+			if matchesPrefix(canonical, m.Source) {
+				return m.Target + canonical[len(m.Source):], true
+			}
+		}
+	}
+	return ref, false
+}
+
+// Enforce rewrites obj in place through the mirrors. When the policy is enforced it returns a
+// *ViolationError listing the references that still point outside the installation.
+func (p *Policy) Enforce(obj map[string]any) error {
+	if p == nil {
+		return nil
+	}
+	refs := p.rewrite(obj)
+	if p.enforce && len(refs) > 0 {
+		return &ViolationError{References: refs}
+	}
+	return nil
+}
+
+// Scan returns the external references in obj that remain after mirror rewriting, without
+// modifying obj. Values containing unresolved CEL expressions in their host are skipped since
+// the host is only known at render time.
+func (p *Policy) Scan(obj any) ([]Reference, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	var copied any
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resource: %w", err)
+	}
+	if p == nil {
+		p = &Policy{}
+	}
+	root, ok := copied.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	return p.rewrite(root), nil
+}
+
+// rewrite replaces mirrored references in obj and returns the external references left over.
+func (p *Policy) rewrite(obj map[string]any) []Reference {
+	var refs []Reference
+	var walk func(v any, path, key string) any
+	walk = func(v any, path, key string) any {
+		switch val := v.(type) {
+		case map[string]any:
+			keys := make([]string, 0, len(val))
+			for k := range val {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				val[k] = walk(val[k], joinPath(path, k), k)
+			}
+			return val
+		case []any:
+			for i := range val {
+				val[i] = walk(val[i], path+"["+strconv.Itoa(i)+"]", key)
+			}
+			return val
+		case string:
+			rewritten, found := p.rewriteString(val, key)
+			for _, f := range found {
+				refs = append(refs, Reference{Path: path, Value: f.value, Host: f.host})
+			}
+			return rewritten
+		default:
+			return v
+		}
+	}
+	walk(obj, "", "")
+	return refs
+}
+
+type foundReference struct {
+	value string
+	host  string
+}
+
+var (
+	// urlPattern matches URLs embedded in arbitrary strings such as script bodies and arguments.
+	urlPattern = regexp.MustCompile(`\b(?:https?|oci|git|ssh)://(?:\$\{[^}]*\}|[^\s"'<>()\[\]{},;])+`)
+	// scpGitPattern matches scp-style git remotes such as git@github.com:org/repo.git.
+	scpGitPattern = regexp.MustCompile(`\bgit@([A-Za-z0-9.-]+):[^\s"'<>]+`)
+)
+
+// rewriteString rewrites the mirrored references in s and returns the external references that remain.
+// The whole value of an "image" field is treated as a container image reference.
+func (p *Policy) rewriteString(s, key string) (string, []foundReference) {
+	if key == "image" {
+		rewritten, _ := p.Resolve(s)
+		if host := imageHost(rewritten); host != "" && !p.isInternal(host) {
+			return rewritten, []foundReference{{value: rewritten, host: host}}
+		}
+		return rewritten, nil
+	}
+
+	rewritten := p.rewriteEmbedded(s)
+	var found []foundReference
+	for _, u := range urlPattern.FindAllString(rewritten, -1) {
+		if host := referenceHost(u); host != "" && !p.isInternal(host) {
+			found = append(found, foundReference{value: u, host: host})
+		}
+	}
+	for _, m := range scpGitPattern.FindAllStringSubmatch(rewritten, -1) {
+		if host := m[1]; !p.isInternal(host) {
+			found = append(found, foundReference{value: m[0], host: host})
+		}
+	}
+	return rewritten, found
+}
+
+// rewriteEmbedded replaces every occurrence of a mirror source in s that starts at a token
+// boundary, so that references inside arguments ("--builder=docker.io/...") and scripts are
+// rewritten as well.
+func (p *Policy) rewriteEmbedded(s string) string {
+	if len(p.mirrors) == 0 {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if i == 0 || isBoundary(s[i-1]) {
+			if m, ok := p.matchAt(s[i:]); ok {
+				b.WriteString(m.Target)
+				i += len(m.Source)
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
+
+func (p *Policy) matchAt(s string) (Mirror, bool) {
+	for _, m := range p.mirrors {
+		if matchesPrefix(s, m.Source) {
+			return m, true
+		}
+	}
+	return Mirror{}, false
+}
+
+// isInternal reports whether host can be reached without leaving the installation.
+func (p *Policy) isInternal(host string) bool {
+	if strings.Contains(host, "${") {
+		return true
+	}
+	host = strings.ToLower(host)
+	if host == "localhost" || strings.HasSuffix(host, ".svc") || strings.HasSuffix(host, ".cluster.local") {
+		return true
+	}
+	if ip := net.ParseIP(host); ip != nil && (ip.IsLoopback() || ip.IsPrivate()) {
+		return true
+	}
+	for _, h := range p.internalHosts {
+		h = strings.ToLower(h)
+		if strings.HasPrefix(h, ".") {
+			if strings.HasSuffix(host, h) || host == h[1:] {
+				return true
+			}
+			continue
+		}
+		if host == h {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPrefix reports whether s starts with prefix and the match ends at a path or tag boundary.
+func matchesPrefix(s, prefix string) bool {
+	if !strings.HasPrefix(s, prefix) {
+		return false
+	}
+	if len(s) == len(prefix) || strings.HasSuffix(prefix, "/") {
+		return true
+	}
+	switch s[len(prefix)] {
+	case '/', ':', '@':
+		return true
+	}
+	return isBoundary(s[len(prefix)])
+}
+
+func isBoundary(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return false
+	case c == '.', c == '-', c == '_', c == '/':
+		return false
+	}
+	return true
+}
+
+// referenceHost returns the host of a URL or image reference, without port.
+func referenceHost(ref string) string {
+	if strings.Contains(ref, "://") {
+		u, err := url.Parse(ref)
+		if err != nil {
+			return ""
+		}
+		return u.Hostname()
+	}
+	return imageHost(ref)
+}
+
+// imageHost returns the registry host of a container image reference. Images without an
+// explicit registry are pulled from Docker Hub. Empty values and unresolved CEL expressions
+// return an empty host.
+func imageHost(image string) string {
+	if image == "" || strings.HasPrefix(image, "${") {
+		return ""
+	}
+	first, _, hasSlash := strings.Cut(image, "/")
+	if !hasSlash || !(strings.ContainsAny(first, ".:") || first == "localhost") {
+		return "docker.io"
+	}
+	if host, _, err := net.SplitHostPort(first); err == nil {
+		return host
+	}
+	return first
+}
+
+// canonicalDockerHubImage expands an image without a registry to its docker.io form,
+// for example "nginx:1.27" to "docker.io/library/nginx:1.27".
+func canonicalDockerHubImage(image string) (string, bool) {
+	if image == "" || strings.HasPrefix(image, "${") {
+		return "", false
+	}
+	first, _, hasSlash := strings.Cut(image, "/")
+	if hasSlash && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		return "", false
+	}
+	if !hasSlash {
+		return "docker.io/library/" + image, true
+	}
+	return "docker.io/" + image, true
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
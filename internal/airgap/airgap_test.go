@@ -0,0 +1,168 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package airgap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPolicy(t *testing.T, enforce bool) *Policy {
+	t.Helper()
+	p, err := NewPolicy(enforce, Config{
+		Mirrors: []Mirror{
+			{Source: "docker.io", Target: "registry.internal/dockerhub"},
+			{Source: "docker.io/paketobuildpacks", Target: "registry.internal/buildpacks"},
+			{Source: "https://charts.bitnami.com/bitnami", Target: "https://charts.internal/bitnami"},
+		},
+		InternalHosts: []string{".corp.example"},
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func TestResolve(t *testing.T) {
+	p := newTestPolicy(t, true)
+
+	tests := []struct {
+		ref     string
+		want    string
+		matched bool
+	}{
+		{"docker.io/paketobuildpacks/builder:base", "registry.internal/buildpacks/builder:base", true},
+		{"docker.io/library/nginx:1.27", "registry.internal/dockerhub/library/nginx:1.27", true},
+		{"nginx:1.27", "registry.internal/dockerhub/library/nginx:1.27", true},
+		{"bitnami/redis", "registry.internal/dockerhub/bitnami/redis", true},
+		{"docker.io.evil.com/app", "docker.io.evil.com/app", false},
+		{"ghcr.io/openchoreo/app:v1", "ghcr.io/openchoreo/app:v1", false},
+		{"https://charts.bitnami.com/bitnami/index.yaml", "https://charts.internal/bitnami/index.yaml", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, matched := p.Resolve(tt.ref)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.matched, matched)
+		})
+	}
+
+	var nilPolicy *Policy
+	got, matched := nilPolicy.Resolve("nginx")
+	assert.Equal(t, "nginx", got)
+	assert.False(t, matched)
+}
+
+func TestEnforce(t *testing.T) {
+	obj := func() map[string]any {
+		return map[string]any{
+			"spec": map[string]any{
+				"containers": []any{
+					map[string]any{
+						"image": "docker.io/paketobuildpacks/builder-jammy-base:0.4",
+						"args":  []any{"--run-image=docker.io/paketobuildpacks/run:base"},
+					},
+					map[string]any{"image": "ghcr.io/acme/tool:v1"},
+					map[string]any{"image": "registry.corp.example/app:v2"},
+					map[string]any{"image": "${parameters.image}"},
+				},
+				"chart":  map[string]any{"repoURL": "https://charts.bitnami.com/bitnami"},
+				"script": "git clone git@github.com:acme/app.git && curl -sf http://artifacts.svc.cluster.local/x",
+				"source": "https://${parameters.gitHost}/repo.git",
+			},
+		}
+	}
+
+	t.Run("rewrites mirrored references and reports the rest", func(t *testing.T) {
+		o := obj()
+		err := newTestPolicy(t, true).Enforce(o)
+
+		var violation *ViolationError
+		require.ErrorAs(t, err, &violation)
+		assert.Equal(t, []Reference{
+			{Path: "spec.containers[1].image", Value: "ghcr.io/acme/tool:v1", Host: "ghcr.io"},
+			{Path: "spec.script", Value: "git@github.com:acme/app.git", Host: "github.com"},
+		}, violation.References)
+
+		spec := o["spec"].(map[string]any)
+		containers := spec["containers"].([]any)
+		first := containers[0].(map[string]any)
+		assert.Equal(t, "registry.internal/buildpacks/builder-jammy-base:0.4", first["image"])
+		assert.Equal(t, []any{"--run-image=registry.internal/buildpacks/run:base"}, first["args"])
+		assert.Equal(t, "https://charts.internal/bitnami", spec["chart"].(map[string]any)["repoURL"])
+	})
+
+	t.Run("rewrites without enforcing", func(t *testing.T) {
+		o := obj()
+		require.NoError(t, newTestPolicy(t, false).Enforce(o))
+		first := o["spec"].(map[string]any)["containers"].([]any)[0].(map[string]any)
+		assert.Equal(t, "registry.internal/buildpacks/builder-jammy-base:0.4", first["image"])
+	})
+
+	t.Run("nil policy is a no-op", func(t *testing.T) {
+		var p *Policy
+		o := obj()
+		require.NoError(t, p.Enforce(o))
+		assert.Equal(t, obj(), o)
+	})
+}
+
+func TestScanDoesNotModifyInput(t *testing.T) {
+	p := newTestPolicy(t, true)
+	in := map[string]any{"image": "quay.io/acme/app", "mirrored": map[string]any{"image": "nginx"}}
+
+	refs, err := p.Scan(in)
+	require.NoError(t, err)
+	assert.Equal(t, []Reference{{Path: "image", Value: "quay.io/acme/app", Host: "quay.io"}}, refs)
+	assert.Equal(t, "nginx", in["mirrored"].(map[string]any)["image"])
+}
+
+func TestViolationErrorMessage(t *testing.T) {
+	err := &ViolationError{References: []Reference{
+		{Path: "a", Value: "ghcr.io/1"},
+		{Path: "b", Value: "ghcr.io/2"},
+		{Path: "c", Value: "ghcr.io/3"},
+		{Path: "d", Value: "ghcr.io/4"},
+	}}
+	assert.Equal(t, "air-gapped mode forbids external references without a mirror: "+
+		"a: ghcr.io/1, b: ghcr.io/2, c: ghcr.io/3 (and 1 more)", err.Error())
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("valid", func(t *testing.T) {
+		path := filepath.Join(dir, "valid.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(`mirrors:
+- source: docker.io
+  target: registry.internal/dockerhub
+internalHosts:
+- .corp.example
+`), 0o600))
+		cfg, err := LoadConfig(path)
+		require.NoError(t, err)
+		assert.Equal(t, []Mirror{{Source: "docker.io", Target: "registry.internal/dockerhub"}}, cfg.Mirrors)
+		assert.Equal(t, []string{".corp.example"}, cfg.InternalHosts)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		path := filepath.Join(dir, "unknown.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("mirror: []\n"), 0o600))
+		_, err := LoadConfig(path)
+		require.Error(t, err)
+	})
+}
+
+func TestNewPolicyRejectsInvalidConfig(t *testing.T) {
+	_, err := NewPolicy(true, Config{Mirrors: []Mirror{{Source: "docker.io"}}})
+	require.ErrorContains(t, err, "source and target are required")
+
+	_, err = NewPolicy(true, Config{Mirrors: []Mirror{
+		{Source: "docker.io", Target: "a"},
+		{Source: "docker.io", Target: "b"},
+	}})
+	require.ErrorContains(t, err, "duplicate source")
+}
@@ -0,0 +1,310 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package analysisreport parses SARIF reports produced by the static analysis step of
+// component workflows and evaluates them against the quality gates that gate release creation.
+package analysisreport
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// maxFindings is the number of findings kept in the summary.
+	maxFindings = 100
+	// maxMessageLength is the length finding messages are truncated to.
+	maxMessageLength = 1024
+)
+
+// severityOrder ranks severities from most to least severe.
+var severityOrder = []openchoreov1alpha1.AnalysisSeverity{
+	openchoreov1alpha1.AnalysisSeverityCritical,
+	openchoreov1alpha1.AnalysisSeverityHigh,
+	openchoreov1alpha1.AnalysisSeverityMedium,
+	openchoreov1alpha1.AnalysisSeverityLow,
+	openchoreov1alpha1.AnalysisSeverityInfo,
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool struct {
+		Driver struct {
+			Name  string      `json:"name"`
+			Rules []sarifRule `json:"rules"`
+		} `json:"driver"`
+	} `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifRule struct {
+	ID                   string `json:"id"`
+	DefaultConfiguration struct {
+		Level string `json:"level"`
+	} `json:"defaultConfiguration"`
+	Properties sarifProperties `json:"properties"`
+}
+
+type sarifResult struct {
+	RuleID    string `json:"ruleId"`
+	RuleIndex *int   `json:"ruleIndex"`
+	Kind      string `json:"kind"`
+	Level     string `json:"level"`
+	Message   struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	Locations []struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+			Region struct {
+				StartLine int32 `json:"startLine"`
+			} `json:"region"`
+		} `json:"physicalLocation"`
+	} `json:"locations"`
+	Suppressions []json.RawMessage `json:"suppressions"`
+	Properties   sarifProperties   `json:"properties"`
+}
+
+type sarifProperties struct {
+	// SecuritySeverity is the CVSS-style score reported by security analyzers.
+	SecuritySeverity json.RawMessage `json:"security-severity"`
+}
+
+// Parse summarizes a SARIF 2.1.0 report. Each run of the report is attributed to the tool
+// that produced it. Suppressed results and results that do not report a problem are ignored.
+func Parse(data []byte) (*openchoreov1alpha1.WorkflowAnalysisResults, error) {
+	var report sarifLog
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("invalid SARIF report: %w", err)
+	}
+	if report.Version != "" && !strings.HasPrefix(report.Version, "2.") {
+		return nil, fmt.Errorf("invalid SARIF report: unsupported version %q", report.Version)
+	}
+	if report.Runs == nil {
+		return nil, fmt.Errorf("invalid SARIF report: no runs")
+	}
+
+	results := &openchoreov1alpha1.WorkflowAnalysisResults{}
+	var findings []openchoreov1alpha1.AnalysisFinding
+	for _, run := range report.Runs {
+		tool := run.Tool.Driver.Name
+		if tool != "" && !slices.Contains(results.Tools, tool) {
+			results.Tools = append(results.Tools, tool)
+		}
+		rules := make(map[string]*sarifRule, len(run.Tool.Driver.Rules))
+		for i := range run.Tool.Driver.Rules {
+			rules[run.Tool.Driver.Rules[i].ID] = &run.Tool.Driver.Rules[i]
+		}
+
+		for _, r := range run.Results {
+			if len(r.Suppressions) > 0 || (r.Kind != "" && r.Kind != "fail") {
+				continue
+			}
+			rule := rules[r.RuleID]
+			if rule == nil && r.RuleIndex != nil && *r.RuleIndex >= 0 && *r.RuleIndex < len(run.Tool.Driver.Rules) {
+				rule = &run.Tool.Driver.Rules[*r.RuleIndex]
+			}
+			finding := openchoreov1alpha1.AnalysisFinding{
+				Tool:     tool,
+				RuleID:   r.RuleID,
+				Severity: severity(r, rule),
+				Message:  truncate(strings.TrimSpace(r.Message.Text)),
+			}
+			if finding.RuleID == "" && rule != nil {
+				finding.RuleID = rule.ID
+			}
+			if len(r.Locations) > 0 {
+				finding.File = r.Locations[0].PhysicalLocation.ArtifactLocation.URI
+				finding.Line = r.Locations[0].PhysicalLocation.Region.StartLine
+			}
+			addCount(results, finding.Severity)
+			findings = append(findings, finding)
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return rank(findings[i].Severity) < rank(findings[j].Severity)
+	})
+	if len(findings) > maxFindings {
+		findings = findings[:maxFindings]
+	}
+	results.Findings = findings
+	return results, nil
+}
+
+// severity determines the severity of a result. A security severity score takes precedence
+// over the SARIF level, which defaults to the rule's configured level and then to warning.
+func severity(r sarifResult, rule *sarifRule) openchoreov1alpha1.AnalysisSeverity {
+	score, ok := parseScore(r.Properties.SecuritySeverity)
+	if !ok && rule != nil {
+		score, ok = parseScore(rule.Properties.SecuritySeverity)
+	}
+	if ok {
+		switch {
+		case score >= 9:
+			return openchoreov1alpha1.AnalysisSeverityCritical
+		case score >= 7:
+			return openchoreov1alpha1.AnalysisSeverityHigh
+		case score >= 4:
+			return openchoreov1alpha1.AnalysisSeverityMedium
+		case score > 0:
+			return openchoreov1alpha1.AnalysisSeverityLow
+		}
+		return openchoreov1alpha1.AnalysisSeverityInfo
+	}
+
+	level := r.Level
+	if level == "" && rule != nil {
+		level = rule.DefaultConfiguration.Level
+	}
+	switch level {
+	case "error":
+		return openchoreov1alpha1.AnalysisSeverityHigh
+	case "note":
+		return openchoreov1alpha1.AnalysisSeverityLow
+	case "none":
+		return openchoreov1alpha1.AnalysisSeverityInfo
+	}
+	return openchoreov1alpha1.AnalysisSeverityMedium
+}
+
+// parseScore reads a security severity score, which analyzers report as a string or a number.
+func parseScore(raw json.RawMessage) (float64, bool) {
+	if len(raw) == 0 {
+		return 0, false
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		s = string(raw)
+	}
+	score, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, false
+	}
+	return score, true
+}
+
+func addCount(results *openchoreov1alpha1.WorkflowAnalysisResults, severity openchoreov1alpha1.AnalysisSeverity) {
+	switch severity {
+	case openchoreov1alpha1.AnalysisSeverityCritical:
+		results.Critical++
+	case openchoreov1alpha1.AnalysisSeverityHigh:
+		results.High++
+	case openchoreov1alpha1.AnalysisSeverityMedium:
+		results.Medium++
+	case openchoreov1alpha1.AnalysisSeverityLow:
+		results.Low++
+	default:
+		results.Info++
+	}
+}
+
+func rank(severity openchoreov1alpha1.AnalysisSeverity) int {
+	for i, s := range severityOrder {
+		if s == severity {
+			return i
+		}
+	}
+	return len(severityOrder)
+}
+
+// IsSeverity reports whether the value is a known severity.
+func IsSeverity(severity openchoreov1alpha1.AnalysisSeverity) bool {
+	return rank(severity) < len(severityOrder)
+}
+
+// AtLeast reports whether a severity is at least as severe as the given minimum.
+func AtLeast(severity, minimum openchoreov1alpha1.AnalysisSeverity) bool {
+	return rank(severity) <= rank(minimum)
+}
+
+func truncate(s string) string {
+	if len(s) <= maxMessageLength {
+		return s
+	}
+	return s[:maxMessageLength] + "..."
+}
+
+// Violations returns a description of each threshold of the quality gates that the results
+// exceed. When several gates set a threshold for the same severity, the strictest one applies.
+func Violations(results *openchoreov1alpha1.WorkflowAnalysisResults, gates ...*openchoreov1alpha1.AnalysisQualityGate) []string {
+	var violations []string
+	for _, severity := range severityOrder {
+		limit, ok := threshold(severity, gates)
+		if !ok {
+			continue
+		}
+		if count := results.Count(severity); count > limit {
+			violations = append(violations, fmt.Sprintf("%d %s findings exceed the maximum of %d",
+				count, strings.ToLower(string(severity)), limit))
+		}
+	}
+	return violations
+}
+
+// threshold returns the strictest threshold the gates set for a severity.
+func threshold(severity openchoreov1alpha1.AnalysisSeverity, gates []*openchoreov1alpha1.AnalysisQualityGate) (int32, bool) {
+	var limit int32
+	found := false
+	for _, gate := range gates {
+		if gate == nil {
+			continue
+		}
+		var maxAllowed *int32
+		switch severity {
+		case openchoreov1alpha1.AnalysisSeverityCritical:
+			maxAllowed = gate.MaxCritical
+		case openchoreov1alpha1.AnalysisSeverityHigh:
+			maxAllowed = gate.MaxHigh
+		case openchoreov1alpha1.AnalysisSeverityMedium:
+			maxAllowed = gate.MaxMedium
+		case openchoreov1alpha1.AnalysisSeverityLow:
+			maxAllowed = gate.MaxLow
+		}
+		if maxAllowed != nil && (!found || *maxAllowed < limit) {
+			limit, found = *maxAllowed, true
+		}
+	}
+	return limit, found
+}
+
+// hasThresholds reports whether any of the gates enforces a threshold.
+func hasThresholds(gates []*openchoreov1alpha1.AnalysisQualityGate) bool {
+	for _, severity := range severityOrder {
+		if _, ok := threshold(severity, gates); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ReleaseBlockedMessage returns a message explaining why release creation is blocked by the
+// analysis findings of the given run, or an empty string when the run meets the quality gates.
+// Runs without analysis results are not gated.
+func ReleaseBlockedMessage(run *openchoreov1alpha1.WorkflowRun, gates ...*openchoreov1alpha1.AnalysisQualityGate) string {
+	if run == nil || run.Status.AnalysisResults == nil || !hasThresholds(gates) {
+		return ""
+	}
+	results := run.Status.AnalysisResults
+	if results.Message != "" {
+		return fmt.Sprintf("Static analysis of workflow run %q could not be evaluated: %s", run.Name, results.Message)
+	}
+	violations := Violations(results, gates...)
+	if len(violations) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Static analysis of workflow run %q does not meet the quality gate: %s",
+		run.Name, strings.Join(violations, "; "))
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analysisreport
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const lintReport = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "golangci-lint"}},
+      "results": [
+        {
+          "ruleId": "errcheck",
+          "level": "error",
+          "message": {"text": "Error return value is not checked"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "main.go"}, "region": {"startLine": 12}}}]
+        },
+        {"ruleId": "unused", "level": "warning", "message": {"text": "func helper is unused"}},
+        {"ruleId": "godot", "level": "note", "message": {"text": "Comment should end in a period"}},
+        {"ruleId": "lll", "message": {"text": "line is 140 characters"}, "suppressions": [{"kind": "inSource"}]}
+      ]
+    },
+    {
+      "tool": {"driver": {"name": "semgrep", "rules": [
+        {"id": "sql-injection", "properties": {"security-severity": "9.8"}},
+        {"id": "weak-hash", "defaultConfiguration": {"level": "note"}}
+      ]}},
+      "results": [
+        {"ruleId": "sql-injection", "message": {"text": "Possible SQL injection"}},
+        {"ruleIndex": 1, "message": {"text": "MD5 is a weak hash"}},
+        {"ruleId": "open-redirect", "level": "error", "properties": {"security-severity": 7.2}, "message": {"text": "Open redirect"}},
+        {"ruleId": "sql-injection", "kind": "pass", "message": {"text": "checked"}}
+      ]
+    }
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	results, err := Parse([]byte(lintReport))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if got := strings.Join(results.Tools, ","); got != "golangci-lint,semgrep" {
+		t.Errorf("Tools = %q, want golangci-lint,semgrep", got)
+	}
+	if results.Critical != 1 || results.High != 2 || results.Medium != 1 || results.Low != 2 || results.Info != 0 {
+		t.Errorf("counts = %d critical, %d high, %d medium, %d low, %d info, want 1, 2, 1, 2, 0",
+			results.Critical, results.High, results.Medium, results.Low, results.Info)
+	}
+	if len(results.Findings) != 6 {
+		t.Fatalf("len(Findings) = %d, want 6", len(results.Findings))
+	}
+
+	first := results.Findings[0]
+	if first.Tool != "semgrep" || first.RuleID != "sql-injection" || first.Severity != openchoreov1alpha1.AnalysisSeverityCritical {
+		t.Errorf("Findings[0] = %+v, want the critical semgrep finding", first)
+	}
+	errcheck := results.Findings[1]
+	if errcheck.RuleID != "errcheck" || errcheck.File != "main.go" || errcheck.Line != 12 {
+		t.Errorf("Findings[1] = %+v, want errcheck at main.go:12", errcheck)
+	}
+	last := results.Findings[5]
+	if last.RuleID != "weak-hash" || last.Severity != openchoreov1alpha1.AnalysisSeverityLow {
+		t.Errorf("Findings[5] = %+v, want weak-hash resolved by rule index with the rule's level", last)
+	}
+}
+
+func TestParse_FindingsAreCapped(t *testing.T) {
+	var b strings.Builder
+	b.WriteString(`{"version": "2.1.0", "runs": [{"tool": {"driver": {"name": "lint"}}, "results": [`)
+	for i := range maxFindings + 5 {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(`{"ruleId": "r", "message": {"text": "` + strings.Repeat("x", maxMessageLength+1) + `"}}`)
+	}
+	b.WriteString(`]}]}`)
+
+	results, err := Parse([]byte(b.String()))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if results.Medium != maxFindings+5 {
+		t.Errorf("Medium = %d, want %d", results.Medium, maxFindings+5)
+	}
+	if len(results.Findings) != maxFindings {
+		t.Errorf("len(Findings) = %d, want %d", len(results.Findings), maxFindings)
+	}
+	if got := len(results.Findings[0].Message); got != maxMessageLength+3 {
+		t.Errorf("message length = %d, want %d", got, maxMessageLength+3)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for name, report := range map[string]string{
+		"not json":            "main.go:12: error return value is not checked",
+		"unsupported version": `{"version": "1.0.0", "runs": []}`,
+		"no runs":             `{"version": "2.1.0"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse([]byte(report)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestAtLeast(t *testing.T) {
+	if !AtLeast(openchoreov1alpha1.AnalysisSeverityCritical, openchoreov1alpha1.AnalysisSeverityHigh) {
+		t.Error("expected Critical to be at least High")
+	}
+	if AtLeast(openchoreov1alpha1.AnalysisSeverityLow, openchoreov1alpha1.AnalysisSeverityMedium) {
+		t.Error("expected Low not to be at least Medium")
+	}
+	if IsSeverity("Blocker") {
+		t.Error("expected Blocker not to be a known severity")
+	}
+}
+
+func TestViolations(t *testing.T) {
+	results := &openchoreov1alpha1.WorkflowAnalysisResults{Critical: 1, High: 3, Medium: 12}
+	componentTypeGate := &openchoreov1alpha1.AnalysisQualityGate{MaxHigh: ptr.To[int32](5), MaxMedium: ptr.To[int32](10)}
+	projectGate := &openchoreov1alpha1.AnalysisQualityGate{MaxCritical: ptr.To[int32](0), MaxHigh: ptr.To[int32](2)}
+
+	got := Violations(results, componentTypeGate, nil, projectGate)
+	want := []string{
+		"1 critical findings exceed the maximum of 0",
+		"3 high findings exceed the maximum of 2",
+		"12 medium findings exceed the maximum of 10",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Violations() = %q, want %q", got, want)
+	}
+	if got := Violations(results, &openchoreov1alpha1.AnalysisQualityGate{MaxLow: ptr.To[int32](0)}); len(got) != 0 {
+		t.Errorf("Violations() = %q, want none", got)
+	}
+}
+
+func TestReleaseBlockedMessage(t *testing.T) {
+	gate := &openchoreov1alpha1.AnalysisQualityGate{MaxHigh: ptr.To[int32](0)}
+	newRun := func(results *openchoreov1alpha1.WorkflowAnalysisResults) *openchoreov1alpha1.WorkflowRun {
+		run := &openchoreov1alpha1.WorkflowRun{}
+		run.Name = "run-1"
+		run.Status.AnalysisResults = results
+		return run
+	}
+
+	tests := []struct {
+		name  string
+		run   *openchoreov1alpha1.WorkflowRun
+		gates []*openchoreov1alpha1.AnalysisQualityGate
+		want  string
+	}{
+		{name: "no run", gates: []*openchoreov1alpha1.AnalysisQualityGate{gate}},
+		{name: "run without analysis", run: newRun(nil), gates: []*openchoreov1alpha1.AnalysisQualityGate{gate}},
+		{name: "no gates", run: newRun(&openchoreov1alpha1.WorkflowAnalysisResults{High: 4})},
+		{
+			name:  "gate without thresholds",
+			run:   newRun(&openchoreov1alpha1.WorkflowAnalysisResults{Message: "no report"}),
+			gates: []*openchoreov1alpha1.AnalysisQualityGate{{}},
+		},
+		{
+			name:  "within the gate",
+			run:   newRun(&openchoreov1alpha1.WorkflowAnalysisResults{Medium: 4}),
+			gates: []*openchoreov1alpha1.AnalysisQualityGate{gate},
+		},
+		{
+			name:  "exceeds the gate",
+			run:   newRun(&openchoreov1alpha1.WorkflowAnalysisResults{High: 2}),
+			gates: []*openchoreov1alpha1.AnalysisQualityGate{gate},
+			want:  `Static analysis of workflow run "run-1" does not meet the quality gate: 2 high findings exceed the maximum of 0`,
+		},
+		{
+			name:  "missing report",
+			run:   newRun(&openchoreov1alpha1.WorkflowAnalysisResults{Message: "no report"}),
+			gates: []*openchoreov1alpha1.AnalysisQualityGate{gate},
+			want:  `Static analysis of workflow run "run-1" could not be evaluated: no report`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReleaseBlockedMessage(tt.run, tt.gates...); got != tt.want {
+				t.Errorf("ReleaseBlockedMessage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
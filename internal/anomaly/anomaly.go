@@ -0,0 +1,144 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package anomaly detects anomalies in component metrics by comparing them with a
+// seasonal baseline: the same time window in previous periods.
+package anomaly
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Signal is a metric that is compared against its baseline.
+type Signal string
+
+const (
+	// SignalRequestRate is the request rate. Spikes and drops are both anomalous.
+	SignalRequestRate Signal = "requestRate"
+	// SignalErrorRatio is the share of failed requests. Only increases are anomalous.
+	SignalErrorRatio Signal = "errorRatio"
+	// SignalLatency is the 90th percentile latency in seconds. Only increases are anomalous.
+	SignalLatency Signal = "latency"
+)
+
+// Signals are all supported signals.
+var Signals = []Signal{SignalRequestRate, SignalErrorRatio, SignalLatency}
+
+// Sensitivity controls how far a signal must deviate from its baseline to be anomalous.
+type Sensitivity string
+
+const (
+	// SensitivityLow requires a deviation of more than 4 standard deviations.
+	SensitivityLow Sensitivity = "low"
+	// SensitivityMedium requires a deviation of more than 3 standard deviations.
+	SensitivityMedium Sensitivity = "medium"
+	// SensitivityHigh requires a deviation of more than 2 standard deviations.
+	SensitivityHigh Sensitivity = "high"
+)
+
+// MinBaselineSamples is the minimum number of baseline samples needed to evaluate a signal.
+const MinBaselineSamples = 3
+
+// relativeSpreadFloor is the smallest spread, as a fraction of the baseline mean, that
+// deviations are measured in. It keeps perfectly steady baselines from flagging noise.
+const relativeSpreadFloor = 0.1
+
+// absoluteSpreadFloors are the smallest spreads deviations are measured in, per signal,
+// so that a baseline of zero does not turn the first error or request into an anomaly.
+var absoluteSpreadFloors = map[Signal]float64{
+	SignalRequestRate: 0.1,   // requests per second
+	SignalErrorRatio:  0.01,  // one percentage point
+	SignalLatency:     0.025, // seconds
+}
+
+// ParseSignal validates a signal name.
+func ParseSignal(s string) (Signal, error) {
+	for _, signal := range Signals {
+		if string(signal) == s {
+			return signal, nil
+		}
+	}
+	return "", fmt.Errorf("unknown signal %q", s)
+}
+
+// Threshold returns the number of standard deviations a signal must deviate from its
+// baseline to be anomalous. An empty sensitivity is medium.
+func Threshold(s Sensitivity) (float64, error) {
+	switch s {
+	case SensitivityLow:
+		return 4, nil
+	case SensitivityMedium, "":
+		return 3, nil
+	case SensitivityHigh:
+		return 2, nil
+	default:
+		return 0, fmt.Errorf("unknown sensitivity %q", s)
+	}
+}
+
+// Result is the evaluation of one signal.
+type Result struct {
+	// Value is the mean of the current samples.
+	Value float64
+	// Baseline is the mean of the baseline samples.
+	Baseline float64
+	// StdDev is the standard deviation of the baseline samples.
+	StdDev float64
+	// Score is the signed deviation of Value from Baseline in units of spread.
+	Score float64
+	// BaselineSamples is the number of baseline samples.
+	BaselineSamples int
+	// Anomalous is true when Score exceeds the threshold in the signal's adverse direction.
+	Anomalous bool
+	// InsufficientData is true when there are no current samples or too few baseline samples.
+	InsufficientData bool
+}
+
+// Evaluate compares the current samples of a signal with its baseline samples.
+func Evaluate(signal Signal, current, baseline []float64, threshold float64) Result {
+	r := Result{BaselineSamples: len(baseline)}
+	if len(current) == 0 || len(baseline) < MinBaselineSamples {
+		r.InsufficientData = true
+		if len(current) > 0 {
+			r.Value = mean(current)
+		}
+		return r
+	}
+
+	r.Value = mean(current)
+	r.Baseline = mean(baseline)
+	r.StdDev = stddev(baseline, r.Baseline)
+	spread := max(r.StdDev, relativeSpreadFloor*math.Abs(r.Baseline), absoluteSpreadFloors[signal])
+	r.Score = (r.Value - r.Baseline) / spread
+
+	switch signal {
+	case SignalRequestRate:
+		r.Anomalous = math.Abs(r.Score) > threshold
+	default:
+		r.Anomalous = r.Score > threshold
+	}
+	return r
+}
+
+// FormatValue formats a value for CRD status fields.
+func FormatValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', 4, 64)
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func stddev(values []float64, mean float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(sum / float64(len(values)))
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomaly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreshold(t *testing.T) {
+	tests := []struct {
+		sensitivity Sensitivity
+		want        float64
+		wantErr     bool
+	}{
+		{SensitivityLow, 4, false},
+		{SensitivityMedium, 3, false},
+		{"", 3, false},
+		{SensitivityHigh, 2, false},
+		{"extreme", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.sensitivity), func(t *testing.T) {
+			got, err := Threshold(tt.sensitivity)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	baseline := []float64{90, 100, 110, 100, 95, 105}
+
+	tests := []struct {
+		name          string
+		signal        Signal
+		current       []float64
+		baseline      []float64
+		wantAnomalous bool
+		wantNoData    bool
+	}{
+		{
+			name:     "within baseline",
+			signal:   SignalRequestRate,
+			current:  []float64{102, 98},
+			baseline: baseline,
+		},
+		{
+			name:          "request rate spike",
+			signal:        SignalRequestRate,
+			current:       []float64{200},
+			baseline:      baseline,
+			wantAnomalous: true,
+		},
+		{
+			name:          "request rate drop",
+			signal:        SignalRequestRate,
+			current:       []float64{10},
+			baseline:      baseline,
+			wantAnomalous: true,
+		},
+		{
+			name:     "latency drop is not anomalous",
+			signal:   SignalLatency,
+			current:  []float64{0.01},
+			baseline: []float64{0.5, 0.5, 0.5},
+		},
+		{
+			name:          "latency increase",
+			signal:        SignalLatency,
+			current:       []float64{1.5},
+			baseline:      []float64{0.5, 0.5, 0.5},
+			wantAnomalous: true,
+		},
+		{
+			name:     "first errors below the spread floor",
+			signal:   SignalErrorRatio,
+			current:  []float64{0.02},
+			baseline: []float64{0, 0, 0, 0},
+		},
+		{
+			name:          "error burst over an error-free baseline",
+			signal:        SignalErrorRatio,
+			current:       []float64{0.2},
+			baseline:      []float64{0, 0, 0, 0},
+			wantAnomalous: true,
+		},
+		{
+			name:       "too few baseline samples",
+			signal:     SignalRequestRate,
+			current:    []float64{200},
+			baseline:   []float64{100, 100},
+			wantNoData: true,
+		},
+		{
+			name:       "no current samples",
+			signal:     SignalRequestRate,
+			baseline:   baseline,
+			wantNoData: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Evaluate(tt.signal, tt.current, tt.baseline, 3)
+			assert.Equal(t, tt.wantAnomalous, got.Anomalous)
+			assert.Equal(t, tt.wantNoData, got.InsufficientData)
+			assert.Equal(t, len(tt.baseline), got.BaselineSamples)
+		})
+	}
+}
+
+func TestEvaluate_Score(t *testing.T) {
+	got := Evaluate(SignalRequestRate, []float64{130}, []float64{90, 110, 90, 110}, 3)
+	assert.InDelta(t, 130, got.Value, 1e-9)
+	assert.InDelta(t, 100, got.Baseline, 1e-9)
+	assert.InDelta(t, 10, got.StdDev, 1e-9)
+	assert.InDelta(t, 3, got.Score, 1e-9)
+	assert.False(t, got.Anomalous, "the score must exceed the threshold")
+}
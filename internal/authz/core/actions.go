@@ -265,6 +265,14 @@ const (
 	// Alerts actions
 	ActionViewAlerts = "alerts:view"
 
+	// Raw query actions
+	ActionExecuteRawQuery = "rawquery:execute"
+
+	// Saved query actions
+	ActionViewSavedQuery   = "savedquery:view"
+	ActionCreateSavedQuery = "savedquery:create"
+	ActionDeleteSavedQuery = "savedquery:delete"
+
 	// Incidents actions
 	ActionViewIncidents   = "incidents:view"
 	ActionUpdateIncidents = "incidents:update"
@@ -529,6 +537,14 @@ var systemActions = []Action{
 	// alerts (dynamic scope: namespace, project, or component depending on query)
 	{Name: ActionViewAlerts, LowestScope: ScopeComponent, IsInternal: false},
 
+	// raw PromQL and OpenSearch queries (namespace scoped, admin only by default)
+	{Name: ActionExecuteRawQuery, LowestScope: ScopeNamespace, IsInternal: false},
+
+	// saved log and trace queries (namespace scoped)
+	{Name: ActionViewSavedQuery, LowestScope: ScopeNamespace, IsInternal: false},
+	{Name: ActionCreateSavedQuery, LowestScope: ScopeNamespace, IsInternal: false},
+	{Name: ActionDeleteSavedQuery, LowestScope: ScopeNamespace, IsInternal: false},
+
 	// incidents (dynamic scope: namespace, project, or component depending on query)
 	{Name: ActionViewIncidents, LowestScope: ScopeComponent, IsInternal: false},
 	{Name: ActionUpdateIncidents, LowestScope: ScopeComponent, IsInternal: false},
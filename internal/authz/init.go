@@ -18,6 +18,8 @@ import (
 
 // Config holds configuration for authorization initialization.
 // Policies are loaded from ClusterAuthzRole, AuthzRole, ClusterAuthzRoleBinding, and AuthzRoleBinding CRDs.
+// The CRDs are the only policy store: every API replica builds its enforcer from its own informers,
+// so policy changes made through any replica reach all of them without a shared database.
 type Config struct {
 	// Enabled enables or disables authorization enforcement.
 	Enabled bool
@@ -0,0 +1,190 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package buildnodes places workflow runs on the dedicated build nodes of a workflow plane
+// and keeps standby build nodes warm during business hours.
+package buildnodes
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// KarpenterNodePoolLabel is the node label Karpenter sets to the NodePool a node belongs to.
+	KarpenterNodePoolLabel = "karpenter.sh/nodepool"
+	// KarpenterDoNotDisruptAnnotation keeps Karpenter from consolidating the node of an annotated pod.
+	KarpenterDoNotDisruptAnnotation = "karpenter.sh/do-not-disrupt"
+)
+
+// NodeSelector returns the node labels build pods are restricted to, including the
+// Karpenter NodePool label when a NodePool is configured.
+func NodeSelector(spec *openchoreov1alpha1.WorkflowBuildNodesSpec) map[string]string {
+	if spec == nil || (len(spec.NodeSelector) == 0 && spec.Karpenter == nil) {
+		return nil
+	}
+	selector := make(map[string]string, len(spec.NodeSelector)+1)
+	for k, v := range spec.NodeSelector {
+		selector[k] = v
+	}
+	if spec.Karpenter != nil {
+		selector[KarpenterNodePoolLabel] = spec.Karpenter.NodePool
+	}
+	return selector
+}
+
+// ApplyPlacement injects the build node placement into a rendered Argo Workflow. nodeSelector
+// entries override the template's, tolerations are appended unless already present, and
+// build pods are protected from Karpenter consolidation unless disabled. Other resources are
+// left untouched.
+func ApplyPlacement(resource map[string]any, spec *openchoreov1alpha1.WorkflowBuildNodesSpec) error {
+	if spec == nil {
+		return nil
+	}
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+	if apiVersion != "argoproj.io/v1alpha1" || kind != "Workflow" {
+		return nil
+	}
+	workflowSpec, ok := resource["spec"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	if selector := NodeSelector(spec); len(selector) > 0 {
+		nodeSelector, _ := workflowSpec["nodeSelector"].(map[string]any)
+		if nodeSelector == nil {
+			nodeSelector = make(map[string]any, len(selector))
+		}
+		for k, v := range selector {
+			nodeSelector[k] = v
+		}
+		workflowSpec["nodeSelector"] = nodeSelector
+	}
+
+	if len(spec.Tolerations) > 0 {
+		existing, _ := workflowSpec["tolerations"].([]any)
+		for i := range spec.Tolerations {
+			t, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&spec.Tolerations[i])
+			if err != nil {
+				return fmt.Errorf("failed to convert toleration: %w", err)
+			}
+			if !slices.ContainsFunc(existing, func(e any) bool { return reflect.DeepEqual(e, any(t)) }) {
+				existing = append(existing, t)
+			}
+		}
+		workflowSpec["tolerations"] = existing
+	}
+
+	if doNotDisrupt(spec) {
+		podMetadata, _ := workflowSpec["podMetadata"].(map[string]any)
+		if podMetadata == nil {
+			podMetadata = make(map[string]any)
+		}
+		annotations, _ := podMetadata["annotations"].(map[string]any)
+		if annotations == nil {
+			annotations = make(map[string]any)
+		}
+		annotations[KarpenterDoNotDisruptAnnotation] = "true"
+		podMetadata["annotations"] = annotations
+		workflowSpec["podMetadata"] = podMetadata
+	}
+	return nil
+}
+
+func doNotDisrupt(spec *openchoreov1alpha1.WorkflowBuildNodesSpec) bool {
+	if spec.Karpenter == nil {
+		return false
+	}
+	return spec.Karpenter.DoNotDisrupt == nil || *spec.Karpenter.DoNotDisrupt
+}
+
+var weekdays = map[openchoreov1alpha1.PreWarmWeekday]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// DesiredStandbyNodes returns the number of standby build nodes wanted at the given time:
+// the configured number inside a pre-warm window and 0 outside of all windows.
+func DesiredStandbyNodes(preWarm *openchoreov1alpha1.BuildNodePreWarmSpec, now time.Time) (int32, error) {
+	if preWarm == nil {
+		return 0, nil
+	}
+	loc := time.UTC
+	if preWarm.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(preWarm.TimeZone); err != nil {
+			return 0, fmt.Errorf("invalid pre-warm time zone %q: %w", preWarm.TimeZone, err)
+		}
+	}
+	now = now.In(loc)
+	for _, window := range preWarm.Schedule {
+		active, err := windowActive(window, now)
+		if err != nil {
+			return 0, err
+		}
+		if active {
+			return preWarm.Nodes, nil
+		}
+	}
+	return 0, nil
+}
+
+// windowActive reports whether the window is open at the given local time. A window that
+// spans midnight belongs to the day it starts on.
+func windowActive(window openchoreov1alpha1.PreWarmWindow, now time.Time) (bool, error) {
+	start, err := minuteOfDay(window.Start)
+	if err != nil {
+		return false, err
+	}
+	end, err := minuteOfDay(window.End)
+	if err != nil {
+		return false, err
+	}
+	minute := now.Hour()*60 + now.Minute()
+	day := now.Weekday()
+
+	switch {
+	case start < end:
+		return minute >= start && minute < end && onDay(window.Days, day), nil
+	case start > end:
+		if minute >= start {
+			return onDay(window.Days, day), nil
+		}
+		if minute < end {
+			return onDay(window.Days, (day+6)%7), nil
+		}
+	}
+	return false, nil
+}
+
+func onDay(days []openchoreov1alpha1.PreWarmWeekday, day time.Weekday) bool {
+	if len(days) == 0 {
+		return true
+	}
+	for _, d := range days {
+		if wd, ok := weekdays[d]; ok && wd == day {
+			return true
+		}
+	}
+	return false
+}
+
+func minuteOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid pre-warm window time %q: expected HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package buildnodes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var buildToleration = corev1.Toleration{
+	Key:      "openchoreo.dev/build",
+	Operator: corev1.TolerationOpExists,
+	Effect:   corev1.TaintEffectNoSchedule,
+}
+
+func newArgoWorkflow(spec map[string]any) map[string]any {
+	return map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Workflow",
+		"spec":       spec,
+	}
+}
+
+func TestApplyPlacement(t *testing.T) {
+	spec := &openchoreov1alpha1.WorkflowBuildNodesSpec{
+		NodeSelector: map[string]string{"pool": "build"},
+		Tolerations:  []corev1.Toleration{buildToleration},
+		Karpenter:    &openchoreov1alpha1.KarpenterBuildNodes{NodePool: "builds"},
+	}
+
+	t.Run("injects the placement into Argo Workflows", func(t *testing.T) {
+		resource := newArgoWorkflow(map[string]any{
+			"nodeSelector": map[string]any{"pool": "general", "arch": "amd64"},
+			"tolerations": []any{
+				map[string]any{"key": "openchoreo.dev/build", "operator": "Exists", "effect": "NoSchedule"},
+			},
+		})
+		require.NoError(t, ApplyPlacement(resource, spec))
+
+		workflowSpec := resource["spec"].(map[string]any)
+		assert.Equal(t, map[string]any{"pool": "build", "arch": "amd64", KarpenterNodePoolLabel: "builds"},
+			workflowSpec["nodeSelector"])
+		assert.Len(t, workflowSpec["tolerations"], 1, "an existing toleration must not be duplicated")
+		assert.Equal(t, map[string]any{"annotations": map[string]any{KarpenterDoNotDisruptAnnotation: "true"}},
+			workflowSpec["podMetadata"])
+	})
+
+	t.Run("disruption protection can be disabled", func(t *testing.T) {
+		resource := newArgoWorkflow(map[string]any{})
+		require.NoError(t, ApplyPlacement(resource, &openchoreov1alpha1.WorkflowBuildNodesSpec{
+			Karpenter: &openchoreov1alpha1.KarpenterBuildNodes{NodePool: "builds", DoNotDisrupt: ptr.To(false)},
+		}))
+		assert.NotContains(t, resource["spec"], "podMetadata")
+	})
+
+	t.Run("leaves other resources untouched", func(t *testing.T) {
+		resource := map[string]any{
+			"apiVersion": "tekton.dev/v1",
+			"kind":       "PipelineRun",
+			"spec":       map[string]any{},
+		}
+		require.NoError(t, ApplyPlacement(resource, spec))
+		assert.Empty(t, resource["spec"])
+	})
+
+	t.Run("nil spec is a no-op", func(t *testing.T) {
+		resource := newArgoWorkflow(map[string]any{})
+		require.NoError(t, ApplyPlacement(resource, nil))
+		assert.Empty(t, resource["spec"])
+	})
+}
+
+func TestDesiredStandbyNodes(t *testing.T) {
+	businessHours := &openchoreov1alpha1.BuildNodePreWarmSpec{
+		Nodes:    3,
+		TimeZone: "Europe/Berlin",
+		Schedule: []openchoreov1alpha1.PreWarmWindow{
+			{Days: []openchoreov1alpha1.PreWarmWeekday{"Mon", "Tue", "Wed", "Thu", "Fri"}, Start: "08:00", End: "18:00"},
+		},
+	}
+	nightShift := &openchoreov1alpha1.BuildNodePreWarmSpec{
+		Nodes: 1,
+		Schedule: []openchoreov1alpha1.PreWarmWindow{
+			{Days: []openchoreov1alpha1.PreWarmWeekday{"Fri"}, Start: "22:00", End: "06:00"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		preWarm *openchoreov1alpha1.BuildNodePreWarmSpec
+		now     time.Time
+		want    int32
+	}{
+		// 2026-03-02 is a Monday; Berlin is UTC+1 in March before daylight saving time.
+		{"inside business hours", businessHours, time.Date(2026, 3, 2, 7, 30, 0, 0, time.UTC), 3},
+		{"before business hours in the plane's time zone", businessHours, time.Date(2026, 3, 2, 6, 30, 0, 0, time.UTC), 0},
+		{"end is exclusive", businessHours, time.Date(2026, 3, 2, 17, 0, 0, 0, time.UTC), 0},
+		{"weekend", businessHours, time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC), 0},
+		{"overnight window on its start day", nightShift, time.Date(2026, 3, 6, 23, 0, 0, 0, time.UTC), 1},
+		{"overnight window after midnight", nightShift, time.Date(2026, 3, 7, 5, 0, 0, 0, time.UTC), 1},
+		{"overnight window after midnight of another day", nightShift, time.Date(2026, 3, 6, 5, 0, 0, 0, time.UTC), 0},
+		{"no pre-warming", nil, time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DesiredStandbyNodes(tt.preWarm, tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("invalid time zone", func(t *testing.T) {
+		_, err := DesiredStandbyNodes(&openchoreov1alpha1.BuildNodePreWarmSpec{
+			TimeZone: "Mars/Olympus",
+			Schedule: []openchoreov1alpha1.PreWarmWindow{{Start: "08:00", End: "18:00"}},
+		}, time.Now())
+		assert.ErrorContains(t, err, "invalid pre-warm time zone")
+	})
+}
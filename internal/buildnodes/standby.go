@@ -0,0 +1,199 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package buildnodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// StandbyPriorityClassName is the PriorityClass of the placeholder pods. Its negative value
+	// lets every build pod preempt a placeholder.
+	StandbyPriorityClassName = "openchoreo-build-standby"
+
+	standbyPriority  int32 = -10
+	standbyComponent       = "build-standby"
+)
+
+// Plane identifies the workflow plane whose standby build nodes are reconciled. Namespace is
+// empty for a ClusterWorkflowPlane.
+type Plane struct {
+	Namespace string
+	Name      string
+}
+
+// StandbyDeploymentName returns the name of the Deployment that holds the standby build nodes
+// of the given workflow plane.
+func StandbyDeploymentName(plane Plane) string {
+	if plane.Namespace == "" {
+		return dpkubernetes.GenerateK8sNameWithLengthLimit(63, standbyComponent, plane.Name)
+	}
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(63, standbyComponent, plane.Namespace, plane.Name)
+}
+
+// ReconcileStandby scales the placeholder Deployment of a workflow plane to the number of
+// standby build nodes wanted at the given time. Each placeholder pod reserves the configured
+// resources on a build node of its own, so the autoscaler keeps that many build nodes running.
+// The Deployment is deleted when pre-warming is not configured, and nil is returned.
+func ReconcileStandby(
+	ctx context.Context,
+	wpClient client.Client,
+	plane Plane,
+	spec *openchoreov1alpha1.WorkflowBuildNodesSpec,
+	now time.Time,
+) (*openchoreov1alpha1.StandbyBuildNodesStatus, error) {
+	if spec == nil || spec.PreWarm == nil {
+		return nil, DeleteStandby(ctx, wpClient, plane)
+	}
+	preWarm := spec.PreWarm
+
+	desired, err := DesiredStandbyNodes(preWarm, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePriorityClass(ctx, wpClient); err != nil {
+		return nil, err
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      StandbyDeploymentName(plane),
+			Namespace: preWarm.GetNamespace(),
+		},
+	}
+	op, err := controllerutil.CreateOrUpdate(ctx, wpClient, deployment, func() error {
+		deployment.Labels = standbyLabels(plane)
+		deployment.Spec = makeStandbyDeploymentSpec(plane, spec, desired)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile standby build node deployment: %w", err)
+	}
+	// Placeholders left behind in a previously configured namespace would keep nodes running.
+	if err := deleteStandby(ctx, wpClient, plane, deployment); err != nil {
+		return nil, err
+	}
+	if op != controllerutil.OperationResultNone {
+		log.FromContext(ctx).Info("Reconciled standby build nodes",
+			"deployment", deployment.Name, "operation", op, "desired", desired)
+	}
+
+	ready := min(deployment.Status.ReadyReplicas, desired)
+	return &openchoreov1alpha1.StandbyBuildNodesStatus{Desired: desired, Ready: ready}, nil
+}
+
+// DeleteStandby deletes the placeholder Deployments of a workflow plane. The PriorityClass is
+// left in place because it is shared by all workflow planes on the cluster.
+func DeleteStandby(ctx context.Context, wpClient client.Client, plane Plane) error {
+	return deleteStandby(ctx, wpClient, plane, nil)
+}
+
+// deleteStandby deletes the placeholder Deployments of a workflow plane except keep.
+func deleteStandby(ctx context.Context, wpClient client.Client, plane Plane, keep *appsv1.Deployment) error {
+	deployments := &appsv1.DeploymentList{}
+	if err := wpClient.List(ctx, deployments, client.MatchingLabels(standbyLabels(plane))); err != nil {
+		return fmt.Errorf("failed to list standby build node deployments: %w", err)
+	}
+	for i := range deployments.Items {
+		if keep != nil && deployments.Items[i].Namespace == keep.Namespace && deployments.Items[i].Name == keep.Name {
+			continue
+		}
+		if err := wpClient.Delete(ctx, &deployments.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete standby build node deployment %q: %w", deployments.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+func ensurePriorityClass(ctx context.Context, wpClient client.Client) error {
+	existing := &schedulingv1.PriorityClass{}
+	err := wpClient.Get(ctx, client.ObjectKey{Name: StandbyPriorityClassName}, existing)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get PriorityClass %q: %w", StandbyPriorityClassName, err)
+	}
+
+	pc := &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: StandbyPriorityClassName,
+			Labels: map[string]string{
+				labels.LabelKeyManagedBy: labels.LabelValueManagedBy,
+			},
+		},
+		Value:            standbyPriority,
+		PreemptionPolicy: ptr.To(corev1.PreemptNever),
+		Description:      "Created by OpenChoreo for the placeholder pods that keep standby build nodes warm",
+	}
+	if err := wpClient.Create(ctx, pc); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create PriorityClass %q: %w", StandbyPriorityClassName, err)
+	}
+	return nil
+}
+
+func standbyLabels(plane Plane) map[string]string {
+	// The namespace label is empty for a ClusterWorkflowPlane so that its placeholders are not
+	// confused with those of a namespaced WorkflowPlane of the same name.
+	return map[string]string{
+		labels.LabelKeyManagedBy:     labels.LabelValueManagedBy,
+		labels.LabelKeyWorkflowPlane: plane.Name,
+		labels.LabelKeyNamespaceName: plane.Namespace,
+		labels.LabelKeyName:          standbyComponent,
+	}
+}
+
+func makeStandbyDeploymentSpec(
+	plane Plane,
+	spec *openchoreov1alpha1.WorkflowBuildNodesSpec,
+	replicas int32,
+) appsv1.DeploymentSpec {
+	podLabels := standbyLabels(plane)
+	return appsv1.DeploymentSpec{
+		Replicas: ptr.To(replicas),
+		Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+			Spec: corev1.PodSpec{
+				PriorityClassName:             StandbyPriorityClassName,
+				TerminationGracePeriodSeconds: ptr.To[int64](0),
+				AutomountServiceAccountToken:  ptr.To(false),
+				NodeSelector:                  NodeSelector(spec),
+				Tolerations:                   spec.Tolerations,
+				// One placeholder per node so that every replica holds a node of its own.
+				Affinity: &corev1.Affinity{
+					PodAntiAffinity: &corev1.PodAntiAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+							LabelSelector: &metav1.LabelSelector{MatchLabels: podLabels},
+							TopologyKey:   corev1.LabelHostname,
+						}},
+					},
+				},
+				Containers: []corev1.Container{{
+					Name:  "pause",
+					Image: spec.PreWarm.GetImage(),
+					Resources: corev1.ResourceRequirements{
+						Requests: spec.PreWarm.Resources,
+					},
+				}},
+			},
+		},
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package buildnodes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// 2026-03-02 is a Monday.
+var (
+	duringBusinessHours = time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	afterBusinessHours  = time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC)
+)
+
+func newStandbySpec() *openchoreov1alpha1.WorkflowBuildNodesSpec {
+	return &openchoreov1alpha1.WorkflowBuildNodesSpec{
+		Tolerations: []corev1.Toleration{buildToleration},
+		Karpenter:   &openchoreov1alpha1.KarpenterBuildNodes{NodePool: "builds"},
+		PreWarm: &openchoreov1alpha1.BuildNodePreWarmSpec{
+			Nodes: 2,
+			Resources: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("3"),
+				corev1.ResourceMemory: resource.MustParse("12Gi"),
+			},
+			Schedule: []openchoreov1alpha1.PreWarmWindow{{Start: "08:00", End: "18:00"}},
+		},
+	}
+}
+
+func newStandbyClient(t *testing.T) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).Build()
+}
+
+func getStandbyDeployment(t *testing.T, wpClient client.Client, plane Plane, namespace string) (*appsv1.Deployment, error) {
+	t.Helper()
+	deployment := &appsv1.Deployment{}
+	err := wpClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: StandbyDeploymentName(plane)}, deployment)
+	return deployment, err
+}
+
+func TestReconcileStandby(t *testing.T) {
+	ctx := context.Background()
+	plane := Plane{Namespace: "default", Name: "ci"}
+
+	t.Run("keeps standby nodes during business hours", func(t *testing.T) {
+		wpClient := newStandbyClient(t)
+		status, err := ReconcileStandby(ctx, wpClient, plane, newStandbySpec(), duringBusinessHours)
+		require.NoError(t, err)
+		assert.Equal(t, &openchoreov1alpha1.StandbyBuildNodesStatus{Desired: 2, Ready: 0}, status)
+
+		deployment, err := getStandbyDeployment(t, wpClient, plane, "openchoreo-workflow-plane")
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), *deployment.Spec.Replicas)
+		podSpec := deployment.Spec.Template.Spec
+		assert.Equal(t, StandbyPriorityClassName, podSpec.PriorityClassName)
+		assert.Equal(t, map[string]string{KarpenterNodePoolLabel: "builds"}, podSpec.NodeSelector)
+		assert.Equal(t, []corev1.Toleration{buildToleration}, podSpec.Tolerations)
+		assert.Equal(t, "registry.k8s.io/pause:3.10", podSpec.Containers[0].Image)
+		assert.True(t, podSpec.Containers[0].Resources.Requests.Cpu().Equal(resource.MustParse("3")))
+		require.Len(t, podSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 1)
+
+		pc := &schedulingv1.PriorityClass{}
+		require.NoError(t, wpClient.Get(ctx, client.ObjectKey{Name: StandbyPriorityClassName}, pc))
+		assert.Equal(t, int32(-10), pc.Value)
+		assert.Equal(t, corev1.PreemptNever, *pc.PreemptionPolicy)
+	})
+
+	t.Run("scales to zero outside of business hours", func(t *testing.T) {
+		wpClient := newStandbyClient(t)
+		_, err := ReconcileStandby(ctx, wpClient, plane, newStandbySpec(), duringBusinessHours)
+		require.NoError(t, err)
+
+		status, err := ReconcileStandby(ctx, wpClient, plane, newStandbySpec(), afterBusinessHours)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), status.Desired)
+		deployment, err := getStandbyDeployment(t, wpClient, plane, "openchoreo-workflow-plane")
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), *deployment.Spec.Replicas)
+	})
+
+	t.Run("moves placeholders when the namespace changes", func(t *testing.T) {
+		wpClient := newStandbyClient(t)
+		_, err := ReconcileStandby(ctx, wpClient, plane, newStandbySpec(), duringBusinessHours)
+		require.NoError(t, err)
+
+		spec := newStandbySpec()
+		spec.PreWarm.Namespace = "builds"
+		_, err = ReconcileStandby(ctx, wpClient, plane, spec, duringBusinessHours)
+		require.NoError(t, err)
+
+		_, err = getStandbyDeployment(t, wpClient, plane, "builds")
+		require.NoError(t, err)
+		_, err = getStandbyDeployment(t, wpClient, plane, "openchoreo-workflow-plane")
+		assert.True(t, apierrors.IsNotFound(err), "expected the old placeholders to be deleted, got %v", err)
+	})
+
+	t.Run("deletes placeholders when pre-warming is disabled", func(t *testing.T) {
+		wpClient := newStandbyClient(t)
+		_, err := ReconcileStandby(ctx, wpClient, plane, newStandbySpec(), duringBusinessHours)
+		require.NoError(t, err)
+
+		spec := newStandbySpec()
+		spec.PreWarm = nil
+		status, err := ReconcileStandby(ctx, wpClient, plane, spec, duringBusinessHours)
+		require.NoError(t, err)
+		assert.Nil(t, status)
+		_, err = getStandbyDeployment(t, wpClient, plane, "openchoreo-workflow-plane")
+		assert.True(t, apierrors.IsNotFound(err), "expected the placeholders to be deleted, got %v", err)
+	})
+
+	t.Run("does not touch placeholders of a cluster plane with the same name", func(t *testing.T) {
+		wpClient := newStandbyClient(t)
+		clusterPlane := Plane{Name: "ci"}
+		_, err := ReconcileStandby(ctx, wpClient, clusterPlane, newStandbySpec(), duringBusinessHours)
+		require.NoError(t, err)
+
+		require.NoError(t, DeleteStandby(ctx, wpClient, plane))
+		_, err = getStandbyDeployment(t, wpClient, clusterPlane, "openchoreo-workflow-plane")
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,299 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package changelog computes the differences between two ComponentReleases so that
+// a promotion can be described to release managers without comparing releases by hand.
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// MaxChanges bounds the number of entries recorded in a changelog.
+	MaxChanges = 100
+
+	// maxValueLength bounds the length of a rendered From/To value.
+	maxValueLength = 256
+
+	// shortCommitLength is the length commits are abbreviated to in a summary.
+	shortCommitLength = 7
+)
+
+// Generate returns the changelog for moving from one ComponentRelease to another.
+// from may be nil for an initial deployment, in which case only the image and source commit
+// of to are reported. The source commits are read from the releases' source commit annotation.
+func Generate(from, to *openchoreov1alpha1.ComponentRelease) openchoreov1alpha1.ReleaseChangelog {
+	cl := openchoreov1alpha1.ReleaseChangelog{}
+	if to != nil {
+		cl.ToImage = to.Spec.Workload.Container.Image
+		cl.ToCommit = to.Annotations[labels.AnnotationKeySourceCommit]
+	}
+	if from == nil || to == nil {
+		return cl
+	}
+	cl.FromImage = from.Spec.Workload.Container.Image
+	cl.FromCommit = from.Annotations[labels.AnnotationKeySourceCommit]
+
+	var changes []openchoreov1alpha1.ReleaseChange
+	changes = append(changes, diffComponentType(&from.Spec.ComponentType, &to.Spec.ComponentType)...)
+	changes = append(changes, diffValues("parameters", profileParameters(from), profileParameters(to))...)
+	changes = append(changes, diffTraits(from.Spec.ComponentProfile, to.Spec.ComponentProfile)...)
+	changes = append(changes, diffTraitDefinitions(from.Spec.Traits, to.Spec.Traits)...)
+	changes = append(changes, diffEnv(from.Spec.Workload.Container.Env, to.Spec.Workload.Container.Env)...)
+
+	if len(changes) > MaxChanges {
+		changes = changes[:MaxChanges]
+		cl.Truncated = true
+	}
+	cl.Changes = changes
+	return cl
+}
+
+// Summary renders a one-line human-readable description of a changelog.
+func Summary(cl openchoreov1alpha1.ReleaseChangelog) string {
+	var parts []string
+	if cl.FromImage != cl.ToImage {
+		if cl.FromImage == "" {
+			parts = append(parts, fmt.Sprintf("image %s", cl.ToImage))
+		} else {
+			parts = append(parts, fmt.Sprintf("image %s -> %s", cl.FromImage, cl.ToImage))
+		}
+	}
+	if commits := CommitRange(cl); commits != "" {
+		parts = append(parts, commits)
+	}
+	if n := len(cl.Changes); n > 0 {
+		suffix := ""
+		if cl.Truncated {
+			suffix = "+"
+		}
+		parts = append(parts, fmt.Sprintf("%d%s configuration change(s)", n, suffix))
+	}
+	if len(parts) == 0 {
+		return "no changes"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// CommitRange renders the source commits a changelog covers as "commits <from>..<to>", or as
+// "commit <to>" when the previous commit is unknown. Returns "" when the newly bound release has
+// no source commit or was built from the same commit as the previous one.
+func CommitRange(cl openchoreov1alpha1.ReleaseChangelog) string {
+	switch {
+	case cl.ToCommit == "" || cl.FromCommit == cl.ToCommit:
+		return ""
+	case cl.FromCommit == "":
+		return fmt.Sprintf("commit %s", shortCommit(cl.ToCommit))
+	default:
+		return fmt.Sprintf("commits %s..%s", shortCommit(cl.FromCommit), shortCommit(cl.ToCommit))
+	}
+}
+
+func shortCommit(commit string) string {
+	if len(commit) <= shortCommitLength {
+		return commit
+	}
+	return commit[:shortCommitLength]
+}
+
+func diffComponentType(from, to *openchoreov1alpha1.ComponentReleaseComponentType) []openchoreov1alpha1.ReleaseChange {
+	fromRef := fmt.Sprintf("%s/%s", from.Kind, from.Name)
+	toRef := fmt.Sprintf("%s/%s", to.Kind, to.Name)
+	if fromRef != toRef {
+		return []openchoreov1alpha1.ReleaseChange{modified("componentType", quote(fromRef), quote(toRef))}
+	}
+	if !apiequality.Semantic.DeepEqual(from.Spec, to.Spec) {
+		return []openchoreov1alpha1.ReleaseChange{modified("componentType.spec", "", "")}
+	}
+	return nil
+}
+
+func diffTraits(from, to *openchoreov1alpha1.ComponentProfile) []openchoreov1alpha1.ReleaseChange {
+	fromTraits := traitInstances(from)
+	toTraits := traitInstances(to)
+
+	var changes []openchoreov1alpha1.ReleaseChange
+	for _, name := range unionKeys(fromTraits, toTraits) {
+		path := "traits." + name
+		f, inFrom := fromTraits[name]
+		t, inTo := toTraits[name]
+		switch {
+		case !inFrom:
+			changes = append(changes, openchoreov1alpha1.ReleaseChange{
+				Path: path, Type: openchoreov1alpha1.ReleaseChangeAdded, To: quote(traitRef(t.Kind, t.Name)),
+			})
+		case !inTo:
+			changes = append(changes, openchoreov1alpha1.ReleaseChange{
+				Path: path, Type: openchoreov1alpha1.ReleaseChangeRemoved, From: quote(traitRef(f.Kind, f.Name)),
+			})
+		default:
+			if traitRef(f.Kind, f.Name) != traitRef(t.Kind, t.Name) {
+				changes = append(changes, modified(path, quote(traitRef(f.Kind, f.Name)), quote(traitRef(t.Kind, t.Name))))
+			}
+			changes = append(changes, diffValues(path+".parameters", flatten(f.Parameters), flatten(t.Parameters))...)
+		}
+	}
+	return changes
+}
+
+// diffTraitDefinitions reports frozen Trait or ClusterTrait specs that changed between releases.
+func diffTraitDefinitions(from, to []openchoreov1alpha1.ComponentReleaseTrait) []openchoreov1alpha1.ReleaseChange {
+	fromSpecs := make(map[string]openchoreov1alpha1.TraitSpec, len(from))
+	for _, t := range from {
+		fromSpecs[traitRef(t.Kind, t.Name)] = t.Spec
+	}
+
+	var changes []openchoreov1alpha1.ReleaseChange
+	for _, t := range to {
+		ref := traitRef(t.Kind, t.Name)
+		if spec, ok := fromSpecs[ref]; ok && !apiequality.Semantic.DeepEqual(spec, t.Spec) {
+			changes = append(changes, modified("traitDefinitions."+ref, "", ""))
+		}
+	}
+	return changes
+}
+
+func diffEnv(from, to []openchoreov1alpha1.EnvVar) []openchoreov1alpha1.ReleaseChange {
+	return diffValues("workload.container.env", envValues(from), envValues(to))
+}
+
+// diffValues compares two flattened value maps and reports added, removed and modified keys.
+func diffValues(prefix string, from, to map[string]string) []openchoreov1alpha1.ReleaseChange {
+	var changes []openchoreov1alpha1.ReleaseChange
+	for _, key := range unionKeys(from, to) {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		f, inFrom := from[key]
+		t, inTo := to[key]
+		switch {
+		case !inFrom:
+			changes = append(changes, openchoreov1alpha1.ReleaseChange{
+				Path: path, Type: openchoreov1alpha1.ReleaseChangeAdded, To: truncate(t),
+			})
+		case !inTo:
+			changes = append(changes, openchoreov1alpha1.ReleaseChange{
+				Path: path, Type: openchoreov1alpha1.ReleaseChangeRemoved, From: truncate(f),
+			})
+		case f != t:
+			changes = append(changes, modified(path, f, t))
+		}
+	}
+	return changes
+}
+
+func profileParameters(cr *openchoreov1alpha1.ComponentRelease) map[string]string {
+	if cr.Spec.ComponentProfile == nil {
+		return nil
+	}
+	return flatten(cr.Spec.ComponentProfile.Parameters)
+}
+
+func traitInstances(profile *openchoreov1alpha1.ComponentProfile) map[string]openchoreov1alpha1.ComponentProfileTrait {
+	if profile == nil {
+		return nil
+	}
+	instances := make(map[string]openchoreov1alpha1.ComponentProfileTrait, len(profile.Traits))
+	for _, t := range profile.Traits {
+		instances[t.InstanceName] = t
+	}
+	return instances
+}
+
+func envValues(env []openchoreov1alpha1.EnvVar) map[string]string {
+	values := make(map[string]string, len(env))
+	for _, e := range env {
+		if e.ValueFrom != nil {
+			values[e.Key] = marshal(e.ValueFrom)
+			continue
+		}
+		values[e.Key] = quote(e.Value)
+	}
+	return values
+}
+
+// flatten converts a raw JSON object into a map from dotted path to the JSON
+// rendering of each leaf. Arrays are treated as leaves.
+func flatten(raw *runtime.RawExtension) map[string]string {
+	out := map[string]string{}
+	if raw == nil || len(raw.Raw) == 0 {
+		return out
+	}
+	var value any
+	if err := json.Unmarshal(raw.Raw, &value); err != nil {
+		return out
+	}
+	flattenInto(out, "", value)
+	return out
+}
+
+func flattenInto(out map[string]string, prefix string, value any) {
+	obj, ok := value.(map[string]any)
+	if !ok || len(obj) == 0 {
+		if prefix != "" {
+			out[prefix] = marshal(value)
+		}
+		return
+	}
+	for k, v := range obj {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		flattenInto(out, key, v)
+	}
+}
+
+func modified(path, from, to string) openchoreov1alpha1.ReleaseChange {
+	return openchoreov1alpha1.ReleaseChange{
+		Path: path, Type: openchoreov1alpha1.ReleaseChangeModified, From: truncate(from), To: truncate(to),
+	}
+}
+
+func traitRef(kind openchoreov1alpha1.TraitRefKind, name string) string {
+	return fmt.Sprintf("%s/%s", kind, name)
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func quote(s string) string {
+	return marshal(s)
+}
+
+func marshal(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+func truncate(s string) string {
+	if len(s) <= maxValueLength {
+		return s
+	}
+	return s[:maxValueLength] + "..."
+}
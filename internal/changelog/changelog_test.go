@@ -0,0 +1,160 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package changelog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func release(image, params string, traits []openchoreov1alpha1.ComponentProfileTrait, env ...openchoreov1alpha1.EnvVar) *openchoreov1alpha1.ComponentRelease {
+	cr := &openchoreov1alpha1.ComponentRelease{
+		Spec: openchoreov1alpha1.ComponentReleaseSpec{
+			ComponentType: openchoreov1alpha1.ComponentReleaseComponentType{
+				Kind: openchoreov1alpha1.ComponentTypeRefKindComponentType,
+				Name: "deployment/service",
+			},
+			ComponentProfile: &openchoreov1alpha1.ComponentProfile{Traits: traits},
+		},
+	}
+	if params != "" {
+		cr.Spec.ComponentProfile.Parameters = &runtime.RawExtension{Raw: []byte(params)}
+	}
+	cr.Spec.Workload.Container.Image = image
+	cr.Spec.Workload.Container.Env = env
+	return cr
+}
+
+func findChange(t *testing.T, cl openchoreov1alpha1.ReleaseChangelog, path string) openchoreov1alpha1.ReleaseChange {
+	t.Helper()
+	for _, c := range cl.Changes {
+		if c.Path == path {
+			return c
+		}
+	}
+	require.Failf(t, "change not found", "path %q not in %+v", path, cl.Changes)
+	return openchoreov1alpha1.ReleaseChange{}
+}
+
+func TestGenerate_InitialDeployment(t *testing.T) {
+	cl := Generate(nil, release("app:v1", `{"replicas":1}`, nil))
+	assert.Empty(t, cl.FromImage)
+	assert.Equal(t, "app:v1", cl.ToImage)
+	assert.Empty(t, cl.Changes)
+	assert.Equal(t, "image app:v1", Summary(cl))
+}
+
+func TestGenerate_Diff(t *testing.T) {
+	from := release("app:v1",
+		`{"replicas":1,"resources":{"cpu":"100m","memory":"128Mi"},"legacy":true}`,
+		[]openchoreov1alpha1.ComponentProfileTrait{
+			{Kind: openchoreov1alpha1.TraitRefKindTrait, Name: "ingress", InstanceName: "public",
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"host":"a.example.com"}`)}},
+			{Kind: openchoreov1alpha1.TraitRefKindTrait, Name: "storage", InstanceName: "data"},
+		},
+		openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"},
+		openchoreov1alpha1.EnvVar{Key: "REMOVED", Value: "x"},
+	)
+	to := release("app:v2",
+		`{"replicas":3,"resources":{"cpu":"100m","memory":"256Mi"},"debug":false}`,
+		[]openchoreov1alpha1.ComponentProfileTrait{
+			{Kind: openchoreov1alpha1.TraitRefKindTrait, Name: "ingress", InstanceName: "public",
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"host":"b.example.com"}`)}},
+			{Kind: openchoreov1alpha1.TraitRefKindTrait, Name: "autoscaler", InstanceName: "hpa"},
+		},
+		openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "debug"},
+	)
+
+	cl := Generate(from, to)
+	assert.Equal(t, "app:v1", cl.FromImage)
+	assert.Equal(t, "app:v2", cl.ToImage)
+	assert.False(t, cl.Truncated)
+
+	assert.Equal(t, openchoreov1alpha1.ReleaseChange{
+		Path: "parameters.replicas", Type: openchoreov1alpha1.ReleaseChangeModified, From: "1", To: "3",
+	}, findChange(t, cl, "parameters.replicas"))
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeModified, findChange(t, cl, "parameters.resources.memory").Type)
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeAdded, findChange(t, cl, "parameters.debug").Type)
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeRemoved, findChange(t, cl, "parameters.legacy").Type)
+	assert.Equal(t, `"b.example.com"`, findChange(t, cl, "traits.public.parameters.host").To)
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeAdded, findChange(t, cl, "traits.hpa").Type)
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeRemoved, findChange(t, cl, "traits.data").Type)
+	assert.Equal(t, `"debug"`, findChange(t, cl, "workload.container.env.LOG_LEVEL").To)
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeRemoved, findChange(t, cl, "workload.container.env.REMOVED").Type)
+
+	for _, c := range cl.Changes {
+		assert.NotEqual(t, "parameters.resources.cpu", c.Path, "unchanged values must not be reported")
+	}
+	assert.Equal(t, fmt.Sprintf("image app:v1 -> app:v2, %d configuration change(s)", len(cl.Changes)), Summary(cl))
+}
+
+func TestGenerate_ComponentTypeChange(t *testing.T) {
+	from := release("app:v1", "", nil)
+	to := release("app:v1", "", nil)
+	to.Spec.ComponentType.Name = "deployment/worker"
+
+	cl := Generate(from, to)
+	change := findChange(t, cl, "componentType")
+	assert.Equal(t, `"ComponentType/deployment/service"`, change.From)
+	assert.Equal(t, `"ComponentType/deployment/worker"`, change.To)
+}
+
+func TestGenerate_NoChanges(t *testing.T) {
+	cl := Generate(release("app:v1", `{"a":1}`, nil), release("app:v1", `{"a":1}`, nil))
+	assert.Empty(t, cl.Changes)
+	assert.Equal(t, "no changes", Summary(cl))
+}
+
+func withCommit(cr *openchoreov1alpha1.ComponentRelease, commit string) *openchoreov1alpha1.ComponentRelease {
+	cr.Annotations = map[string]string{labels.AnnotationKeySourceCommit: commit}
+	return cr
+}
+
+func TestGenerate_CommitRange(t *testing.T) {
+	from := withCommit(release("app:v1", "", nil), "1111111aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	to := withCommit(release("app:v2", "", nil), "2222222bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+
+	cl := Generate(from, to)
+	assert.Equal(t, "1111111aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", cl.FromCommit)
+	assert.Equal(t, "2222222bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", cl.ToCommit)
+	assert.Equal(t, "commits 1111111..2222222", CommitRange(cl))
+	assert.Equal(t, "image app:v1 -> app:v2, commits 1111111..2222222", Summary(cl))
+}
+
+func TestCommitRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		want     string
+	}{
+		{name: "no commits"},
+		{name: "initial commit", to: "2222222bbbb", want: "commit 2222222"},
+		{name: "unknown new commit", from: "1111111aaaa"},
+		{name: "same commit", from: "1111111aaaa", to: "1111111aaaa"},
+		{name: "short commits", from: "abc", to: "def", want: "commits abc..def"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := openchoreov1alpha1.ReleaseChangelog{FromCommit: tt.from, ToCommit: tt.to}
+			assert.Equal(t, tt.want, CommitRange(cl))
+		})
+	}
+}
+
+func TestGenerate_Truncated(t *testing.T) {
+	var env []openchoreov1alpha1.EnvVar
+	for i := range MaxChanges + 5 {
+		env = append(env, openchoreov1alpha1.EnvVar{Key: fmt.Sprintf("VAR_%03d", i), Value: "v"})
+	}
+	cl := Generate(release("app:v1", "", nil), release("app:v1", "", nil, env...))
+	assert.Len(t, cl.Changes, MaxChanges)
+	assert.True(t, cl.Truncated)
+}
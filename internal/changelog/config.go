@@ -0,0 +1,139 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package changelog
+
+import (
+	"regexp"
+	"strings"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// MaskedValue replaces the values of configuration entries that may hold secrets.
+const MaskedValue = "******"
+
+// sensitiveKeyPattern matches the last segment of configuration paths whose literal values
+// are likely secrets, such as DB_PASSWORD, apiKey or auth.token.
+var sensitiveKeyPattern = regexp.MustCompile(`(?i)(passw(or)?d|secret|token|credential|private[-_]?key|api[-_]?key|access[-_]?key)`)
+
+// ConfigChange is a change of the configuration a release binding resolves for a release.
+type ConfigChange struct {
+	openchoreov1alpha1.ReleaseChange
+
+	// Masked is true when From and To were replaced by MaskedValue because the entry may
+	// hold a secret.
+	Masked bool
+}
+
+// DiffConfig returns the differences between the configuration binding resolves for two
+// ComponentReleases: the container env vars and files with the binding's workload overrides
+// applied, the component parameters and the trait instances with their parameters.
+// from may be nil when to is the first release deployed by the binding, in which case every
+// entry is reported as added. The binding's overrides are applied to both releases, so
+// entries it overrides only change when the override itself is added or removed.
+//
+// Env vars and files sourced from secrets are reported by their secret reference. Literal
+// values of entries whose key suggests a secret are masked.
+func DiffConfig(from, to *openchoreov1alpha1.ComponentRelease, binding *openchoreov1alpha1.ReleaseBinding) []ConfigChange {
+	var overrides *openchoreov1alpha1.ContainerOverride
+	if binding != nil && binding.Spec.WorkloadOverrides != nil {
+		overrides = binding.Spec.WorkloadOverrides.Container
+	}
+
+	fromValues := resolvedConfig(from, overrides)
+	toValues := resolvedConfig(to, overrides)
+
+	var changes []openchoreov1alpha1.ReleaseChange
+	changes = append(changes, diffValues("env", fromValues.env, toValues.env)...)
+	changes = append(changes, diffValues("files", fromValues.files, toValues.files)...)
+	changes = append(changes, diffValues("parameters", fromValues.parameters, toValues.parameters)...)
+	changes = append(changes, diffTraits(fromValues.profile, toValues.profile)...)
+
+	out := make([]ConfigChange, 0, len(changes))
+	for _, c := range changes {
+		change := ConfigChange{ReleaseChange: c}
+		if isSensitive(c.Path) {
+			if c.From != "" && !fromValues.references[c.Path] {
+				change.From = MaskedValue
+				change.Masked = true
+			}
+			if c.To != "" && !toValues.references[c.Path] {
+				change.To = MaskedValue
+				change.Masked = true
+			}
+		}
+		out = append(out, change)
+	}
+	return out
+}
+
+// config is the flattened configuration resolved for a release.
+type config struct {
+	env        map[string]string
+	files      map[string]string
+	parameters map[string]string
+	profile    *openchoreov1alpha1.ComponentProfile
+
+	// references holds the paths of env vars and files sourced from secret references.
+	references map[string]bool
+}
+
+func resolvedConfig(cr *openchoreov1alpha1.ComponentRelease, overrides *openchoreov1alpha1.ContainerOverride) config {
+	if cr == nil {
+		return config{}
+	}
+	env := cr.Spec.Workload.Container.Env
+	files := cr.Spec.Workload.Container.Files
+	if overrides != nil {
+		// Overrides replace the entries of the release with the same key.
+		env = append(append([]openchoreov1alpha1.EnvVar{}, env...), overrides.Env...)
+		files = append(append([]openchoreov1alpha1.FileVar{}, files...), overrides.Files...)
+	}
+
+	c := config{
+		env:        envValues(env),
+		files:      fileValues(files),
+		parameters: profileParameters(cr),
+		profile:    cr.Spec.ComponentProfile,
+		references: map[string]bool{},
+	}
+	for _, e := range env {
+		c.references["env."+e.Key] = e.ValueFrom != nil
+	}
+	for _, f := range files {
+		c.references["files."+f.Key] = f.ValueFrom != nil
+	}
+	return c
+}
+
+// fileValues maps each file key to its content or secret reference, and its mount path.
+func fileValues(files []openchoreov1alpha1.FileVar) map[string]string {
+	values := make(map[string]string, 2*len(files))
+	for _, f := range files {
+		if f.ValueFrom != nil {
+			values[f.Key] = marshal(f.ValueFrom)
+		} else {
+			values[f.Key] = quote(f.Value)
+		}
+		values[f.Key+".mountPath"] = quote(f.MountPath)
+	}
+	return values
+}
+
+// isSensitive reports whether a literal value at path may be a secret. Env vars and files
+// are matched by their whole key, since file keys are file names such as secrets.json;
+// other values by the last segment of their path.
+func isSensitive(path string) bool {
+	if strings.HasSuffix(path, ".mountPath") {
+		return false
+	}
+	key, ok := strings.CutPrefix(path, "env.")
+	if !ok {
+		key, ok = strings.CutPrefix(path, "files.")
+	}
+	if !ok {
+		key = path[strings.LastIndex(path, ".")+1:]
+	}
+	return sensitiveKeyPattern.MatchString(key)
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func configChangeAt(t *testing.T, changes []ConfigChange, path string) ConfigChange {
+	t.Helper()
+	for _, c := range changes {
+		if c.Path == path {
+			return c
+		}
+	}
+	require.Failf(t, "change not found", "path %q not in %+v", path, changes)
+	return ConfigChange{}
+}
+
+func TestDiffConfig(t *testing.T) {
+	secretRef := &openchoreov1alpha1.EnvVarValueFrom{
+		SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: "db", Key: "password"},
+	}
+	from := release("app:v1", `{"replicas":1}`,
+		[]openchoreov1alpha1.ComponentProfileTrait{{Kind: "Trait", Name: "autoscaler", InstanceName: "hpa",
+			Parameters: &runtime.RawExtension{Raw: []byte(`{"maxReplicas":3}`)}}},
+		openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"},
+		openchoreov1alpha1.EnvVar{Key: "API_TOKEN", Value: "abc"},
+		openchoreov1alpha1.EnvVar{Key: "DB_PASSWORD", ValueFrom: secretRef},
+		openchoreov1alpha1.EnvVar{Key: "FEATURE_X", Value: "off"},
+	)
+	from.Spec.Workload.Container.Files = []openchoreov1alpha1.FileVar{
+		{Key: "app.yaml", MountPath: "/etc/app", Value: "debug: false"},
+	}
+
+	to := from.DeepCopy()
+	to.Spec.Workload.Container.Image = "app:v2"
+	to.Spec.ComponentProfile.Parameters.Raw = []byte(`{"replicas":2}`)
+	to.Spec.ComponentProfile.Traits[0].Parameters.Raw = []byte(`{"maxReplicas":5}`)
+	to.Spec.Workload.Container.Env[0].Value = "debug"
+	to.Spec.Workload.Container.Env[1].Value = "xyz"
+	to.Spec.Workload.Container.Env[2].ValueFrom = &openchoreov1alpha1.EnvVarValueFrom{
+		SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: "db-rotated", Key: "password"},
+	}
+	to.Spec.Workload.Container.Env[3].Value = "on"
+	to.Spec.Workload.Container.Files[0].Value = "debug: true"
+
+	// The binding pins FEATURE_X, so its change in the release does not reach the environment.
+	binding := &openchoreov1alpha1.ReleaseBinding{Spec: openchoreov1alpha1.ReleaseBindingSpec{
+		WorkloadOverrides: &openchoreov1alpha1.WorkloadOverrideTemplateSpec{
+			Container: &openchoreov1alpha1.ContainerOverride{
+				Env: []openchoreov1alpha1.EnvVar{{Key: "FEATURE_X", Value: "off"}},
+			},
+		},
+	}}
+
+	changes := DiffConfig(from, to, binding)
+	require.Len(t, changes, 6, "%+v", changes)
+
+	c := configChangeAt(t, changes, "env.LOG_LEVEL")
+	assert.Equal(t, `"info"`, c.From)
+	assert.Equal(t, `"debug"`, c.To)
+	assert.False(t, c.Masked)
+
+	c = configChangeAt(t, changes, "env.API_TOKEN")
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeModified, c.Type)
+	assert.Equal(t, MaskedValue, c.From)
+	assert.Equal(t, MaskedValue, c.To)
+	assert.True(t, c.Masked)
+
+	c = configChangeAt(t, changes, "env.DB_PASSWORD")
+	assert.Contains(t, c.From, `"name":"db"`)
+	assert.Contains(t, c.To, `"name":"db-rotated"`)
+	assert.False(t, c.Masked)
+
+	c = configChangeAt(t, changes, "files.app.yaml")
+	assert.Equal(t, `"debug: false"`, c.From)
+	assert.Equal(t, `"debug: true"`, c.To)
+
+	assert.Equal(t, "2", configChangeAt(t, changes, "parameters.replicas").To)
+	assert.Equal(t, "5", configChangeAt(t, changes, "traits.hpa.parameters.maxReplicas").To)
+}
+
+func TestDiffConfig_InitialRelease(t *testing.T) {
+	to := release("app:v1", "", nil, openchoreov1alpha1.EnvVar{Key: "CLIENT_SECRET", Value: "s3cr3t"})
+	to.Spec.Workload.Container.Files = []openchoreov1alpha1.FileVar{
+		{Key: "credentials.json", MountPath: "/var/run/creds", Value: `{"key":"value"}`},
+	}
+
+	changes := DiffConfig(nil, to, nil)
+	require.Len(t, changes, 3)
+	for _, c := range changes {
+		assert.Equal(t, openchoreov1alpha1.ReleaseChangeAdded, c.Type, c.Path)
+		assert.Empty(t, c.From, c.Path)
+	}
+	c := configChangeAt(t, changes, "env.CLIENT_SECRET")
+	assert.Equal(t, MaskedValue, c.To)
+	assert.True(t, c.Masked)
+
+	c = configChangeAt(t, changes, "files.credentials.json")
+	assert.Equal(t, MaskedValue, c.To)
+
+	c = configChangeAt(t, changes, "files.credentials.json.mountPath")
+	assert.Equal(t, `"/var/run/creds"`, c.To)
+	assert.False(t, c.Masked)
+}
+
+func TestDiffConfig_IdenticalReleases(t *testing.T) {
+	cr := release("app:v1", `{"replicas":1}`, nil, openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"})
+	to := cr.DeepCopy()
+	to.Spec.Workload.Container.Image = "app:v2"
+	assert.Empty(t, DiffConfig(cr, to, nil))
+}
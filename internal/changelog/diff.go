@@ -0,0 +1,101 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Diff returns every difference between the specs of two ComponentReleases: the frozen
+// ComponentType including its environment overrides schema, the component parameters,
+// the trait instances and frozen trait definitions, and the workload container and endpoints.
+// Unlike Generate, arrays are compared element by element and the result is not bounded.
+func Diff(from, to *openchoreov1alpha1.ComponentRelease) []openchoreov1alpha1.ReleaseChange {
+	var changes []openchoreov1alpha1.ReleaseChange
+
+	fromRef := fmt.Sprintf("%s/%s", from.Spec.ComponentType.Kind, from.Spec.ComponentType.Name)
+	toRef := fmt.Sprintf("%s/%s", to.Spec.ComponentType.Kind, to.Spec.ComponentType.Name)
+	if fromRef != toRef {
+		changes = append(changes, modified("componentType", quote(fromRef), quote(toRef)))
+	}
+	changes = append(changes, diffValues("componentType.spec",
+		flattenValue(from.Spec.ComponentType.Spec), flattenValue(to.Spec.ComponentType.Spec))...)
+	changes = append(changes, diffValues("parameters", profileParameters(from), profileParameters(to))...)
+	changes = append(changes, diffTraits(from.Spec.ComponentProfile, to.Spec.ComponentProfile)...)
+	changes = append(changes, diffValues("traitDefinitions",
+		traitDefinitionValues(from.Spec.Traits), traitDefinitionValues(to.Spec.Traits))...)
+	changes = append(changes, diffValues("workload", workloadValues(from.Spec.Workload), workloadValues(to.Spec.Workload))...)
+	changes = append(changes, diffEnv(from.Spec.Workload.Container.Env, to.Spec.Workload.Container.Env)...)
+	return changes
+}
+
+// DiffManifests returns the field-level differences between two rendered Kubernetes manifests.
+// Paths are relative to the manifest root, with array elements addressed by index.
+func DiffManifests(from, to map[string]any) []openchoreov1alpha1.ReleaseChange {
+	return diffValues("", flattenValue(from), flattenValue(to))
+}
+
+func traitDefinitionValues(traits []openchoreov1alpha1.ComponentReleaseTrait) map[string]string {
+	values := map[string]string{}
+	for _, t := range traits {
+		ref := traitRef(t.Kind, t.Name)
+		for k, v := range flattenValue(t.Spec) {
+			values[ref+"."+k] = v
+		}
+	}
+	return values
+}
+
+// workloadValues flattens a workload without its env vars, which diffEnv compares by key.
+func workloadValues(w openchoreov1alpha1.WorkloadTemplateSpec) map[string]string {
+	w.Container.Env = nil
+	return flattenValue(w)
+}
+
+// flattenValue converts any JSON-serializable value into a map from path to the JSON
+// rendering of each leaf. Unlike flatten, arrays are descended into and indexed.
+func flattenValue(v any) map[string]string {
+	out := map[string]string{}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return out
+	}
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return out
+	}
+	flattenIndexedInto(out, "", value)
+	return out
+}
+
+func flattenIndexedInto(out map[string]string, prefix string, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			break
+		}
+		for k, child := range v {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			flattenIndexedInto(out, key, child)
+		}
+		return
+	case []any:
+		if len(v) == 0 {
+			break
+		}
+		for i, child := range v {
+			flattenIndexedInto(out, fmt.Sprintf("%s[%d]", prefix, i), child)
+		}
+		return
+	}
+	if prefix != "" && value != nil {
+		out[prefix] = marshal(value)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func changeAt(t *testing.T, changes []openchoreov1alpha1.ReleaseChange, path string) openchoreov1alpha1.ReleaseChange {
+	t.Helper()
+	for _, c := range changes {
+		if c.Path == path {
+			return c
+		}
+	}
+	require.Failf(t, "change not found", "path %q not in %+v", path, changes)
+	return openchoreov1alpha1.ReleaseChange{}
+}
+
+func TestDiff_IdenticalReleases(t *testing.T) {
+	cr := release("app:v1", `{"replicas":1}`, nil, openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"})
+	assert.Empty(t, Diff(cr, cr.DeepCopy()))
+}
+
+func TestDiff_ComponentTypeAndWorkload(t *testing.T) {
+	from := release("app:v1", "", nil, openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"})
+	from.Spec.ComponentType.Spec.EnvironmentConfigs = &openchoreov1alpha1.SchemaSection{
+		OpenAPIV3Schema: &runtime.RawExtension{Raw: []byte(`{"properties":{"replicas":{"type":"integer","default":1}}}`)},
+	}
+	from.Spec.Workload.Container.Args = []string{"--port", "8080"}
+	from.Spec.Workload.Endpoints = map[string]openchoreov1alpha1.WorkloadEndpoint{
+		"http": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 8080},
+	}
+
+	to := from.DeepCopy()
+	to.Spec.ComponentType.Spec.EnvironmentConfigs.OpenAPIV3Schema.Raw = []byte(`{"properties":{"replicas":{"type":"integer","default":2}}}`)
+	to.Spec.Workload.Container.Image = "app:v2"
+	to.Spec.Workload.Container.Args = []string{"--port", "9090"}
+	to.Spec.Workload.Endpoints["grpc"] = openchoreov1alpha1.WorkloadEndpoint{Type: openchoreov1alpha1.EndpointTypeGRPC, Port: 9000}
+	to.Spec.Workload.Container.Env = nil
+
+	changes := Diff(from, to)
+
+	c := changeAt(t, changes, "componentType.spec.environmentConfigs.openAPIV3Schema.properties.replicas.default")
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeModified, c.Type)
+	assert.Equal(t, "1", c.From)
+	assert.Equal(t, "2", c.To)
+
+	c = changeAt(t, changes, "workload.container.image")
+	assert.Equal(t, `"app:v1"`, c.From)
+	assert.Equal(t, `"app:v2"`, c.To)
+
+	c = changeAt(t, changes, "workload.container.args[1]")
+	assert.Equal(t, `"9090"`, c.To)
+
+	c = changeAt(t, changes, "workload.endpoints.grpc.port")
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeAdded, c.Type)
+
+	c = changeAt(t, changes, "workload.container.env.LOG_LEVEL")
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeRemoved, c.Type)
+}
+
+func TestDiff_TraitDefinitions(t *testing.T) {
+	from := release("app:v1", "", nil)
+	from.Spec.Traits = []openchoreov1alpha1.ComponentReleaseTrait{{
+		Kind: openchoreov1alpha1.TraitRefKindTrait,
+		Name: "ingress",
+		Spec: openchoreov1alpha1.TraitSpec{
+			Parameters: &openchoreov1alpha1.SchemaSection{
+				OpenAPIV3Schema: &runtime.RawExtension{Raw: []byte(`{"properties":{"host":{"type":"string"}}}`)},
+			},
+		},
+	}}
+	to := from.DeepCopy()
+	to.Spec.Traits[0].Spec.Parameters.OpenAPIV3Schema.Raw = []byte(`{"properties":{"host":{"type":"string"},"path":{"type":"string"}}}`)
+
+	c := changeAt(t, Diff(from, to), "traitDefinitions.Trait/ingress.parameters.openAPIV3Schema.properties.path.type")
+	assert.Equal(t, openchoreov1alpha1.ReleaseChangeAdded, c.Type)
+}
+
+func TestDiffManifests(t *testing.T) {
+	from := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec": map[string]any{
+			"replicas": 1,
+			"template": map[string]any{"spec": map[string]any{"containers": []any{
+				map[string]any{"name": "main", "image": "app:v1"},
+			}}},
+		},
+	}
+	to := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec": map[string]any{
+			"replicas": 2,
+			"template": map[string]any{"spec": map[string]any{"containers": []any{
+				map[string]any{"name": "main", "image": "app:v2"},
+			}}},
+		},
+	}
+
+	changes := DiffManifests(from, to)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "spec.replicas", changes[0].Path)
+	assert.Equal(t, "spec.template.spec.containers[0].image", changes[1].Path)
+	assert.Equal(t, `"app:v2"`, changes[1].To)
+}
@@ -18,16 +18,43 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
 )
 
 const DefaultMaxPodLogBytes = 10 * 1024 * 1024 // 10MB
 
+// Defaults for retrying idempotent gateway calls that fail with a transient error.
+const (
+	DefaultMaxRetries     = 3
+	DefaultRetryBaseDelay = 200 * time.Millisecond
+	DefaultRetryMaxDelay  = 2 * time.Second
+)
+
+// IdempotencyKeyHeader carries the key that lets the gateway recognise a retried
+// lifecycle notification and replay its response instead of processing it again.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 type Config struct {
 	BaseURL        string
 	TLS            TLSConfig
 	Timeout        time.Duration
 	MaxPodLogBytes int64
+	// Retry configures retries of idempotent calls. The defaults apply to zero fields;
+	// set MaxRetries to a negative value to disable retries.
+	Retry RetryConfig
+	// Priority is sent with every proxied request (see messaging.RequestPriorityHeader).
+	// The gateway treats requests without a priority as reconcile traffic.
+	Priority messaging.RequestPriority
+}
+
+// RetryConfig configures the exponential backoff with full jitter between retries.
+type RetryConfig struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
 }
 
 type TLSConfig struct {
@@ -45,6 +72,8 @@ type Client struct {
 	baseURL        string
 	httpClient     *http.Client
 	maxPodLogBytes int64
+	retry          RetryConfig
+	priority       messaging.RequestPriority
 }
 
 type PlaneNotification struct {
@@ -53,11 +82,15 @@ type PlaneNotification struct {
 	Event     string `json:"event"` // "created", "updated", "deleted"
 	Namespace string `json:"namespace"`
 	Name      string `json:"name"`
+	// IdempotencyKey is sent in the Idempotency-Key header. A random key is generated
+	// when empty; it is reused across the retries of one NotifyPlaneLifecycle call.
+	IdempotencyKey string `json:"-"`
 }
 
 type NotificationResponse struct {
-	DisconnectedAgents int  `json:"disconnectedAgents"`
-	Success            bool `json:"success"`
+	DisconnectedAgents  int  `json:"disconnectedAgents"`
+	RevokedCertificates int  `json:"revokedCertificates"`
+	Success             bool `json:"success"`
 }
 
 type PlaneConnectionStatus struct {
@@ -181,8 +214,16 @@ func NewClientWithConfig(config *Config) (*Client, error) {
 		timeout = 10 * time.Second
 	}
 
+	// A custom TLS config disables HTTP/2 unless it is forced, so that notifications,
+	// status polls and proxied requests share pooled multiplexed connections.
 	transport := &http.Transport{
-		TLSClientConfig: tlsConfig,
+		TLSClientConfig:       tlsConfig,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   20,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
 	}
 
 	maxPodLogBytes := config.MaxPodLogBytes
@@ -197,6 +238,8 @@ func NewClientWithConfig(config *Config) (*Client, error) {
 			Transport: transport,
 		},
 		maxPodLogBytes: maxPodLogBytes,
+		retry:          retryConfigWithDefaults(config.Retry),
+		priority:       config.Priority,
 	}, nil
 }
 
@@ -248,18 +291,37 @@ func BuildTLSConfig(config *TLSConfig) (*tls.Config, error) {
 	return tlsConfig, nil
 }
 
+// NotifyPlaneLifecycle notifies the gateway of a plane CR lifecycle event. Transient
+// failures are retried with the same idempotency key, so the gateway processes the
+// event once even when a response is lost.
 func (c *Client) NotifyPlaneLifecycle(ctx context.Context, notification *PlaneNotification) (*NotificationResponse, error) {
 	body, err := json.Marshal(notification)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal notification: %w", err)
 	}
 
+	idempotencyKey := notification.IdempotencyKey
+	if idempotencyKey == "" {
+		idempotencyKey = uuid.NewString()
+	}
+
+	var response *NotificationResponse
+	err = c.withRetry(ctx, func() error {
+		var err error
+		response, err = c.notifyPlaneLifecycle(ctx, body, idempotencyKey)
+		return err
+	})
+	return response, err
+}
+
+func (c *Client) notifyPlaneLifecycle(ctx context.Context, body []byte, idempotencyKey string) (*NotificationResponse, error) {
 	url := fmt.Sprintf("%s/api/v1/planes/notify", c.baseURL)
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(IdempotencyKeyHeader, idempotencyKey)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -316,7 +378,18 @@ func (c *Client) ForceReconnect(ctx context.Context, planeType, planeID string)
 // This is used by controllers to query agent connection status and update CR status fields
 // If namespace and name are provided, it returns CR-specific authorization status
 // If they are empty, it returns plane-level connection status
+// Transient failures are retried.
 func (c *Client) GetPlaneStatus(ctx context.Context, planeType, planeID, namespace, name string) (*PlaneConnectionStatus, error) {
+	var status *PlaneConnectionStatus
+	err := c.withRetry(ctx, func() error {
+		var err error
+		status, err = c.getPlaneStatus(ctx, planeType, planeID, namespace, name)
+		return err
+	})
+	return status, err
+}
+
+func (c *Client) getPlaneStatus(ctx context.Context, planeType, planeID, namespace, name string) (*PlaneConnectionStatus, error) {
 	url := fmt.Sprintf("%s/api/v1/planes/%s/%s/status", c.baseURL, planeType, planeID)
 
 	// Add query parameters for CR-specific status if provided
@@ -399,7 +472,7 @@ func (c *Client) GetPodLogsFromPlane(ctx context.Context, planeType, planeID, pl
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doProxy(req)
 	if err != nil {
 		// Network errors are transient and should be retried
 		return "", &TransientError{
@@ -439,7 +512,7 @@ func (c *Client) ProxyK8sRequest(ctx context.Context, planeType, planeID, crName
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doProxy(req)
 	if err != nil {
 		return nil, &TransientError{
 			Message: "failed to proxy K8s request",
@@ -463,7 +536,30 @@ func (c *Client) PostK8sRequest(ctx context.Context, planeType, planeID, crNames
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doProxy(req)
+	if err != nil {
+		return nil, &TransientError{
+			Message: "failed to proxy K8s request",
+			Err:     err,
+		}
+	}
+
+	return resp, nil
+}
+
+// DeleteK8sRequest proxies a DELETE K8s API request through the gateway.
+// Returns the raw HTTP response for the caller to inspect.
+// For cluster-scoped CRs, pass crNamespace as "_cluster".
+func (c *Client) DeleteK8sRequest(ctx context.Context, planeType, planeID, crNamespace, crName, k8sPath string) (*http.Response, error) {
+	proxyURL := fmt.Sprintf("%s/api/proxy/%s/%s/%s/%s/k8s/%s", c.baseURL, planeType, planeID, crNamespace, crName, k8sPath)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", proxyURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.doProxy(req)
 	if err != nil {
 		return nil, &TransientError{
 			Message: "failed to proxy K8s request",
@@ -495,7 +591,7 @@ func (c *Client) GetPodEventsFromPlane(ctx context.Context, planeType, planeID,
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doProxy(req)
 	if err != nil {
 		// Network errors are transient and should be retried
 		return nil, &TransientError{
@@ -516,3 +612,11 @@ func (c *Client) GetPodEventsFromPlane(ctx context.Context, planeType, planeID,
 
 	return body, nil
 }
+
+// doProxy sends a request to the gateway proxy with the client's priority class
+func (c *Client) doProxy(req *http.Request) (*http.Response, error) {
+	if c.priority != "" {
+		req.Header.Set(messaging.RequestPriorityHeader, string(c.priority))
+	}
+	return c.httpClient.Do(req)
+}
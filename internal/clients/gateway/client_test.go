@@ -24,6 +24,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
 )
 
 func TestProxyK8sRequest(t *testing.T) {
@@ -247,6 +249,30 @@ func TestProxyK8sRequest_CallerMustCloseBody(t *testing.T) {
 	resp.Body.Close()
 }
 
+func TestProxyRequestPriority(t *testing.T) {
+	var received []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(messaging.RequestPriorityHeader))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := &Client{baseURL: server.URL, httpClient: server.Client(), priority: messaging.PriorityReconcile}
+	resp, err := c.ProxyK8sRequest(context.Background(), "dataplane", "test", "ns", "name", "api/v1/pods", "")
+	require.NoError(t, err)
+	resp.Body.Close()
+	resp, err = c.DeleteK8sRequest(context.Background(), "dataplane", "test", "ns", "name", "api/v1/namespaces/default/pods/p")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	c.priority = ""
+	resp, err = c.ProxyK8sRequest(context.Background(), "dataplane", "test", "ns", "name", "api/v1/pods", "")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, []string{string(messaging.PriorityReconcile), string(messaging.PriorityReconcile), ""}, received)
+}
+
 // ─── Pure-logic tests ─────────────────────────────────────────────────────────
 
 // mockLogger captures calls to Error for use in HandleGatewayError tests.
@@ -502,6 +528,113 @@ func TestNotifyPlaneLifecycle(t *testing.T) {
 	})
 }
 
+func TestNotifyPlaneLifecycle_Retries(t *testing.T) {
+	retry := RetryConfig{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+	t.Run("transient failures are retried with the same idempotency key", func(t *testing.T) {
+		var keys []string
+		c := newTestGatewayClient(t, func(w http.ResponseWriter, r *http.Request) {
+			keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+			if len(keys) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"success":true}`))
+		})
+		c.retry = retry
+
+		resp, err := c.NotifyPlaneLifecycle(context.Background(), &PlaneNotification{PlaneType: "dataplane", PlaneID: "prod"})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		require.Len(t, keys, 3)
+		assert.NotEmpty(t, keys[0])
+		assert.Equal(t, keys[0], keys[1])
+		assert.Equal(t, keys[0], keys[2])
+	})
+
+	t.Run("caller-provided idempotency key is sent", func(t *testing.T) {
+		var key string
+		c := newTestGatewayClient(t, func(w http.ResponseWriter, r *http.Request) {
+			key = r.Header.Get(IdempotencyKeyHeader)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"success":true}`))
+		})
+
+		_, err := c.NotifyPlaneLifecycle(context.Background(), &PlaneNotification{IdempotencyKey: "dp-created-1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "dp-created-1", key)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		calls := 0
+		c := newTestGatewayClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		})
+		c.retry = retry
+
+		_, err := c.NotifyPlaneLifecycle(context.Background(), &PlaneNotification{})
+
+		require.Error(t, err)
+		assert.True(t, IsTransientError(err))
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("permanent failures are not retried", func(t *testing.T) {
+		calls := 0
+		c := newTestGatewayClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		c.retry = retry
+
+		_, err := c.NotifyPlaneLifecycle(context.Background(), &PlaneNotification{})
+
+		require.Error(t, err)
+		assert.True(t, IsPermanentError(err))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops retrying when the context is done", func(t *testing.T) {
+		calls := 0
+		ctx, cancel := context.WithCancel(context.Background())
+		c := newTestGatewayClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			cancel()
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		c.retry = RetryConfig{MaxRetries: 3, BaseDelay: time.Hour, MaxDelay: time.Hour}
+
+		_, err := c.NotifyPlaneLifecycle(ctx, &PlaneNotification{})
+
+		require.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestRetryConfigWithDefaults(t *testing.T) {
+	assert.Equal(t, RetryConfig{MaxRetries: DefaultMaxRetries, BaseDelay: DefaultRetryBaseDelay, MaxDelay: DefaultRetryMaxDelay},
+		retryConfigWithDefaults(RetryConfig{}))
+	assert.Equal(t, 0, retryConfigWithDefaults(RetryConfig{MaxRetries: -1}).MaxRetries)
+	assert.Equal(t, 5, retryConfigWithDefaults(RetryConfig{MaxRetries: 5}).MaxRetries)
+}
+
+func TestBackoff(t *testing.T) {
+	config := RetryConfig{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt, ceiling := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, time.Second, time.Second} {
+		for range 50 {
+			d := backoff(config, attempt)
+			assert.Positive(t, d)
+			assert.LessOrEqual(t, d, ceiling, "attempt %d", attempt)
+		}
+	}
+	assert.LessOrEqual(t, backoff(config, 100), time.Second)
+}
+
 func TestForceReconnect(t *testing.T) {
 	t.Run("success with correct URL and POST", func(t *testing.T) {
 		var capturedMethod, capturedPath string
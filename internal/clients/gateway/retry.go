@@ -0,0 +1,59 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// retryConfigWithDefaults fills the zero fields of a retry config with the defaults.
+// A negative MaxRetries disables retries.
+func retryConfigWithDefaults(config RetryConfig) RetryConfig {
+	switch {
+	case config.MaxRetries == 0:
+		config.MaxRetries = DefaultMaxRetries
+	case config.MaxRetries < 0:
+		config.MaxRetries = 0
+	}
+	if config.BaseDelay <= 0 {
+		config.BaseDelay = DefaultRetryBaseDelay
+	}
+	if config.MaxDelay <= 0 {
+		config.MaxDelay = DefaultRetryMaxDelay
+	}
+	return config
+}
+
+// withRetry runs an idempotent call and retries it while it fails with a transient error,
+// up to the configured number of retries. It returns the last error when the retries are
+// exhausted or the context is done.
+func (c *Client) withRetry(ctx context.Context, call func() error) error {
+	err := call()
+	for attempt := 0; attempt < c.retry.MaxRetries && IsTransientError(err); attempt++ {
+		timer := time.NewTimer(backoff(c.retry, attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		err = call()
+	}
+	return err
+}
+
+// backoff returns the delay before a retry: a random duration up to the exponential
+// backoff of the attempt, capped at the maximum delay. Full jitter spreads out the
+// retries of controllers that failed on the same gateway blip.
+func backoff(config RetryConfig, attempt int) time.Duration {
+	delay := config.MaxDelay
+	if attempt < 32 {
+		if d := config.BaseDelay << attempt; d > 0 && d < delay {
+			delay = d
+		}
+	}
+	return rand.N(delay) + 1
+}
@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
 	argo "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
 	ciliumv2 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/cilium.io/v2"
 	csisecretv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/secretstorecsi/v1"
@@ -32,6 +33,9 @@ type KubeMultiClientManager struct {
 	mu             sync.RWMutex
 	clients        map[string]client.Client
 	ProxyTLSConfig *ProxyTLSConfig // TLS configuration for HTTP proxy connections
+	// ProxyRequestPriority is the priority class sent with requests proxied through the
+	// cluster gateway (see messaging.RequestPriorityHeader). Empty sends no priority.
+	ProxyRequestPriority messaging.RequestPriority
 }
 
 // NewManager initializes a new KubeMultiClientManager.
@@ -119,7 +123,7 @@ func GetK8sClientFromDataPlane(
 	// Use GetOrAddClient to cache the proxy client
 	return clientMgr.GetOrAddClient(key, func() (client.Client, error) {
 		// Proxy client needs CR namespace/name to construct full 6-part URL
-		return NewProxyClient(gatewayURL, planeIdentifier, dataplane.Namespace, dataplane.Name, clientMgr.ProxyTLSConfig)
+		return NewProxyClient(gatewayURL, planeIdentifier, dataplane.Namespace, dataplane.Name, clientMgr.ProxyTLSConfig, WithRequestPriority(clientMgr.ProxyRequestPriority))
 	})
 }
 
@@ -153,7 +157,7 @@ func GetK8sClientFromWorkflowPlane(
 	// Use GetOrAddClient to cache the proxy client
 	return clientMgr.GetOrAddClient(key, func() (client.Client, error) {
 		// Proxy client needs CR namespace/name to construct full 6-part URL
-		return NewProxyClient(gatewayURL, planeIdentifier, workflowPlane.Namespace, workflowPlane.Name, clientMgr.ProxyTLSConfig)
+		return NewProxyClient(gatewayURL, planeIdentifier, workflowPlane.Namespace, workflowPlane.Name, clientMgr.ProxyTLSConfig, WithRequestPriority(clientMgr.ProxyRequestPriority))
 	})
 }
 
@@ -186,7 +190,7 @@ func GetK8sClientFromClusterWorkflowPlane(
 	// Use GetOrAddClient to cache the proxy client
 	return clientMgr.GetOrAddClient(key, func() (client.Client, error) {
 		// Cluster-scoped: use placeholder namespace to maintain 6-part URL format
-		return NewProxyClient(gatewayURL, planeIdentifier, "_cluster", clusterWorkflowPlane.Name, clientMgr.ProxyTLSConfig)
+		return NewProxyClient(gatewayURL, planeIdentifier, "_cluster", clusterWorkflowPlane.Name, clientMgr.ProxyTLSConfig, WithRequestPriority(clientMgr.ProxyRequestPriority))
 	})
 }
 
@@ -219,7 +223,7 @@ func GetK8sClientFromClusterDataPlane(
 	// Use GetOrAddClient to cache the proxy client
 	return clientMgr.GetOrAddClient(key, func() (client.Client, error) {
 		// Cluster-scoped: use placeholder namespace to maintain 6-part URL format
-		return NewProxyClient(gatewayURL, planeIdentifier, "_cluster", clusterDataplane.Name, clientMgr.ProxyTLSConfig)
+		return NewProxyClient(gatewayURL, planeIdentifier, "_cluster", clusterDataplane.Name, clientMgr.ProxyTLSConfig, WithRequestPriority(clientMgr.ProxyRequestPriority))
 	})
 }
 
@@ -251,7 +255,7 @@ func GetK8sClientFromObservabilityPlane(
 
 		// Use GetOrAddClient to cache the proxy client
 		return clientMgr.GetOrAddClient(key, func() (client.Client, error) {
-			return NewProxyClient(gatewayURL, planeIdentifier, observabilityPlane.Namespace, observabilityPlane.Name, clientMgr.ProxyTLSConfig)
+			return NewProxyClient(gatewayURL, planeIdentifier, observabilityPlane.Namespace, observabilityPlane.Name, clientMgr.ProxyTLSConfig, WithRequestPriority(clientMgr.ProxyRequestPriority))
 		})
 	}
 
@@ -288,7 +292,7 @@ func GetK8sClientFromClusterObservabilityPlane(
 		// Use GetOrAddClient to cache the proxy client
 		return clientMgr.GetOrAddClient(key, func() (client.Client, error) {
 			// Cluster-scoped: use placeholder namespace to maintain 6-part URL format
-			return NewProxyClient(gatewayURL, planeIdentifier, "_cluster", clusterObsPlane.Name, clientMgr.ProxyTLSConfig)
+			return NewProxyClient(gatewayURL, planeIdentifier, "_cluster", clusterObsPlane.Name, clientMgr.ProxyTLSConfig, WithRequestPriority(clientMgr.ProxyRequestPriority))
 		})
 	}
 
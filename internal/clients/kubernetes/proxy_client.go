@@ -23,6 +23,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
 )
 
 // ProxyClient is a Kubernetes client that communicates through the cluster gateway HTTP proxy
@@ -37,9 +39,25 @@ type ProxyClient struct {
 	scheme      *runtime.Scheme
 }
 
+// ProxyClientOption configures optional behaviour of a ProxyClient
+type ProxyClientOption func(*proxyClientOptions)
+
+type proxyClientOptions struct {
+	priority messaging.RequestPriority
+}
+
+// WithRequestPriority sends the given priority class (see messaging.RequestPriorityHeader) with
+// every request, so that the gateway can schedule it against other traffic to the plane.
+// An empty priority sends no header.
+func WithRequestPriority(priority messaging.RequestPriority) ProxyClientOption {
+	return func(o *proxyClientOptions) {
+		o.priority = priority
+	}
+}
+
 // NewProxyClient creates a new proxy client for accessing a data plane or workflow plane through the cluster gateway
 // planeIdentifier format: "planeType/planeID" (e.g., "dataplane/prod-cluster")
-func NewProxyClient(gatewayURL, planeIdentifier string, crNamespace, crName string, tlsConfig *ProxyTLSConfig) (client.Client, error) {
+func NewProxyClient(gatewayURL, planeIdentifier string, crNamespace, crName string, tlsConfig *ProxyTLSConfig, opts ...ProxyClientOption) (client.Client, error) {
 	if gatewayURL == "" {
 		return nil, fmt.Errorf("gatewayURL is required")
 	}
@@ -64,6 +82,18 @@ func NewProxyClient(gatewayURL, planeIdentifier string, crNamespace, crName stri
 		return nil, fmt.Errorf("failed to build TLS config: %w", err)
 	}
 
+	options := &proxyClientOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var transport http.RoundTripper = &http.Transport{
+		TLSClientConfig: tlsCfg,
+	}
+	if options.priority != "" {
+		transport = &priorityTransport{base: transport, priority: options.priority}
+	}
+
 	return &ProxyClient{
 		gatewayURL:  strings.TrimSuffix(gatewayURL, "/"),
 		planeType:   planeType,
@@ -71,14 +101,24 @@ func NewProxyClient(gatewayURL, planeIdentifier string, crNamespace, crName stri
 		crNamespace: crNamespace,
 		crName:      crName,
 		httpClient: &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: tlsCfg,
-			},
+			Transport: transport,
 		},
 		scheme: scheme.Scheme,
 	}, nil
 }
 
+// priorityTransport sets the request priority header on every request sent to the gateway
+type priorityTransport struct {
+	base     http.RoundTripper
+	priority messaging.RequestPriority
+}
+
+func (t *priorityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(messaging.RequestPriorityHeader, string(t.priority))
+	return t.base.RoundTrip(req)
+}
+
 // buildProxyURL constructs the proxy URL in the new 6-part format:
 // /api/proxy/{planeType}/{planeID}/{namespace}/{crName}/{target}/{path}
 func (pc *ProxyClient) buildProxyURL(apiPath string) string {
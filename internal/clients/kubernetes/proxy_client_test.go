@@ -30,6 +30,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
 )
 
 // ───────────────────────── Test helpers ─────────────────────────
@@ -145,6 +147,30 @@ func TestNewProxyClientValidation(t *testing.T) {
 	}
 }
 
+func TestNewProxyClientWithRequestPriority(t *testing.T) {
+	var received string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get(messaging.RequestPriorityHeader)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testPodJSON))
+	}))
+	defer server.Close()
+
+	cl, err := NewProxyClient(server.URL, "dataplane/test-plane", "test-ns", "test-cr",
+		&ProxyTLSConfig{Insecure: true}, WithRequestPriority(messaging.PriorityInteractive))
+	require.NoError(t, err)
+
+	pod := &corev1.Pod{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKey{Namespace: testPodNamespace, Name: testPodName}, pod))
+	assert.Equal(t, string(messaging.PriorityInteractive), received)
+
+	cl, err = NewProxyClient(server.URL, "dataplane/test-plane", "test-ns", "test-cr",
+		&ProxyTLSConfig{Insecure: true}, WithRequestPriority(""))
+	require.NoError(t, err)
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKey{Namespace: testPodNamespace, Name: testPodName}, pod))
+	assert.Empty(t, received)
+}
+
 func TestPluralizeKind(t *testing.T) {
 	tests := []struct {
 		kind string
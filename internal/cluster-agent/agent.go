@@ -40,6 +40,7 @@ type Agent struct {
 	k8sClient  client.Client
 	k8sConfig  *rest.Config
 	router     *Router
+	applyQueue *applyQueue
 	mu         sync.Mutex
 	logger     *slog.Logger
 	stopChan   chan struct{}
@@ -92,6 +93,11 @@ func New(cfg *Config, k8sClient client.Client, k8sConfig *rest.Config, logger *s
 		return nil, fmt.Errorf("failed to create router: %w", err)
 	}
 
+	applyQueue, err := newApplyQueue(cfg.ApplyQueueDir, cfg.ApplyQueueMaxAge, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Agent{
 		config:        cfg,
 		clientCert:    cert,
@@ -99,6 +105,7 @@ func New(cfg *Config, k8sClient client.Client, k8sConfig *rest.Config, logger *s
 		k8sClient:     k8sClient,
 		k8sConfig:     k8sConfig,
 		router:        router,
+		applyQueue:    applyQueue,
 		logger:        logger.With("component", "agent", "planeID", cfg.PlaneID),
 		stopChan:      make(chan struct{}),
 		activeStreams: make(map[string]*execSession),
@@ -145,6 +152,9 @@ func (a *Agent) Start(ctx context.Context) error {
 			}
 		}
 
+		// Replay the apply requests whose outcome was lost with the previous connection
+		go a.replayApplyQueue(ctx)
+
 		// Handle messages on the established connection
 		// This will block until connection is lost or context is canceled
 		a.handleConnection(ctx)
@@ -288,6 +298,13 @@ func (a *Agent) handleHTTPTunnelRequest(req *messaging.HTTPTunnelRequest) {
 		"requestID", req.RequestID,
 	)
 
+	if a.applyQueue != nil && isApplyRequest(req) {
+		if key, _, ok := applyKey(req); ok {
+			a.handleApplyRequest(req, key)
+			return
+		}
+	}
+
 	// Route the request to the appropriate backend service
 	response := a.router.Route(req)
 
@@ -299,6 +316,80 @@ func (a *Agent) handleHTTPTunnelRequest(req *messaging.HTTPTunnelRequest) {
 	}
 }
 
+// handleApplyRequest serves a Kubernetes apply or delete request through the apply queue.
+// The request stays queued for replay only if it failed with a retryable status and the
+// gateway could not be told; otherwise the control plane owns any retry.
+func (a *Agent) handleApplyRequest(req *messaging.HTTPTunnelRequest, key string) {
+	unlock := a.applyQueue.lock(key)
+	defer unlock()
+
+	entry := a.applyQueue.enqueue(req)
+	response := a.router.Route(req)
+
+	err := a.sendHTTPTunnelResponse(response)
+	if err == nil || !isRetryableApplyStatus(response.StatusCode) {
+		a.applyQueue.complete(entry)
+	}
+	if err != nil {
+		a.logger.Error("failed to send HTTP tunnel response",
+			"requestID", req.RequestID,
+			"error", err,
+			"queuedForReplay", entry != nil && isRetryableApplyStatus(response.StatusCode),
+		)
+	}
+}
+
+// replayApplyQueue retries the queued apply requests in the order they were received.
+// Requests that fail with a retryable status stay queued for the next connection.
+func (a *Agent) replayApplyQueue(ctx context.Context) {
+	entries := a.applyQueue.pending()
+	if len(entries) == 0 {
+		return
+	}
+	a.logger.Info("replaying queued apply requests", "count", len(entries))
+
+	replayed := 0
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return
+		}
+		if a.replayApply(entry) {
+			replayed++
+		}
+	}
+	a.logger.Info("replayed queued apply requests", "replayed", replayed, "remaining", len(a.applyQueue.pending()))
+}
+
+// replayApply retries a queued request and reports whether it reached a final outcome
+func (a *Agent) replayApply(entry *queuedApply) bool {
+	unlock := a.applyQueue.lock(entry.Key)
+	defer unlock()
+
+	// A newer request for the same resource was served in the meantime
+	if !a.applyQueue.current(entry) {
+		return false
+	}
+
+	response := a.router.Route(entry.Request)
+	if isRetryableApplyStatus(response.StatusCode) {
+		a.logger.Warn("queued apply request failed, keeping it for the next connection",
+			"key", entry.Key,
+			"method", entry.Request.Method,
+			"statusCode", response.StatusCode,
+		)
+		return false
+	}
+	// A conflict means a newer write changed the object since the request was sent;
+	// the request is dropped rather than retried.
+	a.logger.Info("replayed queued apply request",
+		"key", entry.Key,
+		"method", entry.Request.Method,
+		"statusCode", response.StatusCode,
+	)
+	a.applyQueue.complete(entry)
+	return true
+}
+
 func (a *Agent) sendHTTPTunnelResponse(resp *messaging.HTTPTunnelResponse) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -0,0 +1,297 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// applyQueueLockStripes is the number of locks mutations of the same resource are
+// serialized on.
+const applyQueueLockStripes = 64
+
+// queuedApply is a Kubernetes mutation received from the gateway that has not been
+// confirmed yet. It is stored as one JSON file per resource.
+type queuedApply struct {
+	// Key identifies the resource: the API path of the object
+	Key string `json:"key"`
+	// ResourceVersion is the resourceVersion precondition carried by the request body
+	ResourceVersion string                       `json:"resourceVersion,omitempty"`
+	ReceivedAt      time.Time                    `json:"receivedAt"`
+	Request         *messaging.HTTPTunnelRequest `json:"request"`
+
+	// seq orders the entries and tells a completed request apart from a newer one
+	// queued for the same resource.
+	seq uint64
+}
+
+// applyQueue persists the Kubernetes apply and delete requests the agent receives until
+// their outcome is known, so that a request whose response could not be delivered
+// because the gateway connection dropped, or that was interrupted by an agent restart,
+// is replayed after reconnection instead of being lost.
+//
+// Only requests that carry a resourceVersion precondition are queued. The API server
+// rejects a replay of such a request with a conflict once any newer write changed the
+// object, whichever path it took, so a replay never overwrites newer state. Server-side
+// applies and creates carry no precondition; they are left to the control plane's retries.
+//
+// The queue holds at most one entry per resource: a later request for the same resource
+// supersedes a queued one, and a redelivery of a request at the same resource version
+// is not queued twice.
+//
+// Request bodies may hold Secret data; the files are written with owner-only permissions.
+// A nil queue is disabled.
+type applyQueue struct {
+	dir    string
+	maxAge time.Duration
+	logger *slog.Logger
+	now    func() time.Time
+
+	locks [applyQueueLockStripes]sync.Mutex
+
+	mu      sync.Mutex
+	entries map[string]*queuedApply
+	nextSeq uint64
+}
+
+// newApplyQueue opens the queue stored in dir, loading the entries left by a previous
+// run. It returns nil, meaning disabled, when dir is empty. Entries older than maxAge
+// are dropped instead of being replayed; a non-positive maxAge keeps them indefinitely.
+func newApplyQueue(dir string, maxAge time.Duration, logger *slog.Logger) (*applyQueue, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create apply queue directory: %w", err)
+	}
+
+	q := &applyQueue{
+		dir:     dir,
+		maxAge:  maxAge,
+		logger:  logger.With("component", "apply-queue"),
+		now:     time.Now,
+		entries: make(map[string]*queuedApply),
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	loaded := make([]*queuedApply, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read queued apply: %w", err)
+		}
+		entry := &queuedApply{}
+		if err := json.Unmarshal(data, entry); err != nil || entry.Request == nil || entry.Key == "" {
+			q.logger.Warn("dropping unreadable queued apply", "path", path, "error", err)
+			_ = os.Remove(path)
+			continue
+		}
+		loaded = append(loaded, entry)
+	}
+	slices.SortFunc(loaded, func(a, b *queuedApply) int { return a.ReceivedAt.Compare(b.ReceivedAt) })
+	for _, entry := range loaded {
+		q.nextSeq++
+		entry.seq = q.nextSeq
+		q.entries[entry.Key] = entry
+	}
+	if len(loaded) > 0 {
+		q.logger.Info("loaded queued applies from a previous run", "count", len(loaded))
+	}
+	return q, nil
+}
+
+// isApplyRequest reports whether the request mutates a Kubernetes object
+func isApplyRequest(req *messaging.HTTPTunnelRequest) bool {
+	if req.Target != "k8s" {
+		return false
+	}
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// applyKey returns the key and resourceVersion precondition of an apply request. ok is
+// false for requests that cannot be replayed safely: creates and requests without a
+// precondition, such as server-side applies.
+func applyKey(req *messaging.HTTPTunnelRequest) (key, resourceVersion string, ok bool) {
+	if req.Method == http.MethodPost {
+		return "", "", false
+	}
+	var body struct {
+		Metadata struct {
+			ResourceVersion string `json:"resourceVersion"`
+		} `json:"metadata"`
+		// Deletes carry the precondition in their DeleteOptions
+		Preconditions struct {
+			ResourceVersion string `json:"resourceVersion"`
+		} `json:"preconditions"`
+	}
+	// Bodies are JSON or, for server-side apply, possibly YAML.
+	if len(req.Body) > 0 {
+		_ = yaml.Unmarshal(req.Body, &body)
+	}
+
+	resourceVersion = body.Metadata.ResourceVersion
+	if req.Method == http.MethodDelete {
+		resourceVersion = body.Preconditions.ResourceVersion
+	}
+	if resourceVersion == "" {
+		return "", "", false
+	}
+	return strings.TrimSuffix(req.Path, "/"), resourceVersion, true
+}
+
+// lock serializes the requests for the resource identified by key, so that a replay
+// never overtakes a newer request for the same resource.
+func (q *applyQueue) lock(key string) func() {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	mu := &q.locks[h.Sum32()%applyQueueLockStripes]
+	mu.Lock()
+	return mu.Unlock
+}
+
+// enqueue persists the request and returns its entry, or nil if the request is not
+// queued. Must be called with the lock of the request's key held.
+func (q *applyQueue) enqueue(req *messaging.HTTPTunnelRequest) *queuedApply {
+	if q == nil || !isApplyRequest(req) {
+		return nil
+	}
+	key, resourceVersion, ok := applyKey(req)
+	if !ok {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if existing, found := q.entries[key]; found &&
+		existing.ResourceVersion == resourceVersion && existing.Request.Method == req.Method {
+		// A redelivery of the queued request
+		return existing
+	}
+
+	stored := *req
+	// The agent authenticates Kubernetes requests with its own credentials.
+	stored.Headers = make(map[string][]string, len(req.Headers))
+	for k, v := range req.Headers {
+		if !strings.EqualFold(k, "Authorization") {
+			stored.Headers[k] = v
+		}
+	}
+	q.nextSeq++
+	entry := &queuedApply{
+		Key:             key,
+		ResourceVersion: resourceVersion,
+		ReceivedAt:      q.now(),
+		Request:         &stored,
+		seq:             q.nextSeq,
+	}
+	if err := q.writeLocked(entry); err != nil {
+		// The request is still served; it just cannot be replayed.
+		q.logger.Warn("failed to persist apply request", "key", key, "error", err)
+		return nil
+	}
+	q.entries[key] = entry
+	return entry
+}
+
+// complete removes the entry, unless a newer request for the same resource replaced it
+func (q *applyQueue) complete(entry *queuedApply) {
+	if q == nil || entry == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if current, found := q.entries[entry.Key]; !found || current.seq != entry.seq {
+		return
+	}
+	delete(q.entries, entry.Key)
+	if err := os.Remove(q.path(entry.Key)); err != nil && !os.IsNotExist(err) {
+		q.logger.Warn("failed to remove queued apply", "key", entry.Key, "error", err)
+	}
+}
+
+// current reports whether entry is still the queued request for its resource
+func (q *applyQueue) current(entry *queuedApply) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	current, found := q.entries[entry.Key]
+	return found && current.seq == entry.seq
+}
+
+// pending returns the queued entries in the order they were received, dropping the
+// ones older than maxAge.
+func (q *applyQueue) pending() []*queuedApply {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	entries := make([]*queuedApply, 0, len(q.entries))
+	var expired []*queuedApply
+	for _, entry := range q.entries {
+		if q.maxAge > 0 && q.now().Sub(entry.ReceivedAt) > q.maxAge {
+			expired = append(expired, entry)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	q.mu.Unlock()
+
+	for _, entry := range expired {
+		q.logger.Warn("dropping expired queued apply", "key", entry.Key, "receivedAt", entry.ReceivedAt)
+		q.complete(entry)
+	}
+	slices.SortFunc(entries, func(a, b *queuedApply) int { return cmp.Compare(a.seq, b.seq) })
+	return entries
+}
+
+func (q *applyQueue) writeLocked(entry *queuedApply) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so that a crash never leaves a partial entry.
+	path := q.path(entry.Key)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (q *applyQueue) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(q.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// isRetryableApplyStatus reports whether an apply request that ended with the status
+// may succeed when replayed: the backend was unreachable, unavailable or throttling.
+func isRetryableApplyStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,333 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+func newApplyRequest(method, path, body string) *messaging.HTTPTunnelRequest {
+	return &messaging.HTTPTunnelRequest{
+		RequestID: "req-" + method,
+		Target:    "k8s",
+		Method:    method,
+		Path:      path,
+		Headers:   map[string][]string{"Content-Type": {"application/json"}, "Authorization": {"Bearer caller"}},
+		Body:      []byte(body),
+	}
+}
+
+func queueFiles(t *testing.T, dir string) []os.DirEntry {
+	t.Helper()
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	return files
+}
+
+func TestApplyKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *messaging.HTTPTunnelRequest
+		wantKey string
+		wantRV  string
+		wantOK  bool
+	}{
+		{
+			name:   "server-side apply",
+			req:    newApplyRequest(http.MethodPatch, "/apis/apps/v1/namespaces/dp/deployments/web", `{"metadata":{"name":"web"}}`),
+			wantOK: false,
+		},
+		{
+			name:    "server-side apply with resource version",
+			req:     newApplyRequest(http.MethodPatch, "/apis/apps/v1/namespaces/dp/deployments/web", `{"metadata":{"name":"web","resourceVersion":"7"}}`),
+			wantKey: "/apis/apps/v1/namespaces/dp/deployments/web",
+			wantRV:  "7",
+			wantOK:  true,
+		},
+		{
+			name:    "update with resource version in YAML",
+			req:     newApplyRequest(http.MethodPut, "/api/v1/namespaces/dp/configmaps/cfg/", "metadata:\n  name: cfg\n  resourceVersion: \"42\"\n"),
+			wantKey: "/api/v1/namespaces/dp/configmaps/cfg",
+			wantRV:  "42",
+			wantOK:  true,
+		},
+		{
+			name:   "create",
+			req:    newApplyRequest(http.MethodPost, "/api/v1/namespaces/dp/services", `{"metadata":{"name":"web","resourceVersion":"3"}}`),
+			wantOK: false,
+		},
+		{
+			name:   "delete",
+			req:    newApplyRequest(http.MethodDelete, "/api/v1/namespaces/dp/services/web", ""),
+			wantOK: false,
+		},
+		{
+			name:    "delete with precondition",
+			req:     newApplyRequest(http.MethodDelete, "/api/v1/namespaces/dp/services/web", `{"preconditions":{"resourceVersion":"9"}}`),
+			wantKey: "/api/v1/namespaces/dp/services/web",
+			wantRV:  "9",
+			wantOK:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, rv, ok := applyKey(tt.req)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantKey, key)
+			assert.Equal(t, tt.wantRV, rv)
+		})
+	}
+}
+
+func TestIsApplyRequest(t *testing.T) {
+	assert.True(t, isApplyRequest(newApplyRequest(http.MethodPatch, "/x", "")))
+	assert.False(t, isApplyRequest(newApplyRequest(http.MethodGet, "/x", "")))
+
+	other := newApplyRequest(http.MethodPost, "/x", "")
+	other.Target = "monitoring"
+	assert.False(t, isApplyRequest(other))
+}
+
+func TestNewApplyQueue_Disabled(t *testing.T) {
+	q, err := newApplyQueue("", time.Minute, testLogger())
+	require.NoError(t, err)
+	assert.Nil(t, q)
+	assert.Nil(t, q.enqueue(newApplyRequest(http.MethodPatch, "/x", "")))
+	assert.Empty(t, q.pending())
+	q.complete(nil)
+}
+
+func TestApplyQueue_PersistsAcrossRestarts(t *testing.T) {
+	dir := t.TempDir()
+	q, err := newApplyQueue(dir, time.Hour, testLogger())
+	require.NoError(t, err)
+
+	first := q.enqueue(newApplyRequest(http.MethodPut, "/api/v1/namespaces/dp/configmaps/a", `{"metadata":{"name":"a","resourceVersion":"1"}}`))
+	require.NotNil(t, first)
+	require.NotNil(t, q.enqueue(newApplyRequest(http.MethodDelete, "/api/v1/namespaces/dp/configmaps/b", `{"preconditions":{"resourceVersion":"2"}}`)))
+	assert.Nil(t, q.enqueue(newApplyRequest(http.MethodGet, "/api/v1/namespaces/dp/configmaps/c", "")), "reads are not queued")
+	assert.Nil(t, q.enqueue(newApplyRequest(http.MethodPatch, "/api/v1/namespaces/dp/configmaps/d", `{"metadata":{"name":"d"}}`)),
+		"requests without a precondition are not queued")
+	assert.Len(t, queueFiles(t, dir), 2)
+
+	reopened, err := newApplyQueue(dir, time.Hour, testLogger())
+	require.NoError(t, err)
+	pending := reopened.pending()
+	require.Len(t, pending, 2)
+	assert.Equal(t, "/api/v1/namespaces/dp/configmaps/a", pending[0].Key)
+	assert.Equal(t, "/api/v1/namespaces/dp/configmaps/b", pending[1].Key)
+	assert.Equal(t, []byte(`{"metadata":{"name":"a","resourceVersion":"1"}}`), pending[0].Request.Body)
+	assert.NotContains(t, pending[0].Request.Headers, "Authorization", "caller credentials are not persisted")
+	assert.Contains(t, pending[0].Request.Headers, "Content-Type")
+
+	reopened.complete(pending[0])
+	reopened.complete(pending[1])
+	assert.Empty(t, queueFiles(t, dir))
+}
+
+func TestApplyQueue_DropsUnreadableEntries(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(dir+"/broken.json", []byte("{"), 0600))
+
+	q, err := newApplyQueue(dir, time.Hour, testLogger())
+	require.NoError(t, err)
+	assert.Empty(t, q.pending())
+	assert.Empty(t, queueFiles(t, dir))
+}
+
+func TestApplyQueue_DedupByResourceVersion(t *testing.T) {
+	dir := t.TempDir()
+	q, err := newApplyQueue(dir, time.Hour, testLogger())
+	require.NoError(t, err)
+
+	path := "/api/v1/namespaces/dp/configmaps/cfg"
+	v1 := q.enqueue(newApplyRequest(http.MethodPut, path, `{"metadata":{"name":"cfg","resourceVersion":"1"}}`))
+	require.NotNil(t, v1)
+
+	// A redelivery at the same resource version is the same entry
+	assert.Same(t, v1, q.enqueue(newApplyRequest(http.MethodPut, path, `{"metadata":{"name":"cfg","resourceVersion":"1"}}`)))
+
+	// A later request for the resource supersedes the queued one
+	v2 := q.enqueue(newApplyRequest(http.MethodPut, path, `{"metadata":{"name":"cfg","resourceVersion":"2"}}`))
+	require.NotSame(t, v1, v2)
+	assert.False(t, q.current(v1))
+
+	// Completing the superseded request keeps the newer one queued
+	q.complete(v1)
+	pending := q.pending()
+	require.Len(t, pending, 1)
+	assert.Equal(t, "2", pending[0].ResourceVersion)
+	assert.Len(t, queueFiles(t, dir), 1)
+}
+
+func TestApplyQueue_DropsExpiredEntries(t *testing.T) {
+	dir := t.TempDir()
+	q, err := newApplyQueue(dir, time.Minute, testLogger())
+	require.NoError(t, err)
+
+	now := time.Now()
+	q.now = func() time.Time { return now }
+	require.NotNil(t, q.enqueue(newApplyRequest(http.MethodDelete, "/api/v1/namespaces/dp/secrets/s", `{"preconditions":{"resourceVersion":"1"}}`)))
+
+	q.now = func() time.Time { return now.Add(2 * time.Minute) }
+	assert.Empty(t, q.pending())
+	assert.Empty(t, queueFiles(t, dir))
+}
+
+// --- Agent integration ---
+
+// flakyBackend returns a route that fails with 503 until healthy is set, and with 409
+// once conflict is set
+type flakyBackend struct {
+	mu       sync.Mutex
+	healthy  bool
+	conflict bool
+	bodies   []string
+}
+
+func (b *flakyBackend) route() *Route {
+	return newMockRoute("k8s", "https://kubernetes.svc", func(req *http.Request) (*http.Response, error) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		body, _ := io.ReadAll(req.Body)
+		b.bodies = append(b.bodies, string(body))
+		status := http.StatusServiceUnavailable
+		switch {
+		case b.conflict:
+			status = http.StatusConflict
+		case b.healthy:
+			status = http.StatusOK
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	})
+}
+
+func (b *flakyBackend) setHealthy() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.healthy = true
+}
+
+func (b *flakyBackend) setConflict() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.conflict = true
+}
+
+func (b *flakyBackend) calls() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.bodies...)
+}
+
+func newQueueingTestAgent(t *testing.T, backend *flakyBackend) (*Agent, string) {
+	t.Helper()
+	dir := t.TempDir()
+	agent := newTestAgent(t, "ws://unused", newTestRouter(t, map[string]*Route{"k8s": backend.route()}))
+	q, err := newApplyQueue(dir, time.Hour, testLogger())
+	require.NoError(t, err)
+	agent.applyQueue = q
+	return agent, dir
+}
+
+func TestAgent_ApplyRequestReplayedAfterReconnect(t *testing.T) {
+	backend := &flakyBackend{}
+	agent, dir := newQueueingTestAgent(t, backend)
+
+	// The backend fails and the connection is gone: the gateway never learns the outcome
+	body := `{"metadata":{"name":"web","resourceVersion":"5"}}`
+	agent.handleHTTPTunnelRequest(newApplyRequest(http.MethodPut, "/apis/apps/v1/namespaces/dp/deployments/web", body))
+	assert.Len(t, queueFiles(t, dir), 1)
+
+	// A replay against a still failing backend keeps the request
+	agent.replayApplyQueue(context.Background())
+	assert.Len(t, queueFiles(t, dir), 1)
+
+	backend.setHealthy()
+	agent.replayApplyQueue(context.Background())
+	assert.Empty(t, queueFiles(t, dir))
+	assert.Equal(t, []string{body, body, body}, backend.calls())
+
+	// Nothing is left to replay
+	agent.replayApplyQueue(context.Background())
+	assert.Len(t, backend.calls(), 3)
+}
+
+func TestAgent_ApplyRequestNotQueuedWhenGatewayInformed(t *testing.T) {
+	backend := &flakyBackend{}
+	agent, dir := newQueueingTestAgent(t, backend)
+	conn := &mockConnection{}
+	agent.conn = conn
+
+	// The gateway receives the 503 and the control plane retries on its own
+	agent.handleHTTPTunnelRequest(newApplyRequest(http.MethodDelete, "/api/v1/namespaces/dp/services/web", `{"preconditions":{"resourceVersion":"3"}}`))
+	assert.Len(t, conn.getWrittenMessages(), 1)
+	assert.Empty(t, queueFiles(t, dir))
+}
+
+func TestAgent_SucceededApplyNotReplayed(t *testing.T) {
+	backend := &flakyBackend{healthy: true}
+	agent, dir := newQueueingTestAgent(t, backend)
+
+	// The response is lost, but the apply took effect
+	agent.handleHTTPTunnelRequest(newApplyRequest(http.MethodPut, "/api/v1/namespaces/dp/configmaps/cfg", `{"metadata":{"name":"cfg","resourceVersion":"1"}}`))
+	assert.Empty(t, queueFiles(t, dir))
+}
+
+func TestAgent_ServerSideApplyNotQueued(t *testing.T) {
+	backend := &flakyBackend{}
+	agent, dir := newQueueingTestAgent(t, backend)
+
+	// A replayed server-side apply would overwrite newer writes made through any other path,
+	// so it is left to the control plane's retries
+	agent.handleHTTPTunnelRequest(newApplyRequest(http.MethodPatch, "/apis/apps/v1/namespaces/dp/deployments/web", `{"metadata":{"name":"web"}}`))
+	assert.Len(t, backend.calls(), 1)
+	assert.Empty(t, queueFiles(t, dir))
+}
+
+func TestAgent_ReplayDropsRequestAfterNewerWrite(t *testing.T) {
+	backend := &flakyBackend{}
+	agent, dir := newQueueingTestAgent(t, backend)
+
+	agent.handleHTTPTunnelRequest(newApplyRequest(http.MethodPut, "/api/v1/namespaces/dp/configmaps/cfg", `{"metadata":{"name":"cfg","resourceVersion":"1"}}`))
+	assert.Len(t, queueFiles(t, dir), 1)
+
+	// The object was written through another path, so the precondition no longer holds
+	backend.setConflict()
+	agent.replayApplyQueue(context.Background())
+	assert.Empty(t, queueFiles(t, dir))
+
+	agent.replayApplyQueue(context.Background())
+	assert.Len(t, backend.calls(), 2, "the dropped request is not replayed again")
+}
+
+func TestAgent_ReplaySkipsSupersededRequest(t *testing.T) {
+	backend := &flakyBackend{}
+	agent, dir := newQueueingTestAgent(t, backend)
+
+	path := "/api/v1/namespaces/dp/configmaps/cfg"
+	agent.handleHTTPTunnelRequest(newApplyRequest(http.MethodPut, path, `{"metadata":{"name":"cfg","resourceVersion":"1"}}`))
+	stale := agent.applyQueue.pending()
+	require.Len(t, stale, 1)
+
+	// A newer request for the resource succeeds before the replay runs
+	backend.setHealthy()
+	agent.handleHTTPTunnelRequest(newApplyRequest(http.MethodPut, path, `{"metadata":{"name":"cfg","resourceVersion":"2"}}`))
+	assert.Empty(t, queueFiles(t, dir))
+
+	assert.False(t, agent.replayApply(stale[0]), "the stale request is not replayed")
+	assert.Len(t, backend.calls(), 2)
+}
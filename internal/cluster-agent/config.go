@@ -17,4 +17,10 @@ type Config struct {
 	HeartbeatInterval time.Duration
 	RequestTimeout    time.Duration
 	Routes            []RouteConfig // Backend service routes for HTTP proxy
+	// ApplyQueueDir is the directory Kubernetes update and delete requests with a resourceVersion
+	// precondition are persisted in until their outcome is known, so they can be replayed after a
+	// disconnect. Empty disables the queue.
+	ApplyQueueDir string
+	// ApplyQueueMaxAge is how long a queued request stays eligible for replay
+	ApplyQueueMaxAge time.Duration
 }
@@ -0,0 +1,21 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+// RequestPriorityHeader carries the priority class of a request proxied through the cluster
+// gateway. It is consumed by the gateway and not forwarded to the agent.
+const RequestPriorityHeader = "X-OpenChoreo-Request-Priority"
+
+// RequestPriority is the priority class of a request proxied to an agent
+type RequestPriority string
+
+const (
+	// PriorityInteractive is used for user-facing calls (API server, kubectl-style reads)
+	// that a person is waiting on. Interactive requests are dispatched before queued
+	// reconcile requests and may use the streams reserved for them.
+	PriorityInteractive RequestPriority = "interactive"
+	// PriorityReconcile is used for controller reconciles. It is the default for requests
+	// without a priority header, so that only callers that opt in are prioritised.
+	PriorityReconcile RequestPriority = "reconcile"
+)
@@ -29,6 +29,12 @@ type Config struct {
 	ShutdownTimeout      time.Duration
 	HeartbeatInterval    time.Duration
 	HeartbeatTimeout     time.Duration
+	// MaxStreamsPerAgent is the number of proxied requests multiplexed concurrently over
+	// one agent connection. Further requests wait for a free stream. Zero means unlimited.
+	MaxStreamsPerAgent int
+	// ReservedInteractiveStreams is the number of each connection's streams that only
+	// interactive requests may use, so that reconcile traffic cannot starve them.
+	ReservedInteractiveStreams int
 }
 
 // RemoteServerClientConfig holds configuration for RemoteServerClient
@@ -4,7 +4,10 @@
 package clustergateway
 
 import (
+	"context"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -31,9 +34,17 @@ type AgentConnection struct {
 	LastSeen        time.Time
 	ValidCRs        []string          // List of CRs (namespace/name) this connection is authorized for
 	clientCert      *x509.Certificate // Client certificate for re-validation on CR updates
+	streams         *streamPool       // Bounds the requests multiplexed over the connection; nil if unlimited
 	mu              sync.Mutex
 }
 
+// AcquireStream takes one of the connection's parallel streams for a request of the
+// given priority, waiting until one is free or ctx is done. The returned function
+// releases the stream once the response has been received.
+func (ac *AgentConnection) AcquireStream(ctx context.Context, priority messaging.RequestPriority) (func(), error) {
+	return ac.streams.acquire(ctx, priority)
+}
+
 // IsValidForCR checks if this connection is authorized for the specified CR
 func (ac *AgentConnection) IsValidForCR(crKey string) bool {
 	ac.mu.Lock()
@@ -136,6 +147,11 @@ type ConnectionManager struct {
 	// Key format: "planeType/planeID", Value: slice of agent connections
 	connections map[string][]*AgentConnection
 
+	// Parallel streams per agent connection and how many of them are reserved for
+	// interactive requests (see SetStreamLimits)
+	maxStreamsPerAgent         int
+	reservedInteractiveStreams int
+
 	mu         sync.RWMutex
 	roundRobin map[string]int // Track round-robin index per planeIdentifier
 	logger     *slog.Logger
@@ -150,6 +166,16 @@ func NewConnectionManager(logger *slog.Logger) *ConnectionManager {
 	}
 }
 
+// SetStreamLimits sets the number of requests that may be multiplexed concurrently over
+// each agent connection registered afterwards, and how many of those streams only
+// interactive requests may use. A non-positive maxStreams leaves connections unlimited.
+func (cm *ConnectionManager) SetStreamLimits(maxStreams, reservedInteractive int) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.maxStreamsPerAgent = maxStreams
+	cm.reservedInteractiveStreams = reservedInteractive
+}
+
 // Register registers a new agent connection with per-CR authorization
 // planeIdentifier format: {planeType}/{planeID}
 // Multiple agent replicas (for HA) for the same plane share the same planeIdentifier
@@ -179,6 +205,7 @@ func (cm *ConnectionManager) Register(
 		LastSeen:        now,
 		ValidCRs:        validCRs,
 		clientCert:      clientCert,
+		streams:         newStreamPool(planeIdentifier, cm.maxStreamsPerAgent, cm.reservedInteractiveStreams),
 	}
 
 	// Store by planeIdentifier (supports HA - multiple replicas)
@@ -303,13 +330,20 @@ func (cm *ConnectionManager) GetForCR(planeIdentifier, crKey string) (*AgentConn
 		return nil, fmt.Errorf("no agents authorized for CR %s", crKey)
 	}
 
-	// Round-robin among valid connections only
+	// Round-robin among valid connections only, preferring the least loaded one
 	// Use CR-specific round-robin key to ensure fair distribution per CR
 	rrKey := fmt.Sprintf("%s/%s", planeIdentifier, crKey)
 	idx := cm.roundRobin[rrKey] % len(validConns)
 	cm.roundRobin[rrKey] = (idx + 1) % len(validConns)
 
 	selectedConn := validConns[idx]
+	minLoad := selectedConn.streams.load()
+	for i := 1; i < len(validConns) && minLoad > 0; i++ {
+		conn := validConns[(idx+i)%len(validConns)]
+		if load := conn.streams.load(); load < minLoad {
+			selectedConn, minLoad = conn, load
+		}
+	}
 
 	cm.logger.Debug("selected agent for CR",
 		"planeIdentifier", planeIdentifier,
@@ -409,6 +443,35 @@ func (cm *ConnectionManager) DisconnectAllForPlane(planeType, planeID string) in
 	return disconnectedCount
 }
 
+// CertificateFingerprintsForCR returns the fingerprints of the client certificates of all
+// agents authorized for a CR, e.g. to revoke them when the CR is deleted. HA replicas sharing
+// a certificate yield one fingerprint.
+func (cm *ConnectionManager) CertificateFingerprintsForCR(planeType, planeID, crNamespace, crName string) []string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	planeIdentifier := fmt.Sprintf("%s/%s", planeType, planeID)
+	crKey := fmt.Sprintf("%s/%s", crNamespace, crName)
+
+	var fingerprints []string
+	for _, conn := range cm.connections[planeIdentifier] {
+		if conn.clientCert == nil || !conn.IsValidForCR(crKey) {
+			continue
+		}
+		fingerprint := certificateFingerprint(conn.clientCert)
+		if !slices.Contains(fingerprints, fingerprint) {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+	}
+	return fingerprints
+}
+
+// certificateFingerprint returns the hex encoded SHA-256 fingerprint of a certificate
+func certificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
 // SendHTTPTunnelRequest sends an HTTPTunnelRequest through this connection
 func (ac *AgentConnection) SendHTTPTunnelRequest(req *messaging.HTTPTunnelRequest) error {
 	ac.mu.Lock()
@@ -4,6 +4,7 @@
 package clustergateway
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -336,6 +337,37 @@ func TestConnectionManager_GetForCR(t *testing.T) {
 	assert.Equal(t, id2, got.ID)
 }
 
+func TestConnectionManager_GetForCR_PrefersLeastLoaded(t *testing.T) {
+	cm := NewConnectionManager(testLogger())
+	cm.SetStreamLimits(4, 0)
+
+	conn1, cleanup1 := newTestWSConn(t)
+	defer cleanup1()
+	conn2, cleanup2 := newTestWSConn(t)
+	defer cleanup2()
+
+	id1, _ := cm.Register("dataplane", "prod", conn1, []string{"ns/dp1"}, nil)
+	id2, _ := cm.Register("dataplane", "prod", conn2, []string{"ns/dp1"}, nil)
+
+	first, err := cm.GetForCR("dataplane/prod", "ns/dp1")
+	require.NoError(t, err)
+	require.Equal(t, id1, first.ID)
+	release, err := first.AcquireStream(context.Background(), messaging.PriorityReconcile)
+	require.NoError(t, err)
+
+	// Round-robin moves on to conn2, and keeps picking it while conn1 is busy
+	for range 2 {
+		got, err := cm.GetForCR("dataplane/prod", "ns/dp1")
+		require.NoError(t, err)
+		assert.Equal(t, id2, got.ID)
+	}
+
+	release()
+	got, err := cm.GetForCR("dataplane/prod", "ns/dp1")
+	require.NoError(t, err)
+	assert.Equal(t, id2, got.ID, "idle connections are picked in round-robin order")
+}
+
 func TestConnectionManager_GetForCR_NoneAuthorized(t *testing.T) {
 	cm := NewConnectionManager(testLogger())
 
@@ -425,6 +457,31 @@ func TestConnectionManager_DisconnectAllForPlane(t *testing.T) {
 	assert.Equal(t, 0, disconnected)
 }
 
+func TestConnectionManager_CertificateFingerprintsForCR(t *testing.T) {
+	caCert, caKey := generateTestCA(t)
+	sharedCert := generateTestClientCert(t, caCert, caKey)
+	otherCert := generateTestClientCert(t, caCert, caKey)
+
+	cm := NewConnectionManager(testLogger())
+	conn1, cleanup1 := newTestWSConn(t)
+	defer cleanup1()
+	conn2, cleanup2 := newTestWSConn(t)
+	defer cleanup2()
+	conn3, cleanup3 := newTestWSConn(t)
+	defer cleanup3()
+
+	// Two HA replicas share a certificate; a third agent is only authorized for another CR
+	_, _ = cm.Register("dataplane", "prod", conn1, []string{"ns/dp1", "ns/dp2"}, sharedCert)
+	_, _ = cm.Register("dataplane", "prod", conn2, []string{"ns/dp1", "ns/dp2"}, sharedCert)
+	_, _ = cm.Register("dataplane", "prod", conn3, []string{"ns/dp2"}, otherCert)
+
+	assert.Equal(t, []string{certificateFingerprint(sharedCert)},
+		cm.CertificateFingerprintsForCR("dataplane", "prod", "ns", "dp1"))
+	assert.ElementsMatch(t, []string{certificateFingerprint(sharedCert), certificateFingerprint(otherCert)},
+		cm.CertificateFingerprintsForCR("dataplane", "prod", "ns", "dp2"))
+	assert.Empty(t, cm.CertificateFingerprintsForCR("dataplane", "staging", "ns", "dp1"))
+}
+
 func TestConnectionManager_GetPlaneStatus(t *testing.T) {
 	cm := NewConnectionManager(testLogger())
 
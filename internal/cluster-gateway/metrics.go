@@ -0,0 +1,69 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Backpressure metrics of the streams multiplexed over agent connections. They are
+// exposed on the health port at /metrics and labelled by plane ({planeType}/{planeID})
+// and request priority class.
+var (
+	metricsRegistry = prometheus.NewRegistry()
+
+	inFlightStreams = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_cluster_gateway_streams_in_flight",
+			Help: "Number of proxied requests being served over agent connections.",
+		},
+		[]string{"plane", "priority"},
+	)
+
+	queuedStreamRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_cluster_gateway_stream_queue_length",
+			Help: "Number of proxied requests waiting for a free stream on an agent connection.",
+		},
+		[]string{"plane", "priority"},
+	)
+
+	streamWaitSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "openchoreo_cluster_gateway_stream_wait_seconds",
+			Help: "Time a proxied request waited for a free stream on an agent connection.",
+			// 1ms to ~65s.
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 9),
+		},
+		[]string{"plane", "priority"},
+	)
+
+	rejectedStreamRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openchoreo_cluster_gateway_stream_rejections_total",
+			Help: "Number of proxied requests that timed out waiting for a free stream on an agent connection.",
+		},
+		[]string{"plane", "priority"},
+	)
+)
+
+func init() {
+	metricsRegistry.MustRegister(
+		inFlightStreams,
+		queuedStreamRequests,
+		streamWaitSeconds,
+		rejectedStreamRequests,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// metricsHandler returns the handler that exposes the gateway metrics in the Prometheus format
+func metricsHandler() http.Handler {
+	return promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{Registry: metricsRegistry})
+}
@@ -8,12 +8,27 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"sync"
+	"time"
 )
 
+// idempotencyKeyHeader carries the key of a lifecycle notification. Retries of a
+// notification reuse its key, so the response of a processed notification is replayed.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// notificationReplayTTL is how long the response of a processed notification is kept
+// for replay. It comfortably covers the retry window of the controller's gateway client.
+const notificationReplayTTL = 10 * time.Minute
+
+// maxNotificationReplays bounds the responses kept for replay, so that notifications with
+// distinct keys cannot grow the memory of the gateway without bound within the TTL.
+const maxNotificationReplays = 10000
+
 type PlaneAPI struct {
 	connMgr *ConnectionManager
 	server  *Server // For accessing k8sClient to fetch CR CAs
 	logger  *slog.Logger
+	replays *notificationReplays
 }
 
 type PlaneNotification struct {
@@ -31,6 +46,7 @@ type PlaneNotificationResponse struct {
 	DisconnectedAgents    *int   `json:"disconnectedAgents,omitempty"`
 	AuthorizationsGranted *int   `json:"authorizationsGranted,omitempty"`
 	AuthorizationsRevoked *int   `json:"authorizationsRevoked,omitempty"`
+	RevokedCertificates   *int   `json:"revokedCertificates,omitempty"`
 	Error                 string `json:"error,omitempty"`
 }
 
@@ -51,6 +67,7 @@ func NewPlaneAPI(connMgr *ConnectionManager, server *Server, logger *slog.Logger
 		connMgr: connMgr,
 		server:  server,
 		logger:  logger.With("component", "plane-api"),
+		replays: newNotificationReplays(notificationReplayTTL, maxNotificationReplays),
 	}
 }
 
@@ -62,6 +79,15 @@ func (api *PlaneAPI) RegisterRoutes(mux *http.ServeMux) {
 }
 
 func (api *PlaneAPI) handlePlaneNotification(w http.ResponseWriter, r *http.Request) {
+	idempotencyKey := r.Header.Get(idempotencyKeyHeader)
+	if idempotencyKey != "" {
+		if result, ok := api.replays.get(idempotencyKey); ok {
+			api.logger.Info("replaying response to retried plane notification", "idempotencyKey", idempotencyKey)
+			api.writeNotificationResponse(w, result)
+			return
+		}
+	}
+
 	var notification PlaneNotification
 	if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
 		api.logger.Error("invalid notification payload", "error", err)
@@ -131,14 +157,32 @@ func (api *PlaneAPI) handlePlaneNotification(w http.ResponseWriter, r *http.Requ
 		}
 
 	case "deleted":
+		// Revoke the certificates of the CR's agents before disconnecting them, so that
+		// they cannot reconnect through the CR while its deletion completes
+		fingerprints := api.connMgr.CertificateFingerprintsForCR(
+			notification.PlaneType, notification.PlaneID, notification.Namespace, notification.Name)
+		crKey := fmt.Sprintf("%s/%s", notification.Namespace, notification.Name)
+		revokedCount, err := api.server.revokeCertificates(notification.PlaneType, crKey, fingerprints)
+		if err != nil {
+			api.logger.Error("failed to revoke agent certificates", "error", err,
+				"planeType", notification.PlaneType,
+				"planeID", notification.PlaneID,
+				"cr", crKey,
+			)
+			// Return 503 so the controller retries; the agents stay connected until then.
+			http.Error(w, fmt.Sprintf("failed to revoke agent certificates: %v", err), http.StatusServiceUnavailable)
+			return
+		}
 		disconnectedCount := api.connMgr.DisconnectAllForPlane(notification.PlaneType, notification.PlaneID)
 		api.logger.Info("disconnected agents for CR deletion",
 			"planeType", notification.PlaneType,
 			"planeID", notification.PlaneID,
 			"disconnectedAgents", disconnectedCount,
+			"revokedCertificates", revokedCount,
 		)
 		result.Action = "disconnect"
 		result.DisconnectedAgents = &disconnectedCount
+		result.RevokedCertificates = &revokedCount
 
 	default:
 		api.logger.Warn("unknown event type", "event", notification.Event)
@@ -146,6 +190,13 @@ func (api *PlaneAPI) handlePlaneNotification(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if idempotencyKey != "" {
+		api.replays.put(idempotencyKey, result)
+	}
+	api.writeNotificationResponse(w, result)
+}
+
+func (api *PlaneAPI) writeNotificationResponse(w http.ResponseWriter, result PlaneNotificationResponse) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(result); err != nil {
@@ -268,3 +319,72 @@ func (api *PlaneAPI) fetchCRClientCA(notification PlaneNotification) ([]byte, er
 
 	return caData, nil
 }
+
+// notificationReplays keeps the responses of processed notifications by idempotency key
+// until they expire, up to maxEntries responses; beyond that the oldest ones are evicted
+// first. Only successful notifications are kept, so failed ones are processed again when
+// retried.
+type notificationReplays struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+	entries    map[string]notificationReplay
+	// order holds the keys in the order they were put, which is also the order they expire in
+	order []notificationReplayKey
+}
+
+type notificationReplay struct {
+	result    PlaneNotificationResponse
+	expiresAt time.Time
+}
+
+type notificationReplayKey struct {
+	key       string
+	expiresAt time.Time
+}
+
+func newNotificationReplays(ttl time.Duration, maxEntries int) *notificationReplays {
+	return &notificationReplays{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]notificationReplay),
+	}
+}
+
+func (c *notificationReplays) get(key string) (PlaneNotificationResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return PlaneNotificationResponse{}, false
+	}
+	return entry.result, true
+}
+
+// put records the response of a notification and evicts the expired ones, and the oldest
+// ones while more than maxEntries are kept.
+func (c *notificationReplays) put(key string, result PlaneNotificationResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	expiresAt := now.Add(c.ttl)
+	c.entries[key] = notificationReplay{result: result, expiresAt: expiresAt}
+	c.order = append(c.order, notificationReplayKey{key: key, expiresAt: expiresAt})
+
+	for len(c.order) > 0 {
+		oldest := c.order[0]
+		entry, ok := c.entries[oldest.key]
+		switch {
+		case !ok || !entry.expiresAt.Equal(oldest.expiresAt):
+			// Already evicted, or put again later
+		case !now.Before(oldest.expiresAt) || len(c.entries) > c.maxEntries:
+			delete(c.entries, oldest.key)
+		default:
+			return
+		}
+		c.order[0] = notificationReplayKey{}
+		c.order = c.order[1:]
+	}
+}
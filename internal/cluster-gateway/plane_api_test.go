@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,6 +28,7 @@ func newTestPlaneAPI(t *testing.T, objects ...client.Object) (*http.ServeMux, *C
 	fakeClient := fake.NewClientBuilder().
 		WithScheme(scheme).
 		WithObjects(objects...).
+		WithStatusSubresource(&openchoreov1alpha1.DataPlane{}, &openchoreov1alpha1.ClusterDataPlane{}).
 		Build()
 
 	cm := NewConnectionManager(testLogger())
@@ -41,12 +43,18 @@ func newTestPlaneAPI(t *testing.T, objects ...client.Object) (*http.ServeMux, *C
 }
 
 func TestHandlePlaneNotification_Deleted(t *testing.T) {
-	mux, cm := newTestPlaneAPI(t)
+	dp := &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "dp1", Namespace: "ns"},
+		Spec:       openchoreov1alpha1.DataPlaneSpec{PlaneID: "prod"},
+	}
+	mux, cm := newTestPlaneAPI(t, dp)
 
 	// Register a connection so there's something to disconnect
+	caCert, caKey := generateTestCA(t)
+	clientCert := generateTestClientCert(t, caCert, caKey)
 	conn, cleanup := newTestWSConn(t)
 	defer cleanup()
-	_, err := cm.Register("dataplane", "prod", conn, []string{"ns/dp1"}, nil)
+	_, err := cm.Register("dataplane", "prod", conn, []string{"ns/dp1"}, clientCert)
 	require.NoError(t, err)
 
 	notification := PlaneNotification{
@@ -71,6 +79,39 @@ func TestHandlePlaneNotification_Deleted(t *testing.T) {
 	assert.Equal(t, "disconnect", resp.Action)
 	require.NotNil(t, resp.DisconnectedAgents)
 	assert.Equal(t, 1, *resp.DisconnectedAgents)
+	require.NotNil(t, resp.RevokedCertificates)
+	assert.Equal(t, 1, *resp.RevokedCertificates)
+}
+
+func TestHandlePlaneNotification_DeletedWithoutCR(t *testing.T) {
+	mux, cm := newTestPlaneAPI(t)
+
+	caCert, caKey := generateTestCA(t)
+	clientCert := generateTestClientCert(t, caCert, caKey)
+	conn, cleanup := newTestWSConn(t)
+	defer cleanup()
+	_, err := cm.Register("dataplane", "prod", conn, []string{"ns/dp1"}, clientCert)
+	require.NoError(t, err)
+
+	body, _ := json.Marshal(PlaneNotification{
+		PlaneType: "dataplane",
+		PlaneID:   "prod",
+		Event:     "deleted",
+		Namespace: "ns",
+		Name:      "dp1",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/planes/notify", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	// A CR that is already gone has nothing to revoke, but its agents are still disconnected
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp PlaneNotificationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.NotNil(t, resp.DisconnectedAgents)
+	assert.Equal(t, 1, *resp.DisconnectedAgents)
+	require.NotNil(t, resp.RevokedCertificates)
+	assert.Equal(t, 0, *resp.RevokedCertificates)
 }
 
 func TestHandlePlaneNotification_Created(t *testing.T) {
@@ -461,3 +502,87 @@ func TestHandlePlaneNotification_RevalidationError(t *testing.T) {
 	// RevalidateCR will fail because "valid-data" is not valid PEM
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
+
+func TestHandlePlaneNotification_ReplaysRetriedNotification(t *testing.T) {
+	mux, cm := newTestPlaneAPI(t)
+
+	caCert, caKey := generateTestCA(t)
+	clientCert := generateTestClientCert(t, caCert, caKey)
+	conn, cleanup := newTestWSConn(t)
+	defer cleanup()
+	_, err := cm.Register("dataplane", "prod", conn, []string{"ns/dp1"}, clientCert)
+	require.NoError(t, err)
+
+	body, _ := json.Marshal(PlaneNotification{
+		PlaneType: "dataplane",
+		PlaneID:   "prod",
+		Event:     "deleted",
+		Namespace: "ns",
+		Name:      "dp1",
+	})
+	notify := func() PlaneNotificationResponse {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/planes/notify", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(idempotencyKeyHeader, "key-1")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp PlaneNotificationResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	first := notify()
+	require.NotNil(t, first.DisconnectedAgents)
+	assert.Equal(t, 1, *first.DisconnectedAgents)
+
+	// The retry gets the response of the first delivery rather than a fresh result
+	retried := notify()
+	assert.Equal(t, first, retried)
+}
+
+func TestNotificationReplays_Expire(t *testing.T) {
+	now := time.Now()
+	replays := newNotificationReplays(time.Minute, 10)
+	replays.now = func() time.Time { return now }
+
+	replays.put("key-1", PlaneNotificationResponse{Success: true, Action: "revalidate"})
+	result, ok := replays.get("key-1")
+	require.True(t, ok)
+	assert.Equal(t, "revalidate", result.Action)
+
+	_, ok = replays.get("key-2")
+	assert.False(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok = replays.get("key-1")
+	assert.False(t, ok)
+
+	replays.put("key-2", PlaneNotificationResponse{Success: true})
+	assert.NotContains(t, replays.entries, "key-1")
+	assert.Len(t, replays.order, 1)
+}
+
+func TestNotificationReplays_Bounded(t *testing.T) {
+	now := time.Now()
+	replays := newNotificationReplays(time.Minute, 2)
+	replays.now = func() time.Time { return now }
+
+	replays.put("key-1", PlaneNotificationResponse{Success: true})
+	now = now.Add(time.Second)
+	replays.put("key-2", PlaneNotificationResponse{Success: true})
+	now = now.Add(time.Second)
+	// key-1 is put again, so key-2 is now the oldest
+	replays.put("key-1", PlaneNotificationResponse{Success: true, Action: "revalidate"})
+	now = now.Add(time.Second)
+	replays.put("key-3", PlaneNotificationResponse{Success: true})
+
+	assert.Len(t, replays.entries, 2)
+	_, ok := replays.get("key-2")
+	assert.False(t, ok, "the oldest response is evicted")
+	result, ok := replays.get("key-1")
+	require.True(t, ok)
+	assert.Equal(t, "revalidate", result.Action)
+	_, ok = replays.get("key-3")
+	assert.True(t, ok)
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Certificate revocations are recorded in the status of the plane CR being deleted, so that
+// they survive gateway restarts and are shared by all gateway replicas. A CR created again
+// under the same name starts without revocations.
+
+// newPlaneObject returns an empty plane CR of the plane type with the given key
+// ("namespace/name", or "/name" for cluster-scoped CRs)
+func newPlaneObject(planeType, crKey string) (client.Object, error) {
+	namespace, name, _ := strings.Cut(crKey, "/")
+	var obj client.Object
+	switch planeType {
+	case planeTypeDataPlane:
+		if namespace == "" {
+			obj = &openchoreov1alpha1.ClusterDataPlane{}
+		} else {
+			obj = &openchoreov1alpha1.DataPlane{}
+		}
+	case planeTypeWorkflowPlane:
+		if namespace == "" {
+			obj = &openchoreov1alpha1.ClusterWorkflowPlane{}
+		} else {
+			obj = &openchoreov1alpha1.WorkflowPlane{}
+		}
+	case planeTypeObservabilityPlane:
+		if namespace == "" {
+			obj = &openchoreov1alpha1.ClusterObservabilityPlane{}
+		} else {
+			obj = &openchoreov1alpha1.ObservabilityPlane{}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported plane type: %s", planeType)
+	}
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj, nil
+}
+
+// agentConnectionStatus returns the agent connection status field of a plane CR
+func agentConnectionStatus(obj client.Object) **openchoreov1alpha1.AgentConnectionStatus {
+	switch v := obj.(type) {
+	case *openchoreov1alpha1.DataPlane:
+		return &v.Status.AgentConnection
+	case *openchoreov1alpha1.ClusterDataPlane:
+		return &v.Status.AgentConnection
+	case *openchoreov1alpha1.WorkflowPlane:
+		return &v.Status.AgentConnection
+	case *openchoreov1alpha1.ClusterWorkflowPlane:
+		return &v.Status.AgentConnection
+	case *openchoreov1alpha1.ObservabilityPlane:
+		return &v.Status.AgentConnection
+	case *openchoreov1alpha1.ClusterObservabilityPlane:
+		return &v.Status.AgentConnection
+	default:
+		return nil
+	}
+}
+
+// revokeCertificates records the certificate fingerprints as revoked in the status of the
+// plane CR. Returns the number of fingerprints that were not revoked before; a CR that no
+// longer exists has nothing left to protect and revokes nothing.
+func (s *Server) revokeCertificates(planeType, crKey string, fingerprints []string) (int, error) {
+	if len(fingerprints) == 0 {
+		return 0, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	revoked := 0
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := newPlaneObject(planeType, crKey)
+		if err != nil {
+			return err
+		}
+		if err := s.k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			return err
+		}
+
+		patch := client.MergeFromWithOptions(obj.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+		status := agentConnectionStatus(obj)
+		if *status == nil {
+			*status = &openchoreov1alpha1.AgentConnectionStatus{}
+		}
+		revoked = 0
+		for _, fingerprint := range fingerprints {
+			if !slices.Contains((*status).RevokedCertificates, fingerprint) {
+				(*status).RevokedCertificates = append((*status).RevokedCertificates, fingerprint)
+				revoked++
+			}
+		}
+		if revoked == 0 {
+			return nil
+		}
+		return s.k8sClient.Status().Patch(ctx, obj, patch)
+	})
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to record revoked certificates of %s %s: %w", planeType, crKey, err)
+	}
+	return revoked, nil
+}
+
+// filterRevokedCRs returns the CRs from validCRs that have not revoked the client certificate
+func (s *Server) filterRevokedCRs(planeType string, clientCert *x509.Certificate, validCRs []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	fingerprint := certificateFingerprint(clientCert)
+	result := make([]string, 0, len(validCRs))
+	for _, crKey := range validCRs {
+		obj, err := newPlaneObject(planeType, crKey)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get %s %s: %w", planeType, crKey, err)
+		}
+		if status := *agentConnectionStatus(obj); status != nil && slices.Contains(status.RevokedCertificates, fingerprint) {
+			continue
+		}
+		result = append(result, crKey)
+	}
+	return result, nil
+}
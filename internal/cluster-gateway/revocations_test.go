@@ -0,0 +1,73 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestRevokeCertificates(t *testing.T) {
+	ctx := context.Background()
+	caCert, caKey := generateTestCA(t)
+	revokedCert := generateTestClientCert(t, caCert, caKey)
+	otherCert := generateTestClientCert(t, caCert, caKey)
+
+	dp := &openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "dp1", Namespace: "ns"}}
+	cdp := &openchoreov1alpha1.ClusterDataPlane{ObjectMeta: metav1.ObjectMeta{Name: "shared"}}
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme()).
+		WithObjects(dp, cdp).
+		WithStatusSubresource(&openchoreov1alpha1.DataPlane{}, &openchoreov1alpha1.ClusterDataPlane{}).
+		Build()
+	server := &Server{k8sClient: k8sClient, logger: testLogger()}
+
+	fingerprints := []string{certificateFingerprint(revokedCert)}
+	revoked, err := server.revokeCertificates(planeTypeDataPlane, "ns/dp1", fingerprints)
+	require.NoError(t, err)
+	assert.Equal(t, 1, revoked)
+	revoked, err = server.revokeCertificates(planeTypeDataPlane, "ns/dp1", fingerprints)
+	require.NoError(t, err)
+	assert.Equal(t, 0, revoked, "revoking again is a no-op")
+	revoked, err = server.revokeCertificates(planeTypeDataPlane, "/shared", fingerprints)
+	require.NoError(t, err)
+	assert.Equal(t, 1, revoked)
+	revoked, err = server.revokeCertificates(planeTypeDataPlane, "ns/missing", fingerprints)
+	require.NoError(t, err)
+	assert.Equal(t, 0, revoked, "a CR that is gone revokes nothing")
+
+	// The revocation is recorded on the CR, so another replica or a restarted gateway sees it
+	other := &Server{k8sClient: k8sClient, logger: testLogger()}
+	validCRs, err := other.filterRevokedCRs(planeTypeDataPlane, revokedCert, []string{"ns/dp1", "/shared", "ns/missing"})
+	require.NoError(t, err)
+	assert.Empty(t, validCRs)
+	validCRs, err = other.filterRevokedCRs(planeTypeDataPlane, otherCert, []string{"ns/dp1", "/shared"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns/dp1", "/shared"}, validCRs)
+
+	// Updates of the CR keep the revocation
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(dp), dp))
+	dp.Spec.PlaneID = "prod"
+	require.NoError(t, k8sClient.Update(ctx, dp))
+	validCRs, err = other.filterRevokedCRs(planeTypeDataPlane, revokedCert, []string{"ns/dp1"})
+	require.NoError(t, err)
+	assert.Empty(t, validCRs)
+
+	// A CR created again under the same name starts without revocations
+	require.NoError(t, k8sClient.Delete(ctx, dp))
+	require.NoError(t, k8sClient.Create(ctx, &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "dp1", Namespace: "ns"},
+	}))
+	validCRs, err = other.filterRevokedCRs(planeTypeDataPlane, revokedCert, []string{"ns/dp1", "/shared"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns/dp1"}, validCRs)
+}
@@ -63,6 +63,9 @@ type Server struct {
 }
 
 func New(config *Config, k8sClient client.Client, logger *slog.Logger) *Server {
+	connMgr := NewConnectionManager(logger)
+	connMgr.SetStreamLimits(config.MaxStreamsPerAgent, config.ReservedInteractiveStreams)
+
 	return &Server{
 		config: config,
 		upgrader: websocket.Upgrader{
@@ -70,7 +73,7 @@ func New(config *Config, k8sClient client.Client, logger *slog.Logger) *Server {
 				return true
 			},
 		},
-		connMgr:               NewConnectionManager(logger),
+		connMgr:               connMgr,
 		pendingHTTPRequests:   make(map[string]chan *messaging.HTTPTunnelResponse),
 		pendingStreamSessions: make(map[string]*streamSession),
 		validator:             NewRequestValidator(),
@@ -175,6 +178,7 @@ func (s *Server) Start() error {
 	healthMux := http.NewServeMux()
 	healthMux.HandleFunc("/health", s.handleHealth)
 	healthMux.HandleFunc("/ready", s.handleHealth)
+	healthMux.Handle("/metrics", metricsHandler())
 
 	s.healthServer = &http.Server{
 		Addr:         ":8080",
@@ -349,6 +353,27 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Drop CRs that revoked this certificate when they were deleted
+	validCRs, err = s.filterRevokedCRs(planeType, clientCert, validCRs)
+	if err != nil {
+		s.logger.Error("failed to check revoked certificates",
+			"planeType", planeType,
+			"planeID", planeID,
+			"error", err,
+		)
+		http.Error(w, fmt.Sprintf("failed to check revoked certificates: %v", err), http.StatusServiceUnavailable)
+		return
+	}
+	if len(validCRs) == 0 {
+		s.logger.Warn("connection rejected: client certificate has been revoked",
+			"planeType", planeType,
+			"planeID", planeID,
+			"clientCN", clientCert.Subject.CommonName,
+		)
+		http.Error(w, "client certificate has been revoked", http.StatusUnauthorized)
+		return
+	}
+
 	planeIdentifier := fmt.Sprintf("%s/%s", planeType, planeID)
 
 	conn, err := s.upgrader.Upgrade(w, r, nil)
@@ -531,6 +556,10 @@ func (s *Server) handleHTTPProxy(w http.ResponseWriter, r *http.Request) {
 	}
 	crKey := fmt.Sprintf("%s/%s", crNamespace, crName)
 
+	// The priority header is meant for the gateway only
+	priority := requestPriorityFromHeader(r.Header)
+	r.Header.Del(messaging.RequestPriorityHeader)
+
 	isStreaming := s.isStreamingRequest(r, targetPath)
 
 	if isStreaming {
@@ -553,6 +582,7 @@ func (s *Server) handleHTTPProxy(w http.ResponseWriter, r *http.Request) {
 		"target", target,
 		"path", targetPath,
 		"method", r.Method,
+		"priority", priority,
 	)
 
 	tunnelReq := messaging.NewHTTPTunnelRequest(
@@ -566,8 +596,19 @@ func (s *Server) handleHTTPProxy(w http.ResponseWriter, r *http.Request) {
 	tunnelReq.GatewayRequestID = requestID
 
 	// Route request to agent authorized for this specific CR
-	response, err := s.SendHTTPTunnelRequestForCR(planeIdentifier, crKey, tunnelReq, 30*time.Second)
+	response, err := s.SendHTTPTunnelRequestForCR(r.Context(), planeIdentifier, crKey, priority, tunnelReq, 30*time.Second)
 	if err != nil {
+		if errors.Is(err, errStreamsExhausted) {
+			logger.Warn("agent connection busy",
+				"plane", planeIdentifier,
+				"cr", crKey,
+				"priority", priority,
+			)
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, fmt.Sprintf("agent for plane %s is busy, retry later", planeIdentifier), http.StatusServiceUnavailable)
+			return
+		}
+
 		// Check if authorization error (no agents authorized for CR)
 		if strings.Contains(err.Error(), "no agents authorized for CR") {
 			logger.Warn("CR authorization failed",
@@ -690,11 +731,18 @@ func (s *Server) SendHTTPTunnelRequest(planeName string, req *messaging.HTTPTunn
 
 // SendHTTPTunnelRequestForCR sends an HTTP tunnel request to an agent authorized for a specific CR
 // and waits for the response. This enforces per-CR security boundaries.
+// The request waits for a free stream on the agent connection according to its priority; the
+// timeout covers both the wait and the response, and the request is abandoned when ctx is done.
 func (s *Server) SendHTTPTunnelRequestForCR(
+	ctx context.Context,
 	planeName, crKey string,
+	priority messaging.RequestPriority,
 	req *messaging.HTTPTunnelRequest,
 	timeout time.Duration,
 ) (*messaging.HTTPTunnelResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	req.RequestID = messaging.GenerateMessageID()
 
 	replyChan := make(chan *messaging.HTTPTunnelResponse, 1)
@@ -709,6 +757,7 @@ func (s *Server) SendHTTPTunnelRequestForCR(
 		"path", req.Path,
 		"plane", planeName,
 		"cr", crKey,
+		"priority", priority,
 	)
 
 	conn, err := s.connMgr.GetForCR(planeName, crKey)
@@ -719,6 +768,15 @@ func (s *Server) SendHTTPTunnelRequestForCR(
 		return nil, err
 	}
 
+	release, err := conn.AcquireStream(ctx, priority)
+	if err != nil {
+		s.requestsMu.Lock()
+		delete(s.pendingHTTPRequests, req.RequestID)
+		s.requestsMu.Unlock()
+		return nil, err
+	}
+	defer release()
+
 	if err := conn.SendHTTPTunnelRequest(req); err != nil {
 		s.requestsMu.Lock()
 		delete(s.pendingHTTPRequests, req.RequestID)
@@ -735,10 +793,13 @@ func (s *Server) SendHTTPTunnelRequestForCR(
 			"statusCode", response.StatusCode,
 		)
 		return response, nil
-	case <-time.After(timeout):
+	case <-ctx.Done():
 		s.requestsMu.Lock()
 		delete(s.pendingHTTPRequests, req.RequestID)
 		s.requestsMu.Unlock()
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return nil, fmt.Errorf("HTTP tunnel request canceled: %w", ctx.Err())
+		}
 		return nil, fmt.Errorf("HTTP tunnel request timeout")
 	}
 }
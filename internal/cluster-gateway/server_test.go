@@ -4,6 +4,7 @@
 package clustergateway
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -319,7 +320,7 @@ func TestSendHTTPTunnelRequestForCR_NoAuthorizedAgent(t *testing.T) {
 		Path:   "/api/v1/pods",
 	}
 
-	_, err := s.SendHTTPTunnelRequestForCR("dataplane/prod", "ns/dp-other", req, time.Second)
+	_, err := s.SendHTTPTunnelRequestForCR(context.Background(), "dataplane/prod", "ns/dp-other", messaging.PriorityInteractive, req, time.Second)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "no agents authorized for CR")
 }
@@ -348,7 +349,7 @@ func TestSendHTTPTunnelRequestForCR_Success(t *testing.T) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		resp, sendErr = s.SendHTTPTunnelRequestForCR("dataplane/prod", "ns/dp1", req, 2*time.Second)
+		resp, sendErr = s.SendHTTPTunnelRequestForCR(context.Background(), "dataplane/prod", "ns/dp1", messaging.PriorityInteractive, req, 2*time.Second)
 	}()
 
 	// Wait a bit for the request to be registered, then deliver the response
@@ -686,7 +687,7 @@ func TestSendHTTPTunnelRequestForCR_Timeout(t *testing.T) {
 		Path:   "/api/v1/pods",
 	}
 
-	_, err := s.SendHTTPTunnelRequestForCR("dataplane/prod", "ns/dp1", req, 50*time.Millisecond)
+	_, err := s.SendHTTPTunnelRequestForCR(context.Background(), "dataplane/prod", "ns/dp1", messaging.PriorityInteractive, req, 50*time.Millisecond)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "timeout")
 
@@ -696,6 +697,71 @@ func TestSendHTTPTunnelRequestForCR_Timeout(t *testing.T) {
 	s.requestsMu.Unlock()
 }
 
+func TestSendHTTPTunnelRequestForCR_RequestCanceled(t *testing.T) {
+	scheme := testScheme()
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	s := New(&Config{}, fakeClient, testLogger())
+
+	conn, cleanup := newTestWSConn(t)
+	defer cleanup()
+	_, _ = s.connMgr.Register("dataplane", "prod", conn, []string{"ns/dp1"}, nil)
+
+	req := &messaging.HTTPTunnelRequest{
+		Target: "k8s",
+		Method: "GET",
+		Path:   "/api/v1/pods",
+	}
+
+	// The caller going away ends the request long before its timeout
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := s.SendHTTPTunnelRequestForCR(ctx, "dataplane/prod", "ns/dp1", messaging.PriorityInteractive, req, 30*time.Second)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	s.requestsMu.Lock()
+	assert.Empty(t, s.pendingHTTPRequests)
+	s.requestsMu.Unlock()
+}
+
+func TestSendHTTPTunnelRequestForCR_StreamsExhausted(t *testing.T) {
+	scheme := testScheme()
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	s := New(&Config{MaxStreamsPerAgent: 2, ReservedInteractiveStreams: 1}, fakeClient, testLogger())
+
+	conn, cleanup := newTestWSConn(t)
+	defer cleanup()
+	_, _ = s.connMgr.Register("dataplane", "prod", conn, []string{"ns/dp1"}, nil)
+
+	agentConn, err := s.connMgr.GetForCR("dataplane/prod", "ns/dp1")
+	require.NoError(t, err)
+	release, err := agentConn.AcquireStream(context.Background(), messaging.PriorityReconcile)
+	require.NoError(t, err)
+	defer release()
+
+	req := &messaging.HTTPTunnelRequest{
+		Target: "k8s",
+		Method: "GET",
+		Path:   "/api/v1/pods",
+	}
+
+	// The only unreserved stream is taken, so a reconcile request times out waiting
+	_, err = s.SendHTTPTunnelRequestForCR(context.Background(), "dataplane/prod", "ns/dp1", messaging.PriorityReconcile, req, 50*time.Millisecond)
+	assert.ErrorIs(t, err, errStreamsExhausted)
+
+	// An interactive request still gets the reserved stream and is sent to the agent
+	_, err = s.SendHTTPTunnelRequestForCR(context.Background(), "dataplane/prod", "ns/dp1", messaging.PriorityInteractive, req, 50*time.Millisecond)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout")
+
+	s.requestsMu.Lock()
+	assert.Empty(t, s.pendingHTTPRequests)
+	s.requestsMu.Unlock()
+}
+
 func TestHandleHTTPProxy_NoAgentsRegistered(t *testing.T) {
 	scheme := testScheme()
 	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
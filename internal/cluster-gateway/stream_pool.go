@@ -0,0 +1,180 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// requestPriorities lists the priority classes in dispatch order
+var requestPriorities = []messaging.RequestPriority{messaging.PriorityInteractive, messaging.PriorityReconcile}
+
+// errStreamsExhausted is returned when no stream of an agent connection became free
+// before the request timed out.
+var errStreamsExhausted = errors.New("no free stream on agent connection")
+
+// requestPriorityFromHeader returns the priority class requested by the caller
+func requestPriorityFromHeader(h http.Header) messaging.RequestPriority {
+	if messaging.RequestPriority(h.Get(messaging.RequestPriorityHeader)) == messaging.PriorityInteractive {
+		return messaging.PriorityInteractive
+	}
+	return messaging.PriorityReconcile
+}
+
+// streamPool bounds the number of requests multiplexed concurrently over one agent
+// connection. When all streams are busy, requests wait in a FIFO queue per priority
+// class; interactive requests are dispatched first, and a number of streams is kept
+// free for them so that a burst of reconcile requests cannot take every stream.
+type streamPool struct {
+	plane    string // planeIdentifier used to label metrics
+	size     int
+	reserved int // streams only interactive requests may use
+
+	mu       sync.Mutex
+	inFlight map[messaging.RequestPriority]int
+	waiting  map[messaging.RequestPriority]*list.List // of *streamWaiter
+}
+
+type streamWaiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+// newStreamPool creates a pool of size streams. It returns nil, meaning unlimited,
+// when size is not positive. reserved is capped so that reconcile requests keep at
+// least one stream.
+func newStreamPool(plane string, size, reserved int) *streamPool {
+	if size <= 0 {
+		return nil
+	}
+	reserved = max(0, min(reserved, size-1))
+	p := &streamPool{
+		plane:    plane,
+		size:     size,
+		reserved: reserved,
+		inFlight: make(map[messaging.RequestPriority]int, len(requestPriorities)),
+		waiting:  make(map[messaging.RequestPriority]*list.List, len(requestPriorities)),
+	}
+	for _, priority := range requestPriorities {
+		p.waiting[priority] = list.New()
+	}
+	return p
+}
+
+// acquire takes a stream for a request of the given priority, waiting until one is
+// free or ctx is done. The returned function releases the stream. A nil pool never
+// blocks.
+func (p *streamPool) acquire(ctx context.Context, priority messaging.RequestPriority) (func(), error) {
+	if p == nil {
+		return func() {}, nil
+	}
+
+	start := time.Now()
+	p.mu.Lock()
+	if p.waiting[priority].Len() == 0 && p.tryTakeLocked(priority) {
+		p.mu.Unlock()
+		streamWaitSeconds.WithLabelValues(p.plane, string(priority)).Observe(0)
+		return p.releaseFunc(priority), nil
+	}
+
+	w := &streamWaiter{ready: make(chan struct{})}
+	elem := p.waiting[priority].PushBack(w)
+	queuedStreamRequests.WithLabelValues(p.plane, string(priority)).Inc()
+	p.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		streamWaitSeconds.WithLabelValues(p.plane, string(priority)).Observe(time.Since(start).Seconds())
+		return p.releaseFunc(priority), nil
+	case <-ctx.Done():
+		p.mu.Lock()
+		granted := w.granted
+		if !granted {
+			p.waiting[priority].Remove(elem)
+			queuedStreamRequests.WithLabelValues(p.plane, string(priority)).Dec()
+		}
+		p.mu.Unlock()
+		if granted {
+			// The stream was handed over just as the wait ended; give it back.
+			p.release(priority)
+		}
+		rejectedStreamRequests.WithLabelValues(p.plane, string(priority)).Inc()
+		return nil, errStreamsExhausted
+	}
+}
+
+func (p *streamPool) releaseFunc(priority messaging.RequestPriority) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() { p.release(priority) })
+	}
+}
+
+func (p *streamPool) release(priority messaging.RequestPriority) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inFlight[priority]--
+	inFlightStreams.WithLabelValues(p.plane, string(priority)).Dec()
+	p.dispatchLocked()
+}
+
+// dispatchLocked hands free streams to queued requests, interactive ones first.
+// Must be called with p.mu held.
+func (p *streamPool) dispatchLocked() {
+	for _, priority := range requestPriorities {
+		queue := p.waiting[priority]
+		for queue.Len() > 0 && p.tryTakeLocked(priority) {
+			w := queue.Remove(queue.Front()).(*streamWaiter)
+			w.granted = true
+			close(w.ready)
+			queuedStreamRequests.WithLabelValues(p.plane, string(priority)).Dec()
+		}
+	}
+}
+
+// tryTakeLocked takes a stream if one is available to the priority class.
+// Must be called with p.mu held.
+func (p *streamPool) tryTakeLocked(priority messaging.RequestPriority) bool {
+	limit := p.size
+	if priority != messaging.PriorityInteractive {
+		limit -= p.reserved
+	}
+	if p.totalLocked() >= limit {
+		return false
+	}
+	p.inFlight[priority]++
+	inFlightStreams.WithLabelValues(p.plane, string(priority)).Inc()
+	return true
+}
+
+func (p *streamPool) totalLocked() int {
+	total := 0
+	for _, n := range p.inFlight {
+		total += n
+	}
+	return total
+}
+
+// load returns the number of streams in use and requests waiting for one
+func (p *streamPool) load() int {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	load := p.totalLocked()
+	for _, queue := range p.waiting {
+		load += queue.Len()
+	}
+	return load
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+func TestRequestPriorityFromHeader(t *testing.T) {
+	tests := []struct {
+		value string
+		want  messaging.RequestPriority
+	}{
+		{"interactive", messaging.PriorityInteractive},
+		{"reconcile", messaging.PriorityReconcile},
+		{"", messaging.PriorityReconcile},
+		{"urgent", messaging.PriorityReconcile},
+	}
+	for _, tt := range tests {
+		h := http.Header{}
+		if tt.value != "" {
+			h.Set(messaging.RequestPriorityHeader, tt.value)
+		}
+		assert.Equal(t, tt.want, requestPriorityFromHeader(h), "header %q", tt.value)
+	}
+}
+
+func TestNewStreamPool(t *testing.T) {
+	assert.Nil(t, newStreamPool("dataplane/test", 0, 4))
+
+	p := newStreamPool("dataplane/test", 4, 10)
+	require.NotNil(t, p)
+	assert.Equal(t, 3, p.reserved, "reconcile requests keep at least one stream")
+
+	p = newStreamPool("dataplane/test", 4, -1)
+	assert.Equal(t, 0, p.reserved)
+}
+
+func TestStreamPool_NilIsUnlimited(t *testing.T) {
+	var p *streamPool
+	release, err := p.acquire(context.Background(), messaging.PriorityReconcile)
+	require.NoError(t, err)
+	release()
+	assert.Equal(t, 0, p.load())
+}
+
+func TestStreamPool_ReservesStreamsForInteractive(t *testing.T) {
+	p := newStreamPool("dataplane/reserve", 3, 1)
+
+	r1, err := p.acquire(context.Background(), messaging.PriorityReconcile)
+	require.NoError(t, err)
+	r2, err := p.acquire(context.Background(), messaging.PriorityReconcile)
+	require.NoError(t, err)
+
+	rejected := rejectedStreamRequests.WithLabelValues("dataplane/reserve", "reconcile")
+	rejectedBefore := testutil.ToFloat64(rejected)
+
+	// The last stream is reserved: a reconcile request has to wait
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = p.acquire(ctx, messaging.PriorityReconcile)
+	assert.ErrorIs(t, err, errStreamsExhausted)
+	assert.Equal(t, rejectedBefore+1, testutil.ToFloat64(rejected))
+
+	// ...while an interactive request gets it immediately
+	r3, err := p.acquire(context.Background(), messaging.PriorityInteractive)
+	require.NoError(t, err)
+	assert.Equal(t, 3, p.load())
+	assert.Equal(t, 1.0, testutil.ToFloat64(inFlightStreams.WithLabelValues("dataplane/reserve", "interactive")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(inFlightStreams.WithLabelValues("dataplane/reserve", "reconcile")))
+
+	r1()
+	r1() // releasing twice is a no-op
+	r2()
+	r3()
+	assert.Equal(t, 0, p.load())
+	assert.Equal(t, 0.0, testutil.ToFloat64(inFlightStreams.WithLabelValues("dataplane/reserve", "reconcile")))
+}
+
+func TestStreamPool_DispatchesInteractiveFirst(t *testing.T) {
+	p := newStreamPool("dataplane/order", 1, 0)
+
+	release, err := p.acquire(context.Background(), messaging.PriorityReconcile)
+	require.NoError(t, err)
+
+	order := make(chan messaging.RequestPriority, 2)
+	wait := func(priority messaging.RequestPriority) {
+		r, err := p.acquire(context.Background(), priority)
+		if err != nil {
+			return
+		}
+		order <- priority
+		r()
+	}
+	go wait(messaging.PriorityReconcile)
+	require.Eventually(t, func() bool { return p.load() == 2 }, time.Second, time.Millisecond)
+	go wait(messaging.PriorityInteractive)
+	require.Eventually(t, func() bool { return p.load() == 3 }, time.Second, time.Millisecond)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(queuedStreamRequests.WithLabelValues("dataplane/order", "interactive")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(queuedStreamRequests.WithLabelValues("dataplane/order", "reconcile")))
+
+	release()
+	assert.Equal(t, messaging.PriorityInteractive, <-order, "the interactive request queued later is served first")
+	assert.Equal(t, messaging.PriorityReconcile, <-order)
+	assert.Equal(t, 0.0, testutil.ToFloat64(queuedStreamRequests.WithLabelValues("dataplane/order", "reconcile")))
+}
+
+func TestStreamPool_CanceledWaiterLeavesQueue(t *testing.T) {
+	p := newStreamPool("dataplane/cancel", 1, 0)
+
+	release, err := p.acquire(context.Background(), messaging.PriorityInteractive)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := p.acquire(ctx, messaging.PriorityInteractive)
+		done <- err
+	}()
+	require.Eventually(t, func() bool { return p.load() == 2 }, time.Second, time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-done, errStreamsExhausted)
+	assert.Equal(t, 1, p.load())
+
+	release()
+	r, err := p.acquire(context.Background(), messaging.PriorityInteractive)
+	require.NoError(t, err)
+	r()
+}
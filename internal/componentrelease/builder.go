@@ -8,6 +8,7 @@ import (
 	"sort"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
 // BuildInput holds the resolved resources needed to assemble a ComponentReleaseSpec.
@@ -67,6 +68,19 @@ func BuildSpec(input BuildInput) (*openchoreov1alpha1.ComponentReleaseSpec, erro
 	}, nil
 }
 
+// Annotations returns the annotations a ComponentRelease carries over from the Workload it was
+// created from: the source commit recorded by the build. Returns nil if there are none.
+func Annotations(workload *openchoreov1alpha1.Workload) map[string]string {
+	if workload == nil {
+		return nil
+	}
+	commit := workload.Annotations[labels.AnnotationKeySourceCommit]
+	if commit == "" {
+		return nil
+	}
+	return map[string]string{labels.AnnotationKeySourceCommit: commit}
+}
+
 // hasTraitByKind checks whether the named trait exists in the correct map based on its kind.
 func hasTraitByKind(input BuildInput, kind openchoreov1alpha1.TraitRefKind, name string) bool {
 	if kind == openchoreov1alpha1.TraitRefKindClusterTrait {
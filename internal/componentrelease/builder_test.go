@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
 // findTrait reports whether traits contains an entry matching the given kind and name.
@@ -575,3 +576,22 @@ func TestBuildSpec_PreservesComponentTypePostRenderValidations(t *testing.T) {
 			out.ComponentType.Spec.PostRenderValidations)
 	}
 }
+
+func TestAnnotations(t *testing.T) {
+	if got := Annotations(nil); got != nil {
+		t.Errorf("Annotations(nil) = %v, want nil", got)
+	}
+
+	workload := &openchoreov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"other": "value"}},
+	}
+	if got := Annotations(workload); got != nil {
+		t.Errorf("Annotations() without a source commit = %v, want nil", got)
+	}
+
+	workload.Annotations[labels.AnnotationKeySourceCommit] = "0123456789abcdef"
+	got := Annotations(workload)
+	if len(got) != 1 || got[labels.AnnotationKeySourceCommit] != "0123456789abcdef" {
+		t.Errorf("Annotations() = %v, want only the source commit", got)
+	}
+}
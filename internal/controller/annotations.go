@@ -25,6 +25,37 @@ const (
 	// triggers a restart.
 	AnnotationKeyRestartedAt = "openchoreo.dev/restartedAt"
 
+	// AnnotationKeyDecommissionAction is set on an Environment to choose what happens to it
+	// when the data plane it references is deleted. The value is either "delete", which
+	// deletes the environment together with its release bindings, or
+	// "migrate:<Kind>/<name>" (e.g. "migrate:ClusterDataPlane/default"), which moves the
+	// environment to another data plane after removing its releases from the deleted one.
+	// The data plane is only deleted once every environment referencing it has been
+	// deleted or migrated.
+	AnnotationKeyDecommissionAction = "openchoreo.dev/decommission-action"
+
+	// AnnotationKeyRequeueRequestedAt is set on a quarantined object to reconcile it again
+	// once the problem that kept its reconciles failing is fixed. The value is an RFC 3339
+	// time; the quarantine is lifted when it is not before the time the object was
+	// quarantined. An object that keeps failing is quarantined again.
+	AnnotationKeyRequeueRequestedAt = "openchoreo.dev/requeue-requested-at"
+
+	// AnnotationKeyPromoteRollout is set on a ReleaseBinding to promote the release its canary
+	// or blue-green rollout is rolling out. The value is the name of that ComponentRelease, so
+	// that a stale annotation does not promote a later rollout.
+	AnnotationKeyPromoteRollout = "openchoreo.dev/promote-rollout"
+
+	// AnnotationKeyMaxConcurrentBuilds is set on a namespace to limit the number of component
+	// workflow runs of the namespace that are pending or running at the same time. The value is
+	// a positive integer; new builds are rejected while the namespace is at the limit.
+	AnnotationKeyMaxConcurrentBuilds = "openchoreo.dev/max-concurrent-builds"
+
+	// AnnotationKeyTransferredFrom is set on a Component when it is transferred to another project.
+	// The value is the name of the project the component was moved from. The component webhook
+	// only admits a change of spec.owner.projectName that carries this annotation and is made
+	// by the component transfer user.
+	AnnotationKeyTransferredFrom = "openchoreo.dev/transferred-from"
+
 	// SchemaExtensionComponentParameterRepositoryPrefix is the common prefix for all openAPIV3Schema
 	// x- extension keys that mark component repository parameter fields (set to true on the property).
 	// The suffix after the prefix is used as the role key in the map returned by ExtractComponentRepositoryPaths
@@ -35,6 +66,12 @@ const (
 	SchemaExtensionComponentParameterRepositoryCommit    = SchemaExtensionComponentParameterRepositoryPrefix + "commit"
 	SchemaExtensionComponentParameterRepositoryAppPath   = SchemaExtensionComponentParameterRepositoryPrefix + "app-path"
 	SchemaExtensionComponentParameterRepositorySecretRef = SchemaExtensionComponentParameterRepositoryPrefix + "secret-ref"
+
+	// SchemaExtensionAllowPlaintext is set to true on a workflow parameter property to admit
+	// values under it that look like plaintext credentials, such as a public key or a token
+	// that is not secret. Workflow parameters are otherwise rejected when they carry
+	// credentials instead of referencing a SecretReference.
+	SchemaExtensionAllowPlaintext = "x-openchoreo-allow-plaintext"
 )
 
 // ExtractComponentRepositoryPaths scans an openAPIV3Schema RawExtension for boolean
@@ -0,0 +1,327 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalydetector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/anomaly"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+const (
+	// anomalyDetectPath is the anomaly detection endpoint of the observer internal API.
+	anomalyDetectPath = "/api/v1alpha1/anomalies/detect"
+	// detectTimeout is the timeout for anomaly detection calls to the observer internal API. A
+	// run queries the metrics of every baseline season, so it is longer than
+	// controller.ObserverAPITimeout.
+	detectTimeout = 30 * time.Second
+	// defaultInterval is how often the detector runs when the spec does not set an interval.
+	defaultInterval = 5 * time.Minute
+)
+
+// Condition types and reasons for AnomalyDetector.
+const (
+	// ConditionEvaluated indicates whether the last run succeeded.
+	ConditionEvaluated controller.ConditionType = "Evaluated"
+	// ConditionAnomalous indicates whether any signal was anomalous in the last run.
+	ConditionAnomalous controller.ConditionType = "Anomalous"
+
+	// ReasonEvaluationSucceeded indicates the observer evaluated the signals.
+	ReasonEvaluationSucceeded controller.ConditionReason = "EvaluationSucceeded"
+	// ReasonEvaluationFailed indicates the observer could not evaluate the signals.
+	ReasonEvaluationFailed controller.ConditionReason = "EvaluationFailed"
+	// ReasonAnomalyDetected indicates at least one signal deviates from its baseline.
+	ReasonAnomalyDetected controller.ConditionReason = "AnomalyDetected"
+	// ReasonNoAnomaly indicates all signals are within their baseline.
+	ReasonNoAnomaly controller.ConditionReason = "NoAnomaly"
+)
+
+// signalNames maps the CRD signals to the observer signal names.
+var signalNames = map[openchoreov1alpha1.AnomalySignal]anomaly.Signal{
+	openchoreov1alpha1.AnomalySignalRequestRate: anomaly.SignalRequestRate,
+	openchoreov1alpha1.AnomalySignalErrorRatio:  anomaly.SignalErrorRatio,
+	openchoreov1alpha1.AnomalySignalLatency:     anomaly.SignalLatency,
+}
+
+// anomalyRequest is the payload sent to the observer anomaly detection endpoint.
+type anomalyRequest struct {
+	SearchScope  anomalySearchScope `json:"searchScope"`
+	DetectorName string             `json:"detectorName"`
+	Signals      []string           `json:"signals,omitempty"`
+	Sensitivity  string             `json:"sensitivity,omitempty"`
+	Seasonality  string             `json:"seasonality,omitempty"`
+	Seasons      int32              `json:"seasons,omitempty"`
+	Window       string             `json:"window,omitempty"`
+	Actions      *anomalyActions    `json:"actions,omitempty"`
+}
+
+type anomalySearchScope struct {
+	Namespace   string `json:"namespace"`
+	Project     string `json:"project"`
+	Component   string `json:"component"`
+	Environment string `json:"environment"`
+}
+
+type anomalyActions struct {
+	Severity             string   `json:"severity,omitempty"`
+	NotificationChannels []string `json:"notificationChannels,omitempty"`
+	IncidentEnabled      bool     `json:"incidentEnabled,omitempty"`
+	TriggerAiRca         bool     `json:"triggerAiRca,omitempty"`
+}
+
+// anomalyResponse is the response from the observer anomaly detection endpoint.
+type anomalyResponse struct {
+	EndTime  time.Time             `json:"endTime"`
+	Signals  []anomalySignalResult `json:"signals"`
+	Warnings []string              `json:"warnings,omitempty"`
+}
+
+type anomalySignalResult struct {
+	Signal           string  `json:"signal"`
+	Value            float64 `json:"value"`
+	Baseline         float64 `json:"baseline"`
+	Score            float64 `json:"score"`
+	Anomalous        bool    `json:"anomalous"`
+	InsufficientData bool    `json:"insufficientData,omitempty"`
+	AlertID          string  `json:"alertId,omitempty"`
+}
+
+// Reconciler reconciles an AnomalyDetector object by periodically asking the observability
+// plane to compare the component metrics with their seasonal baseline and recording the
+// result in status. The observability plane raises the anomalies as alerts.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// ObserverBaseURL overrides the observer internal base URL. Defaults to
+	// OBSERVER_INTERNAL_ENDPOINT or the in-cluster observer-internal service.
+	ObserverBaseURL string
+
+	httpClient *http.Client
+	// now returns the current time. Overridden in tests.
+	now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=anomalydetectors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=anomalydetectors/status,verbs=get;update;patch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	ad := &openchoreov1alpha1.AnomalyDetector{}
+	if err := r.Get(ctx, req.NamespacedName, ad); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get AnomalyDetector")
+		return ctrl.Result{}, err
+	}
+
+	old := ad.DeepCopy()
+	ad.Status.ObservedGeneration = ad.Generation
+
+	resp, err := r.detect(ctx, buildAnomalyRequest(ad))
+	if err != nil {
+		logger.Info("Failed to detect anomalies", "error", err.Error())
+		controller.MarkFalseCondition(ad, ConditionEvaluated, ReasonEvaluationFailed, err.Error())
+	} else {
+		for _, warning := range resp.Warnings {
+			logger.Info("Anomaly detection warning", "warning", warning)
+		}
+		applyAnomalyResponse(ad, resp, r.clock())
+		controller.MarkTrueCondition(ad, ConditionEvaluated, ReasonEvaluationSucceeded,
+			"Signals evaluated by the observability plane")
+	}
+
+	if !apiequality.Semantic.DeepEqual(old.Status, ad.Status) {
+		if err := r.Status().Update(ctx, ad); err != nil {
+			logger.Error(err, "Failed to update AnomalyDetector status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	interval := ad.Spec.Interval.Duration
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// buildAnomalyRequest converts the AnomalyDetector spec into the observer request payload.
+// Unset durations are left to the observer defaults.
+func buildAnomalyRequest(ad *openchoreov1alpha1.AnomalyDetector) *anomalyRequest {
+	spec := ad.Spec
+	payload := &anomalyRequest{
+		SearchScope: anomalySearchScope{
+			Namespace:   ad.Namespace,
+			Project:     spec.Owner.ProjectName,
+			Component:   spec.Owner.ComponentName,
+			Environment: spec.Environment,
+		},
+		DetectorName: ad.Name,
+		Sensitivity:  strings.ToLower(string(spec.Sensitivity)),
+		Seasons:      spec.Seasons,
+	}
+	for _, signal := range spec.Signals {
+		payload.Signals = append(payload.Signals, string(signalNames[signal]))
+	}
+	if spec.Seasonality.Duration > 0 {
+		payload.Seasonality = spec.Seasonality.Duration.String()
+	}
+	if spec.Window.Duration > 0 {
+		payload.Window = spec.Window.Duration.String()
+	}
+	if actions := spec.Actions; actions != nil {
+		payload.Actions = &anomalyActions{Severity: string(actions.Severity)}
+		for _, channel := range actions.NotificationChannels {
+			payload.Actions.NotificationChannels = append(payload.Actions.NotificationChannels, string(channel))
+		}
+		if actions.Incident != nil {
+			payload.Actions.IncidentEnabled = actions.Incident.Enabled
+			payload.Actions.TriggerAiRca = actions.Incident.TriggerAiRca
+		}
+	}
+	return payload
+}
+
+// applyAnomalyResponse records the observer response in status. Since and the alert ID
+// are carried over while a signal stays anomalous, because the observer suppresses
+// repeated alerts for an ongoing anomaly.
+func applyAnomalyResponse(ad *openchoreov1alpha1.AnomalyDetector, resp *anomalyResponse, now time.Time) {
+	previous := make(map[openchoreov1alpha1.AnomalySignal]openchoreov1alpha1.AnomalySignalStatus, len(ad.Status.Signals))
+	for _, s := range ad.Status.Signals {
+		previous[s.Signal] = s
+	}
+
+	var anomalous []string
+	signals := make([]openchoreov1alpha1.AnomalySignalStatus, 0, len(resp.Signals))
+	for _, result := range resp.Signals {
+		signal, ok := crdSignal(result.Signal)
+		if !ok {
+			continue
+		}
+		status := openchoreov1alpha1.AnomalySignalStatus{
+			Signal:           signal,
+			Anomalous:        result.Anomalous,
+			InsufficientData: result.InsufficientData,
+		}
+		if !result.InsufficientData {
+			status.Value = anomaly.FormatValue(result.Value)
+			status.Baseline = anomaly.FormatValue(result.Baseline)
+			status.Score = anomaly.FormatValue(result.Score)
+		}
+		if result.Anomalous {
+			anomalous = append(anomalous, string(signal))
+			status.Since, status.AlertID = previous[signal].Since, result.AlertID
+			if status.Since == nil {
+				since := metav1.NewTime(now)
+				status.Since = &since
+			}
+			if status.AlertID == "" {
+				status.AlertID = previous[signal].AlertID
+			}
+		}
+		signals = append(signals, status)
+	}
+	ad.Status.Signals = signals
+
+	evaluatedAt := metav1.NewTime(resp.EndTime)
+	if resp.EndTime.IsZero() {
+		evaluatedAt = metav1.NewTime(now)
+	}
+	ad.Status.LastEvaluationTime = &evaluatedAt
+
+	if len(anomalous) > 0 {
+		controller.MarkTrueCondition(ad, ConditionAnomalous, ReasonAnomalyDetected,
+			fmt.Sprintf("Anomalous signals: %s", strings.Join(anomalous, ", ")))
+	} else {
+		controller.MarkFalseCondition(ad, ConditionAnomalous, ReasonNoAnomaly,
+			"All signals are within their seasonal baseline")
+	}
+}
+
+// crdSignal maps an observer signal name back to the CRD signal.
+func crdSignal(name string) (openchoreov1alpha1.AnomalySignal, bool) {
+	for crd, signal := range signalNames {
+		if string(signal) == name {
+			return crd, true
+		}
+	}
+	return "", false
+}
+
+// detect calls POST /api/v1alpha1/anomalies/detect on the observer internal API.
+func (r *Reconciler) detect(ctx context.Context, payload *anomalyRequest) (*anomalyResponse, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, detectTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodPost, controller.ObserverInternalBaseURL(r.ObserverBaseURL)+anomalyDetectPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("anomaly detection request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errBody struct {
+			Message string `json:"message,omitempty"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errBody)
+		return nil, fmt.Errorf("observer anomaly detection API returned status %d: %s", resp.StatusCode, errBody.Message)
+	}
+
+	var out anomalyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &out, nil
+}
+
+func (r *Reconciler) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.httpClient == nil {
+		r.httpClient = &http.Client{Timeout: detectTimeout}
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates must not trigger a re-evaluation; the detector runs periodically.
+		For(&openchoreov1alpha1.AnomalyDetector{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("anomalydetector").
+		Complete(r)
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalydetector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var testNow = time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+
+func newTestAnomalyDetector() *openchoreov1alpha1.AnomalyDetector {
+	return &openchoreov1alpha1.AnomalyDetector{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-anomalies", Namespace: "default", Generation: 1},
+		Spec: openchoreov1alpha1.AnomalyDetectorSpec{
+			Owner:       openchoreov1alpha1.AnomalyDetectorOwner{ProjectName: "proj", ComponentName: "api"},
+			Environment: "production",
+			Signals: []openchoreov1alpha1.AnomalySignal{
+				openchoreov1alpha1.AnomalySignalRequestRate,
+				openchoreov1alpha1.AnomalySignalLatency,
+			},
+			Sensitivity: openchoreov1alpha1.AnomalySensitivityHigh,
+			Seasonality: metav1.Duration{Duration: 24 * time.Hour},
+			Seasons:     4,
+			Window:      metav1.Duration{Duration: 10 * time.Minute},
+			Interval:    metav1.Duration{Duration: 2 * time.Minute},
+			Actions: &openchoreov1alpha1.AnomalyDetectorActions{
+				Severity:             "critical",
+				NotificationChannels: []openchoreov1alpha1.NotificationChannelName{"oncall"},
+				Incident:             &openchoreov1alpha1.AnomalyDetectorIncident{Enabled: true, TriggerAiRca: true},
+			},
+		},
+	}
+}
+
+func newTestReconciler(t *testing.T, ad *openchoreov1alpha1.AnomalyDetector, handler http.HandlerFunc) *Reconciler {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(ad).
+		WithStatusSubresource(&openchoreov1alpha1.AnomalyDetector{}).
+		Build()
+	return &Reconciler{
+		Client:          c,
+		Scheme:          s,
+		ObserverBaseURL: server.URL,
+		httpClient:      server.Client(),
+		now:             func() time.Time { return testNow },
+	}
+}
+
+func reconcileAnomalyDetector(t *testing.T, r *Reconciler) *openchoreov1alpha1.AnomalyDetector {
+	t.Helper()
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "api-anomalies"}
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, result.RequeueAfter)
+	out := &openchoreov1alpha1.AnomalyDetector{}
+	require.NoError(t, r.Get(ctx, key, out))
+	return out
+}
+
+func TestReconcileRecordsAnomalies(t *testing.T) {
+	var got anomalyRequest
+	r := newTestReconciler(t, newTestAnomalyDetector(), func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, anomalyDetectPath, req.URL.Path)
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"startTime":"2026-01-31T11:50:00Z","endTime":"2026-01-31T12:00:00Z","threshold":2,` +
+			`"signals":[` +
+			`{"signal":"requestRate","value":120,"baseline":100,"stdDev":10,"score":2,"baselineSamples":40,"anomalous":false},` +
+			`{"signal":"latency","value":1.5,"baseline":0.5,"stdDev":0.1,"score":10,"baselineSamples":40,"anomalous":true,"alertId":"alert-1"}]}`))
+	})
+
+	out := reconcileAnomalyDetector(t, r)
+
+	assert.Equal(t, anomalyRequest{
+		SearchScope: anomalySearchScope{
+			Namespace: "default", Project: "proj", Component: "api", Environment: "production",
+		},
+		DetectorName: "api-anomalies",
+		Signals:      []string{"requestRate", "latency"},
+		Sensitivity:  "high",
+		Seasonality:  "24h0m0s",
+		Seasons:      4,
+		Window:       "10m0s",
+		Actions: &anomalyActions{
+			Severity:             "critical",
+			NotificationChannels: []string{"oncall"},
+			IncidentEnabled:      true,
+			TriggerAiRca:         true,
+		},
+	}, got)
+
+	require.Len(t, out.Status.Signals, 2)
+	rate := out.Status.Signals[0]
+	assert.Equal(t, openchoreov1alpha1.AnomalySignalRequestRate, rate.Signal)
+	assert.False(t, rate.Anomalous)
+	assert.Equal(t, "120.0000", rate.Value)
+	assert.Nil(t, rate.Since)
+
+	latency := out.Status.Signals[1]
+	assert.True(t, latency.Anomalous)
+	assert.Equal(t, "10.0000", latency.Score)
+	assert.Equal(t, "alert-1", latency.AlertID)
+	require.NotNil(t, latency.Since)
+	assert.True(t, latency.Since.Time.Equal(testNow))
+
+	require.NotNil(t, out.Status.LastEvaluationTime)
+	assert.True(t, out.Status.LastEvaluationTime.Time.Equal(testNow))
+	assert.True(t, apimeta.IsStatusConditionTrue(out.Status.Conditions, string(ConditionEvaluated)))
+	cond := apimeta.FindStatusCondition(out.Status.Conditions, string(ConditionAnomalous))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, "Anomalous signals: Latency", cond.Message)
+}
+
+func TestReconcileKeepsOngoingAnomaly(t *testing.T) {
+	ad := newTestAnomalyDetector()
+	since := metav1.NewTime(testNow.Add(-time.Hour))
+	ad.Status.Signals = []openchoreov1alpha1.AnomalySignalStatus{{
+		Signal:    openchoreov1alpha1.AnomalySignalLatency,
+		Anomalous: true,
+		Since:     &since,
+		AlertID:   "alert-1",
+	}}
+	// The observer suppresses the repeated alert, so no alert ID is returned.
+	r := newTestReconciler(t, ad, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"endTime":"2026-01-31T12:00:00Z","threshold":2,` +
+			`"signals":[{"signal":"latency","value":1.5,"baseline":0.5,"score":10,"anomalous":true}]}`))
+	})
+
+	out := reconcileAnomalyDetector(t, r)
+
+	require.Len(t, out.Status.Signals, 1)
+	latency := out.Status.Signals[0]
+	require.NotNil(t, latency.Since)
+	assert.True(t, latency.Since.Time.Equal(since.Time))
+	assert.Equal(t, "alert-1", latency.AlertID)
+}
+
+func TestReconcileRecordsNoAnomaly(t *testing.T) {
+	r := newTestReconciler(t, newTestAnomalyDetector(), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"endTime":"2026-01-31T12:00:00Z","threshold":2,` +
+			`"signals":[{"signal":"requestRate","baselineSamples":1,"anomalous":false,"insufficientData":true}]}`))
+	})
+
+	out := reconcileAnomalyDetector(t, r)
+
+	require.Len(t, out.Status.Signals, 1)
+	assert.True(t, out.Status.Signals[0].InsufficientData)
+	assert.Empty(t, out.Status.Signals[0].Value)
+	cond := apimeta.FindStatusCondition(out.Status.Conditions, string(ConditionAnomalous))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonNoAnomaly), cond.Reason)
+}
+
+func TestReconcileRecordsEvaluationFailure(t *testing.T) {
+	r := newTestReconciler(t, newTestAnomalyDetector(), func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"Failed to detect anomalies"}`))
+	})
+
+	out := reconcileAnomalyDetector(t, r)
+
+	cond := apimeta.FindStatusCondition(out.Status.Conditions, string(ConditionEvaluated))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonEvaluationFailed), cond.Reason)
+	assert.Contains(t, cond.Message, "status 500")
+	assert.Nil(t, out.Status.LastEvaluationTime)
+}
+
+func TestBuildAnomalyRequestLeavesDefaultsToObserver(t *testing.T) {
+	ad := newTestAnomalyDetector()
+	ad.Spec = openchoreov1alpha1.AnomalyDetectorSpec{
+		Owner:       ad.Spec.Owner,
+		Environment: ad.Spec.Environment,
+	}
+
+	got := buildAnomalyRequest(ad)
+
+	assert.Nil(t, got.Signals)
+	assert.Empty(t, got.Sensitivity)
+	assert.Empty(t, got.Seasonality)
+	assert.Empty(t, got.Window)
+	assert.Nil(t, got.Actions)
+}
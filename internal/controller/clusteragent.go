@@ -0,0 +1,123 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// ConditionValidated represents whether the connection details of a plane are valid
+	ConditionValidated ConditionType = "Validated"
+
+	// ReasonConnectionDetailsValid is the reason used when the connection details of a plane are valid
+	ReasonConnectionDetailsValid ConditionReason = "ConnectionDetailsValid"
+
+	// ReasonConnectionDetailsInvalid is the reason used when the connection details of a plane are
+	// malformed or reference a secret that does not exist
+	ReasonConnectionDetailsInvalid ConditionReason = "ConnectionDetailsInvalid"
+)
+
+// ValidateClusterAgent checks the cluster agent connection details of a plane: the client CA must
+// be set, a referenced secret and key must exist, and the CA must be a PEM bundle of X.509
+// certificates. planeNamespace is the default namespace of the secret reference and is empty for
+// cluster-scoped planes. A missing secret is reported as a NotFound API error.
+func ValidateClusterAgent(
+	ctx context.Context,
+	c client.Reader,
+	planeNamespace string,
+	agent *openchoreov1alpha1.ClusterAgentConfig,
+) error {
+	clientCA := agent.ClientCA
+	if clientCA.Value != "" && clientCA.SecretKeyRef != nil {
+		return errors.New("clusterAgent.clientCA: only one of value and secretKeyRef may be set")
+	}
+	if clientCA.Value != "" {
+		if err := validateCABundle([]byte(clientCA.Value)); err != nil {
+			return fmt.Errorf("clusterAgent.clientCA.value: %w", err)
+		}
+		return nil
+	}
+	ref := clientCA.SecretKeyRef
+	if ref == nil {
+		return errors.New("clusterAgent.clientCA: one of value and secretKeyRef is required")
+	}
+	if ref.Name == "" || ref.Key == "" {
+		return errors.New("clusterAgent.clientCA.secretKeyRef: name and key are required")
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = planeNamespace
+	}
+	if namespace == "" {
+		return errors.New("clusterAgent.clientCA.secretKeyRef: namespace is required for cluster-scoped planes")
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return fmt.Errorf("clusterAgent.clientCA.secretKeyRef: failed to get secret %s/%s: %w", namespace, ref.Name, err)
+	}
+	data, ok := secret.Data[ref.Key]
+	if !ok {
+		return fmt.Errorf("clusterAgent.clientCA.secretKeyRef: key %q not found in secret %s/%s", ref.Key, namespace, ref.Name)
+	}
+	if err := validateCABundle(data); err != nil {
+		return fmt.Errorf("clusterAgent.clientCA.secretKeyRef: secret %s/%s: %w", namespace, ref.Name, err)
+	}
+	return nil
+}
+
+// validateCABundle returns an error unless the data holds at least one PEM encoded certificate
+// and every certificate parses.
+func validateCABundle(data []byte) error {
+	found := false
+	for len(data) > 0 {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+				return fmt.Errorf("invalid CA certificate: %w", err)
+			}
+			found = true
+		}
+		data = rest
+	}
+	if !found {
+		return errors.New("no PEM encoded certificate found")
+	}
+	return nil
+}
+
+// NewClusterAgentValidatedCondition creates the Validated condition of a plane from the result
+// of ValidateClusterAgent.
+func NewClusterAgentValidatedCondition(generation int64, err error) metav1.Condition {
+	if err != nil {
+		return NewCondition(
+			ConditionValidated,
+			metav1.ConditionFalse,
+			ReasonConnectionDetailsInvalid,
+			err.Error(),
+			generation,
+		)
+	}
+	return NewCondition(
+		ConditionValidated,
+		metav1.ConditionTrue,
+		ReasonConnectionDetailsValid,
+		"Connection details are valid",
+		generation,
+	)
+}
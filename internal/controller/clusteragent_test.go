@@ -0,0 +1,113 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// generateTestCAPEM creates a PEM encoded self-signed CA certificate for testing.
+func generateTestCAPEM(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestValidateClusterAgent(t *testing.T) {
+	caPEM := generateTestCAPEM(t)
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent-ca", Namespace: "default"},
+			Data:       map[string][]byte{"ca.crt": []byte(caPEM), "bad.crt": []byte("not a certificate")},
+		},
+	).Build()
+
+	secretRef := func(namespace, name, key string) openchoreov1alpha1.ValueFrom {
+		return openchoreov1alpha1.ValueFrom{
+			SecretKeyRef: &openchoreov1alpha1.SecretKeyReference{Namespace: namespace, Name: name, Key: key},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		planeNamespace string
+		clientCA       openchoreov1alpha1.ValueFrom
+		wantErr        string
+		wantNotFound   bool
+	}{
+		{name: "inline CA", clientCA: openchoreov1alpha1.ValueFrom{Value: caPEM}},
+		{name: "inline CA that is not PEM", clientCA: openchoreov1alpha1.ValueFrom{Value: "garbage"}, wantErr: "no PEM encoded certificate found"},
+		{name: "neither value nor secret", wantErr: "one of value and secretKeyRef is required"},
+		{
+			name:     "both value and secret",
+			clientCA: openchoreov1alpha1.ValueFrom{Value: caPEM, SecretKeyRef: &openchoreov1alpha1.SecretKeyReference{Name: "agent-ca", Key: "ca.crt"}},
+			wantErr:  "only one of value and secretKeyRef may be set",
+		},
+		{name: "secret in the plane namespace", planeNamespace: "default", clientCA: secretRef("", "agent-ca", "ca.crt")},
+		{name: "secret with explicit namespace", clientCA: secretRef("default", "agent-ca", "ca.crt")},
+		{name: "cluster-scoped plane without secret namespace", clientCA: secretRef("", "agent-ca", "ca.crt"), wantErr: "namespace is required"},
+		{name: "missing secret", planeNamespace: "default", clientCA: secretRef("", "missing", "ca.crt"), wantErr: "failed to get secret", wantNotFound: true},
+		{name: "missing key", planeNamespace: "default", clientCA: secretRef("", "agent-ca", "tls.crt"), wantErr: `key "tls.crt" not found`},
+		{name: "secret that is not PEM", planeNamespace: "default", clientCA: secretRef("", "agent-ca", "bad.crt"), wantErr: "no PEM encoded certificate found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := &openchoreov1alpha1.ClusterAgentConfig{ClientCA: tt.clientCA}
+			err := ValidateClusterAgent(context.Background(), c, tt.planeNamespace, agent)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Equal(t, tt.wantNotFound, apierrors.IsNotFound(err))
+		})
+	}
+}
+
+func TestNewClusterAgentValidatedCondition(t *testing.T) {
+	cond := NewClusterAgentValidatedCondition(3, nil)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(ReasonConnectionDetailsValid), cond.Reason)
+	assert.Equal(t, int64(3), cond.ObservedGeneration)
+
+	cond = NewClusterAgentValidatedCondition(3, assert.AnError)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonConnectionDetailsInvalid), cond.Reason)
+	assert.Equal(t, assert.AnError.Error(), cond.Message)
+}
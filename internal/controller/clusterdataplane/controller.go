@@ -21,6 +21,8 @@ import (
 	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/scheduling"
+	"github.com/openchoreo/openchoreo/internal/tenancy"
 )
 
 // Reconciler reconciles a DataPlane object
@@ -31,6 +33,10 @@ type Reconciler struct {
 	ClientMgr     *kubernetesClient.KubeMultiClientManager
 	GatewayClient *gatewayClient.Client // Client for notifying cluster-gateway
 	CacheVersion  string                // Cache key version prefix (e.g., "v2")
+
+	// PlaneClientProvider reaches the data plane cluster to discover its node labels.
+	// Discovery is skipped when nil.
+	PlaneClientProvider kubernetesClient.DataPlaneClientProvider
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -69,6 +75,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Validate the connection details so that a broken configuration surfaces on the
+	// Validated condition instead of when an agent first connects
+	validationErr := controller.ValidateClusterAgent(ctx, r.Client, "", &clusterDataPlane.Spec.ClusterAgent)
+	meta.SetStatusCondition(&clusterDataPlane.Status.Conditions,
+		controller.NewClusterAgentValidatedCondition(clusterDataPlane.Generation, validationErr))
+
 	// Invalidate cached Kubernetes client on UPDATE
 	// This ensures that any changes to the ClusterDataPlane CR (kubeconfig, credentials, etc.)
 	// trigger a new client to be created with the updated configuration
@@ -84,6 +96,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			logger.Error(err, "failed to get agent connection status")
 			// Don't fail reconciliation for status query errors
 		}
+		if err := r.populateNodeLabels(ctx, clusterDataPlane); err != nil {
+			logger.Error(err, "failed to discover node labels")
+			// Don't fail reconciliation for status query errors
+		}
+		r.populateTenancyStatus(clusterDataPlane)
 
 		// We use Status().Update() directly instead of UpdateStatusConditions to preserve agentConnection field
 		if err := r.Status().Update(ctx, clusterDataPlane); err != nil {
@@ -125,9 +142,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			logger.Error(err, "failed to get agent connection status")
 			// Don't fail reconciliation for status query errors
 		}
+		if err := r.populateNodeLabels(ctx, clusterDataPlane); err != nil {
+			logger.Error(err, "failed to discover node labels")
+			// Don't fail reconciliation for status query errors
+		}
 	} else {
 		logger.Info("skipping immediate status poll after gateway notification, agents may be reconnecting")
 	}
+	r.populateTenancyStatus(clusterDataPlane)
 
 	// We use Status().Update() directly instead of UpdateStatusConditions to preserve agentConnection field
 	if err := r.Status().Update(ctx, clusterDataPlane); err != nil {
@@ -184,6 +206,7 @@ func (r *Reconciler) notifyGateway(ctx context.Context, clusterDataPlane *opench
 		"event", event,
 		"planeID", clusterDataPlane.Spec.PlaneID,
 		"disconnectedAgents", resp.DisconnectedAgents,
+		"revokedCertificates", resp.RevokedCertificates,
 	)
 
 	return nil
@@ -251,6 +274,47 @@ func (r *Reconciler) populateAgentConnectionStatus(ctx context.Context, clusterD
 	return nil
 }
 
+// populateNodeLabels lists the nodes of the ClusterDataPlane cluster through the connected agent
+// and records their labels in the status (without persisting to API server). Scheduling
+// policies are validated against them when components are deployed.
+func (r *Reconciler) populateNodeLabels(ctx context.Context, clusterDataPlane *openchoreov1alpha1.ClusterDataPlane) error {
+	if r.PlaneClientProvider == nil {
+		return nil
+	}
+	if clusterDataPlane.Status.AgentConnection == nil || !clusterDataPlane.Status.AgentConnection.Connected {
+		return nil
+	}
+
+	dpClient, err := r.PlaneClientProvider.ClusterDataPlaneClient(clusterDataPlane)
+	if err != nil {
+		return fmt.Errorf("failed to get data plane client: %w", err)
+	}
+	var nodes corev1.NodeList
+	if err := dpClient.List(ctx, &nodes); err != nil {
+		return fmt.Errorf("failed to list data plane nodes: %w", err)
+	}
+	clusterDataPlane.Status.NodeLabels = scheduling.NodeLabelsFromNodes(nodes.Items)
+	return nil
+}
+
+// populateTenancyStatus validates the tenancy policy and records the capacity reservations
+// in the ClusterDataPlane status (without persisting to API server)
+func (r *Reconciler) populateTenancyStatus(clusterDataPlane *openchoreov1alpha1.ClusterDataPlane) {
+	if err := tenancy.ValidatePolicy(clusterDataPlane.Spec.Tenancy); err != nil {
+		meta.SetStatusCondition(&clusterDataPlane.Status.Conditions,
+			NewTenancyPolicyInvalidCondition(clusterDataPlane.Generation, err.Error()))
+		return
+	}
+	reservations, err := tenancy.ParseCapacityReservations(clusterDataPlane.Annotations)
+	if err != nil {
+		meta.SetStatusCondition(&clusterDataPlane.Status.Conditions,
+			NewTenancyPolicyInvalidCondition(clusterDataPlane.Generation, err.Error()))
+		return
+	}
+	clusterDataPlane.Status.CapacityReservations = reservations
+	meta.SetStatusCondition(&clusterDataPlane.Status.Conditions, NewTenancyPolicyValidCondition(clusterDataPlane.Generation))
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.Recorder == nil {
@@ -15,6 +15,9 @@ const (
 
 	// ConditionFinalizing represents whether the clusterdataplane is being finalized
 	ConditionFinalizing controller.ConditionType = "Finalizing"
+
+	// ConditionTenancyPolicyValid represents whether the tenancy policy and capacity reservations are valid
+	ConditionTenancyPolicyValid controller.ConditionType = "TenancyPolicyValid"
 )
 
 const (
@@ -23,6 +26,16 @@ const (
 
 	// ReasonClusterDataplaneFinalizing is the reason used when a clusterdataplane's dependents are being deleted
 	ReasonClusterDataplaneFinalizing controller.ConditionReason = "ClusterDataplaneFinalizing"
+
+	// ReasonDeletionBlocked is the reason used when a clusterdataplane cannot be deleted
+	// because it is still referenced by one or more environments
+	ReasonDeletionBlocked controller.ConditionReason = "DeletionBlocked"
+
+	// ReasonTenancyPolicyValid is the reason used when the tenancy policy and capacity reservations are valid
+	ReasonTenancyPolicyValid controller.ConditionReason = "TenancyPolicyValid"
+
+	// ReasonTenancyPolicyInvalid is the reason used when the tenancy policy or a capacity reservation is malformed
+	ReasonTenancyPolicyInvalid controller.ConditionReason = "TenancyPolicyInvalid"
 )
 
 // NewClusterDataPlaneCreatedCondition creates a condition to indicate the clusterdataplane is created/ready
@@ -46,3 +59,37 @@ func NewClusterDataPlaneFinalizingCondition(generation int64) metav1.Condition {
 		generation,
 	)
 }
+
+// NewDeletionBlockedCondition creates a condition to indicate deletion is blocked
+// because the clusterdataplane is still referenced by environments.
+func NewDeletionBlockedCondition(generation int64, msg string) metav1.Condition {
+	return controller.NewCondition(
+		ConditionFinalizing,
+		metav1.ConditionFalse,
+		ReasonDeletionBlocked,
+		msg,
+		generation,
+	)
+}
+
+// NewTenancyPolicyValidCondition creates a condition to indicate the tenancy policy is valid
+func NewTenancyPolicyValidCondition(generation int64) metav1.Condition {
+	return controller.NewCondition(
+		ConditionTenancyPolicyValid,
+		metav1.ConditionTrue,
+		ReasonTenancyPolicyValid,
+		"Tenancy policy is valid",
+		generation,
+	)
+}
+
+// NewTenancyPolicyInvalidCondition creates a condition to indicate the tenancy policy is invalid
+func NewTenancyPolicyInvalidCondition(generation int64, msg string) metav1.Condition {
+	return controller.NewCondition(
+		ConditionTenancyPolicyValid,
+		metav1.ConditionFalse,
+		ReasonTenancyPolicyInvalid,
+		msg,
+		generation,
+	)
+}
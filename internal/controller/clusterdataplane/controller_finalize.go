@@ -6,13 +6,17 @@ package clusterdataplane
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 // ClusterDataPlaneCleanupFinalizer is the finalizer that is used to clean up clusterdataplane resources.
@@ -40,6 +44,28 @@ func (r *Reconciler) finalize(ctx context.Context, clusterDataPlane *openchoreov
 		return ctrl.Result{}, nil
 	}
 
+	// Block deletion while any environment still references the clusterdataplane. Environments
+	// annotated with a decommission action are deleted or migrated to another data plane.
+	environments, err := r.listReferencingEnvironments(ctx, clusterDataPlane)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to check environment references: %w", err)
+	}
+	pending, err := controller.DecommissionEnvironments(ctx, r.Client, environments)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(pending) > 0 {
+		msg := fmt.Sprintf("Deletion blocked: clusterdataplane is still referenced by %d environment(s): %s",
+			len(pending), strings.Join(pending, ", "))
+		logger.Info(msg)
+		if meta.SetStatusCondition(&clusterDataPlane.Status.Conditions, NewDeletionBlockedCondition(clusterDataPlane.Generation, msg)) {
+			if err := r.Status().Update(ctx, clusterDataPlane); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
 	// Notify gateway of ClusterDataPlane deletion before removing finalizer
 	if r.GatewayClient != nil {
 		if err := r.notifyGateway(ctx, clusterDataPlane, "deleted"); err != nil {
@@ -65,3 +91,37 @@ func (r *Reconciler) finalize(ctx context.Context, clusterDataPlane *openchoreov
 	logger.Info("Successfully finalized clusterdataplane")
 	return ctrl.Result{}, nil
 }
+
+// listReferencingEnvironments returns the environments in any namespace that reference this
+// clusterdataplane, either explicitly or, for the default clusterdataplane, by falling back to it.
+func (r *Reconciler) listReferencingEnvironments(
+	ctx context.Context,
+	clusterDataPlane *openchoreov1alpha1.ClusterDataPlane,
+) ([]openchoreov1alpha1.Environment, error) {
+	environmentsList := &openchoreov1alpha1.EnvironmentList{}
+	if err := r.List(ctx, environmentsList); err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+
+	var environments []openchoreov1alpha1.Environment
+	for _, env := range environmentsList.Items {
+		if ref := env.Spec.DataPlaneRef; ref != nil {
+			if ref.Kind == openchoreov1alpha1.DataPlaneRefKindClusterDataPlane && ref.Name == clusterDataPlane.Name {
+				environments = append(environments, env)
+			}
+			continue
+		}
+		if clusterDataPlane.Name != controller.DefaultPlaneName {
+			continue
+		}
+		// An unset reference prefers the default DataPlane of the environment's namespace
+		result, err := controller.GetDataPlaneFromRef(ctx, r.Client, env.Namespace, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve data plane of environment %s/%s: %w", env.Namespace, env.Name, err)
+		}
+		if result.ClusterDataPlane != nil {
+			environments = append(environments, env)
+		}
+	}
+	return environments, nil
+}
@@ -5,9 +5,16 @@ package clusterdataplane
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
@@ -202,6 +209,119 @@ func TestPopulateAgentConnectionStatus_NilGateway(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// populateTenancyStatus
+// ---------------------------------------------------------------------------
+
+func TestPopulateTenancyStatus(t *testing.T) {
+	r := &Reconciler{}
+
+	cdp := &openchoreov1alpha1.ClusterDataPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "shared",
+			Annotations: map[string]string{"capacity-reservation.openchoreo.dev/team-a": "cpu=4,memory=8Gi"},
+		},
+		Spec: openchoreov1alpha1.ClusterDataPlaneSpec{
+			Tenancy: &openchoreov1alpha1.TenancyPolicy{AllowedNamespaces: []string{"team-*"}},
+		},
+	}
+	r.populateTenancyStatus(cdp)
+	if len(cdp.Status.CapacityReservations) != 1 || cdp.Status.CapacityReservations[0].Namespace != "team-a" {
+		t.Errorf("unexpected capacity reservations: %+v", cdp.Status.CapacityReservations)
+	}
+	if !meta.IsStatusConditionTrue(cdp.Status.Conditions, string(ConditionTenancyPolicyValid)) {
+		t.Error("expected TenancyPolicyValid condition to be true")
+	}
+
+	cdp.Spec.Tenancy.DeniedNamespaces = []string{"team-[x"}
+	r.populateTenancyStatus(cdp)
+	cond := meta.FindStatusCondition(cdp.Status.Conditions, string(ConditionTenancyPolicyValid))
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != string(ReasonTenancyPolicyInvalid) {
+		t.Errorf("expected TenancyPolicyInvalid condition, got %+v", cond)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// finalize — environment references
+// ---------------------------------------------------------------------------
+
+func TestFinalize_BlockedByReferencingEnvironments(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	now := metav1.Now()
+	cdp := &openchoreov1alpha1.ClusterDataPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              controller.DefaultPlaneName,
+			Finalizers:        []string{ClusterDataPlaneCleanupFinalizer},
+			DeletionTimestamp: &now,
+		},
+	}
+	explicit := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "ns-a"},
+		Spec: openchoreov1alpha1.EnvironmentSpec{DataPlaneRef: &openchoreov1alpha1.DataPlaneRef{
+			Kind: openchoreov1alpha1.DataPlaneRefKindClusterDataPlane, Name: controller.DefaultPlaneName,
+		}},
+	}
+	// Falls back to the default ClusterDataPlane since ns-a has no default DataPlane
+	implicit := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: "ns-a"},
+	}
+	// Resolves to the default DataPlane of ns-b and does not block the deletion
+	shadowed := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "ns-b"},
+	}
+	nsDefault := &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: controller.DefaultPlaneName, Namespace: "ns-b"},
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(cdp, explicit, implicit, shadowed, nsDefault).
+		WithStatusSubresource(&openchoreov1alpha1.ClusterDataPlane{}).
+		Build()
+	r := &Reconciler{Client: c, Scheme: scheme}
+
+	result, err := r.finalize(ctx, cdp)
+	if err != nil {
+		t.Fatalf("finalize returned error: %v", err)
+	}
+	if result.RequeueAfter != 30*time.Second {
+		t.Errorf("RequeueAfter: got %v, want 30s", result.RequeueAfter)
+	}
+
+	fresh := &openchoreov1alpha1.ClusterDataPlane{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(cdp), fresh); err != nil {
+		t.Fatalf("failed to get clusterdataplane: %v", err)
+	}
+	cond := meta.FindStatusCondition(fresh.Status.Conditions, string(ConditionFinalizing))
+	if cond == nil || cond.Reason != string(ReasonDeletionBlocked) {
+		t.Fatalf("expected DeletionBlocked condition, got %+v", cond)
+	}
+	if !strings.Contains(cond.Message, "2 environment(s)") ||
+		!strings.Contains(cond.Message, "ns-a/dev") || !strings.Contains(cond.Message, "ns-a/staging") {
+		t.Errorf("unexpected condition message: %q", cond.Message)
+	}
+
+	// Deleting the environments lets the finalizer go through
+	for _, env := range []*openchoreov1alpha1.Environment{explicit, implicit} {
+		if err := c.Delete(ctx, env); err != nil {
+			t.Fatalf("failed to delete environment: %v", err)
+		}
+	}
+	for range 2 {
+		if _, err := r.finalize(ctx, fresh); err != nil {
+			t.Fatalf("finalize returned error: %v", err)
+		}
+	}
+	if controllerutil.ContainsFinalizer(fresh, ClusterDataPlaneCleanupFinalizer) {
+		t.Error("expected the cleanup finalizer to be removed")
+	}
+}
+
 // ---------------------------------------------------------------------------
 // ClusterDataPlaneCleanupFinalizer constant
 // ---------------------------------------------------------------------------
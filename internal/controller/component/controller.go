@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +31,9 @@ import (
 type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Quarantine stops reconciling Components whose reconciles keep failing.
+	Quarantine controller.QuarantinePolicy
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=components,verbs=get;list;watch;create;update;patch;delete
@@ -44,7 +48,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=workflows,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterworkflows,verbs=get;list;watch
-// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowruns,verbs=list;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowruns,verbs=list;watch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=projects,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=deploymentpipelines,verbs=get;list;watch
 
@@ -108,6 +112,14 @@ func (r *Reconciler) reconcileWithComponentType(ctx context.Context, comp *openc
 		}
 	}()
 
+	// An archived component keeps its definition but is neither validated nor deployed;
+	// the ReleaseBinding controller undeploys its bindings.
+	if comp.Spec.IsArchived() {
+		controller.MarkTrueCondition(comp, ConditionReady, ReasonArchived, "Component is archived")
+		logger.Info("Component is archived, skipping reconciliation", "component", comp.Name)
+		return ctrl.Result{}, nil
+	}
+
 	// Validate and fetch ComponentType
 	ct, err := r.validateAndFetchComponentType(ctx, comp)
 	if err != nil {
@@ -128,6 +140,21 @@ func (r *Reconciler) reconcileWithComponentType(ctx context.Context, comp *openc
 		return ctrl.Result{}, nil
 	}
 
+	// Validate library dependencies
+	if ok, err := r.areValidLibraries(ctx, comp); !ok {
+		// Validation failed, condition already set
+		return ctrl.Result{}, err
+	}
+
+	// Library components are built and publish versions, but are never deployed,
+	// so they have no Workload and autoDeploy does not apply.
+	if ct.Spec.IsLibrary() {
+		msg := "Library component validated; library components are not deployed"
+		controller.MarkTrueCondition(comp, ConditionReady, ReasonLibraryReady, msg)
+		logger.Info("Successfully reconciled library Component", "component", comp.Name)
+		return ctrl.Result{}, nil
+	}
+
 	// Validate and fetch Workload
 	workload, err := r.validateAndFetchWorkload(ctx, comp)
 	if err != nil {
@@ -174,6 +201,12 @@ func (r *Reconciler) reconcileWithComponentType(ctx context.Context, comp *openc
 	// Handle autoDeploy if enabled
 	if comp.Spec.AutoDeploy {
 		if err := r.handleAutoDeploy(ctx, comp, ct, workload, traits, clusterTraits, firstEnv); err != nil {
+			var blockedErr *releaseBlockedError
+			if errors.As(err, &blockedErr) {
+				controller.MarkFalseCondition(comp, ConditionReady, blockedErr.reason, blockedErr.message)
+				logger.Info("New ComponentRelease blocked by the latest workflow run", "component", comp.Name, "reason", blockedErr.message)
+				return ctrl.Result{}, nil
+			}
 			msg := fmt.Sprintf("Failed to handle autoDeploy: %v", err)
 			controller.MarkFalseCondition(comp, ConditionReady, ReasonAutoDeployFailed, msg)
 			logger.Error(err, "Failed to handle autoDeploy")
@@ -619,7 +652,7 @@ func (r *Reconciler) handleAutoDeploy(
 	if comp.Status.LatestRelease != nil && comp.Status.LatestRelease.ReleaseHash == currentHash {
 		// Hash matches, verify the ComponentRelease exists and recreate if needed
 		releaseName := comp.Status.LatestRelease.Name
-		exists, err := r.ensureComponentRelease(ctx, comp, crSpec, releaseName, currentHash)
+		exists, err := r.ensureComponentRelease(ctx, comp, crSpec, componentrelease.Annotations(workload), releaseName, currentHash)
 		if err != nil {
 			return err
 		}
@@ -648,8 +681,16 @@ func (r *Reconciler) handleAutoDeploy(
 			}(),
 			"newHash", currentHash)
 
+		blocked, err := r.releaseBlockedByWorkflowRun(ctx, comp, ct)
+		if err != nil {
+			return err
+		}
+		if blocked != nil {
+			return blocked
+		}
+
 		releaseName := fmt.Sprintf("%s-%s", comp.Name, currentHash)
-		if _, err := r.ensureComponentRelease(ctx, comp, crSpec, releaseName, currentHash); err != nil {
+		if _, err := r.ensureComponentRelease(ctx, comp, crSpec, componentrelease.Annotations(workload), releaseName, currentHash); err != nil {
 			return err
 		}
 	}
@@ -667,6 +708,7 @@ func (r *Reconciler) handleAutoDeploy(
 }
 
 // ensureComponentRelease ensures a ComponentRelease with the given name exists.
+// annotations are only set when the release is created.
 // Returns (true, nil) if the release already existed.
 // Returns (false, nil) if the release was created.
 // Returns (false, error) if there was an error checking or creating the release.
@@ -674,6 +716,7 @@ func (r *Reconciler) ensureComponentRelease(
 	ctx context.Context,
 	comp *openchoreov1alpha1.Component,
 	crSpec *openchoreov1alpha1.ComponentReleaseSpec,
+	annotations map[string]string,
 	releaseName string,
 	currentHash string,
 ) (bool, error) {
@@ -720,8 +763,9 @@ func (r *Reconciler) ensureComponentRelease(
 	// ComponentRelease doesn't exist, create it
 	componentRelease := &openchoreov1alpha1.ComponentRelease{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      releaseName,
-			Namespace: comp.Namespace,
+			Name:        releaseName,
+			Namespace:   comp.Namespace,
+			Annotations: annotations,
 		},
 		Spec: *crSpec,
 	}
@@ -796,6 +840,16 @@ func (r *Reconciler) ensureReleaseBinding(
 
 	// ReleaseBinding exists, patch the release name if different
 	if releaseBinding.Spec.ReleaseName != releaseName {
+		// Locked bindings reject deploys; the new release is deployed once the lock is
+		// removed or expires and the component is reconciled again.
+		if lock := releaseBinding.Spec.Lock; lock.Active(time.Now()) {
+			logger.Info("Skipping auto deploy of locked ReleaseBinding",
+				"binding", releaseBinding.Name,
+				"release", releaseName,
+				"reason", lock.Reason,
+				"expiresAt", lock.ExpiresAt.Time)
+			return nil
+		}
 		releaseBinding.Spec.ReleaseName = releaseName
 
 		if err := r.Update(ctx, &releaseBinding); err != nil {
@@ -836,6 +890,10 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return fmt.Errorf("failed to setup workload owner index: %w", err)
 	}
 
+	if err := r.setupLibrariesIndex(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to setup libraries index: %w", err)
+	}
+
 	// Note: ReleaseBinding owner+env index is set up in controller.SetupSharedIndexes
 
 	if err := r.setupComponentReleaseOwnerIndex(ctx, mgr); err != nil {
@@ -847,8 +905,15 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// - Component owner project index (used by Project and Component controllers)
 	// - Project deploymentPipelineRef index (used by Component controller)
 
+	reconciler, err := controller.WithQuarantine(mgr, r, &openchoreov1alpha1.Component{}, r.Quarantine)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.Component{}).
+		Watches(&openchoreov1alpha1.Component{},
+			handler.EnqueueRequestsFromMapFunc(r.listComponentsForLibrary)).
 		Watches(&openchoreov1alpha1.ComponentRelease{},
 			handler.EnqueueRequestsFromMapFunc(r.findComponentsForComponentRelease)).
 		Watches(&openchoreov1alpha1.ReleaseBinding{},
@@ -867,10 +932,12 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.listComponentsForClusterWorkflow)).
 		Watches(&openchoreov1alpha1.Workload{},
 			handler.EnqueueRequestsFromMapFunc(r.listComponentsForWorkload)).
+		Watches(&openchoreov1alpha1.WorkflowRun{},
+			handler.EnqueueRequestsFromMapFunc(r.findComponentsForWorkflowRun)).
 		Watches(&openchoreov1alpha1.Project{},
 			handler.EnqueueRequestsFromMapFunc(r.listComponentsForProject)).
 		Watches(&openchoreov1alpha1.DeploymentPipeline{},
 			handler.EnqueueRequestsFromMapFunc(r.listComponentsForDeploymentPipeline)).
 		Named("component").
-		Complete(r)
+		Complete(reconciler)
 }
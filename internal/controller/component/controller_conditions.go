@@ -34,6 +34,14 @@ const (
 	// Used when autoDeploy is enabled
 	ReasonComponentReleaseReady controller.ConditionReason = "ComponentReleaseReady"
 
+	// ReasonLibraryReady indicates a library Component has been successfully validated.
+	// Library components are never deployed, so autoDeploy does not apply to them
+	ReasonLibraryReady controller.ConditionReason = "LibraryReady"
+
+	// ReasonArchived indicates the Component is archived. Its release bindings are undeployed
+	// and no ComponentReleases are created for it
+	ReasonArchived controller.ConditionReason = "Archived"
+
 	// Configuration issues (Status=False)
 
 	// ReasonWorkloadNotFound indicates the referenced Workload doesn't exist
@@ -52,11 +60,21 @@ const (
 	ReasonWorkflowNotAllowed controller.ConditionReason = "WorkflowNotAllowed"
 	// ReasonWorkflowNotFound indicates the referenced Workflow doesn't exist
 	ReasonWorkflowNotFound controller.ConditionReason = "WorkflowNotFound"
+	// ReasonLibraryNotFound indicates a library dependency references a Component that doesn't exist
+	ReasonLibraryNotFound controller.ConditionReason = "LibraryNotFound"
+	// ReasonLibraryVersionNotFound indicates a library dependency references a version that was not published
+	ReasonLibraryVersionNotFound controller.ConditionReason = "LibraryVersionNotFound"
 
 	// AutoDeploy issues (Status=False)
 
 	// ReasonAutoDeployFailed indicates failure to handle autoDeploy (ComponentRelease/ReleaseBinding creation)
 	ReasonAutoDeployFailed controller.ConditionReason = "AutoDeployFailed"
+	// ReasonReleaseBlockedByTests indicates a new ComponentRelease was not created because the
+	// tests of the latest workflow run failed and the workflow blocks releases on failed tests
+	ReasonReleaseBlockedByTests controller.ConditionReason = "ReleaseBlockedByTests"
+	// ReasonReleaseBlockedByQualityGate indicates a new ComponentRelease was not created because
+	// the static analysis findings of the latest workflow run exceed the configured quality gate
+	ReasonReleaseBlockedByQualityGate controller.ConditionReason = "ReleaseBlockedByQualityGate"
 
 	// ReasonFinalizing indicates the Component is being finalized
 	ReasonFinalizing controller.ConditionReason = "Finalizing"
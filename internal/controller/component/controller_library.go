@@ -0,0 +1,112 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// librariesIndex is the field index name for the library components a component depends on
+const librariesIndex = "spec.libraries"
+
+// setupLibrariesIndex sets up the field index for library dependencies.
+// Index key format: "{componentName}" of the library component.
+func (r *Reconciler) setupLibrariesIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &openchoreov1alpha1.Component{},
+		librariesIndex, func(obj client.Object) []string {
+			comp := obj.(*openchoreov1alpha1.Component)
+			keys := make([]string, 0, len(comp.Spec.Libraries))
+			for _, lib := range comp.Spec.Libraries {
+				keys = append(keys, lib.Component)
+			}
+			return keys
+		})
+}
+
+// listComponentsForLibrary returns reconcile requests for all Components that depend on this
+// library Component, so that they are revalidated when it publishes a version.
+func (r *Reconciler) listComponentsForLibrary(ctx context.Context, obj client.Object) []reconcile.Request {
+	library := obj.(*openchoreov1alpha1.Component)
+	if !library.Spec.IsLibrary() {
+		return nil
+	}
+
+	var components openchoreov1alpha1.ComponentList
+	if err := r.List(ctx, &components,
+		client.InNamespace(library.Namespace),
+		client.MatchingFields{librariesIndex: library.Name}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list Components depending on library", "library", library.Name)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(components.Items))
+	for i, comp := range components.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: comp.Name, Namespace: comp.Namespace},
+		}
+	}
+	return requests
+}
+
+// areValidLibraries validates that every library dependency of the component references a
+// library component in the expected project that has published the referenced version.
+// Returns true if validation passes, false if it fails (with condition set).
+func (r *Reconciler) areValidLibraries(ctx context.Context, comp *openchoreov1alpha1.Component) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	for _, dep := range comp.Spec.Libraries {
+		project := dep.Project
+		if project == "" {
+			project = comp.Spec.Owner.ProjectName
+		}
+
+		var reason controller.ConditionReason
+		var msg string
+		library := &openchoreov1alpha1.Component{}
+		err := r.Get(ctx, types.NamespacedName{Name: dep.Component, Namespace: comp.Namespace}, library)
+		switch {
+		case apierrors.IsNotFound(err):
+			reason, msg = ReasonLibraryNotFound, fmt.Sprintf("Library component %q not found", dep.Component)
+		case err != nil:
+			logger.Error(err, "Failed to get library Component", "library", dep.Component)
+			return false, err
+		default:
+			reason, msg = validateLibraryDependency(comp, library, project, dep.Version)
+		}
+		if msg != "" {
+			controller.MarkFalseCondition(comp, ConditionReady, reason, msg)
+			logger.Info(msg, "component", comp.Name)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// validateLibraryDependency checks a fetched library component against a dependency on it.
+// Returns an empty message when the dependency is satisfied.
+func validateLibraryDependency(comp, library *openchoreov1alpha1.Component, project, version string) (controller.ConditionReason, string) {
+	switch {
+	case library.Name == comp.Name:
+		return ReasonInvalidConfiguration, fmt.Sprintf("Component %q cannot depend on itself", comp.Name)
+	case library.Spec.Owner.ProjectName != project:
+		return ReasonLibraryNotFound, fmt.Sprintf("Library component %q not found in project %q", library.Name, project)
+	case !library.Spec.IsLibrary():
+		return ReasonInvalidConfiguration, fmt.Sprintf("Component %q is not a library (component type %q)",
+			library.Name, library.Spec.ComponentType.Name)
+	case library.Status.FindLibraryVersion(version) == nil:
+		return ReasonLibraryVersionNotFound, fmt.Sprintf("Library component %q has not published version %q", library.Name, version)
+	}
+	return "", ""
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/analysisreport"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/testreport"
+)
+
+// releaseBlockedError is returned by handleAutoDeploy when a new ComponentRelease is not
+// created because the latest workflow run failed its tests or the quality gate
+type releaseBlockedError struct {
+	reason  controller.ConditionReason
+	message string
+}
+
+func (e *releaseBlockedError) Error() string {
+	return e.message
+}
+
+// releaseBlockedByWorkflowRun checks the most recent completed workflow run of the component
+// against its test failure policy and the analysis quality gates of the ComponentType and
+// Project. Returns nil when release creation is allowed.
+func (r *Reconciler) releaseBlockedByWorkflowRun(ctx context.Context, comp *openchoreov1alpha1.Component,
+	ct *openchoreov1alpha1.ComponentType) (*releaseBlockedError, error) {
+	if comp.Spec.Workflow == nil {
+		return nil, nil
+	}
+
+	var runs openchoreov1alpha1.WorkflowRunList
+	if err := r.List(ctx, &runs, client.InNamespace(comp.Namespace), client.MatchingLabels{
+		labels.LabelKeyProjectName:   comp.Spec.Owner.ProjectName,
+		labels.LabelKeyComponentName: comp.Name,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+	latest := testreport.LatestCompletedRun(runs.Items)
+	if msg := testreport.ReleaseBlockedMessage(latest); msg != "" {
+		return &releaseBlockedError{reason: ReasonReleaseBlockedByTests, message: msg}, nil
+	}
+	if latest == nil || latest.Status.AnalysisResults == nil {
+		return nil, nil
+	}
+
+	project := &openchoreov1alpha1.Project{}
+	if err := r.Get(ctx, types.NamespacedName{Name: comp.Spec.Owner.ProjectName, Namespace: comp.Namespace}, project); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get project: %w", err)
+		}
+	}
+	if msg := analysisreport.ReleaseBlockedMessage(latest, ct.Spec.QualityGate, project.Spec.QualityGate); msg != "" {
+		return &releaseBlockedError{reason: ReasonReleaseBlockedByQualityGate, message: msg}, nil
+	}
+	return nil, nil
+}
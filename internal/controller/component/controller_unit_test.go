@@ -161,3 +161,50 @@ func TestFindRootEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLibraryDependency(t *testing.T) {
+	makeComponent := func(name, componentType string) *openchoreov1alpha1.Component {
+		return &openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: openchoreov1alpha1.ComponentSpec{
+				Owner:         openchoreov1alpha1.ComponentOwner{ProjectName: "shop"},
+				ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: componentType},
+			},
+		}
+	}
+	comp := makeComponent("checkout", "deployment/service")
+	library := makeComponent("shared-auth", "library/go-module")
+	library.Status.LibraryVersions = []openchoreov1alpha1.LibraryVersion{
+		{Version: "1.2.0", Artifact: "ghcr.io/example/shared-auth:1.2.0"},
+	}
+
+	tests := []struct {
+		name       string
+		library    *openchoreov1alpha1.Component
+		project    string
+		version    string
+		wantReason string
+	}{
+		{name: "published version", library: library, project: "shop", version: "1.2.0"},
+		{name: "unpublished version", library: library, project: "shop", version: "2.0.0",
+			wantReason: string(ReasonLibraryVersionNotFound)},
+		{name: "library in another project", library: library, project: "billing", version: "1.2.0",
+			wantReason: string(ReasonLibraryNotFound)},
+		{name: "not a library", library: makeComponent("payments", "deployment/service"), project: "shop", version: "1.2.0",
+			wantReason: string(ReasonInvalidConfiguration)},
+		{name: "depends on itself", library: comp, project: "shop", version: "1.2.0",
+			wantReason: string(ReasonInvalidConfiguration)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, msg := validateLibraryDependency(comp, tt.library, tt.project, tt.version)
+			if string(reason) != tt.wantReason {
+				t.Errorf("reason = %q, want %q (message %q)", reason, tt.wantReason, msg)
+			}
+			if (msg == "") != (tt.wantReason == "") {
+				t.Errorf("message = %q, want a message only when the dependency is invalid", msg)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
 const (
@@ -266,6 +267,22 @@ func (r *Reconciler) listComponentsForWorkload(ctx context.Context, obj client.O
 	}}
 }
 
+// findComponentsForWorkflowRun maps a component WorkflowRun to its Component, so that
+// release gating is re-evaluated when a run reports test results
+func (r *Reconciler) findComponentsForWorkflowRun(ctx context.Context, obj client.Object) []ctrl.Request {
+	run := obj.(*openchoreov1alpha1.WorkflowRun)
+	componentName := run.Labels[labels.LabelKeyComponentName]
+	if componentName == "" || run.Status.CompletedAt == nil {
+		return nil
+	}
+	return []ctrl.Request{{
+		NamespacedName: types.NamespacedName{
+			Name:      componentName,
+			Namespace: run.Namespace,
+		},
+	}}
+}
+
 // findComponentsForComponentRelease maps a ComponentRelease to its owner Component
 func (r *Reconciler) findComponentsForComponentRelease(ctx context.Context, obj client.Object) []ctrl.Request {
 	release := obj.(*openchoreov1alpha1.ComponentRelease)
@@ -6,6 +6,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -13,6 +14,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
 // This file contains the types and functions to manage the conditions in the Kubernetes objects.
@@ -113,6 +116,34 @@ func NeedConditionUpdate(currentConditions, updatedConditions []metav1.Condition
 	return false
 }
 
+// RecordConditionTransitions appends a transition to the history for each condition whose status
+// or reason differs between the current and updated conditions, and returns the history trimmed
+// to the most recent maxHistory entries. Message-only changes are not recorded so that progress
+// messages do not flood the history. When no condition types are given, all conditions are tracked.
+func RecordConditionTransitions(history []openchoreov1alpha1.ConditionTransition, currentConditions, updatedConditions []metav1.Condition,
+	now metav1.Time, maxHistory int, types ...ConditionType) []openchoreov1alpha1.ConditionTransition {
+	for _, updated := range updatedConditions {
+		if len(types) > 0 && !slices.Contains(types, ConditionType(updated.Type)) {
+			continue
+		}
+		current := meta.FindStatusCondition(currentConditions, updated.Type)
+		if current != nil && current.Status == updated.Status && current.Reason == updated.Reason {
+			continue
+		}
+		history = append(history, openchoreov1alpha1.ConditionTransition{
+			Type:           updated.Type,
+			Status:         updated.Status,
+			Reason:         updated.Reason,
+			Message:        updated.Message,
+			TransitionTime: now,
+		})
+	}
+	if len(history) > maxHistory {
+		history = history[len(history)-maxHistory:]
+	}
+	return history
+}
+
 // UpdateStatusConditions will compare the current and updated conditions and update the status conditions if needed.
 func UpdateStatusConditions[T ConditionedObject](
 	ctx context.Context,
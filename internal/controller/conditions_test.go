@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
 func TestNeedConditionUpdate(t *testing.T) {
@@ -258,3 +260,70 @@ func TestNeedConditionUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordConditionTransitions(t *testing.T) {
+	now := metav1.Now()
+	ready := func(status metav1.ConditionStatus, reason, message string) metav1.Condition {
+		return metav1.Condition{Type: "Ready", Status: status, Reason: reason, Message: message}
+	}
+	synced := metav1.Condition{Type: "Synced", Status: metav1.ConditionTrue, Reason: "Synced"}
+
+	t.Run("records new and changed conditions", func(t *testing.T) {
+		history := RecordConditionTransitions(nil, nil,
+			[]metav1.Condition{ready(metav1.ConditionFalse, "Progressing", "rolling out"), synced}, now, 10)
+		if len(history) != 2 {
+			t.Fatalf("len(history) = %d, want 2", len(history))
+		}
+
+		history = RecordConditionTransitions(history,
+			[]metav1.Condition{ready(metav1.ConditionFalse, "Progressing", "rolling out"), synced},
+			[]metav1.Condition{ready(metav1.ConditionFalse, "Degraded", "pods are crashing"), synced}, now, 10)
+		if len(history) != 3 {
+			t.Fatalf("len(history) = %d, want 3", len(history))
+		}
+		got := history[2]
+		if got.Type != "Ready" || got.Status != metav1.ConditionFalse || got.Reason != "Degraded" ||
+			got.Message != "pods are crashing" || !got.TransitionTime.Equal(&now) {
+			t.Errorf("history[2] = %+v, want the Degraded transition", got)
+		}
+	})
+
+	t.Run("ignores message-only changes", func(t *testing.T) {
+		history := RecordConditionTransitions(nil,
+			[]metav1.Condition{ready(metav1.ConditionFalse, "Progressing", "1/3 ready")},
+			[]metav1.Condition{ready(metav1.ConditionFalse, "Progressing", "2/3 ready")}, now, 10)
+		if len(history) != 0 {
+			t.Errorf("history = %+v, want empty", history)
+		}
+	})
+
+	t.Run("tracks only the given types", func(t *testing.T) {
+		history := RecordConditionTransitions(nil, nil,
+			[]metav1.Condition{ready(metav1.ConditionTrue, "Ready", ""), synced}, now, 10, "Ready")
+		if len(history) != 1 || history[0].Type != "Ready" {
+			t.Errorf("history = %+v, want only the Ready transition", history)
+		}
+	})
+
+	t.Run("keeps the most recent transitions", func(t *testing.T) {
+		var history []openchoreov1alpha1.ConditionTransition
+		status := metav1.ConditionTrue
+		var current []metav1.Condition
+		for range 5 {
+			updated := []metav1.Condition{ready(status, string(status), "")}
+			history = RecordConditionTransitions(history, current, updated, now, 3)
+			current = updated
+			if status == metav1.ConditionTrue {
+				status = metav1.ConditionFalse
+			} else {
+				status = metav1.ConditionTrue
+			}
+		}
+		if len(history) != 3 {
+			t.Fatalf("len(history) = %d, want 3", len(history))
+		}
+		if history[2].Status != metav1.ConditionTrue || history[1].Status != metav1.ConditionFalse {
+			t.Errorf("history = %+v, want the last three transitions", history)
+		}
+	})
+}
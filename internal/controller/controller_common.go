@@ -9,7 +9,10 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -30,6 +33,14 @@ const (
 	StatusUpdateInterval = 1 * time.Minute
 )
 
+// ResyncAfter returns a result that requeues a periodic resync after the given duration.
+// The resync is queued at low priority, so the priority queue of the controller processes
+// reconciles triggered by spec changes and deploys ahead of it on busy control planes.
+// Low priority items are still processed in order once no higher priority work is queued.
+func ResyncAfter(after time.Duration) ctrl.Result {
+	return ctrl.Result{RequeueAfter: after, Priority: ptr.To(handler.LowPriority)}
+}
+
 // UpdateCondition updates or adds a condition to any resource that has a Status with Conditions
 func UpdateCondition(
 	ctx context.Context,
@@ -0,0 +1,20 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+func TestResyncAfter(t *testing.T) {
+	result := ResyncAfter(5 * time.Minute)
+	assert.Equal(t, 5*time.Minute, result.RequeueAfter)
+	require.NotNil(t, result.Priority)
+	assert.Equal(t, handler.LowPriority, *result.Priority)
+}
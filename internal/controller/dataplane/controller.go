@@ -22,6 +22,7 @@ import (
 	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/scheduling"
 )
 
 // Reconciler reconciles a DataPlane object
@@ -32,6 +33,10 @@ type Reconciler struct {
 	ClientMgr     *kubernetesClient.KubeMultiClientManager
 	GatewayClient *gatewayClient.Client // Client for notifying cluster-gateway
 	CacheVersion  string                // Cache key version prefix (e.g., "v2")
+
+	// PlaneClientProvider reaches the data plane cluster to discover its node labels.
+	// Discovery is skipped when nil.
+	PlaneClientProvider kubernetesClient.DataPlaneClientProvider
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -73,6 +78,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Validate the connection details so that a broken configuration surfaces on the
+	// Validated condition instead of when an agent first connects
+	validationErr := controller.ValidateClusterAgent(ctx, r.Client, dataPlane.Namespace, &dataPlane.Spec.ClusterAgent)
+	meta.SetStatusCondition(&dataPlane.Status.Conditions,
+		controller.NewClusterAgentValidatedCondition(dataPlane.Generation, validationErr))
+
 	// Invalidate cached Kubernetes client on UPDATE
 	// This ensures that any changes to the DataPlane CR (kubeconfig, credentials, etc.)
 	// trigger a new client to be created with the updated configuration
@@ -111,6 +122,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				logger.Error(err, "failed to get agent connection status")
 				// Don't fail reconciliation for status query errors
 			}
+			if err := r.populateNodeLabels(ctx, dataPlane); err != nil {
+				logger.Error(err, "failed to discover node labels")
+				// Don't fail reconciliation for status query errors
+			}
 		} else {
 			logger.Info("skipping immediate status poll after spec-change notification, agents may be reconnecting")
 		}
@@ -155,6 +170,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			logger.Error(err, "failed to get agent connection status")
 			// Don't fail reconciliation for status query errors
 		}
+		if err := r.populateNodeLabels(ctx, dataPlane); err != nil {
+			logger.Error(err, "failed to discover node labels")
+			// Don't fail reconciliation for status query errors
+		}
 	} else {
 		logger.Info("skipping immediate status poll after gateway notification, agents may be reconnecting")
 	}
@@ -230,6 +249,7 @@ func (r *Reconciler) notifyGateway(ctx context.Context, dataPlane *openchoreov1a
 		"event", event,
 		"planeID", effectivePlaneID,
 		"disconnectedAgents", resp.DisconnectedAgents,
+		"revokedCertificates", resp.RevokedCertificates,
 	)
 
 	return nil
@@ -302,6 +322,29 @@ func (r *Reconciler) populateAgentConnectionStatus(ctx context.Context, dataPlan
 	return nil
 }
 
+// populateNodeLabels lists the nodes of the DataPlane cluster through the connected agent
+// and records their labels in the status (without persisting to API server). Scheduling
+// policies are validated against them when components are deployed.
+func (r *Reconciler) populateNodeLabels(ctx context.Context, dataPlane *openchoreov1alpha1.DataPlane) error {
+	if r.PlaneClientProvider == nil {
+		return nil
+	}
+	if dataPlane.Status.AgentConnection == nil || !dataPlane.Status.AgentConnection.Connected {
+		return nil
+	}
+
+	dpClient, err := r.PlaneClientProvider.DataPlaneClient(dataPlane)
+	if err != nil {
+		return fmt.Errorf("failed to get data plane client: %w", err)
+	}
+	var nodes corev1.NodeList
+	if err := dpClient.List(ctx, &nodes); err != nil {
+		return fmt.Errorf("failed to list data plane nodes: %w", err)
+	}
+	dataPlane.Status.NodeLabels = scheduling.NodeLabelsFromNodes(nodes.Items)
+	return nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.Recorder == nil {
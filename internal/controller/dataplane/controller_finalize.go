@@ -6,6 +6,7 @@ package dataplane
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -44,13 +45,20 @@ func (r *Reconciler) finalize(ctx context.Context, old, dataPlane *openchoreov1a
 		return ctrl.Result{}, nil
 	}
 
-	// Block deletion if the dataplane is still referenced by any environment.
-	refCount, err := r.countReferencingEnvironments(ctx, dataPlane)
+	// Block deletion while any environment still references the dataplane. Environments
+	// annotated with a decommission action are deleted or migrated to another data plane;
+	// a deleted environment keeps the dataplane until its release bindings are torn down.
+	environments, err := r.listReferencingEnvironments(ctx, dataPlane)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to check environment references: %w", err)
 	}
-	if refCount > 0 {
-		msg := fmt.Sprintf("Deletion blocked: dataplane is still referenced by %d environment(s)", refCount)
+	pending, err := controller.DecommissionEnvironments(ctx, r.Client, environments)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(pending) > 0 {
+		msg := fmt.Sprintf("Deletion blocked: dataplane is still referenced by %d environment(s): %s",
+			len(pending), strings.Join(pending, ", "))
 		logger.Info(msg)
 		if meta.SetStatusCondition(&dataPlane.Status.Conditions, NewDeletionBlockedCondition(dataPlane.Generation, msg)) {
 			if err := controller.UpdateStatusConditions(ctx, r.Client, old, dataPlane); err != nil {
@@ -95,8 +103,8 @@ func (r *Reconciler) finalize(ctx context.Context, old, dataPlane *openchoreov1a
 	return ctrl.Result{}, nil
 }
 
-// countReferencingEnvironments returns the number of environments in the same namespace that reference this dataplane.
-func (r *Reconciler) countReferencingEnvironments(ctx context.Context, dataPlane *openchoreov1alpha1.DataPlane) (int, error) {
+// listReferencingEnvironments returns the environments in the same namespace that reference this dataplane.
+func (r *Reconciler) listReferencingEnvironments(ctx context.Context, dataPlane *openchoreov1alpha1.DataPlane) ([]openchoreov1alpha1.Environment, error) {
 	environmentsList := &openchoreov1alpha1.EnvironmentList{}
 	if err := r.List(ctx, environmentsList,
 		client.InNamespace(dataPlane.Namespace),
@@ -104,8 +112,8 @@ func (r *Reconciler) countReferencingEnvironments(ctx context.Context, dataPlane
 			dataplaneRefIndexKey: dataPlane.Name,
 		},
 	); err != nil {
-		return 0, fmt.Errorf("failed to list environments: %w", err)
+		return nil, fmt.Errorf("failed to list environments: %w", err)
 	}
 
-	return len(environmentsList.Items), nil
+	return environmentsList.Items, nil
 }
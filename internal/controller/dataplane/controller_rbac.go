@@ -9,3 +9,7 @@ package dataplane
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments/status,verbs=get;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=renderedreleases,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
@@ -7,7 +7,10 @@ import (
 	"context"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
@@ -147,6 +150,52 @@ func TestPopulateAgentConnectionStatus_NilGateway(t *testing.T) {
 	}
 }
 
+// fakeDataPlaneClientProvider returns the same client for every data plane.
+type fakeDataPlaneClientProvider struct {
+	client client.Client
+}
+
+func (f *fakeDataPlaneClientProvider) DataPlaneClient(_ *openchoreov1alpha1.DataPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakeDataPlaneClientProvider) ClusterDataPlaneClient(_ *openchoreov1alpha1.ClusterDataPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func TestPopulateNodeLabels(t *testing.T) {
+	dpClient := fake.NewClientBuilder().WithObjects(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: map[string]string{"pool": "prod"}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n2", Labels: map[string]string{"pool": "general"}}},
+	).Build()
+	r := &Reconciler{PlaneClientProvider: &fakeDataPlaneClientProvider{client: dpClient}}
+
+	t.Run("skipped while no agent is connected", func(t *testing.T) {
+		dp := &openchoreov1alpha1.DataPlane{}
+		if err := r.populateNodeLabels(context.Background(), dp); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if dp.Status.NodeLabels != nil {
+			t.Errorf("expected no node labels, got %v", dp.Status.NodeLabels)
+		}
+	})
+
+	t.Run("records labels of connected data plane", func(t *testing.T) {
+		dp := &openchoreov1alpha1.DataPlane{Status: openchoreov1alpha1.DataPlaneStatus{
+			AgentConnection: &openchoreov1alpha1.AgentConnectionStatus{Connected: true},
+		}}
+		if err := r.populateNodeLabels(context.Background(), dp); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(dp.Status.NodeLabels) != 1 || dp.Status.NodeLabels[0].Key != "pool" {
+			t.Fatalf("expected a single pool label, got %v", dp.Status.NodeLabels)
+		}
+		if got := dp.Status.NodeLabels[0].Values; len(got) != 2 || got[0] != "general" || got[1] != "prod" {
+			t.Errorf("expected values [general prod], got %v", got)
+		}
+	})
+}
+
 func TestNewDeletionBlockedCondition(t *testing.T) {
 	tests := []struct {
 		name       string
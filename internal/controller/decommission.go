@@ -0,0 +1,198 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// DecommissionActionDelete deletes the environment.
+	DecommissionActionDelete = "delete"
+
+	// DecommissionActionMigratePrefix prefixes the data plane an environment is migrated to.
+	DecommissionActionMigratePrefix = "migrate:"
+
+	// ReasonDataPlaneMigrating indicates the resources of a release were removed from the data
+	// plane its environment is migrating away from, and are applied again once the environment
+	// references the migration target.
+	ReasonDataPlaneMigrating ConditionReason = "DataPlaneMigrating"
+)
+
+// ParseDecommissionAction parses the value of the decommission action annotation.
+// It returns a nil reference for the delete action and the target data plane for a migration.
+func ParseDecommissionAction(value string) (*openchoreov1alpha1.DataPlaneRef, error) {
+	if value == DecommissionActionDelete {
+		return nil, nil
+	}
+	target, ok := strings.CutPrefix(value, DecommissionActionMigratePrefix)
+	if !ok {
+		return nil, fmt.Errorf("unsupported decommission action %q: must be %q or %q<Kind>/<name>",
+			value, DecommissionActionDelete, DecommissionActionMigratePrefix)
+	}
+	kind, name, ok := strings.Cut(target, "/")
+	if !ok || name == "" {
+		return nil, fmt.Errorf("invalid migration target %q: must be <Kind>/<name>", target)
+	}
+	switch openchoreov1alpha1.DataPlaneRefKind(kind) {
+	case openchoreov1alpha1.DataPlaneRefKindDataPlane, openchoreov1alpha1.DataPlaneRefKindClusterDataPlane:
+	default:
+		return nil, fmt.Errorf("invalid migration target kind %q: must be %s or %s",
+			kind, openchoreov1alpha1.DataPlaneRefKindDataPlane, openchoreov1alpha1.DataPlaneRefKindClusterDataPlane)
+	}
+	return &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKind(kind), Name: name}, nil
+}
+
+// DecommissionEnvironments applies the decommission action of every environment that still
+// references a data plane being deleted. It returns a description of each environment that
+// keeps the data plane in use: environments that are still being deleted, environments that
+// have no (or an invalid) action yet, and environments whose migration target is not usable.
+// Environments are migrated once their releases are removed from the data plane; until then
+// they are returned as pending. Migrated environments no longer reference the data plane and
+// are not returned.
+func DecommissionEnvironments(
+	ctx context.Context,
+	c client.Client,
+	environments []openchoreov1alpha1.Environment,
+) ([]string, error) {
+	var pending []string
+	for i := range environments {
+		env := &environments[i]
+		if !env.DeletionTimestamp.IsZero() {
+			pending = append(pending, fmt.Sprintf("%s/%s (deleting)", env.Namespace, env.Name))
+			continue
+		}
+
+		value, ok := env.Annotations[AnnotationKeyDecommissionAction]
+		if !ok {
+			pending = append(pending, fmt.Sprintf("%s/%s (no %s annotation)", env.Namespace, env.Name, AnnotationKeyDecommissionAction))
+			continue
+		}
+		target, err := ParseDecommissionAction(value)
+		if err != nil {
+			pending = append(pending, fmt.Sprintf("%s/%s (%v)", env.Namespace, env.Name, err))
+			continue
+		}
+
+		if target == nil {
+			if err := c.Delete(ctx, env); client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("failed to delete environment %s/%s: %w", env.Namespace, env.Name, err)
+			}
+			pending = append(pending, fmt.Sprintf("%s/%s (deleting)", env.Namespace, env.Name))
+			continue
+		}
+
+		if reason := checkMigrationTarget(ctx, c, env, target); reason != "" {
+			pending = append(pending, fmt.Sprintf("%s/%s (%s)", env.Namespace, env.Name, reason))
+			continue
+		}
+		reason, err := migrateEnvironment(ctx, c, env, target)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			pending = append(pending, fmt.Sprintf("%s/%s (%s)", env.Namespace, env.Name, reason))
+		}
+	}
+	return pending, nil
+}
+
+// checkMigrationTarget returns why an environment cannot be migrated to the target data
+// plane, or an empty string when it can.
+func checkMigrationTarget(
+	ctx context.Context,
+	c client.Client,
+	env *openchoreov1alpha1.Environment,
+	target *openchoreov1alpha1.DataPlaneRef,
+) string {
+	if env.Spec.DataPlaneRef != nil && *env.Spec.DataPlaneRef == *target {
+		return "migration target is the data plane being deleted"
+	}
+	result, err := GetDataPlaneFromRef(ctx, c, env.Namespace, target)
+	if err != nil {
+		return fmt.Sprintf("migration target unavailable: %v", err)
+	}
+	if result.DataPlane != nil && !result.DataPlane.DeletionTimestamp.IsZero() ||
+		result.ClusterDataPlane != nil && !result.ClusterDataPlane.DeletionTimestamp.IsZero() {
+		return "migration target is being deleted"
+	}
+	return ""
+}
+
+// DataPlaneMigrationPending reports whether the environment is migrating to another data
+// plane that its dataPlaneRef does not reference yet. Releases of the environment are removed
+// from the current data plane instead of being applied while the migration is pending.
+func DataPlaneMigrationPending(env *openchoreov1alpha1.Environment) bool {
+	target := env.Status.DataPlaneMigration
+	return target != nil && (env.Spec.DataPlaneRef == nil || *env.Spec.DataPlaneRef != *target)
+}
+
+// migrateEnvironment points the environment at the target data plane. The target is first
+// recorded in the status, which is what allows the otherwise immutable dataPlaneRef to change
+// and what makes the RenderedRelease controller remove the releases of the environment from
+// the decommissioned data plane. The dataPlaneRef is changed only once every release is
+// removed; until then the returned reason describes the releases still deployed.
+func migrateEnvironment(
+	ctx context.Context,
+	c client.Client,
+	env *openchoreov1alpha1.Environment,
+	target *openchoreov1alpha1.DataPlaneRef,
+) (string, error) {
+	if env.Status.DataPlaneMigration == nil || *env.Status.DataPlaneMigration != *target {
+		statusPatch := client.MergeFrom(env.DeepCopy())
+		env.Status.DataPlaneMigration = target.DeepCopy()
+		if err := c.Status().Patch(ctx, env, statusPatch); err != nil {
+			return "", fmt.Errorf("failed to record migration of environment %s/%s: %w", env.Namespace, env.Name, err)
+		}
+	}
+
+	deployed, err := countDeployedReleases(ctx, c, env)
+	if err != nil {
+		return "", err
+	}
+	if deployed > 0 {
+		return fmt.Sprintf("removing %d release(s) from the data plane", deployed), nil
+	}
+
+	patch := client.MergeFrom(env.DeepCopy())
+	env.Spec.DataPlaneRef = target.DeepCopy()
+	delete(env.Annotations, AnnotationKeyDecommissionAction)
+	if err := c.Patch(ctx, env, patch); err != nil {
+		return "", fmt.Errorf("failed to migrate environment %s/%s: %w", env.Namespace, env.Name, err)
+	}
+	return "", nil
+}
+
+// countDeployedReleases counts the data plane releases of the environment whose resources
+// have not been removed from the data plane for the pending migration yet.
+func countDeployedReleases(ctx context.Context, c client.Client, env *openchoreov1alpha1.Environment) (int, error) {
+	releases := &openchoreov1alpha1.RenderedReleaseList{}
+	if err := c.List(ctx, releases, client.InNamespace(env.Namespace)); err != nil {
+		return 0, fmt.Errorf("failed to list releases of environment %s/%s: %w", env.Namespace, env.Name, err)
+	}
+	deployed := 0
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if release.Spec.EnvironmentName != env.Name || release.Spec.TargetPlane == openchoreov1alpha1.TargetPlaneObservabilityPlane {
+			continue
+		}
+		// Releases being deleted are removed from the data plane by their finalizer.
+		removed := release.DeletionTimestamp.IsZero() && slices.ContainsFunc(release.Status.Conditions,
+			func(cond metav1.Condition) bool {
+				return cond.Reason == string(ReasonDataPlaneMigrating) && cond.Status == metav1.ConditionFalse
+			})
+		if !removed {
+			deployed++
+		}
+	}
+	return deployed, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestParseDecommissionAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    *openchoreov1alpha1.DataPlaneRef
+		wantErr string
+	}{
+		{name: "delete", value: "delete"},
+		{
+			name:  "migrate to DataPlane",
+			value: "migrate:DataPlane/secondary",
+			want:  &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane, Name: "secondary"},
+		},
+		{
+			name:  "migrate to ClusterDataPlane",
+			value: "migrate:ClusterDataPlane/default",
+			want:  &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindClusterDataPlane, Name: "default"},
+		},
+		{name: "unknown action", value: "keep", wantErr: "unsupported decommission action"},
+		{name: "missing name", value: "migrate:DataPlane/", wantErr: "must be <Kind>/<name>"},
+		{name: "missing kind", value: "migrate:secondary", wantErr: "must be <Kind>/<name>"},
+		{name: "unknown kind", value: "migrate:WorkflowPlane/default", wantErr: "invalid migration target kind"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDecommissionAction(tt.value)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecommissionEnvironments(t *testing.T) {
+	ctx := context.Background()
+	scheme := newScheme(t)
+
+	deleting := metav1.Now()
+	oldRef := &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane, Name: "old"}
+	newEnv := func(name, action string) *openchoreov1alpha1.Environment {
+		env := &openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec:       openchoreov1alpha1.EnvironmentSpec{DataPlaneRef: oldRef.DeepCopy()},
+		}
+		if action != "" {
+			env.Annotations = map[string]string{AnnotationKeyDecommissionAction: action}
+		}
+		return env
+	}
+
+	undecided := newEnv("undecided", "")
+	invalid := newEnv("invalid", "keep")
+	toDelete := newEnv("to-delete", "delete")
+	toMigrate := newEnv("to-migrate", "migrate:ClusterDataPlane/shared")
+	toMissing := newEnv("to-missing", "migrate:DataPlane/missing")
+	toSelf := newEnv("to-self", "migrate:DataPlane/old")
+	terminating := newEnv("terminating", "delete")
+	terminating.Finalizers = []string{"test/finalizer"}
+	terminating.DeletionTimestamp = &deleting
+
+	newRelease := func(name, envName, targetPlane string) *openchoreov1alpha1.RenderedRelease {
+		return &openchoreov1alpha1.RenderedRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec:       openchoreov1alpha1.RenderedReleaseSpec{EnvironmentName: envName, TargetPlane: targetPlane},
+		}
+	}
+	deployed := newRelease("deployed", "to-migrate", "")
+	observability := newRelease("observability", "to-migrate", openchoreov1alpha1.TargetPlaneObservabilityPlane)
+	otherEnv := newRelease("other-env", "to-missing", openchoreov1alpha1.TargetPlaneDataPlane)
+
+	objects := []client.Object{
+		&openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: "ns"}},
+		&openchoreov1alpha1.ClusterDataPlane{ObjectMeta: metav1.ObjectMeta{Name: "shared"}},
+		undecided, invalid, toDelete, toMigrate, toMissing, toSelf, terminating,
+		deployed, observability, otherEnv,
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&openchoreov1alpha1.Environment{}, &openchoreov1alpha1.RenderedRelease{}).
+		Build()
+
+	var envs openchoreov1alpha1.EnvironmentList
+	require.NoError(t, c.List(ctx, &envs))
+
+	pending, err := DecommissionEnvironments(ctx, c, envs.Items)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"ns/undecided (no openchoreo.dev/decommission-action annotation)",
+		`ns/invalid (unsupported decommission action "keep": must be "delete" or "migrate:"<Kind>/<name>)`,
+		"ns/to-delete (deleting)",
+		"ns/to-missing (migration target unavailable: DataPlane 'missing' not found in namespace 'ns': " +
+			`dataplanes.openchoreo.dev "missing" not found)`,
+		"ns/to-self (migration target is the data plane being deleted)",
+		"ns/terminating (deleting)",
+		"ns/to-migrate (removing 1 release(s) from the data plane)",
+	}, pending)
+
+	err = c.Get(ctx, client.ObjectKeyFromObject(toDelete), &openchoreov1alpha1.Environment{})
+	assert.True(t, apierrors.IsNotFound(err), "environment with the delete action is deleted")
+
+	// The environment stays on the data plane until its releases are removed from it
+	migrating := &openchoreov1alpha1.Environment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(toMigrate), migrating))
+	want := &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindClusterDataPlane, Name: "shared"}
+	assert.Equal(t, oldRef, migrating.Spec.DataPlaneRef)
+	assert.Equal(t, want, migrating.Status.DataPlaneMigration)
+	assert.True(t, DataPlaneMigrationPending(migrating))
+
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(deployed), deployed))
+	deployed.Status.Conditions = []metav1.Condition{{
+		Type:               "ResourcesApplied",
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonDataPlaneMigrating),
+		LastTransitionTime: metav1.Now(),
+	}}
+	require.NoError(t, c.Status().Update(ctx, deployed))
+
+	pending, err = DecommissionEnvironments(ctx, c, []openchoreov1alpha1.Environment{*migrating})
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	migrated := &openchoreov1alpha1.Environment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(toMigrate), migrated))
+	assert.Equal(t, want, migrated.Spec.DataPlaneRef)
+	assert.Equal(t, want, migrated.Status.DataPlaneMigration)
+	assert.NotContains(t, migrated.Annotations, AnnotationKeyDecommissionAction)
+	assert.False(t, DataPlaneMigrationPending(migrated))
+
+	unchanged := &openchoreov1alpha1.Environment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(toMissing), unchanged))
+	assert.Equal(t, oldRef, unchanged.Spec.DataPlaneRef)
+	assert.Nil(t, unchanged.Status.DataPlaneMigration)
+}
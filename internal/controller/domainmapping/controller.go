@@ -0,0 +1,447 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmapping
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+const (
+	// challengeRecordPrefix is prepended to the domain to form the name of the TXT record
+	// that proves ownership of the domain.
+	challengeRecordPrefix = "_openchoreo-challenge."
+	// challengeValuePrefix is prepended to the token of a mapping to form the value of its
+	// TXT record.
+	challengeValuePrefix = "openchoreo-domain-verification="
+	// verificationInterval is how often the TXT record of an unverified domain is looked up.
+	verificationInterval = time.Minute
+	// certificateInterval is how often the certificate of a verified domain is rechecked
+	// until it is issued.
+	certificateInterval = 30 * time.Second
+	// lookupTimeout is the timeout of a TXT record lookup.
+	lookupTimeout = 10 * time.Second
+
+	// indexKeyDomain indexes DomainMappings by their domain to find conflicting claims.
+	indexKeyDomain = "domainmapping.spec.domain"
+
+	certificateKind  = "Certificate"
+	certManagerGroup = "cert-manager.io"
+)
+
+// Condition types and reasons for DomainMapping.
+const (
+	// ConditionVerified indicates whether ownership of the domain has been proven.
+	ConditionVerified controller.ConditionType = "Verified"
+	// ConditionCertificateReady indicates whether the certificate of the domain has been issued.
+	ConditionCertificateReady controller.ConditionType = "CertificateReady"
+	// ConditionReady indicates whether the endpoint is served under the domain.
+	ConditionReady controller.ConditionType = "Ready"
+
+	// ReasonDomainVerified indicates the TXT record of the domain was found.
+	ReasonDomainVerified controller.ConditionReason = "DomainVerified"
+	// ReasonVerificationPending indicates the TXT record of the domain was not found yet.
+	ReasonVerificationPending controller.ConditionReason = "VerificationPending"
+	// ReasonDomainConflict indicates an older DomainMapping claims the same domain.
+	ReasonDomainConflict controller.ConditionReason = "DomainConflict"
+	// ReasonCustomDomainsDisabled indicates the environment does not allow custom domains.
+	ReasonCustomDomainsDisabled controller.ConditionReason = "CustomDomainsDisabled"
+	// ReasonEnvironmentNotFound indicates the environment of the mapping does not exist.
+	ReasonEnvironmentNotFound controller.ConditionReason = "EnvironmentNotFound"
+	// ReasonEndpointNotServed indicates no external route is deployed for the endpoint.
+	ReasonEndpointNotServed controller.ConditionReason = "EndpointNotServed"
+	// ReasonCertificatePending indicates the certificate of the domain is not issued yet.
+	ReasonCertificatePending controller.ConditionReason = "CertificatePending"
+	// ReasonCertificateIssued indicates the certificate of the domain is issued.
+	ReasonCertificateIssued controller.ConditionReason = "CertificateIssued"
+	// ReasonDomainReady indicates the endpoint is served under the domain.
+	ReasonDomainReady controller.ConditionReason = "DomainReady"
+)
+
+// TXTResolver looks up DNS TXT records. *net.Resolver implements it.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Reconciler reconciles a DomainMapping object. It verifies ownership of the domain with a
+// DNS TXT challenge and reports the certificate issued for it once the ReleaseBinding of the
+// component serves the endpoint under the domain.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// Resolver looks up the TXT records of the domains. Defaults to net.DefaultResolver.
+	Resolver TXTResolver
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=domainmappings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=domainmappings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=renderedreleases,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	dm := &openchoreov1alpha1.DomainMapping{}
+	if err := r.Get(ctx, req.NamespacedName, dm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get DomainMapping")
+		return ctrl.Result{}, err
+	}
+
+	old := dm.DeepCopy()
+	dm.Status.ObservedGeneration = dm.Generation
+
+	result, err := r.reconcileMapping(ctx, dm)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !apiequality.Semantic.DeepEqual(old.Status, dm.Status) {
+		if err := r.Status().Update(ctx, dm); err != nil {
+			logger.Error(err, "Failed to update DomainMapping status")
+			return ctrl.Result{}, err
+		}
+	}
+	return result, nil
+}
+
+// reconcileMapping updates the status of the mapping and returns when to check it again.
+func (r *Reconciler) reconcileMapping(ctx context.Context, dm *openchoreov1alpha1.DomainMapping) (ctrl.Result, error) {
+	if dm.Status.Verification == nil {
+		dm.Status.Verification = &openchoreov1alpha1.DomainVerificationStatus{}
+	}
+	dm.Status.Verification.RecordName = challengeRecordName(dm.Spec.Domain)
+	dm.Status.Verification.RecordValue = challengeRecordValue(dm)
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: dm.Namespace, Name: dm.Spec.Environment}, env); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to get Environment %q: %w", dm.Spec.Environment, err)
+		}
+		controller.MarkFalseCondition(dm, ConditionReady, ReasonEnvironmentNotFound,
+			fmt.Sprintf("Environment %q not found", dm.Spec.Environment))
+		return controller.ResyncAfter(verificationInterval), nil
+	}
+	if env.Spec.CustomDomains == nil {
+		controller.MarkFalseCondition(dm, ConditionReady, ReasonCustomDomainsDisabled,
+			fmt.Sprintf("Environment %q does not allow custom domains", dm.Spec.Environment))
+		return controller.ResyncAfter(verificationInterval), nil
+	}
+
+	winner, err := r.domainOwner(ctx, dm)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if winner != nil {
+		msg := fmt.Sprintf("Domain %q is already claimed by DomainMapping %s/%s", dm.Spec.Domain, winner.Namespace, winner.Name)
+		dm.Status.Verification.VerifiedAt = nil
+		dm.Status.Certificate = nil
+		dm.Status.URL = ""
+		controller.MarkFalseCondition(dm, ConditionVerified, ReasonDomainConflict, msg)
+		controller.MarkFalseCondition(dm, ConditionReady, ReasonDomainConflict, msg)
+		return controller.ResyncAfter(verificationInterval), nil
+	}
+
+	if !dm.IsVerified() {
+		if !r.verify(ctx, dm) {
+			msg := fmt.Sprintf("Create a TXT record %q with the value %q",
+				dm.Status.Verification.RecordName, dm.Status.Verification.RecordValue)
+			controller.MarkFalseCondition(dm, ConditionVerified, ReasonVerificationPending, msg)
+			controller.MarkFalseCondition(dm, ConditionReady, ReasonVerificationPending, "Domain ownership is not verified yet")
+			return controller.ResyncAfter(verificationInterval), nil
+		}
+		if r.Recorder != nil {
+			r.Recorder.Eventf(dm, corev1.EventTypeNormal, string(ReasonDomainVerified),
+				"Ownership of domain %q verified", dm.Spec.Domain)
+		}
+	}
+	controller.MarkTrueCondition(dm, ConditionVerified, ReasonDomainVerified,
+		fmt.Sprintf("TXT record %q found", dm.Status.Verification.RecordName))
+	dm.Status.URL = "https://" + dm.Spec.Domain
+
+	if err := r.observeCertificate(ctx, dm); err != nil {
+		return ctrl.Result{}, err
+	}
+	if !dm.Status.Certificate.Ready {
+		return controller.ResyncAfter(certificateInterval), nil
+	}
+	controller.MarkTrueCondition(dm, ConditionReady, ReasonDomainReady,
+		fmt.Sprintf("Endpoint %q is served at %s", dm.Spec.Endpoint, dm.Status.URL))
+	return ctrl.Result{}, nil
+}
+
+// verify looks up the TXT record of the mapping and records when it was found.
+func (r *Reconciler) verify(ctx context.Context, dm *openchoreov1alpha1.DomainMapping) bool {
+	logger := log.FromContext(ctx)
+
+	lookupCtx, cancel := context.WithTimeout(ctx, lookupTimeout)
+	defer cancel()
+
+	now := metav1.Now()
+	dm.Status.Verification.LastCheckedAt = &now
+	records, err := r.resolver().LookupTXT(lookupCtx, dm.Status.Verification.RecordName)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			logger.Info("Failed to look up TXT record", "record", dm.Status.Verification.RecordName, "error", err.Error())
+		}
+		return false
+	}
+	if !slices.Contains(records, dm.Status.Verification.RecordValue) {
+		return false
+	}
+	dm.Status.Verification.VerifiedAt = &now
+	return true
+}
+
+// domainOwner returns the oldest other DomainMapping that claims the domain of the mapping,
+// or nil when the mapping owns it. Domains are unique across all namespaces.
+func (r *Reconciler) domainOwner(ctx context.Context,
+	dm *openchoreov1alpha1.DomainMapping) (*openchoreov1alpha1.DomainMapping, error) {
+	var claims openchoreov1alpha1.DomainMappingList
+	if err := r.List(ctx, &claims, client.MatchingFields{indexKeyDomain: dm.Spec.Domain}); err != nil {
+		return nil, fmt.Errorf("failed to list DomainMappings of domain %q: %w", dm.Spec.Domain, err)
+	}
+	var owner *openchoreov1alpha1.DomainMapping
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if claim.UID == dm.UID || !claim.DeletionTimestamp.IsZero() || !claimedBefore(claim, dm) {
+			continue
+		}
+		if owner == nil || claimedBefore(claim, owner) {
+			owner = claim
+		}
+	}
+	return owner, nil
+}
+
+// claimedBefore reports whether a claimed the domain before b. Mappings created in the same
+// second are ordered by namespace and name.
+func claimedBefore(a, b *openchoreov1alpha1.DomainMapping) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+// observeCertificate reports the certificate that the RenderedRelease of the component's
+// binding issues for the domain.
+func (r *Reconciler) observeCertificate(ctx context.Context, dm *openchoreov1alpha1.DomainMapping) error {
+	cert := &openchoreov1alpha1.DomainCertificateStatus{
+		SecretName: componentpipeline.DomainMappingSecretName(dm.Name),
+	}
+	dm.Status.Certificate = cert
+
+	release := &openchoreov1alpha1.RenderedRelease{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: dm.Namespace, Name: renderedReleaseName(dm)}, release)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get RenderedRelease: %w", err)
+	}
+
+	name := componentpipeline.DomainMappingResourceName(dm.Name)
+	if apierrors.IsNotFound(err) || !rendersCertificate(release, name) {
+		cert.Message = fmt.Sprintf("Endpoint %q of component %q is not deployed with external visibility in environment %q",
+			dm.Spec.Endpoint, dm.Spec.Owner.ComponentName, dm.Spec.Environment)
+		controller.MarkFalseCondition(dm, ConditionCertificateReady, ReasonEndpointNotServed, cert.Message)
+		controller.MarkFalseCondition(dm, ConditionReady, ReasonEndpointNotServed, cert.Message)
+		return nil
+	}
+
+	cert.Ready, cert.NotAfter, cert.Message = certificateState(release, name)
+	if !cert.Ready {
+		msg := "Waiting for the certificate to be issued"
+		if cert.Message != "" {
+			msg = cert.Message
+		}
+		controller.MarkFalseCondition(dm, ConditionCertificateReady, ReasonCertificatePending, msg)
+		controller.MarkFalseCondition(dm, ConditionReady, ReasonCertificatePending, msg)
+		return nil
+	}
+	controller.MarkTrueCondition(dm, ConditionCertificateReady, ReasonCertificateIssued,
+		fmt.Sprintf("Certificate stored in secret %q", cert.SecretName))
+	return nil
+}
+
+// renderedReleaseName returns the name of the data plane RenderedRelease of the component's
+// binding in the environment of the mapping.
+func renderedReleaseName(dm *openchoreov1alpha1.DomainMapping) string {
+	return fmt.Sprintf("%s-%s", dm.Spec.Owner.ComponentName, dm.Spec.Environment)
+}
+
+// rendersCertificate reports whether the release applies the Certificate of a mapping.
+func rendersCertificate(release *openchoreov1alpha1.RenderedRelease, name string) bool {
+	for _, res := range release.Spec.Resources {
+		if res.Object == nil {
+			continue
+		}
+		var obj struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+			Metadata   struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(res.Object.Raw, &obj); err != nil {
+			continue
+		}
+		if obj.Kind == certificateKind && strings.HasPrefix(obj.APIVersion, certManagerGroup+"/") && obj.Metadata.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// certificateState reads the Ready condition and expiry of a Certificate from the status of
+// the release.
+func certificateState(release *openchoreov1alpha1.RenderedRelease, name string) (ready bool, notAfter *metav1.Time, message string) {
+	for _, res := range release.Status.Resources {
+		if res.Kind != certificateKind || res.Group != certManagerGroup || res.Name != name || res.Status == nil {
+			continue
+		}
+		var status struct {
+			NotAfter   *metav1.Time `json:"notAfter,omitempty"`
+			Conditions []struct {
+				Type    string `json:"type"`
+				Status  string `json:"status"`
+				Message string `json:"message"`
+			} `json:"conditions"`
+		}
+		if err := json.Unmarshal(res.Status.Raw, &status); err != nil {
+			return false, nil, ""
+		}
+		for _, c := range status.Conditions {
+			if c.Type == "Ready" {
+				return c.Status == string(metav1.ConditionTrue), status.NotAfter, c.Message
+			}
+		}
+		return false, status.NotAfter, ""
+	}
+	return false, nil, ""
+}
+
+// challengeRecordName returns the name of the TXT record that proves ownership of a domain.
+func challengeRecordName(domain string) string {
+	return challengeRecordPrefix + domain
+}
+
+// challengeRecordValue returns the value of the TXT record of a mapping. It is derived from
+// the UID, so that a mapping recreated by someone else gets a new challenge.
+func challengeRecordValue(dm *openchoreov1alpha1.DomainMapping) string {
+	sum := sha256.Sum256([]byte(string(dm.UID) + "/" + dm.Spec.Domain))
+	return challengeValuePrefix + hex.EncodeToString(sum[:16])
+}
+
+func (r *Reconciler) resolver() TXTResolver {
+	if r.Resolver != nil {
+		return r.Resolver
+	}
+	return net.DefaultResolver
+}
+
+// findDomainMappingsForRenderedRelease maps a RenderedRelease to the DomainMappings of its
+// component in its environment, whose certificates it reports.
+func (r *Reconciler) findDomainMappingsForRenderedRelease(ctx context.Context, obj client.Object) []reconcile.Request {
+	release, ok := obj.(*openchoreov1alpha1.RenderedRelease)
+	if !ok || release.Spec.Owner.ComponentName == "" {
+		return nil
+	}
+
+	var mappings openchoreov1alpha1.DomainMappingList
+	if err := r.List(ctx, &mappings, client.InNamespace(release.Namespace)); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, dm := range mappings.Items {
+		if dm.Spec.Owner.ProjectName != release.Spec.Owner.ProjectName ||
+			dm.Spec.Owner.ComponentName != release.Spec.Owner.ComponentName ||
+			dm.Spec.Environment != release.Spec.EnvironmentName {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&dm)})
+	}
+	return requests
+}
+
+// findDomainMappingsForDomain maps a DomainMapping to the other mappings of its domain, so
+// that deleting the owner of a domain lets the next claim proceed.
+func (r *Reconciler) findDomainMappingsForDomain(ctx context.Context, obj client.Object) []reconcile.Request {
+	dm, ok := obj.(*openchoreov1alpha1.DomainMapping)
+	if !ok {
+		return nil
+	}
+
+	var claims openchoreov1alpha1.DomainMappingList
+	if err := r.List(ctx, &claims, client.MatchingFields{indexKeyDomain: dm.Spec.Domain}); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, claim := range claims.Items {
+		if claim.UID == dm.UID {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&claim)})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("domainmapping-controller")
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &openchoreov1alpha1.DomainMapping{},
+		indexKeyDomain, func(obj client.Object) []string {
+			dm := obj.(*openchoreov1alpha1.DomainMapping)
+			if dm.Spec.Domain == "" {
+				return nil
+			}
+			return []string{dm.Spec.Domain}
+		}); err != nil {
+		return fmt.Errorf("failed to setup DomainMapping domain index: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.DomainMapping{}).
+		Watches(&openchoreov1alpha1.DomainMapping{},
+			handler.EnqueueRequestsFromMapFunc(r.findDomainMappingsForDomain)).
+		Watches(&openchoreov1alpha1.RenderedRelease{},
+			handler.EnqueueRequestsFromMapFunc(r.findDomainMappingsForRenderedRelease)).
+		Named("domainmapping").
+		Complete(r)
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmapping
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+type fakeResolver map[string][]string
+
+func (f fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	return f[name], nil
+}
+
+func newTestMapping(name string, created time.Time) *openchoreov1alpha1.DomainMapping {
+	return &openchoreov1alpha1.DomainMapping{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			UID:               types.UID(name + "-uid"),
+			Generation:        1,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: openchoreov1alpha1.DomainMappingSpec{
+			Owner:       openchoreov1alpha1.DomainMappingOwner{ProjectName: "proj", ComponentName: "api"},
+			Environment: "production",
+			Endpoint:    "http",
+			Domain:      "shop.example.com",
+		},
+	}
+}
+
+func newTestEnvironment(customDomains bool) *openchoreov1alpha1.Environment {
+	env := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "default"},
+	}
+	if customDomains {
+		env.Spec.CustomDomains = &openchoreov1alpha1.CustomDomainPolicy{
+			IssuerRef: openchoreov1alpha1.CertificateIssuerRef{Name: "letsencrypt"},
+		}
+	}
+	return env
+}
+
+func newTestRenderedRelease(t *testing.T, mappingName string, certStatus map[string]any) *openchoreov1alpha1.RenderedRelease {
+	t.Helper()
+	name := componentpipeline.DomainMappingResourceName(mappingName)
+	obj, err := json.Marshal(map[string]any{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata":   map[string]any{"name": name, "namespace": "dp-proj"},
+	})
+	require.NoError(t, err)
+	release := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-production", Namespace: "default"},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{
+			Owner:           openchoreov1alpha1.RenderedReleaseOwner{ProjectName: "proj", ComponentName: "api"},
+			EnvironmentName: "production",
+			Resources:       []openchoreov1alpha1.RenderedManifest{{ID: "cert", Object: &runtime.RawExtension{Raw: obj}}},
+		},
+	}
+	if certStatus != nil {
+		raw, err := json.Marshal(certStatus)
+		require.NoError(t, err)
+		release.Status.Resources = []openchoreov1alpha1.RenderedManifestStatus{{
+			ID: "cert", Group: "cert-manager.io", Version: "v1", Kind: "Certificate", Name: name,
+			Status: &runtime.RawExtension{Raw: raw},
+		}}
+	}
+	return release
+}
+
+func newTestReconciler(t *testing.T, resolver TXTResolver, objs ...client.Object) *Reconciler {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.DomainMapping{}).
+		WithIndex(&openchoreov1alpha1.DomainMapping{}, indexKeyDomain, func(obj client.Object) []string {
+			return []string{obj.(*openchoreov1alpha1.DomainMapping).Spec.Domain}
+		}).
+		Build()
+	return &Reconciler{Client: c, Scheme: s, Recorder: record.NewFakeRecorder(10), Resolver: resolver}
+}
+
+func reconcileMapping(t *testing.T, r *Reconciler, name string) (ctrl.Result, *openchoreov1alpha1.DomainMapping) {
+	t.Helper()
+	key := types.NamespacedName{Name: name, Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	dm := &openchoreov1alpha1.DomainMapping{}
+	require.NoError(t, r.Get(context.Background(), key, dm))
+	return result, dm
+}
+
+func TestReconcile_CustomDomainsDisabled(t *testing.T) {
+	r := newTestReconciler(t, fakeResolver{},
+		newTestMapping("shop", time.Now()), newTestEnvironment(false))
+
+	_, dm := reconcileMapping(t, r, "shop")
+
+	cond := apimeta.FindStatusCondition(dm.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonCustomDomainsDisabled), cond.Reason)
+	assert.False(t, dm.IsVerified())
+}
+
+func TestReconcile_VerificationPending(t *testing.T) {
+	r := newTestReconciler(t, fakeResolver{},
+		newTestMapping("shop", time.Now()), newTestEnvironment(true))
+
+	result, dm := reconcileMapping(t, r, "shop")
+
+	assert.Equal(t, verificationInterval, result.RequeueAfter)
+	require.NotNil(t, dm.Status.Verification)
+	assert.Equal(t, "_openchoreo-challenge.shop.example.com", dm.Status.Verification.RecordName)
+	assert.Contains(t, dm.Status.Verification.RecordValue, challengeValuePrefix)
+	assert.NotNil(t, dm.Status.Verification.LastCheckedAt)
+	assert.False(t, dm.IsVerified())
+	cond := apimeta.FindStatusCondition(dm.Status.Conditions, string(ConditionVerified))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonVerificationPending), cond.Reason)
+	assert.Contains(t, cond.Message, dm.Status.Verification.RecordValue)
+}
+
+func TestReconcile_VerifiedAndCertificateIssued(t *testing.T) {
+	mapping := newTestMapping("shop", time.Now())
+	resolver := fakeResolver{
+		"_openchoreo-challenge.shop.example.com": {"unrelated", challengeRecordValue(mapping)},
+	}
+	r := newTestReconciler(t, resolver, mapping, newTestEnvironment(true),
+		newTestRenderedRelease(t, "shop", map[string]any{
+			"notAfter":   "2027-01-01T00:00:00Z",
+			"conditions": []any{map[string]any{"type": "Ready", "status": "True", "message": "Certificate is up to date"}},
+		}))
+
+	result, dm := reconcileMapping(t, r, "shop")
+
+	assert.Zero(t, result.RequeueAfter)
+	assert.True(t, dm.IsVerified())
+	assert.Equal(t, "https://shop.example.com", dm.Status.URL)
+	require.NotNil(t, dm.Status.Certificate)
+	assert.True(t, dm.Status.Certificate.Ready)
+	assert.Equal(t, componentpipeline.DomainMappingSecretName("shop"), dm.Status.Certificate.SecretName)
+	require.NotNil(t, dm.Status.Certificate.NotAfter)
+	assert.Equal(t, 2027, dm.Status.Certificate.NotAfter.Year())
+	assert.True(t, apimeta.IsStatusConditionTrue(dm.Status.Conditions, string(ConditionReady)))
+}
+
+func TestReconcile_EndpointNotServed(t *testing.T) {
+	mapping := newTestMapping("shop", time.Now())
+	resolver := fakeResolver{"_openchoreo-challenge.shop.example.com": {challengeRecordValue(mapping)}}
+	r := newTestReconciler(t, resolver, mapping, newTestEnvironment(true))
+
+	result, dm := reconcileMapping(t, r, "shop")
+
+	assert.Equal(t, certificateInterval, result.RequeueAfter)
+	assert.True(t, dm.IsVerified())
+	cond := apimeta.FindStatusCondition(dm.Status.Conditions, string(ConditionCertificateReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonEndpointNotServed), cond.Reason)
+	assert.False(t, apimeta.IsStatusConditionTrue(dm.Status.Conditions, string(ConditionReady)))
+}
+
+func TestReconcile_CertificatePending(t *testing.T) {
+	mapping := newTestMapping("shop", time.Now())
+	resolver := fakeResolver{"_openchoreo-challenge.shop.example.com": {challengeRecordValue(mapping)}}
+	r := newTestReconciler(t, resolver, mapping, newTestEnvironment(true),
+		newTestRenderedRelease(t, "shop", map[string]any{
+			"conditions": []any{map[string]any{"type": "Ready", "status": "False", "message": "Issuing certificate"}},
+		}))
+
+	_, dm := reconcileMapping(t, r, "shop")
+
+	require.NotNil(t, dm.Status.Certificate)
+	assert.False(t, dm.Status.Certificate.Ready)
+	assert.Equal(t, "Issuing certificate", dm.Status.Certificate.Message)
+	cond := apimeta.FindStatusCondition(dm.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonCertificatePending), cond.Reason)
+}
+
+func TestReconcile_DomainConflict(t *testing.T) {
+	now := time.Now()
+	older := newTestMapping("first", now.Add(-time.Hour))
+	newer := newTestMapping("second", now)
+	resolver := fakeResolver{"_openchoreo-challenge.shop.example.com": {challengeRecordValue(newer)}}
+	r := newTestReconciler(t, resolver, older, newer, newTestEnvironment(true))
+
+	_, dm := reconcileMapping(t, r, "second")
+
+	assert.False(t, dm.IsVerified(), "a domain claimed by an older mapping must not be verified")
+	cond := apimeta.FindStatusCondition(dm.Status.Conditions, string(ConditionVerified))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonDomainConflict), cond.Reason)
+	assert.Contains(t, cond.Message, "default/first")
+}
@@ -78,6 +78,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	if err := r.ensurePriorityClass(ctx, environment); err != nil {
+		logger.Error(err, "Failed to ensure PriorityClass on data plane")
+		meta.SetStatusCondition(&environment.Status.Conditions,
+			NewPriorityClassNotReadyCondition(environment.Generation, err.Error()))
+		if updateErr := controller.UpdateStatusConditions(ctx, r.Client, old, environment); updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+		return ctrl.Result{}, err
+	}
+
 	// Mark the environment as ready. Reaching this point means the environment is successfully reconciled.
 	meta.SetStatusCondition(&environment.Status.Conditions, NewEnvironmentReadyCondition(environment.Generation))
 
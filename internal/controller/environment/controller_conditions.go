@@ -25,6 +25,10 @@ const (
 	ReasonDeletionBlocked controller.ConditionReason = "DeletionBlocked"
 	// ReasonReleaseBindingsPending the environment is waiting for release bindings to be removed
 	ReasonReleaseBindingsPending controller.ConditionReason = "ReleaseBindingsPending"
+	// ReasonNamespacesTerminating the environment is waiting for its data plane namespaces to be deleted
+	ReasonNamespacesTerminating controller.ConditionReason = "NamespacesTerminating"
+	// ReasonPriorityClassNotReady the environment's PriorityClass could not be ensured on the data plane
+	ReasonPriorityClassNotReady controller.ConditionReason = "PriorityClassNotReady"
 )
 
 func NewEnvironmentReadyCondition(generation int64) metav1.Condition {
@@ -47,6 +51,16 @@ func NewEnvironmentFinalizingCondition(generation int64) metav1.Condition {
 	)
 }
 
+func NewPriorityClassNotReadyCondition(generation int64, message string) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReady,
+		metav1.ConditionFalse,
+		ReasonPriorityClassNotReady,
+		message,
+		generation,
+	)
+}
+
 func NewReleaseBindingsPendingCondition(generation int64, message string) metav1.Condition {
 	return controller.NewCondition(
 		ConditionReady,
@@ -57,6 +71,16 @@ func NewReleaseBindingsPendingCondition(generation int64, message string) metav1
 	)
 }
 
+func NewNamespacesTerminatingCondition(generation int64, message string) metav1.Condition {
+	return controller.NewCondition(
+		ConditionReady,
+		metav1.ConditionFalse,
+		ReasonNamespacesTerminating,
+		message,
+		generation,
+	)
+}
+
 func NewDeletionBlockedCondition(generation int64, message string) metav1.Condition {
 	return controller.NewCondition(
 		ConditionReady,
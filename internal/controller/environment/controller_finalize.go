@@ -6,8 +6,11 @@ package environment
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -42,10 +45,14 @@ func (r *Reconciler) ensureFinalizer(ctx context.Context, environment *openchore
 // finalize cleans up the resources associated with the environment.
 // The finalization flow is:
 //  1. Check if the environment is referenced by any DeploymentPipeline — if so, block deletion.
-//  2. Wait for all ReleaseBindings and ProjectReleaseBindings that reference this environment to be gone.
-//  3. Delete the data plane namespaces associated with the environment.
+//  2. Wait for all ReleaseBindings, ProjectReleaseBindings and ResourceReleaseBindings that
+//     reference this environment to be gone.
+//  3. Delete the data plane namespaces associated with the environment, unless the
+//     namespace deletion policy is Retain.
 //  4. Wait for namespace deletion to complete.
 //  5. Remove the finalizer to allow garbage collection.
+//
+// The progress is reported in status.teardown.
 func (r *Reconciler) finalize(ctx context.Context, old, environment *openchoreov1alpha1.Environment) (ctrl.Result, error) {
 	logger := log.FromContext(ctx).WithValues("environment", environment.Name)
 	if !controllerutil.ContainsFinalizer(environment, EnvCleanupFinalizer) {
@@ -60,18 +67,20 @@ func (r *Reconciler) finalize(ctx context.Context, old, environment *openchoreov
 	if referencingPipeline != "" {
 		msg := fmt.Sprintf("Deletion blocked: environment is referenced by DeploymentPipeline %q", referencingPipeline)
 		logger.Info(msg)
-		if meta.SetStatusCondition(&environment.Status.Conditions, NewDeletionBlockedCondition(environment.Generation, msg)) {
-			if err := controller.UpdateStatusConditions(ctx, r.Client, old, environment); err != nil {
-				return ctrl.Result{}, err
-			}
+		meta.SetStatusCondition(&environment.Status.Conditions, NewDeletionBlockedCondition(environment.Generation, msg))
+		environment.Status.Teardown = &openchoreov1alpha1.EnvironmentTeardown{Phase: openchoreov1alpha1.EnvironmentTeardownBlocked}
+		if err := r.updateTeardownStatus(ctx, old, environment); err != nil {
+			return ctrl.Result{}, err
 		}
 		// Requeue to re-evaluate once the pipeline reference is removed
 		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
 	}
 
-	// Mark the environment condition as finalizing.
-	if meta.SetStatusCondition(&environment.Status.Conditions, NewEnvironmentFinalizingCondition(environment.Generation)) {
-		if err := controller.UpdateStatusConditions(ctx, r.Client, old, environment); err != nil {
+	// Mark the environment condition as finalizing when the teardown starts.
+	if teardown := environment.Status.Teardown; teardown == nil || teardown.Phase == openchoreov1alpha1.EnvironmentTeardownBlocked {
+		meta.SetStatusCondition(&environment.Status.Conditions, NewEnvironmentFinalizingCondition(environment.Generation))
+		environment.Status.Teardown = &openchoreov1alpha1.EnvironmentTeardown{Phase: openchoreov1alpha1.EnvironmentTeardownUndeployingBindings}
+		if err := r.updateTeardownStatus(ctx, old, environment); err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, nil
@@ -90,18 +99,32 @@ func (r *Reconciler) finalize(ctx context.Context, old, environment *openchoreov
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	pendingCount := pendingReleaseBindings + pendingProjectReleaseBindings
+	pendingResourceReleaseBindings, err := r.deleteAndCountResourceReleaseBindings(ctx, environment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	pendingCount := pendingReleaseBindings + pendingProjectReleaseBindings + pendingResourceReleaseBindings
 	if pendingCount > 0 {
 		msg := fmt.Sprintf("Deleting %d release binding(s)", pendingCount)
 		logger.Info(msg)
-		if meta.SetStatusCondition(&environment.Status.Conditions, NewReleaseBindingsPendingCondition(environment.Generation, msg)) {
-			if err := controller.UpdateStatusConditions(ctx, r.Client, old, environment); err != nil {
-				return ctrl.Result{}, err
-			}
+		meta.SetStatusCondition(&environment.Status.Conditions, NewReleaseBindingsPendingCondition(environment.Generation, msg))
+		environment.Status.Teardown = &openchoreov1alpha1.EnvironmentTeardown{
+			Phase:           openchoreov1alpha1.EnvironmentTeardownUndeployingBindings,
+			PendingBindings: int32(pendingCount), //nolint:gosec // bounded by the number of bindings in a namespace
+		}
+		if err := r.updateTeardownStatus(ctx, old, environment); err != nil {
+			return ctrl.Result{}, err
 		}
 		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
 	}
 
+	if environment.Spec.NamespaceDeletionPolicy == openchoreov1alpha1.NamespaceDeletionPolicyRetain {
+		logger.Info("Retaining data plane namespaces as requested by the namespace deletion policy")
+		r.Recorder.Event(environment, corev1.EventTypeNormal, "NamespacesRetained",
+			"Data plane namespaces of the environment are retained by the namespace deletion policy")
+		return r.removeFinalizer(ctx, environment)
+	}
+
 	// Step 4 & 5: Delete data plane namespaces and wait for them to be gone.
 	// If the DataPlane is already gone, skip — the namespaces are assumed to be cleaned up with it.
 	// getDPClient handles both namespace-scoped DataPlane and cluster-scoped ClusterDataPlane refs.
@@ -134,6 +157,7 @@ func (r *Reconciler) finalize(ctx context.Context, old, environment *openchoreov
 
 	resourceHandlers := r.makeExternalResourceHandlers(dpClient)
 	pendingDeletion := false
+	var pendingNamespaces []string
 
 	for _, resourceHandler := range resourceHandlers {
 		exists, err := resourceHandler.GetCurrentState(ctx, envCtx)
@@ -145,6 +169,11 @@ func (r *Reconciler) finalize(ctx context.Context, old, environment *openchoreov
 		}
 
 		pendingDeletion = true
+		if namespaces, ok := exists.(*corev1.NamespaceList); ok {
+			for _, ns := range namespaces.Items {
+				pendingNamespaces = append(pendingNamespaces, ns.Name)
+			}
+		}
 		if err := resourceHandler.Delete(ctx, envCtx); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to delete external resource %s: %w", resourceHandler.Name(), err)
 		}
@@ -152,6 +181,16 @@ func (r *Reconciler) finalize(ctx context.Context, old, environment *openchoreov
 
 	if pendingDeletion {
 		logger.Info("Waiting for data plane namespace deletion")
+		slices.Sort(pendingNamespaces)
+		msg := fmt.Sprintf("Deleting %d data plane namespace(s)", len(pendingNamespaces))
+		meta.SetStatusCondition(&environment.Status.Conditions, NewNamespacesTerminatingCondition(environment.Generation, msg))
+		environment.Status.Teardown = &openchoreov1alpha1.EnvironmentTeardown{
+			Phase:             openchoreov1alpha1.EnvironmentTeardownDeletingNamespaces,
+			PendingNamespaces: pendingNamespaces,
+		}
+		if err := r.updateTeardownStatus(ctx, old, environment); err != nil {
+			return ctrl.Result{}, err
+		}
 		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
 	}
 
@@ -159,6 +198,19 @@ func (r *Reconciler) finalize(ctx context.Context, old, environment *openchoreov
 	return r.removeFinalizer(ctx, environment)
 }
 
+// updateTeardownStatus writes the conditions and teardown progress of an environment being
+// deleted, leaving its other status fields as they are.
+func (r *Reconciler) updateTeardownStatus(ctx context.Context, old, environment *openchoreov1alpha1.Environment) error {
+	if !controller.NeedConditionUpdate(old.Status.Conditions, environment.Status.Conditions) &&
+		equality.Semantic.DeepEqual(old.Status.Teardown, environment.Status.Teardown) {
+		return nil
+	}
+	updated := old.DeepCopy()
+	updated.Status.Conditions = environment.Status.Conditions
+	updated.Status.Teardown = environment.Status.Teardown
+	return r.Status().Update(ctx, updated)
+}
+
 // removeFinalizer removes the cleanup finalizer from the environment.
 func (r *Reconciler) removeFinalizer(ctx context.Context, environment *openchoreov1alpha1.Environment) (ctrl.Result, error) {
 	if controllerutil.RemoveFinalizer(environment, EnvCleanupFinalizer) {
@@ -285,3 +337,29 @@ func (r *Reconciler) deleteAndCountProjectReleaseBindings(ctx context.Context, e
 
 	return count, nil
 }
+
+// deleteAndCountResourceReleaseBindings deletes all resource release bindings that reference
+// this environment and returns the count of those still present (pending deletion or not yet
+// deleted).
+func (r *Reconciler) deleteAndCountResourceReleaseBindings(ctx context.Context, environment *openchoreov1alpha1.Environment) (int, error) {
+	bindingList := &openchoreov1alpha1.ResourceReleaseBindingList{}
+	if err := r.List(ctx, bindingList, client.InNamespace(environment.Namespace)); err != nil {
+		return 0, fmt.Errorf("failed to list resource release bindings: %w", err)
+	}
+
+	count := 0
+	for i := range bindingList.Items {
+		binding := &bindingList.Items[i]
+		if binding.Spec.Environment != environment.Name {
+			continue
+		}
+		count++
+		if binding.DeletionTimestamp.IsZero() {
+			if err := r.Delete(ctx, binding); err != nil && !apierrors.IsNotFound(err) {
+				return 0, fmt.Errorf("failed to delete resource release binding %s: %w", binding.Name, err)
+			}
+		}
+	}
+
+	return count, nil
+}
@@ -6,10 +6,14 @@ package environment
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
@@ -171,3 +175,114 @@ func TestFinalizePropagatesProjectReleaseBindingListError(t *testing.T) {
 		t.Fatal("expected error from ProjectReleaseBinding list failure during finalize")
 	}
 }
+
+func TestDeleteAndCountResourceReleaseBindings(t *testing.T) {
+	s := prbTestScheme(t)
+	env := &openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "ns"}}
+	binding := func(name, environment string) *openchoreov1alpha1.ResourceReleaseBinding {
+		return &openchoreov1alpha1.ResourceReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec:       openchoreov1alpha1.ResourceReleaseBindingSpec{Environment: environment},
+		}
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(binding("db-dev", "dev"), binding("db-prod", "prod")).Build()
+	r := &Reconciler{Client: cli, Scheme: s}
+
+	count, err := r.deleteAndCountResourceReleaseBindings(context.Background(), env)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("expected count 1, got %d", count)
+	}
+	if err := cli.Get(context.Background(), client.ObjectKey{Name: "db-dev", Namespace: "ns"}, &openchoreov1alpha1.ResourceReleaseBinding{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("expected the binding of the environment to be deleted, got %v", err)
+	}
+	if err := cli.Get(context.Background(), client.ObjectKey{Name: "db-prod", Namespace: "ns"}, &openchoreov1alpha1.ResourceReleaseBinding{}); err != nil {
+		t.Fatalf("other-environment binding should remain: %v", err)
+	}
+}
+
+// TestFinalizeReportsTeardownAndRetainsNamespaces walks an environment with the Retain
+// namespace deletion policy through its teardown: the pending bindings are reported in the
+// status, and once they are gone the finalizer is removed without touching the data plane.
+func TestFinalizeReportsTeardownAndRetainsNamespaces(t *testing.T) {
+	s := prbTestScheme(t)
+	env := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "dev",
+			Namespace:  "ns",
+			Finalizers: []string{EnvCleanupFinalizer},
+		},
+		Spec: openchoreov1alpha1.EnvironmentSpec{NamespaceDeletionPolicy: openchoreov1alpha1.NamespaceDeletionPolicyRetain},
+	}
+	prb := newPRBForEnv("p-dev", "dev", false)
+	prb.Finalizers = []string{"openchoreo.dev/projectreleasebinding-cleanup"}
+	cli := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(env, prb).
+		WithStatusSubresource(&openchoreov1alpha1.Environment{}).
+		WithIndex(&openchoreov1alpha1.DeploymentPipeline{}, controller.IndexKeyDeploymentPipelineEnvironmentRef,
+			func(client.Object) []string { return nil }).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{Client: cli, Scheme: s, Recorder: recorder}
+
+	ctx := context.Background()
+	if err := cli.Delete(ctx, env); err != nil {
+		t.Fatalf("delete env: %v", err)
+	}
+	finalize := func() *openchoreov1alpha1.Environment {
+		t.Helper()
+		live := &openchoreov1alpha1.Environment{}
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(env), live); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			t.Fatalf("get env: %v", err)
+		}
+		if _, err := r.finalize(ctx, live.DeepCopy(), live); err != nil {
+			t.Fatalf("finalize: %v", err)
+		}
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(env), live); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			t.Fatalf("get env: %v", err)
+		}
+		return live
+	}
+
+	live := finalize()
+	if live.Status.Teardown == nil || live.Status.Teardown.Phase != openchoreov1alpha1.EnvironmentTeardownUndeployingBindings {
+		t.Fatalf("expected the teardown to start by undeploying bindings, got %+v", live.Status.Teardown)
+	}
+
+	live = finalize()
+	if live.Status.Teardown.PendingBindings != 1 {
+		t.Fatalf("expected 1 pending binding, got %+v", live.Status.Teardown)
+	}
+	if cond := meta.FindStatusCondition(live.Status.Conditions, ConditionReady.String()); cond == nil || cond.Reason != string(ReasonReleaseBindingsPending) {
+		t.Fatalf("expected the ReleaseBindingsPending condition, got %+v", cond)
+	}
+
+	// The binding's own finalizer completes
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(prb), prb); err != nil {
+		t.Fatalf("get binding: %v", err)
+	}
+	prb.Finalizers = nil
+	if err := cli.Update(ctx, prb); err != nil {
+		t.Fatalf("remove binding finalizer: %v", err)
+	}
+
+	if live = finalize(); live != nil {
+		t.Fatalf("expected the environment to be removed, finalizers %v", live.Finalizers)
+	}
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, "NamespacesRetained") {
+			t.Errorf("unexpected event %q", event)
+		}
+	default:
+		t.Error("expected a NamespacesRetained event")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"fmt"
+
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// ensurePriorityClass creates the environment's PriorityClass on the data plane when it is
+// missing. An existing PriorityClass is left untouched because it may be shared with other
+// environments or owned by the cluster operator. Nothing is created when spec.priority.value
+// is unset; the PriorityClass is then expected to exist already.
+func (r *Reconciler) ensurePriorityClass(ctx context.Context, env *openchoreov1alpha1.Environment) error {
+	priority := env.Spec.Priority
+	if priority == nil || priority.Value == nil || r.PlaneClientProvider == nil {
+		return nil
+	}
+
+	dpClient, err := r.getDPClient(ctx, env)
+	if err != nil {
+		return err
+	}
+
+	existing := &schedulingv1.PriorityClass{}
+	err = dpClient.Get(ctx, client.ObjectKey{Name: priority.PriorityClassName}, existing)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get PriorityClass %q: %w", priority.PriorityClassName, err)
+	}
+
+	if err := dpClient.Create(ctx, makePriorityClass(env)); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create PriorityClass %q: %w", priority.PriorityClassName, err)
+	}
+	log.FromContext(ctx).Info("Created PriorityClass on data plane",
+		"priorityClass", priority.PriorityClassName, "value", *priority.Value)
+	return nil
+}
+
+func makePriorityClass(env *openchoreov1alpha1.Environment) *schedulingv1.PriorityClass {
+	priority := env.Spec.Priority
+	return &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: priority.PriorityClassName,
+			Labels: map[string]string{
+				labels.LabelKeyManagedBy:       labels.LabelValueManagedBy,
+				labels.LabelKeyNamespaceName:   env.Namespace,
+				labels.LabelKeyEnvironmentName: env.Name,
+			},
+		},
+		Value:            *priority.Value,
+		PreemptionPolicy: priority.PreemptionPolicy,
+		Description:      fmt.Sprintf("Created by OpenChoreo for environment %s/%s", env.Namespace, env.Name),
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// fakeDataPlaneClientProvider returns the same client for every data plane.
+type fakeDataPlaneClientProvider struct {
+	client client.Client
+}
+
+func (f *fakeDataPlaneClientProvider) DataPlaneClient(_ *openchoreov1alpha1.DataPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakeDataPlaneClientProvider) ClusterDataPlaneClient(_ *openchoreov1alpha1.ClusterDataPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func newPriorityEnv(priority *openchoreov1alpha1.EnvironmentPriority) *openchoreov1alpha1.Environment {
+	return &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "ns"},
+		Spec: openchoreov1alpha1.EnvironmentSpec{
+			DataPlaneRef: &openchoreov1alpha1.DataPlaneRef{
+				Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane,
+				Name: "dp",
+			},
+			Priority: priority,
+		},
+	}
+}
+
+func TestEnsurePriorityClass(t *testing.T) {
+	s := prbTestScheme(t)
+	dp := &openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "dp", Namespace: "ns"}}
+	newReconciler := func(dpClient client.Client) *Reconciler {
+		cli := fake.NewClientBuilder().WithScheme(s).WithObjects(dp).Build()
+		return &Reconciler{Client: cli, Scheme: s, PlaneClientProvider: &fakeDataPlaneClientProvider{client: dpClient}}
+	}
+
+	t.Run("creates a missing PriorityClass", func(t *testing.T) {
+		dpClient := fake.NewClientBuilder().Build()
+		env := newPriorityEnv(&openchoreov1alpha1.EnvironmentPriority{
+			PriorityClassName: "production-high",
+			Value:             ptr.To[int32](100000),
+			PreemptionPolicy:  ptr.To(corev1.PreemptNever),
+		})
+
+		if err := newReconciler(dpClient).ensurePriorityClass(context.Background(), env); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pc := &schedulingv1.PriorityClass{}
+		if err := dpClient.Get(context.Background(), client.ObjectKey{Name: "production-high"}, pc); err != nil {
+			t.Fatalf("expected PriorityClass to be created: %v", err)
+		}
+		if pc.Value != 100000 {
+			t.Errorf("expected value 100000, got %d", pc.Value)
+		}
+		if pc.PreemptionPolicy == nil || *pc.PreemptionPolicy != corev1.PreemptNever {
+			t.Errorf("expected preemptionPolicy Never, got %v", pc.PreemptionPolicy)
+		}
+	})
+
+	t.Run("leaves an existing PriorityClass untouched", func(t *testing.T) {
+		dpClient := fake.NewClientBuilder().WithObjects(&schedulingv1.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "production-high"},
+			Value:      5,
+		}).Build()
+		env := newPriorityEnv(&openchoreov1alpha1.EnvironmentPriority{
+			PriorityClassName: "production-high",
+			Value:             ptr.To[int32](100000),
+		})
+
+		if err := newReconciler(dpClient).ensurePriorityClass(context.Background(), env); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pc := &schedulingv1.PriorityClass{}
+		if err := dpClient.Get(context.Background(), client.ObjectKey{Name: "production-high"}, pc); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pc.Value != 5 {
+			t.Errorf("expected existing value 5 to be kept, got %d", pc.Value)
+		}
+	})
+
+	t.Run("skipped without a value", func(t *testing.T) {
+		dpClient := fake.NewClientBuilder().Build()
+		env := newPriorityEnv(&openchoreov1alpha1.EnvironmentPriority{PriorityClassName: "system-cluster-critical"})
+
+		if err := newReconciler(dpClient).ensurePriorityClass(context.Background(), env); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		list := &schedulingv1.PriorityClassList{}
+		if err := dpClient.List(context.Background(), list); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(list.Items) != 0 {
+			t.Errorf("expected no PriorityClass to be created, got %d", len(list.Items))
+		}
+	})
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package inventory exposes platform inventory gauges on the controller manager's metrics
+// endpoint: components by type, release bindings by environment and status, recently created
+// component releases and connected planes. The gauges are computed from the manager's cache
+// on every scrape, so they need no bookkeeping in the controllers and never go stale when
+// resources are deleted.
+package inventory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// collectTimeout bounds the cache reads of a scrape.
+	collectTimeout = 10 * time.Second
+	// recentReleasesWindow is the window of the recently created component releases gauge.
+	recentReleasesWindow = 24 * time.Hour
+	// labelSeparator joins label values into a counter key. It cannot occur in a label value
+	// taken from a Kubernetes name.
+	labelSeparator = "\x00"
+)
+
+// Release binding statuses reported by the release bindings gauge.
+const (
+	BindingStatusReady      = "Ready"
+	BindingStatusNotReady   = "NotReady"
+	BindingStatusUnknown    = "Unknown"
+	BindingStatusUndeployed = "Undeployed"
+)
+
+var (
+	componentsDesc = prometheus.NewDesc(
+		"openchoreo_inventory_components",
+		"Number of Components, by namespace and component type.",
+		[]string{"namespace", "component_type"}, nil,
+	)
+
+	releaseBindingsDesc = prometheus.NewDesc(
+		"openchoreo_inventory_release_bindings",
+		"Number of ReleaseBindings, by namespace, environment and status.",
+		[]string{"namespace", "environment", "status"}, nil,
+	)
+
+	recentReleasesDesc = prometheus.NewDesc(
+		"openchoreo_inventory_component_releases_created_24h",
+		"Number of ComponentReleases created in the last 24 hours, by namespace.",
+		[]string{"namespace"}, nil,
+	)
+
+	planesDesc = prometheus.NewDesc(
+		"openchoreo_inventory_planes",
+		"Number of planes, by kind, namespace and whether a cluster agent is connected. Cluster-scoped planes have an empty namespace.",
+		[]string{"kind", "namespace", "connected"}, nil,
+	)
+
+	collectErrorsDesc = prometheus.NewDesc(
+		"openchoreo_inventory_collect_errors",
+		"Number of resource kinds that could not be read during the scrape.",
+		nil, nil,
+	)
+)
+
+// Collector is a prometheus.Collector that reports the platform inventory.
+type Collector struct {
+	reader client.Reader
+	logger logr.Logger
+	now    func() time.Time
+}
+
+var _ prometheus.Collector = (*Collector)(nil)
+
+// NewCollector creates a Collector that reads resources from the given reader, normally the
+// cached client of the manager.
+func NewCollector(reader client.Reader, logger logr.Logger) *Collector {
+	return &Collector{reader: reader, logger: logger, now: time.Now}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- componentsDesc
+	ch <- releaseBindingsDesc
+	ch <- recentReleasesDesc
+	ch <- planesDesc
+	ch <- collectErrorsDesc
+}
+
+// Collect implements prometheus.Collector. A kind that cannot be read is skipped and counted
+// in openchoreo_inventory_collect_errors instead of failing the whole scrape.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	collectors := []func(context.Context, chan<- prometheus.Metric) error{
+		c.collectComponents,
+		c.collectReleaseBindings,
+		c.collectRecentReleases,
+		c.collectPlanes,
+	}
+	errs := 0
+	for _, collect := range collectors {
+		if err := collect(ctx, ch); err != nil {
+			c.logger.Error(err, "Failed to collect inventory metrics")
+			errs++
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(collectErrorsDesc, prometheus.GaugeValue, float64(errs))
+}
+
+func (c *Collector) collectComponents(ctx context.Context, ch chan<- prometheus.Metric) error {
+	var list openchoreov1alpha1.ComponentList
+	if err := c.reader.List(ctx, &list); err != nil {
+		return err
+	}
+	counts := counter{}
+	for i := range list.Items {
+		comp := &list.Items[i]
+		counts.inc(comp.Namespace, comp.Spec.ComponentType.Name)
+	}
+	counts.emit(ch, componentsDesc)
+	return nil
+}
+
+func (c *Collector) collectReleaseBindings(ctx context.Context, ch chan<- prometheus.Metric) error {
+	var list openchoreov1alpha1.ReleaseBindingList
+	if err := c.reader.List(ctx, &list); err != nil {
+		return err
+	}
+	counts := counter{}
+	for i := range list.Items {
+		rb := &list.Items[i]
+		counts.inc(rb.Namespace, rb.Spec.Environment, bindingStatus(rb))
+	}
+	counts.emit(ch, releaseBindingsDesc)
+	return nil
+}
+
+func (c *Collector) collectRecentReleases(ctx context.Context, ch chan<- prometheus.Metric) error {
+	var list openchoreov1alpha1.ComponentReleaseList
+	if err := c.reader.List(ctx, &list); err != nil {
+		return err
+	}
+	since := c.now().Add(-recentReleasesWindow)
+	counts := counter{}
+	for i := range list.Items {
+		cr := &list.Items[i]
+		if cr.CreationTimestamp.Time.After(since) {
+			counts.inc(cr.Namespace)
+		}
+	}
+	counts.emit(ch, recentReleasesDesc)
+	return nil
+}
+
+func (c *Collector) collectPlanes(ctx context.Context, ch chan<- prometheus.Metric) error {
+	counts := counter{}
+	add := func(kind, namespace string, conn *openchoreov1alpha1.AgentConnectionStatus) {
+		connected := "false"
+		if conn != nil && conn.Connected {
+			connected = "true"
+		}
+		counts.inc(kind, namespace, connected)
+	}
+
+	var dataPlanes openchoreov1alpha1.DataPlaneList
+	if err := c.reader.List(ctx, &dataPlanes); err != nil {
+		return err
+	}
+	for i := range dataPlanes.Items {
+		add("DataPlane", dataPlanes.Items[i].Namespace, dataPlanes.Items[i].Status.AgentConnection)
+	}
+	var clusterDataPlanes openchoreov1alpha1.ClusterDataPlaneList
+	if err := c.reader.List(ctx, &clusterDataPlanes); err != nil {
+		return err
+	}
+	for i := range clusterDataPlanes.Items {
+		add("ClusterDataPlane", "", clusterDataPlanes.Items[i].Status.AgentConnection)
+	}
+	var workflowPlanes openchoreov1alpha1.WorkflowPlaneList
+	if err := c.reader.List(ctx, &workflowPlanes); err != nil {
+		return err
+	}
+	for i := range workflowPlanes.Items {
+		add("WorkflowPlane", workflowPlanes.Items[i].Namespace, workflowPlanes.Items[i].Status.AgentConnection)
+	}
+	var clusterWorkflowPlanes openchoreov1alpha1.ClusterWorkflowPlaneList
+	if err := c.reader.List(ctx, &clusterWorkflowPlanes); err != nil {
+		return err
+	}
+	for i := range clusterWorkflowPlanes.Items {
+		add("ClusterWorkflowPlane", "", clusterWorkflowPlanes.Items[i].Status.AgentConnection)
+	}
+	var observabilityPlanes openchoreov1alpha1.ObservabilityPlaneList
+	if err := c.reader.List(ctx, &observabilityPlanes); err != nil {
+		return err
+	}
+	for i := range observabilityPlanes.Items {
+		add("ObservabilityPlane", observabilityPlanes.Items[i].Namespace, observabilityPlanes.Items[i].Status.AgentConnection)
+	}
+	var clusterObservabilityPlanes openchoreov1alpha1.ClusterObservabilityPlaneList
+	if err := c.reader.List(ctx, &clusterObservabilityPlanes); err != nil {
+		return err
+	}
+	for i := range clusterObservabilityPlanes.Items {
+		add("ClusterObservabilityPlane", "", clusterObservabilityPlanes.Items[i].Status.AgentConnection)
+	}
+
+	counts.emit(ch, planesDesc)
+	return nil
+}
+
+// bindingStatus maps a ReleaseBinding to the status label of the release bindings gauge.
+func bindingStatus(rb *openchoreov1alpha1.ReleaseBinding) string {
+	if rb.Spec.State == openchoreov1alpha1.ReleaseStateUndeploy {
+		return BindingStatusUndeployed
+	}
+	ready := meta.FindStatusCondition(rb.Status.Conditions, "Ready")
+	switch {
+	case ready == nil || ready.Status == metav1.ConditionUnknown:
+		return BindingStatusUnknown
+	case ready.Status == metav1.ConditionTrue:
+		return BindingStatusReady
+	default:
+		return BindingStatusNotReady
+	}
+}
+
+// counter counts the label value combinations of a gauge.
+type counter map[string]int
+
+func (c counter) inc(values ...string) {
+	c[strings.Join(values, labelSeparator)]++
+}
+
+func (c counter) emit(ch chan<- prometheus.Metric, desc *prometheus.Desc) {
+	for key, n := range c {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(n), strings.Split(key, labelSeparator)...)
+	}
+}
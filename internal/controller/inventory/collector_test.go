@@ -0,0 +1,93 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestCollector(t *testing.T) {
+	now := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	meta := func(namespace, name string, age time.Duration) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: namespace, Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))}
+	}
+	component := func(namespace, name, componentType string) *openchoreov1alpha1.Component {
+		return &openchoreov1alpha1.Component{
+			ObjectMeta: meta(namespace, name, 0),
+			Spec:       openchoreov1alpha1.ComponentSpec{ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: componentType}},
+		}
+	}
+	binding := func(name, env string, state openchoreov1alpha1.ReleaseState, ready metav1.ConditionStatus) *openchoreov1alpha1.ReleaseBinding {
+		rb := &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: meta("acme", name, 0),
+			Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: env, State: state},
+		}
+		if ready != "" {
+			rb.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: ready}}
+		}
+		return rb
+	}
+
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		component("acme", "api", "deployment/service"),
+		component("acme", "web", "deployment/service"),
+		component("acme", "nightly", "cronjob/scheduled-task"),
+		binding("api-dev", "dev", "", metav1.ConditionTrue),
+		binding("web-dev", "dev", "", metav1.ConditionFalse),
+		binding("api-prod", "prod", "", ""),
+		binding("web-prod", "prod", openchoreov1alpha1.ReleaseStateUndeploy, metav1.ConditionTrue),
+		&openchoreov1alpha1.ComponentRelease{ObjectMeta: meta("acme", "api-r2", time.Hour)},
+		&openchoreov1alpha1.ComponentRelease{ObjectMeta: meta("acme", "api-r1", 48*time.Hour)},
+		&openchoreov1alpha1.DataPlane{
+			ObjectMeta: meta("acme", "default", 0),
+			Status: openchoreov1alpha1.DataPlaneStatus{
+				AgentConnection: &openchoreov1alpha1.AgentConnectionStatus{Connected: true, ConnectedAgents: 1},
+			},
+		},
+		&openchoreov1alpha1.ClusterWorkflowPlane{ObjectMeta: meta("", "shared", 0)},
+	).Build()
+
+	collector := NewCollector(reader, logr.Discard())
+	collector.now = func() time.Time { return now }
+
+	expected := `
+# HELP openchoreo_inventory_collect_errors Number of resource kinds that could not be read during the scrape.
+# TYPE openchoreo_inventory_collect_errors gauge
+openchoreo_inventory_collect_errors 0
+# HELP openchoreo_inventory_component_releases_created_24h Number of ComponentReleases created in the last 24 hours, by namespace.
+# TYPE openchoreo_inventory_component_releases_created_24h gauge
+openchoreo_inventory_component_releases_created_24h{namespace="acme"} 1
+# HELP openchoreo_inventory_components Number of Components, by namespace and component type.
+# TYPE openchoreo_inventory_components gauge
+openchoreo_inventory_components{component_type="cronjob/scheduled-task",namespace="acme"} 1
+openchoreo_inventory_components{component_type="deployment/service",namespace="acme"} 2
+# HELP openchoreo_inventory_planes Number of planes, by kind, namespace and whether a cluster agent is connected. Cluster-scoped planes have an empty namespace.
+# TYPE openchoreo_inventory_planes gauge
+openchoreo_inventory_planes{connected="false",kind="ClusterWorkflowPlane",namespace=""} 1
+openchoreo_inventory_planes{connected="true",kind="DataPlane",namespace="acme"} 1
+# HELP openchoreo_inventory_release_bindings Number of ReleaseBindings, by namespace, environment and status.
+# TYPE openchoreo_inventory_release_bindings gauge
+openchoreo_inventory_release_bindings{environment="dev",namespace="acme",status="NotReady"} 1
+openchoreo_inventory_release_bindings{environment="dev",namespace="acme",status="Ready"} 1
+openchoreo_inventory_release_bindings{environment="prod",namespace="acme",status="Undeployed"} 1
+openchoreo_inventory_release_bindings{environment="prod",namespace="acme",status="Unknown"} 1
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
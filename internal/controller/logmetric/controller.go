@@ -0,0 +1,283 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logmetric
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/logmetric"
+)
+
+const (
+	// logMetricQueryPath is the log metrics endpoint of the observer internal API.
+	logMetricQueryPath = "/api/v1alpha1/metrics/log-metrics/query"
+	// evaluationInterval is how often the metric is re-evaluated.
+	evaluationInterval = 5 * time.Minute
+	// defaultWindow is the evaluated time range when the spec does not set one.
+	defaultWindow = 5 * time.Minute
+)
+
+// Condition types and reasons for LogMetric.
+const (
+	// ConditionEvaluated indicates whether the last evaluation succeeded.
+	ConditionEvaluated controller.ConditionType = "Evaluated"
+
+	// ReasonEvaluationSucceeded indicates the observer evaluated the metric.
+	ReasonEvaluationSucceeded controller.ConditionReason = "EvaluationSucceeded"
+	// ReasonEvaluationFailed indicates the observer could not evaluate the metric.
+	ReasonEvaluationFailed controller.ConditionReason = "EvaluationFailed"
+	// ReasonInvalidDefinition indicates the pattern or buckets cannot be used.
+	ReasonInvalidDefinition controller.ConditionReason = "InvalidDefinition"
+)
+
+// logMetricRequest is the payload sent to the observer log metrics endpoint.
+type logMetricRequest struct {
+	SearchScope logMetricSearchScope `json:"searchScope"`
+	StartTime   string               `json:"startTime"`
+	EndTime     string               `json:"endTime"`
+	Metric      logMetricDefinition  `json:"metric"`
+}
+
+type logMetricSearchScope struct {
+	Namespace   string `json:"namespace"`
+	Project     string `json:"project"`
+	Component   string `json:"component"`
+	Environment string `json:"environment"`
+}
+
+type logMetricDefinition struct {
+	Name         string    `json:"name"`
+	Type         string    `json:"type"`
+	Pattern      string    `json:"pattern"`
+	SearchPhrase string    `json:"searchPhrase,omitempty"`
+	LogLevels    []string  `json:"logLevels,omitempty"`
+	Buckets      []float64 `json:"buckets,omitempty"`
+}
+
+// logMetricResponse is the response from the observer log metrics endpoint.
+type logMetricResponse struct {
+	Count     []logMetricPoint `json:"count"`
+	Sum       []logMetricPoint `json:"sum,omitempty"`
+	P50       []logMetricPoint `json:"p50,omitempty"`
+	P90       []logMetricPoint `json:"p90,omitempty"`
+	P99       []logMetricPoint `json:"p99,omitempty"`
+	Truncated bool             `json:"truncated"`
+}
+
+type logMetricPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// Reconciler reconciles a LogMetric object by periodically asking the observability
+// plane to evaluate it over the trailing window and recording the result in status.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// ObserverBaseURL overrides the observer internal base URL. Defaults to
+	// OBSERVER_INTERNAL_ENDPOINT or the in-cluster observer-internal service.
+	ObserverBaseURL string
+
+	httpClient *http.Client
+	// now returns the current time. Overridden in tests.
+	now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=logmetrics,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=logmetrics/status,verbs=get;update;patch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	lm := &openchoreov1alpha1.LogMetric{}
+	if err := r.Get(ctx, req.NamespacedName, lm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get LogMetric")
+		return ctrl.Result{}, err
+	}
+
+	old := lm.DeepCopy()
+	lm.Status.ObservedGeneration = lm.Generation
+
+	// An invalid definition cannot become valid without a spec change, which triggers
+	// a new reconcile, so it is not re-evaluated periodically.
+	result := ctrl.Result{}
+	payload, err := r.buildLogMetricRequest(lm)
+	if err != nil {
+		lm.Status.Value = nil
+		controller.MarkFalseCondition(lm, ConditionEvaluated, ReasonInvalidDefinition, err.Error())
+	} else {
+		result.RequeueAfter = evaluationInterval
+		resp, err := r.evaluate(ctx, payload)
+		if err != nil {
+			logger.Info("Failed to evaluate log metric", "error", err.Error())
+			controller.MarkFalseCondition(lm, ConditionEvaluated, ReasonEvaluationFailed, err.Error())
+		} else {
+			applyLogMetricValue(lm, payload, resp)
+			controller.MarkTrueCondition(lm, ConditionEvaluated, ReasonEvaluationSucceeded,
+				"Log metric evaluated by the observability plane")
+		}
+	}
+
+	if !apiequality.Semantic.DeepEqual(old.Status, lm.Status) {
+		if err := r.Status().Update(ctx, lm); err != nil {
+			logger.Error(err, "Failed to update LogMetric status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// buildLogMetricRequest validates the LogMetric spec and converts it into the observer
+// request payload covering the trailing window.
+func (r *Reconciler) buildLogMetricRequest(lm *openchoreov1alpha1.LogMetric) (*logMetricRequest, error) {
+	spec := lm.Spec
+	buckets, err := logmetric.ParseBuckets(spec.Buckets)
+	if err != nil {
+		return nil, err
+	}
+	def := logMetricDefinition{
+		Name:         lm.Name,
+		Type:         strings.ToLower(string(spec.Type)),
+		Pattern:      spec.Pattern,
+		SearchPhrase: spec.SearchPhrase,
+		Buckets:      buckets,
+	}
+	for _, level := range spec.LogLevels {
+		def.LogLevels = append(def.LogLevels, string(level))
+	}
+	if _, err := logmetric.Compile(logmetric.Definition{
+		Type:    logmetric.Type(def.Type),
+		Pattern: def.Pattern,
+		Buckets: def.Buckets,
+	}); err != nil {
+		return nil, err
+	}
+
+	window := spec.Window.Duration
+	if window <= 0 {
+		window = defaultWindow
+	}
+	end := r.clock().UTC().Truncate(time.Second)
+	return &logMetricRequest{
+		SearchScope: logMetricSearchScope{
+			Namespace:   lm.Namespace,
+			Project:     spec.Owner.ProjectName,
+			Component:   spec.Owner.ComponentName,
+			Environment: spec.Environment,
+		},
+		StartTime: end.Add(-window).Format(time.RFC3339),
+		EndTime:   end.Format(time.RFC3339),
+		Metric:    def,
+	}, nil
+}
+
+// applyLogMetricValue records the observer response in status. The request has no step,
+// so each series holds a single point covering the whole window.
+func applyLogMetricValue(lm *openchoreov1alpha1.LogMetric, payload *logMetricRequest, resp *logMetricResponse) {
+	evaluatedAt, err := time.Parse(time.RFC3339, payload.EndTime)
+	if err != nil {
+		evaluatedAt = time.Now()
+	}
+	value := &openchoreov1alpha1.LogMetricValue{
+		Truncated:   resp.Truncated,
+		EvaluatedAt: metav1.NewTime(evaluatedAt),
+	}
+	if len(resp.Count) > 0 {
+		value.Count = int64(resp.Count[0].Value)
+	}
+	value.Sum = formatFirst(resp.Sum)
+	value.P50 = formatFirst(resp.P50)
+	value.P90 = formatFirst(resp.P90)
+	value.P99 = formatFirst(resp.P99)
+	lm.Status.Value = value
+}
+
+// formatFirst formats the first point of a series, or returns "" for an empty series.
+func formatFirst(points []logMetricPoint) string {
+	if len(points) == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(points[0].Value, 'g', -1, 64)
+}
+
+// evaluate calls POST /api/v1alpha1/metrics/log-metrics/query on the observer internal API.
+func (r *Reconciler) evaluate(ctx context.Context, payload *logMetricRequest) (*logMetricResponse, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, controller.ObserverAPITimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodPost, controller.ObserverInternalBaseURL(r.ObserverBaseURL)+logMetricQueryPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("log metric request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errBody struct {
+			Message string `json:"message,omitempty"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errBody)
+		return nil, fmt.Errorf("observer log metrics API returned status %d: %s", resp.StatusCode, errBody.Message)
+	}
+
+	var out logMetricResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &out, nil
+}
+
+func (r *Reconciler) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.httpClient == nil {
+		r.httpClient = &http.Client{Timeout: controller.ObserverAPITimeout}
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates must not trigger a re-evaluation; the value is refreshed periodically.
+		For(&openchoreov1alpha1.LogMetric{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("logmetric").
+		Complete(r)
+}
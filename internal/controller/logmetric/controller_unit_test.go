@@ -0,0 +1,149 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logmetric
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var testNow = time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+
+func newTestLogMetric() *openchoreov1alpha1.LogMetric {
+	return &openchoreov1alpha1.LogMetric{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout-latency", Namespace: "default", Generation: 1},
+		Spec: openchoreov1alpha1.LogMetricSpec{
+			Owner:        openchoreov1alpha1.LogMetricOwner{ProjectName: "proj", ComponentName: "api"},
+			Environment:  "production",
+			Type:         openchoreov1alpha1.LogMetricTypeHistogram,
+			Pattern:      `checkout took (?P<value>[0-9.]+)s`,
+			SearchPhrase: "checkout took",
+			LogLevels:    []openchoreov1alpha1.LogLevel{"INFO"},
+			Buckets:      []string{"0.5", "1", "5"},
+			Window:       metav1.Duration{Duration: 15 * time.Minute},
+		},
+	}
+}
+
+func newTestReconciler(t *testing.T, lm *openchoreov1alpha1.LogMetric, handler http.HandlerFunc) *Reconciler {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(lm).
+		WithStatusSubresource(&openchoreov1alpha1.LogMetric{}).
+		Build()
+	return &Reconciler{
+		Client:          c,
+		Scheme:          s,
+		ObserverBaseURL: server.URL,
+		httpClient:      server.Client(),
+		now:             func() time.Time { return testNow },
+	}
+}
+
+func reconcileLogMetric(t *testing.T, r *Reconciler, wantRequeue time.Duration) *openchoreov1alpha1.LogMetric {
+	t.Helper()
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "checkout-latency"}
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, wantRequeue, result.RequeueAfter)
+	out := &openchoreov1alpha1.LogMetric{}
+	require.NoError(t, r.Get(ctx, key, out))
+	return out
+}
+
+func TestReconcileRecordsValue(t *testing.T) {
+	var got logMetricRequest
+	r := newTestReconciler(t, newTestLogMetric(), func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, logMetricQueryPath, req.URL.Path)
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"name":"checkout-latency","type":"histogram",` +
+			`"count":[{"timestamp":"2026-01-31T11:45:00Z","value":42}],` +
+			`"sum":[{"timestamp":"2026-01-31T11:45:00Z","value":31.5}],` +
+			`"p50":[{"timestamp":"2026-01-31T11:45:00Z","value":0.5}],` +
+			`"p90":[{"timestamp":"2026-01-31T11:45:00Z","value":1.25}],` +
+			`"p99":[{"timestamp":"2026-01-31T11:45:00Z","value":4}],` +
+			`"evaluatedLines":42,"truncated":true}`))
+	})
+
+	out := reconcileLogMetric(t, r, evaluationInterval)
+
+	assert.Equal(t, logMetricSearchScope{
+		Namespace: "default", Project: "proj", Component: "api", Environment: "production",
+	}, got.SearchScope)
+	assert.Equal(t, "2026-01-31T11:45:00Z", got.StartTime)
+	assert.Equal(t, "2026-01-31T12:00:00Z", got.EndTime)
+	assert.Equal(t, logMetricDefinition{
+		Name:         "checkout-latency",
+		Type:         "histogram",
+		Pattern:      `checkout took (?P<value>[0-9.]+)s`,
+		SearchPhrase: "checkout took",
+		LogLevels:    []string{"INFO"},
+		Buckets:      []float64{0.5, 1, 5},
+	}, got.Metric)
+
+	value := out.Status.Value
+	require.NotNil(t, value)
+	assert.Equal(t, int64(42), value.Count)
+	assert.Equal(t, "31.5", value.Sum)
+	assert.Equal(t, "0.5", value.P50)
+	assert.Equal(t, "1.25", value.P90)
+	assert.Equal(t, "4", value.P99)
+	assert.True(t, value.Truncated)
+	assert.True(t, value.EvaluatedAt.Time.Equal(testNow))
+	assert.True(t, apimeta.IsStatusConditionTrue(out.Status.Conditions, string(ConditionEvaluated)))
+}
+
+func TestReconcileRejectsInvalidDefinition(t *testing.T) {
+	lm := newTestLogMetric()
+	lm.Spec.Pattern = `checkout took ([0-9.]+)s`
+	r := newTestReconciler(t, lm, func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("observer must not be called for an invalid definition")
+	})
+
+	out := reconcileLogMetric(t, r, 0)
+
+	assert.Nil(t, out.Status.Value)
+	cond := apimeta.FindStatusCondition(out.Status.Conditions, string(ConditionEvaluated))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonInvalidDefinition), cond.Reason)
+	assert.Contains(t, cond.Message, `capture group named "value"`)
+}
+
+func TestReconcileRecordsEvaluationFailure(t *testing.T) {
+	r := newTestReconciler(t, newTestLogMetric(), func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"Failed to query log metric"}`))
+	})
+
+	out := reconcileLogMetric(t, r, evaluationInterval)
+
+	cond := apimeta.FindStatusCondition(out.Status.Conditions, string(ConditionEvaluated))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonEvaluationFailed), cond.Reason)
+	assert.Contains(t, cond.Message, "status 500")
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package objectmigration
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/migration"
+)
+
+const (
+	// ConditionCompleted indicates whether the last migration run finished for the current spec.
+	ConditionCompleted controller.ConditionType = "Completed"
+
+	ReasonMigrationSucceeded controller.ConditionReason = "MigrationSucceeded"
+	ReasonMigrationFailed    controller.ConditionReason = "MigrationFailed"
+	ReasonMigrationRunning   controller.ConditionReason = "MigrationRunning"
+	ReasonUnknownMigration   controller.ConditionReason = "UnknownMigration"
+)
+
+// Reconciler reconciles an ObjectMigration object by running the referenced
+// migration against the stored resources and recording its progress.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// APIReader reads objects directly from the API server so that migrations
+	// page through stored state rather than the informer cache.
+	APIReader client.Reader
+	// Registry holds the migrations that can be referenced by spec.migration.
+	Registry *migration.Registry
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=objectmigrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=objectmigrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componenttypes;clustercomponenttypes;traits;clustertraits,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	om := &openchoreov1alpha1.ObjectMigration{}
+	if err := r.Get(ctx, req.NamespacedName, om); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get ObjectMigration")
+		return ctrl.Result{}, err
+	}
+
+	if !om.DeletionTimestamp.IsZero() || isCompleted(om) {
+		return ctrl.Result{}, nil
+	}
+
+	m, ok := r.Registry.Get(om.Spec.Migration)
+	if !ok {
+		msg := fmt.Sprintf("migration %q is not registered; known migrations: %v", om.Spec.Migration, r.Registry.Names())
+		r.finish(om, openchoreov1alpha1.ObjectMigrationPhaseFailed, ReasonUnknownMigration, msg)
+		r.Recorder.Event(om, corev1.EventTypeWarning, string(ReasonUnknownMigration), msg)
+		return ctrl.Result{}, r.Status().Update(ctx, om)
+	}
+
+	logger.Info("Starting migration", "migration", m.Name, "dryRun", om.Spec.DryRun)
+	now := metav1.Now()
+	om.Status = openchoreov1alpha1.ObjectMigrationStatus{
+		ObservedGeneration: om.Generation,
+		Phase:              openchoreov1alpha1.ObjectMigrationPhaseRunning,
+		StartTime:          &now,
+		Conditions:         om.Status.Conditions,
+	}
+	controller.MarkFalseCondition(om, ConditionCompleted, ReasonMigrationRunning, "Migration is running")
+	if err := r.Status().Update(ctx, om); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	progress, runErr := migration.NewRunner(r.migrationClient()).Run(ctx, m, migration.RunOptions{
+		BatchSize: om.Spec.BatchSize,
+		DryRun:    om.Spec.DryRun,
+		OnBatch: func(ctx context.Context, p migration.Progress) error {
+			setProgress(om, p)
+			return r.Status().Update(ctx, om)
+		},
+	})
+	setProgress(om, progress)
+
+	switch {
+	case runErr != nil:
+		logger.Error(runErr, "Migration aborted", "migration", m.Name)
+		r.finish(om, openchoreov1alpha1.ObjectMigrationPhaseFailed, ReasonMigrationFailed, runErr.Error())
+		r.Recorder.Event(om, corev1.EventTypeWarning, string(ReasonMigrationFailed), runErr.Error())
+	case progress.Failed > 0:
+		msg := fmt.Sprintf("%d of %d objects could not be migrated", progress.Failed, progress.Scanned)
+		r.finish(om, openchoreov1alpha1.ObjectMigrationPhaseFailed, ReasonMigrationFailed, msg)
+		r.Recorder.Event(om, corev1.EventTypeWarning, string(ReasonMigrationFailed), msg)
+	default:
+		msg := fmt.Sprintf("Migrated %d of %d objects", progress.Migrated, progress.Scanned)
+		if om.Spec.DryRun {
+			msg = fmt.Sprintf("Dry run: %d of %d objects would be migrated", progress.Migrated, progress.Scanned)
+		}
+		r.finish(om, openchoreov1alpha1.ObjectMigrationPhaseSucceeded, ReasonMigrationSucceeded, msg)
+		r.Recorder.Event(om, corev1.EventTypeNormal, string(ReasonMigrationSucceeded), msg)
+	}
+
+	logger.Info("Migration finished", "migration", m.Name, "phase", om.Status.Phase,
+		"scanned", progress.Scanned, "migrated", progress.Migrated, "failed", progress.Failed)
+	return ctrl.Result{}, r.Status().Update(ctx, om)
+}
+
+// migrationClient returns a client whose reads bypass the informer cache when
+// an APIReader is configured, while writes still go through the manager client.
+func (r *Reconciler) migrationClient() client.Client {
+	if r.APIReader == nil {
+		return r.Client
+	}
+	return &directReadClient{Client: r.Client, reader: r.APIReader}
+}
+
+// finish records the terminal phase and Completed condition of a run.
+func (r *Reconciler) finish(om *openchoreov1alpha1.ObjectMigration, phase openchoreov1alpha1.ObjectMigrationPhase,
+	reason controller.ConditionReason, message string) {
+	now := metav1.Now()
+	om.Status.ObservedGeneration = om.Generation
+	om.Status.Phase = phase
+	om.Status.CompletionTime = &now
+	if phase == openchoreov1alpha1.ObjectMigrationPhaseSucceeded {
+		controller.MarkTrueCondition(om, ConditionCompleted, reason, message)
+		return
+	}
+	controller.MarkFalseCondition(om, ConditionCompleted, reason, message)
+}
+
+// isCompleted reports whether the current spec generation already has a finished run.
+// A run that was interrupted (e.g. by a manager restart) is left in the Running
+// phase and is started again from the beginning, which is safe because
+// migrations are idempotent.
+func isCompleted(om *openchoreov1alpha1.ObjectMigration) bool {
+	if om.Status.ObservedGeneration != om.Generation {
+		return false
+	}
+	return om.Status.Phase == openchoreov1alpha1.ObjectMigrationPhaseSucceeded ||
+		om.Status.Phase == openchoreov1alpha1.ObjectMigrationPhaseFailed
+}
+
+func setProgress(om *openchoreov1alpha1.ObjectMigration, p migration.Progress) {
+	om.Status.Scanned = p.Scanned
+	om.Status.Migrated = p.Migrated
+	om.Status.Failed = p.Failed
+	om.Status.Failures = nil
+	for _, f := range p.Failures {
+		om.Status.Failures = append(om.Status.Failures, openchoreov1alpha1.ObjectMigrationFailure{
+			Kind:      f.Kind,
+			Namespace: f.Namespace,
+			Name:      f.Name,
+			Message:   f.Message,
+		})
+	}
+}
+
+// directReadClient serves Get and List from an uncached reader.
+type directReadClient struct {
+	client.Client
+	reader client.Reader
+}
+
+func (c *directReadClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.reader.Get(ctx, key, obj, opts...)
+}
+
+func (c *directReadClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.reader.List(ctx, list, opts...)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("objectmigration-controller")
+	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
+	if r.Registry == nil {
+		r.Registry = migration.DefaultRegistry()
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.ObjectMigration{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("objectmigration").
+		Complete(r)
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package objectmigration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/migration"
+)
+
+func newTestReconciler(t *testing.T, objs ...client.Object) *Reconciler {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.ObjectMigration{}).
+		Build()
+	return &Reconciler{
+		Client:   c,
+		Scheme:   s,
+		Recorder: record.NewFakeRecorder(10),
+		Registry: migration.DefaultRegistry(),
+	}
+}
+
+func reconcileMigration(t *testing.T, r *Reconciler, name string) *openchoreov1alpha1.ObjectMigration {
+	t.Helper()
+	ctx := context.Background()
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+	require.NoError(t, err)
+	om := &openchoreov1alpha1.ObjectMigration{}
+	require.NoError(t, r.Get(ctx, types.NamespacedName{Name: name}, om))
+	return om
+}
+
+func TestReconcileRunsMigration(t *testing.T) {
+	om := &openchoreov1alpha1.ObjectMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "mig", Generation: 1},
+		Spec:       openchoreov1alpha1.ObjectMigrationSpec{Migration: migration.ValidationsToPreRenderValidations},
+	}
+	ct := &openchoreov1alpha1.ComponentType{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default"},
+		Spec: openchoreov1alpha1.ComponentTypeSpec{
+			Validations: []openchoreov1alpha1.ValidationRule{{Rule: "${true}", Message: "invalid"}},
+		},
+	}
+	r := newTestReconciler(t, om, ct)
+
+	got := reconcileMigration(t, r, "mig")
+	assert.Equal(t, openchoreov1alpha1.ObjectMigrationPhaseSucceeded, got.Status.Phase)
+	assert.Equal(t, int64(1), got.Status.Scanned)
+	assert.Equal(t, int64(1), got.Status.Migrated)
+	assert.NotNil(t, got.Status.CompletionTime)
+	cond := apimeta.FindStatusCondition(got.Status.Conditions, string(ConditionCompleted))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(ReasonMigrationSucceeded), cond.Reason)
+
+	migrated := &openchoreov1alpha1.ComponentType{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(ct), migrated))
+	assert.Len(t, migrated.Spec.PreRenderValidations, 1)
+}
+
+func TestReconcileUnknownMigration(t *testing.T) {
+	om := &openchoreov1alpha1.ObjectMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "mig", Generation: 1},
+		Spec:       openchoreov1alpha1.ObjectMigrationSpec{Migration: "does-not-exist"},
+	}
+	r := newTestReconciler(t, om)
+
+	got := reconcileMigration(t, r, "mig")
+	assert.Equal(t, openchoreov1alpha1.ObjectMigrationPhaseFailed, got.Status.Phase)
+	cond := apimeta.FindStatusCondition(got.Status.Conditions, string(ConditionCompleted))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonUnknownMigration), cond.Reason)
+}
+
+func TestReconcileSkipsCompletedGeneration(t *testing.T) {
+	completion := metav1.Now()
+	om := &openchoreov1alpha1.ObjectMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "mig", Generation: 2},
+		Spec:       openchoreov1alpha1.ObjectMigrationSpec{Migration: migration.ValidationsToPreRenderValidations},
+		Status: openchoreov1alpha1.ObjectMigrationStatus{
+			ObservedGeneration: 2,
+			Phase:              openchoreov1alpha1.ObjectMigrationPhaseSucceeded,
+			CompletionTime:     &completion,
+			Migrated:           7,
+		},
+	}
+	r := newTestReconciler(t, om)
+
+	got := reconcileMigration(t, r, "mig")
+	assert.Equal(t, int64(7), got.Status.Migrated)
+	assert.Equal(t, openchoreov1alpha1.ObjectMigrationPhaseSucceeded, got.Status.Phase)
+}
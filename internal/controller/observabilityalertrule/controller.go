@@ -9,7 +9,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"os"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,16 +22,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 const (
-	// defaultObserverInternalBaseURL is the internal observer service URL for v1alpha1 alert CRUD.
-	// This service is only reachable within the cluster (not exposed via Gateway).
-	defaultObserverInternalBaseURL = "http://observer-internal.openchoreo-observability-plane:8081"
-	alertsV1alpha1BasePath         = "/api/v1alpha1/alerts/sources"
-	conditionTypeSynced            = "Synced"
-	// observerAPITimeout is the default timeout for HTTP calls to the observer internal API.
-	observerAPITimeout = 10 * time.Second
+	alertsV1alpha1BasePath = "/api/v1alpha1/alerts/sources"
+	conditionTypeSynced    = "Synced"
 	// AlertRuleCleanupFinalizer is used to ensure alert rules are deleted from the backend before the CR is removed
 	AlertRuleCleanupFinalizer = "openchoreo.dev/alertrule-cleanup"
 )
@@ -179,7 +174,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 //   - If GET returns 404, create a new rule via POST.
 //   - Any other status is treated as an error.
 func (r *Reconciler) upsertAlertRule(ctx context.Context, alertRule *openchoreov1alpha1.ObservabilityAlertRule, payload *alertRuleRequest) (*alertRuleSyncResponse, error) {
-	baseURL := getObserverInternalBaseURL()
+	baseURL := controller.ObserverInternalBaseURL("")
 	ruleName := alertRule.Name
 	sourceType := string(alertRule.Spec.Source.Type)
 
@@ -201,7 +196,7 @@ func (r *Reconciler) upsertAlertRule(ctx context.Context, alertRule *openchoreov
 // getAlertRule calls GET /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}
 func (r *Reconciler) getAlertRule(ctx context.Context, baseURL, ruleName, sourceType string) (*alertRuleGetResponse, int, error) {
 	url := fmt.Sprintf("%s%s/%s/rules/%s", baseURL, alertsV1alpha1BasePath, sourceType, ruleName)
-	reqCtx, cancel := context.WithTimeout(ctx, observerAPITimeout)
+	reqCtx, cancel := context.WithTimeout(ctx, controller.ObserverAPITimeout)
 	defer cancel()
 
 	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodGet, url, nil)
@@ -263,7 +258,7 @@ func (r *Reconciler) callWriteEndpoint(ctx context.Context, method, url string,
 		return nil, 0, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	reqCtx, cancel := context.WithTimeout(ctx, observerAPITimeout)
+	reqCtx, cancel := context.WithTimeout(ctx, controller.ObserverAPITimeout)
 	defer cancel()
 
 	httpReq, err := http.NewRequestWithContext(reqCtx, method, url, bytes.NewReader(bodyBytes))
@@ -348,18 +343,6 @@ func buildAlertRuleRequest(rule *openchoreov1alpha1.ObservabilityAlertRule) (*al
 	return req, nil
 }
 
-// getObserverInternalBaseURL returns the observer internal base URL, allowing override via environment variable.
-func getObserverInternalBaseURL() string {
-	if v := os.Getenv("OBSERVER_INTERNAL_ENDPOINT"); v != "" {
-		return v
-	}
-	// Fall back to legacy OBSERVER_ENDPOINT for backwards compatibility in tests.
-	if v := os.Getenv("OBSERVER_ENDPOINT"); v != "" {
-		return v
-	}
-	return defaultObserverInternalBaseURL
-}
-
 // formatMinutesHours converts a duration to a minutes/hours-only string (e.g. "5m", "1h").
 func formatMinutesHours(d time.Duration) string {
 	if d%time.Hour == 0 {
@@ -428,10 +411,10 @@ func (r *Reconciler) finalize(ctx context.Context, alertRule *openchoreov1alpha1
 
 	logger.Info("Deleting alert rule from observer backend", "name", alertRule.Name, "sourceType", alertRule.Spec.Source.Type)
 
-	baseURL := getObserverInternalBaseURL()
+	baseURL := controller.ObserverInternalBaseURL("")
 	url := fmt.Sprintf("%s%s/%s/rules/%s", baseURL, alertsV1alpha1BasePath, alertRule.Spec.Source.Type, alertRule.Name)
 
-	reqCtx, cancel := context.WithTimeout(ctx, observerAPITimeout)
+	reqCtx, cancel := context.WithTimeout(ctx, controller.ObserverAPITimeout)
 	defer cancel()
 
 	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodDelete, url, nil)
@@ -4,7 +4,6 @@
 package observabilityalertrule
 
 import (
-	"os"
 	"testing"
 	"time"
 
@@ -321,53 +320,3 @@ func TestSetStatusCondition(t *testing.T) {
 		}
 	})
 }
-
-// ---------------------------------------------------------------------------
-// getObserverInternalBaseURL
-// ---------------------------------------------------------------------------
-
-func TestGetObserverInternalBaseURL(t *testing.T) {
-	t.Run("returns default when no env vars set", func(t *testing.T) {
-		t.Setenv("OBSERVER_INTERNAL_ENDPOINT", "")
-		t.Setenv("OBSERVER_ENDPOINT", "")
-		// Unset them completely
-		os.Unsetenv("OBSERVER_INTERNAL_ENDPOINT")
-		os.Unsetenv("OBSERVER_ENDPOINT")
-
-		got := getObserverInternalBaseURL()
-		if got != defaultObserverInternalBaseURL {
-			t.Errorf("expected default URL %q, got %q", defaultObserverInternalBaseURL, got)
-		}
-	})
-
-	t.Run("returns OBSERVER_INTERNAL_ENDPOINT when set", func(t *testing.T) {
-		t.Setenv("OBSERVER_INTERNAL_ENDPOINT", "http://custom-observer:9090")
-		t.Setenv("OBSERVER_ENDPOINT", "")
-		os.Unsetenv("OBSERVER_ENDPOINT")
-
-		got := getObserverInternalBaseURL()
-		if got != "http://custom-observer:9090" {
-			t.Errorf("expected %q, got %q", "http://custom-observer:9090", got)
-		}
-	})
-
-	t.Run("returns OBSERVER_ENDPOINT as legacy fallback", func(t *testing.T) {
-		os.Unsetenv("OBSERVER_INTERNAL_ENDPOINT")
-		t.Setenv("OBSERVER_ENDPOINT", "http://legacy-observer:8080")
-
-		got := getObserverInternalBaseURL()
-		if got != "http://legacy-observer:8080" {
-			t.Errorf("expected %q, got %q", "http://legacy-observer:8080", got)
-		}
-	})
-
-	t.Run("OBSERVER_INTERNAL_ENDPOINT takes priority over OBSERVER_ENDPOINT", func(t *testing.T) {
-		t.Setenv("OBSERVER_INTERNAL_ENDPOINT", "http://internal:9090")
-		t.Setenv("OBSERVER_ENDPOINT", "http://legacy:8080")
-
-		got := getObserverInternalBaseURL()
-		if got != "http://internal:9090" {
-			t.Errorf("OBSERVER_INTERNAL_ENDPOINT should take priority; got %q", got)
-		}
-	})
-}
@@ -0,0 +1,375 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observabilityexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+const (
+	// exportsBasePath is the base path of the export endpoints of the observer internal API.
+	exportsBasePath = "/api/v1alpha1/exports"
+	// statusSyncInterval is how often the export is re-registered to refresh its status and
+	// to re-create it after an observer restart.
+	statusSyncInterval = time.Minute
+	// ExportCleanupFinalizer ensures the export is removed from the observer before the CR is deleted.
+	ExportCleanupFinalizer = "openchoreo.dev/observabilityexport-cleanup"
+)
+
+// Condition types and reasons for ObservabilityExport.
+const (
+	// ConditionReady indicates whether the export is registered and its last run succeeded.
+	ConditionReady controller.ConditionType = "Ready"
+
+	// ReasonExporting indicates the observer exports the logs.
+	ReasonExporting controller.ConditionReason = "Exporting"
+	// ReasonSuspended indicates the export is paused by spec.suspend.
+	ReasonSuspended controller.ConditionReason = "Suspended"
+	// ReasonExportFailed indicates the last run could not write logs to the sink.
+	ReasonExportFailed controller.ConditionReason = "ExportFailed"
+	// ReasonRegistrationFailed indicates the observer rejected or could not be reached.
+	ReasonRegistrationFailed controller.ConditionReason = "RegistrationFailed"
+)
+
+// exportRequest is the payload sent to the observer export endpoint.
+type exportRequest struct {
+	Filter      exportFilter `json:"filter"`
+	Mode        string       `json:"mode,omitempty"`
+	Interval    string       `json:"interval,omitempty"`
+	Sink        exportSink   `json:"sink"`
+	Compression string       `json:"compression,omitempty"`
+	Suspend     bool         `json:"suspend,omitempty"`
+	Since       string       `json:"since,omitempty"`
+}
+
+type exportFilter struct {
+	Project      string   `json:"project,omitempty"`
+	Component    string   `json:"component,omitempty"`
+	Environment  string   `json:"environment,omitempty"`
+	LogLevels    []string `json:"logLevels,omitempty"`
+	SearchPhrase string   `json:"searchPhrase,omitempty"`
+}
+
+type exportSink struct {
+	Type string          `json:"type"`
+	S3   *exportS3Sink   `json:"s3,omitempty"`
+	GCS  *exportGCSSink  `json:"gcs,omitempty"`
+	HTTP *exportHTTPSink `json:"http,omitempty"`
+}
+
+type exportSecretRef struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+type exportS3Sink struct {
+	Bucket          string          `json:"bucket"`
+	Region          string          `json:"region"`
+	Endpoint        string          `json:"endpoint,omitempty"`
+	Prefix          string          `json:"prefix,omitempty"`
+	AccessKeyID     exportSecretRef `json:"accessKeyId"`
+	SecretAccessKey exportSecretRef `json:"secretAccessKey"`
+}
+
+type exportGCSSink struct {
+	Bucket      string          `json:"bucket"`
+	Prefix      string          `json:"prefix,omitempty"`
+	Credentials exportSecretRef `json:"credentials"`
+}
+
+type exportHTTPSink struct {
+	URL     string                       `json:"url"`
+	Headers map[string]exportHeaderValue `json:"headers,omitempty"`
+}
+
+type exportHeaderValue struct {
+	Value     string           `json:"value,omitempty"`
+	SecretRef *exportSecretRef `json:"secretRef,omitempty"`
+}
+
+// exportStatus is the response from the observer export endpoint.
+type exportStatus struct {
+	LastExportTime  *time.Time `json:"lastExportTime,omitempty"`
+	LastRunTime     *time.Time `json:"lastRunTime,omitempty"`
+	ExportedEntries int64      `json:"exportedEntries"`
+	LastError       string     `json:"lastError,omitempty"`
+}
+
+// Reconciler reconciles an ObservabilityExport object by registering the export with the
+// observer, which runs it, and mirroring the progress reported by the observer in status.
+// The recorded checkpoint is sent back on every registration so exports resume where they
+// stopped after an observer restart.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// ObserverBaseURL overrides the observer internal base URL. Defaults to
+	// OBSERVER_INTERNAL_ENDPOINT or the in-cluster observer-internal service.
+	ObserverBaseURL string
+
+	httpClient *http.Client
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=observabilityexports,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=observabilityexports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=observabilityexports/finalizers,verbs=update
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	export := &openchoreov1alpha1.ObservabilityExport{}
+	if err := r.Get(ctx, req.NamespacedName, export); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get ObservabilityExport")
+		return ctrl.Result{}, err
+	}
+
+	if !export.DeletionTimestamp.IsZero() {
+		return r.finalize(ctx, export)
+	}
+
+	if !controllerutil.ContainsFinalizer(export, ExportCleanupFinalizer) {
+		controllerutil.AddFinalizer(export, ExportCleanupFinalizer)
+		if err := r.Update(ctx, export); err != nil {
+			logger.Error(err, "Failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	old := export.DeepCopy()
+	export.Status.ObservedGeneration = export.Generation
+
+	status, err := r.upsert(ctx, export, buildExportRequest(export))
+	if err != nil {
+		logger.Info("Failed to register export with the observer", "error", err.Error())
+		controller.MarkFalseCondition(export, ConditionReady, ReasonRegistrationFailed, err.Error())
+	} else {
+		applyExportStatus(export, status)
+	}
+
+	if !apiequality.Semantic.DeepEqual(old.Status, export.Status) {
+		if err := r.Status().Update(ctx, export); err != nil {
+			logger.Error(err, "Failed to update ObservabilityExport status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: statusSyncInterval}, nil
+}
+
+// buildExportRequest converts the ObservabilityExport spec into the observer request payload.
+// Secrets are passed by reference; the observer reads them from the namespace of the export.
+func buildExportRequest(export *openchoreov1alpha1.ObservabilityExport) *exportRequest {
+	spec := export.Spec
+	payload := &exportRequest{
+		Filter: exportFilter{
+			Project:      spec.Filter.ProjectName,
+			Component:    spec.Filter.ComponentName,
+			Environment:  spec.Filter.Environment,
+			LogLevels:    spec.Filter.LogLevels,
+			SearchPhrase: spec.Filter.SearchPhrase,
+		},
+		Mode:        strings.ToLower(string(spec.Mode)),
+		Sink:        exportSink{Type: string(spec.Sink.Type)},
+		Compression: string(spec.Compression),
+		Suspend:     spec.Suspend,
+	}
+	if spec.Interval.Duration > 0 {
+		payload.Interval = spec.Interval.Duration.String()
+	}
+	if t := export.Status.LastExportTime; t != nil {
+		payload.Since = t.UTC().Format(time.RFC3339Nano)
+	}
+
+	if s3 := spec.Sink.S3; s3 != nil {
+		payload.Sink.S3 = &exportS3Sink{
+			Bucket:          s3.Bucket,
+			Region:          s3.Region,
+			Endpoint:        s3.Endpoint,
+			Prefix:          s3.Prefix,
+			AccessKeyID:     secretRef(s3.AccessKeyID),
+			SecretAccessKey: secretRef(s3.SecretAccessKey),
+		}
+	}
+	if gcs := spec.Sink.GCS; gcs != nil {
+		payload.Sink.GCS = &exportGCSSink{
+			Bucket:      gcs.Bucket,
+			Prefix:      gcs.Prefix,
+			Credentials: secretRef(gcs.Credentials),
+		}
+	}
+	if h := spec.Sink.HTTP; h != nil {
+		payload.Sink.HTTP = &exportHTTPSink{URL: h.URL}
+		if len(h.Headers) > 0 {
+			payload.Sink.HTTP.Headers = make(map[string]exportHeaderValue, len(h.Headers))
+			for name, header := range h.Headers {
+				var value exportHeaderValue
+				if header.Value != nil {
+					value.Value = *header.Value
+				}
+				if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef != nil {
+					ref := secretRef(*header.ValueFrom.SecretKeyRef)
+					value.SecretRef = &ref
+				}
+				payload.Sink.HTTP.Headers[name] = value
+			}
+		}
+	}
+	return payload
+}
+
+func secretRef(ref openchoreov1alpha1.SecretKeyRef) exportSecretRef {
+	return exportSecretRef{Name: ref.Name, Key: ref.Key}
+}
+
+// applyExportStatus records the progress reported by the observer. The checkpoint never
+// moves backwards, since the status is the durable record of what was exported.
+func applyExportStatus(export *openchoreov1alpha1.ObservabilityExport, status *exportStatus) {
+	if status.LastExportTime != nil {
+		current := export.Status.LastExportTime
+		if current == nil || status.LastExportTime.After(current.Time) {
+			t := metav1.NewTime(*status.LastExportTime)
+			export.Status.LastExportTime = &t
+		}
+	}
+	if status.LastRunTime != nil {
+		t := metav1.NewTime(*status.LastRunTime)
+		export.Status.LastRunTime = &t
+	}
+	export.Status.ExportedEntries = status.ExportedEntries
+
+	switch {
+	case status.LastError != "":
+		controller.MarkFalseCondition(export, ConditionReady, ReasonExportFailed, status.LastError)
+	case export.Spec.Suspend:
+		controller.MarkFalseCondition(export, ConditionReady, ReasonSuspended, "Export is suspended")
+	default:
+		controller.MarkTrueCondition(export, ConditionReady, ReasonExporting,
+			fmt.Sprintf("Logs are exported to the %s sink", export.Spec.Sink.Type))
+	}
+}
+
+// upsert calls PUT /api/v1alpha1/exports/{namespace}/{name} on the observer internal API.
+func (r *Reconciler) upsert(ctx context.Context, export *openchoreov1alpha1.ObservabilityExport, payload *exportRequest) (*exportStatus, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, controller.ObserverAPITimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodPut, r.exportURL(export), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("export registration request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("observer export API returned status %d: %s", resp.StatusCode, errorMessage(resp))
+	}
+
+	var out exportStatus
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &out, nil
+}
+
+// finalize removes the export from the observer before the finalizer is released.
+func (r *Reconciler) finalize(ctx context.Context, export *openchoreov1alpha1.ObservabilityExport) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(export, ExportCleanupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, controller.ObserverAPITimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodDelete, r.exportURL(export), nil)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := r.httpClient.Do(httpReq)
+	if err != nil {
+		logger.Error(err, "Failed to call observer export DELETE API")
+		return ctrl.Result{}, err
+	}
+	defer resp.Body.Close()
+
+	// Accept 2xx or 404 (already removed) as success.
+	if (resp.StatusCode < 200 || resp.StatusCode >= 300) && resp.StatusCode != http.StatusNotFound {
+		err := fmt.Errorf("observer export DELETE API returned status %d: %s", resp.StatusCode, errorMessage(resp))
+		logger.Error(err, "Failed to remove export from the observer")
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(export, ExportCleanupFinalizer)
+	if err := r.Update(ctx, export); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Removed export from the observer", "name", export.Name)
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) exportURL(export *openchoreov1alpha1.ObservabilityExport) string {
+	return fmt.Sprintf("%s%s/%s/%s", controller.ObserverInternalBaseURL(r.ObserverBaseURL), exportsBasePath,
+		url.PathEscape(export.Namespace), url.PathEscape(export.Name))
+}
+
+// errorMessage extracts the message of an observer error response.
+func errorMessage(resp *http.Response) string {
+	var errBody struct {
+		Message string `json:"message,omitempty"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&errBody)
+	return errBody.Message
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.httpClient == nil {
+		r.httpClient = &http.Client{Timeout: controller.ObserverAPITimeout}
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates must not trigger a re-registration; the status is refreshed periodically.
+		For(&openchoreov1alpha1.ObservabilityExport{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("observabilityexport").
+		Complete(r)
+}
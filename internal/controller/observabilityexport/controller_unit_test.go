@@ -0,0 +1,232 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observabilityexport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var (
+	testKey        = types.NamespacedName{Namespace: "acme", Name: "audit"}
+	testLastExport = time.Date(2026, 1, 31, 11, 0, 0, 0, time.UTC)
+)
+
+func newTestExport() *openchoreov1alpha1.ObservabilityExport {
+	token := "static"
+	return &openchoreov1alpha1.ObservabilityExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testKey.Name,
+			Namespace:  testKey.Namespace,
+			Generation: 1,
+			Finalizers: []string{ExportCleanupFinalizer},
+		},
+		Spec: openchoreov1alpha1.ObservabilityExportSpec{
+			Filter: openchoreov1alpha1.ObservabilityExportFilter{
+				ProjectName: "shop",
+				Environment: "production",
+				LogLevels:   []string{"ERROR"},
+			},
+			Mode:        openchoreov1alpha1.ObservabilityExportModeScheduled,
+			Interval:    metav1.Duration{Duration: time.Hour},
+			Compression: openchoreov1alpha1.ObservabilityExportCompressionGzip,
+			Sink: openchoreov1alpha1.ObservabilityExportSink{
+				Type: openchoreov1alpha1.ObservabilityExportSinkTypeHTTP,
+				HTTP: &openchoreov1alpha1.ObservabilityExportHTTPSink{
+					URL: "https://logs.example.com/ingest",
+					Headers: map[string]openchoreov1alpha1.WebhookHeaderValue{
+						"X-Source": {Value: &token},
+						"Authorization": {ValueFrom: &openchoreov1alpha1.SecretValueFrom{
+							SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: "log-sink", Key: "token"},
+						}},
+					},
+				},
+			},
+		},
+		Status: openchoreov1alpha1.ObservabilityExportStatus{
+			LastExportTime: &metav1.Time{Time: testLastExport},
+		},
+	}
+}
+
+func newTestReconciler(t *testing.T, export *openchoreov1alpha1.ObservabilityExport, handler http.HandlerFunc) *Reconciler {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(export).
+		WithStatusSubresource(&openchoreov1alpha1.ObservabilityExport{}).
+		Build()
+	return &Reconciler{
+		Client:          c,
+		Scheme:          s,
+		ObserverBaseURL: server.URL,
+		httpClient:      server.Client(),
+	}
+}
+
+func reconcileExport(t *testing.T, r *Reconciler) *openchoreov1alpha1.ObservabilityExport {
+	t.Helper()
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: testKey})
+	require.NoError(t, err)
+	assert.Equal(t, statusSyncInterval, result.RequeueAfter)
+	out := &openchoreov1alpha1.ObservabilityExport{}
+	require.NoError(t, r.Get(context.Background(), testKey, out))
+	return out
+}
+
+func TestReconcileRegistersExport(t *testing.T) {
+	var got exportRequest
+	r := newTestReconciler(t, newTestExport(), func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/api/v1alpha1/exports/acme/audit", req.URL.Path)
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"lastExportTime":"2026-01-31T12:00:00Z","lastRunTime":"2026-01-31T12:01:00Z","exportedEntries":42}`))
+	})
+
+	out := reconcileExport(t, r)
+
+	assert.Equal(t, exportRequest{
+		Filter:      exportFilter{Project: "shop", Environment: "production", LogLevels: []string{"ERROR"}},
+		Mode:        "scheduled",
+		Interval:    "1h0m0s",
+		Compression: "gzip",
+		Since:       "2026-01-31T11:00:00Z",
+		Sink: exportSink{
+			Type: "http",
+			HTTP: &exportHTTPSink{
+				URL: "https://logs.example.com/ingest",
+				Headers: map[string]exportHeaderValue{
+					"X-Source":      {Value: "static"},
+					"Authorization": {SecretRef: &exportSecretRef{Name: "log-sink", Key: "token"}},
+				},
+			},
+		},
+	}, got)
+
+	require.NotNil(t, out.Status.LastExportTime)
+	assert.True(t, out.Status.LastExportTime.Time.Equal(testLastExport.Add(time.Hour)))
+	require.NotNil(t, out.Status.LastRunTime)
+	assert.Equal(t, int64(42), out.Status.ExportedEntries)
+	assert.Equal(t, int64(1), out.Status.ObservedGeneration)
+	cond := apimeta.FindStatusCondition(out.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, string(ReasonExporting), cond.Reason)
+}
+
+func TestReconcileRecordsExportFailure(t *testing.T) {
+	r := newTestReconciler(t, newTestExport(), func(w http.ResponseWriter, _ *http.Request) {
+		// A restarted observer has not exported anything yet; the recorded checkpoint is kept.
+		_, _ = w.Write([]byte(`{"exportedEntries":0,"lastError":"sink returned status 403: denied"}`))
+	})
+
+	out := reconcileExport(t, r)
+
+	require.NotNil(t, out.Status.LastExportTime)
+	assert.True(t, out.Status.LastExportTime.Time.Equal(testLastExport))
+	cond := apimeta.FindStatusCondition(out.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonExportFailed), cond.Reason)
+	assert.Contains(t, cond.Message, "status 403")
+}
+
+func TestReconcileRecordsRegistrationFailure(t *testing.T) {
+	r := newTestReconciler(t, newTestExport(), func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"invalid export: interval must be at least 5m0s"}`))
+	})
+
+	out := reconcileExport(t, r)
+
+	cond := apimeta.FindStatusCondition(out.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonRegistrationFailed), cond.Reason)
+	assert.Contains(t, cond.Message, "status 400: invalid export")
+}
+
+func TestReconcileAddsFinalizer(t *testing.T) {
+	export := newTestExport()
+	export.Finalizers = nil
+	r := newTestReconciler(t, export, func(http.ResponseWriter, *http.Request) {
+		t.Error("the observer must not be called before the finalizer is added")
+	})
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: testKey})
+	require.NoError(t, err)
+	assert.True(t, result.Requeue)
+	out := &openchoreov1alpha1.ObservabilityExport{}
+	require.NoError(t, r.Get(context.Background(), testKey, out))
+	assert.Contains(t, out.Finalizers, ExportCleanupFinalizer)
+}
+
+func TestReconcileDeletesExport(t *testing.T) {
+	var deleted bool
+	r := newTestReconciler(t, newTestExport(), func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, "/api/v1alpha1/exports/acme/audit", req.URL.Path)
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	ctx := context.Background()
+	export := &openchoreov1alpha1.ObservabilityExport{}
+	require.NoError(t, r.Get(ctx, testKey, export))
+	require.NoError(t, r.Delete(ctx, export))
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: testKey})
+	require.NoError(t, err)
+
+	assert.True(t, deleted)
+	err = r.Get(ctx, testKey, &openchoreov1alpha1.ObservabilityExport{})
+	assert.True(t, apierrors.IsNotFound(err), "the export is removed once the finalizer is released")
+}
+
+func TestBuildExportRequestSinks(t *testing.T) {
+	export := newTestExport()
+	export.Status.LastExportTime = nil
+	export.Spec.Mode = openchoreov1alpha1.ObservabilityExportModeContinuous
+	export.Spec.Sink = openchoreov1alpha1.ObservabilityExportSink{
+		Type: openchoreov1alpha1.ObservabilityExportSinkTypeS3,
+		S3: &openchoreov1alpha1.ObservabilityExportS3Sink{
+			Bucket:          "archive",
+			Region:          "eu-west-1",
+			AccessKeyID:     openchoreov1alpha1.SecretKeyRef{Name: "aws", Key: "id"},
+			SecretAccessKey: openchoreov1alpha1.SecretKeyRef{Name: "aws", Key: "secret"},
+		},
+	}
+
+	got := buildExportRequest(export)
+
+	assert.Equal(t, "continuous", got.Mode)
+	assert.Empty(t, got.Since)
+	assert.Nil(t, got.Sink.HTTP)
+	assert.Equal(t, &exportS3Sink{
+		Bucket:          "archive",
+		Region:          "eu-west-1",
+		AccessKeyID:     exportSecretRef{Name: "aws", Key: "id"},
+		SecretAccessKey: exportSecretRef{Name: "aws", Key: "secret"},
+	}, got.Sink.S3)
+}
@@ -37,6 +37,8 @@ type Reconciler struct {
 	ClientMgr     *kubernetesClient.KubeMultiClientManager
 	GatewayClient *gatewayClient.Client // Client for notifying cluster-gateway
 	CacheVersion  string                // Cache key version prefix (e.g., "v2")
+	// PlaneClientProvider provides the client used to provision Prometheus resources in the observability plane
+	PlaneClientProvider kubernetesClient.ObservabilityPlaneClientProvider
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=observabilityplanes,verbs=get;list;watch;create;update;patch;delete
@@ -80,6 +82,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		r.invalidateCache(ctx, observabilityPlane)
 	}
 
+	// Provision the Prometheus resources declared in the spec. This runs on every reconcile so that
+	// drift in the observability plane cluster is corrected on the periodic requeue.
+	r.reconcilePrometheus(ctx, observabilityPlane)
+
 	// Handle create
 	// Ignore reconcile if the ObservabilityPlane is already available since this is a one-time create
 	// However, we still want to update agent connection status periodically
@@ -204,6 +210,12 @@ func (r *Reconciler) finalize(ctx context.Context, _, observabilityPlane *opench
 		}
 	}
 
+	// Release the provisioned Prometheus resources on a best-effort basis so that an unreachable
+	// observability plane does not block deletion
+	if err := r.cleanupPrometheusResources(ctx, observabilityPlane); err != nil {
+		logger.Error(err, "failed to clean up Prometheus resources in the observability plane")
+	}
+
 	// Invalidate cached Kubernetes client before removing finalizer
 	// This ensures the cache is cleaned up even if the ObservabilityPlane CR is deleted
 	if r.ClientMgr != nil && r.CacheVersion != "" {
@@ -0,0 +1,370 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observabilityplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// ConditionPrometheusProvisioned reports whether the Prometheus resources declared in
+	// spec.prometheus are applied to the observability plane cluster.
+	ConditionPrometheusProvisioned controller.ConditionType = "PrometheusProvisioned"
+
+	ReasonPrometheusProvisioned      controller.ConditionReason = "Provisioned"
+	ReasonPrometheusProvisionFailed  controller.ConditionReason = "ProvisionFailed"
+	ReasonPrometheusPlaneUnreachable controller.ConditionReason = "PlaneUnreachable"
+
+	prometheusFieldOwner     = "observabilityplane-controller"
+	nullAlertmanagerReceiver = "null"
+	defaultPrometheusNS      = "openchoreo-observability-plane"
+	defaultPrometheusName    = "openchoreo-prometheus"
+)
+
+var (
+	prometheusGVK          = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "Prometheus"}
+	prometheusRuleGVK      = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+	alertmanagerConfigGVK  = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1alpha1", Kind: "AlertmanagerConfig"}
+	errPlaneClientNotReady = errors.New("observability plane client provider is not configured")
+)
+
+// reconcilePrometheus applies the Prometheus Operator resources declared in spec.prometheus to the
+// observability plane cluster and records the outcome on the PrometheusProvisioned condition.
+// Resources for sections that are no longer declared are removed. Failures are reported on the
+// condition rather than returned, so that they do not block the rest of the plane reconciliation.
+func (r *Reconciler) reconcilePrometheus(ctx context.Context, observabilityPlane *openchoreov1alpha1.ObservabilityPlane) {
+	logger := log.FromContext(ctx).WithValues("observabilityplane", observabilityPlane.Name)
+
+	provisioned := meta.FindStatusCondition(observabilityPlane.Status.Conditions, string(ConditionPrometheusProvisioned)) != nil
+	if observabilityPlane.Spec.Prometheus == nil && !provisioned {
+		return
+	}
+
+	opClient, err := r.observabilityPlaneClient(observabilityPlane)
+	if err != nil {
+		logger.Error(err, "failed to get observability plane client for Prometheus provisioning")
+		r.markPrometheusFailed(observabilityPlane, ReasonPrometheusPlaneUnreachable, err)
+		return
+	}
+
+	if err := applyPrometheusResources(ctx, opClient, observabilityPlane); err != nil {
+		logger.Error(err, "failed to provision Prometheus resources")
+		r.markPrometheusFailed(observabilityPlane, ReasonPrometheusProvisionFailed, err)
+		return
+	}
+
+	if observabilityPlane.Spec.Prometheus == nil {
+		meta.RemoveStatusCondition(&observabilityPlane.Status.Conditions, string(ConditionPrometheusProvisioned))
+		return
+	}
+	setPrometheusCondition(observabilityPlane, metav1.ConditionTrue, ReasonPrometheusProvisioned,
+		"Prometheus resources are provisioned in the observability plane cluster")
+}
+
+func (r *Reconciler) observabilityPlaneClient(observabilityPlane *openchoreov1alpha1.ObservabilityPlane) (client.Client, error) {
+	if r.PlaneClientProvider == nil {
+		return nil, errPlaneClientNotReady
+	}
+	return r.PlaneClientProvider.ObservabilityPlaneClient(observabilityPlane)
+}
+
+// markPrometheusFailed records a provisioning failure and emits a warning event when the
+// condition transitions, so that periodic requeues do not repeat the event.
+func (r *Reconciler) markPrometheusFailed(observabilityPlane *openchoreov1alpha1.ObservabilityPlane,
+	reason controller.ConditionReason, err error) {
+	if setPrometheusCondition(observabilityPlane, metav1.ConditionFalse, reason, err.Error()) && r.Recorder != nil {
+		r.Recorder.Event(observabilityPlane, corev1.EventTypeWarning, string(reason), err.Error())
+	}
+}
+
+func setPrometheusCondition(observabilityPlane *openchoreov1alpha1.ObservabilityPlane, status metav1.ConditionStatus,
+	reason controller.ConditionReason, message string) bool {
+	return meta.SetStatusCondition(&observabilityPlane.Status.Conditions,
+		controller.NewCondition(ConditionPrometheusProvisioned, status, reason, message, observabilityPlane.Generation))
+}
+
+// applyPrometheusResources server-side applies the desired Prometheus resources and deletes the
+// PrometheusRule and AlertmanagerConfig of sections that are empty or no longer declared.
+func applyPrometheusResources(ctx context.Context, opClient client.Client, observabilityPlane *openchoreov1alpha1.ObservabilityPlane) error {
+	spec := observabilityPlane.Spec.Prometheus
+	if spec == nil {
+		spec = &openchoreov1alpha1.ObservabilityPlanePrometheus{}
+	}
+
+	if err := applyRemoteWrite(ctx, opClient, observabilityPlane, spec); err != nil {
+		return err
+	}
+
+	rule := buildPrometheusRule(observabilityPlane, spec)
+	if len(spec.RecordingRuleGroups) > 0 {
+		if err := applyObject(ctx, opClient, rule); err != nil {
+			return err
+		}
+	} else if err := deleteObject(ctx, opClient, rule); err != nil {
+		return err
+	}
+
+	amConfig := buildAlertmanagerConfig(observabilityPlane, spec)
+	if len(spec.AlertmanagerRoutes) > 0 {
+		return applyObject(ctx, opClient, amConfig)
+	}
+	return deleteObject(ctx, opClient, amConfig)
+}
+
+// applyRemoteWrite applies the remote-write targets to the Prometheus resource. The Prometheus
+// resource is not owned by the observability plane, so when no targets are declared it is only
+// re-applied without a spec, which releases the remote-write targets applied earlier.
+func applyRemoteWrite(ctx context.Context, opClient client.Client, observabilityPlane *openchoreov1alpha1.ObservabilityPlane,
+	spec *openchoreov1alpha1.ObservabilityPlanePrometheus) error {
+	prom := buildPrometheus(observabilityPlane, spec)
+	if len(spec.RemoteWrite) > 0 {
+		return applyObject(ctx, opClient, prom)
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(prometheusGVK)
+	if err := opClient.Get(ctx, client.ObjectKeyFromObject(prom), existing); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get Prometheus %s/%s: %w", prom.GetNamespace(), prom.GetName(), err)
+	}
+	return applyObject(ctx, opClient, prom)
+}
+
+func applyObject(ctx context.Context, opClient client.Client, obj *unstructured.Unstructured) error {
+	if err := opClient.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(prometheusFieldOwner)); err != nil {
+		return fmt.Errorf("failed to apply %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	}
+	return nil
+}
+
+func deleteObject(ctx context.Context, opClient client.Client, obj *unstructured.Unstructured) error {
+	if err := opClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("failed to delete %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	}
+	return nil
+}
+
+// cleanupPrometheusResources removes the PrometheusRule and AlertmanagerConfig and releases the
+// remote-write targets provisioned for the observability plane.
+func (r *Reconciler) cleanupPrometheusResources(ctx context.Context, observabilityPlane *openchoreov1alpha1.ObservabilityPlane) error {
+	if meta.FindStatusCondition(observabilityPlane.Status.Conditions, string(ConditionPrometheusProvisioned)) == nil {
+		return nil
+	}
+	opClient, err := r.observabilityPlaneClient(observabilityPlane)
+	if err != nil {
+		return err
+	}
+	cleanup := observabilityPlane.DeepCopy()
+	cleanup.Spec.Prometheus = &openchoreov1alpha1.ObservabilityPlanePrometheus{
+		Namespace: prometheusNamespace(observabilityPlane.Spec.Prometheus),
+		Name:      prometheusName(observabilityPlane.Spec.Prometheus),
+	}
+	return applyPrometheusResources(ctx, opClient, cleanup)
+}
+
+func prometheusNamespace(spec *openchoreov1alpha1.ObservabilityPlanePrometheus) string {
+	if spec == nil || spec.Namespace == "" {
+		return defaultPrometheusNS
+	}
+	return spec.Namespace
+}
+
+func prometheusName(spec *openchoreov1alpha1.ObservabilityPlanePrometheus) string {
+	if spec == nil || spec.Name == "" {
+		return defaultPrometheusName
+	}
+	return spec.Name
+}
+
+// provisionedResourceName derives the name of a resource owned by the observability plane.
+// The namespace is included because ObservabilityPlanes in different control plane namespaces
+// may target the same cluster.
+func provisionedResourceName(observabilityPlane *openchoreov1alpha1.ObservabilityPlane, suffix string) string {
+	return fmt.Sprintf("%s-%s-%s", observabilityPlane.Namespace, observabilityPlane.Name, suffix)
+}
+
+func newProvisionedObject(gvk schema.GroupVersionKind, namespace, name string,
+	observabilityPlane *openchoreov1alpha1.ObservabilityPlane) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{
+		labels.LabelKeyManagedBy:              labels.LabelValueManagedBy,
+		labels.LabelKeyNamespaceName:          observabilityPlane.Namespace,
+		labels.LabelKeyObservabilityPlaneName: observabilityPlane.Name,
+	})
+	return obj
+}
+
+// buildPrometheus renders the remote-write targets as an apply configuration of the Prometheus resource.
+func buildPrometheus(observabilityPlane *openchoreov1alpha1.ObservabilityPlane,
+	spec *openchoreov1alpha1.ObservabilityPlanePrometheus) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(prometheusGVK)
+	obj.SetNamespace(prometheusNamespace(spec))
+	obj.SetName(prometheusName(spec))
+	if len(spec.RemoteWrite) == 0 {
+		return obj
+	}
+
+	remoteWrite := make([]any, 0, len(spec.RemoteWrite))
+	for _, rw := range spec.RemoteWrite {
+		target := map[string]any{
+			"name": provisionedResourceName(observabilityPlane, rw.Name),
+			"url":  rw.URL,
+		}
+		if len(rw.Headers) > 0 {
+			headers := make(map[string]any, len(rw.Headers))
+			for k, v := range rw.Headers {
+				headers[k] = v
+			}
+			target["headers"] = headers
+		}
+		if rw.BearerTokenSecretRef != nil {
+			target["authorization"] = map[string]any{
+				"type": "Bearer",
+				"credentials": map[string]any{
+					"name": rw.BearerTokenSecretRef.Name,
+					"key":  rw.BearerTokenSecretRef.Key,
+				},
+			}
+		}
+		remoteWrite = append(remoteWrite, target)
+	}
+	obj.Object["spec"] = map[string]any{"remoteWrite": remoteWrite}
+	return obj
+}
+
+// buildPrometheusRule renders the recording rule groups as a PrometheusRule.
+func buildPrometheusRule(observabilityPlane *openchoreov1alpha1.ObservabilityPlane,
+	spec *openchoreov1alpha1.ObservabilityPlanePrometheus) *unstructured.Unstructured {
+	obj := newProvisionedObject(prometheusRuleGVK, prometheusNamespace(spec),
+		provisionedResourceName(observabilityPlane, "recording-rules"), observabilityPlane)
+
+	groups := make([]any, 0, len(spec.RecordingRuleGroups))
+	for _, g := range spec.RecordingRuleGroups {
+		rules := make([]any, 0, len(g.Rules))
+		for _, rule := range g.Rules {
+			rendered := map[string]any{"record": rule.Record, "expr": rule.Expr}
+			if len(rule.Labels) > 0 {
+				ruleLabels := make(map[string]any, len(rule.Labels))
+				for k, v := range rule.Labels {
+					ruleLabels[k] = v
+				}
+				rendered["labels"] = ruleLabels
+			}
+			rules = append(rules, rendered)
+		}
+		group := map[string]any{"name": g.Name, "rules": rules}
+		if g.Interval != nil {
+			group["interval"] = formatPrometheusDuration(g.Interval.Duration)
+		}
+		groups = append(groups, group)
+	}
+	obj.Object["spec"] = map[string]any{"groups": groups}
+	return obj
+}
+
+// buildAlertmanagerConfig renders the Alertmanager routes as an AlertmanagerConfig. Alerts that
+// match none of the routes fall through to a receiver without integrations.
+func buildAlertmanagerConfig(observabilityPlane *openchoreov1alpha1.ObservabilityPlane,
+	spec *openchoreov1alpha1.ObservabilityPlanePrometheus) *unstructured.Unstructured {
+	obj := newProvisionedObject(alertmanagerConfigGVK, prometheusNamespace(spec),
+		provisionedResourceName(observabilityPlane, "alertmanager"), observabilityPlane)
+
+	routes := make([]any, 0, len(spec.AlertmanagerRoutes))
+	receivers := []any{map[string]any{"name": nullAlertmanagerReceiver}}
+	for _, route := range spec.AlertmanagerRoutes {
+		matchers := make([]any, 0, len(route.Matchers))
+		for _, m := range route.Matchers {
+			matchType := m.MatchType
+			if matchType == "" {
+				matchType = openchoreov1alpha1.AlertmanagerMatchEqual
+			}
+			matchers = append(matchers, map[string]any{
+				"name":      m.Name,
+				"value":     m.Value,
+				"matchType": string(matchType),
+			})
+		}
+		rendered := map[string]any{
+			"receiver": route.Name,
+			"matchers": matchers,
+			"continue": true,
+		}
+		if len(route.GroupBy) > 0 {
+			groupBy := make([]any, 0, len(route.GroupBy))
+			for _, l := range route.GroupBy {
+				groupBy = append(groupBy, l)
+			}
+			rendered["groupBy"] = groupBy
+		}
+		if route.RepeatInterval != nil {
+			rendered["repeatInterval"] = formatPrometheusDuration(route.RepeatInterval.Duration)
+		}
+		routes = append(routes, rendered)
+		receivers = append(receivers, map[string]any{
+			"name": route.Name,
+			"webhookConfigs": []any{
+				map[string]any{"url": route.WebhookURL, "sendResolved": true},
+			},
+		})
+	}
+	obj.Object["spec"] = map[string]any{
+		"route": map[string]any{
+			"receiver": nullAlertmanagerReceiver,
+			"routes":   routes,
+		},
+		"receivers": receivers,
+	}
+	return obj
+}
+
+// formatPrometheusDuration formats a duration in the Prometheus duration syntax, which does not
+// accept the fractional or zero-valued units produced by time.Duration.String.
+func formatPrometheusDuration(d time.Duration) string {
+	if d <= 0 {
+		return "0s"
+	}
+	units := []struct {
+		suffix string
+		size   time.Duration
+	}{
+		{"d", 24 * time.Hour},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+		{"ms", time.Millisecond},
+	}
+	out := ""
+	for _, u := range units {
+		if n := d / u.size; n > 0 {
+			out += fmt.Sprintf("%d%s", n, u.suffix)
+			d -= n * u.size
+		}
+	}
+	if out == "" {
+		return "0s"
+	}
+	return out
+}
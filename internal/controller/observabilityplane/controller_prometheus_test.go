@@ -0,0 +1,171 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observabilityplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newPrometheusTestPlane() *openchoreov1alpha1.ObservabilityPlane {
+	return &openchoreov1alpha1.ObservabilityPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "acme", Generation: 2},
+		Spec: openchoreov1alpha1.ObservabilityPlaneSpec{
+			Prometheus: &openchoreov1alpha1.ObservabilityPlanePrometheus{
+				RemoteWrite: []openchoreov1alpha1.PrometheusRemoteWrite{{
+					Name:                 "thanos",
+					URL:                  "https://thanos.example.com/api/v1/receive",
+					BearerTokenSecretRef: &openchoreov1alpha1.SecretKeyRef{Name: "thanos-token", Key: "token"},
+					Headers:              map[string]string{"X-Scope-OrgID": "acme"},
+				}},
+				RecordingRuleGroups: []openchoreov1alpha1.PrometheusRecordingRuleGroup{{
+					Name:     "http",
+					Interval: &metav1.Duration{Duration: 90 * time.Second},
+					Rules: []openchoreov1alpha1.PrometheusRecordingRule{{
+						Record: "component:http_requests:rate5m",
+						Expr:   "sum by (component) (rate(http_requests_total[5m]))",
+						Labels: map[string]string{"team": "platform"},
+					}},
+				}},
+				AlertmanagerRoutes: []openchoreov1alpha1.AlertmanagerRoute{{
+					Name:           "oncall",
+					Matchers:       []openchoreov1alpha1.AlertmanagerMatcher{{Name: "severity", Value: "critical"}},
+					WebhookURL:     "https://hooks.example.com/oncall",
+					GroupBy:        []string{"alertname"},
+					RepeatInterval: &metav1.Duration{Duration: 4 * time.Hour},
+				}},
+			},
+		},
+	}
+}
+
+func nestedSlice(t *testing.T, obj map[string]any, fields ...string) []any {
+	t.Helper()
+	v, found, err := unstructured.NestedSlice(obj, fields...)
+	if err != nil || !found {
+		t.Fatalf("field %v: found=%v err=%v", fields, found, err)
+	}
+	return v
+}
+
+func TestBuildPrometheus(t *testing.T) {
+	op := newPrometheusTestPlane()
+	prom := buildPrometheus(op, op.Spec.Prometheus)
+
+	if prom.GetNamespace() != defaultPrometheusNS || prom.GetName() != defaultPrometheusName {
+		t.Errorf("target: got %s/%s, want %s/%s", prom.GetNamespace(), prom.GetName(), defaultPrometheusNS, defaultPrometheusName)
+	}
+	remoteWrite := nestedSlice(t, prom.Object, "spec", "remoteWrite")
+	if len(remoteWrite) != 1 {
+		t.Fatalf("remoteWrite: got %d entries, want 1", len(remoteWrite))
+	}
+	target := remoteWrite[0].(map[string]any)
+	if target["name"] != "acme-default-thanos" {
+		t.Errorf("name: got %v", target["name"])
+	}
+	if token, _, _ := unstructured.NestedString(target, "authorization", "credentials", "name"); token != "thanos-token" {
+		t.Errorf("credentials secret: got %q", token)
+	}
+	if header, _, _ := unstructured.NestedString(target, "headers", "X-Scope-OrgID"); header != "acme" {
+		t.Errorf("header: got %q", header)
+	}
+
+	op.Spec.Prometheus.RemoteWrite = nil
+	if _, found := buildPrometheus(op, op.Spec.Prometheus).Object["spec"]; found {
+		t.Error("expected no spec when no remote-write targets are declared")
+	}
+}
+
+func TestBuildPrometheusRule(t *testing.T) {
+	op := newPrometheusTestPlane()
+	rule := buildPrometheusRule(op, op.Spec.Prometheus)
+
+	if rule.GetName() != "acme-default-recording-rules" {
+		t.Errorf("name: got %q", rule.GetName())
+	}
+	if rule.GetLabels()["openchoreo.dev/observabilityplane"] != "default" {
+		t.Errorf("labels: got %v", rule.GetLabels())
+	}
+	groups := nestedSlice(t, rule.Object, "spec", "groups")
+	group := groups[0].(map[string]any)
+	if group["interval"] != "1m30s" {
+		t.Errorf("interval: got %v", group["interval"])
+	}
+	rules := nestedSlice(t, group, "rules")
+	if rules[0].(map[string]any)["record"] != "component:http_requests:rate5m" {
+		t.Errorf("record: got %v", rules[0])
+	}
+}
+
+func TestBuildAlertmanagerConfig(t *testing.T) {
+	op := newPrometheusTestPlane()
+	cfg := buildAlertmanagerConfig(op, op.Spec.Prometheus)
+
+	if receiver, _, _ := unstructured.NestedString(cfg.Object, "spec", "route", "receiver"); receiver != nullAlertmanagerReceiver {
+		t.Errorf("default receiver: got %q", receiver)
+	}
+	routes := nestedSlice(t, cfg.Object, "spec", "route", "routes")
+	route := routes[0].(map[string]any)
+	if route["receiver"] != "oncall" || route["repeatInterval"] != "4h" {
+		t.Errorf("route: got %v", route)
+	}
+	matcher := nestedSlice(t, route, "matchers")[0].(map[string]any)
+	if matcher["matchType"] != "=" {
+		t.Errorf("matchType: got %v", matcher["matchType"])
+	}
+	receivers := nestedSlice(t, cfg.Object, "spec", "receivers")
+	if len(receivers) != 2 {
+		t.Fatalf("receivers: got %d, want 2", len(receivers))
+	}
+	webhook := nestedSlice(t, receivers[1].(map[string]any), "webhookConfigs")[0].(map[string]any)
+	if webhook["url"] != "https://hooks.example.com/oncall" {
+		t.Errorf("webhook url: got %v", webhook["url"])
+	}
+}
+
+func TestFormatPrometheusDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		0:                                    "0s",
+		500 * time.Millisecond:               "500ms",
+		90 * time.Second:                     "1m30s",
+		time.Hour:                            "1h",
+		30*time.Hour + 1500*time.Millisecond: "1d6h1s500ms",
+	}
+	for d, want := range tests {
+		if got := formatPrometheusDuration(d); got != want {
+			t.Errorf("formatPrometheusDuration(%v): got %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestReconcilePrometheusWithoutClientProvider(t *testing.T) {
+	r := &Reconciler{}
+
+	unconfigured := newPrometheusTestPlane()
+	unconfigured.Spec.Prometheus = nil
+	r.reconcilePrometheus(context.Background(), unconfigured)
+	if len(unconfigured.Status.Conditions) != 0 {
+		t.Errorf("expected no conditions when Prometheus is not configured, got %v", unconfigured.Status.Conditions)
+	}
+
+	op := newPrometheusTestPlane()
+	r.reconcilePrometheus(context.Background(), op)
+	cond := meta.FindStatusCondition(op.Status.Conditions, string(ConditionPrometheusProvisioned))
+	if cond == nil {
+		t.Fatal("expected PrometheusProvisioned condition")
+	}
+	if cond.Status != metav1.ConditionFalse || cond.Reason != string(ReasonPrometheusPlaneUnreachable) {
+		t.Errorf("condition: got %s/%s", cond.Status, cond.Reason)
+	}
+	if cond.ObservedGeneration != 2 {
+		t.Errorf("ObservedGeneration: got %d, want 2", cond.ObservedGeneration)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	// DefaultObserverInternalBaseURL is the URL of the observer internal API, which the
+	// controllers call to sync alert rules and exports and to evaluate SLOs, log metrics and
+	// anomalies. The service is only reachable within the cluster (not exposed via Gateway).
+	DefaultObserverInternalBaseURL = "http://observer-internal.openchoreo-observability-plane:8081"
+
+	// ObserverAPITimeout is the default timeout for HTTP calls to the observer internal API.
+	ObserverAPITimeout = 10 * time.Second
+)
+
+// ObserverInternalBaseURL returns the observer internal base URL. A non-empty override, such as
+// the ObserverBaseURL field of a reconciler, takes priority over the OBSERVER_INTERNAL_ENDPOINT
+// environment variable and the legacy OBSERVER_ENDPOINT one.
+func ObserverInternalBaseURL(override string) string {
+	if override != "" {
+		return override
+	}
+	if v := os.Getenv("OBSERVER_INTERNAL_ENDPOINT"); v != "" {
+		return v
+	}
+	// Fall back to legacy OBSERVER_ENDPOINT for backwards compatibility in tests.
+	if v := os.Getenv("OBSERVER_ENDPOINT"); v != "" {
+		return v
+	}
+	return DefaultObserverInternalBaseURL
+}
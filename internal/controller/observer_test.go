@@ -0,0 +1,59 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"os"
+	"testing"
+)
+
+func TestObserverInternalBaseURL(t *testing.T) {
+	t.Run("returns default when no env vars set", func(t *testing.T) {
+		t.Setenv("OBSERVER_INTERNAL_ENDPOINT", "")
+		t.Setenv("OBSERVER_ENDPOINT", "")
+		os.Unsetenv("OBSERVER_INTERNAL_ENDPOINT")
+		os.Unsetenv("OBSERVER_ENDPOINT")
+
+		if got := ObserverInternalBaseURL(""); got != DefaultObserverInternalBaseURL {
+			t.Errorf("expected default URL %q, got %q", DefaultObserverInternalBaseURL, got)
+		}
+	})
+
+	t.Run("returns OBSERVER_INTERNAL_ENDPOINT when set", func(t *testing.T) {
+		t.Setenv("OBSERVER_INTERNAL_ENDPOINT", "http://custom-observer:9090")
+		t.Setenv("OBSERVER_ENDPOINT", "")
+		os.Unsetenv("OBSERVER_ENDPOINT")
+
+		if got := ObserverInternalBaseURL(""); got != "http://custom-observer:9090" {
+			t.Errorf("expected %q, got %q", "http://custom-observer:9090", got)
+		}
+	})
+
+	t.Run("returns OBSERVER_ENDPOINT as legacy fallback", func(t *testing.T) {
+		t.Setenv("OBSERVER_INTERNAL_ENDPOINT", "")
+		os.Unsetenv("OBSERVER_INTERNAL_ENDPOINT")
+		t.Setenv("OBSERVER_ENDPOINT", "http://legacy-observer:8080")
+
+		if got := ObserverInternalBaseURL(""); got != "http://legacy-observer:8080" {
+			t.Errorf("expected %q, got %q", "http://legacy-observer:8080", got)
+		}
+	})
+
+	t.Run("OBSERVER_INTERNAL_ENDPOINT takes priority over OBSERVER_ENDPOINT", func(t *testing.T) {
+		t.Setenv("OBSERVER_INTERNAL_ENDPOINT", "http://internal:9090")
+		t.Setenv("OBSERVER_ENDPOINT", "http://legacy:8080")
+
+		if got := ObserverInternalBaseURL(""); got != "http://internal:9090" {
+			t.Errorf("OBSERVER_INTERNAL_ENDPOINT should take priority; got %q", got)
+		}
+	})
+
+	t.Run("override takes priority over env vars", func(t *testing.T) {
+		t.Setenv("OBSERVER_INTERNAL_ENDPOINT", "http://internal:9090")
+
+		if got := ObserverInternalBaseURL("http://override:8081"); got != "http://override:8081" {
+			t.Errorf("override should take priority; got %q", got)
+		}
+	})
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package orphanscanner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/secretexpiry"
+)
+
+const (
+	// defaultSchedule is used when the spec does not set a schedule.
+	defaultSchedule = "0 * * * *"
+	// defaultGracePeriod is used when the spec does not set a grace period.
+	defaultGracePeriod = time.Hour
+	// maxRecorded bounds the orphaned and missing resources recorded in status. The metrics
+	// cover every resource.
+	maxRecorded = 100
+)
+
+// Condition types and reasons for OrphanScanner.
+const (
+	// ConditionScanned indicates whether the last scan reached every plane.
+	ConditionScanned controller.ConditionType = "Scanned"
+	// ConditionOrphansFound indicates whether the last scan found orphaned resources.
+	ConditionOrphansFound controller.ConditionType = "OrphansFound"
+	// ConditionResourcesMissing indicates whether the last scan found resources that releases
+	// report but that no longer exist.
+	ConditionResourcesMissing controller.ConditionType = "ResourcesMissing"
+
+	// ReasonScanSucceeded indicates every plane was scanned.
+	ReasonScanSucceeded controller.ConditionReason = "ScanSucceeded"
+	// ReasonScanFailed indicates one or more planes could not be scanned.
+	ReasonScanFailed controller.ConditionReason = "ScanFailed"
+	// ReasonInvalidSchedule indicates the schedule is not a valid cron expression.
+	ReasonInvalidSchedule controller.ConditionReason = "InvalidSchedule"
+	// ReasonOrphanedResourcesFound indicates resources no release accounts for were found.
+	ReasonOrphanedResourcesFound controller.ConditionReason = "OrphanedResourcesFound"
+	// ReasonNoOrphanedResources indicates every managed resource belongs to a release.
+	ReasonNoOrphanedResources controller.ConditionReason = "NoOrphanedResources"
+	// ReasonMissingResourcesFound indicates resources reported by releases were not found.
+	ReasonMissingResourcesFound controller.ConditionReason = "MissingResourcesFound"
+	// ReasonNoMissingResources indicates every resource reported by a release exists.
+	ReasonNoMissingResources controller.ConditionReason = "NoMissingResources"
+)
+
+// Reconciler reconciles an OrphanScanner object by comparing, on the configured cron
+// schedule, the OpenChoreo-managed resources of its data planes with the RenderedReleases of
+// its namespace, and recording the resources that are orphaned or missing.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// PlaneClientProvider provides clients for the scanned data planes.
+	PlaneClientProvider kubernetesClient.PlaneClientProvider
+
+	// now returns the current time. Overridden in tests.
+	now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=orphanscanners,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=orphanscanners/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=renderedreleases,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	scanner := &openchoreov1alpha1.OrphanScanner{}
+	if err := r.Get(ctx, req.NamespacedName, scanner); err != nil {
+		if apierrors.IsNotFound(err) {
+			deleteScannerMetrics(req.Namespace, req.Name)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get OrphanScanner")
+		return ctrl.Result{}, err
+	}
+
+	old := scanner.DeepCopy()
+	now := r.clock()
+
+	schedule, err := secretexpiry.ParseSchedule(scheduleOf(scanner))
+	if err != nil {
+		scanner.Status.ObservedGeneration = scanner.Generation
+		scanner.Status.NextScanTime = nil
+		controller.MarkFalseCondition(scanner, ConditionScanned, ReasonInvalidSchedule, err.Error())
+		return ctrl.Result{}, r.updateStatus(ctx, old, scanner)
+	}
+
+	if scanDue(scanner, now) {
+		result := r.scan(ctx, scanner, now)
+		applyScanResult(scanner, result, now)
+		observeScan(scanner, result, now)
+	}
+	next := metav1.NewTime(schedule.Next(scanner.Status.LastScanTime.UTC()))
+	scanner.Status.NextScanTime = &next
+
+	if err := r.updateStatus(ctx, old, scanner); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	requeueAfter := next.Sub(now)
+	if requeueAfter <= 0 {
+		requeueAfter = time.Second
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// scanDue reports whether the planes must be scanned now: on the first reconcile, after a
+// spec change and when the next scheduled time has passed.
+func scanDue(scanner *openchoreov1alpha1.OrphanScanner, now time.Time) bool {
+	status := scanner.Status
+	return status.LastScanTime == nil || status.NextScanTime == nil ||
+		status.ObservedGeneration != scanner.Generation || !now.Before(status.NextScanTime.Time)
+}
+
+// applyScanResult records a scan in status.
+func applyScanResult(scanner *openchoreov1alpha1.OrphanScanner, result *scanResult, now time.Time) {
+	scannedAt := metav1.NewTime(now)
+	scanner.Status.ObservedGeneration = scanner.Generation
+	scanner.Status.LastScanTime = &scannedAt
+	scanner.Status.ScannedResources = int32(result.scanned)
+
+	orphaned := result.orphaned
+	if len(orphaned) > maxRecorded {
+		orphaned = orphaned[:maxRecorded]
+	}
+	scanner.Status.OrphanedResources = orphaned
+	missing := result.missing
+	if len(missing) > maxRecorded {
+		missing = missing[:maxRecorded]
+	}
+	scanner.Status.MissingResources = missing
+
+	if len(result.errors) > 0 {
+		controller.MarkFalseCondition(scanner, ConditionScanned, ReasonScanFailed, strings.Join(result.errors, "; "))
+	} else {
+		controller.MarkTrueCondition(scanner, ConditionScanned, ReasonScanSucceeded,
+			fmt.Sprintf("Scanned %d resources on %d planes", result.scanned, len(scanner.Spec.Planes)))
+	}
+
+	if n := len(result.orphaned); n > 0 {
+		deleted := 0
+		for _, o := range result.orphaned {
+			if o.Deleted {
+				deleted++
+			}
+		}
+		controller.MarkTrueCondition(scanner, ConditionOrphansFound, ReasonOrphanedResourcesFound,
+			fmt.Sprintf("%d orphaned resources, %d deleted", n, deleted))
+	} else {
+		controller.MarkFalseCondition(scanner, ConditionOrphansFound, ReasonNoOrphanedResources,
+			"Every managed resource belongs to a RenderedRelease")
+	}
+
+	if n := len(result.missing); n > 0 {
+		controller.MarkTrueCondition(scanner, ConditionResourcesMissing, ReasonMissingResourcesFound,
+			fmt.Sprintf("%d resources reported by RenderedReleases were not found", n))
+	} else {
+		controller.MarkFalseCondition(scanner, ConditionResourcesMissing, ReasonNoMissingResources,
+			"Every resource reported by a RenderedRelease exists")
+	}
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, old, scanner *openchoreov1alpha1.OrphanScanner) error {
+	if apiequality.Semantic.DeepEqual(old.Status, scanner.Status) {
+		return nil
+	}
+	if err := r.Status().Update(ctx, scanner); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update OrphanScanner status")
+		return err
+	}
+	return nil
+}
+
+func scheduleOf(scanner *openchoreov1alpha1.OrphanScanner) string {
+	if scanner.Spec.Schedule == "" {
+		return defaultSchedule
+	}
+	return scanner.Spec.Schedule
+}
+
+func gracePeriodOf(scanner *openchoreov1alpha1.OrphanScanner) time.Duration {
+	if scanner.Spec.GracePeriod.Duration <= 0 {
+		return defaultGracePeriod
+	}
+	return scanner.Spec.GracePeriod.Duration
+}
+
+func (r *Reconciler) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates must not trigger a scan; the scanner runs on its schedule.
+		For(&openchoreov1alpha1.OrphanScanner{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("orphanscanner").
+		Complete(r)
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package orphanscanner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/renderedrelease"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+var testNow = time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+
+var testPlane = openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp"}
+
+// fakePlaneClientProvider returns the same client for every plane.
+type fakePlaneClientProvider struct {
+	client client.Client
+}
+
+func (f *fakePlaneClientProvider) DataPlaneClient(_ *openchoreov1alpha1.DataPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) ClusterDataPlaneClient(_ *openchoreov1alpha1.ClusterDataPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) ObservabilityPlaneClient(_ *openchoreov1alpha1.ObservabilityPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) ClusterObservabilityPlaneClient(_ *openchoreov1alpha1.ClusterObservabilityPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) WorkflowPlaneClient(_ *openchoreov1alpha1.WorkflowPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) ClusterWorkflowPlaneClient(_ *openchoreov1alpha1.ClusterWorkflowPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func newTestScanner() *openchoreov1alpha1.OrphanScanner {
+	return &openchoreov1alpha1.OrphanScanner{
+		ObjectMeta: metav1.ObjectMeta{Name: "orphans", Namespace: "default", Generation: 1},
+		Spec: openchoreov1alpha1.OrphanScannerSpec{
+			Schedule:      "0 * * * *",
+			Planes:        []openchoreov1alpha1.TargetPlaneRef{testPlane},
+			CleanupPolicy: openchoreov1alpha1.OrphanCleanupPolicyReport,
+			GracePeriod:   metav1.Duration{Duration: time.Hour},
+		},
+	}
+}
+
+// newRelease returns a release of the checkout component in the dev environment that
+// renders the given resource IDs and reports the given resources as applied.
+func newRelease(name, uid string, ids []string, applied ...openchoreov1alpha1.RenderedManifestStatus) *openchoreov1alpha1.RenderedRelease {
+	release := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(uid)},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{
+			Owner:           openchoreov1alpha1.RenderedReleaseOwner{ProjectName: "shop", ComponentName: "checkout"},
+			EnvironmentName: "dev",
+		},
+		Status: openchoreov1alpha1.RenderedReleaseStatus{Resources: applied},
+	}
+	for _, id := range ids {
+		release.Spec.Resources = append(release.Spec.Resources, openchoreov1alpha1.RenderedManifest{ID: id})
+	}
+	return release
+}
+
+// managedDeployment returns a deployment labeled as applied for a release.
+func managedDeployment(name, release, uid, id string, created time.Time) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "dp-shop-dev",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				labels.LabelKeyManagedBy:                 renderedrelease.ControllerName,
+				labels.LabelKeyRenderedReleaseName:       release,
+				labels.LabelKeyRenderedReleaseNamespace:  "default",
+				labels.LabelKeyRenderedReleaseUID:        uid,
+				labels.LabelKeyRenderedReleaseResourceID: id,
+				labels.LabelKeyProjectName:               "shop",
+				labels.LabelKeyComponentName:             "legacy",
+			},
+		},
+	}
+}
+
+func newTestReconciler(t *testing.T, scanner *openchoreov1alpha1.OrphanScanner, cpObjects []client.Object, planeObjects ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, appsv1.AddToScheme(s))
+
+	dp := &openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "dp", Namespace: "default"}}
+	env := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"},
+		Spec: openchoreov1alpha1.EnvironmentSpec{
+			DataPlaneRef: &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane, Name: "dp"},
+		},
+	}
+	cp := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(append([]client.Object{scanner, dp, env}, cpObjects...)...).
+		WithStatusSubresource(scanner).
+		Build()
+	planeClient := fake.NewClientBuilder().WithScheme(s).WithObjects(planeObjects...).Build()
+	return &Reconciler{
+		Client:              cp,
+		Scheme:              s,
+		PlaneClientProvider: &fakePlaneClientProvider{client: planeClient},
+		now:                 func() time.Time { return testNow },
+	}, planeClient
+}
+
+func reconcileScanner(t *testing.T, r *Reconciler) (ctrl.Result, *openchoreov1alpha1.OrphanScanner) {
+	t.Helper()
+	key := types.NamespacedName{Name: "orphans", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	updated := &openchoreov1alpha1.OrphanScanner{}
+	require.NoError(t, r.Get(context.Background(), key, updated))
+	return result, updated
+}
+
+func TestReconcile_RecordsOrphanedAndMissingResources(t *testing.T) {
+	old := testNow.Add(-2 * time.Hour)
+	release := newRelease("checkout-dev", "uid-1", []string{"deployment"},
+		openchoreov1alpha1.RenderedManifestStatus{ID: "deployment", Group: "apps", Version: "v1", Kind: "Deployment",
+			Namespace: "dp-shop-dev", Name: "checkout"},
+		openchoreov1alpha1.RenderedManifestStatus{ID: "config", Version: "v1", Kind: "ConfigMap",
+			Namespace: "dp-shop-dev", Name: "checkout-config"},
+	)
+	r, _ := newTestReconciler(t, newTestScanner(), []client.Object{release},
+		managedDeployment("checkout", "checkout-dev", "uid-1", "deployment", old),
+		managedDeployment("checkout-worker", "checkout-dev", "uid-1", "worker", old),
+		managedDeployment("legacy", "legacy-dev", "uid-0", "deployment", old),
+		managedDeployment("fresh", "gone-dev", "uid-9", "deployment", testNow.Add(-time.Minute)),
+	)
+
+	result, scanner := reconcileScanner(t, r)
+
+	assert.Equal(t, 30*time.Minute, result.RequeueAfter)
+	assert.Equal(t, testNow, scanner.Status.LastScanTime.UTC())
+	assert.Equal(t, int32(4), scanner.Status.ScannedResources)
+
+	require.Len(t, scanner.Status.OrphanedResources, 2)
+	worker := scanner.Status.OrphanedResources[0]
+	assert.Equal(t, "checkout-worker", worker.Name)
+	assert.Equal(t, openchoreov1alpha1.OrphanReasonNotInInventory, worker.Reason)
+	assert.Equal(t, "checkout", worker.Component, "the component comes from the release when it exists")
+	assert.Equal(t, "apps/v1", worker.APIVersion)
+	assert.Equal(t, testPlane, worker.Plane)
+	legacy := scanner.Status.OrphanedResources[1]
+	assert.Equal(t, "legacy", legacy.Name)
+	assert.Equal(t, openchoreov1alpha1.OrphanReasonReleaseNotFound, legacy.Reason)
+	assert.Equal(t, "legacy", legacy.Component, "the component comes from the labels when the release is gone")
+	assert.False(t, legacy.Deleted)
+
+	require.Len(t, scanner.Status.MissingResources, 1)
+	missing := scanner.Status.MissingResources[0]
+	assert.Equal(t, "checkout-config", missing.Name)
+	assert.Equal(t, "v1", missing.APIVersion)
+	assert.Equal(t, "dev", missing.Environment)
+	assert.Equal(t, testPlane, missing.Plane)
+
+	orphans := apimeta.FindStatusCondition(scanner.Status.Conditions, string(ConditionOrphansFound))
+	require.NotNil(t, orphans)
+	assert.Equal(t, metav1.ConditionTrue, orphans.Status)
+	assert.Equal(t, "2 orphaned resources, 0 deleted", orphans.Message)
+	scanned := apimeta.FindStatusCondition(scanner.Status.Conditions, string(ConditionScanned))
+	require.NotNil(t, scanned)
+	assert.Equal(t, metav1.ConditionTrue, scanned.Status)
+}
+
+func TestReconcile_DeletesOrphans(t *testing.T) {
+	scanner := newTestScanner()
+	scanner.Spec.CleanupPolicy = openchoreov1alpha1.OrphanCleanupPolicyDelete
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:              "dp-shop-old",
+		CreationTimestamp: metav1.NewTime(testNow.Add(-48 * time.Hour)),
+		Labels: map[string]string{
+			labels.LabelKeyManagedBy:                renderedrelease.ControllerName,
+			labels.LabelKeyRenderedReleaseName:      "old-dev",
+			labels.LabelKeyRenderedReleaseNamespace: "default",
+		},
+	}}
+	r, planeClient := newTestReconciler(t, scanner, nil,
+		managedDeployment("legacy", "legacy-dev", "uid-0", "deployment", testNow.Add(-2*time.Hour)), ns)
+
+	_, updated := reconcileScanner(t, r)
+
+	require.Len(t, updated.Status.OrphanedResources, 2)
+	for _, o := range updated.Status.OrphanedResources {
+		assert.Equal(t, o.Kind == "Deployment", o.Deleted, "%s %s", o.Kind, o.Name)
+	}
+	err := planeClient.Get(context.Background(), client.ObjectKey{Namespace: "dp-shop-dev", Name: "legacy"}, &appsv1.Deployment{})
+	assert.True(t, apierrors.IsNotFound(err))
+	require.NoError(t, planeClient.Get(context.Background(), client.ObjectKey{Name: "dp-shop-old"}, &corev1.Namespace{}),
+		"namespaces are never deleted")
+}
+
+func TestReconcile_SkipsReleasesBeingDeleted(t *testing.T) {
+	release := newRelease("checkout-dev", "uid-1", nil)
+	release.Finalizers = []string{"openchoreo.dev/test"}
+	release.DeletionTimestamp = &metav1.Time{Time: testNow}
+	r, _ := newTestReconciler(t, newTestScanner(), []client.Object{release},
+		managedDeployment("checkout", "checkout-dev", "uid-1", "deployment", testNow.Add(-2*time.Hour)))
+
+	_, updated := reconcileScanner(t, r)
+
+	assert.Empty(t, updated.Status.OrphanedResources)
+	cond := apimeta.FindStatusCondition(updated.Status.Conditions, string(ConditionOrphansFound))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+}
+
+func TestReconcile_WaitsForSchedule(t *testing.T) {
+	scanner := newTestScanner()
+	lastScan := metav1.NewTime(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC))
+	nextScan := metav1.NewTime(time.Date(2026, 3, 1, 11, 0, 0, 0, time.UTC))
+	scanner.Status = openchoreov1alpha1.OrphanScannerStatus{
+		ObservedGeneration: 1,
+		LastScanTime:       &lastScan,
+		NextScanTime:       &nextScan,
+		ScannedResources:   7,
+	}
+	r, _ := newTestReconciler(t, scanner, nil,
+		managedDeployment("legacy", "legacy-dev", "uid-0", "deployment", testNow.Add(-2*time.Hour)))
+
+	result, updated := reconcileScanner(t, r)
+
+	assert.Equal(t, 30*time.Minute, result.RequeueAfter)
+	assert.Equal(t, int32(7), updated.Status.ScannedResources, "the planes must not be scanned before the next scheduled time")
+	assert.Empty(t, updated.Status.OrphanedResources)
+}
+
+func TestReconcile_InvalidSchedule(t *testing.T) {
+	scanner := newTestScanner()
+	scanner.Spec.Schedule = "every hour"
+	r, _ := newTestReconciler(t, scanner, nil)
+
+	result, updated := reconcileScanner(t, r)
+
+	assert.Zero(t, result.RequeueAfter)
+	assert.Nil(t, updated.Status.LastScanTime)
+	cond := apimeta.FindStatusCondition(updated.Status.Conditions, string(ConditionScanned))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonInvalidSchedule), cond.Reason)
+}
+
+func TestReconcile_PlaneFailureDoesNotStopScan(t *testing.T) {
+	scanner := newTestScanner()
+	scanner.Spec.Planes = append(scanner.Spec.Planes, openchoreov1alpha1.TargetPlaneRef{Kind: "ClusterDataPlane", Name: "missing"})
+	r, _ := newTestReconciler(t, scanner, nil,
+		managedDeployment("legacy", "legacy-dev", "uid-0", "deployment", testNow.Add(-2*time.Hour)))
+
+	_, updated := reconcileScanner(t, r)
+
+	assert.Len(t, updated.Status.OrphanedResources, 1)
+	cond := apimeta.FindStatusCondition(updated.Status.Conditions, string(ConditionScanned))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Contains(t, cond.Message, "ClusterDataPlane missing")
+}
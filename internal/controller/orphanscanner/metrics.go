@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package orphanscanner
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Orphan scanner metrics exposed on the controller manager's metrics endpoint. The gauges
+// count every orphaned and missing resource of the last scan, including those beyond the
+// status limit.
+var (
+	orphanedResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_orphan_scanner_orphaned_resources",
+			Help: "Number of data plane resources no RenderedRelease accounts for, as of the last scan.",
+		},
+		[]string{"scanner_namespace", "scanner", "plane_kind", "plane", "project", "component", "reason"},
+	)
+
+	missingResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_orphan_scanner_missing_resources",
+			Help: "Number of resources reported by RenderedReleases that do not exist in the data plane, as of the last scan.",
+		},
+		[]string{"scanner_namespace", "scanner", "plane_kind", "plane", "project", "component"},
+	)
+
+	deletedResourcesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openchoreo_orphan_scanner_deleted_resources_total",
+			Help: "Number of orphaned resources deleted by orphan scans.",
+		},
+		[]string{"scanner_namespace", "scanner"},
+	)
+
+	lastScanTimestampSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_orphan_scanner_last_scan_timestamp_seconds",
+			Help: "Time of the last orphan scan, in Unix seconds.",
+		},
+		[]string{"scanner_namespace", "scanner"},
+	)
+
+	scanErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openchoreo_orphan_scanner_scan_errors_total",
+			Help: "Number of orphan scans that could not complete on every plane.",
+		},
+		[]string{"scanner_namespace", "scanner"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		orphanedResources,
+		missingResources,
+		deletedResourcesTotal,
+		lastScanTimestampSeconds,
+		scanErrorsTotal,
+	)
+}
+
+// observeScan replaces the orphan and missing resource series of a scanner with the results
+// of a scan.
+func observeScan(scanner *openchoreov1alpha1.OrphanScanner, result *scanResult, now time.Time) {
+	labels := scannerLabels(scanner.Namespace, scanner.Name)
+	orphanedResources.DeletePartialMatch(labels)
+	missingResources.DeletePartialMatch(labels)
+
+	deleted := 0
+	for _, o := range result.orphaned {
+		orphanedResources.WithLabelValues(scanner.Namespace, scanner.Name, o.Plane.Kind, o.Plane.Name,
+			o.Project, o.Component, string(o.Reason)).Inc()
+		if o.Deleted {
+			deleted++
+		}
+	}
+	for _, m := range result.missing {
+		missingResources.WithLabelValues(scanner.Namespace, scanner.Name, m.Plane.Kind, m.Plane.Name,
+			m.Project, m.Component).Inc()
+	}
+	if deleted > 0 {
+		deletedResourcesTotal.WithLabelValues(scanner.Namespace, scanner.Name).Add(float64(deleted))
+	}
+	lastScanTimestampSeconds.WithLabelValues(scanner.Namespace, scanner.Name).Set(float64(now.Unix()))
+	if len(result.errors) > 0 {
+		scanErrorsTotal.WithLabelValues(scanner.Namespace, scanner.Name).Inc()
+	}
+}
+
+// deleteScannerMetrics removes the series of a deleted scanner.
+func deleteScannerMetrics(namespace, name string) {
+	labels := scannerLabels(namespace, name)
+	orphanedResources.DeletePartialMatch(labels)
+	missingResources.DeletePartialMatch(labels)
+	deletedResourcesTotal.DeletePartialMatch(labels)
+	lastScanTimestampSeconds.DeletePartialMatch(labels)
+	scanErrorsTotal.DeletePartialMatch(labels)
+}
+
+func scannerLabels(namespace, name string) prometheus.Labels {
+	return prometheus.Labels{"scanner_namespace": namespace, "scanner": name}
+}
@@ -0,0 +1,381 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package orphanscanner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/renderedrelease"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// targetPlaneObservabilityPlane is the RenderedRelease target plane that is not scanned.
+const targetPlaneObservabilityPlane = "observabilityplane"
+
+// scanResult is the outcome of scanning every plane of a scanner. Planes that fail are
+// recorded in errors and do not stop the scan of the others.
+type scanResult struct {
+	scanned  int
+	orphaned []openchoreov1alpha1.OrphanedResource
+	missing  []openchoreov1alpha1.MissingResource
+	errors   []string
+}
+
+// resourceKey identifies a resource in a plane independently of its API version.
+type resourceKey struct {
+	group     string
+	kind      string
+	namespace string
+	name      string
+}
+
+func keyOfObject(obj *unstructured.Unstructured) resourceKey {
+	gvk := obj.GroupVersionKind()
+	return resourceKey{group: gvk.Group, kind: gvk.Kind, namespace: obj.GetNamespace(), name: obj.GetName()}
+}
+
+func keyOfStatus(res openchoreov1alpha1.RenderedManifestStatus) resourceKey {
+	return resourceKey{group: res.Group, kind: res.Kind, namespace: res.Namespace, name: res.Name}
+}
+
+// inventory indexes the RenderedReleases of the scanner's namespace by what they render
+// (resource IDs in spec) and what they report as applied (resources in status).
+type inventory struct {
+	releases map[string]*openchoreov1alpha1.RenderedRelease
+	ids      map[string]map[string]bool
+	applied  map[string]map[resourceKey]bool
+}
+
+func newInventory(releases []openchoreov1alpha1.RenderedRelease) *inventory {
+	inv := &inventory{
+		releases: make(map[string]*openchoreov1alpha1.RenderedRelease, len(releases)),
+		ids:      make(map[string]map[string]bool, len(releases)),
+		applied:  make(map[string]map[resourceKey]bool, len(releases)),
+	}
+	for i := range releases {
+		release := &releases[i]
+		inv.releases[release.Name] = release
+		ids := make(map[string]bool, len(release.Spec.Resources))
+		for _, res := range release.Spec.Resources {
+			ids[res.ID] = true
+		}
+		inv.ids[release.Name] = ids
+		applied := make(map[resourceKey]bool, len(release.Status.Resources))
+		for _, res := range release.Status.Resources {
+			applied[keyOfStatus(res)] = true
+		}
+		inv.applied[release.Name] = applied
+	}
+	return inv
+}
+
+// gvks returns the resource types to list in a data plane: the types data-plane releases
+// typically manage and every type a release reports as applied, in a stable order.
+func (inv *inventory) gvks() []schema.GroupVersionKind {
+	set := map[schema.GroupVersionKind]bool{}
+	for _, gvk := range renderedrelease.WellKnownDataPlaneGVKs() {
+		set[gvk] = true
+	}
+	for _, release := range inv.releases {
+		if release.Spec.TargetPlane == targetPlaneObservabilityPlane {
+			continue
+		}
+		for _, res := range release.Status.Resources {
+			set[schema.GroupVersionKind{Group: res.Group, Version: res.Version, Kind: res.Kind}] = true
+		}
+	}
+	gvks := make([]schema.GroupVersionKind, 0, len(set))
+	for gvk := range set {
+		gvks = append(gvks, gvk)
+	}
+	sort.Slice(gvks, func(i, j int) bool { return gvks[i].String() < gvks[j].String() })
+	return gvks
+}
+
+// orphanReason reports why a managed resource is orphaned. The second result is false when
+// a release accounts for the resource, or when the release is being deleted and its
+// finalizer will remove the resource.
+func (inv *inventory) orphanReason(obj *unstructured.Unstructured) (openchoreov1alpha1.OrphanReason, bool) {
+	objLabels := obj.GetLabels()
+	release := inv.releases[objLabels[labels.LabelKeyRenderedReleaseName]]
+	if release == nil || string(release.UID) != objLabels[labels.LabelKeyRenderedReleaseUID] {
+		return openchoreov1alpha1.OrphanReasonReleaseNotFound, true
+	}
+	if !release.DeletionTimestamp.IsZero() {
+		return "", false
+	}
+	if inv.ids[release.Name][objLabels[labels.LabelKeyRenderedReleaseResourceID]] ||
+		inv.applied[release.Name][keyOfObject(obj)] {
+		return "", false
+	}
+	return openchoreov1alpha1.OrphanReasonNotInInventory, true
+}
+
+// scan compares the managed resources of every plane of the scanner with the RenderedReleases
+// of its namespace. Without the releases nothing can be attributed, so a failure to list them
+// fails the whole scan.
+func (r *Reconciler) scan(ctx context.Context, scanner *openchoreov1alpha1.OrphanScanner, now time.Time) *scanResult {
+	result := &scanResult{}
+
+	releases := &openchoreov1alpha1.RenderedReleaseList{}
+	if err := r.List(ctx, releases, client.InNamespace(scanner.Namespace)); err != nil {
+		result.errors = append(result.errors, fmt.Sprintf("failed to list RenderedReleases: %v", err))
+		return result
+	}
+	inv := newInventory(releases.Items)
+	releasesByPlane := r.releasesByPlane(ctx, scanner.Namespace, releases.Items)
+
+	for _, plane := range scanner.Spec.Planes {
+		planeClient, err := r.planeClient(ctx, scanner.Namespace, plane)
+		if err != nil {
+			result.errors = append(result.errors, fmt.Sprintf("%s %s: %v", plane.Kind, plane.Name, err))
+			continue
+		}
+		if err := scanPlane(ctx, planeClient, scanner, plane, inv, releasesByPlane[plane], now, result); err != nil {
+			result.errors = append(result.errors, fmt.Sprintf("%s %s: %v", plane.Kind, plane.Name, err))
+		}
+	}
+
+	sort.SliceStable(result.orphaned, func(i, j int) bool {
+		a, b := result.orphaned[i], result.orphaned[j]
+		if a.RenderedRelease != b.RenderedRelease {
+			return a.RenderedRelease < b.RenderedRelease
+		}
+		return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+	})
+	return result
+}
+
+// scanPlane lists the resources a plane holds for the releases of the scanner's namespace,
+// records the orphaned ones, deletes them when the cleanup policy says so, and records the
+// resources the plane's releases report that do not exist.
+func scanPlane(ctx context.Context, c client.Client, scanner *openchoreov1alpha1.OrphanScanner,
+	plane openchoreov1alpha1.TargetPlaneRef, inv *inventory, releases []*openchoreov1alpha1.RenderedRelease,
+	now time.Time, result *scanResult) error {
+	logger := log.FromContext(ctx)
+	gracePeriod := gracePeriodOf(scanner)
+
+	live, err := listManagedResources(ctx, c, scanner.Namespace, inv.gvks())
+	if err != nil {
+		return err
+	}
+	result.scanned += len(live)
+
+	present := make(map[resourceKey]bool, len(live))
+	for _, obj := range live {
+		present[keyOfObject(obj)] = true
+		if obj.GetCreationTimestamp().Add(gracePeriod).After(now) {
+			continue
+		}
+		reason, orphaned := inv.orphanReason(obj)
+		if !orphaned {
+			continue
+		}
+
+		orphan := orphanedResource(obj, plane, inv, reason)
+		if scanner.Spec.CleanupPolicy == openchoreov1alpha1.OrphanCleanupPolicyDelete && orphan.Kind != "Namespace" {
+			deleted, err := deleteOrphan(ctx, c, obj)
+			if err != nil {
+				result.errors = append(result.errors, fmt.Sprintf("%s %s: failed to delete %s %s: %v",
+					plane.Kind, plane.Name, orphan.Kind, client.ObjectKeyFromObject(obj), err))
+			} else if deleted {
+				logger.Info("Deleted orphaned resource", "plane", plane.Name, "kind", orphan.Kind,
+					"namespace", orphan.Namespace, "name", orphan.Name, "renderedRelease", orphan.RenderedRelease)
+			}
+			orphan.Deleted = deleted
+		}
+		result.orphaned = append(result.orphaned, orphan)
+	}
+
+	for _, release := range releases {
+		if !release.DeletionTimestamp.IsZero() {
+			continue
+		}
+		for _, res := range release.Status.Resources {
+			if present[keyOfStatus(res)] {
+				continue
+			}
+			exists, err := resourceExists(ctx, c, res)
+			if err != nil {
+				return fmt.Errorf("failed to get %s %s/%s: %w", res.Kind, res.Namespace, res.Name, err)
+			}
+			if exists {
+				continue
+			}
+			result.missing = append(result.missing, openchoreov1alpha1.MissingResource{
+				Plane:           plane,
+				RenderedRelease: release.Name,
+				Project:         release.Spec.Owner.ProjectName,
+				Component:       release.Spec.Owner.ComponentName,
+				Environment:     release.Spec.EnvironmentName,
+				APIVersion:      schema.GroupVersion{Group: res.Group, Version: res.Version}.String(),
+				Kind:            res.Kind,
+				Namespace:       res.Namespace,
+				Name:            res.Name,
+			})
+		}
+	}
+	return nil
+}
+
+// orphanedResource describes an orphaned resource. The project and component come from the
+// release when it still exists, and from the resource labels otherwise.
+func orphanedResource(obj *unstructured.Unstructured, plane openchoreov1alpha1.TargetPlaneRef, inv *inventory,
+	reason openchoreov1alpha1.OrphanReason) openchoreov1alpha1.OrphanedResource {
+	objLabels := obj.GetLabels()
+	orphan := openchoreov1alpha1.OrphanedResource{
+		Plane:           plane,
+		APIVersion:      obj.GetAPIVersion(),
+		Kind:            obj.GetKind(),
+		Namespace:       obj.GetNamespace(),
+		Name:            obj.GetName(),
+		RenderedRelease: objLabels[labels.LabelKeyRenderedReleaseName],
+		Project:         objLabels[labels.LabelKeyProjectName],
+		Component:       objLabels[labels.LabelKeyComponentName],
+		Reason:          reason,
+	}
+	if release := inv.releases[orphan.RenderedRelease]; release != nil {
+		orphan.Project = release.Spec.Owner.ProjectName
+		orphan.Component = release.Spec.Owner.ComponentName
+	}
+	return orphan
+}
+
+// listManagedResources lists the resources of the given types that the RenderedRelease
+// controller applied for releases of a namespace. Types the plane does not serve are skipped.
+func listManagedResources(ctx context.Context, c client.Client, releaseNamespace string,
+	gvks []schema.GroupVersionKind) ([]*unstructured.Unstructured, error) {
+	selector := client.MatchingLabels{
+		labels.LabelKeyManagedBy:                renderedrelease.ControllerName,
+		labels.LabelKeyRenderedReleaseNamespace: releaseNamespace,
+	}
+
+	var resources []*unstructured.Unstructured
+	for _, gvk := range gvks {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.List(ctx, list, selector); err != nil {
+			if apimeta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %s: %w", gvk.String(), err)
+		}
+		for i := range list.Items {
+			resources = append(resources, &list.Items[i])
+		}
+	}
+	return resources, nil
+}
+
+// resourceExists reports whether a resource reported by a release exists in a plane,
+// whatever its labels.
+func resourceExists(ctx context.Context, c client.Client, res openchoreov1alpha1.RenderedManifestStatus) (bool, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: res.Group, Version: res.Version, Kind: res.Kind})
+	if err := c.Get(ctx, client.ObjectKey{Namespace: res.Namespace, Name: res.Name}, obj); err != nil {
+		if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// deleteOrphan deletes an orphaned resource. The UID precondition keeps a resource that was
+// recreated since it was listed from being deleted. It reports whether the resource is gone.
+func deleteOrphan(ctx context.Context, c client.Client, obj *unstructured.Unstructured) (bool, error) {
+	uid := obj.GetUID()
+	err := c.Delete(ctx, obj, client.Preconditions{UID: &uid}, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	switch {
+	case err == nil, apierrors.IsNotFound(err):
+		return true, nil
+	case apierrors.IsConflict(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// releasesByPlane groups the data-plane releases of a namespace by the plane of their
+// environment. Releases whose environment cannot be resolved are left out; they are not
+// reported as missing resources.
+func (r *Reconciler) releasesByPlane(ctx context.Context, namespace string,
+	releases []openchoreov1alpha1.RenderedRelease) map[openchoreov1alpha1.TargetPlaneRef][]*openchoreov1alpha1.RenderedRelease {
+	logger := log.FromContext(ctx)
+	planes := map[string]*openchoreov1alpha1.TargetPlaneRef{}
+	byPlane := map[openchoreov1alpha1.TargetPlaneRef][]*openchoreov1alpha1.RenderedRelease{}
+	for i := range releases {
+		release := &releases[i]
+		if release.Spec.TargetPlane == targetPlaneObservabilityPlane {
+			continue
+		}
+		envName := release.Spec.EnvironmentName
+		plane, ok := planes[envName]
+		if !ok {
+			var err error
+			if plane, err = r.environmentPlane(ctx, namespace, envName); err != nil {
+				logger.Info("Failed to resolve the data plane of an environment; its releases are not checked for missing resources",
+					"environment", envName, "error", err.Error())
+			}
+			planes[envName] = plane
+		}
+		if plane != nil {
+			byPlane[*plane] = append(byPlane[*plane], release)
+		}
+	}
+	return byPlane
+}
+
+// environmentPlane returns the data plane an environment deploys to.
+func (r *Reconciler) environmentPlane(ctx context.Context, namespace, envName string) (*openchoreov1alpha1.TargetPlaneRef, error) {
+	env := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: envName}, env); err != nil {
+		return nil, fmt.Errorf("failed to get Environment: %w", err)
+	}
+	dp, err := controller.GetDataPlaneFromRef(ctx, r.Client, env.Namespace, env.Spec.DataPlaneRef)
+	if err != nil {
+		return nil, err
+	}
+	if dp.DataPlane != nil {
+		return &openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: dp.DataPlane.Name}, nil
+	}
+	return &openchoreov1alpha1.TargetPlaneRef{Kind: "ClusterDataPlane", Name: dp.ClusterDataPlane.Name}, nil
+}
+
+// planeClient returns a client for a scanned plane. Namespaced planes are looked up in the
+// namespace of the scanner.
+func (r *Reconciler) planeClient(ctx context.Context, namespace string, plane openchoreov1alpha1.TargetPlaneRef) (client.Client, error) {
+	if r.PlaneClientProvider == nil {
+		return nil, fmt.Errorf("plane client provider is not configured")
+	}
+	switch plane.Kind {
+	case "DataPlane":
+		dp := &openchoreov1alpha1.DataPlane{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: plane.Name}, dp); err != nil {
+			return nil, fmt.Errorf("failed to get DataPlane: %w", err)
+		}
+		return r.PlaneClientProvider.DataPlaneClient(dp)
+	case "ClusterDataPlane":
+		cdp := &openchoreov1alpha1.ClusterDataPlane{}
+		if err := r.Get(ctx, client.ObjectKey{Name: plane.Name}, cdp); err != nil {
+			return nil, fmt.Errorf("failed to get ClusterDataPlane: %w", err)
+		}
+		return r.PlaneClientProvider.ClusterDataPlaneClient(cdp)
+	default:
+		return nil, fmt.Errorf("unsupported plane kind %q", plane.Kind)
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// ProjectQuotaResource names a limit of a project quota.
+type ProjectQuotaResource string
+
+const (
+	ProjectQuotaComponents   ProjectQuotaResource = "Components"
+	ProjectQuotaEnvironments ProjectQuotaResource = "Environments"
+	ProjectQuotaCPU          ProjectQuotaResource = "CPU"
+	ProjectQuotaMemory       ProjectQuotaResource = "Memory"
+)
+
+// ProjectQuotaExceededError is returned when a change would take a project beyond a limit of
+// its quota.
+type ProjectQuotaExceededError struct {
+	Project  string
+	Resource ProjectQuotaResource
+	Limit    resource.Quantity
+	Usage    resource.Quantity
+}
+
+func (e *ProjectQuotaExceededError) Error() string {
+	return fmt.Sprintf("project %s has exhausted its %s quota (%s used of %s)",
+		e.Project, strings.ToLower(string(e.Resource)), e.Usage.String(), e.Limit.String())
+}
+
+// Remaining returns what is left of the exhausted limit, which is zero unless the limit was
+// raised after the check.
+func (e *ProjectQuotaExceededError) Remaining() resource.Quantity {
+	remaining := e.Limit.DeepCopy()
+	remaining.Sub(e.Usage)
+	if remaining.Sign() < 0 {
+		return *resource.NewQuantity(0, e.Limit.Format)
+	}
+	return remaining
+}
+
+// ProjectQuotaUsage is the usage of a project counted against its quota.
+type ProjectQuotaUsage struct {
+	// Components is the number of components of the project.
+	Components int32
+	// Environments are the sorted names of the environments the components are deployed to.
+	Environments []string
+	// CPU and Memory are requested by the pods of the rendered releases of the project, at the
+	// replica count their workloads declare.
+	CPU    resource.Quantity
+	Memory resource.Quantity
+}
+
+// GetProjectQuotaUsage counts the components, the environments deployed to and the CPU and
+// memory requested by the releases of a project. Objects that are being deleted are not counted.
+func GetProjectQuotaUsage(ctx context.Context, c client.Reader, namespace, projectName string) (*ProjectQuotaUsage, error) {
+	usage := &ProjectQuotaUsage{}
+
+	components := &openchoreov1alpha1.ComponentList{}
+	if err := c.List(ctx, components, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+	for i := range components.Items {
+		component := &components.Items[i]
+		if component.Spec.Owner.ProjectName == projectName && component.DeletionTimestamp.IsZero() {
+			usage.Components++
+		}
+	}
+
+	bindings := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := c.List(ctx, bindings, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for i := range bindings.Items {
+		rb := &bindings.Items[i]
+		if rb.Spec.Owner.ProjectName != projectName || !rb.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if !slices.Contains(usage.Environments, rb.Spec.Environment) {
+			usage.Environments = append(usage.Environments, rb.Spec.Environment)
+		}
+	}
+	slices.Sort(usage.Environments)
+
+	releases := &openchoreov1alpha1.RenderedReleaseList{}
+	if err := c.List(ctx, releases, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list rendered releases: %w", err)
+	}
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if release.Spec.Owner.ProjectName != projectName || !release.DeletionTimestamp.IsZero() {
+			continue
+		}
+		for _, manifest := range release.Spec.Resources {
+			cpu, memory := manifestRequests(manifest.Object)
+			usage.CPU.Add(cpu)
+			usage.Memory.Add(memory)
+		}
+	}
+	return usage, nil
+}
+
+// CheckComponent returns a *ProjectQuotaExceededError when the project has no room for
+// another component.
+func (u *ProjectQuotaUsage) CheckComponent(project *openchoreov1alpha1.Project) error {
+	quota := project.Spec.Quota
+	if quota == nil || quota.MaxComponents == nil || u.Components < *quota.MaxComponents {
+		return nil
+	}
+	return &ProjectQuotaExceededError{
+		Project:  project.Name,
+		Resource: ProjectQuotaComponents,
+		Limit:    *resource.NewQuantity(int64(*quota.MaxComponents), resource.DecimalSI),
+		Usage:    *resource.NewQuantity(int64(u.Components), resource.DecimalSI),
+	}
+}
+
+// CheckDeploy returns a *ProjectQuotaExceededError when a component of the project cannot be
+// deployed to an environment: the environment would exceed the environments the project may
+// deploy to, or the CPU or memory budget is used up. The CPU and memory of the new release are
+// only known once it is rendered, so a deployment is accepted while budget is left.
+func (u *ProjectQuotaUsage) CheckDeploy(project *openchoreov1alpha1.Project, environment string) error {
+	quota := project.Spec.Quota
+	if quota == nil {
+		return nil
+	}
+	if quota.MaxEnvironments != nil && !slices.Contains(u.Environments, environment) &&
+		int32(len(u.Environments)) >= *quota.MaxEnvironments {
+		return &ProjectQuotaExceededError{
+			Project:  project.Name,
+			Resource: ProjectQuotaEnvironments,
+			Limit:    *resource.NewQuantity(int64(*quota.MaxEnvironments), resource.DecimalSI),
+			Usage:    *resource.NewQuantity(int64(len(u.Environments)), resource.DecimalSI),
+		}
+	}
+	if quota.CPU != nil && u.CPU.Cmp(*quota.CPU) >= 0 {
+		return &ProjectQuotaExceededError{Project: project.Name, Resource: ProjectQuotaCPU, Limit: *quota.CPU, Usage: u.CPU}
+	}
+	if quota.Memory != nil && u.Memory.Cmp(*quota.Memory) >= 0 {
+		return &ProjectQuotaExceededError{Project: project.Name, Resource: ProjectQuotaMemory, Limit: *quota.Memory, Usage: u.Memory}
+	}
+	return nil
+}
+
+// Exceeded returns the limits of a quota that the usage is already beyond.
+func (u *ProjectQuotaUsage) Exceeded(projectName string, quota *openchoreov1alpha1.ProjectQuota) []*ProjectQuotaExceededError {
+	if quota == nil {
+		return nil
+	}
+	var exceeded []*ProjectQuotaExceededError
+	if quota.MaxComponents != nil && u.Components > *quota.MaxComponents {
+		exceeded = append(exceeded, &ProjectQuotaExceededError{
+			Project:  projectName,
+			Resource: ProjectQuotaComponents,
+			Limit:    *resource.NewQuantity(int64(*quota.MaxComponents), resource.DecimalSI),
+			Usage:    *resource.NewQuantity(int64(u.Components), resource.DecimalSI),
+		})
+	}
+	if quota.MaxEnvironments != nil && int32(len(u.Environments)) > *quota.MaxEnvironments {
+		exceeded = append(exceeded, &ProjectQuotaExceededError{
+			Project:  projectName,
+			Resource: ProjectQuotaEnvironments,
+			Limit:    *resource.NewQuantity(int64(*quota.MaxEnvironments), resource.DecimalSI),
+			Usage:    *resource.NewQuantity(int64(len(u.Environments)), resource.DecimalSI),
+		})
+	}
+	if quota.CPU != nil && u.CPU.Cmp(*quota.CPU) > 0 {
+		exceeded = append(exceeded, &ProjectQuotaExceededError{Project: projectName, Resource: ProjectQuotaCPU, Limit: *quota.CPU, Usage: u.CPU})
+	}
+	if quota.Memory != nil && u.Memory.Cmp(*quota.Memory) > 0 {
+		exceeded = append(exceeded, &ProjectQuotaExceededError{Project: projectName, Resource: ProjectQuotaMemory, Limit: *quota.Memory, Usage: u.Memory})
+	}
+	return exceeded
+}
+
+// podWorkload holds the fields of the rendered workload kinds that describe the pods they run.
+type podWorkload struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Replicas *int32 `json:"replicas"`
+		Template *struct {
+			Spec corev1.PodSpec `json:"spec"`
+		} `json:"template"`
+		JobTemplate *struct {
+			Spec struct {
+				Template struct {
+					Spec corev1.PodSpec `json:"spec"`
+				} `json:"template"`
+			} `json:"spec"`
+		} `json:"jobTemplate"`
+	} `json:"spec"`
+}
+
+// manifestRequests returns the CPU and memory requested by the pods of a rendered resource: the
+// requests of one pod times the replicas the workload declares. Resources that do not run pods,
+// and manifests that cannot be decoded, request nothing.
+func manifestRequests(obj *runtime.RawExtension) (resource.Quantity, resource.Quantity) {
+	var cpu, memory resource.Quantity
+	if obj == nil || len(obj.Raw) == 0 {
+		return cpu, memory
+	}
+	var workload podWorkload
+	if err := json.Unmarshal(obj.Raw, &workload); err != nil {
+		return cpu, memory
+	}
+
+	var pod *corev1.PodSpec
+	replicas := int64(1)
+	switch workload.Kind {
+	case "Deployment", "StatefulSet", "ReplicaSet":
+		if workload.Spec.Replicas != nil {
+			replicas = int64(*workload.Spec.Replicas)
+		}
+		fallthrough
+	case "DaemonSet", "Job":
+		if workload.Spec.Template != nil {
+			pod = &workload.Spec.Template.Spec
+		}
+	case "CronJob":
+		if workload.Spec.JobTemplate != nil {
+			pod = &workload.Spec.JobTemplate.Spec.Template.Spec
+		}
+	case "Pod":
+		var p struct {
+			Spec corev1.PodSpec `json:"spec"`
+		}
+		if err := json.Unmarshal(obj.Raw, &p); err != nil {
+			return cpu, memory
+		}
+		pod = &p.Spec
+	}
+	if pod == nil {
+		return cpu, memory
+	}
+
+	cpu = podRequest(pod, corev1.ResourceCPU)
+	memory = podRequest(pod, corev1.ResourceMemory)
+	cpu.Mul(replicas)
+	memory.Mul(replicas)
+	return cpu, memory
+}
+
+// podRequest returns the effective request of a pod for a resource: the sum of its containers'
+// requests or the largest init container request, whichever is higher. A container without a
+// request is counted at its limit, which is what the API server defaults the request to.
+func podRequest(pod *corev1.PodSpec, name corev1.ResourceName) resource.Quantity {
+	var sum, initMax resource.Quantity
+	for _, c := range pod.Containers {
+		sum.Add(containerRequest(c, name))
+	}
+	for _, c := range pod.InitContainers {
+		if q := containerRequest(c, name); q.Cmp(initMax) > 0 {
+			initMax = q
+		}
+	}
+	if initMax.Cmp(sum) > 0 {
+		return initMax
+	}
+	return sum
+}
+
+func containerRequest(c corev1.Container, name corev1.ResourceName) resource.Quantity {
+	if q, ok := c.Resources.Requests[name]; ok {
+		return q
+	}
+	return c.Resources.Limits[name]
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func quotaTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+func TestGetProjectQuotaUsage(t *testing.T) {
+	component := func(name, project string) client.Object {
+		return &openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: project}},
+		}
+	}
+	binding := func(name, project, env string) client.Object {
+		return &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: project, ComponentName: "api"},
+				Environment: env,
+			},
+		}
+	}
+	release := func(name, project string, manifests ...string) client.Object {
+		rr := &openchoreov1alpha1.RenderedRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: openchoreov1alpha1.RenderedReleaseSpec{
+				Owner:           openchoreov1alpha1.RenderedReleaseOwner{ProjectName: project, ComponentName: "api"},
+				EnvironmentName: "dev",
+			},
+		}
+		for i, m := range manifests {
+			rr.Spec.Resources = append(rr.Spec.Resources, openchoreov1alpha1.RenderedManifest{
+				ID:     name + string(rune('a'+i)),
+				Object: &runtime.RawExtension{Raw: []byte(m)},
+			})
+		}
+		return rr
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(quotaTestScheme(t)).WithObjects(
+		component("api", "shop"), component("web", "shop"), component("other", "billing"),
+		binding("api-dev", "shop", "dev"), binding("web-dev", "shop", "dev"), binding("api-prod", "shop", "prod"),
+		binding("other-staging", "billing", "staging"),
+		release("api-dev", "shop",
+			// 3 replicas of a pod requesting 500m and 256Mi
+			`{"kind":"Deployment","spec":{"replicas":3,"template":{"spec":{"containers":[
+				{"name":"app","resources":{"requests":{"cpu":"250m","memory":"128Mi"}}},
+				{"name":"proxy","resources":{"limits":{"cpu":"250m","memory":"128Mi"}}}]}}}}`,
+			// the init container requests more CPU than the containers together
+			`{"kind":"CronJob","spec":{"jobTemplate":{"spec":{"template":{"spec":{
+				"initContainers":[{"name":"init","resources":{"requests":{"cpu":"1"}}}],
+				"containers":[{"name":"job","resources":{"requests":{"cpu":"100m","memory":"64Mi"}}}]}}}}}}`,
+			`{"kind":"Service","spec":{"ports":[{"port":80}]}}`,
+		),
+		release("other-staging", "billing",
+			`{"kind":"Deployment","spec":{"template":{"spec":{"containers":[{"name":"app","resources":{"requests":{"cpu":"4"}}}]}}}}`),
+	).Build()
+
+	usage, err := GetProjectQuotaUsage(context.Background(), cl, "default", "shop")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), usage.Components)
+	assert.Equal(t, []string{"dev", "prod"}, usage.Environments)
+	assert.Zero(t, usage.CPU.Cmp(resource.MustParse("2500m")), "cpu %s", usage.CPU.String())
+	assert.Zero(t, usage.Memory.Cmp(resource.MustParse("832Mi")), "memory %s", usage.Memory.String())
+}
+
+func TestProjectQuotaChecks(t *testing.T) {
+	project := &openchoreov1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop"},
+		Spec: openchoreov1alpha1.ProjectSpec{Quota: &openchoreov1alpha1.ProjectQuota{
+			MaxComponents:   ptr.To[int32](2),
+			MaxEnvironments: ptr.To[int32](2),
+			CPU:             ptr.To(resource.MustParse("2")),
+			Memory:          ptr.To(resource.MustParse("4Gi")),
+		}},
+	}
+	usage := &ProjectQuotaUsage{
+		Components:   2,
+		Environments: []string{"dev", "prod"},
+		CPU:          resource.MustParse("1500m"),
+		Memory:       resource.MustParse("1Gi"),
+	}
+
+	err := usage.CheckComponent(project)
+	quotaErr, ok := errors.AsType[*ProjectQuotaExceededError](err)
+	require.True(t, ok, "expected a quota error, got %v", err)
+	assert.Equal(t, ProjectQuotaComponents, quotaErr.Resource)
+	assert.Equal(t, "project shop has exhausted its components quota (2 used of 2)", quotaErr.Error())
+	assert.Equal(t, "0", ptr.To(quotaErr.Remaining()).String())
+
+	// Environments already deployed to do not count again
+	assert.NoError(t, usage.CheckDeploy(project, "prod"))
+	quotaErr, ok = errors.AsType[*ProjectQuotaExceededError](usage.CheckDeploy(project, "staging"))
+	require.True(t, ok)
+	assert.Equal(t, ProjectQuotaEnvironments, quotaErr.Resource)
+
+	usage.CPU = resource.MustParse("2")
+	quotaErr, ok = errors.AsType[*ProjectQuotaExceededError](usage.CheckDeploy(project, "dev"))
+	require.True(t, ok)
+	assert.Equal(t, ProjectQuotaCPU, quotaErr.Resource)
+
+	assert.Empty(t, usage.Exceeded("shop", project.Spec.Quota), "usage at the limits does not exceed them")
+	usage.Memory = resource.MustParse("5Gi")
+	exceeded := usage.Exceeded("shop", project.Spec.Quota)
+	require.Len(t, exceeded, 1)
+	assert.Equal(t, ProjectQuotaMemory, exceeded[0].Resource)
+
+	assert.NoError(t, usage.CheckComponent(&openchoreov1alpha1.Project{}), "projects without a quota are not limited")
+}
@@ -103,6 +103,8 @@ func (r *Reconciler) renderAndEmit(
 			EnvironmentName: binding.Spec.Environment,
 			TargetPlane:     openchoreov1alpha1.TargetPlaneDataPlane,
 			Resources:       manifests,
+			// The drift policy is set on the release itself and kept across renders.
+			DriftPolicy: rr.Spec.DriftPolicy,
 		}
 		return controllerutil.SetControllerReference(binding, rr, r.Scheme)
 	})
@@ -0,0 +1,250 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ConditionQuarantined is set to True on an object whose reconciles kept failing beyond
+	// the quarantine policy of its controller. The controller does not reconcile the object
+	// again until its spec changes or a requeue is requested.
+	ConditionQuarantined ConditionType = "Quarantined"
+
+	// ReasonReconcileFailing indicates the object was quarantined after failing reconciles.
+	ReasonReconcileFailing ConditionReason = "ReconcileFailing"
+
+	// EventReasonQuarantined and EventReasonRequeued are the reasons of the events recorded
+	// when an object is quarantined and when it leaves the quarantine.
+	EventReasonQuarantined = "Quarantined"
+	EventReasonRequeued    = "Requeued"
+)
+
+// Quarantine metrics exposed on the controller manager's metrics endpoint.
+var (
+	reconcileQuarantined = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_reconcile_quarantined",
+			Help: "Objects that are quarantined after failing reconciles (1 while quarantined).",
+		},
+		[]string{"kind", "namespace", "name"},
+	)
+
+	reconcileQuarantinesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openchoreo_reconcile_quarantines_total",
+			Help: "Number of times objects were quarantined after failing reconciles.",
+		},
+		[]string{"kind"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(reconcileQuarantined, reconcileQuarantinesTotal)
+}
+
+// QuarantinePolicy decides when an object whose reconciles keep failing is quarantined.
+type QuarantinePolicy struct {
+	// Threshold is the number of consecutive failed reconciles after which an object is
+	// quarantined. Zero disables the quarantine.
+	Threshold int
+	// Window is the minimum time the consecutive failures must span. It keeps objects that
+	// fail a burst of reconciles, e.g. while a dependency is briefly unavailable, out of
+	// the quarantine.
+	Window time.Duration
+}
+
+// Enabled reports whether the policy quarantines objects.
+func (p QuarantinePolicy) Enabled() bool {
+	return p.Threshold > 0
+}
+
+// IsQuarantined reports whether an object is quarantined: its Quarantined condition is
+// True for its current generation and no requeue was requested since it was quarantined.
+func IsQuarantined(obj ConditionedObject) bool {
+	cond := meta.FindStatusCondition(obj.GetConditions(), ConditionQuarantined.String())
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.ObservedGeneration != obj.GetGeneration() {
+		return false
+	}
+	requested, err := time.Parse(time.RFC3339, obj.GetAnnotations()[AnnotationKeyRequeueRequestedAt])
+	return err != nil || requested.Before(cond.LastTransitionTime.Time)
+}
+
+// failureRun is a run of consecutive failed reconciles of an object.
+type failureRun struct {
+	first time.Time
+	count int
+}
+
+// quarantineReconciler quarantines the objects whose reconciles keep failing, so that
+// permanently broken objects do not burn controller cycles forever. Objects being deleted
+// are never held back, so their finalizers keep running.
+type quarantineReconciler struct {
+	client     client.Client
+	reconciler reconcile.Reconciler
+	kind       string
+	newObject  func() ConditionedObject
+	policy     QuarantinePolicy
+	recorder   record.EventRecorder
+	now        func() time.Time
+
+	mu       sync.Mutex
+	failures map[types.NamespacedName]*failureRun
+}
+
+// WithQuarantine wraps the reconciler of the kind of obj with the quarantine policy. The
+// reconciler is returned unchanged when the policy is disabled.
+func WithQuarantine(mgr ctrl.Manager, r reconcile.Reconciler, obj ConditionedObject, policy QuarantinePolicy) (reconcile.Reconciler, error) {
+	if !policy.Enabled() {
+		return r, nil
+	}
+	gvk, err := apiutil.GVKForObject(obj, mgr.GetScheme())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the kind of the quarantined objects: %w", err)
+	}
+	return newQuarantineReconciler(mgr.GetClient(), r, gvk.Kind, obj, policy,
+		mgr.GetEventRecorderFor(fmt.Sprintf("%s-quarantine", gvk.Kind))), nil
+}
+
+func newQuarantineReconciler(
+	c client.Client,
+	r reconcile.Reconciler,
+	kind string,
+	obj ConditionedObject,
+	policy QuarantinePolicy,
+	recorder record.EventRecorder,
+) *quarantineReconciler {
+	return &quarantineReconciler{
+		client:     c,
+		reconciler: r,
+		kind:       kind,
+		newObject:  func() ConditionedObject { return obj.DeepCopyObject().(ConditionedObject) },
+		policy:     policy,
+		recorder:   recorder,
+		now:        time.Now,
+		failures:   make(map[types.NamespacedName]*failureRun),
+	}
+}
+
+func (q *quarantineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	obj := q.newObject()
+	if err := q.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			q.forget(req.NamespacedName)
+			reconcileQuarantined.DeleteLabelValues(q.kind, req.Namespace, req.Name)
+		}
+		// The reconciler handles missing objects and read errors as it always did.
+		return q.reconciler.Reconcile(ctx, req)
+	}
+
+	if meta.IsStatusConditionTrue(obj.GetConditions(), ConditionQuarantined.String()) {
+		if obj.GetDeletionTimestamp().IsZero() && IsQuarantined(obj) {
+			// Objects quarantined before a restart of the manager are counted again.
+			reconcileQuarantined.WithLabelValues(q.kind, req.Namespace, req.Name).Set(1)
+			logger.V(1).Info("Skipping reconcile of quarantined object")
+			return ctrl.Result{}, nil
+		}
+		if err := q.release(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	result, err := q.reconciler.Reconcile(ctx, req)
+	// Terminal errors are not retried, so they cannot hot-loop.
+	if err == nil || errors.Is(err, reconcile.TerminalError(nil)) {
+		q.forget(req.NamespacedName)
+		return result, err
+	}
+
+	now := q.now()
+	run := q.recordFailure(req.NamespacedName, now)
+	if run.count < q.policy.Threshold || now.Sub(run.first) < q.policy.Window {
+		return result, err
+	}
+	if qerr := q.quarantine(ctx, req.NamespacedName, run, err); qerr != nil {
+		logger.Error(qerr, "Failed to quarantine object")
+		return result, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// quarantine marks the object Quarantined with the failure run that caused it.
+func (q *quarantineReconciler) quarantine(ctx context.Context, key types.NamespacedName, run failureRun, cause error) error {
+	// The reconciler may have updated the object, so the condition is set on a fresh copy.
+	obj := q.newObject()
+	if err := q.client.Get(ctx, key, obj); err != nil {
+		return err
+	}
+	patch := client.MergeFrom(obj.DeepCopyObject().(ConditionedObject))
+	message := fmt.Sprintf("Reconcile failed %d consecutive times since %s: %v",
+		run.count, run.first.UTC().Format(time.RFC3339), cause)
+	MarkTrueCondition(obj, ConditionQuarantined, ReasonReconcileFailing, message)
+	if err := q.client.Status().Patch(ctx, obj, patch); err != nil {
+		return err
+	}
+
+	q.forget(key)
+	reconcileQuarantined.WithLabelValues(q.kind, key.Namespace, key.Name).Set(1)
+	reconcileQuarantinesTotal.WithLabelValues(q.kind).Inc()
+	q.recorder.Event(obj, corev1.EventTypeWarning, EventReasonQuarantined, message)
+	log.FromContext(ctx).Info("Quarantined object after failing reconciles",
+		"failures", run.count, "since", run.first, "error", cause.Error())
+	return nil
+}
+
+// release removes the Quarantined condition of an object whose spec changed, for which a
+// requeue was requested or which is being deleted.
+func (q *quarantineReconciler) release(ctx context.Context, obj ConditionedObject) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(ConditionedObject))
+	conditions := obj.GetConditions()
+	meta.RemoveStatusCondition(&conditions, ConditionQuarantined.String())
+	obj.SetConditions(conditions)
+	if err := q.client.Status().Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("failed to release quarantined object: %w", err)
+	}
+
+	reconcileQuarantined.DeleteLabelValues(q.kind, obj.GetNamespace(), obj.GetName())
+	q.recorder.Event(obj, corev1.EventTypeNormal, EventReasonRequeued, "Object left the quarantine and is reconciled again")
+	log.FromContext(ctx).Info("Released quarantined object")
+	return nil
+}
+
+func (q *quarantineReconciler) recordFailure(key types.NamespacedName, now time.Time) failureRun {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	run, ok := q.failures[key]
+	if !ok {
+		run = &failureRun{first: now}
+		q.failures[key] = run
+	}
+	run.count++
+	return *run
+}
+
+func (q *quarantineReconciler) forget(key types.NamespacedName) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.failures, key)
+}
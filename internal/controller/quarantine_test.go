@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// countingReconciler counts its reconciles and fails them while err is set.
+type countingReconciler struct {
+	calls int
+	err   error
+}
+
+func (r *countingReconciler) Reconcile(context.Context, ctrl.Request) (ctrl.Result, error) {
+	r.calls++
+	return ctrl.Result{}, r.err
+}
+
+func TestQuarantineReconciler(t *testing.T) {
+	ctx := context.Background()
+	key := client.ObjectKey{Namespace: "default", Name: "api"}
+	req := ctrl.Request{NamespacedName: key}
+	policy := QuarantinePolicy{Threshold: 3, Window: time.Minute}
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*quarantineReconciler, *countingReconciler, client.Client, *time.Time) {
+		t.Helper()
+		c := fake.NewClientBuilder().WithScheme(newScheme(t)).
+			WithObjects(&openchoreov1alpha1.Component{ObjectMeta: metav1.ObjectMeta{
+				Namespace: key.Namespace, Name: key.Name, Generation: 1,
+			}}).
+			WithStatusSubresource(&openchoreov1alpha1.Component{}).
+			Build()
+		inner := &countingReconciler{err: errors.New("boom")}
+		q := newQuarantineReconciler(c, inner, "Component", &openchoreov1alpha1.Component{}, policy, record.NewFakeRecorder(10))
+		now := start
+		q.now = func() time.Time { return now }
+		return q, inner, c, &now
+	}
+	getComponent := func(t *testing.T, c client.Client) *openchoreov1alpha1.Component {
+		t.Helper()
+		comp := &openchoreov1alpha1.Component{}
+		require.NoError(t, c.Get(ctx, key, comp))
+		return comp
+	}
+	// failUntilQuarantined fails reconciles 30 seconds apart until the object is quarantined.
+	failUntilQuarantined := func(t *testing.T, q *quarantineReconciler, now *time.Time) {
+		t.Helper()
+		for range policy.Threshold - 1 {
+			_, err := q.Reconcile(ctx, req)
+			require.Error(t, err)
+			*now = now.Add(30 * time.Second)
+		}
+		_, err := q.Reconcile(ctx, req)
+		require.NoError(t, err, "the failure that quarantines the object is not retried")
+	}
+
+	t.Run("quarantines after the threshold over the window", func(t *testing.T) {
+		q, inner, c, now := setup(t)
+		failUntilQuarantined(t, q, now)
+
+		comp := getComponent(t, c)
+		cond := meta.FindStatusCondition(comp.Status.Conditions, ConditionQuarantined.String())
+		require.NotNil(t, cond)
+		assert.Equal(t, string(ReasonReconcileFailing), cond.Reason)
+		assert.Contains(t, cond.Message, "boom")
+		assert.True(t, IsQuarantined(comp))
+
+		_, err := q.Reconcile(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, policy.Threshold, inner.calls, "quarantined objects are not reconciled")
+	})
+
+	t.Run("a burst of failures within the window is not quarantined", func(t *testing.T) {
+		q, _, c, _ := setup(t)
+		for range policy.Threshold + 2 {
+			_, err := q.Reconcile(ctx, req)
+			require.Error(t, err)
+		}
+		assert.False(t, IsQuarantined(getComponent(t, c)))
+	})
+
+	t.Run("a successful reconcile resets the failures", func(t *testing.T) {
+		q, inner, c, now := setup(t)
+		for range policy.Threshold - 1 {
+			_, _ = q.Reconcile(ctx, req)
+			*now = now.Add(time.Minute)
+		}
+		inner.err = nil
+		_, err := q.Reconcile(ctx, req)
+		require.NoError(t, err)
+
+		inner.err = errors.New("boom")
+		_, err = q.Reconcile(ctx, req)
+		require.Error(t, err)
+		assert.False(t, IsQuarantined(getComponent(t, c)))
+	})
+
+	t.Run("terminal errors are not counted", func(t *testing.T) {
+		q, inner, c, now := setup(t)
+		inner.err = reconcile.TerminalError(errors.New("invalid spec"))
+		for range policy.Threshold + 1 {
+			_, _ = q.Reconcile(ctx, req)
+			*now = now.Add(time.Minute)
+		}
+		assert.False(t, IsQuarantined(getComponent(t, c)))
+	})
+
+	t.Run("a requeue request lifts the quarantine", func(t *testing.T) {
+		q, inner, c, now := setup(t)
+		failUntilQuarantined(t, q, now)
+
+		comp := getComponent(t, c)
+		comp.Annotations = map[string]string{AnnotationKeyRequeueRequestedAt: time.Now().Add(time.Second).Format(time.RFC3339)}
+		require.NoError(t, c.Update(ctx, comp))
+		inner.err = nil
+
+		_, err := q.Reconcile(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, policy.Threshold+1, inner.calls)
+		assert.Nil(t, meta.FindStatusCondition(getComponent(t, c).Status.Conditions, ConditionQuarantined.String()))
+	})
+
+	t.Run("a spec change lifts the quarantine", func(t *testing.T) {
+		q, inner, c, now := setup(t)
+		failUntilQuarantined(t, q, now)
+
+		comp := getComponent(t, c)
+		comp.Generation = 2
+		assert.False(t, IsQuarantined(comp))
+		require.NoError(t, c.Update(ctx, comp))
+
+		_, _ = q.Reconcile(ctx, req)
+		assert.Equal(t, policy.Threshold+1, inner.calls)
+	})
+}
@@ -76,10 +76,18 @@ func (r *DataPlaneResult) ToDataPlane() *openchoreov1alpha1.DataPlane {
 			Spec: openchoreov1alpha1.DataPlaneSpec{
 				PlaneID:               r.ClusterDataPlane.Spec.PlaneID,
 				ClusterAgent:          r.ClusterDataPlane.Spec.ClusterAgent,
+				Region:                r.ClusterDataPlane.Spec.Region,
 				Gateway:               r.ClusterDataPlane.Spec.Gateway,
 				SecretStoreRef:        r.ClusterDataPlane.Spec.SecretStoreRef,
+				RegistryCredentials:   r.ClusterDataPlane.Spec.RegistryCredentials,
+				Scheduling:            r.ClusterDataPlane.Spec.Scheduling,
+				APIVersions:           r.ClusterDataPlane.Spec.APIVersions,
+				WorkloadIdentity:      r.ClusterDataPlane.Spec.WorkloadIdentity,
 				ObservabilityPlaneRef: obsRef,
 			},
+			Status: openchoreov1alpha1.DataPlaneStatus{
+				NodeLabels: r.ClusterDataPlane.Status.NodeLabels,
+			},
 		}
 	}
 	return nil
@@ -387,6 +395,30 @@ func (r *WorkflowPlaneResult) GetSecretStoreName() string {
 	return ""
 }
 
+// GetScheduling returns the scheduling configuration of the workflow plane
+// (either WorkflowPlane or ClusterWorkflowPlane), or nil when none is configured.
+func (r *WorkflowPlaneResult) GetScheduling() *openchoreov1alpha1.WorkflowSchedulingSpec {
+	if r.WorkflowPlane != nil {
+		return r.WorkflowPlane.Spec.Scheduling
+	}
+	if r.ClusterWorkflowPlane != nil {
+		return r.ClusterWorkflowPlane.Spec.Scheduling
+	}
+	return nil
+}
+
+// GetBuildNodes returns the build node configuration of the workflow plane
+// (either WorkflowPlane or ClusterWorkflowPlane), or nil when none is configured.
+func (r *WorkflowPlaneResult) GetBuildNodes() *openchoreov1alpha1.WorkflowBuildNodesSpec {
+	if r.WorkflowPlane != nil {
+		return r.WorkflowPlane.Spec.BuildNodes
+	}
+	if r.ClusterWorkflowPlane != nil {
+		return r.ClusterWorkflowPlane.Spec.BuildNodes
+	}
+	return nil
+}
+
 // GetObservabilityPlane resolves the observability plane for this workflow plane result.
 func (r *WorkflowPlaneResult) GetObservabilityPlane(ctx context.Context, c client.Client) (*ObservabilityPlaneResult, error) {
 	if r.WorkflowPlane != nil {
@@ -507,6 +539,17 @@ func (r *WorkflowResult) GetNamespace() string {
 	return ""
 }
 
+// GetGeneration returns the generation of the resolved workflow
+func (r *WorkflowResult) GetGeneration() int64 {
+	if r.Workflow != nil {
+		return r.Workflow.Generation
+	}
+	if r.ClusterWorkflow != nil {
+		return r.ClusterWorkflow.Generation
+	}
+	return 0
+}
+
 // GetWorkflowSpec converts the resolved workflow (either kind) to a unified WorkflowSpec.
 // For ClusterWorkflow, ClusterWorkflowPlaneRef is mapped to WorkflowPlaneRef with kind ClusterWorkflowPlane.
 // When ClusterWorkflow omits WorkflowPlaneRef, it defaults to ClusterWorkflowPlane "default" so that
@@ -522,6 +565,14 @@ func (r *WorkflowResult) GetWorkflowSpec() openchoreov1alpha1.WorkflowSpec {
 			Resources:          r.ClusterWorkflow.Spec.Resources,
 			ExternalRefs:       r.ClusterWorkflow.Spec.ExternalRefs,
 			TTLAfterCompletion: r.ClusterWorkflow.Spec.TTLAfterCompletion,
+			Tests:              r.ClusterWorkflow.Spec.Tests,
+			Analysis:           r.ClusterWorkflow.Spec.Analysis,
+			SBOM:               r.ClusterWorkflow.Spec.SBOM,
+			Timeout:            r.ClusterWorkflow.Spec.Timeout,
+			RetryPolicy:        r.ClusterWorkflow.Spec.RetryPolicy,
+			Credentials:        r.ClusterWorkflow.Spec.Credentials,
+
+			WorkflowPlaneSelector: r.ClusterWorkflow.Spec.WorkflowPlaneSelector,
 		}
 		// Map ClusterWorkflowPlaneRef to WorkflowPlaneRef, defaulting to ClusterWorkflowPlane "default"
 		// when the field is omitted (CRD defaulting webhook may not have run).
@@ -575,3 +626,33 @@ func ResolveWorkflow(ctx context.Context, c client.Client, namespace string, kin
 		return nil, fmt.Errorf("unsupported workflowRef kind '%s' for workflow '%s' in namespace '%s'", kind, name, namespace)
 	}
 }
+
+// ResolveWorkflowVersion resolves a published version of a Workflow (WorkflowVersion) or
+// ClusterWorkflow (ClusterWorkflowVersion) by workflow kind, name and version.
+func ResolveWorkflowVersion(ctx context.Context, c client.Client, namespace string, kind openchoreov1alpha1.WorkflowRefKind, name, version string) (*openchoreov1alpha1.WorkflowVersionSpec, error) {
+	objName := openchoreov1alpha1.WorkflowVersionName(name, version)
+	switch kind {
+	case openchoreov1alpha1.WorkflowRefKindClusterWorkflow, "":
+		cwv := &openchoreov1alpha1.ClusterWorkflowVersion{}
+		if err := c.Get(ctx, client.ObjectKey{Name: objName}, cwv); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("version '%s' of clusterWorkflow '%s' not found: %w", version, name, err)
+			}
+			return nil, fmt.Errorf("failed to get version '%s' of clusterWorkflow '%s': %w", version, name, err)
+		}
+		return &cwv.Spec, nil
+
+	case openchoreov1alpha1.WorkflowRefKindWorkflow:
+		wv := &openchoreov1alpha1.WorkflowVersion{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: objName}, wv); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("version '%s' of workflow '%s' not found in namespace '%s': %w", version, name, namespace, err)
+			}
+			return nil, fmt.Errorf("failed to get version '%s' of workflow '%s': %w", version, name, err)
+		}
+		return &wv.Spec, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported workflowRef kind '%s' for workflow '%s' in namespace '%s'", kind, name, namespace)
+	}
+}
@@ -788,6 +788,7 @@ func TestDataPlaneResult_ToDataPlane_WithClusterDataPlane(t *testing.T) {
 		},
 		Spec: openchoreov1alpha1.ClusterDataPlaneSpec{
 			PlaneID: "shared-plane",
+			Region:  "eu-west-1",
 			Gateway: openchoreov1alpha1.GatewaySpec{
 				Ingress: &openchoreov1alpha1.GatewayNetworkSpec{
 					External: &openchoreov1alpha1.GatewayEndpointSpec{
@@ -796,6 +797,13 @@ func TestDataPlaneResult_ToDataPlane_WithClusterDataPlane(t *testing.T) {
 					},
 				},
 			},
+			RegistryCredentials: []openchoreov1alpha1.RegistryCredential{
+				{Name: "ghcr", SecretReferenceName: "ghcr-pull"},
+			},
+			WorkloadIdentity: &openchoreov1alpha1.WorkloadIdentityConfig{
+				Provider: openchoreov1alpha1.WorkloadIdentityProviderGCP,
+				GCP:      &openchoreov1alpha1.GCPWorkloadIdentity{ProjectID: "acme-prod"},
+			},
 			ObservabilityPlaneRef: &openchoreov1alpha1.ClusterObservabilityPlaneRef{
 				Kind: openchoreov1alpha1.ClusterObservabilityPlaneRefKindClusterObservabilityPlane,
 				Name: "shared-obs",
@@ -814,10 +822,13 @@ func TestDataPlaneResult_ToDataPlane_WithClusterDataPlane(t *testing.T) {
 
 	// Verify Spec fields are mapped
 	assert.Equal(t, "shared-plane", got.Spec.PlaneID)
+	assert.Equal(t, "eu-west-1", got.Spec.Region)
 	require.NotNil(t, got.Spec.Gateway.Ingress)
 	require.NotNil(t, got.Spec.Gateway.Ingress.External)
 	assert.Equal(t, "public-gw", got.Spec.Gateway.Ingress.External.Name)
 	assert.Equal(t, "gw-ns", got.Spec.Gateway.Ingress.External.Namespace)
+	assert.Equal(t, cdp.Spec.RegistryCredentials, got.Spec.RegistryCredentials)
+	assert.Equal(t, cdp.Spec.WorkloadIdentity, got.Spec.WorkloadIdentity)
 
 	// Verify ObservabilityPlaneRef is mapped from ClusterObservabilityPlaneRef
 	require.NotNil(t, got.Spec.ObservabilityPlaneRef)
@@ -1170,6 +1181,52 @@ func TestResolveWorkflow(t *testing.T) {
 	}
 }
 
+func TestResolveWorkflowVersion(t *testing.T) {
+	scheme := newScheme(t)
+	ctx := context.Background()
+
+	wfv := &openchoreov1alpha1.WorkflowVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "docker.v1", Namespace: "test-ns"},
+		Spec:       openchoreov1alpha1.WorkflowVersionSpec{WorkflowName: "docker", Version: "v1", Template: openchoreov1alpha1.WorkflowTemplate{TTLAfterCompletion: "1h"}},
+	}
+	cwfv := &openchoreov1alpha1.ClusterWorkflowVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "docker.v1"},
+		Spec:       openchoreov1alpha1.WorkflowVersionSpec{WorkflowName: "docker", Version: "v1", Template: openchoreov1alpha1.WorkflowTemplate{TTLAfterCompletion: "2h"}},
+	}
+
+	tests := []struct {
+		name    string
+		kind    openchoreov1alpha1.WorkflowRefKind
+		version string
+		wantTTL string
+		wantErr string
+	}{
+		{name: "empty kind defaults to ClusterWorkflowVersion", kind: "", version: "v1", wantTTL: "2h"},
+		{name: "Workflow kind resolves WorkflowVersion", kind: openchoreov1alpha1.WorkflowRefKindWorkflow, version: "v1", wantTTL: "1h"},
+		{name: "ClusterWorkflow kind resolves ClusterWorkflowVersion", kind: openchoreov1alpha1.WorkflowRefKindClusterWorkflow, version: "v1", wantTTL: "2h"},
+		{name: "version not published", kind: openchoreov1alpha1.WorkflowRefKindWorkflow, version: "v2", wantErr: "not found"},
+		{name: "unsupported kind", kind: "UnsupportedKind", version: "v1", wantErr: "unsupported workflowRef kind"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := newFakeClient(t, scheme, wfv, cwfv)
+			spec, err := ResolveWorkflowVersion(ctx, fc, "test-ns", tt.kind, "docker", tt.version)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Nil(t, spec)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, spec)
+			assert.Equal(t, tt.wantTTL, spec.Template.TTLAfterCompletion)
+		})
+	}
+}
+
 // ============================================================================
 // Tests for WorkflowResult methods
 // ============================================================================
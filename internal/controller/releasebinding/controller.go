@@ -6,10 +6,15 @@ package releasebinding
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -18,21 +23,32 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/controller/renderedrelease"
 	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/disruptionbudget"
+	"github.com/openchoreo/openchoreo/internal/imagepullsecret"
+	"github.com/openchoreo/openchoreo/internal/kafkatopic"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/networkpolicy"
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/scheduling"
+	"github.com/openchoreo/openchoreo/internal/tenancy"
+	"github.com/openchoreo/openchoreo/internal/vaultsecret"
+	"github.com/openchoreo/openchoreo/internal/workloadidentity"
 )
 
 const (
@@ -71,11 +87,21 @@ var routeKindCompat = map[string]map[openchoreov1alpha1.EndpointType]bool{
 // Reconciler reconciles a ReleaseBinding object
 type Reconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 
 	// Pipeline is the component rendering pipeline, shared across all reconciliations.
 	// This enables CEL environment caching across different component types and reconciliations.
 	Pipeline *componentpipeline.Pipeline
+
+	// Quarantine stops reconciling ReleaseBindings whose reconciles keep failing.
+	Quarantine controller.QuarantinePolicy
+
+	// ObserverBaseURL overrides the observer internal base URL that promotions are sent to.
+	// Defaults to OBSERVER_INTERNAL_ENDPOINT or the in-cluster observer-internal service.
+	ObserverBaseURL string
+
+	httpClient *http.Client
 }
 
 // networkPolicyProviderFromDataPlane reads the "openchoreo.dev/networkpolicyprovider" annotation
@@ -107,7 +133,10 @@ func networkPolicyProviderFromDataPlane(dp *controller.DataPlaneResult) networkp
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterobservabilityplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=renderedreleases,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=servicelevelobjectives,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=domainmappings,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, rErr error) {
@@ -145,10 +174,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 	releaseBinding.Status.ObservedGeneration = releaseBinding.Generation
 
 	// Deferred status update
+	var rollbackRecheck time.Duration
 	defer func() {
 		// Always update the aggregated Ready condition based on current sub-conditions.
 		// This ensures Ready is present on every reconciliation regardless of code path.
 		r.setReadyCondition(releaseBinding)
+		updateDeploymentHistory(releaseBinding, metav1.Now())
+		releaseBinding.Status.ConditionHistory = controller.RecordConditionTransitions(releaseBinding.Status.ConditionHistory,
+			old.Status.Conditions, releaseBinding.Status.Conditions, metav1.Now(), maxConditionHistory, ConditionReady)
+
+		// Evaluate the health check or the rollback policy again once its timeout expires.
+		if rollbackRecheck > 0 && rErr == nil && (result.RequeueAfter == 0 || rollbackRecheck < result.RequeueAfter) {
+			result.RequeueAfter = rollbackRecheck
+		}
 
 		// Skip update if nothing changed
 		if apiequality.Semantic.DeepEqual(old.Status, releaseBinding.Status) {
@@ -162,20 +200,30 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		}
 	}()
 
+	// Roll back a bound release that failed its post-deploy health check. The rollback
+	// target is deployed instead of spec.releaseName until spec.releaseName changes. A
+	// release that degraded after it became Ready is rolled back in spec.releaseName.
+	var err error
+	if rollbackRecheck, err = r.reconcileAutoRollback(ctx, releaseBinding); err != nil {
+		logger.Error(err, "Failed to evaluate auto-rollback")
+		return ctrl.Result{}, err
+	}
+	releaseName := effectiveReleaseName(releaseBinding)
+
 	// Fetch ComponentRelease
 	componentRelease := &openchoreov1alpha1.ComponentRelease{}
 	if err := r.Get(ctx, types.NamespacedName{
-		Name:      releaseBinding.Spec.ReleaseName,
+		Name:      releaseName,
 		Namespace: releaseBinding.Namespace,
 	}, componentRelease); err != nil {
 		if apierrors.IsNotFound(err) {
-			msg := fmt.Sprintf("ComponentRelease %q not found", releaseBinding.Spec.ReleaseName)
+			msg := fmt.Sprintf("ComponentRelease %q not found", releaseName)
 			controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
 				ReasonComponentReleaseNotFound, msg)
-			logger.Info(msg, "componentRelease", releaseBinding.Spec.ReleaseName)
+			logger.Info(msg, "componentRelease", releaseName)
 			return ctrl.Result{}, nil
 		}
-		logger.Error(err, "Failed to get ComponentRelease", "componentRelease", releaseBinding.Spec.ReleaseName)
+		logger.Error(err, "Failed to get ComponentRelease", "componentRelease", releaseName)
 		return ctrl.Result{}, err
 	}
 
@@ -188,6 +236,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, nil
 	}
 
+	// Hold back promotions while an opted-in ServiceLevelObjective has no error budget left.
+	// The previously rendered release keeps running until the budget recovers.
+	blocking, err := r.blockingServiceLevelObjectives(ctx, releaseBinding, componentRelease)
+	if err != nil {
+		logger.Error(err, "Failed to evaluate error budget promotion gate")
+		return ctrl.Result{}, err
+	}
+	if len(blocking) > 0 {
+		msg := promotionBlockedMessage(releaseBinding, componentRelease.Name, blocking)
+		if controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, ReasonErrorBudgetExhausted, msg) &&
+			r.Recorder != nil {
+			r.Recorder.Event(releaseBinding, corev1.EventTypeWarning, EventReasonPromotionBlocked, msg)
+		}
+		logger.Info(msg)
+		return ctrl.Result{}, nil
+	}
+
+	recordDeployment(releaseBinding, componentRelease)
+
 	// Fetch Environment object
 	environment := &openchoreov1alpha1.Environment{}
 	if err := r.Get(ctx, types.NamespacedName{
@@ -253,12 +320,29 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, err
 	}
 
+	// Shared data planes may only admit some namespaces and projects.
+	if cdp := dataPlaneResult.ClusterDataPlane; cdp != nil {
+		if err := tenancy.CheckProject(cdp.Spec.Tenancy, releaseBinding.Namespace, project.Name); err != nil {
+			msg := fmt.Sprintf("ClusterDataPlane %q does not admit this project: %v", cdp.Name, err)
+			controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, ReasonTenancyDenied, msg)
+			logger.Info("Project denied by the tenancy policy", "clusterDataPlane", cdp.Name, "error", err.Error())
+			// The tenancy policy is not watched, so check again later.
+			return controller.ResyncAfter(controller.StatusUpdateInterval), nil
+		}
+	}
+
 	return r.reconcileRelease(ctx, releaseBinding, componentRelease, environment, dataPlaneResult, component, project)
 }
 
 // validateComponentRelease validates the ComponentRelease configuration
 func (r *Reconciler) validateComponentRelease(componentRelease *openchoreov1alpha1.ComponentRelease,
 	releaseBinding *openchoreov1alpha1.ReleaseBinding) error {
+	// Library components are published, never deployed
+	if componentRelease.Spec.ComponentType.Spec.IsLibrary() {
+		return fmt.Errorf("component type %q is a library; library components cannot be deployed",
+			componentRelease.Spec.ComponentType.Name)
+	}
+
 	// Check ComponentType has resources
 	if componentRelease.Spec.ComponentType.Spec.Resources == nil {
 		return fmt.Errorf("component type has no resources")
@@ -325,6 +409,28 @@ func (r *Reconciler) buildMetadataContext(
 		labels.LabelKeyProjectUID:      projectUID,
 	}
 
+	// Propagate catalog metadata: team, domain and tier as labels, links as annotations
+	annotations := map[string]string{}
+	if catalog := component.Spec.Catalog; catalog != nil {
+		for key, value := range map[string]string{
+			labels.LabelKeyTeam:   catalog.Team,
+			labels.LabelKeyDomain: catalog.Domain,
+			labels.LabelKeyTier:   catalog.Tier,
+		} {
+			if value != "" {
+				standardLabels[key] = value
+			}
+		}
+		for key, value := range map[string]string{
+			labels.AnnotationKeyOnCall:     catalog.OnCall,
+			labels.AnnotationKeyRepository: catalog.Repository,
+		} {
+			if value != "" {
+				annotations[key] = value
+			}
+		}
+	}
+
 	// Build pod selectors
 	podSelectors := map[string]string{
 		labels.LabelKeyNamespaceName:   namespaceName,
@@ -341,7 +447,7 @@ func (r *Reconciler) buildMetadataContext(
 		Namespace:          namespace,
 		ComponentNamespace: namespaceName,
 		Labels:             standardLabels,
-		Annotations:        map[string]string{},
+		Annotations:        annotations,
 		PodSelectors:       podSelectors,
 		ComponentName:      componentName,
 		ComponentUID:       componentUID,
@@ -450,15 +556,31 @@ func (r *Reconciler) collectSecretReferences(ctx context.Context, workload *open
 // Matches the same nolint directive on setResourcesReadyStatus and the workflowrun reconcile.
 func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
 	componentRelease *openchoreov1alpha1.ComponentRelease, environment *openchoreov1alpha1.Environment,
-	dataPlaneResult *controller.DataPlaneResult, component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) (ctrl.Result, error) {
+	dataPlaneResult *controller.DataPlaneResult, component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) (result ctrl.Result, rErr error) {
 	logger := log.FromContext(ctx)
 
-	// Handle undeploy state - delete Release resources if they exist
-	if releaseBinding.Spec.State == openchoreov1alpha1.ReleaseStateUndeploy {
+	// Handle undeploy state - delete Release resources if they exist. An archived component
+	// is undeployed from every environment without changing the state of its bindings, so
+	// that unarchiving it restores them.
+	if releaseBinding.Spec.State == openchoreov1alpha1.ReleaseStateUndeploy || component.Spec.IsArchived() {
 		releaseBinding.Status.Endpoints = nil
 		return r.handleUndeploy(ctx, releaseBinding, componentRelease)
 	}
 
+	// Advance the canary or blue-green rollout. While it is in progress, the stable release
+	// is rendered next to componentRelease and the routes split the traffic between them.
+	stableRelease, rolloutRecheck, err := r.reconcileRollout(ctx, releaseBinding, componentRelease)
+	if err != nil {
+		logger.Error(err, "Failed to evaluate rollout")
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		// Move the rollout on once the pause of its current step is over.
+		if rolloutRecheck > 0 && rErr == nil && (result.RequeueAfter == 0 || rolloutRecheck < result.RequeueAfter) {
+			result.RequeueAfter = rolloutRecheck
+		}
+	}()
+
 	// Build a facade DataPlane from the result for use by the pipeline and helper functions.
 	// This works because ClusterDataPlane has the same spec fields (Gateway, SecretStoreRef, etc.).
 	dataPlane := dataPlaneResult.ToDataPlane()
@@ -486,6 +608,17 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 	snapshotTraits := buildTraitsFromRelease(componentRelease)
 	snapshotWorkload := buildWorkloadFromRelease(componentRelease)
 
+	// Rollouts run the stable and the candidate Deployment side by side.
+	if releaseBinding.Spec.RolloutStrategy != nil &&
+		snapshotComponentType.Spec.WorkloadType != string(WorkloadTypeDeployment) {
+		msg := fmt.Sprintf("Rollout strategy %s requires a ComponentType with the %s workload type, got %q",
+			releaseBinding.Spec.RolloutStrategy.Type, WorkloadTypeDeployment, snapshotComponentType.Spec.WorkloadType)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, ReasonRolloutStrategyUnsupported, msg)
+		logger.Info(msg)
+		// The check is deterministic; a change to the binding or the release triggers the next attempt.
+		return ctrl.Result{}, nil
+	}
+
 	// Collect all SecretReferences needed for rendering (must be done after workload merge)
 	secretReferences, err := r.collectSecretReferences(ctx, snapshotWorkload, releaseBinding)
 	if err != nil {
@@ -496,8 +629,20 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		return ctrl.Result{}, fmt.Errorf("failed to collect SecretReferences: %w", err)
 	}
 
-	// Populate status.secretReferenceNames for the field index (pure function of the object)
-	releaseBinding.Status.SecretReferenceNames = collectSecretReferenceNames(snapshotWorkload, releaseBinding)
+	// Populate status.secretReferenceNames for the field index (pure function of the object).
+	// Registry credentials of the data plane are included so that rotating them re-renders.
+	releaseBinding.Status.SecretReferenceNames = appendUnique(
+		collectSecretReferenceNames(snapshotWorkload, releaseBinding),
+		registryCredentialSecretReferenceNames(dataPlane)...)
+
+	registryCredentials, err := r.resolveRegistryCredentials(ctx, dataPlane, releaseBinding.Namespace)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to resolve registry credentials: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to resolve registry credentials")
+		return ctrl.Result{}, fmt.Errorf("failed to resolve registry credentials: %w", err)
+	}
 
 	// Resolve connections inline: build targets, resolve URLs from dependency RBs
 	connectionTargets := buildConnectionTargets(releaseBinding, snapshotWorkload.Spec.GetDependencyEndpoints())
@@ -521,6 +666,15 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		return ctrl.Result{}, fmt.Errorf("failed to resolve resource dependencies: %w", err)
 	}
 
+	// Refuse to render a release that would start without the env vars its component type requires.
+	if unsatisfied := unsatisfiedRequiredEnv(snapshotComponentType.Spec.RequiredEnv, snapshotWorkload, releaseBinding); len(unsatisfied) > 0 {
+		msg := requiredEnvMessage(snapshotComponentType.Name, unsatisfied)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, ReasonRequiredEnvMissing, msg)
+		logger.Info("Required environment variables are not set", "missing", unsatisfied)
+		// The check is deterministic; a change to the workload or binding triggers the next attempt.
+		return ctrl.Result{}, nil
+	}
+
 	// Prepare RenderInput
 	renderInput := &componentpipeline.RenderInput{
 		ComponentType:              snapshotComponentType,
@@ -538,7 +692,16 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 	}
 
 	// Render resources using the shared pipeline instance
-	renderOutput, err := r.Pipeline.Render(renderInput)
+	renderOutput, err := r.Pipeline.Render(ctx, renderInput)
+	if limitErr, ok := errors.AsType[*componentpipeline.LimitExceededError](err); ok {
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonReleaseLimitExceeded, fmt.Sprintf("Rendered release exceeds a limit: %v", limitErr))
+		observeLimitExceeded(releaseBinding, limitErr.Limit)
+		logger.Info("Rendered release exceeds a limit", "limit", limitErr.Limit,
+			"actual", limitErr.Actual, "max", limitErr.Max)
+		// Rendering is deterministic; a change to the inputs triggers the next attempt.
+		return ctrl.Result{}, nil
+	}
 	if err != nil {
 		msg := fmt.Sprintf("Failed to render resources: %v", err)
 		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
@@ -547,6 +710,42 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		return ctrl.Result{}, fmt.Errorf("failed to render resources: %w", err)
 	}
 
+	observeRenderedRelease(releaseBinding, renderOutput.Metadata)
+
+	if releaseBinding.Spec.RolloutStrategy != nil {
+		renderOutput.Resources, err = r.renderRollout(ctx, releaseBinding, renderInput, renderOutput, stableRelease)
+		if err != nil {
+			msg := fmt.Sprintf("Failed to render rollout: %v", err)
+			controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+				ReasonRenderingFailed, msg)
+			logger.Error(err, "Failed to render rollout")
+			return ctrl.Result{}, fmt.Errorf("failed to render rollout: %w", err)
+		}
+	}
+
+	// Serve the verified custom domains of the component's public endpoints.
+	renderOutput.Resources, err = r.renderDomainMappings(ctx, releaseBinding, environment, dataPlane, renderOutput.Resources)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to render domain mappings: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to render domain mappings")
+		return ctrl.Result{}, fmt.Errorf("failed to render domain mappings: %w", err)
+	}
+
+	// Answer the requests of a component in maintenance at the gateway, including those of
+	// its custom domains.
+	renderOutput.Resources = componentpipeline.RenderMaintenance(renderOutput.Resources, releaseBinding.Spec.Maintenance)
+
+	// Bind the workloads to the cloud identity requested by the component type.
+	identityBinding, ok := bindWorkloadIdentity(releaseBinding, snapshotComponentType.Spec.WorkloadIdentity,
+		renderOutput.WorkloadIdentity, dataPlane.Spec.WorkloadIdentity, metadataContext.Namespace, metadataContext.ComponentName)
+	if !ok {
+		logger.Info("Workload identity cannot be bound", "identity", renderOutput.WorkloadIdentity)
+		// The check is deterministic; a change to the component type or data plane triggers the next attempt.
+		return ctrl.Result{}, nil
+	}
+
 	// Log warnings if any
 	if len(renderOutput.Metadata.Warnings) > 0 {
 		logger.Info("Rendering completed with warnings",
@@ -566,6 +765,13 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		}
 	}
 
+	// Lifecycle hook Jobs run with the same scheduling and pull secrets as the workloads.
+	hookJobs := make([]map[string]any, 0, len(renderOutput.Hooks))
+	for _, hook := range renderOutput.Hooks {
+		hookJobs = append(hookJobs, hook.Resource)
+	}
+	podResources := slices.Concat(dataPlaneResources, hookJobs)
+
 	// Inject per-component network policies into dataplane resources.
 	// The provider is determined by the "openchoreo.dev/networkpolicyprovider" annotation on the DataPlane CR.
 	componentNetpols := networkpolicy.MakeComponentPolicies(networkpolicy.ComponentPolicyParams{
@@ -579,6 +785,90 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 	})
 	dataPlaneResources = append(dataPlaneResources, componentNetpols...)
 
+	// Provision the topics of Kafka endpoints that request it as Strimzi KafkaTopics.
+	dataPlaneResources = append(dataPlaneResources, kafkatopic.Make(kafkatopic.Params{
+		Namespace:     metadataContext.Namespace,
+		ComponentName: metadataContext.ComponentName,
+		Endpoints:     snapshotWorkload.Spec.Endpoints,
+	})...)
+
+	// Inject the scheduling policy of the data plane, environment and binding into the
+	// rendered pod specs, rejecting policies that no data plane node can satisfy.
+	schedulingPolicy := scheduling.Merge(dataPlane.Spec.Scheduling, environment.Spec.Scheduling, releaseBinding.Spec.Scheduling)
+	if err := scheduling.Validate(schedulingPolicy, dataPlane.Status.NodeLabels); err != nil {
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonSchedulingPolicyUnsatisfiable, err.Error())
+		logger.Info("Scheduling policy does not match the data plane nodes", "error", err.Error())
+		// Node labels are rediscovered periodically, so check again later.
+		return controller.ResyncAfter(controller.StatusUpdateInterval), nil
+	}
+	if err := scheduling.Apply(podResources, schedulingPolicy, metadataContext.PodSelectors); err != nil {
+		msg := fmt.Sprintf("Failed to apply scheduling policy: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to apply scheduling policy")
+		return ctrl.Result{}, fmt.Errorf("failed to apply scheduling policy: %w", err)
+	}
+	if environment.Spec.Priority != nil {
+		scheduling.SetPriorityClassName(podResources, environment.Spec.Priority.PriorityClassName)
+	}
+
+	// Sync the data plane's registry credentials into the target namespace and attach
+	// them to the rendered workloads as image pull secrets.
+	secretStoreName := ""
+	if dataPlane.Spec.SecretStoreRef != nil {
+		secretStoreName = dataPlane.Spec.SecretStoreRef.Name
+	}
+	pullSecrets, pullSecretNames, err := imagepullsecret.MakeExternalSecrets(imagepullsecret.Params{
+		Namespace:       metadataContext.Namespace,
+		ComponentName:   metadataContext.ComponentName,
+		SecretStoreName: secretStoreName,
+		Credentials:     registryCredentials,
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Failed to render image pull secrets: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to render image pull secrets")
+		return ctrl.Result{}, fmt.Errorf("failed to render image pull secrets: %w", err)
+	}
+	imagepullsecret.Attach(podResources, pullSecretNames)
+	dataPlaneResources = append(dataPlaneResources, pullSecrets...)
+
+	// Read the secrets of Vault-backed SecretReferences directly from Vault.
+	vaultRefs := make([]*openchoreov1alpha1.SecretReference, 0, len(secretReferences)+len(registryCredentials))
+	for _, ref := range secretReferences {
+		vaultRefs = append(vaultRefs, ref)
+	}
+	for _, cred := range registryCredentials {
+		vaultRefs = append(vaultRefs, cred.SecretReference)
+	}
+	dataPlaneResources, err = vaultsecret.Apply(dataPlaneResources, podResources, vaultsecret.Params{
+		Namespace:        metadataContext.Namespace,
+		ComponentName:    metadataContext.ComponentName,
+		SecretReferences: vaultRefs,
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Failed to render Vault secrets: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to render Vault secrets")
+		return ctrl.Result{}, fmt.Errorf("failed to render Vault secrets: %w", err)
+	}
+
+	// Run the workloads as a ServiceAccount annotated for the data plane's identity provider.
+	if identityBinding != nil {
+		workloadidentity.Attach(podResources, identityBinding)
+		dataPlaneResources = append(dataPlaneResources, workloadidentity.MakeServiceAccount(identityBinding))
+	}
+
+	// Protect multi-replica workloads from voluntary disruptions such as node drains.
+	componentTypeBudget := snapshotComponentType.Spec.DisruptionBudget
+	if disruptionbudget.Enabled(componentTypeBudget, releaseBinding.Spec.DisruptionBudget, environment.Spec.IsProduction) {
+		minAvailable := disruptionbudget.MinAvailable(componentTypeBudget, releaseBinding.Spec.DisruptionBudget)
+		dataPlaneResources = append(dataPlaneResources, disruptionbudget.Make(dataPlaneResources, minAvailable)...)
+	}
+
 	// Convert filtered dataplane resources to Release format
 	dataPlaneReleaseResources, err := r.convertToReleaseResources(dataPlaneResources)
 	if err != nil {
@@ -608,6 +898,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		},
 	}
 
+	var hooks *hookPlan
 	dpOp, err := controllerutil.CreateOrUpdate(ctx, r.Client, dataPlaneRelease, func() error {
 		// Check if we own this Release (only for existing releases)
 		if dataPlaneRelease.UID != "" {
@@ -620,6 +911,13 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 			}
 		}
 
+		// Lifecycle hooks decide which of the rendered resources this rollout step applies.
+		var err error
+		hooks, err = planLifecycleHooks(dataPlaneRelease, dataPlaneReleaseResources, renderOutput.Hooks)
+		if err != nil {
+			return err
+		}
+
 		dataPlaneRelease.Labels = map[string]string{
 			labels.LabelKeyNamespaceName:   releaseBinding.Namespace,
 			labels.LabelKeyProjectName:     releaseBinding.Spec.Owner.ProjectName,
@@ -643,7 +941,9 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 			},
 			EnvironmentName: releaseBinding.Spec.Environment,
 			TargetPlane:     openchoreov1alpha1.TargetPlaneDataPlane,
-			Resources:       dataPlaneReleaseResources,
+			Resources:       hooks.resources,
+			// The drift policy is set on the release itself and kept across renders.
+			DriftPolicy: dataPlaneRelease.Spec.DriftPolicy,
 		}
 
 		return controllerutil.SetControllerReference(releaseBinding, dataPlaneRelease, r.Scheme)
@@ -666,6 +966,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		logger.Error(err, "Failed to reconcile dataplane Release", "release", dataPlaneRelease.Name)
 		return ctrl.Result{}, err
 	}
+	setHooksStatus(releaseBinding, hooks)
 
 	// Reconcile observability plane Release (create, update, or cleanup)
 	obsResult, err := r.reconcileObservabilityRelease(ctx, releaseBinding, componentRelease, dataPlaneResult, observabilityPlaneReleaseResources)
@@ -677,11 +978,14 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		return ctrl.Result{}, nil
 	}
 
+	// Asynchronous endpoints are served by a message broker rather than by the component.
+	servedEndpoints, asyncEndpoints := splitAsyncEndpoints(componentRelease.Spec.Workload.Endpoints)
+
 	// Resolve per-endpoint invoke URLs by matching HTTPRoute backendRef ports to workload endpoints.
 	releaseBinding.Status.Endpoints = resolveEndpointURLStatuses(
 		ctx,
 		dataPlaneReleaseResources,
-		componentRelease.Spec.Workload.Endpoints,
+		servedEndpoints,
 		environment,
 		dataPlane,
 	)
@@ -690,10 +994,13 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 	releaseBinding.Status.Endpoints = resolveServiceURLs(
 		ctx,
 		dataPlaneReleaseResources,
-		componentRelease.Spec.Workload.Endpoints,
+		servedEndpoints,
 		releaseBinding.Status.Endpoints,
 	)
 
+	// Resolve broker URLs for Kafka, NATS and AMQP endpoints.
+	releaseBinding.Status.Endpoints = resolveAsyncEndpointURLs(asyncEndpoints, releaseBinding.Status.Endpoints)
+
 	// Connection stability guard: check after endpoint URL resolution so that
 	// this component's own endpoint URLs are always kept up to date (unblocking
 	// other components' connections), but requeue before marking ReleaseSynced
@@ -722,6 +1029,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 
 	// Set ReleaseSynced condition based on operation results.
 	r.setReleaseSyncedCondition(releaseBinding, dataPlaneRelease.Name, dpOp, len(dataPlaneReleaseResources), obsResult)
+	setWorkloadIdentityStatus(releaseBinding, identityBinding, dataPlaneRelease.Status.Resources)
 
 	// Check if the Release controller recorded a resource apply failure.
 	// Only act on the condition when it matches the current Release generation
@@ -751,6 +1059,14 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		return ctrl.Result{}, fmt.Errorf("failed to set resources ready status: %w", err)
 	}
 
+	// Record the promotion and notify the environment channel only once the release is deployed.
+	if meta.IsStatusConditionTrue(releaseBinding.Status.Conditions, string(ConditionResourcesReady)) {
+		if err := r.recordPromotion(ctx, releaseBinding, componentRelease, defaultNotificationChannel); err != nil {
+			logger.Error(err, "Failed to record promotion")
+			return ctrl.Result{}, err
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
@@ -761,6 +1077,7 @@ func (r *Reconciler) handleUndeploy(ctx context.Context,
 	releaseBinding *openchoreov1alpha1.ReleaseBinding,
 	componentRelease *openchoreov1alpha1.ComponentRelease) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
+	releaseBinding.Status.Diagnosis = nil
 
 	// Get release names
 	dpReleaseName := makeDataPlaneReleaseName(componentRelease, releaseBinding)
@@ -924,6 +1241,8 @@ func (r *Reconciler) reconcileObservabilityRelease(
 				EnvironmentName: releaseBinding.Spec.Environment,
 				TargetPlane:     openchoreov1alpha1.TargetPlaneObservabilityPlane,
 				Resources:       observabilityPlaneReleaseResources,
+				// The drift policy is set on the release itself and kept across renders.
+				DriftPolicy: observabilityRelease.Spec.DriftPolicy,
 			}
 
 			return controllerutil.SetControllerReference(releaseBinding, observabilityRelease, r.Scheme)
@@ -1239,6 +1558,10 @@ func resolveEndpointURLStatuses(
 		}
 
 		objLabels := obj.GetLabels()
+		// Custom domain routes are reported by their DomainMapping.
+		if isRolloutCandidate(objLabels) || objLabels[labels.LabelKeyDomainMapping] != "" {
+			continue
+		}
 		endpointName := objLabels[labels.LabelKeyEndpointName]
 		if endpointName == "" {
 			logger.Info("Route missing endpoint-name label, skipping",
@@ -1521,6 +1844,13 @@ func (r *Reconciler) getDefaultNotificationChannelName(ctx context.Context, name
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	ctx := context.Background()
 
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("releasebinding-controller")
+	}
+	if r.httpClient == nil {
+		r.httpClient = &http.Client{Timeout: controller.ObserverAPITimeout}
+	}
+
 	// Setup field index for SecretReferences (reads from status.secretReferenceNames)
 	if err := r.setupSecretReferencesIndex(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to setup SecretReferences index: %w", err)
@@ -1536,6 +1866,11 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return fmt.Errorf("failed to setup resource dependency targets index: %w", err)
 	}
 
+	reconciler, err := controller.WithQuarantine(mgr, r, &openchoreov1alpha1.ReleaseBinding{}, r.Quarantine)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.ReleaseBinding{}).
 		Owns(&openchoreov1alpha1.RenderedRelease{}).
@@ -1574,6 +1909,25 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForClusterDataPlane),
 			builder.WithPredicates(dataPlaneRenderInputsChangedPredicate()),
 		).
+		Watches(
+			&openchoreov1alpha1.Environment{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForEnvironment),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// Release promotions held back by an exhausted error budget once it recovers.
+		Watches(
+			&openchoreov1alpha1.ServiceLevelObjective{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForServiceLevelObjective),
+			builder.WithPredicates(errorBudgetExhaustedChangedPredicate()),
+		).
+		// Verified custom domains are served by the bindings of their component.
+		Watches(
+			&openchoreov1alpha1.DomainMapping{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForDomainMapping),
+			builder.WithPredicates(domainMappingServedChangedPredicate()),
+		).
 		Named("releasebinding").
-		Complete(r)
+		// Periodic rechecks are queued at low priority; see controller.ResyncAfter.
+		WithOptions(crcontroller.Options{UsePriorityQueue: ptr.To(true)}).
+		Complete(reconciler)
 }
@@ -31,6 +31,22 @@ const (
 	// matching ResourceReleaseBinding whose outputs are populated.
 	ConditionResourceDependenciesReady controller.ConditionType = "ResourceDependenciesReady"
 
+	// ConditionRolledBack indicates that spec.releaseName failed its post-deploy health check
+	// and the last healthy release is deployed instead. Only present while the rollback applies.
+	// It is also set when a degraded release was rolled back by re-pointing spec.releaseName,
+	// until the spec changes again, and set to False while such a rollback is blocked by a
+	// deployment lock.
+	ConditionRolledBack controller.ConditionType = "RolledBack"
+
+	// ConditionHooksSucceeded indicates that the lifecycle hooks of the component type succeeded
+	// for the current rollout. Only present when the component type defines hooks.
+	ConditionHooksSucceeded controller.ConditionType = "HooksSucceeded"
+
+	// ConditionWorkloadIdentityBound indicates that the workloads run as a ServiceAccount bound
+	// to the cloud identity requested by the component type. Only present when the component
+	// type requests a workload identity.
+	ConditionWorkloadIdentityBound controller.ConditionType = "WorkloadIdentityBound"
+
 	// ConditionFinalizing indicates that the ReleaseBinding is being finalized (deleted).
 	ConditionFinalizing controller.ConditionType = "Finalizing"
 )
@@ -63,11 +79,36 @@ const (
 	ReasonProjectNotFound controller.ConditionReason = "ProjectNotFound"
 	// ReasonInvalidReleaseConfiguration indicates the ComponentRelease configuration is invalid
 	ReasonInvalidReleaseConfiguration controller.ConditionReason = "InvalidReleaseConfiguration"
+	// ReasonErrorBudgetExhausted indicates a promotion is blocked by an exhausted SLO error budget
+	ReasonErrorBudgetExhausted controller.ConditionReason = "ErrorBudgetExhausted"
 
 	// Rendering issues (Status=False)
 
 	// ReasonRenderingFailed indicates failure to render resources
 	ReasonRenderingFailed controller.ConditionReason = "RenderingFailed"
+	// ReasonReleaseLimitExceeded indicates the rendered release exceeds a render limit
+	ReasonReleaseLimitExceeded controller.ConditionReason = "ReleaseLimitExceeded"
+	// ReasonSchedulingPolicyUnsatisfiable indicates the merged scheduling policy matches no data plane node
+	ReasonSchedulingPolicyUnsatisfiable controller.ConditionReason = "SchedulingPolicyUnsatisfiable"
+	// ReasonTenancyDenied indicates the tenancy policy of the ClusterDataPlane does not admit the project
+	ReasonTenancyDenied controller.ConditionReason = "TenancyDenied"
+	// ReasonRequiredEnvMissing indicates env vars required by the component type are not set
+	ReasonRequiredEnvMissing controller.ConditionReason = "RequiredEnvMissing"
+	// ReasonRolloutStrategyUnsupported indicates the rollout strategy cannot roll out the
+	// workload type of the component type
+	ReasonRolloutStrategyUnsupported controller.ConditionReason = "RolloutStrategyUnsupported"
+
+	// ReasonHealthCheckFailed indicates the bound release failed its post-deploy health check
+	// and was rolled back
+	ReasonHealthCheckFailed controller.ConditionReason = "HealthCheckFailed"
+
+	// ReasonDegradedTimeoutExceeded indicates the resources stayed degraded for longer than the
+	// auto-rollback policy allows and spec.releaseName was re-pointed to the previously healthy release
+	ReasonDegradedTimeoutExceeded controller.ConditionReason = "DegradedTimeoutExceeded"
+
+	// ReasonRollbackBlocked indicates the resources stayed degraded for longer than the
+	// auto-rollback policy allows but the deployment lock holds back the rollback
+	ReasonRollbackBlocked controller.ConditionReason = "RollbackBlocked"
 
 	// Release management issues (Status=False)
 
@@ -96,6 +137,16 @@ const (
 	// ReasonResourcesUnknown indicates resource status is unknown
 	ReasonResourcesUnknown controller.ConditionReason = "ResourcesUnknown"
 
+	// Diagnosed workload failures, reported instead of ResourcesDegraded when the pods of a
+	// degraded workload hit a known failure mode
+
+	// ReasonImagePullFailed indicates a container image of the workload cannot be pulled
+	ReasonImagePullFailed controller.ConditionReason = "ImagePullFailed"
+	// ReasonCrashLoopBackOff indicates a container of the workload keeps crashing
+	ReasonCrashLoopBackOff controller.ConditionReason = "CrashLoopBackOff"
+	// ReasonOOMKilled indicates a container of the workload was killed for running out of memory
+	ReasonOOMKilled controller.ConditionReason = "OOMKilled"
+
 	// Connection condition reasons
 
 	// ReasonAllConnectionsResolved indicates all connection URLs are resolved
@@ -114,6 +165,28 @@ const (
 	// ReasonNoResourceDependencies indicates there are no resource dependencies to resolve
 	ReasonNoResourceDependencies controller.ConditionReason = "NoResourceDependencies"
 
+	// Lifecycle hook condition reasons
+
+	// ReasonHooksSucceeded indicates all lifecycle hooks of the rollout succeeded
+	ReasonHooksSucceeded controller.ConditionReason = "HooksSucceeded"
+	// ReasonPreDeployHooksRunning indicates workload changes wait for pre-deploy hooks
+	ReasonPreDeployHooksRunning controller.ConditionReason = "PreDeployHooksRunning"
+	// ReasonPostDeployHooksRunning indicates post-deploy hooks have not completed yet
+	ReasonPostDeployHooksRunning controller.ConditionReason = "PostDeployHooksRunning"
+	// ReasonHookFailed indicates a lifecycle hook whose failures are not ignored failed
+	ReasonHookFailed controller.ConditionReason = "HookFailed"
+
+	// Workload identity condition reasons
+
+	// ReasonWorkloadIdentityBound indicates the bound ServiceAccount is applied to the data plane
+	ReasonWorkloadIdentityBound controller.ConditionReason = "WorkloadIdentityBound"
+	// ReasonWorkloadIdentityPending indicates the bound ServiceAccount is not applied yet
+	ReasonWorkloadIdentityPending controller.ConditionReason = "WorkloadIdentityPending"
+	// ReasonWorkloadIdentityUnavailable indicates the data plane has no workload identity provider
+	ReasonWorkloadIdentityUnavailable controller.ConditionReason = "WorkloadIdentityUnavailable"
+	// ReasonWorkloadIdentityInvalid indicates the requested identity is not valid for the provider
+	ReasonWorkloadIdentityInvalid controller.ConditionReason = "WorkloadIdentityInvalid"
+
 	// Ready condition reasons
 
 	// ReasonReady indicates the ReleaseBinding is fully ready
@@ -259,12 +259,19 @@ func buildEnvVarsForConnection(
 		})
 	}
 
+	if conn.EnvBindings.Channel != "" {
+		envVars = append(envVars, pipelinecontext.EnvVarEntry{
+			Name:  conn.EnvBindings.Channel,
+			Value: rc.URL.Channel,
+		})
+	}
+
 	return envVars
 }
 
 // formatEndpointAddress formats an EndpointURL into a protocol-appropriate connection string.
-// For schemes that use URL format (http, https, ws, wss, tls): scheme://host:port/path
-// For schemes without URL format (grpc, tcp, udp) or empty: host:port
+// For schemes that use URL format (http, https, ws, wss, tls, nats, amqp): scheme://host:port/path
+// For schemes without URL format (grpc, tcp, udp, kafka) or empty: host:port
 func formatEndpointAddress(url openchoreov1alpha1.EndpointURL) string {
 	var sb strings.Builder
 
@@ -294,7 +301,7 @@ func formatEndpointAddress(url openchoreov1alpha1.EndpointURL) string {
 // in the formatted address (HTTP-family and TLS protocols).
 func schemeUsesURLFormat(scheme string) bool {
 	switch scheme {
-	case "http", "https", "ws", "wss", "tls":
+	case "http", "https", "ws", "wss", "tls", "nats", "amqp":
 		return true
 	default:
 		return false
@@ -0,0 +1,123 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// renderDomainMappings adds the resources that serve the verified DomainMappings of the
+// component in the environment. Environments that do not enable custom domains serve none.
+func (r *Reconciler) renderDomainMappings(ctx context.Context, rb *openchoreov1alpha1.ReleaseBinding,
+	environment *openchoreov1alpha1.Environment, dataPlane *openchoreov1alpha1.DataPlane,
+	resources []renderer.RenderedResource) ([]renderer.RenderedResource, error) {
+	if environment == nil || environment.Spec.CustomDomains == nil {
+		return resources, nil
+	}
+
+	targets, err := r.verifiedDomainMappings(ctx, rb)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return resources, nil
+	}
+
+	out := componentpipeline.RenderDomainMappings(&componentpipeline.DomainMappingInput{
+		Resources: resources,
+		Mappings:  targets,
+		Gateway:   resolveGatewayEndpointByVisibility(openchoreov1alpha1.EndpointVisibilityExternal, environment, dataPlane),
+		IssuerRef: environment.Spec.CustomDomains.IssuerRef,
+	})
+	return out.Resources, nil
+}
+
+// verifiedDomainMappings returns the DomainMappings of the binding's component in its
+// environment whose domain is verified, sorted by name.
+func (r *Reconciler) verifiedDomainMappings(ctx context.Context,
+	rb *openchoreov1alpha1.ReleaseBinding) ([]componentpipeline.DomainMappingTarget, error) {
+	var mappings openchoreov1alpha1.DomainMappingList
+	if err := r.List(ctx, &mappings, client.InNamespace(rb.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list DomainMappings: %w", err)
+	}
+
+	var targets []componentpipeline.DomainMappingTarget
+	for i := range mappings.Items {
+		dm := &mappings.Items[i]
+		if dm.Spec.Owner.ProjectName != rb.Spec.Owner.ProjectName ||
+			dm.Spec.Owner.ComponentName != rb.Spec.Owner.ComponentName ||
+			dm.Spec.Environment != rb.Spec.Environment {
+			continue
+		}
+		if !dm.DeletionTimestamp.IsZero() || !dm.IsVerified() {
+			continue
+		}
+		targets = append(targets, componentpipeline.DomainMappingTarget{
+			Name:     dm.Name,
+			Endpoint: dm.Spec.Endpoint,
+			Domain:   dm.Spec.Domain,
+		})
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].Name < targets[j].Name })
+	return targets, nil
+}
+
+// domainMappingServedChangedPredicate passes when a DomainMapping gets verified or its
+// endpoint changes, which are the only inputs of the rendered domain resources.
+func domainMappingServedChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			dm, ok := e.Object.(*openchoreov1alpha1.DomainMapping)
+			return ok && dm.IsVerified()
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldDM, ok1 := e.ObjectOld.(*openchoreov1alpha1.DomainMapping)
+			newDM, ok2 := e.ObjectNew.(*openchoreov1alpha1.DomainMapping)
+			if !ok1 || !ok2 {
+				return false
+			}
+			return oldDM.IsVerified() != newDM.IsVerified() ||
+				oldDM.Spec.Endpoint != newDM.Spec.Endpoint
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// findReleaseBindingsForDomainMapping maps a DomainMapping to the ReleaseBindings of its
+// component in its environment.
+func (r *Reconciler) findReleaseBindingsForDomainMapping(ctx context.Context, obj client.Object) []reconcile.Request {
+	dm, ok := obj.(*openchoreov1alpha1.DomainMapping)
+	if !ok {
+		return nil
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings,
+		client.InNamespace(dm.Namespace),
+		client.MatchingFields{controller.IndexKeyReleaseBindingOwnerComponentName: dm.Spec.Owner.ComponentName}); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, binding := range bindings.Items {
+		if binding.Spec.Owner.ProjectName != dm.Spec.Owner.ProjectName || binding.Spec.Environment != dm.Spec.Environment {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&binding)})
+	}
+	return requests
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+func makeDomainMapping(name, component, domain string, verified bool) *openchoreov1alpha1.DomainMapping {
+	dm := &openchoreov1alpha1.DomainMapping{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: openchoreov1alpha1.DomainMappingSpec{
+			Owner: openchoreov1alpha1.DomainMappingOwner{
+				ProjectName:   testProjectName,
+				ComponentName: component,
+			},
+			Environment: testEnvStaging,
+			Endpoint:    "api",
+			Domain:      domain,
+		},
+	}
+	if verified {
+		now := metav1.Now()
+		dm.Status.Verification = &openchoreov1alpha1.DomainVerificationStatus{VerifiedAt: &now}
+	}
+	return dm
+}
+
+func TestVerifiedDomainMappings(t *testing.T) {
+	r, _ := newPromotionTestReconciler(t,
+		makeDomainMapping("shop-b", testComponentName, "b.example.com", true),
+		makeDomainMapping("shop-a", testComponentName, "a.example.com", true),
+		makeDomainMapping("pending", testComponentName, "c.example.com", false),
+		makeDomainMapping("other", "other-component", "d.example.com", true),
+	)
+
+	got, err := r.verifiedDomainMappings(context.Background(), makePromotionBinding())
+	require.NoError(t, err)
+	assert.Equal(t, []componentpipeline.DomainMappingTarget{
+		{Name: "shop-a", Endpoint: "api", Domain: "a.example.com"},
+		{Name: "shop-b", Endpoint: "api", Domain: "b.example.com"},
+	}, got)
+}
+
+func TestRenderDomainMappingsRequiresCustomDomains(t *testing.T) {
+	r, _ := newPromotionTestReconciler(t, makeDomainMapping("shop", testComponentName, "shop.example.com", true))
+	resources := []renderer.RenderedResource{{
+		Resource: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata": map[string]any{
+				"name":      "api",
+				"namespace": "dp-shop",
+				"labels": map[string]any{
+					"openchoreo.dev/endpoint-name":       "api",
+					"openchoreo.dev/endpoint-visibility": "external",
+				},
+			},
+		},
+		TargetPlane: openchoreov1alpha1.TargetPlaneDataPlane,
+	}}
+	dataPlane := &openchoreov1alpha1.DataPlane{}
+	dataPlane.Spec.Gateway.Ingress = &openchoreov1alpha1.GatewayNetworkSpec{
+		External: &openchoreov1alpha1.GatewayEndpointSpec{Name: "gateway-default", Namespace: "openchoreo-data-plane"},
+	}
+	environment := &openchoreov1alpha1.Environment{}
+
+	got, err := r.renderDomainMappings(context.Background(), makePromotionBinding(), environment, dataPlane, resources)
+	require.NoError(t, err)
+	assert.Len(t, got, 1, "an environment without custom domains must not serve them")
+
+	environment.Spec.CustomDomains = &openchoreov1alpha1.CustomDomainPolicy{
+		IssuerRef: openchoreov1alpha1.CertificateIssuerRef{Name: "letsencrypt"},
+	}
+	got, err = r.renderDomainMappings(context.Background(), makePromotionBinding(), environment, dataPlane, resources)
+	require.NoError(t, err)
+	kinds := map[string]int{}
+	for _, rr := range got {
+		kinds[rr.Resource["kind"].(string)]++
+	}
+	assert.Equal(t, map[string]int{"Certificate": 1, "HTTPRoute": 2, "XListenerSet": 1}, kinds)
+}
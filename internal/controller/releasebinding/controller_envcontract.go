@@ -0,0 +1,96 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+	"strings"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// envSource describes how an environment variable of the rendered container is set.
+type envSource struct {
+	// fromSecret is true when the value is read from a secret rather than set literally.
+	fromSecret bool
+}
+
+// providedEnv returns the environment variables set for the container of the workload,
+// keyed by name. Workload overrides of the binding replace workload env vars of the same
+// name. Dependency env bindings count as provided even while the dependency is pending,
+// since pending dependencies are reported by their own conditions. Resource dependency
+// outputs may be secrets, so their env bindings satisfy secret variables.
+func providedEnv(workload *openchoreov1alpha1.Workload, rb *openchoreov1alpha1.ReleaseBinding) map[string]envSource {
+	provided := make(map[string]envSource)
+	addEnv := func(env openchoreov1alpha1.EnvVar) {
+		switch {
+		case env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil:
+			provided[env.Key] = envSource{fromSecret: true}
+		case env.Value != "":
+			provided[env.Key] = envSource{}
+		default:
+			delete(provided, env.Key)
+		}
+	}
+
+	if workload != nil {
+		for _, env := range workload.Spec.Container.Env {
+			addEnv(env)
+		}
+	}
+	if rb.Spec.WorkloadOverrides != nil && rb.Spec.WorkloadOverrides.Container != nil {
+		for _, env := range rb.Spec.WorkloadOverrides.Container.Env {
+			addEnv(env)
+		}
+	}
+	if workload != nil {
+		for _, conn := range workload.Spec.GetDependencyEndpoints() {
+			b := conn.EnvBindings
+			for _, name := range []string{b.Address, b.Host, b.Port, b.BasePath, b.Channel} {
+				if _, ok := provided[name]; name != "" && !ok {
+					provided[name] = envSource{}
+				}
+			}
+		}
+		for _, dep := range workload.Spec.GetDependencyResources() {
+			for _, name := range dep.EnvBindings {
+				provided[name] = envSource{fromSecret: true}
+			}
+		}
+	}
+	return provided
+}
+
+// unsatisfiedRequiredEnv returns a description of every required environment variable of
+// the component type that the workload does not set, or sets literally although it must
+// come from a secret, in the order the component type declares them.
+func unsatisfiedRequiredEnv(required []openchoreov1alpha1.RequiredEnvVar, workload *openchoreov1alpha1.Workload,
+	rb *openchoreov1alpha1.ReleaseBinding) []string {
+	if len(required) == 0 {
+		return nil
+	}
+
+	provided := providedEnv(workload, rb)
+	var unsatisfied []string
+	for _, req := range required {
+		source, ok := provided[req.Name]
+		switch {
+		case !ok:
+			if req.Description != "" {
+				unsatisfied = append(unsatisfied, fmt.Sprintf("%s (%s)", req.Name, req.Description))
+			} else {
+				unsatisfied = append(unsatisfied, req.Name)
+			}
+		case req.Secret && !source.fromSecret:
+			unsatisfied = append(unsatisfied, fmt.Sprintf("%s (must be set from a secret, not a literal value)", req.Name))
+		}
+	}
+	return unsatisfied
+}
+
+// requiredEnvMessage formats the condition message for unsatisfied required env vars.
+func requiredEnvMessage(componentType string, unsatisfied []string) string {
+	return fmt.Sprintf("Component type %q requires environment variables that are not set: %s",
+		componentType, strings.Join(unsatisfied, "; "))
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func makeEnvContractWorkload(env ...openchoreov1alpha1.EnvVar) *openchoreov1alpha1.Workload {
+	workload := &openchoreov1alpha1.Workload{}
+	workload.Spec.Container.Env = env
+	return workload
+}
+
+func secretEnv(key string) openchoreov1alpha1.EnvVar {
+	return openchoreov1alpha1.EnvVar{
+		Key:       key,
+		ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: "creds", Key: key}},
+	}
+}
+
+func TestUnsatisfiedRequiredEnv(t *testing.T) {
+	required := []openchoreov1alpha1.RequiredEnvVar{
+		{Name: "LOG_LEVEL"},
+		{Name: "DATABASE_URL", Description: "connection string of the orders database"},
+		{Name: "API_KEY", Secret: true},
+	}
+
+	t.Run("all variables set", func(t *testing.T) {
+		workload := makeEnvContractWorkload(
+			openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"},
+			openchoreov1alpha1.EnvVar{Key: "DATABASE_URL", Value: "postgres://db"},
+			secretEnv("API_KEY"),
+		)
+		assert.Empty(t, unsatisfiedRequiredEnv(required, workload, makePromotionBinding()))
+	})
+
+	t.Run("missing variables are listed in declaration order", func(t *testing.T) {
+		workload := makeEnvContractWorkload(openchoreov1alpha1.EnvVar{Key: "DATABASE_URL", Value: "postgres://db"})
+		assert.Equal(t, []string{"LOG_LEVEL", "API_KEY"}, unsatisfiedRequiredEnv(required, workload, makePromotionBinding()))
+	})
+
+	t.Run("missing variable includes its description", func(t *testing.T) {
+		workload := makeEnvContractWorkload(openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"}, secretEnv("API_KEY"))
+		assert.Equal(t, []string{"DATABASE_URL (connection string of the orders database)"},
+			unsatisfiedRequiredEnv(required, workload, makePromotionBinding()))
+	})
+
+	t.Run("secret variable set literally", func(t *testing.T) {
+		workload := makeEnvContractWorkload(
+			openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"},
+			openchoreov1alpha1.EnvVar{Key: "DATABASE_URL", Value: "postgres://db"},
+			openchoreov1alpha1.EnvVar{Key: "API_KEY", Value: "hunter2"},
+		)
+		assert.Equal(t, []string{"API_KEY (must be set from a secret, not a literal value)"},
+			unsatisfiedRequiredEnv(required, workload, makePromotionBinding()))
+	})
+
+	t.Run("binding overrides and dependency env bindings satisfy variables", func(t *testing.T) {
+		workload := makeEnvContractWorkload(openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: ""})
+		workload.Spec.Dependencies = &openchoreov1alpha1.WorkloadDependencies{
+			Endpoints: []openchoreov1alpha1.WorkloadConnection{{
+				Component: "orders-db", Name: "tcp", Visibility: "project",
+				EnvBindings: openchoreov1alpha1.ConnectionEnvBindings{Address: "DATABASE_URL"},
+			}},
+			Resources: []openchoreov1alpha1.WorkloadResourceDependency{{
+				Ref: "payments-api", EnvBindings: map[string]string{"key": "API_KEY"},
+			}},
+		}
+		rb := makePromotionBinding()
+		rb.Spec.WorkloadOverrides = &openchoreov1alpha1.WorkloadOverrideTemplateSpec{
+			Container: &openchoreov1alpha1.ContainerOverride{Env: []openchoreov1alpha1.EnvVar{{Key: "LOG_LEVEL", Value: "debug"}}},
+		}
+		assert.Empty(t, unsatisfiedRequiredEnv(required, workload, rb))
+	})
+
+	t.Run("empty override unsets a workload variable", func(t *testing.T) {
+		workload := makeEnvContractWorkload(
+			openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"},
+			openchoreov1alpha1.EnvVar{Key: "DATABASE_URL", Value: "postgres://db"},
+			secretEnv("API_KEY"),
+		)
+		rb := makePromotionBinding()
+		rb.Spec.WorkloadOverrides = &openchoreov1alpha1.WorkloadOverrideTemplateSpec{
+			Container: &openchoreov1alpha1.ContainerOverride{Env: []openchoreov1alpha1.EnvVar{{Key: "LOG_LEVEL"}}},
+		}
+		assert.Equal(t, []string{"LOG_LEVEL"}, unsatisfiedRequiredEnv(required, workload, rb))
+	})
+
+	t.Run("no contract", func(t *testing.T) {
+		assert.Nil(t, unsatisfiedRequiredEnv(nil, makeEnvContractWorkload(), makePromotionBinding()))
+	})
+}
+
+func TestRequiredEnvMessage(t *testing.T) {
+	assert.Equal(t, `Component type "deployment/service" requires environment variables that are not set: LOG_LEVEL; API_KEY (must be set from a secret, not a literal value)`,
+		requiredEnvMessage("deployment/service", []string{"LOG_LEVEL", "API_KEY (must be set from a secret, not a literal value)"}))
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// MaxDeploymentHistory bounds status.deploymentHistory.
+const MaxDeploymentHistory = 50
+
+// maxConditionHistory bounds status.conditionHistory.
+const maxConditionHistory = 50
+
+// deploymentFailureReasons are the Ready condition reasons that mark a deployment as failed.
+// Transient reasons such as ResourcesProgressing are not failures.
+var deploymentFailureReasons = map[string]bool{
+	string(ReasonRenderingFailed):     true,
+	string(ReasonResourceApplyFailed): true,
+	string(ReasonResourcesDegraded):   true,
+	string(ReasonJobFailed):           true,
+	string(ReasonImagePullFailed):     true,
+	string(ReasonCrashLoopBackOff):    true,
+	string(ReasonOOMKilled):           true,
+}
+
+// appendDeploymentRecord adds a record for a newly bound release. Binding a release
+// that was created before the currently deployed one is treated as a rollback, which
+// marks the current deployment as failed.
+func appendDeploymentRecord(rb *openchoreov1alpha1.ReleaseBinding, to *openchoreov1alpha1.ComponentRelease, now metav1.Time) {
+	history := rb.Status.DeploymentHistory
+	if n := len(history); n > 0 {
+		last := &history[n-1]
+		if to.CreationTimestamp.Before(&last.ReleaseCreatedAt) {
+			last.RolledBack = true
+			if last.FailedAt == nil {
+				last.FailedAt = &now
+				observeDeploymentFailure(rb)
+			}
+		}
+	}
+
+	history = append(history, openchoreov1alpha1.DeploymentRecord{
+		Release:          to.Name,
+		ReleaseCreatedAt: to.CreationTimestamp,
+		DeployedAt:       now,
+	})
+	if len(history) > MaxDeploymentHistory {
+		history = history[len(history)-MaxDeploymentHistory:]
+	}
+	rb.Status.DeploymentHistory = history
+	observeDeployment(rb, to.CreationTimestamp, now)
+}
+
+// updateDeploymentHistory updates the latest deployment record from the Ready condition.
+// A Ready deployment restores any unrestored failure of itself or of the deployment it replaced.
+func updateDeploymentHistory(rb *openchoreov1alpha1.ReleaseBinding, now metav1.Time) {
+	history := rb.Status.DeploymentHistory
+	n := len(history)
+	if n == 0 {
+		return
+	}
+	ready := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionReady))
+	if ready == nil {
+		return
+	}
+
+	current := &history[n-1]
+	switch {
+	case ready.Status == metav1.ConditionTrue:
+		if current.ReadyAt == nil {
+			current.ReadyAt = &now
+		}
+		restore := func(r *openchoreov1alpha1.DeploymentRecord) {
+			if r.FailedAt != nil && r.RestoredAt == nil {
+				r.RestoredAt = &now
+				observeRestore(rb, *r.FailedAt, now)
+			}
+		}
+		restore(current)
+		if n > 1 {
+			restore(&history[n-2])
+		}
+	case deploymentFailureReasons[ready.Reason]:
+		if current.FailedAt == nil {
+			current.FailedAt = &now
+			observeDeploymentFailure(rb)
+		}
+	}
+}
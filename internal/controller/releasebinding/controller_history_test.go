@@ -0,0 +1,115 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+func releaseCreatedAt(name string, created time.Time) *openchoreov1alpha1.ComponentRelease {
+	cr := makeValidComponentRelease(testProjectName, testComponentName)
+	cr.Name = name
+	cr.CreationTimestamp = metav1.NewTime(created)
+	return cr
+}
+
+func TestAppendDeploymentRecord(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("records deployment", func(t *testing.T) {
+		rb := makePromotionBinding()
+		appendDeploymentRecord(rb, releaseCreatedAt("rel-1", base), metav1.NewTime(base.Add(time.Hour)))
+
+		require.Len(t, rb.Status.DeploymentHistory, 1)
+		rec := rb.Status.DeploymentHistory[0]
+		assert.Equal(t, "rel-1", rec.Release)
+		assert.Equal(t, base, rec.ReleaseCreatedAt.UTC())
+		assert.Equal(t, base.Add(time.Hour), rec.DeployedAt.UTC())
+	})
+
+	t.Run("binding an older release marks a rollback", func(t *testing.T) {
+		rb := makePromotionBinding()
+		appendDeploymentRecord(rb, releaseCreatedAt("rel-1", base), metav1.NewTime(base.Add(time.Hour)))
+		appendDeploymentRecord(rb, releaseCreatedAt("rel-2", base.Add(2*time.Hour)), metav1.NewTime(base.Add(3*time.Hour)))
+		appendDeploymentRecord(rb, releaseCreatedAt("rel-1", base), metav1.NewTime(base.Add(4*time.Hour)))
+
+		require.Len(t, rb.Status.DeploymentHistory, 3)
+		assert.False(t, rb.Status.DeploymentHistory[0].RolledBack)
+		rolledBack := rb.Status.DeploymentHistory[1]
+		assert.True(t, rolledBack.RolledBack)
+		require.NotNil(t, rolledBack.FailedAt)
+		assert.Equal(t, base.Add(4*time.Hour), rolledBack.FailedAt.UTC())
+	})
+
+	t.Run("history is bounded", func(t *testing.T) {
+		rb := makePromotionBinding()
+		for i := range MaxDeploymentHistory + 5 {
+			at := base.Add(time.Duration(i) * time.Hour)
+			appendDeploymentRecord(rb, releaseCreatedAt(fmt.Sprintf("rel-%d", i), at), metav1.NewTime(at))
+		}
+		require.Len(t, rb.Status.DeploymentHistory, MaxDeploymentHistory)
+		assert.Equal(t, "rel-5", rb.Status.DeploymentHistory[0].Release)
+	})
+}
+
+func TestUpdateDeploymentHistory(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newBinding := func() *openchoreov1alpha1.ReleaseBinding {
+		rb := makePromotionBinding()
+		appendDeploymentRecord(rb, releaseCreatedAt("rel-1", base), metav1.NewTime(base))
+		return rb
+	}
+
+	t.Run("progressing is not a failure", func(t *testing.T) {
+		rb := newBinding()
+		controller.MarkFalseCondition(rb, ConditionReady, ReasonResourcesProgressing, "progressing")
+		updateDeploymentHistory(rb, metav1.NewTime(base.Add(time.Minute)))
+		assert.Nil(t, rb.Status.DeploymentHistory[0].FailedAt)
+		assert.Nil(t, rb.Status.DeploymentHistory[0].ReadyAt)
+	})
+
+	t.Run("failure then recovery", func(t *testing.T) {
+		rb := newBinding()
+		controller.MarkTrueCondition(rb, ConditionReady, ReasonReady, "ready")
+		updateDeploymentHistory(rb, metav1.NewTime(base.Add(time.Minute)))
+
+		controller.MarkFalseCondition(rb, ConditionReady, ReasonResourcesDegraded, "degraded")
+		updateDeploymentHistory(rb, metav1.NewTime(base.Add(10*time.Minute)))
+		updateDeploymentHistory(rb, metav1.NewTime(base.Add(12*time.Minute)))
+
+		controller.MarkTrueCondition(rb, ConditionReady, ReasonReady, "ready")
+		updateDeploymentHistory(rb, metav1.NewTime(base.Add(30*time.Minute)))
+
+		rec := rb.Status.DeploymentHistory[0]
+		require.NotNil(t, rec.ReadyAt)
+		assert.Equal(t, base.Add(time.Minute), rec.ReadyAt.UTC())
+		require.NotNil(t, rec.FailedAt)
+		assert.Equal(t, base.Add(10*time.Minute), rec.FailedAt.UTC())
+		require.NotNil(t, rec.RestoredAt)
+		assert.Equal(t, base.Add(30*time.Minute), rec.RestoredAt.UTC())
+	})
+
+	t.Run("ready rollback restores the rolled back deployment", func(t *testing.T) {
+		rb := newBinding()
+		appendDeploymentRecord(rb, releaseCreatedAt("rel-2", base.Add(time.Hour)), metav1.NewTime(base.Add(2*time.Hour)))
+		appendDeploymentRecord(rb, releaseCreatedAt("rel-1", base), metav1.NewTime(base.Add(3*time.Hour)))
+
+		controller.MarkTrueCondition(rb, ConditionReady, ReasonReady, "ready")
+		updateDeploymentHistory(rb, metav1.NewTime(base.Add(4*time.Hour)))
+
+		rolledBack := rb.Status.DeploymentHistory[1]
+		require.NotNil(t, rolledBack.RestoredAt)
+		assert.Equal(t, base.Add(4*time.Hour), rolledBack.RestoredAt.UTC())
+	})
+}
@@ -0,0 +1,413 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/renderedrelease"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+const (
+	// hookResourceIDPrefix prefixes the IDs of lifecycle hook Jobs in the data plane release.
+	hookResourceIDPrefix = "lifecycle-hook-"
+
+	// hookJobSuffixLength is the length of the suffix that makes a hook Job name unique per rollout.
+	hookJobSuffixLength = 8
+
+	// maxJobNameLength keeps hook Job names usable as the job-name label of their pods.
+	maxJobNameLength = 63
+)
+
+// hookPlan is the outcome of planning the lifecycle hooks of a rollout step.
+type hookPlan struct {
+	// resources are the resources to write to the data plane release.
+	resources []openchoreov1alpha1.RenderedManifest
+
+	// hooks are the rendered hooks of the rollout, in the order of statuses.
+	hooks []componentpipeline.RenderedHook
+
+	// statuses reports each hook of the rollout.
+	statuses []openchoreov1alpha1.LifecycleHookStatus
+
+	// blocked is set while pre-deploy hooks hold back the workload changes.
+	blocked bool
+}
+
+// planLifecycleHooks decides which resources are written to the data plane release so that
+// the hooks of the component type run around the rollout of workload changes. A rollout is
+// identified by a revision of the rendered workloads. While the pre-deploy hooks of a new
+// revision have not succeeded, the release keeps the previously applied workloads and only
+// adds the new supporting resources and the pre-deploy Jobs. Once they have succeeded the
+// new workloads are applied, and the post-deploy Jobs are added when the workloads are ready.
+// existing is the data plane release as currently stored; it has no UID when it does not
+// exist yet.
+func planLifecycleHooks(existing *openchoreov1alpha1.RenderedRelease, desired []openchoreov1alpha1.RenderedManifest,
+	hooks []componentpipeline.RenderedHook) (*hookPlan, error) {
+	plan := &hookPlan{resources: desired, hooks: hooks}
+	if len(hooks) == 0 {
+		return plan, nil
+	}
+	if existing != nil && existing.UID == "" {
+		existing = nil
+	}
+
+	revision, err := workloadRevision(desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute workload revision: %w", err)
+	}
+	deployedRevision := ""
+	if existing != nil {
+		deployedRevision, err = workloadRevision(existing.Spec.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute deployed workload revision: %w", err)
+		}
+	}
+	rolledOut := existing != nil && revision == deployedRevision
+
+	var preJobs, postJobs []openchoreov1alpha1.RenderedManifest
+	plan.statuses = make([]openchoreov1alpha1.LifecycleHookStatus, len(hooks))
+	preDone := true
+	postStarted := false
+	for i := range hooks {
+		manifest, jobName, err := makeHookManifest(&hooks[i], revision)
+		if err != nil {
+			return nil, err
+		}
+		plan.statuses[i] = hookStatus(existing, &hooks[i], manifest.ID, jobName)
+		switch hooks[i].Phase {
+		case openchoreov1alpha1.LifecycleHookPhasePreDeploy:
+			preJobs = append(preJobs, manifest)
+			if !hookFinished(&hooks[i], &plan.statuses[i]) {
+				preDone = false
+			}
+		case openchoreov1alpha1.LifecycleHookPhasePostDeploy:
+			postJobs = append(postJobs, manifest)
+			if existing != nil && hasResource(existing.Spec.Resources, manifest.ID, jobName) {
+				postStarted = true
+			}
+		}
+	}
+
+	if !rolledOut && len(preJobs) > 0 && !preDone {
+		plan.blocked = true
+		plan.resources = append(heldResources(existing, desired), preJobs...)
+		return plan, nil
+	}
+
+	plan.resources = append(append([]openchoreov1alpha1.RenderedManifest{}, desired...), preJobs...)
+	if postStarted || (rolledOut && workloadsReady(existing)) {
+		plan.resources = append(plan.resources, postJobs...)
+	}
+	return plan, nil
+}
+
+// makeHookManifest names the Job of a hook for the rollout of the given revision and converts
+// it to a release resource. The name suffix also covers the Job itself, because the pod
+// template of an existing Job cannot be changed.
+func makeHookManifest(hook *componentpipeline.RenderedHook, revision string) (openchoreov1alpha1.RenderedManifest, string, error) {
+	job := runtime.DeepCopyJSON(hook.Resource)
+	metadata, _ := job["metadata"].(map[string]any)
+	baseName, _ := metadata["name"].(string)
+
+	content, err := json.Marshal(job)
+	if err != nil {
+		return openchoreov1alpha1.RenderedManifest{}, "", fmt.Errorf("failed to marshal hook %s: %w", hook.Name, err)
+	}
+	sum := sha256.Sum256(append([]byte(revision+"/"), content...))
+	suffix := hex.EncodeToString(sum[:])[:hookJobSuffixLength]
+	if maxBase := maxJobNameLength - len(suffix) - 1; len(baseName) > maxBase {
+		baseName = strings.TrimRight(baseName[:maxBase], "-.")
+	}
+	jobName := baseName + "-" + suffix
+	metadata["name"] = jobName
+
+	raw, err := json.Marshal(job)
+	if err != nil {
+		return openchoreov1alpha1.RenderedManifest{}, "", fmt.Errorf("failed to marshal hook %s: %w", hook.Name, err)
+	}
+	return openchoreov1alpha1.RenderedManifest{
+		ID:     hookResourceIDPrefix + hook.Name,
+		Object: &runtime.RawExtension{Raw: raw},
+	}, jobName, nil
+}
+
+// workloadRevision identifies the rendered workloads of a release. It is empty when the
+// release has no workloads.
+func workloadRevision(resources []openchoreov1alpha1.RenderedManifest) (string, error) {
+	type workload struct {
+		ID     string `json:"id"`
+		Object any    `json:"object"`
+	}
+	var workloads []workload
+	for i := range resources {
+		res := &resources[i]
+		if isHookResource(res.ID) || res.Object == nil {
+			continue
+		}
+		var obj map[string]any
+		if err := json.Unmarshal(res.Object.Raw, &obj); err != nil {
+			return "", fmt.Errorf("failed to unmarshal resource %s: %w", res.ID, err)
+		}
+		if !isWorkloadObject(obj) {
+			continue
+		}
+		workloads = append(workloads, workload{ID: res.ID, Object: obj})
+	}
+	if len(workloads) == 0 {
+		return "", nil
+	}
+	sort.Slice(workloads, func(i, j int) bool { return workloads[i].ID < workloads[j].ID })
+
+	// Objects are compared after decoding so that the encoding of the stored release does not matter.
+	content, err := json.Marshal(workloads)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal workloads: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// heldResources returns the resources to apply while pre-deploy hooks run: the new
+// supporting resources together with the previously applied workloads, and the previously
+// applied resources that the new release removes.
+func heldResources(existing *openchoreov1alpha1.RenderedRelease, desired []openchoreov1alpha1.RenderedManifest) []openchoreov1alpha1.RenderedManifest {
+	previous := map[string]openchoreov1alpha1.RenderedManifest{}
+	if existing != nil {
+		for _, res := range existing.Spec.Resources {
+			if !isHookResource(res.ID) {
+				previous[res.ID] = res
+			}
+		}
+	}
+
+	held := make([]openchoreov1alpha1.RenderedManifest, 0, len(desired))
+	desiredIDs := make(map[string]bool, len(desired))
+	for _, res := range desired {
+		desiredIDs[res.ID] = true
+		if isWorkloadManifest(res) {
+			if prev, ok := previous[res.ID]; ok {
+				held = append(held, prev)
+			}
+			continue
+		}
+		held = append(held, res)
+	}
+	if existing != nil {
+		for _, res := range existing.Spec.Resources {
+			if !isHookResource(res.ID) && !desiredIDs[res.ID] {
+				held = append(held, res)
+			}
+		}
+	}
+	return held
+}
+
+// workloadsReady reports whether the data plane release has applied its current resources
+// and all of its workloads are healthy.
+func workloadsReady(release *openchoreov1alpha1.RenderedRelease) bool {
+	if release == nil {
+		return false
+	}
+	applyCond := meta.FindStatusCondition(release.Status.Conditions, renderedrelease.ConditionResourcesApplied)
+	if applyCond == nil || applyCond.Status != metav1.ConditionTrue || applyCond.ObservedGeneration != release.Generation {
+		return false
+	}
+	for i := range release.Status.Resources {
+		res := &release.Status.Resources[i]
+		if isHookResource(res.ID) || !isWorkloadKind(res.Group, res.Kind) {
+			continue
+		}
+		if res.HealthStatus != openchoreov1alpha1.HealthStatusHealthy &&
+			res.HealthStatus != openchoreov1alpha1.HealthStatusSuspended {
+			return false
+		}
+	}
+	return true
+}
+
+// hookStatus reports the state of a hook Job from the status of the data plane release.
+func hookStatus(release *openchoreov1alpha1.RenderedRelease, hook *componentpipeline.RenderedHook,
+	id, jobName string) openchoreov1alpha1.LifecycleHookStatus {
+	status := openchoreov1alpha1.LifecycleHookStatus{
+		Name:    hook.Name,
+		Phase:   hook.Phase,
+		JobName: jobName,
+		State:   openchoreov1alpha1.LifecycleHookStatePending,
+	}
+	if release == nil {
+		return status
+	}
+	for i := range release.Status.Resources {
+		res := &release.Status.Resources[i]
+		if res.ID != id || res.Name != jobName {
+			continue
+		}
+		switch res.HealthStatus {
+		case openchoreov1alpha1.HealthStatusHealthy:
+			status.State = openchoreov1alpha1.LifecycleHookStateSucceeded
+		case openchoreov1alpha1.HealthStatusDegraded:
+			status.State = openchoreov1alpha1.LifecycleHookStateFailed
+			status.Message = jobFailureMessage(res.Status)
+		default:
+			status.State = openchoreov1alpha1.LifecycleHookStateRunning
+		}
+		return status
+	}
+	return status
+}
+
+// jobFailureMessage returns the reason and message of the Failed condition of a Job status.
+func jobFailureMessage(raw *runtime.RawExtension) string {
+	if raw == nil || len(raw.Raw) == 0 {
+		return "Job failed"
+	}
+	var status batchv1.JobStatus
+	if err := json.Unmarshal(raw.Raw, &status); err != nil {
+		return "Job failed"
+	}
+	for _, cond := range status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Message != "" {
+			return fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
+		}
+	}
+	return "Job failed"
+}
+
+// hookFinished reports whether a hook no longer holds back the rollout: it succeeded, or it
+// failed and its failures are ignored.
+func hookFinished(hook *componentpipeline.RenderedHook, status *openchoreov1alpha1.LifecycleHookStatus) bool {
+	switch status.State {
+	case openchoreov1alpha1.LifecycleHookStateSucceeded:
+		return true
+	case openchoreov1alpha1.LifecycleHookStateFailed:
+		return hook.FailurePolicy == openchoreov1alpha1.LifecycleHookFailurePolicyIgnore
+	default:
+		return false
+	}
+}
+
+// setHooksStatus records the hook statuses of the plan and sets the HooksSucceeded condition.
+// Bindings whose component type has no hooks carry neither.
+func setHooksStatus(rb *openchoreov1alpha1.ReleaseBinding, plan *hookPlan) {
+	if len(plan.hooks) == 0 {
+		rb.Status.Hooks = nil
+		meta.RemoveStatusCondition(&rb.Status.Conditions, string(ConditionHooksSucceeded))
+		return
+	}
+	rb.Status.Hooks = plan.statuses
+
+	var failed, ignored, waiting []string
+	for i := range plan.hooks {
+		status := &plan.statuses[i]
+		switch {
+		case status.State == openchoreov1alpha1.LifecycleHookStateFailed &&
+			plan.hooks[i].FailurePolicy == openchoreov1alpha1.LifecycleHookFailurePolicyIgnore:
+			ignored = append(ignored, status.Name)
+		case status.State == openchoreov1alpha1.LifecycleHookStateFailed:
+			msg := fmt.Sprintf("%s (Job %s)", status.Name, status.JobName)
+			if status.Message != "" {
+				msg += ": " + status.Message
+			}
+			failed = append(failed, msg)
+		case status.State != openchoreov1alpha1.LifecycleHookStateSucceeded:
+			waiting = append(waiting, status.Name)
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		controller.MarkFalseCondition(rb, ConditionHooksSucceeded, ReasonHookFailed,
+			"Lifecycle hooks failed: "+strings.Join(failed, "; "))
+	case plan.blocked:
+		controller.MarkFalseCondition(rb, ConditionHooksSucceeded, ReasonPreDeployHooksRunning,
+			"Waiting for pre-deploy hooks before rolling out workload changes: "+strings.Join(waiting, ", "))
+	case len(waiting) > 0:
+		controller.MarkFalseCondition(rb, ConditionHooksSucceeded, ReasonPostDeployHooksRunning,
+			"Waiting for post-deploy hooks: "+strings.Join(waiting, ", "))
+	case len(ignored) > 0:
+		controller.MarkTrueCondition(rb, ConditionHooksSucceeded, ReasonHooksSucceeded,
+			"Lifecycle hooks completed; ignored failures of: "+strings.Join(ignored, ", "))
+	default:
+		controller.MarkTrueCondition(rb, ConditionHooksSucceeded, ReasonHooksSucceeded,
+			"All lifecycle hooks succeeded")
+	}
+}
+
+// withoutHookResources returns the resource statuses of a release without its hook Jobs, which
+// do not count towards the readiness of the component.
+func withoutHookResources(resources []openchoreov1alpha1.RenderedManifestStatus) []openchoreov1alpha1.RenderedManifestStatus {
+	filtered := make([]openchoreov1alpha1.RenderedManifestStatus, 0, len(resources))
+	for i := range resources {
+		if !isHookResource(resources[i].ID) {
+			filtered = append(filtered, resources[i])
+		}
+	}
+	return filtered
+}
+
+func isHookResource(id string) bool {
+	return strings.HasPrefix(id, hookResourceIDPrefix)
+}
+
+func hasResource(resources []openchoreov1alpha1.RenderedManifest, id, name string) bool {
+	for i := range resources {
+		if resources[i].ID != id || resources[i].Object == nil {
+			continue
+		}
+		var obj struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(resources[i].Object.Raw, &obj); err == nil && obj.Metadata.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isWorkloadManifest(res openchoreov1alpha1.RenderedManifest) bool {
+	if res.Object == nil {
+		return false
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(res.Object.Raw, &obj); err != nil {
+		return false
+	}
+	return isWorkloadObject(obj)
+}
+
+func isWorkloadObject(obj map[string]any) bool {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	group := ""
+	if i := strings.Index(apiVersion, "/"); i >= 0 {
+		group = apiVersion[:i]
+	}
+	return isWorkloadKind(group, kind)
+}
+
+func isWorkloadKind(group, kind string) bool {
+	switch group {
+	case appsAPIGroup:
+		return kind == kindDeployment || kind == kindStatefulSet || kind == kindDaemonSet
+	case batchAPIGroup:
+		return kind == kindJob || kind == kindCronJob
+	}
+	return false
+}
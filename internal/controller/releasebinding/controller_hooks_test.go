@@ -0,0 +1,346 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/renderedrelease"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+func hookTestManifest(t *testing.T, id string, obj map[string]any) openchoreov1alpha1.RenderedManifest {
+	t.Helper()
+	raw, err := json.Marshal(obj)
+	require.NoError(t, err)
+	return openchoreov1alpha1.RenderedManifest{ID: id, Object: &runtime.RawExtension{Raw: raw}}
+}
+
+func hookTestDeployment(t *testing.T, image string) openchoreov1alpha1.RenderedManifest {
+	return hookTestManifest(t, "deployment-app", map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "app"},
+		"spec":       map[string]any{"template": map[string]any{"spec": map[string]any{"containers": []any{map[string]any{"image": image}}}}},
+	})
+}
+
+func hookTestConfigMap(t *testing.T, name string) openchoreov1alpha1.RenderedManifest {
+	return hookTestManifest(t, "configmap-"+name, map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": name},
+	})
+}
+
+func hookTestHook(name string, phase openchoreov1alpha1.LifecycleHookPhase) componentpipeline.RenderedHook {
+	return componentpipeline.RenderedHook{
+		Name:          name,
+		Phase:         phase,
+		FailurePolicy: openchoreov1alpha1.LifecycleHookFailurePolicyFail,
+		Resource: map[string]any{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"metadata":   map[string]any{"name": "app-" + name},
+			"spec":       map[string]any{"activeDeadlineSeconds": int64(600)},
+		},
+	}
+}
+
+// hookTestRelease returns a stored data plane release with the given resources that has
+// applied its current generation.
+func hookTestRelease(resources ...openchoreov1alpha1.RenderedManifest) *openchoreov1alpha1.RenderedRelease {
+	return &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-dev", UID: types.UID("uid"), Generation: 2},
+		Spec:       openchoreov1alpha1.RenderedReleaseSpec{Resources: resources},
+		Status: openchoreov1alpha1.RenderedReleaseStatus{
+			Conditions: []metav1.Condition{{
+				Type:               renderedrelease.ConditionResourcesApplied,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 2,
+			}},
+		},
+	}
+}
+
+func resourceIDs(resources []openchoreov1alpha1.RenderedManifest) []string {
+	ids := make([]string, 0, len(resources))
+	for _, res := range resources {
+		ids = append(ids, res.ID)
+	}
+	return ids
+}
+
+func deployedImage(t *testing.T, resources []openchoreov1alpha1.RenderedManifest) string {
+	t.Helper()
+	for _, res := range resources {
+		if res.ID != "deployment-app" {
+			continue
+		}
+		var obj struct {
+			Spec struct {
+				Template struct {
+					Spec struct {
+						Containers []struct {
+							Image string `json:"image"`
+						} `json:"containers"`
+					} `json:"spec"`
+				} `json:"template"`
+			} `json:"spec"`
+		}
+		require.NoError(t, json.Unmarshal(res.Object.Raw, &obj))
+		return obj.Spec.Template.Spec.Containers[0].Image
+	}
+	return ""
+}
+
+func TestPlanLifecycleHooks_NoHooks(t *testing.T) {
+	desired := []openchoreov1alpha1.RenderedManifest{hookTestDeployment(t, "app:v2")}
+	plan, err := planLifecycleHooks(hookTestRelease(hookTestDeployment(t, "app:v1")), desired, nil)
+	require.NoError(t, err)
+	assert.Equal(t, desired, plan.resources)
+	assert.False(t, plan.blocked)
+	assert.Empty(t, plan.statuses)
+}
+
+func TestPlanLifecycleHooks_FirstDeployWaitsForPreDeployHooks(t *testing.T) {
+	desired := []openchoreov1alpha1.RenderedManifest{hookTestConfigMap(t, "config"), hookTestDeployment(t, "app:v1")}
+	hooks := []componentpipeline.RenderedHook{hookTestHook("migrate", openchoreov1alpha1.LifecycleHookPhasePreDeploy)}
+
+	plan, err := planLifecycleHooks(&openchoreov1alpha1.RenderedRelease{}, desired, hooks)
+	require.NoError(t, err)
+	assert.True(t, plan.blocked)
+	assert.Equal(t, []string{"configmap-config", "lifecycle-hook-migrate"}, resourceIDs(plan.resources))
+	require.Len(t, plan.statuses, 1)
+	assert.Equal(t, openchoreov1alpha1.LifecycleHookStatePending, plan.statuses[0].State)
+	assert.Regexp(t, `^app-migrate-[0-9a-f]{8}$`, plan.statuses[0].JobName)
+}
+
+func TestPlanLifecycleHooks_UpgradeHoldsWorkloadsUntilPreDeployHooksSucceed(t *testing.T) {
+	hooks := []componentpipeline.RenderedHook{
+		hookTestHook("migrate", openchoreov1alpha1.LifecycleHookPhasePreDeploy),
+		hookTestHook("warmup", openchoreov1alpha1.LifecycleHookPhasePostDeploy),
+	}
+	existing := hookTestRelease(hookTestConfigMap(t, "old"), hookTestDeployment(t, "app:v1"))
+	desired := []openchoreov1alpha1.RenderedManifest{hookTestConfigMap(t, "new"), hookTestDeployment(t, "app:v2")}
+
+	plan, err := planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	require.True(t, plan.blocked)
+	assert.Equal(t, []string{"configmap-new", "deployment-app", "configmap-old", "lifecycle-hook-migrate"}, resourceIDs(plan.resources))
+	assert.Equal(t, "app:v1", deployedImage(t, plan.resources))
+
+	// The pre-deploy Job runs.
+	jobName := plan.statuses[0].JobName
+	existing.Spec.Resources = plan.resources
+	existing.Status.Resources = []openchoreov1alpha1.RenderedManifestStatus{
+		{ID: "lifecycle-hook-migrate", Group: "batch", Version: "v1", Kind: "Job", Name: jobName,
+			HealthStatus: openchoreov1alpha1.HealthStatusProgressing},
+	}
+	plan, err = planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	assert.True(t, plan.blocked)
+	assert.Equal(t, openchoreov1alpha1.LifecycleHookStateRunning, plan.statuses[0].State)
+	assert.Equal(t, jobName, plan.statuses[0].JobName, "the job name is stable within a rollout")
+
+	// Once it succeeded the new workload is applied, but the post-deploy hook waits for it.
+	existing.Status.Resources[0].HealthStatus = openchoreov1alpha1.HealthStatusHealthy
+	plan, err = planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	assert.False(t, plan.blocked)
+	assert.Equal(t, []string{"configmap-new", "deployment-app", "lifecycle-hook-migrate"}, resourceIDs(plan.resources))
+	assert.Equal(t, "app:v2", deployedImage(t, plan.resources))
+	assert.Equal(t, openchoreov1alpha1.LifecycleHookStateSucceeded, plan.statuses[0].State)
+	assert.Equal(t, openchoreov1alpha1.LifecycleHookStatePending, plan.statuses[1].State)
+
+	// When the rolled out workload is ready, the post-deploy hook is added.
+	existing.Spec.Resources = plan.resources
+	existing.Status.Resources = append(existing.Status.Resources, openchoreov1alpha1.RenderedManifestStatus{
+		ID: "deployment-app", Group: "apps", Version: "v1", Kind: "Deployment", Name: "app",
+		HealthStatus: openchoreov1alpha1.HealthStatusProgressing,
+	})
+	plan, err = planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	assert.NotContains(t, resourceIDs(plan.resources), "lifecycle-hook-warmup")
+
+	existing.Status.Resources[1].HealthStatus = openchoreov1alpha1.HealthStatusHealthy
+	plan, err = planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"configmap-new", "deployment-app", "lifecycle-hook-migrate", "lifecycle-hook-warmup"}, resourceIDs(plan.resources))
+
+	// The post-deploy hook stays once started, even if the workload becomes unhealthy.
+	existing.Spec.Resources = plan.resources
+	existing.Status.Resources[1].HealthStatus = openchoreov1alpha1.HealthStatusDegraded
+	plan, err = planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	assert.Contains(t, resourceIDs(plan.resources), "lifecycle-hook-warmup")
+}
+
+func TestPlanLifecycleHooks_FailedPreDeployHook(t *testing.T) {
+	hooks := []componentpipeline.RenderedHook{hookTestHook("migrate", openchoreov1alpha1.LifecycleHookPhasePreDeploy)}
+	existing := hookTestRelease(hookTestDeployment(t, "app:v1"))
+	desired := []openchoreov1alpha1.RenderedManifest{hookTestDeployment(t, "app:v2")}
+
+	plan, err := planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	jobStatus, err := json.Marshal(map[string]any{"conditions": []any{map[string]any{
+		"type": "Failed", "status": "True", "reason": "DeadlineExceeded", "message": "Job was active longer than specified deadline",
+	}}})
+	require.NoError(t, err)
+	existing.Spec.Resources = plan.resources
+	existing.Status.Resources = []openchoreov1alpha1.RenderedManifestStatus{
+		{ID: "lifecycle-hook-migrate", Group: "batch", Version: "v1", Kind: "Job", Name: plan.statuses[0].JobName,
+			HealthStatus: openchoreov1alpha1.HealthStatusDegraded, Status: &runtime.RawExtension{Raw: jobStatus}},
+	}
+
+	plan, err = planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	assert.True(t, plan.blocked, "a failed hook blocks the rollout")
+	assert.Equal(t, "app:v1", deployedImage(t, plan.resources))
+	assert.Equal(t, openchoreov1alpha1.LifecycleHookStateFailed, plan.statuses[0].State)
+	assert.Equal(t, "DeadlineExceeded: Job was active longer than specified deadline", plan.statuses[0].Message)
+
+	hooks[0].FailurePolicy = openchoreov1alpha1.LifecycleHookFailurePolicyIgnore
+	plan, err = planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	assert.False(t, plan.blocked, "ignored failures do not block the rollout")
+	assert.Equal(t, "app:v2", deployedImage(t, plan.resources))
+}
+
+func TestPlanLifecycleHooks_RolledOutRevisionDoesNotRerunPreDeployHooks(t *testing.T) {
+	hooks := []componentpipeline.RenderedHook{hookTestHook("migrate", openchoreov1alpha1.LifecycleHookPhasePreDeploy)}
+	desired := []openchoreov1alpha1.RenderedManifest{hookTestConfigMap(t, "config"), hookTestDeployment(t, "app:v1")}
+
+	// A change of a supporting resource alone is no new rollout.
+	existing := hookTestRelease(hookTestConfigMap(t, "other"), hookTestDeployment(t, "app:v1"))
+	plan, err := planLifecycleHooks(existing, desired, hooks)
+	require.NoError(t, err)
+	assert.False(t, plan.blocked)
+	assert.Equal(t, "app:v1", deployedImage(t, plan.resources))
+}
+
+func TestMakeHookManifest_TruncatesLongNames(t *testing.T) {
+	hook := hookTestHook("migrate", openchoreov1alpha1.LifecycleHookPhasePreDeploy)
+	hook.Resource["metadata"] = map[string]any{"name": "a-very-long-component-name-in-a-very-long-project-name-dev-migrate"}
+
+	manifest, jobName, err := makeHookManifest(&hook, "rev")
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(jobName), maxJobNameLength)
+	assert.Equal(t, "lifecycle-hook-migrate", manifest.ID)
+	assert.Equal(t, "a-very-long-component-name-in-a-very-long-project-name-dev-migrate",
+		hook.Resource["metadata"].(map[string]any)["name"], "the rendered hook is not modified")
+
+	_, otherName, err := makeHookManifest(&hook, "other-rev")
+	require.NoError(t, err)
+	assert.NotEqual(t, jobName, otherName, "each rollout runs a new Job")
+}
+
+func TestSetHooksStatus(t *testing.T) {
+	pre := hookTestHook("migrate", openchoreov1alpha1.LifecycleHookPhasePreDeploy)
+	post := hookTestHook("warmup", openchoreov1alpha1.LifecycleHookPhasePostDeploy)
+	status := func(name string, state openchoreov1alpha1.LifecycleHookState) openchoreov1alpha1.LifecycleHookStatus {
+		return openchoreov1alpha1.LifecycleHookStatus{Name: name, JobName: "app-" + name + "-1", State: state}
+	}
+
+	tests := []struct {
+		name       string
+		plan       *hookPlan
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name: "pre-deploy hooks running",
+			plan: &hookPlan{
+				hooks:    []componentpipeline.RenderedHook{pre, post},
+				statuses: []openchoreov1alpha1.LifecycleHookStatus{status("migrate", openchoreov1alpha1.LifecycleHookStateRunning), status("warmup", openchoreov1alpha1.LifecycleHookStatePending)},
+				blocked:  true,
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonPreDeployHooksRunning),
+		},
+		{
+			name: "post-deploy hooks pending",
+			plan: &hookPlan{
+				hooks:    []componentpipeline.RenderedHook{pre, post},
+				statuses: []openchoreov1alpha1.LifecycleHookStatus{status("migrate", openchoreov1alpha1.LifecycleHookStateSucceeded), status("warmup", openchoreov1alpha1.LifecycleHookStatePending)},
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonPostDeployHooksRunning),
+		},
+		{
+			name: "failed hook",
+			plan: &hookPlan{
+				hooks:    []componentpipeline.RenderedHook{pre},
+				statuses: []openchoreov1alpha1.LifecycleHookStatus{status("migrate", openchoreov1alpha1.LifecycleHookStateFailed)},
+				blocked:  true,
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonHookFailed),
+		},
+		{
+			name: "all succeeded",
+			plan: &hookPlan{
+				hooks:    []componentpipeline.RenderedHook{pre, post},
+				statuses: []openchoreov1alpha1.LifecycleHookStatus{status("migrate", openchoreov1alpha1.LifecycleHookStateSucceeded), status("warmup", openchoreov1alpha1.LifecycleHookStateSucceeded)},
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: string(ReasonHooksSucceeded),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rb := makeReleaseBindingForConditions()
+			setHooksStatus(rb, tt.plan)
+			cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionHooksSucceeded))
+			require.NotNil(t, cond)
+			assert.Equal(t, tt.wantStatus, cond.Status)
+			assert.Equal(t, tt.wantReason, cond.Reason)
+			assert.Equal(t, tt.plan.statuses, rb.Status.Hooks)
+		})
+	}
+
+	t.Run("no hooks removes the condition", func(t *testing.T) {
+		rb := makeReleaseBindingForConditions()
+		setHooksStatus(rb, tests[0].plan)
+		setHooksStatus(rb, &hookPlan{})
+		assert.Nil(t, meta.FindStatusCondition(rb.Status.Conditions, string(ConditionHooksSucceeded)))
+		assert.Nil(t, rb.Status.Hooks)
+	})
+}
+
+func TestSetReadyCondition_HooksNotSucceeded(t *testing.T) {
+	r := newTestReconciler()
+	rb := makeReleaseBindingForConditions()
+
+	setConditionOnRB(rb, string(ConditionReleaseSynced), metav1.ConditionTrue, string(ReasonReleaseSynced), "synced")
+	setConditionOnRB(rb, string(ConditionResourcesReady), metav1.ConditionTrue, string(ReasonReady), "ready")
+	setConditionOnRB(rb, string(ConditionHooksSucceeded), metav1.ConditionFalse, string(ReasonPreDeployHooksRunning), "waiting")
+
+	r.setReadyCondition(rb)
+
+	cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonPreDeployHooksRunning), cond.Reason)
+}
+
+func TestWithoutHookResources(t *testing.T) {
+	resources := []openchoreov1alpha1.RenderedManifestStatus{
+		{ID: "job-app", Group: "batch", Kind: "Job", Name: "app"},
+		{ID: "lifecycle-hook-migrate", Group: "batch", Kind: "Job", Name: "app-migrate-1234abcd"},
+	}
+	filtered := withoutHookResources(resources)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "job-app", filtered[0].ID)
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/imagepullsecret"
+)
+
+// resolveRegistryCredentials fetches the SecretReference of every registry credential
+// configured on the data plane. SecretReferences are looked up in the ReleaseBinding's
+// namespace, like the ones referenced by the workload.
+func (r *Reconciler) resolveRegistryCredentials(ctx context.Context, dataPlane *openchoreov1alpha1.DataPlane,
+	namespace string) ([]imagepullsecret.Credential, error) {
+	creds := make([]imagepullsecret.Credential, 0, len(dataPlane.Spec.RegistryCredentials))
+	for _, rc := range dataPlane.Spec.RegistryCredentials {
+		secretRef := &openchoreov1alpha1.SecretReference{}
+		if err := r.Get(ctx, client.ObjectKey{Name: rc.SecretReferenceName, Namespace: namespace}, secretRef); err != nil {
+			return nil, fmt.Errorf("failed to get SecretReference %q for registry credential %q: %w",
+				rc.SecretReferenceName, rc.Name, err)
+		}
+		creds = append(creds, imagepullsecret.Credential{Name: rc.Name, SecretReference: secretRef})
+	}
+	return creds, nil
+}
+
+// registryCredentialSecretReferenceNames returns the SecretReference names used by the data
+// plane's registry credentials, so that changes to them re-render dependent bindings.
+func registryCredentialSecretReferenceNames(dataPlane *openchoreov1alpha1.DataPlane) []string {
+	if dataPlane == nil {
+		return nil
+	}
+	names := make([]string, 0, len(dataPlane.Spec.RegistryCredentials))
+	for _, rc := range dataPlane.Spec.RegistryCredentials {
+		names = append(names, rc.SecretReferenceName)
+	}
+	return names
+}
+
+// appendUnique appends the names not already present in dst.
+func appendUnique(dst []string, names ...string) []string {
+	seen := make(map[string]struct{}, len(dst))
+	for _, n := range dst {
+		seen[n] = struct{}{}
+	}
+	for _, n := range names {
+		if _, dup := seen[n]; dup {
+			continue
+		}
+		seen[n] = struct{}{}
+		dst = append(dst, n)
+	}
+	return dst
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func dataPlaneWithRegistryCredentials(creds ...openchoreov1alpha1.RegistryCredential) *openchoreov1alpha1.DataPlane {
+	return &openchoreov1alpha1.DataPlane{
+		Spec: openchoreov1alpha1.DataPlaneSpec{
+			SecretStoreRef:      &openchoreov1alpha1.SecretStoreRef{Name: "default"},
+			RegistryCredentials: creds,
+		},
+	}
+}
+
+func TestResolveRegistryCredentials(t *testing.T) {
+	dp := dataPlaneWithRegistryCredentials(
+		openchoreov1alpha1.RegistryCredential{Name: "ghcr", SecretReferenceName: "ghcr-pull"},
+	)
+
+	t.Run("resolves SecretReferences in the binding namespace", func(t *testing.T) {
+		r := newSecretReferenceTestReconciler(t, makeSecretReference("ghcr-pull", ".dockerconfigjson"))
+		creds, err := r.resolveRegistryCredentials(context.Background(), dp, testNamespace)
+		require.NoError(t, err)
+		require.Len(t, creds, 1)
+		assert.Equal(t, "ghcr", creds[0].Name)
+		assert.Equal(t, "ghcr-pull", creds[0].SecretReference.Name)
+	})
+
+	t.Run("missing SecretReference", func(t *testing.T) {
+		r := newSecretReferenceTestReconciler(t)
+		_, err := r.resolveRegistryCredentials(context.Background(), dp, testNamespace)
+		require.ErrorContains(t, err, `failed to get SecretReference "ghcr-pull" for registry credential "ghcr"`)
+	})
+}
+
+func TestRegistryCredentialSecretReferenceNames(t *testing.T) {
+	dp := dataPlaneWithRegistryCredentials(
+		openchoreov1alpha1.RegistryCredential{Name: "ghcr", SecretReferenceName: "shared"},
+		openchoreov1alpha1.RegistryCredential{Name: "ecr", SecretReferenceName: "ecr-pull"},
+	)
+	names := appendUnique([]string{"db-creds", "shared"}, registryCredentialSecretReferenceNames(dp)...)
+	assert.Equal(t, []string{"db-creds", "shared", "ecr-pull"}, names)
+	assert.Nil(t, registryCredentialSecretReferenceNames(nil))
+}
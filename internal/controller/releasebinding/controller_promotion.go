@@ -0,0 +1,176 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/changelog"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+const (
+	// EventReasonPromoted is the event reason emitted when a ReleaseBinding has deployed a
+	// different ComponentRelease.
+	EventReasonPromoted = "Promoted"
+	// EventReasonPromotionNotificationFailed is the event reason emitted when the promotion
+	// could not be sent to the notification channel of the environment.
+	EventReasonPromotionNotificationFailed = "PromotionNotificationFailed"
+
+	// promotionNotificationPath is the promotion notification endpoint of the observer internal API.
+	promotionNotificationPath = "/api/v1alpha1/notifications/promotions"
+)
+
+// promotionNotification is the request body of the observer promotion notification endpoint.
+type promotionNotification struct {
+	Namespace            string   `json:"namespace"`
+	Project              string   `json:"project"`
+	Component            string   `json:"component"`
+	Environment          string   `json:"environment"`
+	ReleaseBinding       string   `json:"releaseBinding,omitempty"`
+	FromRelease          string   `json:"fromRelease,omitempty"`
+	ToRelease            string   `json:"toRelease"`
+	FromImage            string   `json:"fromImage,omitempty"`
+	ToImage              string   `json:"toImage,omitempty"`
+	FromCommit           string   `json:"fromCommit,omitempty"`
+	ToCommit             string   `json:"toCommit,omitempty"`
+	Summary              string   `json:"summary"`
+	NotificationChannels []string `json:"notificationChannels"`
+}
+
+// recordDeployment appends a status.deploymentHistory record when the bound ComponentRelease
+// is not the one of the latest record. It runs before rendering so that rollbacks and the
+// delivery metrics see every attempted deployment, including failed ones.
+func recordDeployment(rb *openchoreov1alpha1.ReleaseBinding, to *openchoreov1alpha1.ComponentRelease) {
+	if n := len(rb.Status.DeploymentHistory); n > 0 && rb.Status.DeploymentHistory[n-1].Release == to.Name {
+		return
+	}
+	appendDeploymentRecord(rb, to, metav1.Now())
+}
+
+// recordPromotion updates status.promotion once the bound ComponentRelease, if it changed
+// since the last recorded promotion, has been deployed successfully. It emits a Promoted
+// event carrying the changelog summary and sends the promotion to the notification channel
+// of the environment, if any. The previously deployed release is taken from the existing
+// status record. Notification failures are reported as events and do not fail the reconcile.
+func (r *Reconciler) recordPromotion(ctx context.Context, rb *openchoreov1alpha1.ReleaseBinding,
+	to *openchoreov1alpha1.ComponentRelease, notificationChannel string) error {
+	logger := log.FromContext(ctx)
+
+	previous := rb.Status.Promotion
+	if previous != nil && previous.ToRelease == to.Name {
+		return nil
+	}
+
+	var from *openchoreov1alpha1.ComponentRelease
+	fromName := ""
+	if previous != nil {
+		fromName = previous.ToRelease
+		from = &openchoreov1alpha1.ComponentRelease{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: rb.Namespace, Name: fromName}, from); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get previous ComponentRelease %q: %w", fromName, err)
+			}
+			// The previous release was garbage collected; record the promotion without a diff.
+			logger.Info("Previous ComponentRelease not found, recording promotion without diff",
+				"componentRelease", fromName)
+			from = nil
+		}
+	}
+
+	cl := changelog.Generate(from, to)
+	rb.Status.Promotion = &openchoreov1alpha1.ReleaseBindingPromotion{
+		FromRelease: fromName,
+		ToRelease:   to.Name,
+		PromotedAt:  metav1.Now(),
+		Changelog:   cl,
+	}
+
+	summary := changelog.Summary(cl)
+	msg := fmt.Sprintf("Deployed ComponentRelease %q to environment %q: %s",
+		to.Name, rb.Spec.Environment, summary)
+	if fromName != "" {
+		msg = fmt.Sprintf("Promoted from ComponentRelease %q to %q in environment %q: %s",
+			fromName, to.Name, rb.Spec.Environment, summary)
+	}
+	logger.Info(msg)
+	if r.Recorder != nil {
+		r.Recorder.Event(rb, corev1.EventTypeNormal, EventReasonPromoted, msg)
+	}
+
+	if notificationChannel == "" {
+		return nil
+	}
+	if err := r.notifyPromotion(ctx, &promotionNotification{
+		Namespace:            rb.Namespace,
+		Project:              rb.Spec.Owner.ProjectName,
+		Component:            rb.Spec.Owner.ComponentName,
+		Environment:          rb.Spec.Environment,
+		ReleaseBinding:       rb.Name,
+		FromRelease:          fromName,
+		ToRelease:            to.Name,
+		FromImage:            cl.FromImage,
+		ToImage:              cl.ToImage,
+		FromCommit:           cl.FromCommit,
+		ToCommit:             cl.ToCommit,
+		Summary:              summary,
+		NotificationChannels: []string{notificationChannel},
+	}); err != nil {
+		logger.Error(err, "Failed to send promotion notification", "notificationChannel", notificationChannel)
+		if r.Recorder != nil {
+			r.Recorder.Eventf(rb, corev1.EventTypeWarning, EventReasonPromotionNotificationFailed,
+				"Failed to notify channel %q of the promotion to ComponentRelease %q: %v", notificationChannel, to.Name, err)
+		}
+	}
+	return nil
+}
+
+// notifyPromotion sends the promotion to the observer internal API, which delivers it to the
+// notification channels through the alert notification pipeline.
+func (r *Reconciler) notifyPromotion(ctx context.Context, payload *promotionNotification) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, controller.ObserverAPITimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodPost,
+		controller.ObserverInternalBaseURL(r.ObserverBaseURL)+promotionNotificationPath, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpClient := r.httpClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: controller.ObserverAPITimeout}
+	}
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("promotion notification request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		var errBody struct {
+			Message string `json:"message,omitempty"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errBody)
+		return fmt.Errorf("observer promotion notification API returned status %d: %s", resp.StatusCode, errBody.Message)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func newPromotionTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, *record.FakeRecorder) {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	recorder := record.NewFakeRecorder(10)
+	return &Reconciler{
+		Client:   fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(),
+		Scheme:   s,
+		Recorder: recorder,
+	}, recorder
+}
+
+func makeReleaseWithImage(name, image string) *openchoreov1alpha1.ComponentRelease {
+	cr := makeValidComponentRelease(testProjectName, testComponentName)
+	cr.Name = name
+	cr.Spec.Workload.Container.Image = image
+	return cr
+}
+
+func makePromotionBinding() *openchoreov1alpha1.ReleaseBinding {
+	rb := makeValidReleaseBinding(testProjectName, testComponentName)
+	rb.Namespace = "default"
+	rb.Spec.Environment = testEnvStaging
+	return rb
+}
+
+func TestRecordPromotion_InitialDeployment(t *testing.T) {
+	to := makeReleaseWithImage("rel-1", "registry/app:v1")
+	r, recorder := newPromotionTestReconciler(t, to)
+	rb := makePromotionBinding()
+
+	require.NoError(t, r.recordPromotion(context.Background(), rb, to, ""))
+
+	require.NotNil(t, rb.Status.Promotion)
+	assert.Empty(t, rb.Status.Promotion.FromRelease)
+	assert.Equal(t, "rel-1", rb.Status.Promotion.ToRelease)
+	assert.Equal(t, "registry/app:v1", rb.Status.Promotion.Changelog.ToImage)
+	event := <-recorder.Events
+	assert.Contains(t, event, EventReasonPromoted)
+}
+
+func TestRecordPromotion_NewRelease(t *testing.T) {
+	from := makeReleaseWithImage("rel-1", "registry/app:v1")
+	to := makeReleaseWithImage("rel-2", "registry/app:v2")
+	r, recorder := newPromotionTestReconciler(t, from, to)
+	rb := makePromotionBinding()
+	rb.Status.Promotion = &openchoreov1alpha1.ReleaseBindingPromotion{ToRelease: "rel-1", PromotedAt: metav1.Now()}
+
+	require.NoError(t, r.recordPromotion(context.Background(), rb, to, ""))
+
+	require.NotNil(t, rb.Status.Promotion)
+	assert.Equal(t, "rel-1", rb.Status.Promotion.FromRelease)
+	assert.Equal(t, "rel-2", rb.Status.Promotion.ToRelease)
+	assert.Equal(t, "registry/app:v1", rb.Status.Promotion.Changelog.FromImage)
+	assert.Equal(t, "registry/app:v2", rb.Status.Promotion.Changelog.ToImage)
+	event := <-recorder.Events
+	assert.True(t, strings.Contains(event, "registry/app:v1 -> registry/app:v2"), event)
+}
+
+func TestRecordPromotion_SameReleaseIsNoop(t *testing.T) {
+	to := makeReleaseWithImage("rel-1", "registry/app:v1")
+	r, recorder := newPromotionTestReconciler(t, to)
+	rb := makePromotionBinding()
+	promotedAt := metav1.Now()
+	rb.Status.Promotion = &openchoreov1alpha1.ReleaseBindingPromotion{ToRelease: "rel-1", PromotedAt: promotedAt}
+
+	require.NoError(t, r.recordPromotion(context.Background(), rb, to, ""))
+
+	assert.Equal(t, promotedAt, rb.Status.Promotion.PromotedAt)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestRecordPromotion_PreviousReleaseDeleted(t *testing.T) {
+	to := makeReleaseWithImage("rel-2", "registry/app:v2")
+	r, _ := newPromotionTestReconciler(t, to)
+	rb := makePromotionBinding()
+	rb.Status.Promotion = &openchoreov1alpha1.ReleaseBindingPromotion{ToRelease: "rel-1", PromotedAt: metav1.Now()}
+
+	require.NoError(t, r.recordPromotion(context.Background(), rb, to, ""))
+
+	assert.Equal(t, "rel-1", rb.Status.Promotion.FromRelease)
+	assert.Empty(t, rb.Status.Promotion.Changelog.FromImage)
+	assert.Empty(t, rb.Status.Promotion.Changelog.Changes)
+}
+
+func TestRecordPromotion_NotifiesChannel(t *testing.T) {
+	from := makeReleaseWithImage("rel-1", "registry/app:v1")
+	from.Annotations = map[string]string{labels.AnnotationKeySourceCommit: "abc1234def"}
+	to := makeReleaseWithImage("rel-2", "registry/app:v2")
+	to.Annotations = map[string]string{labels.AnnotationKeySourceCommit: "def5678abc"}
+
+	var got promotionNotification
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, promotionNotificationPath, req.URL.Path)
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&got))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	r, recorder := newPromotionTestReconciler(t, from, to)
+	r.ObserverBaseURL = srv.URL
+	rb := makePromotionBinding()
+	rb.Status.Promotion = &openchoreov1alpha1.ReleaseBindingPromotion{ToRelease: "rel-1", PromotedAt: metav1.Now()}
+
+	require.NoError(t, r.recordPromotion(context.Background(), rb, to, "releases"))
+
+	assert.Equal(t, []string{"releases"}, got.NotificationChannels)
+	assert.Equal(t, testEnvStaging, got.Environment)
+	assert.Equal(t, "rel-1", got.FromRelease)
+	assert.Equal(t, "rel-2", got.ToRelease)
+	assert.Equal(t, "abc1234def", got.FromCommit)
+	assert.Equal(t, "def5678abc", got.ToCommit)
+	assert.Contains(t, got.Summary, "commits abc1234..def5678")
+	assert.Contains(t, <-recorder.Events, EventReasonPromoted)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestRecordPromotion_NotificationFailureIsNotFatal(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"channel not found"}`))
+	}))
+	defer srv.Close()
+
+	to := makeReleaseWithImage("rel-1", "registry/app:v1")
+	r, recorder := newPromotionTestReconciler(t, to)
+	r.ObserverBaseURL = srv.URL
+	rb := makePromotionBinding()
+
+	require.NoError(t, r.recordPromotion(context.Background(), rb, to, "releases"))
+
+	require.NotNil(t, rb.Status.Promotion)
+	assert.Equal(t, "rel-1", rb.Status.Promotion.ToRelease)
+	assert.Contains(t, <-recorder.Events, EventReasonPromoted)
+	event := <-recorder.Events
+	assert.Contains(t, event, EventReasonPromotionNotificationFailed)
+	assert.Contains(t, event, "channel not found")
+}
+
+func TestRecordDeployment(t *testing.T) {
+	rel1 := makeReleaseWithImage("rel-1", "registry/app:v1")
+	rel2 := makeReleaseWithImage("rel-2", "registry/app:v2")
+	rb := makePromotionBinding()
+
+	recordDeployment(rb, rel1)
+	recordDeployment(rb, rel1)
+	require.Len(t, rb.Status.DeploymentHistory, 1)
+	assert.Equal(t, "rel-1", rb.Status.DeploymentHistory[0].Release)
+
+	recordDeployment(rb, rel2)
+	require.Len(t, rb.Status.DeploymentHistory, 2)
+	assert.Equal(t, "rel-2", rb.Status.DeploymentHistory[1].Release)
+	assert.Nil(t, rb.Status.Promotion, "promotions are only recorded after a successful deploy")
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// EventReasonRolledBack is the event reason emitted when a release that failed its
+// post-deploy health check or stayed degraded is rolled back.
+const EventReasonRolledBack = "RolledBack"
+
+// EventReasonRollbackBlocked is the event reason emitted when the rollback of a degraded
+// release is held back by an active deployment lock.
+const EventReasonRollbackBlocked = "RollbackBlocked"
+
+// defaultHealthCheckTimeout is used when an enabled AutoRollbackPolicy sets no timeout.
+const defaultHealthCheckTimeout = 10 * time.Minute
+
+// degradedReasons are the Ready condition reasons that mark the resources as degraded. The
+// diagnosed workload failures are reported instead of ResourcesDegraded.
+var degradedReasons = map[string]bool{
+	string(ReasonResourcesDegraded): true,
+	string(ReasonImagePullFailed):   true,
+	string(ReasonCrashLoopBackOff):  true,
+	string(ReasonOOMKilled):         true,
+}
+
+// effectiveReleaseName returns the ComponentRelease to deploy: the rollback target while
+// spec.releaseName is the release that was rolled back, spec.releaseName otherwise.
+func effectiveReleaseName(rb *openchoreov1alpha1.ReleaseBinding) string {
+	if rb.Status.Rollback != nil && rb.Status.Rollback.FailedRelease == rb.Spec.ReleaseName {
+		return rb.Status.Rollback.RolledBackTo
+	}
+	return rb.Spec.ReleaseName
+}
+
+// reconcileAutoRollback verifies the health of the currently deployed release and rolls it
+// back when the auto-rollback policy applies. A release that fails its health check is
+// rolled back in status.rollback; spec.releaseName is left untouched so that a fixed
+// release can be bound by changing it. A release that passed its health check and then
+// stayed degraded for longer than the policy allows is rolled back by re-pointing
+// spec.releaseName, unless the binding is locked. Rollbacks are recorded in the RolledBack
+// condition and an event. Returns the time after which the health of the release must be
+// evaluated again, or zero.
+func (r *Reconciler) reconcileAutoRollback(ctx context.Context, rb *openchoreov1alpha1.ReleaseBinding) (time.Duration, error) {
+	if rb.Status.Rollback != nil && rb.Status.Rollback.FailedRelease != rb.Spec.ReleaseName {
+		rb.Status.Rollback = nil
+		meta.RemoveStatusCondition(&rb.Status.Conditions, string(ConditionRolledBack))
+	}
+	wasBlocked := false
+	if cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionRolledBack)); cond != nil {
+		switch {
+		case cond.Reason == string(ReasonRollbackBlocked):
+			// Set again below while the rollback is still blocked.
+			wasBlocked = true
+			meta.RemoveStatusCondition(&rb.Status.Conditions, string(ConditionRolledBack))
+		case cond.Reason == string(ReasonDegradedTimeoutExceeded) && cond.ObservedGeneration != rb.Generation:
+			meta.RemoveStatusCondition(&rb.Status.Conditions, string(ConditionRolledBack))
+		}
+	}
+	if rb.Status.Rollback != nil {
+		return 0, nil
+	}
+
+	policy := rb.Spec.AutoRollback
+	if policy == nil {
+		env := &openchoreov1alpha1.Environment{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: rb.Namespace, Name: rb.Spec.Environment}, env); err != nil {
+			if apierrors.IsNotFound(err) {
+				// Reported by the main reconcile path.
+				return 0, nil
+			}
+			return 0, fmt.Errorf("failed to get Environment %q: %w", rb.Spec.Environment, err)
+		}
+		policy = env.Spec.AutoRollback
+	}
+
+	now := metav1.Now()
+	rollback, recheckAfter := rollbackDecision(rb, policy, now)
+	if rollback == nil {
+		if recheckAfter > 0 {
+			return recheckAfter, nil
+		}
+		return r.rollbackDegraded(ctx, rb, policy, now, wasBlocked)
+	}
+
+	rb.Status.Rollback = rollback
+	msg := fmt.Sprintf("Rolled back ComponentRelease %q to %q: %s",
+		rollback.FailedRelease, rollback.RolledBackTo, rollback.Reason)
+	controller.MarkTrueCondition(rb, ConditionRolledBack, ReasonHealthCheckFailed, msg)
+	log.FromContext(ctx).Info(msg)
+	if r.Recorder != nil {
+		r.Recorder.Event(rb, corev1.EventTypeWarning, EventReasonRolledBack, msg)
+	}
+	return 0, nil
+}
+
+// rollbackDecision decides whether the current deployment failed its health check. Only
+// a deployment of spec.releaseName that has not been Ready yet is checked: it fails when
+// it failed outright or did not become Ready within the health check timeout. It is
+// rolled back to the most recent other release that became Ready. When the deployment is
+// still within its timeout, the time until the timeout expires is returned instead.
+func rollbackDecision(rb *openchoreov1alpha1.ReleaseBinding, policy *openchoreov1alpha1.AutoRollbackPolicy,
+	now metav1.Time) (*openchoreov1alpha1.ReleaseBindingRollback, time.Duration) {
+	if policy == nil || !policy.Enabled {
+		return nil, 0
+	}
+	history := rb.Status.DeploymentHistory
+	n := len(history)
+	if n == 0 {
+		return nil, 0
+	}
+	current := history[n-1]
+	if current.Release != rb.Spec.ReleaseName || current.ReadyAt != nil {
+		return nil, 0
+	}
+
+	target := ""
+	for i := n - 2; i >= 0; i-- {
+		if history[i].ReadyAt != nil && history[i].Release != current.Release {
+			target = history[i].Release
+			break
+		}
+	}
+	if target == "" {
+		return nil, 0
+	}
+
+	timeout := defaultHealthCheckTimeout
+	if policy.HealthCheckTimeout != nil {
+		timeout = policy.HealthCheckTimeout.Duration
+	}
+
+	var reason string
+	switch {
+	case current.FailedAt != nil:
+		reason = "deployment failed"
+		if ready := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionReady)); ready != nil &&
+			ready.Status == metav1.ConditionFalse && ready.Message != "" {
+			reason = fmt.Sprintf("deployment failed: %s", ready.Message)
+		}
+	case now.Sub(current.DeployedAt.Time) >= timeout:
+		reason = fmt.Sprintf("not Ready within the health check timeout of %s", timeout)
+	default:
+		return nil, current.DeployedAt.Add(timeout).Sub(now.Time)
+	}
+
+	return &openchoreov1alpha1.ReleaseBindingRollback{
+		FailedRelease: current.Release,
+		RolledBackTo:  target,
+		Reason:        reason,
+		RolledBackAt:  now,
+	}, 0
+}
+
+// rollbackDegraded re-points spec.releaseName to the previously healthy release when the
+// resources stayed degraded for longer than the policy allows. The rollback is recorded in
+// the RolledBack condition, which is kept until the spec changes again. A locked binding
+// rejects changes to spec.releaseName, so the rollback is held back until the lock expires
+// and reported in the RolledBack condition instead; the event is only emitted when the
+// rollback becomes blocked.
+func (r *Reconciler) rollbackDegraded(ctx context.Context, rb *openchoreov1alpha1.ReleaseBinding,
+	policy *openchoreov1alpha1.AutoRollbackPolicy, now metav1.Time, wasBlocked bool) (time.Duration, error) {
+	target, reason, recheckAfter := degradedRollbackDecision(rb, policy, now)
+	if target == "" {
+		return recheckAfter, nil
+	}
+	failed := rb.Spec.ReleaseName
+
+	if lock := rb.Spec.Lock; lock.Active(now.Time) {
+		msg := fmt.Sprintf("Rollback of ComponentRelease %q to %q (%s) is blocked by the deployment lock until %s: %s",
+			failed, target, reason, lock.ExpiresAt.UTC().Format(time.RFC3339), lock.Reason)
+		controller.MarkFalseCondition(rb, ConditionRolledBack, ReasonRollbackBlocked, msg)
+		if !wasBlocked {
+			log.FromContext(ctx).Info(msg)
+			if r.Recorder != nil {
+				r.Recorder.Event(rb, corev1.EventTypeWarning, EventReasonRollbackBlocked, msg)
+			}
+		}
+		return lock.ExpiresAt.Sub(now.Time), nil
+	}
+
+	patched := rb.DeepCopy()
+	patched.Spec.ReleaseName = target
+	if err := r.Patch(ctx, patched, client.MergeFrom(rb)); err != nil {
+		return 0, fmt.Errorf("failed to roll back spec.releaseName to %q: %w", target, err)
+	}
+	// Keep the in-memory status; only the spec and the object version changed.
+	rb.Spec.ReleaseName = target
+	rb.Generation = patched.Generation
+	rb.ResourceVersion = patched.ResourceVersion
+
+	msg := fmt.Sprintf("Rolled back ComponentRelease %q to %q: %s", failed, target, reason)
+	controller.MarkTrueCondition(rb, ConditionRolledBack, ReasonDegradedTimeoutExceeded, msg)
+	log.FromContext(ctx).Info(msg)
+	if r.Recorder != nil {
+		r.Recorder.Event(rb, corev1.EventTypeWarning, EventReasonRolledBack, msg)
+	}
+	return 0, nil
+}
+
+// degradedRollbackDecision decides whether the deployment of spec.releaseName stayed degraded
+// for longer than the policy's degraded timeout after it became Ready; a deployment that never
+// became Ready is covered by the health check instead. The degraded period starts at the
+// oldest of the trailing degraded entries of the condition history. The rollback target is the most recent
+// release created before spec.releaseName that became Ready, so repeated rollbacks only ever
+// move back in time. When the resources are degraded but still within the timeout, the time
+// until the timeout expires is returned instead.
+func degradedRollbackDecision(rb *openchoreov1alpha1.ReleaseBinding, policy *openchoreov1alpha1.AutoRollbackPolicy,
+	now metav1.Time) (target, reason string, recheckAfter time.Duration) {
+	if policy == nil || !policy.Enabled || policy.DegradedTimeout == nil ||
+		rb.Spec.State == openchoreov1alpha1.ReleaseStateUndeploy {
+		return "", "", 0
+	}
+	history := rb.Status.DeploymentHistory
+	n := len(history)
+	if n == 0 || history[n-1].Release != rb.Spec.ReleaseName || history[n-1].ReadyAt == nil {
+		return "", "", 0
+	}
+	current := history[n-1]
+
+	var degradedSince *metav1.Time
+	for i := len(rb.Status.ConditionHistory) - 1; i >= 0; i-- {
+		t := rb.Status.ConditionHistory[i]
+		if t.Type != string(ConditionReady) || t.Status != metav1.ConditionFalse || !degradedReasons[t.Reason] {
+			break
+		}
+		degradedSince = &rb.Status.ConditionHistory[i].TransitionTime
+	}
+	if degradedSince == nil {
+		return "", "", 0
+	}
+	// Only degradation after the current deployment became Ready counts.
+	if degradedSince.Before(current.ReadyAt) {
+		degradedSince = current.ReadyAt
+	}
+
+	for i := n - 2; i >= 0; i-- {
+		if history[i].ReadyAt != nil && history[i].Release != current.Release &&
+			history[i].ReleaseCreatedAt.Before(&current.ReleaseCreatedAt) {
+			target = history[i].Release
+			break
+		}
+	}
+	if target == "" {
+		return "", "", 0
+	}
+
+	timeout := policy.DegradedTimeout.Duration
+	if elapsed := now.Sub(degradedSince.Time); elapsed < timeout {
+		return "", "", timeout - elapsed
+	}
+	return target, fmt.Sprintf("resources degraded for more than %s", timeout), 0
+}
@@ -0,0 +1,355 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// makeRollbackBinding returns a binding of rel-2 deployed at deployedAt, following a
+// deployment of rel-1 that became Ready.
+func makeRollbackBinding(deployedAt time.Time) *openchoreov1alpha1.ReleaseBinding {
+	rb := makePromotionBinding()
+	rb.Spec.ReleaseName = "rel-2"
+	readyAt := metav1.NewTime(deployedAt.Add(-time.Hour))
+	rb.Status.DeploymentHistory = []openchoreov1alpha1.DeploymentRecord{
+		{Release: "rel-1", DeployedAt: metav1.NewTime(deployedAt.Add(-2 * time.Hour)), ReadyAt: &readyAt},
+		{Release: "rel-2", DeployedAt: metav1.NewTime(deployedAt)},
+	}
+	return rb
+}
+
+func makeRollbackEnvironment(policy *openchoreov1alpha1.AutoRollbackPolicy) *openchoreov1alpha1.Environment {
+	return &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: testEnvStaging, Namespace: "default"},
+		Spec:       openchoreov1alpha1.EnvironmentSpec{AutoRollback: policy},
+	}
+}
+
+func enabledPolicy(timeout time.Duration) *openchoreov1alpha1.AutoRollbackPolicy {
+	return &openchoreov1alpha1.AutoRollbackPolicy{Enabled: true, HealthCheckTimeout: &metav1.Duration{Duration: timeout}}
+}
+
+func TestRollbackDecision(t *testing.T) {
+	deployedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("disabled policy never rolls back", func(t *testing.T) {
+		rb := makeRollbackBinding(deployedAt)
+		rollback, recheck := rollbackDecision(rb, &openchoreov1alpha1.AutoRollbackPolicy{}, metav1.NewTime(deployedAt.Add(time.Hour)))
+		assert.Nil(t, rollback)
+		assert.Zero(t, recheck)
+
+		rollback, _ = rollbackDecision(rb, nil, metav1.NewTime(deployedAt.Add(time.Hour)))
+		assert.Nil(t, rollback)
+	})
+
+	t.Run("within the timeout rechecks when it expires", func(t *testing.T) {
+		rb := makeRollbackBinding(deployedAt)
+		rollback, recheck := rollbackDecision(rb, enabledPolicy(5*time.Minute), metav1.NewTime(deployedAt.Add(2*time.Minute)))
+		assert.Nil(t, rollback)
+		assert.Equal(t, 3*time.Minute, recheck)
+	})
+
+	t.Run("not ready within the timeout rolls back", func(t *testing.T) {
+		rb := makeRollbackBinding(deployedAt)
+		now := metav1.NewTime(deployedAt.Add(5 * time.Minute))
+		rollback, _ := rollbackDecision(rb, enabledPolicy(5*time.Minute), now)
+		require.NotNil(t, rollback)
+		assert.Equal(t, "rel-2", rollback.FailedRelease)
+		assert.Equal(t, "rel-1", rollback.RolledBackTo)
+		assert.Contains(t, rollback.Reason, "not Ready within the health check timeout of 5m0s")
+		assert.Equal(t, now, rollback.RolledBackAt)
+	})
+
+	t.Run("default timeout applies", func(t *testing.T) {
+		rb := makeRollbackBinding(deployedAt)
+		policy := &openchoreov1alpha1.AutoRollbackPolicy{Enabled: true}
+		rollback, recheck := rollbackDecision(rb, policy, metav1.NewTime(deployedAt.Add(time.Minute)))
+		assert.Nil(t, rollback)
+		assert.Equal(t, defaultHealthCheckTimeout-time.Minute, recheck)
+	})
+
+	t.Run("failed deployment rolls back before the timeout", func(t *testing.T) {
+		rb := makeRollbackBinding(deployedAt)
+		failedAt := metav1.NewTime(deployedAt.Add(time.Minute))
+		rb.Status.DeploymentHistory[1].FailedAt = &failedAt
+		controller.MarkFalseCondition(rb, ConditionReady, ReasonResourcesDegraded, "Deployment has crashing pods")
+
+		rollback, _ := rollbackDecision(rb, enabledPolicy(time.Hour), metav1.NewTime(deployedAt.Add(2*time.Minute)))
+		require.NotNil(t, rollback)
+		assert.Equal(t, "deployment failed: Deployment has crashing pods", rollback.Reason)
+	})
+
+	t.Run("deployment that became ready is not rolled back", func(t *testing.T) {
+		rb := makeRollbackBinding(deployedAt)
+		readyAt := metav1.NewTime(deployedAt.Add(time.Minute))
+		rb.Status.DeploymentHistory[1].ReadyAt = &readyAt
+
+		rollback, recheck := rollbackDecision(rb, enabledPolicy(5*time.Minute), metav1.NewTime(deployedAt.Add(time.Hour)))
+		assert.Nil(t, rollback)
+		assert.Zero(t, recheck)
+	})
+
+	t.Run("no healthy previous release", func(t *testing.T) {
+		rb := makeRollbackBinding(deployedAt)
+		rb.Status.DeploymentHistory[0].ReadyAt = nil
+
+		rollback, _ := rollbackDecision(rb, enabledPolicy(5*time.Minute), metav1.NewTime(deployedAt.Add(time.Hour)))
+		assert.Nil(t, rollback)
+	})
+
+	t.Run("the rollback deployment itself is not checked", func(t *testing.T) {
+		rb := makeRollbackBinding(deployedAt)
+		rb.Status.DeploymentHistory = append(rb.Status.DeploymentHistory,
+			openchoreov1alpha1.DeploymentRecord{Release: "rel-1", DeployedAt: metav1.NewTime(deployedAt.Add(5 * time.Minute))})
+
+		rollback, _ := rollbackDecision(rb, enabledPolicy(5*time.Minute), metav1.NewTime(deployedAt.Add(time.Hour)))
+		assert.Nil(t, rollback)
+	})
+}
+
+func TestReconcileAutoRollback(t *testing.T) {
+	deployedAt := time.Now().Add(-time.Hour)
+
+	t.Run("rolls back using the environment policy", func(t *testing.T) {
+		env := makeRollbackEnvironment(enabledPolicy(5 * time.Minute))
+		r, recorder := newPromotionTestReconciler(t, env)
+		rb := makeRollbackBinding(deployedAt)
+
+		recheck, err := r.reconcileAutoRollback(context.Background(), rb)
+		require.NoError(t, err)
+		assert.Zero(t, recheck)
+
+		require.NotNil(t, rb.Status.Rollback)
+		assert.Equal(t, "rel-1", effectiveReleaseName(rb))
+		cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionRolledBack))
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, string(ReasonHealthCheckFailed), cond.Reason)
+		event := <-recorder.Events
+		assert.Contains(t, event, EventReasonRolledBack)
+	})
+
+	t.Run("binding policy overrides the environment policy", func(t *testing.T) {
+		env := makeRollbackEnvironment(enabledPolicy(5 * time.Minute))
+		r, _ := newPromotionTestReconciler(t, env)
+		rb := makeRollbackBinding(deployedAt)
+		rb.Spec.AutoRollback = &openchoreov1alpha1.AutoRollbackPolicy{Enabled: false}
+
+		_, err := r.reconcileAutoRollback(context.Background(), rb)
+		require.NoError(t, err)
+		assert.Nil(t, rb.Status.Rollback)
+		assert.Equal(t, "rel-2", effectiveReleaseName(rb))
+	})
+
+	t.Run("changing spec.releaseName clears the rollback", func(t *testing.T) {
+		r, _ := newPromotionTestReconciler(t)
+		rb := makeRollbackBinding(deployedAt)
+		rb.Status.Rollback = &openchoreov1alpha1.ReleaseBindingRollback{FailedRelease: "rel-2", RolledBackTo: "rel-1"}
+		controller.MarkTrueCondition(rb, ConditionRolledBack, ReasonHealthCheckFailed, "rolled back")
+		rb.Spec.ReleaseName = "rel-3"
+
+		_, err := r.reconcileAutoRollback(context.Background(), rb)
+		require.NoError(t, err)
+		assert.Nil(t, rb.Status.Rollback)
+		assert.Nil(t, meta.FindStatusCondition(rb.Status.Conditions, string(ConditionRolledBack)))
+		assert.Equal(t, "rel-3", effectiveReleaseName(rb))
+	})
+}
+
+// makeDegradedBinding returns a binding of rel-2 that became Ready and degraded at
+// degradedAt, following a deployment of the older rel-1 that became Ready.
+func makeDegradedBinding(degradedAt time.Time) *openchoreov1alpha1.ReleaseBinding {
+	rb := makeRollbackBinding(degradedAt.Add(-time.Hour))
+	rb.Status.DeploymentHistory[0].ReleaseCreatedAt = metav1.NewTime(degradedAt.Add(-48 * time.Hour))
+	rb.Status.DeploymentHistory[1].ReleaseCreatedAt = metav1.NewTime(degradedAt.Add(-24 * time.Hour))
+	readyAt := metav1.NewTime(degradedAt.Add(-30 * time.Minute))
+	rb.Status.DeploymentHistory[1].ReadyAt = &readyAt
+	rb.Status.ConditionHistory = []openchoreov1alpha1.ConditionTransition{
+		{Type: string(ConditionReady), Status: metav1.ConditionTrue, Reason: string(ReasonReady), TransitionTime: readyAt},
+		{Type: string(ConditionReady), Status: metav1.ConditionFalse, Reason: string(ReasonResourcesDegraded), TransitionTime: metav1.NewTime(degradedAt)},
+	}
+	return rb
+}
+
+func degradedPolicy(timeout time.Duration) *openchoreov1alpha1.AutoRollbackPolicy {
+	return &openchoreov1alpha1.AutoRollbackPolicy{Enabled: true, DegradedTimeout: &metav1.Duration{Duration: timeout}}
+}
+
+func TestDegradedRollbackDecision(t *testing.T) {
+	degradedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("disabled policy never rolls back", func(t *testing.T) {
+		rb := makeDegradedBinding(degradedAt)
+		target, _, _ := degradedRollbackDecision(rb, &openchoreov1alpha1.AutoRollbackPolicy{}, metav1.NewTime(degradedAt.Add(time.Hour)))
+		assert.Empty(t, target)
+
+		target, _, _ = degradedRollbackDecision(rb, nil, metav1.NewTime(degradedAt.Add(time.Hour)))
+		assert.Empty(t, target)
+	})
+
+	t.Run("policy without a degraded timeout never rolls back", func(t *testing.T) {
+		rb := makeDegradedBinding(degradedAt)
+		target, _, recheck := degradedRollbackDecision(rb, enabledPolicy(5*time.Minute), metav1.NewTime(degradedAt.Add(time.Hour)))
+		assert.Empty(t, target)
+		assert.Zero(t, recheck)
+	})
+
+	t.Run("within the timeout rechecks when it expires", func(t *testing.T) {
+		rb := makeDegradedBinding(degradedAt)
+		target, _, recheck := degradedRollbackDecision(rb, degradedPolicy(5*time.Minute), metav1.NewTime(degradedAt.Add(2*time.Minute)))
+		assert.Empty(t, target)
+		assert.Equal(t, 3*time.Minute, recheck)
+	})
+
+	t.Run("degraded beyond the timeout rolls back", func(t *testing.T) {
+		rb := makeDegradedBinding(degradedAt)
+		target, reason, _ := degradedRollbackDecision(rb, degradedPolicy(5*time.Minute), metav1.NewTime(degradedAt.Add(5*time.Minute)))
+		assert.Equal(t, "rel-1", target)
+		assert.Equal(t, "resources degraded for more than 5m0s", reason)
+	})
+
+	t.Run("diagnosed failures count as degraded", func(t *testing.T) {
+		rb := makeDegradedBinding(degradedAt)
+		rb.Status.ConditionHistory = append(rb.Status.ConditionHistory, openchoreov1alpha1.ConditionTransition{
+			Type: string(ConditionReady), Status: metav1.ConditionFalse, Reason: string(ReasonCrashLoopBackOff),
+			TransitionTime: metav1.NewTime(degradedAt.Add(time.Minute)),
+		})
+		target, _, _ := degradedRollbackDecision(rb, degradedPolicy(5*time.Minute), metav1.NewTime(degradedAt.Add(5*time.Minute)))
+		assert.Equal(t, "rel-1", target)
+	})
+
+	t.Run("healthy resources are not rolled back", func(t *testing.T) {
+		rb := makeDegradedBinding(degradedAt)
+		rb.Status.ConditionHistory = append(rb.Status.ConditionHistory, openchoreov1alpha1.ConditionTransition{
+			Type: string(ConditionReady), Status: metav1.ConditionTrue, Reason: string(ReasonReady),
+			TransitionTime: metav1.NewTime(degradedAt.Add(time.Minute)),
+		})
+		target, _, recheck := degradedRollbackDecision(rb, degradedPolicy(5*time.Minute), metav1.NewTime(degradedAt.Add(time.Hour)))
+		assert.Empty(t, target)
+		assert.Zero(t, recheck)
+	})
+
+	t.Run("release that never became ready is left to the health check", func(t *testing.T) {
+		rb := makeDegradedBinding(degradedAt)
+		rb.Status.DeploymentHistory[1].ReadyAt = nil
+		target, _, recheck := degradedRollbackDecision(rb, degradedPolicy(5*time.Minute), metav1.NewTime(degradedAt.Add(time.Hour)))
+		assert.Empty(t, target)
+		assert.Zero(t, recheck)
+	})
+
+	t.Run("never rolls forward to a newer release", func(t *testing.T) {
+		rb := makeDegradedBinding(degradedAt)
+		rb.Status.DeploymentHistory[0].ReleaseCreatedAt = metav1.NewTime(degradedAt)
+		target, _, _ := degradedRollbackDecision(rb, degradedPolicy(5*time.Minute), metav1.NewTime(degradedAt.Add(time.Hour)))
+		assert.Empty(t, target)
+	})
+}
+
+// storeDegradedBinding stores a degraded binding of rel-2 with the given auto-rollback
+// policy and returns it with its degraded status.
+func storeDegradedBinding(t *testing.T, degradedAt time.Time,
+	mutate func(rb *openchoreov1alpha1.ReleaseBinding)) (*Reconciler, *record.FakeRecorder, *openchoreov1alpha1.ReleaseBinding) {
+	t.Helper()
+	rb := makeDegradedBinding(degradedAt)
+	rb.Name = "checkout-staging"
+	rb.Spec.AutoRollback = degradedPolicy(5 * time.Minute)
+	mutate(rb)
+	r, recorder := newPromotionTestReconciler(t, rb)
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(rb), rb))
+	rb.Status = makeDegradedBinding(degradedAt).Status
+	return r, recorder, rb
+}
+
+func TestReconcileAutoRollbackDegraded(t *testing.T) {
+	degradedAt := time.Now().Add(-time.Hour)
+
+	t.Run("re-points spec.releaseName", func(t *testing.T) {
+		r, recorder, rb := storeDegradedBinding(t, degradedAt, func(*openchoreov1alpha1.ReleaseBinding) {})
+
+		recheck, err := r.reconcileAutoRollback(context.Background(), rb)
+		require.NoError(t, err)
+		assert.Zero(t, recheck)
+		assert.Equal(t, "rel-1", rb.Spec.ReleaseName)
+		assert.Nil(t, rb.Status.Rollback)
+
+		stored := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(rb), stored))
+		assert.Equal(t, "rel-1", stored.Spec.ReleaseName)
+		assert.Equal(t, stored.ResourceVersion, rb.ResourceVersion)
+
+		cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionRolledBack))
+		require.NotNil(t, cond)
+		assert.Equal(t, string(ReasonDegradedTimeoutExceeded), cond.Reason)
+		assert.Equal(t, rb.Generation, cond.ObservedGeneration)
+		event := <-recorder.Events
+		assert.Contains(t, event, EventReasonRolledBack)
+	})
+
+	t.Run("locked binding is not rolled back", func(t *testing.T) {
+		expiresAt := time.Now().Add(2 * time.Hour)
+		r, recorder, rb := storeDegradedBinding(t, degradedAt, func(rb *openchoreov1alpha1.ReleaseBinding) {
+			rb.Spec.Lock = &openchoreov1alpha1.DeploymentLock{Reason: "incident", ExpiresAt: metav1.NewTime(expiresAt)}
+		})
+
+		recheck, err := r.reconcileAutoRollback(context.Background(), rb)
+		require.NoError(t, err)
+		assert.InDelta(t, 2*time.Hour, recheck, float64(time.Minute))
+		assert.Equal(t, "rel-2", rb.Spec.ReleaseName)
+
+		stored := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(rb), stored))
+		assert.Equal(t, "rel-2", stored.Spec.ReleaseName)
+
+		cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionRolledBack))
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, string(ReasonRollbackBlocked), cond.Reason)
+		assert.Contains(t, cond.Message, "incident")
+		event := <-recorder.Events
+		assert.Contains(t, event, EventReasonRollbackBlocked)
+
+		// The blocked rollback is reported once.
+		_, err = r.reconcileAutoRollback(context.Background(), rb)
+		require.NoError(t, err)
+		assert.Equal(t, string(ReasonRollbackBlocked),
+			meta.FindStatusCondition(rb.Status.Conditions, string(ConditionRolledBack)).Reason)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("expired lock does not block the rollback", func(t *testing.T) {
+		r, _, rb := storeDegradedBinding(t, degradedAt, func(rb *openchoreov1alpha1.ReleaseBinding) {
+			rb.Spec.Lock = &openchoreov1alpha1.DeploymentLock{Reason: "incident", ExpiresAt: metav1.NewTime(time.Now().Add(-time.Minute))}
+		})
+
+		_, err := r.reconcileAutoRollback(context.Background(), rb)
+		require.NoError(t, err)
+		assert.Equal(t, "rel-1", rb.Spec.ReleaseName)
+	})
+
+	t.Run("later spec changes clear the condition", func(t *testing.T) {
+		r, _ := newPromotionTestReconciler(t)
+		rb := makePromotionBinding()
+		rb.Generation = 2
+		controller.MarkTrueCondition(rb, ConditionRolledBack, ReasonDegradedTimeoutExceeded, "rolled back")
+		rb.Generation = 3
+		rb.Spec.AutoRollback = &openchoreov1alpha1.AutoRollbackPolicy{Enabled: false}
+
+		_, err := r.reconcileAutoRollback(context.Background(), rb)
+		require.NoError(t, err)
+		assert.Nil(t, meta.FindStatusCondition(rb.Status.Conditions, string(ConditionRolledBack)))
+	})
+}
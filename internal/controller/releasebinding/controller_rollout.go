@@ -0,0 +1,244 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// EventReasonRolloutCompleted is the event reason emitted when the release rolled out by a
+// canary or blue-green rollout serves all traffic.
+const EventReasonRolloutCompleted = "RolloutCompleted"
+
+// defaultPreviewHostnamePrefix is used when a BlueGreen strategy sets no preview hostname prefix.
+const defaultPreviewHostnamePrefix = "preview-"
+
+// reconcileRollout advances the rollout of a binding with a rollout strategy and returns the
+// ComponentRelease to render as the stable track next to componentRelease, or nil when only
+// componentRelease is rendered. Also returns the time after which the rollout must be
+// evaluated again, or zero.
+func (r *Reconciler) reconcileRollout(ctx context.Context, rb *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease) (*openchoreov1alpha1.ComponentRelease, time.Duration, error) {
+	if rb.Spec.RolloutStrategy == nil {
+		rb.Status.Rollout = nil
+		return nil, 0, nil
+	}
+	logger := log.FromContext(ctx)
+
+	existing := &openchoreov1alpha1.RenderedRelease{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Namespace: rb.Namespace,
+		Name:      makeDataPlaneReleaseName(componentRelease, rb),
+	}, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, 0, fmt.Errorf("failed to get dataplane Release: %w", err)
+		}
+		existing = nil
+	}
+
+	previous := rb.Status.Rollout.DeepCopy()
+	recheckAfter := advanceRollout(rb, componentRelease.Name, workloadsReady(existing), metav1.Now())
+	rollout := rb.Status.Rollout
+	if previous != nil && previous.CandidateRelease != "" && rollout.CandidateRelease == "" &&
+		rollout.StableRelease == previous.CandidateRelease {
+		msg := fmt.Sprintf("ComponentRelease %q serves all traffic", rollout.StableRelease)
+		logger.Info(msg)
+		if r.Recorder != nil {
+			r.Recorder.Event(rb, corev1.EventTypeNormal, EventReasonRolloutCompleted, msg)
+		}
+	}
+
+	switch {
+	case rollout.CandidateRelease == "":
+		return nil, recheckAfter, nil
+	case rollout.Phase == openchoreov1alpha1.RolloutPhasePromoting:
+		// The stable track is replaced by the candidate while the candidate serves all traffic.
+		return componentRelease, recheckAfter, nil
+	}
+
+	stable := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: rb.Namespace, Name: rollout.StableRelease}, stable); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, 0, fmt.Errorf("failed to get stable ComponentRelease %q: %w", rollout.StableRelease, err)
+		}
+		// There is nothing left to shift traffic from, so the candidate takes over at once.
+		logger.Info("Stable ComponentRelease not found, completing the rollout", "stableRelease", rollout.StableRelease)
+		rb.Status.Rollout = &openchoreov1alpha1.ReleaseBindingRollout{
+			StableRelease: componentRelease.Name,
+			Phase:         openchoreov1alpha1.RolloutPhaseCompleted,
+		}
+		return nil, 0, nil
+	}
+	return stable, recheckAfter, nil
+}
+
+// advanceRollout moves the rollout recorded in status.rollout to its next step. target is the
+// ComponentRelease the binding deploys and ready reports whether the workloads of the current
+// step are ready. A new target starts a rollout from the stable release; binding the stable
+// release again, e.g. by an automatic rollback, aborts it. Canary steps and the BlueGreen
+// promotion delay start counting once the candidate is ready, and a step without a pause waits
+// for the promote-rollout annotation. Once promoted, the candidate serves all traffic until it
+// has replaced the stable release. Returns the time after which the rollout must be evaluated
+// again, or zero.
+func advanceRollout(rb *openchoreov1alpha1.ReleaseBinding, target string, ready bool, now metav1.Time) time.Duration {
+	strategy := rb.Spec.RolloutStrategy
+	rollout := rb.Status.Rollout
+	if rollout == nil || rollout.StableRelease == "" || rollout.StableRelease == target {
+		// The first release bound to the environment has no traffic to take over, and binding
+		// the stable release again ends the rollout.
+		rb.Status.Rollout = &openchoreov1alpha1.ReleaseBindingRollout{
+			StableRelease: target,
+			Phase:         openchoreov1alpha1.RolloutPhaseCompleted,
+		}
+		return 0
+	}
+
+	if rollout.CandidateRelease != target {
+		*rollout = openchoreov1alpha1.ReleaseBindingRollout{
+			StableRelease:    rollout.StableRelease,
+			CandidateRelease: target,
+			Phase:            openchoreov1alpha1.RolloutPhaseProgressing,
+		}
+		if strategy.Type == openchoreov1alpha1.RolloutStrategyCanary && strategy.Canary != nil &&
+			len(strategy.Canary.Steps) > 0 {
+			rollout.CandidateWeight = strategy.Canary.Steps[0].Weight
+		}
+		return 0
+	}
+
+	if rollout.Phase == openchoreov1alpha1.RolloutPhasePromoting {
+		if ready {
+			rb.Status.Rollout = &openchoreov1alpha1.ReleaseBindingRollout{
+				StableRelease: target,
+				Phase:         openchoreov1alpha1.RolloutPhaseCompleted,
+			}
+		}
+		return 0
+	}
+
+	if rb.Annotations[controller.AnnotationKeyPromoteRollout] == target {
+		promoteRollout(rollout)
+		return 0
+	}
+	if !ready {
+		return 0
+	}
+	if rollout.StepStartedAt == nil {
+		rollout.StepStartedAt = &now
+	}
+
+	var pause *metav1.Duration
+	lastStep := true
+	switch strategy.Type {
+	case openchoreov1alpha1.RolloutStrategyCanary:
+		if strategy.Canary == nil || int(rollout.Step) >= len(strategy.Canary.Steps) {
+			promoteRollout(rollout)
+			return 0
+		}
+		pause = strategy.Canary.Steps[rollout.Step].Pause
+		lastStep = int(rollout.Step) == len(strategy.Canary.Steps)-1
+	case openchoreov1alpha1.RolloutStrategyBlueGreen:
+		if strategy.BlueGreen != nil {
+			pause = strategy.BlueGreen.AutoPromotionDelay
+		}
+	}
+
+	if pause == nil {
+		rollout.Phase = openchoreov1alpha1.RolloutPhasePaused
+		return 0
+	}
+	if remaining := pause.Duration - now.Sub(rollout.StepStartedAt.Time); remaining > 0 {
+		return remaining
+	}
+	if lastStep {
+		promoteRollout(rollout)
+		return 0
+	}
+	rollout.Step++
+	rollout.CandidateWeight = strategy.Canary.Steps[rollout.Step].Weight
+	rollout.StepStartedAt = nil
+	return 0
+}
+
+// promoteRollout sends all traffic to the candidate release while it replaces the stable one.
+func promoteRollout(rollout *openchoreov1alpha1.ReleaseBindingRollout) {
+	rollout.Phase = openchoreov1alpha1.RolloutPhasePromoting
+	rollout.CandidateWeight = 100
+	rollout.StepStartedAt = nil
+}
+
+// renderRollout combines the resources rendered for the binding with those of the stable
+// release of its rollout. The stable release is rendered with the configuration of the
+// binding, like the candidate. Without a stable release, the rendered resources are only
+// labeled as the stable track.
+func (r *Reconciler) renderRollout(ctx context.Context, rb *openchoreov1alpha1.ReleaseBinding,
+	input *componentpipeline.RenderInput, output *componentpipeline.RenderOutput,
+	stableRelease *openchoreov1alpha1.ComponentRelease) ([]renderer.RenderedResource, error) {
+	strategy := rb.Spec.RolloutStrategy
+	rolloutInput := &componentpipeline.RolloutInput{
+		WorkloadType: input.ComponentType.Spec.WorkloadType,
+		Strategy:     strategy.Type,
+		Stable:       output.Resources,
+	}
+
+	if stableRelease != nil {
+		stableInput := *input
+		stableInput.Component = buildComponentFromRelease(stableRelease)
+		stableInput.ComponentType = buildComponentTypeFromRelease(stableRelease)
+		stableInput.Traits = buildTraitsFromRelease(stableRelease)
+		stableInput.Workload = buildWorkloadFromRelease(stableRelease)
+
+		stableSecretReferences, err := r.collectSecretReferences(ctx, stableInput.Workload, rb)
+		if err != nil {
+			return nil, fmt.Errorf("failed to collect SecretReferences of the stable release: %w", err)
+		}
+		stableInput.SecretReferences = make(map[string]*openchoreov1alpha1.SecretReference,
+			len(input.SecretReferences)+len(stableSecretReferences))
+		maps.Copy(stableInput.SecretReferences, input.SecretReferences)
+		maps.Copy(stableInput.SecretReferences, stableSecretReferences)
+
+		stableOutput, err := r.Pipeline.Render(ctx, &stableInput)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render stable ComponentRelease %q: %w", stableRelease.Name, err)
+		}
+		rolloutInput.Stable = stableOutput.Resources
+		rolloutInput.Candidate = output.Resources
+		rolloutInput.CandidateWeight = rb.Status.Rollout.CandidateWeight
+		rolloutInput.PreviewHostnamePrefix = defaultPreviewHostnamePrefix
+		if strategy.BlueGreen != nil && strategy.BlueGreen.PreviewHostnamePrefix != "" {
+			rolloutInput.PreviewHostnamePrefix = strategy.BlueGreen.PreviewHostnamePrefix
+		}
+	}
+
+	rolloutOutput, err := componentpipeline.RenderRollout(rolloutInput)
+	if err != nil {
+		return nil, err
+	}
+	if rb.Status.Rollout != nil {
+		rb.Status.Rollout.PreviewHostnames = rolloutOutput.PreviewHostnames
+	}
+	return rolloutOutput.Resources, nil
+}
+
+// isRolloutCandidate reports whether a rendered object belongs to the release rolled out next
+// to the stable one. Its Services and preview routes do not determine the endpoint URLs.
+func isRolloutCandidate(objLabels map[string]string) bool {
+	track := objLabels[labels.LabelKeyRolloutTrack]
+	return track == labels.LabelValueRolloutTrackCanary || track == labels.LabelValueRolloutTrackPreview
+}
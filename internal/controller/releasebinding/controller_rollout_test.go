@@ -0,0 +1,126 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// makeCanaryBinding returns a binding of rel-2 rolling out from rel-1 with a canary of two
+// steps: 20% for five minutes, then 50% until promoted.
+func makeCanaryBinding() *openchoreov1alpha1.ReleaseBinding {
+	rb := makePromotionBinding()
+	rb.Spec.ReleaseName = "rel-2"
+	rb.Spec.RolloutStrategy = &openchoreov1alpha1.RolloutStrategy{
+		Type: openchoreov1alpha1.RolloutStrategyCanary,
+		Canary: &openchoreov1alpha1.CanaryStrategy{Steps: []openchoreov1alpha1.CanaryStep{
+			{Weight: 20, Pause: &metav1.Duration{Duration: 5 * time.Minute}},
+			{Weight: 50},
+		}},
+	}
+	rb.Status.Rollout = &openchoreov1alpha1.ReleaseBindingRollout{
+		StableRelease: "rel-1",
+		Phase:         openchoreov1alpha1.RolloutPhaseCompleted,
+	}
+	return rb
+}
+
+func TestAdvanceRollout(t *testing.T) {
+	start := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	t.Run("first release completes at once", func(t *testing.T) {
+		rb := makeCanaryBinding()
+		rb.Status.Rollout = nil
+		advanceRollout(rb, "rel-2", false, start)
+		require.NotNil(t, rb.Status.Rollout)
+		assert.Equal(t, "rel-2", rb.Status.Rollout.StableRelease)
+		assert.Empty(t, rb.Status.Rollout.CandidateRelease)
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseCompleted, rb.Status.Rollout.Phase)
+	})
+
+	t.Run("canary walks through its steps", func(t *testing.T) {
+		rb := makeCanaryBinding()
+		rollout := func() *openchoreov1alpha1.ReleaseBindingRollout { return rb.Status.Rollout }
+
+		advanceRollout(rb, "rel-2", true, start)
+		assert.Equal(t, "rel-2", rollout().CandidateRelease)
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseProgressing, rollout().Phase)
+		assert.Equal(t, int32(20), rollout().CandidateWeight)
+		assert.Nil(t, rollout().StepStartedAt)
+
+		// The pause starts once the candidate is ready.
+		assert.Zero(t, advanceRollout(rb, "rel-2", false, start))
+		assert.Nil(t, rollout().StepStartedAt)
+		assert.Equal(t, 5*time.Minute, advanceRollout(rb, "rel-2", true, start))
+		assert.Equal(t, 3*time.Minute, advanceRollout(rb, "rel-2", true, metav1.NewTime(start.Add(2*time.Minute))))
+
+		advanceRollout(rb, "rel-2", true, metav1.NewTime(start.Add(5*time.Minute)))
+		assert.Equal(t, int32(1), rollout().Step)
+		assert.Equal(t, int32(50), rollout().CandidateWeight)
+
+		// The last step has no pause and waits to be promoted.
+		advanceRollout(rb, "rel-2", true, metav1.NewTime(start.Add(time.Hour)))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhasePaused, rollout().Phase)
+
+		rb.Annotations = map[string]string{controller.AnnotationKeyPromoteRollout: "rel-2"}
+		advanceRollout(rb, "rel-2", true, metav1.NewTime(start.Add(time.Hour)))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhasePromoting, rollout().Phase)
+		assert.Equal(t, int32(100), rollout().CandidateWeight)
+
+		// The stable track is replaced before the rollout completes.
+		advanceRollout(rb, "rel-2", false, metav1.NewTime(start.Add(time.Hour)))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhasePromoting, rollout().Phase)
+		advanceRollout(rb, "rel-2", true, metav1.NewTime(start.Add(time.Hour)))
+		assert.Equal(t, &openchoreov1alpha1.ReleaseBindingRollout{
+			StableRelease: "rel-2",
+			Phase:         openchoreov1alpha1.RolloutPhaseCompleted,
+		}, rollout())
+	})
+
+	t.Run("stale promotion annotation is ignored", func(t *testing.T) {
+		rb := makeCanaryBinding()
+		rb.Annotations = map[string]string{controller.AnnotationKeyPromoteRollout: "rel-0"}
+		advanceRollout(rb, "rel-2", true, start)
+		advanceRollout(rb, "rel-2", true, start)
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseProgressing, rb.Status.Rollout.Phase)
+	})
+
+	t.Run("binding the stable release aborts the rollout", func(t *testing.T) {
+		rb := makeCanaryBinding()
+		advanceRollout(rb, "rel-2", true, start)
+		advanceRollout(rb, "rel-1", true, start)
+		assert.Equal(t, "rel-1", rb.Status.Rollout.StableRelease)
+		assert.Empty(t, rb.Status.Rollout.CandidateRelease)
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseCompleted, rb.Status.Rollout.Phase)
+	})
+
+	t.Run("blue-green promotes after its delay", func(t *testing.T) {
+		rb := makeCanaryBinding()
+		rb.Spec.RolloutStrategy = &openchoreov1alpha1.RolloutStrategy{
+			Type:      openchoreov1alpha1.RolloutStrategyBlueGreen,
+			BlueGreen: &openchoreov1alpha1.BlueGreenStrategy{AutoPromotionDelay: &metav1.Duration{Duration: time.Minute}},
+		}
+		advanceRollout(rb, "rel-2", true, start)
+		assert.Zero(t, rb.Status.Rollout.CandidateWeight)
+		assert.Equal(t, time.Minute, advanceRollout(rb, "rel-2", true, start))
+		advanceRollout(rb, "rel-2", true, metav1.NewTime(start.Add(time.Minute)))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhasePromoting, rb.Status.Rollout.Phase)
+	})
+
+	t.Run("blue-green without a delay waits to be promoted", func(t *testing.T) {
+		rb := makeCanaryBinding()
+		rb.Spec.RolloutStrategy = &openchoreov1alpha1.RolloutStrategy{Type: openchoreov1alpha1.RolloutStrategyBlueGreen}
+		advanceRollout(rb, "rel-2", true, start)
+		advanceRollout(rb, "rel-2", true, metav1.NewTime(start.Add(24*time.Hour)))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhasePaused, rb.Status.Rollout.Phase)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// EventReasonPromotionBlocked is the event reason emitted when a promotion is held back
+// because an error budget is exhausted.
+const EventReasonPromotionBlocked = "PromotionBlocked"
+
+// blockingServiceLevelObjectives returns the names of the ServiceLevelObjectives that block
+// binding the given release. Only promotions are gated: the initial deployment, re-reconciling
+// the current release and rollbacks to an older release are always allowed, since holding
+// those back would not protect the error budget.
+func (r *Reconciler) blockingServiceLevelObjectives(ctx context.Context, rb *openchoreov1alpha1.ReleaseBinding,
+	to *openchoreov1alpha1.ComponentRelease) ([]string, error) {
+	n := len(rb.Status.DeploymentHistory)
+	if n == 0 {
+		return nil, nil
+	}
+	current := rb.Status.DeploymentHistory[n-1]
+	if current.Release == to.Name || to.CreationTimestamp.Before(&current.ReleaseCreatedAt) {
+		return nil, nil
+	}
+
+	var slos openchoreov1alpha1.ServiceLevelObjectiveList
+	if err := r.List(ctx, &slos, client.InNamespace(rb.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ServiceLevelObjectives: %w", err)
+	}
+
+	var blocking []string
+	for i := range slos.Items {
+		s := &slos.Items[i]
+		if s.Spec.Owner.ProjectName != rb.Spec.Owner.ProjectName ||
+			s.Spec.Owner.ComponentName != rb.Spec.Owner.ComponentName ||
+			s.Spec.Environment != rb.Spec.Environment {
+			continue
+		}
+		if s.Spec.PromotionPolicy != openchoreov1alpha1.SLOPromotionPolicyBlockWhenExhausted {
+			continue
+		}
+		if s.Status.ErrorBudget != nil && s.Status.ErrorBudget.Exhausted {
+			blocking = append(blocking, s.Name)
+		}
+	}
+	sort.Strings(blocking)
+	return blocking, nil
+}
+
+// promotionBlockedMessage describes a promotion held back by exhausted error budgets.
+func promotionBlockedMessage(rb *openchoreov1alpha1.ReleaseBinding, to string, blocking []string) string {
+	return fmt.Sprintf("Promotion to ComponentRelease %q in environment %q is blocked: "+
+		"error budget exhausted for ServiceLevelObjective(s) %s",
+		to, rb.Spec.Environment, strings.Join(blocking, ", "))
+}
+
+// errorBudgetExhaustedChangedPredicate passes when a ServiceLevelObjective's exhausted state
+// or promotion policy changes, which are the only inputs of the promotion gate.
+func errorBudgetExhaustedChangedPredicate() predicate.Predicate {
+	exhausted := func(s *openchoreov1alpha1.ServiceLevelObjective) bool {
+		return s.Status.ErrorBudget != nil && s.Status.ErrorBudget.Exhausted
+	}
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSLO, ok1 := e.ObjectOld.(*openchoreov1alpha1.ServiceLevelObjective)
+			newSLO, ok2 := e.ObjectNew.(*openchoreov1alpha1.ServiceLevelObjective)
+			if !ok1 || !ok2 {
+				return false
+			}
+			return exhausted(oldSLO) != exhausted(newSLO) ||
+				oldSLO.Spec.PromotionPolicy != newSLO.Spec.PromotionPolicy
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// findReleaseBindingsForServiceLevelObjective maps a ServiceLevelObjective to the
+// ReleaseBindings of its component in its environment.
+func (r *Reconciler) findReleaseBindingsForServiceLevelObjective(ctx context.Context, obj client.Object) []reconcile.Request {
+	s, ok := obj.(*openchoreov1alpha1.ServiceLevelObjective)
+	if !ok {
+		return nil
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings,
+		client.InNamespace(s.Namespace),
+		client.MatchingFields{controller.IndexKeyReleaseBindingOwnerComponentName: s.Spec.Owner.ComponentName}); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, binding := range bindings.Items {
+		if binding.Spec.Owner.ProjectName != s.Spec.Owner.ProjectName || binding.Spec.Environment != s.Spec.Environment {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&binding)})
+	}
+	return requests
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func makeSLO(name string, policy openchoreov1alpha1.SLOPromotionPolicy, exhausted bool) *openchoreov1alpha1.ServiceLevelObjective {
+	return &openchoreov1alpha1.ServiceLevelObjective{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: openchoreov1alpha1.ServiceLevelObjectiveSpec{
+			Owner: openchoreov1alpha1.ServiceLevelObjectiveOwner{
+				ProjectName:   testProjectName,
+				ComponentName: testComponentName,
+			},
+			Environment:     testEnvStaging,
+			Availability:    &openchoreov1alpha1.AvailabilityObjective{Target: "99.9"},
+			PromotionPolicy: policy,
+		},
+		Status: openchoreov1alpha1.ServiceLevelObjectiveStatus{
+			ErrorBudget: &openchoreov1alpha1.ErrorBudgetStatus{Exhausted: exhausted},
+		},
+	}
+}
+
+func TestBlockingServiceLevelObjectives(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := releaseCreatedAt("rel-1", base)
+	newer := releaseCreatedAt("rel-2", base.Add(time.Hour))
+	older := releaseCreatedAt("rel-0", base.Add(-time.Hour))
+
+	deployedBinding := func() *openchoreov1alpha1.ReleaseBinding {
+		rb := makePromotionBinding()
+		appendDeploymentRecord(rb, current, metav1.NewTime(base))
+		return rb
+	}
+	exhausted := makeSLO("gating", openchoreov1alpha1.SLOPromotionPolicyBlockWhenExhausted, true)
+
+	tests := []struct {
+		name string
+		rb   *openchoreov1alpha1.ReleaseBinding
+		to   *openchoreov1alpha1.ComponentRelease
+		slos []*openchoreov1alpha1.ServiceLevelObjective
+		want []string
+	}{
+		{
+			name: "exhausted gating SLO blocks promotion",
+			rb:   deployedBinding(),
+			to:   newer,
+			slos: []*openchoreov1alpha1.ServiceLevelObjective{
+				exhausted,
+				makeSLO("allow", openchoreov1alpha1.SLOPromotionPolicyAllow, true),
+				makeSLO("healthy", openchoreov1alpha1.SLOPromotionPolicyBlockWhenExhausted, false),
+			},
+			want: []string{"gating"},
+		},
+		{
+			name: "initial deployment is not gated",
+			rb:   makePromotionBinding(),
+			to:   newer,
+			slos: []*openchoreov1alpha1.ServiceLevelObjective{exhausted},
+		},
+		{
+			name: "current release is not gated",
+			rb:   deployedBinding(),
+			to:   current,
+			slos: []*openchoreov1alpha1.ServiceLevelObjective{exhausted},
+		},
+		{
+			name: "rollback is not gated",
+			rb:   deployedBinding(),
+			to:   older,
+			slos: []*openchoreov1alpha1.ServiceLevelObjective{exhausted},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := newPromotionTestReconciler(t)
+			for _, s := range tt.slos {
+				require.NoError(t, r.Create(context.Background(), s.DeepCopy()))
+			}
+			got, err := r.blockingServiceLevelObjectives(context.Background(), tt.rb, tt.to)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPromotionBlockedMessage(t *testing.T) {
+	msg := promotionBlockedMessage(makePromotionBinding(), "rel-2", []string{"a", "b"})
+	assert.Contains(t, msg, `"rel-2"`)
+	assert.Contains(t, msg, testEnvStaging)
+	assert.Contains(t, msg, "a, b")
+}
@@ -72,6 +72,10 @@ func (r *Reconciler) setResourcesReadyStatus(
 	component *openchoreov1alpha1.Component,
 ) error {
 	logger := log.FromContext(ctx)
+	releaseBinding.Status.Diagnosis = nil
+
+	// Lifecycle hook Jobs are reported by the HooksSucceeded condition instead
+	resources := withoutHookResources(release.Status.Resources)
 
 	// Extract workload type from Component's ComponentType field
 	componentTypeName := component.Spec.ComponentType.Name
@@ -80,10 +84,10 @@ func (r *Reconciler) setResourcesReadyStatus(
 	logger.Info("Evaluating resource status",
 		"componentType", componentTypeName,
 		"workloadType", workloadType,
-		"resourceCount", len(release.Status.Resources))
+		"resourceCount", len(resources))
 
 	// If Release has no resources yet, check if there's an apply error on the Release
-	if len(release.Status.Resources) == 0 {
+	if len(resources) == 0 {
 		// Check if the Release controller recorded an apply failure for the current generation
 		applyCond := meta.FindStatusCondition(release.Status.Conditions, renderedrelease.ConditionResourcesApplied)
 		if applyCond != nil && applyCond.Status == metav1.ConditionFalse &&
@@ -104,28 +108,39 @@ func (r *Reconciler) setResourcesReadyStatus(
 
 	switch workloadType {
 	case WorkloadTypeDeployment:
-		ready, reason, message = evaluateDeploymentStatus(release.Status.Resources, workloadType)
+		ready, reason, message = evaluateDeploymentStatus(resources, workloadType)
 
 	case WorkloadTypeStatefulSet:
-		ready, reason, message = evaluateStatefulSetStatus(release.Status.Resources, workloadType)
+		ready, reason, message = evaluateStatefulSetStatus(resources, workloadType)
 
 	case WorkloadTypeCronJob:
-		ready, reason, message = evaluateCronJobStatus(release.Status.Resources, workloadType)
+		ready, reason, message = evaluateCronJobStatus(resources, workloadType)
 
 	case WorkloadTypeJob:
-		ready, reason, message = evaluateJobStatus(release.Status.Resources, workloadType)
+		ready, reason, message = evaluateJobStatus(resources, workloadType)
 
 	case WorkloadTypeProxy:
 		// Proxy components are generic resources without traditional workload semantics
-		ready, reason, message = evaluateGenericStatus(release.Status.Resources)
+		ready, reason, message = evaluateGenericStatus(resources)
 
 	case WorkloadTypeUnknown:
 		// Fallback for unknown workload types or legacy components
-		ready, reason, message = evaluateGenericStatus(release.Status.Resources)
+		ready, reason, message = evaluateGenericStatus(resources)
 		logger.Info("Using generic status evaluation for unknown workload type",
 			"componentType", componentTypeName)
 	}
 
+	// Report a diagnosed pod failure instead of a generic degraded status
+	if !ready {
+		if resource := findDiagnosedResource(resources, workloadType); resource != nil {
+			releaseBinding.Status.Diagnosis = resource.Diagnosis.DeepCopy()
+			if reason == string(ReasonResourcesDegraded) {
+				reason = string(resource.Diagnosis.Reason)
+				message = fmt.Sprintf("%s %s: %s", resource.Kind, resource.Name, resource.Diagnosis.Message)
+			}
+		}
+	}
+
 	// Set the ResourcesReady condition
 	if ready {
 		controller.MarkTrueCondition(releaseBinding, ConditionResourcesReady,
@@ -139,12 +154,15 @@ func (r *Reconciler) setResourcesReadyStatus(
 }
 
 // setReadyCondition sets the top-level Ready condition based on ReleaseSynced,
-// ResourcesReady, ConnectionsResolved, and ResourceDependenciesReady conditions.
-// ConnectionsResolved and ResourceDependenciesReady are optional — workloads without
-// the corresponding dependency type don't carry the condition and shouldn't be blocked.
+// ResourcesReady, ConnectionsResolved, ResourceDependenciesReady, HooksSucceeded and
+// WorkloadIdentityBound conditions. ConnectionsResolved, ResourceDependenciesReady,
+// HooksSucceeded and WorkloadIdentityBound are optional — bindings without dependencies of
+// the corresponding type, lifecycle hooks or a workload identity don't carry the condition
+// and shouldn't be blocked.
 func (r *Reconciler) setReadyCondition(releaseBinding *openchoreov1alpha1.ReleaseBinding) {
 	// Find all relevant conditions
-	var releaseSynced, resourcesReady, connectionsResolved, resourceDependenciesReady *metav1.Condition
+	var releaseSynced, resourcesReady, connectionsResolved, resourceDependenciesReady, hooksSucceeded,
+		workloadIdentityBound *metav1.Condition
 	for i := range releaseBinding.Status.Conditions {
 		switch releaseBinding.Status.Conditions[i].Type {
 		case string(ConditionReleaseSynced):
@@ -155,15 +173,22 @@ func (r *Reconciler) setReadyCondition(releaseBinding *openchoreov1alpha1.Releas
 			connectionsResolved = &releaseBinding.Status.Conditions[i]
 		case string(ConditionResourceDependenciesReady):
 			resourceDependenciesReady = &releaseBinding.Status.Conditions[i]
+		case string(ConditionHooksSucceeded):
+			hooksSucceeded = &releaseBinding.Status.Conditions[i]
+		case string(ConditionWorkloadIdentityBound):
+			workloadIdentityBound = &releaseBinding.Status.Conditions[i]
 		}
 	}
 
 	// All present conditions must be True for Ready to be True.
-	// ConnectionsResolved and ResourceDependenciesReady are optional — absent = pass.
+	// ConnectionsResolved, ResourceDependenciesReady, HooksSucceeded and WorkloadIdentityBound
+	// are optional — absent = pass.
 	allTrue := releaseSynced != nil && releaseSynced.Status == metav1.ConditionTrue &&
 		resourcesReady != nil && resourcesReady.Status == metav1.ConditionTrue &&
 		(connectionsResolved == nil || connectionsResolved.Status == metav1.ConditionTrue) &&
-		(resourceDependenciesReady == nil || resourceDependenciesReady.Status == metav1.ConditionTrue)
+		(resourceDependenciesReady == nil || resourceDependenciesReady.Status == metav1.ConditionTrue) &&
+		(hooksSucceeded == nil || hooksSucceeded.Status == metav1.ConditionTrue) &&
+		(workloadIdentityBound == nil || workloadIdentityBound.Status == metav1.ConditionTrue)
 
 	if allTrue {
 		controller.MarkTrueCondition(releaseBinding, ConditionReady,
@@ -185,7 +210,8 @@ func (r *Reconciler) setReadyCondition(releaseBinding *openchoreov1alpha1.Releas
 
 	// Priority order when multiple sub-conditions are False is a UX choice, locked by
 	// tests below: ConnectionsResolved is reported above ResourceDependenciesReady, which
-	// is reported above ResourcesReady.
+	// is reported above HooksSucceeded, which is reported above ResourcesReady, which is
+	// reported above WorkloadIdentityBound.
 	if connectionsResolved != nil && connectionsResolved.Status != metav1.ConditionTrue {
 		controller.MarkFalseCondition(releaseBinding, ConditionReady,
 			controller.ConditionReason(connectionsResolved.Reason), connectionsResolved.Message)
@@ -199,14 +225,47 @@ func (r *Reconciler) setReadyCondition(releaseBinding *openchoreov1alpha1.Releas
 		return
 	}
 
-	// If ResourcesReady is not True, use its reason
-	if resourcesReady != nil {
+	// If HooksSucceeded is False, use its reason: the rollout waits for or failed a hook
+	if hooksSucceeded != nil && hooksSucceeded.Status != metav1.ConditionTrue {
 		controller.MarkFalseCondition(releaseBinding, ConditionReady,
-			controller.ConditionReason(resourcesReady.Reason), resourcesReady.Message)
-	} else {
+			controller.ConditionReason(hooksSucceeded.Reason), hooksSucceeded.Message)
+		return
+	}
+
+	// If ResourcesReady is not True, use its reason
+	if resourcesReady == nil {
 		controller.MarkFalseCondition(releaseBinding, ConditionReady,
 			ReasonResourcesProgressing, "Resources are being evaluated")
+		return
+	}
+	if resourcesReady.Status != metav1.ConditionTrue {
+		controller.MarkFalseCondition(releaseBinding, ConditionReady,
+			controller.ConditionReason(resourcesReady.Reason), resourcesReady.Message)
+		return
+	}
+
+	// Otherwise the workloads run, but without their cloud identity
+	controller.MarkFalseCondition(releaseBinding, ConditionReady,
+		controller.ConditionReason(workloadIdentityBound.Reason), workloadIdentityBound.Message)
+}
+
+// findDiagnosedResource returns the resource whose pod failure diagnosis explains why the
+// binding is not ready, preferring the primary workload over other workloads.
+func findDiagnosedResource(resources []openchoreov1alpha1.RenderedManifestStatus, workloadType WorkloadType) *openchoreov1alpha1.RenderedManifestStatus {
+	var diagnosed *openchoreov1alpha1.RenderedManifestStatus
+	for i := range resources {
+		res := &resources[i]
+		if res.Diagnosis == nil {
+			continue
+		}
+		if isPrimaryWorkload(schema.GroupVersionKind{Group: res.Group, Version: res.Version, Kind: res.Kind}, workloadType) {
+			return res
+		}
+		if diagnosed == nil {
+			diagnosed = res
+		}
 	}
+	return diagnosed
 }
 
 // categorizeResource determines the category of a resource based on its GVK and workload type.
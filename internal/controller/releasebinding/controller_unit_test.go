@@ -114,6 +114,19 @@ func TestValidateComponentRelease_NilResources(t *testing.T) {
 	}
 }
 
+func TestValidateComponentRelease_Library(t *testing.T) {
+	r := newTestReconciler()
+	cr := makeValidComponentRelease(testProjectName, testComponentName)
+	cr.Spec.ComponentType.Name = "library/go-module"
+	cr.Spec.ComponentType.Spec = openchoreov1alpha1.ComponentTypeSpec{WorkloadType: openchoreov1alpha1.WorkloadTypeLibrary}
+	rb := makeValidReleaseBinding(testProjectName, testComponentName)
+
+	err := r.validateComponentRelease(cr, rb)
+	if err == nil || !strings.Contains(err.Error(), "library components cannot be deployed") {
+		t.Errorf("validateComponentRelease error = %v, want library components cannot be deployed", err)
+	}
+}
+
 func TestValidateComponentRelease_MissingProjectName(t *testing.T) {
 	r := newTestReconciler()
 
@@ -555,6 +568,7 @@ func TestExtractWorkloadType_KnownTypes(t *testing.T) {
 		{"cronjob/nightly-task", WorkloadTypeCronJob},
 		{"job/migration", WorkloadTypeJob},
 		{"proxy/my-proxy", WorkloadTypeProxy},
+		{"library/go-module", WorkloadTypeLibrary},
 		{"", WorkloadTypeUnknown},
 		{"unknown/something", WorkloadTypeUnknown},
 		{"deployment", WorkloadTypeDeployment}, // no slash — first part only
@@ -1421,6 +1435,80 @@ func TestSetResourcesReadyStatus_StatefulSetDegraded(t *testing.T) {
 	}
 }
 
+func TestSetResourcesReadyStatus_DegradedWithDiagnosis(t *testing.T) {
+	r := newTestReconciler()
+	rb := makeReleaseBindingForConditions()
+	diagnosis := &openchoreov1alpha1.WorkloadDiagnosis{
+		Reason:    openchoreov1alpha1.WorkloadFailureCrashLoopBackOff,
+		Message:   `Container "main" is crash looping after 3 restarts, last exit code 1: missing DB_URL`,
+		Pod:       "app-1",
+		Container: "main",
+	}
+	release := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-release"},
+		Status: openchoreov1alpha1.RenderedReleaseStatus{
+			Resources: []openchoreov1alpha1.RenderedManifestStatus{
+				{Group: "apps", Version: "v1", Kind: "StatefulSet", Name: "cache", HealthStatus: openchoreov1alpha1.HealthStatusDegraded,
+					Diagnosis: &openchoreov1alpha1.WorkloadDiagnosis{Reason: openchoreov1alpha1.WorkloadFailureOOMKilled, Message: "oom"}},
+				{Group: "apps", Version: "v1", Kind: "Deployment", Name: "app", HealthStatus: openchoreov1alpha1.HealthStatusDegraded,
+					Diagnosis: diagnosis},
+			},
+		},
+	}
+	comp := &openchoreov1alpha1.Component{
+		Spec: openchoreov1alpha1.ComponentSpec{
+			ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: "deployment/my-svc"},
+		},
+	}
+
+	// The Deployment is the primary workload; its diagnosis is preferred.
+	err := r.setResourcesReadyStatus(testContext(), rb, release, comp)
+	require.NoError(t, err)
+
+	cond := findCondition(rb.Status.Conditions, string(ConditionResourcesReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonCrashLoopBackOff), cond.Reason)
+	assert.Equal(t, "Deployment app: "+diagnosis.Message, cond.Message)
+	assert.Equal(t, diagnosis, rb.Status.Diagnosis)
+	assert.True(t, deploymentFailureReasons[cond.Reason])
+
+	// The diagnosis is cleared once the workload recovers.
+	for i := range release.Status.Resources {
+		release.Status.Resources[i].HealthStatus = openchoreov1alpha1.HealthStatusHealthy
+		release.Status.Resources[i].Diagnosis = nil
+	}
+	require.NoError(t, r.setResourcesReadyStatus(testContext(), rb, release, comp))
+	assert.Nil(t, rb.Status.Diagnosis)
+}
+
+func TestSetResourcesReadyStatus_ProgressingWithDiagnosis(t *testing.T) {
+	r := newTestReconciler()
+	rb := makeReleaseBindingForConditions()
+	release := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-release"},
+		Status: openchoreov1alpha1.RenderedReleaseStatus{
+			Resources: []openchoreov1alpha1.RenderedManifestStatus{
+				{Group: "apps", Version: "v1", Kind: "Deployment", Name: "app", HealthStatus: openchoreov1alpha1.HealthStatusProgressing,
+					Diagnosis: &openchoreov1alpha1.WorkloadDiagnosis{Reason: openchoreov1alpha1.WorkloadFailureImagePullFailed, Message: "401"}},
+			},
+		},
+	}
+	comp := &openchoreov1alpha1.Component{
+		Spec: openchoreov1alpha1.ComponentSpec{
+			ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: "deployment/my-svc"},
+		},
+	}
+
+	// A rollout that is still progressing keeps its reason but reports the diagnosis.
+	require.NoError(t, r.setResourcesReadyStatus(testContext(), rb, release, comp))
+	cond := findCondition(rb.Status.Conditions, string(ConditionResourcesReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonResourcesProgressing), cond.Reason)
+	require.NotNil(t, rb.Status.Diagnosis)
+	assert.Equal(t, openchoreov1alpha1.WorkloadFailureImagePullFailed, rb.Status.Diagnosis.Reason)
+}
+
 func TestSetResourcesReadyStatus_CronJobScheduled(t *testing.T) {
 	r := newTestReconciler()
 	rb := makeReleaseBindingForConditions()
@@ -1727,6 +1815,47 @@ func TestBuildMetadataContext_LabelContent(t *testing.T) {
 	}
 }
 
+func TestBuildMetadataContext_CatalogMetadata(t *testing.T) {
+	r := newTestReconciler()
+
+	componentRelease := &openchoreov1alpha1.ComponentRelease{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns"},
+		Spec: openchoreov1alpha1.ComponentReleaseSpec{
+			Owner: openchoreov1alpha1.ComponentReleaseOwner{ProjectName: "test-proj", ComponentName: "test-comp"},
+		},
+	}
+	component := &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{UID: "comp-uid-aaa"},
+		Spec: openchoreov1alpha1.ComponentSpec{
+			Catalog: &openchoreov1alpha1.ComponentCatalog{
+				Team:       "payments",
+				Tier:       "tier-1",
+				Repository: "https://github.com/example/checkout",
+			},
+		},
+	}
+
+	mctx := r.buildMetadataContext(componentRelease, component, &openchoreov1alpha1.Project{},
+		&openchoreov1alpha1.DataPlane{}, &openchoreov1alpha1.Environment{}, "test-env")
+
+	if got := mctx.Labels[labels.LabelKeyTeam]; got != "payments" {
+		t.Errorf("Labels[%q] = %q, want %q", labels.LabelKeyTeam, got, "payments")
+	}
+	if got := mctx.Labels[labels.LabelKeyTier]; got != "tier-1" {
+		t.Errorf("Labels[%q] = %q, want %q", labels.LabelKeyTier, got, "tier-1")
+	}
+	if _, ok := mctx.Labels[labels.LabelKeyDomain]; ok {
+		t.Errorf("Labels should not contain %q when the domain is unset", labels.LabelKeyDomain)
+	}
+	if _, ok := mctx.PodSelectors[labels.LabelKeyTeam]; ok {
+		t.Error("PodSelectors should not contain catalog labels")
+	}
+	want := map[string]string{labels.AnnotationKeyRepository: "https://github.com/example/checkout"}
+	if len(mctx.Annotations) != 1 || mctx.Annotations[labels.AnnotationKeyRepository] != want[labels.AnnotationKeyRepository] {
+		t.Errorf("Annotations = %v, want %v", mctx.Annotations, want)
+	}
+}
+
 // findCondition is a test helper that looks up a condition by type.
 func findCondition(conditions []metav1.Condition, condType string) *metav1.Condition {
 	for i := range conditions {
@@ -2443,3 +2572,61 @@ func TestCollectSecretReferences_NonOverriddenWorkloadEntryStillCollected(t *tes
 	assert.NotNil(t, refs["wl-secret"], "expected wl-secret for non-overridden DB_USER")
 	assert.NotNil(t, refs["rb-secret"], "expected rb-secret for overridden DB_PASS")
 }
+
+func TestResolveAsyncEndpointURLs(t *testing.T) {
+	endpoints := map[string]openchoreov1alpha1.WorkloadEndpoint{
+		"http": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 8080},
+		"orders": {
+			Type:  openchoreov1alpha1.EndpointTypeKafka,
+			Port:  9092,
+			Async: &openchoreov1alpha1.AsyncEndpoint{Channel: "shop.orders", Broker: "events-kafka-bootstrap.kafka"},
+		},
+		"audit": {
+			Type:  openchoreov1alpha1.EndpointTypeNATS,
+			Port:  4222,
+			Async: &openchoreov1alpha1.AsyncEndpoint{Channel: "audit.>", Broker: "nats.messaging"},
+		},
+	}
+
+	served, async := splitAsyncEndpoints(endpoints)
+	assert.Len(t, served, 1)
+	assert.Contains(t, served, "http")
+	assert.Len(t, async, 2)
+
+	existing := []openchoreov1alpha1.EndpointURLStatus{{Name: "http", Type: openchoreov1alpha1.EndpointTypeHTTP}}
+	got := resolveAsyncEndpointURLs(async, existing)
+	require.Len(t, got, 3)
+	assert.Equal(t, "http", got[0].Name)
+	assert.Equal(t, openchoreov1alpha1.EndpointURLStatus{
+		Name: "audit",
+		Type: openchoreov1alpha1.EndpointTypeNATS,
+		ServiceURL: &openchoreov1alpha1.EndpointURL{
+			Scheme: "nats", Host: "nats.messaging", Port: 4222, Channel: "audit.>",
+		},
+	}, got[1])
+	assert.Equal(t, "orders", got[2].Name)
+	assert.Equal(t, "kafka", got[2].ServiceURL.Scheme)
+}
+
+func TestBuildEnvVarsForConnection_AsyncEndpoint(t *testing.T) {
+	conn := openchoreov1alpha1.WorkloadConnection{
+		Component: "checkout",
+		Name:      "orders",
+		EnvBindings: openchoreov1alpha1.ConnectionEnvBindings{
+			Address: "KAFKA_BOOTSTRAP_SERVERS",
+			Channel: "ORDERS_TOPIC",
+		},
+	}
+	rc := openchoreov1alpha1.ResolvedConnection{
+		URL: openchoreov1alpha1.EndpointURL{Scheme: "kafka", Host: "events-kafka-bootstrap.kafka", Port: 9092, Channel: "shop.orders"},
+	}
+
+	envVars := buildEnvVarsForConnection(conn, rc)
+	assert.Equal(t, []string{"KAFKA_BOOTSTRAP_SERVERS", "ORDERS_TOPIC"}, []string{envVars[0].Name, envVars[1].Name})
+	// Kafka clients expect bootstrap servers as host:port.
+	assert.Equal(t, "events-kafka-bootstrap.kafka:9092", envVars[0].Value)
+	assert.Equal(t, "shop.orders", envVars[1].Value)
+
+	rc.URL.Scheme = "amqp"
+	assert.Equal(t, "amqp://events-kafka-bootstrap.kafka:9092", formatEndpointAddress(rc.URL))
+}
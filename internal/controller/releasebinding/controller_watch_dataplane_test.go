@@ -148,3 +148,17 @@ func TestFindReleaseBindingsForClusterDataPlane(t *testing.T) {
 		&openchoreov1alpha1.ClusterDataPlane{ObjectMeta: metav1.ObjectMeta{Name: "cdp"}})
 	assert.ElementsMatch(t, []string{"rb-1", "rb-2"}, requestNames(reqs))
 }
+
+// TestFindReleaseBindingsForEnvironment: an Environment change enqueues the bindings that
+// target it within its namespace.
+func TestFindReleaseBindingsForEnvironment(t *testing.T) {
+	r := newReconcilerWith(t,
+		bindingFor("org", "rb-prod", "prod"),
+		bindingFor("org", "rb-dev", "dev"),
+		bindingFor("other", "rb-other-prod", "prod"),
+	)
+
+	reqs := r.findReleaseBindingsForEnvironment(context.Background(),
+		&openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Namespace: "org", Name: "prod"}})
+	assert.ElementsMatch(t, []string{"rb-prod"}, requestNames(reqs))
+}
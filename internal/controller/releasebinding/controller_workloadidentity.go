@@ -0,0 +1,69 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/workloadidentity"
+)
+
+// bindWorkloadIdentity resolves the binding of the component's workloads to the cloud identity
+// requested by its ComponentType, verifying the identity against the data plane's provider.
+// It returns a nil binding when the type requests none, or when the request is optional and
+// the data plane has no provider. When the binding cannot be established, ReleaseSynced and
+// WorkloadIdentityBound are marked False and ok is false.
+func bindWorkloadIdentity(rb *openchoreov1alpha1.ReleaseBinding, request *openchoreov1alpha1.WorkloadIdentityRequest,
+	identity string, config *openchoreov1alpha1.WorkloadIdentityConfig, namespace, componentName string,
+) (binding *workloadidentity.Binding, ok bool) {
+	if request == nil {
+		return nil, true
+	}
+	if config == nil && request.Optional {
+		return nil, true
+	}
+
+	binding, err := workloadidentity.Resolve(config, identity, namespace, componentName)
+	if err != nil {
+		reason := ReasonWorkloadIdentityInvalid
+		msg := fmt.Sprintf("Workload identity %q cannot be bound: %v", identity, err)
+		if errors.Is(err, workloadidentity.ErrNotConfigured) {
+			reason = ReasonWorkloadIdentityUnavailable
+			msg = fmt.Sprintf("The component type requests workload identity %q, but %v", identity, err)
+		}
+		controller.MarkFalseCondition(rb, ConditionWorkloadIdentityBound, reason, msg)
+		controller.MarkFalseCondition(rb, ConditionReleaseSynced, reason, msg)
+		return nil, false
+	}
+	return binding, true
+}
+
+// setWorkloadIdentityStatus sets the WorkloadIdentityBound condition from the resources applied
+// by the data plane release: the binding holds once its ServiceAccount is applied. The
+// condition is removed when there is no binding.
+func setWorkloadIdentityStatus(rb *openchoreov1alpha1.ReleaseBinding, binding *workloadidentity.Binding,
+	applied []openchoreov1alpha1.RenderedManifestStatus) {
+	if binding == nil {
+		meta.RemoveStatusCondition(&rb.Status.Conditions, string(ConditionWorkloadIdentityBound))
+		return
+	}
+
+	for _, resource := range applied {
+		if resource.Group == "" && resource.Kind == "ServiceAccount" &&
+			resource.Namespace == binding.Namespace && resource.Name == binding.ServiceAccountName {
+			controller.MarkTrueCondition(rb, ConditionWorkloadIdentityBound, ReasonWorkloadIdentityBound,
+				fmt.Sprintf("ServiceAccount %s/%s is bound to %s identity %s, which must trust %s",
+					binding.Namespace, binding.ServiceAccountName, binding.Provider, binding.CloudIdentity, binding.Subject))
+			return
+		}
+	}
+	controller.MarkFalseCondition(rb, ConditionWorkloadIdentityBound, ReasonWorkloadIdentityPending,
+		fmt.Sprintf("Waiting for ServiceAccount %s/%s bound to %s identity %s to be applied",
+			binding.Namespace, binding.ServiceAccountName, binding.Provider, binding.CloudIdentity))
+}
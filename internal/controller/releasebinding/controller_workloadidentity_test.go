@@ -0,0 +1,119 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/workloadidentity"
+)
+
+var testIdentityConfig = &openchoreov1alpha1.WorkloadIdentityConfig{
+	Provider: openchoreov1alpha1.WorkloadIdentityProviderAWS,
+	AWS:      &openchoreov1alpha1.AWSWorkloadIdentity{AccountID: "123456789012"},
+}
+
+func TestBindWorkloadIdentity(t *testing.T) {
+	request := &openchoreov1alpha1.WorkloadIdentityRequest{Identity: "${metadata.componentName}"}
+
+	t.Run("no request", func(t *testing.T) {
+		rb := makeReleaseBindingForConditions()
+		binding, ok := bindWorkloadIdentity(rb, nil, "", testIdentityConfig, "dp-ns", "payments")
+		assert.True(t, ok)
+		assert.Nil(t, binding)
+		assert.Empty(t, rb.Status.Conditions)
+	})
+
+	t.Run("bound", func(t *testing.T) {
+		rb := makeReleaseBindingForConditions()
+		binding, ok := bindWorkloadIdentity(rb, request, "payments", testIdentityConfig, "dp-ns", "payments")
+		require.True(t, ok)
+		assert.Equal(t, "arn:aws:iam::123456789012:role/payments", binding.CloudIdentity)
+		assert.Equal(t, workloadidentity.ServiceAccountName("payments"), binding.ServiceAccountName)
+	})
+
+	t.Run("data plane without provider", func(t *testing.T) {
+		rb := makeReleaseBindingForConditions()
+		_, ok := bindWorkloadIdentity(rb, request, "payments", nil, "dp-ns", "payments")
+		require.False(t, ok)
+		for _, condType := range []string{string(ConditionWorkloadIdentityBound), string(ConditionReleaseSynced)} {
+			cond := meta.FindStatusCondition(rb.Status.Conditions, condType)
+			require.NotNil(t, cond, condType)
+			assert.Equal(t, metav1.ConditionFalse, cond.Status)
+			assert.Equal(t, string(ReasonWorkloadIdentityUnavailable), cond.Reason)
+		}
+	})
+
+	t.Run("optional request on a data plane without provider", func(t *testing.T) {
+		rb := makeReleaseBindingForConditions()
+		optional := &openchoreov1alpha1.WorkloadIdentityRequest{Identity: "payments", Optional: true}
+		binding, ok := bindWorkloadIdentity(rb, optional, "payments", nil, "dp-ns", "payments")
+		assert.True(t, ok)
+		assert.Nil(t, binding)
+	})
+
+	t.Run("role of another account", func(t *testing.T) {
+		rb := makeReleaseBindingForConditions()
+		_, ok := bindWorkloadIdentity(rb, request, "arn:aws:iam::210987654321:role/payments", testIdentityConfig, "dp-ns", "payments")
+		require.False(t, ok)
+		cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionReleaseSynced))
+		require.NotNil(t, cond)
+		assert.Equal(t, string(ReasonWorkloadIdentityInvalid), cond.Reason)
+		assert.Contains(t, cond.Message, "belongs to account 210987654321")
+	})
+}
+
+func TestSetWorkloadIdentityStatus(t *testing.T) {
+	binding, err := workloadidentity.Resolve(testIdentityConfig, "payments", "dp-ns", "payments")
+	require.NoError(t, err)
+
+	rb := makeReleaseBindingForConditions()
+	setWorkloadIdentityStatus(rb, binding, []openchoreov1alpha1.RenderedManifestStatus{
+		{Group: "apps", Version: "v1", Kind: "Deployment", Name: "payments", Namespace: "dp-ns"},
+	})
+	cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionWorkloadIdentityBound))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonWorkloadIdentityPending), cond.Reason)
+
+	setWorkloadIdentityStatus(rb, binding, []openchoreov1alpha1.RenderedManifestStatus{
+		{Version: "v1", Kind: "ServiceAccount", Name: binding.ServiceAccountName, Namespace: "dp-ns"},
+	})
+	cond = meta.FindStatusCondition(rb.Status.Conditions, string(ConditionWorkloadIdentityBound))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Contains(t, cond.Message, "arn:aws:iam::123456789012:role/payments")
+	assert.Contains(t, cond.Message, "system:serviceaccount:dp-ns:"+binding.ServiceAccountName)
+
+	setWorkloadIdentityStatus(rb, nil, nil)
+	assert.Nil(t, meta.FindStatusCondition(rb.Status.Conditions, string(ConditionWorkloadIdentityBound)))
+}
+
+func TestSetReadyCondition_WorkloadIdentityNotBound(t *testing.T) {
+	r := newTestReconciler()
+	rb := makeReleaseBindingForConditions()
+
+	setConditionOnRB(rb, string(ConditionReleaseSynced), metav1.ConditionTrue, string(ReasonReleaseSynced), "synced")
+	setConditionOnRB(rb, string(ConditionResourcesReady), metav1.ConditionTrue, string(ReasonReady), "ready")
+	setConditionOnRB(rb, string(ConditionWorkloadIdentityBound), metav1.ConditionFalse, string(ReasonWorkloadIdentityPending), "waiting")
+
+	r.setReadyCondition(rb)
+
+	cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonWorkloadIdentityPending), cond.Reason)
+
+	setConditionOnRB(rb, string(ConditionWorkloadIdentityBound), metav1.ConditionTrue, string(ReasonWorkloadIdentityBound), "bound")
+	r.setReadyCondition(rb)
+	cond = meta.FindStatusCondition(rb.Status.Conditions, string(ConditionReady))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+}
@@ -30,6 +30,9 @@ const (
 	schemeTCP   = "tcp"
 	schemeTLS   = "tls"
 	schemeUDP   = "udp"
+	schemeKafka = "kafka"
+	schemeNATS  = "nats"
+	schemeAMQP  = "amqp"
 )
 
 // serviceInfo holds the name, namespace, and ports extracted from a rendered K8s Service resource.
@@ -54,7 +57,7 @@ func extractAllServiceInfos(resources []openchoreov1alpha1.RenderedManifest) []s
 			continue
 		}
 
-		if obj.GetAPIVersion() != "v1" || obj.GetKind() != "Service" {
+		if obj.GetAPIVersion() != "v1" || obj.GetKind() != "Service" || isRolloutCandidate(obj.GetLabels()) {
 			continue
 		}
 
@@ -219,7 +222,59 @@ func schemeForEndpointType(epType openchoreov1alpha1.EndpointType) string {
 		return schemeTCP
 	case openchoreov1alpha1.EndpointTypeUDP:
 		return schemeUDP
+	case openchoreov1alpha1.EndpointTypeKafka:
+		return schemeKafka
+	case openchoreov1alpha1.EndpointTypeNATS:
+		return schemeNATS
+	case openchoreov1alpha1.EndpointTypeAMQP:
+		return schemeAMQP
 	default:
 		return schemeHTTP
 	}
 }
+
+// splitAsyncEndpoints separates the asynchronous endpoints, which are served by a message
+// broker, from the endpoints served by the component itself.
+func splitAsyncEndpoints(endpoints map[string]openchoreov1alpha1.WorkloadEndpoint) (served, async map[string]openchoreov1alpha1.WorkloadEndpoint) {
+	served = make(map[string]openchoreov1alpha1.WorkloadEndpoint, len(endpoints))
+	async = make(map[string]openchoreov1alpha1.WorkloadEndpoint)
+	for name, ep := range endpoints {
+		if ep.Type.IsAsync() {
+			async[name] = ep
+		} else {
+			served[name] = ep
+		}
+	}
+	return served, async
+}
+
+// resolveAsyncEndpointURLs appends an EndpointURLStatus for each asynchronous endpoint whose
+// ServiceURL points at the broker and carries the channel, so that connections to the
+// endpoint resolve like those to a Service. The entries are appended sorted by name.
+func resolveAsyncEndpointURLs(
+	endpoints map[string]openchoreov1alpha1.WorkloadEndpoint,
+	existing []openchoreov1alpha1.EndpointURLStatus,
+) []openchoreov1alpha1.EndpointURLStatus {
+	names := make([]string, 0, len(endpoints))
+	for name, ep := range endpoints {
+		if ep.Async != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ep := endpoints[name]
+		existing = append(existing, openchoreov1alpha1.EndpointURLStatus{
+			Name: name,
+			Type: ep.Type,
+			ServiceURL: &openchoreov1alpha1.EndpointURL{
+				Scheme:  schemeForEndpointType(ep.Type),
+				Host:    ep.Async.Broker,
+				Port:    ep.Port,
+				Channel: ep.Async.Channel,
+			},
+		})
+	}
+	return existing
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+)
+
+// Delivery metrics exposed on the controller manager's metrics endpoint. Together they
+// provide the DORA metrics: deployment frequency, lead time, change failure rate and
+// time to restore service. The rendered release metrics track the size of renders
+// against the pipeline's render limits.
+var (
+	deploymentsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openchoreo_deployments_total",
+			Help: "Number of ComponentReleases bound to an environment.",
+		},
+		[]string{"namespace", "project", "component", "environment"},
+	)
+
+	deploymentLeadTimeSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "openchoreo_deployment_lead_time_seconds",
+			Help: "Time from ComponentRelease creation to its deployment to an environment.",
+			// 1m to ~2.9 weeks.
+			Buckets: prometheus.ExponentialBuckets(60, 3, 11),
+		},
+		[]string{"namespace", "project", "environment"},
+	)
+
+	deploymentFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openchoreo_deployment_failures_total",
+			Help: "Number of deployments that failed or were rolled back.",
+		},
+		[]string{"namespace", "project", "component", "environment"},
+	)
+
+	deploymentRestoreTimeSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "openchoreo_deployment_restore_time_seconds",
+			Help: "Time from a deployment failure until the ReleaseBinding is Ready again.",
+			// 30s to ~20 days.
+			Buckets: prometheus.ExponentialBuckets(30, 3, 11),
+		},
+		[]string{"namespace", "project", "environment"},
+	)
+
+	renderedReleaseResources = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "openchoreo_rendered_release_resources",
+			Help: "Number of resources in a rendered release.",
+			// 1 to 2048.
+			Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+		},
+		[]string{"namespace", "project", "environment"},
+	)
+
+	renderedReleaseBytes = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "openchoreo_rendered_release_bytes",
+			Help: "Total JSON size of the resources in a rendered release.",
+			// 1KiB to 2MiB.
+			Buckets: prometheus.ExponentialBuckets(1024, 2, 12),
+		},
+		[]string{"namespace", "project", "environment"},
+	)
+
+	renderLimitExceededTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openchoreo_render_limit_exceeded_total",
+			Help: "Number of renders rejected because the rendered release exceeded a limit.",
+		},
+		[]string{"namespace", "project", "component", "environment", "limit"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		deploymentsTotal,
+		deploymentLeadTimeSeconds,
+		deploymentFailuresTotal,
+		deploymentRestoreTimeSeconds,
+		renderedReleaseResources,
+		renderedReleaseBytes,
+		renderLimitExceededTotal,
+	)
+}
+
+func observeDeployment(rb *openchoreov1alpha1.ReleaseBinding, releaseCreatedAt, deployedAt metav1.Time) {
+	deploymentsTotal.WithLabelValues(rb.Namespace, rb.Spec.Owner.ProjectName,
+		rb.Spec.Owner.ComponentName, rb.Spec.Environment).Inc()
+	if !releaseCreatedAt.IsZero() && !deployedAt.Before(&releaseCreatedAt) {
+		deploymentLeadTimeSeconds.WithLabelValues(rb.Namespace, rb.Spec.Owner.ProjectName, rb.Spec.Environment).
+			Observe(deployedAt.Sub(releaseCreatedAt.Time).Seconds())
+	}
+}
+
+func observeDeploymentFailure(rb *openchoreov1alpha1.ReleaseBinding) {
+	deploymentFailuresTotal.WithLabelValues(rb.Namespace, rb.Spec.Owner.ProjectName,
+		rb.Spec.Owner.ComponentName, rb.Spec.Environment).Inc()
+}
+
+func observeRestore(rb *openchoreov1alpha1.ReleaseBinding, failedAt, restoredAt metav1.Time) {
+	if restoredAt.Before(&failedAt) {
+		return
+	}
+	deploymentRestoreTimeSeconds.WithLabelValues(rb.Namespace, rb.Spec.Owner.ProjectName, rb.Spec.Environment).
+		Observe(restoredAt.Sub(failedAt.Time).Seconds())
+}
+
+func observeRenderedRelease(rb *openchoreov1alpha1.ReleaseBinding, metadata *componentpipeline.RenderMetadata) {
+	renderedReleaseResources.WithLabelValues(rb.Namespace, rb.Spec.Owner.ProjectName, rb.Spec.Environment).
+		Observe(float64(metadata.ResourceCount))
+	renderedReleaseBytes.WithLabelValues(rb.Namespace, rb.Spec.Owner.ProjectName, rb.Spec.Environment).
+		Observe(float64(metadata.RenderedBytes))
+}
+
+func observeLimitExceeded(rb *openchoreov1alpha1.ReleaseBinding, limit string) {
+	renderLimitExceededTotal.WithLabelValues(rb.Namespace, rb.Spec.Owner.ProjectName,
+		rb.Spec.Owner.ComponentName, rb.Spec.Environment, limit).Inc()
+}
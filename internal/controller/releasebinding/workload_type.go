@@ -16,12 +16,13 @@ const (
 	WorkloadTypeCronJob     WorkloadType = "cronjob"
 	WorkloadTypeJob         WorkloadType = "job"
 	WorkloadTypeProxy       WorkloadType = "proxy"
+	WorkloadTypeLibrary     WorkloadType = "library"
 	WorkloadTypeUnknown     WorkloadType = "unknown"
 )
 
 // extractWorkloadType extracts the workload type from ComponentType field.
 // ComponentType format: "deployment/http-service", "cronjob/scheduled-task", etc.
-// The pattern is validated as: ^(deployment|statefulset|cronjob|job|proxy)/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+// The pattern is validated as: ^(deployment|statefulset|cronjob|job|proxy|library)/[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 func extractWorkloadType(componentType string) WorkloadType {
 	if componentType == "" {
 		return WorkloadTypeUnknown
@@ -44,6 +45,8 @@ func extractWorkloadType(componentType string) WorkloadType {
 		return WorkloadTypeJob
 	case "proxy":
 		return WorkloadTypeProxy
+	case "library":
+		return WorkloadTypeLibrary
 	default:
 		return WorkloadTypeUnknown
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -16,14 +17,17 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/airgap"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/gitops"
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
@@ -44,6 +48,18 @@ const (
 	ReasonApplySucceeded = "ApplySucceeded"
 	// ReasonApplyFailed indicates one or more resources failed to apply
 	ReasonApplyFailed = "ApplyFailed"
+	// ReasonAirGapPolicyViolation indicates resources reference external artifacts without a mirror
+	ReasonAirGapPolicyViolation = "AirGapPolicyViolation"
+
+	// ConditionFieldOwnership indicates whether the fields of the applied resources are managed by
+	// OpenChoreo alone. When False, another field manager such as a GitOps tool or a kubectl user
+	// changed fields of the resources, which were taken back on the last apply.
+	ConditionFieldOwnership = "FieldOwnership"
+
+	// ReasonFieldOwnershipExclusive indicates no other field manager changed the applied resources
+	ReasonFieldOwnershipExclusive = "Exclusive"
+	// ReasonFieldManagerConflict indicates another field manager changed fields of the applied resources
+	ReasonFieldManagerConflict = "FieldManagerConflict"
 )
 
 // Reconciler reconciles a RenderedRelease object
@@ -54,6 +70,19 @@ type Reconciler struct {
 
 	// MaxConcurrentReconciles bounds parallel reconciles; 0 means the default (1).
 	MaxConcurrentReconciles int
+
+	// AirGap rewrites external artifact references through the configured mirrors before
+	// resources are applied. A nil policy leaves resources untouched.
+	AirGap *airgap.Policy
+
+	// GitOps adds the markers that let external GitOps tools ignore the applied resources and
+	// selects the field manager conflicts that are reported. A nil policy adds no markers and
+	// reports every conflict.
+	GitOps *gitops.Policy
+
+	// DriftScanInterval is the minimum time between two drift scans of a release, independent
+	// of how often the release is resynced. 0 scans on every reconcile.
+	DriftScanInterval time.Duration
 }
 
 // TODO: Optimize to apply resource only if spec has changed
@@ -125,6 +154,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
+	// While the environment migrates to another data plane, the resources are removed from the
+	// data plane it still references instead of being applied there.
+	if targetPlane != targetPlaneObservabilityPlane {
+		migrating, err := r.dataPlaneMigrationPending(ctx, release.Namespace, release.Spec.EnvironmentName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if migrating {
+			return r.undeployForMigration(ctx, old, release, planeClient)
+		}
+	}
+
 	// Get desired resources from spec
 	desiredResources, err := r.makeDesiredResources(release)
 	if err != nil {
@@ -132,6 +173,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Resolve external artifacts through the air-gap mirrors. A violation will not go away
+	// until the release changes, so it is recorded in status without requeueing.
+	if err := r.enforceAirGapPolicy(desiredResources); err != nil {
+		logger.Info("Release resources violate the air-gap policy", "error", err.Error())
+		if changed := controller.MarkFalseCondition(release, controller.ConditionType(ConditionResourcesApplied),
+			controller.ConditionReason(ReasonAirGapPolicyViolation), err.Error()); changed {
+			recordConditionHistory(old, release)
+			if statusErr := r.Status().Update(ctx, release); statusErr != nil {
+				logger.Error(statusErr, "Failed to update Release status with air-gap policy violation")
+				return ctrl.Result{}, statusErr
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// Ensure namespaces exist before applying resources on the observability plane only.
 	// The data plane's cell namespace is owned by the ProjectReleaseBinding, so the DP
 	// apply path must not regain implicit namespace creation. On the observability plane
@@ -144,15 +200,34 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
+	// Scan the applied resources for drift before applying them again, since the apply takes
+	// back drifted fields. Under the Notify policy the drifted resources are left as they are.
+	digest, err := resourcesDigest(desiredResources)
+	if err != nil {
+		logger.Error(err, "Failed to compute the digest of desired resources")
+		return ctrl.Result{}, err
+	}
+	drift, driftCurrent, err := r.detectDrift(ctx, planeClient, release, desiredResources, digest)
+	if err != nil {
+		logger.Error(err, "Failed to scan resources for drift", "targetPlane", targetPlane)
+		return ctrl.Result{}, err
+	}
+	resourcesToApply := desiredResources
+	if driftPolicy(release) == openchoreov1alpha1.DriftPolicyNotify {
+		resourcesToApply = withoutDrifted(desiredResources, drift)
+	}
+
 	// PHASE 1: Apply desired resources to the target plane
 	// This ensures all resources in the spec are created/updated with proper tracking labels
-	if err := r.applyResources(ctx, planeClient, desiredResources); err != nil {
+	conflicts, err := r.applyResources(ctx, planeClient, resourcesToApply)
+	if err != nil {
 		logger.Error(err, "Failed to apply resources to target plane", "targetPlane", targetPlane)
 		// Persist the apply error in Release status so upstream controllers (e.g., ReleaseBinding) can surface it
 		changed := controller.MarkFalseCondition(release, controller.ConditionType(ConditionResourcesApplied),
 			controller.ConditionReason(ReasonApplyFailed),
 			fmt.Sprintf("Failed to apply resources to target plane: %v", err))
 		if changed {
+			recordConditionHistory(old, release)
 			if statusErr := r.Status().Update(ctx, release); statusErr != nil {
 				logger.Error(statusErr, "Failed to update Release status with apply error")
 			}
@@ -160,9 +235,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	// Mark resources as successfully applied and persist to API
-	if changed := controller.MarkTrueCondition(release, controller.ConditionType(ConditionResourcesApplied),
-		controller.ConditionReason(ReasonApplySucceeded), "All resources applied successfully"); changed {
+	// Mark resources as successfully applied, report the fields other managers had taken over and
+	// the drifted resources, and persist to API
+	if driftPolicy(release) == openchoreov1alpha1.DriftPolicyNotify {
+		keepSkippedConflicts(old, conflicts, drift)
+	}
+	release.Status.AppliedDigest = digest
+	applied := controller.MarkTrueCondition(release, controller.ConditionType(ConditionResourcesApplied),
+		controller.ConditionReason(ReasonApplySucceeded), "All resources applied successfully")
+	drifted := false
+	if driftCurrent {
+		observeDrift(release, drift)
+		drifted = markDrift(release, drift)
+	}
+	if changed := markFieldOwnership(release, conflicts); changed || applied || drifted {
+		recordConditionHistory(old, release)
 		if statusErr := r.Status().Update(ctx, release); statusErr != nil {
 			logger.Error(statusErr, "Failed to update Release status with apply success")
 			return ctrl.Result{}, statusErr
@@ -188,9 +275,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Diagnose unhealthy workloads from their pods so that failures such as image pull
+	// errors and crash loops can be reported instead of a generic degraded status
+	diagnoses := r.diagnoseWorkloads(ctx, planeClient, liveResources)
+
 	// PHASE 4: Update status with applied resources inventory (done last after all operations)
 	// This maintains an inventory of what we applied for future cleanup operations
-	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources); err != nil || statusUpdated {
+	if statusUpdated, err := r.updateStatus(ctx, old, release, desiredResources, liveResources, diagnoses, conflicts, drift); err != nil || statusUpdated {
 		// Return after updating the status to ensure it is persisted before continuing
 		return ctrl.Result{}, err
 	}
@@ -205,10 +296,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 
+	// Stable resources are only resynced to detect drift, so the resync yields to reconciles
+	// of releases that changed.
 	requeueAfter := getStableRequeueInterval(release)
 	logger.Info("Successfully applied the Release resources to the target plane",
 		"targetPlane", targetPlane, "requeueAfter", requeueAfter)
-	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	return controller.ResyncAfter(requeueAfter), nil
 }
 
 // getDPClient gets the dataplane client for the specified environment
@@ -257,17 +350,39 @@ func (r *Reconciler) getOPClient(ctx context.Context, namespaceName string, envi
 	return opClient, nil
 }
 
-// applyResources applies the given resources to the target plane
-func (r *Reconciler) applyResources(ctx context.Context, planeClient client.Client, resources []*unstructured.Unstructured) error {
+// applyResources applies the given resources to the target plane. Fields that another field manager
+// took over are taken back, and the reported conflicts are returned keyed by resource ID.
+func (r *Reconciler) applyResources(ctx context.Context, planeClient client.Client, resources []*unstructured.Unstructured) (map[string][]openchoreov1alpha1.FieldManagerConflict, error) {
+	conflicts := make(map[string][]openchoreov1alpha1.FieldManagerConflict)
 	for _, obj := range resources {
 		resourceID := obj.GetLabels()[labels.LabelKeyRenderedReleaseResourceID]
 
-		// Apply the resource using server-side apply
-		if err := planeClient.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(ControllerName)); err != nil {
-			return fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
+		// Apply the resource using server-side apply. The first apply does not force ownership so
+		// that the server reports the fields other managers own; they are then taken back.
+		desired := obj.DeepCopy()
+		err := planeClient.Patch(ctx, obj, client.Apply, client.FieldOwner(ControllerName))
+		if apierrors.IsConflict(err) {
+			if found := r.fieldManagerConflicts(err); len(found) > 0 {
+				conflicts[resourceID] = found
+			}
+			obj.Object = desired.Object
+			err = planeClient.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(ControllerName))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
 		}
 	}
 
+	return conflicts, nil
+}
+
+// enforceAirGapPolicy rewrites the desired resources in place through the air-gap mirrors.
+func (r *Reconciler) enforceAirGapPolicy(resources []*unstructured.Unstructured) error {
+	for _, obj := range resources {
+		if err := r.AirGap.Enforce(obj.Object); err != nil {
+			return fmt.Errorf("%s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
 	return nil
 }
 
@@ -284,6 +399,10 @@ func (r *Reconciler) makeDesiredResources(release *openchoreov1alpha1.RenderedRe
 			return nil, fmt.Errorf("failed to unmarshal resource %s: %w", resource.ID, err)
 		}
 
+		// Add the markers for external GitOps tools before the tracking metadata so that the
+		// configuration cannot override it
+		r.GitOps.Mark(obj)
+
 		// Add tracking labels
 		resourceLabels := obj.GetLabels()
 		if resourceLabels == nil {
@@ -294,9 +413,21 @@ func (r *Reconciler) makeDesiredResources(release *openchoreov1alpha1.RenderedRe
 		resourceLabels[labels.LabelKeyRenderedReleaseUID] = string(release.UID)
 		resourceLabels[labels.LabelKeyRenderedReleaseName] = release.Name
 		resourceLabels[labels.LabelKeyRenderedReleaseNamespace] = release.Namespace
+		resourceLabels[labels.LabelKeyAppManagedBy] = labels.LabelValueAppManagedBy
 
 		obj.SetLabels(resourceLabels)
 
+		// Add annotations identifying the owning release and binding
+		resourceAnnotations := obj.GetAnnotations()
+		if resourceAnnotations == nil {
+			resourceAnnotations = make(map[string]string)
+		}
+		resourceAnnotations[labels.AnnotationKeyRenderedRelease] = release.Namespace + "/" + release.Name
+		if owner := metav1.GetControllerOf(release); owner != nil {
+			resourceAnnotations[labels.AnnotationKeyReleaseBinding] = owner.Kind + "/" + owner.Name
+		}
+		obj.SetAnnotations(resourceAnnotations)
+
 		if restartedAt != "" {
 			if err := injectRestartedAt(obj, restartedAt); err != nil {
 				return nil, fmt.Errorf("failed to inject restartedAt on resource %s: %w", resource.ID, err)
@@ -485,6 +616,12 @@ var wellKnownDataPlaneGVKs = []schema.GroupVersionKind{
 	{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "Gateway"},
 }
 
+// WellKnownDataPlaneGVKs returns a copy of the resource types that data-plane rendered
+// releases typically manage.
+func WellKnownDataPlaneGVKs() []schema.GroupVersionKind {
+	return slices.Clone(wellKnownDataPlaneGVKs)
+}
+
 // wellKnownObservabilityPlaneGVKs is the safety-net list for observability-plane rendered
 // releases. It is intentionally narrow: only the CRDs that the observability-plane agent
 // reconciles and has RBAC permission to list. Broad Kubernetes types (Services, Deployments,
@@ -655,6 +792,10 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.RenderedRelease{}).
 		Named("renderedrelease").
-		WithOptions(crcontroller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		WithOptions(crcontroller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			// Periodic resyncs are queued at low priority; see controller.ResyncAfter.
+			UsePriorityQueue: ptr.To(true),
+		}).
 		Complete(r)
 }
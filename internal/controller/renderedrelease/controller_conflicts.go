@@ -0,0 +1,101 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// maxReportedConflicts bounds the resources listed in the FieldOwnership condition message.
+const maxReportedConflicts = 3
+
+// fieldManagerConflicts extracts the conflicting field managers and fields from the error returned
+// by a server-side apply that did not force ownership. Managers ignored by the GitOps policy are
+// left out.
+func (r *Reconciler) fieldManagerConflicts(err error) []openchoreov1alpha1.FieldManagerConflict {
+	var statusErr apierrors.APIStatus
+	if !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return nil
+	}
+
+	fields := make(map[string][]string)
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		manager, ok := conflictManager(cause.Message)
+		if !ok || !r.GitOps.Reported(manager) {
+			continue
+		}
+		fields[manager] = append(fields[manager], cause.Field)
+	}
+
+	conflicts := make([]openchoreov1alpha1.FieldManagerConflict, 0, len(fields))
+	for _, manager := range slices.Sorted(maps.Keys(fields)) {
+		managerFields := fields[manager]
+		slices.Sort(managerFields)
+		conflicts = append(conflicts, openchoreov1alpha1.FieldManagerConflict{
+			Manager: manager,
+			Fields:  slices.Compact(managerFields),
+		})
+	}
+	return conflicts
+}
+
+// conflictManager returns the field manager named in a conflict cause message, which has the
+// form `conflict with "<manager>" [with subresource "<name>"] [using <apiVersion> ...]`.
+func conflictManager(message string) (string, bool) {
+	rest, ok := strings.CutPrefix(message, "conflict with ")
+	if !ok {
+		return "", false
+	}
+	quoted, err := strconv.QuotedPrefix(rest)
+	if err != nil {
+		return "", false
+	}
+	manager, err := strconv.Unquote(quoted)
+	if err != nil || manager == "" {
+		return "", false
+	}
+	return manager, true
+}
+
+// markFieldOwnership sets the FieldOwnership condition from the conflicts found while applying the
+// resources, keyed by resource ID. It returns true if the condition changed.
+func markFieldOwnership(release *openchoreov1alpha1.RenderedRelease, conflicts map[string][]openchoreov1alpha1.FieldManagerConflict) bool {
+	if len(conflicts) == 0 {
+		return controller.MarkTrueCondition(release, controller.ConditionType(ConditionFieldOwnership),
+			controller.ConditionReason(ReasonFieldOwnershipExclusive), "No other field manager changed the applied resources")
+	}
+
+	resourceIDs := slices.Sorted(maps.Keys(conflicts))
+	parts := make([]string, 0, maxReportedConflicts)
+	for i, id := range resourceIDs {
+		if i == maxReportedConflicts {
+			break
+		}
+		managers := make([]string, 0, len(conflicts[id]))
+		for _, c := range conflicts[id] {
+			managers = append(managers, c.Manager)
+		}
+		parts = append(parts, fmt.Sprintf("%s (%s)", id, strings.Join(managers, ", ")))
+	}
+	msg := "Fields changed by other field managers were taken back: " + strings.Join(parts, ", ")
+	if extra := len(resourceIDs) - maxReportedConflicts; extra > 0 {
+		msg += fmt.Sprintf(" (and %d more)", extra)
+	}
+	return controller.MarkFalseCondition(release, controller.ConditionType(ConditionFieldOwnership),
+		controller.ConditionReason(ReasonFieldManagerConflict), msg)
+}
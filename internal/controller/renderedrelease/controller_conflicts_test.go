@@ -0,0 +1,131 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/gitops"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func newConflictError() error {
+	return apierrors.NewApplyConflict([]metav1.StatusCause{
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "argocd-controller" using apps/v1`, Field: ".spec.replicas"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "argocd-controller" using apps/v1`, Field: ".metadata.labels.app"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-rollout" using apps/v1`, Field: ".spec.template.metadata.annotations.restartedAt"},
+		{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "hpa" with subresource "scale" using autoscaling/v1`, Field: ".spec.replicas"},
+	}, "Apply failed with 4 conflicts")
+}
+
+func TestApplyResourcesFieldManagerConflicts(t *testing.T) {
+	var forced []bool
+	cl := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch, opts ...client.PatchOption) error {
+			patchOpts := &client.PatchOptions{}
+			patchOpts.ApplyOptions(opts)
+			force := patchOpts.Force != nil && *patchOpts.Force
+			forced = append(forced, force)
+			if !force && obj.GetName() == "web" {
+				return newConflictError()
+			}
+			return nil
+		},
+	}).Build()
+
+	newObj := func(name, id string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("apps/v1")
+		obj.SetKind("Deployment")
+		obj.SetName(name)
+		obj.SetNamespace("dp-ns")
+		obj.SetLabels(map[string]string{labels.LabelKeyRenderedReleaseResourceID: id})
+		return obj
+	}
+
+	policy, err := gitops.NewPolicy(gitops.Config{IgnoredFieldManagers: []string{"kubectl-*"}})
+	if err != nil {
+		t.Fatalf("NewPolicy: %v", err)
+	}
+	r := &Reconciler{GitOps: policy}
+
+	conflicts, err := r.applyResources(context.Background(), cl, []*unstructured.Unstructured{newObj("web", "res-web"), newObj("worker", "res-worker")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []bool{false, true, false}; !reflect.DeepEqual(forced, want) {
+		t.Errorf("expected applies with force %v, got %v", want, forced)
+	}
+
+	want := map[string][]openchoreov1alpha1.FieldManagerConflict{
+		"res-web": {
+			{Manager: "argocd-controller", Fields: []string{".metadata.labels.app", ".spec.replicas"}},
+			{Manager: "hpa", Fields: []string{".spec.replicas"}},
+		},
+	}
+	if !reflect.DeepEqual(conflicts, want) {
+		t.Errorf("expected conflicts %v, got %v", want, conflicts)
+	}
+}
+
+func TestConflictManager(t *testing.T) {
+	tests := []struct {
+		message string
+		want    string
+		ok      bool
+	}{
+		{`conflict with "argocd-controller" using apps/v1`, "argocd-controller", true},
+		{`conflict with "foo" with subresource "scale" using v1 at 2001-02-03T04:05:06Z`, "foo", true},
+		{`conflict with "bar"`, "bar", true},
+		{`conflict with ""`, "", false},
+		{`some other error`, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := conflictManager(tt.message)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("conflictManager(%q) = %q, %v; want %q, %v", tt.message, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestMarkFieldOwnership(t *testing.T) {
+	release := &openchoreov1alpha1.RenderedRelease{}
+
+	conflicts := map[string][]openchoreov1alpha1.FieldManagerConflict{
+		"res-b": {{Manager: "flux"}},
+		"res-a": {{Manager: "argocd-controller"}, {Manager: "kubectl-edit"}},
+		"res-c": {{Manager: "flux"}},
+		"res-d": {{Manager: "flux"}},
+	}
+	if !markFieldOwnership(release, conflicts) {
+		t.Fatal("expected the condition to change")
+	}
+	cond := apimeta.FindStatusCondition(release.Status.Conditions, ConditionFieldOwnership)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != ReasonFieldManagerConflict {
+		t.Fatalf("unexpected condition %+v", cond)
+	}
+	wantMsg := "Fields changed by other field managers were taken back: res-a (argocd-controller, kubectl-edit), res-b (flux), res-c (flux) (and 1 more)"
+	if cond.Message != wantMsg {
+		t.Errorf("expected message %q, got %q", wantMsg, cond.Message)
+	}
+
+	if !markFieldOwnership(release, nil) {
+		t.Fatal("expected the condition to change")
+	}
+	cond = apimeta.FindStatusCondition(release.Status.Conditions, ConditionFieldOwnership)
+	if cond.Status != metav1.ConditionTrue || cond.Reason != ReasonFieldOwnershipExclusive {
+		t.Errorf("unexpected condition %+v", cond)
+	}
+}
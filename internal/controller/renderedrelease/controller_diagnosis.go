@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// imagePullFailureReasons are the container waiting reasons reported when an image cannot be pulled.
+var imagePullFailureReasons = []string{"ImagePullBackOff", "ErrImagePull", "InvalidImageName", "ErrImageNeverPull"}
+
+const reasonOOMKilled = "OOMKilled"
+
+// maxTerminationMessageLength bounds the part of a termination message that is copied into status.
+const maxTerminationMessageLength = 512
+
+// diagnoseWorkloads inspects the pods of the live workloads that are not healthy and returns
+// a diagnosis for each workload whose pods hit a known failure mode, keyed by resource ID.
+// Diagnosis is best effort: pods that cannot be listed are skipped.
+func (r *Reconciler) diagnoseWorkloads(ctx context.Context, planeClient client.Client, liveResources []*unstructured.Unstructured) map[string]*openchoreov1alpha1.WorkloadDiagnosis {
+	logger := log.FromContext(ctx)
+	diagnoses := make(map[string]*openchoreov1alpha1.WorkloadDiagnosis)
+
+	for _, live := range liveResources {
+		resourceID := live.GetLabels()[labels.LabelKeyRenderedReleaseResourceID]
+		gvk := live.GroupVersionKind()
+		if resourceID == "" {
+			continue
+		}
+		isPod := gvk.Group == "" && gvk.Kind == "Pod"
+		isWorkload := gvk.Group == "apps" && (gvk.Kind == "Deployment" || gvk.Kind == "StatefulSet")
+		if !isPod && !isWorkload {
+			continue
+		}
+		health, err := GetHealthCheckFunc(gvk)(live)
+		if err != nil || health == openchoreov1alpha1.HealthStatusHealthy || health == openchoreov1alpha1.HealthStatusSuspended {
+			continue
+		}
+
+		var pods []corev1.Pod
+		if isPod {
+			var pod corev1.Pod
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(live.Object, &pod); err != nil {
+				continue
+			}
+			pods = []corev1.Pod{pod}
+		} else {
+			pods, err = listWorkloadPods(ctx, planeClient, live)
+			if err != nil {
+				logger.Info("Failed to list workload pods for diagnosis", "resourceID", resourceID, "error", err.Error())
+				continue
+			}
+		}
+
+		if diagnosis := diagnosePods(pods); diagnosis != nil {
+			diagnoses[resourceID] = diagnosis
+		}
+	}
+	return diagnoses
+}
+
+// listWorkloadPods lists the pods selected by the spec.selector of a Deployment or StatefulSet.
+func listWorkloadPods(ctx context.Context, planeClient client.Client, workload *unstructured.Unstructured) ([]corev1.Pod, error) {
+	selectorField, found, err := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if err != nil || !found {
+		return nil, fmt.Errorf("workload has no pod selector")
+	}
+	var labelSelector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selectorField, &labelSelector); err != nil {
+		return nil, fmt.Errorf("failed to parse pod selector: %w", err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pod selector: %w", err)
+	}
+	if selector.Empty() {
+		return nil, fmt.Errorf("workload has an empty pod selector")
+	}
+
+	podList := &corev1.PodList{}
+	if err := planeClient.List(ctx, podList, client.InNamespace(workload.GetNamespace()),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+// diagnosePods classifies the failure of a set of pods from their container statuses. Image
+// pull failures take precedence over out-of-memory kills, which take precedence over crash
+// loops, as the first two explain a crash loop better than its exit code. Pods are inspected
+// in name order so that the diagnosis is stable across reconciles.
+func diagnosePods(pods []corev1.Pod) *openchoreov1alpha1.WorkloadDiagnosis {
+	slices.SortFunc(pods, func(a, b corev1.Pod) int { return strings.Compare(a.Name, b.Name) })
+
+	var oomKilled, crashLoop *openchoreov1alpha1.WorkloadDiagnosis
+	for i := range pods {
+		pod := &pods[i]
+		statuses := append(slices.Clone(pod.Status.InitContainerStatuses), pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			if d := diagnoseContainer(pod, cs); d != nil {
+				switch d.Reason {
+				case openchoreov1alpha1.WorkloadFailureImagePullFailed:
+					return d
+				case openchoreov1alpha1.WorkloadFailureOOMKilled:
+					if oomKilled == nil {
+						oomKilled = d
+					}
+				case openchoreov1alpha1.WorkloadFailureCrashLoopBackOff:
+					if crashLoop == nil {
+						crashLoop = d
+					}
+				}
+			}
+		}
+	}
+	if oomKilled != nil {
+		return oomKilled
+	}
+	return crashLoop
+}
+
+// diagnoseContainer classifies the failure of a single container, or returns nil when the
+// container is not in a known failure mode.
+func diagnoseContainer(pod *corev1.Pod, cs corev1.ContainerStatus) *openchoreov1alpha1.WorkloadDiagnosis {
+	diagnosis := func(reason openchoreov1alpha1.WorkloadFailureReason, message string) *openchoreov1alpha1.WorkloadDiagnosis {
+		return &openchoreov1alpha1.WorkloadDiagnosis{Reason: reason, Message: message, Pod: pod.Name, Container: cs.Name}
+	}
+
+	waiting := cs.State.Waiting
+	if waiting != nil && slices.Contains(imagePullFailureReasons, waiting.Reason) {
+		msg := fmt.Sprintf("Container %q cannot pull image %q", cs.Name, cs.Image)
+		if waiting.Message != "" {
+			msg = fmt.Sprintf("%s: %s", msg, waiting.Message)
+		}
+		return diagnosis(openchoreov1alpha1.WorkloadFailureImagePullFailed, msg)
+	}
+
+	// A past out-of-memory kill only explains the failure while the container is not ready.
+	terminated := cs.State.Terminated
+	if terminated == nil && !cs.Ready {
+		terminated = cs.LastTerminationState.Terminated
+	}
+	if terminated != nil && terminated.Reason == reasonOOMKilled {
+		msg := fmt.Sprintf("Container %q was killed because it ran out of memory", cs.Name)
+		if limit := memoryLimit(pod, cs.Name); limit != "" {
+			msg = fmt.Sprintf("%s (limit %s)", msg, limit)
+		}
+		return diagnosis(openchoreov1alpha1.WorkloadFailureOOMKilled, msg)
+	}
+
+	if waiting != nil && waiting.Reason == "CrashLoopBackOff" {
+		msg := fmt.Sprintf("Container %q is crash looping after %d restarts", cs.Name, cs.RestartCount)
+		if last := cs.LastTerminationState.Terminated; last != nil {
+			msg = fmt.Sprintf("%s, last exit code %d", msg, last.ExitCode)
+			if last.Message != "" {
+				msg = fmt.Sprintf("%s: %s", msg, truncateMessage(strings.TrimSpace(last.Message)))
+			} else if last.Reason != "" {
+				msg = fmt.Sprintf("%s (%s)", msg, last.Reason)
+			}
+		}
+		return diagnosis(openchoreov1alpha1.WorkloadFailureCrashLoopBackOff, msg)
+	}
+	return nil
+}
+
+// memoryLimit returns the memory limit of the named container of the pod, if it has one.
+func memoryLimit(pod *corev1.Pod, containerName string) string {
+	containers := append(slices.Clone(pod.Spec.InitContainers), pod.Spec.Containers...)
+	for _, c := range containers {
+		if c.Name != containerName {
+			continue
+		}
+		if limit, ok := c.Resources.Limits[corev1.ResourceMemory]; ok {
+			return limit.String()
+		}
+	}
+	return ""
+}
+
+// truncateMessage shortens a container termination message to maxTerminationMessageLength bytes.
+func truncateMessage(msg string) string {
+	if len(msg) <= maxTerminationMessageLength {
+		return msg
+	}
+	return strings.ToValidUTF8(msg[:maxTerminationMessageLength], "") + "..."
+}
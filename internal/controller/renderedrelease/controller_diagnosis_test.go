@@ -0,0 +1,225 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func imagePullPod(name string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "dp", Labels: map[string]string{"app": "api"}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "main",
+				Image: "registry.example.com/api:v2",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "ImagePullBackOff",
+					Message: "Back-off pulling image: 401 Unauthorized",
+				}},
+			}},
+		},
+	}
+}
+
+func crashLoopPod(name, message string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "dp", Labels: map[string]string{"app": "api"}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "main",
+				RestartCount: 4,
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason: "CrashLoopBackOff",
+				}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+					ExitCode: 1,
+					Reason:   "Error",
+					Message:  message,
+				}},
+			}},
+		},
+	}
+}
+
+func oomKilledPod(name string) corev1.Pod {
+	pod := crashLoopPod(name, "")
+	pod.Spec.Containers = []corev1.Container{{
+		Name: "main",
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		},
+	}}
+	pod.Status.ContainerStatuses[0].LastTerminationState.Terminated.ExitCode = 137
+	pod.Status.ContainerStatuses[0].LastTerminationState.Terminated.Reason = reasonOOMKilled
+	return pod
+}
+
+func TestDiagnosePods(t *testing.T) {
+	tests := []struct {
+		name          string
+		pods          []corev1.Pod
+		wantReason    openchoreov1alpha1.WorkloadFailureReason
+		wantPod       string
+		wantInMessage string
+	}{
+		{
+			name:          "image pull failure with registry error",
+			pods:          []corev1.Pod{imagePullPod("api-1")},
+			wantReason:    openchoreov1alpha1.WorkloadFailureImagePullFailed,
+			wantPod:       "api-1",
+			wantInMessage: `cannot pull image "registry.example.com/api:v2": Back-off pulling image: 401 Unauthorized`,
+		},
+		{
+			name:          "crash loop with last termination message",
+			pods:          []corev1.Pod{crashLoopPod("api-1", "database connection refused\n")},
+			wantReason:    openchoreov1alpha1.WorkloadFailureCrashLoopBackOff,
+			wantPod:       "api-1",
+			wantInMessage: "after 4 restarts, last exit code 1: database connection refused",
+		},
+		{
+			name:          "crash loop without termination message reports the reason",
+			pods:          []corev1.Pod{crashLoopPod("api-1", "")},
+			wantReason:    openchoreov1alpha1.WorkloadFailureCrashLoopBackOff,
+			wantInMessage: "last exit code 1 (Error)",
+		},
+		{
+			name:          "out of memory kill includes the memory limit",
+			pods:          []corev1.Pod{oomKilledPod("api-1")},
+			wantReason:    openchoreov1alpha1.WorkloadFailureOOMKilled,
+			wantInMessage: "ran out of memory (limit 256Mi)",
+		},
+		{
+			name:       "image pull failure takes precedence",
+			pods:       []corev1.Pod{crashLoopPod("api-1", "boom"), oomKilledPod("api-2"), imagePullPod("api-3")},
+			wantReason: openchoreov1alpha1.WorkloadFailureImagePullFailed,
+			wantPod:    "api-3",
+		},
+		{
+			name:       "out of memory kill takes precedence over crash loop",
+			pods:       []corev1.Pod{crashLoopPod("api-1", "boom"), oomKilledPod("api-2")},
+			wantReason: openchoreov1alpha1.WorkloadFailureOOMKilled,
+			wantPod:    "api-2",
+		},
+		{
+			name:       "pods are inspected in name order",
+			pods:       []corev1.Pod{crashLoopPod("api-b", "b"), crashLoopPod("api-a", "a")},
+			wantReason: openchoreov1alpha1.WorkloadFailureCrashLoopBackOff,
+			wantPod:    "api-a",
+		},
+		{
+			name: "pending pod without a failure",
+			pods: []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "api-1"}, Status: corev1.PodStatus{Phase: corev1.PodPending}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diagnosePods(tt.pods)
+			if tt.wantReason == "" {
+				if got != nil {
+					t.Fatalf("diagnosePods() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("diagnosePods() = nil, want reason %s", tt.wantReason)
+			}
+			if got.Reason != tt.wantReason {
+				t.Errorf("Reason = %s, want %s", got.Reason, tt.wantReason)
+			}
+			if tt.wantPod != "" && got.Pod != tt.wantPod {
+				t.Errorf("Pod = %q, want %q", got.Pod, tt.wantPod)
+			}
+			if got.Container != "main" {
+				t.Errorf("Container = %q, want main", got.Container)
+			}
+			if !strings.Contains(got.Message, tt.wantInMessage) {
+				t.Errorf("Message = %q, want it to contain %q", got.Message, tt.wantInMessage)
+			}
+		})
+	}
+}
+
+func TestDiagnosePods_PastOOMKillOfReadyContainer(t *testing.T) {
+	pod := oomKilledPod("api-1")
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	pod.Status.ContainerStatuses[0].Ready = true
+
+	if got := diagnosePods([]corev1.Pod{pod}); got != nil {
+		t.Errorf("diagnosePods() = %+v, want nil for a ready container", got)
+	}
+}
+
+func TestTruncateMessage(t *testing.T) {
+	long := strings.Repeat("x", maxTerminationMessageLength+10)
+	got := truncateMessage(long)
+	if len(got) != maxTerminationMessageLength+3 || !strings.HasSuffix(got, "...") {
+		t.Errorf("truncateMessage() length = %d, want %d ending in ...", len(got), maxTerminationMessageLength+3)
+	}
+	if got := truncateMessage("short"); got != "short" {
+		t.Errorf("truncateMessage() = %q, want short", got)
+	}
+}
+
+func TestDiagnoseWorkloads(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	degraded := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "api", Namespace: "dp", Generation: 1,
+			Labels: map[string]string{labels.LabelKeyRenderedReleaseResourceID: "deployment"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(1),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Conditions: []appsv1.DeploymentCondition{{
+				Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded",
+			}},
+		},
+	}
+	healthy := degraded.DeepCopy()
+	healthy.Name = "worker"
+	healthy.Labels = map[string]string{labels.LabelKeyRenderedReleaseResourceID: "worker"}
+	healthy.Status = appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1, AvailableReplicas: 1}
+
+	pod := imagePullPod("api-1")
+	otherPod := crashLoopPod("other-1", "boom")
+	otherPod.Labels = map[string]string{"app": "other"}
+	planeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&pod, &otherPod).Build()
+
+	r := &Reconciler{}
+	diagnoses := r.diagnoseWorkloads(context.Background(), planeClient,
+		[]*unstructured.Unstructured{toUnstructured(t, degraded), toUnstructured(t, healthy)})
+
+	if len(diagnoses) != 1 {
+		t.Fatalf("diagnoses = %+v, want only the degraded deployment", diagnoses)
+	}
+	got := diagnoses["deployment"]
+	if got == nil || got.Reason != openchoreov1alpha1.WorkloadFailureImagePullFailed || got.Pod != "api-1" {
+		t.Errorf("diagnosis = %+v, want ImagePullFailed from pod api-1", got)
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	// ConditionDriftDetected indicates whether the last drift scan found resources in the target
+	// plane that differ from the rendered resources. It is not set when the drift policy is Ignore.
+	ConditionDriftDetected = "DriftDetected"
+
+	// ReasonNoDrift indicates the resources in the target plane match the rendered resources
+	ReasonNoDrift = "NoDrift"
+	// ReasonDrifted indicates drifted resources were left as they are under the Notify policy
+	ReasonDrifted = "Drifted"
+	// ReasonDriftReverted indicates drifted resources were re-applied under the Revert policy
+	ReasonDriftReverted = "DriftReverted"
+
+	// maxDriftedFields bounds the field paths recorded for a drifted resource.
+	maxDriftedFields = 10
+	// maxReportedDrift bounds the resources listed in the DriftDetected condition message.
+	maxReportedDrift = 3
+)
+
+// driftPolicy returns the drift policy of the release, defaulting to Revert.
+func driftPolicy(release *openchoreov1alpha1.RenderedRelease) openchoreov1alpha1.DriftPolicy {
+	if release.Spec.DriftPolicy == "" {
+		return openchoreov1alpha1.DriftPolicyRevert
+	}
+	return release.Spec.DriftPolicy
+}
+
+// resourcesDigest returns the digest of the desired resources, which identifies the resources
+// applied to the target plane.
+func resourcesDigest(resources []*unstructured.Unstructured) (string, error) {
+	h := sha256.New()
+	for _, obj := range resources {
+		data, err := json.Marshal(obj.Object)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal resource %s: %w", obj.GetLabels()[labels.LabelKeyRenderedReleaseResourceID], err)
+		}
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// detectDrift compares the resources in the target plane with the desired resources and returns
+// the drifted resources keyed by resource ID. The resources are only scanned when the desired
+// resources are the ones last applied: when they changed, the differences are not drift and the
+// resources are applied anyway. Releases with the Ignore policy are not scanned.
+//
+// Each scan costs a read of every resource in the target plane, so the resources are scanned at
+// most once per DriftScanInterval rather than on every resync; the time of the last scan is
+// recorded in the status, along with the time the changed resources were applied. Until the
+// next scan is due, the drift recorded by the last scan is returned and current is false.
+func (r *Reconciler) detectDrift(ctx context.Context, planeClient client.Client, release *openchoreov1alpha1.RenderedRelease,
+	desiredResources []*unstructured.Unstructured, digest string) (drift map[string]*openchoreov1alpha1.ResourceDrift, current bool, err error) {
+	if driftPolicy(release) == openchoreov1alpha1.DriftPolicyIgnore {
+		return nil, true, nil
+	}
+	now := metav1.Now()
+	if release.Status.AppliedDigest != digest {
+		release.Status.LastDriftScanTime = &now
+		return nil, true, nil
+	}
+	if last := release.Status.LastDriftScanTime; last != nil && r.DriftScanInterval > 0 &&
+		now.Sub(last.Time) < r.DriftScanInterval {
+		return recordedDrift(release), false, nil
+	}
+
+	drift = make(map[string]*openchoreov1alpha1.ResourceDrift)
+	for _, desired := range desiredResources {
+		resourceID := desired.GetLabels()[labels.LabelKeyRenderedReleaseResourceID]
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(desired.GroupVersionKind())
+		err := planeClient.Get(ctx, client.ObjectKeyFromObject(desired), live)
+		if apierrors.IsNotFound(err) {
+			drift[resourceID] = &openchoreov1alpha1.ResourceDrift{Missing: true}
+			continue
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get resource %s: %w", resourceID, err)
+		}
+
+		if fields := driftedFields(desired, live); len(fields) > 0 {
+			drift[resourceID] = &openchoreov1alpha1.ResourceDrift{Fields: fields}
+		}
+	}
+	release.Status.LastDriftScanTime = &now
+	return drift, true, nil
+}
+
+// recordedDrift returns the drift recorded in the status by the last scan, keyed by resource ID.
+func recordedDrift(release *openchoreov1alpha1.RenderedRelease) map[string]*openchoreov1alpha1.ResourceDrift {
+	var drift map[string]*openchoreov1alpha1.ResourceDrift
+	for _, resource := range release.Status.Resources {
+		if resource.Drift == nil {
+			continue
+		}
+		if drift == nil {
+			drift = make(map[string]*openchoreov1alpha1.ResourceDrift)
+		}
+		drift[resource.ID] = resource.Drift
+	}
+	return drift
+}
+
+// withoutDrifted returns the desired resources that did not drift.
+func withoutDrifted(resources []*unstructured.Unstructured, drift map[string]*openchoreov1alpha1.ResourceDrift) []*unstructured.Unstructured {
+	if len(drift) == 0 {
+		return resources
+	}
+	kept := make([]*unstructured.Unstructured, 0, len(resources))
+	for _, obj := range resources {
+		if _, drifted := drift[obj.GetLabels()[labels.LabelKeyRenderedReleaseResourceID]]; !drifted {
+			kept = append(kept, obj)
+		}
+	}
+	return kept
+}
+
+// driftedFields returns the sorted paths of the fields of the desired resource whose values differ
+// in the live resource. Only the labels and annotations of the metadata are compared, and fields
+// the live resource has in addition, such as the defaults set by the API server, are not drift.
+func driftedFields(desired, live *unstructured.Unstructured) []string {
+	desiredObj := desired.Object
+	if desired.GroupVersionKind().GroupKind() == (schema.GroupKind{Kind: "Secret"}) {
+		desiredObj = withStringDataEncoded(desiredObj)
+	}
+
+	var fields []string
+	for key, value := range desiredObj {
+		switch key {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			for _, metaKey := range []string{"labels", "annotations"} {
+				desiredMeta, _, _ := unstructured.NestedFieldNoCopy(desiredObj, "metadata", metaKey)
+				liveMeta, _, _ := unstructured.NestedFieldNoCopy(live.Object, "metadata", metaKey)
+				compareValues(".metadata."+metaKey, desiredMeta, liveMeta, &fields)
+			}
+			continue
+		}
+		compareValues("."+key, value, live.Object[key], &fields)
+	}
+
+	slices.Sort(fields)
+	if len(fields) > maxDriftedFields {
+		fields = fields[:maxDriftedFields]
+	}
+	return fields
+}
+
+// withStringDataEncoded returns a copy of a Secret with its stringData merged into data, the way the
+// API server stores it.
+func withStringDataEncoded(secret map[string]any) map[string]any {
+	stringData, ok := secret["stringData"].(map[string]any)
+	if !ok {
+		return secret
+	}
+	out := maps.Clone(secret)
+	delete(out, "stringData")
+	data := map[string]any{}
+	if existing, ok := secret["data"].(map[string]any); ok {
+		maps.Copy(data, existing)
+	}
+	for k, v := range stringData {
+		if s, ok := v.(string); ok {
+			data[k] = base64.StdEncoding.EncodeToString([]byte(s))
+		}
+	}
+	out["data"] = data
+	return out
+}
+
+// compareValues appends the paths under path where the desired value is not found in the live value.
+func compareValues(path string, desired, live any, fields *[]string) {
+	switch d := desired.(type) {
+	case nil:
+		return
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			if len(d) > 0 || live != nil {
+				*fields = append(*fields, path)
+			}
+			return
+		}
+		for key, value := range d {
+			compareValues(path+"."+key, value, l[key], fields)
+		}
+	case []any:
+		l, ok := live.([]any)
+		if !ok || len(l) != len(d) {
+			if len(d) > 0 || len(l) > 0 {
+				*fields = append(*fields, path)
+			}
+			return
+		}
+		for i := range d {
+			compareValues(fmt.Sprintf("%s[%d]", path, i), d[i], l[i], fields)
+		}
+	default:
+		if !scalarEqual(d, live) {
+			*fields = append(*fields, path)
+		}
+	}
+}
+
+// scalarEqual reports whether a desired scalar matches the live value. The API server drops zero
+// values and normalizes numbers and quantities, so "500m" matches 0.5 and false matches no value.
+func scalarEqual(desired, live any) bool {
+	if live == nil {
+		return reflect.ValueOf(desired).IsZero()
+	}
+	if reflect.DeepEqual(desired, live) {
+		return true
+	}
+	if d, ok := toFloat(desired); ok {
+		if l, ok := toFloat(live); ok {
+			return d == l
+		}
+	}
+	d, ok := toQuantity(desired)
+	if !ok {
+		return false
+	}
+	l, ok := toQuantity(live)
+	return ok && d.Cmp(l) == 0
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func toQuantity(v any) (resource.Quantity, bool) {
+	var s string
+	switch q := v.(type) {
+	case string:
+		s = q
+	case int64, int, float64:
+		s = fmt.Sprint(q)
+	default:
+		return resource.Quantity{}, false
+	}
+	quantity, err := resource.ParseQuantity(s)
+	return quantity, err == nil
+}
+
+// markDrift sets the DriftDetected condition from the drift found by the last scan, keyed by
+// resource ID. The condition is removed when the drift policy is Ignore. It returns true if the
+// condition changed.
+func markDrift(release *openchoreov1alpha1.RenderedRelease, drift map[string]*openchoreov1alpha1.ResourceDrift) bool {
+	policy := driftPolicy(release)
+	if policy == openchoreov1alpha1.DriftPolicyIgnore {
+		return apimeta.RemoveStatusCondition(&release.Status.Conditions, ConditionDriftDetected)
+	}
+	if len(drift) == 0 {
+		return controller.MarkFalseCondition(release, controller.ConditionType(ConditionDriftDetected),
+			controller.ConditionReason(ReasonNoDrift), "The resources in the target plane match the rendered resources")
+	}
+
+	resourceIDs := slices.Sorted(maps.Keys(drift))
+	parts := make([]string, 0, maxReportedDrift)
+	for i, id := range resourceIDs {
+		if i == maxReportedDrift {
+			break
+		}
+		if drift[id].Missing {
+			parts = append(parts, id+" (missing)")
+		} else {
+			parts = append(parts, fmt.Sprintf("%s (%s)", id, strings.Join(drift[id].Fields, ", ")))
+		}
+	}
+	list := strings.Join(parts, ", ")
+	if extra := len(resourceIDs) - maxReportedDrift; extra > 0 {
+		list += fmt.Sprintf(" (and %d more)", extra)
+	}
+
+	if policy == openchoreov1alpha1.DriftPolicyNotify {
+		return controller.MarkTrueCondition(release, controller.ConditionType(ConditionDriftDetected),
+			controller.ConditionReason(ReasonDrifted), "Resources were changed in the target plane and left as they are: "+list)
+	}
+	return controller.MarkTrueCondition(release, controller.ConditionType(ConditionDriftDetected),
+		controller.ConditionReason(ReasonDriftReverted), "Resources changed in the target plane were reverted: "+list)
+}
+
+// keepSkippedConflicts carries the field manager conflicts of the drifted resources that were not
+// applied over from the previous status.
+func keepSkippedConflicts(old *openchoreov1alpha1.RenderedRelease, conflicts map[string][]openchoreov1alpha1.FieldManagerConflict,
+	drift map[string]*openchoreov1alpha1.ResourceDrift) {
+	for _, resource := range old.Status.Resources {
+		if _, skipped := drift[resource.ID]; skipped && len(resource.FieldManagerConflicts) > 0 {
+			conflicts[resource.ID] = resource.FieldManagerConflicts
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func newDriftDeployment(id, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": "dp-ns",
+			"labels":    map[string]any{labels.LabelKeyRenderedReleaseResourceID: id, "app": name},
+		},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"paused":   false,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{
+							"name":      "app",
+							"image":     "app:v1",
+							"resources": map[string]any{"requests": map[string]any{"cpu": "500m", "memory": "1024Mi"}},
+						},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func TestDriftedFields(t *testing.T) {
+	desired := newDriftDeployment("res-web", "web")
+
+	// Server defaults, zero values dropped by the server and normalized quantities are not drift
+	live := desired.DeepCopy()
+	unstructured.RemoveNestedField(live.Object, "spec", "paused")
+	_ = unstructured.SetNestedField(live.Object, "RollingUpdate", "spec", "strategy", "type")
+	_ = unstructured.SetNestedField(live.Object, map[string]any{"readyReplicas": int64(1)}, "status")
+	_ = unstructured.SetNestedField(live.Object, "abc", "metadata", "uid")
+	containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+	containers[0].(map[string]any)["resources"] = map[string]any{"requests": map[string]any{"cpu": 0.5, "memory": "1Gi"}}
+	containers[0].(map[string]any)["imagePullPolicy"] = "IfNotPresent"
+	_ = unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers")
+	if fields := driftedFields(desired, live); len(fields) != 0 {
+		t.Fatalf("expected no drift, got %v", fields)
+	}
+
+	// Changed values, removed labels and added containers are drift
+	_ = unstructured.SetNestedField(live.Object, int64(5), "spec", "replicas")
+	unstructured.RemoveNestedField(live.Object, "metadata", "labels", "app")
+	containers[0].(map[string]any)["image"] = "app:debug"
+	_ = unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers")
+	want := []string{".metadata.labels.app", ".spec.replicas", ".spec.template.spec.containers[0].image"}
+	if fields := driftedFields(desired, live); !reflect.DeepEqual(fields, want) {
+		t.Errorf("expected drifted fields %v, got %v", want, fields)
+	}
+
+	_ = unstructured.SetNestedSlice(live.Object, append(containers, map[string]any{"name": "debug"}), "spec", "template", "spec", "containers")
+	if fields := driftedFields(desired, live); len(fields) != 3 || fields[2] != ".spec.template.spec.containers" {
+		t.Errorf("expected the containers to drift, got %v", fields)
+	}
+}
+
+func TestDriftedFieldsSecretStringData(t *testing.T) {
+	desired := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "creds", "namespace": "dp-ns"},
+		"stringData": map[string]any{"password": "s3cr3t"},
+	}}
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "creds", "namespace": "dp-ns"},
+		"data":       map[string]any{"password": "czNjcjN0"},
+		"type":       "Opaque",
+	}}
+	if fields := driftedFields(desired, live); len(fields) != 0 {
+		t.Fatalf("expected no drift, got %v", fields)
+	}
+
+	live.Object["data"] = map[string]any{"password": "b3RoZXI="}
+	if fields := driftedFields(desired, live); !reflect.DeepEqual(fields, []string{".data.password"}) {
+		t.Errorf("expected the password to drift, got %v", fields)
+	}
+}
+
+func TestDetectDrift(t *testing.T) {
+	web := newDriftDeployment("res-web", "web")
+	worker := newDriftDeployment("res-worker", "worker")
+	api := newDriftDeployment("res-api", "api")
+	desired := []*unstructured.Unstructured{web, worker, api}
+	digest, err := resourcesDigest(desired)
+	if err != nil {
+		t.Fatalf("resourcesDigest: %v", err)
+	}
+
+	liveWorker := worker.DeepCopy()
+	_ = unstructured.SetNestedField(liveWorker.Object, int64(0), "spec", "replicas")
+	cl := fake.NewClientBuilder().WithObjects(web.DeepCopy(), liveWorker).Build()
+	r := &Reconciler{}
+
+	release := &openchoreov1alpha1.RenderedRelease{Status: openchoreov1alpha1.RenderedReleaseStatus{AppliedDigest: digest}}
+	drift, current, err := r.detectDrift(context.Background(), cl, release, desired, digest)
+	if err != nil || !current {
+		t.Fatalf("expected a scan, got current=%v, err=%v", current, err)
+	}
+	want := map[string]*openchoreov1alpha1.ResourceDrift{
+		"res-worker": {Fields: []string{".spec.replicas"}},
+		"res-api":    {Missing: true},
+	}
+	if !reflect.DeepEqual(drift, want) {
+		t.Errorf("expected drift %v, got %v", want, drift)
+	}
+	if kept := withoutDrifted(desired, drift); len(kept) != 1 || kept[0] != web {
+		t.Errorf("expected only the web deployment to be applied, got %d resources", len(kept))
+	}
+	if release.Status.LastDriftScanTime == nil {
+		t.Error("expected the scan to be recorded in the status")
+	}
+
+	// Changed resources are applied anyway and not scanned
+	release.Status.AppliedDigest = "previous"
+	if drift, current, err := r.detectDrift(context.Background(), cl, release, desired, digest); err != nil || !current || drift != nil {
+		t.Errorf("expected no scan of changed resources, got %v, %v, %v", drift, current, err)
+	}
+
+	release.Status.AppliedDigest = digest
+	release.Spec.DriftPolicy = openchoreov1alpha1.DriftPolicyIgnore
+	if drift, current, err := r.detectDrift(context.Background(), cl, release, desired, digest); err != nil || !current || drift != nil {
+		t.Errorf("expected no scan under the Ignore policy, got %v, %v, %v", drift, current, err)
+	}
+}
+
+func TestDetectDriftInterval(t *testing.T) {
+	web := newDriftDeployment("res-web", "web")
+	desired := []*unstructured.Unstructured{web}
+	digest, err := resourcesDigest(desired)
+	if err != nil {
+		t.Fatalf("resourcesDigest: %v", err)
+	}
+	// The web deployment is missing, but the last scan found it unchanged
+	cl := fake.NewClientBuilder().Build()
+	r := &Reconciler{DriftScanInterval: time.Hour}
+
+	lastScan := metav1.NewTime(time.Now().Add(-time.Minute))
+	release := &openchoreov1alpha1.RenderedRelease{Status: openchoreov1alpha1.RenderedReleaseStatus{
+		AppliedDigest:     digest,
+		LastDriftScanTime: &lastScan,
+		Resources:         []openchoreov1alpha1.RenderedManifestStatus{{ID: "res-web"}},
+	}}
+	drift, current, err := r.detectDrift(context.Background(), cl, release, desired, digest)
+	if err != nil || current || drift != nil {
+		t.Errorf("expected no scan within the interval, got %v, %v, %v", drift, current, err)
+	}
+
+	// The drift recorded by the last scan is kept until the next scan
+	release.Status.Resources[0].Drift = &openchoreov1alpha1.ResourceDrift{Fields: []string{".spec.replicas"}}
+	drift, current, err = r.detectDrift(context.Background(), cl, release, desired, digest)
+	want := map[string]*openchoreov1alpha1.ResourceDrift{"res-web": {Fields: []string{".spec.replicas"}}}
+	if err != nil || current || !reflect.DeepEqual(drift, want) {
+		t.Errorf("expected the recorded drift %v, got %v, %v, %v", want, drift, current, err)
+	}
+
+	lastScan = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	release.Status.LastDriftScanTime = &lastScan
+	drift, current, err = r.detectDrift(context.Background(), cl, release, desired, digest)
+	want = map[string]*openchoreov1alpha1.ResourceDrift{"res-web": {Missing: true}}
+	if err != nil || !current || !reflect.DeepEqual(drift, want) {
+		t.Errorf("expected a scan once the interval passed, got %v, %v, %v", drift, current, err)
+	}
+	if !release.Status.LastDriftScanTime.After(lastScan.Time) {
+		t.Error("expected the scan to be recorded in the status")
+	}
+}
+
+func TestResourcesDigest(t *testing.T) {
+	a, _ := resourcesDigest([]*unstructured.Unstructured{newDriftDeployment("res-web", "web")})
+	b, _ := resourcesDigest([]*unstructured.Unstructured{newDriftDeployment("res-web", "web")})
+	if a != b {
+		t.Errorf("expected equal resources to have the same digest")
+	}
+	changed := newDriftDeployment("res-web", "web")
+	_ = unstructured.SetNestedField(changed.Object, int64(3), "spec", "replicas")
+	if c, _ := resourcesDigest([]*unstructured.Unstructured{changed}); c == a {
+		t.Errorf("expected changed resources to have another digest")
+	}
+}
+
+func TestMarkDrift(t *testing.T) {
+	drift := map[string]*openchoreov1alpha1.ResourceDrift{
+		"res-web": {Fields: []string{".spec.replicas"}},
+		"res-api": {Missing: true},
+	}
+
+	tests := []struct {
+		policy     openchoreov1alpha1.DriftPolicy
+		drift      map[string]*openchoreov1alpha1.ResourceDrift
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantMsg    string
+	}{
+		{"", drift, metav1.ConditionTrue, ReasonDriftReverted, "res-api (missing), res-web (.spec.replicas)"},
+		{openchoreov1alpha1.DriftPolicyNotify, drift, metav1.ConditionTrue, ReasonDrifted, "left as they are"},
+		{openchoreov1alpha1.DriftPolicyNotify, nil, metav1.ConditionFalse, ReasonNoDrift, "match"},
+	}
+	for _, tt := range tests {
+		release := &openchoreov1alpha1.RenderedRelease{Spec: openchoreov1alpha1.RenderedReleaseSpec{DriftPolicy: tt.policy}}
+		if !markDrift(release, tt.drift) {
+			t.Fatalf("%q: expected the condition to change", tt.policy)
+		}
+		cond := apimeta.FindStatusCondition(release.Status.Conditions, ConditionDriftDetected)
+		if cond == nil || cond.Status != tt.wantStatus || cond.Reason != tt.wantReason || !strings.Contains(cond.Message, tt.wantMsg) {
+			t.Errorf("%q: unexpected condition %+v", tt.policy, cond)
+		}
+	}
+
+	release := &openchoreov1alpha1.RenderedRelease{}
+	markDrift(release, drift)
+	release.Spec.DriftPolicy = openchoreov1alpha1.DriftPolicyIgnore
+	if !markDrift(release, nil) || apimeta.FindStatusCondition(release.Status.Conditions, ConditionDriftDetected) != nil {
+		t.Errorf("expected the condition to be removed under the Ignore policy")
+	}
+}
+
+func TestKeepSkippedConflicts(t *testing.T) {
+	old := &openchoreov1alpha1.RenderedRelease{Status: openchoreov1alpha1.RenderedReleaseStatus{
+		Resources: []openchoreov1alpha1.RenderedManifestStatus{
+			{ID: "res-web", FieldManagerConflicts: []openchoreov1alpha1.FieldManagerConflict{{Manager: "argocd-controller"}}},
+			{ID: "res-worker", FieldManagerConflicts: []openchoreov1alpha1.FieldManagerConflict{{Manager: "argocd-controller"}}},
+		},
+	}}
+	conflicts := map[string][]openchoreov1alpha1.FieldManagerConflict{}
+	keepSkippedConflicts(old, conflicts, map[string]*openchoreov1alpha1.ResourceDrift{"res-web": {Missing: true}})
+	if _, ok := conflicts["res-web"]; !ok || len(conflicts) != 1 {
+		t.Errorf("expected only the conflicts of the skipped resource to be kept, got %v", conflicts)
+	}
+}
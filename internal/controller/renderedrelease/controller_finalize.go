@@ -49,6 +49,7 @@ func (r *Reconciler) ensureFinalizer(ctx context.Context, release *openchoreov1a
 
 // finalize dispatches to the appropriate plane-specific cleanup based on targetPlane.
 func (r *Reconciler) finalize(ctx context.Context, old, release *openchoreov1alpha1.RenderedRelease) (ctrl.Result, error) {
+	deleteDriftMetrics(release.Namespace, release.Name)
 	if release.Spec.TargetPlane == targetPlaneObservabilityPlane {
 		return r.finalizeObsPlane(ctx, old, release)
 	}
@@ -1563,7 +1563,7 @@ var _ = Describe("applyResources and deleteResources", func() {
 	Context("with an empty resource list", func() {
 		It("applyResources should be a no-op", func() {
 			r := &Reconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
-			Expect(r.applyResources(ctx, k8sClient, nil)).To(Succeed())
+			Expect(r.applyResources(ctx, k8sClient, nil)).Error().NotTo(HaveOccurred())
 		})
 
 		It("deleteResources should be a no-op", func() {
@@ -1582,7 +1582,7 @@ var _ = Describe("applyResources and deleteResources", func() {
 		It("should apply the resource with tracking labels", func() {
 			r := &Reconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
 			obj := makeTrackedCM(cmName, resourceID, releaseUID)
-			Expect(r.applyResources(ctx, k8sClient, []*unstructured.Unstructured{obj})).To(Succeed())
+			Expect(r.applyResources(ctx, k8sClient, []*unstructured.Unstructured{obj})).Error().NotTo(HaveOccurred())
 
 			existing := &unstructured.Unstructured{}
 			existing.SetGroupVersionKind(configMapGVK)
@@ -1593,9 +1593,9 @@ var _ = Describe("applyResources and deleteResources", func() {
 		It("should be idempotent when applied twice", func() {
 			r := &Reconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
 			obj := makeTrackedCM(cmName, resourceID, releaseUID)
-			Expect(r.applyResources(ctx, k8sClient, []*unstructured.Unstructured{obj})).To(Succeed())
+			Expect(r.applyResources(ctx, k8sClient, []*unstructured.Unstructured{obj})).Error().NotTo(HaveOccurred())
 			obj2 := makeTrackedCM(cmName, resourceID, releaseUID)
-			Expect(r.applyResources(ctx, k8sClient, []*unstructured.Unstructured{obj2})).To(Succeed())
+			Expect(r.applyResources(ctx, k8sClient, []*unstructured.Unstructured{obj2})).Error().NotTo(HaveOccurred())
 		})
 	})
 
@@ -1607,7 +1607,7 @@ var _ = Describe("applyResources and deleteResources", func() {
 		BeforeEach(func() {
 			r := &Reconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
 			obj := makeTrackedCM(cmName, resourceID, releaseUID)
-			Expect(r.applyResources(ctx, k8sClient, []*unstructured.Unstructured{obj})).To(Succeed())
+			Expect(r.applyResources(ctx, k8sClient, []*unstructured.Unstructured{obj})).Error().NotTo(HaveOccurred())
 		})
 
 		AfterEach(func() { deleteCM(cmName) })
@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// migrationRequeueInterval is how often a release removed for a data plane migration checks
+// whether its environment references the migration target, since environments are not watched.
+const migrationRequeueInterval = 10 * time.Second
+
+// dataPlaneMigrationPending reports whether the environment of a release is migrating to
+// another data plane.
+func (r *Reconciler) dataPlaneMigrationPending(ctx context.Context, namespaceName, environmentName string) (bool, error) {
+	env := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, client.ObjectKey{Name: environmentName, Namespace: namespaceName}, env); err != nil {
+		return false, fmt.Errorf("failed to get environment %s: %w", environmentName, err)
+	}
+	return controller.DataPlaneMigrationPending(env), nil
+}
+
+// undeployForMigration removes the resources of the release from the data plane its
+// environment is migrating away from. Once they are gone the release is marked as not applied,
+// which lets the migration proceed, and it is applied to the new data plane once the
+// environment references it.
+func (r *Reconciler) undeployForMigration(ctx context.Context, old, release *openchoreov1alpha1.RenderedRelease,
+	planeClient client.Client) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("release", release.Name)
+
+	gvks := findAllKnownGVKs(nil, release.Status.Resources, targetPlaneDataPlane)
+	liveResources, err := r.listLiveResourcesByGVKs(ctx, planeClient, release, gvks)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list live resources for migration: %w", err)
+	}
+	if err := r.deleteResources(ctx, planeClient, liveResources); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete resources for migration: %w", err)
+	}
+	if len(liveResources) > 0 {
+		logger.Info("Removing resources from the data plane the environment is migrating away from",
+			"remainingResources", len(liveResources))
+		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
+	}
+
+	// Clearing the digest makes the drift scan skip the first apply on the new data plane.
+	release.Status.AppliedDigest = ""
+	changed := controller.MarkFalseCondition(release, controller.ConditionType(ConditionResourcesApplied),
+		controller.ReasonDataPlaneMigrating, "Resources were removed from the data plane the environment is migrating away from")
+	if changed || old.Status.AppliedDigest != "" {
+		recordConditionHistory(old, release)
+		if err := r.Status().Update(ctx, release); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: migrationRequeueInterval}, nil
+}
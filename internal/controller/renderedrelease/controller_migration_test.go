@@ -0,0 +1,127 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	k8sMocks "github.com/openchoreo/openchoreo/internal/clients/kubernetes/mocks"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func TestReconcileUndeploysFromDataPlaneDuringMigration(t *testing.T) {
+	ctx := context.Background()
+	s := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	env := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: openchoreov1alpha1.EnvironmentSpec{
+			DataPlaneRef: &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane, Name: "old"},
+		},
+		Status: openchoreov1alpha1.EnvironmentStatus{
+			DataPlaneMigration: &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane, Name: "new"},
+		},
+	}
+	release := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "web",
+			Namespace:  "default",
+			UID:        types.UID("release-uid"),
+			Finalizers: []string{DataPlaneCleanupFinalizer},
+		},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{
+			EnvironmentName: "prod",
+			Resources: []openchoreov1alpha1.RenderedManifest{{
+				ID:     "config",
+				Object: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"web","namespace":"dp-ns"}}`)},
+			}},
+		},
+		Status: openchoreov1alpha1.RenderedReleaseStatus{AppliedDigest: "applied"},
+	}
+	cpClient := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(env, release, &openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: "default"}}).
+		WithStatusSubresource(&openchoreov1alpha1.RenderedRelease{}).
+		Build()
+
+	managed := func(name, releaseUID string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "dp-ns",
+			Labels: map[string]string{
+				labels.LabelKeyManagedBy:          ControllerName,
+				labels.LabelKeyRenderedReleaseUID: releaseUID,
+			},
+		}}
+	}
+	oldPlaneClient := fake.NewClientBuilder().
+		WithScheme(clientgoscheme.Scheme).
+		WithObjects(managed("web", "release-uid"), managed("other", "other-uid")).
+		Build()
+
+	provider := &k8sMocks.MockPlaneClientProvider{}
+	provider.EXPECT().DataPlaneClient(mock.Anything).Return(oldPlaneClient, nil)
+	r := &Reconciler{Client: cpClient, Scheme: s, PlaneClientProvider: provider}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(release)}
+
+	result, err := r.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.RequeueAfter != 5*time.Second {
+		t.Errorf("expected a requeue while resources are deleted, got %+v", result)
+	}
+	err = oldPlaneClient.Get(ctx, client.ObjectKey{Namespace: "dp-ns", Name: "web"}, &corev1.ConfigMap{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("expected the release's resource to be removed from the old data plane, got %v", err)
+	}
+	if err := oldPlaneClient.Get(ctx, client.ObjectKey{Namespace: "dp-ns", Name: "other"}, &corev1.ConfigMap{}); err != nil {
+		t.Errorf("expected resources of other releases to be kept, got %v", err)
+	}
+
+	result, err = r.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.RequeueAfter != migrationRequeueInterval {
+		t.Errorf("expected a requeue until the environment is migrated, got %+v", result)
+	}
+	got := &openchoreov1alpha1.RenderedRelease{}
+	if err := cpClient.Get(ctx, req.NamespacedName, got); err != nil {
+		t.Fatalf("get release: %v", err)
+	}
+	cond := apimeta.FindStatusCondition(got.Status.Conditions, ConditionResourcesApplied)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != string(controller.ReasonDataPlaneMigrating) {
+		t.Errorf("expected ResourcesApplied=False with reason %s, got %+v", controller.ReasonDataPlaneMigrating, cond)
+	}
+	if got.Status.AppliedDigest != "" {
+		t.Errorf("expected the applied digest to be cleared, got %q", got.Status.AppliedDigest)
+	}
+	var live corev1.ConfigMapList
+	if err := oldPlaneClient.List(ctx, &live, client.MatchingLabels{labels.LabelKeyRenderedReleaseUID: "release-uid"}); err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(live.Items) != 0 {
+		t.Errorf("expected nothing to be applied to the old data plane, got %d resources", len(live.Items))
+	}
+}
@@ -20,16 +20,35 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
-// updateStatus updates the Release status with applied resources
+// maxConditionHistory bounds status.conditionHistory.
+const maxConditionHistory = 50
+
+// recordConditionHistory records the condition changes made to the release since the start of
+// the reconcile. It must be called before persisting a condition change.
+func recordConditionHistory(old, release *openchoreov1alpha1.RenderedRelease) {
+	release.Status.ConditionHistory = controller.RecordConditionTransitions(release.Status.ConditionHistory,
+		old.Status.Conditions, release.Status.Conditions, metav1.Now(), maxConditionHistory)
+}
+
+// updateStatus updates the Release status with applied resources, the diagnoses of failing workloads,
+// the field manager conflicts found while applying them and the drift found before.
 // Returns true if the status was updated, false if unchanged
-func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov1alpha1.RenderedRelease, appliedResources, liveResources []*unstructured.Unstructured) (bool, error) {
+func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov1alpha1.RenderedRelease, appliedResources, liveResources []*unstructured.Unstructured,
+	diagnoses map[string]*openchoreov1alpha1.WorkloadDiagnosis, conflicts map[string][]openchoreov1alpha1.FieldManagerConflict,
+	drift map[string]*openchoreov1alpha1.ResourceDrift) (bool, error) {
 	logger := log.FromContext(ctx)
 
 	// Build resource status from applied and live resources
 	resourceStatuses := r.buildResourceStatus(ctx, old, appliedResources, liveResources)
+	for i := range resourceStatuses {
+		resourceStatuses[i].Diagnosis = diagnoses[resourceStatuses[i].ID]
+		resourceStatuses[i].FieldManagerConflicts = conflicts[resourceStatuses[i].ID]
+		resourceStatuses[i].Drift = drift[resourceStatuses[i].ID]
+	}
 
 	// Update the status
 	release.Status.Resources = resourceStatuses
@@ -37,7 +56,9 @@ func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov
 	// Sync conditions in old to match release before comparison, because conditions
 	// (e.g., ResourcesApplied) were already persisted earlier in the reconcile loop.
 	// Without this, DeepEqual sees a false diff and triggers a redundant status update.
+	// The same applies to the condition history recorded alongside them.
 	old.Status.Conditions = release.Status.Conditions
+	old.Status.ConditionHistory = release.Status.ConditionHistory
 
 	// Check if the entire status actually changed and skip update if not
 	if apiequality.Semantic.DeepEqual(old.Status, release.Status) {
@@ -178,6 +199,8 @@ func GetHealthCheckFunc(gvk schema.GroupVersionKind) func(obj *unstructured.Unst
 		return getPodHealth
 	case gvk.Group == "batch" && gvk.Kind == "CronJob":
 		return getCronJobHealth
+	case gvk.Group == "batch" && gvk.Kind == "Job":
+		return getJobHealth
 		// TODO: Add gateway http route health check, and other resources as needed
 	}
 	return getUnknownResourceHealth
@@ -389,6 +412,34 @@ func getCronJobHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.Health
 	return openchoreov1alpha1.HealthStatusProgressing, nil
 }
 
+func getJobHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	// Convert unstructured object to Job
+	var job batchv1.Job
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &job); err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to convert to job: %w", err)
+	}
+
+	// A finished Job reports either the Complete or the Failed condition
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return openchoreov1alpha1.HealthStatusHealthy, nil
+		case batchv1.JobFailed:
+			return openchoreov1alpha1.HealthStatusDegraded, nil
+		}
+	}
+
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return openchoreov1alpha1.HealthStatusSuspended, nil
+	}
+
+	// Created, running or retrying
+	return openchoreov1alpha1.HealthStatusProgressing, nil
+}
+
 func getUnknownResourceHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
 	// For unknown resources, we can't determine health status reliably
 	// Resources like ConfigMaps, Secrets, Services, etc. don't have meaningful health states
@@ -22,6 +22,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/airgap"
+	"github.com/openchoreo/openchoreo/internal/gitops"
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
@@ -419,6 +421,11 @@ func TestGetHealthCheckFunc(t *testing.T) {
 			gvk:        schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"},
 			wantNonNil: true,
 		},
+		{
+			name:       "batch/Job",
+			gvk:        schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"},
+			wantNonNil: true,
+		},
 		{
 			name:        "unknown resource returns non-nil health function",
 			gvk:         schema.GroupVersionKind{Group: "custom.io", Version: "v1", Kind: "Widget"},
@@ -884,6 +891,61 @@ func TestGetPodHealth(t *testing.T) {
 	}
 }
 
+// ─────────────────────────────────────────────────────────────
+// getJobHealth
+// ─────────────────────────────────────────────────────────────
+
+func TestGetJobHealth(t *testing.T) {
+	makeJob := func(job batchv1.Job) *unstructured.Unstructured {
+		return toUnstructured(t, &job)
+	}
+
+	tests := []struct {
+		name string
+		job  batchv1.Job
+		want openchoreov1alpha1.HealthStatus
+	}{
+		{
+			name: "complete job is Healthy",
+			job: batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			}}},
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "failed job is Degraded",
+			job: batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+			}}},
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "suspended job is Suspended",
+			job:  batchv1.Job{Spec: batchv1.JobSpec{Suspend: boolPtr(true)}},
+			want: openchoreov1alpha1.HealthStatusSuspended,
+		},
+		{
+			name: "running job is Progressing",
+			job: batchv1.Job{Status: batchv1.JobStatus{Active: 1, Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionFalse},
+			}}},
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getJobHealth(makeJob(tt.job))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
 // ─────────────────────────────────────────────────────────────
 // getCronJobHealth
 // ─────────────────────────────────────────────────────────────
@@ -1086,12 +1148,129 @@ func TestMakeDesiredResources(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("resources identify the owning release and binding", func(t *testing.T) {
+		release := &openchoreov1alpha1.RenderedRelease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "checkout-dev", Namespace: "default", UID: "uid-4",
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "openchoreo.dev/v1alpha1", Kind: "ReleaseBinding", Name: "checkout-dev", Controller: boolPtr(true)},
+				},
+			},
+			Spec: openchoreov1alpha1.RenderedReleaseSpec{
+				Resources: []openchoreov1alpha1.RenderedManifest{
+					{ID: "a", Object: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`)}},
+				},
+			},
+		}
+		result, err := r.makeDesiredResources(release)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := result[0].GetLabels()[labels.LabelKeyAppManagedBy]; got != labels.LabelValueAppManagedBy {
+			t.Errorf("managed-by label: expected %q, got %q", labels.LabelValueAppManagedBy, got)
+		}
+		annotations := result[0].GetAnnotations()
+		if got := annotations[labels.AnnotationKeyRenderedRelease]; got != "default/checkout-dev" {
+			t.Errorf("rendered release annotation: got %q", got)
+		}
+		if got := annotations[labels.AnnotationKeyReleaseBinding]; got != "ReleaseBinding/checkout-dev" {
+			t.Errorf("release binding annotation: got %q", got)
+		}
+	})
+
+	t.Run("GitOps markers are added without overriding tracking labels", func(t *testing.T) {
+		policy, err := gitops.NewPolicy(gitops.Config{
+			Tools:  []gitops.Tool{gitops.ToolArgoCD},
+			Labels: map[string]string{labels.LabelKeyManagedBy: "someone-else", "team": "platform"},
+		})
+		if err != nil {
+			t.Fatalf("NewPolicy: %v", err)
+		}
+		r := &Reconciler{GitOps: policy}
+		release := &openchoreov1alpha1.RenderedRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: "r5", Namespace: "ns5", UID: "uid-5"},
+			Spec: openchoreov1alpha1.RenderedReleaseSpec{
+				Resources: []openchoreov1alpha1.RenderedManifest{
+					{ID: "a", Object: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`)}},
+				},
+			},
+		}
+		result, err := r.makeDesiredResources(release)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		lbls := result[0].GetLabels()
+		if lbls[labels.LabelKeyManagedBy] != ControllerName {
+			t.Errorf("tracking label overridden: got %q", lbls[labels.LabelKeyManagedBy])
+		}
+		if lbls["team"] != "platform" {
+			t.Error("configured label not added")
+		}
+		if got := result[0].GetAnnotations()["argocd.argoproj.io/compare-options"]; got != "IgnoreExtraneous" {
+			t.Errorf("Argo CD annotation: got %q", got)
+		}
+	})
 }
 
 // ─────────────────────────────────────────────────────────────
 // makeDesiredNamespaces
 // ─────────────────────────────────────────────────────────────
 
+func TestEnforceAirGapPolicy(t *testing.T) {
+	deployment := func(image string) *unstructured.Unstructured {
+		return toUnstructured(t, &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "dp-ns"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: image}}},
+				},
+			},
+		})
+	}
+	policy, err := airgap.NewPolicy(true, airgap.Config{
+		Mirrors: []airgap.Mirror{{Source: "docker.io", Target: "registry.internal/dockerhub"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to build air-gap policy: %v", err)
+	}
+
+	t.Run("nil policy leaves resources untouched", func(t *testing.T) {
+		obj := deployment("nginx:1.27")
+		if err := (&Reconciler{}).enforceAirGapPolicy([]*unstructured.Unstructured{obj}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+		if image := containers[0].(map[string]any)["image"]; image != "nginx:1.27" {
+			t.Errorf("expected image to be unchanged, got %v", image)
+		}
+	})
+
+	t.Run("rewrites mirrored images", func(t *testing.T) {
+		obj := deployment("nginx:1.27")
+		if err := (&Reconciler{AirGap: policy}).enforceAirGapPolicy([]*unstructured.Unstructured{obj}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+		if image := containers[0].(map[string]any)["image"]; image != "registry.internal/dockerhub/library/nginx:1.27" {
+			t.Errorf("expected image to be rewritten to the mirror, got %v", image)
+		}
+	})
+
+	t.Run("reports unmirrored images with the offending resource", func(t *testing.T) {
+		err := (&Reconciler{AirGap: policy}).enforceAirGapPolicy([]*unstructured.Unstructured{deployment("ghcr.io/acme/web:v1")})
+		if err == nil {
+			t.Fatal("expected an air-gap policy violation")
+		}
+		want := "Deployment dp-ns/web: air-gapped mode forbids external references without a mirror: " +
+			"spec.template.spec.containers[0].image: ghcr.io/acme/web:v1"
+		if err.Error() != want {
+			t.Errorf("unexpected error:\n got: %s\nwant: %s", err.Error(), want)
+		}
+	})
+}
+
 func TestMakeDesiredNamespaces(t *testing.T) {
 	r := &Reconciler{}
 
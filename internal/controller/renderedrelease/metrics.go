@@ -0,0 +1,57 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderedrelease
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Drift metrics exposed on the controller manager's metrics endpoint.
+var (
+	driftedResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_rendered_release_drifted_resources",
+			Help: "Number of resources of a rendered release that differ from the rendered resources, as found by the last drift scan.",
+		},
+		[]string{"namespace", "release", "environment"},
+	)
+
+	driftDetectedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openchoreo_rendered_release_drift_detected_total",
+			Help: "Number of drifted resources found by drift scans, by the drift policy that handled them.",
+		},
+		[]string{"namespace", "project", "environment", "policy"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		driftedResources,
+		driftDetectedTotal,
+	)
+}
+
+// observeDrift records the result of a drift scan of a release. The series of a release whose
+// drift policy is Ignore are removed.
+func observeDrift(release *openchoreov1alpha1.RenderedRelease, drift map[string]*openchoreov1alpha1.ResourceDrift) {
+	policy := driftPolicy(release)
+	if policy == openchoreov1alpha1.DriftPolicyIgnore {
+		deleteDriftMetrics(release.Namespace, release.Name)
+		return
+	}
+	driftedResources.WithLabelValues(release.Namespace, release.Name, release.Spec.EnvironmentName).Set(float64(len(drift)))
+	if len(drift) > 0 {
+		driftDetectedTotal.WithLabelValues(release.Namespace, release.Spec.Owner.ProjectName,
+			release.Spec.EnvironmentName, string(policy)).Add(float64(len(drift)))
+	}
+}
+
+// deleteDriftMetrics removes the series of a deleted release.
+func deleteDriftMetrics(namespace, name string) {
+	driftedResources.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "release": name})
+}
@@ -26,6 +26,9 @@ import (
 type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Quarantine stops reconciling Resources whose reconciles keep failing.
+	Quarantine controller.QuarantinePolicy
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=resources,verbs=get;list;watch;create;update;patch;delete
@@ -206,6 +209,11 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return fmt.Errorf("setup resource type reference index: %w", err)
 	}
 
+	reconciler, err := controller.WithQuarantine(mgr, r, &openchoreov1alpha1.Resource{}, r.Quarantine)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.Resource{}).
 		Watches(&openchoreov1alpha1.ResourceType{},
@@ -213,5 +221,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&openchoreov1alpha1.ClusterResourceType{},
 			handler.EnqueueRequestsFromMapFunc(r.listResourcesForClusterResourceType)).
 		Named("resource").
-		Complete(r)
+		Complete(reconciler)
 }
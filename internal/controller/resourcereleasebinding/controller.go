@@ -39,6 +39,9 @@ type Reconciler struct {
 	// instance holds CEL env and program caches; reuse it across reconciles
 	// to keep them warm.
 	Pipeline *resourcepipeline.Pipeline
+
+	// Quarantine stops reconciling ResourceReleaseBindings whose reconciles keep failing.
+	Quarantine controller.QuarantinePolicy
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=resourcereleasebindings,verbs=get;list;watch;create;update;patch;delete
@@ -271,6 +274,8 @@ func (r *Reconciler) renderAndEmit(
 			EnvironmentName: binding.Spec.Environment,
 			TargetPlane:     openchoreov1alpha1.TargetPlaneDataPlane,
 			Resources:       manifests,
+			// The drift policy is set on the release itself and kept across renders.
+			DriftPolicy: rr.Spec.DriftPolicy,
 		}
 		return controllerutil.SetControllerReference(binding, rr, r.Scheme)
 	})
@@ -460,11 +465,16 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return fmt.Errorf("setup ResourceRelease ref index: %w", err)
 	}
 
+	reconciler, err := controller.WithQuarantine(mgr, r, &openchoreov1alpha1.ResourceReleaseBinding{}, r.Quarantine)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.ResourceReleaseBinding{}).
 		Owns(&openchoreov1alpha1.RenderedRelease{}).
 		Watches(&openchoreov1alpha1.ResourceRelease{},
 			handler.EnqueueRequestsFromMapFunc(r.listResourceReleaseBindingsForResourceRelease)).
 		Named("resourcereleasebinding").
-		Complete(r)
+		Complete(reconciler)
 }
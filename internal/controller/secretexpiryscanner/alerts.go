@@ -0,0 +1,98 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretexpiryscanner
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+const (
+	alertRulesFieldOwner = "secretexpiryscanner-controller"
+	alertRulesNameSuffix = "-secret-expiry"
+)
+
+var prometheusRuleGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+
+// reconcileAlertRules applies the PrometheusRule of a scanner with alerting configured and
+// removes it once alerting is no longer configured. The rule is owned by the scanner, so it
+// is garbage collected with it.
+func (r *Reconciler) reconcileAlertRules(ctx context.Context, scanner *openchoreov1alpha1.SecretExpiryScanner) error {
+	if scanner.Spec.Alerting == nil {
+		if apimeta.FindStatusCondition(scanner.Status.Conditions, string(ConditionAlertRulesReady)) == nil {
+			return nil
+		}
+		rule := &unstructured.Unstructured{}
+		rule.SetGroupVersionKind(prometheusRuleGVK)
+		rule.SetNamespace(scanner.Namespace)
+		rule.SetName(scanner.Name + alertRulesNameSuffix)
+		if err := r.Delete(ctx, rule); err != nil && !apierrors.IsNotFound(err) && !apimeta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to delete PrometheusRule %s: %w", rule.GetName(), err)
+		}
+		apimeta.RemoveStatusCondition(&scanner.Status.Conditions, string(ConditionAlertRulesReady))
+		return nil
+	}
+
+	rule := buildPrometheusRule(scanner)
+	if err := controllerutil.SetControllerReference(scanner, rule, r.Scheme); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	if err := r.Patch(ctx, rule, client.Apply, client.ForceOwnership, client.FieldOwner(alertRulesFieldOwner)); err != nil {
+		return fmt.Errorf("failed to apply PrometheusRule %s: %w", rule.GetName(), err)
+	}
+	controller.MarkTrueCondition(scanner, ConditionAlertRulesReady, ReasonAlertRulesProvisioned,
+		fmt.Sprintf("PrometheusRule %s is applied", rule.GetName()))
+	return nil
+}
+
+// buildPrometheusRule builds the alert rules on the expiry metrics of a scanner: a warning
+// alert within the warning threshold and a critical alert within the critical threshold,
+// which also covers expired certificates.
+func buildPrometheusRule(scanner *openchoreov1alpha1.SecretExpiryScanner) *unstructured.Unstructured {
+	thresholds := thresholdsOf(scanner)
+	selector := fmt.Sprintf(`openchoreo_secret_expiry_timestamp_seconds{scanner_namespace=%q,scanner=%q}`,
+		scanner.Namespace, scanner.Name)
+	annotations := map[string]any{
+		"summary": "TLS certificate in secret {{ $labels.namespace }}/{{ $labels.secret }} on {{ $labels.plane_kind }} " +
+			"{{ $labels.plane }} expires in {{ $value | humanizeDuration }}",
+	}
+	alert := func(name, severity string, seconds float64) map[string]any {
+		return map[string]any{
+			"alert":       name,
+			"expr":        fmt.Sprintf("%s - time() < %.0f", selector, seconds),
+			"labels":      map[string]any{"severity": severity},
+			"annotations": annotations,
+		}
+	}
+
+	rule := &unstructured.Unstructured{}
+	rule.SetGroupVersionKind(prometheusRuleGVK)
+	rule.SetNamespace(scanner.Namespace)
+	rule.SetName(scanner.Name + alertRulesNameSuffix)
+	if labels := scanner.Spec.Alerting.Labels; len(labels) > 0 {
+		rule.SetLabels(labels)
+	}
+	rule.Object["spec"] = map[string]any{
+		"groups": []any{
+			map[string]any{
+				"name": fmt.Sprintf("openchoreo-secret-expiry-%s-%s", scanner.Namespace, scanner.Name),
+				"rules": []any{
+					alert("OpenChoreoCertificateExpiringSoon", "warning", thresholds.Warning.Seconds()),
+					alert("OpenChoreoCertificateExpiryCritical", "critical", thresholds.Critical.Seconds()),
+				},
+			},
+		},
+	}
+	return rule
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretexpiryscanner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/secretexpiry"
+)
+
+const (
+	// defaultSchedule is used when the spec does not set a schedule.
+	defaultSchedule = "0 */6 * * *"
+	// defaultWarningBefore and defaultCriticalBefore are used when the spec does not set
+	// the thresholds.
+	defaultWarningBefore  = 30 * 24 * time.Hour
+	defaultCriticalBefore = 7 * 24 * time.Hour
+	// maxFindings bounds the findings recorded in status. The metrics cover every secret.
+	maxFindings = 100
+)
+
+// Condition types and reasons for SecretExpiryScanner.
+const (
+	// ConditionScanned indicates whether the last scan reached every plane.
+	ConditionScanned controller.ConditionType = "Scanned"
+	// ConditionExpiringSecrets indicates whether the last scan found certificates that
+	// expire within the warning threshold.
+	ConditionExpiringSecrets controller.ConditionType = "ExpiringSecrets"
+	// ConditionAlertRulesReady indicates whether the alert rules are provisioned.
+	ConditionAlertRulesReady controller.ConditionType = "AlertRulesReady"
+
+	// ReasonScanSucceeded indicates every plane was scanned.
+	ReasonScanSucceeded controller.ConditionReason = "ScanSucceeded"
+	// ReasonScanFailed indicates one or more planes could not be scanned.
+	ReasonScanFailed controller.ConditionReason = "ScanFailed"
+	// ReasonInvalidSchedule indicates the schedule is not a valid cron expression.
+	ReasonInvalidSchedule controller.ConditionReason = "InvalidSchedule"
+	// ReasonExpiringSecretsFound indicates certificates expire within the warning threshold.
+	ReasonExpiringSecretsFound controller.ConditionReason = "ExpiringSecretsFound"
+	// ReasonNoExpiringSecrets indicates all certificates are valid beyond the warning threshold.
+	ReasonNoExpiringSecrets controller.ConditionReason = "NoExpiringSecrets"
+	// ReasonAlertRulesProvisioned indicates the PrometheusRule is applied.
+	ReasonAlertRulesProvisioned controller.ConditionReason = "AlertRulesProvisioned"
+	// ReasonAlertRulesFailed indicates the PrometheusRule could not be applied.
+	ReasonAlertRulesFailed controller.ConditionReason = "AlertRulesFailed"
+)
+
+// Reconciler reconciles a SecretExpiryScanner object by scanning the TLS secrets of its
+// planes on the configured cron schedule and recording the certificates that are about to
+// expire in status and metrics.
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// PlaneClientProvider provides clients for the scanned data and workflow planes.
+	PlaneClientProvider kubernetesClient.PlaneClientProvider
+
+	// now returns the current time. Overridden in tests.
+	now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=secretexpiryscanners,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=secretexpiryscanners/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;create;patch;delete
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	scanner := &openchoreov1alpha1.SecretExpiryScanner{}
+	if err := r.Get(ctx, req.NamespacedName, scanner); err != nil {
+		if apierrors.IsNotFound(err) {
+			deleteScannerMetrics(req.Namespace, req.Name)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get SecretExpiryScanner")
+		return ctrl.Result{}, err
+	}
+
+	old := scanner.DeepCopy()
+	now := r.clock()
+
+	schedule, err := secretexpiry.ParseSchedule(scheduleOf(scanner))
+	if err != nil {
+		scanner.Status.ObservedGeneration = scanner.Generation
+		scanner.Status.NextScanTime = nil
+		controller.MarkFalseCondition(scanner, ConditionScanned, ReasonInvalidSchedule, err.Error())
+		return ctrl.Result{}, r.updateStatus(ctx, old, scanner)
+	}
+
+	if scanDue(scanner, now) {
+		result := r.scan(ctx, scanner, now)
+		applyScanResult(scanner, result, now)
+		observeScan(scanner, result, now)
+	}
+	next := metav1.NewTime(schedule.Next(scanner.Status.LastScanTime.UTC()))
+	scanner.Status.NextScanTime = &next
+
+	if err := r.reconcileAlertRules(ctx, scanner); err != nil {
+		logger.Info("Failed to provision secret expiry alert rules", "error", err.Error())
+		controller.MarkFalseCondition(scanner, ConditionAlertRulesReady, ReasonAlertRulesFailed, err.Error())
+	}
+
+	if err := r.updateStatus(ctx, old, scanner); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	requeueAfter := next.Sub(now)
+	if requeueAfter <= 0 {
+		requeueAfter = time.Second
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// scanDue reports whether the planes must be scanned now: on the first reconcile, after a
+// spec change and when the next scheduled time has passed.
+func scanDue(scanner *openchoreov1alpha1.SecretExpiryScanner, now time.Time) bool {
+	status := scanner.Status
+	return status.LastScanTime == nil || status.NextScanTime == nil ||
+		status.ObservedGeneration != scanner.Generation || !now.Before(status.NextScanTime.Time)
+}
+
+// applyScanResult records a scan in status.
+func applyScanResult(scanner *openchoreov1alpha1.SecretExpiryScanner, result *scanResult, now time.Time) {
+	scannedAt := metav1.NewTime(now)
+	scanner.Status.ObservedGeneration = scanner.Generation
+	scanner.Status.LastScanTime = &scannedAt
+	scanner.Status.ScannedSecrets = int32(len(result.certificates))
+
+	findings := result.findings()
+	counts := map[openchoreov1alpha1.SecretExpirySeverity]int{}
+	for _, f := range findings {
+		counts[f.Severity]++
+	}
+	if len(findings) > maxFindings {
+		findings = findings[:maxFindings]
+	}
+	scanner.Status.Findings = findings
+
+	if len(result.errors) > 0 {
+		controller.MarkFalseCondition(scanner, ConditionScanned, ReasonScanFailed, strings.Join(result.errors, "; "))
+	} else {
+		controller.MarkTrueCondition(scanner, ConditionScanned, ReasonScanSucceeded,
+			fmt.Sprintf("Scanned %d TLS secrets on %d planes", len(result.certificates), len(scanner.Spec.Planes)))
+	}
+
+	if total := counts[openchoreov1alpha1.SecretExpirySeverityExpired] + counts[openchoreov1alpha1.SecretExpirySeverityCritical] +
+		counts[openchoreov1alpha1.SecretExpirySeverityWarning]; total > 0 {
+		controller.MarkTrueCondition(scanner, ConditionExpiringSecrets, ReasonExpiringSecretsFound,
+			fmt.Sprintf("%d expired, %d critical and %d warning certificates",
+				counts[openchoreov1alpha1.SecretExpirySeverityExpired],
+				counts[openchoreov1alpha1.SecretExpirySeverityCritical],
+				counts[openchoreov1alpha1.SecretExpirySeverityWarning]))
+	} else {
+		controller.MarkFalseCondition(scanner, ConditionExpiringSecrets, ReasonNoExpiringSecrets,
+			"All certificates are valid beyond the warning threshold")
+	}
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, old, scanner *openchoreov1alpha1.SecretExpiryScanner) error {
+	if apiequality.Semantic.DeepEqual(old.Status, scanner.Status) {
+		return nil
+	}
+	if err := r.Status().Update(ctx, scanner); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update SecretExpiryScanner status")
+		return err
+	}
+	return nil
+}
+
+func scheduleOf(scanner *openchoreov1alpha1.SecretExpiryScanner) string {
+	if scanner.Spec.Schedule == "" {
+		return defaultSchedule
+	}
+	return scanner.Spec.Schedule
+}
+
+func thresholdsOf(scanner *openchoreov1alpha1.SecretExpiryScanner) secretexpiry.Thresholds {
+	thresholds := secretexpiry.Thresholds{
+		Warning:  scanner.Spec.WarningBefore.Duration,
+		Critical: scanner.Spec.CriticalBefore.Duration,
+	}
+	if thresholds.Warning <= 0 {
+		thresholds.Warning = defaultWarningBefore
+	}
+	if thresholds.Critical <= 0 {
+		thresholds.Critical = defaultCriticalBefore
+	}
+	return thresholds
+}
+
+func (r *Reconciler) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates must not trigger a scan; the scanner runs on its schedule.
+		For(&openchoreov1alpha1.SecretExpiryScanner{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("secretexpiryscanner").
+		Complete(r)
+}
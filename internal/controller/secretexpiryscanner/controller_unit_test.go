@@ -0,0 +1,291 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretexpiryscanner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var testNow = time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)
+
+// fakePlaneClientProvider returns the same client for every plane.
+type fakePlaneClientProvider struct {
+	client client.Client
+}
+
+func (f *fakePlaneClientProvider) DataPlaneClient(_ *openchoreov1alpha1.DataPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) ClusterDataPlaneClient(_ *openchoreov1alpha1.ClusterDataPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) ObservabilityPlaneClient(_ *openchoreov1alpha1.ObservabilityPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) ClusterObservabilityPlaneClient(_ *openchoreov1alpha1.ClusterObservabilityPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) WorkflowPlaneClient(_ *openchoreov1alpha1.WorkflowPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakePlaneClientProvider) ClusterWorkflowPlaneClient(_ *openchoreov1alpha1.ClusterWorkflowPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func newTestScanner() *openchoreov1alpha1.SecretExpiryScanner {
+	return &openchoreov1alpha1.SecretExpiryScanner{
+		ObjectMeta: metav1.ObjectMeta{Name: "certs", Namespace: "default", Generation: 1},
+		Spec: openchoreov1alpha1.SecretExpiryScannerSpec{
+			Schedule:       "0 */6 * * *",
+			Planes:         []openchoreov1alpha1.TargetPlaneRef{{Kind: "DataPlane", Name: "dp"}},
+			WarningBefore:  metav1.Duration{Duration: 30 * 24 * time.Hour},
+			CriticalBefore: metav1.Duration{Duration: 7 * 24 * time.Hour},
+		},
+	}
+}
+
+func tlsSecret(t *testing.T, namespace, name string, notAfter time.Time) *corev1.Secret {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name + ".example.com"},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			corev1.TLSPrivateKeyKey: []byte("key"),
+		},
+	}
+}
+
+func newTestReconciler(t *testing.T, scanner *openchoreov1alpha1.SecretExpiryScanner, cpObjects []client.Object, planeObjects ...client.Object) *Reconciler {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	dp := &openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "dp", Namespace: "default"}}
+	cp := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(append([]client.Object{scanner, dp}, cpObjects...)...).
+		WithStatusSubresource(scanner).
+		Build()
+	planeClient := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(planeObjects...).
+		WithIndex(&corev1.Secret{}, secretTypeField, func(o client.Object) []string {
+			return []string{string(o.(*corev1.Secret).Type)}
+		}).
+		Build()
+	return &Reconciler{
+		Client:              cp,
+		Scheme:              s,
+		PlaneClientProvider: &fakePlaneClientProvider{client: planeClient},
+		now:                 func() time.Time { return testNow },
+	}
+}
+
+func reconcileScanner(t *testing.T, r *Reconciler) (ctrl.Result, *openchoreov1alpha1.SecretExpiryScanner) {
+	t.Helper()
+	key := types.NamespacedName{Name: "certs", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	updated := &openchoreov1alpha1.SecretExpiryScanner{}
+	require.NoError(t, r.Get(context.Background(), key, updated))
+	return result, updated
+}
+
+func TestReconcile_RecordsFindings(t *testing.T) {
+	opaque := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "opaque", Namespace: "app"}, Type: corev1.SecretTypeOpaque}
+	invalid := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "app"}, Type: corev1.SecretTypeTLS}
+	r := newTestReconciler(t, newTestScanner(), nil,
+		tlsSecret(t, "app", "valid", testNow.AddDate(1, 0, 0)),
+		tlsSecret(t, "app", "warning", testNow.AddDate(0, 0, 20)),
+		tlsSecret(t, "gateway", "critical", testNow.AddDate(0, 0, 2)),
+		tlsSecret(t, "gateway", "expired", testNow.AddDate(0, 0, -1)),
+		opaque, invalid,
+	)
+
+	result, scanner := reconcileScanner(t, r)
+
+	assert.Equal(t, 90*time.Minute, result.RequeueAfter)
+	require.NotNil(t, scanner.Status.LastScanTime)
+	assert.Equal(t, testNow, scanner.Status.LastScanTime.UTC())
+	assert.Equal(t, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), scanner.Status.NextScanTime.UTC())
+	assert.Equal(t, int32(4), scanner.Status.ScannedSecrets)
+
+	require.Len(t, scanner.Status.Findings, 3)
+	assert.Equal(t, "expired", scanner.Status.Findings[0].SecretName)
+	assert.Equal(t, openchoreov1alpha1.SecretExpirySeverityExpired, scanner.Status.Findings[0].Severity)
+	assert.Equal(t, "critical", scanner.Status.Findings[1].SecretName)
+	assert.Equal(t, openchoreov1alpha1.SecretExpirySeverityCritical, scanner.Status.Findings[1].Severity)
+	assert.Equal(t, "warning", scanner.Status.Findings[2].SecretName)
+	assert.Equal(t, "warning.example.com", scanner.Status.Findings[2].Subject)
+	assert.Equal(t, openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp"}, scanner.Status.Findings[2].Plane)
+
+	scanned := apimeta.FindStatusCondition(scanner.Status.Conditions, string(ConditionScanned))
+	require.NotNil(t, scanned)
+	assert.Equal(t, metav1.ConditionTrue, scanned.Status)
+	expiring := apimeta.FindStatusCondition(scanner.Status.Conditions, string(ConditionExpiringSecrets))
+	require.NotNil(t, expiring)
+	assert.Equal(t, metav1.ConditionTrue, expiring.Status)
+	assert.Equal(t, "1 expired, 1 critical and 1 warning certificates", expiring.Message)
+}
+
+func TestReconcile_WaitsForSchedule(t *testing.T) {
+	scanner := newTestScanner()
+	lastScan := metav1.NewTime(time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC))
+	nextScan := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	scanner.Status = openchoreov1alpha1.SecretExpiryScannerStatus{
+		ObservedGeneration: 1,
+		LastScanTime:       &lastScan,
+		NextScanTime:       &nextScan,
+		ScannedSecrets:     7,
+	}
+	r := newTestReconciler(t, scanner, nil, tlsSecret(t, "app", "expired", testNow.AddDate(0, 0, -1)))
+
+	result, updated := reconcileScanner(t, r)
+
+	assert.Equal(t, 90*time.Minute, result.RequeueAfter)
+	assert.Equal(t, int32(7), updated.Status.ScannedSecrets, "the planes must not be scanned before the next scheduled time")
+	assert.Empty(t, updated.Status.Findings)
+
+	// A spec change triggers a scan right away.
+	updated.Generation = 2
+	require.NoError(t, r.Update(context.Background(), updated))
+	_, rescanned := reconcileScanner(t, r)
+	assert.Equal(t, int32(1), rescanned.Status.ScannedSecrets)
+	assert.Len(t, rescanned.Status.Findings, 1)
+}
+
+func TestReconcile_InvalidSchedule(t *testing.T) {
+	scanner := newTestScanner()
+	scanner.Spec.Schedule = "every hour"
+	r := newTestReconciler(t, scanner, nil)
+
+	result, updated := reconcileScanner(t, r)
+
+	assert.Zero(t, result.RequeueAfter)
+	assert.Nil(t, updated.Status.LastScanTime)
+	cond := apimeta.FindStatusCondition(updated.Status.Conditions, string(ConditionScanned))
+	require.NotNil(t, cond)
+	assert.Equal(t, string(ReasonInvalidSchedule), cond.Reason)
+}
+
+func TestReconcile_PlaneFailureDoesNotStopScan(t *testing.T) {
+	scanner := newTestScanner()
+	scanner.Spec.Planes = append(scanner.Spec.Planes, openchoreov1alpha1.TargetPlaneRef{Kind: "ClusterDataPlane", Name: "missing"})
+	r := newTestReconciler(t, scanner, nil, tlsSecret(t, "app", "critical", testNow.AddDate(0, 0, 1)))
+
+	_, updated := reconcileScanner(t, r)
+
+	assert.Len(t, updated.Status.Findings, 1)
+	cond := apimeta.FindStatusCondition(updated.Status.Conditions, string(ConditionScanned))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Contains(t, cond.Message, "ClusterDataPlane missing")
+}
+
+func TestSecretReferenceOf(t *testing.T) {
+	plane := openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "dp"}
+	ref := remoteRefKey{key: "secret/default/tls/api-cert", property: corev1.TLSCertKey}
+	secretReferences := map[remoteRefKey][]openchoreov1alpha1.SecretReference{
+		ref: {
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-plane"},
+				Spec: openchoreov1alpha1.SecretReferenceSpec{
+					TargetPlane: &openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "other"},
+				},
+			},
+			{ObjectMeta: metav1.ObjectMeta{Name: "api-cert"}},
+		},
+	}
+	externalSecrets := map[client.ObjectKey]remoteRefKey{{Namespace: "app", Name: "api-cert-es"}: ref}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name: "api-cert", Namespace: "app",
+		OwnerReferences: []metav1.OwnerReference{{Kind: "ExternalSecret", Name: "api-cert-es"}},
+	}}
+	assert.Equal(t, "api-cert", secretReferenceOf(secret, plane, externalSecrets, secretReferences))
+
+	secret.OwnerReferences[0].Name = "unrelated"
+	assert.Empty(t, secretReferenceOf(secret, plane, externalSecrets, secretReferences))
+}
+
+func TestTLSExternalSecrets(t *testing.T) {
+	es := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "external-secrets.io/v1",
+		"kind":       "ExternalSecret",
+		"metadata":   map[string]any{"name": "api-cert-es", "namespace": "app"},
+		"spec": map[string]any{
+			"data": []any{
+				map[string]any{"secretKey": "tls.key", "remoteRef": map[string]any{"key": "k", "property": "tls.key"}},
+				map[string]any{"secretKey": "tls.crt", "remoteRef": map[string]any{"key": "k", "property": "tls.crt"}},
+			},
+		},
+	}}
+	c := fake.NewClientBuilder().WithObjects(es).Build()
+
+	refs, err := tlsExternalSecrets(context.Background(), c, "app")
+	require.NoError(t, err)
+	assert.Equal(t, map[client.ObjectKey]remoteRefKey{
+		{Namespace: "app", Name: "api-cert-es"}: {key: "k", property: "tls.crt"},
+	}, refs)
+}
+
+func TestBuildPrometheusRule(t *testing.T) {
+	scanner := newTestScanner()
+	scanner.Spec.Alerting = &openchoreov1alpha1.SecretExpiryAlerting{Labels: map[string]string{"release": "prometheus"}}
+
+	rule := buildPrometheusRule(scanner)
+
+	assert.Equal(t, "certs-secret-expiry", rule.GetName())
+	assert.Equal(t, map[string]string{"release": "prometheus"}, rule.GetLabels())
+	groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+	require.Len(t, groups, 1)
+	rules := groups[0].(map[string]any)["rules"].([]any)
+	require.Len(t, rules, 2)
+	assert.Equal(t,
+		`openchoreo_secret_expiry_timestamp_seconds{scanner_namespace="default",scanner="certs"} - time() < 2592000`,
+		rules[0].(map[string]any)["expr"])
+	assert.Equal(t,
+		`openchoreo_secret_expiry_timestamp_seconds{scanner_namespace="default",scanner="certs"} - time() < 604800`,
+		rules[1].(map[string]any)["expr"])
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretexpiryscanner
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Secret expiry metrics exposed on the controller manager's metrics endpoint. The expiry
+// timestamp covers every scanned TLS secret, so alerts can use their own thresholds.
+var (
+	secretExpiryTimestampSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_secret_expiry_timestamp_seconds",
+			Help: "Expiry time of the leaf certificate of a TLS secret on a plane, in Unix seconds.",
+		},
+		[]string{"scanner_namespace", "scanner", "plane_kind", "plane", "namespace", "secret", "secret_reference"},
+	)
+
+	secretExpiryLastScanTimestampSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_secret_expiry_last_scan_timestamp_seconds",
+			Help: "Time of the last secret expiry scan, in Unix seconds.",
+		},
+		[]string{"scanner_namespace", "scanner"},
+	)
+
+	secretExpiryScanErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openchoreo_secret_expiry_scan_errors_total",
+			Help: "Number of secret expiry scans that could not reach every plane.",
+		},
+		[]string{"scanner_namespace", "scanner"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		secretExpiryTimestampSeconds,
+		secretExpiryLastScanTimestampSeconds,
+		secretExpiryScanErrorsTotal,
+	)
+}
+
+// observeScan replaces the expiry series of a scanner with the certificates of a scan.
+func observeScan(scanner *openchoreov1alpha1.SecretExpiryScanner, result *scanResult, now time.Time) {
+	secretExpiryTimestampSeconds.DeletePartialMatch(scannerLabels(scanner.Namespace, scanner.Name))
+	for _, c := range result.certificates {
+		secretExpiryTimestampSeconds.WithLabelValues(scanner.Namespace, scanner.Name, c.plane.Kind, c.plane.Name,
+			c.namespace, c.secretName, c.secretReference).Set(float64(c.notAfter.Unix()))
+	}
+	secretExpiryLastScanTimestampSeconds.WithLabelValues(scanner.Namespace, scanner.Name).Set(float64(now.Unix()))
+	if len(result.errors) > 0 {
+		secretExpiryScanErrorsTotal.WithLabelValues(scanner.Namespace, scanner.Name).Inc()
+	}
+}
+
+// deleteScannerMetrics removes the series of a deleted scanner.
+func deleteScannerMetrics(namespace, name string) {
+	labels := scannerLabels(namespace, name)
+	secretExpiryTimestampSeconds.DeletePartialMatch(labels)
+	secretExpiryLastScanTimestampSeconds.DeletePartialMatch(labels)
+	secretExpiryScanErrorsTotal.DeletePartialMatch(labels)
+}
+
+func scannerLabels(namespace, name string) prometheus.Labels {
+	return prometheus.Labels{"scanner_namespace": namespace, "scanner": name}
+}
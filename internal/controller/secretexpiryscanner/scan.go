@@ -0,0 +1,258 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretexpiryscanner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/secretexpiry"
+)
+
+// secretTypeField is the field selector that limits a Secret list to TLS secrets.
+const secretTypeField = "type"
+
+var externalSecretListGVK = schema.GroupVersionKind{Group: "external-secrets.io", Version: "v1", Kind: "ExternalSecretList"}
+
+// certificate is a TLS certificate found on a plane.
+type certificate struct {
+	plane           openchoreov1alpha1.TargetPlaneRef
+	namespace       string
+	secretName      string
+	secretReference string
+	subject         string
+	notAfter        time.Time
+	severity        secretexpiry.Severity
+}
+
+// scanResult is the outcome of scanning every plane of a scanner. Planes that fail are
+// recorded in errors and do not stop the scan of the others.
+type scanResult struct {
+	certificates []certificate
+	errors       []string
+}
+
+// findings returns the certificates that expire within the warning threshold, soonest first.
+func (s *scanResult) findings() []openchoreov1alpha1.SecretExpiryFinding {
+	var findings []openchoreov1alpha1.SecretExpiryFinding
+	for _, c := range s.certificates {
+		if c.severity == secretexpiry.SeverityNone {
+			continue
+		}
+		findings = append(findings, openchoreov1alpha1.SecretExpiryFinding{
+			Plane:           c.plane,
+			Namespace:       c.namespace,
+			SecretName:      c.secretName,
+			SecretReference: c.secretReference,
+			Subject:         c.subject,
+			NotAfter:        metav1.NewTime(c.notAfter),
+			Severity:        openchoreov1alpha1.SecretExpirySeverity(c.severity),
+		})
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].NotAfter.Before(&findings[j].NotAfter)
+	})
+	return findings
+}
+
+// remoteRefKey identifies a value in an external secret store.
+type remoteRefKey struct {
+	key      string
+	property string
+}
+
+// scan inspects the TLS secrets of every plane of the scanner.
+func (r *Reconciler) scan(ctx context.Context, scanner *openchoreov1alpha1.SecretExpiryScanner, now time.Time) *scanResult {
+	result := &scanResult{}
+	thresholds := thresholdsOf(scanner)
+
+	secretReferences, err := r.tlsSecretReferences(ctx, scanner.Namespace)
+	if err != nil {
+		result.errors = append(result.errors, err.Error())
+	}
+
+	for _, plane := range scanner.Spec.Planes {
+		planeClient, err := r.planeClient(ctx, scanner.Namespace, plane)
+		if err != nil {
+			result.errors = append(result.errors, fmt.Sprintf("%s %s: %v", plane.Kind, plane.Name, err))
+			continue
+		}
+		certs, err := scanPlane(ctx, planeClient, plane, scanner.Spec.Namespaces, secretReferences, now, thresholds)
+		if err != nil {
+			result.errors = append(result.errors, fmt.Sprintf("%s %s: %v", plane.Kind, plane.Name, err))
+			continue
+		}
+		result.certificates = append(result.certificates, certs...)
+	}
+	return result
+}
+
+// scanPlane lists the TLS secrets in the given namespaces of a plane, or in all namespaces
+// when none are given, and classifies their leaf certificates. Secrets synced by an
+// ExternalSecret from the same store value as a SecretReference are attributed to it.
+func scanPlane(ctx context.Context, c client.Client, plane openchoreov1alpha1.TargetPlaneRef, namespaces []string,
+	secretReferences map[remoteRefKey][]openchoreov1alpha1.SecretReference, now time.Time,
+	thresholds secretexpiry.Thresholds) ([]certificate, error) {
+	logger := log.FromContext(ctx)
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+
+	var certs []certificate
+	for _, ns := range namespaces {
+		secrets := &corev1.SecretList{}
+		if err := c.List(ctx, secrets, client.InNamespace(ns),
+			client.MatchingFields{secretTypeField: string(corev1.SecretTypeTLS)}); err != nil {
+			return nil, fmt.Errorf("failed to list TLS secrets: %w", err)
+		}
+
+		var externalSecrets map[client.ObjectKey]remoteRefKey
+		if len(secretReferences) > 0 {
+			var err error
+			if externalSecrets, err = tlsExternalSecrets(ctx, c, ns); err != nil {
+				logger.Info("Failed to list ExternalSecrets; secrets are not attributed to SecretReferences",
+					"plane", plane.Name, "error", err.Error())
+			}
+		}
+
+		for i := range secrets.Items {
+			secret := &secrets.Items[i]
+			cert, err := secretexpiry.LeafCertificate(secret.Data[corev1.TLSCertKey])
+			if err != nil {
+				logger.V(1).Info("Skipping TLS secret without a valid certificate",
+					"plane", plane.Name, "namespace", secret.Namespace, "secret", secret.Name, "error", err.Error())
+				continue
+			}
+			certs = append(certs, certificate{
+				plane:           plane,
+				namespace:       secret.Namespace,
+				secretName:      secret.Name,
+				secretReference: secretReferenceOf(secret, plane, externalSecrets, secretReferences),
+				subject:         cert.Subject.CommonName,
+				notAfter:        cert.NotAfter.UTC(),
+				severity:        secretexpiry.Classify(cert.NotAfter, now, thresholds),
+			})
+		}
+	}
+	return certs, nil
+}
+
+// tlsExternalSecrets maps the ExternalSecrets of a namespace to the store value they sync
+// into the tls.crt key. A missing ExternalSecret CRD is not an error.
+func tlsExternalSecrets(ctx context.Context, c client.Client, namespace string) (map[client.ObjectKey]remoteRefKey, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(externalSecretListGVK)
+	if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		if apimeta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	refs := make(map[client.ObjectKey]remoteRefKey, len(list.Items))
+	for _, es := range list.Items {
+		data, _, _ := unstructured.NestedSlice(es.Object, "spec", "data")
+		for _, item := range data {
+			entry, ok := item.(map[string]any)
+			if !ok || entry["secretKey"] != corev1.TLSCertKey {
+				continue
+			}
+			key, _, _ := unstructured.NestedString(entry, "remoteRef", "key")
+			property, _, _ := unstructured.NestedString(entry, "remoteRef", "property")
+			refs[client.ObjectKeyFromObject(&es)] = remoteRefKey{key: key, property: property}
+		}
+	}
+	return refs, nil
+}
+
+// secretReferenceOf returns the SecretReference a secret is synced from: the secret is owned
+// by an ExternalSecret whose tls.crt comes from the same store value as the SecretReference,
+// and the SecretReference targets the scanned plane or no plane in particular.
+func secretReferenceOf(secret *corev1.Secret, plane openchoreov1alpha1.TargetPlaneRef,
+	externalSecrets map[client.ObjectKey]remoteRefKey, secretReferences map[remoteRefKey][]openchoreov1alpha1.SecretReference) string {
+	for _, owner := range secret.OwnerReferences {
+		if owner.Kind != "ExternalSecret" {
+			continue
+		}
+		ref, ok := externalSecrets[client.ObjectKey{Namespace: secret.Namespace, Name: owner.Name}]
+		if !ok {
+			continue
+		}
+		for _, sr := range secretReferences[ref] {
+			if target := sr.Spec.TargetPlane; target == nil || *target == plane {
+				return sr.Name
+			}
+		}
+	}
+	return ""
+}
+
+// tlsSecretReferences indexes the kubernetes.io/tls SecretReferences of a namespace by the
+// store value of their tls.crt key.
+func (r *Reconciler) tlsSecretReferences(ctx context.Context, namespace string) (map[remoteRefKey][]openchoreov1alpha1.SecretReference, error) {
+	list := &openchoreov1alpha1.SecretReferenceList{}
+	if err := r.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list SecretReferences: %w", err)
+	}
+	refs := map[remoteRefKey][]openchoreov1alpha1.SecretReference{}
+	for _, sr := range list.Items {
+		if sr.Spec.Template.Type != corev1.SecretTypeTLS {
+			continue
+		}
+		for _, d := range sr.Spec.Data {
+			if d.SecretKey == corev1.TLSCertKey {
+				key := remoteRefKey{key: d.RemoteRef.Key, property: d.RemoteRef.Property}
+				refs[key] = append(refs[key], sr)
+			}
+		}
+	}
+	return refs, nil
+}
+
+// planeClient returns a client for a scanned plane. Namespaced planes are looked up in the
+// namespace of the scanner.
+func (r *Reconciler) planeClient(ctx context.Context, namespace string, plane openchoreov1alpha1.TargetPlaneRef) (client.Client, error) {
+	if r.PlaneClientProvider == nil {
+		return nil, fmt.Errorf("plane client provider is not configured")
+	}
+	switch plane.Kind {
+	case "DataPlane":
+		dp := &openchoreov1alpha1.DataPlane{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: plane.Name}, dp); err != nil {
+			return nil, fmt.Errorf("failed to get DataPlane: %w", err)
+		}
+		return r.PlaneClientProvider.DataPlaneClient(dp)
+	case "ClusterDataPlane":
+		cdp := &openchoreov1alpha1.ClusterDataPlane{}
+		if err := r.Get(ctx, client.ObjectKey{Name: plane.Name}, cdp); err != nil {
+			return nil, fmt.Errorf("failed to get ClusterDataPlane: %w", err)
+		}
+		return r.PlaneClientProvider.ClusterDataPlaneClient(cdp)
+	case "WorkflowPlane":
+		wp := &openchoreov1alpha1.WorkflowPlane{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: plane.Name}, wp); err != nil {
+			return nil, fmt.Errorf("failed to get WorkflowPlane: %w", err)
+		}
+		return r.PlaneClientProvider.WorkflowPlaneClient(wp)
+	case "ClusterWorkflowPlane":
+		cwp := &openchoreov1alpha1.ClusterWorkflowPlane{}
+		if err := r.Get(ctx, client.ObjectKey{Name: plane.Name}, cwp); err != nil {
+			return nil, fmt.Errorf("failed to get ClusterWorkflowPlane: %w", err)
+		}
+		return r.PlaneClientProvider.ClusterWorkflowPlaneClient(cwp)
+	default:
+		return nil, fmt.Errorf("unsupported plane kind %q", plane.Kind)
+	}
+}
@@ -5,16 +5,34 @@ package secretreference
 
 import (
 	"context"
+	"fmt"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/vaultsecret"
 )
 
-// Reconciler reconciles a SecretReference object
+const (
+	// ConditionReady indicates whether the SecretReference can be resolved by the data plane.
+	ConditionReady controller.ConditionType = "Ready"
+
+	// ReasonExternalSecretStore indicates the values are read from the plane's external secret store.
+	ReasonExternalSecretStore controller.ConditionReason = "ExternalSecretStore"
+	// ReasonVaultSourceValid indicates the Vault source is valid.
+	ReasonVaultSourceValid controller.ConditionReason = "VaultSourceValid"
+	// ReasonInvalidVaultSource indicates the Vault source cannot be used.
+	ReasonInvalidVaultSource controller.ConditionReason = "InvalidVaultSource"
+)
+
+// Reconciler reconciles a SecretReference object by validating where its values are read
+// from. The data plane resources that deliver the values are rendered with the components
+// that use the reference.
 type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
@@ -24,21 +42,37 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/finalizers,verbs=update
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the SecretReference object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
 
-	// TODO(user): your logic here
+	secretRef := &openchoreodevv1alpha1.SecretReference{}
+	if err := r.Get(ctx, req.NamespacedName, secretRef); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get SecretReference")
+		return ctrl.Result{}, err
+	}
+
+	old := secretRef.DeepCopy()
+	markReady(secretRef)
+	return controller.UpdateStatusConditionsAndReturn(ctx, r.Client, old, secretRef)
+}
 
-	return ctrl.Result{}, nil
+// markReady sets the Ready condition from the source of the secret values.
+func markReady(secretRef *openchoreodevv1alpha1.SecretReference) {
+	vault := secretRef.Spec.Vault
+	if vault == nil {
+		controller.MarkTrueCondition(secretRef, ConditionReady, ReasonExternalSecretStore,
+			"Secret values are read from the external secret store of the plane")
+		return
+	}
+	if err := vaultsecret.Validate(vault); err != nil {
+		controller.MarkFalseCondition(secretRef, ConditionReady, ReasonInvalidVaultSource, err.Error())
+		return
+	}
+	controller.MarkTrueCondition(secretRef, ConditionReady, ReasonVaultSourceValid,
+		fmt.Sprintf("Secret values are read from Vault at %s", vault.Server))
 }
 
 // SetupWithManager sets up the controller with the Manager.
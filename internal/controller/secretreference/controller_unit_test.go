@@ -0,0 +1,65 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestMarkReady(t *testing.T) {
+	tests := []struct {
+		name       string
+		vault      *openchoreov1alpha1.VaultSource
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "external secret store",
+			wantStatus: metav1.ConditionTrue,
+			wantReason: string(ReasonExternalSecretStore),
+		},
+		{
+			name: "valid vault source",
+			vault: &openchoreov1alpha1.VaultSource{
+				Server: "https://vault.example.com:8200",
+				Path:   "secret",
+				Auth:   openchoreov1alpha1.VaultAuth{Role: "reading-list"},
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: string(ReasonVaultSourceValid),
+		},
+		{
+			name: "vault server without host",
+			vault: &openchoreov1alpha1.VaultSource{
+				Server: "https://",
+				Path:   "secret",
+				Auth:   openchoreov1alpha1.VaultAuth{Role: "reading-list"},
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonInvalidVaultSource),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secretRef := &openchoreov1alpha1.SecretReference{
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "acme", Generation: 2},
+				Spec:       openchoreov1alpha1.SecretReferenceSpec{Vault: tt.vault},
+			}
+			markReady(secretRef)
+
+			cond := apimeta.FindStatusCondition(secretRef.Status.Conditions, string(ConditionReady))
+			require.NotNil(t, cond)
+			assert.Equal(t, tt.wantStatus, cond.Status)
+			assert.Equal(t, tt.wantReason, cond.Reason)
+			assert.Equal(t, int64(2), cond.ObservedGeneration)
+		})
+	}
+}
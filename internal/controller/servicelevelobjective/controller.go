@@ -0,0 +1,266 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package servicelevelobjective
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/slo"
+)
+
+const (
+	// errorBudgetPath is the error budget endpoint of the observer internal API.
+	errorBudgetPath = "/api/v1alpha1/slos/error-budget"
+	// evaluationInterval is how often the error budget is re-evaluated.
+	evaluationInterval = 5 * time.Minute
+)
+
+// Condition types and reasons for ServiceLevelObjective.
+const (
+	// ConditionEvaluated indicates whether the last error budget evaluation succeeded.
+	ConditionEvaluated controller.ConditionType = "Evaluated"
+	// ConditionErrorBudgetExhausted is True while no error budget is left.
+	ConditionErrorBudgetExhausted controller.ConditionType = "ErrorBudgetExhausted"
+
+	// ReasonEvaluationSucceeded indicates the observer evaluated the objective.
+	ReasonEvaluationSucceeded controller.ConditionReason = "EvaluationSucceeded"
+	// ReasonEvaluationFailed indicates the observer could not evaluate the objective.
+	ReasonEvaluationFailed controller.ConditionReason = "EvaluationFailed"
+	// ReasonBudgetExhausted indicates no error budget is left.
+	ReasonBudgetExhausted controller.ConditionReason = "BudgetExhausted"
+	// ReasonBudgetAvailable indicates error budget is left.
+	ReasonBudgetAvailable controller.ConditionReason = "BudgetAvailable"
+)
+
+// errorBudgetRequest is the payload sent to the observer error budget endpoint.
+type errorBudgetRequest struct {
+	SearchScope        errorBudgetSearchScope       `json:"searchScope"`
+	Endpoint           string                       `json:"endpoint,omitempty"`
+	AvailabilityTarget string                       `json:"availabilityTarget,omitempty"`
+	Latency            *errorBudgetLatencyObjective `json:"latency,omitempty"`
+	Window             string                       `json:"window"`
+}
+
+type errorBudgetSearchScope struct {
+	Namespace   string `json:"namespace"`
+	Project     string `json:"project"`
+	Component   string `json:"component"`
+	Environment string `json:"environment"`
+}
+
+type errorBudgetLatencyObjective struct {
+	Percentile       string  `json:"percentile,omitempty"`
+	ThresholdSeconds float64 `json:"thresholdSeconds"`
+}
+
+// errorBudgetResponse is the response from the observer error budget endpoint.
+type errorBudgetResponse struct {
+	EndTime                time.Time `json:"endTime"`
+	TotalRequests          float64   `json:"totalRequests"`
+	FailedRequests         float64   `json:"failedRequests"`
+	Availability           *float64  `json:"availability,omitempty"`
+	ErrorBudgetRemaining   *float64  `json:"errorBudgetRemaining,omitempty"`
+	BurnRate               *float64  `json:"burnRate,omitempty"`
+	Exhausted              bool      `json:"exhausted"`
+	ObservedLatencySeconds *float64  `json:"observedLatencySeconds,omitempty"`
+	LatencyMet             *bool     `json:"latencyMet,omitempty"`
+}
+
+// Reconciler reconciles a ServiceLevelObjective object by periodically asking the
+// observability plane to evaluate its error budget and recording the result in status.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// ObserverBaseURL overrides the observer internal base URL. Defaults to
+	// OBSERVER_INTERNAL_ENDPOINT or the in-cluster observer-internal service.
+	ObserverBaseURL string
+
+	httpClient *http.Client
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=servicelevelobjectives,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=servicelevelobjectives/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	sloObj := &openchoreov1alpha1.ServiceLevelObjective{}
+	if err := r.Get(ctx, req.NamespacedName, sloObj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get ServiceLevelObjective")
+		return ctrl.Result{}, err
+	}
+
+	old := sloObj.DeepCopy()
+	sloObj.Status.ObservedGeneration = sloObj.Generation
+
+	resp, err := r.evaluate(ctx, buildErrorBudgetRequest(sloObj))
+	if err != nil {
+		logger.Info("Failed to evaluate error budget", "error", err.Error())
+		controller.MarkFalseCondition(sloObj, ConditionEvaluated, ReasonEvaluationFailed, err.Error())
+	} else {
+		wasExhausted := old.Status.ErrorBudget != nil && old.Status.ErrorBudget.Exhausted
+		applyErrorBudget(sloObj, resp)
+		controller.MarkTrueCondition(sloObj, ConditionEvaluated, ReasonEvaluationSucceeded,
+			"Error budget evaluated by the observability plane")
+		if resp.Exhausted && !wasExhausted && r.Recorder != nil {
+			r.Recorder.Eventf(sloObj, corev1.EventTypeWarning, string(ReasonBudgetExhausted),
+				"Error budget of component %q in environment %q is exhausted",
+				sloObj.Spec.Owner.ComponentName, sloObj.Spec.Environment)
+		}
+	}
+
+	if !apiequality.Semantic.DeepEqual(old.Status, sloObj.Status) {
+		if err := r.Status().Update(ctx, sloObj); err != nil {
+			logger.Error(err, "Failed to update ServiceLevelObjective status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: evaluationInterval}, nil
+}
+
+// buildErrorBudgetRequest converts the ServiceLevelObjective spec into the observer request payload.
+func buildErrorBudgetRequest(sloObj *openchoreov1alpha1.ServiceLevelObjective) *errorBudgetRequest {
+	spec := sloObj.Spec
+	window := spec.Window.Duration
+	if window <= 0 {
+		window = 30 * 24 * time.Hour
+	}
+	req := &errorBudgetRequest{
+		SearchScope: errorBudgetSearchScope{
+			Namespace:   sloObj.Namespace,
+			Project:     spec.Owner.ProjectName,
+			Component:   spec.Owner.ComponentName,
+			Environment: spec.Environment,
+		},
+		Endpoint: spec.Endpoint,
+		Window:   window.String(),
+	}
+	if spec.Availability != nil {
+		req.AvailabilityTarget = spec.Availability.Target
+	}
+	if spec.Latency != nil {
+		req.Latency = &errorBudgetLatencyObjective{
+			Percentile:       string(spec.Latency.Percentile),
+			ThresholdSeconds: spec.Latency.Threshold.Seconds(),
+		}
+	}
+	return req
+}
+
+// applyErrorBudget records the observer response in status and updates the exhausted condition.
+func applyErrorBudget(sloObj *openchoreov1alpha1.ServiceLevelObjective, resp *errorBudgetResponse) {
+	evaluatedAt := metav1.NewTime(resp.EndTime)
+	if resp.EndTime.IsZero() {
+		evaluatedAt = metav1.Now()
+	}
+	budget := &openchoreov1alpha1.ErrorBudgetStatus{
+		TotalRequests:  int64(resp.TotalRequests),
+		FailedRequests: int64(resp.FailedRequests),
+		Exhausted:      resp.Exhausted,
+		LatencyMet:     resp.LatencyMet,
+		EvaluatedAt:    evaluatedAt,
+	}
+	if resp.Availability != nil {
+		budget.Availability = slo.FormatRatio(*resp.Availability)
+	}
+	if resp.ErrorBudgetRemaining != nil {
+		budget.Remaining = slo.FormatRatio(*resp.ErrorBudgetRemaining)
+	}
+	if resp.BurnRate != nil {
+		budget.BurnRate = slo.FormatRatio(*resp.BurnRate)
+	}
+	if resp.ObservedLatencySeconds != nil {
+		budget.ObservedLatency = &metav1.Duration{
+			Duration: time.Duration(*resp.ObservedLatencySeconds * float64(time.Second)),
+		}
+	}
+	sloObj.Status.ErrorBudget = budget
+
+	if resp.Exhausted {
+		controller.MarkTrueCondition(sloObj, ConditionErrorBudgetExhausted, ReasonBudgetExhausted,
+			fmt.Sprintf("Error budget exhausted (remaining %s)", budget.Remaining))
+	} else {
+		controller.MarkFalseCondition(sloObj, ConditionErrorBudgetExhausted, ReasonBudgetAvailable,
+			fmt.Sprintf("Error budget remaining %s", budget.Remaining))
+	}
+}
+
+// evaluate calls POST /api/v1alpha1/slos/error-budget on the observer internal API.
+func (r *Reconciler) evaluate(ctx context.Context, payload *errorBudgetRequest) (*errorBudgetResponse, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, controller.ObserverAPITimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodPost, controller.ObserverInternalBaseURL(r.ObserverBaseURL)+errorBudgetPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("error budget request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errBody struct {
+			Message string `json:"message,omitempty"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errBody)
+		return nil, fmt.Errorf("observer error budget API returned status %d: %s", resp.StatusCode, errBody.Message)
+	}
+
+	var out errorBudgetResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &out, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.httpClient == nil {
+		r.httpClient = &http.Client{Timeout: controller.ObserverAPITimeout}
+	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("servicelevelobjective-controller")
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates must not trigger a re-evaluation; the budget is refreshed periodically.
+		For(&openchoreov1alpha1.ServiceLevelObjective{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("servicelevelobjective").
+		Complete(r)
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package servicelevelobjective
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newTestSLO() *openchoreov1alpha1.ServiceLevelObjective {
+	return &openchoreov1alpha1.ServiceLevelObjective{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-availability", Namespace: "default", Generation: 1},
+		Spec: openchoreov1alpha1.ServiceLevelObjectiveSpec{
+			Owner:        openchoreov1alpha1.ServiceLevelObjectiveOwner{ProjectName: "proj", ComponentName: "api"},
+			Environment:  "production",
+			Endpoint:     "http",
+			Availability: &openchoreov1alpha1.AvailabilityObjective{Target: "99.9"},
+			Latency: &openchoreov1alpha1.LatencyObjective{
+				Percentile: openchoreov1alpha1.LatencyPercentileP99,
+				Threshold:  metav1.Duration{Duration: 500 * time.Millisecond},
+			},
+			Window: metav1.Duration{Duration: 720 * time.Hour},
+		},
+	}
+}
+
+func newTestReconciler(t *testing.T, handler http.HandlerFunc) (*Reconciler, *record.FakeRecorder) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(newTestSLO()).
+		WithStatusSubresource(&openchoreov1alpha1.ServiceLevelObjective{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	return &Reconciler{
+		Client:          c,
+		Scheme:          s,
+		Recorder:        recorder,
+		ObserverBaseURL: server.URL,
+		httpClient:      server.Client(),
+	}, recorder
+}
+
+func reconcileSLO(t *testing.T, r *Reconciler) *openchoreov1alpha1.ServiceLevelObjective {
+	t.Helper()
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "api-availability"}
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, evaluationInterval, result.RequeueAfter)
+	out := &openchoreov1alpha1.ServiceLevelObjective{}
+	require.NoError(t, r.Get(ctx, key, out))
+	return out
+}
+
+func TestReconcileRecordsErrorBudget(t *testing.T) {
+	var got errorBudgetRequest
+	r, recorder := newTestReconciler(t, func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, errorBudgetPath, req.URL.Path)
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"endTime":"2026-01-31T00:00:00Z","totalRequests":10000,"failedRequests":25,` +
+			`"availability":99.75,"errorBudgetRemaining":-1.5,"burnRate":3,"exhausted":true,` +
+			`"observedLatencySeconds":0.25,"latencyMet":true}`))
+	})
+
+	out := reconcileSLO(t, r)
+
+	assert.Equal(t, errorBudgetSearchScope{
+		Namespace: "default", Project: "proj", Component: "api", Environment: "production",
+	}, got.SearchScope)
+	assert.Equal(t, "http", got.Endpoint)
+	assert.Equal(t, "99.9", got.AvailabilityTarget)
+	assert.Equal(t, "720h0m0s", got.Window)
+	require.NotNil(t, got.Latency)
+	assert.InDelta(t, 0.5, got.Latency.ThresholdSeconds, 1e-9)
+
+	budget := out.Status.ErrorBudget
+	require.NotNil(t, budget)
+	assert.Equal(t, int64(10000), budget.TotalRequests)
+	assert.Equal(t, int64(25), budget.FailedRequests)
+	assert.Equal(t, "99.7500", budget.Availability)
+	assert.Equal(t, "-1.5000", budget.Remaining)
+	assert.Equal(t, "3.0000", budget.BurnRate)
+	assert.True(t, budget.Exhausted)
+	require.NotNil(t, budget.ObservedLatency)
+	assert.Equal(t, 250*time.Millisecond, budget.ObservedLatency.Duration)
+	assert.True(t, apimeta.IsStatusConditionTrue(out.Status.Conditions, string(ConditionEvaluated)))
+	assert.True(t, apimeta.IsStatusConditionTrue(out.Status.Conditions, string(ConditionErrorBudgetExhausted)))
+	assert.Contains(t, <-recorder.Events, string(ReasonBudgetExhausted))
+}
+
+func TestReconcileRecordsEvaluationFailure(t *testing.T) {
+	r, _ := newTestReconciler(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"Failed to evaluate error budget"}`))
+	})
+
+	out := reconcileSLO(t, r)
+
+	assert.Nil(t, out.Status.ErrorBudget)
+	cond := apimeta.FindStatusCondition(out.Status.Conditions, string(ConditionEvaluated))
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, string(ReasonEvaluationFailed), cond.Reason)
+	assert.Contains(t, cond.Message, "status 500")
+}
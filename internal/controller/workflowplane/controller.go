@@ -36,11 +36,16 @@ type Reconciler struct {
 	ClientMgr     *kubernetesClient.KubeMultiClientManager
 	GatewayClient *gatewayClient.Client // Client for notifying cluster-gateway
 	CacheVersion  string                // Cache key version prefix (e.g., "v2")
+
+	// PlaneClientProvider reaches the workflow plane cluster to keep standby build nodes warm.
+	// Standby build nodes are not managed when nil.
+	PlaneClientProvider kubernetesClient.WorkflowPlaneClientProvider
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowplanes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowplanes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowplanes/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -72,6 +77,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Validate the connection details so that a broken configuration surfaces on the
+	// Validated condition instead of when an agent first connects
+	validationErr := controller.ValidateClusterAgent(ctx, r.Client, workflowPlane.Namespace, &workflowPlane.Spec.ClusterAgent)
+	meta.SetStatusCondition(&workflowPlane.Status.Conditions,
+		controller.NewClusterAgentValidatedCondition(workflowPlane.Generation, validationErr))
+
 	// Invalidate cached Kubernetes client on UPDATE
 	// This ensures that any changes to the WorkflowPlane CR (kubeconfig, credentials, etc.)
 	// trigger a new client to be created with the updated configuration
@@ -110,6 +121,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				logger.Error(err, "failed to get agent connection status")
 				// Don't fail reconciliation for status query errors
 			}
+			if err := r.reconcileStandbyBuildNodes(ctx, workflowPlane); err != nil {
+				logger.Error(err, "failed to reconcile standby build nodes")
+				// Don't fail reconciliation for standby build node errors
+			}
 		} else {
 			logger.Info("skipping immediate status poll after spec-change notification, agents may be reconnecting")
 		}
@@ -153,6 +168,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			logger.Error(err, "failed to get agent connection status")
 			// Don't fail reconciliation for status query errors
 		}
+		if err := r.reconcileStandbyBuildNodes(ctx, workflowPlane); err != nil {
+			logger.Error(err, "failed to reconcile standby build nodes")
+			// Don't fail reconciliation for standby build node errors
+		}
 	} else {
 		logger.Info("skipping immediate status poll after gateway notification, agents may be reconnecting")
 	}
@@ -194,6 +213,12 @@ func (r *Reconciler) finalize(ctx context.Context, _, workflowPlane *openchoreov
 		return ctrl.Result{}, nil
 	}
 
+	// Remove the standby build nodes while the agent can still reach the workflow plane.
+	// Cleanup is best effort so that an unreachable workflow plane does not block deletion.
+	if err := r.deleteStandbyBuildNodes(ctx, workflowPlane); err != nil {
+		logger.Error(err, "failed to delete standby build nodes")
+	}
+
 	// Notify gateway of WorkflowPlane deletion before removing finalizer
 	if r.GatewayClient != nil {
 		if err := r.notifyGateway(ctx, workflowPlane, "deleted"); err != nil {
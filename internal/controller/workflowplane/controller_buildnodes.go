@@ -0,0 +1,54 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/buildnodes"
+)
+
+// reconcileStandbyBuildNodes scales the standby build nodes of the WorkflowPlane to its pre-warm
+// schedule and records them in the status (without persisting to API server). It is skipped
+// while no agent is connected because the workflow plane cannot be reached.
+func (r *Reconciler) reconcileStandbyBuildNodes(ctx context.Context, workflowPlane *openchoreov1alpha1.WorkflowPlane) error {
+	if r.PlaneClientProvider == nil {
+		return nil
+	}
+	if workflowPlane.Status.AgentConnection == nil || !workflowPlane.Status.AgentConnection.Connected {
+		return nil
+	}
+	// Nothing was ever created for planes that do not pre-warm build nodes.
+	buildNodes := workflowPlane.Spec.BuildNodes
+	if (buildNodes == nil || buildNodes.PreWarm == nil) && workflowPlane.Status.StandbyBuildNodes == nil {
+		return nil
+	}
+
+	wpClient, err := r.PlaneClientProvider.WorkflowPlaneClient(workflowPlane)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow plane client: %w", err)
+	}
+	status, err := buildnodes.ReconcileStandby(ctx, wpClient, buildnodes.Plane{Namespace: workflowPlane.Namespace, Name: workflowPlane.Name},
+		buildNodes, time.Now())
+	if err != nil {
+		return err
+	}
+	workflowPlane.Status.StandbyBuildNodes = status
+	return nil
+}
+
+// deleteStandbyBuildNodes removes the standby build nodes of a WorkflowPlane that is being deleted.
+func (r *Reconciler) deleteStandbyBuildNodes(ctx context.Context, workflowPlane *openchoreov1alpha1.WorkflowPlane) error {
+	if r.PlaneClientProvider == nil || workflowPlane.Status.StandbyBuildNodes == nil {
+		return nil
+	}
+	wpClient, err := r.PlaneClientProvider.WorkflowPlaneClient(workflowPlane)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow plane client: %w", err)
+	}
+	return buildnodes.DeleteStandby(ctx, wpClient, buildnodes.Plane{Namespace: workflowPlane.Namespace, Name: workflowPlane.Name})
+}
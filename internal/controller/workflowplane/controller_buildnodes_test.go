@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowplane
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// fakeWorkflowPlaneClientProvider returns the same client for every workflow plane.
+type fakeWorkflowPlaneClientProvider struct {
+	client client.Client
+}
+
+func (f *fakeWorkflowPlaneClientProvider) WorkflowPlaneClient(_ *openchoreov1alpha1.WorkflowPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func (f *fakeWorkflowPlaneClientProvider) ClusterWorkflowPlaneClient(_ *openchoreov1alpha1.ClusterWorkflowPlane) (client.Client, error) {
+	return f.client, nil
+}
+
+func newBuildNodesPlane(connected bool) *openchoreov1alpha1.WorkflowPlane {
+	return &openchoreov1alpha1.WorkflowPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "default"},
+		Spec: openchoreov1alpha1.WorkflowPlaneSpec{
+			BuildNodes: &openchoreov1alpha1.WorkflowBuildNodesSpec{
+				PreWarm: &openchoreov1alpha1.BuildNodePreWarmSpec{
+					Nodes:     2,
+					Resources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")},
+					// An all-day window keeps the test independent of the time it runs at.
+					Schedule: []openchoreov1alpha1.PreWarmWindow{{Start: "00:00", End: "23:59"}, {Start: "23:59", End: "00:00"}},
+				},
+			},
+		},
+		Status: openchoreov1alpha1.WorkflowPlaneStatus{
+			AgentConnection: &openchoreov1alpha1.AgentConnectionStatus{Connected: connected},
+		},
+	}
+}
+
+func newBuildNodesReconciler(t *testing.T) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	wpClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	return &Reconciler{PlaneClientProvider: &fakeWorkflowPlaneClientProvider{client: wpClient}}, wpClient
+}
+
+func TestReconcileStandbyBuildNodes(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keeps standby build nodes on connected planes", func(t *testing.T) {
+		r, wpClient := newBuildNodesReconciler(t)
+		workflowPlane := newBuildNodesPlane(true)
+
+		if err := r.reconcileStandbyBuildNodes(ctx, workflowPlane); err != nil {
+			t.Fatalf("reconcileStandbyBuildNodes() error = %v", err)
+		}
+		if got := workflowPlane.Status.StandbyBuildNodes; got == nil || got.Desired != 2 {
+			t.Errorf("expected 2 desired standby build nodes, got %+v", got)
+		}
+		var deployments appsv1.DeploymentList
+		if err := wpClient.List(ctx, &deployments); err != nil {
+			t.Fatalf("failed to list deployments: %v", err)
+		}
+		if len(deployments.Items) != 1 {
+			t.Errorf("expected 1 placeholder deployment, got %d", len(deployments.Items))
+		}
+	})
+
+	t.Run("skips disconnected planes", func(t *testing.T) {
+		r, wpClient := newBuildNodesReconciler(t)
+		workflowPlane := newBuildNodesPlane(false)
+
+		if err := r.reconcileStandbyBuildNodes(ctx, workflowPlane); err != nil {
+			t.Fatalf("reconcileStandbyBuildNodes() error = %v", err)
+		}
+		if workflowPlane.Status.StandbyBuildNodes != nil {
+			t.Errorf("expected no standby status, got %+v", workflowPlane.Status.StandbyBuildNodes)
+		}
+		var deployments appsv1.DeploymentList
+		if err := wpClient.List(ctx, &deployments); err != nil {
+			t.Fatalf("failed to list deployments: %v", err)
+		}
+		if len(deployments.Items) != 0 {
+			t.Errorf("expected no placeholder deployment, got %d", len(deployments.Items))
+		}
+	})
+
+	t.Run("clears the status when pre-warming is disabled", func(t *testing.T) {
+		r, _ := newBuildNodesReconciler(t)
+		workflowPlane := newBuildNodesPlane(true)
+		if err := r.reconcileStandbyBuildNodes(ctx, workflowPlane); err != nil {
+			t.Fatalf("reconcileStandbyBuildNodes() error = %v", err)
+		}
+
+		workflowPlane.Spec.BuildNodes = nil
+		if err := r.reconcileStandbyBuildNodes(ctx, workflowPlane); err != nil {
+			t.Fatalf("reconcileStandbyBuildNodes() error = %v", err)
+		}
+		if workflowPlane.Status.StandbyBuildNodes != nil {
+			t.Errorf("expected the standby status to be cleared, got %+v", workflowPlane.Status.StandbyBuildNodes)
+		}
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"fmt"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/analysisreport"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+// syncAnalysisResults collects the static analysis findings of the run once its analysis
+// step has finished. Like test results, findings are collected only once.
+func syncAnalysisResults(workflowRun *openchoreodevv1alpha1.WorkflowRun, nodes argoproj.Nodes) {
+	if workflowRun.Status.AnalysisResults != nil || workflowRun.Status.ResolvedTemplate == nil ||
+		workflowRun.Status.ResolvedTemplate.Analysis == nil {
+		return
+	}
+	results := collectAnalysisResults(workflowRun.Status.ResolvedTemplate.Analysis, nodes)
+	if results == nil {
+		return
+	}
+	workflowRun.Status.AnalysisResults = results
+	setAnalysisCondition(workflowRun, results)
+}
+
+// collectAnalysisResults reads the SARIF report from the output parameter of the analysis step.
+// Returns nil while the analysis step has not finished, or when it was skipped.
+func collectAnalysisResults(analysis *openchoreodevv1alpha1.WorkflowAnalysisSpec, nodes argoproj.Nodes) *openchoreodevv1alpha1.WorkflowAnalysisResults {
+	parameter := analysis.GetReportParameter()
+	report, finished := readStepOutput(nodes, analysis.Step, parameter)
+	if !finished {
+		return nil
+	}
+
+	var results *openchoreodevv1alpha1.WorkflowAnalysisResults
+	if report == "" {
+		results = &openchoreodevv1alpha1.WorkflowAnalysisResults{
+			Message: fmt.Sprintf("analysis step %q did not produce the %q output parameter", analysis.Step, parameter),
+		}
+	} else if parsed, err := analysisreport.Parse([]byte(report)); err != nil {
+		results = &openchoreodevv1alpha1.WorkflowAnalysisResults{Message: err.Error()}
+	} else {
+		results = parsed
+	}
+	results.Step = analysis.Step
+	return results
+}
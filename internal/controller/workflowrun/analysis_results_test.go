@@ -0,0 +1,96 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+const sarifReport = `{"version": "2.1.0", "runs": [{"tool": {"driver": {"name": "golangci-lint"}}, "results": [
+  {"ruleId": "errcheck", "level": "error", "message": {"text": "unchecked error"}},
+  {"ruleId": "unused", "level": "warning", "message": {"text": "unused func"}}
+]}]}`
+
+func lintStepNodes(phase argoproj.NodePhase, params ...argoproj.Parameter) argoproj.Nodes {
+	return argoproj.Nodes{
+		"lint-node": {
+			Name:        "wf[1].lint",
+			DisplayName: "lint",
+			Type:        argoproj.NodeTypePod,
+			Phase:       phase,
+			Outputs:     &argoproj.Outputs{Parameters: params},
+		},
+	}
+}
+
+func TestCollectAnalysisResults(t *testing.T) {
+	analysis := &openchoreodevv1alpha1.WorkflowAnalysisSpec{Step: "lint"}
+
+	t.Run("returns nil while the analysis step is running", func(t *testing.T) {
+		if got := collectAnalysisResults(analysis, lintStepNodes(argoproj.NodeRunning)); got != nil {
+			t.Errorf("expected nil, got %+v", got)
+		}
+	})
+
+	t.Run("parses the report of a finished analysis step", func(t *testing.T) {
+		nodes := lintStepNodes(argoproj.NodeSucceeded,
+			reportParameter(openchoreodevv1alpha1.DefaultAnalysisReportParameter, sarifReport))
+		got := collectAnalysisResults(analysis, nodes)
+		if got == nil {
+			t.Fatal("expected results, got nil")
+		}
+		if got.Step != "lint" || got.High != 1 || got.Medium != 1 || len(got.Findings) != 2 {
+			t.Errorf("unexpected results: %+v", got)
+		}
+	})
+
+	t.Run("missing report", func(t *testing.T) {
+		got := collectAnalysisResults(analysis, lintStepNodes(argoproj.NodeFailed))
+		if got == nil || !strings.Contains(got.Message, `did not produce the "sarif-report" output parameter`) {
+			t.Errorf("expected a missing-report message, got %+v", got)
+		}
+	})
+
+	t.Run("invalid report", func(t *testing.T) {
+		nodes := lintStepNodes(argoproj.NodeSucceeded,
+			reportParameter(openchoreodevv1alpha1.DefaultAnalysisReportParameter, "main.go:1: unchecked error"))
+		got := collectAnalysisResults(analysis, nodes)
+		if got == nil || !strings.Contains(got.Message, "invalid SARIF report") {
+			t.Errorf("expected an invalid-report message, got %+v", got)
+		}
+	})
+}
+
+func TestSyncAnalysisResults(t *testing.T) {
+	run := &openchoreodevv1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "run-1", Generation: 1},
+		Status: openchoreodevv1alpha1.WorkflowRunStatus{
+			ResolvedTemplate: &openchoreodevv1alpha1.ResolvedWorkflowTemplate{
+				Analysis: &openchoreodevv1alpha1.WorkflowAnalysisSpec{Step: "lint", ReportParameter: "report"},
+			},
+		},
+	}
+	syncAnalysisResults(run, lintStepNodes(argoproj.NodeSucceeded, reportParameter("report", sarifReport)))
+
+	if run.Status.AnalysisResults == nil || run.Status.AnalysisResults.High != 1 {
+		t.Fatalf("expected 1 high finding, got %+v", run.Status.AnalysisResults)
+	}
+	cond := meta.FindStatusCondition(run.Status.Conditions, string(ConditionAnalysisCompleted))
+	if cond == nil {
+		t.Fatal("expected AnalysisCompleted condition")
+	}
+	if cond.Status != metav1.ConditionTrue || cond.Reason != string(ReasonAnalysisCompleted) {
+		t.Errorf("unexpected condition: %+v", cond)
+	}
+	if cond.Message != "0 critical, 1 high, 1 medium, 0 low and 0 informational findings" {
+		t.Errorf("unexpected condition message: %q", cond.Message)
+	}
+}
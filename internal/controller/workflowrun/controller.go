@@ -22,6 +22,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/airgap"
+	"github.com/openchoreo/openchoreo/internal/buildnodes"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/cmdutil"
 	"github.com/openchoreo/openchoreo/internal/controller"
@@ -38,6 +40,15 @@ type Reconciler struct {
 	// Pipeline is the workflow rendering pipeline, shared across all reconciliations.
 	// This enables CEL environment caching across different workflow runs and reconciliations.
 	Pipeline *workflowpipeline.Pipeline
+
+	// AirGap rewrites external artifact references in rendered runs through the configured
+	// mirrors and, in air-gapped mode, rejects runs that would still pull from outside.
+	// A nil policy leaves rendered runs untouched.
+	AirGap *airgap.Policy
+
+	// CredentialBroker issues broker tokens to runs of workflows that declare credentials.
+	// Such runs fail when it is nil.
+	CredentialBroker *CredentialBroker
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowruns,verbs=get;list;watch;create;update;patch;delete
@@ -45,6 +56,8 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowruns/finalizers,verbs=update
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=workflows,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterworkflows,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowversions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterworkflowversions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=components,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componenttypes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=clustercomponenttypes,verbs=get;list;watch
@@ -113,6 +126,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// Runs cancelled before they were submitted to the workflow plane have nothing to abort.
+	if workflowRun.Spec.Cancellation != nil && workflowRun.Status.RunReference == nil {
+		logger.Info("WorkflowRun cancelled before submission", "requestedBy", workflowRun.Spec.Cancellation.RequestedBy)
+		setWorkflowCancelledCondition(workflowRun)
+		return ctrl.Result{}, nil
+	}
+
 	// Validate component workflow runs before proceeding.
 	// Skip validation if the workflow is already running or has been submitted
 	// (RunReference set) to avoid disrupting in-progress or pending executions.
@@ -148,7 +168,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		Spec: workflowSpec,
 	}
 
-	workflowPlaneResult, err := controller.GetWorkflowPlaneFromRef(ctx, r.Client, workflowRun.Namespace, workflow.Spec.WorkflowPlaneRef)
+	workflowPlaneResult, err := r.resolveWorkflowPlane(ctx, workflowRun, workflowResult, &workflow.Spec)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			logger.Info("No workflow plane found for workflow",
@@ -181,6 +201,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		}, runResource)
 
 		if err == nil {
+			if handled, result := r.enforceRunPolicies(ctx, workflowRun, runResource, wpClient); handled {
+				return result, nil
+			}
 			return r.syncWorkflowRunStatus(workflowRun, runResource), nil
 		} else if !errors.IsNotFound(err) {
 			logger.Error(err, "failed to get run resource",
@@ -192,6 +215,47 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, nil
 	}
 
+	// Wait for capacity on workflow planes that limit the number of concurrent runs.
+	admitted, err := r.admitWorkflowRun(ctx, workflowRun, workflowPlaneResult)
+	if err != nil {
+		logger.Error(err, "failed to schedule workflow run",
+			"workflowplane", workflowPlaneResult.GetName())
+		return ctrl.Result{Requeue: true}, nil
+	}
+	if !admitted {
+		return ctrl.Result{RequeueAfter: queuedRunRequeueInterval}, nil
+	}
+
+	// Runs that pin a published version render from its frozen template instead of the
+	// live workflow, so that editing the workflow does not change how they are built.
+	resolvedTemplate := &openchoreodevv1alpha1.ResolvedWorkflowTemplate{Generation: workflowResult.GetGeneration()}
+	if version := workflowRun.Spec.Workflow.Version; version != "" {
+		versionSpec, err := controller.ResolveWorkflowVersion(ctx, r.Client, workflowRun.Namespace,
+			workflowRun.Spec.Workflow.Kind, workflowRun.Spec.Workflow.Name, version)
+		if err != nil {
+			logger.Error(err, "failed to resolve workflow version",
+				"workflow", workflowRun.Spec.Workflow.Name,
+				"version", version)
+			if errors.IsNotFound(err) {
+				setWorkflowVersionNotFoundCondition(workflowRun)
+				return ctrl.Result{}, nil
+			}
+			setWorkflowResolutionFailedCondition(workflowRun, err)
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+		if versionSpec.Deprecated {
+			logger.Info("WorkflowRun uses a deprecated workflow version",
+				"workflow", workflowRun.Spec.Workflow.Name,
+				"version", version,
+				"message", versionSpec.DeprecationMessage)
+		}
+		applyWorkflowTemplate(&workflow.Spec, &versionSpec.Template)
+		resolvedTemplate = &openchoreodevv1alpha1.ResolvedWorkflowTemplate{
+			Version:    version,
+			Deprecated: versionSpec.Deprecated,
+		}
+	}
+
 	// Copy TTL from Workflow to WorkflowRun if not already set
 	if workflowRun.Spec.TTLAfterCompletion == "" && workflow.Spec.TTLAfterCompletion != "" {
 		workflowRun.Spec.TTLAfterCompletion = workflow.Spec.TTLAfterCompletion
@@ -242,15 +306,74 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	if err := enforceAirGapPolicy(r.AirGap, output); err != nil {
+		logger.Info("Rendered workflow run violates the air-gap policy", "error", err.Error())
+		setAirGapPolicyViolationCondition(workflowRun, err)
+		return ctrl.Result{}, nil
+	}
+
+	if err := buildnodes.ApplyPlacement(output.Resource, workflowPlaneResult.GetBuildNodes()); err != nil {
+		logger.Error(err, "failed to place workflow run on build nodes")
+		return ctrl.Result{Requeue: true}, nil
+	}
+
 	runResNamespace, err := extractRunResourceNamespace(output.Resource)
 	if err != nil {
 		logger.Error(err, "failed to extract namespace from rendered resource")
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	if len(workflow.Spec.Credentials) > 0 {
+		celContext, err := r.credentialCELContext(ctx, renderInput)
+		if err != nil {
+			logger.Error(err, "failed to build CEL context for credentials")
+			return ctrl.Result{Requeue: true}, nil
+		}
+		if err := issueCredentials(r.CredentialBroker, workflowRun, workflow, celContext, output, runResNamespace, time.Now()); err != nil {
+			logger.Info("Failed to issue credentials to workflow run", "error", err.Error())
+			setCredentialIssuanceFailedCondition(workflowRun, err)
+			return ctrl.Result{}, nil
+		}
+	}
+
+	resolvedTemplate.Tests = workflow.Spec.Tests
+	resolvedTemplate.Analysis = workflow.Spec.Analysis
+	resolvedTemplate.SBOM = workflow.Spec.SBOM
+	resolvedTemplate.Timeout = workflow.Spec.Timeout
+	resolvedTemplate.RetryPolicy = workflow.Spec.RetryPolicy
+	workflowRun.Status.ResolvedTemplate = resolvedTemplate
 	return r.ensureRunResource(ctx, workflowRun, output, runResNamespace, wpClient), nil
 }
 
+// enforceAirGapPolicy rewrites the rendered run and its resources through the air-gap mirrors.
+func enforceAirGapPolicy(policy *airgap.Policy, output *workflowpipeline.RenderOutput) error {
+	if err := policy.Enforce(output.Resource); err != nil {
+		return err
+	}
+	for _, res := range output.Resources {
+		if err := policy.Enforce(res.Resource); err != nil {
+			return fmt.Errorf("resource %q: %w", res.ID, err)
+		}
+	}
+	return nil
+}
+
+// applyWorkflowTemplate replaces the rendering inputs of spec with a published template.
+// The workflow plane reference is kept from the live workflow.
+func applyWorkflowTemplate(spec *openchoreodevv1alpha1.WorkflowSpec, tmpl *openchoreodevv1alpha1.WorkflowTemplate) {
+	spec.Parameters = tmpl.Parameters
+	spec.RunTemplate = tmpl.RunTemplate
+	spec.Resources = tmpl.Resources
+	spec.ExternalRefs = tmpl.ExternalRefs
+	spec.TTLAfterCompletion = tmpl.TTLAfterCompletion
+	spec.Tests = tmpl.Tests
+	spec.Analysis = tmpl.Analysis
+	spec.SBOM = tmpl.SBOM
+	spec.Timeout = tmpl.Timeout
+	spec.RetryPolicy = tmpl.RetryPolicy
+	spec.Credentials = tmpl.Credentials
+}
+
 func (r *Reconciler) ensureRunResource(
 	ctx context.Context,
 	workflowRun *openchoreodevv1alpha1.WorkflowRun,
@@ -301,6 +424,9 @@ func (r *Reconciler) syncWorkflowRunStatus(
 	// Extract and update tasks from argo workflow nodes
 	// This should be extended to support other workflow engines in the future
 	workflowRun.Status.Tasks = extractArgoTasksFromWorkflowNodes(runResource.Status.Nodes)
+	syncTestResults(workflowRun, runResource.Status.Nodes)
+	syncAnalysisResults(workflowRun, runResource.Status.Nodes)
+	syncSBOM(workflowRun, runResource.Status.Nodes)
 
 	switch runResource.Status.Phase {
 	case argoproj.WorkflowRunning:
@@ -363,6 +489,10 @@ func (r *Reconciler) applyRenderedRunResource(
 		}
 		logger.Info("created run resource", "kind", kind, "name", name, "namespace", namespace)
 	} else {
+		// A retried run waits until the run resource of the previous attempt is gone.
+		if existingResource.GetDeletionTimestamp() != nil {
+			return fmt.Errorf("%s %q in namespace %q is still being deleted", kind, name, namespace)
+		}
 		// Resource exists, update it
 		unstructuredResource.SetResourceVersion(existingResource.GetResourceVersion())
 		if err := wpClient.Update(ctx, unstructuredResource); err != nil {
@@ -4,6 +4,8 @@
 package workflowrun
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -16,6 +18,9 @@ const (
 	ConditionWorkflowFailed    controller.ConditionType = "WorkflowFailed"
 	ConditionWorkflowSucceeded controller.ConditionType = "WorkflowSucceeded"
 	ConditionWorkflowCompleted controller.ConditionType = "WorkflowCompleted"
+	ConditionTestsPassed       controller.ConditionType = "TestsPassed"
+	ConditionAnalysisCompleted controller.ConditionType = "AnalysisCompleted"
+	ConditionSBOMCollected     controller.ConditionType = "SBOMCollected"
 )
 
 const (
@@ -27,6 +32,20 @@ const (
 	ReasonWorkflowPlaneResolutionFailed controller.ConditionReason = "WorkflowPlaneResolutionFailed"
 	ReasonWorkflowResolutionFailed      controller.ConditionReason = "WorkflowResolutionFailed"
 	ReasonComponentValidationFailed     controller.ConditionReason = "ComponentValidationFailed"
+	ReasonWorkflowVersionNotFound       controller.ConditionReason = "WorkflowVersionNotFound"
+	ReasonAirGapPolicyViolation         controller.ConditionReason = "AirGapPolicyViolation"
+	ReasonCredentialIssuanceFailed      controller.ConditionReason = "CredentialIssuanceFailed"
+	ReasonTestsPassed                   controller.ConditionReason = "TestsPassed"
+	ReasonTestsFailed                   controller.ConditionReason = "TestsFailed"
+	ReasonTestReportInvalid             controller.ConditionReason = "TestReportInvalid"
+	ReasonAnalysisCompleted             controller.ConditionReason = "AnalysisCompleted"
+	ReasonAnalysisReportInvalid         controller.ConditionReason = "AnalysisReportInvalid"
+	ReasonSBOMCollected                 controller.ConditionReason = "SBOMCollected"
+	ReasonSBOMInvalid                   controller.ConditionReason = "SBOMInvalid"
+	ReasonWorkflowQueued                controller.ConditionReason = "WorkflowQueued"
+	ReasonWorkflowCancelled             controller.ConditionReason = "WorkflowCancelled"
+	ReasonWorkflowTimedOut              controller.ConditionReason = "WorkflowTimedOut"
+	ReasonWorkflowRetrying              controller.ConditionReason = "WorkflowRetrying"
 )
 
 func setWorkflowPendingCondition(workflowRun *openchoreov1alpha1.WorkflowRun) {
@@ -151,6 +170,90 @@ func setWorkflowResolutionFailedCondition(workflowRun *openchoreov1alpha1.Workfl
 	})
 }
 
+func setWorkflowQueuedCondition(workflowRun *openchoreov1alpha1.WorkflowRun, queue *openchoreov1alpha1.WorkflowRunQueueStatus) {
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:   string(ConditionWorkflowCompleted),
+		Status: metav1.ConditionFalse,
+		Reason: string(ReasonWorkflowQueued),
+		Message: fmt.Sprintf("Waiting at position %d in the build queue of %s",
+			queue.Position, queue.WorkflowPlane),
+		ObservedGeneration: workflowRun.Generation,
+	})
+}
+
+func setWorkflowCancelledCondition(workflowRun *openchoreov1alpha1.WorkflowRun) {
+	message := "Workflow run was cancelled"
+	if cancellation := workflowRun.Spec.Cancellation; cancellation != nil {
+		if cancellation.RequestedBy != "" {
+			message += " by " + cancellation.RequestedBy
+		}
+		if cancellation.Reason != "" {
+			message += ": " + cancellation.Reason
+		}
+	}
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowRunning),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonWorkflowCancelled),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowCompleted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonWorkflowCancelled),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+}
+
+func setWorkflowTimedOutCondition(workflowRun *openchoreov1alpha1.WorkflowRun, timeout string) {
+	message := fmt.Sprintf("Workflow run exceeded its timeout of %s", timeout)
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowRunning),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonWorkflowTimedOut),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowFailed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonWorkflowTimedOut),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowCompleted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonWorkflowTimedOut),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+}
+
+func setWorkflowRetryingCondition(workflowRun *openchoreov1alpha1.WorkflowRun, infrastructureError bool, limit int32) {
+	cause := "a failure"
+	if infrastructureError {
+		cause = "an infrastructure error"
+	}
+	message := fmt.Sprintf("Retrying after %s (retry %d of %d)", cause, workflowRun.Status.Retries, limit)
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowRunning),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonWorkflowRetrying),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowCompleted),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonWorkflowRetrying),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+}
+
 func isWorkflowInitiated(workflowRun *openchoreov1alpha1.WorkflowRun) bool {
 	return meta.FindStatusCondition(workflowRun.Status.Conditions, string(ConditionWorkflowCompleted)) != nil
 }
@@ -192,3 +295,145 @@ func setComponentValidationFailedCondition(workflowRun *openchoreov1alpha1.Workf
 		ObservedGeneration: workflowRun.Generation,
 	})
 }
+
+// setWorkflowVersionNotFoundCondition marks the workflow run as permanently failed
+// because the pinned workflow version has not been published.
+func setWorkflowVersionNotFoundCondition(workflowRun *openchoreov1alpha1.WorkflowRun) {
+	message := "Workflow version " + workflowRun.Spec.Workflow.Version + " is not published"
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowRunning),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonWorkflowRunning),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowFailed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonWorkflowVersionNotFound),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowCompleted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonWorkflowVersionNotFound),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+}
+
+// setAirGapPolicyViolationCondition marks the workflow run as permanently failed because the
+// rendered run references external artifacts that have no internal mirror.
+func setAirGapPolicyViolationCondition(workflowRun *openchoreov1alpha1.WorkflowRun, err error) {
+	message := err.Error()
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowRunning),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonWorkflowRunning),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowFailed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonAirGapPolicyViolation),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowCompleted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonAirGapPolicyViolation),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+}
+
+// setCredentialIssuanceFailedCondition marks the workflow run as permanently failed because the
+// credentials declared by its workflow could not be issued.
+func setCredentialIssuanceFailedCondition(workflowRun *openchoreov1alpha1.WorkflowRun, err error) {
+	message := err.Error()
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowRunning),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(ReasonWorkflowRunning),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowFailed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonCredentialIssuanceFailed),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionWorkflowCompleted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonCredentialIssuanceFailed),
+		Message:            message,
+		ObservedGeneration: workflowRun.Generation,
+	})
+}
+
+func setTestsCondition(workflowRun *openchoreov1alpha1.WorkflowRun, results *openchoreov1alpha1.WorkflowTestResults) {
+	condition := metav1.Condition{
+		Type:               string(ConditionTestsPassed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonTestsPassed),
+		Message:            fmt.Sprintf("%d tests passed, %d skipped", results.Tests-results.Skipped, results.Skipped),
+		ObservedGeneration: workflowRun.Generation,
+	}
+	switch {
+	case results.Message != "":
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(ReasonTestReportInvalid)
+		condition.Message = results.Message
+	case !results.Passed:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(ReasonTestsFailed)
+		condition.Message = fmt.Sprintf("%d of %d tests failed, %d errored",
+			results.Failures, results.Tests, results.Errors)
+	}
+	if condition.Status == metav1.ConditionFalse && results.BlocksRelease {
+		condition.Message += "; releases are blocked until the tests pass"
+	}
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, condition)
+}
+
+// setAnalysisCondition records the outcome of the analysis step. Whether the findings block
+// releases depends on the quality gates of the component, which are evaluated at release time.
+func setAnalysisCondition(workflowRun *openchoreov1alpha1.WorkflowRun, results *openchoreov1alpha1.WorkflowAnalysisResults) {
+	condition := metav1.Condition{
+		Type:   string(ConditionAnalysisCompleted),
+		Status: metav1.ConditionTrue,
+		Reason: string(ReasonAnalysisCompleted),
+		Message: fmt.Sprintf("%d critical, %d high, %d medium, %d low and %d informational findings",
+			results.Critical, results.High, results.Medium, results.Low, results.Info),
+		ObservedGeneration: workflowRun.Generation,
+	}
+	if results.Message != "" {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(ReasonAnalysisReportInvalid)
+		condition.Message = results.Message
+	}
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, condition)
+}
+
+// setSBOMCondition records whether the SBOM of the built image was collected.
+func setSBOMCondition(workflowRun *openchoreov1alpha1.WorkflowRun, sbom *openchoreov1alpha1.WorkflowSBOM) {
+	condition := metav1.Condition{
+		Type:               string(ConditionSBOMCollected),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(ReasonSBOMCollected),
+		Message:            fmt.Sprintf("%s %s SBOM with %d components", sbom.Format, sbom.SpecVersion, sbom.ComponentCount),
+		ObservedGeneration: workflowRun.Generation,
+	}
+	if sbom.Message != "" {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(ReasonSBOMInvalid)
+		condition.Message = sbom.Message
+	}
+	meta.SetStatusCondition(&workflowRun.Status.Conditions, condition)
+}
@@ -59,6 +59,10 @@ func (r *Reconciler) finalize(ctx context.Context, cwRun *openchoreodevv1alpha1.
 		return r.removeFinalizer(ctx, cwRun)
 	}
 	workflowPlaneRef := workflowResult.GetWorkflowSpec().WorkflowPlaneRef
+	if cwRun.Status.WorkflowPlane != nil {
+		// The run was scheduled on a plane selected by label.
+		workflowPlaneRef = cwRun.Status.WorkflowPlane
+	}
 
 	// Get workflow plane client (supports both WorkflowPlane and ClusterWorkflowPlane)
 	workflowPlaneResult, err := controller.GetWorkflowPlaneFromRef(ctx, r.Client, cwRun.Namespace, workflowPlaneRef)
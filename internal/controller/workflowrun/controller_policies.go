@@ -0,0 +1,172 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/cmdutil"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+// enforceRunPolicies aborts cancelled and timed out runs and resubmits failed runs according to
+// the retry policy of their workflow. It reports whether the run was handled, in which case the
+// caller returns the given result instead of syncing the run status.
+func (r *Reconciler) enforceRunPolicies(
+	ctx context.Context,
+	workflowRun *openchoreodevv1alpha1.WorkflowRun,
+	runResource *argoproj.Workflow,
+	wpClient client.Client,
+) (bool, ctrl.Result) {
+	logger := log.FromContext(ctx)
+
+	if isRunResourceCompleted(runResource) {
+		// A cancellation that arrives after the run finished has nothing to abort.
+		if infrastructureError, retry := shouldRetry(workflowRun, runResource); retry {
+			return true, r.retryWorkflowRun(ctx, workflowRun, runResource, wpClient, infrastructureError)
+		}
+		return false, ctrl.Result{}
+	}
+
+	if cancellation := workflowRun.Spec.Cancellation; cancellation != nil {
+		if err := terminateRunResource(ctx, wpClient, runResource); err != nil {
+			logger.Error(err, "failed to terminate run resource of cancelled workflow run",
+				"runName", runResource.Name,
+				"runNamespace", runResource.Namespace)
+			return true, ctrl.Result{Requeue: true}
+		}
+		logger.Info("Cancelled WorkflowRun", "requestedBy", cancellation.RequestedBy)
+		workflowRun.Status.Tasks = extractArgoTasksFromWorkflowNodes(runResource.Status.Nodes)
+		setWorkflowCancelledCondition(workflowRun)
+		return true, ctrl.Result{}
+	}
+
+	timeout, exceeded := timeoutExceeded(ctx, workflowRun, runResource, time.Now())
+	if !exceeded {
+		return false, ctrl.Result{}
+	}
+	if err := terminateRunResource(ctx, wpClient, runResource); err != nil {
+		logger.Error(err, "failed to terminate run resource of timed out workflow run",
+			"runName", runResource.Name,
+			"runNamespace", runResource.Namespace)
+		return true, ctrl.Result{Requeue: true}
+	}
+	logger.Info("WorkflowRun timed out", "timeout", timeout)
+	workflowRun.Status.Tasks = extractArgoTasksFromWorkflowNodes(runResource.Status.Nodes)
+	setWorkflowTimedOutCondition(workflowRun, timeout)
+	return true, ctrl.Result{}
+}
+
+func isRunResourceCompleted(runResource *argoproj.Workflow) bool {
+	switch runResource.Status.Phase {
+	case argoproj.WorkflowSucceeded, argoproj.WorkflowFailed, argoproj.WorkflowError:
+		return true
+	}
+	return false
+}
+
+// terminateRunResource aborts a running Argo Workflow. Argo stops its pods and marks it as failed.
+func terminateRunResource(ctx context.Context, wpClient client.Client, runResource *argoproj.Workflow) error {
+	if runResource.Spec.Shutdown == argoproj.ShutdownStrategyTerminate {
+		return nil
+	}
+	patch := client.MergeFrom(runResource.DeepCopy())
+	runResource.Spec.Shutdown = argoproj.ShutdownStrategyTerminate
+	return wpClient.Patch(ctx, runResource, patch)
+}
+
+// timeoutExceeded reports whether the run resource has existed for longer than the timeout of
+// the workflow template the run was rendered from, and returns the timeout.
+func timeoutExceeded(
+	ctx context.Context,
+	workflowRun *openchoreodevv1alpha1.WorkflowRun,
+	runResource *argoproj.Workflow,
+	now time.Time,
+) (string, bool) {
+	tmpl := workflowRun.Status.ResolvedTemplate
+	if tmpl == nil || tmpl.Timeout == "" || runResource.CreationTimestamp.IsZero() {
+		return "", false
+	}
+	timeout, err := cmdutil.ParseDuration(tmpl.Timeout)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Invalid workflow run timeout", "timeout", tmpl.Timeout)
+		return "", false
+	}
+	return tmpl.Timeout, now.After(runResource.CreationTimestamp.Add(timeout))
+}
+
+// shouldRetry reports whether a failed run is resubmitted by the retry policy of its workflow,
+// and whether it failed because of an infrastructure error.
+func shouldRetry(workflowRun *openchoreodevv1alpha1.WorkflowRun, runResource *argoproj.Workflow) (bool, bool) {
+	tmpl := workflowRun.Status.ResolvedTemplate
+	if tmpl == nil || tmpl.RetryPolicy == nil || workflowRun.Spec.Cancellation != nil {
+		return false, false
+	}
+	if runResource.Status.Phase != argoproj.WorkflowFailed && runResource.Status.Phase != argoproj.WorkflowError {
+		return false, false
+	}
+	if workflowRun.Status.Retries >= tmpl.RetryPolicy.Limit {
+		return false, false
+	}
+	infrastructureError := isInfrastructureError(runResource)
+	return infrastructureError, infrastructureError || tmpl.RetryPolicy.RetriesAllFailures()
+}
+
+// isInfrastructureError reports whether a failed run failed because a step could not be
+// executed rather than because a step failed. Argo reports the Error phase for the workflow
+// or its pods in that case, for example when a pod is evicted or deleted.
+func isInfrastructureError(runResource *argoproj.Workflow) bool {
+	if runResource.Status.Phase == argoproj.WorkflowError {
+		return true
+	}
+	for _, node := range runResource.Status.Nodes {
+		if node.Type == argoproj.NodeTypePod && node.Phase == argoproj.NodeError {
+			return true
+		}
+	}
+	return false
+}
+
+// retryWorkflowRun deletes the failed run resource and resets the run so that the next
+// reconcile renders and submits it again.
+func (r *Reconciler) retryWorkflowRun(
+	ctx context.Context,
+	workflowRun *openchoreodevv1alpha1.WorkflowRun,
+	runResource *argoproj.Workflow,
+	wpClient client.Client,
+	infrastructureError bool,
+) ctrl.Result {
+	logger := log.FromContext(ctx)
+
+	if err := wpClient.Delete(ctx, runResource); client.IgnoreNotFound(err) != nil {
+		logger.Error(err, "failed to delete run resource of failed workflow run",
+			"runName", runResource.Name,
+			"runNamespace", runResource.Namespace)
+		return ctrl.Result{Requeue: true}
+	}
+
+	limit := workflowRun.Status.ResolvedTemplate.RetryPolicy.Limit
+	workflowRun.Status.Retries++
+	workflowRun.Status.RunReference = nil
+	workflowRun.Status.Tasks = nil
+	workflowRun.Status.TestResults = nil
+	workflowRun.Status.AnalysisResults = nil
+	workflowRun.Status.SBOM = nil
+	meta.RemoveStatusCondition(&workflowRun.Status.Conditions, string(ConditionTestsPassed))
+	meta.RemoveStatusCondition(&workflowRun.Status.Conditions, string(ConditionAnalysisCompleted))
+	meta.RemoveStatusCondition(&workflowRun.Status.Conditions, string(ConditionSBOMCollected))
+	setWorkflowRetryingCondition(workflowRun, infrastructureError, limit)
+	logger.Info("Retrying failed WorkflowRun",
+		"retry", workflowRun.Status.Retries,
+		"limit", limit,
+		"infrastructureError", infrastructureError)
+	return ctrl.Result{Requeue: true}
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+func newPolicyTestRun(tmpl *openchoreodevv1alpha1.ResolvedWorkflowTemplate) *openchoreodevv1alpha1.WorkflowRun {
+	return &openchoreodevv1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "run-1", Namespace: "default", Generation: 1},
+		Status: openchoreodevv1alpha1.WorkflowRunStatus{
+			ResolvedTemplate: tmpl,
+			RunReference: &openchoreodevv1alpha1.ResourceReference{
+				APIVersion: "argoproj.io/v1alpha1",
+				Kind:       "Workflow",
+				Name:       "run-1",
+				Namespace:  testBuildNS,
+			},
+		},
+	}
+}
+
+func newPolicyTestRunResource(phase argoproj.WorkflowPhase, age time.Duration) *argoproj.Workflow {
+	return &argoproj.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "run-1",
+			Namespace:         testBuildNS,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+		Status: argoproj.WorkflowStatus{Phase: phase},
+	}
+}
+
+func newPolicyTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = argoproj.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func getRunResource(t *testing.T, wpClient client.Client) (*argoproj.Workflow, error) {
+	t.Helper()
+	runResource := &argoproj.Workflow{}
+	err := wpClient.Get(context.Background(), types.NamespacedName{Name: "run-1", Namespace: testBuildNS}, runResource)
+	return runResource, err
+}
+
+func TestEnforceRunPolicies_Cancellation(t *testing.T) {
+	r := &Reconciler{}
+
+	t.Run("terminates a running run", func(t *testing.T) {
+		runResource := newPolicyTestRunResource(argoproj.WorkflowRunning, time.Minute)
+		wpClient := newPolicyTestClient(runResource)
+		wfr := newPolicyTestRun(&openchoreodevv1alpha1.ResolvedWorkflowTemplate{})
+		wfr.Spec.Cancellation = &openchoreodevv1alpha1.WorkflowRunCancellation{RequestedBy: "alice", Reason: "wrong branch"}
+
+		handled, _ := r.enforceRunPolicies(context.Background(), wfr, runResource, wpClient)
+		if !handled {
+			t.Fatal("expected the cancellation to be handled")
+		}
+		assertCondition(t, wfr, string(ConditionWorkflowCompleted), metav1.ConditionTrue, string(ReasonWorkflowCancelled))
+		cond := findConditionByType(wfr.Status.Conditions, string(ConditionWorkflowCompleted))
+		if cond.Message != "Workflow run was cancelled by alice: wrong branch" {
+			t.Errorf("unexpected condition message %q", cond.Message)
+		}
+		if findConditionByType(wfr.Status.Conditions, string(ConditionWorkflowFailed)) != nil {
+			t.Error("expected a cancelled run not to be marked as failed")
+		}
+
+		got, err := getRunResource(t, wpClient)
+		if err != nil {
+			t.Fatalf("failed to get run resource: %v", err)
+		}
+		if got.Spec.Shutdown != argoproj.ShutdownStrategyTerminate {
+			t.Errorf("expected the run resource to be terminated, got shutdown %q", got.Spec.Shutdown)
+		}
+	})
+
+	t.Run("ignores cancellations of finished runs", func(t *testing.T) {
+		runResource := newPolicyTestRunResource(argoproj.WorkflowSucceeded, time.Minute)
+		wfr := newPolicyTestRun(&openchoreodevv1alpha1.ResolvedWorkflowTemplate{})
+		wfr.Spec.Cancellation = &openchoreodevv1alpha1.WorkflowRunCancellation{RequestedBy: "alice"}
+
+		if handled, _ := r.enforceRunPolicies(context.Background(), wfr, runResource, newPolicyTestClient(runResource)); handled {
+			t.Error("expected the finished run to be synced instead of cancelled")
+		}
+	})
+}
+
+func TestEnforceRunPolicies_Timeout(t *testing.T) {
+	r := &Reconciler{}
+	tmpl := &openchoreodevv1alpha1.ResolvedWorkflowTemplate{Timeout: "30m"}
+
+	t.Run("terminates runs that exceed the timeout", func(t *testing.T) {
+		runResource := newPolicyTestRunResource(argoproj.WorkflowRunning, time.Hour)
+		wpClient := newPolicyTestClient(runResource)
+		wfr := newPolicyTestRun(tmpl)
+
+		handled, _ := r.enforceRunPolicies(context.Background(), wfr, runResource, wpClient)
+		if !handled {
+			t.Fatal("expected the timeout to be handled")
+		}
+		assertCondition(t, wfr, string(ConditionWorkflowFailed), metav1.ConditionTrue, string(ReasonWorkflowTimedOut))
+		assertCondition(t, wfr, string(ConditionWorkflowCompleted), metav1.ConditionTrue, string(ReasonWorkflowTimedOut))
+
+		got, err := getRunResource(t, wpClient)
+		if err != nil {
+			t.Fatalf("failed to get run resource: %v", err)
+		}
+		if got.Spec.Shutdown != argoproj.ShutdownStrategyTerminate {
+			t.Errorf("expected the run resource to be terminated, got shutdown %q", got.Spec.Shutdown)
+		}
+	})
+
+	t.Run("leaves runs within the timeout running", func(t *testing.T) {
+		runResource := newPolicyTestRunResource(argoproj.WorkflowRunning, time.Minute)
+		wfr := newPolicyTestRun(tmpl)
+
+		if handled, _ := r.enforceRunPolicies(context.Background(), wfr, runResource, newPolicyTestClient(runResource)); handled {
+			t.Error("expected the run to be synced")
+		}
+	})
+}
+
+func TestEnforceRunPolicies_Retry(t *testing.T) {
+	r := &Reconciler{}
+	infraFailure := func() *argoproj.Workflow {
+		runResource := newPolicyTestRunResource(argoproj.WorkflowFailed, time.Minute)
+		runResource.Status.Nodes = argoproj.Nodes{
+			"build": {Name: "run-1[0].build", Type: argoproj.NodeTypePod, Phase: argoproj.NodeError, Message: "pod deleted"},
+		}
+		return runResource
+	}
+	stepFailure := func() *argoproj.Workflow {
+		runResource := newPolicyTestRunResource(argoproj.WorkflowFailed, time.Minute)
+		runResource.Status.Nodes = argoproj.Nodes{
+			"build": {Name: "run-1[0].build", Type: argoproj.NodeTypePod, Phase: argoproj.NodeFailed},
+		}
+		return runResource
+	}
+	policy := func(retryOn openchoreodevv1alpha1.WorkflowRetryOn) *openchoreodevv1alpha1.ResolvedWorkflowTemplate {
+		return &openchoreodevv1alpha1.ResolvedWorkflowTemplate{
+			RetryPolicy: &openchoreodevv1alpha1.WorkflowRetryPolicy{Limit: 2, RetryOn: retryOn},
+		}
+	}
+
+	t.Run("resubmits runs that failed with an infrastructure error", func(t *testing.T) {
+		runResource := infraFailure()
+		wpClient := newPolicyTestClient(runResource)
+		wfr := newPolicyTestRun(policy(openchoreodevv1alpha1.WorkflowRetryOnInfrastructureErrors))
+		wfr.Status.Tasks = []openchoreodevv1alpha1.WorkflowTask{{Name: "build", Phase: "Error"}}
+
+		handled, result := r.enforceRunPolicies(context.Background(), wfr, runResource, wpClient)
+		if !handled || !result.Requeue {
+			t.Fatalf("expected the retry to be handled and requeued, got handled=%v result=%+v", handled, result)
+		}
+		if wfr.Status.Retries != 1 || wfr.Status.RunReference != nil || wfr.Status.Tasks != nil {
+			t.Errorf("expected the run to be reset for a retry, got %+v", wfr.Status)
+		}
+		assertCondition(t, wfr, string(ConditionWorkflowCompleted), metav1.ConditionFalse, string(ReasonWorkflowRetrying))
+		cond := findConditionByType(wfr.Status.Conditions, string(ConditionWorkflowCompleted))
+		if cond.Message != "Retrying after an infrastructure error (retry 1 of 2)" {
+			t.Errorf("unexpected condition message %q", cond.Message)
+		}
+		if _, err := getRunResource(t, wpClient); !errors.IsNotFound(err) {
+			t.Errorf("expected the failed run resource to be deleted, got %v", err)
+		}
+	})
+
+	t.Run("does not retry step failures on infrastructure errors only", func(t *testing.T) {
+		runResource := stepFailure()
+		wfr := newPolicyTestRun(policy(openchoreodevv1alpha1.WorkflowRetryOnInfrastructureErrors))
+
+		if handled, _ := r.enforceRunPolicies(context.Background(), wfr, runResource, newPolicyTestClient(runResource)); handled {
+			t.Error("expected the failure to be synced")
+		}
+	})
+
+	t.Run("retries step failures on all failures", func(t *testing.T) {
+		runResource := stepFailure()
+		wfr := newPolicyTestRun(policy(openchoreodevv1alpha1.WorkflowRetryOnAllFailures))
+
+		if handled, _ := r.enforceRunPolicies(context.Background(), wfr, runResource, newPolicyTestClient(runResource)); !handled {
+			t.Fatal("expected the failure to be retried")
+		}
+		cond := findConditionByType(wfr.Status.Conditions, string(ConditionWorkflowCompleted))
+		if cond.Message != "Retrying after a failure (retry 1 of 2)" {
+			t.Errorf("unexpected condition message %q", cond.Message)
+		}
+	})
+
+	t.Run("stops retrying at the limit", func(t *testing.T) {
+		runResource := infraFailure()
+		wfr := newPolicyTestRun(policy(openchoreodevv1alpha1.WorkflowRetryOnInfrastructureErrors))
+		wfr.Status.Retries = 2
+
+		if handled, _ := r.enforceRunPolicies(context.Background(), wfr, runResource, newPolicyTestClient(runResource)); handled {
+			t.Error("expected the failure to be synced once the retry limit is reached")
+		}
+	})
+
+	t.Run("does not retry cancelled runs", func(t *testing.T) {
+		runResource := infraFailure()
+		wfr := newPolicyTestRun(policy(openchoreodevv1alpha1.WorkflowRetryOnAllFailures))
+		wfr.Spec.Cancellation = &openchoreodevv1alpha1.WorkflowRunCancellation{}
+
+		if handled, _ := r.enforceRunPolicies(context.Background(), wfr, runResource, newPolicyTestClient(runResource)); handled {
+			t.Error("expected the cancelled run not to be retried")
+		}
+	})
+}
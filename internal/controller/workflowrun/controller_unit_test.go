@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/airgap"
 	k8sMocks "github.com/openchoreo/openchoreo/internal/clients/kubernetes/mocks"
 	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
 	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
@@ -1225,6 +1226,64 @@ func TestValidateComponentWorkflowRun(t *testing.T) {
 		}
 	})
 
+	t.Run("version does not match component pinned version fails", func(t *testing.T) {
+		ct := &openchoreodevv1alpha1.ComponentType{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-ct", Namespace: "default"},
+			Spec: openchoreodevv1alpha1.ComponentTypeSpec{
+				WorkloadType: "deployment",
+				AllowedWorkflows: []openchoreodevv1alpha1.WorkflowRef{
+					{Kind: openchoreodevv1alpha1.WorkflowRefKindClusterWorkflow, Name: "wf-a"},
+				},
+				Resources: []openchoreodevv1alpha1.ResourceTemplate{
+					{ID: "deployment", Template: &runtime.RawExtension{Raw: []byte("{}")}},
+				},
+			},
+		}
+		comp := &openchoreodevv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-comp", Namespace: "default"},
+			Spec: openchoreodevv1alpha1.ComponentSpec{
+				Owner:         openchoreodevv1alpha1.ComponentOwner{ProjectName: "my-proj"},
+				ComponentType: openchoreodevv1alpha1.ComponentTypeRef{Name: "deployment/my-ct"},
+				Workflow: &openchoreodevv1alpha1.ComponentWorkflowConfig{
+					Kind:    openchoreodevv1alpha1.WorkflowRefKindClusterWorkflow,
+					Name:    "wf-a",
+					Version: "v2",
+				},
+			},
+		}
+
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(ct, comp).Build()
+		r := &Reconciler{Client: fakeClient, Scheme: scheme}
+
+		wfr := &openchoreodevv1alpha1.WorkflowRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-wfr",
+				Namespace: "default",
+				Labels: map[string]string{
+					"openchoreo.dev/project":   "my-proj",
+					"openchoreo.dev/component": "my-comp",
+				},
+			},
+			Spec: openchoreodevv1alpha1.WorkflowRunSpec{
+				Workflow: openchoreodevv1alpha1.WorkflowRunConfig{
+					Kind:    openchoreodevv1alpha1.WorkflowRefKindClusterWorkflow,
+					Name:    "wf-a",
+					Version: "v1",
+				},
+			},
+		}
+
+		result := r.validateComponentWorkflowRun(context.Background(), wfr)
+		if !result.shouldReturn {
+			t.Error("expected shouldReturn=true")
+		}
+		cond := findConditionByType(wfr.Status.Conditions, string(ConditionWorkflowCompleted))
+		if cond == nil || !strings.Contains(cond.Message, `pinned to version "v2"`) {
+			t.Error("expected condition message to mention the pinned version")
+		}
+	})
+
 	t.Run("valid component workflow run passes", func(t *testing.T) {
 		ct := &openchoreodevv1alpha1.ComponentType{
 			ObjectMeta: metav1.ObjectMeta{Name: "my-ct", Namespace: "default"},
@@ -2109,6 +2168,223 @@ func TestReconcileFullRenderAndApply(t *testing.T) {
 	}
 }
 
+func TestReconcileRendersPinnedWorkflowVersion(t *testing.T) {
+	s := newTestScheme()
+
+	runTemplate := func(serviceAccount string) *runtime.RawExtension {
+		return &runtime.RawExtension{Raw: []byte(`{
+			"apiVersion":"argoproj.io/v1alpha1","kind":"Workflow",
+			"metadata":{"name":"${metadata.workflowRunName}","namespace":"${metadata.namespace}"},
+			"spec":{"entrypoint":"main","serviceAccountName":"` + serviceAccount + `"}
+		}`)}
+	}
+	cwf := &openchoreodevv1alpha1.ClusterWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-wf", Generation: 3},
+		Spec:       openchoreodevv1alpha1.ClusterWorkflowSpec{RunTemplate: runTemplate("live-sa")},
+	}
+	cwfv := &openchoreodevv1alpha1.ClusterWorkflowVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-wf.v1"},
+		Spec: openchoreodevv1alpha1.WorkflowVersionSpec{
+			WorkflowName: "build-wf",
+			Version:      "v1",
+			Template:     openchoreodevv1alpha1.WorkflowTemplate{RunTemplate: runTemplate("v1-sa")},
+			Deprecated:   true,
+		},
+	}
+
+	newRun := func(name, version string) *openchoreodevv1alpha1.WorkflowRun {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "default",
+				Finalizers: []string{WorkflowRunCleanupFinalizer},
+				Generation: 1,
+			},
+			Spec: openchoreodevv1alpha1.WorkflowRunSpec{
+				Workflow: openchoreodevv1alpha1.WorkflowRunConfig{Name: "build-wf", Version: version},
+			},
+		}
+		setWorkflowPendingCondition(wfr)
+		return wfr
+	}
+	pinned := newRun("pinned-wfr", "v1")
+	missing := newRun("missing-version-wfr", "v9")
+	cwp := &openchoreodevv1alpha1.ClusterWorkflowPlane{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+
+	cpClient := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(cwf, cwfv, pinned, missing, cwp).
+		WithStatusSubresource(pinned, missing).
+		Build()
+	wpClient := fake.NewClientBuilder().WithScheme(s).Build()
+
+	mockProvider := &k8sMocks.MockWorkflowPlaneClientProvider{}
+	mockProvider.EXPECT().ClusterWorkflowPlaneClient(mock.Anything).Return(wpClient, nil)
+
+	r := &Reconciler{
+		Client:              cpClient,
+		Scheme:              s,
+		PlaneClientProvider: mockProvider,
+		Pipeline:            workflowpipeline.NewPipeline(),
+	}
+
+	t.Run("renders from the published snapshot", func(t *testing.T) {
+		key := types.NamespacedName{Name: "pinned-wfr", Namespace: "default"}
+		if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		rendered := &unstructured.Unstructured{}
+		rendered.SetGroupVersionKind(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Workflow"})
+		if err := wpClient.Get(context.Background(), types.NamespacedName{
+			Name:      "pinned-wfr",
+			Namespace: "workflows-default",
+		}, rendered); err != nil {
+			t.Fatalf("expected rendered workflow to exist in workflow plane: %v", err)
+		}
+		sa, _, _ := unstructured.NestedString(rendered.Object, "spec", "serviceAccountName")
+		if sa != "v1-sa" {
+			t.Errorf("expected run to render from version v1 (serviceAccountName v1-sa), got %q", sa)
+		}
+
+		got := &openchoreodevv1alpha1.WorkflowRun{}
+		if err := cpClient.Get(context.Background(), key, got); err != nil {
+			t.Fatalf("failed to get WorkflowRun: %v", err)
+		}
+		want := &openchoreodevv1alpha1.ResolvedWorkflowTemplate{Version: "v1", Deprecated: true}
+		if got.Status.ResolvedTemplate == nil || *got.Status.ResolvedTemplate != *want {
+			t.Errorf("expected resolved template %+v, got %+v", want, got.Status.ResolvedTemplate)
+		}
+	})
+
+	t.Run("fails permanently when the version is not published", func(t *testing.T) {
+		key := types.NamespacedName{Name: "missing-version-wfr", Namespace: "default"}
+		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Requeue || result.RequeueAfter != 0 {
+			t.Errorf("expected no requeue, got %+v", result)
+		}
+
+		got := &openchoreodevv1alpha1.WorkflowRun{}
+		if err := cpClient.Get(context.Background(), key, got); err != nil {
+			t.Fatalf("failed to get WorkflowRun: %v", err)
+		}
+		assertCondition(t, got, string(ConditionWorkflowCompleted), metav1.ConditionTrue, string(ReasonWorkflowVersionNotFound))
+		if got.Status.RunReference != nil {
+			t.Error("expected no run resource to be created")
+		}
+	})
+}
+
+func TestReconcileAppliesAirGapPolicy(t *testing.T) {
+	s := newTestScheme()
+
+	cwf := &openchoreodevv1alpha1.ClusterWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-wf"},
+		Spec: openchoreodevv1alpha1.ClusterWorkflowSpec{
+			RunTemplate: &runtime.RawExtension{Raw: []byte(`{
+				"apiVersion":"argoproj.io/v1alpha1","kind":"Workflow",
+				"metadata":{"name":"${metadata.workflowRunName}","namespace":"${metadata.namespace}"},
+				"spec":{"entrypoint":"main","serviceAccountName":"wf-sa","templates":[
+					{"name":"build","container":{"image":"docker.io/paketobuildpacks/builder:base"}},
+					{"name":"scan","container":{"image":"${parameters.scanner}"}}
+				]}
+			}`)},
+			Parameters: &openchoreodevv1alpha1.SchemaSection{
+				OpenAPIV3Schema: &runtime.RawExtension{Raw: []byte(`{
+					"type":"object","properties":{"scanner":{"type":"string","default":"registry.internal/scanner:v1"}}
+				}`)},
+			},
+		},
+	}
+	newRun := func(name, scanner string) *openchoreodevv1alpha1.WorkflowRun {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "default",
+				Finalizers: []string{WorkflowRunCleanupFinalizer},
+				Generation: 1,
+			},
+			Spec: openchoreodevv1alpha1.WorkflowRunSpec{
+				Workflow: openchoreodevv1alpha1.WorkflowRunConfig{
+					Name:       "build-wf",
+					Parameters: &runtime.RawExtension{Raw: []byte(`{"scanner":"` + scanner + `"}`)},
+				},
+			},
+		}
+		setWorkflowPendingCondition(wfr)
+		return wfr
+	}
+	mirrored := newRun("mirrored-wfr", "registry.internal/scanner:v1")
+	external := newRun("external-wfr", "ghcr.io/acme/scanner:v1")
+	cwp := &openchoreodevv1alpha1.ClusterWorkflowPlane{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+
+	cpClient := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(cwf, mirrored, external, cwp).
+		WithStatusSubresource(mirrored, external).
+		Build()
+	wpClient := fake.NewClientBuilder().WithScheme(s).Build()
+
+	mockProvider := &k8sMocks.MockWorkflowPlaneClientProvider{}
+	mockProvider.EXPECT().ClusterWorkflowPlaneClient(mock.Anything).Return(wpClient, nil)
+
+	policy, err := airgap.NewPolicy(true, airgap.Config{
+		Mirrors: []airgap.Mirror{{Source: "docker.io/paketobuildpacks", Target: "registry.internal/buildpacks"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to build air-gap policy: %v", err)
+	}
+	r := &Reconciler{
+		Client:              cpClient,
+		Scheme:              s,
+		PlaneClientProvider: mockProvider,
+		Pipeline:            workflowpipeline.NewPipeline(),
+		AirGap:              policy,
+	}
+
+	t.Run("rewrites mirrored images", func(t *testing.T) {
+		key := types.NamespacedName{Name: "mirrored-wfr", Namespace: "default"}
+		if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		rendered := &unstructured.Unstructured{}
+		rendered.SetGroupVersionKind(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Workflow"})
+		if err := wpClient.Get(context.Background(), types.NamespacedName{
+			Name:      "mirrored-wfr",
+			Namespace: "workflows-default",
+		}, rendered); err != nil {
+			t.Fatalf("expected rendered workflow to exist in workflow plane: %v", err)
+		}
+		templates, _, _ := unstructured.NestedSlice(rendered.Object, "spec", "templates")
+		image, _, _ := unstructured.NestedString(templates[0].(map[string]any), "container", "image")
+		if image != "registry.internal/buildpacks/builder:base" {
+			t.Errorf("expected builder image to be rewritten to the mirror, got %q", image)
+		}
+	})
+
+	t.Run("fails permanently on unmirrored images", func(t *testing.T) {
+		key := types.NamespacedName{Name: "external-wfr", Namespace: "default"}
+		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Requeue || result.RequeueAfter != 0 {
+			t.Errorf("expected no requeue, got %+v", result)
+		}
+
+		got := &openchoreodevv1alpha1.WorkflowRun{}
+		if err := cpClient.Get(context.Background(), key, got); err != nil {
+			t.Fatalf("failed to get WorkflowRun: %v", err)
+		}
+		assertCondition(t, got, string(ConditionWorkflowCompleted), metav1.ConditionTrue, string(ReasonAirGapPolicyViolation))
+		if got.Status.RunReference != nil {
+			t.Error("expected no run resource to be created")
+		}
+	})
+}
+
 func TestReconcileSyncsRunningWorkflow(t *testing.T) {
 	s := newTestScheme()
 
@@ -128,6 +128,14 @@ func (r *Reconciler) validateComponentWorkflowRun(
 			setComponentValidationFailedCondition(workflowRun, msg)
 			return validateComponentWorkflowResult{shouldReturn: true, result: ctrl.Result{}}
 		}
+		// A component pinned to a version only builds with that version
+		if pinned := comp.Spec.Workflow.Version; pinned != "" && workflowRun.Spec.Workflow.Version != pinned {
+			msg := fmt.Sprintf(
+				"workflow run uses version %q of workflow %s/%s but component %q is pinned to version %q",
+				workflowRun.Spec.Workflow.Version, wfKind, wfName, comp.Name, pinned)
+			setComponentValidationFailedCondition(workflowRun, msg)
+			return validateComponentWorkflowResult{shouldReturn: true, result: ctrl.Result{}}
+		}
 	}
 
 	return validateComponentWorkflowResult{}
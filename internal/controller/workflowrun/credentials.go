@@ -0,0 +1,158 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
+	"github.com/openchoreo/openchoreo/internal/template"
+	"github.com/openchoreo/openchoreo/internal/workflowcredentials"
+)
+
+// credentialsResourceID is the ID of the broker token secret among the run's rendered resources.
+const credentialsResourceID = "workflow-credentials"
+
+// CredentialBroker configures how workflow runs obtain short-lived credentials.
+type CredentialBroker struct {
+	// APIURL is the openchoreo-api URL reachable from workflow planes, at which runs exchange
+	// their broker token.
+	APIURL string
+	// TokenTTL bounds the lifetime of a broker token. Runs with a shorter timeout get a token
+	// that expires with the timeout.
+	TokenTTL time.Duration
+}
+
+// issueCredentials issues a broker token to a run of a workflow that declares credentials. The
+// token is added to the rendered resources as the <run>-credentials secret in the run's
+// namespace on the workflow plane, together with the URL at which it is exchanged. Only its
+// hash is recorded in the run status.
+func issueCredentials(
+	broker *CredentialBroker,
+	workflowRun *openchoreodevv1alpha1.WorkflowRun,
+	workflow *openchoreodevv1alpha1.Workflow,
+	celContext map[string]any,
+	output *workflowpipeline.RenderOutput,
+	runResNamespace string,
+	now time.Time,
+) error {
+	if len(workflow.Spec.Credentials) == 0 {
+		workflowRun.Status.Credentials = nil
+		return nil
+	}
+	if broker == nil || broker.APIURL == "" {
+		return fmt.Errorf("workflow %q declares credentials but the credential broker is not configured", workflow.Name)
+	}
+
+	engine := template.NewEngine()
+	credentials := make([]openchoreodevv1alpha1.WorkflowCredential, 0, len(workflow.Spec.Credentials))
+	for _, credential := range workflow.Spec.Credentials {
+		rendered, err := engine.Render(credential.Repository, celContext)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate repository of credential %q: %w", credential.Name, err)
+		}
+		repository, ok := rendered.(string)
+		if !ok || repository == "" {
+			return fmt.Errorf("repository of credential %q must evaluate to a non-empty string", credential.Name)
+		}
+		credential.Repository = repository
+		credentials = append(credentials, credential)
+	}
+
+	expiresAt, err := credentialsExpiry(broker, workflow.Spec.Timeout, now)
+	if err != nil {
+		return err
+	}
+	token, hash, err := workflowcredentials.NewBrokerToken()
+	if err != nil {
+		return err
+	}
+
+	secretName := workflowRun.Name + openchoreodevv1alpha1.WorkflowCredentialsSecretSuffix
+	exchangeURL := workflowcredentials.ExchangeURL(broker.APIURL, workflowRun.Namespace, workflowRun.Name)
+	output.Resources = append(output.Resources, workflowpipeline.RenderedResource{
+		ID: credentialsResourceID,
+		Resource: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]any{
+				"name":      secretName,
+				"namespace": runResNamespace,
+			},
+			"type": "Opaque",
+			"data": map[string]any{
+				"token": base64.StdEncoding.EncodeToString([]byte(token)),
+				"url":   base64.StdEncoding.EncodeToString([]byte(exchangeURL)),
+			},
+		},
+	})
+	workflowRun.Status.Credentials = &openchoreodevv1alpha1.WorkflowRunCredentialsStatus{
+		SecretName:  secretName,
+		TokenHash:   hash,
+		ExpiresAt:   metav1.NewTime(expiresAt),
+		Credentials: credentials,
+	}
+	return nil
+}
+
+// credentialCELContext builds the context the repositories of credentials are evaluated in.
+// Whoever creates a run chooses its parameters and labels, so they must not pick the
+// repository a credential is minted for: parameters come from the run's component, or only
+// from the workflow's defaults for standalone runs, and run labels and externalRefs (resolved
+// from run parameters) are left out.
+func (r *Reconciler) credentialCELContext(
+	ctx context.Context,
+	renderInput *workflowpipeline.RenderInput,
+) (map[string]any, error) {
+	run := renderInput.WorkflowRun.DeepCopy()
+	run.Spec.Workflow.Parameters = nil
+	if componentName := run.Labels[labels.LabelKeyComponentName]; componentName != "" {
+		comp := &openchoreodevv1alpha1.Component{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: run.Namespace, Name: componentName}, comp); err != nil {
+			return nil, fmt.Errorf("failed to get component %q: %w", componentName, err)
+		}
+		if comp.Spec.Workflow != nil {
+			run.Spec.Workflow.Parameters = comp.Spec.Workflow.Parameters
+		}
+	}
+
+	trusted := &workflowpipeline.RenderInput{
+		WorkflowRun: run,
+		Workflow:    renderInput.Workflow,
+		Context: workflowpipeline.WorkflowContext{
+			NamespaceName:   renderInput.Context.NamespaceName,
+			WorkflowRunName: renderInput.Context.WorkflowRunName,
+			WorkflowPlane:   renderInput.Context.WorkflowPlane,
+		},
+	}
+	return r.Pipeline.BuildCELContext(trusted)
+}
+
+// credentialsExpiry returns when a broker token issued now expires: after the run timeout if
+// it is shorter than the broker's token TTL.
+func credentialsExpiry(broker *CredentialBroker, timeout string, now time.Time) (time.Time, error) {
+	ttl := broker.TokenTTL
+	if timeout != "" {
+		runTimeout, err := cmdutil.ParseDuration(timeout)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid workflow run timeout %q: %w", timeout, err)
+		}
+		if ttl <= 0 || runTimeout < ttl {
+			ttl = runTimeout
+		}
+	}
+	if ttl <= 0 {
+		return time.Time{}, fmt.Errorf("credential broker token TTL must be positive")
+	}
+	return now.Add(ttl).Truncate(time.Second), nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	k8sMocks "github.com/openchoreo/openchoreo/internal/clients/kubernetes/mocks"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
+	"github.com/openchoreo/openchoreo/internal/workflowcredentials"
+)
+
+func TestReconcileIssuesCredentials(t *testing.T) {
+	s := newTestScheme()
+
+	cwf := &openchoreodevv1alpha1.ClusterWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-wf"},
+		Spec: openchoreodevv1alpha1.ClusterWorkflowSpec{
+			RunTemplate: &runtime.RawExtension{Raw: []byte(`{
+				"apiVersion":"argoproj.io/v1alpha1","kind":"Workflow",
+				"metadata":{"name":"${metadata.workflowRunName}","namespace":"${metadata.namespace}"},
+				"spec":{"entrypoint":"main","serviceAccountName":"wf-sa"}
+			}`)},
+			Parameters: &openchoreodevv1alpha1.SchemaSection{
+				OpenAPIV3Schema: &runtime.RawExtension{Raw: []byte(`{
+					"type":"object","properties":{"repo":{"type":"string","default":"https://github.com/acme/api"}}
+				}`)},
+			},
+			Credentials: []openchoreodevv1alpha1.WorkflowCredential{
+				{Name: "source", Type: openchoreodevv1alpha1.WorkflowCredentialTypeGitRead, Repository: "${parameters.repo}"},
+				{Name: "image", Type: openchoreodevv1alpha1.WorkflowCredentialTypeRegistryPush, Repository: "registry.example.com/acme/api"},
+			},
+			Timeout: "30m",
+		},
+	}
+	newRun := func(name string) *openchoreodevv1alpha1.WorkflowRun {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "default",
+				Finalizers: []string{WorkflowRunCleanupFinalizer},
+				Generation: 1,
+			},
+			Spec: openchoreodevv1alpha1.WorkflowRunSpec{
+				Workflow: openchoreodevv1alpha1.WorkflowRunConfig{
+					Name:       "build-wf",
+					Parameters: &runtime.RawExtension{Raw: []byte(`{"repo":"https://github.com/attacker/api"}`)},
+				},
+			},
+		}
+		setWorkflowPendingCondition(wfr)
+		return wfr
+	}
+	brokered := newRun("brokered-wfr")
+	unbrokered := newRun("unbrokered-wfr")
+	cwp := &openchoreodevv1alpha1.ClusterWorkflowPlane{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+
+	cpClient := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(cwf, brokered, unbrokered, cwp).
+		WithStatusSubresource(brokered, unbrokered).
+		Build()
+	wpClient := fake.NewClientBuilder().WithScheme(s).Build()
+
+	mockProvider := &k8sMocks.MockWorkflowPlaneClientProvider{}
+	mockProvider.EXPECT().ClusterWorkflowPlaneClient(mock.Anything).Return(wpClient, nil)
+
+	newReconciler := func(broker *CredentialBroker) *Reconciler {
+		return &Reconciler{
+			Client:              cpClient,
+			Scheme:              s,
+			PlaneClientProvider: mockProvider,
+			Pipeline:            workflowpipeline.NewPipeline(),
+			CredentialBroker:    broker,
+		}
+	}
+
+	t.Run("stores the broker token on the workflow plane", func(t *testing.T) {
+		r := newReconciler(&CredentialBroker{APIURL: "https://api.openchoreo.example", TokenTTL: 3 * time.Hour})
+		key := types.NamespacedName{Name: "brokered-wfr", Namespace: "default"}
+		if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got := &openchoreodevv1alpha1.WorkflowRun{}
+		if err := cpClient.Get(context.Background(), key, got); err != nil {
+			t.Fatalf("failed to get WorkflowRun: %v", err)
+		}
+		status := got.Status.Credentials
+		if status == nil {
+			t.Fatal("expected credentials to be recorded in the run status")
+		}
+		if status.SecretName != "brokered-wfr-credentials" {
+			t.Errorf("expected secret brokered-wfr-credentials, got %q", status.SecretName)
+		}
+		if status.Credentials[0].Repository != "https://github.com/acme/api" {
+			t.Errorf("expected the repository to be resolved from the workflow defaults, not the run parameters, got %q",
+				status.Credentials[0].Repository)
+		}
+		if until := time.Until(status.ExpiresAt.Time); until > 30*time.Minute || until < 29*time.Minute {
+			t.Errorf("expected the token to expire with the 30m run timeout, expires in %s", until)
+		}
+
+		secret := &corev1.Secret{}
+		if err := wpClient.Get(context.Background(), types.NamespacedName{
+			Name:      "brokered-wfr-credentials",
+			Namespace: "workflows-default",
+		}, secret); err != nil {
+			t.Fatalf("expected the broker token secret on the workflow plane: %v", err)
+		}
+		if !workflowcredentials.VerifyBrokerToken(string(secret.Data["token"]), status.TokenHash) {
+			t.Error("expected the stored token to match the recorded hash")
+		}
+		wantURL := "https://api.openchoreo.example/api/v1/namespaces/default/workflowruns/brokered-wfr/credentials"
+		if got := string(secret.Data["url"]); got != wantURL {
+			t.Errorf("expected exchange URL %q, got %q", wantURL, got)
+		}
+	})
+
+	t.Run("fails permanently without a broker", func(t *testing.T) {
+		r := newReconciler(nil)
+		key := types.NamespacedName{Name: "unbrokered-wfr", Namespace: "default"}
+		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Requeue || result.RequeueAfter != 0 {
+			t.Errorf("expected no requeue, got %+v", result)
+		}
+
+		got := &openchoreodevv1alpha1.WorkflowRun{}
+		if err := cpClient.Get(context.Background(), key, got); err != nil {
+			t.Fatalf("failed to get WorkflowRun: %v", err)
+		}
+		assertCondition(t, got, string(ConditionWorkflowCompleted), metav1.ConditionTrue, string(ReasonCredentialIssuanceFailed))
+		if got.Status.RunReference != nil {
+			t.Error("expected no run resource to be created")
+		}
+	})
+}
+
+func TestCredentialCELContext(t *testing.T) {
+	s := newTestScheme()
+	comp := &openchoreodevv1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+		Spec: openchoreodevv1alpha1.ComponentSpec{
+			Owner: openchoreodevv1alpha1.ComponentOwner{ProjectName: "shop"},
+			Workflow: &openchoreodevv1alpha1.ComponentWorkflowConfig{
+				Name:       "build-wf",
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"repo":"https://github.com/acme/api"}`)},
+			},
+		},
+	}
+	r := &Reconciler{
+		Client:   fake.NewClientBuilder().WithScheme(s).WithObjects(comp).Build(),
+		Scheme:   s,
+		Pipeline: workflowpipeline.NewPipeline(),
+	}
+	run := &openchoreodevv1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "api-build-1",
+			Namespace: "default",
+			Labels: map[string]string{
+				labels.LabelKeyProjectName:   "shop",
+				labels.LabelKeyComponentName: "api",
+				"repo":                       "https://github.com/attacker/api",
+			},
+		},
+		Spec: openchoreodevv1alpha1.WorkflowRunSpec{
+			Workflow: openchoreodevv1alpha1.WorkflowRunConfig{
+				Name:       "build-wf",
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"repo":"https://github.com/attacker/api"}`)},
+			},
+		},
+	}
+	renderInput := &workflowpipeline.RenderInput{
+		WorkflowRun: run,
+		Workflow:    &openchoreodevv1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "build-wf"}},
+		Context: workflowpipeline.WorkflowContext{
+			NamespaceName:   "default",
+			WorkflowRunName: run.Name,
+			Labels:          run.Labels,
+			ExternalRefs:    map[string]any{"git": map[string]any{"spec": map[string]any{}}},
+		},
+	}
+
+	celContext, err := r.credentialCELContext(context.Background(), renderInput)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := celContext["parameters"].(map[string]any)["repo"]; got != "https://github.com/acme/api" {
+		t.Errorf("expected parameters from the component, got %v", got)
+	}
+	if got := celContext["metadata"].(map[string]any)["labels"].(map[string]string); len(got) != 0 {
+		t.Errorf("expected run labels to be left out, got %v", got)
+	}
+	if _, ok := celContext["externalRefs"]; ok {
+		t.Error("expected externalRefs to be left out")
+	}
+	if string(run.Spec.Workflow.Parameters.Raw) != `{"repo":"https://github.com/attacker/api"}` {
+		t.Error("expected the run to be left unmodified")
+	}
+}
+
+func TestCredentialsExpiry(t *testing.T) {
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	broker := &CredentialBroker{TokenTTL: time.Hour}
+
+	tests := []struct {
+		name    string
+		timeout string
+		want    time.Time
+	}{
+		{"uses the token TTL without a run timeout", "", now.Add(time.Hour)},
+		{"expires with a shorter run timeout", "20m", now.Add(20 * time.Minute)},
+		{"is capped by the token TTL", "2h", now.Add(time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := credentialsExpiry(broker, tt.timeout, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+
+	if _, err := credentialsExpiry(broker, "soon", now); err == nil {
+		t.Error("expected an invalid timeout to be rejected")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Build queue metrics exposed on the controller manager's metrics endpoint. They track how
+// long workflow runs wait for capacity on workflow planes that limit concurrent runs.
+var (
+	queueWaitSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "openchoreo_workflow_run_queue_wait_seconds",
+			Help: "Time a WorkflowRun waited in the build queue of its workflow plane before it was admitted.",
+			// 1s to ~6 hours.
+			Buckets: prometheus.ExponentialBuckets(1, 3, 10),
+		},
+		[]string{"workflow_plane", "namespace", "priority_class"},
+	)
+
+	queuedRuns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_workflow_run_queue_length",
+			Help: "Number of WorkflowRuns waiting in the build queue of a workflow plane.",
+		},
+		[]string{"workflow_plane"},
+	)
+
+	runningRuns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openchoreo_workflow_runs_admitted",
+			Help: "Number of admitted WorkflowRuns executing on a workflow plane that limits concurrent runs.",
+		},
+		[]string{"workflow_plane"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		queueWaitSeconds,
+		queuedRuns,
+		runningRuns,
+	)
+}
+
+func observeAdmission(run *openchoreov1alpha1.WorkflowRun) {
+	queue := run.Status.Queue
+	if queue.AdmittedAt == nil || queue.AdmittedAt.Before(&queue.QueuedAt) {
+		return
+	}
+	queueWaitSeconds.WithLabelValues(queue.WorkflowPlane, run.Namespace, queue.PriorityClass).
+		Observe(queue.AdmittedAt.Sub(queue.QueuedAt.Time).Seconds())
+}
+
+func observeQueue(plane string, queued, running int) {
+	queuedRuns.WithLabelValues(plane).Set(float64(queued))
+	runningRuns.WithLabelValues(plane).Set(float64(running))
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// resolveWorkflowPlane returns the workflow plane a run executes on. Runs keep the plane recorded
+// in their status. Runs of workflows that select planes by label are scheduled on one of the
+// matching planes and the choice is recorded in status; other runs use the workflow's plane ref.
+func (r *Reconciler) resolveWorkflowPlane(
+	ctx context.Context,
+	workflowRun *openchoreodevv1alpha1.WorkflowRun,
+	workflowResult *controller.WorkflowResult,
+	spec *openchoreodevv1alpha1.WorkflowSpec,
+) (*controller.WorkflowPlaneResult, error) {
+	if ref := workflowRun.Status.WorkflowPlane; ref != nil {
+		return controller.GetWorkflowPlaneFromRef(ctx, r.Client, workflowRun.Namespace, ref)
+	}
+	// Runs submitted before the workflow selected planes stay on the plane they were submitted to.
+	if spec.WorkflowPlaneSelector == nil || workflowRun.Status.RunReference != nil {
+		return controller.GetWorkflowPlaneFromRef(ctx, r.Client, workflowRun.Namespace, spec.WorkflowPlaneRef)
+	}
+
+	plane, err := r.scheduleWorkflowPlane(ctx, workflowRun, spec.WorkflowPlaneSelector, workflowResult.ClusterWorkflow != nil)
+	if err != nil {
+		return nil, err
+	}
+	workflowRun.Status.WorkflowPlane = planeRef(plane)
+	return plane, nil
+}
+
+// planeCandidate is a workflow plane a run can be scheduled on, with its current load.
+type planeCandidate struct {
+	plane    *controller.WorkflowPlaneResult
+	key      string
+	affinity bool
+	waiting  int
+	active   int
+}
+
+// scheduleWorkflowPlane picks the plane of a run among the planes matching the selector. Runs of
+// ClusterWorkflows can only use ClusterWorkflowPlanes; runs of namespaced Workflows can also use
+// the WorkflowPlanes of their namespace. The plane of the component's previous run is kept when
+// the selector is sticky and the plane still matches. Otherwise planes the run's namespace has an
+// affinity to are preferred, then planes with the fewest queued runs, then the fewest active runs.
+func (r *Reconciler) scheduleWorkflowPlane(
+	ctx context.Context,
+	workflowRun *openchoreodevv1alpha1.WorkflowRun,
+	selector *openchoreodevv1alpha1.WorkflowPlaneSelector,
+	clusterOnly bool,
+) (*controller.WorkflowPlaneResult, error) {
+	candidates, err := r.listPlaneCandidates(ctx, workflowRun.Namespace, selector, clusterOnly)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		matchLabels := k8slabels.SelectorFromSet(selector.MatchLabels).String()
+		return nil, fmt.Errorf("no workflow plane matches the selector %q: %w", matchLabels,
+			apierrors.NewNotFound(schema.GroupResource{
+				Group:    openchoreodevv1alpha1.GroupVersion.Group,
+				Resource: "workflowplanes",
+			}, matchLabels))
+	}
+
+	runList := &openchoreodevv1alpha1.WorkflowRunList{}
+	if err := r.List(ctx, runList); err != nil {
+		return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+
+	byKey := make(map[string]*planeCandidate, len(candidates))
+	for _, c := range candidates {
+		byKey[c.key] = c
+	}
+	for i := range runList.Items {
+		run := &runList.Items[i]
+		if run.UID == workflowRun.UID || !run.DeletionTimestamp.IsZero() || isWorkflowCompleted(run) {
+			continue
+		}
+		c := byKey[runPlaneKey(run)]
+		// Namespaced planes with the same name in other namespaces are different planes.
+		if c == nil || (c.plane.WorkflowPlane != nil && run.Namespace != workflowRun.Namespace) {
+			continue
+		}
+		c.active++
+		if queue := run.Status.Queue; queue != nil && queue.AdmittedAt == nil {
+			c.waiting++
+		}
+	}
+
+	logger := log.FromContext(ctx)
+	if selector.StickyPerComponent {
+		if key := previousComponentPlane(workflowRun, runList.Items); byKey[key] != nil {
+			logger.Info("Scheduled workflow run on the plane of the component's previous run", "workflowPlane", key)
+			return byKey[key].plane, nil
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.affinity != b.affinity {
+			return a.affinity
+		}
+		if a.waiting != b.waiting {
+			return a.waiting < b.waiting
+		}
+		if a.active != b.active {
+			return a.active < b.active
+		}
+		return a.key < b.key
+	})
+	chosen := candidates[0]
+	logger.Info("Scheduled workflow run on workflow plane",
+		"workflowPlane", chosen.key,
+		"queued", chosen.waiting,
+		"active", chosen.active,
+		"candidates", len(candidates))
+	return chosen.plane, nil
+}
+
+// listPlaneCandidates lists the workflow planes matching the selector.
+func (r *Reconciler) listPlaneCandidates(
+	ctx context.Context,
+	namespace string,
+	selector *openchoreodevv1alpha1.WorkflowPlaneSelector,
+	clusterOnly bool,
+) ([]*planeCandidate, error) {
+	matching := client.MatchingLabels(selector.MatchLabels)
+	var candidates []*planeCandidate
+
+	if !clusterOnly {
+		wpList := &openchoreodevv1alpha1.WorkflowPlaneList{}
+		if err := r.List(ctx, wpList, client.InNamespace(namespace), matching); err != nil {
+			return nil, fmt.Errorf("failed to list workflow planes: %w", err)
+		}
+		for i := range wpList.Items {
+			plane := &controller.WorkflowPlaneResult{WorkflowPlane: &wpList.Items[i]}
+			candidates = append(candidates, &planeCandidate{plane: plane, key: queueKey(plane), affinity: true})
+		}
+	}
+
+	cwpList := &openchoreodevv1alpha1.ClusterWorkflowPlaneList{}
+	if err := r.List(ctx, cwpList, matching); err != nil {
+		return nil, fmt.Errorf("failed to list cluster workflow planes: %w", err)
+	}
+	for i := range cwpList.Items {
+		plane := &controller.WorkflowPlaneResult{ClusterWorkflowPlane: &cwpList.Items[i]}
+		candidates = append(candidates, &planeCandidate{
+			plane:    plane,
+			key:      queueKey(plane),
+			affinity: plane.GetScheduling().IsPreferredBy(namespace),
+		})
+	}
+	return candidates, nil
+}
+
+// previousComponentPlane returns the plane of the most recent other run of the same component,
+// or an empty string when the run does not belong to a component or no previous run was scheduled.
+func previousComponentPlane(workflowRun *openchoreodevv1alpha1.WorkflowRun, runs []openchoreodevv1alpha1.WorkflowRun) string {
+	project := workflowRun.Labels[labels.LabelKeyProjectName]
+	component := workflowRun.Labels[labels.LabelKeyComponentName]
+	if project == "" || component == "" {
+		return ""
+	}
+
+	var previous *openchoreodevv1alpha1.WorkflowRun
+	for i := range runs {
+		run := &runs[i]
+		if run.UID == workflowRun.UID || run.Namespace != workflowRun.Namespace || run.Status.WorkflowPlane == nil ||
+			run.Labels[labels.LabelKeyProjectName] != project || run.Labels[labels.LabelKeyComponentName] != component {
+			continue
+		}
+		if previous == nil || previous.CreationTimestamp.Before(&run.CreationTimestamp) {
+			previous = run
+		}
+	}
+	if previous == nil {
+		return ""
+	}
+	return runPlaneKey(previous)
+}
+
+// runPlaneKey returns the queue key of the plane a run was scheduled on or queued on.
+func runPlaneKey(run *openchoreodevv1alpha1.WorkflowRun) string {
+	if ref := run.Status.WorkflowPlane; ref != nil {
+		return string(ref.Kind) + "/" + ref.Name
+	}
+	if run.Status.Queue != nil {
+		return run.Status.Queue.WorkflowPlane
+	}
+	return ""
+}
+
+func planeRef(plane *controller.WorkflowPlaneResult) *openchoreodevv1alpha1.WorkflowPlaneRef {
+	kind := openchoreodevv1alpha1.WorkflowPlaneRefKindWorkflowPlane
+	if plane.ClusterWorkflowPlane != nil {
+		kind = openchoreodevv1alpha1.WorkflowPlaneRefKindClusterWorkflowPlane
+	}
+	return &openchoreodevv1alpha1.WorkflowPlaneRef{Kind: kind, Name: plane.GetName()}
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func TestScheduleWorkflowPlane(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = openchoreodevv1alpha1.AddToScheme(scheme)
+
+	newClusterPlane := func(name, arch string, scheduling *openchoreodevv1alpha1.WorkflowSchedulingSpec) *openchoreodevv1alpha1.ClusterWorkflowPlane {
+		return &openchoreodevv1alpha1.ClusterWorkflowPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"arch": arch}},
+			Spec:       openchoreodevv1alpha1.ClusterWorkflowPlaneSpec{Scheduling: scheduling},
+		}
+	}
+	newRun := func(name, component string, created time.Duration, plane string) *openchoreodevv1alpha1.WorkflowRun {
+		run := &openchoreodevv1alpha1.WorkflowRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "a",
+				UID:               types.UID("a/" + name),
+				CreationTimestamp: metav1.NewTime(queueBase.Add(created)),
+			},
+		}
+		if component != "" {
+			run.Labels = map[string]string{
+				labels.LabelKeyProjectName:   "project",
+				labels.LabelKeyComponentName: component,
+			}
+		}
+		if plane != "" {
+			run.Status.WorkflowPlane = &openchoreodevv1alpha1.WorkflowPlaneRef{
+				Kind: openchoreodevv1alpha1.WorkflowPlaneRefKindClusterWorkflowPlane,
+				Name: plane,
+			}
+		}
+		return run
+	}
+	newReconciler := func(objs ...client.Object) *Reconciler {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+		return &Reconciler{Client: fakeClient, Scheme: scheme}
+	}
+	selector := &openchoreodevv1alpha1.WorkflowPlaneSelector{MatchLabels: map[string]string{"arch": "arm64"}}
+
+	t.Run("picks the matching plane with the fewest active runs", func(t *testing.T) {
+		r := newReconciler(
+			newClusterPlane("arm-1", "arm64", nil),
+			newClusterPlane("arm-2", "arm64", nil),
+			newClusterPlane("amd-1", "amd64", nil),
+			newRun("running-1", "", 0, "arm-1"),
+		)
+		plane, err := r.scheduleWorkflowPlane(context.Background(), newRun("new-run", "", time.Hour, ""), selector, true)
+		if err != nil {
+			t.Fatalf("scheduleWorkflowPlane() error = %v", err)
+		}
+		if plane.GetName() != "arm-2" {
+			t.Errorf("scheduled on %s, want arm-2", plane.GetName())
+		}
+	})
+
+	t.Run("prefers planes with a shorter queue", func(t *testing.T) {
+		limited := &openchoreodevv1alpha1.WorkflowSchedulingSpec{MaxConcurrentRuns: ptr.To[int32](1)}
+		queued := newRun("queued", "", time.Minute, "arm-1")
+		queued.Status.Queue = &openchoreodevv1alpha1.WorkflowRunQueueStatus{WorkflowPlane: "ClusterWorkflowPlane/arm-1"}
+		r := newReconciler(
+			newClusterPlane("arm-1", "arm64", limited),
+			newClusterPlane("arm-2", "arm64", nil),
+			queued,
+			newRun("running-1", "", 0, "arm-2"),
+			newRun("running-2", "", 0, "arm-2"),
+		)
+		plane, err := r.scheduleWorkflowPlane(context.Background(), newRun("new-run", "", time.Hour, ""), selector, true)
+		if err != nil {
+			t.Fatalf("scheduleWorkflowPlane() error = %v", err)
+		}
+		if plane.GetName() != "arm-2" {
+			t.Errorf("scheduled on %s, want arm-2", plane.GetName())
+		}
+	})
+
+	t.Run("prefers planes the namespace has an affinity to", func(t *testing.T) {
+		preferred := &openchoreodevv1alpha1.WorkflowSchedulingSpec{PreferredNamespaces: []string{"a"}}
+		r := newReconciler(
+			newClusterPlane("arm-1", "arm64", nil),
+			newClusterPlane("arm-2", "arm64", preferred),
+			newRun("running-1", "", 0, "arm-2"),
+		)
+		plane, err := r.scheduleWorkflowPlane(context.Background(), newRun("new-run", "", time.Hour, ""), selector, true)
+		if err != nil {
+			t.Fatalf("scheduleWorkflowPlane() error = %v", err)
+		}
+		if plane.GetName() != "arm-2" {
+			t.Errorf("scheduled on %s, want arm-2", plane.GetName())
+		}
+	})
+
+	t.Run("keeps the plane of the component's previous run when sticky", func(t *testing.T) {
+		completed := newRun("previous", "api", time.Minute, "arm-1")
+		completed.Status.Conditions = []metav1.Condition{{
+			Type:   string(ConditionWorkflowCompleted),
+			Status: metav1.ConditionTrue,
+			Reason: string(ReasonWorkflowSucceeded),
+		}}
+		r := newReconciler(
+			newClusterPlane("arm-1", "arm64", nil),
+			newClusterPlane("arm-2", "arm64", nil),
+			newRun("older", "api", 0, "arm-2"),
+			completed,
+			newRun("running-1", "", 0, "arm-1"),
+		)
+		sticky := &openchoreodevv1alpha1.WorkflowPlaneSelector{MatchLabels: selector.MatchLabels, StickyPerComponent: true}
+		plane, err := r.scheduleWorkflowPlane(context.Background(), newRun("new-run", "api", time.Hour, ""), sticky, true)
+		if err != nil {
+			t.Fatalf("scheduleWorkflowPlane() error = %v", err)
+		}
+		if plane.GetName() != "arm-1" {
+			t.Errorf("scheduled on %s, want arm-1", plane.GetName())
+		}
+	})
+
+	t.Run("leaves the previous plane once it no longer matches", func(t *testing.T) {
+		r := newReconciler(
+			newClusterPlane("arm-1", "arm64", nil),
+			newClusterPlane("amd-1", "amd64", nil),
+			newRun("previous", "api", 0, "amd-1"),
+		)
+		sticky := &openchoreodevv1alpha1.WorkflowPlaneSelector{MatchLabels: selector.MatchLabels, StickyPerComponent: true}
+		plane, err := r.scheduleWorkflowPlane(context.Background(), newRun("new-run", "api", time.Hour, ""), sticky, true)
+		if err != nil {
+			t.Fatalf("scheduleWorkflowPlane() error = %v", err)
+		}
+		if plane.GetName() != "arm-1" {
+			t.Errorf("scheduled on %s, want arm-1", plane.GetName())
+		}
+	})
+
+	t.Run("prefers workflow planes of the run's namespace for namespaced workflows", func(t *testing.T) {
+		r := newReconciler(
+			newClusterPlane("arm-1", "arm64", nil),
+			&openchoreodevv1alpha1.WorkflowPlane{ObjectMeta: metav1.ObjectMeta{
+				Name: "tenant-arm", Namespace: "a", Labels: map[string]string{"arch": "arm64"},
+			}},
+		)
+		run := newRun("new-run", "", time.Hour, "")
+		plane, err := r.scheduleWorkflowPlane(context.Background(), run, selector, false)
+		if err != nil {
+			t.Fatalf("scheduleWorkflowPlane() error = %v", err)
+		}
+		if plane.WorkflowPlane == nil || plane.GetName() != "tenant-arm" {
+			t.Errorf("scheduled on %s, want WorkflowPlane/tenant-arm", queueKey(plane))
+		}
+
+		plane, err = r.scheduleWorkflowPlane(context.Background(), run, selector, true)
+		if err != nil {
+			t.Fatalf("scheduleWorkflowPlane() error = %v", err)
+		}
+		if plane.ClusterWorkflowPlane == nil || plane.GetName() != "arm-1" {
+			t.Errorf("scheduled on %s, want ClusterWorkflowPlane/arm-1", queueKey(plane))
+		}
+	})
+
+	t.Run("returns not found when no plane matches", func(t *testing.T) {
+		r := newReconciler(newClusterPlane("amd-1", "amd64", nil))
+		_, err := r.scheduleWorkflowPlane(context.Background(), newRun("new-run", "", time.Hour, ""), selector, true)
+		if !apierrors.IsNotFound(err) {
+			t.Errorf("scheduleWorkflowPlane() error = %v, want not found", err)
+		}
+	})
+}
+
+func TestResolveWorkflowPlane(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = openchoreodevv1alpha1.AddToScheme(scheme)
+
+	planes := []client.Object{
+		&openchoreodevv1alpha1.ClusterWorkflowPlane{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&openchoreodevv1alpha1.ClusterWorkflowPlane{ObjectMeta: metav1.ObjectMeta{
+			Name: "arm-1", Labels: map[string]string{"arch": "arm64"},
+		}},
+	}
+	r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(planes...).Build(), Scheme: scheme}
+	workflowResult := &controller.WorkflowResult{ClusterWorkflow: &openchoreodevv1alpha1.ClusterWorkflow{}}
+	spec := &openchoreodevv1alpha1.WorkflowSpec{
+		WorkflowPlaneRef: &openchoreodevv1alpha1.WorkflowPlaneRef{
+			Kind: openchoreodevv1alpha1.WorkflowPlaneRefKindClusterWorkflowPlane,
+			Name: "default",
+		},
+		WorkflowPlaneSelector: &openchoreodevv1alpha1.WorkflowPlaneSelector{MatchLabels: map[string]string{"arch": "arm64"}},
+	}
+
+	t.Run("records the scheduled plane in status", func(t *testing.T) {
+		run := &openchoreodevv1alpha1.WorkflowRun{ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "a"}}
+		plane, err := r.resolveWorkflowPlane(context.Background(), run, workflowResult, spec)
+		if err != nil {
+			t.Fatalf("resolveWorkflowPlane() error = %v", err)
+		}
+		if plane.GetName() != "arm-1" {
+			t.Errorf("resolved %s, want arm-1", plane.GetName())
+		}
+		want := openchoreodevv1alpha1.WorkflowPlaneRef{Kind: openchoreodevv1alpha1.WorkflowPlaneRefKindClusterWorkflowPlane, Name: "arm-1"}
+		if run.Status.WorkflowPlane == nil || *run.Status.WorkflowPlane != want {
+			t.Errorf("status.workflowPlane = %+v, want %+v", run.Status.WorkflowPlane, want)
+		}
+	})
+
+	t.Run("keeps runs submitted before the workflow selected planes on the workflow's plane", func(t *testing.T) {
+		run := &openchoreodevv1alpha1.WorkflowRun{ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "a"}}
+		run.Status.RunReference = &openchoreodevv1alpha1.ResourceReference{Name: "run", Namespace: "workflows-a"}
+		plane, err := r.resolveWorkflowPlane(context.Background(), run, workflowResult, spec)
+		if err != nil {
+			t.Fatalf("resolveWorkflowPlane() error = %v", err)
+		}
+		if plane.GetName() != "default" || run.Status.WorkflowPlane != nil {
+			t.Errorf("resolved %s with status %+v, want default without status", plane.GetName(), run.Status.WorkflowPlane)
+		}
+	})
+}
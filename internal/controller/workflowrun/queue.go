@@ -0,0 +1,166 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// queuedRunRequeueInterval is how often queued runs check whether capacity became available.
+const queuedRunRequeueInterval = 15 * time.Second
+
+// queueKey identifies the build queue of a workflow plane. Namespaced workflow planes are only
+// referenced by runs in their own namespace, so the kind and name are enough to identify them.
+func queueKey(plane *controller.WorkflowPlaneResult) string {
+	if plane.ClusterWorkflowPlane != nil {
+		return "ClusterWorkflowPlane/" + plane.GetName()
+	}
+	return "WorkflowPlane/" + plane.GetName()
+}
+
+// admitWorkflowRun places a run that has not started yet in the build queue of its workflow
+// plane and reports whether it may start. Runs on planes that do not limit concurrent runs are
+// admitted immediately. Admission is decided from the cached runs, so the limit can briefly be
+// exceeded while status updates of recently admitted runs are still propagating.
+func (r *Reconciler) admitWorkflowRun(
+	ctx context.Context,
+	workflowRun *openchoreodevv1alpha1.WorkflowRun,
+	plane *controller.WorkflowPlaneResult,
+) (bool, error) {
+	queue := workflowRun.Status.Queue
+	if queue != nil && queue.AdmittedAt != nil {
+		return true, nil
+	}
+
+	scheduling := plane.GetScheduling()
+	limit := scheduling.GetMaxConcurrentRuns()
+	if limit == 0 {
+		// The limit was removed while the run was waiting.
+		if queue != nil {
+			admit(workflowRun)
+		}
+		return true, nil
+	}
+
+	key := queueKey(plane)
+	if queue == nil || queue.WorkflowPlane != key {
+		queue = &openchoreodevv1alpha1.WorkflowRunQueueStatus{
+			WorkflowPlane: key,
+			QueuedAt:      metav1.Now(),
+		}
+		workflowRun.Status.Queue = queue
+	}
+	priorityClass, found := scheduling.PriorityClass(workflowRun.Spec.PriorityClassName)
+	if !found {
+		log.FromContext(ctx).Info("Priority class is not defined by the workflow plane, using priority 0",
+			"priorityClass", priorityClass.Name,
+			"workflowPlane", key)
+	}
+	queue.PriorityClass = priorityClass.Name
+	queue.Priority = priorityClass.Priority
+
+	var opts []client.ListOption
+	if plane.WorkflowPlane != nil {
+		opts = append(opts, client.InNamespace(workflowRun.Namespace))
+	}
+	runList := &openchoreodevv1alpha1.WorkflowRunList{}
+	if err := r.List(ctx, runList, opts...); err != nil {
+		return false, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+
+	running := make(map[string]int)
+	var total int
+	pending := []*openchoreodevv1alpha1.WorkflowRun{workflowRun}
+	for i := range runList.Items {
+		run := &runList.Items[i]
+		if run.UID == workflowRun.UID || !inQueue(run, key) {
+			continue
+		}
+		if run.Status.Queue.AdmittedAt != nil {
+			running[run.Namespace]++
+			total++
+			continue
+		}
+		pending = append(pending, run)
+	}
+
+	free := max(limit-int32(total), 0)
+	position := int32(slices.Index(orderQueue(pending, running, scheduling), workflowRun)) + 1
+	if position <= free {
+		admit(workflowRun)
+		observeQueue(key, len(pending)-1, total+1)
+		return true, nil
+	}
+	queue.Position = position - free
+	setWorkflowQueuedCondition(workflowRun, queue)
+	observeQueue(key, len(pending), total)
+	return false, nil
+}
+
+// inQueue reports whether a run holds or waits for capacity in the given build queue.
+func inQueue(run *openchoreodevv1alpha1.WorkflowRun, key string) bool {
+	return run.Status.Queue != nil && run.Status.Queue.WorkflowPlane == key &&
+		run.DeletionTimestamp.IsZero() && !isWorkflowCompleted(run)
+}
+
+func admit(workflowRun *openchoreodevv1alpha1.WorkflowRun) {
+	now := metav1.Now()
+	workflowRun.Status.Queue.Position = 0
+	workflowRun.Status.Queue.AdmittedAt = &now
+	setWorkflowPendingCondition(workflowRun)
+	observeAdmission(workflowRun)
+}
+
+// orderQueue returns the order in which waiting runs are admitted. Runs of a higher priority go
+// first. Among runs of the same priority, the next run is taken from the namespace with the
+// fewest running and already ordered runs relative to its fair-share weight, and within a
+// namespace runs are admitted in the order they were queued.
+func orderQueue(
+	pending []*openchoreodevv1alpha1.WorkflowRun,
+	running map[string]int,
+	scheduling *openchoreodevv1alpha1.WorkflowSchedulingSpec,
+) []*openchoreodevv1alpha1.WorkflowRun {
+	remaining := slices.Clone(pending)
+	sort.SliceStable(remaining, func(i, j int) bool {
+		a, b := remaining[i].Status.Queue, remaining[j].Status.Queue
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if !a.QueuedAt.Equal(&b.QueuedAt) {
+			return a.QueuedAt.Before(&b.QueuedAt)
+		}
+		return remaining[i].Namespace+"/"+remaining[i].Name < remaining[j].Namespace+"/"+remaining[j].Name
+	})
+
+	shares := maps.Clone(running)
+	ordered := make([]*openchoreodevv1alpha1.WorkflowRun, 0, len(remaining))
+	for len(remaining) > 0 {
+		next := 0
+		for i := 1; i < len(remaining) && remaining[i].Status.Queue.Priority == remaining[0].Status.Queue.Priority; i++ {
+			candidate, best := remaining[i].Namespace, remaining[next].Namespace
+			// Compare shares[candidate]/weight(candidate) < shares[best]/weight(best) without division.
+			if int64(shares[candidate])*int64(scheduling.NamespaceWeight(best)) <
+				int64(shares[best])*int64(scheduling.NamespaceWeight(candidate)) {
+				next = i
+			}
+		}
+		run := remaining[next]
+		ordered = append(ordered, run)
+		shares[run.Namespace]++
+		remaining = slices.Delete(remaining, next, next+1)
+	}
+	return ordered
+}
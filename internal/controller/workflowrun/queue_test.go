@@ -0,0 +1,201 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+var queueBase = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+const testQueueKey = "ClusterWorkflowPlane/default"
+
+func newQueuedRun(namespace, name string, priority int32, offset time.Duration, admitted bool) *openchoreodevv1alpha1.WorkflowRun {
+	run := &openchoreodevv1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       types.UID(namespace + "/" + name),
+		},
+		Status: openchoreodevv1alpha1.WorkflowRunStatus{
+			Queue: &openchoreodevv1alpha1.WorkflowRunQueueStatus{
+				WorkflowPlane: testQueueKey,
+				Priority:      priority,
+				QueuedAt:      metav1.NewTime(queueBase.Add(offset)),
+			},
+		},
+	}
+	if admitted {
+		run.Status.Queue.AdmittedAt = ptr.To(metav1.NewTime(queueBase.Add(offset + time.Minute)))
+	}
+	return run
+}
+
+func runNames(runs []*openchoreodevv1alpha1.WorkflowRun) string {
+	names := make([]string, 0, len(runs))
+	for _, run := range runs {
+		names = append(names, run.Namespace+"/"+run.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+func TestOrderQueue(t *testing.T) {
+	t.Run("higher priority runs jump ahead", func(t *testing.T) {
+		pending := []*openchoreodevv1alpha1.WorkflowRun{
+			newQueuedRun("a", "branch-1", 0, 0, false),
+			newQueuedRun("a", "branch-2", 0, time.Minute, false),
+			newQueuedRun("a", "release", 100, 2*time.Minute, false),
+		}
+		got := runNames(orderQueue(pending, map[string]int{}, nil))
+		if want := "a/release,a/branch-1,a/branch-2"; got != want {
+			t.Errorf("orderQueue() = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("capacity is shared between namespaces by weight", func(t *testing.T) {
+		scheduling := &openchoreodevv1alpha1.WorkflowSchedulingSpec{
+			NamespaceWeights: []openchoreodevv1alpha1.NamespaceWeight{{Namespace: "a", Weight: 2}},
+		}
+		pending := []*openchoreodevv1alpha1.WorkflowRun{
+			newQueuedRun("a", "run-1", 0, 0, false),
+			newQueuedRun("a", "run-2", 0, time.Minute, false),
+			newQueuedRun("a", "run-3", 0, 2*time.Minute, false),
+			newQueuedRun("a", "run-4", 0, 3*time.Minute, false),
+			newQueuedRun("b", "run-1", 0, 4*time.Minute, false),
+			newQueuedRun("b", "run-2", 0, 5*time.Minute, false),
+		}
+		got := runNames(orderQueue(pending, map[string]int{"b": 1}, scheduling))
+		if want := "a/run-1,a/run-2,a/run-3,b/run-1,a/run-4,b/run-2"; got != want {
+			t.Errorf("orderQueue() = %s, want %s", got, want)
+		}
+	})
+}
+
+func TestAdmitWorkflowRun(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = openchoreodevv1alpha1.AddToScheme(scheme)
+
+	newPlane := func(scheduling *openchoreodevv1alpha1.WorkflowSchedulingSpec) *controller.WorkflowPlaneResult {
+		return &controller.WorkflowPlaneResult{ClusterWorkflowPlane: &openchoreodevv1alpha1.ClusterWorkflowPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       openchoreodevv1alpha1.ClusterWorkflowPlaneSpec{Scheduling: scheduling},
+		}}
+	}
+	newReconciler := func(objs ...client.Object) *Reconciler {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+		return &Reconciler{Client: fakeClient, Scheme: scheme}
+	}
+	newRun := func(priorityClass string) *openchoreodevv1alpha1.WorkflowRun {
+		return &openchoreodevv1alpha1.WorkflowRun{
+			ObjectMeta: metav1.ObjectMeta{Name: "new-run", Namespace: "a", UID: "a/new-run"},
+			Spec:       openchoreodevv1alpha1.WorkflowRunSpec{PriorityClassName: priorityClass},
+		}
+	}
+	scheduling := &openchoreodevv1alpha1.WorkflowSchedulingSpec{
+		MaxConcurrentRuns: ptr.To[int32](2),
+		PriorityClasses: []openchoreodevv1alpha1.WorkflowPriorityClass{
+			{Name: "release", Priority: 100},
+			{Name: "branch", Priority: 0},
+		},
+		DefaultPriorityClass: "branch",
+	}
+
+	t.Run("admits runs on planes without a limit", func(t *testing.T) {
+		run := newRun("")
+		admitted, err := newReconciler().admitWorkflowRun(context.Background(), run, newPlane(nil))
+		if err != nil || !admitted {
+			t.Fatalf("admitWorkflowRun() = %v, %v, want true, nil", admitted, err)
+		}
+		if run.Status.Queue != nil {
+			t.Errorf("expected no queue status, got %+v", run.Status.Queue)
+		}
+	})
+
+	t.Run("admits runs while the plane has capacity", func(t *testing.T) {
+		run := newRun("")
+		r := newReconciler(newQueuedRun("b", "running", 0, 0, true))
+		admitted, err := r.admitWorkflowRun(context.Background(), run, newPlane(scheduling))
+		if err != nil || !admitted {
+			t.Fatalf("admitWorkflowRun() = %v, %v, want true, nil", admitted, err)
+		}
+		queue := run.Status.Queue
+		if queue.WorkflowPlane != testQueueKey || queue.PriorityClass != "branch" || queue.AdmittedAt == nil || queue.Position != 0 {
+			t.Errorf("unexpected queue status: %+v", queue)
+		}
+	})
+
+	t.Run("queues runs when the plane is at capacity", func(t *testing.T) {
+		run := newRun("")
+		r := newReconciler(
+			newQueuedRun("b", "running-1", 0, 0, true),
+			newQueuedRun("b", "running-2", 0, 0, true),
+			newQueuedRun("a", "waiting", 0, 0, false),
+		)
+		admitted, err := r.admitWorkflowRun(context.Background(), run, newPlane(scheduling))
+		if err != nil || admitted {
+			t.Fatalf("admitWorkflowRun() = %v, %v, want false, nil", admitted, err)
+		}
+		if run.Status.Queue.Position != 2 || run.Status.Queue.AdmittedAt != nil {
+			t.Errorf("unexpected queue status: %+v", run.Status.Queue)
+		}
+		cond := meta.FindStatusCondition(run.Status.Conditions, string(ConditionWorkflowCompleted))
+		if cond == nil || cond.Reason != string(ReasonWorkflowQueued) {
+			t.Fatalf("expected a WorkflowQueued condition, got %+v", cond)
+		}
+		if want := "Waiting at position 2 in the build queue of " + testQueueKey; cond.Message != want {
+			t.Errorf("condition message = %q, want %q", cond.Message, want)
+		}
+	})
+
+	t.Run("release runs jump ahead of waiting branch runs", func(t *testing.T) {
+		run := newRun("release")
+		r := newReconciler(
+			newQueuedRun("b", "running-1", 0, 0, true),
+			newQueuedRun("b", "running-2", 0, 0, true),
+			newQueuedRun("a", "waiting", 0, 0, false),
+		)
+		if _, err := r.admitWorkflowRun(context.Background(), run, newPlane(scheduling)); err != nil {
+			t.Fatalf("admitWorkflowRun() error = %v", err)
+		}
+		if run.Status.Queue.Priority != 100 || run.Status.Queue.Position != 1 {
+			t.Errorf("unexpected queue status: %+v", run.Status.Queue)
+		}
+	})
+
+	t.Run("completed runs do not hold capacity", func(t *testing.T) {
+		completed := newQueuedRun("b", "completed", 0, 0, true)
+		setWorkflowSucceededCondition(completed)
+		run := newRun("")
+		r := newReconciler(newQueuedRun("b", "running", 0, 0, true), completed)
+		admitted, err := r.admitWorkflowRun(context.Background(), run, newPlane(scheduling))
+		if err != nil || !admitted {
+			t.Fatalf("admitWorkflowRun() = %v, %v, want true, nil", admitted, err)
+		}
+	})
+
+	t.Run("waiting runs are admitted when the limit is removed", func(t *testing.T) {
+		run := newQueuedRun("a", "new-run", 0, 0, false)
+		admitted, err := newReconciler().admitWorkflowRun(context.Background(), run, newPlane(nil))
+		if err != nil || !admitted {
+			t.Fatalf("admitWorkflowRun() = %v, %v, want true, nil", admitted, err)
+		}
+		if run.Status.Queue.AdmittedAt == nil {
+			t.Error("expected the run to be marked as admitted")
+		}
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"fmt"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/sbomreport"
+)
+
+// syncSBOM collects the SBOM of the built image once the SBOM step of the run has finished.
+// Like test results, the SBOM is collected only once.
+func syncSBOM(workflowRun *openchoreodevv1alpha1.WorkflowRun, nodes argoproj.Nodes) {
+	if workflowRun.Status.SBOM != nil || workflowRun.Status.ResolvedTemplate == nil ||
+		workflowRun.Status.ResolvedTemplate.SBOM == nil {
+		return
+	}
+	sbom := collectSBOM(workflowRun.Status.ResolvedTemplate.SBOM, nodes)
+	if sbom == nil {
+		return
+	}
+	workflowRun.Status.SBOM = sbom
+	setSBOMCondition(workflowRun, sbom)
+}
+
+// collectSBOM reads the SBOM document from the output parameter of the SBOM step.
+// Returns nil while the SBOM step has not finished, or when it was skipped.
+func collectSBOM(spec *openchoreodevv1alpha1.WorkflowSBOMSpec, nodes argoproj.Nodes) *openchoreodevv1alpha1.WorkflowSBOM {
+	parameter := spec.GetReportParameter()
+	document, finished := readStepOutput(nodes, spec.Step, parameter)
+	if !finished {
+		return nil
+	}
+
+	var sbom *openchoreodevv1alpha1.WorkflowSBOM
+	if document == "" {
+		sbom = &openchoreodevv1alpha1.WorkflowSBOM{
+			Message: fmt.Sprintf("SBOM step %q did not produce the %q output parameter", spec.Step, parameter),
+		}
+	} else if parsed, err := sbomreport.Parse([]byte(document)); err != nil {
+		sbom = &openchoreodevv1alpha1.WorkflowSBOM{Message: err.Error()}
+	} else {
+		sbom = parsed
+	}
+	sbom.Step = spec.Step
+	return sbom
+}
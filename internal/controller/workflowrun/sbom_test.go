@@ -0,0 +1,103 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+const cycloneDXSBOM = `{"bomFormat": "CycloneDX", "specVersion": "1.4", "components": [
+  {"name": "express", "version": "4.19.2", "purl": "pkg:npm/express@4.19.2"},
+  {"name": "node", "version": "20.11.1"}
+]}`
+
+func buildStepNodes(phase argoproj.NodePhase, params ...argoproj.Parameter) argoproj.Nodes {
+	return argoproj.Nodes{
+		"build-node": {
+			Name:        "wf[1].build-image",
+			DisplayName: "build-image",
+			Type:        argoproj.NodeTypePod,
+			Phase:       phase,
+			Outputs:     &argoproj.Outputs{Parameters: params},
+		},
+	}
+}
+
+func TestCollectSBOM(t *testing.T) {
+	spec := &openchoreodevv1alpha1.WorkflowSBOMSpec{Step: "build-image"}
+
+	t.Run("returns nil while the build step is running", func(t *testing.T) {
+		if got := collectSBOM(spec, buildStepNodes(argoproj.NodeRunning)); got != nil {
+			t.Errorf("expected nil, got %+v", got)
+		}
+	})
+
+	t.Run("parses the SBOM of a finished build step", func(t *testing.T) {
+		nodes := buildStepNodes(argoproj.NodeSucceeded,
+			reportParameter(openchoreodevv1alpha1.DefaultSBOMReportParameter, cycloneDXSBOM))
+		got := collectSBOM(spec, nodes)
+		if got == nil {
+			t.Fatal("expected an SBOM, got nil")
+		}
+		if got.Step != "build-image" || got.Format != openchoreodevv1alpha1.SBOMFormatCycloneDX ||
+			got.ComponentCount != 2 || got.Digest == "" {
+			t.Errorf("unexpected SBOM: %+v", got)
+		}
+	})
+
+	t.Run("missing SBOM", func(t *testing.T) {
+		got := collectSBOM(spec, buildStepNodes(argoproj.NodeFailed))
+		if got == nil || !strings.Contains(got.Message, `did not produce the "sbom" output parameter`) {
+			t.Errorf("expected a missing-SBOM message, got %+v", got)
+		}
+	})
+
+	t.Run("invalid SBOM", func(t *testing.T) {
+		nodes := buildStepNodes(argoproj.NodeSucceeded,
+			reportParameter(openchoreodevv1alpha1.DefaultSBOMReportParameter, "express 4.19.2"))
+		got := collectSBOM(spec, nodes)
+		if got == nil || !strings.Contains(got.Message, "invalid SBOM") {
+			t.Errorf("expected an invalid-SBOM message, got %+v", got)
+		}
+	})
+}
+
+func TestSyncSBOM(t *testing.T) {
+	run := &openchoreodevv1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "run-1", Generation: 1},
+		Status: openchoreodevv1alpha1.WorkflowRunStatus{
+			ResolvedTemplate: &openchoreodevv1alpha1.ResolvedWorkflowTemplate{
+				SBOM: &openchoreodevv1alpha1.WorkflowSBOMSpec{Step: "build-image", ReportParameter: "bom"},
+			},
+		},
+	}
+	syncSBOM(run, buildStepNodes(argoproj.NodeSucceeded, reportParameter("bom", cycloneDXSBOM)))
+
+	if run.Status.SBOM == nil || run.Status.SBOM.ComponentCount != 2 {
+		t.Fatalf("expected 2 components, got %+v", run.Status.SBOM)
+	}
+	cond := meta.FindStatusCondition(run.Status.Conditions, string(ConditionSBOMCollected))
+	if cond == nil {
+		t.Fatal("expected SBOMCollected condition")
+	}
+	if cond.Status != metav1.ConditionTrue || cond.Reason != string(ReasonSBOMCollected) {
+		t.Errorf("unexpected condition: %+v", cond)
+	}
+	if cond.Message != "CycloneDX 1.4 SBOM with 2 components" {
+		t.Errorf("unexpected condition message: %q", cond.Message)
+	}
+
+	// A later reconcile must not overwrite the collected SBOM.
+	syncSBOM(run, buildStepNodes(argoproj.NodeSucceeded, reportParameter("bom", "{}")))
+	if run.Status.SBOM.Message != "" {
+		t.Errorf("expected the collected SBOM to be kept, got %+v", run.Status.SBOM)
+	}
+}
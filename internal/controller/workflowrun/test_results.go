@@ -0,0 +1,92 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"fmt"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/testreport"
+)
+
+// syncTestResults collects the test results of the run once its test step has finished.
+// Results are collected only once, since the report of a finished step does not change.
+func syncTestResults(workflowRun *openchoreodevv1alpha1.WorkflowRun, nodes argoproj.Nodes) {
+	if workflowRun.Status.TestResults != nil || workflowRun.Status.ResolvedTemplate == nil ||
+		workflowRun.Status.ResolvedTemplate.Tests == nil {
+		return
+	}
+	results := collectTestResults(workflowRun.Status.ResolvedTemplate.Tests, nodes)
+	if results == nil {
+		return
+	}
+	workflowRun.Status.TestResults = results
+	setTestsCondition(workflowRun, results)
+}
+
+// collectTestResults reads the JUnit report from the output parameter of the test step.
+// Returns nil while the test step has not finished, or when it was skipped.
+func collectTestResults(tests *openchoreodevv1alpha1.WorkflowTestSpec, nodes argoproj.Nodes) *openchoreodevv1alpha1.WorkflowTestResults {
+	parameter := tests.GetReportParameter()
+	report, finished := readStepOutput(nodes, tests.Step, parameter)
+	if !finished {
+		return nil
+	}
+
+	var results *openchoreodevv1alpha1.WorkflowTestResults
+	if report == "" {
+		results = &openchoreodevv1alpha1.WorkflowTestResults{
+			Message: fmt.Sprintf("test step %q did not produce the %q output parameter", tests.Step, parameter),
+		}
+	} else if parsed, err := testreport.Parse([]byte(report)); err != nil {
+		results = &openchoreodevv1alpha1.WorkflowTestResults{Message: err.Error()}
+	} else {
+		results = parsed
+	}
+	results.Step = tests.Step
+	results.BlocksRelease = !results.Passed && tests.BlocksRelease()
+	return results
+}
+
+// readStepOutput returns the value of an output parameter of the named workflow step.
+// finished is false while the step has not finished, or when it was skipped.
+func readStepOutput(nodes argoproj.Nodes, step, parameter string) (value string, finished bool) {
+	node := findArgoStepNode(nodes, step)
+	if node == nil {
+		return "", false
+	}
+	switch node.Phase {
+	case argoproj.NodeSucceeded, argoproj.NodeFailed, argoproj.NodeError:
+	default:
+		return "", false
+	}
+
+	if node.Outputs != nil {
+		for _, p := range node.Outputs.Parameters {
+			if p.Name == parameter && p.Value != nil {
+				return string(*p.Value), true
+			}
+		}
+	}
+	return "", true
+}
+
+// findArgoStepNode returns the pod node of the named workflow step.
+func findArgoStepNode(nodes argoproj.Nodes, step string) *argoproj.NodeStatus {
+	for id := range nodes {
+		node := nodes[id]
+		if node.Type != argoproj.NodeTypePod {
+			continue
+		}
+		name := node.DisplayName
+		if name == "" {
+			name = extractTaskNameFromArgoNodeName(node.Name)
+		}
+		if name == step {
+			return &node
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+const failingReport = `<testsuite name="unit">
+  <testcase name="a"/>
+  <testcase name="b"><failure message="boom"/></testcase>
+</testsuite>`
+
+func testStepNodes(phase argoproj.NodePhase, params ...argoproj.Parameter) argoproj.Nodes {
+	return argoproj.Nodes{
+		"build-node": {
+			Name:        "wf[0].build",
+			DisplayName: "build",
+			Type:        argoproj.NodeTypePod,
+			Phase:       argoproj.NodeSucceeded,
+		},
+		"test-node": {
+			Name:        "wf[1].unit-tests",
+			DisplayName: "unit-tests",
+			Type:        argoproj.NodeTypePod,
+			Phase:       phase,
+			Outputs:     &argoproj.Outputs{Parameters: params},
+		},
+	}
+}
+
+func reportParameter(name, report string) argoproj.Parameter {
+	value := argoproj.AnyString(report)
+	return argoproj.Parameter{Name: name, Value: &value}
+}
+
+func TestCollectTestResults(t *testing.T) {
+	tests := &openchoreodevv1alpha1.WorkflowTestSpec{Step: "unit-tests"}
+
+	t.Run("returns nil while the test step is running", func(t *testing.T) {
+		if got := collectTestResults(tests, testStepNodes(argoproj.NodeRunning)); got != nil {
+			t.Errorf("expected nil, got %+v", got)
+		}
+	})
+
+	t.Run("returns nil when the test step is not found", func(t *testing.T) {
+		other := &openchoreodevv1alpha1.WorkflowTestSpec{Step: "integration-tests"}
+		if got := collectTestResults(other, testStepNodes(argoproj.NodeFailed)); got != nil {
+			t.Errorf("expected nil, got %+v", got)
+		}
+	})
+
+	t.Run("parses the report of a failed test step", func(t *testing.T) {
+		nodes := testStepNodes(argoproj.NodeFailed,
+			reportParameter(openchoreodevv1alpha1.DefaultTestReportParameter, failingReport))
+		got := collectTestResults(tests, nodes)
+		if got == nil {
+			t.Fatal("expected results, got nil")
+		}
+		if got.Step != "unit-tests" || got.Passed || got.Tests != 2 || got.Failures != 1 {
+			t.Errorf("unexpected results: %+v", got)
+		}
+		if !got.BlocksRelease {
+			t.Error("expected failed tests to block releases by default")
+		}
+	})
+
+	t.Run("ignore policy does not block releases", func(t *testing.T) {
+		ignore := &openchoreodevv1alpha1.WorkflowTestSpec{
+			Step:          "unit-tests",
+			FailurePolicy: openchoreodevv1alpha1.WorkflowTestFailurePolicyIgnore,
+		}
+		nodes := testStepNodes(argoproj.NodeFailed,
+			reportParameter(openchoreodevv1alpha1.DefaultTestReportParameter, failingReport))
+		got := collectTestResults(ignore, nodes)
+		if got == nil || got.Passed || got.BlocksRelease {
+			t.Errorf("expected failed results that do not block releases, got %+v", got)
+		}
+	})
+
+	t.Run("custom report parameter", func(t *testing.T) {
+		custom := &openchoreodevv1alpha1.WorkflowTestSpec{Step: "unit-tests", ReportParameter: "report"}
+		nodes := testStepNodes(argoproj.NodeSucceeded,
+			reportParameter("report", `<testsuite name="unit"><testcase name="a"/></testsuite>`))
+		got := collectTestResults(custom, nodes)
+		if got == nil || !got.Passed || got.BlocksRelease || got.Tests != 1 {
+			t.Errorf("expected 1 passing test, got %+v", got)
+		}
+	})
+
+	t.Run("missing report blocks releases", func(t *testing.T) {
+		got := collectTestResults(tests, testStepNodes(argoproj.NodeError))
+		if got == nil {
+			t.Fatal("expected results, got nil")
+		}
+		if !strings.Contains(got.Message, "did not produce") || !got.BlocksRelease {
+			t.Errorf("expected a blocking missing-report message, got %+v", got)
+		}
+	})
+
+	t.Run("invalid report blocks releases", func(t *testing.T) {
+		nodes := testStepNodes(argoproj.NodeSucceeded,
+			reportParameter(openchoreodevv1alpha1.DefaultTestReportParameter, "ok 3 tests"))
+		got := collectTestResults(tests, nodes)
+		if got == nil || !strings.Contains(got.Message, "invalid JUnit report") || !got.BlocksRelease {
+			t.Errorf("expected a blocking invalid-report message, got %+v", got)
+		}
+	})
+}
+
+func TestSyncTestResults(t *testing.T) {
+	newRun := func(tests *openchoreodevv1alpha1.WorkflowTestSpec) *openchoreodevv1alpha1.WorkflowRun {
+		return &openchoreodevv1alpha1.WorkflowRun{
+			ObjectMeta: metav1.ObjectMeta{Name: "run-1", Generation: 2},
+			Status: openchoreodevv1alpha1.WorkflowRunStatus{
+				ResolvedTemplate: &openchoreodevv1alpha1.ResolvedWorkflowTemplate{Tests: tests},
+			},
+		}
+	}
+	nodes := testStepNodes(argoproj.NodeFailed,
+		reportParameter(openchoreodevv1alpha1.DefaultTestReportParameter, failingReport))
+
+	t.Run("workflow without tests is untouched", func(t *testing.T) {
+		run := newRun(nil)
+		syncTestResults(run, nodes)
+		if run.Status.TestResults != nil || len(run.Status.Conditions) != 0 {
+			t.Errorf("expected no test results, got %+v", run.Status)
+		}
+	})
+
+	t.Run("records results and the tests condition", func(t *testing.T) {
+		run := newRun(&openchoreodevv1alpha1.WorkflowTestSpec{Step: "unit-tests"})
+		syncTestResults(run, nodes)
+		if run.Status.TestResults == nil || run.Status.TestResults.Failures != 1 {
+			t.Fatalf("expected 1 failure, got %+v", run.Status.TestResults)
+		}
+		cond := meta.FindStatusCondition(run.Status.Conditions, string(ConditionTestsPassed))
+		if cond == nil {
+			t.Fatal("expected TestsPassed condition")
+		}
+		if cond.Status != metav1.ConditionFalse || cond.Reason != string(ReasonTestsFailed) {
+			t.Errorf("unexpected condition: %+v", cond)
+		}
+		if !strings.HasSuffix(cond.Message, "releases are blocked until the tests pass") {
+			t.Errorf("expected message to mention blocked releases, got %q", cond.Message)
+		}
+		if cond.ObservedGeneration != 2 {
+			t.Errorf("expected observed generation 2, got %d", cond.ObservedGeneration)
+		}
+	})
+
+	t.Run("collected results are not replaced", func(t *testing.T) {
+		run := newRun(&openchoreodevv1alpha1.WorkflowTestSpec{Step: "unit-tests"})
+		existing := &openchoreodevv1alpha1.WorkflowTestResults{Step: "unit-tests", Passed: true, Tests: 5}
+		run.Status.TestResults = existing
+		syncTestResults(run, nodes)
+		if run.Status.TestResults != existing {
+			t.Errorf("expected existing results to be kept, got %+v", run.Status.TestResults)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deploymentlock enforces ReleaseBinding deployment locks. It is shared by the
+// ReleaseBinding admission webhook and the API server so that both reject the same changes.
+package deploymentlock
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// LockedError is returned when a change is rejected by an active deployment lock.
+type LockedError struct {
+	// Binding is the name of the locked ReleaseBinding.
+	Binding string
+	// Lock is the lock that rejected the change.
+	Lock openchoreov1alpha1.DeploymentLock
+}
+
+func (e *LockedError) Error() string {
+	msg := fmt.Sprintf("release binding %q is locked until %s: %s",
+		e.Binding, e.Lock.ExpiresAt.UTC().Format(time.RFC3339), e.Lock.Reason)
+	if e.Lock.LockedBy != "" {
+		msg += fmt.Sprintf(" (locked by %s)", e.Lock.LockedBy)
+	}
+	return msg
+}
+
+// Check returns a *LockedError when cur changes anything but the lock or the maintenance of a
+// ReleaseBinding whose lock in old is active at now. Removing or replacing the lock is always
+// allowed, but must be done on its own: the lock of old applies even when cur drops it.
+// Maintenance deploys nothing and is often needed during the incident a lock is placed for.
+func Check(old, cur *openchoreov1alpha1.ReleaseBinding, now time.Time) error {
+	if old == nil || cur == nil || !old.Spec.Lock.Active(now) {
+		return nil
+	}
+	if !specChanged(&old.Spec, &cur.Spec) &&
+		old.Annotations[controller.AnnotationKeyRestartedAt] == cur.Annotations[controller.AnnotationKeyRestartedAt] {
+		return nil
+	}
+	return &LockedError{Binding: old.Name, Lock: *old.Spec.Lock}
+}
+
+// specChanged reports whether the specs differ in anything but the lock and the
+// maintenance. An empty releaseName or state in cur is treated as unchanged, matching the
+// ReleaseBinding defaulting webhook and the CRD default.
+func specChanged(old, cur *openchoreov1alpha1.ReleaseBindingSpec) bool {
+	o, c := old.DeepCopy(), cur.DeepCopy()
+	o.Lock, c.Lock = nil, nil
+	o.Maintenance, c.Maintenance = nil, nil
+	if c.ReleaseName == "" {
+		c.ReleaseName = o.ReleaseName
+	}
+	if o.State == "" {
+		o.State = openchoreov1alpha1.ReleaseStateActive
+	}
+	if c.State == "" {
+		c.State = openchoreov1alpha1.ReleaseStateActive
+	}
+	return !equality.Semantic.DeepEqual(o, c)
+}
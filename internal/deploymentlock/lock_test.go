@@ -0,0 +1,134 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentlock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+var now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func lockedBinding(expiresAt time.Time) *openchoreov1alpha1.ReleaseBinding {
+	return &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-prod"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "api"},
+			Environment: "prod",
+			ReleaseName: "api-v1",
+			State:       openchoreov1alpha1.ReleaseStateActive,
+			Lock: &openchoreov1alpha1.DeploymentLock{
+				Reason:    "INC-42 database failover",
+				LockedBy:  "alice",
+				ExpiresAt: metav1.NewTime(expiresAt),
+			},
+		},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiresAt  time.Time
+		mutate     func(*openchoreov1alpha1.ReleaseBinding)
+		wantLocked bool
+	}{
+		{
+			name:       "promotion is rejected",
+			expiresAt:  now.Add(time.Hour),
+			mutate:     func(rb *openchoreov1alpha1.ReleaseBinding) { rb.Spec.ReleaseName = "api-v2" },
+			wantLocked: true,
+		},
+		{
+			name:      "restart is rejected",
+			expiresAt: now.Add(time.Hour),
+			mutate: func(rb *openchoreov1alpha1.ReleaseBinding) {
+				rb.Annotations = map[string]string{controller.AnnotationKeyRestartedAt: now.Format(time.RFC3339)}
+			},
+			wantLocked: true,
+		},
+		{
+			name:       "undeploy is rejected",
+			expiresAt:  now.Add(time.Hour),
+			mutate:     func(rb *openchoreov1alpha1.ReleaseBinding) { rb.Spec.State = openchoreov1alpha1.ReleaseStateUndeploy },
+			wantLocked: true,
+		},
+		{
+			name:      "deploy combined with unlock is rejected",
+			expiresAt: now.Add(time.Hour),
+			mutate: func(rb *openchoreov1alpha1.ReleaseBinding) {
+				rb.Spec.Lock = nil
+				rb.Spec.ReleaseName = "api-v2"
+			},
+			wantLocked: true,
+		},
+		{
+			name:      "unlock is allowed",
+			expiresAt: now.Add(time.Hour),
+			mutate:    func(rb *openchoreov1alpha1.ReleaseBinding) { rb.Spec.Lock = nil },
+		},
+		{
+			name:      "extending the lock is allowed",
+			expiresAt: now.Add(time.Hour),
+			mutate: func(rb *openchoreov1alpha1.ReleaseBinding) {
+				rb.Spec.Lock.ExpiresAt = metav1.NewTime(now.Add(2 * time.Hour))
+			},
+		},
+		{
+			name:      "maintenance is allowed",
+			expiresAt: now.Add(time.Hour),
+			mutate: func(rb *openchoreov1alpha1.ReleaseBinding) {
+				rb.Spec.Maintenance = &openchoreov1alpha1.MaintenanceMode{Reason: "INC-42"}
+			},
+		},
+		{
+			name:      "defaulted fields are not changes",
+			expiresAt: now.Add(time.Hour),
+			mutate: func(rb *openchoreov1alpha1.ReleaseBinding) {
+				rb.Spec.ReleaseName = ""
+				rb.Spec.State = ""
+				rb.Labels = map[string]string{"team": "payments"}
+			},
+		},
+		{
+			name:      "expired lock allows changes",
+			expiresAt: now.Add(-time.Minute),
+			mutate:    func(rb *openchoreov1alpha1.ReleaseBinding) { rb.Spec.ReleaseName = "api-v2" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := lockedBinding(tt.expiresAt)
+			cur := old.DeepCopy()
+			tt.mutate(cur)
+
+			err := Check(old, cur, now)
+			if !tt.wantLocked {
+				assert.NoError(t, err)
+				return
+			}
+			var lockedErr *LockedError
+			require.ErrorAs(t, err, &lockedErr)
+			assert.Equal(t, "api-prod", lockedErr.Binding)
+			assert.Equal(t, `release binding "api-prod" is locked until 2026-03-01T13:00:00Z: INC-42 database failover (locked by alice)`, err.Error())
+		})
+	}
+}
+
+func TestCheck_Unlocked(t *testing.T) {
+	old := lockedBinding(now.Add(time.Hour))
+	old.Spec.Lock = nil
+	cur := old.DeepCopy()
+	cur.Spec.ReleaseName = "api-v2"
+	assert.NoError(t, Check(old, cur, now))
+	assert.NoError(t, Check(nil, cur, now))
+}
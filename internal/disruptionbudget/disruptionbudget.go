@@ -0,0 +1,140 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package disruptionbudget generates PodDisruptionBudgets for the multi-replica workloads
+// of a component so that node drains never evict every replica at once.
+package disruptionbudget
+
+import (
+	"reflect"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+)
+
+// Enabled reports whether PodDisruptionBudgets are generated for a deployment. A binding
+// that sets enabled decides on its own; otherwise the ComponentType's policy applies to
+// production environments only.
+func Enabled(componentType, binding *openchoreov1alpha1.DisruptionBudgetPolicy, production bool) bool {
+	if binding != nil && binding.Enabled != nil {
+		return *binding.Enabled
+	}
+	if !production {
+		return false
+	}
+	if componentType != nil {
+		return ptr.Deref(componentType.Enabled, true)
+	}
+	// A binding that only sets minAvailable still opts in.
+	return binding != nil
+}
+
+// MinAvailable returns the minAvailable override of the binding, falling back to the
+// ComponentType's. Nil means the value is derived from the replica count.
+func MinAvailable(componentType, binding *openchoreov1alpha1.DisruptionBudgetPolicy) *intstr.IntOrString {
+	if binding != nil && binding.MinAvailable != nil {
+		return binding.MinAvailable
+	}
+	if componentType != nil {
+		return componentType.MinAvailable
+	}
+	return nil
+}
+
+// Make returns a PodDisruptionBudget for every Deployment and StatefulSet with more than
+// one replica. The budget selects the workload's pods through its own selector and keeps
+// minAvailable pods running, defaulting to one less than the replica count. Workloads
+// already covered by a rendered PodDisruptionBudget with the same selector are skipped.
+func Make(resources []map[string]any, minAvailable *intstr.IntOrString) []map[string]any {
+	var existing []map[string]any
+	for _, resource := range resources {
+		if resource["apiVersion"] == "policy/v1" && resource["kind"] == "PodDisruptionBudget" {
+			existing = append(existing, selectorOf(resource))
+		}
+	}
+
+	var budgets []map[string]any
+	for _, resource := range resources {
+		if resource["apiVersion"] != "apps/v1" {
+			continue
+		}
+		if kind := resource["kind"]; kind != "Deployment" && kind != "StatefulSet" {
+			continue
+		}
+		replicas, ok := replicasOf(resource)
+		if !ok || replicas <= 1 {
+			continue
+		}
+		selector := selectorOf(resource)
+		if selector == nil || slices.ContainsFunc(existing, func(s map[string]any) bool {
+			return reflect.DeepEqual(s, selector)
+		}) {
+			continue
+		}
+
+		metadata, _ := resource["metadata"].(map[string]any)
+		name, _ := metadata["name"].(string)
+		if name == "" {
+			continue
+		}
+		budgetMetadata := map[string]any{
+			"name": dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxResourceNameLength, name, "pdb"),
+		}
+		if namespace, ok := metadata["namespace"].(string); ok && namespace != "" {
+			budgetMetadata["namespace"] = namespace
+		}
+
+		var minValue any = replicas - 1
+		if minAvailable != nil {
+			if minAvailable.Type == intstr.String {
+				minValue = minAvailable.StrVal
+			} else {
+				minValue = int64(minAvailable.IntVal)
+			}
+		}
+
+		budgets = append(budgets, map[string]any{
+			"apiVersion": "policy/v1",
+			"kind":       "PodDisruptionBudget",
+			"metadata":   budgetMetadata,
+			"spec": map[string]any{
+				"minAvailable": minValue,
+				"selector":     selector,
+			},
+		})
+	}
+	return budgets
+}
+
+// replicasOf returns spec.replicas of a workload, which Kubernetes defaults to 1.
+func replicasOf(resource map[string]any) (int64, bool) {
+	spec, _ := resource["spec"].(map[string]any)
+	switch v := spec["replicas"].(type) {
+	case nil:
+		return 1, true
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), true
+	default:
+		// Unresolved expressions cannot be reasoned about.
+		return 0, false
+	}
+}
+
+func selectorOf(resource map[string]any) map[string]any {
+	spec, _ := resource["spec"].(map[string]any)
+	selector, _ := spec["selector"].(map[string]any)
+	if len(selector) == 0 {
+		return nil
+	}
+	return selector
+}
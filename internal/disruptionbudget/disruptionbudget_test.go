@@ -0,0 +1,112 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package disruptionbudget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestEnabled(t *testing.T) {
+	on := &openchoreov1alpha1.DisruptionBudgetPolicy{}
+	off := &openchoreov1alpha1.DisruptionBudgetPolicy{Enabled: ptr.To(false)}
+	forced := &openchoreov1alpha1.DisruptionBudgetPolicy{Enabled: ptr.To(true)}
+
+	tests := []struct {
+		name          string
+		componentType *openchoreov1alpha1.DisruptionBudgetPolicy
+		binding       *openchoreov1alpha1.DisruptionBudgetPolicy
+		production    bool
+		want          bool
+	}{
+		{"not configured", nil, nil, true, false},
+		{"component type in production", on, nil, true, true},
+		{"component type outside production", on, nil, false, false},
+		{"component type disabled", off, nil, true, false},
+		{"binding disables", on, off, true, false},
+		{"binding enables outside production", nil, forced, false, true},
+		{"binding minAvailable only in production", nil, &openchoreov1alpha1.DisruptionBudgetPolicy{
+			MinAvailable: ptr.To(intstr.FromInt32(2)),
+		}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Enabled(tt.componentType, tt.binding, tt.production))
+		})
+	}
+}
+
+func TestMinAvailable(t *testing.T) {
+	ct := &openchoreov1alpha1.DisruptionBudgetPolicy{MinAvailable: ptr.To(intstr.FromString("50%"))}
+	rb := &openchoreov1alpha1.DisruptionBudgetPolicy{MinAvailable: ptr.To(intstr.FromInt32(2))}
+
+	assert.Equal(t, rb.MinAvailable, MinAvailable(ct, rb))
+	assert.Equal(t, ct.MinAvailable, MinAvailable(ct, &openchoreov1alpha1.DisruptionBudgetPolicy{}))
+	assert.Nil(t, MinAvailable(nil, nil))
+}
+
+func workload(kind, name string, replicas any) map[string]any {
+	spec := map[string]any{
+		"selector": map[string]any{"matchLabels": map[string]any{"app": name}},
+	}
+	if replicas != nil {
+		spec["replicas"] = replicas
+	}
+	return map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       kind,
+		"metadata":   map[string]any{"name": name, "namespace": "dp-acme-prod"},
+		"spec":       spec,
+	}
+}
+
+func TestMake(t *testing.T) {
+	resources := []map[string]any{
+		workload("Deployment", "api", int64(3)),
+		workload("StatefulSet", "db", float64(2)),
+		workload("Deployment", "single", int64(1)),
+		workload("Deployment", "defaulted", nil),
+		workload("Deployment", "templated", "${parameters.replicas}"),
+		{"apiVersion": "batch/v1", "kind": "Job", "metadata": map[string]any{"name": "migrate"}},
+	}
+
+	budgets := Make(resources, nil)
+	require.Len(t, budgets, 2)
+	assert.Equal(t, map[string]any{
+		"apiVersion": "policy/v1",
+		"kind":       "PodDisruptionBudget",
+		"metadata":   map[string]any{"name": budgets[0]["metadata"].(map[string]any)["name"], "namespace": "dp-acme-prod"},
+		"spec": map[string]any{
+			"minAvailable": int64(2),
+			"selector":     map[string]any{"matchLabels": map[string]any{"app": "api"}},
+		},
+	}, budgets[0])
+	assert.Equal(t, int64(1), budgets[1]["spec"].(map[string]any)["minAvailable"])
+	assert.NotEqual(t, budgets[0]["metadata"].(map[string]any)["name"], budgets[1]["metadata"].(map[string]any)["name"])
+
+	t.Run("minAvailable override", func(t *testing.T) {
+		budgets := Make(resources[:1], ptr.To(intstr.FromString("50%")))
+		require.Len(t, budgets, 1)
+		assert.Equal(t, "50%", budgets[0]["spec"].(map[string]any)["minAvailable"])
+	})
+
+	t.Run("rendered budget is kept", func(t *testing.T) {
+		rendered := map[string]any{
+			"apiVersion": "policy/v1",
+			"kind":       "PodDisruptionBudget",
+			"metadata":   map[string]any{"name": "custom"},
+			"spec": map[string]any{
+				"maxUnavailable": int64(1),
+				"selector":       map[string]any{"matchLabels": map[string]any{"app": "api"}},
+			},
+		}
+		assert.Empty(t, Make([]map[string]any{resources[0], rendered}, nil))
+	})
+}
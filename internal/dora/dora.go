@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dora derives DORA delivery metrics (deployment frequency, lead time for
+// changes, change failure rate and time to restore service) from the deployment
+// history recorded on ReleaseBindings.
+//
+// Lead time is measured from the creation of the ComponentRelease to its deployment,
+// because source commit times are not recorded on OpenChoreo resources.
+package dora
+
+import (
+	"slices"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Metrics holds the DORA metrics for a set of deployments within a time window.
+type Metrics struct {
+	WindowStart time.Time
+	WindowEnd   time.Time
+
+	// Deployments is the number of deployments that started within the window.
+	Deployments int
+	// DeploymentsPerDay is Deployments divided by the window length in days.
+	DeploymentsPerDay float64
+	// FailedDeployments is the number of deployments within the window that failed or were rolled back.
+	FailedDeployments int
+	// ChangeFailureRate is FailedDeployments divided by Deployments. Nil when there were no deployments.
+	ChangeFailureRate *float64
+	// MedianLeadTime is the median time from ComponentRelease creation to deployment.
+	MedianLeadTime *time.Duration
+	// MeanTimeToRestore is the mean time from a failure to the environment becoming ready again,
+	// over the restored failures within the window.
+	MeanTimeToRestore *time.Duration
+}
+
+// Compute derives metrics from deployment records whose DeployedAt lies in [start, end).
+func Compute(records []openchoreov1alpha1.DeploymentRecord, start, end time.Time) Metrics {
+	m := Metrics{WindowStart: start, WindowEnd: end}
+
+	var leadTimes []time.Duration
+	var restoreTotal time.Duration
+	restored := 0
+	for i := range records {
+		r := &records[i]
+		deployedAt := r.DeployedAt.Time
+		if deployedAt.Before(start) || !deployedAt.Before(end) {
+			continue
+		}
+		m.Deployments++
+		if !r.ReleaseCreatedAt.IsZero() && !deployedAt.Before(r.ReleaseCreatedAt.Time) {
+			leadTimes = append(leadTimes, deployedAt.Sub(r.ReleaseCreatedAt.Time))
+		}
+		if r.FailedAt == nil {
+			continue
+		}
+		m.FailedDeployments++
+		if r.RestoredAt != nil && !r.RestoredAt.Before(r.FailedAt) {
+			restoreTotal += r.RestoredAt.Sub(r.FailedAt.Time)
+			restored++
+		}
+	}
+
+	if days := end.Sub(start).Hours() / 24; days > 0 {
+		m.DeploymentsPerDay = float64(m.Deployments) / days
+	}
+	if m.Deployments > 0 {
+		rate := float64(m.FailedDeployments) / float64(m.Deployments)
+		m.ChangeFailureRate = &rate
+	}
+	if len(leadTimes) > 0 {
+		median := medianDuration(leadTimes)
+		m.MedianLeadTime = &median
+	}
+	if restored > 0 {
+		mean := restoreTotal / time.Duration(restored)
+		m.MeanTimeToRestore = &mean
+	}
+	return m
+}
+
+func medianDuration(d []time.Duration) time.Duration {
+	slices.Sort(d)
+	mid := len(d) / 2
+	if len(d)%2 == 1 {
+		return d[mid]
+	}
+	return (d[mid-1] + d[mid]) / 2
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dora
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func at(base time.Time, offset time.Duration) metav1.Time {
+	return metav1.NewTime(base.Add(offset))
+}
+
+func atPtr(base time.Time, offset time.Duration) *metav1.Time {
+	t := at(base, offset)
+	return &t
+}
+
+func TestCompute(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(10 * 24 * time.Hour)
+
+	records := []openchoreov1alpha1.DeploymentRecord{
+		// Before the window: ignored.
+		{Release: "r0", ReleaseCreatedAt: at(start, -3*time.Hour), DeployedAt: at(start, -time.Hour)},
+		{Release: "r1", ReleaseCreatedAt: at(start, 0), DeployedAt: at(start, time.Hour)},
+		{
+			Release: "r2", ReleaseCreatedAt: at(start, 24*time.Hour), DeployedAt: at(start, 27*time.Hour),
+			FailedAt: atPtr(start, 28*time.Hour), RestoredAt: atPtr(start, 30*time.Hour),
+		},
+		{
+			Release: "r3", ReleaseCreatedAt: at(start, 48*time.Hour), DeployedAt: at(start, 53*time.Hour),
+			FailedAt: atPtr(start, 54*time.Hour), RolledBack: true,
+		},
+		{Release: "r1", ReleaseCreatedAt: at(start, 0), DeployedAt: at(start, 56*time.Hour)},
+		// After the window: ignored.
+		{Release: "r4", ReleaseCreatedAt: at(end, 0), DeployedAt: at(end, time.Hour)},
+	}
+
+	m := Compute(records, start, end)
+	assert.Equal(t, 4, m.Deployments)
+	assert.InDelta(t, 0.4, m.DeploymentsPerDay, 1e-9)
+	assert.Equal(t, 2, m.FailedDeployments)
+	require.NotNil(t, m.ChangeFailureRate)
+	assert.InDelta(t, 0.5, *m.ChangeFailureRate, 1e-9)
+	// Lead times: 1h, 3h, 5h, 56h -> median (3h+5h)/2.
+	require.NotNil(t, m.MedianLeadTime)
+	assert.Equal(t, 4*time.Hour, *m.MedianLeadTime)
+	// Only r2 was restored.
+	require.NotNil(t, m.MeanTimeToRestore)
+	assert.Equal(t, 2*time.Hour, *m.MeanTimeToRestore)
+}
+
+func TestCompute_NoDeployments(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := Compute(nil, start, start.Add(24*time.Hour))
+	assert.Zero(t, m.Deployments)
+	assert.Zero(t, m.DeploymentsPerDay)
+	assert.Nil(t, m.ChangeFailureRate)
+	assert.Nil(t, m.MedianLeadTime)
+	assert.Nil(t, m.MeanTimeToRestore)
+}
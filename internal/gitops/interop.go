@@ -0,0 +1,168 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gitops implements interoperability with external GitOps tools.
+//
+// Argo CD and Flux running in the same cluster as a data plane can pick up or prune the
+// resources OpenChoreo renders, and both sides then keep overwriting each other. A Policy
+// adds the labels and annotations that make those tools leave rendered resources alone, and
+// selects which field managers are reported when they change fields OpenChoreo manages.
+package gitops
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// Tool is an external GitOps tool that OpenChoreo can be configured to coexist with.
+type Tool string
+
+const (
+	// ToolArgoCD marks rendered resources so that Argo CD neither reports them as
+	// out of sync nor prunes them.
+	ToolArgoCD Tool = "argocd"
+	// ToolFlux marks rendered resources so that Flux kustomizations neither reconcile
+	// nor prune them.
+	ToolFlux Tool = "flux"
+)
+
+// toolAnnotations are the annotations each tool honours to ignore a resource.
+var toolAnnotations = map[Tool]map[string]string{
+	ToolArgoCD: {
+		"argocd.argoproj.io/compare-options": "IgnoreExtraneous",
+		"argocd.argoproj.io/sync-options":    "Prune=false,Delete=false",
+	},
+	ToolFlux: {
+		"kustomize.toolkit.fluxcd.io/reconcile": "disabled",
+		"kustomize.toolkit.fluxcd.io/prune":     "disabled",
+	},
+}
+
+// Config is the interop configuration loaded from the GitOps interop config file.
+type Config struct {
+	// Tools are the GitOps tools running alongside OpenChoreo. Each adds the annotations
+	// that tell the tool to ignore rendered resources.
+	Tools []Tool `json:"tools,omitempty"`
+	// Labels are added to every rendered resource.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to every rendered resource. They take precedence over the
+	// annotations added for Tools.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// IgnoredFieldManagers are field managers whose changes to rendered resources are
+	// overwritten without being reported as conflicts, for example "kubectl-rollout".
+	// An entry ending with "*" matches every manager with that prefix.
+	IgnoredFieldManagers []string `json:"ignoredFieldManagers,omitempty"`
+}
+
+// LoadConfig reads a Config from a YAML or JSON file.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read GitOps interop config: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse GitOps interop config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate checks that every tool is supported and that labels and annotations are valid.
+func (c Config) Validate() error {
+	for i, tool := range c.Tools {
+		if _, ok := toolAnnotations[tool]; !ok {
+			return fmt.Errorf("tools[%d]: unsupported tool %q, must be one of %q or %q", i, tool, ToolArgoCD, ToolFlux)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(c.Labels)) {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("labels: invalid key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(c.Labels[key]); len(errs) > 0 {
+			return fmt.Errorf("labels[%s]: invalid value: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(c.Annotations)) {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("annotations: invalid key %q: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	for i, m := range c.IgnoredFieldManagers {
+		if strings.TrimSuffix(m, "*") == "" {
+			return fmt.Errorf("ignoredFieldManagers[%d]: manager must not be empty", i)
+		}
+	}
+	return nil
+}
+
+// Policy marks rendered resources for external GitOps tools. A nil Policy adds no markers and
+// reports every conflicting field manager.
+type Policy struct {
+	labels          map[string]string
+	annotations     map[string]string
+	ignoredManagers []string
+}
+
+// NewPolicy builds a Policy from cfg.
+func NewPolicy(cfg Config) (*Policy, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	annotations := make(map[string]string)
+	for _, tool := range cfg.Tools {
+		maps.Copy(annotations, toolAnnotations[tool])
+	}
+	maps.Copy(annotations, cfg.Annotations)
+	return &Policy{
+		labels:          maps.Clone(cfg.Labels),
+		annotations:     annotations,
+		ignoredManagers: slices.Clone(cfg.IgnoredFieldManagers),
+	}, nil
+}
+
+// Mark adds the configured labels and annotations to obj, replacing existing values.
+func (p *Policy) Mark(obj *unstructured.Unstructured) {
+	if p == nil {
+		return
+	}
+	if len(p.labels) > 0 {
+		objLabels := obj.GetLabels()
+		if objLabels == nil {
+			objLabels = make(map[string]string, len(p.labels))
+		}
+		maps.Copy(objLabels, p.labels)
+		obj.SetLabels(objLabels)
+	}
+	if len(p.annotations) > 0 {
+		objAnnotations := obj.GetAnnotations()
+		if objAnnotations == nil {
+			objAnnotations = make(map[string]string, len(p.annotations))
+		}
+		maps.Copy(objAnnotations, p.annotations)
+		obj.SetAnnotations(objAnnotations)
+	}
+}
+
+// Reported reports whether a conflict with the given field manager should be surfaced.
+func (p *Policy) Reported(manager string) bool {
+	if p == nil {
+		return true
+	}
+	for _, ignored := range p.ignoredManagers {
+		if prefix, ok := strings.CutSuffix(ignored, "*"); ok {
+			if strings.HasPrefix(manager, prefix) {
+				return false
+			}
+		} else if manager == ignored {
+			return false
+		}
+	}
+	return true
+}
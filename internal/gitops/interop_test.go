@@ -0,0 +1,96 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitops
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMark(t *testing.T) {
+	p, err := NewPolicy(Config{
+		Tools:       []Tool{ToolArgoCD, ToolFlux},
+		Labels:      map[string]string{"team": "platform"},
+		Annotations: map[string]string{"argocd.argoproj.io/sync-options": "Prune=false"},
+	})
+	require.NoError(t, err)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetLabels(map[string]string{"app": "web"})
+	p.Mark(obj)
+
+	assert.Equal(t, map[string]string{"app": "web", "team": "platform"}, obj.GetLabels())
+	assert.Equal(t, map[string]string{
+		"argocd.argoproj.io/compare-options":    "IgnoreExtraneous",
+		"argocd.argoproj.io/sync-options":       "Prune=false",
+		"kustomize.toolkit.fluxcd.io/reconcile": "disabled",
+		"kustomize.toolkit.fluxcd.io/prune":     "disabled",
+	}, obj.GetAnnotations())
+
+	var nilPolicy *Policy
+	untouched := &unstructured.Unstructured{}
+	nilPolicy.Mark(untouched)
+	assert.Nil(t, untouched.GetLabels())
+	assert.Nil(t, untouched.GetAnnotations())
+}
+
+func TestReported(t *testing.T) {
+	p, err := NewPolicy(Config{IgnoredFieldManagers: []string{"kubectl-rollout", "vpa-*"}})
+	require.NoError(t, err)
+
+	assert.False(t, p.Reported("kubectl-rollout"))
+	assert.False(t, p.Reported("vpa-updater"))
+	assert.True(t, p.Reported("argocd-controller"))
+	assert.True(t, p.Reported("kubectl-rollout-2"))
+
+	var nilPolicy *Policy
+	assert.True(t, nilPolicy.Reported("kubectl-rollout"))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{name: "valid", cfg: Config{Tools: []Tool{ToolFlux}, Labels: map[string]string{"example.com/owner": "openchoreo"}}},
+		{name: "unsupported tool", cfg: Config{Tools: []Tool{"jenkins"}}, wantErr: `tools[0]: unsupported tool "jenkins"`},
+		{name: "invalid label key", cfg: Config{Labels: map[string]string{"bad key": "x"}}, wantErr: `labels: invalid key "bad key"`},
+		{name: "invalid label value", cfg: Config{Labels: map[string]string{"owner": "not valid!"}}, wantErr: "labels[owner]: invalid value"},
+		{name: "invalid annotation key", cfg: Config{Annotations: map[string]string{"/x": ""}}, wantErr: `annotations: invalid key "/x"`},
+		{name: "empty ignored manager", cfg: Config{IgnoredFieldManagers: []string{"*"}}, wantErr: "ignoredFieldManagers[0]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gitops-interop.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`tools: [argocd]
+ignoredFieldManagers: [kubectl-rollout]
+`), 0o600))
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, []Tool{ToolArgoCD}, cfg.Tools)
+	assert.Equal(t, []string{"kubectl-rollout"}, cfg.IgnoredFieldManagers)
+
+	require.NoError(t, os.WriteFile(path, []byte("tool: [argocd]\n"), 0o600))
+	_, err = LoadConfig(path)
+	assert.Error(t, err)
+}
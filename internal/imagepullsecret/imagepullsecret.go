@@ -0,0 +1,173 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepullsecret
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/podspec"
+)
+
+// defaultRefreshInterval matches the SecretReference refreshInterval default.
+const defaultRefreshInterval = "1h"
+
+// Credential pairs a DataPlane registry credential with its resolved SecretReference.
+type Credential struct {
+	Name            string
+	SecretReference *openchoreov1alpha1.SecretReference
+}
+
+// Params holds parameters for generating the image pull secrets of a component.
+type Params struct {
+	Namespace       string       // data plane namespace name
+	ComponentName   string       // for naming the secrets
+	SecretStoreName string       // ClusterSecretStore in the data plane
+	Credentials     []Credential // registry credentials of the data plane
+}
+
+// SecretName returns the name of the image pull secret created for a component from the
+// named registry credential. Secrets are named per component so that the Releases of
+// components sharing a namespace never own the same object.
+func SecretName(componentName, credentialName string) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxResourceNameLength,
+		componentName, credentialName, "pull")
+}
+
+// MakeExternalSecrets returns one ExternalSecret per credential that materializes a
+// kubernetes.io/dockerconfigjson Secret in the target namespace, together with the names
+// of the resulting Secrets in credential order.
+func MakeExternalSecrets(params Params) ([]map[string]any, []string, error) {
+	if len(params.Credentials) == 0 {
+		return nil, nil, nil
+	}
+
+	resources := make([]map[string]any, 0, len(params.Credentials))
+	names := make([]string, 0, len(params.Credentials))
+	for _, cred := range params.Credentials {
+		ref := cred.SecretReference
+		if ref == nil {
+			return nil, nil, fmt.Errorf("registry credential %q has no SecretReference", cred.Name)
+		}
+		if ref.Spec.Template.Type != corev1.SecretTypeDockerConfigJson {
+			return nil, nil, fmt.Errorf("registry credential %q: SecretReference %q must have template type %s, got %q",
+				cred.Name, ref.Name, corev1.SecretTypeDockerConfigJson, ref.Spec.Template.Type)
+		}
+		// Vault-backed credentials are redirected to their own SecretStore by the vaultsecret
+		// package, so only the others need the data plane's secret store.
+		if vault := ref.Spec.Vault; vault != nil {
+			if vault.Delivery == openchoreov1alpha1.VaultDeliveryCSI {
+				return nil, nil, fmt.Errorf("registry credential %q: SecretReference %q is delivered through CSI, which cannot provide image pull secrets",
+					cred.Name, ref.Name)
+			}
+		} else if params.SecretStoreName == "" {
+			return nil, nil, fmt.Errorf("registry credentials require the data plane to configure a secretStoreRef")
+		}
+		name := SecretName(params.ComponentName, cred.Name)
+		resources = append(resources, makeExternalSecret(name, params.Namespace, params.SecretStoreName, ref))
+		names = append(names, name)
+	}
+	return resources, names, nil
+}
+
+func makeExternalSecret(name, namespace, secretStoreName string, ref *openchoreov1alpha1.SecretReference) map[string]any {
+	data := make([]any, 0, len(ref.Spec.Data))
+	for _, d := range ref.Spec.Data {
+		remoteRef := map[string]any{"key": d.RemoteRef.Key}
+		if d.RemoteRef.Property != "" {
+			remoteRef["property"] = d.RemoteRef.Property
+		}
+		if d.RemoteRef.Version != "" {
+			remoteRef["version"] = d.RemoteRef.Version
+		}
+		data = append(data, map[string]any{
+			"secretKey": d.SecretKey,
+			"remoteRef": remoteRef,
+		})
+	}
+
+	template := map[string]any{"type": string(corev1.SecretTypeDockerConfigJson)}
+	if md := ref.Spec.Template.Metadata; md != nil && (len(md.Labels) > 0 || len(md.Annotations) > 0) {
+		metadata := map[string]any{}
+		if len(md.Labels) > 0 {
+			metadata["labels"] = toAnyMap(md.Labels)
+		}
+		if len(md.Annotations) > 0 {
+			metadata["annotations"] = toAnyMap(md.Annotations)
+		}
+		template["metadata"] = metadata
+	}
+
+	refreshInterval := defaultRefreshInterval
+	if ref.Spec.RefreshInterval != nil {
+		refreshInterval = ref.Spec.RefreshInterval.Duration.String()
+	}
+
+	spec := map[string]any{
+		"refreshInterval": refreshInterval,
+		"target": map[string]any{
+			"name":           name,
+			"creationPolicy": "Owner",
+			"template":       template,
+		},
+		"data": data,
+	}
+	if secretStoreName != "" {
+		spec["secretStoreRef"] = map[string]any{
+			"kind": "ClusterSecretStore",
+			"name": secretStoreName,
+		}
+	}
+	return map[string]any{
+		"apiVersion": "external-secrets.io/v1",
+		"kind":       "ExternalSecret",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": spec,
+	}
+}
+
+// Attach adds the named image pull secrets to the pod spec of every workload resource,
+// keeping any pull secrets the template already set. Resources that carry no pod spec
+// are left untouched.
+func Attach(resources []map[string]any, names []string) {
+	if len(names) == 0 {
+		return
+	}
+	for _, resource := range resources {
+		podSpec := podspec.Find(resource)
+		if podSpec == nil {
+			continue
+		}
+		existing, _ := podSpec["imagePullSecrets"].([]any)
+		seen := make(map[string]struct{}, len(existing))
+		for _, e := range existing {
+			if m, ok := e.(map[string]any); ok {
+				if n, ok := m["name"].(string); ok {
+					seen[n] = struct{}{}
+				}
+			}
+		}
+		for _, name := range names {
+			if _, dup := seen[name]; dup {
+				continue
+			}
+			existing = append(existing, map[string]any{"name": name})
+		}
+		podSpec["imagePullSecrets"] = existing
+	}
+}
+
+func toAnyMap(m map[string]string) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
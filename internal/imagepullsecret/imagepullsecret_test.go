@@ -0,0 +1,166 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepullsecret
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/podspec"
+)
+
+func dockerConfigRef(name string) *openchoreov1alpha1.SecretReference {
+	return &openchoreov1alpha1.SecretReference{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme"},
+		Spec: openchoreov1alpha1.SecretReferenceSpec{
+			Template: openchoreov1alpha1.SecretTemplate{Type: corev1.SecretTypeDockerConfigJson},
+			Data: []openchoreov1alpha1.SecretDataSource{{
+				SecretKey: ".dockerconfigjson",
+				RemoteRef: openchoreov1alpha1.RemoteReference{Key: "registry/ghcr", Property: "config"},
+			}},
+		},
+	}
+}
+
+func TestMakeExternalSecrets(t *testing.T) {
+	ref := dockerConfigRef("ghcr-creds")
+	ref.Spec.RefreshInterval = &metav1.Duration{Duration: 15 * time.Minute}
+
+	resources, names, err := MakeExternalSecrets(Params{
+		Namespace:       "dp-acme-dev",
+		ComponentName:   "reading-list",
+		SecretStoreName: "default",
+		Credentials:     []Credential{{Name: "ghcr", SecretReference: ref}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	require.Equal(t, []string{SecretName("reading-list", "ghcr")}, names)
+
+	assert.Equal(t, map[string]any{
+		"apiVersion": "external-secrets.io/v1",
+		"kind":       "ExternalSecret",
+		"metadata": map[string]any{
+			"name":      names[0],
+			"namespace": "dp-acme-dev",
+		},
+		"spec": map[string]any{
+			"refreshInterval": "15m0s",
+			"secretStoreRef":  map[string]any{"kind": "ClusterSecretStore", "name": "default"},
+			"target": map[string]any{
+				"name":           names[0],
+				"creationPolicy": "Owner",
+				"template":       map[string]any{"type": "kubernetes.io/dockerconfigjson"},
+			},
+			"data": []any{map[string]any{
+				"secretKey": ".dockerconfigjson",
+				"remoteRef": map[string]any{"key": "registry/ghcr", "property": "config"},
+			}},
+		},
+	}, resources[0])
+}
+
+func TestMakeExternalSecretsErrors(t *testing.T) {
+	t.Run("no credentials", func(t *testing.T) {
+		resources, names, err := MakeExternalSecrets(Params{Namespace: "ns"})
+		require.NoError(t, err)
+		assert.Nil(t, resources)
+		assert.Nil(t, names)
+	})
+
+	t.Run("missing secret store", func(t *testing.T) {
+		_, _, err := MakeExternalSecrets(Params{
+			Credentials: []Credential{{Name: "ghcr", SecretReference: dockerConfigRef("ghcr-creds")}},
+		})
+		require.ErrorContains(t, err, "secretStoreRef")
+	})
+
+	t.Run("wrong secret type", func(t *testing.T) {
+		ref := dockerConfigRef("opaque")
+		ref.Spec.Template.Type = corev1.SecretTypeOpaque
+		_, _, err := MakeExternalSecrets(Params{
+			SecretStoreName: "default",
+			Credentials:     []Credential{{Name: "ghcr", SecretReference: ref}},
+		})
+		require.ErrorContains(t, err, `SecretReference "opaque" must have template type kubernetes.io/dockerconfigjson`)
+	})
+
+	t.Run("vault credential delivered through CSI", func(t *testing.T) {
+		ref := dockerConfigRef("ghcr-creds")
+		ref.Spec.Vault = &openchoreov1alpha1.VaultSource{
+			Server:   "https://vault.example.com",
+			Path:     "secret",
+			Auth:     openchoreov1alpha1.VaultAuth{Role: "registry"},
+			Delivery: openchoreov1alpha1.VaultDeliveryCSI,
+		}
+		_, _, err := MakeExternalSecrets(Params{
+			Credentials: []Credential{{Name: "ghcr", SecretReference: ref}},
+		})
+		require.ErrorContains(t, err, "delivered through CSI")
+	})
+}
+
+func TestMakeExternalSecretsVault(t *testing.T) {
+	ref := dockerConfigRef("ghcr-creds")
+	ref.Spec.Vault = &openchoreov1alpha1.VaultSource{
+		Server: "https://vault.example.com",
+		Path:   "secret",
+		Auth:   openchoreov1alpha1.VaultAuth{Role: "registry"},
+	}
+
+	// Vault-backed credentials need no data plane secret store; the ExternalSecret is pointed
+	// at the generated Vault SecretStore afterwards.
+	resources, _, err := MakeExternalSecrets(Params{
+		Namespace:     "dp-acme-dev",
+		ComponentName: "reading-list",
+		Credentials:   []Credential{{Name: "ghcr", SecretReference: ref}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.NotContains(t, resources[0]["spec"], "secretStoreRef")
+}
+
+func TestAttach(t *testing.T) {
+	podSpec := func() map[string]any {
+		return map[string]any{"containers": []any{map[string]any{"name": "main"}}}
+	}
+	deployment := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+			"containers":       []any{map[string]any{"name": "main"}},
+			"imagePullSecrets": []any{map[string]any{"name": "existing"}, map[string]any{"name": "a"}},
+		}}},
+	}
+	cronJob := map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "CronJob",
+		"spec": map[string]any{"jobTemplate": map[string]any{"spec": map[string]any{
+			"template": map[string]any{"spec": podSpec()},
+		}}},
+	}
+	service := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"spec":       map[string]any{"ports": []any{}},
+	}
+
+	Attach([]map[string]any{deployment, cronJob, service}, []string{"a", "b"})
+
+	assert.Equal(t, []any{
+		map[string]any{"name": "existing"},
+		map[string]any{"name": "a"},
+		map[string]any{"name": "b"},
+	}, podspec.Find(deployment)["imagePullSecrets"])
+	assert.Equal(t, []any{
+		map[string]any{"name": "a"},
+		map[string]any{"name": "b"},
+	}, podspec.Find(cronJob)["imagePullSecrets"])
+	assert.NotContains(t, service["spec"], "imagePullSecrets")
+}
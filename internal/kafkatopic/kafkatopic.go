@@ -0,0 +1,90 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkatopic
+
+import (
+	"sort"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+)
+
+const (
+	// apiVersion is the Strimzi API version of the rendered KafkaTopic resources.
+	apiVersion = "kafka.strimzi.io/v1beta2"
+
+	// clusterLabel selects the Strimzi Kafka cluster whose topic operator manages a KafkaTopic.
+	clusterLabel = "strimzi.io/cluster"
+)
+
+// Params holds parameters for generating the Kafka topics of a component.
+type Params struct {
+	Namespace     string                                         // data plane namespace name
+	ComponentName string                                         // for naming the topics
+	Endpoints     map[string]openchoreov1alpha1.WorkloadEndpoint // from workload spec
+}
+
+// Name returns the name of the KafkaTopic resource rendered for the named endpoint of a
+// component. The Kafka topic itself is named by spec.topicName, so that topic names that
+// are not valid Kubernetes names are supported.
+func Name(componentName, endpointName string) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxResourceNameLength,
+		componentName, endpointName)
+}
+
+// Make returns a Strimzi KafkaTopic for each Kafka endpoint that requests topic
+// provisioning, ordered by endpoint name.
+func Make(params Params) []map[string]any {
+	names := make([]string, 0, len(params.Endpoints))
+	for name, ep := range params.Endpoints {
+		if ep.Type == openchoreov1alpha1.EndpointTypeKafka && ep.Async != nil && ep.Async.KafkaTopic != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	topics := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		async := params.Endpoints[name].Async
+		topics = append(topics, makeKafkaTopic(Name(params.ComponentName, name), params.Namespace, async))
+	}
+	return topics
+}
+
+func makeKafkaTopic(name, namespace string, async *openchoreov1alpha1.AsyncEndpoint) map[string]any {
+	provisioning := async.KafkaTopic
+	if provisioning.Namespace != "" {
+		namespace = provisioning.Namespace
+	}
+
+	spec := map[string]any{
+		"topicName": async.Channel,
+	}
+	if provisioning.Partitions != nil {
+		spec["partitions"] = int64(*provisioning.Partitions)
+	}
+	if provisioning.Replicas != nil {
+		spec["replicas"] = int64(*provisioning.Replicas)
+	}
+	if len(provisioning.Config) > 0 {
+		config := make(map[string]any, len(provisioning.Config))
+		for k, v := range provisioning.Config {
+			config[k] = v
+		}
+		spec["config"] = config
+	}
+
+	return map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       "KafkaTopic",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+			"labels": map[string]any{
+				clusterLabel: provisioning.Cluster,
+			},
+		},
+		"spec": spec,
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkatopic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestMake(t *testing.T) {
+	endpoints := map[string]openchoreov1alpha1.WorkloadEndpoint{
+		"http": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 8080},
+		"orders": {
+			Type: openchoreov1alpha1.EndpointTypeKafka,
+			Port: 9092,
+			Async: &openchoreov1alpha1.AsyncEndpoint{
+				Channel: "shop.orders.v1",
+				Broker:  "events-kafka-bootstrap.kafka",
+				KafkaTopic: &openchoreov1alpha1.KafkaTopicProvisioning{
+					Cluster:    "events",
+					Namespace:  "kafka",
+					Partitions: ptr.To[int32](6),
+					Replicas:   ptr.To[int32](3),
+					Config:     map[string]string{"retention.ms": "604800000"},
+				},
+			},
+		},
+		"audit": {
+			Type: openchoreov1alpha1.EndpointTypeKafka,
+			Port: 9092,
+			Async: &openchoreov1alpha1.AsyncEndpoint{
+				Channel:    "audit",
+				Broker:     "events-kafka-bootstrap.kafka",
+				KafkaTopic: &openchoreov1alpha1.KafkaTopicProvisioning{Cluster: "events"},
+			},
+		},
+		"unprovisioned": {
+			Type:  openchoreov1alpha1.EndpointTypeKafka,
+			Port:  9092,
+			Async: &openchoreov1alpha1.AsyncEndpoint{Channel: "existing", Broker: "events-kafka-bootstrap.kafka"},
+		},
+	}
+
+	topics := Make(Params{Namespace: "dp-shop-dev", ComponentName: "checkout", Endpoints: endpoints})
+	require.Len(t, topics, 2)
+
+	// Topics are ordered by endpoint name and default to the component namespace.
+	assert.Equal(t, map[string]any{
+		"apiVersion": "kafka.strimzi.io/v1beta2",
+		"kind":       "KafkaTopic",
+		"metadata": map[string]any{
+			"name":      Name("checkout", "audit"),
+			"namespace": "dp-shop-dev",
+			"labels":    map[string]any{"strimzi.io/cluster": "events"},
+		},
+		"spec": map[string]any{"topicName": "audit"},
+	}, topics[0])
+
+	assert.Equal(t, map[string]any{
+		"apiVersion": "kafka.strimzi.io/v1beta2",
+		"kind":       "KafkaTopic",
+		"metadata": map[string]any{
+			"name":      Name("checkout", "orders"),
+			"namespace": "kafka",
+			"labels":    map[string]any{"strimzi.io/cluster": "events"},
+		},
+		"spec": map[string]any{
+			"topicName":  "shop.orders.v1",
+			"partitions": int64(6),
+			"replicas":   int64(3),
+			"config":     map[string]any{"retention.ms": "604800000"},
+		},
+	}, topics[1])
+}
+
+func TestMake_NoKafkaEndpoints(t *testing.T) {
+	topics := Make(Params{
+		Namespace:     "dp-shop-dev",
+		ComponentName: "checkout",
+		Endpoints: map[string]openchoreov1alpha1.WorkloadEndpoint{
+			"http": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 8080},
+		},
+	})
+	assert.Empty(t, topics)
+}
@@ -56,6 +56,10 @@ const (
 	// created by the observabilityalertsnotificationchannel controller.
 	LabelKeyNotificationChannelName = "openchoreo.dev/notification-channel-name"
 
+	// LabelKeyObservabilityPlaneName identifies the ObservabilityPlane that provisioned a Prometheus
+	// Operator resource in the observability plane cluster.
+	LabelKeyObservabilityPlaneName = "openchoreo.dev/observabilityplane"
+
 	// LabelKeyEndpointName identifies the workload endpoint name associated with a rendered gateway resource (e.g. HTTPRoute).
 	LabelKeyEndpointName = "openchoreo.dev/endpoint-name"
 
@@ -75,6 +79,24 @@ const (
 	// network access to user workloads. Used in NetworkPolicy rules to allow ingress from system components.
 	LabelKeySystemComponent = "openchoreo.dev/system-component"
 
+	// LabelKeyTeam, LabelKeyDomain and LabelKeyTier carry a component's catalog metadata
+	// (spec.catalog) on the component and on every resource rendered for it.
+	LabelKeyTeam   = "openchoreo.dev/team"
+	LabelKeyDomain = "openchoreo.dev/domain"
+	LabelKeyTier   = "openchoreo.dev/tier"
+
+	// LabelKeyArchived marks an archived component (spec.state Archived) so that component lists
+	// can leave archived components out with a label selector.
+	LabelKeyArchived = "openchoreo.dev/archived"
+
+	// LabelKeyRolloutTrack tells apart the pods, Services and routes of the release running in an
+	// environment from those of the release rolled out next to it by a canary or blue-green rollout.
+	LabelKeyRolloutTrack = "openchoreo.dev/rollout-track"
+
+	// LabelKeyDomainMapping names the DomainMapping a Certificate, listener set or route was
+	// rendered for, so that they are not mistaken for the resources of the endpoint itself.
+	LabelKeyDomainMapping = "openchoreo.dev/domain-mapping"
+
 	// AnnotationKeyDPResourceHash contains a hash of all dataplane resources (excluding the main workload)
 	// to trigger pod rollout when dependent ConfigMaps, Secrets, etc. change.
 	AnnotationKeyDPResourceHash = "openchoreo.dev/dp-resource-hash"
@@ -86,7 +108,45 @@ const (
 	// the controller falls back to the first route match path (the prefix-routing convention).
 	AnnotationKeyEndpointBasePath = "openchoreo.dev/endpoint-base-path"
 
+	// AnnotationKeyExternalID records an identifier assigned by an external system, such as the
+	// ID of a Terraform or Pulumi resource, so the resource can be looked up by that ID.
+	AnnotationKeyExternalID = "openchoreo.dev/external-id"
+
+	// AnnotationKeyOnCall and AnnotationKeyRepository carry the on-call and source repository
+	// links of a component's catalog metadata. They are annotations since URLs are not valid label values.
+	AnnotationKeyOnCall     = "openchoreo.dev/oncall"
+	AnnotationKeyRepository = "openchoreo.dev/repository"
+
+	// AnnotationKeySourceCommit records the source commit a Workload was built from. It is set by
+	// the build workflow and copied to the ComponentReleases created from the Workload.
+	AnnotationKeySourceCommit = "openchoreo.dev/source-commit"
+
+	// AnnotationKeyDirectoryGroup and AnnotationKeyDirectoryUser record the directory group and user
+	// an authz role binding was synced from.
+	AnnotationKeyDirectoryGroup = "openchoreo.dev/directory-group"
+	AnnotationKeyDirectoryUser  = "openchoreo.dev/directory-user"
+
+	// AnnotationKeyRenderedRelease and AnnotationKeyReleaseBinding identify the rendered release
+	// ("<namespace>/<name>") and the binding ("<kind>/<name>") that own a resource rendered to a
+	// data plane, so that external GitOps tools and operators can trace it back to OpenChoreo.
+	AnnotationKeyRenderedRelease = "openchoreo.dev/rendered-release"
+	AnnotationKeyReleaseBinding  = "openchoreo.dev/release-binding"
+
+	// LabelKeyAppManagedBy is the well-known Kubernetes label naming the tool that manages a resource.
+	// Resources rendered to a data plane carry LabelValueAppManagedBy.
+	LabelKeyAppManagedBy   = "app.kubernetes.io/managed-by"
+	LabelValueAppManagedBy = "openchoreo"
+
 	LabelValueManagedBy = "openchoreo-control-plane"
+	// LabelValueManagedByDirectorySync marks authz role bindings that are owned by the directory sync.
+	LabelValueManagedByDirectorySync = "directory-sync"
 	// LabelValueTrue is the standard "true" value for boolean labels
 	LabelValueTrue = "true"
+
+	// LabelValueRolloutTrackStable, LabelValueRolloutTrackCanary and LabelValueRolloutTrackPreview
+	// are the rollout tracks of the running release and of the release rolled out by a canary or
+	// a blue-green rollout.
+	LabelValueRolloutTrackStable  = "stable"
+	LabelValueRolloutTrackCanary  = "canary"
+	LabelValueRolloutTrackPreview = "preview"
 )
@@ -0,0 +1,205 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package logmetric derives counters and histograms from log lines.
+package logmetric
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Type is the kind of metric derived from log lines.
+type Type string
+
+const (
+	// TypeCounter counts the log lines that match the pattern.
+	TypeCounter Type = "counter"
+	// TypeHistogram observes the value extracted from each matching log line.
+	TypeHistogram Type = "histogram"
+)
+
+// ValueGroup is the name of the capture group that holds the observed value of a histogram.
+const ValueGroup = "value"
+
+// DefaultBuckets are the histogram bucket upper bounds used when none are configured.
+// They suit durations in seconds.
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Definition describes how a metric is derived from log lines.
+type Definition struct {
+	Type Type
+	// Pattern is an RE2 regular expression that log lines must match. Histograms need a
+	// capture group named "value" that holds a number.
+	Pattern string
+	// Buckets are the histogram bucket upper bounds in ascending order.
+	Buckets []float64
+}
+
+// Extractor matches log lines against a compiled definition.
+type Extractor struct {
+	typ        Type
+	re         *regexp.Regexp
+	valueIndex int
+	buckets    []float64
+}
+
+// Compile validates a definition and compiles its pattern.
+func Compile(def Definition) (*Extractor, error) {
+	if def.Type != TypeCounter && def.Type != TypeHistogram {
+		return nil, fmt.Errorf("invalid type %q: must be %q or %q", def.Type, TypeCounter, TypeHistogram)
+	}
+	re, err := regexp.Compile(def.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	e := &Extractor{typ: def.Type, re: re, valueIndex: -1}
+	if def.Type == TypeCounter {
+		return e, nil
+	}
+
+	e.valueIndex = re.SubexpIndex(ValueGroup)
+	if e.valueIndex < 0 {
+		return nil, fmt.Errorf("histogram pattern must contain a capture group named %q", ValueGroup)
+	}
+	e.buckets = def.Buckets
+	if len(e.buckets) == 0 {
+		e.buckets = DefaultBuckets
+	}
+	for i := range e.buckets {
+		if math.IsNaN(e.buckets[i]) || math.IsInf(e.buckets[i], 0) {
+			return nil, fmt.Errorf("bucket %d must be a finite number", i)
+		}
+		if i > 0 && e.buckets[i] <= e.buckets[i-1] {
+			return nil, fmt.Errorf("buckets must be in strictly ascending order")
+		}
+	}
+	return e, nil
+}
+
+// ParseBuckets parses bucket upper bounds given as decimal strings.
+func ParseBuckets(values []string) ([]float64, error) {
+	buckets := make([]float64, 0, len(values))
+	for _, v := range values {
+		b, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bucket %q: %w", v, err)
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, nil
+}
+
+// Match reports whether the line matches the pattern and, for histograms, returns the
+// extracted value. Histogram lines whose value is not a number do not match.
+func (e *Extractor) Match(line string) (float64, bool) {
+	if e.valueIndex < 0 {
+		return 0, e.re.MatchString(line)
+	}
+	m := e.re.FindStringSubmatch(line)
+	if m == nil {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(m[e.valueIndex], 64)
+	if err != nil || math.IsNaN(v) {
+		return 0, false
+	}
+	return v, true
+}
+
+// Buckets returns the histogram bucket upper bounds, or nil for counters.
+func (e *Extractor) Buckets() []float64 {
+	return e.buckets
+}
+
+// Series is a metric aggregated into fixed steps.
+type Series struct {
+	// Steps holds one aggregate per step, starting at the range start.
+	Steps []Step
+	// BucketCounts are the cumulative histogram bucket counts over the whole range, one per
+	// bucket plus a final +Inf bucket. Nil for counters.
+	BucketCounts []int64
+}
+
+// Step is the aggregate of one step of a series.
+type Step struct {
+	Start time.Time
+	// Count is the number of matching lines in the step.
+	Count int64
+	// Values are the extracted histogram values in the step, sorted in ascending order.
+	Values []float64
+}
+
+// Sum returns the sum of the step's values.
+func (s Step) Sum() float64 {
+	var sum float64
+	for _, v := range s.Values {
+		sum += v
+	}
+	return sum
+}
+
+// Quantile returns the q-quantile of the step's values using the nearest-rank method, or
+// false when the step has no values.
+func (s Step) Quantile(q float64) (float64, bool) {
+	if len(s.Values) == 0 {
+		return 0, false
+	}
+	rank := int(math.Ceil(q*float64(len(s.Values)))) - 1
+	rank = max(0, min(rank, len(s.Values)-1))
+	return s.Values[rank], true
+}
+
+// Line is a timestamped log line.
+type Line struct {
+	Timestamp time.Time
+	Text      string
+}
+
+// Aggregate evaluates lines in [start, end) into steps of the given size. Lines outside the
+// range are ignored.
+func (e *Extractor) Aggregate(lines []Line, start, end time.Time, step time.Duration) Series {
+	n := 0
+	if end.After(start) && step > 0 {
+		n = int((end.Sub(start) + step - 1) / step)
+	}
+	series := Series{Steps: make([]Step, n)}
+	for i := range series.Steps {
+		series.Steps[i].Start = start.Add(time.Duration(i) * step)
+	}
+	if e.typ == TypeHistogram {
+		series.BucketCounts = make([]int64, len(e.buckets)+1)
+	}
+
+	for _, l := range lines {
+		if l.Timestamp.Before(start) || !l.Timestamp.Before(end) {
+			continue
+		}
+		v, ok := e.Match(l.Text)
+		if !ok {
+			continue
+		}
+		s := &series.Steps[int(l.Timestamp.Sub(start)/step)]
+		s.Count++
+		if e.typ != TypeHistogram {
+			continue
+		}
+		s.Values = append(s.Values, v)
+		for i, b := range e.buckets {
+			if v <= b {
+				series.BucketCounts[i]++
+			}
+		}
+		series.BucketCounts[len(e.buckets)]++
+	}
+
+	for i := range series.Steps {
+		sort.Float64s(series.Steps[i].Values)
+	}
+	return series
+}
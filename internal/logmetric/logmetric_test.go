@@ -0,0 +1,107 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logmetric
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name    string
+		def     Definition
+		wantErr string
+	}{
+		{name: "counter", def: Definition{Type: TypeCounter, Pattern: `ERROR`}},
+		{name: "histogram", def: Definition{Type: TypeHistogram, Pattern: `took (?P<value>[0-9.]+)s`}},
+		{name: "invalid type", def: Definition{Type: "gauge", Pattern: `x`}, wantErr: "invalid type"},
+		{name: "invalid pattern", def: Definition{Type: TypeCounter, Pattern: `(`}, wantErr: "invalid pattern"},
+		{
+			name:    "histogram without value group",
+			def:     Definition{Type: TypeHistogram, Pattern: `took ([0-9.]+)s`},
+			wantErr: `capture group named "value"`,
+		},
+		{
+			name:    "unsorted buckets",
+			def:     Definition{Type: TypeHistogram, Pattern: `(?P<value>\d+)`, Buckets: []float64{1, 1}},
+			wantErr: "ascending",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.def)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestParseBuckets(t *testing.T) {
+	buckets, err := ParseBuckets([]string{"0.1", "1", "10"})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.1, 1, 10}, buckets)
+
+	_, err = ParseBuckets([]string{"fast"})
+	assert.Error(t, err)
+}
+
+func TestAggregateCounter(t *testing.T) {
+	e, err := Compile(Definition{Type: TypeCounter, Pattern: `level=error`})
+	require.NoError(t, err)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	lines := []Line{
+		{Timestamp: start.Add(10 * time.Second), Text: "level=error msg=a"},
+		{Timestamp: start.Add(20 * time.Second), Text: "level=info msg=b"},
+		{Timestamp: start.Add(70 * time.Second), Text: "level=error msg=c"},
+		{Timestamp: start.Add(-time.Second), Text: "level=error before range"},
+		{Timestamp: start.Add(3 * time.Minute), Text: "level=error at range end"},
+	}
+
+	series := e.Aggregate(lines, start, start.Add(3*time.Minute), time.Minute)
+
+	require.Len(t, series.Steps, 3)
+	assert.Equal(t, []int64{1, 1, 0}, []int64{series.Steps[0].Count, series.Steps[1].Count, series.Steps[2].Count})
+	assert.Equal(t, start.Add(time.Minute), series.Steps[1].Start)
+	assert.Nil(t, series.BucketCounts)
+}
+
+func TestAggregateHistogram(t *testing.T) {
+	e, err := Compile(Definition{
+		Type:    TypeHistogram,
+		Pattern: `duration=(?P<value>[0-9.]+)`,
+		Buckets: []float64{0.1, 1},
+	})
+	require.NoError(t, err)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var lines []Line
+	for i, v := range []string{"0.05", "0.5", "2", "0.7", "abc"} {
+		lines = append(lines, Line{Timestamp: start.Add(time.Duration(i) * time.Second), Text: "duration=" + v})
+	}
+
+	series := e.Aggregate(lines, start, start.Add(time.Minute), time.Minute)
+
+	require.Len(t, series.Steps, 1)
+	s := series.Steps[0]
+	assert.Equal(t, int64(4), s.Count)
+	assert.InDelta(t, 3.25, s.Sum(), 1e-9)
+	p50, ok := s.Quantile(0.5)
+	require.True(t, ok)
+	assert.InDelta(t, 0.5, p50, 1e-9)
+	p99, _ := s.Quantile(0.99)
+	assert.InDelta(t, 2, p99, 1e-9)
+	assert.Equal(t, []int64{1, 3, 4}, series.BucketCounts)
+
+	_, ok = Step{}.Quantile(0.5)
+	assert.False(t, ok)
+}
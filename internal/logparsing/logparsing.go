@@ -0,0 +1,237 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package logparsing extracts structured fields from log lines according to a component's
+// log parsing profile.
+package logparsing
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// LevelField is the name of the parsed field that sets the level of a log entry.
+const LevelField = "level"
+
+// DefaultMaxLines is the maximum number of lines joined into one entry when the profile
+// does not set one.
+const DefaultMaxLines = 500
+
+// Levels are the normalized log levels.
+var Levels = []string{"DEBUG", "INFO", "WARN", "ERROR"}
+
+// levelAliases normalizes common spellings of the log levels. Profile mappings take precedence.
+var levelAliases = map[string]string{
+	"trace":    "DEBUG",
+	"debug":    "DEBUG",
+	"info":     "INFO",
+	"notice":   "INFO",
+	"warn":     "WARN",
+	"warning":  "WARN",
+	"err":      "ERROR",
+	"error":    "ERROR",
+	"fatal":    "ERROR",
+	"critical": "ERROR",
+	"panic":    "ERROR",
+}
+
+// Parser parses log entries with a compiled profile.
+type Parser struct {
+	format   openchoreov1alpha1.LogFormat
+	pattern  *regexp.Regexp
+	fields   map[string]string
+	start    *regexp.Regexp
+	maxLines int
+	severity *regexp.Regexp
+	mapping  map[string]string
+}
+
+// Result holds what was parsed from a log entry.
+type Result struct {
+	// Level is the normalized level of the entry, or empty when none was found.
+	Level string
+	// Fields holds the values of the profile fields found in the entry.
+	Fields map[string]string
+}
+
+// Compile validates a profile and compiles its patterns.
+func Compile(profile *openchoreov1alpha1.LogParsingProfile) (*Parser, error) {
+	if profile == nil {
+		return nil, fmt.Errorf("profile is required")
+	}
+	p := &Parser{format: profile.Format, fields: profile.Fields, maxLines: DefaultMaxLines}
+
+	switch profile.Format {
+	case openchoreov1alpha1.LogFormatJSON:
+	case openchoreov1alpha1.LogFormatRegex:
+		re, err := regexp.Compile(profile.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		p.pattern = re
+	default:
+		return nil, fmt.Errorf("invalid format %q: must be %q or %q",
+			profile.Format, openchoreov1alpha1.LogFormatJSON, openchoreov1alpha1.LogFormatRegex)
+	}
+
+	for name, source := range profile.Fields {
+		if name == "" || source == "" {
+			return nil, fmt.Errorf("field %q must have a name and a source", name)
+		}
+		if p.pattern != nil && p.pattern.SubexpIndex(source) < 0 {
+			return nil, fmt.Errorf("field %q: pattern has no capture group named %q", name, source)
+		}
+	}
+
+	if m := profile.Multiline; m != nil {
+		re, err := regexp.Compile(m.StartPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multiline start pattern: %w", err)
+		}
+		p.start = re
+		if m.MaxLines > 0 {
+			p.maxLines = int(m.MaxLines)
+		}
+	}
+
+	if sev := profile.Severity; sev != nil {
+		if sev.Pattern != "" {
+			re, err := regexp.Compile(sev.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid severity pattern: %w", err)
+			}
+			if re.NumSubexp() < 1 {
+				return nil, fmt.Errorf("severity pattern must contain a capture group")
+			}
+			p.severity = re
+		}
+		p.mapping = make(map[string]string, len(sev.Mapping))
+		for raw, level := range sev.Mapping {
+			if !slices.Contains(Levels, level) {
+				return nil, fmt.Errorf("severity mapping %q: level %q must be one of %s",
+					raw, level, strings.Join(Levels, ", "))
+			}
+			p.mapping[strings.ToLower(raw)] = level
+		}
+	}
+	return p, nil
+}
+
+// Multiline reports whether the profile joins continuation lines into entries.
+func (p *Parser) Multiline() bool {
+	return p.start != nil
+}
+
+// IsStart reports whether a line begins a new entry. Every line begins an entry when the
+// profile has no multiline configuration.
+func (p *Parser) IsStart(line string) bool {
+	return p.start == nil || p.start.MatchString(line)
+}
+
+// MaxLines returns the maximum number of lines joined into one entry.
+func (p *Parser) MaxLines() int {
+	return p.maxLines
+}
+
+// Parse extracts the fields and level of an entry. Only the first line of a multiline entry
+// is parsed.
+func (p *Parser) Parse(entry string) Result {
+	first, _, _ := strings.Cut(entry, "\n")
+	res := Result{Fields: map[string]string{}}
+
+	switch p.format {
+	case openchoreov1alpha1.LogFormatJSON:
+		p.parseJSON(first, res.Fields)
+	case openchoreov1alpha1.LogFormatRegex:
+		p.parseRegex(first, res.Fields)
+	}
+
+	raw := res.Fields[LevelField]
+	if raw == "" && p.severity != nil {
+		if m := p.severity.FindStringSubmatch(first); m != nil {
+			raw = m[1]
+		}
+	}
+	if raw != "" {
+		res.Level = p.normalizeLevel(raw)
+	}
+	return res
+}
+
+func (p *Parser) parseJSON(line string, out map[string]string) {
+	dec := json.NewDecoder(strings.NewReader(line))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil {
+		return
+	}
+	for name, key := range p.fields {
+		if v, ok := lookup(obj, key); ok {
+			out[name] = v
+		}
+	}
+}
+
+func (p *Parser) parseRegex(line string, out map[string]string) {
+	m := p.pattern.FindStringSubmatch(line)
+	if m == nil {
+		return
+	}
+	for name, group := range p.fields {
+		if v := m[p.pattern.SubexpIndex(group)]; v != "" {
+			out[name] = v
+		}
+	}
+}
+
+func (p *Parser) normalizeLevel(raw string) string {
+	key := strings.ToLower(strings.TrimSpace(raw))
+	if level, ok := p.mapping[key]; ok {
+		return level
+	}
+	if level, ok := levelAliases[key]; ok {
+		return level
+	}
+	return strings.ToUpper(key)
+}
+
+// lookup resolves a dot-separated key in a decoded JSON object. Keys that contain dots are
+// matched before descending into nested objects.
+func lookup(obj map[string]any, key string) (string, bool) {
+	if v, ok := obj[key]; ok {
+		return stringify(v)
+	}
+	head, rest, found := strings.Cut(key, ".")
+	if !found {
+		return "", false
+	}
+	nested, ok := obj[head].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	return lookup(nested, rest)
+}
+
+func stringify(v any) (string, bool) {
+	switch val := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return val, true
+	case json.Number:
+		return val.String(), true
+	case bool:
+		return fmt.Sprintf("%t", val), true
+	default:
+		raw, err := json.Marshal(val)
+		if err != nil {
+			return "", false
+		}
+		return string(raw), true
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logparsing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestCompile_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile openchoreov1alpha1.LogParsingProfile
+		wantErr string
+	}{
+		{
+			name:    "unknown format",
+			profile: openchoreov1alpha1.LogParsingProfile{Format: "XML"},
+			wantErr: "invalid format",
+		},
+		{
+			name:    "invalid pattern",
+			profile: openchoreov1alpha1.LogParsingProfile{Format: openchoreov1alpha1.LogFormatRegex, Pattern: "("},
+			wantErr: "invalid pattern",
+		},
+		{
+			name: "field without capture group",
+			profile: openchoreov1alpha1.LogParsingProfile{
+				Format:  openchoreov1alpha1.LogFormatRegex,
+				Pattern: `^(?P<lvl>\w+) `,
+				Fields:  map[string]string{"level": "severity"},
+			},
+			wantErr: `no capture group named "severity"`,
+		},
+		{
+			name: "invalid start pattern",
+			profile: openchoreov1alpha1.LogParsingProfile{
+				Format:    openchoreov1alpha1.LogFormatJSON,
+				Multiline: &openchoreov1alpha1.LogMultilineConfig{StartPattern: "["},
+			},
+			wantErr: "invalid multiline start pattern",
+		},
+		{
+			name: "severity pattern without group",
+			profile: openchoreov1alpha1.LogParsingProfile{
+				Format:   openchoreov1alpha1.LogFormatJSON,
+				Severity: &openchoreov1alpha1.LogSeverityConfig{Pattern: "ERROR"},
+			},
+			wantErr: "must contain a capture group",
+		},
+		{
+			name: "unknown mapped level",
+			profile: openchoreov1alpha1.LogParsingProfile{
+				Format:   openchoreov1alpha1.LogFormatJSON,
+				Severity: &openchoreov1alpha1.LogSeverityConfig{Mapping: map[string]string{"50": "FATAL"}},
+			},
+			wantErr: "must be one of",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(&tt.profile)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestParse_JSON(t *testing.T) {
+	p, err := Compile(&openchoreov1alpha1.LogParsingProfile{
+		Format: openchoreov1alpha1.LogFormatJSON,
+		Fields: map[string]string{"level": "severity", "logger": "log.logger", "status": "http.status"},
+		Severity: &openchoreov1alpha1.LogSeverityConfig{
+			Mapping: map[string]string{"50": "ERROR"},
+		},
+	})
+	require.NoError(t, err)
+
+	res := p.Parse(`{"severity":"warning","log":{"logger":"com.acme.Checkout"},"http":{"status":503}}`)
+	assert.Equal(t, "WARN", res.Level)
+	assert.Equal(t, map[string]string{
+		"level":  "warning",
+		"logger": "com.acme.Checkout",
+		"status": "503",
+	}, res.Fields)
+
+	res = p.Parse(`{"severity":50}`)
+	assert.Equal(t, "ERROR", res.Level)
+
+	res = p.Parse("not json")
+	assert.Empty(t, res.Level)
+	assert.Empty(t, res.Fields)
+}
+
+func TestParse_RegexWithSeverityPattern(t *testing.T) {
+	p, err := Compile(&openchoreov1alpha1.LogParsingProfile{
+		Format:  openchoreov1alpha1.LogFormatRegex,
+		Pattern: `^\S+ \[(?P<thread>[^\]]+)\] (?P<logger>\S+) -`,
+		Fields:  map[string]string{"logger": "logger", "thread": "thread"},
+		Severity: &openchoreov1alpha1.LogSeverityConfig{
+			Pattern: `\b(SEVERE|WARNING|INFO|FINE)\b`,
+			Mapping: map[string]string{"severe": "ERROR", "fine": "DEBUG"},
+		},
+		Multiline: &openchoreov1alpha1.LogMultilineConfig{StartPattern: `^\d{4}-\d{2}-\d{2}`},
+	})
+	require.NoError(t, err)
+
+	entry := "2026-03-07T10:00:00Z [main] com.acme.Billing - SEVERE payment failed\n\tat com.acme.Billing.charge(Billing.java:42)"
+	res := p.Parse(entry)
+	assert.Equal(t, "ERROR", res.Level)
+	assert.Equal(t, map[string]string{"logger": "com.acme.Billing", "thread": "main"}, res.Fields)
+
+	assert.True(t, p.Multiline())
+	assert.True(t, p.IsStart("2026-03-07T10:00:00Z [main] x - INFO ok"))
+	assert.False(t, p.IsStart("\tat com.acme.Billing.charge(Billing.java:42)"))
+	assert.Equal(t, DefaultMaxLines, p.MaxLines())
+}
+
+func TestIsStart_WithoutMultiline(t *testing.T) {
+	p, err := Compile(&openchoreov1alpha1.LogParsingProfile{Format: openchoreov1alpha1.LogFormatJSON})
+	require.NoError(t, err)
+	assert.False(t, p.Multiline())
+	assert.True(t, p.IsStart("anything"))
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// ValidationsToPreRenderValidations is the name of the migration that moves the
+// deprecated spec.validations field of ComponentTypes and Traits to spec.preRenderValidations.
+const ValidationsToPreRenderValidations = "validations-to-prerendervalidations"
+
+// Builtin returns the migrations shipped with this release of OpenChoreo.
+func Builtin() []Migration {
+	return []Migration{
+		validationsToPreRenderValidations(),
+	}
+}
+
+// DefaultRegistry returns a registry containing the built-in migrations.
+func DefaultRegistry() *Registry {
+	r, err := NewRegistry(Builtin()...)
+	if err != nil {
+		// Built-in migrations are static; a duplicate name is a programming error.
+		panic(err)
+	}
+	return r
+}
+
+func validationsToPreRenderValidations() Migration {
+	return Migration{
+		Name: ValidationsToPreRenderValidations,
+		Description: "Moves the deprecated spec.validations rules of ComponentTypes, ClusterComponentTypes, " +
+			"Traits and ClusterTraits to spec.preRenderValidations",
+		Targets: []Target{
+			{
+				Kind:    "ComponentType",
+				NewList: func() client.ObjectList { return &openchoreov1alpha1.ComponentTypeList{} },
+				Migrate: func(obj client.Object) (bool, error) {
+					ct, ok := obj.(*openchoreov1alpha1.ComponentType)
+					if !ok {
+						return false, fmt.Errorf("unexpected object type %T", obj)
+					}
+					//nolint:staticcheck // migrating away from the deprecated field
+					return moveValidations(&ct.Spec.Validations, &ct.Spec.PreRenderValidations), nil
+				},
+			},
+			{
+				Kind:    "ClusterComponentType",
+				NewList: func() client.ObjectList { return &openchoreov1alpha1.ClusterComponentTypeList{} },
+				Migrate: func(obj client.Object) (bool, error) {
+					cct, ok := obj.(*openchoreov1alpha1.ClusterComponentType)
+					if !ok {
+						return false, fmt.Errorf("unexpected object type %T", obj)
+					}
+					//nolint:staticcheck // migrating away from the deprecated field
+					return moveValidations(&cct.Spec.Validations, &cct.Spec.PreRenderValidations), nil
+				},
+			},
+			{
+				Kind:    "Trait",
+				NewList: func() client.ObjectList { return &openchoreov1alpha1.TraitList{} },
+				Migrate: func(obj client.Object) (bool, error) {
+					trait, ok := obj.(*openchoreov1alpha1.Trait)
+					if !ok {
+						return false, fmt.Errorf("unexpected object type %T", obj)
+					}
+					//nolint:staticcheck // migrating away from the deprecated field
+					return moveValidations(&trait.Spec.Validations, &trait.Spec.PreRenderValidations), nil
+				},
+			},
+			{
+				Kind:    "ClusterTrait",
+				NewList: func() client.ObjectList { return &openchoreov1alpha1.ClusterTraitList{} },
+				Migrate: func(obj client.Object) (bool, error) {
+					trait, ok := obj.(*openchoreov1alpha1.ClusterTrait)
+					if !ok {
+						return false, fmt.Errorf("unexpected object type %T", obj)
+					}
+					//nolint:staticcheck // migrating away from the deprecated field
+					return moveValidations(&trait.Spec.Validations, &trait.Spec.PreRenderValidations), nil
+				},
+			},
+		},
+	}
+}
+
+// moveValidations moves legacy rules into preRender when preRender is empty.
+// PreRenderValidations takes precedence at render time, so when both are set
+// the legacy rules are inert and are simply dropped.
+func moveValidations(legacy, preRender *[]openchoreov1alpha1.ValidationRule) bool {
+	if len(*legacy) == 0 {
+		return false
+	}
+	if len(*preRender) == 0 {
+		*preRender = *legacy
+	}
+	*legacy = nil
+	return true
+}
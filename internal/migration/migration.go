@@ -0,0 +1,222 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package migration provides a framework for applying idempotent transformations
+// to stored OpenChoreo resources when CRD semantics change between releases.
+//
+// A Migration declares the object kinds it touches and a per-object transform.
+// The Runner pages through every stored object of those kinds, applies the
+// transform, and persists the objects that changed. Transforms must be
+// idempotent so that a run can be interrupted and repeated safely.
+package migration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultBatchSize is the page size used when the caller does not specify one.
+const DefaultBatchSize int64 = 100
+
+// MaxRecordedFailures bounds the number of failures kept in a Progress report.
+const MaxRecordedFailures = 20
+
+// MigrateFunc transforms obj in place and reports whether it was modified.
+// Implementations must be idempotent: applying the function to an already
+// migrated object must return false.
+type MigrateFunc func(obj client.Object) (bool, error)
+
+// Target describes one object kind touched by a migration.
+type Target struct {
+	// Kind is the human-readable kind used in progress reports.
+	Kind string
+	// NewList returns an empty list object for the kind.
+	NewList func() client.ObjectList
+	// Migrate transforms a single object of the kind.
+	Migrate MigrateFunc
+}
+
+// Migration is a named, idempotent transformation over stored objects.
+type Migration struct {
+	// Name uniquely identifies the migration. ObjectMigration resources refer to it.
+	Name string
+	// Description explains what the migration changes.
+	Description string
+	// Targets lists the object kinds the migration touches, processed in order.
+	Targets []Target
+}
+
+// Failure records an object that could not be migrated.
+type Failure struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Message   string
+}
+
+// Progress reports the counters of a migration run.
+type Progress struct {
+	Scanned  int64
+	Migrated int64
+	Failed   int64
+	// Failures holds at most MaxRecordedFailures entries.
+	Failures []Failure
+}
+
+func (p *Progress) recordFailure(kind string, obj client.Object, err error) {
+	p.Failed++
+	if len(p.Failures) < MaxRecordedFailures {
+		p.Failures = append(p.Failures, Failure{
+			Kind:      kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+			Message:   err.Error(),
+		})
+	}
+}
+
+// Registry holds the migrations known to the controller manager.
+type Registry struct {
+	migrations map[string]Migration
+}
+
+// NewRegistry creates a registry from the given migrations.
+// It returns an error when two migrations share a name.
+func NewRegistry(migrations ...Migration) (*Registry, error) {
+	r := &Registry{migrations: make(map[string]Migration, len(migrations))}
+	for _, m := range migrations {
+		if m.Name == "" {
+			return nil, fmt.Errorf("migration name cannot be empty")
+		}
+		if _, exists := r.migrations[m.Name]; exists {
+			return nil, fmt.Errorf("duplicate migration %q", m.Name)
+		}
+		r.migrations[m.Name] = m
+	}
+	return r, nil
+}
+
+// Get returns the migration registered under name.
+func (r *Registry) Get(name string) (Migration, bool) {
+	m, ok := r.migrations[name]
+	return m, ok
+}
+
+// Names returns the registered migration names in sorted order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.migrations))
+	for name := range r.migrations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RunOptions configures a migration run.
+type RunOptions struct {
+	// BatchSize is the list page size. Defaults to DefaultBatchSize.
+	BatchSize int64
+	// DryRun counts objects that would change without updating them.
+	DryRun bool
+	// OnBatch, when set, is called after every processed page with the
+	// cumulative progress so callers can persist it.
+	OnBatch func(ctx context.Context, p Progress) error
+}
+
+// Runner applies migrations using a Kubernetes client.
+type Runner struct {
+	client client.Client
+}
+
+// NewRunner creates a Runner backed by the given client. The client should read
+// directly from the API server so that pagination reflects stored state.
+func NewRunner(c client.Client) *Runner {
+	return &Runner{client: c}
+}
+
+// Run applies m to every stored object of its target kinds.
+// Per-object failures are recorded in the returned Progress; an error is only
+// returned when listing fails or the OnBatch callback fails.
+func (r *Runner) Run(ctx context.Context, m Migration, opts RunOptions) (Progress, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	var progress Progress
+	for _, target := range m.Targets {
+		continueToken := ""
+		for {
+			list := target.NewList()
+			if err := r.client.List(ctx, list, client.Limit(batchSize), client.Continue(continueToken)); err != nil {
+				return progress, fmt.Errorf("failed to list %s: %w", target.Kind, err)
+			}
+
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				return progress, fmt.Errorf("failed to extract %s items: %w", target.Kind, err)
+			}
+			for _, item := range items {
+				obj, ok := item.(client.Object)
+				if !ok {
+					continue
+				}
+				progress.Scanned++
+				changed, err := r.migrateObject(ctx, target, obj, opts.DryRun)
+				if err != nil {
+					progress.recordFailure(target.Kind, obj, err)
+					continue
+				}
+				if changed {
+					progress.Migrated++
+				}
+			}
+
+			if opts.OnBatch != nil {
+				if err := opts.OnBatch(ctx, progress); err != nil {
+					return progress, err
+				}
+			}
+
+			continueToken = list.GetContinue()
+			if continueToken == "" {
+				break
+			}
+		}
+	}
+	return progress, nil
+}
+
+// migrateObject transforms a single object and persists it when it changed.
+// Update conflicts are retried against a fresh copy of the object so that a
+// concurrent writer never has its change overwritten.
+func (r *Runner) migrateObject(ctx context.Context, target Target, obj client.Object, dryRun bool) (bool, error) {
+	changed, err := target.Migrate(obj)
+	if err != nil || !changed || dryRun {
+		return changed, err
+	}
+
+	first := true
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !first {
+			if err := r.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+				return err
+			}
+			changed, err = target.Migrate(obj)
+			if err != nil || !changed {
+				return err
+			}
+		}
+		first = false
+		return r.client.Update(ctx, obj)
+	})
+	if err != nil {
+		return false, err
+	}
+	return changed, nil
+}
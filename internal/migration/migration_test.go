@@ -0,0 +1,184 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	return s
+}
+
+func rule(expr string) openchoreov1alpha1.ValidationRule {
+	return openchoreov1alpha1.ValidationRule{Rule: expr, Message: "invalid"}
+}
+
+func TestNewRegistry(t *testing.T) {
+	t.Run("rejects duplicate names", func(t *testing.T) {
+		_, err := NewRegistry(Migration{Name: "a"}, Migration{Name: "a"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate migration")
+	})
+
+	t.Run("rejects empty names", func(t *testing.T) {
+		_, err := NewRegistry(Migration{})
+		require.Error(t, err)
+	})
+
+	t.Run("returns sorted names", func(t *testing.T) {
+		r, err := NewRegistry(Migration{Name: "b"}, Migration{Name: "a"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, r.Names())
+		_, ok := r.Get("a")
+		assert.True(t, ok)
+		_, ok = r.Get("c")
+		assert.False(t, ok)
+	})
+
+	t.Run("default registry contains builtin migrations", func(t *testing.T) {
+		_, ok := DefaultRegistry().Get(ValidationsToPreRenderValidations)
+		assert.True(t, ok)
+	})
+}
+
+func TestRunValidationsToPreRenderValidations(t *testing.T) {
+	ctx := context.Background()
+
+	legacy := &openchoreov1alpha1.ComponentType{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "default"},
+		Spec: openchoreov1alpha1.ComponentTypeSpec{
+			Validations: []openchoreov1alpha1.ValidationRule{rule("${true}")},
+		},
+	}
+	both := &openchoreov1alpha1.ComponentType{
+		ObjectMeta: metav1.ObjectMeta{Name: "both", Namespace: "default"},
+		Spec: openchoreov1alpha1.ComponentTypeSpec{
+			Validations:          []openchoreov1alpha1.ValidationRule{rule("${false}")},
+			PreRenderValidations: []openchoreov1alpha1.ValidationRule{rule("${true}")},
+		},
+	}
+	current := &openchoreov1alpha1.Trait{
+		ObjectMeta: metav1.ObjectMeta{Name: "current", Namespace: "default"},
+		Spec: openchoreov1alpha1.TraitSpec{
+			PreRenderValidations: []openchoreov1alpha1.ValidationRule{rule("${true}")},
+		},
+	}
+	legacyClusterTrait := &openchoreov1alpha1.ClusterTrait{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy"},
+		Spec: openchoreov1alpha1.ClusterTraitSpec{
+			Validations: []openchoreov1alpha1.ValidationRule{rule("${true}")},
+		},
+	}
+
+	newClient := func() client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(newScheme(t)).
+			WithObjects(legacy.DeepCopy(), both.DeepCopy(), current.DeepCopy(), legacyClusterTrait.DeepCopy()).
+			Build()
+	}
+	m, _ := DefaultRegistry().Get(ValidationsToPreRenderValidations)
+
+	t.Run("migrates stored objects", func(t *testing.T) {
+		c := newClient()
+		var batches int
+		progress, err := NewRunner(c).Run(ctx, m, RunOptions{
+			BatchSize: 1,
+			OnBatch: func(context.Context, Progress) error {
+				batches++
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), progress.Scanned)
+		assert.Equal(t, int64(3), progress.Migrated)
+		assert.Zero(t, progress.Failed)
+		assert.Positive(t, batches)
+
+		got := &openchoreov1alpha1.ComponentType{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(legacy), got))
+		assert.Empty(t, got.Spec.Validations) //nolint:staticcheck // asserting the deprecated field is cleared
+		assert.Equal(t, []openchoreov1alpha1.ValidationRule{rule("${true}")}, got.Spec.PreRenderValidations)
+
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(both), got))
+		assert.Empty(t, got.Spec.Validations) //nolint:staticcheck // asserting the deprecated field is cleared
+		assert.Equal(t, []openchoreov1alpha1.ValidationRule{rule("${true}")}, got.Spec.PreRenderValidations)
+
+		gotTrait := &openchoreov1alpha1.ClusterTrait{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(legacyClusterTrait), gotTrait))
+		assert.Empty(t, gotTrait.Spec.Validations) //nolint:staticcheck // asserting the deprecated field is cleared
+		assert.Len(t, gotTrait.Spec.PreRenderValidations, 1)
+
+		// A second run is a no-op.
+		progress, err = NewRunner(c).Run(ctx, m, RunOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), progress.Scanned)
+		assert.Zero(t, progress.Migrated)
+	})
+
+	t.Run("dry run does not persist changes", func(t *testing.T) {
+		c := newClient()
+		progress, err := NewRunner(c).Run(ctx, m, RunOptions{DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), progress.Migrated)
+
+		got := &openchoreov1alpha1.ComponentType{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(legacy), got))
+		assert.Len(t, got.Spec.Validations, 1) //nolint:staticcheck // asserting the deprecated field is untouched
+		assert.Empty(t, got.Spec.PreRenderValidations)
+	})
+}
+
+func TestRunRecordsFailures(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().
+		WithScheme(newScheme(t)).
+		WithObjects(
+			&openchoreov1alpha1.Trait{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}},
+			&openchoreov1alpha1.Trait{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "default"}},
+		).
+		Build()
+
+	m := Migration{
+		Name: "always-fails",
+		Targets: []Target{{
+			Kind:    "Trait",
+			NewList: func() client.ObjectList { return &openchoreov1alpha1.TraitList{} },
+			Migrate: func(client.Object) (bool, error) { return false, errors.New("boom") },
+		}},
+	}
+
+	progress, err := NewRunner(c).Run(ctx, m, RunOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), progress.Scanned)
+	assert.Equal(t, int64(2), progress.Failed)
+	require.Len(t, progress.Failures, 2)
+	assert.Equal(t, "Trait", progress.Failures[0].Kind)
+	assert.Equal(t, "boom", progress.Failures[0].Message)
+}
+
+func TestRunStopsWhenOnBatchFails(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).Build()
+	m, _ := DefaultRegistry().Get(ValidationsToPreRenderValidations)
+
+	_, err := NewRunner(c).Run(ctx, m, RunOptions{
+		OnBatch: func(context.Context, Progress) error { return errors.New("status update failed") },
+	})
+	require.Error(t, err)
+}
@@ -45,6 +45,7 @@ type ComponentPolicyParams struct {
 // When Provider is ProviderCilium a CiliumNetworkPolicy is returned with L7 HTTP rules
 // for HTTP-proxied endpoints; otherwise a standard Kubernetes NetworkPolicy is returned.
 func MakeComponentPolicies(params ComponentPolicyParams) []map[string]any {
+	params.Endpoints = servedEndpoints(params.Endpoints)
 	if params.Provider == ProviderCilium {
 		return makeCiliumComponentPolicies(params)
 	}
@@ -84,6 +85,18 @@ func MakeComponentPolicies(params ComponentPolicyParams) []map[string]any {
 	return []map[string]any{policy}
 }
 
+// servedEndpoints drops the asynchronous endpoints, which are served by a message broker
+// rather than by the component, so that no ingress is opened for them.
+func servedEndpoints(endpoints map[string]openchoreov1alpha1.WorkloadEndpoint) map[string]openchoreov1alpha1.WorkloadEndpoint {
+	served := make(map[string]openchoreov1alpha1.WorkloadEndpoint, len(endpoints))
+	for name, ep := range endpoints {
+		if !ep.Type.IsAsync() {
+			served[name] = ep
+		}
+	}
+	return served
+}
+
 // makeIngressRules builds ingress rules from component endpoints.
 func makeIngressRules(params ComponentPolicyParams) []any {
 	if len(params.Endpoints) == 0 {
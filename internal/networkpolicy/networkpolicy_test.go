@@ -376,6 +376,48 @@ spec:
 `)
 }
 
+func TestMakeComponentPolicies_AsyncEndpoint(t *testing.T) {
+	policies := MakeComponentPolicies(ComponentPolicyParams{
+		Namespace:     "dp-ns",
+		CPNamespace:   "cp-ns",
+		Environment:   "development",
+		ComponentName: "orders",
+		PodSelectors:  map[string]string{"app": "orders"},
+		Endpoints: map[string]openchoreov1alpha1.WorkloadEndpoint{
+			"http": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 8080},
+			"events": {
+				Type:  openchoreov1alpha1.EndpointTypeKafka,
+				Port:  9092,
+				Async: &openchoreov1alpha1.AsyncEndpoint{Channel: "orders", Broker: "kafka-bootstrap.kafka"},
+			},
+		},
+	})
+	if len(policies) != 1 {
+		t.Fatalf("expected 1 policy, got %d", len(policies))
+	}
+
+	// The broker port of the Kafka endpoint is not opened on the component.
+	assertYAMLEqual(t, "async-endpoint", policies[0], `
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: openchoreo-orders
+  namespace: dp-ns
+spec:
+  podSelector:
+    matchLabels:
+      app: orders
+  policyTypes:
+    - Ingress
+  ingress:
+    - from:
+        - podSelector: {}
+      ports:
+        - protocol: TCP
+          port: 8080
+`)
+}
+
 // --- Cilium CNP tests ---
 
 func TestMakeComponentPolicies_Cilium_NoEndpoints(t *testing.T) {
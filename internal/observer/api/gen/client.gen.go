@@ -130,6 +130,34 @@ type ClientInterface interface {
 
 	HandleAlertWebhook(ctx context.Context, body HandleAlertWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DetectAnomaliesWithBody request with any body
+	DetectAnomaliesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DetectAnomalies(ctx context.Context, body DetectAnomaliesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QueryCorrelationsWithBody request with any body
+	QueryCorrelationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QueryCorrelations(ctx context.Context, body QueryCorrelationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteExport request
+	DeleteExport(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpsertExportWithBody request with any body
+	UpsertExportWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpsertExport(ctx context.Context, namespace string, name string, body UpsertExportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QueryFederatedLogsWithBody request with any body
+	QueryFederatedLogsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QueryFederatedLogs(ctx context.Context, body QueryFederatedLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QueryFederatedMetricsWithBody request with any body
+	QueryFederatedMetricsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QueryFederatedMetrics(ctx context.Context, body QueryFederatedMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryIncidentsWithBody request with any body
 	QueryIncidentsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -140,11 +168,65 @@ type ClientInterface interface {
 
 	UpdateIncident(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetIdleWorkloadsWithBody request with any body
+	GetIdleWorkloadsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetIdleWorkloads(ctx context.Context, body GetIdleWorkloadsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QueryLogMetricWithBody request with any body
+	QueryLogMetricWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QueryLogMetric(ctx context.Context, body QueryLogMetricJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecommendResourcesWithBody request with any body
+	RecommendResourcesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecommendResources(ctx context.Context, body RecommendResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryRuntimeTopologyWithBody request with any body
 	QueryRuntimeTopologyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	QueryRuntimeTopology(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// NotifyPromotionWithBody request with any body
+	NotifyPromotionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NotifyPromotion(ctx context.Context, body NotifyPromotionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSavedQueryWithBody request with any body
+	CreateSavedQueryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSavedQuery(ctx context.Context, body CreateSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSavedQueries request
+	ListSavedQueries(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSavedQuery request
+	DeleteSavedQuery(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSavedQuery request
+	GetSavedQuery(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RunSavedQueryWithBody request with any body
+	RunSavedQueryWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RunSavedQuery(ctx context.Context, namespace string, name string, body RunSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchOpenSearchWithBody request with any body
+	SearchOpenSearchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchOpenSearch(ctx context.Context, body SearchOpenSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QueryPromQLWithBody request with any body
+	QueryPromQLWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QueryPromQL(ctx context.Context, body QueryPromQLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EvaluateErrorBudgetWithBody request with any body
+	EvaluateErrorBudgetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EvaluateErrorBudget(ctx context.Context, body EvaluateErrorBudgetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryTracesWithBody request with any body
 	QueryTracesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -354,8 +436,8 @@ func (c *Client) HandleAlertWebhook(ctx context.Context, body HandleAlertWebhook
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryIncidentsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryIncidentsRequestWithBody(c.Server, contentType, body)
+func (c *Client) DetectAnomaliesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDetectAnomaliesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -366,8 +448,8 @@ func (c *Client) QueryIncidentsWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryIncidents(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryIncidentsRequest(c.Server, body)
+func (c *Client) DetectAnomalies(ctx context.Context, body DetectAnomaliesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDetectAnomaliesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -378,8 +460,8 @@ func (c *Client) QueryIncidents(ctx context.Context, body QueryIncidentsJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateIncidentWithBody(ctx context.Context, incidentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateIncidentRequestWithBody(c.Server, incidentId, contentType, body)
+func (c *Client) QueryCorrelationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryCorrelationsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -390,8 +472,8 @@ func (c *Client) UpdateIncidentWithBody(ctx context.Context, incidentId string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateIncident(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateIncidentRequest(c.Server, incidentId, body)
+func (c *Client) QueryCorrelations(ctx context.Context, body QueryCorrelationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryCorrelationsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -402,8 +484,8 @@ func (c *Client) UpdateIncident(ctx context.Context, incidentId string, body Upd
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryRuntimeTopologyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryRuntimeTopologyRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteExport(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteExportRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -414,8 +496,8 @@ func (c *Client) QueryRuntimeTopologyWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryRuntimeTopology(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryRuntimeTopologyRequest(c.Server, body)
+func (c *Client) UpsertExportWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertExportRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -426,8 +508,8 @@ func (c *Client) QueryRuntimeTopology(ctx context.Context, body QueryRuntimeTopo
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryTracesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryTracesRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpsertExport(ctx context.Context, namespace string, name string, body UpsertExportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertExportRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -438,8 +520,8 @@ func (c *Client) QueryTracesWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryTraces(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryTracesRequest(c.Server, body)
+func (c *Client) QueryFederatedLogsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryFederatedLogsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -450,8 +532,8 @@ func (c *Client) QueryTraces(ctx context.Context, body QueryTracesJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) QuerySpansForTraceWithBody(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQuerySpansForTraceRequestWithBody(c.Server, traceId, contentType, body)
+func (c *Client) QueryFederatedLogs(ctx context.Context, body QueryFederatedLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryFederatedLogsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -462,8 +544,8 @@ func (c *Client) QuerySpansForTraceWithBody(ctx context.Context, traceId string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) QuerySpansForTrace(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQuerySpansForTraceRequest(c.Server, traceId, body)
+func (c *Client) QueryFederatedMetricsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryFederatedMetricsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -474,8 +556,8 @@ func (c *Client) QuerySpansForTrace(ctx context.Context, traceId string, body Qu
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSpanDetailsForTrace(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSpanDetailsForTraceRequest(c.Server, traceId, spanId)
+func (c *Client) QueryFederatedMetrics(ctx context.Context, body QueryFederatedMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryFederatedMetricsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -486,8 +568,8 @@ func (c *Client) GetSpanDetailsForTrace(ctx context.Context, traceId string, spa
 	return c.Client.Do(req)
 }
 
-func (c *Client) Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthRequest(c.Server)
+func (c *Client) QueryIncidentsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryIncidentsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -498,402 +580,403 @@ func (c *Client) Health(ctx context.Context, reqEditors ...RequestEditorFn) (*ht
 	return c.Client.Do(req)
 }
 
-// NewQueryEventsRequest calls the generic QueryEvents builder with application/json body
-func NewQueryEventsRequest(server string, body QueryEventsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) QueryIncidents(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryIncidentsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewQueryEventsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewQueryEventsRequestWithBody generates requests for QueryEvents with any type of body
-func NewQueryEventsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateIncidentWithBody(ctx context.Context, incidentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIncidentRequestWithBody(c.Server, incidentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/events/query")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateIncident(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIncidentRequest(c.Server, incidentId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewQueryLogsRequest calls the generic QueryLogs builder with application/json body
-func NewQueryLogsRequest(server string, body QueryLogsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetIdleWorkloadsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIdleWorkloadsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewQueryLogsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewQueryLogsRequestWithBody generates requests for QueryLogs with any type of body
-func NewQueryLogsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetIdleWorkloads(ctx context.Context, body GetIdleWorkloadsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIdleWorkloadsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/logs/query")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) QueryLogMetricWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryLogMetricRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewQueryMetricsRequest calls the generic QueryMetrics builder with application/json body
-func NewQueryMetricsRequest(server string, body QueryMetricsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) QueryLogMetric(ctx context.Context, body QueryLogMetricJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryLogMetricRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewQueryMetricsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewQueryMetricsRequestWithBody generates requests for QueryMetrics with any type of body
-func NewQueryMetricsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RecommendResourcesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecommendResourcesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/metrics/query")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RecommendResources(ctx context.Context, body RecommendResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecommendResourcesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewQueryAlertsRequest calls the generic QueryAlerts builder with application/json body
-func NewQueryAlertsRequest(server string, body QueryAlertsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) QueryRuntimeTopologyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryRuntimeTopologyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewQueryAlertsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewQueryAlertsRequestWithBody generates requests for QueryAlerts with any type of body
-func NewQueryAlertsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) QueryRuntimeTopology(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryRuntimeTopologyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/query")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) NotifyPromotionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotifyPromotionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateAlertRuleRequest calls the generic CreateAlertRule builder with application/json body
-func NewCreateAlertRuleRequest(server string, sourceType string, body CreateAlertRuleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) NotifyPromotion(ctx context.Context, body NotifyPromotionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotifyPromotionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateAlertRuleRequestWithBody(server, sourceType, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateAlertRuleRequestWithBody generates requests for CreateAlertRule with any type of body
-func NewCreateAlertRuleRequestWithBody(server string, sourceType string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sourceType", runtime.ParamLocationPath, sourceType)
+func (c *Client) CreateSavedQueryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSavedQueryRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateSavedQuery(ctx context.Context, body CreateSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSavedQueryRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/sources/%s/rules", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListSavedQueries(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSavedQueriesRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) DeleteSavedQuery(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSavedQueryRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteAlertRuleRequest generates requests for DeleteAlertRule
-func NewDeleteAlertRuleRequest(server string, sourceType string, ruleName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sourceType", runtime.ParamLocationPath, sourceType)
+func (c *Client) GetSavedQuery(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSavedQueryRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, ruleName)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RunSavedQueryWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunSavedQueryRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/sources/%s/rules/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RunSavedQuery(ctx context.Context, namespace string, name string, body RunSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunSavedQueryRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAlertRuleRequest generates requests for GetAlertRule
-func NewGetAlertRuleRequest(server string, sourceType string, ruleName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sourceType", runtime.ParamLocationPath, sourceType)
+func (c *Client) SearchOpenSearchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchOpenSearchRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, ruleName)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SearchOpenSearch(ctx context.Context, body SearchOpenSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchOpenSearchRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/sources/%s/rules/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) QueryPromQLWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryPromQLRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateAlertRuleRequest calls the generic UpdateAlertRule builder with application/json body
-func NewUpdateAlertRuleRequest(server string, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) QueryPromQL(ctx context.Context, body QueryPromQLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryPromQLRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateAlertRuleRequestWithBody(server, sourceType, ruleName, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateAlertRuleRequestWithBody generates requests for UpdateAlertRule with any type of body
-func NewUpdateAlertRuleRequestWithBody(server string, sourceType string, ruleName string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sourceType", runtime.ParamLocationPath, sourceType)
+func (c *Client) EvaluateErrorBudgetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEvaluateErrorBudgetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, ruleName)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) EvaluateErrorBudget(ctx context.Context, body EvaluateErrorBudgetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEvaluateErrorBudgetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/sources/%s/rules/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) QueryTracesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryTracesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) QueryTraces(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryTracesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewHandleAlertWebhookRequest calls the generic HandleAlertWebhook builder with application/json body
-func NewHandleAlertWebhookRequest(server string, body HandleAlertWebhookJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) QuerySpansForTraceWithBody(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQuerySpansForTraceRequestWithBody(c.Server, traceId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewHandleAlertWebhookRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewHandleAlertWebhookRequestWithBody generates requests for HandleAlertWebhook with any type of body
-func NewHandleAlertWebhookRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) QuerySpansForTrace(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQuerySpansForTraceRequest(c.Server, traceId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/webhook")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetSpanDetailsForTrace(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpanDetailsForTraceRequest(c.Server, traceId, spanId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewQueryIncidentsRequest calls the generic QueryIncidents builder with application/json body
-func NewQueryIncidentsRequest(server string, body QueryIncidentsJSONRequestBody) (*http.Request, error) {
+// NewQueryEventsRequest calls the generic QueryEvents builder with application/json body
+func NewQueryEventsRequest(server string, body QueryEventsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewQueryIncidentsRequestWithBody(server, "application/json", bodyReader)
+	return NewQueryEventsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewQueryIncidentsRequestWithBody generates requests for QueryIncidents with any type of body
-func NewQueryIncidentsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewQueryEventsRequestWithBody generates requests for QueryEvents with any type of body
+func NewQueryEventsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -901,7 +984,7 @@ func NewQueryIncidentsRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/incidents/query")
+	operationPath := fmt.Sprintf("/api/v1/events/query")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -921,34 +1004,27 @@ func NewQueryIncidentsRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewUpdateIncidentRequest calls the generic UpdateIncident builder with application/json body
-func NewUpdateIncidentRequest(server string, incidentId string, body UpdateIncidentJSONRequestBody) (*http.Request, error) {
+// NewQueryLogsRequest calls the generic QueryLogs builder with application/json body
+func NewQueryLogsRequest(server string, body QueryLogsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateIncidentRequestWithBody(server, incidentId, "application/json", bodyReader)
+	return NewQueryLogsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateIncidentRequestWithBody generates requests for UpdateIncident with any type of body
-func NewUpdateIncidentRequestWithBody(server string, incidentId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewQueryLogsRequestWithBody generates requests for QueryLogs with any type of body
+func NewQueryLogsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "incidentId", runtime.ParamLocationPath, incidentId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/incidents/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/logs/query")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -958,7 +1034,7 @@ func NewUpdateIncidentRequestWithBody(server string, incidentId string, contentT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -968,19 +1044,19 @@ func NewUpdateIncidentRequestWithBody(server string, incidentId string, contentT
 	return req, nil
 }
 
-// NewQueryRuntimeTopologyRequest calls the generic QueryRuntimeTopology builder with application/json body
-func NewQueryRuntimeTopologyRequest(server string, body QueryRuntimeTopologyJSONRequestBody) (*http.Request, error) {
+// NewQueryMetricsRequest calls the generic QueryMetrics builder with application/json body
+func NewQueryMetricsRequest(server string, body QueryMetricsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewQueryRuntimeTopologyRequestWithBody(server, "application/json", bodyReader)
+	return NewQueryMetricsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewQueryRuntimeTopologyRequestWithBody generates requests for QueryRuntimeTopology with any type of body
-func NewQueryRuntimeTopologyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewQueryMetricsRequestWithBody generates requests for QueryMetrics with any type of body
+func NewQueryMetricsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -988,7 +1064,7 @@ func NewQueryRuntimeTopologyRequestWithBody(server string, contentType string, b
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/metrics/runtime-topology")
+	operationPath := fmt.Sprintf("/api/v1/metrics/query")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1008,19 +1084,19 @@ func NewQueryRuntimeTopologyRequestWithBody(server string, contentType string, b
 	return req, nil
 }
 
-// NewQueryTracesRequest calls the generic QueryTraces builder with application/json body
-func NewQueryTracesRequest(server string, body QueryTracesJSONRequestBody) (*http.Request, error) {
+// NewQueryAlertsRequest calls the generic QueryAlerts builder with application/json body
+func NewQueryAlertsRequest(server string, body QueryAlertsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewQueryTracesRequestWithBody(server, "application/json", bodyReader)
+	return NewQueryAlertsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewQueryTracesRequestWithBody generates requests for QueryTraces with any type of body
-func NewQueryTracesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewQueryAlertsRequestWithBody generates requests for QueryAlerts with any type of body
+func NewQueryAlertsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1028,7 +1104,7 @@ func NewQueryTracesRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/traces/query")
+	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/query")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1048,24 +1124,24 @@ func NewQueryTracesRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
-// NewQuerySpansForTraceRequest calls the generic QuerySpansForTrace builder with application/json body
-func NewQuerySpansForTraceRequest(server string, traceId string, body QuerySpansForTraceJSONRequestBody) (*http.Request, error) {
+// NewCreateAlertRuleRequest calls the generic CreateAlertRule builder with application/json body
+func NewCreateAlertRuleRequest(server string, sourceType string, body CreateAlertRuleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewQuerySpansForTraceRequestWithBody(server, traceId, "application/json", bodyReader)
+	return NewCreateAlertRuleRequestWithBody(server, sourceType, "application/json", bodyReader)
 }
 
-// NewQuerySpansForTraceRequestWithBody generates requests for QuerySpansForTrace with any type of body
-func NewQuerySpansForTraceRequestWithBody(server string, traceId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAlertRuleRequestWithBody generates requests for CreateAlertRule with any type of body
+func NewCreateAlertRuleRequestWithBody(server string, sourceType string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "traceId", runtime.ParamLocationPath, traceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sourceType", runtime.ParamLocationPath, sourceType)
 	if err != nil {
 		return nil, err
 	}
@@ -1075,7 +1151,7 @@ func NewQuerySpansForTraceRequestWithBody(server string, traceId string, content
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/traces/%s/spans/query", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/sources/%s/rules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1095,20 +1171,20 @@ func NewQuerySpansForTraceRequestWithBody(server string, traceId string, content
 	return req, nil
 }
 
-// NewGetSpanDetailsForTraceRequest generates requests for GetSpanDetailsForTrace
-func NewGetSpanDetailsForTraceRequest(server string, traceId string, spanId string) (*http.Request, error) {
+// NewDeleteAlertRuleRequest generates requests for DeleteAlertRule
+func NewDeleteAlertRuleRequest(server string, sourceType string, ruleName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "traceId", runtime.ParamLocationPath, traceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sourceType", runtime.ParamLocationPath, sourceType)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "spanId", runtime.ParamLocationPath, spanId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, ruleName)
 	if err != nil {
 		return nil, err
 	}
@@ -1118,7 +1194,7 @@ func NewGetSpanDetailsForTraceRequest(server string, traceId string, spanId stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1alpha1/traces/%s/spans/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/sources/%s/rules/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1128,7 +1204,7 @@ func NewGetSpanDetailsForTraceRequest(server string, traceId string, spanId stri
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1136,16 +1212,30 @@ func NewGetSpanDetailsForTraceRequest(server string, traceId string, spanId stri
 	return req, nil
 }
 
-// NewHealthRequest generates requests for Health
-func NewHealthRequest(server string) (*http.Request, error) {
+// NewGetAlertRuleRequest generates requests for GetAlertRule
+func NewGetAlertRuleRequest(server string, sourceType string, ruleName string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sourceType", runtime.ParamLocationPath, sourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, ruleName)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/health")
+	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/sources/%s/rules/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1163,791 +1253,3563 @@ func NewHealthRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewUpdateAlertRuleRequest calls the generic UpdateAlertRule builder with application/json body
+func NewUpdateAlertRuleRequest(server string, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAlertRuleRequestWithBody(server, sourceType, ruleName, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewUpdateAlertRuleRequestWithBody generates requests for UpdateAlertRule with any type of body
+func NewUpdateAlertRuleRequestWithBody(server string, sourceType string, ruleName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sourceType", runtime.ParamLocationPath, sourceType)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, ruleName)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// QueryEventsWithBodyWithResponse request with any body
-	QueryEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryEventsResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	QueryEventsWithResponse(ctx context.Context, body QueryEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryEventsResp, error)
+	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/sources/%s/rules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// QueryLogsWithBodyWithResponse request with any body
-	QueryLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogsResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	QueryLogsWithResponse(ctx context.Context, body QueryLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogsResp, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// QueryMetricsWithBodyWithResponse request with any body
-	QueryMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error)
+	req.Header.Add("Content-Type", contentType)
 
-	QueryMetricsWithResponse(ctx context.Context, body QueryMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error)
+	return req, nil
+}
 
-	// QueryAlertsWithBodyWithResponse request with any body
-	QueryAlertsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error)
+// NewHandleAlertWebhookRequest calls the generic HandleAlertWebhook builder with application/json body
+func NewHandleAlertWebhookRequest(server string, body HandleAlertWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHandleAlertWebhookRequestWithBody(server, "application/json", bodyReader)
+}
 
-	QueryAlertsWithResponse(ctx context.Context, body QueryAlertsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error)
+// NewHandleAlertWebhookRequestWithBody generates requests for HandleAlertWebhook with any type of body
+func NewHandleAlertWebhookRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// CreateAlertRuleWithBodyWithResponse request with any body
-	CreateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateAlertRuleWithResponse(ctx context.Context, sourceType string, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+	operationPath := fmt.Sprintf("/api/v1alpha1/alerts/webhook")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteAlertRuleWithResponse request
-	DeleteAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAlertRuleWithResponse request
-	GetAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*GetAlertRuleResp, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateAlertRuleWithBodyWithResponse request with any body
-	UpdateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, ruleName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+	req.Header.Add("Content-Type", contentType)
 
-	UpdateAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+	return req, nil
+}
 
-	// HandleAlertWebhookWithBodyWithResponse request with any body
-	HandleAlertWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error)
+// NewDetectAnomaliesRequest calls the generic DetectAnomalies builder with application/json body
+func NewDetectAnomaliesRequest(server string, body DetectAnomaliesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDetectAnomaliesRequestWithBody(server, "application/json", bodyReader)
+}
 
-	HandleAlertWebhookWithResponse(ctx context.Context, body HandleAlertWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error)
+// NewDetectAnomaliesRequestWithBody generates requests for DetectAnomalies with any type of body
+func NewDetectAnomaliesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// QueryIncidentsWithBodyWithResponse request with any body
-	QueryIncidentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	QueryIncidentsWithResponse(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error)
+	operationPath := fmt.Sprintf("/api/v1alpha1/anomalies/detect")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateIncidentWithBodyWithResponse request with any body
-	UpdateIncidentWithBodyWithResponse(ctx context.Context, incidentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateIncidentWithResponse(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// QueryRuntimeTopologyWithBodyWithResponse request with any body
-	QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error)
+	req.Header.Add("Content-Type", contentType)
 
-	QueryRuntimeTopologyWithResponse(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error)
+	return req, nil
+}
 
-	// QueryTracesWithBodyWithResponse request with any body
-	QueryTracesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTracesResp, error)
+// NewQueryCorrelationsRequest calls the generic QueryCorrelations builder with application/json body
+func NewQueryCorrelationsRequest(server string, body QueryCorrelationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryCorrelationsRequestWithBody(server, "application/json", bodyReader)
+}
 
-	QueryTracesWithResponse(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTracesResp, error)
+// NewQueryCorrelationsRequestWithBody generates requests for QueryCorrelations with any type of body
+func NewQueryCorrelationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// QuerySpansForTraceWithBodyWithResponse request with any body
-	QuerySpansForTraceWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	QuerySpansForTraceWithResponse(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error)
+	operationPath := fmt.Sprintf("/api/v1alpha1/correlations/query")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetSpanDetailsForTraceWithResponse request
-	GetSpanDetailsForTraceWithResponse(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*GetSpanDetailsForTraceResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// HealthWithResponse request
-	HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResp, error)
-}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-type QueryEventsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *EventsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-	JSON501      *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r QueryEventsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryEventsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewDeleteExportRequest generates requests for DeleteExport
+func NewDeleteExportRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
 
-type QueryLogsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LogsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r QueryLogsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryLogsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type QueryMetricsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MetricsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r QueryMetricsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryMetricsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type QueryAlertsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1alpha1/exports/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r QueryAlertsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryAlertsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AlertingRuleSyncResponse
-	JSON400      *ErrorResponse
-	JSON409      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpsertExportRequest calls the generic UpsertExport builder with application/json body
+func NewUpsertExportRequest(server string, namespace string, name string, body UpsertExportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpsertExportRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpsertExportRequestWithBody generates requests for UpsertExport with any type of body
+func NewUpsertExportRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertingRuleSyncResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertRuleResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1alpha1/exports/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertingRuleSyncResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r UpdateAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewQueryFederatedLogsRequest calls the generic QueryFederatedLogs builder with application/json body
+func NewQueryFederatedLogsRequest(server string, body QueryFederatedLogsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryFederatedLogsRequestWithBody(server, "application/json", bodyReader)
 }
 
-type HandleAlertWebhookResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertWebhookResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+// NewQueryFederatedLogsRequestWithBody generates requests for QueryFederatedLogs with any type of body
+func NewQueryFederatedLogsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r HandleAlertWebhookResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HandleAlertWebhookResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1alpha1/federation/logs/query")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type QueryIncidentsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IncidentsQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r QueryIncidentsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryIncidentsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewQueryFederatedMetricsRequest calls the generic QueryFederatedMetrics builder with application/json body
+func NewQueryFederatedMetricsRequest(server string, body QueryFederatedMetricsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryFederatedMetricsRequestWithBody(server, "application/json", bodyReader)
 }
 
-type UpdateIncidentResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IncidentPutResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+// NewQueryFederatedMetricsRequestWithBody generates requests for QueryFederatedMetrics with any type of body
+func NewQueryFederatedMetricsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r UpdateIncidentResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateIncidentResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1alpha1/federation/metrics/query")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type QueryRuntimeTopologyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RuntimeTopologyResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r QueryRuntimeTopologyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryRuntimeTopologyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type QueryTracesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TracesQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r QueryTracesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryTracesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewQueryIncidentsRequest calls the generic QueryIncidents builder with application/json body
+func NewQueryIncidentsRequest(server string, body QueryIncidentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type QuerySpansForTraceResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TraceSpansQueryResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryIncidentsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r QuerySpansForTraceResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewQueryIncidentsRequestWithBody generates requests for QueryIncidents with any type of body
+func NewQueryIncidentsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QuerySpansForTraceResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetSpanDetailsForTraceResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TraceSpanDetailsResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON500      *ErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r GetSpanDetailsForTraceResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1alpha1/incidents/query")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSpanDetailsForTraceResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type HealthResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-	}
-	JSON503 *struct {
-		Error  *string `json:"error,omitempty"`
-		Status *string `json:"status,omitempty"`
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r HealthResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-// QueryEventsWithBodyWithResponse request with arbitrary body returning *QueryEventsResp
-func (c *ClientWithResponses) QueryEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryEventsResp, error) {
-	rsp, err := c.QueryEventsWithBody(ctx, contentType, body, reqEditors...)
+// NewUpdateIncidentRequest calls the generic UpdateIncident builder with application/json body
+func NewUpdateIncidentRequest(server string, incidentId string, body UpdateIncidentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryEventsResp(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateIncidentRequestWithBody(server, incidentId, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) QueryEventsWithResponse(ctx context.Context, body QueryEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryEventsResp, error) {
-	rsp, err := c.QueryEvents(ctx, body, reqEditors...)
+// NewUpdateIncidentRequestWithBody generates requests for UpdateIncident with any type of body
+func NewUpdateIncidentRequestWithBody(server string, incidentId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "incidentId", runtime.ParamLocationPath, incidentId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryEventsResp(rsp)
-}
 
-// QueryLogsWithBodyWithResponse request with arbitrary body returning *QueryLogsResp
-func (c *ClientWithResponses) QueryLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogsResp, error) {
-	rsp, err := c.QueryLogsWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryLogsResp(rsp)
-}
 
-func (c *ClientWithResponses) QueryLogsWithResponse(ctx context.Context, body QueryLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogsResp, error) {
-	rsp, err := c.QueryLogs(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/api/v1alpha1/incidents/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseQueryLogsResp(rsp)
-}
 
-// QueryMetricsWithBodyWithResponse request with arbitrary body returning *QueryMetricsResp
-func (c *ClientWithResponses) QueryMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error) {
-	rsp, err := c.QueryMetricsWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryMetricsResp(rsp)
-}
 
-func (c *ClientWithResponses) QueryMetricsWithResponse(ctx context.Context, body QueryMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error) {
-	rsp, err := c.QueryMetrics(ctx, body, reqEditors...)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryMetricsResp(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// QueryAlertsWithBodyWithResponse request with arbitrary body returning *QueryAlertsResp
-func (c *ClientWithResponses) QueryAlertsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error) {
-	rsp, err := c.QueryAlertsWithBody(ctx, contentType, body, reqEditors...)
+// NewGetIdleWorkloadsRequest calls the generic GetIdleWorkloads builder with application/json body
+func NewGetIdleWorkloadsRequest(server string, body GetIdleWorkloadsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryAlertsResp(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewGetIdleWorkloadsRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) QueryAlertsWithResponse(ctx context.Context, body QueryAlertsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error) {
-	rsp, err := c.QueryAlerts(ctx, body, reqEditors...)
+// NewGetIdleWorkloadsRequestWithBody generates requests for GetIdleWorkloads with any type of body
+func NewGetIdleWorkloadsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryAlertsResp(rsp)
-}
 
-// CreateAlertRuleWithBodyWithResponse request with arbitrary body returning *CreateAlertRuleResp
-func (c *ClientWithResponses) CreateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
-	rsp, err := c.CreateAlertRuleWithBody(ctx, sourceType, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1alpha1/metrics/idle-workloads")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAlertRuleResp(rsp)
-}
 
-func (c *ClientWithResponses) CreateAlertRuleWithResponse(ctx context.Context, sourceType string, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
-	rsp, err := c.CreateAlertRule(ctx, sourceType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAlertRuleResp(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// DeleteAlertRuleWithResponse request returning *DeleteAlertRuleResp
-func (c *ClientWithResponses) DeleteAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error) {
-	rsp, err := c.DeleteAlertRule(ctx, sourceType, ruleName, reqEditors...)
+// NewQueryLogMetricRequest calls the generic QueryLogMetric builder with application/json body
+func NewQueryLogMetricRequest(server string, body QueryLogMetricJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAlertRuleResp(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryLogMetricRequestWithBody(server, "application/json", bodyReader)
 }
 
-// GetAlertRuleWithResponse request returning *GetAlertRuleResp
-func (c *ClientWithResponses) GetAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*GetAlertRuleResp, error) {
-	rsp, err := c.GetAlertRule(ctx, sourceType, ruleName, reqEditors...)
+// NewQueryLogMetricRequestWithBody generates requests for QueryLogMetric with any type of body
+func NewQueryLogMetricRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAlertRuleResp(rsp)
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/metrics/log-metrics/query")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// UpdateAlertRuleWithBodyWithResponse request with arbitrary body returning *UpdateAlertRuleResp
-func (c *ClientWithResponses) UpdateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, ruleName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
-	rsp, err := c.UpdateAlertRuleWithBody(ctx, sourceType, ruleName, contentType, body, reqEditors...)
+// NewRecommendResourcesRequest calls the generic RecommendResources builder with application/json body
+func NewRecommendResourcesRequest(server string, body RecommendResourcesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAlertRuleResp(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewRecommendResourcesRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) UpdateAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
-	rsp, err := c.UpdateAlertRule(ctx, sourceType, ruleName, body, reqEditors...)
+// NewRecommendResourcesRequestWithBody generates requests for RecommendResources with any type of body
+func NewRecommendResourcesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAlertRuleResp(rsp)
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/metrics/resource-recommendations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// HandleAlertWebhookWithBodyWithResponse request with arbitrary body returning *HandleAlertWebhookResp
-func (c *ClientWithResponses) HandleAlertWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error) {
-	rsp, err := c.HandleAlertWebhookWithBody(ctx, contentType, body, reqEditors...)
+// NewQueryRuntimeTopologyRequest calls the generic QueryRuntimeTopology builder with application/json body
+func NewQueryRuntimeTopologyRequest(server string, body QueryRuntimeTopologyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHandleAlertWebhookResp(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryRuntimeTopologyRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) HandleAlertWebhookWithResponse(ctx context.Context, body HandleAlertWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error) {
-	rsp, err := c.HandleAlertWebhook(ctx, body, reqEditors...)
+// NewQueryRuntimeTopologyRequestWithBody generates requests for QueryRuntimeTopology with any type of body
+func NewQueryRuntimeTopologyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHandleAlertWebhookResp(rsp)
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/metrics/runtime-topology")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// QueryIncidentsWithBodyWithResponse request with arbitrary body returning *QueryIncidentsResp
-func (c *ClientWithResponses) QueryIncidentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error) {
-	rsp, err := c.QueryIncidentsWithBody(ctx, contentType, body, reqEditors...)
+// NewNotifyPromotionRequest calls the generic NotifyPromotion builder with application/json body
+func NewNotifyPromotionRequest(server string, body NotifyPromotionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryIncidentsResp(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewNotifyPromotionRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) QueryIncidentsWithResponse(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error) {
-	rsp, err := c.QueryIncidents(ctx, body, reqEditors...)
+// NewNotifyPromotionRequestWithBody generates requests for NotifyPromotion with any type of body
+func NewNotifyPromotionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryIncidentsResp(rsp)
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/notifications/promotions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// UpdateIncidentWithBodyWithResponse request with arbitrary body returning *UpdateIncidentResp
-func (c *ClientWithResponses) UpdateIncidentWithBodyWithResponse(ctx context.Context, incidentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error) {
-	rsp, err := c.UpdateIncidentWithBody(ctx, incidentId, contentType, body, reqEditors...)
+// NewCreateSavedQueryRequest calls the generic CreateSavedQuery builder with application/json body
+func NewCreateSavedQueryRequest(server string, body CreateSavedQueryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateIncidentResp(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSavedQueryRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) UpdateIncidentWithResponse(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error) {
-	rsp, err := c.UpdateIncident(ctx, incidentId, body, reqEditors...)
+// NewCreateSavedQueryRequestWithBody generates requests for CreateSavedQuery with any type of body
+func NewCreateSavedQueryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateIncidentResp(rsp)
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/queries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// QueryRuntimeTopologyWithBodyWithResponse request with arbitrary body returning *QueryRuntimeTopologyResp
-func (c *ClientWithResponses) QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error) {
-	rsp, err := c.QueryRuntimeTopologyWithBody(ctx, contentType, body, reqEditors...)
+// NewListSavedQueriesRequest generates requests for ListSavedQueries
+func NewListSavedQueriesRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryRuntimeTopologyResp(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/queries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) QueryRuntimeTopologyWithResponse(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error) {
-	rsp, err := c.QueryRuntimeTopology(ctx, body, reqEditors...)
+// NewDeleteSavedQueryRequest generates requests for DeleteSavedQuery
+func NewDeleteSavedQueryRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryRuntimeTopologyResp(rsp)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/queries/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// QueryTracesWithBodyWithResponse request with arbitrary body returning *QueryTracesResp
-func (c *ClientWithResponses) QueryTracesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTracesResp, error) {
-	rsp, err := c.QueryTracesWithBody(ctx, contentType, body, reqEditors...)
+// NewGetSavedQueryRequest generates requests for GetSavedQuery
+func NewGetSavedQueryRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryTracesResp(rsp)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/queries/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) QueryTracesWithResponse(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTracesResp, error) {
-	rsp, err := c.QueryTraces(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// NewRunSavedQueryRequest calls the generic RunSavedQuery builder with application/json body
+func NewRunSavedQueryRequest(server string, namespace string, name string, body RunSavedQueryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRunSavedQueryRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewRunSavedQueryRequestWithBody generates requests for RunSavedQuery with any type of body
+func NewRunSavedQueryRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/queries/%s/%s/run", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchOpenSearchRequest calls the generic SearchOpenSearch builder with application/json body
+func NewSearchOpenSearchRequest(server string, body SearchOpenSearchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSearchOpenSearchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSearchOpenSearchRequestWithBody generates requests for SearchOpenSearch with any type of body
+func NewSearchOpenSearchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/raw/opensearch/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewQueryPromQLRequest calls the generic QueryPromQL builder with application/json body
+func NewQueryPromQLRequest(server string, body QueryPromQLJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryPromQLRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewQueryPromQLRequestWithBody generates requests for QueryPromQL with any type of body
+func NewQueryPromQLRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/raw/promql/query")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEvaluateErrorBudgetRequest calls the generic EvaluateErrorBudget builder with application/json body
+func NewEvaluateErrorBudgetRequest(server string, body EvaluateErrorBudgetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEvaluateErrorBudgetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEvaluateErrorBudgetRequestWithBody generates requests for EvaluateErrorBudget with any type of body
+func NewEvaluateErrorBudgetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/slos/error-budget")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewQueryTracesRequest calls the generic QueryTraces builder with application/json body
+func NewQueryTracesRequest(server string, body QueryTracesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryTracesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewQueryTracesRequestWithBody generates requests for QueryTraces with any type of body
+func NewQueryTracesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/traces/query")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewQuerySpansForTraceRequest calls the generic QuerySpansForTrace builder with application/json body
+func NewQuerySpansForTraceRequest(server string, traceId string, body QuerySpansForTraceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQuerySpansForTraceRequestWithBody(server, traceId, "application/json", bodyReader)
+}
+
+// NewQuerySpansForTraceRequestWithBody generates requests for QuerySpansForTrace with any type of body
+func NewQuerySpansForTraceRequestWithBody(server string, traceId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "traceId", runtime.ParamLocationPath, traceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/traces/%s/spans/query", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSpanDetailsForTraceRequest generates requests for GetSpanDetailsForTrace
+func NewGetSpanDetailsForTraceRequest(server string, traceId string, spanId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "traceId", runtime.ParamLocationPath, traceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "spanId", runtime.ParamLocationPath, spanId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/traces/%s/spans/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHealthRequest generates requests for Health
+func NewHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// QueryEventsWithBodyWithResponse request with any body
+	QueryEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryEventsResp, error)
+
+	QueryEventsWithResponse(ctx context.Context, body QueryEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryEventsResp, error)
+
+	// QueryLogsWithBodyWithResponse request with any body
+	QueryLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogsResp, error)
+
+	QueryLogsWithResponse(ctx context.Context, body QueryLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogsResp, error)
+
+	// QueryMetricsWithBodyWithResponse request with any body
+	QueryMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error)
+
+	QueryMetricsWithResponse(ctx context.Context, body QueryMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error)
+
+	// QueryAlertsWithBodyWithResponse request with any body
+	QueryAlertsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error)
+
+	QueryAlertsWithResponse(ctx context.Context, body QueryAlertsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error)
+
+	// CreateAlertRuleWithBodyWithResponse request with any body
+	CreateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+
+	CreateAlertRuleWithResponse(ctx context.Context, sourceType string, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+
+	// DeleteAlertRuleWithResponse request
+	DeleteAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error)
+
+	// GetAlertRuleWithResponse request
+	GetAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*GetAlertRuleResp, error)
+
+	// UpdateAlertRuleWithBodyWithResponse request with any body
+	UpdateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, ruleName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+
+	UpdateAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+
+	// HandleAlertWebhookWithBodyWithResponse request with any body
+	HandleAlertWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error)
+
+	HandleAlertWebhookWithResponse(ctx context.Context, body HandleAlertWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error)
+
+	// DetectAnomaliesWithBodyWithResponse request with any body
+	DetectAnomaliesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DetectAnomaliesResp, error)
+
+	DetectAnomaliesWithResponse(ctx context.Context, body DetectAnomaliesJSONRequestBody, reqEditors ...RequestEditorFn) (*DetectAnomaliesResp, error)
+
+	// QueryCorrelationsWithBodyWithResponse request with any body
+	QueryCorrelationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryCorrelationsResp, error)
+
+	QueryCorrelationsWithResponse(ctx context.Context, body QueryCorrelationsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryCorrelationsResp, error)
+
+	// DeleteExportWithResponse request
+	DeleteExportWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteExportResp, error)
+
+	// UpsertExportWithBodyWithResponse request with any body
+	UpsertExportWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertExportResp, error)
+
+	UpsertExportWithResponse(ctx context.Context, namespace string, name string, body UpsertExportJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertExportResp, error)
+
+	// QueryFederatedLogsWithBodyWithResponse request with any body
+	QueryFederatedLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryFederatedLogsResp, error)
+
+	QueryFederatedLogsWithResponse(ctx context.Context, body QueryFederatedLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryFederatedLogsResp, error)
+
+	// QueryFederatedMetricsWithBodyWithResponse request with any body
+	QueryFederatedMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryFederatedMetricsResp, error)
+
+	QueryFederatedMetricsWithResponse(ctx context.Context, body QueryFederatedMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryFederatedMetricsResp, error)
+
+	// QueryIncidentsWithBodyWithResponse request with any body
+	QueryIncidentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error)
+
+	QueryIncidentsWithResponse(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error)
+
+	// UpdateIncidentWithBodyWithResponse request with any body
+	UpdateIncidentWithBodyWithResponse(ctx context.Context, incidentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error)
+
+	UpdateIncidentWithResponse(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error)
+
+	// GetIdleWorkloadsWithBodyWithResponse request with any body
+	GetIdleWorkloadsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetIdleWorkloadsResp, error)
+
+	GetIdleWorkloadsWithResponse(ctx context.Context, body GetIdleWorkloadsJSONRequestBody, reqEditors ...RequestEditorFn) (*GetIdleWorkloadsResp, error)
+
+	// QueryLogMetricWithBodyWithResponse request with any body
+	QueryLogMetricWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogMetricResp, error)
+
+	QueryLogMetricWithResponse(ctx context.Context, body QueryLogMetricJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogMetricResp, error)
+
+	// RecommendResourcesWithBodyWithResponse request with any body
+	RecommendResourcesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecommendResourcesResp, error)
+
+	RecommendResourcesWithResponse(ctx context.Context, body RecommendResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*RecommendResourcesResp, error)
+
+	// QueryRuntimeTopologyWithBodyWithResponse request with any body
+	QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error)
+
+	QueryRuntimeTopologyWithResponse(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error)
+
+	// NotifyPromotionWithBodyWithResponse request with any body
+	NotifyPromotionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotifyPromotionResp, error)
+
+	NotifyPromotionWithResponse(ctx context.Context, body NotifyPromotionJSONRequestBody, reqEditors ...RequestEditorFn) (*NotifyPromotionResp, error)
+
+	// CreateSavedQueryWithBodyWithResponse request with any body
+	CreateSavedQueryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSavedQueryResp, error)
+
+	CreateSavedQueryWithResponse(ctx context.Context, body CreateSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSavedQueryResp, error)
+
+	// ListSavedQueriesWithResponse request
+	ListSavedQueriesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListSavedQueriesResp, error)
+
+	// DeleteSavedQueryWithResponse request
+	DeleteSavedQueryWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteSavedQueryResp, error)
+
+	// GetSavedQueryWithResponse request
+	GetSavedQueryWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetSavedQueryResp, error)
+
+	// RunSavedQueryWithBodyWithResponse request with any body
+	RunSavedQueryWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunSavedQueryResp, error)
+
+	RunSavedQueryWithResponse(ctx context.Context, namespace string, name string, body RunSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*RunSavedQueryResp, error)
+
+	// SearchOpenSearchWithBodyWithResponse request with any body
+	SearchOpenSearchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchOpenSearchResp, error)
+
+	SearchOpenSearchWithResponse(ctx context.Context, body SearchOpenSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchOpenSearchResp, error)
+
+	// QueryPromQLWithBodyWithResponse request with any body
+	QueryPromQLWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryPromQLResp, error)
+
+	QueryPromQLWithResponse(ctx context.Context, body QueryPromQLJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryPromQLResp, error)
+
+	// EvaluateErrorBudgetWithBodyWithResponse request with any body
+	EvaluateErrorBudgetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EvaluateErrorBudgetResp, error)
+
+	EvaluateErrorBudgetWithResponse(ctx context.Context, body EvaluateErrorBudgetJSONRequestBody, reqEditors ...RequestEditorFn) (*EvaluateErrorBudgetResp, error)
+
+	// QueryTracesWithBodyWithResponse request with any body
+	QueryTracesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTracesResp, error)
+
+	QueryTracesWithResponse(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTracesResp, error)
+
+	// QuerySpansForTraceWithBodyWithResponse request with any body
+	QuerySpansForTraceWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error)
+
+	QuerySpansForTraceWithResponse(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error)
+
+	// GetSpanDetailsForTraceWithResponse request
+	GetSpanDetailsForTraceWithResponse(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*GetSpanDetailsForTraceResp, error)
+
+	// HealthWithResponse request
+	HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResp, error)
+}
+
+type QueryEventsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+	JSON501      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryEventsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryEventsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryLogsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryLogsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryLogsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryMetricsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetricsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryMetricsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryMetricsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryAlertsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryAlertsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryAlertsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AlertingRuleSyncResponse
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertingRuleSyncResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertRuleResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertingRuleSyncResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HandleAlertWebhookResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertWebhookResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r HandleAlertWebhookResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HandleAlertWebhookResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DetectAnomaliesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AnomalyDetectionResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DetectAnomaliesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DetectAnomaliesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryCorrelationsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CorrelationQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryCorrelationsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryCorrelationsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteExportResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteExportResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteExportResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpsertExportResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExportStatus
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpsertExportResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertExportResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryFederatedLogsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FederatedLogsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryFederatedLogsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryFederatedLogsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryFederatedMetricsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FederatedMetricsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryFederatedMetricsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryFederatedMetricsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryIncidentsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IncidentsQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryIncidentsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryIncidentsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateIncidentResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IncidentPutResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateIncidentResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateIncidentResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIdleWorkloadsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdleWorkloadsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIdleWorkloadsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIdleWorkloadsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryLogMetricResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogMetricQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryLogMetricResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryLogMetricResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecommendResourcesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResourceRecommendationResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RecommendResourcesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecommendResourcesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryRuntimeTopologyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RuntimeTopologyResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryRuntimeTopologyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryRuntimeTopologyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotifyPromotionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r NotifyPromotionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotifyPromotionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSavedQueryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SavedQuery
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSavedQueryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSavedQueryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSavedQueriesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SavedQueryListResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSavedQueriesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSavedQueriesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSavedQueryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSavedQueryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSavedQueryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSavedQueryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SavedQuery
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSavedQueryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSavedQueryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunSavedQueryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RunSavedQueryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunSavedQueryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchOpenSearchResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenSearchQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchOpenSearchResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchOpenSearchResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryPromQLResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PromQLQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryPromQLResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryPromQLResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EvaluateErrorBudgetResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ErrorBudgetResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EvaluateErrorBudgetResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EvaluateErrorBudgetResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryTracesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TracesQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryTracesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryTracesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QuerySpansForTraceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TraceSpansQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QuerySpansForTraceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QuerySpansForTraceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpanDetailsForTraceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TraceSpanDetailsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpanDetailsForTraceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpanDetailsForTraceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	JSON503 *struct {
+		Error  *string `json:"error,omitempty"`
+		Status *string `json:"status,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// QueryEventsWithBodyWithResponse request with arbitrary body returning *QueryEventsResp
+func (c *ClientWithResponses) QueryEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryEventsResp, error) {
+	rsp, err := c.QueryEventsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryEventsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryEventsWithResponse(ctx context.Context, body QueryEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryEventsResp, error) {
+	rsp, err := c.QueryEvents(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryEventsResp(rsp)
+}
+
+// QueryLogsWithBodyWithResponse request with arbitrary body returning *QueryLogsResp
+func (c *ClientWithResponses) QueryLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogsResp, error) {
+	rsp, err := c.QueryLogsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryLogsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryLogsWithResponse(ctx context.Context, body QueryLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogsResp, error) {
+	rsp, err := c.QueryLogs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryLogsResp(rsp)
+}
+
+// QueryMetricsWithBodyWithResponse request with arbitrary body returning *QueryMetricsResp
+func (c *ClientWithResponses) QueryMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error) {
+	rsp, err := c.QueryMetricsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryMetricsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryMetricsWithResponse(ctx context.Context, body QueryMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryMetricsResp, error) {
+	rsp, err := c.QueryMetrics(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryMetricsResp(rsp)
+}
+
+// QueryAlertsWithBodyWithResponse request with arbitrary body returning *QueryAlertsResp
+func (c *ClientWithResponses) QueryAlertsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error) {
+	rsp, err := c.QueryAlertsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryAlertsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryAlertsWithResponse(ctx context.Context, body QueryAlertsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryAlertsResp, error) {
+	rsp, err := c.QueryAlerts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryAlertsResp(rsp)
+}
+
+// CreateAlertRuleWithBodyWithResponse request with arbitrary body returning *CreateAlertRuleResp
+func (c *ClientWithResponses) CreateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
+	rsp, err := c.CreateAlertRuleWithBody(ctx, sourceType, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateAlertRuleWithResponse(ctx context.Context, sourceType string, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
+	rsp, err := c.CreateAlertRule(ctx, sourceType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertRuleResp(rsp)
+}
+
+// DeleteAlertRuleWithResponse request returning *DeleteAlertRuleResp
+func (c *ClientWithResponses) DeleteAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error) {
+	rsp, err := c.DeleteAlertRule(ctx, sourceType, ruleName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAlertRuleResp(rsp)
+}
+
+// GetAlertRuleWithResponse request returning *GetAlertRuleResp
+func (c *ClientWithResponses) GetAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, reqEditors ...RequestEditorFn) (*GetAlertRuleResp, error) {
+	rsp, err := c.GetAlertRule(ctx, sourceType, ruleName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertRuleResp(rsp)
+}
+
+// UpdateAlertRuleWithBodyWithResponse request with arbitrary body returning *UpdateAlertRuleResp
+func (c *ClientWithResponses) UpdateAlertRuleWithBodyWithResponse(ctx context.Context, sourceType string, ruleName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
+	rsp, err := c.UpdateAlertRuleWithBody(ctx, sourceType, ruleName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAlertRuleWithResponse(ctx context.Context, sourceType string, ruleName string, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
+	rsp, err := c.UpdateAlertRule(ctx, sourceType, ruleName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertRuleResp(rsp)
+}
+
+// HandleAlertWebhookWithBodyWithResponse request with arbitrary body returning *HandleAlertWebhookResp
+func (c *ClientWithResponses) HandleAlertWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error) {
+	rsp, err := c.HandleAlertWebhookWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHandleAlertWebhookResp(rsp)
+}
+
+func (c *ClientWithResponses) HandleAlertWebhookWithResponse(ctx context.Context, body HandleAlertWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*HandleAlertWebhookResp, error) {
+	rsp, err := c.HandleAlertWebhook(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHandleAlertWebhookResp(rsp)
+}
+
+// DetectAnomaliesWithBodyWithResponse request with arbitrary body returning *DetectAnomaliesResp
+func (c *ClientWithResponses) DetectAnomaliesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DetectAnomaliesResp, error) {
+	rsp, err := c.DetectAnomaliesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDetectAnomaliesResp(rsp)
+}
+
+func (c *ClientWithResponses) DetectAnomaliesWithResponse(ctx context.Context, body DetectAnomaliesJSONRequestBody, reqEditors ...RequestEditorFn) (*DetectAnomaliesResp, error) {
+	rsp, err := c.DetectAnomalies(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDetectAnomaliesResp(rsp)
+}
+
+// QueryCorrelationsWithBodyWithResponse request with arbitrary body returning *QueryCorrelationsResp
+func (c *ClientWithResponses) QueryCorrelationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryCorrelationsResp, error) {
+	rsp, err := c.QueryCorrelationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryCorrelationsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryCorrelationsWithResponse(ctx context.Context, body QueryCorrelationsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryCorrelationsResp, error) {
+	rsp, err := c.QueryCorrelations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryCorrelationsResp(rsp)
+}
+
+// DeleteExportWithResponse request returning *DeleteExportResp
+func (c *ClientWithResponses) DeleteExportWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteExportResp, error) {
+	rsp, err := c.DeleteExport(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteExportResp(rsp)
+}
+
+// UpsertExportWithBodyWithResponse request with arbitrary body returning *UpsertExportResp
+func (c *ClientWithResponses) UpsertExportWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertExportResp, error) {
+	rsp, err := c.UpsertExportWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertExportResp(rsp)
+}
+
+func (c *ClientWithResponses) UpsertExportWithResponse(ctx context.Context, namespace string, name string, body UpsertExportJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertExportResp, error) {
+	rsp, err := c.UpsertExport(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertExportResp(rsp)
+}
+
+// QueryFederatedLogsWithBodyWithResponse request with arbitrary body returning *QueryFederatedLogsResp
+func (c *ClientWithResponses) QueryFederatedLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryFederatedLogsResp, error) {
+	rsp, err := c.QueryFederatedLogsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryFederatedLogsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryFederatedLogsWithResponse(ctx context.Context, body QueryFederatedLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryFederatedLogsResp, error) {
+	rsp, err := c.QueryFederatedLogs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryFederatedLogsResp(rsp)
+}
+
+// QueryFederatedMetricsWithBodyWithResponse request with arbitrary body returning *QueryFederatedMetricsResp
+func (c *ClientWithResponses) QueryFederatedMetricsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryFederatedMetricsResp, error) {
+	rsp, err := c.QueryFederatedMetricsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryFederatedMetricsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryFederatedMetricsWithResponse(ctx context.Context, body QueryFederatedMetricsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryFederatedMetricsResp, error) {
+	rsp, err := c.QueryFederatedMetrics(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryFederatedMetricsResp(rsp)
+}
+
+// QueryIncidentsWithBodyWithResponse request with arbitrary body returning *QueryIncidentsResp
+func (c *ClientWithResponses) QueryIncidentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error) {
+	rsp, err := c.QueryIncidentsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryIncidentsResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryIncidentsWithResponse(ctx context.Context, body QueryIncidentsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryIncidentsResp, error) {
+	rsp, err := c.QueryIncidents(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryIncidentsResp(rsp)
+}
+
+// UpdateIncidentWithBodyWithResponse request with arbitrary body returning *UpdateIncidentResp
+func (c *ClientWithResponses) UpdateIncidentWithBodyWithResponse(ctx context.Context, incidentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error) {
+	rsp, err := c.UpdateIncidentWithBody(ctx, incidentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIncidentResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateIncidentWithResponse(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error) {
+	rsp, err := c.UpdateIncident(ctx, incidentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIncidentResp(rsp)
+}
+
+// GetIdleWorkloadsWithBodyWithResponse request with arbitrary body returning *GetIdleWorkloadsResp
+func (c *ClientWithResponses) GetIdleWorkloadsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetIdleWorkloadsResp, error) {
+	rsp, err := c.GetIdleWorkloadsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIdleWorkloadsResp(rsp)
+}
+
+func (c *ClientWithResponses) GetIdleWorkloadsWithResponse(ctx context.Context, body GetIdleWorkloadsJSONRequestBody, reqEditors ...RequestEditorFn) (*GetIdleWorkloadsResp, error) {
+	rsp, err := c.GetIdleWorkloads(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIdleWorkloadsResp(rsp)
+}
+
+// QueryLogMetricWithBodyWithResponse request with arbitrary body returning *QueryLogMetricResp
+func (c *ClientWithResponses) QueryLogMetricWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryLogMetricResp, error) {
+	rsp, err := c.QueryLogMetricWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryLogMetricResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryLogMetricWithResponse(ctx context.Context, body QueryLogMetricJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryLogMetricResp, error) {
+	rsp, err := c.QueryLogMetric(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryLogMetricResp(rsp)
+}
+
+// RecommendResourcesWithBodyWithResponse request with arbitrary body returning *RecommendResourcesResp
+func (c *ClientWithResponses) RecommendResourcesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecommendResourcesResp, error) {
+	rsp, err := c.RecommendResourcesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecommendResourcesResp(rsp)
+}
+
+func (c *ClientWithResponses) RecommendResourcesWithResponse(ctx context.Context, body RecommendResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*RecommendResourcesResp, error) {
+	rsp, err := c.RecommendResources(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecommendResourcesResp(rsp)
+}
+
+// QueryRuntimeTopologyWithBodyWithResponse request with arbitrary body returning *QueryRuntimeTopologyResp
+func (c *ClientWithResponses) QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error) {
+	rsp, err := c.QueryRuntimeTopologyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryRuntimeTopologyResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryRuntimeTopologyWithResponse(ctx context.Context, body QueryRuntimeTopologyJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error) {
+	rsp, err := c.QueryRuntimeTopology(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryRuntimeTopologyResp(rsp)
+}
+
+// NotifyPromotionWithBodyWithResponse request with arbitrary body returning *NotifyPromotionResp
+func (c *ClientWithResponses) NotifyPromotionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NotifyPromotionResp, error) {
+	rsp, err := c.NotifyPromotionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotifyPromotionResp(rsp)
+}
+
+func (c *ClientWithResponses) NotifyPromotionWithResponse(ctx context.Context, body NotifyPromotionJSONRequestBody, reqEditors ...RequestEditorFn) (*NotifyPromotionResp, error) {
+	rsp, err := c.NotifyPromotion(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotifyPromotionResp(rsp)
+}
+
+// CreateSavedQueryWithBodyWithResponse request with arbitrary body returning *CreateSavedQueryResp
+func (c *ClientWithResponses) CreateSavedQueryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSavedQueryResp, error) {
+	rsp, err := c.CreateSavedQueryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSavedQueryResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateSavedQueryWithResponse(ctx context.Context, body CreateSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSavedQueryResp, error) {
+	rsp, err := c.CreateSavedQuery(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSavedQueryResp(rsp)
+}
+
+// ListSavedQueriesWithResponse request returning *ListSavedQueriesResp
+func (c *ClientWithResponses) ListSavedQueriesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListSavedQueriesResp, error) {
+	rsp, err := c.ListSavedQueries(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSavedQueriesResp(rsp)
+}
+
+// DeleteSavedQueryWithResponse request returning *DeleteSavedQueryResp
+func (c *ClientWithResponses) DeleteSavedQueryWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteSavedQueryResp, error) {
+	rsp, err := c.DeleteSavedQuery(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSavedQueryResp(rsp)
+}
+
+// GetSavedQueryWithResponse request returning *GetSavedQueryResp
+func (c *ClientWithResponses) GetSavedQueryWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetSavedQueryResp, error) {
+	rsp, err := c.GetSavedQuery(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSavedQueryResp(rsp)
+}
+
+// RunSavedQueryWithBodyWithResponse request with arbitrary body returning *RunSavedQueryResp
+func (c *ClientWithResponses) RunSavedQueryWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunSavedQueryResp, error) {
+	rsp, err := c.RunSavedQueryWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunSavedQueryResp(rsp)
+}
+
+func (c *ClientWithResponses) RunSavedQueryWithResponse(ctx context.Context, namespace string, name string, body RunSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*RunSavedQueryResp, error) {
+	rsp, err := c.RunSavedQuery(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunSavedQueryResp(rsp)
+}
+
+// SearchOpenSearchWithBodyWithResponse request with arbitrary body returning *SearchOpenSearchResp
+func (c *ClientWithResponses) SearchOpenSearchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchOpenSearchResp, error) {
+	rsp, err := c.SearchOpenSearchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchOpenSearchResp(rsp)
+}
+
+func (c *ClientWithResponses) SearchOpenSearchWithResponse(ctx context.Context, body SearchOpenSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchOpenSearchResp, error) {
+	rsp, err := c.SearchOpenSearch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchOpenSearchResp(rsp)
+}
+
+// QueryPromQLWithBodyWithResponse request with arbitrary body returning *QueryPromQLResp
+func (c *ClientWithResponses) QueryPromQLWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryPromQLResp, error) {
+	rsp, err := c.QueryPromQLWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryPromQLResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryPromQLWithResponse(ctx context.Context, body QueryPromQLJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryPromQLResp, error) {
+	rsp, err := c.QueryPromQL(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryPromQLResp(rsp)
+}
+
+// EvaluateErrorBudgetWithBodyWithResponse request with arbitrary body returning *EvaluateErrorBudgetResp
+func (c *ClientWithResponses) EvaluateErrorBudgetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EvaluateErrorBudgetResp, error) {
+	rsp, err := c.EvaluateErrorBudgetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEvaluateErrorBudgetResp(rsp)
+}
+
+func (c *ClientWithResponses) EvaluateErrorBudgetWithResponse(ctx context.Context, body EvaluateErrorBudgetJSONRequestBody, reqEditors ...RequestEditorFn) (*EvaluateErrorBudgetResp, error) {
+	rsp, err := c.EvaluateErrorBudget(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEvaluateErrorBudgetResp(rsp)
+}
+
+// QueryTracesWithBodyWithResponse request with arbitrary body returning *QueryTracesResp
+func (c *ClientWithResponses) QueryTracesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryTracesResp, error) {
+	rsp, err := c.QueryTracesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryTracesResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryTracesWithResponse(ctx context.Context, body QueryTracesJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryTracesResp, error) {
+	rsp, err := c.QueryTraces(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryTracesResp(rsp)
+}
+
+// QuerySpansForTraceWithBodyWithResponse request with arbitrary body returning *QuerySpansForTraceResp
+func (c *ClientWithResponses) QuerySpansForTraceWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error) {
+	rsp, err := c.QuerySpansForTraceWithBody(ctx, traceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuerySpansForTraceResp(rsp)
+}
+
+func (c *ClientWithResponses) QuerySpansForTraceWithResponse(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error) {
+	rsp, err := c.QuerySpansForTrace(ctx, traceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuerySpansForTraceResp(rsp)
+}
+
+// GetSpanDetailsForTraceWithResponse request returning *GetSpanDetailsForTraceResp
+func (c *ClientWithResponses) GetSpanDetailsForTraceWithResponse(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*GetSpanDetailsForTraceResp, error) {
+	rsp, err := c.GetSpanDetailsForTrace(ctx, traceId, spanId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpanDetailsForTraceResp(rsp)
+}
+
+// HealthWithResponse request returning *HealthResp
+func (c *ClientWithResponses) HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResp, error) {
+	rsp, err := c.Health(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthResp(rsp)
+}
+
+// ParseQueryEventsResp parses an HTTP response from a QueryEventsWithResponse call
+func ParseQueryEventsResp(rsp *http.Response) (*QueryEventsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryEventsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryLogsResp parses an HTTP response from a QueryLogsWithResponse call
+func ParseQueryLogsResp(rsp *http.Response) (*QueryLogsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryLogsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryMetricsResp parses an HTTP response from a QueryMetricsWithResponse call
+func ParseQueryMetricsResp(rsp *http.Response) (*QueryMetricsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryMetricsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetricsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryAlertsResp parses an HTTP response from a QueryAlertsWithResponse call
+func ParseQueryAlertsResp(rsp *http.Response) (*QueryAlertsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryAlertsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAlertRuleResp parses an HTTP response from a CreateAlertRuleWithResponse call
+func ParseCreateAlertRuleResp(rsp *http.Response) (*CreateAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AlertingRuleSyncResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAlertRuleResp parses an HTTP response from a DeleteAlertRuleWithResponse call
+func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertingRuleSyncResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAlertRuleResp parses an HTTP response from a GetAlertRuleWithResponse call
+func ParseGetAlertRuleResp(rsp *http.Response) (*GetAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertRuleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAlertRuleResp parses an HTTP response from a UpdateAlertRuleWithResponse call
+func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertingRuleSyncResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHandleAlertWebhookResp parses an HTTP response from a HandleAlertWebhookWithResponse call
+func ParseHandleAlertWebhookResp(rsp *http.Response) (*HandleAlertWebhookResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HandleAlertWebhookResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertWebhookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDetectAnomaliesResp parses an HTTP response from a DetectAnomaliesWithResponse call
+func ParseDetectAnomaliesResp(rsp *http.Response) (*DetectAnomaliesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DetectAnomaliesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AnomalyDetectionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryCorrelationsResp parses an HTTP response from a QueryCorrelationsWithResponse call
+func ParseQueryCorrelationsResp(rsp *http.Response) (*QueryCorrelationsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryCorrelationsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CorrelationQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteExportResp parses an HTTP response from a DeleteExportWithResponse call
+func ParseDeleteExportResp(rsp *http.Response) (*DeleteExportResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteExportResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpsertExportResp parses an HTTP response from a UpsertExportWithResponse call
+func ParseUpsertExportResp(rsp *http.Response) (*UpsertExportResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpsertExportResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExportStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryFederatedLogsResp parses an HTTP response from a QueryFederatedLogsWithResponse call
+func ParseQueryFederatedLogsResp(rsp *http.Response) (*QueryFederatedLogsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryFederatedLogsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FederatedLogsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryFederatedMetricsResp parses an HTTP response from a QueryFederatedMetricsWithResponse call
+func ParseQueryFederatedMetricsResp(rsp *http.Response) (*QueryFederatedMetricsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryFederatedMetricsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FederatedMetricsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryIncidentsResp parses an HTTP response from a QueryIncidentsWithResponse call
+func ParseQueryIncidentsResp(rsp *http.Response) (*QueryIncidentsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryIncidentsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IncidentsQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseQueryTracesResp(rsp)
-}
 
-// QuerySpansForTraceWithBodyWithResponse request with arbitrary body returning *QuerySpansForTraceResp
-func (c *ClientWithResponses) QuerySpansForTraceWithBodyWithResponse(ctx context.Context, traceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error) {
-	rsp, err := c.QuerySpansForTraceWithBody(ctx, traceId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseQuerySpansForTraceResp(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) QuerySpansForTraceWithResponse(ctx context.Context, traceId string, body QuerySpansForTraceJSONRequestBody, reqEditors ...RequestEditorFn) (*QuerySpansForTraceResp, error) {
-	rsp, err := c.QuerySpansForTrace(ctx, traceId, body, reqEditors...)
+// ParseUpdateIncidentResp parses an HTTP response from a UpdateIncidentWithResponse call
+func ParseUpdateIncidentResp(rsp *http.Response) (*UpdateIncidentResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseQuerySpansForTraceResp(rsp)
-}
 
-// GetSpanDetailsForTraceWithResponse request returning *GetSpanDetailsForTraceResp
-func (c *ClientWithResponses) GetSpanDetailsForTraceWithResponse(ctx context.Context, traceId string, spanId string, reqEditors ...RequestEditorFn) (*GetSpanDetailsForTraceResp, error) {
-	rsp, err := c.GetSpanDetailsForTrace(ctx, traceId, spanId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateIncidentResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetSpanDetailsForTraceResp(rsp)
-}
 
-// HealthWithResponse request returning *HealthResp
-func (c *ClientWithResponses) HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResp, error) {
-	rsp, err := c.Health(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IncidentPutResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseHealthResp(rsp)
+
+	return response, nil
 }
 
-// ParseQueryEventsResp parses an HTTP response from a QueryEventsWithResponse call
-func ParseQueryEventsResp(rsp *http.Response) (*QueryEventsResp, error) {
+// ParseGetIdleWorkloadsResp parses an HTTP response from a GetIdleWorkloadsWithResponse call
+func ParseGetIdleWorkloadsResp(rsp *http.Response) (*GetIdleWorkloadsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryEventsResp{
+	response := &GetIdleWorkloadsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventsQueryResponse
+		var dest IdleWorkloadsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1981,34 +4843,34 @@ func ParseQueryEventsResp(rsp *http.Response) (*QueryEventsResp, error) {
 		}
 		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON501 = &dest
+		response.JSON503 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseQueryLogsResp parses an HTTP response from a QueryLogsWithResponse call
-func ParseQueryLogsResp(rsp *http.Response) (*QueryLogsResp, error) {
+// ParseQueryLogMetricResp parses an HTTP response from a QueryLogMetricWithResponse call
+func ParseQueryLogMetricResp(rsp *http.Response) (*QueryLogMetricResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryLogsResp{
+	response := &QueryLogMetricResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LogsQueryResponse
+		var dest LogMetricQueryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2047,22 +4909,22 @@ func ParseQueryLogsResp(rsp *http.Response) (*QueryLogsResp, error) {
 	return response, nil
 }
 
-// ParseQueryMetricsResp parses an HTTP response from a QueryMetricsWithResponse call
-func ParseQueryMetricsResp(rsp *http.Response) (*QueryMetricsResp, error) {
+// ParseRecommendResourcesResp parses an HTTP response from a RecommendResourcesWithResponse call
+func ParseRecommendResourcesResp(rsp *http.Response) (*RecommendResourcesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryMetricsResp{
+	response := &RecommendResourcesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MetricsQueryResponse
+		var dest ResourceRecommendationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2101,22 +4963,22 @@ func ParseQueryMetricsResp(rsp *http.Response) (*QueryMetricsResp, error) {
 	return response, nil
 }
 
-// ParseQueryAlertsResp parses an HTTP response from a QueryAlertsWithResponse call
-func ParseQueryAlertsResp(rsp *http.Response) (*QueryAlertsResp, error) {
+// ParseQueryRuntimeTopologyResp parses an HTTP response from a QueryRuntimeTopologyWithResponse call
+func ParseQueryRuntimeTopologyResp(rsp *http.Response) (*QueryRuntimeTopologyResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryAlertsResp{
+	response := &QueryRuntimeTopologyResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertsQueryResponse
+		var dest RuntimeTopologyResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2155,22 +5017,55 @@ func ParseQueryAlertsResp(rsp *http.Response) (*QueryAlertsResp, error) {
 	return response, nil
 }
 
-// ParseCreateAlertRuleResp parses an HTTP response from a CreateAlertRuleWithResponse call
-func ParseCreateAlertRuleResp(rsp *http.Response) (*CreateAlertRuleResp, error) {
+// ParseNotifyPromotionResp parses an HTTP response from a NotifyPromotionWithResponse call
+func ParseNotifyPromotionResp(rsp *http.Response) (*NotifyPromotionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAlertRuleResp{
+	response := &NotifyPromotionResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSavedQueryResp parses an HTTP response from a CreateSavedQueryWithResponse call
+func ParseCreateSavedQueryResp(rsp *http.Response) (*CreateSavedQueryResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSavedQueryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AlertingRuleSyncResponse
+		var dest SavedQuery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2183,6 +5078,20 @@ func ParseCreateAlertRuleResp(rsp *http.Response) (*CreateAlertRuleResp, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2202,40 +5111,40 @@ func ParseCreateAlertRuleResp(rsp *http.Response) (*CreateAlertRuleResp, error)
 	return response, nil
 }
 
-// ParseDeleteAlertRuleResp parses an HTTP response from a DeleteAlertRuleWithResponse call
-func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error) {
+// ParseListSavedQueriesResp parses an HTTP response from a ListSavedQueriesWithResponse call
+func ParseListSavedQueriesResp(rsp *http.Response) (*ListSavedQueriesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAlertRuleResp{
+	response := &ListSavedQueriesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertingRuleSyncResponse
+		var dest SavedQueryListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -2249,33 +5158,40 @@ func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error)
 	return response, nil
 }
 
-// ParseGetAlertRuleResp parses an HTTP response from a GetAlertRuleWithResponse call
-func ParseGetAlertRuleResp(rsp *http.Response) (*GetAlertRuleResp, error) {
+// ParseDeleteSavedQueryResp parses an HTTP response from a DeleteSavedQueryWithResponse call
+func ParseDeleteSavedQueryResp(rsp *http.Response) (*DeleteSavedQueryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAlertRuleResp{
+	response := &DeleteSavedQueryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertRuleResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
@@ -2296,22 +5212,22 @@ func ParseGetAlertRuleResp(rsp *http.Response) (*GetAlertRuleResp, error) {
 	return response, nil
 }
 
-// ParseUpdateAlertRuleResp parses an HTTP response from a UpdateAlertRuleWithResponse call
-func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error) {
+// ParseGetSavedQueryResp parses an HTTP response from a GetSavedQueryWithResponse call
+func ParseGetSavedQueryResp(rsp *http.Response) (*GetSavedQueryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAlertRuleResp{
+	response := &GetSavedQueryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertingRuleSyncResponse
+		var dest SavedQuery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2324,6 +5240,27 @@ func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2336,22 +5273,22 @@ func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error)
 	return response, nil
 }
 
-// ParseHandleAlertWebhookResp parses an HTTP response from a HandleAlertWebhookWithResponse call
-func ParseHandleAlertWebhookResp(rsp *http.Response) (*HandleAlertWebhookResp, error) {
+// ParseRunSavedQueryResp parses an HTTP response from a RunSavedQueryWithResponse call
+func ParseRunSavedQueryResp(rsp *http.Response) (*RunSavedQueryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HandleAlertWebhookResp{
+	response := &RunSavedQueryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertWebhookResponse
+		var dest map[string]interface{}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2364,6 +5301,27 @@ func ParseHandleAlertWebhookResp(rsp *http.Response) (*HandleAlertWebhookResp, e
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2376,22 +5334,22 @@ func ParseHandleAlertWebhookResp(rsp *http.Response) (*HandleAlertWebhookResp, e
 	return response, nil
 }
 
-// ParseQueryIncidentsResp parses an HTTP response from a QueryIncidentsWithResponse call
-func ParseQueryIncidentsResp(rsp *http.Response) (*QueryIncidentsResp, error) {
+// ParseSearchOpenSearchResp parses an HTTP response from a SearchOpenSearchWithResponse call
+func ParseSearchOpenSearchResp(rsp *http.Response) (*SearchOpenSearchResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryIncidentsResp{
+	response := &SearchOpenSearchResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IncidentsQueryResponse
+		var dest OpenSearchQueryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2430,22 +5388,22 @@ func ParseQueryIncidentsResp(rsp *http.Response) (*QueryIncidentsResp, error) {
 	return response, nil
 }
 
-// ParseUpdateIncidentResp parses an HTTP response from a UpdateIncidentWithResponse call
-func ParseUpdateIncidentResp(rsp *http.Response) (*UpdateIncidentResp, error) {
+// ParseQueryPromQLResp parses an HTTP response from a QueryPromQLWithResponse call
+func ParseQueryPromQLResp(rsp *http.Response) (*QueryPromQLResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateIncidentResp{
+	response := &QueryPromQLResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IncidentPutResponse
+		var dest PromQLQueryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2472,13 +5430,6 @@ func ParseUpdateIncidentResp(rsp *http.Response) (*UpdateIncidentResp, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2491,22 +5442,22 @@ func ParseUpdateIncidentResp(rsp *http.Response) (*UpdateIncidentResp, error) {
 	return response, nil
 }
 
-// ParseQueryRuntimeTopologyResp parses an HTTP response from a QueryRuntimeTopologyWithResponse call
-func ParseQueryRuntimeTopologyResp(rsp *http.Response) (*QueryRuntimeTopologyResp, error) {
+// ParseEvaluateErrorBudgetResp parses an HTTP response from a EvaluateErrorBudgetWithResponse call
+func ParseEvaluateErrorBudgetResp(rsp *http.Response) (*EvaluateErrorBudgetResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryRuntimeTopologyResp{
+	response := &EvaluateErrorBudgetResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RuntimeTopologyResponse
+		var dest ErrorBudgetResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
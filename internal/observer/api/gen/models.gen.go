@@ -108,9 +108,38 @@ const (
 
 // Defines values for AlertsQueryResponseAlertsMetadataAlertRuleSourceType.
 const (
-	Budget AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "budget"
-	Log    AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "log"
-	Metric AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "metric"
+	Anomaly AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "anomaly"
+	Budget  AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "budget"
+	Log     AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "log"
+	Metric  AlertsQueryResponseAlertsMetadataAlertRuleSourceType = "metric"
+)
+
+// Defines values for AnomalyDetectionRequestSensitivity.
+const (
+	High   AnomalyDetectionRequestSensitivity = "high"
+	Low    AnomalyDetectionRequestSensitivity = "low"
+	Medium AnomalyDetectionRequestSensitivity = "medium"
+)
+
+// Defines values for AnomalyDetectionRequestSignals.
+const (
+	AnomalyDetectionRequestSignalsErrorRatio  AnomalyDetectionRequestSignals = "errorRatio"
+	AnomalyDetectionRequestSignalsLatency     AnomalyDetectionRequestSignals = "latency"
+	AnomalyDetectionRequestSignalsRequestRate AnomalyDetectionRequestSignals = "requestRate"
+)
+
+// Defines values for AnomalySignalResultSignal.
+const (
+	AnomalySignalResultSignalErrorRatio  AnomalySignalResultSignal = "errorRatio"
+	AnomalySignalResultSignalLatency     AnomalySignalResultSignal = "latency"
+	AnomalySignalResultSignalRequestRate AnomalySignalResultSignal = "requestRate"
+)
+
+// Defines values for ErrorBudgetLatencyObjectivePercentile.
+const (
+	P50 ErrorBudgetLatencyObjectivePercentile = "p50"
+	P90 ErrorBudgetLatencyObjectivePercentile = "p90"
+	P99 ErrorBudgetLatencyObjectivePercentile = "p99"
 )
 
 // Defines values for ErrorResponseTitle.
@@ -157,12 +186,32 @@ const (
 	Resolved     IncidentsQueryResponseIncidentsStatus = "resolved"
 )
 
+// Defines values for LogMetricDefinitionLogLevels.
+const (
+	LogMetricDefinitionLogLevelsDEBUG LogMetricDefinitionLogLevels = "DEBUG"
+	LogMetricDefinitionLogLevelsERROR LogMetricDefinitionLogLevels = "ERROR"
+	LogMetricDefinitionLogLevelsINFO  LogMetricDefinitionLogLevels = "INFO"
+	LogMetricDefinitionLogLevelsWARN  LogMetricDefinitionLogLevels = "WARN"
+)
+
+// Defines values for LogMetricDefinitionType.
+const (
+	LogMetricDefinitionTypeCounter   LogMetricDefinitionType = "counter"
+	LogMetricDefinitionTypeHistogram LogMetricDefinitionType = "histogram"
+)
+
+// Defines values for LogMetricQueryResponseType.
+const (
+	LogMetricQueryResponseTypeCounter   LogMetricQueryResponseType = "counter"
+	LogMetricQueryResponseTypeHistogram LogMetricQueryResponseType = "histogram"
+)
+
 // Defines values for LogsQueryRequestLogLevels.
 const (
-	DEBUG LogsQueryRequestLogLevels = "DEBUG"
-	ERROR LogsQueryRequestLogLevels = "ERROR"
-	INFO  LogsQueryRequestLogLevels = "INFO"
-	WARN  LogsQueryRequestLogLevels = "WARN"
+	LogsQueryRequestLogLevelsDEBUG LogsQueryRequestLogLevels = "DEBUG"
+	LogsQueryRequestLogLevelsERROR LogsQueryRequestLogLevels = "ERROR"
+	LogsQueryRequestLogLevelsINFO  LogsQueryRequestLogLevels = "INFO"
+	LogsQueryRequestLogLevelsWARN  LogsQueryRequestLogLevels = "WARN"
 )
 
 // Defines values for LogsQueryRequestSortOrder.
@@ -486,8 +535,97 @@ type AlertsQueryResponseAlertsMetadataAlertRuleSeverity string
 // AlertsQueryResponseAlertsMetadataAlertRuleSourceType The type of the alert source
 type AlertsQueryResponseAlertsMetadataAlertRuleSourceType string
 
+// AnomalyActions Raises anomalies through the alerting pipeline.
+type AnomalyActions struct {
+	IncidentEnabled      *bool     `json:"incidentEnabled,omitempty"`
+	NotificationChannels *[]string `json:"notificationChannels,omitempty"`
+
+	// Severity Severity of the raised alerts (info, warning or critical). Defaults to warning.
+	Severity *string `json:"severity,omitempty"`
+
+	// TriggerAiRca Triggers AI root cause analysis for the incidents. Requires incidentEnabled.
+	TriggerAiRca *bool `json:"triggerAiRca,omitempty"`
+}
+
+// AnomalyDetectionRequest defines model for AnomalyDetectionRequest.
+type AnomalyDetectionRequest struct {
+	// Actions Raises anomalies through the alerting pipeline.
+	Actions *AnomalyActions `json:"actions,omitempty"`
+
+	// DetectorName Identifies the detector that raises the anomalies. Required with actions.
+	DetectorName *string `json:"detectorName,omitempty"`
+
+	// EndTime End of the evaluated window. Defaults to now.
+	EndTime     *time.Time             `json:"endTime,omitempty"`
+	SearchScope ErrorBudgetSearchScope `json:"searchScope"`
+
+	// Seasonality Baseline period as a Go duration. Defaults to 168h.
+	Seasonality *string `json:"seasonality,omitempty"`
+
+	// Seasons Number of past periods in the baseline. Defaults to 4.
+	Seasons *int `json:"seasons,omitempty"`
+
+	// Sensitivity Deviation, in standard deviations, a signal needs to be anomalous: 4 for
+	// low, 3 for medium and 2 for high. Defaults to medium.
+	Sensitivity *AnomalyDetectionRequestSensitivity `json:"sensitivity,omitempty"`
+
+	// Signals Signals to evaluate. Defaults to all of them.
+	Signals *[]AnomalyDetectionRequestSignals `json:"signals,omitempty"`
+
+	// Window Evaluated time range as a Go duration. At least 1m; defaults to 10m.
+	Window *string `json:"window,omitempty"`
+}
+
+// AnomalyDetectionRequestSensitivity Deviation, in standard deviations, a signal needs to be anomalous: 4 for
+// low, 3 for medium and 2 for high. Defaults to medium.
+type AnomalyDetectionRequestSensitivity string
+
+// AnomalyDetectionRequestSignals defines model for AnomalyDetectionRequest.Signals.
+type AnomalyDetectionRequestSignals string
+
+// AnomalyDetectionResponse defines model for AnomalyDetectionResponse.
+type AnomalyDetectionResponse struct {
+	EndTime   time.Time             `json:"endTime"`
+	Signals   []AnomalySignalResult `json:"signals"`
+	StartTime time.Time             `json:"startTime"`
+
+	// Threshold Score a signal must exceed to be anomalous.
+	Threshold float64 `json:"threshold"`
+
+	// Warnings Anomalies that could not be raised.
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// AnomalySignalResult defines model for AnomalySignalResult.
+type AnomalySignalResult struct {
+	// AlertId Alert raised for the anomaly. Omitted when no alert was raised.
+	AlertId   *string `json:"alertId,omitempty"`
+	Anomalous bool    `json:"anomalous"`
+
+	// Baseline Mean of the signal over the baseline windows.
+	Baseline        float64 `json:"baseline"`
+	BaselineSamples int     `json:"baselineSamples"`
+
+	// InsufficientData True when there were too few samples to evaluate the signal.
+	InsufficientData *bool `json:"insufficientData,omitempty"`
+
+	// Score Signed deviation from the baseline in standard deviations.
+	Score  float64                   `json:"score"`
+	Signal AnomalySignalResultSignal `json:"signal"`
+	StdDev float64                   `json:"stdDev"`
+
+	// Value Mean of the signal over the evaluated window.
+	Value float64 `json:"value"`
+}
+
+// AnomalySignalResultSignal defines model for AnomalySignalResult.Signal.
+type AnomalySignalResultSignal string
+
 // ComponentLogEntry defines model for ComponentLogEntry.
 type ComponentLogEntry struct {
+	// Fields Fields extracted by the log parsing profile of the component
+	Fields *map[string]string `json:"fields,omitempty"`
+
 	// Level The log level
 	Level *string `json:"level,omitempty"`
 
@@ -539,6 +677,110 @@ type ComponentSearchScope struct {
 	Project     *string `json:"project,omitempty"`
 }
 
+// CorrelationMetrics Metric snapshots around the anchor time, at one minute resolution.
+type CorrelationMetrics struct {
+	Http     *HttpMetricsTimeSeries     `json:"http,omitempty"`
+	Resource *ResourceMetricsTimeSeries `json:"resource,omitempty"`
+}
+
+// CorrelationQueryRequest defines model for CorrelationQueryRequest.
+type CorrelationQueryRequest struct {
+	// LogLimit Maximum number of surrounding log entries. Defaults to 100.
+	LogLimit *int `json:"logLimit,omitempty"`
+
+	// PodName Restricts the surrounding logs to a pod. When pivoting from a trace it defaults to the k8s.pod.name resource attribute of the trace's spans.
+	PodName     *string                `json:"podName,omitempty"`
+	SearchScope CorrelationSearchScope `json:"searchScope"`
+
+	// Timestamp Timestamp of the log entry to pivot from. Required when traceId is not set.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+
+	// TraceId Trace to pivot from. Its spans are returned and anchor the time window.
+	TraceId *string `json:"traceId,omitempty"`
+
+	// Window How far before and after the anchor time logs are collected, as a Go duration. At most 15m; defaults to 30s.
+	Window *string `json:"window,omitempty"`
+}
+
+// CorrelationQueryResponse defines model for CorrelationQueryResponse.
+type CorrelationQueryResponse struct {
+	// AnchorTime The time the windows are centered on.
+	AnchorTime time.Time `json:"anchorTime"`
+
+	// EndTime End of the log window.
+	EndTime time.Time          `json:"endTime"`
+	Logs    *LogsQueryResponse `json:"logs,omitempty"`
+
+	// Metrics Metric snapshots around the anchor time, at one minute resolution.
+	Metrics CorrelationMetrics `json:"metrics"`
+
+	// PodName The pod the logs were restricted to, if any.
+	PodName *string                  `json:"podName,omitempty"`
+	Spans   *TraceSpansQueryResponse `json:"spans,omitempty"`
+
+	// StartTime Start of the log window.
+	StartTime time.Time `json:"startTime"`
+
+	// Warnings Signals that could not be retrieved. The others are still returned.
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// CorrelationSearchScope defines model for CorrelationSearchScope.
+type CorrelationSearchScope = ComponentSearchScope
+
+// ErrorBudgetLatencyObjective defines model for ErrorBudgetLatencyObjective.
+type ErrorBudgetLatencyObjective struct {
+	Percentile *ErrorBudgetLatencyObjectivePercentile `json:"percentile,omitempty"`
+
+	// ThresholdSeconds Maximum allowed latency for the percentile, in seconds.
+	ThresholdSeconds float64 `json:"thresholdSeconds"`
+}
+
+// ErrorBudgetLatencyObjectivePercentile defines model for ErrorBudgetLatencyObjective.Percentile.
+type ErrorBudgetLatencyObjectivePercentile string
+
+// ErrorBudgetRequest Request body for POST /api/v1alpha1/slos/error-budget. At least one of
+// availabilityTarget or latency must be set.
+type ErrorBudgetRequest struct {
+	// AvailabilityTarget Percentage of requests that must succeed.
+	AvailabilityTarget *string `json:"availabilityTarget,omitempty"`
+
+	// EndTime End of the compliance window. Defaults to now.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// Endpoint Component endpoint whose gateway traffic is evaluated. All gateway
+	// traffic to the component is evaluated when omitted.
+	Endpoint    *string                      `json:"endpoint,omitempty"`
+	Latency     *ErrorBudgetLatencyObjective `json:"latency,omitempty"`
+	SearchScope ErrorBudgetSearchScope       `json:"searchScope"`
+
+	// Window Compliance window as a Go duration.
+	Window string `json:"window"`
+}
+
+// ErrorBudgetResponse defines model for ErrorBudgetResponse.
+type ErrorBudgetResponse struct {
+	// Availability Observed percentage of successful requests. Omitted when no traffic was observed.
+	Availability *float64 `json:"availability,omitempty"`
+
+	// BurnRate Error rate over the last hour divided by the allowed error rate.
+	BurnRate *float64  `json:"burnRate,omitempty"`
+	EndTime  time.Time `json:"endTime"`
+
+	// ErrorBudgetRemaining Fraction of the error budget left (1 is untouched, 0 or less is
+	// exhausted). Omitted when no availability target was requested.
+	ErrorBudgetRemaining   *float64  `json:"errorBudgetRemaining,omitempty"`
+	Exhausted              bool      `json:"exhausted"`
+	FailedRequests         float64   `json:"failedRequests"`
+	LatencyMet             *bool     `json:"latencyMet,omitempty"`
+	ObservedLatencySeconds *float64  `json:"observedLatencySeconds,omitempty"`
+	StartTime              time.Time `json:"startTime"`
+	TotalRequests          float64   `json:"totalRequests"`
+}
+
+// ErrorBudgetSearchScope defines model for ErrorBudgetSearchScope.
+type ErrorBudgetSearchScope = ComponentSearchScope
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	// ErrorCode The error code from observer service
@@ -638,6 +880,182 @@ type EventsQueryResponse struct {
 	Total *int `json:"total,omitempty"`
 }
 
+// ExportRequest defines model for ExportRequest.
+type ExportRequest struct {
+	// Compression gzip (default) or none.
+	Compression *string `json:"compression,omitempty"`
+	Filter      *struct {
+		// Component Requires project.
+		Component    *string   `json:"component,omitempty"`
+		Environment  *string   `json:"environment,omitempty"`
+		LogLevels    *[]string `json:"logLevels,omitempty"`
+		Project      *string   `json:"project,omitempty"`
+		SearchPhrase *string   `json:"searchPhrase,omitempty"`
+	} `json:"filter,omitempty"`
+
+	// Interval Run interval of scheduled exports as a Go duration. At least 5m; defaults to 1h.
+	Interval *string `json:"interval,omitempty"`
+
+	// Mode scheduled (default) or continuous.
+	Mode *string `json:"mode,omitempty"`
+
+	// Since Logs up to this time were already exported. The observer keeps the later of
+	// this and its own checkpoint, so exports resume after a restart.
+	Since *time.Time `json:"since,omitempty"`
+	Sink  struct {
+		Gcs *struct {
+			Bucket      string          `json:"bucket"`
+			Credentials ExportSecretRef `json:"credentials"`
+			Prefix      *string         `json:"prefix,omitempty"`
+		} `json:"gcs,omitempty"`
+		Http *struct {
+			Headers *map[string]struct {
+				SecretRef *ExportSecretRef `json:"secretRef,omitempty"`
+				Value     *string          `json:"value,omitempty"`
+			} `json:"headers,omitempty"`
+			Url string `json:"url"`
+		} `json:"http,omitempty"`
+		S3 *struct {
+			AccessKeyId ExportSecretRef `json:"accessKeyId"`
+			Bucket      string          `json:"bucket"`
+
+			// Endpoint Endpoint of S3-compatible storage. Objects are addressed path-style.
+			Endpoint        *string         `json:"endpoint,omitempty"`
+			Prefix          *string         `json:"prefix,omitempty"`
+			Region          string          `json:"region"`
+			SecretAccessKey ExportSecretRef `json:"secretAccessKey"`
+		} `json:"s3,omitempty"`
+
+		// Type s3, gcs or http. The block of the same name must be set.
+		Type string `json:"type"`
+	} `json:"sink"`
+
+	// Suspend Keeps the export registered without running it.
+	Suspend *bool `json:"suspend,omitempty"`
+}
+
+// ExportSecretRef defines model for ExportSecretRef.
+type ExportSecretRef struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// ExportStatus defines model for ExportStatus.
+type ExportStatus struct {
+	// ExportedEntries Entries exported since the export was registered with this observer.
+	ExportedEntries int64 `json:"exportedEntries"`
+
+	// LastError Error of the last run. Empty when it succeeded.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastExportTime Logs up to this time have been exported.
+	LastExportTime *time.Time `json:"lastExportTime,omitempty"`
+	LastRunTime    *time.Time `json:"lastRunTime,omitempty"`
+}
+
+// FederatedLogEntry defines model for FederatedLogEntry.
+type FederatedLogEntry struct {
+	// Fields Fields extracted by the log parsing profile of the component
+	Fields *map[string]string `json:"fields,omitempty"`
+
+	// Level The log level
+	Level *string `json:"level,omitempty"`
+
+	// Log The log message
+	Log *string `json:"log,omitempty"`
+
+	// Metadata The metadata of the log entry
+	Metadata *struct {
+		// ComponentName The OpenChoreo component name that generated the log
+		ComponentName *string `json:"componentName,omitempty"`
+
+		// ComponentUid The OpenChoreo component UID that generated the log
+		ComponentUid *openapi_types.UUID `json:"componentUid,omitempty"`
+
+		// ContainerName The container name that generated the log
+		ContainerName *string `json:"containerName,omitempty"`
+
+		// EnvironmentName The OpenChoreo environment name that generated the log
+		EnvironmentName *string `json:"environmentName,omitempty"`
+
+		// EnvironmentUid The OpenChoreo environment UID that generated the log
+		EnvironmentUid *openapi_types.UUID `json:"environmentUid,omitempty"`
+
+		// NamespaceName The OpenChoreo namespace name that generated the log
+		NamespaceName *string `json:"namespaceName,omitempty"`
+
+		// PodName The Kubernetes pod name that generated the log
+		PodName *string `json:"podName,omitempty"`
+
+		// PodNamespace The namespace of the Kubernetes pod that generated the log
+		PodNamespace *string `json:"podNamespace,omitempty"`
+
+		// ProjectName The OpenChoreo project name that generated the log
+		ProjectName *string `json:"projectName,omitempty"`
+
+		// ProjectUid The OpenChoreo project UID that generated the log
+		ProjectUid *openapi_types.UUID `json:"projectUid,omitempty"`
+	} `json:"metadata,omitempty"`
+
+	// ObservabilityPlane The observability plane the entry was read from.
+	ObservabilityPlane string `json:"observabilityPlane"`
+
+	// Timestamp The timestamp of the log entry
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// FederatedLogsQueryResponse defines model for FederatedLogsQueryResponse.
+type FederatedLogsQueryResponse struct {
+	Logs   []FederatedLogEntry    `json:"logs"`
+	Planes []FederatedPlaneStatus `json:"planes"`
+
+	// TookMs The time taken to query every plane in milliseconds
+	TookMs int `json:"tookMs"`
+
+	// Total The sum of the matching log entries reported by each plane
+	Total int `json:"total"`
+
+	// Warnings Environments whose observability plane could not be resolved.
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// FederatedMetricsQueryResponse defines model for FederatedMetricsQueryResponse.
+type FederatedMetricsQueryResponse struct {
+	Planes  []FederatedPlaneStatus   `json:"planes"`
+	Results []FederatedMetricsResult `json:"results"`
+
+	// Warnings Environments whose observability plane could not be resolved.
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// FederatedMetricsResult defines model for FederatedMetricsResult.
+type FederatedMetricsResult struct {
+	// Environments The environments of the component whose data the plane holds.
+	Environments []string             `json:"environments"`
+	Metrics      MetricsQueryResponse `json:"metrics"`
+
+	// ObservabilityPlane The observability plane the metrics were read from.
+	ObservabilityPlane string `json:"observabilityPlane"`
+}
+
+// FederatedPlaneStatus The outcome of the query to one observability plane.
+type FederatedPlaneStatus struct {
+	// Environments The environments of the component whose data the plane holds.
+	Environments []string `json:"environments"`
+
+	// Error Why the query to the plane failed.
+	Error *string `json:"error,omitempty"`
+
+	// Local Whether the plane is served by the observer that answered the request.
+	Local bool `json:"local"`
+
+	// Name The name of the observability plane.
+	Name string `json:"name"`
+
+	// Status Either succeeded or failed.
+	Status string `json:"status"`
+}
+
 // HttpMetricsTimeSeries defines model for HttpMetricsTimeSeries.
 type HttpMetricsTimeSeries struct {
 	LatencyP50               *[]MetricsTimeSeriesItem `json:"latencyP50,omitempty"`
@@ -649,6 +1067,51 @@ type HttpMetricsTimeSeries struct {
 	UnsuccessfulRequestCount *[]MetricsTimeSeriesItem `json:"unsuccessfulRequestCount,omitempty"`
 }
 
+// IdleWorkload defines model for IdleWorkload.
+type IdleWorkload struct {
+	Component string `json:"component"`
+
+	// CpuP95Cores The 95th percentile CPU usage in cores over the window
+	CpuP95Cores     float32 `json:"cpuP95Cores"`
+	CpuRequestCores float32 `json:"cpuRequestCores"`
+	Environment     string  `json:"environment"`
+
+	// EstimatedMonthlySavings The monthly cost of the requested CPU and memory
+	EstimatedMonthlySavings float32 `json:"estimatedMonthlySavings"`
+	MemoryRequestBytes      float32 `json:"memoryRequestBytes"`
+	Namespace               string  `json:"namespace"`
+
+	// PeakRequestRate The highest request rate (requests per second) over the window
+	PeakRequestRate float32 `json:"peakRequestRate"`
+	Project         string  `json:"project"`
+	ReleaseBinding  string  `json:"releaseBinding"`
+
+	// SuspendUrl The OpenChoreo API endpoint that suspends the ReleaseBinding (POST)
+	SuspendUrl  string    `json:"suspendUrl"`
+	WindowEnd   time.Time `json:"windowEnd"`
+	WindowStart time.Time `json:"windowStart"`
+}
+
+// IdleWorkloadsRequest defines model for IdleWorkloadsRequest.
+type IdleWorkloadsRequest struct {
+	// Environment Restricts the report to an environment
+	Environment *string `json:"environment,omitempty"`
+
+	// Namespace The namespace to report on
+	Namespace string `json:"namespace"`
+
+	// Project Restricts the report to a project
+	Project *string `json:"project,omitempty"`
+}
+
+// IdleWorkloadsResponse defines model for IdleWorkloadsResponse.
+type IdleWorkloadsResponse struct {
+	Currency                     string         `json:"currency"`
+	GeneratedAt                  time.Time      `json:"generatedAt"`
+	Items                        []IdleWorkload `json:"items"`
+	TotalEstimatedMonthlySavings float32        `json:"totalEstimatedMonthlySavings"`
+}
+
 // IncidentPutRequest defines model for IncidentPutRequest.
 type IncidentPutRequest struct {
 	// Description The description of the incident
@@ -811,11 +1274,85 @@ type IncidentsQueryResponse struct {
 // IncidentsQueryResponseIncidentsStatus The status of the incident
 type IncidentsQueryResponseIncidentsStatus string
 
+// LogMetricBucket defines model for LogMetricBucket.
+type LogMetricBucket struct {
+	// Count Cumulative number of observations up to the upper bound.
+	Count int64 `json:"count"`
+
+	// UpperBound Bucket upper bound, "+Inf" for the last bucket.
+	UpperBound string `json:"upperBound"`
+}
+
+// LogMetricDefinition defines model for LogMetricDefinition.
+type LogMetricDefinition struct {
+	// Buckets Histogram bucket upper bounds in ascending order. Defaults to buckets suited to durations in seconds.
+	Buckets   *[]float64                      `json:"buckets,omitempty"`
+	LogLevels *[]LogMetricDefinitionLogLevels `json:"logLevels,omitempty"`
+
+	// Name Identifies the metric in the response.
+	Name string `json:"name"`
+
+	// Pattern RE2 regular expression that log lines must match. Histogram patterns need
+	// a capture group named "value" that holds the observed number.
+	Pattern string `json:"pattern"`
+
+	// SearchPhrase Pre-filters the log lines in the log store before the pattern is applied.
+	SearchPhrase *string                 `json:"searchPhrase,omitempty"`
+	Type         LogMetricDefinitionType `json:"type"`
+}
+
+// LogMetricDefinitionLogLevels defines model for LogMetricDefinition.LogLevels.
+type LogMetricDefinitionLogLevels string
+
+// LogMetricDefinitionType defines model for LogMetricDefinition.Type.
+type LogMetricDefinitionType string
+
+// LogMetricQueryRequest defines model for LogMetricQueryRequest.
+type LogMetricQueryRequest struct {
+	EndTime     time.Time              `json:"endTime"`
+	Metric      LogMetricDefinition    `json:"metric"`
+	SearchScope ErrorBudgetSearchScope `json:"searchScope"`
+	StartTime   time.Time              `json:"startTime"`
+
+	// Step Query resolution step as a Go duration. Defaults to the whole range.
+	Step *string `json:"step,omitempty"`
+}
+
+// LogMetricQueryResponse defines model for LogMetricQueryResponse.
+type LogMetricQueryResponse struct {
+	// Buckets Cumulative bucket counts over the whole range. Histograms only.
+	Buckets *[]LogMetricBucket `json:"buckets,omitempty"`
+
+	// Count Number of matching log lines per step.
+	Count []MetricsTimeSeriesItem `json:"count"`
+
+	// EvaluatedLines Number of log lines the metric was evaluated over.
+	EvaluatedLines int                      `json:"evaluatedLines"`
+	Name           string                   `json:"name"`
+	P50            *[]MetricsTimeSeriesItem `json:"p50,omitempty"`
+	P90            *[]MetricsTimeSeriesItem `json:"p90,omitempty"`
+	P99            *[]MetricsTimeSeriesItem `json:"p99,omitempty"`
+
+	// Sum Sum of the extracted values per step. Histograms only.
+	Sum *[]MetricsTimeSeriesItem `json:"sum,omitempty"`
+
+	// Truncated True when more log lines matched than could be evaluated; values are then a lower bound.
+	Truncated bool                       `json:"truncated"`
+	Type      LogMetricQueryResponseType `json:"type"`
+}
+
+// LogMetricQueryResponseType defines model for LogMetricQueryResponse.Type.
+type LogMetricQueryResponseType string
+
 // LogsQueryRequest defines model for LogsQueryRequest.
 type LogsQueryRequest struct {
 	// EndTime The end time of the query
 	EndTime time.Time `json:"endTime"`
 
+	// FieldFilters Exact-match filters on the fields extracted by the log parsing profile of the component
+	// in the search scope, such as logger. Entries that lack a filtered field do not match.
+	FieldFilters *map[string]string `json:"fieldFilters,omitempty"`
+
 	// Limit The maximum number of items to return
 	Limit        *int                         `json:"limit,omitempty"`
 	LogLevels    *[]LogsQueryRequestLogLevels `json:"logLevels,omitempty"`
@@ -896,6 +1433,110 @@ type MetricsTimeSeriesItem struct {
 	Value *float64 `json:"value,omitempty"`
 }
 
+// OpenSearchQueryRequest defines model for OpenSearchQueryRequest.
+type OpenSearchQueryRequest struct {
+	// Body An OpenSearch search request body. Only query, aggs, aggregations, sort, size,
+	// from, _source and track_total_hits are accepted.
+	Body    *map[string]interface{} `json:"body,omitempty"`
+	EndTime time.Time               `json:"endTime"`
+
+	// LogType The log indices to search (runtime, build or gateway)
+	LogType string `json:"logType"`
+
+	// Namespace The namespace the searched documents are restricted to
+	Namespace string    `json:"namespace"`
+	StartTime time.Time `json:"startTime"`
+}
+
+// OpenSearchQueryResponse defines model for OpenSearchQueryResponse.
+type OpenSearchQueryResponse struct {
+	// Aggregations The OpenSearch aggregation results, passed through unchanged
+	Aggregations *map[string]interface{} `json:"aggregations,omitempty"`
+
+	// Hits The OpenSearch hits, passed through unchanged
+	Hits     map[string]interface{} `json:"hits"`
+	TimedOut bool                   `json:"timedOut"`
+
+	// Took Search execution time in milliseconds
+	Took int `json:"took"`
+}
+
+// PromQLQueryRequest defines model for PromQLQueryRequest.
+type PromQLQueryRequest struct {
+	// EndTime End of the query range, or the evaluation time of an instant query
+	EndTime time.Time `json:"endTime"`
+
+	// Namespace The namespace every selector of the query is restricted to
+	Namespace string `json:"namespace"`
+
+	// Query The PromQL expression
+	Query string `json:"query"`
+
+	// StartTime Start of the query range. Required when step is set.
+	StartTime *time.Time `json:"startTime,omitempty"`
+
+	// Step Range query resolution as a duration. Omit to run an instant query.
+	Step *string `json:"step,omitempty"`
+}
+
+// PromQLQueryResponse defines model for PromQLQueryResponse.
+type PromQLQueryResponse struct {
+	// Query The namespace scoped query that was executed
+	Query string `json:"query"`
+
+	// Result The Prometheus result, passed through unchanged apart from truncation
+	Result interface{} `json:"result"`
+
+	// ResultType The Prometheus result type (vector, matrix, scalar or string)
+	ResultType string `json:"resultType"`
+
+	// Truncated Whether series beyond the result limit were dropped
+	Truncated bool      `json:"truncated"`
+	Warnings  *[]string `json:"warnings,omitempty"`
+}
+
+// PromotionNotificationRequest A promotion of a component to an environment, sent to notification channels.
+type PromotionNotificationRequest struct {
+	// Component Component that was promoted
+	Component string `json:"component"`
+
+	// Environment Environment the component was promoted to
+	Environment string `json:"environment"`
+
+	// FromCommit Source commit of the previously deployed release
+	FromCommit *string `json:"fromCommit,omitempty"`
+
+	// FromImage Container image of the previously deployed release
+	FromImage *string `json:"fromImage,omitempty"`
+
+	// FromRelease Previously deployed ComponentRelease. Empty for the initial deployment.
+	FromRelease *string `json:"fromRelease,omitempty"`
+
+	// Namespace Namespace of the component
+	Namespace string `json:"namespace"`
+
+	// NotificationChannels Notification channels the promotion is sent to
+	NotificationChannels []string `json:"notificationChannels"`
+
+	// Project Project of the component
+	Project string `json:"project"`
+
+	// ReleaseBinding ReleaseBinding that deployed the release
+	ReleaseBinding *string `json:"releaseBinding,omitempty"`
+
+	// Summary One-line summary of the release changelog
+	Summary string `json:"summary"`
+
+	// ToCommit Source commit of the newly deployed release
+	ToCommit *string `json:"toCommit,omitempty"`
+
+	// ToImage Container image of the newly deployed release
+	ToImage *string `json:"toImage,omitempty"`
+
+	// ToRelease Newly deployed ComponentRelease
+	ToRelease string `json:"toRelease"`
+}
+
 // ResourceMetricsTimeSeries defines model for ResourceMetricsTimeSeries.
 type ResourceMetricsTimeSeries struct {
 	CpuLimits      *[]MetricsTimeSeriesItem `json:"cpuLimits,omitempty"`
@@ -906,6 +1547,53 @@ type ResourceMetricsTimeSeries struct {
 	MemoryUsage    *[]MetricsTimeSeriesItem `json:"memoryUsage,omitempty"`
 }
 
+// ResourceQuantities CPU and memory in Kubernetes quantity notation.
+type ResourceQuantities struct {
+	Cpu    *string `json:"cpu,omitempty"`
+	Memory *string `json:"memory,omitempty"`
+}
+
+// ResourceRecommendationRequest defines model for ResourceRecommendationRequest.
+type ResourceRecommendationRequest struct {
+	// EndTime End of the analyzed window. Defaults to now.
+	EndTime     *time.Time                        `json:"endTime,omitempty"`
+	SearchScope ResourceRecommendationSearchScope `json:"searchScope"`
+
+	// Window Usage history to analyze as a Go duration. At least 1h; defaults to 168h.
+	Window *string `json:"window,omitempty"`
+}
+
+// ResourceRecommendationResponse defines model for ResourceRecommendationResponse.
+type ResourceRecommendationResponse struct {
+	Current     ResourceSettings     `json:"current"`
+	EndTime     time.Time            `json:"endTime"`
+	Recommended *ResourceSettings    `json:"recommended,omitempty"`
+	StartTime   time.Time            `json:"startTime"`
+	Usage       ResourceUsageSummary `json:"usage"`
+}
+
+// ResourceRecommendationSearchScope defines model for ResourceRecommendationSearchScope.
+type ResourceRecommendationSearchScope = ComponentSearchScope
+
+// ResourceSettings defines model for ResourceSettings.
+type ResourceSettings struct {
+	// Limits CPU and memory in Kubernetes quantity notation.
+	Limits ResourceQuantities `json:"limits"`
+
+	// Requests CPU and memory in Kubernetes quantity notation.
+	Requests ResourceQuantities `json:"requests"`
+}
+
+// ResourceUsageSummary defines model for ResourceUsageSummary.
+type ResourceUsageSummary struct {
+	CpuP90Cores       float64 `json:"cpuP90Cores"`
+	CpuPeakCores      float64 `json:"cpuPeakCores"`
+	CpuSampleCount    int     `json:"cpuSampleCount"`
+	MemoryP95Bytes    float64 `json:"memoryP95Bytes"`
+	MemoryPeakBytes   float64 `json:"memoryPeakBytes"`
+	MemorySampleCount int     `json:"memorySampleCount"`
+}
+
 // RuntimeTopologyEdge An observed traffic flow from a source node to a target node.
 type RuntimeTopologyEdge struct {
 	// Id Stable identifier for the edge. Convention:
@@ -1068,6 +1756,61 @@ type RuntimeTopologySummary struct {
 	StartTime   time.Time `json:"startTime"`
 }
 
+// SavedQuery defines model for SavedQuery.
+type SavedQuery struct {
+	CreatedAt   *time.Time              `json:"createdAt,omitempty"`
+	CreatedBy   *string                 `json:"createdBy,omitempty"`
+	Description *string                 `json:"description,omitempty"`
+	Kind        *string                 `json:"kind,omitempty"`
+	Name        *string                 `json:"name,omitempty"`
+	Namespace   *string                 `json:"namespace,omitempty"`
+	Parameters  *[]SavedQueryParameter  `json:"parameters,omitempty"`
+	Query       *map[string]interface{} `json:"query,omitempty"`
+}
+
+// SavedQueryListResponse defines model for SavedQueryListResponse.
+type SavedQueryListResponse struct {
+	Items *[]SavedQuery `json:"items,omitempty"`
+	Total *int          `json:"total,omitempty"`
+}
+
+// SavedQueryParameter defines model for SavedQueryParameter.
+type SavedQueryParameter struct {
+	// Default Value used when the parameter is not given.
+	Default     *string `json:"default,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the parameter, referenced as {{name}} in the query.
+	Name string `json:"name"`
+
+	// Required Whether the parameter must be given when the query is run.
+	Required *bool `json:"required,omitempty"`
+}
+
+// SavedQueryRequest defines model for SavedQueryRequest.
+type SavedQueryRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// Kind logs or traces.
+	Kind string `json:"kind"`
+
+	// Name Name of the saved query. Must be a DNS label.
+	Name       string                 `json:"name"`
+	Namespace  string                 `json:"namespace"`
+	Parameters *[]SavedQueryParameter `json:"parameters,omitempty"`
+
+	// Query A LogsQueryRequest or TracesQueryRequest. String values may contain {{name}}
+	// placeholders for the declared parameters. The search scope namespace must
+	// be the namespace of the saved query.
+	Query map[string]interface{} `json:"query"`
+}
+
+// SavedQueryRunRequest defines model for SavedQueryRunRequest.
+type SavedQueryRunRequest struct {
+	// Parameters Values of the query parameters by name.
+	Parameters *map[string]string `json:"parameters,omitempty"`
+}
+
 // SpanStatus Execution status of the span, following the OpenTelemetry span Status model.
 type SpanStatus struct {
 	// Code The status code of the span. One of "ok", "error", or "unset".
@@ -1250,15 +1993,57 @@ type UpdateAlertRuleJSONRequestBody = AlertRuleRequest
 // HandleAlertWebhookJSONRequestBody defines body for HandleAlertWebhook for application/json ContentType.
 type HandleAlertWebhookJSONRequestBody = AlertWebhookRequest
 
+// DetectAnomaliesJSONRequestBody defines body for DetectAnomalies for application/json ContentType.
+type DetectAnomaliesJSONRequestBody = AnomalyDetectionRequest
+
+// QueryCorrelationsJSONRequestBody defines body for QueryCorrelations for application/json ContentType.
+type QueryCorrelationsJSONRequestBody = CorrelationQueryRequest
+
+// UpsertExportJSONRequestBody defines body for UpsertExport for application/json ContentType.
+type UpsertExportJSONRequestBody = ExportRequest
+
+// QueryFederatedLogsJSONRequestBody defines body for QueryFederatedLogs for application/json ContentType.
+type QueryFederatedLogsJSONRequestBody = LogsQueryRequest
+
+// QueryFederatedMetricsJSONRequestBody defines body for QueryFederatedMetrics for application/json ContentType.
+type QueryFederatedMetricsJSONRequestBody = MetricsQueryRequest
+
 // QueryIncidentsJSONRequestBody defines body for QueryIncidents for application/json ContentType.
 type QueryIncidentsJSONRequestBody = IncidentsQueryRequest
 
 // UpdateIncidentJSONRequestBody defines body for UpdateIncident for application/json ContentType.
 type UpdateIncidentJSONRequestBody = IncidentPutRequest
 
+// GetIdleWorkloadsJSONRequestBody defines body for GetIdleWorkloads for application/json ContentType.
+type GetIdleWorkloadsJSONRequestBody = IdleWorkloadsRequest
+
+// QueryLogMetricJSONRequestBody defines body for QueryLogMetric for application/json ContentType.
+type QueryLogMetricJSONRequestBody = LogMetricQueryRequest
+
+// RecommendResourcesJSONRequestBody defines body for RecommendResources for application/json ContentType.
+type RecommendResourcesJSONRequestBody = ResourceRecommendationRequest
+
 // QueryRuntimeTopologyJSONRequestBody defines body for QueryRuntimeTopology for application/json ContentType.
 type QueryRuntimeTopologyJSONRequestBody = RuntimeTopologyRequest
 
+// NotifyPromotionJSONRequestBody defines body for NotifyPromotion for application/json ContentType.
+type NotifyPromotionJSONRequestBody = PromotionNotificationRequest
+
+// CreateSavedQueryJSONRequestBody defines body for CreateSavedQuery for application/json ContentType.
+type CreateSavedQueryJSONRequestBody = SavedQueryRequest
+
+// RunSavedQueryJSONRequestBody defines body for RunSavedQuery for application/json ContentType.
+type RunSavedQueryJSONRequestBody = SavedQueryRunRequest
+
+// SearchOpenSearchJSONRequestBody defines body for SearchOpenSearch for application/json ContentType.
+type SearchOpenSearchJSONRequestBody = OpenSearchQueryRequest
+
+// QueryPromQLJSONRequestBody defines body for QueryPromQL for application/json ContentType.
+type QueryPromQLJSONRequestBody = PromQLQueryRequest
+
+// EvaluateErrorBudgetJSONRequestBody defines body for EvaluateErrorBudget for application/json ContentType.
+type EvaluateErrorBudgetJSONRequestBody = ErrorBudgetRequest
+
 // QueryTracesJSONRequestBody defines body for QueryTraces for application/json ContentType.
 type QueryTracesJSONRequestBody = TracesQueryRequest
 
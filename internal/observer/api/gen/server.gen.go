@@ -51,15 +51,69 @@ type ServerInterface interface {
 	// Handles triggered alerts from the alerting backend
 	// (POST /api/v1alpha1/alerts/webhook)
 	HandleAlertWebhook(w http.ResponseWriter, r *http.Request)
+	// Detect anomalies in component metrics
+	// (POST /api/v1alpha1/anomalies/detect)
+	DetectAnomalies(w http.ResponseWriter, r *http.Request)
+	// Query signals correlated with a trace or log entry
+	// (POST /api/v1alpha1/correlations/query)
+	QueryCorrelations(w http.ResponseWriter, r *http.Request)
+	// Remove a log export
+	// (DELETE /api/v1alpha1/exports/{namespace}/{name})
+	DeleteExport(w http.ResponseWriter, r *http.Request, namespace string, name string)
+	// Register a log export
+	// (PUT /api/v1alpha1/exports/{namespace}/{name})
+	UpsertExport(w http.ResponseWriter, r *http.Request, namespace string, name string)
+	// Query component logs across observability planes
+	// (POST /api/v1alpha1/federation/logs/query)
+	QueryFederatedLogs(w http.ResponseWriter, r *http.Request)
+	// Query component metrics across observability planes
+	// (POST /api/v1alpha1/federation/metrics/query)
+	QueryFederatedMetrics(w http.ResponseWriter, r *http.Request)
 	// Query incidents
 	// (POST /api/v1alpha1/incidents/query)
 	QueryIncidents(w http.ResponseWriter, r *http.Request)
 	// Update incident
 	// (PUT /api/v1alpha1/incidents/{incidentId})
 	UpdateIncident(w http.ResponseWriter, r *http.Request, incidentId string)
+	// Report idle workloads
+	// (POST /api/v1alpha1/metrics/idle-workloads)
+	GetIdleWorkloads(w http.ResponseWriter, r *http.Request)
+	// Query a log-based metric
+	// (POST /api/v1alpha1/metrics/log-metrics/query)
+	QueryLogMetric(w http.ResponseWriter, r *http.Request)
+	// Recommend resource requests and limits
+	// (POST /api/v1alpha1/metrics/resource-recommendations)
+	RecommendResources(w http.ResponseWriter, r *http.Request)
 	// Query runtime topology
 	// (POST /api/v1alpha1/metrics/runtime-topology)
 	QueryRuntimeTopology(w http.ResponseWriter, r *http.Request)
+	// Send a promotion notification
+	// (POST /api/v1alpha1/notifications/promotions)
+	NotifyPromotion(w http.ResponseWriter, r *http.Request)
+	// Save a query
+	// (POST /api/v1alpha1/queries)
+	CreateSavedQuery(w http.ResponseWriter, r *http.Request)
+	// List saved queries
+	// (GET /api/v1alpha1/queries/{namespace})
+	ListSavedQueries(w http.ResponseWriter, r *http.Request, namespace string)
+	// Delete a saved query
+	// (DELETE /api/v1alpha1/queries/{namespace}/{name})
+	DeleteSavedQuery(w http.ResponseWriter, r *http.Request, namespace string, name string)
+	// Get a saved query
+	// (GET /api/v1alpha1/queries/{namespace}/{name})
+	GetSavedQuery(w http.ResponseWriter, r *http.Request, namespace string, name string)
+	// Run a saved query
+	// (POST /api/v1alpha1/queries/{namespace}/{name}/run)
+	RunSavedQuery(w http.ResponseWriter, r *http.Request, namespace string, name string)
+	// Run a raw OpenSearch search
+	// (POST /api/v1alpha1/raw/opensearch/search)
+	SearchOpenSearch(w http.ResponseWriter, r *http.Request)
+	// Run a raw PromQL query
+	// (POST /api/v1alpha1/raw/promql/query)
+	QueryPromQL(w http.ResponseWriter, r *http.Request)
+	// Evaluate an SLO error budget
+	// (POST /api/v1alpha1/slos/error-budget)
+	EvaluateErrorBudget(w http.ResponseWriter, r *http.Request)
 	// Query traces
 	// (POST /api/v1alpha1/traces/query)
 	QueryTraces(w http.ResponseWriter, r *http.Request)
@@ -328,8 +382,22 @@ func (siw *ServerInterfaceWrapper) HandleAlertWebhook(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// QueryIncidents operation middleware
-func (siw *ServerInterfaceWrapper) QueryIncidents(w http.ResponseWriter, r *http.Request) {
+// DetectAnomalies operation middleware
+func (siw *ServerInterfaceWrapper) DetectAnomalies(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DetectAnomalies(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QueryCorrelations operation middleware
+func (siw *ServerInterfaceWrapper) QueryCorrelations(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -338,7 +406,7 @@ func (siw *ServerInterfaceWrapper) QueryIncidents(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryIncidents(w, r)
+		siw.Handler.QueryCorrelations(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -348,20 +416,77 @@ func (siw *ServerInterfaceWrapper) QueryIncidents(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateIncident operation middleware
-func (siw *ServerInterfaceWrapper) UpdateIncident(w http.ResponseWriter, r *http.Request) {
+// DeleteExport operation middleware
+func (siw *ServerInterfaceWrapper) DeleteExport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "incidentId" -------------
-	var incidentId string
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "incidentId", r.PathValue("incidentId"), &incidentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "incidentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteExport(w, r, namespace, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpsertExport operation middleware
+func (siw *ServerInterfaceWrapper) UpsertExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpsertExport(w, r, namespace, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QueryFederatedLogs operation middleware
+func (siw *ServerInterfaceWrapper) QueryFederatedLogs(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -369,7 +494,7 @@ func (siw *ServerInterfaceWrapper) UpdateIncident(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateIncident(w, r, incidentId)
+		siw.Handler.QueryFederatedLogs(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -379,8 +504,8 @@ func (siw *ServerInterfaceWrapper) UpdateIncident(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// QueryRuntimeTopology operation middleware
-func (siw *ServerInterfaceWrapper) QueryRuntimeTopology(w http.ResponseWriter, r *http.Request) {
+// QueryFederatedMetrics operation middleware
+func (siw *ServerInterfaceWrapper) QueryFederatedMetrics(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -389,7 +514,7 @@ func (siw *ServerInterfaceWrapper) QueryRuntimeTopology(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryRuntimeTopology(w, r)
+		siw.Handler.QueryFederatedMetrics(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -399,8 +524,8 @@ func (siw *ServerInterfaceWrapper) QueryRuntimeTopology(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// QueryTraces operation middleware
-func (siw *ServerInterfaceWrapper) QueryTraces(w http.ResponseWriter, r *http.Request) {
+// QueryIncidents operation middleware
+func (siw *ServerInterfaceWrapper) QueryIncidents(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -409,7 +534,7 @@ func (siw *ServerInterfaceWrapper) QueryTraces(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryTraces(w, r)
+		siw.Handler.QueryIncidents(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -419,17 +544,17 @@ func (siw *ServerInterfaceWrapper) QueryTraces(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// QuerySpansForTrace operation middleware
-func (siw *ServerInterfaceWrapper) QuerySpansForTrace(w http.ResponseWriter, r *http.Request) {
+// UpdateIncident operation middleware
+func (siw *ServerInterfaceWrapper) UpdateIncident(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "traceId" -------------
-	var traceId string
+	// ------------- Path parameter "incidentId" -------------
+	var incidentId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "traceId", r.PathValue("traceId"), &traceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "incidentId", r.PathValue("incidentId"), &incidentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "incidentId", Err: err})
 		return
 	}
 
@@ -440,7 +565,7 @@ func (siw *ServerInterfaceWrapper) QuerySpansForTrace(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QuerySpansForTrace(w, r, traceId)
+		siw.Handler.UpdateIncident(w, r, incidentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -450,29 +575,29 @@ func (siw *ServerInterfaceWrapper) QuerySpansForTrace(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetSpanDetailsForTrace operation middleware
-func (siw *ServerInterfaceWrapper) GetSpanDetailsForTrace(w http.ResponseWriter, r *http.Request) {
+// GetIdleWorkloads operation middleware
+func (siw *ServerInterfaceWrapper) GetIdleWorkloads(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "traceId" -------------
-	var traceId string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "traceId", r.PathValue("traceId"), &traceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceId", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
-	// ------------- Path parameter "spanId" -------------
-	var spanId string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIdleWorkloads(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "spanId", r.PathValue("spanId"), &spanId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "spanId", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// QueryLogMetric operation middleware
+func (siw *ServerInterfaceWrapper) QueryLogMetric(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -480,7 +605,7 @@ func (siw *ServerInterfaceWrapper) GetSpanDetailsForTrace(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSpanDetailsForTrace(w, r, traceId, spanId)
+		siw.Handler.QueryLogMetric(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -490,11 +615,17 @@ func (siw *ServerInterfaceWrapper) GetSpanDetailsForTrace(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// Health operation middleware
-func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+// RecommendResources operation middleware
+func (siw *ServerInterfaceWrapper) RecommendResources(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Health(w, r)
+		siw.Handler.RecommendResources(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -504,1020 +635,2940 @@ func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// QueryRuntimeTopology operation middleware
+func (siw *ServerInterfaceWrapper) QueryRuntimeTopology(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	r = r.WithContext(ctx)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryRuntimeTopology(w, r)
+	}))
 
-type RequiredParamError struct {
-	ParamName string
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// NotifyPromotion operation middleware
+func (siw *ServerInterfaceWrapper) NotifyPromotion(w http.ResponseWriter, r *http.Request) {
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.NotifyPromotion(w, r)
+	}))
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// CreateSavedQuery operation middleware
+func (siw *ServerInterfaceWrapper) CreateSavedQuery(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	r = r.WithContext(ctx)
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSavedQuery(w, r)
+	}))
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+// ListSavedQueries operation middleware
+func (siw *ServerInterfaceWrapper) ListSavedQueries(w http.ResponseWriter, r *http.Request) {
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+	var err error
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSavedQueries(w, r, namespace)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1/events/query", wrapper.QueryEvents)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1/logs/query", wrapper.QueryLogs)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1/metrics/query", wrapper.QueryMetrics)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/query", wrapper.QueryAlerts)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules", wrapper.CreateAlertRule)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.DeleteAlertRule)
-	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.GetAlertRule)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.UpdateAlertRule)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/webhook", wrapper.HandleAlertWebhook)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/incidents/query", wrapper.QueryIncidents)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/incidents/{incidentId}", wrapper.UpdateIncident)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/metrics/runtime-topology", wrapper.QueryRuntimeTopology)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/query", wrapper.QueryTraces)
-	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/spans/query", wrapper.QuerySpansForTrace)
-	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/spans/{spanId}", wrapper.GetSpanDetailsForTrace)
-	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.Health)
-
-	return m
+	handler.ServeHTTP(w, r)
 }
 
-type QueryEventsRequestObject struct {
-	Body *QueryEventsJSONRequestBody
-}
+// DeleteSavedQuery operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSavedQuery(w http.ResponseWriter, r *http.Request) {
 
-type QueryEventsResponseObject interface {
-	VisitQueryEventsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type QueryEvents200JSONResponse EventsQueryResponse
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-func (response QueryEvents200JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "name" -------------
+	var name string
 
-type QueryEvents400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-func (response QueryEvents400JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type QueryEvents401JSONResponse ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response QueryEvents401JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSavedQuery(w, r, namespace, name)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type QueryEvents403JSONResponse ErrorResponse
+// GetSavedQuery operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedQuery(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryEvents403JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-type QueryEvents500JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
 
-func (response QueryEvents500JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-type QueryEvents501JSONResponse ErrorResponse
+	ctx := r.Context()
 
-func (response QueryEvents501JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type QueryLogsRequestObject struct {
-	Body *QueryLogsJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavedQuery(w, r, namespace, name)
+	}))
 
-type QueryLogsResponseObject interface {
-	VisitQueryLogsResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type QueryLogs200JSONResponse LogsQueryResponse
+// RunSavedQuery operation middleware
+func (siw *ServerInterfaceWrapper) RunSavedQuery(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryLogs200JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-type QueryLogs400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", r.PathValue("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
 
-func (response QueryLogs400JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-type QueryLogs401JSONResponse ErrorResponse
+	ctx := r.Context()
 
-func (response QueryLogs401JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type QueryLogs403JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunSavedQuery(w, r, namespace, name)
+	}))
 
-func (response QueryLogs403JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type QueryLogs500JSONResponse ErrorResponse
-
-func (response QueryLogs500JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// SearchOpenSearch operation middleware
+func (siw *ServerInterfaceWrapper) SearchOpenSearch(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type QueryMetricsRequestObject struct {
-	Body *QueryMetricsJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type QueryMetricsResponseObject interface {
-	VisitQueryMetricsResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type QueryMetrics200JSONResponse MetricsQueryResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchOpenSearch(w, r)
+	}))
 
-func (response QueryMetrics200JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type QueryMetrics400JSONResponse ErrorResponse
+// QueryPromQL operation middleware
+func (siw *ServerInterfaceWrapper) QueryPromQL(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryMetrics400JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type QueryMetrics401JSONResponse ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response QueryMetrics401JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryPromQL(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type QueryMetrics403JSONResponse ErrorResponse
+// EvaluateErrorBudget operation middleware
+func (siw *ServerInterfaceWrapper) EvaluateErrorBudget(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryMetrics403JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type QueryMetrics500JSONResponse ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response QueryMetrics500JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EvaluateErrorBudget(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type QueryAlertsRequestObject struct {
-	Body *QueryAlertsJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type QueryAlertsResponseObject interface {
-	VisitQueryAlertsResponse(w http.ResponseWriter) error
-}
+// QueryTraces operation middleware
+func (siw *ServerInterfaceWrapper) QueryTraces(w http.ResponseWriter, r *http.Request) {
 
-type QueryAlerts200JSONResponse AlertsQueryResponse
+	ctx := r.Context()
 
-func (response QueryAlerts200JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type QueryAlerts400JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryTraces(w, r)
+	}))
 
-func (response QueryAlerts400JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type QueryAlerts401JSONResponse ErrorResponse
+// QuerySpansForTrace operation middleware
+func (siw *ServerInterfaceWrapper) QuerySpansForTrace(w http.ResponseWriter, r *http.Request) {
 
-func (response QueryAlerts401JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "traceId" -------------
+	var traceId string
 
-type QueryAlerts403JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "traceId", r.PathValue("traceId"), &traceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceId", Err: err})
+		return
+	}
 
-func (response QueryAlerts403JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type QueryAlerts500JSONResponse ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response QueryAlerts500JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QuerySpansForTrace(w, r, traceId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateAlertRuleRequestObject struct {
-	SourceType string `json:"sourceType"`
-	Body       *CreateAlertRuleJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type CreateAlertRuleResponseObject interface {
-	VisitCreateAlertRuleResponse(w http.ResponseWriter) error
-}
+// GetSpanDetailsForTrace operation middleware
+func (siw *ServerInterfaceWrapper) GetSpanDetailsForTrace(w http.ResponseWriter, r *http.Request) {
 
-type CreateAlertRule201JSONResponse AlertingRuleSyncResponse
+	var err error
 
-func (response CreateAlertRule201JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "traceId" -------------
+	var traceId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "traceId", r.PathValue("traceId"), &traceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceId", Err: err})
+		return
+	}
 
-type CreateAlertRule400JSONResponse ErrorResponse
+	// ------------- Path parameter "spanId" -------------
+	var spanId string
 
-func (response CreateAlertRule400JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "spanId", r.PathValue("spanId"), &spanId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "spanId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CreateAlertRule409JSONResponse ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response CreateAlertRule409JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpanDetailsForTrace(w, r, traceId, spanId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateAlertRule500JSONResponse ErrorResponse
+// Health operation middleware
+func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateAlertRule500JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Health(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteAlertRuleRequestObject struct {
-	SourceType string `json:"sourceType"`
-	RuleName   string `json:"ruleName"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteAlertRuleResponseObject interface {
-	VisitDeleteAlertRuleResponse(w http.ResponseWriter) error
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type DeleteAlertRule200JSONResponse AlertingRuleSyncResponse
-
-func (response DeleteAlertRule200JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type DeleteAlertRule400JSONResponse ErrorResponse
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
 
-func (response DeleteAlertRule400JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-type DeleteAlertRule404JSONResponse ErrorResponse
+type RequiredParamError struct {
+	ParamName string
+}
 
-func (response DeleteAlertRule404JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
 
-	return json.NewEncoder(w).Encode(response)
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type DeleteAlertRule500JSONResponse ErrorResponse
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
 
-func (response DeleteAlertRule500JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
 
-	return json.NewEncoder(w).Encode(response)
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
 }
 
-type GetAlertRuleRequestObject struct {
-	SourceType string `json:"sourceType"`
-	RuleName   string `json:"ruleName"`
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
 }
 
-type GetAlertRuleResponseObject interface {
-	VisitGetAlertRuleResponse(w http.ResponseWriter) error
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-type GetAlertRule200JSONResponse AlertRuleResponse
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
 
-func (response GetAlertRule200JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
 }
 
-type GetAlertRule400JSONResponse ErrorResponse
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
 
-func (response GetAlertRule400JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
 }
 
-type GetAlertRule404JSONResponse ErrorResponse
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
 
-func (response GetAlertRule404JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
 
-type GetAlertRule500JSONResponse ErrorResponse
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
 
-func (response GetAlertRule500JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/events/query", wrapper.QueryEvents)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/logs/query", wrapper.QueryLogs)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/metrics/query", wrapper.QueryMetrics)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/query", wrapper.QueryAlerts)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules", wrapper.CreateAlertRule)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.DeleteAlertRule)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.GetAlertRule)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName}", wrapper.UpdateAlertRule)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/webhook", wrapper.HandleAlertWebhook)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/anomalies/detect", wrapper.DetectAnomalies)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/correlations/query", wrapper.QueryCorrelations)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1alpha1/exports/{namespace}/{name}", wrapper.DeleteExport)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/exports/{namespace}/{name}", wrapper.UpsertExport)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/federation/logs/query", wrapper.QueryFederatedLogs)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/federation/metrics/query", wrapper.QueryFederatedMetrics)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/incidents/query", wrapper.QueryIncidents)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/incidents/{incidentId}", wrapper.UpdateIncident)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/metrics/idle-workloads", wrapper.GetIdleWorkloads)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/metrics/log-metrics/query", wrapper.QueryLogMetric)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/metrics/resource-recommendations", wrapper.RecommendResources)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/metrics/runtime-topology", wrapper.QueryRuntimeTopology)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/notifications/promotions", wrapper.NotifyPromotion)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/queries", wrapper.CreateSavedQuery)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/queries/{namespace}", wrapper.ListSavedQueries)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1alpha1/queries/{namespace}/{name}", wrapper.DeleteSavedQuery)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/queries/{namespace}/{name}", wrapper.GetSavedQuery)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/queries/{namespace}/{name}/run", wrapper.RunSavedQuery)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/raw/opensearch/search", wrapper.SearchOpenSearch)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/raw/promql/query", wrapper.QueryPromQL)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/slos/error-budget", wrapper.EvaluateErrorBudget)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/query", wrapper.QueryTraces)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/spans/query", wrapper.QuerySpansForTrace)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/spans/{spanId}", wrapper.GetSpanDetailsForTrace)
+	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.Health)
 
-	return json.NewEncoder(w).Encode(response)
+	return m
 }
 
-type UpdateAlertRuleRequestObject struct {
-	SourceType string `json:"sourceType"`
-	RuleName   string `json:"ruleName"`
-	Body       *UpdateAlertRuleJSONRequestBody
+type QueryEventsRequestObject struct {
+	Body *QueryEventsJSONRequestBody
 }
 
-type UpdateAlertRuleResponseObject interface {
-	VisitUpdateAlertRuleResponse(w http.ResponseWriter) error
+type QueryEventsResponseObject interface {
+	VisitQueryEventsResponse(w http.ResponseWriter) error
 }
 
-type UpdateAlertRule200JSONResponse AlertingRuleSyncResponse
+type QueryEvents200JSONResponse EventsQueryResponse
 
-func (response UpdateAlertRule200JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+func (response QueryEvents200JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateAlertRule400JSONResponse ErrorResponse
+type QueryEvents400JSONResponse ErrorResponse
 
-func (response UpdateAlertRule400JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+func (response QueryEvents400JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateAlertRule500JSONResponse ErrorResponse
+type QueryEvents401JSONResponse ErrorResponse
 
-func (response UpdateAlertRule500JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+func (response QueryEvents401JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleAlertWebhookRequestObject struct {
-	Body *HandleAlertWebhookJSONRequestBody
-}
-
-type HandleAlertWebhookResponseObject interface {
-	VisitHandleAlertWebhookResponse(w http.ResponseWriter) error
-}
-
-type HandleAlertWebhook200JSONResponse AlertWebhookResponse
+type QueryEvents403JSONResponse ErrorResponse
 
-func (response HandleAlertWebhook200JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+func (response QueryEvents403JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleAlertWebhook400JSONResponse ErrorResponse
+type QueryEvents500JSONResponse ErrorResponse
 
-func (response HandleAlertWebhook400JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+func (response QueryEvents500JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleAlertWebhook500JSONResponse ErrorResponse
+type QueryEvents501JSONResponse ErrorResponse
 
-func (response HandleAlertWebhook500JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+func (response QueryEvents501JSONResponse) VisitQueryEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidentsRequestObject struct {
-	Body *QueryIncidentsJSONRequestBody
+type QueryLogsRequestObject struct {
+	Body *QueryLogsJSONRequestBody
 }
 
-type QueryIncidentsResponseObject interface {
-	VisitQueryIncidentsResponse(w http.ResponseWriter) error
+type QueryLogsResponseObject interface {
+	VisitQueryLogsResponse(w http.ResponseWriter) error
 }
 
-type QueryIncidents200JSONResponse IncidentsQueryResponse
+type QueryLogs200JSONResponse LogsQueryResponse
 
-func (response QueryIncidents200JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryLogs200JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidents400JSONResponse ErrorResponse
+type QueryLogs400JSONResponse ErrorResponse
 
-func (response QueryIncidents400JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryLogs400JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidents401JSONResponse ErrorResponse
+type QueryLogs401JSONResponse ErrorResponse
 
-func (response QueryIncidents401JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryLogs401JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidents403JSONResponse ErrorResponse
+type QueryLogs403JSONResponse ErrorResponse
 
-func (response QueryIncidents403JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryLogs403JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryIncidents500JSONResponse ErrorResponse
+type QueryLogs500JSONResponse ErrorResponse
 
-func (response QueryIncidents500JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+func (response QueryLogs500JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncidentRequestObject struct {
-	IncidentId string `json:"incidentId"`
-	Body       *UpdateIncidentJSONRequestBody
+type QueryMetricsRequestObject struct {
+	Body *QueryMetricsJSONRequestBody
 }
 
-type UpdateIncidentResponseObject interface {
-	VisitUpdateIncidentResponse(w http.ResponseWriter) error
+type QueryMetricsResponseObject interface {
+	VisitQueryMetricsResponse(w http.ResponseWriter) error
 }
 
-type UpdateIncident200JSONResponse IncidentPutResponse
+type QueryMetrics200JSONResponse MetricsQueryResponse
 
-func (response UpdateIncident200JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryMetrics200JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncident400JSONResponse ErrorResponse
+type QueryMetrics400JSONResponse ErrorResponse
 
-func (response UpdateIncident400JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryMetrics400JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncident401JSONResponse ErrorResponse
+type QueryMetrics401JSONResponse ErrorResponse
 
-func (response UpdateIncident401JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryMetrics401JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncident403JSONResponse ErrorResponse
+type QueryMetrics403JSONResponse ErrorResponse
 
-func (response UpdateIncident403JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryMetrics403JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateIncident404JSONResponse ErrorResponse
-
-func (response UpdateIncident404JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UpdateIncident500JSONResponse ErrorResponse
+type QueryMetrics500JSONResponse ErrorResponse
 
-func (response UpdateIncident500JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+func (response QueryMetrics500JSONResponse) VisitQueryMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopologyRequestObject struct {
-	Body *QueryRuntimeTopologyJSONRequestBody
+type QueryAlertsRequestObject struct {
+	Body *QueryAlertsJSONRequestBody
 }
 
-type QueryRuntimeTopologyResponseObject interface {
-	VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error
+type QueryAlertsResponseObject interface {
+	VisitQueryAlertsResponse(w http.ResponseWriter) error
 }
 
-type QueryRuntimeTopology200JSONResponse RuntimeTopologyResponse
+type QueryAlerts200JSONResponse AlertsQueryResponse
 
-func (response QueryRuntimeTopology200JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+func (response QueryAlerts200JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopology400JSONResponse ErrorResponse
+type QueryAlerts400JSONResponse ErrorResponse
 
-func (response QueryRuntimeTopology400JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+func (response QueryAlerts400JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopology401JSONResponse ErrorResponse
+type QueryAlerts401JSONResponse ErrorResponse
 
-func (response QueryRuntimeTopology401JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+func (response QueryAlerts401JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopology403JSONResponse ErrorResponse
+type QueryAlerts403JSONResponse ErrorResponse
 
-func (response QueryRuntimeTopology403JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+func (response QueryAlerts403JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryRuntimeTopology500JSONResponse ErrorResponse
+type QueryAlerts500JSONResponse ErrorResponse
 
-func (response QueryRuntimeTopology500JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+func (response QueryAlerts500JSONResponse) VisitQueryAlertsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryTracesRequestObject struct {
-	Body *QueryTracesJSONRequestBody
+type CreateAlertRuleRequestObject struct {
+	SourceType string `json:"sourceType"`
+	Body       *CreateAlertRuleJSONRequestBody
 }
 
-type QueryTracesResponseObject interface {
-	VisitQueryTracesResponse(w http.ResponseWriter) error
+type CreateAlertRuleResponseObject interface {
+	VisitCreateAlertRuleResponse(w http.ResponseWriter) error
 }
 
-type QueryTraces200JSONResponse TracesQueryResponse
+type CreateAlertRule201JSONResponse AlertingRuleSyncResponse
 
-func (response QueryTraces200JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+func (response CreateAlertRule201JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryTraces400JSONResponse ErrorResponse
+type CreateAlertRule400JSONResponse ErrorResponse
 
-func (response QueryTraces400JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+func (response CreateAlertRule400JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryTraces401JSONResponse ErrorResponse
-
-func (response QueryTraces401JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type QueryTraces403JSONResponse ErrorResponse
+type CreateAlertRule409JSONResponse ErrorResponse
 
-func (response QueryTraces403JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+func (response CreateAlertRule409JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryTraces500JSONResponse ErrorResponse
+type CreateAlertRule500JSONResponse ErrorResponse
 
-func (response QueryTraces500JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+func (response CreateAlertRule500JSONResponse) VisitCreateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuerySpansForTraceRequestObject struct {
-	TraceId string `json:"traceId"`
-	Body    *QuerySpansForTraceJSONRequestBody
+type DeleteAlertRuleRequestObject struct {
+	SourceType string `json:"sourceType"`
+	RuleName   string `json:"ruleName"`
 }
 
-type QuerySpansForTraceResponseObject interface {
-	VisitQuerySpansForTraceResponse(w http.ResponseWriter) error
+type DeleteAlertRuleResponseObject interface {
+	VisitDeleteAlertRuleResponse(w http.ResponseWriter) error
 }
 
-type QuerySpansForTrace200JSONResponse TraceSpansQueryResponse
+type DeleteAlertRule200JSONResponse AlertingRuleSyncResponse
 
-func (response QuerySpansForTrace200JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+func (response DeleteAlertRule200JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuerySpansForTrace400JSONResponse ErrorResponse
+type DeleteAlertRule400JSONResponse ErrorResponse
 
-func (response QuerySpansForTrace400JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+func (response DeleteAlertRule400JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuerySpansForTrace401JSONResponse ErrorResponse
-
-func (response QuerySpansForTrace401JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type QuerySpansForTrace403JSONResponse ErrorResponse
+type DeleteAlertRule404JSONResponse ErrorResponse
 
-func (response QuerySpansForTrace403JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+func (response DeleteAlertRule404JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QuerySpansForTrace500JSONResponse ErrorResponse
+type DeleteAlertRule500JSONResponse ErrorResponse
 
-func (response QuerySpansForTrace500JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+func (response DeleteAlertRule500JSONResponse) VisitDeleteAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTraceRequestObject struct {
-	TraceId string `json:"traceId"`
-	SpanId  string `json:"spanId"`
+type GetAlertRuleRequestObject struct {
+	SourceType string `json:"sourceType"`
+	RuleName   string `json:"ruleName"`
 }
 
-type GetSpanDetailsForTraceResponseObject interface {
-	VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error
+type GetAlertRuleResponseObject interface {
+	VisitGetAlertRuleResponse(w http.ResponseWriter) error
 }
 
-type GetSpanDetailsForTrace200JSONResponse TraceSpanDetailsResponse
+type GetAlertRule200JSONResponse AlertRuleResponse
 
-func (response GetSpanDetailsForTrace200JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+func (response GetAlertRule200JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTrace400JSONResponse ErrorResponse
+type GetAlertRule400JSONResponse ErrorResponse
 
-func (response GetSpanDetailsForTrace400JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+func (response GetAlertRule400JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTrace401JSONResponse ErrorResponse
+type GetAlertRule404JSONResponse ErrorResponse
 
-func (response GetSpanDetailsForTrace401JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+func (response GetAlertRule404JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTrace403JSONResponse ErrorResponse
+type GetAlertRule500JSONResponse ErrorResponse
 
-func (response GetSpanDetailsForTrace403JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+func (response GetAlertRule500JSONResponse) VisitGetAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpanDetailsForTrace500JSONResponse ErrorResponse
+type UpdateAlertRuleRequestObject struct {
+	SourceType string `json:"sourceType"`
+	RuleName   string `json:"ruleName"`
+	Body       *UpdateAlertRuleJSONRequestBody
+}
 
-func (response GetSpanDetailsForTrace500JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+type UpdateAlertRuleResponseObject interface {
+	VisitUpdateAlertRuleResponse(w http.ResponseWriter) error
+}
+
+type UpdateAlertRule200JSONResponse AlertingRuleSyncResponse
+
+func (response UpdateAlertRule200JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HealthRequestObject struct {
-}
+type UpdateAlertRule400JSONResponse ErrorResponse
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
+func (response UpdateAlertRule400JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAlertRule500JSONResponse ErrorResponse
+
+func (response UpdateAlertRule500JSONResponse) VisitUpdateAlertRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleAlertWebhookRequestObject struct {
+	Body *HandleAlertWebhookJSONRequestBody
+}
+
+type HandleAlertWebhookResponseObject interface {
+	VisitHandleAlertWebhookResponse(w http.ResponseWriter) error
+}
+
+type HandleAlertWebhook200JSONResponse AlertWebhookResponse
+
+func (response HandleAlertWebhook200JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleAlertWebhook400JSONResponse ErrorResponse
+
+func (response HandleAlertWebhook400JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleAlertWebhook500JSONResponse ErrorResponse
+
+func (response HandleAlertWebhook500JSONResponse) VisitHandleAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DetectAnomaliesRequestObject struct {
+	Body *DetectAnomaliesJSONRequestBody
+}
+
+type DetectAnomaliesResponseObject interface {
+	VisitDetectAnomaliesResponse(w http.ResponseWriter) error
+}
+
+type DetectAnomalies200JSONResponse AnomalyDetectionResponse
+
+func (response DetectAnomalies200JSONResponse) VisitDetectAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DetectAnomalies400JSONResponse ErrorResponse
+
+func (response DetectAnomalies400JSONResponse) VisitDetectAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DetectAnomalies500JSONResponse ErrorResponse
+
+func (response DetectAnomalies500JSONResponse) VisitDetectAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryCorrelationsRequestObject struct {
+	Body *QueryCorrelationsJSONRequestBody
+}
+
+type QueryCorrelationsResponseObject interface {
+	VisitQueryCorrelationsResponse(w http.ResponseWriter) error
+}
+
+type QueryCorrelations200JSONResponse CorrelationQueryResponse
+
+func (response QueryCorrelations200JSONResponse) VisitQueryCorrelationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryCorrelations400JSONResponse ErrorResponse
+
+func (response QueryCorrelations400JSONResponse) VisitQueryCorrelationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryCorrelations401JSONResponse ErrorResponse
+
+func (response QueryCorrelations401JSONResponse) VisitQueryCorrelationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryCorrelations403JSONResponse ErrorResponse
+
+func (response QueryCorrelations403JSONResponse) VisitQueryCorrelationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryCorrelations500JSONResponse ErrorResponse
+
+func (response QueryCorrelations500JSONResponse) VisitQueryCorrelationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteExportRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+type DeleteExportResponseObject interface {
+	VisitDeleteExportResponse(w http.ResponseWriter) error
+}
+
+type DeleteExport204Response struct {
+}
+
+func (response DeleteExport204Response) VisitDeleteExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteExport400JSONResponse ErrorResponse
+
+func (response DeleteExport400JSONResponse) VisitDeleteExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteExport500JSONResponse ErrorResponse
+
+func (response DeleteExport500JSONResponse) VisitDeleteExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertExportRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Body      *UpsertExportJSONRequestBody
+}
+
+type UpsertExportResponseObject interface {
+	VisitUpsertExportResponse(w http.ResponseWriter) error
+}
+
+type UpsertExport200JSONResponse ExportStatus
+
+func (response UpsertExport200JSONResponse) VisitUpsertExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertExport400JSONResponse ErrorResponse
+
+func (response UpsertExport400JSONResponse) VisitUpsertExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertExport500JSONResponse ErrorResponse
+
+func (response UpsertExport500JSONResponse) VisitUpsertExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedLogsRequestObject struct {
+	Body *QueryFederatedLogsJSONRequestBody
+}
+
+type QueryFederatedLogsResponseObject interface {
+	VisitQueryFederatedLogsResponse(w http.ResponseWriter) error
+}
+
+type QueryFederatedLogs200JSONResponse FederatedLogsQueryResponse
+
+func (response QueryFederatedLogs200JSONResponse) VisitQueryFederatedLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedLogs400JSONResponse ErrorResponse
+
+func (response QueryFederatedLogs400JSONResponse) VisitQueryFederatedLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedLogs401JSONResponse ErrorResponse
+
+func (response QueryFederatedLogs401JSONResponse) VisitQueryFederatedLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedLogs403JSONResponse ErrorResponse
+
+func (response QueryFederatedLogs403JSONResponse) VisitQueryFederatedLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedLogs500JSONResponse ErrorResponse
+
+func (response QueryFederatedLogs500JSONResponse) VisitQueryFederatedLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedMetricsRequestObject struct {
+	Body *QueryFederatedMetricsJSONRequestBody
+}
+
+type QueryFederatedMetricsResponseObject interface {
+	VisitQueryFederatedMetricsResponse(w http.ResponseWriter) error
+}
+
+type QueryFederatedMetrics200JSONResponse FederatedMetricsQueryResponse
+
+func (response QueryFederatedMetrics200JSONResponse) VisitQueryFederatedMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedMetrics400JSONResponse ErrorResponse
+
+func (response QueryFederatedMetrics400JSONResponse) VisitQueryFederatedMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedMetrics401JSONResponse ErrorResponse
+
+func (response QueryFederatedMetrics401JSONResponse) VisitQueryFederatedMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedMetrics403JSONResponse ErrorResponse
+
+func (response QueryFederatedMetrics403JSONResponse) VisitQueryFederatedMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryFederatedMetrics500JSONResponse ErrorResponse
+
+func (response QueryFederatedMetrics500JSONResponse) VisitQueryFederatedMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryIncidentsRequestObject struct {
+	Body *QueryIncidentsJSONRequestBody
+}
+
+type QueryIncidentsResponseObject interface {
+	VisitQueryIncidentsResponse(w http.ResponseWriter) error
+}
+
+type QueryIncidents200JSONResponse IncidentsQueryResponse
+
+func (response QueryIncidents200JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryIncidents400JSONResponse ErrorResponse
+
+func (response QueryIncidents400JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryIncidents401JSONResponse ErrorResponse
+
+func (response QueryIncidents401JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryIncidents403JSONResponse ErrorResponse
+
+func (response QueryIncidents403JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryIncidents500JSONResponse ErrorResponse
+
+func (response QueryIncidents500JSONResponse) VisitQueryIncidentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncidentRequestObject struct {
+	IncidentId string `json:"incidentId"`
+	Body       *UpdateIncidentJSONRequestBody
+}
+
+type UpdateIncidentResponseObject interface {
+	VisitUpdateIncidentResponse(w http.ResponseWriter) error
+}
+
+type UpdateIncident200JSONResponse IncidentPutResponse
+
+func (response UpdateIncident200JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident400JSONResponse ErrorResponse
+
+func (response UpdateIncident400JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident401JSONResponse ErrorResponse
+
+func (response UpdateIncident401JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident403JSONResponse ErrorResponse
+
+func (response UpdateIncident403JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident404JSONResponse ErrorResponse
+
+func (response UpdateIncident404JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIncident500JSONResponse ErrorResponse
+
+func (response UpdateIncident500JSONResponse) VisitUpdateIncidentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdleWorkloadsRequestObject struct {
+	Body *GetIdleWorkloadsJSONRequestBody
+}
+
+type GetIdleWorkloadsResponseObject interface {
+	VisitGetIdleWorkloadsResponse(w http.ResponseWriter) error
+}
+
+type GetIdleWorkloads200JSONResponse IdleWorkloadsResponse
+
+func (response GetIdleWorkloads200JSONResponse) VisitGetIdleWorkloadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdleWorkloads400JSONResponse ErrorResponse
+
+func (response GetIdleWorkloads400JSONResponse) VisitGetIdleWorkloadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdleWorkloads401JSONResponse ErrorResponse
+
+func (response GetIdleWorkloads401JSONResponse) VisitGetIdleWorkloadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdleWorkloads403JSONResponse ErrorResponse
+
+func (response GetIdleWorkloads403JSONResponse) VisitGetIdleWorkloadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdleWorkloads500JSONResponse ErrorResponse
+
+func (response GetIdleWorkloads500JSONResponse) VisitGetIdleWorkloadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdleWorkloads503JSONResponse ErrorResponse
+
+func (response GetIdleWorkloads503JSONResponse) VisitGetIdleWorkloadsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryLogMetricRequestObject struct {
+	Body *QueryLogMetricJSONRequestBody
+}
+
+type QueryLogMetricResponseObject interface {
+	VisitQueryLogMetricResponse(w http.ResponseWriter) error
+}
+
+type QueryLogMetric200JSONResponse LogMetricQueryResponse
+
+func (response QueryLogMetric200JSONResponse) VisitQueryLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryLogMetric400JSONResponse ErrorResponse
+
+func (response QueryLogMetric400JSONResponse) VisitQueryLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryLogMetric401JSONResponse ErrorResponse
+
+func (response QueryLogMetric401JSONResponse) VisitQueryLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryLogMetric403JSONResponse ErrorResponse
+
+func (response QueryLogMetric403JSONResponse) VisitQueryLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryLogMetric500JSONResponse ErrorResponse
+
+func (response QueryLogMetric500JSONResponse) VisitQueryLogMetricResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecommendResourcesRequestObject struct {
+	Body *RecommendResourcesJSONRequestBody
+}
+
+type RecommendResourcesResponseObject interface {
+	VisitRecommendResourcesResponse(w http.ResponseWriter) error
+}
+
+type RecommendResources200JSONResponse ResourceRecommendationResponse
+
+func (response RecommendResources200JSONResponse) VisitRecommendResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecommendResources400JSONResponse ErrorResponse
+
+func (response RecommendResources400JSONResponse) VisitRecommendResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecommendResources401JSONResponse ErrorResponse
+
+func (response RecommendResources401JSONResponse) VisitRecommendResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecommendResources403JSONResponse ErrorResponse
+
+func (response RecommendResources403JSONResponse) VisitRecommendResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecommendResources500JSONResponse ErrorResponse
+
+func (response RecommendResources500JSONResponse) VisitRecommendResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopologyRequestObject struct {
+	Body *QueryRuntimeTopologyJSONRequestBody
+}
+
+type QueryRuntimeTopologyResponseObject interface {
+	VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error
+}
+
+type QueryRuntimeTopology200JSONResponse RuntimeTopologyResponse
+
+func (response QueryRuntimeTopology200JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopology400JSONResponse ErrorResponse
+
+func (response QueryRuntimeTopology400JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopology401JSONResponse ErrorResponse
+
+func (response QueryRuntimeTopology401JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopology403JSONResponse ErrorResponse
+
+func (response QueryRuntimeTopology403JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryRuntimeTopology500JSONResponse ErrorResponse
+
+func (response QueryRuntimeTopology500JSONResponse) VisitQueryRuntimeTopologyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotifyPromotionRequestObject struct {
+	Body *NotifyPromotionJSONRequestBody
+}
+
+type NotifyPromotionResponseObject interface {
+	VisitNotifyPromotionResponse(w http.ResponseWriter) error
+}
+
+type NotifyPromotion204Response struct {
+}
+
+func (response NotifyPromotion204Response) VisitNotifyPromotionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type NotifyPromotion400JSONResponse ErrorResponse
+
+func (response NotifyPromotion400JSONResponse) VisitNotifyPromotionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotifyPromotion500JSONResponse ErrorResponse
+
+func (response NotifyPromotion500JSONResponse) VisitNotifyPromotionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedQueryRequestObject struct {
+	Body *CreateSavedQueryJSONRequestBody
+}
+
+type CreateSavedQueryResponseObject interface {
+	VisitCreateSavedQueryResponse(w http.ResponseWriter) error
+}
+
+type CreateSavedQuery201JSONResponse SavedQuery
+
+func (response CreateSavedQuery201JSONResponse) VisitCreateSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedQuery400JSONResponse ErrorResponse
+
+func (response CreateSavedQuery400JSONResponse) VisitCreateSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedQuery401JSONResponse ErrorResponse
+
+func (response CreateSavedQuery401JSONResponse) VisitCreateSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedQuery403JSONResponse ErrorResponse
+
+func (response CreateSavedQuery403JSONResponse) VisitCreateSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedQuery409JSONResponse ErrorResponse
+
+func (response CreateSavedQuery409JSONResponse) VisitCreateSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedQuery500JSONResponse ErrorResponse
+
+func (response CreateSavedQuery500JSONResponse) VisitCreateSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedQueriesRequestObject struct {
+	Namespace string `json:"namespace"`
+}
+
+type ListSavedQueriesResponseObject interface {
+	VisitListSavedQueriesResponse(w http.ResponseWriter) error
+}
+
+type ListSavedQueries200JSONResponse SavedQueryListResponse
+
+func (response ListSavedQueries200JSONResponse) VisitListSavedQueriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedQueries401JSONResponse ErrorResponse
+
+func (response ListSavedQueries401JSONResponse) VisitListSavedQueriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedQueries403JSONResponse ErrorResponse
+
+func (response ListSavedQueries403JSONResponse) VisitListSavedQueriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedQueries500JSONResponse ErrorResponse
+
+func (response ListSavedQueries500JSONResponse) VisitListSavedQueriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedQueryRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+type DeleteSavedQueryResponseObject interface {
+	VisitDeleteSavedQueryResponse(w http.ResponseWriter) error
+}
+
+type DeleteSavedQuery204Response struct {
+}
+
+func (response DeleteSavedQuery204Response) VisitDeleteSavedQueryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSavedQuery400JSONResponse ErrorResponse
+
+func (response DeleteSavedQuery400JSONResponse) VisitDeleteSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedQuery401JSONResponse ErrorResponse
+
+func (response DeleteSavedQuery401JSONResponse) VisitDeleteSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedQuery403JSONResponse ErrorResponse
+
+func (response DeleteSavedQuery403JSONResponse) VisitDeleteSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedQuery404JSONResponse ErrorResponse
+
+func (response DeleteSavedQuery404JSONResponse) VisitDeleteSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedQuery500JSONResponse ErrorResponse
+
+func (response DeleteSavedQuery500JSONResponse) VisitDeleteSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedQueryRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+type GetSavedQueryResponseObject interface {
+	VisitGetSavedQueryResponse(w http.ResponseWriter) error
+}
+
+type GetSavedQuery200JSONResponse SavedQuery
+
+func (response GetSavedQuery200JSONResponse) VisitGetSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedQuery400JSONResponse ErrorResponse
+
+func (response GetSavedQuery400JSONResponse) VisitGetSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedQuery401JSONResponse ErrorResponse
+
+func (response GetSavedQuery401JSONResponse) VisitGetSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedQuery403JSONResponse ErrorResponse
+
+func (response GetSavedQuery403JSONResponse) VisitGetSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedQuery404JSONResponse ErrorResponse
+
+func (response GetSavedQuery404JSONResponse) VisitGetSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavedQuery500JSONResponse ErrorResponse
+
+func (response GetSavedQuery500JSONResponse) VisitGetSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedQueryRequestObject struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Body      *RunSavedQueryJSONRequestBody
+}
+
+type RunSavedQueryResponseObject interface {
+	VisitRunSavedQueryResponse(w http.ResponseWriter) error
+}
+
+type RunSavedQuery200JSONResponse map[string]interface{}
+
+func (response RunSavedQuery200JSONResponse) VisitRunSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedQuery400JSONResponse ErrorResponse
+
+func (response RunSavedQuery400JSONResponse) VisitRunSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedQuery401JSONResponse ErrorResponse
+
+func (response RunSavedQuery401JSONResponse) VisitRunSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedQuery403JSONResponse ErrorResponse
+
+func (response RunSavedQuery403JSONResponse) VisitRunSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedQuery404JSONResponse ErrorResponse
+
+func (response RunSavedQuery404JSONResponse) VisitRunSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunSavedQuery500JSONResponse ErrorResponse
+
+func (response RunSavedQuery500JSONResponse) VisitRunSavedQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchOpenSearchRequestObject struct {
+	Body *SearchOpenSearchJSONRequestBody
+}
+
+type SearchOpenSearchResponseObject interface {
+	VisitSearchOpenSearchResponse(w http.ResponseWriter) error
+}
+
+type SearchOpenSearch200JSONResponse OpenSearchQueryResponse
+
+func (response SearchOpenSearch200JSONResponse) VisitSearchOpenSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchOpenSearch400JSONResponse ErrorResponse
+
+func (response SearchOpenSearch400JSONResponse) VisitSearchOpenSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchOpenSearch401JSONResponse ErrorResponse
+
+func (response SearchOpenSearch401JSONResponse) VisitSearchOpenSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchOpenSearch403JSONResponse ErrorResponse
+
+func (response SearchOpenSearch403JSONResponse) VisitSearchOpenSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchOpenSearch500JSONResponse ErrorResponse
+
+func (response SearchOpenSearch500JSONResponse) VisitSearchOpenSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryPromQLRequestObject struct {
+	Body *QueryPromQLJSONRequestBody
+}
+
+type QueryPromQLResponseObject interface {
+	VisitQueryPromQLResponse(w http.ResponseWriter) error
+}
+
+type QueryPromQL200JSONResponse PromQLQueryResponse
+
+func (response QueryPromQL200JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryPromQL400JSONResponse ErrorResponse
+
+func (response QueryPromQL400JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryPromQL401JSONResponse ErrorResponse
+
+func (response QueryPromQL401JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryPromQL403JSONResponse ErrorResponse
+
+func (response QueryPromQL403JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryPromQL500JSONResponse ErrorResponse
+
+func (response QueryPromQL500JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluateErrorBudgetRequestObject struct {
+	Body *EvaluateErrorBudgetJSONRequestBody
+}
+
+type EvaluateErrorBudgetResponseObject interface {
+	VisitEvaluateErrorBudgetResponse(w http.ResponseWriter) error
+}
+
+type EvaluateErrorBudget200JSONResponse ErrorBudgetResponse
+
+func (response EvaluateErrorBudget200JSONResponse) VisitEvaluateErrorBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluateErrorBudget400JSONResponse ErrorResponse
+
+func (response EvaluateErrorBudget400JSONResponse) VisitEvaluateErrorBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluateErrorBudget401JSONResponse ErrorResponse
+
+func (response EvaluateErrorBudget401JSONResponse) VisitEvaluateErrorBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluateErrorBudget403JSONResponse ErrorResponse
+
+func (response EvaluateErrorBudget403JSONResponse) VisitEvaluateErrorBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluateErrorBudget500JSONResponse ErrorResponse
+
+func (response EvaluateErrorBudget500JSONResponse) VisitEvaluateErrorBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTracesRequestObject struct {
+	Body *QueryTracesJSONRequestBody
+}
+
+type QueryTracesResponseObject interface {
+	VisitQueryTracesResponse(w http.ResponseWriter) error
+}
+
+type QueryTraces200JSONResponse TracesQueryResponse
+
+func (response QueryTraces200JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraces400JSONResponse ErrorResponse
+
+func (response QueryTraces400JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraces401JSONResponse ErrorResponse
+
+func (response QueryTraces401JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraces403JSONResponse ErrorResponse
+
+func (response QueryTraces403JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryTraces500JSONResponse ErrorResponse
+
+func (response QueryTraces500JSONResponse) VisitQueryTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTraceRequestObject struct {
+	TraceId string `json:"traceId"`
+	Body    *QuerySpansForTraceJSONRequestBody
+}
+
+type QuerySpansForTraceResponseObject interface {
+	VisitQuerySpansForTraceResponse(w http.ResponseWriter) error
+}
+
+type QuerySpansForTrace200JSONResponse TraceSpansQueryResponse
+
+func (response QuerySpansForTrace200JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTrace400JSONResponse ErrorResponse
+
+func (response QuerySpansForTrace400JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTrace401JSONResponse ErrorResponse
+
+func (response QuerySpansForTrace401JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTrace403JSONResponse ErrorResponse
+
+func (response QuerySpansForTrace403JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuerySpansForTrace500JSONResponse ErrorResponse
+
+func (response QuerySpansForTrace500JSONResponse) VisitQuerySpansForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTraceRequestObject struct {
+	TraceId string `json:"traceId"`
+	SpanId  string `json:"spanId"`
+}
+
+type GetSpanDetailsForTraceResponseObject interface {
+	VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error
+}
+
+type GetSpanDetailsForTrace200JSONResponse TraceSpanDetailsResponse
+
+func (response GetSpanDetailsForTrace200JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTrace400JSONResponse ErrorResponse
+
+func (response GetSpanDetailsForTrace400JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTrace401JSONResponse ErrorResponse
+
+func (response GetSpanDetailsForTrace401JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTrace403JSONResponse ErrorResponse
+
+func (response GetSpanDetailsForTrace403JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpanDetailsForTrace500JSONResponse ErrorResponse
+
+func (response GetSpanDetailsForTrace500JSONResponse) VisitGetSpanDetailsForTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
 }
 
 type Health200JSONResponse struct {
 	Status *string `json:"status,omitempty"`
 }
 
-func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Health503JSONResponse struct {
+	Error  *string `json:"error,omitempty"`
+	Status *string `json:"status,omitempty"`
+}
+
+func (response Health503JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Query events
+	// (POST /api/v1/events/query)
+	QueryEvents(ctx context.Context, request QueryEventsRequestObject) (QueryEventsResponseObject, error)
+	// Query logs
+	// (POST /api/v1/logs/query)
+	QueryLogs(ctx context.Context, request QueryLogsRequestObject) (QueryLogsResponseObject, error)
+	// Query metrics
+	// (POST /api/v1/metrics/query)
+	QueryMetrics(ctx context.Context, request QueryMetricsRequestObject) (QueryMetricsResponseObject, error)
+	// Query alerts
+	// (POST /api/v1alpha1/alerts/query)
+	QueryAlerts(ctx context.Context, request QueryAlertsRequestObject) (QueryAlertsResponseObject, error)
+	// Create alert rule
+	// (POST /api/v1alpha1/alerts/sources/{sourceType}/rules)
+	CreateAlertRule(ctx context.Context, request CreateAlertRuleRequestObject) (CreateAlertRuleResponseObject, error)
+	// Delete alert rule
+	// (DELETE /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName})
+	DeleteAlertRule(ctx context.Context, request DeleteAlertRuleRequestObject) (DeleteAlertRuleResponseObject, error)
+	// Get alert rule
+	// (GET /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName})
+	GetAlertRule(ctx context.Context, request GetAlertRuleRequestObject) (GetAlertRuleResponseObject, error)
+	// Update alert rule
+	// (PUT /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName})
+	UpdateAlertRule(ctx context.Context, request UpdateAlertRuleRequestObject) (UpdateAlertRuleResponseObject, error)
+	// Handles triggered alerts from the alerting backend
+	// (POST /api/v1alpha1/alerts/webhook)
+	HandleAlertWebhook(ctx context.Context, request HandleAlertWebhookRequestObject) (HandleAlertWebhookResponseObject, error)
+	// Detect anomalies in component metrics
+	// (POST /api/v1alpha1/anomalies/detect)
+	DetectAnomalies(ctx context.Context, request DetectAnomaliesRequestObject) (DetectAnomaliesResponseObject, error)
+	// Query signals correlated with a trace or log entry
+	// (POST /api/v1alpha1/correlations/query)
+	QueryCorrelations(ctx context.Context, request QueryCorrelationsRequestObject) (QueryCorrelationsResponseObject, error)
+	// Remove a log export
+	// (DELETE /api/v1alpha1/exports/{namespace}/{name})
+	DeleteExport(ctx context.Context, request DeleteExportRequestObject) (DeleteExportResponseObject, error)
+	// Register a log export
+	// (PUT /api/v1alpha1/exports/{namespace}/{name})
+	UpsertExport(ctx context.Context, request UpsertExportRequestObject) (UpsertExportResponseObject, error)
+	// Query component logs across observability planes
+	// (POST /api/v1alpha1/federation/logs/query)
+	QueryFederatedLogs(ctx context.Context, request QueryFederatedLogsRequestObject) (QueryFederatedLogsResponseObject, error)
+	// Query component metrics across observability planes
+	// (POST /api/v1alpha1/federation/metrics/query)
+	QueryFederatedMetrics(ctx context.Context, request QueryFederatedMetricsRequestObject) (QueryFederatedMetricsResponseObject, error)
+	// Query incidents
+	// (POST /api/v1alpha1/incidents/query)
+	QueryIncidents(ctx context.Context, request QueryIncidentsRequestObject) (QueryIncidentsResponseObject, error)
+	// Update incident
+	// (PUT /api/v1alpha1/incidents/{incidentId})
+	UpdateIncident(ctx context.Context, request UpdateIncidentRequestObject) (UpdateIncidentResponseObject, error)
+	// Report idle workloads
+	// (POST /api/v1alpha1/metrics/idle-workloads)
+	GetIdleWorkloads(ctx context.Context, request GetIdleWorkloadsRequestObject) (GetIdleWorkloadsResponseObject, error)
+	// Query a log-based metric
+	// (POST /api/v1alpha1/metrics/log-metrics/query)
+	QueryLogMetric(ctx context.Context, request QueryLogMetricRequestObject) (QueryLogMetricResponseObject, error)
+	// Recommend resource requests and limits
+	// (POST /api/v1alpha1/metrics/resource-recommendations)
+	RecommendResources(ctx context.Context, request RecommendResourcesRequestObject) (RecommendResourcesResponseObject, error)
+	// Query runtime topology
+	// (POST /api/v1alpha1/metrics/runtime-topology)
+	QueryRuntimeTopology(ctx context.Context, request QueryRuntimeTopologyRequestObject) (QueryRuntimeTopologyResponseObject, error)
+	// Send a promotion notification
+	// (POST /api/v1alpha1/notifications/promotions)
+	NotifyPromotion(ctx context.Context, request NotifyPromotionRequestObject) (NotifyPromotionResponseObject, error)
+	// Save a query
+	// (POST /api/v1alpha1/queries)
+	CreateSavedQuery(ctx context.Context, request CreateSavedQueryRequestObject) (CreateSavedQueryResponseObject, error)
+	// List saved queries
+	// (GET /api/v1alpha1/queries/{namespace})
+	ListSavedQueries(ctx context.Context, request ListSavedQueriesRequestObject) (ListSavedQueriesResponseObject, error)
+	// Delete a saved query
+	// (DELETE /api/v1alpha1/queries/{namespace}/{name})
+	DeleteSavedQuery(ctx context.Context, request DeleteSavedQueryRequestObject) (DeleteSavedQueryResponseObject, error)
+	// Get a saved query
+	// (GET /api/v1alpha1/queries/{namespace}/{name})
+	GetSavedQuery(ctx context.Context, request GetSavedQueryRequestObject) (GetSavedQueryResponseObject, error)
+	// Run a saved query
+	// (POST /api/v1alpha1/queries/{namespace}/{name}/run)
+	RunSavedQuery(ctx context.Context, request RunSavedQueryRequestObject) (RunSavedQueryResponseObject, error)
+	// Run a raw OpenSearch search
+	// (POST /api/v1alpha1/raw/opensearch/search)
+	SearchOpenSearch(ctx context.Context, request SearchOpenSearchRequestObject) (SearchOpenSearchResponseObject, error)
+	// Run a raw PromQL query
+	// (POST /api/v1alpha1/raw/promql/query)
+	QueryPromQL(ctx context.Context, request QueryPromQLRequestObject) (QueryPromQLResponseObject, error)
+	// Evaluate an SLO error budget
+	// (POST /api/v1alpha1/slos/error-budget)
+	EvaluateErrorBudget(ctx context.Context, request EvaluateErrorBudgetRequestObject) (EvaluateErrorBudgetResponseObject, error)
+	// Query traces
+	// (POST /api/v1alpha1/traces/query)
+	QueryTraces(ctx context.Context, request QueryTracesRequestObject) (QueryTracesResponseObject, error)
+	// Query spans for a trace
+	// (POST /api/v1alpha1/traces/{traceId}/spans/query)
+	QuerySpansForTrace(ctx context.Context, request QuerySpansForTraceRequestObject) (QuerySpansForTraceResponseObject, error)
+	// Get details of a span for a trace
+	// (GET /api/v1alpha1/traces/{traceId}/spans/{spanId})
+	GetSpanDetailsForTrace(ctx context.Context, request GetSpanDetailsForTraceRequestObject) (GetSpanDetailsForTraceResponseObject, error)
+	// Health check
+	// (GET /health)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// QueryEvents operation middleware
+func (sh *strictHandler) QueryEvents(w http.ResponseWriter, r *http.Request) {
+	var request QueryEventsRequestObject
+
+	var body QueryEventsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryEvents(ctx, request.(QueryEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryEventsResponseObject); ok {
+		if err := validResponse.VisitQueryEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QueryLogs operation middleware
+func (sh *strictHandler) QueryLogs(w http.ResponseWriter, r *http.Request) {
+	var request QueryLogsRequestObject
+
+	var body QueryLogsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryLogs(ctx, request.(QueryLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryLogsResponseObject); ok {
+		if err := validResponse.VisitQueryLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QueryMetrics operation middleware
+func (sh *strictHandler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
+	var request QueryMetricsRequestObject
+
+	var body QueryMetricsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryMetrics(ctx, request.(QueryMetricsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryMetrics")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryMetricsResponseObject); ok {
+		if err := validResponse.VisitQueryMetricsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QueryAlerts operation middleware
+func (sh *strictHandler) QueryAlerts(w http.ResponseWriter, r *http.Request) {
+	var request QueryAlertsRequestObject
+
+	var body QueryAlertsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryAlerts(ctx, request.(QueryAlertsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryAlerts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryAlertsResponseObject); ok {
+		if err := validResponse.VisitQueryAlertsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAlertRule operation middleware
+func (sh *strictHandler) CreateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string) {
+	var request CreateAlertRuleRequestObject
+
+	request.SourceType = sourceType
+
+	var body CreateAlertRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAlertRule(ctx, request.(CreateAlertRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAlertRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAlertRuleResponseObject); ok {
+		if err := validResponse.VisitCreateAlertRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAlertRule operation middleware
+func (sh *strictHandler) DeleteAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
+	var request DeleteAlertRuleRequestObject
+
+	request.SourceType = sourceType
+	request.RuleName = ruleName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAlertRule(ctx, request.(DeleteAlertRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAlertRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAlertRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteAlertRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAlertRule operation middleware
+func (sh *strictHandler) GetAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
+	var request GetAlertRuleRequestObject
+
+	request.SourceType = sourceType
+	request.RuleName = ruleName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAlertRule(ctx, request.(GetAlertRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAlertRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAlertRuleResponseObject); ok {
+		if err := validResponse.VisitGetAlertRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateAlertRule operation middleware
+func (sh *strictHandler) UpdateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
+	var request UpdateAlertRuleRequestObject
+
+	request.SourceType = sourceType
+	request.RuleName = ruleName
+
+	var body UpdateAlertRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAlertRule(ctx, request.(UpdateAlertRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAlertRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAlertRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateAlertRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HandleAlertWebhook operation middleware
+func (sh *strictHandler) HandleAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	var request HandleAlertWebhookRequestObject
+
+	var body HandleAlertWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HandleAlertWebhook(ctx, request.(HandleAlertWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HandleAlertWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HandleAlertWebhookResponseObject); ok {
+		if err := validResponse.VisitHandleAlertWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DetectAnomalies operation middleware
+func (sh *strictHandler) DetectAnomalies(w http.ResponseWriter, r *http.Request) {
+	var request DetectAnomaliesRequestObject
+
+	var body DetectAnomaliesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DetectAnomalies(ctx, request.(DetectAnomaliesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DetectAnomalies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DetectAnomaliesResponseObject); ok {
+		if err := validResponse.VisitDetectAnomaliesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QueryCorrelations operation middleware
+func (sh *strictHandler) QueryCorrelations(w http.ResponseWriter, r *http.Request) {
+	var request QueryCorrelationsRequestObject
+
+	var body QueryCorrelationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryCorrelations(ctx, request.(QueryCorrelationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryCorrelations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryCorrelationsResponseObject); ok {
+		if err := validResponse.VisitQueryCorrelationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteExport operation middleware
+func (sh *strictHandler) DeleteExport(w http.ResponseWriter, r *http.Request, namespace string, name string) {
+	var request DeleteExportRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteExport(ctx, request.(DeleteExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteExportResponseObject); ok {
+		if err := validResponse.VisitDeleteExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpsertExport operation middleware
+func (sh *strictHandler) UpsertExport(w http.ResponseWriter, r *http.Request, namespace string, name string) {
+	var request UpsertExportRequestObject
+
+	request.Namespace = namespace
+	request.Name = name
+
+	var body UpsertExportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpsertExport(ctx, request.(UpsertExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpsertExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpsertExportResponseObject); ok {
+		if err := validResponse.VisitUpsertExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QueryFederatedLogs operation middleware
+func (sh *strictHandler) QueryFederatedLogs(w http.ResponseWriter, r *http.Request) {
+	var request QueryFederatedLogsRequestObject
+
+	var body QueryFederatedLogsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryFederatedLogs(ctx, request.(QueryFederatedLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryFederatedLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryFederatedLogsResponseObject); ok {
+		if err := validResponse.VisitQueryFederatedLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QueryFederatedMetrics operation middleware
+func (sh *strictHandler) QueryFederatedMetrics(w http.ResponseWriter, r *http.Request) {
+	var request QueryFederatedMetricsRequestObject
+
+	var body QueryFederatedMetricsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryFederatedMetrics(ctx, request.(QueryFederatedMetricsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryFederatedMetrics")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryFederatedMetricsResponseObject); ok {
+		if err := validResponse.VisitQueryFederatedMetricsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// QueryIncidents operation middleware
+func (sh *strictHandler) QueryIncidents(w http.ResponseWriter, r *http.Request) {
+	var request QueryIncidentsRequestObject
+
+	var body QueryIncidentsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryIncidents(ctx, request.(QueryIncidentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryIncidents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryIncidentsResponseObject); ok {
+		if err := validResponse.VisitQueryIncidentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateIncident operation middleware
+func (sh *strictHandler) UpdateIncident(w http.ResponseWriter, r *http.Request, incidentId string) {
+	var request UpdateIncidentRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.IncidentId = incidentId
 
-type Health503JSONResponse struct {
-	Error  *string `json:"error,omitempty"`
-	Status *string `json:"status,omitempty"`
-}
+	var body UpdateIncidentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response Health503JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(503)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateIncident(ctx, request.(UpdateIncidentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateIncident")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Query events
-	// (POST /api/v1/events/query)
-	QueryEvents(ctx context.Context, request QueryEventsRequestObject) (QueryEventsResponseObject, error)
-	// Query logs
-	// (POST /api/v1/logs/query)
-	QueryLogs(ctx context.Context, request QueryLogsRequestObject) (QueryLogsResponseObject, error)
-	// Query metrics
-	// (POST /api/v1/metrics/query)
-	QueryMetrics(ctx context.Context, request QueryMetricsRequestObject) (QueryMetricsResponseObject, error)
-	// Query alerts
-	// (POST /api/v1alpha1/alerts/query)
-	QueryAlerts(ctx context.Context, request QueryAlertsRequestObject) (QueryAlertsResponseObject, error)
-	// Create alert rule
-	// (POST /api/v1alpha1/alerts/sources/{sourceType}/rules)
-	CreateAlertRule(ctx context.Context, request CreateAlertRuleRequestObject) (CreateAlertRuleResponseObject, error)
-	// Delete alert rule
-	// (DELETE /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName})
-	DeleteAlertRule(ctx context.Context, request DeleteAlertRuleRequestObject) (DeleteAlertRuleResponseObject, error)
-	// Get alert rule
-	// (GET /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName})
-	GetAlertRule(ctx context.Context, request GetAlertRuleRequestObject) (GetAlertRuleResponseObject, error)
-	// Update alert rule
-	// (PUT /api/v1alpha1/alerts/sources/{sourceType}/rules/{ruleName})
-	UpdateAlertRule(ctx context.Context, request UpdateAlertRuleRequestObject) (UpdateAlertRuleResponseObject, error)
-	// Handles triggered alerts from the alerting backend
-	// (POST /api/v1alpha1/alerts/webhook)
-	HandleAlertWebhook(ctx context.Context, request HandleAlertWebhookRequestObject) (HandleAlertWebhookResponseObject, error)
-	// Query incidents
-	// (POST /api/v1alpha1/incidents/query)
-	QueryIncidents(ctx context.Context, request QueryIncidentsRequestObject) (QueryIncidentsResponseObject, error)
-	// Update incident
-	// (PUT /api/v1alpha1/incidents/{incidentId})
-	UpdateIncident(ctx context.Context, request UpdateIncidentRequestObject) (UpdateIncidentResponseObject, error)
-	// Query runtime topology
-	// (POST /api/v1alpha1/metrics/runtime-topology)
-	QueryRuntimeTopology(ctx context.Context, request QueryRuntimeTopologyRequestObject) (QueryRuntimeTopologyResponseObject, error)
-	// Query traces
-	// (POST /api/v1alpha1/traces/query)
-	QueryTraces(ctx context.Context, request QueryTracesRequestObject) (QueryTracesResponseObject, error)
-	// Query spans for a trace
-	// (POST /api/v1alpha1/traces/{traceId}/spans/query)
-	QuerySpansForTrace(ctx context.Context, request QuerySpansForTraceRequestObject) (QuerySpansForTraceResponseObject, error)
-	// Get details of a span for a trace
-	// (GET /api/v1alpha1/traces/{traceId}/spans/{spanId})
-	GetSpanDetailsForTrace(ctx context.Context, request GetSpanDetailsForTraceRequestObject) (GetSpanDetailsForTraceResponseObject, error)
-	// Health check
-	// (GET /health)
-	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateIncidentResponseObject); ok {
+		if err := validResponse.VisitUpdateIncidentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetIdleWorkloads operation middleware
+func (sh *strictHandler) GetIdleWorkloads(w http.ResponseWriter, r *http.Request) {
+	var request GetIdleWorkloadsRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body GetIdleWorkloadsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIdleWorkloads(ctx, request.(GetIdleWorkloadsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIdleWorkloads")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIdleWorkloadsResponseObject); ok {
+		if err := validResponse.VisitGetIdleWorkloadsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// QueryEvents operation middleware
-func (sh *strictHandler) QueryEvents(w http.ResponseWriter, r *http.Request) {
-	var request QueryEventsRequestObject
+// QueryLogMetric operation middleware
+func (sh *strictHandler) QueryLogMetric(w http.ResponseWriter, r *http.Request) {
+	var request QueryLogMetricRequestObject
 
-	var body QueryEventsJSONRequestBody
+	var body QueryLogMetricJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1525,18 +3576,18 @@ func (sh *strictHandler) QueryEvents(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryEvents(ctx, request.(QueryEventsRequestObject))
+		return sh.ssi.QueryLogMetric(ctx, request.(QueryLogMetricRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryEvents")
+		handler = middleware(handler, "QueryLogMetric")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryEventsResponseObject); ok {
-		if err := validResponse.VisitQueryEventsResponse(w); err != nil {
+	} else if validResponse, ok := response.(QueryLogMetricResponseObject); ok {
+		if err := validResponse.VisitQueryLogMetricResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1544,11 +3595,11 @@ func (sh *strictHandler) QueryEvents(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// QueryLogs operation middleware
-func (sh *strictHandler) QueryLogs(w http.ResponseWriter, r *http.Request) {
-	var request QueryLogsRequestObject
+// RecommendResources operation middleware
+func (sh *strictHandler) RecommendResources(w http.ResponseWriter, r *http.Request) {
+	var request RecommendResourcesRequestObject
 
-	var body QueryLogsJSONRequestBody
+	var body RecommendResourcesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1556,18 +3607,18 @@ func (sh *strictHandler) QueryLogs(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryLogs(ctx, request.(QueryLogsRequestObject))
+		return sh.ssi.RecommendResources(ctx, request.(RecommendResourcesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryLogs")
+		handler = middleware(handler, "RecommendResources")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryLogsResponseObject); ok {
-		if err := validResponse.VisitQueryLogsResponse(w); err != nil {
+	} else if validResponse, ok := response.(RecommendResourcesResponseObject); ok {
+		if err := validResponse.VisitRecommendResourcesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1575,11 +3626,11 @@ func (sh *strictHandler) QueryLogs(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// QueryMetrics operation middleware
-func (sh *strictHandler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
-	var request QueryMetricsRequestObject
+// QueryRuntimeTopology operation middleware
+func (sh *strictHandler) QueryRuntimeTopology(w http.ResponseWriter, r *http.Request) {
+	var request QueryRuntimeTopologyRequestObject
 
-	var body QueryMetricsJSONRequestBody
+	var body QueryRuntimeTopologyJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1587,18 +3638,18 @@ func (sh *strictHandler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryMetrics(ctx, request.(QueryMetricsRequestObject))
+		return sh.ssi.QueryRuntimeTopology(ctx, request.(QueryRuntimeTopologyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryMetrics")
+		handler = middleware(handler, "QueryRuntimeTopology")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryMetricsResponseObject); ok {
-		if err := validResponse.VisitQueryMetricsResponse(w); err != nil {
+	} else if validResponse, ok := response.(QueryRuntimeTopologyResponseObject); ok {
+		if err := validResponse.VisitQueryRuntimeTopologyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1606,11 +3657,11 @@ func (sh *strictHandler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// QueryAlerts operation middleware
-func (sh *strictHandler) QueryAlerts(w http.ResponseWriter, r *http.Request) {
-	var request QueryAlertsRequestObject
+// NotifyPromotion operation middleware
+func (sh *strictHandler) NotifyPromotion(w http.ResponseWriter, r *http.Request) {
+	var request NotifyPromotionRequestObject
 
-	var body QueryAlertsJSONRequestBody
+	var body NotifyPromotionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1618,18 +3669,18 @@ func (sh *strictHandler) QueryAlerts(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryAlerts(ctx, request.(QueryAlertsRequestObject))
+		return sh.ssi.NotifyPromotion(ctx, request.(NotifyPromotionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryAlerts")
+		handler = middleware(handler, "NotifyPromotion")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryAlertsResponseObject); ok {
-		if err := validResponse.VisitQueryAlertsResponse(w); err != nil {
+	} else if validResponse, ok := response.(NotifyPromotionResponseObject); ok {
+		if err := validResponse.VisitNotifyPromotionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1637,13 +3688,11 @@ func (sh *strictHandler) QueryAlerts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateAlertRule operation middleware
-func (sh *strictHandler) CreateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string) {
-	var request CreateAlertRuleRequestObject
-
-	request.SourceType = sourceType
+// CreateSavedQuery operation middleware
+func (sh *strictHandler) CreateSavedQuery(w http.ResponseWriter, r *http.Request) {
+	var request CreateSavedQueryRequestObject
 
-	var body CreateAlertRuleJSONRequestBody
+	var body CreateSavedQueryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1651,18 +3700,18 @@ func (sh *strictHandler) CreateAlertRule(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAlertRule(ctx, request.(CreateAlertRuleRequestObject))
+		return sh.ssi.CreateSavedQuery(ctx, request.(CreateSavedQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAlertRule")
+		handler = middleware(handler, "CreateSavedQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAlertRuleResponseObject); ok {
-		if err := validResponse.VisitCreateAlertRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSavedQueryResponseObject); ok {
+		if err := validResponse.VisitCreateSavedQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1670,26 +3719,25 @@ func (sh *strictHandler) CreateAlertRule(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteAlertRule operation middleware
-func (sh *strictHandler) DeleteAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
-	var request DeleteAlertRuleRequestObject
+// ListSavedQueries operation middleware
+func (sh *strictHandler) ListSavedQueries(w http.ResponseWriter, r *http.Request, namespace string) {
+	var request ListSavedQueriesRequestObject
 
-	request.SourceType = sourceType
-	request.RuleName = ruleName
+	request.Namespace = namespace
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteAlertRule(ctx, request.(DeleteAlertRuleRequestObject))
+		return sh.ssi.ListSavedQueries(ctx, request.(ListSavedQueriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteAlertRule")
+		handler = middleware(handler, "ListSavedQueries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteAlertRuleResponseObject); ok {
-		if err := validResponse.VisitDeleteAlertRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSavedQueriesResponseObject); ok {
+		if err := validResponse.VisitListSavedQueriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1697,26 +3745,26 @@ func (sh *strictHandler) DeleteAlertRule(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetAlertRule operation middleware
-func (sh *strictHandler) GetAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
-	var request GetAlertRuleRequestObject
+// DeleteSavedQuery operation middleware
+func (sh *strictHandler) DeleteSavedQuery(w http.ResponseWriter, r *http.Request, namespace string, name string) {
+	var request DeleteSavedQueryRequestObject
 
-	request.SourceType = sourceType
-	request.RuleName = ruleName
+	request.Namespace = namespace
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAlertRule(ctx, request.(GetAlertRuleRequestObject))
+		return sh.ssi.DeleteSavedQuery(ctx, request.(DeleteSavedQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAlertRule")
+		handler = middleware(handler, "DeleteSavedQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAlertRuleResponseObject); ok {
-		if err := validResponse.VisitGetAlertRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSavedQueryResponseObject); ok {
+		if err := validResponse.VisitDeleteSavedQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1724,33 +3772,26 @@ func (sh *strictHandler) GetAlertRule(w http.ResponseWriter, r *http.Request, so
 	}
 }
 
-// UpdateAlertRule operation middleware
-func (sh *strictHandler) UpdateAlertRule(w http.ResponseWriter, r *http.Request, sourceType string, ruleName string) {
-	var request UpdateAlertRuleRequestObject
-
-	request.SourceType = sourceType
-	request.RuleName = ruleName
+// GetSavedQuery operation middleware
+func (sh *strictHandler) GetSavedQuery(w http.ResponseWriter, r *http.Request, namespace string, name string) {
+	var request GetSavedQueryRequestObject
 
-	var body UpdateAlertRuleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Namespace = namespace
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateAlertRule(ctx, request.(UpdateAlertRuleRequestObject))
+		return sh.ssi.GetSavedQuery(ctx, request.(GetSavedQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateAlertRule")
+		handler = middleware(handler, "GetSavedQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateAlertRuleResponseObject); ok {
-		if err := validResponse.VisitUpdateAlertRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSavedQueryResponseObject); ok {
+		if err := validResponse.VisitGetSavedQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1758,11 +3799,14 @@ func (sh *strictHandler) UpdateAlertRule(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// HandleAlertWebhook operation middleware
-func (sh *strictHandler) HandleAlertWebhook(w http.ResponseWriter, r *http.Request) {
-	var request HandleAlertWebhookRequestObject
+// RunSavedQuery operation middleware
+func (sh *strictHandler) RunSavedQuery(w http.ResponseWriter, r *http.Request, namespace string, name string) {
+	var request RunSavedQueryRequestObject
 
-	var body HandleAlertWebhookJSONRequestBody
+	request.Namespace = namespace
+	request.Name = name
+
+	var body RunSavedQueryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1770,18 +3814,18 @@ func (sh *strictHandler) HandleAlertWebhook(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.HandleAlertWebhook(ctx, request.(HandleAlertWebhookRequestObject))
+		return sh.ssi.RunSavedQuery(ctx, request.(RunSavedQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HandleAlertWebhook")
+		handler = middleware(handler, "RunSavedQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(HandleAlertWebhookResponseObject); ok {
-		if err := validResponse.VisitHandleAlertWebhookResponse(w); err != nil {
+	} else if validResponse, ok := response.(RunSavedQueryResponseObject); ok {
+		if err := validResponse.VisitRunSavedQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1789,11 +3833,11 @@ func (sh *strictHandler) HandleAlertWebhook(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// QueryIncidents operation middleware
-func (sh *strictHandler) QueryIncidents(w http.ResponseWriter, r *http.Request) {
-	var request QueryIncidentsRequestObject
+// SearchOpenSearch operation middleware
+func (sh *strictHandler) SearchOpenSearch(w http.ResponseWriter, r *http.Request) {
+	var request SearchOpenSearchRequestObject
 
-	var body QueryIncidentsJSONRequestBody
+	var body SearchOpenSearchJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1801,18 +3845,18 @@ func (sh *strictHandler) QueryIncidents(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryIncidents(ctx, request.(QueryIncidentsRequestObject))
+		return sh.ssi.SearchOpenSearch(ctx, request.(SearchOpenSearchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryIncidents")
+		handler = middleware(handler, "SearchOpenSearch")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryIncidentsResponseObject); ok {
-		if err := validResponse.VisitQueryIncidentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(SearchOpenSearchResponseObject); ok {
+		if err := validResponse.VisitSearchOpenSearchResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1820,13 +3864,11 @@ func (sh *strictHandler) QueryIncidents(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// UpdateIncident operation middleware
-func (sh *strictHandler) UpdateIncident(w http.ResponseWriter, r *http.Request, incidentId string) {
-	var request UpdateIncidentRequestObject
-
-	request.IncidentId = incidentId
+// QueryPromQL operation middleware
+func (sh *strictHandler) QueryPromQL(w http.ResponseWriter, r *http.Request) {
+	var request QueryPromQLRequestObject
 
-	var body UpdateIncidentJSONRequestBody
+	var body QueryPromQLJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1834,18 +3876,18 @@ func (sh *strictHandler) UpdateIncident(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateIncident(ctx, request.(UpdateIncidentRequestObject))
+		return sh.ssi.QueryPromQL(ctx, request.(QueryPromQLRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateIncident")
+		handler = middleware(handler, "QueryPromQL")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateIncidentResponseObject); ok {
-		if err := validResponse.VisitUpdateIncidentResponse(w); err != nil {
+	} else if validResponse, ok := response.(QueryPromQLResponseObject); ok {
+		if err := validResponse.VisitQueryPromQLResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1853,11 +3895,11 @@ func (sh *strictHandler) UpdateIncident(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// QueryRuntimeTopology operation middleware
-func (sh *strictHandler) QueryRuntimeTopology(w http.ResponseWriter, r *http.Request) {
-	var request QueryRuntimeTopologyRequestObject
+// EvaluateErrorBudget operation middleware
+func (sh *strictHandler) EvaluateErrorBudget(w http.ResponseWriter, r *http.Request) {
+	var request EvaluateErrorBudgetRequestObject
 
-	var body QueryRuntimeTopologyJSONRequestBody
+	var body EvaluateErrorBudgetJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1865,18 +3907,18 @@ func (sh *strictHandler) QueryRuntimeTopology(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryRuntimeTopology(ctx, request.(QueryRuntimeTopologyRequestObject))
+		return sh.ssi.EvaluateErrorBudget(ctx, request.(EvaluateErrorBudgetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryRuntimeTopology")
+		handler = middleware(handler, "EvaluateErrorBudget")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryRuntimeTopologyResponseObject); ok {
-		if err := validResponse.VisitQueryRuntimeTopologyResponse(w); err != nil {
+	} else if validResponse, ok := response.(EvaluateErrorBudgetResponseObject); ok {
+		if err := validResponse.VisitEvaluateErrorBudgetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2001,114 +4043,253 @@ func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+w97W7buJavQmgHaAMoTtpOF2gu9kcmzczk3k7aTdLbH+NgQ0u0zRuZVEnKqScIsA+x",
-	"T7hPsuCXRMmkLDl2mp3xnzaJqMPDw/PFw3OO7qOEznJKEBE8OrqPeDJFM6h+PM4QExdFhi7Q1wJxIf+W",
-	"M5ojJjBSIxJKUiwwJcuPEIGjDKXyxxTxhOFcj4u+TJGYIgbEFAEoZwCsyBDAHNhX4kgschQdRSNKMwRJ",
-	"9BBHmAjE5jBbhnc1RcA+BXQMBJ4hICj4WiC2AGPanKkCzwXDZCKhS8ShoMwP3T6VUAuO/DARKWbR0e/R",
-	"RERxNBHyT5lQ/6inX6M4IuhrdO2ZXUwZ4lOapf7py8dgDrMCtWJhYJNiNkJMwr7DJKV3fsD62Xo0e4gj",
-	"hr4WmMkt/j2qts5M6OyYQ153rRUl6OhfKBES2xkSMIUC+jjNMOlnHCDTxxyRkylliIJyMPh89r5cVxRH",
-	"Y8pmUERHUVHg1McIiMwxo2TWcSJneO+pCJwh/wTyidqVlXwrR/IcJi2A1ONlaODkwgcwZ1TuRZe1m6E9",
-	"193gG0UEdx01FJb2I67zgY+FOC2YpkedgWZIMJz4V6Wf1QXA/G0EOUo13bgj5Ule/FfB4UQiPEMzyhbl",
-	"r6MinSDhFXRNIy8KemJBAfqGkkJo8c7opInAsvJQf/DqjUVebryhSrWAjE4U6oooLUg39ks9XSZ7Y1Qp",
-	"xuV2xI6p8O2aY2p4TglHO1uzszUOD+4sxV/RUuyU+9aV+7Ii9+vmL2g0pfQ2eBJQa7jCM8QFnOUBnO3j",
-	"GpO5nJBCgfblMB8x1Oh/SrXkB681VgP0kpKSLH2+AYGycNYTqm7sXqd8yDDOEFfcGeB+9bCOwZ0G6VsW",
-	"F1AU3A9LPwuBsszHiyRBXMkTY5R1YjuzVEwm0ge4XJAkvFyYWCdgGUP9DAh4iwiQP4QsZ8IQFMr8F3lq",
-	"fyLJFJKJ+jlFGZJ/9Ql6BrmQKKL0WHRkdPkK4AuShDjpJ5jcIpKeBZTpSD8GZ+/BS6lFx4zOAB1x6YiM",
-	"cIbFwg7Z6869H+gEJzALzZnpx2pOyckdIfdloMbGcEVYqRMgzrwbEOQe/p9SzQY1FCKp1E9+zCRxlWNi",
-	"cFuyUa2aKcMzbFhhDItMREevDg9jnzTCb3hWzIBWR3IyLNCMS9PAkCgYkWpbj1EwDuNohon5tZxYeqAT",
-	"rc04giyZXiZU24kfGBpHR9G/HVQxnQMT0Dk4sX+6dN5RNpWJjyxFrLYAhXzkW4McD6h8oUksu4ewfNMr",
-	"P1xAbSqCTMLE2pvROIlUc8UlA9Spdr2KnYJ6SFttv+xgLiT2pWVX2xyAERJALR9n7zdtCysomCQ4RUSc",
-	"9j8/JZSM8aRgKJXMKxieTBADFiAHd1NEwFhtg++IFXbfoT0JrjgBLq+5fFwiB9VvPnVTB9x+4EOSmBpU",
-	"efZ7iQaTARhGr2bDKAbD6O1sGO31P+1JMYUMc4mlPfgV0iWUDmI1b/PIl7nnvo0f+aZQ2A3l7b5U24FP",
-	"CbA59cnVwMmEoYkmo6XeW0O9V1Mv9XyavjaRb17nL91PRo91BjmaI4ZFwP23T1sNHyZjGsXRHWREAo2j",
-	"hGEhDbBfh5YHIZ+Cls96C0GHM1TJmvr3/VXHl5VHohJgRif7mzkM6RVu+UiUwRHKeEvsodsJoxzuW+7q",
-	"OIb0BD2Q+oQuuuHpvLBuKMTBtQ6tU/RDnaK64eqGkkNBi26QzOB1gh/OaisoveMdPs4jVOAxTpRQn0wh",
-	"IYYPPUtxRoLEDK1JyQCcznKxAHgMtLctTbl6bTFwfZYVBPfMExbfCDIGF+r3LQYLfJRbmp/S2994i/HS",
-	"p8gyblTiwAEmYIazDHMkfQ5HWTmeuaAi5FCoR84ZoKnySii+ZZRu/Ac6OSVC69e6FsrQHGXBQx3Qj33H",
-	"GDoJv2WjDJ73XGfOazvU0/IsTCcAKcTj/trTG7pV3Kjclgki0ouSnKxnWk+xhgPEoUlWarGEEgExQSy8",
-	"tnJI3wV10ueBWPT6U60V9V6bfh2sgDNvFXnrub6cpuEJ/lGMECNIIA5ymq4Juk+8sDFhj7lW2TlPdL7v",
-	"ctaM/6/JAV6N3tOCuJpnXSvijaKE/UD5S5sQeZ/X7mpChPc889ytazC+GMcpY5SFoxsqdntC01C8TD4G",
-	"CU2RG4tEDMj/sPa8v8FZLo/x0cefLvf/+Wr/w/7r137zEYhf/1rMINlnCKZwlNk5KztUTfAb5hyTCbCr",
-	"B2OMspSDF2X45wWAJAUvTAjohdc/wSJrXa0zszlUjGBqw41xVBBYiCll+A8dv6RshNMUycM7oeJnWhCd",
-	"v0DGGVb+oAomEJhdKsqp/dBjz+SyJHd0jn+ezlUUx+sNtF4PIPni5my7Ardpu26xxBxAzmmClea4w2K6",
-	"ceveOtVmzlXtdrjfWh9tjR+33kfa5H5r1cz+D0wC67zFJPXYTf2aOxuZ02yOuAlCnTBK/k5He+Epux0W",
-	"u0zZPseajkGv2R7hF/TbrbW9g8dwpE8zMgR5KFTIp5SJGMxgMsUEVYZGv1OmpmiENLtcwjvpAajLwRDb",
-	"9HVLrNLsdtcUjoJpPFUsTCN7LgFmMfiiY4odI6zKluzu0iJK0MdxdPT7Wrdq7S99oex2nNG72jvXu7u4",
-	"61XsGPRW5zZ9PSAWXCGOUQpMesK4yLKFG+Rq2y/HvdpQIMkgteFA0gwKqcomBnwMEpjnKAVQACkAHSNM",
-	"vwqR/6YC5Vzu0aWkmyfWnUGBSLL49PZQXaF1oeMS1DOBZj6SWtjvtgn73eZhzxAkHzT8zQNnWhuf0EKf",
-	"GzcLvZKLi63OU5CnmcnH2WfmevpTIYKmbZ0LRnvt7XWSqfAAjs7ln5suzkpg3ZNrHCilKUgEnkuVC5Nb",
-	"Qu8ylOpcJ4a4dBjT1dnfZvrrVaQNp25VE6/OnVIpBO5awB3koIF8j9TBUJ5FdVej7w7dC3ATomrbk00z",
-	"jH22Gt0uUK70Oo7xCeXimMBswTEPJ3ocn4GEcgGgGalIXtHCusTLM9fS5xtTXySwdcaLk+N15tldwu4u",
-	"Ybd/CbthDW6V7brqr1TWXVXfU5uMOCrFeN01lgAeEae39mh3kN0lhW7mINrkqJCTU+ZAtqeGVsPC2aE7",
-	"d2nnLu3cpZ27tHOX/szuUs/rAmfejjcGz8Ef20TQtKou2HDc1LXFXSKkH+jkr+hXZnTyAc2NESl9Fsv8",
-	"709/+vxLFEdn5z9/jOLoy/HFeRRHpxcXHy/8fO9yRBwVBH8t0JmGKliBSkf205RB7s+F2V3ZPEdP2RGP",
-	"kJOc0QkP5poGL2uq/e0UKV5OlV1WRB1B2e0PQ7peU6mp9W7rHshmvGG07mWQibZ/N23XVpViyz6a5fWO",
-	"LEnLaypBpkLkfoHawHF5mzIpwaM8BBnlNZiSDCkSiM0w0WkbFVvkFEvjWer/ATgdTAbg1SwGb2cxeCX/",
-	"eXMof5qu1AxlJc16KqLOVpWW6KbBL8yuLt9SrlLj/rtNJbz+e6UlXu/rrqld5wqgsr+dN33esbqzdQJa",
-	"jHy9WnySHibq8pExLz5IL4Rv/nowyQujZrYD/LPNhdz0xe+MssW2iKKhb48uGv5WSOPltIJIpr2iOc3o",
-	"ZHGa+rJTj4lNKk6BYHA8xgmQFljnG0Nb5UhoqroJQSAgmyCh/jBYrvD1HHovhcr2Up4/HmPEqoyvdIIG",
-	"4ISSuXxEydGQ7Ffhiv1hcXj4BpW/H4GbH+45S0rj8HCkfr/UqdAPZvwP9ykXtTEpF3bMjZxhAgW6g4tl",
-	"+ADcmGdHP9ybn87hrAfoJvLom849PgLdkC/H/3A/pVxIoGFrvZJ3GgxgWMkEKQRNqMfP+YKZCkyox1b3",
-	"NTlk4Jj+sL0va2d74HhOU3SBxorBFaOt+37DjqqoSumjGNDXq4Xmt4rSDbkxddYI/Hp19clU6nJA56aM",
-	"32SRoNSt0B4MbdqKti0cQCbP28A4pOBlQgnHXKiQABZTcABzfDB/dWDgHyjnY2+g2KItT6iO7NtDMQU5",
-	"YomUs6xEDph3YgeFQRe71swcqs/2bnuzvfPM9m7TszWyi+rT/YYg2cAczSSjhvPROGgoFjOv8EoasQ4i",
-	"Gd7qNnFbXlAjklhO774DXhJK9l9/+7bXwKo/Mh1s1rm3MuZYm6MmHZh+FwjzcqxFCAtedkVAqZXUQbh8",
-	"whsCaYbcV8ampf72Qro1ufVljyQnPm+Mjqq60ZbAq1ofrf9tM4aN1UQ1494ryWMLmFaWVylyXXdjFan5",
-	"l7jlAo0RQyQx/otinQDHDIDOV4c5AinKkVTJlIAbicON8k7kT//huiQuX9yodPrsDi44yGleZDoAb4O9",
-	"KRRwSIB0EhA3/hVRUrRvzYfpLvU3B+6NbQuFORjjLEOphFECLSvCEqWXVAAcYDEokbUejfRuJCCFZFnB",
-	"ZVuE6CIpJHSjEEwEg+q3vQqQ48tAATIEuQCUqPPRjWT2G0CZi/dBnTYSaz6lRZaCkTxIib+BG8MzNwc3",
-	"Ffco/DBJsiJ1iadttwSiHgMIUjxWGyvsPYrPKtaEus4XJ/UCqJdqqvr+xnJJqkeMWTtIGOV839ZSaKT4",
-	"3qD/JV2oOmoAPpWco1hElH1BHPYoOBoX2ZBI3Lj2r8tgVEmyab2uT60Sc1AQOIc4k3/TFOusyhplg5QL",
-	"l2qWRn5qbEDr+TvI/KJfXtpEA9SPTUubzrJGyHRdSBHI8Fwe+smg5b5vGdAnt7DHQydVKlmxdpiv9wZ9",
-	"7xn9ZT+DLnvt6OXGwYCy24zCFCCSqghXWGx8CK+p1Z1YaFOraxdsRFPda/TTx8sr6y7DLJ/Cymk2an6/",
-	"VPND4gTOwKzgwmqc6o44tqSL1Ua5RX3/+9//Y03HkFigcv/MG/vNN/a5nCjV5oWqJUhdYuk1JKqQNgZ4",
-	"LBVjLPW4xFyoi9Ui08FEeVrmJg2EFslU/1gC8Wm//gFjUPaV7xY9M2Q7tXLrXs4IVqA41OmMlhQ366IH",
-	"St1VbgygheA4RfXj1JBYjn5Z18VUuqrj/TyDQqK+NwDvNSJcd04rjLbzZoZIRIwi4euswSgbYI7xjkpX",
-	"q/Pg4sWkR4i8IScbCZT32/zmHZaDgT9c3Uncqxj1MtpNd01Khxo+ANL741rsFDuZJh+AkmwB5MlQioTU",
-	"E0NyN8VSeEys6w46B6q0kEtrOb+DSwGF6nKsMRiSl3dWL2qHUR3uJwzmU+WxnX+8qpwZ5XUq70uj/TeA",
-	"hdY+IzQkYySSKUoBRzlkUKBsUTkAjkI//nTmFXW57M5RRV9o0BOwlNZvbaDq7OatgJnNoK6g78Pg5q0l",
-	"tjN/78BcjVYSMMvWvsW+DrWAqPyBev+Jaw86FR2aIQZT7a//PLIs6cqo4VHNR4qJJe6F0FlqgzZL0E2x",
-	"l71DdDZL56yeSu1s7B7cRcW3zZc5JJeBfKJTdVWJKWlkFfEckhiMaZbRO0tfKWRXKEPSbVioEUCDBTOa",
-	"oswXMUhRawqTatzhzDgAH/WBaRjRW33UUj0v5I+UgWFUEC5PXW58VTd9Nl0r1PNASCDQfuI9mqNMYr0/",
-	"holcauNYYFB1XhqAq0WOE5hlC+mOaB2q3Dy1HswrtAfdspKuGEyQ3Kb3SECc8ZbSJSEYHhUmtQ2mutMo",
-	"zD45o3w2+cpQGDgAfG0tTZ/G80D2QNro46hAYgIIJLRKGig5GxPx7z96swh6eV5yls4eVw7l2UASM5SK",
-	"q0do3P1tbe11/fEjqG1hrKA4b0G0BUP5qFsTCkM8L4RuyZxBCH2dqF77WGVAtpkfR7e1S9aqTCCJ24pU",
-	"eT0knCa/E82daK4UzU6C9ZcQzU2k4iqR3FramoK+ZsKaUjzfL1/NnKnqUlKe2scw412O7Q21VF4LlMdK",
-	"99iugPrP7bsatD9XZm2NuUMWdR15Fgrw1gRag+8g0XGkh7Y7BGZM0CPoa7IVvO3bbDVNZ00yhVz1BGwp",
-	"P4NkUbob1TqmkANITNNCbTL82oFR6jgFyxbfPLY2NTjgPHRpK3ELZRLUMlNLc+KSyaNU+gpoP4qr0SHH",
-	"Q9PW73moZ90ch8biupfM+EYsZaX78uzXaun8PVqr+koslhbUngcgIL8NcuOdgX9RhDi2RwNVZeKSgmGx",
-	"uJR2TGP3E4IMseNCTOVvI/Xbz5Ycf/9ytWS3/v7lCggq1bH6RkYhpogI00x9AM6MO6AYR40yInJsOo1q",
-	"LTZFUBo9yMELjQBQ0f5EvaID/y+kBlAGV+kANaraFZUq9/Cg3JcxNZ9aEVBfHurbTffq7grB2VIpb7Ot",
-	"30d7/3/86QzkjM5xinh5R6dC3tr+mDoFHg+JNROQlOkwOtRc7oR+r3IiysswvnQbJgFCDu5QlknSqDoL",
-	"BczyAR8MyZlQ33SZMCjdLJWWY8Pcje9rzWhaZEg7XEgkunQRJqKAmUqgAHMMh0QuNoFZpu9T5IgU5oIy",
-	"bkmQgpG2uAaeDplnOEHGlhtyH+cwmSLweiCtZMEys0v86ODg7u5uANXjAWWTA/MuP/hwdnJ6fnm6/3pw",
-	"OJiKWeY0tY0CGxPF0Rwxrjfw1eBwcGi+WUNgjqOj6M3gcPAmkgdIMVUMbtP+dIOxg/IDH7n3Jl45Km6z",
-	"StP2rLw+8LQN1t/BwVRZJQ1Bt4CLyuS0n2i6sExqMihgnmdGbA7+ZRo+av+yU2+3+nnhoa4ITDGadb4V",
-	"HV4fHm4HA+PUKRQaEeOWPnYPcfRjJ4zKnsm1Ds9R5MRp1+umbPjM6Yj8EHddf60TtWflZ2QOM5zaqy+9",
-	"2lcbWq0FThmYmYUrveksqtbZeXPL+twA++Phmw2t6bLQTWG1Gfi2+MPc/krPkFCQI6aWStUhYI7RnRVM",
-	"OgZVmsmY0hjYZJERZDGoMpNG8A9pi06d5INUx/Ntwb2hXdUGe3OE+9mF+fYxfG86k5/uH76qEdBZgK9L",
-	"9yZZ2+TcaPCghP92Ywzu6A2VC0KoALjqMG7tkfNBN2UqleHSToKhRKMz+eaIcE4FqEF2L2ONEUHWBgg4",
-	"4dI5M0bhWg62Vkki3s0mVd5AdzP0gU62ZYSWCsqf2AQtV+x6tulDsDJ3Z3525udR5keJ41/U+HzYf/3u",
-	"WRkfj/bNtOqzuldpwprmrZUBrVK+taNdd/1rywS2o4J9he5PrIW9RdGefTPjHqmLv7d2/K5q7PspgyeX",
-	"3VkpNlZ8rSC5Emwyk/Wn6LqJsfkYXk8pPrYfu9uGEHu+A/7EMuz7dLRn+/SwnQTvJLiDBFffhzQCbGQo",
-	"LL+m/ufgXv9wtcjRwwErMtPMATI4QwIxrrJMg18QXv6srvr698uMTmKjVlR6oP6Y7p76pFV0pIKFka2K",
-	"iSoMoqYcuo5M7+/0XscB5XTCEBQIQFL7YjnppqL0y8flN8i3qKYk/F5K6tVm58dkIlG4XJBkpabSREwU",
-	"cZ6jtnr3dPM79IAZQzBdAPQNc8GfpQKxwlD7evnjtcjBvfxPtaDQApgh4U3xlX9fUxT1y3VR3KbR7i8P",
-	"etnPUR5+/C7yQKgAY/Wdw+coCpYZW0Uhjkxrj0YtJxJrcvEvSDwdC2uT0mmvmLSwaL7j3v8n3Ks4cAXr",
-	"/in8unhVBk2NCh7ErGVqRcvnTRYewf+cp+s7k/rl5+lMfnfjWSjiPD/18+wk37LgWi7cHRpNKb0Nh3J+",
-	"hSRVaS1lM/lmWAea/bVNHpbYXINQqHwx022R080U35PZSxRWMbqhPpgqCu14PcTrJpEuOvr92uX8tXhz",
-	"tWiU3b67hTmrruM9I51nTlfxbYiD/xM3TywQga+iePff0nEX9dxFPVdHPWtN+Y1QVyLVKtf31UdhHjoF",
-	"PJc/EgMENS6K38t0PjuzWT+zRKCfl3lWfRVim7rG+XLmd1I07gcmW7TMs/Uv/6JK5klP9SUTPO8zvRF6",
-	"9/s3XfRcqHtU2JG5UCV8XFdq4LlpDWs7zZRda1QJQtmYS/X8muA5Im5W/dGQwLIOS7VhAS/ddHzTcIjH",
-	"VWu6Mud/T+VtVa+r1jBD8rJsc2PiE7ZZkeno7HZ/5nsxQDCZ6lT95e6ZQ/LS9kBNaEFEbMqgzC+mL6rT",
-	"lpXvVQ1KlnvkDkm9Sa7f0Wv0T9mSCg40IHtiNRzqi+QRgYtmV6Sd37fz+1b7fc1mWo5abAqaRznqEqJu",
-	"JztT9NrzWHdli0+3IeSe6vUnFnBfibFnP/WwnUjvRLqDSJf12laQjQyF5ffe1OI+HKjS4G7yrKuItRej",
-	"y3V7irbqV/MzZVemSLfHudHW9XqOirasuOc58U+sXjx9gTz8pUbtNMxOw3TQMEui/xhlc68bEKnQUTAd",
-	"INUd46T8Q92EYT3F8wsSTgO6Z6F84vbZTMsi352wbibRW9FtW9c0u/sFlE25pzuds9M5q5IxWuU/pH2m",
-	"CGa6N4RXr5xMUXKrW+SrgY3eoE1dMli+itXwHylTjQZ9ZdOxso4p0ugtuvT18Iiaxh5gDiwctclvHoGk",
-	"7kNaw5HmyHRTPwIJJQQlqlnGGOIMpe3d1SogBdnUUitIrVeeet8TyQgOE5l9vX5ovFvvOPL7tVSn5p37",
-	"5epNm/TlxuQq5a2KupZ1f7N7QzsQU5W7DMZdmO9Fs8KH64f/CwAA//+jbxN7kb4AAA==",
+	"H4sIAAAAAAAC/+1963LbRrrgq6C0U2WphqJkO54dOXVqS7aVRCeOrUjO+EeoSppEi8QRCDC4SFZcrjoP",
+	"sU+4T7LfpbvRABo3SrJ0MvyRmCKBvn736+etWbxcxZGMsnTr5eetdLaQS0EfD0OZZKd5KE/lH7lMM/xu",
+	"lcQr+DaQ9MQsjvwgC+Ko/pOMxDSUPn70ZTpLghU/t/VxIbOFTDz4nydwBi+BKbwg9fQro63sZiXh0Wkc",
+	"h1JEW19GW0GUyeRKhPXxPsA4+lcvvvCyYCm9LPZgycmNdxFXZyqGT7MkiOY4Oi5cZHHiHl3/iqPmqXSP",
+	"KaN8ufXy1615Bn/MM/wqzOh/9Osf8L8I/n/umD1bJDJdxKHvnt787MEOc9m6CjU2rGUqExz7Ooj8+No9",
+	"MP+23pnB0AlARZDgFf+6VVydmtC6Met47b0WJxFP/0vOMlztUmbCF5lwQZoC0l+ChmN6v5LR60WcyNgz",
+	"D3u/HL8x+4LZYWdLAXC8leeB7wIEGV0FSRwte05kPT54qkgspXsC/IVupRNu8cl0JWYtA9HP9dG816eu",
+	"AeHQ8S767F09OnDfFbihQ7D3UVpC7T5GZThwgVAa5wmfRxmAALSSYObeFf9WRgD13VSk0udzSy0sn63y",
+	"3/JUzHHBS7mMkxvz5zT35zJzIjqfkXMJPDGsQH6Sszxj9A7jeXUBdeJBXzjpBvyiL16dSrEBGJqWTofS",
+	"sujKfdGv9WOvPGXQ2FzHyGIVrluzWE0K95vKDa/Z8BoLBjec4t+RU2yI+70T9zohd9Pmj3K6iOPLRk2A",
+	"9vABUDvNxHLVsGb9cwnIbEgAjJa7+JjrMOjpfyFZcg/PFKsydI1IIUi/uwOE0uOsh1T9wL188k2MESYh",
+	"6GyAfvqxvIJrHtK1LbifLE/dY/FvTUNp4Evz2QwmxW+SBNjcef+twq8oA5zdRLPm7YqZFgLqK+TfvExc",
+	"ysjDD02cc5ZIADWkOvnK15+i2UJEc/rsy1Dity5ED0Wa4RKlf5j1BHR8xUvhnSZIeiVmsGT/uIGYTvln",
+	"DwjoNlLRiyReevE0RUFkGoRBdqMf2ekPvW/jeTATYdOcIf9McyIk9xx5KABVLialg0WaIILQeQGN0JP+",
+	"jGS2kULB4SB9cq8MD5cEE7W2Go9qpUxhsAwUKFyIHESxl0/390cubBSfgmW+9Jgc4WRBJpcpsoZEZnkS",
+	"IdnmZ2gMGGQZROpPMzFKoHOmZqkUyWxxNouZT/wtkRfwwP/aK2w6e8qgs/daf3VmvUM8NcneJ75MShug",
+	"xW+59oDPezG+UD0sfYfCvOnEH4ACZhWNQAITrHsZFU2kmGtkAKB8audd4NRIh5hru3EnAHyH1RvOTtfc",
+	"MEYTAjJ+HL+5a15YjBJEs8AHiDgarj+BNnYRzHM4ZgReGHEOEOnpAVPvegEU+IKuwaViNYvvQmuCHRpg",
+	"fc/mZ7M4QX+5yE154HaFT+Jh8lBG99uW4/nYm2w9XU62RvDvC/h3Z7i2h2gqkiDFVWrFL0eREAXEYt6q",
+	"yhfaet+dq3wLkekLTdtlqTaFjxBYaX24GzGfJ3LOx6hP74U6vacL5+m5KH1pIte81jf9NaPbCoOpvJIJ",
+	"cOEGiqZ+bWV8QXQRo/lUJBEOOgIRBYAZGLCbhhpFyEWg8bfBSNBDhzKgyX/vdqkvnSqRGRCEjd27UYZ4",
+	"hz1VIiCkUbwU4c3aylEopjJMW6wQ/XQN87hr490WDZQJHSMNMWL0W6f1wrpGEWut5dF62UFIn+q3Vtuo",
+	"3GS+6DeSengdM4i122KUwZYPF+RFcRZcAIHAyV6D4hIpOHRsxXrSm6lHS/gy9o6WKyBQwYXHcjcydXrt",
+	"ZmxLLx0H7pinGZG3RJKIG/r7Hs0GrpOrzQ8K7E9pCxtjfdJYkMwaUhAIvGUQgrgnUfqwyJYlo2dx1iRa",
+	"0E+WNlAlfmYUp9LDpOuQdF7H8k8FLCv1mMIBWUJOH+fzRbF+OCFvFaxkGERy7JCKatJhXZBrgkIDMp23",
+	"38w7zyp8M8ENaQ7hbSPHHHmKYYJG4mmOuTP23rAeQ5qVemLsBEWWcw6D05lwXJGWgg6PvSSOM28m0Bou",
+	"Ijj2FIRgbbw2Yu/YO2XlI/Uqpzd2iMEtl/pGZpLutdngVlx7m9ZXARKSoHDoOHGTvmNcM9yoZNFPP8xS",
+	"YcIQRfCjocps2QdpL1soE0w6drOHBhX8CNRvzRNY6qXhUHgs32UEX/RWywcox0doqnpFQkFVPZYCxHMR",
+	"OiH0FYgsiDweXEwQA2gCunnfx56vxK7y4p/+458LXL38BMQMFZytZ98sGhaeOlH6naEUK7Qo8axEhTKy",
+	"EvFqyrN+M7aNCv/stihEKaDRlXO/b+RVQBsb4ZxAlCNfJD5Aifo6HcH+02AeIVWT0qcFTDW0xHn60vsG",
+	"sWYShfH1yHuuhEk/yJfwjO89oy8WwXxR3gM/Mp5EJYnumiQ6/AU+4EtuUZmW4zjMM/6B7PIK6MqzijBU",
+	"ULkscUG9goRx81SwDoYgdIrHgGoZfBfNbtz6mE0A0ewYwDDHPHiW5LJFsToyyEF8KUFzpQPoDjMPKAwA",
+	"yNPlt55vQ+D+sgyA8EW3DaXLWlIjWU0mEwv/e2JwcXfm9HtQO75ZWAeaslwsx7ZA9VtJixINJ5PIAu6X",
+	"ORy8/DSTLEXZ0F8mXXE+bfCjMsNygOyhxcuBHs/iHNR2YME4C3PHEqB28N5etrJi48V1tEBB6ej727sO",
+	"WS9lBm98wjzk2Hu/DDLiCGhUimLtegDALzZdN5HpU3cLLppY1tfyEzxhXGt8p/GVMoLptxRz6nuj+rUz",
+	"wjt7RRblDaI0vwBZKgCQfqNsY1V5JJd8CGiVg4/4P5BevQt57aU8tk3OrC2MnWa4FEHXTRmlRdbZ31Da",
+	"v5v89zwOXtIdkNI084EllbG4cdYrt7m07bprwkif/VXRijer57cgz6xfX0QdUGwwdmGdMeq/jedHUcbW",
+	"ljLOgSwX+ox9PttHRXhSeqJ2rOUD+o4GAJKWJSDcwVlMWQVCB/ZKJClpEUl8AXpjiznDMpmAWB82+pw8",
+	"/tnlZYnnzW9pJ6jjPdvW7DRt0a/GVQeDSTrJ0XCTjjOyhFRkotdzGaGRFxkDz7Setac5fqVpkk7TCmiv",
+	"mQC4S5r3Zh4ZuqFeRqaGUJn1p1orKGft8+thmrLmLQIDBu5vFfvNE/yYA/mJQApLPXhuzaGHhDNUJhww",
+	"V5fxzRE8NHQ7a4YnrQkBTjPTQLOWTXnWNW05nbzNxmkn9betsq7fS6FkTQfv+M0R+svDuBlbksiQpIqf",
+	"yGifujg3eSXSSKxAQgUNRyRxHvlKdpwtUIyEkwOdNPNgux4ovhh8BfJsHOakKdVo/CLLVl1Kxg/wjFoT",
+	"yshnoIRLsqzgwNot0zbAqXrOMciX9qNoj3AAAHpbRCKUTqoWd5DmCR0WMm8NeGTNKRks9vfHLREJ+y77",
+	"QSOJgl3jdjO2H1XmZ30bycjY+4gC7iq4isk+SaKn8FD2AKkzK6mzONDlP9Mxvkb0Qd8A3DjMNcXbVshF",
+	"7z9JPYC4BuPUoFAKcyMVa1EbzjfiO26F9kubtQ1qJOrjyo99dLqjppfKrL8FTL3r0iTwOCvzHmfqfACR",
+	"pApIQXtr5Bt0Kjt2nefYZLv4AR3BIgFV9YL0ZRz1ItPBBQW6MjjgCmZxGEqUOEduG8cyRhPHi4qN4/l+",
+	"WrZxwBe3tnHUcbAxLIR20hzewg4FExGtdioxsADOmmlSv8vtY01FGHOpLq3RTPG809AC6kYlPuaL9q6m",
+	"A7BHk/Yu0YbFCwUapPUmipqQkWWEjisR3bjxGiG6a02ED2f4ZG1XLeFKZxSqdIujbrb0GONk3c6DZwY6",
+	"ElBKShhAUwBDUZoFYWjw9haWIAuGRw1mIX3XHchSEUNEGL6HO/h1nUi18ybxoZA5LOWpnDu0dQ7Lsmz8",
+	"b9mm8J6WHFw50Bg+IlIGoSyHxa0ODiwb9OrFPk5/wP8/aA+/OVM+wkbuDKcTXwNAK4uHsYMVa2GzO4+z",
+	"ji2ithbX9VnnZIkaVWZOP3jT2Od1nrw/++DtiVWwd/VUhKuFeLqXhnG6R6acXQ63sAzTKI7FF5NIXIkg",
+	"VCGsH0QCD6EjTx8AmVKnknge2f4rpLb2cn2hJ3x2KgRamZkUWtHwFK7M2FKwjIOD8cG6JBdBMAwAheTt",
+	"PVgw3yoOIse+DI54+hmQFuJUenMY71rcoNiAxkTKddJWLLgAIBDqiUmkH1GSVGFLsN9hISRmCyzfgiMi",
+	"mk10/Z1sNQS8K3ddk/TxunordZGiBAH/+9n+YpDUYKbuRKlGycEC5/oG3lO0N9zHqgTRKtr+Ig8NcNft",
+	"5fqi0WIeq3H6Wq6Bl5BVtg7yuCcP9eTCXkpx7gsQwT0/uAr8wlaoSZs0L/Wcf7DLSNpHvRQBRdPVFv9d",
+	"olIFtNeZ1sVkCkjUReZtP0U0yKMszgHoQATdJ8oEZw3fTyL5aSGAfEh/x+GesC7Sy5iqkbOCL0ijUZ/d",
+	"61ncPgyO1VG0OO1pBlfI+pPM3INqAFEoanGtPpb9NTxrGAMzaA/9/FalYWtnZZ9tB8Y+OhmmxcGKP7+O",
+	"/aYsB4LyGfxuZ5AA7uI/AcdLGgr4/tXZ7r+e7r7dffbMbVVvyDr6IV+KaDeRwsfAFzVnYZ4vJvgpSMl3",
+	"oE/EY0+F98Rc6BPSEp+oS33ihJ4gC1t3a82shLap0GBA3neRZyDsBn9y1kmcTAPfl4ifIG9/hyYKTl6+",
+	"CAO6HQoBB9H8jE6O7oOfPcZt4UX1zlo5uqL4IKfXpjWpS+KLd+fyoOHu2t2hVwlEVKRpPAuUGy1b3LnT",
+	"o3Wqu4mBbXdPDNvrrZ0Ut9vvLV0Vw/bKwP4jSEbumS6DQnC23An8mj1bdBWHV/ALpw68hgP5z3i60zxl",
+	"v8DePlO2z7Gmv2TQbLdwlwy7rbWdJreBSBdlTCgAriG9ARaQjbylmC2CSBaMht8xSjMviMHlTFyT/I8p",
+	"nU1gM9Rbo4lmTyGnMXeB10kZDLzYdzhgOPI+sm2oZ14M8ZJNBuQW8IW1pbNR+0sf4+TyAtSYskS3yaA8",
+	"7wLHRmn1Shcda0CLlBYeACkp1NzwxrZrthoJCvHqjoL+1aLuOOgfbgRJ2VwNP/JmYrVCv0uGDrj9ntkA",
+	"R59WAFYtldqWqwRO0Jk3N/8zWHnbCnJ3UM+NYs4IqIHeRRBmDOgtLuW6rZBC4hXPGHcIRU57Nfo2MTBo",
+	"YHJBszdaw/DJIhGpdLura4fcnCh6mkelykAIhH6OmTSSLiZtC9OturCeVsLEnzq59dKp5xUTly4Uo3eC",
+	"KFdBqMXQ5nF3+G3kEm7Q+ePlK7YbAstnjyB6ZUSI7PhG7dm4KLSaeSnlKlWGooyAfxLRCKjmBbD3+BoT",
+	"h+TskiyaI6CU5vgAgHKYhl2Ggvw/QJiqlpT2YOLosg6485kje2+azy6lG2pmQBfRGK/CklsJEC39TMIr",
+	"gJgXDI/yIvjUHRyhFlCezkVodcBCJYwBbkEmrUF/5RdSs8jhezJxlf1Tr4ov8iTsPg18yFnr7bkrLwYZ",
+	"xY/yht3eA/fScvHNtvgjbYEHvD97vktJ3VmAMmmaxQmo6GOPDd3spoMbQUqMplyRLXbT7CZ009pGYMHT",
+	"mStK7iBruJ9DfQyDD6EJEtWUo9IJ16c7b7z1GqV6PvIA+5A6IRQzrZiG8ezShOOiEkOajO0JKtOv51tr",
+	"1qyDpecpKDQOXedHQ6eY+ni495Q99KjUxDnmUEeUdxZkDald5RhgID3njVz7zMa+Mjhf8g02Zq33Kq94",
+	"2XAvanZTsaUinikifsShQS64px8MtfeIX9jnxlbv0tkxy9AsoeQFABT6xzdOaQr9Cmxqa3BD2HV24GZ0",
+	"PitZ5APj5GvIFKDRacFumdrJ7xbiCqBVwviG2fXXlmBCEBiG2Mmr9VYrd+O63e9gwxTGaMeGD7Rem1dR",
+	"OSqDR6n80EkoogZtpFymaIUPquRvDH1iCBE+hyB17tsxaX3r55XNd+khOuCll1ZRP1WX2IkrW2NM2pHC",
+	"xzvQWTB/Vh/5LZUWEL40khl9xYobhDtUNGAK04rZgmd1ztIc9HJUKAGpcme7oKcSDpOi4ew24S50/3r3",
+	"5pTNLbbilopg6oCwe4KHhNKs1hhXrbo5Q+7R3ZHe6qBbaUpDs5TNJvODvctqUovaNrlQKD6HdoxhNemQ",
+	"HfYOmHPCmPHUrk+B1fQ6oO42RHhUPtP2+DAneLtXnmdwHmUzFpI3Ch+q76oez/1Yblq6hZePi5vyxopJ",
+	"2F/tllfimYtM28W6FM1PPRU0osIwjCZO8U8iSq9JLqPiCmw5cucJ9quR1HAjvUsEHgW0fiOsoWbQeAxu",
+	"SZePpgaNakYXMLqj+esyAkdDnLzY701ta6NijrcLOPTYB/c59sHdj70E6HhbhH7d7eAKHl/HOavcdzt6",
+	"YU8+vdd58ujrzOQyrRz7oUSfRRgLf2gO0GyVnxy8eB0nsoFsHrwAVa4ITPVen/ziUZFkFDQxmTUtosJM",
+	"S4hauBBMY04lKSWj2hFg7ZZheDlYEtOPo2wR3pyJK7fkQu4nfgZWmJrAbROWRZtAUyQXfXYtmH9Ra351",
+	"kzWsuSNFSgpdSNkdWocrxXoWGGGrVseBdtsmgHVFUTsoyu/0Oeg2O3gi0QotXwWUjeO2KbGl5Jck7HQM",
+	"H54cFyGpxGbUy2xQOS1N5m1j3PBOcxrJEZtnekbT0ysUlL+mWj0sIKt2dOUl2HsoY1QdBOqo4IS1Zmgv",
+	"3dF5BzVIW1zEJWRry+BijY/ytqKuunC9q8+TG5jGjaOWCIUBa2su3jYoH7FyfI1NKvIk0fywtniTV3qY",
+	"9QdqwyV6sYsSzXdaEEDHPWqmmO2RlvYGRsVeO4bVe3Aeq6qSdZI3uy7XKfmpq285gTHOXFztHX5dDV/p",
+	"HKx/uWtrFOPm59h3NKlfRvE1CLpcfVzry939WFoE29LRNhdTLyburmauS4+YvZD5rrL4AcX8myofFzUT",
+	"udKLXZJWqSttd3LXAKN/615un1E+6JJzr0EGOVSl5Jq1ucNjFlZM0Tk88uIsqiXoGhraVKZ2VruzZjx9",
+	"fbjOPJtiqJtiqPdfDPWOKbgmtuuSP0Ose8chfGWWYapcrr9HM8AtSlNofrQJUty0abibIMMqRDUJOaY8",
+	"a3uzhuKx5n4NG3FpIy5txKWNuLQRl/7K4tLAVBBr3r5leR6BPHYXAfFFv587jom3eXGf6Pe38Zw9Ja9M",
+	"AGeVpubOMgr5MsdKJVfSmlz5MamiqYn7kvAJje5TTAntGbZGb7yiHNJ66XBaqD0odsL5+3F0Mdky7IPi",
+	"2TgCs9sFas02Uvs9bzuqN/IChFZ3R12e0wENP4CwFM8TsVTLsjdAUABSpWTbPgmf5eIXalgvzQOu3GOi",
+	"4dNKZRMjgPVIOq/5PJ25ApokvDl69cv3MMzxu+/ewz8fD0/fwT9Hp6fvT9ct1x31KaWveuioWu2Jklbd",
+	"gb8iwyRjh5X76BnGUwLIJhh2qBI6WOCj2qlBBLNRsCxFaY294r7UoCkVZp9EAtNMQJeR3jyJAchxDz5A",
+	"IEVzAwjSmBTpYEcO+ApNVCl2E4arr/E/tv/PySTf338+43Hwo/x1f/dgfP73nbS57FqRhVEp35LIXU45",
+	"SU2RJd6kOkf8AqOspa4rRtySt0rpiKtVGDTEUeiwZNMUEnFGJlRMXp1atwVWBR7QQ8XNtSJebwW4Hzcp",
+	"Gjd1VOyqof2d9UgYXvUhzaSDvdLJWAUaPXyso6sCOT4BUFU5/HJ4+IvlwCIubdWuet1qkxLaSFEtFqRI",
+	"KsGh7T+3dlcgNDwQheX2PL3uX3FIB91s4JHv6plqBRqSHxouqfc6eodOmApEb4NItvbCKFZj0VkUk4oi",
+	"RrGKNa/z6IZA+hHV9rrzeJDVwb0MenAfETJLR1W6Igq4KAdOlN6ChLVBtPfagO1GM5FJv61E/hK5gcUU",
+	"EXbJGiIiFZ06tQq8f6u3IZiHgCDjYckiS+CrK/D3wEAYB2vQb++5gQo9nF2V6rZ8x1z6FnXmjz4BPO3S",
+	"PXma56sezhe3KEE/iZSowITeS5HSjzDKcIGcBYaYo5iqk1lYlgJ1EQCAV6Er03g+9URTklVZAKIkAqQo",
+	"L9HiMlbfjymXMc6z8Ym4WZJNl+vsODsJPph1+qvLyx2Zt5uk/sdpbx+QSuNs0tCYzl/cby8+4cpOqvKI",
+	"nkPp628e6XxN0wjt974qBViZN2uWCyjnFHx9ntHWbVa3czVR8fKTnOWZXcPLlFpXGdDnty3o3eB0u0+c",
+	"bFKCeGS5qmU9YDO+ZBmo7I0CLCjW0qL/wMywkMzT5ch7Af89xf8938dP3SUtTYfc9UhEUzpUPwreUhh/",
+	"tFZN/vNiSRWpsgbrQ42+dOspDUj8t/elX/Xs2t46QXNlxNqVYGwug3U7smMp32bpDTn3qNaNzCsG1+JV",
+	"YlUGHnvvQf5nAB5hB/B0ZPcBT0fENeH/wZ9yNIkw+2jk/aZL+CN9AXHv8jcig78tAp0/D7xjVS1GW+x3",
+	"sP0EiOmHxvJMSGkxunfGnbXUJrdBGOfWEtM8CClRRVXV3SmZH9RjtwuJNaIrtuSKZzmnKolqEfStLpni",
+	"lvHQ+pjcpOG8D+Q1RiFaMDEMBHXwuYJBu8m8yhgcYavMlBQ/7n4LatQCTSm+C3gQyG61Ahxg2JR4Ef77",
+	"vKEaK4oers64NBnzRbIAI7HoljaqlRFwcGsFav+uu4RjWP78dk1xwaqOzcyMbFkjLy51WTP7wEbIWE0H",
+	"G8xlA1lqb7Ti3OhUhtzftrS8IO1GrZbe9nxWloG+XLECVDdQX7dXsb8DhAQ2s21aa/02W+W/URrNb+oS",
+	"mfj9+mJ5vrOzNUxrKHUnsM692mCEZA1KFRzQWcQtupxSQ9I/qlZcMuAW1lusl0zSSh7VLrpS+Gg5iEQV",
+	"GlczUSoBchNBarncAoLIkOAbkV+wa1bJqn6D71zlI7uBBgNK8lRRrmYq4okV3iw3ZWSzkLLm85vN3Kw2",
+	"iSo8eEVIgEUVYa2fgCHPBLqYYrToJe4ihK1WOB0bo8SXqbyJI51mStOSsYMTj304+ZV9Xhb1s9PQ10wc",
+	"1zBhHY25iC6rGh5XjFt6Z3U5b+yJcIgmKH6eSJiVRlxLkoEjVt/bDdS9meqgPm6uxtvWCsAAIS9E+j0q",
+	"rTVm91czoa1hG0giAiQsxtmB6oyFuhn9XES1yKsgzlOQEX25CuMbGFqlVDWNf7x0VkV+bZoTBktVGX/N",
+	"CVSCmtMVWRvLHL16S9edKcK/AizdpV7AUx0PZFrvqkVkW6O9bGB6rWDJGfhTBzkdZqQAmPgBQWhrnjto",
+	"o8q097S1+F71KLl4bJ8t1bMTq4lfpYRCQgFzP0xxGu8bWPFSuAj9+0juUptb9USRKkpjeUyIG1oPZvEg",
+	"JIjkdU/wzOJh0D9k5Eawf1cepArxd55SWSyluJ8GwHaR7GYTQj0kaJVTu7z07p1nRTLn/Qz+i64Nf9cJ",
+	"/Zhxel+HUspnvbfx7+VovrRA2s85CK+BM1lxq5xQjgqaVQP8D37xBkUA4W5HCVfNldi0QPzsxf7SbU6l",
+	"jPXSsy+ePvsp6BcLqPdyKpE2gfhclXSGK3oUgP1n0Tl7/SZIA+y47n30bBFEkOOR55btrWoLbZVbny4q",
+	"lVv/8c9q7Vb45tbtB5vupz0NOet7XGcyy0jMXsd8lug1SX+d+daIHco1iveZi271TDGSfn6wXLXV0KfY",
+	"/0YeU6uY2mHXnXWG0Pc5SIvOFeVZ1nq7VtrL9ORRK2o78NJ9unj6ycG+KSbSI3gU35DicuArZ4TeppRL",
+	"3avHBPnk4IUpEtJjYPUSLGf4Wx0rqpy5fU6VM6itvb6u2hG4FuG8RLaHf4hXMQjPN0e+S5o9jIpoU925",
+	"DH20uhWw8g9E2MaIKjyoFlv4RZ2FupIrzjLqGBHoAN2k6Brho30M5GksxQ4Pv5xEu4V+ssvxrObvl97v",
+	"f/ucJjODwV9e0t8qzOOLev5vn32s6mE9A3/rZ37HGZTzoD6+5/2ufoO31CdUCfsPXV28/MT9i2DsXos3",
+	"z//t8yJOMxy02Z/bTQ7KAGD3f03iLJ7FrrpmALSe/rlca6yAkLHlHG72CPdrz11e4zsAK1UdOjNNJtd5",
+	"v4KClL1jvNhq6B5I09gO/VA5P6T3w4cPJ6a6nwkjLeoc2S2cJ7qElx0AV8Tie2icTrGAL9qAMEFINfnc",
+	"U+PvkYFtx9Wfs1wzrbzYF/vl+lHabajeGdzotFpFrTzbwf3NduCY7eCuZ6tUWqu0j4Uf72COasG1ivW4",
+	"EopCIGaqURlsVKF2rj7IjRO31UhrCvm13/G2ozjaffbp005lVcMX86Ub/d45uy4cMjuqnoPy/wKb4pdH",
+	"jELkydbY6mtM7TD6dqZ2duZAIv12jnSp+nMVcayFWKmYDuk0zAmcpPXW9L8xDrujllpLYbNyfmXn8egm",
+	"iJ1V1em4zvuBiirqXrVZXkgsnaTkFwKdBojhqvjpQqwwD5vrqMWR9zuu4XeSTvDTf9giiQ0Xv1MOTAgX",
+	"mHqreIWJBtrth7NhYdNJ5KGQAJSf5auIsGhXs4+pmF3CrN9a4/4OGAeXsoNjXwRhiOlEXjGoKTI6I7pE",
+	"iZZYJt8sVks0KN3gQL+zw1X5JLkL1kQ1WpTZZGvEfyWC/topBrJkGVHuLO39jsD+O3qwrHXvlc8GV50u",
+	"dCh4KjPYpIIZeLSAHlpfEM3C3LcPj3k3DhJw5pvnBxd0sZlpdePgir08OZRBvE1Tle+XnOYoreq9e7Mk",
+	"TtNd3Y+NF5XujIcngzd1WBx7JwZy2Odo1Q424JGnEmjxJLogpyHJ1yZc0RzZotwblHZJXXZVy9xQVhq6",
+	"dJGySnIiZv5bp6bPyH0ad0D13Il/36sW3NVL1CFC6/uAyKqP1CIMrigHbjyoSN6J3RzQcU4cc2VAuxmu",
+	"d8ZD89ndrQPHfe7aossVxUAVuivqTTaizU53+mxfqt7o/z21It8IRbG2pRaXRbhaiEJoVmR+15D5SWTZ",
+	"ADmDU1GcIuJgpI9uRBdlNwb9f//9fzXrmER6ULw/9cZu9Y1djl5QDZpWHOtkJ01QygOIkRdIGEcmHkYH",
+	"DpC1E7VllTFBbbL5oxnERf2GhxQXVU17FkzkYzvSeGuH77sCuEzZ7NicuNpXvEfkrhBjsB55GviyrE5N",
+	"Ig3R22VaTGFFF7srIJ249J1K3iKspRTCWK5AggtRhCRdZw+K2HhKjbdIOu3OsRbnSoYY38t4cieh1MMu",
+	"f3BmZy90L4zs9WVXxbUir9tD6Y87mzE4qSAvSonD4H0yhiKdmETXiwCRR9m6MNLCKBJ+nrBbu0l/97B+",
+	"PrylVzCJtq81XWSBkZT7eSJWC5LY3r3/UAgzJHVyuBst+1vslqOaLQENkJwzl8qVwEA1WLgRAMolf52o",
+	"jtvu7YlzmQYdTj7kfmsPSrqbO9dRm5OHAHiTU0F93wO4vra/oOYjcO/IYWJQHcP566kGSRtHS2UNCIhx",
+	"7XnG1ZDGbZygH2Ffq3zubSOg3R4ieymuaz4TgLs/6yDCivwP4u/ATahXXt10Z1G2aPd9e4h1qtxAC5ZS",
+	"J3v2wsPiPE70yy48NGGXbeHXlcP+0nr8b4O0pfrtsNrK1qU2VVZu8Ly0LLA4D0fdY8Xxq9j4L0oTyVNb",
+	"GTWXgiQes1TnoCG41YMueHErNe/smlZ6MpQLlSnDRzf558/48pcv2prhCOlFBuJ2I2uka+1rYrapOwLS",
+	"PotzKAK486hHYz7aazsG961L3Yh35d1QXiB6mxLAr0ozVt2IaviNpLhcddzeT+pohPfm3ZlHVebK8+i0",
+	"5F1qS5N2qqNWnRcFk6NHRxaq9thqRjye+Qc6c/tbFJ9IwFLOj6W4MYKaBmaQ70N4D2vhYEq6dhX6chaK",
+	"hLp46o0rY52VZ26FiyPATqKpLNeXc12gK6+pJapON3kMqPATH1YHSOfNsT3la1wzlf9ffJolSb4YGNMf",
+	"cNHjrX7UfCWipiZRRyYDplwKDs4mGsFVhWF8rYUVlFg/yFCiDn5DT3g8rIedlEOX+d2XrXXnZmTzL2bE",
+	"fDf6YrIVX7LdkjAMPwLUTNDVgSZM21lJGTjcHmrEvzfY19PUGfv5BjP3cdW7F2KGW63Y2NRSrZcASm9W",
+	"wQyETcx/yVghIQJK+wHaaZY97hc+RniF1/QG5MQgbOmPIDIYZpqr4IZhWVZ0Y9YAjoXoCK13Dcna+nf7",
+	"0pBXRSKKi6ypHpXdBpkxcJbe5gtAExTt4ZWm+qn8BK/9+E1TjUY04B3e4rT1GB0nnrYstGWF+NOPTv6I",
+	"LyIpqxyec4R+FTgbRxhqkRh0j0XZylYOWNC2dszqKryAa+uob8yPNNc23qDmBjU7UbMXYv1boOZd1E8l",
+	"lLy3KiE0+pr1Qeqi8lctD6IMlGUsMQrxhQjTPjbwClmq1d4s2cBpULcRfNM44K9VyKgE3E0cdR18ZqX+",
+	"3hCah++B0ZjYio+2CwTqmUaJYCjLpvHun2fTNL0pyUKkR2zlaDQsiejGiBvFPhaY9hF5pAkpLcpNHZI4",
+	"toSCOsdXP//YZIbVD7xrMsfi2prC8kqFgAw7sY/JQVSGIuiwE6enmwQPPlu35EG/9RMcKpvrX+fc9USt",
+	"CJirrFlzeRitmt9BJXld1+vmFnXdXRXtahtqt/BnIr1shMZrNf5p3gSxAxorEoub5UmQ3ZwhH+PVvYKl",
+	"y+QwzxZUmIj++k4fx39+/FDjW/AdUE0kx2idEzkW8MxUuujYO1biAAEOPaVQBGeIk+BPpmILKZDpAdo/",
+	"4QV4XMmaXmEv+hOkAMRwiQbQU8WtUNz5ly8kvlzEbEGKMsGROGzDteNgPkixrJvOKu1U3+tgOuyrCnd4",
+	"FaBXWQe8sDWS+I8qC5eOJpFmE5x5yHFa5Lc1N8HvFUKEiSxJa6ElOKDAHvFhqMplqsE0HKTjSXSM0SpA",
+	"X9AnlnKMq/YZV7qCL2M/D7mWEnmXOVdwluWYJY8exqtATCLcLBqoTIFn4YtVBkRcH4GpAKrGY5tpGMyk",
+	"4uXquA9ht/DUszFyyRzb19ItpS/39q6vr8eCfh7HyXxPvZvuvT1+ffTu7GgX3hkvsmXIeJyFlduzLwbG",
+	"hk8pX+BTeG8fXwJ8i8QqgK+ew1fPuTr4ggBcx9DLKxLhjIF75Qxr46rYVuYov1b44k3EpQ6VosmZOSMh",
+	"5hGOrlRDBRVI8EoV/EIgVeGIVC2d0Wbvv1L2a7B82Vkc/KrW2utLmRCo2p9a+KZzeLa/fz8rUEIdLaFi",
+	"Meazc1aihIe/6bUiq+4ryQevyVK8tWXZabd+ClKqS2sCW1UR2yeG/T4hXHuihJ8nWwWcTYVvTnHUd/+4",
+	"kLadH0dXIgx8HUfCu316R7vVgwNZWaqNE920NpVHQtFb6d/htn6pDPvN/vM72tNZTlxKsYFPN3+qUCqU",
+	"DKMYMzxoqzEpAVeBvNaICdy8iNm8iOORpyMvpyIZFTUU4M8/kRfZVVd8tufragjq7IBcTwPfl9EdHtx3",
+	"9pgvbgP371+d7f7r6e7RLoCTfYDWBjiaW4RnRKhobXcK2iqAlYf3zPgv7gzALbpxo33sAb6FN1XwI5jp",
+	"IpjniO/EKolxsZCgTgJePC7eu8NDeAcrKo1sRzYpJiI1D8gEZgT/qra1dY4Pa66EC+/HkwppoD8bestO",
+	"7vtgQrUq6F+ZBdULJDuu6W1jIeQN+9mwn1uxH44t+fdkPm93nx08KubjoL46vkfRXqKEJcpbyqntIr4l",
+	"1a4//dU5d/dDgl11xb8yFXbWoHbcm3rulrT4oanjg5KxhyMGXx13lwZtNPpqRLIxWKX5UB/enmjMzw7F",
+	"4kN6656QmAd/SBwuraD5+vixDQZvMLgHBguNMhqBFQ41469Kpt37zB+weOyXvQTtjdVIyV+djhSO0tDt",
+	"LIoG3TiEtw3SwEiRFQoPnFJrPSy1G+AIaCzU0Z0vt4oVbFXx0BZktIuWS1OaZg48tKsXFXzlJk6vKecA",
+	"PWDWmoOoH4nil+l8T/NQ3ieZwvEHEamndzs/HCMu4ewmmnVSKj5Elc3xCKnVwdeb3zoPEWKw6o0nPwVY",
+	"BewxEhCNDGbRd0NF9j7jP1TPiREwBHLiCvHF79dERX65jIr3ybSH4wNv+zHiwzcPgg9oWbygNs6PERU0",
+	"MLaiAmbIOXjK9zJbE4rhza8HwsxSet1VghxWXm2g938I9BIEdoDuX0KuG3VF0JROwbEwzZlal+WSJnMH",
+	"4v+y8tcXJvnlxylMPjjzzOlwHh/5eXSYr0FwLRHuWk4XqhGTW1v6QUQ+hbUkAbaBxeDMillHqPvVFZNq",
+	"YM5D0FI+qunuEdLVFA8J7GYJXYCuTt9b0AltYL0J1lUgHUD0uQ35a8FmD9SI4iWcBGgx2JeSg9waTAmw",
+	"TZGo9uy6UCXGho1UdC1hAXkjVwf7uoRltYcOVqKKJpFd9ciUNsVX0kwXoqD4M06qNXVS8HXdDQZdZ0Hs",
+	"p5Noe3qjy7cXL2TYPDyIdMaAFLOFic/ECnMg3SQAk/Iy3Rl7h14azPE6AhiOjwRnoHTKgBxqAcXEqbOm",
+	"tioipWJLU5FKajIyveFm5dSaHKbBWlBRGmTBFUbMZQs4Okw+ppKeGZySSHw1MDbMG3sfqV35jP6i8q1U",
+	"scncD6wLewWKIOUkfXX7VKuJ+mrcFE1g8A50NagQrmBFwZIzqpSsitVguLI3E3mqavynASzhjKvVqHbd",
+	"2t/mrSiXIApvvtXBEVkSw9ehgG1TgN8kwk4oqqAeFpvJuYX3Ia3+5g2BFoKIynlSkX5VHRefOtQbvi+6",
+	"aS/Jao/wtWlnbRkt9JOf9Xz9cNHwfkNFh1FRPu8CqxAbC+I0yDs0i5NEhoy9XT6ik+Aq1qRamOh5VCtE",
+	"ES3+JLVCyYmMxr6OnGXypBPjgbQgiStRVayYqaH1pZWWZofaj4qe1nIZZAg/IkEtbxJxs4/ZAssiYM7A",
+	"tg5kt6LbmUqndkNCGnZHh03RqmDVFH4MNEZaTWCtatZeGokVEEPqf4rTY11Rpn8rPCjkYaWjQlYQ53Bt",
+	"k8gsZ2Qnq6RFb1WLmwCVf/aNB29iTQdD4q8XMRA9xSMn0QWmuuMIsT6RCAEaaR5X1tLd61wki1wSry1A",
+	"uCeiZU3xkP68+jKacVM/izRKAe/Gwbdx8HU7+DS0zAoAIlFQEwMgQ3aCjabUnA3oItTyEyJzuvfZJE58",
+	"4c+t5vqzLF6lmjzTCNhxdRlfIXUCCS+PLqP4OlK/MUxLLtPuMt0f0WN1o+c3rmooNGKCk204/FAOT3ck",
+	"SxdXD9bqMA8WkXfbcTIXkcot2tFc772dDGOuy3SBcNjh7Eo//Q1xo7ZiUest4k4MgexIowJYbLkqIwor",
+	"JaBmAOv000LmKDJg4ZuLIMwozxsVLu/suTfNgSOjxuN9//pM/UUSEpcyN9lSoKYoDcNX81GZMBCA4L4A",
+	"80AXQaABuPW2ny68Qjfc+ZZUF9AJUbezX+Uu0qwtwvBBdInuXeorI0KteUmhCmefSfixaExQKwKlqYVb",
+	"m5pEFXWqnFmllCpWsUIss4QKFiqhpIximy5Q5EDhnE+iNghIlRwDVFQ30MTMdaQnnATrkGZ+WcF4mUWp",
+	"7iHBiAZ/qNwimtxUg6gnFekjnGOnlGRDe4fTXj65Dupb49AXgGsMh72zFgJpEZaKjSnWGO1CLaJN5KtI",
+	"sYNHyRSVjrw0VuWvBWNfBEydvGHI7T0u8csDoTEnVd9YYzyBITEF0lFFjoodkvdGmPLpiKVFqW3vo9Z0",
+	"Sn2mgXiwhmYPxwqQvfpKr2fQaaakXWF7ikRq8XvsHQm9CaCVhViuO5QHRSuYpLDC8YGgjooR+SNTZB7l",
+	"sEA1HFrKZI6VtE2FDl3q2KpGge+ZYh5Gv9V3I2+4nbihtqi4qaUyV9Hqmq2i0QPpt1aZOqqGxs8S3WR4",
+	"4Fnoa6B/p0x4QC034IfLmeKesOiaId36MBo1wO94AOn/hbNiSnv8GukxG33v30PfKwgWp9pR9X0X6U6H",
+	"cZIBWRiamZhma3fFT9ZjAtzDGBvvIOPBEu7w4zRA4U2dDp+H4lY4Ab0Ay5aGtHsstGqhr6j9LsIYhUdD",
+	"2GschF/nLRny63VSX+ZQfcmv14f68pD9ye9fPimmutNNdsyGFN8LKdaUsB81bvGSGLdjvzQay0s5LJPm",
+	"WL94T8hvxn9I9K8uou3O9TluMH+D+d2YH1joo7G6QKlWvP6sPx77X3ol1KDj80LZxPhNZPxsSHQbLosZ",
+	"7jiO0SxgWBSjPpl7pjUnefbAhIZW0E1lHm384r8pkfmqUeMGCB53zLhC+qBA3V50TmuRgR/KXd2SK20W",
+	"Y05J46qY4kCaCcV8XtSXUZFuHMEWzDwc3TMNv0xA1iQ6LNvz+DmOSku9gxflDtWvT37xcqybwNEjUlyW",
+	"4vQmUZqJG1yEDFWjJ6vOjQlQU73GcYKSWdO0KkPzIQZ8ySWoqIlRnmGaYMmdkOHSF9iWQKCzNOUCuqll",
+	"Diw7KcQkCtHlAkwgzVPsdUaPn0psBStfwazsM2LrpKNrmfce1E1SkvVxFwZL6gVCZTVMoQX0KVF3dO6e",
+	"5lIrv5fAa0L50dz3PRF6e46HIvXlNbQgmQ2jlgVgQ/M3gqVVqesr7v8DuZGTNDMUU1XV4e6lnI94I7MK",
+	"JzglyC3T3J7arOYGYTzf7WlffAM0/ooc5DMs/oslfhNvEaRZPAc5uVBzXY4sCpa2qTDQqtc8SsrDmXqz",
+	"GIKsooHJza76bGV4T98W8xnPDocRqqLDM7HKiAko9qTee4I9ZKil0mTLmwP5XbE9U0XxBakVhyqySaQq",
+	"aFPJYYQKXAv2cq5wmnp5dbOBb3lZpv8gExkyIprmYcWcvGfyFwGVjGb4pbK4on1VBwyQkyttCW3WvaBK",
+	"gc0j5dpjF3pqse1JpNpMwWBv4zmDS3tosy6Z9pNO+bonDxGP/8DF00qLaHURaUh6vOHMG2byqGp/IKXY",
+	"xYwLXRJ5GNnWOLqLSL0EguqrqN1G6n2IjOVPJeGifM3VmJcxrIZF7S6SrUghOlwog2U7xeqNni9uUjs2",
+	"iQZOc1AS0sx0GZaJhkWWlKmDhXZd87eYJ4Lwoh3pB/ugE2zDSnlEUhG2ecE7asWWxkBh2jwqDkAqAz00",
+	"Yk8SOYmwonYSoyue29bYR2cHTqNewh4btVXWJrIYi0RfI01eYY1nEOxLh49+LupziBBmNmKJ80+G6AIO",
+	"4mvmO9Uk+p4IsB6/vMEHIsRNi2khP0p1JIjfkOINKXaTYgNQRQcrB5EaSJi5g/Wubn/ez74SgmDNsaG6",
+	"6bpp4E5KvvGvI1FWLWYtyvxyEgnTRYU6knvbdjH9OUgk10CmgRZ+UsdiKvYzdS1epy7pk2jbdHxXJ7Or",
+	"qn1mIpnLzKyTKu7v2ERWzOeJnLP5hA8FRtPWGxL1dZql+kPnVxbUHBZlenWbLBhjdOHUGW3EaZJRK63E",
+	"74tQlmd5KApZXUUzXqhHC/jaeNU2RLJbXk0qYGMRxSqiOYgjZfWqPaR7QMuWcYe0egb0KS2Jo+YtE6+p",
+	"1lgYZUmyotzhuaSEv3gS2VObtOIRiIY6ep/zD/kHo9uLYiIJsh7nBWD4PKUqs/hYHlmZj+kBrJ7iTbbM",
+	"iidbY6x5rDZNki68jEbsOCmlQOOEfkxmn3Kmc4+EZpZXHRnNHiU0Y+5iVfhEE5MvV2GMpnThRSDaqkN0",
+	"UdV3lJpt9nFPBNWM/8463kFk1ZGHZI/lpcpNi8kIGiA2EfGdEfGIkSyIKDS04b9XgQbmNG1IL650/o3V",
+	"kl4H4qF2WmSo6Oj2INNaF6a/iDk2StftvCdRuZl6qf23q7O619pYfRIVAQHapkZaaz5NsyDLteZoTRJQ",
+	"Vg5gr+mmrgLMDfLD7Kj3cXOsb6226/ggRVgOi+LmhKaitfo9oanVu/1hal1aO3SAt8q/FI8t63CkU88L",
+	"QEYinKD0LNlZWEDADSsg/9be/69ZAbR09OWaLRTlzPVAa8lyj1JqQ/QAeqk7mXYFmyuCYycW4zKdRRPf",
+	"0inw0djEqkygR5Qpw44YIsY1SoXjGCzWVVLuSUEqqAXO2na4ZUqNSrGThGx0k8cA5XiZZSi8RYK07txd",
+	"Ge7OUqDP+2CdM53flYVf4fBdEvCZRdoeb33bTYjbfc9sw8H/jLq+Nld2YbfiUbWopzYE2f9KYqh92o+5",
+	"Mu8G7zZ4V6pI3IV067LUm69WU6TXnPfGv9EN1JlB8Fc9p6YOHmfGTqKyM83hoD0zri6Hy37k2EA+G3sn",
+	"xcNWDTUy7HiZuKQosCDRQQjfAgibRoNmnuJNtghx+if61VS0FcZrRT7VyzD2GyKXQpdTK4gX6AaXRsXS",
+	"RsVJVO9ZiSp/GW7YrsW/FuUHTKhYoKLfdIU87apzXNmT1LsMImfg72kefU0rUH6f0QHC9wOuAXpidY1n",
+	"QKw2cG+wBaF10Ga8mA5cy/8nk19YJPybqG4uyVV/1rJQBhwv91D83EImqk5qg/GGyW+YfCXyAW3l7Uy+",
+	"xu0Scb2HXimuAbDH/7SENyDlFrZ1W1cPmAIJYkt9WkT9oktqpq1I5LwDvLaLDlDsK1ARjChQ5Vb8eJYv",
+	"dYPrcpmWwr5K5BVdlwm6BVWtTfgbaD1+gdSWzKxF4Fk9wJeJNAU4/52C1Mh7gFcsP2GtPB3m1RIYDCsH",
+	"voSOPDqjdGRcqkuABUwuGXnzMJ5iWS0dQcHeQgpPuQBsjvB8YI0xBRDrA+PiCJqJmVoEHH0srul2X8pP",
+	"cpajNsVFd+OKCVN7M6jYAdVP9ZdB5CVxKL05HFNmB/i5mA1fcHHV98RvigkeMjC4tooWisAQr47/cal+",
+	"yCe0asrgU/QMh19V8NPG2vnIiDbgdJ2m9iTf6Lz9I+zK82DKTRELP7/Vsrgi1/glJjDk7B79w5ScEapg",
+	"PSDfjDMwTPCXCTXTsQ0zjOkae0dUSuWK66mnMuQPNSpfif0qKPtUXnCVeu1tRWWBWYb6mwg8ElCu2sJ/",
+	"m5nIDdtJ+0vMA8dSC6bwaQHr/KRpOw9o8jdahizYAte7eTC6TQSML/oe4zl+fvuQ5Lq0gmYMZDVhQ6k3",
+	"lPouKbVNRPsFEqdhnO5RfOyu6qbVSKiPVLIRydniSgShrmsDxGkPVVnduYSUY4wx5mji1iYmJo9PRQ2b",
+	"yN/iLR38a2KEdZlEFmmBCKpgQCRsRZQzR/3yrrAoNLAUjM4xEb6U6hiIaGbyLoQu6D+FQSj1u1IVH0vi",
+	"OxLlvMY8OVW0tneiHNN2HOWMC2m8BV4SvtcHWrQGcGbL6RsiIHnF13lP5WiLGR6qJq29gmb8ObKBYJMu",
+	"tyGl7aRUYxCSuLO370s0xCKo8JOTmtqm1q6KXcqYN7Bcl6rWfz9YXbI7PghWOyyfzgR22xC6weYNNrcl",
+	"E2QaZbo7Xij8/Uz/Hvtf9qgVUD985q5BLPFww42BqH2GA8CB0vK2RkPqgWXqFYdDTW1lqE/tL0xe6Jy7",
+	"bWl0nRsKs6EwPTrvVFH/NsTmM/6jSgI2thH3ZUZFgsl/gS+sSXgwcgnefsPDPQriM2qfDTfb0Euazm04",
+	"obtvWqMOt4vYmDvd0JwNzekKmWrF/ybqs5AizBaNdOX1Qs4uCcf4QdXwRiNelZbUQ+x/4PFviVMrK+YC",
+	"vuemM9gD/hPVwYB5eHlk4KrSju74DGVXQZONHmeN8lvlRZKWWF5j4bp+iVafSPUfxRru0q+vfOTcaB7d",
+	"1VaLkVpbJfO9zxAQLCBS93r+pfLu561XsEOZHOYIVb+eIzlV73x21CxSIal2NYCCeJMfqU77f8yngA8S",
+	"jY5Y/SVrH+ToiqpR1oexN+Z6Ue3wy/mX/w8GU5NNEHwBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,70 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// DetectAnomalies handles POST /api/v1alpha1/anomalies/detect on the internal port.
+// The control plane calls it on the schedule of each AnomalyDetector resource.
+func (h *InternalHandler) DetectAnomalies(w http.ResponseWriter, r *http.Request) {
+	var req types.AnomalyDetectionRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind anomaly detection request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateAnomalyDetectionRequest(&req); err != nil {
+		h.logger.Debug("Anomaly detection validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	// Guard against misconfigured deployments.
+	if h.anomalyService == nil {
+		h.logger.Error("Anomaly detection service is not initialized")
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1AnomalyServiceNotReady,
+			"Anomaly detection service is not initialized",
+		)
+		return
+	}
+
+	result, err := h.anomalyService.DetectAnomalies(r.Context(), &req)
+	if err != nil {
+		errorCode := types.ErrorCodeV1AnomalyInternalGeneric
+		switch {
+		case errors.Is(err, service.ErrAnomalyInvalidRequest), errors.Is(err, service.ErrMetricsInvalidRequest):
+			h.logger.Debug("Invalid anomaly detection request", "error", err)
+			h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, errorCode, err.Error())
+			return
+		case errors.Is(err, service.ErrMetricsResolveSearchScope):
+			errorCode = types.ErrorCodeV1AnomalyResolverFailed
+		case errors.Is(err, service.ErrMetricsRetrieval):
+			errorCode = types.ErrorCodeV1AnomalyRetrievalFailed
+		}
+		h.logger.Error("Failed to detect anomalies", "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			errorCode,
+			"Failed to detect anomalies",
+		)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const validAnomalyBody = `{"searchScope":{"namespace":"ns","project":"p","component":"c","environment":"prod"},` +
+	`"detectorName":"c-anomalies","sensitivity":"high"}`
+
+func newAnomalyRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/anomalies/detect", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestDetectAnomalies_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockAnomalyDetector(t)
+	svc.EXPECT().DetectAnomalies(mock.Anything, mock.MatchedBy(func(r *types.AnomalyDetectionRequest) bool {
+		return r.DetectorName == "c-anomalies" && r.Sensitivity == "high"
+	})).Return(&types.AnomalyDetectionResponse{
+		Threshold: 2,
+		Signals:   []types.AnomalySignalResult{{Signal: "latency", Anomalous: true, AlertID: "alert-1"}},
+	}, nil)
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}, anomalyService: svc}
+	rr := httptest.NewRecorder()
+	h.DetectAnomalies(rr, newAnomalyRequest(validAnomalyBody))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"alertId":"alert-1"`)
+}
+
+func TestDetectAnomalies_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	h := &InternalHandler{
+		baseHandler:    baseHandler{logger: noopLogger()},
+		anomalyService: servicemocks.NewMockAnomalyDetector(t),
+	}
+	rr := httptest.NewRecorder()
+	h.DetectAnomalies(rr, newAnomalyRequest(
+		`{"searchScope":{"namespace":"ns","project":"p","component":"c"}}`))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "searchScope.environment is required")
+}
+
+func TestDetectAnomalies_ServiceErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"invalid request", fmt.Errorf("%w: unknown signal", service.ErrAnomalyInvalidRequest), http.StatusBadRequest, ""},
+		{"resolver", service.ErrMetricsResolveSearchScope, http.StatusInternalServerError, types.ErrorCodeV1AnomalyResolverFailed},
+		{"retrieval", service.ErrMetricsRetrieval, http.StatusInternalServerError, types.ErrorCodeV1AnomalyRetrievalFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockAnomalyDetector(t)
+			svc.EXPECT().DetectAnomalies(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}, anomalyService: svc}
+			rr := httptest.NewRecorder()
+			h.DetectAnomalies(rr, newAnomalyRequest(validAnomalyBody))
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestDetectAnomalies_ServiceNotReady(t *testing.T) {
+	t.Parallel()
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}}
+	rr := httptest.NewRecorder()
+	h.DetectAnomalies(rr, newAnomalyRequest(validAnomalyBody))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1AnomalyServiceNotReady)
+}
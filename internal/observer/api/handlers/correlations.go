@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// QueryCorrelations handles POST /api/v1alpha1/correlations/query.
+func (h *Handler) QueryCorrelations(w http.ResponseWriter, r *http.Request) {
+	var req types.CorrelationQueryRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind correlation query request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateCorrelationQueryRequest(&req); err != nil {
+		h.logger.Debug("Correlation query validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	// Guard against misconfigured deployments.
+	if h.correlationService == nil {
+		h.logger.Error("Correlation service is not initialized")
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1CorrelationServiceNotReady,
+			"Correlation service is not initialized",
+		)
+		return
+	}
+
+	result, err := h.correlationService.QueryCorrelations(r.Context(), &req)
+	if err != nil {
+		if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
+			h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+			return
+		}
+		if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
+			h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+			return
+		}
+		errorCode := types.ErrorCodeV1CorrelationInternalGeneric
+		switch {
+		case errors.Is(err, service.ErrScopeAuthFailed):
+			h.writeErrorResponse(
+				w,
+				http.StatusInternalServerError,
+				gen.InternalServerError,
+				types.ErrorCodeV1ScopeAuthFailed,
+				"",
+			)
+			return
+		case errors.Is(err, service.ErrCorrelationInvalidRequest),
+			errors.Is(err, service.ErrTracesInvalidRequest),
+			errors.Is(err, service.ErrMetricsInvalidRequest):
+			h.logger.Debug("Invalid correlation query request", "error", err)
+			h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, errorCode, err.Error())
+			return
+		case errors.Is(err, service.ErrLogsResolveSearchScope),
+			errors.Is(err, service.ErrTracesResolveSearchScope),
+			errors.Is(err, service.ErrMetricsResolveSearchScope):
+			errorCode = types.ErrorCodeV1CorrelationResolverFailed
+		case errors.Is(err, service.ErrTracesRetrieval):
+			errorCode = types.ErrorCodeV1CorrelationRetrievalFailed
+		}
+		h.logger.Error("Failed to query correlations", "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			errorCode,
+			"Failed to query correlations",
+		)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
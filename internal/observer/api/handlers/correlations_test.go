@@ -0,0 +1,119 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const correlationScope = `"searchScope":{"namespace":"ns","project":"proj","component":"api","environment":"prod"}`
+
+func newCorrelationsRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/correlations/query", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestQueryCorrelations_Success(t *testing.T) {
+	t.Parallel()
+
+	anchor := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	svc := servicemocks.NewMockCorrelationQuerier(t)
+	svc.EXPECT().QueryCorrelations(mock.Anything, mock.MatchedBy(func(r *types.CorrelationQueryRequest) bool {
+		return r.TraceID == "trace-1" && r.SearchScope.Component == "api"
+	})).Return(&types.CorrelationQueryResponse{
+		AnchorTime: anchor,
+		PodName:    "api-1",
+		Warnings:   []string{"logs could not be retrieved"},
+	}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, correlationService: svc}
+	rr := httptest.NewRecorder()
+	h.QueryCorrelations(rr, newCorrelationsRequest(`{`+correlationScope+`,"traceId":"trace-1"}`))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"anchorTime":"2026-01-02T10:00:00Z"`)
+	assert.Contains(t, rr.Body.String(), `"podName":"api-1"`)
+	assert.Contains(t, rr.Body.String(), `"warnings":["logs could not be retrieved"]`)
+}
+
+func TestQueryCorrelations_Errors(t *testing.T) {
+	t.Parallel()
+
+	validation := []struct {
+		name    string
+		body    string
+		wantMsg string
+	}{
+		{"missing component", `{"searchScope":{"namespace":"ns","project":"proj","environment":"prod"},"traceId":"t"}`,
+			"searchScope.component is required"},
+		{"missing pivot", `{` + correlationScope + `}`, "one of traceId or timestamp is required"},
+		{"bad timestamp", `{` + correlationScope + `,"timestamp":"yesterday"}`, "timestamp must be in RFC3339 format"},
+		{"window too wide", `{` + correlationScope + `,"traceId":"t","window":"1h"}`, "window must be greater than 0"},
+		{"log limit too high", `{` + correlationScope + `,"traceId":"t","logLimit":5000}`, "logLimit must be between"},
+	}
+	for _, tt := range validation {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, correlationService: servicemocks.NewMockCorrelationQuerier(t)}
+			rr := httptest.NewRecorder()
+			h.QueryCorrelations(rr, newCorrelationsRequest(tt.body))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantMsg)
+		})
+	}
+
+	t.Run("not initialized", func(t *testing.T) {
+		t.Parallel()
+
+		h := &Handler{baseHandler: baseHandler{logger: noopLogger()}}
+		rr := httptest.NewRecorder()
+		h.QueryCorrelations(rr, newCorrelationsRequest(`{`+correlationScope+`,"traceId":"t"}`))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Contains(t, rr.Body.String(), types.ErrorCodeV1CorrelationServiceNotReady)
+	})
+
+	tests := []struct {
+		name      string
+		err       error
+		wantCode  int
+		wantError string
+	}{
+		{"forbidden", observerAuthz.ErrAuthzForbidden, http.StatusForbidden, ""},
+		{"invalid", service.ErrCorrelationInvalidRequest, http.StatusBadRequest, types.ErrorCodeV1CorrelationInternalGeneric},
+		{"resolver", service.ErrLogsResolveSearchScope, http.StatusInternalServerError, types.ErrorCodeV1CorrelationResolverFailed},
+		{"retrieval", service.ErrTracesRetrieval, http.StatusInternalServerError, types.ErrorCodeV1CorrelationRetrievalFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockCorrelationQuerier(t)
+			svc.EXPECT().QueryCorrelations(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, correlationService: svc}
+			rr := httptest.NewRecorder()
+			h.QueryCorrelations(rr, newCorrelationsRequest(`{`+correlationScope+`,"traceId":"t"}`))
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantError)
+		})
+	}
+}
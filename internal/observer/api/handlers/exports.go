@@ -0,0 +1,105 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	"github.com/openchoreo/openchoreo/internal/observer/export"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// UpsertExport handles PUT /api/v1alpha1/exports/{namespace}/{name} on the internal port.
+// The observability plane controller calls it whenever an ObservabilityExport resource is
+// reconciled and mirrors the returned status into the resource.
+func (h *InternalHandler) UpsertExport(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	name := r.PathValue("name")
+
+	var req types.ExportRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind export request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateExportRequest(namespace, name, &req); err != nil {
+		h.logger.Debug("Export validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.exportServiceReady(w) {
+		return
+	}
+
+	status, err := h.exportService.UpsertExport(r.Context(), namespace, name, &req)
+	if err != nil {
+		if errors.Is(err, export.ErrInvalidExport) {
+			h.logger.Debug("Invalid export", "namespace", namespace, "name", name, "error", err)
+			h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+			return
+		}
+		h.logger.Error("Failed to register export", "namespace", namespace, "name", name, "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1ExportInternalGeneric,
+			"Failed to register export",
+		)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, status)
+}
+
+// DeleteExport handles DELETE /api/v1alpha1/exports/{namespace}/{name} on the internal port.
+// Deleting an unknown export is not an error.
+func (h *InternalHandler) DeleteExport(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	name := r.PathValue("name")
+
+	if err := validateExportKey(namespace, name); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.exportServiceReady(w) {
+		return
+	}
+
+	if err := h.exportService.DeleteExport(r.Context(), namespace, name); err != nil {
+		h.logger.Error("Failed to delete export", "namespace", namespace, "name", name, "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1ExportInternalGeneric,
+			"Failed to delete export",
+		)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// exportServiceReady guards against deployments where log export is disabled.
+func (h *InternalHandler) exportServiceReady(w http.ResponseWriter) bool {
+	if h.exportService != nil {
+		return true
+	}
+	h.logger.Error("Log export service is not initialized")
+	h.writeErrorResponse(
+		w,
+		http.StatusInternalServerError,
+		gen.InternalServerError,
+		types.ErrorCodeV1ExportServiceNotReady,
+		"Log export service is not initialized",
+	)
+	return false
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/export"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const validExportBody = `{"filter":{"project":"shop","logLevels":["ERROR"]},"mode":"scheduled",` +
+	`"sink":{"type":"http","http":{"url":"https://logs.example.com/ingest"}}}`
+
+func newExportRequest(method, body string) *http.Request {
+	req := httptest.NewRequest(method, "/api/v1alpha1/exports/acme/audit", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetPathValue("namespace", "acme")
+	req.SetPathValue("name", "audit")
+	return req
+}
+
+func TestUpsertExport_Success(t *testing.T) {
+	t.Parallel()
+
+	lastExport := time.Date(2026, 1, 2, 11, 59, 0, 0, time.UTC)
+	svc := servicemocks.NewMockLogExporter(t)
+	svc.EXPECT().UpsertExport(mock.Anything, "acme", "audit", mock.MatchedBy(func(r *types.ExportRequest) bool {
+		return r.Filter.Project == "shop" && r.Sink.HTTP != nil
+	})).Return(&types.ExportStatus{LastExportTime: &lastExport, ExportedEntries: 42}, nil)
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}, exportService: svc}
+	rr := httptest.NewRecorder()
+	h.UpsertExport(rr, newExportRequest(http.MethodPut, validExportBody))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"lastExportTime":"2026-01-02T11:59:00Z"`)
+	assert.Contains(t, rr.Body.String(), `"exportedEntries":42`)
+}
+
+func TestUpsertExport_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	h := &InternalHandler{
+		baseHandler:   baseHandler{logger: noopLogger()},
+		exportService: servicemocks.NewMockLogExporter(t),
+	}
+	rr := httptest.NewRecorder()
+	h.UpsertExport(rr, newExportRequest(http.MethodPut,
+		`{"filter":{"logLevels":["LOUD"]},"sink":{"type":"http"}}`))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "filter.logLevels")
+}
+
+func TestUpsertExport_ServiceErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"invalid export", fmt.Errorf("%w: unsupported sink type", export.ErrInvalidExport), http.StatusBadRequest, ""},
+		{"internal", errors.New("boom"), http.StatusInternalServerError, types.ErrorCodeV1ExportInternalGeneric},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockLogExporter(t)
+			svc.EXPECT().UpsertExport(mock.Anything, "acme", "audit", mock.Anything).Return(nil, tt.err)
+
+			h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}, exportService: svc}
+			rr := httptest.NewRecorder()
+			h.UpsertExport(rr, newExportRequest(http.MethodPut, validExportBody))
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestUpsertExport_ServiceNotReady(t *testing.T) {
+	t.Parallel()
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}}
+	rr := httptest.NewRecorder()
+	h.UpsertExport(rr, newExportRequest(http.MethodPut, validExportBody))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1ExportServiceNotReady)
+}
+
+func TestDeleteExport(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockLogExporter(t)
+	svc.EXPECT().DeleteExport(mock.Anything, "acme", "audit").Return(nil)
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}, exportService: svc}
+	rr := httptest.NewRecorder()
+	h.DeleteExport(rr, newExportRequest(http.MethodDelete, ""))
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+}
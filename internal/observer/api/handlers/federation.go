@@ -0,0 +1,117 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// QueryFederatedLogs handles POST /api/v1alpha1/federation/logs/query.
+func (h *Handler) QueryFederatedLogs(w http.ResponseWriter, r *http.Request) {
+	var req types.LogsQueryRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind federated logs query request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateFederatedLogsQueryRequest(&req); err != nil {
+		h.logger.Debug("Federated logs query validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.federationServiceReady(w) {
+		return
+	}
+	result, err := h.federationService.QueryFederatedLogs(r.Context(), &req)
+	if err != nil {
+		h.writeFederationError(w, err, "Failed to retrieve federated logs")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// QueryFederatedMetrics handles POST /api/v1alpha1/federation/metrics/query.
+func (h *Handler) QueryFederatedMetrics(w http.ResponseWriter, r *http.Request) {
+	var req types.MetricsQueryRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind federated metrics query request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateFederatedMetricsQueryRequest(&req); err != nil {
+		h.logger.Debug("Federated metrics query validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.federationServiceReady(w) {
+		return
+	}
+	result, err := h.federationService.QueryFederatedMetrics(r.Context(), &req)
+	if err != nil {
+		h.writeFederationError(w, err, "Failed to retrieve federated metrics")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// federationServiceReady guards against deployments that have federation disabled.
+func (h *Handler) federationServiceReady(w http.ResponseWriter) bool {
+	if h.federationService != nil {
+		return true
+	}
+	h.logger.Error("Federation service is not initialized")
+	h.writeErrorResponse(
+		w,
+		http.StatusInternalServerError,
+		gen.InternalServerError,
+		types.ErrorCodeV1FederationServiceNotReady,
+		"Federated queries are not enabled",
+	)
+	return false
+}
+
+func (h *Handler) writeFederationError(w http.ResponseWriter, err error, message string) {
+	errorCode := types.ErrorCodeV1FederationInternalGeneric
+	switch {
+	case errors.Is(err, observerAuthz.ErrAuthzForbidden):
+		h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+		return
+	case errors.Is(err, observerAuthz.ErrAuthzUnauthorized):
+		h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+		return
+	case errors.Is(err, service.ErrScopeAuthFailed):
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1ScopeAuthFailed,
+			"",
+		)
+		return
+	case errors.Is(err, service.ErrFederationInvalidRequest),
+		errors.Is(err, service.ErrMetricsInvalidRequest):
+		h.logger.Debug("Invalid federated query request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, errorCode, err.Error())
+		return
+	case errors.Is(err, service.ErrFederationResolveScope):
+		errorCode = types.ErrorCodeV1FederationResolverFailed
+	case errors.Is(err, service.ErrFederationRetrieval):
+		errorCode = types.ErrorCodeV1FederationRetrievalFailed
+	}
+	h.logger.Error(message, "error", err)
+	h.writeErrorResponse(w, http.StatusInternalServerError, gen.InternalServerError, errorCode, message)
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	federationScope = `"searchScope":{"namespace":"ns","project":"proj","component":"api"}`
+	federationRange = `"startTime":"2026-01-02T10:00:00Z","endTime":"2026-01-02T11:00:00Z"`
+)
+
+func newFederationRequest(path, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestQueryFederatedLogs_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockFederatedQuerier(t)
+	svc.EXPECT().QueryFederatedLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.SearchScope.Component.Component == "api" && r.Limit == 100
+	})).Return(&types.FederatedLogsQueryResponse{
+		Logs: []types.FederatedLogEntry{{
+			LogEntry:           types.LogEntry{Timestamp: "2026-01-02T10:30:00Z", Log: "started"},
+			ObservabilityPlane: "us-east",
+		}},
+		Total: 1,
+		Planes: []types.FederatedPlaneStatus{
+			{Name: "us-east", Environments: []string{"prod"}, Status: types.FederatedPlaneStatusSucceeded},
+			{Name: "eu-west", Environments: []string{"dev"}, Status: types.FederatedPlaneStatusFailed, Error: "timeout"},
+		},
+	}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, federationService: svc}
+	rr := httptest.NewRecorder()
+	h.QueryFederatedLogs(rr, newFederationRequest("/api/v1alpha1/federation/logs/query",
+		`{`+federationScope+`,`+federationRange+`}`))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"observabilityPlane":"us-east"`)
+	assert.Contains(t, rr.Body.String(), `"status":"failed","error":"timeout"`)
+}
+
+func TestQueryFederatedLogs_Errors(t *testing.T) {
+	t.Parallel()
+
+	validation := []struct {
+		name    string
+		body    string
+		wantMsg string
+	}{
+		{"missing component", `{"searchScope":{"namespace":"ns","project":"proj"},` + federationRange + `}`,
+			"searchScope.project and searchScope.component are required"},
+		{"workflow scope", `{"searchScope":{"namespace":"ns","workflowRunName":"run-1"},` + federationRange + `}`,
+			"searchScope must be a ComponentSearchScope"},
+		{"missing time range", `{` + federationScope + `}`, "startTime"},
+	}
+	for _, tt := range validation {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, federationService: servicemocks.NewMockFederatedQuerier(t)}
+			rr := httptest.NewRecorder()
+			h.QueryFederatedLogs(rr, newFederationRequest("/api/v1alpha1/federation/logs/query", tt.body))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantMsg)
+		})
+	}
+
+	serviceErrors := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"forbidden", observerAuthz.ErrAuthzForbidden, http.StatusForbidden, ""},
+		{"resolver", service.ErrFederationResolveScope, http.StatusInternalServerError, types.ErrorCodeV1FederationResolverFailed},
+		{"every plane failed", service.ErrFederationRetrieval, http.StatusInternalServerError, types.ErrorCodeV1FederationRetrievalFailed},
+	}
+	for _, tt := range serviceErrors {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockFederatedQuerier(t)
+			svc.EXPECT().QueryFederatedLogs(mock.Anything, mock.Anything).Return(nil, tt.err)
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, federationService: svc}
+			rr := httptest.NewRecorder()
+			h.QueryFederatedLogs(rr, newFederationRequest("/api/v1alpha1/federation/logs/query",
+				`{`+federationScope+`,`+federationRange+`}`))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantCode)
+		})
+	}
+}
+
+func TestQueryFederatedMetrics_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockFederatedQuerier(t)
+	svc.EXPECT().QueryFederatedMetrics(mock.Anything, mock.MatchedBy(func(r *types.MetricsQueryRequest) bool {
+		return r.Metric == types.MetricTypeHTTP && r.SearchScope.Component == "api"
+	})).Return(&types.FederatedMetricsQueryResponse{
+		Results: []types.FederatedMetricsResult{{
+			ObservabilityPlane: "us-east",
+			Environments:       []string{"prod"},
+			Metrics:            &types.HTTPMetricsQueryResponse{},
+		}},
+	}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, federationService: svc}
+	rr := httptest.NewRecorder()
+	h.QueryFederatedMetrics(rr, newFederationRequest("/api/v1alpha1/federation/metrics/query",
+		`{"metric":"http",`+federationScope+`,`+federationRange+`}`))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"observabilityPlane":"us-east"`)
+}
+
+func TestQueryFederatedMetrics_NotEnabled(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}}
+	rr := httptest.NewRecorder()
+	h.QueryFederatedMetrics(rr, newFederationRequest("/api/v1alpha1/federation/metrics/query",
+		`{"metric":"http",`+federationScope+`,`+federationRange+`}`))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1FederationServiceNotReady)
+}
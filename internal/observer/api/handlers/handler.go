@@ -45,12 +45,20 @@ func (b *baseHandler) writeErrorResponse(
 // than bare service instances.
 type Handler struct {
 	baseHandler
-	healthService        service.HealthChecker
-	logsService          service.LogsQuerier
-	eventsService        service.EventsQuerier
-	metricsService       service.MetricsQuerier
-	alertIncidentService service.AlertIncidentService
-	tracesService        service.TracesQuerier
+	healthService         service.HealthChecker
+	logsService           service.LogsQuerier
+	eventsService         service.EventsQuerier
+	metricsService        service.MetricsQuerier
+	alertIncidentService  service.AlertIncidentService
+	tracesService         service.TracesQuerier
+	sloService            service.SLOEvaluator
+	logMetricsService     service.LogMetricsQuerier
+	recommendationService service.ResourceRecommender
+	idleWorkloadService   service.IdleWorkloadReporter
+	correlationService    service.CorrelationQuerier
+	rawQueryService       service.RawQuerier
+	federationService     service.FederatedQuerier
+	savedQueryService     service.SavedQueryService
 }
 
 // NewHandler creates a new public Handler instance.
@@ -61,33 +69,66 @@ func NewHandler(
 	metricsService service.MetricsQuerier,
 	alertIncidentService service.AlertIncidentService,
 	tracesService service.TracesQuerier,
+	sloService service.SLOEvaluator,
+	logMetricsService service.LogMetricsQuerier,
+	recommendationService service.ResourceRecommender,
+	idleWorkloadService service.IdleWorkloadReporter,
+	correlationService service.CorrelationQuerier,
+	rawQueryService service.RawQuerier,
+	federationService service.FederatedQuerier,
+	savedQueryService service.SavedQueryService,
 	logger *slog.Logger,
 ) *Handler {
 	return &Handler{
-		baseHandler:          baseHandler{logger: logger},
-		healthService:        healthService,
-		logsService:          logsService,
-		eventsService:        eventsService,
-		metricsService:       metricsService,
-		alertIncidentService: alertIncidentService,
-		tracesService:        tracesService,
+		baseHandler:           baseHandler{logger: logger},
+		healthService:         healthService,
+		logsService:           logsService,
+		eventsService:         eventsService,
+		metricsService:        metricsService,
+		alertIncidentService:  alertIncidentService,
+		tracesService:         tracesService,
+		sloService:            sloService,
+		logMetricsService:     logMetricsService,
+		recommendationService: recommendationService,
+		idleWorkloadService:   idleWorkloadService,
+		correlationService:    correlationService,
+		rawQueryService:       rawQueryService,
+		federationService:     federationService,
+		savedQueryService:     savedQueryService,
 	}
 }
 
 // InternalHandler contains the HTTP handlers that run on the internal port (8081)
-// without JWT authentication. It manages alert rules and processes incoming webhooks.
+// without JWT authentication. It manages alert rules, processes incoming webhooks
+// evaluates error budgets, log metrics and anomalies and sends promotion notifications
+// for the control plane.
 type InternalHandler struct {
 	baseHandler
-	alertService service.AlertRuleService
+	alertService      service.AlertRuleService
+	sloService        service.SLOEvaluator
+	logMetricsService service.LogMetricsQuerier
+	anomalyService    service.AnomalyDetector
+	promotionNotifier service.PromotionNotifier
+	exportService     service.LogExporter
 }
 
 // NewInternalHandler creates a new InternalHandler instance.
 func NewInternalHandler(
 	alertService service.AlertRuleService,
+	sloService service.SLOEvaluator,
+	logMetricsService service.LogMetricsQuerier,
+	anomalyService service.AnomalyDetector,
+	promotionNotifier service.PromotionNotifier,
+	exportService service.LogExporter,
 	logger *slog.Logger,
 ) *InternalHandler {
 	return &InternalHandler{
-		baseHandler:  baseHandler{logger: logger},
-		alertService: alertService,
+		baseHandler:       baseHandler{logger: logger},
+		alertService:      alertService,
+		sloService:        sloService,
+		logMetricsService: logMetricsService,
+		anomalyService:    anomalyService,
+		promotionNotifier: promotionNotifier,
+		exportService:     exportService,
 	}
 }
@@ -0,0 +1,77 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// IdleWorkloads handles POST /api/v1alpha1/metrics/idle-workloads.
+func (h *Handler) IdleWorkloads(w http.ResponseWriter, r *http.Request) {
+	var req types.IdleWorkloadsRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind idle workloads request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateIdleWorkloadsRequest(&req); err != nil {
+		h.logger.Debug("Idle workloads validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	// Guard against misconfigured deployments.
+	if h.idleWorkloadService == nil {
+		h.logger.Error("Idle workload service is not initialized")
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1IdleWorkloadsServiceNotReady,
+			"Idle workload detection is not enabled",
+		)
+		return
+	}
+
+	result, err := h.idleWorkloadService.IdleWorkloads(r.Context(), &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, observerAuthz.ErrAuthzForbidden):
+			h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+		case errors.Is(err, observerAuthz.ErrAuthzUnauthorized):
+			h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+		case errors.Is(err, service.ErrIdleWorkloadsInvalidRequest):
+			h.logger.Debug("Invalid idle workloads request", "error", err)
+			h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		case errors.Is(err, service.ErrIdleWorkloadsNotReady):
+			h.writeErrorResponse(
+				w,
+				http.StatusServiceUnavailable,
+				gen.InternalServerError,
+				types.ErrorCodeV1IdleWorkloadsServiceNotReady,
+				"Idle workload analysis has not completed yet",
+			)
+		default:
+			h.logger.Error("Failed to build idle workloads report", "error", err)
+			h.writeErrorResponse(
+				w,
+				http.StatusInternalServerError,
+				gen.InternalServerError,
+				types.ErrorCodeV1IdleWorkloadsInternalGeneric,
+				"Failed to build idle workloads report",
+			)
+		}
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func newIdleWorkloadsRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/metrics/idle-workloads", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestIdleWorkloads_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockIdleWorkloadReporter(t)
+	svc.EXPECT().IdleWorkloads(mock.Anything, &types.IdleWorkloadsRequest{Namespace: "ns", Environment: "dev"}).
+		Return(&types.IdleWorkloadsResponse{
+			Currency:                     "USD",
+			TotalEstimatedMonthlySavings: 12.5,
+			Items:                        []types.IdleWorkload{{Component: "legacy-api", EstimatedMonthlySavings: 12.5}},
+		}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, idleWorkloadService: svc}
+	rr := httptest.NewRecorder()
+	h.IdleWorkloads(rr, newIdleWorkloadsRequest(`{"namespace":"ns","environment":"dev"}`))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"component":"legacy-api"`)
+	assert.Contains(t, rr.Body.String(), `"totalEstimatedMonthlySavings":12.5`)
+}
+
+func TestIdleWorkloads_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing namespace", func(t *testing.T) {
+		t.Parallel()
+
+		h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, idleWorkloadService: servicemocks.NewMockIdleWorkloadReporter(t)}
+		rr := httptest.NewRecorder()
+		h.IdleWorkloads(rr, newIdleWorkloadsRequest(`{"environment":"dev"}`))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "namespace is required")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		h := &Handler{baseHandler: baseHandler{logger: noopLogger()}}
+		rr := httptest.NewRecorder()
+		h.IdleWorkloads(rr, newIdleWorkloadsRequest(`{"namespace":"ns"}`))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Contains(t, rr.Body.String(), types.ErrorCodeV1IdleWorkloadsServiceNotReady)
+	})
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{"forbidden", observerAuthz.ErrAuthzForbidden, http.StatusForbidden},
+		{"not ready", service.ErrIdleWorkloadsNotReady, http.StatusServiceUnavailable},
+		{"invalid", service.ErrIdleWorkloadsInvalidRequest, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockIdleWorkloadReporter(t)
+			svc.EXPECT().IdleWorkloads(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, idleWorkloadService: svc}
+			rr := httptest.NewRecorder()
+			h.IdleWorkloads(rr, newIdleWorkloadsRequest(`{"namespace":"ns"}`))
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
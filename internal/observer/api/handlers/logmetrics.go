@@ -0,0 +1,97 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// QueryLogMetric handles POST /api/v1alpha1/metrics/log-metrics/query.
+func (h *Handler) QueryLogMetric(w http.ResponseWriter, r *http.Request) {
+	h.queryLogMetric(w, r, h.logMetricsService)
+}
+
+// QueryLogMetric handles POST /api/v1alpha1/metrics/log-metrics/query on the internal port.
+// The control plane uses it to record the latest values of LogMetric resources.
+func (h *InternalHandler) QueryLogMetric(w http.ResponseWriter, r *http.Request) {
+	h.queryLogMetric(w, r, h.logMetricsService)
+}
+
+func (b *baseHandler) queryLogMetric(w http.ResponseWriter, r *http.Request, logMetricsService service.LogMetricsQuerier) {
+	var req types.LogMetricQueryRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		b.logger.Error("Failed to bind log metric request", "error", err)
+		b.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateLogMetricQueryRequest(&req); err != nil {
+		b.logger.Debug("Log metric validation failed", "error", err)
+		b.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	// Guard against misconfigured deployments.
+	if logMetricsService == nil {
+		b.logger.Error("Log metrics service is not initialized")
+		b.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1LogMetricsServiceNotReady,
+			"Log metrics service is not initialized",
+		)
+		return
+	}
+
+	result, err := logMetricsService.QueryLogMetric(r.Context(), &req)
+	if err != nil {
+		if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
+			b.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+			return
+		}
+		if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
+			b.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+			return
+		}
+		errorCode := types.ErrorCodeV1LogMetricsInternalGeneric
+		switch {
+		case errors.Is(err, service.ErrScopeAuthFailed):
+			b.writeErrorResponse(
+				w,
+				http.StatusInternalServerError,
+				gen.InternalServerError,
+				types.ErrorCodeV1ScopeAuthFailed,
+				"",
+			)
+			return
+		case errors.Is(err, service.ErrLogMetricsInvalidRequest):
+			b.logger.Debug("Invalid log metric request", "error", err)
+			b.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, errorCode, err.Error())
+			return
+		case errors.Is(err, service.ErrLogsResolveSearchScope):
+			errorCode = types.ErrorCodeV1LogMetricsResolverFailed
+		case errors.Is(err, service.ErrLogsRetrieval):
+			errorCode = types.ErrorCodeV1LogMetricsRetrievalFailed
+		}
+		b.logger.Error("Failed to query log metric", "error", err)
+		b.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			errorCode,
+			"Failed to query log metric",
+		)
+		return
+	}
+
+	b.writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const validLogMetricBody = `{"searchScope":{"namespace":"ns","project":"p","component":"c","environment":"prod"},` +
+	`"startTime":"2026-01-01T00:00:00Z","endTime":"2026-01-01T01:00:00Z","step":"5m",` +
+	`"metric":{"name":"failures","type":"counter","pattern":"failed"}}`
+
+func newLogMetricRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/metrics/log-metrics/query", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestQueryLogMetric_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockLogMetricsQuerier(t)
+	svc.EXPECT().QueryLogMetric(mock.Anything, mock.Anything).
+		Return(&types.LogMetricQueryResponse{Name: "failures", Type: "counter", EvaluatedLines: 3}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logMetricsService: svc}
+	rr := httptest.NewRecorder()
+	h.QueryLogMetric(rr, newLogMetricRequest(validLogMetricBody))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"evaluatedLines":3`)
+}
+
+func TestQueryLogMetric_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{
+			name: "missing component",
+			body: `{"searchScope":{"namespace":"ns","project":"p","environment":"prod"},` +
+				`"startTime":"2026-01-01T00:00:00Z","endTime":"2026-01-01T01:00:00Z",` +
+				`"metric":{"name":"m","type":"counter","pattern":"x"}}`,
+			wantErr: "searchScope.component is required",
+		},
+		{
+			name: "invalid type",
+			body: `{"searchScope":{"namespace":"ns","project":"p","component":"c","environment":"prod"},` +
+				`"startTime":"2026-01-01T00:00:00Z","endTime":"2026-01-01T01:00:00Z",` +
+				`"metric":{"name":"m","type":"gauge","pattern":"x"}}`,
+			wantErr: "invalid metric.type",
+		},
+		{
+			name: "invalid step",
+			body: `{"searchScope":{"namespace":"ns","project":"p","component":"c","environment":"prod"},` +
+				`"startTime":"2026-01-01T00:00:00Z","endTime":"2026-01-01T01:00:00Z","step":"often",` +
+				`"metric":{"name":"m","type":"counter","pattern":"x"}}`,
+			wantErr: "step must be a valid duration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logMetricsService: servicemocks.NewMockLogMetricsQuerier(t)}
+			rr := httptest.NewRecorder()
+			h.QueryLogMetric(rr, newLogMetricRequest(tt.body))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantErr)
+		})
+	}
+}
+
+func TestQueryLogMetric_ServiceNotInitialized(t *testing.T) {
+	t.Parallel()
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}}
+	rr := httptest.NewRecorder()
+	h.QueryLogMetric(rr, newLogMetricRequest(validLogMetricBody))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1LogMetricsServiceNotReady)
+}
+
+func TestQueryLogMetric_ServiceErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"forbidden", observerAuthz.ErrAuthzForbidden, http.StatusForbidden, ""},
+		{"invalid", fmt.Errorf("%w: bad", service.ErrLogMetricsInvalidRequest), http.StatusBadRequest, types.ErrorCodeV1LogMetricsInternalGeneric},
+		{"resolver", fmt.Errorf("%w: boom", service.ErrLogsResolveSearchScope), http.StatusInternalServerError, types.ErrorCodeV1LogMetricsResolverFailed},
+		{"retrieval", fmt.Errorf("%w: boom", service.ErrLogsRetrieval), http.StatusInternalServerError, types.ErrorCodeV1LogMetricsRetrievalFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockLogMetricsQuerier(t)
+			svc.EXPECT().QueryLogMetric(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logMetricsService: svc}
+			rr := httptest.NewRecorder()
+			h.QueryLogMetric(rr, newLogMetricRequest(validLogMetricBody))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// NotifyPromotion handles POST /api/v1alpha1/notifications/promotions on the internal port.
+// The control plane calls it once a ReleaseBinding has deployed a new release.
+func (h *InternalHandler) NotifyPromotion(w http.ResponseWriter, r *http.Request) {
+	var req types.PromotionNotificationRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind promotion notification request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidatePromotionNotificationRequest(&req); err != nil {
+		h.logger.Debug("Promotion notification validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	// Guard against misconfigured deployments.
+	if h.promotionNotifier == nil {
+		h.logger.Error("Promotion notifier is not initialized")
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1PromotionServiceNotReady,
+			"Promotion notifier is not initialized",
+		)
+		return
+	}
+
+	if err := h.promotionNotifier.NotifyPromotion(r.Context(), &req); err != nil {
+		h.logger.Error("Failed to send promotion notification", "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1PromotionInternalGeneric,
+			"Failed to send promotion notification",
+		)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const validPromotionBody = `{"namespace":"ns","project":"p","component":"c","environment":"prod",` +
+	`"fromRelease":"c-1","toRelease":"c-2","toCommit":"def5678","summary":"image c:v1 -> c:v2",` +
+	`"notificationChannels":["releases"]}`
+
+func newPromotionRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/notifications/promotions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestNotifyPromotion_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockPromotionNotifier(t)
+	svc.EXPECT().NotifyPromotion(mock.Anything, mock.MatchedBy(func(r *types.PromotionNotificationRequest) bool {
+		return r.ToRelease == "c-2" && r.ToCommit == "def5678" && r.NotificationChannels[0] == "releases"
+	})).Return(nil)
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}, promotionNotifier: svc}
+	rr := httptest.NewRecorder()
+	h.NotifyPromotion(rr, newPromotionRequest(validPromotionBody))
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestNotifyPromotion_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{"invalid json", `{`, "Invalid request format"},
+		{"missing release", `{"namespace":"ns","project":"p","component":"c","environment":"prod",` +
+			`"summary":"s","notificationChannels":["releases"]}`, "toRelease is required"},
+		{"no channels", `{"namespace":"ns","project":"p","component":"c","environment":"prod",` +
+			`"toRelease":"c-2","summary":"s"}`, "notificationChannels must not be empty"},
+		{"empty channel", `{"namespace":"ns","project":"p","component":"c","environment":"prod",` +
+			`"toRelease":"c-2","summary":"s","notificationChannels":[" "]}`, "must not contain empty names"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := &InternalHandler{
+				baseHandler:       baseHandler{logger: noopLogger()},
+				promotionNotifier: servicemocks.NewMockPromotionNotifier(t),
+			}
+			rr := httptest.NewRecorder()
+			h.NotifyPromotion(rr, newPromotionRequest(tt.body))
+
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantErr)
+		})
+	}
+}
+
+func TestNotifyPromotion_ServiceError(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockPromotionNotifier(t)
+	svc.EXPECT().NotifyPromotion(mock.Anything, mock.Anything).Return(errors.New("channel releases not found"))
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}, promotionNotifier: svc}
+	rr := httptest.NewRecorder()
+	h.NotifyPromotion(rr, newPromotionRequest(validPromotionBody))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1PromotionInternalGeneric)
+}
+
+func TestNotifyPromotion_ServiceNotReady(t *testing.T) {
+	t.Parallel()
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}}
+	rr := httptest.NewRecorder()
+	h.NotifyPromotion(rr, newPromotionRequest(validPromotionBody))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1PromotionServiceNotReady)
+}
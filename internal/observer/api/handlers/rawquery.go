@@ -0,0 +1,126 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// QueryPromQL handles POST /api/v1alpha1/raw/promql/query.
+func (h *Handler) QueryPromQL(w http.ResponseWriter, r *http.Request) {
+	var req types.PromQLQueryRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind PromQL query request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidatePromQLQueryRequest(&req); err != nil {
+		h.logger.Debug("PromQL query validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.rawQueryServiceReady(w) {
+		return
+	}
+
+	result, err := h.rawQueryService.QueryPromQL(r.Context(), &req)
+	if err != nil {
+		h.writeRawQueryError(w, err, "Failed to run PromQL query")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// SearchOpenSearch handles POST /api/v1alpha1/raw/opensearch/search.
+func (h *Handler) SearchOpenSearch(w http.ResponseWriter, r *http.Request) {
+	var req types.OpenSearchQueryRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind OpenSearch query request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateOpenSearchQueryRequest(&req); err != nil {
+		h.logger.Debug("OpenSearch query validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.rawQueryServiceReady(w) {
+		return
+	}
+
+	result, err := h.rawQueryService.SearchOpenSearch(r.Context(), &req)
+	if err != nil {
+		h.writeRawQueryError(w, err, "Failed to run OpenSearch query")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// rawQueryServiceReady guards against deployments that have raw queries disabled.
+func (h *Handler) rawQueryServiceReady(w http.ResponseWriter) bool {
+	if h.rawQueryService != nil {
+		return true
+	}
+	h.logger.Error("Raw query service is not initialized")
+	h.writeErrorResponse(
+		w,
+		http.StatusInternalServerError,
+		gen.InternalServerError,
+		types.ErrorCodeV1RawQueryServiceNotReady,
+		"Raw queries are not enabled",
+	)
+	return false
+}
+
+func (h *Handler) writeRawQueryError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, observerAuthz.ErrAuthzForbidden):
+		h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+	case errors.Is(err, observerAuthz.ErrAuthzUnauthorized):
+		h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+	case errors.Is(err, service.ErrRawQueryInvalidRequest):
+		h.logger.Debug("Invalid raw query", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+	case errors.Is(err, service.ErrRawQueryNotConfigured):
+		h.logger.Error("Raw query backend is not configured", "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1RawQueryServiceNotReady,
+			"Raw query backend is not configured",
+		)
+	case errors.Is(err, service.ErrRawQueryRetrieval):
+		h.logger.Error(message, "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1RawQueryRetrievalFailed,
+			message,
+		)
+	default:
+		h.logger.Error(message, "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1RawQueryInternalGeneric,
+			message,
+		)
+	}
+}
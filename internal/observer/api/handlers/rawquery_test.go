@@ -0,0 +1,121 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	validPromQLBody = `{"namespace":"ns","query":"up","endTime":"2026-01-31T12:00:00Z"}`
+	validSearchBody = `{"namespace":"ns","logType":"runtime","startTime":"2026-01-31T11:00:00Z",` +
+		`"endTime":"2026-01-31T12:00:00Z","body":{"query":{"match":{"log":"boom"}}}}`
+)
+
+func newRawQueryRequest(path, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestQueryPromQL_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockRawQuerier(t)
+	svc.EXPECT().QueryPromQL(mock.Anything, mock.MatchedBy(func(r *types.PromQLQueryRequest) bool {
+		return r.Namespace == "ns" && r.Query == "up"
+	})).Return(&types.PromQLQueryResponse{Query: `up{ns="ns"}`, ResultType: "vector", Result: []byte(`[]`)}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, rawQueryService: svc}
+	rr := httptest.NewRecorder()
+	h.QueryPromQL(rr, newRawQueryRequest("/api/v1alpha1/raw/promql/query", validPromQLBody))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"resultType":"vector"`)
+}
+
+func TestQueryPromQL_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, rawQueryService: servicemocks.NewMockRawQuerier(t)}
+	rr := httptest.NewRecorder()
+	h.QueryPromQL(rr, newRawQueryRequest("/api/v1alpha1/raw/promql/query",
+		`{"namespace":"ns","query":"up","endTime":"2026-01-31T12:00:00Z","step":"1m"}`))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "startTime must be in RFC3339 format")
+}
+
+func TestSearchOpenSearch_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockRawQuerier(t)
+	svc.EXPECT().SearchOpenSearch(mock.Anything, mock.MatchedBy(func(r *types.OpenSearchQueryRequest) bool {
+		return r.LogType == "runtime" && strings.Contains(string(r.Body), "boom")
+	})).Return(&types.OpenSearchQueryResponse{Took: 2, Hits: []byte(`{"hits":[]}`)}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, rawQueryService: svc}
+	rr := httptest.NewRecorder()
+	h.SearchOpenSearch(rr, newRawQueryRequest("/api/v1alpha1/raw/opensearch/search", validSearchBody))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"took":2`)
+}
+
+func TestSearchOpenSearch_ServiceErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"forbidden", observerAuthz.ErrAuthzForbidden, http.StatusForbidden, ""},
+		{"invalid query", fmt.Errorf("%w: size too large", service.ErrRawQueryInvalidRequest), http.StatusBadRequest, ""},
+		{"not configured", service.ErrRawQueryNotConfigured, http.StatusInternalServerError, types.ErrorCodeV1RawQueryServiceNotReady},
+		{"retrieval", service.ErrRawQueryRetrieval, http.StatusInternalServerError, types.ErrorCodeV1RawQueryRetrievalFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockRawQuerier(t)
+			svc.EXPECT().SearchOpenSearch(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, rawQueryService: svc}
+			rr := httptest.NewRecorder()
+			h.SearchOpenSearch(rr, newRawQueryRequest("/api/v1alpha1/raw/opensearch/search", validSearchBody))
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRawQuery_ServiceNotReady(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}}
+	rr := httptest.NewRecorder()
+	h.QueryPromQL(rr, newRawQueryRequest("/api/v1alpha1/raw/promql/query", validPromQLBody))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1RawQueryServiceNotReady)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// RecommendResources handles POST /api/v1alpha1/metrics/resource-recommendations.
+func (h *Handler) RecommendResources(w http.ResponseWriter, r *http.Request) {
+	var req types.ResourceRecommendationRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind resource recommendation request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateResourceRecommendationRequest(&req); err != nil {
+		h.logger.Debug("Resource recommendation validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	// Guard against misconfigured deployments.
+	if h.recommendationService == nil {
+		h.logger.Error("Recommendation service is not initialized")
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1RecommendationServiceNotReady,
+			"Recommendation service is not initialized",
+		)
+		return
+	}
+
+	result, err := h.recommendationService.RecommendResources(r.Context(), &req)
+	if err != nil {
+		if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
+			h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+			return
+		}
+		if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
+			h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+			return
+		}
+		errorCode := types.ErrorCodeV1RecommendationInternalGeneric
+		switch {
+		case errors.Is(err, service.ErrScopeAuthFailed):
+			h.writeErrorResponse(
+				w,
+				http.StatusInternalServerError,
+				gen.InternalServerError,
+				types.ErrorCodeV1ScopeAuthFailed,
+				"",
+			)
+			return
+		case errors.Is(err, service.ErrRecommendationInvalidRequest), errors.Is(err, service.ErrMetricsInvalidRequest):
+			h.logger.Debug("Invalid resource recommendation request", "error", err)
+			h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, errorCode, err.Error())
+			return
+		case errors.Is(err, service.ErrMetricsResolveSearchScope):
+			errorCode = types.ErrorCodeV1RecommendationResolverFailed
+		case errors.Is(err, service.ErrMetricsRetrieval):
+			errorCode = types.ErrorCodeV1RecommendationRetrievalFailed
+		}
+		h.logger.Error("Failed to compute resource recommendation", "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			errorCode,
+			"Failed to compute resource recommendation",
+		)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const validRecommendationBody = `{"searchScope":{"namespace":"ns","project":"p","component":"c","environment":"prod"},"window":"168h"}`
+
+func newRecommendationRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/metrics/resource-recommendations", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestRecommendResources_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockResourceRecommender(t)
+	svc.EXPECT().RecommendResources(mock.Anything, mock.Anything).
+		Return(&types.ResourceRecommendationResponse{
+			Recommended: &types.ResourceSettings{Requests: types.ResourceQuantities{CPU: "250m"}},
+		}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, recommendationService: svc}
+	rr := httptest.NewRecorder()
+	h.RecommendResources(rr, newRecommendationRequest(validRecommendationBody))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"cpu":"250m"`)
+}
+
+func TestRecommendResources_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, recommendationService: servicemocks.NewMockResourceRecommender(t)}
+	body := `{"searchScope":{"namespace":"ns","project":"p","component":"c","environment":"prod"},"window":"10m"}`
+	rr := httptest.NewRecorder()
+	h.RecommendResources(rr, newRecommendationRequest(body))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "window must be at least 1h")
+}
+
+func TestRecommendResources_ServiceErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantBody string
+	}{
+		{"forbidden", observerAuthz.ErrAuthzForbidden, http.StatusForbidden, "Access denied"},
+		{"resolver", fmt.Errorf("%w: boom", service.ErrMetricsResolveSearchScope), http.StatusInternalServerError,
+			types.ErrorCodeV1RecommendationResolverFailed},
+		{"retrieval", fmt.Errorf("%w: boom", service.ErrMetricsRetrieval), http.StatusInternalServerError,
+			types.ErrorCodeV1RecommendationRetrievalFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockResourceRecommender(t)
+			svc.EXPECT().RecommendResources(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, recommendationService: svc}
+			rr := httptest.NewRecorder()
+			h.RecommendResources(rr, newRecommendationRequest(validRecommendationBody))
+
+			assert.Equal(t, tt.wantCode, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantBody)
+		})
+	}
+}
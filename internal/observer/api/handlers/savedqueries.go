@@ -0,0 +1,207 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// CreateSavedQuery handles POST /api/v1alpha1/queries
+func (h *Handler) CreateSavedQuery(w http.ResponseWriter, r *http.Request) {
+	var req types.SavedQueryRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateSavedQueryRequest(&req); err != nil {
+		h.logger.Debug("Validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.savedQueryServiceReady(w) {
+		return
+	}
+
+	result, err := h.savedQueryService.CreateSavedQuery(r.Context(), &req)
+	if err != nil {
+		h.writeSavedQueryError(w, err, "Failed to create saved query")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, result)
+}
+
+// ListSavedQueries handles GET /api/v1alpha1/queries/{namespace}
+func (h *Handler) ListSavedQueries(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+
+	if !h.savedQueryServiceReady(w) {
+		return
+	}
+
+	result, err := h.savedQueryService.ListSavedQueries(r.Context(), namespace)
+	if err != nil {
+		h.writeSavedQueryError(w, err, "Failed to list saved queries")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetSavedQuery handles GET /api/v1alpha1/queries/{namespace}/{name}
+func (h *Handler) GetSavedQuery(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	name := r.PathValue("name")
+
+	if err := validateSavedQueryKey(namespace, name); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.savedQueryServiceReady(w) {
+		return
+	}
+
+	result, err := h.savedQueryService.GetSavedQuery(r.Context(), namespace, name)
+	if err != nil {
+		h.writeSavedQueryError(w, err, "Failed to get saved query")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// DeleteSavedQuery handles DELETE /api/v1alpha1/queries/{namespace}/{name}
+func (h *Handler) DeleteSavedQuery(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	name := r.PathValue("name")
+
+	if err := validateSavedQueryKey(namespace, name); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.savedQueryServiceReady(w) {
+		return
+	}
+
+	if err := h.savedQueryService.DeleteSavedQuery(r.Context(), namespace, name); err != nil {
+		h.writeSavedQueryError(w, err, "Failed to delete saved query")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunSavedQuery handles POST /api/v1alpha1/queries/{namespace}/{name}/run. The parameters are
+// substituted into the query template and the rendered query is served by the query endpoint
+// of the saved query's kind, so it is validated and authorized like a direct query.
+func (h *Handler) RunSavedQuery(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	name := r.PathValue("name")
+
+	var req types.SavedQueryRunRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := validateSavedQueryKey(namespace, name); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	if !h.savedQueryServiceReady(w) {
+		return
+	}
+
+	query, err := h.savedQueryService.GetSavedQuery(r.Context(), namespace, name)
+	if err != nil {
+		h.writeSavedQueryError(w, err, "Failed to get saved query")
+		return
+	}
+
+	body, err := service.RenderSavedQuery(query, req.Parameters)
+	if err != nil {
+		h.writeSavedQueryError(w, err, "Failed to render saved query")
+		return
+	}
+
+	queryReq := r.Clone(r.Context())
+	queryReq.Body = io.NopCloser(bytes.NewReader(body))
+	queryReq.ContentLength = int64(len(body))
+	queryReq.Header.Set("Content-Type", "application/json")
+
+	switch query.Kind {
+	case types.SavedQueryKindLogs:
+		h.QueryLogs(w, queryReq)
+	case types.SavedQueryKindTraces:
+		h.QueryTraces(w, queryReq)
+	default:
+		h.logger.Error("Saved query has an unknown kind", "namespace", namespace, "name", name, "kind", query.Kind)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1SavedQueryInternalGeneric,
+			"Saved query has an unknown kind",
+		)
+	}
+}
+
+// writeSavedQueryError maps saved query service errors to responses.
+func (h *Handler) writeSavedQueryError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, observerAuthz.ErrAuthzForbidden):
+		h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+	case errors.Is(err, observerAuthz.ErrAuthzUnauthorized):
+		h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+	case errors.Is(err, service.ErrSavedQueryInvalid):
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+	case errors.Is(err, service.ErrSavedQueryLimitReached):
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+	case errors.Is(err, service.ErrSavedQueryNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, gen.NotFound, "", "Saved query not found")
+	case errors.Is(err, service.ErrSavedQueryExists):
+		h.writeErrorResponse(w, http.StatusConflict, gen.Conflict, "", "Saved query already exists")
+	default:
+		h.logger.Error(message, "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1SavedQueryInternalGeneric,
+			message,
+		)
+	}
+}
+
+// savedQueryServiceReady guards against deployments where saved queries are disabled.
+func (h *Handler) savedQueryServiceReady(w http.ResponseWriter) bool {
+	if h.savedQueryService != nil {
+		return true
+	}
+	h.logger.Error("Saved query service is not initialized")
+	h.writeErrorResponse(
+		w,
+		http.StatusInternalServerError,
+		gen.InternalServerError,
+		types.ErrorCodeV1SavedQueryServiceNotReady,
+		"Saved query service is not initialized",
+	)
+	return false
+}
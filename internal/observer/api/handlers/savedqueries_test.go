@@ -0,0 +1,214 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const validSavedQueryBody = `{"namespace":"acme","name":"checkout-errors","kind":"logs",` +
+	`"query":{"searchScope":{"namespace":"acme","project":"shop","component":"checkout"},` +
+	`"startTime":"{{from}}","endTime":"{{to}}","logLevels":["ERROR"]},` +
+	`"parameters":[{"name":"from","required":true},{"name":"to","required":true}]}`
+
+func newSavedQueryRequest(method, path, body string) *http.Request {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetPathValue("namespace", "acme")
+	req.SetPathValue("name", "checkout-errors")
+	return req
+}
+
+func savedCheckoutErrorsQuery() *types.SavedQuery {
+	return &types.SavedQuery{
+		Namespace: "acme",
+		Name:      "checkout-errors",
+		Kind:      types.SavedQueryKindLogs,
+		Query: []byte(`{"searchScope":{"namespace":"acme","project":"shop","component":"checkout"},` +
+			`"startTime":"{{from}}","endTime":"{{to}}","logLevels":["ERROR"]}`),
+		Parameters: []types.SavedQueryParameter{{Name: "from", Required: true}, {Name: "to", Required: true}},
+	}
+}
+
+func TestCreateSavedQuery_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockSavedQueryService(t)
+	svc.EXPECT().CreateSavedQuery(mock.Anything, mock.MatchedBy(func(r *types.SavedQueryRequest) bool {
+		return r.Namespace == "acme" && r.Name == "checkout-errors" && len(r.Parameters) == 2
+	})).Return(savedCheckoutErrorsQuery(), nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, savedQueryService: svc}
+	rr := httptest.NewRecorder()
+	h.CreateSavedQuery(rr, newSavedQueryRequest(http.MethodPost, "/api/v1alpha1/queries", validSavedQueryBody))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"name":"checkout-errors"`)
+}
+
+func TestCreateSavedQuery_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"invalid name", strings.Replace(validSavedQueryBody, `"checkout-errors"`, `"Checkout_Errors"`, 1), "name"},
+		{"invalid kind", strings.Replace(validSavedQueryBody, `"kind":"logs"`, `"kind":"metrics"`, 1), "kind"},
+		{"invalid parameter name", strings.Replace(validSavedQueryBody, `{"name":"from"`, `{"name":"from-time"`, 1), "parameters[0].name"},
+		{"duplicate parameter", strings.Replace(validSavedQueryBody, `{"name":"to"`, `{"name":"from"`, 1), "duplicate parameter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := &Handler{
+				baseHandler:       baseHandler{logger: noopLogger()},
+				savedQueryService: servicemocks.NewMockSavedQueryService(t),
+			}
+			rr := httptest.NewRecorder()
+			h.CreateSavedQuery(rr, newSavedQueryRequest(http.MethodPost, "/api/v1alpha1/queries", tt.body))
+
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.want)
+		})
+	}
+}
+
+func TestCreateSavedQuery_ServiceErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"forbidden", observerAuthz.ErrAuthzForbidden, http.StatusForbidden},
+		{"invalid template", fmt.Errorf("%w: undeclared parameters: env", service.ErrSavedQueryInvalid), http.StatusBadRequest},
+		{"limit reached", service.ErrSavedQueryLimitReached, http.StatusBadRequest},
+		{"exists", service.ErrSavedQueryExists, http.StatusConflict},
+		{"store failure", fmt.Errorf("OpenSearch returned status 503"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockSavedQueryService(t)
+			svc.EXPECT().CreateSavedQuery(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, savedQueryService: svc}
+			rr := httptest.NewRecorder()
+			h.CreateSavedQuery(rr, newSavedQueryRequest(http.MethodPost, "/api/v1alpha1/queries", validSavedQueryBody))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestSavedQueries_ServiceNotReady(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}}
+	rr := httptest.NewRecorder()
+	h.ListSavedQueries(rr, newSavedQueryRequest(http.MethodGet, "/api/v1alpha1/queries/acme", ""))
+
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1SavedQueryServiceNotReady)
+}
+
+func TestGetSavedQuery_NotFound(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockSavedQueryService(t)
+	svc.EXPECT().GetSavedQuery(mock.Anything, "acme", "checkout-errors").Return(nil, service.ErrSavedQueryNotFound)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, savedQueryService: svc}
+	rr := httptest.NewRecorder()
+	h.GetSavedQuery(rr, newSavedQueryRequest(http.MethodGet, "/api/v1alpha1/queries/acme/checkout-errors", ""))
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestDeleteSavedQuery_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockSavedQueryService(t)
+	svc.EXPECT().DeleteSavedQuery(mock.Anything, "acme", "checkout-errors").Return(nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, savedQueryService: svc}
+	rr := httptest.NewRecorder()
+	h.DeleteSavedQuery(rr, newSavedQueryRequest(http.MethodDelete, "/api/v1alpha1/queries/acme/checkout-errors", ""))
+
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestRunSavedQuery_RunsRenderedLogsQuery(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockSavedQueryService(t)
+	svc.EXPECT().GetSavedQuery(mock.Anything, "acme", "checkout-errors").Return(savedCheckoutErrorsQuery(), nil)
+	logs := servicemocks.NewMockLogsQuerier(t)
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.SearchScope.Component != nil &&
+			r.SearchScope.Component.Component == "checkout" &&
+			r.StartTime == "2026-01-01T00:00:00Z" &&
+			r.EndTime == "2026-01-01T01:00:00Z" &&
+			r.Limit == defaultLimit
+	})).Return(&types.LogsQueryResponse{Total: 3}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, savedQueryService: svc, logsService: logs}
+	rr := httptest.NewRecorder()
+	h.RunSavedQuery(rr, newSavedQueryRequest(http.MethodPost, "/api/v1alpha1/queries/acme/checkout-errors/run",
+		`{"parameters":{"from":"2026-01-01T00:00:00Z","to":"2026-01-01T01:00:00Z"}}`))
+
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Contains(t, rr.Body.String(), `"total":3`)
+}
+
+func TestRunSavedQuery_MissingParameter(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockSavedQueryService(t)
+	svc.EXPECT().GetSavedQuery(mock.Anything, "acme", "checkout-errors").Return(savedCheckoutErrorsQuery(), nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, savedQueryService: svc}
+	rr := httptest.NewRecorder()
+	h.RunSavedQuery(rr, newSavedQueryRequest(http.MethodPost, "/api/v1alpha1/queries/acme/checkout-errors/run",
+		`{"parameters":{"from":"2026-01-01T00:00:00Z"}}`))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "missing required parameters: to")
+}
+
+func TestRunSavedQuery_InvalidRenderedQuery(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockSavedQueryService(t)
+	svc.EXPECT().GetSavedQuery(mock.Anything, "acme", "checkout-errors").Return(savedCheckoutErrorsQuery(), nil)
+
+	h := &Handler{
+		baseHandler:       baseHandler{logger: noopLogger()},
+		savedQueryService: svc,
+		logsService:       servicemocks.NewMockLogsQuerier(t),
+	}
+	rr := httptest.NewRecorder()
+	h.RunSavedQuery(rr, newSavedQueryRequest(http.MethodPost, "/api/v1alpha1/queries/acme/checkout-errors/run",
+		`{"parameters":{"from":"yesterday","to":"today"}}`))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
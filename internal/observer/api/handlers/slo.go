@@ -0,0 +1,97 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// EvaluateErrorBudget handles POST /api/v1alpha1/slos/error-budget.
+func (h *Handler) EvaluateErrorBudget(w http.ResponseWriter, r *http.Request) {
+	h.evaluateErrorBudget(w, r, h.sloService)
+}
+
+// EvaluateErrorBudget handles POST /api/v1alpha1/slos/error-budget on the internal port.
+// The control plane uses it to record error budgets on ServiceLevelObjective resources.
+func (h *InternalHandler) EvaluateErrorBudget(w http.ResponseWriter, r *http.Request) {
+	h.evaluateErrorBudget(w, r, h.sloService)
+}
+
+func (b *baseHandler) evaluateErrorBudget(w http.ResponseWriter, r *http.Request, sloService service.SLOEvaluator) {
+	var req types.ErrorBudgetRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		b.logger.Error("Failed to bind error budget request", "error", err)
+		b.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidateErrorBudgetRequest(&req); err != nil {
+		b.logger.Debug("Error budget validation failed", "error", err)
+		b.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	// Guard against misconfigured deployments.
+	if sloService == nil {
+		b.logger.Error("SLO service is not initialized")
+		b.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1SLOServiceNotReady,
+			"SLO service is not initialized",
+		)
+		return
+	}
+
+	result, err := sloService.EvaluateErrorBudget(r.Context(), &req)
+	if err != nil {
+		if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
+			b.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+			return
+		}
+		if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
+			b.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+			return
+		}
+		errorCode := types.ErrorCodeV1SLOInternalGeneric
+		switch {
+		case errors.Is(err, service.ErrScopeAuthFailed):
+			b.writeErrorResponse(
+				w,
+				http.StatusInternalServerError,
+				gen.InternalServerError,
+				types.ErrorCodeV1ScopeAuthFailed,
+				"",
+			)
+			return
+		case errors.Is(err, service.ErrSLOInvalidRequest), errors.Is(err, service.ErrRuntimeTopologyInvalidRequest):
+			b.logger.Debug("Invalid error budget request", "error", err)
+			b.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, errorCode, err.Error())
+			return
+		case errors.Is(err, service.ErrRuntimeTopologyResolveSearchScope):
+			errorCode = types.ErrorCodeV1SLOResolverFailed
+		case errors.Is(err, service.ErrRuntimeTopologyRetrieval):
+			errorCode = types.ErrorCodeV1SLORetrievalFailed
+		}
+		b.logger.Error("Failed to evaluate error budget", "error", err)
+		b.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			errorCode,
+			"Failed to evaluate error budget",
+		)
+		return
+	}
+
+	b.writeJSON(w, http.StatusOK, result)
+}
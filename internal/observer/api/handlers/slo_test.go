@@ -0,0 +1,101 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const validErrorBudgetBody = `{"searchScope":{"namespace":"ns","project":"p","component":"c","environment":"prod"},` +
+	`"availabilityTarget":"99.9","window":"720h"}`
+
+func newErrorBudgetRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/slos/error-budget", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestEvaluateErrorBudget_Success(t *testing.T) {
+	t.Parallel()
+
+	remaining := 0.5
+	svc := servicemocks.NewMockSLOEvaluator(t)
+	svc.EXPECT().EvaluateErrorBudget(mock.Anything, mock.Anything).
+		Return(&types.ErrorBudgetResponse{TotalRequests: 10, ErrorBudgetRemaining: &remaining}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, sloService: svc}
+	rr := httptest.NewRecorder()
+	h.EvaluateErrorBudget(rr, newErrorBudgetRequest(validErrorBudgetBody))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"errorBudgetRemaining":0.5`)
+}
+
+func TestEvaluateErrorBudget_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}, sloService: servicemocks.NewMockSLOEvaluator(t)}
+	body := `{"searchScope":{"namespace":"ns","project":"p","environment":"prod"},"availabilityTarget":"99.9","window":"720h"}`
+	rr := httptest.NewRecorder()
+	h.EvaluateErrorBudget(rr, newErrorBudgetRequest(body))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "searchScope.component is required")
+}
+
+func TestEvaluateErrorBudget_ServiceNotInitialized(t *testing.T) {
+	t.Parallel()
+
+	h := &InternalHandler{baseHandler: baseHandler{logger: noopLogger()}}
+	rr := httptest.NewRecorder()
+	h.EvaluateErrorBudget(rr, newErrorBudgetRequest(validErrorBudgetBody))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1SLOServiceNotReady)
+}
+
+func TestEvaluateErrorBudget_ServiceErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"forbidden", observerAuthz.ErrAuthzForbidden, http.StatusForbidden, ""},
+		{"invalid", fmt.Errorf("%w: bad", service.ErrSLOInvalidRequest), http.StatusBadRequest, types.ErrorCodeV1SLOInternalGeneric},
+		{"retrieval", fmt.Errorf("%w: boom", service.ErrRuntimeTopologyRetrieval), http.StatusInternalServerError, types.ErrorCodeV1SLORetrievalFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockSLOEvaluator(t)
+			svc.EXPECT().EvaluateErrorBudget(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, sloService: svc}
+			rr := httptest.NewRecorder()
+			h.EvaluateErrorBudget(rr, newErrorBudgetRequest(validErrorBudgetBody))
+
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+			}
+		})
+	}
+}
@@ -5,11 +5,13 @@ package handlers
 
 import (
 	"fmt"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
 	"github.com/openchoreo/openchoreo/internal/observer/config"
@@ -22,11 +24,15 @@ const (
 	sortOrderAsc      = "asc"
 	maxQueryTimeRange = 30 * 24 * time.Hour // 30 days
 
+	maxCorrelationWindow = 15 * time.Minute
+
 	sourceTypeLog    = "log"
 	sourceTypeMetric = "metric"
 	sourceTypeBudget = "budget"
 )
 
+var savedQueryParameterName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 // ValidateLogsQueryRequest validates the LogsQueryRequest
 func ValidateLogsQueryRequest(req *types.LogsQueryRequest) error {
 	if req == nil {
@@ -80,6 +86,18 @@ func ValidateLogsQueryRequest(req *types.LogsQueryRequest) error {
 		return err
 	}
 
+	// Field filters apply to the fields parsed by a single component's log parsing profile
+	if len(req.FieldFilters) > 0 {
+		if req.SearchScope.Component == nil || req.SearchScope.Component.Component == "" {
+			return fmt.Errorf("fieldFilters require a searchScope with a component")
+		}
+		for name := range req.FieldFilters {
+			if name == "" {
+				return fmt.Errorf("fieldFilters cannot contain an empty field name")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -264,6 +282,34 @@ func ValidateMetricsQueryRequest(req *types.MetricsQueryRequest) error {
 	return nil
 }
 
+// ValidateFederatedLogsQueryRequest validates the request body for
+// POST /api/v1alpha1/federation/logs/query. Federated queries fan out to the planes of a
+// single component, so the project and component are required.
+func ValidateFederatedLogsQueryRequest(req *types.LogsQueryRequest) error {
+	if err := ValidateLogsQueryRequest(req); err != nil {
+		return err
+	}
+	if req.SearchScope.Component == nil {
+		return fmt.Errorf("searchScope must be a ComponentSearchScope")
+	}
+	if req.SearchScope.Component.Project == "" || req.SearchScope.Component.Component == "" {
+		return fmt.Errorf("searchScope.project and searchScope.component are required")
+	}
+	return nil
+}
+
+// ValidateFederatedMetricsQueryRequest validates the request body for
+// POST /api/v1alpha1/federation/metrics/query.
+func ValidateFederatedMetricsQueryRequest(req *types.MetricsQueryRequest) error {
+	if err := ValidateMetricsQueryRequest(req); err != nil {
+		return err
+	}
+	if req.SearchScope.Project == "" || req.SearchScope.Component == "" {
+		return fmt.Errorf("searchScope.project and searchScope.component are required")
+	}
+	return nil
+}
+
 // ValidateRuntimeTopologyRequest validates the request body for
 // POST /api/v1alpha1/metrics/runtime-topology. Runtime topology requires the
 // project + environment to be specified explicitly; namespace alone is not
@@ -291,6 +337,306 @@ func ValidateRuntimeTopologyRequest(req *types.RuntimeTopologyRequest) error {
 	return nil
 }
 
+// ValidateErrorBudgetRequest validates the SLO error budget request
+func ValidateErrorBudgetRequest(req *types.ErrorBudgetRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+
+	scope := req.SearchScope
+	if strings.TrimSpace(scope.Namespace) == "" {
+		return fmt.Errorf("searchScope.namespace is required")
+	}
+	if strings.TrimSpace(scope.Project) == "" {
+		return fmt.Errorf("searchScope.project is required")
+	}
+	if strings.TrimSpace(scope.Component) == "" {
+		return fmt.Errorf("searchScope.component is required")
+	}
+	if strings.TrimSpace(scope.Environment) == "" {
+		return fmt.Errorf("searchScope.environment is required")
+	}
+	if strings.TrimSpace(req.Window) == "" {
+		return fmt.Errorf("window is required")
+	}
+	if req.AvailabilityTarget == "" && req.Latency == nil {
+		return fmt.Errorf("availabilityTarget or latency is required")
+	}
+	if req.Latency != nil {
+		switch req.Latency.Percentile {
+		case "", "p50", "p90", "p99":
+		default:
+			return fmt.Errorf("invalid latency percentile %q; valid values are: p50, p90, p99", req.Latency.Percentile)
+		}
+		if req.Latency.ThresholdSeconds <= 0 {
+			return fmt.Errorf("latency.thresholdSeconds must be greater than 0")
+		}
+	}
+
+	return nil
+}
+
+// ValidateResourceRecommendationRequest validates the resource recommendation request
+func ValidateResourceRecommendationRequest(req *types.ResourceRecommendationRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+
+	scope := req.SearchScope
+	if strings.TrimSpace(scope.Namespace) == "" {
+		return fmt.Errorf("searchScope.namespace is required")
+	}
+	if strings.TrimSpace(scope.Project) == "" {
+		return fmt.Errorf("searchScope.project is required")
+	}
+	if strings.TrimSpace(scope.Component) == "" {
+		return fmt.Errorf("searchScope.component is required")
+	}
+	if strings.TrimSpace(scope.Environment) == "" {
+		return fmt.Errorf("searchScope.environment is required")
+	}
+	if req.Window != "" {
+		window, err := time.ParseDuration(req.Window)
+		if err != nil {
+			return fmt.Errorf("window must be a valid duration (e.g. 24h, 168h): %w", err)
+		}
+		if window < time.Hour {
+			return fmt.Errorf("window must be at least 1h")
+		}
+	}
+	if req.EndTime != "" {
+		if _, err := time.Parse(time.RFC3339, req.EndTime); err != nil {
+			return fmt.Errorf("endTime must be in RFC3339 format: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateCorrelationQueryRequest validates the request body for
+// POST /api/v1alpha1/correlations/query.
+func ValidateCorrelationQueryRequest(req *types.CorrelationQueryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+
+	scope := req.SearchScope
+	if strings.TrimSpace(scope.Namespace) == "" {
+		return fmt.Errorf("searchScope.namespace is required")
+	}
+	if strings.TrimSpace(scope.Project) == "" {
+		return fmt.Errorf("searchScope.project is required")
+	}
+	if strings.TrimSpace(scope.Component) == "" {
+		return fmt.Errorf("searchScope.component is required")
+	}
+	if strings.TrimSpace(scope.Environment) == "" {
+		return fmt.Errorf("searchScope.environment is required")
+	}
+	if req.TraceID == "" && req.Timestamp == "" {
+		return fmt.Errorf("one of traceId or timestamp is required")
+	}
+	if req.Timestamp != "" {
+		if _, err := time.Parse(time.RFC3339, req.Timestamp); err != nil {
+			return fmt.Errorf("timestamp must be in RFC3339 format: %w", err)
+		}
+	}
+	if req.Window != "" {
+		window, err := time.ParseDuration(req.Window)
+		if err != nil {
+			return fmt.Errorf("window must be a valid duration (e.g. 30s, 2m): %w", err)
+		}
+		if window <= 0 || window > maxCorrelationWindow {
+			return fmt.Errorf("window must be greater than 0 and at most %s", maxCorrelationWindow)
+		}
+	}
+	if req.LogLimit < 0 || req.LogLimit > config.MaxLimit {
+		return fmt.Errorf("logLimit must be between 0 and %d", config.MaxLimit)
+	}
+	return nil
+}
+
+// ValidateLogMetricQueryRequest validates the request body for
+// POST /api/v1alpha1/metrics/log-metrics/query. The metric pattern itself is
+// compiled and validated by the service.
+func ValidateLogMetricQueryRequest(req *types.LogMetricQueryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+
+	scope := req.SearchScope
+	if strings.TrimSpace(scope.Namespace) == "" {
+		return fmt.Errorf("searchScope.namespace is required")
+	}
+	if strings.TrimSpace(scope.Project) == "" {
+		return fmt.Errorf("searchScope.project is required")
+	}
+	if strings.TrimSpace(scope.Component) == "" {
+		return fmt.Errorf("searchScope.component is required")
+	}
+	if strings.TrimSpace(scope.Environment) == "" {
+		return fmt.Errorf("searchScope.environment is required")
+	}
+
+	if err := ValidateTimeRange(req.StartTime, req.EndTime); err != nil {
+		return err
+	}
+	if req.Step != nil && *req.Step != "" {
+		step, err := time.ParseDuration(*req.Step)
+		if err != nil {
+			return fmt.Errorf("step must be a valid duration (e.g. 1m, 5m, 15m, 30m, 1h): %w", err)
+		}
+		if step <= 0 {
+			return fmt.Errorf("step must be greater than 0")
+		}
+	}
+
+	if strings.TrimSpace(req.Metric.Name) == "" {
+		return fmt.Errorf("metric.name is required")
+	}
+	switch req.Metric.Type {
+	case "counter", "histogram":
+	default:
+		return fmt.Errorf("invalid metric.type %q; valid values are: counter, histogram", req.Metric.Type)
+	}
+	if req.Metric.Pattern == "" {
+		return fmt.Errorf("metric.pattern is required")
+	}
+	return ValidateLogLevels(req.Metric.LogLevels)
+}
+
+// ValidateAnomalyDetectionRequest validates the request body for
+// POST /api/v1alpha1/anomalies/detect. Signals, sensitivity and durations are
+// validated by the service.
+func ValidateAnomalyDetectionRequest(req *types.AnomalyDetectionRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+
+	scope := req.SearchScope
+	if strings.TrimSpace(scope.Namespace) == "" {
+		return fmt.Errorf("searchScope.namespace is required")
+	}
+	if strings.TrimSpace(scope.Project) == "" {
+		return fmt.Errorf("searchScope.project is required")
+	}
+	if strings.TrimSpace(scope.Component) == "" {
+		return fmt.Errorf("searchScope.component is required")
+	}
+	if strings.TrimSpace(scope.Environment) == "" {
+		return fmt.Errorf("searchScope.environment is required")
+	}
+	if req.EndTime != "" {
+		if _, err := time.Parse(time.RFC3339, req.EndTime); err != nil {
+			return fmt.Errorf("endTime must be in RFC3339 format: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidatePromotionNotificationRequest validates the request body for
+// POST /api/v1alpha1/notifications/promotions.
+func ValidatePromotionNotificationRequest(req *types.PromotionNotificationRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+
+	required := []struct {
+		field string
+		value string
+	}{
+		{"namespace", req.Namespace},
+		{"project", req.Project},
+		{"component", req.Component},
+		{"environment", req.Environment},
+		{"toRelease", req.ToRelease},
+		{"summary", req.Summary},
+	}
+	for _, r := range required {
+		if strings.TrimSpace(r.value) == "" {
+			return fmt.Errorf("%s is required", r.field)
+		}
+	}
+	if len(req.NotificationChannels) == 0 {
+		return fmt.Errorf("notificationChannels must not be empty")
+	}
+	for _, channel := range req.NotificationChannels {
+		if strings.TrimSpace(channel) == "" {
+			return fmt.Errorf("notificationChannels must not contain empty names")
+		}
+	}
+	return nil
+}
+
+// ValidateExportRequest validates the request body for
+// PUT /api/v1alpha1/exports/{namespace}/{name}. Mode, interval and sink settings
+// are validated by the export manager.
+func ValidateExportRequest(namespace, name string, req *types.ExportRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+	if err := validateExportKey(namespace, name); err != nil {
+		return err
+	}
+	if strings.TrimSpace(req.Sink.Type) == "" {
+		return fmt.Errorf("sink.type is required")
+	}
+	if len(req.Filter.LogLevels) > 0 {
+		if err := ValidateLogLevels(req.Filter.LogLevels); err != nil {
+			return fmt.Errorf("filter.logLevels: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateExportKey(namespace, name string) error {
+	if strings.TrimSpace(namespace) == "" {
+		return fmt.Errorf("namespace path parameter is required")
+	}
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("name path parameter is required")
+	}
+	return nil
+}
+
+// ValidateSavedQueryRequest validates the request body for POST /api/v1alpha1/queries.
+// Placeholders of the query template are checked against the parameters by the service.
+func ValidateSavedQueryRequest(req *types.SavedQueryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+	if err := validateSavedQueryKey(req.Namespace, req.Name); err != nil {
+		return err
+	}
+	if req.Kind != types.SavedQueryKindLogs && req.Kind != types.SavedQueryKindTraces {
+		return fmt.Errorf("kind must be %q or %q", types.SavedQueryKindLogs, types.SavedQueryKindTraces)
+	}
+	if len(req.Query) == 0 {
+		return fmt.Errorf("query is required")
+	}
+	seen := make(map[string]struct{}, len(req.Parameters))
+	for i, p := range req.Parameters {
+		if !savedQueryParameterName.MatchString(p.Name) {
+			return fmt.Errorf("parameters[%d].name must start with a letter or underscore and contain only letters, digits and underscores", i)
+		}
+		if _, exists := seen[p.Name]; exists {
+			return fmt.Errorf("duplicate parameter %q", p.Name)
+		}
+		seen[p.Name] = struct{}{}
+	}
+	return nil
+}
+
+// validateSavedQueryKey checks that the namespace is set and that the name is a DNS label.
+func validateSavedQueryKey(namespace, name string) error {
+	if strings.TrimSpace(namespace) == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("name %q is invalid: %s", name, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 // ValidateLogLevels validates the log levels array
 func ValidateLogLevels(logLevels []string) error {
 	validLevels := map[string]bool{
@@ -548,3 +894,61 @@ func ValidateIncidentPutRequest(req *gen.IncidentPutRequest) error {
 	}
 	return nil
 }
+
+// ValidateIdleWorkloadsRequest validates the request body for
+// POST /api/v1alpha1/metrics/idle-workloads.
+func ValidateIdleWorkloadsRequest(req *types.IdleWorkloadsRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+	if strings.TrimSpace(req.Namespace) == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	return nil
+}
+
+// ValidatePromQLQueryRequest validates the request body for
+// POST /api/v1alpha1/raw/promql/query. Cost limits are checked by the service.
+func ValidatePromQLQueryRequest(req *types.PromQLQueryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+	if strings.TrimSpace(req.Namespace) == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		return fmt.Errorf("query is required")
+	}
+	if _, err := time.Parse(time.RFC3339, req.EndTime); err != nil {
+		return fmt.Errorf("endTime must be in RFC3339 format: %w", err)
+	}
+	if req.Step != "" {
+		if _, err := time.Parse(time.RFC3339, req.StartTime); err != nil {
+			return fmt.Errorf("startTime must be in RFC3339 format when step is set: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateOpenSearchQueryRequest validates the request body for
+// POST /api/v1alpha1/raw/opensearch/search. Cost limits are checked by the service.
+func ValidateOpenSearchQueryRequest(req *types.OpenSearchQueryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+	if strings.TrimSpace(req.Namespace) == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	switch req.LogType {
+	case "runtime", "build", "gateway":
+	default:
+		return fmt.Errorf("logType must be one of runtime, build or gateway")
+	}
+	if _, err := time.Parse(time.RFC3339, req.StartTime); err != nil {
+		return fmt.Errorf("startTime must be in RFC3339 format: %w", err)
+	}
+	if _, err := time.Parse(time.RFC3339, req.EndTime); err != nil {
+		return fmt.Errorf("endTime must be in RFC3339 format: %w", err)
+	}
+	return nil
+}
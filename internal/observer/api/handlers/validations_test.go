@@ -280,6 +280,27 @@ func TestValidateLogsQueryRequest(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "field filters without component",
+			req: &types.LogsQueryRequest{
+				SearchScope: validComponentScope,
+				StartTime:   validStart, EndTime: validEnd,
+				FieldFilters: map[string]string{"logger": "com.acme.Billing"},
+			},
+			wantErr:     true,
+			errContains: "fieldFilters require a searchScope with a component",
+		},
+		{
+			name: "field filters with component",
+			req: &types.LogsQueryRequest{
+				SearchScope: &types.SearchScope{
+					Component: &types.ComponentSearchScope{Namespace: "ns", Project: "proj", Component: "comp"},
+				},
+				StartTime: validStart, EndTime: validEnd,
+				FieldFilters: map[string]string{"logger": "com.acme.Billing"},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -23,9 +23,10 @@ import (
 const evaluatesEndpoint = "/api/v1/authz/evaluates"
 
 type Client struct {
-	httpClient *http.Client
-	baseURL    string
-	logger     *slog.Logger
+	httpClient     *http.Client
+	baseURL        string
+	namespaceClaim string
+	logger         *slog.Logger
 }
 
 // NewClient creates a new authz HTTP client
@@ -49,20 +50,31 @@ func NewClient(cfg *config.AuthzConfig, logger *slog.Logger) (*Client, error) {
 
 	logger.Info("Authorization client initialized",
 		"service_url", cfg.ServiceURL,
-		"timeout", cfg.Timeout)
+		"timeout", cfg.Timeout,
+		"namespace_claim", cfg.NamespaceClaim)
 
 	return &Client{
-		baseURL:    cfg.ServiceURL,
-		httpClient: httpClient,
-		logger:     logger,
+		baseURL:        cfg.ServiceURL,
+		httpClient:     httpClient,
+		namespaceClaim: cfg.NamespaceClaim,
+		logger:         logger,
 	}, nil
 }
 
 // Evaluate evaluates a single authorization request via the unified evaluates endpoint.
+// Requests for a namespace outside the caller's namespace claim are denied without a call.
 func (c *Client) Evaluate(ctx context.Context, request *authzcore.EvaluateRequest) (*authzcore.Decision, error) {
 	if request == nil {
 		return nil, fmt.Errorf("evaluate request must not be nil")
 	}
+	if denied := tenantDecision(ctx, c.namespaceClaim, request); denied != nil {
+		c.logger.Warn("Cross-tenant access denied",
+			"action", request.Action,
+			"resource_type", request.Resource.Type,
+			"resource_id", request.Resource.ID,
+			"namespace", request.Resource.Hierarchy.Namespace)
+		return denied, nil
+	}
 
 	decisions, err := c.evaluate(ctx, []authzcore.EvaluateRequest{*request})
 	if err != nil {
@@ -92,19 +104,42 @@ func (c *Client) BatchEvaluate(ctx context.Context, request *authzcore.BatchEval
 		return nil, fmt.Errorf("batch evaluate request must not be nil")
 	}
 
-	decisions, err := c.evaluate(ctx, request.Requests)
+	// Requests outside the caller's namespace claim are answered locally; only the rest
+	// are sent to the authz service.
+	results := make([]authzcore.Decision, len(request.Requests))
+	pending := make([]int, 0, len(request.Requests))
+	pendingRequests := make([]authzcore.EvaluateRequest, 0, len(request.Requests))
+	for i := range request.Requests {
+		if denied := tenantDecision(ctx, c.namespaceClaim, &request.Requests[i]); denied != nil {
+			results[i] = *denied
+			continue
+		}
+		pending = append(pending, i)
+		pendingRequests = append(pendingRequests, request.Requests[i])
+	}
+	if denied := len(request.Requests) - len(pending); denied > 0 {
+		c.logger.Warn("Cross-tenant access denied in batch", "denied_count", denied)
+	}
+	if len(pending) == 0 {
+		return &authzcore.BatchEvaluateResponse{Decisions: results}, nil
+	}
+
+	decisions, err := c.evaluate(ctx, pendingRequests)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(decisions) != len(request.Requests) {
-		c.logger.Error("Decisions count mismatch", "expected", len(request.Requests), "got", len(decisions))
+	if len(decisions) != len(pendingRequests) {
+		c.logger.Error("Decisions count mismatch", "expected", len(pendingRequests), "got", len(decisions))
 		return nil, ErrAuthzInvalidResponse
 	}
+	for i, d := range decisions {
+		results[pending[i]] = d
+	}
 
 	c.logger.Debug("Batch authorization evaluated", "request_count", len(request.Requests))
 
-	return &authzcore.BatchEvaluateResponse{Decisions: decisions}, nil
+	return &authzcore.BatchEvaluateResponse{Decisions: results}, nil
 }
 
 // GetSubjectProfile is not implemented for observer API
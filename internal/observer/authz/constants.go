@@ -6,13 +6,17 @@ package authz
 type Action string
 
 const (
-	ActionViewLogs        Action = "logs:view"
-	ActionViewEvents      Action = "events:view"
-	ActionViewTraces      Action = "traces:view"
-	ActionViewMetrics     Action = "metrics:view"
-	ActionViewAlerts      Action = "alerts:view"
-	ActionViewIncidents   Action = "incidents:view"
-	ActionUpdateIncidents Action = "incidents:update"
+	ActionViewLogs         Action = "logs:view"
+	ActionViewEvents       Action = "events:view"
+	ActionViewTraces       Action = "traces:view"
+	ActionViewMetrics      Action = "metrics:view"
+	ActionViewAlerts       Action = "alerts:view"
+	ActionViewIncidents    Action = "incidents:view"
+	ActionUpdateIncidents  Action = "incidents:update"
+	ActionExecuteRawQuery  Action = "rawquery:execute"
+	ActionViewSavedQuery   Action = "savedquery:view"
+	ActionCreateSavedQuery Action = "savedquery:create"
+	ActionDeleteSavedQuery Action = "savedquery:delete"
 )
 
 type ResourceType string
@@ -142,11 +142,22 @@ func CheckAuthorization(
 		return fmt.Errorf("authorization evaluation failed: %w", err)
 	}
 
-	logger.Debug("Authorization decision received",
+	// Every decision is logged so that access to observability data can be audited.
+	reason := ""
+	if decision.Context != nil {
+		reason = decision.Context.Reason
+	}
+	logger.Info("Authorization decision",
 		"decision", decision.Decision,
+		"reason", reason,
+		"subject_id", authSubjectCtx.ID,
+		"subject_type", authSubjectCtx.Type,
 		"action", action,
 		"resource_type", resourceType,
-		"resource_id", resourceID)
+		"resource_id", resourceID,
+		"namespace", hierarchy.Namespace,
+		"project", hierarchy.Project,
+		"component", hierarchy.Component)
 
 	if !decision.Decision {
 		return ErrAuthzForbidden
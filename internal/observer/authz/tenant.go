@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+// tenantDeniedReason is the reason recorded on decisions denied by the tenant claim.
+const tenantDeniedReason = "namespace is not in the caller's tenant claim"
+
+// NamespacesFromClaim returns the namespaces listed in the given claim of the caller's JWT.
+// The claim may be a list of strings or a single comma or space separated string.
+// ok is false when the request carries no JWT claims.
+func NamespacesFromClaim(ctx context.Context, claim string) (namespaces []string, ok bool) {
+	claims, ok := jwt.GetClaimsFromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	switch v := claims[claim].(type) {
+	case string:
+		namespaces = strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' })
+	case []any:
+		for _, item := range v {
+			if s, isString := item.(string); isString && s != "" {
+				namespaces = append(namespaces, s)
+			}
+		}
+	case []string:
+		namespaces = v
+	}
+	return namespaces, true
+}
+
+// tenantDecision denies requests for a namespace outside the caller's namespace claim.
+// It returns nil when the request must be evaluated by the authz service.
+func tenantDecision(ctx context.Context, claim string, request *authzcore.EvaluateRequest) *authzcore.Decision {
+	if claim == "" {
+		return nil
+	}
+	namespace := request.Resource.Hierarchy.Namespace
+	namespaces, _ := NamespacesFromClaim(ctx, claim)
+	if namespace != "" && slices.Contains(namespaces, namespace) {
+		return nil
+	}
+	return &authzcore.Decision{
+		Decision: false,
+		Context:  &authzcore.DecisionContext{Reason: tenantDeniedReason},
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+func ctxWithClaims(claims gojwt.MapClaims) context.Context {
+	return jwt.WithClaims(context.Background(), claims)
+}
+
+func TestNamespacesFromClaim(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		want   []string
+		wantOK bool
+	}{
+		{
+			name:   "no claims in context",
+			ctx:    context.Background(),
+			wantOK: false,
+		},
+		{
+			name:   "list claim",
+			ctx:    ctxWithClaims(gojwt.MapClaims{"namespaces": []any{"acme", "", "globex"}}),
+			want:   []string{"acme", "globex"},
+			wantOK: true,
+		},
+		{
+			name:   "separated string claim",
+			ctx:    ctxWithClaims(gojwt.MapClaims{"namespaces": "acme, globex"}),
+			want:   []string{"acme", "globex"},
+			wantOK: true,
+		},
+		{
+			name:   "missing claim",
+			ctx:    ctxWithClaims(gojwt.MapClaims{"sub": "user-123"}),
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NamespacesFromClaim(tt.ctx, "namespaces")
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// newTenantClient builds a Client that enforces the "namespaces" claim and counts the requests
+// forwarded to the authz service, which allows all of them.
+func newTenantClient(t *testing.T) (*Client, *[][]authzcore.EvaluateRequest) {
+	t.Helper()
+	var forwarded [][]authzcore.EvaluateRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqs []authzcore.EvaluateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&reqs))
+		forwarded = append(forwarded, reqs)
+		decisions := make([]authzcore.Decision, len(reqs))
+		for i := range decisions {
+			decisions[i].Decision = true
+		}
+		_ = json.NewEncoder(w).Encode(decisions)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := NewClient(&config.AuthzConfig{
+		ServiceURL:     srv.URL,
+		Timeout:        5 * time.Second,
+		NamespaceClaim: "namespaces",
+	}, noopLogger())
+	require.NoError(t, err)
+	return c, &forwarded
+}
+
+func viewLogsRequest(namespace string) authzcore.EvaluateRequest {
+	return authzcore.EvaluateRequest{
+		Action: string(ActionViewLogs),
+		Resource: authzcore.Resource{
+			Type:      string(ResourceTypeComponent),
+			ID:        "api",
+			Hierarchy: authzcore.ResourceHierarchy{Namespace: namespace, Project: "shop", Component: "api"},
+		},
+	}
+}
+
+func TestEvaluate_TenantClaim(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		namespace     string
+		wantDecision  bool
+		wantForwarded int
+	}{
+		{
+			name:          "namespace in claim is forwarded",
+			ctx:           ctxWithClaims(gojwt.MapClaims{"namespaces": []any{"acme"}}),
+			namespace:     "acme",
+			wantDecision:  true,
+			wantForwarded: 1,
+		},
+		{
+			name:      "namespace outside claim is denied",
+			ctx:       ctxWithClaims(gojwt.MapClaims{"namespaces": []any{"acme"}}),
+			namespace: "globex",
+		},
+		{
+			name:      "request without namespace is denied",
+			ctx:       ctxWithClaims(gojwt.MapClaims{"namespaces": []any{"acme"}}),
+			namespace: "",
+		},
+		{
+			name:      "request without claims is denied",
+			ctx:       context.Background(),
+			namespace: "acme",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, forwarded := newTenantClient(t)
+			req := viewLogsRequest(tt.namespace)
+
+			decision, err := c.Evaluate(tt.ctx, &req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDecision, decision.Decision)
+			assert.Len(t, *forwarded, tt.wantForwarded)
+			if !tt.wantDecision {
+				require.NotNil(t, decision.Context)
+				assert.Equal(t, tenantDeniedReason, decision.Context.Reason)
+			}
+		})
+	}
+}
+
+func TestBatchEvaluate_TenantClaim(t *testing.T) {
+	c, forwarded := newTenantClient(t)
+	ctx := ctxWithClaims(gojwt.MapClaims{"namespaces": []any{"acme"}})
+
+	resp, err := c.BatchEvaluate(ctx, &authzcore.BatchEvaluateRequest{
+		Requests: []authzcore.EvaluateRequest{
+			viewLogsRequest("globex"),
+			viewLogsRequest("acme"),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Decisions, 2)
+	assert.False(t, resp.Decisions[0].Decision)
+	assert.True(t, resp.Decisions[1].Decision)
+	require.Len(t, *forwarded, 1)
+	require.Len(t, (*forwarded)[0], 1)
+	assert.Equal(t, "acme", (*forwarded)[0][0].Resource.Hierarchy.Namespace)
+}
+
+func TestBatchEvaluate_TenantClaimAllDenied(t *testing.T) {
+	c, forwarded := newTenantClient(t)
+	ctx := ctxWithClaims(gojwt.MapClaims{"namespaces": []any{"acme"}})
+
+	resp, err := c.BatchEvaluate(ctx, &authzcore.BatchEvaluateRequest{
+		Requests: []authzcore.EvaluateRequest{viewLogsRequest("globex")},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Decisions, 1)
+	assert.False(t, resp.Decisions[0].Decision)
+	assert.Empty(t, *forwarded)
+}
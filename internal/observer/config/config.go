@@ -29,8 +29,20 @@ type Config struct {
 	Alerting    AlertingConfig    `koanf:"alerting"`
 	Adapters    AdaptersConfig    `koanf:"adapters"`
 	UIDResolver UIDResolverConfig `koanf:"uid_resolver"`
-	CORS        CORSConfig        `koanf:"cors"`
-	LogLevel    string            `koanf:"loglevel"`
+	// IndexLifecycle configures OpenSearch index state management for log indices
+	IndexLifecycle IndexLifecycleConfig `koanf:"index_lifecycle"`
+	// IdleDetection configures the periodic analysis that flags idle workloads
+	IdleDetection IdleDetectionConfig `koanf:"idle_detection"`
+	// RawQuery configures the admin-only PromQL and OpenSearch passthrough endpoints
+	RawQuery RawQueryConfig `koanf:"raw_query"`
+	// Federation configures fan-out of log and metric queries to the observers of other planes
+	Federation FederationConfig `koanf:"federation"`
+	// Export configures forwarding of log streams to external sinks
+	Export ExportConfig `koanf:"export"`
+	// SavedQueries configures the store of named log and trace query templates
+	SavedQueries SavedQueriesConfig `koanf:"saved_queries"`
+	CORS         CORSConfig         `koanf:"cors"`
+	LogLevel     string             `koanf:"loglevel"`
 }
 
 // AdaptersConfig holds adapter configuration
@@ -52,6 +64,14 @@ type ServerConfig struct {
 	ReadTimeout     time.Duration `koanf:"read.timeout"`
 	WriteTimeout    time.Duration `koanf:"write.timeout"`
 	ShutdownTimeout time.Duration `koanf:"shutdown.timeout"`
+	// MetricsEnabled records HTTP server metrics and exposes them on the internal port at /metrics
+	MetricsEnabled bool `koanf:"metrics.enabled"`
+	// RateLimitRequestsPerSecond is the sustained request rate allowed per client IP on the
+	// public port. Zero disables rate limiting.
+	RateLimitRequestsPerSecond float64 `koanf:"rate.limit.requests.per.second"`
+	// RateLimitBurst is the number of requests a client may make at once. Defaults to the
+	// requests per second when zero.
+	RateLimitBurst int `koanf:"rate.limit.burst"`
 }
 
 // CORSConfig holds CORS configuration
@@ -72,6 +92,9 @@ type AuthzConfig struct {
 	ServiceURL            string        `koanf:"service.url"`
 	Timeout               time.Duration `koanf:"timeout"`
 	TLSInsecureSkipVerify bool          `koanf:"tls.insecure.skip.verify"`
+	// NamespaceClaim is the JWT claim listing the namespaces a caller belongs to. When set,
+	// queries for any other namespace are denied before the authz service is consulted.
+	NamespaceClaim string `koanf:"namespace.claim"`
 }
 
 // LoggingConfig holds application logging configuration
@@ -129,6 +152,393 @@ type UIDResolverConfig struct {
 	MaxAuthRetry int `koanf:"max.auth.retry"`
 }
 
+// IndexLifecycleConfig holds configuration for the OpenSearch index state management (ISM)
+// policies that the observer provisions for log indices. Each log category gets a
+// hot-warm-delete policy; a category is skipped when its retention is zero.
+type IndexLifecycleConfig struct {
+	// Enabled controls whether the observer provisions ISM policies
+	Enabled bool `koanf:"enabled"`
+	// OpenSearchURL is the base URL of the OpenSearch cluster holding the log indices
+	OpenSearchURL string `koanf:"opensearch.url"`
+	// OpenSearchUsername is the basic auth username for OpenSearch
+	OpenSearchUsername string `koanf:"opensearch.username"`
+	// OpenSearchPassword is the basic auth password for OpenSearch
+	OpenSearchPassword string `koanf:"opensearch.password"`
+	// TLSInsecureSkipVerify skips TLS certificate verification (for development)
+	TLSInsecureSkipVerify bool `koanf:"tls.insecure.skip.verify"`
+	// Timeout is the HTTP client timeout for OpenSearch calls
+	Timeout time.Duration `koanf:"timeout"`
+	// SyncInterval is how often the policies are re-applied to correct drift
+	SyncInterval time.Duration `koanf:"sync.interval"`
+
+	// Per-category index patterns, days before moving indices to the warm tier (0 disables the
+	// warm tier) and days before deleting indices (0 disables the policy for the category).
+	BuildIndexPattern    string `koanf:"build.index.pattern"`
+	BuildWarmAfterDays   int    `koanf:"build.warm.after.days"`
+	BuildRetentionDays   int    `koanf:"build.retention.days"`
+	RuntimeIndexPattern  string `koanf:"runtime.index.pattern"`
+	RuntimeWarmAfterDays int    `koanf:"runtime.warm.after.days"`
+	RuntimeRetentionDays int    `koanf:"runtime.retention.days"`
+	GatewayIndexPattern  string `koanf:"gateway.index.pattern"`
+	GatewayWarmAfterDays int    `koanf:"gateway.warm.after.days"`
+	GatewayRetentionDays int    `koanf:"gateway.retention.days"`
+	AuditIndexPattern    string `koanf:"audit.index.pattern"`
+	AuditWarmAfterDays   int    `koanf:"audit.warm.after.days"`
+	AuditRetentionDays   int    `koanf:"audit.retention.days"`
+}
+
+// IndexLifecyclePolicy is the retention policy of a single log category.
+type IndexLifecyclePolicy struct {
+	// Category is the log category, e.g. "build" or "runtime"
+	Category      string
+	IndexPattern  string
+	WarmAfterDays int
+	RetentionDays int
+}
+
+// Policies returns the retention policies of the log categories that have a retention configured.
+func (c *IndexLifecycleConfig) Policies() []IndexLifecyclePolicy {
+	all := []IndexLifecyclePolicy{
+		{Category: "build", IndexPattern: c.BuildIndexPattern, WarmAfterDays: c.BuildWarmAfterDays, RetentionDays: c.BuildRetentionDays},
+		{Category: "runtime", IndexPattern: c.RuntimeIndexPattern, WarmAfterDays: c.RuntimeWarmAfterDays, RetentionDays: c.RuntimeRetentionDays},
+		{Category: "gateway", IndexPattern: c.GatewayIndexPattern, WarmAfterDays: c.GatewayWarmAfterDays, RetentionDays: c.GatewayRetentionDays},
+		{Category: "audit", IndexPattern: c.AuditIndexPattern, WarmAfterDays: c.AuditWarmAfterDays, RetentionDays: c.AuditRetentionDays},
+	}
+	policies := make([]IndexLifecyclePolicy, 0, len(all))
+	for _, p := range all {
+		if p.RetentionDays > 0 {
+			policies = append(policies, p)
+		}
+	}
+	return policies
+}
+
+func (c *IndexLifecycleConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.OpenSearchURL == "" {
+		return fmt.Errorf("index lifecycle opensearch URL is required when index lifecycle is enabled")
+	}
+	c.OpenSearchURL = strings.TrimRight(c.OpenSearchURL, "/")
+	if c.Timeout <= 0 {
+		return fmt.Errorf("index lifecycle timeout must be positive")
+	}
+	if c.SyncInterval <= 0 {
+		return fmt.Errorf("index lifecycle sync interval must be positive")
+	}
+	for _, p := range c.Policies() {
+		if strings.TrimSpace(p.IndexPattern) == "" {
+			return fmt.Errorf("index lifecycle %s index pattern is required", p.Category)
+		}
+		if p.WarmAfterDays < 0 {
+			return fmt.Errorf("index lifecycle %s warm.after.days must be non-negative", p.Category)
+		}
+		if p.WarmAfterDays >= p.RetentionDays {
+			return fmt.Errorf("index lifecycle %s warm.after.days (%d) must be less than retention.days (%d)",
+				p.Category, p.WarmAfterDays, p.RetentionDays)
+		}
+	}
+	return nil
+}
+
+// IdleDetectionConfig holds configuration for the periodic analysis that flags components
+// with near-zero traffic and CPU usage so that abandoned deployments can be cleaned up.
+type IdleDetectionConfig struct {
+	// Enabled controls whether the observer runs the idle workload analysis
+	Enabled bool `koanf:"enabled"`
+	// Interval is how often the analysis runs
+	Interval time.Duration `koanf:"interval"`
+	// Window is the usage history analyzed for environments without a window override
+	Window time.Duration `koanf:"window"`
+	// EnvironmentWindows overrides the window per environment name as a comma-separated list
+	// of name=duration pairs, e.g. "development=72h,production=336h"
+	EnvironmentWindows string `koanf:"environment.windows"`
+	// MaxCPUCores is the 95th percentile CPU usage (cores) at or below which a component is idle
+	MaxCPUCores float64 `koanf:"max.cpu.cores"`
+	// MaxRequestRate is the peak request rate (requests per second) at or below which a
+	// component is considered to receive no traffic
+	MaxRequestRate float64 `koanf:"max.request.rate"`
+	// CPUCoreMonthlyCost is the monthly price of one requested CPU core, used to estimate savings
+	CPUCoreMonthlyCost float64 `koanf:"cpu.core.monthly.cost"`
+	// MemoryGiBMonthlyCost is the monthly price of one requested GiB of memory
+	MemoryGiBMonthlyCost float64 `koanf:"memory.gib.monthly.cost"`
+	// Currency labels the savings estimate
+	Currency string `koanf:"currency"`
+	// SuspendBaseURL is the openchoreo-api URL used to build suspend links. Defaults to the
+	// UID resolver's openchoreo-api URL.
+	SuspendBaseURL string `koanf:"suspend.base.url"`
+}
+
+// WindowFor returns the analysis window of the named environment.
+func (c *IdleDetectionConfig) WindowFor(environment string) time.Duration {
+	windows, err := c.environmentWindows()
+	if err == nil {
+		if w, ok := windows[environment]; ok {
+			return w
+		}
+	}
+	return c.Window
+}
+
+func (c *IdleDetectionConfig) environmentWindows() (map[string]time.Duration, error) {
+	windows := make(map[string]time.Duration)
+	for _, entry := range strings.Split(c.EnvironmentWindows, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid idle detection environment window %q: expected name=duration", entry)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid idle detection window for environment %q: %q", name, value)
+		}
+		windows[strings.TrimSpace(name)] = d
+	}
+	return windows, nil
+}
+
+func (c *IdleDetectionConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("idle detection interval must be positive")
+	}
+	if c.Window <= 0 {
+		return fmt.Errorf("idle detection window must be positive")
+	}
+	if _, err := c.environmentWindows(); err != nil {
+		return err
+	}
+	if c.MaxCPUCores < 0 || c.MaxRequestRate < 0 {
+		return fmt.Errorf("idle detection thresholds must be non-negative")
+	}
+	if c.CPUCoreMonthlyCost < 0 || c.MemoryGiBMonthlyCost < 0 {
+		return fmt.Errorf("idle detection costs must be non-negative")
+	}
+	c.SuspendBaseURL = strings.TrimRight(c.SuspendBaseURL, "/")
+	return nil
+}
+
+// RawQueryConfig holds configuration for the passthrough endpoints that run raw PromQL and
+// OpenSearch queries. Every query is pinned to a single namespace through the configured
+// label and field, and is bounded by the time range and result size limits.
+type RawQueryConfig struct {
+	// Enabled controls whether the passthrough endpoints are served
+	Enabled bool `koanf:"enabled"`
+	// PrometheusURL is the base URL of the Prometheus HTTP API
+	PrometheusURL string `koanf:"prometheus.url"`
+	// PrometheusNamespaceLabel is the metric label that carries the OpenChoreo namespace
+	PrometheusNamespaceLabel string `koanf:"prometheus.namespace.label"`
+	// OpenSearchURL is the base URL of the OpenSearch cluster holding the log indices
+	OpenSearchURL string `koanf:"opensearch.url"`
+	// OpenSearchUsername is the basic auth username for OpenSearch
+	OpenSearchUsername string `koanf:"opensearch.username"`
+	// OpenSearchPassword is the basic auth password for OpenSearch
+	OpenSearchPassword string `koanf:"opensearch.password"`
+	// OpenSearchNamespaceField is the keyword field of log documents that carries the OpenChoreo namespace
+	OpenSearchNamespaceField string `koanf:"opensearch.namespace.field"`
+	// TLSInsecureSkipVerify skips TLS certificate verification (for development)
+	TLSInsecureSkipVerify bool `koanf:"tls.insecure.skip.verify"`
+	// Timeout bounds the execution of a single query
+	Timeout time.Duration `koanf:"timeout"`
+	// MaxTimeRange is the longest time range a query may cover
+	MaxTimeRange time.Duration `koanf:"max.time.range"`
+	// MaxResults is the maximum number of series or documents a query may return
+	MaxResults int `koanf:"max.results"`
+
+	// Index patterns searched for each log type
+	RuntimeIndexPattern string `koanf:"runtime.index.pattern"`
+	BuildIndexPattern   string `koanf:"build.index.pattern"`
+	GatewayIndexPattern string `koanf:"gateway.index.pattern"`
+}
+
+// IndexPattern returns the index pattern of the given log type, or an empty string when
+// the log type is unknown.
+func (c *RawQueryConfig) IndexPattern(logType string) string {
+	switch logType {
+	case "runtime":
+		return c.RuntimeIndexPattern
+	case "build":
+		return c.BuildIndexPattern
+	case "gateway":
+		return c.GatewayIndexPattern
+	default:
+		return ""
+	}
+}
+
+func (c *RawQueryConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.PrometheusURL == "" && c.OpenSearchURL == "" {
+		return fmt.Errorf("raw query requires a prometheus URL or an opensearch URL when enabled")
+	}
+	c.PrometheusURL = strings.TrimRight(c.PrometheusURL, "/")
+	c.OpenSearchURL = strings.TrimRight(c.OpenSearchURL, "/")
+	if c.PrometheusURL != "" && strings.TrimSpace(c.PrometheusNamespaceLabel) == "" {
+		return fmt.Errorf("raw query prometheus namespace label is required")
+	}
+	if c.OpenSearchURL != "" && strings.TrimSpace(c.OpenSearchNamespaceField) == "" {
+		return fmt.Errorf("raw query opensearch namespace field is required")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("raw query timeout must be positive")
+	}
+	if c.MaxTimeRange <= 0 {
+		return fmt.Errorf("raw query max time range must be positive")
+	}
+	if c.MaxResults <= 0 || c.MaxResults > MaxLimit {
+		return fmt.Errorf("raw query max results must be between 1 and %d", MaxLimit)
+	}
+	return nil
+}
+
+// FederationConfig holds configuration for federating log and metric queries across the
+// observability planes of a component. The environments of a component are mapped to the
+// ObservabilityPlane serving them, and each plane's observer is queried with the caller's token.
+type FederationConfig struct {
+	// Enabled controls whether the federated query endpoints are served
+	Enabled bool `koanf:"enabled"`
+	// LocalPlane is the name of the observability plane this observer serves. Queries for it
+	// are answered locally.
+	LocalPlane string `koanf:"local.plane"`
+	// Planes lists the observers of the other observability planes as a comma-separated list
+	// of name=url pairs, e.g. "us-east=https://observer.us-east.example.com"
+	Planes string `koanf:"planes"`
+	// TLSInsecureSkipVerify skips TLS certificate verification (for development)
+	TLSInsecureSkipVerify bool `koanf:"tls.insecure.skip.verify"`
+	// Timeout bounds the query to a single remote plane
+	Timeout time.Duration `koanf:"timeout"`
+}
+
+// PlaneURLs returns the observer base URL of each remote observability plane by plane name.
+func (c *FederationConfig) PlaneURLs() (map[string]string, error) {
+	urls := make(map[string]string)
+	for _, entry := range strings.Split(c.Planes, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("invalid federation plane %q: expected name=url", entry)
+		}
+		if name == c.LocalPlane {
+			return nil, fmt.Errorf("federation plane %q is the local plane", name)
+		}
+		if _, exists := urls[name]; exists {
+			return nil, fmt.Errorf("federation plane %q is listed more than once", name)
+		}
+		urls[name] = strings.TrimRight(value, "/")
+	}
+	return urls, nil
+}
+
+func (c *FederationConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if strings.TrimSpace(c.LocalPlane) == "" {
+		return fmt.Errorf("federation local plane is required when federation is enabled")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("federation timeout must be positive")
+	}
+	if _, err := c.PlaneURLs(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ExportConfig holds configuration for exporting logs to external sinks. Exports are
+// registered by the ObservabilityExport controller; the observer runs them.
+type ExportConfig struct {
+	// Enabled controls whether exports are accepted and run
+	Enabled bool `koanf:"enabled"`
+	// SyncInterval is how often the observer checks which exports are due
+	SyncInterval time.Duration `koanf:"sync.interval"`
+	// ContinuousInterval is how often continuous exports flush new logs to their sink
+	ContinuousInterval time.Duration `koanf:"continuous.interval"`
+	// IngestionDelay holds back the most recent logs so that late-arriving entries are
+	// exported with their window
+	IngestionDelay time.Duration `koanf:"ingestion.delay"`
+	// MaxEntriesPerRun caps the log entries written by one run. Logs beyond the cap are
+	// exported by the next run.
+	MaxEntriesPerRun int `koanf:"max.entries.per.run"`
+	// SinkTimeout bounds a single write to a sink
+	SinkTimeout time.Duration `koanf:"sink.timeout"`
+}
+
+func (c *ExportConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.SyncInterval <= 0 {
+		return fmt.Errorf("export sync interval must be positive")
+	}
+	if c.ContinuousInterval < c.SyncInterval {
+		return fmt.Errorf("export continuous interval must not be shorter than the sync interval")
+	}
+	if c.IngestionDelay < 0 {
+		return fmt.Errorf("export ingestion delay must not be negative")
+	}
+	if c.MaxEntriesPerRun <= 0 {
+		return fmt.Errorf("export max entries per run must be positive")
+	}
+	if c.SinkTimeout <= 0 {
+		return fmt.Errorf("export sink timeout must be positive")
+	}
+	return nil
+}
+
+// SavedQueriesConfig holds configuration for saved queries: named log and trace query
+// templates that teams share within a namespace. They are stored in an OpenSearch index.
+type SavedQueriesConfig struct {
+	// Enabled controls whether the saved query endpoints are served
+	Enabled bool `koanf:"enabled"`
+	// OpenSearchURL is the base URL of the OpenSearch cluster holding the saved query index
+	OpenSearchURL string `koanf:"opensearch.url"`
+	// OpenSearchUsername is the basic auth username for OpenSearch
+	OpenSearchUsername string `koanf:"opensearch.username"`
+	// OpenSearchPassword is the basic auth password for OpenSearch
+	OpenSearchPassword string `koanf:"opensearch.password"`
+	// Index is the OpenSearch index the saved queries are stored in. It is created on startup.
+	Index string `koanf:"index"`
+	// TLSInsecureSkipVerify skips TLS certificate verification (for development)
+	TLSInsecureSkipVerify bool `koanf:"tls.insecure.skip.verify"`
+	// Timeout is the HTTP client timeout for OpenSearch calls
+	Timeout time.Duration `koanf:"timeout"`
+	// MaxPerNamespace caps the number of saved queries in a namespace
+	MaxPerNamespace int `koanf:"max.per.namespace"`
+}
+
+func (c *SavedQueriesConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.OpenSearchURL == "" {
+		return fmt.Errorf("saved queries opensearch URL is required when saved queries are enabled")
+	}
+	c.OpenSearchURL = strings.TrimRight(c.OpenSearchURL, "/")
+	if strings.TrimSpace(c.Index) == "" {
+		return fmt.Errorf("saved queries index is required")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("saved queries timeout must be positive")
+	}
+	if c.MaxPerNamespace <= 0 || c.MaxPerNamespace > MaxLimit {
+		return fmt.Errorf("saved queries max per namespace must be between 1 and %d", MaxLimit)
+	}
+	return nil
+}
+
 // Load loads configuration from environment variables and defaults
 func Load() (*Config, error) {
 	k := koanf.New(".")
@@ -164,49 +574,115 @@ func Load() (*Config, error) {
 
 	// Define environment variable mappings
 	envMappings := map[string]string{
-		"SERVER_PORT":                           "server.port",
-		"SERVER_INTERNAL_PORT":                  "server.internal.port",
-		"SERVER_READ_TIMEOUT":                   "server.read.timeout",
-		"SERVER_WRITE_TIMEOUT":                  "server.write.timeout",
-		"SERVER_SHUTDOWN_TIMEOUT":               "server.shutdown.timeout",
-		"AUTH_JWT_SECRET":                       "auth.jwt.secret",
-		"AUTH_ENABLE_AUTH":                      "auth.enable.auth",
-		"AUTH_REQUIRED_ROLE":                    "auth.required.role",
-		"AUTHZ_SERVICE_URL":                     "authz.service.url",
-		"AUTHZ_TIMEOUT":                         "authz.timeout",
-		"AUTHZ_TLS_INSECURE_SKIP_VERIFY":        "authz.tls.insecure.skip.verify",
-		"LOGGING_MAX_LOG_LIMIT":                 "logging.max.log.limit",
-		"LOGGING_DEFAULT_LOG_LIMIT":             "logging.default.log.limit",
-		"LOGGING_DEFAULT_BUILD_LOG_LIMIT":       "logging.default.build.log.limit",
-		"LOGGING_MAX_LOG_LINES_PER_FILE":        "logging.max.log.lines.per.file",
-		"RCA_SERVICE_URL":                       "alerting.rca.service.url",
-		"AI_RCA_ENABLED":                        "alerting.ai.rca.enabled",
-		"OBSERVABILITY_NAMESPACE":               "alerting.observability.namespace",
-		"ALERT_STORE_BACKEND":                   "alerting.alert.store.backend",
-		"ALERT_STORE_DSN":                       "alerting.alert.store.dsn",
-		"ALERT_SUPPRESSION_WINDOW":              "alerting.alert.suppression.window",
-		"FINOPS_AGENT_URL":                      "alerting.finops.agent.url",
-		"FINOPS_AGENT_ENABLED":                  "alerting.finops.agent.enabled",
-		"LOG_LEVEL":                             "loglevel",
-		"PORT":                                  "server.port",           // Common alias
-		"INTERNAL_PORT":                         "server.internal.port",  // Common alias
-		"JWT_SECRET":                            "auth.jwt.secret",       // Common alias
-		"ENABLE_AUTH":                           "auth.enable.auth",      // Common alias
-		"MAX_LOG_LIMIT":                         "logging.max.log.limit", // Common alias
-		"LOGS_ADAPTER_URL":                      "adapters.logs.adapter.url",
-		"LOGS_ADAPTER_TIMEOUT":                  "adapters.logs.adapter.timeout",
-		"TRACING_ADAPTER_URL":                   "adapters.tracing.adapter.url",
-		"TRACING_ADAPTER_TIMEOUT":               "adapters.tracing.adapter.timeout",
-		"METRICS_ADAPTER_URL":                   "adapters.metrics.adapter.url",
-		"METRICS_ADAPTER_TIMEOUT":               "adapters.metrics.adapter.timeout",
-		"UID_RESOLVER_OPENCHOREO_API_URL":       "uid_resolver.openchoreo.api.url",
-		"UID_RESOLVER_OAUTH_TOKEN_URL":          "uid_resolver.oauth.token.url",
-		"UID_RESOLVER_OAUTH_CLIENT_ID":          "uid_resolver.oauth.client.id",
-		"UID_RESOLVER_OAUTH_CLIENT_SECRET":      "uid_resolver.oauth.client.secret",
-		"UID_RESOLVER_OAUTH_SCOPE":              "uid_resolver.oauth.scope",
-		"UID_RESOLVER_TLS_INSECURE_SKIP_VERIFY": "uid_resolver.tls.insecure.skip.verify",
-		"UID_RESOLVER_TIMEOUT":                  "uid_resolver.timeout",
-		"UID_RESOLVER_MAX_AUTH_RETRY":           "uid_resolver.max.auth.retry",
+		"SERVER_PORT":                              "server.port",
+		"SERVER_INTERNAL_PORT":                     "server.internal.port",
+		"SERVER_READ_TIMEOUT":                      "server.read.timeout",
+		"SERVER_WRITE_TIMEOUT":                     "server.write.timeout",
+		"SERVER_SHUTDOWN_TIMEOUT":                  "server.shutdown.timeout",
+		"SERVER_METRICS_ENABLED":                   "server.metrics.enabled",
+		"SERVER_RATE_LIMIT_REQUESTS_PER_SECOND":    "server.rate.limit.requests.per.second",
+		"SERVER_RATE_LIMIT_BURST":                  "server.rate.limit.burst",
+		"AUTH_JWT_SECRET":                          "auth.jwt.secret",
+		"AUTH_ENABLE_AUTH":                         "auth.enable.auth",
+		"AUTH_REQUIRED_ROLE":                       "auth.required.role",
+		"AUTHZ_SERVICE_URL":                        "authz.service.url",
+		"AUTHZ_TIMEOUT":                            "authz.timeout",
+		"AUTHZ_TLS_INSECURE_SKIP_VERIFY":           "authz.tls.insecure.skip.verify",
+		"AUTHZ_NAMESPACE_CLAIM":                    "authz.namespace.claim",
+		"LOGGING_MAX_LOG_LIMIT":                    "logging.max.log.limit",
+		"LOGGING_DEFAULT_LOG_LIMIT":                "logging.default.log.limit",
+		"LOGGING_DEFAULT_BUILD_LOG_LIMIT":          "logging.default.build.log.limit",
+		"LOGGING_MAX_LOG_LINES_PER_FILE":           "logging.max.log.lines.per.file",
+		"RCA_SERVICE_URL":                          "alerting.rca.service.url",
+		"AI_RCA_ENABLED":                           "alerting.ai.rca.enabled",
+		"OBSERVABILITY_NAMESPACE":                  "alerting.observability.namespace",
+		"ALERT_STORE_BACKEND":                      "alerting.alert.store.backend",
+		"ALERT_STORE_DSN":                          "alerting.alert.store.dsn",
+		"ALERT_SUPPRESSION_WINDOW":                 "alerting.alert.suppression.window",
+		"FINOPS_AGENT_URL":                         "alerting.finops.agent.url",
+		"FINOPS_AGENT_ENABLED":                     "alerting.finops.agent.enabled",
+		"LOG_LEVEL":                                "loglevel",
+		"PORT":                                     "server.port",           // Common alias
+		"INTERNAL_PORT":                            "server.internal.port",  // Common alias
+		"JWT_SECRET":                               "auth.jwt.secret",       // Common alias
+		"ENABLE_AUTH":                              "auth.enable.auth",      // Common alias
+		"MAX_LOG_LIMIT":                            "logging.max.log.limit", // Common alias
+		"LOGS_ADAPTER_URL":                         "adapters.logs.adapter.url",
+		"LOGS_ADAPTER_TIMEOUT":                     "adapters.logs.adapter.timeout",
+		"TRACING_ADAPTER_URL":                      "adapters.tracing.adapter.url",
+		"TRACING_ADAPTER_TIMEOUT":                  "adapters.tracing.adapter.timeout",
+		"METRICS_ADAPTER_URL":                      "adapters.metrics.adapter.url",
+		"METRICS_ADAPTER_TIMEOUT":                  "adapters.metrics.adapter.timeout",
+		"UID_RESOLVER_OPENCHOREO_API_URL":          "uid_resolver.openchoreo.api.url",
+		"UID_RESOLVER_OAUTH_TOKEN_URL":             "uid_resolver.oauth.token.url",
+		"UID_RESOLVER_OAUTH_CLIENT_ID":             "uid_resolver.oauth.client.id",
+		"UID_RESOLVER_OAUTH_CLIENT_SECRET":         "uid_resolver.oauth.client.secret",
+		"UID_RESOLVER_OAUTH_SCOPE":                 "uid_resolver.oauth.scope",
+		"UID_RESOLVER_TLS_INSECURE_SKIP_VERIFY":    "uid_resolver.tls.insecure.skip.verify",
+		"UID_RESOLVER_TIMEOUT":                     "uid_resolver.timeout",
+		"UID_RESOLVER_MAX_AUTH_RETRY":              "uid_resolver.max.auth.retry",
+		"INDEX_LIFECYCLE_ENABLED":                  "index_lifecycle.enabled",
+		"INDEX_LIFECYCLE_OPENSEARCH_URL":           "index_lifecycle.opensearch.url",
+		"INDEX_LIFECYCLE_OPENSEARCH_USERNAME":      "index_lifecycle.opensearch.username",
+		"INDEX_LIFECYCLE_OPENSEARCH_PASSWORD":      "index_lifecycle.opensearch.password",
+		"INDEX_LIFECYCLE_TLS_INSECURE_SKIP_VERIFY": "index_lifecycle.tls.insecure.skip.verify",
+		"INDEX_LIFECYCLE_TIMEOUT":                  "index_lifecycle.timeout",
+		"INDEX_LIFECYCLE_SYNC_INTERVAL":            "index_lifecycle.sync.interval",
+		"INDEX_LIFECYCLE_BUILD_INDEX_PATTERN":      "index_lifecycle.build.index.pattern",
+		"INDEX_LIFECYCLE_BUILD_WARM_AFTER_DAYS":    "index_lifecycle.build.warm.after.days",
+		"INDEX_LIFECYCLE_BUILD_RETENTION_DAYS":     "index_lifecycle.build.retention.days",
+		"INDEX_LIFECYCLE_RUNTIME_INDEX_PATTERN":    "index_lifecycle.runtime.index.pattern",
+		"INDEX_LIFECYCLE_RUNTIME_WARM_AFTER_DAYS":  "index_lifecycle.runtime.warm.after.days",
+		"INDEX_LIFECYCLE_RUNTIME_RETENTION_DAYS":   "index_lifecycle.runtime.retention.days",
+		"INDEX_LIFECYCLE_GATEWAY_INDEX_PATTERN":    "index_lifecycle.gateway.index.pattern",
+		"INDEX_LIFECYCLE_GATEWAY_WARM_AFTER_DAYS":  "index_lifecycle.gateway.warm.after.days",
+		"INDEX_LIFECYCLE_GATEWAY_RETENTION_DAYS":   "index_lifecycle.gateway.retention.days",
+		"INDEX_LIFECYCLE_AUDIT_INDEX_PATTERN":      "index_lifecycle.audit.index.pattern",
+		"INDEX_LIFECYCLE_AUDIT_WARM_AFTER_DAYS":    "index_lifecycle.audit.warm.after.days",
+		"INDEX_LIFECYCLE_AUDIT_RETENTION_DAYS":     "index_lifecycle.audit.retention.days",
+		"IDLE_DETECTION_ENABLED":                   "idle_detection.enabled",
+		"IDLE_DETECTION_INTERVAL":                  "idle_detection.interval",
+		"IDLE_DETECTION_WINDOW":                    "idle_detection.window",
+		"IDLE_DETECTION_ENVIRONMENT_WINDOWS":       "idle_detection.environment.windows",
+		"IDLE_DETECTION_MAX_CPU_CORES":             "idle_detection.max.cpu.cores",
+		"IDLE_DETECTION_MAX_REQUEST_RATE":          "idle_detection.max.request.rate",
+		"IDLE_DETECTION_CPU_CORE_MONTHLY_COST":     "idle_detection.cpu.core.monthly.cost",
+		"IDLE_DETECTION_MEMORY_GIB_MONTHLY_COST":   "idle_detection.memory.gib.monthly.cost",
+		"IDLE_DETECTION_CURRENCY":                  "idle_detection.currency",
+		"IDLE_DETECTION_SUSPEND_BASE_URL":          "idle_detection.suspend.base.url",
+		"RAW_QUERY_ENABLED":                        "raw_query.enabled",
+		"RAW_QUERY_PROMETHEUS_URL":                 "raw_query.prometheus.url",
+		"RAW_QUERY_PROMETHEUS_NAMESPACE_LABEL":     "raw_query.prometheus.namespace.label",
+		"RAW_QUERY_OPENSEARCH_URL":                 "raw_query.opensearch.url",
+		"RAW_QUERY_OPENSEARCH_USERNAME":            "raw_query.opensearch.username",
+		"RAW_QUERY_OPENSEARCH_PASSWORD":            "raw_query.opensearch.password",
+		"RAW_QUERY_OPENSEARCH_NAMESPACE_FIELD":     "raw_query.opensearch.namespace.field",
+		"RAW_QUERY_TLS_INSECURE_SKIP_VERIFY":       "raw_query.tls.insecure.skip.verify",
+		"RAW_QUERY_TIMEOUT":                        "raw_query.timeout",
+		"RAW_QUERY_MAX_TIME_RANGE":                 "raw_query.max.time.range",
+		"RAW_QUERY_MAX_RESULTS":                    "raw_query.max.results",
+		"RAW_QUERY_RUNTIME_INDEX_PATTERN":          "raw_query.runtime.index.pattern",
+		"RAW_QUERY_BUILD_INDEX_PATTERN":            "raw_query.build.index.pattern",
+		"RAW_QUERY_GATEWAY_INDEX_PATTERN":          "raw_query.gateway.index.pattern",
+		"FEDERATION_ENABLED":                       "federation.enabled",
+		"FEDERATION_LOCAL_PLANE":                   "federation.local.plane",
+		"FEDERATION_PLANES":                        "federation.planes",
+		"FEDERATION_TLS_INSECURE_SKIP_VERIFY":      "federation.tls.insecure.skip.verify",
+		"FEDERATION_TIMEOUT":                       "federation.timeout",
+		"EXPORT_ENABLED":                           "export.enabled",
+		"EXPORT_SYNC_INTERVAL":                     "export.sync.interval",
+		"EXPORT_CONTINUOUS_INTERVAL":               "export.continuous.interval",
+		"EXPORT_INGESTION_DELAY":                   "export.ingestion.delay",
+		"EXPORT_MAX_ENTRIES_PER_RUN":               "export.max.entries.per.run",
+		"EXPORT_SINK_TIMEOUT":                      "export.sink.timeout",
+		"SAVED_QUERIES_ENABLED":                    "saved_queries.enabled",
+		"SAVED_QUERIES_OPENSEARCH_URL":             "saved_queries.opensearch.url",
+		"SAVED_QUERIES_OPENSEARCH_USERNAME":        "saved_queries.opensearch.username",
+		"SAVED_QUERIES_OPENSEARCH_PASSWORD":        "saved_queries.opensearch.password",
+		"SAVED_QUERIES_INDEX":                      "saved_queries.index",
+		"SAVED_QUERIES_TLS_INSECURE_SKIP_VERIFY":   "saved_queries.tls.insecure.skip.verify",
+		"SAVED_QUERIES_TIMEOUT":                    "saved_queries.timeout",
+		"SAVED_QUERIES_MAX_PER_NAMESPACE":          "saved_queries.max.per.namespace",
 	}
 
 	// Check for environment variables and map them to nested structure
@@ -289,6 +765,7 @@ func getDefaults() map[string]interface{} {
 			"read.timeout":     "30s",
 			"write.timeout":    "30s",
 			"shutdown.timeout": "10s",
+			"metrics.enabled":  true,
 		},
 		"auth": map[string]interface{}{
 			"enable.auth":   false,
@@ -299,6 +776,7 @@ func getDefaults() map[string]interface{} {
 			"service.url":              "http://localhost:8080",
 			"timeout":                  "30s",
 			"tls.insecure.skip.verify": false,
+			"namespace.claim":          "",
 		},
 		"logging": map[string]interface{}{
 			"max.log.limit":           10000,
@@ -333,6 +811,71 @@ func getDefaults() map[string]interface{} {
 			"timeout":                  "30s",
 			"max.auth.retry":           2,
 		},
+		"index_lifecycle": map[string]interface{}{
+			"enabled":                  false,
+			"opensearch.url":           "https://opensearch:9200",
+			"tls.insecure.skip.verify": false,
+			"timeout":                  "30s",
+			"sync.interval":            "10m",
+			"build.index.pattern":      "build-logs-*",
+			"build.warm.after.days":    7,
+			"build.retention.days":     30,
+			"runtime.index.pattern":    "container-logs-*",
+			"runtime.warm.after.days":  7,
+			"runtime.retention.days":   30,
+			"gateway.index.pattern":    "gateway-logs-*",
+			"gateway.warm.after.days":  7,
+			"gateway.retention.days":   30,
+			"audit.index.pattern":      "audit-logs-*",
+			"audit.warm.after.days":    30,
+			"audit.retention.days":     365,
+		},
+		"idle_detection": map[string]interface{}{
+			"enabled":                 false,
+			"interval":                "6h",
+			"window":                  "168h",
+			"max.cpu.cores":           0.005,
+			"max.request.rate":        0.001,
+			"cpu.core.monthly.cost":   25.0,
+			"memory.gib.monthly.cost": 3.0,
+			"currency":                "USD",
+		},
+		"raw_query": map[string]interface{}{
+			"enabled":                    false,
+			"prometheus.url":             "http://prometheus:9090",
+			"prometheus.namespace.label": "openchoreo_dev_namespace",
+			"opensearch.url":             "https://opensearch:9200",
+			"opensearch.namespace.field": "kubernetes.labels.openchoreo_dev/namespace.keyword",
+			"tls.insecure.skip.verify":   false,
+			"timeout":                    "30s",
+			"max.time.range":             "24h",
+			"max.results":                1000,
+			"runtime.index.pattern":      "container-logs-*",
+			"build.index.pattern":        "build-logs-*",
+			"gateway.index.pattern":      "gateway-logs-*",
+		},
+		"federation": map[string]interface{}{
+			"enabled":                  false,
+			"local.plane":              "default",
+			"tls.insecure.skip.verify": false,
+			"timeout":                  "30s",
+		},
+		"export": map[string]interface{}{
+			"enabled":             false,
+			"sync.interval":       "30s",
+			"continuous.interval": "1m",
+			"ingestion.delay":     "1m",
+			"max.entries.per.run": 50000,
+			"sink.timeout":        "60s",
+		},
+		"saved_queries": map[string]interface{}{
+			"enabled":                  false,
+			"opensearch.url":           "https://opensearch:9200",
+			"index":                    "openchoreo-saved-queries",
+			"tls.insecure.skip.verify": false,
+			"timeout":                  "30s",
+			"max.per.namespace":        500,
+		},
 		"loglevel": "info",
 	}
 }
@@ -350,6 +893,13 @@ func (c *Config) validate() error {
 		return fmt.Errorf("server internal port must differ from server port: %d", c.Server.Port)
 	}
 
+	if c.Server.RateLimitRequestsPerSecond < 0 {
+		return fmt.Errorf("server rate.limit.requests.per.second must be non-negative")
+	}
+	if c.Server.RateLimitBurst < 0 {
+		return fmt.Errorf("server rate.limit.burst must be non-negative")
+	}
+
 	if c.Logging.MaxLogLimit <= 0 {
 		return fmt.Errorf("max log limit must be positive")
 	}
@@ -406,5 +956,32 @@ func (c *Config) validate() error {
 		return fmt.Errorf("metrics adapter timeout must be positive")
 	}
 
+	if err := c.IndexLifecycle.validate(); err != nil {
+		return err
+	}
+
+	if err := c.IdleDetection.validate(); err != nil {
+		return err
+	}
+	if c.IdleDetection.SuspendBaseURL == "" {
+		c.IdleDetection.SuspendBaseURL = strings.TrimRight(c.UIDResolver.OpenChoreoAPIURL, "/")
+	}
+
+	if err := c.RawQuery.validate(); err != nil {
+		return err
+	}
+
+	if err := c.Federation.validate(); err != nil {
+		return err
+	}
+
+	if err := c.Export.validate(); err != nil {
+		return err
+	}
+
+	if err := c.SavedQueries.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -24,6 +24,8 @@ func TestLoad_WithDefaults(t *testing.T) {
 	assert.Equal(t, "http://logs-adapter:9098", cfg.Adapters.LogsAdapterURL)
 	assert.Equal(t, "http://tracing-adapter:9100", cfg.Adapters.TracingAdapterURL)
 	assert.Equal(t, "http://metrics-adapter:9099", cfg.Adapters.MetricsAdapterURL)
+	assert.True(t, cfg.Server.MetricsEnabled)
+	assert.Zero(t, cfg.Server.RateLimitRequestsPerSecond)
 }
 
 func TestLoad_WithEnvironmentVariables(t *testing.T) {
@@ -31,10 +33,17 @@ func TestLoad_WithEnvironmentVariables(t *testing.T) {
 	t.Setenv("LOG_LEVEL", "debug")
 	t.Setenv("AUTH_ENABLE_AUTH", "true")
 	t.Setenv("LOGGING_MAX_LOG_LIMIT", "5000")
+	t.Setenv("SERVER_METRICS_ENABLED", "false")
+	t.Setenv("SERVER_RATE_LIMIT_REQUESTS_PER_SECOND", "2.5")
+	t.Setenv("SERVER_RATE_LIMIT_BURST", "10")
 
 	cfg, err := Load()
 	require.NoError(t, err, "Failed to load config")
 
+	assert.False(t, cfg.Server.MetricsEnabled)
+	assert.InDelta(t, 2.5, cfg.Server.RateLimitRequestsPerSecond, 0)
+	assert.Equal(t, 10, cfg.Server.RateLimitBurst)
+
 	assert.Equal(t, 8080, cfg.Server.Port)
 	assert.Equal(t, "debug", cfg.LogLevel)
 	assert.True(t, cfg.Auth.EnableAuth)
@@ -151,6 +160,16 @@ func TestValidate(t *testing.T) {
 			mutate:    func(c *Config) { c.Server.Port = 99999 },
 			expectErr: true,
 		},
+		{
+			name:      "negative rate limit",
+			mutate:    func(c *Config) { c.Server.RateLimitRequestsPerSecond = -1 },
+			expectErr: true,
+		},
+		{
+			name:      "negative rate limit burst",
+			mutate:    func(c *Config) { c.Server.RateLimitBurst = -1 },
+			expectErr: true,
+		},
 		{
 			name:      "invalid max log limit",
 			mutate:    func(c *Config) { c.Logging.MaxLogLimit = 0 },
@@ -176,6 +195,68 @@ func TestValidate(t *testing.T) {
 			mutate:    func(c *Config) { c.Adapters.MetricsAdapterTimeout = 0 },
 			expectErr: true,
 		},
+		{
+			name:      "valid index lifecycle",
+			mutate:    func(c *Config) { c.IndexLifecycle = validIndexLifecycle() },
+			expectErr: false,
+		},
+		{
+			name: "index lifecycle missing opensearch URL",
+			mutate: func(c *Config) {
+				c.IndexLifecycle = validIndexLifecycle()
+				c.IndexLifecycle.OpenSearchURL = ""
+			},
+			expectErr: true,
+		},
+		{
+			name: "index lifecycle warm tier after retention",
+			mutate: func(c *Config) {
+				c.IndexLifecycle = validIndexLifecycle()
+				c.IndexLifecycle.RuntimeWarmAfterDays = 30
+			},
+			expectErr: true,
+		},
+		{
+			name: "index lifecycle missing index pattern",
+			mutate: func(c *Config) {
+				c.IndexLifecycle = validIndexLifecycle()
+				c.IndexLifecycle.RuntimeIndexPattern = ""
+			},
+			expectErr: true,
+		},
+		{
+			name: "idle detection with invalid environment window",
+			mutate: func(c *Config) {
+				c.IdleDetection = IdleDetectionConfig{
+					Enabled: true, Interval: time.Hour, Window: 168 * time.Hour, EnvironmentWindows: "development",
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "raw query max results above the query limit",
+			mutate: func(c *Config) {
+				c.RawQuery = RawQueryConfig{
+					Enabled: true, PrometheusURL: "http://prometheus:9090", PrometheusNamespaceLabel: "namespace",
+					Timeout: 30 * time.Second, MaxTimeRange: time.Hour, MaxResults: MaxLimit + 1,
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "raw query without a backend",
+			mutate: func(c *Config) {
+				c.RawQuery = RawQueryConfig{Enabled: true, Timeout: 30 * time.Second, MaxTimeRange: time.Hour, MaxResults: 10}
+			},
+			expectErr: true,
+		},
+		{
+			name: "disabled index lifecycle is not validated",
+			mutate: func(c *Config) {
+				c.IndexLifecycle = IndexLifecycleConfig{Enabled: false}
+			},
+			expectErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -191,3 +272,146 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func validIndexLifecycle() IndexLifecycleConfig {
+	return IndexLifecycleConfig{
+		Enabled:              true,
+		OpenSearchURL:        "https://opensearch:9200/",
+		Timeout:              30 * time.Second,
+		SyncInterval:         10 * time.Minute,
+		RuntimeIndexPattern:  "container-logs-*",
+		RuntimeWarmAfterDays: 7,
+		RuntimeRetentionDays: 30,
+	}
+}
+
+func TestLoad_IndexLifecycle(t *testing.T) {
+	t.Setenv("INDEX_LIFECYCLE_ENABLED", "true")
+	t.Setenv("INDEX_LIFECYCLE_OPENSEARCH_URL", "https://search.example.com/")
+	t.Setenv("INDEX_LIFECYCLE_AUDIT_RETENTION_DAYS", "0")
+	t.Setenv("INDEX_LIFECYCLE_RUNTIME_RETENTION_DAYS", "14")
+
+	cfg, err := Load()
+	require.NoError(t, err, "Failed to load config")
+
+	assert.True(t, cfg.IndexLifecycle.Enabled)
+	assert.Equal(t, "https://search.example.com", cfg.IndexLifecycle.OpenSearchURL)
+	assert.Equal(t, 10*time.Minute, cfg.IndexLifecycle.SyncInterval)
+
+	policies := cfg.IndexLifecycle.Policies()
+	categories := make([]string, 0, len(policies))
+	for _, p := range policies {
+		categories = append(categories, p.Category)
+	}
+	assert.Equal(t, []string{"build", "runtime", "gateway"}, categories)
+	assert.Equal(t, IndexLifecyclePolicy{
+		Category: "runtime", IndexPattern: "container-logs-*", WarmAfterDays: 7, RetentionDays: 14,
+	}, policies[1])
+}
+
+func TestLoad_IdleDetection(t *testing.T) {
+	t.Setenv("IDLE_DETECTION_ENABLED", "true")
+	t.Setenv("IDLE_DETECTION_ENVIRONMENT_WINDOWS", "development=72h, production=336h")
+	t.Setenv("IDLE_DETECTION_MAX_CPU_CORES", "0.02")
+
+	cfg, err := Load()
+	require.NoError(t, err, "Failed to load config")
+
+	assert.True(t, cfg.IdleDetection.Enabled)
+	assert.Equal(t, 6*time.Hour, cfg.IdleDetection.Interval)
+	assert.InDelta(t, 0.02, cfg.IdleDetection.MaxCPUCores, 1e-9)
+	assert.Equal(t, 72*time.Hour, cfg.IdleDetection.WindowFor("development"))
+	assert.Equal(t, 336*time.Hour, cfg.IdleDetection.WindowFor("production"))
+	assert.Equal(t, 168*time.Hour, cfg.IdleDetection.WindowFor("staging"))
+	assert.Equal(t, cfg.UIDResolver.OpenChoreoAPIURL, cfg.IdleDetection.SuspendBaseURL)
+}
+
+func TestLoad_RawQuery(t *testing.T) {
+	t.Setenv("RAW_QUERY_ENABLED", "true")
+	t.Setenv("RAW_QUERY_PROMETHEUS_URL", "http://prometheus.example.com/")
+	t.Setenv("RAW_QUERY_MAX_RESULTS", "200")
+
+	cfg, err := Load()
+	require.NoError(t, err, "Failed to load config")
+
+	assert.True(t, cfg.RawQuery.Enabled)
+	assert.Equal(t, "http://prometheus.example.com", cfg.RawQuery.PrometheusURL)
+	assert.Equal(t, 200, cfg.RawQuery.MaxResults)
+	assert.Equal(t, 24*time.Hour, cfg.RawQuery.MaxTimeRange)
+	assert.Equal(t, "container-logs-*", cfg.RawQuery.IndexPattern("runtime"))
+	assert.Empty(t, cfg.RawQuery.IndexPattern("audit"))
+}
+
+func TestLoad_Federation(t *testing.T) {
+	t.Setenv("FEDERATION_ENABLED", "true")
+	t.Setenv("FEDERATION_LOCAL_PLANE", "eu-west")
+	t.Setenv("FEDERATION_PLANES", "us-east=https://observer.us-east.example.com/, ap-south=http://observer.ap-south:9097")
+
+	cfg, err := Load()
+	require.NoError(t, err, "Failed to load config")
+
+	assert.True(t, cfg.Federation.Enabled)
+	assert.Equal(t, 30*time.Second, cfg.Federation.Timeout)
+	urls, err := cfg.Federation.PlaneURLs()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"us-east":  "https://observer.us-east.example.com",
+		"ap-south": "http://observer.ap-south:9097",
+	}, urls)
+}
+
+func TestLoad_FederationInvalidPlanes(t *testing.T) {
+	for name, planes := range map[string]string{
+		"missing url":    "us-east",
+		"local plane":    "default=http://observer:9097",
+		"duplicate name": "us-east=http://a:9097,us-east=http://b:9097",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("FEDERATION_ENABLED", "true")
+			t.Setenv("FEDERATION_PLANES", planes)
+
+			_, err := Load()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "federation plane")
+		})
+	}
+}
+
+func TestLoad_Export(t *testing.T) {
+	t.Setenv("EXPORT_ENABLED", "true")
+	t.Setenv("EXPORT_MAX_ENTRIES_PER_RUN", "1000")
+
+	cfg, err := Load()
+	require.NoError(t, err, "Failed to load config")
+
+	assert.True(t, cfg.Export.Enabled)
+	assert.Equal(t, 30*time.Second, cfg.Export.SyncInterval)
+	assert.Equal(t, time.Minute, cfg.Export.ContinuousInterval)
+	assert.Equal(t, time.Minute, cfg.Export.IngestionDelay)
+	assert.Equal(t, 1000, cfg.Export.MaxEntriesPerRun)
+	assert.Equal(t, 60*time.Second, cfg.Export.SinkTimeout)
+
+	t.Setenv("EXPORT_CONTINUOUS_INTERVAL", "10s")
+	_, err = Load()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "export continuous interval")
+}
+
+func TestLoad_SavedQueries(t *testing.T) {
+	t.Setenv("SAVED_QUERIES_ENABLED", "true")
+	t.Setenv("SAVED_QUERIES_OPENSEARCH_URL", "https://opensearch.example.com:9200/")
+
+	cfg, err := Load()
+	require.NoError(t, err, "Failed to load config")
+
+	assert.True(t, cfg.SavedQueries.Enabled)
+	assert.Equal(t, "https://opensearch.example.com:9200", cfg.SavedQueries.OpenSearchURL)
+	assert.Equal(t, "openchoreo-saved-queries", cfg.SavedQueries.Index)
+	assert.Equal(t, 30*time.Second, cfg.SavedQueries.Timeout)
+	assert.Equal(t, 500, cfg.SavedQueries.MaxPerNamespace)
+
+	t.Setenv("SAVED_QUERIES_MAX_PER_NAMESPACE", "0")
+	_, err = Load()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "saved queries max per namespace")
+}
@@ -0,0 +1,429 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package export forwards the logs of a namespace to external sinks. Exports are defined by
+// ObservabilityExport resources; the observability plane controller registers them with the
+// observer, which runs them on a schedule or continuously and writes each run as one
+// newline-delimited JSON batch to S3, GCS or an HTTP endpoint.
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// ErrInvalidExport indicates the export definition is malformed. Maps to HTTP 400.
+var ErrInvalidExport = errors.New("invalid export")
+
+const (
+	// defaultInterval is how often a scheduled export runs when the request does not set one.
+	defaultInterval = time.Hour
+	// minInterval is the shortest schedule accepted for scheduled exports.
+	minInterval = 5 * time.Minute
+	// contentTypeNDJSON is the content type of exported batches.
+	contentTypeNDJSON = "application/x-ndjson"
+)
+
+// Batch is the output of one export run.
+type Batch struct {
+	// Object is the name of the batch, relative to the sink prefix.
+	Object          string
+	Body            []byte
+	ContentType     string
+	ContentEncoding string
+	Entries         int
+}
+
+// Sink writes exported batches to an external destination.
+type Sink interface {
+	Write(ctx context.Context, batch *Batch) error
+}
+
+// sinkFactory builds the sink of an export, resolving its credentials from Secrets.
+type sinkFactory func(ctx context.Context, namespace string, spec *types.ExportSink) (Sink, error)
+
+// export is a registered export and its progress.
+type export struct {
+	namespace string
+	name      string
+	spec      types.ExportRequest
+	interval  time.Duration
+	status    types.ExportStatus
+	// running is set while a run is in progress so that runs of one export do not overlap.
+	running bool
+}
+
+// Manager holds the exports registered by the controller and runs those that are due.
+// Exports are kept in memory; the controller re-registers them with their checkpoint, so a
+// restart of the observer resumes each export where it stopped.
+type Manager struct {
+	logs    service.LogsQuerier
+	config  *config.ExportConfig
+	logger  *slog.Logger
+	newSink sinkFactory
+	now     func() time.Time
+
+	mu      sync.Mutex
+	exports map[string]*export
+}
+
+var _ service.LogExporter = (*Manager)(nil)
+
+// NewManager creates a new Manager. Exports run in the background without a caller, so pass
+// the unwrapped LogsQuerier. Sink credentials are read from Secrets with secrets.
+func NewManager(
+	logs service.LogsQuerier,
+	secrets client.Reader,
+	cfg *config.ExportConfig,
+	logger *slog.Logger,
+) *Manager {
+	m := &Manager{
+		logs:    logs,
+		config:  cfg,
+		logger:  logger,
+		now:     time.Now,
+		exports: make(map[string]*export),
+	}
+	m.newSink = func(ctx context.Context, namespace string, spec *types.ExportSink) (Sink, error) {
+		return newSink(ctx, secrets, namespace, spec, cfg.SinkTimeout)
+	}
+	return m
+}
+
+// UpsertExport registers or updates an export and returns its progress. The checkpoint of an
+// existing export is kept unless the request carries a later one.
+func (m *Manager) UpsertExport(
+	_ context.Context,
+	namespace, name string,
+	req *types.ExportRequest,
+) (*types.ExportStatus, error) {
+	spec, interval, since, err := normalize(req)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := namespace + "/" + name
+	e, ok := m.exports[key]
+	if !ok {
+		e = &export{namespace: namespace, name: name}
+		m.exports[key] = e
+		m.logger.Info("Registered log export", "namespace", namespace, "name", name,
+			"mode", spec.Mode, "sink", spec.Sink.Type)
+	}
+	e.spec, e.interval = *spec, interval
+	if since != nil && (e.status.LastExportTime == nil || since.After(*e.status.LastExportTime)) {
+		e.status.LastExportTime = since
+	}
+	status := e.status
+	return &status, nil
+}
+
+// DeleteExport unregisters an export. Deleting an unknown export is not an error.
+func (m *Manager) DeleteExport(_ context.Context, namespace, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := namespace + "/" + name
+	if _, ok := m.exports[key]; ok {
+		delete(m.exports, key)
+		m.logger.Info("Unregistered log export", "namespace", namespace, "name", name)
+	}
+	return nil
+}
+
+// Run runs the due exports immediately and then on every sync interval until ctx is
+// cancelled. A failed run is recorded in the export status and retried when it is next due.
+func (m *Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		m.RunDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue runs every export that is due, one after another.
+func (m *Manager) RunDue(ctx context.Context) {
+	for _, e := range m.claimDue() {
+		if ctx.Err() != nil {
+			return
+		}
+		m.run(ctx, e)
+	}
+}
+
+// claimDue marks the due exports as running and returns snapshots of them.
+func (m *Manager) claimDue() []export {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	var due []export
+	for _, e := range m.exports {
+		if e.running || e.spec.Suspend {
+			continue
+		}
+		if e.status.LastRunTime != nil && now.Sub(*e.status.LastRunTime) < m.runInterval(e) {
+			continue
+		}
+		e.running = true
+		due = append(due, *e)
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].namespace+"/"+due[i].name < due[j].namespace+"/"+due[j].name
+	})
+	return due
+}
+
+func (m *Manager) runInterval(e *export) time.Duration {
+	if e.spec.Mode == types.ExportModeContinuous {
+		return m.config.ContinuousInterval
+	}
+	return e.interval
+}
+
+// run exports the logs written since the checkpoint of e and records the outcome.
+func (m *Manager) run(ctx context.Context, e export) {
+	now := m.now().UTC()
+	end := now.Add(-m.config.IngestionDelay)
+	start := end.Add(-m.runInterval(&e))
+	if e.status.LastExportTime != nil {
+		start = *e.status.LastExportTime
+	}
+
+	exported, checkpoint, err := m.export(ctx, &e, start, end)
+	if err != nil {
+		m.logger.Error("Log export failed", "namespace", e.namespace, "name", e.name, "error", err)
+	} else {
+		m.logger.Debug("Log export completed", "namespace", e.namespace, "name", e.name,
+			"entries", exported, "checkpoint", checkpoint)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.exports[e.namespace+"/"+e.name]
+	if !ok {
+		// Deleted while running.
+		return
+	}
+	current.running = false
+	current.status.LastRunTime = &now
+	if err != nil {
+		current.status.LastError = err.Error()
+		return
+	}
+	current.status.LastError = ""
+	current.status.ExportedEntries += int64(exported)
+	if current.status.LastExportTime == nil || checkpoint.After(*current.status.LastExportTime) {
+		current.status.LastExportTime = &checkpoint
+	}
+}
+
+// export writes the logs in [start, end) to the sink of e and returns the number of entries
+// written and the new checkpoint. When the run is capped by MaxEntriesPerRun the checkpoint
+// is the timestamp of the last entry written, so entries sharing that timestamp may be
+// exported twice.
+func (m *Manager) export(ctx context.Context, e *export, start, end time.Time) (int, time.Time, error) {
+	if !end.After(start) {
+		return 0, start, nil
+	}
+
+	entries, checkpoint, err := m.collect(ctx, e, start, end)
+	if err != nil {
+		return 0, start, err
+	}
+	if len(entries) == 0 {
+		return 0, checkpoint, nil
+	}
+
+	batch, err := encodeBatch(e, entries, start, checkpoint)
+	if err != nil {
+		return 0, start, err
+	}
+	sink, err := m.newSink(ctx, e.namespace, &e.spec.Sink)
+	if err != nil {
+		return 0, start, err
+	}
+	if err := sink.Write(ctx, batch); err != nil {
+		return 0, start, fmt.Errorf("failed to write to %s sink: %w", e.spec.Sink.Type, err)
+	}
+	return len(entries), checkpoint, nil
+}
+
+// collect pages through the logs in [start, end) in ascending order. The next page starts at
+// the timestamp of the last entry; entries at that timestamp already collected are skipped.
+func (m *Manager) collect(ctx context.Context, e *export, start, end time.Time) ([]types.LogEntry, time.Time, error) {
+	var entries []types.LogEntry
+	cursor, seenAtCursor := start, 0
+	for {
+		limit := min(config.MaxLimit, m.config.MaxEntriesPerRun-len(entries)+seenAtCursor)
+		resp, err := m.logs.QueryLogs(ctx, logsQuery(e, cursor, end, limit))
+		if err != nil {
+			return nil, start, fmt.Errorf("failed to query logs: %w", err)
+		}
+
+		added, skip := 0, seenAtCursor
+		pageStart := cursor
+		for _, entry := range resp.Logs {
+			ts, err := time.Parse(time.RFC3339Nano, entry.Timestamp)
+			if err != nil {
+				return nil, start, fmt.Errorf("invalid log timestamp %q: %w", entry.Timestamp, err)
+			}
+			if skip > 0 && ts.Equal(pageStart) {
+				skip--
+				continue
+			}
+			if !ts.Equal(cursor) {
+				cursor, seenAtCursor = ts, 0
+			}
+			entries = append(entries, entry)
+			seenAtCursor++
+			added++
+		}
+
+		switch {
+		case len(resp.Logs) < limit:
+			// The window is exhausted.
+			return entries, end, nil
+		case len(entries) >= m.config.MaxEntriesPerRun:
+			return entries, cursor, nil
+		case added == 0:
+			// More entries share the cursor timestamp than fit in a page; move past it.
+			return entries, cursor.Add(time.Nanosecond), nil
+		}
+	}
+}
+
+func logsQuery(e *export, start, end time.Time, limit int) *types.LogsQueryRequest {
+	filter := e.spec.Filter
+	return &types.LogsQueryRequest{
+		SearchScope: &types.SearchScope{Component: &types.ComponentSearchScope{
+			Namespace:   e.namespace,
+			Project:     filter.Project,
+			Component:   filter.Component,
+			Environment: filter.Environment,
+		}},
+		StartTime:    start.Format(time.RFC3339Nano),
+		EndTime:      end.Format(time.RFC3339Nano),
+		SearchPhrase: filter.SearchPhrase,
+		LogLevels:    filter.LogLevels,
+		Limit:        limit,
+		SortOrder:    "asc",
+	}
+}
+
+// encodeBatch encodes entries as newline-delimited JSON. Batches are named after the export
+// and the window they cover so that objects sort chronologically.
+func encodeBatch(e *export, entries []types.LogEntry, start, end time.Time) (*Batch, error) {
+	var buf bytes.Buffer
+	var w io.Writer = &buf
+	var gz *gzip.Writer
+	if e.spec.Compression == types.ExportCompressionGzip {
+		gz = gzip.NewWriter(&buf)
+		w = gz
+	}
+
+	enc := json.NewEncoder(w)
+	for i := range entries {
+		if err := enc.Encode(&entries[i]); err != nil {
+			return nil, fmt.Errorf("failed to encode log entry: %w", err)
+		}
+	}
+
+	const layout = "20060102T150405.000000000Z"
+	batch := &Batch{
+		Object: path.Join(e.namespace, e.name, start.UTC().Format("2006/01/02"),
+			fmt.Sprintf("%s_%s.ndjson", start.UTC().Format(layout), end.UTC().Format(layout))),
+		ContentType: contentTypeNDJSON,
+		Entries:     len(entries),
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return nil, fmt.Errorf("failed to compress batch: %w", err)
+		}
+		batch.Object += ".gz"
+		batch.ContentEncoding = "gzip"
+	}
+	batch.Body = buf.Bytes()
+	return batch, nil
+}
+
+// normalize validates req and applies the defaults. It returns the schedule of scheduled
+// exports and the checkpoint carried by the request.
+func normalize(req *types.ExportRequest) (*types.ExportRequest, time.Duration, *time.Time, error) {
+	if req == nil {
+		return nil, 0, nil, fmt.Errorf("%w: request must not be nil", ErrInvalidExport)
+	}
+	spec := *req
+
+	switch spec.Mode {
+	case "":
+		spec.Mode = types.ExportModeScheduled
+	case types.ExportModeScheduled, types.ExportModeContinuous:
+	default:
+		return nil, 0, nil, fmt.Errorf("%w: unsupported mode %q", ErrInvalidExport, spec.Mode)
+	}
+
+	interval := defaultInterval
+	if spec.Interval != "" {
+		d, err := time.ParseDuration(spec.Interval)
+		if err != nil {
+			return nil, 0, nil, fmt.Errorf("%w: invalid interval: %w", ErrInvalidExport, err)
+		}
+		if d < minInterval {
+			return nil, 0, nil, fmt.Errorf("%w: interval must be at least %s", ErrInvalidExport, minInterval)
+		}
+		interval = d
+	}
+
+	switch spec.Compression {
+	case "":
+		spec.Compression = types.ExportCompressionGzip
+	case types.ExportCompressionGzip, types.ExportCompressionNone:
+	default:
+		return nil, 0, nil, fmt.Errorf("%w: unsupported compression %q", ErrInvalidExport, spec.Compression)
+	}
+
+	if spec.Filter.Component != "" && spec.Filter.Project == "" {
+		return nil, 0, nil, fmt.Errorf("%w: filter.project is required with filter.component", ErrInvalidExport)
+	}
+	if err := validateSink(&spec.Sink); err != nil {
+		return nil, 0, nil, err
+	}
+
+	var since *time.Time
+	if spec.Since != "" {
+		t, err := time.Parse(time.RFC3339Nano, spec.Since)
+		if err != nil {
+			return nil, 0, nil, fmt.Errorf("%w: invalid since: %w", ErrInvalidExport, err)
+		}
+		t = t.UTC()
+		since = &t
+	}
+	return &spec, interval, since, nil
+}
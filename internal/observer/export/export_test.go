@@ -0,0 +1,254 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+var testNow = time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+type recordingSink struct {
+	batches []*Batch
+	err     error
+}
+
+func (s *recordingSink) Write(_ context.Context, batch *Batch) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.batches = append(s.batches, batch)
+	return nil
+}
+
+func newTestManager(t *testing.T, maxEntries int) (*Manager, *mocks.MockLogsQuerier, *recordingSink) {
+	t.Helper()
+	logs := mocks.NewMockLogsQuerier(t)
+	m := NewManager(logs, nil, &config.ExportConfig{
+		Enabled:            true,
+		SyncInterval:       30 * time.Second,
+		ContinuousInterval: time.Minute,
+		IngestionDelay:     time.Minute,
+		MaxEntriesPerRun:   maxEntries,
+		SinkTimeout:        time.Minute,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	sink := &recordingSink{}
+	m.newSink = func(context.Context, string, *types.ExportSink) (Sink, error) { return sink, nil }
+	m.now = func() time.Time { return testNow }
+	return m, logs, sink
+}
+
+func httpExport(mode string) *types.ExportRequest {
+	return &types.ExportRequest{
+		Filter: types.ExportFilter{Project: "shop", LogLevels: []string{"ERROR"}},
+		Mode:   mode,
+		Sink: types.ExportSink{
+			Type: types.ExportSinkTypeHTTP,
+			HTTP: &types.ExportHTTPSink{URL: "https://logs.example.com/ingest"},
+		},
+	}
+}
+
+func logEntries(timestamps ...string) []types.LogEntry {
+	entries := make([]types.LogEntry, len(timestamps))
+	for i, ts := range timestamps {
+		entries[i] = types.LogEntry{Timestamp: ts, Log: "line " + ts}
+	}
+	return entries
+}
+
+func TestManager_RunDue(t *testing.T) {
+	m, logs, sink := newTestManager(t, 100)
+	_, err := m.UpsertExport(context.Background(), "acme", "audit", httpExport(""))
+	require.NoError(t, err)
+
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		scope := r.SearchScope.Component
+		return scope.Namespace == "acme" && scope.Project == "shop" && r.SortOrder == "asc" &&
+			r.StartTime == "2026-01-02T10:59:00Z" && r.EndTime == "2026-01-02T11:59:00Z" &&
+			assert.ObjectsAreEqual([]string{"ERROR"}, r.LogLevels)
+	})).Return(&types.LogsQueryResponse{
+		Logs: logEntries("2026-01-02T11:00:00Z", "2026-01-02T11:30:00.5Z"),
+	}, nil).Once()
+
+	m.RunDue(context.Background())
+
+	require.Len(t, sink.batches, 1)
+	batch := sink.batches[0]
+	assert.Equal(t, "acme/audit/2026/01/02/20260102T105900.000000000Z_20260102T115900.000000000Z.ndjson.gz", batch.Object)
+	assert.Equal(t, "gzip", batch.ContentEncoding)
+	assert.Equal(t, 2, batch.Entries)
+	gz, err := gzip.NewReader(bytes.NewReader(batch.Body))
+	require.NoError(t, err)
+	body, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{"timestamp":"2026-01-02T11:00:00Z","log":"line 2026-01-02T11:00:00Z"}`, lines[0])
+
+	status, err := m.UpsertExport(context.Background(), "acme", "audit", httpExport(""))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), status.ExportedEntries)
+	assert.Equal(t, testNow.Add(-time.Minute), *status.LastExportTime)
+	assert.Empty(t, status.LastError)
+
+	// Not due again until the interval has passed.
+	m.RunDue(context.Background())
+	assert.Len(t, sink.batches, 1)
+}
+
+func TestManager_RunDuePaginatesAndCaps(t *testing.T) {
+	m, logs, sink := newTestManager(t, 4)
+	_, err := m.UpsertExport(context.Background(), "acme", "stream", &types.ExportRequest{
+		Mode:        types.ExportModeContinuous,
+		Compression: types.ExportCompressionNone,
+		Since:       "2026-01-02T11:50:00Z",
+		Sink:        httpExport("").Sink,
+	})
+	require.NoError(t, err)
+
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.StartTime == "2026-01-02T11:50:00Z" && r.Limit == 4
+	})).Return(&types.LogsQueryResponse{
+		Logs: logEntries("2026-01-02T11:51:00Z", "2026-01-02T11:52:00Z", "2026-01-02T11:53:00Z", "2026-01-02T11:53:00Z"),
+	}, nil).Once()
+
+	m.RunDue(context.Background())
+
+	require.Len(t, sink.batches, 1)
+	assert.Equal(t, 4, sink.batches[0].Entries)
+	assert.Empty(t, sink.batches[0].ContentEncoding)
+	status, err := m.UpsertExport(context.Background(), "acme", "stream", &types.ExportRequest{
+		Mode: types.ExportModeContinuous,
+		Sink: httpExport("").Sink,
+	})
+	require.NoError(t, err)
+	// The run was capped, so the checkpoint is the last exported entry rather than the window end.
+	assert.Equal(t, time.Date(2026, 1, 2, 11, 53, 0, 0, time.UTC), *status.LastExportTime)
+
+	// The next run starts at the checkpoint, so the entries at that timestamp are exported again.
+	m.now = func() time.Time { return testNow.Add(time.Minute) }
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.StartTime == "2026-01-02T11:53:00Z"
+	})).Return(&types.LogsQueryResponse{
+		Logs: logEntries("2026-01-02T11:53:00Z", "2026-01-02T11:54:00Z"),
+	}, nil).Once()
+
+	m.RunDue(context.Background())
+
+	require.Len(t, sink.batches, 2)
+	assert.Equal(t, 2, sink.batches[1].Entries)
+}
+
+func TestManager_RunDueSkipsEntriesSeenOnPreviousPage(t *testing.T) {
+	m, logs, sink := newTestManager(t, 5000)
+	_, err := m.UpsertExport(context.Background(), "acme", "audit", httpExport(""))
+	require.NoError(t, err)
+
+	firstPage := make([]string, config.MaxLimit)
+	for i := range firstPage {
+		firstPage[i] = "2026-01-02T11:00:00Z"
+	}
+	firstPage[0] = "2026-01-02T10:59:30Z"
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.StartTime == "2026-01-02T10:59:00Z"
+	})).Return(&types.LogsQueryResponse{Logs: logEntries(firstPage...)}, nil).Once()
+	// The second page repeats the 999 entries at 11:00:00 that were already collected.
+	secondPage := append(firstPage[1:], "2026-01-02T11:10:00Z")
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.StartTime == "2026-01-02T11:00:00Z" && r.Limit == config.MaxLimit
+	})).Return(&types.LogsQueryResponse{Logs: logEntries(secondPage...)}, nil).Once()
+	// The second page was full, so the window is read until a short page.
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.StartTime == "2026-01-02T11:10:00Z"
+	})).Return(&types.LogsQueryResponse{Logs: logEntries("2026-01-02T11:10:00Z")}, nil).Once()
+
+	m.RunDue(context.Background())
+
+	require.Len(t, sink.batches, 1)
+	assert.Equal(t, config.MaxLimit+1, sink.batches[0].Entries)
+}
+
+func TestManager_RunDueFailure(t *testing.T) {
+	m, logs, sink := newTestManager(t, 100)
+	sink.err = errors.New("connection refused")
+	_, err := m.UpsertExport(context.Background(), "acme", "audit", httpExport(""))
+	require.NoError(t, err)
+	logs.EXPECT().QueryLogs(mock.Anything, mock.Anything).Return(&types.LogsQueryResponse{
+		Logs: logEntries("2026-01-02T11:00:00Z"),
+	}, nil).Once()
+
+	m.RunDue(context.Background())
+
+	status, err := m.UpsertExport(context.Background(), "acme", "audit", httpExport(""))
+	require.NoError(t, err)
+	assert.Contains(t, status.LastError, "connection refused")
+	assert.Nil(t, status.LastExportTime, "checkpoint must not advance when the write fails")
+	assert.Zero(t, status.ExportedEntries)
+}
+
+func TestManager_SuspendAndDelete(t *testing.T) {
+	m, _, sink := newTestManager(t, 100)
+	req := httpExport("")
+	req.Suspend = true
+	_, err := m.UpsertExport(context.Background(), "acme", "audit", req)
+	require.NoError(t, err)
+	_, err = m.UpsertExport(context.Background(), "acme", "old", httpExport(""))
+	require.NoError(t, err)
+	require.NoError(t, m.DeleteExport(context.Background(), "acme", "old"))
+	require.NoError(t, m.DeleteExport(context.Background(), "acme", "unknown"))
+
+	// Neither the suspended nor the deleted export queries logs.
+	m.RunDue(context.Background())
+	assert.Empty(t, sink.batches)
+}
+
+func TestManager_UpsertExportValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*types.ExportRequest)
+		want   string
+	}{
+		{"unknown mode", func(r *types.ExportRequest) { r.Mode = "hourly" }, "unsupported mode"},
+		{"short interval", func(r *types.ExportRequest) { r.Interval = "1m" }, "interval must be at least"},
+		{"component without project", func(r *types.ExportRequest) {
+			r.Filter = types.ExportFilter{Component: "api"}
+		}, "filter.project is required"},
+		{"unknown sink", func(r *types.ExportRequest) { r.Sink.Type = "ftp" }, "unsupported sink type"},
+		{"s3 without credentials", func(r *types.ExportRequest) {
+			r.Sink = types.ExportSink{Type: types.ExportSinkTypeS3, S3: &types.ExportS3Sink{Bucket: "logs", Region: "us-east-1"}}
+		}, "sink.s3.accessKeyId"},
+		{"http header without value", func(r *types.ExportRequest) {
+			r.Sink.HTTP.Headers = map[string]types.ExportHeaderValue{"Authorization": {}}
+		}, "exactly one of value or secretRef"},
+		{"invalid since", func(r *types.ExportRequest) { r.Since = "yesterday" }, "invalid since"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _, _ := newTestManager(t, 100)
+			req := httpExport("")
+			tt.mutate(req)
+
+			_, err := m.UpsertExport(context.Background(), "acme", "audit", req)
+			require.ErrorIs(t, err, ErrInvalidExport)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	// gcsBaseURL is the Cloud Storage JSON API endpoint.
+	gcsBaseURL = "https://storage.googleapis.com"
+	// gcsTokenURL is the Google OAuth 2.0 token endpoint used when the key does not name one.
+	gcsTokenURL = "https://oauth2.googleapis.com/token"
+	// gcsScope allows objects to be created in the bucket.
+	gcsScope = "https://www.googleapis.com/auth/devstorage.read_write"
+)
+
+// serviceAccountKey holds the fields of a Google service account JSON key used to obtain
+// access tokens.
+type serviceAccountKey struct {
+	Type         string `json:"type"`
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyID string `json:"private_key_id"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// gcsSink writes each batch as an object to a Cloud Storage bucket with a simple media
+// upload, authenticated as the service account of the key.
+type gcsSink struct {
+	bucket  string
+	prefix  string
+	baseURL string
+	client  *http.Client
+}
+
+func newGCSSink(ctx context.Context, spec *types.ExportGCSSink, credentials []byte, httpClient *http.Client) (*gcsSink, error) {
+	var key serviceAccountKey
+	if err := json.Unmarshal(credentials, &key); err != nil {
+		return nil, fmt.Errorf("invalid service account key: %w", err)
+	}
+	if key.Type != "service_account" || key.ClientEmail == "" || key.PrivateKey == "" {
+		return nil, fmt.Errorf("invalid service account key: a service_account key with client_email and private_key is required")
+	}
+	tokenURL := key.TokenURI
+	if tokenURL == "" {
+		tokenURL = gcsTokenURL
+	}
+
+	cfg := &jwt.Config{
+		Email:        key.ClientEmail,
+		PrivateKey:   []byte(key.PrivateKey),
+		PrivateKeyID: key.PrivateKeyID,
+		Scopes:       []string{gcsScope},
+		TokenURL:     tokenURL,
+	}
+	// The token exchange uses the same client, so it is bounded by the sink timeout.
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	client := cfg.Client(ctx)
+	client.Timeout = httpClient.Timeout
+
+	return &gcsSink{
+		bucket:  spec.Bucket,
+		prefix:  strings.Trim(spec.Prefix, "/"),
+		baseURL: gcsBaseURL,
+		client:  client,
+	}, nil
+}
+
+func (s *gcsSink) Write(ctx context.Context, batch *Batch) error {
+	query := url.Values{"uploadType": {"media"}, "name": {path.Join(s.prefix, batch.Object)}}
+	uploadURL := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?%s", s.baseURL, url.PathEscape(s.bucket), query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, bytes.NewReader(batch.Body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", batch.ContentType)
+	if batch.ContentEncoding != "" {
+		req.Header.Set("Content-Encoding", batch.ContentEncoding)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// maxErrorBodyBytes caps how much of a failed sink response is included in the error.
+const maxErrorBodyBytes = 512
+
+// validateSink checks that the block matching the sink type is set and complete.
+func validateSink(sink *types.ExportSink) error {
+	switch sink.Type {
+	case types.ExportSinkTypeS3:
+		s3 := sink.S3
+		if s3 == nil || s3.Bucket == "" || s3.Region == "" {
+			return fmt.Errorf("%w: sink.s3.bucket and sink.s3.region are required", ErrInvalidExport)
+		}
+		if err := validateSecretRef("sink.s3.accessKeyId", &s3.AccessKeyID); err != nil {
+			return err
+		}
+		if err := validateSecretRef("sink.s3.secretAccessKey", &s3.SecretAccessKey); err != nil {
+			return err
+		}
+		if s3.Endpoint != "" {
+			if err := validateURL("sink.s3.endpoint", s3.Endpoint); err != nil {
+				return err
+			}
+		}
+	case types.ExportSinkTypeGCS:
+		if sink.GCS == nil || sink.GCS.Bucket == "" {
+			return fmt.Errorf("%w: sink.gcs.bucket is required", ErrInvalidExport)
+		}
+		return validateSecretRef("sink.gcs.credentials", &sink.GCS.Credentials)
+	case types.ExportSinkTypeHTTP:
+		if sink.HTTP == nil {
+			return fmt.Errorf("%w: sink.http is required", ErrInvalidExport)
+		}
+		if err := validateURL("sink.http.url", sink.HTTP.URL); err != nil {
+			return err
+		}
+		for name, header := range sink.HTTP.Headers {
+			if (header.Value == "") == (header.SecretRef == nil) {
+				return fmt.Errorf("%w: exactly one of value or secretRef must be set for header %q", ErrInvalidExport, name)
+			}
+			if header.SecretRef != nil {
+				if err := validateSecretRef("sink.http.headers."+name, header.SecretRef); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("%w: unsupported sink type %q", ErrInvalidExport, sink.Type)
+	}
+	return nil
+}
+
+func validateSecretRef(field string, ref *types.ExportSecretRef) error {
+	if ref.Name == "" || ref.Key == "" {
+		return fmt.Errorf("%w: %s must reference a secret name and key", ErrInvalidExport, field)
+	}
+	return nil
+}
+
+func validateURL(field, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %s must be an absolute http or https URL", ErrInvalidExport, field)
+	}
+	return nil
+}
+
+// newSink builds the sink described by spec. Credentials are read from Secrets in the
+// namespace of the export on every run, so rotated credentials are picked up.
+func newSink(
+	ctx context.Context,
+	secrets client.Reader,
+	namespace string,
+	spec *types.ExportSink,
+	timeout time.Duration,
+) (Sink, error) {
+	httpClient := &http.Client{Timeout: timeout}
+	switch spec.Type {
+	case types.ExportSinkTypeS3:
+		accessKeyID, err := secretValue(ctx, secrets, namespace, &spec.S3.AccessKeyID)
+		if err != nil {
+			return nil, err
+		}
+		secretAccessKey, err := secretValue(ctx, secrets, namespace, &spec.S3.SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+		return newS3Sink(spec.S3, accessKeyID, secretAccessKey, httpClient), nil
+	case types.ExportSinkTypeGCS:
+		credentials, err := secretValue(ctx, secrets, namespace, &spec.GCS.Credentials)
+		if err != nil {
+			return nil, err
+		}
+		return newGCSSink(ctx, spec.GCS, []byte(credentials), httpClient)
+	case types.ExportSinkTypeHTTP:
+		headers := make(map[string]string, len(spec.HTTP.Headers))
+		for name, header := range spec.HTTP.Headers {
+			value := header.Value
+			if header.SecretRef != nil {
+				v, err := secretValue(ctx, secrets, namespace, header.SecretRef)
+				if err != nil {
+					return nil, err
+				}
+				value = v
+			}
+			headers[name] = value
+		}
+		return &httpSink{url: spec.HTTP.URL, headers: headers, client: httpClient}, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported sink type %q", ErrInvalidExport, spec.Type)
+	}
+}
+
+// secretValue reads one key of a Secret in namespace.
+func secretValue(ctx context.Context, secrets client.Reader, namespace string, ref *types.ExportSecretRef) (string, error) {
+	if secrets == nil {
+		return "", fmt.Errorf("kubernetes client not configured")
+	}
+	secret := &corev1.Secret{}
+	if err := secrets.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s/%s: %w", namespace, ref.Name, err)
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s/%s", ref.Key, namespace, ref.Name)
+	}
+	// Trim whitespace/newlines that may have been accidentally included in the secret
+	return strings.TrimSpace(string(value)), nil
+}
+
+// checkResponse returns an error for non-2xx responses, including the start of the body.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	return fmt.Errorf("sink returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// httpSink posts each batch to an HTTP endpoint.
+type httpSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (s *httpSink) Write(ctx context.Context, batch *Batch) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(batch.Body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", batch.ContentType)
+	if batch.ContentEncoding != "" {
+		req.Header.Set("Content-Encoding", batch.ContentEncoding)
+	}
+	req.Header.Set("X-OpenChoreo-Export-Batch", batch.Object)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
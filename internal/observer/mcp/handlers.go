@@ -5,6 +5,7 @@ package mcp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 
@@ -20,6 +21,8 @@ type MCPHandler struct {
 	metricsService       service.MetricsQuerier
 	alertIncidentService service.AlertIncidentService
 	tracesService        service.TracesQuerier
+	savedQueryService    service.SavedQueryService
+	correlationService   service.CorrelationQuerier
 	logger               *slog.Logger
 }
 
@@ -30,6 +33,8 @@ func NewMCPHandler(
 	metricsService service.MetricsQuerier,
 	alertIncidentService service.AlertIncidentService,
 	tracesService service.TracesQuerier,
+	savedQueryService service.SavedQueryService,
+	correlationService service.CorrelationQuerier,
 	logger *slog.Logger,
 ) (*MCPHandler, error) {
 	if healthService == nil {
@@ -60,6 +65,8 @@ func NewMCPHandler(
 		metricsService:       metricsService,
 		alertIncidentService: alertIncidentService,
 		tracesService:        tracesService,
+		savedQueryService:    savedQueryService,
+		correlationService:   correlationService,
 		logger:               logger,
 	}, nil
 }
@@ -288,3 +295,68 @@ func (h *MCPHandler) QueryIncidents(ctx context.Context, namespace, project, com
 	}
 	return h.alertIncidentService.QueryIncidents(ctx, req)
 }
+
+// CorrelateSignals pivots from a trace or a log entry timestamp to the spans, surrounding
+// logs and metric snapshots of the same component.
+func (h *MCPHandler) CorrelateSignals(ctx context.Context, namespace, project, component, environment,
+	traceID, timestamp, podName, window string, logLimit int) (any, error) {
+	req := &types.CorrelationQueryRequest{
+		SearchScope: types.ComponentSearchScope{
+			Namespace:   namespace,
+			Project:     project,
+			Component:   component,
+			Environment: environment,
+		},
+		TraceID:   traceID,
+		Timestamp: timestamp,
+		PodName:   podName,
+		Window:    window,
+		LogLimit:  logLimit,
+	}
+	return h.correlationService.QueryCorrelations(ctx, req)
+}
+
+func (h *MCPHandler) ListSavedQueries(ctx context.Context, namespace string) (any, error) {
+	return h.savedQueryService.ListSavedQueries(ctx, namespace)
+}
+
+// RunSavedQuery renders a saved query with the given parameters and runs it against the logs
+// or traces service, depending on its kind.
+func (h *MCPHandler) RunSavedQuery(ctx context.Context, namespace, name string, params map[string]string) (any, error) {
+	query, err := h.savedQueryService.GetSavedQuery(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	body, err := service.RenderSavedQuery(query, params)
+	if err != nil {
+		return nil, err
+	}
+
+	switch query.Kind {
+	case types.SavedQueryKindLogs:
+		var req types.LogsQueryRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			return nil, fmt.Errorf("invalid logs query: %w", err)
+		}
+		if req.SearchScope == nil {
+			return nil, fmt.Errorf("invalid logs query: searchScope is required")
+		}
+		req.Limit, req.SortOrder, req.LogLevels = setDefaults(req.Limit, req.SortOrder, req.LogLevels)
+		return h.logsService.QueryLogs(ctx, &req)
+	case types.SavedQueryKindTraces:
+		var req struct {
+			SearchScope types.ComponentSearchScope `json:"searchScope"`
+			StartTime   string                     `json:"startTime"`
+			EndTime     string                     `json:"endTime"`
+			Limit       int                        `json:"limit"`
+			SortOrder   string                     `json:"sortOrder"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			return nil, fmt.Errorf("invalid traces query: %w", err)
+		}
+		return h.QueryTraces(ctx, req.SearchScope.Namespace, req.SearchScope.Project, req.SearchScope.Component,
+			req.SearchScope.Environment, req.StartTime, req.EndTime, req.Limit, req.SortOrder)
+	default:
+		return nil, fmt.Errorf("saved query %s/%s has unknown kind %q", namespace, name, query.Kind)
+	}
+}
@@ -154,6 +154,30 @@ func TestQueryHTTPMetrics(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCorrelateSignals(t *testing.T) {
+	ctx := context.Background()
+
+	correlationSvc := mocks.NewMockCorrelationQuerier(t)
+	correlationSvc.EXPECT().
+		QueryCorrelations(mock.Anything, &types.CorrelationQueryRequest{
+			SearchScope: types.ComponentSearchScope{
+				Namespace:   testNamespace,
+				Project:     testProject,
+				Component:   testComponent,
+				Environment: testEnvironment,
+			},
+			Timestamp: testStartTime,
+			Window:    "2m",
+			LogLimit:  50,
+		}).
+		Return(&types.CorrelationQueryResponse{}, nil)
+
+	h := newTestMCPHandler(t, withCorrelationQuerier(correlationSvc))
+	_, err := h.CorrelateSignals(ctx, testNamespace, testProject, testComponent, testEnvironment,
+		"", testStartTime, "", "2m", 50)
+	require.NoError(t, err)
+}
+
 func TestQueryTraces(t *testing.T) {
 	ctx := context.Background()
 
@@ -385,3 +409,51 @@ func TestQueryWorkflowEvents(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestRunSavedQuery(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("renders a traces query and forwards to traces service", func(t *testing.T) {
+		savedQueries := mocks.NewMockSavedQueryService(t)
+		savedQueries.EXPECT().GetSavedQuery(mock.Anything, testNamespace, "slow-checkout").Return(&types.SavedQuery{
+			Namespace: testNamespace,
+			Name:      "slow-checkout",
+			Kind:      types.SavedQueryKindTraces,
+			Query: []byte(`{"searchScope":{"namespace":"test-org","project":"test-project","environment":"{{env}}"},` +
+				`"startTime":"{{from}}","endTime":"{{to}}","limit":20}`),
+			Parameters: []types.SavedQueryParameter{{Name: "env"}, {Name: "from"}, {Name: "to"}},
+		}, nil)
+		tracesSvc := mocks.NewMockTracesQuerier(t)
+		tracesSvc.EXPECT().
+			QueryTraces(mock.Anything, mock.MatchedBy(func(req *types.TracesQueryRequest) bool {
+				return req.SearchScope.Namespace == testNamespace &&
+					req.SearchScope.Project == testProject &&
+					req.SearchScope.Environment == testEnvironment &&
+					req.Limit == 20 &&
+					req.SortOrder == sortOrderDesc
+			})).
+			Return(&types.TracesQueryResponse{}, nil)
+
+		h := newTestMCPHandler(t, withSavedQueryService(savedQueries), withTracesService(tracesSvc))
+		_, err := h.RunSavedQuery(ctx, testNamespace, "slow-checkout", map[string]string{
+			"env": testEnvironment, "from": testStartTime, "to": testEndTime,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("returns render errors", func(t *testing.T) {
+		savedQueries := mocks.NewMockSavedQueryService(t)
+		savedQueries.EXPECT().GetSavedQuery(mock.Anything, testNamespace, "errors").Return(&types.SavedQuery{
+			Namespace:  testNamespace,
+			Name:       "errors",
+			Kind:       types.SavedQueryKindLogs,
+			Query:      []byte(`{"searchScope":{"namespace":"test-org"},"startTime":"{{from}}"}`),
+			Parameters: []types.SavedQueryParameter{{Name: "from", Required: true}},
+		}, nil)
+
+		h := newTestMCPHandler(t, withSavedQueryService(savedQueries))
+		_, err := h.RunSavedQuery(ctx, testNamespace, "errors", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameters: from")
+	})
+}
@@ -6,6 +6,7 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
@@ -402,6 +403,94 @@ func registerTools(s *mcpsdk.Server, handler *MCPHandler) {
 		)
 		return handleToolResult(result, err)
 	})
+
+	// Tool 10: correlate_signals
+	if handler.correlationService != nil {
+		mcpsdk.AddTool(s, &mcpsdk.Tool{
+			Name:        "correlate_signals",
+			Description: "Correlate logs, metrics, and traces of a component in OpenChoreo. Pivot from a trace ID (from query_traces) or from the timestamp of a log entry (from query_component_logs) to return, in one call, the spans of the trace, the logs surrounding the anchor time, and CPU/memory and HTTP metric snapshots around it. Signals that cannot be retrieved are listed as warnings while the others are still returned. Useful for root cause analysis.",
+			InputSchema: createSchema(map[string]any{
+				"namespace":   stringProperty("Organization namespace (required)"),
+				"project":     stringProperty("Project name (required)"),
+				"component":   stringProperty("Component name (required)"),
+				"environment": stringProperty("Environment name (required)"),
+				"trace_id":    stringProperty("Trace ID to pivot from. Either trace_id or timestamp is required"),
+				"timestamp":   stringProperty("Timestamp of a log entry to pivot from, in RFC3339 format (e.g., 2025-11-04T08:29:02.452Z). Either trace_id or timestamp is required"),
+				"pod_name":    stringProperty("Pod name to restrict the surrounding logs to. When pivoting from a trace, defaults to the pod that served it"),
+				"window":      stringProperty("How far before and after the anchor time logs are collected, as a duration (e.g., '30s', '2m'). Default: 30s"),
+				"log_limit":   limitLogsProperty(),
+			}, []string{"namespace", "project", "component", "environment"}),
+		}, func(ctx context.Context, req *mcpsdk.CallToolRequest, args struct {
+			Namespace   string `json:"namespace"`
+			Project     string `json:"project"`
+			Component   string `json:"component"`
+			Environment string `json:"environment"`
+			TraceID     string `json:"trace_id"`
+			Timestamp   string `json:"timestamp"`
+			PodName     string `json:"pod_name"`
+			Window      string `json:"window"`
+			LogLimit    int    `json:"log_limit"`
+		}) (*mcpsdk.CallToolResult, any, error) {
+			if args.Namespace == "" || args.Project == "" || args.Component == "" || args.Environment == "" {
+				return nil, nil, fmt.Errorf("namespace, project, component and environment are required")
+			}
+			if args.TraceID == "" && args.Timestamp == "" {
+				return nil, nil, fmt.Errorf("one of trace_id or timestamp is required")
+			}
+			result, err := handler.CorrelateSignals(ctx,
+				args.Namespace, args.Project, args.Component, args.Environment,
+				args.TraceID, args.Timestamp, args.PodName, args.Window, args.LogLimit,
+			)
+			return handleToolResult(result, err)
+		})
+	}
+
+	// Saved query tools are only available when saved queries are enabled
+	if handler.savedQueryService == nil {
+		return
+	}
+
+	// Tool 11: list_saved_queries
+	mcpsdk.AddTool(s, &mcpsdk.Tool{
+		Name:        "list_saved_queries",
+		Description: "List the saved log and trace queries of a namespace. Saved queries are query templates shared by a team; each lists its kind (logs or traces) and the parameters it accepts. Run one with run_saved_query.",
+		InputSchema: createSchema(map[string]any{
+			"namespace": stringProperty("Organization namespace (required)"),
+		}, []string{"namespace"}),
+	}, func(ctx context.Context, req *mcpsdk.CallToolRequest, args struct {
+		Namespace string `json:"namespace"`
+	}) (*mcpsdk.CallToolResult, any, error) {
+		if args.Namespace == "" {
+			return nil, nil, fmt.Errorf("namespace is required")
+		}
+		result, err := handler.ListSavedQueries(ctx, args.Namespace)
+		return handleToolResult(result, err)
+	})
+
+	// Tool 12: run_saved_query
+	mcpsdk.AddTool(s, &mcpsdk.Tool{
+		Name:        "run_saved_query",
+		Description: "Run a saved log or trace query by name. Parameter values are substituted into the query template; parameters that are not supplied take their default. Returns the logs or traces matched by the query.",
+		InputSchema: createSchema(map[string]any{
+			"namespace": stringProperty("Organization namespace (required)"),
+			"name":      stringProperty("Name of the saved query (required)"),
+			"parameters": map[string]any{
+				"type":                 "object",
+				"description":          "Parameter values by parameter name (e.g., {'environment': 'production', 'startTime': '2025-11-04T08:29:02Z'})",
+				"additionalProperties": map[string]any{"type": "string"},
+			},
+		}, []string{"namespace", "name"}),
+	}, func(ctx context.Context, req *mcpsdk.CallToolRequest, args struct {
+		Namespace  string            `json:"namespace"`
+		Name       string            `json:"name"`
+		Parameters map[string]string `json:"parameters"`
+	}) (*mcpsdk.CallToolResult, any, error) {
+		if args.Namespace == "" || args.Name == "" {
+			return nil, nil, fmt.Errorf("namespace and name are required")
+		}
+		result, err := handler.RunSavedQuery(ctx, args.Namespace, args.Name, args.Parameters)
+		return handleToolResult(result, err)
+	})
 }
 
 // Helper functions for schema creation
@@ -280,6 +280,74 @@ func (m *MockAlertIncidentService) reset() {
 	m.incidentsRequests = nil
 }
 
+type MockSavedQueryService struct {
+	listNamespaces []string
+	query          *types.SavedQuery
+}
+
+func NewMockSavedQueryService() *MockSavedQueryService {
+	return &MockSavedQueryService{
+		query: &types.SavedQuery{
+			Namespace:  testNamespace,
+			Name:       "checkout-errors",
+			Kind:       types.SavedQueryKindLogs,
+			Query:      []byte(`{"searchScope":{"namespace":"test-org","project":"{{project}}"},"startTime":"{{from}}","endTime":"{{to}}","logLevels":["ERROR"]}`),
+			Parameters: []types.SavedQueryParameter{{Name: "project"}, {Name: "from"}, {Name: "to"}},
+		},
+	}
+}
+
+func (m *MockSavedQueryService) CreateSavedQuery(_ context.Context, _ *types.SavedQueryRequest) (*types.SavedQuery, error) {
+	return m.query, nil
+}
+
+func (m *MockSavedQueryService) GetSavedQuery(_ context.Context, _, _ string) (*types.SavedQuery, error) {
+	return m.query, nil
+}
+
+func (m *MockSavedQueryService) ListSavedQueries(_ context.Context, namespace string) (*types.SavedQueryListResponse, error) {
+	m.listNamespaces = append(m.listNamespaces, namespace)
+	return &types.SavedQueryListResponse{Items: []types.SavedQuery{*m.query}, Total: 1}, nil
+}
+
+func (m *MockSavedQueryService) DeleteSavedQuery(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *MockSavedQueryService) reset() { m.listNamespaces = nil }
+
+type MockCorrelationQuerier struct {
+	requests []*types.CorrelationQueryRequest
+	err      error
+}
+
+func NewMockCorrelationQuerier() *MockCorrelationQuerier {
+	return &MockCorrelationQuerier{}
+}
+
+func (m *MockCorrelationQuerier) QueryCorrelations(_ context.Context, req *types.CorrelationQueryRequest) (*types.CorrelationQueryResponse, error) {
+	m.requests = append(m.requests, req)
+	if m.err != nil {
+		return nil, m.err
+	}
+	anchor := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	return &types.CorrelationQueryResponse{
+		AnchorTime: anchor,
+		StartTime:  anchor.Add(-30 * time.Second),
+		EndTime:    anchor.Add(30 * time.Second),
+		Logs:       &types.LogsQueryResponse{Total: 0},
+	}, nil
+}
+
+func (m *MockCorrelationQuerier) lastRequest() *types.CorrelationQueryRequest {
+	if len(m.requests) == 0 {
+		return nil
+	}
+	return m.requests[len(m.requests)-1]
+}
+
+func (m *MockCorrelationQuerier) reset() { m.requests = nil }
+
 // ---- Test harness ----
 
 type testServices struct {
@@ -288,6 +356,8 @@ type testServices struct {
 	metrics         *MockMetricsQuerier
 	traces          *MockTracesQuerier
 	alertsIncidents *MockAlertIncidentService
+	savedQueries    *MockSavedQueryService
+	correlations    *MockCorrelationQuerier
 }
 
 func newTestServices() *testServices {
@@ -297,6 +367,8 @@ func newTestServices() *testServices {
 		metrics:         NewMockMetricsQuerier(),
 		traces:          NewMockTracesQuerier(),
 		alertsIncidents: NewMockAlertIncidentService(),
+		savedQueries:    NewMockSavedQueryService(),
+		correlations:    NewMockCorrelationQuerier(),
 	}
 }
 
@@ -306,6 +378,8 @@ func (s *testServices) resetAll() {
 	s.metrics.reset()
 	s.traces.reset()
 	s.alertsIncidents.reset()
+	s.savedQueries.reset()
+	s.correlations.reset()
 }
 
 func buildMCPHandler(svcs *testServices) (*MCPHandler, error) {
@@ -314,7 +388,7 @@ func buildMCPHandler(svcs *testServices) (*MCPHandler, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewMCPHandler(healthSvc, svcs.logs, svcs.events, svcs.metrics, svcs.alertsIncidents, svcs.traces, logger)
+	return NewMCPHandler(healthSvc, svcs.logs, svcs.events, svcs.metrics, svcs.alertsIncidents, svcs.traces, svcs.savedQueries, svcs.correlations, logger)
 }
 
 func setupTestServer(t *testing.T) (*mcpsdk.ClientSession, *testServices) {
@@ -710,6 +784,81 @@ var allToolSpecs = []toolTestSpec{
 			assert.Equal(t, sortOrderDesc, string(*req.SortOrder))
 		},
 	},
+	{
+		name:                "correlate_signals",
+		descriptionKeywords: []string{"correlate", "logs", "metrics", "traces"},
+		descriptionMinLen:   20,
+		requiredParams:      []string{"namespace", "project", "component", "environment"},
+		optionalParams:      []string{"trace_id", "timestamp", "pod_name", "window", "log_limit"},
+		testArgs: map[string]any{
+			"namespace":   testNamespace,
+			"project":     testProject,
+			"component":   testComponent,
+			"environment": testEnvironment,
+			"trace_id":    testTraceID,
+			"pod_name":    "test-pod",
+			"window":      "1m",
+			"log_limit":   20,
+		},
+		validateCall: func(t *testing.T, svcs *testServices) {
+			t.Helper()
+			req := svcs.correlations.lastRequest()
+			require.NotNil(t, req, "Expected QueryCorrelations to be called")
+			assert.Equal(t, types.ComponentSearchScope{
+				Namespace:   testNamespace,
+				Project:     testProject,
+				Component:   testComponent,
+				Environment: testEnvironment,
+			}, req.SearchScope)
+			assert.Equal(t, testTraceID, req.TraceID)
+			assert.Empty(t, req.Timestamp)
+			assert.Equal(t, "test-pod", req.PodName)
+			assert.Equal(t, "1m", req.Window)
+			assert.Equal(t, 20, req.LogLimit)
+		},
+	},
+	{
+		name:                "list_saved_queries",
+		descriptionKeywords: []string{"saved", "queries"},
+		descriptionMinLen:   20,
+		requiredParams:      []string{"namespace"},
+		optionalParams:      []string{},
+		testArgs: map[string]any{
+			"namespace": testNamespace,
+		},
+		validateCall: func(t *testing.T, svcs *testServices) {
+			t.Helper()
+			require.Equal(t, []string{testNamespace}, svcs.savedQueries.listNamespaces)
+		},
+	},
+	{
+		name:                "run_saved_query",
+		descriptionKeywords: []string{"saved", "query"},
+		descriptionMinLen:   20,
+		requiredParams:      []string{"namespace", "name"},
+		optionalParams:      []string{"parameters"},
+		testArgs: map[string]any{
+			"namespace": testNamespace,
+			"name":      "checkout-errors",
+			"parameters": map[string]any{
+				"project": testProject,
+				"from":    testStartTime,
+				"to":      testEndTime,
+			},
+		},
+		validateCall: func(t *testing.T, svcs *testServices) {
+			t.Helper()
+			req := svcs.logs.lastRequest()
+			require.NotNil(t, req, "Expected QueryLogs to be called")
+			require.NotNil(t, req.SearchScope.Component)
+			assert.Equal(t, testNamespace, req.SearchScope.Component.Namespace)
+			assert.Equal(t, testProject, req.SearchScope.Component.Project)
+			assert.Equal(t, testStartTime, req.StartTime)
+			assert.Equal(t, testEndTime, req.EndTime)
+			assert.Equal(t, []string{"ERROR"}, req.LogLevels)
+			assert.Equal(t, 100, req.Limit)
+		},
+	},
 }
 
 // ---- Tests ----
@@ -745,7 +894,7 @@ func TestNewMCPHandlerValidation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := NewMCPHandler(tt.health, tt.logs, tt.events, tt.metrics, tt.alertIncidentService, tt.traces, tt.log)
+			_, err := NewMCPHandler(tt.health, tt.logs, tt.events, tt.metrics, tt.alertIncidentService, tt.traces, nil, nil, tt.log)
 			require.Error(t, err, "Expected error for %s", tt.name)
 		})
 	}
@@ -1216,6 +1365,29 @@ func TestHandlerErrorPropagation(t *testing.T) {
 			},
 			setupErr: func(s *testServices) {},
 		},
+		{
+			name:     "correlation_service_error",
+			toolName: "correlate_signals",
+			args: map[string]any{
+				"namespace":   testNamespace,
+				"project":     testProject,
+				"component":   testComponent,
+				"environment": testEnvironment,
+				"timestamp":   testStartTime,
+			},
+			setupErr: func(s *testServices) { s.correlations.err = errors.New("prometheus unavailable") },
+		},
+		{
+			name:     "correlation_missing_anchor",
+			toolName: "correlate_signals",
+			args: map[string]any{
+				"namespace":   testNamespace,
+				"project":     testProject,
+				"component":   testComponent,
+				"environment": testEnvironment,
+			},
+			setupErr: func(s *testServices) {},
+		},
 		{
 			name:     "traces_invalid_start_time",
 			toolName: "query_traces",
@@ -15,11 +15,13 @@ import (
 
 // handlerTestDeps holds dependencies for building an MCPHandler in unit tests.
 type handlerTestDeps struct {
-	logs    service.LogsQuerier
-	events  service.EventsQuerier
-	metrics service.MetricsQuerier
-	alerts  service.AlertIncidentService
-	traces  service.TracesQuerier
+	logs         service.LogsQuerier
+	events       service.EventsQuerier
+	metrics      service.MetricsQuerier
+	alerts       service.AlertIncidentService
+	traces       service.TracesQuerier
+	savedQueries service.SavedQueryService
+	correlations service.CorrelationQuerier
 }
 
 // newTestMCPHandler builds an MCPHandler with mockery mocks by default; options override individual deps.
@@ -27,11 +29,13 @@ func newTestMCPHandler(t *testing.T, opts ...func(*handlerTestDeps)) *MCPHandler
 	t.Helper()
 
 	d := handlerTestDeps{
-		logs:    servicemocks.NewMockLogsQuerier(t),
-		events:  servicemocks.NewMockEventsQuerier(t),
-		metrics: servicemocks.NewMockMetricsQuerier(t),
-		alerts:  servicemocks.NewMockAlertIncidentService(t),
-		traces:  servicemocks.NewMockTracesQuerier(t),
+		logs:         servicemocks.NewMockLogsQuerier(t),
+		events:       servicemocks.NewMockEventsQuerier(t),
+		metrics:      servicemocks.NewMockMetricsQuerier(t),
+		alerts:       servicemocks.NewMockAlertIncidentService(t),
+		traces:       servicemocks.NewMockTracesQuerier(t),
+		savedQueries: servicemocks.NewMockSavedQueryService(t),
+		correlations: servicemocks.NewMockCorrelationQuerier(t),
 	}
 	for _, o := range opts {
 		o(&d)
@@ -41,11 +45,19 @@ func newTestMCPHandler(t *testing.T, opts ...func(*handlerTestDeps)) *MCPHandler
 	healthSvc, err := service.NewHealthService(logger)
 	require.NoError(t, err)
 
-	h, err := NewMCPHandler(healthSvc, d.logs, d.events, d.metrics, d.alerts, d.traces, logger)
+	h, err := NewMCPHandler(healthSvc, d.logs, d.events, d.metrics, d.alerts, d.traces, d.savedQueries, d.correlations, logger)
 	require.NoError(t, err)
 	return h
 }
 
+func withSavedQueryService(s service.SavedQueryService) func(*handlerTestDeps) {
+	return func(d *handlerTestDeps) { d.savedQueries = s }
+}
+
+func withCorrelationQuerier(s service.CorrelationQuerier) func(*handlerTestDeps) {
+	return func(d *handlerTestDeps) { d.correlations = s }
+}
+
 func withLogsService(s service.LogsQuerier) func(*handlerTestDeps) {
 	return func(d *handlerTestDeps) { d.logs = s }
 }
@@ -1,121 +0,0 @@
-// Copyright 2025 The OpenChoreo Authors
-// SPDX-License-Identifier: Apache-2.0
-
-package middleware
-
-import (
-	"log/slog"
-	"net/http"
-	"runtime/debug"
-	"time"
-)
-
-// Logger returns a middleware that logs HTTP requests using slog
-func Logger(logger *slog.Logger) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			start := time.Now()
-
-			// Wrap the response writer to capture status code
-			wrappedWriter := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
-
-			// Process request
-			next.ServeHTTP(wrappedWriter, r)
-
-			// Log request details
-			duration := time.Since(start)
-			logger.Debug("HTTP request",
-				"method", r.Method,
-				"path", r.URL.Path,
-				"status", wrappedWriter.statusCode,
-				"duration", duration,
-				"remote_addr", r.RemoteAddr,
-				"user_agent", r.UserAgent(),
-			)
-		})
-	}
-}
-
-// Recovery returns a middleware that recovers from panics
-func Recovery(logger *slog.Logger) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			defer func() {
-				if err := recover(); err != nil {
-					// Log the panic
-					logger.Error("Panic recovered",
-						"error", err,
-						"method", r.Method,
-						"path", r.URL.Path,
-						"stack", string(debug.Stack()),
-					)
-
-					// Return 500 error
-					http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-				}
-			}()
-
-			next.ServeHTTP(w, r)
-		})
-	}
-}
-
-// CORS returns a middleware that handles Cross-Origin Resource Sharing.
-// It sets the appropriate headers for preflight and actual requests.
-// If allowedOrigins is empty, CORS headers are not added.
-func CORS(allowedOrigins []string) func(http.Handler) http.Handler {
-	allowed := make(map[string]bool, len(allowedOrigins))
-	for _, o := range allowedOrigins {
-		allowed[o] = true
-	}
-
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if len(allowed) == 0 {
-				next.ServeHTTP(w, r)
-				return
-			}
-
-			origin := r.Header.Get("Origin")
-			if origin != "" && allowed[origin] {
-				w.Header().Set("Access-Control-Allow-Origin", origin)
-				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-				w.Header().Set("Access-Control-Max-Age", "3600")
-				w.Header().Set("Access-Control-Allow-Credentials", "true")
-				w.Header().Set("Vary", "Origin")
-			}
-
-			// Handle CORS preflight requests only when both Origin and
-			// Access-Control-Request-Method headers are present.
-			if r.Method == http.MethodOptions &&
-				origin != "" && r.Header.Get("Access-Control-Request-Method") != "" {
-				w.WriteHeader(http.StatusNoContent)
-				return
-			}
-
-			next.ServeHTTP(w, r)
-		})
-	}
-}
-
-// Chain applies multiple middleware functions in order
-func Chain(middlewares ...func(http.Handler) http.Handler) func(http.Handler) http.Handler {
-	return func(handler http.Handler) http.Handler {
-		for i := len(middlewares) - 1; i >= 0; i-- {
-			handler = middlewares[i](handler)
-		}
-		return handler
-	}
-}
-
-// responseWriter wraps http.ResponseWriter to capture status code
-type responseWriter struct {
-	http.ResponseWriter
-	statusCode int
-}
-
-func (rw *responseWriter) WriteHeader(code int) {
-	rw.statusCode = code
-	rw.ResponseWriter.WriteHeader(code)
-}
@@ -0,0 +1,151 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rawquery
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TimestampField is the log document field the search time range applies to.
+const TimestampField = "@timestamp"
+
+// defaultSearchSize is the number of hits OpenSearch returns when a search has no size.
+const defaultSearchSize = 10
+
+// allowedSearchKeys are the top-level search body keys a raw search may use.
+var allowedSearchKeys = map[string]bool{
+	"query": true, "aggs": true, "aggregations": true, "sort": true, "size": true, "from": true,
+	"_source": true, "track_total_hits": true,
+}
+
+// forbiddenSearchKeys may not appear anywhere in a raw search body. Scripts and runtime
+// mappings run arbitrary code on the cluster, the global aggregation ignores the query and
+// therefore the namespace filter, and index references let lookups read other indices.
+var forbiddenSearchKeys = map[string]bool{
+	"script": true, "script_score": true, "scripted_metric": true, "runtime_mappings": true,
+	"global": true, "significant_terms": true, "significant_text": true,
+	"index": true, "_index": true,
+}
+
+// SearchScope bounds a raw OpenSearch search.
+type SearchScope struct {
+	// NamespaceField is the keyword field that carries the namespace of a log document
+	NamespaceField string
+	Namespace      string
+	StartTime      time.Time
+	EndTime        time.Time
+	// MaxResults caps from+size of the search
+	MaxResults int
+}
+
+// ScopeSearch validates a raw OpenSearch search body and returns a body whose query only
+// matches documents of the scope's namespace and time range. The user query, if any, is
+// kept as a required clause next to the scope filters.
+func ScopeSearch(body json.RawMessage, scope SearchScope) ([]byte, error) {
+	search := map[string]json.RawMessage{}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &search); err != nil {
+			return nil, fmt.Errorf("%w: search body must be a JSON object: %v", ErrInvalidQuery, err)
+		}
+		if search == nil {
+			search = map[string]json.RawMessage{}
+		}
+	}
+
+	var unknown []string
+	for key := range search {
+		if !allowedSearchKeys[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("%w: unsupported search keys: %s", ErrInvalidQuery, strings.Join(unknown, ", "))
+	}
+
+	var tree any
+	if len(search) > 0 {
+		if err := json.Unmarshal(body, &tree); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
+		}
+		if key, found := findForbiddenKey(tree); found {
+			return nil, fmt.Errorf("%w: %q is not allowed in raw searches", ErrInvalidQuery, key)
+		}
+	}
+
+	from, err := intField(search, "from", 0)
+	if err != nil {
+		return nil, err
+	}
+	size, err := intField(search, "size", defaultSearchSize)
+	if err != nil {
+		return nil, err
+	}
+	if from < 0 || size < 0 {
+		return nil, fmt.Errorf("%w: from and size must be non-negative", ErrInvalidQuery)
+	}
+	if from+size > scope.MaxResults {
+		return nil, fmt.Errorf("%w: from + size must not exceed %d", ErrInvalidQuery, scope.MaxResults)
+	}
+
+	boolQuery := map[string]any{
+		"filter": []any{
+			map[string]any{"term": map[string]any{scope.NamespaceField: scope.Namespace}},
+			map[string]any{"range": map[string]any{TimestampField: map[string]any{
+				"gte": scope.StartTime.UTC().Format(time.RFC3339Nano),
+				"lte": scope.EndTime.UTC().Format(time.RFC3339Nano),
+			}}},
+		},
+	}
+	if userQuery, ok := search["query"]; ok {
+		boolQuery["must"] = []json.RawMessage{userQuery}
+	}
+	scoped, err := json.Marshal(map[string]any{"bool": boolQuery})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build scoped query: %w", err)
+	}
+	search["query"] = scoped
+	search["size"] = json.RawMessage(fmt.Sprint(size))
+
+	return json.Marshal(search)
+}
+
+// findForbiddenKey walks a decoded JSON value and reports the first forbidden object key.
+func findForbiddenKey(v any) (string, bool) {
+	switch t := v.(type) {
+	case map[string]any:
+		for key, child := range t {
+			if forbiddenSearchKeys[key] {
+				return key, true
+			}
+			if key, found := findForbiddenKey(child); found {
+				return key, true
+			}
+		}
+	case []any:
+		for _, child := range t {
+			if key, found := findForbiddenKey(child); found {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
+
+func intField(search map[string]json.RawMessage, key string, def int) (int, error) {
+	raw, ok := search[key]
+	if !ok {
+		return def, nil
+	}
+	var n int
+	if err := json.Unmarshal(raw, &n); err != nil {
+		return 0, fmt.Errorf("%w: %s must be an integer", ErrInvalidQuery, key)
+	}
+	return n, nil
+}
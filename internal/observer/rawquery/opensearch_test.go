@@ -0,0 +1,75 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rawquery
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSearchScope = SearchScope{
+	NamespaceField: "kubernetes.labels.openchoreo_dev/namespace.keyword",
+	Namespace:      "team-a",
+	StartTime:      time.Date(2026, 1, 31, 11, 0, 0, 0, time.UTC),
+	EndTime:        time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC),
+	MaxResults:     100,
+}
+
+func TestScopeSearch(t *testing.T) {
+	body := `{"query":{"match":{"log":"timeout"}},"sort":[{"@timestamp":"desc"}],"size":50,` +
+		`"aggs":{"by_pod":{"terms":{"field":"kubernetes.pod_name"}}}}`
+
+	got, err := ScopeSearch(json.RawMessage(body), testSearchScope)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"query": {"bool": {
+			"filter": [
+				{"term": {"kubernetes.labels.openchoreo_dev/namespace.keyword": "team-a"}},
+				{"range": {"@timestamp": {"gte": "2026-01-31T11:00:00Z", "lte": "2026-01-31T12:00:00Z"}}}
+			],
+			"must": [{"match": {"log": "timeout"}}]
+		}},
+		"sort": [{"@timestamp": "desc"}],
+		"size": 50,
+		"aggs": {"by_pod": {"terms": {"field": "kubernetes.pod_name"}}}
+	}`, string(got))
+}
+
+func TestScopeSearchEmptyBody(t *testing.T) {
+	got, err := ScopeSearch(nil, testSearchScope)
+	require.NoError(t, err)
+
+	var search map[string]any
+	require.NoError(t, json.Unmarshal(got, &search))
+	assert.InDelta(t, defaultSearchSize, search["size"], 0)
+	assert.NotContains(t, search["query"].(map[string]any)["bool"], "must")
+}
+
+func TestScopeSearchErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"not an object", `[]`},
+		{"unsupported top-level key", `{"query":{"match_all":{}},"profile":true}`},
+		{"script query", `{"query":{"bool":{"filter":[{"script":{"script":"true"}}]}}}`},
+		{"global aggregation", `{"aggs":{"all":{"global":{}}}}`},
+		{"terms lookup into another index", `{"query":{"terms":{"user":{"index":"users","id":"1","path":"x"}}}}`},
+		{"size above the limit", `{"size":101}`},
+		{"from and size above the limit", `{"from":60,"size":50}`},
+		{"negative from", `{"from":-1}`},
+		{"non-integer size", `{"size":"all"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ScopeSearch(json.RawMessage(tt.body), testSearchScope)
+			require.ErrorIs(t, err, ErrInvalidQuery)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rawquery scopes user supplied PromQL and OpenSearch queries to a single namespace
+// so that they can be passed through to the backends without exposing other tenants' data.
+package rawquery
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// ErrInvalidQuery is returned when a query cannot be scoped safely.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// EnforceLabel rewrites a PromQL expression so that every vector selector carries the
+// label="value" matcher. A selector that already matches the label on a different value
+// selects nothing, since all matchers of a selector must hold. The query is parsed and
+// printed back, so the result is the canonical form of the expression; queries that do
+// not parse are rejected.
+func EnforceLabel(query, label, value string) (string, error) {
+	expr, err := parsePromQL(query)
+	if err != nil {
+		return "", err
+	}
+
+	matcher := labels.MustNewMatcher(labels.MatchEqual, label, value)
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		if vs, ok := node.(*parser.VectorSelector); ok {
+			vs.LabelMatchers = append(vs.LabelMatchers, matcher)
+		}
+		return nil
+	})
+	return expr.String(), nil
+}
+
+// ValidateRanges checks that no range selector or subquery of a PromQL expression looks
+// back further than max, so that a query cannot scan more samples than the time range
+// limit allows. Offsets shift the evaluated window without widening it and are not limited.
+func ValidateRanges(query string, maxRange time.Duration) error {
+	expr, err := parsePromQL(query)
+	if err != nil {
+		return err
+	}
+
+	var rangeErr error
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		var lookback time.Duration
+		switch n := node.(type) {
+		case *parser.MatrixSelector:
+			lookback = n.Range
+		case *parser.SubqueryExpr:
+			lookback = n.Range
+		default:
+			return nil
+		}
+		if lookback > maxRange {
+			rangeErr = fmt.Errorf("%w: range %s exceeds the maximum of %s",
+				ErrInvalidQuery, model.Duration(lookback), maxRange)
+		}
+		return rangeErr
+	})
+	return rangeErr
+}
+
+// parsePromQL parses a PromQL expression, wrapping syntax errors in ErrInvalidQuery.
+func parsePromQL(query string) (parser.Expr, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("%w: query is required", ErrInvalidQuery)
+	}
+	// Experimental functions and duration expressions stay disabled, as in Prometheus by default
+	expr, err := parser.ParseExpr(query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
+	}
+	return expr, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rawquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnforceLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "bare metric",
+			query: "up",
+			want:  `up{ns="team-a"}`,
+		},
+		{
+			name:  "existing matchers",
+			query: `http_requests_total{code=~"5.."}`,
+			want:  `http_requests_total{code=~"5..",ns="team-a"}`,
+		},
+		{
+			name:  "empty matcher block",
+			query: "up{ }",
+			want:  `up{ns="team-a"}`,
+		},
+		{
+			name:  "selector without metric name",
+			query: `{__name__=~".+"}`,
+			want:  `{__name__=~".+",ns="team-a"}`,
+		},
+		{
+			name:  "metric name regex with other matchers",
+			query: `count({__name__=~"http_.*", job="api"})`,
+			want:  `count({__name__=~"http_.*",job="api",ns="team-a"})`,
+		},
+		{
+			name:  "matcher on the enforced label stays and is combined",
+			query: `up{ns="team-b"}`,
+			want:  `up{ns="team-a",ns="team-b"}`,
+		},
+		{
+			name:  "functions, ranges and aggregation modifiers",
+			query: `sum by (pod, code) (rate(http_requests_total{job="api"}[5m] offset 1h))`,
+			want:  `sum by (pod, code) (rate(http_requests_total{job="api",ns="team-a"}[5m] offset 1h))`,
+		},
+		{
+			name:  "binary operators and vector matching",
+			query: `a / on(pod) group_left(node) b > bool 0.5 and c unless d`,
+			want:  `a{ns="team-a"} / on (pod) group_left (node) b{ns="team-a"} > bool 0.5 and c{ns="team-a"} unless d{ns="team-a"}`,
+		},
+		{
+			name:  "subquery and at modifier",
+			query: `max_over_time(rate(x[1m])[1h:5m] @ end())`,
+			want:  `max_over_time(rate(x{ns="team-a"}[1m])[1h:5m] @ end())`,
+		},
+		{
+			name:  "nested subqueries",
+			query: `max_over_time(avg_over_time(rate(x[1m])[10m:1m])[1h:5m]) / on() group_left() y`,
+			want:  `max_over_time(avg_over_time(rate(x{ns="team-a"}[1m])[10m:1m])[1h:5m]) / on () group_left () y{ns="team-a"}`,
+		},
+		{
+			name:  "strings, braces in strings and comments are not rewritten",
+			query: "label_replace(up, \"dst\", \"}{\", \"src\", \"(.*)\") # up\n+ inf",
+			want:  `label_replace(up{ns="team-a"}, "dst", "}{", "src", "(.*)") + +Inf`,
+		},
+		{
+			name:  "aggregation parameters and keywords are case insensitive",
+			query: `TOPK(5, recording:rule:sum) WITHOUT (instance)`,
+			want:  `topk without (instance) (5, recording:rule:sum{ns="team-a"})`,
+		},
+		{
+			name:  "quoted metric and label names",
+			query: `{"my.metric", "we\"ird}"="x"}`,
+			want:  `{"we\"ird}"="x",__name__="my.metric",ns="team-a"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EnforceLabel(tt.query, "ns", "team-a")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEnforceLabelEscapesValue(t *testing.T) {
+	got, err := EnforceLabel("up", "ns", `team"a}`)
+	require.NoError(t, err)
+	assert.Equal(t, `up{ns="team\"a}"}`, got)
+}
+
+func TestEnforceLabelErrors(t *testing.T) {
+	for _, query := range []string{
+		"", "  ", `up{job="api"`, `up{job="api}`, `rate(up[5m)`, `sum by (pod`, `up +`,
+		`{__name__=~".*"}`, `up{"we"ird"="x"}`,
+	} {
+		_, err := EnforceLabel(query, "ns", "team-a")
+		require.ErrorIs(t, err, ErrInvalidQuery, "query %q", query)
+	}
+}
+
+func TestValidateRanges(t *testing.T) {
+	require.NoError(t, ValidateRanges(`rate(x{path="[30d]"}[5m]) # [30d]`, time.Hour))
+	require.NoError(t, ValidateRanges(`max_over_time(rate(x[1m])[1h:5m] offset 7d)`, time.Hour))
+
+	for _, query := range []string{
+		`rate(x[2h])`, `max_over_time(rate(x[1m])[1h1m:])`, `rate(x[five])`,
+		`max_over_time(avg_over_time(rate(x[1m])[2h:1m])[1h:5m])`, `rate(x[5m`,
+	} {
+		err := ValidateRanges(query, time.Hour)
+		require.ErrorIs(t, err, ErrInvalidQuery, "query %q", query)
+	}
+}
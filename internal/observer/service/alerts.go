@@ -565,6 +565,15 @@ func (s *AlertService) triggerRCAAnalysis(alertID string, alertDetails *legacyty
 			"operator":  alertRule.Spec.Condition.Operator,
 			"threshold": alertRule.Spec.Condition.Threshold,
 		}
+	} else {
+		// Anomalies are raised without an alert rule; describe them from the alert details.
+		ruleInfo["description"] = alertDetails.AlertDescription
+		ruleInfo["severity"] = alertDetails.AlertSeverity
+		ruleInfo["source"] = map[string]interface{}{"type": alertDetails.AlertType}
+		ruleInfo["condition"] = map[string]interface{}{
+			"operator":  "gt",
+			"threshold": alertDetails.AlertThreshold,
+		}
 	}
 
 	rcaPayload := map[string]interface{}{
@@ -0,0 +1,137 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/store/alertentry"
+	legacytypes "github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	// sourceTypeAnomaly is the alert source type of anomalies raised by anomaly detectors.
+	sourceTypeAnomaly = "anomaly"
+	// defaultAnomalySeverity is used when the detector does not set a severity.
+	defaultAnomalySeverity = "warning"
+)
+
+var _ AnomalyEventRaiser = (*AlertService)(nil)
+
+// RaiseAnomalyEvent stores a detected anomaly as an alert and, like a fired alert rule,
+// notifies its channels and optionally creates an incident for the AI RCA agent. The
+// anomalies of a detector are suppressed within the alert suppression window, in which
+// case an empty alert ID is returned.
+func (s *AlertService) RaiseAnomalyEvent(ctx context.Context, event *legacytypes.AnomalyEvent) (string, error) {
+	if s.alertEntryStore == nil {
+		return "", fmt.Errorf("alert entry store is not initialized")
+	}
+
+	details, err := s.buildAnomalyDetails(ctx, event)
+	if err != nil {
+		return "", err
+	}
+
+	if s.config.Alerting.AlertSuppressionWindow > 0 && details.ComponentID != "" {
+		since := time.Now().UTC().Add(-s.config.Alerting.AlertSuppressionWindow)
+		isDuplicate, err := s.alertEntryStore.HasRecentAlert(ctx, event.DetectorName, event.Namespace, details.ComponentID, since)
+		if err != nil {
+			s.logger.Warn("Failed to check anomaly suppression", "error", err, "detector", event.DetectorName)
+		} else if isDuplicate {
+			s.logger.Info("Anomaly suppressed (duplicate within suppression window)",
+				"detector", event.DetectorName, "namespace", event.Namespace, "signal", event.Result.Signal)
+			return "", nil
+		}
+	}
+
+	var notificationChannelsJSON string
+	if len(details.NotificationChannels) > 0 {
+		if b, err := json.Marshal(details.NotificationChannels); err == nil {
+			notificationChannelsJSON = string(b)
+		}
+	}
+	alertID, err := s.alertEntryStore.WriteAlertEntry(ctx, &alertentry.AlertEntry{
+		Timestamp:            details.AlertTimestamp,
+		AlertRuleName:        details.AlertName,
+		AlertRuleCRName:      event.DetectorName,
+		AlertRuleCRNamespace: event.Namespace,
+		AlertValue:           details.AlertValue,
+		NamespaceName:        details.Namespace,
+		ComponentName:        details.Component,
+		EnvironmentName:      details.Environment,
+		ProjectName:          details.Project,
+		ComponentID:          details.ComponentID,
+		EnvironmentID:        details.EnvironmentID,
+		ProjectID:            details.ProjectID,
+		IncidentEnabled:      details.IncidentEnabled,
+		Severity:             details.AlertSeverity,
+		Description:          details.AlertDescription,
+		NotificationChannels: notificationChannelsJSON,
+		SourceType:           sourceTypeAnomaly,
+		SourceMetric:         event.Result.Signal,
+		ConditionOperator:    "gt",
+		ConditionThreshold:   event.Threshold,
+		ConditionWindow:      event.Window.String(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to store anomaly alert entry: %w", err)
+	}
+
+	s.logger.Info("Anomaly raised", "alertID", alertID, "detector", event.DetectorName,
+		"signal", event.Result.Signal, "score", event.Result.Score)
+	s.triggerBackgroundTasks(alertID, details, nil)
+	return alertID, nil
+}
+
+// buildAnomalyDetails converts an anomaly into the alert details used by notifications,
+// incidents and the AI RCA agent. The alert value is the anomaly score and the threshold
+// is the sensitivity threshold.
+func (s *AlertService) buildAnomalyDetails(ctx context.Context, event *legacytypes.AnomalyEvent) (*legacytypes.AlertDetails, error) {
+	severity := event.Actions.Severity
+	if severity == "" {
+		severity = defaultAnomalySeverity
+	}
+	direction := "above"
+	if event.Result.Score < 0 {
+		direction = "below"
+	}
+	details := &legacytypes.AlertDetails{
+		AlertName:      fmt.Sprintf("%s/%s", event.DetectorName, event.Result.Signal),
+		AlertTimestamp: event.Timestamp.UTC().Format(time.RFC3339),
+		AlertSeverity:  severity,
+		AlertDescription: fmt.Sprintf("%s of %s is %.1f standard deviations %s its seasonal baseline (observed %g, baseline %g)",
+			event.Result.Signal, event.Component, math.Abs(event.Result.Score), direction, event.Result.Value, event.Result.Baseline),
+		AlertThreshold:       strconv.FormatFloat(event.Threshold, 'f', -1, 64),
+		AlertValue:           strconv.FormatFloat(event.Result.Score, 'f', 2, 64),
+		AlertType:            sourceTypeAnomaly,
+		Namespace:            event.Namespace,
+		Component:            event.Component,
+		Project:              event.Project,
+		Environment:          event.Environment,
+		NotificationChannels: event.Actions.NotificationChannels,
+		IncidentEnabled:      event.Actions.IncidentEnabled,
+		TriggerAiRca:         event.Actions.IncidentEnabled && event.Actions.TriggerAiRca,
+	}
+
+	// Incidents and alert queries are keyed by UID, so resolve them like the alert rule
+	// labels carry them for rule-based alerts.
+	if s.resolver != nil {
+		var err error
+		if details.ComponentID, err = s.resolver.GetComponentUID(ctx, event.Namespace, event.Project, event.Component); err != nil {
+			return nil, fmt.Errorf("failed to resolve component UID: %w", err)
+		}
+		if details.ProjectID, err = s.resolver.GetProjectUID(ctx, event.Namespace, event.Project); err != nil {
+			return nil, fmt.Errorf("failed to resolve project UID: %w", err)
+		}
+		if details.EnvironmentID, err = s.resolver.GetEnvironmentUID(ctx, event.Namespace, event.Environment); err != nil {
+			return nil, fmt.Errorf("failed to resolve environment UID: %w", err)
+		}
+	}
+	return details, nil
+}
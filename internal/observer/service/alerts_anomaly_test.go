@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/store/alertentry"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func testAnomalyEvent(incidentEnabled, triggerRCA bool) *types.AnomalyEvent {
+	return &types.AnomalyEvent{
+		DetectorName: "api-anomalies",
+		Namespace:    "ns",
+		Project:      "proj",
+		Component:    "api",
+		Environment:  "prod",
+		Timestamp:    time.Now().UTC(),
+		Window:       10 * time.Minute,
+		Threshold:    3,
+		Result: types.AnomalySignalResult{
+			Signal:    "latency",
+			Value:     1.5,
+			Baseline:  0.5,
+			Score:     10,
+			Anomalous: true,
+		},
+		Actions: types.AnomalyActions{IncidentEnabled: incidentEnabled, TriggerAiRca: triggerRCA},
+	}
+}
+
+func TestRaiseAnomalyEvent_FullProcessing(t *testing.T) {
+	f := newWebhookTestFixture(t, time.Hour, testAlertRule("unused", false, false), true)
+
+	alertID, err := f.svc.RaiseAnomalyEvent(context.Background(), testAnomalyEvent(true, true))
+	require.NoError(t, err)
+	assert.NotEmpty(t, alertID)
+
+	entries, total, err := f.alertStore.QueryAlertEntries(context.Background(), alertentry.QueryParams{
+		StartTime: "2000-01-01T00:00:00Z",
+		EndTime:   "2099-01-01T00:00:00Z",
+		Limit:     100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	entry := entries[0]
+	assert.Equal(t, "api-anomalies/latency", entry.AlertRuleName)
+	assert.Equal(t, "api-anomalies", entry.AlertRuleCRName)
+	assert.Equal(t, sourceTypeAnomaly, entry.SourceType)
+	assert.Equal(t, "latency", entry.SourceMetric)
+	assert.Equal(t, defaultAnomalySeverity, entry.Severity)
+	assert.Equal(t, "10.00", entry.AlertValue)
+	assert.Contains(t, entry.Description, "10.0 standard deviations above its seasonal baseline")
+
+	assert.Eventually(t, func() bool { return f.incidentCount(t) == 1 }, 2*time.Second, 50*time.Millisecond,
+		"expected 1 incident entry")
+	assert.Eventually(t, func() bool { return f.rcaCallCount.Load() == 1 }, 2*time.Second, 50*time.Millisecond,
+		"expected 1 RCA call")
+}
+
+func TestRaiseAnomalyEvent_IncidentDisabled(t *testing.T) {
+	f := newWebhookTestFixture(t, time.Hour, testAlertRule("unused", false, false), true)
+
+	_, err := f.svc.RaiseAnomalyEvent(context.Background(), testAnomalyEvent(false, false))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, f.alertCount(t))
+	assert.Never(t, func() bool { return f.incidentCount(t) > 0 }, 300*time.Millisecond, 50*time.Millisecond,
+		"anomaly without incident action should not create an incident")
+	assert.Equal(t, int32(0), f.rcaCallCount.Load())
+}
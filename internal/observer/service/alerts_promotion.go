@@ -0,0 +1,53 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	legacytypes "github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	// alertTypePromotion is the alert type of promotion notifications, so channel templates
+	// can tell them apart from fired alerts.
+	alertTypePromotion = "promotion"
+	// promotionSeverity is the severity of promotion notifications.
+	promotionSeverity = "info"
+)
+
+var _ PromotionNotifier = (*AlertService)(nil)
+
+// NotifyPromotion sends a component promotion to its notification channels through the
+// same channel configuration and templates as alerts. Unlike anomalies, promotions are
+// not stored as alerts and never open incidents.
+func (s *AlertService) NotifyPromotion(ctx context.Context, req *legacytypes.PromotionNotificationRequest) error {
+	details := buildPromotionDetails(req, time.Now())
+	if err := DispatchAlertNotifications(ctx, details, details.NotificationChannels, s.getNotificationChannelConfig, s.logger); err != nil {
+		return fmt.Errorf("failed to send promotion notification: %w", err)
+	}
+	s.logger.Info("Promotion notification sent", "namespace", req.Namespace, "component", req.Component,
+		"environment", req.Environment, "release", req.ToRelease)
+	return nil
+}
+
+// buildPromotionDetails converts a promotion into the alert details rendered by the
+// notification channels. The alert value is the promoted release.
+func buildPromotionDetails(req *legacytypes.PromotionNotificationRequest, now time.Time) *legacytypes.AlertDetails {
+	return &legacytypes.AlertDetails{
+		AlertName:            fmt.Sprintf("%s promoted to %s", req.Component, req.Environment),
+		AlertTimestamp:       now.UTC().Format(time.RFC3339),
+		AlertSeverity:        promotionSeverity,
+		AlertDescription:     fmt.Sprintf("%s was promoted to %s: %s", req.Component, req.Environment, req.Summary),
+		AlertValue:           req.ToRelease,
+		AlertType:            alertTypePromotion,
+		Namespace:            req.Namespace,
+		Component:            req.Component,
+		Project:              req.Project,
+		Environment:          req.Environment,
+		NotificationChannels: req.NotificationChannels,
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func testPromotionRequest() *types.PromotionNotificationRequest {
+	return &types.PromotionNotificationRequest{
+		Namespace:            "ns",
+		Project:              "proj",
+		Component:            "api",
+		Environment:          "prod",
+		FromRelease:          "api-1",
+		ToRelease:            "api-2",
+		Summary:              "image api:v1 -> api:v2, commits abc1234..def5678",
+		NotificationChannels: []string{"releases"},
+	}
+}
+
+func TestBuildPromotionDetails(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	details := buildPromotionDetails(testPromotionRequest(), now)
+
+	assert.Equal(t, "api promoted to prod", details.AlertName)
+	assert.Equal(t, "2026-05-01T10:00:00Z", details.AlertTimestamp)
+	assert.Equal(t, promotionSeverity, details.AlertSeverity)
+	assert.Equal(t, alertTypePromotion, details.AlertType)
+	assert.Equal(t, "api-2", details.AlertValue)
+	assert.Equal(t, "api was promoted to prod: image api:v1 -> api:v2, commits abc1234..def5678", details.AlertDescription)
+	assert.Equal(t, []string{"releases"}, details.NotificationChannels)
+	assert.False(t, details.IncidentEnabled)
+}
+
+func TestNotifyPromotion_ChannelConfigError(t *testing.T) {
+	svc := &AlertService{logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	err := svc.NotifyPromotion(context.Background(), testPromotionRequest())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"releases"`)
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/anomaly"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	// DefaultAnomalySeasonality is the default baseline period.
+	DefaultAnomalySeasonality = 7 * 24 * time.Hour
+	// DefaultAnomalySeasons is the default number of past periods in the baseline.
+	DefaultAnomalySeasons = 4
+	// DefaultAnomalyWindow is the default evaluated time range.
+	DefaultAnomalyWindow = 10 * time.Minute
+	// MaxAnomalySeasons bounds the number of metrics queries per evaluation.
+	MaxAnomalySeasons = 8
+
+	// anomalyMetricsStep is the resolution of the evaluated samples.
+	anomalyMetricsStep = "1m"
+)
+
+// ErrAnomalyInvalidRequest indicates the anomaly detection request is malformed. Maps to HTTP 400.
+var ErrAnomalyInvalidRequest = errors.New("invalid anomaly detection request")
+
+// AnomalyService detects anomalies in the HTTP metrics of a component by comparing the
+// latest window with the same window in past periods.
+type AnomalyService struct {
+	metrics MetricsQuerier
+	events  AnomalyEventRaiser
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+var _ AnomalyDetector = (*AnomalyService)(nil)
+
+// NewAnomalyService creates a new AnomalyService. Anomalies are raised through events
+// when the request sets actions; events may be nil to only report them.
+func NewAnomalyService(metrics MetricsQuerier, events AnomalyEventRaiser, logger *slog.Logger) *AnomalyService {
+	return &AnomalyService{metrics: metrics, events: events, logger: logger, now: time.Now}
+}
+
+// DetectAnomalies evaluates the requested signals against their seasonal baseline.
+func (s *AnomalyService) DetectAnomalies(
+	ctx context.Context,
+	req *types.AnomalyDetectionRequest,
+) (*types.AnomalyDetectionResponse, error) {
+	params, err := parseAnomalyRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	end := s.now().UTC().Truncate(time.Minute)
+	if req.EndTime != "" {
+		end, err = time.Parse(time.RFC3339, req.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid endTime: %w", ErrAnomalyInvalidRequest, err)
+		}
+	}
+
+	current, err := s.signalSamples(ctx, req.SearchScope, end, params.window)
+	if err != nil {
+		return nil, err
+	}
+	baseline := make(map[anomaly.Signal][]float64, len(params.signals))
+	for season := 1; season <= params.seasons; season++ {
+		samples, err := s.signalSamples(ctx, req.SearchScope, end.Add(-time.Duration(season)*params.seasonality), params.window)
+		if err != nil {
+			return nil, err
+		}
+		for signal, values := range samples {
+			baseline[signal] = append(baseline[signal], values...)
+		}
+	}
+
+	resp := &types.AnomalyDetectionResponse{
+		StartTime: end.Add(-params.window),
+		EndTime:   end,
+		Threshold: params.threshold,
+		Signals:   make([]types.AnomalySignalResult, 0, len(params.signals)),
+	}
+	for _, signal := range params.signals {
+		r := anomaly.Evaluate(signal, current[signal], baseline[signal], params.threshold)
+		result := types.AnomalySignalResult{
+			Signal:           string(signal),
+			Value:            r.Value,
+			Baseline:         r.Baseline,
+			StdDev:           r.StdDev,
+			Score:            r.Score,
+			BaselineSamples:  r.BaselineSamples,
+			Anomalous:        r.Anomalous,
+			InsufficientData: r.InsufficientData,
+		}
+		if r.Anomalous && req.Actions != nil && s.events != nil {
+			alertID, err := s.events.RaiseAnomalyEvent(ctx, &types.AnomalyEvent{
+				DetectorName: req.DetectorName,
+				Namespace:    req.SearchScope.Namespace,
+				Project:      req.SearchScope.Project,
+				Component:    req.SearchScope.Component,
+				Environment:  req.SearchScope.Environment,
+				Timestamp:    end,
+				Window:       params.window,
+				Threshold:    params.threshold,
+				Result:       result,
+				Actions:      *req.Actions,
+			})
+			if err != nil {
+				s.logger.Warn("Failed to raise anomaly", "detector", req.DetectorName, "signal", signal, "error", err)
+				resp.Warnings = append(resp.Warnings, fmt.Sprintf("%s anomaly could not be raised", signal))
+			}
+			result.AlertID = alertID
+		}
+		resp.Signals = append(resp.Signals, result)
+	}
+
+	s.logger.Debug("Evaluated anomaly detection",
+		"namespace", req.SearchScope.Namespace,
+		"project", req.SearchScope.Project,
+		"component", req.SearchScope.Component,
+		"environment", req.SearchScope.Environment,
+		"detector", req.DetectorName,
+	)
+	return resp, nil
+}
+
+type anomalyParams struct {
+	signals     []anomaly.Signal
+	threshold   float64
+	seasonality time.Duration
+	seasons     int
+	window      time.Duration
+}
+
+func parseAnomalyRequest(req *types.AnomalyDetectionRequest) (*anomalyParams, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request must not be nil", ErrAnomalyInvalidRequest)
+	}
+	if req.SearchScope.Component == "" {
+		return nil, fmt.Errorf("%w: searchScope.component is required", ErrAnomalyInvalidRequest)
+	}
+	if req.Actions != nil && req.DetectorName == "" {
+		return nil, fmt.Errorf("%w: detectorName is required with actions", ErrAnomalyInvalidRequest)
+	}
+
+	p := &anomalyParams{
+		signals:     anomaly.Signals,
+		seasonality: DefaultAnomalySeasonality,
+		seasons:     DefaultAnomalySeasons,
+		window:      DefaultAnomalyWindow,
+	}
+	if len(req.Signals) > 0 {
+		p.signals = make([]anomaly.Signal, 0, len(req.Signals))
+		for _, name := range req.Signals {
+			signal, err := anomaly.ParseSignal(name)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrAnomalyInvalidRequest, err)
+			}
+			p.signals = append(p.signals, signal)
+		}
+	}
+	var err error
+	if p.threshold, err = anomaly.Threshold(anomaly.Sensitivity(req.Sensitivity)); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAnomalyInvalidRequest, err)
+	}
+	if req.Seasonality != "" {
+		if p.seasonality, err = time.ParseDuration(req.Seasonality); err != nil || p.seasonality <= 0 {
+			return nil, fmt.Errorf("%w: invalid seasonality %q", ErrAnomalyInvalidRequest, req.Seasonality)
+		}
+	}
+	if req.Window != "" {
+		if p.window, err = time.ParseDuration(req.Window); err != nil || p.window < time.Minute {
+			return nil, fmt.Errorf("%w: invalid window %q", ErrAnomalyInvalidRequest, req.Window)
+		}
+	}
+	if p.seasonality < p.window {
+		return nil, fmt.Errorf("%w: seasonality must not be shorter than window", ErrAnomalyInvalidRequest)
+	}
+	if req.Seasons != 0 {
+		if req.Seasons < 1 || req.Seasons > MaxAnomalySeasons {
+			return nil, fmt.Errorf("%w: seasons must be between 1 and %d", ErrAnomalyInvalidRequest, MaxAnomalySeasons)
+		}
+		p.seasons = req.Seasons
+	}
+	return p, nil
+}
+
+// signalSamples returns the per-minute samples of each signal in the window ending at end.
+func (s *AnomalyService) signalSamples(
+	ctx context.Context,
+	scope types.ComponentSearchScope,
+	end time.Time,
+	window time.Duration,
+) (map[anomaly.Signal][]float64, error) {
+	step := anomalyMetricsStep
+	raw, err := s.metrics.QueryMetrics(ctx, &types.MetricsQueryRequest{
+		Metric:      types.MetricTypeHTTP,
+		StartTime:   end.Add(-window).Format(time.RFC3339),
+		EndTime:     end.Format(time.RFC3339),
+		Step:        &step,
+		SearchScope: scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var metrics types.HTTPMetricsQueryResponse
+	if err := decodeMetrics(raw, &metrics); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMetricsRetrieval, err)
+	}
+
+	unsuccessful := make(map[int64]float64, len(metrics.UnsuccessfulRequestCount))
+	for _, item := range metrics.UnsuccessfulRequestCount {
+		unsuccessful[item.Timestamp.UnixNano()] = item.Value
+	}
+	samples := map[anomaly.Signal][]float64{}
+	for _, item := range metrics.RequestCount {
+		if math.IsNaN(item.Value) {
+			continue
+		}
+		samples[anomaly.SignalRequestRate] = append(samples[anomaly.SignalRequestRate], item.Value)
+		if item.Value > 0 {
+			samples[anomaly.SignalErrorRatio] = append(samples[anomaly.SignalErrorRatio],
+				unsuccessful[item.Timestamp.UnixNano()]/item.Value)
+		}
+	}
+	for _, item := range metrics.LatencyP90 {
+		if !math.IsNaN(item.Value) {
+			samples[anomaly.SignalLatency] = append(samples[anomaly.SignalLatency], item.Value)
+		}
+	}
+	return samples, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func newAnomalyRequest() *types.AnomalyDetectionRequest {
+	return &types.AnomalyDetectionRequest{
+		SearchScope: types.ComponentSearchScope{
+			Namespace:   "ns",
+			Project:     "proj",
+			Component:   "api",
+			Environment: "prod",
+		},
+		DetectorName: "api-anomalies",
+		Seasons:      2,
+		EndTime:      "2026-01-15T12:00:00Z",
+	}
+}
+
+// httpMetrics returns HTTP metrics with the given per-minute request rate, a 1% error
+// ratio and a constant latency.
+func httpMetrics(t *testing.T, requests func(i int) float64) json.RawMessage {
+	t.Helper()
+	raw, err := json.Marshal(types.HTTPMetricsQueryResponse{
+		RequestCount:             series(10, requests),
+		UnsuccessfulRequestCount: series(10, func(i int) float64 { return requests(i) / 100 }),
+		LatencyP90:               series(10, func(int) float64 { return 0.5 }),
+	})
+	require.NoError(t, err)
+	return raw
+}
+
+// expectAnomalyMetrics serves a request rate spike in the current window and a steady
+// baseline in the past periods.
+func expectAnomalyMetrics(t *testing.T, metrics *mocks.MockMetricsQuerier) {
+	t.Helper()
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.MatchedBy(func(r *types.MetricsQueryRequest) bool {
+		return r.EndTime == "2026-01-15T12:00:00Z"
+	})).Return(httpMetrics(t, func(int) float64 { return 200 }), nil).Once()
+	baselineWindows := map[string]string{
+		"2026-01-08T12:00:00Z": "2026-01-08T11:50:00Z",
+		"2026-01-01T12:00:00Z": "2026-01-01T11:50:00Z",
+	}
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.MatchedBy(func(r *types.MetricsQueryRequest) bool {
+		return r.Metric == types.MetricTypeHTTP && r.Step != nil && *r.Step == "1m" &&
+			baselineWindows[r.EndTime] == r.StartTime
+	})).Return(httpMetrics(t, func(i int) float64 { return float64(90 + 10*(i%3)) }), nil).Twice()
+}
+
+func TestAnomalyService_DetectAnomalies(t *testing.T) {
+	metrics := mocks.NewMockMetricsQuerier(t)
+	expectAnomalyMetrics(t, metrics)
+
+	resp, err := NewAnomalyService(metrics, nil, testLogger()).DetectAnomalies(context.Background(), newAnomalyRequest())
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2026, 1, 15, 11, 50, 0, 0, time.UTC), resp.StartTime)
+	assert.InDelta(t, 3, resp.Threshold, 1e-9)
+	require.Len(t, resp.Signals, 3)
+
+	rate := resp.Signals[0]
+	assert.Equal(t, "requestRate", rate.Signal)
+	assert.True(t, rate.Anomalous)
+	assert.InDelta(t, 200, rate.Value, 1e-9)
+	assert.Equal(t, 20, rate.BaselineSamples)
+	assert.Empty(t, rate.AlertID)
+
+	assert.Equal(t, "errorRatio", resp.Signals[1].Signal)
+	assert.False(t, resp.Signals[1].Anomalous)
+	assert.Equal(t, "latency", resp.Signals[2].Signal)
+	assert.False(t, resp.Signals[2].Anomalous)
+}
+
+func TestAnomalyService_RaisesAnomalies(t *testing.T) {
+	metrics := mocks.NewMockMetricsQuerier(t)
+	expectAnomalyMetrics(t, metrics)
+	events := mocks.NewMockAnomalyEventRaiser(t)
+	events.EXPECT().RaiseAnomalyEvent(mock.Anything, mock.MatchedBy(func(e *types.AnomalyEvent) bool {
+		return e.DetectorName == "api-anomalies" &&
+			e.Component == "api" &&
+			e.Result.Signal == "requestRate" &&
+			e.Window == 10*time.Minute &&
+			e.Actions.Severity == "critical"
+	})).Return("alert-1", nil).Once()
+
+	req := newAnomalyRequest()
+	req.Actions = &types.AnomalyActions{Severity: "critical"}
+	resp, err := NewAnomalyService(metrics, events, testLogger()).DetectAnomalies(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "alert-1", resp.Signals[0].AlertID)
+	assert.Empty(t, resp.Warnings)
+}
+
+func TestAnomalyService_RaiseFailureIsAWarning(t *testing.T) {
+	metrics := mocks.NewMockMetricsQuerier(t)
+	expectAnomalyMetrics(t, metrics)
+	events := mocks.NewMockAnomalyEventRaiser(t)
+	events.EXPECT().RaiseAnomalyEvent(mock.Anything, mock.Anything).Return("", errors.New("store unavailable"))
+
+	req := newAnomalyRequest()
+	req.Actions = &types.AnomalyActions{}
+	resp, err := NewAnomalyService(metrics, events, testLogger()).DetectAnomalies(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, resp.Signals[0].Anomalous)
+	assert.Equal(t, []string{"requestRate anomaly could not be raised"}, resp.Warnings)
+}
+
+func TestAnomalyService_Errors(t *testing.T) {
+	svc := NewAnomalyService(mocks.NewMockMetricsQuerier(t), nil, testLogger())
+
+	tests := []struct {
+		name   string
+		modify func(*types.AnomalyDetectionRequest)
+	}{
+		{"unknown signal", func(r *types.AnomalyDetectionRequest) { r.Signals = []string{"cpu"} }},
+		{"unknown sensitivity", func(r *types.AnomalyDetectionRequest) { r.Sensitivity = "extreme" }},
+		{"window below a minute", func(r *types.AnomalyDetectionRequest) { r.Window = "30s" }},
+		{"seasonality shorter than window", func(r *types.AnomalyDetectionRequest) {
+			r.Seasonality = "5m"
+			r.Window = "10m"
+		}},
+		{"too many seasons", func(r *types.AnomalyDetectionRequest) { r.Seasons = MaxAnomalySeasons + 1 }},
+		{"actions without detector", func(r *types.AnomalyDetectionRequest) {
+			r.DetectorName = ""
+			r.Actions = &types.AnomalyActions{}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newAnomalyRequest()
+			tt.modify(req)
+			_, err := svc.DetectAnomalies(context.Background(), req)
+			require.ErrorIs(t, err, ErrAnomalyInvalidRequest)
+		})
+	}
+
+	metrics := mocks.NewMockMetricsQuerier(t)
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.Anything).Return(nil, ErrMetricsRetrieval)
+	_, err := NewAnomalyService(metrics, nil, testLogger()).DetectAnomalies(context.Background(), newAnomalyRequest())
+	require.ErrorIs(t, err, ErrMetricsRetrieval)
+}
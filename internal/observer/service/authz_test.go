@@ -373,3 +373,89 @@ func TestMetricsAuthz_QueryRuntimeTopology_Denied(t *testing.T) {
 	_, err := svc.QueryRuntimeTopology(authedCtx(), req)
 	assert.ErrorIs(t, err, observerAuthz.ErrAuthzForbidden)
 }
+
+// --- RawQueryService Authz Tests ---
+
+func TestRawQueryAuthz_QueryPromQL_Allowed(t *testing.T) {
+	inner := mocks.NewMockRawQuerier(t)
+	expected := &types.PromQLQueryResponse{ResultType: "vector"}
+	inner.EXPECT().QueryPromQL(mock.Anything, mock.Anything).Return(expected, nil)
+
+	pdp := coremocks.NewMockPDP(t)
+	pdp.EXPECT().Evaluate(mock.Anything, mock.MatchedBy(func(r *authzcore.EvaluateRequest) bool {
+		return r.Action == string(observerAuthz.ActionExecuteRawQuery) && r.Resource.ID == "ns"
+	})).Return(&authzcore.Decision{Decision: true}, nil).Once()
+
+	svc := NewRawQueryServiceWithAuthz(inner, pdp, testLogger())
+	resp, err := svc.QueryPromQL(authedCtx(), &types.PromQLQueryRequest{Namespace: "ns", Query: "up"})
+	require.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+func TestRawQueryAuthz_SearchOpenSearch_Denied(t *testing.T) {
+	inner := mocks.NewMockRawQuerier(t)
+
+	svc := NewRawQueryServiceWithAuthz(inner, mockPDPDeny(t), testLogger())
+	_, err := svc.SearchOpenSearch(authedCtx(), &types.OpenSearchQueryRequest{Namespace: "ns", LogType: "runtime"})
+	assert.ErrorIs(t, err, observerAuthz.ErrAuthzForbidden)
+}
+
+func TestFederationAuthz_QueryFederatedLogs_Denied(t *testing.T) {
+	inner := mocks.NewMockFederatedQuerier(t)
+
+	svc := NewFederationServiceWithAuthz(inner, mockPDPDeny(t), testLogger())
+
+	_, err := svc.QueryFederatedLogs(authedCtx(), federatedLogsRequest(""))
+	assert.ErrorIs(t, err, observerAuthz.ErrAuthzForbidden)
+}
+
+func TestFederationAuthz_QueryFederatedMetrics_Allowed(t *testing.T) {
+	inner := mocks.NewMockFederatedQuerier(t)
+	expected := &types.FederatedMetricsQueryResponse{}
+	inner.EXPECT().QueryFederatedMetrics(mock.Anything, mock.Anything).Return(expected, nil)
+
+	svc := NewFederationServiceWithAuthz(inner, mockPDPAllow(t), testLogger())
+	req := &types.MetricsQueryRequest{
+		Metric:      types.MetricTypeHTTP,
+		SearchScope: types.ComponentSearchScope{Namespace: "acme", Project: "shop", Component: "api"},
+	}
+
+	resp, err := svc.QueryFederatedMetrics(authedCtx(), req)
+	require.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+func TestSavedQueryAuthz_CreateSavedQuery_Allowed(t *testing.T) {
+	inner := mocks.NewMockSavedQueryService(t)
+	expected := &types.SavedQuery{Namespace: "ns", Name: "errors"}
+	inner.EXPECT().CreateSavedQuery(mock.Anything, mock.Anything).Return(expected, nil)
+
+	pdp := coremocks.NewMockPDP(t)
+	pdp.EXPECT().Evaluate(mock.Anything, mock.MatchedBy(func(r *authzcore.EvaluateRequest) bool {
+		return r.Action == string(observerAuthz.ActionCreateSavedQuery) && r.Resource.ID == "ns"
+	})).Return(&authzcore.Decision{Decision: true}, nil).Once()
+
+	svc := NewSavedQueryServiceWithAuthz(inner, pdp, testLogger())
+	resp, err := svc.CreateSavedQuery(authedCtx(), &types.SavedQueryRequest{Namespace: "ns", Name: "errors"})
+	require.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
+
+func TestSavedQueryAuthz_DeleteSavedQuery_Denied(t *testing.T) {
+	inner := mocks.NewMockSavedQueryService(t)
+
+	svc := NewSavedQueryServiceWithAuthz(inner, mockPDPDeny(t), testLogger())
+	err := svc.DeleteSavedQuery(authedCtx(), "ns", "errors")
+	assert.ErrorIs(t, err, observerAuthz.ErrAuthzForbidden)
+}
+
+func TestSavedQueryAuthz_ListSavedQueries_NilPDP(t *testing.T) {
+	inner := mocks.NewMockSavedQueryService(t)
+	expected := &types.SavedQueryListResponse{Total: 0}
+	inner.EXPECT().ListSavedQueries(mock.Anything, "ns").Return(expected, nil)
+
+	svc := NewSavedQueryServiceWithAuthz(inner, nil, testLogger())
+	resp, err := svc.ListSavedQueries(context.Background(), "ns")
+	require.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
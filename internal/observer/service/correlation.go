@@ -0,0 +1,272 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const (
+	// DefaultCorrelationWindow is how far around the anchor time logs are collected by default.
+	DefaultCorrelationWindow = 30 * time.Second
+	// DefaultCorrelationLogLimit is the default number of surrounding log entries.
+	DefaultCorrelationLogLimit = 100
+
+	// correlationTraceLookback is how far back a trace is searched when no timestamp is given.
+	correlationTraceLookback = 24 * time.Hour
+	// correlationMinMetricsWindow keeps the metric snapshots wide enough to hold a few samples.
+	correlationMinMetricsWindow = 5 * time.Minute
+	// correlationMetricsStep is the resolution of the metric snapshots.
+	correlationMetricsStep = "1m"
+	// podNameAttribute is the OpenTelemetry resource attribute holding the pod name.
+	podNameAttribute = "k8s.pod.name"
+)
+
+// ErrCorrelationInvalidRequest indicates the correlation request is malformed. Maps to HTTP 400.
+var ErrCorrelationInvalidRequest = errors.New("invalid correlation request")
+
+// CorrelationService pivots from a trace or a log entry to the spans, surrounding logs and
+// metric snapshots of the same component, so that callers need not issue each query.
+type CorrelationService struct {
+	logs    LogsQuerier
+	traces  TracesQuerier
+	metrics MetricsQuerier
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+var _ CorrelationQuerier = (*CorrelationService)(nil)
+
+// NewCorrelationService creates a new CorrelationService. Pass authz-wrapped queriers so
+// that callers need view access to each signal of the component.
+func NewCorrelationService(
+	logs LogsQuerier,
+	traces TracesQuerier,
+	metrics MetricsQuerier,
+	logger *slog.Logger,
+) *CorrelationService {
+	return &CorrelationService{logs: logs, traces: traces, metrics: metrics, logger: logger, now: time.Now}
+}
+
+// QueryCorrelations returns the signals linked to the trace or log entry in the request.
+// A signal whose backend fails is reported as a warning; authorization and scope
+// resolution failures fail the whole query.
+func (s *CorrelationService) QueryCorrelations(
+	ctx context.Context,
+	req *types.CorrelationQueryRequest,
+) (*types.CorrelationQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request must not be nil", ErrCorrelationInvalidRequest)
+	}
+	if req.TraceID == "" && req.Timestamp == "" {
+		return nil, fmt.Errorf("%w: one of traceId or timestamp is required", ErrCorrelationInvalidRequest)
+	}
+	window := DefaultCorrelationWindow
+	if req.Window != "" {
+		var err error
+		window, err = time.ParseDuration(req.Window)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("%w: invalid window %q", ErrCorrelationInvalidRequest, req.Window)
+		}
+	}
+	logLimit := req.LogLimit
+	if logLimit <= 0 {
+		logLimit = DefaultCorrelationLogLimit
+	}
+
+	resp := &types.CorrelationQueryResponse{PodName: req.PodName}
+	var anchor time.Time
+	if req.Timestamp != "" {
+		var err error
+		anchor, err = time.Parse(time.RFC3339, req.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid timestamp: %w", ErrCorrelationInvalidRequest, err)
+		}
+	}
+
+	if req.TraceID != "" {
+		spans, err := s.querySpans(ctx, req, anchor, window)
+		switch {
+		case errors.Is(err, ErrTracesRetrieval) && !anchor.IsZero():
+			s.logger.Warn("Failed to retrieve correlated spans", "traceId", req.TraceID, "error", err)
+			resp.Warnings = append(resp.Warnings, "spans could not be retrieved")
+		case err != nil:
+			// Without a timestamp the trace is needed to anchor the other signals.
+			return nil, err
+		default:
+			resp.Spans = spans
+			if anchor.IsZero() {
+				anchor = traceStart(spans)
+			}
+			if resp.PodName == "" {
+				resp.PodName = tracePod(spans)
+			}
+		}
+		if anchor.IsZero() {
+			return nil, fmt.Errorf("%w: trace %q was not found in the last %s; set timestamp to search earlier",
+				ErrCorrelationInvalidRequest, req.TraceID, correlationTraceLookback)
+		}
+	}
+
+	resp.AnchorTime = anchor.UTC()
+	resp.StartTime = resp.AnchorTime.Add(-window)
+	resp.EndTime = resp.AnchorTime.Add(window)
+
+	logs, err := s.queryLogs(ctx, req, resp, logLimit)
+	switch {
+	case errors.Is(err, ErrLogsRetrieval):
+		s.logger.Warn("Failed to retrieve correlated logs", "error", err)
+		resp.Warnings = append(resp.Warnings, "logs could not be retrieved")
+	case err != nil:
+		return nil, err
+	default:
+		resp.Logs = logs
+	}
+
+	metricsWindow := max(window, correlationMinMetricsWindow)
+	metricsReq := func(metric string) *types.MetricsQueryRequest {
+		step := correlationMetricsStep
+		return &types.MetricsQueryRequest{
+			Metric:      metric,
+			StartTime:   resp.AnchorTime.Add(-metricsWindow).Format(time.RFC3339),
+			EndTime:     resp.AnchorTime.Add(metricsWindow).Format(time.RFC3339),
+			Step:        &step,
+			SearchScope: req.SearchScope,
+		}
+	}
+	var resource types.ResourceMetricsQueryResponse
+	if err := s.queryMetrics(ctx, metricsReq(types.MetricTypeResource), &resource); err != nil {
+		if !errors.Is(err, ErrMetricsRetrieval) {
+			return nil, err
+		}
+		s.logger.Warn("Failed to retrieve correlated resource metrics", "error", err)
+		resp.Warnings = append(resp.Warnings, "resource metrics could not be retrieved")
+	} else {
+		resp.Metrics.Resource = &resource
+	}
+	var httpMetrics types.HTTPMetricsQueryResponse
+	if err := s.queryMetrics(ctx, metricsReq(types.MetricTypeHTTP), &httpMetrics); err != nil {
+		if !errors.Is(err, ErrMetricsRetrieval) {
+			return nil, err
+		}
+		s.logger.Warn("Failed to retrieve correlated HTTP metrics", "error", err)
+		resp.Warnings = append(resp.Warnings, "HTTP metrics could not be retrieved")
+	} else {
+		resp.Metrics.HTTP = &httpMetrics
+	}
+
+	return resp, nil
+}
+
+// querySpans returns the spans of the requested trace. Without an anchor time the trace is
+// searched over the last day.
+func (s *CorrelationService) querySpans(
+	ctx context.Context,
+	req *types.CorrelationQueryRequest,
+	anchor time.Time,
+	window time.Duration,
+) (*types.SpansQueryResponse, error) {
+	start, end := anchor.Add(-window), anchor.Add(window)
+	if anchor.IsZero() {
+		end = s.now().UTC()
+		start = end.Add(-correlationTraceLookback)
+	}
+	return s.traces.QuerySpans(ctx, req.TraceID, &types.TracesQueryRequest{
+		StartTime:         start,
+		EndTime:           end,
+		Limit:             config.MaxLimit,
+		SortOrder:         "asc",
+		IncludeAttributes: true,
+		SearchScope:       req.SearchScope,
+	})
+}
+
+// queryLogs returns the component logs within the response window, oldest first. When the
+// logs are restricted to a pod, the maximum number of entries is fetched and filtered so that
+// other pods do not crowd out the requested one.
+func (s *CorrelationService) queryLogs(
+	ctx context.Context,
+	req *types.CorrelationQueryRequest,
+	resp *types.CorrelationQueryResponse,
+	logLimit int,
+) (*types.LogsQueryResponse, error) {
+	scope := req.SearchScope
+	limit := logLimit
+	if resp.PodName != "" {
+		limit = config.MaxLimit
+	}
+	logs, err := s.logs.QueryLogs(ctx, &types.LogsQueryRequest{
+		SearchScope: &types.SearchScope{Component: &scope},
+		StartTime:   resp.StartTime.Format(time.RFC3339),
+		EndTime:     resp.EndTime.Format(time.RFC3339),
+		Limit:       limit,
+		SortOrder:   "asc",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.PodName != "" {
+		kept := make([]types.LogEntry, 0, len(logs.Logs))
+		for _, entry := range logs.Logs {
+			if entry.Metadata != nil && entry.Metadata.PodName == resp.PodName {
+				kept = append(kept, entry)
+			}
+		}
+		logs.Logs = kept
+		logs.Total = len(kept)
+	}
+	if len(logs.Logs) > logLimit {
+		logs.Logs = logs.Logs[:logLimit]
+	}
+	return logs, nil
+}
+
+// queryMetrics runs a metrics query and decodes its result into out.
+func (s *CorrelationService) queryMetrics(ctx context.Context, req *types.MetricsQueryRequest, out any) error {
+	raw, err := s.metrics.QueryMetrics(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := decodeMetrics(raw, out); err != nil {
+		return fmt.Errorf("%w: %w", ErrMetricsRetrieval, err)
+	}
+	return nil
+}
+
+// traceStart returns the earliest span start time, or the zero time when there are no spans.
+func traceStart(spans *types.SpansQueryResponse) time.Time {
+	var start time.Time
+	for _, span := range spans.Spans {
+		if span.StartTime != nil && (start.IsZero() || span.StartTime.Before(start)) {
+			start = *span.StartTime
+		}
+	}
+	return start
+}
+
+// tracePod returns the pod that emitted the root span of the trace, falling back to the
+// first span that names a pod.
+func tracePod(spans *types.SpansQueryResponse) string {
+	pod := ""
+	for _, span := range spans.Spans {
+		name, _ := span.ResourceAttributes[podNameAttribute].(string)
+		if name == "" {
+			continue
+		}
+		if span.ParentSpanID == "" {
+			return name
+		}
+		if pod == "" {
+			pod = name
+		}
+	}
+	return pod
+}
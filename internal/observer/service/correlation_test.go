@@ -0,0 +1,180 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func newCorrelationRequest() *types.CorrelationQueryRequest {
+	return &types.CorrelationQueryRequest{
+		SearchScope: types.ComponentSearchScope{
+			Namespace:   "ns",
+			Project:     "proj",
+			Component:   "api",
+			Environment: "prod",
+		},
+	}
+}
+
+func podLog(timestamp, pod string) types.LogEntry {
+	return types.LogEntry{Timestamp: timestamp, Log: "line", Metadata: &types.LogMetadata{PodName: pod}}
+}
+
+func expectCorrelationMetrics(metrics *mocks.MockMetricsQuerier) {
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.MatchedBy(func(r *types.MetricsQueryRequest) bool {
+		return r.Metric == types.MetricTypeResource
+	})).Return(&types.ResourceMetricsQueryResponse{CPUUsage: series(2, func(int) float64 { return 0.5 })}, nil).Once()
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.MatchedBy(func(r *types.MetricsQueryRequest) bool {
+		return r.Metric == types.MetricTypeHTTP
+	})).Return(&types.HTTPMetricsQueryResponse{}, nil).Once()
+}
+
+func TestCorrelationService_FromTrace(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	rootStart := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	childStart := rootStart.Add(time.Second)
+
+	traces := mocks.NewMockTracesQuerier(t)
+	traces.EXPECT().QuerySpans(mock.Anything, "trace-1", mock.MatchedBy(func(r *types.TracesQueryRequest) bool {
+		return r.StartTime.Equal(now.Add(-24*time.Hour)) && r.EndTime.Equal(now) &&
+			r.IncludeAttributes && r.SearchScope.Component == "api"
+	})).Return(&types.SpansQueryResponse{
+		Spans: []types.SpanInfo{
+			{SpanID: "child", ParentSpanID: "root", StartTime: &childStart,
+				ResourceAttributes: map[string]any{podNameAttribute: "api-2"}},
+			{SpanID: "root", StartTime: &rootStart,
+				ResourceAttributes: map[string]any{podNameAttribute: "api-1"}},
+		},
+		Total: 2,
+	}, nil).Once()
+
+	logs := mocks.NewMockLogsQuerier(t)
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.StartTime == "2026-01-02T09:59:50Z" && r.EndTime == "2026-01-02T10:00:10Z" &&
+			r.Limit == config.MaxLimit && r.SortOrder == "asc" &&
+			r.SearchScope.Component != nil && r.SearchScope.Component.Component == "api"
+	})).Return(&types.LogsQueryResponse{
+		Logs: []types.LogEntry{
+			podLog("2026-01-02T09:59:55Z", "api-1"),
+			podLog("2026-01-02T09:59:56Z", "api-2"),
+			podLog("2026-01-02T10:00:01Z", "api-1"),
+			podLog("2026-01-02T10:00:02Z", "api-1"),
+		},
+		Total: 4,
+	}, nil).Once()
+
+	metrics := mocks.NewMockMetricsQuerier(t)
+	expectCorrelationMetrics(metrics)
+
+	svc := NewCorrelationService(logs, traces, metrics, testLogger())
+	svc.now = func() time.Time { return now }
+
+	req := newCorrelationRequest()
+	req.TraceID = "trace-1"
+	req.Window = "10s"
+	req.LogLimit = 2
+	resp, err := svc.QueryCorrelations(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, rootStart, resp.AnchorTime)
+	assert.Equal(t, rootStart.Add(-10*time.Second), resp.StartTime)
+	assert.Equal(t, "api-1", resp.PodName)
+	require.NotNil(t, resp.Spans)
+	assert.Len(t, resp.Spans.Spans, 2)
+	require.NotNil(t, resp.Logs)
+	assert.Equal(t, []types.LogEntry{
+		podLog("2026-01-02T09:59:55Z", "api-1"),
+		podLog("2026-01-02T10:00:01Z", "api-1"),
+	}, resp.Logs.Logs)
+	require.NotNil(t, resp.Metrics.Resource)
+	assert.Len(t, resp.Metrics.Resource.CPUUsage, 2)
+	assert.NotNil(t, resp.Metrics.HTTP)
+	assert.Empty(t, resp.Warnings)
+}
+
+func TestCorrelationService_FromLogEntry(t *testing.T) {
+	logs := mocks.NewMockLogsQuerier(t)
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.StartTime == "2026-01-02T09:59:30Z" && r.Limit == DefaultCorrelationLogLimit
+	})).Return(&types.LogsQueryResponse{Logs: []types.LogEntry{podLog("2026-01-02T10:00:00Z", "api-1")}}, nil).Once()
+
+	metrics := mocks.NewMockMetricsQuerier(t)
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.MatchedBy(func(r *types.MetricsQueryRequest) bool {
+		return r.StartTime == "2026-01-02T09:55:00Z" && r.EndTime == "2026-01-02T10:05:00Z"
+	})).Return(nil, fmt.Errorf("%w: prometheus unavailable", ErrMetricsRetrieval)).Twice()
+
+	// No trace is given, so the traces service must not be queried.
+	svc := NewCorrelationService(logs, mocks.NewMockTracesQuerier(t), metrics, testLogger())
+
+	req := newCorrelationRequest()
+	req.Timestamp = "2026-01-02T10:00:00Z"
+	resp, err := svc.QueryCorrelations(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Nil(t, resp.Spans)
+	require.NotNil(t, resp.Logs)
+	assert.Len(t, resp.Logs.Logs, 1)
+	assert.Nil(t, resp.Metrics.Resource)
+	assert.Nil(t, resp.Metrics.HTTP)
+	assert.Equal(t, []string{
+		"resource metrics could not be retrieved",
+		"HTTP metrics could not be retrieved",
+	}, resp.Warnings)
+}
+
+func TestCorrelationService_Errors(t *testing.T) {
+	t.Run("missing pivot", func(t *testing.T) {
+		svc := NewCorrelationService(mocks.NewMockLogsQuerier(t), mocks.NewMockTracesQuerier(t),
+			mocks.NewMockMetricsQuerier(t), testLogger())
+		_, err := svc.QueryCorrelations(context.Background(), newCorrelationRequest())
+		require.ErrorIs(t, err, ErrCorrelationInvalidRequest)
+	})
+
+	t.Run("trace not found", func(t *testing.T) {
+		traces := mocks.NewMockTracesQuerier(t)
+		traces.EXPECT().QuerySpans(mock.Anything, "missing", mock.Anything).
+			Return(&types.SpansQueryResponse{}, nil).Once()
+		svc := NewCorrelationService(mocks.NewMockLogsQuerier(t), traces, mocks.NewMockMetricsQuerier(t), testLogger())
+
+		req := newCorrelationRequest()
+		req.TraceID = "missing"
+		_, err := svc.QueryCorrelations(context.Background(), req)
+		require.ErrorIs(t, err, ErrCorrelationInvalidRequest)
+	})
+
+	t.Run("trace retrieval fails without timestamp", func(t *testing.T) {
+		traces := mocks.NewMockTracesQuerier(t)
+		traces.EXPECT().QuerySpans(mock.Anything, "trace-1", mock.Anything).
+			Return(nil, ErrTracesRetrieval).Once()
+		svc := NewCorrelationService(mocks.NewMockLogsQuerier(t), traces, mocks.NewMockMetricsQuerier(t), testLogger())
+
+		req := newCorrelationRequest()
+		req.TraceID = "trace-1"
+		_, err := svc.QueryCorrelations(context.Background(), req)
+		require.ErrorIs(t, err, ErrTracesRetrieval)
+	})
+
+	t.Run("scope resolution fails", func(t *testing.T) {
+		logs := mocks.NewMockLogsQuerier(t)
+		logs.EXPECT().QueryLogs(mock.Anything, mock.Anything).Return(nil, ErrLogsResolveSearchScope).Once()
+		svc := NewCorrelationService(logs, mocks.NewMockTracesQuerier(t), mocks.NewMockMetricsQuerier(t), testLogger())
+
+		req := newCorrelationRequest()
+		req.Timestamp = "2026-01-02T10:00:00Z"
+		_, err := svc.QueryCorrelations(context.Background(), req)
+		require.ErrorIs(t, err, ErrLogsResolveSearchScope)
+	})
+}
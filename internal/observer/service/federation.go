@@ -0,0 +1,396 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+var (
+	// ErrFederationInvalidRequest indicates the federated query is malformed. Maps to HTTP 400.
+	ErrFederationInvalidRequest = errors.New("invalid federated query request")
+	// ErrFederationResolveScope indicates the environments of the component or their
+	// observability planes could not be resolved.
+	ErrFederationResolveScope = errors.New("failed to resolve the observability planes of the component")
+	// ErrFederationRetrieval indicates that no observability plane answered the query.
+	ErrFederationRetrieval = errors.New("federated query failed on every observability plane")
+)
+
+const (
+	// federationMaxResponseBytes bounds a remote plane response read into memory.
+	federationMaxResponseBytes = 32 << 20
+
+	federatedLogsPath    = "/api/v1/logs/query"
+	federatedMetricsPath = "/api/v1/metrics/query"
+)
+
+// environmentPlaneResolver lists the environments of a component and resolves the
+// observability plane serving each.
+type environmentPlaneResolver interface {
+	ListReleaseBindings(ctx context.Context, namespaceName string) ([]ReleaseBindingRef, error)
+	GetEnvironmentObservabilityPlane(ctx context.Context, namespaceName, environmentName string) (string, error)
+}
+
+// federatedPlane is an observability plane holding data of some environments of a component.
+type federatedPlane struct {
+	name         string
+	local        bool
+	environments []string
+}
+
+// FederationService fans log and metric queries out to the observability planes that serve the
+// environments of a component. The local plane is queried in process; the other planes are
+// queried through their observers with the caller's token, so each plane authorizes the caller
+// itself. A plane that fails is reported in the response instead of failing the query.
+type FederationService struct {
+	resolver   environmentPlaneResolver
+	logs       LogsQuerier
+	metrics    MetricsQuerier
+	localPlane string
+	planeURLs  map[string]string
+	timeout    time.Duration
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+var _ FederatedQuerier = (*FederationService)(nil)
+
+// NewFederationService creates a new FederationService. The logs and metrics queriers answer
+// for the local plane; pass unwrapped services and authorize through NewFederationServiceWithAuthz.
+func NewFederationService(
+	resolver environmentPlaneResolver,
+	logs LogsQuerier,
+	metrics MetricsQuerier,
+	cfg *config.FederationConfig,
+	logger *slog.Logger,
+) (*FederationService, error) {
+	planeURLs, err := cfg.PlaneURLs()
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: cfg.TLSInsecureSkipVerify, //nolint:gosec // G402: Configurable for development
+		},
+	}
+	return &FederationService{
+		resolver:   resolver,
+		logs:       logs,
+		metrics:    metrics,
+		localPlane: cfg.LocalPlane,
+		planeURLs:  planeURLs,
+		timeout:    cfg.Timeout,
+		httpClient: &http.Client{Transport: transport},
+		logger:     logger,
+	}, nil
+}
+
+// QueryFederatedLogs queries the logs of a component on every observability plane serving its
+// environments and merges them in the requested order, attributing each entry to its plane.
+func (s *FederationService) QueryFederatedLogs(
+	ctx context.Context,
+	req *types.LogsQueryRequest,
+) (*types.FederatedLogsQueryResponse, error) {
+	if req == nil || req.SearchScope == nil || req.SearchScope.Component == nil {
+		return nil, fmt.Errorf("%w: a component search scope is required", ErrFederationInvalidRequest)
+	}
+	start := time.Now()
+	planes, warnings, err := s.resolvePlanes(ctx, *req.SearchScope.Component)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFederationInvalidRequest, err)
+	}
+
+	query := func(ctx context.Context, plane federatedPlane) (*types.LogsQueryResponse, error) {
+		if plane.local {
+			return s.logs.QueryLogs(ctx, req)
+		}
+		var resp types.LogsQueryResponse
+		if err := s.queryRemote(ctx, plane.name, federatedLogsPath, body, &resp); err != nil {
+			return nil, err
+		}
+		return &resp, nil
+	}
+	results, statuses, err := fanOut(ctx, s.logger, planes, query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.FederatedLogsQueryResponse{
+		Logs:     []types.FederatedLogEntry{},
+		Planes:   statuses,
+		Warnings: warnings,
+	}
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		resp.Total += result.Total
+		for _, entry := range result.Logs {
+			resp.Logs = append(resp.Logs, types.FederatedLogEntry{LogEntry: entry, ObservabilityPlane: planes[i].name})
+		}
+	}
+	ascending := req.SortOrder == "asc"
+	sort.SliceStable(resp.Logs, func(i, j int) bool {
+		ti, tj := parseLogTimestamp(resp.Logs[i].Timestamp), parseLogTimestamp(resp.Logs[j].Timestamp)
+		if ascending {
+			return ti.Before(tj)
+		}
+		return ti.After(tj)
+	})
+	if req.Limit > 0 && len(resp.Logs) > req.Limit {
+		resp.Logs = resp.Logs[:req.Limit]
+	}
+	resp.TookMs = int(time.Since(start).Milliseconds())
+	return resp, nil
+}
+
+// QueryFederatedMetrics queries the metrics of a component on every observability plane
+// serving its environments. The series of each plane are returned separately.
+func (s *FederationService) QueryFederatedMetrics(
+	ctx context.Context,
+	req *types.MetricsQueryRequest,
+) (*types.FederatedMetricsQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request must not be nil", ErrFederationInvalidRequest)
+	}
+	planes, warnings, err := s.resolvePlanes(ctx, req.SearchScope)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFederationInvalidRequest, err)
+	}
+
+	results, statuses, err := fanOut(ctx, s.logger, planes, func(ctx context.Context, plane federatedPlane) (any, error) {
+		if plane.local {
+			return s.metrics.QueryMetrics(ctx, req)
+		}
+		var resp json.RawMessage
+		if err := s.queryRemote(ctx, plane.name, federatedMetricsPath, body, &resp); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.FederatedMetricsQueryResponse{
+		Results:  []types.FederatedMetricsResult{},
+		Planes:   statuses,
+		Warnings: warnings,
+	}
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		resp.Results = append(resp.Results, types.FederatedMetricsResult{
+			ObservabilityPlane: planes[i].name,
+			Environments:       planes[i].environments,
+			Metrics:            result,
+		})
+	}
+	return resp, nil
+}
+
+// resolvePlanes groups the environments in scope by the observability plane serving them.
+// Without an environment in scope, the environments the component is bound to are used.
+// Environments whose plane cannot be resolved are reported as warnings.
+func (s *FederationService) resolvePlanes(
+	ctx context.Context,
+	scope types.ComponentSearchScope,
+) ([]federatedPlane, []string, error) {
+	if scope.Namespace == "" || scope.Project == "" || scope.Component == "" {
+		return nil, nil, fmt.Errorf("%w: namespace, project and component are required", ErrFederationInvalidRequest)
+	}
+
+	var environments []string
+	if scope.Environment != "" {
+		environments = []string{scope.Environment}
+	} else {
+		bindings, err := s.resolver.ListReleaseBindings(ctx, scope.Namespace)
+		if err != nil {
+			if errors.Is(err, ErrScopeAuthFailed) {
+				return nil, nil, err
+			}
+			return nil, nil, fmt.Errorf("%w: %w", ErrFederationResolveScope, err)
+		}
+		for _, b := range bindings {
+			if b.Project == scope.Project && b.Component == scope.Component && !slices.Contains(environments, b.Environment) {
+				environments = append(environments, b.Environment)
+			}
+		}
+		sort.Strings(environments)
+	}
+
+	byName := make(map[string]*federatedPlane)
+	var names, warnings []string
+	for _, env := range environments {
+		name, err := s.resolver.GetEnvironmentObservabilityPlane(ctx, scope.Namespace, env)
+		if err != nil {
+			if errors.Is(err, ErrScopeAuthFailed) {
+				return nil, nil, err
+			}
+			s.logger.Warn("Failed to resolve the observability plane of an environment",
+				"namespace", scope.Namespace, "environment", env, "error", err)
+			warnings = append(warnings, fmt.Sprintf("observability plane of environment %q could not be resolved", env))
+			continue
+		}
+		plane, ok := byName[name]
+		if !ok {
+			plane = &federatedPlane{name: name, local: name == s.localPlane}
+			byName[name] = plane
+			names = append(names, name)
+		}
+		plane.environments = append(plane.environments, env)
+	}
+	if len(names) == 0 && len(warnings) > 0 {
+		return nil, nil, fmt.Errorf("%w: no environment could be mapped to an observability plane", ErrFederationResolveScope)
+	}
+
+	sort.Strings(names)
+	planes := make([]federatedPlane, 0, len(names))
+	for _, name := range names {
+		planes = append(planes, *byName[name])
+	}
+	return planes, warnings, nil
+}
+
+// fanOut runs query against every plane concurrently and returns the results in plane order,
+// with a nil result for each failed plane. Authorization and malformed request errors of the
+// local plane fail the whole query, as does the failure of every plane.
+func fanOut[T any](
+	ctx context.Context,
+	logger *slog.Logger,
+	planes []federatedPlane,
+	query func(ctx context.Context, plane federatedPlane) (T, error),
+) ([]T, []types.FederatedPlaneStatus, error) {
+	results := make([]T, len(planes))
+	errs := make([]error, len(planes))
+	var wg sync.WaitGroup
+	for i, plane := range planes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = query(ctx, plane)
+		}()
+	}
+	wg.Wait()
+
+	statuses := make([]types.FederatedPlaneStatus, len(planes))
+	failed := 0
+	for i, plane := range planes {
+		statuses[i] = types.FederatedPlaneStatus{
+			Name:         plane.name,
+			Local:        plane.local,
+			Environments: plane.environments,
+			Status:       types.FederatedPlaneStatusSucceeded,
+		}
+		err := errs[i]
+		if err == nil {
+			continue
+		}
+		if plane.local && isFederationFatal(err) {
+			return nil, nil, err
+		}
+		logger.Warn("Federated query failed on an observability plane", "plane", plane.name, "error", err)
+		statuses[i].Status = types.FederatedPlaneStatusFailed
+		statuses[i].Error = err.Error()
+		failed++
+	}
+	if failed > 0 && failed == len(planes) {
+		return nil, nil, fmt.Errorf("%w: %w", ErrFederationRetrieval, errors.Join(errs...))
+	}
+	return results, statuses, nil
+}
+
+// isFederationFatal reports whether a local plane error is about the request or the caller
+// rather than the plane, so that the other planes would fail the same way.
+func isFederationFatal(err error) bool {
+	return errors.Is(err, observerAuthz.ErrAuthzForbidden) ||
+		errors.Is(err, observerAuthz.ErrAuthzUnauthorized) ||
+		errors.Is(err, ErrScopeAuthFailed) ||
+		errors.Is(err, ErrMetricsInvalidRequest)
+}
+
+// queryRemote posts a query to the observer of a remote plane on behalf of the caller and
+// decodes the response into out.
+func (s *FederationService) queryRemote(ctx context.Context, plane, path string, body []byte, out any) error {
+	baseURL, ok := s.planeURLs[plane]
+	if !ok {
+		return fmt.Errorf("no observer is configured for observability plane %q", plane)
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if token := jwt.GetTokenFromContext(ctx); token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	httpResp, err := s.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to reach the observer: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(httpResp.Body, federationMaxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("failed to read the observer response: %w", err)
+	}
+	switch httpResp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("access denied by the observer (status %d)", httpResp.StatusCode)
+	default:
+		var errResp struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &errResp) == nil && errResp.Message != "" {
+			return fmt.Errorf("observer returned status %d: %s", httpResp.StatusCode, errResp.Message)
+		}
+		return fmt.Errorf("observer returned status %d", httpResp.StatusCode)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode the observer response: %w", err)
+	}
+	return nil
+}
+
+// parseLogTimestamp parses a log entry timestamp, returning the zero time when it is malformed
+// so that such entries sort last in descending order.
+func parseLogTimestamp(ts string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// federationServiceWithAuthz wraps a FederatedQuerier and authorizes the caller for the
+// component before its environments and planes are resolved. Remote planes authorize the
+// caller again with the forwarded token.
+type federationServiceWithAuthz struct {
+	internal FederatedQuerier
+	pdp      authzcore.PDP
+	logger   *slog.Logger
+}
+
+var _ FederatedQuerier = (*federationServiceWithAuthz)(nil)
+
+// NewFederationServiceWithAuthz wraps the provided FederatedQuerier with authorization checks.
+func NewFederationServiceWithAuthz(s FederatedQuerier, pdp authzcore.PDP, logger *slog.Logger) FederatedQuerier {
+	return &federationServiceWithAuthz{internal: s, pdp: pdp, logger: logger}
+}
+
+func (s *federationServiceWithAuthz) QueryFederatedLogs(
+	ctx context.Context,
+	req *types.LogsQueryRequest,
+) (*types.FederatedLogsQueryResponse, error) {
+	if req == nil || req.SearchScope == nil || req.SearchScope.Component == nil {
+		return nil, fmt.Errorf("%w: a component search scope is required", ErrFederationInvalidRequest)
+	}
+	if err := s.authorize(ctx, observerAuthz.ActionViewLogs, *req.SearchScope.Component); err != nil {
+		return nil, err
+	}
+	return s.internal.QueryFederatedLogs(ctx, req)
+}
+
+func (s *federationServiceWithAuthz) QueryFederatedMetrics(
+	ctx context.Context,
+	req *types.MetricsQueryRequest,
+) (*types.FederatedMetricsQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request must not be nil", ErrFederationInvalidRequest)
+	}
+	if err := s.authorize(ctx, observerAuthz.ActionViewMetrics, req.SearchScope); err != nil {
+		return nil, err
+	}
+	return s.internal.QueryFederatedMetrics(ctx, req)
+}
+
+func (s *federationServiceWithAuthz) authorize(
+	ctx context.Context,
+	action observerAuthz.Action,
+	scope types.ComponentSearchScope,
+) error {
+	resourceType, resourceName, hierarchy := observerAuthz.ComponentScopeAuthz(scope.Namespace, scope.Project, scope.Component)
+	// TODO: currently the obs API is not equipped to provide cluster level environments,
+	// once that is done update false to proper isClusterScoped value.
+	return observerAuthz.CheckAuthorization(
+		ctx, s.logger, s.pdp,
+		action,
+		resourceType, resourceName, hierarchy,
+		authzcore.Context{Resource: authzcore.ResourceAttribute{
+			Environment: observerAuthz.FormatDualScopedResourceName(scope.Namespace, scope.Environment, false),
+		}},
+	)
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+type fakePlaneResolver struct {
+	bindings []ReleaseBindingRef
+	planes   map[string]string
+}
+
+func (f *fakePlaneResolver) ListReleaseBindings(context.Context, string) ([]ReleaseBindingRef, error) {
+	return f.bindings, nil
+}
+
+func (f *fakePlaneResolver) GetEnvironmentObservabilityPlane(_ context.Context, _, env string) (string, error) {
+	plane, ok := f.planes[env]
+	if !ok {
+		return "", fmt.Errorf("environment %q: %w", env, ErrResourceNotFound)
+	}
+	return plane, nil
+}
+
+func newFederationTestService(
+	t *testing.T,
+	remote http.HandlerFunc,
+) (*FederationService, *mocks.MockLogsQuerier, *mocks.MockMetricsQuerier) {
+	t.Helper()
+	server := httptest.NewServer(remote)
+	t.Cleanup(server.Close)
+	resolver := &fakePlaneResolver{
+		bindings: []ReleaseBindingRef{
+			{Namespace: "acme", Project: "shop", Component: "api", Environment: "dev"},
+			{Namespace: "acme", Project: "shop", Component: "api", Environment: "prod"},
+			{Namespace: "acme", Project: "shop", Component: "api", Environment: "staging"},
+			{Namespace: "acme", Project: "shop", Component: "web", Environment: "qa"},
+		},
+		planes: map[string]string{"dev": "eu-west", "staging": "eu-west", "prod": "us-east", "qa": "ap-south"},
+	}
+	logs := mocks.NewMockLogsQuerier(t)
+	metrics := mocks.NewMockMetricsQuerier(t)
+	svc, err := NewFederationService(resolver, logs, metrics, &config.FederationConfig{
+		LocalPlane: "eu-west",
+		Planes:     "us-east=" + server.URL,
+		Timeout:    5 * time.Second,
+	}, testLogger())
+	require.NoError(t, err)
+	return svc, logs, metrics
+}
+
+func federatedLogsRequest(environment string) *types.LogsQueryRequest {
+	return &types.LogsQueryRequest{
+		SearchScope: &types.SearchScope{Component: &types.ComponentSearchScope{
+			Namespace: "acme", Project: "shop", Component: "api", Environment: environment,
+		}},
+		StartTime: "2026-01-02T10:00:00Z",
+		EndTime:   "2026-01-02T11:00:00Z",
+		Limit:     3,
+		SortOrder: "desc",
+	}
+}
+
+func TestFederationService_QueryFederatedLogs(t *testing.T) {
+	svc, logs, _ := newFederationTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, federatedLogsPath, r.URL.Path)
+		var req types.LogsQueryRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "api", req.SearchScope.Component.Component)
+		_ = json.NewEncoder(w).Encode(types.LogsQueryResponse{
+			Logs: []types.LogEntry{
+				{Timestamp: "2026-01-02T10:30:00Z", Log: "prod-2"},
+				{Timestamp: "2026-01-02T10:10:00Z", Log: "prod-1"},
+			},
+			Total: 2,
+		})
+	})
+	logs.EXPECT().QueryLogs(mock.Anything, mock.Anything).Return(&types.LogsQueryResponse{
+		Logs: []types.LogEntry{
+			{Timestamp: "2026-01-02T10:40:00Z", Log: "dev-2"},
+			{Timestamp: "2026-01-02T10:20:00Z", Log: "dev-1"},
+		},
+		Total: 2,
+	}, nil).Once()
+
+	resp, err := svc.QueryFederatedLogs(context.Background(), federatedLogsRequest(""))
+	require.NoError(t, err)
+
+	require.Len(t, resp.Logs, 3)
+	assert.Equal(t, "dev-2", resp.Logs[0].Log)
+	assert.Equal(t, "eu-west", resp.Logs[0].ObservabilityPlane)
+	assert.Equal(t, "prod-2", resp.Logs[1].Log)
+	assert.Equal(t, "us-east", resp.Logs[1].ObservabilityPlane)
+	assert.Equal(t, "dev-1", resp.Logs[2].Log)
+	assert.Equal(t, 4, resp.Total)
+	assert.Equal(t, []types.FederatedPlaneStatus{
+		{Name: "eu-west", Local: true, Environments: []string{"dev", "staging"}, Status: types.FederatedPlaneStatusSucceeded},
+		{Name: "us-east", Environments: []string{"prod"}, Status: types.FederatedPlaneStatusSucceeded},
+	}, resp.Planes)
+	assert.Empty(t, resp.Warnings)
+}
+
+func TestFederationService_QueryFederatedLogsPartialFailure(t *testing.T) {
+	svc, logs, _ := newFederationTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"Failed to retrieve logs"}`))
+	})
+	logs.EXPECT().QueryLogs(mock.Anything, mock.Anything).Return(&types.LogsQueryResponse{
+		Logs:  []types.LogEntry{{Timestamp: "2026-01-02T10:40:00Z", Log: "dev"}},
+		Total: 1,
+	}, nil).Once()
+
+	resp, err := svc.QueryFederatedLogs(context.Background(), federatedLogsRequest(""))
+	require.NoError(t, err)
+
+	require.Len(t, resp.Logs, 1)
+	require.Len(t, resp.Planes, 2)
+	assert.Equal(t, types.FederatedPlaneStatusSucceeded, resp.Planes[0].Status)
+	assert.Equal(t, types.FederatedPlaneStatusFailed, resp.Planes[1].Status)
+	assert.Contains(t, resp.Planes[1].Error, "Failed to retrieve logs")
+}
+
+func TestFederationService_QueryFederatedLogsErrors(t *testing.T) {
+	t.Run("every plane fails", func(t *testing.T) {
+		svc, logs, _ := newFederationTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		})
+		logs.EXPECT().QueryLogs(mock.Anything, mock.Anything).Return(nil, ErrLogsRetrieval).Once()
+
+		_, err := svc.QueryFederatedLogs(context.Background(), federatedLogsRequest(""))
+		require.ErrorIs(t, err, ErrFederationRetrieval)
+	})
+
+	t.Run("local authorization failure", func(t *testing.T) {
+		svc, logs, _ := newFederationTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(types.LogsQueryResponse{})
+		})
+		logs.EXPECT().QueryLogs(mock.Anything, mock.Anything).Return(nil, observerAuthz.ErrAuthzForbidden).Once()
+
+		_, err := svc.QueryFederatedLogs(context.Background(), federatedLogsRequest(""))
+		require.ErrorIs(t, err, observerAuthz.ErrAuthzForbidden)
+	})
+
+	t.Run("unresolvable environment", func(t *testing.T) {
+		svc, _, _ := newFederationTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Error("no plane should be queried")
+		})
+
+		_, err := svc.QueryFederatedLogs(context.Background(), federatedLogsRequest("sandbox"))
+		require.ErrorIs(t, err, ErrFederationResolveScope)
+	})
+}
+
+func TestFederationService_QueryFederatedMetrics(t *testing.T) {
+	svc, _, metrics := newFederationTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, federatedMetricsPath, r.URL.Path)
+		_, _ = w.Write([]byte(`{"cpuUsage":[{"timestamp":"2026-01-02T10:00:00Z","value":0.5}]}`))
+	})
+	step := "1m"
+	req := &types.MetricsQueryRequest{
+		Metric:      types.MetricTypeResource,
+		StartTime:   "2026-01-02T10:00:00Z",
+		EndTime:     "2026-01-02T11:00:00Z",
+		Step:        &step,
+		SearchScope: types.ComponentSearchScope{Namespace: "acme", Project: "shop", Component: "api", Environment: "prod"},
+	}
+
+	resp, err := svc.QueryFederatedMetrics(context.Background(), req)
+	require.NoError(t, err)
+
+	// Only the plane of the requested environment is queried.
+	metrics.AssertNotCalled(t, "QueryMetrics", mock.Anything, mock.Anything)
+	require.Len(t, resp.Results, 1)
+	assert.Equal(t, "us-east", resp.Results[0].ObservabilityPlane)
+	assert.Equal(t, []string{"prod"}, resp.Results[0].Environments)
+	raw, err := json.Marshal(resp.Results[0].Metrics)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"cpuUsage":[{"timestamp":"2026-01-02T10:00:00Z","value":0.5}]}`, string(raw))
+}
+
+func TestFederationService_UnconfiguredPlane(t *testing.T) {
+	svc, logs, _ := newFederationTestService(t, func(w http.ResponseWriter, r *http.Request) {})
+	svc.resolver.(*fakePlaneResolver).planes["prod"] = "ap-south"
+	logs.EXPECT().QueryLogs(mock.Anything, mock.Anything).Return(&types.LogsQueryResponse{}, nil).Once()
+
+	resp, err := svc.QueryFederatedLogs(context.Background(), federatedLogsRequest(""))
+	require.NoError(t, err)
+
+	require.Len(t, resp.Planes, 2)
+	assert.Equal(t, "ap-south", resp.Planes[0].Name)
+	assert.Equal(t, types.FederatedPlaneStatusFailed, resp.Planes[0].Status)
+	assert.Contains(t, resp.Planes[0].Error, "no observer is configured")
+}
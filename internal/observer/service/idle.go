@@ -0,0 +1,264 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/internal/recommender"
+)
+
+var (
+	// ErrIdleWorkloadsInvalidRequest indicates the report request is malformed. Maps to HTTP 400.
+	ErrIdleWorkloadsInvalidRequest = errors.New("invalid idle workloads request")
+	// ErrIdleWorkloadsNotReady indicates that no analysis has completed yet. Maps to HTTP 503.
+	ErrIdleWorkloadsNotReady = errors.New("idle workload analysis has not completed yet")
+)
+
+const (
+	// idleCPUPercentile is the CPU usage percentile compared against the idle threshold.
+	idleCPUPercentile = 0.95
+	// hoursPerMonth is the average number of hours in a month, used to price resources.
+	hoursPerMonth = 730
+	// releaseStateUndeploy marks release bindings whose resources are already removed.
+	releaseStateUndeploy = "Undeploy"
+)
+
+// releaseBindingLister lists the deployed components to analyze.
+type releaseBindingLister interface {
+	ListNamespaces(ctx context.Context) ([]string, error)
+	ListReleaseBindings(ctx context.Context, namespaceName string) ([]ReleaseBindingRef, error)
+}
+
+// IdleWorkloadDetector periodically flags deployed components whose traffic and CPU usage
+// stayed near zero over a per-environment window, and serves the latest result as a report.
+type IdleWorkloadDetector struct {
+	lister  releaseBindingLister
+	metrics MetricsQuerier
+	config  *config.IdleDetectionConfig
+	logger  *slog.Logger
+	now     func() time.Time
+
+	mu          sync.RWMutex
+	generatedAt time.Time
+	items       []types.IdleWorkload
+}
+
+var _ IdleWorkloadReporter = (*IdleWorkloadDetector)(nil)
+
+// NewIdleWorkloadDetector creates a new IdleWorkloadDetector. The analysis runs in the
+// background without a caller, so pass the unwrapped MetricsQuerier; access to the report
+// is checked per component by NewIdleWorkloadsServiceWithAuthz.
+func NewIdleWorkloadDetector(
+	lister releaseBindingLister,
+	metrics MetricsQuerier,
+	cfg *config.IdleDetectionConfig,
+	logger *slog.Logger,
+) *IdleWorkloadDetector {
+	return &IdleWorkloadDetector{lister: lister, metrics: metrics, config: cfg, logger: logger, now: time.Now}
+}
+
+// Run analyzes the workloads immediately and then on every interval until ctx is cancelled.
+// Failures are logged and retried on the next interval.
+func (d *IdleWorkloadDetector) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Analyze(ctx); err != nil {
+			d.logger.Error("Failed to analyze idle workloads", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Analyze evaluates every active release binding and replaces the report. Bindings whose
+// metrics cannot be read are skipped so that one failure does not hide the rest.
+func (d *IdleWorkloadDetector) Analyze(ctx context.Context) error {
+	namespaces, err := d.lister.ListNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	end := d.now().UTC()
+	var items []types.IdleWorkload
+	for _, ns := range namespaces {
+		refs, err := d.lister.ListReleaseBindings(ctx, ns)
+		if err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			if ref.State == releaseStateUndeploy {
+				continue
+			}
+			item, err := d.analyzeReleaseBinding(ctx, ref, end)
+			if err != nil {
+				d.logger.Warn("Skipping release binding in idle workload analysis",
+					"namespace", ref.Namespace, "releaseBinding", ref.Name, "error", err)
+				continue
+			}
+			if item != nil {
+				items = append(items, *item)
+			}
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].EstimatedMonthlySavings > items[j].EstimatedMonthlySavings
+	})
+
+	d.mu.Lock()
+	d.generatedAt = end
+	d.items = items
+	d.mu.Unlock()
+
+	d.logger.Info("Idle workload analysis completed", "namespaces", len(namespaces), "idleWorkloads", len(items))
+	return nil
+}
+
+// analyzeReleaseBinding returns the idle workload entry of the binding, or nil when the
+// component is in use or has no running pods.
+func (d *IdleWorkloadDetector) analyzeReleaseBinding(
+	ctx context.Context,
+	ref ReleaseBindingRef,
+	end time.Time,
+) (*types.IdleWorkload, error) {
+	window := d.config.WindowFor(ref.Environment)
+	start := end.Add(-window)
+	step := recommendationStep(window)
+	query := func(metric string) (any, error) {
+		return d.metrics.QueryMetrics(ctx, &types.MetricsQueryRequest{
+			Metric:    metric,
+			StartTime: start.Format(time.RFC3339),
+			EndTime:   end.Format(time.RFC3339),
+			Step:      &step,
+			SearchScope: types.ComponentSearchScope{
+				Namespace:   ref.Namespace,
+				Project:     ref.Project,
+				Component:   ref.Component,
+				Environment: ref.Environment,
+			},
+		})
+	}
+
+	rawResource, err := query(types.MetricTypeResource)
+	if err != nil {
+		return nil, err
+	}
+	resource, err := decodeResourceMetrics(rawResource)
+	if err != nil {
+		return nil, err
+	}
+	cpu := seriesValues(resource.CPUUsage)
+	if len(cpu) == 0 {
+		return nil, nil
+	}
+	cpuP95 := recommender.Percentile(cpu, idleCPUPercentile)
+	if cpuP95 > d.config.MaxCPUCores {
+		return nil, nil
+	}
+
+	rawHTTP, err := query(types.MetricTypeHTTP)
+	if err != nil {
+		return nil, err
+	}
+	var httpMetrics types.HTTPMetricsQueryResponse
+	if err := decodeMetrics(rawHTTP, &httpMetrics); err != nil {
+		return nil, err
+	}
+	peakRate := recommender.Percentile(seriesValues(httpMetrics.RequestCount), 1)
+	if peakRate > d.config.MaxRequestRate {
+		return nil, nil
+	}
+
+	cpuRequest := latestValue(resource.CPURequests)
+	memoryRequest := latestValue(resource.MemoryRequests)
+	return &types.IdleWorkload{
+		Namespace:               ref.Namespace,
+		Project:                 ref.Project,
+		Component:               ref.Component,
+		Environment:             ref.Environment,
+		ReleaseBinding:          ref.Name,
+		WindowStart:             start,
+		WindowEnd:               end,
+		CPUP95Cores:             cpuP95,
+		PeakRequestRate:         peakRate,
+		CPURequestCores:         cpuRequest,
+		MemoryRequestBytes:      memoryRequest,
+		EstimatedMonthlySavings: d.monthlyCost(cpuRequest, memoryRequest),
+		SuspendURL:              d.suspendURL(ref),
+	}, nil
+}
+
+// monthlyCost prices the requested CPU cores and memory bytes, rounded to cents.
+func (d *IdleWorkloadDetector) monthlyCost(cpuCores, memoryBytes float64) float64 {
+	cost := cpuCores*d.config.CPUCoreMonthlyCost + memoryBytes/(1<<30)*d.config.MemoryGiBMonthlyCost
+	return math.Round(cost*100) / 100
+}
+
+func (d *IdleWorkloadDetector) suspendURL(ref ReleaseBindingRef) string {
+	return fmt.Sprintf("%s/api/v1alpha1/namespaces/%s/releasebindings/%s/suspend",
+		d.config.SuspendBaseURL, url.PathEscape(ref.Namespace), url.PathEscape(ref.Name))
+}
+
+// IdleWorkloads returns the idle workloads of the latest analysis within the requested scope.
+func (d *IdleWorkloadDetector) IdleWorkloads(
+	_ context.Context,
+	req *types.IdleWorkloadsRequest,
+) (*types.IdleWorkloadsResponse, error) {
+	if req == nil || req.Namespace == "" {
+		return nil, fmt.Errorf("%w: namespace is required", ErrIdleWorkloadsInvalidRequest)
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.generatedAt.IsZero() {
+		return nil, ErrIdleWorkloadsNotReady
+	}
+
+	resp := &types.IdleWorkloadsResponse{
+		GeneratedAt: d.generatedAt,
+		Currency:    d.config.Currency,
+		Items:       []types.IdleWorkload{},
+	}
+	for _, item := range d.items {
+		if item.Namespace != req.Namespace ||
+			(req.Project != "" && item.Project != req.Project) ||
+			(req.Environment != "" && item.Environment != req.Environment) {
+			continue
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	resp.TotalEstimatedMonthlySavings = totalSavings(resp.Items)
+	return resp, nil
+}
+
+func totalSavings(items []types.IdleWorkload) float64 {
+	total := 0.0
+	for _, item := range items {
+		total += item.EstimatedMonthlySavings
+	}
+	return math.Round(total*100) / 100
+}
+
+// latestValue returns the last value of a series, or zero when it is empty.
+func latestValue(series []types.MetricsTimeSeriesItem) float64 {
+	if len(series) == 0 {
+		return 0
+	}
+	return series[len(series)-1].Value
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// idleWorkloadsServiceWithAuthz wraps an IdleWorkloadReporter and drops the report entries
+// of components whose metrics the caller may not view.
+type idleWorkloadsServiceWithAuthz struct {
+	internal IdleWorkloadReporter
+	pdp      authzcore.PDP
+	logger   *slog.Logger
+}
+
+var _ IdleWorkloadReporter = (*idleWorkloadsServiceWithAuthz)(nil)
+
+// NewIdleWorkloadsServiceWithAuthz wraps the provided IdleWorkloadReporter with authorization checks.
+func NewIdleWorkloadsServiceWithAuthz(s IdleWorkloadReporter, pdp authzcore.PDP, logger *slog.Logger) IdleWorkloadReporter {
+	return &idleWorkloadsServiceWithAuthz{internal: s, pdp: pdp, logger: logger}
+}
+
+func (s *idleWorkloadsServiceWithAuthz) IdleWorkloads(
+	ctx context.Context,
+	req *types.IdleWorkloadsRequest,
+) (*types.IdleWorkloadsResponse, error) {
+	resp, err := s.internal.IdleWorkloads(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]types.IdleWorkload, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		resourceType, resourceName, hierarchy := observerAuthz.ComponentScopeAuthz(item.Namespace, item.Project, item.Component)
+		err := observerAuthz.CheckAuthorization(
+			ctx, s.logger, s.pdp,
+			observerAuthz.ActionViewMetrics,
+			resourceType, resourceName, hierarchy,
+			authzcore.Context{Resource: authzcore.ResourceAttribute{
+				Environment: observerAuthz.FormatDualScopedResourceName(item.Namespace, item.Environment, false),
+			}},
+		)
+		if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		allowed = append(allowed, item)
+	}
+	resp.Items = allowed
+	resp.TotalEstimatedMonthlySavings = totalSavings(allowed)
+	return resp, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	coremocks "github.com/openchoreo/openchoreo/internal/authz/core/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+type fakeReleaseBindingLister struct {
+	bindings map[string][]ReleaseBindingRef
+}
+
+func (f *fakeReleaseBindingLister) ListNamespaces(context.Context) ([]string, error) {
+	names := make([]string, 0, len(f.bindings))
+	for ns := range f.bindings {
+		names = append(names, ns)
+	}
+	return names, nil
+}
+
+func (f *fakeReleaseBindingLister) ListReleaseBindings(_ context.Context, ns string) ([]ReleaseBindingRef, error) {
+	return f.bindings[ns], nil
+}
+
+func newIdleDetector(t *testing.T) (*IdleWorkloadDetector, *mocks.MockMetricsQuerier) {
+	t.Helper()
+	lister := &fakeReleaseBindingLister{bindings: map[string][]ReleaseBindingRef{
+		"acme": {
+			{Namespace: "acme", Name: "legacy-dev", Project: "shop", Component: "legacy", Environment: "dev"},
+			{Namespace: "acme", Name: "api-dev", Project: "shop", Component: "api", Environment: "dev"},
+			{Namespace: "acme", Name: "web-prod", Project: "shop", Component: "web", Environment: "prod"},
+			{Namespace: "acme", Name: "old-prod", Project: "shop", Component: "old", Environment: "prod", State: "Undeploy"},
+		},
+	}}
+	metrics := mocks.NewMockMetricsQuerier(t)
+	cfg := &config.IdleDetectionConfig{
+		Window:               168 * time.Hour,
+		EnvironmentWindows:   "dev=72h",
+		MaxCPUCores:          0.005,
+		MaxRequestRate:       0.001,
+		CPUCoreMonthlyCost:   20,
+		MemoryGiBMonthlyCost: 4,
+		Currency:             "USD",
+		SuspendBaseURL:       "https://api.example.com",
+	}
+	d := NewIdleWorkloadDetector(lister, metrics, cfg, testLogger())
+	d.now = func() time.Time { return time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC) }
+	return d, metrics
+}
+
+func expectMetrics(metrics *mocks.MockMetricsQuerier, component, metric string, resp any) {
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.MatchedBy(func(r *types.MetricsQueryRequest) bool {
+		return r.SearchScope.Component == component && r.Metric == metric
+	})).Return(resp, nil).Once()
+}
+
+func TestIdleWorkloadDetector_Analyze(t *testing.T) {
+	d, metrics := newIdleDetector(t)
+
+	// legacy: idle, with half a core and 1 GiB requested.
+	expectMetrics(metrics, "legacy", types.MetricTypeResource, &types.ResourceMetricsQueryResponse{
+		CPUUsage:       series(20, func(int) float64 { return 0.001 }),
+		CPURequests:    series(1, func(int) float64 { return 0.5 }),
+		MemoryRequests: series(1, func(int) float64 { return 1 << 30 }),
+	})
+	expectMetrics(metrics, "legacy", types.MetricTypeHTTP, &types.HTTPMetricsQueryResponse{})
+	// api: busy CPU, traffic is not queried.
+	expectMetrics(metrics, "api", types.MetricTypeResource, &types.ResourceMetricsQueryResponse{
+		CPUUsage: series(20, func(int) float64 { return 0.2 }),
+	})
+	// web: quiet CPU but still serving requests.
+	expectMetrics(metrics, "web", types.MetricTypeResource, &types.ResourceMetricsQueryResponse{
+		CPUUsage: series(20, func(int) float64 { return 0.002 }),
+	})
+	expectMetrics(metrics, "web", types.MetricTypeHTTP, &types.HTTPMetricsQueryResponse{
+		RequestCount: series(20, func(i int) float64 { return float64(i) / 100 }),
+	})
+
+	require.NoError(t, d.Analyze(context.Background()))
+
+	resp, err := d.IdleWorkloads(context.Background(), &types.IdleWorkloadsRequest{Namespace: "acme"})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	item := resp.Items[0]
+	assert.Equal(t, "legacy", item.Component)
+	assert.Equal(t, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), item.WindowStart, "dev uses its 72h window")
+	assert.InDelta(t, 14.0, item.EstimatedMonthlySavings, 1e-9)
+	assert.Equal(t, "https://api.example.com/api/v1alpha1/namespaces/acme/releasebindings/legacy-dev/suspend", item.SuspendURL)
+	assert.InDelta(t, 14.0, resp.TotalEstimatedMonthlySavings, 1e-9)
+	assert.Equal(t, "USD", resp.Currency)
+
+	resp, err = d.IdleWorkloads(context.Background(), &types.IdleWorkloadsRequest{Namespace: "acme", Environment: "prod"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Items)
+}
+
+func TestIdleWorkloadDetector_NotReady(t *testing.T) {
+	d, _ := newIdleDetector(t)
+
+	_, err := d.IdleWorkloads(context.Background(), &types.IdleWorkloadsRequest{Namespace: "acme"})
+	require.ErrorIs(t, err, ErrIdleWorkloadsNotReady)
+
+	_, err = d.IdleWorkloads(context.Background(), &types.IdleWorkloadsRequest{})
+	require.ErrorIs(t, err, ErrIdleWorkloadsInvalidRequest)
+}
+
+func TestIdleWorkloadsAuthz_FiltersDeniedComponents(t *testing.T) {
+	inner := mocks.NewMockIdleWorkloadReporter(t)
+	inner.EXPECT().IdleWorkloads(mock.Anything, mock.Anything).Return(&types.IdleWorkloadsResponse{
+		Items: []types.IdleWorkload{
+			{Namespace: "acme", Project: "shop", Component: "legacy", EstimatedMonthlySavings: 14},
+			{Namespace: "acme", Project: "shop", Component: "secret", EstimatedMonthlySavings: 30},
+		},
+		TotalEstimatedMonthlySavings: 44,
+	}, nil)
+
+	pdp := coremocks.NewMockPDP(t)
+	pdp.EXPECT().Evaluate(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, req *authzcore.EvaluateRequest) (*authzcore.Decision, error) {
+			return &authzcore.Decision{Decision: req.Resource.ID != "secret"}, nil
+		})
+
+	resp, err := NewIdleWorkloadsServiceWithAuthz(inner, pdp, testLogger()).
+		IdleWorkloads(authedCtx(), &types.IdleWorkloadsRequest{Namespace: "acme"})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "legacy", resp.Items[0].Component)
+	assert.InDelta(t, 14.0, resp.TotalEstimatedMonthlySavings, 1e-9)
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+)
+
+const (
+	// ismPolicyIDPrefix prefixes the IDs of the ISM policies owned by the observer.
+	ismPolicyIDPrefix = "openchoreo-"
+	// ismTemplatePriority is the ISM template priority of the observer policies. It is above the
+	// default priority so that the policies win over catch-all templates.
+	ismTemplatePriority = 100
+
+	ismStateHot    = "hot"
+	ismStateWarm   = "warm"
+	ismStateDelete = "delete"
+)
+
+// ismPolicy is the subset of an OpenSearch ISM policy that the observer manages. Fields that
+// OpenSearch adds on read (policy_id, timestamps, retry settings) are not modelled, so a policy
+// read back from OpenSearch compares equal to the rendered one unless it has drifted.
+type ismPolicy struct {
+	Description  string           `json:"description"`
+	DefaultState string           `json:"default_state"`
+	States       []ismState       `json:"states"`
+	ISMTemplate  []ismTemplateDef `json:"ism_template"`
+}
+
+type ismState struct {
+	Name        string          `json:"name"`
+	Actions     []ismAction     `json:"actions"`
+	Transitions []ismTransition `json:"transitions"`
+}
+
+type ismAction struct {
+	ReadOnly *struct{} `json:"read_only,omitempty"`
+	Delete   *struct{} `json:"delete,omitempty"`
+}
+
+type ismTransition struct {
+	StateName  string                  `json:"state_name"`
+	Conditions ismTransitionConditions `json:"conditions"`
+}
+
+type ismTransitionConditions struct {
+	MinIndexAge string `json:"min_index_age"`
+}
+
+type ismTemplateDef struct {
+	IndexPatterns []string `json:"index_patterns"`
+	Priority      int      `json:"priority"`
+}
+
+type ismPolicyDocument struct {
+	ID          string    `json:"_id,omitempty"`
+	SeqNo       *int64    `json:"_seq_no,omitempty"`
+	PrimaryTerm *int64    `json:"_primary_term,omitempty"`
+	Policy      ismPolicy `json:"policy"`
+}
+
+// IndexLifecycleManager provisions OpenSearch ISM policies for the log indices and periodically
+// re-applies them so that manual edits in OpenSearch are reverted.
+type IndexLifecycleManager struct {
+	config     *config.IndexLifecycleConfig
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewIndexLifecycleManager creates a new IndexLifecycleManager instance
+func NewIndexLifecycleManager(cfg *config.IndexLifecycleConfig, logger *slog.Logger) *IndexLifecycleManager {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: cfg.TLSInsecureSkipVerify, //nolint:gosec // G402: Configurable for development
+		},
+	}
+	return &IndexLifecycleManager{
+		config: cfg,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   cfg.Timeout,
+		},
+		logger: logger,
+	}
+}
+
+// Run applies the policies immediately and then on every sync interval until ctx is cancelled.
+// Sync failures are logged and retried on the next interval.
+func (m *IndexLifecycleManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Sync(ctx); err != nil {
+			m.logger.Error("Failed to sync index lifecycle policies", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync creates missing policies and overwrites policies that differ from the configuration.
+// It attempts every policy and returns the joined errors of the ones that failed.
+func (m *IndexLifecycleManager) Sync(ctx context.Context) error {
+	var errs []error
+	for _, p := range m.config.Policies() {
+		if err := m.syncPolicy(ctx, p); err != nil {
+			errs = append(errs, fmt.Errorf("policy %s: %w", ismPolicyID(p), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *IndexLifecycleManager) syncPolicy(ctx context.Context, p config.IndexLifecyclePolicy) error {
+	id := ismPolicyID(p)
+	desired := buildISMPolicy(p)
+
+	current, err := m.getPolicy(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current != nil && reflect.DeepEqual(current.Policy, desired) {
+		return nil
+	}
+
+	if err := m.putPolicy(ctx, id, desired, current); err != nil {
+		return err
+	}
+	if current == nil {
+		m.logger.Info("Created index lifecycle policy", "policy", id, "indexPattern", p.IndexPattern)
+	} else {
+		m.logger.Info("Corrected drifted index lifecycle policy", "policy", id, "indexPattern", p.IndexPattern)
+	}
+	return nil
+}
+
+// getPolicy fetches a policy, returning nil when it does not exist.
+func (m *IndexLifecycleManager) getPolicy(ctx context.Context, id string) (*ismPolicyDocument, error) {
+	resp, err := m.do(ctx, http.MethodGet, ismPolicyPath(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, unexpectedOpenSearchStatus(resp)
+	}
+
+	var doc ismPolicyDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode policy: %w", err)
+	}
+	return &doc, nil
+}
+
+// putPolicy creates the policy, or updates it with optimistic concurrency when it exists.
+func (m *IndexLifecycleManager) putPolicy(ctx context.Context, id string, policy ismPolicy, current *ismPolicyDocument) error {
+	path := ismPolicyPath(id)
+	if current != nil && current.SeqNo != nil && current.PrimaryTerm != nil {
+		q := url.Values{}
+		q.Set("if_seq_no", fmt.Sprintf("%d", *current.SeqNo))
+		q.Set("if_primary_term", fmt.Sprintf("%d", *current.PrimaryTerm))
+		path += "?" + q.Encode()
+	}
+
+	body, err := json.Marshal(ismPolicyDocument{Policy: policy})
+	if err != nil {
+		return fmt.Errorf("failed to marshal policy: %w", err)
+	}
+	resp, err := m.do(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return unexpectedOpenSearchStatus(resp)
+	}
+	return nil
+}
+
+func (m *IndexLifecycleManager) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, m.config.OpenSearchURL+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if m.config.OpenSearchUsername != "" {
+		req.SetBasicAuth(m.config.OpenSearchUsername, m.config.OpenSearchPassword)
+	}
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call OpenSearch: %w", err)
+	}
+	return resp, nil
+}
+
+func unexpectedOpenSearchStatus(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("OpenSearch returned status %d: %s", resp.StatusCode, string(body))
+}
+
+func ismPolicyID(p config.IndexLifecyclePolicy) string {
+	return ismPolicyIDPrefix + p.Category + "-logs"
+}
+
+func ismPolicyPath(id string) string {
+	return "/_plugins/_ism/policies/" + url.PathEscape(id)
+}
+
+// buildISMPolicy renders the hot-warm-delete policy of a log category. Indices start in the hot
+// tier, become read-only in the warm tier and are deleted once the retention has passed. The
+// warm tier is omitted when WarmAfterDays is zero.
+func buildISMPolicy(p config.IndexLifecyclePolicy) ismPolicy {
+	deleteAfter := ismTransition{
+		StateName:  ismStateDelete,
+		Conditions: ismTransitionConditions{MinIndexAge: fmt.Sprintf("%dd", p.RetentionDays)},
+	}
+
+	states := make([]ismState, 0, 3)
+	if p.WarmAfterDays > 0 {
+		states = append(states,
+			ismState{
+				Name:    ismStateHot,
+				Actions: []ismAction{},
+				Transitions: []ismTransition{{
+					StateName:  ismStateWarm,
+					Conditions: ismTransitionConditions{MinIndexAge: fmt.Sprintf("%dd", p.WarmAfterDays)},
+				}},
+			},
+			ismState{
+				Name:        ismStateWarm,
+				Actions:     []ismAction{{ReadOnly: &struct{}{}}},
+				Transitions: []ismTransition{deleteAfter},
+			},
+		)
+	} else {
+		states = append(states, ismState{
+			Name:        ismStateHot,
+			Actions:     []ismAction{},
+			Transitions: []ismTransition{deleteAfter},
+		})
+	}
+	states = append(states, ismState{
+		Name:        ismStateDelete,
+		Actions:     []ismAction{{Delete: &struct{}{}}},
+		Transitions: []ismTransition{},
+	})
+
+	return ismPolicy{
+		Description:  fmt.Sprintf("OpenChoreo %s log retention, managed by the observer", p.Category),
+		DefaultState: ismStateHot,
+		States:       states,
+		ISMTemplate: []ismTemplateDef{{
+			IndexPatterns: []string{p.IndexPattern},
+			Priority:      ismTemplatePriority,
+		}},
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+)
+
+// fakeISMServer is an in-memory OpenSearch ISM policy API.
+type fakeISMServer struct {
+	mu       sync.Mutex
+	policies map[string]json.RawMessage
+	puts     []string
+}
+
+func (f *fakeISMServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := r.URL.Path[len("/_plugins/_ism/policies/"):]
+	switch r.Method {
+	case http.MethodGet:
+		policy, ok := f.policies[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"_id":"` + id + `","_seq_no":4,"_primary_term":1,"policy":` + string(policy) + `}`))
+	case http.MethodPut:
+		var doc struct {
+			Policy json.RawMessage `json:"policy"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&doc); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.policies[id] = doc.Policy
+		f.puts = append(f.puts, r.URL.RequestURI())
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestIndexLifecycleManager(t *testing.T, srv *fakeISMServer) *IndexLifecycleManager {
+	t.Helper()
+	server := httptest.NewServer(srv)
+	t.Cleanup(server.Close)
+	return NewIndexLifecycleManager(&config.IndexLifecycleConfig{
+		Enabled:              true,
+		OpenSearchURL:        server.URL,
+		Timeout:              5 * time.Second,
+		SyncInterval:         time.Minute,
+		RuntimeIndexPattern:  "container-logs-*",
+		RuntimeWarmAfterDays: 7,
+		RuntimeRetentionDays: 30,
+		AuditIndexPattern:    "audit-logs-*",
+		AuditRetentionDays:   365,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestIndexLifecycleManager_SyncCreatesAndCorrectsDrift(t *testing.T) {
+	srv := &fakeISMServer{policies: map[string]json.RawMessage{}}
+	m := newTestIndexLifecycleManager(t, srv)
+
+	require.NoError(t, m.Sync(context.Background()))
+	assert.Equal(t, []string{
+		"/_plugins/_ism/policies/openchoreo-runtime-logs",
+		"/_plugins/_ism/policies/openchoreo-audit-logs",
+	}, srv.puts)
+
+	// OpenSearch decorates stored policies with read-only fields; these must not count as drift.
+	srv.policies["openchoreo-runtime-logs"] = decoratePolicy(t, srv.policies["openchoreo-runtime-logs"])
+	srv.puts = nil
+	require.NoError(t, m.Sync(context.Background()))
+	assert.Empty(t, srv.puts)
+
+	// A manual edit is reverted with optimistic concurrency control.
+	srv.policies["openchoreo-audit-logs"] = json.RawMessage(`{"description":"edited","default_state":"hot","states":[]}`)
+	require.NoError(t, m.Sync(context.Background()))
+	assert.Equal(t, []string{"/_plugins/_ism/policies/openchoreo-audit-logs?if_primary_term=1&if_seq_no=4"}, srv.puts)
+
+	var restored ismPolicy
+	require.NoError(t, json.Unmarshal(srv.policies["openchoreo-audit-logs"], &restored))
+	assert.Equal(t, buildISMPolicy(config.IndexLifecyclePolicy{
+		Category: "audit", IndexPattern: "audit-logs-*", RetentionDays: 365,
+	}), restored)
+}
+
+func TestIndexLifecycleManager_SyncReportsErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":"no permissions"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	m := NewIndexLifecycleManager(&config.IndexLifecycleConfig{
+		OpenSearchURL:        server.URL,
+		Timeout:              5 * time.Second,
+		RuntimeIndexPattern:  "container-logs-*",
+		RuntimeRetentionDays: 30,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	err := m.Sync(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "openchoreo-runtime-logs")
+	assert.Contains(t, err.Error(), "status 403")
+}
+
+func TestBuildISMPolicy(t *testing.T) {
+	withWarm := buildISMPolicy(config.IndexLifecyclePolicy{
+		Category: "build", IndexPattern: "build-logs-*", WarmAfterDays: 7, RetentionDays: 30,
+	})
+	require.Len(t, withWarm.States, 3)
+	assert.Equal(t, "hot", withWarm.DefaultState)
+	assert.Equal(t, "7d", withWarm.States[0].Transitions[0].Conditions.MinIndexAge)
+	assert.NotNil(t, withWarm.States[1].Actions[0].ReadOnly)
+	assert.Equal(t, "30d", withWarm.States[1].Transitions[0].Conditions.MinIndexAge)
+	assert.NotNil(t, withWarm.States[2].Actions[0].Delete)
+	assert.Equal(t, []string{"build-logs-*"}, withWarm.ISMTemplate[0].IndexPatterns)
+
+	withoutWarm := buildISMPolicy(config.IndexLifecyclePolicy{
+		Category: "audit", IndexPattern: "audit-logs-*", RetentionDays: 365,
+	})
+	require.Len(t, withoutWarm.States, 2)
+	assert.Equal(t, "delete", withoutWarm.States[0].Transitions[0].StateName)
+	assert.Equal(t, "365d", withoutWarm.States[0].Transitions[0].Conditions.MinIndexAge)
+}
+
+// decoratePolicy adds the fields OpenSearch returns on read but which are not part of the desired policy.
+func decoratePolicy(t *testing.T, raw json.RawMessage) json.RawMessage {
+	t.Helper()
+	var policy map[string]any
+	require.NoError(t, json.Unmarshal(raw, &policy))
+	policy["policy_id"] = "openchoreo-runtime-logs"
+	policy["last_updated_time"] = 1700000000000
+	policy["error_notification"] = nil
+	for _, s := range policy["states"].([]any) {
+		for _, a := range s.(map[string]any)["actions"].([]any) {
+			a.(map[string]any)["retry"] = map[string]any{"count": 3, "backoff": "exponential", "delay": "1m"}
+		}
+	}
+	for _, tmpl := range policy["ism_template"].([]any) {
+		tmpl.(map[string]any)["last_updated_time"] = 1700000000000
+	}
+	out, err := json.Marshal(policy)
+	require.NoError(t, err)
+	return out
+}
@@ -31,6 +31,73 @@ type MetricsQuerier interface {
 	QueryRuntimeTopology(ctx context.Context, req *types.RuntimeTopologyRequest) (*types.RuntimeTopologyResponse, error)
 }
 
+// SLOEvaluator is the interface for evaluating service level objectives.
+type SLOEvaluator interface {
+	EvaluateErrorBudget(ctx context.Context, req *types.ErrorBudgetRequest) (*types.ErrorBudgetResponse, error)
+}
+
+// ResourceRecommender is the interface for recommending resource requests and limits.
+type ResourceRecommender interface {
+	RecommendResources(ctx context.Context, req *types.ResourceRecommendationRequest) (*types.ResourceRecommendationResponse, error)
+}
+
+// AnomalyDetector is the interface for evaluating component metrics against their seasonal baseline.
+type AnomalyDetector interface {
+	DetectAnomalies(ctx context.Context, req *types.AnomalyDetectionRequest) (*types.AnomalyDetectionResponse, error)
+}
+
+// AnomalyEventRaiser raises detected anomalies through the alerting pipeline and returns the alert ID.
+type AnomalyEventRaiser interface {
+	RaiseAnomalyEvent(ctx context.Context, event *types.AnomalyEvent) (string, error)
+}
+
+// PromotionNotifier sends component promotions to notification channels.
+type PromotionNotifier interface {
+	NotifyPromotion(ctx context.Context, req *types.PromotionNotificationRequest) error
+}
+
+// CorrelationQuerier is the interface for pivoting from a trace or log entry to its linked signals.
+type CorrelationQuerier interface {
+	QueryCorrelations(ctx context.Context, req *types.CorrelationQueryRequest) (*types.CorrelationQueryResponse, error)
+}
+
+// FederatedQuerier is the interface for querying logs and metrics across the observability
+// planes that serve the environments of a component.
+type FederatedQuerier interface {
+	QueryFederatedLogs(ctx context.Context, req *types.LogsQueryRequest) (*types.FederatedLogsQueryResponse, error)
+	QueryFederatedMetrics(ctx context.Context, req *types.MetricsQueryRequest) (*types.FederatedMetricsQueryResponse, error)
+}
+
+// LogExporter is the interface for registering the log exports run by the observer.
+type LogExporter interface {
+	UpsertExport(ctx context.Context, namespace, name string, req *types.ExportRequest) (*types.ExportStatus, error)
+	DeleteExport(ctx context.Context, namespace, name string) error
+}
+
+// SavedQueryService is the interface for managing the saved log and trace query templates of a namespace.
+type SavedQueryService interface {
+	CreateSavedQuery(ctx context.Context, req *types.SavedQueryRequest) (*types.SavedQuery, error)
+	GetSavedQuery(ctx context.Context, namespace, name string) (*types.SavedQuery, error)
+	ListSavedQueries(ctx context.Context, namespace string) (*types.SavedQueryListResponse, error)
+	DeleteSavedQuery(ctx context.Context, namespace, name string) error
+}
+
+// IdleWorkloadReporter is the interface for reading the idle workload report.
+type IdleWorkloadReporter interface {
+	IdleWorkloads(ctx context.Context, req *types.IdleWorkloadsRequest) (*types.IdleWorkloadsResponse, error)
+}
+
+// LogMetricsQuerier is the interface for querying metrics derived from log lines.
+type LogMetricsQuerier interface {
+	QueryLogMetric(ctx context.Context, req *types.LogMetricQueryRequest) (*types.LogMetricQueryResponse, error)
+}
+
+// RawQuerier is the interface for running namespace scoped PromQL and OpenSearch queries.
+type RawQuerier interface {
+	QueryPromQL(ctx context.Context, req *types.PromQLQueryRequest) (*types.PromQLQueryResponse, error)
+	SearchOpenSearch(ctx context.Context, req *types.OpenSearchQueryRequest) (*types.OpenSearchQueryResponse, error)
+}
+
 // TracesQuerier is the interface for querying traces and spans.
 type TracesQuerier interface {
 	QueryTraces(ctx context.Context, req *types.TracesQueryRequest) (*types.TracesQueryResponse, error)
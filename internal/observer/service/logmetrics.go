@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/logmetric"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// ErrLogMetricsInvalidRequest indicates the log metric request is malformed. Maps to HTTP 400.
+var ErrLogMetricsInvalidRequest = errors.New("invalid log metric request")
+
+// LogMetricsService derives counters and histograms from component logs at query time.
+// Log lines are fetched through the logs service, so the metric values are bounded by
+// the maximum number of lines a single logs query may return.
+type LogMetricsService struct {
+	logs     LogsQuerier
+	maxLines int
+	logger   *slog.Logger
+}
+
+var _ LogMetricsQuerier = (*LogMetricsService)(nil)
+
+// NewLogMetricsService creates a new LogMetricsService. Pass an authz-wrapped LogsQuerier so
+// that callers need view access to the component logs. maxLines caps the log lines fetched
+// per query.
+func NewLogMetricsService(logs LogsQuerier, maxLines int, logger *slog.Logger) *LogMetricsService {
+	return &LogMetricsService{logs: logs, maxLines: maxLines, logger: logger}
+}
+
+// QueryLogMetric evaluates the metric definition over the component logs in the requested range.
+func (s *LogMetricsService) QueryLogMetric(
+	ctx context.Context,
+	req *types.LogMetricQueryRequest,
+) (*types.LogMetricQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request must not be nil", ErrLogMetricsInvalidRequest)
+	}
+	extractor, err := logmetric.Compile(logmetric.Definition{
+		Type:    logmetric.Type(req.Metric.Type),
+		Pattern: req.Metric.Pattern,
+		Buckets: req.Metric.Buckets,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: metric: %w", ErrLogMetricsInvalidRequest, err)
+	}
+	start, err := time.Parse(time.RFC3339, req.StartTime)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid startTime: %w", ErrLogMetricsInvalidRequest, err)
+	}
+	end, err := time.Parse(time.RFC3339, req.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid endTime: %w", ErrLogMetricsInvalidRequest, err)
+	}
+	step := end.Sub(start)
+	if req.Step != nil && *req.Step != "" {
+		step, err = time.ParseDuration(*req.Step)
+		if err != nil || step <= 0 {
+			return nil, fmt.Errorf("%w: invalid step %q", ErrLogMetricsInvalidRequest, *req.Step)
+		}
+	}
+
+	scope := req.SearchScope
+	logs, err := s.logs.QueryLogs(ctx, &types.LogsQueryRequest{
+		SearchScope:  &types.SearchScope{Component: &scope},
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		SearchPhrase: req.Metric.SearchPhrase,
+		LogLevels:    req.Metric.LogLevels,
+		Limit:        s.maxLines,
+		SortOrder:    "asc",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]logmetric.Line, 0, len(logs.Logs))
+	for _, entry := range logs.Logs {
+		ts, err := time.Parse(time.RFC3339Nano, entry.Timestamp)
+		if err != nil {
+			s.logger.Debug("Skipping log entry with unparseable timestamp", "timestamp", entry.Timestamp)
+			continue
+		}
+		lines = append(lines, logmetric.Line{Timestamp: ts, Text: entry.Log})
+	}
+
+	series := extractor.Aggregate(lines, start, end, step)
+	resp := &types.LogMetricQueryResponse{
+		Name:           req.Metric.Name,
+		Type:           req.Metric.Type,
+		Count:          make([]types.MetricsTimeSeriesItem, 0, len(series.Steps)),
+		EvaluatedLines: len(logs.Logs),
+		Truncated:      logs.Total > len(logs.Logs),
+	}
+	histogram := series.BucketCounts != nil
+	for _, st := range series.Steps {
+		resp.Count = append(resp.Count, types.MetricsTimeSeriesItem{Timestamp: st.Start, Value: float64(st.Count)})
+		if !histogram {
+			continue
+		}
+		resp.Sum = append(resp.Sum, types.MetricsTimeSeriesItem{Timestamp: st.Start, Value: st.Sum()})
+		for _, q := range []struct {
+			quantile float64
+			out      *[]types.MetricsTimeSeriesItem
+		}{{0.5, &resp.P50}, {0.9, &resp.P90}, {0.99, &resp.P99}} {
+			if v, ok := st.Quantile(q.quantile); ok {
+				*q.out = append(*q.out, types.MetricsTimeSeriesItem{Timestamp: st.Start, Value: v})
+			}
+		}
+	}
+	if histogram {
+		bounds := extractor.Buckets()
+		for i, count := range series.BucketCounts {
+			upper := "+Inf"
+			if i < len(bounds) {
+				upper = strconv.FormatFloat(bounds[i], 'g', -1, 64)
+			}
+			resp.Buckets = append(resp.Buckets, types.LogMetricBucket{UpperBound: upper, Count: count})
+		}
+	}
+
+	if resp.Truncated {
+		s.logger.Warn("Log metric evaluated over a truncated set of log lines",
+			"metric", req.Metric.Name, "evaluated", len(logs.Logs), "total", logs.Total)
+	}
+	return resp, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func newLogMetricQueryRequest(metric types.LogMetricDefinition) *types.LogMetricQueryRequest {
+	step := "1m"
+	return &types.LogMetricQueryRequest{
+		SearchScope: types.ComponentSearchScope{
+			Namespace:   "ns",
+			Project:     "proj",
+			Component:   "api",
+			Environment: "prod",
+		},
+		StartTime: "2026-01-01T00:00:00Z",
+		EndTime:   "2026-01-01T00:02:00Z",
+		Step:      &step,
+		Metric:    metric,
+	}
+}
+
+func TestLogMetricsService_QueryLogMetricHistogram(t *testing.T) {
+	logs := mocks.NewMockLogsQuerier(t)
+	logs.EXPECT().QueryLogs(mock.Anything, mock.MatchedBy(func(req *types.LogsQueryRequest) bool {
+		return req.SearchScope.Component.Component == "api" &&
+			req.SearchPhrase == "duration" &&
+			req.Limit == 100 &&
+			req.SortOrder == "asc"
+	})).Return(&types.LogsQueryResponse{
+		Logs: []types.LogEntry{
+			{Timestamp: "2026-01-01T00:00:10Z", Log: "GET /orders duration=0.2"},
+			{Timestamp: "2026-01-01T00:00:20.5Z", Log: "GET /orders duration=0.8"},
+			{Timestamp: "2026-01-01T00:01:30Z", Log: "GET /orders duration=3"},
+			{Timestamp: "2026-01-01T00:01:40Z", Log: "GET /health"},
+			{Timestamp: "not-a-time", Log: "GET /orders duration=1"},
+		},
+		Total: 8,
+	}, nil)
+
+	svc := NewLogMetricsService(logs, 100, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	resp, err := svc.QueryLogMetric(context.Background(), newLogMetricQueryRequest(types.LogMetricDefinition{
+		Name:         "order_latency",
+		Type:         "histogram",
+		Pattern:      `duration=(?P<value>[0-9.]+)`,
+		SearchPhrase: "duration",
+		Buckets:      []float64{0.5, 1},
+	}))
+	require.NoError(t, err)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []types.MetricsTimeSeriesItem{
+		{Timestamp: start, Value: 2},
+		{Timestamp: start.Add(time.Minute), Value: 1},
+	}, resp.Count)
+	require.Len(t, resp.Sum, 2)
+	assert.InDelta(t, 1.0, resp.Sum[0].Value, 1e-9)
+	assert.InDelta(t, 0.2, resp.P50[0].Value, 1e-9)
+	assert.InDelta(t, 3.0, resp.P99[1].Value, 1e-9)
+	assert.Equal(t, []types.LogMetricBucket{
+		{UpperBound: "0.5", Count: 1},
+		{UpperBound: "1", Count: 2},
+		{UpperBound: "+Inf", Count: 3},
+	}, resp.Buckets)
+	assert.Equal(t, 5, resp.EvaluatedLines)
+	assert.True(t, resp.Truncated)
+}
+
+func TestLogMetricsService_QueryLogMetricCounter(t *testing.T) {
+	logs := mocks.NewMockLogsQuerier(t)
+	logs.EXPECT().QueryLogs(mock.Anything, mock.Anything).Return(&types.LogsQueryResponse{
+		Logs: []types.LogEntry{
+			{Timestamp: "2026-01-01T00:00:10Z", Log: "payment failed"},
+			{Timestamp: "2026-01-01T00:01:10Z", Log: "payment ok"},
+		},
+		Total: 2,
+	}, nil)
+
+	svc := NewLogMetricsService(logs, 100, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	resp, err := svc.QueryLogMetric(context.Background(), newLogMetricQueryRequest(types.LogMetricDefinition{
+		Name:    "payment_failures",
+		Type:    "counter",
+		Pattern: "failed",
+	}))
+	require.NoError(t, err)
+
+	require.Len(t, resp.Count, 2)
+	assert.InDelta(t, 1.0, resp.Count[0].Value, 1e-9)
+	assert.InDelta(t, 0.0, resp.Count[1].Value, 1e-9)
+	assert.Nil(t, resp.Sum)
+	assert.Nil(t, resp.Buckets)
+	assert.False(t, resp.Truncated)
+}
+
+func TestLogMetricsService_QueryLogMetricErrors(t *testing.T) {
+	t.Run("invalid pattern", func(t *testing.T) {
+		svc := NewLogMetricsService(mocks.NewMockLogsQuerier(t), 100, slog.New(slog.NewTextHandler(io.Discard, nil)))
+		_, err := svc.QueryLogMetric(context.Background(), newLogMetricQueryRequest(types.LogMetricDefinition{
+			Name:    "latency",
+			Type:    "histogram",
+			Pattern: `duration=([0-9.]+)`,
+		}))
+		assert.ErrorIs(t, err, ErrLogMetricsInvalidRequest)
+	})
+
+	t.Run("logs error is propagated", func(t *testing.T) {
+		logs := mocks.NewMockLogsQuerier(t)
+		logs.EXPECT().QueryLogs(mock.Anything, mock.Anything).Return(nil, ErrLogsRetrieval)
+
+		svc := NewLogMetricsService(logs, 100, slog.New(slog.NewTextHandler(io.Discard, nil)))
+		_, err := svc.QueryLogMetric(context.Background(), newLogMetricQueryRequest(types.LogMetricDefinition{
+			Name:    "failures",
+			Type:    "counter",
+			Pattern: "failed",
+		}))
+		assert.True(t, errors.Is(err, ErrLogsRetrieval))
+	})
+}
@@ -128,6 +128,18 @@ func (s *LogsService) queryComponentLogs(
 		SortOrder:     req.SortOrder,
 	}
 
+	// With a log parsing profile, levels come from the parsed entries rather than the backend,
+	// and joining and filtering happen after retrieval, so a full window of lines is fetched.
+	parser := s.logParser(ctx, req.SearchScope.Component)
+	postProcess := parser != nil || len(req.FieldFilters) > 0
+	var levels []string
+	if parser != nil {
+		levels, params.LogLevels = req.LogLevels, nil
+	}
+	if postProcess {
+		params.Limit = config.MaxLimit
+	}
+
 	result, err := s.logsAdapter.GetComponentApplicationLogs(ctx, params)
 	if err != nil {
 		s.logger.Error("Failed to get component logs from adapter", "error", err)
@@ -141,7 +153,49 @@ func (s *LogsService) queryComponentLogs(
 		"count", len(result.Logs),
 		"total", result.TotalCount)
 
-	return s.convertComponentLogsToResponse(result), nil
+	if dropped := filterLogsToScope(result, scope); dropped > 0 {
+		s.logger.Warn("Dropped log entries outside the query scope",
+			"namespaceName", scope.NamespaceName,
+			"dropped", dropped)
+	}
+
+	if parser != nil && parser.Multiline() {
+		result.Logs = joinMultilineEntries(result.Logs, parser, req.SortOrder)
+	}
+	resp := s.convertComponentLogsToResponse(result)
+	if postProcess {
+		resp.Logs = applyLogParsing(resp.Logs, parser, levels, req.FieldFilters)
+		resp.Total = len(resp.Logs)
+		if req.Limit > 0 && len(resp.Logs) > req.Limit {
+			resp.Logs = resp.Logs[:req.Limit]
+		}
+	}
+	return resp, nil
+}
+
+// filterLogsToScope removes entries that the adapter returned for another namespace, project,
+// component or environment than the query scope, so that a misbehaving backend cannot leak
+// logs across tenants. It returns the number of entries removed.
+func filterLogsToScope(result *observability.ComponentApplicationLogsResult, scope *internalSearchScope) int {
+	outside := func(got, want string) bool {
+		return want != "" && got != "" && got != want
+	}
+	kept := result.Logs[:0]
+	for _, entry := range result.Logs {
+		if outside(entry.NamespaceName, scope.NamespaceName) ||
+			outside(entry.ProjectID, scope.ProjectUID) ||
+			outside(entry.ComponentID, scope.ComponentUID) ||
+			outside(entry.EnvironmentID, scope.EnvironmentUID) {
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	dropped := len(result.Logs) - len(kept)
+	result.Logs = kept
+	if dropped > 0 {
+		result.TotalCount = max(result.TotalCount-dropped, len(kept))
+	}
+	return dropped
 }
 
 // queryWorkflowLogs handles workflow log queries
@@ -245,8 +299,12 @@ func resolveSearchScope(
 		return nil, fmt.Errorf("search scope is required")
 	}
 
+	// Every query is confined to a single namespace, the tenant boundary.
 	if searchScope.Workflow != nil {
 		scope := searchScope.Workflow
+		if scope.Namespace == "" {
+			return nil, fmt.Errorf("namespace is required")
+		}
 		return &internalSearchScope{
 			NamespaceName:   scope.Namespace,
 			WorkflowRunName: scope.WorkflowRunName,
@@ -260,6 +318,9 @@ func resolveSearchScope(
 			return nil, fmt.Errorf("resource UID resolver is not initialized")
 		}
 		scope := searchScope.Component
+		if scope.Namespace == "" {
+			return nil, fmt.Errorf("namespace is required")
+		}
 		projectUID, err := resolver.GetProjectUID(ctx, scope.Namespace, scope.Project)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,122 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/openchoreo/openchoreo/internal/logparsing"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/pkg/observability"
+)
+
+// logParser returns the compiled log parsing profile of the component in the search scope, or
+// nil when the scope is not a single component, the component has no profile or the profile
+// cannot be used. Logs are then returned as the backend parsed them.
+func (s *LogsService) logParser(ctx context.Context, scope *types.ComponentSearchScope) *logparsing.Parser {
+	if scope == nil || scope.Component == "" || s.resolver == nil {
+		return nil
+	}
+	profile, err := s.resolver.GetComponentLogParsingProfile(ctx, scope.Namespace, scope.Component)
+	if err != nil {
+		s.logger.Warn("Failed to fetch log parsing profile, returning unparsed logs",
+			"namespace", scope.Namespace,
+			"component", scope.Component,
+			"error", err)
+		return nil
+	}
+	if profile == nil {
+		return nil
+	}
+	parser, err := logparsing.Compile(profile)
+	if err != nil {
+		s.logger.Warn("Ignoring invalid log parsing profile",
+			"namespace", scope.Namespace,
+			"component", scope.Component,
+			"error", err)
+		return nil
+	}
+	return parser
+}
+
+// joinMultilineEntries appends continuation lines to the entry they belong to. Lines are joined
+// per pod and container in chronological order and the entries are returned in the requested
+// sort order. A continuation line whose first line is outside the queried window stays an
+// entry of its own.
+func joinMultilineEntries(
+	logs []observability.LogEntry,
+	parser *logparsing.Parser,
+	sortOrder string,
+) []observability.LogEntry {
+	ordered := slices.Clone(logs)
+	if sortOrder != "asc" {
+		slices.Reverse(ordered)
+	}
+	slices.SortStableFunc(ordered, func(a, b observability.LogEntry) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+
+	type openEntry struct {
+		index int
+		lines int
+	}
+	open := map[string]*openEntry{}
+	joined := make([]observability.LogEntry, 0, len(ordered))
+	for _, entry := range ordered {
+		key := entry.PodName + "/" + entry.ContainerName
+		if cur := open[key]; cur != nil && cur.lines < parser.MaxLines() && !parser.IsStart(entry.Log) {
+			joined[cur.index].Log += "\n" + entry.Log
+			cur.lines++
+			continue
+		}
+		joined = append(joined, entry)
+		open[key] = &openEntry{index: len(joined) - 1, lines: 1}
+	}
+
+	if sortOrder != "asc" {
+		slices.Reverse(joined)
+	}
+	return joined
+}
+
+// applyLogParsing sets the level and fields parsed from each entry, then drops the entries whose
+// level is not in levels or whose fields do not match the filters. Without a parser, entries
+// have no fields and match no field filter.
+func applyLogParsing(
+	logs []types.LogEntry,
+	parser *logparsing.Parser,
+	levels []string,
+	filters map[string]string,
+) []types.LogEntry {
+	kept := logs[:0]
+	for _, entry := range logs {
+		if parser != nil {
+			res := parser.Parse(entry.Log)
+			if res.Level != "" {
+				entry.Level = res.Level
+			}
+			if len(res.Fields) > 0 {
+				entry.Fields = res.Fields
+			}
+		}
+		if len(levels) > 0 && !slices.Contains(levels, entry.Level) {
+			continue
+		}
+		if !matchesFieldFilters(entry.Fields, filters) {
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	return kept
+}
+
+func matchesFieldFilters(fields, filters map[string]string) bool {
+	for name, want := range filters {
+		if got, ok := fields[name]; !ok || got != want {
+			return false
+		}
+	}
+	return true
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	choreoapis "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/logparsing"
 	"github.com/openchoreo/openchoreo/internal/observer/config"
 	"github.com/openchoreo/openchoreo/internal/observer/types"
 	"github.com/openchoreo/openchoreo/pkg/observability"
@@ -284,7 +286,7 @@ func TestLogsService_QueryLogs_ComponentScope_Success(t *testing.T) {
 		case strings.Contains(r.URL.Path, "/projects/"):
 			_, _ = w.Write([]byte(uidResponse(sampleProjectUID)))
 		case strings.Contains(r.URL.Path, "/components/"):
-			_, _ = w.Write([]byte(uidResponse(sampleComponentUID)))
+			_, _ = w.Write([]byte(componentResponse(sampleComponentUID, "proj")))
 		case strings.Contains(r.URL.Path, "/environments/"):
 			_, _ = w.Write([]byte(uidResponse(sampleEnvironmentUID)))
 		default:
@@ -394,3 +396,131 @@ func TestLogsService_ConvertWorkflowLogsToResponse(t *testing.T) {
 	assert.Equal(t, 2, resp.Total)
 	assert.Equal(t, 7, resp.TookMs)
 }
+
+func TestLogsService_QueryLogs_RequiresNamespace(t *testing.T) {
+	t.Parallel()
+	adapter := &fakeLogsAdapter{}
+	svc := newLogsServiceForTest(t, adapter)
+
+	_, err := svc.QueryLogs(context.Background(), &types.LogsQueryRequest{
+		SearchScope: &types.SearchScope{
+			Workflow: &types.WorkflowSearchScope{WorkflowRunName: "run-1"},
+		},
+		StartTime: "2026-03-07T10:00:00Z",
+		EndTime:   "2026-03-07T11:00:00Z",
+	})
+	require.ErrorIs(t, err, ErrLogsResolveSearchScope)
+	assert.False(t, adapter.workflowCalled)
+}
+
+func TestFilterLogsToScope(t *testing.T) {
+	t.Parallel()
+	scope := &internalSearchScope{
+		NamespaceName:  "acme",
+		ProjectUID:     sampleProjectUID,
+		ComponentUID:   sampleComponentUID,
+		EnvironmentUID: sampleEnvironmentUID,
+	}
+	result := &observability.ComponentApplicationLogsResult{
+		Logs: []observability.LogEntry{
+			{Log: "in scope", NamespaceName: "acme", ProjectID: sampleProjectUID,
+				ComponentID: sampleComponentUID, EnvironmentID: sampleEnvironmentUID},
+			{Log: "no metadata"},
+			{Log: "other namespace", NamespaceName: "globex"},
+			{Log: "other component", NamespaceName: "acme", ComponentID: "other-component"},
+			{Log: "other environment", EnvironmentID: "other-environment"},
+		},
+		TotalCount: 5,
+	}
+
+	dropped := filterLogsToScope(result, scope)
+
+	assert.Equal(t, 3, dropped)
+	require.Len(t, result.Logs, 2)
+	assert.Equal(t, "in scope", result.Logs[0].Log)
+	assert.Equal(t, "no metadata", result.Logs[1].Log)
+	assert.Equal(t, 2, result.TotalCount)
+}
+
+func TestLogsService_QueryLogs_AppliesLogParsingProfile(t *testing.T) {
+	t.Parallel()
+	base := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+
+	tokenSrv := newAlwaysOKTokenServer(t)
+	defer tokenSrv.Close()
+
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.Contains(r.URL.Path, "/components/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"metadata":{"uid":"` + sampleComponentUID + `"},"spec":{"owner":{"projectName":"proj"},` +
+			`"logParsing":{"format":"JSON","fields":{"level":"severity","logger":"logger"},` +
+			`"multiline":{"startPattern":"^\\{"}}}}`))
+	}))
+	defer apiSrv.Close()
+
+	resolver := newTestResolver(t, apiSrv, tokenSrv, &config.UIDResolverConfig{MaxAuthRetry: 1})
+	adapter := &fakeLogsAdapter{
+		componentResult: &observability.ComponentApplicationLogsResult{
+			// Newest first, as returned for sortOrder desc.
+			Logs: []observability.LogEntry{
+				{Timestamp: base.Add(3 * time.Second), Log: `{"severity":"info","logger":"web"}`, PodName: "p1"},
+				{Timestamp: base.Add(2 * time.Second), Log: "\tat Billing.charge(Billing.java:42)", PodName: "p1"},
+				{Timestamp: base.Add(time.Second), Log: `{"severity":"error","logger":"billing"}`, PodName: "p1"},
+				{Timestamp: base, Log: `{"severity":"warning","logger":"billing"}`, PodName: "p1"},
+			},
+			TotalCount: 4,
+		},
+	}
+	svc, err := NewLogsService(adapter, resolver, &config.Config{},
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+
+	resp, err := svc.QueryLogs(context.Background(), &types.LogsQueryRequest{
+		SearchScope: &types.SearchScope{
+			Component: &types.ComponentSearchScope{Namespace: "ns", Component: "comp"},
+		},
+		StartTime:    "2026-03-07T10:00:00Z",
+		EndTime:      "2026-03-07T11:00:00Z",
+		LogLevels:    []string{"ERROR", "WARN"},
+		FieldFilters: map[string]string{"logger": "billing"},
+		Limit:        1,
+		SortOrder:    "desc",
+	})
+	require.NoError(t, err)
+
+	assert.Nil(t, adapter.lastComponent.LogLevels, "levels are filtered after parsing")
+	assert.Equal(t, config.MaxLimit, adapter.lastComponent.Limit)
+	assert.Equal(t, 2, resp.Total)
+	require.Len(t, resp.Logs, 1)
+	assert.Equal(t, "ERROR", resp.Logs[0].Level)
+	assert.Equal(t, `{"severity":"error","logger":"billing"}`+"\n\tat Billing.charge(Billing.java:42)", resp.Logs[0].Log)
+	assert.Equal(t, map[string]string{"level": "error", "logger": "billing"}, resp.Logs[0].Fields)
+}
+
+func TestJoinMultilineEntries(t *testing.T) {
+	t.Parallel()
+	base := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	parser, err := logparsing.Compile(&choreoapis.LogParsingProfile{
+		Format:    choreoapis.LogFormatJSON,
+		Multiline: &choreoapis.LogMultilineConfig{StartPattern: `^\S`, MaxLines: 2},
+	})
+	require.NoError(t, err)
+
+	logs := []observability.LogEntry{
+		{Timestamp: base, Log: "  orphan", PodName: "p1"},
+		{Timestamp: base.Add(time.Second), Log: "first", PodName: "p1"},
+		{Timestamp: base.Add(time.Second), Log: "other", PodName: "p2"},
+		{Timestamp: base.Add(2 * time.Second), Log: "  cont 1", PodName: "p1"},
+		{Timestamp: base.Add(3 * time.Second), Log: "  cont 2", PodName: "p1"},
+	}
+	joined := joinMultilineEntries(logs, parser, "asc")
+
+	got := make([]string, 0, len(joined))
+	for _, e := range joined {
+		got = append(got, e.Log)
+	}
+	assert.Equal(t, []string{"  orphan", "first\n  cont 1", "other", "  cont 2"}, got)
+}
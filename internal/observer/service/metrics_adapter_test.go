@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -25,6 +26,9 @@ import (
 // that returns the specified UIDs. The returnErr function, if non-nil, is called
 // with the request path to determine whether to return an error for that path.
 func newMockUIDResolver(projectUID, componentUID, environmentUID string, returnErr func(path string) bool) (*ResourceUIDResolver, func()) {
+	// Components are reported as owned by the project looked up before them.
+	var project atomic.Value
+	project.Store("")
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Handle OAuth token endpoint
 		if strings.Contains(r.URL.Path, "/token") {
@@ -63,6 +67,14 @@ func newMockUIDResolver(projectUID, componentUID, environmentUID string, returnE
 				"uid": uid,
 			},
 		}
+		if _, name, ok := strings.Cut(r.URL.Path, "/projects/"); ok {
+			project.Store(name)
+		}
+		if strings.Contains(r.URL.Path, "/components/") {
+			resp["spec"] = map[string]interface{}{
+				"owner": map[string]interface{}{"projectName": project.Load()},
+			}
+		}
 		_ = json.NewEncoder(w).Encode(resp)
 	}))
 
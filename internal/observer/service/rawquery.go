@@ -0,0 +1,267 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/rawquery"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+var (
+	// ErrRawQueryInvalidRequest indicates the query is malformed, exceeds a cost limit or was
+	// rejected by the backend. Maps to HTTP 400.
+	ErrRawQueryInvalidRequest = errors.New("invalid raw query request")
+	// ErrRawQueryNotConfigured indicates that the requested backend has no URL configured.
+	ErrRawQueryNotConfigured = errors.New("raw query backend is not configured")
+	// ErrRawQueryRetrieval indicates the backend could not be reached or failed.
+	ErrRawQueryRetrieval = errors.New("raw query retrieval failed")
+)
+
+// rawQueryMaxResponseBytes bounds the backend response read into memory.
+const rawQueryMaxResponseBytes = 32 << 20
+
+// RawQueryService runs raw PromQL and OpenSearch queries after pinning them to the
+// requested namespace and checking them against the configured cost limits.
+type RawQueryService struct {
+	config     *config.RawQueryConfig
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+var _ RawQuerier = (*RawQueryService)(nil)
+
+// NewRawQueryService creates a new RawQueryService instance
+func NewRawQueryService(cfg *config.RawQueryConfig, logger *slog.Logger) *RawQueryService {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: cfg.TLSInsecureSkipVerify, //nolint:gosec // G402: Configurable for development
+		},
+	}
+	return &RawQueryService{
+		config: cfg,
+		httpClient: &http.Client{
+			Transport: transport,
+			// Leave room for the backend to report its own timeout.
+			Timeout: cfg.Timeout + 5*time.Second,
+		},
+		logger: logger,
+	}
+}
+
+type prometheusResponse struct {
+	Status    string   `json:"status"`
+	ErrorType string   `json:"errorType"`
+	Error     string   `json:"error"`
+	Warnings  []string `json:"warnings"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// QueryPromQL runs a PromQL instant query, or a range query when a step is given.
+func (s *RawQueryService) QueryPromQL(ctx context.Context, req *types.PromQLQueryRequest) (*types.PromQLQueryResponse, error) {
+	if s.config.PrometheusURL == "" {
+		return nil, fmt.Errorf("%w: prometheus URL is not set", ErrRawQueryNotConfigured)
+	}
+
+	end, err := time.Parse(time.RFC3339, req.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("%w: endTime must be an RFC3339 timestamp", ErrRawQueryInvalidRequest)
+	}
+	if err := rawquery.ValidateRanges(req.Query, s.config.MaxTimeRange); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRawQueryInvalidRequest, err)
+	}
+	query, err := rawquery.EnforceLabel(req.Query, s.config.PrometheusNamespaceLabel, req.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRawQueryInvalidRequest, err)
+	}
+
+	form := url.Values{}
+	form.Set("query", query)
+	form.Set("timeout", s.config.Timeout.String())
+	path := "/api/v1/query"
+	if req.Step == "" {
+		form.Set("time", formatPrometheusTime(end))
+	} else {
+		start, err := s.parseTimeRange(req.StartTime, end)
+		if err != nil {
+			return nil, err
+		}
+		step, err := time.ParseDuration(req.Step)
+		if err != nil || step <= 0 {
+			return nil, fmt.Errorf("%w: step must be a positive duration", ErrRawQueryInvalidRequest)
+		}
+		path = "/api/v1/query_range"
+		form.Set("start", formatPrometheusTime(start))
+		form.Set("end", formatPrometheusTime(end))
+		form.Set("step", strconv.FormatFloat(step.Seconds(), 'f', -1, 64))
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.PrometheusURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var promResp prometheusResponse
+	status, err := s.do(httpReq, &promResp)
+	if err != nil {
+		return nil, err
+	}
+	if promResp.Status != "success" {
+		if status == http.StatusBadRequest || status == http.StatusUnprocessableEntity {
+			return nil, fmt.Errorf("%w: %s", ErrRawQueryInvalidRequest, promResp.Error)
+		}
+		return nil, fmt.Errorf("%w: prometheus returned status %d: %s", ErrRawQueryRetrieval, status, promResp.Error)
+	}
+
+	resp := &types.PromQLQueryResponse{
+		Query:      query,
+		ResultType: promResp.Data.ResultType,
+		Result:     promResp.Data.Result,
+		Warnings:   promResp.Warnings,
+	}
+	if resp.ResultType == "vector" || resp.ResultType == "matrix" {
+		var series []json.RawMessage
+		if err := json.Unmarshal(promResp.Data.Result, &series); err != nil {
+			return nil, fmt.Errorf("%w: failed to decode prometheus result: %w", ErrRawQueryRetrieval, err)
+		}
+		if len(series) > s.config.MaxResults {
+			truncated, err := json.Marshal(series[:s.config.MaxResults])
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode truncated result: %w", err)
+			}
+			resp.Result = truncated
+			resp.Truncated = true
+		}
+	}
+	return resp, nil
+}
+
+type openSearchResponse struct {
+	Took         int             `json:"took"`
+	TimedOut     bool            `json:"timed_out"`
+	Hits         json.RawMessage `json:"hits"`
+	Aggregations json.RawMessage `json:"aggregations"`
+	Error        json.RawMessage `json:"error"`
+}
+
+// SearchOpenSearch runs an OpenSearch search against the indices of the requested log type.
+func (s *RawQueryService) SearchOpenSearch(
+	ctx context.Context,
+	req *types.OpenSearchQueryRequest,
+) (*types.OpenSearchQueryResponse, error) {
+	if s.config.OpenSearchURL == "" {
+		return nil, fmt.Errorf("%w: opensearch URL is not set", ErrRawQueryNotConfigured)
+	}
+	indexPattern := s.config.IndexPattern(req.LogType)
+	if indexPattern == "" {
+		return nil, fmt.Errorf("%w: unknown log type %q", ErrRawQueryInvalidRequest, req.LogType)
+	}
+
+	end, err := time.Parse(time.RFC3339, req.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("%w: endTime must be an RFC3339 timestamp", ErrRawQueryInvalidRequest)
+	}
+	start, err := s.parseTimeRange(req.StartTime, end)
+	if err != nil {
+		return nil, err
+	}
+	body, err := rawquery.ScopeSearch(req.Body, rawquery.SearchScope{
+		NamespaceField: s.config.OpenSearchNamespaceField,
+		Namespace:      req.Namespace,
+		StartTime:      start,
+		EndTime:        end,
+		MaxResults:     s.config.MaxResults,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRawQueryInvalidRequest, err)
+	}
+
+	params := url.Values{}
+	params.Set("timeout", s.config.Timeout.String())
+	params.Set("ignore_unavailable", "true")
+	params.Set("allow_no_indices", "true")
+	searchURL := s.config.OpenSearchURL + "/" + url.PathEscape(indexPattern) + "/_search?" + params.Encode()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, searchURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if s.config.OpenSearchUsername != "" {
+		httpReq.SetBasicAuth(s.config.OpenSearchUsername, s.config.OpenSearchPassword)
+	}
+
+	var osResp openSearchResponse
+	status, err := s.do(httpReq, &osResp)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		if status == http.StatusBadRequest {
+			return nil, fmt.Errorf("%w: %s", ErrRawQueryInvalidRequest, string(osResp.Error))
+		}
+		return nil, fmt.Errorf("%w: opensearch returned status %d: %s", ErrRawQueryRetrieval, status, string(osResp.Error))
+	}
+
+	return &types.OpenSearchQueryResponse{
+		Took:         osResp.Took,
+		TimedOut:     osResp.TimedOut,
+		Hits:         osResp.Hits,
+		Aggregations: osResp.Aggregations,
+	}, nil
+}
+
+// parseTimeRange parses the start of a time range ending at end and checks it against the
+// maximum time range.
+func (s *RawQueryService) parseTimeRange(startTime string, end time.Time) (time.Time, error) {
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: startTime must be an RFC3339 timestamp", ErrRawQueryInvalidRequest)
+	}
+	if end.Before(start) {
+		return time.Time{}, fmt.Errorf("%w: endTime must not be before startTime", ErrRawQueryInvalidRequest)
+	}
+	if end.Sub(start) > s.config.MaxTimeRange {
+		return time.Time{}, fmt.Errorf("%w: time range must not exceed %s", ErrRawQueryInvalidRequest, s.config.MaxTimeRange)
+	}
+	return start, nil
+}
+
+// do sends the request and decodes the JSON response body into out, returning the status
+// code. Error responses of both backends are JSON, so they are decoded as well.
+func (s *RawQueryService) do(req *http.Request, out any) (int, error) {
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrRawQueryRetrieval, err)
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, rawQueryMaxResponseBytes)).Decode(out); err != nil {
+		return 0, fmt.Errorf("%w: failed to decode response with status %d: %w", ErrRawQueryRetrieval, resp.StatusCode, err)
+	}
+	return resp.StatusCode, nil
+}
+
+func formatPrometheusTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixMilli())/1000, 'f', -1, 64)
+}
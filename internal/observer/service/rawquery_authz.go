@@ -0,0 +1,65 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// rawQueryServiceWithAuthz wraps a RawQuerier and requires the rawquery:execute action on
+// the queried namespace. The action is not part of any default role except admin.
+type rawQueryServiceWithAuthz struct {
+	internal RawQuerier
+	pdp      authzcore.PDP
+	logger   *slog.Logger
+}
+
+var _ RawQuerier = (*rawQueryServiceWithAuthz)(nil)
+
+// NewRawQueryServiceWithAuthz wraps the provided RawQuerier with authorization checks.
+func NewRawQueryServiceWithAuthz(s RawQuerier, pdp authzcore.PDP, logger *slog.Logger) RawQuerier {
+	return &rawQueryServiceWithAuthz{internal: s, pdp: pdp, logger: logger}
+}
+
+func (s *rawQueryServiceWithAuthz) QueryPromQL(
+	ctx context.Context,
+	req *types.PromQLQueryRequest,
+) (*types.PromQLQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("promql query request is required")
+	}
+	if err := s.authorize(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+	return s.internal.QueryPromQL(ctx, req)
+}
+
+func (s *rawQueryServiceWithAuthz) SearchOpenSearch(
+	ctx context.Context,
+	req *types.OpenSearchQueryRequest,
+) (*types.OpenSearchQueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("opensearch query request is required")
+	}
+	if err := s.authorize(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+	return s.internal.SearchOpenSearch(ctx, req)
+}
+
+func (s *rawQueryServiceWithAuthz) authorize(ctx context.Context, namespace string) error {
+	resourceType, resourceName, hierarchy := observerAuthz.ComponentScopeAuthz(namespace, "", "")
+	return observerAuthz.CheckAuthorization(
+		ctx, s.logger, s.pdp,
+		observerAuthz.ActionExecuteRawQuery,
+		resourceType, resourceName, hierarchy,
+		authzcore.Context{},
+	)
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func newRawQueryTestService(t *testing.T, handler http.HandlerFunc) *RawQueryService {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewRawQueryService(&config.RawQueryConfig{
+		PrometheusURL:            server.URL,
+		PrometheusNamespaceLabel: "openchoreo_dev_namespace",
+		OpenSearchURL:            server.URL,
+		OpenSearchNamespaceField: "namespace.keyword",
+		Timeout:                  10 * time.Second,
+		MaxTimeRange:             time.Hour,
+		MaxResults:               2,
+		RuntimeIndexPattern:      "container-logs-*",
+	}, testLogger())
+}
+
+func TestRawQueryService_QueryPromQLRange(t *testing.T) {
+	svc := newRawQueryTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query_range", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, `rate(http_requests_total{code="500",openchoreo_dev_namespace="ns"}[5m])`, r.PostForm.Get("query"))
+		assert.Equal(t, "1769857200", r.PostForm.Get("start"))
+		assert.Equal(t, "1769860800", r.PostForm.Get("end"))
+		assert.Equal(t, "60", r.PostForm.Get("step"))
+		assert.Equal(t, "10s", r.PostForm.Get("timeout"))
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"pod":"a"}},{"metric":{"pod":"b"}},{"metric":{"pod":"c"}}]}}`))
+	})
+
+	resp, err := svc.QueryPromQL(context.Background(), &types.PromQLQueryRequest{
+		Namespace: "ns",
+		Query:     `rate(http_requests_total{code="500"}[5m])`,
+		StartTime: "2026-01-31T11:00:00Z",
+		EndTime:   "2026-01-31T12:00:00Z",
+		Step:      "1m",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "matrix", resp.ResultType)
+	assert.True(t, resp.Truncated)
+	assert.JSONEq(t, `[{"metric":{"pod":"a"}},{"metric":{"pod":"b"}}]`, string(resp.Result))
+}
+
+func TestRawQueryService_QueryPromQLInstant(t *testing.T) {
+	svc := newRawQueryTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "1769860800", r.PostForm.Get("time"))
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"scalar","result":[1769860800,"1"]}}`))
+	})
+
+	resp, err := svc.QueryPromQL(context.Background(), &types.PromQLQueryRequest{
+		Namespace: "ns", Query: "scalar(up)", EndTime: "2026-01-31T12:00:00Z",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "scalar", resp.ResultType)
+	assert.False(t, resp.Truncated)
+}
+
+func TestRawQueryService_QueryPromQLBackendRejectsQuery(t *testing.T) {
+	svc := newRawQueryTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+	})
+
+	_, err := svc.QueryPromQL(context.Background(), &types.PromQLQueryRequest{
+		Namespace: "ns", Query: "up", EndTime: "2026-01-31T12:00:00Z",
+	})
+	require.ErrorIs(t, err, ErrRawQueryInvalidRequest)
+	assert.Contains(t, err.Error(), "parse error")
+}
+
+func TestRawQueryService_SearchOpenSearch(t *testing.T) {
+	svc := newRawQueryTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/container-logs-*/_search", r.URL.Path)
+		assert.Equal(t, "10s", r.URL.Query().Get("timeout"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var search map[string]any
+		require.NoError(t, json.Unmarshal(body, &search))
+		assert.Contains(t, string(body), `{"term":{"namespace.keyword":"ns"}}`)
+		_, _ = w.Write([]byte(`{"took":3,"timed_out":false,"hits":{"total":{"value":1},"hits":[{"_source":{"log":"boom"}}]}}`))
+	})
+
+	resp, err := svc.SearchOpenSearch(context.Background(), &types.OpenSearchQueryRequest{
+		Namespace: "ns",
+		LogType:   "runtime",
+		StartTime: "2026-01-31T11:00:00Z",
+		EndTime:   "2026-01-31T12:00:00Z",
+		Body:      json.RawMessage(`{"query":{"match":{"log":"boom"}},"size":1}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Took)
+	assert.Contains(t, string(resp.Hits), "boom")
+	assert.Empty(t, resp.Aggregations)
+}
+
+func TestRawQueryService_CostLimits(t *testing.T) {
+	svc := newRawQueryTestService(t, func(http.ResponseWriter, *http.Request) {
+		t.Error("backend must not be called")
+	})
+	ctx := context.Background()
+
+	_, err := svc.QueryPromQL(ctx, &types.PromQLQueryRequest{
+		Namespace: "ns", Query: "up", StartTime: "2026-01-31T09:00:00Z", EndTime: "2026-01-31T12:00:00Z", Step: "1m",
+	})
+	require.ErrorIs(t, err, ErrRawQueryInvalidRequest, "time range above the limit")
+
+	_, err = svc.QueryPromQL(ctx, &types.PromQLQueryRequest{
+		Namespace: "ns", Query: "rate(up[1d])", EndTime: "2026-01-31T12:00:00Z",
+	})
+	require.ErrorIs(t, err, ErrRawQueryInvalidRequest, "range selector above the limit")
+
+	_, err = svc.SearchOpenSearch(ctx, &types.OpenSearchQueryRequest{
+		Namespace: "ns", LogType: "runtime", StartTime: "2026-01-31T11:00:00Z", EndTime: "2026-01-31T12:00:00Z",
+		Body: json.RawMessage(`{"size":3}`),
+	})
+	require.ErrorIs(t, err, ErrRawQueryInvalidRequest, "size above the limit")
+
+	_, err = svc.SearchOpenSearch(ctx, &types.OpenSearchQueryRequest{
+		Namespace: "ns", LogType: "build", StartTime: "2026-01-31T11:00:00Z", EndTime: "2026-01-31T12:00:00Z",
+	})
+	require.ErrorIs(t, err, ErrRawQueryInvalidRequest, "log type without an index pattern")
+}
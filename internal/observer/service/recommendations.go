@@ -0,0 +1,201 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/internal/recommender"
+)
+
+// DefaultRecommendationWindow is the usage history analyzed when the request sets no window.
+const DefaultRecommendationWindow = 7 * 24 * time.Hour
+
+// ErrRecommendationInvalidRequest indicates the recommendation request is malformed. Maps to HTTP 400.
+var ErrRecommendationInvalidRequest = errors.New("invalid resource recommendation request")
+
+// recommendationSteps are the query resolutions supported by the metrics adapter, finest first.
+var recommendationSteps = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute, 30 * time.Minute, time.Hour}
+
+// recommendationMaxPoints bounds the number of samples requested per series.
+const recommendationMaxPoints = 1000
+
+// RecommendationService suggests container requests and limits for a component from its
+// historical CPU and memory usage.
+type RecommendationService struct {
+	metrics MetricsQuerier
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+var _ ResourceRecommender = (*RecommendationService)(nil)
+
+// NewRecommendationService creates a new RecommendationService. Pass an authz-wrapped
+// MetricsQuerier so that callers need view access to the component metrics.
+func NewRecommendationService(metrics MetricsQuerier, logger *slog.Logger) *RecommendationService {
+	return &RecommendationService{metrics: metrics, logger: logger, now: time.Now}
+}
+
+// RecommendResources analyzes the component's resource usage over the requested window.
+// Usage samples are per pod, matching the requests and limits series reported with them.
+func (s *RecommendationService) RecommendResources(
+	ctx context.Context,
+	req *types.ResourceRecommendationRequest,
+) (*types.ResourceRecommendationResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request must not be nil", ErrRecommendationInvalidRequest)
+	}
+	if req.SearchScope.Component == "" {
+		return nil, fmt.Errorf("%w: searchScope.component is required", ErrRecommendationInvalidRequest)
+	}
+	window := DefaultRecommendationWindow
+	if req.Window != "" {
+		var err error
+		window, err = time.ParseDuration(req.Window)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("%w: invalid window %q", ErrRecommendationInvalidRequest, req.Window)
+		}
+	}
+	end := s.now().UTC()
+	if req.EndTime != "" {
+		var err error
+		end, err = time.Parse(time.RFC3339, req.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid endTime: %w", ErrRecommendationInvalidRequest, err)
+		}
+	}
+	start := end.Add(-window)
+
+	step := recommendationStep(window)
+	raw, err := s.metrics.QueryMetrics(ctx, &types.MetricsQueryRequest{
+		Metric:      types.MetricTypeResource,
+		StartTime:   start.Format(time.RFC3339),
+		EndTime:     end.Format(time.RFC3339),
+		Step:        &step,
+		SearchScope: req.SearchScope,
+	})
+	if err != nil {
+		return nil, err
+	}
+	usage, err := decodeResourceMetrics(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMetricsRetrieval, err)
+	}
+
+	cpu := seriesValues(usage.CPUUsage)
+	memory := seriesValues(usage.MemoryUsage)
+	resp := &types.ResourceRecommendationResponse{
+		StartTime: start,
+		EndTime:   end,
+		Usage: types.ResourceUsageSummary{
+			CPUP90Cores:       recommender.Percentile(cpu, recommender.CPURequestPercentile),
+			CPUPeakCores:      recommender.Percentile(cpu, 1),
+			MemoryP95Bytes:    recommender.Percentile(memory, recommender.MemoryRequestPercentile),
+			MemoryPeakBytes:   recommender.Percentile(memory, 1),
+			CPUSampleCount:    len(cpu),
+			MemorySampleCount: len(memory),
+		},
+		Current: types.ResourceSettings{
+			Requests: types.ResourceQuantities{
+				CPU:    cpuQuantity(usage.CPURequests),
+				Memory: memoryQuantity(usage.MemoryRequests),
+			},
+			Limits: types.ResourceQuantities{
+				CPU:    cpuQuantity(usage.CPULimits),
+				Memory: memoryQuantity(usage.MemoryLimits),
+			},
+		},
+	}
+	if rec, ok := recommender.Recommend(cpu, memory); ok {
+		resp.Recommended = &types.ResourceSettings{
+			Requests: types.ResourceQuantities{CPU: rec.CPURequest.String(), Memory: rec.MemoryRequest.String()},
+			Limits:   types.ResourceQuantities{CPU: rec.CPULimit.String(), Memory: rec.MemoryLimit.String()},
+		}
+	}
+
+	s.logger.Debug("Computed resource recommendation",
+		"namespace", req.SearchScope.Namespace,
+		"project", req.SearchScope.Project,
+		"component", req.SearchScope.Component,
+		"environment", req.SearchScope.Environment,
+		"cpuSamples", len(cpu),
+		"memorySamples", len(memory),
+		"recommended", resp.Recommended != nil,
+	)
+	return resp, nil
+}
+
+// recommendationStep picks the finest supported step that keeps the window under
+// recommendationMaxPoints samples.
+func recommendationStep(window time.Duration) string {
+	for _, step := range recommendationSteps {
+		if window/step <= recommendationMaxPoints {
+			return formatStep(step)
+		}
+	}
+	return formatStep(recommendationSteps[len(recommendationSteps)-1])
+}
+
+func formatStep(d time.Duration) string {
+	if d >= time.Hour {
+		return strconv.Itoa(int(d/time.Hour)) + "h"
+	}
+	return strconv.Itoa(int(d/time.Minute)) + "m"
+}
+
+// decodeResourceMetrics converts the metrics querier result, which is the adapter's raw
+// JSON response, into the resource metrics series.
+func decodeResourceMetrics(raw any) (*types.ResourceMetricsQueryResponse, error) {
+	var out types.ResourceMetricsQueryResponse
+	if err := decodeMetrics(raw, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// decodeMetrics decodes a metrics querier result into out.
+func decodeMetrics(raw, out any) error {
+	data, ok := raw.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(raw); err != nil {
+			return fmt.Errorf("failed to encode metrics: %w", err)
+		}
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode metrics: %w", err)
+	}
+	return nil
+}
+
+func seriesValues(series []types.MetricsTimeSeriesItem) []float64 {
+	values := make([]float64, 0, len(series))
+	for _, item := range series {
+		values = append(values, item.Value)
+	}
+	return values
+}
+
+// cpuQuantity formats the latest value of a CPU series (cores) as millicores.
+func cpuQuantity(series []types.MetricsTimeSeriesItem) string {
+	if len(series) == 0 || series[len(series)-1].Value <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(int64(series[len(series)-1].Value*1000+0.5), 10) + "m"
+}
+
+// memoryQuantity formats the latest value of a memory series (bytes) in mebibytes.
+func memoryQuantity(series []types.MetricsTimeSeriesItem) string {
+	if len(series) == 0 || series[len(series)-1].Value <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(int64(series[len(series)-1].Value/(1<<20)+0.5), 10) + "Mi"
+}
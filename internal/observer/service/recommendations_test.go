@@ -0,0 +1,102 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func newRecommendationRequest() *types.ResourceRecommendationRequest {
+	return &types.ResourceRecommendationRequest{
+		SearchScope: types.ComponentSearchScope{
+			Namespace:   "ns",
+			Project:     "proj",
+			Component:   "api",
+			Environment: "prod",
+		},
+		EndTime: "2026-01-08T00:00:00Z",
+	}
+}
+
+func series(n int, value func(i int) float64) []types.MetricsTimeSeriesItem {
+	items := make([]types.MetricsTimeSeriesItem, n)
+	for i := range items {
+		items[i] = types.MetricsTimeSeriesItem{Value: value(i)}
+	}
+	return items
+}
+
+func TestRecommendationService_RecommendResources(t *testing.T) {
+	usage := types.ResourceMetricsQueryResponse{
+		CPUUsage:       series(20, func(i int) float64 { return 0.1 + 0.01*float64(i) }),
+		MemoryUsage:    series(20, func(i int) float64 { return float64(100+i) * (1 << 20) }),
+		CPURequests:    series(1, func(int) float64 { return 1 }),
+		MemoryRequests: series(1, func(int) float64 { return 512 << 20 }),
+	}
+	raw, err := json.Marshal(usage)
+	require.NoError(t, err)
+
+	metrics := mocks.NewMockMetricsQuerier(t)
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.MatchedBy(func(r *types.MetricsQueryRequest) bool {
+		return r.Metric == types.MetricTypeResource &&
+			r.StartTime == "2026-01-01T00:00:00Z" &&
+			r.Step != nil && *r.Step == "15m" &&
+			r.SearchScope.Component == "api"
+	})).Return(json.RawMessage(raw), nil).Once()
+
+	resp, err := NewRecommendationService(metrics, testLogger()).RecommendResources(context.Background(), newRecommendationRequest())
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), resp.StartTime)
+	assert.Equal(t, 20, resp.Usage.CPUSampleCount)
+	assert.InDelta(t, 0.29, resp.Usage.CPUPeakCores, 1e-9)
+	assert.Equal(t, types.ResourceSettings{
+		Requests: types.ResourceQuantities{CPU: "1000m", Memory: "512Mi"},
+	}, resp.Current)
+	require.NotNil(t, resp.Recommended)
+	assert.Equal(t, types.ResourceQuantities{CPU: "311m", Memory: "136Mi"}, resp.Recommended.Requests)
+	assert.Equal(t, types.ResourceQuantities{CPU: "334m", Memory: "149Mi"}, resp.Recommended.Limits)
+}
+
+func TestRecommendationService_InsufficientData(t *testing.T) {
+	metrics := mocks.NewMockMetricsQuerier(t)
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.Anything).
+		Return(&types.ResourceMetricsQueryResponse{CPUUsage: series(3, func(int) float64 { return 0.2 })}, nil)
+
+	resp, err := NewRecommendationService(metrics, testLogger()).RecommendResources(context.Background(), newRecommendationRequest())
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Usage.CPUSampleCount)
+	assert.Nil(t, resp.Recommended)
+}
+
+func TestRecommendationService_Errors(t *testing.T) {
+	svc := NewRecommendationService(mocks.NewMockMetricsQuerier(t), testLogger())
+
+	req := newRecommendationRequest()
+	req.Window = "soon"
+	_, err := svc.RecommendResources(context.Background(), req)
+	require.ErrorIs(t, err, ErrRecommendationInvalidRequest)
+
+	metrics := mocks.NewMockMetricsQuerier(t)
+	metrics.EXPECT().QueryMetrics(mock.Anything, mock.Anything).Return(nil, ErrMetricsRetrieval)
+	_, err = NewRecommendationService(metrics, testLogger()).RecommendResources(context.Background(), newRecommendationRequest())
+	require.True(t, errors.Is(err, ErrMetricsRetrieval))
+}
+
+func TestRecommendationStep(t *testing.T) {
+	assert.Equal(t, "1m", recommendationStep(6*time.Hour))
+	assert.Equal(t, "15m", recommendationStep(7*24*time.Hour))
+	assert.Equal(t, "1h", recommendationStep(90*24*time.Hour))
+}
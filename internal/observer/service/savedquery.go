@@ -0,0 +1,297 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/store/savedquery"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+var (
+	// ErrSavedQueryInvalid is returned when a query template or its run parameters are invalid.
+	ErrSavedQueryInvalid = errors.New("invalid saved query")
+	// ErrSavedQueryNotFound is returned when the saved query does not exist.
+	ErrSavedQueryNotFound = errors.New("saved query not found")
+	// ErrSavedQueryExists is returned when the namespace already has a saved query of the name.
+	ErrSavedQueryExists = errors.New("saved query already exists")
+	// ErrSavedQueryLimitReached is returned when the namespace has reached its saved query cap.
+	ErrSavedQueryLimitReached = errors.New("saved query limit reached")
+)
+
+// savedQueryPlaceholder matches {{name}} placeholders in string values of a query template.
+var savedQueryPlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// SavedQueryStoreService manages saved queries in the saved query store.
+type SavedQueryStoreService struct {
+	store           savedquery.SavedQueryStore
+	maxPerNamespace int
+	logger          *slog.Logger
+	now             func() time.Time
+}
+
+var _ SavedQueryService = (*SavedQueryStoreService)(nil)
+
+// NewSavedQueryStoreService creates a SavedQueryStoreService that allows at most
+// maxPerNamespace saved queries per namespace.
+func NewSavedQueryStoreService(store savedquery.SavedQueryStore, maxPerNamespace int, logger *slog.Logger) *SavedQueryStoreService {
+	return &SavedQueryStoreService{
+		store:           store,
+		maxPerNamespace: maxPerNamespace,
+		logger:          logger,
+		now:             time.Now,
+	}
+}
+
+// CreateSavedQuery stores a new saved query. Every placeholder of the query template must be
+// declared as a parameter. The caller is recorded as the creator.
+func (s *SavedQueryStoreService) CreateSavedQuery(ctx context.Context, req *types.SavedQueryRequest) (*types.SavedQuery, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request is required", ErrSavedQueryInvalid)
+	}
+	if err := validateSavedQueryTemplate(req.Query, req.Parameters); err != nil {
+		return nil, err
+	}
+
+	_, total, err := s.store.List(ctx, req.Namespace, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count saved queries: %w", err)
+	}
+	if total >= s.maxPerNamespace {
+		return nil, fmt.Errorf("%w: namespace %s already has %d saved queries", ErrSavedQueryLimitReached, req.Namespace, total)
+	}
+
+	entry := &savedquery.SavedQuery{
+		Namespace:   req.Namespace,
+		Name:        req.Name,
+		Description: req.Description,
+		Kind:        req.Kind,
+		Query:       req.Query,
+		Parameters:  toStoreParameters(req.Parameters),
+		CreatedAt:   s.now().UTC().Truncate(time.Second),
+	}
+	if subject, ok := auth.GetSubjectContextFromContext(ctx); ok && subject != nil {
+		entry.CreatedBy = subject.ID
+	}
+
+	if err := s.store.Create(ctx, entry); err != nil {
+		if errors.Is(err, savedquery.ErrAlreadyExists) {
+			return nil, fmt.Errorf("%w: %s/%s", ErrSavedQueryExists, req.Namespace, req.Name)
+		}
+		return nil, fmt.Errorf("failed to store saved query: %w", err)
+	}
+	s.logger.Info("Created saved query", "namespace", entry.Namespace, "name", entry.Name, "kind", entry.Kind)
+	return fromStoreSavedQuery(entry), nil
+}
+
+// GetSavedQuery returns a saved query of a namespace.
+func (s *SavedQueryStoreService) GetSavedQuery(ctx context.Context, namespace, name string) (*types.SavedQuery, error) {
+	entry, err := s.store.Get(ctx, namespace, name)
+	if err != nil {
+		if errors.Is(err, savedquery.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s/%s", ErrSavedQueryNotFound, namespace, name)
+		}
+		return nil, fmt.Errorf("failed to get saved query: %w", err)
+	}
+	return fromStoreSavedQuery(entry), nil
+}
+
+// ListSavedQueries returns the saved queries of a namespace ordered by name.
+func (s *SavedQueryStoreService) ListSavedQueries(ctx context.Context, namespace string) (*types.SavedQueryListResponse, error) {
+	entries, total, err := s.store.List(ctx, namespace, s.maxPerNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved queries: %w", err)
+	}
+	items := make([]types.SavedQuery, 0, len(entries))
+	for i := range entries {
+		items = append(items, *fromStoreSavedQuery(&entries[i]))
+	}
+	return &types.SavedQueryListResponse{Items: items, Total: total}, nil
+}
+
+// DeleteSavedQuery removes a saved query of a namespace.
+func (s *SavedQueryStoreService) DeleteSavedQuery(ctx context.Context, namespace, name string) error {
+	if err := s.store.Delete(ctx, namespace, name); err != nil {
+		if errors.Is(err, savedquery.ErrNotFound) {
+			return fmt.Errorf("%w: %s/%s", ErrSavedQueryNotFound, namespace, name)
+		}
+		return fmt.Errorf("failed to delete saved query: %w", err)
+	}
+	s.logger.Info("Deleted saved query", "namespace", namespace, "name", name)
+	return nil
+}
+
+// RenderSavedQuery substitutes the parameter values into the query template of a saved query
+// and returns the request body for the query endpoint of its kind. Parameters that are not
+// supplied take their default, or the empty string when they are optional. Placeholders are
+// only replaced inside string values, so parameter values cannot change the structure of the
+// query. The rendered query must stay within the namespace of the saved query.
+func RenderSavedQuery(q *types.SavedQuery, params map[string]string) (json.RawMessage, error) {
+	declared := make(map[string]types.SavedQueryParameter, len(q.Parameters))
+	for _, p := range q.Parameters {
+		declared[p.Name] = p
+	}
+	for name := range params {
+		if _, ok := declared[name]; !ok {
+			return nil, fmt.Errorf("%w: unknown parameter %q", ErrSavedQueryInvalid, name)
+		}
+	}
+
+	values := make(map[string]string, len(declared))
+	var missing []string
+	for name, p := range declared {
+		switch v, ok := params[name]; {
+		case ok:
+			values[name] = v
+		case p.Default != nil:
+			values[name] = *p.Default
+		case p.Required:
+			missing = append(missing, name)
+		default:
+			values[name] = ""
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("%w: missing required parameters: %s", ErrSavedQueryInvalid, strings.Join(missing, ", "))
+	}
+
+	var template any
+	if err := json.Unmarshal(q.Query, &template); err != nil {
+		return nil, fmt.Errorf("%w: query is not valid JSON: %w", ErrSavedQueryInvalid, err)
+	}
+	rendered := substitutePlaceholders(template, values)
+
+	var scoped struct {
+		SearchScope struct {
+			Namespace string `json:"namespace"`
+		} `json:"searchScope"`
+	}
+	body, err := json.Marshal(rendered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rendered query: %w", err)
+	}
+	if err := json.Unmarshal(body, &scoped); err != nil {
+		return nil, fmt.Errorf("%w: searchScope must be an object: %w", ErrSavedQueryInvalid, err)
+	}
+	if scoped.SearchScope.Namespace != q.Namespace {
+		return nil, fmt.Errorf("%w: searchScope.namespace must be %q", ErrSavedQueryInvalid, q.Namespace)
+	}
+	return body, nil
+}
+
+// validateSavedQueryTemplate checks that the query template is a JSON object and that every
+// placeholder it references is declared.
+func validateSavedQueryTemplate(query json.RawMessage, params []types.SavedQueryParameter) error {
+	var template any
+	if err := json.Unmarshal(query, &template); err != nil {
+		return fmt.Errorf("%w: query is not valid JSON: %w", ErrSavedQueryInvalid, err)
+	}
+	if _, ok := template.(map[string]any); !ok {
+		return fmt.Errorf("%w: query must be a JSON object", ErrSavedQueryInvalid)
+	}
+
+	declared := make(map[string]struct{}, len(params))
+	for _, p := range params {
+		declared[p.Name] = struct{}{}
+	}
+	used := make(map[string]struct{})
+	collectPlaceholders(template, used)
+	var undeclared []string
+	for name := range used {
+		if _, ok := declared[name]; !ok {
+			undeclared = append(undeclared, name)
+		}
+	}
+	if len(undeclared) > 0 {
+		sort.Strings(undeclared)
+		return fmt.Errorf("%w: undeclared parameters: %s", ErrSavedQueryInvalid, strings.Join(undeclared, ", "))
+	}
+	return nil
+}
+
+func collectPlaceholders(v any, into map[string]struct{}) {
+	switch t := v.(type) {
+	case string:
+		for _, m := range savedQueryPlaceholder.FindAllStringSubmatch(t, -1) {
+			into[m[1]] = struct{}{}
+		}
+	case map[string]any:
+		for _, child := range t {
+			collectPlaceholders(child, into)
+		}
+	case []any:
+		for _, child := range t {
+			collectPlaceholders(child, into)
+		}
+	}
+}
+
+func substitutePlaceholders(v any, values map[string]string) any {
+	switch t := v.(type) {
+	case string:
+		return savedQueryPlaceholder.ReplaceAllStringFunc(t, func(match string) string {
+			return values[savedQueryPlaceholder.FindStringSubmatch(match)[1]]
+		})
+	case map[string]any:
+		for key, child := range t {
+			t[key] = substitutePlaceholders(child, values)
+		}
+		return t
+	case []any:
+		for i, child := range t {
+			t[i] = substitutePlaceholders(child, values)
+		}
+		return t
+	default:
+		return v
+	}
+}
+
+func toStoreParameters(params []types.SavedQueryParameter) []savedquery.Parameter {
+	if len(params) == 0 {
+		return nil
+	}
+	out := make([]savedquery.Parameter, 0, len(params))
+	for _, p := range params {
+		out = append(out, savedquery.Parameter{
+			Name:        p.Name,
+			Description: p.Description,
+			Default:     p.Default,
+			Required:    p.Required,
+		})
+	}
+	return out
+}
+
+func fromStoreSavedQuery(entry *savedquery.SavedQuery) *types.SavedQuery {
+	q := &types.SavedQuery{
+		Namespace:   entry.Namespace,
+		Name:        entry.Name,
+		Description: entry.Description,
+		Kind:        entry.Kind,
+		Query:       entry.Query,
+		CreatedBy:   entry.CreatedBy,
+		CreatedAt:   entry.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, p := range entry.Parameters {
+		q.Parameters = append(q.Parameters, types.SavedQueryParameter{
+			Name:        p.Name,
+			Description: p.Description,
+			Default:     p.Default,
+			Required:    p.Required,
+		})
+	}
+	return q
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// savedQueryServiceWithAuthz wraps a SavedQueryService and requires the savedquery:view,
+// savedquery:create or savedquery:delete action on the namespace of the saved query.
+// Running a saved query additionally requires the action of the query it runs.
+type savedQueryServiceWithAuthz struct {
+	internal SavedQueryService
+	pdp      authzcore.PDP
+	logger   *slog.Logger
+}
+
+var _ SavedQueryService = (*savedQueryServiceWithAuthz)(nil)
+
+// NewSavedQueryServiceWithAuthz wraps the provided SavedQueryService with authorization checks.
+func NewSavedQueryServiceWithAuthz(s SavedQueryService, pdp authzcore.PDP, logger *slog.Logger) SavedQueryService {
+	return &savedQueryServiceWithAuthz{internal: s, pdp: pdp, logger: logger}
+}
+
+func (s *savedQueryServiceWithAuthz) CreateSavedQuery(
+	ctx context.Context,
+	req *types.SavedQueryRequest,
+) (*types.SavedQuery, error) {
+	if req == nil {
+		return nil, fmt.Errorf("saved query request is required")
+	}
+	if err := s.authorize(ctx, observerAuthz.ActionCreateSavedQuery, req.Namespace); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateSavedQuery(ctx, req)
+}
+
+func (s *savedQueryServiceWithAuthz) GetSavedQuery(ctx context.Context, namespace, name string) (*types.SavedQuery, error) {
+	if err := s.authorize(ctx, observerAuthz.ActionViewSavedQuery, namespace); err != nil {
+		return nil, err
+	}
+	return s.internal.GetSavedQuery(ctx, namespace, name)
+}
+
+func (s *savedQueryServiceWithAuthz) ListSavedQueries(ctx context.Context, namespace string) (*types.SavedQueryListResponse, error) {
+	if err := s.authorize(ctx, observerAuthz.ActionViewSavedQuery, namespace); err != nil {
+		return nil, err
+	}
+	return s.internal.ListSavedQueries(ctx, namespace)
+}
+
+func (s *savedQueryServiceWithAuthz) DeleteSavedQuery(ctx context.Context, namespace, name string) error {
+	if err := s.authorize(ctx, observerAuthz.ActionDeleteSavedQuery, namespace); err != nil {
+		return err
+	}
+	return s.internal.DeleteSavedQuery(ctx, namespace, name)
+}
+
+func (s *savedQueryServiceWithAuthz) authorize(ctx context.Context, action observerAuthz.Action, namespace string) error {
+	resourceType, resourceName, hierarchy := observerAuthz.ComponentScopeAuthz(namespace, "", "")
+	return observerAuthz.CheckAuthorization(
+		ctx, s.logger, s.pdp,
+		action,
+		resourceType, resourceName, hierarchy,
+		authzcore.Context{},
+	)
+}
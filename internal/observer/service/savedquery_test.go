@@ -0,0 +1,199 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/store/savedquery"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// fakeSavedQueryStore is an in-memory SavedQueryStore.
+type fakeSavedQueryStore struct {
+	queries map[string]savedquery.SavedQuery
+}
+
+func newFakeSavedQueryStore() *fakeSavedQueryStore {
+	return &fakeSavedQueryStore{queries: map[string]savedquery.SavedQuery{}}
+}
+
+func (f *fakeSavedQueryStore) Initialize(context.Context) error { return nil }
+
+func (f *fakeSavedQueryStore) Create(_ context.Context, q *savedquery.SavedQuery) error {
+	key := q.Namespace + "/" + q.Name
+	if _, ok := f.queries[key]; ok {
+		return savedquery.ErrAlreadyExists
+	}
+	f.queries[key] = *q
+	return nil
+}
+
+func (f *fakeSavedQueryStore) Get(_ context.Context, namespace, name string) (*savedquery.SavedQuery, error) {
+	q, ok := f.queries[namespace+"/"+name]
+	if !ok {
+		return nil, savedquery.ErrNotFound
+	}
+	return &q, nil
+}
+
+func (f *fakeSavedQueryStore) List(_ context.Context, namespace string, limit int) ([]savedquery.SavedQuery, int, error) {
+	var all []savedquery.SavedQuery
+	for _, q := range f.queries {
+		if q.Namespace == namespace {
+			all = append(all, q)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+	if len(all) > limit {
+		return all[:limit], len(all), nil
+	}
+	return all, len(all), nil
+}
+
+func (f *fakeSavedQueryStore) Delete(_ context.Context, namespace, name string) error {
+	key := namespace + "/" + name
+	if _, ok := f.queries[key]; !ok {
+		return savedquery.ErrNotFound
+	}
+	delete(f.queries, key)
+	return nil
+}
+
+func strPtr(s string) *string { return &s }
+
+func newTestSavedQueryRequest(name string) *types.SavedQueryRequest {
+	return &types.SavedQueryRequest{
+		Namespace: "acme",
+		Name:      name,
+		Kind:      types.SavedQueryKindLogs,
+		Query: json.RawMessage(`{
+			"searchScope": {"namespace": "acme", "component": "{{component}}", "environment": "{{env}}"},
+			"startTime": "{{from}}", "endTime": "{{to}}", "searchPhrase": "order {{orderId}} failed",
+			"logLevels": ["ERROR"], "limit": 50
+		}`),
+		Parameters: []types.SavedQueryParameter{
+			{Name: "component", Required: true},
+			{Name: "env", Default: strPtr("production")},
+			{Name: "from", Required: true},
+			{Name: "to", Required: true},
+			{Name: "orderId"},
+		},
+	}
+}
+
+func TestSavedQueryStoreService_CreateGetListDelete(t *testing.T) {
+	store := newFakeSavedQueryStore()
+	svc := NewSavedQueryStoreService(store, 2, testLogger())
+	svc.now = func() time.Time { return time.Date(2026, 3, 4, 5, 6, 7, 8, time.UTC) }
+	ctx := authedCtx()
+
+	created, err := svc.CreateSavedQuery(ctx, newTestSavedQueryRequest("checkout-errors"))
+	require.NoError(t, err)
+	assert.Equal(t, "test-user", created.CreatedBy)
+	assert.Equal(t, "2026-03-04T05:06:07Z", created.CreatedAt)
+	require.Len(t, created.Parameters, 5)
+
+	_, err = svc.CreateSavedQuery(ctx, newTestSavedQueryRequest("checkout-errors"))
+	assert.ErrorIs(t, err, ErrSavedQueryExists)
+
+	_, err = svc.CreateSavedQuery(ctx, newTestSavedQueryRequest("payment-errors"))
+	require.NoError(t, err)
+	_, err = svc.CreateSavedQuery(ctx, newTestSavedQueryRequest("refund-errors"))
+	assert.ErrorIs(t, err, ErrSavedQueryLimitReached)
+
+	got, err := svc.GetSavedQuery(ctx, "acme", "checkout-errors")
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+
+	list, err := svc.ListSavedQueries(ctx, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, 2, list.Total)
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, "checkout-errors", list.Items[0].Name)
+
+	require.NoError(t, svc.DeleteSavedQuery(ctx, "acme", "checkout-errors"))
+	assert.ErrorIs(t, svc.DeleteSavedQuery(ctx, "acme", "checkout-errors"), ErrSavedQueryNotFound)
+	_, err = svc.GetSavedQuery(ctx, "acme", "checkout-errors")
+	assert.ErrorIs(t, err, ErrSavedQueryNotFound)
+}
+
+func TestSavedQueryStoreService_CreateRejectsInvalidTemplates(t *testing.T) {
+	svc := NewSavedQueryStoreService(newFakeSavedQueryStore(), 10, testLogger())
+
+	undeclared := newTestSavedQueryRequest("undeclared")
+	undeclared.Parameters = undeclared.Parameters[:2]
+	_, err := svc.CreateSavedQuery(authedCtx(), undeclared)
+	require.ErrorIs(t, err, ErrSavedQueryInvalid)
+	assert.Contains(t, err.Error(), "from, orderId, to")
+
+	notObject := newTestSavedQueryRequest("not-object")
+	notObject.Query = json.RawMessage(`["searchScope"]`)
+	_, err = svc.CreateSavedQuery(authedCtx(), notObject)
+	assert.ErrorIs(t, err, ErrSavedQueryInvalid)
+}
+
+func TestRenderSavedQuery(t *testing.T) {
+	req := newTestSavedQueryRequest("checkout-errors")
+	q := &types.SavedQuery{Namespace: req.Namespace, Name: req.Name, Kind: req.Kind, Query: req.Query, Parameters: req.Parameters}
+
+	t.Run("substitutes values and defaults", func(t *testing.T) {
+		body, err := RenderSavedQuery(q, map[string]string{
+			"component": "checkout",
+			"from":      "2026-01-01T00:00:00Z",
+			"to":        "2026-01-01T01:00:00Z",
+			"orderId":   `42", "namespace": "other`,
+		})
+		require.NoError(t, err)
+
+		var rendered types.LogsQueryRequest
+		require.NoError(t, json.Unmarshal(body, &rendered))
+		require.NotNil(t, rendered.SearchScope.Component)
+		assert.Equal(t, "acme", rendered.SearchScope.Component.Namespace)
+		assert.Equal(t, "checkout", rendered.SearchScope.Component.Component)
+		assert.Equal(t, "production", rendered.SearchScope.Component.Environment)
+		assert.Equal(t, "2026-01-01T00:00:00Z", rendered.StartTime)
+		assert.Equal(t, `order 42", "namespace": "other failed`, rendered.SearchPhrase)
+		assert.Equal(t, 50, rendered.Limit)
+	})
+
+	t.Run("optional parameters without default are empty", func(t *testing.T) {
+		body, err := RenderSavedQuery(q, map[string]string{"component": "c", "from": "a", "to": "b"})
+		require.NoError(t, err)
+		assert.Contains(t, string(body), `"searchPhrase":"order  failed"`)
+	})
+
+	t.Run("missing required parameters", func(t *testing.T) {
+		_, err := RenderSavedQuery(q, map[string]string{"component": "c"})
+		require.ErrorIs(t, err, ErrSavedQueryInvalid)
+		assert.Contains(t, err.Error(), "from, to")
+	})
+
+	t.Run("unknown parameter", func(t *testing.T) {
+		_, err := RenderSavedQuery(q, map[string]string{"component": "c", "from": "a", "to": "b", "limit": "5"})
+		require.ErrorIs(t, err, ErrSavedQueryInvalid)
+		assert.Contains(t, err.Error(), `"limit"`)
+	})
+
+	t.Run("namespace cannot be parameterized away", func(t *testing.T) {
+		escaping := &types.SavedQuery{
+			Namespace:  "acme",
+			Kind:       types.SavedQueryKindTraces,
+			Query:      json.RawMessage(`{"searchScope":{"namespace":"{{ns}}"}}`),
+			Parameters: []types.SavedQueryParameter{{Name: "ns", Required: true}},
+		}
+		_, err := RenderSavedQuery(escaping, map[string]string{"ns": "other"})
+		assert.ErrorIs(t, err, ErrSavedQueryInvalid)
+
+		_, err = RenderSavedQuery(escaping, map[string]string{"ns": "acme"})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+	"github.com/openchoreo/openchoreo/internal/slo"
+)
+
+// BurnRateWindow is the trailing window the error budget burn rate is computed over.
+const BurnRateWindow = time.Hour
+
+// ErrSLOInvalidRequest indicates the error budget request is malformed. Maps to HTTP 400.
+var ErrSLOInvalidRequest = errors.New("invalid error budget request")
+
+// SLOService evaluates service level objectives from the gateway -> component edges
+// of the runtime topology.
+type SLOService struct {
+	metrics MetricsQuerier
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+var _ SLOEvaluator = (*SLOService)(nil)
+
+// NewSLOService creates a new SLOService. Pass an authz-wrapped MetricsQuerier when the
+// service backs the public API so that callers need view access to the component metrics.
+func NewSLOService(metrics MetricsQuerier, logger *slog.Logger) *SLOService {
+	return &SLOService{metrics: metrics, logger: logger, now: time.Now}
+}
+
+// EvaluateErrorBudget computes the error budget and burn rate for the requested objective.
+func (s *SLOService) EvaluateErrorBudget(
+	ctx context.Context,
+	req *types.ErrorBudgetRequest,
+) (*types.ErrorBudgetResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request must not be nil", ErrSLOInvalidRequest)
+	}
+	if req.SearchScope.Component == "" {
+		return nil, fmt.Errorf("%w: searchScope.component is required", ErrSLOInvalidRequest)
+	}
+	if req.AvailabilityTarget == "" && req.Latency == nil {
+		return nil, fmt.Errorf("%w: availabilityTarget or latency is required", ErrSLOInvalidRequest)
+	}
+	window, err := time.ParseDuration(req.Window)
+	if err != nil || window <= 0 {
+		return nil, fmt.Errorf("%w: invalid window %q", ErrSLOInvalidRequest, req.Window)
+	}
+	end := s.now().UTC()
+	if req.EndTime != "" {
+		end, err = time.Parse(time.RFC3339, req.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid endTime: %w", ErrSLOInvalidRequest, err)
+		}
+	}
+	start := end.Add(-window)
+
+	windowCounts, latencies, err := s.gatewayTraffic(ctx, req, start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.ErrorBudgetResponse{
+		StartTime:      start,
+		EndTime:        end,
+		TotalRequests:  windowCounts.Total,
+		FailedRequests: windowCounts.Failed,
+	}
+
+	if req.AvailabilityTarget != "" {
+		target, err := slo.ParseTarget(req.AvailabilityTarget)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSLOInvalidRequest, err)
+		}
+		burnStart := end.Add(-BurnRateWindow)
+		if burnStart.Before(start) {
+			burnStart = start
+		}
+		burnCounts, _, err := s.gatewayTraffic(ctx, req, burnStart, end)
+		if err != nil {
+			return nil, err
+		}
+		budget := slo.Evaluate(target, windowCounts, burnCounts)
+		if budget.Availability != nil {
+			pct := *budget.Availability * 100
+			resp.Availability = &pct
+		}
+		resp.ErrorBudgetRemaining = &budget.Remaining
+		resp.BurnRate = &budget.BurnRate
+		resp.Exhausted = budget.Exhausted
+	}
+
+	if req.Latency != nil {
+		observed, ok := latencies[latencyPercentileOrDefault(req.Latency.Percentile)]
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid latency percentile %q", ErrSLOInvalidRequest, req.Latency.Percentile)
+		}
+		if windowCounts.Total > 0 {
+			met := observed <= req.Latency.ThresholdSeconds
+			resp.ObservedLatencySeconds = &observed
+			resp.LatencyMet = &met
+		}
+	}
+
+	s.logger.Debug("Evaluated error budget",
+		"namespace", req.SearchScope.Namespace,
+		"project", req.SearchScope.Project,
+		"component", req.SearchScope.Component,
+		"environment", req.SearchScope.Environment,
+		"endpoint", req.Endpoint,
+		"totalRequests", resp.TotalRequests,
+		"failedRequests", resp.FailedRequests,
+		"exhausted", resp.Exhausted,
+	)
+	return resp, nil
+}
+
+// gatewayTraffic sums the request counts of the gateway edges into the requested
+// component (and endpoint) between start and end. Latency percentiles are the highest
+// reported by any matching edge, since percentiles cannot be merged exactly.
+func (s *SLOService) gatewayTraffic(
+	ctx context.Context,
+	req *types.ErrorBudgetRequest,
+	start, end time.Time,
+) (slo.Counts, map[string]float64, error) {
+	includeGateways := true
+	includeExternal := false
+	topology, err := s.metrics.QueryRuntimeTopology(ctx, &types.RuntimeTopologyRequest{
+		SearchScope:     req.SearchScope,
+		StartTime:       start.Format(time.RFC3339),
+		EndTime:         end.Format(time.RFC3339),
+		IncludeGateways: &includeGateways,
+		IncludeExternal: &includeExternal,
+	})
+	if err != nil {
+		return slo.Counts{}, nil, err
+	}
+
+	var counts slo.Counts
+	latencies := map[string]float64{"p50": 0, "p90": 0, "p99": 0}
+	for _, edge := range topology.Edges {
+		if edge.Source.Kind != types.RuntimeTopologyNodeKindGateway || edge.Metrics == nil {
+			continue
+		}
+		if edge.Target.Component != req.SearchScope.Component {
+			continue
+		}
+		if req.Endpoint != "" && edge.Target.Service != req.Endpoint {
+			continue
+		}
+		counts.Total += edge.Metrics.RequestCount
+		counts.Failed += edge.Metrics.UnsuccessfulRequestCount
+		latencies["p50"] = max(latencies["p50"], edge.Metrics.LatencyP50)
+		latencies["p90"] = max(latencies["p90"], edge.Metrics.LatencyP90)
+		latencies["p99"] = max(latencies["p99"], edge.Metrics.LatencyP99)
+	}
+	return counts, latencies, nil
+}
+
+func latencyPercentileOrDefault(p string) string {
+	if p == "" {
+		return "p99"
+	}
+	return p
+}
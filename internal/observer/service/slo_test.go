@@ -0,0 +1,137 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func gatewayEdge(component, service string, total, failed, p99 float64) types.RuntimeTopologyEdge {
+	return types.RuntimeTopologyEdge{
+		Source: types.RuntimeTopologyNodeRef{Kind: types.RuntimeTopologyNodeKindGateway, Name: "internet"},
+		Target: types.RuntimeTopologyNodeRef{
+			Kind:      types.RuntimeTopologyNodeKindComponent,
+			Component: component,
+			Service:   service,
+		},
+		Metrics: &types.RuntimeTopologyMetrics{
+			RequestCount:             total,
+			UnsuccessfulRequestCount: failed,
+			LatencyP99:               p99,
+		},
+	}
+}
+
+func newErrorBudgetRequest() *types.ErrorBudgetRequest {
+	return &types.ErrorBudgetRequest{
+		SearchScope: types.ComponentSearchScope{
+			Namespace:   "ns",
+			Project:     "proj",
+			Component:   "api",
+			Environment: "prod",
+		},
+		Endpoint:           "http",
+		AvailabilityTarget: "99",
+		Latency:            &types.ErrorBudgetLatencyObjective{Percentile: "p99", ThresholdSeconds: 0.5},
+		Window:             "720h",
+		EndTime:            "2026-01-31T00:00:00Z",
+	}
+}
+
+func TestSLOService_EvaluateErrorBudget(t *testing.T) {
+	metrics := mocks.NewMockMetricsQuerier(t)
+	edges := []types.RuntimeTopologyEdge{
+		gatewayEdge("api", "http", 1000, 5, 0.3),
+		gatewayEdge("api", "admin", 500, 500, 9),
+		gatewayEdge("other", "http", 500, 500, 9),
+		{
+			Source:  types.RuntimeTopologyNodeRef{Kind: types.RuntimeTopologyNodeKindComponent, Component: "web"},
+			Target:  types.RuntimeTopologyNodeRef{Kind: types.RuntimeTopologyNodeKindComponent, Component: "api", Service: "http"},
+			Metrics: &types.RuntimeTopologyMetrics{RequestCount: 100, UnsuccessfulRequestCount: 100},
+		},
+	}
+	metrics.EXPECT().QueryRuntimeTopology(mock.Anything, mock.MatchedBy(func(r *types.RuntimeTopologyRequest) bool {
+		return r.StartTime == "2026-01-01T00:00:00Z"
+	})).Return(&types.RuntimeTopologyResponse{Edges: edges}, nil).Once()
+	metrics.EXPECT().QueryRuntimeTopology(mock.Anything, mock.MatchedBy(func(r *types.RuntimeTopologyRequest) bool {
+		return r.StartTime == "2026-01-30T23:00:00Z"
+	})).Return(&types.RuntimeTopologyResponse{Edges: []types.RuntimeTopologyEdge{
+		gatewayEdge("api", "http", 100, 2, 0.3),
+	}}, nil).Once()
+
+	svc := NewSLOService(metrics, testLogger())
+	resp, err := svc.EvaluateErrorBudget(context.Background(), newErrorBudgetRequest())
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), resp.StartTime)
+	assert.InDelta(t, 1000, resp.TotalRequests, 1e-9)
+	assert.InDelta(t, 5, resp.FailedRequests, 1e-9)
+	require.NotNil(t, resp.Availability)
+	assert.InDelta(t, 99.5, *resp.Availability, 1e-9)
+	require.NotNil(t, resp.ErrorBudgetRemaining)
+	assert.InDelta(t, 0.5, *resp.ErrorBudgetRemaining, 1e-9)
+	require.NotNil(t, resp.BurnRate)
+	assert.InDelta(t, 2, *resp.BurnRate, 1e-9)
+	assert.False(t, resp.Exhausted)
+	require.NotNil(t, resp.LatencyMet)
+	assert.True(t, *resp.LatencyMet)
+}
+
+func TestSLOService_EvaluateErrorBudget_LatencyOnly(t *testing.T) {
+	metrics := mocks.NewMockMetricsQuerier(t)
+	metrics.EXPECT().QueryRuntimeTopology(mock.Anything, mock.Anything).Return(&types.RuntimeTopologyResponse{
+		Edges: []types.RuntimeTopologyEdge{gatewayEdge("api", "http", 10, 0, 0.8)},
+	}, nil).Once()
+
+	req := newErrorBudgetRequest()
+	req.AvailabilityTarget = ""
+	resp, err := NewSLOService(metrics, testLogger()).EvaluateErrorBudget(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Nil(t, resp.ErrorBudgetRemaining)
+	require.NotNil(t, resp.ObservedLatencySeconds)
+	assert.InDelta(t, 0.8, *resp.ObservedLatencySeconds, 1e-9)
+	require.NotNil(t, resp.LatencyMet)
+	assert.False(t, *resp.LatencyMet)
+}
+
+func TestSLOService_EvaluateErrorBudget_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*types.ErrorBudgetRequest)
+	}{
+		{"missing component", func(r *types.ErrorBudgetRequest) { r.SearchScope.Component = "" }},
+		{"no objective", func(r *types.ErrorBudgetRequest) { r.AvailabilityTarget = ""; r.Latency = nil }},
+		{"bad window", func(r *types.ErrorBudgetRequest) { r.Window = "30d" }},
+		{"bad end time", func(r *types.ErrorBudgetRequest) { r.EndTime = "yesterday" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newErrorBudgetRequest()
+			tt.mutate(req)
+			_, err := NewSLOService(mocks.NewMockMetricsQuerier(t), testLogger()).
+				EvaluateErrorBudget(context.Background(), req)
+			assert.ErrorIs(t, err, ErrSLOInvalidRequest)
+		})
+	}
+}
+
+func TestSLOService_EvaluateErrorBudget_RetrievalError(t *testing.T) {
+	metrics := mocks.NewMockMetricsQuerier(t)
+	metrics.EXPECT().QueryRuntimeTopology(mock.Anything, mock.Anything).
+		Return(nil, errors.Join(ErrRuntimeTopologyRetrieval, errors.New("boom"))).Once()
+
+	_, err := NewSLOService(metrics, testLogger()).EvaluateErrorBudget(context.Background(), newErrorBudgetRequest())
+	assert.ErrorIs(t, err, ErrRuntimeTopologyRetrieval)
+}
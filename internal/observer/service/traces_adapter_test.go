@@ -189,7 +189,7 @@ func TestTracesService_QueryTraces_WithResolver_Success(t *testing.T) {
 		case strings.Contains(r.URL.Path, "/projects/"):
 			_, _ = w.Write([]byte(uidResponse(sampleProjectUID)))
 		case strings.Contains(r.URL.Path, "/components/"):
-			_, _ = w.Write([]byte(uidResponse(sampleComponentUID)))
+			_, _ = w.Write([]byte(componentResponse(sampleComponentUID, "proj")))
 		case strings.Contains(r.URL.Path, "/environments/"):
 			_, _ = w.Write([]byte(uidResponse(sampleEnvironmentUID)))
 		default:
@@ -13,10 +13,12 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	choreoapis "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/observer/config"
 )
 
@@ -108,6 +110,8 @@ func (r *ResourceUIDResolver) GetProjectUID(ctx context.Context, namespaceName,
 }
 
 // GetComponentUID resolves a component name to its UID within a namespace and project.
+// A component owned by a different project is reported as not found, so that a caller
+// authorized for one project cannot reach the components of another by name.
 func (r *ResourceUIDResolver) GetComponentUID(
 	ctx context.Context,
 	namespaceName, projectName, componentName string,
@@ -120,7 +124,29 @@ func (r *ResourceUIDResolver) GetComponentUID(
 	path := fmt.Sprintf("/api/v1/namespaces/%s/components/%s",
 		url.PathEscape(namespaceName),
 		url.PathEscape(componentName))
-	uid, err := r.fetchResourceUID(ctx, path)
+	var response struct {
+		Metadata struct {
+			UID string `json:"uid"`
+		} `json:"metadata"`
+		Spec struct {
+			Owner struct {
+				ProjectName string `json:"projectName"`
+			} `json:"owner"`
+		} `json:"spec"`
+	}
+	err := r.fetchJSON(ctx, path, &response)
+	switch {
+	case err != nil:
+	case response.Metadata.UID == "":
+		err = fmt.Errorf("uid not found in response")
+	case projectName != "" && response.Spec.Owner.ProjectName != projectName:
+		r.logger.Warn("Rejected component outside the requested project",
+			"namespace", namespaceName,
+			"project", projectName,
+			"component", componentName,
+			"ownerProject", response.Spec.Owner.ProjectName)
+		err = fmt.Errorf("%w: component is owned by another project", ErrResourceNotFound)
+	}
 	if err != nil {
 		return "", fmt.Errorf(
 			"failed to resolve component UID for namespace %q project %q component %q: %w",
@@ -131,7 +157,37 @@ func (r *ResourceUIDResolver) GetComponentUID(
 		)
 	}
 
-	return uid, nil
+	return response.Metadata.UID, nil
+}
+
+// GetComponentLogParsingProfile returns the log parsing profile of a component, or nil when
+// the component does not define one.
+func (r *ResourceUIDResolver) GetComponentLogParsingProfile(
+	ctx context.Context,
+	namespaceName, componentName string,
+) (*choreoapis.LogParsingProfile, error) {
+	if componentName == "" {
+		return nil, nil
+	}
+
+	// Call API: GET /api/v1/namespaces/{ns}/components/{componentName}
+	path := fmt.Sprintf("/api/v1/namespaces/%s/components/%s",
+		url.PathEscape(namespaceName),
+		url.PathEscape(componentName))
+	var response struct {
+		Spec struct {
+			LogParsing *choreoapis.LogParsingProfile `json:"logParsing"`
+		} `json:"spec"`
+	}
+	if err := r.fetchJSON(ctx, path, &response); err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch log parsing profile for namespace %q component %q: %w",
+			namespaceName,
+			componentName,
+			err,
+		)
+	}
+	return response.Spec.LogParsing, nil
 }
 
 // GetEnvironmentUID resolves an environment name to its UID within a namespace.
@@ -157,33 +213,215 @@ func (r *ResourceUIDResolver) GetEnvironmentUID(ctx context.Context, namespaceNa
 	return uid, nil
 }
 
-// fetchResourceUID makes an HTTP GET request to the openchoreo-api and extracts data.uid
+// defaultPlaneName is the data plane of an environment without a dataPlaneRef, and the
+// observability plane of a data plane without an observabilityPlaneRef.
+const defaultPlaneName = "default"
+
+// GetEnvironmentObservabilityPlane resolves the name of the observability plane that collects
+// the logs and metrics of an environment, through the data plane the environment runs on.
+func (r *ResourceUIDResolver) GetEnvironmentObservabilityPlane(
+	ctx context.Context,
+	namespaceName, environmentName string,
+) (string, error) {
+	// Call API: GET /api/v1/namespaces/{ns}/environments/{environmentName}
+	path := fmt.Sprintf("/api/v1/namespaces/%s/environments/%s",
+		url.PathEscape(namespaceName),
+		url.PathEscape(environmentName))
+	var environment struct {
+		Spec struct {
+			DataPlaneRef *choreoapis.DataPlaneRef `json:"dataPlaneRef"`
+		} `json:"spec"`
+	}
+	if err := r.fetchJSON(ctx, path, &environment); err != nil {
+		return "", fmt.Errorf(
+			"failed to resolve environment %q in namespace %q: %w", environmentName, namespaceName, err)
+	}
+
+	ref := environment.Spec.DataPlaneRef
+	if ref == nil {
+		ref = &choreoapis.DataPlaneRef{Kind: choreoapis.DataPlaneRefKindDataPlane, Name: defaultPlaneName}
+	}
+	switch ref.Kind {
+	case choreoapis.DataPlaneRefKindClusterDataPlane:
+		// Call API: GET /api/v1/clusterdataplanes/{name}
+		path = fmt.Sprintf("/api/v1/clusterdataplanes/%s", url.PathEscape(ref.Name))
+	default:
+		// Call API: GET /api/v1/namespaces/{ns}/dataplanes/{name}
+		path = fmt.Sprintf("/api/v1/namespaces/%s/dataplanes/%s",
+			url.PathEscape(namespaceName),
+			url.PathEscape(ref.Name))
+	}
+	var dataPlane struct {
+		Spec struct {
+			ObservabilityPlaneRef *struct {
+				Name string `json:"name"`
+			} `json:"observabilityPlaneRef"`
+		} `json:"spec"`
+	}
+	if err := r.fetchJSON(ctx, path, &dataPlane); err != nil {
+		return "", fmt.Errorf("failed to resolve %s %q of environment %q: %w", ref.Kind, ref.Name, environmentName, err)
+	}
+	if dataPlane.Spec.ObservabilityPlaneRef == nil || dataPlane.Spec.ObservabilityPlaneRef.Name == "" {
+		return defaultPlaneName, nil
+	}
+	return dataPlane.Spec.ObservabilityPlaneRef.Name, nil
+}
+
+// listPageSize is the page size used when listing resources from the openchoreo-api.
+const listPageSize = 100
+
+// ReleaseBindingRef identifies a release binding and the component it deploys.
+type ReleaseBindingRef struct {
+	Namespace   string
+	Name        string
+	Project     string
+	Component   string
+	Environment string
+	State       string
+}
+
+// ListNamespaces returns the names of the OpenChoreo namespaces visible to the observer.
+func (r *ResourceUIDResolver) ListNamespaces(ctx context.Context) ([]string, error) {
+	var names []string
+	err := r.listAll(ctx, "/api/v1/namespaces", func(raw json.RawMessage) error {
+		var item struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return err
+		}
+		names = append(names, item.Metadata.Name)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	return names, nil
+}
+
+// ListReleaseBindings returns the release bindings of a namespace.
+func (r *ResourceUIDResolver) ListReleaseBindings(ctx context.Context, namespaceName string) ([]ReleaseBindingRef, error) {
+	var refs []ReleaseBindingRef
+	path := fmt.Sprintf("/api/v1/namespaces/%s/releasebindings", url.PathEscape(namespaceName))
+	err := r.listAll(ctx, path, func(raw json.RawMessage) error {
+		var item struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Spec struct {
+				Owner struct {
+					ProjectName   string `json:"projectName"`
+					ComponentName string `json:"componentName"`
+				} `json:"owner"`
+				Environment string `json:"environment"`
+				State       string `json:"state"`
+			} `json:"spec"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return err
+		}
+		refs = append(refs, ReleaseBindingRef{
+			Namespace:   namespaceName,
+			Name:        item.Metadata.Name,
+			Project:     item.Spec.Owner.ProjectName,
+			Component:   item.Spec.Owner.ComponentName,
+			Environment: item.Spec.Environment,
+			State:       item.Spec.State,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list release bindings in namespace %q: %w", namespaceName, err)
+	}
+	return refs, nil
+}
+
+// listAll pages through a list endpoint of the openchoreo-api, calling visit for every item.
+func (r *ResourceUIDResolver) listAll(ctx context.Context, path string, visit func(json.RawMessage) error) error {
+	cursor := ""
+	for {
+		query := url.Values{"limit": {strconv.Itoa(listPageSize)}}
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+		var page struct {
+			Items      []json.RawMessage `json:"items"`
+			Pagination struct {
+				NextCursor string `json:"nextCursor"`
+			} `json:"pagination"`
+		}
+		if err := r.fetchJSON(ctx, path+"?"+query.Encode(), &page); err != nil {
+			return err
+		}
+		for _, item := range page.Items {
+			if err := visit(item); err != nil {
+				return fmt.Errorf("failed to decode list item: %w", err)
+			}
+		}
+		if page.Pagination.NextCursor == "" {
+			return nil
+		}
+		cursor = page.Pagination.NextCursor
+	}
+}
+
+// fetchResourceUID makes an HTTP GET request to the openchoreo-api and extracts metadata.uid
 func (r *ResourceUIDResolver) fetchResourceUID(ctx context.Context, path string) (string, error) {
+	var response struct {
+		Metadata struct {
+			UID string `json:"uid"`
+		} `json:"metadata"`
+	}
+	if err := r.fetchJSON(ctx, path, &response); err != nil {
+		return "", err
+	}
+
+	if response.Metadata.UID == "" {
+		return "", fmt.Errorf("uid not found in response")
+	}
+
+	r.logger.Debug("Resolved resource UID",
+		"path", path,
+		"uid", response.Metadata.UID)
+
+	return response.Metadata.UID, nil
+}
+
+// fetchJSON makes an HTTP GET request to the openchoreo-api and decodes the response body into out.
+func (r *ResourceUIDResolver) fetchJSON(ctx context.Context, path string, out any) error {
 	// Skip API call if not configured
 	if r.config.OpenChoreoAPIURL == "" {
-		return "", fmt.Errorf("openchoreo API URL not configured")
+		return fmt.Errorf("openchoreo API URL not configured")
 	}
 
 	// Build request URL
 	reqURL := strings.TrimSuffix(r.config.OpenChoreoAPIURL, "/") + path
 	for attempt := 0; attempt < (r.config.MaxAuthRetry + 1); attempt++ {
-		uid, err, retry := r.doFetchResourceUID(ctx, reqURL, path, attempt)
+		body, err, retry := r.doFetch(ctx, reqURL, path, attempt)
 		if retry {
 			continue
 		}
-		return uid, err
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return nil
 	}
 	// Unreachable: every loop iteration either returns or continues (401 retry path).
 	// Kept as a defensive fallback.
-	return "", fmt.Errorf("%w: retry loop exhausted", ErrScopeAuthFailed)
+	return fmt.Errorf("%w: retry loop exhausted", ErrScopeAuthFailed)
 }
 
-// doFetchResourceUID performs a single HTTP attempt to fetch a resource UID.
-// It returns (uid, err, retry) where retry=true signals the caller to retry (401 case).
-func (r *ResourceUIDResolver) doFetchResourceUID(ctx context.Context, reqURL, path string, attempt int) (string, error, bool) {
+// doFetch performs a single HTTP GET attempt against the openchoreo-api.
+// It returns (body, err, retry) where retry=true signals the caller to retry (401 case).
+func (r *ResourceUIDResolver) doFetch(ctx context.Context, reqURL, path string, attempt int) ([]byte, error, bool) {
 	token, err := r.getAccessToken(ctx)
 	if err != nil {
-		return "", fmt.Errorf("%w: failed to obtain access token: %w", ErrScopeAuthFailed, err), false
+		return nil, fmt.Errorf("%w: failed to obtain access token: %w", ErrScopeAuthFailed, err), false
 	}
 
 	reqCtx, reqCancel := context.WithTimeout(ctx, r.config.Timeout)
@@ -191,7 +429,7 @@ func (r *ResourceUIDResolver) doFetchResourceUID(ctx context.Context, reqURL, pa
 
 	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, reqURL, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err), false
+		return nil, fmt.Errorf("failed to create request: %w", err), false
 	}
 
 	req.Header.Set("Accept", "application/json")
@@ -201,7 +439,7 @@ func (r *ResourceUIDResolver) doFetchResourceUID(ctx context.Context, reqURL, pa
 
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("request failed: %w", err), false
+		return nil, fmt.Errorf("request failed: %w", err), false
 	}
 	defer resp.Body.Close()
 
@@ -209,34 +447,15 @@ func (r *ResourceUIDResolver) doFetchResourceUID(ctx context.Context, reqURL, pa
 	case http.StatusOK:
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return "", fmt.Errorf("failed to read response body: %w", err), false
+			return nil, fmt.Errorf("failed to read response body: %w", err), false
 		}
 
-		r.logger.Debug("Raw UID resolver response", "path", path, "status", resp.StatusCode, "body", string(body))
-
-		var response struct {
-			Metadata struct {
-				UID string `json:"uid"`
-			} `json:"metadata"`
-		}
-
-		if err := json.Unmarshal(body, &response); err != nil {
-			return "", fmt.Errorf("failed to decode response: %w", err), false
-		}
-
-		if response.Metadata.UID == "" {
-			return "", fmt.Errorf("uid not found in response"), false
-		}
-
-		r.logger.Debug("Resolved resource UID",
-			"path", path,
-			"uid", response.Metadata.UID)
-
-		return response.Metadata.UID, nil, false
+		r.logger.Debug("Raw openchoreo-api response", "path", path, "status", resp.StatusCode, "body", string(body))
+		return body, nil, false
 
 	case http.StatusNotFound:
 		_, _ = io.Copy(io.Discard, resp.Body)
-		return "", fmt.Errorf("%w: %s", ErrResourceNotFound, path), false
+		return nil, fmt.Errorf("%w: %s", ErrResourceNotFound, path), false
 
 	case http.StatusUnauthorized:
 		_, _ = io.Copy(io.Discard, resp.Body)
@@ -249,16 +468,16 @@ func (r *ResourceUIDResolver) doFetchResourceUID(ctx context.Context, reqURL, pa
 		if remaining > 0 {
 			r.logger.Debug("Received 401 from openchoreo-api; invalidating cached token and retrying",
 				"path", path, "attempt", attempt+1, "remaining_retries", remaining)
-			return "", nil, true
+			return nil, nil, true
 		}
 
 		r.logger.Error("Received 401 from openchoreo-api and retries are exhausted",
 			"path", path, "max_auth_retry", r.config.MaxAuthRetry)
-		return "", fmt.Errorf("%w: received 401 after %d attempt(s)", ErrScopeAuthFailed, r.config.MaxAuthRetry+1), false
+		return nil, fmt.Errorf("%w: received 401 after %d attempt(s)", ErrScopeAuthFailed, r.config.MaxAuthRetry+1), false
 
 	default:
 		_, _ = io.Copy(io.Discard, resp.Body)
-		return "", fmt.Errorf("API returned status %d", resp.StatusCode), false
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode), false
 	}
 }
 
@@ -27,6 +27,15 @@ func uidResponse(uid string) string {
 	return string(body)
 }
 
+// componentResponse builds the JSON body of a component owned by the given project.
+func componentResponse(uid, project string) string {
+	body, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"uid": uid},
+		"spec":     map[string]interface{}{"owner": map[string]interface{}{"projectName": project}},
+	})
+	return string(body)
+}
+
 // newTestResolver builds a ResourceUIDResolver whose HTTP client targets the
 // given test server and uses the supplied config.
 func newTestResolver(t *testing.T, apiServer *httptest.Server, tokenServer *httptest.Server, cfg *config.UIDResolverConfig) *ResourceUIDResolver {
@@ -389,3 +398,122 @@ func TestFetchResourceUID_BadClientCredentialsAfterInvalidation(t *testing.T) {
 		t.Errorf("expected 1 API call, got %d", n)
 	}
 }
+
+// TestListReleaseBindings_FollowsCursor verifies that every page of the list endpoint is read.
+func TestListReleaseBindings_FollowsCursor(t *testing.T) {
+	t.Parallel()
+
+	tokenSrv := newAlwaysOKTokenServer(t)
+	defer tokenSrv.Close()
+
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/acme/releasebindings" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			_, _ = w.Write([]byte(`{"items":[{"metadata":{"name":"api-dev"},"spec":{"owner":{"projectName":"shop","componentName":"api"},"environment":"dev"}}],"pagination":{"nextCursor":"page-2"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"items":[{"metadata":{"name":"api-prod"},"spec":{"owner":{"projectName":"shop","componentName":"api"},"environment":"prod","state":"Undeploy"}}],"pagination":{}}`))
+	}))
+	defer apiSrv.Close()
+
+	resolver := newTestResolver(t, apiSrv, tokenSrv, nil)
+
+	refs, err := resolver.ListReleaseBindings(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []ReleaseBindingRef{
+		{Namespace: "acme", Name: "api-dev", Project: "shop", Component: "api", Environment: "dev"},
+		{Namespace: "acme", Name: "api-prod", Project: "shop", Component: "api", Environment: "prod", State: "Undeploy"},
+	}
+	if len(refs) != len(want) {
+		t.Fatalf("expected %d release bindings, got %d", len(want), len(refs))
+	}
+	for i := range want {
+		if refs[i] != want[i] {
+			t.Errorf("release binding %d: expected %+v, got %+v", i, want[i], refs[i])
+		}
+	}
+}
+
+// TestGetComponentUID_RejectsComponentOfAnotherProject verifies that a component is not
+// resolved through a project that does not own it.
+func TestGetComponentUID_RejectsComponentOfAnotherProject(t *testing.T) {
+	t.Parallel()
+
+	tokenSrv := newAlwaysOKTokenServer(t)
+	defer tokenSrv.Close()
+
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(componentResponse("uid-payments-api", "payments")))
+	}))
+	defer apiSrv.Close()
+
+	resolver := newTestResolver(t, apiSrv, tokenSrv, nil)
+
+	uid, err := resolver.GetComponentUID(context.Background(), "acme", "payments", "api")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uid != "uid-payments-api" {
+		t.Fatalf("expected uid-payments-api, got %q", uid)
+	}
+
+	_, err = resolver.GetComponentUID(context.Background(), "acme", "shop", "api")
+	if !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound, got %v", err)
+	}
+}
+
+// TestGetEnvironmentObservabilityPlane verifies that an environment is mapped to the
+// observability plane of its data plane, falling back to the default planes.
+func TestGetEnvironmentObservabilityPlane(t *testing.T) {
+	t.Parallel()
+
+	tokenSrv := newAlwaysOKTokenServer(t)
+	defer tokenSrv.Close()
+
+	bodies := map[string]string{
+		"/api/v1/namespaces/acme/environments/prod":  `{"spec":{"dataPlaneRef":{"kind":"ClusterDataPlane","name":"us-east"}}}`,
+		"/api/v1/clusterdataplanes/us-east":          `{"spec":{"observabilityPlaneRef":{"kind":"ClusterObservabilityPlane","name":"obs-us-east"}}}`,
+		"/api/v1/namespaces/acme/environments/dev":   `{"spec":{}}`,
+		"/api/v1/namespaces/acme/dataplanes/default": `{"spec":{}}`,
+	}
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer apiSrv.Close()
+
+	resolver := newTestResolver(t, apiSrv, tokenSrv, nil)
+
+	plane, err := resolver.GetEnvironmentObservabilityPlane(context.Background(), "acme", "prod")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plane != "obs-us-east" {
+		t.Errorf("expected obs-us-east, got %q", plane)
+	}
+
+	plane, err = resolver.GetEnvironmentObservabilityPlane(context.Background(), "acme", "dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plane != "default" {
+		t.Errorf("expected default, got %q", plane)
+	}
+
+	_, err = resolver.GetEnvironmentObservabilityPlane(context.Background(), "acme", "staging")
+	if !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound, got %v", err)
+	}
+}
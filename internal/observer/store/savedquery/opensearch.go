@@ -0,0 +1,251 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package savedquery
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+)
+
+// indexMappings is the mapping of the saved query index. The query template and its
+// parameters are stored but not indexed, so arbitrary query fields do not grow the mapping.
+var indexMappings = map[string]any{
+	"mappings": map[string]any{
+		"dynamic": "strict",
+		"properties": map[string]any{
+			"namespace":   map[string]any{"type": "keyword"},
+			"name":        map[string]any{"type": "keyword"},
+			"description": map[string]any{"type": "text"},
+			"kind":        map[string]any{"type": "keyword"},
+			"query":       map[string]any{"type": "object", "enabled": false},
+			"parameters":  map[string]any{"type": "object", "enabled": false},
+			"createdBy":   map[string]any{"type": "keyword"},
+			"createdAt":   map[string]any{"type": "date"},
+		},
+	},
+}
+
+// OpenSearchStore stores saved queries as documents of an OpenSearch index. The document ID
+// is derived from the namespace and name, which makes names unique within a namespace.
+type OpenSearchStore struct {
+	config     *config.SavedQueriesConfig
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+var _ SavedQueryStore = (*OpenSearchStore)(nil)
+
+// NewOpenSearchStore creates a saved query store backed by the configured OpenSearch index.
+func NewOpenSearchStore(cfg *config.SavedQueriesConfig, logger *slog.Logger) *OpenSearchStore {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: cfg.TLSInsecureSkipVerify, //nolint:gosec // G402: Configurable for development
+		},
+	}
+	return &OpenSearchStore{
+		config: cfg,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   cfg.Timeout,
+		},
+		logger: logger,
+	}
+}
+
+// Initialize creates the saved query index when it does not exist.
+func (s *OpenSearchStore) Initialize(ctx context.Context) error {
+	resp, err := s.do(ctx, http.MethodHead, s.indexPath(), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("OpenSearch returned status %d for index %s", resp.StatusCode, s.config.Index)
+	}
+
+	body, err := json.Marshal(indexMappings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal index mappings: %w", err)
+	}
+	resp, err = s.do(ctx, http.MethodPut, s.indexPath(), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		s.logger.Info("Created saved query index", "index", s.config.Index)
+		return nil
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	// Another observer replica created the index first.
+	if resp.StatusCode == http.StatusBadRequest && strings.Contains(string(respBody), "resource_already_exists_exception") {
+		return nil
+	}
+	return fmt.Errorf("OpenSearch returned status %d: %s", resp.StatusCode, string(respBody))
+}
+
+// Create stores a new saved query. It returns ErrAlreadyExists when the namespace already
+// has a saved query of the same name.
+func (s *OpenSearchStore) Create(ctx context.Context, query *SavedQuery) error {
+	body, err := json.Marshal(query)
+	if err != nil {
+		return fmt.Errorf("failed to marshal saved query: %w", err)
+	}
+	resp, err := s.do(ctx, http.MethodPut, s.docPath("_create", query.Namespace, query.Name)+"?refresh=wait_for", body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	case http.StatusConflict:
+		return ErrAlreadyExists
+	default:
+		return unexpectedStatus(resp)
+	}
+}
+
+// Get returns a saved query, or ErrNotFound when it does not exist.
+func (s *OpenSearchStore) Get(ctx context.Context, namespace, name string) (*SavedQuery, error) {
+	resp, err := s.do(ctx, http.MethodGet, s.docPath("_doc", namespace, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(resp)
+	}
+
+	var doc struct {
+		Found  bool       `json:"found"`
+		Source SavedQuery `json:"_source"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode saved query: %w", err)
+	}
+	if !doc.Found {
+		return nil, ErrNotFound
+	}
+	return &doc.Source, nil
+}
+
+// List returns up to limit saved queries of a namespace ordered by name, and the total
+// number of saved queries in the namespace.
+func (s *OpenSearchStore) List(ctx context.Context, namespace string, limit int) ([]SavedQuery, int, error) {
+	body, err := json.Marshal(map[string]any{
+		"size":             limit,
+		"track_total_hits": true,
+		"query":            map[string]any{"term": map[string]any{"namespace": namespace}},
+		"sort":             []any{map[string]any{"name": "asc"}},
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to marshal search: %w", err)
+	}
+	resp, err := s.do(ctx, http.MethodPost, s.indexPath()+"/_search", body)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, unexpectedStatus(resp)
+	}
+
+	var result struct {
+		Hits struct {
+			Total struct {
+				Value int `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source SavedQuery `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode search response: %w", err)
+	}
+
+	queries := make([]SavedQuery, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		queries = append(queries, hit.Source)
+	}
+	return queries, result.Hits.Total.Value, nil
+}
+
+// Delete removes a saved query. It returns ErrNotFound when it does not exist.
+func (s *OpenSearchStore) Delete(ctx context.Context, namespace, name string) error {
+	resp, err := s.do(ctx, http.MethodDelete, s.docPath("_doc", namespace, name)+"?refresh=wait_for", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return ErrNotFound
+	default:
+		return unexpectedStatus(resp)
+	}
+}
+
+func (s *OpenSearchStore) indexPath() string {
+	return "/" + url.PathEscape(s.config.Index)
+}
+
+// docPath returns the path of a saved query document for the given document API.
+// Namespace and name are DNS labels, so joining them with a slash is unambiguous.
+func (s *OpenSearchStore) docPath(api, namespace, name string) string {
+	return s.indexPath() + "/" + api + "/" + url.PathEscape(namespace+"/"+name)
+}
+
+func (s *OpenSearchStore) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.config.OpenSearchURL+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.config.OpenSearchUsername != "" {
+		req.SetBasicAuth(s.config.OpenSearchUsername, s.config.OpenSearchPassword)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call OpenSearch: %w", err)
+	}
+	return resp, nil
+}
+
+func unexpectedStatus(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("OpenSearch returned status %d: %s", resp.StatusCode, string(body))
+}
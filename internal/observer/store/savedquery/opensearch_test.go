@@ -0,0 +1,167 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package savedquery
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+)
+
+const testIndex = "openchoreo-saved-queries"
+
+// fakeOpenSearch is an in-memory OpenSearch serving the index and document APIs used by the store.
+type fakeOpenSearch struct {
+	mu          sync.Mutex
+	indexExists bool
+	mappings    json.RawMessage
+	docs        map[string]json.RawMessage
+}
+
+func (f *fakeOpenSearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.EscapedPath(), "/"+testIndex)
+	switch {
+	case path == "" && r.Method == http.MethodHead:
+		if !f.indexExists {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case path == "" && r.Method == http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.mappings = body
+		f.indexExists = true
+	case path == "/_search":
+		var search struct {
+			Query struct {
+				Term struct {
+					Namespace string `json:"namespace"`
+				} `json:"term"`
+			} `json:"query"`
+			Size int `json:"size"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&search)
+		ids := make([]string, 0, len(f.docs))
+		for id := range f.docs {
+			if strings.HasPrefix(id, search.Query.Term.Namespace+"%2F") {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		hits := make([]map[string]json.RawMessage, 0, len(ids))
+		for i, id := range ids {
+			if i >= search.Size {
+				break
+			}
+			hits = append(hits, map[string]json.RawMessage{"_source": f.docs[id]})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"hits": map[string]any{"total": map[string]int{"value": len(ids)}, "hits": hits},
+		})
+	case strings.HasPrefix(path, "/_create/"):
+		id := strings.TrimPrefix(path, "/_create/")
+		if _, ok := f.docs[id]; ok {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		f.docs[id] = body
+		w.WriteHeader(http.StatusCreated)
+	case strings.HasPrefix(path, "/_doc/"):
+		id := strings.TrimPrefix(path, "/_doc/")
+		doc, ok := f.docs[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"found":false}`))
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(f.docs, id)
+			return
+		}
+		_, _ = w.Write([]byte(`{"found":true,"_source":` + string(doc) + `}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func newTestStore(t *testing.T, srv *fakeOpenSearch) *OpenSearchStore {
+	t.Helper()
+	server := httptest.NewServer(srv)
+	t.Cleanup(server.Close)
+	return NewOpenSearchStore(&config.SavedQueriesConfig{
+		Enabled:       true,
+		OpenSearchURL: server.URL,
+		Index:         testIndex,
+		Timeout:       5 * time.Second,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestOpenSearchStore_Initialize(t *testing.T) {
+	srv := &fakeOpenSearch{docs: map[string]json.RawMessage{}}
+	s := newTestStore(t, srv)
+
+	require.NoError(t, s.Initialize(context.Background()))
+	assert.True(t, srv.indexExists)
+	assert.Contains(t, string(srv.mappings), `"dynamic":"strict"`)
+
+	srv.mappings = nil
+	require.NoError(t, s.Initialize(context.Background()))
+	assert.Nil(t, srv.mappings, "existing index must not be recreated")
+}
+
+func TestOpenSearchStore_CRUD(t *testing.T) {
+	srv := &fakeOpenSearch{indexExists: true, docs: map[string]json.RawMessage{}}
+	s := newTestStore(t, srv)
+	ctx := context.Background()
+
+	defaultEnv := "dev"
+	query := &SavedQuery{
+		Namespace: "acme",
+		Name:      "checkout-errors",
+		Kind:      "logs",
+		Query:     json.RawMessage(`{"searchScope":{"namespace":"acme","environment":"{{env}}"}}`),
+		Parameters: []Parameter{
+			{Name: "env", Default: &defaultEnv},
+		},
+		CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	require.NoError(t, s.Create(ctx, query))
+	assert.ErrorIs(t, s.Create(ctx, query), ErrAlreadyExists)
+	require.NoError(t, s.Create(ctx, &SavedQuery{Namespace: "acme", Name: "api-latency", Kind: "traces", Query: json.RawMessage(`{}`)}))
+	require.NoError(t, s.Create(ctx, &SavedQuery{Namespace: "other", Name: "api-latency", Kind: "traces", Query: json.RawMessage(`{}`)}))
+
+	got, err := s.Get(ctx, "acme", "checkout-errors")
+	require.NoError(t, err)
+	assert.Equal(t, "logs", got.Kind)
+	assert.JSONEq(t, string(query.Query), string(got.Query))
+	require.Len(t, got.Parameters, 1)
+	assert.Equal(t, "dev", *got.Parameters[0].Default)
+	assert.True(t, query.CreatedAt.Equal(got.CreatedAt))
+
+	list, total, err := s.List(ctx, "acme", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, list, 1)
+	assert.Equal(t, "api-latency", list[0].Name)
+
+	require.NoError(t, s.Delete(ctx, "acme", "checkout-errors"))
+	assert.ErrorIs(t, s.Delete(ctx, "acme", "checkout-errors"), ErrNotFound)
+	_, err = s.Get(ctx, "acme", "checkout-errors")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package savedquery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// SavedQuery represents one saved query persisted by the observer.
+type SavedQuery struct {
+	Namespace   string          `json:"namespace"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Kind        string          `json:"kind"`
+	Query       json.RawMessage `json:"query"`
+	Parameters  []Parameter     `json:"parameters,omitempty"`
+	CreatedBy   string          `json:"createdBy,omitempty"`
+	CreatedAt   time.Time       `json:"createdAt"`
+}
+
+// Parameter is a placeholder of a saved query.
+type Parameter struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Default     *string `json:"default,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+}
+
+// ErrNotFound is returned when a saved query with the given name does not exist.
+var ErrNotFound = errors.New("saved query not found")
+
+// ErrAlreadyExists is returned when a saved query with the given name already exists.
+var ErrAlreadyExists = errors.New("saved query already exists")
+
+// SavedQueryStore defines lifecycle, read and write operations for saved query persistence.
+type SavedQueryStore interface {
+	Initialize(ctx context.Context) error
+	Create(ctx context.Context, query *SavedQuery) error
+	Get(ctx context.Context, namespace, name string) (*SavedQuery, error)
+	// List returns up to limit saved queries of a namespace, ordered by name, and the
+	// total number of saved queries in the namespace.
+	List(ctx context.Context, namespace string, limit int) ([]SavedQuery, int, error)
+	Delete(ctx context.Context, namespace, name string) error
+}
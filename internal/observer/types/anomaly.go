@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "time"
+
+// AnomalyDetectionRequest is the request body for POST /api/v1alpha1/anomalies/detect.
+// Matches the OpenAPI AnomalyDetectionRequest schema.
+type AnomalyDetectionRequest struct {
+	// SearchScope identifies the component and environment to evaluate. namespace,
+	// project, component, and environment are all required for this endpoint.
+	SearchScope ComponentSearchScope `json:"searchScope"`
+
+	// DetectorName identifies the detector that raises the anomalies. Required with Actions.
+	DetectorName string `json:"detectorName,omitempty"`
+
+	// Signals to evaluate: requestRate, errorRatio and latency. Defaults to all of them.
+	Signals []string `json:"signals,omitempty"`
+
+	// Sensitivity is low, medium or high. Defaults to medium.
+	Sensitivity string `json:"sensitivity,omitempty"`
+
+	// Seasonality is the baseline period as a Go duration. Defaults to 168h.
+	Seasonality string `json:"seasonality,omitempty"`
+
+	// Seasons is the number of past periods in the baseline. Defaults to 4.
+	Seasons int `json:"seasons,omitempty"`
+
+	// Window is the evaluated time range as a Go duration. Defaults to 10m.
+	Window string `json:"window,omitempty"`
+
+	// EndTime is the end of the evaluated window (RFC3339). Defaults to now.
+	EndTime string `json:"endTime,omitempty"`
+
+	// Actions raises anomalies through the alerting pipeline. When nil, anomalies are
+	// only reported in the response.
+	Actions *AnomalyActions `json:"actions,omitempty"`
+}
+
+// AnomalyActions defines how anomalies are raised through the alerting pipeline.
+type AnomalyActions struct {
+	Severity             string   `json:"severity,omitempty"`
+	NotificationChannels []string `json:"notificationChannels,omitempty"`
+	IncidentEnabled      bool     `json:"incidentEnabled,omitempty"`
+	TriggerAiRca         bool     `json:"triggerAiRca,omitempty"`
+}
+
+// AnomalySignalResult is the evaluation of one signal.
+type AnomalySignalResult struct {
+	Signal           string  `json:"signal"`
+	Value            float64 `json:"value"`
+	Baseline         float64 `json:"baseline"`
+	StdDev           float64 `json:"stdDev"`
+	Score            float64 `json:"score"`
+	BaselineSamples  int     `json:"baselineSamples"`
+	Anomalous        bool    `json:"anomalous"`
+	InsufficientData bool    `json:"insufficientData,omitempty"`
+	// AlertID is the alert raised for the anomaly, if any.
+	AlertID string `json:"alertId,omitempty"`
+}
+
+// AnomalyDetectionResponse is the response body for POST /api/v1alpha1/anomalies/detect.
+type AnomalyDetectionResponse struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// Threshold is the score a signal must exceed to be anomalous.
+	Threshold float64               `json:"threshold"`
+	Signals   []AnomalySignalResult `json:"signals"`
+	// Warnings lists anomalies that could not be raised.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// AnomalyEvent is an anomaly raised through the alerting pipeline.
+type AnomalyEvent struct {
+	DetectorName string
+	Namespace    string
+	Project      string
+	Component    string
+	Environment  string
+	Timestamp    time.Time
+	Window       time.Duration
+	Threshold    float64
+	Result       AnomalySignalResult
+	Actions      AnomalyActions
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "time"
+
+// CorrelationQueryRequest is the request body for POST /api/v1alpha1/correlations/query.
+// Matches the OpenAPI CorrelationQueryRequest schema.
+type CorrelationQueryRequest struct {
+	// SearchScope identifies the component and environment to investigate. namespace,
+	// project, component, and environment are all required for this endpoint.
+	SearchScope ComponentSearchScope `json:"searchScope"`
+
+	// TraceID pivots from a trace. Its spans are returned and anchor the time window.
+	TraceID string `json:"traceId,omitempty"`
+
+	// Timestamp pivots from a log entry (RFC3339). Required when TraceID is not set.
+	Timestamp string `json:"timestamp,omitempty"`
+
+	// PodName restricts the surrounding logs to a single pod. When pivoting from a trace
+	// it defaults to the k8s.pod.name resource attribute of the trace's spans.
+	PodName string `json:"podName,omitempty"`
+
+	// Window is how far before and after the anchor time logs are collected, as a Go
+	// duration (e.g. "30s"). Defaults to 30s.
+	Window string `json:"window,omitempty"`
+
+	// LogLimit bounds the number of surrounding log entries. Defaults to 100.
+	LogLimit int `json:"logLimit,omitempty"`
+}
+
+// CorrelationMetrics holds the metric snapshots around the anchor time.
+type CorrelationMetrics struct {
+	Resource *ResourceMetricsQueryResponse `json:"resource,omitempty"`
+	HTTP     *HTTPMetricsQueryResponse     `json:"http,omitempty"`
+}
+
+// CorrelationQueryResponse is the response body for POST /api/v1alpha1/correlations/query.
+type CorrelationQueryResponse struct {
+	// AnchorTime is the time the windows are centered on: the log entry timestamp or the
+	// start of the trace.
+	AnchorTime time.Time `json:"anchorTime"`
+	StartTime  time.Time `json:"startTime"`
+	EndTime    time.Time `json:"endTime"`
+
+	// PodName is the pod the logs were restricted to, if any.
+	PodName string `json:"podName,omitempty"`
+
+	Spans   *SpansQueryResponse `json:"spans,omitempty"`
+	Logs    *LogsQueryResponse  `json:"logs,omitempty"`
+	Metrics CorrelationMetrics  `json:"metrics"`
+
+	// Warnings lists the signals that could not be retrieved. The others are still returned.
+	Warnings []string `json:"warnings,omitempty"`
+}
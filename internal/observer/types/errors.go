@@ -43,6 +43,63 @@ const (
 	ErrorCodeV1RuntimeTopologyResolverFailed  = "OBS-V1-RG-04"
 	ErrorCodeV1RuntimeTopologyRetrievalFailed = "OBS-V1-RG-05"
 
+	// SLO error budget API (v1alpha1) internal server error codes.
+	ErrorCodeV1SLOInternalGeneric = "OBS-V1-SLO-01"
+	ErrorCodeV1SLOServiceNotReady = "OBS-V1-SLO-03"
+	ErrorCodeV1SLOResolverFailed  = "OBS-V1-SLO-04"
+	ErrorCodeV1SLORetrievalFailed = "OBS-V1-SLO-05"
+
+	// Resource recommendation API (v1alpha1) internal server error codes.
+	ErrorCodeV1RecommendationInternalGeneric = "OBS-V1-RR-01"
+	ErrorCodeV1RecommendationServiceNotReady = "OBS-V1-RR-03"
+	ErrorCodeV1RecommendationResolverFailed  = "OBS-V1-RR-04"
+	ErrorCodeV1RecommendationRetrievalFailed = "OBS-V1-RR-05"
+
+	// Idle workloads API (v1alpha1) internal server error codes.
+	ErrorCodeV1IdleWorkloadsInternalGeneric = "OBS-V1-IW-01"
+	ErrorCodeV1IdleWorkloadsServiceNotReady = "OBS-V1-IW-03"
+
+	// Correlation API (v1alpha1) internal server error codes.
+	ErrorCodeV1CorrelationInternalGeneric = "OBS-V1-CR-01"
+	ErrorCodeV1CorrelationServiceNotReady = "OBS-V1-CR-03"
+	ErrorCodeV1CorrelationResolverFailed  = "OBS-V1-CR-04"
+	ErrorCodeV1CorrelationRetrievalFailed = "OBS-V1-CR-05"
+
+	// Federation API (v1alpha1) internal server error codes.
+	ErrorCodeV1FederationInternalGeneric = "OBS-V1-FD-01"
+	ErrorCodeV1FederationServiceNotReady = "OBS-V1-FD-03"
+	ErrorCodeV1FederationResolverFailed  = "OBS-V1-FD-04"
+	ErrorCodeV1FederationRetrievalFailed = "OBS-V1-FD-05"
+
+	// Log metrics API (v1alpha1) internal server error codes.
+	ErrorCodeV1LogMetricsInternalGeneric = "OBS-V1-LM-01"
+	ErrorCodeV1LogMetricsServiceNotReady = "OBS-V1-LM-03"
+	ErrorCodeV1LogMetricsResolverFailed  = "OBS-V1-LM-04"
+	ErrorCodeV1LogMetricsRetrievalFailed = "OBS-V1-LM-05"
+
+	// Anomaly detection API (v1alpha1) internal server error codes.
+	ErrorCodeV1AnomalyInternalGeneric = "OBS-V1-AD-01"
+	ErrorCodeV1AnomalyServiceNotReady = "OBS-V1-AD-03"
+	ErrorCodeV1AnomalyResolverFailed  = "OBS-V1-AD-04"
+	ErrorCodeV1AnomalyRetrievalFailed = "OBS-V1-AD-05"
+
+	// Promotion notification API (v1alpha1) internal server error codes.
+	ErrorCodeV1PromotionInternalGeneric = "OBS-V1-PN-01"
+	ErrorCodeV1PromotionServiceNotReady = "OBS-V1-PN-03"
+
+	// Raw query API (v1alpha1) internal server error codes.
+	ErrorCodeV1RawQueryInternalGeneric = "OBS-V1-RQ-01"
+	ErrorCodeV1RawQueryServiceNotReady = "OBS-V1-RQ-03"
+	ErrorCodeV1RawQueryRetrievalFailed = "OBS-V1-RQ-05"
+
+	// Log export API (v1alpha1) internal server error codes.
+	ErrorCodeV1ExportInternalGeneric = "OBS-V1-EX-01"
+	ErrorCodeV1ExportServiceNotReady = "OBS-V1-EX-03"
+
+	// Saved query API (v1alpha1) internal server error codes.
+	ErrorCodeV1SavedQueryInternalGeneric = "OBS-V1-SQ-01"
+	ErrorCodeV1SavedQueryServiceNotReady = "OBS-V1-SQ-03"
+
 	// Scope resolution auth failure — shared across all APIs.
 	ErrorCodeV1ScopeAuthFailed = "OBS-V1-SCOPE-AUTH-FAILED"
 )
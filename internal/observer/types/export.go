@@ -0,0 +1,123 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "time"
+
+// Export modes.
+const (
+	ExportModeScheduled  = "scheduled"
+	ExportModeContinuous = "continuous"
+)
+
+// Export sink types.
+const (
+	ExportSinkTypeS3   = "s3"
+	ExportSinkTypeGCS  = "gcs"
+	ExportSinkTypeHTTP = "http"
+)
+
+// Export compression formats.
+const (
+	ExportCompressionNone = "none"
+	ExportCompressionGzip = "gzip"
+)
+
+// ExportRequest is the request body for PUT /api/v1alpha1/exports/{namespace}/{name}.
+// Matches the OpenAPI ExportRequest schema.
+type ExportRequest struct {
+	// Filter selects the logs of the namespace to export.
+	Filter ExportFilter `json:"filter"`
+
+	// Mode is scheduled or continuous. Defaults to scheduled.
+	Mode string `json:"mode,omitempty"`
+
+	// Interval is how often a scheduled export runs, as a Go duration. Defaults to 1h.
+	Interval string `json:"interval,omitempty"`
+
+	// Sink is the destination of the exported logs.
+	Sink ExportSink `json:"sink"`
+
+	// Compression is none or gzip. Defaults to gzip.
+	Compression string `json:"compression,omitempty"`
+
+	// Suspend pauses the export without losing its checkpoint.
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Since is the checkpoint recorded by the controller (RFC3339). Logs before it are not
+	// exported again when the observer restarts.
+	Since string `json:"since,omitempty"`
+}
+
+// ExportFilter selects the logs to export. Project, component and environment narrow the
+// scope of the namespace.
+type ExportFilter struct {
+	Project      string   `json:"project,omitempty"`
+	Component    string   `json:"component,omitempty"`
+	Environment  string   `json:"environment,omitempty"`
+	LogLevels    []string `json:"logLevels,omitempty"`
+	SearchPhrase string   `json:"searchPhrase,omitempty"`
+}
+
+// ExportSink is the destination of an export. Exactly the block matching Type is set.
+type ExportSink struct {
+	Type string          `json:"type"`
+	S3   *ExportS3Sink   `json:"s3,omitempty"`
+	GCS  *ExportGCSSink  `json:"gcs,omitempty"`
+	HTTP *ExportHTTPSink `json:"http,omitempty"`
+}
+
+// ExportSecretRef references a key of a Secret in the namespace of the export. Secrets are
+// read by the observer when the export runs, so credentials never leave the cluster.
+type ExportSecretRef struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// ExportS3Sink writes exported logs as objects to an S3 or S3-compatible bucket.
+type ExportS3Sink struct {
+	Bucket string `json:"bucket"`
+	Region string `json:"region"`
+	// Endpoint overrides the AWS endpoint for S3-compatible storage.
+	Endpoint        string          `json:"endpoint,omitempty"`
+	Prefix          string          `json:"prefix,omitempty"`
+	AccessKeyID     ExportSecretRef `json:"accessKeyId"`
+	SecretAccessKey ExportSecretRef `json:"secretAccessKey"`
+}
+
+// ExportGCSSink writes exported logs as objects to a Google Cloud Storage bucket.
+type ExportGCSSink struct {
+	Bucket string `json:"bucket"`
+	Prefix string `json:"prefix,omitempty"`
+	// Credentials references a service account JSON key.
+	Credentials ExportSecretRef `json:"credentials"`
+}
+
+// ExportHTTPSink posts exported logs to an HTTP endpoint.
+type ExportHTTPSink struct {
+	URL     string                       `json:"url"`
+	Headers map[string]ExportHeaderValue `json:"headers,omitempty"`
+}
+
+// ExportHeaderValue is a header value provided inline or read from a Secret.
+type ExportHeaderValue struct {
+	Value     string           `json:"value,omitempty"`
+	SecretRef *ExportSecretRef `json:"secretRef,omitempty"`
+}
+
+// ExportStatus is the response body for PUT /api/v1alpha1/exports/{namespace}/{name}.
+// It reports the progress of the export.
+type ExportStatus struct {
+	// LastExportTime is the end of the last exported window; the checkpoint of the export.
+	LastExportTime *time.Time `json:"lastExportTime,omitempty"`
+
+	// LastRunTime is when the export last ran.
+	LastRunTime *time.Time `json:"lastRunTime,omitempty"`
+
+	// ExportedEntries is the number of log entries exported since the export was registered.
+	ExportedEntries int64 `json:"exportedEntries"`
+
+	// LastError describes why the last run failed. Empty when it succeeded.
+	LastError string `json:"lastError,omitempty"`
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// Federated plane query statuses.
+const (
+	FederatedPlaneStatusSucceeded = "succeeded"
+	FederatedPlaneStatusFailed    = "failed"
+)
+
+// FederatedPlaneStatus reports the outcome of the query to one observability plane.
+type FederatedPlaneStatus struct {
+	// Name is the name of the observability plane.
+	Name string `json:"name"`
+
+	// Local is true for the plane served by the observer that answered the request.
+	Local bool `json:"local"`
+
+	// Environments lists the environments of the component whose data the plane holds.
+	Environments []string `json:"environments"`
+
+	// Status is "succeeded" or "failed".
+	Status string `json:"status"`
+
+	// Error describes why the query to the plane failed.
+	Error string `json:"error,omitempty"`
+}
+
+// FederatedLogEntry is a log entry attributed to the observability plane it was read from.
+type FederatedLogEntry struct {
+	LogEntry
+	ObservabilityPlane string `json:"observabilityPlane"`
+}
+
+// FederatedLogsQueryResponse is the response body for POST /api/v1alpha1/federation/logs/query.
+type FederatedLogsQueryResponse struct {
+	Logs   []FederatedLogEntry `json:"logs"`
+	Total  int                 `json:"total"`
+	TookMs int                 `json:"tookMs"`
+
+	// Planes lists the observability planes that were queried. A failed plane does not fail
+	// the query as long as another plane succeeded.
+	Planes []FederatedPlaneStatus `json:"planes"`
+
+	// Warnings lists the environments whose observability plane could not be resolved.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// FederatedMetricsResult holds the metrics returned by one observability plane.
+type FederatedMetricsResult struct {
+	ObservabilityPlane string   `json:"observabilityPlane"`
+	Environments       []string `json:"environments"`
+
+	// Metrics is a ResourceMetricsQueryResponse or an HTTPMetricsQueryResponse, depending on
+	// the requested metric.
+	Metrics any `json:"metrics"`
+}
+
+// FederatedMetricsQueryResponse is the response body for POST /api/v1alpha1/federation/metrics/query.
+// Series are not summed across planes; each plane's series are returned separately.
+type FederatedMetricsQueryResponse struct {
+	Results []FederatedMetricsResult `json:"results"`
+
+	// Planes lists the observability planes that were queried. A failed plane does not fail
+	// the query as long as another plane succeeded.
+	Planes []FederatedPlaneStatus `json:"planes"`
+
+	// Warnings lists the environments whose observability plane could not be resolved.
+	Warnings []string `json:"warnings,omitempty"`
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "time"
+
+// IdleWorkloadsRequest is the request body for POST /api/v1alpha1/metrics/idle-workloads.
+// Matches the OpenAPI IdleWorkloadsRequest schema.
+type IdleWorkloadsRequest struct {
+	// Namespace is required; Project and Environment narrow the report.
+	Namespace   string `json:"namespace"`
+	Project     string `json:"project,omitempty"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// IdleWorkload is a deployed component whose traffic and CPU usage stayed below the idle
+// thresholds over the analysis window.
+type IdleWorkload struct {
+	Namespace      string `json:"namespace"`
+	Project        string `json:"project"`
+	Component      string `json:"component"`
+	Environment    string `json:"environment"`
+	ReleaseBinding string `json:"releaseBinding"`
+
+	// WindowStart and WindowEnd bound the analyzed usage history.
+	WindowStart time.Time `json:"windowStart"`
+	WindowEnd   time.Time `json:"windowEnd"`
+
+	// CPUP95Cores is the 95th percentile CPU usage and PeakRequestRate the highest
+	// request rate (requests per second) observed in the window.
+	CPUP95Cores     float64 `json:"cpuP95Cores"`
+	PeakRequestRate float64 `json:"peakRequestRate"`
+
+	// CPURequestCores and MemoryRequestBytes are the latest requested resources.
+	CPURequestCores    float64 `json:"cpuRequestCores"`
+	MemoryRequestBytes float64 `json:"memoryRequestBytes"`
+
+	// EstimatedMonthlySavings prices the requested resources that suspending would free.
+	EstimatedMonthlySavings float64 `json:"estimatedMonthlySavings"`
+
+	// SuspendURL is the openchoreo-api endpoint that suspends the release binding (POST).
+	SuspendURL string `json:"suspendUrl"`
+}
+
+// IdleWorkloadsResponse is the response body for POST /api/v1alpha1/metrics/idle-workloads.
+type IdleWorkloadsResponse struct {
+	// GeneratedAt is when the analysis that produced the report finished.
+	GeneratedAt                  time.Time      `json:"generatedAt"`
+	Currency                     string         `json:"currency"`
+	TotalEstimatedMonthlySavings float64        `json:"totalEstimatedMonthlySavings"`
+	Items                        []IdleWorkload `json:"items"`
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// LogMetricQueryRequest is the request body for POST /api/v1alpha1/metrics/log-metrics/query.
+// Matches the OpenAPI LogMetricQueryRequest schema.
+type LogMetricQueryRequest struct {
+	// SearchScope identifies the component logs to evaluate. namespace, project,
+	// component, and environment are all required for this endpoint.
+	SearchScope ComponentSearchScope `json:"searchScope"`
+
+	// Time range for the query (RFC3339, required).
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+
+	// Step is the query resolution step (e.g. "1m", "5m"). Defaults to the whole range.
+	Step *string `json:"step,omitempty"`
+
+	// Metric defines how the metric is derived from the log lines.
+	Metric LogMetricDefinition `json:"metric"`
+}
+
+// LogMetricDefinition describes a counter or histogram derived from log lines.
+type LogMetricDefinition struct {
+	// Name identifies the metric in the response.
+	Name string `json:"name"`
+	// Type is "counter" or "histogram".
+	Type string `json:"type"`
+	// Pattern is an RE2 regular expression that log lines must match. Histogram patterns
+	// need a capture group named "value" that holds the observed number.
+	Pattern string `json:"pattern"`
+	// SearchPhrase pre-filters the log lines in the log store before the pattern is applied.
+	SearchPhrase string `json:"searchPhrase,omitempty"`
+	// LogLevels restricts the evaluation to the given log levels.
+	LogLevels []string `json:"logLevels,omitempty"`
+	// Buckets are the histogram bucket upper bounds in ascending order.
+	Buckets []float64 `json:"buckets,omitempty"`
+}
+
+// LogMetricQueryResponse is the response body for POST /api/v1alpha1/metrics/log-metrics/query.
+// Sum, quantile and bucket fields are only set for histograms.
+type LogMetricQueryResponse struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+
+	// Count is the number of matching log lines per step.
+	Count []MetricsTimeSeriesItem `json:"count"`
+	// Sum is the sum of the extracted values per step.
+	Sum []MetricsTimeSeriesItem `json:"sum,omitempty"`
+	// P50, P90 and P99 are the quantiles of the extracted values per step. Steps
+	// without observations are omitted.
+	P50 []MetricsTimeSeriesItem `json:"p50,omitempty"`
+	P90 []MetricsTimeSeriesItem `json:"p90,omitempty"`
+	P99 []MetricsTimeSeriesItem `json:"p99,omitempty"`
+	// Buckets are the cumulative bucket counts over the whole range.
+	Buckets []LogMetricBucket `json:"buckets,omitempty"`
+
+	// EvaluatedLines is the number of log lines the metric was evaluated over.
+	EvaluatedLines int `json:"evaluatedLines"`
+	// Truncated is true when the log store held more lines than could be evaluated,
+	// in which case the values are a lower bound.
+	Truncated bool `json:"truncated"`
+}
+
+// LogMetricBucket is a cumulative histogram bucket. UpperBound is "+Inf" for the last bucket.
+type LogMetricBucket struct {
+	UpperBound string `json:"upperBound"`
+	Count      int64  `json:"count"`
+}
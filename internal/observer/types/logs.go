@@ -39,6 +39,9 @@ type LogsQueryRequest struct {
 	SearchPhrase string   `json:"searchPhrase,omitempty"`
 	LogLevels    []string `json:"logLevels,omitempty"`
 
+	// FieldFilters match fields extracted by the component's log parsing profile exactly
+	FieldFilters map[string]string `json:"fieldFilters,omitempty"`
+
 	// Pagination and sorting
 	Limit     int    `json:"limit,omitempty"`
 	SortOrder string `json:"sortOrder,omitempty"` // asc or desc, default: desc
@@ -137,10 +140,11 @@ type LogMetadata struct {
 // Used for both component and workflow logs
 // Matches OpenAPI ComponentLogEntry/WorkflowLogEntry schemas
 type LogEntry struct {
-	Timestamp string       `json:"timestamp"`
-	Log       string       `json:"log"`
-	Level     string       `json:"level,omitempty"`
-	Metadata  *LogMetadata `json:"metadata,omitempty"`
+	Timestamp string            `json:"timestamp"`
+	Log       string            `json:"log"`
+	Level     string            `json:"level,omitempty"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	Metadata  *LogMetadata      `json:"metadata,omitempty"`
 }
 
 // LogsQueryResponse represents the response for POST /api/v1/logs/query
@@ -0,0 +1,26 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// PromotionNotificationRequest is the request body for POST /api/v1alpha1/notifications/promotions.
+// Matches the OpenAPI PromotionNotificationRequest schema.
+type PromotionNotificationRequest struct {
+	Namespace      string `json:"namespace"`
+	Project        string `json:"project"`
+	Component      string `json:"component"`
+	Environment    string `json:"environment"`
+	ReleaseBinding string `json:"releaseBinding,omitempty"`
+
+	// FromRelease is empty for the initial deployment of the component to the environment.
+	FromRelease string `json:"fromRelease,omitempty"`
+	ToRelease   string `json:"toRelease"`
+	FromImage   string `json:"fromImage,omitempty"`
+	ToImage     string `json:"toImage,omitempty"`
+	FromCommit  string `json:"fromCommit,omitempty"`
+	ToCommit    string `json:"toCommit,omitempty"`
+
+	// Summary is the one-line summary of the release changelog.
+	Summary              string   `json:"summary"`
+	NotificationChannels []string `json:"notificationChannels"`
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "encoding/json"
+
+// PromQLQueryRequest is the request body for POST /api/v1alpha1/raw/promql/query.
+// Matches the OpenAPI PromQLQueryRequest schema.
+type PromQLQueryRequest struct {
+	// Namespace scopes every selector of the query.
+	Namespace string `json:"namespace"`
+	Query     string `json:"query"`
+	// StartTime and EndTime are RFC3339 timestamps. Without a step the query is an instant
+	// query evaluated at EndTime and StartTime may be omitted.
+	StartTime string `json:"startTime,omitempty"`
+	EndTime   string `json:"endTime"`
+	// Step is the range query resolution as a duration, e.g. "1m".
+	Step string `json:"step,omitempty"`
+}
+
+// PromQLQueryResponse is the response body for POST /api/v1alpha1/raw/promql/query.
+type PromQLQueryResponse struct {
+	// Query is the namespace scoped query that was executed.
+	Query string `json:"query"`
+	// ResultType and Result are passed through from the Prometheus HTTP API.
+	ResultType string          `json:"resultType"`
+	Result     json.RawMessage `json:"result"`
+	// Truncated reports that series beyond the result limit were dropped.
+	Truncated bool     `json:"truncated"`
+	Warnings  []string `json:"warnings,omitempty"`
+}
+
+// OpenSearchQueryRequest is the request body for POST /api/v1alpha1/raw/opensearch/search.
+// Matches the OpenAPI OpenSearchQueryRequest schema.
+type OpenSearchQueryRequest struct {
+	// Namespace scopes the searched documents.
+	Namespace string `json:"namespace"`
+	// LogType selects the indices to search: runtime, build or gateway.
+	LogType   string `json:"logType"`
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+	// Body is an OpenSearch search request body. Only query, aggs/aggregations, sort, size,
+	// from, _source and track_total_hits are accepted.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// OpenSearchQueryResponse is the response body for POST /api/v1alpha1/raw/opensearch/search.
+type OpenSearchQueryResponse struct {
+	Took     int  `json:"took"`
+	TimedOut bool `json:"timedOut"`
+	// Hits and Aggregations are passed through from the OpenSearch search response.
+	Hits         json.RawMessage `json:"hits"`
+	Aggregations json.RawMessage `json:"aggregations,omitempty"`
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "time"
+
+// ResourceRecommendationRequest is the request body for
+// POST /api/v1alpha1/metrics/resource-recommendations.
+// Matches the OpenAPI ResourceRecommendationRequest schema.
+type ResourceRecommendationRequest struct {
+	// SearchScope identifies the component and environment to analyze. namespace,
+	// project, component, and environment are all required for this endpoint.
+	SearchScope ComponentSearchScope `json:"searchScope"`
+
+	// Window is the usage history to analyze as a Go duration (e.g. "168h").
+	// Defaults to seven days.
+	Window string `json:"window,omitempty"`
+
+	// EndTime is the end of the analyzed window (RFC3339). Defaults to now.
+	EndTime string `json:"endTime,omitempty"`
+}
+
+// ResourceQuantities holds CPU and memory quantities in Kubernetes notation
+// (e.g. "250m", "512Mi").
+type ResourceQuantities struct {
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+}
+
+// ResourceSettings pairs resource requests with limits.
+type ResourceSettings struct {
+	Requests ResourceQuantities `json:"requests"`
+	Limits   ResourceQuantities `json:"limits"`
+}
+
+// ResourceUsageSummary summarizes the usage the recommendation was derived from.
+type ResourceUsageSummary struct {
+	CPUP90Cores       float64 `json:"cpuP90Cores"`
+	CPUPeakCores      float64 `json:"cpuPeakCores"`
+	MemoryP95Bytes    float64 `json:"memoryP95Bytes"`
+	MemoryPeakBytes   float64 `json:"memoryPeakBytes"`
+	CPUSampleCount    int     `json:"cpuSampleCount"`
+	MemorySampleCount int     `json:"memorySampleCount"`
+}
+
+// ResourceRecommendationResponse is the response body for
+// POST /api/v1alpha1/metrics/resource-recommendations.
+type ResourceRecommendationResponse struct {
+	StartTime time.Time            `json:"startTime"`
+	EndTime   time.Time            `json:"endTime"`
+	Usage     ResourceUsageSummary `json:"usage"`
+
+	// Current holds the most recent requests and limits reported for the component.
+	Current ResourceSettings `json:"current"`
+
+	// Recommended is omitted when the window holds too few samples.
+	Recommended *ResourceSettings `json:"recommended,omitempty"`
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "encoding/json"
+
+// Saved query kinds. The kind selects the query endpoint a saved query is run against.
+const (
+	SavedQueryKindLogs   = "logs"
+	SavedQueryKindTraces = "traces"
+)
+
+// SavedQueryParameter declares a placeholder of a saved query template.
+// Matches the OpenAPI SavedQueryParameter schema.
+type SavedQueryParameter struct {
+	// Name is referenced as {{name}} in string values of the query template.
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Default is used when the parameter is not supplied at run time.
+	Default *string `json:"default,omitempty"`
+	// Required parameters without a default must be supplied at run time.
+	Required bool `json:"required,omitempty"`
+}
+
+// SavedQueryRequest is the request body for POST /api/v1alpha1/queries.
+// Matches the OpenAPI SavedQueryRequest schema.
+type SavedQueryRequest struct {
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Kind is logs or traces.
+	Kind string `json:"kind"`
+	// Query is the request body of the kind's query endpoint. String values may contain
+	// {{parameter}} placeholders.
+	Query      json.RawMessage       `json:"query"`
+	Parameters []SavedQueryParameter `json:"parameters,omitempty"`
+}
+
+// SavedQuery is a stored query template. Matches the OpenAPI SavedQuery schema.
+type SavedQuery struct {
+	Namespace   string                `json:"namespace"`
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	Kind        string                `json:"kind"`
+	Query       json.RawMessage       `json:"query"`
+	Parameters  []SavedQueryParameter `json:"parameters,omitempty"`
+	CreatedBy   string                `json:"createdBy,omitempty"`
+	CreatedAt   string                `json:"createdAt"`
+}
+
+// SavedQueryListResponse is the response of GET /api/v1alpha1/queries/{namespace}.
+type SavedQueryListResponse struct {
+	Items []SavedQuery `json:"items"`
+	Total int          `json:"total"`
+}
+
+// SavedQueryRunRequest is the request body for POST /api/v1alpha1/queries/{namespace}/{name}/run.
+type SavedQueryRunRequest struct {
+	// Parameters are the values substituted for the placeholders of the query template.
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "time"
+
+// ErrorBudgetRequest is the request body for POST /api/v1alpha1/slos/error-budget.
+// Matches the OpenAPI ErrorBudgetRequest schema.
+type ErrorBudgetRequest struct {
+	// SearchScope identifies the component and environment to evaluate. namespace,
+	// project, component, and environment are all required for this endpoint.
+	SearchScope ComponentSearchScope `json:"searchScope"`
+
+	// Endpoint restricts the evaluation to gateway traffic for a single component
+	// endpoint. All gateway traffic to the component is evaluated when empty.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// AvailabilityTarget is the percentage of requests that must succeed (e.g. "99.9").
+	AvailabilityTarget string `json:"availabilityTarget,omitempty"`
+
+	// Latency is the optional latency objective.
+	Latency *ErrorBudgetLatencyObjective `json:"latency,omitempty"`
+
+	// Window is the compliance window as a Go duration (e.g. "720h").
+	Window string `json:"window"`
+
+	// EndTime is the end of the compliance window (RFC3339). Defaults to now.
+	EndTime string `json:"endTime,omitempty"`
+}
+
+// ErrorBudgetLatencyObjective is the latency part of an error budget request.
+type ErrorBudgetLatencyObjective struct {
+	// Percentile is one of p50, p90 or p99. Defaults to p99.
+	Percentile string `json:"percentile,omitempty"`
+	// ThresholdSeconds is the maximum allowed latency for the percentile.
+	ThresholdSeconds float64 `json:"thresholdSeconds"`
+}
+
+// ErrorBudgetResponse is the response body for POST /api/v1alpha1/slos/error-budget.
+// Availability and budget fields are omitted when no availability target was requested;
+// latency fields are omitted when no latency objective was requested.
+type ErrorBudgetResponse struct {
+	StartTime      time.Time `json:"startTime"`
+	EndTime        time.Time `json:"endTime"`
+	TotalRequests  float64   `json:"totalRequests"`
+	FailedRequests float64   `json:"failedRequests"`
+
+	// Availability is the observed percentage of successful requests.
+	Availability *float64 `json:"availability,omitempty"`
+	// ErrorBudgetRemaining is the fraction of the error budget left.
+	ErrorBudgetRemaining *float64 `json:"errorBudgetRemaining,omitempty"`
+	// BurnRate is the error budget burn rate over the last hour.
+	BurnRate  *float64 `json:"burnRate,omitempty"`
+	Exhausted bool     `json:"exhausted"`
+
+	// ObservedLatencySeconds is the observed value of the requested percentile.
+	ObservedLatencySeconds *float64 `json:"observedLatencySeconds,omitempty"`
+	LatencyMet             *bool    `json:"latencyMet,omitempty"`
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package airgap
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/openchoreo/openchoreo/internal/airgap"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// AirGap implements air-gap validation operations
+type AirGap struct {
+	client client.Interface
+}
+
+// New creates a new air-gap implementation
+func New(c client.Interface) *AirGap {
+	return &AirGap{client: c}
+}
+
+// template is a resource whose spec is rendered into workloads or workflow runs.
+type template struct {
+	kind string
+	name string
+	spec any
+}
+
+// violation is an external reference found in a template.
+type violation struct {
+	kind string
+	name string
+	ref  airgap.Reference
+}
+
+// Validate prints the external references in component types, traits and workflows that
+// are not covered by the mirror map, and returns an error when any are found.
+func (a *AirGap) Validate(params ValidateParams) error {
+	ctx := context.Background()
+
+	var cfg airgap.Config
+	if params.MirrorConfig != "" {
+		var err error
+		if cfg, err = airgap.LoadConfig(params.MirrorConfig); err != nil {
+			return err
+		}
+	}
+	policy, err := airgap.NewPolicy(true, cfg)
+	if err != nil {
+		return fmt.Errorf("invalid mirror config: %w", err)
+	}
+
+	templates, err := a.listTemplates(ctx, params.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var violations []violation
+	for _, t := range templates {
+		refs, err := policy.Scan(t.spec)
+		if err != nil {
+			return fmt.Errorf("failed to scan %s %s: %w", t.kind, t.name, err)
+		}
+		for _, ref := range refs {
+			ref.Path = "spec." + ref.Path
+			violations = append(violations, violation{kind: t.kind, name: t.name, ref: ref})
+		}
+	}
+
+	if err := printViolations(violations); err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("found %d external reference(s) without a mirror", len(violations))
+	}
+	return nil
+}
+
+func (a *AirGap) listTemplates(ctx context.Context, namespace string) ([]template, error) {
+	var templates []template
+
+	clusterComponentTypes, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterComponentType, string, error) {
+		p := &gen.ListClusterComponentTypesParams{Limit: &limit}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := a.client.ListClusterComponentTypes(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Items, nextCursor(result.Pagination), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range clusterComponentTypes {
+		templates = append(templates, template{kind: "ClusterComponentType", name: item.Metadata.Name, spec: item.Spec})
+	}
+
+	clusterTraits, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterTrait, string, error) {
+		p := &gen.ListClusterTraitsParams{Limit: &limit}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := a.client.ListClusterTraits(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Items, nextCursor(result.Pagination), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range clusterTraits {
+		templates = append(templates, template{kind: "ClusterTrait", name: item.Metadata.Name, spec: item.Spec})
+	}
+
+	clusterWorkflows, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterWorkflow, string, error) {
+		p := &gen.ListClusterWorkflowsParams{Limit: &limit}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := a.client.ListClusterWorkflows(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Items, nextCursor(result.Pagination), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range clusterWorkflows {
+		templates = append(templates, template{kind: "ClusterWorkflow", name: item.Metadata.Name, spec: item.Spec})
+	}
+
+	if namespace == "" {
+		return templates, nil
+	}
+
+	componentTypes, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ComponentType, string, error) {
+		p := &gen.ListComponentTypesParams{Limit: &limit}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := a.client.ListComponentTypes(ctx, namespace, p)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Items, nextCursor(result.Pagination), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range componentTypes {
+		templates = append(templates, template{kind: "ComponentType", name: item.Metadata.Name, spec: item.Spec})
+	}
+
+	traits, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.Trait, string, error) {
+		p := &gen.ListTraitsParams{Limit: &limit}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := a.client.ListTraits(ctx, namespace, p)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Items, nextCursor(result.Pagination), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range traits {
+		templates = append(templates, template{kind: "Trait", name: item.Metadata.Name, spec: item.Spec})
+	}
+
+	workflows, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.Workflow, string, error) {
+		p := &gen.ListWorkflowsParams{Limit: &limit}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := a.client.ListWorkflows(ctx, namespace, p)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Items, nextCursor(result.Pagination), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range workflows {
+		templates = append(templates, template{kind: "Workflow", name: item.Metadata.Name, spec: item.Spec})
+	}
+
+	return templates, nil
+}
+
+func nextCursor(p gen.Pagination) string {
+	if p.NextCursor == nil {
+		return ""
+	}
+	return *p.NextCursor
+}
+
+func printViolations(violations []violation) error {
+	if len(violations) == 0 {
+		fmt.Println("No external references without a mirror found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNAME\tPATH\tREFERENCE")
+	for _, v := range violations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", v.kind, v.name, v.ref.Path, v.ref.Value)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package airgap
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func expectClusterTemplates(mc *mocks.MockInterface, workflows []gen.ClusterWorkflow) {
+	mc.EXPECT().ListClusterComponentTypes(mock.Anything, mock.Anything).Return(&gen.ClusterComponentTypeList{}, nil)
+	mc.EXPECT().ListClusterTraits(mock.Anything, mock.Anything).Return(&gen.ClusterTraitList{}, nil)
+	mc.EXPECT().ListClusterWorkflows(mock.Anything, mock.Anything).Return(&gen.ClusterWorkflowList{Items: workflows}, nil)
+}
+
+func buildpacksWorkflow() gen.ClusterWorkflow {
+	return gen.ClusterWorkflow{
+		Metadata: gen.ObjectMeta{Name: "buildpacks"},
+		Spec: &gen.ClusterWorkflowSpec{
+			RunTemplate: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"templates": []interface{}{
+						map[string]interface{}{
+							"container": map[string]interface{}{
+								"image": "docker.io/paketobuildpacks/builder-jammy-base:0.4",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidate_ReportsUnmirroredReferences(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	expectClusterTemplates(mc, []gen.ClusterWorkflow{buildpacksWorkflow()})
+
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = New(mc).Validate(ValidateParams{})
+	})
+	assert.EqualError(t, err, "found 1 external reference(s) without a mirror")
+	assert.Contains(t, out, "KIND")
+	assert.Contains(t, out, "ClusterWorkflow")
+	assert.Contains(t, out, "spec.runTemplate.spec.templates[0].container.image")
+	assert.Contains(t, out, "docker.io/paketobuildpacks/builder-jammy-base:0.4")
+}
+
+func TestValidate_MirrorConfigCoversReferences(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "air-gap.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("mirrors:\n- source: docker.io\n  target: registry.internal/dockerhub\n"), 0o600))
+
+	mc := mocks.NewMockInterface(t)
+	expectClusterTemplates(mc, []gen.ClusterWorkflow{buildpacksWorkflow()})
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Validate(ValidateParams{MirrorConfig: path}))
+	})
+	assert.Contains(t, out, "No external references without a mirror found")
+}
+
+func TestValidate_IncludesNamespacedTemplates(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	expectClusterTemplates(mc, nil)
+	mc.EXPECT().ListComponentTypes(mock.Anything, "acme", mock.Anything).Return(&gen.ComponentTypeList{}, nil)
+	mc.EXPECT().ListTraits(mock.Anything, "acme", mock.Anything).Return(&gen.TraitList{}, nil)
+	mc.EXPECT().ListWorkflows(mock.Anything, "acme", mock.Anything).Return(&gen.WorkflowList{
+		Items: []gen.Workflow{{
+			Metadata: gen.ObjectMeta{Name: "checkout"},
+			Spec: &gen.WorkflowSpec{
+				RunTemplate: map[string]interface{}{
+					"args": []interface{}{"git clone https://github.com/acme/app.git"},
+				},
+			},
+		}},
+	}, nil)
+
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = New(mc).Validate(ValidateParams{Namespace: "acme"})
+	})
+	require.Error(t, err)
+	assert.Contains(t, out, "Workflow")
+	assert.Contains(t, out, "spec.runTemplate.args[0]")
+	assert.Contains(t, out, "https://github.com/acme/app.git")
+}
+
+func TestValidate_APIError(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListClusterComponentTypes(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
+
+	assert.EqualError(t, New(mc).Validate(ValidateParams{}), "server error")
+}
+
+func TestValidate_InvalidMirrorConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "air-gap.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("mirrors:\n- source: docker.io\n"), 0o600))
+
+	err := New(mocks.NewMockInterface(t)).Validate(ValidateParams{MirrorConfig: path})
+	assert.ErrorContains(t, err, "invalid mirror config")
+}
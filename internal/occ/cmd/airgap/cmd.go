@@ -0,0 +1,55 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package airgap
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewAirGapCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airgap",
+		Short: "Air-gapped installation tools",
+		Long:  `Tools for running OpenChoreo in air-gapped mode, where no artifacts are fetched from outside the installation.`,
+	}
+	cmd.AddCommand(newValidateCmd(f))
+	return cmd
+}
+
+func newValidateCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "List external references that violate the air-gap policy",
+		Long: `List the external URLs and container images in component types, traits and workflows
+that are not covered by the mirror map and would be rejected in air-gapped mode.
+
+Cluster-scoped resources are always checked; namespaced resources are checked when
+--namespace is set. The command exits with an error when any violation is found.`,
+		Example: `  # Check cluster-scoped templates against a mirror map
+  occ airgap validate --mirror-config air-gap.yaml
+
+  # Also check the templates of a namespace
+  occ airgap validate --mirror-config air-gap.yaml --namespace acme-corp`,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			mirrorConfig, _ := cmd.Flags().GetString("mirror-config")
+			return New(cl).Validate(ValidateParams{
+				Namespace:    flags.GetNamespace(cmd),
+				MirrorConfig: mirrorConfig,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	cmd.Flags().String("mirror-config", "",
+		"Path to the air-gap config with the mirror map and internal hosts (same format as the controller manager's --air-gap-config)")
+	return cmd
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package airgap
+
+// ValidateParams defines parameters for validating templates against the air-gap policy
+type ValidateParams struct {
+	Namespace    string // optional; namespaced templates are skipped when empty
+	MirrorConfig string // optional path to the mirror map
+}
@@ -28,6 +28,9 @@ func NewComponentCmd(f client.NewClientFunc) *cobra.Command {
 		newScaffoldCmd(f),
 		newDeployCmd(f),
 		newLogsCmd(f),
+		newIdleReportCmd(f),
+		newRawQueryCmd(f),
+		newSavedQueryCmd(f),
 		newExecCmd(f),
 		newWorkflowCmd(f),
 		newWorkflowRunCmd(f),
@@ -236,6 +239,8 @@ If --env is not specified, uses the lowest environment from the deployment pipel
 				return err
 			}
 			container, _ := cmd.Flags().GetString("container")
+			startTime, _ := cmd.Flags().GetString("start-time")
+			endTime, _ := cmd.Flags().GetString("end-time")
 			tail := flags.GetTail(cmd)
 			return New(cl).Logs(LogsParams{
 				Namespace:   flags.GetNamespace(cmd),
@@ -245,6 +250,8 @@ If --env is not specified, uses the lowest environment from the deployment pipel
 				Container:   container,
 				Follow:      flags.GetFollow(cmd),
 				Since:       flags.GetSince(cmd),
+				StartTime:   startTime,
+				EndTime:     endTime,
 				Tail:        tail,
 			})
 		},
@@ -254,12 +261,244 @@ If --env is not specified, uses the lowest environment from the deployment pipel
 	flags.AddEnvironment(cmd)
 	// No short alias: `-c` denotes --component elsewhere in the CLI.
 	cmd.Flags().String("container", "", "Container name to fetch logs from (defaults to all containers)")
+	cmd.Flags().String("start-time", "", "Start of the time range as an RFC3339 timestamp (overrides --since)")
+	cmd.Flags().String("end-time", "", "End of the time range as an RFC3339 timestamp (defaults to now)")
 	flags.AddFollow(cmd)
 	flags.AddSince(cmd)
 	flags.AddTail(cmd)
 	return cmd
 }
 
+func newIdleReportCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "idle-report",
+		Short: "List idle components in an environment",
+		Long: `List components whose traffic and CPU usage stayed near zero over the observer's
+idle detection window, with the estimated monthly savings of suspending each one.
+Suspend a component with a POST to its suspend URL.`,
+		Example: `  # List idle components in the dev environment
+  occ component idle-report --namespace acme-corp --env dev
+
+  # Restrict the report to a project
+  occ component idle-report --namespace acme-corp --project online-store --env dev`,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).IdleReport(IdleReportParams{
+				Namespace:   flags.GetNamespace(cmd),
+				Project:     flags.GetProject(cmd),
+				Environment: flags.GetEnvironment(cmd),
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	flags.AddEnvironment(cmd)
+	return cmd
+}
+
+func newRawQueryCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "raw-query",
+		Short: "Run a raw PromQL query or OpenSearch search in a namespace",
+		Long: `Run a PromQL query or an OpenSearch search body through the observer and print the
+JSON response. The observer restricts the query to the namespace and enforces its time
+range and result size limits. Requires the rawquery:execute permission, which only
+administrators have by default.`,
+		Example: `  # Per-pod CPU usage over the last hour at a 5 minute resolution
+  occ component raw-query --namespace acme-corp --env dev \
+    --promql 'sum by (pod) (rate(container_cpu_usage_seconds_total[5m]))' --since 1h --step 5m
+
+  # Search the runtime logs of the last 30 minutes with a search body from a file
+  occ component raw-query --namespace acme-corp --env dev --log-type runtime --body-file search.json --since 30m`,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			promql, _ := cmd.Flags().GetString("promql")
+			logType, _ := cmd.Flags().GetString("log-type")
+			bodyFile, _ := cmd.Flags().GetString("body-file")
+			step, _ := cmd.Flags().GetString("step")
+			return New(cl).RawQuery(RawQueryParams{
+				Namespace:   flags.GetNamespace(cmd),
+				Environment: flags.GetEnvironment(cmd),
+				PromQL:      promql,
+				LogType:     logType,
+				BodyFile:    bodyFile,
+				Since:       flags.GetSince(cmd),
+				Step:        step,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddEnvironment(cmd)
+	cmd.Flags().String("promql", "", "PromQL expression to run")
+	cmd.Flags().String("log-type", "", "Log indices to search with an OpenSearch body (runtime, build or gateway)")
+	cmd.Flags().String("body-file", "", "File containing the OpenSearch search body (defaults to matching all documents)")
+	cmd.Flags().String("since", "", "Query the time range from this relative duration ago until now, like 5m or 1h (default 1h)")
+	cmd.Flags().String("step", "", "PromQL range query resolution, like 1m (omit for an instant query)")
+	return cmd
+}
+
+func newSavedQueryCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "saved-query",
+		Aliases: []string{"sq"},
+		Short:   "Manage and run saved logs and traces queries",
+		Long: `Manage named logs and traces queries of a namespace and run them with parameter values.
+String values of a saved query may contain {{name}} placeholders for its declared parameters.
+Saved queries are stored by the observer serving the environment given with --env.`,
+	}
+	cmd.AddCommand(
+		newListSavedQueryCmd(f),
+		newGetSavedQueryCmd(f),
+		newCreateSavedQueryCmd(f),
+		newDeleteSavedQueryCmd(f),
+		newRunSavedQueryCmd(f),
+	)
+	return cmd
+}
+
+func newListSavedQueryCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the saved queries of a namespace",
+		Example: `  # List saved queries
+  occ component saved-query list --namespace acme-corp --env dev`,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).ListSavedQueries(SavedQueryParams{
+				Namespace:   flags.GetNamespace(cmd),
+				Environment: flags.GetEnvironment(cmd),
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddEnvironment(cmd)
+	return cmd
+}
+
+func newGetSavedQueryCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [QUERY_NAME]",
+		Short: "Print a saved query",
+		Example: `  # Print a saved query
+  occ component saved-query get checkout-errors --namespace acme-corp --env dev`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).GetSavedQuery(SavedQueryParams{
+				Namespace:   flags.GetNamespace(cmd),
+				Environment: flags.GetEnvironment(cmd),
+				Name:        args[0],
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddEnvironment(cmd)
+	return cmd
+}
+
+func newCreateSavedQueryCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Save a logs or traces query",
+		Long: `Save the query defined in a YAML or JSON file. The file sets name, kind (logs or
+traces), query and the declared parameters, and optionally description and namespace.`,
+		Example: `  # Save a query defined in a file
+  occ component saved-query create --namespace acme-corp --env dev --file checkout-errors.yaml`,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			name, _ := cmd.Flags().GetString("name")
+			file, _ := cmd.Flags().GetString("file")
+			return New(cl).CreateSavedQuery(SavedQueryParams{
+				Namespace:   flags.GetNamespace(cmd),
+				Environment: flags.GetEnvironment(cmd),
+				Name:        name,
+				File:        file,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddEnvironment(cmd)
+	cmd.Flags().StringP("file", "f", "", "YAML or JSON file defining the saved query")
+	cmd.Flags().String("name", "", "Name of the saved query (overrides the name in the file)")
+	return cmd
+}
+
+func newDeleteSavedQueryCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [QUERY_NAME]",
+		Short: "Delete a saved query",
+		Example: `  # Delete a saved query
+  occ component saved-query delete checkout-errors --namespace acme-corp --env dev`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).DeleteSavedQuery(SavedQueryParams{
+				Namespace:   flags.GetNamespace(cmd),
+				Environment: flags.GetEnvironment(cmd),
+				Name:        args[0],
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddEnvironment(cmd)
+	return cmd
+}
+
+func newRunSavedQueryCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run [QUERY_NAME]",
+		Short: "Run a saved query",
+		Long: `Run a saved query with the given parameter values and print the JSON response.
+Parameters without a value take their default.`,
+		Example: `  # Run a saved query for the last day
+  occ component saved-query run checkout-errors --namespace acme-corp --env dev \
+    --param from=2026-01-01T00:00:00Z --param to=2026-01-02T00:00:00Z`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			params, _ := cmd.Flags().GetStringArray("param")
+			return New(cl).RunSavedQuery(SavedQueryParams{
+				Namespace:   flags.GetNamespace(cmd),
+				Environment: flags.GetEnvironment(cmd),
+				Name:        args[0],
+				Parameters:  params,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddEnvironment(cmd)
+	cmd.Flags().StringArray("param", nil, "Parameter value as name=value (repeatable)")
+	return cmd
+}
+
 func newExecCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "exec COMPONENT_NAME [-- COMMAND [args...]]",
@@ -0,0 +1,77 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+// IdleReport prints the components the observer flagged as idle in an environment
+func (cp *Component) IdleReport(params IdleReportParams) error {
+	if err := cmdutil.RequireFields("idle-report", "component", map[string]string{
+		"namespace": params.Namespace,
+		"env":       params.Environment,
+	}); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	observerURL, err := resolveObserverURL(ctx, cp.client, params.Namespace, params.Environment)
+	if err != nil {
+		return fmt.Errorf("failed to resolve observer URL: %w", err)
+	}
+
+	credential, err := config.GetCurrentCredential()
+	if err != nil {
+		return fmt.Errorf("failed to get credentials: %w", err)
+	}
+	if credential == nil {
+		return fmt.Errorf("no current credential available")
+	}
+
+	report, err := client.NewObserverClient(observerURL, credential.Token).FetchIdleWorkloads(ctx, client.IdleWorkloadsRequest{
+		Namespace:   params.Namespace,
+		Project:     params.Project,
+		Environment: params.Environment,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch idle workloads: %w", err)
+	}
+
+	printIdleReport(report)
+	return nil
+}
+
+func printIdleReport(report *client.IdleWorkloadsResponse) {
+	if len(report.Items) == 0 {
+		fmt.Println("No idle components found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "COMPONENT\tPROJECT\tENVIRONMENT\tCPU P95\tPEAK RPS\tEST. MONTHLY SAVINGS\tSUSPEND URL")
+	for _, item := range report.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%.4f\t%.4f\t%.2f %s\t%s\n",
+			item.Component,
+			item.Project,
+			item.Environment,
+			item.CPUP95Cores,
+			item.PeakRequestRate,
+			item.EstimatedMonthlySavings,
+			report.Currency,
+			item.SuspendURL)
+	}
+	w.Flush()
+
+	fmt.Printf("\nTotal estimated monthly savings: %.2f %s (analyzed %s)\n",
+		report.TotalEstimatedMonthlySavings, report.Currency, report.GeneratedAt)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func TestIdleReport_Success(t *testing.T) {
+	setupLogsConfig(t)
+
+	observerURL := observerTestURL
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetEnvironment(mock.Anything, "ns", "dev").Return(&gen.Environment{
+		Spec: &gen.EnvironmentSpec{
+			DataPlaneRef: &struct {
+				Kind gen.EnvironmentSpecDataPlaneRefKind `json:"kind"`
+				Name string                              `json:"name"`
+			}{Kind: gen.EnvironmentSpecDataPlaneRefKindClusterDataPlane, Name: "default"},
+		},
+	}, nil)
+	mc.EXPECT().GetClusterDataPlane(mock.Anything, "default").Return(&gen.ClusterDataPlane{}, nil)
+	mc.EXPECT().GetClusterObservabilityPlane(mock.Anything, "default").Return(
+		&gen.ClusterObservabilityPlane{Spec: &gen.ClusterObservabilityPlaneSpec{ObserverURL: &observerURL}}, nil)
+
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, observerURL+"/api/v1alpha1/metrics/idle-workloads", r.URL.String())
+		var req client.IdleWorkloadsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, client.IdleWorkloadsRequest{Namespace: "ns", Environment: "dev"}, req)
+		return testutil.JSONResp(http.StatusOK, client.IdleWorkloadsResponse{
+			Currency:                     "USD",
+			TotalEstimatedMonthlySavings: 14.6,
+			Items: []client.IdleWorkload{{
+				Component:               "legacy-api",
+				Project:                 "shop",
+				Environment:             "dev",
+				EstimatedMonthlySavings: 14.6,
+				SuspendURL:              "http://api/suspend",
+			}},
+		}), nil
+	}))
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).IdleReport(IdleReportParams{Namespace: "ns", Environment: "dev"}))
+	})
+	assert.Contains(t, out, "legacy-api")
+	assert.Contains(t, out, "14.60 USD")
+	assert.Contains(t, out, "http://api/suspend")
+	assert.Contains(t, out, "Total estimated monthly savings: 14.60 USD")
+}
+
+func TestIdleReport_RequiresEnvironment(t *testing.T) {
+	err := New(mocks.NewMockInterface(t)).IdleReport(IdleReportParams{Namespace: "ns"})
+	assert.ErrorContains(t, err, "--env")
+}
@@ -25,9 +25,13 @@ func (cp *Component) Logs(params LogsParams) error {
 	apiClient := cp.client
 
 	// Verify the component exists
-	if _, err := apiClient.GetComponent(ctx, params.Namespace, params.Component); err != nil {
+	comp, err := apiClient.GetComponent(ctx, params.Namespace, params.Component)
+	if err != nil {
 		return fmt.Errorf("failed to get component: %w", err)
 	}
+	if params.Project == "" && comp.Spec != nil {
+		params.Project = comp.Spec.Owner.ProjectName
+	}
 
 	// If environment not specified, get the lowest environment from deployment pipeline
 	envName := params.Environment
@@ -46,8 +50,8 @@ func (cp *Component) Logs(params LogsParams) error {
 		envName = rootEnv
 	}
 
-	// Resolve observer URL by traversing Environment → DataPlane → ObservabilityPlane
-	observerURL, err := resolveObserverURL(ctx, apiClient, params.Namespace, envName)
+	// The API resolves the observer serving the environment the component runs in
+	observerURL, err := apiClient.GetComponentObserverURL(ctx, params.Namespace, params.Component, envName)
 	if err != nil {
 		return fmt.Errorf("failed to resolve observer URL: %w", err)
 	}
@@ -61,20 +65,11 @@ func (cp *Component) Logs(params LogsParams) error {
 	// Update params with resolved environment name
 	params.Environment = envName
 
-	// Set defaults
-	if params.Since == "" {
-		params.Since = "1h"
-	}
-
-	// Calculate time range from --since flag
-	duration, err := time.ParseDuration(params.Since)
+	startTime, endTime, err := logsTimeRange(params, time.Now())
 	if err != nil {
-		return fmt.Errorf("invalid duration format: %w", err)
+		return err
 	}
 
-	startTime := time.Now().Add(-duration)
-	endTime := time.Now()
-
 	credential, err := config.GetCurrentCredential()
 	if err != nil {
 		return fmt.Errorf("failed to get credentials: %w", err)
@@ -95,6 +90,44 @@ func (cp *Component) Logs(params LogsParams) error {
 	return cp.fetchAndPrintLogs(ctx, observerURL, credential.Token, environmentUID, params, startTime, endTime)
 }
 
+// logsTimeRange returns the time range to fetch logs for. --start-time and --end-time take
+// precedence over --since, which defaults to the last hour.
+func logsTimeRange(params LogsParams, now time.Time) (time.Time, time.Time, error) {
+	if params.Follow && params.EndTime != "" {
+		return time.Time{}, time.Time{}, fmt.Errorf("--end-time cannot be used with --follow")
+	}
+
+	endTime := now
+	if params.EndTime != "" {
+		t, err := time.Parse(time.RFC3339, params.EndTime)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --end-time %q: must be an RFC3339 timestamp", params.EndTime)
+		}
+		endTime = t
+	}
+
+	if params.StartTime != "" {
+		startTime, err := time.Parse(time.RFC3339, params.StartTime)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --start-time %q: must be an RFC3339 timestamp", params.StartTime)
+		}
+		if !startTime.Before(endTime) {
+			return time.Time{}, time.Time{}, fmt.Errorf("--start-time must be before --end-time")
+		}
+		return startTime, endTime, nil
+	}
+
+	since := params.Since
+	if since == "" {
+		since = "1h"
+	}
+	duration, err := time.ParseDuration(since)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid duration format: %w", err)
+	}
+	return endTime.Add(-duration), endTime, nil
+}
+
 // fetchAndPrintLogs fetches logs for a given time range and prints them
 func (cp *Component) fetchAndPrintLogs(
 	ctx context.Context,
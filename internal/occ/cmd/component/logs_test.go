@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -223,35 +224,18 @@ func TestFindRootEnvironment(t *testing.T) {
 // --- Logs integration tests ---
 
 // setupMockForLogs configures the mock client for the standard Logs resolution chain:
-// GetComponent -> GetProjectDeploymentPipeline -> GetEnvironment (for resolve + UID) ->
-// GetDataPlane -> GetObservabilityPlane.
+// GetComponent -> GetProjectDeploymentPipeline -> GetComponentObserverURL -> GetEnvironment (for UID).
 func setupMockForLogs(t *testing.T, mc *mocks.MockInterface, observerURL string) {
 	t.Helper()
 	mc.EXPECT().GetComponent(mock.Anything, "ns", "my-comp").Return(&gen.Component{}, nil)
 	mc.EXPECT().GetProjectDeploymentPipeline(mock.Anything, "ns", "my-proj").Return(
 		makePipeline([]gen.PromotionPath{promotionPath("dev", "prod")}), nil)
+	mc.EXPECT().GetComponentObserverURL(mock.Anything, "ns", "my-comp", "dev").Return(observerURL, nil)
 
 	envUID := "env-uid-123"
-	// GetEnvironment is called twice: once in resolveObserverURL and once for UID
 	mc.EXPECT().GetEnvironment(mock.Anything, "ns", "dev").Return(&gen.Environment{
 		Metadata: gen.ObjectMeta{Uid: &envUID},
-		Spec: &gen.EnvironmentSpec{
-			DataPlaneRef: &struct {
-				Kind gen.EnvironmentSpecDataPlaneRefKind `json:"kind"`
-				Name string                              `json:"name"`
-			}{Kind: gen.EnvironmentSpecDataPlaneRefKindDataPlane, Name: "my-dp"},
-		},
 	}, nil)
-	mc.EXPECT().GetDataPlane(mock.Anything, "ns", "my-dp").Return(&gen.DataPlane{
-		Spec: &gen.DataPlaneSpec{
-			ObservabilityPlaneRef: &gen.ObservabilityPlaneRef{
-				Kind: gen.ObservabilityPlaneRefKindObservabilityPlane,
-				Name: "my-obs",
-			},
-		},
-	}, nil)
-	mc.EXPECT().GetObservabilityPlane(mock.Anything, "ns", "my-obs").Return(
-		&gen.ObservabilityPlane{Spec: &gen.ObservabilityPlaneSpec{ObserverURL: &observerURL}}, nil)
 }
 
 func TestLogs_Success(t *testing.T) {
@@ -290,26 +274,12 @@ func TestLogs_WithExplicitEnvironment(t *testing.T) {
 	mc.EXPECT().GetComponent(mock.Anything, "ns", "my-comp").Return(&gen.Component{}, nil)
 	// No pipeline lookup when environment is explicit
 
+	mc.EXPECT().GetComponentObserverURL(mock.Anything, "ns", "my-comp", "staging").Return(observerURL, nil)
+
 	envUID := "env-uid-456"
 	mc.EXPECT().GetEnvironment(mock.Anything, "ns", "staging").Return(&gen.Environment{
 		Metadata: gen.ObjectMeta{Uid: &envUID},
-		Spec: &gen.EnvironmentSpec{
-			DataPlaneRef: &struct {
-				Kind gen.EnvironmentSpecDataPlaneRefKind `json:"kind"`
-				Name string                              `json:"name"`
-			}{Kind: gen.EnvironmentSpecDataPlaneRefKindDataPlane, Name: "my-dp"},
-		},
-	}, nil)
-	mc.EXPECT().GetDataPlane(mock.Anything, "ns", "my-dp").Return(&gen.DataPlane{
-		Spec: &gen.DataPlaneSpec{
-			ObservabilityPlaneRef: &gen.ObservabilityPlaneRef{
-				Kind: gen.ObservabilityPlaneRefKindObservabilityPlane,
-				Name: "my-obs",
-			},
-		},
 	}, nil)
-	mc.EXPECT().GetObservabilityPlane(mock.Anything, "ns", "my-obs").Return(
-		&gen.ObservabilityPlane{Spec: &gen.ObservabilityPlaneSpec{ObserverURL: &observerURL}}, nil)
 
 	testutil.SetTransport(t, testutil.RoundTripFunc(func(_ *http.Request) (*http.Response, error) {
 		return testutil.JSONResp(http.StatusOK, client.LogResponse{
@@ -345,6 +315,42 @@ func TestLogs_PipelineError(t *testing.T) {
 	assert.ErrorContains(t, err, "failed to get deployment pipeline")
 }
 
+func TestLogs_ObserverURLError(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetComponent(mock.Anything, "ns", "my-comp").Return(&gen.Component{}, nil)
+	mc.EXPECT().GetComponentObserverURL(mock.Anything, "ns", "my-comp", "dev").
+		Return("", fmt.Errorf("observer URL not configured"))
+
+	cp := New(mc)
+	err := cp.Logs(LogsParams{Namespace: "ns", Project: "my-proj", Component: "my-comp", Environment: "dev"})
+	assert.ErrorContains(t, err, "failed to resolve observer URL")
+}
+
+func TestLogs_ProjectFromComponent(t *testing.T) {
+	setupLogsConfig(t)
+
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetComponent(mock.Anything, "ns", "my-comp").Return(&gen.Component{
+		Spec: &gen.ComponentSpec{Owner: struct {
+			ProjectName string `json:"projectName"`
+		}{ProjectName: "my-proj"}},
+	}, nil)
+	mc.EXPECT().GetProjectDeploymentPipeline(mock.Anything, "ns", "my-proj").Return(
+		makePipeline([]gen.PromotionPath{promotionPath("dev", "prod")}), nil)
+	mc.EXPECT().GetComponentObserverURL(mock.Anything, "ns", "my-comp", "dev").Return(observerTestURL, nil)
+	mc.EXPECT().GetEnvironment(mock.Anything, "ns", "dev").Return(&gen.Environment{}, nil)
+
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		scope, _ := body["searchScope"].(map[string]any)
+		assert.Equal(t, "my-proj", scope["project"])
+		return testutil.JSONResp(http.StatusOK, client.LogResponse{}), nil
+	}))
+
+	require.NoError(t, New(mc).Logs(LogsParams{Namespace: "ns", Component: "my-comp"}))
+}
+
 func TestLogs_InvalidSince(t *testing.T) {
 	setupLogsConfig(t)
 
@@ -354,26 +360,12 @@ func TestLogs_InvalidSince(t *testing.T) {
 	mc.EXPECT().GetProjectDeploymentPipeline(mock.Anything, "ns", "my-proj").Return(
 		makePipeline([]gen.PromotionPath{promotionPath("dev", "prod")}), nil)
 
+	mc.EXPECT().GetComponentObserverURL(mock.Anything, "ns", "my-comp", "dev").Return(observerURL, nil)
+
 	envUID := "env-uid-789"
 	mc.EXPECT().GetEnvironment(mock.Anything, "ns", "dev").Return(&gen.Environment{
 		Metadata: gen.ObjectMeta{Uid: &envUID},
-		Spec: &gen.EnvironmentSpec{
-			DataPlaneRef: &struct {
-				Kind gen.EnvironmentSpecDataPlaneRefKind `json:"kind"`
-				Name string                              `json:"name"`
-			}{Kind: gen.EnvironmentSpecDataPlaneRefKindDataPlane, Name: "my-dp"},
-		},
-	}, nil)
-	mc.EXPECT().GetDataPlane(mock.Anything, "ns", "my-dp").Return(&gen.DataPlane{
-		Spec: &gen.DataPlaneSpec{
-			ObservabilityPlaneRef: &gen.ObservabilityPlaneRef{
-				Kind: gen.ObservabilityPlaneRefKindObservabilityPlane,
-				Name: "my-obs",
-			},
-		},
 	}, nil)
-	mc.EXPECT().GetObservabilityPlane(mock.Anything, "ns", "my-obs").Return(
-		&gen.ObservabilityPlane{Spec: &gen.ObservabilityPlaneSpec{ObserverURL: &observerURL}}, nil)
 
 	cp := New(mc)
 	err := cp.Logs(LogsParams{
@@ -397,26 +389,12 @@ func TestLogs_CredentialError(t *testing.T) {
 	mc.EXPECT().GetProjectDeploymentPipeline(mock.Anything, "ns", "my-proj").Return(
 		makePipeline([]gen.PromotionPath{promotionPath("dev", "prod")}), nil)
 
+	mc.EXPECT().GetComponentObserverURL(mock.Anything, "ns", "my-comp", "dev").Return(observerURL, nil)
+
 	envUID := "env-uid-abc"
 	mc.EXPECT().GetEnvironment(mock.Anything, "ns", "dev").Return(&gen.Environment{
 		Metadata: gen.ObjectMeta{Uid: &envUID},
-		Spec: &gen.EnvironmentSpec{
-			DataPlaneRef: &struct {
-				Kind gen.EnvironmentSpecDataPlaneRefKind `json:"kind"`
-				Name string                              `json:"name"`
-			}{Kind: gen.EnvironmentSpecDataPlaneRefKindDataPlane, Name: "my-dp"},
-		},
-	}, nil)
-	mc.EXPECT().GetDataPlane(mock.Anything, "ns", "my-dp").Return(&gen.DataPlane{
-		Spec: &gen.DataPlaneSpec{
-			ObservabilityPlaneRef: &gen.ObservabilityPlaneRef{
-				Kind: gen.ObservabilityPlaneRefKindObservabilityPlane,
-				Name: "my-obs",
-			},
-		},
 	}, nil)
-	mc.EXPECT().GetObservabilityPlane(mock.Anything, "ns", "my-obs").Return(
-		&gen.ObservabilityPlane{Spec: &gen.ObservabilityPlaneSpec{ObserverURL: &observerURL}}, nil)
 
 	cp := New(mc)
 	err := cp.Logs(LogsParams{Namespace: "ns", Project: "my-proj", Component: "my-comp"})
@@ -499,3 +477,67 @@ func TestLogs_WithContainerFilter(t *testing.T) {
 	assert.Contains(t, out, "[daprd] daprd log")
 	assert.NotContains(t, out, "main log")
 }
+
+// --- logsTimeRange ---
+
+func TestLogsTimeRange(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		params    LogsParams
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   string
+	}{
+		{
+			name:      "defaults to the last hour",
+			wantStart: now.Add(-time.Hour),
+			wantEnd:   now,
+		},
+		{
+			name:      "since",
+			params:    LogsParams{Since: "30m"},
+			wantStart: now.Add(-30 * time.Minute),
+			wantEnd:   now,
+		},
+		{
+			name:      "start time overrides since",
+			params:    LogsParams{Since: "30m", StartTime: "2026-01-01T10:00:00Z"},
+			wantStart: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+			wantEnd:   now,
+		},
+		{
+			name:      "since counts back from end time",
+			params:    LogsParams{Since: "1h", EndTime: "2026-01-01T09:00:00Z"},
+			wantStart: time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "invalid start time",
+			params:  LogsParams{StartTime: "yesterday"},
+			wantErr: "invalid --start-time",
+		},
+		{
+			name:    "start after end",
+			params:  LogsParams{StartTime: "2026-01-01T10:00:00Z", EndTime: "2026-01-01T09:00:00Z"},
+			wantErr: "--start-time must be before --end-time",
+		},
+		{
+			name:    "end time with follow",
+			params:  LogsParams{Follow: true, EndTime: "2026-01-01T09:00:00Z"},
+			wantErr: "--end-time cannot be used with --follow",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := logsTimeRange(tt.params, now)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+		})
+	}
+}
@@ -99,9 +99,38 @@ type LogsParams struct {
 	Container   string // optional — empty means logs from all containers
 	Follow      bool
 	Since       string // duration like "1h", "30m", "5m"
+	StartTime   string // optional RFC3339 start of the time range; takes precedence over Since
+	EndTime     string // optional RFC3339 end of the time range; defaults to now
 	Tail        int    // number of lines to show from the end of logs (0 means no limit)
 }
 
+// IdleReportParams defines parameters for the idle workload report
+type IdleReportParams struct {
+	Namespace   string
+	Project     string // optional — empty means all projects
+	Environment string
+}
+
+// RawQueryParams defines parameters for running a raw PromQL query or OpenSearch search
+type RawQueryParams struct {
+	Namespace   string
+	Environment string // used to resolve the observer
+	PromQL      string // PromQL expression; mutually exclusive with LogType
+	LogType     string // runtime, build or gateway; selects an OpenSearch search
+	BodyFile    string // optional — path of the OpenSearch search body
+	Since       string // duration like "1h"; the queried time range ends now
+	Step        string // optional — PromQL range query resolution; empty means an instant query
+}
+
+// SavedQueryParams defines parameters for managing and running saved queries
+type SavedQueryParams struct {
+	Namespace   string
+	Environment string   // used to resolve the observer storing the saved queries
+	Name        string   // saved query name; overrides the name in File on create
+	File        string   // YAML or JSON definition of the saved query to create
+	Parameters  []string // name=value pairs substituted when running the query
+}
+
 // ExecParams defines parameters for exec-ing into a component's running pod
 type ExecParams struct {
 	Namespace   string
@@ -0,0 +1,100 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+// RawQuery runs a raw PromQL query or OpenSearch search through the observer and prints
+// the JSON response
+func (cp *Component) RawQuery(params RawQueryParams) error {
+	if err := cmdutil.RequireFields("raw-query", "component", map[string]string{
+		"namespace": params.Namespace,
+		"env":       params.Environment,
+	}); err != nil {
+		return err
+	}
+	if (params.PromQL == "") == (params.LogType == "") {
+		return fmt.Errorf("exactly one of --promql or --log-type is required")
+	}
+	if params.PromQL != "" && params.BodyFile != "" {
+		return fmt.Errorf("--body-file can only be used with --log-type")
+	}
+
+	if params.Since == "" {
+		params.Since = "1h"
+	}
+	duration, err := time.ParseDuration(params.Since)
+	if err != nil {
+		return fmt.Errorf("invalid duration format: %w", err)
+	}
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-duration)
+
+	var body json.RawMessage
+	if params.BodyFile != "" {
+		body, err = os.ReadFile(params.BodyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read search body: %w", err)
+		}
+	}
+
+	ctx := context.Background()
+
+	observerURL, err := resolveObserverURL(ctx, cp.client, params.Namespace, params.Environment)
+	if err != nil {
+		return fmt.Errorf("failed to resolve observer URL: %w", err)
+	}
+
+	credential, err := config.GetCurrentCredential()
+	if err != nil {
+		return fmt.Errorf("failed to get credentials: %w", err)
+	}
+	if credential == nil {
+		return fmt.Errorf("no current credential available")
+	}
+	obsClient := client.NewObserverClient(observerURL, credential.Token)
+
+	var result json.RawMessage
+	if params.PromQL != "" {
+		req := client.PromQLQueryRequest{
+			Namespace: params.Namespace,
+			Query:     params.PromQL,
+			EndTime:   endTime.Format(time.RFC3339),
+			Step:      params.Step,
+		}
+		if params.Step != "" {
+			req.StartTime = startTime.Format(time.RFC3339)
+		}
+		result, err = obsClient.RunPromQLQuery(ctx, req)
+	} else {
+		result, err = obsClient.RunOpenSearchQuery(ctx, client.OpenSearchQueryRequest{
+			Namespace: params.Namespace,
+			LogType:   params.LogType,
+			StartTime: startTime.Format(time.RFC3339),
+			EndTime:   endTime.Format(time.RFC3339),
+			Body:      body,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run raw query: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, result, "", "  "); err != nil {
+		return fmt.Errorf("failed to format response: %w", err)
+	}
+	fmt.Println(out.String())
+	return nil
+}
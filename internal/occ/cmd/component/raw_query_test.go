@@ -0,0 +1,100 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// newRawQueryMockClient returns an API client that resolves the observer of the dev environment.
+func newRawQueryMockClient(t *testing.T) *mocks.MockInterface {
+	t.Helper()
+	observerURL := observerTestURL
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetEnvironment(mock.Anything, "ns", "dev").Return(&gen.Environment{
+		Spec: &gen.EnvironmentSpec{
+			DataPlaneRef: &struct {
+				Kind gen.EnvironmentSpecDataPlaneRefKind `json:"kind"`
+				Name string                              `json:"name"`
+			}{Kind: gen.EnvironmentSpecDataPlaneRefKindClusterDataPlane, Name: "default"},
+		},
+	}, nil)
+	mc.EXPECT().GetClusterDataPlane(mock.Anything, "default").Return(&gen.ClusterDataPlane{}, nil)
+	mc.EXPECT().GetClusterObservabilityPlane(mock.Anything, "default").Return(
+		&gen.ClusterObservabilityPlane{Spec: &gen.ClusterObservabilityPlaneSpec{ObserverURL: &observerURL}}, nil)
+	return mc
+}
+
+func TestRawQuery_PromQL(t *testing.T) {
+	setupLogsConfig(t)
+	mc := newRawQueryMockClient(t)
+
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, observerTestURL+"/api/v1alpha1/raw/promql/query", r.URL.String())
+		var req client.PromQLQueryRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "ns", req.Namespace)
+		assert.Equal(t, "up", req.Query)
+		assert.Equal(t, "1m", req.Step)
+		assert.NotEmpty(t, req.StartTime)
+		return testutil.JSONResp(http.StatusOK, map[string]any{
+			"query": `up{ns="ns"}`, "resultType": "vector", "result": []any{}, "truncated": false,
+		}), nil
+	}))
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).RawQuery(RawQueryParams{
+			Namespace: "ns", Environment: "dev", PromQL: "up", Step: "1m",
+		}))
+	})
+	assert.Contains(t, out, `"resultType": "vector"`)
+}
+
+func TestRawQuery_OpenSearch(t *testing.T) {
+	setupLogsConfig(t)
+	mc := newRawQueryMockClient(t)
+
+	bodyFile := filepath.Join(t.TempDir(), "search.json")
+	require.NoError(t, os.WriteFile(bodyFile, []byte(`{"query":{"match":{"log":"timeout"}}}`), 0o600))
+
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, observerTestURL+"/api/v1alpha1/raw/opensearch/search", r.URL.String())
+		var req client.OpenSearchQueryRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "runtime", req.LogType)
+		assert.JSONEq(t, `{"query":{"match":{"log":"timeout"}}}`, string(req.Body))
+		return testutil.JSONResp(http.StatusOK, map[string]any{"took": 4, "timedOut": false, "hits": map[string]any{}}), nil
+	}))
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).RawQuery(RawQueryParams{
+			Namespace: "ns", Environment: "dev", LogType: "runtime", BodyFile: bodyFile, Since: "30m",
+		}))
+	})
+	assert.Contains(t, out, `"took": 4`)
+}
+
+func TestRawQuery_Validation(t *testing.T) {
+	cp := New(mocks.NewMockInterface(t))
+
+	assert.ErrorContains(t, cp.RawQuery(RawQueryParams{Namespace: "ns", PromQL: "up"}), "--env")
+	assert.ErrorContains(t, cp.RawQuery(RawQueryParams{Namespace: "ns", Environment: "dev"}),
+		"exactly one of --promql or --log-type")
+	assert.ErrorContains(t, cp.RawQuery(RawQueryParams{
+		Namespace: "ns", Environment: "dev", PromQL: "up", BodyFile: "search.json",
+	}), "--body-file")
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+// ListSavedQueries lists the saved queries of a namespace
+func (cp *Component) ListSavedQueries(params SavedQueryParams) error {
+	obsClient, err := cp.savedQueryObserverClient("list", params)
+	if err != nil {
+		return err
+	}
+
+	list, err := obsClient.ListSavedQueries(context.Background(), params.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list saved queries: %w", err)
+	}
+
+	if len(list.Items) == 0 {
+		fmt.Println("No saved queries found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tKIND\tPARAMETERS\tCREATED BY\tDESCRIPTION")
+	for _, q := range list.Items {
+		names := make([]string, 0, len(q.Parameters))
+		for _, p := range q.Parameters {
+			names = append(names, p.Name)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", q.Name, q.Kind, strings.Join(names, ","), q.CreatedBy, q.Description)
+	}
+	w.Flush()
+	return nil
+}
+
+// GetSavedQuery prints a saved query as JSON
+func (cp *Component) GetSavedQuery(params SavedQueryParams) error {
+	if err := requireSavedQueryName(params); err != nil {
+		return err
+	}
+	obsClient, err := cp.savedQueryObserverClient("get", params)
+	if err != nil {
+		return err
+	}
+
+	query, err := obsClient.GetSavedQuery(context.Background(), params.Namespace, params.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get saved query: %w", err)
+	}
+	return printJSON(query)
+}
+
+// CreateSavedQuery saves the query defined in a YAML or JSON file
+func (cp *Component) CreateSavedQuery(params SavedQueryParams) error {
+	if params.File == "" {
+		return fmt.Errorf("--file is required")
+	}
+	data, err := os.ReadFile(params.File)
+	if err != nil {
+		return fmt.Errorf("failed to read saved query file: %w", err)
+	}
+	var query client.SavedQuery
+	if err := yaml.Unmarshal(data, &query); err != nil {
+		return fmt.Errorf("failed to parse saved query file: %w", err)
+	}
+	if params.Name != "" {
+		query.Name = params.Name
+	}
+	if query.Namespace == "" {
+		query.Namespace = params.Namespace
+	} else if params.Namespace != "" && query.Namespace != params.Namespace {
+		return fmt.Errorf("saved query namespace %q does not match --namespace %q", query.Namespace, params.Namespace)
+	}
+	params.Namespace = query.Namespace
+	if query.Name == "" {
+		return fmt.Errorf("saved query name is required; set name in the file or pass --name")
+	}
+
+	obsClient, err := cp.savedQueryObserverClient("create", params)
+	if err != nil {
+		return err
+	}
+
+	created, err := obsClient.CreateSavedQuery(context.Background(), query)
+	if err != nil {
+		return fmt.Errorf("failed to create saved query: %w", err)
+	}
+	fmt.Printf("Saved query %s created in namespace %s\n", created.Name, created.Namespace)
+	return nil
+}
+
+// DeleteSavedQuery deletes a saved query
+func (cp *Component) DeleteSavedQuery(params SavedQueryParams) error {
+	if err := requireSavedQueryName(params); err != nil {
+		return err
+	}
+	obsClient, err := cp.savedQueryObserverClient("delete", params)
+	if err != nil {
+		return err
+	}
+
+	if err := obsClient.DeleteSavedQuery(context.Background(), params.Namespace, params.Name); err != nil {
+		return fmt.Errorf("failed to delete saved query: %w", err)
+	}
+	fmt.Printf("Saved query %s deleted from namespace %s\n", params.Name, params.Namespace)
+	return nil
+}
+
+// RunSavedQuery runs a saved query with the given parameter values and prints the JSON response
+func (cp *Component) RunSavedQuery(params SavedQueryParams) error {
+	if err := requireSavedQueryName(params); err != nil {
+		return err
+	}
+	values := make(map[string]string, len(params.Parameters))
+	for _, p := range params.Parameters {
+		key, value, ok := strings.Cut(p, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("invalid --param %q: expected name=value", p)
+		}
+		values[key] = value
+	}
+
+	obsClient, err := cp.savedQueryObserverClient("run", params)
+	if err != nil {
+		return err
+	}
+
+	result, err := obsClient.RunSavedQuery(context.Background(), params.Namespace, params.Name, values)
+	if err != nil {
+		return fmt.Errorf("failed to run saved query: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, result, "", "  "); err != nil {
+		return fmt.Errorf("failed to format response: %w", err)
+	}
+	fmt.Println(out.String())
+	return nil
+}
+
+func requireSavedQueryName(params SavedQueryParams) error {
+	if params.Name == "" {
+		return fmt.Errorf("saved query name is required")
+	}
+	return nil
+}
+
+// savedQueryObserverClient validates the common flags and returns a client of the observer
+// serving the environment. Saved queries are stored per observability plane.
+func (cp *Component) savedQueryObserverClient(action string, params SavedQueryParams) (*client.ObserverClient, error) {
+	if err := cmdutil.RequireFields("saved-query "+action, "component", map[string]string{
+		"namespace": params.Namespace,
+		"env":       params.Environment,
+	}); err != nil {
+		return nil, err
+	}
+
+	observerURL, err := resolveObserverURL(context.Background(), cp.client, params.Namespace, params.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve observer URL: %w", err)
+	}
+
+	credential, err := config.GetCurrentCredential()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credentials: %w", err)
+	}
+	if credential == nil {
+		return nil, fmt.Errorf("no current credential available")
+	}
+	return client.NewObserverClient(observerURL, credential.Token), nil
+}
+
+func printJSON(v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to format response: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+func TestCreateSavedQuery_FromYAML(t *testing.T) {
+	setupLogsConfig(t)
+	mc := newRawQueryMockClient(t)
+
+	file := filepath.Join(t.TempDir(), "checkout-errors.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`name: checkout-errors
+kind: logs
+query:
+  searchScope:
+    namespace: ns
+    component: checkout
+  startTime: "{{from}}"
+  endTime: "{{to}}"
+parameters:
+  - name: from
+    required: true
+  - name: to
+    required: true
+`), 0o600))
+
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, observerTestURL+"/api/v1alpha1/queries", r.URL.String())
+		var req client.SavedQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "ns", req.Namespace)
+		assert.Equal(t, "checkout-errors", req.Name)
+		assert.JSONEq(t, `{"searchScope":{"namespace":"ns","component":"checkout"},"startTime":"{{from}}","endTime":"{{to}}"}`,
+			string(req.Query))
+		require.Len(t, req.Parameters, 2)
+		return testutil.JSONResp(http.StatusCreated, req), nil
+	}))
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).CreateSavedQuery(SavedQueryParams{Namespace: "ns", Environment: "dev", File: file}))
+	})
+	assert.Contains(t, out, "Saved query checkout-errors created in namespace ns")
+}
+
+func TestRunSavedQuery(t *testing.T) {
+	setupLogsConfig(t)
+	mc := newRawQueryMockClient(t)
+
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, observerTestURL+"/api/v1alpha1/queries/ns/checkout-errors/run", r.URL.String())
+		var req struct {
+			Parameters map[string]string `json:"parameters"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]string{"from": "2026-01-01T00:00:00Z", "filter": "a=b"}, req.Parameters)
+		return testutil.JSONResp(http.StatusOK, map[string]any{"logs": []any{}, "total": 0, "tookMs": 3}), nil
+	}))
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).RunSavedQuery(SavedQueryParams{
+			Namespace: "ns", Environment: "dev", Name: "checkout-errors",
+			Parameters: []string{"from=2026-01-01T00:00:00Z", "filter=a=b"},
+		}))
+	})
+	assert.Contains(t, out, `"tookMs": 3`)
+}
+
+func TestSavedQuery_Validation(t *testing.T) {
+	cp := New(mocks.NewMockInterface(t))
+
+	assert.ErrorContains(t, cp.ListSavedQueries(SavedQueryParams{Namespace: "ns"}), "--env")
+	assert.ErrorContains(t, cp.CreateSavedQuery(SavedQueryParams{Namespace: "ns", Environment: "dev"}), "--file")
+	assert.ErrorContains(t, cp.RunSavedQuery(SavedQueryParams{
+		Namespace: "ns", Environment: "dev", Name: "q", Parameters: []string{"from"},
+	}), "expected name=value")
+}
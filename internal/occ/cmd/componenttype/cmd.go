@@ -4,12 +4,16 @@
 package componenttype
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/openchoreo/openchoreo/internal/occ/auth"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
 	"github.com/openchoreo/openchoreo/internal/occ/flags"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/scaffold/definition"
 )
 
 func NewComponentTypeCmd(f client.NewClientFunc) *cobra.Command {
@@ -23,6 +27,8 @@ func NewComponentTypeCmd(f client.NewClientFunc) *cobra.Command {
 		newListCmd(f),
 		newGetCmd(f),
 		newDeleteCmd(f),
+		newInitCmd(),
+		newTestCmd(),
 	)
 	return cmd
 }
@@ -96,3 +102,62 @@ func newDeleteCmd(f client.NewClientFunc) *cobra.Command {
 	flags.AddNamespace(cmd)
 	return cmd
 }
+
+func newInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init [COMPONENT_TYPE_NAME]",
+		Short: "Scaffold a new component type",
+		Long: `Scaffold a new component type for local development.
+
+Writes the ComponentType with a parameter schema and resource templates for the
+workload type, a sample Component and Workload, and a render test with its golden
+file. Edit them and run "occ componenttype test" to render the samples locally.`,
+		Example: `  # Scaffold a deployment component type in ./web-app
+  occ componenttype init web-app
+
+  # Scaffold a cron job component type in a given directory
+  occ componenttype init nightly-task --workload-type cronjob --dir platform/nightly-task`,
+		Args: cmdutil.ExactOneArgWithUsage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, _ := cmd.Flags().GetString("namespace")
+			workloadType, _ := cmd.Flags().GetString("workload-type")
+			dir, _ := cmd.Flags().GetString("dir")
+			force, _ := cmd.Flags().GetBool("force")
+			return Init(InitParams{
+				ComponentTypeName: args[0],
+				Namespace:         namespace,
+				WorkloadType:      workloadType,
+				Dir:               dir,
+				Force:             force,
+			})
+		},
+	}
+	cmd.Flags().String("namespace", definition.DefaultNamespace, "Namespace of the generated resources")
+	cmd.Flags().String("workload-type", definition.DefaultWorkloadType,
+		fmt.Sprintf("Workload type of the component type (%s)", strings.Join(definition.WorkloadTypes, ", ")))
+	cmd.Flags().String("dir", "", "Directory to write the scaffold to (defaults to the component type name)")
+	cmd.Flags().Bool("force", false, "Overwrite existing files")
+	return cmd
+}
+
+func newTestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test [DIR]",
+		Short: "Run the render tests of a component type",
+		Long: `Render the samples of a component type scaffolded with "occ componenttype init" and
+check the output against the expected resources and golden files of its render tests
+in DIR/tests. No cluster or login is needed.`,
+		Example: `  # Run the render tests
+  occ componenttype test web-app
+
+  # Rewrite the golden files after an intended change
+  occ componenttype test web-app --update`,
+		Args: cmdutil.ExactOneArgWithUsage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			update, _ := cmd.Flags().GetBool("update")
+			return Test(TestParams{Dir: args[0], Update: update})
+		},
+	}
+	cmd.Flags().Bool("update", false, "Rewrite the golden files with the rendered output")
+	return cmd
+}
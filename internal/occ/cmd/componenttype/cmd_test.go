@@ -5,6 +5,9 @@ package componenttype
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -44,7 +47,7 @@ func TestNewComponentTypeCmd_Subcommands(t *testing.T) {
 	for _, sub := range cmd.Commands() {
 		names = append(names, sub.Name())
 	}
-	assert.ElementsMatch(t, []string{"list", "get", "delete"}, names)
+	assert.ElementsMatch(t, []string{"list", "get", "delete", "init", "test"}, names)
 }
 
 // --- list ---
@@ -131,3 +134,61 @@ func TestDeleteCmd_Success(t *testing.T) {
 	})
 	assert.Contains(t, out, "deleted")
 }
+
+// --- init / test ---
+
+func TestInitCmd_MissingArg(t *testing.T) {
+	cmd := newInitCmd()
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "COMPONENT_TYPE_NAME")
+}
+
+func TestInitAndTestCmd(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "nightly-task")
+
+	initCmd := newInitCmd()
+	require.NoError(t, initCmd.Flags().Set("dir", dir))
+	require.NoError(t, initCmd.Flags().Set("workload-type", "cronjob"))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, initCmd.RunE(initCmd, []string{"nightly-task"}))
+	})
+	assert.Contains(t, out, "Created ComponentType nightly-task in "+dir)
+	assert.Contains(t, out, filepath.Join(dir, "tests", "default.golden.yaml"))
+	assert.Contains(t, out, "occ componenttype test "+dir)
+
+	// A second init does not overwrite the scaffold
+	err := initCmd.RunE(initCmd, []string{"nightly-task"})
+	assert.ErrorContains(t, err, "refusing to overwrite existing files")
+
+	testCmd := newTestCmd()
+	out = testutil.CaptureStdout(t, func() {
+		require.NoError(t, testCmd.RunE(testCmd, []string{dir}))
+	})
+	assert.Contains(t, out, "PASS    default")
+
+	// An edit that changes the rendered output fails until the golden file is updated
+	ctPath := filepath.Join(dir, "componenttype.yaml")
+	data, err := os.ReadFile(ctPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(ctPath, []byte(strings.Replace(string(data), "concurrencyPolicy: Forbid", "concurrencyPolicy: Replace", 1)), 0600))
+	out = testutil.CaptureStdout(t, func() {
+		assert.EqualError(t, testCmd.RunE(testCmd, []string{dir}), "1 of 1 render tests failed")
+	})
+	assert.Contains(t, out, "FAIL    default")
+	assert.Contains(t, out, "concurrencyPolicy: Replace")
+
+	require.NoError(t, testCmd.Flags().Set("update", "true"))
+	out = testutil.CaptureStdout(t, func() {
+		require.NoError(t, testCmd.RunE(testCmd, []string{dir}))
+	})
+	assert.Contains(t, out, "UPDATED default")
+}
+
+func TestInitCmd_UnsupportedWorkloadType(t *testing.T) {
+	cmd := newInitCmd()
+	require.NoError(t, cmd.Flags().Set("dir", t.TempDir()))
+	require.NoError(t, cmd.Flags().Set("workload-type", "proxy"))
+	err := cmd.RunE(cmd, []string{"gateway"})
+	assert.ErrorContains(t, err, `unsupported workload type "proxy"`)
+}
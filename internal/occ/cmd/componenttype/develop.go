@@ -0,0 +1,31 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componenttype
+
+import (
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/scaffold/definition"
+)
+
+// Init scaffolds a new component type with a sample Component and a render test
+func Init(params InitParams) error {
+	files, err := definition.ComponentType(definition.Options{
+		Name:         params.ComponentTypeName,
+		Namespace:    params.Namespace,
+		WorkloadType: params.WorkloadType,
+	})
+	if err != nil {
+		return err
+	}
+	dir := params.Dir
+	if dir == "" {
+		dir = params.ComponentTypeName
+	}
+	return utils.WriteScaffold("ComponentType "+params.ComponentTypeName, "componenttype", dir, files, params.Force)
+}
+
+// Test runs the render tests of the component type in params.Dir
+func Test(params TestParams) error {
+	return utils.RunRenderTests(params.Dir, params.Update)
+}
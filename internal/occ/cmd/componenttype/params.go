@@ -26,3 +26,19 @@ type DeleteParams struct {
 
 func (p DeleteParams) GetNamespace() string         { return p.Namespace }
 func (p DeleteParams) GetComponentTypeName() string { return p.ComponentTypeName }
+
+// InitParams defines parameters for scaffolding a new component type
+type InitParams struct {
+	ComponentTypeName string
+	Namespace         string
+	WorkloadType      string
+	// Dir is the directory the scaffold is written to. Defaults to the component type name.
+	Dir   string
+	Force bool
+}
+
+// TestParams defines parameters for running the render tests of a component type
+type TestParams struct {
+	Dir    string
+	Update bool
+}
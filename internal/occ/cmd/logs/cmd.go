@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewLogsCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Stream logs from the observer",
+		Long: `Commands for reading logs collected by the observer of an observability plane.
+
+The observer is resolved through the OpenChoreo API and queried with the token of the
+current login.`,
+	}
+	cmd.AddCommand(newComponentCmd(f))
+	return cmd
+}
+
+func newComponentCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "component COMPONENT_NAME",
+		Short: "Stream runtime or build logs of a component",
+		Long: `Print the runtime logs of a component in an environment, or the logs of its builds.
+
+Runtime logs are read from the observer serving the environment. If --env is not
+specified, the lowest environment of the deployment pipeline is used. Build logs are
+read from the latest workflow run of the component unless --workflowrun is given.`,
+		Example: `  # Follow the runtime logs of a component in dev
+  occ logs component my-service --env dev --follow
+
+  # Logs of a single container between two points in time
+  occ logs component my-service --env prod --container main \
+    --start-time 2026-01-01T10:00:00Z --end-time 2026-01-01T11:00:00Z
+
+  # Follow the logs of the latest build
+  occ logs component my-service --type build -f`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			logType, _ := cmd.Flags().GetString("type")
+			container, _ := cmd.Flags().GetString("container")
+			startTime, _ := cmd.Flags().GetString("start-time")
+			endTime, _ := cmd.Flags().GetString("end-time")
+			return New(cl).Component(ComponentParams{
+				Namespace:   flags.GetNamespace(cmd),
+				Project:     flags.GetProject(cmd),
+				Component:   args[0],
+				Environment: flags.GetEnvironment(cmd),
+				Type:        logType,
+				Container:   container,
+				WorkflowRun: flags.GetWorkflowRun(cmd),
+				Follow:      flags.GetFollow(cmd),
+				Since:       flags.GetSince(cmd),
+				StartTime:   startTime,
+				EndTime:     endTime,
+				Tail:        flags.GetTail(cmd),
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	flags.AddEnvironment(cmd)
+	cmd.Flags().String("type", LogTypeRuntime, "Logs to show: runtime or build")
+	// No short alias: `-c` denotes --component elsewhere in the CLI.
+	cmd.Flags().String("container", "", "Container name to fetch runtime logs from (defaults to all containers)")
+	cmd.Flags().String("start-time", "", "Start of the time range as an RFC3339 timestamp (overrides --since)")
+	cmd.Flags().String("end-time", "", "End of the time range as an RFC3339 timestamp (defaults to now)")
+	flags.AddWorkflowRun(cmd)
+	flags.AddFollow(cmd)
+	flags.AddSince(cmd)
+	flags.AddTail(cmd)
+	return cmd
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/component"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+// Log types that can be streamed for a component.
+const (
+	LogTypeRuntime = "runtime"
+	LogTypeBuild   = "build"
+)
+
+// Logs implements log streaming operations
+type Logs struct {
+	client client.Interface
+}
+
+// New creates a new logs implementation
+func New(c client.Interface) *Logs {
+	return &Logs{client: c}
+}
+
+// Component prints the runtime or build logs of a component
+func (l *Logs) Component(params ComponentParams) error {
+	if err := cmdutil.RequireFields("logs", "component", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	switch params.Type {
+	case "", LogTypeRuntime:
+		if params.WorkflowRun != "" {
+			return fmt.Errorf("--workflowrun can only be used with --type %s", LogTypeBuild)
+		}
+		return component.New(l.client).Logs(component.LogsParams{
+			Namespace:   params.Namespace,
+			Project:     params.Project,
+			Component:   params.Component,
+			Environment: params.Environment,
+			Container:   params.Container,
+			Follow:      params.Follow,
+			Since:       params.Since,
+			StartTime:   params.StartTime,
+			EndTime:     params.EndTime,
+			Tail:        params.Tail,
+		})
+	case LogTypeBuild:
+		switch {
+		case params.Environment != "":
+			return fmt.Errorf("--env can only be used with --type %s", LogTypeRuntime)
+		case params.Container != "":
+			return fmt.Errorf("--container can only be used with --type %s", LogTypeRuntime)
+		case params.StartTime != "" || params.EndTime != "":
+			return fmt.Errorf("--start-time and --end-time can only be used with --type %s", LogTypeRuntime)
+		}
+		return component.New(l.client).WorkflowRunLogs(component.WorkflowRunLogsParams{
+			Namespace:     params.Namespace,
+			ComponentName: params.Component,
+			RunName:       params.WorkflowRun,
+			Follow:        params.Follow,
+			Since:         params.Since,
+		})
+	default:
+		return fmt.Errorf("unsupported log type %q, must be one of %s or %s", params.Type, LogTypeRuntime, LogTypeBuild)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func TestComponent_RequiresNamespace(t *testing.T) {
+	err := New(mocks.NewMockInterface(t)).Component(ComponentParams{Component: "api"})
+	assert.ErrorContains(t, err, "namespace")
+}
+
+func TestComponent_InvalidFlagCombinations(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  ComponentParams
+		wantErr string
+	}{
+		{"unknown type", ComponentParams{Type: "audit"}, `unsupported log type "audit"`},
+		{"workflow run with runtime logs", ComponentParams{WorkflowRun: "api-build-1"}, "--workflowrun can only be used with --type build"},
+		{"environment with build logs", ComponentParams{Type: LogTypeBuild, Environment: "dev"}, "--env can only be used with --type runtime"},
+		{"container with build logs", ComponentParams{Type: LogTypeBuild, Container: "main"}, "--container can only be used with --type runtime"},
+		{"time range with build logs", ComponentParams{Type: LogTypeBuild, StartTime: "2026-01-01T00:00:00Z"}, "--start-time and --end-time"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.Namespace = "acme"
+			tt.params.Component = "api"
+			err := New(mocks.NewMockInterface(t)).Component(tt.params)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestComponent_RuntimeResolvesObserverThroughAPI(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetComponent(mock.Anything, "acme", "api").Return(&gen.Component{}, nil)
+	mc.EXPECT().GetComponentObserverURL(mock.Anything, "acme", "api", "dev").
+		Return("", fmt.Errorf("observer URL not configured"))
+
+	err := New(mc).Component(ComponentParams{Namespace: "acme", Project: "shop", Component: "api", Environment: "dev"})
+	assert.ErrorContains(t, err, "observer URL not configured")
+}
+
+func TestComponent_BuildUsesComponentWorkflowRun(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "acme", "api-build-1").
+		Return(nil, fmt.Errorf("workflow run not found"))
+
+	err := New(mc).Component(ComponentParams{Namespace: "acme", Component: "api", Type: LogTypeBuild, WorkflowRun: "api-build-1"})
+	assert.ErrorContains(t, err, "workflow run not found")
+}
+
+func TestNewLogsCmd(t *testing.T) {
+	cmd := NewLogsCmd(nil)
+	assert.Equal(t, "logs", cmd.Use)
+	component, _, err := cmd.Find([]string{"component"})
+	assert.NoError(t, err)
+	for _, name := range []string{"env", "type", "container", "start-time", "end-time", "follow", "since", "tail", "workflowrun"} {
+		assert.NotNil(t, component.Flags().Lookup(name), "missing flag --%s", name)
+	}
+}
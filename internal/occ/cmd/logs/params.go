@@ -0,0 +1,20 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+// ComponentParams defines parameters for streaming the logs of a component
+type ComponentParams struct {
+	Namespace   string
+	Project     string // optional — defaults to the component's project
+	Component   string
+	Environment string // optional — defaults to the lowest environment of the pipeline
+	Type        string // runtime or build
+	Container   string // optional — runtime logs only; empty means all containers
+	WorkflowRun string // optional — build logs only; defaults to the latest run
+	Follow      bool
+	Since       string // duration like "1h", "30m", "5m"
+	StartTime   string // optional RFC3339 start of the time range; runtime logs only
+	EndTime     string // optional RFC3339 end of the time range; runtime logs only
+	Tail        int    // number of runtime log lines to show from the end (0 means no limit)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewQuarantineCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantine",
+		Short: "Manage quarantined resources",
+		Long: `Commands for managing resources quarantined after failing reconciles.
+
+The controller manager stops reconciling a Component, ReleaseBinding, Resource or
+ResourceReleaseBinding whose reconciles keep failing. Once the underlying problem is
+fixed, requeue the resource to have it reconciled again. Changing the spec of a
+quarantined resource requeues it as well.`,
+	}
+	cmd.AddCommand(
+		newListCmd(f),
+		newRequeueCmd(f),
+	)
+	return cmd
+}
+
+func newListCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List quarantined resources",
+		Long:  `List the resources of a namespace quarantined after failing reconciles.`,
+		Example: `  # List quarantined resources
+  occ quarantine list --namespace acme-corp`,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).List(ListParams{Namespace: flags.GetNamespace(cmd)})
+		},
+	}
+	flags.AddNamespace(cmd)
+	return cmd
+}
+
+func newRequeueCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "requeue KIND NAME",
+		Short: "Requeue a quarantined resource",
+		Long: `Request the controller manager to reconcile a quarantined resource again.
+
+KIND is one of Component, ReleaseBinding, Resource or ResourceReleaseBinding.`,
+		Example: `  # Requeue a release binding after fixing its configuration
+  occ quarantine requeue ReleaseBinding api-production --namespace acme-corp`,
+		Args:    cobra.ExactArgs(2),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Requeue(RequeueParams{
+				Namespace: flags.GetNamespace(cmd),
+				Kind:      args[0],
+				Name:      args[1],
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	return cmd
+}
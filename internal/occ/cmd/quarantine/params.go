@@ -0,0 +1,16 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+// ListParams defines parameters for listing quarantined resources
+type ListParams struct {
+	Namespace string
+}
+
+// RequeueParams defines parameters for requeueing a quarantined resource
+type RequeueParams struct {
+	Namespace string
+	Kind      string // Component, ReleaseBinding, Resource or ResourceReleaseBinding
+	Name      string
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// Quarantine implements quarantined resource operations
+type Quarantine struct {
+	client client.Interface
+}
+
+// New creates a new quarantine implementation
+func New(c client.Interface) *Quarantine {
+	return &Quarantine{client: c}
+}
+
+// List lists the quarantined resources of a namespace
+func (q *Quarantine) List(params ListParams) error {
+	if err := cmdutil.RequireFields("list", "quarantine", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	result, err := q.client.ListQuarantinedResources(context.Background(), params.Namespace)
+	if err != nil {
+		return err
+	}
+	return printQuarantinedResources(result.Items)
+}
+
+// Requeue requests a quarantined resource to be reconciled again
+func (q *Quarantine) Requeue(params RequeueParams) error {
+	if err := cmdutil.RequireFields("requeue", "quarantine", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	if _, err := q.client.RequeueQuarantinedResource(context.Background(), params.Namespace, params.Kind, params.Name); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s '%s' requeued\n", params.Kind, params.Name)
+	return nil
+}
+
+func printQuarantinedResources(items []gen.QuarantinedResource) error {
+	if len(items) == 0 {
+		fmt.Println("No quarantined resources found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNAME\tPROJECT\tQUARANTINED\tMESSAGE")
+	for _, r := range items {
+		project := ""
+		if r.Project != nil {
+			project = *r.Project
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			r.Kind, r.Name, project, utils.FormatAge(r.QuarantinedAt), r.Message)
+	}
+	return w.Flush()
+}
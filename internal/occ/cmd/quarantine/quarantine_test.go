@@ -0,0 +1,70 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func TestList_PrintsQuarantinedResources(t *testing.T) {
+	project := "shop"
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListQuarantinedResources(mock.Anything, "acme").Return(&gen.QuarantinedResourceList{
+		Items: []gen.QuarantinedResource{{
+			Kind:          gen.QuarantinedResourceKindReleaseBinding,
+			Name:          "api-production",
+			Project:       &project,
+			QuarantinedAt: time.Now().Add(-2 * time.Hour),
+			Message:       "Reconcile failed 20 consecutive times",
+		}},
+	}, nil)
+
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = New(mc).List(ListParams{Namespace: "acme"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "QUARANTINED")
+	assert.Contains(t, out, "api-production")
+	assert.Contains(t, out, "Reconcile failed 20 consecutive times")
+}
+
+func TestList_NoResources(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListQuarantinedResources(mock.Anything, "acme").Return(&gen.QuarantinedResourceList{}, nil)
+
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = New(mc).List(ListParams{Namespace: "acme"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "No quarantined resources found")
+}
+
+func TestRequeue(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().RequeueQuarantinedResource(mock.Anything, "acme", "Component", "api").
+		Return(&gen.QuarantinedResource{Kind: gen.QuarantinedResourceKindComponent, Name: "api"}, nil)
+
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = New(mc).Requeue(RequeueParams{Namespace: "acme", Kind: "Component", Name: "api"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Component 'api' requeued")
+}
+
+func TestRequeue_RequiresNamespace(t *testing.T) {
+	err := New(mocks.NewMockInterface(t)).Requeue(RequeueParams{Kind: "Component", Name: "api"})
+	require.Error(t, err)
+}
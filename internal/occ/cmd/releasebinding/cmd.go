@@ -28,6 +28,7 @@ func NewReleaseBindingCmd(f client.NewClientFunc) *cobra.Command {
 		newListCmd(f),
 		newGetCmd(f),
 		newDeleteCmd(f),
+		newMaintenanceCmd(f),
 	)
 	return cmd
 }
@@ -172,6 +173,89 @@ func newDeleteCmd(f client.NewClientFunc) *cobra.Command {
 	return cmd
 }
 
+func newMaintenanceCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Manage the maintenance of release bindings",
+		Long: `Put a component out of service in an environment without undeploying it. While in
+maintenance, the gateway answers the requests of the component with a fixed response or a
+redirect instead of routing them to the workload.`,
+	}
+	cmd.AddCommand(
+		newMaintenanceEnableCmd(f),
+		newMaintenanceDisableCmd(f),
+	)
+	return cmd
+}
+
+func newMaintenanceEnableCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable [RELEASE_BINDING_NAME]",
+		Short: "Put a release binding in maintenance",
+		Long: `Put a release binding in maintenance. Requests are answered with --status-code
+(default 503) and --body or the contents of --body-file, or redirected to --redirect-url
+(default status 302).`,
+		Example: `  # Serve a maintenance page
+  occ releasebinding maintenance enable my-binding --namespace acme-corp --body-file maintenance.html --reason "Database migration"
+
+  # Redirect to a status page
+  occ releasebinding maintenance enable my-binding --namespace acme-corp --redirect-url https://status.example.com`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			statusCode, _ := cmd.Flags().GetInt32("status-code")
+			body, _ := cmd.Flags().GetString("body")
+			bodyFile, _ := cmd.Flags().GetString("body-file")
+			redirectURL, _ := cmd.Flags().GetString("redirect-url")
+			reason, _ := cmd.Flags().GetString("reason")
+			return New(cl).EnableMaintenance(MaintenanceEnableParams{
+				Namespace:          flags.GetNamespace(cmd),
+				ReleaseBindingName: args[0],
+				StatusCode:         statusCode,
+				Body:               body,
+				BodyFile:           bodyFile,
+				RedirectURL:        redirectURL,
+				Reason:             reason,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	cmd.Flags().Int32("status-code", 0, "HTTP status of the response (default 503, or 302 with --redirect-url)")
+	cmd.Flags().String("body", "", "Body of the response")
+	cmd.Flags().String("body-file", "", "Path to a file with the body of the response")
+	cmd.Flags().String("redirect-url", "", "Absolute http or https URL to redirect requests to")
+	cmd.Flags().String("reason", "", "Why the component is in maintenance")
+	return cmd
+}
+
+func newMaintenanceDisableCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable [RELEASE_BINDING_NAME]",
+		Short: "End the maintenance of a release binding",
+		Long:  `End the maintenance of a release binding, routing its requests to the workload again.`,
+		Example: `  # End the maintenance of a release binding
+  occ releasebinding maintenance disable my-binding --namespace acme-corp`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).DisableMaintenance(MaintenanceDisableParams{
+				Namespace:          flags.GetNamespace(cmd),
+				ReleaseBindingName: args[0],
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	return cmd
+}
+
 // isFlagInArgs checks if a flag was explicitly provided in os.Args.
 func isFlagInArgs(flagName string) bool {
 	for _, arg := range os.Args {
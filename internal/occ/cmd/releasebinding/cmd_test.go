@@ -5,11 +5,14 @@ package releasebinding
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
@@ -44,7 +47,7 @@ func TestNewReleaseBindingCmd_Subcommands(t *testing.T) {
 	for _, sub := range cmd.Commands() {
 		names = append(names, sub.Name())
 	}
-	assert.ElementsMatch(t, []string{"generate", "list", "get", "delete"}, names)
+	assert.ElementsMatch(t, []string{"generate", "list", "get", "delete", "maintenance"}, names)
 }
 
 // --- list ---
@@ -132,6 +135,64 @@ func TestDeleteCmd_Success(t *testing.T) {
 	assert.Contains(t, out, "deleted")
 }
 
+// --- maintenance ---
+
+func TestMaintenanceEnableCmd_Success(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().EnableReleaseBindingMaintenance(mock.Anything, "acme-corp", "my-binding", gen.ReleaseBindingMaintenanceRequest{
+		RedirectURL: ptr.To("https://status.example.com"),
+		Reason:      ptr.To("upgrade"),
+	}).Return(&gen.ReleaseBinding{Metadata: gen.ObjectMeta{Name: "my-binding"}}, nil)
+
+	cmd := newMaintenanceEnableCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	require.NoError(t, cmd.Flags().Set("redirect-url", "https://status.example.com"))
+	require.NoError(t, cmd.Flags().Set("reason", "upgrade"))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cmd.RunE(cmd, []string{"my-binding"}))
+	})
+	assert.Contains(t, out, "in maintenance")
+}
+
+func TestMaintenanceEnableCmd_BodyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "maintenance.html")
+	require.NoError(t, os.WriteFile(path, []byte("<h1>Back soon</h1>"), 0o600))
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().EnableReleaseBindingMaintenance(mock.Anything, "acme-corp", "my-binding", gen.ReleaseBindingMaintenanceRequest{
+		Body: ptr.To("<h1>Back soon</h1>"),
+	}).Return(&gen.ReleaseBinding{Metadata: gen.ObjectMeta{Name: "my-binding"}}, nil)
+
+	cmd := newMaintenanceEnableCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	require.NoError(t, cmd.Flags().Set("body-file", path))
+	testutil.CaptureStdout(t, func() {
+		require.NoError(t, cmd.RunE(cmd, []string{"my-binding"}))
+	})
+}
+
+func TestMaintenanceEnableCmd_BodyAndBodyFile(t *testing.T) {
+	cmd := newMaintenanceEnableCmd(mockFactory(mocks.NewMockInterface(t)))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	require.NoError(t, cmd.Flags().Set("body", "down"))
+	require.NoError(t, cmd.Flags().Set("body-file", "page.html"))
+	err := cmd.RunE(cmd, []string{"my-binding"})
+	assert.EqualError(t, err, "--body and --body-file cannot be used together")
+}
+
+func TestMaintenanceDisableCmd_Success(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().DisableReleaseBindingMaintenance(mock.Anything, "acme-corp", "my-binding").Return(
+		&gen.ReleaseBinding{Metadata: gen.ObjectMeta{Name: "my-binding"}}, nil,
+	)
+
+	cmd := newMaintenanceDisableCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cmd.RunE(cmd, []string{"my-binding"}))
+	})
+	assert.Contains(t, out, "out of maintenance")
+}
+
 // --- isFlagInArgs ---
 
 func TestIsFlagInArgs_ExactMatch(t *testing.T) {
@@ -44,3 +44,24 @@ type DeleteParams struct {
 
 func (p DeleteParams) GetNamespace() string          { return p.Namespace }
 func (p DeleteParams) GetReleaseBindingName() string { return p.ReleaseBindingName }
+
+// MaintenanceEnableParams defines parameters for putting a release binding in maintenance
+type MaintenanceEnableParams struct {
+	Namespace          string
+	ReleaseBindingName string
+	StatusCode         int32
+	Body               string
+	BodyFile           string
+	RedirectURL        string
+	Reason             string
+}
+
+func (p MaintenanceEnableParams) GetNamespace() string { return p.Namespace }
+
+// MaintenanceDisableParams defines parameters for ending the maintenance of a release binding
+type MaintenanceDisableParams struct {
+	Namespace          string
+	ReleaseBindingName string
+}
+
+func (p MaintenanceDisableParams) GetNamespace() string { return p.Namespace }
@@ -238,6 +238,63 @@ func (r *ReleaseBinding) Delete(params DeleteParams) error {
 	return nil
 }
 
+// EnableMaintenance puts a release binding in maintenance
+func (r *ReleaseBinding) EnableMaintenance(params MaintenanceEnableParams) error {
+	if err := cmdutil.RequireFields("maintenance enable", "releasebinding", map[string]string{"namespace": params.Namespace, "name": params.ReleaseBindingName}); err != nil {
+		return err
+	}
+	if params.Body != "" && params.BodyFile != "" {
+		return fmt.Errorf("--body and --body-file cannot be used together")
+	}
+
+	req := gen.ReleaseBindingMaintenanceRequest{}
+	if params.StatusCode != 0 {
+		req.StatusCode = &params.StatusCode
+	}
+	body := params.Body
+	if params.BodyFile != "" {
+		data, err := os.ReadFile(params.BodyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read body file: %w", err)
+		}
+		body = string(data)
+	}
+	if body != "" {
+		req.Body = &body
+	}
+	if params.RedirectURL != "" {
+		req.RedirectURL = &params.RedirectURL
+	}
+	if params.Reason != "" {
+		req.Reason = &params.Reason
+	}
+
+	ctx := context.Background()
+	result, err := r.client.EnableReleaseBindingMaintenance(ctx, params.Namespace, params.ReleaseBindingName, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("ReleaseBinding '%s' is in maintenance\n", result.Metadata.Name)
+	return nil
+}
+
+// DisableMaintenance ends the maintenance of a release binding
+func (r *ReleaseBinding) DisableMaintenance(params MaintenanceDisableParams) error {
+	if err := cmdutil.RequireFields("maintenance disable", "releasebinding", map[string]string{"namespace": params.Namespace, "name": params.ReleaseBindingName}); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	result, err := r.client.DisableReleaseBindingMaintenance(ctx, params.Namespace, params.ReleaseBindingName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("ReleaseBinding '%s' is out of maintenance\n", result.Metadata.Name)
+	return nil
+}
+
 // loadReleaseConfig loads the release-config.yaml file
 func (r *ReleaseBinding) loadReleaseConfig(repoPath string, requireForBulk bool) (*occonfig.ReleaseConfig, error) {
 	configPath := filepath.Join(repoPath, releaseConfigFileName)
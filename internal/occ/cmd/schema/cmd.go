@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewSchemaCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Manage the local schema cache",
+		Long:  `Manage the local cache of component type and trait parameter schemas used by occ validate --offline.`,
+	}
+	cmd.AddCommand(newPullCmd(f))
+	return cmd
+}
+
+func newPullCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pull",
+		Short: "Download component type and trait schemas into the local cache",
+		Long: `Download the parameter schemas of component types and traits from the control plane
+into a local cache, so that manifests can be validated with occ validate --offline.
+
+Cluster-scoped component types and traits are always pulled; namespaced ones are pulled
+when --namespace is set. Schemas of the pulled scopes that no longer exist are removed.
+Commit the cache directory to a repository to lint manifests in CI without access to the
+control plane.`,
+		Example: `  # Pull the cluster-scoped schemas into ~/.openchoreo/schemas
+  occ schema pull
+
+  # Pull the schemas of a namespace into a directory of the repository
+  occ schema pull --namespace acme-corp --dir .openchoreo/schemas`,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			dir, _ := cmd.Flags().GetString("dir")
+			return New(cl).Pull(PullParams{
+				Namespace: flags.GetNamespace(cmd),
+				Dir:       dir,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	cmd.Flags().String("dir", "", "Schema cache directory (default ~/.openchoreo/schemas)")
+	return cmd
+}
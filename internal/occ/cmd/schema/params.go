@@ -0,0 +1,10 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+// PullParams defines parameters for pulling schemas into the local cache
+type PullParams struct {
+	Namespace string // optional; namespaced component types and traits are skipped when empty
+	Dir       string // optional cache directory; defaults to ~/.openchoreo/schemas
+}
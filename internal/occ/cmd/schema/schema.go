@@ -0,0 +1,187 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/schemacache"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// Schema implements schema cache operations
+type Schema struct {
+	client client.Interface
+}
+
+// New creates a new schema implementation
+func New(c client.Interface) *Schema {
+	return &Schema{client: c}
+}
+
+// source lists the resources of a kind and fetches their schemas.
+type source struct {
+	kind      string
+	namespace string
+	list      func(ctx context.Context) ([]string, error)
+	get       func(ctx context.Context, name string) (*json.RawMessage, error)
+}
+
+// Pull downloads the parameter schemas of component types and traits into the cache,
+// replacing the previously cached schemas of the pulled scopes.
+func (s *Schema) Pull(params PullParams) error {
+	ctx := context.Background()
+
+	cache, err := schemacache.New(params.Dir)
+	if err != nil {
+		return err
+	}
+
+	total := 0
+	for _, src := range s.sources(params.Namespace) {
+		names, err := src.list(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list %s resources: %w", src.kind, err)
+		}
+		schemas := make(map[string]json.RawMessage, len(names))
+		for _, name := range names {
+			raw, err := src.get(ctx, name)
+			if err != nil {
+				return fmt.Errorf("failed to get schema of %s %s: %w", src.kind, name, err)
+			}
+			if raw != nil {
+				schemas[name] = *raw
+			}
+		}
+
+		if err := cache.Clear(src.kind, src.namespace); err != nil {
+			return err
+		}
+		for name, raw := range schemas {
+			if err := cache.Save(src.kind, src.namespace, name, raw); err != nil {
+				return err
+			}
+		}
+		total += len(schemas)
+	}
+
+	fmt.Printf("Pulled %d schema(s) into %s\n", total, cache.Dir)
+	return nil
+}
+
+func (s *Schema) sources(namespace string) []source {
+	sources := []source{
+		{
+			kind: schemacache.KindClusterComponentType,
+			list: func(ctx context.Context) ([]string, error) {
+				items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterComponentType, string, error) {
+					p := &gen.ListClusterComponentTypesParams{Limit: &limit}
+					if cursor != "" {
+						p.Cursor = &cursor
+					}
+					result, err := s.client.ListClusterComponentTypes(ctx, p)
+					if err != nil {
+						return nil, "", err
+					}
+					return result.Items, nextCursor(result.Pagination), nil
+				})
+				names := make([]string, 0, len(items))
+				for _, item := range items {
+					names = append(names, item.Metadata.Name)
+				}
+				return names, err
+			},
+			get: s.client.GetClusterComponentTypeSchema,
+		},
+		{
+			kind: schemacache.KindClusterTrait,
+			list: func(ctx context.Context) ([]string, error) {
+				items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterTrait, string, error) {
+					p := &gen.ListClusterTraitsParams{Limit: &limit}
+					if cursor != "" {
+						p.Cursor = &cursor
+					}
+					result, err := s.client.ListClusterTraits(ctx, p)
+					if err != nil {
+						return nil, "", err
+					}
+					return result.Items, nextCursor(result.Pagination), nil
+				})
+				names := make([]string, 0, len(items))
+				for _, item := range items {
+					names = append(names, item.Metadata.Name)
+				}
+				return names, err
+			},
+			get: s.client.GetClusterTraitSchema,
+		},
+	}
+	if namespace == "" {
+		return sources
+	}
+
+	return append(sources,
+		source{
+			kind:      schemacache.KindComponentType,
+			namespace: namespace,
+			list: func(ctx context.Context) ([]string, error) {
+				items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ComponentType, string, error) {
+					p := &gen.ListComponentTypesParams{Limit: &limit}
+					if cursor != "" {
+						p.Cursor = &cursor
+					}
+					result, err := s.client.ListComponentTypes(ctx, namespace, p)
+					if err != nil {
+						return nil, "", err
+					}
+					return result.Items, nextCursor(result.Pagination), nil
+				})
+				names := make([]string, 0, len(items))
+				for _, item := range items {
+					names = append(names, item.Metadata.Name)
+				}
+				return names, err
+			},
+			get: func(ctx context.Context, name string) (*json.RawMessage, error) {
+				return s.client.GetComponentTypeSchema(ctx, namespace, name)
+			},
+		},
+		source{
+			kind:      schemacache.KindTrait,
+			namespace: namespace,
+			list: func(ctx context.Context) ([]string, error) {
+				items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.Trait, string, error) {
+					p := &gen.ListTraitsParams{Limit: &limit}
+					if cursor != "" {
+						p.Cursor = &cursor
+					}
+					result, err := s.client.ListTraits(ctx, namespace, p)
+					if err != nil {
+						return nil, "", err
+					}
+					return result.Items, nextCursor(result.Pagination), nil
+				})
+				names := make([]string, 0, len(items))
+				for _, item := range items {
+					names = append(names, item.Metadata.Name)
+				}
+				return names, err
+			},
+			get: func(ctx context.Context, name string) (*json.RawMessage, error) {
+				return s.client.GetTraitSchema(ctx, namespace, name)
+			},
+		},
+	)
+}
+
+func nextCursor(p gen.Pagination) string {
+	if p.NextCursor == nil {
+		return ""
+	}
+	return *p.NextCursor
+}
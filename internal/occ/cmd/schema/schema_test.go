@@ -0,0 +1,73 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/schemacache"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func rawSchema(s string) *json.RawMessage {
+	raw := json.RawMessage(s)
+	return &raw
+}
+
+func expectClusterSchemas(mc *mocks.MockInterface) {
+	mc.EXPECT().ListClusterComponentTypes(mock.Anything, mock.Anything).Return(&gen.ClusterComponentTypeList{
+		Items: []gen.ClusterComponentType{{Metadata: gen.ObjectMeta{Name: "service"}}},
+	}, nil)
+	mc.EXPECT().GetClusterComponentTypeSchema(mock.Anything, "service").Return(rawSchema(`{"type":"object"}`), nil)
+	mc.EXPECT().ListClusterTraits(mock.Anything, mock.Anything).Return(&gen.ClusterTraitList{}, nil)
+}
+
+func TestPull_ClusterScoped(t *testing.T) {
+	dir := t.TempDir()
+	// A schema of a cluster component type that no longer exists is removed.
+	stale := filepath.Join(dir, "clustercomponenttypes", "deleted.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(stale), 0o755))
+	require.NoError(t, os.WriteFile(stale, []byte(`{}`), 0o600))
+
+	mc := mocks.NewMockInterface(t)
+	expectClusterSchemas(mc)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Pull(PullParams{Dir: dir}))
+	})
+	assert.Contains(t, out, "Pulled 1 schema(s) into "+dir)
+
+	cache := &schemacache.Cache{Dir: dir}
+	loaded, err := cache.Load(schemacache.KindClusterComponentType, "", "service")
+	require.NoError(t, err)
+	assert.Equal(t, "object", loaded["type"])
+	assert.NoFileExists(t, stale)
+}
+
+func TestPull_IncludesNamespace(t *testing.T) {
+	dir := t.TempDir()
+	mc := mocks.NewMockInterface(t)
+	expectClusterSchemas(mc)
+	mc.EXPECT().ListComponentTypes(mock.Anything, "acme", mock.Anything).Return(&gen.ComponentTypeList{}, nil)
+	mc.EXPECT().ListTraits(mock.Anything, "acme", mock.Anything).Return(&gen.TraitList{
+		Items: []gen.Trait{{Metadata: gen.ObjectMeta{Name: "ingress"}}},
+	}, nil)
+	mc.EXPECT().GetTraitSchema(mock.Anything, "acme", "ingress").Return(rawSchema(`{"type":"object"}`), nil)
+
+	testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Pull(PullParams{Namespace: "acme", Dir: dir}))
+	})
+
+	_, err := (&schemacache.Cache{Dir: dir}).Load(schemacache.KindTrait, "acme", "ingress")
+	assert.NoError(t, err)
+}
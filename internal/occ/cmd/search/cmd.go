@@ -0,0 +1,63 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewSearchCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search [QUERY]",
+		Short: "Search components",
+		Long: `Search components by name, display name, description, labels and endpoint hosts.
+
+Every query term must match; results are ranked with name matches first. Without a
+query, every component matching the filters is listed.`,
+		Example: `  # Search all namespaces
+  occ search checkout
+
+  # Search a namespace, only components deployed to production
+  occ search payments --namespace acme-corp --env production
+
+  # Count results by project and type
+  occ search api --facets project,type`,
+		Args:    cobra.ArbitraryArgs,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			kind, _ := cmd.Flags().GetString("kind")
+			componentType, _ := cmd.Flags().GetString("type")
+			facets, _ := cmd.Flags().GetStringSlice("facets")
+			limit, _ := cmd.Flags().GetInt("limit")
+			return New(cl).Search(SearchParams{
+				Query:       strings.Join(args, " "),
+				Kind:        kind,
+				Namespace:   flags.GetNamespace(cmd),
+				Project:     flags.GetProject(cmd),
+				Type:        componentType,
+				Environment: flags.GetEnvironment(cmd),
+				Facets:      facets,
+				Limit:       limit,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	cmd.Flags().String("env", "", "Only show components bound to this environment (e.g., production)")
+	cmd.Flags().String("kind", "", "Kind of resource to search (component)")
+	cmd.Flags().String("type", "", "Only show components of this type (e.g., deployment/web-app)")
+	cmd.Flags().StringSlice("facets", nil, "Print result counts per value of these facets (type, project, env)")
+	cmd.Flags().Int("limit", 0, "Maximum number of results to show (server default when 0)")
+	return cmd
+}
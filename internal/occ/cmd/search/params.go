@@ -0,0 +1,16 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+// SearchParams defines parameters for searching resources
+type SearchParams struct {
+	Query       string   // optional; every resource matching the filters is returned when empty
+	Kind        string   // optional; defaults to component on the server
+	Namespace   string   // optional; all namespaces are searched when empty
+	Project     string   // optional filter
+	Type        string   // optional filter on the component type
+	Environment string   // optional filter on bound environments
+	Facets      []string // optional facets to print counts for
+	Limit       int      // optional; the server default applies when zero
+}
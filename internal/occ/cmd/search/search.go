@@ -0,0 +1,125 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// Search implements search operations
+type Search struct {
+	client client.Interface
+}
+
+// New creates a new search implementation
+func New(c client.Interface) *Search {
+	return &Search{client: c}
+}
+
+// Search prints the ranked results of a search, followed by the requested facet counts.
+func (s *Search) Search(params SearchParams) error {
+	p := &gen.SearchParams{}
+	if params.Query != "" {
+		p.Q = &params.Query
+	}
+	if params.Kind != "" {
+		kind := gen.SearchParamsKind(params.Kind)
+		p.Kind = &kind
+	}
+	if params.Namespace != "" {
+		p.Namespace = &params.Namespace
+	}
+	if params.Project != "" {
+		p.Project = &params.Project
+	}
+	if params.Type != "" {
+		p.Type = &params.Type
+	}
+	if params.Environment != "" {
+		p.Env = &params.Environment
+	}
+	if len(params.Facets) > 0 {
+		facets := make([]gen.SearchParamsFacets, 0, len(params.Facets))
+		for _, f := range params.Facets {
+			facets = append(facets, gen.SearchParamsFacets(f))
+		}
+		p.Facets = &facets
+	}
+	if params.Limit > 0 {
+		p.Limit = &params.Limit
+	}
+
+	result, err := s.client.Search(context.Background(), p)
+	if err != nil {
+		return err
+	}
+	if err := printResults(result); err != nil {
+		return err
+	}
+	return printFacets(result, params.Facets)
+}
+
+func printResults(result *gen.SearchResults) error {
+	if len(result.Items) == 0 {
+		fmt.Println("No results found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tPROJECT\tNAME\tTYPE\tENVIRONMENTS\tMATCHED")
+	for _, hit := range result.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			hit.Namespace, hit.Project, hit.Name, deref(hit.Type), join(hit.Environments), join(hit.MatchedFields))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if result.Total > len(result.Items) {
+		fmt.Printf("\nShowing %d of %d results\n", len(result.Items), result.Total)
+	}
+	return nil
+}
+
+func printFacets(result *gen.SearchResults, facets []string) error {
+	if result.Facets == nil {
+		return nil
+	}
+	for _, name := range facets {
+		counts, ok := (*result.Facets)[name]
+		if !ok {
+			continue
+		}
+		fmt.Println()
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintf(w, "%s\tCOUNT\n", strings.ToUpper(name))
+		for _, c := range counts {
+			fmt.Fprintf(w, "%s\t%d\n", c.Value, c.Count)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func join(s *[]string) string {
+	if s == nil {
+		return ""
+	}
+	return strings.Join(*s, ",")
+}
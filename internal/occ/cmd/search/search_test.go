@@ -0,0 +1,70 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func TestSearch_PrintsResultsAndFacets(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().Search(mock.Anything, mock.MatchedBy(func(p *gen.SearchParams) bool {
+		return *p.Q == "checkout api" && *p.Namespace == "acme" && p.Project == nil &&
+			len(*p.Facets) == 1 && (*p.Facets)[0] == gen.SearchParamsFacetsProject && *p.Limit == 1
+	})).Return(&gen.SearchResults{
+		Items: []gen.SearchHit{{
+			Namespace:     "acme",
+			Project:       "shop",
+			Name:          "checkout",
+			Type:          ptr("deployment/service"),
+			Environments:  &[]string{"dev", "prod"},
+			MatchedFields: &[]string{"name", "hosts"},
+		}},
+		Total:  2,
+		Facets: &map[string][]gen.FacetCount{"project": {{Value: "shop", Count: 2}}},
+	}, nil)
+
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = New(mc).Search(SearchParams{Query: "checkout api", Namespace: "acme", Facets: []string{"project"}, Limit: 1})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "NAMESPACE")
+	assert.Contains(t, out, "deployment/service")
+	assert.Contains(t, out, "dev,prod")
+	assert.Contains(t, out, "name,hosts")
+	assert.Contains(t, out, "Showing 1 of 2 results")
+	assert.Contains(t, out, "PROJECT   COUNT")
+}
+
+func TestSearch_NoResults(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().Search(mock.Anything, &gen.SearchParams{}).Return(&gen.SearchResults{Items: []gen.SearchHit{}}, nil)
+
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = New(mc).Search(SearchParams{})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "No results found")
+}
+
+func TestSearch_Error(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().Search(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("unsupported facet"))
+
+	err := New(mc).Search(SearchParams{Facets: []string{"owner"}})
+	require.ErrorContains(t, err, "unsupported facet")
+}
+
+func ptr(s string) *string { return &s }
@@ -10,6 +10,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
 	"github.com/openchoreo/openchoreo/internal/occ/flags"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/scaffold/definition"
 )
 
 func NewTraitCmd(f client.NewClientFunc) *cobra.Command {
@@ -23,6 +24,8 @@ func NewTraitCmd(f client.NewClientFunc) *cobra.Command {
 		newListCmd(f),
 		newGetCmd(f),
 		newDeleteCmd(f),
+		newInitCmd(),
+		newTestCmd(),
 	)
 	return cmd
 }
@@ -96,3 +99,58 @@ func newDeleteCmd(f client.NewClientFunc) *cobra.Command {
 	flags.AddNamespace(cmd)
 	return cmd
 }
+
+func newInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init [TRAIT_NAME]",
+		Short: "Scaffold a new trait",
+		Long: `Scaffold a new trait for local development.
+
+Writes the Trait with a parameter schema, a created resource and patches, a sample
+ComponentType, Component and Workload to attach it to, and a render test with its
+golden file. Edit them and run "occ trait test" to render the samples locally.`,
+		Example: `  # Scaffold a trait in ./persistent-volume
+  occ trait init persistent-volume
+
+  # Scaffold a trait in a given directory
+  occ trait init persistent-volume --dir platform/traits/persistent-volume`,
+		Args: cmdutil.ExactOneArgWithUsage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, _ := cmd.Flags().GetString("namespace")
+			dir, _ := cmd.Flags().GetString("dir")
+			force, _ := cmd.Flags().GetBool("force")
+			return Init(InitParams{
+				TraitName: args[0],
+				Namespace: namespace,
+				Dir:       dir,
+				Force:     force,
+			})
+		},
+	}
+	cmd.Flags().String("namespace", definition.DefaultNamespace, "Namespace of the generated resources")
+	cmd.Flags().String("dir", "", "Directory to write the scaffold to (defaults to the trait name)")
+	cmd.Flags().Bool("force", false, "Overwrite existing files")
+	return cmd
+}
+
+func newTestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test [DIR]",
+		Short: "Run the render tests of a trait",
+		Long: `Render the samples of a trait scaffolded with "occ trait init" and check the output
+against the expected resources and golden files of its render tests in DIR/tests.
+No cluster or login is needed.`,
+		Example: `  # Run the render tests
+  occ trait test persistent-volume
+
+  # Rewrite the golden files after an intended change
+  occ trait test persistent-volume --update`,
+		Args: cmdutil.ExactOneArgWithUsage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			update, _ := cmd.Flags().GetBool("update")
+			return Test(TestParams{Dir: args[0], Update: update})
+		},
+	}
+	cmd.Flags().Bool("update", false, "Rewrite the golden files with the rendered output")
+	return cmd
+}
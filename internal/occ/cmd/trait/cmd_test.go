@@ -5,6 +5,7 @@ package trait
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -43,7 +44,7 @@ func TestNewTraitCmd_Subcommands(t *testing.T) {
 	for _, sub := range cmd.Commands() {
 		names = append(names, sub.Name())
 	}
-	assert.ElementsMatch(t, []string{"list", "get", "delete"}, names)
+	assert.ElementsMatch(t, []string{"list", "get", "delete", "init", "test"}, names)
 }
 
 // --- list ---
@@ -130,3 +131,36 @@ func TestDeleteCmd_Success(t *testing.T) {
 	})
 	assert.Contains(t, out, "deleted")
 }
+
+// --- init / test ---
+
+func TestInitCmd_MissingArg(t *testing.T) {
+	cmd := newInitCmd()
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "TRAIT_NAME")
+}
+
+func TestInitAndTestCmd(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "persistent-volume")
+
+	initCmd := newInitCmd()
+	require.NoError(t, initCmd.Flags().Set("dir", dir))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, initCmd.RunE(initCmd, []string{"persistent-volume"}))
+	})
+	assert.Contains(t, out, "Created Trait persistent-volume in "+dir)
+	assert.Contains(t, out, filepath.Join(dir, "samples", "componenttype.yaml"))
+
+	testCmd := newTestCmd()
+	out = testutil.CaptureStdout(t, func() {
+		require.NoError(t, testCmd.RunE(testCmd, []string{dir}))
+	})
+	assert.Contains(t, out, "PASS    default")
+}
+
+func TestTestCmd_NoTests(t *testing.T) {
+	cmd := newTestCmd()
+	err := cmd.RunE(cmd, []string{t.TempDir()})
+	assert.ErrorContains(t, err, "no render tests found")
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trait
+
+import (
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/scaffold/definition"
+)
+
+// Init scaffolds a new trait with a sample ComponentType and Component and a render test
+func Init(params InitParams) error {
+	files, err := definition.Trait(definition.Options{
+		Name:      params.TraitName,
+		Namespace: params.Namespace,
+	})
+	if err != nil {
+		return err
+	}
+	dir := params.Dir
+	if dir == "" {
+		dir = params.TraitName
+	}
+	return utils.WriteScaffold("Trait "+params.TraitName, "trait", dir, files, params.Force)
+}
+
+// Test runs the render tests of the trait in params.Dir
+func Test(params TestParams) error {
+	return utils.RunRenderTests(params.Dir, params.Update)
+}
@@ -26,3 +26,18 @@ type DeleteParams struct {
 
 func (p DeleteParams) GetNamespace() string { return p.Namespace }
 func (p DeleteParams) GetTraitName() string { return p.TraitName }
+
+// InitParams defines parameters for scaffolding a new trait
+type InitParams struct {
+	TraitName string
+	Namespace string
+	// Dir is the directory the scaffold is written to. Defaults to the trait name.
+	Dir   string
+	Force bool
+}
+
+// TestParams defines parameters for running the render tests of a trait
+type TestParams struct {
+	Dir    string
+	Update bool
+}
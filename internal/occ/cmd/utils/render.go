@@ -0,0 +1,71 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/harness"
+	"github.com/openchoreo/openchoreo/internal/scaffold/definition"
+)
+
+// WriteScaffold writes a generated ComponentType or Trait to dir, records the golden
+// files of its render tests and prints the created files.
+func WriteScaffold(kind, command, dir string, files []definition.File, force bool) error {
+	if err := definition.WriteFiles(dir, files, force); err != nil {
+		return err
+	}
+	results, err := harness.RunDir(dir, true)
+	if err != nil {
+		return err
+	}
+	for _, r := range results {
+		if !r.Passed() {
+			return fmt.Errorf("generated render test %s failed: %s", r.Name, strings.Join(r.Failures, "; "))
+		}
+	}
+
+	fmt.Printf("Created %s in %s:\n", kind, dir)
+	for _, f := range files {
+		fmt.Printf("  %s\n", filepath.Join(dir, f.Path))
+	}
+	for _, r := range results {
+		if r.Updated {
+			fmt.Printf("  %s\n", r.GoldenPath)
+		}
+	}
+	fmt.Printf("\nEdit the definition and the samples, then render them with:\n  occ %s test %s\n", command, dir)
+	return nil
+}
+
+// RunRenderTests runs the render tests of the ComponentType or Trait in dir and prints
+// a line per test. It returns an error if any test failed.
+func RunRenderTests(dir string, update bool) error {
+	results, err := harness.RunDir(dir, update)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, r := range results {
+		switch {
+		case !r.Passed():
+			failed++
+			fmt.Printf("FAIL    %s (%s)\n", r.Name, r.Path)
+			for _, f := range r.Failures {
+				fmt.Printf("        %s\n", strings.ReplaceAll(f, "\n", "\n        "))
+			}
+		case r.Updated:
+			fmt.Printf("UPDATED %s\n", r.Name)
+		default:
+			fmt.Printf("PASS    %s\n", r.Name)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d render tests failed", failed, len(results))
+	}
+	return nil
+}
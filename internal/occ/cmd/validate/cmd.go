@@ -0,0 +1,70 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/schemacache"
+)
+
+func NewValidateCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate component manifests against component type and trait schemas",
+		Long: `Validate the parameters of the Components in local manifests, and of their traits,
+against the schemas of the referenced component types and traits.
+
+Component types and traits defined in the manifests are used as-is. Other schemas are
+fetched from the control plane, or with --offline read from the cache populated by
+occ schema pull, so that CI can lint manifests without access to the control plane.`,
+		Example: `  # Validate a directory of manifests against the control plane
+  occ validate -f manifests/
+
+  # Validate offline against schemas committed to the repository
+  occ validate -f manifests/ --offline --schema-dir .openchoreo/schemas`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if offline, _ := cmd.Flags().GetBool("offline"); offline {
+				return nil
+			}
+			return auth.RequireLogin()(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filePath, _ := cmd.Flags().GetString("file")
+			offline, _ := cmd.Flags().GetBool("offline")
+			namespace := flags.GetNamespace(cmd)
+			if namespace == "" {
+				if ctx, err := config.GetCurrentContext(); err == nil {
+					namespace = ctx.Namespace
+				}
+			}
+
+			var source SchemaSource
+			if offline {
+				dir, _ := cmd.Flags().GetString("schema-dir")
+				cache, err := schemacache.New(dir)
+				if err != nil {
+					return err
+				}
+				source = CacheSource{Cache: cache}
+			} else {
+				cl, err := f()
+				if err != nil {
+					return err
+				}
+				source = APISource{Client: cl}
+			}
+			return New(source).Validate(Params{FilePath: filePath, Namespace: namespace})
+		},
+	}
+	cmd.Flags().StringP("file", "f", "", "Path to the manifest file or directory to validate")
+	cmd.Flags().Bool("offline", false, "Read schemas from the local cache instead of the control plane")
+	cmd.Flags().String("schema-dir", "", "Schema cache directory used with --offline (default ~/.openchoreo/schemas)")
+	flags.AddNamespace(cmd)
+	return cmd
+}
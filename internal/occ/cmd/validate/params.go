@@ -0,0 +1,10 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+// Params defines parameters for validating manifests
+type Params struct {
+	FilePath  string // file or directory of YAML manifests
+	Namespace string // namespace of resources that do not set metadata.namespace
+}
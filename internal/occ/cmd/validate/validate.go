@@ -0,0 +1,381 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/schemacache"
+	"github.com/openchoreo/openchoreo/internal/schema"
+)
+
+// SchemaSource provides the parameter schemas of component types and traits.
+type SchemaSource interface {
+	Schema(ctx context.Context, kind, namespace, name string) (map[string]any, error)
+}
+
+// CacheSource reads schemas from the local schema cache.
+type CacheSource struct {
+	Cache *schemacache.Cache
+}
+
+// Schema implements SchemaSource.
+func (s CacheSource) Schema(_ context.Context, kind, namespace, name string) (map[string]any, error) {
+	raw, err := s.Cache.Load(kind, namespace, name)
+	if errors.Is(err, schemacache.ErrNotCached) {
+		return nil, fmt.Errorf("%w; run occ schema pull to update %s", err, s.Cache.Dir)
+	}
+	return raw, err
+}
+
+// APISource fetches schemas from the control plane.
+type APISource struct {
+	Client client.Interface
+}
+
+// Schema implements SchemaSource.
+func (s APISource) Schema(ctx context.Context, kind, namespace, name string) (map[string]any, error) {
+	var raw *json.RawMessage
+	var err error
+	switch kind {
+	case schemacache.KindComponentType:
+		raw, err = s.Client.GetComponentTypeSchema(ctx, namespace, name)
+	case schemacache.KindClusterComponentType:
+		raw, err = s.Client.GetClusterComponentTypeSchema(ctx, name)
+	case schemacache.KindTrait:
+		raw, err = s.Client.GetTraitSchema(ctx, namespace, name)
+	case schemacache.KindClusterTrait:
+		raw, err = s.Client.GetClusterTraitSchema(ctx, name)
+	default:
+		return nil, fmt.Errorf("unsupported kind %q", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if raw != nil {
+		if err := json.Unmarshal(*raw, &out); err != nil {
+			return nil, fmt.Errorf("failed to parse schema of %s %s: %w", kind, name, err)
+		}
+	}
+	return out, nil
+}
+
+// Validator validates the parameters of components and their traits in local manifests.
+type Validator struct {
+	source SchemaSource
+}
+
+// New creates a validator that resolves schemas from the given source.
+func New(source SchemaSource) *Validator {
+	return &Validator{source: source}
+}
+
+// document is a resource read from a manifest file.
+type document struct {
+	file   string
+	object map[string]any
+}
+
+// resourceKey identifies a component type or trait.
+type resourceKey struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// compiled is a schema prepared for defaulting and validation.
+type compiled struct {
+	structural *apiextschema.Structural
+	jsonSchema *extv1.JSONSchemaProps
+	err        error
+}
+
+// Validate checks the parameters of every Component in the manifests, and the parameters of
+// its traits, against the schemas of the referenced component types and traits. Component
+// types and traits defined in the manifests themselves take precedence over the schema source.
+func (v *Validator) Validate(params Params) error {
+	if params.FilePath == "" {
+		return fmt.Errorf("file path is required")
+	}
+	files, err := discoverFiles(params.FilePath)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no YAML files found in: %s", params.FilePath)
+	}
+
+	var docs []document
+	var errs []string
+	for _, file := range files {
+		objects, err := readDocuments(file)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", file, err))
+			continue
+		}
+		for _, obj := range objects {
+			docs = append(docs, document{file: file, object: obj})
+		}
+	}
+
+	run := &validation{
+		ctx:              context.Background(),
+		source:           v.source,
+		defaultNamespace: params.Namespace,
+		local:            map[resourceKey]map[string]any{},
+		schemas:          map[resourceKey]*compiled{},
+	}
+	for _, doc := range docs {
+		if err := run.addLocalDefinition(doc.object); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", doc.file, err))
+		}
+	}
+
+	components := 0
+	for _, doc := range docs {
+		if kind, _ := doc.object["kind"].(string); kind != "Component" {
+			continue
+		}
+		components++
+		for _, err := range run.validateComponent(doc.object) {
+			errs = append(errs, fmt.Sprintf("%s: %v", doc.file, err))
+		}
+	}
+
+	for _, e := range errs {
+		fmt.Printf("Error: %s\n", e)
+	}
+	if len(errs) > 0 {
+		fmt.Printf("\nValidated %d component(s) from %d file(s) with %d error(s)\n", components, len(files), len(errs))
+		return fmt.Errorf("validation failed with %d error(s)", len(errs))
+	}
+	fmt.Printf("Validated %d component(s) from %d file(s)\n", components, len(files))
+	return nil
+}
+
+// validation holds the state of a single Validate call.
+type validation struct {
+	ctx              context.Context
+	source           SchemaSource
+	defaultNamespace string
+	local            map[resourceKey]map[string]any
+	schemas          map[resourceKey]*compiled
+}
+
+// addLocalDefinition records the parameter schema of a component type or trait defined in the
+// manifests.
+func (r *validation) addLocalDefinition(obj map[string]any) error {
+	kind, _ := obj["kind"].(string)
+	switch kind {
+	case schemacache.KindComponentType, schemacache.KindClusterComponentType, schemacache.KindTrait, schemacache.KindClusterTrait:
+	default:
+		return nil
+	}
+	name, namespace := nameAndNamespace(obj)
+	if schemacache.IsClusterScoped(kind) {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = r.defaultNamespace
+	}
+
+	spec, _ := obj["spec"].(map[string]any)
+	var section *openchoreov1alpha1.SchemaSection
+	if parameters, ok := spec["parameters"]; ok && parameters != nil {
+		data, err := json.Marshal(parameters)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", kind, name, err)
+		}
+		section = &openchoreov1alpha1.SchemaSection{}
+		if err := json.Unmarshal(data, section); err != nil {
+			return fmt.Errorf("%s %s: invalid parameters schema: %w", kind, name, err)
+		}
+	}
+	raw, err := schema.SectionToRawJSONSchema(section)
+	if err != nil {
+		return fmt.Errorf("%s %s: invalid parameters schema: %w", kind, name, err)
+	}
+	r.local[resourceKey{kind: kind, namespace: namespace, name: name}] = raw
+	return nil
+}
+
+// validateComponent validates the parameters of a component and of its traits.
+func (r *validation) validateComponent(obj map[string]any) []error {
+	name, namespace := nameAndNamespace(obj)
+	if namespace == "" {
+		namespace = r.defaultNamespace
+	}
+	prefix := "Component " + name
+	spec, _ := obj["spec"].(map[string]any)
+
+	var errs []error
+	componentType, _ := spec["componentType"].(map[string]any)
+	ctKind, _ := componentType["kind"].(string)
+	if ctKind == "" {
+		ctKind = schemacache.KindComponentType
+	}
+	ctRef, _ := componentType["name"].(string)
+	_, ctName, ok := strings.Cut(ctRef, "/")
+	if !ok || ctName == "" {
+		errs = append(errs, fmt.Errorf("%s: invalid componentType %q: expected {workloadType}/{name}", prefix, ctRef))
+	} else if err := r.validateParameters(ctKind, namespace, ctName, spec["parameters"]); err != nil {
+		errs = append(errs, fmt.Errorf("%s: parameters: %w", prefix, err))
+	}
+
+	traits, _ := spec["traits"].([]any)
+	for i, t := range traits {
+		trait, _ := t.(map[string]any)
+		traitKind, _ := trait["kind"].(string)
+		if traitKind == "" {
+			traitKind = schemacache.KindTrait
+		}
+		traitName, _ := trait["name"].(string)
+		instanceName, _ := trait["instanceName"].(string)
+		if instanceName == "" {
+			instanceName = fmt.Sprintf("traits[%d]", i)
+		}
+		if traitName == "" {
+			errs = append(errs, fmt.Errorf("%s: trait %s: missing name", prefix, instanceName))
+			continue
+		}
+		if err := r.validateParameters(traitKind, namespace, traitName, trait["parameters"]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: trait %s: parameters: %w", prefix, instanceName, err))
+		}
+	}
+	return errs
+}
+
+// validateParameters applies the schema defaults to the parameter values and validates them.
+func (r *validation) validateParameters(kind, namespace, name string, values any) error {
+	if schemacache.IsClusterScoped(kind) {
+		namespace = ""
+	} else if namespace == "" {
+		return fmt.Errorf("namespace of %s %s is unknown; set metadata.namespace or --namespace", kind, name)
+	}
+	c := r.schema(resourceKey{kind: kind, namespace: namespace, name: name})
+	if c.err != nil {
+		return c.err
+	}
+	if c.jsonSchema == nil {
+		return nil
+	}
+
+	params := map[string]any{}
+	if values != nil {
+		m, ok := values.(map[string]any)
+		if !ok {
+			return fmt.Errorf("must be an object")
+		}
+		params = m
+	}
+	params = schema.ApplyDefaults(params, c.structural)
+	return schema.ValidateWithJSONSchema(params, c.jsonSchema)
+}
+
+func (r *validation) schema(key resourceKey) *compiled {
+	if c, ok := r.schemas[key]; ok {
+		return c
+	}
+	c := &compiled{}
+	raw, ok := r.local[key]
+	if !ok {
+		raw, c.err = r.source.Schema(r.ctx, key.kind, key.namespace, key.name)
+	}
+	if c.err == nil && raw != nil {
+		c.structural, c.jsonSchema, c.err = schema.OpenAPIV3ToStructuralAndJSONSchema(raw)
+		if c.err != nil {
+			c.err = fmt.Errorf("invalid schema of %s %s: %w", key.kind, key.name, c.err)
+		}
+	}
+	r.schemas[key] = c
+	return c
+}
+
+func nameAndNamespace(obj map[string]any) (string, string) {
+	metadata, _ := obj["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	return name, namespace
+}
+
+// discoverFiles returns the path itself when it is a file, or the YAML files under it.
+func discoverFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("path %s does not exist", path)
+		}
+		return nil, fmt.Errorf("error accessing path %s: %w", path, err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(p))
+		if ext == ".yaml" || ext == ".yml" {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking directory %s: %w", path, err)
+	}
+	return files, nil
+}
+
+// readDocuments parses the resources of a multi-document YAML file. Values are normalized
+// through JSON so that they have the types the control plane validates.
+func readDocuments(file string) ([]map[string]any, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	var objects []map[string]any
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc map[string]any
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse YAML document: %w", err)
+		}
+		if doc == nil || doc["kind"] == nil {
+			continue
+		}
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert YAML document: %w", err)
+		}
+		var obj map[string]any
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil, fmt.Errorf("failed to convert YAML document: %w", err)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
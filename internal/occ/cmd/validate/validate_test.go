@@ -0,0 +1,122 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/schemacache"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+const serviceSchema = `{
+  "type": "object",
+  "required": ["port"],
+  "properties": {
+    "port": {"type": "integer"},
+    "replicas": {"type": "integer", "default": 1, "minimum": 1}
+  }
+}`
+
+const ingressTrait = `apiVersion: openchoreo.dev/v1alpha1
+kind: Trait
+metadata:
+  name: ingress
+  namespace: acme
+spec:
+  parameters:
+    openAPIV3Schema:
+      type: object
+      required: [host]
+      properties:
+        host:
+          type: string
+`
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func offlineValidator(t *testing.T) *Validator {
+	t.Helper()
+	cache := &schemacache.Cache{Dir: t.TempDir()}
+	require.NoError(t, cache.Save(schemacache.KindClusterComponentType, "", "service", json.RawMessage(serviceSchema)))
+	return New(CacheSource{Cache: cache})
+}
+
+func component(parameters, traits string) string {
+	return `apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: reading-list
+spec:
+  owner:
+    projectName: default
+  componentType:
+    kind: ClusterComponentType
+    name: deployment/service
+  parameters:
+` + parameters + traits
+}
+
+func TestValidate_Offline(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "trait.yaml", ingressTrait)
+	writeFile(t, dir, "component.yaml", component("    port: 8080\n", `  traits:
+    - name: ingress
+      instanceName: public
+      parameters:
+        host: reading-list.example.com
+`))
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, offlineValidator(t).Validate(Params{FilePath: dir, Namespace: "acme"}))
+	})
+	assert.Contains(t, out, "Validated 1 component(s) from 2 file(s)")
+}
+
+func TestValidate_ReportsInvalidParameters(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "trait.yaml", ingressTrait)
+	path := writeFile(t, dir, "component.yaml", component("    replicas: 0\n", `  traits:
+    - name: ingress
+      instanceName: public
+      parameters: {}
+`))
+
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = offlineValidator(t).Validate(Params{FilePath: dir, Namespace: "acme"})
+	})
+	assert.EqualError(t, err, "validation failed with 2 error(s)")
+	assert.Contains(t, out, path+": Component reading-list: parameters:")
+	assert.Contains(t, out, ".port is required")
+	assert.Contains(t, out, "replicas should be greater than or equal to 1")
+	assert.Contains(t, out, "Component reading-list: trait public: parameters: .host is required")
+}
+
+func TestValidate_MissingCachedSchema(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "component.yaml", component("    port: 8080\n", `  traits:
+    - kind: ClusterTrait
+      name: autoscaler
+      instanceName: hpa
+`))
+
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		err = offlineValidator(t).Validate(Params{FilePath: dir})
+	})
+	require.Error(t, err)
+	assert.Contains(t, out, "trait hpa: parameters: schema not cached: ClusterTrait autoscaler; run occ schema pull")
+}
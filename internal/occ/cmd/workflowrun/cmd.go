@@ -22,6 +22,7 @@ func NewWorkflowRunCmd(f client.NewClientFunc) *cobra.Command {
 	cmd.AddCommand(
 		newListCmd(f),
 		newGetCmd(f),
+		newCancelCmd(f),
 		newLogsCmd(f),
 	)
 	return cmd
@@ -77,6 +78,38 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	return cmd
 }
 
+func newCancelCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [WORKFLOW_RUN_NAME]",
+		Short: "Cancel a workflow run",
+		Long: `Cancel a workflow run.
+A queued run is withdrawn from the build queue and a running run is aborted
+on the workflow plane.`,
+		Example: `  # Cancel a workflow run
+  occ workflowrun cancel my-run --namespace acme-corp
+
+  # Cancel a workflow run and record why
+  occ workflowrun cancel my-run --namespace acme-corp --reason "built the wrong branch"`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			reason, _ := cmd.Flags().GetString("reason")
+			return New(cl).Cancel(CancelParams{
+				Namespace:       flags.GetNamespace(cmd),
+				WorkflowRunName: args[0],
+				Reason:          reason,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	cmd.Flags().String("reason", "", "Why the workflow run is cancelled")
+	return cmd
+}
+
 func newLogsCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "logs [WORKFLOW_RUN_NAME]",
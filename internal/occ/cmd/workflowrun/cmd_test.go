@@ -44,7 +44,7 @@ func TestNewWorkflowRunCmd_Subcommands(t *testing.T) {
 	for _, sub := range cmd.Commands() {
 		names = append(names, sub.Name())
 	}
-	assert.ElementsMatch(t, []string{"list", "get", "logs"}, names)
+	assert.ElementsMatch(t, []string{"list", "get", "cancel", "logs"}, names)
 }
 
 // --- list ---
@@ -105,6 +105,36 @@ func TestGetCmd_Success(t *testing.T) {
 	assert.Contains(t, out, "run-abc")
 }
 
+// --- cancel ---
+
+func TestCancelCmd_MissingArg(t *testing.T) {
+	cmd := newCancelCmd(errFactory("unused"))
+	err := cmd.Args(cmd, []string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "required argument")
+}
+
+func TestCancelCmd_FactoryError(t *testing.T) {
+	cmd := newCancelCmd(errFactory("factory failed"))
+	err := cmd.RunE(cmd, []string{"run-abc"})
+	assert.EqualError(t, err, "factory failed")
+}
+
+func TestCancelCmd_Success(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().CancelWorkflowRun(mock.Anything, "acme-corp", "run-abc", "wrong branch").Return(
+		&gen.WorkflowRun{Metadata: gen.ObjectMeta{Name: "run-abc"}}, nil,
+	)
+
+	cmd := newCancelCmd(mockFactory(mc))
+	require.NoError(t, cmd.Flags().Set("namespace", "acme-corp"))
+	require.NoError(t, cmd.Flags().Set("reason", "wrong branch"))
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cmd.RunE(cmd, []string{"run-abc"}))
+	})
+	assert.Contains(t, out, "WorkflowRun 'run-abc' cancellation requested")
+}
+
 // --- logs ---
 
 func TestLogsCmd_MissingArg(t *testing.T) {
@@ -19,6 +19,15 @@ type GetParams struct {
 
 func (p GetParams) GetNamespace() string { return p.Namespace }
 
+// CancelParams defines parameters for cancelling a workflow run
+type CancelParams struct {
+	Namespace       string
+	WorkflowRunName string
+	Reason          string
+}
+
+func (p CancelParams) GetNamespace() string { return p.Namespace }
+
 // LogsParams defines parameters for getting workflow run logs
 type LogsParams struct {
 	Namespace       string
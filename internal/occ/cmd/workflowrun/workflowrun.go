@@ -127,6 +127,22 @@ func (w *WorkflowRun) Get(params GetParams) error {
 	return nil
 }
 
+// Cancel requests a workflow run to be cancelled
+func (w *WorkflowRun) Cancel(params CancelParams) error {
+	if err := cmdutil.RequireFields("cancel", "workflowrun", map[string]string{"namespace": params.Namespace}); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if _, err := w.client.CancelWorkflowRun(ctx, params.Namespace, params.WorkflowRunName, params.Reason); err != nil {
+		return err
+	}
+
+	fmt.Printf("WorkflowRun '%s' cancellation requested\n", params.WorkflowRunName)
+	return nil
+}
+
 func PrintList(items []gen.WorkflowRun) error {
 	if len(items) == 0 {
 		fmt.Println("No workflow runs found")
@@ -178,6 +194,9 @@ func deriveStatus(conditions []gen.Condition) string {
 		return "Running"
 	}
 	if c, ok := conds["WorkflowCompleted"]; ok {
+		if c.Reason == "WorkflowCancelled" {
+			return "Cancelled"
+		}
 		return c.Reason
 	}
 	return "Pending"
@@ -129,6 +129,10 @@ func TestDeriveStatus(t *testing.T) {
 		{name: "failed", conditions: []gen.Condition{cond("WorkflowFailed", "True", "Error")}, want: "Failed"},
 		{name: "running", conditions: []gen.Condition{cond("WorkflowRunning", "True", "InProgress")}, want: "Running"},
 		{name: "completed with reason", conditions: []gen.Condition{cond("WorkflowCompleted", "True", "Finished")}, want: "Finished"},
+		{name: "cancelled", conditions: []gen.Condition{
+			cond("WorkflowRunning", "False", "WorkflowCancelled"),
+			cond("WorkflowCompleted", "True", "WorkflowCancelled"),
+		}, want: "Cancelled"},
 		{name: "empty returns pending", conditions: []gen.Condition{}, want: "Pending"},
 		{name: "succeeded takes priority over running", conditions: []gen.Condition{
 			cond("WorkflowRunning", "True", "InProgress"),
@@ -230,6 +234,21 @@ func TestGet_Success(t *testing.T) {
 	assert.Contains(t, out, "name: run-1")
 }
 
+// --- Cancel tests ---
+
+func TestCancel_ValidationError(t *testing.T) {
+	wr := New(mocks.NewMockInterface(t))
+	assert.Error(t, wr.Cancel(CancelParams{WorkflowRunName: "run-1"}))
+}
+
+func TestCancel_APIError(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().CancelWorkflowRun(mock.Anything, "ns", "run-1", "").Return(nil, fmt.Errorf("workflow run has already completed"))
+
+	wr := New(mc)
+	assert.EqualError(t, wr.Cancel(CancelParams{Namespace: "ns", WorkflowRunName: "run-1"}), "workflow run has already completed")
+}
+
 // --- FetchAll tests ---
 
 func TestFetchAll_APIError(t *testing.T) {
@@ -24,6 +24,7 @@ type Interface interface {
 
 	ListComponents(ctx context.Context, namespaceName, projectName string, params *gen.ListComponentsParams) (*gen.ComponentList, error)
 	GetComponent(ctx context.Context, namespaceName, componentName string) (*gen.Component, error)
+	GetComponentObserverURL(ctx context.Context, namespaceName, componentName, envName string) (string, error)
 	DeleteComponent(ctx context.Context, namespaceName, componentName string) error
 
 	ListEnvironments(ctx context.Context, namespaceName string, params *gen.ListEnvironmentsParams) (*gen.EnvironmentList, error)
@@ -90,6 +91,7 @@ type Interface interface {
 
 	ListWorkflowRuns(ctx context.Context, namespaceName string, params *gen.ListWorkflowRunsParams) (*gen.WorkflowRunList, error)
 	GetWorkflowRun(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRun, error)
+	CancelWorkflowRun(ctx context.Context, namespaceName, runName, reason string) (*gen.WorkflowRun, error)
 	CreateWorkflowRun(ctx context.Context, namespace string, body gen.CreateWorkflowRunJSONRequestBody) (*gen.WorkflowRun, error)
 	GetWorkflowRunStatus(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRunStatusResponse, error)
 	GetWorkflowRunLogs(ctx context.Context, namespaceName, runName string, params *gen.GetWorkflowRunLogsParams) ([]gen.WorkflowRunLogEntry, error)
@@ -105,6 +107,8 @@ type Interface interface {
 	CreateReleaseBinding(ctx context.Context, namespaceName string, req gen.ReleaseBinding) (*gen.ReleaseBinding, error)
 	UpdateReleaseBinding(ctx context.Context, namespaceName, bindingName string, req gen.ReleaseBinding) (*gen.ReleaseBinding, error)
 	DeleteReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) error
+	EnableReleaseBindingMaintenance(ctx context.Context, namespaceName, releaseBindingName string, req gen.ReleaseBindingMaintenanceRequest) (*gen.ReleaseBinding, error)
+	DisableReleaseBindingMaintenance(ctx context.Context, namespaceName, releaseBindingName string) (*gen.ReleaseBinding, error)
 
 	ListResourceTypes(ctx context.Context, namespaceName string, params *gen.ListResourceTypesParams) (*gen.ResourceTypeList, error)
 	GetResourceType(ctx context.Context, namespaceName, rtName string) (*gen.ResourceType, error)
@@ -199,6 +203,11 @@ type Interface interface {
 	ListNamespaceRoleBindings(ctx context.Context, namespaceName string, params *gen.ListNamespaceRoleBindingsParams) (*gen.AuthzRoleBindingList, error)
 	GetNamespaceRoleBinding(ctx context.Context, namespaceName, name string) (*gen.AuthzRoleBinding, error)
 	DeleteNamespaceRoleBinding(ctx context.Context, namespaceName, name string) error
+
+	Search(ctx context.Context, params *gen.SearchParams) (*gen.SearchResults, error)
+
+	ListQuarantinedResources(ctx context.Context, namespaceName string) (*gen.QuarantinedResourceList, error)
+	RequeueQuarantinedResource(ctx context.Context, namespaceName, kind, name string) (*gen.QuarantinedResource, error)
 }
 
 // compile-time check that *Client satisfies Interface.
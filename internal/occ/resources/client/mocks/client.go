@@ -4,7 +4,7 @@ package mocks
 
 import (
 	context "context"
-	json "encoding/json"
+	jsontext "encoding/json/jsontext"
 
 	gen "github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 
@@ -24,6 +24,67 @@ func (_m *MockInterface) EXPECT() *MockInterface_Expecter {
 	return &MockInterface_Expecter{mock: &_m.Mock}
 }
 
+// CancelWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName, reason
+func (_m *MockInterface) CancelWorkflowRun(ctx context.Context, namespaceName string, runName string, reason string) (*gen.WorkflowRun, error) {
+	ret := _m.Called(ctx, namespaceName, runName, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelWorkflowRun")
+	}
+
+	var r0 *gen.WorkflowRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*gen.WorkflowRun, error)); ok {
+		return rf(ctx, namespaceName, runName, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *gen.WorkflowRun); ok {
+		r0 = rf(ctx, namespaceName, runName, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.WorkflowRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_CancelWorkflowRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelWorkflowRun'
+type MockInterface_CancelWorkflowRun_Call struct {
+	*mock.Call
+}
+
+// CancelWorkflowRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+//   - reason string
+func (_e *MockInterface_Expecter) CancelWorkflowRun(ctx interface{}, namespaceName interface{}, runName interface{}, reason interface{}) *MockInterface_CancelWorkflowRun_Call {
+	return &MockInterface_CancelWorkflowRun_Call{Call: _e.mock.On("CancelWorkflowRun", ctx, namespaceName, runName, reason)}
+}
+
+func (_c *MockInterface_CancelWorkflowRun_Call) Run(run func(ctx context.Context, namespaceName string, runName string, reason string)) *MockInterface_CancelWorkflowRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_CancelWorkflowRun_Call) Return(_a0 *gen.WorkflowRun, _a1 error) *MockInterface_CancelWorkflowRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_CancelWorkflowRun_Call) RunAndReturn(run func(context.Context, string, string, string) (*gen.WorkflowRun, error)) *MockInterface_CancelWorkflowRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateClusterProjectType provides a mock function with given fields: ctx, cpt
 func (_m *MockInterface) CreateClusterProjectType(ctx context.Context, cpt gen.ClusterProjectType) (*gen.ClusterProjectType, error) {
 	ret := _m.Called(ctx, cpt)
@@ -2639,6 +2700,127 @@ func (_c *MockInterface_DeleteWorkload_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DisableReleaseBindingMaintenance provides a mock function with given fields: ctx, namespaceName, releaseBindingName
+func (_m *MockInterface) DisableReleaseBindingMaintenance(ctx context.Context, namespaceName string, releaseBindingName string) (*gen.ReleaseBinding, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableReleaseBindingMaintenance")
+	}
+
+	var r0 *gen.ReleaseBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*gen.ReleaseBinding, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *gen.ReleaseBinding); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ReleaseBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_DisableReleaseBindingMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableReleaseBindingMaintenance'
+type MockInterface_DisableReleaseBindingMaintenance_Call struct {
+	*mock.Call
+}
+
+// DisableReleaseBindingMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+func (_e *MockInterface_Expecter) DisableReleaseBindingMaintenance(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}) *MockInterface_DisableReleaseBindingMaintenance_Call {
+	return &MockInterface_DisableReleaseBindingMaintenance_Call{Call: _e.mock.On("DisableReleaseBindingMaintenance", ctx, namespaceName, releaseBindingName)}
+}
+
+func (_c *MockInterface_DisableReleaseBindingMaintenance_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string)) *MockInterface_DisableReleaseBindingMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_DisableReleaseBindingMaintenance_Call) Return(_a0 *gen.ReleaseBinding, _a1 error) *MockInterface_DisableReleaseBindingMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_DisableReleaseBindingMaintenance_Call) RunAndReturn(run func(context.Context, string, string) (*gen.ReleaseBinding, error)) *MockInterface_DisableReleaseBindingMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableReleaseBindingMaintenance provides a mock function with given fields: ctx, namespaceName, releaseBindingName, req
+func (_m *MockInterface) EnableReleaseBindingMaintenance(ctx context.Context, namespaceName string, releaseBindingName string, req gen.ReleaseBindingMaintenanceRequest) (*gen.ReleaseBinding, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableReleaseBindingMaintenance")
+	}
+
+	var r0 *gen.ReleaseBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, gen.ReleaseBindingMaintenanceRequest) (*gen.ReleaseBinding, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, gen.ReleaseBindingMaintenanceRequest) *gen.ReleaseBinding); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ReleaseBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, gen.ReleaseBindingMaintenanceRequest) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_EnableReleaseBindingMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableReleaseBindingMaintenance'
+type MockInterface_EnableReleaseBindingMaintenance_Call struct {
+	*mock.Call
+}
+
+// EnableReleaseBindingMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+//   - req gen.ReleaseBindingMaintenanceRequest
+func (_e *MockInterface_Expecter) EnableReleaseBindingMaintenance(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}, req interface{}) *MockInterface_EnableReleaseBindingMaintenance_Call {
+	return &MockInterface_EnableReleaseBindingMaintenance_Call{Call: _e.mock.On("EnableReleaseBindingMaintenance", ctx, namespaceName, releaseBindingName, req)}
+}
+
+func (_c *MockInterface_EnableReleaseBindingMaintenance_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string, req gen.ReleaseBindingMaintenanceRequest)) *MockInterface_EnableReleaseBindingMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(gen.ReleaseBindingMaintenanceRequest))
+	})
+	return _c
+}
+
+func (_c *MockInterface_EnableReleaseBindingMaintenance_Call) Return(_a0 *gen.ReleaseBinding, _a1 error) *MockInterface_EnableReleaseBindingMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_EnableReleaseBindingMaintenance_Call) RunAndReturn(run func(context.Context, string, string, gen.ReleaseBindingMaintenanceRequest) (*gen.ReleaseBinding, error)) *MockInterface_EnableReleaseBindingMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateRelease provides a mock function with given fields: ctx, namespaceName, componentName, req
 func (_m *MockInterface) GenerateRelease(ctx context.Context, namespaceName string, componentName string, req gen.GenerateReleaseRequest) (*gen.ComponentRelease, error) {
 	ret := _m.Called(ctx, namespaceName, componentName, req)
@@ -2760,23 +2942,23 @@ func (_c *MockInterface_GetClusterComponentType_Call) RunAndReturn(run func(cont
 }
 
 // GetClusterComponentTypeSchema provides a mock function with given fields: ctx, cctName
-func (_m *MockInterface) GetClusterComponentTypeSchema(ctx context.Context, cctName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetClusterComponentTypeSchema(ctx context.Context, cctName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, cctName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetClusterComponentTypeSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, cctName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *jsontext.Value); ok {
 		r0 = rf(ctx, cctName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -2808,12 +2990,12 @@ func (_c *MockInterface_GetClusterComponentTypeSchema_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockInterface_GetClusterComponentTypeSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetClusterComponentTypeSchema_Call {
+func (_c *MockInterface_GetClusterComponentTypeSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetClusterComponentTypeSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetClusterComponentTypeSchema_Call) RunAndReturn(run func(context.Context, string) (*json.RawMessage, error)) *MockInterface_GetClusterComponentTypeSchema_Call {
+func (_c *MockInterface_GetClusterComponentTypeSchema_Call) RunAndReturn(run func(context.Context, string) (*jsontext.Value, error)) *MockInterface_GetClusterComponentTypeSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2996,23 +3178,23 @@ func (_c *MockInterface_GetClusterProjectType_Call) RunAndReturn(run func(contex
 }
 
 // GetClusterProjectTypeSchema provides a mock function with given fields: ctx, cptName
-func (_m *MockInterface) GetClusterProjectTypeSchema(ctx context.Context, cptName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetClusterProjectTypeSchema(ctx context.Context, cptName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, cptName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetClusterProjectTypeSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, cptName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *jsontext.Value); ok {
 		r0 = rf(ctx, cptName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -3044,12 +3226,12 @@ func (_c *MockInterface_GetClusterProjectTypeSchema_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockInterface_GetClusterProjectTypeSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetClusterProjectTypeSchema_Call {
+func (_c *MockInterface_GetClusterProjectTypeSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetClusterProjectTypeSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetClusterProjectTypeSchema_Call) RunAndReturn(run func(context.Context, string) (*json.RawMessage, error)) *MockInterface_GetClusterProjectTypeSchema_Call {
+func (_c *MockInterface_GetClusterProjectTypeSchema_Call) RunAndReturn(run func(context.Context, string) (*jsontext.Value, error)) *MockInterface_GetClusterProjectTypeSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3114,23 +3296,23 @@ func (_c *MockInterface_GetClusterResourceType_Call) RunAndReturn(run func(conte
 }
 
 // GetClusterResourceTypeSchema provides a mock function with given fields: ctx, crtName
-func (_m *MockInterface) GetClusterResourceTypeSchema(ctx context.Context, crtName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetClusterResourceTypeSchema(ctx context.Context, crtName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, crtName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetClusterResourceTypeSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, crtName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *jsontext.Value); ok {
 		r0 = rf(ctx, crtName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -3162,12 +3344,12 @@ func (_c *MockInterface_GetClusterResourceTypeSchema_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockInterface_GetClusterResourceTypeSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetClusterResourceTypeSchema_Call {
+func (_c *MockInterface_GetClusterResourceTypeSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetClusterResourceTypeSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetClusterResourceTypeSchema_Call) RunAndReturn(run func(context.Context, string) (*json.RawMessage, error)) *MockInterface_GetClusterResourceTypeSchema_Call {
+func (_c *MockInterface_GetClusterResourceTypeSchema_Call) RunAndReturn(run func(context.Context, string) (*jsontext.Value, error)) *MockInterface_GetClusterResourceTypeSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3350,23 +3532,23 @@ func (_c *MockInterface_GetClusterTrait_Call) RunAndReturn(run func(context.Cont
 }
 
 // GetClusterTraitSchema provides a mock function with given fields: ctx, clusterTraitName
-func (_m *MockInterface) GetClusterTraitSchema(ctx context.Context, clusterTraitName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetClusterTraitSchema(ctx context.Context, clusterTraitName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, clusterTraitName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetClusterTraitSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, clusterTraitName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *jsontext.Value); ok {
 		r0 = rf(ctx, clusterTraitName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -3398,12 +3580,12 @@ func (_c *MockInterface_GetClusterTraitSchema_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockInterface_GetClusterTraitSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetClusterTraitSchema_Call {
+func (_c *MockInterface_GetClusterTraitSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetClusterTraitSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetClusterTraitSchema_Call) RunAndReturn(run func(context.Context, string) (*json.RawMessage, error)) *MockInterface_GetClusterTraitSchema_Call {
+func (_c *MockInterface_GetClusterTraitSchema_Call) RunAndReturn(run func(context.Context, string) (*jsontext.Value, error)) *MockInterface_GetClusterTraitSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3527,23 +3709,23 @@ func (_c *MockInterface_GetClusterWorkflowPlane_Call) RunAndReturn(run func(cont
 }
 
 // GetClusterWorkflowSchema provides a mock function with given fields: ctx, clusterWorkflowName
-func (_m *MockInterface) GetClusterWorkflowSchema(ctx context.Context, clusterWorkflowName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetClusterWorkflowSchema(ctx context.Context, clusterWorkflowName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, clusterWorkflowName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetClusterWorkflowSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, clusterWorkflowName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *jsontext.Value); ok {
 		r0 = rf(ctx, clusterWorkflowName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -3575,12 +3757,12 @@ func (_c *MockInterface_GetClusterWorkflowSchema_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockInterface_GetClusterWorkflowSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetClusterWorkflowSchema_Call {
+func (_c *MockInterface_GetClusterWorkflowSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetClusterWorkflowSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetClusterWorkflowSchema_Call) RunAndReturn(run func(context.Context, string) (*json.RawMessage, error)) *MockInterface_GetClusterWorkflowSchema_Call {
+func (_c *MockInterface_GetClusterWorkflowSchema_Call) RunAndReturn(run func(context.Context, string) (*jsontext.Value, error)) *MockInterface_GetClusterWorkflowSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3645,6 +3827,65 @@ func (_c *MockInterface_GetComponent_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetComponentObserverURL provides a mock function with given fields: ctx, namespaceName, componentName, envName
+func (_m *MockInterface) GetComponentObserverURL(ctx context.Context, namespaceName string, componentName string, envName string) (string, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, envName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComponentObserverURL")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return rf(ctx, namespaceName, componentName, envName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, namespaceName, componentName, envName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, envName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_GetComponentObserverURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentObserverURL'
+type MockInterface_GetComponentObserverURL_Call struct {
+	*mock.Call
+}
+
+// GetComponentObserverURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - envName string
+func (_e *MockInterface_Expecter) GetComponentObserverURL(ctx interface{}, namespaceName interface{}, componentName interface{}, envName interface{}) *MockInterface_GetComponentObserverURL_Call {
+	return &MockInterface_GetComponentObserverURL_Call{Call: _e.mock.On("GetComponentObserverURL", ctx, namespaceName, componentName, envName)}
+}
+
+func (_c *MockInterface_GetComponentObserverURL_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, envName string)) *MockInterface_GetComponentObserverURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetComponentObserverURL_Call) Return(_a0 string, _a1 error) *MockInterface_GetComponentObserverURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_GetComponentObserverURL_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *MockInterface_GetComponentObserverURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentRelease provides a mock function with given fields: ctx, namespaceName, componentReleaseName
 func (_m *MockInterface) GetComponentRelease(ctx context.Context, namespaceName string, componentReleaseName string) (*gen.ComponentRelease, error) {
 	ret := _m.Called(ctx, namespaceName, componentReleaseName)
@@ -3766,23 +4007,23 @@ func (_c *MockInterface_GetComponentType_Call) RunAndReturn(run func(context.Con
 }
 
 // GetComponentTypeSchema provides a mock function with given fields: ctx, namespaceName, ctName
-func (_m *MockInterface) GetComponentTypeSchema(ctx context.Context, namespaceName string, ctName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetComponentTypeSchema(ctx context.Context, namespaceName string, ctName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, namespaceName, ctName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetComponentTypeSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, namespaceName, ctName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *jsontext.Value); ok {
 		r0 = rf(ctx, namespaceName, ctName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -3815,12 +4056,12 @@ func (_c *MockInterface_GetComponentTypeSchema_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *MockInterface_GetComponentTypeSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetComponentTypeSchema_Call {
+func (_c *MockInterface_GetComponentTypeSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetComponentTypeSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetComponentTypeSchema_Call) RunAndReturn(run func(context.Context, string, string) (*json.RawMessage, error)) *MockInterface_GetComponentTypeSchema_Call {
+func (_c *MockInterface_GetComponentTypeSchema_Call) RunAndReturn(run func(context.Context, string, string) (*jsontext.Value, error)) *MockInterface_GetComponentTypeSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4605,23 +4846,23 @@ func (_c *MockInterface_GetProjectType_Call) RunAndReturn(run func(context.Conte
 }
 
 // GetProjectTypeSchema provides a mock function with given fields: ctx, namespaceName, ptName
-func (_m *MockInterface) GetProjectTypeSchema(ctx context.Context, namespaceName string, ptName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetProjectTypeSchema(ctx context.Context, namespaceName string, ptName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, namespaceName, ptName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProjectTypeSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, namespaceName, ptName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *jsontext.Value); ok {
 		r0 = rf(ctx, namespaceName, ptName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -4654,12 +4895,12 @@ func (_c *MockInterface_GetProjectTypeSchema_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockInterface_GetProjectTypeSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetProjectTypeSchema_Call {
+func (_c *MockInterface_GetProjectTypeSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetProjectTypeSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetProjectTypeSchema_Call) RunAndReturn(run func(context.Context, string, string) (*json.RawMessage, error)) *MockInterface_GetProjectTypeSchema_Call {
+func (_c *MockInterface_GetProjectTypeSchema_Call) RunAndReturn(run func(context.Context, string, string) (*jsontext.Value, error)) *MockInterface_GetProjectTypeSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4965,23 +5206,23 @@ func (_c *MockInterface_GetResourceType_Call) RunAndReturn(run func(context.Cont
 }
 
 // GetResourceTypeSchema provides a mock function with given fields: ctx, namespaceName, rtName
-func (_m *MockInterface) GetResourceTypeSchema(ctx context.Context, namespaceName string, rtName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetResourceTypeSchema(ctx context.Context, namespaceName string, rtName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, namespaceName, rtName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetResourceTypeSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, namespaceName, rtName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *jsontext.Value); ok {
 		r0 = rf(ctx, namespaceName, rtName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -5014,12 +5255,12 @@ func (_c *MockInterface_GetResourceTypeSchema_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockInterface_GetResourceTypeSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetResourceTypeSchema_Call {
+func (_c *MockInterface_GetResourceTypeSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetResourceTypeSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetResourceTypeSchema_Call) RunAndReturn(run func(context.Context, string, string) (*json.RawMessage, error)) *MockInterface_GetResourceTypeSchema_Call {
+func (_c *MockInterface_GetResourceTypeSchema_Call) RunAndReturn(run func(context.Context, string, string) (*jsontext.Value, error)) *MockInterface_GetResourceTypeSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5205,23 +5446,23 @@ func (_c *MockInterface_GetTrait_Call) RunAndReturn(run func(context.Context, st
 }
 
 // GetTraitSchema provides a mock function with given fields: ctx, namespaceName, traitName
-func (_m *MockInterface) GetTraitSchema(ctx context.Context, namespaceName string, traitName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetTraitSchema(ctx context.Context, namespaceName string, traitName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, namespaceName, traitName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTraitSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, namespaceName, traitName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *jsontext.Value); ok {
 		r0 = rf(ctx, namespaceName, traitName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -5254,12 +5495,12 @@ func (_c *MockInterface_GetTraitSchema_Call) Run(run func(ctx context.Context, n
 	return _c
 }
 
-func (_c *MockInterface_GetTraitSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetTraitSchema_Call {
+func (_c *MockInterface_GetTraitSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetTraitSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetTraitSchema_Call) RunAndReturn(run func(context.Context, string, string) (*json.RawMessage, error)) *MockInterface_GetTraitSchema_Call {
+func (_c *MockInterface_GetTraitSchema_Call) RunAndReturn(run func(context.Context, string, string) (*jsontext.Value, error)) *MockInterface_GetTraitSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5566,23 +5807,23 @@ func (_c *MockInterface_GetWorkflowRunStatus_Call) RunAndReturn(run func(context
 }
 
 // GetWorkflowSchema provides a mock function with given fields: ctx, namespaceName, workflowName
-func (_m *MockInterface) GetWorkflowSchema(ctx context.Context, namespaceName string, workflowName string) (*json.RawMessage, error) {
+func (_m *MockInterface) GetWorkflowSchema(ctx context.Context, namespaceName string, workflowName string) (*jsontext.Value, error) {
 	ret := _m.Called(ctx, namespaceName, workflowName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetWorkflowSchema")
 	}
 
-	var r0 *json.RawMessage
+	var r0 *jsontext.Value
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*json.RawMessage, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*jsontext.Value, error)); ok {
 		return rf(ctx, namespaceName, workflowName)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *json.RawMessage); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *jsontext.Value); ok {
 		r0 = rf(ctx, namespaceName, workflowName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(*jsontext.Value)
 		}
 	}
 
@@ -5615,12 +5856,12 @@ func (_c *MockInterface_GetWorkflowSchema_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockInterface_GetWorkflowSchema_Call) Return(_a0 *json.RawMessage, _a1 error) *MockInterface_GetWorkflowSchema_Call {
+func (_c *MockInterface_GetWorkflowSchema_Call) Return(_a0 *jsontext.Value, _a1 error) *MockInterface_GetWorkflowSchema_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockInterface_GetWorkflowSchema_Call) RunAndReturn(run func(context.Context, string, string) (*json.RawMessage, error)) *MockInterface_GetWorkflowSchema_Call {
+func (_c *MockInterface_GetWorkflowSchema_Call) RunAndReturn(run func(context.Context, string, string) (*jsontext.Value, error)) *MockInterface_GetWorkflowSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7175,6 +7416,65 @@ func (_c *MockInterface_ListProjects_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ListQuarantinedResources provides a mock function with given fields: ctx, namespaceName
+func (_m *MockInterface) ListQuarantinedResources(ctx context.Context, namespaceName string) (*gen.QuarantinedResourceList, error) {
+	ret := _m.Called(ctx, namespaceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQuarantinedResources")
+	}
+
+	var r0 *gen.QuarantinedResourceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gen.QuarantinedResourceList, error)); ok {
+		return rf(ctx, namespaceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gen.QuarantinedResourceList); ok {
+		r0 = rf(ctx, namespaceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.QuarantinedResourceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_ListQuarantinedResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQuarantinedResources'
+type MockInterface_ListQuarantinedResources_Call struct {
+	*mock.Call
+}
+
+// ListQuarantinedResources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+func (_e *MockInterface_Expecter) ListQuarantinedResources(ctx interface{}, namespaceName interface{}) *MockInterface_ListQuarantinedResources_Call {
+	return &MockInterface_ListQuarantinedResources_Call{Call: _e.mock.On("ListQuarantinedResources", ctx, namespaceName)}
+}
+
+func (_c *MockInterface_ListQuarantinedResources_Call) Run(run func(ctx context.Context, namespaceName string)) *MockInterface_ListQuarantinedResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_ListQuarantinedResources_Call) Return(_a0 *gen.QuarantinedResourceList, _a1 error) *MockInterface_ListQuarantinedResources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_ListQuarantinedResources_Call) RunAndReturn(run func(context.Context, string) (*gen.QuarantinedResourceList, error)) *MockInterface_ListQuarantinedResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListReleaseBindings provides a mock function with given fields: ctx, namespaceName, params
 func (_m *MockInterface) ListReleaseBindings(ctx context.Context, namespaceName string, params *gen.ListReleaseBindingsParams) (*gen.ReleaseBindingList, error) {
 	ret := _m.Called(ctx, namespaceName, params)
@@ -7895,6 +8195,126 @@ func (_c *MockInterface_ListWorkloads_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// RequeueQuarantinedResource provides a mock function with given fields: ctx, namespaceName, kind, name
+func (_m *MockInterface) RequeueQuarantinedResource(ctx context.Context, namespaceName string, kind string, name string) (*gen.QuarantinedResource, error) {
+	ret := _m.Called(ctx, namespaceName, kind, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueQuarantinedResource")
+	}
+
+	var r0 *gen.QuarantinedResource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*gen.QuarantinedResource, error)); ok {
+		return rf(ctx, namespaceName, kind, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *gen.QuarantinedResource); ok {
+		r0 = rf(ctx, namespaceName, kind, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.QuarantinedResource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, kind, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_RequeueQuarantinedResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueQuarantinedResource'
+type MockInterface_RequeueQuarantinedResource_Call struct {
+	*mock.Call
+}
+
+// RequeueQuarantinedResource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - kind string
+//   - name string
+func (_e *MockInterface_Expecter) RequeueQuarantinedResource(ctx interface{}, namespaceName interface{}, kind interface{}, name interface{}) *MockInterface_RequeueQuarantinedResource_Call {
+	return &MockInterface_RequeueQuarantinedResource_Call{Call: _e.mock.On("RequeueQuarantinedResource", ctx, namespaceName, kind, name)}
+}
+
+func (_c *MockInterface_RequeueQuarantinedResource_Call) Run(run func(ctx context.Context, namespaceName string, kind string, name string)) *MockInterface_RequeueQuarantinedResource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_RequeueQuarantinedResource_Call) Return(_a0 *gen.QuarantinedResource, _a1 error) *MockInterface_RequeueQuarantinedResource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_RequeueQuarantinedResource_Call) RunAndReturn(run func(context.Context, string, string, string) (*gen.QuarantinedResource, error)) *MockInterface_RequeueQuarantinedResource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, params
+func (_m *MockInterface) Search(ctx context.Context, params *gen.SearchParams) (*gen.SearchResults, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 *gen.SearchResults
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SearchParams) (*gen.SearchResults, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SearchParams) *gen.SearchResults); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SearchResults)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SearchParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockInterface_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *gen.SearchParams
+func (_e *MockInterface_Expecter) Search(ctx interface{}, params interface{}) *MockInterface_Search_Call {
+	return &MockInterface_Search_Call{Call: _e.mock.On("Search", ctx, params)}
+}
+
+func (_c *MockInterface_Search_Call) Run(run func(ctx context.Context, params *gen.SearchParams)) *MockInterface_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gen.SearchParams))
+	})
+	return _c
+}
+
+func (_c *MockInterface_Search_Call) Return(_a0 *gen.SearchResults, _a1 error) *MockInterface_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_Search_Call) RunAndReturn(run func(context.Context, *gen.SearchParams) (*gen.SearchResults, error)) *MockInterface_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateClusterProjectType provides a mock function with given fields: ctx, cptName, cpt
 func (_m *MockInterface) UpdateClusterProjectType(ctx context.Context, cptName string, cpt gen.ClusterProjectType) (*gen.ClusterProjectType, error) {
 	ret := _m.Called(ctx, cptName, cpt)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -161,6 +162,231 @@ func (c *ObserverClient) FetchWorkflowRunLogs(ctx context.Context, runName strin
 	return &logResponse, nil
 }
 
+// IdleWorkload is a component flagged by the observer's idle workload analysis
+type IdleWorkload struct {
+	Namespace               string  `json:"namespace"`
+	Project                 string  `json:"project"`
+	Component               string  `json:"component"`
+	Environment             string  `json:"environment"`
+	ReleaseBinding          string  `json:"releaseBinding"`
+	WindowStart             string  `json:"windowStart"`
+	WindowEnd               string  `json:"windowEnd"`
+	CPUP95Cores             float64 `json:"cpuP95Cores"`
+	PeakRequestRate         float64 `json:"peakRequestRate"`
+	CPURequestCores         float64 `json:"cpuRequestCores"`
+	MemoryRequestBytes      float64 `json:"memoryRequestBytes"`
+	EstimatedMonthlySavings float64 `json:"estimatedMonthlySavings"`
+	SuspendURL              string  `json:"suspendUrl"`
+}
+
+// IdleWorkloadsResponse represents the response from the observer idle workloads API
+type IdleWorkloadsResponse struct {
+	GeneratedAt                  string         `json:"generatedAt"`
+	Currency                     string         `json:"currency"`
+	TotalEstimatedMonthlySavings float64        `json:"totalEstimatedMonthlySavings"`
+	Items                        []IdleWorkload `json:"items"`
+}
+
+// IdleWorkloadsRequest represents the request body for the idle workloads API
+type IdleWorkloadsRequest struct {
+	Namespace   string `json:"namespace"`
+	Project     string `json:"project,omitempty"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// FetchIdleWorkloads fetches the latest idle workload report from the observer API
+func (c *ObserverClient) FetchIdleWorkloads(ctx context.Context, req IdleWorkloadsRequest) (*IdleWorkloadsResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/api/v1alpha1/metrics/idle-workloads", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("observer API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var report IdleWorkloadsResponse
+	if err := json.Unmarshal(body, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &report, nil
+}
+
+// PromQLQueryRequest represents the request body for the raw PromQL query API
+type PromQLQueryRequest struct {
+	Namespace string `json:"namespace"`
+	Query     string `json:"query"`
+	StartTime string `json:"startTime,omitempty"`
+	EndTime   string `json:"endTime"`
+	Step      string `json:"step,omitempty"`
+}
+
+// OpenSearchQueryRequest represents the request body for the raw OpenSearch search API
+type OpenSearchQueryRequest struct {
+	Namespace string          `json:"namespace"`
+	LogType   string          `json:"logType"`
+	StartTime string          `json:"startTime"`
+	EndTime   string          `json:"endTime"`
+	Body      json.RawMessage `json:"body,omitempty"`
+}
+
+// RunPromQLQuery runs a namespace scoped PromQL query through the observer API and returns
+// the raw JSON response
+func (c *ObserverClient) RunPromQLQuery(ctx context.Context, req PromQLQueryRequest) (json.RawMessage, error) {
+	return c.doRawQuery(ctx, "/api/v1alpha1/raw/promql/query", req)
+}
+
+// RunOpenSearchQuery runs a namespace scoped OpenSearch search through the observer API and
+// returns the raw JSON response
+func (c *ObserverClient) RunOpenSearchQuery(ctx context.Context, req OpenSearchQueryRequest) (json.RawMessage, error) {
+	return c.doRawQuery(ctx, "/api/v1alpha1/raw/opensearch/search", req)
+}
+
+// SavedQueryParameter represents a parameter of a saved query
+type SavedQueryParameter struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Default     *string `json:"default,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+}
+
+// SavedQuery represents a saved logs or traces query
+type SavedQuery struct {
+	Namespace   string                `json:"namespace"`
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	Kind        string                `json:"kind"`
+	Query       json.RawMessage       `json:"query"`
+	Parameters  []SavedQueryParameter `json:"parameters,omitempty"`
+	CreatedBy   string                `json:"createdBy,omitempty"`
+	CreatedAt   string                `json:"createdAt,omitempty"`
+}
+
+// SavedQueryListResponse represents the response of the saved query list API
+type SavedQueryListResponse struct {
+	Items []SavedQuery `json:"items"`
+	Total int          `json:"total"`
+}
+
+// ListSavedQueries lists the saved queries of a namespace
+func (c *ObserverClient) ListSavedQueries(ctx context.Context, namespace string) (*SavedQueryListResponse, error) {
+	body, err := c.doSavedQueryRequest(ctx, "GET", savedQueryPath(namespace), nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var list SavedQueryListResponse
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &list, nil
+}
+
+// GetSavedQuery returns a saved query
+func (c *ObserverClient) GetSavedQuery(ctx context.Context, namespace, name string) (*SavedQuery, error) {
+	body, err := c.doSavedQueryRequest(ctx, "GET", savedQueryPath(namespace, name), nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var query SavedQuery
+	if err := json.Unmarshal(body, &query); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &query, nil
+}
+
+// CreateSavedQuery saves a query
+func (c *ObserverClient) CreateSavedQuery(ctx context.Context, query SavedQuery) (*SavedQuery, error) {
+	body, err := c.doSavedQueryRequest(ctx, "POST", "/api/v1alpha1/queries", query, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	var created SavedQuery
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &created, nil
+}
+
+// DeleteSavedQuery deletes a saved query
+func (c *ObserverClient) DeleteSavedQuery(ctx context.Context, namespace, name string) error {
+	_, err := c.doSavedQueryRequest(ctx, "DELETE", savedQueryPath(namespace, name), nil, http.StatusNoContent)
+	return err
+}
+
+// RunSavedQuery runs a saved query with the given parameter values and returns the raw JSON
+// response of the logs or traces query
+func (c *ObserverClient) RunSavedQuery(
+	ctx context.Context,
+	namespace, name string,
+	parameters map[string]string,
+) (json.RawMessage, error) {
+	req := struct {
+		Parameters map[string]string `json:"parameters,omitempty"`
+	}{Parameters: parameters}
+	return c.doSavedQueryRequest(ctx, "POST", savedQueryPath(namespace, name)+"/run", req, http.StatusOK)
+}
+
+func savedQueryPath(segments ...string) string {
+	path := "/api/v1alpha1/queries"
+	for _, s := range segments {
+		path += "/" + url.PathEscape(s)
+	}
+	return path
+}
+
+func (c *ObserverClient) doSavedQueryRequest(
+	ctx context.Context,
+	method, path string,
+	req interface{},
+	wantStatus int,
+) ([]byte, error) {
+	resp, err := c.doRequest(ctx, method, path, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != wantStatus {
+		return nil, fmt.Errorf("observer API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
+func (c *ObserverClient) doRawQuery(ctx context.Context, path string, req interface{}) (json.RawMessage, error) {
+	resp, err := c.doRequest(ctx, "POST", path, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("observer API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
 // doRequest performs HTTP request with proper headers
 func (c *ObserverClient) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
 	// Reuse legacy_client.go's APIClient doRequest logic
@@ -159,6 +159,20 @@ func (c *Client) GetComponent(ctx context.Context, namespaceName, componentName
 	return resp.JSON200, nil
 }
 
+// GetComponentObserverURL retrieves the observer URL that serves a component's logs in an environment
+func (c *Client) GetComponentObserverURL(ctx context.Context, namespaceName, componentName, envName string) (string, error) {
+	resp, err := c.client.GetComponentObserverURLWithResponse(ctx, namespaceName, componentName, &gen.GetComponentObserverURLParams{
+		Environment: envName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get component observer URL: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return "", apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.ObserverURL, nil
+}
+
 // ListEnvironments retrieves all environments for a namespace
 func (c *Client) ListEnvironments(ctx context.Context, namespaceName string, params *gen.ListEnvironmentsParams) (*gen.EnvironmentList, error) {
 	resp, err := c.client.ListEnvironmentsWithResponse(ctx, namespaceName, params)
@@ -905,6 +919,22 @@ func (c *Client) GetWorkflowRun(ctx context.Context, namespaceName, runName stri
 	return resp.JSON200, nil
 }
 
+// CancelWorkflowRun requests a workflow run to be cancelled
+func (c *Client) CancelWorkflowRun(ctx context.Context, namespaceName, runName, reason string) (*gen.WorkflowRun, error) {
+	body := gen.CancelWorkflowRunJSONRequestBody{}
+	if reason != "" {
+		body.Reason = &reason
+	}
+	resp, err := c.client.CancelWorkflowRunWithResponse(ctx, namespaceName, runName, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel workflow run: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
 // GetWorkload retrieves a specific workload
 func (c *Client) GetWorkload(ctx context.Context, namespaceName, workloadName string) (*gen.Workload, error) {
 	resp, err := c.client.GetWorkloadWithResponse(ctx, namespaceName, workloadName)
@@ -1010,6 +1040,30 @@ func (c *Client) DeleteReleaseBinding(ctx context.Context, namespaceName, releas
 	return nil
 }
 
+// EnableReleaseBindingMaintenance puts a release binding in maintenance
+func (c *Client) EnableReleaseBindingMaintenance(ctx context.Context, namespaceName, releaseBindingName string, req gen.ReleaseBindingMaintenanceRequest) (*gen.ReleaseBinding, error) {
+	resp, err := c.client.EnableReleaseBindingMaintenanceWithResponse(ctx, namespaceName, releaseBindingName, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable release binding maintenance: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// DisableReleaseBindingMaintenance ends the maintenance of a release binding
+func (c *Client) DisableReleaseBindingMaintenance(ctx context.Context, namespaceName, releaseBindingName string) (*gen.ReleaseBinding, error) {
+	resp, err := c.client.DisableReleaseBindingMaintenanceWithResponse(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to disable release binding maintenance: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
 // GetComponentRelease retrieves a specific component release
 func (c *Client) GetComponentRelease(ctx context.Context, namespaceName, componentReleaseName string) (*gen.ComponentRelease, error) {
 	resp, err := c.client.GetComponentReleaseWithResponse(ctx, namespaceName, componentReleaseName)
@@ -2047,3 +2101,42 @@ func schemaResponseToRaw(schema *gen.SchemaResponse) (*json.RawMessage, error) {
 	raw := json.RawMessage(data)
 	return &raw, nil
 }
+
+// Search runs a free-text search across resources
+func (c *Client) Search(ctx context.Context, params *gen.SearchParams) (*gen.SearchResults, error) {
+	if params == nil {
+		params = &gen.SearchParams{}
+	}
+	resp, err := c.client.SearchWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// ListQuarantinedResources lists the resources of a namespace quarantined after failing reconciles
+func (c *Client) ListQuarantinedResources(ctx context.Context, namespaceName string) (*gen.QuarantinedResourceList, error) {
+	resp, err := c.client.ListQuarantinedResourcesWithResponse(ctx, namespaceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quarantined resources: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// RequeueQuarantinedResource requests a quarantined resource to be reconciled again
+func (c *Client) RequeueQuarantinedResource(ctx context.Context, namespaceName, kind, name string) (*gen.QuarantinedResource, error) {
+	resp, err := c.client.RequeueQuarantinedResourceWithResponse(ctx, namespaceName, kind, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue quarantined resource: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
@@ -435,6 +435,34 @@ func TestGetComponent_APIError(t *testing.T) {
 	require.ErrorContains(t, err, "component not found")
 }
 
+// --- GetComponentObserverURL ---
+
+func TestGetComponentObserverURL_Success(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().GetComponentObserverURLWithResponse(mock.Anything, "org-a", "comp-1",
+		&gen.GetComponentObserverURLParams{Environment: "dev"}, mock.Anything).Return(&gen.GetComponentObserverURLResp{
+		HTTPResponse: httpResp(http.StatusOK),
+		JSON200:      &gen.ComponentObserverURL{Component: "comp-1", Environment: "dev", ObserverURL: "http://observer.dev"},
+	}, nil)
+
+	c := newMockClient(m)
+	result, err := c.GetComponentObserverURL(context.Background(), "org-a", "comp-1", "dev")
+	require.NoError(t, err)
+	assert.Equal(t, "http://observer.dev", result)
+}
+
+func TestGetComponentObserverURL_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().GetComponentObserverURLWithResponse(mock.Anything, "org-a", "comp-1", mock.Anything, mock.Anything).Return(&gen.GetComponentObserverURLResp{
+		HTTPResponse: httpResp(http.StatusUnprocessableEntity),
+		Body:         []byte(`{"error":"observer URL not configured"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.GetComponentObserverURL(context.Background(), "org-a", "comp-1", "dev")
+	require.ErrorContains(t, err, "observer URL not configured")
+}
+
 // --- DeleteComponent ---
 
 func TestDeleteComponent_Success(t *testing.T) {
@@ -1612,6 +1640,35 @@ func TestGetWorkflowRun_APIError(t *testing.T) {
 	require.ErrorContains(t, err, "workflow run not found")
 }
 
+// --- CancelWorkflowRun ---
+
+func TestCancelWorkflowRun_Success(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().CancelWorkflowRunWithResponse(mock.Anything, "org-a", "run-1", mock.MatchedBy(func(body gen.CancelWorkflowRunJSONRequestBody) bool {
+		return body.Reason != nil && *body.Reason == "wrong branch"
+	}), mock.Anything).Return(&gen.CancelWorkflowRunResp{
+		HTTPResponse: httpResp(http.StatusOK),
+		JSON200:      &gen.WorkflowRun{Metadata: gen.ObjectMeta{Name: "run-1"}},
+	}, nil)
+
+	c := newMockClient(m)
+	result, err := c.CancelWorkflowRun(context.Background(), "org-a", "run-1", "wrong branch")
+	require.NoError(t, err)
+	assert.Equal(t, "run-1", result.Metadata.Name)
+}
+
+func TestCancelWorkflowRun_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().CancelWorkflowRunWithResponse(mock.Anything, "org-a", "run-1", mock.Anything, mock.Anything).Return(&gen.CancelWorkflowRunResp{
+		HTTPResponse: httpResp(http.StatusConflict),
+		Body:         []byte(`{"error":"workflow run has already completed"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.CancelWorkflowRun(context.Background(), "org-a", "run-1", "")
+	require.ErrorContains(t, err, "already completed")
+}
+
 // --- CreateWorkflowRun ---
 
 func TestCreateWorkflowRun_Success(t *testing.T) {
@@ -3699,3 +3756,43 @@ func TestListComponents_WithProjectFilter(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, result.Items, 1)
 }
+
+// --- Search ---
+
+func TestSearch_Success(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().SearchWithResponse(mock.Anything, mock.Anything).Return(&gen.SearchResp{
+		HTTPResponse: httpResp(http.StatusOK),
+		JSON200: &gen.SearchResults{
+			Items: []gen.SearchHit{{Kind: "component", Namespace: "acme", Project: "shop", Name: "checkout"}},
+			Total: 1,
+		},
+	}, nil)
+
+	c := newMockClient(m)
+	result, err := c.Search(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "checkout", result.Items[0].Name)
+}
+
+func TestSearch_TransportError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().SearchWithResponse(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("connection refused"))
+
+	c := newMockClient(m)
+	_, err := c.Search(context.Background(), nil)
+	require.ErrorContains(t, err, "failed to search")
+}
+
+func TestSearch_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().SearchWithResponse(mock.Anything, mock.Anything).Return(&gen.SearchResp{
+		HTTPResponse: httpResp(http.StatusBadRequest),
+		Body:         []byte(`{"error":"unsupported facet"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.Search(context.Background(), nil)
+	require.ErrorContains(t, err, "unsupported facet")
+}
@@ -6,6 +6,7 @@ package root
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/airgap"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/apply"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/authzrole"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/authzrolebinding"
@@ -28,6 +29,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/environment"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/login"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/logout"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/logs"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/namespace"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/observabilityalertsnotificationchannel"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/observabilityplane"
@@ -35,14 +37,18 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/projectrelease"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/projectreleasebinding"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/projecttype"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/quarantine"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/releasebinding"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/resource"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/resourcerelease"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/resourcereleasebinding"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/resourcetype"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/schema"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/search"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/secret"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/secretreference"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/trait"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/validate"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/version"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflow"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowplane"
@@ -65,8 +71,13 @@ func BuildRootCmd() *cobra.Command {
 
 	rootCmd.AddCommand(
 		apply.NewApplyCmd(f),
+		airgap.NewAirGapCmd(f),
+		validate.NewValidateCmd(f),
+		schema.NewSchemaCmd(f),
+		search.NewSearchCmd(f),
 		login.NewLoginCmd(),
 		logout.NewLogoutCmd(),
+		logs.NewLogsCmd(f),
 		config.NewConfigCmd(),
 		version.NewVersionCmd(),
 		componentrelease.NewComponentReleaseCmd(f),
@@ -106,6 +117,7 @@ func BuildRootCmd() *cobra.Command {
 		workload.NewWorkloadCmd(f),
 		deploymentpipeline.NewDeploymentPipelineCmd(f),
 		observabilityalertsnotificationchannel.NewObservabilityAlertsNotificationChannelCmd(f),
+		quarantine.NewQuarantineCmd(f),
 	)
 
 	return rootCmd
@@ -22,8 +22,13 @@ func TestBuildRootCmd_Subcommands(t *testing.T) {
 
 	expected := []string{
 		"apply",
+		"airgap",
+		"validate",
+		"schema",
+		"search",
 		"login",
 		"logout",
+		"logs",
 		"config",
 		"version",
 		"componentrelease",
@@ -63,6 +68,7 @@ func TestBuildRootCmd_Subcommands(t *testing.T) {
 		"workload",
 		"deploymentpipeline",
 		"observabilityalertsnotificationchannel",
+		"quarantine",
 	}
 
 	commands := cmd.Commands()
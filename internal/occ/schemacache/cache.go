@@ -0,0 +1,165 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schemacache stores the parameter schemas of component types and traits on disk, so
+// that manifests can be validated without access to the control plane.
+//
+// The cache is a directory tree with one JSON Schema file per resource:
+//
+//	<dir>/clustercomponenttypes/<name>.json
+//	<dir>/clustertraits/<name>.json
+//	<dir>/namespaces/<namespace>/componenttypes/<name>.json
+//	<dir>/namespaces/<namespace>/traits/<name>.json
+package schemacache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Kinds whose parameter schemas are cached.
+const (
+	KindComponentType        = "ComponentType"
+	KindClusterComponentType = "ClusterComponentType"
+	KindTrait                = "Trait"
+	KindClusterTrait         = "ClusterTrait"
+)
+
+// ErrNotCached is returned when the cache holds no schema for a resource.
+var ErrNotCached = errors.New("schema not cached")
+
+// Cache is a schema cache rooted at a directory.
+type Cache struct {
+	Dir string
+}
+
+// DefaultDir returns the default cache directory, ~/.openchoreo/schemas, next to the occ
+// configuration file.
+func DefaultDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".openchoreo", "schemas"), nil
+}
+
+// New returns a cache rooted at dir, or at the default directory when dir is empty.
+func New(dir string) (*Cache, error) {
+	if dir == "" {
+		var err error
+		if dir, err = DefaultDir(); err != nil {
+			return nil, err
+		}
+	}
+	return &Cache{Dir: dir}, nil
+}
+
+// IsClusterScoped reports whether a cached kind is cluster-scoped.
+func IsClusterScoped(kind string) bool {
+	return kind == KindClusterComponentType || kind == KindClusterTrait
+}
+
+// KindDir returns the directory holding the schemas of a kind. The namespace is ignored for
+// cluster-scoped kinds.
+func (c *Cache) KindDir(kind, namespace string) (string, error) {
+	var plural string
+	switch kind {
+	case KindComponentType, KindClusterComponentType, KindTrait, KindClusterTrait:
+		plural = strings.ToLower(kind) + "s"
+	default:
+		return "", fmt.Errorf("unsupported kind %q", kind)
+	}
+	if IsClusterScoped(kind) {
+		return filepath.Join(c.Dir, plural), nil
+	}
+	if namespace == "" {
+		return "", fmt.Errorf("namespace is required for %s schemas", kind)
+	}
+	if err := validateName(namespace); err != nil {
+		return "", err
+	}
+	return filepath.Join(c.Dir, "namespaces", namespace, plural), nil
+}
+
+// Path returns the file holding the schema of a resource.
+func (c *Cache) Path(kind, namespace, name string) (string, error) {
+	if err := validateName(name); err != nil {
+		return "", err
+	}
+	dir, err := c.KindDir(kind, namespace)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".json"), nil
+}
+
+// Save writes the schema of a resource to the cache.
+func (c *Cache) Save(kind, namespace, name string, schema json.RawMessage) error {
+	path, err := c.Path(kind, namespace, name)
+	if err != nil {
+		return err
+	}
+	if !json.Valid(schema) {
+		return fmt.Errorf("schema of %s %s is not valid JSON", kind, name)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create schema directory: %w", err)
+	}
+	if err := os.WriteFile(path, schema, 0o600); err != nil {
+		return fmt.Errorf("failed to write schema file: %w", err)
+	}
+	return nil
+}
+
+// Load reads the schema of a resource from the cache. It returns an error wrapping
+// ErrNotCached when the schema has not been pulled.
+func (c *Cache) Load(kind, namespace, name string) (map[string]any, error) {
+	path, err := c.Path(kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s %s", ErrNotCached, kind, qualifiedName(namespace, name, kind))
+		}
+		return nil, fmt.Errorf("failed to read schema file: %w", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema file %s: %w", path, err)
+	}
+	return schema, nil
+}
+
+// Clear removes the cached schemas of a kind, so that a pull does not leave the schemas of
+// deleted resources behind.
+func (c *Cache) Clear(kind, namespace string) error {
+	dir, err := c.KindDir(kind, namespace)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clear schema directory: %w", err)
+	}
+	return nil
+}
+
+// validateName rejects names that would escape the cache directory.
+func validateName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid resource name %q", name)
+	}
+	return nil
+}
+
+func qualifiedName(namespace, name, kind string) string {
+	if IsClusterScoped(kind) {
+		return name
+	}
+	return namespace + "/" + name
+}
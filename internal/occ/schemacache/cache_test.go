@@ -0,0 +1,58 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schemacache
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_SaveAndLoad(t *testing.T) {
+	cache := &Cache{Dir: t.TempDir()}
+	schema := json.RawMessage(`{"type":"object","properties":{"replicas":{"type":"integer"}}}`)
+
+	require.NoError(t, cache.Save(KindComponentType, "acme", "service", schema))
+	require.NoError(t, cache.Save(KindClusterTrait, "ignored", "autoscaler", schema))
+
+	path, err := cache.Path(KindComponentType, "acme", "service")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(cache.Dir, "namespaces", "acme", "componenttypes", "service.json"), path)
+	path, err = cache.Path(KindClusterTrait, "", "autoscaler")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(cache.Dir, "clustertraits", "autoscaler.json"), path)
+
+	loaded, err := cache.Load(KindComponentType, "acme", "service")
+	require.NoError(t, err)
+	assert.Equal(t, "object", loaded["type"])
+
+	_, err = cache.Load(KindComponentType, "other", "service")
+	assert.ErrorIs(t, err, ErrNotCached)
+	assert.ErrorContains(t, err, "ComponentType other/service")
+}
+
+func TestCache_Clear(t *testing.T) {
+	cache := &Cache{Dir: t.TempDir()}
+	require.NoError(t, cache.Save(KindTrait, "acme", "ingress", json.RawMessage(`{}`)))
+	require.NoError(t, cache.Save(KindComponentType, "acme", "service", json.RawMessage(`{}`)))
+
+	require.NoError(t, cache.Clear(KindTrait, "acme"))
+
+	_, err := cache.Load(KindTrait, "acme", "ingress")
+	assert.ErrorIs(t, err, ErrNotCached)
+	_, err = cache.Load(KindComponentType, "acme", "service")
+	assert.NoError(t, err)
+}
+
+func TestCache_RejectsInvalidInput(t *testing.T) {
+	cache := &Cache{Dir: t.TempDir()}
+
+	assert.ErrorContains(t, cache.Save(KindComponentType, "acme", "../escape", json.RawMessage(`{}`)), "invalid resource name")
+	assert.ErrorContains(t, cache.Save(KindComponentType, "", "service", json.RawMessage(`{}`)), "namespace is required")
+	assert.ErrorContains(t, cache.Save("Workflow", "acme", "build", json.RawMessage(`{}`)), "unsupported kind")
+	assert.ErrorContains(t, cache.Save(KindComponentType, "acme", "service", json.RawMessage(`{`)), "not valid JSON")
+}
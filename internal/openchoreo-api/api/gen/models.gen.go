@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -24,6 +25,15 @@ const (
 	ActionInfoLowestScopeResource  ActionInfoLowestScope = "resource"
 )
 
+// Defines values for AnalysisSeverity.
+const (
+	Critical AnalysisSeverity = "Critical"
+	High     AnalysisSeverity = "High"
+	Info     AnalysisSeverity = "Info"
+	Low      AnalysisSeverity = "Low"
+	Medium   AnalysisSeverity = "Medium"
+)
+
 // Defines values for AuthzRoleBindingSpecEffect.
 const (
 	AuthzRoleBindingSpecEffectAllow AuthzRoleBindingSpecEffect = "allow"
@@ -73,6 +83,7 @@ const (
 	ClusterComponentTypeSpecWorkloadTypeCronjob     ClusterComponentTypeSpecWorkloadType = "cronjob"
 	ClusterComponentTypeSpecWorkloadTypeDeployment  ClusterComponentTypeSpecWorkloadType = "deployment"
 	ClusterComponentTypeSpecWorkloadTypeJob         ClusterComponentTypeSpecWorkloadType = "job"
+	ClusterComponentTypeSpecWorkloadTypeLibrary     ClusterComponentTypeSpecWorkloadType = "library"
 	ClusterComponentTypeSpecWorkloadTypeProxy       ClusterComponentTypeSpecWorkloadType = "proxy"
 	ClusterComponentTypeSpecWorkloadTypeStatefulset ClusterComponentTypeSpecWorkloadType = "statefulset"
 )
@@ -106,12 +117,29 @@ const (
 	ClusterWorkflowPlaneRefKindClusterWorkflowPlane ClusterWorkflowPlaneRefKind = "ClusterWorkflowPlane"
 )
 
+// Defines values for ComponentPromotionResultPhase.
+const (
+	ComponentPromotionResultPhaseFailed          ComponentPromotionResultPhase = "Failed"
+	ComponentPromotionResultPhasePartiallyFailed ComponentPromotionResultPhase = "PartiallyFailed"
+	ComponentPromotionResultPhasePending         ComponentPromotionResultPhase = "Pending"
+	ComponentPromotionResultPhaseProgressing     ComponentPromotionResultPhase = "Progressing"
+	ComponentPromotionResultPhaseRolledBack      ComponentPromotionResultPhase = "RolledBack"
+	ComponentPromotionResultPhaseSkipped         ComponentPromotionResultPhase = "Skipped"
+	ComponentPromotionResultPhaseSucceeded       ComponentPromotionResultPhase = "Succeeded"
+)
+
 // Defines values for ComponentSpecComponentTypeKind.
 const (
 	ComponentSpecComponentTypeKindClusterComponentType ComponentSpecComponentTypeKind = "ClusterComponentType"
 	ComponentSpecComponentTypeKindComponentType        ComponentSpecComponentTypeKind = "ComponentType"
 )
 
+// Defines values for ComponentSpecState.
+const (
+	ComponentSpecStateActive   ComponentSpecState = "Active"
+	ComponentSpecStateArchived ComponentSpecState = "Archived"
+)
+
 // Defines values for ComponentTraitKind.
 const (
 	ComponentTraitKindClusterTrait ComponentTraitKind = "ClusterTrait"
@@ -124,6 +152,14 @@ const (
 	ComponentTraitInputKindTrait        ComponentTraitInputKind = "Trait"
 )
 
+// Defines values for ComponentTransferStepStatus.
+const (
+	ComponentTransferStepStatusFailed     ComponentTransferStepStatus = "Failed"
+	ComponentTransferStepStatusMoved      ComponentTransferStepStatus = "Moved"
+	ComponentTransferStepStatusPlanned    ComponentTransferStepStatus = "Planned"
+	ComponentTransferStepStatusRolledBack ComponentTransferStepStatus = "RolledBack"
+)
+
 // Defines values for ComponentTypeSpecAllowedTraitsKind.
 const (
 	ComponentTypeSpecAllowedTraitsKindClusterTrait ComponentTypeSpecAllowedTraitsKind = "ClusterTrait"
@@ -153,6 +189,7 @@ const (
 	ComponentTypeSpecWorkloadTypeCronjob     ComponentTypeSpecWorkloadType = "cronjob"
 	ComponentTypeSpecWorkloadTypeDeployment  ComponentTypeSpecWorkloadType = "deployment"
 	ComponentTypeSpecWorkloadTypeJob         ComponentTypeSpecWorkloadType = "job"
+	ComponentTypeSpecWorkloadTypeLibrary     ComponentTypeSpecWorkloadType = "library"
 	ComponentTypeSpecWorkloadTypeProxy       ComponentTypeSpecWorkloadType = "proxy"
 	ComponentTypeSpecWorkloadTypeStatefulset ComponentTypeSpecWorkloadType = "statefulset"
 )
@@ -176,6 +213,33 @@ const (
 	ConditionStatusUnknown ConditionStatus = "Unknown"
 )
 
+// Defines values for ConditionHistoryEntrySourceKind.
+const (
+	ConditionHistoryEntrySourceKindReleaseBinding  ConditionHistoryEntrySourceKind = "ReleaseBinding"
+	ConditionHistoryEntrySourceKindRenderedRelease ConditionHistoryEntrySourceKind = "RenderedRelease"
+)
+
+// Defines values for ConditionHistoryEntryStatus.
+const (
+	ConditionHistoryEntryStatusFalse   ConditionHistoryEntryStatus = "False"
+	ConditionHistoryEntryStatusTrue    ConditionHistoryEntryStatus = "True"
+	ConditionHistoryEntryStatusUnknown ConditionHistoryEntryStatus = "Unknown"
+)
+
+// Defines values for ConditionTransitionStatus.
+const (
+	ConditionTransitionStatusFalse   ConditionTransitionStatus = "False"
+	ConditionTransitionStatusTrue    ConditionTransitionStatus = "True"
+	ConditionTransitionStatusUnknown ConditionTransitionStatus = "Unknown"
+)
+
+// Defines values for ConfigChangeType.
+const (
+	ConfigChangeTypeAdded    ConfigChangeType = "Added"
+	ConfigChangeTypeModified ConfigChangeType = "Modified"
+	ConfigChangeTypeRemoved  ConfigChangeType = "Removed"
+)
+
 // Defines values for CreateGitSecretRequestSecretType.
 const (
 	BasicAuth CreateGitSecretRequestSecretType = "basic-auth"
@@ -188,11 +252,46 @@ const (
 	CreateGitSecretRequestWorkflowPlaneKindWorkflowPlane        CreateGitSecretRequestWorkflowPlaneKind = "WorkflowPlane"
 )
 
+// Defines values for DebugCredentialAccess.
+const (
+	DebugCredentialAccessLogsExec DebugCredentialAccess = "logs-exec"
+	DebugCredentialAccessReadOnly DebugCredentialAccess = "read-only"
+)
+
+// Defines values for DebugCredentialRequestAccess.
+const (
+	DebugCredentialRequestAccessLogsExec DebugCredentialRequestAccess = "logs-exec"
+	DebugCredentialRequestAccessReadOnly DebugCredentialRequestAccess = "read-only"
+)
+
+// Defines values for DirectorySyncChangeKind.
+const (
+	DirectorySyncChangeKindAuthzRoleBinding        DirectorySyncChangeKind = "AuthzRoleBinding"
+	DirectorySyncChangeKindClusterAuthzRoleBinding DirectorySyncChangeKind = "ClusterAuthzRoleBinding"
+)
+
+// Defines values for DirectorySyncConflictType.
+const (
+	DenyBinding   DirectorySyncConflictType = "DenyBinding"
+	GroupNotFound DirectorySyncConflictType = "GroupNotFound"
+	NameTaken     DirectorySyncConflictType = "NameTaken"
+	RoleNotFound  DirectorySyncConflictType = "RoleNotFound"
+)
+
+// Defines values for DirectorySyncReportSource.
+const (
+	Ldap DirectorySyncReportSource = "ldap"
+	Scim DirectorySyncReportSource = "scim"
+)
+
 // Defines values for EndpointURLStatusType.
 const (
+	EndpointURLStatusTypeAMQP      EndpointURLStatusType = "AMQP"
 	EndpointURLStatusTypeGRPC      EndpointURLStatusType = "gRPC"
 	EndpointURLStatusTypeGraphQL   EndpointURLStatusType = "GraphQL"
 	EndpointURLStatusTypeHTTP      EndpointURLStatusType = "HTTP"
+	EndpointURLStatusTypeKafka     EndpointURLStatusType = "Kafka"
+	EndpointURLStatusTypeNATS      EndpointURLStatusType = "NATS"
 	EndpointURLStatusTypeTCP       EndpointURLStatusType = "TCP"
 	EndpointURLStatusTypeUDP       EndpointURLStatusType = "UDP"
 	EndpointURLStatusTypeWebsocket EndpointURLStatusType = "Websocket"
@@ -212,6 +311,8 @@ const (
 	INTERNALERROR        ErrorResponseCode = "INTERNAL_ERROR"
 	NOTFOUND             ErrorResponseCode = "NOT_FOUND"
 	NOTIMPLEMENTED       ErrorResponseCode = "NOT_IMPLEMENTED"
+	PAYLOADTOOLARGE      ErrorResponseCode = "PAYLOAD_TOO_LARGE"
+	QUOTAEXCEEDED        ErrorResponseCode = "QUOTA_EXCEEDED"
 	UNAUTHORIZED         ErrorResponseCode = "UNAUTHORIZED"
 	UNKNOWNGITPROVIDER   ErrorResponseCode = "UNKNOWN_GIT_PROVIDER"
 	UNPROCESSABLECONTENT ErrorResponseCode = "UNPROCESSABLE_CONTENT"
@@ -222,6 +323,41 @@ const (
 	ExternalRefKindSecretReference ExternalRefKind = "SecretReference"
 )
 
+// Defines values for HistoricalDeploymentStatus.
+const (
+	HistoricalDeploymentStatusFailed      HistoricalDeploymentStatus = "Failed"
+	HistoricalDeploymentStatusNotDeployed HistoricalDeploymentStatus = "NotDeployed"
+	HistoricalDeploymentStatusProgressing HistoricalDeploymentStatus = "Progressing"
+	HistoricalDeploymentStatusReady       HistoricalDeploymentStatus = "Ready"
+	HistoricalDeploymentStatusUnknown     HistoricalDeploymentStatus = "Unknown"
+)
+
+// Defines values for LifecycleHookFailurePolicy.
+const (
+	LifecycleHookFailurePolicyFail   LifecycleHookFailurePolicy = "Fail"
+	LifecycleHookFailurePolicyIgnore LifecycleHookFailurePolicy = "Ignore"
+)
+
+// Defines values for LifecycleHookPhase.
+const (
+	PostDeploy LifecycleHookPhase = "PostDeploy"
+	PreDeploy  LifecycleHookPhase = "PreDeploy"
+)
+
+// Defines values for LifecycleHookStatusState.
+const (
+	LifecycleHookStatusStateFailed    LifecycleHookStatusState = "Failed"
+	LifecycleHookStatusStatePending   LifecycleHookStatusState = "Pending"
+	LifecycleHookStatusStateRunning   LifecycleHookStatusState = "Running"
+	LifecycleHookStatusStateSucceeded LifecycleHookStatusState = "Succeeded"
+)
+
+// Defines values for LogParsingProfileFormat.
+const (
+	JSON  LogParsingProfileFormat = "JSON"
+	Regex LogParsingProfileFormat = "Regex"
+)
+
 // Defines values for NamespaceStatusPhase.
 const (
 	NamespaceStatusPhaseActive      NamespaceStatusPhase = "Active"
@@ -240,12 +376,47 @@ const (
 	ObservabilityPlaneRefKindObservabilityPlane        ObservabilityPlaneRefKind = "ObservabilityPlane"
 )
 
+// Defines values for OrphanedResourceReason.
+const (
+	NotInInventory  OrphanedResourceReason = "NotInInventory"
+	ReleaseNotFound OrphanedResourceReason = "ReleaseNotFound"
+)
+
 // Defines values for PostRenderValidationTargetPlane.
 const (
 	PostRenderValidationTargetPlaneDataplane          PostRenderValidationTargetPlane = "dataplane"
 	PostRenderValidationTargetPlaneObservabilityplane PostRenderValidationTargetPlane = "observabilityplane"
 )
 
+// Defines values for PreWarmWindowDays.
+const (
+	Fri PreWarmWindowDays = "Fri"
+	Mon PreWarmWindowDays = "Mon"
+	Sat PreWarmWindowDays = "Sat"
+	Sun PreWarmWindowDays = "Sun"
+	Thu PreWarmWindowDays = "Thu"
+	Tue PreWarmWindowDays = "Tue"
+	Wed PreWarmWindowDays = "Wed"
+)
+
+// Defines values for ProjectPromotionStatusPhase.
+const (
+	ProjectPromotionStatusPhaseFailed          ProjectPromotionStatusPhase = "Failed"
+	ProjectPromotionStatusPhasePartiallyFailed ProjectPromotionStatusPhase = "PartiallyFailed"
+	ProjectPromotionStatusPhasePending         ProjectPromotionStatusPhase = "Pending"
+	ProjectPromotionStatusPhaseProgressing     ProjectPromotionStatusPhase = "Progressing"
+	ProjectPromotionStatusPhaseRolledBack      ProjectPromotionStatusPhase = "RolledBack"
+	ProjectPromotionStatusPhaseSucceeded       ProjectPromotionStatusPhase = "Succeeded"
+)
+
+// Defines values for ProjectQuotaExceededErrorReason.
+const (
+	CPU          ProjectQuotaExceededErrorReason = "CPU"
+	Components   ProjectQuotaExceededErrorReason = "Components"
+	Environments ProjectQuotaExceededErrorReason = "Environments"
+	Memory       ProjectQuotaExceededErrorReason = "Memory"
+)
+
 // Defines values for ProjectReleaseSpecProjectTypeKind.
 const (
 	ProjectReleaseSpecProjectTypeKindClusterProjectType ProjectReleaseSpecProjectTypeKind = "ClusterProjectType"
@@ -274,12 +445,60 @@ const (
 	PromotionPathSourceEnvironmentRefKindEnvironment PromotionPathSourceEnvironmentRefKind = "Environment"
 )
 
+// Defines values for PromotionStatusPhase.
+const (
+	PromotionStatusPhaseFailed          PromotionStatusPhase = "Failed"
+	PromotionStatusPhasePartiallyFailed PromotionStatusPhase = "PartiallyFailed"
+	PromotionStatusPhasePending         PromotionStatusPhase = "Pending"
+	PromotionStatusPhaseProgressing     PromotionStatusPhase = "Progressing"
+	PromotionStatusPhaseSucceeded       PromotionStatusPhase = "Succeeded"
+)
+
+// Defines values for PromotionTargetStatusPhase.
+const (
+	PromotionTargetStatusPhaseBlocked     PromotionTargetStatusPhase = "Blocked"
+	PromotionTargetStatusPhaseFailed      PromotionTargetStatusPhase = "Failed"
+	PromotionTargetStatusPhasePending     PromotionTargetStatusPhase = "Pending"
+	PromotionTargetStatusPhaseProgressing PromotionTargetStatusPhase = "Progressing"
+	PromotionTargetStatusPhaseRolledBack  PromotionTargetStatusPhase = "RolledBack"
+	PromotionTargetStatusPhaseSucceeded   PromotionTargetStatusPhase = "Succeeded"
+)
+
+// Defines values for QuarantinedResourceKind.
+const (
+	QuarantinedResourceKindComponent              QuarantinedResourceKind = "Component"
+	QuarantinedResourceKindReleaseBinding         QuarantinedResourceKind = "ReleaseBinding"
+	QuarantinedResourceKindResource               QuarantinedResourceKind = "Resource"
+	QuarantinedResourceKindResourceReleaseBinding QuarantinedResourceKind = "ResourceReleaseBinding"
+)
+
+// Defines values for QuotaExceededErrorReason.
+const (
+	NamespaceConcurrentBuilds QuotaExceededErrorReason = "NamespaceConcurrentBuilds"
+	WorkflowPlaneQueueFull    QuotaExceededErrorReason = "WorkflowPlaneQueueFull"
+)
+
+// Defines values for ReleaseBindingRolloutPhase.
+const (
+	ReleaseBindingRolloutPhaseCompleted   ReleaseBindingRolloutPhase = "Completed"
+	ReleaseBindingRolloutPhasePaused      ReleaseBindingRolloutPhase = "Paused"
+	ReleaseBindingRolloutPhaseProgressing ReleaseBindingRolloutPhase = "Progressing"
+	ReleaseBindingRolloutPhasePromoting   ReleaseBindingRolloutPhase = "Promoting"
+)
+
 // Defines values for ReleaseBindingSpecState.
 const (
 	ReleaseBindingSpecStateActive   ReleaseBindingSpecState = "Active"
 	ReleaseBindingSpecStateUndeploy ReleaseBindingSpecState = "Undeploy"
 )
 
+// Defines values for ReleaseChangeType.
+const (
+	ReleaseChangeTypeAdded    ReleaseChangeType = "Added"
+	ReleaseChangeTypeModified ReleaseChangeType = "Modified"
+	ReleaseChangeTypeRemoved  ReleaseChangeType = "Removed"
+)
+
 // Defines values for ReleaseResourceTreeTargetPlane.
 const (
 	ReleaseResourceTreeTargetPlaneDataplane          ReleaseResourceTreeTargetPlane = "dataplane"
@@ -301,6 +520,13 @@ const (
 	RenderedReleaseStatusResourcesHealthStatusUnknown     RenderedReleaseStatusResourcesHealthStatus = "Unknown"
 )
 
+// Defines values for RenderedResourceDiffType.
+const (
+	RenderedResourceDiffTypeAdded    RenderedResourceDiffType = "Added"
+	RenderedResourceDiffTypeModified RenderedResourceDiffType = "Modified"
+	RenderedResourceDiffTypeRemoved  RenderedResourceDiffType = "Removed"
+)
+
 // Defines values for ResolvedConnectionVisibility.
 const (
 	ResolvedConnectionVisibilityExternal  ResolvedConnectionVisibility = "external"
@@ -333,6 +559,19 @@ const (
 	ResourceTypeSpecRetainPolicyRetain ResourceTypeSpecRetainPolicy = "Retain"
 )
 
+// Defines values for RolloutStrategyType.
+const (
+	BlueGreen RolloutStrategyType = "BlueGreen"
+	Canary    RolloutStrategyType = "Canary"
+)
+
+// Defines values for SecretExpiryFindingSeverity.
+const (
+	SecretExpiryFindingSeverityCritical SecretExpiryFindingSeverity = "Critical"
+	SecretExpiryFindingSeverityExpired  SecretExpiryFindingSeverity = "Expired"
+	SecretExpiryFindingSeverityWarning  SecretExpiryFindingSeverity = "Warning"
+)
+
 // Defines values for SecretTemplateType.
 const (
 	SecretTemplateTypeBootstrapKubernetesIotoken   SecretTemplateType = "bootstrap.kubernetes.io/token"
@@ -397,20 +636,55 @@ const (
 	Observabilityplane TraitSpecPatchesTargetPlane = "observabilityplane"
 )
 
+// Defines values for WorkflowCredentialType.
+const (
+	WorkflowCredentialTypeGitRead      WorkflowCredentialType = "GitRead"
+	WorkflowCredentialTypeRegistryPush WorkflowCredentialType = "RegistryPush"
+)
+
+// Defines values for WorkflowCredentialResponseType.
+const (
+	WorkflowCredentialResponseTypeGitRead      WorkflowCredentialResponseType = "GitRead"
+	WorkflowCredentialResponseTypeRegistryPush WorkflowCredentialResponseType = "RegistryPush"
+)
+
+// Defines values for WorkflowPlaneQueueUsageKind.
+const (
+	WorkflowPlaneQueueUsageKindClusterWorkflowPlane WorkflowPlaneQueueUsageKind = "ClusterWorkflowPlane"
+	WorkflowPlaneQueueUsageKindWorkflowPlane        WorkflowPlaneQueueUsageKind = "WorkflowPlane"
+)
+
 // Defines values for WorkflowPlaneRefKind.
 const (
 	WorkflowPlaneRefKindClusterWorkflowPlane WorkflowPlaneRefKind = "ClusterWorkflowPlane"
 	WorkflowPlaneRefKindWorkflowPlane        WorkflowPlaneRefKind = "WorkflowPlane"
 )
 
+// Defines values for WorkflowRetryPolicyRetryOn.
+const (
+	AllFailures          WorkflowRetryPolicyRetryOn = "AllFailures"
+	InfrastructureErrors WorkflowRetryPolicyRetryOn = "InfrastructureErrors"
+)
+
 // Defines values for WorkflowRunConfigKind.
 const (
 	WorkflowRunConfigKindClusterWorkflow WorkflowRunConfigKind = "ClusterWorkflow"
 	WorkflowRunConfigKindWorkflow        WorkflowRunConfigKind = "Workflow"
 )
 
+// Defines values for WorkflowRunProgressResponseStatus.
+const (
+	WorkflowRunProgressResponseStatusCancelled WorkflowRunProgressResponseStatus = "Cancelled"
+	WorkflowRunProgressResponseStatusError     WorkflowRunProgressResponseStatus = "Error"
+	WorkflowRunProgressResponseStatusFailed    WorkflowRunProgressResponseStatus = "Failed"
+	WorkflowRunProgressResponseStatusPending   WorkflowRunProgressResponseStatus = "Pending"
+	WorkflowRunProgressResponseStatusRunning   WorkflowRunProgressResponseStatus = "Running"
+	WorkflowRunProgressResponseStatusSucceeded WorkflowRunProgressResponseStatus = "Succeeded"
+)
+
 // Defines values for WorkflowRunStatusResponseStatus.
 const (
+	WorkflowRunStatusResponseStatusCancelled WorkflowRunStatusResponseStatus = "Cancelled"
 	WorkflowRunStatusResponseStatusError     WorkflowRunStatusResponseStatus = "Error"
 	WorkflowRunStatusResponseStatusFailed    WorkflowRunStatusResponseStatus = "Failed"
 	WorkflowRunStatusResponseStatusPending   WorkflowRunStatusResponseStatus = "Pending"
@@ -418,6 +692,25 @@ const (
 	WorkflowRunStatusResponseStatusSucceeded WorkflowRunStatusResponseStatus = "Succeeded"
 )
 
+// Defines values for WorkflowStepProgressPhase.
+const (
+	WorkflowStepProgressPhaseError     WorkflowStepProgressPhase = "Error"
+	WorkflowStepProgressPhaseFailed    WorkflowStepProgressPhase = "Failed"
+	WorkflowStepProgressPhasePending   WorkflowStepProgressPhase = "Pending"
+	WorkflowStepProgressPhaseRunning   WorkflowStepProgressPhase = "Running"
+	WorkflowStepProgressPhaseSkipped   WorkflowStepProgressPhase = "Skipped"
+	WorkflowStepProgressPhaseSucceeded WorkflowStepProgressPhase = "Succeeded"
+)
+
+// Defines values for WorkflowStepProgressStage.
+const (
+	Build WorkflowStepProgressStage = "build"
+	Clone WorkflowStepProgressStage = "clone"
+	Other WorkflowStepProgressStage = "other"
+	Push  WorkflowStepProgressStage = "push"
+	Test  WorkflowStepProgressStage = "test"
+)
+
 // Defines values for WorkflowStepStatusPhase.
 const (
 	WorkflowStepStatusPhaseError     WorkflowStepStatusPhase = "Error"
@@ -428,17 +721,33 @@ const (
 	WorkflowStepStatusPhaseSucceeded WorkflowStepStatusPhase = "Succeeded"
 )
 
+// Defines values for WorkflowTestSpecFailurePolicy.
+const (
+	WorkflowTestSpecFailurePolicyBlockRelease WorkflowTestSpecFailurePolicy = "BlockRelease"
+	WorkflowTestSpecFailurePolicyIgnore       WorkflowTestSpecFailurePolicy = "Ignore"
+)
+
 // Defines values for WorkloadConnectionVisibility.
 const (
 	WorkloadConnectionVisibilityNamespace WorkloadConnectionVisibility = "namespace"
 	WorkloadConnectionVisibilityProject   WorkloadConnectionVisibility = "project"
 )
 
+// Defines values for WorkloadDiagnosisReason.
+const (
+	CrashLoopBackOff WorkloadDiagnosisReason = "CrashLoopBackOff"
+	ImagePullFailed  WorkloadDiagnosisReason = "ImagePullFailed"
+	OOMKilled        WorkloadDiagnosisReason = "OOMKilled"
+)
+
 // Defines values for WorkloadEndpointType.
 const (
+	WorkloadEndpointTypeAMQP      WorkloadEndpointType = "AMQP"
 	WorkloadEndpointTypeGRPC      WorkloadEndpointType = "gRPC"
 	WorkloadEndpointTypeGraphQL   WorkloadEndpointType = "GraphQL"
 	WorkloadEndpointTypeHTTP      WorkloadEndpointType = "HTTP"
+	WorkloadEndpointTypeKafka     WorkloadEndpointType = "Kafka"
+	WorkloadEndpointTypeNATS      WorkloadEndpointType = "NATS"
 	WorkloadEndpointTypeTCP       WorkloadEndpointType = "TCP"
 	WorkloadEndpointTypeUDP       WorkloadEndpointType = "UDP"
 	WorkloadEndpointTypeWebsocket WorkloadEndpointType = "Websocket"
@@ -452,6 +761,41 @@ const (
 	WorkloadEndpointVisibilityProject   WorkloadEndpointVisibility = "project"
 )
 
+// Defines values for WorkloadIdentityConfigProvider.
+const (
+	WorkloadIdentityConfigProviderAWS   WorkloadIdentityConfigProvider = "AWS"
+	WorkloadIdentityConfigProviderAzure WorkloadIdentityConfigProvider = "Azure"
+	WorkloadIdentityConfigProviderGCP   WorkloadIdentityConfigProvider = "GCP"
+)
+
+// Defines values for SearchParamsKind.
+const (
+	SearchParamsKindComponent SearchParamsKind = "component"
+)
+
+// Defines values for SearchParamsFacets.
+const (
+	SearchParamsFacetsDomain  SearchParamsFacets = "domain"
+	SearchParamsFacetsEnv     SearchParamsFacets = "env"
+	SearchParamsFacetsProject SearchParamsFacets = "project"
+	SearchParamsFacetsTeam    SearchParamsFacets = "team"
+	SearchParamsFacetsTier    SearchParamsFacets = "tier"
+	SearchParamsFacetsType    SearchParamsFacets = "type"
+)
+
+// APIVersionPreference Selects the API version of rendered resources of an API group. A preference for a kind
+// takes precedence over a preference for the whole group.
+type APIVersionPreference struct {
+	// Group API group of the resources; the empty string selects the core group
+	Group string `json:"group"`
+
+	// Kind Restricts the preference to resources of this kind
+	Kind *string `json:"kind,omitempty"`
+
+	// Version API version to render the resources with
+	Version string `json:"version"`
+}
+
 // ActionCapability Capabilities for a specific action
 type ActionCapability struct {
 	// Allowed Resources where action is allowed
@@ -497,6 +841,55 @@ type AgentConnectionStatus struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// AnalysisFinding A single static analysis finding
+type AnalysisFinding struct {
+	File *string `json:"file,omitempty"`
+	Line *int32  `json:"line,omitempty"`
+
+	// Message Finding message, truncated to 1024 characters
+	Message *string `json:"message,omitempty"`
+	RuleId  *string `json:"ruleId,omitempty"`
+
+	// Severity Severity of a static analysis finding
+	Severity AnalysisSeverity `json:"severity"`
+	Tool     string           `json:"tool"`
+}
+
+// AnalysisQualityGate Maximum number of static analysis findings per severity that the latest workflow run of a
+// component may report before release creation is blocked. Unset thresholds are not enforced.
+type AnalysisQualityGate struct {
+	MaxCritical *int32 `json:"maxCritical,omitempty"`
+	MaxHigh     *int32 `json:"maxHigh,omitempty"`
+	MaxLow      *int32 `json:"maxLow,omitempty"`
+	MaxMedium   *int32 `json:"maxMedium,omitempty"`
+}
+
+// AnalysisSeverity Severity of a static analysis finding
+type AnalysisSeverity string
+
+// AsyncEndpoint Message channel of a Kafka, NATS or AMQP endpoint. Async endpoints get no Service port or gateway route; connections to them resolve to the broker.
+type AsyncEndpoint struct {
+	// Broker Host of the message broker
+	Broker string `json:"broker"`
+
+	// Channel Kafka topic, NATS subject or AMQP queue
+	Channel string `json:"channel"`
+
+	// KafkaTopic Provisions the topic on the data plane as a Strimzi KafkaTopic (Kafka only)
+	KafkaTopic *struct {
+		// Cluster Name of the Strimzi Kafka cluster that owns the topic
+		Cluster string `json:"cluster"`
+
+		// Config Kafka topic configuration such as retention.ms
+		Config *map[string]string `json:"config,omitempty"`
+
+		// Namespace Namespace watched by the Strimzi topic operator; defaults to the component namespace
+		Namespace  *string `json:"namespace,omitempty"`
+		Partitions *int32  `json:"partitions,omitempty"`
+		Replicas   *int32  `json:"replicas,omitempty"`
+	} `json:"kafkaTopic,omitempty"`
+}
+
 // AuthMechanismConfig Configuration for an authentication mechanism
 type AuthMechanismConfig struct {
 	// Entitlement Configuration for extracting entitlement claims from tokens
@@ -663,6 +1056,60 @@ type AuthzScope struct {
 	Project *string `json:"project,omitempty"`
 }
 
+// AutoRollbackPolicy Post-deploy health check that rolls a newly bound release back to the last healthy release when it does not become Ready, or when it stays degraded after it became Ready
+type AutoRollbackPolicy struct {
+	// DegradedTimeout How long the resources of a release that became Ready may stay degraded before spec.releaseName is re-pointed to the previously healthy release. Degraded releases are not rolled back when unset.
+	DegradedTimeout *string `json:"degradedTimeout,omitempty"`
+
+	// Enabled Turns on the health check and automatic rollback
+	Enabled bool `json:"enabled"`
+
+	// HealthCheckTimeout How long a newly bound release may take to become Ready before it is rolled back. Defaults to 10m.
+	HealthCheckTimeout *string `json:"healthCheckTimeout,omitempty"`
+}
+
+// BuildNodePreWarmSpec Standby build nodes kept warm during business hours
+type BuildNodePreWarmSpec struct {
+	// Image Container image of the placeholder pods; defaults to registry.k8s.io/pause:3.10
+	Image *string `json:"image,omitempty"`
+
+	// Namespace Namespace of the placeholder pods; defaults to openchoreo-workflow-plane
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Nodes Number of standby build nodes kept during business hours
+	Nodes int32 `json:"nodes"`
+
+	// Resources Resources reserved by each placeholder pod
+	Resources map[string]string `json:"resources"`
+
+	// Schedule Business hours during which standby nodes are kept
+	Schedule []PreWarmWindow `json:"schedule"`
+
+	// TimeZone IANA time zone the schedule is evaluated in; defaults to UTC
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// BuildQuota Concurrent component build usage of a namespace and the build queues available to it
+type BuildQuota struct {
+	// ActiveBuilds Component builds of the namespace that are pending or running
+	ActiveBuilds int32 `json:"activeBuilds"`
+
+	// MaxConcurrentBuilds Limit on pending or running component builds, set by the openchoreo.dev/max-concurrent-builds namespace annotation. Unset when builds are not limited.
+	MaxConcurrentBuilds *int32 `json:"maxConcurrentBuilds,omitempty"`
+
+	// Namespace Namespace name
+	Namespace string `json:"namespace"`
+
+	// WorkflowPlanes Build queues of the workflow planes available to the namespace
+	WorkflowPlanes []WorkflowPlaneQueueUsage `json:"workflowPlanes"`
+}
+
+// CancelWorkflowRunRequest Request to cancel a workflow run
+type CancelWorkflowRunRequest struct {
+	// Reason Why the run is cancelled
+	Reason *string `json:"reason,omitempty"`
+}
+
 // CapabilityConstraints CEL expressions constraining access for a given action and resource path. Multiple expressions are OR'd.
 type CapabilityConstraints struct {
 	// Expressions CEL expressions; access is granted if any one evaluates to true
@@ -825,9 +1272,17 @@ type ClusterComponentTypeSpec struct {
 		Name string `json:"name"`
 	} `json:"allowedWorkflows,omitempty"`
 
+	// DisruptionBudget Controls the PodDisruptionBudgets generated for multi-replica Deployments and
+	// StatefulSets. A component type enables them for production environments; a
+	// release binding can override the policy for its environment.
+	DisruptionBudget *DisruptionBudgetPolicy `json:"disruptionBudget,omitempty"`
+
 	// EnvironmentConfigs Schema section using openAPIV3Schema format
 	EnvironmentConfigs *SchemaSection `json:"environmentConfigs,omitempty"`
 
+	// Hooks Jobs run around the rollout of workload changes, such as schema migrations or cache warmups
+	Hooks *[]LifecycleHook `json:"hooks,omitempty"`
+
 	// Parameters Schema section using openAPIV3Schema format
 	Parameters *SchemaSection `json:"parameters,omitempty"`
 
@@ -837,8 +1292,15 @@ type ClusterComponentTypeSpec struct {
 	// PreRenderValidations CEL-based validation rules evaluated before rendering; replaces the deprecated validations field
 	PreRenderValidations *[]ValidationRule `json:"preRenderValidations,omitempty"`
 
+	// QualityGate Maximum number of static analysis findings per severity that the latest workflow run of a
+	// component may report before release creation is blocked. Unset thresholds are not enforced.
+	QualityGate *AnalysisQualityGate `json:"qualityGate,omitempty"`
+
+	// RequiredEnv Environment variables that components of this type must set before a release binding is rendered
+	RequiredEnv *[]RequiredEnvVar `json:"requiredEnv,omitempty"`
+
 	// Resources Templates that generate Kubernetes resources dynamically
-	Resources []struct {
+	Resources *[]struct {
 		// ForEach CEL expression for generating multiple resources from a list
 		ForEach *string `json:"forEach,omitempty"`
 
@@ -856,7 +1318,7 @@ type ClusterComponentTypeSpec struct {
 
 		// Var Loop variable name when using forEach
 		Var *string `json:"var,omitempty"`
-	} `json:"resources"`
+	} `json:"resources,omitempty"`
 
 	// Traits Pre-configured trait instances embedded in this component type
 	Traits *[]struct {
@@ -879,7 +1341,12 @@ type ClusterComponentTypeSpec struct {
 	// Validations CEL-based validation rules evaluated before rendering. Deprecated: use preRenderValidations (mutually exclusive).
 	Validations *[]ValidationRule `json:"validations,omitempty"`
 
-	// WorkloadType Primary workload resource type for this component type
+	// WorkloadIdentity Cloud identity the workloads of a component type assume through the workload
+	// identity provider of the data plane they are deployed to.
+	WorkloadIdentity *WorkloadIdentityRequest `json:"workloadIdentity,omitempty"`
+
+	// WorkloadType Primary workload resource type for this component type. Components of a library
+	// type are built and publish versions, but are never deployed.
 	WorkloadType ClusterComponentTypeSpecWorkloadType `json:"workloadType"`
 }
 
@@ -895,7 +1362,8 @@ type ClusterComponentTypeSpecResourcesTargetPlane string
 // ClusterComponentTypeSpecTraitsKind Kind of trait (only ClusterTrait allowed for cluster-scoped)
 type ClusterComponentTypeSpecTraitsKind string
 
-// ClusterComponentTypeSpecWorkloadType Primary workload resource type for this component type
+// ClusterComponentTypeSpecWorkloadType Primary workload resource type for this component type. Components of a library
+// type are built and publish versions, but are never deployed.
 type ClusterComponentTypeSpecWorkloadType string
 
 // ClusterComponentTypeStatus Observed state of a ClusterComponentType
@@ -930,6 +1398,9 @@ type ClusterDataPlaneList struct {
 
 // ClusterDataPlaneSpec Desired state of a ClusterDataPlane
 type ClusterDataPlaneSpec struct {
+	// ApiVersions API versions pinned for the resources rendered to the data plane
+	ApiVersions *[]APIVersionPreference `json:"apiVersions,omitempty"`
+
 	// ClusterAgent Configuration for cluster agent-based communication
 	ClusterAgent *ClusterAgentConfig `json:"clusterAgent,omitempty"`
 
@@ -943,8 +1414,27 @@ type ClusterDataPlaneSpec struct {
 	// Multiple ClusterDataPlane CRs can share the same planeID.
 	PlaneID *string `json:"planeID,omitempty"`
 
+	// Region Region the data plane cluster runs in, inherited by its environments
+	Region *string `json:"region,omitempty"`
+
+	// RegistryCredentials Private container registry credentials made available to every deployed component
+	RegistryCredentials *[]RegistryCredential `json:"registryCredentials,omitempty"`
+
+	// Scheduling Controls where component pods are scheduled. Policies from the data plane,
+	// environment and release binding are merged in that order.
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+
 	// SecretStoreRef Reference to an External Secrets Operator ClusterSecretStore
 	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
+
+	// Tenancy Restricts which namespaces and projects may use a shared data plane. Entries are glob
+	// patterns; projects are written as <namespace>/<project>. Denied entries take precedence
+	// and an empty allow list admits every tenant.
+	Tenancy *TenancyPolicy `json:"tenancy,omitempty"`
+
+	// WorkloadIdentity Cloud workload identity federation of a data plane. Components whose component
+	// type requests a workload identity run as a ServiceAccount annotated for the provider.
+	WorkloadIdentity *WorkloadIdentityConfig `json:"workloadIdentity,omitempty"`
 }
 
 // ClusterDataPlaneStatus Observed state of a ClusterDataPlane
@@ -952,9 +1442,15 @@ type ClusterDataPlaneStatus struct {
 	// AgentConnection Status of cluster agent connections
 	AgentConnection *AgentConnectionStatus `json:"agentConnection,omitempty"`
 
+	// CapacityReservations Per-tenant capacity reservations parsed from the capacity-reservation annotations
+	CapacityReservations *[]TenantCapacityReservation `json:"capacityReservations,omitempty"`
+
 	// Conditions Current state conditions of the ClusterDataPlane
 	Conditions *[]Condition `json:"conditions,omitempty"`
 
+	// NodeLabels Node labels discovered on the data plane cluster
+	NodeLabels *[]NodeLabel `json:"nodeLabels,omitempty"`
+
 	// ObservedGeneration Generation of the most recently observed ClusterDataPlane
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 }
@@ -1272,6 +1768,10 @@ type ClusterWorkflowPlaneRefKind string
 
 // ClusterWorkflowPlaneSpec Desired state of a ClusterWorkflowPlane
 type ClusterWorkflowPlaneSpec struct {
+	// BuildNodes Dedicated build nodes of a workflow plane. The placement is added to every
+	// workflow run executed on the plane.
+	BuildNodes *WorkflowBuildNodesSpec `json:"buildNodes,omitempty"`
+
 	// ClusterAgent Configuration for cluster agent-based communication
 	ClusterAgent *ClusterAgentConfig `json:"clusterAgent,omitempty"`
 
@@ -1282,6 +1782,9 @@ type ClusterWorkflowPlaneSpec struct {
 	// Multiple ClusterWorkflowPlane CRs can share the same planeID.
 	PlaneID *string `json:"planeID,omitempty"`
 
+	// Scheduling Build queue configuration of a workflow plane
+	Scheduling *WorkflowSchedulingSpec `json:"scheduling,omitempty"`
+
 	// SecretStoreRef Reference to an External Secrets Operator ClusterSecretStore
 	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
 }
@@ -1296,10 +1799,19 @@ type ClusterWorkflowPlaneStatus struct {
 
 	// ObservedGeneration Generation of the most recently observed ClusterWorkflowPlane
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// StandbyBuildNodes Standby build nodes kept warm on a workflow plane
+	StandbyBuildNodes *StandbyBuildNodesStatus `json:"standbyBuildNodes,omitempty"`
 }
 
 // ClusterWorkflowSpec Desired state of a ClusterWorkflow
 type ClusterWorkflowSpec struct {
+	// Analysis Static analysis step of a workflow whose SARIF report is collected into the run status
+	Analysis *WorkflowAnalysisSpec `json:"analysis,omitempty"`
+
+	// Credentials Short-lived credentials that runs exchange their broker token for instead of mounting static secrets
+	Credentials *[]WorkflowCredential `json:"credentials,omitempty"`
+
 	// ExternalRefs External CR references resolved and injected into the CEL context under their id.
 	ExternalRefs *[]ExternalRef `json:"externalRefs,omitempty"`
 
@@ -1309,9 +1821,18 @@ type ClusterWorkflowSpec struct {
 	// Resources Additional resource templates to render and apply alongside the workflow run.
 	Resources *[]WorkflowResource `json:"resources,omitempty"`
 
+	// RetryPolicy How failed workflow runs are resubmitted. Cancelled and timed out runs are not retried.
+	RetryPolicy *WorkflowRetryPolicy `json:"retryPolicy,omitempty"`
+
 	// RunTemplate Kubernetes resource template to render and apply for this workflow run.
 	RunTemplate map[string]interface{} `json:"runTemplate"`
 
+	// Tests Test step of a workflow whose JUnit report is collected into the run status
+	Tests *WorkflowTestSpec `json:"tests,omitempty"`
+
+	// Timeout Maximum time a run may execute on the workflow plane (duration string like 1h or 30m).
+	Timeout *string `json:"timeout,omitempty"`
+
 	// TtlAfterCompletion Time-to-live for WorkflowRun instances after completion (duration string like 10d1h30m).
 	TtlAfterCompletion *string `json:"ttlAfterCompletion,omitempty"`
 
@@ -1343,6 +1864,35 @@ type Component struct {
 	Status *ComponentStatus `json:"status,omitempty"`
 }
 
+// ComponentCatalog Service registry metadata of a component. Team, domain and tier are propagated as
+// openchoreo.dev/team, openchoreo.dev/domain and openchoreo.dev/tier labels to the component
+// and to its rendered resources, so components can be filtered with label selectors.
+type ComponentCatalog struct {
+	// Domain Business domain the component belongs to
+	Domain *string `json:"domain,omitempty"`
+
+	// OnCall Link to the on-call schedule of the owning team
+	OnCall *string `json:"onCall,omitempty"`
+
+	// Repository URL of the component's source repository
+	Repository *string `json:"repository,omitempty"`
+
+	// Team Team that owns the component
+	Team *string `json:"team,omitempty"`
+
+	// Tier Criticality tier of the component
+	Tier *string `json:"tier,omitempty"`
+}
+
+// ComponentDeploymentStatus Deployment status of a component, keyed by environment name
+type ComponentDeploymentStatus struct {
+	// Environments Status in each environment the component is bound to
+	Environments map[string]EnvironmentDeploymentStatus `json:"environments"`
+
+	// Name Component name
+	Name string `json:"name"`
+}
+
 // ComponentList Paginated list of components
 type ComponentList struct {
 	Items []Component `json:"items"`
@@ -1352,6 +1902,33 @@ type ComponentList struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// ComponentObserverURL Observer that serves the observability data of a component in an environment
+type ComponentObserverURL struct {
+	Component   string `json:"component"`
+	Environment string `json:"environment"`
+
+	// ObserverURL Base URL of the Observer API in the observability plane cluster
+	ObserverURL string `json:"observerURL"`
+}
+
+// ComponentPromotionResult Promotion result of a single component of a project promotion
+type ComponentPromotionResult struct {
+	Component string `json:"component"`
+
+	// Message Why the component was skipped
+	Message *string `json:"message,omitempty"`
+
+	// Phase Skipped when the component has no release in the source environment, RolledBack when its promoted targets were restored, otherwise aggregated over its targets like the phase of a component promotion.
+	Phase ComponentPromotionResultPhase `json:"phase"`
+
+	// Release Release bound in the source environment; omitted when there is none
+	Release *string                 `json:"release,omitempty"`
+	Targets []PromotionTargetStatus `json:"targets"`
+}
+
+// ComponentPromotionResultPhase Skipped when the component has no release in the source environment, RolledBack when its promoted targets were restored, otherwise aggregated over its targets like the phase of a component promotion.
+type ComponentPromotionResultPhase string
+
 // ComponentRelease ComponentRelease resource.
 // Immutable snapshot of component state at release time.
 type ComponentRelease struct {
@@ -1372,6 +1949,30 @@ type ComponentRelease struct {
 	Status *map[string]interface{} `json:"status,omitempty"`
 }
 
+// ComponentReleaseDiff Differences between two ComponentReleases of the same component
+type ComponentReleaseDiff struct {
+	// BaseRelease ComponentRelease the changes are relative to
+	BaseRelease string `json:"baseRelease"`
+
+	// Changes Component type, parameter, trait and workload changes
+	Changes []ReleaseChange `json:"changes"`
+
+	// Component Component the releases belong to
+	Component string `json:"component"`
+
+	// Environment Environment the releases were rendered for
+	Environment *string `json:"environment,omitempty"`
+
+	// Project Project of the component
+	Project string `json:"project"`
+
+	// Resources Rendered resources that were added, removed or modified. Only set when an environment is given.
+	Resources *[]RenderedResourceDiff `json:"resources,omitempty"`
+
+	// TargetRelease ComponentRelease compared against the base release
+	TargetRelease string `json:"targetRelease"`
+}
+
 // ComponentReleaseList Paginated list of component releases
 type ComponentReleaseList struct {
 	Items []ComponentRelease `json:"items"`
@@ -1381,6 +1982,19 @@ type ComponentReleaseList struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// ComponentReleasePrune ComponentReleases deleted by a prune
+type ComponentReleasePrune struct {
+	Component string `json:"component"`
+
+	// Deleted Releases that were deleted, or would be in a dry run, oldest first
+	Deleted []string `json:"deleted"`
+	DryRun  bool     `json:"dryRun"`
+
+	// InUse Releases older than the newest keep that were kept because they are in use, oldest first
+	InUse   []string `json:"inUse"`
+	Project string   `json:"project"`
+}
+
 // ComponentReleaseSpec Desired state of a ComponentRelease
 type ComponentReleaseSpec struct {
 	// ComponentProfile Snapshot of component parameters and trait configs
@@ -1419,6 +2033,11 @@ type ComponentSpec struct {
 	// AutoDeploy Whether to automatically deploy to default environment when created
 	AutoDeploy *bool `json:"autoDeploy,omitempty"`
 
+	// Catalog Service registry metadata of a component. Team, domain and tier are propagated as
+	// openchoreo.dev/team, openchoreo.dev/domain and openchoreo.dev/tier labels to the component
+	// and to its rendered resources, so components can be filtered with label selectors.
+	Catalog *ComponentCatalog `json:"catalog,omitempty"`
+
 	// ComponentType Reference to the ComponentType or ClusterComponentType
 	ComponentType struct {
 		// Kind Kind of component type (ComponentType or ClusterComponentType)
@@ -1428,6 +2047,14 @@ type ComponentSpec struct {
 		Name string `json:"name"`
 	} `json:"componentType"`
 
+	// Libraries Published versions of library components this component depends on
+	Libraries *[]LibraryDependency `json:"libraries,omitempty"`
+
+	// LogParsing How the component's log lines are parsed when its logs are queried from the observer.
+	// Parsed fields can be used to filter log queries and the parsed level replaces the
+	// level detected by the logging backend.
+	LogParsing *LogParsingProfile `json:"logParsing,omitempty"`
+
 	// Owner Ownership information for the component
 	Owner struct {
 		// ProjectName Name of the project this component belongs to
@@ -1437,6 +2064,9 @@ type ComponentSpec struct {
 	// Parameters ComponentType parameter values (schema defined by the referenced ComponentType)
 	Parameters *map[string]interface{} `json:"parameters,omitempty"`
 
+	// State Lifecycle state of the component. Archived components keep their definition and release history but are not deployed or built.
+	State *ComponentSpecState `json:"state,omitempty"`
+
 	// Traits Trait instances attached to the component
 	Traits *[]ComponentTrait `json:"traits,omitempty"`
 
@@ -1447,6 +2077,9 @@ type ComponentSpec struct {
 // ComponentSpecComponentTypeKind Kind of component type (ComponentType or ClusterComponentType)
 type ComponentSpecComponentTypeKind string
 
+// ComponentSpecState Lifecycle state of the component. Archived components keep their definition and release history but are not deployed or built.
+type ComponentSpecState string
+
 // ComponentStatus Observed state of a Component
 type ComponentStatus struct {
 	// Conditions Current state conditions of the Component
@@ -1461,6 +2094,9 @@ type ComponentStatus struct {
 		ReleaseHash *string `json:"releaseHash,omitempty"`
 	} `json:"latestRelease,omitempty"`
 
+	// LibraryVersions Versions published by a library component, oldest first
+	LibraryVersions *[]LibraryVersion `json:"libraryVersions,omitempty"`
+
 	// ObservedGeneration Generation of the most recently observed Component
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 }
@@ -1501,6 +2137,30 @@ type ComponentTraitInput struct {
 // ComponentTraitInputKind Kind of trait resource (Trait for namespace-scoped, ClusterTrait for cluster-scoped)
 type ComponentTraitInputKind string
 
+// ComponentTransfer Resources moved by a component transfer
+type ComponentTransfer struct {
+	Component     string `json:"component"`
+	DryRun        bool   `json:"dryRun"`
+	SourceProject string `json:"sourceProject"`
+
+	// Steps Resources moved by the transfer, in the order they were moved
+	Steps         []ComponentTransferStep `json:"steps"`
+	TargetProject string                  `json:"targetProject"`
+}
+
+// ComponentTransferStep Progress of one resource moved by a component transfer
+type ComponentTransferStep struct {
+	Kind string `json:"kind"`
+
+	// Message Why the resource could not be moved or moved back
+	Message *string                     `json:"message,omitempty"`
+	Name    string                      `json:"name"`
+	Status  ComponentTransferStepStatus `json:"status"`
+}
+
+// ComponentTransferStepStatus defines model for ComponentTransferStep.Status.
+type ComponentTransferStepStatus string
+
 // ComponentType ComponentType resource.
 // Defines workload templates used by platform engineers to govern component behavior.
 type ComponentType struct {
@@ -1548,9 +2208,17 @@ type ComponentTypeSpec struct {
 		Name string `json:"name"`
 	} `json:"allowedWorkflows,omitempty"`
 
+	// DisruptionBudget Controls the PodDisruptionBudgets generated for multi-replica Deployments and
+	// StatefulSets. A component type enables them for production environments; a
+	// release binding can override the policy for its environment.
+	DisruptionBudget *DisruptionBudgetPolicy `json:"disruptionBudget,omitempty"`
+
 	// EnvironmentConfigs Schema section using openAPIV3Schema format
 	EnvironmentConfigs *SchemaSection `json:"environmentConfigs,omitempty"`
 
+	// Hooks Jobs run around the rollout of workload changes, such as schema migrations or cache warmups
+	Hooks *[]LifecycleHook `json:"hooks,omitempty"`
+
 	// Parameters Schema section using openAPIV3Schema format
 	Parameters *SchemaSection `json:"parameters,omitempty"`
 
@@ -1560,8 +2228,15 @@ type ComponentTypeSpec struct {
 	// PreRenderValidations CEL-based validation rules evaluated before rendering; replaces the deprecated validations field
 	PreRenderValidations *[]ValidationRule `json:"preRenderValidations,omitempty"`
 
+	// QualityGate Maximum number of static analysis findings per severity that the latest workflow run of a
+	// component may report before release creation is blocked. Unset thresholds are not enforced.
+	QualityGate *AnalysisQualityGate `json:"qualityGate,omitempty"`
+
+	// RequiredEnv Environment variables that components of this type must set before a release binding is rendered
+	RequiredEnv *[]RequiredEnvVar `json:"requiredEnv,omitempty"`
+
 	// Resources Templates that generate Kubernetes resources dynamically
-	Resources []struct {
+	Resources *[]struct {
 		// ForEach CEL expression for generating multiple resources from a list
 		ForEach *string `json:"forEach,omitempty"`
 
@@ -1579,7 +2254,7 @@ type ComponentTypeSpec struct {
 
 		// Var Loop variable name when using forEach
 		Var *string `json:"var,omitempty"`
-	} `json:"resources"`
+	} `json:"resources,omitempty"`
 
 	// Traits Pre-configured trait instances embedded in this component type
 	Traits *[]struct {
@@ -1602,7 +2277,12 @@ type ComponentTypeSpec struct {
 	// Validations CEL-based validation rules evaluated before rendering. Deprecated: use preRenderValidations (mutually exclusive).
 	Validations *[]ValidationRule `json:"validations,omitempty"`
 
-	// WorkloadType Primary workload resource type for this component type
+	// WorkloadIdentity Cloud identity the workloads of a component type assume through the workload
+	// identity provider of the data plane they are deployed to.
+	WorkloadIdentity *WorkloadIdentityRequest `json:"workloadIdentity,omitempty"`
+
+	// WorkloadType Primary workload resource type for this component type. Components of a library
+	// type are built and publish versions, but are never deployed.
 	WorkloadType ComponentTypeSpecWorkloadType `json:"workloadType"`
 }
 
@@ -1618,7 +2298,8 @@ type ComponentTypeSpecResourcesTargetPlane string
 // ComponentTypeSpecTraitsKind Kind of trait (Trait or ClusterTrait)
 type ComponentTypeSpecTraitsKind string
 
-// ComponentTypeSpecWorkloadType Primary workload resource type for this component type
+// ComponentTypeSpecWorkloadType Primary workload resource type for this component type. Components of a library
+// type are built and publish versions, but are never deployed.
 type ComponentTypeSpecWorkloadType string
 
 // ComponentTypeStatus Observed state of a ComponentType
@@ -1634,6 +2315,9 @@ type ComponentWorkflowConfig struct {
 
 	// Parameters Developer-provided parameters for the referenced workflow
 	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+
+	// Version Published workflow version to render runs from. When omitted, the live workflow template is used.
+	Version *string `json:"version,omitempty"`
 }
 
 // ComponentWorkflowConfigKind Kind of referenced workflow resource (Workflow or ClusterWorkflow)
@@ -1687,16 +2371,100 @@ type ConditionAttribute struct {
 	Key string `json:"key"`
 }
 
+// ConditionHistoryEntry A condition transition of a release binding or of its rendered release
+type ConditionHistoryEntry struct {
+	Message *string `json:"message,omitempty"`
+	Reason  *string `json:"reason,omitempty"`
+
+	// SourceKind Kind of the resource whose condition changed
+	SourceKind ConditionHistoryEntrySourceKind `json:"sourceKind"`
+
+	// SourceName Name of the resource whose condition changed
+	SourceName string `json:"sourceName"`
+
+	// Status Status the condition changed to
+	Status         ConditionHistoryEntryStatus `json:"status"`
+	TransitionTime time.Time                   `json:"transitionTime"`
+
+	// Type Type of the condition that changed
+	Type string `json:"type"`
+}
+
+// ConditionHistoryEntrySourceKind Kind of the resource whose condition changed
+type ConditionHistoryEntrySourceKind string
+
+// ConditionHistoryEntryStatus Status the condition changed to
+type ConditionHistoryEntryStatus string
+
+// ConditionTransition One recorded change of a status condition
+type ConditionTransition struct {
+	// Message Human-readable message of the condition after the change
+	Message *string `json:"message,omitempty"`
+
+	// Reason Machine-readable reason of the condition after the change
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Status the condition changed to
+	Status ConditionTransitionStatus `json:"status"`
+
+	// TransitionTime Time the change was observed
+	TransitionTime time.Time `json:"transitionTime"`
+
+	// Type Type of the condition that changed
+	Type string `json:"type"`
+}
+
+// ConditionTransitionStatus Status the condition changed to
+type ConditionTransitionStatus string
+
+// ConfigChange A change of an env var, file, parameter or trait instance resolved by a release binding
+type ConfigChange struct {
+	// From Previous value rendered as JSON. Empty for added values.
+	From *string `json:"from,omitempty"`
+
+	// Masked Whether from and to are hidden because the value may be a secret
+	Masked *bool `json:"masked,omitempty"`
+
+	// Path Identifies the changed value, e.g. env.LOG_LEVEL, files.app.yaml or traits.hpa.parameters.maxReplicas
+	Path string `json:"path"`
+
+	// To New value rendered as JSON. Empty for removed values.
+	To *string `json:"to,omitempty"`
+
+	// Type Kind of change
+	Type ConfigChangeType `json:"type"`
+}
+
+// ConfigChangeType Kind of change
+type ConfigChangeType string
+
+// ConfigDiff Configuration changes between the previous and current release of a release binding
+type ConfigDiff struct {
+	Changes     []ConfigChange `json:"changes"`
+	Environment string         `json:"environment"`
+
+	// FromRelease Release replaced by the current one; empty when the current release is the first deployed
+	FromRelease    *string `json:"fromRelease,omitempty"`
+	ReleaseBinding string  `json:"releaseBinding"`
+
+	// ToRelease Release currently deployed; empty when no release has been deployed
+	ToRelease *string `json:"toRelease,omitempty"`
+}
+
 // ConnectionEnvBindings Maps resolved connection address components to environment variable names
 type ConnectionEnvBindings struct {
 	// Address Env var name for the protocol-appropriate connection string.
 	// For HTTP/HTTPS/WS/WSS: scheme://host:port/basePath
-	// For gRPC/TCP/UDP: host:port
+	// For gRPC/TCP/UDP/Kafka: host:port
+	// For NATS/AMQP: scheme://host:port
 	Address *string `json:"address,omitempty"`
 
 	// BasePath Env var name for just the base path
 	BasePath *string `json:"basePath,omitempty"`
 
+	// Channel Env var name for the Kafka topic, NATS subject or AMQP queue of an async endpoint
+	Channel *string `json:"channel,omitempty"`
+
 	// Host Env var name for just the hostname
 	Host *string `json:"host,omitempty"`
 
@@ -1741,6 +2509,21 @@ type CreateComponentRequest struct {
 	Workflow *ComponentWorkflowInput `json:"workflow,omitempty"`
 }
 
+// CreateDomainMappingRequest Request to attach a custom domain to a public endpoint of a component
+type CreateDomainMappingRequest struct {
+	// Domain Custom domain
+	Domain string `json:"domain"`
+
+	// Endpoint Public endpoint of the component to route the domain to
+	Endpoint string `json:"endpoint"`
+
+	// Environment Environment to serve the domain in
+	Environment string `json:"environment"`
+
+	// Name Name of the domain mapping. Derived from the component and the domain when empty
+	Name *string `json:"name,omitempty"`
+}
+
 // CreateGitSecretRequest Request body for creating a git secret
 type CreateGitSecretRequest struct {
 	// SecretName Name of the git secret
@@ -1844,6 +2627,9 @@ type DataPlaneList struct {
 
 // DataPlaneSpec Desired state of a DataPlane
 type DataPlaneSpec struct {
+	// ApiVersions API versions pinned for the resources rendered to the data plane
+	ApiVersions *[]APIVersionPreference `json:"apiVersions,omitempty"`
+
 	// ClusterAgent Configuration for cluster agent-based communication
 	ClusterAgent *ClusterAgentConfig `json:"clusterAgent,omitempty"`
 
@@ -1857,8 +2643,22 @@ type DataPlaneSpec struct {
 	// Multiple DataPlane CRs can share the same planeID.
 	PlaneID *string `json:"planeID,omitempty"`
 
+	// Region Region the data plane cluster runs in, inherited by its environments
+	Region *string `json:"region,omitempty"`
+
+	// RegistryCredentials Private container registry credentials made available to every deployed component
+	RegistryCredentials *[]RegistryCredential `json:"registryCredentials,omitempty"`
+
+	// Scheduling Controls where component pods are scheduled. Policies from the data plane,
+	// environment and release binding are merged in that order.
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+
 	// SecretStoreRef Reference to an External Secrets Operator ClusterSecretStore
 	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
+
+	// WorkloadIdentity Cloud workload identity federation of a data plane. Components whose component
+	// type requests a workload identity run as a ServiceAccount annotated for the provider.
+	WorkloadIdentity *WorkloadIdentityConfig `json:"workloadIdentity,omitempty"`
 }
 
 // DataPlaneStatus Observed state of a DataPlane
@@ -1869,10 +2669,52 @@ type DataPlaneStatus struct {
 	// Conditions Current state conditions of the DataPlane
 	Conditions *[]Condition `json:"conditions,omitempty"`
 
+	// NodeLabels Node labels discovered on the data plane cluster
+	NodeLabels *[]NodeLabel `json:"nodeLabels,omitempty"`
+
 	// ObservedGeneration Generation of the most recently observed DataPlane
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 }
 
+// DebugCredential A short-lived credential scoped to the data plane resources of a release binding
+type DebugCredential struct {
+	// Access Access level of the credential
+	Access DebugCredentialAccess `json:"access"`
+
+	// ExpiresAt Time after which the token is rejected
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Kubeconfig Kubeconfig using the token, returned when apiServerUrl was given
+	Kubeconfig *string `json:"kubeconfig,omitempty"`
+
+	// Namespace Data plane namespace the credential is scoped to
+	Namespace string `json:"namespace"`
+
+	// ServiceAccount Name of the ServiceAccount the token was issued for
+	ServiceAccount string `json:"serviceAccount"`
+
+	// Token Bearer token for the data plane API server
+	Token string `json:"token"`
+}
+
+// DebugCredentialAccess Access level of the credential
+type DebugCredentialAccess string
+
+// DebugCredentialRequest Request to mint a short-lived debug credential for a release binding
+type DebugCredentialRequest struct {
+	// Access Access level of the credential
+	Access *DebugCredentialRequestAccess `json:"access,omitempty"`
+
+	// ApiServerUrl Data plane API server URL used to render a kubeconfig
+	ApiServerUrl *string `json:"apiServerUrl,omitempty"`
+
+	// TtlSeconds Lifetime of the token in seconds
+	TtlSeconds *int64 `json:"ttlSeconds,omitempty"`
+}
+
+// DebugCredentialRequestAccess Access level of the credential
+type DebugCredentialRequestAccess string
+
 // Decision Authorization decision
 type Decision struct {
 	// Context Additional decision context
@@ -1885,6 +2727,21 @@ type Decision struct {
 	Decision bool `json:"decision"`
 }
 
+// DeploymentLock Lock of a component in an environment against deploys, promotions and restarts
+type DeploymentLock struct {
+	// ExpiresAt When the lock stops being enforced
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// LockedAt When the lock was placed
+	LockedAt *time.Time `json:"lockedAt,omitempty"`
+
+	// LockedBy Who placed the lock
+	LockedBy *string `json:"lockedBy,omitempty"`
+
+	// Reason Why the component is locked
+	Reason string `json:"reason"`
+}
+
 // DeploymentPipeline DeploymentPipeline resource.
 // Defines promotion paths between environments for component deployments.
 type DeploymentPipeline struct {
@@ -1924,6 +2781,207 @@ type DeploymentPipelineStatus struct {
 	Conditions *[]Condition `json:"conditions,omitempty"`
 }
 
+// DeploymentRecord One deployment of a ComponentRelease to an environment
+type DeploymentRecord struct {
+	// DeployedAt Time the release was bound to the environment
+	DeployedAt time.Time `json:"deployedAt"`
+
+	// FailedAt Time the deployment failed or was rolled back
+	FailedAt *time.Time `json:"failedAt,omitempty"`
+
+	// ReadyAt Time the release binding first became Ready with this release
+	ReadyAt *time.Time `json:"readyAt,omitempty"`
+
+	// Release Name of the deployed ComponentRelease
+	Release string `json:"release"`
+
+	// ReleaseCreatedAt Creation time of the ComponentRelease
+	ReleaseCreatedAt time.Time `json:"releaseCreatedAt"`
+
+	// RestoredAt Time the release binding became Ready again after the failure
+	RestoredAt *time.Time `json:"restoredAt,omitempty"`
+
+	// RolledBack True when the deployment was replaced by an older ComponentRelease
+	RolledBack *bool `json:"rolledBack,omitempty"`
+}
+
+// DeploymentStatusCounts Number of components in each deployment status
+type DeploymentStatusCounts struct {
+	Failed int `json:"failed"`
+
+	// Locked Components with an active deployment lock in the environment, counted in addition to their status
+	Locked *int `json:"locked,omitempty"`
+
+	// NotDeployed Components of the project that have no binding to the environment
+	NotDeployed int `json:"notDeployed"`
+	Progressing int `json:"progressing"`
+	Ready       int `json:"ready"`
+	Undeployed  int `json:"undeployed"`
+}
+
+// DeprecateWorkflowVersionRequest Request to deprecate a workflow version
+type DeprecateWorkflowVersionRequest struct {
+	// Message Why the version is deprecated and what to use instead
+	Message *string `json:"message,omitempty"`
+}
+
+// DirectorySyncChange A role binding created, updated or deleted by a directory sync
+type DirectorySyncChange struct {
+	// Action One of create, update or delete
+	Action string `json:"action"`
+
+	// Group Directory group the binding is synced from
+	Group *string                 `json:"group,omitempty"`
+	Kind  DirectorySyncChangeKind `json:"kind"`
+	Name  string                  `json:"name"`
+
+	// Namespace Namespace of an AuthzRoleBinding
+	Namespace *string `json:"namespace,omitempty"`
+
+	// User Directory user the binding grants roles to
+	User *string `json:"user,omitempty"`
+}
+
+// DirectorySyncChangeKind defines model for DirectorySyncChange.Kind.
+type DirectorySyncChangeKind string
+
+// DirectorySyncConflict A problem that prevented part of a directory sync from being applied
+type DirectorySyncConflict struct {
+	Group     *string `json:"group,omitempty"`
+	Message   string  `json:"message"`
+	Name      *string `json:"name,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Type GroupNotFound and RoleNotFound skip the mapping and keep its previously synced bindings.
+	// NameTaken skips a binding whose name is used by a binding not managed by the sync.
+	// DenyBinding reports a deny binding that overrides the roles synced for a user.
+	Type DirectorySyncConflictType `json:"type"`
+	User *string                   `json:"user,omitempty"`
+}
+
+// DirectorySyncConflictType GroupNotFound and RoleNotFound skip the mapping and keep its previously synced bindings.
+// NameTaken skips a binding whose name is used by a binding not managed by the sync.
+// DenyBinding reports a deny binding that overrides the roles synced for a user.
+type DirectorySyncConflictType string
+
+// DirectorySyncReport Role binding changes and conflicts of a directory sync run
+type DirectorySyncReport struct {
+	Changes     []DirectorySyncChange   `json:"changes"`
+	CompletedAt time.Time               `json:"completedAt"`
+	Conflicts   []DirectorySyncConflict `json:"conflicts"`
+
+	// DryRun True when the changes were computed but not applied
+	DryRun bool `json:"dryRun"`
+
+	// Error Set when the run failed; changes before the failure may have been applied
+	Error *string `json:"error,omitempty"`
+
+	// Groups Number of mapped groups found in the directory
+	Groups int `json:"groups"`
+
+	// Source Directory the groups were read from
+	Source    DirectorySyncReportSource `json:"source"`
+	StartedAt time.Time                 `json:"startedAt"`
+
+	// Users Number of distinct users granted roles
+	Users int `json:"users"`
+}
+
+// DirectorySyncReportSource Directory the groups were read from
+type DirectorySyncReportSource string
+
+// DisruptionBudgetPolicy Controls the PodDisruptionBudgets generated for multi-replica Deployments and
+// StatefulSets. A component type enables them for production environments; a
+// release binding can override the policy for its environment.
+type DisruptionBudgetPolicy struct {
+	// Enabled Whether PodDisruptionBudgets are generated; defaults to true when the policy is set
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinAvailable Number (integer) or percentage (string) of pods that must stay available;
+	// defaults to one less than the replica count
+	MinAvailable interface{} `json:"minAvailable,omitempty"`
+}
+
+// DomainCertificate TLS certificate issued for a custom domain
+type DomainCertificate struct {
+	// Message Details of the issuance of the certificate
+	Message *string `json:"message,omitempty"`
+
+	// NotAfter Expiry of the certificate
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+
+	// Ready Whether the certificate is issued and valid
+	Ready bool `json:"ready"`
+
+	// SecretName Name of the secret in the data plane that holds the certificate
+	SecretName string `json:"secretName"`
+}
+
+// DomainMapping A custom domain attached to a public endpoint of a component in an environment
+type DomainMapping struct {
+	// Certificate TLS certificate issued for a custom domain
+	Certificate *DomainCertificate `json:"certificate,omitempty"`
+
+	// Component Component the domain is attached to
+	Component string `json:"component"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Domain Custom domain
+	Domain string `json:"domain"`
+
+	// Endpoint Public endpoint of the component the domain routes to
+	Endpoint string `json:"endpoint"`
+
+	// Environment Environment the domain is served in
+	Environment string `json:"environment"`
+
+	// Message Details of the readiness of the domain mapping
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the domain mapping
+	Name string `json:"name"`
+
+	// Project Project of the component
+	Project string `json:"project"`
+
+	// Ready Whether the endpoint is served under the domain with a valid certificate
+	Ready bool `json:"ready"`
+
+	// Reason Reason of the readiness of the domain mapping
+	Reason *string `json:"reason,omitempty"`
+
+	// Url URL the endpoint is served at under the domain, once the domain is verified
+	Url *string `json:"url,omitempty"`
+
+	// Verification DNS TXT record that proves ownership of a custom domain
+	Verification *DomainVerification `json:"verification,omitempty"`
+}
+
+// DomainMappingList Custom domains of a component
+type DomainMappingList struct {
+	Items []DomainMapping `json:"items"`
+}
+
+// DomainVerification DNS TXT record that proves ownership of a custom domain
+type DomainVerification struct {
+	// LastCheckedAt Time the record was last looked up
+	LastCheckedAt *time.Time `json:"lastCheckedAt,omitempty"`
+
+	// RecordName DNS name of the TXT record to create
+	RecordName string `json:"recordName"`
+
+	// RecordValue Value of the TXT record to create
+	RecordValue string `json:"recordValue"`
+
+	// Verified Whether the record was found
+	Verified bool `json:"verified"`
+
+	// VerifiedAt Time the record was found
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+}
+
 // EndpointGatewayURLs Resolved gateway URLs for an endpoint
 type EndpointGatewayURLs struct {
 	// Http Structured URL with its components
@@ -1938,6 +2996,9 @@ type EndpointGatewayURLs struct {
 
 // EndpointURL Structured URL with its components
 type EndpointURL struct {
+	// Channel Kafka topic, NATS subject or AMQP queue of an async endpoint
+	Channel *string `json:"channel,omitempty"`
+
 	// Host Hostname or IP address
 	Host string `json:"host"`
 
@@ -1947,7 +3008,7 @@ type EndpointURL struct {
 	// Port Port number
 	Port *int32 `json:"port,omitempty"`
 
-	// Scheme URL scheme (http, https, tcp, udp, ws, wss, tls)
+	// Scheme URL scheme (http, https, tcp, udp, ws, wss, tls, kafka, nats, amqp)
 	Scheme *string `json:"scheme,omitempty"`
 }
 
@@ -1968,11 +3029,11 @@ type EndpointURLStatus struct {
 	// ServiceURL Structured URL with its components
 	ServiceURL *EndpointURL `json:"serviceURL,omitempty"`
 
-	// Type Endpoint type (HTTP, gRPC, GraphQL, Websocket, TCP, UDP)
+	// Type Endpoint type (HTTP, gRPC, GraphQL, Websocket, TCP, UDP, Kafka, NATS, AMQP)
 	Type *EndpointURLStatusType `json:"type,omitempty"`
 }
 
-// EndpointURLStatusType Endpoint type (HTTP, gRPC, GraphQL, Websocket, TCP, UDP)
+// EndpointURLStatusType Endpoint type (HTTP, gRPC, GraphQL, Websocket, TCP, UDP, Kafka, NATS, AMQP)
 type EndpointURLStatusType string
 
 // Entitlement Entitlement with claim and value
@@ -2035,6 +3096,33 @@ type Environment struct {
 	Status *EnvironmentStatus `json:"status,omitempty"`
 }
 
+// EnvironmentDeploymentStatus Deployment status of a component in one environment
+type EnvironmentDeploymentStatus struct {
+	// LastTransitionTime When the binding's Ready condition last changed
+	LastTransitionTime *time.Time `json:"lastTransitionTime,omitempty"`
+
+	// Lock Lock of a component in an environment against deploys, promotions and restarts
+	Lock *DeploymentLock `json:"lock,omitempty"`
+
+	// Message Message of the binding's Ready condition
+	Message *string `json:"message,omitempty"`
+
+	// Reason Reason of the binding's Ready condition
+	Reason *string `json:"reason,omitempty"`
+
+	// Release Name of the ComponentRelease the binding points at
+	Release *string `json:"release,omitempty"`
+
+	// ReleaseBinding Name of the ReleaseBinding
+	ReleaseBinding string `json:"releaseBinding"`
+
+	// Resources Health of the resources rendered for a component in an environment
+	Resources *ResourceHealthCounts `json:"resources,omitempty"`
+
+	// Status Aggregated deployment status, one of Ready, Progressing, Failed or Undeployed
+	Status string `json:"status"`
+}
+
 // EnvironmentList Paginated list of environments
 type EnvironmentList struct {
 	Items []Environment `json:"items"`
@@ -2044,8 +3132,41 @@ type EnvironmentList struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// EnvironmentPriority PriorityClass applied to the workloads deployed to an environment
+type EnvironmentPriority struct {
+	// PreemptionPolicy Preemption policy of the created PriorityClass (PreemptLowerPriority or Never)
+	PreemptionPolicy *string `json:"preemptionPolicy,omitempty"`
+
+	// PriorityClassName Name of the PriorityClass set on workload pod specs
+	PriorityClassName string `json:"priorityClassName"`
+
+	// Value Priority value used to create the PriorityClass on the data plane when it does not exist
+	Value *int32 `json:"value,omitempty"`
+}
+
+// EnvironmentSecrets The secret values a component is given in an environment
+type EnvironmentSecrets struct {
+	// Component Component name
+	Component string `json:"component"`
+
+	// Environment Environment name
+	Environment string `json:"environment"`
+
+	// Keys Keys of the secret values, each exposed as an environment variable of the same name
+	Keys []string `json:"keys"`
+
+	// ReleaseBinding Release binding whose workload overrides expose the values
+	ReleaseBinding *string `json:"releaseBinding,omitempty"`
+
+	// SecretReference SecretReference holding the values
+	SecretReference string `json:"secretReference"`
+}
+
 // EnvironmentSpec Desired state of an Environment
 type EnvironmentSpec struct {
+	// AutoRollback Post-deploy health check that rolls a newly bound release back to the last healthy release when it does not become Ready
+	AutoRollback *AutoRollbackPolicy `json:"autoRollback,omitempty"`
+
 	// DataPlaneRef Reference to the DataPlane or ClusterDataPlane for this environment.
 	// If not specified, defaults to a DataPlane named "default" in the same namespace.
 	// Immutable once set.
@@ -2057,11 +3178,27 @@ type EnvironmentSpec struct {
 		Name string `json:"name"`
 	} `json:"dataPlaneRef,omitempty"`
 
+	// DnsSuffix DNS suffix of generated hostnames. Defaults to the external ingress gateway host.
+	DnsSuffix *string `json:"dnsSuffix,omitempty"`
+
 	// Gateway Gateway configuration with ingress and egress network specs
 	Gateway *GatewaySpec `json:"gateway,omitempty"`
 
 	// IsProduction Whether this is a production environment
 	IsProduction *bool `json:"isProduction,omitempty"`
+
+	// Priority PriorityClass applied to the workloads deployed to an environment
+	Priority *EnvironmentPriority `json:"priority,omitempty"`
+
+	// Region Region of the environment. Defaults to the region of the data plane.
+	Region *string `json:"region,omitempty"`
+
+	// Scheduling Controls where component pods are scheduled. Policies from the data plane,
+	// environment and release binding are merged in that order.
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+
+	// Tier Environment tier. Defaults to "production" or "non-production" based on isProduction.
+	Tier *string `json:"tier,omitempty"`
 }
 
 // EnvironmentSpecDataPlaneRefKind Kind of data plane (DataPlane or ClusterDataPlane)
@@ -2112,6 +3249,15 @@ type EvaluateRequest struct {
 	SubjectContext *SubjectContext `json:"subject_context,omitempty"`
 }
 
+// ExchangeWorkflowCredentialRequest Broker token of a workflow run and the credential to exchange it for
+type ExchangeWorkflowCredentialRequest struct {
+	// Name Name of a credential declared by the run's workflow
+	Name string `json:"name"`
+
+	// Token Broker token from the run's credentials secret
+	Token string `json:"token"`
+}
+
 // ExternalRef Reference to an external CR whose spec is resolved and injected into the CEL context under the given id.
 type ExternalRef struct {
 	// ApiVersion API version of the referenced resource.
@@ -2130,6 +3276,12 @@ type ExternalRef struct {
 // ExternalRefKind Kind of the referenced resource.
 type ExternalRefKind string
 
+// FacetCount defines model for FacetCount.
+type FacetCount struct {
+	Count int    `json:"count"`
+	Value string `json:"value"`
+}
+
 // FileVar File mount variable
 type FileVar struct {
 	// Key File key/name
@@ -2145,6 +3297,20 @@ type FileVar struct {
 	ValueFrom *EnvVarValueFrom `json:"valueFrom,omitempty"`
 }
 
+// FlakyTest A test case with inconsistent results across recent runs
+type FlakyTest struct {
+	// FailedRuns Number of runs in which the test case failed
+	FailedRuns int `json:"failedRuns"`
+
+	// LastFailedRun Most recent run in which the test case failed
+	LastFailedRun *string `json:"lastFailedRun,omitempty"`
+	Name          string  `json:"name"`
+	Suite         string  `json:"suite"`
+
+	// TotalRuns Number of runs in the history
+	TotalRuns int `json:"totalRuns"`
+}
+
 // GatewayEndpointSpec Gateway resource endpoint configuration
 type GatewayEndpointSpec struct {
 	// Http Gateway listener configuration
@@ -2238,12 +3404,119 @@ type HealthInfo struct {
 	Status string `json:"status"`
 }
 
+// HistoricalDeployment Deployment of a component in an environment at a point in time
+type HistoricalDeployment struct {
+	// Component Component name
+	Component string `json:"component"`
+
+	// DeployedAt When the release was deployed to the environment
+	DeployedAt *time.Time `json:"deployedAt,omitempty"`
+
+	// Environment Environment name
+	Environment string `json:"environment"`
+
+	// Release ComponentRelease that was deployed
+	Release *string `json:"release,omitempty"`
+
+	// ReleaseBinding Name of the ReleaseBinding
+	ReleaseBinding string `json:"releaseBinding"`
+
+	// Status Status at that time. NotDeployed when nothing had been deployed yet, and Unknown
+	// when the retained deployment history does not reach back far enough.
+	Status HistoricalDeploymentStatus `json:"status"`
+}
+
+// HistoricalDeploymentStatus Status at that time. NotDeployed when nothing had been deployed yet, and Unknown
+// when the retained deployment history does not reach back far enough.
+type HistoricalDeploymentStatus string
+
 // K8sResourceTreeResponse Response containing resource trees for all rendered releases owned by a release binding
 type K8sResourceTreeResponse struct {
 	// RenderedReleases Resource trees per rendered release (dataplane and/or observabilityplane)
 	RenderedReleases []ReleaseResourceTree `json:"renderedReleases"`
 }
 
+// KarpenterBuildNodes Karpenter NodePool that provisions build nodes
+type KarpenterBuildNodes struct {
+	// DoNotDisrupt Whether build pods are protected from Karpenter consolidation; defaults to true
+	DoNotDisrupt *bool `json:"doNotDisrupt,omitempty"`
+
+	// NodePool Name of the Karpenter NodePool
+	NodePool string `json:"nodePool"`
+}
+
+// LibraryDependency Reference to a published version of a library component
+type LibraryDependency struct {
+	// Component Name of the library component
+	Component string `json:"component"`
+
+	// Project Project of the library component; defaults to the project of the depending component
+	Project *string `json:"project,omitempty"`
+
+	// Version Published version of the library
+	Version string `json:"version"`
+}
+
+// LibraryVersion A versioned artifact published by a library component
+type LibraryVersion struct {
+	// Artifact Registry reference of the published artifact, such as an OCI reference or package URL
+	Artifact string `json:"artifact"`
+
+	// PublishedAt When the version was published
+	PublishedAt time.Time `json:"publishedAt"`
+
+	// Version Version of the library
+	Version string `json:"version"`
+}
+
+// LifecycleHook A Job rendered for a release and run around the rollout of its workload
+type LifecycleHook struct {
+	// FailurePolicy Whether a failed hook blocks the rollout or is only reported
+	FailurePolicy *LifecycleHookFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// IncludeWhen CEL expression determining if the hook runs
+	IncludeWhen *string `json:"includeWhen,omitempty"`
+
+	// Name Name of the hook within the component type
+	Name string `json:"name"`
+
+	// Phase When a lifecycle hook runs relative to the rollout of workload changes
+	Phase LifecycleHookPhase `json:"phase"`
+
+	// Template batch/v1 Job template with CEL expressions
+	Template map[string]interface{} `json:"template"`
+
+	// TimeoutSeconds Maximum run time of the hook Job, applied as its activeDeadlineSeconds
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// LifecycleHookFailurePolicy Whether a failed hook blocks the rollout or is only reported
+type LifecycleHookFailurePolicy string
+
+// LifecycleHookPhase When a lifecycle hook runs relative to the rollout of workload changes
+type LifecycleHookPhase string
+
+// LifecycleHookStatus A lifecycle hook run for the current rollout of a release binding
+type LifecycleHookStatus struct {
+	// JobName Name of the Job run for the hook in the data plane
+	JobName *string `json:"jobName,omitempty"`
+
+	// Message Reason a failed hook failed
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the hook in the component type
+	Name string `json:"name"`
+
+	// Phase When a lifecycle hook runs relative to the rollout of workload changes
+	Phase LifecycleHookPhase `json:"phase"`
+
+	// State State of the hook Job
+	State LifecycleHookStatusState `json:"state"`
+}
+
+// LifecycleHookStatusState State of the hook Job
+type LifecycleHookStatusState string
+
 // ListSecretsResponse Paginated list of secrets.
 type ListSecretsResponse struct {
 	// Items Page of secrets.
@@ -2254,12 +3527,91 @@ type ListSecretsResponse struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// LogParsingProfile How the component's log lines are parsed when its logs are queried from the observer.
+// Parsed fields can be used to filter log queries and the parsed level replaces the
+// level detected by the logging backend.
+type LogParsingProfile struct {
+	// Fields Parsed field names mapped to the JSON key (dot-separated when nested) or capture group they are read from. The level field sets the entry level.
+	Fields *map[string]string `json:"fields,omitempty"`
+
+	// Format Format of each log line
+	Format LogParsingProfileFormat `json:"format"`
+
+	// Multiline How continuation lines, such as stack traces, are joined to the entry they belong to
+	Multiline *struct {
+		// MaxLines Maximum number of lines joined into one entry
+		MaxLines *int32 `json:"maxLines,omitempty"`
+
+		// StartPattern Regular expression matching the first line of an entry
+		StartPattern string `json:"startPattern"`
+	} `json:"multiline,omitempty"`
+
+	// Pattern RE2 regular expression matched against each line when format is Regex. Named capture groups can be mapped to fields.
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Severity How the level of an entry is extracted and normalized
+	Severity *struct {
+		// Mapping Raw levels, compared case-insensitively, mapped to DEBUG, INFO, WARN or ERROR
+		Mapping *map[string]string `json:"mapping,omitempty"`
+
+		// Pattern Regular expression whose first capture group is the raw level, used when no level field is parsed
+		Pattern *string `json:"pattern,omitempty"`
+	} `json:"severity,omitempty"`
+}
+
+// LogParsingProfileFormat Format of each log line
+type LogParsingProfileFormat string
+
+// MaintenanceMode Maintenance of a component in an environment, answered at the gateway instead of by the workload
+type MaintenanceMode struct {
+	// Body Body of the response, for example a maintenance page
+	Body *string `json:"body,omitempty"`
+
+	// EnabledAt When the component was put in maintenance
+	EnabledAt *time.Time `json:"enabledAt,omitempty"`
+
+	// EnabledBy Who put the component in maintenance
+	EnabledBy *string `json:"enabledBy,omitempty"`
+
+	// Reason Why the component is in maintenance
+	Reason *string `json:"reason,omitempty"`
+
+	// RedirectURL Absolute http or https URL requests are redirected to
+	RedirectURL *string `json:"redirectURL,omitempty"`
+
+	// StatusCode HTTP status of the response. Defaults to 503, or to 302 with a redirect.
+	StatusCode *int32 `json:"statusCode,omitempty"`
+}
+
 // MessageResponse Simple message response
 type MessageResponse struct {
 	// Message Response message
 	Message string `json:"message"`
 }
 
+// MissingResource A resource a RenderedRelease reports that does not exist in the data plane
+type MissingResource struct {
+	ApiVersion  string  `json:"apiVersion"`
+	Component   *string `json:"component,omitempty"`
+	Environment string  `json:"environment"`
+	Kind        string  `json:"kind"`
+	Name        string  `json:"name"`
+
+	// Namespace Namespace of the resource in the data plane; empty for cluster-scoped resources
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Plane Reference to the plane that hosts the secret data.
+	Plane           TargetPlaneRef `json:"plane"`
+	Project         string         `json:"project"`
+	RenderedRelease string         `json:"renderedRelease"`
+
+	// ScannedAt When the scan that looked for the resource ran
+	ScannedAt *time.Time `json:"scannedAt,omitempty"`
+
+	// Scanner Name of the OrphanScanner that looked for the resource
+	Scanner string `json:"scanner"`
+}
+
 // Namespace Namespace resource.
 // Control plane namespaces hold resources like Projects, Components, and Environments.
 // These namespaces are identified by the label `openchoreo.dev/control-plane=true`.
@@ -2288,6 +3640,23 @@ type NamespaceStatus struct {
 // NamespaceStatusPhase Namespace phase
 type NamespaceStatusPhase string
 
+// NamespaceWeight Fair-share weight of a namespace on a workflow plane
+type NamespaceWeight struct {
+	// Namespace Name of the namespace
+	Namespace string `json:"namespace"`
+
+	// Weight Share of the plane's capacity relative to other namespaces
+	Weight int32 `json:"weight"`
+}
+
+// NodeLabel A node label key discovered on a data plane and its observed values
+type NodeLabel struct {
+	Key string `json:"key"`
+
+	// Values Observed values; empty when the key has too many values to track
+	Values *[]string `json:"values,omitempty"`
+}
+
 // NotificationEmailConfig Configuration for email notification channels
 type NotificationEmailConfig struct {
 	// From Sender email address
@@ -2578,6 +3947,45 @@ type OpenChoreoClient struct {
 	Scopes []string `json:"scopes"`
 }
 
+// OrphanReport Resources that are orphaned in or missing from the data planes
+type OrphanReport struct {
+	Missing  []MissingResource  `json:"missing"`
+	Orphaned []OrphanedResource `json:"orphaned"`
+}
+
+// OrphanedResource A data plane resource labeled as managed by OpenChoreo that no RenderedRelease accounts for
+type OrphanedResource struct {
+	ApiVersion string  `json:"apiVersion"`
+	Component  *string `json:"component,omitempty"`
+
+	// Deleted Whether the scan deleted the resource
+	Deleted bool   `json:"deleted"`
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+
+	// Namespace Namespace of the resource in the data plane; empty for cluster-scoped resources
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Plane Reference to the plane that hosts the secret data.
+	Plane   TargetPlaneRef `json:"plane"`
+	Project *string        `json:"project,omitempty"`
+
+	// Reason Why the resource is orphaned
+	Reason OrphanedResourceReason `json:"reason"`
+
+	// RenderedRelease RenderedRelease named by the resource labels
+	RenderedRelease string `json:"renderedRelease"`
+
+	// ScannedAt When the scan that found the resource ran
+	ScannedAt *time.Time `json:"scannedAt,omitempty"`
+
+	// Scanner Name of the OrphanScanner that found the resource
+	Scanner string `json:"scanner"`
+}
+
+// OrphanedResourceReason Why the resource is orphaned
+type OrphanedResourceReason string
+
 // Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
 // for efficient pagination through large result sets.
 type Pagination struct {
@@ -2704,6 +4112,21 @@ type PostRenderValidation struct {
 // PostRenderValidationTargetPlane Plane to scope selection to; without it a rule matches resources of the same GVK across every plane
 type PostRenderValidationTargetPlane string
 
+// PreWarmWindow Daily time window on a set of weekdays
+type PreWarmWindow struct {
+	// Days Weekdays the window applies to; every day when empty
+	Days *[]PreWarmWindowDays `json:"days,omitempty"`
+
+	// End Time of day the window closes (HH:MM); a window ending before it starts spans midnight
+	End string `json:"end"`
+
+	// Start Time of day the window opens (HH:MM)
+	Start string `json:"start"`
+}
+
+// PreWarmWindowDays defines model for PreWarmWindow.Days.
+type PreWarmWindowDays string
+
 // Project Project resource.
 // Projects group components within a namespace and reference a deployment pipeline.
 type Project struct {
@@ -2722,6 +4145,84 @@ type Project struct {
 	Status *ProjectStatus `json:"status,omitempty"`
 }
 
+// ProjectDeliveryMetrics DORA delivery metrics for a project over a time window
+type ProjectDeliveryMetrics struct {
+	// ChangeFailureRate Ratio of failed deployments to deployments. Omitted when there were no deployments.
+	ChangeFailureRate *float64 `json:"changeFailureRate,omitempty"`
+
+	// Deployments Number of deployments within the window
+	Deployments int `json:"deployments"`
+
+	// DeploymentsPerDay Average number of deployments per day
+	DeploymentsPerDay float64 `json:"deploymentsPerDay"`
+
+	// Environments Environments whose deployments were included
+	Environments []string `json:"environments"`
+
+	// FailedDeployments Number of deployments that failed or were rolled back
+	FailedDeployments int `json:"failedDeployments"`
+
+	// MeanTimeToRestoreSeconds Mean time from a failed deployment until the environment was ready again, in seconds
+	MeanTimeToRestoreSeconds *int64 `json:"meanTimeToRestoreSeconds,omitempty"`
+
+	// MedianLeadTimeSeconds Median time from ComponentRelease creation to deployment, in seconds
+	MedianLeadTimeSeconds *int64 `json:"medianLeadTimeSeconds,omitempty"`
+
+	// Project Project name
+	Project string `json:"project"`
+
+	// WindowEnd End of the reporting window
+	WindowEnd time.Time `json:"windowEnd"`
+
+	// WindowStart Start of the reporting window
+	WindowStart time.Time `json:"windowStart"`
+}
+
+// ProjectDeploymentState Deployments of the components of a project at a point in time
+type ProjectDeploymentState struct {
+	// At Point in time the state was reconstructed for
+	At time.Time `json:"at"`
+
+	// Deployments One entry per release binding of the project, sorted by component and environment
+	Deployments []HistoricalDeployment `json:"deployments"`
+
+	// Project Project name
+	Project string `json:"project"`
+}
+
+// ProjectDeploymentStatus Deployment status of the components of a project across environments
+type ProjectDeploymentStatus struct {
+	// Components Components of the project, sorted by name
+	Components []ComponentDeploymentStatus `json:"components"`
+
+	// Environments Environments that at least one component of the project is bound to, sorted by name
+	Environments []string `json:"environments"`
+
+	// Project Project name
+	Project string `json:"project"`
+
+	// Summary Status counts keyed by environment name
+	Summary map[string]DeploymentStatusCounts `json:"summary"`
+}
+
+// ProjectDeploymentSummary Per-environment deployment status counts of a project
+type ProjectDeploymentSummary struct {
+	// Components Number of components in the project
+	Components   int      `json:"components"`
+	Environments []string `json:"environments"`
+
+	// Project Project name
+	Project string `json:"project"`
+
+	// Summary Status counts keyed by environment name
+	Summary map[string]DeploymentStatusCounts `json:"summary"`
+}
+
+// ProjectDeploymentSummaryList Deployment summaries of the projects in a namespace
+type ProjectDeploymentSummaryList struct {
+	Items []ProjectDeploymentSummary `json:"items"`
+}
+
 // ProjectList Paginated list of projects
 type ProjectList struct {
 	Items []Project `json:"items"`
@@ -2731,6 +4232,80 @@ type ProjectList struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// ProjectPromotionRequest Request to promote the components of a project from a source environment
+type ProjectPromotionRequest struct {
+	// Components Components of the project to promote. All components are promoted when omitted.
+	Components *[]string `json:"components,omitempty"`
+
+	// RollbackOnFailure Restore the previous release of every promoted target when any target is blocked or fails
+	RollbackOnFailure *bool `json:"rollbackOnFailure,omitempty"`
+
+	// SourceEnvironment Environment whose bound releases are promoted
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// TargetEnvironments Targets of the source environment's promotion path to promote to. All targets are promoted when omitted.
+	TargetEnvironments *[]string `json:"targetEnvironments,omitempty"`
+}
+
+// ProjectPromotionStatus Status of promoting the components of a project from a source environment
+type ProjectPromotionStatus struct {
+	Components []ComponentPromotionResult `json:"components"`
+
+	// Phase Aggregated over the targets of the promoted components like the phase of a component promotion, or RolledBack when the promoted targets were restored.
+	Phase   ProjectPromotionStatusPhase `json:"phase"`
+	Project string                      `json:"project"`
+
+	// RolledBack Whether the targets promoted by the request were restored to their previous release
+	RolledBack        bool   `json:"rolledBack"`
+	SourceEnvironment string `json:"sourceEnvironment"`
+}
+
+// ProjectPromotionStatusPhase Aggregated over the targets of the promoted components like the phase of a component promotion, or RolledBack when the promoted targets were restored.
+type ProjectPromotionStatusPhase string
+
+// ProjectQuota Limits on the components of a project, the environments they are deployed to and the
+// CPU and memory requested by their releases. Unset limits are not enforced.
+type ProjectQuota struct {
+	// Cpu CPU budget for the pods of all the releases of the project, across environments
+	Cpu *string `json:"cpu,omitempty"`
+
+	// MaxComponents Maximum number of components in the project
+	MaxComponents *int32 `json:"maxComponents,omitempty"`
+
+	// MaxEnvironments Maximum number of environments the components of the project are deployed to
+	MaxEnvironments *int32 `json:"maxEnvironments,omitempty"`
+
+	// Memory Memory budget for the pods of all the releases of the project, across environments
+	Memory *string `json:"memory,omitempty"`
+}
+
+// ProjectQuotaExceededError Error returned when a request is rejected because a limit of the project quota is exhausted
+type ProjectQuotaExceededError struct {
+	// Code Machine-readable error code, always QUOTA_EXCEEDED
+	Code string `json:"code"`
+
+	// Error Human-readable error message
+	Error string `json:"error"`
+
+	// Limit Configured limit, as a count or a resource quantity
+	Limit string `json:"limit"`
+
+	// Project Project whose quota was exhausted
+	Project string `json:"project"`
+
+	// Reason Limit of the project quota that was exhausted
+	Reason ProjectQuotaExceededErrorReason `json:"reason"`
+
+	// Remaining Usage left before the limit is reached
+	Remaining string `json:"remaining"`
+
+	// Usage Current usage of the project, as a count or a resource quantity
+	Usage string `json:"usage"`
+}
+
+// ProjectQuotaExceededErrorReason Limit of the project quota that was exhausted
+type ProjectQuotaExceededErrorReason string
+
 // ProjectRelease ProjectRelease resource.
 // Immutable snapshot of Project.spec and the referenced (Cluster)ProjectType.spec
 // at the time it was cut. Normally cut by the Project controller; the create
@@ -2873,6 +4448,14 @@ type ProjectSpec struct {
 	// each ProjectRelease snapshot.
 	Parameters *map[string]interface{} `json:"parameters,omitempty"`
 
+	// QualityGate Maximum number of static analysis findings per severity that the latest workflow run of a
+	// component may report before release creation is blocked. Unset thresholds are not enforced.
+	QualityGate *AnalysisQualityGate `json:"qualityGate,omitempty"`
+
+	// Quota Limits on the components of a project, the environments they are deployed to and the
+	// CPU and memory requested by their releases. Unset limits are not enforced.
+	Quota *ProjectQuota `json:"quota,omitempty"`
+
 	// Type Reference to a ProjectType or ClusterProjectType template. Immutable
 	// after the Project is created. When omitted on create, the API defaults
 	// to the cluster-scoped `default` ClusterProjectType.
@@ -2979,6 +4562,24 @@ type ProjectTypeSpec struct {
 // ProjectTypeSpecResourcesTargetPlane Target plane for deployment.
 type ProjectTypeSpecResourcesTargetPlane string
 
+// PromoteComponentRequest Request to promote a component from a source environment
+type PromoteComponentRequest struct {
+	// SourceEnvironment Environment whose bound release is promoted
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// TargetEnvironments Targets of the source environment's promotion path to promote to. All targets are promoted when omitted.
+	TargetEnvironments *[]string `json:"targetEnvironments,omitempty"`
+}
+
+// PromotionGate Checks the source environment must pass before a release is promoted to a target environment. Each target is gated independently.
+type PromotionGate struct {
+	// RequireSourceReady Require the release binding of the source environment to be Ready
+	RequireSourceReady *bool `json:"requireSourceReady,omitempty"`
+
+	// SoakTime Minimum time the release must have been Ready in the source environment. Implies requireSourceReady.
+	SoakTime *string `json:"soakTime,omitempty"`
+}
+
 // PromotionPath Promotion path between environments
 type PromotionPath struct {
 	// SourceEnvironmentRef Reference to the source environment for this promotion path.
@@ -2997,6 +4598,141 @@ type PromotionPath struct {
 // PromotionPathSourceEnvironmentRefKind Kind of environment resource
 type PromotionPathSourceEnvironmentRefKind string
 
+// PromotionStatus Aggregated status of promoting a component from a source environment to the targets of its promotion path
+type PromotionStatus struct {
+	Component string `json:"component"`
+
+	// Phase Succeeded when every target succeeded, Failed when every target failed or is blocked, PartiallyFailed when only some are, Pending when a target still has to be promoted and Progressing otherwise.
+	Phase   PromotionStatusPhase `json:"phase"`
+	Project string               `json:"project"`
+
+	// Release Release bound in the source environment; omitted when there is none
+	Release           *string                 `json:"release,omitempty"`
+	SourceEnvironment string                  `json:"sourceEnvironment"`
+	Targets           []PromotionTargetStatus `json:"targets"`
+}
+
+// PromotionStatusPhase Succeeded when every target succeeded, Failed when every target failed or is blocked, PartiallyFailed when only some are, Pending when a target still has to be promoted and Progressing otherwise.
+type PromotionStatusPhase string
+
+// PromotionTargetStatus Promotion status of a single target environment
+type PromotionTargetStatus struct {
+	Environment string `json:"environment"`
+
+	// Message Why the target is blocked or failed, or the rollout message
+	Message *string `json:"message,omitempty"`
+
+	// Phase Pending when the target is not on the source release, Blocked when a gate or a deployment lock holds it back, Progressing while the source release rolls out, Succeeded once it is Ready and Failed when the promotion or the rollout failed. RolledBack when a project promotion restored the target's previous release.
+	Phase PromotionTargetStatusPhase `json:"phase"`
+
+	// Release Release currently bound in the target
+	Release *string `json:"release,omitempty"`
+
+	// ReleaseBinding Release binding of the component in the target; omitted when there is none
+	ReleaseBinding *string `json:"releaseBinding,omitempty"`
+}
+
+// PromotionTargetStatusPhase Pending when the target is not on the source release, Blocked when a gate or a deployment lock holds it back, Progressing while the source release rolls out, Succeeded once it is Ready and Failed when the promotion or the rollout failed. RolledBack when a project promotion restored the target's previous release.
+type PromotionTargetStatusPhase string
+
+// PublishLibraryVersionRequest A library version to publish
+type PublishLibraryVersionRequest struct {
+	// Artifact Registry reference of the published artifact
+	Artifact string `json:"artifact"`
+
+	// Version Version of the library
+	Version string `json:"version"`
+}
+
+// PublishWorkflowVersionRequest Request to publish the current workflow template as a version
+type PublishWorkflowVersionRequest struct {
+	// Version Version label to publish, for example v1 or v1.2.0
+	Version string `json:"version"`
+}
+
+// PruneComponentReleasesRequest Request to delete the older releases of a component
+type PruneComponentReleasesRequest struct {
+	// DryRun Only report the releases that would be deleted
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Keep Number of the newest releases to keep
+	Keep int32 `json:"keep"`
+}
+
+// QuarantinedResource A resource the controller manager stopped reconciling because its reconciles kept failing
+type QuarantinedResource struct {
+	// Component Component the resource belongs to, for Components and ReleaseBindings
+	Component *string `json:"component,omitempty"`
+
+	// Environment Environment of a ReleaseBinding or ResourceReleaseBinding
+	Environment *string `json:"environment,omitempty"`
+
+	// Kind Kind of the resource
+	Kind QuarantinedResourceKind `json:"kind"`
+
+	// Message Number of failed reconciles and the last reconcile error
+	Message string `json:"message"`
+
+	// Name Name of the resource
+	Name string `json:"name"`
+
+	// Project Project the resource belongs to
+	Project *string `json:"project,omitempty"`
+
+	// QuarantinedAt Time the resource was quarantined
+	QuarantinedAt time.Time `json:"quarantinedAt"`
+
+	// RequeueRequestedAt Time a requeue was last requested
+	RequeueRequestedAt *time.Time `json:"requeueRequestedAt,omitempty"`
+
+	// Resource Resource the resource belongs to, for Resources and ResourceReleaseBindings
+	Resource *string `json:"resource,omitempty"`
+}
+
+// QuarantinedResourceKind Kind of the resource
+type QuarantinedResourceKind string
+
+// QuarantinedResourceList Resources quarantined after failing reconciles
+type QuarantinedResourceList struct {
+	Items []QuarantinedResource `json:"items"`
+}
+
+// QuotaExceededError Error returned when a request is rejected because a quota is exhausted
+type QuotaExceededError struct {
+	// Code Machine-readable error code, always QUOTA_EXCEEDED
+	Code string `json:"code"`
+
+	// Error Human-readable error message
+	Error string `json:"error"`
+
+	// Limit Configured limit of the exhausted quota
+	Limit int32 `json:"limit"`
+
+	// Reason Quota that was exhausted
+	Reason QuotaExceededErrorReason `json:"reason"`
+
+	// RetryAfterSeconds Seconds to wait before retrying
+	RetryAfterSeconds int `json:"retryAfterSeconds"`
+
+	// Usage Current usage of the exhausted quota
+	Usage int32 `json:"usage"`
+
+	// WorkflowPlane Workflow plane whose build queue is full, as <kind>/<name>
+	WorkflowPlane *string `json:"workflowPlane,omitempty"`
+}
+
+// QuotaExceededErrorReason Quota that was exhausted
+type QuotaExceededErrorReason string
+
+// RegistryCredential Docker config JSON credentials for a private container registry
+type RegistryCredential struct {
+	// Name Credential name, used to derive the image pull secret name
+	Name string `json:"name"`
+
+	// SecretReferenceName Name of a SecretReference of type kubernetes.io/dockerconfigjson
+	SecretReferenceName string `json:"secretReferenceName"`
+}
+
 // ReleaseBinding ReleaseBinding resource.
 // Binds a ComponentRelease to a specific environment.
 type ReleaseBinding struct {
@@ -3010,6 +4746,8 @@ type ReleaseBinding struct {
 	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
 	Metadata ObjectMeta `json:"metadata"`
 
+	Runtime *ReleaseBindingRuntime `json:"runtime,omitempty"`
+
 	// Spec Desired state of a ReleaseBinding
 	Spec   *ReleaseBindingSpec   `json:"spec,omitempty"`
 	Status *ReleaseBindingStatus `json:"status,omitempty"`
@@ -3024,14 +4762,122 @@ type ReleaseBindingList struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// ReleaseBindingLockRequest Request to lock a release binding
+type ReleaseBindingLockRequest struct {
+	// ExpiresAt When the lock stops being enforced; must be in the future
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Reason Why the component is locked
+	Reason string `json:"reason"`
+}
+
+// ReleaseBindingMaintenanceRequest Request to put a release binding in maintenance
+type ReleaseBindingMaintenanceRequest struct {
+	// Body Body of the response; cannot be set together with redirectURL
+	Body *string `json:"body,omitempty"`
+
+	// Reason Why the component is in maintenance
+	Reason *string `json:"reason,omitempty"`
+
+	// RedirectURL Absolute http or https URL requests are redirected to
+	RedirectURL *string `json:"redirectURL,omitempty"`
+
+	// StatusCode HTTP status of the response. Defaults to 503, or to 302 with a redirect; must be 301 or 302 with a redirect.
+	StatusCode *int32 `json:"statusCode,omitempty"`
+}
+
+// ReleaseBindingPromotion Records the most recent change of the ComponentRelease deployed to an environment, once its resources are ready
+type ReleaseBindingPromotion struct {
+	// Changelog Differences between two ComponentReleases
+	Changelog *ReleaseChangelog `json:"changelog,omitempty"`
+
+	// FromRelease Previously bound ComponentRelease. Empty for the initial deployment.
+	FromRelease *string `json:"fromRelease,omitempty"`
+
+	// PromotedAt Timestamp when the controller observed the new release as deployed
+	PromotedAt time.Time `json:"promotedAt"`
+
+	// ToRelease ComponentRelease bound by this promotion
+	ToRelease string `json:"toRelease"`
+}
+
+// ReleaseBindingRollback Automatic rollback of the bound release after a failed health check
+type ReleaseBindingRollback struct {
+	// FailedRelease ComponentRelease that failed its health check
+	FailedRelease string `json:"failedRelease"`
+
+	// Reason Why the health check failed
+	Reason string `json:"reason"`
+
+	// RolledBackAt When the rollback was decided
+	RolledBackAt time.Time `json:"rolledBackAt"`
+
+	// RolledBackTo Last healthy ComponentRelease that is deployed instead until releaseName changes
+	RolledBackTo string `json:"rolledBackTo"`
+}
+
+// ReleaseBindingRollout Progress of the rollout of the bound release under a rollout strategy
+type ReleaseBindingRollout struct {
+	// CandidateRelease ComponentRelease being rolled out, unset once the rollout completed
+	CandidateRelease *string `json:"candidateRelease,omitempty"`
+
+	// CandidateWeight Percentage of traffic sent to the candidate release
+	CandidateWeight *int32 `json:"candidateWeight,omitempty"`
+
+	// Phase Phase of the rollout
+	Phase ReleaseBindingRolloutPhase `json:"phase"`
+
+	// PreviewHostnames Hostnames that preview the candidate release of a BlueGreen rollout
+	PreviewHostnames *[]string `json:"previewHostnames,omitempty"`
+
+	// StableRelease ComponentRelease serving traffic before the rollout
+	StableRelease *string `json:"stableRelease,omitempty"`
+
+	// Step Index of the current canary step
+	Step *int32 `json:"step,omitempty"`
+
+	// StepStartedAt When the candidate release became ready at the current step
+	StepStartedAt *time.Time `json:"stepStartedAt,omitempty"`
+}
+
+// ReleaseBindingRolloutPhase Phase of the rollout
+type ReleaseBindingRolloutPhase string
+
+// ReleaseBindingRuntime Runtime state of a ReleaseBinding, returned when listing release bindings with includeRuntime.
+// Fields are omitted when their source is unavailable.
+type ReleaseBindingRuntime struct {
+	// ErrorRate Ratio of unsuccessful requests over the last five minutes, from 0 to 1
+	ErrorRate *float64 `json:"errorRate,omitempty"`
+
+	// Replicas Replica counts of the Deployments and StatefulSets of a release
+	Replicas *ReplicaCounts `json:"replicas,omitempty"`
+
+	// RequestRate Requests per second over the last five minutes
+	RequestRate *float64 `json:"requestRate,omitempty"`
+}
+
 // ReleaseBindingSpec Desired state of a ReleaseBinding
 type ReleaseBindingSpec struct {
+	// AutoRollback Post-deploy health check that rolls a newly bound release back to the last healthy release when it does not become Ready
+	AutoRollback *AutoRollbackPolicy `json:"autoRollback,omitempty"`
+
 	// ComponentTypeEnvironmentConfigs Environment-specific ComponentType overrides
 	ComponentTypeEnvironmentConfigs *map[string]interface{} `json:"componentTypeEnvironmentConfigs,omitempty"`
 
+	// DisruptionBudget Controls the PodDisruptionBudgets generated for multi-replica Deployments and
+	// StatefulSets. A component type enables them for production environments; a
+	// release binding can override the policy for its environment.
+	DisruptionBudget *DisruptionBudgetPolicy `json:"disruptionBudget,omitempty"`
+
 	// Environment Target environment name
 	Environment string `json:"environment"`
 
+	// Lock Lock of a component in an environment against deploys, promotions and restarts
+	Lock *DeploymentLock `json:"lock,omitempty"`
+
+	// Maintenance Maintenance of a component in an environment, answered at the gateway instead of by the workload
+	Maintenance *MaintenanceMode `json:"maintenance,omitempty"`
+
 	// Owner Owner identifies the component and project this ReleaseBinding belongs to
 	Owner struct {
 		// ComponentName Name of the component
@@ -3044,6 +4890,13 @@ type ReleaseBindingSpec struct {
 	// ReleaseName Reference to component release
 	ReleaseName *string `json:"releaseName,omitempty"`
 
+	// RolloutStrategy Shifts traffic from the stable release to a newly bound release gradually (Canary) or after a preview (BlueGreen)
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// Scheduling Controls where component pods are scheduled. Policies from the data plane,
+	// environment and release binding are merged in that order.
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+
 	// State Controls the state of the Release created by this binding
 	State *ReleaseBindingSpecState `json:"state,omitempty"`
 
@@ -3059,12 +4912,24 @@ type ReleaseBindingSpecState string
 
 // ReleaseBindingStatus Observed state of a ReleaseBinding
 type ReleaseBindingStatus struct {
+	// ConditionHistory Most recent changes of the Ready condition, oldest first
+	ConditionHistory *[]ConditionTransition `json:"conditionHistory,omitempty"`
+
 	// Conditions Latest available observations of the ReleaseBinding's current state
 	Conditions *[]Condition `json:"conditions,omitempty"`
 
+	// DeploymentHistory Most recent deployments to the environment, oldest first
+	DeploymentHistory *[]DeploymentRecord `json:"deploymentHistory,omitempty"`
+
+	// Diagnosis Human-readable diagnosis of a failing workload, derived from the statuses of its pods and containers in the data plane
+	Diagnosis *WorkloadDiagnosis `json:"diagnosis,omitempty"`
+
 	// Endpoints Resolved invoke URLs for each named workload endpoint
 	Endpoints *[]EndpointURLStatus `json:"endpoints,omitempty"`
 
+	// Hooks Lifecycle hooks of the component type run for the current rollout
+	Hooks *[]LifecycleHookStatus `json:"hooks,omitempty"`
+
 	// LastSpecUpdateTime Timestamp of the last spec change observed by the controller
 	LastSpecUpdateTime *time.Time `json:"lastSpecUpdateTime,omitempty"`
 
@@ -3074,8 +4939,56 @@ type ReleaseBindingStatus struct {
 	// PendingConnections Connections that could not be resolved
 	PendingConnections *[]PendingConnection `json:"pendingConnections,omitempty"`
 
+	// Promotion Records the most recent change of the ComponentRelease deployed to an environment, once its resources are ready
+	Promotion *ReleaseBindingPromotion `json:"promotion,omitempty"`
+
 	// ResolvedConnections Connections that have been successfully resolved
 	ResolvedConnections *[]ResolvedConnection `json:"resolvedConnections,omitempty"`
+
+	// Rollback Automatic rollback of the bound release after a failed health check
+	Rollback *ReleaseBindingRollback `json:"rollback,omitempty"`
+
+	// Rollout Progress of the rollout of the bound release under a rollout strategy
+	Rollout *ReleaseBindingRollout `json:"rollout,omitempty"`
+}
+
+// ReleaseChange A single entry in a release changelog
+type ReleaseChange struct {
+	// From Previous value rendered as JSON. Empty for added values.
+	From *string `json:"from,omitempty"`
+
+	// Path Identifies the changed value, e.g. parameters.replicas or workload.container.env.LOG_LEVEL
+	Path string `json:"path"`
+
+	// To New value rendered as JSON. Empty for removed values.
+	To *string `json:"to,omitempty"`
+
+	// Type Kind of change
+	Type ReleaseChangeType `json:"type"`
+}
+
+// ReleaseChangeType Kind of change
+type ReleaseChangeType string
+
+// ReleaseChangelog Differences between two ComponentReleases
+type ReleaseChangelog struct {
+	// Changes Component type, parameter, trait and environment variable changes
+	Changes *[]ReleaseChange `json:"changes,omitempty"`
+
+	// FromCommit Source commit the previously bound release was built from
+	FromCommit *string `json:"fromCommit,omitempty"`
+
+	// FromImage Container image of the previously bound release
+	FromImage *string `json:"fromImage,omitempty"`
+
+	// ToCommit Source commit the newly bound release was built from
+	ToCommit *string `json:"toCommit,omitempty"`
+
+	// ToImage Container image of the newly bound release
+	ToImage *string `json:"toImage,omitempty"`
+
+	// Truncated True when more changes were found than are recorded in changes
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 // ReleaseResourceTree Resource tree for a single release
@@ -3108,6 +5021,27 @@ type RemoteReference struct {
 	Version *string `json:"version,omitempty"`
 }
 
+// RenderedComponentRelease Resources a ComponentRelease renders for an environment
+type RenderedComponentRelease struct {
+	// Component Component name
+	Component string `json:"component"`
+
+	// Environment Environment the release was rendered for
+	Environment string `json:"environment"`
+
+	// InputsResolvedAt Time the environment, its data plane, the ReleaseBinding overrides and the SecretReferences were read. They are the inputs current at this time, not those of the time the release was deployed
+	InputsResolvedAt time.Time `json:"inputsResolvedAt"`
+
+	// Project Project name
+	Project string `json:"project"`
+
+	// Release ComponentRelease name
+	Release string `json:"release"`
+
+	// Resources Rendered resources in render order
+	Resources []RenderedResource `json:"resources"`
+}
+
 // RenderedRelease RenderedRelease resource.
 // Contains the final rendered Kubernetes manifests deployed to data plane clusters.
 type RenderedRelease struct {
@@ -3164,11 +5098,17 @@ type RenderedReleaseSpecTargetPlane string
 
 // RenderedReleaseStatus Observed state of a RenderedRelease
 type RenderedReleaseStatus struct {
+	// ConditionHistory Most recent changes of the conditions, oldest first
+	ConditionHistory *[]ConditionTransition `json:"conditionHistory,omitempty"`
+
 	// Conditions Latest available observations of the RenderedRelease's current state
 	Conditions *[]Condition `json:"conditions,omitempty"`
 
 	// Resources Resources applied to the data plane with their observed status
 	Resources *[]struct {
+		// Diagnosis Human-readable diagnosis of a failing workload, derived from the statuses of its pods and containers in the data plane
+		Diagnosis *WorkloadDiagnosis `json:"diagnosis,omitempty"`
+
 		// Group API group of the resource
 		Group *string `json:"group,omitempty"`
 
@@ -3198,6 +5138,66 @@ type RenderedReleaseStatus struct {
 // RenderedReleaseStatusResourcesHealthStatus Health status of the resource
 type RenderedReleaseStatusResourcesHealthStatus string
 
+// RenderedResource A resource rendered from a ComponentRelease
+type RenderedResource struct {
+	// Resource Kubernetes manifest of the resource
+	Resource map[string]interface{} `json:"resource"`
+
+	// TargetPlane Plane the resource is applied to
+	TargetPlane string `json:"targetPlane"`
+}
+
+// RenderedResourceDiff Difference of a single resource rendered from two ComponentReleases
+type RenderedResourceDiff struct {
+	// ApiVersion API version of the resource
+	ApiVersion string `json:"apiVersion"`
+
+	// Changes Field changes of a modified resource
+	Changes *[]ReleaseChange `json:"changes,omitempty"`
+
+	// Kind Kind of the resource
+	Kind string `json:"kind"`
+
+	// Name Name of the resource
+	Name string `json:"name"`
+
+	// Namespace Namespace of the resource
+	Namespace *string `json:"namespace,omitempty"`
+
+	// TargetPlane Plane the resource is applied to
+	TargetPlane string `json:"targetPlane"`
+
+	// Type Kind of change
+	Type RenderedResourceDiffType `json:"type"`
+}
+
+// RenderedResourceDiffType Kind of change
+type RenderedResourceDiffType string
+
+// ReplicaCounts Replica counts of the Deployments and StatefulSets of a release
+type ReplicaCounts struct {
+	// AvailableReplicas Number of available replicas
+	AvailableReplicas int `json:"availableReplicas"`
+
+	// ReadyReplicas Number of ready replicas
+	ReadyReplicas int `json:"readyReplicas"`
+
+	// Replicas Number of replicas
+	Replicas int `json:"replicas"`
+}
+
+// RequiredEnvVar An environment variable that a component type requires at runtime
+type RequiredEnvVar struct {
+	// Description What the variable configures; shown when the variable is missing
+	Description *string `json:"description,omitempty"`
+
+	// Name Environment variable name
+	Name string `json:"name"`
+
+	// Secret Whether the value must come from a secret rather than a literal value
+	Secret *bool `json:"secret,omitempty"`
+}
+
 // ResolvedConnection Holds the resolved URL for a single connection
 type ResolvedConnection struct {
 	// Component Name of the target component
@@ -3240,6 +5240,30 @@ type ResolvedResourceOutput struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// ResolvedWorkflowTemplate Workflow template version a run was rendered from
+type ResolvedWorkflowTemplate struct {
+	// Analysis Static analysis step of a workflow whose SARIF report is collected into the run status
+	Analysis *WorkflowAnalysisSpec `json:"analysis,omitempty"`
+
+	// Deprecated Whether the version was deprecated when the run was rendered
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// Generation Generation of the Workflow or WorkflowVersion the run was rendered from
+	Generation int64 `json:"generation"`
+
+	// RetryPolicy How failed workflow runs are resubmitted. Cancelled and timed out runs are not retried.
+	RetryPolicy *WorkflowRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Tests Test step of a workflow whose JUnit report is collected into the run status
+	Tests *WorkflowTestSpec `json:"tests,omitempty"`
+
+	// Timeout Execution timeout of the template
+	Timeout *string `json:"timeout,omitempty"`
+
+	// Version Published version the run was rendered from. Empty when rendered from the live workflow.
+	Version *string `json:"version,omitempty"`
+}
+
 // Resource Resource for authorization evaluation
 type Resource struct {
 	// Hierarchy Resource hierarchy scope. Authoritative validation lives on the
@@ -3298,6 +5322,16 @@ type ResourceEventsResponse struct {
 	Events []ResourceEvent `json:"events"`
 }
 
+// ResourceHealthCounts Health of the resources rendered for a component in an environment
+type ResourceHealthCounts struct {
+	Degraded    int `json:"degraded"`
+	Healthy     int `json:"healthy"`
+	Progressing int `json:"progressing"`
+	Suspended   int `json:"suspended"`
+	Total       int `json:"total"`
+	Unknown     int `json:"unknown"`
+}
+
 // ResourceHierarchy Resource hierarchy scope. Authoritative validation lives on the
 // AuthzRoleBinding / ClusterAuthzRoleBinding CRD CEL rules; this schema
 // documents the same invariants for clients:
@@ -3381,6 +5415,21 @@ type ResourceInstanceStatus struct {
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 }
 
+// ResourceLookup Identity of a resource found by name, uid or external ID
+type ResourceLookup struct {
+	// ExternalId Value of the openchoreo.dev/external-id annotation
+	ExternalId *string `json:"externalId,omitempty"`
+	Kind       string  `json:"kind"`
+	Name       string  `json:"name"`
+	Namespace  string  `json:"namespace"`
+
+	// Project Owning project, set for components
+	Project *string `json:"project,omitempty"`
+
+	// Uid Kubernetes UID, stable for the lifetime of the resource
+	Uid string `json:"uid"`
+}
+
 // ResourceNode A single resource in the resource tree
 type ResourceNode struct {
 	// CreatedAt Creation timestamp of the resource
@@ -3423,6 +5472,24 @@ type ResourcePodLogsResponse struct {
 	LogEntries []PodLogEntry `json:"logEntries"`
 }
 
+// ResourceRecommendationApplyRequest Requests and limits to write into a release binding. Omitted values are left unchanged.
+type ResourceRecommendationApplyRequest struct {
+	// Limits CPU and memory in Kubernetes quantity notation
+	Limits *ResourceRecommendationQuantities `json:"limits,omitempty"`
+
+	// Requests CPU and memory in Kubernetes quantity notation
+	Requests *ResourceRecommendationQuantities `json:"requests,omitempty"`
+}
+
+// ResourceRecommendationQuantities CPU and memory in Kubernetes quantity notation
+type ResourceRecommendationQuantities struct {
+	// Cpu CPU quantity
+	Cpu *string `json:"cpu,omitempty"`
+
+	// Memory Memory quantity
+	Memory *string `json:"memory,omitempty"`
+}
+
 // ResourceRef Reference to a parent resource in the resource tree
 type ResourceRef struct {
 	// Group API group of the resource
@@ -3687,6 +5754,103 @@ type ResourceTypeSpec struct {
 // ResourceTypeSpecRetainPolicy Default retention for ResourceReleaseBindings of this type. Per-env override available on the binding.
 type ResourceTypeSpecRetainPolicy string
 
+// RolloutProgress Rollout progress of the workloads rendered for a release binding
+type RolloutProgress struct {
+	// Complete True when every desired replica is updated and ready
+	Complete        bool   `json:"complete"`
+	DesiredReplicas int32  `json:"desiredReplicas"`
+	Environment     string `json:"environment"`
+
+	// EtaSeconds Estimated seconds until the rollout completes; omitted until the first replica is updated and ready
+	EtaSeconds *int64 `json:"etaSeconds,omitempty"`
+
+	// Percentage Replicas that are both updated and ready, as a percentage of the desired replicas
+	Percentage    int32 `json:"percentage"`
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// Release Release being rolled out
+	Release         *string           `json:"release,omitempty"`
+	ReleaseBinding  string            `json:"releaseBinding"`
+	UpdatedReplicas int32             `json:"updatedReplicas"`
+	Workloads       []WorkloadRollout `json:"workloads"`
+}
+
+// RolloutStrategy Shifts traffic from the stable release to a newly bound release gradually (Canary) or after a preview (BlueGreen)
+type RolloutStrategy struct {
+	// BlueGreen Preview of a BlueGreen rollout
+	BlueGreen *struct {
+		// AutoPromotionDelay How long the new release is previewed once ready before it is promoted. Without it, the rollout waits to be promoted.
+		AutoPromotionDelay *string `json:"autoPromotionDelay,omitempty"`
+
+		// PreviewHostnamePrefix Prefix added to the hostnames of the routes that preview the new release. Defaults to preview-.
+		PreviewHostnamePrefix *string `json:"previewHostnamePrefix,omitempty"`
+	} `json:"blueGreen,omitempty"`
+
+	// Canary Traffic steps of a Canary rollout
+	Canary *struct {
+		// Steps Percentages of traffic sent to the new release, in order
+		Steps []struct {
+			// Pause How long the step lasts once the new release is ready. Without it, the rollout waits to be promoted.
+			Pause *string `json:"pause,omitempty"`
+
+			// Weight Percentage of traffic sent to the new release
+			Weight int32 `json:"weight"`
+		} `json:"steps"`
+	} `json:"canary,omitempty"`
+
+	// Type Strategy used to roll out a newly bound release
+	Type RolloutStrategyType `json:"type"`
+}
+
+// RolloutStrategyType Strategy used to roll out a newly bound release
+type RolloutStrategyType string
+
+// RunJobRequest Request to run a one-off job with the workload of a release binding
+type RunJobRequest struct {
+	// Args Arguments override for the container
+	Args *[]string `json:"args,omitempty"`
+
+	// Command Entrypoint override for the container. The container's args are dropped unless args is given.
+	Command *[]string `json:"command,omitempty"`
+
+	// Container Workload container to run. Defaults to the first container.
+	Container *string `json:"container,omitempty"`
+
+	// TtlSecondsAfterFinished Seconds the finished Job is kept before it is removed
+	TtlSecondsAfterFinished *int64 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// RunJobResponse Response describing the one-off Job created from a release binding's workload
+type RunJobResponse struct {
+	// Container Name of the container the Job runs
+	Container string `json:"container"`
+
+	// JobName Name of the created Job
+	JobName string `json:"jobName"`
+
+	// Namespace Data plane namespace where the Job was created
+	Namespace string `json:"namespace"`
+
+	// WorkloadKind Kind of the workload the Job was created from
+	WorkloadKind string `json:"workloadKind"`
+
+	// WorkloadName Name of the workload the Job was created from
+	WorkloadName string `json:"workloadName"`
+}
+
+// SchedulingPolicy Controls where component pods are scheduled. Policies from the data plane,
+// environment and release binding are merged in that order.
+type SchedulingPolicy struct {
+	// NodeSelector Node labels the pods must be scheduled on
+	NodeSelector *map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations Kubernetes tolerations added to the pods
+	Tolerations *[]map[string]interface{} `json:"tolerations,omitempty"`
+
+	// TopologySpreadConstraints Kubernetes topology spread constraints added to the pods
+	TopologySpreadConstraints *[]map[string]interface{} `json:"topologySpreadConstraints,omitempty"`
+}
+
 // SchemaResponse JSON Schema response for component types, traits, or workflows
 type SchemaResponse map[string]interface{}
 
@@ -3696,6 +5860,54 @@ type SchemaSection struct {
 	OpenAPIV3Schema *map[string]interface{} `json:"openAPIV3Schema,omitempty"`
 }
 
+// SearchHit A search result
+type SearchHit struct {
+	// Archived Whether the component is archived
+	Archived    *bool   `json:"archived,omitempty"`
+	Description *string `json:"description,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Domain Business domain of the component
+	Domain *string `json:"domain,omitempty"`
+
+	// Environments Environments the component is bound to
+	Environments *[]string `json:"environments,omitempty"`
+
+	// Hosts Hosts of the component's resolved endpoint URLs
+	Hosts *[]string `json:"hosts,omitempty"`
+	Kind  string    `json:"kind"`
+
+	// MatchedFields Fields that matched the query (name, displayName, labels, hosts, description)
+	MatchedFields *[]string `json:"matchedFields,omitempty"`
+	Name          string    `json:"name"`
+	Namespace     string    `json:"namespace"`
+	Project       string    `json:"project"`
+
+	// Score Relevance score. Zero when no query is given.
+	Score float64 `json:"score"`
+
+	// Team Team that owns the component
+	Team *string `json:"team,omitempty"`
+
+	// Tier Criticality tier of the component
+	Tier *string `json:"tier,omitempty"`
+
+	// Type Component type name
+	Type *string `json:"type,omitempty"`
+}
+
+// SearchResults Ranked search results with facet counts
+type SearchResults struct {
+	// Facets Value counts keyed by facet name
+	Facets *map[string][]FacetCount `json:"facets,omitempty"`
+
+	// Items Results, best match first
+	Items []SearchHit `json:"items"`
+
+	// Total Number of matching results before the limit is applied
+	Total int `json:"total"`
+}
+
 // Secret Kubernetes Secret. Wire shape matches `corev1.Secret`: `data` is a map
 // of keys to base64-encoded values.
 type Secret struct {
@@ -3726,6 +5938,44 @@ type SecretDataSource struct {
 	SecretKey string `json:"secretKey"`
 }
 
+// SecretExpiryFinding A TLS certificate on a plane that expires within the warning threshold of a scanner
+type SecretExpiryFinding struct {
+	// Namespace Namespace of the secret on the plane
+	Namespace string `json:"namespace"`
+
+	// NotAfter Expiry time of the certificate
+	NotAfter time.Time `json:"notAfter"`
+
+	// Plane Reference to the plane that hosts the secret data.
+	Plane TargetPlaneRef `json:"plane"`
+
+	// ScannedAt When the scan that found the certificate ran
+	ScannedAt *time.Time `json:"scannedAt,omitempty"`
+
+	// Scanner Name of the SecretExpiryScanner that found the certificate
+	Scanner string `json:"scanner"`
+
+	// SecretName Name of the TLS secret on the plane
+	SecretName string `json:"secretName"`
+
+	// SecretReference SecretReference the secret is synced from, if any
+	SecretReference *string `json:"secretReference,omitempty"`
+
+	// Severity How close the certificate is to expiry
+	Severity SecretExpiryFindingSeverity `json:"severity"`
+
+	// Subject Common name of the certificate subject
+	Subject *string `json:"subject,omitempty"`
+}
+
+// SecretExpiryFindingSeverity How close the certificate is to expiry
+type SecretExpiryFindingSeverity string
+
+// SecretExpiryFindingList Certificates that are about to expire or have expired
+type SecretExpiryFindingList struct {
+	Items []SecretExpiryFinding `json:"items"`
+}
+
 // SecretKeyReference Reference to a specific key in a Kubernetes secret
 type SecretKeyReference struct {
 	// Key Key within the secret
@@ -3834,6 +6084,46 @@ type SecretTemplateType string
 // SecretType Kubernetes Secret type
 type SecretType string
 
+// SessionTokenResponse A short-lived OpenChoreo session token
+type SessionTokenResponse struct {
+	// AccessToken Session token to send as a Bearer token
+	AccessToken string    `json:"accessToken"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+
+	// ExpiresIn Seconds until the token expires
+	ExpiresIn int64 `json:"expiresIn"`
+
+	// Namespaces Namespaces the caller may view, evaluated at exchange time
+	Namespaces []string `json:"namespaces"`
+
+	// TokenType Always Bearer
+	TokenType string `json:"tokenType"`
+}
+
+// SetEnvironmentSecretsRequest Secret values of a component in an environment
+type SetEnvironmentSecretsRequest struct {
+	// Data Secret values keyed by the name of the environment variable that exposes them
+	Data map[string]string `json:"data"`
+}
+
+// StandbyBuildNodesStatus Standby build nodes kept warm on a workflow plane
+type StandbyBuildNodesStatus struct {
+	// Desired Number of standby build nodes wanted at the last reconcile; 0 outside of business hours
+	Desired int32 `json:"desired"`
+
+	// Ready Number of placeholder pods holding a build node
+	Ready int32 `json:"ready"`
+}
+
+// StatusHistory Condition transition timeline of a release binding and its rendered release
+type StatusHistory struct {
+	Environment    string `json:"environment"`
+	ReleaseBinding string `json:"releaseBinding"`
+
+	// Transitions Recorded condition transitions, newest first
+	Transitions []ConditionHistoryEntry `json:"transitions"`
+}
+
 // SubjectContext Authenticated subject context
 type SubjectContext struct {
 	// EntitlementClaim Entitlement claim name
@@ -3866,6 +6156,9 @@ type SubjectTypeConfig struct {
 
 // TargetEnvironmentRef Target environment reference
 type TargetEnvironmentRef struct {
+	// Gate Checks the source environment must pass before a release is promoted to a target environment. Each target is gated independently.
+	Gate *PromotionGate `json:"gate,omitempty"`
+
 	// Kind Kind of environment resource
 	Kind *TargetEnvironmentRefKind `json:"kind,omitempty"`
 
@@ -3888,6 +6181,45 @@ type TargetPlaneRef struct {
 // TargetPlaneRefKind Kind of the target plane resource
 type TargetPlaneRefKind string
 
+// TenancyPolicy Restricts which namespaces and projects may use a shared data plane. Entries are glob
+// patterns; projects are written as <namespace>/<project>. Denied entries take precedence
+// and an empty allow list admits every tenant.
+type TenancyPolicy struct {
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+	AllowedProjects   *[]string `json:"allowedProjects,omitempty"`
+	DeniedNamespaces  *[]string `json:"deniedNamespaces,omitempty"`
+	DeniedProjects    *[]string `json:"deniedProjects,omitempty"`
+}
+
+// TenantCapacityReservation Capacity reserved for a tenant namespace on a shared data plane
+type TenantCapacityReservation struct {
+	Namespace string `json:"namespace"`
+
+	// Resources Reserved resource quantities, e.g. cpu and memory
+	Resources *map[string]string `json:"resources,omitempty"`
+}
+
+// TestCaseFailure A failed or errored test case
+type TestCaseFailure struct {
+	// Error Whether the test case errored rather than failed an assertion
+	Error *bool `json:"error,omitempty"`
+
+	// Message Failure message, truncated to 1024 characters
+	Message *string `json:"message,omitempty"`
+	Name    string  `json:"name"`
+	Suite   string  `json:"suite"`
+}
+
+// TestSuiteResult Results of a single test suite
+type TestSuiteResult struct {
+	DurationMillis *int64 `json:"durationMillis,omitempty"`
+	Errors         int32  `json:"errors"`
+	Failures       int32  `json:"failures"`
+	Name           string `json:"name"`
+	Skipped        int32  `json:"skipped"`
+	Tests          int32  `json:"tests"`
+}
+
 // Trait Trait resource.
 // Defines composable cross-cutting concerns that can be applied to components.
 type Trait struct {
@@ -4035,6 +6367,15 @@ type TraitSpecPatchesTargetPlane string
 // TraitStatus Observed state of a Trait
 type TraitStatus = map[string]interface{}
 
+// TransferComponentRequest Request to transfer a component to another project
+type TransferComponentRequest struct {
+	// DryRun Only report the resources that would be moved
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// TargetProject Project of the same namespace to move the component to
+	TargetProject string `json:"targetProject"`
+}
+
 // UpdateSecretRequest Request body for replacing a secret's data. The data map is the final
 // state; keys present in the existing secret but absent here are pruned.
 type UpdateSecretRequest struct {
@@ -4122,18 +6463,98 @@ type Workflow struct {
 	// ApiVersion API version of the resource
 	ApiVersion *string `json:"apiVersion,omitempty"`
 
-	// Kind Kind of the resource
-	Kind *string `json:"kind,omitempty"`
+	// Kind Kind of the resource
+	Kind *string `json:"kind,omitempty"`
+
+	// Metadata Standard Kubernetes object metadata (without kind/apiVersion).
+	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec Desired state of a Workflow
+	Spec   *WorkflowSpec   `json:"spec,omitempty"`
+	Status *WorkflowStatus `json:"status,omitempty"`
+}
+
+// WorkflowAnalysisResults Summary of the SARIF report of a workflow run's analysis step
+type WorkflowAnalysisResults struct {
+	Critical int32 `json:"critical"`
+
+	// Findings Findings ordered by severity, capped at 100 entries
+	Findings *[]AnalysisFinding `json:"findings,omitempty"`
+	High     int32              `json:"high"`
+	Info     int32              `json:"info"`
+	Low      int32              `json:"low"`
+	Medium   int32              `json:"medium"`
+
+	// Message Why the report could not be collected or parsed
+	Message *string `json:"message,omitempty"`
+
+	// Step Workflow step the findings were collected from
+	Step string `json:"step"`
+
+	// Tools Analyzers that contributed findings to the report
+	Tools *[]string `json:"tools,omitempty"`
+}
+
+// WorkflowAnalysisSpec Static analysis step of a workflow whose SARIF report is collected into the run status
+type WorkflowAnalysisSpec struct {
+	// ReportParameter Output parameter of the analysis step holding the SARIF 2.1.0 report. Defaults to sarif-report.
+	ReportParameter *string `json:"reportParameter,omitempty"`
+
+	// Step Name of the workflow step that runs the analysis
+	Step string `json:"step"`
+}
+
+// WorkflowBuildNodesSpec Dedicated build nodes of a workflow plane. The placement is added to every
+// workflow run executed on the plane.
+type WorkflowBuildNodesSpec struct {
+	// Karpenter Karpenter NodePool that provisions build nodes
+	Karpenter *KarpenterBuildNodes `json:"karpenter,omitempty"`
+
+	// NodeSelector Node labels build pods must be scheduled on
+	NodeSelector *map[string]string `json:"nodeSelector,omitempty"`
+
+	// PreWarm Standby build nodes kept warm during business hours
+	PreWarm *BuildNodePreWarmSpec `json:"preWarm,omitempty"`
+
+	// Tolerations Kubernetes tolerations added to build pods
+	Tolerations *[]map[string]interface{} `json:"tolerations,omitempty"`
+}
+
+// WorkflowCredential Short-lived credential a workflow run obtains from the control plane's credential broker
+type WorkflowCredential struct {
+	// Name Name the run uses to exchange its broker token for the credential
+	Name string `json:"name"`
+
+	// Repository Git repository URL or image repository the credential is scoped to. May contain CEL expressions.
+	Repository string `json:"repository"`
+
+	// Type GitRead reads a single git repository; RegistryPush pushes to a single image repository
+	Type WorkflowCredentialType `json:"type"`
+}
+
+// WorkflowCredentialType GitRead reads a single git repository; RegistryPush pushes to a single image repository
+type WorkflowCredentialType string
 
-	// Metadata Standard Kubernetes object metadata (without kind/apiVersion).
-	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
-	Metadata ObjectMeta `json:"metadata"`
+// WorkflowCredentialResponse A short-lived credential scoped to a single repository
+type WorkflowCredentialResponse struct {
+	// DockerConfigJson Docker config.json that presents the registry token (RegistryPush)
+	DockerConfigJson *string   `json:"dockerConfigJson,omitempty"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+	Name             string    `json:"name"`
+	Repository       string    `json:"repository"`
 
-	// Spec Desired state of a Workflow
-	Spec   *WorkflowSpec   `json:"spec,omitempty"`
-	Status *WorkflowStatus `json:"status,omitempty"`
+	// Token Access token for git, or registry bearer token
+	Token string                         `json:"token"`
+	Type  WorkflowCredentialResponseType `json:"type"`
+
+	// Username Username to send with the token over HTTPS (GitRead)
+	Username *string `json:"username,omitempty"`
 }
 
+// WorkflowCredentialResponseType defines model for WorkflowCredentialResponse.Type.
+type WorkflowCredentialResponseType string
+
 // WorkflowList Paginated list of workflows
 type WorkflowList struct {
 	Items []Workflow `json:"items"`
@@ -4170,6 +6591,30 @@ type WorkflowPlaneList struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// WorkflowPlaneQueueUsage Build queue state of a workflow plane
+type WorkflowPlaneQueueUsage struct {
+	// Kind Kind of the workflow plane
+	Kind WorkflowPlaneQueueUsageKind `json:"kind"`
+
+	// MaxConcurrentRuns Limit on runs executing on the plane. Unset when runs are not limited.
+	MaxConcurrentRuns *int32 `json:"maxConcurrentRuns,omitempty"`
+
+	// MaxQueuedRuns Limit on component runs waiting in the plane's queue. Unset when the queue is not limited.
+	MaxQueuedRuns *int32 `json:"maxQueuedRuns,omitempty"`
+
+	// Name Name of the workflow plane
+	Name string `json:"name"`
+
+	// QueuedRuns Runs waiting in the plane's queue
+	QueuedRuns int32 `json:"queuedRuns"`
+
+	// RunningRuns Runs admitted by the plane's queue
+	RunningRuns int32 `json:"runningRuns"`
+}
+
+// WorkflowPlaneQueueUsageKind Kind of the workflow plane
+type WorkflowPlaneQueueUsageKind string
+
 // WorkflowPlaneRef Reference to a WorkflowPlane or ClusterWorkflowPlane
 type WorkflowPlaneRef struct {
 	// Kind Kind of workflow plane
@@ -4184,6 +6629,10 @@ type WorkflowPlaneRefKind string
 
 // WorkflowPlaneSpec Desired state of a WorkflowPlane
 type WorkflowPlaneSpec struct {
+	// BuildNodes Dedicated build nodes of a workflow plane. The placement is added to every
+	// workflow run executed on the plane.
+	BuildNodes *WorkflowBuildNodesSpec `json:"buildNodes,omitempty"`
+
 	// ClusterAgent Configuration for cluster agent-based communication
 	ClusterAgent *ClusterAgentConfig `json:"clusterAgent,omitempty"`
 
@@ -4194,6 +6643,9 @@ type WorkflowPlaneSpec struct {
 	// Multiple WorkflowPlane CRs can share the same planeID.
 	PlaneID *string `json:"planeID,omitempty"`
 
+	// Scheduling Build queue configuration of a workflow plane
+	Scheduling *WorkflowSchedulingSpec `json:"scheduling,omitempty"`
+
 	// SecretStoreRef Reference to an External Secrets Operator ClusterSecretStore
 	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
 }
@@ -4208,6 +6660,18 @@ type WorkflowPlaneStatus struct {
 
 	// ObservedGeneration Generation of the most recently observed WorkflowPlane
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// StandbyBuildNodes Standby build nodes kept warm on a workflow plane
+	StandbyBuildNodes *StandbyBuildNodesStatus `json:"standbyBuildNodes,omitempty"`
+}
+
+// WorkflowPriorityClass Named priority that workflow runs can request
+type WorkflowPriorityClass struct {
+	// Name Name runs use to request the priority class
+	Name string `json:"name"`
+
+	// Priority Runs with a higher priority are scheduled first
+	Priority int32 `json:"priority"`
 }
 
 // WorkflowResource Template for generating an additional Kubernetes resource for a workflow run.
@@ -4222,6 +6686,18 @@ type WorkflowResource struct {
 	Template map[string]interface{} `json:"template"`
 }
 
+// WorkflowRetryPolicy How failed workflow runs are resubmitted. Cancelled and timed out runs are not retried.
+type WorkflowRetryPolicy struct {
+	// Limit Maximum number of times a run is retried
+	Limit int32 `json:"limit"`
+
+	// RetryOn Failures that are retried. InfrastructureErrors only retries runs whose steps could not be executed.
+	RetryOn *WorkflowRetryPolicyRetryOn `json:"retryOn,omitempty"`
+}
+
+// WorkflowRetryPolicyRetryOn Failures that are retried. InfrastructureErrors only retries runs whose steps could not be executed.
+type WorkflowRetryPolicyRetryOn string
+
 // WorkflowRun defines model for WorkflowRun.
 type WorkflowRun struct {
 	// ApiVersion API version of the resource
@@ -4239,6 +6715,15 @@ type WorkflowRun struct {
 	Status *WorkflowRunStatus `json:"status,omitempty"`
 }
 
+// WorkflowRunCancellation Request to cancel a workflow run. Immutable once set.
+type WorkflowRunCancellation struct {
+	// Reason Why the run was cancelled
+	Reason *string `json:"reason,omitempty"`
+
+	// RequestedBy User or service account that cancelled the run
+	RequestedBy *string `json:"requestedBy,omitempty"`
+}
+
 // WorkflowRunConfig Workflow configuration referencing the Workflow and providing schema values. Kind and name are immutable after creation.
 type WorkflowRunConfig struct {
 	// Kind Kind of referenced workflow resource (Workflow or ClusterWorkflow)
@@ -4249,11 +6734,27 @@ type WorkflowRunConfig struct {
 
 	// Parameters Developer-provided parameters for the referenced workflow
 	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+
+	// Version Published workflow version to render runs from. When omitted, the live workflow template is used.
+	Version *string `json:"version,omitempty"`
 }
 
 // WorkflowRunConfigKind Kind of referenced workflow resource (Workflow or ClusterWorkflow)
 type WorkflowRunConfigKind string
 
+// WorkflowRunCredentialsStatus Broker token issued to a workflow run
+type WorkflowRunCredentialsStatus struct {
+	// Credentials Credentials the run may exchange its token for, with their repositories resolved
+	Credentials *[]WorkflowCredential `json:"credentials,omitempty"`
+	ExpiresAt   time.Time             `json:"expiresAt"`
+
+	// SecretName Secret on the workflow plane that holds the broker token and the exchange URL
+	SecretName string `json:"secretName"`
+
+	// TokenHash Hex-encoded SHA-256 hash of the broker token
+	TokenHash string `json:"tokenHash"`
+}
+
 // WorkflowRunEventEntry A single Kubernetes event from a workflow run
 type WorkflowRunEventEntry struct {
 	// Message Human-readable description of the event
@@ -4269,6 +6770,13 @@ type WorkflowRunEventEntry struct {
 	Type string `json:"type"`
 }
 
+// WorkflowRunFindingsResponse Static analysis findings of a workflow run
+type WorkflowRunFindingsResponse struct {
+	// Results Summary of the SARIF report of a workflow run's analysis step
+	Results *WorkflowAnalysisResults `json:"results,omitempty"`
+	RunName string                   `json:"runName"`
+}
+
 // WorkflowRunList Paginated list of workflow runs
 type WorkflowRunList struct {
 	Items []WorkflowRun `json:"items"`
@@ -4287,8 +6795,59 @@ type WorkflowRunLogEntry struct {
 	Timestamp *time.Time `json:"timestamp,omitempty"`
 }
 
+// WorkflowRunProgressResponse Step timeline of a workflow run
+type WorkflowRunProgressResponse struct {
+	// CompletedSteps Number of steps that reached a terminal phase
+	CompletedSteps int `json:"completedSteps"`
+
+	// DurationSeconds Run duration, or the elapsed time while the run is in progress
+	DurationSeconds *float64 `json:"durationSeconds,omitempty"`
+
+	// FinishedAt When the run finished
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// StartedAt When the run started
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// Status Overall workflow run status
+	Status WorkflowRunProgressResponseStatus `json:"status"`
+
+	// Steps Steps in execution order
+	Steps []WorkflowStepProgress `json:"steps"`
+
+	// TotalSteps Number of steps known so far; steps appear as the workflow engine schedules them
+	TotalSteps int `json:"totalSteps"`
+}
+
+// WorkflowRunProgressResponseStatus Overall workflow run status
+type WorkflowRunProgressResponseStatus string
+
+// WorkflowRunQueueStatus Place of a workflow run in the build queue of its workflow plane
+type WorkflowRunQueueStatus struct {
+	AdmittedAt *time.Time `json:"admittedAt,omitempty"`
+
+	// Position 1-based position in the queue. 0 once the run is admitted.
+	Position *int32 `json:"position,omitempty"`
+
+	// Priority Priority of the run's priority class
+	Priority *int32 `json:"priority,omitempty"`
+
+	// PriorityClass Priority class the run was queued with
+	PriorityClass *string   `json:"priorityClass,omitempty"`
+	QueuedAt      time.Time `json:"queuedAt"`
+
+	// WorkflowPlane Workflow plane the run is queued on, as <kind>/<name>
+	WorkflowPlane string `json:"workflowPlane"`
+}
+
 // WorkflowRunSpec Desired state of a WorkflowRun
 type WorkflowRunSpec struct {
+	// Cancellation Request to cancel a workflow run. Immutable once set.
+	Cancellation *WorkflowRunCancellation `json:"cancellation,omitempty"`
+
+	// PriorityClassName Priority class of the workflow plane's build queue. Defaults to the plane's default priority class.
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+
 	// TtlAfterCompletion Time-to-live for this workflow run after completion (duration string like 10d1h30m).
 	TtlAfterCompletion *string `json:"ttlAfterCompletion,omitempty"`
 
@@ -4298,16 +6857,33 @@ type WorkflowRunSpec struct {
 
 // WorkflowRunStatus Observed state of a WorkflowRun
 type WorkflowRunStatus struct {
-	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// AnalysisResults Summary of the SARIF report of a workflow run's analysis step
+	AnalysisResults *WorkflowAnalysisResults `json:"analysisResults,omitempty"`
+	CompletedAt     *time.Time               `json:"completedAt,omitempty"`
 
 	// Conditions Kubernetes-style conditions
-	Conditions *[]Condition         `json:"conditions,omitempty"`
-	Resources  *[]ResourceReference `json:"resources,omitempty"`
+	Conditions *[]Condition `json:"conditions,omitempty"`
+
+	// Credentials Broker token issued to a workflow run
+	Credentials *WorkflowRunCredentialsStatus `json:"credentials,omitempty"`
+
+	// Queue Place of a workflow run in the build queue of its workflow plane
+	Queue *WorkflowRunQueueStatus `json:"queue,omitempty"`
+
+	// ResolvedTemplate Workflow template version a run was rendered from
+	ResolvedTemplate *ResolvedWorkflowTemplate `json:"resolvedTemplate,omitempty"`
+	Resources        *[]ResourceReference      `json:"resources,omitempty"`
+
+	// Retries Number of times the run was resubmitted by the workflow's retry policy
+	Retries *int32 `json:"retries,omitempty"`
 
 	// RunReference Reference to a Kubernetes resource applied during a workflow run
 	RunReference *ResourceReference `json:"runReference,omitempty"`
 	StartedAt    *time.Time         `json:"startedAt,omitempty"`
 	Tasks        *[]WorkflowTask    `json:"tasks,omitempty"`
+
+	// TestResults Summary of the JUnit report of a workflow run's test step
+	TestResults *WorkflowTestResults `json:"testResults,omitempty"`
 }
 
 // WorkflowRunStatusResponse Status of a workflow run including per-step details
@@ -4325,8 +6901,45 @@ type WorkflowRunStatusResponse struct {
 // WorkflowRunStatusResponseStatus Overall workflow run status
 type WorkflowRunStatusResponseStatus string
 
+// WorkflowRunTestResultsResponse Test results of a workflow run with the flaky test history of its component
+type WorkflowRunTestResultsResponse struct {
+	// FlakyTests Test cases that both failed and passed across the recent runs of the component
+	FlakyTests []FlakyTest `json:"flakyTests"`
+
+	// HistoryRuns Number of runs with test results that the flaky test history covers
+	HistoryRuns int `json:"historyRuns"`
+
+	// Results Summary of the JUnit report of a workflow run's test step
+	Results *WorkflowTestResults `json:"results,omitempty"`
+	RunName string               `json:"runName"`
+}
+
+// WorkflowSchedulingSpec Build queue configuration of a workflow plane
+type WorkflowSchedulingSpec struct {
+	// DefaultPriorityClass Priority class of runs that do not request one
+	DefaultPriorityClass *string `json:"defaultPriorityClass,omitempty"`
+
+	// MaxConcurrentRuns Maximum number of workflow runs executing on the plane at the same time. Further runs wait in the queue.
+	MaxConcurrentRuns *int32 `json:"maxConcurrentRuns,omitempty"`
+
+	// MaxQueuedRuns Maximum number of component workflow runs waiting in the plane's queue. New component builds are rejected while the queue is full.
+	MaxQueuedRuns *int32 `json:"maxQueuedRuns,omitempty"`
+
+	// NamespaceWeights Fair-share weights of namespaces. Namespaces that are not listed have a weight of 1.
+	NamespaceWeights *[]NamespaceWeight `json:"namespaceWeights,omitempty"`
+
+	// PriorityClasses Priority classes workflow runs can request. Queued runs of a higher priority are scheduled first.
+	PriorityClasses *[]WorkflowPriorityClass `json:"priorityClasses,omitempty"`
+}
+
 // WorkflowSpec Desired state of a Workflow
 type WorkflowSpec struct {
+	// Analysis Static analysis step of a workflow whose SARIF report is collected into the run status
+	Analysis *WorkflowAnalysisSpec `json:"analysis,omitempty"`
+
+	// Credentials Short-lived credentials that runs exchange their broker token for instead of mounting static secrets
+	Credentials *[]WorkflowCredential `json:"credentials,omitempty"`
+
 	// ExternalRefs External CR references resolved and injected into the CEL context under their id.
 	ExternalRefs *[]ExternalRef `json:"externalRefs,omitempty"`
 
@@ -4336,9 +6949,18 @@ type WorkflowSpec struct {
 	// Resources Additional resource templates to render and apply alongside the workflow run.
 	Resources *[]WorkflowResource `json:"resources,omitempty"`
 
+	// RetryPolicy How failed workflow runs are resubmitted. Cancelled and timed out runs are not retried.
+	RetryPolicy *WorkflowRetryPolicy `json:"retryPolicy,omitempty"`
+
 	// RunTemplate Kubernetes resource template to render and apply for this workflow run.
 	RunTemplate map[string]interface{} `json:"runTemplate"`
 
+	// Tests Test step of a workflow whose JUnit report is collected into the run status
+	Tests *WorkflowTestSpec `json:"tests,omitempty"`
+
+	// Timeout Maximum time a run may execute on the workflow plane (duration string like 1h or 30m).
+	Timeout *string `json:"timeout,omitempty"`
+
 	// TtlAfterCompletion Time-to-live for WorkflowRun instances after completion (duration string like 10d1h30m).
 	TtlAfterCompletion *string `json:"ttlAfterCompletion,omitempty"`
 
@@ -4352,6 +6974,33 @@ type WorkflowStatus struct {
 	Conditions *[]Condition `json:"conditions,omitempty"`
 }
 
+// WorkflowStepProgress A workflow step mapped to a pipeline stage
+type WorkflowStepProgress struct {
+	// DurationSeconds Step duration, or the elapsed time while the step is running
+	DurationSeconds *float64 `json:"durationSeconds,omitempty"`
+
+	// FinishedAt When the step finished
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Name Step name
+	Name string `json:"name"`
+
+	// Phase Step phase
+	Phase WorkflowStepProgressPhase `json:"phase"`
+
+	// Stage Pipeline stage derived from the step name
+	Stage WorkflowStepProgressStage `json:"stage"`
+
+	// StartedAt When the step started
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+}
+
+// WorkflowStepProgressPhase Step phase
+type WorkflowStepProgressPhase string
+
+// WorkflowStepProgressStage Pipeline stage derived from the step name
+type WorkflowStepProgressStage string
+
 // WorkflowStepStatus Status of an individual workflow step
 type WorkflowStepStatus struct {
 	// FinishedAt When the step finished
@@ -4381,6 +7030,125 @@ type WorkflowTask struct {
 	StartedAt *time.Time `json:"startedAt,omitempty"`
 }
 
+// WorkflowTemplate Frozen workflow template captured when a version is published
+type WorkflowTemplate struct {
+	// Analysis Static analysis step of a workflow whose SARIF report is collected into the run status
+	Analysis *WorkflowAnalysisSpec `json:"analysis,omitempty"`
+
+	// Credentials Short-lived credentials that runs exchange their broker token for instead of mounting static secrets
+	Credentials  *[]WorkflowCredential `json:"credentials,omitempty"`
+	ExternalRefs *[]ExternalRef        `json:"externalRefs,omitempty"`
+
+	// Parameters Schema section using openAPIV3Schema format
+	Parameters *SchemaSection      `json:"parameters,omitempty"`
+	Resources  *[]WorkflowResource `json:"resources,omitempty"`
+
+	// RetryPolicy How failed workflow runs are resubmitted. Cancelled and timed out runs are not retried.
+	RetryPolicy *WorkflowRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// RunTemplate Kubernetes resource template to render and apply for a workflow run.
+	RunTemplate map[string]interface{} `json:"runTemplate"`
+
+	// Tests Test step of a workflow whose JUnit report is collected into the run status
+	Tests *WorkflowTestSpec `json:"tests,omitempty"`
+
+	// Timeout Maximum time a run may execute on the workflow plane (duration string like 1h or 30m).
+	Timeout *string `json:"timeout,omitempty"`
+
+	// TtlAfterCompletion Time-to-live for WorkflowRun instances after completion.
+	TtlAfterCompletion *string `json:"ttlAfterCompletion,omitempty"`
+}
+
+// WorkflowTestResults Summary of the JUnit report of a workflow run's test step
+type WorkflowTestResults struct {
+	// BlocksRelease Whether the failed tests block release creation
+	BlocksRelease *bool `json:"blocksRelease,omitempty"`
+
+	// DurationMillis Total test time in milliseconds
+	DurationMillis *int64 `json:"durationMillis,omitempty"`
+
+	// Errors Number of test cases that errored
+	Errors int32 `json:"errors"`
+
+	// FailedCases Failed and errored test cases, capped at 50 entries
+	FailedCases *[]TestCaseFailure `json:"failedCases,omitempty"`
+
+	// Failures Number of failed test cases
+	Failures int32 `json:"failures"`
+
+	// Message Why the report could not be collected or parsed
+	Message *string `json:"message,omitempty"`
+
+	// Passed Whether no test failed or errored
+	Passed bool `json:"passed"`
+
+	// Skipped Number of skipped test cases
+	Skipped int32 `json:"skipped"`
+
+	// Step Workflow step the results were collected from
+	Step string `json:"step"`
+
+	// Suites Results per test suite
+	Suites *[]TestSuiteResult `json:"suites,omitempty"`
+
+	// Tests Total number of test cases
+	Tests int32 `json:"tests"`
+}
+
+// WorkflowTestSpec Test step of a workflow whose JUnit report is collected into the run status
+type WorkflowTestSpec struct {
+	// FailurePolicy Whether failed tests block release creation for the component
+	FailurePolicy *WorkflowTestSpecFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// ReportParameter Output parameter of the test step holding the JUnit XML report. Defaults to junit-report.
+	ReportParameter *string `json:"reportParameter,omitempty"`
+
+	// Step Name of the workflow step that runs the tests
+	Step string `json:"step"`
+}
+
+// WorkflowTestSpecFailurePolicy Whether failed tests block release creation for the component
+type WorkflowTestSpecFailurePolicy string
+
+// WorkflowVersion Immutable snapshot of a Workflow or ClusterWorkflow template
+type WorkflowVersion struct {
+	// ApiVersion API version of the resource
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind Kind of the resource
+	Kind *string `json:"kind,omitempty"`
+
+	// Metadata Standard Kubernetes object metadata (without kind/apiVersion).
+	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec Published version of a workflow template
+	Spec *WorkflowVersionSpec `json:"spec,omitempty"`
+}
+
+// WorkflowVersionList List of published workflow versions
+type WorkflowVersionList struct {
+	Items []WorkflowVersion `json:"items"`
+}
+
+// WorkflowVersionSpec Published version of a workflow template
+type WorkflowVersionSpec struct {
+	// Deprecated Whether the version is deprecated
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// DeprecationMessage Why the version is deprecated and what to use instead
+	DeprecationMessage *string `json:"deprecationMessage,omitempty"`
+
+	// Template Frozen workflow template captured when a version is published
+	Template WorkflowTemplate `json:"template"`
+
+	// Version Version label
+	Version string `json:"version"`
+
+	// WorkflowName Name of the workflow this version was published from
+	WorkflowName string `json:"workflowName"`
+}
+
 // Workload Workload resource.
 // Defines the source code, container, endpoints and dependencies for a component.
 type Workload struct {
@@ -4438,15 +7206,36 @@ type WorkloadContainer struct {
 	Image string `json:"image"`
 }
 
+// WorkloadDiagnosis Human-readable diagnosis of a failing workload, derived from the statuses of its pods and containers in the data plane
+type WorkloadDiagnosis struct {
+	// Container Failing container
+	Container *string `json:"container,omitempty"`
+
+	// Message Description of the failure, such as the registry error or the last termination message
+	Message string `json:"message"`
+
+	// Pod Pod the diagnosis was derived from
+	Pod *string `json:"pod,omitempty"`
+
+	// Reason Classification of the failure
+	Reason WorkloadDiagnosisReason `json:"reason"`
+}
+
+// WorkloadDiagnosisReason Classification of the failure
+type WorkloadDiagnosisReason string
+
 // WorkloadEndpoint Network endpoint specification
 type WorkloadEndpoint struct {
+	// Async Message channel of a Kafka, NATS or AMQP endpoint. Async endpoints get no Service port or gateway route; connections to them resolve to the broker.
+	Async *AsyncEndpoint `json:"async,omitempty"`
+
 	// BasePath Base path of the API exposed via the endpoint
 	BasePath *string `json:"basePath,omitempty"`
 
 	// DisplayName Human-readable name for the endpoint
 	DisplayName *string `json:"displayName,omitempty"`
 
-	// Port Port exposed by the endpoint
+	// Port Port exposed by the endpoint, or the broker port of an async endpoint
 	Port int `json:"port"`
 
 	// Schema API definition schema
@@ -4471,6 +7260,51 @@ type WorkloadEndpointType string
 // WorkloadEndpointVisibility defines model for WorkloadEndpoint.Visibility.
 type WorkloadEndpointVisibility string
 
+// WorkloadIdentityConfig Cloud workload identity federation of a data plane. Components whose component
+// type requests a workload identity run as a ServiceAccount annotated for the provider.
+type WorkloadIdentityConfig struct {
+	// Aws IAM Roles for Service Accounts settings; required for the AWS provider
+	Aws *struct {
+		// AccountID AWS account of the IAM roles
+		AccountID string `json:"accountID"`
+
+		// Partition AWS partition of the account; defaults to aws
+		Partition *string `json:"partition,omitempty"`
+	} `json:"aws,omitempty"`
+
+	// Azure Microsoft Entra Workload ID settings
+	Azure *struct {
+		// TenantID Microsoft Entra tenant of the identities
+		TenantID *string `json:"tenantID,omitempty"`
+	} `json:"azure,omitempty"`
+
+	// Gcp GKE Workload Identity settings; required for the GCP provider
+	Gcp *struct {
+		// ProjectID Google Cloud project of the Google service accounts
+		ProjectID string `json:"projectID"`
+
+		// WorkloadPool Workload identity pool of the cluster; defaults to the pool of the project
+		WorkloadPool *string `json:"workloadPool,omitempty"`
+	} `json:"gcp,omitempty"`
+
+	// Provider Workload identity federation mechanism of the data plane cluster
+	Provider WorkloadIdentityConfigProvider `json:"provider"`
+}
+
+// WorkloadIdentityConfigProvider Workload identity federation mechanism of the data plane cluster
+type WorkloadIdentityConfigProvider string
+
+// WorkloadIdentityRequest Cloud identity the workloads of a component type assume through the workload
+// identity provider of the data plane they are deployed to.
+type WorkloadIdentityRequest struct {
+	// Identity IAM role name or ARN on AWS, Google service account name or email on GCP, or
+	// managed identity client ID on Azure. May contain ${...} CEL expressions.
+	Identity string `json:"identity"`
+
+	// Optional Whether components may deploy without a cloud identity to data planes without a provider
+	Optional *bool `json:"optional,omitempty"`
+}
+
 // WorkloadList Paginated list of workloads
 type WorkloadList struct {
 	Items []Workload `json:"items"`
@@ -4504,6 +7338,21 @@ type WorkloadResourceDependency struct {
 	Ref string `json:"ref"`
 }
 
+// WorkloadRollout Rollout progress of a single rendered workload
+type WorkloadRollout struct {
+	// CurrentStep Index of the current canary step; only set for progressive rollouts
+	CurrentStep     *int32  `json:"currentStep,omitempty"`
+	DesiredReplicas int32   `json:"desiredReplicas"`
+	Kind            string  `json:"kind"`
+	Name            string  `json:"name"`
+	Namespace       *string `json:"namespace,omitempty"`
+	ReadyReplicas   int32   `json:"readyReplicas"`
+
+	// TotalSteps Number of canary steps; only set for progressive rollouts
+	TotalSteps      *int32 `json:"totalSteps,omitempty"`
+	UpdatedReplicas int32  `json:"updatedReplicas"`
+}
+
 // WorkloadSpec Desired state of a Workload
 type WorkloadSpec struct {
 	// Container Container specification
@@ -4578,6 +7427,9 @@ type DataPlaneNameParam = string
 // DeploymentPipelineNameParam defines model for DeploymentPipelineNameParam.
 type DeploymentPipelineNameParam = string
 
+// DomainMappingNameParam defines model for DomainMappingNameParam.
+type DomainMappingNameParam = string
+
 // EnvironmentNameParam defines model for EnvironmentNameParam.
 type EnvironmentNameParam = string
 
@@ -4620,6 +7472,12 @@ type ProjectReleaseNameParam = string
 // ProjectTypeNameParam defines model for ProjectTypeNameParam.
 type ProjectTypeNameParam = string
 
+// QuarantinedResourceKindParam defines model for QuarantinedResourceKindParam.
+type QuarantinedResourceKindParam = string
+
+// QuarantinedResourceNameParam defines model for QuarantinedResourceNameParam.
+type QuarantinedResourceNameParam = string
+
 // ReleaseBindingNameParam defines model for ReleaseBindingNameParam.
 type ReleaseBindingNameParam = string
 
@@ -4647,6 +7505,9 @@ type SecretNameParam = string
 // SecretReferenceNameParam defines model for SecretReferenceNameParam.
 type SecretReferenceNameParam = string
 
+// TargetReleaseNameParam defines model for TargetReleaseNameParam.
+type TargetReleaseNameParam = string
+
 // TraitNameParam defines model for TraitNameParam.
 type TraitNameParam = string
 
@@ -4662,6 +7523,9 @@ type WorkflowQueryParam = string
 // WorkflowRunNameParam defines model for WorkflowRunNameParam.
 type WorkflowRunNameParam = string
 
+// WorkflowVersionParam defines model for WorkflowVersionParam.
+type WorkflowVersionParam = string
+
 // WorkloadNameParam defines model for WorkloadNameParam.
 type WorkloadNameParam = string
 
@@ -4683,6 +7547,15 @@ type NotFound = ErrorResponse
 // NotImplemented Standard error response format
 type NotImplemented = ErrorResponse
 
+// PayloadTooLarge Standard error response format
+type PayloadTooLarge = ErrorResponse
+
+// ProjectQuotaExceeded Error returned when a request is rejected because a limit of the project quota is exhausted
+type ProjectQuotaExceeded = ProjectQuotaExceededError
+
+// QuotaExceeded Error returned when a request is rejected because a quota is exhausted
+type QuotaExceeded = QuotaExceededError
+
 // Unauthorized Standard error response format
 type Unauthorized = ErrorResponse
 
@@ -4948,6 +7821,18 @@ type ListComponentReleasesParams struct {
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// DiffComponentReleasesParams defines parameters for DiffComponentReleases.
+type DiffComponentReleasesParams struct {
+	// Environment Render both releases for this environment and compare the rendered resources
+	Environment *string `form:"environment,omitempty" json:"environment,omitempty"`
+}
+
+// RenderComponentReleaseParams defines parameters for RenderComponentRelease.
+type RenderComponentReleaseParams struct {
+	// Environment Environment to render the release for
+	Environment string `form:"environment" json:"environment"`
+}
+
 // ListComponentsParams defines parameters for ListComponents.
 type ListComponentsParams struct {
 	// Project Filter resources by project name
@@ -4966,6 +7851,21 @@ type ListComponentsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeArchived Include archived components in the list
+	IncludeArchived *bool `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+}
+
+// GetComponentObserverURLParams defines parameters for GetComponentObserverURL.
+type GetComponentObserverURLParams struct {
+	// Environment Environment the component is deployed to
+	Environment string `form:"environment" json:"environment"`
+}
+
+// GetComponentPromotionStatusParams defines parameters for GetComponentPromotionStatus.
+type GetComponentPromotionStatusParams struct {
+	// SourceEnvironment Source environment of the promotion path
+	SourceEnvironment string `form:"sourceEnvironment" json:"sourceEnvironment"`
 }
 
 // ListComponentTypesParams defines parameters for ListComponentTypes.
@@ -5036,6 +7936,21 @@ type ListEnvironmentsParams struct {
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// LookupResourceParams defines parameters for LookupResource.
+type LookupResourceParams struct {
+	// Kind Kind of resource to look up (project, component, environment or dataplane)
+	Kind string `form:"kind" json:"kind"`
+
+	// Name Name of the resource
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Uid Kubernetes UID of the resource
+	Uid *string `form:"uid,omitempty" json:"uid,omitempty"`
+
+	// ExternalId Value of the resource's openchoreo.dev/external-id annotation
+	ExternalId *string `form:"externalId,omitempty" json:"externalId,omitempty"`
+}
+
 // ListObservabilityAlertsNotificationChannelsParams defines parameters for ListObservabilityAlertsNotificationChannels.
 type ListObservabilityAlertsNotificationChannelsParams struct {
 	// LabelSelector A label selector to filter resources using Kubernetes label selector syntax.
@@ -5070,6 +7985,15 @@ type ListObservabilityPlanesParams struct {
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// ListOrphanedResourcesParams defines parameters for ListOrphanedResources.
+type ListOrphanedResourcesParams struct {
+	// Project Filter resources by project name
+	Project *ProjectQueryParam `form:"project,omitempty" json:"project,omitempty"`
+
+	// Component Filter resources by component name
+	Component *ComponentQueryParam `form:"component,omitempty" json:"component,omitempty"`
+}
+
 // ListProjectReleaseBindingsParams defines parameters for ListProjectReleaseBindings.
 type ListProjectReleaseBindingsParams struct {
 	// Project Filter resources by project name
@@ -5127,6 +8051,21 @@ type ListProjectsParams struct {
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetProjectDeliveryMetricsParams defines parameters for GetProjectDeliveryMetrics.
+type GetProjectDeliveryMetricsParams struct {
+	// Environment Compute metrics for this environment instead of the production environments
+	Environment *string `form:"environment,omitempty" json:"environment,omitempty"`
+
+	// WindowDays Length of the reporting window in days, ending now
+	WindowDays *int `form:"windowDays,omitempty" json:"windowDays,omitempty"`
+}
+
+// GetProjectDeploymentStateParams defines parameters for GetProjectDeploymentState.
+type GetProjectDeploymentStateParams struct {
+	// At Point in time to reconstruct, in RFC 3339 format
+	At time.Time `form:"at" json:"at"`
+}
+
 // ListProjectTypesParams defines parameters for ListProjectTypes.
 type ListProjectTypesParams struct {
 	// LabelSelector A label selector to filter resources using Kubernetes label selector syntax.
@@ -5162,6 +8101,9 @@ type ListReleaseBindingsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeRuntime Include the replica counts and traffic rates of each release binding
+	IncludeRuntime *bool `form:"includeRuntime,omitempty" json:"includeRuntime,omitempty"`
 }
 
 // GetReleaseBindingK8sResourceEventsParams defines parameters for GetReleaseBindingK8sResourceEvents.
@@ -5345,6 +8287,12 @@ type GetWorkflowRunEventsParams struct {
 	Task *string `form:"task,omitempty" json:"task,omitempty"`
 }
 
+// GetWorkflowRunFindingsParams defines parameters for GetWorkflowRunFindings.
+type GetWorkflowRunFindingsParams struct {
+	// Severity Only return findings of at least this severity
+	Severity *AnalysisSeverity `form:"severity,omitempty" json:"severity,omitempty"`
+}
+
 // GetWorkflowRunLogsParams defines parameters for GetWorkflowRunLogs.
 type GetWorkflowRunLogsParams struct {
 	// Task Filter logs by task name
@@ -5354,6 +8302,15 @@ type GetWorkflowRunLogsParams struct {
 	SinceSeconds *int64 `form:"sinceSeconds,omitempty" json:"sinceSeconds,omitempty"`
 }
 
+// GetWorkflowRunProgressParams defines parameters for GetWorkflowRunProgress.
+type GetWorkflowRunProgressParams struct {
+	// Follow Stream status transitions as Server-Sent Events until the run finishes
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+
+	// LastEventID Id of the last progress event received, sent by SSE clients when reconnecting
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
 // ListWorkflowsParams defines parameters for ListWorkflows.
 type ListWorkflowsParams struct {
 	// LabelSelector A label selector to filter resources using Kubernetes label selector syntax.
@@ -5391,6 +8348,51 @@ type ListWorkloadsParams struct {
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// UploadWorkloadFilesMultipartBody defines parameters for UploadWorkloadFiles.
+type UploadWorkloadFilesMultipartBody map[string]openapi_types.File
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// Q Free-text query. When omitted, every component matching the filters is returned.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Kind Kind of resource to search
+	Kind *SearchParamsKind `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// Namespace Restrict the search to a namespace. When omitted, all namespaces are searched.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Project Only return components of this project
+	Project *string `form:"project,omitempty" json:"project,omitempty"`
+
+	// Type Only return components of this component type, for example deployment/web-app
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Env Only return components bound to this environment
+	Env *string `form:"env,omitempty" json:"env,omitempty"`
+
+	// Team Only return components owned by this team (spec.catalog.team)
+	Team *string `form:"team,omitempty" json:"team,omitempty"`
+
+	// Domain Only return components of this business domain (spec.catalog.domain)
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty"`
+
+	// Tier Only return components of this tier (spec.catalog.tier)
+	Tier *string `form:"tier,omitempty" json:"tier,omitempty"`
+
+	// Facets Facets to count over all matching results
+	Facets *[]SearchParamsFacets `form:"facets,omitempty" json:"facets,omitempty"`
+
+	// Limit Maximum number of items to return per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SearchParamsKind defines parameters for Search.
+type SearchParamsKind string
+
+// SearchParamsFacets defines parameters for Search.
+type SearchParamsFacets string
+
 // HandleAutoBuildJSONBody defines parameters for HandleAutoBuild.
 type HandleAutoBuildJSONBody map[string]interface{}
 
@@ -5482,6 +8484,12 @@ type CreateClusterWorkflowJSONRequestBody = ClusterWorkflow
 // UpdateClusterWorkflowJSONRequestBody defines body for UpdateClusterWorkflow for application/json ContentType.
 type UpdateClusterWorkflowJSONRequestBody = ClusterWorkflow
 
+// PublishClusterWorkflowVersionJSONRequestBody defines body for PublishClusterWorkflowVersion for application/json ContentType.
+type PublishClusterWorkflowVersionJSONRequestBody = PublishWorkflowVersionRequest
+
+// DeprecateClusterWorkflowVersionJSONRequestBody defines body for DeprecateClusterWorkflowVersion for application/json ContentType.
+type DeprecateClusterWorkflowVersionJSONRequestBody = DeprecateWorkflowVersionRequest
+
 // CreateNamespaceJSONRequestBody defines body for CreateNamespace for application/json ContentType.
 type CreateNamespaceJSONRequestBody = Namespace
 
@@ -5509,9 +8517,27 @@ type CreateComponentJSONRequestBody = Component
 // UpdateComponentJSONRequestBody defines body for UpdateComponent for application/json ContentType.
 type UpdateComponentJSONRequestBody = Component
 
+// CreateDomainMappingJSONRequestBody defines body for CreateDomainMapping for application/json ContentType.
+type CreateDomainMappingJSONRequestBody = CreateDomainMappingRequest
+
+// SetEnvironmentSecretsJSONRequestBody defines body for SetEnvironmentSecrets for application/json ContentType.
+type SetEnvironmentSecretsJSONRequestBody = SetEnvironmentSecretsRequest
+
 // GenerateReleaseJSONRequestBody defines body for GenerateRelease for application/json ContentType.
 type GenerateReleaseJSONRequestBody = GenerateReleaseRequest
 
+// PublishLibraryVersionJSONRequestBody defines body for PublishLibraryVersion for application/json ContentType.
+type PublishLibraryVersionJSONRequestBody = PublishLibraryVersionRequest
+
+// PromoteComponentJSONRequestBody defines body for PromoteComponent for application/json ContentType.
+type PromoteComponentJSONRequestBody = PromoteComponentRequest
+
+// PruneComponentReleasesJSONRequestBody defines body for PruneComponentReleases for application/json ContentType.
+type PruneComponentReleasesJSONRequestBody = PruneComponentReleasesRequest
+
+// TransferComponentJSONRequestBody defines body for TransferComponent for application/json ContentType.
+type TransferComponentJSONRequestBody = TransferComponentRequest
+
 // CreateComponentTypeJSONRequestBody defines body for CreateComponentType for application/json ContentType.
 type CreateComponentTypeJSONRequestBody = ComponentType
 
@@ -5563,6 +8589,9 @@ type CreateProjectJSONRequestBody = Project
 // UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
 type UpdateProjectJSONRequestBody = Project
 
+// PromoteProjectJSONRequestBody defines body for PromoteProject for application/json ContentType.
+type PromoteProjectJSONRequestBody = ProjectPromotionRequest
+
 // CreateProjectTypeJSONRequestBody defines body for CreateProjectType for application/json ContentType.
 type CreateProjectTypeJSONRequestBody = ProjectType
 
@@ -5620,24 +8649,54 @@ type CreateWorkflowRunJSONRequestBody = WorkflowRun
 // UpdateWorkflowRunJSONRequestBody defines body for UpdateWorkflowRun for application/json ContentType.
 type UpdateWorkflowRunJSONRequestBody = WorkflowRun
 
+// CancelWorkflowRunJSONRequestBody defines body for CancelWorkflowRun for application/json ContentType.
+type CancelWorkflowRunJSONRequestBody = CancelWorkflowRunRequest
+
+// ExchangeWorkflowCredentialJSONRequestBody defines body for ExchangeWorkflowCredential for application/json ContentType.
+type ExchangeWorkflowCredentialJSONRequestBody = ExchangeWorkflowCredentialRequest
+
 // CreateWorkflowJSONRequestBody defines body for CreateWorkflow for application/json ContentType.
 type CreateWorkflowJSONRequestBody = Workflow
 
 // UpdateWorkflowJSONRequestBody defines body for UpdateWorkflow for application/json ContentType.
 type UpdateWorkflowJSONRequestBody = Workflow
 
+// PublishWorkflowVersionJSONRequestBody defines body for PublishWorkflowVersion for application/json ContentType.
+type PublishWorkflowVersionJSONRequestBody = PublishWorkflowVersionRequest
+
+// DeprecateWorkflowVersionJSONRequestBody defines body for DeprecateWorkflowVersion for application/json ContentType.
+type DeprecateWorkflowVersionJSONRequestBody = DeprecateWorkflowVersionRequest
+
 // CreateWorkloadJSONRequestBody defines body for CreateWorkload for application/json ContentType.
 type CreateWorkloadJSONRequestBody = Workload
 
 // UpdateWorkloadJSONRequestBody defines body for UpdateWorkload for application/json ContentType.
 type UpdateWorkloadJSONRequestBody = Workload
 
+// UploadWorkloadFilesMultipartRequestBody defines body for UploadWorkloadFiles for multipart/form-data ContentType.
+type UploadWorkloadFilesMultipartRequestBody UploadWorkloadFilesMultipartBody
+
 // HandleAutoBuildJSONRequestBody defines body for HandleAutoBuild for application/json ContentType.
 type HandleAutoBuildJSONRequestBody HandleAutoBuildJSONBody
 
 // CreateGitSecretJSONRequestBody defines body for CreateGitSecret for application/json ContentType.
 type CreateGitSecretJSONRequestBody = CreateGitSecretRequest
 
+// MintReleaseBindingDebugCredentialJSONRequestBody defines body for MintReleaseBindingDebugCredential for application/json ContentType.
+type MintReleaseBindingDebugCredentialJSONRequestBody = DebugCredentialRequest
+
+// LockReleaseBindingJSONRequestBody defines body for LockReleaseBinding for application/json ContentType.
+type LockReleaseBindingJSONRequestBody = ReleaseBindingLockRequest
+
+// EnableReleaseBindingMaintenanceJSONRequestBody defines body for EnableReleaseBindingMaintenance for application/json ContentType.
+type EnableReleaseBindingMaintenanceJSONRequestBody = ReleaseBindingMaintenanceRequest
+
+// ApplyReleaseBindingResourceRecommendationJSONRequestBody defines body for ApplyReleaseBindingResourceRecommendation for application/json ContentType.
+type ApplyReleaseBindingResourceRecommendationJSONRequestBody = ResourceRecommendationApplyRequest
+
+// RunReleaseBindingJobJSONRequestBody defines body for RunReleaseBindingJob for application/json ContentType.
+type RunReleaseBindingJobJSONRequestBody = RunJobRequest
+
 // CreateSecretJSONRequestBody defines body for CreateSecret for application/json ContentType.
 type CreateSecretJSONRequestBody = CreateSecretRequest
 
@@ -0,0 +1,31 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/bodylimit"
+)
+
+// RequestErrorHandler answers requests the strict handler fails to decode. Bodies cut off by
+// the body limit middleware get a 413 that names the limit of the endpoint; other decode
+// errors keep the strict handler's default 400.
+func RequestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	if maxBytesErr, ok := errors.AsType[*http.MaxBytesError](err); ok {
+		bodylimit.WriteError(w, bodyLimit(r.Context(), maxBytesErr))
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+// bodyLimit returns the body size limit that cut off a request body.
+func bodyLimit(ctx context.Context, maxBytesErr *http.MaxBytesError) bodylimit.Limit {
+	if limit, ok := bodylimit.FromContext(ctx); ok {
+		return limit
+	}
+	return bodylimit.Limit{MaxBytes: maxBytesErr.Limit}
+}
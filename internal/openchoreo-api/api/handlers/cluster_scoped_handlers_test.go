@@ -73,6 +73,26 @@ func (s *selectivePDP) GetSubjectProfile(_ context.Context, _ *authzcore.Profile
 	return nil, nil
 }
 
+// actionPDP allows requests whose action is in the allowedActions set.
+type actionPDP struct {
+	allowedActions map[string]bool
+}
+
+func (a *actionPDP) Evaluate(_ context.Context, req *authzcore.EvaluateRequest) (*authzcore.Decision, error) {
+	return &authzcore.Decision{
+		Decision: a.allowedActions[req.Action],
+		Context:  &authzcore.DecisionContext{},
+	}, nil
+}
+
+func (a *actionPDP) BatchEvaluate(_ context.Context, _ *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
+	return nil, nil
+}
+
+func (a *actionPDP) GetSubjectProfile(_ context.Context, _ *authzcore.ProfileRequest) (*authzcore.UserCapabilitiesResponse, error) {
+	return nil, nil
+}
+
 // allowAllPDP is a PDP stub that always allows authorization.
 type allowAllPDP struct{}
 
@@ -80,8 +100,12 @@ func (a *allowAllPDP) Evaluate(_ context.Context, _ *authzcore.EvaluateRequest)
 	return &authzcore.Decision{Decision: true, Context: &authzcore.DecisionContext{}}, nil
 }
 
-func (a *allowAllPDP) BatchEvaluate(_ context.Context, _ *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
-	return nil, nil
+func (a *allowAllPDP) BatchEvaluate(_ context.Context, req *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
+	decisions := make([]authzcore.Decision, len(req.Requests))
+	for i := range decisions {
+		decisions[i] = authzcore.Decision{Decision: true, Context: &authzcore.DecisionContext{}}
+	}
+	return &authzcore.BatchEvaluateResponse{Decisions: decisions}, nil
 }
 
 func (a *allowAllPDP) GetSubjectProfile(_ context.Context, _ *authzcore.ProfileRequest) (*authzcore.UserCapabilitiesResponse, error) {
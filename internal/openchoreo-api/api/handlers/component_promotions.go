@@ -0,0 +1,101 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+)
+
+// PromoteComponent promotes the release of a source environment to the targets of its promotion path.
+func (h *Handler) PromoteComponent(
+	ctx context.Context,
+	request gen.PromoteComponentRequestObject,
+) (gen.PromoteComponentResponseObject, error) {
+	h.logger.Info("PromoteComponent called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	if request.Body == nil {
+		return gen.PromoteComponent400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if request.Body.SourceEnvironment == "" {
+		return gen.PromoteComponent400JSONResponse{BadRequestJSONResponse: badRequest("sourceEnvironment is required")}, nil
+	}
+	var targets []string
+	if request.Body.TargetEnvironments != nil {
+		targets = *request.Body.TargetEnvironments
+	}
+
+	status, err := h.services.PromotionService.Promote(ctx, request.NamespaceName, request.ComponentName,
+		request.Body.SourceEnvironment, targets)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.PromoteComponent403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrComponentNotFound) {
+			return gen.PromoteComponent404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrDeploymentPipelineNotFound) {
+			return gen.PromoteComponent404JSONResponse{NotFoundJSONResponse: notFound("DeploymentPipeline")}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrSourceNotDeployed) {
+			return gen.PromoteComponent409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.PromoteComponent400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to promote component", "error", err)
+		return gen.PromoteComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genStatus, err := convert[models.PromotionStatus, gen.PromotionStatus](*status)
+	if err != nil {
+		h.logger.Error("Failed to convert promotion status", "error", err)
+		return gen.PromoteComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.PromoteComponent200JSONResponse(genStatus), nil
+}
+
+// GetComponentPromotionStatus reports the promotion status of the targets of a promotion path.
+func (h *Handler) GetComponentPromotionStatus(
+	ctx context.Context,
+	request gen.GetComponentPromotionStatusRequestObject,
+) (gen.GetComponentPromotionStatusResponseObject, error) {
+	h.logger.Debug("GetComponentPromotionStatus called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"sourceEnvironment", request.Params.SourceEnvironment)
+
+	status, err := h.services.PromotionService.GetPromotionStatus(ctx, request.NamespaceName, request.ComponentName,
+		request.Params.SourceEnvironment)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetComponentPromotionStatus403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrComponentNotFound) {
+			return gen.GetComponentPromotionStatus404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrDeploymentPipelineNotFound) {
+			return gen.GetComponentPromotionStatus404JSONResponse{NotFoundJSONResponse: notFound("DeploymentPipeline")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.GetComponentPromotionStatus400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to get component promotion status", "error", err)
+		return gen.GetComponentPromotionStatus500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genStatus, err := convert[models.PromotionStatus, gen.PromotionStatus](*status)
+	if err != nil {
+		h.logger.Error("Failed to convert promotion status", "error", err)
+		return gen.GetComponentPromotionStatus500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetComponentPromotionStatus200JSONResponse(genStatus), nil
+}
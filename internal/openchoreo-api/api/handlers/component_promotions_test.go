@@ -0,0 +1,136 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+	promotionmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion/mocks"
+)
+
+func newPromotionHandler(svc promotionsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{PromotionService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func samplePromotionStatus() *models.PromotionStatus {
+	return &models.PromotionStatus{
+		Component:         "api",
+		Project:           "shop",
+		SourceEnvironment: "staging",
+		Release:           "api-r2",
+		Phase:             models.PromotionPhasePartiallyFailed,
+		Targets: []models.PromotionTarget{
+			{Environment: "prod-us", ReleaseBinding: "api-prod-us", Release: "api-r2", Phase: models.PromotionPhaseProgressing},
+			{Environment: "prod-eu", Phase: models.PromotionPhaseBlocked, Message: "source release must soak for 1h0m0s more"},
+		},
+	}
+}
+
+func TestPromoteComponentHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success returns 200 with the status", func(t *testing.T) {
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().Promote(mock.Anything, ns, "api", "staging", []string{"prod-us", "prod-eu"}).Return(samplePromotionStatus(), nil)
+
+		resp, err := newPromotionHandler(svc).PromoteComponent(ctx, gen.PromoteComponentRequestObject{
+			NamespaceName: ns,
+			ComponentName: "api",
+			Body: &gen.PromoteComponentJSONRequestBody{
+				SourceEnvironment:  "staging",
+				TargetEnvironments: &[]string{"prod-us", "prod-eu"},
+			},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.PromoteComponent200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, gen.PromotionStatusPhase("PartiallyFailed"), typed.Phase)
+		require.Len(t, typed.Targets, 2)
+		assert.Equal(t, gen.PromotionTargetStatusPhase("Blocked"), typed.Targets[1].Phase)
+		assert.Nil(t, typed.Targets[1].ReleaseBinding)
+	})
+
+	t.Run("missing source returns 400", func(t *testing.T) {
+		resp, err := newPromotionHandler(promotionmocks.NewMockService(t)).PromoteComponent(ctx, gen.PromoteComponentRequestObject{
+			NamespaceName: ns,
+			ComponentName: "api",
+			Body:          &gen.PromoteComponentJSONRequestBody{},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PromoteComponent400JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"source not deployed -> 409", promotionsvc.ErrSourceNotDeployed, gen.PromoteComponent409JSONResponse{}},
+		{"component not found -> 404", promotionsvc.ErrComponentNotFound, gen.PromoteComponent404JSONResponse{}},
+		{"pipeline not found -> 404", promotionsvc.ErrDeploymentPipelineNotFound, gen.PromoteComponent404JSONResponse{}},
+		{"validation -> 400", &svcpkg.ValidationError{Msg: "not a target"}, gen.PromoteComponent400JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.PromoteComponent403JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.PromoteComponent500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := promotionmocks.NewMockService(t)
+			svc.EXPECT().Promote(mock.Anything, ns, "api", "staging", []string(nil)).Return(nil, tt.svcErr)
+
+			resp, err := newPromotionHandler(svc).PromoteComponent(ctx, gen.PromoteComponentRequestObject{
+				NamespaceName: ns,
+				ComponentName: "api",
+				Body:          &gen.PromoteComponentJSONRequestBody{SourceEnvironment: "staging"},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestGetComponentPromotionStatusHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.GetComponentPromotionStatusRequestObject{
+		NamespaceName: ns,
+		ComponentName: "api",
+		Params:        gen.GetComponentPromotionStatusParams{SourceEnvironment: "staging"},
+	}
+
+	t.Run("success returns 200", func(t *testing.T) {
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().GetPromotionStatus(mock.Anything, ns, "api", "staging").Return(samplePromotionStatus(), nil)
+
+		resp, err := newPromotionHandler(svc).GetComponentPromotionStatus(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetComponentPromotionStatus200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "api-r2", *typed.Release)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().GetPromotionStatus(mock.Anything, ns, "api", "staging").Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := newPromotionHandler(svc).GetComponentPromotionStatus(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetComponentPromotionStatus403JSONResponse{}, resp)
+	})
+}
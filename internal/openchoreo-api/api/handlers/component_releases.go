@@ -8,8 +8,11 @@ import (
 	"errors"
 	"net/http"
 
+	"k8s.io/utils/ptr"
+
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	componentreleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componentrelease"
 )
@@ -146,3 +149,113 @@ func (h *Handler) DeleteComponentRelease(
 	h.logger.Info("ComponentRelease deleted successfully", "namespaceName", request.NamespaceName, "componentRelease", request.ComponentReleaseName)
 	return gen.DeleteComponentRelease204Response{}, nil
 }
+
+// DiffComponentReleases returns the differences between two releases of the same component,
+// optionally including the resources both releases render for an environment.
+func (h *Handler) DiffComponentReleases(
+	ctx context.Context,
+	request gen.DiffComponentReleasesRequestObject,
+) (gen.DiffComponentReleasesResponseObject, error) {
+	h.logger.Debug("DiffComponentReleases called", "namespaceName", request.NamespaceName,
+		"componentReleaseName", request.ComponentReleaseName, "targetReleaseName", request.TargetReleaseName)
+
+	diff, err := h.services.ComponentReleaseService.DiffComponentReleases(ctx, request.NamespaceName,
+		request.ComponentReleaseName, request.TargetReleaseName, ptr.Deref(request.Params.Environment, ""))
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.DiffComponentReleases403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, componentreleasesvc.ErrComponentReleaseNotFound) {
+			return gen.DiffComponentReleases404JSONResponse{NotFoundJSONResponse: notFound("ComponentRelease")}, nil
+		}
+		if errors.Is(err, componentreleasesvc.ErrEnvironmentNotFound) {
+			return gen.DiffComponentReleases404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.DiffComponentReleases400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to diff component releases", "error", err)
+		return gen.DiffComponentReleases500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genDiff, err := convert[models.ComponentReleaseDiff, gen.ComponentReleaseDiff](*diff)
+	if err != nil {
+		h.logger.Error("Failed to convert component release diff", "error", err)
+		return gen.DiffComponentReleases500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.DiffComponentReleases200JSONResponse(genDiff), nil
+}
+
+// RenderComponentRelease returns the resources a component release renders for an environment.
+func (h *Handler) RenderComponentRelease(
+	ctx context.Context,
+	request gen.RenderComponentReleaseRequestObject,
+) (gen.RenderComponentReleaseResponseObject, error) {
+	h.logger.Debug("RenderComponentRelease called", "namespaceName", request.NamespaceName,
+		"componentReleaseName", request.ComponentReleaseName, "environment", request.Params.Environment)
+
+	if request.Params.Environment == "" {
+		return gen.RenderComponentRelease400JSONResponse{BadRequestJSONResponse: badRequest("environment is required")}, nil
+	}
+
+	rendered, err := h.services.ComponentReleaseService.RenderComponentRelease(ctx, request.NamespaceName,
+		request.ComponentReleaseName, request.Params.Environment)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.RenderComponentRelease403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, componentreleasesvc.ErrComponentReleaseNotFound) {
+			return gen.RenderComponentRelease404JSONResponse{NotFoundJSONResponse: notFound("ComponentRelease")}, nil
+		}
+		if errors.Is(err, componentreleasesvc.ErrEnvironmentNotFound) {
+			return gen.RenderComponentRelease404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.RenderComponentRelease400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to render component release", "error", err)
+		return gen.RenderComponentRelease500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRendered, err := convert[models.RenderedComponentRelease, gen.RenderedComponentRelease](*rendered)
+	if err != nil {
+		h.logger.Error("Failed to convert rendered component release", "error", err)
+		return gen.RenderComponentRelease500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.RenderComponentRelease200JSONResponse(genRendered), nil
+}
+
+// PruneComponentReleases deletes the older releases of a component that are not in use.
+func (h *Handler) PruneComponentReleases(
+	ctx context.Context,
+	request gen.PruneComponentReleasesRequestObject,
+) (gen.PruneComponentReleasesResponseObject, error) {
+	h.logger.Info("PruneComponentReleases called", "namespaceName", request.NamespaceName, "componentName", request.ComponentName)
+
+	if request.Body == nil {
+		return gen.PruneComponentReleases400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	result, err := h.services.ComponentReleaseService.PruneComponentReleases(ctx, request.NamespaceName,
+		request.ComponentName, int(request.Body.Keep), ptr.Deref(request.Body.DryRun, false))
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.PruneComponentReleases403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, componentreleasesvc.ErrComponentNotFound) {
+			return gen.PruneComponentReleases404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.PruneComponentReleases400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to prune component releases", "error", err)
+		return gen.PruneComponentReleases500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genResult, err := convert[models.ComponentReleasePrune, gen.ComponentReleasePrune](*result)
+	if err != nil {
+		h.logger.Error("Failed to convert component release prune result", "error", err)
+		return gen.PruneComponentReleases500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.PruneComponentReleases200JSONResponse(genResult), nil
+}
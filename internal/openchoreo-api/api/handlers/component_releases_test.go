@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -17,7 +18,10 @@ import (
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	componentreleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componentrelease"
+	componentreleasemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componentrelease/mocks"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
 )
 
@@ -222,3 +226,187 @@ func TestDeleteComponentReleaseHandler(t *testing.T) {
 		assert.IsType(t, gen.DeleteComponentRelease403JSONResponse{}, resp)
 	})
 }
+
+// --- DiffComponentReleases Handler ---
+
+func TestDiffComponentReleasesHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.DiffComponentReleasesRequestObject{
+		NamespaceName:        "test-ns",
+		ComponentReleaseName: "cr-1",
+		TargetReleaseName:    "cr-2",
+		Params:               gen.DiffComponentReleasesParams{Environment: ptr.To("development")},
+	}
+
+	t.Run("success returns changes and rendered resources", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().DiffComponentReleases(mock.Anything, "test-ns", "cr-1", "cr-2", "development").Return(&models.ComponentReleaseDiff{
+			Project:       "shop",
+			Component:     "api",
+			BaseRelease:   "cr-1",
+			TargetRelease: "cr-2",
+			Changes: []openchoreov1alpha1.ReleaseChange{{
+				Path: "workload.container.image", Type: openchoreov1alpha1.ReleaseChangeModified, From: `"app:v1"`, To: `"app:v2"`,
+			}},
+			Environment: "development",
+			Resources: []models.RenderedResourceDiff{{
+				APIVersion: "apps/v1", Kind: "Deployment", Name: "api", TargetPlane: "dataplane",
+				Type: openchoreov1alpha1.ReleaseChangeModified,
+			}},
+		}, nil)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).DiffComponentReleases(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.DiffComponentReleases200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Changes, 1)
+		assert.Equal(t, gen.ReleaseChangeTypeModified, typed.Changes[0].Type)
+		require.NotNil(t, typed.Resources)
+		assert.Equal(t, gen.RenderedResourceDiffTypeModified, (*typed.Resources)[0].Type)
+	})
+
+	t.Run("release not found returns 404", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().DiffComponentReleases(mock.Anything, "test-ns", "cr-1", "cr-2", "development").
+			Return(nil, componentreleasesvc.ErrComponentReleaseNotFound)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).DiffComponentReleases(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DiffComponentReleases404JSONResponse{}, resp)
+	})
+
+	t.Run("releases of different components returns 400", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().DiffComponentReleases(mock.Anything, "test-ns", "cr-1", "cr-2", "development").
+			Return(nil, &svcpkg.ValidationError{Msg: "component releases belong to different components"})
+
+		resp, err := newHandlerWithComponentReleaseService(svc).DiffComponentReleases(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DiffComponentReleases400JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().DiffComponentReleases(mock.Anything, "test-ns", "cr-1", "cr-2", "development").Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).DiffComponentReleases(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DiffComponentReleases403JSONResponse{}, resp)
+	})
+}
+
+// --- RenderComponentRelease Handler ---
+
+func TestRenderComponentReleaseHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.RenderComponentReleaseRequestObject{
+		NamespaceName:        "test-ns",
+		ComponentReleaseName: "cr-1",
+		Params:               gen.RenderComponentReleaseParams{Environment: "production"},
+	}
+
+	t.Run("success returns rendered resources", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().RenderComponentRelease(mock.Anything, "test-ns", "cr-1", "production").Return(&models.RenderedComponentRelease{
+			Project:     "shop",
+			Component:   "api",
+			Release:     "cr-1",
+			Environment: "production",
+			Resources: []models.RenderedResource{{
+				TargetPlane: "dataplane",
+				Resource:    map[string]any{"apiVersion": "apps/v1", "kind": "Deployment"},
+			}},
+		}, nil)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).RenderComponentRelease(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.RenderComponentRelease200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "production", typed.Environment)
+		require.Len(t, typed.Resources, 1)
+		assert.Equal(t, "Deployment", typed.Resources[0].Resource["kind"])
+	})
+
+	t.Run("missing environment returns 400", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).RenderComponentRelease(ctx,
+			gen.RenderComponentReleaseRequestObject{NamespaceName: "test-ns", ComponentReleaseName: "cr-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RenderComponentRelease400JSONResponse{}, resp)
+	})
+
+	t.Run("environment not found returns 404", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().RenderComponentRelease(mock.Anything, "test-ns", "cr-1", "production").
+			Return(nil, componentreleasesvc.ErrEnvironmentNotFound)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).RenderComponentRelease(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.RenderComponentRelease404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().RenderComponentRelease(mock.Anything, "test-ns", "cr-1", "production").Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).RenderComponentRelease(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.RenderComponentRelease403JSONResponse{}, resp)
+	})
+}
+
+// --- PruneComponentReleases Handler ---
+
+func TestPruneComponentReleasesHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.PruneComponentReleasesRequestObject{
+		NamespaceName: "test-ns",
+		ComponentName: "api",
+		Body:          &gen.PruneComponentReleasesJSONRequestBody{Keep: 3, DryRun: ptr.To(true)},
+	}
+
+	t.Run("success returns deleted and in-use releases", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().PruneComponentReleases(mock.Anything, "test-ns", "api", 3, true).Return(&models.ComponentReleasePrune{
+			Project: "shop", Component: "api", DryRun: true, Deleted: []string{"api-1"}, InUse: []string{"api-2"},
+		}, nil)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).PruneComponentReleases(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.PruneComponentReleases200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.True(t, typed.DryRun)
+		assert.Equal(t, []string{"api-1"}, typed.Deleted)
+		assert.Equal(t, []string{"api-2"}, typed.InUse)
+	})
+
+	t.Run("missing body returns 400", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).PruneComponentReleases(ctx, gen.PruneComponentReleasesRequestObject{
+			NamespaceName: "test-ns", ComponentName: "api",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PruneComponentReleases400JSONResponse{}, resp)
+	})
+
+	t.Run("component not found returns 404", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().PruneComponentReleases(mock.Anything, "test-ns", "api", 3, true).
+			Return(nil, componentreleasesvc.ErrComponentNotFound)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).PruneComponentReleases(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.PruneComponentReleases404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := componentreleasemocks.NewMockService(t)
+		svc.EXPECT().PruneComponentReleases(mock.Anything, "test-ns", "api", 3, true).Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := newHandlerWithComponentReleaseService(svc).PruneComponentReleases(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.PruneComponentReleases403JSONResponse{}, resp)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
@@ -33,6 +34,9 @@ func (h *Handler) ListComponents(
 	}
 
 	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	if request.Params.IncludeArchived == nil || !*request.Params.IncludeArchived {
+		opts.LabelSelector = componentsvc.ExcludeArchived(opts.LabelSelector)
+	}
 
 	result, err := h.services.ComponentService.ListComponents(ctx, request.NamespaceName, projectName, opts)
 	if err != nil {
@@ -88,6 +92,9 @@ func (h *Handler) CreateComponent(
 		if errors.Is(err, componentsvc.ErrComponentAlreadyExists) {
 			return gen.CreateComponent409JSONResponse{ConflictJSONResponse: conflict("Component already exists")}, nil
 		}
+		if quotaErr, ok := errors.AsType[*controller.ProjectQuotaExceededError](err); ok {
+			return gen.CreateComponent429JSONResponse{ProjectQuotaExceededJSONResponse: projectQuotaExceeded(quotaErr)}, nil
+		}
 		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
 			if validationErr.StatusCode == http.StatusUnprocessableEntity {
 				return gen.CreateComponent422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
@@ -239,8 +246,14 @@ func (h *Handler) UpdateComponent(
 			return gen.UpdateComponent403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
 		}
 		if errors.Is(err, componentsvc.ErrComponentNotFound) {
+			if componentCR.UID == "" {
+				return h.createComponentOnUpdate(ctx, request.NamespaceName, &componentCR)
+			}
 			return gen.UpdateComponent404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
 		}
+		if errors.Is(err, svcerrors.ErrUIDMismatch) {
+			return gen.UpdateComponent409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
 		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
 			if validationErr.StatusCode == http.StatusUnprocessableEntity {
 				return gen.UpdateComponent422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
@@ -261,6 +274,45 @@ func (h *Handler) UpdateComponent(
 	return gen.UpdateComponent200JSONResponse(genComponent), nil
 }
 
+// createComponentOnUpdate creates the component of an update request that found no existing
+// component, which makes PUT an idempotent upsert. Creating requires the create permission.
+func (h *Handler) createComponentOnUpdate(
+	ctx context.Context,
+	namespaceName string,
+	componentCR *openchoreov1alpha1.Component,
+) (gen.UpdateComponentResponseObject, error) {
+	componentCR.ResourceVersion = ""
+	created, err := h.services.ComponentService.CreateComponent(ctx, namespaceName, componentCR)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.UpdateComponent403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, componentsvc.ErrComponentAlreadyExists) {
+			return gen.UpdateComponent409JSONResponse{ConflictJSONResponse: conflict("Component already exists")}, nil
+		}
+		if errors.Is(err, projectsvc.ErrProjectNotFound) {
+			return gen.UpdateComponent400JSONResponse{BadRequestJSONResponse: badRequest("Referenced project not found")}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			if validationErr.StatusCode == http.StatusUnprocessableEntity {
+				return gen.UpdateComponent422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+			}
+			return gen.UpdateComponent400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create component", "error", err)
+		return gen.UpdateComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genComponent, err := convert[openchoreov1alpha1.Component, gen.Component](*created)
+	if err != nil {
+		h.logger.Error("Failed to convert created component", "error", err)
+		return gen.UpdateComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Component created by update", "namespaceName", namespaceName, "component", created.Name)
+	return gen.UpdateComponent201JSONResponse(genComponent), nil
+}
+
 // DeleteComponent deletes a component by name.
 func (h *Handler) DeleteComponent(
 	ctx context.Context,
@@ -373,4 +425,210 @@ func (h *Handler) GenerateRelease(
 	return gen.GenerateRelease201JSONResponse(genRelease), nil
 }
 
+// PublishLibraryVersion records a published version of a library component
+func (h *Handler) PublishLibraryVersion(
+	ctx context.Context,
+	request gen.PublishLibraryVersionRequestObject,
+) (gen.PublishLibraryVersionResponseObject, error) {
+	h.logger.Info("PublishLibraryVersion called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	if request.Body == nil {
+		return gen.PublishLibraryVersion400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	component, err := h.services.ComponentService.PublishLibraryVersion(ctx, request.NamespaceName, request.ComponentName,
+		&componentsvc.PublishLibraryVersionRequest{Version: request.Body.Version, Artifact: request.Body.Artifact})
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.PublishLibraryVersion403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, componentsvc.ErrComponentNotFound) {
+			return gen.PublishLibraryVersion404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, componentsvc.ErrValidation) {
+			return gen.PublishLibraryVersion400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		if errors.Is(err, componentsvc.ErrLibraryVersionExists) {
+			return gen.PublishLibraryVersion409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to publish library version", "error", err)
+		return gen.PublishLibraryVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genComponent, err := convert[openchoreov1alpha1.Component, gen.Component](*component)
+	if err != nil {
+		h.logger.Error("Failed to convert component", "error", err)
+		return gen.PublishLibraryVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.PublishLibraryVersion200JSONResponse(genComponent), nil
+}
+
+// ArchiveComponent undeploys a component from every environment while keeping its definition and release history.
+func (h *Handler) ArchiveComponent(
+	ctx context.Context,
+	request gen.ArchiveComponentRequestObject,
+) (gen.ArchiveComponentResponseObject, error) {
+	h.logger.Info("ArchiveComponent called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	component, err := h.services.ComponentService.ArchiveComponent(ctx, request.NamespaceName, request.ComponentName)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.ArchiveComponent403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, componentsvc.ErrComponentNotFound) {
+			return gen.ArchiveComponent404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		h.logger.Error("Failed to archive component", "error", err)
+		return gen.ArchiveComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genComponent, err := convert[openchoreov1alpha1.Component, gen.Component](*component)
+	if err != nil {
+		h.logger.Error("Failed to convert component", "error", err)
+		return gen.ArchiveComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ArchiveComponent200JSONResponse(genComponent), nil
+}
+
+// UnarchiveComponent makes an archived component active again.
+func (h *Handler) UnarchiveComponent(
+	ctx context.Context,
+	request gen.UnarchiveComponentRequestObject,
+) (gen.UnarchiveComponentResponseObject, error) {
+	h.logger.Info("UnarchiveComponent called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	component, err := h.services.ComponentService.UnarchiveComponent(ctx, request.NamespaceName, request.ComponentName)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.UnarchiveComponent403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, componentsvc.ErrComponentNotFound) {
+			return gen.UnarchiveComponent404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		h.logger.Error("Failed to unarchive component", "error", err)
+		return gen.UnarchiveComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genComponent, err := convert[openchoreov1alpha1.Component, gen.Component](*component)
+	if err != nil {
+		h.logger.Error("Failed to convert component", "error", err)
+		return gen.UnarchiveComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.UnarchiveComponent200JSONResponse(genComponent), nil
+}
+
+// TransferComponent moves a component and the resources that belong to it to another project.
+func (h *Handler) TransferComponent(
+	ctx context.Context,
+	request gen.TransferComponentRequestObject,
+) (gen.TransferComponentResponseObject, error) {
+	h.logger.Info("TransferComponent called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	if request.Body == nil {
+		return gen.TransferComponent400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if request.Body.TargetProject == "" {
+		return gen.TransferComponent400JSONResponse{BadRequestJSONResponse: badRequest("targetProject is required")}, nil
+	}
+
+	result, err := h.services.ComponentService.TransferComponent(ctx, request.NamespaceName, request.ComponentName,
+		&componentsvc.TransferComponentRequest{
+			TargetProject: request.Body.TargetProject,
+			DryRun:        ptr.Deref(request.Body.DryRun, false),
+		})
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.TransferComponent403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, componentsvc.ErrComponentNotFound) {
+			return gen.TransferComponent404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, projectsvc.ErrProjectNotFound) {
+			return gen.TransferComponent404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		}
+		if errors.Is(err, componentsvc.ErrComponentTransferFailed) {
+			h.logger.Error("Failed to transfer component", "error", err)
+			return gen.TransferComponent409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			if validationErr.StatusCode == http.StatusUnprocessableEntity {
+				return gen.TransferComponent422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+			}
+			return gen.TransferComponent400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to transfer component", "error", err)
+		return gen.TransferComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.TransferComponent200JSONResponse(toGenComponentTransfer(result)), nil
+}
+
+// GetComponentObserverURL returns the observer URL that serves a component's logs in an environment.
+func (h *Handler) GetComponentObserverURL(
+	ctx context.Context,
+	request gen.GetComponentObserverURLRequestObject,
+) (gen.GetComponentObserverURLResponseObject, error) {
+	h.logger.Debug("GetComponentObserverURL called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"environment", request.Params.Environment)
+
+	observerURL, err := h.services.ComponentService.GetComponentObserverURL(ctx, request.NamespaceName, request.ComponentName, request.Params.Environment)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.GetComponentObserverURL403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, componentsvc.ErrComponentNotFound) {
+			return gen.GetComponentObserverURL404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, componentsvc.ErrEnvironmentNotFound) {
+			return gen.GetComponentObserverURL404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+		}
+		if errors.Is(err, componentsvc.ErrObserverURLNotConfigured) {
+			return gen.GetComponentObserverURL422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(err.Error())}, nil
+		}
+		h.logger.Error("Failed to get component observer URL", "error", err)
+		return gen.GetComponentObserverURL500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetComponentObserverURL200JSONResponse{
+		Component:   request.ComponentName,
+		Environment: request.Params.Environment,
+		ObserverURL: observerURL,
+	}, nil
+}
+
+func toGenComponentTransfer(result *componentsvc.TransferResult) gen.ComponentTransfer {
+	steps := make([]gen.ComponentTransferStep, 0, len(result.Steps))
+	for _, step := range result.Steps {
+		genStep := gen.ComponentTransferStep{
+			Kind:   step.Kind,
+			Name:   step.Name,
+			Status: gen.ComponentTransferStepStatus(step.Status),
+		}
+		if step.Message != "" {
+			genStep.Message = ptr.To(step.Message)
+		}
+		steps = append(steps, genStep)
+	}
+	return gen.ComponentTransfer{
+		Component:     result.ComponentName,
+		SourceProject: result.SourceProject,
+		TargetProject: result.TargetProject,
+		DryRun:        result.DryRun,
+		Steps:         steps,
+	}
+}
+
 // Converter functions
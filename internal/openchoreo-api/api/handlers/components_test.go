@@ -6,6 +6,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
@@ -15,12 +16,14 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
@@ -492,6 +495,7 @@ func TestUpdateComponentHandler_MapsErrors(t *testing.T) {
 	}{
 		{"forbidden -> 403", svcpkg.ErrForbidden, gen.UpdateComponent403JSONResponse{}},
 		{"not found -> 404", componentsvc.ErrComponentNotFound, gen.UpdateComponent404JSONResponse{}},
+		{"uid mismatch -> 409", svcpkg.ErrUIDMismatch, gen.UpdateComponent409JSONResponse{}},
 		{"validation -> 400", &svcpkg.ValidationError{Msg: "bad request"}, gen.UpdateComponent400JSONResponse{}},
 		{"internal -> 500", errors.New("internal server error"), gen.UpdateComponent500JSONResponse{}},
 	}
@@ -504,7 +508,73 @@ func TestUpdateComponentHandler_MapsErrors(t *testing.T) {
 				services: &handlerservices.Services{ComponentService: svc},
 				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
 			}
-			body := gen.Component{Metadata: gen.ObjectMeta{Name: "comp-a"}}
+			// A uid pins the update, so a missing component is not created.
+			body := gen.Component{Metadata: gen.ObjectMeta{Name: "comp-a", Uid: ptr.To("uid-a")}}
+			resp, err := h.UpdateComponent(ctx, gen.UpdateComponentRequestObject{
+				NamespaceName: "test-ns",
+				ComponentName: "comp-a",
+				Body:          &body,
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestUpdateComponentHandler_CreatesWhenMissing(t *testing.T) {
+	ctx := testContext()
+
+	svc := componentsvcmocks.NewMockService(t)
+	svc.EXPECT().UpdateComponent(mock.Anything, "test-ns", mock.Anything).Return(nil, componentsvc.ErrComponentNotFound)
+	svc.EXPECT().CreateComponent(mock.Anything, "test-ns", mock.Anything).RunAndReturn(func(_ context.Context, _ string, component *openchoreov1alpha1.Component) (*openchoreov1alpha1.Component, error) {
+		assert.Equal(t, "comp-a", component.Name)
+		return component, nil
+	})
+	h := &Handler{
+		services: &handlerservices.Services{ComponentService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	body := gen.Component{Metadata: gen.ObjectMeta{Name: "comp-a"}}
+	resp, err := h.UpdateComponent(ctx, gen.UpdateComponentRequestObject{
+		NamespaceName: "test-ns",
+		ComponentName: "comp-a",
+		Body:          &body,
+	})
+	require.NoError(t, err)
+	assert.IsType(t, gen.UpdateComponent201JSONResponse{}, resp)
+}
+
+func TestUpdateComponentHandler_UpsertPermissions(t *testing.T) {
+	ctx := testContext()
+	project := &openchoreov1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-a", Namespace: "test-ns"},
+		Spec:       openchoreov1alpha1.ProjectSpec{DeploymentPipelineRef: openchoreov1alpha1.DeploymentPipelineRef{Name: "default"}},
+	}
+
+	tests := []struct {
+		name    string
+		allowed []string
+		objects []client.Object
+		wantTyp any
+	}{
+		{"update allowed on existing component", []string{authzcore.ActionUpdateComponent}, []client.Object{project, testComponentObj("proj-a", "comp-a")}, gen.UpdateComponent200JSONResponse{}},
+		{"update only on missing component", []string{authzcore.ActionUpdateComponent}, []client.Object{project}, gen.UpdateComponent403JSONResponse{}},
+		{"create only on existing component", []string{authzcore.ActionCreateComponent}, []client.Object{project, testComponentObj("proj-a", "comp-a")}, gen.UpdateComponent403JSONResponse{}},
+		{"create only on missing component", []string{authzcore.ActionCreateComponent}, []client.Object{project}, gen.UpdateComponent201JSONResponse{}},
+		{"neither on missing component", nil, []client.Object{project}, gen.UpdateComponent403JSONResponse{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pdp := &actionPDP{allowedActions: map[string]bool{}}
+			for _, action := range tt.allowed {
+				pdp.allowedActions[action] = true
+			}
+			h := newHandlerWithComponentService(newComponentService(t, tt.objects, pdp))
+
+			body, err := convert[openchoreov1alpha1.Component, gen.Component](*testComponentObj("proj-a", "comp-a"))
+			require.NoError(t, err)
 			resp, err := h.UpdateComponent(ctx, gen.UpdateComponentRequestObject{
 				NamespaceName: "test-ns",
 				ComponentName: "comp-a",
@@ -585,6 +655,7 @@ func TestCreateComponentHandler(t *testing.T) {
 		{"forbidden -> 403", svcpkg.ErrForbidden, gen.CreateComponent403JSONResponse{}},
 		{"project not found -> 400", projectsvc.ErrProjectNotFound, gen.CreateComponent400JSONResponse{}},
 		{"already exists -> 409", componentsvc.ErrComponentAlreadyExists, gen.CreateComponent409JSONResponse{}},
+		{"project quota -> 429", &controller.ProjectQuotaExceededError{Resource: controller.ProjectQuotaComponents}, gen.CreateComponent429JSONResponse{}},
 		{"validation -> 400", &svcpkg.ValidationError{Msg: "bad request"}, gen.CreateComponent400JSONResponse{}},
 		{"internal -> 500", errors.New("internal server error"), gen.CreateComponent500JSONResponse{}},
 	}
@@ -601,6 +672,30 @@ func TestCreateComponentHandler(t *testing.T) {
 			assert.IsType(t, tt.wantTyp, resp)
 		})
 	}
+
+	t.Run("project quota details", func(t *testing.T) {
+		svc := componentsvcmocks.NewMockService(t)
+		svc.EXPECT().CreateComponent(mock.Anything, ns, mock.Anything).Return(nil, &controller.ProjectQuotaExceededError{
+			Project:  "shop",
+			Resource: controller.ProjectQuotaCPU,
+			Limit:    resource.MustParse("2"),
+			Usage:    resource.MustParse("2500m"),
+		})
+		h := &Handler{
+			services: &handlerservices.Services{ComponentService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.CreateComponent(ctx, gen.CreateComponentRequestObject{NamespaceName: ns, Body: body})
+		require.NoError(t, err)
+		quotaResp, ok := resp.(gen.CreateComponent429JSONResponse)
+		require.True(t, ok, "expected 429, got %T", resp)
+		assert.Equal(t, gen.CPU, quotaResp.Reason)
+		assert.Equal(t, "shop", quotaResp.Project)
+		assert.Equal(t, "2", quotaResp.Limit)
+		assert.Equal(t, "2500m", quotaResp.Usage)
+		assert.Equal(t, "0", quotaResp.Remaining)
+		assert.Equal(t, string(gen.QUOTAEXCEEDED), quotaResp.Code)
+	})
 }
 
 // --- GetComponent ---
@@ -784,6 +879,179 @@ func TestGenerateReleaseHandler(t *testing.T) {
 	}
 }
 
+// --- PublishLibraryVersion ---
+
+func TestPublishLibraryVersionHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	body := &gen.PublishLibraryVersionRequest{Version: "1.0.0", Artifact: "registry.example.com/lib:1.0.0"}
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := &Handler{
+			services: &handlerservices.Services{ComponentService: componentsvcmocks.NewMockService(t)},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.PublishLibraryVersion(ctx, gen.PublishLibraryVersionRequestObject{NamespaceName: ns, ComponentName: "lib-a", Body: nil})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PublishLibraryVersion400JSONResponse{}, resp)
+	})
+
+	t.Run("success forwards the version", func(t *testing.T) {
+		svc := componentsvcmocks.NewMockService(t)
+		svc.EXPECT().PublishLibraryVersion(mock.Anything, ns, "lib-a",
+			&componentsvc.PublishLibraryVersionRequest{Version: "1.0.0", Artifact: "registry.example.com/lib:1.0.0"}).
+			Return(testComponentObj("proj", "lib-a"), nil)
+		h := &Handler{
+			services: &handlerservices.Services{ComponentService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.PublishLibraryVersion(ctx, gen.PublishLibraryVersionRequestObject{NamespaceName: ns, ComponentName: "lib-a", Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PublishLibraryVersion200JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.PublishLibraryVersion403JSONResponse{}},
+		{"component not found -> 404", componentsvc.ErrComponentNotFound, gen.PublishLibraryVersion404JSONResponse{}},
+		{"validation error -> 400", fmt.Errorf("%w: component is not a library", componentsvc.ErrValidation), gen.PublishLibraryVersion400JSONResponse{}},
+		{"version exists -> 409", componentsvc.ErrLibraryVersionExists, gen.PublishLibraryVersion409JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.PublishLibraryVersion500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := componentsvcmocks.NewMockService(t)
+			svc.EXPECT().PublishLibraryVersion(mock.Anything, ns, "lib-a", mock.Anything).Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{ComponentService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.PublishLibraryVersion(ctx, gen.PublishLibraryVersionRequestObject{NamespaceName: ns, ComponentName: "lib-a", Body: body})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestTransferComponentHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	body := &gen.TransferComponentRequest{TargetProject: "payments", DryRun: ptr.To(true)}
+
+	t.Run("missing target project returns 400", func(t *testing.T) {
+		h := &Handler{
+			services: &handlerservices.Services{ComponentService: componentsvcmocks.NewMockService(t)},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.TransferComponent(ctx, gen.TransferComponentRequestObject{NamespaceName: ns, ComponentName: "api", Body: &gen.TransferComponentRequest{}})
+		require.NoError(t, err)
+		assert.IsType(t, gen.TransferComponent400JSONResponse{}, resp)
+	})
+
+	t.Run("success reports the moved resources", func(t *testing.T) {
+		svc := componentsvcmocks.NewMockService(t)
+		svc.EXPECT().TransferComponent(mock.Anything, ns, "api",
+			&componentsvc.TransferComponentRequest{TargetProject: "payments", DryRun: true}).
+			Return(&componentsvc.TransferResult{
+				ComponentName: "api",
+				SourceProject: "shop",
+				TargetProject: "payments",
+				DryRun:        true,
+				Steps:         []componentsvc.TransferStep{{Kind: "Component", Name: "api", Status: componentsvc.TransferStepPlanned}},
+			}, nil)
+		h := &Handler{
+			services: &handlerservices.Services{ComponentService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.TransferComponent(ctx, gen.TransferComponentRequestObject{NamespaceName: ns, ComponentName: "api", Body: body})
+		require.NoError(t, err)
+		ok, isOK := resp.(gen.TransferComponent200JSONResponse)
+		require.True(t, isOK)
+		assert.Equal(t, "shop", ok.SourceProject)
+		require.Len(t, ok.Steps, 1)
+		assert.Equal(t, gen.ComponentTransferStepStatusPlanned, ok.Steps[0].Status)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.TransferComponent403JSONResponse{}},
+		{"component not found -> 404", componentsvc.ErrComponentNotFound, gen.TransferComponent404JSONResponse{}},
+		{"project not found -> 404", projectsvc.ErrProjectNotFound, gen.TransferComponent404JSONResponse{}},
+		{"validation error -> 400", &svcpkg.ValidationError{Msg: "environment not in pipeline"}, gen.TransferComponent400JSONResponse{}},
+		{"rolled back -> 409", fmt.Errorf("%w: failed to move Component", componentsvc.ErrComponentTransferFailed), gen.TransferComponent409JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.TransferComponent500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := componentsvcmocks.NewMockService(t)
+			svc.EXPECT().TransferComponent(mock.Anything, ns, "api", mock.Anything).Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{ComponentService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.TransferComponent(ctx, gen.TransferComponentRequestObject{NamespaceName: ns, ComponentName: "api", Body: body})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestGetComponentObserverURLHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.GetComponentObserverURLRequestObject{
+		NamespaceName: ns,
+		ComponentName: "api",
+		Params:        gen.GetComponentObserverURLParams{Environment: "dev"},
+	}
+
+	t.Run("success returns the observer URL", func(t *testing.T) {
+		svc := componentsvcmocks.NewMockService(t)
+		svc.EXPECT().GetComponentObserverURL(mock.Anything, ns, "api", "dev").Return("http://observer.dev", nil)
+		h := &Handler{
+			services: &handlerservices.Services{ComponentService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.GetComponentObserverURL(ctx, request)
+		require.NoError(t, err)
+		ok, isOK := resp.(gen.GetComponentObserverURL200JSONResponse)
+		require.True(t, isOK)
+		assert.Equal(t, "http://observer.dev", ok.ObserverURL)
+		assert.Equal(t, "dev", ok.Environment)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetComponentObserverURL403JSONResponse{}},
+		{"component not found -> 404", componentsvc.ErrComponentNotFound, gen.GetComponentObserverURL404JSONResponse{}},
+		{"environment not found -> 404", componentsvc.ErrEnvironmentNotFound, gen.GetComponentObserverURL404JSONResponse{}},
+		{"observer not configured -> 422", fmt.Errorf("%w: no observer URL", componentsvc.ErrObserverURLNotConfigured), gen.GetComponentObserverURL422JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.GetComponentObserverURL500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := componentsvcmocks.NewMockService(t)
+			svc.EXPECT().GetComponentObserverURL(mock.Anything, ns, "api", "dev").Return("", tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{ComponentService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.GetComponentObserverURL(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
 // --- toModelWorkflowConfig and mapToRawExtension ---
 
 func TestToModelWorkflowConfig(t *testing.T) {
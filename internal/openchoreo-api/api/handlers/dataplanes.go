@@ -149,8 +149,14 @@ func (h *Handler) UpdateDataPlane(
 			return gen.UpdateDataPlane403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
 		}
 		if errors.Is(err, dataplanesvc.ErrDataPlaneNotFound) {
+			if dpCR.UID == "" {
+				return h.createDataPlaneOnUpdate(ctx, request.NamespaceName, &dpCR)
+			}
 			return gen.UpdateDataPlane404JSONResponse{NotFoundJSONResponse: notFound("DataPlane")}, nil
 		}
+		if errors.Is(err, services.ErrUIDMismatch) {
+			return gen.UpdateDataPlane409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			if validationErr.StatusCode == http.StatusUnprocessableEntity {
 				return gen.UpdateDataPlane422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
@@ -171,6 +177,42 @@ func (h *Handler) UpdateDataPlane(
 	return gen.UpdateDataPlane200JSONResponse(genDP), nil
 }
 
+// createDataPlaneOnUpdate creates the data plane of an update request that found no existing
+// data plane, which makes PUT an idempotent upsert. Creating requires the create permission.
+func (h *Handler) createDataPlaneOnUpdate(
+	ctx context.Context,
+	namespaceName string,
+	dpCR *openchoreov1alpha1.DataPlane,
+) (gen.UpdateDataPlaneResponseObject, error) {
+	dpCR.ResourceVersion = ""
+	created, err := h.services.DataPlaneService.CreateDataPlane(ctx, namespaceName, dpCR)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.UpdateDataPlane403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, dataplanesvc.ErrDataPlaneAlreadyExists) {
+			return gen.UpdateDataPlane409JSONResponse{ConflictJSONResponse: conflict("DataPlane already exists")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			if validationErr.StatusCode == http.StatusUnprocessableEntity {
+				return gen.UpdateDataPlane422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+			}
+			return gen.UpdateDataPlane400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create data plane", "error", err)
+		return gen.UpdateDataPlane500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genDataPlane, err := convert[openchoreov1alpha1.DataPlane, gen.DataPlane](*created)
+	if err != nil {
+		h.logger.Error("Failed to convert created data plane", "error", err)
+		return gen.UpdateDataPlane500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("DataPlane created by update", "namespaceName", namespaceName, "dataPlane", created.Name)
+	return gen.UpdateDataPlane201JSONResponse(genDataPlane), nil
+}
+
 // DeleteDataPlane deletes a data plane by name.
 func (h *Handler) DeleteDataPlane(
 	ctx context.Context,
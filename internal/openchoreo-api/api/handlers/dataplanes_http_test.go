@@ -239,14 +239,14 @@ func TestDataPlaneHTTPUpdate(t *testing.T) {
 	assertConformsToSpec(t, req, rec.Code, rec.Result().Header, bodyBytes)
 }
 
-func TestDataPlaneHTTPUpdateNotFound(t *testing.T) {
+func TestDataPlaneHTTPUpdateCreatesWhenMissing(t *testing.T) {
 	bundle := newDataPlaneBundle(t, nil, &allowAllPDP{})
 
 	body, _ := json.Marshal(gen.DataPlane{Metadata: gen.ObjectMeta{Name: "nonexistent"}})
 	_, rec := doRequest(t, bundle.handler, http.MethodPut,
 		"/api/v1/namespaces/"+testNS+"/dataplanes/nonexistent", body)
 
-	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, http.StatusCreated, rec.Code)
 }
 
 func TestDataPlaneHTTPUpdateForbidden(t *testing.T) {
@@ -245,7 +245,7 @@ func TestUpdateDataPlaneHandler(t *testing.T) {
 		assert.IsType(t, gen.UpdateDataPlane400JSONResponse{}, resp)
 	})
 
-	t.Run("not found returns 404", func(t *testing.T) {
+	t.Run("not found creates and returns 201", func(t *testing.T) {
 		svc := newDataPlaneService(t, nil, &allowAllPDP{})
 		h := newHandlerWithDataPlaneService(svc)
 
@@ -255,9 +255,35 @@ func TestUpdateDataPlaneHandler(t *testing.T) {
 			Body:          &gen.DataPlane{Metadata: gen.ObjectMeta{Name: "nonexistent"}},
 		})
 		require.NoError(t, err)
+		assert.IsType(t, gen.UpdateDataPlane201JSONResponse{}, resp)
+	})
+
+	t.Run("not found with uid returns 404", func(t *testing.T) {
+		svc := newDataPlaneService(t, nil, &allowAllPDP{})
+		h := newHandlerWithDataPlaneService(svc)
+
+		resp, err := h.UpdateDataPlane(ctx, gen.UpdateDataPlaneRequestObject{
+			NamespaceName: ns,
+			DpName:        "nonexistent",
+			Body:          &gen.DataPlane{Metadata: gen.ObjectMeta{Name: "nonexistent", Uid: ptr.To("old-uid")}},
+		})
+		require.NoError(t, err)
 		assert.IsType(t, gen.UpdateDataPlane404JSONResponse{}, resp)
 	})
 
+	t.Run("uid mismatch returns 409", func(t *testing.T) {
+		svc := newDataPlaneService(t, []client.Object{testDataPlane("dp-1")}, &allowAllPDP{})
+		h := newHandlerWithDataPlaneService(svc)
+
+		resp, err := h.UpdateDataPlane(ctx, gen.UpdateDataPlaneRequestObject{
+			NamespaceName: ns,
+			DpName:        "dp-1",
+			Body:          &gen.DataPlane{Metadata: gen.ObjectMeta{Name: "dp-1", Uid: ptr.To("old-uid")}},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.UpdateDataPlane409JSONResponse{}, resp)
+	})
+
 	t.Run("forbidden returns 403", func(t *testing.T) {
 		svc := newDataPlaneService(t, []client.Object{testDataPlane("dp-1")}, &denyAllPDP{})
 		h := newHandlerWithDataPlaneService(svc)
@@ -289,6 +315,42 @@ func TestUpdateDataPlaneHandler(t *testing.T) {
 	})
 }
 
+func TestUpdateDataPlaneHandler_UpsertPermissions(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	tests := []struct {
+		name    string
+		allowed []string
+		objects []client.Object
+		wantTyp any
+	}{
+		{"update allowed on existing data plane", []string{authzcore.ActionUpdateDataPlane}, []client.Object{testDataPlane("dp-1")}, gen.UpdateDataPlane200JSONResponse{}},
+		{"update only on missing data plane", []string{authzcore.ActionUpdateDataPlane}, nil, gen.UpdateDataPlane403JSONResponse{}},
+		{"create only on existing data plane", []string{authzcore.ActionCreateDataPlane}, []client.Object{testDataPlane("dp-1")}, gen.UpdateDataPlane403JSONResponse{}},
+		{"create only on missing data plane", []string{authzcore.ActionCreateDataPlane}, nil, gen.UpdateDataPlane201JSONResponse{}},
+		{"neither on missing data plane", nil, nil, gen.UpdateDataPlane403JSONResponse{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pdp := &actionPDP{allowedActions: map[string]bool{}}
+			for _, action := range tt.allowed {
+				pdp.allowedActions[action] = true
+			}
+			h := newHandlerWithDataPlaneService(newDataPlaneService(t, tt.objects, pdp))
+
+			resp, err := h.UpdateDataPlane(ctx, gen.UpdateDataPlaneRequestObject{
+				NamespaceName: ns,
+				DpName:        "dp-1",
+				Body:          &gen.DataPlane{Metadata: gen.ObjectMeta{Name: "dp-1"}},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
 // --- DeleteDataPlane Handler ---
 
 func TestDeleteDataPlaneHandler(t *testing.T) {
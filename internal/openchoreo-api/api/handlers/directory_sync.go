@@ -0,0 +1,129 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	directorysyncsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/directorysync"
+)
+
+const directorySyncDisabledMessage = "Directory sync is disabled on this server"
+
+// directorySyncEnabled reports whether the directory sync endpoints are enabled.
+func (h *Handler) directorySyncEnabled() bool {
+	return h.Config != nil && h.Config.DirectorySync.Enabled && h.services.DirectorySyncService != nil
+}
+
+// GetDirectorySyncStatus returns the report of the latest directory sync.
+func (h *Handler) GetDirectorySyncStatus(
+	ctx context.Context,
+	_ gen.GetDirectorySyncStatusRequestObject,
+) (gen.GetDirectorySyncStatusResponseObject, error) {
+	if !h.directorySyncEnabled() {
+		return gen.GetDirectorySyncStatus501JSONResponse{NotImplementedJSONResponse: notImplemented(directorySyncDisabledMessage)}, nil
+	}
+	h.logger.Debug("GetDirectorySyncStatus called")
+
+	report, err := h.services.DirectorySyncService.Status(ctx)
+	if err != nil {
+		if errors.Is(err, svcpkg.ErrForbidden) {
+			return gen.GetDirectorySyncStatus403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, directorysyncsvc.ErrNoSyncRun) {
+			return gen.GetDirectorySyncStatus404JSONResponse{NotFoundJSONResponse: notFound("Directory sync run")}, nil
+		}
+		h.logger.Error("Failed to get directory sync status", "error", err)
+		return gen.GetDirectorySyncStatus500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetDirectorySyncStatus200JSONResponse(toGenDirectorySyncReport(report)), nil
+}
+
+// PreviewDirectorySync returns the changes a directory sync would make without applying them.
+func (h *Handler) PreviewDirectorySync(
+	ctx context.Context,
+	_ gen.PreviewDirectorySyncRequestObject,
+) (gen.PreviewDirectorySyncResponseObject, error) {
+	if !h.directorySyncEnabled() {
+		return gen.PreviewDirectorySync501JSONResponse{NotImplementedJSONResponse: notImplemented(directorySyncDisabledMessage)}, nil
+	}
+	h.logger.Debug("PreviewDirectorySync called")
+
+	report, err := h.services.DirectorySyncService.Preview(ctx)
+	if err != nil {
+		if errors.Is(err, svcpkg.ErrForbidden) {
+			return gen.PreviewDirectorySync403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to preview directory sync", "error", err)
+		return gen.PreviewDirectorySync500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.PreviewDirectorySync200JSONResponse(toGenDirectorySyncReport(report)), nil
+}
+
+// RunDirectorySync syncs the directory now.
+func (h *Handler) RunDirectorySync(
+	ctx context.Context,
+	_ gen.RunDirectorySyncRequestObject,
+) (gen.RunDirectorySyncResponseObject, error) {
+	if !h.directorySyncEnabled() {
+		return gen.RunDirectorySync501JSONResponse{NotImplementedJSONResponse: notImplemented(directorySyncDisabledMessage)}, nil
+	}
+	h.logger.Debug("RunDirectorySync called")
+
+	report, err := h.services.DirectorySyncService.Sync(ctx)
+	if err != nil {
+		if errors.Is(err, svcpkg.ErrForbidden) {
+			return gen.RunDirectorySync403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, directorysyncsvc.ErrSyncInProgress) {
+			return gen.RunDirectorySync409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to run directory sync", "error", err)
+		return gen.RunDirectorySync500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Directory sync completed", "changes", len(report.Changes), "conflicts", len(report.Conflicts))
+	return gen.RunDirectorySync200JSONResponse(toGenDirectorySyncReport(report)), nil
+}
+
+func toGenDirectorySyncReport(r *models.DirectorySyncReport) gen.DirectorySyncReport {
+	out := gen.DirectorySyncReport{
+		DryRun:      r.DryRun,
+		Source:      gen.DirectorySyncReportSource(r.Source),
+		StartedAt:   r.StartedAt,
+		CompletedAt: r.CompletedAt,
+		Groups:      r.Groups,
+		Users:       r.Users,
+		Changes:     make([]gen.DirectorySyncChange, 0, len(r.Changes)),
+		Conflicts:   make([]gen.DirectorySyncConflict, 0, len(r.Conflicts)),
+		Error:       optionalString(r.Error),
+	}
+	for _, c := range r.Changes {
+		out.Changes = append(out.Changes, gen.DirectorySyncChange{
+			Action:    c.Action,
+			Kind:      gen.DirectorySyncChangeKind(c.Kind),
+			Namespace: optionalString(c.Namespace),
+			Name:      c.Name,
+			Group:     optionalString(c.Group),
+			User:      optionalString(c.User),
+		})
+	}
+	for _, c := range r.Conflicts {
+		out.Conflicts = append(out.Conflicts, gen.DirectorySyncConflict{
+			Type:      gen.DirectorySyncConflictType(c.Type),
+			Group:     optionalString(c.Group),
+			User:      optionalString(c.User),
+			Namespace: optionalString(c.Namespace),
+			Name:      optionalString(c.Name),
+			Message:   c.Message,
+		})
+	}
+	return out
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	directorysyncsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/directorysync"
+	directorysyncmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/directorysync/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+func newDirectorySyncHandler(t *testing.T, enabled bool) (*Handler, *directorysyncmocks.MockService) {
+	t.Helper()
+	svc := directorysyncmocks.NewMockService(t)
+	h := &Handler{
+		services: &handlerservices.Services{DirectorySyncService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Config:   &config.Config{DirectorySync: config.DirectorySyncConfig{Enabled: enabled}},
+	}
+	return h, svc
+}
+
+func sampleDirectorySyncReport() *models.DirectorySyncReport {
+	return &models.DirectorySyncReport{
+		Source: "ldap",
+		Groups: 1,
+		Users:  1,
+		Changes: []models.DirectorySyncChange{
+			{Action: models.DirectorySyncActionCreate, Kind: "AuthzRoleBinding", Namespace: "acme", Name: "dirsync-developers-1", Group: "developers", User: "alice@example.com"},
+		},
+		Conflicts: []models.DirectorySyncConflict{
+			{Type: models.DirectorySyncConflictGroupNotFound, Group: "auditors", Message: "group not found"},
+		},
+	}
+}
+
+func TestDirectorySyncHandlers_FeatureDisabledReturns501(t *testing.T) {
+	ctx := testContext()
+	h, _ := newDirectorySyncHandler(t, false)
+
+	status, err := h.GetDirectorySyncStatus(ctx, gen.GetDirectorySyncStatusRequestObject{})
+	require.NoError(t, err)
+	assert.IsType(t, gen.GetDirectorySyncStatus501JSONResponse{}, status)
+
+	preview, err := h.PreviewDirectorySync(ctx, gen.PreviewDirectorySyncRequestObject{})
+	require.NoError(t, err)
+	assert.IsType(t, gen.PreviewDirectorySync501JSONResponse{}, preview)
+
+	run, err := h.RunDirectorySync(ctx, gen.RunDirectorySyncRequestObject{})
+	require.NoError(t, err)
+	assert.IsType(t, gen.RunDirectorySync501JSONResponse{}, run)
+}
+
+func TestGetDirectorySyncStatusHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success returns 200 with the report", func(t *testing.T) {
+		h, svc := newDirectorySyncHandler(t, true)
+		svc.EXPECT().Status(mock.Anything).Return(sampleDirectorySyncReport(), nil)
+
+		resp, err := h.GetDirectorySyncStatus(ctx, gen.GetDirectorySyncStatusRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetDirectorySyncStatus200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, gen.DirectorySyncReportSource("ldap"), typed.Source)
+		require.Len(t, typed.Changes, 1)
+		assert.Equal(t, "create", typed.Changes[0].Action)
+		assert.Equal(t, "acme", *typed.Changes[0].Namespace)
+		require.Len(t, typed.Conflicts, 1)
+		assert.Equal(t, gen.DirectorySyncConflictType("GroupNotFound"), typed.Conflicts[0].Type)
+		assert.Nil(t, typed.Conflicts[0].Namespace)
+	})
+
+	t.Run("no run returns 404", func(t *testing.T) {
+		h, svc := newDirectorySyncHandler(t, true)
+		svc.EXPECT().Status(mock.Anything).Return(nil, directorysyncsvc.ErrNoSyncRun)
+
+		resp, err := h.GetDirectorySyncStatus(ctx, gen.GetDirectorySyncStatusRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetDirectorySyncStatus404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		h, svc := newDirectorySyncHandler(t, true)
+		svc.EXPECT().Status(mock.Anything).Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := h.GetDirectorySyncStatus(ctx, gen.GetDirectorySyncStatusRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetDirectorySyncStatus403JSONResponse{}, resp)
+	})
+}
+
+func TestPreviewDirectorySyncHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success returns 200", func(t *testing.T) {
+		h, svc := newDirectorySyncHandler(t, true)
+		report := sampleDirectorySyncReport()
+		report.DryRun = true
+		svc.EXPECT().Preview(mock.Anything).Return(report, nil)
+
+		resp, err := h.PreviewDirectorySync(ctx, gen.PreviewDirectorySyncRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.PreviewDirectorySync200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.True(t, typed.DryRun)
+	})
+
+	t.Run("directory error returns 500", func(t *testing.T) {
+		h, svc := newDirectorySyncHandler(t, true)
+		svc.EXPECT().Preview(mock.Anything).Return(nil, errors.New("connection refused"))
+
+		resp, err := h.PreviewDirectorySync(ctx, gen.PreviewDirectorySyncRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PreviewDirectorySync500JSONResponse{}, resp)
+	})
+}
+
+func TestRunDirectorySyncHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success returns 200", func(t *testing.T) {
+		h, svc := newDirectorySyncHandler(t, true)
+		svc.EXPECT().Sync(mock.Anything).Return(sampleDirectorySyncReport(), nil)
+
+		resp, err := h.RunDirectorySync(ctx, gen.RunDirectorySyncRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RunDirectorySync200JSONResponse{}, resp)
+	})
+
+	t.Run("sync in progress returns 409", func(t *testing.T) {
+		h, svc := newDirectorySyncHandler(t, true)
+		svc.EXPECT().Sync(mock.Anything).Return(nil, directorysyncsvc.ErrSyncInProgress)
+
+		resp, err := h.RunDirectorySync(ctx, gen.RunDirectorySyncRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RunDirectorySync409JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		h, svc := newDirectorySyncHandler(t, true)
+		svc.EXPECT().Sync(mock.Anything).Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := h.RunDirectorySync(ctx, gen.RunDirectorySyncRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RunDirectorySync403JSONResponse{}, resp)
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	domainmappingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/domainmapping"
+)
+
+// ListDomainMappings returns the custom domains attached to the endpoints of a component.
+func (h *Handler) ListDomainMappings(
+	ctx context.Context,
+	request gen.ListDomainMappingsRequestObject,
+) (gen.ListDomainMappingsResponseObject, error) {
+	h.logger.Debug("ListDomainMappings called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	mappings, err := h.services.DomainMappingService.ListDomainMappings(ctx, request.NamespaceName, request.ComponentName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListDomainMappings403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, domainmappingsvc.ErrComponentNotFound) {
+			return gen.ListDomainMappings404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		h.logger.Error("Failed to list domain mappings", "error", err)
+		return gen.ListDomainMappings500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[models.DomainMapping, gen.DomainMapping](mappings)
+	if err != nil {
+		h.logger.Error("Failed to convert domain mappings", "error", err)
+		return gen.ListDomainMappings500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.ListDomainMappings200JSONResponse{Items: items}, nil
+}
+
+// CreateDomainMapping attaches a custom domain to a public endpoint of a component.
+func (h *Handler) CreateDomainMapping(
+	ctx context.Context,
+	request gen.CreateDomainMappingRequestObject,
+) (gen.CreateDomainMappingResponseObject, error) {
+	h.logger.Info("CreateDomainMapping called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	if request.Body == nil {
+		return gen.CreateDomainMapping400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	mapping, err := h.services.DomainMappingService.CreateDomainMapping(ctx, request.NamespaceName, request.ComponentName,
+		&models.CreateDomainMappingRequest{
+			Name:        ptr.Deref(request.Body.Name, ""),
+			Environment: request.Body.Environment,
+			Endpoint:    request.Body.Endpoint,
+			Domain:      request.Body.Domain,
+		})
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.CreateDomainMapping403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, domainmappingsvc.ErrComponentNotFound) {
+			return gen.CreateDomainMapping404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, domainmappingsvc.ErrDomainMappingAlreadyExists) || errors.Is(err, domainmappingsvc.ErrDomainAlreadyClaimed) {
+			return gen.CreateDomainMapping409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.CreateDomainMapping400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create domain mapping", "error", err)
+		return gen.CreateDomainMapping500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genMapping, err := convert[models.DomainMapping, gen.DomainMapping](*mapping)
+	if err != nil {
+		h.logger.Error("Failed to convert domain mapping", "error", err)
+		return gen.CreateDomainMapping500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.CreateDomainMapping201JSONResponse(genMapping), nil
+}
+
+// GetDomainMapping returns a custom domain of a component with its verification and
+// certificate status.
+func (h *Handler) GetDomainMapping(
+	ctx context.Context,
+	request gen.GetDomainMappingRequestObject,
+) (gen.GetDomainMappingResponseObject, error) {
+	h.logger.Debug("GetDomainMapping called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"domainMappingName", request.DomainMappingName)
+
+	mapping, err := h.services.DomainMappingService.GetDomainMapping(ctx, request.NamespaceName, request.ComponentName, request.DomainMappingName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetDomainMapping403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, domainmappingsvc.ErrComponentNotFound) {
+			return gen.GetDomainMapping404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, domainmappingsvc.ErrDomainMappingNotFound) {
+			return gen.GetDomainMapping404JSONResponse{NotFoundJSONResponse: notFound("DomainMapping")}, nil
+		}
+		h.logger.Error("Failed to get domain mapping", "error", err)
+		return gen.GetDomainMapping500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genMapping, err := convert[models.DomainMapping, gen.DomainMapping](*mapping)
+	if err != nil {
+		h.logger.Error("Failed to convert domain mapping", "error", err)
+		return gen.GetDomainMapping500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetDomainMapping200JSONResponse(genMapping), nil
+}
+
+// DeleteDomainMapping detaches a custom domain from a component.
+func (h *Handler) DeleteDomainMapping(
+	ctx context.Context,
+	request gen.DeleteDomainMappingRequestObject,
+) (gen.DeleteDomainMappingResponseObject, error) {
+	h.logger.Info("DeleteDomainMapping called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"domainMappingName", request.DomainMappingName)
+
+	err := h.services.DomainMappingService.DeleteDomainMapping(ctx, request.NamespaceName, request.ComponentName, request.DomainMappingName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.DeleteDomainMapping403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, domainmappingsvc.ErrComponentNotFound) {
+			return gen.DeleteDomainMapping404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, domainmappingsvc.ErrDomainMappingNotFound) {
+			return gen.DeleteDomainMapping404JSONResponse{NotFoundJSONResponse: notFound("DomainMapping")}, nil
+		}
+		h.logger.Error("Failed to delete domain mapping", "error", err)
+		return gen.DeleteDomainMapping500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.DeleteDomainMapping204Response{}, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	domainmappingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/domainmapping"
+	domainmappingmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/domainmapping/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+func newDomainMappingHandler(svc domainmappingsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{DomainMappingService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestCreateDomainMappingHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.CreateDomainMappingRequestObject{
+		NamespaceName: "test-ns",
+		ComponentName: "api",
+		Body: &gen.CreateDomainMappingJSONRequestBody{
+			Environment: "production",
+			Endpoint:    "http",
+			Domain:      "shop.example.com",
+		},
+	}
+	serviceRequest := &models.CreateDomainMappingRequest{Environment: "production", Endpoint: "http", Domain: "shop.example.com"}
+
+	t.Run("success returns 201 with the verification record", func(t *testing.T) {
+		svc := domainmappingmocks.NewMockService(t)
+		svc.EXPECT().CreateDomainMapping(mock.Anything, "test-ns", "api", serviceRequest).Return(&models.DomainMapping{
+			Name:        "api-shop",
+			Project:     "shop",
+			Component:   "api",
+			Environment: "production",
+			Endpoint:    "http",
+			Domain:      "shop.example.com",
+			Verification: &models.DomainVerification{
+				RecordName:  "_openchoreo-challenge.shop.example.com",
+				RecordValue: "openchoreo-domain-verification=abc",
+			},
+			CreatedAt: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+		}, nil)
+
+		resp, err := newDomainMappingHandler(svc).CreateDomainMapping(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.CreateDomainMapping201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "shop.example.com", typed.Domain)
+		require.NotNil(t, typed.Verification)
+		assert.Equal(t, "_openchoreo-challenge.shop.example.com", typed.Verification.RecordName)
+		assert.False(t, typed.Ready)
+	})
+
+	t.Run("missing body returns 400", func(t *testing.T) {
+		resp, err := newDomainMappingHandler(domainmappingmocks.NewMockService(t)).CreateDomainMapping(ctx,
+			gen.CreateDomainMappingRequestObject{NamespaceName: "test-ns", ComponentName: "api"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateDomainMapping400JSONResponse{}, resp)
+	})
+
+	t.Run("validation error returns 400", func(t *testing.T) {
+		svc := domainmappingmocks.NewMockService(t)
+		svc.EXPECT().CreateDomainMapping(mock.Anything, "test-ns", "api", serviceRequest).
+			Return(nil, &svcpkg.ValidationError{Msg: `environment "production" does not allow custom domains`})
+
+		resp, err := newDomainMappingHandler(svc).CreateDomainMapping(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateDomainMapping400JSONResponse{}, resp)
+	})
+
+	t.Run("claimed domain returns 409", func(t *testing.T) {
+		svc := domainmappingmocks.NewMockService(t)
+		svc.EXPECT().CreateDomainMapping(mock.Anything, "test-ns", "api", serviceRequest).
+			Return(nil, domainmappingsvc.ErrDomainAlreadyClaimed)
+
+		resp, err := newDomainMappingHandler(svc).CreateDomainMapping(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateDomainMapping409JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := domainmappingmocks.NewMockService(t)
+		svc.EXPECT().CreateDomainMapping(mock.Anything, "test-ns", "api", serviceRequest).Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := newDomainMappingHandler(svc).CreateDomainMapping(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateDomainMapping403JSONResponse{}, resp)
+	})
+}
+
+func TestGetDomainMappingHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.GetDomainMappingRequestObject{NamespaceName: "test-ns", ComponentName: "api", DomainMappingName: "api-shop"}
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := domainmappingmocks.NewMockService(t)
+		svc.EXPECT().GetDomainMapping(mock.Anything, "test-ns", "api", "api-shop").Return(nil, domainmappingsvc.ErrDomainMappingNotFound)
+
+		resp, err := newDomainMappingHandler(svc).GetDomainMapping(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetDomainMapping404JSONResponse{}, resp)
+	})
+}
+
+func TestDeleteDomainMappingHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.DeleteDomainMappingRequestObject{NamespaceName: "test-ns", ComponentName: "api", DomainMappingName: "api-shop"}
+
+	t.Run("success returns 204", func(t *testing.T) {
+		svc := domainmappingmocks.NewMockService(t)
+		svc.EXPECT().DeleteDomainMapping(mock.Anything, "test-ns", "api", "api-shop").Return(nil)
+
+		resp, err := newDomainMappingHandler(svc).DeleteDomainMapping(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteDomainMapping204Response{}, resp)
+	})
+
+	t.Run("component not found returns 404", func(t *testing.T) {
+		svc := domainmappingmocks.NewMockService(t)
+		svc.EXPECT().DeleteDomainMapping(mock.Anything, "test-ns", "api", "api-shop").Return(domainmappingsvc.ErrComponentNotFound)
+
+		resp, err := newDomainMappingHandler(svc).DeleteDomainMapping(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteDomainMapping404JSONResponse{}, resp)
+	})
+}
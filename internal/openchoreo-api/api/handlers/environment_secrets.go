@@ -0,0 +1,141 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentsecretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environmentsecret"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+)
+
+// GetEnvironmentSecrets returns the keys of the secret values of a component in an environment.
+func (h *Handler) GetEnvironmentSecrets(
+	ctx context.Context,
+	request gen.GetEnvironmentSecretsRequestObject,
+) (gen.GetEnvironmentSecretsResponseObject, error) {
+	h.logger.Debug("GetEnvironmentSecrets called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"envName", request.EnvName)
+
+	secrets, err := h.services.EnvironmentSecretService.GetEnvironmentSecrets(ctx, request.NamespaceName, request.ComponentName, request.EnvName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetEnvironmentSecrets403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, environmentsecretsvc.ErrComponentNotFound) {
+			return gen.GetEnvironmentSecrets404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, environmentsecretsvc.ErrEnvironmentSecretsNotFound) || errors.Is(err, environmentsecretsvc.ErrSecretReferenceConflict) {
+			return gen.GetEnvironmentSecrets404JSONResponse{NotFoundJSONResponse: notFound("EnvironmentSecrets")}, nil
+		}
+		h.logger.Error("Failed to get environment secrets", "error", err)
+		return gen.GetEnvironmentSecrets500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genSecrets, err := convert[models.EnvironmentSecrets, gen.EnvironmentSecrets](*secrets)
+	if err != nil {
+		h.logger.Error("Failed to convert environment secrets", "error", err)
+		return gen.GetEnvironmentSecrets500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetEnvironmentSecrets200JSONResponse(genSecrets), nil
+}
+
+// SetEnvironmentSecrets sets the secret values of a component in an environment and wires them
+// into the workload overrides of the component's release binding.
+func (h *Handler) SetEnvironmentSecrets(
+	ctx context.Context,
+	request gen.SetEnvironmentSecretsRequestObject,
+) (gen.SetEnvironmentSecretsResponseObject, error) {
+	h.logger.Info("SetEnvironmentSecrets called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"envName", request.EnvName)
+
+	if request.Body == nil {
+		return gen.SetEnvironmentSecrets400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	secrets, err := h.services.EnvironmentSecretService.SetEnvironmentSecrets(ctx, request.NamespaceName, request.ComponentName, request.EnvName,
+		&models.SetEnvironmentSecretsRequest{Data: request.Body.Data})
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.SetEnvironmentSecrets403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, environmentsecretsvc.ErrComponentNotFound) {
+			return gen.SetEnvironmentSecrets404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, environmentsecretsvc.ErrEnvironmentNotFound) {
+			return gen.SetEnvironmentSecrets404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+		}
+		if errors.Is(err, environmentsecretsvc.ErrReleaseBindingNotFound) {
+			return gen.SetEnvironmentSecrets404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		if errors.Is(err, environmentsecretsvc.ErrSecretReferenceConflict) {
+			return gen.SetEnvironmentSecrets409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		if errors.Is(err, secretsvc.ErrPlaneNotFound) {
+			return gen.SetEnvironmentSecrets422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent("data plane of the environment not found")}, nil
+		}
+		if errors.Is(err, secretsvc.ErrSecretStoreNotConfigured) {
+			return gen.SetEnvironmentSecrets400JSONResponse{BadRequestJSONResponse: badRequest("secret store is not configured on the data plane of the environment")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.SetEnvironmentSecrets400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to set environment secrets", "error", err)
+		return gen.SetEnvironmentSecrets500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genSecrets, err := convert[models.EnvironmentSecrets, gen.EnvironmentSecrets](*secrets)
+	if err != nil {
+		h.logger.Error("Failed to convert environment secrets", "error", err)
+		return gen.SetEnvironmentSecrets500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.SetEnvironmentSecrets200JSONResponse(genSecrets), nil
+}
+
+// DeleteEnvironmentSecrets removes the secret values of a component in an environment.
+func (h *Handler) DeleteEnvironmentSecrets(
+	ctx context.Context,
+	request gen.DeleteEnvironmentSecretsRequestObject,
+) (gen.DeleteEnvironmentSecretsResponseObject, error) {
+	h.logger.Info("DeleteEnvironmentSecrets called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"envName", request.EnvName)
+
+	err := h.services.EnvironmentSecretService.DeleteEnvironmentSecrets(ctx, request.NamespaceName, request.ComponentName, request.EnvName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.DeleteEnvironmentSecrets403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, environmentsecretsvc.ErrComponentNotFound) {
+			return gen.DeleteEnvironmentSecrets404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, environmentsecretsvc.ErrEnvironmentSecretsNotFound) {
+			return gen.DeleteEnvironmentSecrets404JSONResponse{NotFoundJSONResponse: notFound("EnvironmentSecrets")}, nil
+		}
+		if errors.Is(err, environmentsecretsvc.ErrSecretReferenceConflict) {
+			return gen.DeleteEnvironmentSecrets409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		if errors.Is(err, secretsvc.ErrPlaneNotFound) {
+			return gen.DeleteEnvironmentSecrets422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent("data plane of the environment not found")}, nil
+		}
+		if errors.Is(err, secretsvc.ErrSecretStoreNotConfigured) {
+			return gen.DeleteEnvironmentSecrets400JSONResponse{BadRequestJSONResponse: badRequest("secret store is not configured on the data plane of the environment")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.DeleteEnvironmentSecrets400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to delete environment secrets", "error", err)
+		return gen.DeleteEnvironmentSecrets500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.DeleteEnvironmentSecrets204Response{}, nil
+}
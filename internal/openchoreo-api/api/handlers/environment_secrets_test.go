@@ -0,0 +1,117 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentsecretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environmentsecret"
+	environmentsecretmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environmentsecret/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+)
+
+func newEnvironmentSecretHandler(svc environmentsecretsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{EnvironmentSecretService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestSetEnvironmentSecretsHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.SetEnvironmentSecretsRequestObject{
+		NamespaceName: "test-ns",
+		ComponentName: "api",
+		EnvName:       "production",
+		Body:          &gen.SetEnvironmentSecretsJSONRequestBody{Data: map[string]string{"DB_PASSWORD": "s3cr3t"}},
+	}
+	serviceRequest := &models.SetEnvironmentSecretsRequest{Data: map[string]string{"DB_PASSWORD": "s3cr3t"}}
+
+	t.Run("success returns 200 with the keys only", func(t *testing.T) {
+		svc := environmentsecretmocks.NewMockService(t)
+		svc.EXPECT().SetEnvironmentSecrets(mock.Anything, "test-ns", "api", "production", serviceRequest).Return(&models.EnvironmentSecrets{
+			Component:       "api",
+			Environment:     "production",
+			SecretReference: "api-production-secrets-1a2b3c4d",
+			ReleaseBinding:  "api-production",
+			Keys:            []string{"DB_PASSWORD"},
+		}, nil)
+
+		resp, err := newEnvironmentSecretHandler(svc).SetEnvironmentSecrets(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.SetEnvironmentSecrets200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, []string{"DB_PASSWORD"}, typed.Keys)
+		assert.Equal(t, "api-production-secrets-1a2b3c4d", typed.SecretReference)
+		require.NotNil(t, typed.ReleaseBinding)
+		assert.Equal(t, "api-production", *typed.ReleaseBinding)
+	})
+
+	t.Run("missing body returns 400", func(t *testing.T) {
+		resp, err := newEnvironmentSecretHandler(environmentsecretmocks.NewMockService(t)).SetEnvironmentSecrets(ctx,
+			gen.SetEnvironmentSecretsRequestObject{NamespaceName: "test-ns", ComponentName: "api", EnvName: "production"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.SetEnvironmentSecrets400JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		want gen.SetEnvironmentSecretsResponseObject
+	}{
+		{"validation error returns 400", &svcpkg.ValidationError{Msg: "data must contain at least one entry"}, gen.SetEnvironmentSecrets400JSONResponse{}},
+		{"forbidden returns 403", svcpkg.ErrForbidden, gen.SetEnvironmentSecrets403JSONResponse{}},
+		{"unbound component returns 404", environmentsecretsvc.ErrReleaseBindingNotFound, gen.SetEnvironmentSecrets404JSONResponse{}},
+		{"foreign secret reference returns 409", environmentsecretsvc.ErrSecretReferenceConflict, gen.SetEnvironmentSecrets409JSONResponse{}},
+		{"missing data plane returns 422", secretsvc.ErrPlaneNotFound, gen.SetEnvironmentSecrets422JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := environmentsecretmocks.NewMockService(t)
+			svc.EXPECT().SetEnvironmentSecrets(mock.Anything, "test-ns", "api", "production", serviceRequest).Return(nil, tt.err)
+
+			resp, err := newEnvironmentSecretHandler(svc).SetEnvironmentSecrets(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, resp)
+		})
+	}
+}
+
+func TestGetEnvironmentSecretsHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.GetEnvironmentSecretsRequestObject{NamespaceName: "test-ns", ComponentName: "api", EnvName: "production"}
+
+	t.Run("not set returns 404", func(t *testing.T) {
+		svc := environmentsecretmocks.NewMockService(t)
+		svc.EXPECT().GetEnvironmentSecrets(mock.Anything, "test-ns", "api", "production").Return(nil, environmentsecretsvc.ErrEnvironmentSecretsNotFound)
+
+		resp, err := newEnvironmentSecretHandler(svc).GetEnvironmentSecrets(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetEnvironmentSecrets404JSONResponse{}, resp)
+	})
+}
+
+func TestDeleteEnvironmentSecretsHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.DeleteEnvironmentSecretsRequestObject{NamespaceName: "test-ns", ComponentName: "api", EnvName: "production"}
+
+	t.Run("success returns 204", func(t *testing.T) {
+		svc := environmentsecretmocks.NewMockService(t)
+		svc.EXPECT().DeleteEnvironmentSecrets(mock.Anything, "test-ns", "api", "production").Return(nil)
+
+		resp, err := newEnvironmentSecretHandler(svc).DeleteEnvironmentSecrets(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteEnvironmentSecrets204Response{}, resp)
+	})
+}
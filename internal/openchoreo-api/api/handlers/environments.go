@@ -149,8 +149,14 @@ func (h *Handler) UpdateEnvironment(
 			return gen.UpdateEnvironment403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
 		}
 		if errors.Is(err, environmentsvc.ErrEnvironmentNotFound) {
+			if envCR.UID == "" {
+				return h.createEnvironmentOnUpdate(ctx, request.NamespaceName, &envCR)
+			}
 			return gen.UpdateEnvironment404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
 		}
+		if errors.Is(err, services.ErrUIDMismatch) {
+			return gen.UpdateEnvironment409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			if validationErr.StatusCode == http.StatusUnprocessableEntity {
 				return gen.UpdateEnvironment422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
@@ -171,6 +177,45 @@ func (h *Handler) UpdateEnvironment(
 	return gen.UpdateEnvironment200JSONResponse(genEnv), nil
 }
 
+// createEnvironmentOnUpdate creates the environment of an update request that found no existing
+// environment, which makes PUT an idempotent upsert. Creating requires the create permission.
+func (h *Handler) createEnvironmentOnUpdate(
+	ctx context.Context,
+	namespaceName string,
+	envCR *openchoreov1alpha1.Environment,
+) (gen.UpdateEnvironmentResponseObject, error) {
+	envCR.ResourceVersion = ""
+	created, err := h.services.EnvironmentService.CreateEnvironment(ctx, namespaceName, envCR)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.UpdateEnvironment403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, environmentsvc.ErrEnvironmentAlreadyExists) {
+			return gen.UpdateEnvironment409JSONResponse{ConflictJSONResponse: conflict("Environment already exists")}, nil
+		}
+		if errors.Is(err, environmentsvc.ErrDataPlaneNotFound) {
+			return gen.UpdateEnvironment400JSONResponse{BadRequestJSONResponse: badRequest("DataPlane not found")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			if validationErr.StatusCode == http.StatusUnprocessableEntity {
+				return gen.UpdateEnvironment422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+			}
+			return gen.UpdateEnvironment400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create environment", "error", err)
+		return gen.UpdateEnvironment500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genEnvironment, err := convert[openchoreov1alpha1.Environment, gen.Environment](*created)
+	if err != nil {
+		h.logger.Error("Failed to convert created environment", "error", err)
+		return gen.UpdateEnvironment500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Environment created by update", "namespaceName", namespaceName, "environment", created.Name)
+	return gen.UpdateEnvironment201JSONResponse(genEnvironment), nil
+}
+
 // DeleteEnvironment deletes an environment by name.
 func (h *Handler) DeleteEnvironment(
 	ctx context.Context,
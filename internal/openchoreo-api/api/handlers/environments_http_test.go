@@ -272,14 +272,14 @@ func TestEnvironmentHTTPUpdate(t *testing.T) {
 	assertConformsToSpec(t, req, rec.Code, rec.Result().Header, bodyBytes)
 }
 
-func TestEnvironmentHTTPUpdateNotFound(t *testing.T) {
-	bundle := newEnvBundle(t, nil, &allowAllPDP{})
+func TestEnvironmentHTTPUpdateCreatesWhenMissing(t *testing.T) {
+	bundle := newEnvBundle(t, []client.Object{seedDP(testNS)}, &allowAllPDP{})
 
 	body, _ := json.Marshal(gen.Environment{Metadata: gen.ObjectMeta{Name: "nonexistent"}})
 	_, rec := doRequest(t, bundle.handler, http.MethodPut,
 		"/api/v1/namespaces/"+testNS+"/environments/nonexistent", body)
 
-	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, http.StatusCreated, rec.Code)
 }
 
 func TestEnvironmentHTTPUpdateForbidden(t *testing.T) {
@@ -289,8 +289,8 @@ func TestUpdateEnvironmentHandler(t *testing.T) {
 		assert.IsType(t, gen.UpdateEnvironment400JSONResponse{}, resp)
 	})
 
-	t.Run("not found returns 404", func(t *testing.T) {
-		svc := newEnvironmentService(t, nil, &allowAllPDP{})
+	t.Run("not found creates and returns 201", func(t *testing.T) {
+		svc := newEnvironmentService(t, []client.Object{testDataPlaneObj()}, &allowAllPDP{})
 		h := newHandlerWithEnvironmentService(svc)
 
 		resp, err := h.UpdateEnvironment(ctx, gen.UpdateEnvironmentRequestObject{
@@ -299,9 +299,35 @@ func TestUpdateEnvironmentHandler(t *testing.T) {
 			Body:          &gen.Environment{Metadata: gen.ObjectMeta{Name: "nonexistent"}},
 		})
 		require.NoError(t, err)
+		assert.IsType(t, gen.UpdateEnvironment201JSONResponse{}, resp)
+	})
+
+	t.Run("not found with uid returns 404", func(t *testing.T) {
+		svc := newEnvironmentService(t, nil, &allowAllPDP{})
+		h := newHandlerWithEnvironmentService(svc)
+
+		resp, err := h.UpdateEnvironment(ctx, gen.UpdateEnvironmentRequestObject{
+			NamespaceName: ns,
+			EnvName:       "nonexistent",
+			Body:          &gen.Environment{Metadata: gen.ObjectMeta{Name: "nonexistent", Uid: ptr.To("old-uid")}},
+		})
+		require.NoError(t, err)
 		assert.IsType(t, gen.UpdateEnvironment404JSONResponse{}, resp)
 	})
 
+	t.Run("uid mismatch returns 409", func(t *testing.T) {
+		svc := newEnvironmentService(t, []client.Object{testEnvObj("dev")}, &allowAllPDP{})
+		h := newHandlerWithEnvironmentService(svc)
+
+		resp, err := h.UpdateEnvironment(ctx, gen.UpdateEnvironmentRequestObject{
+			NamespaceName: ns,
+			EnvName:       "dev",
+			Body:          &gen.Environment{Metadata: gen.ObjectMeta{Name: "dev", Uid: ptr.To("old-uid")}},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.UpdateEnvironment409JSONResponse{}, resp)
+	})
+
 	t.Run("forbidden returns 403", func(t *testing.T) {
 		svc := newEnvironmentService(t, []client.Object{testEnvObj("dev")}, &denyAllPDP{})
 		h := newHandlerWithEnvironmentService(svc)
@@ -333,6 +359,42 @@ func TestUpdateEnvironmentHandler(t *testing.T) {
 	})
 }
 
+func TestUpdateEnvironmentHandler_UpsertPermissions(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	tests := []struct {
+		name    string
+		allowed []string
+		objects []client.Object
+		wantTyp any
+	}{
+		{"update allowed on existing environment", []string{authzcore.ActionUpdateEnvironment}, []client.Object{testDataPlaneObj(), testEnvObj("dev")}, gen.UpdateEnvironment200JSONResponse{}},
+		{"update only on missing environment", []string{authzcore.ActionUpdateEnvironment}, []client.Object{testDataPlaneObj()}, gen.UpdateEnvironment403JSONResponse{}},
+		{"create only on existing environment", []string{authzcore.ActionCreateEnvironment}, []client.Object{testDataPlaneObj(), testEnvObj("dev")}, gen.UpdateEnvironment403JSONResponse{}},
+		{"create only on missing environment", []string{authzcore.ActionCreateEnvironment}, []client.Object{testDataPlaneObj()}, gen.UpdateEnvironment201JSONResponse{}},
+		{"neither on missing environment", nil, []client.Object{testDataPlaneObj()}, gen.UpdateEnvironment403JSONResponse{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pdp := &actionPDP{allowedActions: map[string]bool{}}
+			for _, action := range tt.allowed {
+				pdp.allowedActions[action] = true
+			}
+			h := newHandlerWithEnvironmentService(newEnvironmentService(t, tt.objects, pdp))
+
+			resp, err := h.UpdateEnvironment(ctx, gen.UpdateEnvironmentRequestObject{
+				NamespaceName: ns,
+				EnvName:       "dev",
+				Body:          &gen.Environment{Metadata: gen.ObjectMeta{Name: "dev"}},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
 // --- DeleteEnvironment Handler ---
 
 func TestDeleteEnvironmentHandler(t *testing.T) {
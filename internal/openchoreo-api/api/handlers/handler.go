@@ -11,6 +11,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/session"
 )
 
 // Handler implements gen.StrictServerInterface
@@ -33,7 +34,8 @@ func New(svc *handlerservices.Services, logger *slog.Logger, cfg *config.Config)
 }
 
 // InitJWTMiddleware initializes the JWT authentication middleware from the unified configuration.
-func InitJWTMiddleware(cfg *config.Config, logger *slog.Logger) func(http.Handler) http.Handler {
+// When sessionIssuer is not nil, session tokens of that issuer are accepted as well.
+func InitJWTMiddleware(cfg *config.Config, sessionIssuer *session.Issuer, logger *slog.Logger) func(http.Handler) http.Handler {
 	jwtCfg := &cfg.Security.Authentication.JWT
 
 	// Create OAuth2 user type resolver from configuration
@@ -48,5 +50,9 @@ func InitJWTMiddleware(cfg *config.Config, logger *slog.Logger) func(http.Handle
 		}
 	}
 
-	return jwt.Middleware(jwtCfg.ToJWTMiddlewareConfig(&cfg.Identity.OIDC, logger, resolver, cfg.Security.Enabled))
+	jwtMiddleware := jwt.Middleware(jwtCfg.ToJWTMiddlewareConfig(&cfg.Identity.OIDC, logger, resolver, cfg.Security.Enabled))
+	if sessionIssuer == nil {
+		return jwtMiddleware
+	}
+	return session.Middleware(sessionIssuer, jwtMiddleware, logger)
 }
@@ -29,7 +29,12 @@ import (
 func newTestHTTPHandler(t *testing.T, services *handlerservices.Services) http.Handler {
 	t.Helper()
 	h := &Handler{services: services, logger: slog.Default()}
-	strictHandler := gen.NewStrictHandler(h, nil)
+	strictHandler := gen.NewStrictHandlerWithOptions(h, nil, gen.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: RequestErrorHandler,
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	})
 	mux := http.NewServeMux()
 	gen.HandlerWithOptions(strictHandler, gen.StdHTTPServerOptions{
 		BaseRouter:  mux,
@@ -0,0 +1,55 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	lookupsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/lookup"
+)
+
+// LookupResource finds a project, component, environment or data plane by name, uid or
+// external ID.
+func (h *Handler) LookupResource(
+	ctx context.Context,
+	request gen.LookupResourceRequestObject,
+) (gen.LookupResourceResponseObject, error) {
+	params := request.Params
+	h.logger.Debug("LookupResource called", "namespaceName", request.NamespaceName, "kind", params.Kind)
+
+	resp, err := h.services.LookupService.Lookup(ctx, models.ResourceLookupRequest{
+		Namespace:  request.NamespaceName,
+		Kind:       params.Kind,
+		Name:       getStringValue(params.Name),
+		UID:        getStringValue(params.Uid),
+		ExternalID: getStringValue(params.ExternalId),
+	})
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.LookupResource403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, lookupsvc.ErrUnsupportedKind) || errors.Is(err, lookupsvc.ErrInvalidLookup) ||
+			errors.Is(err, lookupsvc.ErrAmbiguousExternalID) {
+			return gen.LookupResource400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		if errors.Is(err, lookupsvc.ErrResourceNotFound) {
+			return gen.LookupResource404JSONResponse{NotFoundJSONResponse: notFound("Resource")}, nil
+		}
+		h.logger.Error("Failed to look up resource", "error", err)
+		return gen.LookupResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.LookupResource200JSONResponse{
+		Kind:       resp.Kind,
+		Namespace:  resp.Namespace,
+		Name:       resp.Name,
+		Uid:        resp.UID,
+		ExternalId: optionalString(resp.ExternalID),
+		Project:    optionalString(resp.Project),
+	}, nil
+}
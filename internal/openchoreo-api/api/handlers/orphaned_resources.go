@@ -0,0 +1,82 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+// ListOrphanedResources returns the resources the orphan scanners of a namespace found
+// orphaned in or missing from the data planes.
+func (h *Handler) ListOrphanedResources(
+	ctx context.Context,
+	request gen.ListOrphanedResourcesRequestObject,
+) (gen.ListOrphanedResourcesResponseObject, error) {
+	h.logger.Debug("ListOrphanedResources called", "namespaceName", request.NamespaceName)
+
+	filter := componentsvc.OrphanReportFilter{}
+	if request.Params.Project != nil {
+		filter.Project = *request.Params.Project
+	}
+	if request.Params.Component != nil {
+		filter.Component = *request.Params.Component
+	}
+
+	report, err := h.services.ComponentService.ListOrphanedResources(ctx, request.NamespaceName, filter)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListOrphanedResources403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to list orphaned resources", "error", err)
+		return gen.ListOrphanedResources500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	resp := gen.ListOrphanedResources200JSONResponse{
+		Orphaned: make([]gen.OrphanedResource, 0, len(report.Orphaned)),
+		Missing:  make([]gen.MissingResource, 0, len(report.Missing)),
+	}
+	for _, r := range report.Orphaned {
+		item := gen.OrphanedResource{
+			Scanner:         r.Scanner,
+			Plane:           gen.TargetPlaneRef{Kind: gen.TargetPlaneRefKind(r.Plane.Kind), Name: r.Plane.Name},
+			ApiVersion:      r.APIVersion,
+			Kind:            r.Kind,
+			Namespace:       optionalString(r.Namespace),
+			Name:            r.Name,
+			RenderedRelease: r.RenderedRelease,
+			Project:         optionalString(r.Project),
+			Component:       optionalString(r.Component),
+			Reason:          gen.OrphanedResourceReason(r.Reason),
+			Deleted:         r.Deleted,
+		}
+		if r.ScannedAt != nil {
+			item.ScannedAt = &r.ScannedAt.Time
+		}
+		resp.Orphaned = append(resp.Orphaned, item)
+	}
+	for _, r := range report.Missing {
+		item := gen.MissingResource{
+			Scanner:         r.Scanner,
+			Plane:           gen.TargetPlaneRef{Kind: gen.TargetPlaneRefKind(r.Plane.Kind), Name: r.Plane.Name},
+			RenderedRelease: r.RenderedRelease,
+			Project:         r.Project,
+			Component:       optionalString(r.Component),
+			Environment:     r.Environment,
+			ApiVersion:      r.APIVersion,
+			Kind:            r.Kind,
+			Namespace:       optionalString(r.Namespace),
+			Name:            r.Name,
+		}
+		if r.ScannedAt != nil {
+			item.ScannedAt = &r.ScannedAt.Time
+		}
+		resp.Missing = append(resp.Missing, item)
+	}
+	return resp, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	componentmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component/mocks"
+)
+
+func TestListOrphanedResourcesHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	plane := openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "default"}
+	report := &componentsvc.OrphanReport{
+		Orphaned: []componentsvc.OrphanedResource{{
+			OrphanedResource: openchoreov1alpha1.OrphanedResource{
+				Plane: plane, APIVersion: "apps/v1", Kind: "Deployment", Namespace: "dp-ns", Name: "checkout-worker",
+				RenderedRelease: "checkout-dev", Project: "shop", Component: "checkout",
+				Reason: openchoreov1alpha1.OrphanReasonNotInInventory,
+			},
+			Scanner: "default-data-plane",
+		}},
+		Missing: []componentsvc.MissingResource{{
+			MissingResource: openchoreov1alpha1.MissingResource{
+				Plane: plane, RenderedRelease: "checkout-dev", Project: "shop", Component: "checkout", Environment: "dev",
+				APIVersion: "v1", Kind: "ConfigMap", Namespace: "dp-ns", Name: "checkout-config",
+			},
+			Scanner: "default-data-plane",
+		}},
+	}
+
+	t.Run("success - passes the filter and maps the report", func(t *testing.T) {
+		svc := componentmocks.NewMockService(t)
+		svc.EXPECT().ListOrphanedResources(mock.Anything, ns,
+			componentsvc.OrphanReportFilter{Project: "shop", Component: "checkout"}).Return(report, nil)
+		h := newHandlerWithComponentService(svc)
+
+		resp, err := h.ListOrphanedResources(ctx, gen.ListOrphanedResourcesRequestObject{
+			NamespaceName: ns,
+			Params:        gen.ListOrphanedResourcesParams{Project: ptr.To("shop"), Component: ptr.To("checkout")},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListOrphanedResources200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Orphaned, 1)
+		assert.Equal(t, "default-data-plane", typed.Orphaned[0].Scanner)
+		assert.Equal(t, gen.NotInInventory, typed.Orphaned[0].Reason)
+		assert.Equal(t, ptr.To("dp-ns"), typed.Orphaned[0].Namespace)
+		require.Len(t, typed.Missing, 1)
+		assert.Equal(t, "checkout-config", typed.Missing[0].Name)
+		assert.Nil(t, typed.Missing[0].ScannedAt)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := componentmocks.NewMockService(t)
+		svc.EXPECT().ListOrphanedResources(mock.Anything, ns, componentsvc.OrphanReportFilter{}).Return(nil, svcpkg.ErrForbidden)
+		h := newHandlerWithComponentService(svc)
+
+		resp, err := h.ListOrphanedResources(ctx, gen.ListOrphanedResourcesRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListOrphanedResources403JSONResponse{}, resp)
+	})
+}
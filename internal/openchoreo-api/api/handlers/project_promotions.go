@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+)
+
+// PromoteProject promotes the releases of a source environment of the components of a project.
+func (h *Handler) PromoteProject(
+	ctx context.Context,
+	request gen.PromoteProjectRequestObject,
+) (gen.PromoteProjectResponseObject, error) {
+	h.logger.Info("PromoteProject called",
+		"namespaceName", request.NamespaceName,
+		"projectName", request.ProjectName)
+
+	if request.Body == nil {
+		return gen.PromoteProject400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if request.Body.SourceEnvironment == "" {
+		return gen.PromoteProject400JSONResponse{BadRequestJSONResponse: badRequest("sourceEnvironment is required")}, nil
+	}
+	req := &models.ProjectPromotionRequest{SourceEnvironment: request.Body.SourceEnvironment}
+	if request.Body.TargetEnvironments != nil {
+		req.TargetEnvironments = *request.Body.TargetEnvironments
+	}
+	if request.Body.Components != nil {
+		req.Components = *request.Body.Components
+	}
+	if request.Body.RollbackOnFailure != nil {
+		req.RollbackOnFailure = *request.Body.RollbackOnFailure
+	}
+
+	status, err := h.services.PromotionService.PromoteProject(ctx, request.NamespaceName, request.ProjectName, req)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.PromoteProject403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrProjectNotFound) {
+			return gen.PromoteProject404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrComponentNotFound) {
+			return gen.PromoteProject404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrDeploymentPipelineNotFound) {
+			return gen.PromoteProject404JSONResponse{NotFoundJSONResponse: notFound("DeploymentPipeline")}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrSourceNotDeployed) {
+			return gen.PromoteProject409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.PromoteProject400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to promote project", "error", err)
+		return gen.PromoteProject500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genStatus, err := convert[models.ProjectPromotionStatus, gen.ProjectPromotionStatus](*status)
+	if err != nil {
+		h.logger.Error("Failed to convert project promotion status", "error", err)
+		return gen.PromoteProject500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.PromoteProject200JSONResponse(genStatus), nil
+}
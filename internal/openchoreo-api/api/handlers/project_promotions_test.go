@@ -0,0 +1,101 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+	promotionmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion/mocks"
+)
+
+func TestPromoteProjectHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success returns 200 with the status", func(t *testing.T) {
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().PromoteProject(mock.Anything, ns, "shop", &models.ProjectPromotionRequest{
+			SourceEnvironment: "staging",
+			Components:        []string{"api"},
+			RollbackOnFailure: true,
+		}).Return(&models.ProjectPromotionStatus{
+			Project:           "shop",
+			SourceEnvironment: "staging",
+			Phase:             models.PromotionPhaseRolledBack,
+			RolledBack:        true,
+			Components: []models.ComponentPromotionResult{{
+				Component: "api",
+				Release:   "api-r2",
+				Phase:     models.PromotionPhaseRolledBack,
+				Targets: []models.PromotionTarget{
+					{Environment: "prod-us", ReleaseBinding: "api-prod-us", Release: "api-r1", Phase: models.PromotionPhaseRolledBack},
+				},
+			}},
+		}, nil)
+
+		resp, err := newPromotionHandler(svc).PromoteProject(ctx, gen.PromoteProjectRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "shop",
+			Body: &gen.PromoteProjectJSONRequestBody{
+				SourceEnvironment: "staging",
+				Components:        &[]string{"api"},
+				RollbackOnFailure: ptr.To(true),
+			},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.PromoteProject200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, gen.ProjectPromotionStatusPhase("RolledBack"), typed.Phase)
+		assert.True(t, typed.RolledBack)
+		require.Len(t, typed.Components, 1)
+		assert.Equal(t, gen.PromotionTargetStatusPhase("RolledBack"), typed.Components[0].Targets[0].Phase)
+	})
+
+	t.Run("missing source returns 400", func(t *testing.T) {
+		resp, err := newPromotionHandler(promotionmocks.NewMockService(t)).PromoteProject(ctx, gen.PromoteProjectRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "shop",
+			Body:          &gen.PromoteProjectJSONRequestBody{},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PromoteProject400JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"source not deployed -> 409", promotionsvc.ErrSourceNotDeployed, gen.PromoteProject409JSONResponse{}},
+		{"project not found -> 404", promotionsvc.ErrProjectNotFound, gen.PromoteProject404JSONResponse{}},
+		{"pipeline not found -> 404", promotionsvc.ErrDeploymentPipelineNotFound, gen.PromoteProject404JSONResponse{}},
+		{"validation -> 400", &svcpkg.ValidationError{Msg: "not part of the project"}, gen.PromoteProject400JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.PromoteProject403JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.PromoteProject500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := promotionmocks.NewMockService(t)
+			svc.EXPECT().PromoteProject(mock.Anything, ns, "shop", mock.Anything).Return(nil, tt.svcErr)
+
+			resp, err := newPromotionHandler(svc).PromoteProject(ctx, gen.PromoteProjectRequestObject{
+				NamespaceName: ns,
+				ProjectName:   "shop",
+				Body:          &gen.PromoteProjectJSONRequestBody{SourceEnvironment: "staging"},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	projectstatussvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectstatus"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+// GetProjectDeploymentStatus returns the component/environment status matrix of a project.
+func (h *Handler) GetProjectDeploymentStatus(
+	ctx context.Context,
+	request gen.GetProjectDeploymentStatusRequestObject,
+) (gen.GetProjectDeploymentStatusResponseObject, error) {
+	h.logger.Debug("GetProjectDeploymentStatus called", "namespaceName", request.NamespaceName, "projectName", request.ProjectName)
+
+	status, err := h.services.ProjectStatusService.GetProjectStatus(ctx, request.NamespaceName, request.ProjectName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetProjectDeploymentStatus403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, projectstatussvc.ErrProjectNotFound) {
+			return gen.GetProjectDeploymentStatus404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		}
+		h.logger.Error("Failed to get project deployment status", "error", err)
+		return gen.GetProjectDeploymentStatus500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	now := time.Now()
+	components := make([]gen.ComponentDeploymentStatus, 0, len(status.Components))
+	for _, c := range status.Components {
+		envs := make(map[string]gen.EnvironmentDeploymentStatus, len(c.Environments))
+		for env, s := range c.Environments {
+			envs[env] = toGenEnvironmentDeploymentStatus(s, now)
+		}
+		components = append(components, gen.ComponentDeploymentStatus{Name: c.Name, Environments: envs})
+	}
+	return gen.GetProjectDeploymentStatus200JSONResponse{
+		Project:      status.Project,
+		Environments: nonNilStrings(status.Environments),
+		Components:   components,
+		Summary:      toGenDeploymentStatusSummary(status.Summary, status.LockedCounts(now)),
+	}, nil
+}
+
+// ListProjectDeploymentSummaries returns the per-environment status counts of the projects in a namespace.
+func (h *Handler) ListProjectDeploymentSummaries(
+	ctx context.Context,
+	request gen.ListProjectDeploymentSummariesRequestObject,
+) (gen.ListProjectDeploymentSummariesResponseObject, error) {
+	h.logger.Debug("ListProjectDeploymentSummaries called", "namespaceName", request.NamespaceName)
+
+	summaries, err := h.services.ProjectStatusService.ListProjectSummaries(ctx, request.NamespaceName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListProjectDeploymentSummaries403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to list project deployment summaries", "error", err)
+		return gen.ListProjectDeploymentSummaries500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.ProjectDeploymentSummary, 0, len(summaries))
+	for _, s := range summaries {
+		items = append(items, gen.ProjectDeploymentSummary{
+			Project:      s.Project,
+			Components:   s.Components,
+			Environments: nonNilStrings(s.Environments),
+			Summary:      toGenDeploymentStatusSummary(s.Summary, s.Locked),
+		})
+	}
+	return gen.ListProjectDeploymentSummaries200JSONResponse{Items: items}, nil
+}
+
+// toGenEnvironmentDeploymentStatus converts a matrix cell. The lock is only included while
+// it is active at now.
+func toGenEnvironmentDeploymentStatus(s statusmodel.EnvironmentStatus, now time.Time) gen.EnvironmentDeploymentStatus {
+	out := gen.EnvironmentDeploymentStatus{
+		Status:             string(s.Status),
+		ReleaseBinding:     s.ReleaseBinding,
+		Release:            optionalString(s.Release),
+		Reason:             optionalString(s.Reason),
+		Message:            optionalString(s.Message),
+		LastTransitionTime: s.LastTransitionTime,
+	}
+	if s.Resources != nil {
+		out.Resources = &gen.ResourceHealthCounts{
+			Total:       s.Resources.Total,
+			Healthy:     s.Resources.Healthy,
+			Progressing: s.Resources.Progressing,
+			Degraded:    s.Resources.Degraded,
+			Suspended:   s.Resources.Suspended,
+			Unknown:     s.Resources.Unknown,
+		}
+	}
+	if s.Lock.Active(now) {
+		out.Lock = &gen.DeploymentLock{
+			Reason:    s.Lock.Reason,
+			LockedBy:  optionalString(s.Lock.LockedBy),
+			ExpiresAt: s.Lock.ExpiresAt,
+		}
+	}
+	return out
+}
+
+func toGenDeploymentStatusSummary(summary map[string]statusmodel.StatusCounts, locked map[string]int) map[string]gen.DeploymentStatusCounts {
+	out := make(map[string]gen.DeploymentStatusCounts, len(summary))
+	for env, c := range summary {
+		counts := gen.DeploymentStatusCounts{
+			Ready:       c.Ready,
+			Progressing: c.Progressing,
+			Failed:      c.Failed,
+			Undeployed:  c.Undeployed,
+			NotDeployed: c.NotDeployed,
+		}
+		if n := locked[env]; n > 0 {
+			counts.Locked = &n
+		}
+		out[env] = counts
+	}
+	return out
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
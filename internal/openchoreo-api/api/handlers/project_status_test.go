@@ -0,0 +1,156 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	projectstatussvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectstatus"
+	projectstatusmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectstatus/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+func newProjectStatusHandler(svc projectstatussvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{ProjectStatusService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestGetProjectDeploymentStatusHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := projectstatusmocks.NewMockService(t)
+		svc.EXPECT().GetProjectStatus(mock.Anything, ns, "shop").Return(&statusmodel.ProjectStatus{
+			Project:      "shop",
+			Environments: []string{"dev"},
+			Components: []statusmodel.ComponentStatus{{
+				Name: "api",
+				Environments: map[string]statusmodel.EnvironmentStatus{
+					"dev": {
+						Status:         statusmodel.StatusReady,
+						ReleaseBinding: "api-dev",
+						Release:        "api-r1",
+						Resources:      &statusmodel.ResourceHealth{Total: 2, Healthy: 2},
+					},
+				},
+			}},
+			Summary: map[string]statusmodel.StatusCounts{"dev": {Ready: 1}},
+		}, nil)
+
+		resp, err := newProjectStatusHandler(svc).GetProjectDeploymentStatus(ctx,
+			gen.GetProjectDeploymentStatusRequestObject{NamespaceName: ns, ProjectName: "shop"})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetProjectDeploymentStatus200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Components, 1)
+		dev := typed.Components[0].Environments["dev"]
+		assert.Equal(t, "Ready", dev.Status)
+		assert.Equal(t, "api-r1", *dev.Release)
+		assert.Nil(t, dev.Reason)
+		assert.Equal(t, &gen.ResourceHealthCounts{Total: 2, Healthy: 2}, dev.Resources)
+		assert.Equal(t, gen.DeploymentStatusCounts{Ready: 1}, typed.Summary["dev"])
+	})
+
+	t.Run("active locks are reported", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		svc := projectstatusmocks.NewMockService(t)
+		svc.EXPECT().GetProjectStatus(mock.Anything, ns, "shop").Return(&statusmodel.ProjectStatus{
+			Project:      "shop",
+			Environments: []string{"prod"},
+			Components: []statusmodel.ComponentStatus{
+				{Name: "api", Environments: map[string]statusmodel.EnvironmentStatus{"prod": {
+					Status: statusmodel.StatusReady,
+					Lock:   &statusmodel.Lock{Reason: "incident", LockedBy: "alice", ExpiresAt: expiresAt},
+				}}},
+				{Name: "worker", Environments: map[string]statusmodel.EnvironmentStatus{"prod": {
+					Status: statusmodel.StatusReady,
+					Lock:   &statusmodel.Lock{Reason: "old", ExpiresAt: time.Now().Add(-time.Hour)},
+				}}},
+			},
+			Summary: map[string]statusmodel.StatusCounts{"prod": {Ready: 2}},
+		}, nil)
+
+		resp, err := newProjectStatusHandler(svc).GetProjectDeploymentStatus(ctx,
+			gen.GetProjectDeploymentStatusRequestObject{NamespaceName: ns, ProjectName: "shop"})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetProjectDeploymentStatus200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Components, 2)
+		api := typed.Components[0].Environments["prod"]
+		require.NotNil(t, api.Lock)
+		assert.Equal(t, "incident", api.Lock.Reason)
+		assert.Equal(t, "alice", *api.Lock.LockedBy)
+		assert.True(t, expiresAt.Equal(api.Lock.ExpiresAt))
+		assert.Nil(t, typed.Components[1].Environments["prod"].Lock, "expired locks are not reported")
+		require.NotNil(t, typed.Summary["prod"].Locked)
+		assert.Equal(t, 1, *typed.Summary["prod"].Locked)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"not found -> 404", projectstatussvc.ErrProjectNotFound, gen.GetProjectDeploymentStatus404JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetProjectDeploymentStatus403JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.GetProjectDeploymentStatus500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := projectstatusmocks.NewMockService(t)
+			svc.EXPECT().GetProjectStatus(mock.Anything, ns, "shop").Return(nil, tt.svcErr)
+			resp, err := newProjectStatusHandler(svc).GetProjectDeploymentStatus(ctx,
+				gen.GetProjectDeploymentStatusRequestObject{NamespaceName: ns, ProjectName: "shop"})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestListProjectDeploymentSummariesHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := projectstatusmocks.NewMockService(t)
+		svc.EXPECT().ListProjectSummaries(mock.Anything, ns).Return([]statusmodel.ProjectSummary{
+			{Project: "empty", Summary: map[string]statusmodel.StatusCounts{}},
+			{Project: "shop", Components: 3, Environments: []string{"dev"},
+				Summary: map[string]statusmodel.StatusCounts{"dev": {Ready: 2, Failed: 1}}},
+		}, nil)
+
+		resp, err := newProjectStatusHandler(svc).ListProjectDeploymentSummaries(ctx,
+			gen.ListProjectDeploymentSummariesRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListProjectDeploymentSummaries200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Items, 2)
+		assert.Equal(t, []string{}, typed.Items[0].Environments)
+		assert.Equal(t, 3, typed.Items[1].Components)
+		assert.Equal(t, gen.DeploymentStatusCounts{Ready: 2, Failed: 1}, typed.Items[1].Summary["dev"])
+	})
+
+	t.Run("internal -> 500", func(t *testing.T) {
+		svc := projectstatusmocks.NewMockService(t)
+		svc.EXPECT().ListProjectSummaries(mock.Anything, ns).Return(nil, errors.New("boom"))
+		resp, err := newProjectStatusHandler(svc).ListProjectDeploymentSummaries(ctx,
+			gen.ListProjectDeploymentSummariesRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListProjectDeploymentSummaries500JSONResponse{}, resp)
+	})
+}
@@ -7,9 +7,13 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
+
+	"k8s.io/utils/ptr"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
 )
@@ -143,8 +147,14 @@ func (h *Handler) UpdateProject(
 			return gen.UpdateProject403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
 		}
 		if errors.Is(err, projectsvc.ErrProjectNotFound) {
+			if projectCR.UID == "" {
+				return h.createProjectOnUpdate(ctx, request.NamespaceName, &projectCR)
+			}
 			return gen.UpdateProject404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
 		}
+		if errors.Is(err, services.ErrUIDMismatch) {
+			return gen.UpdateProject409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			if validationErr.StatusCode == http.StatusUnprocessableEntity {
 				return gen.UpdateProject422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
@@ -165,6 +175,42 @@ func (h *Handler) UpdateProject(
 	return gen.UpdateProject200JSONResponse(genProject), nil
 }
 
+// createProjectOnUpdate creates the project of an update request that found no existing
+// project, which makes PUT an idempotent upsert. Creating requires the create permission.
+func (h *Handler) createProjectOnUpdate(
+	ctx context.Context,
+	namespaceName string,
+	projectCR *openchoreov1alpha1.Project,
+) (gen.UpdateProjectResponseObject, error) {
+	projectCR.ResourceVersion = ""
+	created, err := h.services.ProjectService.CreateProject(ctx, namespaceName, projectCR)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.UpdateProject403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, projectsvc.ErrProjectAlreadyExists) {
+			return gen.UpdateProject409JSONResponse{ConflictJSONResponse: conflict("Project already exists")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			if validationErr.StatusCode == http.StatusUnprocessableEntity {
+				return gen.UpdateProject422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+			}
+			return gen.UpdateProject400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create project", "error", err)
+		return gen.UpdateProject500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genProject, err := convert[openchoreov1alpha1.Project, gen.Project](*created)
+	if err != nil {
+		h.logger.Error("Failed to convert created project", "error", err)
+		return gen.UpdateProject500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Project created by update", "namespaceName", namespaceName, "project", created.Name)
+	return gen.UpdateProject201JSONResponse(genProject), nil
+}
+
 // DeleteProject deletes a project by name.
 func (h *Handler) DeleteProject(
 	ctx context.Context,
@@ -187,3 +233,86 @@ func (h *Handler) DeleteProject(
 	h.logger.Info("Project deleted successfully", "namespaceName", request.NamespaceName, "project", request.ProjectName)
 	return gen.DeleteProject204Response{}, nil
 }
+
+// GetProjectDeliveryMetrics returns DORA delivery metrics for a project.
+func (h *Handler) GetProjectDeliveryMetrics(
+	ctx context.Context,
+	request gen.GetProjectDeliveryMetricsRequestObject,
+) (gen.GetProjectDeliveryMetricsResponseObject, error) {
+	h.logger.Debug("GetProjectDeliveryMetrics called", "namespaceName", request.NamespaceName, "projectName", request.ProjectName)
+
+	opts := models.DeliveryMetricsRequest{}
+	if request.Params.Environment != nil {
+		opts.Environment = *request.Params.Environment
+	}
+	if request.Params.WindowDays != nil {
+		days := *request.Params.WindowDays
+		if days < 1 || days > 365 {
+			return gen.GetProjectDeliveryMetrics400JSONResponse{BadRequestJSONResponse: badRequest("windowDays must be between 1 and 365")}, nil
+		}
+		opts.Window = time.Duration(days) * 24 * time.Hour
+	}
+
+	metrics, err := h.services.ProjectService.GetProjectDeliveryMetrics(ctx, request.NamespaceName, request.ProjectName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetProjectDeliveryMetrics403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, projectsvc.ErrProjectNotFound) {
+			return gen.GetProjectDeliveryMetrics404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		}
+		h.logger.Error("Failed to get project delivery metrics", "error", err)
+		return gen.GetProjectDeliveryMetrics500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	resp := gen.GetProjectDeliveryMetrics200JSONResponse{
+		Project:           metrics.Project,
+		Environments:      metrics.Environments,
+		WindowStart:       metrics.WindowStart,
+		WindowEnd:         metrics.WindowEnd,
+		Deployments:       metrics.Deployments,
+		DeploymentsPerDay: metrics.DeploymentsPerDay,
+		FailedDeployments: metrics.FailedDeployments,
+		ChangeFailureRate: metrics.ChangeFailureRate,
+	}
+	if metrics.MedianLeadTime != nil {
+		resp.MedianLeadTimeSeconds = ptr.To(int64(metrics.MedianLeadTime.Seconds()))
+	}
+	if metrics.MeanTimeToRestore != nil {
+		resp.MeanTimeToRestoreSeconds = ptr.To(int64(metrics.MeanTimeToRestore.Seconds()))
+	}
+	return resp, nil
+}
+
+// GetProjectDeploymentState returns which release each component of a project had deployed
+// in each environment at a point in time.
+func (h *Handler) GetProjectDeploymentState(
+	ctx context.Context,
+	request gen.GetProjectDeploymentStateRequestObject,
+) (gen.GetProjectDeploymentStateResponseObject, error) {
+	h.logger.Debug("GetProjectDeploymentState called", "namespaceName", request.NamespaceName,
+		"projectName", request.ProjectName, "at", request.Params.At)
+
+	if request.Params.At.After(time.Now()) {
+		return gen.GetProjectDeploymentState400JSONResponse{BadRequestJSONResponse: badRequest("at must not be in the future")}, nil
+	}
+
+	state, err := h.services.ProjectService.GetProjectDeploymentState(ctx, request.NamespaceName, request.ProjectName, request.Params.At)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetProjectDeploymentState403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, projectsvc.ErrProjectNotFound) {
+			return gen.GetProjectDeploymentState404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		}
+		h.logger.Error("Failed to get project deployment state", "error", err)
+		return gen.GetProjectDeploymentState500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genState, err := convert[models.ProjectDeploymentStateResponse, gen.ProjectDeploymentState](*state)
+	if err != nil {
+		h.logger.Error("Failed to convert project deployment state", "error", err)
+		return gen.GetProjectDeploymentState500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetProjectDeploymentState200JSONResponse(genState), nil
+}
@@ -291,14 +291,14 @@ func TestProjectHTTPUpdate(t *testing.T) {
 	assertConformsToSpec(t, req, rec.Code, rec.Result().Header, bodyBytes)
 }
 
-func TestProjectHTTPUpdateNotFound(t *testing.T) {
+func TestProjectHTTPUpdateCreatesWhenMissing(t *testing.T) {
 	bundle := newProjectBundle(t, nil, &allowAllPDP{})
 
 	body, _ := json.Marshal(gen.Project{Metadata: gen.ObjectMeta{Name: "nonexistent"}})
 	_, rec := doRequest(t, bundle.handler, http.MethodPut,
 		"/api/v1/namespaces/"+testNS+"/projects/nonexistent", body)
 
-	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, http.StatusCreated, rec.Code)
 }
 
 func TestProjectHTTPUpdateForbidden(t *testing.T) {
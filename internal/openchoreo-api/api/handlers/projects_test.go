@@ -6,6 +6,7 @@ package handlers
 import (
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -233,7 +234,7 @@ func TestUpdateProjectHandler(t *testing.T) {
 		assert.IsType(t, gen.UpdateProject400JSONResponse{}, resp)
 	})
 
-	t.Run("not found returns 404", func(t *testing.T) {
+	t.Run("not found creates and returns 201", func(t *testing.T) {
 		svc := newProjectService(t, nil, &allowAllPDP{})
 		h := newHandlerWithProjectService(svc)
 
@@ -243,9 +244,35 @@ func TestUpdateProjectHandler(t *testing.T) {
 			Body:          &gen.Project{Metadata: gen.ObjectMeta{Name: "nonexistent"}},
 		})
 		require.NoError(t, err)
+		assert.IsType(t, gen.UpdateProject201JSONResponse{}, resp)
+	})
+
+	t.Run("not found with uid returns 404", func(t *testing.T) {
+		svc := newProjectService(t, nil, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.UpdateProject(ctx, gen.UpdateProjectRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "nonexistent",
+			Body:          &gen.Project{Metadata: gen.ObjectMeta{Name: "nonexistent", Uid: ptr.To("old-uid")}},
+		})
+		require.NoError(t, err)
 		assert.IsType(t, gen.UpdateProject404JSONResponse{}, resp)
 	})
 
+	t.Run("uid mismatch returns 409", func(t *testing.T) {
+		svc := newProjectService(t, []client.Object{testProjectObj("proj-1")}, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.UpdateProject(ctx, gen.UpdateProjectRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "proj-1",
+			Body:          &gen.Project{Metadata: gen.ObjectMeta{Name: "proj-1", Uid: ptr.To("old-uid")}},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.UpdateProject409JSONResponse{}, resp)
+	})
+
 	t.Run("forbidden returns 403", func(t *testing.T) {
 		svc := newProjectService(t, []client.Object{testProjectObj("proj-1")}, &denyAllPDP{})
 		h := newHandlerWithProjectService(svc)
@@ -277,6 +304,42 @@ func TestUpdateProjectHandler(t *testing.T) {
 	})
 }
 
+func TestUpdateProjectHandler_UpsertPermissions(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	tests := []struct {
+		name    string
+		allowed []string
+		objects []client.Object
+		wantTyp any
+	}{
+		{"update allowed on existing project", []string{authzcore.ActionUpdateProject}, []client.Object{testProjectObj("proj-1")}, gen.UpdateProject200JSONResponse{}},
+		{"update only on missing project", []string{authzcore.ActionUpdateProject}, nil, gen.UpdateProject403JSONResponse{}},
+		{"create only on existing project", []string{authzcore.ActionCreateProject}, []client.Object{testProjectObj("proj-1")}, gen.UpdateProject403JSONResponse{}},
+		{"create only on missing project", []string{authzcore.ActionCreateProject}, nil, gen.UpdateProject201JSONResponse{}},
+		{"neither on missing project", nil, nil, gen.UpdateProject403JSONResponse{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pdp := &actionPDP{allowedActions: map[string]bool{}}
+			for _, action := range tt.allowed {
+				pdp.allowedActions[action] = true
+			}
+			h := newHandlerWithProjectService(newProjectService(t, tt.objects, pdp))
+
+			resp, err := h.UpdateProject(ctx, gen.UpdateProjectRequestObject{
+				NamespaceName: ns,
+				ProjectName:   "proj-1",
+				Body:          &gen.Project{Metadata: gen.ObjectMeta{Name: "proj-1"}},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
 // --- DeleteProject Handler ---
 
 func TestDeleteProjectHandler(t *testing.T) {
@@ -310,3 +373,118 @@ func TestDeleteProjectHandler(t *testing.T) {
 		assert.IsType(t, gen.DeleteProject403JSONResponse{}, resp)
 	})
 }
+
+// --- GetProjectDeliveryMetrics Handler ---
+
+func TestGetProjectDeliveryMetricsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := newProjectService(t, []client.Object{testProjectObj("proj-1")}, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.GetProjectDeliveryMetrics(ctx, gen.GetProjectDeliveryMetricsRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "proj-1",
+			Params:        gen.GetProjectDeliveryMetricsParams{Environment: ptr.To("prod"), WindowDays: ptr.To(7)},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetProjectDeliveryMetrics200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "proj-1", typed.Project)
+		assert.Equal(t, []string{"prod"}, typed.Environments)
+		assert.Zero(t, typed.Deployments)
+		assert.Nil(t, typed.ChangeFailureRate)
+		assert.Equal(t, 7*24*time.Hour, typed.WindowEnd.Sub(typed.WindowStart))
+	})
+
+	t.Run("invalid window returns 400", func(t *testing.T) {
+		svc := newProjectService(t, []client.Object{testProjectObj("proj-1")}, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.GetProjectDeliveryMetrics(ctx, gen.GetProjectDeliveryMetricsRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "proj-1",
+			Params:        gen.GetProjectDeliveryMetricsParams{WindowDays: ptr.To(0)},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetProjectDeliveryMetrics400JSONResponse{}, resp)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newProjectService(t, nil, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.GetProjectDeliveryMetrics(ctx, gen.GetProjectDeliveryMetricsRequestObject{NamespaceName: ns, ProjectName: "nonexistent"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetProjectDeliveryMetrics404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newProjectService(t, []client.Object{testProjectObj("proj-1")}, &denyAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.GetProjectDeliveryMetrics(ctx, gen.GetProjectDeliveryMetricsRequestObject{NamespaceName: ns, ProjectName: "proj-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetProjectDeliveryMetrics403JSONResponse{}, resp)
+	})
+}
+
+// --- GetProjectDeploymentState Handler ---
+
+func TestGetProjectDeploymentStateHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	at := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	t.Run("success", func(t *testing.T) {
+		svc := newProjectService(t, []client.Object{testProjectObj("proj-1")}, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.GetProjectDeploymentState(ctx, gen.GetProjectDeploymentStateRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "proj-1",
+			Params:        gen.GetProjectDeploymentStateParams{At: at},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetProjectDeploymentState200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "proj-1", typed.Project)
+		assert.True(t, typed.At.Equal(at))
+		assert.Empty(t, typed.Deployments)
+	})
+
+	t.Run("future time returns 400", func(t *testing.T) {
+		svc := newProjectService(t, []client.Object{testProjectObj("proj-1")}, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.GetProjectDeploymentState(ctx, gen.GetProjectDeploymentStateRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "proj-1",
+			Params:        gen.GetProjectDeploymentStateParams{At: time.Now().Add(time.Hour)},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetProjectDeploymentState400JSONResponse{}, resp)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newProjectService(t, nil, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.GetProjectDeploymentState(ctx, gen.GetProjectDeploymentStateRequestObject{
+			NamespaceName: ns, ProjectName: "nonexistent", Params: gen.GetProjectDeploymentStateParams{At: at}})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetProjectDeploymentState404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newProjectService(t, []client.Object{testProjectObj("proj-1")}, &denyAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.GetProjectDeploymentState(ctx, gen.GetProjectDeploymentStateRequestObject{
+			NamespaceName: ns, ProjectName: "proj-1", Params: gen.GetProjectDeploymentStateParams{At: at}})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetProjectDeploymentState403JSONResponse{}, resp)
+	})
+}
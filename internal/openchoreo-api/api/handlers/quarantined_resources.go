@@ -0,0 +1,77 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	quarantinesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quarantine"
+)
+
+// ListQuarantinedResources returns the resources of a namespace the controller manager
+// quarantined after failing reconciles.
+func (h *Handler) ListQuarantinedResources(
+	ctx context.Context,
+	request gen.ListQuarantinedResourcesRequestObject,
+) (gen.ListQuarantinedResourcesResponseObject, error) {
+	h.logger.Debug("ListQuarantinedResources called", "namespaceName", request.NamespaceName)
+
+	resources, err := h.services.QuarantineService.ListQuarantinedResources(ctx, request.NamespaceName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListQuarantinedResources403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to list quarantined resources", "error", err)
+		return gen.ListQuarantinedResources500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[models.QuarantinedResource, gen.QuarantinedResource](resources)
+	if err != nil {
+		h.logger.Error("Failed to convert quarantined resources", "error", err)
+		return gen.ListQuarantinedResources500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.ListQuarantinedResources200JSONResponse{Items: items}, nil
+}
+
+// RequeueQuarantinedResource requests the controller manager to reconcile a quarantined
+// resource again.
+func (h *Handler) RequeueQuarantinedResource(
+	ctx context.Context,
+	request gen.RequeueQuarantinedResourceRequestObject,
+) (gen.RequeueQuarantinedResourceResponseObject, error) {
+	h.logger.Info("RequeueQuarantinedResource called",
+		"namespaceName", request.NamespaceName,
+		"kind", request.Kind,
+		"name", request.Name)
+
+	resource, err := h.services.QuarantineService.RequeueQuarantinedResource(ctx, request.NamespaceName, request.Kind, request.Name)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.RequeueQuarantinedResource403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, quarantinesvc.ErrUnsupportedKind) {
+			return gen.RequeueQuarantinedResource400JSONResponse{BadRequestJSONResponse: badRequest(
+				"kind must be one of Component, ReleaseBinding, Resource or ResourceReleaseBinding")}, nil
+		}
+		if errors.Is(err, quarantinesvc.ErrResourceNotFound) {
+			return gen.RequeueQuarantinedResource404JSONResponse{NotFoundJSONResponse: notFound(request.Kind)}, nil
+		}
+		if errors.Is(err, quarantinesvc.ErrNotQuarantined) {
+			return gen.RequeueQuarantinedResource409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to requeue quarantined resource", "error", err)
+		return gen.RequeueQuarantinedResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genResource, err := convert[models.QuarantinedResource, gen.QuarantinedResource](*resource)
+	if err != nil {
+		h.logger.Error("Failed to convert quarantined resource", "error", err)
+		return gen.RequeueQuarantinedResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.RequeueQuarantinedResource200JSONResponse(genResource), nil
+}
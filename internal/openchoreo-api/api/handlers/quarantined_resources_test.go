@@ -0,0 +1,109 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	quarantinesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quarantine"
+	quarantinemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quarantine/mocks"
+)
+
+func newQuarantineHandler(svc quarantinesvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{QuarantineService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestListQuarantinedResourcesHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success returns 200 with the resources", func(t *testing.T) {
+		svc := quarantinemocks.NewMockService(t)
+		svc.EXPECT().ListQuarantinedResources(mock.Anything, "test-ns").Return([]models.QuarantinedResource{{
+			Kind:          quarantinesvc.KindReleaseBinding,
+			Name:          "api-production",
+			Project:       "shop",
+			Component:     "api",
+			Environment:   "production",
+			QuarantinedAt: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+			Message:       "Reconcile failed 20 consecutive times",
+		}}, nil)
+
+		resp, err := newQuarantineHandler(svc).ListQuarantinedResources(ctx, gen.ListQuarantinedResourcesRequestObject{NamespaceName: "test-ns"})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListQuarantinedResources200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Items, 1)
+		assert.Equal(t, gen.QuarantinedResourceKindReleaseBinding, typed.Items[0].Kind)
+		assert.Equal(t, "production", *typed.Items[0].Environment)
+		assert.Nil(t, typed.Items[0].Resource)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := quarantinemocks.NewMockService(t)
+		svc.EXPECT().ListQuarantinedResources(mock.Anything, "test-ns").Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := newQuarantineHandler(svc).ListQuarantinedResources(ctx, gen.ListQuarantinedResourcesRequestObject{NamespaceName: "test-ns"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListQuarantinedResources403JSONResponse{}, resp)
+	})
+}
+
+func TestRequeueQuarantinedResourceHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.RequeueQuarantinedResourceRequestObject{NamespaceName: "test-ns", Kind: "Component", Name: "api"}
+
+	t.Run("success returns 200", func(t *testing.T) {
+		requestedAt := time.Date(2026, 5, 1, 13, 0, 0, 0, time.UTC)
+		svc := quarantinemocks.NewMockService(t)
+		svc.EXPECT().RequeueQuarantinedResource(mock.Anything, "test-ns", "Component", "api").Return(&models.QuarantinedResource{
+			Kind:               quarantinesvc.KindComponent,
+			Name:               "api",
+			RequeueRequestedAt: &requestedAt,
+		}, nil)
+
+		resp, err := newQuarantineHandler(svc).RequeueQuarantinedResource(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.RequeueQuarantinedResource200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, typed.RequeueRequestedAt)
+		assert.True(t, typed.RequeueRequestedAt.Equal(requestedAt))
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"unsupported kind -> 400", quarantinesvc.ErrUnsupportedKind, gen.RequeueQuarantinedResource400JSONResponse{}},
+		{"not found -> 404", quarantinesvc.ErrResourceNotFound, gen.RequeueQuarantinedResource404JSONResponse{}},
+		{"not quarantined -> 409", quarantinesvc.ErrNotQuarantined, gen.RequeueQuarantinedResource409JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.RequeueQuarantinedResource403JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.RequeueQuarantinedResource500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := quarantinemocks.NewMockService(t)
+			svc.EXPECT().RequeueQuarantinedResource(mock.Anything, "test-ns", "Component", "api").Return(nil, tt.svcErr)
+
+			resp, err := newQuarantineHandler(svc).RequeueQuarantinedResource(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
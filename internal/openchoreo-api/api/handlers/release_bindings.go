@@ -6,10 +6,18 @@ package handlers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/deploymentlock"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	releasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding"
 )
@@ -49,6 +57,10 @@ func (h *Handler) ListReleaseBindings(
 		return gen.ListReleaseBindings500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
 	}
 
+	if request.Params.IncludeRuntime != nil && *request.Params.IncludeRuntime {
+		h.addReleaseBindingsRuntime(ctx, request.NamespaceName, result.Items, items)
+	}
+
 	resp := gen.ListReleaseBindings200JSONResponse{
 		Items: items,
 	}
@@ -57,6 +69,28 @@ func (h *Handler) ListReleaseBindings(
 	return resp, nil
 }
 
+// addReleaseBindingsRuntime sets the runtime state of the listed release bindings. The runtime
+// state is best effort: when it cannot be read the bindings are returned without it.
+func (h *Handler) addReleaseBindingsRuntime(ctx context.Context, namespaceName string, bindings []openchoreov1alpha1.ReleaseBinding, items []gen.ReleaseBinding) {
+	runtimes, err := h.services.ReleaseBindingService.GetReleaseBindingsRuntime(ctx, namespaceName, bindings)
+	if err != nil {
+		h.logger.Warn("Failed to get release binding runtime", "error", err)
+		return
+	}
+	for i := range items {
+		runtime, ok := runtimes[items[i].Metadata.Name]
+		if !ok {
+			continue
+		}
+		genRuntime, err := convert[models.ReleaseBindingRuntime, gen.ReleaseBindingRuntime](*runtime)
+		if err != nil {
+			h.logger.Warn("Failed to convert release binding runtime", "error", err)
+			return
+		}
+		items[i].Runtime = &genRuntime
+	}
+}
+
 // CreateReleaseBinding creates a new release binding within a namespace.
 func (h *Handler) CreateReleaseBinding(
 	ctx context.Context,
@@ -86,6 +120,9 @@ func (h *Handler) CreateReleaseBinding(
 		if errors.Is(err, releasebindingsvc.ErrReleaseBindingAlreadyExists) {
 			return gen.CreateReleaseBinding409JSONResponse{ConflictJSONResponse: conflict("ReleaseBinding already exists")}, nil
 		}
+		if quotaErr, ok := errors.AsType[*controller.ProjectQuotaExceededError](err); ok {
+			return gen.CreateReleaseBinding429JSONResponse{ProjectQuotaExceededJSONResponse: projectQuotaExceeded(quotaErr)}, nil
+		}
 		if errors.Is(err, releasebindingsvc.ErrComponentNotFound) {
 			return gen.CreateReleaseBinding400JSONResponse{BadRequestJSONResponse: badRequest("Referenced component not found")}, nil
 		}
@@ -169,6 +206,9 @@ func (h *Handler) UpdateReleaseBinding(
 		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
 			return gen.UpdateReleaseBinding404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
 		}
+		if lockedErr, ok := errors.AsType[*deploymentlock.LockedError](err); ok {
+			return gen.UpdateReleaseBinding409JSONResponse{ConflictJSONResponse: conflict(lockedErr.Error())}, nil
+		}
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			if validationErr.StatusCode == http.StatusUnprocessableEntity {
 				return gen.UpdateReleaseBinding422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
@@ -211,3 +251,342 @@ func (h *Handler) DeleteReleaseBinding(
 	h.logger.Info("ReleaseBinding deleted successfully", "namespaceName", request.NamespaceName, "releaseBinding", request.ReleaseBindingName)
 	return gen.DeleteReleaseBinding204Response{}, nil
 }
+
+// ApplyReleaseBindingResourceRecommendation writes recommended CPU and memory requests and
+// limits into a release binding's environment configuration.
+func (h *Handler) ApplyReleaseBindingResourceRecommendation(
+	ctx context.Context,
+	request gen.ApplyReleaseBindingResourceRecommendationRequestObject,
+) (gen.ApplyReleaseBindingResourceRecommendationResponseObject, error) {
+	h.logger.Info("ApplyReleaseBindingResourceRecommendation called", "namespaceName", request.NamespaceName, "releaseBindingName", request.ReleaseBindingName)
+
+	if request.Body == nil {
+		return gen.ApplyReleaseBindingResourceRecommendation400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	requests, err := toResourceList("requests", request.Body.Requests)
+	if err != nil {
+		return gen.ApplyReleaseBindingResourceRecommendation400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+	limits, err := toResourceList("limits", request.Body.Limits)
+	if err != nil {
+		return gen.ApplyReleaseBindingResourceRecommendation400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	updated, err := h.services.ReleaseBindingService.ApplyResourceRecommendation(ctx, request.NamespaceName, request.ReleaseBindingName,
+		corev1.ResourceRequirements{Requests: requests, Limits: limits})
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ApplyReleaseBindingResourceRecommendation403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
+			return gen.ApplyReleaseBindingResourceRecommendation404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		if lockedErr, ok := errors.AsType[*deploymentlock.LockedError](err); ok {
+			return gen.ApplyReleaseBindingResourceRecommendation409JSONResponse{ConflictJSONResponse: conflict(lockedErr.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			if validationErr.StatusCode == http.StatusUnprocessableEntity {
+				return gen.ApplyReleaseBindingResourceRecommendation422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+			}
+			return gen.ApplyReleaseBindingResourceRecommendation400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to apply resource recommendation", "error", err)
+		return gen.ApplyReleaseBindingResourceRecommendation500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRB, err := convert[openchoreov1alpha1.ReleaseBinding, gen.ReleaseBinding](*updated)
+	if err != nil {
+		h.logger.Error("Failed to convert updated release binding", "error", err)
+		return gen.ApplyReleaseBindingResourceRecommendation500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Resource recommendation applied", "namespaceName", request.NamespaceName, "releaseBinding", updated.Name)
+	return gen.ApplyReleaseBindingResourceRecommendation200JSONResponse(genRB), nil
+}
+
+// SuspendReleaseBinding sets a release binding's state to Undeploy.
+func (h *Handler) SuspendReleaseBinding(
+	ctx context.Context,
+	request gen.SuspendReleaseBindingRequestObject,
+) (gen.SuspendReleaseBindingResponseObject, error) {
+	h.logger.Info("SuspendReleaseBinding called", "namespaceName", request.NamespaceName, "releaseBindingName", request.ReleaseBindingName)
+
+	suspended, err := h.services.ReleaseBindingService.SuspendReleaseBinding(ctx, request.NamespaceName, request.ReleaseBindingName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.SuspendReleaseBinding403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
+			return gen.SuspendReleaseBinding404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		if lockedErr, ok := errors.AsType[*deploymentlock.LockedError](err); ok {
+			return gen.SuspendReleaseBinding409JSONResponse{ConflictJSONResponse: conflict(lockedErr.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.SuspendReleaseBinding400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to suspend release binding", "error", err)
+		return gen.SuspendReleaseBinding500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRB, err := convert[openchoreov1alpha1.ReleaseBinding, gen.ReleaseBinding](*suspended)
+	if err != nil {
+		h.logger.Error("Failed to convert suspended release binding", "error", err)
+		return gen.SuspendReleaseBinding500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Release binding suspended", "namespaceName", request.NamespaceName, "releaseBinding", suspended.Name)
+	return gen.SuspendReleaseBinding200JSONResponse(genRB), nil
+}
+
+// LockReleaseBinding locks a release binding against deploys, promotions and restarts.
+func (h *Handler) LockReleaseBinding(
+	ctx context.Context,
+	request gen.LockReleaseBindingRequestObject,
+) (gen.LockReleaseBindingResponseObject, error) {
+	h.logger.Info("LockReleaseBinding called", "namespaceName", request.NamespaceName, "releaseBindingName", request.ReleaseBindingName)
+
+	if request.Body == nil {
+		return gen.LockReleaseBinding400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	locked, err := h.services.ReleaseBindingService.LockReleaseBinding(ctx, request.NamespaceName, request.ReleaseBindingName,
+		openchoreov1alpha1.DeploymentLock{
+			Reason:    request.Body.Reason,
+			ExpiresAt: metav1.NewTime(request.Body.ExpiresAt),
+		})
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.LockReleaseBinding403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
+			return gen.LockReleaseBinding404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.LockReleaseBinding400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to lock release binding", "error", err)
+		return gen.LockReleaseBinding500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRB, err := convert[openchoreov1alpha1.ReleaseBinding, gen.ReleaseBinding](*locked)
+	if err != nil {
+		h.logger.Error("Failed to convert locked release binding", "error", err)
+		return gen.LockReleaseBinding500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Release binding locked", "namespaceName", request.NamespaceName, "releaseBinding", locked.Name)
+	return gen.LockReleaseBinding200JSONResponse(genRB), nil
+}
+
+// UnlockReleaseBinding removes the lock of a release binding.
+func (h *Handler) UnlockReleaseBinding(
+	ctx context.Context,
+	request gen.UnlockReleaseBindingRequestObject,
+) (gen.UnlockReleaseBindingResponseObject, error) {
+	h.logger.Info("UnlockReleaseBinding called", "namespaceName", request.NamespaceName, "releaseBindingName", request.ReleaseBindingName)
+
+	unlocked, err := h.services.ReleaseBindingService.UnlockReleaseBinding(ctx, request.NamespaceName, request.ReleaseBindingName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.UnlockReleaseBinding403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
+			return gen.UnlockReleaseBinding404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		h.logger.Error("Failed to unlock release binding", "error", err)
+		return gen.UnlockReleaseBinding500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRB, err := convert[openchoreov1alpha1.ReleaseBinding, gen.ReleaseBinding](*unlocked)
+	if err != nil {
+		h.logger.Error("Failed to convert unlocked release binding", "error", err)
+		return gen.UnlockReleaseBinding500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Release binding unlocked", "namespaceName", request.NamespaceName, "releaseBinding", unlocked.Name)
+	return gen.UnlockReleaseBinding200JSONResponse(genRB), nil
+}
+
+// EnableReleaseBindingMaintenance puts a release binding in maintenance.
+func (h *Handler) EnableReleaseBindingMaintenance(
+	ctx context.Context,
+	request gen.EnableReleaseBindingMaintenanceRequestObject,
+) (gen.EnableReleaseBindingMaintenanceResponseObject, error) {
+	h.logger.Info("EnableReleaseBindingMaintenance called", "namespaceName", request.NamespaceName, "releaseBindingName", request.ReleaseBindingName)
+
+	if request.Body == nil {
+		return gen.EnableReleaseBindingMaintenance400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	maintenance := openchoreov1alpha1.MaintenanceMode{}
+	if request.Body.StatusCode != nil {
+		maintenance.StatusCode = *request.Body.StatusCode
+	}
+	if request.Body.Body != nil {
+		maintenance.Body = *request.Body.Body
+	}
+	if request.Body.RedirectURL != nil {
+		maintenance.RedirectURL = *request.Body.RedirectURL
+	}
+	if request.Body.Reason != nil {
+		maintenance.Reason = *request.Body.Reason
+	}
+
+	updated, err := h.services.ReleaseBindingService.EnableMaintenance(ctx, request.NamespaceName, request.ReleaseBindingName, maintenance)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.EnableReleaseBindingMaintenance403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
+			return gen.EnableReleaseBindingMaintenance404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.EnableReleaseBindingMaintenance400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to enable release binding maintenance", "error", err)
+		return gen.EnableReleaseBindingMaintenance500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRB, err := convert[openchoreov1alpha1.ReleaseBinding, gen.ReleaseBinding](*updated)
+	if err != nil {
+		h.logger.Error("Failed to convert release binding in maintenance", "error", err)
+		return gen.EnableReleaseBindingMaintenance500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Release binding in maintenance", "namespaceName", request.NamespaceName, "releaseBinding", updated.Name)
+	return gen.EnableReleaseBindingMaintenance200JSONResponse(genRB), nil
+}
+
+// DisableReleaseBindingMaintenance ends the maintenance of a release binding.
+func (h *Handler) DisableReleaseBindingMaintenance(
+	ctx context.Context,
+	request gen.DisableReleaseBindingMaintenanceRequestObject,
+) (gen.DisableReleaseBindingMaintenanceResponseObject, error) {
+	h.logger.Info("DisableReleaseBindingMaintenance called", "namespaceName", request.NamespaceName, "releaseBindingName", request.ReleaseBindingName)
+
+	updated, err := h.services.ReleaseBindingService.DisableMaintenance(ctx, request.NamespaceName, request.ReleaseBindingName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.DisableReleaseBindingMaintenance403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
+			return gen.DisableReleaseBindingMaintenance404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		h.logger.Error("Failed to disable release binding maintenance", "error", err)
+		return gen.DisableReleaseBindingMaintenance500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRB, err := convert[openchoreov1alpha1.ReleaseBinding, gen.ReleaseBinding](*updated)
+	if err != nil {
+		h.logger.Error("Failed to convert release binding out of maintenance", "error", err)
+		return gen.DisableReleaseBindingMaintenance500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Release binding out of maintenance", "namespaceName", request.NamespaceName, "releaseBinding", updated.Name)
+	return gen.DisableReleaseBindingMaintenance200JSONResponse(genRB), nil
+}
+
+// GetReleaseBindingRolloutProgress reports the rollout progress of a release binding's workloads.
+func (h *Handler) GetReleaseBindingRolloutProgress(
+	ctx context.Context,
+	request gen.GetReleaseBindingRolloutProgressRequestObject,
+) (gen.GetReleaseBindingRolloutProgressResponseObject, error) {
+	h.logger.Debug("GetReleaseBindingRolloutProgress called", "namespaceName", request.NamespaceName, "releaseBindingName", request.ReleaseBindingName)
+
+	progress, err := h.services.ReleaseBindingService.GetRolloutProgress(ctx, request.NamespaceName, request.ReleaseBindingName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetReleaseBindingRolloutProgress403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
+			return gen.GetReleaseBindingRolloutProgress404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		h.logger.Error("Failed to get rollout progress", "error", err)
+		return gen.GetReleaseBindingRolloutProgress500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genProgress, err := convert[models.RolloutProgress, gen.RolloutProgress](*progress)
+	if err != nil {
+		h.logger.Error("Failed to convert rollout progress", "error", err)
+		return gen.GetReleaseBindingRolloutProgress500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetReleaseBindingRolloutProgress200JSONResponse(genProgress), nil
+}
+
+// GetReleaseBindingStatusHistory returns the condition transition timeline of a release binding.
+func (h *Handler) GetReleaseBindingStatusHistory(
+	ctx context.Context,
+	request gen.GetReleaseBindingStatusHistoryRequestObject,
+) (gen.GetReleaseBindingStatusHistoryResponseObject, error) {
+	h.logger.Debug("GetReleaseBindingStatusHistory called", "namespaceName", request.NamespaceName, "releaseBindingName", request.ReleaseBindingName)
+
+	history, err := h.services.ReleaseBindingService.GetStatusHistory(ctx, request.NamespaceName, request.ReleaseBindingName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetReleaseBindingStatusHistory403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
+			return gen.GetReleaseBindingStatusHistory404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		h.logger.Error("Failed to get release binding status history", "error", err)
+		return gen.GetReleaseBindingStatusHistory500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genHistory, err := convert[models.StatusHistory, gen.StatusHistory](*history)
+	if err != nil {
+		h.logger.Error("Failed to convert status history", "error", err)
+		return gen.GetReleaseBindingStatusHistory500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetReleaseBindingStatusHistory200JSONResponse(genHistory), nil
+}
+
+// GetReleaseBindingConfigDiff returns the configuration changes between the previous and current
+// release of a release binding.
+func (h *Handler) GetReleaseBindingConfigDiff(
+	ctx context.Context,
+	request gen.GetReleaseBindingConfigDiffRequestObject,
+) (gen.GetReleaseBindingConfigDiffResponseObject, error) {
+	h.logger.Debug("GetReleaseBindingConfigDiff called", "namespaceName", request.NamespaceName, "releaseBindingName", request.ReleaseBindingName)
+
+	diff, err := h.services.ReleaseBindingService.GetConfigDiff(ctx, request.NamespaceName, request.ReleaseBindingName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetReleaseBindingConfigDiff403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrReleaseBindingNotFound) {
+			return gen.GetReleaseBindingConfigDiff404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+		}
+		if errors.Is(err, releasebindingsvc.ErrComponentReleaseNotFound) {
+			return gen.GetReleaseBindingConfigDiff404JSONResponse{NotFoundJSONResponse: notFound("ComponentRelease")}, nil
+		}
+		h.logger.Error("Failed to get release binding config diff", "error", err)
+		return gen.GetReleaseBindingConfigDiff500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genDiff, err := convert[models.ConfigDiff, gen.ConfigDiff](*diff)
+	if err != nil {
+		h.logger.Error("Failed to convert config diff", "error", err)
+		return gen.GetReleaseBindingConfigDiff500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetReleaseBindingConfigDiff200JSONResponse(genDiff), nil
+}
+
+// toResourceList parses the CPU and memory quantities of a recommendation request.
+func toResourceList(field string, q *gen.ResourceRecommendationQuantities) (corev1.ResourceList, error) {
+	if q == nil {
+		return nil, nil
+	}
+	list := corev1.ResourceList{}
+	for name, value := range map[corev1.ResourceName]*string{corev1.ResourceCPU: q.Cpu, corev1.ResourceMemory: q.Memory} {
+		if value == nil || *value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(*value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s.%s %q: %w", field, name, *value, err)
+		}
+		list[name] = quantity
+	}
+	return list, nil
+}
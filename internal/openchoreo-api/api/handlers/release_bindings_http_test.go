@@ -40,7 +40,7 @@ func newRBBundle(t *testing.T, objects []client.Object, pdp authzcore.PDP) rbBun
 		WithScheme(newTestScheme(t)).
 		WithObjects(objects...).
 		Build()
-	svc := releasebindingsvc.NewServiceWithAuthz(fc, pdp, slog.Default())
+	svc := releasebindingsvc.NewServiceWithAuthz(fc, nil, pdp, slog.Default())
 	services := &handlerservices.Services{ReleaseBindingService: svc}
 	return rbBundle{
 		handler:    newTestHTTPHandler(t, services),
@@ -6,6 +6,7 @@ package handlers
 import (
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,7 +28,7 @@ func newReleaseBindingService(t *testing.T, objects []client.Object, pdp authzco
 		WithScheme(newTestScheme(t)).
 		WithObjects(objects...).
 		Build()
-	return releasebindingsvc.NewServiceWithAuthz(fakeClient, pdp, slog.Default())
+	return releasebindingsvc.NewServiceWithAuthz(fakeClient, nil, pdp, slog.Default())
 }
 
 func newHandlerWithReleaseBindingService(svc releasebindingsvc.Service) *Handler {
@@ -93,6 +94,25 @@ func TestListReleaseBindingsHandler(t *testing.T) {
 		typed, ok := resp.(gen.ListReleaseBindings200JSONResponse)
 		require.True(t, ok, "expected 200 response, got %T", resp)
 		require.Len(t, typed.Items, 1)
+		assert.Nil(t, typed.Items[0].Runtime)
+	})
+
+	t.Run("includeRuntime adds runtime", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.ListReleaseBindings(ctx, gen.ListReleaseBindingsRequestObject{
+			NamespaceName: ns,
+			Params:        gen.ListReleaseBindingsParams{IncludeRuntime: ptr.To(true)},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListReleaseBindings200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Items, 1)
+		// Without a RenderedRelease or an observability plane the runtime has no fields
+		require.NotNil(t, typed.Items[0].Runtime)
+		assert.Nil(t, typed.Items[0].Runtime.Replicas)
+		assert.Nil(t, typed.Items[0].Runtime.RequestRate)
 	})
 
 	t.Run("validation error returns 400", func(t *testing.T) {
@@ -203,6 +223,28 @@ func TestCreateReleaseBindingHandler(t *testing.T) {
 		assert.IsType(t, gen.CreateReleaseBinding409JSONResponse{}, resp)
 	})
 
+	t.Run("project quota exhausted returns 429", func(t *testing.T) {
+		project := &openchoreov1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-proj", Namespace: ns},
+			Spec: openchoreov1alpha1.ProjectSpec{
+				Quota: &openchoreov1alpha1.ProjectQuota{MaxEnvironments: ptr.To[int32](0)},
+			},
+		}
+		svc := newReleaseBindingService(t, []client.Object{testComponentForRB(), project}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.CreateReleaseBinding(ctx, gen.CreateReleaseBindingRequestObject{
+			NamespaceName: ns,
+			Body:          validReleaseBindingBody("new-rb"),
+		})
+		require.NoError(t, err)
+		quotaResp, ok := resp.(gen.CreateReleaseBinding429JSONResponse)
+		require.True(t, ok, "expected 429, got %T", resp)
+		assert.Equal(t, gen.Environments, quotaResp.Reason)
+		assert.Equal(t, "0", quotaResp.Limit)
+		assert.Equal(t, "0", quotaResp.Remaining)
+	})
+
 	t.Run("namespace mismatch returns 400", func(t *testing.T) {
 		svc := newReleaseBindingService(t, nil, &allowAllPDP{})
 		h := newHandlerWithReleaseBindingService(svc)
@@ -341,3 +383,395 @@ func TestDeleteReleaseBindingHandler(t *testing.T) {
 		assert.IsType(t, gen.DeleteReleaseBinding403JSONResponse{}, resp)
 	})
 }
+
+// --- ApplyReleaseBindingResourceRecommendation Handler ---
+
+func TestApplyReleaseBindingResourceRecommendationHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	body := &gen.ResourceRecommendationApplyRequest{
+		Requests: &gen.ResourceRecommendationQuantities{Cpu: ptr.To("120m"), Memory: ptr.To("200Mi")},
+		Limits:   &gen.ResourceRecommendationQuantities{Memory: ptr.To("256Mi")},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.ApplyReleaseBindingResourceRecommendation(ctx, gen.ApplyReleaseBindingResourceRecommendationRequestObject{
+			NamespaceName: ns, ReleaseBindingName: "rb-1", Body: body,
+		})
+		require.NoError(t, err)
+		rb, ok := resp.(gen.ApplyReleaseBindingResourceRecommendation200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, rb.Metadata.Annotations)
+		assert.Contains(t, *rb.Metadata.Annotations, releasebindingsvc.AnnotationKeyResourceRecommendation)
+	})
+
+	t.Run("invalid quantity returns 400", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.ApplyReleaseBindingResourceRecommendation(ctx, gen.ApplyReleaseBindingResourceRecommendationRequestObject{
+			NamespaceName: ns, ReleaseBindingName: "rb-1",
+			Body: &gen.ResourceRecommendationApplyRequest{Requests: &gen.ResourceRecommendationQuantities{Cpu: ptr.To("lots")}},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ApplyReleaseBindingResourceRecommendation400JSONResponse{}, resp)
+	})
+
+	t.Run("empty body returns 400", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.ApplyReleaseBindingResourceRecommendation(ctx, gen.ApplyReleaseBindingResourceRecommendationRequestObject{
+			NamespaceName: ns, ReleaseBindingName: "rb-1", Body: &gen.ResourceRecommendationApplyRequest{},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ApplyReleaseBindingResourceRecommendation400JSONResponse{}, resp)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newReleaseBindingService(t, nil, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.ApplyReleaseBindingResourceRecommendation(ctx, gen.ApplyReleaseBindingResourceRecommendationRequestObject{
+			NamespaceName: ns, ReleaseBindingName: "nonexistent", Body: body,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ApplyReleaseBindingResourceRecommendation404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &denyAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.ApplyReleaseBindingResourceRecommendation(ctx, gen.ApplyReleaseBindingResourceRecommendationRequestObject{
+			NamespaceName: ns, ReleaseBindingName: "rb-1", Body: body,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ApplyReleaseBindingResourceRecommendation403JSONResponse{}, resp)
+	})
+}
+
+func TestSuspendReleaseBindingHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.SuspendReleaseBinding(ctx, gen.SuspendReleaseBindingRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		rb, ok := resp.(gen.SuspendReleaseBinding200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, rb.Spec)
+		require.NotNil(t, rb.Spec.State)
+		assert.Equal(t, gen.ReleaseBindingSpecStateUndeploy, *rb.Spec.State)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newReleaseBindingService(t, nil, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.SuspendReleaseBinding(ctx, gen.SuspendReleaseBindingRequestObject{NamespaceName: ns, ReleaseBindingName: "nonexistent"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.SuspendReleaseBinding404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &denyAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.SuspendReleaseBinding(ctx, gen.SuspendReleaseBindingRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.SuspendReleaseBinding403JSONResponse{}, resp)
+	})
+}
+
+func lockedReleaseBindingObj(name string) *openchoreov1alpha1.ReleaseBinding {
+	rb := testReleaseBindingObj(name)
+	rb.Spec.Lock = &openchoreov1alpha1.DeploymentLock{
+		Reason:    "incident",
+		ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour)),
+	}
+	return rb
+}
+
+func TestSuspendReleaseBindingHandler_LockedReturns409(t *testing.T) {
+	svc := newReleaseBindingService(t, []client.Object{lockedReleaseBindingObj("rb-1")}, &allowAllPDP{})
+	h := newHandlerWithReleaseBindingService(svc)
+
+	resp, err := h.SuspendReleaseBinding(testContext(), gen.SuspendReleaseBindingRequestObject{NamespaceName: "test-ns", ReleaseBindingName: "rb-1"})
+	require.NoError(t, err)
+	conflictResp, ok := resp.(gen.SuspendReleaseBinding409JSONResponse)
+	require.True(t, ok, "expected 409 response, got %T", resp)
+	assert.Contains(t, conflictResp.Error, "incident")
+}
+
+func TestLockReleaseBindingHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	body := &gen.LockReleaseBindingJSONRequestBody{Reason: "release freeze", ExpiresAt: time.Now().Add(time.Hour)}
+
+	t.Run("success", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.LockReleaseBinding(ctx, gen.LockReleaseBindingRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1", Body: body})
+		require.NoError(t, err)
+		rb, ok := resp.(gen.LockReleaseBinding200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, rb.Spec)
+		require.NotNil(t, rb.Spec.Lock)
+		assert.Equal(t, "release freeze", rb.Spec.Lock.Reason)
+		assert.NotNil(t, rb.Spec.Lock.LockedAt)
+	})
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.LockReleaseBinding(ctx, gen.LockReleaseBindingRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.LockReleaseBinding400JSONResponse{}, resp)
+	})
+
+	t.Run("expiry in the past returns 400", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.LockReleaseBinding(ctx, gen.LockReleaseBindingRequestObject{
+			NamespaceName: ns, ReleaseBindingName: "rb-1",
+			Body: &gen.LockReleaseBindingJSONRequestBody{Reason: "freeze", ExpiresAt: time.Now().Add(-time.Hour)},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.LockReleaseBinding400JSONResponse{}, resp)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newReleaseBindingService(t, nil, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.LockReleaseBinding(ctx, gen.LockReleaseBindingRequestObject{NamespaceName: ns, ReleaseBindingName: "nonexistent", Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.LockReleaseBinding404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &denyAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.LockReleaseBinding(ctx, gen.LockReleaseBindingRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1", Body: body})
+		require.NoError(t, err)
+		assert.IsType(t, gen.LockReleaseBinding403JSONResponse{}, resp)
+	})
+}
+
+func TestUnlockReleaseBindingHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{lockedReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.UnlockReleaseBinding(ctx, gen.UnlockReleaseBindingRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		rb, ok := resp.(gen.UnlockReleaseBinding200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, rb.Spec)
+		assert.Nil(t, rb.Spec.Lock)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{lockedReleaseBindingObj("rb-1")}, &denyAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.UnlockReleaseBinding(ctx, gen.UnlockReleaseBindingRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.UnlockReleaseBinding403JSONResponse{}, resp)
+	})
+}
+
+func TestReleaseBindingMaintenanceHandlers(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("enable", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.EnableReleaseBindingMaintenance(ctx, gen.EnableReleaseBindingMaintenanceRequestObject{
+			NamespaceName: ns, ReleaseBindingName: "rb-1",
+			Body: &gen.EnableReleaseBindingMaintenanceJSONRequestBody{Body: ptr.To("Back soon"), Reason: ptr.To("upgrade")},
+		})
+		require.NoError(t, err)
+		rb, ok := resp.(gen.EnableReleaseBindingMaintenance200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, rb.Spec)
+		require.NotNil(t, rb.Spec.Maintenance)
+		assert.Equal(t, ptr.To("Back soon"), rb.Spec.Maintenance.Body)
+		assert.NotNil(t, rb.Spec.Maintenance.EnabledAt)
+	})
+
+	t.Run("invalid redirect returns 400", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.EnableReleaseBindingMaintenance(ctx, gen.EnableReleaseBindingMaintenanceRequestObject{
+			NamespaceName: ns, ReleaseBindingName: "rb-1",
+			Body: &gen.EnableReleaseBindingMaintenanceJSONRequestBody{RedirectURL: ptr.To("status.example.com")},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.EnableReleaseBindingMaintenance400JSONResponse{}, resp)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		obj := testReleaseBindingObj("rb-1")
+		obj.Spec.Maintenance = &openchoreov1alpha1.MaintenanceMode{Reason: "upgrade"}
+		svc := newReleaseBindingService(t, []client.Object{obj}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.DisableReleaseBindingMaintenance(ctx, gen.DisableReleaseBindingMaintenanceRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		rb, ok := resp.(gen.DisableReleaseBindingMaintenance200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, rb.Spec)
+		assert.Nil(t, rb.Spec.Maintenance)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &denyAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.DisableReleaseBindingMaintenance(ctx, gen.DisableReleaseBindingMaintenanceRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DisableReleaseBindingMaintenance403JSONResponse{}, resp)
+	})
+}
+
+func TestGetReleaseBindingRolloutProgressHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success without a rendered release", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.GetReleaseBindingRolloutProgress(ctx, gen.GetReleaseBindingRolloutProgressRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		progress, ok := resp.(gen.GetReleaseBindingRolloutProgress200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "rb-1", progress.ReleaseBinding)
+		assert.Empty(t, progress.Workloads)
+		assert.False(t, progress.Complete)
+		assert.Nil(t, progress.EtaSeconds)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newReleaseBindingService(t, nil, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.GetReleaseBindingRolloutProgress(ctx, gen.GetReleaseBindingRolloutProgressRequestObject{NamespaceName: ns, ReleaseBindingName: "nonexistent"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetReleaseBindingRolloutProgress404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &denyAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.GetReleaseBindingRolloutProgress(ctx, gen.GetReleaseBindingRolloutProgressRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetReleaseBindingRolloutProgress403JSONResponse{}, resp)
+	})
+}
+
+func TestGetReleaseBindingStatusHistoryHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		rb := testReleaseBindingObj("rb-1")
+		rb.Status.ConditionHistory = []openchoreov1alpha1.ConditionTransition{{
+			Type: "Ready", Status: metav1.ConditionFalse, Reason: "CrashLoopBackOff", TransitionTime: metav1.Now(),
+		}}
+		svc := newReleaseBindingService(t, []client.Object{rb}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.GetReleaseBindingStatusHistory(ctx, gen.GetReleaseBindingStatusHistoryRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		history, ok := resp.(gen.GetReleaseBindingStatusHistory200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "rb-1", history.ReleaseBinding)
+		require.Len(t, history.Transitions, 1)
+		assert.Equal(t, gen.ConditionHistoryEntrySourceKindReleaseBinding, history.Transitions[0].SourceKind)
+		assert.Equal(t, "CrashLoopBackOff", *history.Transitions[0].Reason)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newReleaseBindingService(t, nil, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.GetReleaseBindingStatusHistory(ctx, gen.GetReleaseBindingStatusHistoryRequestObject{NamespaceName: ns, ReleaseBindingName: "nonexistent"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetReleaseBindingStatusHistory404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &denyAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.GetReleaseBindingStatusHistory(ctx, gen.GetReleaseBindingStatusHistoryRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetReleaseBindingStatusHistory403JSONResponse{}, resp)
+	})
+}
+
+func TestGetReleaseBindingConfigDiffHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		rb := testReleaseBindingObj("rb-1")
+		rb.Spec.ReleaseName = "rel-1"
+		release := &openchoreov1alpha1.ComponentRelease{ObjectMeta: metav1.ObjectMeta{Name: "rel-1", Namespace: ns}}
+		release.Spec.Workload.Container.Env = []openchoreov1alpha1.EnvVar{{Key: "API_KEY", Value: "k"}}
+		svc := newReleaseBindingService(t, []client.Object{rb, release}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.GetReleaseBindingConfigDiff(ctx, gen.GetReleaseBindingConfigDiffRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		diff, ok := resp.(gen.GetReleaseBindingConfigDiff200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "rel-1", *diff.ToRelease)
+		assert.Nil(t, diff.FromRelease)
+		require.Len(t, diff.Changes, 1)
+		assert.Equal(t, gen.ConfigChangeTypeAdded, diff.Changes[0].Type)
+		assert.True(t, *diff.Changes[0].Masked)
+	})
+
+	t.Run("missing release returns 404", func(t *testing.T) {
+		rb := testReleaseBindingObj("rb-1")
+		rb.Spec.ReleaseName = "rel-1"
+		svc := newReleaseBindingService(t, []client.Object{rb}, &allowAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.GetReleaseBindingConfigDiff(ctx, gen.GetReleaseBindingConfigDiffRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		notFound, ok := resp.(gen.GetReleaseBindingConfigDiff404JSONResponse)
+		require.True(t, ok, "expected 404 response, got %T", resp)
+		assert.Equal(t, "ComponentRelease not found", notFound.Error)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newReleaseBindingService(t, []client.Object{testReleaseBindingObj("rb-1")}, &denyAllPDP{})
+		h := newHandlerWithReleaseBindingService(svc)
+
+		resp, err := h.GetReleaseBindingConfigDiff(ctx, gen.GetReleaseBindingConfigDiffRequestObject{NamespaceName: ns, ReleaseBindingName: "rb-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetReleaseBindingConfigDiff403JSONResponse{}, resp)
+	})
+}
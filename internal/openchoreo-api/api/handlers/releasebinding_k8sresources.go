@@ -6,6 +6,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"time"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
@@ -192,6 +193,136 @@ func (h *Handler) handleTriggerCronJobError(err error) (gen.TriggerReleaseBindin
 	return gen.TriggerReleaseBindingCronJob500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
 }
 
+// RunReleaseBindingJob runs a one-off Job with the image and environment of the release
+// binding's deployed workload, for tasks such as database migrations.
+func (h *Handler) RunReleaseBindingJob(
+	ctx context.Context,
+	request gen.RunReleaseBindingJobRequestObject,
+) (gen.RunReleaseBindingJobResponseObject, error) {
+	h.logger.Debug("RunReleaseBindingJob called",
+		"namespace", request.NamespaceName,
+		"releaseBinding", request.ReleaseBindingName)
+
+	req := &models.RunJobRequest{}
+	if request.Body != nil {
+		if request.Body.Container != nil {
+			req.Container = *request.Body.Container
+		}
+		if request.Body.Command != nil {
+			req.Command = *request.Body.Command
+		}
+		if request.Body.Args != nil {
+			req.Args = *request.Body.Args
+		}
+		if request.Body.TtlSecondsAfterFinished != nil {
+			req.TTLAfterFinished = time.Duration(*request.Body.TtlSecondsAfterFinished) * time.Second
+		}
+	}
+
+	resp, err := h.services.K8sResourcesService.RunJob(ctx, request.NamespaceName, request.ReleaseBindingName, req)
+	if err != nil {
+		return h.handleRunJobError(err)
+	}
+
+	result, err := convert[models.RunJobResponse, gen.RunJobResponse](*resp)
+	if err != nil {
+		h.logger.Error("Failed to convert run job response", "error", err)
+		return gen.RunReleaseBindingJob500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.RunReleaseBindingJob200JSONResponse(result), nil
+}
+
+func (h *Handler) handleRunJobError(err error) (gen.RunReleaseBindingJobResponseObject, error) {
+	if errors.Is(err, services.ErrForbidden) {
+		return gen.RunReleaseBindingJob403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+	}
+	if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+		return gen.RunReleaseBindingJob400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+	}
+	if errors.Is(err, k8sresourcessvc.ErrInvalidContainer) {
+		return gen.RunReleaseBindingJob400JSONResponse{
+			BadRequestJSONResponse: badRequest("container not found in the workload"),
+		}, nil
+	}
+	if errors.Is(err, k8sresourcessvc.ErrTriggerConflict) {
+		return gen.RunReleaseBindingJob400JSONResponse{
+			BadRequestJSONResponse: badRequest("a job with the same name already exists, retry the run"),
+		}, nil
+	}
+	if errors.Is(err, k8sresourcessvc.ErrReleaseBindingNotFound) {
+		return gen.RunReleaseBindingJob404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+	}
+	if errors.Is(err, k8sresourcessvc.ErrRenderedReleaseNotFound) {
+		return gen.RunReleaseBindingJob404JSONResponse{NotFoundJSONResponse: notFound("RenderedRelease")}, nil
+	}
+	if errors.Is(err, k8sresourcessvc.ErrWorkloadNotFound) {
+		return gen.RunReleaseBindingJob404JSONResponse{NotFoundJSONResponse: notFound("Workload")}, nil
+	}
+	if errors.Is(err, k8sresourcessvc.ErrEnvironmentNotFound) {
+		return gen.RunReleaseBindingJob404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+	}
+	h.logger.Error("Failed to run job", "error", err)
+	return gen.RunReleaseBindingJob500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+}
+
+// MintReleaseBindingDebugCredential mints a short-lived credential scoped to the data plane
+// resources of a release binding.
+func (h *Handler) MintReleaseBindingDebugCredential(
+	ctx context.Context,
+	request gen.MintReleaseBindingDebugCredentialRequestObject,
+) (gen.MintReleaseBindingDebugCredentialResponseObject, error) {
+	h.logger.Debug("MintReleaseBindingDebugCredential called",
+		"namespace", request.NamespaceName,
+		"releaseBinding", request.ReleaseBindingName)
+
+	req := &models.DebugCredentialRequest{}
+	if request.Body != nil {
+		if request.Body.Access != nil {
+			req.Access = string(*request.Body.Access)
+		}
+		if request.Body.TtlSeconds != nil {
+			req.TTL = time.Duration(*request.Body.TtlSeconds) * time.Second
+		}
+		if request.Body.ApiServerUrl != nil {
+			req.APIServerURL = *request.Body.ApiServerUrl
+		}
+	}
+
+	resp, err := h.services.K8sResourcesService.MintDebugCredential(ctx, request.NamespaceName, request.ReleaseBindingName, req)
+	if err != nil {
+		return h.handleMintDebugCredentialError(err)
+	}
+
+	result, err := convert[models.DebugCredentialResponse, gen.DebugCredential](*resp)
+	if err != nil {
+		h.logger.Error("Failed to convert debug credential response", "error", err)
+		return gen.MintReleaseBindingDebugCredential500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.MintReleaseBindingDebugCredential200JSONResponse(result), nil
+}
+
+func (h *Handler) handleMintDebugCredentialError(err error) (gen.MintReleaseBindingDebugCredentialResponseObject, error) {
+	if errors.Is(err, services.ErrForbidden) {
+		return gen.MintReleaseBindingDebugCredential403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+	}
+	if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+		return gen.MintReleaseBindingDebugCredential400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+	}
+	if errors.Is(err, k8sresourcessvc.ErrReleaseBindingNotFound) {
+		return gen.MintReleaseBindingDebugCredential404JSONResponse{NotFoundJSONResponse: notFound("ReleaseBinding")}, nil
+	}
+	if errors.Is(err, k8sresourcessvc.ErrRenderedReleaseNotFound) {
+		return gen.MintReleaseBindingDebugCredential404JSONResponse{NotFoundJSONResponse: notFound("RenderedRelease")}, nil
+	}
+	if errors.Is(err, k8sresourcessvc.ErrEnvironmentNotFound) {
+		return gen.MintReleaseBindingDebugCredential404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+	}
+	h.logger.Error("Failed to mint debug credential", "error", err)
+	return gen.MintReleaseBindingDebugCredential500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+}
+
 func (h *Handler) handleK8sResourceTreeError(err error) (gen.GetReleaseBindingK8sResourceTreeResponseObject, error) {
 	if errors.Is(err, services.ErrForbidden) {
 		return gen.GetReleaseBindingK8sResourceTree403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -3,7 +3,10 @@
 
 package handlers
 
-import "github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
 
 // Error response helpers - create the error response types
 
@@ -42,6 +45,13 @@ func unprocessableContent(message string) gen.UnprocessableContentJSONResponse {
 	}
 }
 
+func payloadTooLarge(message string) gen.PayloadTooLargeJSONResponse {
+	return gen.PayloadTooLargeJSONResponse{
+		Code:  gen.PAYLOADTOOLARGE,
+		Error: message,
+	}
+}
+
 func internalError() gen.InternalErrorJSONResponse {
 	return gen.InternalErrorJSONResponse{
 		Code:  gen.INTERNALERROR,
@@ -56,3 +66,16 @@ func notImplemented(message string) gen.NotImplementedJSONResponse {
 		Error: message,
 	}
 }
+
+func projectQuotaExceeded(qErr *controller.ProjectQuotaExceededError) gen.ProjectQuotaExceededJSONResponse {
+	remaining := qErr.Remaining()
+	return gen.ProjectQuotaExceededJSONResponse{
+		Code:      string(gen.QUOTAEXCEEDED),
+		Error:     qErr.Error(),
+		Reason:    gen.ProjectQuotaExceededErrorReason(qErr.Resource),
+		Project:   qErr.Project,
+		Limit:     qErr.Limit.String(),
+		Usage:     qErr.Usage.String(),
+		Remaining: remaining.String(),
+	}
+}
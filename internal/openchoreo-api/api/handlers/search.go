@@ -0,0 +1,99 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	searchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/search"
+)
+
+// Search returns ranked search results with facet counts.
+func (h *Handler) Search(
+	ctx context.Context,
+	request gen.SearchRequestObject,
+) (gen.SearchResponseObject, error) {
+	params := request.Params
+	h.logger.Debug("Search called", "query", getStringValue(params.Q), "namespace", getStringValue(params.Namespace))
+
+	req := models.SearchRequest{
+		Query:       getStringValue(params.Q),
+		Namespace:   getStringValue(params.Namespace),
+		Project:     getStringValue(params.Project),
+		Type:        getStringValue(params.Type),
+		Environment: getStringValue(params.Env),
+		Team:        getStringValue(params.Team),
+		Domain:      getStringValue(params.Domain),
+		Tier:        getStringValue(params.Tier),
+		Limit:       NormalizeListOptions(params.Limit, nil, nil).Limit,
+	}
+	if params.Kind != nil {
+		req.Kind = string(*params.Kind)
+	}
+	if params.Facets != nil {
+		for _, f := range *params.Facets {
+			req.Facets = append(req.Facets, string(f))
+		}
+	}
+
+	resp, err := h.services.SearchService.Search(ctx, req)
+	if err != nil {
+		if errors.Is(err, searchsvc.ErrUnsupportedKind) || errors.Is(err, searchsvc.ErrUnsupportedFacet) {
+			return gen.Search400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		h.logger.Error("Failed to search", "error", err)
+		return gen.Search500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.SearchHit, 0, len(resp.Items))
+	for _, hit := range resp.Items {
+		items = append(items, gen.SearchHit{
+			Kind:          hit.Kind,
+			Namespace:     hit.Namespace,
+			Project:       hit.Project,
+			Name:          hit.Name,
+			DisplayName:   optionalString(hit.DisplayName),
+			Description:   optionalString(hit.Description),
+			Type:          optionalString(hit.Type),
+			Team:          optionalString(hit.Team),
+			Domain:        optionalString(hit.Domain),
+			Tier:          optionalString(hit.Tier),
+			Archived:      optionalTrue(hit.Archived),
+			Environments:  optionalStrings(hit.Environments),
+			Hosts:         optionalStrings(hit.Hosts),
+			Score:         hit.Score,
+			MatchedFields: optionalStrings(hit.MatchedFields),
+		})
+	}
+	out := gen.Search200JSONResponse{Items: items, Total: resp.Total}
+	if resp.Facets != nil {
+		facets := make(map[string][]gen.FacetCount, len(resp.Facets))
+		for name, counts := range resp.Facets {
+			values := make([]gen.FacetCount, 0, len(counts))
+			for _, c := range counts {
+				values = append(values, gen.FacetCount{Value: c.Value, Count: c.Count})
+			}
+			facets[name] = values
+		}
+		out.Facets = &facets
+	}
+	return out, nil
+}
+
+func optionalTrue(b bool) *bool {
+	if !b {
+		return nil
+	}
+	return &b
+}
+
+func optionalStrings(s []string) *[]string {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s
+}
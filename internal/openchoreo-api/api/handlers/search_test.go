@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	searchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/search"
+	searchmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/search/mocks"
+)
+
+func newSearchHandler(svc searchsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{SearchService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestSearchHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success", func(t *testing.T) {
+		q, env, team := "checkout", "dev", "payments"
+		facets := []gen.SearchParamsFacets{gen.SearchParamsFacetsProject, gen.SearchParamsFacetsTier}
+		svc := searchmocks.NewMockService(t)
+		svc.EXPECT().Search(mock.Anything, models.SearchRequest{
+			Query:       q,
+			Environment: env,
+			Team:        team,
+			Facets:      []string{"project", "tier"},
+			Limit:       defaultPageLimit,
+		}).Return(&models.SearchResponse{
+			Items: []models.SearchHit{{
+				Kind: "component", Namespace: "acme", Project: "shop", Name: "checkout",
+				Team: "payments", Environments: []string{"dev"}, Score: 10, MatchedFields: []string{"name"},
+			}},
+			Total:  1,
+			Facets: map[string][]models.FacetCount{"project": {{Value: "shop", Count: 1}}},
+		}, nil)
+
+		resp, err := newSearchHandler(svc).Search(ctx, gen.SearchRequestObject{
+			Params: gen.SearchParams{Q: &q, Env: &env, Team: &team, Facets: &facets},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.Search200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Items, 1)
+		assert.Equal(t, "checkout", typed.Items[0].Name)
+		assert.Nil(t, typed.Items[0].DisplayName)
+		assert.Nil(t, typed.Items[0].Hosts)
+		assert.Equal(t, &team, typed.Items[0].Team)
+		assert.Equal(t, &[]string{"dev"}, typed.Items[0].Environments)
+		require.NotNil(t, typed.Facets)
+		assert.Equal(t, []gen.FacetCount{{Value: "shop", Count: 1}}, (*typed.Facets)["project"])
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"unsupported kind -> 400", searchsvc.ErrUnsupportedKind, gen.Search400JSONResponse{}},
+		{"unsupported facet -> 400", searchsvc.ErrUnsupportedFacet, gen.Search400JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.Search500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := searchmocks.NewMockService(t)
+			svc.EXPECT().Search(mock.Anything, mock.Anything).Return(nil, tt.svcErr)
+			resp, err := newSearchHandler(svc).Search(ctx, gen.SearchRequestObject{})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
@@ -187,3 +187,43 @@ func (h *Handler) DeleteSecretReference(
 	h.logger.Info("Secret reference deleted successfully", "namespaceName", request.NamespaceName, "secretReference", request.SecretReferenceName)
 	return gen.DeleteSecretReference204Response{}, nil
 }
+
+// ListSecretExpiryFindings returns the certificates found by the secret expiry scanners of a
+// namespace that are about to expire or have expired.
+func (h *Handler) ListSecretExpiryFindings(
+	ctx context.Context,
+	request gen.ListSecretExpiryFindingsRequestObject,
+) (gen.ListSecretExpiryFindingsResponseObject, error) {
+	h.logger.Debug("ListSecretExpiryFindings called", "namespaceName", request.NamespaceName)
+
+	findings, err := h.services.SecretReferenceService.ListSecretExpiryFindings(ctx, request.NamespaceName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListSecretExpiryFindings403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to list secret expiry findings", "error", err)
+		return gen.ListSecretExpiryFindings500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.SecretExpiryFinding, 0, len(findings))
+	for _, f := range findings {
+		item := gen.SecretExpiryFinding{
+			Scanner: f.Scanner,
+			Plane: gen.TargetPlaneRef{
+				Kind: gen.TargetPlaneRefKind(f.Plane.Kind),
+				Name: f.Plane.Name,
+			},
+			Namespace:       f.Namespace,
+			SecretName:      f.SecretName,
+			SecretReference: optionalString(f.SecretReference),
+			Subject:         optionalString(f.Subject),
+			NotAfter:        f.NotAfter.Time,
+			Severity:        gen.SecretExpiryFindingSeverity(f.Severity),
+		}
+		if f.ScannedAt != nil {
+			item.ScannedAt = &f.ScannedAt.Time
+		}
+		items = append(items, item)
+	}
+	return gen.ListSecretExpiryFindings200JSONResponse{Items: items}, nil
+}
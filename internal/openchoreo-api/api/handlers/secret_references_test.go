@@ -315,3 +315,50 @@ func TestDeleteSecretReferenceHandler(t *testing.T) {
 		assert.IsType(t, gen.DeleteSecretReference403JSONResponse{}, resp)
 	})
 }
+
+// --- ListSecretExpiryFindings Handler ---
+
+func TestListSecretExpiryFindingsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	scanner := &openchoreov1alpha1.SecretExpiryScanner{
+		ObjectMeta: metav1.ObjectMeta{Name: "certs", Namespace: ns},
+		Status: openchoreov1alpha1.SecretExpiryScannerStatus{
+			Findings: []openchoreov1alpha1.SecretExpiryFinding{{
+				Plane:           openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "default"},
+				Namespace:       "dp-ns",
+				SecretName:      "gateway-tls",
+				SecretReference: "gateway-tls",
+				Subject:         "api.example.com",
+				NotAfter:        metav1.Now(),
+				Severity:        openchoreov1alpha1.SecretExpirySeverityCritical,
+			}},
+		},
+	}
+
+	t.Run("success - returns findings", func(t *testing.T) {
+		svc := newSecretReferenceService(t, []client.Object{scanner}, &allowAllPDP{})
+		h := newHandlerWithSecretReferenceService(svc)
+
+		resp, err := h.ListSecretExpiryFindings(ctx, gen.ListSecretExpiryFindingsRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListSecretExpiryFindings200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Items, 1)
+		assert.Equal(t, "certs", typed.Items[0].Scanner)
+		assert.Equal(t, gen.SecretExpiryFindingSeverityCritical, typed.Items[0].Severity)
+		assert.Equal(t, ptr.To("gateway-tls"), typed.Items[0].SecretReference)
+		assert.Nil(t, typed.Items[0].ScannedAt)
+	})
+
+	t.Run("findings the caller cannot view are omitted", func(t *testing.T) {
+		svc := newSecretReferenceService(t, []client.Object{scanner}, &denyAllPDP{})
+		h := newHandlerWithSecretReferenceService(svc)
+
+		resp, err := h.ListSecretExpiryFindings(ctx, gen.ListSecretExpiryFindingsRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListSecretExpiryFindings200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Empty(t, typed.Items)
+	})
+}
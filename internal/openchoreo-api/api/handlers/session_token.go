@@ -0,0 +1,48 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	sessiontokensvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sessiontoken"
+)
+
+// ExchangeSessionToken exchanges the IdP access token of the request for a session token.
+func (h *Handler) ExchangeSessionToken(
+	ctx context.Context,
+	_ gen.ExchangeSessionTokenRequestObject,
+) (gen.ExchangeSessionTokenResponseObject, error) {
+	if h.Config == nil || !h.Config.Security.SessionTokens.Enabled || h.services.SessionTokenService == nil {
+		return gen.ExchangeSessionToken501JSONResponse{NotImplementedJSONResponse: notImplemented("Session tokens are disabled on this server")}, nil
+	}
+	h.logger.Debug("ExchangeSessionToken called")
+
+	token, err := h.services.SessionTokenService.Exchange(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, sessiontokensvc.ErrNotExchangeable):
+			return gen.ExchangeSessionToken400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, sessiontokensvc.ErrUnauthenticated):
+			return gen.ExchangeSessionToken401JSONResponse{
+				UnauthorizedJSONResponse: gen.UnauthorizedJSONResponse{
+					Code:  gen.UNAUTHORIZED,
+					Error: "Authentication required",
+				},
+			}, nil
+		}
+		h.logger.Error("Failed to exchange session token", "error", err)
+		return gen.ExchangeSessionToken500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ExchangeSessionToken200JSONResponse{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		ExpiresAt:   token.ExpiresAt,
+		ExpiresIn:   token.ExpiresIn,
+		Namespaces:  token.Namespaces,
+	}, nil
+}
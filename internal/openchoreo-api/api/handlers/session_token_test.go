@@ -0,0 +1,95 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	sessiontokensvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sessiontoken"
+	sessiontokenmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sessiontoken/mocks"
+)
+
+func newSessionTokenHandler(t *testing.T, enabled bool) (*Handler, *sessiontokenmocks.MockService) {
+	t.Helper()
+	svc := sessiontokenmocks.NewMockService(t)
+	cfg := &config.Config{}
+	cfg.Security.SessionTokens.Enabled = enabled
+	h := &Handler{
+		services: &handlerservices.Services{SessionTokenService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Config:   cfg,
+	}
+	return h, svc
+}
+
+func TestExchangeSessionTokenHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("disabled returns 501", func(t *testing.T) {
+		h, _ := newSessionTokenHandler(t, false)
+
+		resp, err := h.ExchangeSessionToken(ctx, gen.ExchangeSessionTokenRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExchangeSessionToken501JSONResponse{}, resp)
+	})
+
+	t.Run("success returns 200 with the token", func(t *testing.T) {
+		h, svc := newSessionTokenHandler(t, true)
+		expiresAt := time.Now().Add(5 * time.Minute)
+		svc.EXPECT().Exchange(mock.Anything).Return(&models.SessionToken{
+			AccessToken: "token",
+			TokenType:   "Bearer",
+			ExpiresAt:   expiresAt,
+			ExpiresIn:   300,
+			Namespaces:  []string{"acme"},
+		}, nil)
+
+		resp, err := h.ExchangeSessionToken(ctx, gen.ExchangeSessionTokenRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ExchangeSessionToken200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "token", typed.AccessToken)
+		assert.Equal(t, int64(300), typed.ExpiresIn)
+		assert.Equal(t, []string{"acme"}, typed.Namespaces)
+	})
+
+	t.Run("session token returns 400", func(t *testing.T) {
+		h, svc := newSessionTokenHandler(t, true)
+		svc.EXPECT().Exchange(mock.Anything).Return(nil, sessiontokensvc.ErrNotExchangeable)
+
+		resp, err := h.ExchangeSessionToken(ctx, gen.ExchangeSessionTokenRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExchangeSessionToken400JSONResponse{}, resp)
+	})
+
+	t.Run("unauthenticated returns 401", func(t *testing.T) {
+		h, svc := newSessionTokenHandler(t, true)
+		svc.EXPECT().Exchange(mock.Anything).Return(nil, sessiontokensvc.ErrUnauthenticated)
+
+		resp, err := h.ExchangeSessionToken(ctx, gen.ExchangeSessionTokenRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExchangeSessionToken401JSONResponse{}, resp)
+	})
+
+	t.Run("other errors return 500", func(t *testing.T) {
+		h, svc := newSessionTokenHandler(t, true)
+		svc.EXPECT().Exchange(mock.Anything).Return(nil, errors.New("pdp unavailable"))
+
+		resp, err := h.ExchangeSessionToken(ctx, gen.ExchangeSessionTokenRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExchangeSessionToken500JSONResponse{}, resp)
+	})
+}
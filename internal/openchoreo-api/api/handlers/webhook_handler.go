@@ -13,6 +13,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	autobuildsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/autobuild"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/bodylimit"
 )
 
 // webhookRawBodyKey is the context key for raw webhook request body bytes.
@@ -35,7 +36,7 @@ func WebhookRawBodyMiddleware(next http.Handler) http.Handler {
 			if err != nil {
 				var maxBytesErr *http.MaxBytesError
 				if errors.As(err, &maxBytesErr) {
-					http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+					bodylimit.WriteError(w, bodylimit.Limit{MaxBytes: maxWebhookPayloadSize})
 				} else {
 					http.Error(w, "failed to read request body", http.StatusBadRequest)
 				}
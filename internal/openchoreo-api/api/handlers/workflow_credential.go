@@ -0,0 +1,67 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	workflowcredentialsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowcredential"
+)
+
+// ExchangeWorkflowCredential exchanges the broker token of a workflow run for a short-lived
+// credential declared by its workflow.
+func (h *Handler) ExchangeWorkflowCredential(
+	ctx context.Context,
+	request gen.ExchangeWorkflowCredentialRequestObject,
+) (gen.ExchangeWorkflowCredentialResponseObject, error) {
+	if h.Config == nil || !h.Config.WorkflowCredentials.Enabled || h.services.WorkflowCredentialService == nil {
+		return gen.ExchangeWorkflowCredential501JSONResponse{
+			NotImplementedJSONResponse: notImplemented("The workflow credential broker is disabled on this server"),
+		}, nil
+	}
+	if request.Body == nil || request.Body.Name == "" || request.Body.Token == "" {
+		return gen.ExchangeWorkflowCredential400JSONResponse{
+			BadRequestJSONResponse: badRequest("Credential name and token are required"),
+		}, nil
+	}
+	h.logger.Debug("ExchangeWorkflowCredential called",
+		"namespaceName", request.NamespaceName, "runName", request.RunName, "credential", request.Body.Name)
+
+	credential, err := h.services.WorkflowCredentialService.Exchange(ctx,
+		request.NamespaceName, request.RunName, request.Body.Name, request.Body.Token)
+	if err != nil {
+		switch {
+		case errors.Is(err, workflowcredentialsvc.ErrInvalidToken):
+			return gen.ExchangeWorkflowCredential401JSONResponse{
+				UnauthorizedJSONResponse: gen.UnauthorizedJSONResponse{
+					Code:  gen.UNAUTHORIZED,
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, workflowcredentialsvc.ErrCredentialNotFound):
+			return gen.ExchangeWorkflowCredential404JSONResponse{NotFoundJSONResponse: notFound("Credential")}, nil
+		case errors.Is(err, workflowcredentialsvc.ErrCredentialTypeNotConfigured):
+			return gen.ExchangeWorkflowCredential501JSONResponse{NotImplementedJSONResponse: notImplemented(err.Error())}, nil
+		}
+		h.logger.Error("Failed to exchange workflow credential", "error", err)
+		return gen.ExchangeWorkflowCredential500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	resp := gen.ExchangeWorkflowCredential200JSONResponse{
+		Name:       credential.Name,
+		Type:       gen.WorkflowCredentialResponseType(credential.Type),
+		Repository: credential.Repository,
+		Token:      credential.Token,
+		ExpiresAt:  credential.ExpiresAt,
+	}
+	if credential.Username != "" {
+		resp.Username = &credential.Username
+	}
+	if credential.DockerConfigJSON != "" {
+		resp.DockerConfigJson = &credential.DockerConfigJSON
+	}
+	return resp, nil
+}
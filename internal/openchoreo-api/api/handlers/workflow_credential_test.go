@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	workflowcredentialsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowcredential"
+	workflowcredentialmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowcredential/mocks"
+)
+
+func newWorkflowCredentialHandler(t *testing.T, enabled bool) (*Handler, *workflowcredentialmocks.MockService) {
+	t.Helper()
+	svc := workflowcredentialmocks.NewMockService(t)
+	cfg := &config.Config{}
+	cfg.WorkflowCredentials.Enabled = enabled
+	h := &Handler{
+		services: &handlerservices.Services{WorkflowCredentialService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Config:   cfg,
+	}
+	return h, svc
+}
+
+func newExchangeWorkflowCredentialRequest() gen.ExchangeWorkflowCredentialRequestObject {
+	return gen.ExchangeWorkflowCredentialRequestObject{
+		NamespaceName: "default",
+		RunName:       "build-1",
+		Body:          &gen.ExchangeWorkflowCredentialJSONRequestBody{Name: "source", Token: "broker-token"},
+	}
+}
+
+func TestExchangeWorkflowCredentialHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("disabled returns 501", func(t *testing.T) {
+		h, _ := newWorkflowCredentialHandler(t, false)
+
+		resp, err := h.ExchangeWorkflowCredential(ctx, newExchangeWorkflowCredentialRequest())
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExchangeWorkflowCredential501JSONResponse{}, resp)
+	})
+
+	t.Run("missing token returns 400", func(t *testing.T) {
+		h, _ := newWorkflowCredentialHandler(t, true)
+		req := newExchangeWorkflowCredentialRequest()
+		req.Body.Token = ""
+
+		resp, err := h.ExchangeWorkflowCredential(ctx, req)
+		require.NoError(t, err)
+		assert.IsType(t, gen.ExchangeWorkflowCredential400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns 200 with the credential", func(t *testing.T) {
+		h, svc := newWorkflowCredentialHandler(t, true)
+		expiresAt := time.Now().Add(time.Hour)
+		svc.EXPECT().Exchange(mock.Anything, "default", "build-1", "source", "broker-token").Return(&models.WorkflowCredential{
+			Name:       "source",
+			Type:       "GitRead",
+			Repository: "https://github.com/acme/api",
+			Username:   "x-access-token",
+			Token:      "ghs_token",
+			ExpiresAt:  expiresAt,
+		}, nil)
+
+		resp, err := h.ExchangeWorkflowCredential(ctx, newExchangeWorkflowCredentialRequest())
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ExchangeWorkflowCredential200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, gen.WorkflowCredentialResponseTypeGitRead, typed.Type)
+		assert.Equal(t, "ghs_token", typed.Token)
+		require.NotNil(t, typed.Username)
+		assert.Equal(t, "x-access-token", *typed.Username)
+		assert.Nil(t, typed.DockerConfigJson)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"invalid token returns 401", workflowcredentialsvc.ErrInvalidToken, gen.ExchangeWorkflowCredential401JSONResponse{}},
+		{"unknown credential returns 404", workflowcredentialsvc.ErrCredentialNotFound, gen.ExchangeWorkflowCredential404JSONResponse{}},
+		{"unconfigured type returns 501", workflowcredentialsvc.ErrCredentialTypeNotConfigured, gen.ExchangeWorkflowCredential501JSONResponse{}},
+		{"other errors return 500", errors.New("github unavailable"), gen.ExchangeWorkflowCredential500JSONResponse{}},
+	}
+	for _, tt := range errorCases {
+		t.Run(tt.name, func(t *testing.T) {
+			h, svc := newWorkflowCredentialHandler(t, true)
+			svc.EXPECT().Exchange(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			resp, err := h.ExchangeWorkflowCredential(ctx, newExchangeWorkflowCredentialRequest())
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, resp)
+		})
+	}
+}
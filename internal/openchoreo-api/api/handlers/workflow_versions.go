@@ -0,0 +1,210 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	clusterworkflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterworkflow"
+	workflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow"
+)
+
+// ListWorkflowVersions returns the published versions of a workflow.
+func (h *Handler) ListWorkflowVersions(
+	ctx context.Context,
+	request gen.ListWorkflowVersionsRequestObject,
+) (gen.ListWorkflowVersionsResponseObject, error) {
+	h.logger.Debug("ListWorkflowVersions called", "namespaceName", request.NamespaceName, "workflowName", request.WorkflowName)
+
+	versions, err := h.services.WorkflowService.ListWorkflowVersions(ctx, request.NamespaceName, request.WorkflowName)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.ListWorkflowVersions403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to list workflow versions", "error", err)
+		return gen.ListWorkflowVersions500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[openchoreov1alpha1.WorkflowVersion, gen.WorkflowVersion](versions)
+	if err != nil {
+		h.logger.Error("Failed to convert workflow versions", "error", err)
+		return gen.ListWorkflowVersions500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ListWorkflowVersions200JSONResponse{Items: items}, nil
+}
+
+// PublishWorkflowVersion snapshots the current template of a workflow as a named version.
+func (h *Handler) PublishWorkflowVersion(
+	ctx context.Context,
+	request gen.PublishWorkflowVersionRequestObject,
+) (gen.PublishWorkflowVersionResponseObject, error) {
+	h.logger.Info("PublishWorkflowVersion called", "namespaceName", request.NamespaceName, "workflowName", request.WorkflowName)
+
+	if request.Body == nil {
+		return gen.PublishWorkflowVersion400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	published, err := h.services.WorkflowService.PublishWorkflowVersion(ctx, request.NamespaceName, request.WorkflowName, request.Body.Version)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.PublishWorkflowVersion403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, workflowsvc.ErrWorkflowNotFound) {
+			return gen.PublishWorkflowVersion404JSONResponse{NotFoundJSONResponse: notFound("Workflow")}, nil
+		}
+		if errors.Is(err, workflowsvc.ErrWorkflowVersionAlreadyExists) {
+			return gen.PublishWorkflowVersion409JSONResponse{ConflictJSONResponse: conflict("Workflow version already exists")}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.PublishWorkflowVersion400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to publish workflow version", "error", err)
+		return gen.PublishWorkflowVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genVersion, err := convert[openchoreov1alpha1.WorkflowVersion, gen.WorkflowVersion](*published)
+	if err != nil {
+		h.logger.Error("Failed to convert published workflow version", "error", err)
+		return gen.PublishWorkflowVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Workflow version published successfully", "namespaceName", request.NamespaceName, "workflowVersion", published.Name)
+	return gen.PublishWorkflowVersion201JSONResponse(genVersion), nil
+}
+
+// DeprecateWorkflowVersion marks a published workflow version as deprecated.
+func (h *Handler) DeprecateWorkflowVersion(
+	ctx context.Context,
+	request gen.DeprecateWorkflowVersionRequestObject,
+) (gen.DeprecateWorkflowVersionResponseObject, error) {
+	h.logger.Info("DeprecateWorkflowVersion called", "namespaceName", request.NamespaceName,
+		"workflowName", request.WorkflowName, "version", request.Version)
+
+	message := ""
+	if request.Body != nil && request.Body.Message != nil {
+		message = *request.Body.Message
+	}
+
+	deprecated, err := h.services.WorkflowService.DeprecateWorkflowVersion(ctx, request.NamespaceName, request.WorkflowName, request.Version, message)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.DeprecateWorkflowVersion403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, workflowsvc.ErrWorkflowVersionNotFound) {
+			return gen.DeprecateWorkflowVersion404JSONResponse{NotFoundJSONResponse: notFound("WorkflowVersion")}, nil
+		}
+		h.logger.Error("Failed to deprecate workflow version", "error", err)
+		return gen.DeprecateWorkflowVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genVersion, err := convert[openchoreov1alpha1.WorkflowVersion, gen.WorkflowVersion](*deprecated)
+	if err != nil {
+		h.logger.Error("Failed to convert deprecated workflow version", "error", err)
+		return gen.DeprecateWorkflowVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.DeprecateWorkflowVersion200JSONResponse(genVersion), nil
+}
+
+// ListClusterWorkflowVersions returns the published versions of a cluster workflow.
+func (h *Handler) ListClusterWorkflowVersions(
+	ctx context.Context,
+	request gen.ListClusterWorkflowVersionsRequestObject,
+) (gen.ListClusterWorkflowVersionsResponseObject, error) {
+	h.logger.Debug("ListClusterWorkflowVersions called", "clusterWorkflowName", request.ClusterWorkflowName)
+
+	versions, err := h.services.ClusterWorkflowService.ListClusterWorkflowVersions(ctx, request.ClusterWorkflowName)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.ListClusterWorkflowVersions403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to list cluster workflow versions", "error", err)
+		return gen.ListClusterWorkflowVersions500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[openchoreov1alpha1.ClusterWorkflowVersion, gen.WorkflowVersion](versions)
+	if err != nil {
+		h.logger.Error("Failed to convert cluster workflow versions", "error", err)
+		return gen.ListClusterWorkflowVersions500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ListClusterWorkflowVersions200JSONResponse{Items: items}, nil
+}
+
+// PublishClusterWorkflowVersion snapshots the current template of a cluster workflow as a named version.
+func (h *Handler) PublishClusterWorkflowVersion(
+	ctx context.Context,
+	request gen.PublishClusterWorkflowVersionRequestObject,
+) (gen.PublishClusterWorkflowVersionResponseObject, error) {
+	h.logger.Info("PublishClusterWorkflowVersion called", "clusterWorkflowName", request.ClusterWorkflowName)
+
+	if request.Body == nil {
+		return gen.PublishClusterWorkflowVersion400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	published, err := h.services.ClusterWorkflowService.PublishClusterWorkflowVersion(ctx, request.ClusterWorkflowName, request.Body.Version)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.PublishClusterWorkflowVersion403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, clusterworkflowsvc.ErrClusterWorkflowNotFound) {
+			return gen.PublishClusterWorkflowVersion404JSONResponse{NotFoundJSONResponse: notFound("ClusterWorkflow")}, nil
+		}
+		if errors.Is(err, clusterworkflowsvc.ErrClusterWorkflowVersionAlreadyExists) {
+			return gen.PublishClusterWorkflowVersion409JSONResponse{ConflictJSONResponse: conflict("ClusterWorkflow version already exists")}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.PublishClusterWorkflowVersion400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to publish cluster workflow version", "error", err)
+		return gen.PublishClusterWorkflowVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genVersion, err := convert[openchoreov1alpha1.ClusterWorkflowVersion, gen.WorkflowVersion](*published)
+	if err != nil {
+		h.logger.Error("Failed to convert published cluster workflow version", "error", err)
+		return gen.PublishClusterWorkflowVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("ClusterWorkflow version published successfully", "clusterWorkflowVersion", published.Name)
+	return gen.PublishClusterWorkflowVersion201JSONResponse(genVersion), nil
+}
+
+// DeprecateClusterWorkflowVersion marks a published cluster workflow version as deprecated.
+func (h *Handler) DeprecateClusterWorkflowVersion(
+	ctx context.Context,
+	request gen.DeprecateClusterWorkflowVersionRequestObject,
+) (gen.DeprecateClusterWorkflowVersionResponseObject, error) {
+	h.logger.Info("DeprecateClusterWorkflowVersion called", "clusterWorkflowName", request.ClusterWorkflowName, "version", request.Version)
+
+	message := ""
+	if request.Body != nil && request.Body.Message != nil {
+		message = *request.Body.Message
+	}
+
+	deprecated, err := h.services.ClusterWorkflowService.DeprecateClusterWorkflowVersion(ctx, request.ClusterWorkflowName, request.Version, message)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.DeprecateClusterWorkflowVersion403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, clusterworkflowsvc.ErrClusterWorkflowVersionNotFound) {
+			return gen.DeprecateClusterWorkflowVersion404JSONResponse{NotFoundJSONResponse: notFound("ClusterWorkflowVersion")}, nil
+		}
+		h.logger.Error("Failed to deprecate cluster workflow version", "error", err)
+		return gen.DeprecateClusterWorkflowVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genVersion, err := convert[openchoreov1alpha1.ClusterWorkflowVersion, gen.WorkflowVersion](*deprecated)
+	if err != nil {
+		h.logger.Error("Failed to convert deprecated cluster workflow version", "error", err)
+		return gen.DeprecateClusterWorkflowVersion500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.DeprecateClusterWorkflowVersion200JSONResponse(genVersion), nil
+}
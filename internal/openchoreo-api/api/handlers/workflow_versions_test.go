@@ -0,0 +1,214 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	clusterworkflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterworkflow"
+	clusterworkflowmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterworkflow/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	workflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow"
+	workflowmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow/mocks"
+)
+
+func testWorkflowVersion(deprecated bool) *openchoreov1alpha1.WorkflowVersion {
+	return &openchoreov1alpha1.WorkflowVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "build.v1", Namespace: "test-ns"},
+		Spec: openchoreov1alpha1.WorkflowVersionSpec{
+			WorkflowName: "build",
+			Version:      "v1",
+			Deprecated:   deprecated,
+		},
+	}
+}
+
+func TestListWorkflowVersionsHandler(t *testing.T) {
+	ctx := testContext()
+	svc := workflowmocks.NewMockService(t)
+	svc.EXPECT().ListWorkflowVersions(mock.Anything, "test-ns", "build").
+		Return([]openchoreov1alpha1.WorkflowVersion{*testWorkflowVersion(false)}, nil)
+	h := &Handler{
+		services: &handlerservices.Services{WorkflowService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	resp, err := h.ListWorkflowVersions(ctx, gen.ListWorkflowVersionsRequestObject{NamespaceName: "test-ns", WorkflowName: "build"})
+	require.NoError(t, err)
+	typed, ok := resp.(gen.ListWorkflowVersions200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	require.Len(t, typed.Items, 1)
+	require.NotNil(t, typed.Items[0].Spec)
+	assert.Equal(t, "v1", typed.Items[0].Spec.Version)
+	assert.Equal(t, "build", typed.Items[0].Spec.WorkflowName)
+}
+
+func TestPublishWorkflowVersionHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success", func(t *testing.T) {
+		svc := workflowmocks.NewMockService(t)
+		svc.EXPECT().PublishWorkflowVersion(mock.Anything, "test-ns", "build", "v1").Return(testWorkflowVersion(false), nil)
+		h := &Handler{
+			services: &handlerservices.Services{WorkflowService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.PublishWorkflowVersion(ctx, gen.PublishWorkflowVersionRequestObject{
+			NamespaceName: "test-ns",
+			WorkflowName:  "build",
+			Body:          &gen.PublishWorkflowVersionJSONRequestBody{Version: "v1"},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.PublishWorkflowVersion201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "build.v1", typed.Metadata.Name)
+	})
+
+	t.Run("nil body -> 400", func(t *testing.T) {
+		h := &Handler{
+			services: &handlerservices.Services{WorkflowService: workflowmocks.NewMockService(t)},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.PublishWorkflowVersion(ctx, gen.PublishWorkflowVersionRequestObject{NamespaceName: "test-ns", WorkflowName: "build"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PublishWorkflowVersion400JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"invalid version -> 400", &svcpkg.ValidationError{Msg: "invalid version"}, gen.PublishWorkflowVersion400JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.PublishWorkflowVersion403JSONResponse{}},
+		{"workflow not found -> 404", workflowsvc.ErrWorkflowNotFound, gen.PublishWorkflowVersion404JSONResponse{}},
+		{"already exists -> 409", workflowsvc.ErrWorkflowVersionAlreadyExists, gen.PublishWorkflowVersion409JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.PublishWorkflowVersion500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowmocks.NewMockService(t)
+			svc.EXPECT().PublishWorkflowVersion(mock.Anything, "test-ns", "build", "v1").Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{WorkflowService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.PublishWorkflowVersion(ctx, gen.PublishWorkflowVersionRequestObject{
+				NamespaceName: "test-ns",
+				WorkflowName:  "build",
+				Body:          &gen.PublishWorkflowVersionJSONRequestBody{Version: "v1"},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestDeprecateWorkflowVersionHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success passes the message through", func(t *testing.T) {
+		svc := workflowmocks.NewMockService(t)
+		svc.EXPECT().DeprecateWorkflowVersion(mock.Anything, "test-ns", "build", "v1", "use v2").Return(testWorkflowVersion(true), nil)
+		h := &Handler{
+			services: &handlerservices.Services{WorkflowService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.DeprecateWorkflowVersion(ctx, gen.DeprecateWorkflowVersionRequestObject{
+			NamespaceName: "test-ns",
+			WorkflowName:  "build",
+			Version:       "v1",
+			Body:          &gen.DeprecateWorkflowVersionJSONRequestBody{Message: ptr.To("use v2")},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.DeprecateWorkflowVersion200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, typed.Spec)
+		assert.Equal(t, ptr.To(true), typed.Spec.Deprecated)
+	})
+
+	t.Run("not found -> 404", func(t *testing.T) {
+		svc := workflowmocks.NewMockService(t)
+		svc.EXPECT().DeprecateWorkflowVersion(mock.Anything, "test-ns", "build", "v9", "").Return(nil, workflowsvc.ErrWorkflowVersionNotFound)
+		h := &Handler{
+			services: &handlerservices.Services{WorkflowService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.DeprecateWorkflowVersion(ctx, gen.DeprecateWorkflowVersionRequestObject{
+			NamespaceName: "test-ns",
+			WorkflowName:  "build",
+			Version:       "v9",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeprecateWorkflowVersion404JSONResponse{}, resp)
+	})
+}
+
+func TestClusterWorkflowVersionHandlers(t *testing.T) {
+	ctx := testContext()
+	version := &openchoreov1alpha1.ClusterWorkflowVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "docker.v1"},
+		Spec:       openchoreov1alpha1.WorkflowVersionSpec{WorkflowName: "docker", Version: "v1"},
+	}
+
+	t.Run("publish", func(t *testing.T) {
+		svc := clusterworkflowmocks.NewMockService(t)
+		svc.EXPECT().PublishClusterWorkflowVersion(mock.Anything, "docker", "v1").Return(version, nil)
+		h := &Handler{
+			services: &handlerservices.Services{ClusterWorkflowService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.PublishClusterWorkflowVersion(ctx, gen.PublishClusterWorkflowVersionRequestObject{
+			ClusterWorkflowName: "docker",
+			Body:                &gen.PublishClusterWorkflowVersionJSONRequestBody{Version: "v1"},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.PublishClusterWorkflowVersion201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "docker.v1", typed.Metadata.Name)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		svc := clusterworkflowmocks.NewMockService(t)
+		svc.EXPECT().ListClusterWorkflowVersions(mock.Anything, "docker").
+			Return([]openchoreov1alpha1.ClusterWorkflowVersion{*version}, nil)
+		h := &Handler{
+			services: &handlerservices.Services{ClusterWorkflowService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.ListClusterWorkflowVersions(ctx, gen.ListClusterWorkflowVersionsRequestObject{ClusterWorkflowName: "docker"})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListClusterWorkflowVersions200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Len(t, typed.Items, 1)
+	})
+
+	t.Run("deprecate not found -> 404", func(t *testing.T) {
+		svc := clusterworkflowmocks.NewMockService(t)
+		svc.EXPECT().DeprecateClusterWorkflowVersion(mock.Anything, "docker", "v2", "").
+			Return(nil, clusterworkflowsvc.ErrClusterWorkflowVersionNotFound)
+		h := &Handler{
+			services: &handlerservices.Services{ClusterWorkflowService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.DeprecateClusterWorkflowVersion(ctx, gen.DeprecateClusterWorkflowVersionRequestObject{
+			ClusterWorkflowName: "docker",
+			Version:             "v2",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeprecateClusterWorkflowVersion404JSONResponse{}, resp)
+	})
+}
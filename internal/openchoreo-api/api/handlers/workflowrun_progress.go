@@ -0,0 +1,227 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
+)
+
+const (
+	// workflowRunProgressPollInterval is how often a followed run is re-read for status transitions.
+	workflowRunProgressPollInterval = 2 * time.Second
+	// workflowRunProgressHeartbeatInterval is how often an SSE comment is sent while nothing changes,
+	// so that idle proxies keep the stream open.
+	workflowRunProgressHeartbeatInterval = 15 * time.Second
+	// workflowRunProgressRetry is the reconnection delay suggested to SSE clients, in milliseconds.
+	workflowRunProgressRetry = 3000
+)
+
+// GetWorkflowRunProgress returns the step timeline of a workflow run, or streams its status
+// transitions as Server-Sent Events when follow=true.
+func (h *Handler) GetWorkflowRunProgress(
+	ctx context.Context,
+	request gen.GetWorkflowRunProgressRequestObject,
+) (gen.GetWorkflowRunProgressResponseObject, error) {
+	h.logger.Info("GetWorkflowRunProgress called",
+		"namespace", request.NamespaceName,
+		"runName", request.RunName)
+
+	progress, err := h.services.WorkflowRunService.GetWorkflowRunProgress(ctx, request.NamespaceName, request.RunName)
+	if err != nil {
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound) {
+			return gen.GetWorkflowRunProgress404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		}
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.GetWorkflowRunProgress403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to get workflow run progress", "error", err)
+		return gen.GetWorkflowRunProgress500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	if request.Params.Follow == nil || !*request.Params.Follow {
+		return gen.GetWorkflowRunProgress200JSONResponse(toGenWorkflowRunProgress(progress)), nil
+	}
+
+	namespaceName, runName := request.NamespaceName, request.RunName
+	lastEventID := ""
+	if request.Params.LastEventID != nil {
+		lastEventID = *request.Params.LastEventID
+	}
+	return &workflowRunProgressStream{
+		ctx:         ctx,
+		initial:     progress,
+		lastEventID: lastEventID,
+		fetch: func(ctx context.Context) (*models.WorkflowRunProgressResponse, error) {
+			return h.services.WorkflowRunService.GetWorkflowRunProgress(ctx, namespaceName, runName)
+		},
+		pollInterval:      workflowRunProgressPollInterval,
+		heartbeatInterval: workflowRunProgressHeartbeatInterval,
+		logger:            h.logger.With("namespace", namespaceName, "runName", runName),
+	}, nil
+}
+
+// workflowRunProgressStream is a Server-Sent Events response that emits a "progress" event
+// whenever the run or one of its steps changes status, and ends once the run has finished.
+//
+// The stream holds no state beyond the connection: event ids are derived from the timeline
+// itself, so a client reconnecting with Last-Event-ID can be served by any API replica.
+type workflowRunProgressStream struct {
+	ctx               context.Context
+	initial           *models.WorkflowRunProgressResponse
+	lastEventID       string
+	fetch             func(ctx context.Context) (*models.WorkflowRunProgressResponse, error)
+	pollInterval      time.Duration
+	heartbeatInterval time.Duration
+	logger            *slog.Logger
+}
+
+// VisitGetWorkflowRunProgressResponse writes the stream. Errors after the headers have been
+// sent are reported to the client as an "error" event.
+func (s *workflowRunProgressStream) VisitGetWorkflowRunProgressResponse(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+	// The server's WriteTimeout is an absolute deadline that would cut long builds short;
+	// clear it for this connection only.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		s.logger.Warn("Failed to disable write deadline for progress stream", "error", err)
+	}
+
+	hdr := w.Header()
+	hdr.Set("Content-Type", "text/event-stream")
+	hdr.Set("Cache-Control", "no-cache, no-transform")
+	hdr.Set("Connection", "keep-alive")
+	hdr.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", workflowRunProgressRetry); err != nil {
+		return err
+	}
+
+	poll := time.NewTicker(s.pollInterval)
+	defer poll.Stop()
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	progress := s.initial
+	lastID := s.lastEventID
+	for {
+		if id := workflowRunProgressEventID(progress); id != lastID {
+			data, err := json.Marshal(toGenWorkflowRunProgress(progress))
+			if err != nil {
+				return fmt.Errorf("failed to marshal workflow run progress: %w", err)
+			}
+			if err := writeSSE(w, rc, "progress", id, data); err != nil {
+				return err
+			}
+			lastID = id
+			heartbeat.Reset(s.heartbeatInterval)
+		}
+		if isWorkflowRunFinished(progress.Status) {
+			return nil
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+			if err := rc.Flush(); err != nil {
+				return err
+			}
+		case <-poll.C:
+			next, err := s.fetch(s.ctx)
+			if err != nil {
+				if s.ctx.Err() != nil {
+					return nil
+				}
+				s.logger.Error("Failed to refresh workflow run progress", "error", err)
+				msg, _ := json.Marshal(map[string]string{"message": "failed to refresh workflow run progress"})
+				return writeSSE(w, rc, "error", "", msg)
+			}
+			progress = next
+		}
+	}
+}
+
+// writeSSE writes a single Server-Sent Event and flushes it to the client. The id line is
+// omitted when id is empty, which leaves the client's last event id unchanged.
+func writeSSE(w http.ResponseWriter, rc *http.ResponseController, event, id string, data []byte) error {
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
+
+// workflowRunProgressEventID returns the SSE event id for a timeline. Equal timelines get
+// equal ids, so a reconnecting client is not sent a timeline it has already seen.
+func workflowRunProgressEventID(p *models.WorkflowRunProgressResponse) string {
+	sum := sha256.Sum256([]byte(workflowRunProgressKey(p)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// workflowRunProgressKey identifies the status transitions of a run. Durations of running steps
+// grow on every read and are deliberately left out so that they do not produce events.
+func workflowRunProgressKey(p *models.WorkflowRunProgressResponse) string {
+	var b strings.Builder
+	b.WriteString(p.Status)
+	for _, step := range p.Steps {
+		fmt.Fprintf(&b, "|%s=%s", step.Name, step.Phase)
+		if step.StartedAt != nil {
+			fmt.Fprintf(&b, ",%d", step.StartedAt.Unix())
+		}
+		if step.FinishedAt != nil {
+			fmt.Fprintf(&b, ",%d", step.FinishedAt.Unix())
+		}
+	}
+	return b.String()
+}
+
+func isWorkflowRunFinished(status string) bool {
+	return status == string(gen.WorkflowRunProgressResponseStatusSucceeded) ||
+		status == string(gen.WorkflowRunProgressResponseStatusFailed) ||
+		status == string(gen.WorkflowRunProgressResponseStatusCancelled) ||
+		status == string(gen.WorkflowRunProgressResponseStatusError)
+}
+
+func toGenWorkflowRunProgress(p *models.WorkflowRunProgressResponse) gen.WorkflowRunProgressResponse {
+	steps := make([]gen.WorkflowStepProgress, 0, len(p.Steps))
+	for _, s := range p.Steps {
+		steps = append(steps, gen.WorkflowStepProgress{
+			Name:            s.Name,
+			Stage:           gen.WorkflowStepProgressStage(s.Stage),
+			Phase:           gen.WorkflowStepProgressPhase(normalizeStepPhase(s.Phase)),
+			StartedAt:       s.StartedAt,
+			FinishedAt:      s.FinishedAt,
+			DurationSeconds: s.DurationSeconds,
+		})
+	}
+	return gen.WorkflowRunProgressResponse{
+		Status:          gen.WorkflowRunProgressResponseStatus(p.Status),
+		Steps:           steps,
+		CompletedSteps:  p.CompletedSteps,
+		TotalSteps:      p.TotalSteps,
+		StartedAt:       p.StartedAt,
+		FinishedAt:      p.FinishedAt,
+		DurationSeconds: p.DurationSeconds,
+	}
+}
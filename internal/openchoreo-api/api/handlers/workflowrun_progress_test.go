@@ -0,0 +1,172 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
+	workflowrunmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun/mocks"
+)
+
+func progressWithPhases(status string, phases ...string) *models.WorkflowRunProgressResponse {
+	names := []string{"checkout-source", "build-image", "publish-image"}
+	stages := []string{"clone", "build", "push"}
+	p := &models.WorkflowRunProgressResponse{Status: status, TotalSteps: len(phases)}
+	for i, phase := range phases {
+		p.Steps = append(p.Steps, models.WorkflowStepProgress{Name: names[i], Stage: stages[i], Phase: phase})
+	}
+	return p
+}
+
+func TestGetWorkflowRunProgressHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		duration := 42.0
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().GetWorkflowRunProgress(mock.Anything, ns, "run-1").Return(&models.WorkflowRunProgressResponse{
+			Status:         "Running",
+			CompletedSteps: 1,
+			TotalSteps:     2,
+			Steps: []models.WorkflowStepProgress{
+				{Name: "checkout-source", Stage: "clone", Phase: "Succeeded", DurationSeconds: &duration},
+				{Name: "build-image", Stage: "build", Phase: "Omitted"},
+			},
+		}, nil)
+		h := &Handler{
+			services: &handlerservices.Services{WorkflowRunService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.GetWorkflowRunProgress(ctx, gen.GetWorkflowRunProgressRequestObject{NamespaceName: ns, RunName: "run-1"})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetWorkflowRunProgress200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, 1, typed.CompletedSteps)
+		require.Len(t, typed.Steps, 2)
+		assert.Equal(t, gen.Clone, typed.Steps[0].Stage)
+		assert.Equal(t, &duration, typed.Steps[0].DurationSeconds)
+		assert.Equal(t, gen.WorkflowStepProgressPhaseSkipped, typed.Steps[1].Phase, "Omitted should map to Skipped")
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"not found -> 404", workflowrunsvc.ErrWorkflowRunNotFound, gen.GetWorkflowRunProgress404JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetWorkflowRunProgress403JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.GetWorkflowRunProgress500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().GetWorkflowRunProgress(mock.Anything, ns, "run-1").Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{WorkflowRunService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.GetWorkflowRunProgress(ctx, gen.GetWorkflowRunProgressRequestObject{NamespaceName: ns, RunName: "run-1"})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestWorkflowRunProgressStream_EmitsTransitionsUntilFinished(t *testing.T) {
+	// Each poll returns the next snapshot. The repeated snapshot must not produce an event.
+	snapshots := []*models.WorkflowRunProgressResponse{
+		progressWithPhases("Running", "Succeeded", "Running"),
+		progressWithPhases("Running", "Succeeded", "Running"),
+		progressWithPhases("Running", "Succeeded", "Succeeded", "Running"),
+		progressWithPhases("Succeeded", "Succeeded", "Succeeded", "Succeeded"),
+	}
+	polls := 0
+	stream := &workflowRunProgressStream{
+		ctx:     context.Background(),
+		initial: progressWithPhases("Running", "Running"),
+		fetch: func(context.Context) (*models.WorkflowRunProgressResponse, error) {
+			next := snapshots[polls]
+			polls++
+			return next, nil
+		},
+		pollInterval:      time.Millisecond,
+		heartbeatInterval: time.Hour,
+		logger:            slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	rec := httptest.NewRecorder()
+	require.NoError(t, stream.VisitGetWorkflowRunProgressResponse(rec))
+
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, len(snapshots), polls)
+	body := rec.Body.String()
+	assert.Equal(t, 4, strings.Count(body, "event: progress\n"))
+	assert.True(t, strings.HasSuffix(body, `"status":"Succeeded","steps":[`+
+		`{"name":"checkout-source","phase":"Succeeded","stage":"clone"},`+
+		`{"name":"build-image","phase":"Succeeded","stage":"build"},`+
+		`{"name":"publish-image","phase":"Succeeded","stage":"push"}],"totalSteps":3}`+"\n\n"), body)
+}
+
+func TestWorkflowRunProgressStream_ReportsRefreshError(t *testing.T) {
+	stream := &workflowRunProgressStream{
+		ctx:     context.Background(),
+		initial: progressWithPhases("Running", "Running"),
+		fetch: func(context.Context) (*models.WorkflowRunProgressResponse, error) {
+			return nil, errors.New("boom")
+		},
+		pollInterval:      time.Millisecond,
+		heartbeatInterval: time.Hour,
+		logger:            slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	rec := httptest.NewRecorder()
+	require.NoError(t, stream.VisitGetWorkflowRunProgressResponse(rec))
+
+	body := rec.Body.String()
+	assert.Equal(t, 1, strings.Count(body, "event: progress\n"))
+	assert.Contains(t, body, "event: error\ndata: {\"message\":\"failed to refresh workflow run progress\"}\n\n")
+}
+
+func TestWorkflowRunProgressStream_ResumesFromLastEventID(t *testing.T) {
+	running := progressWithPhases("Running", "Succeeded", "Running")
+	finished := progressWithPhases("Succeeded", "Succeeded", "Succeeded")
+	stream := &workflowRunProgressStream{
+		ctx:         context.Background(),
+		initial:     running,
+		lastEventID: workflowRunProgressEventID(running),
+		fetch: func(context.Context) (*models.WorkflowRunProgressResponse, error) {
+			return finished, nil
+		},
+		pollInterval:      time.Millisecond,
+		heartbeatInterval: time.Hour,
+		logger:            slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	rec := httptest.NewRecorder()
+	require.NoError(t, stream.VisitGetWorkflowRunProgressResponse(rec))
+
+	// The client already saw the running timeline, so only the transition to finished is sent.
+	body := rec.Body.String()
+	assert.True(t, strings.HasPrefix(body, "retry: 3000\n\n"), body)
+	assert.Equal(t, 1, strings.Count(body, "event: progress\n"))
+	assert.Contains(t, body, "id: "+workflowRunProgressEventID(finished)+"\nevent: progress\n")
+	assert.NotEqual(t, workflowRunProgressEventID(running), workflowRunProgressEventID(finished))
+}
@@ -11,6 +11,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	workflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow"
 	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
@@ -272,6 +273,9 @@ func (h *Handler) CreateWorkflowRun(
 		if errors.Is(err, svcerrors.ErrForbidden) {
 			return gen.CreateWorkflowRun403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
 		}
+		if quotaErr, ok := errors.AsType[*workflowrunsvc.QuotaExceededError](err); ok {
+			return gen.CreateWorkflowRun429JSONResponse{QuotaExceededJSONResponse: quotaExceeded(quotaErr)}, nil
+		}
 		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
 			if validationErr.StatusCode == http.StatusUnprocessableEntity {
 				return gen.CreateWorkflowRun422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
@@ -398,6 +402,47 @@ func (h *Handler) DeleteWorkflowRun(
 	return gen.DeleteWorkflowRun204Response{}, nil
 }
 
+// CancelWorkflowRun requests a workflow run to be cancelled
+func (h *Handler) CancelWorkflowRun(
+	ctx context.Context,
+	request gen.CancelWorkflowRunRequestObject,
+) (gen.CancelWorkflowRunResponseObject, error) {
+	h.logger.Info("CancelWorkflowRun called",
+		"namespace", request.NamespaceName,
+		"runName", request.RunName)
+
+	reason := ""
+	if request.Body != nil && request.Body.Reason != nil {
+		reason = *request.Body.Reason
+	}
+
+	cancelled, err := h.services.WorkflowRunService.CancelWorkflowRun(ctx, request.NamespaceName, request.RunName, reason)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.CancelWorkflowRun403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound) {
+			return gen.CancelWorkflowRun404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunAlreadyCompleted) {
+			return gen.CancelWorkflowRun409JSONResponse{ConflictJSONResponse: conflict("Workflow run has already completed")}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.CancelWorkflowRun400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to cancel workflow run", "error", err)
+		return gen.CancelWorkflowRun500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genWfRun, err := convert[openchoreov1alpha1.WorkflowRun, gen.WorkflowRun](*cancelled)
+	if err != nil {
+		h.logger.Error("Failed to convert cancelled workflow run", "error", err)
+		return gen.CancelWorkflowRun500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.CancelWorkflowRun200JSONResponse(genWfRun), nil
+}
+
 // GetWorkflowRunStatus returns the status and per-step details of a specific workflow run
 func (h *Handler) GetWorkflowRunStatus(
 	ctx context.Context,
@@ -437,6 +482,74 @@ func (h *Handler) GetWorkflowRunStatus(
 	}, nil
 }
 
+// GetWorkflowRunTestResults returns the test results of a specific workflow run
+func (h *Handler) GetWorkflowRunTestResults(
+	ctx context.Context,
+	request gen.GetWorkflowRunTestResultsRequestObject,
+) (gen.GetWorkflowRunTestResultsResponseObject, error) {
+	h.logger.Info("GetWorkflowRunTestResults called",
+		"namespace", request.NamespaceName,
+		"runName", request.RunName)
+
+	results, err := h.services.WorkflowRunService.GetWorkflowRunTestResults(ctx, request.NamespaceName, request.RunName)
+	if err != nil {
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound) {
+			return gen.GetWorkflowRunTestResults404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		}
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.GetWorkflowRunTestResults403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to get workflow run test results", "error", err)
+		return gen.GetWorkflowRunTestResults500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genResults, err := convert[models.WorkflowRunTestResultsResponse, gen.WorkflowRunTestResultsResponse](*results)
+	if err != nil {
+		h.logger.Error("Failed to convert workflow run test results", "error", err)
+		return gen.GetWorkflowRunTestResults500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetWorkflowRunTestResults200JSONResponse(genResults), nil
+}
+
+// GetWorkflowRunFindings returns the static analysis findings of a specific workflow run
+func (h *Handler) GetWorkflowRunFindings(
+	ctx context.Context,
+	request gen.GetWorkflowRunFindingsRequestObject,
+) (gen.GetWorkflowRunFindingsResponseObject, error) {
+	h.logger.Info("GetWorkflowRunFindings called",
+		"namespace", request.NamespaceName,
+		"runName", request.RunName)
+
+	var minSeverity openchoreov1alpha1.AnalysisSeverity
+	if request.Params.Severity != nil {
+		minSeverity = openchoreov1alpha1.AnalysisSeverity(*request.Params.Severity)
+	}
+
+	findings, err := h.services.WorkflowRunService.GetWorkflowRunFindings(ctx, request.NamespaceName, request.RunName, minSeverity)
+	if err != nil {
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.GetWorkflowRunFindings400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound) {
+			return gen.GetWorkflowRunFindings404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		}
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.GetWorkflowRunFindings403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to get workflow run findings", "error", err)
+		return gen.GetWorkflowRunFindings500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genFindings, err := convert[models.WorkflowRunFindingsResponse, gen.WorkflowRunFindingsResponse](*findings)
+	if err != nil {
+		h.logger.Error("Failed to convert workflow run findings", "error", err)
+		return gen.GetWorkflowRunFindings500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetWorkflowRunFindings200JSONResponse(genFindings), nil
+}
+
 // GetWorkflowRunLogs returns logs for a specific workflow run
 func (h *Handler) GetWorkflowRunLogs(
 	ctx context.Context,
@@ -531,6 +644,51 @@ func (h *Handler) GetWorkflowRunEvents(
 	return result, nil
 }
 
+// GetBuildQuota returns the concurrent build usage of a namespace and the build queue state
+// of the workflow planes available to it
+func (h *Handler) GetBuildQuota(
+	ctx context.Context,
+	request gen.GetBuildQuotaRequestObject,
+) (gen.GetBuildQuotaResponseObject, error) {
+	h.logger.Info("GetBuildQuota called", "namespaceName", request.NamespaceName)
+
+	quota, err := h.services.WorkflowRunService.GetBuildQuota(ctx, request.NamespaceName)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.GetBuildQuota403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to get build quota", "error", err)
+		return gen.GetBuildQuota500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genQuota, err := convert[models.BuildQuotaResponse, gen.BuildQuota](*quota)
+	if err != nil {
+		h.logger.Error("Failed to convert build quota", "error", err)
+		return gen.GetBuildQuota500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetBuildQuota200JSONResponse(genQuota), nil
+}
+
+// quotaExceeded builds the 429 response of a build rejected by the build quota.
+func quotaExceeded(qErr *workflowrunsvc.QuotaExceededError) gen.QuotaExceededJSONResponse {
+	retryAfter := int(qErr.RetryAfter.Seconds())
+	body := gen.QuotaExceededError{
+		Code:              string(gen.QUOTAEXCEEDED),
+		Error:             qErr.Error(),
+		Reason:            gen.QuotaExceededErrorReason(qErr.Reason),
+		Limit:             qErr.Limit,
+		Usage:             qErr.Usage,
+		RetryAfterSeconds: retryAfter,
+	}
+	if qErr.WorkflowPlane != "" {
+		body.WorkflowPlane = &qErr.WorkflowPlane
+	}
+	return gen.QuotaExceededJSONResponse{
+		Body:    body,
+		Headers: gen.QuotaExceededResponseHeaders{RetryAfter: retryAfter},
+	}
+}
+
 // normalizeStepPhase maps a raw phase string from the WorkflowTask CRD to a
 // valid OpenAPI enum value. Argo's "Omitted" phase is mapped to "Skipped";
 // any other unrecognized value falls back to "Error".
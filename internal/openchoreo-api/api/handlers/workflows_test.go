@@ -9,6 +9,7 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -99,6 +100,58 @@ func TestDeleteWorkflowRunHandler(t *testing.T) {
 	})
 }
 
+// --- CancelWorkflowRun Handler ---
+
+func TestCancelWorkflowRunHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success records the reason", func(t *testing.T) {
+		svc := newWorkflowRunService(t, []client.Object{testWorkflowRunObj()}, &allowAllPDP{})
+		h := newHandlerWithWorkflowRunService(svc)
+
+		resp, err := h.CancelWorkflowRun(ctx, gen.CancelWorkflowRunRequestObject{
+			NamespaceName: ns,
+			RunName:       "run-1",
+			Body:          &gen.CancelWorkflowRunJSONRequestBody{Reason: ptr.To("wrong branch")},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.CancelWorkflowRun200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, typed.Spec.Cancellation)
+		assert.Equal(t, ptr.To("wrong branch"), typed.Spec.Cancellation.Reason)
+	})
+
+	t.Run("completed run returns 409", func(t *testing.T) {
+		run := testWorkflowRunObj()
+		run.Status.Conditions = []metav1.Condition{{Type: "WorkflowFailed", Status: metav1.ConditionTrue}}
+		svc := newWorkflowRunService(t, []client.Object{run}, &allowAllPDP{})
+		h := newHandlerWithWorkflowRunService(svc)
+
+		resp, err := h.CancelWorkflowRun(ctx, gen.CancelWorkflowRunRequestObject{NamespaceName: ns, RunName: "run-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CancelWorkflowRun409JSONResponse{}, resp)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newWorkflowRunService(t, nil, &allowAllPDP{})
+		h := newHandlerWithWorkflowRunService(svc)
+
+		resp, err := h.CancelWorkflowRun(ctx, gen.CancelWorkflowRunRequestObject{NamespaceName: ns, RunName: "nonexistent"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CancelWorkflowRun404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newWorkflowRunService(t, []client.Object{testWorkflowRunObj()}, &denyAllPDP{})
+		h := newHandlerWithWorkflowRunService(svc)
+
+		resp, err := h.CancelWorkflowRun(ctx, gen.CancelWorkflowRunRequestObject{NamespaceName: ns, RunName: "run-1"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CancelWorkflowRun403JSONResponse{}, resp)
+	})
+}
+
 func TestUpdateWorkflowHandler_UsesPathName(t *testing.T) {
 	ctx := testContext()
 	svc := workflowmocks.NewMockService(t)
@@ -685,6 +738,91 @@ func TestCreateWorkflowRunHandler(t *testing.T) {
 	}
 }
 
+func TestCreateWorkflowRunHandler_QuotaExceeded(t *testing.T) {
+	svc := workflowrunmocks.NewMockService(t)
+	svc.EXPECT().CreateWorkflowRun(mock.Anything, "test-ns", mock.Anything).Return(nil, &workflowrunsvc.QuotaExceededError{
+		Reason:        workflowrunsvc.QuotaReasonWorkflowPlaneQueueFull,
+		Limit:         20,
+		Usage:         20,
+		WorkflowPlane: "ClusterWorkflowPlane/default",
+		RetryAfter:    90 * time.Second,
+	})
+	h := &Handler{
+		services: &handlerservices.Services{WorkflowRunService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	resp, err := h.CreateWorkflowRun(testContext(), gen.CreateWorkflowRunRequestObject{
+		NamespaceName: "test-ns",
+		Body:          &gen.WorkflowRun{Metadata: gen.ObjectMeta{Name: "run-1"}},
+	})
+	require.NoError(t, err)
+	quotaResp, ok := resp.(gen.CreateWorkflowRun429JSONResponse)
+	require.True(t, ok, "expected 429, got %T", resp)
+	assert.Equal(t, string(gen.QUOTAEXCEEDED), quotaResp.Body.Code)
+	assert.Equal(t, gen.WorkflowPlaneQueueFull, quotaResp.Body.Reason)
+	assert.Equal(t, int32(20), quotaResp.Body.Limit)
+	assert.Equal(t, ptr.To("ClusterWorkflowPlane/default"), quotaResp.Body.WorkflowPlane)
+	assert.Equal(t, 90, quotaResp.Body.RetryAfterSeconds)
+
+	rec := httptest.NewRecorder()
+	require.NoError(t, quotaResp.VisitCreateWorkflowRunResponse(rec))
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "90", rec.Header().Get("Retry-After"))
+}
+
+func TestGetBuildQuotaHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().GetBuildQuota(mock.Anything, ns).Return(&models.BuildQuotaResponse{
+			Namespace:     ns,
+			MaxConcurrent: ptr.To(int32(5)),
+			ActiveBuilds:  3,
+			WorkflowPlanes: []models.WorkflowPlaneQueueUsage{
+				{Kind: "ClusterWorkflowPlane", Name: "default", MaxQueuedRuns: ptr.To(int32(50)), RunningRuns: 10, QueuedRuns: 4},
+			},
+		}, nil)
+		h := &Handler{
+			services: &handlerservices.Services{WorkflowRunService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		resp, err := h.GetBuildQuota(ctx, gen.GetBuildQuotaRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		quota, ok := resp.(gen.GetBuildQuota200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		assert.Equal(t, ptr.To(int32(5)), quota.MaxConcurrentBuilds)
+		assert.Equal(t, int32(3), quota.ActiveBuilds)
+		require.Len(t, quota.WorkflowPlanes, 1)
+		assert.Equal(t, gen.WorkflowPlaneQueueUsageKindClusterWorkflowPlane, quota.WorkflowPlanes[0].Kind)
+		assert.Equal(t, int32(4), quota.WorkflowPlanes[0].QueuedRuns)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetBuildQuota403JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.GetBuildQuota500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().GetBuildQuota(mock.Anything, ns).Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{WorkflowRunService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.GetBuildQuota(ctx, gen.GetBuildQuotaRequestObject{NamespaceName: ns})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
 // --- GetWorkflowRun Handler ---
 
 func TestGetWorkflowRunHandler(t *testing.T) {
@@ -937,3 +1075,122 @@ func TestNormalizeStepPhase(t *testing.T) {
 		})
 	}
 }
+
+// --- GetWorkflowRunTestResults Handler ---
+
+func TestGetWorkflowRunTestResultsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().GetWorkflowRunTestResults(mock.Anything, ns, "run-1").Return(&models.WorkflowRunTestResultsResponse{
+			RunName: "run-1",
+			Results: &openchoreov1alpha1.WorkflowTestResults{
+				Step:        "unit-tests",
+				Tests:       4,
+				Failures:    1,
+				FailedCases: []openchoreov1alpha1.TestCaseFailure{{Suite: "unit", Name: "checkout"}},
+			},
+			FlakyTests:  []models.FlakyTest{{Suite: "unit", Name: "checkout", FailedRuns: 2, TotalRuns: 5, LastFailedRun: "run-1"}},
+			HistoryRuns: 5,
+		}, nil)
+		h := newHandlerWithWorkflowRunService(svc)
+
+		resp, err := h.GetWorkflowRunTestResults(ctx, gen.GetWorkflowRunTestResultsRequestObject{NamespaceName: ns, RunName: "run-1"})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetWorkflowRunTestResults200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "run-1", typed.RunName)
+		assert.Equal(t, 5, typed.HistoryRuns)
+		require.NotNil(t, typed.Results)
+		assert.Equal(t, int32(1), typed.Results.Failures)
+		require.Len(t, typed.FlakyTests, 1)
+		assert.Equal(t, ptr.To("run-1"), typed.FlakyTests[0].LastFailedRun)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"not found -> 404", workflowrunsvc.ErrWorkflowRunNotFound, gen.GetWorkflowRunTestResults404JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetWorkflowRunTestResults403JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.GetWorkflowRunTestResults500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().GetWorkflowRunTestResults(mock.Anything, ns, "run-1").Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{WorkflowRunService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.GetWorkflowRunTestResults(ctx, gen.GetWorkflowRunTestResultsRequestObject{NamespaceName: ns, RunName: "run-1"})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+// --- GetWorkflowRunFindings Handler ---
+
+func TestGetWorkflowRunFindingsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success forwards the severity filter", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().GetWorkflowRunFindings(mock.Anything, ns, "run-1", openchoreov1alpha1.AnalysisSeverityHigh).
+			Return(&models.WorkflowRunFindingsResponse{
+				RunName: "run-1",
+				Results: &openchoreov1alpha1.WorkflowAnalysisResults{
+					Step: "lint",
+					High: 1,
+					Findings: []openchoreov1alpha1.AnalysisFinding{
+						{Tool: "semgrep", RuleID: "sql-injection", Severity: openchoreov1alpha1.AnalysisSeverityHigh, Line: 7},
+					},
+				},
+			}, nil)
+		h := newHandlerWithWorkflowRunService(svc)
+
+		severity := gen.High
+		resp, err := h.GetWorkflowRunFindings(ctx, gen.GetWorkflowRunFindingsRequestObject{
+			NamespaceName: ns,
+			RunName:       "run-1",
+			Params:        gen.GetWorkflowRunFindingsParams{Severity: &severity},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetWorkflowRunFindings200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "run-1", typed.RunName)
+		require.NotNil(t, typed.Results)
+		require.NotNil(t, typed.Results.Findings)
+		require.Len(t, *typed.Results.Findings, 1)
+		assert.Equal(t, gen.High, (*typed.Results.Findings)[0].Severity)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"validation -> 400", &svcpkg.ValidationError{Msg: `unknown severity "Blocker"`}, gen.GetWorkflowRunFindings400JSONResponse{}},
+		{"not found -> 404", workflowrunsvc.ErrWorkflowRunNotFound, gen.GetWorkflowRunFindings404JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetWorkflowRunFindings403JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.GetWorkflowRunFindings500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().GetWorkflowRunFindings(mock.Anything, ns, "run-1", openchoreov1alpha1.AnalysisSeverity("")).Return(nil, tt.svcErr)
+			h := &Handler{
+				services: &handlerservices.Services{WorkflowRunService: svc},
+				logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			resp, err := h.GetWorkflowRunFindings(ctx, gen.GetWorkflowRunFindingsRequestObject{NamespaceName: ns, RunName: "run-1"})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
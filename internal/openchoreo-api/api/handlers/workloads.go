@@ -6,12 +6,17 @@ package handlers
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"slices"
+	"unicode/utf8"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	workloadsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/bodylimit"
 )
 
 // ListWorkloads returns a paginated list of workloads within a namespace.
@@ -195,3 +200,104 @@ func (h *Handler) DeleteWorkload(
 	h.logger.Info("Workload deleted successfully", "namespaceName", request.NamespaceName, "workload", request.WorkloadName)
 	return gen.DeleteWorkload204Response{}, nil
 }
+
+// UploadWorkloadFiles sets the contents of file mounts declared in a workload's container from
+// the parts of a multipart body, each named after the key of a file. Parts are read one at a
+// time as they arrive, so large files are neither buffered as a whole request nor escaped
+// into a JSON spec.
+func (h *Handler) UploadWorkloadFiles(
+	ctx context.Context,
+	request gen.UploadWorkloadFilesRequestObject,
+) (gen.UploadWorkloadFilesResponseObject, error) {
+	h.logger.Info("UploadWorkloadFiles called", "namespaceName", request.NamespaceName, "workloadName", request.WorkloadName)
+
+	if request.Body == nil {
+		return gen.UploadWorkloadFiles400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	w, err := h.services.WorkloadService.GetWorkload(ctx, request.NamespaceName, request.WorkloadName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.UploadWorkloadFiles403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, workloadsvc.ErrWorkloadNotFound) {
+			return gen.UploadWorkloadFiles404JSONResponse{NotFoundJSONResponse: notFound("Workload")}, nil
+		}
+		h.logger.Error("Failed to get workload", "error", err)
+		return gen.UploadWorkloadFiles500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	files := w.Spec.Container.Files
+	uploaded := make(map[string]bool)
+	for {
+		part, err := request.Body.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return uploadWorkloadFilesReadError(ctx, err), nil
+		}
+
+		key := part.FormName()
+		i := slices.IndexFunc(files, func(f openchoreov1alpha1.FileVar) bool { return f.Key == key })
+		if i < 0 {
+			return gen.UploadWorkloadFiles400JSONResponse{BadRequestJSONResponse: badRequest(fmt.Sprintf(
+				"file %q is not declared in the workload; declare it with its mountPath before uploading its contents", key))}, nil
+		}
+		if uploaded[key] {
+			return gen.UploadWorkloadFiles400JSONResponse{BadRequestJSONResponse: badRequest(fmt.Sprintf("file %q is uploaded more than once", key))}, nil
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return uploadWorkloadFilesReadError(ctx, err), nil
+		}
+		if !utf8.Valid(content) {
+			return gen.UploadWorkloadFiles422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(fmt.Sprintf(
+				"file %q is not valid UTF-8 text; mount binary files from a secret with valueFrom.secretKeyRef", key))}, nil
+		}
+		files[i].Value = string(content)
+		files[i].ValueFrom = nil
+		uploaded[key] = true
+	}
+	if len(uploaded) == 0 {
+		return gen.UploadWorkloadFiles400JSONResponse{BadRequestJSONResponse: badRequest("at least one file part is required")}, nil
+	}
+
+	updated, err := h.services.WorkloadService.UpdateWorkload(ctx, request.NamespaceName, w)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.UploadWorkloadFiles403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, workloadsvc.ErrWorkloadNotFound) {
+			return gen.UploadWorkloadFiles404JSONResponse{NotFoundJSONResponse: notFound("Workload")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			if validationErr.StatusCode == http.StatusUnprocessableEntity {
+				return gen.UploadWorkloadFiles422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+			}
+			return gen.UploadWorkloadFiles400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to update workload files", "error", err)
+		return gen.UploadWorkloadFiles500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genWorkload, err := convert[openchoreov1alpha1.Workload, gen.Workload](*updated)
+	if err != nil {
+		h.logger.Error("Failed to convert updated workload", "error", err)
+		return gen.UploadWorkloadFiles500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Workload files uploaded successfully", "namespaceName", request.NamespaceName,
+		"workload", updated.Name, "files", len(uploaded))
+	return gen.UploadWorkloadFiles200JSONResponse(genWorkload), nil
+}
+
+// uploadWorkloadFilesReadError maps an error reading a multipart body to a 413 when the body
+// crossed its size limit, or to a 400 otherwise.
+func uploadWorkloadFilesReadError(ctx context.Context, err error) gen.UploadWorkloadFilesResponseObject {
+	if maxBytesErr, ok := errors.AsType[*http.MaxBytesError](err); ok {
+		return gen.UploadWorkloadFiles413JSONResponse{PayloadTooLargeJSONResponse: payloadTooLarge(bodylimit.Message(bodyLimit(ctx, maxBytesErr)))}
+	}
+	return gen.UploadWorkloadFiles400JSONResponse{BadRequestJSONResponse: badRequest("Invalid multipart body: " + err.Error())}
+}
@@ -4,10 +4,14 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"log/slog"
+	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,6 +27,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
 	workloadsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/bodylimit"
 )
 
 // wlBundle holds the real HTTP handler wired to a fake K8s client so tests can
@@ -311,3 +316,125 @@ func TestWorkloadHTTPDeleteForbidden(t *testing.T) {
 
 	assert.Equal(t, http.StatusForbidden, rec.Code)
 }
+
+// --- Upload files ---
+
+// seedWorkloadWithFiles returns a workload that declares an application config file,
+// sourced from a secret, and a literal banner file.
+func seedWorkloadWithFiles(name string) *openchoreov1alpha1.Workload {
+	wl := seedWorkload(name)
+	wl.Spec.Container.Files = []openchoreov1alpha1.FileVar{
+		{
+			Key:       "app.yaml",
+			MountPath: "/etc/app",
+			ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+				SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: "app-config", Key: "app.yaml"},
+			},
+		},
+		{Key: "banner.txt", MountPath: "/etc/app", Value: "hello"},
+	}
+	return wl
+}
+
+// doMultipartRequest streams a multipart body with a part per file through the handler.
+// The body has no declared length, so body limits are enforced while the parts are read.
+func doMultipartRequest(t *testing.T, h http.Handler, path string, files [][2]string) (*http.Request, *httptest.ResponseRecorder) {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, f := range files {
+		fw, err := mw.CreateFormFile(f[0], f[0])
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(f[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest(http.MethodPut, path, &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.ContentLength = -1
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return req, rec
+}
+
+func TestWorkloadHTTPUploadFiles(t *testing.T) {
+	bundle := newWLBundle(t, []client.Object{seedWorkloadWithFiles("wl-1")}, &allowAllPDP{})
+
+	req, rec := doMultipartRequest(t, bundle.handler, "/api/v1/namespaces/"+testNS+"/workloads/wl-1/files",
+		[][2]string{{"app.yaml", "server:\n  port: 8080\n"}})
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assertConformsToSpec(t, req, rec.Code, rec.Result().Header, rec.Body.Bytes())
+
+	// The uploaded contents replace the secret reference; other files are kept.
+	var k8sWL openchoreov1alpha1.Workload
+	require.NoError(t, bundle.fakeClient.Get(context.Background(),
+		types.NamespacedName{Name: "wl-1", Namespace: testNS}, &k8sWL))
+	assert.Equal(t, []openchoreov1alpha1.FileVar{
+		{Key: "app.yaml", MountPath: "/etc/app", Value: "server:\n  port: 8080\n"},
+		{Key: "banner.txt", MountPath: "/etc/app", Value: "hello"},
+	}, k8sWL.Spec.Container.Files)
+}
+
+func TestWorkloadHTTPUploadFilesRejected(t *testing.T) {
+	tests := []struct {
+		name  string
+		files [][2]string
+		pdp   authzcore.PDP
+		want  int
+	}{
+		{name: "undeclared file", files: [][2]string{{"other.yaml", "x"}}, pdp: &allowAllPDP{}, want: http.StatusBadRequest},
+		{name: "file uploaded twice", files: [][2]string{{"app.yaml", "a"}, {"app.yaml", "b"}}, pdp: &allowAllPDP{}, want: http.StatusBadRequest},
+		{name: "no files", pdp: &allowAllPDP{}, want: http.StatusBadRequest},
+		{name: "binary contents", files: [][2]string{{"app.yaml", "\xff\xfe"}}, pdp: &allowAllPDP{}, want: http.StatusUnprocessableEntity},
+		{name: "forbidden", files: [][2]string{{"app.yaml", "a"}}, pdp: &denyAllPDP{}, want: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundle := newWLBundle(t, []client.Object{seedWorkloadWithFiles("wl-1")}, tt.pdp)
+
+			req, rec := doMultipartRequest(t, bundle.handler, "/api/v1/namespaces/"+testNS+"/workloads/wl-1/files", tt.files)
+
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+			assertConformsToSpec(t, req, rec.Code, rec.Result().Header, rec.Body.Bytes())
+		})
+	}
+}
+
+func TestWorkloadHTTPBodyLimit(t *testing.T) {
+	bundle := newWLBundle(t, []client.Object{seedWorkloadWithFiles("wl-1")}, &allowAllPDP{})
+	handler := bodylimit.Middleware(bodylimit.Config{
+		MaxBytes: 64,
+		Routes: []bodylimit.Route{{
+			Pattern:  "PUT /api/v1/namespaces/{namespaceName}/workloads/{workloadName}/files",
+			MaxBytes: 512,
+			Guidance: "Mount large files from a secret.",
+		}},
+	})(bundle.handler)
+
+	t.Run("streamed JSON body over the limit returns 413", func(t *testing.T) {
+		body, _ := json.Marshal(newWorkloadBody())
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/namespaces/"+testNS+"/workloads/wl-1", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.ContentLength = -1
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		assertConformsToSpec(t, req, rec.Code, rec.Result().Header, rec.Body.Bytes())
+	})
+
+	t.Run("multipart part over the limit returns 413 with guidance", func(t *testing.T) {
+		req, rec := doMultipartRequest(t, handler, "/api/v1/namespaces/"+testNS+"/workloads/wl-1/files",
+			[][2]string{{"app.yaml", strings.Repeat("x", 1024)}})
+		require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		assertConformsToSpec(t, req, rec.Code, rec.Result().Header, rec.Body.Bytes())
+
+		var resp gen.ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, gen.PAYLOADTOOLARGE, resp.Code)
+		assert.Equal(t, "request body exceeds the limit of 512 bytes for "+
+			"PUT /api/v1/namespaces/{namespaceName}/workloads/{workloadName}/files. Mount large files from a secret.", resp.Error)
+	})
+}
@@ -32,6 +32,42 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Action:   "update_component",
 			Category: audit.CategoryResource,
 		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/archive",
+			Action:   "archive_component",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/unarchive",
+			Action:   "unarchive_component",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/transfer",
+			Action:   "transfer_component",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/prune-releases",
+			Action:   "prune_component_releases",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/domain-mappings",
+			Action:   "create_domain_mapping",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/domain-mappings/{domainMappingName}",
+			Action:   "delete_domain_mapping",
+			Category: audit.CategoryResource,
+		},
 
 		// DataPlane operations
 		{
@@ -110,6 +146,12 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Action:   "promote_component",
 			Category: audit.CategoryResource,
 		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/projects/{projectName}/promote",
+			Action:   "promote_project",
+			Category: audit.CategoryResource,
+		},
 
 		// Trait operations
 		{
@@ -127,6 +169,52 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Category: audit.CategoryResource,
 		},
 
+		// Release binding operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1alpha1/namespaces/{namespaceName}/releasebindings/{releaseBindingName}/resource-recommendation",
+			Action:   "apply_resource_recommendation",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1alpha1/namespaces/{namespaceName}/releasebindings/{releaseBindingName}/suspend",
+			Action:   "suspend_release_binding",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "PUT",
+			Pattern:  "/api/v1alpha1/namespaces/{namespaceName}/releasebindings/{releaseBindingName}/maintenance",
+			Action:   "enable_release_binding_maintenance",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1alpha1/namespaces/{namespaceName}/releasebindings/{releaseBindingName}/maintenance",
+			Action:   "disable_release_binding_maintenance",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1alpha1/namespaces/{namespaceName}/releasebindings/{releaseBindingName}/debug-credentials",
+			Action:   "mint_debug_credential",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1alpha1/namespaces/{namespaceName}/releasebindings/{releaseBindingName}/run-job",
+			Action:   "run_release_binding_job",
+			Category: audit.CategoryResource,
+		},
+
+		// Quarantine operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/quarantined-resources/{kind}/{name}/requeue",
+			Action:   "requeue_quarantined_resource",
+			Category: audit.CategoryResource,
+		},
+
 		// Workflow operations
 		{
 			Method:   "PATCH",
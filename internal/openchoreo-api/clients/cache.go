@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewCachedK8sClient creates a client that serves Get and List from the given informer cache
+// and sends writes straight to the API server.
+//
+// Secrets and Pods are always read from the API server: caching them would keep every Secret
+// and Pod of the control plane cluster in memory for the few requests that need them.
+func NewCachedK8sClient(config *rest.Config, scheme *runtime.Scheme, cache client.Reader) (client.Client, error) {
+	return client.New(config, client.Options{
+		Scheme: scheme,
+		Cache: &client.CacheOptions{
+			Reader:     &PagingReader{Reader: cache},
+			DisableFor: []client.Object{&corev1.Secret{}, &corev1.Pod{}},
+		},
+	})
+}
+
+// PagingReader adds limit/continue pagination to a reader that does not support it, such as
+// the controller-runtime informer cache.
+//
+// Items are ordered by namespace and name, and the continue token encodes the key of the last
+// returned item rather than a snapshot held in memory. Any API replica can therefore serve the
+// next page, which keeps cursors valid behind a load balancer. Unlike API server continue tokens,
+// pages reflect the cache at the time each page is read, so items created or deleted between
+// pages may be included or skipped.
+type PagingReader struct {
+	client.Reader
+}
+
+// List lists objects, applying Limit and Continue over the full result of the underlying reader.
+func (r *PagingReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+	if listOpts.Limit <= 0 && listOpts.Continue == "" {
+		return r.Reader.List(ctx, list, opts...)
+	}
+
+	after, err := decodeContinue(listOpts.Continue)
+	if err != nil {
+		return err
+	}
+	limit := listOpts.Limit
+	listOpts.Limit = 0
+	listOpts.Continue = ""
+	if err := r.Reader.List(ctx, list, listOpts); err != nil {
+		return err
+	}
+
+	objs, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, len(objs))
+	for i, obj := range objs {
+		key, err := objectKey(obj)
+		if err != nil {
+			return err
+		}
+		keys[i] = key
+	}
+	sort.Sort(&byKey{objs: objs, keys: keys})
+
+	start := sort.SearchStrings(keys, after)
+	if start < len(keys) && keys[start] == after {
+		start++
+	}
+	objs, keys = objs[start:], keys[start:]
+
+	var next string
+	var remaining *int64
+	if limit > 0 && int64(len(objs)) > limit {
+		count := int64(len(objs)) - limit
+		remaining = &count
+		objs = objs[:limit]
+		next = base64.RawURLEncoding.EncodeToString([]byte(keys[limit-1]))
+	}
+
+	if err := meta.SetList(list, objs); err != nil {
+		return err
+	}
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+	listMeta.SetContinue(next)
+	listMeta.SetRemainingItemCount(remaining)
+	return nil
+}
+
+func decodeContinue(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", apierrors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err))
+	}
+	return string(b), nil
+}
+
+func objectKey(obj runtime.Object) (string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	return accessor.GetNamespace() + "/" + accessor.GetName(), nil
+}
+
+type byKey struct {
+	objs []runtime.Object
+	keys []string
+}
+
+func (b *byKey) Len() int           { return len(b.keys) }
+func (b *byKey) Less(i, j int) bool { return b.keys[i] < b.keys[j] }
+func (b *byKey) Swap(i, j int) {
+	b.objs[i], b.objs[j] = b.objs[j], b.objs[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newPagingReader(t *testing.T, names ...string) *PagingReader {
+	t.Helper()
+	scheme, err := NewScheme()
+	require.NoError(t, err)
+	objs := make([]client.Object, 0, len(names))
+	for _, name := range names {
+		objs = append(objs, &openchoreov1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}})
+	}
+	return &PagingReader{Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()}
+}
+
+func projectNames(list *openchoreov1alpha1.ProjectList) []string {
+	names := make([]string, len(list.Items))
+	for i := range list.Items {
+		names[i] = list.Items[i].Name
+	}
+	return names
+}
+
+func TestPagingReader_PagesInKeyOrder(t *testing.T) {
+	ctx := context.Background()
+	r := newPagingReader(t, "delta", "alpha", "echo", "charlie", "bravo")
+
+	var first openchoreov1alpha1.ProjectList
+	require.NoError(t, r.List(ctx, &first, client.InNamespace("default"), client.Limit(2)))
+	assert.Equal(t, []string{"alpha", "bravo"}, projectNames(&first))
+	require.NotEmpty(t, first.Continue)
+	require.NotNil(t, first.RemainingItemCount)
+	assert.Equal(t, int64(3), *first.RemainingItemCount)
+
+	// A fresh reader stands in for another API replica: the token carries all the state.
+	other := newPagingReader(t, "delta", "alpha", "echo", "charlie", "bravo")
+	var second openchoreov1alpha1.ProjectList
+	require.NoError(t, other.List(ctx, &second, client.InNamespace("default"), client.Limit(2), client.Continue(first.Continue)))
+	assert.Equal(t, []string{"charlie", "delta"}, projectNames(&second))
+
+	var last openchoreov1alpha1.ProjectList
+	require.NoError(t, r.List(ctx, &last, client.InNamespace("default"), client.Limit(2), client.Continue(second.Continue)))
+	assert.Equal(t, []string{"echo"}, projectNames(&last))
+	assert.Empty(t, last.Continue)
+	assert.Nil(t, last.RemainingItemCount)
+}
+
+func TestPagingReader_ContinueAfterDeletedItem(t *testing.T) {
+	ctx := context.Background()
+	r := newPagingReader(t, "alpha", "bravo", "charlie")
+
+	var first openchoreov1alpha1.ProjectList
+	require.NoError(t, r.List(ctx, &first, client.Limit(2)))
+	require.Equal(t, []string{"alpha", "bravo"}, projectNames(&first))
+
+	// The last item of the page is gone by the time the next page is read.
+	require.NoError(t, r.Reader.(client.Client).Delete(ctx, &first.Items[1]))
+
+	var second openchoreov1alpha1.ProjectList
+	require.NoError(t, r.List(ctx, &second, client.Limit(2), client.Continue(first.Continue)))
+	assert.Equal(t, []string{"charlie"}, projectNames(&second))
+}
+
+func TestPagingReader_UnpagedListPassesThrough(t *testing.T) {
+	r := newPagingReader(t, "alpha", "bravo")
+
+	var list openchoreov1alpha1.ProjectList
+	require.NoError(t, r.List(context.Background(), &list))
+	assert.ElementsMatch(t, []string{"alpha", "bravo"}, projectNames(&list))
+	assert.Empty(t, list.Continue)
+}
+
+func TestPagingReader_InvalidContinue(t *testing.T) {
+	r := newPagingReader(t, "alpha")
+
+	var list openchoreov1alpha1.ProjectList
+	err := r.List(context.Background(), &list, client.Limit(1), client.Continue("not base64!"))
+	assert.True(t, apierrors.IsBadRequest(err), "expected bad request, got %v", err)
+}
@@ -20,6 +20,16 @@ func NewK8sClient() (client.Client, error) {
 		return nil, fmt.Errorf("failed to create kubernetes config: %w", err)
 	}
 
+	scheme, err := NewScheme()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(config, client.Options{Scheme: scheme})
+}
+
+// NewScheme returns a scheme with the core Kubernetes and OpenChoreo types used by the API.
+func NewScheme() (*runtime.Scheme, error) {
 	scheme := runtime.NewScheme()
 
 	// Add core Kubernetes types (Secret, ConfigMap, etc.)
@@ -32,5 +42,5 @@ func NewK8sClient() (client.Client, error) {
 		return nil, fmt.Errorf("failed to add OpenChoreo scheme: %w", err)
 	}
 
-	return client.New(config, client.Options{Scheme: scheme})
+	return scheme, nil
 }
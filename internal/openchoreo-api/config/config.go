@@ -27,18 +27,27 @@ type Config struct {
 	Logging LoggingConfig `koanf:"logging"`
 	// ClusterGateway defines cluster gateway connection settings.
 	ClusterGateway ClusterGatewayConfig `koanf:"cluster_gateway"`
+	// Kubernetes defines control plane Kubernetes client settings.
+	Kubernetes KubernetesConfig `koanf:"kubernetes"`
+	// DirectorySync defines the optional SCIM/LDAP group sync into authz role bindings.
+	DirectorySync DirectorySyncConfig `koanf:"directory_sync"`
+	// WorkflowCredentials defines the optional credential broker for workflow runs.
+	WorkflowCredentials WorkflowCredentialsConfig `koanf:"workflow_credentials"`
 }
 
 // Defaults returns the default configuration.
 func Defaults() Config {
 	return Config{
-		Server:           ServerDefaults(),
-		Security:         SecurityDefaults(),
-		Identity:         IdentityDefaults(),
-		MCP:              MCPDefaults(),
-		SecretManagement: SecretManagementDefaults(),
-		Logging:          LoggingDefaults(),
-		ClusterGateway:   ClusterGatewayDefaults(),
+		Server:              ServerDefaults(),
+		Security:            SecurityDefaults(),
+		Identity:            IdentityDefaults(),
+		MCP:                 MCPDefaults(),
+		SecretManagement:    SecretManagementDefaults(),
+		Logging:             LoggingDefaults(),
+		ClusterGateway:      ClusterGatewayDefaults(),
+		Kubernetes:          KubernetesDefaults(),
+		DirectorySync:       DirectorySyncDefaults(),
+		WorkflowCredentials: WorkflowCredentialsDefaults(),
 	}
 }
 
@@ -84,6 +93,9 @@ func (c *Config) Validate() error {
 	errs = append(errs, c.MCP.ValidateMCPConfig(coreconfig.NewPath("mcp"))...)
 	errs = append(errs, c.Logging.Validate(coreconfig.NewPath("logging"))...)
 	errs = append(errs, c.ClusterGateway.Validate(coreconfig.NewPath("cluster_gateway"))...)
+	errs = append(errs, c.Kubernetes.Validate(coreconfig.NewPath("kubernetes"))...)
+	errs = append(errs, c.DirectorySync.Validate(coreconfig.NewPath("directory_sync"))...)
+	errs = append(errs, c.WorkflowCredentials.Validate(coreconfig.NewPath("workflow_credentials"))...)
 
 	return errs.OrNil()
 }
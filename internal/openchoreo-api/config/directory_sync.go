@@ -0,0 +1,288 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/directorysync"
+)
+
+const (
+	DirectorySyncSourceSCIM = "scim"
+	DirectorySyncSourceLDAP = "ldap"
+)
+
+// DirectorySyncConfig defines the optional sync of directory groups into authz role bindings.
+// When enabled, the API periodically reads users and groups from a SCIM or LDAP directory and
+// keeps one role binding per mapped group member. Bindings created by the sync carry the
+// openchoreo.dev/managed-by=directory-sync label; other bindings are never modified.
+type DirectorySyncConfig struct {
+	// Enabled turns on the periodic sync and the directory sync API endpoints.
+	Enabled bool `koanf:"enabled"`
+	// Interval is the time between syncs.
+	Interval time.Duration `koanf:"interval"`
+	// Source selects the directory: "scim" or "ldap".
+	Source string `koanf:"source"`
+	// EntitlementClaim is the JWT claim that carries the directory user identifier (e.g., "email").
+	EntitlementClaim string `koanf:"entitlement_claim"`
+	// SCIM defines the SCIM 2.0 directory settings.
+	SCIM DirectorySyncSCIMConfig `koanf:"scim"`
+	// LDAP defines the LDAP directory settings.
+	LDAP DirectorySyncLDAPConfig `koanf:"ldap"`
+	// Mappings maps directory groups to roles.
+	Mappings []DirectorySyncMapping `koanf:"mappings"`
+}
+
+// DirectorySyncSCIMConfig defines the SCIM 2.0 directory settings.
+type DirectorySyncSCIMConfig struct {
+	// BaseURL is the SCIM base URL (e.g., https://idp.example.com/scim/v2).
+	BaseURL string `koanf:"base_url"`
+	// Token is the bearer token for the SCIM API.
+	Token string `koanf:"token"`
+	// UserAttribute is the user attribute matched against the entitlement claim: userName, email or id.
+	UserAttribute string `koanf:"user_attribute"`
+	// Timeout bounds each request to the SCIM API.
+	Timeout time.Duration `koanf:"timeout"`
+}
+
+// DirectorySyncLDAPConfig defines the LDAP directory settings.
+type DirectorySyncLDAPConfig struct {
+	// URL is the server URL (ldap:// or ldaps://).
+	URL string `koanf:"url"`
+	// BindDN and BindPassword authenticate the sync. Anonymous bind is used when BindDN is empty.
+	BindDN       string `koanf:"bind_dn"`
+	BindPassword string `koanf:"bind_password"`
+	// SkipTLSVerify disables server certificate verification for ldaps://.
+	SkipTLSVerify bool `koanf:"skip_tls_verify"`
+	// GroupBaseDN is the subtree searched for groups.
+	GroupBaseDN string `koanf:"group_base_dn"`
+	// GroupFilter selects group entries.
+	GroupFilter string `koanf:"group_filter"`
+	// GroupNameAttribute holds the group name matched against the mappings.
+	GroupNameAttribute string `koanf:"group_name_attribute"`
+	// MemberAttribute lists the member DNs of a group.
+	MemberAttribute string `koanf:"member_attribute"`
+	// UserBaseDN is the subtree searched for users. Defaults to GroupBaseDN.
+	UserBaseDN string `koanf:"user_base_dn"`
+	// UserFilter selects user entries.
+	UserFilter string `koanf:"user_filter"`
+	// UserAttribute is the user attribute matched against the entitlement claim.
+	UserAttribute string `koanf:"user_attribute"`
+	// Timeout bounds the connection and each sync.
+	Timeout time.Duration `koanf:"timeout"`
+}
+
+// DirectorySyncMapping grants roles to the members of a directory group.
+type DirectorySyncMapping struct {
+	// Group is the directory group name.
+	Group string `koanf:"group"`
+	// Namespace is where AuthzRoleBindings are created. When empty, ClusterAuthzRoleBindings are created.
+	Namespace string `koanf:"namespace"`
+	// Roles are the roles granted to the group members.
+	Roles []DirectorySyncRole `koanf:"roles"`
+}
+
+// DirectorySyncRole is a role granted by a mapping and the scope it applies to.
+type DirectorySyncRole struct {
+	// Kind is AuthzRole or ClusterAuthzRole. Defaults to ClusterAuthzRole.
+	Kind string `koanf:"kind"`
+	// Name is the role name.
+	Name string `koanf:"name"`
+	// Namespace narrows a cluster binding to a namespace. Only valid for cluster mappings.
+	Namespace string `koanf:"namespace"`
+	// Project narrows the grant to a project.
+	Project string `koanf:"project"`
+	// Component narrows the grant to a component of the project.
+	Component string `koanf:"component"`
+}
+
+// DirectorySyncDefaults returns the default directory sync configuration.
+func DirectorySyncDefaults() DirectorySyncConfig {
+	return DirectorySyncConfig{
+		Enabled:          false,
+		Interval:         15 * time.Minute,
+		Source:           DirectorySyncSourceSCIM,
+		EntitlementClaim: "email",
+		SCIM: DirectorySyncSCIMConfig{
+			UserAttribute: directorysync.SCIMUserAttributeEmail,
+			Timeout:       30 * time.Second,
+		},
+		LDAP: DirectorySyncLDAPConfig{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Validate validates the directory sync configuration. Nothing is checked while the sync is disabled.
+func (c *DirectorySyncConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustBeGreaterThan(path.Child("interval"), c.Interval, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustNotBeEmpty(path.Child("entitlement_claim"), c.EntitlementClaim); err != nil {
+		errs = append(errs, err)
+	}
+
+	switch c.Source {
+	case DirectorySyncSourceSCIM:
+		errs = append(errs, c.SCIM.validate(path.Child("scim"))...)
+	case DirectorySyncSourceLDAP:
+		errs = append(errs, c.LDAP.validate(path.Child("ldap"))...)
+	default:
+		errs = append(errs, config.MustBeOneOf(path.Child("source"), c.Source,
+			[]string{DirectorySyncSourceSCIM, DirectorySyncSourceLDAP}))
+	}
+
+	if len(c.Mappings) == 0 {
+		errs = append(errs, config.Required(path.Child("mappings")))
+	}
+	seen := map[string]bool{}
+	for i, m := range c.Mappings {
+		mPath := path.Child("mappings").Index(i)
+		if m.Group == "" {
+			errs = append(errs, config.Required(mPath.Child("group")))
+		}
+		key := m.Namespace + "/" + m.Group
+		if seen[key] {
+			errs = append(errs, config.Invalid(mPath, fmt.Sprintf("duplicate mapping for group %q in namespace %q", m.Group, m.Namespace)))
+		}
+		seen[key] = true
+		if len(m.Roles) == 0 {
+			errs = append(errs, config.Required(mPath.Child("roles")))
+		}
+		for j, r := range m.Roles {
+			errs = append(errs, r.validate(mPath.Child("roles").Index(j), m.Namespace == "")...)
+		}
+	}
+	return errs
+}
+
+func (c *DirectorySyncSCIMConfig) validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+	if err := validateURL(path.Child("base_url"), c.BaseURL, "http", "https"); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeOneOf(path.Child("user_attribute"), c.UserAttribute, []string{
+		directorysync.SCIMUserAttributeUserName, directorysync.SCIMUserAttributeEmail, directorysync.SCIMUserAttributeID,
+	}); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(path.Child("timeout"), c.Timeout); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (c *DirectorySyncLDAPConfig) validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+	if err := validateURL(path.Child("url"), c.URL, "ldap", "ldaps"); err != nil {
+		errs = append(errs, err)
+	}
+	if c.GroupBaseDN == "" {
+		errs = append(errs, config.Required(path.Child("group_base_dn")))
+	}
+	if c.BindDN != "" && c.BindPassword == "" {
+		errs = append(errs, config.Invalid(path.Child("bind_password"), "is required when bind_dn is set"))
+	}
+	if err := config.MustBeNonNegative(path.Child("timeout"), c.Timeout); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (r *DirectorySyncRole) validate(path *config.Path, cluster bool) config.ValidationErrors {
+	var errs config.ValidationErrors
+	if r.Name == "" {
+		errs = append(errs, config.Required(path.Child("name")))
+	}
+	if r.Kind != "" {
+		if err := config.MustBeOneOf(path.Child("kind"), r.Kind, []string{
+			string(openchoreov1alpha1.RoleRefKindAuthzRole), string(openchoreov1alpha1.RoleRefKindClusterAuthzRole),
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if cluster && r.Kind == string(openchoreov1alpha1.RoleRefKindAuthzRole) {
+		errs = append(errs, config.Invalid(path.Child("kind"), "AuthzRole can only be granted by mappings with a namespace"))
+	}
+	if !cluster && r.Namespace != "" {
+		errs = append(errs, config.Invalid(path.Child("namespace"), "can only be set on mappings without a namespace"))
+	}
+	if r.Component != "" && r.Project == "" {
+		errs = append(errs, config.Invalid(path.Child("component"), "requires project"))
+	}
+	if cluster && r.Project != "" && r.Namespace == "" {
+		errs = append(errs, config.Invalid(path.Child("project"), "requires namespace"))
+	}
+	return errs
+}
+
+func validateURL(path *config.Path, value string, schemes ...string) *config.FieldError {
+	if value == "" {
+		return config.Required(path)
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		return config.Invalid(path, "must be a valid URL")
+	}
+	return config.MustBeOneOf(path, u.Scheme, schemes)
+}
+
+// ToDirectorySyncConfig converts to the directory sync service config.
+func (c *DirectorySyncConfig) ToDirectorySyncConfig() directorysync.Config {
+	out := directorysync.Config{EntitlementClaim: c.EntitlementClaim}
+	for _, m := range c.Mappings {
+		mapping := directorysync.GroupMapping{Group: m.Group, Namespace: m.Namespace}
+		for _, r := range m.Roles {
+			kind := openchoreov1alpha1.RoleRefKind(r.Kind)
+			if kind == "" {
+				kind = openchoreov1alpha1.RoleRefKindClusterAuthzRole
+			}
+			mapping.Roles = append(mapping.Roles, directorysync.RoleGrant{
+				Kind:      kind,
+				Name:      r.Name,
+				Namespace: r.Namespace,
+				Project:   r.Project,
+				Component: r.Component,
+			})
+		}
+		out.Mappings = append(out.Mappings, mapping)
+	}
+	return out
+}
+
+// NewDirectory creates the configured directory client.
+func (c *DirectorySyncConfig) NewDirectory() directorysync.Directory {
+	if c.Source == DirectorySyncSourceLDAP {
+		return directorysync.NewLDAPDirectory(directorysync.LDAPConfig{
+			URL:                c.LDAP.URL,
+			BindDN:             c.LDAP.BindDN,
+			BindPassword:       c.LDAP.BindPassword,
+			SkipTLSVerify:      c.LDAP.SkipTLSVerify,
+			GroupBaseDN:        c.LDAP.GroupBaseDN,
+			GroupFilter:        c.LDAP.GroupFilter,
+			GroupNameAttribute: c.LDAP.GroupNameAttribute,
+			MemberAttribute:    c.LDAP.MemberAttribute,
+			UserBaseDN:         c.LDAP.UserBaseDN,
+			UserFilter:         c.LDAP.UserFilter,
+			UserAttribute:      c.LDAP.UserAttribute,
+			Timeout:            c.LDAP.Timeout,
+		})
+	}
+	return directorysync.NewSCIMDirectory(directorysync.SCIMConfig{
+		BaseURL:       c.SCIM.BaseURL,
+		Token:         c.SCIM.Token,
+		UserAttribute: c.SCIM.UserAttribute,
+		Timeout:       c.SCIM.Timeout,
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/directorysync"
+)
+
+func validDirectorySyncConfig() DirectorySyncConfig {
+	cfg := DirectorySyncDefaults()
+	cfg.Enabled = true
+	cfg.SCIM.BaseURL = "https://idp.example.com/scim/v2"
+	cfg.Mappings = []DirectorySyncMapping{
+		{Group: "platform", Roles: []DirectorySyncRole{{Name: "admin"}}},
+		{Group: "developers", Namespace: "acme", Roles: []DirectorySyncRole{{Kind: "AuthzRole", Name: "developer", Project: "shop"}}},
+	}
+	return cfg
+}
+
+func TestDirectorySyncConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(*DirectorySyncConfig)
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:   "valid scim config",
+			mutate: func(*DirectorySyncConfig) {},
+		},
+		{
+			name: "disabled config is not validated",
+			mutate: func(c *DirectorySyncConfig) {
+				*c = DirectorySyncDefaults()
+			},
+		},
+		{
+			name: "valid ldap config",
+			mutate: func(c *DirectorySyncConfig) {
+				c.Source = DirectorySyncSourceLDAP
+				c.LDAP.URL = "ldaps://ldap.example.com"
+				c.LDAP.GroupBaseDN = "ou=groups,dc=example,dc=com"
+			},
+		},
+		{
+			name: "unknown source",
+			mutate: func(c *DirectorySyncConfig) {
+				c.Source = "okta"
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "directory_sync.source", Message: "must be one of: scim, ldap"},
+			},
+		},
+		{
+			name: "missing connection settings",
+			mutate: func(c *DirectorySyncConfig) {
+				c.Interval = 0
+				c.Source = DirectorySyncSourceLDAP
+				c.LDAP.URL = "https://ldap.example.com"
+				c.LDAP.BindDN = "cn=sync,dc=example,dc=com"
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "directory_sync.interval", Message: "must be greater than 0s"},
+				{Field: "directory_sync.ldap.url", Message: "must be one of: ldap, ldaps"},
+				{Field: "directory_sync.ldap.group_base_dn", Message: "is required"},
+				{Field: "directory_sync.ldap.bind_password", Message: "is required when bind_dn is set"},
+			},
+		},
+		{
+			name: "invalid mappings",
+			mutate: func(c *DirectorySyncConfig) {
+				c.Mappings = append(c.Mappings,
+					DirectorySyncMapping{Group: "platform", Roles: []DirectorySyncRole{{Name: "viewer"}}},
+					DirectorySyncMapping{Group: "ops", Roles: []DirectorySyncRole{{Kind: "AuthzRole", Name: "ops", Project: "shop"}}},
+					DirectorySyncMapping{Group: "qa", Namespace: "acme"},
+				)
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "directory_sync.mappings[2]", Message: `duplicate mapping for group "platform" in namespace ""`},
+				{Field: "directory_sync.mappings[3].roles[0].kind", Message: "AuthzRole can only be granted by mappings with a namespace"},
+				{Field: "directory_sync.mappings[3].roles[0].project", Message: "requires namespace"},
+				{Field: "directory_sync.mappings[4].roles", Message: "is required"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validDirectorySyncConfig()
+			tt.mutate(&cfg)
+			errs := cfg.Validate(config.NewPath("directory_sync"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDirectorySyncConfig_ToDirectorySyncConfig(t *testing.T) {
+	cfg := validDirectorySyncConfig()
+	want := directorysync.Config{
+		EntitlementClaim: "email",
+		Mappings: []directorysync.GroupMapping{
+			{Group: "platform", Roles: []directorysync.RoleGrant{
+				{Kind: openchoreov1alpha1.RoleRefKindClusterAuthzRole, Name: "admin"},
+			}},
+			{Group: "developers", Namespace: "acme", Roles: []directorysync.RoleGrant{
+				{Kind: openchoreov1alpha1.RoleRefKindAuthzRole, Name: "developer", Project: "shop"},
+			}},
+		},
+	}
+	if diff := cmp.Diff(want, cfg.ToDirectorySyncConfig()); diff != "" {
+		t.Errorf("config mismatch (-want +got):\n%s", diff)
+	}
+}
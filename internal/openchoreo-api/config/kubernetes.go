@@ -0,0 +1,62 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"time"
+
+	coreconfig "github.com/openchoreo/openchoreo/internal/config"
+)
+
+// KubernetesConfig defines how the API talks to the control plane Kubernetes API server.
+type KubernetesConfig struct {
+	// Cache defines the shared informer cache used to serve reads.
+	Cache KubernetesCacheConfig `koanf:"cache"`
+	// StatusModel defines the in-memory project deployment status model.
+	StatusModel KubernetesStatusModelConfig `koanf:"status_model"`
+}
+
+// KubernetesCacheConfig defines the shared informer cache settings.
+// When enabled, Get and List calls are served from informers that are shared by all
+// requests (and by the authorization watchers), while writes go straight to the API server.
+// Reads may briefly lag behind writes made by other clients or other API replicas.
+type KubernetesCacheConfig struct {
+	// Enabled serves reads from the shared informer cache.
+	Enabled bool `koanf:"enabled"`
+	// ResyncInterval is the interval for informer cache resync.
+	// Set to 0 to disable periodic resync (watch events still work).
+	ResyncInterval time.Duration `koanf:"resync_interval"`
+}
+
+// KubernetesStatusModelConfig defines the in-memory project deployment status model.
+// When enabled, dedicated informers over Components, ReleaseBindings and RenderedReleases
+// keep a component/environment status matrix per project up to date, and the project
+// status and search endpoints are served from it. When disabled, each request lists those
+// resources.
+type KubernetesStatusModelConfig struct {
+	// Enabled builds and serves the status model.
+	Enabled bool `koanf:"enabled"`
+}
+
+// KubernetesDefaults returns the default Kubernetes client configuration.
+func KubernetesDefaults() KubernetesConfig {
+	return KubernetesConfig{
+		Cache: KubernetesCacheConfig{
+			Enabled:        false,
+			ResyncInterval: 10 * time.Minute,
+		},
+		StatusModel: KubernetesStatusModelConfig{
+			Enabled: true,
+		},
+	}
+}
+
+// Validate validates the Kubernetes client configuration.
+func (c *KubernetesConfig) Validate(path *coreconfig.Path) coreconfig.ValidationErrors {
+	var errs coreconfig.ValidationErrors
+	if err := coreconfig.MustBeNonNegative(path.Child("cache").Child("resync_interval"), c.Cache.ResyncInterval); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
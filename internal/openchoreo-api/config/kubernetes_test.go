@@ -0,0 +1,52 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestKubernetesConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            KubernetesConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            KubernetesDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name: "zero resync interval disables resync",
+			cfg: KubernetesConfig{
+				Cache: KubernetesCacheConfig{Enabled: true, ResyncInterval: 0},
+			},
+			expectedErrors: nil,
+		},
+		{
+			name: "negative resync interval",
+			cfg: KubernetesConfig{
+				Cache: KubernetesCacheConfig{Enabled: true, ResyncInterval: -time.Minute},
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "kubernetes.cache.resync_interval", Message: "must be non-negative"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("kubernetes"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,6 +28,8 @@ type SecurityConfig struct {
 	Subjects map[string]SubjectConfig `koanf:"subjects"`
 	// Authorization defines authorization (Casbin) settings.
 	Authorization AuthorizationConfig `koanf:"authorization"`
+	// SessionTokens defines the console session token exchange.
+	SessionTokens SessionTokensConfig `koanf:"session_tokens"`
 }
 
 // SecurityDefaults returns the default security configuration.
@@ -37,6 +39,7 @@ func SecurityDefaults() SecurityConfig {
 		Authentication: AuthenticationDefaults(),
 		Subjects:       nil,
 		Authorization:  AuthorizationDefaults(),
+		SessionTokens:  SessionTokensDefaults(),
 	}
 }
 
@@ -51,6 +54,7 @@ func (c *SecurityConfig) Validate(path *config.Path) config.ValidationErrors {
 	errs = append(errs, c.Authentication.Validate(path.Child("authentication"))...)
 	errs = append(errs, c.validateSubjects(path.Child("subjects"))...)
 	errs = append(errs, c.Authorization.Validate(path.Child("authorization"))...)
+	errs = append(errs, c.SessionTokens.Validate(path.Child("session_tokens"))...)
 
 	return errs
 }
@@ -5,10 +5,13 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/config"
 	"github.com/openchoreo/openchoreo/internal/server"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/bodylimit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/ratelimit"
 )
 
 // ServerConfig defines HTTP server settings.
@@ -68,19 +71,178 @@ func TLSDefaults() TLSConfig {
 }
 
 // MiddlewareConfig defines server middleware configurations.
-// Placeholder for future middleware settings (e.g., request_id).
+// Request IDs, access logs and panic recovery are always enabled.
 type MiddlewareConfig struct {
-	// Future: RequestID config, rate limiting, etc.
+	// Metrics defines the HTTP server metrics.
+	Metrics MetricsConfig `koanf:"metrics"`
+	// RateLimit defines per-client request rate limiting.
+	RateLimit RateLimitConfig `koanf:"rate_limit"`
+	// BodyLimit defines request body size limits.
+	BodyLimit BodyLimitConfig `koanf:"body_limit"`
 }
 
+// MetricsConfig defines the HTTP server metrics.
+// The metrics are served by a separate server on Port rather than the API port, so that
+// they are not exposed to the clients of the API.
+type MetricsConfig struct {
+	// Enabled records request metrics and exposes them on Path of the metrics server.
+	Enabled bool `koanf:"enabled"`
+	// BindAddress is the address to bind the metrics server to.
+	BindAddress string `koanf:"bind_address"`
+	// Port is the metrics server port. It must differ from the API port.
+	Port int `koanf:"port"`
+	// Path is the path the metrics are exposed on.
+	Path string `koanf:"path"`
+}
+
+// RateLimitConfig defines per-client request rate limiting.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained request rate allowed per client IP.
+	// Zero disables rate limiting.
+	RequestsPerSecond float64 `koanf:"requests_per_second"`
+	// Burst is the number of requests a client may make at once.
+	// Defaults to the requests per second when zero.
+	Burst int `koanf:"burst"`
+}
+
+// BodyLimitConfig defines request body size limits.
+type BodyLimitConfig struct {
+	// MaxBytes is the body size limit of requests that match no route.
+	// Zero disables the limit.
+	MaxBytes int64 `koanf:"max_bytes"`
+	// Routes override the limit for specific endpoints.
+	Routes []BodyLimitRouteConfig `koanf:"routes"`
+}
+
+// BodyLimitRouteConfig defines the body size limit of an endpoint.
+type BodyLimitRouteConfig struct {
+	// Pattern is a Go http.ServeMux pattern, such as
+	// "PUT /api/v1/namespaces/{namespaceName}/workloads/{workloadName}".
+	Pattern string `koanf:"pattern"`
+	// MaxBytes is the body size limit of the endpoint. Zero disables the limit.
+	MaxBytes int64 `koanf:"max_bytes"`
+	// Guidance is added to the 413 response, such as how to send a smaller payload.
+	Guidance string `koanf:"guidance"`
+}
+
+// bodyLimitGuidance is the 413 guidance of endpoints without their own.
+const bodyLimitGuidance = "Reduce the size of the request, or ask an administrator to raise " +
+	"server.middleware.body_limit for this endpoint"
+
+// workloadBodyLimitGuidance is the 413 guidance of the workload endpoints, whose
+// payloads grow with inlined file contents.
+const workloadBodyLimitGuidance = "Workloads are stored as Kubernetes resources, which are limited to about 1.5 MiB; " +
+	"mount large files from a secret with valueFrom.secretKeyRef instead of inlining them"
+
 // MiddlewareDefaults returns the default middleware configuration.
 func MiddlewareDefaults() MiddlewareConfig {
-	return MiddlewareConfig{}
+	return MiddlewareConfig{
+		Metrics: MetricsConfig{
+			Enabled:     true,
+			BindAddress: "0.0.0.0",
+			Port:        9090,
+			Path:        "/metrics",
+		},
+		BodyLimit: BodyLimitConfig{
+			MaxBytes: 1 << 20,
+			Routes: []BodyLimitRouteConfig{
+				{
+					Pattern:  "POST /api/v1/namespaces/{namespaceName}/workloads",
+					MaxBytes: 2 << 20,
+					Guidance: workloadBodyLimitGuidance,
+				},
+				{
+					Pattern:  "PUT /api/v1/namespaces/{namespaceName}/workloads/{workloadName}",
+					MaxBytes: 2 << 20,
+					Guidance: workloadBodyLimitGuidance,
+				},
+				{
+					Pattern:  "PUT /api/v1/namespaces/{namespaceName}/workloads/{workloadName}/files",
+					MaxBytes: 2 << 20,
+					Guidance: workloadBodyLimitGuidance,
+				},
+			},
+		},
+	}
 }
 
 // Validate validates the middleware configuration.
 func (c *MiddlewareConfig) Validate(path *config.Path) config.ValidationErrors {
-	return nil // No validation needed for empty config
+	var errs config.ValidationErrors
+
+	if c.Metrics.Enabled {
+		metricsPath := path.Child("metrics")
+		if err := config.MustBeInRange(metricsPath.Child("port"), c.Metrics.Port, 1, 65535); err != nil {
+			errs = append(errs, err)
+		}
+		if !strings.HasPrefix(c.Metrics.Path, "/") {
+			errs = append(errs, config.Invalid(metricsPath.Child("path"), "must start with /"))
+		}
+	}
+
+	rateLimitPath := path.Child("rate_limit")
+	if err := config.MustBeNonNegative(rateLimitPath.Child("requests_per_second"), c.RateLimit.RequestsPerSecond); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(rateLimitPath.Child("burst"), c.RateLimit.Burst); err != nil {
+		errs = append(errs, err)
+	}
+
+	errs = append(errs, c.BodyLimit.Validate(path.Child("body_limit"))...)
+
+	return errs
+}
+
+// Validate validates the body limit configuration.
+func (c *BodyLimitConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if err := config.MustBeNonNegative(path.Child("max_bytes"), c.MaxBytes); err != nil {
+		errs = append(errs, err)
+	}
+	// Patterns are checked one at a time against the valid ones before them, so a conflict
+	// is reported on the later route.
+	var valid []bodylimit.Route
+	for i, route := range c.Routes {
+		routePath := path.Child("routes").Index(i)
+		if err := config.MustNotBeEmpty(routePath.Child("pattern"), route.Pattern); err != nil {
+			errs = append(errs, err)
+		} else if err := (bodylimit.Config{Routes: append(valid, bodylimit.Route{Pattern: route.Pattern})}).Validate(); err != nil {
+			errs = append(errs, config.Invalid(routePath.Child("pattern"), err.Error()))
+		} else {
+			valid = append(valid, bodylimit.Route{Pattern: route.Pattern})
+		}
+		if err := config.MustBeNonNegative(routePath.Child("max_bytes"), route.MaxBytes); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// ToBodyLimitConfig converts to the body limit middleware config.
+func (c *BodyLimitConfig) ToBodyLimitConfig() bodylimit.Config {
+	routes := make([]bodylimit.Route, 0, len(c.Routes))
+	for _, route := range c.Routes {
+		routes = append(routes, bodylimit.Route{
+			Pattern:  route.Pattern,
+			MaxBytes: route.MaxBytes,
+			Guidance: route.Guidance,
+		})
+	}
+	return bodylimit.Config{
+		MaxBytes: c.MaxBytes,
+		Routes:   routes,
+		Guidance: bodyLimitGuidance,
+	}
+}
+
+// ToRateLimitConfig converts to the rate limit middleware config.
+func (c *RateLimitConfig) ToRateLimitConfig() ratelimit.Config {
+	return ratelimit.Config{
+		RequestsPerSecond: c.RequestsPerSecond,
+		Burst:             c.Burst,
+	}
 }
 
 // ServerDefaults returns the default server configuration.
@@ -106,6 +268,10 @@ func (c *ServerConfig) Validate(path *config.Path) config.ValidationErrors {
 	errs = append(errs, c.Timeouts.Validate(path.Child("timeouts"))...)
 	errs = append(errs, c.TLS.Validate(path.Child("tls"))...)
 	errs = append(errs, c.Middleware.Validate(path.Child("middleware"))...)
+	if c.Middleware.Metrics.Enabled && c.Middleware.Metrics.Port == c.Port {
+		errs = append(errs, config.Invalid(path.Child("middleware").Child("metrics").Child("port"),
+			"must differ from the server port"))
+	}
 
 	return errs
 }
@@ -165,3 +331,14 @@ func (c *ServerConfig) ToServerConfig() server.Config {
 		TLSKeyFile:      c.TLS.KeyFile,
 	}
 }
+
+// ToMetricsServerConfig converts to the config of the server that exposes the metrics.
+func (c *ServerConfig) ToMetricsServerConfig() server.Config {
+	return server.Config{
+		Addr:            fmt.Sprintf("%s:%d", c.Middleware.Metrics.BindAddress, c.Middleware.Metrics.Port),
+		ReadTimeout:     c.Timeouts.Read,
+		WriteTimeout:    c.Timeouts.Write,
+		IdleTimeout:     c.Timeouts.Idle,
+		ShutdownTimeout: c.Timeouts.Shutdown,
+	}
+}
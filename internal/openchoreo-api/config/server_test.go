@@ -75,3 +75,104 @@ func TestTLSConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestMiddlewareConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            MiddlewareConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            MiddlewareDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name: "disabled metrics skip path validation",
+			cfg: MiddlewareConfig{
+				Metrics: MetricsConfig{Enabled: false},
+			},
+			expectedErrors: nil,
+		},
+		{
+			name: "enabled metrics require an absolute path",
+			cfg: MiddlewareConfig{
+				Metrics: MetricsConfig{Enabled: true, Port: 9090, Path: "metrics"},
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "middleware.metrics.path", Message: "must start with /"},
+			},
+		},
+		{
+			name: "enabled metrics require a valid port",
+			cfg: MiddlewareConfig{
+				Metrics: MetricsConfig{Enabled: true, Path: "/metrics"},
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "middleware.metrics.port", Message: "must be between 1 and 65535"},
+			},
+		},
+		{
+			name: "negative rate limit is invalid",
+			cfg: MiddlewareConfig{
+				RateLimit: RateLimitConfig{RequestsPerSecond: -1, Burst: -1},
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "middleware.rate_limit.requests_per_second", Message: "must be non-negative"},
+				{Field: "middleware.rate_limit.burst", Message: "must be non-negative"},
+			},
+		},
+		{
+			name: "body limit routes must be valid and distinct",
+			cfg: MiddlewareConfig{
+				BodyLimit: BodyLimitConfig{
+					MaxBytes: -1,
+					Routes: []BodyLimitRouteConfig{
+						{Pattern: "PUT /workloads/{name}", MaxBytes: 1},
+						{Pattern: "", MaxBytes: 1},
+						{Pattern: "PUT /workloads/{id}", MaxBytes: -1},
+					},
+				},
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "middleware.body_limit.max_bytes", Message: "must be non-negative"},
+				{Field: "middleware.body_limit.routes[1].pattern", Message: "must not be empty"},
+				{
+					Field:   "middleware.body_limit.routes[2].pattern",
+					Message: `route "PUT /workloads/{id}": PUT /workloads/{id} matches the same requests as PUT /workloads/{name}`,
+				},
+				{Field: "middleware.body_limit.routes[2].max_bytes", Message: "must be non-negative"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("middleware"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServerConfig_Validate(t *testing.T) {
+	cfg := ServerDefaults()
+	if errs := cfg.Validate(config.NewPath("server")); errs != nil {
+		t.Fatalf("defaults are invalid: %v", errs)
+	}
+
+	// The metrics are served on their own port so that they are not exposed on the API port
+	cfg.Middleware.Metrics.Port = cfg.Port
+	want := config.ValidationErrors{
+		{Field: "server.middleware.metrics.port", Message: "must differ from the server port"},
+	}
+	if diff := cmp.Diff(want, cfg.Validate(config.NewPath("server"))); diff != "" {
+		t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+	}
+
+	cfg.Middleware.Metrics.Enabled = false
+	if errs := cfg.Validate(config.NewPath("server")); errs != nil {
+		t.Errorf("disabled metrics are not checked: %v", errs)
+	}
+}
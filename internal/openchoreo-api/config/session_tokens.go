@@ -0,0 +1,70 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/session"
+)
+
+// maxSessionTokenTTL bounds how long namespace visibility evaluated at exchange time is trusted.
+const maxSessionTokenTTL = time.Hour
+
+// SessionTokensConfig defines the console session token exchange.
+// When enabled, clients can trade an IdP access token for a short-lived session token at
+// /api/v1/authn/token-exchange. The session token embeds the namespaces the subject may view,
+// evaluated once at exchange time, and namespace visibility checks for requests made with it
+// are answered from the token instead of the authorization engine. Role changes therefore
+// take effect for namespace visibility only when a new token is exchanged.
+type SessionTokensConfig struct {
+	// Enabled turns on the token exchange endpoint and accepts session tokens.
+	Enabled bool `koanf:"enabled"`
+	// Issuer is the iss claim of session tokens. It must differ from the IdP issuer.
+	Issuer string `koanf:"issuer"`
+	// SigningKey is the HMAC-SHA256 key used to sign session tokens (at least 32 bytes).
+	// All API replicas must share the same key.
+	SigningKey string `koanf:"signing_key"`
+	// TTL is the lifetime of a session token.
+	TTL time.Duration `koanf:"ttl"`
+}
+
+// SessionTokensDefaults returns the default session token configuration.
+func SessionTokensDefaults() SessionTokensConfig {
+	return SessionTokensConfig{
+		Enabled: false,
+		Issuer:  "openchoreo-api-session",
+		TTL:     5 * time.Minute,
+	}
+}
+
+// Validate validates the session token configuration.
+func (c *SessionTokensConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustNotBeEmpty(path.Child("issuer"), c.Issuer); err != nil {
+		errs = append(errs, err)
+	}
+	if len(c.SigningKey) < session.MinSigningKeyLength {
+		errs = append(errs, config.Invalid(path.Child("signing_key"),
+			fmt.Sprintf("must be at least %d bytes", session.MinSigningKeyLength)))
+	}
+	if err := config.MustBeInRange(path.Child("ttl"), c.TTL, time.Second, maxSessionTokenTTL); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// NewIssuer creates the session token issuer, or returns nil when session tokens are disabled.
+func (c *SessionTokensConfig) NewIssuer() (*session.Issuer, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+	return session.NewIssuer(c.Issuer, []byte(c.SigningKey), c.TTL)
+}
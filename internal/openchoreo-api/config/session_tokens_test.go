@@ -0,0 +1,73 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestSessionTokensConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(*SessionTokensConfig)
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:   "valid config",
+			mutate: func(*SessionTokensConfig) {},
+		},
+		{
+			name: "disabled config is not validated",
+			mutate: func(c *SessionTokensConfig) {
+				*c = SessionTokensDefaults()
+			},
+		},
+		{
+			name: "invalid fields",
+			mutate: func(c *SessionTokensConfig) {
+				c.Issuer = ""
+				c.SigningKey = "too-short"
+				c.TTL = 2 * time.Hour
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "security.session_tokens.issuer", Message: "must not be empty"},
+				{Field: "security.session_tokens.signing_key", Message: "must be at least 32 bytes"},
+				{Field: "security.session_tokens.ttl", Message: "must be between 1s and 1h0m0s"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := SessionTokensDefaults()
+			cfg.Enabled = true
+			cfg.SigningKey = "0123456789abcdef0123456789abcdef"
+			tt.mutate(&cfg)
+			errs := cfg.Validate(config.NewPath("security").Child("session_tokens"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSessionTokensConfig_NewIssuer(t *testing.T) {
+	cfg := SessionTokensDefaults()
+	issuer, err := cfg.NewIssuer()
+	if err != nil || issuer != nil {
+		t.Fatalf("NewIssuer() = %v, %v; want nil issuer when disabled", issuer, err)
+	}
+
+	cfg.Enabled = true
+	cfg.SigningKey = "0123456789abcdef0123456789abcdef"
+	issuer, err = cfg.NewIssuer()
+	if err != nil || issuer == nil {
+		t.Fatalf("NewIssuer() = %v, %v; want issuer", issuer, err)
+	}
+}
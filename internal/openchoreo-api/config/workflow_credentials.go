@@ -0,0 +1,171 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/workflowcredentials"
+)
+
+// maxRegistryTokenTTL bounds how long a registry push token outlives the exchange.
+const maxRegistryTokenTTL = time.Hour
+
+// WorkflowCredentialsConfig defines the credential broker for workflow runs.
+// When enabled, workflow runs exchange the broker token issued by the controller manager at
+// /api/v1/namespaces/{namespaceName}/workflowruns/{runName}/credentials for short-lived
+// credentials scoped to a single repository. The endpoint is authenticated by the broker
+// token alone, so it is reachable without an IdP token.
+type WorkflowCredentialsConfig struct {
+	// Enabled turns on the credential exchange endpoint.
+	Enabled bool `koanf:"enabled"`
+	// Registry defines the issuer of RegistryPush credentials.
+	Registry WorkflowCredentialsRegistryConfig `koanf:"registry"`
+	// GitHubApp defines the issuer of GitRead credentials.
+	GitHubApp WorkflowCredentialsGitHubAppConfig `koanf:"github_app"`
+}
+
+// WorkflowCredentialsRegistryConfig defines the registry token issuer. The registry must be
+// configured for token authentication with this issuer and the public key of PrivateKeyFile.
+type WorkflowCredentialsRegistryConfig struct {
+	// Enabled turns on RegistryPush credentials.
+	Enabled bool `koanf:"enabled"`
+	// Issuer is the iss claim the registry trusts.
+	Issuer string `koanf:"issuer"`
+	// Service is the service name of the registry, used as the aud claim.
+	Service string `koanf:"service"`
+	// KeyID is the kid header of issued tokens.
+	KeyID string `koanf:"key_id"`
+	// PrivateKeyFile is the PEM-encoded RSA or ECDSA key that signs registry tokens.
+	PrivateKeyFile string `koanf:"private_key_file"`
+	// TTL is the lifetime of a registry token.
+	TTL time.Duration `koanf:"ttl"`
+}
+
+// WorkflowCredentialsGitHubAppConfig defines the GitHub App whose installation tokens are
+// issued as GitRead credentials.
+type WorkflowCredentialsGitHubAppConfig struct {
+	// Enabled turns on GitRead credentials.
+	Enabled bool `koanf:"enabled"`
+	// APIURL is the GitHub REST API URL. Set it for GitHub Enterprise Server.
+	APIURL string `koanf:"api_url"`
+	// AppID is the ID of the GitHub App.
+	AppID int64 `koanf:"app_id"`
+	// InstallationID is the installation of the app that can read the build repositories.
+	InstallationID int64 `koanf:"installation_id"`
+	// PrivateKeyFile is the PEM-encoded private key of the GitHub App.
+	PrivateKeyFile string `koanf:"private_key_file"`
+	// Timeout bounds each request to the GitHub API.
+	Timeout time.Duration `koanf:"timeout"`
+}
+
+// WorkflowCredentialsDefaults returns the default workflow credentials configuration.
+func WorkflowCredentialsDefaults() WorkflowCredentialsConfig {
+	return WorkflowCredentialsConfig{
+		Enabled: false,
+		Registry: WorkflowCredentialsRegistryConfig{
+			Issuer: "openchoreo-workflow-credentials",
+			TTL:    15 * time.Minute,
+		},
+		GitHubApp: WorkflowCredentialsGitHubAppConfig{
+			APIURL:  workflowcredentials.DefaultGitHubAPIURL,
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Validate validates the workflow credentials configuration. Nothing is checked while the
+// broker is disabled.
+func (c *WorkflowCredentialsConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+	if !c.Enabled {
+		return errs
+	}
+	if !c.Registry.Enabled && !c.GitHubApp.Enabled {
+		errs = append(errs, config.Invalid(path, "at least one of registry or github_app must be enabled"))
+	}
+	if c.Registry.Enabled {
+		errs = append(errs, c.Registry.validate(path.Child("registry"))...)
+	}
+	if c.GitHubApp.Enabled {
+		errs = append(errs, c.GitHubApp.validate(path.Child("github_app"))...)
+	}
+	return errs
+}
+
+func (c *WorkflowCredentialsRegistryConfig) validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+	if err := config.MustNotBeEmpty(path.Child("issuer"), c.Issuer); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustNotBeEmpty(path.Child("service"), c.Service); err != nil {
+		errs = append(errs, err)
+	}
+	if c.PrivateKeyFile == "" {
+		errs = append(errs, config.Required(path.Child("private_key_file")))
+	}
+	if err := config.MustBeInRange(path.Child("ttl"), c.TTL, time.Minute, maxRegistryTokenTTL); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (c *WorkflowCredentialsGitHubAppConfig) validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+	if err := validateURL(path.Child("api_url"), c.APIURL, "http", "https"); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeGreaterThan(path.Child("app_id"), c.AppID, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeGreaterThan(path.Child("installation_id"), c.InstallationID, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if c.PrivateKeyFile == "" {
+		errs = append(errs, config.Required(path.Child("private_key_file")))
+	}
+	if err := config.MustBeNonNegative(path.Child("timeout"), c.Timeout); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// NewRegistryIssuer creates the registry token issuer, or returns nil when RegistryPush
+// credentials are disabled.
+func (c *WorkflowCredentialsRegistryConfig) NewRegistryIssuer() (*workflowcredentials.RegistryIssuer, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+	key, err := os.ReadFile(c.PrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry token signing key: %w", err)
+	}
+	return workflowcredentials.NewRegistryIssuer(workflowcredentials.RegistryIssuerConfig{
+		Issuer:  c.Issuer,
+		Service: c.Service,
+		KeyID:   c.KeyID,
+		TTL:     c.TTL,
+	}, key)
+}
+
+// NewGitHubAppIssuer creates the GitHub App installation token issuer, or returns nil when
+// GitRead credentials are disabled.
+func (c *WorkflowCredentialsGitHubAppConfig) NewGitHubAppIssuer() (*workflowcredentials.GitHubAppIssuer, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+	key, err := os.ReadFile(c.PrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GitHub App private key: %w", err)
+	}
+	return workflowcredentials.NewGitHubAppIssuer(workflowcredentials.GitHubAppIssuerConfig{
+		APIURL:         c.APIURL,
+		AppID:          c.AppID,
+		InstallationID: c.InstallationID,
+		Timeout:        c.Timeout,
+	}, key)
+}
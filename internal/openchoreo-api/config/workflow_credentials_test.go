@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestWorkflowCredentialsConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(*WorkflowCredentialsConfig)
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:   "valid config",
+			mutate: func(*WorkflowCredentialsConfig) {},
+		},
+		{
+			name: "disabled config is not validated",
+			mutate: func(c *WorkflowCredentialsConfig) {
+				*c = WorkflowCredentialsDefaults()
+			},
+		},
+		{
+			name: "no credential type enabled",
+			mutate: func(c *WorkflowCredentialsConfig) {
+				c.Registry.Enabled = false
+				c.GitHubApp.Enabled = false
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "workflow_credentials", Message: "at least one of registry or github_app must be enabled"},
+			},
+		},
+		{
+			name: "invalid fields",
+			mutate: func(c *WorkflowCredentialsConfig) {
+				c.Registry.Service = ""
+				c.Registry.PrivateKeyFile = ""
+				c.Registry.TTL = 2 * time.Hour
+				c.GitHubApp.APIURL = "ftp://github.example.com"
+				c.GitHubApp.InstallationID = 0
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "workflow_credentials.registry.service", Message: "must not be empty"},
+				{Field: "workflow_credentials.registry.private_key_file", Message: "is required"},
+				{Field: "workflow_credentials.registry.ttl", Message: "must be between 1m0s and 1h0m0s"},
+				{Field: "workflow_credentials.github_app.api_url", Message: "must be one of: http, https"},
+				{Field: "workflow_credentials.github_app.installation_id", Message: "must be greater than 0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := WorkflowCredentialsDefaults()
+			cfg.Enabled = true
+			cfg.Registry.Enabled = true
+			cfg.Registry.Service = "registry.example.com"
+			cfg.Registry.PrivateKeyFile = "/etc/openchoreo/registry-token.key"
+			cfg.GitHubApp.Enabled = true
+			cfg.GitHubApp.AppID = 7
+			cfg.GitHubApp.InstallationID = 42
+			cfg.GitHubApp.PrivateKeyFile = "/etc/openchoreo/github-app.pem"
+			tt.mutate(&cfg)
+			errs := cfg.Validate(config.NewPath("workflow_credentials"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -94,7 +94,9 @@ func (h *MCPHandler) CreateComponent(
 }
 
 func (h *MCPHandler) ListComponents(ctx context.Context, namespaceName, projectName string, opts tools.ListOpts) (any, error) {
-	result, err := h.services.ComponentService.ListComponents(ctx, namespaceName, projectName, toServiceListOptions(opts))
+	listOpts := toServiceListOptions(opts)
+	listOpts.LabelSelector = componentsvc.ExcludeArchived(listOpts.LabelSelector)
+	result, err := h.services.ComponentService.ListComponents(ctx, namespaceName, projectName, listOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -751,6 +753,7 @@ func (h *MCPHandler) TriggerWorkflowRun(
 			Workflow: openchoreov1alpha1.WorkflowRunConfig{
 				Kind:       component.Spec.Workflow.Kind,
 				Name:       component.Spec.Workflow.Name,
+				Version:    component.Spec.Workflow.Version,
 				Parameters: parameters,
 			},
 		},
@@ -6,7 +6,9 @@ package models
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -72,6 +74,27 @@ type PromoteComponentRequest struct {
 	// TODO Support overrides for the target environment
 }
 
+// ProjectPromotionRequest promotes the components of a project from a source environment
+type ProjectPromotionRequest struct {
+	SourceEnvironment  string   `json:"sourceEnvironment"`
+	TargetEnvironments []string `json:"targetEnvironments,omitempty"` // All targets of the promotion path when empty
+	Components         []string `json:"components,omitempty"`         // All components of the project when empty
+	RollbackOnFailure  bool     `json:"rollbackOnFailure,omitempty"`
+}
+
+// CreateDomainMappingRequest attaches a custom domain to a public endpoint of a component
+type CreateDomainMappingRequest struct {
+	Name        string `json:"name,omitempty"` // Derived from the component and the domain when empty
+	Environment string `json:"environment"`
+	Endpoint    string `json:"endpoint"`
+	Domain      string `json:"domain"`
+}
+
+// SetEnvironmentSecretsRequest sets the secret values of a component in an environment
+type SetEnvironmentSecretsRequest struct {
+	Data map[string]string `json:"data"` // Final state; keys not present are removed
+}
+
 // PatchComponentRequest represents the request to patch a Component
 type PatchComponentRequest struct {
 	// DisplayName is a human-readable display name
@@ -559,3 +582,138 @@ func (req *CreateWorkflowRunRequest) Validate() error {
 func (req *CreateWorkflowRunRequest) Sanitize() {
 	req.WorkflowName = strings.TrimSpace(req.WorkflowName)
 }
+
+// DeliveryMetricsRequest selects the deployments included in a project delivery metrics report
+type DeliveryMetricsRequest struct {
+	// Environment restricts the report to a single environment. When empty, all
+	// environments marked as production are included.
+	Environment string
+	// Window is the length of the reporting window ending now. Zero selects the default window.
+	Window time.Duration
+}
+
+// SearchRequest is a free-text search with optional filters and facets
+type SearchRequest struct {
+	// Query is matched term by term against names, display names, descriptions, labels
+	// and endpoint hosts. An empty query matches everything.
+	Query string
+	// Kind is the kind of resource to search. Only "component" is supported.
+	Kind string
+	// Namespace restricts the search to a namespace. When empty, all namespaces are searched.
+	Namespace string
+	// Project, Type, Environment, Team, Domain and Tier filter the results by exact value.
+	Project     string
+	Type        string
+	Environment string
+	Team        string
+	Domain      string
+	Tier        string
+	// Facets lists the facets to count over the results (type, project, env, team, domain, tier).
+	Facets []string
+	// Limit caps the number of returned results. Zero returns all results.
+	Limit int
+}
+
+// Kinds of resources that can be looked up by name, uid or external ID
+const (
+	LookupKindProject     = "project"
+	LookupKindComponent   = "component"
+	LookupKindEnvironment = "environment"
+	LookupKindDataPlane   = "dataplane"
+)
+
+// ResourceLookupRequest finds a single resource by exactly one of Name, UID or ExternalID
+type ResourceLookupRequest struct {
+	Namespace  string
+	Kind       string
+	Name       string
+	UID        string
+	ExternalID string // value of the openchoreo.dev/external-id annotation
+}
+
+// Debug credential access levels
+const (
+	DebugAccessReadOnly = "read-only"
+	DebugAccessLogsExec = "logs-exec"
+)
+
+// Debug credential lifetime bounds. The lower bound is the minimum token lifetime accepted
+// by the Kubernetes TokenRequest API.
+const (
+	DefaultDebugCredentialTTL = time.Hour
+	MinDebugCredentialTTL     = 10 * time.Minute
+	MaxDebugCredentialTTL     = 8 * time.Hour
+)
+
+// DebugCredentialRequest asks for a short-lived credential scoped to a release binding
+type DebugCredentialRequest struct {
+	// Access is DebugAccessReadOnly or DebugAccessLogsExec. Empty selects read-only.
+	Access string
+	// TTL is the token lifetime. Zero selects DefaultDebugCredentialTTL.
+	TTL time.Duration
+	// APIServerURL is the data plane API server URL. When set, a kubeconfig is rendered.
+	APIServerURL string
+}
+
+// Validate validates the DebugCredentialRequest and applies defaults
+func (req *DebugCredentialRequest) Validate() error {
+	if req.Access == "" {
+		req.Access = DebugAccessReadOnly
+	}
+	if req.Access != DebugAccessReadOnly && req.Access != DebugAccessLogsExec {
+		return fmt.Errorf("access must be %q or %q", DebugAccessReadOnly, DebugAccessLogsExec)
+	}
+	if req.TTL == 0 {
+		req.TTL = DefaultDebugCredentialTTL
+	}
+	if req.TTL < MinDebugCredentialTTL || req.TTL > MaxDebugCredentialTTL {
+		return fmt.Errorf("ttlSeconds must be between %d and %d",
+			int64(MinDebugCredentialTTL.Seconds()), int64(MaxDebugCredentialTTL.Seconds()))
+	}
+	if req.APIServerURL != "" {
+		u, err := url.Parse(req.APIServerURL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return errors.New("apiServerUrl must be an https URL")
+		}
+	}
+	return nil
+}
+
+// Run job lifetime bounds. Finished run jobs and their pods are garbage collected after TTLAfterFinished.
+const (
+	DefaultRunJobTTLAfterFinished = time.Hour
+	MaxRunJobTTLAfterFinished     = 7 * 24 * time.Hour
+)
+
+// RunJobRequest asks for a one-off Job run with the image and environment of a release binding's workload
+type RunJobRequest struct {
+	// Container is the workload container to run. Empty selects the first container.
+	Container string
+	// Command overrides the container entrypoint when set.
+	Command []string
+	// Args overrides the container arguments when set.
+	Args []string
+	// TTLAfterFinished is how long the finished Job is kept. Zero selects DefaultRunJobTTLAfterFinished.
+	TTLAfterFinished time.Duration
+}
+
+// OverridesCommand reports whether the request replaces the container's command or arguments
+func (req *RunJobRequest) OverridesCommand() bool {
+	return len(req.Command) > 0 || len(req.Args) > 0
+}
+
+// Validate validates the RunJobRequest and applies defaults
+func (req *RunJobRequest) Validate() error {
+	if req.Container != "" {
+		if errs := validation.IsDNS1123Label(req.Container); len(errs) > 0 {
+			return fmt.Errorf("container must be a valid container name: %s", strings.Join(errs, ", "))
+		}
+	}
+	if req.TTLAfterFinished == 0 {
+		req.TTLAfterFinished = DefaultRunJobTTLAfterFinished
+	}
+	if req.TTLAfterFinished < 0 || req.TTLAfterFinished > MaxRunJobTTLAfterFinished {
+		return fmt.Errorf("ttlSecondsAfterFinished must be between 0 and %d", int64(MaxRunJobTTLAfterFinished.Seconds()))
+	}
+	return nil
+}
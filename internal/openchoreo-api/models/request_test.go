@@ -6,6 +6,7 @@ package models
 import (
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestDeployReleaseRequest_Sanitize(t *testing.T) {
@@ -186,6 +187,80 @@ func TestUpdateBindingRequest_Validate(t *testing.T) {
 	}
 }
 
+func TestDebugCredentialRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     DebugCredentialRequest
+		wantErr string
+	}{
+		{name: "Defaults", req: DebugCredentialRequest{}},
+		{name: "Logs and exec with kubeconfig", req: DebugCredentialRequest{
+			Access: DebugAccessLogsExec, TTL: 2 * time.Hour, APIServerURL: "https://dp.example.com:6443",
+		}},
+		{name: "Unknown access", req: DebugCredentialRequest{Access: "admin"},
+			wantErr: `access must be "read-only" or "logs-exec"`},
+		{name: "TTL too short", req: DebugCredentialRequest{TTL: time.Minute},
+			wantErr: "ttlSeconds must be between 600 and 28800"},
+		{name: "TTL too long", req: DebugCredentialRequest{TTL: 24 * time.Hour},
+			wantErr: "ttlSeconds must be between 600 and 28800"},
+		{name: "Plain HTTP API server", req: DebugCredentialRequest{APIServerURL: "http://dp.example.com"},
+			wantErr: "apiServerUrl must be an https URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Validate() unexpected error = %v", err)
+			}
+			if tt.req.Access == "" || tt.req.TTL == 0 {
+				t.Errorf("Validate() did not apply defaults: %+v", tt.req)
+			}
+		})
+	}
+}
+
+func TestRunJobRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     RunJobRequest
+		wantErr string
+	}{
+		{name: "Defaults", req: RunJobRequest{}},
+		{name: "Container and command override", req: RunJobRequest{
+			Container: "main", Command: []string{"./manage.py"}, Args: []string{"migrate"}, TTLAfterFinished: time.Minute,
+		}},
+		{name: "Invalid container name", req: RunJobRequest{Container: "Main_Container"},
+			wantErr: "container must be a valid container name"},
+		{name: "TTL too long", req: RunJobRequest{TTLAfterFinished: 30 * 24 * time.Hour},
+			wantErr: "ttlSecondsAfterFinished must be between 0 and 604800"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Validate() unexpected error = %v", err)
+			}
+			if tt.req.TTLAfterFinished == 0 {
+				t.Errorf("Validate() did not apply defaults: %+v", tt.req)
+			}
+		})
+	}
+}
+
 func TestPromoteComponentRequest_Sanitize(t *testing.T) {
 	tests := []struct {
 		name      string
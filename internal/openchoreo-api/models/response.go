@@ -7,6 +7,7 @@ import (
 	"time"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/dora"
 )
 
 // APIResponse represents a standard API response wrapper
@@ -448,6 +449,25 @@ type CronJobTriggerResponse struct {
 	CronJobName string `json:"cronJobName"`
 }
 
+// RunJobResponse describes the one-off Job created from a release binding's workload.
+type RunJobResponse struct {
+	JobName      string `json:"jobName"`
+	Namespace    string `json:"namespace"`
+	WorkloadKind string `json:"workloadKind"`
+	WorkloadName string `json:"workloadName"`
+	Container    string `json:"container"`
+}
+
+// DebugCredentialResponse is a short-lived data plane credential minted for a release binding.
+type DebugCredentialResponse struct {
+	Namespace      string    `json:"namespace"`
+	ServiceAccount string    `json:"serviceAccount"`
+	Access         string    `json:"access"`
+	Token          string    `json:"token"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	Kubeconfig     string    `json:"kubeconfig,omitempty"`
+}
+
 // SecretReferenceResponse represents a SecretReference in API responses
 type SecretReferenceResponse struct {
 	Name            string                 `json:"name"`
@@ -581,6 +601,50 @@ type WorkflowStepStatus struct {
 	FinishedAt *time.Time `json:"finishedAt"` // When step finished
 }
 
+// WorkflowRunProgressResponse represents the step timeline of a workflow run
+type WorkflowRunProgressResponse struct {
+	Status          string                 `json:"status"`                    // Overall workflow status (Pending/Running/Succeeded/Failed)
+	Steps           []WorkflowStepProgress `json:"steps"`                     // Steps in execution order
+	CompletedSteps  int                    `json:"completedSteps"`            // Steps that reached a terminal phase
+	TotalSteps      int                    `json:"totalSteps"`                // Steps known so far
+	StartedAt       *time.Time             `json:"startedAt,omitempty"`       // When the run started
+	FinishedAt      *time.Time             `json:"finishedAt,omitempty"`      // When the run finished
+	DurationSeconds *float64               `json:"durationSeconds,omitempty"` // Run duration, or elapsed time while running
+}
+
+// WorkflowStepProgress represents a workflow step mapped to a pipeline stage
+type WorkflowStepProgress struct {
+	Name            string     `json:"name"`                      // Step name
+	Stage           string     `json:"stage"`                     // Pipeline stage (clone|build|test|push|other)
+	Phase           string     `json:"phase"`                     // Step phase (Pending|Running|Succeeded|Failed|Skipped|Error)
+	StartedAt       *time.Time `json:"startedAt,omitempty"`       // When step started
+	FinishedAt      *time.Time `json:"finishedAt,omitempty"`      // When step finished
+	DurationSeconds *float64   `json:"durationSeconds,omitempty"` // Step duration, or elapsed time while running
+}
+
+// WorkflowRunTestResultsResponse represents the test results of a workflow run
+type WorkflowRunTestResultsResponse struct {
+	RunName     string                                  `json:"runName"`           // Workflow run name
+	Results     *openchoreov1alpha1.WorkflowTestResults `json:"results,omitempty"` // Collected results, nil until the test step finishes
+	FlakyTests  []FlakyTest                             `json:"flakyTests"`        // Test cases with inconsistent results across recent runs
+	HistoryRuns int                                     `json:"historyRuns"`       // Runs with test results covered by the flaky history
+}
+
+// FlakyTest represents a test case that both failed and passed across recent runs
+type FlakyTest struct {
+	Suite         string `json:"suite"`                   // Test suite name
+	Name          string `json:"name"`                    // Test case name
+	FailedRuns    int    `json:"failedRuns"`              // Runs in which the test case failed
+	TotalRuns     int    `json:"totalRuns"`               // Runs in the history
+	LastFailedRun string `json:"lastFailedRun,omitempty"` // Most recent run in which the test case failed
+}
+
+// WorkflowRunFindingsResponse represents the static analysis findings of a workflow run
+type WorkflowRunFindingsResponse struct {
+	RunName string                                      `json:"runName"`           // Workflow run name
+	Results *openchoreov1alpha1.WorkflowAnalysisResults `json:"results,omitempty"` // Collected findings, nil until the analysis step finishes
+}
+
 // WorkflowRunLogEntry represents a log entry from a workflow run
 type WorkflowRunLogEntry struct {
 	Timestamp string `json:"timestamp,omitempty"`
@@ -594,3 +658,422 @@ type WorkflowRunEventEntry struct {
 	Reason    string `json:"reason"`
 	Message   string `json:"message"`
 }
+
+// BuildQuotaResponse represents the build quota usage of a namespace
+type BuildQuotaResponse struct {
+	Namespace      string                    `json:"namespace"`
+	MaxConcurrent  *int32                    `json:"maxConcurrentBuilds,omitempty"` // Namespace limit on active component builds, unset when unlimited
+	ActiveBuilds   int32                     `json:"activeBuilds"`                  // Component builds of the namespace that are pending or running
+	WorkflowPlanes []WorkflowPlaneQueueUsage `json:"workflowPlanes"`                // Build queues of the planes available to the namespace
+}
+
+// WorkflowPlaneQueueUsage represents the build queue state of a workflow plane
+type WorkflowPlaneQueueUsage struct {
+	Kind              string `json:"kind"`                        // WorkflowPlane or ClusterWorkflowPlane
+	Name              string `json:"name"`                        // Plane name
+	MaxConcurrentRuns *int32 `json:"maxConcurrentRuns,omitempty"` // Plane limit on executing runs, unset when unlimited
+	MaxQueuedRuns     *int32 `json:"maxQueuedRuns,omitempty"`     // Plane limit on queued runs, unset when unlimited
+	RunningRuns       int32  `json:"runningRuns"`                 // Runs admitted by the plane's queue
+	QueuedRuns        int32  `json:"queuedRuns"`                  // Runs waiting in the plane's queue
+}
+
+// ProjectDeliveryMetricsResponse represents the DORA delivery metrics report for a project
+type ProjectDeliveryMetricsResponse struct {
+	dora.Metrics
+	Project      string
+	Environments []string
+}
+
+// HistoricalDeploymentStatus is the status of a deployment at a point in time
+type HistoricalDeploymentStatus string
+
+const (
+	HistoricalDeploymentReady       HistoricalDeploymentStatus = "Ready"
+	HistoricalDeploymentProgressing HistoricalDeploymentStatus = "Progressing"
+	HistoricalDeploymentFailed      HistoricalDeploymentStatus = "Failed"
+	HistoricalDeploymentNotDeployed HistoricalDeploymentStatus = "NotDeployed"
+	HistoricalDeploymentUnknown     HistoricalDeploymentStatus = "Unknown"
+)
+
+// ProjectDeploymentStateResponse represents which release was deployed for each component
+// of a project in each environment at a point in time. It is reconstructed from the deployment
+// history of the project's live ReleaseBindings, so environments whose binding has since been
+// deleted are not included.
+type ProjectDeploymentStateResponse struct {
+	Project     string                 `json:"project"`
+	At          time.Time              `json:"at"`
+	Deployments []HistoricalDeployment `json:"deployments"`
+}
+
+// HistoricalDeployment represents the deployment of a component in an environment at a point in time
+type HistoricalDeployment struct {
+	Component      string                     `json:"component"`
+	Environment    string                     `json:"environment"`
+	ReleaseBinding string                     `json:"releaseBinding"`
+	Status         HistoricalDeploymentStatus `json:"status"`
+	Release        string                     `json:"release,omitempty"`
+	DeployedAt     *time.Time                 `json:"deployedAt,omitempty"`
+}
+
+// SearchResponse represents ranked search results with facet counts
+type SearchResponse struct {
+	Items  []SearchHit             `json:"items"`            // Results, best match first
+	Total  int                     `json:"total"`            // Number of matching results before the limit
+	Facets map[string][]FacetCount `json:"facets,omitempty"` // Counts per requested facet
+}
+
+// SearchHit represents a single search result
+type SearchHit struct {
+	Kind          string   `json:"kind"`
+	Namespace     string   `json:"namespace"`
+	Project       string   `json:"project"`
+	Name          string   `json:"name"`
+	DisplayName   string   `json:"displayName,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	Type          string   `json:"type,omitempty"`
+	Team          string   `json:"team,omitempty"`
+	Domain        string   `json:"domain,omitempty"`
+	Tier          string   `json:"tier,omitempty"`
+	Archived      bool     `json:"archived,omitempty"`
+	Environments  []string `json:"environments,omitempty"`  // Environments the component is bound to
+	Hosts         []string `json:"hosts,omitempty"`         // Hosts of the resolved endpoint URLs
+	Score         float64  `json:"score"`                   // Relevance score; zero for an empty query
+	MatchedFields []string `json:"matchedFields,omitempty"` // Fields that matched the query
+}
+
+// ResourceLookupResponse represents the identity of a resource found by name, uid or external ID
+type ResourceLookupResponse struct {
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	UID        string `json:"uid"`
+	ExternalID string `json:"externalId,omitempty"`
+	Project    string `json:"project,omitempty"` // Owning project, set for components
+}
+
+// FacetCount represents the number of results with a facet value
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// Directory sync change actions
+const (
+	DirectorySyncActionCreate = "create"
+	DirectorySyncActionUpdate = "update"
+	DirectorySyncActionDelete = "delete"
+)
+
+// Directory sync conflict types
+const (
+	// DirectorySyncConflictGroupNotFound reports a mapped group that is missing from the directory.
+	// Bindings previously synced for the group are retained.
+	DirectorySyncConflictGroupNotFound = "GroupNotFound"
+	// DirectorySyncConflictRoleNotFound reports a mapped role that does not exist.
+	// The mapping is skipped and bindings previously synced for it are retained.
+	DirectorySyncConflictRoleNotFound = "RoleNotFound"
+	// DirectorySyncConflictNameTaken reports a binding name that is used by a binding not managed by the sync.
+	DirectorySyncConflictNameTaken = "NameTaken"
+	// DirectorySyncConflictDenyBinding reports a deny binding for a synced user, which overrides the synced grants.
+	DirectorySyncConflictDenyBinding = "DenyBinding"
+)
+
+// DirectorySyncReport describes the changes of a directory sync run and the conflicts it found
+type DirectorySyncReport struct {
+	DryRun      bool                    `json:"dryRun"`
+	Source      string                  `json:"source"`
+	StartedAt   time.Time               `json:"startedAt"`
+	CompletedAt time.Time               `json:"completedAt"`
+	Groups      int                     `json:"groups"` // Mapped groups found in the directory
+	Users       int                     `json:"users"`  // Distinct users granted roles
+	Changes     []DirectorySyncChange   `json:"changes"`
+	Conflicts   []DirectorySyncConflict `json:"conflicts"`
+	Error       string                  `json:"error,omitempty"` // Set when the run failed
+}
+
+// DirectorySyncChange is a role binding created, updated or deleted by a directory sync
+type DirectorySyncChange struct {
+	Action    string `json:"action"`
+	Kind      string `json:"kind"` // AuthzRoleBinding or ClusterAuthzRoleBinding
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Group     string `json:"group"`
+	User      string `json:"user"`
+}
+
+// DirectorySyncConflict is a problem that prevented part of a directory sync from being applied
+type DirectorySyncConflict struct {
+	Type      string `json:"type"`
+	Group     string `json:"group,omitempty"`
+	User      string `json:"user,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Message   string `json:"message"`
+}
+
+// SessionToken is a short-lived OpenChoreo session token exchanged for an IdP access token
+type SessionToken struct {
+	AccessToken string    `json:"accessToken"`
+	TokenType   string    `json:"tokenType"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	ExpiresIn   int64     `json:"expiresIn"`  // Seconds until the token expires
+	Namespaces  []string  `json:"namespaces"` // Namespaces the subject may view, evaluated at exchange time
+}
+
+// WorkflowCredential is a short-lived credential exchanged for the broker token of a workflow run
+type WorkflowCredential struct {
+	Name             string    `json:"name"`
+	Type             string    `json:"type"`
+	Repository       string    `json:"repository"`
+	Username         string    `json:"username,omitempty"`
+	Token            string    `json:"token"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+	DockerConfigJSON string    `json:"dockerConfigJson,omitempty"` // Docker config.json for RegistryPush credentials
+}
+
+// Promotion phases, used both for a single target environment and for a whole promotion
+const (
+	// PromotionPhasePending means the target is not on the source release and no gate blocks it.
+	PromotionPhasePending = "Pending"
+	// PromotionPhaseBlocked means a gate or a deployment lock holds the target back.
+	PromotionPhaseBlocked = "Blocked"
+	// PromotionPhaseProgressing means the target is on the source release and rolling out.
+	PromotionPhaseProgressing = "Progressing"
+	// PromotionPhaseSucceeded means the target is on the source release and Ready.
+	PromotionPhaseSucceeded = "Succeeded"
+	// PromotionPhasePartiallyFailed is only used for a whole promotion: some targets failed
+	// or are blocked while others were promoted.
+	PromotionPhasePartiallyFailed = "PartiallyFailed"
+	// PromotionPhaseFailed means the promotion or the rollout of the target failed.
+	PromotionPhaseFailed = "Failed"
+	// PromotionPhaseRolledBack means a project promotion restored the previous release.
+	PromotionPhaseRolledBack = "RolledBack"
+	// PromotionPhaseSkipped is only used for a component of a project promotion that has no
+	// release in the source environment.
+	PromotionPhaseSkipped = "Skipped"
+)
+
+// PromotionStatus is the aggregated status of promoting a component from a source
+// environment to the target environments of its promotion path
+type PromotionStatus struct {
+	Component         string            `json:"component"`
+	Project           string            `json:"project"`
+	SourceEnvironment string            `json:"sourceEnvironment"`
+	Release           string            `json:"release,omitempty"` // Release bound in the source environment
+	Phase             string            `json:"phase"`
+	Targets           []PromotionTarget `json:"targets"`
+}
+
+// PromotionTarget is the promotion status of a single target environment
+type PromotionTarget struct {
+	Environment    string `json:"environment"`
+	ReleaseBinding string `json:"releaseBinding,omitempty"`
+	Release        string `json:"release,omitempty"` // Release currently bound in the target
+	Phase          string `json:"phase"`
+	Message        string `json:"message,omitempty"`
+}
+
+// ProjectPromotionStatus is the status of promoting the components of a project from a
+// source environment
+type ProjectPromotionStatus struct {
+	Project           string                     `json:"project"`
+	SourceEnvironment string                     `json:"sourceEnvironment"`
+	Phase             string                     `json:"phase"`
+	RolledBack        bool                       `json:"rolledBack"`
+	Components        []ComponentPromotionResult `json:"components"`
+}
+
+// ComponentPromotionResult is the promotion result of a single component of a project promotion
+type ComponentPromotionResult struct {
+	Component string            `json:"component"`
+	Release   string            `json:"release,omitempty"` // Release bound in the source environment
+	Phase     string            `json:"phase"`
+	Message   string            `json:"message,omitempty"`
+	Targets   []PromotionTarget `json:"targets"`
+}
+
+// RolloutProgress is the rollout progress of the workloads rendered for a release binding
+type RolloutProgress struct {
+	ReleaseBinding  string            `json:"releaseBinding"`
+	Environment     string            `json:"environment"`
+	Release         string            `json:"release,omitempty"` // Release being rolled out
+	DesiredReplicas int32             `json:"desiredReplicas"`
+	UpdatedReplicas int32             `json:"updatedReplicas"`
+	ReadyReplicas   int32             `json:"readyReplicas"`
+	Percentage      int32             `json:"percentage"`           // Updated and ready replicas as a percentage of desired replicas
+	ETASeconds      *int64            `json:"etaSeconds,omitempty"` // Omitted when the rate of pods becoming ready is not known yet
+	Complete        bool              `json:"complete"`
+	Workloads       []WorkloadRollout `json:"workloads"`
+}
+
+// WorkloadRollout is the rollout progress of a single rendered workload
+type WorkloadRollout struct {
+	Kind            string `json:"kind"`
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace,omitempty"`
+	DesiredReplicas int32  `json:"desiredReplicas"`
+	UpdatedReplicas int32  `json:"updatedReplicas"`
+	ReadyReplicas   int32  `json:"readyReplicas"`
+	CurrentStep     *int32 `json:"currentStep,omitempty"` // Canary step index, only for progressive rollouts
+	TotalSteps      *int32 `json:"totalSteps,omitempty"`
+}
+
+// StatusHistory is the condition transition timeline of a release binding and its rendered release
+type StatusHistory struct {
+	ReleaseBinding string                  `json:"releaseBinding"`
+	Environment    string                  `json:"environment"`
+	Transitions    []ConditionHistoryEntry `json:"transitions"` // Newest first
+}
+
+// ConditionHistoryEntry is a single condition transition of a release binding or rendered release
+type ConditionHistoryEntry struct {
+	SourceKind     string    `json:"sourceKind"` // ReleaseBinding or RenderedRelease
+	SourceName     string    `json:"sourceName"`
+	Type           string    `json:"type"`
+	Status         string    `json:"status"`
+	Reason         string    `json:"reason,omitempty"`
+	Message        string    `json:"message,omitempty"`
+	TransitionTime time.Time `json:"transitionTime"`
+}
+
+// ConfigDiff is the difference of the configuration a release binding resolves for the release
+// it currently deploys and the release it replaced
+type ConfigDiff struct {
+	ReleaseBinding string         `json:"releaseBinding"`
+	Environment    string         `json:"environment"`
+	FromRelease    string         `json:"fromRelease,omitempty"` // Empty when the current release is the first deployed
+	ToRelease      string         `json:"toRelease,omitempty"`   // Empty when no release has been deployed
+	Changes        []ConfigChange `json:"changes"`
+}
+
+// ConfigChange is a single change of an env var, file, parameter or trait instance
+type ConfigChange struct {
+	Path   string `json:"path"`
+	Type   string `json:"type"` // Added, Removed or Modified
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+	Masked bool   `json:"masked,omitempty"` // From and To are hidden because the value may be a secret
+}
+
+// ReleaseBindingRuntime is the runtime state of a release binding. Fields are nil when their
+// source is unavailable.
+type ReleaseBindingRuntime struct {
+	Replicas    *ReplicaCounts `json:"replicas,omitempty"`
+	RequestRate *float64       `json:"requestRate,omitempty"` // Requests per second over the traffic window
+	ErrorRate   *float64       `json:"errorRate,omitempty"`   // Ratio of unsuccessful requests over the traffic window
+}
+
+// ReplicaCounts counts the pods of the Deployments and StatefulSets of a release
+type ReplicaCounts struct {
+	Replicas          int `json:"replicas"`
+	ReadyReplicas     int `json:"readyReplicas"`
+	AvailableReplicas int `json:"availableReplicas"`
+}
+
+// QuarantinedResource is a resource the controller manager stopped reconciling because its
+// reconciles kept failing
+type QuarantinedResource struct {
+	Kind               string     `json:"kind"`
+	Name               string     `json:"name"`
+	Project            string     `json:"project,omitempty"`
+	Component          string     `json:"component,omitempty"`
+	Resource           string     `json:"resource,omitempty"`
+	Environment        string     `json:"environment,omitempty"`
+	QuarantinedAt      time.Time  `json:"quarantinedAt"`
+	Message            string     `json:"message"`
+	RequeueRequestedAt *time.Time `json:"requeueRequestedAt,omitempty"`
+}
+
+// DomainMapping is a custom domain attached to a public endpoint of a component in an
+// environment
+type DomainMapping struct {
+	Name         string              `json:"name"`
+	Project      string              `json:"project"`
+	Component    string              `json:"component"`
+	Environment  string              `json:"environment"`
+	Endpoint     string              `json:"endpoint"`
+	Domain       string              `json:"domain"`
+	Verification *DomainVerification `json:"verification,omitempty"`
+	Certificate  *DomainCertificate  `json:"certificate,omitempty"`
+	URL          string              `json:"url,omitempty"`
+	Ready        bool                `json:"ready"`
+	Reason       string              `json:"reason,omitempty"`
+	Message      string              `json:"message,omitempty"`
+	CreatedAt    time.Time           `json:"createdAt"`
+}
+
+// DomainVerification is the DNS TXT record that proves ownership of a custom domain
+type DomainVerification struct {
+	RecordName    string     `json:"recordName"`
+	RecordValue   string     `json:"recordValue"`
+	Verified      bool       `json:"verified"`
+	VerifiedAt    *time.Time `json:"verifiedAt,omitempty"`
+	LastCheckedAt *time.Time `json:"lastCheckedAt,omitempty"`
+}
+
+// DomainCertificate is the TLS certificate issued for a custom domain
+type DomainCertificate struct {
+	SecretName string     `json:"secretName"`
+	Ready      bool       `json:"ready"`
+	NotAfter   *time.Time `json:"notAfter,omitempty"`
+	Message    string     `json:"message,omitempty"`
+}
+
+// EnvironmentSecrets are the secret values a component is given in an environment. Only the
+// keys are exposed; the values stay in the secret store of the environment's data plane.
+type EnvironmentSecrets struct {
+	Component       string   `json:"component"`
+	Environment     string   `json:"environment"`
+	SecretReference string   `json:"secretReference"`
+	ReleaseBinding  string   `json:"releaseBinding,omitempty"`
+	Keys            []string `json:"keys"`
+}
+
+// ComponentReleaseDiff is the difference between two ComponentReleases of the same component
+type ComponentReleaseDiff struct {
+	Project       string                             `json:"project"`
+	Component     string                             `json:"component"`
+	BaseRelease   string                             `json:"baseRelease"`
+	TargetRelease string                             `json:"targetRelease"`
+	Changes       []openchoreov1alpha1.ReleaseChange `json:"changes"`
+	Environment   string                             `json:"environment,omitempty"`
+	Resources     []RenderedResourceDiff             `json:"resources,omitempty"`
+}
+
+// ComponentReleasePrune lists the ComponentReleases a prune deleted, or would delete in a dry run
+type ComponentReleasePrune struct {
+	Project   string   `json:"project"`
+	Component string   `json:"component"`
+	DryRun    bool     `json:"dryRun"`
+	Deleted   []string `json:"deleted"`
+	InUse     []string `json:"inUse"`
+}
+
+// RenderedComponentRelease is the resource set a ComponentRelease renders for an environment
+type RenderedComponentRelease struct {
+	Project     string `json:"project"`
+	Component   string `json:"component"`
+	Release     string `json:"release"`
+	Environment string `json:"environment"`
+	// InputsResolvedAt is when the environment inputs of the render were read. Only the
+	// release is a snapshot; the other inputs are the current ones.
+	InputsResolvedAt time.Time          `json:"inputsResolvedAt"`
+	Resources        []RenderedResource `json:"resources"`
+}
+
+// RenderedResource is a single resource rendered from a ComponentRelease
+type RenderedResource struct {
+	TargetPlane string         `json:"targetPlane"`
+	Resource    map[string]any `json:"resource"`
+}
+
+// RenderedResourceDiff is the difference of a single resource rendered from two ComponentReleases
+type RenderedResourceDiff struct {
+	APIVersion  string                               `json:"apiVersion"`
+	Kind        string                               `json:"kind"`
+	Name        string                               `json:"name"`
+	Namespace   string                               `json:"namespace,omitempty"`
+	TargetPlane string                               `json:"targetPlane"`
+	Type        openchoreov1alpha1.ReleaseChangeType `json:"type"`
+	Changes     []openchoreov1alpha1.ReleaseChange   `json:"changes,omitempty"`
+}
@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/session"
 )
 
 // CheckRequest represents a resource authorization check.
@@ -34,6 +36,13 @@ func NewAuthzChecker(pdp authz.PDP, logger *slog.Logger) *AuthzChecker {
 
 // Check performs a single authorization check.
 func (c *AuthzChecker) Check(ctx context.Context, req CheckRequest) error {
+	if allowed, ok := sessionDecision(ctx, req); ok {
+		if !allowed {
+			return ErrForbidden
+		}
+		return nil
+	}
+
 	authSubjectCtx, _ := auth.GetSubjectContextFromContext(ctx)
 	authzSubjectCtx := authz.GetAuthzSubjectContext(authSubjectCtx)
 
@@ -75,9 +84,18 @@ func (c *AuthzChecker) BatchCheck(ctx context.Context, requests []CheckRequest)
 	authSubjectCtx, _ := auth.GetSubjectContextFromContext(ctx)
 	authzSubjectCtx := authz.GetAuthzSubjectContext(authSubjectCtx)
 
-	evalRequests := make([]authz.EvaluateRequest, len(requests))
+	// Requests answered by the session token are not sent to the PDP; pending maps the
+	// evaluated requests back to their position in requests.
+	results := make([]bool, len(requests))
+	evalRequests := make([]authz.EvaluateRequest, 0, len(requests))
+	pending := make([]int, 0, len(requests))
 	for i, r := range requests {
-		evalRequests[i] = authz.EvaluateRequest{
+		if allowed, ok := sessionDecision(ctx, r); ok {
+			results[i] = allowed
+			continue
+		}
+		pending = append(pending, i)
+		evalRequests = append(evalRequests, authz.EvaluateRequest{
 			SubjectContext: authzSubjectCtx,
 			Action:         r.Action,
 			Resource: authz.Resource{
@@ -86,7 +104,10 @@ func (c *AuthzChecker) BatchCheck(ctx context.Context, requests []CheckRequest)
 				Hierarchy: r.Hierarchy,
 			},
 			Context: r.Context,
-		}
+		})
+	}
+	if len(evalRequests) == 0 {
+		return results, nil
 	}
 
 	resp, err := c.pdp.BatchEvaluate(ctx, &authz.BatchEvaluateRequest{Requests: evalRequests})
@@ -95,10 +116,26 @@ func (c *AuthzChecker) BatchCheck(ctx context.Context, requests []CheckRequest)
 		return nil, fmt.Errorf("batch authorization evaluation failed: %w", err)
 	}
 
-	results := make([]bool, len(resp.Decisions))
 	for i, d := range resp.Decisions {
-		results[i] = d.Decision
+		if i < len(pending) {
+			results[pending[i]] = d.Decision
+		}
 	}
 
 	return results, nil
 }
+
+// sessionDecision answers namespace view checks from the namespaces embedded in the session
+// token that authenticated the request. Those were evaluated by the PDP for the same action
+// and scope when the token was issued. ok is false when the PDP must be asked.
+func sessionDecision(ctx context.Context, req CheckRequest) (allowed, ok bool) {
+	if req.Action != authz.ActionViewNamespace || req.Context != (authz.Context{}) ||
+		req.Hierarchy != (authz.ResourceHierarchy{Namespace: req.Hierarchy.Namespace}) {
+		return false, false
+	}
+	namespaces, ok := session.NamespacesFromContext(ctx)
+	if !ok {
+		return false, false
+	}
+	return slices.Contains(namespaces, req.Hierarchy.Namespace), true
+}
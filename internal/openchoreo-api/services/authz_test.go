@@ -16,6 +16,7 @@ import (
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
 	authzmocks "github.com/openchoreo/openchoreo/internal/authz/core/mocks"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/session"
 )
 
 // ctxWithSubject returns a context with the given SubjectContext set.
@@ -198,3 +199,70 @@ func TestBatchCheck_SingleRequest(t *testing.T) {
 	require.Len(t, results, 1)
 	require.False(t, results[0], "expected results[0] to be false")
 }
+
+// ---------------------------------------------------------------------------
+// Session token tests
+// ---------------------------------------------------------------------------
+
+// ctxWithSession returns a context authenticated with a session token for the given namespaces.
+func ctxWithSession(namespaces ...string) context.Context {
+	return session.WithNamespaces(ctxWithSubject(testSubjectContext()), namespaces)
+}
+
+func namespaceViewRequest(ns string) CheckRequest {
+	return CheckRequest{
+		Action:       authz.ActionViewNamespace,
+		ResourceType: "namespace",
+		ResourceID:   ns,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: ns},
+	}
+}
+
+func TestCheck_SessionNamespaces(t *testing.T) {
+	// The mock PDP has no expectations: namespace view checks must not reach it.
+	checker := newTestChecker(authzmocks.NewMockPDP(t))
+	ctx := ctxWithSession("acme")
+
+	require.NoError(t, checker.Check(ctx, namespaceViewRequest("acme")))
+	require.ErrorIs(t, checker.Check(ctx, namespaceViewRequest("payments")), ErrForbidden)
+}
+
+func TestCheck_SessionOtherActionsUsePDP(t *testing.T) {
+	pdp := authzmocks.NewMockPDP(t)
+	pdp.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(&authz.Decision{Decision: false, Context: &authz.DecisionContext{Reason: "denied"}}, nil).Twice()
+	checker := newTestChecker(pdp)
+	ctx := ctxWithSession("ns-1")
+
+	// A different action in a namespace of the token is still evaluated.
+	require.ErrorIs(t, checker.Check(ctx, testCheckRequest()), ErrForbidden)
+
+	// Namespace view with a narrower hierarchy is still evaluated.
+	req := namespaceViewRequest("ns-1")
+	req.Hierarchy.Project = "my-project"
+	require.ErrorIs(t, checker.Check(ctx, req), ErrForbidden)
+}
+
+func TestBatchCheck_SessionNamespaces(t *testing.T) {
+	pdp := authzmocks.NewMockPDP(t)
+	pdp.EXPECT().BatchEvaluate(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *authz.BatchEvaluateRequest) (*authz.BatchEvaluateResponse, error) {
+			require.Len(t, req.Requests, 1, "only the project check should reach the PDP")
+			require.Equal(t, "project:view", req.Requests[0].Action)
+			return &authz.BatchEvaluateResponse{Decisions: []authz.Decision{{Decision: true}}}, nil
+		})
+	checker := newTestChecker(pdp)
+
+	requests := []CheckRequest{namespaceViewRequest("acme"), testCheckRequest(), namespaceViewRequest("payments")}
+	results, err := checker.BatchCheck(ctxWithSession("acme"), requests)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, false}, results)
+}
+
+func TestBatchCheck_SessionOnlyNamespaceViews(t *testing.T) {
+	checker := newTestChecker(authzmocks.NewMockPDP(t))
+
+	requests := []CheckRequest{namespaceViewRequest("acme"), namespaceViewRequest("payments")}
+	results, err := checker.BatchCheck(ctxWithSession("payments"), requests)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, true}, results)
+}
@@ -128,6 +128,12 @@ func (s *webhookProcessor) findAffectedComponents(ctx context.Context, event *gi
 			continue
 		}
 
+		// Archived components are never built
+		if comp.Spec.IsArchived() {
+			s.logger.Info("Skipping component: archived", "component", comp.Name)
+			continue
+		}
+
 		// Get repository URL, appPath, and branch from component workflow schema extensions
 		repoURL, appPath, branch, err := s.extractRepoInfoFromComponent(ctx, comp)
 		if err != nil {
@@ -197,9 +203,16 @@ func (s *webhookProcessor) extractRepoInfoFromComponent(ctx context.Context, com
 		return "", "", "", fmt.Errorf("component has no workflow configuration")
 	}
 
-	// Fetch the Workflow or ClusterWorkflow CR to get the schema
+	// Fetch the pinned workflow version, or the Workflow or ClusterWorkflow CR, to get the schema
 	var parametersSchema *v1alpha1.SchemaSection
-	if comp.Spec.Workflow.Kind == v1alpha1.WorkflowRefKindClusterWorkflow {
+	if version := comp.Spec.Workflow.Version; version != "" {
+		versionSpec, err := controller.ResolveWorkflowVersion(ctx, s.k8sClient, comp.Namespace,
+			comp.Spec.Workflow.Kind, comp.Spec.Workflow.Name, version)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to get version %s of workflow %s: %w", version, comp.Spec.Workflow.Name, err)
+		}
+		parametersSchema = versionSpec.Template.Parameters
+	} else if comp.Spec.Workflow.Kind == v1alpha1.WorkflowRefKindClusterWorkflow {
 		cw := &v1alpha1.ClusterWorkflow{}
 		if err := s.k8sClient.Get(ctx, client.ObjectKey{
 			Name: comp.Spec.Workflow.Name,
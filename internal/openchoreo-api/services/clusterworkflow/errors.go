@@ -9,4 +9,7 @@ var (
 	ErrClusterWorkflowNotFound      = errors.New("cluster workflow not found")
 	ErrClusterWorkflowAlreadyExists = errors.New("cluster workflow already exists")
 	ErrClusterWorkflowNil           = errors.New("cluster workflow cannot be nil")
+
+	ErrClusterWorkflowVersionNotFound      = errors.New("cluster workflow version not found")
+	ErrClusterWorkflowVersionAlreadyExists = errors.New("cluster workflow version already exists")
 )
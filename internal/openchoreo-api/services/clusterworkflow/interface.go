@@ -21,4 +21,8 @@ type Service interface {
 	GetClusterWorkflow(ctx context.Context, clusterWorkflowName string) (*openchoreov1alpha1.ClusterWorkflow, error)
 	DeleteClusterWorkflow(ctx context.Context, clusterWorkflowName string) error
 	GetClusterWorkflowSchema(ctx context.Context, clusterWorkflowName string) (map[string]any, error)
+
+	ListClusterWorkflowVersions(ctx context.Context, clusterWorkflowName string) ([]openchoreov1alpha1.ClusterWorkflowVersion, error)
+	PublishClusterWorkflowVersion(ctx context.Context, clusterWorkflowName, version string) (*openchoreov1alpha1.ClusterWorkflowVersion, error)
+	DeprecateClusterWorkflowVersion(ctx context.Context, clusterWorkflowName, version, message string) (*openchoreov1alpha1.ClusterWorkflowVersion, error)
 }
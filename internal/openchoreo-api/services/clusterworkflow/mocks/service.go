@@ -130,6 +130,67 @@ func (_c *MockService_DeleteClusterWorkflow_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// DeprecateClusterWorkflowVersion provides a mock function with given fields: ctx, clusterWorkflowName, version, message
+func (_m *MockService) DeprecateClusterWorkflowVersion(ctx context.Context, clusterWorkflowName string, version string, message string) (*v1alpha1.ClusterWorkflowVersion, error) {
+	ret := _m.Called(ctx, clusterWorkflowName, version, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeprecateClusterWorkflowVersion")
+	}
+
+	var r0 *v1alpha1.ClusterWorkflowVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*v1alpha1.ClusterWorkflowVersion, error)); ok {
+		return rf(ctx, clusterWorkflowName, version, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *v1alpha1.ClusterWorkflowVersion); ok {
+		r0 = rf(ctx, clusterWorkflowName, version, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ClusterWorkflowVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, clusterWorkflowName, version, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_DeprecateClusterWorkflowVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeprecateClusterWorkflowVersion'
+type MockService_DeprecateClusterWorkflowVersion_Call struct {
+	*mock.Call
+}
+
+// DeprecateClusterWorkflowVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clusterWorkflowName string
+//   - version string
+//   - message string
+func (_e *MockService_Expecter) DeprecateClusterWorkflowVersion(ctx interface{}, clusterWorkflowName interface{}, version interface{}, message interface{}) *MockService_DeprecateClusterWorkflowVersion_Call {
+	return &MockService_DeprecateClusterWorkflowVersion_Call{Call: _e.mock.On("DeprecateClusterWorkflowVersion", ctx, clusterWorkflowName, version, message)}
+}
+
+func (_c *MockService_DeprecateClusterWorkflowVersion_Call) Run(run func(ctx context.Context, clusterWorkflowName string, version string, message string)) *MockService_DeprecateClusterWorkflowVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeprecateClusterWorkflowVersion_Call) Return(_a0 *v1alpha1.ClusterWorkflowVersion, _a1 error) *MockService_DeprecateClusterWorkflowVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_DeprecateClusterWorkflowVersion_Call) RunAndReturn(run func(context.Context, string, string, string) (*v1alpha1.ClusterWorkflowVersion, error)) *MockService_DeprecateClusterWorkflowVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetClusterWorkflow provides a mock function with given fields: ctx, clusterWorkflowName
 func (_m *MockService) GetClusterWorkflow(ctx context.Context, clusterWorkflowName string) (*v1alpha1.ClusterWorkflow, error) {
 	ret := _m.Called(ctx, clusterWorkflowName)
@@ -248,6 +309,65 @@ func (_c *MockService_GetClusterWorkflowSchema_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ListClusterWorkflowVersions provides a mock function with given fields: ctx, clusterWorkflowName
+func (_m *MockService) ListClusterWorkflowVersions(ctx context.Context, clusterWorkflowName string) ([]v1alpha1.ClusterWorkflowVersion, error) {
+	ret := _m.Called(ctx, clusterWorkflowName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListClusterWorkflowVersions")
+	}
+
+	var r0 []v1alpha1.ClusterWorkflowVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]v1alpha1.ClusterWorkflowVersion, error)); ok {
+		return rf(ctx, clusterWorkflowName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []v1alpha1.ClusterWorkflowVersion); ok {
+		r0 = rf(ctx, clusterWorkflowName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v1alpha1.ClusterWorkflowVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, clusterWorkflowName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListClusterWorkflowVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListClusterWorkflowVersions'
+type MockService_ListClusterWorkflowVersions_Call struct {
+	*mock.Call
+}
+
+// ListClusterWorkflowVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clusterWorkflowName string
+func (_e *MockService_Expecter) ListClusterWorkflowVersions(ctx interface{}, clusterWorkflowName interface{}) *MockService_ListClusterWorkflowVersions_Call {
+	return &MockService_ListClusterWorkflowVersions_Call{Call: _e.mock.On("ListClusterWorkflowVersions", ctx, clusterWorkflowName)}
+}
+
+func (_c *MockService_ListClusterWorkflowVersions_Call) Run(run func(ctx context.Context, clusterWorkflowName string)) *MockService_ListClusterWorkflowVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListClusterWorkflowVersions_Call) Return(_a0 []v1alpha1.ClusterWorkflowVersion, _a1 error) *MockService_ListClusterWorkflowVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListClusterWorkflowVersions_Call) RunAndReturn(run func(context.Context, string) ([]v1alpha1.ClusterWorkflowVersion, error)) *MockService_ListClusterWorkflowVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListClusterWorkflows provides a mock function with given fields: ctx, opts
 func (_m *MockService) ListClusterWorkflows(ctx context.Context, opts services.ListOptions) (*services.ListResult[v1alpha1.ClusterWorkflow], error) {
 	ret := _m.Called(ctx, opts)
@@ -307,6 +427,66 @@ func (_c *MockService_ListClusterWorkflows_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// PublishClusterWorkflowVersion provides a mock function with given fields: ctx, clusterWorkflowName, version
+func (_m *MockService) PublishClusterWorkflowVersion(ctx context.Context, clusterWorkflowName string, version string) (*v1alpha1.ClusterWorkflowVersion, error) {
+	ret := _m.Called(ctx, clusterWorkflowName, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishClusterWorkflowVersion")
+	}
+
+	var r0 *v1alpha1.ClusterWorkflowVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.ClusterWorkflowVersion, error)); ok {
+		return rf(ctx, clusterWorkflowName, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.ClusterWorkflowVersion); ok {
+		r0 = rf(ctx, clusterWorkflowName, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ClusterWorkflowVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, clusterWorkflowName, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_PublishClusterWorkflowVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishClusterWorkflowVersion'
+type MockService_PublishClusterWorkflowVersion_Call struct {
+	*mock.Call
+}
+
+// PublishClusterWorkflowVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clusterWorkflowName string
+//   - version string
+func (_e *MockService_Expecter) PublishClusterWorkflowVersion(ctx interface{}, clusterWorkflowName interface{}, version interface{}) *MockService_PublishClusterWorkflowVersion_Call {
+	return &MockService_PublishClusterWorkflowVersion_Call{Call: _e.mock.On("PublishClusterWorkflowVersion", ctx, clusterWorkflowName, version)}
+}
+
+func (_c *MockService_PublishClusterWorkflowVersion_Call) Run(run func(ctx context.Context, clusterWorkflowName string, version string)) *MockService_PublishClusterWorkflowVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_PublishClusterWorkflowVersion_Call) Return(_a0 *v1alpha1.ClusterWorkflowVersion, _a1 error) *MockService_PublishClusterWorkflowVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_PublishClusterWorkflowVersion_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.ClusterWorkflowVersion, error)) *MockService_PublishClusterWorkflowVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateClusterWorkflow provides a mock function with given fields: ctx, cwf
 func (_m *MockService) UpdateClusterWorkflow(ctx context.Context, cwf *v1alpha1.ClusterWorkflow) (*v1alpha1.ClusterWorkflow, error) {
 	ret := _m.Called(ctx, cwf)
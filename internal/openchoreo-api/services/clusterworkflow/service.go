@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,6 +31,11 @@ var clusterWorkflowTypeMeta = metav1.TypeMeta{
 	Kind:       "ClusterWorkflow",
 }
 
+var clusterWorkflowVersionTypeMeta = metav1.TypeMeta{
+	APIVersion: openchoreov1alpha1.GroupVersion.String(),
+	Kind:       "ClusterWorkflowVersion",
+}
+
 // NewService creates a new cluster workflow service without authorization.
 func NewService(k8sClient client.Client, logger *slog.Logger) Service {
 	return &clusterWorkflowService{
@@ -191,3 +197,110 @@ func (s *clusterWorkflowService) GetClusterWorkflowSchema(ctx context.Context, c
 	s.logger.Debug("Retrieved cluster workflow schema successfully", "clusterWorkflow", clusterWorkflowName)
 	return rawSchema, nil
 }
+
+func (s *clusterWorkflowService) ListClusterWorkflowVersions(ctx context.Context, clusterWorkflowName string) ([]openchoreov1alpha1.ClusterWorkflowVersion, error) {
+	s.logger.Debug("Listing cluster workflow versions", "clusterWorkflow", clusterWorkflowName)
+
+	var list openchoreov1alpha1.ClusterWorkflowVersionList
+	if err := s.k8sClient.List(ctx, &list); err != nil {
+		s.logger.Error("Failed to list cluster workflow versions", "error", err)
+		return nil, fmt.Errorf("failed to list cluster workflow versions: %w", err)
+	}
+
+	versions := make([]openchoreov1alpha1.ClusterWorkflowVersion, 0, len(list.Items))
+	for _, cwv := range list.Items {
+		if cwv.Spec.WorkflowName != clusterWorkflowName {
+			continue
+		}
+		cwv.TypeMeta = clusterWorkflowVersionTypeMeta
+		versions = append(versions, cwv)
+	}
+	slices.SortFunc(versions, func(a, b openchoreov1alpha1.ClusterWorkflowVersion) int {
+		return services.CompareWorkflowVersions(a.Spec.Version, b.Spec.Version)
+	})
+
+	s.logger.Debug("Listed cluster workflow versions", "clusterWorkflow", clusterWorkflowName, "count", len(versions))
+	return versions, nil
+}
+
+// PublishClusterWorkflowVersion freezes the current template of the cluster workflow as an immutable version.
+func (s *clusterWorkflowService) PublishClusterWorkflowVersion(ctx context.Context, clusterWorkflowName, version string) (*openchoreov1alpha1.ClusterWorkflowVersion, error) {
+	s.logger.Debug("Publishing cluster workflow version", "clusterWorkflow", clusterWorkflowName, "version", version)
+
+	if err := services.ValidateWorkflowVersion(version); err != nil {
+		return nil, err
+	}
+
+	cwf, err := s.GetClusterWorkflow(ctx, clusterWorkflowName)
+	if err != nil {
+		return nil, err
+	}
+
+	cwv := &openchoreov1alpha1.ClusterWorkflowVersion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: openchoreov1alpha1.WorkflowVersionName(clusterWorkflowName, version),
+		},
+		Spec: openchoreov1alpha1.WorkflowVersionSpec{
+			WorkflowName: clusterWorkflowName,
+			Version:      version,
+			Template: openchoreov1alpha1.WorkflowTemplate{
+				Parameters:         cwf.Spec.Parameters,
+				RunTemplate:        cwf.Spec.RunTemplate,
+				Resources:          cwf.Spec.Resources,
+				ExternalRefs:       cwf.Spec.ExternalRefs,
+				TTLAfterCompletion: cwf.Spec.TTLAfterCompletion,
+				Tests:              cwf.Spec.Tests,
+				Analysis:           cwf.Spec.Analysis,
+				SBOM:               cwf.Spec.SBOM,
+				Timeout:            cwf.Spec.Timeout,
+				RetryPolicy:        cwf.Spec.RetryPolicy,
+				Credentials:        cwf.Spec.Credentials,
+			},
+		},
+	}
+
+	if err := s.k8sClient.Create(ctx, cwv); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, ErrClusterWorkflowVersionAlreadyExists
+		}
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return nil, vErr
+		}
+		s.logger.Error("Failed to create cluster workflow version CR", "error", err)
+		return nil, fmt.Errorf("failed to create cluster workflow version: %w", err)
+	}
+
+	cwv.TypeMeta = clusterWorkflowVersionTypeMeta
+	s.logger.Debug("Cluster workflow version published successfully", "clusterWorkflow", clusterWorkflowName, "version", version)
+	return cwv, nil
+}
+
+// DeprecateClusterWorkflowVersion marks a published version as deprecated. The version keeps
+// working for components and runs that still pin it.
+func (s *clusterWorkflowService) DeprecateClusterWorkflowVersion(ctx context.Context, clusterWorkflowName, version, message string) (*openchoreov1alpha1.ClusterWorkflowVersion, error) {
+	s.logger.Debug("Deprecating cluster workflow version", "clusterWorkflow", clusterWorkflowName, "version", version)
+
+	cwv := &openchoreov1alpha1.ClusterWorkflowVersion{}
+	key := client.ObjectKey{Name: openchoreov1alpha1.WorkflowVersionName(clusterWorkflowName, version)}
+	if err := s.k8sClient.Get(ctx, key, cwv); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrClusterWorkflowVersionNotFound
+		}
+		s.logger.Error("Failed to get cluster workflow version", "error", err)
+		return nil, fmt.Errorf("failed to get cluster workflow version: %w", err)
+	}
+
+	cwv.Spec.Deprecated = true
+	cwv.Spec.DeprecationMessage = message
+	if err := s.k8sClient.Update(ctx, cwv); err != nil {
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return nil, vErr
+		}
+		s.logger.Error("Failed to update cluster workflow version CR", "error", err)
+		return nil, fmt.Errorf("failed to deprecate cluster workflow version: %w", err)
+	}
+
+	cwv.TypeMeta = clusterWorkflowVersionTypeMeta
+	s.logger.Debug("Cluster workflow version deprecated successfully", "clusterWorkflow", clusterWorkflowName, "version", version)
+	return cwv, nil
+}
@@ -117,3 +117,41 @@ func (s *clusterWorkflowServiceWithAuthz) GetClusterWorkflowSchema(ctx context.C
 	}
 	return s.internal.GetClusterWorkflowSchema(ctx, clusterWorkflowName)
 }
+
+func (s *clusterWorkflowServiceWithAuthz) ListClusterWorkflowVersions(ctx context.Context, clusterWorkflowName string) ([]openchoreov1alpha1.ClusterWorkflowVersion, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewClusterWorkflow,
+		ResourceType: resourceTypeClusterWorkflow,
+		ResourceID:   clusterWorkflowName,
+		Hierarchy:    authz.ResourceHierarchy{},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.ListClusterWorkflowVersions(ctx, clusterWorkflowName)
+}
+
+// PublishClusterWorkflowVersion requires update permission on the cluster workflow, since a
+// published version changes what pinned components build with.
+func (s *clusterWorkflowServiceWithAuthz) PublishClusterWorkflowVersion(ctx context.Context, clusterWorkflowName, version string) (*openchoreov1alpha1.ClusterWorkflowVersion, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateClusterWorkflow,
+		ResourceType: resourceTypeClusterWorkflow,
+		ResourceID:   clusterWorkflowName,
+		Hierarchy:    authz.ResourceHierarchy{},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.PublishClusterWorkflowVersion(ctx, clusterWorkflowName, version)
+}
+
+func (s *clusterWorkflowServiceWithAuthz) DeprecateClusterWorkflowVersion(ctx context.Context, clusterWorkflowName, version, message string) (*openchoreov1alpha1.ClusterWorkflowVersion, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateClusterWorkflow,
+		ResourceType: resourceTypeClusterWorkflow,
+		ResourceID:   clusterWorkflowName,
+		Hierarchy:    authz.ResourceHierarchy{},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.DeprecateClusterWorkflowVersion(ctx, clusterWorkflowName, version, message)
+}
@@ -170,3 +170,27 @@ func TestListClusterWorkflows_AuthzCheck(t *testing.T) {
 		require.Empty(t, result.Items)
 	})
 }
+
+func TestPublishClusterWorkflowVersion_AuthzCheck(t *testing.T) {
+	resource := &openchoreov1alpha1.ClusterWorkflowVersion{ObjectMeta: metav1.ObjectMeta{Name: "my-cwf.v1"}}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("PublishClusterWorkflowVersion", mock.Anything, "my-cwf", "v1").Return(resource, nil)
+		svc := newCWFAuthzSvc(pdp, mockSvc)
+		result, err := svc.PublishClusterWorkflowVersion(testutil.AuthzContext(), "my-cwf", "v1")
+		require.NoError(t, err)
+		require.Equal(t, resource, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "clusterworkflow:update", "clusterWorkflow", "my-cwf", authzcore.ResourceHierarchy{})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		svc := newCWFAuthzSvc(pdp, mockSvc)
+		_, err := svc.PublishClusterWorkflowVersion(testutil.AuthzContext(), "my-cwf", "v1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -243,3 +243,55 @@ func TestGetClusterWorkflowSchema(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestClusterWorkflowVersions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("publish, list and deprecate", func(t *testing.T) {
+		cwf := testutil.NewClusterWorkflow("test-cwf")
+		svc := newService(t, cwf)
+
+		published, err := svc.PublishClusterWorkflowVersion(ctx, "test-cwf", "v1.1")
+		require.NoError(t, err)
+		assert.Equal(t, clusterWorkflowVersionTypeMeta, published.TypeMeta)
+		assert.Equal(t, "test-cwf.v1.1", published.Name)
+		assert.Equal(t, cwf.Spec.RunTemplate, published.Spec.Template.RunTemplate)
+
+		_, err = svc.PublishClusterWorkflowVersion(ctx, "test-cwf", "v1")
+		require.NoError(t, err)
+
+		versions, err := svc.ListClusterWorkflowVersions(ctx, "test-cwf")
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, "v1", versions[0].Spec.Version)
+		assert.Equal(t, "v1.1", versions[1].Spec.Version)
+
+		deprecated, err := svc.DeprecateClusterWorkflowVersion(ctx, "test-cwf", "v1", "use v1.1")
+		require.NoError(t, err)
+		assert.True(t, deprecated.Spec.Deprecated)
+		assert.Equal(t, "use v1.1", deprecated.Spec.DeprecationMessage)
+	})
+
+	t.Run("publish errors", func(t *testing.T) {
+		svc := newService(t, testutil.NewClusterWorkflow("test-cwf"))
+
+		_, err := svc.PublishClusterWorkflowVersion(ctx, "missing", "v1")
+		require.ErrorIs(t, err, ErrClusterWorkflowNotFound)
+
+		_, err = svc.PublishClusterWorkflowVersion(ctx, "test-cwf", "latest")
+		var vErr *services.ValidationError
+		require.ErrorAs(t, err, &vErr)
+
+		_, err = svc.PublishClusterWorkflowVersion(ctx, "test-cwf", "v1")
+		require.NoError(t, err)
+		_, err = svc.PublishClusterWorkflowVersion(ctx, "test-cwf", "v1")
+		require.ErrorIs(t, err, ErrClusterWorkflowVersionAlreadyExists)
+	})
+
+	t.Run("deprecate unknown version", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.DeprecateClusterWorkflowVersion(ctx, "test-cwf", "v1", "")
+		require.ErrorIs(t, err, ErrClusterWorkflowVersionNotFound)
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// ArchiveComponent sets the component state to Archived. Its release bindings are undeployed
+// and no builds or releases are created for it, while its definition and release history are
+// kept. Archiving an already archived component is a no-op.
+func (s *componentService) ArchiveComponent(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Component, error) {
+	return s.setComponentState(ctx, namespaceName, componentName, openchoreov1alpha1.ComponentStateArchived)
+}
+
+// UnarchiveComponent sets the component state back to Active, which redeploys the release
+// bindings that were active when the component was archived. Unarchiving a component that is
+// not archived is a no-op.
+func (s *componentService) UnarchiveComponent(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Component, error) {
+	return s.setComponentState(ctx, namespaceName, componentName, openchoreov1alpha1.ComponentStateActive)
+}
+
+func (s *componentService) setComponentState(ctx context.Context, namespaceName, componentName string, state openchoreov1alpha1.ComponentState) (*openchoreov1alpha1.Component, error) {
+	component, err := s.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	if component.Spec.IsArchived() != (state == openchoreov1alpha1.ComponentStateArchived) {
+		component.Spec.State = state
+		if err := s.k8sClient.Update(ctx, component); err != nil {
+			if vErr := services.ExtractValidationError(err); vErr != nil {
+				return nil, vErr
+			}
+			s.logger.Error("Failed to update component CR", "error", err)
+			return nil, fmt.Errorf("failed to update component: %w", err)
+		}
+		s.logger.Info("Changed component state", "namespace", namespaceName, "component", componentName, "state", state)
+	}
+
+	component.TypeMeta = componentTypeMeta
+	return component, nil
+}
+
+// ExcludeArchived adds a requirement to a label selector that leaves archived components out.
+// Component lists exclude archived components unless they are explicitly asked for.
+func ExcludeArchived(labelSelector string) string {
+	requirement := "!" + labels.LabelKeyArchived
+	if labelSelector == "" {
+		return requirement
+	}
+	return labelSelector + "," + requirement
+}
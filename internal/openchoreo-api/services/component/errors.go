@@ -13,4 +13,8 @@ var (
 	ErrTraitNotFound            = errors.New("trait not found")
 	ErrValidation               = errors.New("validation error")
 	ErrComponentTypeNotFound    = errors.New("component type not found")
+	ErrLibraryVersionExists     = errors.New("library version already published with a different artifact")
+	ErrComponentTransferFailed  = errors.New("component transfer failed")
+	ErrEnvironmentNotFound      = errors.New("environment not found")
+	ErrObserverURLNotConfigured = errors.New("observer URL not configured")
 )
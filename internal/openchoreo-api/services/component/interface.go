@@ -7,6 +7,7 @@ import (
 	"context"
 
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
@@ -17,6 +18,71 @@ type GenerateReleaseRequest struct {
 	ReleaseName string
 }
 
+// PublishLibraryVersionRequest contains the parameters for publishing a library version.
+type PublishLibraryVersionRequest struct {
+	Version  string
+	Artifact string
+}
+
+// TransferComponentRequest contains the parameters for transferring a component to another project.
+type TransferComponentRequest struct {
+	TargetProject string
+	DryRun        bool
+}
+
+// TransferStep reports the progress of one resource moved by a component transfer.
+type TransferStep struct {
+	Kind    string
+	Name    string
+	Status  string
+	Message string
+}
+
+// TransferResult reports the resources moved by a component transfer.
+type TransferResult struct {
+	ComponentName string
+	SourceProject string
+	TargetProject string
+	DryRun        bool
+	Steps         []TransferStep
+}
+
+// OrphanReportFilter narrows an orphan report to the resources of a project or a component.
+// Empty fields match everything.
+type OrphanReportFilter struct {
+	Project   string
+	Component string
+}
+
+// OrphanedResource is a data plane resource an OrphanScanner of the namespace found that no
+// RenderedRelease accounts for.
+type OrphanedResource struct {
+	openchoreov1alpha1.OrphanedResource
+
+	// Scanner is the name of the OrphanScanner that found the resource.
+	Scanner string
+	// ScannedAt is the time of the scan that found the resource.
+	ScannedAt *metav1.Time
+}
+
+// MissingResource is a resource a RenderedRelease reports that an OrphanScanner of the
+// namespace did not find in the data plane.
+type MissingResource struct {
+	openchoreov1alpha1.MissingResource
+
+	// Scanner is the name of the OrphanScanner that looked for the resource.
+	Scanner string
+	// ScannedAt is the time of the scan that looked for the resource.
+	ScannedAt *metav1.Time
+}
+
+// OrphanReport lists the orphaned and missing resources recorded by the last scan of every
+// OrphanScanner in a namespace.
+type OrphanReport struct {
+	Orphaned []OrphanedResource
+	Missing  []MissingResource
+}
+
 // Service defines the component service interface.
 // Both the core service (no authz) and the authz-wrapped service implement this.
 // Methods accept and return Kubernetes CRD types directly for alignment with
@@ -30,4 +96,10 @@ type Service interface {
 	GenerateRelease(ctx context.Context, namespaceName, componentName string, req *GenerateReleaseRequest) (*openchoreov1alpha1.ComponentRelease, error)
 	GetComponentSchema(ctx context.Context, namespaceName, componentName string) (*extv1.JSONSchemaProps, error)
 	GetComponentReleaseSchema(ctx context.Context, namespaceName, releaseName, componentName string) (*extv1.JSONSchemaProps, error)
+	PublishLibraryVersion(ctx context.Context, namespaceName, componentName string, req *PublishLibraryVersionRequest) (*openchoreov1alpha1.Component, error)
+	ArchiveComponent(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Component, error)
+	UnarchiveComponent(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Component, error)
+	TransferComponent(ctx context.Context, namespaceName, componentName string, req *TransferComponentRequest) (*TransferResult, error)
+	GetComponentObserverURL(ctx context.Context, namespaceName, componentName, environmentName string) (string, error)
+	ListOrphanedResources(ctx context.Context, namespaceName string, filter OrphanReportFilter) (*OrphanReport, error)
 }
@@ -0,0 +1,68 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// maxLibraryVersions is the number of published versions retained in the component status.
+const maxLibraryVersions = 100
+
+// PublishLibraryVersion records a published version of a library component. Publishing an
+// already recorded version with the same artifact is a no-op.
+func (s *componentService) PublishLibraryVersion(ctx context.Context, namespaceName, componentName string, req *PublishLibraryVersionRequest) (*openchoreov1alpha1.Component, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", ErrValidation)
+	}
+	version := strings.TrimSpace(req.Version)
+	artifact := strings.TrimSpace(req.Artifact)
+	if version == "" {
+		return nil, fmt.Errorf("%w: version is required", ErrValidation)
+	}
+	if artifact == "" {
+		return nil, fmt.Errorf("%w: artifact is required", ErrValidation)
+	}
+
+	s.logger.Debug("Publishing library version", "namespace", namespaceName, "component", componentName, "version", version)
+
+	component, err := s.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if !component.Spec.IsLibrary() {
+		return nil, fmt.Errorf("%w: component %q is not a library", ErrValidation, componentName)
+	}
+
+	if existing := component.Status.FindLibraryVersion(version); existing != nil {
+		if existing.Artifact != artifact {
+			return nil, ErrLibraryVersionExists
+		}
+		return component, nil
+	}
+
+	component.Status.LibraryVersions = append(component.Status.LibraryVersions, openchoreov1alpha1.LibraryVersion{
+		Version:     version,
+		Artifact:    artifact,
+		PublishedAt: metav1.Now(),
+	})
+	if n := len(component.Status.LibraryVersions); n > maxLibraryVersions {
+		component.Status.LibraryVersions = component.Status.LibraryVersions[n-maxLibraryVersions:]
+	}
+
+	if err := s.k8sClient.Status().Update(ctx, component); err != nil {
+		s.logger.Error("Failed to update component status", "error", err)
+		return nil, fmt.Errorf("failed to publish library version: %w", err)
+	}
+
+	s.logger.Debug("Library version published", "namespace", namespaceName, "component", componentName, "version", version)
+	component.TypeMeta = componentTypeMeta
+	return component, nil
+}
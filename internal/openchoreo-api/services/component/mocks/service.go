@@ -29,6 +29,66 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// ArchiveComponent provides a mock function with given fields: ctx, namespaceName, componentName
+func (_m *MockService) ArchiveComponent(ctx context.Context, namespaceName string, componentName string) (*v1alpha1.Component, error) {
+	ret := _m.Called(ctx, namespaceName, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveComponent")
+	}
+
+	var r0 *v1alpha1.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.Component, error)); ok {
+		return rf(ctx, namespaceName, componentName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.Component); ok {
+		r0 = rf(ctx, namespaceName, componentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ArchiveComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveComponent'
+type MockService_ArchiveComponent_Call struct {
+	*mock.Call
+}
+
+// ArchiveComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+func (_e *MockService_Expecter) ArchiveComponent(ctx interface{}, namespaceName interface{}, componentName interface{}) *MockService_ArchiveComponent_Call {
+	return &MockService_ArchiveComponent_Call{Call: _e.mock.On("ArchiveComponent", ctx, namespaceName, componentName)}
+}
+
+func (_c *MockService_ArchiveComponent_Call) Run(run func(ctx context.Context, namespaceName string, componentName string)) *MockService_ArchiveComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ArchiveComponent_Call) Return(_a0 *v1alpha1.Component, _a1 error) *MockService_ArchiveComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ArchiveComponent_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.Component, error)) *MockService_ArchiveComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateComponent provides a mock function with given fields: ctx, namespaceName, _a2
 func (_m *MockService) CreateComponent(ctx context.Context, namespaceName string, _a2 *v1alpha1.Component) (*v1alpha1.Component, error) {
 	ret := _m.Called(ctx, namespaceName, _a2)
@@ -258,6 +318,65 @@ func (_c *MockService_GetComponent_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetComponentObserverURL provides a mock function with given fields: ctx, namespaceName, componentName, environmentName
+func (_m *MockService) GetComponentObserverURL(ctx context.Context, namespaceName string, componentName string, environmentName string) (string, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, environmentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComponentObserverURL")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return rf(ctx, namespaceName, componentName, environmentName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, namespaceName, componentName, environmentName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, environmentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetComponentObserverURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentObserverURL'
+type MockService_GetComponentObserverURL_Call struct {
+	*mock.Call
+}
+
+// GetComponentObserverURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - environmentName string
+func (_e *MockService_Expecter) GetComponentObserverURL(ctx interface{}, namespaceName interface{}, componentName interface{}, environmentName interface{}) *MockService_GetComponentObserverURL_Call {
+	return &MockService_GetComponentObserverURL_Call{Call: _e.mock.On("GetComponentObserverURL", ctx, namespaceName, componentName, environmentName)}
+}
+
+func (_c *MockService_GetComponentObserverURL_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, environmentName string)) *MockService_GetComponentObserverURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetComponentObserverURL_Call) Return(_a0 string, _a1 error) *MockService_GetComponentObserverURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetComponentObserverURL_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *MockService_GetComponentObserverURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentReleaseSchema provides a mock function with given fields: ctx, namespaceName, releaseName, componentName
 func (_m *MockService) GetComponentReleaseSchema(ctx context.Context, namespaceName string, releaseName string, componentName string) (*v1.JSONSchemaProps, error) {
 	ret := _m.Called(ctx, namespaceName, releaseName, componentName)
@@ -440,6 +559,248 @@ func (_c *MockService_ListComponents_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ListOrphanedResources provides a mock function with given fields: ctx, namespaceName, filter
+func (_m *MockService) ListOrphanedResources(ctx context.Context, namespaceName string, filter component.OrphanReportFilter) (*component.OrphanReport, error) {
+	ret := _m.Called(ctx, namespaceName, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrphanedResources")
+	}
+
+	var r0 *component.OrphanReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, component.OrphanReportFilter) (*component.OrphanReport, error)); ok {
+		return rf(ctx, namespaceName, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, component.OrphanReportFilter) *component.OrphanReport); ok {
+		r0 = rf(ctx, namespaceName, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*component.OrphanReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, component.OrphanReportFilter) error); ok {
+		r1 = rf(ctx, namespaceName, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListOrphanedResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrphanedResources'
+type MockService_ListOrphanedResources_Call struct {
+	*mock.Call
+}
+
+// ListOrphanedResources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - filter component.OrphanReportFilter
+func (_e *MockService_Expecter) ListOrphanedResources(ctx interface{}, namespaceName interface{}, filter interface{}) *MockService_ListOrphanedResources_Call {
+	return &MockService_ListOrphanedResources_Call{Call: _e.mock.On("ListOrphanedResources", ctx, namespaceName, filter)}
+}
+
+func (_c *MockService_ListOrphanedResources_Call) Run(run func(ctx context.Context, namespaceName string, filter component.OrphanReportFilter)) *MockService_ListOrphanedResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(component.OrphanReportFilter))
+	})
+	return _c
+}
+
+func (_c *MockService_ListOrphanedResources_Call) Return(_a0 *component.OrphanReport, _a1 error) *MockService_ListOrphanedResources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListOrphanedResources_Call) RunAndReturn(run func(context.Context, string, component.OrphanReportFilter) (*component.OrphanReport, error)) *MockService_ListOrphanedResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishLibraryVersion provides a mock function with given fields: ctx, namespaceName, componentName, req
+func (_m *MockService) PublishLibraryVersion(ctx context.Context, namespaceName string, componentName string, req *component.PublishLibraryVersionRequest) (*v1alpha1.Component, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishLibraryVersion")
+	}
+
+	var r0 *v1alpha1.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *component.PublishLibraryVersionRequest) (*v1alpha1.Component, error)); ok {
+		return rf(ctx, namespaceName, componentName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *component.PublishLibraryVersionRequest) *v1alpha1.Component); ok {
+		r0 = rf(ctx, namespaceName, componentName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *component.PublishLibraryVersionRequest) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_PublishLibraryVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishLibraryVersion'
+type MockService_PublishLibraryVersion_Call struct {
+	*mock.Call
+}
+
+// PublishLibraryVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - req *component.PublishLibraryVersionRequest
+func (_e *MockService_Expecter) PublishLibraryVersion(ctx interface{}, namespaceName interface{}, componentName interface{}, req interface{}) *MockService_PublishLibraryVersion_Call {
+	return &MockService_PublishLibraryVersion_Call{Call: _e.mock.On("PublishLibraryVersion", ctx, namespaceName, componentName, req)}
+}
+
+func (_c *MockService_PublishLibraryVersion_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, req *component.PublishLibraryVersionRequest)) *MockService_PublishLibraryVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*component.PublishLibraryVersionRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_PublishLibraryVersion_Call) Return(_a0 *v1alpha1.Component, _a1 error) *MockService_PublishLibraryVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_PublishLibraryVersion_Call) RunAndReturn(run func(context.Context, string, string, *component.PublishLibraryVersionRequest) (*v1alpha1.Component, error)) *MockService_PublishLibraryVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferComponent provides a mock function with given fields: ctx, namespaceName, componentName, req
+func (_m *MockService) TransferComponent(ctx context.Context, namespaceName string, componentName string, req *component.TransferComponentRequest) (*component.TransferResult, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferComponent")
+	}
+
+	var r0 *component.TransferResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *component.TransferComponentRequest) (*component.TransferResult, error)); ok {
+		return rf(ctx, namespaceName, componentName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *component.TransferComponentRequest) *component.TransferResult); ok {
+		r0 = rf(ctx, namespaceName, componentName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*component.TransferResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *component.TransferComponentRequest) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_TransferComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferComponent'
+type MockService_TransferComponent_Call struct {
+	*mock.Call
+}
+
+// TransferComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - req *component.TransferComponentRequest
+func (_e *MockService_Expecter) TransferComponent(ctx interface{}, namespaceName interface{}, componentName interface{}, req interface{}) *MockService_TransferComponent_Call {
+	return &MockService_TransferComponent_Call{Call: _e.mock.On("TransferComponent", ctx, namespaceName, componentName, req)}
+}
+
+func (_c *MockService_TransferComponent_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, req *component.TransferComponentRequest)) *MockService_TransferComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*component.TransferComponentRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_TransferComponent_Call) Return(_a0 *component.TransferResult, _a1 error) *MockService_TransferComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_TransferComponent_Call) RunAndReturn(run func(context.Context, string, string, *component.TransferComponentRequest) (*component.TransferResult, error)) *MockService_TransferComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnarchiveComponent provides a mock function with given fields: ctx, namespaceName, componentName
+func (_m *MockService) UnarchiveComponent(ctx context.Context, namespaceName string, componentName string) (*v1alpha1.Component, error) {
+	ret := _m.Called(ctx, namespaceName, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveComponent")
+	}
+
+	var r0 *v1alpha1.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.Component, error)); ok {
+		return rf(ctx, namespaceName, componentName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.Component); ok {
+		r0 = rf(ctx, namespaceName, componentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UnarchiveComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnarchiveComponent'
+type MockService_UnarchiveComponent_Call struct {
+	*mock.Call
+}
+
+// UnarchiveComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+func (_e *MockService_Expecter) UnarchiveComponent(ctx interface{}, namespaceName interface{}, componentName interface{}) *MockService_UnarchiveComponent_Call {
+	return &MockService_UnarchiveComponent_Call{Call: _e.mock.On("UnarchiveComponent", ctx, namespaceName, componentName)}
+}
+
+func (_c *MockService_UnarchiveComponent_Call) Run(run func(ctx context.Context, namespaceName string, componentName string)) *MockService_UnarchiveComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_UnarchiveComponent_Call) Return(_a0 *v1alpha1.Component, _a1 error) *MockService_UnarchiveComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UnarchiveComponent_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.Component, error)) *MockService_UnarchiveComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateComponent provides a mock function with given fields: ctx, namespaceName, _a2
 func (_m *MockService) UpdateComponent(ctx context.Context, namespaceName string, _a2 *v1alpha1.Component) (*v1alpha1.Component, error) {
 	ret := _m.Called(ctx, namespaceName, _a2)
@@ -0,0 +1,47 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// GetComponentObserverURL returns the URL of the observer that serves the component's logs and
+// metrics in an environment, resolved through Environment → DataPlane/ClusterDataPlane →
+// ObservabilityPlane/ClusterObservabilityPlane.
+func (s *componentService) GetComponentObserverURL(ctx context.Context, namespaceName, componentName, environmentName string) (string, error) {
+	if _, err := s.GetComponent(ctx, namespaceName, componentName); err != nil {
+		return "", err
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: environmentName}, env); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return "", ErrEnvironmentNotFound
+		}
+		return "", fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	dpResult, err := controller.GetDataPlaneFromRef(ctx, s.k8sClient, env.Namespace, env.Spec.DataPlaneRef)
+	if err != nil {
+		s.logger.Warn("Failed to resolve data plane for environment", "environment", environmentName, "error", err)
+		return "", fmt.Errorf("%w: %v", ErrObserverURLNotConfigured, err)
+	}
+	obsResult, err := dpResult.GetObservabilityPlane(ctx, s.k8sClient)
+	if err != nil {
+		s.logger.Warn("Failed to resolve observability plane for environment", "environment", environmentName, "error", err)
+		return "", fmt.Errorf("%w: %v", ErrObserverURLNotConfigured, err)
+	}
+	observerURL := obsResult.GetObserverURL()
+	if observerURL == "" {
+		return "", fmt.Errorf("%w: observability plane of environment %q has no observer URL", ErrObserverURLNotConfigured, environmentName)
+	}
+	return observerURL, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func observerTestObjects(observerURL string) []client.Object {
+	return []client.Object{
+		testComponent(),
+		&openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: testNamespace},
+			Spec: openchoreov1alpha1.EnvironmentSpec{
+				DataPlaneRef: &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane, Name: "dp"},
+			},
+		},
+		&openchoreov1alpha1.DataPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "dp", Namespace: testNamespace},
+			Spec: openchoreov1alpha1.DataPlaneSpec{
+				ObservabilityPlaneRef: &openchoreov1alpha1.ObservabilityPlaneRef{
+					Kind: openchoreov1alpha1.ObservabilityPlaneRefKindObservabilityPlane,
+					Name: "obs",
+				},
+			},
+		},
+		&openchoreov1alpha1.ObservabilityPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "obs", Namespace: testNamespace},
+			Spec:       openchoreov1alpha1.ObservabilityPlaneSpec{ObserverURL: observerURL},
+		},
+	}
+}
+
+func TestGetComponentObserverURL(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("resolves through the environment data plane", func(t *testing.T) {
+		svc := newService(t, observerTestObjects("http://observer.dev")...)
+		url, err := svc.GetComponentObserverURL(ctx, testNamespace, testComponentName, "dev")
+		require.NoError(t, err)
+		assert.Equal(t, "http://observer.dev", url)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		svc := newService(t, observerTestObjects("http://observer.dev")...)
+		_, err := svc.GetComponentObserverURL(ctx, testNamespace, "missing", "dev")
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		svc := newService(t, observerTestObjects("http://observer.dev")...)
+		_, err := svc.GetComponentObserverURL(ctx, testNamespace, testComponentName, "prod")
+		require.ErrorIs(t, err, ErrEnvironmentNotFound)
+	})
+
+	t.Run("observer URL not set", func(t *testing.T) {
+		svc := newService(t, observerTestObjects("")...)
+		_, err := svc.GetComponentObserverURL(ctx, testNamespace, testComponentName, "dev")
+		require.ErrorIs(t, err, ErrObserverURLNotConfigured)
+	})
+
+	t.Run("observability plane missing", func(t *testing.T) {
+		svc := newService(t, observerTestObjects("http://observer.dev")[:3]...)
+		_, err := svc.GetComponentObserverURL(ctx, testNamespace, testComponentName, "dev")
+		require.ErrorIs(t, err, ErrObserverURLNotConfigured)
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// ListOrphanedResources returns the orphaned and missing resources recorded by the last scan
+// of every OrphanScanner in the namespace that match the filter.
+func (s *componentService) ListOrphanedResources(ctx context.Context, namespaceName string, filter OrphanReportFilter) (*OrphanReport, error) {
+	s.logger.Debug("Listing orphaned resources", "namespace", namespaceName,
+		"project", filter.Project, "component", filter.Component)
+
+	var scanners openchoreov1alpha1.OrphanScannerList
+	if err := s.k8sClient.List(ctx, &scanners, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list orphan scanners", "error", err)
+		return nil, fmt.Errorf("failed to list orphan scanners: %w", err)
+	}
+
+	report := &OrphanReport{Orphaned: []OrphanedResource{}, Missing: []MissingResource{}}
+	for _, scanner := range scanners.Items {
+		for _, r := range scanner.Status.OrphanedResources {
+			if !filter.matches(r.Project, r.Component) {
+				continue
+			}
+			report.Orphaned = append(report.Orphaned, OrphanedResource{
+				OrphanedResource: r,
+				Scanner:          scanner.Name,
+				ScannedAt:        scanner.Status.LastScanTime,
+			})
+		}
+		for _, r := range scanner.Status.MissingResources {
+			if !filter.matches(r.Project, r.Component) {
+				continue
+			}
+			report.Missing = append(report.Missing, MissingResource{
+				MissingResource: r,
+				Scanner:         scanner.Name,
+				ScannedAt:       scanner.Status.LastScanTime,
+			})
+		}
+	}
+
+	s.logger.Debug("Listed orphaned resources", "namespace", namespaceName,
+		"orphaned", len(report.Orphaned), "missing", len(report.Missing))
+	return report, nil
+}
+
+func (f OrphanReportFilter) matches(project, component string) bool {
+	return (f.Project == "" || f.Project == project) && (f.Component == "" || f.Component == component)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestListOrphanedResources(t *testing.T) {
+	ctx := context.Background()
+	plane := openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "default"}
+	scannedAt := metav1.NewTime(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC))
+	scanner := func(name, namespace string) *openchoreov1alpha1.OrphanScanner {
+		return &openchoreov1alpha1.OrphanScanner{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       openchoreov1alpha1.OrphanScannerSpec{Planes: []openchoreov1alpha1.TargetPlaneRef{plane}},
+			Status: openchoreov1alpha1.OrphanScannerStatus{
+				LastScanTime: &scannedAt,
+				OrphanedResources: []openchoreov1alpha1.OrphanedResource{
+					{Plane: plane, Kind: "Deployment", Name: "checkout-worker", Project: testProjectName, Component: testComponentName,
+						Reason: openchoreov1alpha1.OrphanReasonNotInInventory},
+					{Plane: plane, Kind: "Service", Name: "legacy", Project: testProjectName, Component: "legacy",
+						Reason: openchoreov1alpha1.OrphanReasonReleaseNotFound},
+				},
+				MissingResources: []openchoreov1alpha1.MissingResource{
+					{Plane: plane, Kind: "ConfigMap", Name: "checkout-config", Project: testProjectName, Component: testComponentName},
+				},
+			},
+		}
+	}
+
+	t.Run("merges scanners of the namespace", func(t *testing.T) {
+		svc := newService(t, scanner("a", testNamespace), scanner("b", "other-ns"))
+
+		report, err := svc.ListOrphanedResources(ctx, testNamespace, OrphanReportFilter{})
+		require.NoError(t, err)
+		require.Len(t, report.Orphaned, 2)
+		require.Len(t, report.Missing, 1)
+		assert.Equal(t, "a", report.Orphaned[0].Scanner)
+		require.NotNil(t, report.Missing[0].ScannedAt)
+		assert.True(t, report.Missing[0].ScannedAt.Equal(&scannedAt))
+	})
+
+	t.Run("filters by component", func(t *testing.T) {
+		svc := newService(t, scanner("a", testNamespace))
+
+		report, err := svc.ListOrphanedResources(ctx, testNamespace,
+			OrphanReportFilter{Project: testProjectName, Component: testComponentName})
+		require.NoError(t, err)
+		require.Len(t, report.Orphaned, 1)
+		assert.Equal(t, "checkout-worker", report.Orphaned[0].Name)
+		assert.Len(t, report.Missing, 1)
+	})
+
+	t.Run("no scanners", func(t *testing.T) {
+		svc := newService(t)
+
+		report, err := svc.ListOrphanedResources(ctx, testNamespace, OrphanReportFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, report.Orphaned)
+		assert.Empty(t, report.Missing)
+	})
+}
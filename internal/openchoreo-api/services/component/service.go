@@ -10,16 +10,20 @@ import (
 	"strings"
 
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/analysisreport"
 	"github.com/openchoreo/openchoreo/internal/componentrelease"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
 	openchoreoschema "github.com/openchoreo/openchoreo/internal/schema"
+	"github.com/openchoreo/openchoreo/internal/testreport"
 	componentvalidation "github.com/openchoreo/openchoreo/internal/validation/component"
 )
 
@@ -62,7 +66,8 @@ func (s *componentService) CreateComponent(ctx context.Context, namespaceName st
 	s.logger.Debug("Creating component", "namespace", namespaceName, "component", component.Name)
 
 	// Validate that the referenced project exists
-	if _, err := s.projectService.GetProject(ctx, namespaceName, component.Spec.Owner.ProjectName); err != nil {
+	project, err := s.projectService.GetProject(ctx, namespaceName, component.Spec.Owner.ProjectName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -76,6 +81,23 @@ func (s *componentService) CreateComponent(ctx context.Context, namespaceName st
 		return nil, ErrComponentAlreadyExists
 	}
 
+	if err := validateWorkflowParameters(ctx, s.k8sClient, namespaceName, component.Spec.Workflow); err != nil {
+		return nil, err
+	}
+
+	// Components are subject to the component limit of the project quota
+	if project.Spec.Quota != nil {
+		usage, err := controller.GetProjectQuotaUsage(ctx, s.k8sClient, namespaceName, project.Name)
+		if err != nil {
+			s.logger.Error("Failed to get project quota usage", "error", err, "project", project.Name)
+			return nil, fmt.Errorf("failed to get project quota usage: %w", err)
+		}
+		if err := usage.CheckComponent(project); err != nil {
+			s.logger.Info("Rejecting component, project quota exhausted", "namespace", namespaceName, "component", component.Name, "error", err)
+			return nil, err
+		}
+	}
+
 	// Set defaults
 	component.Status = openchoreov1alpha1.ComponentStatus{}
 	component.Namespace = namespaceName
@@ -118,6 +140,11 @@ func (s *componentService) UpdateComponent(ctx context.Context, namespaceName st
 		return nil, fmt.Errorf("failed to get component: %w", err)
 	}
 
+	// A uid in the request pins the update to that instance of the resource
+	if component.UID != "" && component.UID != existing.UID {
+		return nil, services.ErrUIDMismatch
+	}
+
 	// Clear status from user input — status is server-managed
 	component.Status = openchoreov1alpha1.ComponentStatus{}
 
@@ -127,6 +154,14 @@ func (s *componentService) UpdateComponent(ctx context.Context, namespaceName st
 		return nil, &services.ValidationError{Msg: "spec.owner.projectName is immutable"}
 	}
 
+	// Workflow parameters stored before plaintext credentials were rejected are only checked
+	// once they change
+	if !equality.Semantic.DeepEqual(existing.Spec.Workflow, component.Spec.Workflow) {
+		if err := validateWorkflowParameters(ctx, s.k8sClient, namespaceName, component.Spec.Workflow); err != nil {
+			return nil, err
+		}
+	}
+
 	// Only apply user-mutable fields to the existing object, preserving server-managed fields
 	existing.Spec = component.Spec
 	existing.Labels = component.Labels
@@ -259,6 +294,12 @@ func (s *componentService) GenerateRelease(ctx context.Context, namespaceName, c
 	if err != nil {
 		return nil, err
 	}
+	if component.Spec.IsLibrary() {
+		return nil, fmt.Errorf("%w: component %q is a library; library components cannot be released", ErrValidation, componentName)
+	}
+	if component.Spec.IsArchived() {
+		return nil, fmt.Errorf("%w: component %q is archived; unarchive it to create releases", ErrValidation, componentName)
+	}
 	projectName := component.Spec.Owner.ProjectName
 
 	// Find the workload for this component
@@ -282,6 +323,12 @@ func (s *componentService) GenerateRelease(ctx context.Context, namespaceName, c
 		return nil, fmt.Errorf("failed to fetch component type: %w", err)
 	}
 
+	if blocked, err := s.releaseBlockedByWorkflowRun(ctx, component, componentTypeSpec); err != nil {
+		return nil, err
+	} else if blocked != "" {
+		return nil, fmt.Errorf("%w: %s", ErrValidation, blocked)
+	}
+
 	// Fetch all trait specs (embedded from ComponentType + component-level)
 	traits, clusterTraits, err := s.fetchAllTraits(ctx, componentTypeSpec, component)
 	if err != nil {
@@ -317,6 +364,7 @@ func (s *componentService) GenerateRelease(ctx context.Context, namespaceName, c
 				labels.LabelKeyProjectName:   projectName,
 				labels.LabelKeyComponentName: componentName,
 			},
+			Annotations: componentrelease.Annotations(workload),
 		},
 		Spec: *crSpec,
 	}
@@ -335,6 +383,41 @@ func (s *componentService) GenerateRelease(ctx context.Context, namespaceName, c
 	return componentRelease, nil
 }
 
+// releaseBlockedByWorkflowRun returns a message when the most recent completed workflow run of
+// the component failed tests that block release creation or does not meet the analysis quality
+// gates of the component type and project, or an empty string otherwise.
+func (s *componentService) releaseBlockedByWorkflowRun(ctx context.Context, component *openchoreov1alpha1.Component,
+	componentTypeSpec *openchoreov1alpha1.ComponentTypeSpec) (string, error) {
+	if component.Spec.Workflow == nil {
+		return "", nil
+	}
+
+	var runs openchoreov1alpha1.WorkflowRunList
+	if err := s.k8sClient.List(ctx, &runs, client.InNamespace(component.Namespace), client.MatchingLabels{
+		labels.LabelKeyProjectName:   component.Spec.Owner.ProjectName,
+		labels.LabelKeyComponentName: component.Name,
+	}); err != nil {
+		s.logger.Error("Failed to list workflow runs", "error", err)
+		return "", fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+	latest := testreport.LatestCompletedRun(runs.Items)
+	if msg := testreport.ReleaseBlockedMessage(latest); msg != "" {
+		return msg, nil
+	}
+	if latest == nil || latest.Status.AnalysisResults == nil {
+		return "", nil
+	}
+
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: component.Spec.Owner.ProjectName, Namespace: component.Namespace}, project); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			s.logger.Error("Failed to get project", "error", err)
+			return "", fmt.Errorf("failed to get project: %w", err)
+		}
+	}
+	return analysisreport.ReleaseBlockedMessage(latest, componentTypeSpec.QualityGate, project.Spec.QualityGate), nil
+}
+
 // findWorkload finds the workload for a given component within a namespace.
 func (s *componentService) findWorkload(ctx context.Context, namespaceName, projectName, componentName string) (*openchoreov1alpha1.Workload, error) {
 	workloadList := &openchoreov1alpha1.WorkloadList{}
@@ -749,3 +832,11 @@ func (s *componentService) componentExists(ctx context.Context, namespaceName, c
 	}
 	return true, nil
 }
+
+// validateWorkflowParameters rejects component workflow parameters that carry plaintext credentials.
+func validateWorkflowParameters(ctx context.Context, c client.Client, namespaceName string, wf *openchoreov1alpha1.ComponentWorkflowConfig) error {
+	if wf == nil {
+		return nil
+	}
+	return services.ValidateWorkflowParameters(ctx, c, namespaceName, wf.Kind, wf.Name, wf.Version, wf.Parameters)
+}
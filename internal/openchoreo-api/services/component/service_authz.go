@@ -5,6 +5,7 @@ package component
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"strings"
 
@@ -88,11 +89,21 @@ func (s *componentServiceWithAuthz) UpdateComponent(ctx context.Context, namespa
 			},
 		},
 	}); err != nil {
+		// Updating a missing component creates it, which the create permission authorizes.
+		if errors.Is(err, services.ErrForbidden) && component.UID == "" && s.isComponentMissing(ctx, namespaceName, component.Name) {
+			return nil, ErrComponentNotFound
+		}
 		return nil, err
 	}
 	return s.internal.UpdateComponent(ctx, namespaceName, component)
 }
 
+// isComponentMissing reports whether the component does not exist.
+func (s *componentServiceWithAuthz) isComponentMissing(ctx context.Context, namespaceName, name string) bool {
+	_, err := s.internal.GetComponent(ctx, namespaceName, name)
+	return errors.Is(err, ErrComponentNotFound)
+}
+
 func (s *componentServiceWithAuthz) ListComponents(ctx context.Context, namespaceName, projectName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Component], error) {
 	return services.FilteredList(ctx, opts, s.authz,
 		func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Component], error) {
@@ -181,6 +192,102 @@ func (s *componentServiceWithAuthz) GenerateRelease(ctx context.Context, namespa
 	return s.internal.GenerateRelease(ctx, namespaceName, componentName, req)
 }
 
+func (s *componentServiceWithAuthz) PublishLibraryVersion(ctx context.Context, namespaceName, componentName string, req *PublishLibraryVersionRequest) (*openchoreov1alpha1.Component, error) {
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.internal.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateComponent,
+		ResourceType: resourceTypeComponent,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				ComponentType: formatComponentTypeAttr(namespaceName, comp.Spec.ComponentType),
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.PublishLibraryVersion(ctx, namespaceName, componentName, req)
+}
+
+func (s *componentServiceWithAuthz) ArchiveComponent(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Component, error) {
+	if err := s.checkUpdate(ctx, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+	return s.internal.ArchiveComponent(ctx, namespaceName, componentName)
+}
+
+func (s *componentServiceWithAuthz) UnarchiveComponent(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Component, error) {
+	if err := s.checkUpdate(ctx, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+	return s.internal.UnarchiveComponent(ctx, namespaceName, componentName)
+}
+
+// TransferComponent requires permission to update the component in its current project and to
+// create it in the target project.
+func (s *componentServiceWithAuthz) TransferComponent(ctx context.Context, namespaceName, componentName string, req *TransferComponentRequest) (*TransferResult, error) {
+	if err := s.checkUpdate(ctx, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+	if req != nil && req.TargetProject != "" {
+		comp, err := s.internal.GetComponent(ctx, namespaceName, componentName)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.authz.Check(ctx, services.CheckRequest{
+			Action:       authz.ActionCreateComponent,
+			ResourceType: resourceTypeComponent,
+			ResourceID:   componentName,
+			Hierarchy: authz.ResourceHierarchy{
+				Namespace: namespaceName,
+				Project:   req.TargetProject,
+				Component: componentName,
+			},
+			Context: authz.Context{
+				Resource: authz.ResourceAttribute{
+					ComponentType: formatComponentTypeAttr(namespaceName, comp.Spec.ComponentType),
+				},
+			},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return s.internal.TransferComponent(ctx, namespaceName, componentName, req)
+}
+
+// checkUpdate checks that the caller may update the component.
+func (s *componentServiceWithAuthz) checkUpdate(ctx context.Context, namespaceName, componentName string) error {
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.internal.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return err
+	}
+	return s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateComponent,
+		ResourceType: resourceTypeComponent,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				ComponentType: formatComponentTypeAttr(namespaceName, comp.Spec.ComponentType),
+			},
+		},
+	})
+}
+
 func (s *componentServiceWithAuthz) GetComponentSchema(ctx context.Context, namespaceName, componentName string) (*extv1.JSONSchemaProps, error) {
 	// Fetch first to get the project for authz hierarchy
 	comp, err := s.internal.GetComponent(ctx, namespaceName, componentName)
@@ -222,3 +329,90 @@ func (s *componentServiceWithAuthz) GetComponentReleaseSchema(ctx context.Contex
 	}
 	return s.internal.GetComponentReleaseSchema(ctx, namespaceName, releaseName, componentName)
 }
+
+func (s *componentServiceWithAuthz) GetComponentObserverURL(ctx context.Context, namespaceName, componentName, environmentName string) (string, error) {
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.internal.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return "", err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewComponent,
+		ResourceType: resourceTypeComponent,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+	}); err != nil {
+		return "", err
+	}
+	return s.internal.GetComponentObserverURL(ctx, namespaceName, componentName, environmentName)
+}
+
+// ListOrphanedResources returns the orphaned and missing resources the caller can view.
+// Resources of a component are checked against the component, resources of a project
+// without a known component against the project, and the rest against the namespace.
+func (s *componentServiceWithAuthz) ListOrphanedResources(ctx context.Context, namespaceName string, filter OrphanReportFilter) (*OrphanReport, error) {
+	report, err := s.internal.ListOrphanedResources(ctx, namespaceName, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]services.CheckRequest, 0, len(report.Orphaned)+len(report.Missing))
+	for _, r := range report.Orphaned {
+		requests = append(requests, orphanCheckRequest(namespaceName, r.Project, r.Component))
+	}
+	for _, r := range report.Missing {
+		requests = append(requests, orphanCheckRequest(namespaceName, r.Project, r.Component))
+	}
+	allowed, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	isAllowed := func(i int) bool { return i < len(allowed) && allowed[i] }
+
+	out := &OrphanReport{Orphaned: []OrphanedResource{}, Missing: []MissingResource{}}
+	for i, r := range report.Orphaned {
+		if isAllowed(i) {
+			out.Orphaned = append(out.Orphaned, r)
+		}
+	}
+	for i, r := range report.Missing {
+		if isAllowed(len(report.Orphaned) + i) {
+			out.Missing = append(out.Missing, r)
+		}
+	}
+	return out, nil
+}
+
+func orphanCheckRequest(namespaceName, projectName, componentName string) services.CheckRequest {
+	switch {
+	case componentName != "":
+		return services.CheckRequest{
+			Action:       authz.ActionViewComponent,
+			ResourceType: resourceTypeComponent,
+			ResourceID:   componentName,
+			Hierarchy: authz.ResourceHierarchy{
+				Namespace: namespaceName,
+				Project:   projectName,
+				Component: componentName,
+			},
+		}
+	case projectName != "":
+		return services.CheckRequest{
+			Action:       authz.ActionViewProject,
+			ResourceType: "project",
+			ResourceID:   projectName,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName},
+		}
+	default:
+		return services.CheckRequest{
+			Action:       authz.ActionViewNamespace,
+			ResourceType: "namespace",
+			ResourceID:   namespaceName,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+		}
+	}
+}
@@ -56,6 +56,24 @@ func (m *mockService) GetComponent(ctx context.Context, namespaceName, component
 	return res, args.Error(1)
 }
 
+func (m *mockService) ArchiveComponent(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Component, error) {
+	args := m.Called(ctx, namespaceName, componentName)
+	res, _ := args.Get(0).(*openchoreov1alpha1.Component)
+	return res, args.Error(1)
+}
+
+func (m *mockService) UnarchiveComponent(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Component, error) {
+	args := m.Called(ctx, namespaceName, componentName)
+	res, _ := args.Get(0).(*openchoreov1alpha1.Component)
+	return res, args.Error(1)
+}
+
+func (m *mockService) TransferComponent(ctx context.Context, namespaceName, componentName string, req *TransferComponentRequest) (*TransferResult, error) {
+	args := m.Called(ctx, namespaceName, componentName, req)
+	res, _ := args.Get(0).(*TransferResult)
+	return res, args.Error(1)
+}
+
 func (m *mockService) DeleteComponent(ctx context.Context, namespaceName, componentName string) error {
 	args := m.Called(ctx, namespaceName, componentName)
 	return args.Error(0)
@@ -67,6 +85,12 @@ func (m *mockService) GenerateRelease(ctx context.Context, namespaceName, compon
 	return res, args.Error(1)
 }
 
+func (m *mockService) PublishLibraryVersion(ctx context.Context, namespaceName, componentName string, req *PublishLibraryVersionRequest) (*openchoreov1alpha1.Component, error) {
+	args := m.Called(ctx, namespaceName, componentName, req)
+	res, _ := args.Get(0).(*openchoreov1alpha1.Component)
+	return res, args.Error(1)
+}
+
 func (m *mockService) GetComponentSchema(ctx context.Context, namespaceName, componentName string) (*extv1.JSONSchemaProps, error) {
 	args := m.Called(ctx, namespaceName, componentName)
 	res, _ := args.Get(0).(*extv1.JSONSchemaProps)
@@ -79,6 +103,17 @@ func (m *mockService) GetComponentReleaseSchema(ctx context.Context, namespaceNa
 	return res, args.Error(1)
 }
 
+func (m *mockService) GetComponentObserverURL(ctx context.Context, namespaceName, componentName, environmentName string) (string, error) {
+	args := m.Called(ctx, namespaceName, componentName, environmentName)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockService) ListOrphanedResources(ctx context.Context, namespaceName string, filter OrphanReportFilter) (*OrphanReport, error) {
+	args := m.Called(ctx, namespaceName, filter)
+	res, _ := args.Get(0).(*OrphanReport)
+	return res, args.Error(1)
+}
+
 func testComp() *openchoreov1alpha1.Component {
 	return &openchoreov1alpha1.Component{
 		ObjectMeta: metav1.ObjectMeta{Name: "my-comp", Namespace: "ns-1"},
@@ -145,9 +180,10 @@ func TestUpdateComponent_AuthzCheck(t *testing.T) {
 		require.Equal(t, "ns-1/web-app", pdp.Captured[0].Context.Resource.ComponentType)
 	})
 
-	t.Run("denied", func(t *testing.T) {
+	t.Run("denied on existing", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
 		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(comp, nil)
 		svc := &componentServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -155,6 +191,18 @@ func TestUpdateComponent_AuthzCheck(t *testing.T) {
 		_, err := svc.UpdateComponent(testutil.AuthzContext(), "ns-1", comp)
 		require.ErrorIs(t, err, services.ErrForbidden)
 	})
+
+	t.Run("denied on missing reports not found", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(nil, ErrComponentNotFound)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.UpdateComponent(testutil.AuthzContext(), "ns-1", comp)
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
 }
 
 // --- ListComponents ---
@@ -298,6 +346,108 @@ func TestDeleteComponent_AuthzCheck(t *testing.T) {
 	})
 }
 
+// --- ArchiveComponent / UnarchiveComponent ---
+
+func TestArchiveComponent_AuthzCheck(t *testing.T) {
+	fetched := testComp()
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		mockSvc.On("ArchiveComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.ArchiveComponent(testutil.AuthzContext(), "ns-1", "my-comp")
+		require.NoError(t, err)
+		require.Equal(t, fetched, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:update", "component", "my-comp", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.ArchiveComponent(testutil.AuthzContext(), "ns-1", "my-comp")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestUnarchiveComponent_AuthzCheck(t *testing.T) {
+	fetched := testComp()
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		mockSvc.On("UnarchiveComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.UnarchiveComponent(testutil.AuthzContext(), "ns-1", "my-comp")
+		require.NoError(t, err)
+		require.Equal(t, fetched, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:update", "component", "my-comp", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.UnarchiveComponent(testutil.AuthzContext(), "ns-1", "my-comp")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// --- TransferComponent ---
+
+func TestTransferComponent_AuthzCheck(t *testing.T) {
+	fetched := testComp()
+	req := &TransferComponentRequest{TargetProject: "other-proj"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		mockSvc.On("TransferComponent", mock.Anything, "ns-1", "my-comp", req).Return(&TransferResult{ComponentName: "my-comp"}, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.TransferComponent(testutil.AuthzContext(), "ns-1", "my-comp", req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:update", "component", "my-comp", compHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "component:create", "component", "my-comp",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "other-proj", Component: "my-comp"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.TransferComponent(testutil.AuthzContext(), "ns-1", "my-comp", req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
 // --- GenerateRelease ---
 
 func TestGenerateRelease_AuthzCheck(t *testing.T) {
@@ -348,6 +498,41 @@ func TestGenerateRelease_AuthzCheck(t *testing.T) {
 	})
 }
 
+// --- PublishLibraryVersion ---
+
+func TestPublishLibraryVersion_AuthzCheck(t *testing.T) {
+	fetched := testComp()
+	publishReq := &PublishLibraryVersionRequest{Version: "1.0.0", Artifact: "registry.example.com/lib:1.0.0"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		mockSvc.On("PublishLibraryVersion", mock.Anything, "ns-1", "my-comp", publishReq).Return(fetched, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.PublishLibraryVersion(testutil.AuthzContext(), "ns-1", "my-comp", publishReq)
+		require.NoError(t, err)
+		require.Equal(t, fetched, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:update", "component", "my-comp", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.PublishLibraryVersion(testutil.AuthzContext(), "ns-1", "my-comp", publishReq)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
 // --- GetComponentSchema ---
 
 func TestGetComponentSchema_AuthzCheck(t *testing.T) {
@@ -446,6 +631,40 @@ func TestGetComponentReleaseSchema_AuthzCheck(t *testing.T) {
 	})
 }
 
+// --- GetComponentObserverURL ---
+
+func TestGetComponentObserverURL_AuthzCheck(t *testing.T) {
+	fetched := testComp()
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		mockSvc.On("GetComponentObserverURL", mock.Anything, "ns-1", "my-comp", "dev").Return("http://observer.dev", nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.GetComponentObserverURL(testutil.AuthzContext(), "ns-1", "my-comp", "dev")
+		require.NoError(t, err)
+		require.Equal(t, "http://observer.dev", result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:view", "component", "my-comp", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetComponent", mock.Anything, "ns-1", "my-comp").Return(fetched, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.GetComponentObserverURL(testutil.AuthzContext(), "ns-1", "my-comp", "dev")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
 func TestFormatComponentTypeAttr(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -485,3 +704,51 @@ func TestFormatComponentTypeAttr(t *testing.T) {
 		})
 	}
 }
+
+// --- ListOrphanedResources ---
+
+func TestListOrphanedResources_AuthzCheck(t *testing.T) {
+	report := &OrphanReport{
+		Orphaned: []OrphanedResource{
+			{OrphanedResource: openchoreov1alpha1.OrphanedResource{Name: "a", Project: "my-proj", Component: "my-comp"}},
+			{OrphanedResource: openchoreov1alpha1.OrphanedResource{Name: "b"}},
+		},
+		Missing: []MissingResource{
+			{MissingResource: openchoreov1alpha1.MissingResource{Name: "c", Project: "my-proj"}},
+		},
+	}
+
+	t.Run("all allowed — checks the most specific owner", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("ListOrphanedResources", mock.Anything, "ns-1", OrphanReportFilter{}).Return(report, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.ListOrphanedResources(testutil.AuthzContext(), "ns-1", OrphanReportFilter{})
+		require.NoError(t, err)
+		require.Len(t, result.Orphaned, 2)
+		require.Len(t, result.Missing, 1)
+		require.Len(t, pdp.Captured, 3)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:view", "component", "my-comp", compHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "namespace:view", "namespace", "ns-1",
+			authzcore.ResourceHierarchy{Namespace: "ns-1"})
+		testutil.RequireEvalRequest(t, pdp.Captured[2], "project:view", "project", "my-proj",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "my-proj"})
+	})
+
+	t.Run("all denied — empty report", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("ListOrphanedResources", mock.Anything, "ns-1", OrphanReportFilter{}).Return(report, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.ListOrphanedResources(testutil.AuthzContext(), "ns-1", OrphanReportFilter{})
+		require.NoError(t, err)
+		require.Empty(t, result.Orphaned)
+		require.Empty(t, result.Missing)
+	})
+}
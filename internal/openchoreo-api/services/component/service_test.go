@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,11 +20,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
@@ -347,6 +350,25 @@ func TestCreateComponent(t *testing.T) {
 		require.ErrorIs(t, err, ErrComponentAlreadyExists)
 	})
 
+	t.Run("project component quota exhausted", func(t *testing.T) {
+		project := testProject()
+		project.Spec.Quota = &openchoreov1alpha1.ProjectQuota{MaxComponents: ptr.To[int32](1)}
+		svc := newService(t, project, testComponent())
+		comp := &openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "new-comp"},
+			Spec: openchoreov1alpha1.ComponentSpec{
+				Owner:         openchoreov1alpha1.ComponentOwner{ProjectName: testProjectName},
+				ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: "deployment/web-app"},
+			},
+		}
+
+		_, err := svc.CreateComponent(ctx, testNamespace, comp)
+		quotaErr, ok := errors.AsType[*controller.ProjectQuotaExceededError](err)
+		require.True(t, ok, "expected a project quota error, got %v", err)
+		assert.Equal(t, controller.ProjectQuotaComponents, quotaErr.Resource)
+		assert.Equal(t, "1", quotaErr.Usage.String())
+	})
+
 	t.Run("sets project label when labels are nil", func(t *testing.T) {
 		svc := newService(t, testProject())
 		comp := &openchoreov1alpha1.Component{
@@ -361,6 +383,27 @@ func TestCreateComponent(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, testProjectName, result.Labels[labels.LabelKeyProjectName])
 	})
+
+	t.Run("plaintext credential in workflow parameters", func(t *testing.T) {
+		svc := newService(t, testProject())
+		comp := &openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "new-comp"},
+			Spec: openchoreov1alpha1.ComponentSpec{
+				Owner:         openchoreov1alpha1.ComponentOwner{ProjectName: testProjectName},
+				ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: "deployment/web-app"},
+				Workflow: &openchoreov1alpha1.ComponentWorkflowConfig{
+					Name:       "dockerfile-builder",
+					Parameters: &runtime.RawExtension{Raw: []byte(`{"buildEnv":[{"name":"NPM_TOKEN","value":"npm-token-value"}]}`)},
+				},
+			},
+		}
+
+		_, err := svc.CreateComponent(ctx, testNamespace, comp)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Msg, "spec.workflow.parameters.buildEnv[0].value")
+		assert.NotContains(t, validationErr.Msg, "npm-token-value")
+	})
 }
 
 func TestGetComponent(t *testing.T) {
@@ -457,6 +500,27 @@ func TestUpdateComponent(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, testProjectName, result.Labels[labels.LabelKeyProjectName])
 	})
+
+	t.Run("checks workflow parameters only when they change", func(t *testing.T) {
+		existing := testComponent()
+		existing.Spec.Workflow = &openchoreov1alpha1.ComponentWorkflowConfig{
+			Name:       "dockerfile-builder",
+			Parameters: &runtime.RawExtension{Raw: []byte(`{"registry":{"password":"hunter2"}}`)},
+		}
+		svc := newService(t, testProject(), existing)
+
+		update := existing.DeepCopy()
+		update.Spec.AutoDeploy = true
+		_, err := svc.UpdateComponent(ctx, testNamespace, update)
+		require.NoError(t, err)
+
+		update = existing.DeepCopy()
+		update.Spec.Workflow.Parameters = &runtime.RawExtension{Raw: []byte(`{"registry":{"password":"hunter3"}}`)}
+		_, err = svc.UpdateComponent(ctx, testNamespace, update)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Msg, "spec.workflow.parameters.registry.password")
+	})
 }
 
 func TestDeleteComponent(t *testing.T) {
@@ -478,6 +542,41 @@ func TestDeleteComponent(t *testing.T) {
 	})
 }
 
+func TestArchiveComponent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("archive and unarchive", func(t *testing.T) {
+		svc := newService(t, testComponent())
+
+		archived, err := svc.ArchiveComponent(ctx, testNamespace, testComponentName)
+		require.NoError(t, err)
+		assert.True(t, archived.Spec.IsArchived())
+
+		fetched, err := svc.GetComponent(ctx, testNamespace, testComponentName)
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.ComponentStateArchived, fetched.Spec.State)
+
+		// Archiving twice is a no-op
+		_, err = svc.ArchiveComponent(ctx, testNamespace, testComponentName)
+		require.NoError(t, err)
+
+		restored, err := svc.UnarchiveComponent(ctx, testNamespace, testComponentName)
+		require.NoError(t, err)
+		assert.False(t, restored.Spec.IsArchived())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+		_, err := svc.ArchiveComponent(ctx, testNamespace, "nonexistent")
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+}
+
+func TestExcludeArchived(t *testing.T) {
+	assert.Equal(t, "!openchoreo.dev/archived", ExcludeArchived(""))
+	assert.Equal(t, "team=a,!openchoreo.dev/archived", ExcludeArchived("team=a"))
+}
+
 // --- Orchestration Flows ---
 
 func tier3SeedObjects() []client.Object {
@@ -744,6 +843,209 @@ func TestGenerateRelease(t *testing.T) {
 	})
 }
 
+func testLibraryComponent() *openchoreov1alpha1.Component {
+	comp := testComponent()
+	comp.Spec.ComponentType.Name = "library/shared-lib"
+	comp.Status.LibraryVersions = []openchoreov1alpha1.LibraryVersion{
+		{Version: "1.0.0", Artifact: "registry.example.com/shared-lib:1.0.0"},
+	}
+	return comp
+}
+
+func TestGenerateRelease_Library(t *testing.T) {
+	svc := newService(t, testProject(), testDeploymentPipeline(), testLibraryComponent(), testWorkload())
+
+	_, err := svc.GenerateRelease(context.Background(), testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
+	require.ErrorIs(t, err, ErrValidation)
+	assert.Contains(t, err.Error(), "library components cannot be released")
+}
+
+func TestGenerateRelease_Archived(t *testing.T) {
+	comp := testComponent()
+	comp.Spec.State = openchoreov1alpha1.ComponentStateArchived
+	svc := newService(t, testProject(), testDeploymentPipeline(), comp, testComponentType(), testWorkload())
+
+	_, err := svc.GenerateRelease(context.Background(), testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
+	require.ErrorIs(t, err, ErrValidation)
+	assert.Contains(t, err.Error(), "is archived")
+}
+
+func TestGenerateRelease_BlockedByWorkflowRun(t *testing.T) {
+	ctx := context.Background()
+	newRun := func(name string, offset time.Duration) *openchoreov1alpha1.WorkflowRun {
+		created := metav1.NewTime(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC).Add(offset))
+		completed := metav1.NewTime(created.Add(time.Minute))
+		return &openchoreov1alpha1.WorkflowRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: created,
+				Labels: map[string]string{
+					labels.LabelKeyProjectName:   testProjectName,
+					labels.LabelKeyComponentName: testComponentName,
+				},
+			},
+			Status: openchoreov1alpha1.WorkflowRunStatus{CompletedAt: &completed},
+		}
+	}
+	testedRun := func(name string, offset time.Duration, results *openchoreov1alpha1.WorkflowTestResults) *openchoreov1alpha1.WorkflowRun {
+		run := newRun(name, offset)
+		run.Status.TestResults = results
+		return run
+	}
+	failing := &openchoreov1alpha1.WorkflowTestResults{Step: "unit-tests", Tests: 4, Failures: 1, BlocksRelease: true}
+	passing := &openchoreov1alpha1.WorkflowTestResults{Step: "unit-tests", Tests: 4, Passed: true}
+	seedWith := func(project *openchoreov1alpha1.Project, ct *openchoreov1alpha1.ComponentType, runs ...client.Object) []client.Object {
+		comp := testComponent()
+		comp.Spec.Workflow = &openchoreov1alpha1.ComponentWorkflowConfig{
+			Kind: openchoreov1alpha1.WorkflowRefKindWorkflow,
+			Name: "build-go",
+		}
+		return append([]client.Object{project, testDeploymentPipeline(), comp, ct, testWorkload()}, runs...)
+	}
+	seed := func(runs ...client.Object) []client.Object {
+		return seedWith(testProject(), testComponentType(), runs...)
+	}
+
+	t.Run("latest run failed blocking tests", func(t *testing.T) {
+		svc := newService(t, seed(testedRun("run-1", 0, passing), testedRun("run-2", time.Hour, failing))...)
+
+		_, err := svc.GenerateRelease(ctx, testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
+		require.ErrorIs(t, err, ErrValidation)
+		assert.Contains(t, err.Error(), `Tests of workflow run "run-2" failed`)
+	})
+
+	t.Run("latest run passed", func(t *testing.T) {
+		svc := newService(t, seed(testedRun("run-1", 0, failing), testedRun("run-2", time.Hour, passing))...)
+
+		result, err := svc.GenerateRelease(ctx, testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
+		require.NoError(t, err)
+		assert.Equal(t, "v1", result.Name)
+	})
+
+	analyzed := newRun("run-1", 0)
+	analyzed.Status.AnalysisResults = &openchoreov1alpha1.WorkflowAnalysisResults{Step: "lint", High: 2, Medium: 5}
+
+	t.Run("findings exceed the project quality gate", func(t *testing.T) {
+		project := testProject()
+		project.Spec.QualityGate = &openchoreov1alpha1.AnalysisQualityGate{MaxHigh: ptr.To[int32](0)}
+		ct := testComponentType()
+		ct.Spec.QualityGate = &openchoreov1alpha1.AnalysisQualityGate{MaxHigh: ptr.To[int32](5)}
+		svc := newService(t, seedWith(project, ct, analyzed.DeepCopy())...)
+
+		_, err := svc.GenerateRelease(ctx, testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
+		require.ErrorIs(t, err, ErrValidation)
+		assert.Contains(t, err.Error(), "2 high findings exceed the maximum of 0")
+	})
+
+	t.Run("findings exceed the component type quality gate", func(t *testing.T) {
+		ct := testComponentType()
+		ct.Spec.QualityGate = &openchoreov1alpha1.AnalysisQualityGate{MaxMedium: ptr.To[int32](3)}
+		svc := newService(t, seedWith(testProject(), ct, analyzed.DeepCopy())...)
+
+		_, err := svc.GenerateRelease(ctx, testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
+		require.ErrorIs(t, err, ErrValidation)
+		assert.Contains(t, err.Error(), "5 medium findings exceed the maximum of 3")
+	})
+
+	t.Run("findings within the quality gates", func(t *testing.T) {
+		ct := testComponentType()
+		ct.Spec.QualityGate = &openchoreov1alpha1.AnalysisQualityGate{MaxHigh: ptr.To[int32](2)}
+		svc := newService(t, seedWith(testProject(), ct, analyzed.DeepCopy())...)
+
+		_, err := svc.GenerateRelease(ctx, testNamespace, testComponentName, &GenerateReleaseRequest{ReleaseName: "v1"})
+		require.NoError(t, err)
+	})
+}
+
+func TestPublishLibraryVersion(t *testing.T) {
+	ctx := context.Background()
+	newStatusService := func(t *testing.T, objs ...client.Object) Service {
+		t.Helper()
+		k8sClient := fake.NewClientBuilder().WithScheme(newScheme(t)).
+			WithStatusSubresource(&openchoreov1alpha1.Component{}).
+			WithObjects(objs...).Build()
+		return NewService(k8sClient, testLogger())
+	}
+
+	t.Run("records a new version", func(t *testing.T) {
+		svc := newStatusService(t, testLibraryComponent())
+
+		result, err := svc.PublishLibraryVersion(ctx, testNamespace, testComponentName,
+			&PublishLibraryVersionRequest{Version: "1.1.0", Artifact: "registry.example.com/shared-lib:1.1.0"})
+		require.NoError(t, err)
+		assert.Equal(t, componentTypeMeta, result.TypeMeta)
+		require.Len(t, result.Status.LibraryVersions, 2)
+		published := result.Status.FindLibraryVersion("1.1.0")
+		require.NotNil(t, published)
+		assert.Equal(t, "registry.example.com/shared-lib:1.1.0", published.Artifact)
+		assert.False(t, published.PublishedAt.IsZero())
+
+		stored, err := svc.GetComponent(ctx, testNamespace, testComponentName)
+		require.NoError(t, err)
+		assert.NotNil(t, stored.Status.FindLibraryVersion("1.1.0"))
+	})
+
+	t.Run("republishing the same artifact is a no-op", func(t *testing.T) {
+		svc := newStatusService(t, testLibraryComponent())
+
+		result, err := svc.PublishLibraryVersion(ctx, testNamespace, testComponentName,
+			&PublishLibraryVersionRequest{Version: "1.0.0", Artifact: "registry.example.com/shared-lib:1.0.0"})
+		require.NoError(t, err)
+		assert.Len(t, result.Status.LibraryVersions, 1)
+	})
+
+	t.Run("republishing a different artifact conflicts", func(t *testing.T) {
+		svc := newStatusService(t, testLibraryComponent())
+
+		_, err := svc.PublishLibraryVersion(ctx, testNamespace, testComponentName,
+			&PublishLibraryVersionRequest{Version: "1.0.0", Artifact: "registry.example.com/shared-lib:other"})
+		require.ErrorIs(t, err, ErrLibraryVersionExists)
+	})
+
+	t.Run("oldest versions are dropped beyond the limit", func(t *testing.T) {
+		comp := testLibraryComponent()
+		comp.Status.LibraryVersions = nil
+		for i := range maxLibraryVersions {
+			comp.Status.LibraryVersions = append(comp.Status.LibraryVersions, openchoreov1alpha1.LibraryVersion{
+				Version: fmt.Sprintf("0.0.%d", i), Artifact: "a",
+			})
+		}
+		svc := newStatusService(t, comp)
+
+		result, err := svc.PublishLibraryVersion(ctx, testNamespace, testComponentName,
+			&PublishLibraryVersionRequest{Version: "1.0.0", Artifact: "a"})
+		require.NoError(t, err)
+		assert.Len(t, result.Status.LibraryVersions, maxLibraryVersions)
+		assert.Nil(t, result.Status.FindLibraryVersion("0.0.0"))
+		assert.NotNil(t, result.Status.FindLibraryVersion("1.0.0"))
+	})
+
+	t.Run("non-library component", func(t *testing.T) {
+		svc := newStatusService(t, testComponent())
+
+		_, err := svc.PublishLibraryVersion(ctx, testNamespace, testComponentName,
+			&PublishLibraryVersionRequest{Version: "1.0.0", Artifact: "a"})
+		require.ErrorIs(t, err, ErrValidation)
+	})
+
+	t.Run("missing version", func(t *testing.T) {
+		svc := newStatusService(t, testLibraryComponent())
+
+		_, err := svc.PublishLibraryVersion(ctx, testNamespace, testComponentName,
+			&PublishLibraryVersionRequest{Artifact: "a"})
+		require.ErrorIs(t, err, ErrValidation)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		svc := newStatusService(t)
+
+		_, err := svc.PublishLibraryVersion(ctx, testNamespace, testComponentName,
+			&PublishLibraryVersionRequest{Version: "1.0.0", Artifact: "a"})
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+}
+
 func TestGetComponentSchema(t *testing.T) {
 	ctx := context.Background()
 
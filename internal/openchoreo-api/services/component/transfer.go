@@ -0,0 +1,401 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// Statuses reported for each resource moved by a component transfer.
+const (
+	TransferStepPlanned    = "Planned"
+	TransferStepMoved      = "Moved"
+	TransferStepFailed     = "Failed"
+	TransferStepRolledBack = "RolledBack"
+)
+
+// transferTarget is a resource that belongs to the project of a transferred component.
+// setProject moves the fetched object from one project to another in memory. Resources whose
+// owner is immutable are recreated in the other project instead of updated.
+type transferTarget struct {
+	kind       string
+	obj        client.Object
+	setProject func(from, to string)
+	recreate   bool
+}
+
+// TransferComponent moves a component, together with its workloads, releases, release bindings,
+// observability resources, workflow runs and scoped role bindings, to another project of the
+// same namespace. The environments the component is deployed to must be part of the target
+// project's deployment pipeline. Resources are moved one at a time with the component first,
+// since the resources it owns may only follow it to its current project; ComponentReleases are
+// immutable snapshots and are recreated under the same name in the target project. When a
+// resource cannot be moved, the resources moved before it are moved back. A dry run only
+// reports the resources that would be moved.
+func (s *componentService) TransferComponent(ctx context.Context, namespaceName, componentName string, req *TransferComponentRequest) (*TransferResult, error) {
+	if req == nil || req.TargetProject == "" {
+		return nil, &services.ValidationError{Msg: "targetProject is required"}
+	}
+
+	component, err := s.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	sourceProject := component.Spec.Owner.ProjectName
+	if req.TargetProject == sourceProject {
+		return nil, &services.ValidationError{Msg: fmt.Sprintf("component %q already belongs to project %q", componentName, sourceProject)}
+	}
+	if _, err := s.projectService.GetProject(ctx, namespaceName, req.TargetProject); err != nil {
+		return nil, err
+	}
+
+	targets, err := s.planTransfer(ctx, namespaceName, component)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateTransferPipeline(ctx, namespaceName, req.TargetProject, targets); err != nil {
+		return nil, err
+	}
+
+	result := &TransferResult{
+		ComponentName: componentName,
+		SourceProject: sourceProject,
+		TargetProject: req.TargetProject,
+		DryRun:        req.DryRun,
+		Steps:         make([]TransferStep, 0, len(targets)),
+	}
+	for _, t := range targets {
+		result.Steps = append(result.Steps, TransferStep{Kind: t.kind, Name: t.obj.GetName(), Status: TransferStepPlanned})
+	}
+	if req.DryRun {
+		return result, nil
+	}
+
+	for i, t := range targets {
+		if err := s.moveTransferTarget(ctx, t, sourceProject, req.TargetProject); err != nil {
+			result.Steps[i].Status = TransferStepFailed
+			result.Steps[i].Message = err.Error()
+			s.logger.Error("Failed to transfer resource, rolling back", "error", err,
+				"namespace", namespaceName, "component", componentName, "kind", t.kind, "name", t.obj.GetName())
+			rolledBack := s.rollbackTransfer(ctx, targets[:i], result, sourceProject, req.TargetProject)
+			if vErr := services.ExtractValidationError(err); vErr != nil && rolledBack {
+				return nil, vErr
+			}
+			if !rolledBack {
+				return nil, fmt.Errorf("%w: failed to move %s %q and the rollback is incomplete: %w",
+					ErrComponentTransferFailed, t.kind, t.obj.GetName(), err)
+			}
+			return nil, fmt.Errorf("%w: failed to move %s %q: %w", ErrComponentTransferFailed, t.kind, t.obj.GetName(), err)
+		}
+		result.Steps[i].Status = TransferStepMoved
+	}
+
+	s.logger.Info("Transferred component", "namespace", namespaceName, "component", componentName,
+		"sourceProject", sourceProject, "targetProject", req.TargetProject, "resources", len(targets))
+	return result, nil
+}
+
+// planTransfer collects the resources that belong to the component's project and must move with
+// it. The component itself comes first: the webhooks only admit a project change of the resources
+// of a component when it matches the component's current project.
+func (s *componentService) planTransfer(ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component) ([]transferTarget, error) {
+	componentName := component.Name
+	sourceProject := component.Spec.Owner.ProjectName
+	targets := []transferTarget{{kind: "Component", obj: component,
+		setProject: func(from, to string) {
+			if component.Annotations == nil {
+				component.Annotations = map[string]string{}
+			}
+			component.Annotations[controller.AnnotationKeyTransferredFrom] = from
+			component.Spec.Owner.ProjectName = to
+		}}}
+
+	var releases openchoreov1alpha1.ComponentReleaseList
+	if err := s.k8sClient.List(ctx, &releases, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list component releases: %w", err)
+	}
+	for i := range releases.Items {
+		r := &releases.Items[i]
+		if r.Spec.Owner.ComponentName == componentName && r.Spec.Owner.ProjectName == sourceProject {
+			targets = append(targets, transferTarget{kind: "ComponentRelease", obj: r, recreate: true,
+				setProject: func(_, to string) { r.Spec.Owner.ProjectName = to }})
+		}
+	}
+
+	var workloads openchoreov1alpha1.WorkloadList
+	if err := s.k8sClient.List(ctx, &workloads, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list workloads: %w", err)
+	}
+	for i := range workloads.Items {
+		w := &workloads.Items[i]
+		if w.Spec.Owner.ComponentName == componentName && w.Spec.Owner.ProjectName == sourceProject {
+			targets = append(targets, transferTarget{kind: "Workload", obj: w,
+				setProject: func(_, to string) { w.Spec.Owner.ProjectName = to }})
+		}
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := s.k8sClient.List(ctx, &bindings, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for i := range bindings.Items {
+		rb := &bindings.Items[i]
+		if rb.Spec.Owner.ComponentName == componentName && rb.Spec.Owner.ProjectName == sourceProject {
+			targets = append(targets, transferTarget{kind: "ReleaseBinding", obj: rb,
+				setProject: func(_, to string) { rb.Spec.Owner.ProjectName = to }})
+		}
+	}
+
+	var slos openchoreov1alpha1.ServiceLevelObjectiveList
+	if err := s.k8sClient.List(ctx, &slos, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list service level objectives: %w", err)
+	}
+	for i := range slos.Items {
+		slo := &slos.Items[i]
+		if slo.Spec.Owner.ComponentName == componentName && slo.Spec.Owner.ProjectName == sourceProject {
+			targets = append(targets, transferTarget{kind: "ServiceLevelObjective", obj: slo,
+				setProject: func(_, to string) { slo.Spec.Owner.ProjectName = to }})
+		}
+	}
+
+	var logMetrics openchoreov1alpha1.LogMetricList
+	if err := s.k8sClient.List(ctx, &logMetrics, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list log metrics: %w", err)
+	}
+	for i := range logMetrics.Items {
+		lm := &logMetrics.Items[i]
+		if lm.Spec.Owner.ComponentName == componentName && lm.Spec.Owner.ProjectName == sourceProject {
+			targets = append(targets, transferTarget{kind: "LogMetric", obj: lm,
+				setProject: func(_, to string) { lm.Spec.Owner.ProjectName = to }})
+		}
+	}
+
+	var detectors openchoreov1alpha1.AnomalyDetectorList
+	if err := s.k8sClient.List(ctx, &detectors, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list anomaly detectors: %w", err)
+	}
+	for i := range detectors.Items {
+		ad := &detectors.Items[i]
+		if ad.Spec.Owner.ComponentName == componentName && ad.Spec.Owner.ProjectName == sourceProject {
+			targets = append(targets, transferTarget{kind: "AnomalyDetector", obj: ad,
+				setProject: func(_, to string) { ad.Spec.Owner.ProjectName = to }})
+		}
+	}
+
+	var mappings openchoreov1alpha1.DomainMappingList
+	if err := s.k8sClient.List(ctx, &mappings, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list domain mappings: %w", err)
+	}
+	for i := range mappings.Items {
+		dm := &mappings.Items[i]
+		if dm.Spec.Owner.ComponentName == componentName && dm.Spec.Owner.ProjectName == sourceProject {
+			targets = append(targets, transferTarget{kind: "DomainMapping", obj: dm,
+				setProject: func(_, to string) { dm.Spec.Owner.ProjectName = to }})
+		}
+	}
+
+	// Workflow runs only record their project in a label, which is updated with every target.
+	var runs openchoreov1alpha1.WorkflowRunList
+	if err := s.k8sClient.List(ctx, &runs, client.InNamespace(namespaceName), client.MatchingLabels{
+		labels.LabelKeyProjectName:   sourceProject,
+		labels.LabelKeyComponentName: componentName,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+	for i := range runs.Items {
+		targets = append(targets, transferTarget{kind: "WorkflowRun", obj: &runs.Items[i],
+			setProject: func(_, _ string) {}})
+	}
+
+	var roleBindings openchoreov1alpha1.AuthzRoleBindingList
+	if err := s.k8sClient.List(ctx, &roleBindings, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %w", err)
+	}
+	for i := range roleBindings.Items {
+		rb := &roleBindings.Items[i]
+		if !roleBindingScopesComponent(rb, sourceProject, componentName) {
+			continue
+		}
+		targets = append(targets, transferTarget{kind: "AuthzRoleBinding", obj: rb,
+			setProject: func(from, to string) {
+				for j := range rb.Spec.RoleMappings {
+					if scope := &rb.Spec.RoleMappings[j].Scope; scope.Project == from && scope.Component == componentName {
+						scope.Project = to
+					}
+				}
+			}})
+	}
+
+	var clusterRoleBindings openchoreov1alpha1.ClusterAuthzRoleBindingList
+	if err := s.k8sClient.List(ctx, &clusterRoleBindings); err != nil {
+		return nil, fmt.Errorf("failed to list cluster role bindings: %w", err)
+	}
+	for i := range clusterRoleBindings.Items {
+		crb := &clusterRoleBindings.Items[i]
+		if !clusterRoleBindingScopesComponent(crb, namespaceName, sourceProject, componentName) {
+			continue
+		}
+		targets = append(targets, transferTarget{kind: "ClusterAuthzRoleBinding", obj: crb,
+			setProject: func(from, to string) {
+				for j := range crb.Spec.RoleMappings {
+					scope := &crb.Spec.RoleMappings[j].Scope
+					if scope.Namespace == namespaceName && scope.Project == from && scope.Component == componentName {
+						scope.Project = to
+					}
+				}
+			}})
+	}
+	return targets, nil
+}
+
+// validateTransferPipeline checks that every environment the component is bound to is part of
+// the deployment pipeline of the target project, so that its bindings stay promotable.
+func (s *componentService) validateTransferPipeline(ctx context.Context, namespaceName, targetProject string, targets []transferTarget) error {
+	var environments []string
+	for _, t := range targets {
+		if rb, ok := t.obj.(*openchoreov1alpha1.ReleaseBinding); ok {
+			environments = append(environments, rb.Spec.Environment)
+		}
+	}
+	if len(environments) == 0 {
+		return nil
+	}
+
+	project, err := s.projectService.GetProject(ctx, namespaceName, targetProject)
+	if err != nil {
+		return err
+	}
+	pipelineName := project.Spec.DeploymentPipelineRef.Name
+	pipeline := &openchoreov1alpha1.DeploymentPipeline{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: pipelineName}, pipeline); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return &services.ValidationError{Msg: fmt.Sprintf("deployment pipeline %q of project %q not found", pipelineName, targetProject)}
+		}
+		return fmt.Errorf("failed to get deployment pipeline: %w", err)
+	}
+
+	inPipeline := make(map[string]bool)
+	for _, path := range pipeline.Spec.PromotionPaths {
+		inPipeline[path.SourceEnvironmentRef.Name] = true
+		for _, target := range path.TargetEnvironmentRefs {
+			inPipeline[target.Name] = true
+		}
+	}
+	for _, env := range environments {
+		if !inPipeline[env] {
+			return &services.ValidationError{Msg: fmt.Sprintf(
+				"environment %q is not part of deployment pipeline %q of project %q", env, pipelineName, targetProject)}
+		}
+	}
+	return nil
+}
+
+// moveTransferTarget moves a resource from one project to another, refetching it on conflicts.
+func (s *componentService) moveTransferTarget(ctx context.Context, t transferTarget, from, to string) error {
+	if t.recreate {
+		return s.recreateTransferTarget(ctx, t, from, to)
+	}
+	key := client.ObjectKeyFromObject(t.obj)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := s.k8sClient.Get(ctx, key, t.obj); err != nil {
+			return err
+		}
+		t.setProject(from, to)
+		relabelProject(t.obj, from, to)
+		return s.k8sClient.Update(ctx, t.obj)
+	})
+}
+
+// recreateTransferTarget moves a resource with an immutable owner by replacing it with a copy of
+// the same name in the other project. The original is only deleted if it did not change since it
+// was read, and it is restored when the copy cannot be created.
+func (s *componentService) recreateTransferTarget(ctx context.Context, t transferTarget, from, to string) error {
+	if err := s.k8sClient.Get(ctx, client.ObjectKeyFromObject(t.obj), t.obj); err != nil {
+		return err
+	}
+	original, ok := t.obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("failed to copy %s %q", t.kind, t.obj.GetName())
+	}
+	uid, resourceVersion := original.GetUID(), original.GetResourceVersion()
+	if err := s.k8sClient.Delete(ctx, original,
+		client.Preconditions{UID: &uid, ResourceVersion: &resourceVersion}); err != nil {
+		return err
+	}
+
+	t.setProject(from, to)
+	relabelProject(t.obj, from, to)
+	resetForCreate(t.obj)
+	if err := s.k8sClient.Create(ctx, t.obj); err != nil {
+		resetForCreate(original)
+		if restoreErr := s.k8sClient.Create(ctx, original); restoreErr != nil {
+			return fmt.Errorf("%w; restoring the original failed: %w", err, restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// relabelProject updates the project label of an object moved from one project to another.
+func relabelProject(obj client.Object, from, to string) {
+	if objLabels := obj.GetLabels(); objLabels[labels.LabelKeyProjectName] == from {
+		objLabels[labels.LabelKeyProjectName] = to
+	}
+}
+
+// resetForCreate clears the server-populated metadata of a fetched object so it can be created again.
+func resetForCreate(obj client.Object) {
+	obj.SetUID("")
+	obj.SetResourceVersion("")
+	obj.SetGeneration(0)
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetDeletionTimestamp(nil)
+	obj.SetManagedFields(nil)
+}
+
+// rollbackTransfer moves already moved resources back to the source project, the component first
+// so that the resources it owns may follow it. It reports whether every resource was moved back.
+func (s *componentService) rollbackTransfer(ctx context.Context, moved []transferTarget, result *TransferResult, sourceProject, targetProject string) bool {
+	complete := true
+	for i := range moved {
+		t := moved[i]
+		if err := s.moveTransferTarget(ctx, t, targetProject, sourceProject); err != nil {
+			complete = false
+			result.Steps[i].Message = fmt.Sprintf("rollback failed: %v", err)
+			s.logger.Error("Failed to roll back transferred resource", "error", err,
+				"kind", t.kind, "name", t.obj.GetName(), "project", targetProject)
+			continue
+		}
+		result.Steps[i].Status = TransferStepRolledBack
+	}
+	return complete
+}
+
+func roleBindingScopesComponent(rb *openchoreov1alpha1.AuthzRoleBinding, projectName, componentName string) bool {
+	for _, m := range rb.Spec.RoleMappings {
+		if m.Scope.Project == projectName && m.Scope.Component == componentName {
+			return true
+		}
+	}
+	return false
+}
+
+func clusterRoleBindingScopesComponent(crb *openchoreov1alpha1.ClusterAuthzRoleBinding, namespaceName, projectName, componentName string) bool {
+	for _, m := range crb.Spec.RoleMappings {
+		if m.Scope.Namespace == namespaceName && m.Scope.Project == projectName && m.Scope.Component == componentName {
+			return true
+		}
+	}
+	return false
+}
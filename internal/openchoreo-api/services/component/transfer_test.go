@@ -0,0 +1,211 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testTargetProject = "target-project"
+
+func transferSeedObjects() []client.Object {
+	target := testProject()
+	target.Name = testTargetProject
+
+	binding := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "dev", "test-comp-dev")
+	binding.Labels = map[string]string{labels.LabelKeyProjectName: testProjectName}
+	run := testutil.NewWorkflowRun(testNamespace, "build", "test-comp-build-1")
+	run.Labels = map[string]string{
+		labels.LabelKeyProjectName:   testProjectName,
+		labels.LabelKeyComponentName: testComponentName,
+	}
+	roleBinding := &openchoreov1alpha1.AuthzRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "comp-devs", Namespace: testNamespace},
+		Spec: openchoreov1alpha1.AuthzRoleBindingSpec{
+			RoleMappings: []openchoreov1alpha1.RoleMapping{
+				{Scope: openchoreov1alpha1.TargetScope{Project: testProjectName, Component: testComponentName}},
+				{Scope: openchoreov1alpha1.TargetScope{Project: testProjectName}},
+			},
+		},
+	}
+
+	return []client.Object{
+		testProject(),
+		target,
+		testDeploymentPipeline(),
+		testComponent(),
+		testutil.NewWorkload(testNamespace, testProjectName, testComponentName, "test-comp-workload"),
+		testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, "test-comp-v1"),
+		testutil.NewComponentRelease(testNamespace, testProjectName, "other-comp", "other-comp-v1"),
+		binding,
+		run,
+		roleBinding,
+	}
+}
+
+func TestTransferComponent(t *testing.T) {
+	ctx := context.Background()
+	req := &TransferComponentRequest{TargetProject: testTargetProject}
+
+	t.Run("moves the component and its resources", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(transferSeedObjects()...).Build()
+		svc := NewService(k8sClient, testLogger())
+
+		result, err := svc.TransferComponent(ctx, testNamespace, testComponentName, req)
+		require.NoError(t, err)
+		assert.Equal(t, testProjectName, result.SourceProject)
+		require.Len(t, result.Steps, 6)
+		for _, step := range result.Steps {
+			assert.Equal(t, TransferStepMoved, step.Status, "%s %s", step.Kind, step.Name)
+		}
+		assert.Equal(t, "Component", result.Steps[0].Kind)
+
+		comp := &openchoreov1alpha1.Component{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: testComponentName}, comp))
+		assert.Equal(t, testTargetProject, comp.Spec.Owner.ProjectName)
+		assert.Equal(t, testTargetProject, comp.Labels[labels.LabelKeyProjectName])
+		assert.Equal(t, testProjectName, comp.Annotations[controller.AnnotationKeyTransferredFrom])
+
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "test-comp-dev"}, rb))
+		assert.Equal(t, testTargetProject, rb.Spec.Owner.ProjectName)
+		assert.Equal(t, testTargetProject, rb.Labels[labels.LabelKeyProjectName])
+
+		release := &openchoreov1alpha1.ComponentRelease{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "test-comp-v1"}, release))
+		assert.Equal(t, testTargetProject, release.Spec.Owner.ProjectName)
+		assert.Equal(t, testComponentName, release.Spec.Owner.ComponentName)
+
+		other := &openchoreov1alpha1.ComponentRelease{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "other-comp-v1"}, other))
+		assert.Equal(t, testProjectName, other.Spec.Owner.ProjectName)
+
+		run := &openchoreov1alpha1.WorkflowRun{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "test-comp-build-1"}, run))
+		assert.Equal(t, testTargetProject, run.Labels[labels.LabelKeyProjectName])
+
+		roleBinding := &openchoreov1alpha1.AuthzRoleBinding{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "comp-devs"}, roleBinding))
+		assert.Equal(t, testTargetProject, roleBinding.Spec.RoleMappings[0].Scope.Project)
+		assert.Equal(t, testProjectName, roleBinding.Spec.RoleMappings[1].Scope.Project)
+	})
+
+	t.Run("dry run only reports the plan", func(t *testing.T) {
+		svc := newService(t, transferSeedObjects()...)
+
+		result, err := svc.TransferComponent(ctx, testNamespace, testComponentName,
+			&TransferComponentRequest{TargetProject: testTargetProject, DryRun: true})
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		require.Len(t, result.Steps, 6)
+		assert.Equal(t, TransferStepPlanned, result.Steps[0].Status)
+
+		comp, err := svc.GetComponent(ctx, testNamespace, testComponentName)
+		require.NoError(t, err)
+		assert.Equal(t, testProjectName, comp.Spec.Owner.ProjectName)
+	})
+
+	t.Run("rejects the current project", func(t *testing.T) {
+		svc := newService(t, transferSeedObjects()...)
+		_, err := svc.TransferComponent(ctx, testNamespace, testComponentName, &TransferComponentRequest{TargetProject: testProjectName})
+		var vErr *services.ValidationError
+		require.ErrorAs(t, err, &vErr)
+	})
+
+	t.Run("target project not found", func(t *testing.T) {
+		svc := newService(t, transferSeedObjects()...)
+		_, err := svc.TransferComponent(ctx, testNamespace, testComponentName, &TransferComponentRequest{TargetProject: "missing"})
+		require.ErrorIs(t, err, projectsvc.ErrProjectNotFound)
+	})
+
+	t.Run("rejects environments outside the target pipeline", func(t *testing.T) {
+		objs := transferSeedObjects()
+		objs = append(objs, testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "sandbox", "test-comp-sandbox"))
+		svc := newService(t, objs...)
+
+		_, err := svc.TransferComponent(ctx, testNamespace, testComponentName, req)
+		var vErr *services.ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Contains(t, vErr.Msg, `"sandbox"`)
+	})
+
+	t.Run("rolls back moved resources when a resource cannot be moved", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().
+			WithScheme(newScheme(t)).
+			WithObjects(transferSeedObjects()...).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					if rb, ok := obj.(*openchoreov1alpha1.ReleaseBinding); ok && rb.Spec.Owner.ProjectName == testTargetProject {
+						return errors.New("admission webhook unavailable")
+					}
+					return c.Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		svc := NewService(k8sClient, testLogger())
+
+		_, err := svc.TransferComponent(ctx, testNamespace, testComponentName, req)
+		require.ErrorIs(t, err, ErrComponentTransferFailed)
+
+		comp := &openchoreov1alpha1.Component{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: testComponentName}, comp))
+		assert.Equal(t, testProjectName, comp.Spec.Owner.ProjectName)
+
+		release := &openchoreov1alpha1.ComponentRelease{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "test-comp-v1"}, release))
+		assert.Equal(t, testProjectName, release.Spec.Owner.ProjectName)
+
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "test-comp-dev"}, rb))
+		assert.Equal(t, testProjectName, rb.Spec.Owner.ProjectName)
+		assert.Equal(t, testProjectName, rb.Labels[labels.LabelKeyProjectName])
+
+		roleBinding := &openchoreov1alpha1.AuthzRoleBinding{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "comp-devs"}, roleBinding))
+		assert.Equal(t, testProjectName, roleBinding.Spec.RoleMappings[0].Scope.Project)
+	})
+
+	t.Run("restores a component release that cannot be recreated", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().
+			WithScheme(newScheme(t)).
+			WithObjects(transferSeedObjects()...).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if cr, ok := obj.(*openchoreov1alpha1.ComponentRelease); ok && cr.Spec.Owner.ProjectName == testTargetProject {
+						return errors.New("exceeded quota")
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		svc := NewService(k8sClient, testLogger())
+
+		_, err := svc.TransferComponent(ctx, testNamespace, testComponentName, req)
+		require.ErrorIs(t, err, ErrComponentTransferFailed)
+
+		release := &openchoreov1alpha1.ComponentRelease{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "test-comp-v1"}, release))
+		assert.Equal(t, testProjectName, release.Spec.Owner.ProjectName)
+
+		comp := &openchoreov1alpha1.Component{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: testComponentName}, comp))
+		assert.Equal(t, testProjectName, comp.Spec.Owner.ProjectName)
+	})
+}
@@ -9,4 +9,6 @@ var (
 	ErrComponentReleaseNil           = errors.New("component release is nil")
 	ErrComponentReleaseNotFound      = errors.New("component release not found")
 	ErrComponentReleaseAlreadyExists = errors.New("component release already exists")
+	ErrEnvironmentNotFound           = errors.New("environment not found")
+	ErrComponentNotFound             = errors.New("component not found")
 )
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -16,4 +17,7 @@ type Service interface {
 	GetComponentRelease(ctx context.Context, namespaceName, componentReleaseName string) (*openchoreov1alpha1.ComponentRelease, error)
 	CreateComponentRelease(ctx context.Context, namespaceName string, cr *openchoreov1alpha1.ComponentRelease) (*openchoreov1alpha1.ComponentRelease, error)
 	DeleteComponentRelease(ctx context.Context, namespaceName, componentReleaseName string) error
+	DiffComponentReleases(ctx context.Context, namespaceName, baseReleaseName, targetReleaseName, environmentName string) (*models.ComponentReleaseDiff, error)
+	RenderComponentRelease(ctx context.Context, namespaceName, componentReleaseName, environmentName string) (*models.RenderedComponentRelease, error)
+	PruneComponentReleases(ctx context.Context, namespaceName, componentName string, keep int, dryRun bool) (*models.ComponentReleasePrune, error)
 }
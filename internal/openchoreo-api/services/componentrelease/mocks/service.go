@@ -5,9 +5,11 @@ package mocks
 import (
 	context "context"
 
-	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	models "github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	mock "github.com/stretchr/testify/mock"
 
+	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+
 	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
@@ -132,6 +134,68 @@ func (_c *MockService_DeleteComponentRelease_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DiffComponentReleases provides a mock function with given fields: ctx, namespaceName, baseReleaseName, targetReleaseName, environmentName
+func (_m *MockService) DiffComponentReleases(ctx context.Context, namespaceName string, baseReleaseName string, targetReleaseName string, environmentName string) (*models.ComponentReleaseDiff, error) {
+	ret := _m.Called(ctx, namespaceName, baseReleaseName, targetReleaseName, environmentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffComponentReleases")
+	}
+
+	var r0 *models.ComponentReleaseDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*models.ComponentReleaseDiff, error)); ok {
+		return rf(ctx, namespaceName, baseReleaseName, targetReleaseName, environmentName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *models.ComponentReleaseDiff); ok {
+		r0 = rf(ctx, namespaceName, baseReleaseName, targetReleaseName, environmentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ComponentReleaseDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, baseReleaseName, targetReleaseName, environmentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_DiffComponentReleases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffComponentReleases'
+type MockService_DiffComponentReleases_Call struct {
+	*mock.Call
+}
+
+// DiffComponentReleases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - baseReleaseName string
+//   - targetReleaseName string
+//   - environmentName string
+func (_e *MockService_Expecter) DiffComponentReleases(ctx interface{}, namespaceName interface{}, baseReleaseName interface{}, targetReleaseName interface{}, environmentName interface{}) *MockService_DiffComponentReleases_Call {
+	return &MockService_DiffComponentReleases_Call{Call: _e.mock.On("DiffComponentReleases", ctx, namespaceName, baseReleaseName, targetReleaseName, environmentName)}
+}
+
+func (_c *MockService_DiffComponentReleases_Call) Run(run func(ctx context.Context, namespaceName string, baseReleaseName string, targetReleaseName string, environmentName string)) *MockService_DiffComponentReleases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DiffComponentReleases_Call) Return(_a0 *models.ComponentReleaseDiff, _a1 error) *MockService_DiffComponentReleases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_DiffComponentReleases_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*models.ComponentReleaseDiff, error)) *MockService_DiffComponentReleases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentRelease provides a mock function with given fields: ctx, namespaceName, componentReleaseName
 func (_m *MockService) GetComponentRelease(ctx context.Context, namespaceName string, componentReleaseName string) (*v1alpha1.ComponentRelease, error) {
 	ret := _m.Called(ctx, namespaceName, componentReleaseName)
@@ -253,6 +317,129 @@ func (_c *MockService_ListComponentReleases_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// PruneComponentReleases provides a mock function with given fields: ctx, namespaceName, componentName, keep, dryRun
+func (_m *MockService) PruneComponentReleases(ctx context.Context, namespaceName string, componentName string, keep int, dryRun bool) (*models.ComponentReleasePrune, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, keep, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneComponentReleases")
+	}
+
+	var r0 *models.ComponentReleasePrune
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, bool) (*models.ComponentReleasePrune, error)); ok {
+		return rf(ctx, namespaceName, componentName, keep, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, bool) *models.ComponentReleasePrune); ok {
+		r0 = rf(ctx, namespaceName, componentName, keep, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ComponentReleasePrune)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, bool) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, keep, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_PruneComponentReleases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneComponentReleases'
+type MockService_PruneComponentReleases_Call struct {
+	*mock.Call
+}
+
+// PruneComponentReleases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - keep int
+//   - dryRun bool
+func (_e *MockService_Expecter) PruneComponentReleases(ctx interface{}, namespaceName interface{}, componentName interface{}, keep interface{}, dryRun interface{}) *MockService_PruneComponentReleases_Call {
+	return &MockService_PruneComponentReleases_Call{Call: _e.mock.On("PruneComponentReleases", ctx, namespaceName, componentName, keep, dryRun)}
+}
+
+func (_c *MockService_PruneComponentReleases_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, keep int, dryRun bool)) *MockService_PruneComponentReleases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockService_PruneComponentReleases_Call) Return(_a0 *models.ComponentReleasePrune, _a1 error) *MockService_PruneComponentReleases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_PruneComponentReleases_Call) RunAndReturn(run func(context.Context, string, string, int, bool) (*models.ComponentReleasePrune, error)) *MockService_PruneComponentReleases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderComponentRelease provides a mock function with given fields: ctx, namespaceName, componentReleaseName, environmentName
+func (_m *MockService) RenderComponentRelease(ctx context.Context, namespaceName string, componentReleaseName string, environmentName string) (*models.RenderedComponentRelease, error) {
+	ret := _m.Called(ctx, namespaceName, componentReleaseName, environmentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderComponentRelease")
+	}
+
+	var r0 *models.RenderedComponentRelease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.RenderedComponentRelease, error)); ok {
+		return rf(ctx, namespaceName, componentReleaseName, environmentName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.RenderedComponentRelease); ok {
+		r0 = rf(ctx, namespaceName, componentReleaseName, environmentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RenderedComponentRelease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentReleaseName, environmentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_RenderComponentRelease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderComponentRelease'
+type MockService_RenderComponentRelease_Call struct {
+	*mock.Call
+}
+
+// RenderComponentRelease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentReleaseName string
+//   - environmentName string
+func (_e *MockService_Expecter) RenderComponentRelease(ctx interface{}, namespaceName interface{}, componentReleaseName interface{}, environmentName interface{}) *MockService_RenderComponentRelease_Call {
+	return &MockService_RenderComponentRelease_Call{Call: _e.mock.On("RenderComponentRelease", ctx, namespaceName, componentReleaseName, environmentName)}
+}
+
+func (_c *MockService_RenderComponentRelease_Call) Run(run func(ctx context.Context, namespaceName string, componentReleaseName string, environmentName string)) *MockService_RenderComponentRelease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RenderComponentRelease_Call) Return(_a0 *models.RenderedComponentRelease, _a1 error) *MockService_RenderComponentRelease_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_RenderComponentRelease_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.RenderedComponentRelease, error)) *MockService_RenderComponentRelease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockService(t interface {
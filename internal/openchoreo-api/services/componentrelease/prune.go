@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// PruneComponentReleases deletes the ComponentReleases of a component except the newest keep
+// ones. Releases still referenced by the component or one of its ReleaseBindings (see
+// releasesInUse) are kept regardless of their age.
+func (s *componentReleaseService) PruneComponentReleases(ctx context.Context, namespaceName, componentName string, keep int, dryRun bool) (*models.ComponentReleasePrune, error) {
+	s.logger.Debug("Pruning component releases", "namespace", namespaceName, "component", componentName, "keep", keep, "dryRun", dryRun)
+
+	if keep < 1 {
+		return nil, &services.ValidationError{Msg: "keep must be at least 1"}
+	}
+
+	comp := &openchoreov1alpha1.Component{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: componentName}, comp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrComponentNotFound
+		}
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+	projectName := comp.Spec.Owner.ProjectName
+
+	var releaseList openchoreov1alpha1.ComponentReleaseList
+	if err := s.k8sClient.List(ctx, &releaseList, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list component releases: %w", err)
+	}
+	var releases []*openchoreov1alpha1.ComponentRelease
+	for i := range releaseList.Items {
+		cr := &releaseList.Items[i]
+		if cr.Spec.Owner.ProjectName == projectName && cr.Spec.Owner.ComponentName == componentName && cr.DeletionTimestamp == nil {
+			releases = append(releases, cr)
+		}
+	}
+	// Oldest first, so that the newest keep releases are at the end
+	sort.Slice(releases, func(i, j int) bool {
+		ti, tj := releases[i].CreationTimestamp, releases[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return releases[i].Name < releases[j].Name
+	})
+
+	inUse, err := s.releasesInUse(ctx, comp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ComponentReleasePrune{
+		Project:   projectName,
+		Component: componentName,
+		DryRun:    dryRun,
+		Deleted:   []string{},
+		InUse:     []string{},
+	}
+	for i := 0; i < len(releases)-keep; i++ {
+		cr := releases[i]
+		if inUse[cr.Name] {
+			result.InUse = append(result.InUse, cr.Name)
+			continue
+		}
+		if !dryRun {
+			if err := s.k8sClient.Delete(ctx, cr); err != nil && !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to delete component release %s: %w", cr.Name, err)
+			}
+		}
+		result.Deleted = append(result.Deleted, cr.Name)
+	}
+
+	s.logger.Info("Pruned component releases", "namespace", namespaceName, "component", componentName,
+		"deleted", len(result.Deleted), "inUse", len(result.InUse), "dryRun", dryRun)
+	return result, nil
+}
+
+// releasesInUse returns the names of the component's latest release and of the releases its
+// ReleaseBindings reference:
+//   - the bound release, which is also the source release of pending promotions
+//   - both releases of the last promotion: the one still deployed while a promotion is in
+//     progress, and the previous one a bulk promotion restores when it rolls back
+//   - the releases of an automatic rollback or a rollout
+//   - every release in the deployment history of a binding with an active deployment lock, as
+//     a rollback held back by the lock moves to one of them once the lock expires
+func (s *componentReleaseService) releasesInUse(ctx context.Context, comp *openchoreov1alpha1.Component) (map[string]bool, error) {
+	now := time.Now()
+	inUse := map[string]bool{}
+	if comp.Status.LatestRelease != nil {
+		inUse[comp.Status.LatestRelease.Name] = true
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := s.k8sClient.List(ctx, &bindings, client.InNamespace(comp.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for i := range bindings.Items {
+		rb := &bindings.Items[i]
+		if rb.Spec.Owner.ProjectName != comp.Spec.Owner.ProjectName || rb.Spec.Owner.ComponentName != comp.Name {
+			continue
+		}
+		inUse[rb.Spec.ReleaseName] = true
+		if rb.Status.Promotion != nil {
+			inUse[rb.Status.Promotion.FromRelease] = true
+			inUse[rb.Status.Promotion.ToRelease] = true
+		}
+		if rb.Status.Rollback != nil {
+			inUse[rb.Status.Rollback.FailedRelease] = true
+			inUse[rb.Status.Rollback.RolledBackTo] = true
+		}
+		if rb.Status.Rollout != nil {
+			inUse[rb.Status.Rollout.StableRelease] = true
+			inUse[rb.Status.Rollout.CandidateRelease] = true
+		}
+		if rb.Spec.Lock.Active(now) {
+			for _, record := range rb.Status.DeploymentHistory {
+				inUse[record.Release] = true
+			}
+		}
+	}
+	delete(inUse, "")
+	return inUse, nil
+}
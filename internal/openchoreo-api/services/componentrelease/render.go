@@ -0,0 +1,354 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/changelog"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// renderTarget holds the environment inputs shared by the renders of both releases of a diff.
+type renderTarget struct {
+	component      *openchoreov1alpha1.Component
+	project        *openchoreov1alpha1.Project
+	environment    *openchoreov1alpha1.Environment
+	dataPlane      *openchoreov1alpha1.DataPlane
+	releaseBinding *openchoreov1alpha1.ReleaseBinding
+}
+
+// resolveRenderTarget loads the component, its project, the environment, its data plane and
+// the component's ReleaseBinding for the environment, whose overrides are applied to both renders.
+func (s *componentReleaseService) resolveRenderTarget(ctx context.Context, namespaceName, environmentName string,
+	owner openchoreov1alpha1.ComponentReleaseOwner) (*renderTarget, error) {
+	component := &openchoreov1alpha1.Component{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: owner.ComponentName}, component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, &services.ValidationError{Msg: fmt.Sprintf(
+				"component %q no longer exists; releases can only be rendered for existing components", owner.ComponentName)}
+		}
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: owner.ProjectName}, project); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, &services.ValidationError{Msg: fmt.Sprintf(
+				"project %q no longer exists; releases can only be rendered for existing projects", owner.ProjectName)}
+		}
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: environmentName}, env); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrEnvironmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	dpResult, err := controller.GetDataPlaneFromRef(ctx, s.k8sClient, env.Namespace, env.Spec.DataPlaneRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve data plane of environment %q: %w", environmentName, err)
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := s.k8sClient.List(ctx, &bindings, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	var binding *openchoreov1alpha1.ReleaseBinding
+	for i := range bindings.Items {
+		rb := &bindings.Items[i]
+		if rb.Spec.Owner.ProjectName == owner.ProjectName && rb.Spec.Owner.ComponentName == owner.ComponentName &&
+			rb.Spec.Environment == environmentName {
+			binding = rb
+			break
+		}
+	}
+
+	return &renderTarget{
+		component:      component,
+		project:        project,
+		environment:    env,
+		dataPlane:      dpResult.ToDataPlane(),
+		releaseBinding: binding,
+	}, nil
+}
+
+// render renders the resources of a ComponentRelease for the target environment with the
+// same component pipeline the ReleaseBinding controller uses.
+func (s *componentReleaseService) render(ctx context.Context, cr *openchoreov1alpha1.ComponentRelease, target *renderTarget) ([]renderer.RenderedResource, error) {
+	workload := buildWorkloadFromRelease(cr)
+	binding := target.releaseBinding
+	if binding == nil {
+		// Without a binding there are no overrides; the pipeline still needs the environment name.
+		binding = &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: cr.Spec.Owner.ComponentName, Namespace: cr.Namespace},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner: openchoreov1alpha1.ReleaseBindingOwner{
+					ProjectName:   cr.Spec.Owner.ProjectName,
+					ComponentName: cr.Spec.Owner.ComponentName,
+				},
+				Environment: target.environment.Name,
+				ReleaseName: cr.Name,
+			},
+		}
+	}
+
+	secretReferences, err := s.collectSecretReferences(ctx, cr.Namespace, workload, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := s.pipeline.Render(ctx, &componentpipeline.RenderInput{
+		ComponentType:    buildComponentTypeFromRelease(cr),
+		Component:        buildComponentFromRelease(cr),
+		Traits:           buildTraitsFromRelease(cr),
+		Workload:         workload,
+		Environment:      target.environment,
+		ReleaseBinding:   binding,
+		DataPlane:        target.dataPlane,
+		SecretReferences: secretReferences,
+		Metadata:         buildMetadataContext(cr, target),
+	})
+	if err != nil {
+		return nil, &services.ValidationError{Msg: fmt.Sprintf("failed to render component release %q: %v", cr.Name, err)}
+	}
+	return output.Resources, nil
+}
+
+// collectSecretReferences loads the SecretReferences referenced by the workload and the
+// binding overrides. References that do not exist are skipped; the pipeline then renders
+// without them, which is what a diff reviewer needs to see.
+func (s *componentReleaseService) collectSecretReferences(ctx context.Context, namespaceName string,
+	workload *openchoreov1alpha1.Workload, binding *openchoreov1alpha1.ReleaseBinding) (map[string]*openchoreov1alpha1.SecretReference, error) {
+	names := map[string]struct{}{}
+	collect := func(env []openchoreov1alpha1.EnvVar, files []openchoreov1alpha1.FileVar) {
+		for _, e := range env {
+			if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil && e.ValueFrom.SecretKeyRef.Name != "" {
+				names[e.ValueFrom.SecretKeyRef.Name] = struct{}{}
+			}
+		}
+		for _, f := range files {
+			if f.ValueFrom != nil && f.ValueFrom.SecretKeyRef != nil && f.ValueFrom.SecretKeyRef.Name != "" {
+				names[f.ValueFrom.SecretKeyRef.Name] = struct{}{}
+			}
+		}
+	}
+	collect(workload.Spec.Container.Env, workload.Spec.Container.Files)
+	if binding.Spec.WorkloadOverrides != nil && binding.Spec.WorkloadOverrides.Container != nil {
+		collect(binding.Spec.WorkloadOverrides.Container.Env, binding.Spec.WorkloadOverrides.Container.Files)
+	}
+
+	secretRefs := make(map[string]*openchoreov1alpha1.SecretReference, len(names))
+	for name := range names {
+		secretRef := &openchoreov1alpha1.SecretReference{}
+		if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: name}, secretRef); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get SecretReference %q: %w", name, err)
+		}
+		secretRefs[name] = secretRef
+	}
+	return secretRefs, nil
+}
+
+// diffRenderedResources pairs the resources rendered from two releases by target plane,
+// API version, kind, namespace and name, and reports each pair that differs.
+func diffRenderedResources(base, target []renderer.RenderedResource) []models.RenderedResourceDiff {
+	type entry struct {
+		diff     models.RenderedResourceDiff
+		resource map[string]any
+	}
+	index := func(resources []renderer.RenderedResource) map[string]entry {
+		out := make(map[string]entry, len(resources))
+		for _, r := range resources {
+			obj := unstructured.Unstructured{Object: r.Resource}
+			d := models.RenderedResourceDiff{
+				APIVersion:  obj.GetAPIVersion(),
+				Kind:        obj.GetKind(),
+				Name:        obj.GetName(),
+				Namespace:   obj.GetNamespace(),
+				TargetPlane: r.TargetPlane,
+			}
+			key := fmt.Sprintf("%s|%s|%s|%s|%s", d.TargetPlane, d.APIVersion, d.Kind, d.Namespace, d.Name)
+			out[key] = entry{diff: d, resource: r.Resource}
+		}
+		return out
+	}
+	baseIndex := index(base)
+	targetIndex := index(target)
+
+	keys := make([]string, 0, len(baseIndex)+len(targetIndex))
+	for k := range baseIndex {
+		keys = append(keys, k)
+	}
+	for k := range targetIndex {
+		if _, ok := baseIndex[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var diffs []models.RenderedResourceDiff
+	for _, k := range keys {
+		b, inBase := baseIndex[k]
+		t, inTarget := targetIndex[k]
+		switch {
+		case !inBase:
+			t.diff.Type = openchoreov1alpha1.ReleaseChangeAdded
+			diffs = append(diffs, t.diff)
+		case !inTarget:
+			b.diff.Type = openchoreov1alpha1.ReleaseChangeRemoved
+			diffs = append(diffs, b.diff)
+		default:
+			if changes := changelog.DiffManifests(b.resource, t.resource); len(changes) > 0 {
+				t.diff.Type = openchoreov1alpha1.ReleaseChangeModified
+				t.diff.Changes = changes
+				diffs = append(diffs, t.diff)
+			}
+		}
+	}
+	return diffs
+}
+
+// buildMetadataContext mirrors releasebinding's metadata context for rendering.
+func buildMetadataContext(cr *openchoreov1alpha1.ComponentRelease, target *renderTarget) pipelinecontext.MetadataContext {
+	namespaceName := cr.Namespace
+	projectName := cr.Spec.Owner.ProjectName
+	componentName := cr.Spec.Owner.ComponentName
+	environmentName := target.environment.Name
+	componentUID := string(target.component.UID)
+	projectUID := string(target.project.UID)
+	environmentUID := string(target.environment.UID)
+
+	podSelectors := map[string]string{
+		labels.LabelKeyNamespaceName:   namespaceName,
+		labels.LabelKeyProjectName:     projectName,
+		labels.LabelKeyComponentName:   componentName,
+		labels.LabelKeyEnvironmentName: environmentName,
+		labels.LabelKeyComponentUID:    componentUID,
+		labels.LabelKeyEnvironmentUID:  environmentUID,
+		labels.LabelKeyProjectUID:      projectUID,
+	}
+	standardLabels := make(map[string]string, len(podSelectors))
+	for k, v := range podSelectors {
+		standardLabels[k] = v
+	}
+
+	return pipelinecontext.MetadataContext{
+		Name: dpkubernetes.GenerateK8sName(componentName, environmentName),
+		Namespace: dpkubernetes.GenerateK8sNameWithLengthLimit(
+			dpkubernetes.MaxNamespaceNameLength,
+			"dp", namespaceName, projectName, environmentName,
+		),
+		ComponentNamespace: namespaceName,
+		Labels:             standardLabels,
+		Annotations:        map[string]string{},
+		PodSelectors:       podSelectors,
+		ComponentName:      componentName,
+		ComponentUID:       componentUID,
+		ProjectName:        projectName,
+		ProjectUID:         projectUID,
+		DataPlaneName:      target.dataPlane.Name,
+		DataPlaneUID:       string(target.dataPlane.UID),
+		EnvironmentName:    environmentName,
+		EnvironmentUID:     environmentUID,
+	}
+}
+
+// Mirrors releasebinding.buildComponentFromRelease.
+func buildComponentFromRelease(cr *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Component {
+	var parameters *runtime.RawExtension
+	var traits []openchoreov1alpha1.ComponentTrait
+
+	if cr.Spec.ComponentProfile != nil {
+		parameters = cr.Spec.ComponentProfile.Parameters
+		traits = make([]openchoreov1alpha1.ComponentTrait, 0, len(cr.Spec.ComponentProfile.Traits))
+		for _, pt := range cr.Spec.ComponentProfile.Traits {
+			traits = append(traits, openchoreov1alpha1.ComponentTrait(pt))
+		}
+	}
+
+	return &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cr.Spec.Owner.ComponentName,
+			Namespace: cr.Namespace,
+		},
+		Spec: openchoreov1alpha1.ComponentSpec{
+			Owner: openchoreov1alpha1.ComponentOwner{
+				ProjectName: cr.Spec.Owner.ProjectName,
+			},
+			Parameters: parameters,
+			Traits:     traits,
+		},
+	}
+}
+
+// Mirrors releasebinding.buildComponentTypeFromRelease.
+func buildComponentTypeFromRelease(cr *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.ComponentType {
+	return &openchoreov1alpha1.ComponentType{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cr.Spec.ComponentType.Name,
+			Namespace: cr.Namespace,
+		},
+		Spec: *cr.Spec.ComponentType.Spec.DeepCopy(),
+	}
+}
+
+// Mirrors releasebinding.buildTraitsFromRelease.
+func buildTraitsFromRelease(cr *openchoreov1alpha1.ComponentRelease) []openchoreov1alpha1.Trait {
+	if len(cr.Spec.Traits) == 0 {
+		return nil
+	}
+
+	traits := make([]openchoreov1alpha1.Trait, 0, len(cr.Spec.Traits))
+	for _, rt := range cr.Spec.Traits {
+		traits = append(traits, openchoreov1alpha1.Trait{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       string(rt.Kind),
+				APIVersion: openchoreov1alpha1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      rt.Name,
+				Namespace: cr.Namespace,
+			},
+			Spec: rt.Spec,
+		})
+	}
+	return traits
+}
+
+// Mirrors releasebinding.buildWorkloadFromRelease.
+func buildWorkloadFromRelease(cr *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Workload {
+	return &openchoreov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "from-release",
+			Namespace: cr.Namespace,
+		},
+		Spec: openchoreov1alpha1.WorkloadSpec{
+			Owner: openchoreov1alpha1.WorkloadOwner{
+				ProjectName:   cr.Spec.Owner.ProjectName,
+				ComponentName: cr.Spec.Owner.ComponentName,
+			},
+			WorkloadTemplateSpec: cr.Spec.Workload,
+		},
+	}
+}
@@ -7,19 +7,24 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/changelog"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 )
 
 // componentReleaseService handles component release business logic without authorization checks.
 // Other services within this layer should use this directly to avoid double authz.
 type componentReleaseService struct {
 	k8sClient client.Client
+	pipeline  *componentpipeline.Pipeline
 	logger    *slog.Logger
 }
 
@@ -34,6 +39,7 @@ var _ Service = (*componentReleaseService)(nil)
 func NewService(k8sClient client.Client, logger *slog.Logger) Service {
 	return &componentReleaseService{
 		k8sClient: k8sClient,
+		pipeline:  componentpipeline.NewPipeline(),
 		logger:    logger,
 	}
 }
@@ -162,3 +168,86 @@ func (s *componentReleaseService) DeleteComponentRelease(ctx context.Context, na
 	s.logger.Debug("Component release deleted successfully", "namespace", namespaceName, "componentRelease", componentReleaseName)
 	return nil
 }
+
+func (s *componentReleaseService) DiffComponentReleases(ctx context.Context, namespaceName, baseReleaseName, targetReleaseName, environmentName string) (*models.ComponentReleaseDiff, error) {
+	s.logger.Debug("Diffing component releases", "namespace", namespaceName,
+		"baseRelease", baseReleaseName, "targetRelease", targetReleaseName, "environment", environmentName)
+
+	base, err := s.GetComponentRelease(ctx, namespaceName, baseReleaseName)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.GetComponentRelease(ctx, namespaceName, targetReleaseName)
+	if err != nil {
+		return nil, err
+	}
+	if base.Spec.Owner != target.Spec.Owner {
+		return nil, &services.ValidationError{Msg: fmt.Sprintf(
+			"component releases %q and %q belong to different components", baseReleaseName, targetReleaseName)}
+	}
+
+	diff := &models.ComponentReleaseDiff{
+		Project:       base.Spec.Owner.ProjectName,
+		Component:     base.Spec.Owner.ComponentName,
+		BaseRelease:   baseReleaseName,
+		TargetRelease: targetReleaseName,
+		Changes:       changelog.Diff(base, target),
+	}
+	if diff.Changes == nil {
+		diff.Changes = []openchoreov1alpha1.ReleaseChange{}
+	}
+	if environmentName == "" {
+		return diff, nil
+	}
+
+	// Render both releases against the same environment so that the diff also shows
+	// how the changes surface in the resources applied to the data plane.
+	renderTarget, err := s.resolveRenderTarget(ctx, namespaceName, environmentName, base.Spec.Owner)
+	if err != nil {
+		return nil, err
+	}
+	baseResources, err := s.render(ctx, base, renderTarget)
+	if err != nil {
+		return nil, err
+	}
+	targetResources, err := s.render(ctx, target, renderTarget)
+	if err != nil {
+		return nil, err
+	}
+	diff.Environment = environmentName
+	diff.Resources = diffRenderedResources(baseResources, targetResources)
+	return diff, nil
+}
+
+func (s *componentReleaseService) RenderComponentRelease(ctx context.Context, namespaceName, componentReleaseName, environmentName string) (*models.RenderedComponentRelease, error) {
+	s.logger.Debug("Rendering component release", "namespace", namespaceName,
+		"componentRelease", componentReleaseName, "environment", environmentName)
+
+	cr, err := s.GetComponentRelease(ctx, namespaceName, componentReleaseName)
+	if err != nil {
+		return nil, err
+	}
+	// The environment inputs have no history, so the release renders against the current ones.
+	inputsResolvedAt := time.Now().UTC()
+	renderTarget, err := s.resolveRenderTarget(ctx, namespaceName, environmentName, cr.Spec.Owner)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := s.render(ctx, cr, renderTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &models.RenderedComponentRelease{
+		Project:          cr.Spec.Owner.ProjectName,
+		Component:        cr.Spec.Owner.ComponentName,
+		Release:          componentReleaseName,
+		Environment:      environmentName,
+		InputsResolvedAt: inputsResolvedAt,
+		Resources:        make([]models.RenderedResource, 0, len(resources)),
+	}
+	for _, r := range resources {
+		rendered.Resources = append(rendered.Resources, models.RenderedResource{TargetPlane: r.TargetPlane, Resource: r.Resource})
+	}
+	return rendered, nil
+}
@@ -7,10 +7,12 @@ import (
 	"context"
 	"log/slog"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -119,3 +121,46 @@ func (s *componentReleaseServiceWithAuthz) DeleteComponentRelease(ctx context.Co
 	}
 	return s.internal.DeleteComponentRelease(ctx, namespaceName, componentReleaseName)
 }
+
+func (s *componentReleaseServiceWithAuthz) DiffComponentReleases(ctx context.Context, namespaceName, baseReleaseName, targetReleaseName, environmentName string) (*models.ComponentReleaseDiff, error) {
+	// Both releases are revealed by the diff, so viewing each of them must be allowed
+	for _, name := range []string{baseReleaseName, targetReleaseName} {
+		if _, err := s.GetComponentRelease(ctx, namespaceName, name); err != nil {
+			return nil, err
+		}
+	}
+	return s.internal.DiffComponentReleases(ctx, namespaceName, baseReleaseName, targetReleaseName, environmentName)
+}
+
+func (s *componentReleaseServiceWithAuthz) RenderComponentRelease(ctx context.Context, namespaceName, componentReleaseName, environmentName string) (*models.RenderedComponentRelease, error) {
+	// The rendered resources reveal the release, so viewing it must be allowed
+	if _, err := s.GetComponentRelease(ctx, namespaceName, componentReleaseName); err != nil {
+		return nil, err
+	}
+	return s.internal.RenderComponentRelease(ctx, namespaceName, componentReleaseName, environmentName)
+}
+
+func (s *componentReleaseServiceWithAuthz) PruneComponentReleases(ctx context.Context, namespaceName, componentName string, keep int, dryRun bool) (*models.ComponentReleasePrune, error) {
+	// Fetch the component first to get the project for authz
+	comp := &openchoreov1alpha1.Component{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: componentName}, comp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrComponentNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionDeleteComponentRelease,
+		ResourceType: resourceTypeComponentRelease,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.PruneComponentReleases(ctx, namespaceName, componentName, keep, dryRun)
+}
@@ -13,6 +13,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componentrelease/mocks"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
@@ -221,3 +222,122 @@ func TestDeleteComponentRelease_AuthzCheck(t *testing.T) {
 		require.Empty(t, pdp.Captured, "authz should not be called when fetch fails")
 	})
 }
+
+// --- DiffComponentReleases ---
+
+func TestDiffComponentReleases_AuthzCheck(t *testing.T) {
+	base := testCR()
+	target := testCR()
+	target.Name = "my-cr-2"
+
+	t.Run("allowed checks both releases", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetComponentRelease", mock.Anything, "ns-1", "my-cr").Return(base, nil)
+		mockSvc.On("GetComponentRelease", mock.Anything, "ns-1", "my-cr-2").Return(target, nil)
+		mockSvc.On("DiffComponentReleases", mock.Anything, "ns-1", "my-cr", "my-cr-2", "dev").
+			Return(&models.ComponentReleaseDiff{BaseRelease: "my-cr", TargetRelease: "my-cr-2"}, nil)
+		svc := &componentReleaseServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.DiffComponentReleases(testutil.AuthzContext(), "ns-1", "my-cr", "my-cr-2", "dev")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "componentrelease:view", "componentrelease", "my-cr", crHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "componentrelease:view", "componentrelease", "my-cr-2", crHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetComponentRelease", mock.Anything, "ns-1", "my-cr").Return(base, nil)
+		svc := &componentReleaseServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.DiffComponentReleases(testutil.AuthzContext(), "ns-1", "my-cr", "my-cr-2", "")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// --- RenderComponentRelease ---
+
+func TestRenderComponentRelease_AuthzCheck(t *testing.T) {
+	cr := testCR()
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetComponentRelease", mock.Anything, "ns-1", "my-cr").Return(cr, nil)
+		mockSvc.On("RenderComponentRelease", mock.Anything, "ns-1", "my-cr", "dev").
+			Return(&models.RenderedComponentRelease{Release: "my-cr", Environment: "dev"}, nil)
+		svc := &componentReleaseServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.RenderComponentRelease(testutil.AuthzContext(), "ns-1", "my-cr", "dev")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "componentrelease:view", "componentrelease", "my-cr", crHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetComponentRelease", mock.Anything, "ns-1", "my-cr").Return(cr, nil)
+		svc := &componentReleaseServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.RenderComponentRelease(testutil.AuthzContext(), "ns-1", "my-cr", "dev")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// --- PruneComponentReleases ---
+
+func TestPruneComponentReleases_AuthzCheck(t *testing.T) {
+	comp := testutil.NewComponent("ns-1", "my-proj", "my-comp")
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("PruneComponentReleases", mock.Anything, "ns-1", "my-comp", 5, false).
+			Return(&models.ComponentReleasePrune{Project: "my-proj", Component: "my-comp"}, nil)
+		svc := &componentReleaseServiceWithAuthz{
+			internal:  mockSvc,
+			k8sClient: testutil.NewFakeClient(comp),
+			authz:     testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.PruneComponentReleases(testutil.AuthzContext(), "ns-1", "my-comp", 5, false)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "componentrelease:delete", "componentrelease", "my-comp", crHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		svc := &componentReleaseServiceWithAuthz{
+			internal:  mockSvc,
+			k8sClient: testutil.NewFakeClient(comp),
+			authz:     testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.PruneComponentReleases(testutil.AuthzContext(), "ns-1", "my-comp", 5, false)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		svc := &componentReleaseServiceWithAuthz{
+			internal:  mockSvc,
+			k8sClient: testutil.NewFakeClient(),
+			authz:     testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.PruneComponentReleases(testutil.AuthzContext(), "ns-1", "my-comp", 5, false)
+		require.ErrorIs(t, err, ErrComponentNotFound)
+		require.Empty(t, pdp.Captured, "authz should not be called when fetch fails")
+	})
+}
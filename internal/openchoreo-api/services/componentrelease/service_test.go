@@ -7,10 +7,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -193,3 +196,253 @@ func TestDeleteComponentRelease(t *testing.T) {
 		require.ErrorIs(t, err, ErrComponentReleaseNotFound)
 	})
 }
+
+// withUID sets the UID the render pipeline requires on objects created by the fake client.
+func withUID(obj client.Object) client.Object {
+	obj.SetUID(types.UID(obj.GetName() + "-uid"))
+	return obj
+}
+
+func TestDiffComponentReleases(t *testing.T) {
+	ctx := context.Background()
+
+	newRelease := func(name, image string) *openchoreov1alpha1.ComponentRelease {
+		cr := testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, name)
+		cr.Spec.ComponentType.Spec.Resources[0].Template = &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"${metadata.name}"},"data":{"image":"${workload.container.image}"}}`)}
+		cr.Spec.Workload.Container.Image = image
+		return cr
+	}
+
+	t.Run("spec changes", func(t *testing.T) {
+		svc := newService(t, newRelease("rel-1", "app:v1"), newRelease("rel-2", "app:v2"))
+
+		diff, err := svc.DiffComponentReleases(ctx, testNamespace, "rel-1", "rel-2", "")
+		require.NoError(t, err)
+		assert.Equal(t, testComponentName, diff.Component)
+		require.Len(t, diff.Changes, 1)
+		assert.Equal(t, "workload.container.image", diff.Changes[0].Path)
+		assert.Equal(t, `"app:v2"`, diff.Changes[0].To)
+		assert.Empty(t, diff.Resources)
+	})
+
+	t.Run("rendered resource changes", func(t *testing.T) {
+		svc := newService(t, newRelease("rel-1", "app:v1"), newRelease("rel-2", "app:v2"),
+			withUID(testutil.NewComponent(testNamespace, testProjectName, testComponentName)),
+			withUID(testutil.NewProject(testNamespace, testProjectName)),
+			withUID(testutil.NewEnvironment(testNamespace, "dev")),
+			withUID(testutil.NewDataPlane(testNamespace, "default")))
+
+		diff, err := svc.DiffComponentReleases(ctx, testNamespace, "rel-1", "rel-2", "dev")
+		require.NoError(t, err)
+		assert.Equal(t, "dev", diff.Environment)
+		require.Len(t, diff.Resources, 1)
+		assert.Equal(t, "ConfigMap", diff.Resources[0].Kind)
+		assert.Equal(t, openchoreov1alpha1.ReleaseChangeModified, diff.Resources[0].Type)
+		require.Len(t, diff.Resources[0].Changes, 1)
+		assert.Equal(t, "data.image", diff.Resources[0].Changes[0].Path)
+	})
+
+	t.Run("releases of different components", func(t *testing.T) {
+		other := testutil.NewComponentRelease(testNamespace, testProjectName, "other-comp", "rel-2")
+		svc := newService(t, newRelease("rel-1", "app:v1"), other)
+
+		_, err := svc.DiffComponentReleases(ctx, testNamespace, "rel-1", "rel-2", "")
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("release not found", func(t *testing.T) {
+		svc := newService(t, newRelease("rel-1", "app:v1"))
+
+		_, err := svc.DiffComponentReleases(ctx, testNamespace, "rel-1", "missing", "")
+		require.ErrorIs(t, err, ErrComponentReleaseNotFound)
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		svc := newService(t, newRelease("rel-1", "app:v1"), newRelease("rel-2", "app:v2"),
+			testutil.NewComponent(testNamespace, testProjectName, testComponentName),
+			testutil.NewProject(testNamespace, testProjectName))
+
+		_, err := svc.DiffComponentReleases(ctx, testNamespace, "rel-1", "rel-2", "missing")
+		require.ErrorIs(t, err, ErrEnvironmentNotFound)
+	})
+}
+
+func TestRenderComponentRelease(t *testing.T) {
+	ctx := context.Background()
+
+	cr := testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, "rel-1")
+	cr.Spec.ComponentType.Spec.Resources[0].Template = &runtime.RawExtension{Raw: []byte(
+		`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"${metadata.name}"},"data":{"image":"${workload.container.image}"}}`)}
+	cr.Spec.Workload.Container.Image = "app:v1"
+
+	t.Run("renders for the environment", func(t *testing.T) {
+		svc := newService(t, cr,
+			withUID(testutil.NewComponent(testNamespace, testProjectName, testComponentName)),
+			withUID(testutil.NewProject(testNamespace, testProjectName)),
+			withUID(testutil.NewEnvironment(testNamespace, "dev")),
+			withUID(testutil.NewDataPlane(testNamespace, "default")))
+
+		before := time.Now().UTC()
+		rendered, err := svc.RenderComponentRelease(ctx, testNamespace, "rel-1", "dev")
+		require.NoError(t, err)
+		assert.Equal(t, testComponentName, rendered.Component)
+		assert.Equal(t, "dev", rendered.Environment)
+		assert.False(t, rendered.InputsResolvedAt.Before(before), "inputs are resolved at render time")
+		require.Len(t, rendered.Resources, 1)
+		assert.Equal(t, openchoreov1alpha1.TargetPlaneDataPlane, rendered.Resources[0].TargetPlane)
+		assert.Equal(t, map[string]any{"image": "app:v1"}, rendered.Resources[0].Resource["data"])
+	})
+
+	t.Run("release not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.RenderComponentRelease(ctx, testNamespace, "missing", "dev")
+		require.ErrorIs(t, err, ErrComponentReleaseNotFound)
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		svc := newService(t, cr,
+			testutil.NewComponent(testNamespace, testProjectName, testComponentName),
+			testutil.NewProject(testNamespace, testProjectName))
+
+		_, err := svc.RenderComponentRelease(ctx, testNamespace, "rel-1", "missing")
+		require.ErrorIs(t, err, ErrEnvironmentNotFound)
+	})
+}
+
+func TestPruneComponentReleases(t *testing.T) {
+	ctx := context.Background()
+
+	// release returns a release of the test component created age minutes ago
+	release := func(name string, age int) client.Object {
+		cr := testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, name)
+		cr.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Duration(age) * time.Minute))
+		return cr
+	}
+	releaseNames := func(t *testing.T, svc Service) []string {
+		t.Helper()
+		result, err := svc.ListComponentReleases(ctx, testNamespace, testComponentName, services.ListOptions{})
+		require.NoError(t, err)
+		names := make([]string, 0, len(result.Items))
+		for _, item := range result.Items {
+			names = append(names, item.Name)
+		}
+		return names
+	}
+
+	t.Run("deletes all but the newest releases", func(t *testing.T) {
+		comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+		other := testutil.NewComponentRelease(testNamespace, testProjectName, "other-comp", "other-rel")
+		svc := newService(t, comp, other, release("rel-1", 30), release("rel-2", 20), release("rel-3", 10))
+
+		result, err := svc.PruneComponentReleases(ctx, testNamespace, testComponentName, 1, false)
+		require.NoError(t, err)
+		assert.Equal(t, testProjectName, result.Project)
+		assert.Equal(t, []string{"rel-1", "rel-2"}, result.Deleted)
+		assert.Empty(t, result.InUse)
+		assert.Equal(t, []string{"rel-3"}, releaseNames(t, svc))
+
+		_, err = svc.GetComponentRelease(ctx, testNamespace, "other-rel")
+		require.NoError(t, err, "releases of other components must not be pruned")
+	})
+
+	t.Run("keeps releases in use", func(t *testing.T) {
+		comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+		comp.Status.LatestRelease = &openchoreov1alpha1.LatestRelease{Name: "rel-2"}
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "dev", "rb-dev")
+		rb.Spec.ReleaseName = "rel-1"
+		svc := newService(t, comp, rb, release("rel-1", 40), release("rel-2", 30), release("rel-3", 20), release("rel-4", 10))
+
+		result, err := svc.PruneComponentReleases(ctx, testNamespace, testComponentName, 1, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"rel-3"}, result.Deleted)
+		assert.Equal(t, []string{"rel-1", "rel-2"}, result.InUse)
+		assert.ElementsMatch(t, []string{"rel-1", "rel-2", "rel-4"}, releaseNames(t, svc))
+	})
+
+	t.Run("keeps the deployed release of a pending promotion", func(t *testing.T) {
+		comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+		// rel-3 is promoted to prod but not deployed yet, so prod still runs rel-2
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "prod", "rb-prod")
+		rb.Spec.ReleaseName = "rel-3"
+		rb.Status.Promotion = &openchoreov1alpha1.ReleaseBindingPromotion{ToRelease: "rel-2"}
+		svc := newService(t, comp, rb, release("rel-1", 40), release("rel-2", 30), release("rel-3", 20), release("rel-4", 10))
+
+		result, err := svc.PruneComponentReleases(ctx, testNamespace, testComponentName, 1, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"rel-1"}, result.Deleted)
+		assert.Equal(t, []string{"rel-2", "rel-3"}, result.InUse)
+	})
+
+	t.Run("keeps the rollback target of a bulk promotion", func(t *testing.T) {
+		comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+		// Rolling back the promotion of rel-3 to prod restores rel-2
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "prod", "rb-prod")
+		rb.Spec.ReleaseName = "rel-3"
+		rb.Status.Promotion = &openchoreov1alpha1.ReleaseBindingPromotion{FromRelease: "rel-2", ToRelease: "rel-3"}
+		svc := newService(t, comp, rb, release("rel-1", 40), release("rel-2", 30), release("rel-3", 20), release("rel-4", 10))
+
+		result, err := svc.PruneComponentReleases(ctx, testNamespace, testComponentName, 1, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"rel-1"}, result.Deleted)
+		assert.Equal(t, []string{"rel-2", "rel-3"}, result.InUse)
+	})
+
+	t.Run("keeps the deployment history of locked bindings", func(t *testing.T) {
+		comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+		history := []openchoreov1alpha1.DeploymentRecord{{Release: "rel-1"}, {Release: "rel-2"}, {Release: "rel-3"}}
+		locked := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "prod", "rb-prod")
+		locked.Spec.ReleaseName = "rel-3"
+		locked.Spec.Lock = &openchoreov1alpha1.DeploymentLock{Reason: "incident", ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour))}
+		locked.Status.DeploymentHistory = history
+		expired := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "staging", "rb-staging")
+		expired.Spec.ReleaseName = "rel-4"
+		expired.Spec.Lock = &openchoreov1alpha1.DeploymentLock{Reason: "incident", ExpiresAt: metav1.NewTime(time.Now().Add(-time.Hour))}
+		expired.Status.DeploymentHistory = []openchoreov1alpha1.DeploymentRecord{{Release: "rel-2"}, {Release: "rel-4"}}
+		k8sClient := testutil.NewFakeClient(comp, locked, expired,
+			release("rel-1", 50), release("rel-2", 40), release("rel-3", 30), release("rel-4", 20), release("rel-5", 10))
+		svc := NewService(k8sClient, testutil.TestLogger())
+
+		result, err := svc.PruneComponentReleases(ctx, testNamespace, testComponentName, 1, false)
+		require.NoError(t, err)
+		assert.Empty(t, result.Deleted)
+		assert.Equal(t, []string{"rel-1", "rel-2", "rel-3", "rel-4"}, result.InUse)
+
+		// Once the lock expires, only the bound release is kept
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(locked), locked))
+		locked.Spec.Lock.ExpiresAt = metav1.NewTime(time.Now().Add(-time.Minute))
+		require.NoError(t, k8sClient.Update(ctx, locked))
+		result, err = svc.PruneComponentReleases(ctx, testNamespace, testComponentName, 1, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"rel-1", "rel-2"}, result.Deleted)
+		assert.Equal(t, []string{"rel-3", "rel-4"}, result.InUse)
+	})
+
+	t.Run("dry run deletes nothing", func(t *testing.T) {
+		comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+		svc := newService(t, comp, release("rel-1", 20), release("rel-2", 10))
+
+		result, err := svc.PruneComponentReleases(ctx, testNamespace, testComponentName, 1, true)
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, []string{"rel-1"}, result.Deleted)
+		assert.Len(t, releaseNames(t, svc), 2)
+	})
+
+	t.Run("invalid keep", func(t *testing.T) {
+		svc := newService(t, testutil.NewComponent(testNamespace, testProjectName, testComponentName))
+
+		_, err := svc.PruneComponentReleases(ctx, testNamespace, testComponentName, 0, false)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.PruneComponentReleases(ctx, testNamespace, "nonexistent", 1, false)
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+}
@@ -145,6 +145,11 @@ func (s *dataPlaneService) UpdateDataPlane(ctx context.Context, namespaceName st
 		return nil, fmt.Errorf("failed to get data plane: %w", err)
 	}
 
+	// A uid in the request pins the update to that instance of the resource
+	if dp.UID != "" && dp.UID != existing.UID {
+		return nil, services.ErrUIDMismatch
+	}
+
 	// Clear status from user input — status is server-managed
 	dp.Status = openchoreov1alpha1.DataPlaneStatus{}
 
@@ -5,6 +5,7 @@ package dataplane
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -88,11 +89,21 @@ func (s *dataPlaneServiceWithAuthz) UpdateDataPlane(ctx context.Context, namespa
 		ResourceID:   dp.Name,
 		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
 	}); err != nil {
+		// Updating a missing data plane creates it, which the create permission authorizes.
+		if errors.Is(err, services.ErrForbidden) && dp.UID == "" && s.isDataPlaneMissing(ctx, namespaceName, dp.Name) {
+			return nil, ErrDataPlaneNotFound
+		}
 		return nil, err
 	}
 	return s.internal.UpdateDataPlane(ctx, namespaceName, dp)
 }
 
+// isDataPlaneMissing reports whether the data plane does not exist.
+func (s *dataPlaneServiceWithAuthz) isDataPlaneMissing(ctx context.Context, namespaceName, name string) bool {
+	_, err := s.internal.GetDataPlane(ctx, namespaceName, name)
+	return errors.Is(err, ErrDataPlaneNotFound)
+}
+
 func (s *dataPlaneServiceWithAuthz) DeleteDataPlane(ctx context.Context, namespaceName, dpName string) error {
 	if err := s.authz.Check(ctx, services.CheckRequest{
 		Action:       authz.ActionDeleteDataPlane,
@@ -89,9 +89,10 @@ func TestDataPlaneAuthz_UpdateDataPlane(t *testing.T) {
 		testutil.RequireEvalRequest(t, pdp.Captured[0], "dataplane:update", "dataPlane", "dp-1", authzcore.ResourceHierarchy{Namespace: "ns-1"})
 	})
 
-	t.Run("denied", func(t *testing.T) {
+	t.Run("denied on existing", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
 		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetDataPlane", mock.Anything, "ns-1", "dp-1").Return(dp, nil)
 		svc := &dataPlaneServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -99,6 +100,18 @@ func TestDataPlaneAuthz_UpdateDataPlane(t *testing.T) {
 		_, err := svc.UpdateDataPlane(testutil.AuthzContext(), "ns-1", dp)
 		require.ErrorIs(t, err, services.ErrForbidden)
 	})
+
+	t.Run("denied on missing reports not found", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetDataPlane", mock.Anything, "ns-1", "dp-1").Return(nil, ErrDataPlaneNotFound)
+		svc := &dataPlaneServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.UpdateDataPlane(testutil.AuthzContext(), "ns-1", dp)
+		require.ErrorIs(t, err, ErrDataPlaneNotFound)
+	})
 }
 
 func TestDataPlaneAuthz_GetDataPlane(t *testing.T) {
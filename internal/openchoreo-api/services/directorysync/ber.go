@@ -0,0 +1,182 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// This file implements the subset of BER (X.690) needed to speak LDAPv3: single-octet tags,
+// definite lengths, and the universal INTEGER, OCTET STRING, BOOLEAN, ENUMERATED,
+// SEQUENCE and SET types.
+
+const (
+	berTagBoolean     = 0x01
+	berTagInteger     = 0x02
+	berTagOctetString = 0x04
+	berTagEnumerated  = 0x0a
+	berTagSequence    = 0x30
+	berTagSet         = 0x31
+
+	// berMaxElementSize bounds the size of a single element read from the network.
+	berMaxElementSize = 64 << 20
+)
+
+var errBERTruncated = errors.New("ber: truncated element")
+
+// berElement is a decoded BER element.
+type berElement struct {
+	tag     byte
+	content []byte
+}
+
+// berEncode encodes an element from its tag and content.
+func berEncode(tag byte, content []byte) []byte {
+	out := append([]byte{tag}, berLength(len(content))...)
+	return append(out, content...)
+}
+
+// berConstructed encodes a constructed element from its encoded children.
+func berConstructed(tag byte, children ...[]byte) []byte {
+	var content []byte
+	for _, c := range children {
+		content = append(content, c...)
+	}
+	return berEncode(tag, content)
+}
+
+func berString(tag byte, s string) []byte {
+	return berEncode(tag, []byte(s))
+}
+
+func berBool(v bool) []byte {
+	if v {
+		return berEncode(berTagBoolean, []byte{0xff})
+	}
+	return berEncode(berTagBoolean, []byte{0x00})
+}
+
+// berInt encodes a two's complement integer with the given tag (INTEGER or ENUMERATED).
+func berInt(tag byte, v int64) []byte {
+	var b []byte
+	for {
+		b = append([]byte{byte(v)}, b...)
+		v >>= 8
+		if (v == 0 && b[0]&0x80 == 0) || (v == -1 && b[0]&0x80 != 0) {
+			break
+		}
+	}
+	return berEncode(tag, b)
+}
+
+func berLength(n int) []byte {
+	if n < 0x80 {
+		return []byte{byte(n)}
+	}
+	var b []byte
+	for ; n > 0; n >>= 8 {
+		b = append([]byte{byte(n)}, b...)
+	}
+	return append([]byte{0x80 | byte(len(b))}, b...)
+}
+
+// berParse decodes the first element of b and returns it with the remaining bytes.
+func berParse(b []byte) (berElement, []byte, error) {
+	if len(b) < 2 {
+		return berElement{}, nil, errBERTruncated
+	}
+	tag := b[0]
+	if tag&0x1f == 0x1f {
+		return berElement{}, nil, fmt.Errorf("ber: multi-octet tags are not supported")
+	}
+	length, n, err := berParseLength(b[1:])
+	if err != nil {
+		return berElement{}, nil, err
+	}
+	start := 1 + n
+	if length > len(b)-start {
+		return berElement{}, nil, errBERTruncated
+	}
+	return berElement{tag: tag, content: b[start : start+length]}, b[start+length:], nil
+}
+
+// berParseLength decodes a definite length and returns it with the number of octets used.
+func berParseLength(b []byte) (int, int, error) {
+	if len(b) == 0 {
+		return 0, 0, errBERTruncated
+	}
+	if b[0]&0x80 == 0 {
+		return int(b[0]), 1, nil
+	}
+	n := int(b[0] & 0x7f)
+	if n == 0 {
+		return 0, 0, fmt.Errorf("ber: indefinite lengths are not supported")
+	}
+	if n > 4 || len(b) < 1+n {
+		return 0, 0, errBERTruncated
+	}
+	length := 0
+	for _, o := range b[1 : 1+n] {
+		length = length<<8 | int(o)
+	}
+	if length > berMaxElementSize {
+		return 0, 0, fmt.Errorf("ber: element of %d bytes exceeds the size limit", length)
+	}
+	return length, 1 + n, nil
+}
+
+// berRead reads one complete encoded element from r.
+func berRead(r *bufio.Reader) ([]byte, error) {
+	header := make([]byte, 2, 6)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	if header[1]&0x80 != 0 {
+		extra := make([]byte, header[1]&0x7f)
+		if _, err := io.ReadFull(r, extra); err != nil {
+			return nil, err
+		}
+		header = append(header, extra...)
+	}
+	length, _, err := berParseLength(header[1:])
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(header)+length)
+	copy(out, header)
+	if _, err := io.ReadFull(r, out[len(header):]); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// children decodes the content of a constructed element.
+func (e berElement) children() ([]berElement, error) {
+	var out []berElement
+	for rest := e.content; len(rest) > 0; {
+		var child berElement
+		var err error
+		child, rest, err = berParse(rest)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, child)
+	}
+	return out, nil
+}
+
+// int decodes the content of an INTEGER or ENUMERATED element.
+func (e berElement) int() (int64, error) {
+	if len(e.content) == 0 || len(e.content) > 8 {
+		return 0, fmt.Errorf("ber: invalid integer of %d bytes", len(e.content))
+	}
+	v := int64(int8(e.content[0]))
+	for _, o := range e.content[1:] {
+		v = v<<8 | int64(o)
+	}
+	return v, nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Config defines how directory groups map to authz roles.
+type Config struct {
+	// EntitlementClaim is the JWT claim whose value matches the directory user identifier,
+	// for example "email". Synced bindings grant roles to this claim.
+	EntitlementClaim string
+	// Mappings maps directory groups to roles. Groups without a mapping are ignored.
+	Mappings []GroupMapping
+}
+
+// GroupMapping grants roles to every member of a directory group.
+type GroupMapping struct {
+	// Group is the directory group name.
+	Group string
+	// Namespace is the namespace of the synced AuthzRoleBindings. When empty,
+	// ClusterAuthzRoleBindings are synced instead.
+	Namespace string
+	// Roles are the roles granted to the group members.
+	Roles []RoleGrant
+}
+
+// RoleGrant is a role and the scope it is granted at.
+type RoleGrant struct {
+	Kind openchoreov1alpha1.RoleRefKind
+	Name string
+	// Namespace scopes a cluster binding to a namespace. Ignored for namespaced bindings.
+	Namespace string
+	Project   string
+	Component string
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"context"
+	"sort"
+)
+
+// Directory is a source of groups and their members, such as a SCIM service provider or an
+// LDAP server.
+type Directory interface {
+	// Name identifies the directory type in sync reports, for example "scim" or "ldap".
+	Name() string
+	// ListGroups returns every group with its members resolved to user identifiers.
+	// Members of nested groups are included in the parent group.
+	ListGroups(ctx context.Context) ([]Group, error)
+}
+
+// Group is a directory group with the identifiers of its (direct and nested) members.
+// A member identifier is the value of the directory user attribute that matches the
+// configured JWT entitlement claim, for example the user's email address.
+type Group struct {
+	Name    string
+	Members []string
+}
+
+// rawGroup is a group as read from a directory, before nested groups and user references are resolved.
+type rawGroup struct {
+	name string
+	// users holds references to member users (SCIM ids or LDAP DNs).
+	users []string
+	// groups holds references to member groups (SCIM ids or LDAP DNs).
+	groups []string
+}
+
+// resolveGroups flattens nested groups and maps user references to user identifiers.
+// groups is keyed by group reference. User references missing from users are skipped,
+// which drops inactive or filtered-out users.
+func resolveGroups(groups map[string]*rawGroup, users map[string]string) []Group {
+	var resolve func(ref string, visited map[string]bool, members map[string]bool)
+	resolve = func(ref string, visited map[string]bool, members map[string]bool) {
+		g, ok := groups[ref]
+		if !ok || visited[ref] {
+			return
+		}
+		visited[ref] = true
+		for _, u := range g.users {
+			if id, ok := users[u]; ok && id != "" {
+				members[id] = true
+			}
+		}
+		for _, nested := range g.groups {
+			resolve(nested, visited, members)
+		}
+	}
+
+	out := make([]Group, 0, len(groups))
+	for ref, g := range groups {
+		members := map[string]bool{}
+		resolve(ref, map[string]bool{}, members)
+		group := Group{Name: g.name, Members: make([]string, 0, len(members))}
+		for m := range members {
+			group.Members = append(group.Members, m)
+		}
+		sort.Strings(group.Members)
+		out = append(out, group)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveGroups(t *testing.T) {
+	groups := map[string]*rawGroup{
+		"g-dev":      {name: "developers", users: []string{"u-alice", "u-inactive"}, groups: []string{"g-frontend"}},
+		"g-frontend": {name: "frontend", users: []string{"u-bob", "u-alice"}, groups: []string{"g-dev"}},
+		"g-empty":    {name: "empty", groups: []string{"g-missing"}},
+	}
+	users := map[string]string{
+		"u-alice": "alice@example.com",
+		"u-bob":   "bob@example.com",
+	}
+
+	got := resolveGroups(groups, users)
+
+	// Nested members are included, cycles terminate and unknown references are dropped.
+	assert.Equal(t, []Group{
+		{Name: "developers", Members: []string{"alice@example.com", "bob@example.com"}},
+		{Name: "empty", Members: []string{}},
+		{Name: "frontend", Members: []string{"alice@example.com", "bob@example.com"}},
+	}, got)
+}
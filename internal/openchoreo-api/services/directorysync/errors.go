@@ -0,0 +1,11 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import "errors"
+
+var (
+	ErrNoSyncRun      = errors.New("directory sync has not run yet")
+	ErrSyncInProgress = errors.New("a directory sync is already in progress")
+)
@@ -0,0 +1,21 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Service defines the directory sync service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// Preview computes the changes a sync would make without applying them.
+	Preview(ctx context.Context) (*models.DirectorySyncReport, error)
+	// Sync applies the changes and records the report as the latest status.
+	Sync(ctx context.Context) (*models.DirectorySyncReport, error)
+	// Status returns the report of the latest sync.
+	Status(ctx context.Context) (*models.DirectorySyncReport, error)
+}
@@ -0,0 +1,543 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// LDAP protocol operation tags (RFC 4511), with the APPLICATION class bits set.
+const (
+	ldapTagBindRequest       = 0x60
+	ldapTagBindResponse      = 0x61
+	ldapTagUnbindRequest     = 0x42
+	ldapTagSearchRequest     = 0x63
+	ldapTagSearchResultEntry = 0x64
+	ldapTagSearchResultDone  = 0x65
+	ldapTagSearchResultRef   = 0x73
+	ldapTagControls          = 0xa0
+	ldapTagSimpleAuth        = 0x80
+
+	ldapScopeWholeSubtree = 2
+	ldapResultSuccess     = 0
+
+	// ldapPagedResultsOID is the Simple Paged Results control (RFC 2696). Servers such as
+	// Active Directory cap unpaged searches, so every search is paged.
+	ldapPagedResultsOID = "1.2.840.113556.1.4.319"
+	ldapPageSize        = 500
+)
+
+// LDAPConfig configures an LDAP directory.
+type LDAPConfig struct {
+	// URL is the server URL, ldap://host:389 or ldaps://host:636.
+	URL string
+	// BindDN and BindPassword authenticate with a simple bind. Anonymous when BindDN is empty.
+	BindDN       string
+	BindPassword string
+	// SkipTLSVerify skips certificate verification for ldaps:// URLs.
+	SkipTLSVerify bool
+	// GroupBaseDN and GroupFilter select the groups to read.
+	GroupBaseDN string
+	GroupFilter string
+	// GroupNameAttribute holds the group name, for example "cn".
+	GroupNameAttribute string
+	// MemberAttribute holds the DNs of group members, for example "member".
+	MemberAttribute string
+	// UserBaseDN and UserFilter select the users that can be group members.
+	UserBaseDN string
+	UserFilter string
+	// UserAttribute holds the member identifier, for example "mail" or "uid".
+	UserAttribute string
+	// Timeout bounds connecting and each network round trip.
+	Timeout time.Duration
+}
+
+type ldapDirectory struct {
+	cfg LDAPConfig
+}
+
+var _ Directory = (*ldapDirectory)(nil)
+
+// NewLDAPDirectory creates a directory that reads users and groups from an LDAP server.
+func NewLDAPDirectory(cfg LDAPConfig) Directory {
+	if cfg.GroupFilter == "" {
+		cfg.GroupFilter = "(|(objectClass=groupOfNames)(objectClass=group))"
+	}
+	if cfg.GroupNameAttribute == "" {
+		cfg.GroupNameAttribute = "cn"
+	}
+	if cfg.MemberAttribute == "" {
+		cfg.MemberAttribute = "member"
+	}
+	if cfg.UserFilter == "" {
+		cfg.UserFilter = "(|(objectClass=person)(objectClass=inetOrgPerson))"
+	}
+	if cfg.UserAttribute == "" {
+		cfg.UserAttribute = "mail"
+	}
+	if cfg.UserBaseDN == "" {
+		cfg.UserBaseDN = cfg.GroupBaseDN
+	}
+	return &ldapDirectory{cfg: cfg}
+}
+
+func (d *ldapDirectory) Name() string { return "ldap" }
+
+func (d *ldapDirectory) ListGroups(ctx context.Context) ([]Group, error) {
+	groupFilter, err := parseLDAPFilter(d.cfg.GroupFilter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group filter: %w", err)
+	}
+	userFilter, err := parseLDAPFilter(d.cfg.UserFilter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user filter: %w", err)
+	}
+
+	conn, err := d.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.close()
+
+	if err := conn.bind(d.cfg.BindDN, d.cfg.BindPassword); err != nil {
+		return nil, err
+	}
+
+	users := map[string]string{}
+	err = conn.search(d.cfg.UserBaseDN, userFilter, []string{d.cfg.UserAttribute}, func(e ldapEntry) {
+		if v := e.first(d.cfg.UserAttribute); v != "" {
+			users[normalizeDN(e.dn)] = v
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search LDAP users: %w", err)
+	}
+
+	groups := map[string]*rawGroup{}
+	err = conn.search(d.cfg.GroupBaseDN, groupFilter, []string{d.cfg.GroupNameAttribute, d.cfg.MemberAttribute}, func(e ldapEntry) {
+		g := &rawGroup{name: e.first(d.cfg.GroupNameAttribute)}
+		for _, m := range e.attrs[strings.ToLower(d.cfg.MemberAttribute)] {
+			// A member DN is either a user or a nested group; resolveGroups skips the
+			// references that are not found in the respective map.
+			dn := normalizeDN(m)
+			g.users = append(g.users, dn)
+			g.groups = append(g.groups, dn)
+		}
+		groups[normalizeDN(e.dn)] = g
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search LDAP groups: %w", err)
+	}
+	return resolveGroups(groups, users), nil
+}
+
+// normalizeDN lowercases a DN and removes spaces around separators so member references
+// match entry DNs regardless of formatting.
+func normalizeDN(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, p := range parts {
+		kv := strings.SplitN(p, "=", 2)
+		for j := range kv {
+			kv[j] = strings.TrimSpace(kv[j])
+		}
+		parts[i] = strings.Join(kv, "=")
+	}
+	return strings.ToLower(strings.Join(parts, ","))
+}
+
+// ldapEntry is a search result entry with lowercased attribute names.
+type ldapEntry struct {
+	dn    string
+	attrs map[string][]string
+}
+
+func (e ldapEntry) first(attr string) string {
+	if v := e.attrs[strings.ToLower(attr)]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+type ldapConn struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	timeout time.Duration
+	nextID  int64
+}
+
+func (d *ldapDirectory) dial(ctx context.Context) (*ldapConn, error) {
+	u, err := url.Parse(d.cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LDAP URL: %w", err)
+	}
+	dialer := &net.Dialer{Timeout: d.cfg.Timeout}
+	var conn net.Conn
+	switch u.Scheme {
+	case "ldap":
+		host := u.Host
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "389")
+		}
+		conn, err = dialer.DialContext(ctx, "tcp", host)
+	case "ldaps":
+		host := u.Host
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "636")
+		}
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: &tls.Config{
+			ServerName:         u.Hostname(),
+			InsecureSkipVerify: d.cfg.SkipTLSVerify, //nolint:gosec // opt-in for development directories
+			MinVersion:         tls.VersionTLS12,
+		}}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", host)
+	default:
+		return nil, fmt.Errorf("unsupported LDAP URL scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to LDAP server: %w", err)
+	}
+	return &ldapConn{conn: conn, reader: bufio.NewReader(conn), timeout: d.cfg.Timeout}, nil
+}
+
+func (c *ldapConn) close() {
+	_ = c.send(berString(ldapTagUnbindRequest, ""), nil)
+	_ = c.conn.Close()
+}
+
+// send writes an LDAPMessage with the given protocol operation and optional controls.
+func (c *ldapConn) send(op []byte, controls []byte) error {
+	c.nextID++
+	parts := [][]byte{berInt(berTagInteger, c.nextID), op}
+	if controls != nil {
+		parts = append(parts, controls)
+	}
+	if c.timeout > 0 {
+		_ = c.conn.SetDeadline(time.Now().Add(c.timeout))
+	}
+	_, err := c.conn.Write(berConstructed(berTagSequence, parts...))
+	return err
+}
+
+// receive reads the next LDAPMessage and returns its protocol operation and controls.
+func (c *ldapConn) receive() (berElement, *berElement, error) {
+	if c.timeout > 0 {
+		_ = c.conn.SetDeadline(time.Now().Add(c.timeout))
+	}
+	raw, err := berRead(c.reader)
+	if err != nil {
+		return berElement{}, nil, err
+	}
+	msg, _, err := berParse(raw)
+	if err != nil {
+		return berElement{}, nil, err
+	}
+	parts, err := msg.children()
+	if err != nil || len(parts) < 2 {
+		return berElement{}, nil, fmt.Errorf("malformed LDAP message")
+	}
+	if id, err := parts[0].int(); err != nil || id != c.nextID {
+		return berElement{}, nil, fmt.Errorf("unexpected LDAP message id")
+	}
+	var controls *berElement
+	if len(parts) > 2 && parts[2].tag == ldapTagControls {
+		controls = &parts[2]
+	}
+	return parts[1], controls, nil
+}
+
+func (c *ldapConn) bind(dn, password string) error {
+	op := berConstructed(ldapTagBindRequest,
+		berInt(berTagInteger, 3),
+		berString(berTagOctetString, dn),
+		berString(ldapTagSimpleAuth, password),
+	)
+	if err := c.send(op, nil); err != nil {
+		return fmt.Errorf("failed to send LDAP bind: %w", err)
+	}
+	resp, _, err := c.receive()
+	if err != nil {
+		return fmt.Errorf("failed to read LDAP bind response: %w", err)
+	}
+	if resp.tag != ldapTagBindResponse {
+		return fmt.Errorf("unexpected LDAP response to bind")
+	}
+	if err := ldapResultError(resp); err != nil {
+		return fmt.Errorf("LDAP bind failed: %w", err)
+	}
+	return nil
+}
+
+// search runs a paged subtree search and calls fn for every entry.
+func (c *ldapConn) search(baseDN string, filter []byte, attributes []string, fn func(ldapEntry)) error {
+	attrs := make([][]byte, len(attributes))
+	for i, a := range attributes {
+		attrs[i] = berString(berTagOctetString, a)
+	}
+
+	var cookie []byte
+	for {
+		op := berConstructed(ldapTagSearchRequest,
+			berString(berTagOctetString, baseDN),
+			berInt(berTagEnumerated, ldapScopeWholeSubtree),
+			berInt(berTagEnumerated, 0), // neverDerefAliases
+			berInt(berTagInteger, 0),    // no size limit
+			berInt(berTagInteger, 0),    // no time limit
+			berBool(false),              // typesOnly
+			filter,
+			berConstructed(berTagSequence, attrs...),
+		)
+		if err := c.send(op, pagedResultsControl(cookie)); err != nil {
+			return err
+		}
+
+		next, err := c.readSearchResults(fn)
+		if err != nil {
+			return err
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		cookie = next
+	}
+}
+
+// readSearchResults reads the responses of one search page and returns the paging cookie.
+func (c *ldapConn) readSearchResults(fn func(ldapEntry)) ([]byte, error) {
+	for {
+		op, controls, err := c.receive()
+		if err != nil {
+			return nil, err
+		}
+		switch op.tag {
+		case ldapTagSearchResultEntry:
+			entry, err := parseLDAPEntry(op)
+			if err != nil {
+				return nil, err
+			}
+			fn(entry)
+		case ldapTagSearchResultRef:
+			// Referrals to other servers are not followed.
+		case ldapTagSearchResultDone:
+			if err := ldapResultError(op); err != nil {
+				return nil, err
+			}
+			return pagedResultsCookie(controls), nil
+		default:
+			return nil, fmt.Errorf("unexpected LDAP response tag 0x%x", op.tag)
+		}
+	}
+}
+
+func parseLDAPEntry(op berElement) (ldapEntry, error) {
+	parts, err := op.children()
+	if err != nil || len(parts) != 2 {
+		return ldapEntry{}, fmt.Errorf("malformed LDAP search entry")
+	}
+	entry := ldapEntry{dn: string(parts[0].content), attrs: map[string][]string{}}
+	attrs, err := parts[1].children()
+	if err != nil {
+		return ldapEntry{}, err
+	}
+	for _, a := range attrs {
+		kv, err := a.children()
+		if err != nil || len(kv) != 2 {
+			return ldapEntry{}, fmt.Errorf("malformed LDAP attribute")
+		}
+		vals, err := kv[1].children()
+		if err != nil {
+			return ldapEntry{}, err
+		}
+		name := strings.ToLower(string(kv[0].content))
+		for _, v := range vals {
+			entry.attrs[name] = append(entry.attrs[name], string(v.content))
+		}
+	}
+	return entry, nil
+}
+
+// ldapResultError returns an error for an LDAPResult with a non-success result code.
+func ldapResultError(op berElement) error {
+	parts, err := op.children()
+	if err != nil || len(parts) < 3 {
+		return fmt.Errorf("malformed LDAP result")
+	}
+	code, err := parts[0].int()
+	if err != nil {
+		return err
+	}
+	if code != ldapResultSuccess {
+		return fmt.Errorf("result code %d: %s", code, string(parts[2].content))
+	}
+	return nil
+}
+
+func pagedResultsControl(cookie []byte) []byte {
+	value := berConstructed(berTagSequence,
+		berInt(berTagInteger, ldapPageSize),
+		berEncode(berTagOctetString, cookie),
+	)
+	control := berConstructed(berTagSequence,
+		berString(berTagOctetString, ldapPagedResultsOID),
+		berEncode(berTagOctetString, value),
+	)
+	return berConstructed(ldapTagControls, control)
+}
+
+func pagedResultsCookie(controls *berElement) []byte {
+	if controls == nil {
+		return nil
+	}
+	list, err := controls.children()
+	if err != nil {
+		return nil
+	}
+	for _, ctrl := range list {
+		parts, err := ctrl.children()
+		if err != nil || len(parts) < 2 || string(parts[0].content) != ldapPagedResultsOID {
+			continue
+		}
+		value, _, err := berParse(parts[len(parts)-1].content)
+		if err != nil {
+			return nil
+		}
+		fields, err := value.children()
+		if err != nil || len(fields) != 2 {
+			return nil
+		}
+		return fields[1].content
+	}
+	return nil
+}
+
+// parseLDAPFilter encodes a string filter (RFC 4515). The and (&), or (|) and not (!)
+// operators and equality, presence and substring items are supported.
+func parseLDAPFilter(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "(") {
+		s = "(" + s + ")"
+	}
+	out, rest, err := parseLDAPFilterAt(s)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("unexpected %q after filter", rest)
+	}
+	return out, nil
+}
+
+func parseLDAPFilterAt(s string) ([]byte, string, error) {
+	if !strings.HasPrefix(s, "(") {
+		return nil, "", fmt.Errorf("expected '(' at %q", s)
+	}
+	s = s[1:]
+	if s == "" {
+		return nil, "", fmt.Errorf("unterminated filter")
+	}
+
+	switch s[0] {
+	case '&', '|':
+		tag := byte(0xa0)
+		if s[0] == '|' {
+			tag = 0xa1
+		}
+		s = s[1:]
+		var children [][]byte
+		for strings.HasPrefix(s, "(") {
+			child, rest, err := parseLDAPFilterAt(s)
+			if err != nil {
+				return nil, "", err
+			}
+			children = append(children, child)
+			s = rest
+		}
+		if !strings.HasPrefix(s, ")") {
+			return nil, "", fmt.Errorf("unterminated filter")
+		}
+		return berConstructed(tag, children...), s[1:], nil
+	case '!':
+		child, rest, err := parseLDAPFilterAt(s[1:])
+		if err != nil {
+			return nil, "", err
+		}
+		if !strings.HasPrefix(rest, ")") {
+			return nil, "", fmt.Errorf("unterminated filter")
+		}
+		return berConstructed(0xa2, child), rest[1:], nil
+	}
+
+	end := strings.IndexByte(s, ')')
+	if end < 0 {
+		return nil, "", fmt.Errorf("unterminated filter")
+	}
+	item, rest := s[:end], s[end+1:]
+	attr, value, ok := strings.Cut(item, "=")
+	if !ok || attr == "" || strings.ContainsAny(attr, "<>~:") {
+		return nil, "", fmt.Errorf("unsupported filter item %q", item)
+	}
+
+	if value == "*" {
+		return berString(0x87, attr), rest, nil
+	}
+	if !strings.Contains(value, "*") {
+		v, err := unescapeLDAPFilterValue(value)
+		if err != nil {
+			return nil, "", err
+		}
+		return berConstructed(0xa3, berString(berTagOctetString, attr), berString(berTagOctetString, v)), rest, nil
+	}
+
+	pieces := strings.Split(value, "*")
+	var subs [][]byte
+	for i, p := range pieces {
+		if p == "" {
+			continue
+		}
+		v, err := unescapeLDAPFilterValue(p)
+		if err != nil {
+			return nil, "", err
+		}
+		tag := byte(0x81) // any
+		switch i {
+		case 0:
+			tag = 0x80 // initial
+		case len(pieces) - 1:
+			tag = 0x82 // final
+		}
+		subs = append(subs, berString(tag, v))
+	}
+	return berConstructed(0xa4, berString(berTagOctetString, attr), berConstructed(berTagSequence, subs...)), rest, nil
+}
+
+// unescapeLDAPFilterValue decodes \XX hex escapes in a filter value.
+func unescapeLDAPFilterValue(v string) (string, error) {
+	if !strings.Contains(v, `\`) {
+		return v, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(v); i++ {
+		if v[i] != '\\' {
+			b.WriteByte(v[i])
+			continue
+		}
+		if i+2 >= len(v) {
+			return "", fmt.Errorf("invalid escape in filter value %q", v)
+		}
+		decoded, err := hex.DecodeString(v[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("invalid escape in filter value %q", v)
+		}
+		b.Write(decoded)
+		i += 2
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPeopleDN = "ou=people,dc=example,dc=com"
+	testGroupsDN = "ou=groups,dc=example,dc=com"
+)
+
+type fakeLDAPEntry struct {
+	dn    string
+	attrs map[string][]string
+}
+
+// fakeLDAPServer answers binds and searches on a single connection. Group searches are
+// returned in two pages to exercise the paged results control.
+type fakeLDAPServer struct {
+	t        *testing.T
+	listener net.Listener
+	password string
+	people   []fakeLDAPEntry
+	groups   [][]fakeLDAPEntry
+}
+
+func newFakeLDAPServer(t *testing.T) *fakeLDAPServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeLDAPServer{
+		t:        t,
+		listener: l,
+		password: "secret",
+		people: []fakeLDAPEntry{
+			{dn: "uid=alice," + testPeopleDN, attrs: map[string][]string{"mail": {"alice@example.com"}}},
+			{dn: "uid=bob," + testPeopleDN, attrs: map[string][]string{"mail": {"bob@example.com"}}},
+		},
+		groups: [][]fakeLDAPEntry{
+			{{dn: "cn=developers," + testGroupsDN, attrs: map[string][]string{
+				"cn": {"developers"},
+				// Member DNs are matched regardless of case and spacing.
+				"member": {"UID=Alice, ou=People,dc=example,dc=com", "cn=admins," + testGroupsDN},
+			}}},
+			{{dn: "cn=admins," + testGroupsDN, attrs: map[string][]string{
+				"cn":     {"admins"},
+				"member": {"uid=bob," + testPeopleDN, "uid=removed," + testPeopleDN},
+			}}},
+		},
+	}
+	go s.serve()
+	t.Cleanup(func() { _ = l.Close() })
+	return s
+}
+
+func (s *fakeLDAPServer) url() string { return "ldap://" + s.listener.Addr().String() }
+
+func (s *fakeLDAPServer) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		raw, err := berRead(r)
+		if err != nil {
+			return
+		}
+		msg, _, _ := berParse(raw)
+		parts, _ := msg.children()
+		id, _ := parts[0].int()
+		op := parts[1]
+		reply := func(op []byte, controls ...[]byte) {
+			_, _ = conn.Write(berConstructed(berTagSequence, append([][]byte{berInt(berTagInteger, id), op}, controls...)...))
+		}
+
+		switch op.tag {
+		case ldapTagBindRequest:
+			fields, _ := op.children()
+			code := int64(0)
+			if string(fields[2].content) != s.password {
+				code = 49 // invalidCredentials
+			}
+			reply(ldapResult(ldapTagBindResponse, code))
+		case ldapTagSearchRequest:
+			fields, _ := op.children()
+			var cookie []byte
+			if len(parts) > 2 {
+				cookie = pagedResultsCookie(&parts[2])
+			}
+			var entries []fakeLDAPEntry
+			var next []byte
+			switch string(fields[0].content) {
+			case testPeopleDN:
+				entries = s.people
+			case testGroupsDN:
+				if len(cookie) == 0 {
+					entries, next = s.groups[0], []byte("page-2")
+				} else {
+					entries = s.groups[1]
+				}
+			}
+			for _, e := range entries {
+				reply(encodeLDAPEntry(e))
+			}
+			control := berConstructed(berTagSequence,
+				berString(berTagOctetString, ldapPagedResultsOID),
+				berEncode(berTagOctetString, berConstructed(berTagSequence, berInt(berTagInteger, 0), berEncode(berTagOctetString, next))),
+			)
+			reply(ldapResult(ldapTagSearchResultDone, 0), berConstructed(ldapTagControls, control))
+		case ldapTagUnbindRequest:
+			return
+		}
+	}
+}
+
+func ldapResult(tag byte, code int64) []byte {
+	return berConstructed(tag, berInt(berTagEnumerated, code), berString(berTagOctetString, ""), berString(berTagOctetString, ""))
+}
+
+func encodeLDAPEntry(e fakeLDAPEntry) []byte {
+	var attrs [][]byte
+	for name, values := range e.attrs {
+		var vals [][]byte
+		for _, v := range values {
+			vals = append(vals, berString(berTagOctetString, v))
+		}
+		attrs = append(attrs, berConstructed(berTagSequence, berString(berTagOctetString, name), berConstructed(berTagSet, vals...)))
+	}
+	return berConstructed(ldapTagSearchResultEntry, berString(berTagOctetString, e.dn), berConstructed(berTagSequence, attrs...))
+}
+
+func TestLDAPDirectory_ListGroups(t *testing.T) {
+	srv := newFakeLDAPServer(t)
+	dir := NewLDAPDirectory(LDAPConfig{
+		URL:          srv.url(),
+		BindDN:       "cn=sync,dc=example,dc=com",
+		BindPassword: "secret",
+		GroupBaseDN:  testGroupsDN,
+		UserBaseDN:   testPeopleDN,
+		Timeout:      5 * time.Second,
+	})
+
+	got, err := dir.ListGroups(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Group{
+		{Name: "admins", Members: []string{"bob@example.com"}},
+		{Name: "developers", Members: []string{"alice@example.com", "bob@example.com"}},
+	}, got)
+}
+
+func TestLDAPDirectory_BindFailure(t *testing.T) {
+	srv := newFakeLDAPServer(t)
+	dir := NewLDAPDirectory(LDAPConfig{
+		URL:          srv.url(),
+		BindDN:       "cn=sync,dc=example,dc=com",
+		BindPassword: "wrong",
+		GroupBaseDN:  testGroupsDN,
+		Timeout:      5 * time.Second,
+	})
+
+	_, err := dir.ListGroups(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "LDAP bind failed: result code 49")
+}
+
+func TestParseLDAPFilter(t *testing.T) {
+	equality := func(attr, value string) []byte {
+		return berConstructed(0xa3, berString(berTagOctetString, attr), berString(berTagOctetString, value))
+	}
+	tests := []struct {
+		name    string
+		filter  string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:   "equality without parentheses",
+			filter: "objectClass=person",
+			want:   equality("objectClass", "person"),
+		},
+		{
+			name:   "escaped value",
+			filter: `(cn=a\2ab)`,
+			want:   equality("cn", "a*b"),
+		},
+		{
+			name:   "and, or, not and presence",
+			filter: "(&(|(objectClass=person)(objectClass=user))(!(mail=*)))",
+			want: berConstructed(0xa0,
+				berConstructed(0xa1, equality("objectClass", "person"), equality("objectClass", "user")),
+				berConstructed(0xa2, berString(0x87, "mail")),
+			),
+		},
+		{
+			name:   "substrings",
+			filter: "(cn=dev*ops*team)",
+			want: berConstructed(0xa4, berString(berTagOctetString, "cn"), berConstructed(berTagSequence,
+				berString(0x80, "dev"), berString(0x81, "ops"), berString(0x82, "team"),
+			)),
+		},
+		{name: "unterminated", filter: "(&(cn=a)", wantErr: true},
+		{name: "trailing input", filter: "(cn=a)(cn=b)", wantErr: true},
+		{name: "approximate match", filter: "(cn~=a)", wantErr: true},
+		{name: "truncated escape", filter: `(cn=a\2)`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLDAPFilter(tt.filter)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalizeDN(t *testing.T) {
+	assert.Equal(t, "uid=alice,ou=people,dc=example,dc=com", normalizeDN("UID = Alice, OU=People ,dc=example,dc=com"))
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	scimPageSize = 100
+
+	// SCIMUserAttributeUserName identifies SCIM users by their userName.
+	SCIMUserAttributeUserName = "userName"
+	// SCIMUserAttributeEmail identifies SCIM users by their primary email address.
+	SCIMUserAttributeEmail = "email"
+	// SCIMUserAttributeID identifies SCIM users by their SCIM id.
+	SCIMUserAttributeID = "id"
+)
+
+// SCIMConfig configures a SCIM 2.0 (RFC 7644) directory.
+type SCIMConfig struct {
+	// BaseURL is the SCIM base URL, for example https://idp.example.com/scim/v2.
+	BaseURL string
+	// Token is the bearer token used to authenticate to the SCIM service provider.
+	Token string
+	// UserAttribute selects the user attribute used as the member identifier:
+	// userName, email or id.
+	UserAttribute string
+	// Timeout bounds each HTTP request.
+	Timeout time.Duration
+}
+
+type scimDirectory struct {
+	cfg        SCIMConfig
+	httpClient *http.Client
+}
+
+var _ Directory = (*scimDirectory)(nil)
+
+// NewSCIMDirectory creates a directory that reads users and groups from a SCIM service provider.
+func NewSCIMDirectory(cfg SCIMConfig) Directory {
+	if cfg.UserAttribute == "" {
+		cfg.UserAttribute = SCIMUserAttributeUserName
+	}
+	return &scimDirectory{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+func (d *scimDirectory) Name() string { return "scim" }
+
+type scimListResponse[T any] struct {
+	TotalResults int `json:"totalResults"`
+	ItemsPerPage int `json:"itemsPerPage"`
+	Resources    []T `json:"Resources"`
+}
+
+type scimUser struct {
+	ID       string `json:"id"`
+	UserName string `json:"userName"`
+	Active   *bool  `json:"active"`
+	Emails   []struct {
+		Value   string `json:"value"`
+		Primary bool   `json:"primary"`
+	} `json:"emails"`
+}
+
+type scimGroup struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Members     []struct {
+		Value string `json:"value"`
+		Type  string `json:"type"`
+		Ref   string `json:"$ref"`
+	} `json:"members"`
+}
+
+func (d *scimDirectory) ListGroups(ctx context.Context) ([]Group, error) {
+	users, err := scimList[scimUser](ctx, d, "Users", "id,userName,emails,active")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SCIM users: %w", err)
+	}
+	groups, err := scimList[scimGroup](ctx, d, "Groups", "id,displayName,members")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SCIM groups: %w", err)
+	}
+
+	userIDs := make(map[string]string, len(users))
+	for _, u := range users {
+		if u.Active != nil && !*u.Active {
+			continue
+		}
+		userIDs[u.ID] = d.userIdentifier(u)
+	}
+
+	raw := make(map[string]*rawGroup, len(groups))
+	for _, g := range groups {
+		rg := &rawGroup{name: g.DisplayName}
+		for _, m := range g.Members {
+			// The member type is optional; fall back to the resource reference.
+			if strings.EqualFold(m.Type, "Group") || strings.Contains(m.Ref, "/Groups/") {
+				rg.groups = append(rg.groups, m.Value)
+			} else {
+				rg.users = append(rg.users, m.Value)
+			}
+		}
+		raw[g.ID] = rg
+	}
+	return resolveGroups(raw, userIDs), nil
+}
+
+func (d *scimDirectory) userIdentifier(u scimUser) string {
+	switch d.cfg.UserAttribute {
+	case SCIMUserAttributeID:
+		return u.ID
+	case SCIMUserAttributeEmail:
+		for _, e := range u.Emails {
+			if e.Primary {
+				return e.Value
+			}
+		}
+		if len(u.Emails) > 0 {
+			return u.Emails[0].Value
+		}
+		return ""
+	default:
+		return u.UserName
+	}
+}
+
+// scimList reads every page of a SCIM resource collection.
+func scimList[T any](ctx context.Context, d *scimDirectory, resource, attributes string) ([]T, error) {
+	var all []T
+	for start := 1; ; {
+		q := url.Values{}
+		q.Set("startIndex", strconv.Itoa(start))
+		q.Set("count", strconv.Itoa(scimPageSize))
+		q.Set("attributes", attributes)
+
+		var page scimListResponse[T]
+		if err := d.get(ctx, resource, q, &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page.Resources...)
+		start += len(page.Resources)
+		if len(page.Resources) == 0 || start > page.TotalResults {
+			return all, nil
+		}
+	}
+}
+
+func (d *scimDirectory) get(ctx context.Context, resource string, query url.Values, out any) error {
+	endpoint := strings.TrimSuffix(d.cfg.BaseURL, "/") + "/" + resource + "?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/scim+json, application/json")
+	if d.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+d.cfg.Token)
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("GET %s returned %d: %s", resource, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSCIMServer serves the given users and groups one resource per page to exercise paging.
+func newSCIMServer(t *testing.T, users, groups []map[string]any) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var resources []map[string]any
+		switch r.URL.Path {
+		case "/scim/v2/Users":
+			resources = users
+		case "/scim/v2/Groups":
+			resources = groups
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		start, _ := strconv.Atoi(r.URL.Query().Get("startIndex"))
+		page := []map[string]any{}
+		if start >= 1 && start <= len(resources) {
+			page = resources[start-1 : start]
+		}
+		w.Header().Set("Content-Type", "application/scim+json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"totalResults": len(resources),
+			"itemsPerPage": len(page),
+			"Resources":    page,
+		})
+	}))
+}
+
+func TestSCIMDirectory_ListGroups(t *testing.T) {
+	users := []map[string]any{
+		{"id": "1", "userName": "alice", "emails": []map[string]any{{"value": "alice@work.example.com"}, {"value": "alice@example.com", "primary": true}}},
+		{"id": "2", "userName": "bob", "emails": []map[string]any{{"value": "bob@example.com"}}},
+		{"id": "3", "userName": "carol", "active": false, "emails": []map[string]any{{"value": "carol@example.com"}}},
+	}
+	groups := []map[string]any{
+		{"id": "g1", "displayName": "developers", "members": []map[string]any{
+			{"value": "1", "type": "User"},
+			{"value": "3"},
+			{"value": "g2", "$ref": "https://idp.example.com/scim/v2/Groups/g2"},
+		}},
+		{"id": "g2", "displayName": "frontend", "members": []map[string]any{{"value": "2"}}},
+	}
+	srv := newSCIMServer(t, users, groups)
+	defer srv.Close()
+
+	tests := []struct {
+		name      string
+		attribute string
+		want      []Group
+	}{
+		{
+			name:      "primary email",
+			attribute: SCIMUserAttributeEmail,
+			want: []Group{
+				{Name: "developers", Members: []string{"alice@example.com", "bob@example.com"}},
+				{Name: "frontend", Members: []string{"bob@example.com"}},
+			},
+		},
+		{
+			name: "user name by default",
+			want: []Group{
+				{Name: "developers", Members: []string{"alice", "bob"}},
+				{Name: "frontend", Members: []string{"bob"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := NewSCIMDirectory(SCIMConfig{BaseURL: srv.URL + "/scim/v2/", Token: "secret", UserAttribute: tt.attribute})
+			got, err := dir.ListGroups(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSCIMDirectory_ListGroupsError(t *testing.T) {
+	srv := newSCIMServer(t, nil, nil)
+	defer srv.Close()
+
+	dir := NewSCIMDirectory(SCIMConfig{BaseURL: srv.URL + "/scim/v2", Token: "wrong"})
+	_, err := dir.ListGroups(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "returned 401")
+}
@@ -0,0 +1,456 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+const (
+	kindClusterAuthzRoleBinding = "ClusterAuthzRoleBinding"
+	kindAuthzRoleBinding        = "AuthzRoleBinding"
+
+	bindingNamePrefix    = "dirsync"
+	maxBindingNameLength = 63
+)
+
+// syncService syncs directory group memberships into authz role bindings without authorization checks.
+type syncService struct {
+	k8sClient client.Client
+	directory Directory
+	cfg       Config
+	logger    *slog.Logger
+	now       func() time.Time
+
+	// syncMu serializes sync runs; mu guards last.
+	syncMu sync.Mutex
+	mu     sync.RWMutex
+	last   *models.DirectorySyncReport
+}
+
+var _ Service = (*syncService)(nil)
+
+// NewService creates a new directory sync service without authorization.
+func NewService(k8sClient client.Client, directory Directory, cfg Config, logger *slog.Logger) Service {
+	return &syncService{
+		k8sClient: k8sClient,
+		directory: directory,
+		cfg:       cfg,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// RunPeriodically syncs once and then every interval until ctx is done.
+func RunPeriodically(ctx context.Context, svc Service, interval time.Duration, logger *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if report, err := svc.Sync(ctx); err != nil {
+			logger.Error("Directory sync failed", "error", err)
+		} else {
+			logger.Info("Directory sync completed",
+				"groups", report.Groups, "users", report.Users,
+				"changes", len(report.Changes), "conflicts", len(report.Conflicts))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *syncService) Preview(ctx context.Context) (*models.DirectorySyncReport, error) {
+	s.logger.Debug("Previewing directory sync", "source", s.directory.Name())
+	report, _, err := s.plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.DryRun = true
+	report.CompletedAt = s.now()
+	return report, nil
+}
+
+func (s *syncService) Sync(ctx context.Context) (*models.DirectorySyncReport, error) {
+	if !s.syncMu.TryLock() {
+		return nil, ErrSyncInProgress
+	}
+	defer s.syncMu.Unlock()
+
+	s.logger.Debug("Running directory sync", "source", s.directory.Name())
+	started := s.now()
+	report, ops, err := s.plan(ctx)
+	if err == nil {
+		err = s.apply(ctx, ops)
+	}
+	if report == nil {
+		report = s.newReport(started)
+	}
+	if err != nil {
+		report.Error = err.Error()
+	}
+	report.CompletedAt = s.now()
+
+	s.mu.Lock()
+	s.last = report
+	s.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *syncService) Status(_ context.Context) (*models.DirectorySyncReport, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.last == nil {
+		return nil, ErrNoSyncRun
+	}
+	report := *s.last
+	return &report, nil
+}
+
+func (s *syncService) newReport(started time.Time) *models.DirectorySyncReport {
+	return &models.DirectorySyncReport{
+		Source:    s.directory.Name(),
+		StartedAt: started,
+		Changes:   []models.DirectorySyncChange{},
+		Conflicts: []models.DirectorySyncConflict{},
+	}
+}
+
+// operation is a change to apply to the authz store.
+type operation struct {
+	action string
+	object client.Object
+}
+
+// bindingKey identifies a role binding. Cluster bindings have an empty namespace.
+type bindingKey struct {
+	namespace string
+	name      string
+}
+
+// plan reads the directory and the synced bindings and computes the operations that make the
+// bindings match the directory.
+func (s *syncService) plan(ctx context.Context) (*models.DirectorySyncReport, []operation, error) {
+	report := s.newReport(s.now())
+
+	groups, err := s.directory.ListGroups(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+	groupsByName := make(map[string]Group, len(groups))
+	for _, g := range groups {
+		groupsByName[g.Name] = g
+	}
+
+	existing, err := s.listBindings(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	desired := map[bindingKey]client.Object{}
+	retained := map[bindingKey]bool{} // mapping (namespace, group) whose synced bindings are kept
+	users := map[string]bool{}
+	roleExists := map[string]bool{}
+	for _, m := range s.cfg.Mappings {
+		mappingKey := bindingKey{namespace: m.Namespace, name: m.Group}
+		group, ok := groupsByName[m.Group]
+		if !ok {
+			retained[mappingKey] = true
+			report.Conflicts = append(report.Conflicts, models.DirectorySyncConflict{
+				Type:      models.DirectorySyncConflictGroupNotFound,
+				Group:     m.Group,
+				Namespace: m.Namespace,
+				Message:   fmt.Sprintf("group %q was not found in the directory; previously synced bindings are kept", m.Group),
+			})
+			continue
+		}
+		if missing, err := s.missingRole(ctx, m, roleExists); err != nil {
+			return nil, nil, err
+		} else if missing != "" {
+			retained[mappingKey] = true
+			report.Conflicts = append(report.Conflicts, models.DirectorySyncConflict{
+				Type:      models.DirectorySyncConflictRoleNotFound,
+				Group:     m.Group,
+				Namespace: m.Namespace,
+				Message:   fmt.Sprintf("role %s does not exist; the mapping is skipped", missing),
+			})
+			continue
+		}
+		report.Groups++
+
+		for _, user := range group.Members {
+			obj := s.desiredBinding(m, user)
+			key := bindingKey{namespace: obj.GetNamespace(), name: obj.GetName()}
+			if current, ok := existing[key]; ok && !isManaged(current) {
+				report.Conflicts = append(report.Conflicts, models.DirectorySyncConflict{
+					Type:      models.DirectorySyncConflictNameTaken,
+					Group:     m.Group,
+					User:      user,
+					Namespace: key.namespace,
+					Name:      key.name,
+					Message:   fmt.Sprintf("binding %q exists and is not managed by the directory sync", key.name),
+				})
+				continue
+			}
+			desired[key] = obj
+			users[user] = true
+		}
+	}
+	report.Users = len(users)
+
+	var ops []operation
+	for key, obj := range desired {
+		current, ok := existing[key]
+		switch {
+		case !ok:
+			ops = append(ops, operation{action: models.DirectorySyncActionCreate, object: obj})
+		case !bindingSpecEqual(current, obj):
+			updated := current.DeepCopyObject().(client.Object)
+			copyBindingSpec(updated, obj)
+			ops = append(ops, operation{action: models.DirectorySyncActionUpdate, object: updated})
+		}
+	}
+	for key, current := range existing {
+		if !isManaged(current) {
+			continue
+		}
+		group := current.GetAnnotations()[labels.AnnotationKeyDirectoryGroup]
+		if _, ok := desired[key]; ok || retained[bindingKey{namespace: key.namespace, name: group}] {
+			continue
+		}
+		ops = append(ops, operation{action: models.DirectorySyncActionDelete, object: current})
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		a, b := ops[i].object, ops[j].object
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+	for _, op := range ops {
+		report.Changes = append(report.Changes, models.DirectorySyncChange{
+			Action:    op.action,
+			Kind:      bindingKind(op.object),
+			Namespace: op.object.GetNamespace(),
+			Name:      op.object.GetName(),
+			Group:     op.object.GetAnnotations()[labels.AnnotationKeyDirectoryGroup],
+			User:      op.object.GetAnnotations()[labels.AnnotationKeyDirectoryUser],
+		})
+	}
+
+	report.Conflicts = append(report.Conflicts, s.denyConflicts(existing, users)...)
+	return report, ops, nil
+}
+
+// listBindings returns every cluster and namespaced role binding.
+func (s *syncService) listBindings(ctx context.Context) (map[bindingKey]client.Object, error) {
+	out := map[bindingKey]client.Object{}
+
+	var clusterBindings openchoreov1alpha1.ClusterAuthzRoleBindingList
+	if err := s.k8sClient.List(ctx, &clusterBindings); err != nil {
+		return nil, fmt.Errorf("failed to list cluster role bindings: %w", err)
+	}
+	for i := range clusterBindings.Items {
+		b := &clusterBindings.Items[i]
+		out[bindingKey{name: b.Name}] = b
+	}
+
+	var bindings openchoreov1alpha1.AuthzRoleBindingList
+	if err := s.k8sClient.List(ctx, &bindings); err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %w", err)
+	}
+	for i := range bindings.Items {
+		b := &bindings.Items[i]
+		out[bindingKey{namespace: b.Namespace, name: b.Name}] = b
+	}
+	return out, nil
+}
+
+// missingRole returns the first role of the mapping that does not exist, or an empty string.
+func (s *syncService) missingRole(ctx context.Context, m GroupMapping, cache map[string]bool) (string, error) {
+	for _, r := range m.Roles {
+		var obj client.Object = &openchoreov1alpha1.ClusterAuthzRole{}
+		key := client.ObjectKey{Name: r.Name}
+		if r.Kind == openchoreov1alpha1.RoleRefKindAuthzRole {
+			obj = &openchoreov1alpha1.AuthzRole{}
+			key.Namespace = m.Namespace
+		}
+		ref := fmt.Sprintf("%s %s", r.Kind, key.String())
+		exists, ok := cache[ref]
+		if !ok {
+			err := s.k8sClient.Get(ctx, key, obj)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return "", fmt.Errorf("failed to get %s: %w", ref, err)
+			}
+			exists = err == nil
+			cache[ref] = exists
+		}
+		if !exists {
+			return ref, nil
+		}
+	}
+	return "", nil
+}
+
+// denyConflicts reports unmanaged deny bindings for synced users, which override the synced grants.
+func (s *syncService) denyConflicts(existing map[bindingKey]client.Object, users map[string]bool) []models.DirectorySyncConflict {
+	var out []models.DirectorySyncConflict
+	for key, obj := range existing {
+		if isManaged(obj) {
+			continue
+		}
+		var entitlement openchoreov1alpha1.EntitlementClaim
+		var effect openchoreov1alpha1.EffectType
+		switch b := obj.(type) {
+		case *openchoreov1alpha1.ClusterAuthzRoleBinding:
+			entitlement, effect = b.Spec.Entitlement, b.Spec.Effect
+		case *openchoreov1alpha1.AuthzRoleBinding:
+			entitlement, effect = b.Spec.Entitlement, b.Spec.Effect
+		}
+		if effect != openchoreov1alpha1.EffectDeny || entitlement.Claim != s.cfg.EntitlementClaim || !users[entitlement.Value] {
+			continue
+		}
+		out = append(out, models.DirectorySyncConflict{
+			Type:      models.DirectorySyncConflictDenyBinding,
+			User:      entitlement.Value,
+			Namespace: key.namespace,
+			Name:      key.name,
+			Message:   fmt.Sprintf("deny binding %q overrides roles synced for %s", key.name, entitlement.Value),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Namespace != out[j].Namespace {
+			return out[i].Namespace < out[j].Namespace
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// desiredBinding builds the binding that grants the mapping's roles to a group member.
+func (s *syncService) desiredBinding(m GroupMapping, user string) client.Object {
+	meta := metav1.ObjectMeta{
+		// The user is hashed into the name but not shown, since it is usually an email address.
+		Name:      dpkubernetes.GenerateK8sNameWithExtraHashInput(maxBindingNameLength, user, bindingNamePrefix, m.Group),
+		Namespace: m.Namespace,
+		Labels:    map[string]string{labels.LabelKeyManagedBy: labels.LabelValueManagedByDirectorySync},
+		Annotations: map[string]string{
+			labels.AnnotationKeyDirectoryGroup: m.Group,
+			labels.AnnotationKeyDirectoryUser:  user,
+		},
+	}
+	entitlement := openchoreov1alpha1.EntitlementClaim{Claim: s.cfg.EntitlementClaim, Value: user}
+
+	if m.Namespace == "" {
+		mappings := make([]openchoreov1alpha1.ClusterRoleMapping, 0, len(m.Roles))
+		for _, r := range m.Roles {
+			mappings = append(mappings, openchoreov1alpha1.ClusterRoleMapping{
+				RoleRef: openchoreov1alpha1.RoleRef{Kind: r.Kind, Name: r.Name},
+				Scope: openchoreov1alpha1.ClusterTargetScope{
+					Namespace: r.Namespace,
+					Project:   r.Project,
+					Component: r.Component,
+				},
+			})
+		}
+		return &openchoreov1alpha1.ClusterAuthzRoleBinding{
+			ObjectMeta: meta,
+			Spec: openchoreov1alpha1.ClusterAuthzRoleBindingSpec{
+				Entitlement:  entitlement,
+				RoleMappings: mappings,
+				Effect:       openchoreov1alpha1.EffectAllow,
+			},
+		}
+	}
+
+	mappings := make([]openchoreov1alpha1.RoleMapping, 0, len(m.Roles))
+	for _, r := range m.Roles {
+		mappings = append(mappings, openchoreov1alpha1.RoleMapping{
+			RoleRef: openchoreov1alpha1.RoleRef{Kind: r.Kind, Name: r.Name},
+			Scope:   openchoreov1alpha1.TargetScope{Project: r.Project, Component: r.Component},
+		})
+	}
+	return &openchoreov1alpha1.AuthzRoleBinding{
+		ObjectMeta: meta,
+		Spec: openchoreov1alpha1.AuthzRoleBindingSpec{
+			Entitlement:  entitlement,
+			RoleMappings: mappings,
+			Effect:       openchoreov1alpha1.EffectAllow,
+		},
+	}
+}
+
+// apply creates, updates and deletes the planned bindings, stopping at the first failure.
+func (s *syncService) apply(ctx context.Context, ops []operation) error {
+	for _, op := range ops {
+		var err error
+		switch op.action {
+		case models.DirectorySyncActionCreate:
+			err = s.k8sClient.Create(ctx, op.object)
+		case models.DirectorySyncActionUpdate:
+			err = s.k8sClient.Update(ctx, op.object)
+		case models.DirectorySyncActionDelete:
+			err = client.IgnoreNotFound(s.k8sClient.Delete(ctx, op.object))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s %s %q: %w", op.action, bindingKind(op.object), op.object.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func isManaged(obj client.Object) bool {
+	return obj.GetLabels()[labels.LabelKeyManagedBy] == labels.LabelValueManagedByDirectorySync
+}
+
+func bindingKind(obj client.Object) string {
+	if _, ok := obj.(*openchoreov1alpha1.ClusterAuthzRoleBinding); ok {
+		return kindClusterAuthzRoleBinding
+	}
+	return kindAuthzRoleBinding
+}
+
+// bindingSpecEqual reports whether two bindings of the same kind grant the same roles to the same subject.
+func bindingSpecEqual(a, b client.Object) bool {
+	switch x := a.(type) {
+	case *openchoreov1alpha1.ClusterAuthzRoleBinding:
+		y, ok := b.(*openchoreov1alpha1.ClusterAuthzRoleBinding)
+		return ok && equality.Semantic.DeepEqual(x.Spec, y.Spec) && equality.Semantic.DeepEqual(x.Annotations, y.Annotations)
+	case *openchoreov1alpha1.AuthzRoleBinding:
+		y, ok := b.(*openchoreov1alpha1.AuthzRoleBinding)
+		return ok && equality.Semantic.DeepEqual(x.Spec, y.Spec) && equality.Semantic.DeepEqual(x.Annotations, y.Annotations)
+	}
+	return false
+}
+
+// copyBindingSpec copies the spec and sync annotations of src onto dst, keeping dst's other metadata.
+func copyBindingSpec(dst, src client.Object) {
+	switch d := dst.(type) {
+	case *openchoreov1alpha1.ClusterAuthzRoleBinding:
+		d.Spec = src.(*openchoreov1alpha1.ClusterAuthzRoleBinding).Spec
+	case *openchoreov1alpha1.AuthzRoleBinding:
+		d.Spec = src.(*openchoreov1alpha1.AuthzRoleBinding).Spec
+	}
+	dst.SetAnnotations(src.GetAnnotations())
+}
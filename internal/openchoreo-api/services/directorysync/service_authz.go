@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"context"
+	"log/slog"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	// The sync writes cluster and namespaced bindings across the control plane, so it is
+	// authorized as a cluster role binding operation.
+	resourceTypeClusterAuthzRoleBinding = "clusterAuthzRoleBinding"
+)
+
+// directorySyncServiceWithAuthz wraps a Service and adds authorization checks.
+type directorySyncServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*directorySyncServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz wraps an existing service with authorization checks. The internal
+// service is shared with the scheduler so that both report the same status.
+func NewServiceWithAuthz(internal Service, authzPDP authzcore.PDP, logger *slog.Logger) Service {
+	return &directorySyncServiceWithAuthz{
+		internal: internal,
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+func (s *directorySyncServiceWithAuthz) Preview(ctx context.Context) (*models.DirectorySyncReport, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authzcore.ActionViewClusterAuthzRoleBinding,
+		ResourceType: resourceTypeClusterAuthzRoleBinding,
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.Preview(ctx)
+}
+
+func (s *directorySyncServiceWithAuthz) Sync(ctx context.Context) (*models.DirectorySyncReport, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authzcore.ActionUpdateClusterAuthzRoleBinding,
+		ResourceType: resourceTypeClusterAuthzRoleBinding,
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.Sync(ctx)
+}
+
+func (s *directorySyncServiceWithAuthz) Status(ctx context.Context) (*models.DirectorySyncReport, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authzcore.ActionViewClusterAuthzRoleBinding,
+		ResourceType: resourceTypeClusterAuthzRoleBinding,
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.Status(ctx)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/directorysync/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newDirectorySyncAuthzSvc(pdp *testutil.CapturingPDP, internal Service) Service {
+	return &directorySyncServiceWithAuthz{
+		internal: internal,
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestDirectorySync_AuthzCheck(t *testing.T) {
+	tests := []struct {
+		method string
+		action string
+		call   func(Service, context.Context) (*models.DirectorySyncReport, error)
+	}{
+		{method: "Preview", action: "clusterauthzrolebinding:view", call: Service.Preview},
+		{method: "Sync", action: "clusterauthzrolebinding:update", call: Service.Sync},
+		{method: "Status", action: "clusterauthzrolebinding:view", call: Service.Status},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" allowed", func(t *testing.T) {
+			pdp := testutil.AllowPDP()
+			report := &models.DirectorySyncReport{Source: "scim"}
+			mockSvc := mocks.NewMockService(t)
+			mockSvc.On(tt.method, mock.Anything).Return(report, nil)
+			svc := newDirectorySyncAuthzSvc(pdp, mockSvc)
+			result, err := tt.call(svc, testutil.AuthzContext())
+			require.NoError(t, err)
+			require.Equal(t, report, result)
+			require.Len(t, pdp.Captured, 1)
+			testutil.RequireEvalRequest(t, pdp.Captured[0], tt.action, "clusterAuthzRoleBinding", "", authzcore.ResourceHierarchy{})
+		})
+
+		t.Run(tt.method+" denied", func(t *testing.T) {
+			mockSvc := mocks.NewMockService(t)
+			svc := newDirectorySyncAuthzSvc(testutil.DenyPDP(), mockSvc)
+			_, err := tt.call(svc, testutil.AuthzContext())
+			require.ErrorIs(t, err, services.ErrForbidden)
+		})
+	}
+}
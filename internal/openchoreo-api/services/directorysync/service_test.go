@@ -0,0 +1,181 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package directorysync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+type fakeDirectory struct {
+	groups []Group
+	err    error
+}
+
+func (d *fakeDirectory) Name() string { return "scim" }
+
+func (d *fakeDirectory) ListGroups(context.Context) ([]Group, error) {
+	return d.groups, d.err
+}
+
+var testSyncConfig = Config{
+	EntitlementClaim: "email",
+	Mappings: []GroupMapping{
+		{Group: "platform", Roles: []RoleGrant{{Kind: openchoreov1alpha1.RoleRefKindClusterAuthzRole, Name: "admin"}}},
+		{Group: "developers", Namespace: "acme", Roles: []RoleGrant{{Kind: openchoreov1alpha1.RoleRefKindAuthzRole, Name: "developer", Project: "shop"}}},
+		{Group: "contractors", Namespace: "acme", Roles: []RoleGrant{{Kind: openchoreov1alpha1.RoleRefKindAuthzRole, Name: "contractor"}}},
+		{Group: "auditors", Roles: []RoleGrant{{Kind: openchoreov1alpha1.RoleRefKindClusterAuthzRole, Name: "admin"}}},
+	},
+}
+
+func newTestService(dir Directory, objects ...client.Object) (*syncService, client.Client) {
+	objects = append(objects,
+		&openchoreov1alpha1.ClusterAuthzRole{ObjectMeta: metav1.ObjectMeta{Name: "admin"}},
+		&openchoreov1alpha1.AuthzRole{ObjectMeta: metav1.ObjectMeta{Name: "developer", Namespace: "acme"}},
+	)
+	k8sClient := testutil.NewFakeClient(objects...)
+	return NewService(k8sClient, dir, testSyncConfig, testutil.TestLogger()).(*syncService), k8sClient
+}
+
+// managed marks a binding as created by the sync for the given group and user.
+func managed(obj client.Object, group, user string) client.Object {
+	obj.SetLabels(map[string]string{labels.LabelKeyManagedBy: labels.LabelValueManagedByDirectorySync})
+	obj.SetAnnotations(map[string]string{
+		labels.AnnotationKeyDirectoryGroup: group,
+		labels.AnnotationKeyDirectoryUser:  user,
+	})
+	return obj
+}
+
+func TestSyncService_Sync(t *testing.T) {
+	ctx := context.Background()
+	dir := &fakeDirectory{groups: []Group{
+		{Name: "platform", Members: []string{"alice@example.com"}},
+		{Name: "developers", Members: []string{"alice@example.com", "bob@example.com"}},
+		{Name: "contractors", Members: []string{"carol@example.com"}},
+		{Name: "unmapped", Members: []string{"dave@example.com"}},
+	}}
+	svc, _ := newTestService(dir)
+
+	// Bindings the sync manages: bob's grant is outdated, erin left the platform group, and
+	// frank's contractor binding is kept because the contractor role is missing.
+	bob := svc.desiredBinding(testSyncConfig.Mappings[1], "bob@example.com").(*openchoreov1alpha1.AuthzRoleBinding)
+	bob.Spec.RoleMappings[0].Scope.Project = "legacy"
+	erin := managed(&openchoreov1alpha1.ClusterAuthzRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "dirsync-erin"}}, "platform", "erin@example.com")
+	frank := managed(&openchoreov1alpha1.AuthzRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "dirsync-frank", Namespace: "acme"}}, "contractors", "frank@example.com")
+	// Unmanaged bindings: one holds the name of alice's developer binding, one denies alice.
+	taken := svc.desiredBinding(testSyncConfig.Mappings[1], "alice@example.com")
+	taken.SetLabels(nil)
+	deny := &openchoreov1alpha1.ClusterAuthzRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-alice"},
+		Spec: openchoreov1alpha1.ClusterAuthzRoleBindingSpec{
+			Entitlement: openchoreov1alpha1.EntitlementClaim{Claim: "email", Value: "alice@example.com"},
+			Effect:      openchoreov1alpha1.EffectDeny,
+		},
+	}
+	svc, k8sClient := newTestService(dir, bob, erin, frank, taken, deny)
+	alice := svc.desiredBinding(testSyncConfig.Mappings[0], "alice@example.com")
+
+	t.Run("status before the first run", func(t *testing.T) {
+		_, err := svc.Status(ctx)
+		require.ErrorIs(t, err, ErrNoSyncRun)
+	})
+
+	wantChanges := []models.DirectorySyncChange{
+		{Action: models.DirectorySyncActionDelete, Kind: kindClusterAuthzRoleBinding, Name: "dirsync-erin", Group: "platform", User: "erin@example.com"},
+		{Action: models.DirectorySyncActionCreate, Kind: kindClusterAuthzRoleBinding, Name: alice.GetName(), Group: "platform", User: "alice@example.com"},
+		{Action: models.DirectorySyncActionUpdate, Kind: kindAuthzRoleBinding, Namespace: "acme", Name: bob.Name, Group: "developers", User: "bob@example.com"},
+	}
+	wantConflicts := []models.DirectorySyncConflict{
+		{Type: models.DirectorySyncConflictNameTaken, Group: "developers", User: "alice@example.com", Namespace: "acme", Name: taken.GetName(),
+			Message: `binding "` + taken.GetName() + `" exists and is not managed by the directory sync`},
+		{Type: models.DirectorySyncConflictRoleNotFound, Group: "contractors", Namespace: "acme",
+			Message: "role AuthzRole acme/contractor does not exist; the mapping is skipped"},
+		{Type: models.DirectorySyncConflictGroupNotFound, Group: "auditors",
+			Message: `group "auditors" was not found in the directory; previously synced bindings are kept`},
+		{Type: models.DirectorySyncConflictDenyBinding, User: "alice@example.com", Name: "deny-alice",
+			Message: `deny binding "deny-alice" overrides roles synced for alice@example.com`},
+	}
+
+	t.Run("preview does not apply changes", func(t *testing.T) {
+		report, err := svc.Preview(ctx)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, "scim", report.Source)
+		assert.Equal(t, 2, report.Groups)
+		assert.Equal(t, 2, report.Users)
+		assert.Equal(t, wantChanges, report.Changes)
+		assert.Equal(t, wantConflicts, report.Conflicts)
+
+		err = k8sClient.Get(ctx, client.ObjectKeyFromObject(erin), &openchoreov1alpha1.ClusterAuthzRoleBinding{})
+		require.NoError(t, err)
+		_, err = svc.Status(ctx)
+		require.ErrorIs(t, err, ErrNoSyncRun)
+	})
+
+	t.Run("sync applies changes", func(t *testing.T) {
+		report, err := svc.Sync(ctx)
+		require.NoError(t, err)
+		assert.False(t, report.DryRun)
+		assert.Equal(t, wantChanges, report.Changes)
+
+		var created openchoreov1alpha1.ClusterAuthzRoleBinding
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(alice), &created))
+		assert.Equal(t, openchoreov1alpha1.EntitlementClaim{Claim: "email", Value: "alice@example.com"}, created.Spec.Entitlement)
+		assert.Equal(t, "admin", created.Spec.RoleMappings[0].RoleRef.Name)
+
+		var updated openchoreov1alpha1.AuthzRoleBinding
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(bob), &updated))
+		assert.Equal(t, "shop", updated.Spec.RoleMappings[0].Scope.Project)
+
+		err = k8sClient.Get(ctx, client.ObjectKeyFromObject(erin), &openchoreov1alpha1.ClusterAuthzRoleBinding{})
+		assert.True(t, apierrors.IsNotFound(err))
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(frank), &openchoreov1alpha1.AuthzRoleBinding{}))
+
+		status, err := svc.Status(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, report, status)
+	})
+
+	t.Run("second sync has no changes", func(t *testing.T) {
+		report, err := svc.Sync(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, report.Changes)
+		assert.Equal(t, wantConflicts, report.Conflicts)
+	})
+}
+
+func TestSyncService_SyncDirectoryError(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(&fakeDirectory{err: errors.New("connection refused")})
+
+	_, err := svc.Sync(ctx)
+	require.Error(t, err)
+
+	status, err := svc.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "failed to read directory: connection refused", status.Error)
+	assert.Empty(t, status.Changes)
+}
+
+func TestSyncService_SyncInProgress(t *testing.T) {
+	svc, _ := newTestService(&fakeDirectory{})
+	svc.syncMu.Lock()
+	defer svc.syncMu.Unlock()
+
+	_, err := svc.Sync(context.Background())
+	require.ErrorIs(t, err, ErrSyncInProgress)
+}
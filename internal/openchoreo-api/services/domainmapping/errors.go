@@ -0,0 +1,13 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmapping
+
+import "errors"
+
+var (
+	ErrComponentNotFound          = errors.New("component not found")
+	ErrDomainMappingNotFound      = errors.New("domain mapping not found")
+	ErrDomainMappingAlreadyExists = errors.New("domain mapping already exists")
+	ErrDomainAlreadyClaimed       = errors.New("domain is already mapped")
+)
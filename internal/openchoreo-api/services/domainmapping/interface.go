@@ -0,0 +1,21 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmapping
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Service defines the domain mapping service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	ListDomainMappings(ctx context.Context, namespaceName, componentName string) ([]models.DomainMapping, error)
+	GetDomainMapping(ctx context.Context, namespaceName, componentName, name string) (*models.DomainMapping, error)
+	// CreateDomainMapping attaches a custom domain to a public endpoint of a component. The
+	// domain is served once its ownership is verified with the returned DNS TXT record.
+	CreateDomainMapping(ctx context.Context, namespaceName, componentName string, req *models.CreateDomainMappingRequest) (*models.DomainMapping, error)
+	DeleteDomainMapping(ctx context.Context, namespaceName, componentName, name string) error
+}
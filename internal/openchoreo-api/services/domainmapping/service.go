@@ -0,0 +1,270 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmapping
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// conditionReady is the condition the DomainMapping controller reports whether the
+// endpoint is served under the domain with.
+const conditionReady = "Ready"
+
+var domainPattern = regexp.MustCompile(`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+// domainMappingService handles domain mappings without authorization checks.
+type domainMappingService struct {
+	k8sClient client.Client
+	logger    *slog.Logger
+}
+
+var _ Service = (*domainMappingService)(nil)
+
+// NewService creates a new domain mapping service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &domainMappingService{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+func (s *domainMappingService) ListDomainMappings(ctx context.Context, namespaceName, componentName string) ([]models.DomainMapping, error) {
+	s.logger.Debug("Listing domain mappings", "namespace", namespaceName, "component", componentName)
+
+	component, err := getComponent(ctx, s.k8sClient, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	var list openchoreov1alpha1.DomainMappingList
+	if err := s.k8sClient.List(ctx, &list, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list domain mappings", "error", err)
+		return nil, fmt.Errorf("failed to list domain mappings: %w", err)
+	}
+
+	out := []models.DomainMapping{}
+	for i := range list.Items {
+		if ownedBy(&list.Items[i], component) {
+			out = append(out, toModel(&list.Items[i]))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func (s *domainMappingService) GetDomainMapping(ctx context.Context, namespaceName, componentName, name string) (*models.DomainMapping, error) {
+	dm, err := s.get(ctx, namespaceName, componentName, name)
+	if err != nil {
+		return nil, err
+	}
+	m := toModel(dm)
+	return &m, nil
+}
+
+func (s *domainMappingService) CreateDomainMapping(ctx context.Context, namespaceName, componentName string, req *models.CreateDomainMappingRequest) (*models.DomainMapping, error) {
+	if req == nil {
+		return nil, fmt.Errorf("domain mapping request cannot be nil")
+	}
+	domain := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(req.Domain), "."))
+	if err := validateRequest(req, domain); err != nil {
+		return nil, err
+	}
+
+	component, err := getComponent(ctx, s.k8sClient, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: req.Environment}, env); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &services.ValidationError{Msg: fmt.Sprintf("environment %q not found", req.Environment)}
+		}
+		s.logger.Error("Failed to get environment", "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+	if env.Spec.CustomDomains == nil {
+		return nil, &services.ValidationError{Msg: fmt.Sprintf("environment %q does not allow custom domains", req.Environment)}
+	}
+
+	claimed, err := s.domainClaimed(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	if claimed {
+		return nil, ErrDomainAlreadyClaimed
+	}
+
+	name := req.Name
+	if name == "" {
+		name = dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxServiceNameLength, componentName, domain)
+	}
+	dm := &openchoreov1alpha1.DomainMapping{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespaceName},
+		Spec: openchoreov1alpha1.DomainMappingSpec{
+			Owner: openchoreov1alpha1.DomainMappingOwner{
+				ProjectName:   component.Spec.Owner.ProjectName,
+				ComponentName: componentName,
+			},
+			Environment: req.Environment,
+			Endpoint:    req.Endpoint,
+			Domain:      domain,
+		},
+	}
+	if err := s.k8sClient.Create(ctx, dm); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, ErrDomainMappingAlreadyExists
+		}
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return nil, vErr
+		}
+		s.logger.Error("Failed to create domain mapping", "error", err)
+		return nil, fmt.Errorf("failed to create domain mapping: %w", err)
+	}
+
+	s.logger.Info("Domain mapping created", "namespace", namespaceName, "component", componentName, "domain", domain)
+	m := toModel(dm)
+	return &m, nil
+}
+
+func (s *domainMappingService) DeleteDomainMapping(ctx context.Context, namespaceName, componentName, name string) error {
+	dm, err := s.get(ctx, namespaceName, componentName, name)
+	if err != nil {
+		return err
+	}
+	if err := s.k8sClient.Delete(ctx, dm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrDomainMappingNotFound
+		}
+		s.logger.Error("Failed to delete domain mapping", "error", err)
+		return fmt.Errorf("failed to delete domain mapping: %w", err)
+	}
+	s.logger.Info("Domain mapping deleted", "namespace", namespaceName, "component", componentName, "name", name)
+	return nil
+}
+
+// get returns a domain mapping of the component.
+func (s *domainMappingService) get(ctx context.Context, namespaceName, componentName, name string) (*openchoreov1alpha1.DomainMapping, error) {
+	component, err := getComponent(ctx, s.k8sClient, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	dm := &openchoreov1alpha1.DomainMapping{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: name}, dm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrDomainMappingNotFound
+		}
+		s.logger.Error("Failed to get domain mapping", "error", err)
+		return nil, fmt.Errorf("failed to get domain mapping: %w", err)
+	}
+	if !ownedBy(dm, component) {
+		return nil, ErrDomainMappingNotFound
+	}
+	return dm, nil
+}
+
+// domainClaimed reports whether a domain mapping in any namespace already claims the domain.
+func (s *domainMappingService) domainClaimed(ctx context.Context, domain string) (bool, error) {
+	var list openchoreov1alpha1.DomainMappingList
+	if err := s.k8sClient.List(ctx, &list); err != nil {
+		s.logger.Error("Failed to list domain mappings", "error", err)
+		return false, fmt.Errorf("failed to list domain mappings: %w", err)
+	}
+	for _, dm := range list.Items {
+		if dm.Spec.Domain == domain {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func validateRequest(req *models.CreateDomainMappingRequest, domain string) error {
+	switch {
+	case req.Environment == "":
+		return &services.ValidationError{Msg: "environment is required"}
+	case req.Endpoint == "":
+		return &services.ValidationError{Msg: "endpoint is required"}
+	case domain == "":
+		return &services.ValidationError{Msg: "domain is required"}
+	case len(domain) > 253 || !domainPattern.MatchString(domain):
+		return &services.ValidationError{Msg: fmt.Sprintf("domain %q is not a valid fully qualified domain name", req.Domain)}
+	}
+	return nil
+}
+
+// getComponent returns a component, mapping a missing component to ErrComponentNotFound.
+func getComponent(ctx context.Context, k8sClient client.Client, namespaceName, componentName string) (*openchoreov1alpha1.Component, error) {
+	component := &openchoreov1alpha1.Component{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: componentName}, component); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrComponentNotFound
+		}
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+	return component, nil
+}
+
+func ownedBy(dm *openchoreov1alpha1.DomainMapping, component *openchoreov1alpha1.Component) bool {
+	return dm.Spec.Owner.ComponentName == component.Name && dm.Spec.Owner.ProjectName == component.Spec.Owner.ProjectName
+}
+
+// toModel converts a DomainMapping to its API model.
+func toModel(dm *openchoreov1alpha1.DomainMapping) models.DomainMapping {
+	m := models.DomainMapping{
+		Name:        dm.Name,
+		Project:     dm.Spec.Owner.ProjectName,
+		Component:   dm.Spec.Owner.ComponentName,
+		Environment: dm.Spec.Environment,
+		Endpoint:    dm.Spec.Endpoint,
+		Domain:      dm.Spec.Domain,
+		URL:         dm.Status.URL,
+		CreatedAt:   dm.CreationTimestamp.Time,
+	}
+	if v := dm.Status.Verification; v != nil {
+		m.Verification = &models.DomainVerification{
+			RecordName:    v.RecordName,
+			RecordValue:   v.RecordValue,
+			Verified:      v.VerifiedAt != nil,
+			VerifiedAt:    timePtr(v.VerifiedAt),
+			LastCheckedAt: timePtr(v.LastCheckedAt),
+		}
+	}
+	if c := dm.Status.Certificate; c != nil {
+		m.Certificate = &models.DomainCertificate{
+			SecretName: c.SecretName,
+			Ready:      c.Ready,
+			NotAfter:   timePtr(c.NotAfter),
+			Message:    c.Message,
+		}
+	}
+	if cond := meta.FindStatusCondition(dm.Status.Conditions, conditionReady); cond != nil {
+		m.Ready = cond.Status == metav1.ConditionTrue
+		m.Reason = cond.Reason
+		m.Message = cond.Message
+	}
+	return m
+}
+
+func timePtr(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmapping
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const resourceTypeComponent = "component"
+
+// domainMappingServiceWithAuthz wraps a Service and adds authorization checks. Domain
+// mappings are part of a component: viewing them requires the view action and changing
+// them the update action of the component.
+type domainMappingServiceWithAuthz struct {
+	internal  Service
+	k8sClient client.Client
+	authz     *services.AuthzChecker
+}
+
+var _ Service = (*domainMappingServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a domain mapping service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &domainMappingServiceWithAuthz{
+		internal:  NewService(k8sClient, logger),
+		k8sClient: k8sClient,
+		authz:     services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+func (s *domainMappingServiceWithAuthz) ListDomainMappings(ctx context.Context, namespaceName, componentName string) ([]models.DomainMapping, error) {
+	if err := s.check(ctx, authz.ActionViewComponent, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+	return s.internal.ListDomainMappings(ctx, namespaceName, componentName)
+}
+
+func (s *domainMappingServiceWithAuthz) GetDomainMapping(ctx context.Context, namespaceName, componentName, name string) (*models.DomainMapping, error) {
+	if err := s.check(ctx, authz.ActionViewComponent, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+	return s.internal.GetDomainMapping(ctx, namespaceName, componentName, name)
+}
+
+func (s *domainMappingServiceWithAuthz) CreateDomainMapping(ctx context.Context, namespaceName, componentName string, req *models.CreateDomainMappingRequest) (*models.DomainMapping, error) {
+	if err := s.check(ctx, authz.ActionUpdateComponent, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateDomainMapping(ctx, namespaceName, componentName, req)
+}
+
+func (s *domainMappingServiceWithAuthz) DeleteDomainMapping(ctx context.Context, namespaceName, componentName, name string) error {
+	if err := s.check(ctx, authz.ActionUpdateComponent, namespaceName, componentName); err != nil {
+		return err
+	}
+	return s.internal.DeleteDomainMapping(ctx, namespaceName, componentName, name)
+}
+
+// check authorizes an action on the component, which resolves the project of the check.
+func (s *domainMappingServiceWithAuthz) check(ctx context.Context, action, namespaceName, componentName string) error {
+	component, err := getComponent(ctx, s.k8sClient, namespaceName, componentName)
+	if err != nil {
+		return err
+	}
+	return s.authz.Check(ctx, services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceTypeComponent,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   component.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmapping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/domainmapping/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newDomainMappingAuthzSvc(pdp authzcore.PDP, internal Service) Service {
+	return &domainMappingServiceWithAuthz{
+		internal:  internal,
+		k8sClient: testutil.NewFakeClient(testutil.NewComponent("ns-1", "shop", "api")),
+		authz:     testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+var componentHierarchy = authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "shop", Component: "api"}
+
+func TestListDomainMappings_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("ListDomainMappings", mock.Anything, "ns-1", "api").Return([]models.DomainMapping{}, nil)
+		svc := newDomainMappingAuthzSvc(pdp, mockSvc)
+
+		_, err := svc.ListDomainMappings(testutil.AuthzContext(), "ns-1", "api")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:view", "component", "api", componentHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		svc := newDomainMappingAuthzSvc(testutil.DenyPDP(), mocks.NewMockService(t))
+		_, err := svc.ListDomainMappings(testutil.AuthzContext(), "ns-1", "api")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		svc := newDomainMappingAuthzSvc(testutil.AllowPDP(), mocks.NewMockService(t))
+		_, err := svc.ListDomainMappings(testutil.AuthzContext(), "ns-1", "missing")
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+}
+
+func TestCreateDomainMapping_AuthzCheck(t *testing.T) {
+	req := &models.CreateDomainMappingRequest{Environment: "production", Endpoint: "http", Domain: "shop.example.com"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("CreateDomainMapping", mock.Anything, "ns-1", "api", req).Return(&models.DomainMapping{Name: "api-shop"}, nil)
+		svc := newDomainMappingAuthzSvc(pdp, mockSvc)
+
+		_, err := svc.CreateDomainMapping(testutil.AuthzContext(), "ns-1", "api", req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:update", "component", "api", componentHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		svc := newDomainMappingAuthzSvc(testutil.DenyPDP(), mocks.NewMockService(t))
+		_, err := svc.CreateDomainMapping(testutil.AuthzContext(), "ns-1", "api", req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestDeleteDomainMapping_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("DeleteDomainMapping", mock.Anything, "ns-1", "api", "api-shop").Return(nil)
+		svc := newDomainMappingAuthzSvc(pdp, mockSvc)
+
+		require.NoError(t, svc.DeleteDomainMapping(testutil.AuthzContext(), "ns-1", "api", "api-shop"))
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:update", "component", "api", componentHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		svc := newDomainMappingAuthzSvc(testutil.DenyPDP(), mocks.NewMockService(t))
+		err := svc.DeleteDomainMapping(testutil.AuthzContext(), "ns-1", "api", "api-shop")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmapping
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testNamespace = "test-ns"
+
+func newTestService(objs ...client.Object) (Service, client.Client) {
+	k8sClient := testutil.NewFakeClient(objs...)
+	return NewService(k8sClient, testutil.TestLogger()), k8sClient
+}
+
+func customDomainEnvironment(name string) *openchoreov1alpha1.Environment {
+	env := testutil.NewEnvironment(testNamespace, name)
+	env.Spec.CustomDomains = &openchoreov1alpha1.CustomDomainPolicy{
+		IssuerRef: openchoreov1alpha1.CertificateIssuerRef{Name: "letsencrypt"},
+	}
+	return env
+}
+
+func newMapping(namespace, project, component, name, domain string) *openchoreov1alpha1.DomainMapping {
+	return &openchoreov1alpha1.DomainMapping{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: openchoreov1alpha1.DomainMappingSpec{
+			Owner:       openchoreov1alpha1.DomainMappingOwner{ProjectName: project, ComponentName: component},
+			Environment: "production",
+			Endpoint:    "http",
+			Domain:      domain,
+		},
+	}
+}
+
+func TestCreateDomainMapping(t *testing.T) {
+	svc, k8sClient := newTestService(
+		testutil.NewComponent(testNamespace, "shop", "api"),
+		customDomainEnvironment("production"),
+	)
+
+	result, err := svc.CreateDomainMapping(context.Background(), testNamespace, "api", &models.CreateDomainMappingRequest{
+		Environment: "production",
+		Endpoint:    "http",
+		Domain:      " Shop.Example.com. ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "shop.example.com", result.Domain)
+	assert.Equal(t, "shop", result.Project)
+	assert.Equal(t, "api", result.Component)
+	assert.NotEmpty(t, result.Name)
+
+	dm := &openchoreov1alpha1.DomainMapping{}
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: result.Name}, dm))
+	assert.Equal(t, "shop.example.com", dm.Spec.Domain)
+	assert.Equal(t, "http", dm.Spec.Endpoint)
+	assert.Equal(t, openchoreov1alpha1.DomainMappingOwner{ProjectName: "shop", ComponentName: "api"}, dm.Spec.Owner)
+}
+
+func TestCreateDomainMapping_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  models.CreateDomainMappingRequest
+		want string
+	}{
+		{
+			name: "missing environment",
+			req:  models.CreateDomainMappingRequest{Endpoint: "http", Domain: "shop.example.com"},
+			want: "environment is required",
+		},
+		{
+			name: "invalid domain",
+			req:  models.CreateDomainMappingRequest{Environment: "production", Endpoint: "http", Domain: "not a domain"},
+			want: "not a valid fully qualified domain name",
+		},
+		{
+			name: "custom domains not allowed",
+			req:  models.CreateDomainMappingRequest{Environment: "development", Endpoint: "http", Domain: "shop.example.com"},
+			want: "does not allow custom domains",
+		},
+		{
+			name: "unknown environment",
+			req:  models.CreateDomainMappingRequest{Environment: "staging", Endpoint: "http", Domain: "shop.example.com"},
+			want: `environment "staging" not found`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newTestService(
+				testutil.NewComponent(testNamespace, "shop", "api"),
+				customDomainEnvironment("production"),
+				testutil.NewEnvironment(testNamespace, "development"),
+			)
+			_, err := svc.CreateDomainMapping(context.Background(), testNamespace, "api", &tt.req)
+			var vErr *services.ValidationError
+			require.True(t, errors.As(err, &vErr), "expected a validation error, got %v", err)
+			assert.Contains(t, vErr.Msg, tt.want)
+		})
+	}
+}
+
+func TestCreateDomainMapping_DomainAlreadyClaimed(t *testing.T) {
+	svc, _ := newTestService(
+		testutil.NewComponent(testNamespace, "shop", "api"),
+		customDomainEnvironment("production"),
+		newMapping("other-ns", "store", "web", "web-shop", "shop.example.com"),
+	)
+
+	_, err := svc.CreateDomainMapping(context.Background(), testNamespace, "api", &models.CreateDomainMappingRequest{
+		Environment: "production",
+		Endpoint:    "http",
+		Domain:      "shop.example.com",
+	})
+	require.ErrorIs(t, err, ErrDomainAlreadyClaimed)
+}
+
+func TestCreateDomainMapping_ComponentNotFound(t *testing.T) {
+	svc, _ := newTestService(customDomainEnvironment("production"))
+
+	_, err := svc.CreateDomainMapping(context.Background(), testNamespace, "api", &models.CreateDomainMappingRequest{
+		Environment: "production",
+		Endpoint:    "http",
+		Domain:      "shop.example.com",
+	})
+	require.ErrorIs(t, err, ErrComponentNotFound)
+}
+
+func TestListDomainMappings(t *testing.T) {
+	svc, _ := newTestService(
+		testutil.NewComponent(testNamespace, "shop", "api"),
+		newMapping(testNamespace, "shop", "api", "api-www", "www.example.com"),
+		newMapping(testNamespace, "shop", "api", "api-shop", "shop.example.com"),
+		newMapping(testNamespace, "shop", "web", "web-shop", "web.example.com"),
+	)
+
+	result, err := svc.ListDomainMappings(context.Background(), testNamespace, "api")
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "api-shop", result[0].Name)
+	assert.Equal(t, "api-www", result[1].Name)
+}
+
+func TestGetDomainMapping_OtherComponent(t *testing.T) {
+	svc, _ := newTestService(
+		testutil.NewComponent(testNamespace, "shop", "api"),
+		newMapping(testNamespace, "shop", "web", "web-shop", "web.example.com"),
+	)
+
+	_, err := svc.GetDomainMapping(context.Background(), testNamespace, "api", "web-shop")
+	require.ErrorIs(t, err, ErrDomainMappingNotFound)
+}
+
+func TestGetDomainMapping_Status(t *testing.T) {
+	verifiedAt := metav1.Now()
+	mapping := newMapping(testNamespace, "shop", "api", "api-shop", "shop.example.com")
+	mapping.Status = openchoreov1alpha1.DomainMappingStatus{
+		URL: "https://shop.example.com",
+		Verification: &openchoreov1alpha1.DomainVerificationStatus{
+			RecordName:  "_openchoreo-challenge.shop.example.com",
+			RecordValue: "openchoreo-domain-verification=abc",
+			VerifiedAt:  &verifiedAt,
+		},
+		Conditions: []metav1.Condition{{
+			Type:    conditionReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  "DomainReady",
+			Message: "Domain is served",
+		}},
+	}
+	svc, _ := newTestService(testutil.NewComponent(testNamespace, "shop", "api"), mapping)
+
+	result, err := svc.GetDomainMapping(context.Background(), testNamespace, "api", "api-shop")
+	require.NoError(t, err)
+	assert.True(t, result.Ready)
+	assert.Equal(t, "DomainReady", result.Reason)
+	assert.Equal(t, "https://shop.example.com", result.URL)
+	require.NotNil(t, result.Verification)
+	assert.True(t, result.Verification.Verified)
+	assert.Equal(t, "_openchoreo-challenge.shop.example.com", result.Verification.RecordName)
+}
+
+func TestDeleteDomainMapping(t *testing.T) {
+	svc, k8sClient := newTestService(
+		testutil.NewComponent(testNamespace, "shop", "api"),
+		newMapping(testNamespace, "shop", "api", "api-shop", "shop.example.com"),
+	)
+
+	require.NoError(t, svc.DeleteDomainMapping(context.Background(), testNamespace, "api", "api-shop"))
+
+	var list openchoreov1alpha1.DomainMappingList
+	require.NoError(t, k8sClient.List(context.Background(), &list, client.InNamespace(testNamespace)))
+	assert.Empty(t, list.Items)
+
+	err := svc.DeleteDomainMapping(context.Background(), testNamespace, "api", "api-shop")
+	require.ErrorIs(t, err, ErrDomainMappingNotFound)
+}
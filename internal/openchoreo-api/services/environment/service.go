@@ -162,6 +162,11 @@ func (s *environmentService) UpdateEnvironment(ctx context.Context, namespaceNam
 		return nil, fmt.Errorf("failed to get environment: %w", err)
 	}
 
+	// A uid in the request pins the update to that instance of the resource
+	if env.UID != "" && env.UID != existing.UID {
+		return nil, services.ErrUIDMismatch
+	}
+
 	// Clear status from user input — status is server-managed
 	env.Status = openchoreov1alpha1.EnvironmentStatus{}
 
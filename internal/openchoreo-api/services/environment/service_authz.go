@@ -5,6 +5,7 @@ package environment
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -86,11 +87,21 @@ func (s *environmentServiceWithAuthz) UpdateEnvironment(ctx context.Context, nam
 		ResourceID:   env.Name,
 		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
 	}); err != nil {
+		// Updating a missing environment creates it, which the create permission authorizes.
+		if errors.Is(err, services.ErrForbidden) && env.UID == "" && s.isEnvironmentMissing(ctx, namespaceName, env.Name) {
+			return nil, ErrEnvironmentNotFound
+		}
 		return nil, err
 	}
 	return s.internal.UpdateEnvironment(ctx, namespaceName, env)
 }
 
+// isEnvironmentMissing reports whether the environment does not exist.
+func (s *environmentServiceWithAuthz) isEnvironmentMissing(ctx context.Context, namespaceName, name string) bool {
+	_, err := s.internal.GetEnvironment(ctx, namespaceName, name)
+	return errors.Is(err, ErrEnvironmentNotFound)
+}
+
 // DeleteEnvironment checks authorization and delegates to the internal service.
 func (s *environmentServiceWithAuthz) DeleteEnvironment(ctx context.Context, namespaceName, envName string) error {
 	if err := s.authz.Check(ctx, services.CheckRequest{
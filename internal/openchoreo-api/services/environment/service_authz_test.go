@@ -77,9 +77,10 @@ func TestEnvironmentAuthz_UpdateEnvironment(t *testing.T) {
 		testutil.RequireEvalRequest(t, pdp.Captured[0], "environment:update", "environment", "env-1", authzcore.ResourceHierarchy{Namespace: "ns-1"})
 	})
 
-	t.Run("denied", func(t *testing.T) {
+	t.Run("denied on existing", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
 		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetEnvironment", mock.Anything, "ns-1", "env-1").Return(env, nil)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -87,6 +88,18 @@ func TestEnvironmentAuthz_UpdateEnvironment(t *testing.T) {
 		_, err := svc.UpdateEnvironment(testutil.AuthzContext(), "ns-1", env)
 		require.ErrorIs(t, err, services.ErrForbidden)
 	})
+
+	t.Run("denied on missing reports not found", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetEnvironment", mock.Anything, "ns-1", "env-1").Return(nil, ErrEnvironmentNotFound)
+		svc := &environmentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.UpdateEnvironment(testutil.AuthzContext(), "ns-1", env)
+		require.ErrorIs(t, err, ErrEnvironmentNotFound)
+	})
 }
 
 func TestEnvironmentAuthz_GetEnvironment(t *testing.T) {
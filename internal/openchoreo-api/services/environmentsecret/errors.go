@@ -0,0 +1,14 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environmentsecret
+
+import "errors"
+
+var (
+	ErrComponentNotFound          = errors.New("component not found")
+	ErrEnvironmentNotFound        = errors.New("environment not found")
+	ErrReleaseBindingNotFound     = errors.New("component is not bound to the environment")
+	ErrEnvironmentSecretsNotFound = errors.New("environment secrets not found")
+	ErrSecretReferenceConflict    = errors.New("secret reference is not managed as environment secrets of the component")
+)
@@ -0,0 +1,21 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environmentsecret
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Service defines the environment secret service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	GetEnvironmentSecrets(ctx context.Context, namespaceName, componentName, environmentName string) (*models.EnvironmentSecrets, error)
+	// SetEnvironmentSecrets stores the secret values of a component in an environment and
+	// exposes every key as an environment variable through the workload overrides of the
+	// component's release binding for the environment.
+	SetEnvironmentSecrets(ctx context.Context, namespaceName, componentName, environmentName string, req *models.SetEnvironmentSecretsRequest) (*models.EnvironmentSecrets, error)
+	DeleteEnvironmentSecrets(ctx context.Context, namespaceName, componentName, environmentName string) error
+}
@@ -0,0 +1,382 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environmentsecret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+)
+
+// envVarNamePattern restricts secret keys to names that can be exposed as environment variables.
+var envVarNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// environmentSecretService handles environment secrets without authorization checks.
+type environmentSecretService struct {
+	k8sClient     client.Client
+	secretService secretsvc.Service
+	logger        *slog.Logger
+}
+
+var _ Service = (*environmentSecretService)(nil)
+
+// NewService creates a new environment secret service without authorization. The values are
+// stored through the given secret service, which must not check authorization itself: access
+// to environment secrets is governed by the component.
+func NewService(k8sClient client.Client, secretService secretsvc.Service, logger *slog.Logger) Service {
+	return &environmentSecretService{
+		k8sClient:     k8sClient,
+		secretService: secretService,
+		logger:        logger,
+	}
+}
+
+func (s *environmentSecretService) GetEnvironmentSecrets(ctx context.Context, namespaceName, componentName, environmentName string) (*models.EnvironmentSecrets, error) {
+	s.logger.Debug("Getting environment secrets", "namespace", namespaceName, "component", componentName, "environment", environmentName)
+
+	component, err := getComponent(ctx, s.k8sClient, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	secretRef, err := s.getSecretReference(ctx, component, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	if secretRef == nil {
+		return nil, ErrEnvironmentSecretsNotFound
+	}
+	binding, err := s.findReleaseBinding(ctx, component, environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(secretRef.Spec.Data))
+	for _, data := range secretRef.Spec.Data {
+		keys = append(keys, data.SecretKey)
+	}
+	sort.Strings(keys)
+	return toModel(component, environmentName, secretRef.Name, binding, keys), nil
+}
+
+func (s *environmentSecretService) SetEnvironmentSecrets(ctx context.Context, namespaceName, componentName, environmentName string, req *models.SetEnvironmentSecretsRequest) (*models.EnvironmentSecrets, error) {
+	if req == nil {
+		return nil, fmt.Errorf("environment secrets request cannot be nil")
+	}
+	if err := validateData(req.Data); err != nil {
+		return nil, err
+	}
+
+	component, err := getComponent(ctx, s.k8sClient, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: environmentName}, env); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrEnvironmentNotFound
+		}
+		s.logger.Error("Failed to get environment", "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+	binding, err := s.findReleaseBinding(ctx, component, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	if binding == nil {
+		return nil, ErrReleaseBindingNotFound
+	}
+
+	secretRef, err := s.getSecretReference(ctx, component, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	name := secretName(componentName, environmentName)
+	if secretRef != nil {
+		_, err = s.secretService.UpdateSecret(ctx, namespaceName, name, &secretsvc.UpdateSecretParams{
+			Data:   req.Data,
+			Labels: secretLabels(component, environmentName),
+		})
+	} else {
+		var target openchoreov1alpha1.TargetPlaneRef
+		if target, err = s.targetPlane(ctx, env); err != nil {
+			return nil, err
+		}
+		_, err = s.secretService.CreateSecret(ctx, namespaceName, &secretsvc.CreateSecretParams{
+			SecretName:  name,
+			SecretType:  corev1.SecretTypeOpaque,
+			TargetPlane: target,
+			Data:        req.Data,
+			Labels:      secretLabels(component, environmentName),
+		})
+	}
+	if err != nil {
+		if errors.Is(err, secretsvc.ErrSecretAlreadyExists) {
+			return nil, ErrSecretReferenceConflict
+		}
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(req.Data))
+	for k := range req.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if err := s.wireOverrides(ctx, binding, name, keys); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Environment secrets set", "namespace", namespaceName, "component", componentName,
+		"environment", environmentName, "keys", len(keys))
+	return toModel(component, environmentName, name, binding, keys), nil
+}
+
+func (s *environmentSecretService) DeleteEnvironmentSecrets(ctx context.Context, namespaceName, componentName, environmentName string) error {
+	component, err := getComponent(ctx, s.k8sClient, namespaceName, componentName)
+	if err != nil {
+		return err
+	}
+	secretRef, err := s.getSecretReference(ctx, component, environmentName)
+	if err != nil {
+		return err
+	}
+	if secretRef == nil {
+		return ErrEnvironmentSecretsNotFound
+	}
+
+	// Unwire the values before deleting them, so that the binding never references a missing
+	// SecretReference.
+	binding, err := s.findReleaseBinding(ctx, component, environmentName)
+	if err != nil {
+		return err
+	}
+	if binding != nil {
+		if err := s.wireOverrides(ctx, binding, secretRef.Name, nil); err != nil {
+			return err
+		}
+	}
+	if err := s.secretService.DeleteSecret(ctx, namespaceName, secretRef.Name); err != nil {
+		if errors.Is(err, secretsvc.ErrSecretNotFound) {
+			return ErrEnvironmentSecretsNotFound
+		}
+		return err
+	}
+
+	s.logger.Info("Environment secrets deleted", "namespace", namespaceName, "component", componentName, "environment", environmentName)
+	return nil
+}
+
+// getSecretReference returns the SecretReference holding the environment secrets of a
+// component, or nil when none has been set. A SecretReference with the same name that does not
+// belong to the component and environment is a conflict.
+func (s *environmentSecretService) getSecretReference(ctx context.Context, component *openchoreov1alpha1.Component, environmentName string) (*openchoreov1alpha1.SecretReference, error) {
+	secretRef := &openchoreov1alpha1.SecretReference{}
+	key := client.ObjectKey{Namespace: component.Namespace, Name: secretName(component.Name, environmentName)}
+	if err := s.k8sClient.Get(ctx, key, secretRef); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		s.logger.Error("Failed to get secret reference", "error", err)
+		return nil, fmt.Errorf("failed to get secret reference: %w", err)
+	}
+	if secretRef.Labels[labels.LabelKeyComponentName] != component.Name ||
+		secretRef.Labels[labels.LabelKeyEnvironmentName] != environmentName {
+		return nil, ErrSecretReferenceConflict
+	}
+	return secretRef, nil
+}
+
+// findReleaseBinding returns the release binding of a component in an environment, or nil when
+// the component is not bound to the environment.
+func (s *environmentSecretService) findReleaseBinding(ctx context.Context, component *openchoreov1alpha1.Component, environmentName string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	binding, err := findReleaseBinding(ctx, s.k8sClient, component, environmentName)
+	if err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+	}
+	return binding, err
+}
+
+// findReleaseBinding returns the release binding of a component in an environment, or nil when
+// the component is not bound to the environment.
+func findReleaseBinding(ctx context.Context, k8sClient client.Client, component *openchoreov1alpha1.Component, environmentName string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := k8sClient.List(ctx, &bindings, client.InNamespace(component.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for i := range bindings.Items {
+		rb := &bindings.Items[i]
+		if rb.Spec.Owner.ProjectName == component.Spec.Owner.ProjectName && rb.Spec.Owner.ComponentName == component.Name &&
+			rb.Spec.Environment == environmentName {
+			return rb, nil
+		}
+	}
+	return nil, nil
+}
+
+// targetPlane returns the data plane of an environment, which the values are pushed to.
+func (s *environmentSecretService) targetPlane(ctx context.Context, env *openchoreov1alpha1.Environment) (openchoreov1alpha1.TargetPlaneRef, error) {
+	result, err := controller.GetDataPlaneFromRef(ctx, s.k8sClient, env.Namespace, env.Spec.DataPlaneRef)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return openchoreov1alpha1.TargetPlaneRef{}, secretsvc.ErrPlaneNotFound
+		}
+		return openchoreov1alpha1.TargetPlaneRef{}, fmt.Errorf("failed to resolve data plane of environment %q: %w", env.Name, err)
+	}
+	if result.ClusterDataPlane != nil {
+		return openchoreov1alpha1.TargetPlaneRef{
+			Kind: string(openchoreov1alpha1.DataPlaneRefKindClusterDataPlane),
+			Name: result.ClusterDataPlane.Name,
+		}, nil
+	}
+	return openchoreov1alpha1.TargetPlaneRef{
+		Kind: string(openchoreov1alpha1.DataPlaneRefKindDataPlane),
+		Name: result.DataPlane.Name,
+	}, nil
+}
+
+// wireOverrides exposes the keys of a SecretReference as environment variables in the workload
+// overrides of a release binding. Variables previously wired from the SecretReference are
+// replaced, and the keys take precedence over other overrides of the same variable.
+func (s *environmentSecretService) wireOverrides(ctx context.Context, binding *openchoreov1alpha1.ReleaseBinding, secretRefName string, keys []string) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := s.k8sClient.Get(ctx, client.ObjectKeyFromObject(binding), binding); err != nil {
+			return err
+		}
+		var current []openchoreov1alpha1.EnvVar
+		if binding.Spec.WorkloadOverrides != nil && binding.Spec.WorkloadOverrides.Container != nil {
+			current = binding.Spec.WorkloadOverrides.Container.Env
+		}
+		env := wiredEnv(current, secretRefName, keys)
+		if slices.EqualFunc(current, env, envVarEqual) {
+			return nil
+		}
+
+		if binding.Spec.WorkloadOverrides == nil {
+			binding.Spec.WorkloadOverrides = &openchoreov1alpha1.WorkloadOverrideTemplateSpec{}
+		}
+		if binding.Spec.WorkloadOverrides.Container == nil {
+			binding.Spec.WorkloadOverrides.Container = &openchoreov1alpha1.ContainerOverride{}
+		}
+		binding.Spec.WorkloadOverrides.Container.Env = env
+		return s.k8sClient.Update(ctx, binding)
+	})
+	if err != nil {
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return vErr
+		}
+		s.logger.Error("Failed to update release binding", "releaseBinding", binding.Name, "error", err)
+		return fmt.Errorf("failed to update release binding: %w", err)
+	}
+	return nil
+}
+
+// wiredEnv returns the override variables with the keys of a SecretReference wired in.
+func wiredEnv(current []openchoreov1alpha1.EnvVar, secretRefName string, keys []string) []openchoreov1alpha1.EnvVar {
+	wired := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		wired[k] = struct{}{}
+	}
+	env := make([]openchoreov1alpha1.EnvVar, 0, len(current)+len(keys))
+	for _, e := range current {
+		if _, ok := wired[e.Key]; ok {
+			continue
+		}
+		if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil && e.ValueFrom.SecretKeyRef.Name == secretRefName {
+			continue
+		}
+		env = append(env, e)
+	}
+	for _, k := range keys {
+		env = append(env, openchoreov1alpha1.EnvVar{
+			Key: k,
+			ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+				SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: secretRefName, Key: k},
+			},
+		})
+	}
+	return env
+}
+
+func envVarEqual(a, b openchoreov1alpha1.EnvVar) bool {
+	if a.Key != b.Key || a.Value != b.Value || (a.ValueFrom == nil) != (b.ValueFrom == nil) {
+		return false
+	}
+	if a.ValueFrom == nil {
+		return true
+	}
+	ar, br := a.ValueFrom.SecretKeyRef, b.ValueFrom.SecretKeyRef
+	if ar == nil || br == nil {
+		return ar == br
+	}
+	return *ar == *br
+}
+
+func validateData(data map[string]string) error {
+	if len(data) == 0 {
+		return &services.ValidationError{Msg: "data must contain at least one entry"}
+	}
+	for k, v := range data {
+		if !envVarNamePattern.MatchString(k) {
+			return &services.ValidationError{Msg: fmt.Sprintf("data key %q is not a valid environment variable name", k)}
+		}
+		if v == "" {
+			return &services.ValidationError{Msg: fmt.Sprintf("data[%q] must not be empty", k)}
+		}
+	}
+	return nil
+}
+
+// secretName is the name of the SecretReference holding the environment secrets of a component.
+func secretName(componentName, environmentName string) string {
+	return dpkubernetes.GenerateK8sName(componentName, environmentName, "secrets")
+}
+
+func secretLabels(component *openchoreov1alpha1.Component, environmentName string) map[string]string {
+	return map[string]string{
+		labels.LabelKeyProjectName:     component.Spec.Owner.ProjectName,
+		labels.LabelKeyComponentName:   component.Name,
+		labels.LabelKeyEnvironmentName: environmentName,
+	}
+}
+
+// getComponent returns a component, mapping a missing component to ErrComponentNotFound.
+func getComponent(ctx context.Context, k8sClient client.Client, namespaceName, componentName string) (*openchoreov1alpha1.Component, error) {
+	component := &openchoreov1alpha1.Component{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: componentName}, component); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrComponentNotFound
+		}
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+	return component, nil
+}
+
+func toModel(component *openchoreov1alpha1.Component, environmentName, secretRefName string, binding *openchoreov1alpha1.ReleaseBinding, keys []string) *models.EnvironmentSecrets {
+	m := &models.EnvironmentSecrets{
+		Component:       component.Name,
+		Environment:     environmentName,
+		SecretReference: secretRefName,
+		Keys:            keys,
+	}
+	if binding != nil {
+		m.ReleaseBinding = binding.Name
+	}
+	return m
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environmentsecret
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+)
+
+const (
+	resourceTypeComponent       = "component"
+	resourceTypeSecretReference = "secretReference"
+	resourceTypeSecret          = "secret"
+	resourceTypeReleaseBinding  = "releasebinding"
+)
+
+// environmentSecretServiceWithAuthz wraps a Service and adds authorization checks. Environment
+// secrets are part of a component: viewing their keys requires the view action of the component.
+// Changing them also writes the SecretReference and Secret holding the values and the release
+// binding they are wired into, so it requires the actions on those resources as well.
+type environmentSecretServiceWithAuthz struct {
+	internal  Service
+	k8sClient client.Client
+	authz     *services.AuthzChecker
+}
+
+var _ Service = (*environmentSecretServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates an environment secret service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, planeClientProvider kubernetesClient.PlaneClientProvider, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &environmentSecretServiceWithAuthz{
+		internal:  NewService(k8sClient, secretsvc.NewService(k8sClient, planeClientProvider, logger), logger),
+		k8sClient: k8sClient,
+		authz:     services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+func (s *environmentSecretServiceWithAuthz) GetEnvironmentSecrets(ctx context.Context, namespaceName, componentName, environmentName string) (*models.EnvironmentSecrets, error) {
+	component, err := getComponent(ctx, s.k8sClient, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, componentCheck(authz.ActionViewComponent, component)); err != nil {
+		return nil, err
+	}
+	return s.internal.GetEnvironmentSecrets(ctx, namespaceName, componentName, environmentName)
+}
+
+func (s *environmentSecretServiceWithAuthz) SetEnvironmentSecrets(ctx context.Context, namespaceName, componentName, environmentName string, req *models.SetEnvironmentSecretsRequest) (*models.EnvironmentSecrets, error) {
+	// Setting the secrets creates the SecretReference and Secret the first time and updates
+	// them afterwards, so both actions are required whichever applies
+	if err := s.checkAll(ctx, namespaceName, componentName, environmentName,
+		[]string{authz.ActionCreateSecretReference, authz.ActionUpdateSecretReference},
+		[]string{authz.ActionCreateSecret, authz.ActionUpdateSecret}); err != nil {
+		return nil, err
+	}
+	return s.internal.SetEnvironmentSecrets(ctx, namespaceName, componentName, environmentName, req)
+}
+
+func (s *environmentSecretServiceWithAuthz) DeleteEnvironmentSecrets(ctx context.Context, namespaceName, componentName, environmentName string) error {
+	if err := s.checkAll(ctx, namespaceName, componentName, environmentName,
+		[]string{authz.ActionDeleteSecretReference},
+		[]string{authz.ActionDeleteSecret}); err != nil {
+		return err
+	}
+	return s.internal.DeleteEnvironmentSecrets(ctx, namespaceName, componentName, environmentName)
+}
+
+// checkAll authorizes a change of the environment secrets of a component: the update action
+// of the component, the given SecretReference and Secret actions on the resources holding the
+// values, and the update action of the release binding they are wired into. A component that is
+// not bound to the environment has no binding to check. All checks use the hierarchy of the
+// component and the first denial is returned.
+func (s *environmentSecretServiceWithAuthz) checkAll(ctx context.Context, namespaceName, componentName, environmentName string, secretRefActions, secretActions []string) error {
+	component, err := getComponent(ctx, s.k8sClient, namespaceName, componentName)
+	if err != nil {
+		return err
+	}
+	binding, err := findReleaseBinding(ctx, s.k8sClient, component, environmentName)
+	if err != nil {
+		return err
+	}
+
+	name := secretName(componentName, environmentName)
+	requests := []services.CheckRequest{componentCheck(authz.ActionUpdateComponent, component)}
+	for _, action := range secretRefActions {
+		requests = append(requests, resourceCheck(action, resourceTypeSecretReference, name, component))
+	}
+	for _, action := range secretActions {
+		requests = append(requests, resourceCheck(action, resourceTypeSecret, name, component))
+	}
+	if binding != nil {
+		req := resourceCheck(authz.ActionUpdateReleaseBinding, resourceTypeReleaseBinding, binding.Name, component)
+		req.Context = authz.Context{
+			Resource: authz.ResourceAttribute{
+				Environment: services.FormatDualScopedResourceName(namespaceName, binding.Spec.Environment, false)},
+		}
+		requests = append(requests, req)
+	}
+
+	for _, req := range requests {
+		if err := s.authz.Check(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// componentCheck builds the request that authorizes an action on a component.
+func componentCheck(action string, component *openchoreov1alpha1.Component) services.CheckRequest {
+	return resourceCheck(action, resourceTypeComponent, component.Name, component)
+}
+
+// resourceCheck builds the request that authorizes an action on a resource of a component.
+func resourceCheck(action, resourceType, resourceID string, component *openchoreov1alpha1.Component) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: component.Namespace,
+			Project:   component.Spec.Owner.ProjectName,
+			Component: component.Name,
+		},
+	}
+}
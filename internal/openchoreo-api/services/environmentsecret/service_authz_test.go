@@ -0,0 +1,160 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environmentsecret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environmentsecret/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newEnvironmentSecretAuthzSvc(pdp authzcore.PDP, internal Service) Service {
+	return &environmentSecretServiceWithAuthz{
+		internal:  internal,
+		k8sClient: testutil.NewFakeClient(testutil.NewComponent("ns-1", "shop", "api")),
+		authz:     testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+var componentHierarchy = authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "shop", Component: "api"}
+
+func TestGetEnvironmentSecrets_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetEnvironmentSecrets", mock.Anything, "ns-1", "api", "production").Return(&models.EnvironmentSecrets{}, nil)
+		svc := newEnvironmentSecretAuthzSvc(pdp, mockSvc)
+
+		_, err := svc.GetEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "api", "production")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:view", "component", "api", componentHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		svc := newEnvironmentSecretAuthzSvc(testutil.DenyPDP(), mocks.NewMockService(t))
+		_, err := svc.GetEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "api", "production")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		svc := newEnvironmentSecretAuthzSvc(testutil.AllowPDP(), mocks.NewMockService(t))
+		_, err := svc.GetEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "missing", "production")
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+}
+
+// denyActionPDP approves every request except those for one action.
+type denyActionPDP struct {
+	testutil.CapturingPDP
+	action string
+}
+
+func (p *denyActionPDP) Evaluate(ctx context.Context, req *authzcore.EvaluateRequest) (*authzcore.Decision, error) {
+	if _, err := p.CapturingPDP.Evaluate(ctx, req); err != nil {
+		return nil, err
+	}
+	return &authzcore.Decision{Decision: req.Action != p.action, Context: &authzcore.DecisionContext{}}, nil
+}
+
+func newBoundEnvironmentSecretAuthzSvc(pdp authzcore.PDP, internal Service) Service {
+	return &environmentSecretServiceWithAuthz{
+		internal: internal,
+		k8sClient: testutil.NewFakeClient(
+			testutil.NewComponent("ns-1", "shop", "api"),
+			testutil.NewReleaseBinding("ns-1", "shop", "api", "production", "api-production"),
+		),
+		authz: testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestSetEnvironmentSecrets_AuthzCheck(t *testing.T) {
+	req := &models.SetEnvironmentSecretsRequest{Data: map[string]string{"DB_PASSWORD": "s3cr3t"}}
+	name := secretName("api", "production")
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("SetEnvironmentSecrets", mock.Anything, "ns-1", "api", "production", req).Return(&models.EnvironmentSecrets{}, nil)
+		svc := newBoundEnvironmentSecretAuthzSvc(pdp, mockSvc)
+
+		_, err := svc.SetEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "api", "production", req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 6)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:update", "component", "api", componentHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "secretreference:create", "secretReference", name, componentHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[2], "secretreference:update", "secretReference", name, componentHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[3], "secret:create", "secret", name, componentHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[4], "secret:update", "secret", name, componentHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[5], "releasebinding:update", "releasebinding", "api-production", componentHierarchy)
+		require.Equal(t, "ns-1/production", pdp.Captured[5].Context.Resource.Environment)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		svc := newBoundEnvironmentSecretAuthzSvc(testutil.DenyPDP(), mocks.NewMockService(t))
+		_, err := svc.SetEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "api", "production", req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	for _, action := range []string{
+		"secretreference:create", "secretreference:update", "secret:create", "secret:update", "releasebinding:update",
+	} {
+		t.Run("denied "+action, func(t *testing.T) {
+			svc := newBoundEnvironmentSecretAuthzSvc(&denyActionPDP{action: action}, mocks.NewMockService(t))
+			_, err := svc.SetEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "api", "production", req)
+			require.ErrorIs(t, err, services.ErrForbidden)
+		})
+	}
+
+	t.Run("unbound component", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("SetEnvironmentSecrets", mock.Anything, "ns-1", "api", "production", req).Return(nil, ErrReleaseBindingNotFound)
+		svc := newEnvironmentSecretAuthzSvc(pdp, mockSvc)
+
+		_, err := svc.SetEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "api", "production", req)
+		require.ErrorIs(t, err, ErrReleaseBindingNotFound)
+		require.Len(t, pdp.Captured, 5)
+	})
+}
+
+func TestDeleteEnvironmentSecrets_AuthzCheck(t *testing.T) {
+	name := secretName("api", "production")
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("DeleteEnvironmentSecrets", mock.Anything, "ns-1", "api", "production").Return(nil)
+		svc := newBoundEnvironmentSecretAuthzSvc(pdp, mockSvc)
+
+		require.NoError(t, svc.DeleteEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "api", "production"))
+		require.Len(t, pdp.Captured, 4)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:update", "component", "api", componentHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "secretreference:delete", "secretReference", name, componentHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[2], "secret:delete", "secret", name, componentHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[3], "releasebinding:update", "releasebinding", "api-production", componentHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		svc := newBoundEnvironmentSecretAuthzSvc(testutil.DenyPDP(), mocks.NewMockService(t))
+		err := svc.DeleteEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "api", "production")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	for _, action := range []string{"secretreference:delete", "secret:delete", "releasebinding:update"} {
+		t.Run("denied "+action, func(t *testing.T) {
+			svc := newBoundEnvironmentSecretAuthzSvc(&denyActionPDP{action: action}, mocks.NewMockService(t))
+			err := svc.DeleteEnvironmentSecrets(testutil.AuthzContext(), "ns-1", "api", "production")
+			require.ErrorIs(t, err, services.ErrForbidden)
+		})
+	}
+}
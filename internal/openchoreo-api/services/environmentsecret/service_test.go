@@ -0,0 +1,274 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environmentsecret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+	secretmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testNamespace = "test-ns"
+
+func newTestService(t *testing.T, objs ...client.Object) (Service, *secretmocks.MockService, client.Client) {
+	k8sClient := testutil.NewFakeClient(objs...)
+	secrets := secretmocks.NewMockService(t)
+	return NewService(k8sClient, secrets, testutil.TestLogger()), secrets, k8sClient
+}
+
+func productionEnvironment() *openchoreov1alpha1.Environment {
+	env := testutil.NewEnvironment(testNamespace, "production")
+	env.Spec.DataPlaneRef = &openchoreov1alpha1.DataPlaneRef{
+		Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane,
+		Name: "prod-dp",
+	}
+	return env
+}
+
+// managedSecretReference is the SecretReference of previously set environment secrets.
+func managedSecretReference(keys ...string) *openchoreov1alpha1.SecretReference {
+	secretRef := testutil.NewSecretReference(testNamespace, secretName("api", "production"))
+	secretRef.Labels = map[string]string{
+		labels.LabelKeyProjectName:     "shop",
+		labels.LabelKeyComponentName:   "api",
+		labels.LabelKeyEnvironmentName: "production",
+	}
+	secretRef.Spec.Data = nil
+	for _, k := range keys {
+		secretRef.Spec.Data = append(secretRef.Spec.Data, openchoreov1alpha1.SecretDataSource{
+			SecretKey: k,
+			RemoteRef: openchoreov1alpha1.RemoteReference{Key: "secret/test", Property: k},
+		})
+	}
+	return secretRef
+}
+
+func secretEnv(secretRefName, key string) openchoreov1alpha1.EnvVar {
+	return openchoreov1alpha1.EnvVar{
+		Key: key,
+		ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+			SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: secretRefName, Key: key},
+		},
+	}
+}
+
+func bindingEnv(t *testing.T, k8sClient client.Client) []openchoreov1alpha1.EnvVar {
+	t.Helper()
+	rb := &openchoreov1alpha1.ReleaseBinding{}
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: "api-production"}, rb))
+	require.NotNil(t, rb.Spec.WorkloadOverrides)
+	require.NotNil(t, rb.Spec.WorkloadOverrides.Container)
+	return rb.Spec.WorkloadOverrides.Container.Env
+}
+
+func TestSetEnvironmentSecrets_Create(t *testing.T) {
+	binding := testutil.NewReleaseBinding(testNamespace, "shop", "api", "production", "api-production")
+	binding.Spec.WorkloadOverrides = &openchoreov1alpha1.WorkloadOverrideTemplateSpec{
+		Container: &openchoreov1alpha1.ContainerOverride{
+			Env: []openchoreov1alpha1.EnvVar{
+				{Key: "LOG_LEVEL", Value: "debug"},
+				{Key: "DB_PASSWORD", Value: "plaintext"},
+			},
+		},
+	}
+	svc, secrets, k8sClient := newTestService(t,
+		testutil.NewComponent(testNamespace, "shop", "api"),
+		productionEnvironment(),
+		testutil.NewDataPlane(testNamespace, "prod-dp"),
+		binding,
+	)
+
+	name := secretName("api", "production")
+	data := map[string]string{"DB_PASSWORD": "s3cr3t", "API_KEY": "k3y"}
+	secrets.On("CreateSecret", mock.Anything, testNamespace, &secretsvc.CreateSecretParams{
+		SecretName:  name,
+		SecretType:  corev1.SecretTypeOpaque,
+		TargetPlane: openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "prod-dp"},
+		Data:        data,
+		Labels: map[string]string{
+			labels.LabelKeyProjectName:     "shop",
+			labels.LabelKeyComponentName:   "api",
+			labels.LabelKeyEnvironmentName: "production",
+		},
+	}).Return(&corev1.Secret{}, nil)
+
+	result, err := svc.SetEnvironmentSecrets(context.Background(), testNamespace, "api", "production",
+		&models.SetEnvironmentSecretsRequest{Data: data})
+	require.NoError(t, err)
+	assert.Equal(t, &models.EnvironmentSecrets{
+		Component:       "api",
+		Environment:     "production",
+		SecretReference: name,
+		ReleaseBinding:  "api-production",
+		Keys:            []string{"API_KEY", "DB_PASSWORD"},
+	}, result)
+
+	// The secret values take precedence over other overrides of the same variable.
+	assert.Equal(t, []openchoreov1alpha1.EnvVar{
+		{Key: "LOG_LEVEL", Value: "debug"},
+		secretEnv(name, "API_KEY"),
+		secretEnv(name, "DB_PASSWORD"),
+	}, bindingEnv(t, k8sClient))
+}
+
+func TestSetEnvironmentSecrets_Update(t *testing.T) {
+	name := secretName("api", "production")
+	binding := testutil.NewReleaseBinding(testNamespace, "shop", "api", "production", "api-production")
+	binding.Spec.WorkloadOverrides = &openchoreov1alpha1.WorkloadOverrideTemplateSpec{
+		Container: &openchoreov1alpha1.ContainerOverride{
+			Env: []openchoreov1alpha1.EnvVar{
+				secretEnv(name, "OLD_TOKEN"),
+				secretEnv("shared-db", "DB_PASSWORD"),
+			},
+		},
+	}
+	svc, secrets, k8sClient := newTestService(t,
+		testutil.NewComponent(testNamespace, "shop", "api"),
+		productionEnvironment(),
+		binding,
+		managedSecretReference("OLD_TOKEN"),
+	)
+
+	data := map[string]string{"NEW_TOKEN": "t0k3n"}
+	secrets.On("UpdateSecret", mock.Anything, testNamespace, name, mock.MatchedBy(func(p *secretsvc.UpdateSecretParams) bool {
+		return p.Data["NEW_TOKEN"] == "t0k3n" && p.Labels[labels.LabelKeyEnvironmentName] == "production"
+	})).Return(&corev1.Secret{}, nil)
+
+	result, err := svc.SetEnvironmentSecrets(context.Background(), testNamespace, "api", "production",
+		&models.SetEnvironmentSecretsRequest{Data: data})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"NEW_TOKEN"}, result.Keys)
+
+	// Removed keys are unwired; variables from other SecretReferences are kept.
+	assert.Equal(t, []openchoreov1alpha1.EnvVar{
+		secretEnv("shared-db", "DB_PASSWORD"),
+		secretEnv(name, "NEW_TOKEN"),
+	}, bindingEnv(t, k8sClient))
+}
+
+func TestSetEnvironmentSecrets_Errors(t *testing.T) {
+	valid := map[string]string{"DB_PASSWORD": "s3cr3t"}
+	tests := []struct {
+		name    string
+		objs    []client.Object
+		data    map[string]string
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name:    "empty data",
+			wantMsg: "data must contain at least one entry",
+		},
+		{
+			name:    "key is not an environment variable name",
+			data:    map[string]string{"db-password": "s3cr3t"},
+			wantMsg: `data key "db-password" is not a valid environment variable name`,
+		},
+		{
+			name:    "empty value",
+			data:    map[string]string{"DB_PASSWORD": ""},
+			wantMsg: `data["DB_PASSWORD"] must not be empty`,
+		},
+		{
+			name:    "component not found",
+			data:    valid,
+			wantErr: ErrComponentNotFound,
+		},
+		{
+			name:    "environment not found",
+			objs:    []client.Object{testutil.NewComponent(testNamespace, "shop", "api")},
+			data:    valid,
+			wantErr: ErrEnvironmentNotFound,
+		},
+		{
+			name: "component not bound to the environment",
+			objs: []client.Object{
+				testutil.NewComponent(testNamespace, "shop", "api"),
+				productionEnvironment(),
+				testutil.NewReleaseBinding(testNamespace, "shop", "api", "staging", "api-staging"),
+			},
+			data:    valid,
+			wantErr: ErrReleaseBindingNotFound,
+		},
+		{
+			name: "secret reference not managed as environment secrets",
+			objs: []client.Object{
+				testutil.NewComponent(testNamespace, "shop", "api"),
+				productionEnvironment(),
+				testutil.NewReleaseBinding(testNamespace, "shop", "api", "production", "api-production"),
+				testutil.NewSecretReference(testNamespace, secretName("api", "production")),
+			},
+			data:    valid,
+			wantErr: ErrSecretReferenceConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newTestService(t, tt.objs...)
+			_, err := svc.SetEnvironmentSecrets(context.Background(), testNamespace, "api", "production",
+				&models.SetEnvironmentSecretsRequest{Data: tt.data})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			var validationErr *services.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.wantMsg, validationErr.Msg)
+		})
+	}
+}
+
+func TestGetEnvironmentSecrets(t *testing.T) {
+	svc, _, _ := newTestService(t,
+		testutil.NewComponent(testNamespace, "shop", "api"),
+		testutil.NewReleaseBinding(testNamespace, "shop", "api", "production", "api-production"),
+		managedSecretReference("TOKEN", "DB_PASSWORD"),
+	)
+
+	result, err := svc.GetEnvironmentSecrets(context.Background(), testNamespace, "api", "production")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DB_PASSWORD", "TOKEN"}, result.Keys)
+	assert.Equal(t, "api-production", result.ReleaseBinding)
+
+	_, err = svc.GetEnvironmentSecrets(context.Background(), testNamespace, "api", "staging")
+	require.ErrorIs(t, err, ErrEnvironmentSecretsNotFound)
+}
+
+func TestDeleteEnvironmentSecrets(t *testing.T) {
+	name := secretName("api", "production")
+	binding := testutil.NewReleaseBinding(testNamespace, "shop", "api", "production", "api-production")
+	binding.Spec.WorkloadOverrides = &openchoreov1alpha1.WorkloadOverrideTemplateSpec{
+		Container: &openchoreov1alpha1.ContainerOverride{
+			Env: []openchoreov1alpha1.EnvVar{
+				{Key: "LOG_LEVEL", Value: "debug"},
+				secretEnv(name, "TOKEN"),
+			},
+		},
+	}
+	svc, secrets, k8sClient := newTestService(t,
+		testutil.NewComponent(testNamespace, "shop", "api"),
+		binding,
+		managedSecretReference("TOKEN"),
+	)
+	secrets.On("DeleteSecret", mock.Anything, testNamespace, name).Return(nil)
+
+	require.NoError(t, svc.DeleteEnvironmentSecrets(context.Background(), testNamespace, "api", "production"))
+	assert.Equal(t, []openchoreov1alpha1.EnvVar{{Key: "LOG_LEVEL", Value: "debug"}}, bindingEnv(t, k8sClient))
+
+	err := svc.DeleteEnvironmentSecrets(context.Background(), testNamespace, "api", "staging")
+	require.ErrorIs(t, err, ErrEnvironmentSecretsNotFound)
+}
@@ -17,3 +17,7 @@ type ValidationError struct {
 }
 
 func (e *ValidationError) Error() string { return e.Msg }
+
+// ErrUIDMismatch is returned when an update names a metadata.uid that differs from the
+// existing resource, for example because the resource was deleted and recreated.
+var ErrUIDMismatch = errors.New("metadata.uid does not match the existing resource")
@@ -26,73 +26,99 @@ import (
 	componenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componenttype"
 	dataplanesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dataplane"
 	deploymentpipelinesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deploymentpipeline"
+	directorysyncsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/directorysync"
+	domainmappingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/domainmapping"
 	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	environmentsecretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environmentsecret"
 	gitsecretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/gitsecret"
 	k8sresourcessvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/k8sresources"
+	lookupsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/lookup"
 	namespacesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/namespace"
 	observabilityalertsnotificationchannelsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/observabilityalertsnotificationchannel"
 	observabilityplanesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/observabilityplane"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
 	projectreleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectrelease"
 	projectreleasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectreleasebinding"
+	projectstatussvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectstatus"
 	projecttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projecttype"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+	quarantinesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quarantine"
 	releasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding"
 	resourcesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource"
 	resourcereleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcerelease"
 	resourcereleasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcereleasebinding"
 	resourcetypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcetype"
+	searchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/search"
 	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
 	secretreferencesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secretreference"
+	sessiontokensvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sessiontoken"
 	traitsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/trait"
 	workflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow"
+	workflowcredentialsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowcredential"
 	workflowplanesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowplane"
 	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
 	workloadsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
 )
 
 // Services aggregates all K8s-native API service interfaces.
 type Services struct {
-	AutoBuildService                              autobuildsvc.Service
-	AuthzService                                  authzsvc.Service
-	ProjectService                                projectsvc.Service
-	ProjectTypeService                            projecttypesvc.Service
-	ProjectReleaseService                         projectreleasesvc.Service
-	ProjectReleaseBindingService                  projectreleasebindingsvc.Service
-	WorkflowPlaneService                          workflowplanesvc.Service
-	ClusterWorkflowPlaneService                   clusterworkflowplanesvc.Service
-	ClusterComponentTypeService                   clustercomponenttypesvc.Service
-	ClusterDataPlaneService                       clusterdataplanesvc.Service
-	ClusterObservabilityPlaneService              clusterobservabilityplanesvc.Service
-	ClusterProjectTypeService                     clusterprojecttypesvc.Service
-	ClusterResourceTypeService                    clusterresourcetypesvc.Service
-	ClusterTraitService                           clustertraitsvc.Service
-	ClusterWorkflowService                        clusterworkflowsvc.Service
-	DataPlaneService                              dataplanesvc.Service
-	DeploymentPipelineService                     deploymentpipelinesvc.Service
+	AutoBuildService                 autobuildsvc.Service
+	AuthzService                     authzsvc.Service
+	ProjectService                   projectsvc.Service
+	ProjectTypeService               projecttypesvc.Service
+	ProjectReleaseService            projectreleasesvc.Service
+	ProjectReleaseBindingService     projectreleasebindingsvc.Service
+	ProjectStatusService             projectstatussvc.Service
+	PromotionService                 promotionsvc.Service
+	QuarantineService                quarantinesvc.Service
+	WorkflowPlaneService             workflowplanesvc.Service
+	ClusterWorkflowPlaneService      clusterworkflowplanesvc.Service
+	ClusterComponentTypeService      clustercomponenttypesvc.Service
+	ClusterDataPlaneService          clusterdataplanesvc.Service
+	ClusterObservabilityPlaneService clusterobservabilityplanesvc.Service
+	ClusterProjectTypeService        clusterprojecttypesvc.Service
+	ClusterResourceTypeService       clusterresourcetypesvc.Service
+	ClusterTraitService              clustertraitsvc.Service
+	ClusterWorkflowService           clusterworkflowsvc.Service
+	DataPlaneService                 dataplanesvc.Service
+	DeploymentPipelineService        deploymentpipelinesvc.Service
+	// DirectorySyncService is nil unless the directory sync is enabled in the config.
+	DirectorySyncService                          directorysyncsvc.Service
 	NamespaceService                              namespacesvc.Service
 	ComponentService                              componentsvc.Service
 	ComponentReleaseService                       componentreleasesvc.Service
 	ComponentTypeService                          componenttypesvc.Service
+	DomainMappingService                          domainmappingsvc.Service
 	EnvironmentService                            environmentsvc.Service
+	EnvironmentSecretService                      environmentsecretsvc.Service
 	GitSecretService                              gitsecretsvc.Service
 	ObservabilityAlertsNotificationChannelService observabilityalertsnotificationchannelsvc.Service
 	ObservabilityPlaneService                     observabilityplanesvc.Service
 	K8sResourcesService                           k8sresourcessvc.Service
+	LookupService                                 lookupsvc.Service
 	ReleaseBindingService                         releasebindingsvc.Service
 	ResourceService                               resourcesvc.Service
 	ResourceReleaseService                        resourcereleasesvc.Service
 	ResourceReleaseBindingService                 resourcereleasebindingsvc.Service
 	ResourceTypeService                           resourcetypesvc.Service
+	SearchService                                 searchsvc.Service
 	SecretService                                 secretsvc.Service
 	SecretReferenceService                        secretreferencesvc.Service
-	TraitService                                  traitsvc.Service
-	WorkflowService                               workflowsvc.Service
-	WorkflowRunService                            workflowrunsvc.Service
-	WorkloadService                               workloadsvc.Service
+	// SessionTokenService is nil unless session tokens are enabled in the config.
+	SessionTokenService sessiontokensvc.Service
+	TraitService        traitsvc.Service
+	WorkflowService     workflowsvc.Service
+	// WorkflowCredentialService is nil unless the workflow credential broker is enabled in the config.
+	WorkflowCredentialService workflowcredentialsvc.Service
+	WorkflowRunService        workflowrunsvc.Service
+	WorkloadService           workloadsvc.Service
 }
 
 // NewServices creates all K8s-native API services with authorization wrappers.
-func NewServices(k8sClient client.Client, pap authzcore.PAP, pdp authzcore.PDP, planeClientProvider kubernetesClient.PlaneClientProvider, logger *slog.Logger, gwClient *gatewayClient.Client, webhookProcessor autobuildsvc.WebhookProcessor) *Services {
+// statusReader may be nil, in which case project status, search results and release binding
+// replica counts are computed per request.
+func NewServices(k8sClient client.Client, statusReader statusmodel.Reader, pap authzcore.PAP, pdp authzcore.PDP, planeClientProvider kubernetesClient.PlaneClientProvider, logger *slog.Logger, gwClient *gatewayClient.Client, webhookProcessor autobuildsvc.WebhookProcessor) *Services {
 	return &Services{
 		AutoBuildService:                              autobuildsvc.NewService(k8sClient, webhookProcessor, logger.With("component", "autobuild-service")),
 		AuthzService:                                  authzsvc.NewServiceWithAuthz(pap, pdp, logger.With("component", "authz-service")),
@@ -100,6 +126,9 @@ func NewServices(k8sClient client.Client, pap authzcore.PAP, pdp authzcore.PDP,
 		ProjectTypeService:                            projecttypesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "projecttype-service")),
 		ProjectReleaseService:                         projectreleasesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "projectrelease-service")),
 		ProjectReleaseBindingService:                  projectreleasebindingsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "projectreleasebinding-service")),
+		ProjectStatusService:                          projectstatussvc.NewServiceWithAuthz(k8sClient, statusReader, pdp, logger.With("component", "projectstatus-service")),
+		PromotionService:                              promotionsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "promotion-service")),
+		QuarantineService:                             quarantinesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "quarantine-service")),
 		WorkflowPlaneService:                          workflowplanesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "workflowplane-service")),
 		ClusterWorkflowPlaneService:                   clusterworkflowplanesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "clusterworkflowplane-service")),
 		ClusterComponentTypeService:                   clustercomponenttypesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "clustercomponenttype-service")),
@@ -115,16 +144,20 @@ func NewServices(k8sClient client.Client, pap authzcore.PAP, pdp authzcore.PDP,
 		ComponentService:                              componentsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "component-service")),
 		ComponentReleaseService:                       componentreleasesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "componentrelease-service")),
 		ComponentTypeService:                          componenttypesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "componenttype-service")),
+		DomainMappingService:                          domainmappingsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "domainmapping-service")),
 		EnvironmentService:                            environmentsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "environment-service")),
+		EnvironmentSecretService:                      environmentsecretsvc.NewServiceWithAuthz(k8sClient, planeClientProvider, pdp, logger.With("component", "environmentsecret-service")),
 		GitSecretService:                              gitsecretsvc.NewServiceWithAuthz(k8sClient, planeClientProvider, pdp, logger.With("component", "gitsecret-service")),
 		ObservabilityAlertsNotificationChannelService: observabilityalertsnotificationchannelsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "observabilityalertsnotificationchannel-service")),
 		ObservabilityPlaneService:                     observabilityplanesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "observabilityplane-service")),
 		K8sResourcesService:                           k8sresourcessvc.NewServiceWithAuthz(k8sClient, gwClient, pdp, logger.With("component", "k8sresources-service")),
-		ReleaseBindingService:                         releasebindingsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "releasebinding-service")),
+		LookupService:                                 lookupsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "lookup-service")),
+		ReleaseBindingService:                         releasebindingsvc.NewServiceWithAuthz(k8sClient, statusReader, pdp, logger.With("component", "releasebinding-service")),
 		ResourceService:                               resourcesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "resource-service")),
 		ResourceReleaseService:                        resourcereleasesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "resourcerelease-service")),
 		ResourceReleaseBindingService:                 resourcereleasebindingsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "resourcereleasebinding-service")),
 		ResourceTypeService:                           resourcetypesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "resourcetype-service")),
+		SearchService:                                 searchsvc.NewServiceWithAuthz(k8sClient, statusReader, pdp, logger.With("component", "search-service")),
 		SecretService:                                 secretsvc.NewServiceWithAuthz(k8sClient, planeClientProvider, pdp, logger.With("component", "secret-service")),
 		SecretReferenceService:                        secretreferencesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "secretreference-service")),
 		TraitService:                                  traitsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "trait-service")),
@@ -0,0 +1,489 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sresources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/clients/gateway"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+const (
+	// debugCredentialLabel marks the ServiceAccounts, Roles and RoleBindings created for debug credentials.
+	debugCredentialLabel = "openchoreo.dev/debug-credential"
+	// debugCredentialExpiresAtAnnotation records when the credential's token expires (RFC 3339).
+	debugCredentialExpiresAtAnnotation = "openchoreo.dev/expires-at"
+	// debugCredentialRequestedByAnnotation records the subject that minted the credential.
+	debugCredentialRequestedByAnnotation = "openchoreo.dev/requested-by"
+	// debugCredentialAccessAnnotation records the access level of the credential.
+	debugCredentialAccessAnnotation = "openchoreo.dev/debug-access"
+
+	maxDebugNameLength           = 63
+	debugNameRandomSuffixLength  = 5
+	kubeRootCAConfigMapName      = "kube-root-ca.crt"
+	kubeRootCAConfigMapKey       = "ca.crt"
+	rbacAPIVersion               = "rbac.authorization.k8s.io/v1"
+	rbacAPIGroup                 = "rbac.authorization.k8s.io"
+	debugCredentialKubeconfigCtx = "openchoreo-debug"
+
+	// DebugCredentialSweepInterval is how often expired debug credentials are deleted from the
+	// data planes.
+	DebugCredentialSweepInterval = time.Minute
+)
+
+// MintDebugCredential creates a ServiceAccount in the data plane namespace backing the release
+// binding, binds it to a Role limited to the binding's resources and current pods, and returns a
+// token for it that expires after the requested TTL. The Role and RoleBinding are owned by the
+// ServiceAccount, which RunDebugCredentialSweeper deletes once the token has expired.
+func (s *k8sResourcesService) MintDebugCredential(ctx context.Context, namespaceName, releaseBindingName string, req *models.DebugCredentialRequest) (*models.DebugCredentialResponse, error) {
+	s.logger.Debug("Minting debug credential", "namespace", namespaceName, "releaseBinding", releaseBindingName)
+
+	if s.gatewayClient == nil {
+		return nil, fmt.Errorf("gateway client is not configured")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, &services.ValidationError{Msg: err.Error()}
+	}
+
+	releaseContexts, err := s.resolveReleaseContexts(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, err
+	}
+	rc := findDataPlaneRelease(releaseContexts)
+	if rc == nil {
+		return nil, ErrRenderedReleaseNotFound
+	}
+
+	now := time.Now().UTC()
+	podNames, err := s.resolveDebugPodNames(ctx, rc, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	if len(podNames) == 0 && req.Access == models.DebugAccessLogsExec {
+		return nil, &services.ValidationError{Msg: "release binding has no pods to scope logs-exec access to"}
+	}
+
+	rules := buildDebugRoleRules(rc.release.Status.Resources, podNames, rc.namespace, req.Access, s.resolveResourcePlural)
+	expiresAt := now.Add(req.TTL)
+	requestedBy := ""
+	if subject, ok := auth.GetSubjectContextFromContext(ctx); ok && subject != nil {
+		requestedBy = subject.ID
+	}
+
+	name := makeDebugName(releaseBindingName)
+	metadata := debugObjectMetadata(name, rc.namespace, rc.release, req.Access, requestedBy, expiresAt)
+	sa, err := s.createK8sObject(ctx, rc.plane, buildK8sListPath("", "v1", "serviceaccounts", rc.namespace), map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ServiceAccount",
+		"metadata":   metadata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create service account: %w", err)
+	}
+
+	resp, err := s.issueDebugToken(ctx, rc, name, getNestedString(sa, "metadata", "uid"), metadata, rules, req)
+	if err != nil {
+		// Deleting the ServiceAccount garbage-collects the Role and RoleBinding it owns.
+		s.deleteK8sObject(ctx, rc.plane, buildK8sGetPath("", "v1", "serviceaccounts", rc.namespace, name))
+		return nil, err
+	}
+
+	s.logger.Info("Minted debug credential",
+		"namespace", namespaceName,
+		"releaseBinding", releaseBindingName,
+		"dataPlaneNamespace", rc.namespace,
+		"serviceAccount", name,
+		"access", req.Access,
+		"requestedBy", requestedBy,
+		"expiresAt", resp.ExpiresAt,
+	)
+	return resp, nil
+}
+
+// issueDebugToken creates the Role and RoleBinding for the debug ServiceAccount and requests
+// a token for it.
+func (s *k8sResourcesService) issueDebugToken(
+	ctx context.Context,
+	rc *releaseContext,
+	name, saUID string,
+	metadata map[string]any,
+	rules []any,
+	req *models.DebugCredentialRequest,
+) (*models.DebugCredentialResponse, error) {
+	ownedMetadata := func() map[string]any {
+		m := make(map[string]any, len(metadata)+1)
+		for k, v := range metadata {
+			m[k] = v
+		}
+		m["ownerReferences"] = []any{map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"name":       name,
+			"uid":        saUID,
+		}}
+		return m
+	}
+
+	if _, err := s.createK8sObject(ctx, rc.plane, buildK8sListPath(rbacAPIGroup, "v1", "roles", rc.namespace), map[string]any{
+		"apiVersion": rbacAPIVersion,
+		"kind":       "Role",
+		"metadata":   ownedMetadata(),
+		"rules":      rules,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+	if _, err := s.createK8sObject(ctx, rc.plane, buildK8sListPath(rbacAPIGroup, "v1", "rolebindings", rc.namespace), map[string]any{
+		"apiVersion": rbacAPIVersion,
+		"kind":       "RoleBinding",
+		"metadata":   ownedMetadata(),
+		"roleRef":    map[string]any{"apiGroup": rbacAPIGroup, "kind": "Role", "name": name},
+		"subjects": []any{map[string]any{
+			"kind":      "ServiceAccount",
+			"name":      name,
+			"namespace": rc.namespace,
+		}},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to create role binding: %w", err)
+	}
+
+	tokenPath := buildK8sGetPath("", "v1", "serviceaccounts", rc.namespace, name) + "/token"
+	tokenRequest, err := s.createK8sObject(ctx, rc.plane, tokenPath, map[string]any{
+		"apiVersion": "authentication.k8s.io/v1",
+		"kind":       "TokenRequest",
+		"spec":       map[string]any{"expirationSeconds": int64(req.TTL.Seconds())},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request token: %w", err)
+	}
+	token := getNestedString(tokenRequest, "status", "token")
+	if token == "" {
+		return nil, fmt.Errorf("token request returned no token")
+	}
+	expiresAt, err := time.Parse(time.RFC3339, getNestedString(tokenRequest, "status", "expirationTimestamp"))
+	if err != nil {
+		return nil, fmt.Errorf("token request returned an invalid expiration: %w", err)
+	}
+
+	resp := &models.DebugCredentialResponse{
+		Namespace:      rc.namespace,
+		ServiceAccount: name,
+		Access:         req.Access,
+		Token:          token,
+		ExpiresAt:      expiresAt,
+	}
+	if req.APIServerURL != "" {
+		caData := s.fetchClusterCA(ctx, rc.plane, rc.namespace)
+		kubeconfig, err := buildDebugKubeconfig(req.APIServerURL, caData, rc.namespace, name, token)
+		if err != nil {
+			return nil, err
+		}
+		resp.Kubeconfig = kubeconfig
+	}
+	return resp, nil
+}
+
+// resolveDebugPodNames returns the names of the pods currently running for the release, found
+// by the component and environment labels the pipeline puts on every pod it renders. The data
+// plane namespace is shared by the project's components, so pod access is limited to these names.
+func (s *k8sResourcesService) resolveDebugPodNames(ctx context.Context, rc *releaseContext, namespaceName string) ([]string, error) {
+	selector := k8slabels.SelectorFromSet(k8slabels.Set{
+		labels.LabelKeyNamespaceName:   namespaceName,
+		labels.LabelKeyProjectName:     rc.release.Spec.Owner.ProjectName,
+		labels.LabelKeyComponentName:   rc.release.Spec.Owner.ComponentName,
+		labels.LabelKeyEnvironmentName: rc.release.Spec.EnvironmentName,
+	})
+	items, err := s.fetchK8sList(ctx, rc.plane, buildK8sListPath("", "v1", "pods", rc.namespace),
+		"labelSelector="+url.QueryEscape(selector.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		if name := getNestedString(item, "metadata", "name"); name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// RunDebugCredentialSweeper deletes the debug credentials of every data plane whose tokens have
+// expired, then repeats every interval until ctx is done.
+func RunDebugCredentialSweeper(ctx context.Context, k8sClient client.Client, gatewayClient *gateway.Client, interval time.Duration, logger *slog.Logger) {
+	s := &k8sResourcesService{k8sClient: k8sClient, gatewayClient: gatewayClient, logger: logger}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.sweepExpiredDebugCredentials(ctx, time.Now().UTC()); err != nil {
+			logger.Error("Debug credential sweep failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweepExpiredDebugCredentials deletes the expired debug ServiceAccounts of every DataPlane and
+// ClusterDataPlane. Deleting a ServiceAccount garbage-collects the Role and RoleBinding it owns.
+func (s *k8sResourcesService) sweepExpiredDebugCredentials(ctx context.Context, now time.Time) error {
+	var dataPlanes openchoreov1alpha1.DataPlaneList
+	if err := s.k8sClient.List(ctx, &dataPlanes); err != nil {
+		return fmt.Errorf("failed to list data planes: %w", err)
+	}
+	var clusterDataPlanes openchoreov1alpha1.ClusterDataPlaneList
+	if err := s.k8sClient.List(ctx, &clusterDataPlanes); err != nil {
+		return fmt.Errorf("failed to list cluster data planes: %w", err)
+	}
+
+	for i := range dataPlanes.Items {
+		s.deleteExpiredDebugServiceAccounts(ctx, resolveDataPlaneInfo(&controller.DataPlaneResult{DataPlane: &dataPlanes.Items[i]}), now)
+	}
+	for i := range clusterDataPlanes.Items {
+		s.deleteExpiredDebugServiceAccounts(ctx, resolveDataPlaneInfo(&controller.DataPlaneResult{ClusterDataPlane: &clusterDataPlanes.Items[i]}), now)
+	}
+	return nil
+}
+
+// deleteExpiredDebugServiceAccounts removes the debug ServiceAccounts of a data plane whose
+// tokens have expired. Failures are logged and the remaining accounts are still processed.
+func (s *k8sResourcesService) deleteExpiredDebugServiceAccounts(ctx context.Context, pi planeInfo, now time.Time) {
+	items, err := s.fetchK8sList(ctx, pi, buildK8sListPath("", "v1", "serviceaccounts", ""),
+		"labelSelector="+debugCredentialLabel+"%3Dtrue")
+	if err != nil {
+		s.logger.Warn("Failed to list debug service accounts", "plane", pi.crName, "error", err)
+		return
+	}
+	for _, item := range items {
+		expiresAt, err := time.Parse(time.RFC3339, getNestedString(item, "metadata", "annotations", debugCredentialExpiresAtAnnotation))
+		if err != nil || expiresAt.After(now) {
+			continue
+		}
+		namespace := getNestedString(item, "metadata", "namespace")
+		name := getNestedString(item, "metadata", "name")
+		s.deleteK8sObject(ctx, pi, buildK8sGetPath("", "v1", "serviceaccounts", namespace, name))
+	}
+}
+
+// fetchClusterCA returns the data plane API server CA bundle published in every namespace,
+// or nil when it cannot be read.
+func (s *k8sResourcesService) fetchClusterCA(ctx context.Context, pi planeInfo, namespace string) []byte {
+	cm, err := s.fetchLiveResource(ctx, pi, buildK8sGetPath("", "v1", "configmaps", namespace, kubeRootCAConfigMapName))
+	if err != nil {
+		s.logger.Warn("Failed to read the data plane CA bundle", "namespace", namespace, "error", err)
+		return nil
+	}
+	if ca := getNestedString(cm, "data", kubeRootCAConfigMapKey); ca != "" {
+		return []byte(ca)
+	}
+	return nil
+}
+
+// createK8sObject POSTs obj to the data plane and returns the created object.
+func (s *k8sResourcesService) createK8sObject(ctx context.Context, pi planeInfo, k8sPath string, obj map[string]any) (map[string]any, error) {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal object: %w", err)
+	}
+
+	resp, err := s.gatewayClient.PostK8sRequest(ctx, pi.planeType, pi.planeID, pi.crNamespace, pi.crName, k8sPath, body)
+	if err != nil {
+		return nil, fmt.Errorf("proxy request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var created map[string]any
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return created, nil
+}
+
+// deleteK8sObject deletes an object from the data plane. Failures are logged only.
+func (s *k8sResourcesService) deleteK8sObject(ctx context.Context, pi planeInfo, k8sPath string) {
+	resp, err := s.gatewayClient.DeleteK8sRequest(ctx, pi.planeType, pi.planeID, pi.crNamespace, pi.crName, k8sPath)
+	if err != nil {
+		s.logger.Warn("Failed to delete data plane object", "path", k8sPath, "error", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNotFound {
+		s.logger.Warn("Failed to delete data plane object", "path", k8sPath, "status", resp.StatusCode)
+	}
+}
+
+// findDataPlaneRelease returns the first release context that targets the data plane.
+func findDataPlaneRelease(contexts []releaseContext) *releaseContext {
+	for i := range contexts {
+		if contexts[i].plane.planeType == planeTypeDataPlane && contexts[i].namespace != "" {
+			return &contexts[i]
+		}
+	}
+	return nil
+}
+
+// buildDebugRoleRules returns the Role rules of a debug credential. Both access levels can get
+// the release's resources (Secrets excluded) and its pods by name, and list events in the
+// namespace. Rules naming resources grant get only, since RBAC does not scope list and watch
+// by resource name. logs-exec adds logs and exec of those pods.
+func buildDebugRoleRules(
+	resources []openchoreov1alpha1.RenderedManifestStatus,
+	podNames []string,
+	namespace, access string,
+	resolvePlural func(group, version, kind string) (string, error),
+) []any {
+	type groupResource struct{ group, resource string }
+	names := map[groupResource][]string{}
+	for _, rs := range resources {
+		if rs.Namespace != namespace || (rs.Group == "" && rs.Kind == "Secret") {
+			continue
+		}
+		plural, err := resolvePlural(rs.Group, rs.Version, rs.Kind)
+		if err != nil {
+			continue
+		}
+		gr := groupResource{rs.Group, plural}
+		names[gr] = append(names[gr], rs.Name)
+	}
+	keys := make([]groupResource, 0, len(names))
+	for gr := range names {
+		keys = append(keys, gr)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].group != keys[j].group {
+			return keys[i].group < keys[j].group
+		}
+		return keys[i].resource < keys[j].resource
+	})
+
+	rules := make([]any, 0, len(keys)+4)
+	for _, gr := range keys {
+		resourceNames := names[gr]
+		sort.Strings(resourceNames)
+		rules = append(rules, policyRule([]string{gr.group}, []string{gr.resource}, resourceNames, "get"))
+	}
+	if len(podNames) > 0 {
+		rules = append(rules, policyRule([]string{""}, []string{"pods"}, podNames, "get"))
+		if access == models.DebugAccessLogsExec {
+			rules = append(rules,
+				policyRule([]string{""}, []string{"pods/log"}, podNames, "get"),
+				policyRule([]string{""}, []string{"pods/exec"}, podNames, "get", "create"),
+			)
+		}
+	}
+	rules = append(rules, policyRule([]string{""}, []string{"events"}, nil, "get", "list", "watch"))
+	return rules
+}
+
+func policyRule(apiGroups, resources, resourceNames []string, verbs ...string) map[string]any {
+	rule := map[string]any{
+		"apiGroups": toAnySlice(apiGroups),
+		"resources": toAnySlice(resources),
+		"verbs":     toAnySlice(verbs),
+	}
+	if len(resourceNames) > 0 {
+		rule["resourceNames"] = toAnySlice(resourceNames)
+	}
+	return rule
+}
+
+func toAnySlice(values []string) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
+
+// debugObjectMetadata returns the metadata shared by the ServiceAccount, Role and RoleBinding
+// of a debug credential.
+func debugObjectMetadata(
+	name, namespace string,
+	release *openchoreov1alpha1.RenderedRelease,
+	access, requestedBy string,
+	expiresAt time.Time,
+) map[string]any {
+	annotations := map[string]any{
+		debugCredentialExpiresAtAnnotation: expiresAt.Format(time.RFC3339),
+		debugCredentialAccessAnnotation:    access,
+	}
+	if requestedBy != "" {
+		annotations[debugCredentialRequestedByAnnotation] = requestedBy
+	}
+	return map[string]any{
+		"name":      name,
+		"namespace": namespace,
+		"labels": map[string]any{
+			debugCredentialLabel:           "true",
+			labels.LabelKeyProjectName:     release.Spec.Owner.ProjectName,
+			labels.LabelKeyComponentName:   release.Spec.Owner.ComponentName,
+			labels.LabelKeyEnvironmentName: release.Spec.EnvironmentName,
+			labels.LabelKeyManagedBy:       "openchoreo-api",
+		},
+		"annotations": annotations,
+	}
+}
+
+// makeDebugName builds a unique name for the debug ServiceAccount, Role and RoleBinding from
+// the release binding name, truncated to stay within 63 characters.
+func makeDebugName(releaseBindingName string) string {
+	suffix := "-debug-" + rand.String(debugNameRandomSuffixLength)
+	maxPrefix := maxDebugNameLength - len(suffix)
+	if len(releaseBindingName) > maxPrefix {
+		releaseBindingName = releaseBindingName[:maxPrefix]
+	}
+	return releaseBindingName + suffix
+}
+
+// buildDebugKubeconfig renders a kubeconfig that authenticates to the data plane with the
+// debug token and defaults to its namespace.
+func buildDebugKubeconfig(server string, caData []byte, namespace, user, token string) (string, error) {
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[debugCredentialKubeconfigCtx] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: caData,
+	}
+	cfg.AuthInfos[user] = &clientcmdapi.AuthInfo{Token: token}
+	cfg.Contexts[debugCredentialKubeconfigCtx] = &clientcmdapi.Context{
+		Cluster:   debugCredentialKubeconfigCtx,
+		AuthInfo:  user,
+		Namespace: namespace,
+	}
+	cfg.CurrentContext = debugCredentialKubeconfigCtx
+
+	out, err := clientcmd.Write(*cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to render kubeconfig: %w", err)
+	}
+	return string(out), nil
+}
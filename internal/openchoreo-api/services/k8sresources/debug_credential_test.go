@@ -0,0 +1,285 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sresources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+func testPlural(_, _, kind string) (string, error) {
+	switch kind {
+	case "Deployment":
+		return "deployments", nil
+	case "Service":
+		return "services", nil
+	case "Secret":
+		return "secrets", nil
+	}
+	return "", fmt.Errorf("unknown kind %s", kind)
+}
+
+func TestBuildDebugRoleRules(t *testing.T) {
+	resources := []openchoreov1alpha1.RenderedManifestStatus{
+		{Group: "apps", Version: "v1", Kind: "Deployment", Name: "web", Namespace: "dp-ns"},
+		{Group: "", Version: "v1", Kind: "Service", Name: "web", Namespace: "dp-ns"},
+		{Group: "", Version: "v1", Kind: "Service", Name: "api", Namespace: "dp-ns"},
+		{Group: "", Version: "v1", Kind: "Secret", Name: "creds", Namespace: "dp-ns"},
+		{Group: "example.com", Version: "v1", Kind: "Widget", Name: "w", Namespace: "dp-ns"},
+		{Group: "apps", Version: "v1", Kind: "Deployment", Name: "other", Namespace: "other-ns"},
+	}
+
+	pods := []string{"web-7d4f-abcde", "web-7d4f-fghij"}
+
+	named := func(group, resource string, names ...string) map[string]any {
+		rule := map[string]any{
+			"apiGroups": []any{group},
+			"resources": []any{resource},
+			"verbs":     []any{"get"},
+		}
+		resourceNames := make([]any, len(names))
+		for i, name := range names {
+			resourceNames[i] = name
+		}
+		rule["resourceNames"] = resourceNames
+		return rule
+	}
+	events := map[string]any{
+		"apiGroups": []any{""},
+		"resources": []any{"events"},
+		"verbs":     []any{"get", "list", "watch"},
+	}
+
+	t.Run("read-only", func(t *testing.T) {
+		rules := buildDebugRoleRules(resources, pods, "dp-ns", models.DebugAccessReadOnly, testPlural)
+		assert.Equal(t, []any{
+			named("", "services", "api", "web"),
+			named("apps", "deployments", "web"),
+			named("", "pods", pods...),
+			events,
+		}, rules)
+	})
+
+	t.Run("logs-exec adds logs and exec of the binding's pods only", func(t *testing.T) {
+		rules := buildDebugRoleRules(resources, pods, "dp-ns", models.DebugAccessLogsExec, testPlural)
+		exec := named("", "pods/exec", pods...)
+		exec["verbs"] = []any{"get", "create"}
+		assert.Equal(t, []any{
+			named("", "services", "api", "web"),
+			named("apps", "deployments", "web"),
+			named("", "pods", pods...),
+			named("", "pods/log", pods...),
+			exec,
+			events,
+		}, rules)
+	})
+
+	t.Run("no pods grants no pod access", func(t *testing.T) {
+		rules := buildDebugRoleRules(resources, nil, "dp-ns", models.DebugAccessLogsExec, testPlural)
+		assert.Equal(t, []any{
+			named("", "services", "api", "web"),
+			named("apps", "deployments", "web"),
+			events,
+		}, rules)
+	})
+}
+
+func TestMakeDebugName(t *testing.T) {
+	name := makeDebugName(strings.Repeat("a", 80))
+	assert.LessOrEqual(t, len(name), maxDebugNameLength)
+	assert.Contains(t, name, "-debug-")
+	assert.NotEqual(t, makeDebugName("rb-1"), makeDebugName("rb-1"))
+}
+
+func TestBuildDebugKubeconfig(t *testing.T) {
+	out, err := buildDebugKubeconfig("https://dp.example.com:6443", []byte("ca-data"), "dp-ns", "rb-1-debug-abcde", "tok")
+	require.NoError(t, err)
+
+	cfg, err := clientcmd.Load([]byte(out))
+	require.NoError(t, err)
+	kctx := cfg.Contexts[cfg.CurrentContext]
+	require.NotNil(t, kctx)
+	assert.Equal(t, "dp-ns", kctx.Namespace)
+	assert.Equal(t, "https://dp.example.com:6443", cfg.Clusters[kctx.Cluster].Server)
+	assert.Equal(t, []byte("ca-data"), cfg.Clusters[kctx.Cluster].CertificateAuthorityData)
+	assert.Equal(t, "tok", cfg.AuthInfos[kctx.AuthInfo].Token)
+}
+
+func TestMintDebugCredential(t *testing.T) {
+	objects := func() []client.Object {
+		rb := testReleaseBinding()
+		rr := testRenderedRelease(rb, planeTypeDataPlane, []openchoreov1alpha1.RenderedManifestStatus{
+			{ID: "dep", Group: "apps", Version: "v1", Kind: "Deployment", Name: "web", Namespace: "dp-ns"},
+		})
+		return []client.Object{rb, testEnvironment(), testDataPlane("default"), rr}
+	}
+
+	t.Run("invalid request returns validation error", func(t *testing.T) {
+		gc := testGatewayServer(t, func(w http.ResponseWriter, r *http.Request) {})
+		svc := NewService(newFakeClient(objects()...), gc, testLogger())
+
+		_, err := svc.MintDebugCredential(context.Background(), testNamespace, "rb-1",
+			&models.DebugCredentialRequest{Access: "admin"})
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("creates RBAC scoped to the binding's pods and returns token", func(t *testing.T) {
+		var mu sync.Mutex
+		created := map[string]map[string]any{}
+		var podSelector string
+
+		gc := testGatewayServer(t, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			path := r.URL.Path[strings.Index(r.URL.Path, "/k8s/")+len("/k8s/"):]
+			switch r.Method {
+			case http.MethodGet:
+				if strings.HasSuffix(path, "/pods") {
+					podSelector = r.URL.Query().Get("labelSelector")
+					_, _ = w.Write(jsonMarshal(t, k8sList(map[string]any{"metadata": map[string]any{"name": "web-7d4f-abcde"}})))
+					return
+				}
+				_, _ = w.Write(jsonMarshal(t, map[string]any{"data": map[string]any{kubeRootCAConfigMapKey: "ca-data"}}))
+			case http.MethodPost:
+				body, _ := io.ReadAll(r.Body)
+				var obj map[string]any
+				_ = json.Unmarshal(body, &obj)
+				created[path] = obj
+				if strings.HasSuffix(path, "/token") {
+					obj["status"] = map[string]any{"token": "secret-token", "expirationTimestamp": "2026-01-01T01:00:00Z"}
+				} else {
+					obj["metadata"].(map[string]any)["uid"] = "sa-uid"
+				}
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write(jsonMarshal(t, obj))
+			}
+		})
+		svc := NewService(newFakeClient(objects()...), gc, testLogger())
+
+		resp, err := svc.MintDebugCredential(context.Background(), testNamespace, "rb-1",
+			&models.DebugCredentialRequest{Access: models.DebugAccessLogsExec, APIServerURL: "https://dp.example.com"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "dp-ns", resp.Namespace)
+		assert.Equal(t, "secret-token", resp.Token)
+		assert.Equal(t, models.DebugAccessLogsExec, resp.Access)
+		assert.Equal(t, time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC), resp.ExpiresAt)
+		assert.Contains(t, resp.Kubeconfig, "secret-token")
+		assert.Contains(t, podSelector, labels.LabelKeyComponentName+"=")
+		assert.Contains(t, podSelector, labels.LabelKeyEnvironmentName+"=")
+
+		role := created["apis/rbac.authorization.k8s.io/v1/namespaces/dp-ns/roles"]
+		require.NotNil(t, role)
+		rules := role["rules"].([]any)
+		require.Len(t, rules, 5)
+		for _, rule := range rules[1:4] {
+			assert.Equal(t, []any{"web-7d4f-abcde"}, rule.(map[string]any)["resourceNames"])
+		}
+		owners := role["metadata"].(map[string]any)["ownerReferences"].([]any)
+		assert.Equal(t, "sa-uid", owners[0].(map[string]any)["uid"])
+
+		binding := created["apis/rbac.authorization.k8s.io/v1/namespaces/dp-ns/rolebindings"]
+		require.NotNil(t, binding)
+		assert.Equal(t, resp.ServiceAccount, binding["subjects"].([]any)[0].(map[string]any)["name"])
+
+		token := created["api/v1/namespaces/dp-ns/serviceaccounts/"+resp.ServiceAccount+"/token"]
+		require.NotNil(t, token)
+		assert.EqualValues(t, 3600, token["spec"].(map[string]any)["expirationSeconds"])
+	})
+
+	t.Run("logs-exec without pods is rejected", func(t *testing.T) {
+		var posted bool
+		gc := testGatewayServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				posted = true
+			}
+			_, _ = w.Write(jsonMarshal(t, k8sList()))
+		})
+		svc := NewService(newFakeClient(objects()...), gc, testLogger())
+
+		_, err := svc.MintDebugCredential(context.Background(), testNamespace, "rb-1",
+			&models.DebugCredentialRequest{Access: models.DebugAccessLogsExec})
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.False(t, posted)
+	})
+
+	t.Run("failed token request deletes the service account", func(t *testing.T) {
+		var deleted []string
+		gc := testGatewayServer(t, func(w http.ResponseWriter, r *http.Request) {
+			path := r.URL.Path[strings.Index(r.URL.Path, "/k8s/")+len("/k8s/"):]
+			switch {
+			case r.Method == http.MethodGet:
+				_, _ = w.Write(jsonMarshal(t, k8sList()))
+			case r.Method == http.MethodDelete:
+				deleted = append(deleted, path)
+			case strings.HasSuffix(path, "/token"):
+				w.WriteHeader(http.StatusForbidden)
+			default:
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"metadata":{"uid":"sa-uid"}}`))
+			}
+		})
+		svc := NewService(newFakeClient(objects()...), gc, testLogger())
+
+		_, err := svc.MintDebugCredential(context.Background(), testNamespace, "rb-1", &models.DebugCredentialRequest{})
+		require.Error(t, err)
+		require.Len(t, deleted, 1)
+		assert.Contains(t, deleted[0], "/serviceaccounts/rb-1-debug-")
+	})
+}
+
+func TestSweepExpiredDebugCredentials(t *testing.T) {
+	now := time.Now().UTC()
+	var mu sync.Mutex
+	var listed, deleted []string
+
+	gc := testGatewayServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		path := r.URL.Path[strings.Index(r.URL.Path, "/k8s/")+len("/k8s/"):]
+		switch r.Method {
+		case http.MethodGet:
+			listed = append(listed, path)
+			_, _ = w.Write(jsonMarshal(t, k8sList(
+				map[string]any{"metadata": map[string]any{
+					"name":        "rb-1-debug-old",
+					"namespace":   "dp-ns",
+					"annotations": map[string]any{debugCredentialExpiresAtAnnotation: now.Add(-time.Minute).Format(time.RFC3339)},
+				}},
+				map[string]any{"metadata": map[string]any{
+					"name":        "rb-1-debug-new",
+					"namespace":   "dp-ns",
+					"annotations": map[string]any{debugCredentialExpiresAtAnnotation: now.Add(time.Hour).Format(time.RFC3339)},
+				}},
+			)))
+		case http.MethodDelete:
+			deleted = append(deleted, path)
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	svc := NewService(newFakeClient(testDataPlane("default")), gc, testLogger()).(*k8sResourcesService)
+
+	require.NoError(t, svc.sweepExpiredDebugCredentials(context.Background(), now))
+	assert.Equal(t, []string{"api/v1/serviceaccounts"}, listed)
+	assert.Equal(t, []string{"api/v1/namespaces/dp-ns/serviceaccounts/rb-1-debug-old"}, deleted)
+}
@@ -15,4 +15,5 @@ var (
 	ErrNotCronJobWorkload       = errors.New("release binding component is not a cronjob workload")
 	ErrCronJobNotFound          = errors.New("cronjob not found in rendered release")
 	ErrTriggerConflict          = errors.New("a job with the same name already exists, retry the trigger")
+	ErrWorkloadNotFound         = errors.New("workload not found in rendered release")
 )
@@ -29,4 +29,6 @@ type Service interface {
 	GetResourceEvents(ctx context.Context, namespaceName, releaseBindingName, group, version, kind, name string) (*models.ResourceEventsResponse, error)
 	GetResourceLogs(ctx context.Context, namespaceName, releaseBindingName, podName, container string, sinceSeconds *int64) (*models.ResourcePodLogsResponse, error)
 	TriggerCronJob(ctx context.Context, namespaceName, releaseBindingName string) (*models.CronJobTriggerResponse, error)
+	RunJob(ctx context.Context, namespaceName, releaseBindingName string, req *models.RunJobRequest) (*models.RunJobResponse, error)
+	MintDebugCredential(ctx context.Context, namespaceName, releaseBindingName string, req *models.DebugCredentialRequest) (*models.DebugCredentialResponse, error)
 }
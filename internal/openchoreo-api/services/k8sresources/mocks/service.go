@@ -211,6 +211,128 @@ func (_c *MockService_GetResourceTree_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// MintDebugCredential provides a mock function with given fields: ctx, namespaceName, releaseBindingName, req
+func (_m *MockService) MintDebugCredential(ctx context.Context, namespaceName string, releaseBindingName string, req *models.DebugCredentialRequest) (*models.DebugCredentialResponse, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MintDebugCredential")
+	}
+
+	var r0 *models.DebugCredentialResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *models.DebugCredentialRequest) (*models.DebugCredentialResponse, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *models.DebugCredentialRequest) *models.DebugCredentialResponse); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DebugCredentialResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *models.DebugCredentialRequest) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_MintDebugCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MintDebugCredential'
+type MockService_MintDebugCredential_Call struct {
+	*mock.Call
+}
+
+// MintDebugCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+//   - req *models.DebugCredentialRequest
+func (_e *MockService_Expecter) MintDebugCredential(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}, req interface{}) *MockService_MintDebugCredential_Call {
+	return &MockService_MintDebugCredential_Call{Call: _e.mock.On("MintDebugCredential", ctx, namespaceName, releaseBindingName, req)}
+}
+
+func (_c *MockService_MintDebugCredential_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string, req *models.DebugCredentialRequest)) *MockService_MintDebugCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*models.DebugCredentialRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_MintDebugCredential_Call) Return(_a0 *models.DebugCredentialResponse, _a1 error) *MockService_MintDebugCredential_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_MintDebugCredential_Call) RunAndReturn(run func(context.Context, string, string, *models.DebugCredentialRequest) (*models.DebugCredentialResponse, error)) *MockService_MintDebugCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunJob provides a mock function with given fields: ctx, namespaceName, releaseBindingName, req
+func (_m *MockService) RunJob(ctx context.Context, namespaceName string, releaseBindingName string, req *models.RunJobRequest) (*models.RunJobResponse, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunJob")
+	}
+
+	var r0 *models.RunJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *models.RunJobRequest) (*models.RunJobResponse, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *models.RunJobRequest) *models.RunJobResponse); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RunJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *models.RunJobRequest) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_RunJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunJob'
+type MockService_RunJob_Call struct {
+	*mock.Call
+}
+
+// RunJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+//   - req *models.RunJobRequest
+func (_e *MockService_Expecter) RunJob(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}, req interface{}) *MockService_RunJob_Call {
+	return &MockService_RunJob_Call{Call: _e.mock.On("RunJob", ctx, namespaceName, releaseBindingName, req)}
+}
+
+func (_c *MockService_RunJob_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string, req *models.RunJobRequest)) *MockService_RunJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*models.RunJobRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_RunJob_Call) Return(_a0 *models.RunJobResponse, _a1 error) *MockService_RunJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_RunJob_Call) RunAndReturn(run func(context.Context, string, string, *models.RunJobRequest) (*models.RunJobResponse, error)) *MockService_RunJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TriggerCronJob provides a mock function with given fields: ctx, namespaceName, releaseBindingName
 func (_m *MockService) TriggerCronJob(ctx context.Context, namespaceName string, releaseBindingName string) (*models.CronJobTriggerResponse, error) {
 	ret := _m.Called(ctx, namespaceName, releaseBindingName)
@@ -0,0 +1,245 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sresources
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	// runJobAnnotationKey marks Jobs created by RunJob and records the workload they were created from.
+	runJobAnnotationKey = "openchoreo.dev/run-job-of"
+)
+
+// runJobWorkloadKinds are the workload kinds a one-off Job can be created from, keyed by kind
+// with the API group they must belong to.
+var runJobWorkloadKinds = map[string]string{
+	"Deployment":  "apps",
+	"StatefulSet": "apps",
+	"CronJob":     "batch",
+	"Job":         "batch",
+}
+
+// jobControllerLabels are added to pod templates by the Job controller. They are bound to the
+// original Job's uid and name, so they are dropped before the template is reused.
+var jobControllerLabels = []string{
+	"controller-uid",
+	"job-name",
+	"batch.kubernetes.io/controller-uid",
+	"batch.kubernetes.io/job-name",
+}
+
+// RunJob creates a one-off Job in the data plane namespace of the release binding from the pod
+// template of the deployed workload, so the Job runs with the workload's image, environment,
+// volumes and service account. Only the selected container is kept, its probes are removed and
+// its command and arguments may be overridden. The Job keeps the pod template labels, so its
+// logs are attributed to the component by the observer, and it is owned by the workload so it
+// is garbage collected with it.
+func (s *k8sResourcesService) RunJob(ctx context.Context, namespaceName, releaseBindingName string, req *models.RunJobRequest) (*models.RunJobResponse, error) {
+	s.logger.Debug("Running job", "namespace", namespaceName, "releaseBinding", releaseBindingName)
+
+	if s.gatewayClient == nil {
+		return nil, fmt.Errorf("gateway client is not configured")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, &services.ValidationError{Msg: err.Error()}
+	}
+
+	releaseContexts, err := s.resolveReleaseContexts(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, workloadStatus := findWorkloadResource(releaseContexts)
+	if rc == nil {
+		return nil, ErrWorkloadNotFound
+	}
+
+	plural, err := s.resolveResourcePlural(workloadStatus.Group, workloadStatus.Version, workloadStatus.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve workload plural: %w", err)
+	}
+	getPath := buildK8sGetPath(workloadStatus.Group, workloadStatus.Version, plural, workloadStatus.Namespace, workloadStatus.Name)
+	workload, err := s.fetchLiveResource(ctx, rc.plane, getPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workload: %w", err)
+	}
+
+	job, container, err := buildRunJob(workload, workloadStatus, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.createJob(ctx, rc.plane, workloadStatus.Namespace, job); err != nil {
+		return nil, err
+	}
+
+	return &models.RunJobResponse{
+		JobName:      getNestedString(job, "metadata", "name"),
+		Namespace:    workloadStatus.Namespace,
+		WorkloadKind: workloadStatus.Kind,
+		WorkloadName: workloadStatus.Name,
+		Container:    container,
+	}, nil
+}
+
+// findWorkloadResource returns the release context and status entry for the first workload
+// resource found on a data plane release owned by the binding.
+func findWorkloadResource(contexts []releaseContext) (*releaseContext, *openchoreov1alpha1.RenderedManifestStatus) {
+	for i := range contexts {
+		rc := &contexts[i]
+		if rc.plane.planeType != planeTypeDataPlane {
+			continue
+		}
+		for j := range rc.release.Status.Resources {
+			rs := &rc.release.Status.Resources[j]
+			if group, ok := runJobWorkloadKinds[rs.Kind]; ok && rs.Group == group {
+				return rc, rs
+			}
+		}
+	}
+	return nil, nil
+}
+
+// workloadPodTemplate returns the pod template of a workload.
+func workloadPodTemplate(workload map[string]any, kind string) (map[string]any, error) {
+	spec, ok := workload["spec"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s has no spec", kind)
+	}
+	if kind == "CronJob" {
+		jobTemplate, ok := spec["jobTemplate"].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("cronjob has no spec.jobTemplate")
+		}
+		spec, ok = jobTemplate["spec"].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("cronjob has no spec.jobTemplate.spec")
+		}
+	}
+	template, ok := spec["template"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s has no pod template", kind)
+	}
+	return template, nil
+}
+
+// buildRunJob builds a one-off Job manifest from the workload's pod template and returns it with
+// the name of the container it runs.
+func buildRunJob(workload map[string]any, rs *openchoreov1alpha1.RenderedManifestStatus, req *models.RunJobRequest) (map[string]any, string, error) {
+	workloadName := getNestedString(workload, "metadata", "name")
+	workloadUID := getNestedString(workload, "metadata", "uid")
+	if workloadName == "" || workloadUID == "" {
+		return nil, "", fmt.Errorf("workload is missing name or uid")
+	}
+
+	template, err := workloadPodTemplate(workload, rs.Kind)
+	if err != nil {
+		return nil, "", err
+	}
+	// The template is reused in a new object, so work on a copy of the fetched workload's data.
+	template = runtime.DeepCopyJSON(template)
+
+	podSpec, ok := template["spec"].(map[string]any)
+	if !ok {
+		return nil, "", fmt.Errorf("%s pod template has no spec", rs.Kind)
+	}
+	container, err := selectRunContainer(podSpec, req)
+	if err != nil {
+		return nil, "", err
+	}
+	podSpec["restartPolicy"] = "Never"
+
+	var labels map[string]any
+	if tmplMeta, ok := template["metadata"].(map[string]any); ok {
+		labels, _ = tmplMeta["labels"].(map[string]any)
+	}
+	for _, l := range jobControllerLabels {
+		delete(labels, l)
+	}
+
+	apiVersion := rs.Version
+	if rs.Group != "" {
+		apiVersion = rs.Group + "/" + rs.Version
+	}
+	metadata := map[string]any{
+		"name":      makeJobName(workloadName),
+		"namespace": getNestedString(workload, "metadata", "namespace"),
+		"annotations": map[string]any{
+			runJobAnnotationKey: rs.Kind + "/" + workloadName,
+		},
+		// The workload owns the Job for garbage collection only; it is not its controller.
+		"ownerReferences": []any{
+			map[string]any{
+				"apiVersion": apiVersion,
+				"kind":       rs.Kind,
+				"name":       workloadName,
+				"uid":        workloadUID,
+				"controller": false,
+			},
+		},
+	}
+	if len(labels) > 0 {
+		metadata["labels"] = runtime.DeepCopyJSON(labels)
+	}
+
+	return map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   metadata,
+		"spec": map[string]any{
+			// A run is a single attempt; retrying a failed migration is the caller's decision.
+			"backoffLimit":            int64(0),
+			"ttlSecondsAfterFinished": int64(req.TTLAfterFinished.Seconds()),
+			"template":                template,
+		},
+	}, container, nil
+}
+
+// selectRunContainer reduces the pod spec's containers to the requested one, or the first one
+// when none is requested, removes its probes and applies the command and argument overrides.
+// Other containers are dropped because long-running sidecars would keep the Job from completing.
+func selectRunContainer(podSpec map[string]any, req *models.RunJobRequest) (string, error) {
+	containers, _ := podSpec["containers"].([]any)
+	var selected map[string]any
+	for _, c := range containers {
+		cm, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if req.Container == "" || cm["name"] == req.Container {
+			selected = cm
+			break
+		}
+	}
+	if selected == nil {
+		if req.Container == "" {
+			return "", fmt.Errorf("pod template has no containers")
+		}
+		return "", ErrInvalidContainer
+	}
+
+	for _, probe := range []string{"livenessProbe", "readinessProbe", "startupProbe"} {
+		delete(selected, probe)
+	}
+	if len(req.Command) > 0 {
+		selected["command"] = toAnySlice(req.Command)
+		// A new entrypoint does not take the original arguments.
+		delete(selected, "args")
+	}
+	if len(req.Args) > 0 {
+		selected["args"] = toAnySlice(req.Args)
+	}
+
+	podSpec["containers"] = []any{selected}
+	name, _ := selected["name"].(string)
+	return name, nil
+}
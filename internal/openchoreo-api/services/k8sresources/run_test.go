@@ -0,0 +1,178 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sresources
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func sampleDeployment() map[string]any {
+	return map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      "my-service",
+			"namespace": "dp-ns",
+			"uid":       "deployment-uid-123",
+		},
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"labels": map[string]any{"openchoreo.dev/component": "my-service"},
+				},
+				"spec": map[string]any{
+					"serviceAccountName": "my-service",
+					"containers": []any{
+						map[string]any{
+							"name":           "main",
+							"image":          "registry/my-service:v1",
+							"args":           []any{"serve"},
+							"env":            []any{map[string]any{"name": "DB_URL", "value": "postgres://db"}},
+							"readinessProbe": map[string]any{"httpGet": map[string]any{"path": "/healthz"}},
+						},
+						map[string]any{"name": "proxy", "image": "envoy"},
+					},
+				},
+			},
+		},
+	}
+}
+
+var deploymentStatus = &openchoreov1alpha1.RenderedManifestStatus{
+	Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "dp-ns", Name: "my-service",
+}
+
+func TestBuildRunJob(t *testing.T) {
+	deployment := sampleDeployment()
+	req := &models.RunJobRequest{Command: []string{"./manage.py"}, TTLAfterFinished: time.Hour}
+	job, container, err := buildRunJob(deployment, deploymentStatus, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if container != "main" {
+		t.Fatalf("expected the first container to be selected, got %q", container)
+	}
+	if job["apiVersion"] != "batch/v1" || job["kind"] != "Job" {
+		t.Fatalf("unexpected job type: %v/%v", job["apiVersion"], job["kind"])
+	}
+
+	meta := job["metadata"].(map[string]any)
+	if name := meta["name"].(string); !strings.HasPrefix(name, "my-service-") {
+		t.Fatalf("job name %q does not start with the workload name", name)
+	}
+	if meta["namespace"] != "dp-ns" {
+		t.Fatalf("unexpected namespace: %v", meta["namespace"])
+	}
+	if meta["labels"].(map[string]any)["openchoreo.dev/component"] != "my-service" {
+		t.Fatalf("pod template labels not carried over: %v", meta["labels"])
+	}
+	owner := meta["ownerReferences"].([]any)[0].(map[string]any)
+	if owner["apiVersion"] != "apps/v1" || owner["kind"] != "Deployment" || owner["uid"] != "deployment-uid-123" {
+		t.Fatalf("unexpected owner reference: %v", owner)
+	}
+	if owner["controller"] != false {
+		t.Fatalf("the workload must not be the job's controller: %v", owner)
+	}
+
+	spec := job["spec"].(map[string]any)
+	if spec["backoffLimit"] != int64(0) || spec["ttlSecondsAfterFinished"] != int64(3600) {
+		t.Fatalf("unexpected job spec: %v", spec)
+	}
+	podSpec := spec["template"].(map[string]any)["spec"].(map[string]any)
+	if podSpec["restartPolicy"] != "Never" || podSpec["serviceAccountName"] != "my-service" {
+		t.Fatalf("unexpected pod spec: %v", podSpec)
+	}
+	containers := podSpec["containers"].([]any)
+	if len(containers) != 1 {
+		t.Fatalf("expected only the selected container, got %d", len(containers))
+	}
+	c := containers[0].(map[string]any)
+	if !reflect.DeepEqual(c["command"], []any{"./manage.py"}) {
+		t.Fatalf("command not overridden: %v", c["command"])
+	}
+	if _, ok := c["args"]; ok {
+		t.Fatalf("args should be dropped when only the command is overridden: %v", c["args"])
+	}
+	if _, ok := c["readinessProbe"]; ok {
+		t.Fatalf("probes should be removed")
+	}
+	if c["env"] == nil || c["image"] != "registry/my-service:v1" {
+		t.Fatalf("image and env should be kept: %v", c)
+	}
+
+	// The fetched workload is left untouched.
+	if !reflect.DeepEqual(deployment, sampleDeployment()) {
+		t.Fatalf("workload was modified")
+	}
+}
+
+func TestBuildRunJob_ContainerAndArgs(t *testing.T) {
+	req := &models.RunJobRequest{Container: "proxy", Args: []string{"--version"}, TTLAfterFinished: time.Minute}
+	job, container, err := buildRunJob(sampleDeployment(), deploymentStatus, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if container != "proxy" {
+		t.Fatalf("expected container proxy, got %q", container)
+	}
+	c := job["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)["containers"].([]any)[0].(map[string]any)
+	if !reflect.DeepEqual(c["args"], []any{"--version"}) {
+		t.Fatalf("args not overridden: %v", c["args"])
+	}
+
+	req.Container = "missing"
+	if _, _, err := buildRunJob(sampleDeployment(), deploymentStatus, req); !errors.Is(err, ErrInvalidContainer) {
+		t.Fatalf("expected ErrInvalidContainer, got %v", err)
+	}
+}
+
+func TestBuildRunJob_FromCronJob(t *testing.T) {
+	cronJob := sampleCronJob()
+	tmpl := cronJob["spec"].(map[string]any)["jobTemplate"].(map[string]any)["spec"].(map[string]any)["template"].(map[string]any)
+	tmpl["metadata"] = map[string]any{"labels": map[string]any{"app": "my-task", "batch.kubernetes.io/job-name": "my-task-1"}}
+	rs := &openchoreov1alpha1.RenderedManifestStatus{Group: "batch", Version: "v1", Kind: kindCronJob, Namespace: "dp-ns", Name: "my-task"}
+
+	job, container, err := buildRunJob(cronJob, rs, &models.RunJobRequest{TTLAfterFinished: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if container != "task" {
+		t.Fatalf("expected container task, got %q", container)
+	}
+	labels := job["metadata"].(map[string]any)["labels"].(map[string]any)
+	if _, ok := labels["batch.kubernetes.io/job-name"]; ok || labels["app"] != "my-task" {
+		t.Fatalf("job controller labels should be dropped and others kept: %v", labels)
+	}
+	if owner := job["metadata"].(map[string]any)["ownerReferences"].([]any)[0].(map[string]any); owner["apiVersion"] != "batch/v1" {
+		t.Fatalf("unexpected owner reference: %v", owner)
+	}
+}
+
+func TestFindWorkloadResource(t *testing.T) {
+	contexts := []releaseContext{{
+		plane: planeInfo{planeType: planeTypeDataPlane},
+		release: &openchoreov1alpha1.RenderedRelease{Status: openchoreov1alpha1.RenderedReleaseStatus{
+			Resources: []openchoreov1alpha1.RenderedManifestStatus{
+				{Group: "", Version: "v1", Kind: "Service", Name: "my-service"},
+				{Group: "apps", Version: "v1", Kind: "Deployment", Name: "my-service"},
+			},
+		}},
+	}}
+	rc, rs := findWorkloadResource(contexts)
+	if rc == nil || rs.Kind != "Deployment" {
+		t.Fatalf("expected the deployment to be found, got %v", rs)
+	}
+
+	contexts[0].release.Status.Resources = contexts[0].release.Status.Resources[:1]
+	if rc, _ := findWorkloadResource(contexts); rc != nil {
+		t.Fatalf("expected no workload to be found")
+	}
+}
@@ -68,6 +68,36 @@ func (s *k8sResourcesServiceWithAuthz) TriggerCronJob(ctx context.Context, names
 	return s.internal.TriggerCronJob(ctx, namespaceName, releaseBindingName)
 }
 
+func (s *k8sResourcesServiceWithAuthz) RunJob(ctx context.Context, namespaceName, releaseBindingName string, req *models.RunJobRequest) (*models.RunJobResponse, error) {
+	// Running a job creates a Job in the data plane, so it requires the update action.
+	if err := s.checkReleaseBindingAuthzWithAction(ctx, namespaceName, releaseBindingName, authz.ActionUpdateReleaseBinding); err != nil {
+		return nil, err
+	}
+	// Overriding the command runs arbitrary code in the workload's context, the same as exec,
+	// so it also requires component:exec in the binding's environment.
+	if req != nil && req.OverridesCommand() {
+		if err := s.checkExecAuthz(ctx, namespaceName, releaseBindingName); err != nil {
+			return nil, err
+		}
+	}
+	return s.internal.RunJob(ctx, namespaceName, releaseBindingName, req)
+}
+
+func (s *k8sResourcesServiceWithAuthz) MintDebugCredential(ctx context.Context, namespaceName, releaseBindingName string, req *models.DebugCredentialRequest) (*models.DebugCredentialResponse, error) {
+	// Minting creates RBAC objects in the data plane, so it requires the update action.
+	if err := s.checkReleaseBindingAuthzWithAction(ctx, namespaceName, releaseBindingName, authz.ActionUpdateReleaseBinding); err != nil {
+		return nil, err
+	}
+	// A logs-exec credential grants pod exec, so it also requires component:exec in the
+	// binding's environment.
+	if req != nil && req.Access == models.DebugAccessLogsExec {
+		if err := s.checkExecAuthz(ctx, namespaceName, releaseBindingName); err != nil {
+			return nil, err
+		}
+	}
+	return s.internal.MintDebugCredential(ctx, namespaceName, releaseBindingName, req)
+}
+
 // checkReleaseBindingAuthz fetches the release binding and checks view authorization.
 func (s *k8sResourcesServiceWithAuthz) checkReleaseBindingAuthz(ctx context.Context, namespaceName, releaseBindingName string) error {
 	return s.checkReleaseBindingAuthzWithAction(ctx, namespaceName, releaseBindingName, authz.ActionViewReleaseBinding)
@@ -94,3 +124,29 @@ func (s *k8sResourcesServiceWithAuthz) checkReleaseBindingAuthzWithAction(ctx co
 		},
 	})
 }
+
+// checkExecAuthz checks the component:exec action for the release binding's component and environment.
+func (s *k8sResourcesServiceWithAuthz) checkExecAuthz(ctx context.Context, namespaceName, releaseBindingName string) error {
+	var rb openchoreov1alpha1.ReleaseBinding
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: releaseBindingName}, &rb); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return ErrReleaseBindingNotFound
+		}
+		return fmt.Errorf("failed to get release binding: %w", err)
+	}
+
+	return s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionExecComponent,
+		ResourceType: "component",
+		ResourceID:   rb.Spec.Owner.ComponentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   rb.Spec.Owner.ProjectName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				Environment: services.FormatDualScopedResourceName(namespaceName, rb.Spec.Environment, false),
+			},
+		},
+	})
+}
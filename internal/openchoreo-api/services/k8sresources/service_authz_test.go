@@ -159,3 +159,87 @@ func TestGetResourceLogs_AuthzCheck(t *testing.T) {
 		require.Equal(t, expected, result)
 	})
 }
+
+// --- MintDebugCredential ---
+
+func TestMintDebugCredential_AuthzCheck(t *testing.T) {
+	t.Run("read-only requires releasebinding update", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc, svc := newAuthzSvcWithRB(t, pdp)
+		req := &models.DebugCredentialRequest{Access: models.DebugAccessReadOnly}
+		expected := &models.DebugCredentialResponse{Token: "tok"}
+		mockSvc.On("MintDebugCredential", mock.Anything, "ns-1", "rb-1", req).Return(expected, nil)
+
+		result, err := svc.MintDebugCredential(testutil.AuthzContext(), "ns-1", "rb-1", req)
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0],
+			"releasebinding:update", "releasebinding", "rb-1",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "proj-1", Component: "comp-1"})
+	})
+
+	t.Run("logs-exec also requires component exec", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc, svc := newAuthzSvcWithRB(t, pdp)
+		req := &models.DebugCredentialRequest{Access: models.DebugAccessLogsExec}
+		mockSvc.On("MintDebugCredential", mock.Anything, "ns-1", "rb-1", req).Return(&models.DebugCredentialResponse{}, nil)
+
+		_, err := svc.MintDebugCredential(testutil.AuthzContext(), "ns-1", "rb-1", req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[1],
+			"component:exec", "component", "comp-1",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "proj-1"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		_, svc := newAuthzSvcWithRB(t, pdp)
+
+		_, err := svc.MintDebugCredential(testutil.AuthzContext(), "ns-1", "rb-1", &models.DebugCredentialRequest{})
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// --- RunJob ---
+
+func TestRunJob_AuthzCheck(t *testing.T) {
+	t.Run("default command requires releasebinding update", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc, svc := newAuthzSvcWithRB(t, pdp)
+		req := &models.RunJobRequest{}
+		expected := &models.RunJobResponse{JobName: "app-1721563200-abcde"}
+		mockSvc.On("RunJob", mock.Anything, "ns-1", "rb-1", req).Return(expected, nil)
+
+		result, err := svc.RunJob(testutil.AuthzContext(), "ns-1", "rb-1", req)
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0],
+			"releasebinding:update", "releasebinding", "rb-1",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "proj-1", Component: "comp-1"})
+	})
+
+	t.Run("command override also requires component exec", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc, svc := newAuthzSvcWithRB(t, pdp)
+		req := &models.RunJobRequest{Command: []string{"./migrate"}}
+		mockSvc.On("RunJob", mock.Anything, "ns-1", "rb-1", req).Return(&models.RunJobResponse{}, nil)
+
+		_, err := svc.RunJob(testutil.AuthzContext(), "ns-1", "rb-1", req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[1],
+			"component:exec", "component", "comp-1",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "proj-1"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		_, svc := newAuthzSvcWithRB(t, pdp)
+
+		_, err := svc.RunJob(testutil.AuthzContext(), "ns-1", "rb-1", &models.RunJobRequest{})
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup
+
+import "errors"
+
+var (
+	ErrUnsupportedKind     = errors.New("unsupported lookup kind")
+	ErrInvalidLookup       = errors.New("exactly one of name, uid or externalId is required")
+	ErrResourceNotFound    = errors.New("resource not found")
+	ErrAmbiguousExternalID = errors.New("more than one resource has this external ID")
+)
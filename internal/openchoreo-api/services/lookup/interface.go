@@ -0,0 +1,16 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Service defines the resource lookup service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	Lookup(ctx context.Context, req models.ResourceLookupRequest) (*models.ResourceLookupResponse, error)
+}
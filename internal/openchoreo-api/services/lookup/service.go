@@ -0,0 +1,116 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// listTypes maps each supported lookup kind to its list type.
+var listTypes = map[string]func() client.ObjectList{
+	models.LookupKindProject:     func() client.ObjectList { return &openchoreov1alpha1.ProjectList{} },
+	models.LookupKindComponent:   func() client.ObjectList { return &openchoreov1alpha1.ComponentList{} },
+	models.LookupKindEnvironment: func() client.ObjectList { return &openchoreov1alpha1.EnvironmentList{} },
+	models.LookupKindDataPlane:   func() client.ObjectList { return &openchoreov1alpha1.DataPlaneList{} },
+}
+
+// lookupService finds resources by name, uid or external ID without authorization checks.
+type lookupService struct {
+	k8sClient client.Client
+	logger    *slog.Logger
+}
+
+var _ Service = (*lookupService)(nil)
+
+// NewService creates a new lookup service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &lookupService{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+func (s *lookupService) Lookup(ctx context.Context, req models.ResourceLookupRequest) (*models.ResourceLookupResponse, error) {
+	s.logger.Debug("Looking up resource", "namespace", req.Namespace, "kind", req.Kind,
+		"name", req.Name, "uid", req.UID, "externalId", req.ExternalID)
+
+	newList, ok := listTypes[req.Kind]
+	if !ok {
+		return nil, ErrUnsupportedKind
+	}
+	if countSet(req.Name, req.UID, req.ExternalID) != 1 {
+		return nil, ErrInvalidLookup
+	}
+
+	list := newList()
+	if err := s.k8sClient.List(ctx, list, client.InNamespace(req.Namespace)); err != nil {
+		s.logger.Error("Failed to list resources", "kind", req.Kind, "error", err)
+		return nil, fmt.Errorf("failed to list %s resources: %w", req.Kind, err)
+	}
+
+	var found client.Object
+	err := meta.EachListItem(list, func(o runtime.Object) error {
+		obj, ok := o.(client.Object)
+		if !ok || !matches(obj, req) {
+			return nil
+		}
+		if found != nil {
+			return ErrAmbiguousExternalID
+		}
+		found = obj
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, ErrResourceNotFound
+	}
+	return toResponse(req.Kind, found), nil
+}
+
+func matches(obj client.Object, req models.ResourceLookupRequest) bool {
+	switch {
+	case req.Name != "":
+		return obj.GetName() == req.Name
+	case req.UID != "":
+		return string(obj.GetUID()) == req.UID
+	default:
+		return obj.GetAnnotations()[labels.AnnotationKeyExternalID] == req.ExternalID
+	}
+}
+
+func toResponse(kind string, obj client.Object) *models.ResourceLookupResponse {
+	resp := &models.ResourceLookupResponse{
+		Kind:       kind,
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		UID:        string(obj.GetUID()),
+		ExternalID: obj.GetAnnotations()[labels.AnnotationKeyExternalID],
+	}
+	if comp, ok := obj.(*openchoreov1alpha1.Component); ok {
+		resp.Project = comp.Spec.Owner.ProjectName
+	}
+	return resp
+}
+
+func countSet(values ...string) int {
+	n := 0
+	for _, v := range values {
+		if v != "" {
+			n++
+		}
+	}
+	return n
+}
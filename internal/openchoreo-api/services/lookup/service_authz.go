@@ -0,0 +1,90 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	resourceTypeProject     = "project"
+	resourceTypeComponent   = "component"
+	resourceTypeEnvironment = "environment"
+	resourceTypeDataPlane   = "dataPlane"
+)
+
+// lookupServiceWithAuthz wraps a Service and checks that the caller can view the resource found.
+type lookupServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*lookupServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a lookup service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &lookupServiceWithAuthz{
+		internal: NewService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// Lookup resolves the resource first, since the authz hierarchy of a uid or external ID
+// lookup is only known once the resource is found.
+func (s *lookupServiceWithAuthz) Lookup(ctx context.Context, req models.ResourceLookupRequest) (*models.ResourceLookupResponse, error) {
+	resp, err := s.internal.Lookup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, viewRequest(resp)); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// viewRequest returns the view check of the found resource, matching the checks of the
+// resource's own get endpoint.
+func viewRequest(resp *models.ResourceLookupResponse) services.CheckRequest {
+	switch resp.Kind {
+	case models.LookupKindProject:
+		return services.CheckRequest{
+			Action:       authz.ActionViewProject,
+			ResourceType: resourceTypeProject,
+			ResourceID:   resp.Name,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: resp.Namespace, Project: resp.Name},
+		}
+	case models.LookupKindComponent:
+		return services.CheckRequest{
+			Action:       authz.ActionViewComponent,
+			ResourceType: resourceTypeComponent,
+			ResourceID:   resp.Name,
+			Hierarchy: authz.ResourceHierarchy{
+				Namespace: resp.Namespace,
+				Project:   resp.Project,
+				Component: resp.Name,
+			},
+		}
+	case models.LookupKindEnvironment:
+		return services.CheckRequest{
+			Action:       authz.ActionViewEnvironment,
+			ResourceType: resourceTypeEnvironment,
+			ResourceID:   resp.Name,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: resp.Namespace},
+		}
+	default:
+		return services.CheckRequest{
+			Action:       authz.ActionViewDataPlane,
+			ResourceType: resourceTypeDataPlane,
+			ResourceID:   resp.Name,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: resp.Namespace},
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/lookup/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func TestLookup_AuthzCheck(t *testing.T) {
+	req := models.ResourceLookupRequest{Namespace: "ns-1", Kind: models.LookupKindComponent, UID: "uid-api"}
+	found := &models.ResourceLookupResponse{Kind: models.LookupKindComponent, Namespace: "ns-1", Name: "api", UID: "uid-api", Project: "shop"}
+
+	t.Run("allowed checks view on the found resource", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("Lookup", mock.Anything, req).Return(found, nil)
+		svc := &lookupServiceWithAuthz{internal: mockSvc, authz: testutil.NewTestAuthzChecker(pdp)}
+
+		resp, err := svc.Lookup(testutil.AuthzContext(), req)
+		require.NoError(t, err)
+		require.Equal(t, found, resp)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0],
+			"component:view", "component", "api",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "shop", Component: "api"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("Lookup", mock.Anything, req).Return(found, nil)
+		svc := &lookupServiceWithAuthz{internal: mockSvc, authz: testutil.NewTestAuthzChecker(testutil.DenyPDP())}
+
+		_, err := svc.Lookup(testutil.AuthzContext(), req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
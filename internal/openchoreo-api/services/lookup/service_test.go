@@ -0,0 +1,93 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lookup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testNamespace = "test-ns"
+
+func withIdentity[T client.Object](obj T, uid, externalID string) T {
+	obj.SetUID(types.UID(uid))
+	if externalID != "" {
+		obj.SetAnnotations(map[string]string{labels.AnnotationKeyExternalID: externalID})
+	}
+	return obj
+}
+
+func TestLookup(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(testutil.NewFakeClient(
+		withIdentity(testutil.NewProject(testNamespace, "shop"), "uid-shop", "tf-shop"),
+		withIdentity(testutil.NewComponent(testNamespace, "shop", "api"), "uid-api", "tf-api"),
+		withIdentity(testutil.NewComponent(testNamespace, "shop", "web"), "uid-web", "tf-dup"),
+		withIdentity(testutil.NewComponent(testNamespace, "shop", "worker"), "uid-worker", "tf-dup"),
+		withIdentity(testutil.NewEnvironment(testNamespace, "dev"), "uid-dev", ""),
+		withIdentity(testutil.NewDataPlane(testNamespace, "default"), "uid-dp", ""),
+		withIdentity(testutil.NewProject("other-ns", "hidden"), "uid-hidden", "tf-hidden"),
+	), testutil.TestLogger())
+
+	t.Run("by name", func(t *testing.T) {
+		resp, err := svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: models.LookupKindProject, Name: "shop"})
+		require.NoError(t, err)
+		assert.Equal(t, &models.ResourceLookupResponse{
+			Kind: models.LookupKindProject, Namespace: testNamespace, Name: "shop", UID: "uid-shop", ExternalID: "tf-shop",
+		}, resp)
+	})
+
+	t.Run("component by uid includes the project", func(t *testing.T) {
+		resp, err := svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: models.LookupKindComponent, UID: "uid-api"})
+		require.NoError(t, err)
+		assert.Equal(t, "api", resp.Name)
+		assert.Equal(t, "shop", resp.Project)
+	})
+
+	t.Run("by external ID", func(t *testing.T) {
+		resp, err := svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: models.LookupKindComponent, ExternalID: "tf-api"})
+		require.NoError(t, err)
+		assert.Equal(t, "uid-api", resp.UID)
+	})
+
+	t.Run("environment and data plane", func(t *testing.T) {
+		env, err := svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: models.LookupKindEnvironment, UID: "uid-dev"})
+		require.NoError(t, err)
+		assert.Equal(t, "dev", env.Name)
+		dp, err := svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: models.LookupKindDataPlane, Name: "default"})
+		require.NoError(t, err)
+		assert.Equal(t, "uid-dp", dp.UID)
+	})
+
+	t.Run("other namespaces are not searched", func(t *testing.T) {
+		_, err := svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: models.LookupKindProject, ExternalID: "tf-hidden"})
+		require.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("duplicate external ID", func(t *testing.T) {
+		_, err := svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: models.LookupKindComponent, ExternalID: "tf-dup"})
+		require.ErrorIs(t, err, ErrAmbiguousExternalID)
+	})
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		_, err := svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: "secret", Name: "x"})
+		require.ErrorIs(t, err, ErrUnsupportedKind)
+	})
+
+	t.Run("requires exactly one selector", func(t *testing.T) {
+		_, err := svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: models.LookupKindProject})
+		require.ErrorIs(t, err, ErrInvalidLookup)
+		_, err = svc.Lookup(ctx, models.ResourceLookupRequest{Namespace: testNamespace, Kind: models.LookupKindProject, Name: "shop", UID: "uid-shop"})
+		require.ErrorIs(t, err, ErrInvalidLookup)
+	})
+}
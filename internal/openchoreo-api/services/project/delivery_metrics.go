@@ -0,0 +1,87 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/dora"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// DefaultDeliveryMetricsWindow is the reporting window used when none is requested.
+const DefaultDeliveryMetricsWindow = 30 * 24 * time.Hour
+
+func (s *projectService) GetProjectDeliveryMetrics(ctx context.Context, namespaceName, projectName string, opts models.DeliveryMetricsRequest) (*models.ProjectDeliveryMetricsResponse, error) {
+	s.logger.Debug("Getting project delivery metrics", "namespace", namespaceName, "project", projectName,
+		"environment", opts.Environment, "window", opts.Window)
+
+	if _, err := s.GetProject(ctx, namespaceName, projectName); err != nil {
+		return nil, err
+	}
+
+	environments, err := s.deliveryMetricsEnvironments(ctx, namespaceName, opts.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := s.k8sClient.List(ctx, &bindings, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	envSet := make(map[string]bool, len(environments))
+	for _, env := range environments {
+		envSet[env] = true
+	}
+	var records []openchoreov1alpha1.DeploymentRecord
+	for i := range bindings.Items {
+		rb := &bindings.Items[i]
+		if rb.Spec.Owner.ProjectName != projectName || !envSet[rb.Spec.Environment] {
+			continue
+		}
+		records = append(records, rb.Status.DeploymentHistory...)
+	}
+
+	window := opts.Window
+	if window <= 0 {
+		window = DefaultDeliveryMetricsWindow
+	}
+	end := time.Now()
+	return &models.ProjectDeliveryMetricsResponse{
+		Metrics:      dora.Compute(records, end.Add(-window), end),
+		Project:      projectName,
+		Environments: environments,
+	}, nil
+}
+
+// deliveryMetricsEnvironments returns the requested environment, or all production
+// environments in the namespace when none is requested.
+func (s *projectService) deliveryMetricsEnvironments(ctx context.Context, namespaceName, environment string) ([]string, error) {
+	if environment != "" {
+		return []string{environment}, nil
+	}
+
+	var envList openchoreov1alpha1.EnvironmentList
+	if err := s.k8sClient.List(ctx, &envList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list environments", "error", err)
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+
+	environments := make([]string, 0, len(envList.Items))
+	for _, env := range envList.Items {
+		if env.Spec.IsProduction {
+			environments = append(environments, env.Name)
+		}
+	}
+	sort.Strings(environments)
+	return environments, nil
+}
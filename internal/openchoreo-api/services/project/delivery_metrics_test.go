@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func deliveryMetricsBinding(name, project, env string, records ...openchoreov1alpha1.DeploymentRecord) *openchoreov1alpha1.ReleaseBinding {
+	return &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: project, ComponentName: name},
+			Environment: env,
+		},
+		Status: openchoreov1alpha1.ReleaseBindingStatus{DeploymentHistory: records},
+	}
+}
+
+func TestGetProjectDeliveryMetrics(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	record := func(deployedAgo time.Duration, failed bool) openchoreov1alpha1.DeploymentRecord {
+		r := openchoreov1alpha1.DeploymentRecord{
+			Release:          "rel",
+			ReleaseCreatedAt: metav1.NewTime(now.Add(-deployedAgo - time.Hour)),
+			DeployedAt:       metav1.NewTime(now.Add(-deployedAgo)),
+		}
+		if failed {
+			failedAt := metav1.NewTime(now.Add(-deployedAgo + time.Minute))
+			restoredAt := metav1.NewTime(now.Add(-deployedAgo + 11*time.Minute))
+			r.FailedAt, r.RestoredAt = &failedAt, &restoredAt
+		}
+		return r
+	}
+
+	objs := []openchoreov1alpha1.Environment{
+		{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: testNamespace}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: testNamespace},
+			Spec: openchoreov1alpha1.EnvironmentSpec{IsProduction: true}},
+	}
+	svc := newService(t,
+		&openchoreov1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: testProjectName, Namespace: testNamespace}},
+		&objs[0], &objs[1],
+		deliveryMetricsBinding("api-prod", testProjectName, "prod",
+			record(48*time.Hour, false), record(24*time.Hour, true), record(40*24*time.Hour, false)),
+		deliveryMetricsBinding("api-dev", testProjectName, "dev", record(time.Hour, false), record(2*time.Hour, false)),
+		deliveryMetricsBinding("other-prod", "other-project", "prod", record(time.Hour, false)),
+	)
+
+	t.Run("production environments by default", func(t *testing.T) {
+		m, err := svc.GetProjectDeliveryMetrics(ctx, testNamespace, testProjectName, models.DeliveryMetricsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, testProjectName, m.Project)
+		assert.Equal(t, []string{"prod"}, m.Environments)
+		assert.Equal(t, 2, m.Deployments)
+		assert.Equal(t, 1, m.FailedDeployments)
+		require.NotNil(t, m.ChangeFailureRate)
+		assert.InDelta(t, 0.5, *m.ChangeFailureRate, 1e-9)
+		require.NotNil(t, m.MedianLeadTime)
+		assert.Equal(t, time.Hour, *m.MedianLeadTime)
+		require.NotNil(t, m.MeanTimeToRestore)
+		assert.Equal(t, 10*time.Minute, *m.MeanTimeToRestore)
+	})
+
+	t.Run("explicit environment and window", func(t *testing.T) {
+		m, err := svc.GetProjectDeliveryMetrics(ctx, testNamespace, testProjectName, models.DeliveryMetricsRequest{
+			Environment: "dev",
+			Window:      24 * time.Hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dev"}, m.Environments)
+		assert.Equal(t, 2, m.Deployments)
+		assert.InDelta(t, 2.0, m.DeploymentsPerDay, 1e-9)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		_, err := svc.GetProjectDeliveryMetrics(ctx, testNamespace, "missing", models.DeliveryMetricsRequest{})
+		require.ErrorIs(t, err, ErrProjectNotFound)
+	})
+}
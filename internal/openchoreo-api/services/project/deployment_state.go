@@ -0,0 +1,96 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func (s *projectService) GetProjectDeploymentState(ctx context.Context, namespaceName, projectName string, at time.Time) (*models.ProjectDeploymentStateResponse, error) {
+	s.logger.Debug("Getting project deployment state", "namespace", namespaceName, "project", projectName, "at", at)
+
+	if _, err := s.GetProject(ctx, namespaceName, projectName); err != nil {
+		return nil, err
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := s.k8sClient.List(ctx, &bindings, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	deployments := make([]models.HistoricalDeployment, 0)
+	for i := range bindings.Items {
+		rb := &bindings.Items[i]
+		if rb.Spec.Owner.ProjectName != projectName {
+			continue
+		}
+		deployments = append(deployments, deploymentAt(rb, at))
+	}
+	sort.Slice(deployments, func(i, j int) bool {
+		if deployments[i].Component != deployments[j].Component {
+			return deployments[i].Component < deployments[j].Component
+		}
+		return deployments[i].Environment < deployments[j].Environment
+	})
+
+	return &models.ProjectDeploymentStateResponse{
+		Project:     projectName,
+		At:          at,
+		Deployments: deployments,
+	}, nil
+}
+
+// deploymentAt reconstructs the deployment of a ReleaseBinding at the given time from its
+// deployment history: the release is the last one deployed at or before that time, and its
+// status follows the ready, failed and restored times recorded for it.
+func deploymentAt(rb *openchoreov1alpha1.ReleaseBinding, at time.Time) models.HistoricalDeployment {
+	deployment := models.HistoricalDeployment{
+		Component:      rb.Spec.Owner.ComponentName,
+		Environment:    rb.Spec.Environment,
+		ReleaseBinding: rb.Name,
+		Status:         models.HistoricalDeploymentNotDeployed,
+	}
+
+	history := rb.Status.DeploymentHistory
+	var record *openchoreov1alpha1.DeploymentRecord
+	for i := len(history) - 1; i >= 0; i-- {
+		if !history[i].DeployedAt.Time.After(at) {
+			record = &history[i]
+			break
+		}
+	}
+	if record == nil {
+		// Records older than the retained history are gone, so an older deployment can
+		// only be ruled out while the history is not full.
+		if len(history) >= releasebinding.MaxDeploymentHistory && !rb.CreationTimestamp.Time.After(at) {
+			deployment.Status = models.HistoricalDeploymentUnknown
+		}
+		return deployment
+	}
+
+	deployedAt := record.DeployedAt.Time
+	deployment.Release = record.Release
+	deployment.DeployedAt = &deployedAt
+	reached := func(t *metav1.Time) bool { return t != nil && !t.Time.After(at) }
+	switch {
+	case reached(record.FailedAt) && !reached(record.RestoredAt):
+		deployment.Status = models.HistoricalDeploymentFailed
+	case reached(record.ReadyAt) || reached(record.RestoredAt):
+		deployment.Status = models.HistoricalDeploymentReady
+	default:
+		deployment.Status = models.HistoricalDeploymentProgressing
+	}
+	return deployment
+}
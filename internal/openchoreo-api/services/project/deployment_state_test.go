@@ -0,0 +1,104 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func TestGetProjectDeploymentState(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *metav1.Time {
+		mt := metav1.NewTime(base.Add(d))
+		return &mt
+	}
+
+	// api-prod: r1 ready after 5m, r2 deployed at 1h, failed at 1h10m and rolled back to r1 at 1h20m.
+	apiProd := deliveryMetricsBinding("api-prod", testProjectName, "prod",
+		openchoreov1alpha1.DeploymentRecord{Release: "api-r1", DeployedAt: *at(0), ReadyAt: at(5 * time.Minute)},
+		openchoreov1alpha1.DeploymentRecord{Release: "api-r2", DeployedAt: *at(time.Hour), ReadyAt: at(time.Hour + time.Minute),
+			FailedAt: at(time.Hour + 10*time.Minute), RolledBack: true},
+		openchoreov1alpha1.DeploymentRecord{Release: "api-r1", DeployedAt: *at(time.Hour + 20*time.Minute),
+			ReadyAt: at(time.Hour + 22*time.Minute)},
+	)
+	apiProd.Spec.Owner.ComponentName = "api"
+	worker := deliveryMetricsBinding("worker-prod", testProjectName, "prod",
+		openchoreov1alpha1.DeploymentRecord{Release: "worker-r1", DeployedAt: *at(2 * time.Hour)})
+	worker.Spec.Owner.ComponentName = "worker"
+
+	truncated := deliveryMetricsBinding("cron-prod", testProjectName, "prod")
+	truncated.Spec.Owner.ComponentName = "cron"
+	truncated.CreationTimestamp = metav1.NewTime(base.Add(-24 * time.Hour))
+	for i := range releasebinding.MaxDeploymentHistory {
+		truncated.Status.DeploymentHistory = append(truncated.Status.DeploymentHistory,
+			openchoreov1alpha1.DeploymentRecord{Release: "cron-r", DeployedAt: *at(time.Duration(i+1) * time.Hour)})
+	}
+
+	svc := newService(t,
+		&openchoreov1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: testProjectName, Namespace: testNamespace}},
+		apiProd, worker, truncated,
+		deliveryMetricsBinding("other-prod", "other-project", "prod",
+			openchoreov1alpha1.DeploymentRecord{Release: "other-r1", DeployedAt: *at(0)}),
+	)
+
+	stateAt := func(t *testing.T, d time.Duration) map[string]models.HistoricalDeployment {
+		t.Helper()
+		state, err := svc.GetProjectDeploymentState(ctx, testNamespace, testProjectName, base.Add(d))
+		require.NoError(t, err)
+		assert.Equal(t, testProjectName, state.Project)
+		require.Len(t, state.Deployments, 3)
+		assert.Equal(t, "api", state.Deployments[0].Component)
+		out := make(map[string]models.HistoricalDeployment, len(state.Deployments))
+		for _, d := range state.Deployments {
+			out[d.Component] = d
+		}
+		return out
+	}
+
+	t.Run("before the first deployment", func(t *testing.T) {
+		state := stateAt(t, -time.Minute)
+		assert.Equal(t, models.HistoricalDeploymentNotDeployed, state["api"].Status)
+		assert.Empty(t, state["api"].Release)
+		assert.Equal(t, models.HistoricalDeploymentUnknown, state["cron"].Status)
+	})
+
+	t.Run("while rolling out", func(t *testing.T) {
+		state := stateAt(t, 2*time.Minute)
+		assert.Equal(t, models.HistoricalDeploymentProgressing, state["api"].Status)
+		assert.Equal(t, "api-r1", state["api"].Release)
+	})
+
+	t.Run("during an incident", func(t *testing.T) {
+		state := stateAt(t, time.Hour+15*time.Minute)
+		assert.Equal(t, models.HistoricalDeploymentFailed, state["api"].Status)
+		assert.Equal(t, "api-r2", state["api"].Release)
+		require.NotNil(t, state["api"].DeployedAt)
+		assert.True(t, state["api"].DeployedAt.Equal(base.Add(time.Hour)))
+		assert.Equal(t, models.HistoricalDeploymentNotDeployed, state["worker"].Status)
+		assert.Equal(t, models.HistoricalDeploymentProgressing, state["cron"].Status)
+	})
+
+	t.Run("after the rollback", func(t *testing.T) {
+		state := stateAt(t, 3*time.Hour)
+		assert.Equal(t, models.HistoricalDeploymentReady, state["api"].Status)
+		assert.Equal(t, "api-r1", state["api"].Release)
+		assert.Equal(t, "worker-r1", state["worker"].Release)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		_, err := svc.GetProjectDeploymentState(ctx, testNamespace, "missing", base)
+		require.ErrorIs(t, err, ErrProjectNotFound)
+	})
+}
@@ -5,8 +5,10 @@ package project
 
 import (
 	"context"
+	"time"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -20,4 +22,6 @@ type Service interface {
 	ListProjects(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Project], error)
 	GetProject(ctx context.Context, namespaceName, projectName string) (*openchoreov1alpha1.Project, error)
 	DeleteProject(ctx context.Context, namespaceName, projectName string) error
+	GetProjectDeliveryMetrics(ctx context.Context, namespaceName, projectName string, opts models.DeliveryMetricsRequest) (*models.ProjectDeliveryMetricsResponse, error)
+	GetProjectDeploymentState(ctx context.Context, namespaceName, projectName string, at time.Time) (*models.ProjectDeploymentStateResponse, error)
 }
@@ -5,10 +5,13 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	mock "github.com/stretchr/testify/mock"
 
 	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 
+	time "time"
+
 	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
@@ -193,6 +196,128 @@ func (_c *MockService_GetProject_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// GetProjectDeliveryMetrics provides a mock function with given fields: ctx, namespaceName, projectName, opts
+func (_m *MockService) GetProjectDeliveryMetrics(ctx context.Context, namespaceName string, projectName string, opts models.DeliveryMetricsRequest) (*models.ProjectDeliveryMetricsResponse, error) {
+	ret := _m.Called(ctx, namespaceName, projectName, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectDeliveryMetrics")
+	}
+
+	var r0 *models.ProjectDeliveryMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.DeliveryMetricsRequest) (*models.ProjectDeliveryMetricsResponse, error)); ok {
+		return rf(ctx, namespaceName, projectName, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.DeliveryMetricsRequest) *models.ProjectDeliveryMetricsResponse); ok {
+		r0 = rf(ctx, namespaceName, projectName, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProjectDeliveryMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, models.DeliveryMetricsRequest) error); ok {
+		r1 = rf(ctx, namespaceName, projectName, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetProjectDeliveryMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectDeliveryMetrics'
+type MockService_GetProjectDeliveryMetrics_Call struct {
+	*mock.Call
+}
+
+// GetProjectDeliveryMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - opts models.DeliveryMetricsRequest
+func (_e *MockService_Expecter) GetProjectDeliveryMetrics(ctx interface{}, namespaceName interface{}, projectName interface{}, opts interface{}) *MockService_GetProjectDeliveryMetrics_Call {
+	return &MockService_GetProjectDeliveryMetrics_Call{Call: _e.mock.On("GetProjectDeliveryMetrics", ctx, namespaceName, projectName, opts)}
+}
+
+func (_c *MockService_GetProjectDeliveryMetrics_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, opts models.DeliveryMetricsRequest)) *MockService_GetProjectDeliveryMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(models.DeliveryMetricsRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_GetProjectDeliveryMetrics_Call) Return(_a0 *models.ProjectDeliveryMetricsResponse, _a1 error) *MockService_GetProjectDeliveryMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetProjectDeliveryMetrics_Call) RunAndReturn(run func(context.Context, string, string, models.DeliveryMetricsRequest) (*models.ProjectDeliveryMetricsResponse, error)) *MockService_GetProjectDeliveryMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectDeploymentState provides a mock function with given fields: ctx, namespaceName, projectName, at
+func (_m *MockService) GetProjectDeploymentState(ctx context.Context, namespaceName string, projectName string, at time.Time) (*models.ProjectDeploymentStateResponse, error) {
+	ret := _m.Called(ctx, namespaceName, projectName, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectDeploymentState")
+	}
+
+	var r0 *models.ProjectDeploymentStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*models.ProjectDeploymentStateResponse, error)); ok {
+		return rf(ctx, namespaceName, projectName, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *models.ProjectDeploymentStateResponse); ok {
+		r0 = rf(ctx, namespaceName, projectName, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProjectDeploymentStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, namespaceName, projectName, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetProjectDeploymentState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectDeploymentState'
+type MockService_GetProjectDeploymentState_Call struct {
+	*mock.Call
+}
+
+// GetProjectDeploymentState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - at time.Time
+func (_e *MockService_Expecter) GetProjectDeploymentState(ctx interface{}, namespaceName interface{}, projectName interface{}, at interface{}) *MockService_GetProjectDeploymentState_Call {
+	return &MockService_GetProjectDeploymentState_Call{Call: _e.mock.On("GetProjectDeploymentState", ctx, namespaceName, projectName, at)}
+}
+
+func (_c *MockService_GetProjectDeploymentState_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, at time.Time)) *MockService_GetProjectDeploymentState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_GetProjectDeploymentState_Call) Return(_a0 *models.ProjectDeploymentStateResponse, _a1 error) *MockService_GetProjectDeploymentState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetProjectDeploymentState_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (*models.ProjectDeploymentStateResponse, error)) *MockService_GetProjectDeploymentState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListProjects provides a mock function with given fields: ctx, namespaceName, opts
 func (_m *MockService) ListProjects(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[v1alpha1.Project], error) {
 	ret := _m.Called(ctx, namespaceName, opts)
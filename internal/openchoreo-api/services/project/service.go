@@ -113,6 +113,11 @@ func (s *projectService) UpdateProject(ctx context.Context, namespaceName string
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
 
+	// A uid in the request pins the update to that instance of the resource
+	if project.UID != "" && project.UID != existing.UID {
+		return nil, services.ErrUIDMismatch
+	}
+
 	// Clear status from user input — status is server-managed
 	project.Status = openchoreov1alpha1.ProjectStatus{}
 
@@ -5,12 +5,15 @@ package project
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -54,11 +57,21 @@ func (s *projectServiceWithAuthz) UpdateProject(ctx context.Context, namespaceNa
 		ResourceID:   project.Name,
 		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: project.Name},
 	}); err != nil {
+		// Updating a missing project creates it, which the create permission authorizes.
+		if errors.Is(err, services.ErrForbidden) && project.UID == "" && s.isProjectMissing(ctx, namespaceName, project.Name) {
+			return nil, ErrProjectNotFound
+		}
 		return nil, err
 	}
 	return s.internal.UpdateProject(ctx, namespaceName, project)
 }
 
+// isProjectMissing reports whether the project does not exist.
+func (s *projectServiceWithAuthz) isProjectMissing(ctx context.Context, namespaceName, name string) bool {
+	_, err := s.internal.GetProject(ctx, namespaceName, name)
+	return errors.Is(err, ErrProjectNotFound)
+}
+
 func (s *projectServiceWithAuthz) ListProjects(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Project], error) {
 	return services.FilteredList(ctx, opts, s.authz,
 		func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Project], error) {
@@ -98,3 +111,27 @@ func (s *projectServiceWithAuthz) DeleteProject(ctx context.Context, namespaceNa
 	}
 	return s.internal.DeleteProject(ctx, namespaceName, projectName)
 }
+
+func (s *projectServiceWithAuthz) GetProjectDeliveryMetrics(ctx context.Context, namespaceName, projectName string, opts models.DeliveryMetricsRequest) (*models.ProjectDeliveryMetricsResponse, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewProject,
+		ResourceType: resourceTypeProject,
+		ResourceID:   projectName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetProjectDeliveryMetrics(ctx, namespaceName, projectName, opts)
+}
+
+func (s *projectServiceWithAuthz) GetProjectDeploymentState(ctx context.Context, namespaceName, projectName string, at time.Time) (*models.ProjectDeploymentStateResponse, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewProject,
+		ResourceType: resourceTypeProject,
+		ResourceID:   projectName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetProjectDeploymentState(ctx, namespaceName, projectName, at)
+}
@@ -5,6 +5,7 @@ package project
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project/mocks"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
@@ -71,13 +73,23 @@ func TestUpdateProject_AuthzCheck(t *testing.T) {
 			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "my-project"})
 	})
 
-	t.Run("denied", func(t *testing.T) {
+	t.Run("denied on existing", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
 		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetProject", mock.Anything, "ns-1", "my-project").Return(project, nil)
 		svc := newProjectAuthzSvc(pdp, mockSvc)
 		_, err := svc.UpdateProject(testutil.AuthzContext(), "ns-1", project)
 		require.ErrorIs(t, err, services.ErrForbidden)
 	})
+
+	t.Run("denied on missing reports not found", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetProject", mock.Anything, "ns-1", "my-project").Return(nil, ErrProjectNotFound)
+		svc := newProjectAuthzSvc(pdp, mockSvc)
+		_, err := svc.UpdateProject(testutil.AuthzContext(), "ns-1", project)
+		require.ErrorIs(t, err, ErrProjectNotFound)
+	})
 }
 
 func TestGetProject_AuthzCheck(t *testing.T) {
@@ -158,3 +170,54 @@ func TestListProjects_AuthzCheck(t *testing.T) {
 		require.Empty(t, result.Items)
 	})
 }
+
+func TestGetProjectDeliveryMetrics_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		expected := &models.ProjectDeliveryMetricsResponse{Project: "my-project"}
+		mockSvc.On("GetProjectDeliveryMetrics", mock.Anything, "ns-1", "my-project", models.DeliveryMetricsRequest{}).
+			Return(expected, nil)
+		svc := newProjectAuthzSvc(pdp, mockSvc)
+		result, err := svc.GetProjectDeliveryMetrics(testutil.AuthzContext(), "ns-1", "my-project", models.DeliveryMetricsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "project:view", "project", "my-project",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "my-project"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		svc := newProjectAuthzSvc(pdp, mockSvc)
+		_, err := svc.GetProjectDeliveryMetrics(testutil.AuthzContext(), "ns-1", "my-project", models.DeliveryMetricsRequest{})
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestGetProjectDeploymentState_AuthzCheck(t *testing.T) {
+	at := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		expected := &models.ProjectDeploymentStateResponse{Project: "my-project", At: at}
+		mockSvc.On("GetProjectDeploymentState", mock.Anything, "ns-1", "my-project", at).Return(expected, nil)
+		svc := newProjectAuthzSvc(pdp, mockSvc)
+		result, err := svc.GetProjectDeploymentState(testutil.AuthzContext(), "ns-1", "my-project", at)
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "project:view", "project", "my-project",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "my-project"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		svc := newProjectAuthzSvc(pdp, mockSvc)
+		_, err := svc.GetProjectDeploymentState(testutil.AuthzContext(), "ns-1", "my-project", at)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -177,6 +177,18 @@ func TestUpdateProject(t *testing.T) {
 		_, err := svc.UpdateProject(ctx, testNamespace, proj)
 		require.ErrorIs(t, err, ErrProjectNotFound)
 	})
+
+	t.Run("uid mismatch", func(t *testing.T) {
+		existing := testutil.NewProject(testNamespace, testProjectName)
+		existing.UID = "current-uid"
+		svc := newService(t, existing)
+		proj := &openchoreov1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: testProjectName, UID: "old-uid"},
+		}
+
+		_, err := svc.UpdateProject(ctx, testNamespace, proj)
+		require.ErrorIs(t, err, services.ErrUIDMismatch)
+	})
 }
 
 func TestListProjects(t *testing.T) {
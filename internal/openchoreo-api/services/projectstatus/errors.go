@@ -0,0 +1,8 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectstatus
+
+import "errors"
+
+var ErrProjectNotFound = errors.New("project not found")
@@ -0,0 +1,17 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectstatus
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+// Service defines the project deployment status service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	GetProjectStatus(ctx context.Context, namespaceName, projectName string) (*statusmodel.ProjectStatus, error)
+	ListProjectSummaries(ctx context.Context, namespaceName string) ([]statusmodel.ProjectSummary, error)
+}
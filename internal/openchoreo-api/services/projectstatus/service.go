@@ -0,0 +1,79 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectstatus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+// projectStatusService serves project deployment status without authorization checks.
+type projectStatusService struct {
+	k8sClient client.Client
+	reader    statusmodel.Reader
+	logger    *slog.Logger
+}
+
+var _ Service = (*projectStatusService)(nil)
+
+// NewService creates a new project status service without authorization.
+// When reader is nil the status model is disabled, and every request builds the matrix
+// from live LIST calls instead.
+func NewService(k8sClient client.Client, reader statusmodel.Reader, logger *slog.Logger) Service {
+	return &projectStatusService{
+		k8sClient: k8sClient,
+		reader:    reader,
+		logger:    logger,
+	}
+}
+
+func (s *projectStatusService) GetProjectStatus(ctx context.Context, namespaceName, projectName string) (*statusmodel.ProjectStatus, error) {
+	s.logger.Debug("Getting project status", "namespace", namespaceName, "project", projectName)
+
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, project); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrProjectNotFound
+		}
+		s.logger.Error("Failed to get project", "error", err)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	reader, err := s.readerFor(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	return reader.ProjectStatus(namespaceName, projectName), nil
+}
+
+func (s *projectStatusService) ListProjectSummaries(ctx context.Context, namespaceName string) ([]statusmodel.ProjectSummary, error) {
+	s.logger.Debug("Listing project summaries", "namespace", namespaceName)
+
+	reader, err := s.readerFor(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	return reader.NamespaceSummaries(namespaceName), nil
+}
+
+// readerFor returns the status model, or a one-off model of the namespace built from
+// live LIST calls when the status model is disabled.
+func (s *projectStatusService) readerFor(ctx context.Context, namespaceName string) (statusmodel.Reader, error) {
+	if s.reader != nil {
+		return s.reader, nil
+	}
+
+	store, err := statusmodel.List(ctx, s.k8sClient, namespaceName)
+	if err != nil {
+		s.logger.Error("Failed to build project status", "error", err)
+		return nil, err
+	}
+	return store, nil
+}
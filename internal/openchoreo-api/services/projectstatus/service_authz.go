@@ -0,0 +1,77 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectstatus
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+const (
+	resourceTypeProject = "project"
+)
+
+// projectStatusServiceWithAuthz wraps a Service and adds authorization checks.
+type projectStatusServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*projectStatusServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a project status service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, reader statusmodel.Reader, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &projectStatusServiceWithAuthz{
+		internal: NewService(k8sClient, reader, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+func (s *projectStatusServiceWithAuthz) GetProjectStatus(ctx context.Context, namespaceName, projectName string) (*statusmodel.ProjectStatus, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewProject,
+		ResourceType: resourceTypeProject,
+		ResourceID:   projectName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetProjectStatus(ctx, namespaceName, projectName)
+}
+
+// ListProjectSummaries returns the summaries of the projects the caller can view.
+func (s *projectStatusServiceWithAuthz) ListProjectSummaries(ctx context.Context, namespaceName string) ([]statusmodel.ProjectSummary, error) {
+	summaries, err := s.internal.ListProjectSummaries(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]services.CheckRequest, len(summaries))
+	for i, summary := range summaries {
+		requests[i] = services.CheckRequest{
+			Action:       authz.ActionViewProject,
+			ResourceType: resourceTypeProject,
+			ResourceID:   summary.Project,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: summary.Project},
+		}
+	}
+	allowed, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]statusmodel.ProjectSummary, 0, len(summaries))
+	for i, summary := range summaries {
+		if i < len(allowed) && allowed[i] {
+			out = append(out, summary)
+		}
+	}
+	return out, nil
+}
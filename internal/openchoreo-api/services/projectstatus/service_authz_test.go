@@ -0,0 +1,76 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectstatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectstatus/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+func newProjectStatusAuthzSvc(pdp authzcore.PDP, internal Service) Service {
+	return &projectStatusServiceWithAuthz{
+		internal: internal,
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestGetProjectStatus_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		expected := &statusmodel.ProjectStatus{Project: "my-project"}
+		mockSvc.On("GetProjectStatus", mock.Anything, "ns-1", "my-project").Return(expected, nil)
+		svc := newProjectStatusAuthzSvc(pdp, mockSvc)
+		result, err := svc.GetProjectStatus(testutil.AuthzContext(), "ns-1", "my-project")
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "project:view", "project", "my-project",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "my-project"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := mocks.NewMockService(t)
+		svc := newProjectStatusAuthzSvc(testutil.DenyPDP(), mockSvc)
+		_, err := svc.GetProjectStatus(testutil.AuthzContext(), "ns-1", "my-project")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// projectPDP allows viewing a single project.
+type projectPDP struct {
+	*testutil.CapturingPDP
+	project string
+}
+
+func (p projectPDP) BatchEvaluate(_ context.Context, req *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
+	resp := &authzcore.BatchEvaluateResponse{}
+	for _, r := range req.Requests {
+		resp.Decisions = append(resp.Decisions, authzcore.Decision{Decision: r.Resource.Hierarchy.Project == p.project})
+	}
+	return resp, nil
+}
+
+func TestListProjectSummaries_AuthzFilter(t *testing.T) {
+	mockSvc := mocks.NewMockService(t)
+	mockSvc.On("ListProjectSummaries", mock.Anything, "ns-1").Return([]statusmodel.ProjectSummary{
+		{Project: "hidden"},
+		{Project: "visible"},
+	}, nil)
+	svc := newProjectStatusAuthzSvc(projectPDP{CapturingPDP: testutil.AllowPDP(), project: "visible"}, mockSvc)
+
+	result, err := svc.ListProjectSummaries(testutil.AuthzContext(), "ns-1")
+	require.NoError(t, err)
+	assert.Equal(t, []statusmodel.ProjectSummary{{Project: "visible"}}, result)
+}
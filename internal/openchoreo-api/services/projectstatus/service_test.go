@@ -0,0 +1,108 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectstatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+const (
+	testNamespace   = "test-ns"
+	testProjectName = "test-project"
+)
+
+func testBinding(component, env string) *openchoreov1alpha1.ReleaseBinding {
+	return &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: component + "-" + env, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner: openchoreov1alpha1.ReleaseBindingOwner{
+				ProjectName:   testProjectName,
+				ComponentName: component,
+			},
+			Environment: env,
+		},
+		Status: openchoreov1alpha1.ReleaseBindingStatus{
+			Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Ready"}},
+		},
+	}
+}
+
+// staticReader is a Reader that serves a fixed matrix.
+type staticReader struct {
+	status *statusmodel.ProjectStatus
+}
+
+func (r staticReader) ProjectStatus(_, _ string) *statusmodel.ProjectStatus { return r.status }
+
+func (r staticReader) ProjectStatuses(_ string) []*statusmodel.ProjectStatus {
+	return []*statusmodel.ProjectStatus{r.status}
+}
+
+func (r staticReader) NamespaceSummaries(_ string) []statusmodel.ProjectSummary {
+	return []statusmodel.ProjectSummary{r.status.ProjectSummary()}
+}
+
+func TestGetProjectStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("builds the matrix from live objects without a reader", func(t *testing.T) {
+		k8sClient := testutil.NewFakeClient(
+			testutil.NewProject(testNamespace, testProjectName),
+			testutil.NewComponent(testNamespace, testProjectName, "api"),
+			testutil.NewComponent(testNamespace, testProjectName, "web"),
+			testBinding("api", "dev"),
+		)
+		svc := NewService(k8sClient, nil, testutil.TestLogger())
+
+		got, err := svc.GetProjectStatus(ctx, testNamespace, testProjectName)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dev"}, got.Environments)
+		require.Len(t, got.Components, 2)
+		assert.Equal(t, statusmodel.StatusReady, got.Components[0].Environments["dev"].Status)
+		assert.Equal(t, statusmodel.StatusCounts{Ready: 1, NotDeployed: 1}, got.Summary["dev"])
+	})
+
+	t.Run("serves from the reader when enabled", func(t *testing.T) {
+		want := &statusmodel.ProjectStatus{Namespace: testNamespace, Project: testProjectName}
+		k8sClient := testutil.NewFakeClient(testutil.NewProject(testNamespace, testProjectName))
+		svc := NewService(k8sClient, staticReader{status: want}, testutil.TestLogger())
+
+		got, err := svc.GetProjectStatus(ctx, testNamespace, testProjectName)
+		require.NoError(t, err)
+		assert.Same(t, want, got)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		svc := NewService(testutil.NewFakeClient(), nil, testutil.TestLogger())
+		_, err := svc.GetProjectStatus(ctx, testNamespace, "missing")
+		require.ErrorIs(t, err, ErrProjectNotFound)
+	})
+}
+
+func TestListProjectSummaries(t *testing.T) {
+	k8sClient := testutil.NewFakeClient(
+		testutil.NewComponent(testNamespace, testProjectName, "api"),
+		testutil.NewComponent(testNamespace, "other", "worker"),
+		testutil.NewComponent("other-ns", testProjectName, "ignored"),
+		testBinding("api", "dev"),
+	)
+	svc := NewService(k8sClient, nil, testutil.TestLogger())
+
+	got, err := svc.ListProjectSummaries(context.Background(), testNamespace)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "other", got[0].Project)
+	assert.Equal(t, testProjectName, got[1].Project)
+	assert.Equal(t, 1, got[1].Components)
+	assert.Equal(t, statusmodel.StatusCounts{Ready: 1}, got[1].Summary["dev"])
+}
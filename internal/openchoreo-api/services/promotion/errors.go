@@ -0,0 +1,13 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import "errors"
+
+var (
+	ErrProjectNotFound            = errors.New("project not found")
+	ErrComponentNotFound          = errors.New("component not found")
+	ErrDeploymentPipelineNotFound = errors.New("deployment pipeline not found")
+	ErrSourceNotDeployed          = errors.New("component has no release in the source environment")
+)
@@ -0,0 +1,30 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Service defines the component promotion service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// Promote promotes the release bound in sourceEnvironment to targetEnvironments, which
+	// must be targets of the promotion path of sourceEnvironment. All targets of the path are
+	// promoted when targetEnvironments is empty. Targets are gated and promoted
+	// independently; a blocked or failed target is reported in the returned status and does
+	// not hold back the others.
+	Promote(ctx context.Context, namespaceName, componentName, sourceEnvironment string, targetEnvironments []string) (*models.PromotionStatus, error)
+	// PromoteProject promotes the components of a project, or the selected ones, like
+	// Promote. Every component is resolved before any of them is promoted, and components
+	// without a release in the source environment are skipped unless they are selected.
+	// With RollbackOnFailure, the targets changed by the request are restored to their
+	// previous release when any target is blocked or fails.
+	PromoteProject(ctx context.Context, namespaceName, projectName string, req *models.ProjectPromotionRequest) (*models.ProjectPromotionStatus, error)
+	// GetPromotionStatus reports the promotion status of every target of the promotion path
+	// of sourceEnvironment against the release bound in sourceEnvironment.
+	GetPromotionStatus(ctx context.Context, namespaceName, componentName, sourceEnvironment string) (*models.PromotionStatus, error)
+}
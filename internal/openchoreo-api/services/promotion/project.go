@@ -0,0 +1,214 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// componentPromotion is the promotion of one component of a project promotion.
+type componentPromotion struct {
+	plan    *plan
+	targets []target
+}
+
+func (s *promotionService) PromoteProject(ctx context.Context, namespaceName, projectName string, req *models.ProjectPromotionRequest) (*models.ProjectPromotionStatus, error) {
+	components, err := projectComponents(ctx, s.k8sClient, namespaceName, projectName, req.Components)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every component is resolved before any of them is promoted, so that an invalid
+	// request does not leave the project partially promoted.
+	promotions := make([]componentPromotion, 0, len(components))
+	for _, component := range components {
+		p, err := s.resolve(ctx, namespaceName, component, req.SourceEnvironment)
+		if err != nil {
+			return nil, fmt.Errorf("component %q: %w", component, err)
+		}
+		if p.release() == "" && len(req.Components) > 0 {
+			return nil, fmt.Errorf("component %q: %w", component, ErrSourceNotDeployed)
+		}
+		selected, err := p.selectTargets(req.TargetEnvironments)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, componentPromotion{plan: p, targets: selected})
+	}
+
+	now := s.now()
+	status := &models.ProjectPromotionStatus{
+		Project:           projectName,
+		SourceEnvironment: req.SourceEnvironment,
+		Components:        make([]models.ComponentPromotionResult, 0, len(promotions)),
+	}
+	var all []models.PromotionTarget
+	for _, cp := range promotions {
+		result := models.ComponentPromotionResult{
+			Component: cp.plan.component,
+			Release:   cp.plan.release(),
+			Targets:   []models.PromotionTarget{},
+		}
+		if result.Release == "" {
+			result.Phase = models.PromotionPhaseSkipped
+			result.Message = fmt.Sprintf("component has no release in %s", req.SourceEnvironment)
+		} else {
+			result.Targets = s.promoteTargets(ctx, cp.plan, cp.targets, now)
+			result.Phase = aggregatePhase(result.Targets)
+			all = append(all, result.Targets...)
+		}
+		status.Components = append(status.Components, result)
+	}
+	status.Phase = aggregatePhase(all)
+
+	if req.RollbackOnFailure &&
+		(status.Phase == models.PromotionPhaseFailed || status.Phase == models.PromotionPhasePartiallyFailed) {
+		s.rollback(ctx, promotions, status)
+	}
+
+	s.logger.Info("Promoted project",
+		"namespace", namespaceName,
+		"project", projectName,
+		"sourceEnvironment", req.SourceEnvironment,
+		"components", len(status.Components),
+		"phase", status.Phase,
+		"rolledBack", status.RolledBack,
+	)
+	return status, nil
+}
+
+// rollback restores the previous release of every target the promotion changed. Release
+// bindings created by the promotion are deleted. The promotion is only reported as rolled
+// back when every target was restored.
+func (s *promotionService) rollback(ctx context.Context, promotions []componentPromotion, status *models.ProjectPromotionStatus) {
+	restored := true
+	for i, cp := range promotions {
+		result := &status.Components[i]
+		if result.Phase == models.PromotionPhaseSkipped {
+			continue
+		}
+		var rolledBack, failed bool
+		for j, t := range cp.targets {
+			out := &result.Targets[j]
+			if !changedByPromotion(cp.plan, t, out) {
+				continue
+			}
+			if err := s.rollbackTarget(ctx, cp.plan, t, out.ReleaseBinding); err != nil {
+				s.logger.Warn("Failed to roll back promotion of target environment",
+					"namespace", cp.plan.namespace,
+					"component", cp.plan.component,
+					"environment", t.environment,
+					"error", err,
+				)
+				out.Phase = models.PromotionPhaseFailed
+				out.Message = fmt.Sprintf("rollback failed: %v", err)
+				failed = true
+				continue
+			}
+			*out = rolledBackTarget(t)
+			rolledBack = true
+		}
+		switch {
+		case failed:
+			result.Phase = models.PromotionPhaseFailed
+			restored = false
+		case rolledBack:
+			result.Phase = models.PromotionPhaseRolledBack
+		}
+	}
+	if restored {
+		status.RolledBack = true
+		status.Phase = models.PromotionPhaseRolledBack
+	}
+}
+
+// changedByPromotion reports whether the promotion changed the release binding of a target,
+// as opposed to a target that was already on the source release or was not promoted.
+func changedByPromotion(p *plan, t target, out *models.PromotionTarget) bool {
+	if out.Phase != models.PromotionPhaseProgressing || out.ReleaseBinding == "" {
+		return false
+	}
+	return t.binding == nil || t.binding.Spec.ReleaseName != p.release()
+}
+
+// rollbackTarget restores the release binding of a target to its state before the promotion.
+func (s *promotionService) rollbackTarget(ctx context.Context, p *plan, t target, bindingName string) error {
+	if t.binding == nil {
+		return s.bindings.DeleteReleaseBinding(ctx, p.namespace, bindingName)
+	}
+	current, err := s.bindings.GetReleaseBinding(ctx, p.namespace, bindingName)
+	if err != nil {
+		return err
+	}
+	update := current.DeepCopy()
+	update.Spec.ReleaseName = t.binding.Spec.ReleaseName
+	_, err = s.bindings.UpdateReleaseBinding(ctx, p.namespace, update)
+	return err
+}
+
+// rolledBackTarget reports a target restored to its state before the promotion.
+func rolledBackTarget(t target) models.PromotionTarget {
+	if t.binding == nil {
+		return models.PromotionTarget{
+			Environment: t.environment,
+			Phase:       models.PromotionPhaseRolledBack,
+			Message:     "release binding created by the promotion was deleted",
+		}
+	}
+	return models.PromotionTarget{
+		Environment:    t.environment,
+		ReleaseBinding: t.binding.Name,
+		Release:        t.binding.Spec.ReleaseName,
+		Phase:          models.PromotionPhaseRolledBack,
+		Message:        fmt.Sprintf("restored release %s", t.binding.Spec.ReleaseName),
+	}
+}
+
+// projectComponents returns the components of a project a promotion applies to: the
+// selected components, which must belong to the project, or all of its components.
+func projectComponents(ctx context.Context, k8sClient client.Client, namespaceName, projectName string, selected []string) ([]string, error) {
+	project := &openchoreov1alpha1.Project{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, project); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var list openchoreov1alpha1.ComponentList
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+	var components []string
+	for _, c := range list.Items {
+		if c.Spec.Owner.ProjectName == projectName {
+			components = append(components, c.Name)
+		}
+	}
+	slices.Sort(components)
+	if len(selected) == 0 {
+		return components, nil
+	}
+
+	out := make([]string, 0, len(selected))
+	for _, name := range selected {
+		if !slices.Contains(components, name) {
+			return nil, &services.ValidationError{
+				Msg: fmt.Sprintf("component %q is not part of project %q", name, projectName),
+			}
+		}
+		if !slices.Contains(out, name) {
+			out = append(out, name)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,385 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/deploymentlock"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	releasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+// promotionService handles component promotions without authorization checks.
+type promotionService struct {
+	k8sClient client.Client
+	bindings  releasebindingsvc.Service
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+var _ Service = (*promotionService)(nil)
+
+// NewService creates a new promotion service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &promotionService{
+		k8sClient: k8sClient,
+		bindings:  releasebindingsvc.NewService(k8sClient, nil, logger),
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// plan is a promotion of a component resolved against its project's deployment pipeline.
+type plan struct {
+	namespace string
+	component string
+	project   string
+	sourceEnv string
+	// source is the release binding of the source environment, nil when there is none.
+	source  *openchoreov1alpha1.ReleaseBinding
+	targets []target
+}
+
+// target is one target environment of the promotion path.
+type target struct {
+	environment string
+	gate        *openchoreov1alpha1.PromotionGate
+	// binding is the component's release binding of the environment, nil when there is none.
+	binding *openchoreov1alpha1.ReleaseBinding
+}
+
+// release returns the release bound in the source environment.
+func (p *plan) release() string {
+	if p.source == nil {
+		return ""
+	}
+	return p.source.Spec.ReleaseName
+}
+
+func (s *promotionService) Promote(ctx context.Context, namespaceName, componentName, sourceEnvironment string, targetEnvironments []string) (*models.PromotionStatus, error) {
+	p, err := s.resolve(ctx, namespaceName, componentName, sourceEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	if p.release() == "" {
+		return nil, ErrSourceNotDeployed
+	}
+
+	selected, err := p.selectTargets(targetEnvironments)
+	if err != nil {
+		return nil, err
+	}
+
+	results := s.promoteTargets(ctx, p, selected, s.now())
+	status := &models.PromotionStatus{
+		Component:         componentName,
+		Project:           p.project,
+		SourceEnvironment: sourceEnvironment,
+		Release:           p.release(),
+		Phase:             aggregatePhase(results),
+		Targets:           results,
+	}
+	s.logger.Info("Promoted component",
+		"namespace", namespaceName,
+		"component", componentName,
+		"sourceEnvironment", sourceEnvironment,
+		"release", status.Release,
+		"phase", status.Phase,
+	)
+	return status, nil
+}
+
+func (s *promotionService) GetPromotionStatus(ctx context.Context, namespaceName, componentName, sourceEnvironment string) (*models.PromotionStatus, error) {
+	p, err := s.resolve(ctx, namespaceName, componentName, sourceEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	results := make([]models.PromotionTarget, len(p.targets))
+	for i, t := range p.targets {
+		results[i] = targetStatus(p, t, now)
+	}
+	return &models.PromotionStatus{
+		Component:         componentName,
+		Project:           p.project,
+		SourceEnvironment: sourceEnvironment,
+		Release:           p.release(),
+		Phase:             aggregatePhase(results),
+		Targets:           results,
+	}, nil
+}
+
+// resolve looks up the promotion path of sourceEnvironment in the deployment pipeline of
+// the component's project, and the component's release bindings of the environments on it.
+func (s *promotionService) resolve(ctx context.Context, namespaceName, componentName, sourceEnvironment string) (*plan, error) {
+	component := &openchoreov1alpha1.Component{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: componentName}, component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrComponentNotFound
+		}
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+	projectName := component.Spec.Owner.ProjectName
+
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, project); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, fmt.Errorf("%w: project %q not found", ErrDeploymentPipelineNotFound, projectName)
+		}
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	pipelineName := project.Spec.DeploymentPipelineRef.Name
+	if pipelineName == "" {
+		return nil, fmt.Errorf("%w: project %q has no deployment pipeline", ErrDeploymentPipelineNotFound, projectName)
+	}
+
+	pipeline := &openchoreov1alpha1.DeploymentPipeline{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: pipelineName}, pipeline); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrDeploymentPipelineNotFound
+		}
+		return nil, fmt.Errorf("failed to get deployment pipeline: %w", err)
+	}
+
+	idx := slices.IndexFunc(pipeline.Spec.PromotionPaths, func(path openchoreov1alpha1.PromotionPath) bool {
+		return path.SourceEnvironmentRef.Name == sourceEnvironment
+	})
+	if idx < 0 {
+		return nil, &services.ValidationError{
+			Msg: fmt.Sprintf("environment %q is not the source of a promotion path in deployment pipeline %q", sourceEnvironment, pipelineName),
+		}
+	}
+	path := pipeline.Spec.PromotionPaths[idx]
+
+	var rbList openchoreov1alpha1.ReleaseBindingList
+	if err := s.k8sClient.List(ctx, &rbList, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	byEnv := make(map[string]*openchoreov1alpha1.ReleaseBinding)
+	for i := range rbList.Items {
+		rb := &rbList.Items[i]
+		if rb.Spec.Owner.ComponentName == componentName {
+			byEnv[rb.Spec.Environment] = rb
+		}
+	}
+
+	p := &plan{
+		namespace: namespaceName,
+		component: componentName,
+		project:   projectName,
+		sourceEnv: sourceEnvironment,
+		source:    byEnv[sourceEnvironment],
+		targets:   make([]target, 0, len(path.TargetEnvironmentRefs)),
+	}
+	for _, ref := range path.TargetEnvironmentRefs {
+		p.targets = append(p.targets, target{environment: ref.Name, gate: ref.Gate, binding: byEnv[ref.Name]})
+	}
+	return p, nil
+}
+
+// selectTargets returns the targets named in environments, or all targets when it is empty.
+func (p *plan) selectTargets(environments []string) ([]target, error) {
+	if len(environments) == 0 {
+		return p.targets, nil
+	}
+	selected := make([]target, 0, len(environments))
+	for _, env := range environments {
+		idx := slices.IndexFunc(p.targets, func(t target) bool { return t.environment == env })
+		if idx < 0 {
+			return nil, &services.ValidationError{
+				Msg: fmt.Sprintf("environment %q is not a promotion target of %q", env, p.sourceEnv),
+			}
+		}
+		if !slices.ContainsFunc(selected, func(t target) bool { return t.environment == env }) {
+			selected = append(selected, p.targets[idx])
+		}
+	}
+	return selected, nil
+}
+
+// promoteTargets promotes the source release to the given targets. Targets are independent
+// of each other, so they are promoted in parallel and a failure of one target does not roll
+// back the others.
+func (s *promotionService) promoteTargets(ctx context.Context, p *plan, targets []target, now time.Time) []models.PromotionTarget {
+	results := make([]models.PromotionTarget, len(targets))
+	var wg sync.WaitGroup
+	for i, t := range targets {
+		wg.Go(func() {
+			results[i] = s.promoteTarget(ctx, p, t, now)
+		})
+	}
+	wg.Wait()
+	return results
+}
+
+// promoteTarget binds the source release in a single target environment, creating the
+// target's release binding when it does not exist yet.
+func (s *promotionService) promoteTarget(ctx context.Context, p *plan, t target, now time.Time) models.PromotionTarget {
+	release := p.release()
+	if t.binding != nil && t.binding.Spec.ReleaseName == release {
+		return targetStatus(p, t, now)
+	}
+	if reason := gateBlocked(t.gate, p.source, now); reason != "" {
+		return targetResult(t, models.PromotionPhaseBlocked, reason)
+	}
+
+	var (
+		promoted *openchoreov1alpha1.ReleaseBinding
+		err      error
+	)
+	if t.binding == nil {
+		promoted, err = s.bindings.CreateReleaseBinding(ctx, p.namespace, &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-%s", p.component, t.environment)},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner: openchoreov1alpha1.ReleaseBindingOwner{
+					ProjectName:   p.project,
+					ComponentName: p.component,
+				},
+				Environment: t.environment,
+				ReleaseName: release,
+			},
+		})
+	} else {
+		update := t.binding.DeepCopy()
+		update.Spec.ReleaseName = release
+		promoted, err = s.bindings.UpdateReleaseBinding(ctx, p.namespace, update)
+	}
+	if err != nil {
+		if lockedErr, ok := errors.AsType[*deploymentlock.LockedError](err); ok {
+			return targetResult(t, models.PromotionPhaseBlocked, lockedErr.Error())
+		}
+		if quotaErr, ok := errors.AsType[*controller.ProjectQuotaExceededError](err); ok {
+			return targetResult(t, models.PromotionPhaseBlocked, quotaErr.Error())
+		}
+		s.logger.Warn("Failed to promote component to target environment",
+			"namespace", p.namespace,
+			"component", p.component,
+			"environment", t.environment,
+			"error", err,
+		)
+		return targetResult(t, models.PromotionPhaseFailed, err.Error())
+	}
+
+	return models.PromotionTarget{
+		Environment:    t.environment,
+		ReleaseBinding: promoted.Name,
+		Release:        release,
+		Phase:          models.PromotionPhaseProgressing,
+		Message:        fmt.Sprintf("promoted from %s", p.sourceEnv),
+	}
+}
+
+// targetStatus reports the status of a target against the source release without changing it.
+func targetStatus(p *plan, t target, now time.Time) models.PromotionTarget {
+	out := models.PromotionTarget{Environment: t.environment, Phase: models.PromotionPhasePending}
+	if t.binding != nil {
+		out.ReleaseBinding = t.binding.Name
+		out.Release = t.binding.Spec.ReleaseName
+	}
+
+	release := p.release()
+	switch {
+	case release == "":
+		out.Message = fmt.Sprintf("component has no release in %s", p.sourceEnv)
+		return out
+	case t.binding == nil || t.binding.Spec.ReleaseName != release:
+		if reason := gateBlocked(t.gate, p.source, now); reason != "" {
+			out.Phase = models.PromotionPhaseBlocked
+			out.Message = reason
+		}
+		return out
+	}
+
+	st := statusmodel.BindingStatus(t.binding)
+	switch st.Status {
+	case statusmodel.StatusReady:
+		out.Phase = models.PromotionPhaseSucceeded
+	case statusmodel.StatusFailed:
+		out.Phase = models.PromotionPhaseFailed
+		out.Message = st.Message
+	case statusmodel.StatusUndeployed:
+		out.Message = "release binding is undeployed"
+	default:
+		out.Phase = models.PromotionPhaseProgressing
+		out.Message = st.Message
+	}
+	return out
+}
+
+// gateBlocked returns why gate does not allow promoting the release of source, or "" when
+// it does.
+func gateBlocked(gate *openchoreov1alpha1.PromotionGate, source *openchoreov1alpha1.ReleaseBinding, now time.Time) string {
+	if gate == nil || (!gate.RequireSourceReady && gate.SoakTime == nil) {
+		return ""
+	}
+	if source == nil {
+		return "source release binding not found"
+	}
+	st := statusmodel.BindingStatus(source)
+	if st.Status != statusmodel.StatusReady {
+		return fmt.Sprintf("source release binding %s is %s", source.Name, st.Status)
+	}
+	if gate.SoakTime != nil && st.LastTransitionTime != nil {
+		if remaining := st.LastTransitionTime.Add(gate.SoakTime.Duration).Sub(now); remaining > 0 {
+			return fmt.Sprintf("source release must soak for %s more", remaining.Round(time.Second))
+		}
+	}
+	return ""
+}
+
+// targetResult reports a target that was not promoted.
+func targetResult(t target, phase, message string) models.PromotionTarget {
+	out := models.PromotionTarget{
+		Environment: t.environment,
+		Phase:       phase,
+		Message:     message,
+	}
+	if t.binding != nil {
+		out.ReleaseBinding = t.binding.Name
+		out.Release = t.binding.Spec.ReleaseName
+	}
+	return out
+}
+
+// aggregatePhase summarizes the phases of the targets of a promotion.
+func aggregatePhase(targets []models.PromotionTarget) string {
+	var succeeded, unsuccessful, pending int
+	for _, t := range targets {
+		switch t.Phase {
+		case models.PromotionPhaseSucceeded:
+			succeeded++
+		case models.PromotionPhaseFailed, models.PromotionPhaseBlocked:
+			unsuccessful++
+		case models.PromotionPhasePending:
+			pending++
+		}
+	}
+	switch {
+	case succeeded == len(targets):
+		return models.PromotionPhaseSucceeded
+	case unsuccessful == len(targets):
+		return models.PromotionPhaseFailed
+	case unsuccessful > 0:
+		return models.PromotionPhasePartiallyFailed
+	case pending > 0:
+		return models.PromotionPhasePending
+	default:
+		return models.PromotionPhaseProgressing
+	}
+}
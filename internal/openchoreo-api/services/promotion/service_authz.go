@@ -0,0 +1,143 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	resourceTypeComponent      = "component"
+	resourceTypeReleaseBinding = "releasebinding"
+)
+
+// promotionServiceWithAuthz wraps a Service and adds authorization checks.
+type promotionServiceWithAuthz struct {
+	internal  Service
+	k8sClient client.Client
+	authz     *services.AuthzChecker
+}
+
+var _ Service = (*promotionServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a promotion service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &promotionServiceWithAuthz{
+		internal:  NewService(k8sClient, logger),
+		k8sClient: k8sClient,
+		authz:     services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// Promote requires the caller to be allowed to create or update the release binding of
+// every promoted target. A promotion is rejected as a whole when any target is denied.
+func (s *promotionServiceWithAuthz) Promote(ctx context.Context, namespaceName, componentName, sourceEnvironment string, targetEnvironments []string) (*models.PromotionStatus, error) {
+	// The current status resolves the project and the targets of the promotion path.
+	current, err := s.internal.GetPromotionStatus(ctx, namespaceName, componentName, sourceEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkAll(ctx, targetChecks(namespaceName, current, targetEnvironments, false)); err != nil {
+		return nil, err
+	}
+	return s.internal.Promote(ctx, namespaceName, componentName, sourceEnvironment, targetEnvironments)
+}
+
+// PromoteProject requires the caller to be allowed to promote every component of the
+// request like Promote, and to delete the release bindings a rollback may delete. The
+// request is rejected as a whole when any check is denied.
+func (s *promotionServiceWithAuthz) PromoteProject(ctx context.Context, namespaceName, projectName string, req *models.ProjectPromotionRequest) (*models.ProjectPromotionStatus, error) {
+	components, err := projectComponents(ctx, s.k8sClient, namespaceName, projectName, req.Components)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []services.CheckRequest
+	for _, component := range components {
+		current, err := s.internal.GetPromotionStatus(ctx, namespaceName, component, req.SourceEnvironment)
+		if err != nil {
+			return nil, fmt.Errorf("component %q: %w", component, err)
+		}
+		// Components without a source release are skipped and need no permission.
+		if current.Release == "" {
+			continue
+		}
+		requests = append(requests, targetChecks(namespaceName, current, req.TargetEnvironments, req.RollbackOnFailure)...)
+	}
+	if err := s.checkAll(ctx, requests); err != nil {
+		return nil, err
+	}
+	return s.internal.PromoteProject(ctx, namespaceName, projectName, req)
+}
+
+func (s *promotionServiceWithAuthz) GetPromotionStatus(ctx context.Context, namespaceName, componentName, sourceEnvironment string) (*models.PromotionStatus, error) {
+	status, err := s.internal.GetPromotionStatus(ctx, namespaceName, componentName, sourceEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewComponent,
+		ResourceType: resourceTypeComponent,
+		ResourceID:   componentName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: status.Project, Component: componentName},
+	}); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// targetChecks returns the checks for promoting a component to the selected targets of its
+// current promotion status. Targets without a release binding need the create permission,
+// and the delete permission as well when a rollback may delete the created binding.
+func targetChecks(namespaceName string, current *models.PromotionStatus, targetEnvironments []string, rollback bool) []services.CheckRequest {
+	hierarchy := authz.ResourceHierarchy{Namespace: namespaceName, Project: current.Project, Component: current.Component}
+	requests := make([]services.CheckRequest, 0, len(current.Targets))
+	for _, t := range current.Targets {
+		if len(targetEnvironments) > 0 && !slices.Contains(targetEnvironments, t.Environment) {
+			continue
+		}
+		check := services.CheckRequest{
+			Action:       authz.ActionUpdateReleaseBinding,
+			ResourceType: resourceTypeReleaseBinding,
+			ResourceID:   t.ReleaseBinding,
+			Hierarchy:    hierarchy,
+			Context: authz.Context{
+				Resource: authz.ResourceAttribute{Environment: services.FormatDualScopedResourceName(namespaceName, t.Environment, false)},
+			},
+		}
+		if t.ReleaseBinding != "" {
+			requests = append(requests, check)
+			continue
+		}
+		check.Action, check.ResourceID = authz.ActionCreateReleaseBinding, fmt.Sprintf("%s-%s", current.Component, t.Environment)
+		requests = append(requests, check)
+		if rollback {
+			check.Action = authz.ActionDeleteReleaseBinding
+			requests = append(requests, check)
+		}
+	}
+	return requests
+}
+
+// checkAll returns ErrForbidden unless every request is allowed.
+func (s *promotionServiceWithAuthz) checkAll(ctx context.Context, requests []services.CheckRequest) error {
+	allowed, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return err
+	}
+	if len(allowed) != len(requests) || slices.Contains(allowed, false) {
+		return services.ErrForbidden
+	}
+	return nil
+}
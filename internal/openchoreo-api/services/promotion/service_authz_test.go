@@ -0,0 +1,144 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+var compHierarchy = authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "my-proj", Component: "api"}
+
+func testStatus() *models.PromotionStatus {
+	return &models.PromotionStatus{
+		Component:         "api",
+		Project:           "my-proj",
+		SourceEnvironment: "staging",
+		Release:           "api-r2",
+		Phase:             models.PromotionPhasePending,
+		Targets: []models.PromotionTarget{
+			{Environment: "prod-us", ReleaseBinding: "api-prod-us", Release: "api-r1", Phase: models.PromotionPhasePending},
+			{Environment: "prod-eu", Phase: models.PromotionPhasePending},
+		},
+	}
+}
+
+func TestPromote_AuthzCheck(t *testing.T) {
+	t.Run("checks every selected target", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetPromotionStatus", mock.Anything, "ns-1", "api", "staging").Return(testStatus(), nil)
+		mockSvc.On("Promote", mock.Anything, "ns-1", "api", "staging", []string(nil)).Return(testStatus(), nil)
+		svc := &promotionServiceWithAuthz{internal: mockSvc, authz: testutil.NewTestAuthzChecker(pdp)}
+
+		_, err := svc.Promote(testutil.AuthzContext(), "ns-1", "api", "staging", nil)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:update", "releasebinding", "api-prod-us", compHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "releasebinding:create", "releasebinding", "api-prod-eu", compHierarchy)
+	})
+
+	t.Run("only the selected targets are checked", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetPromotionStatus", mock.Anything, "ns-1", "api", "staging").Return(testStatus(), nil)
+		mockSvc.On("Promote", mock.Anything, "ns-1", "api", "staging", []string{"prod-eu"}).Return(testStatus(), nil)
+		svc := &promotionServiceWithAuthz{internal: mockSvc, authz: testutil.NewTestAuthzChecker(pdp)}
+
+		_, err := svc.Promote(testutil.AuthzContext(), "ns-1", "api", "staging", []string{"prod-eu"})
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:create", "releasebinding", "api-prod-eu", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetPromotionStatus", mock.Anything, "ns-1", "api", "staging").Return(testStatus(), nil)
+		svc := &promotionServiceWithAuthz{internal: mockSvc, authz: testutil.NewTestAuthzChecker(pdp)}
+
+		_, err := svc.Promote(testutil.AuthzContext(), "ns-1", "api", "staging", nil)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestGetPromotionStatus_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetPromotionStatus", mock.Anything, "ns-1", "api", "staging").Return(testStatus(), nil)
+		svc := &promotionServiceWithAuthz{internal: mockSvc, authz: testutil.NewTestAuthzChecker(pdp)}
+
+		_, err := svc.GetPromotionStatus(testutil.AuthzContext(), "ns-1", "api", "staging")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:view", "component", "api", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetPromotionStatus", mock.Anything, "ns-1", "api", "staging").Return(testStatus(), nil)
+		svc := &promotionServiceWithAuthz{internal: mockSvc, authz: testutil.NewTestAuthzChecker(pdp)}
+
+		_, err := svc.GetPromotionStatus(testutil.AuthzContext(), "ns-1", "api", "staging")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestPromoteProject_AuthzCheck(t *testing.T) {
+	objs := []client.Object{
+		testutil.NewProject("ns-1", "my-proj"),
+		testutil.NewComponent("ns-1", "my-proj", "api"),
+		testutil.NewComponent("ns-1", "my-proj", "web"),
+	}
+	notDeployed := &models.PromotionStatus{Component: "web", Project: "my-proj", SourceEnvironment: "staging"}
+
+	t.Run("checks the targets of every deployed component", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		req := &models.ProjectPromotionRequest{SourceEnvironment: "staging", RollbackOnFailure: true}
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetPromotionStatus", mock.Anything, "ns-1", "api", "staging").Return(testStatus(), nil)
+		mockSvc.On("GetPromotionStatus", mock.Anything, "ns-1", "web", "staging").Return(notDeployed, nil)
+		mockSvc.On("PromoteProject", mock.Anything, "ns-1", "my-proj", req).Return(&models.ProjectPromotionStatus{}, nil)
+		svc := &promotionServiceWithAuthz{
+			internal:  mockSvc,
+			k8sClient: testutil.NewFakeClient(objs...),
+			authz:     testutil.NewTestAuthzChecker(pdp),
+		}
+
+		_, err := svc.PromoteProject(testutil.AuthzContext(), "ns-1", "my-proj", req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 3)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:update", "releasebinding", "api-prod-us", compHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "releasebinding:create", "releasebinding", "api-prod-eu", compHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[2], "releasebinding:delete", "releasebinding", "api-prod-eu", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetPromotionStatus", mock.Anything, "ns-1", "api", "staging").Return(testStatus(), nil)
+		svc := &promotionServiceWithAuthz{
+			internal:  mockSvc,
+			k8sClient: testutil.NewFakeClient(objs...),
+			authz:     testutil.NewTestAuthzChecker(pdp),
+		}
+
+		_, err := svc.PromoteProject(testutil.AuthzContext(), "ns-1", "my-proj", &models.ProjectPromotionRequest{
+			SourceEnvironment: "staging",
+			Components:        []string{"api"},
+		})
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
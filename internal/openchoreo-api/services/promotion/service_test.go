@@ -0,0 +1,380 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace     = "test-ns"
+	testProjectName   = "test-project"
+	testComponentName = "api"
+)
+
+var testNow = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func newService(t *testing.T, objs ...client.Object) (*promotionService, client.Client) {
+	t.Helper()
+	k8sClient := testutil.NewFakeClient(objs...)
+	svc := NewService(k8sClient, testutil.TestLogger()).(*promotionService)
+	svc.now = func() time.Time { return testNow }
+	return svc, k8sClient
+}
+
+// fanOutPipeline promotes staging to prod-us and prod-eu; prod-eu is gated by gate.
+func fanOutPipeline(gate *openchoreov1alpha1.PromotionGate) *openchoreov1alpha1.DeploymentPipeline {
+	dp := testutil.NewDeploymentPipeline(testNamespace, "default")
+	dp.Spec.PromotionPaths = []openchoreov1alpha1.PromotionPath{{
+		SourceEnvironmentRef: openchoreov1alpha1.EnvironmentRef{Name: "staging"},
+		TargetEnvironmentRefs: []openchoreov1alpha1.TargetEnvironmentRef{
+			{Name: "prod-us"},
+			{Name: "prod-eu", Gate: gate},
+		},
+	}}
+	return dp
+}
+
+// binding returns a release binding of the test component; readySince is the time its Ready
+// condition turned true, or zero for a binding that is not ready.
+func binding(env, release string, readySince time.Time) *openchoreov1alpha1.ReleaseBinding {
+	rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, env, testComponentName+"-"+env)
+	rb.Spec.ReleaseName = release
+	if !readySince.IsZero() {
+		rb.Status.Conditions = []metav1.Condition{{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			Reason:             "Ready",
+			LastTransitionTime: metav1.NewTime(readySince),
+		}}
+	}
+	return rb
+}
+
+func baseObjects(pipeline *openchoreov1alpha1.DeploymentPipeline) []client.Object {
+	return []client.Object{
+		testutil.NewProject(testNamespace, testProjectName),
+		testutil.NewComponent(testNamespace, testProjectName, testComponentName),
+		pipeline,
+	}
+}
+
+func TestPromote(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fans out to all targets", func(t *testing.T) {
+		objs := append(baseObjects(fanOutPipeline(nil)),
+			binding("staging", "api-r2", testNow.Add(-time.Hour)),
+			binding("prod-us", "api-r1", testNow.Add(-time.Hour)),
+		)
+		svc, k8sClient := newService(t, objs...)
+
+		status, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", nil)
+		require.NoError(t, err)
+		assert.Equal(t, testProjectName, status.Project)
+		assert.Equal(t, "api-r2", status.Release)
+		assert.Equal(t, models.PromotionPhaseProgressing, status.Phase)
+		require.Len(t, status.Targets, 2)
+		for _, target := range status.Targets {
+			assert.Equal(t, models.PromotionPhaseProgressing, target.Phase, target.Environment)
+			assert.Equal(t, "api-r2", target.Release)
+
+			rb := &openchoreov1alpha1.ReleaseBinding{}
+			require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: target.ReleaseBinding}, rb))
+			assert.Equal(t, "api-r2", rb.Spec.ReleaseName)
+			assert.Equal(t, target.Environment, rb.Spec.Environment)
+		}
+	})
+
+	t.Run("only the selected targets", func(t *testing.T) {
+		objs := append(baseObjects(fanOutPipeline(nil)), binding("staging", "api-r2", testNow.Add(-time.Hour)))
+		svc, k8sClient := newService(t, objs...)
+
+		status, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", []string{"prod-eu"})
+		require.NoError(t, err)
+		require.Len(t, status.Targets, 1)
+		assert.Equal(t, "prod-eu", status.Targets[0].Environment)
+
+		err = k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "api-prod-us"}, &openchoreov1alpha1.ReleaseBinding{})
+		assert.True(t, apierrors.IsNotFound(err), "prod-us must not be promoted")
+	})
+
+	t.Run("gate blocks a single target", func(t *testing.T) {
+		gate := &openchoreov1alpha1.PromotionGate{SoakTime: &metav1.Duration{Duration: 2 * time.Hour}}
+		objs := append(baseObjects(fanOutPipeline(gate)), binding("staging", "api-r2", testNow.Add(-time.Hour)))
+		svc, _ := newService(t, objs...)
+
+		status, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", nil)
+		require.NoError(t, err)
+		assert.Equal(t, models.PromotionPhasePartiallyFailed, status.Phase)
+		assert.Equal(t, models.PromotionPhaseProgressing, status.Targets[0].Phase)
+		assert.Equal(t, models.PromotionPhaseBlocked, status.Targets[1].Phase)
+		assert.Contains(t, status.Targets[1].Message, "soak for 1h0m0s more")
+	})
+
+	t.Run("source not ready blocks gated targets", func(t *testing.T) {
+		gate := &openchoreov1alpha1.PromotionGate{RequireSourceReady: true}
+		objs := append(baseObjects(fanOutPipeline(gate)), binding("staging", "api-r2", time.Time{}))
+		svc, _ := newService(t, objs...)
+
+		status, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", []string{"prod-eu"})
+		require.NoError(t, err)
+		assert.Equal(t, models.PromotionPhaseFailed, status.Phase)
+		assert.Equal(t, models.PromotionPhaseBlocked, status.Targets[0].Phase)
+		assert.Contains(t, status.Targets[0].Message, "Progressing")
+	})
+
+	t.Run("locked target is blocked", func(t *testing.T) {
+		locked := binding("prod-eu", "api-r1", testNow.Add(-time.Hour))
+		locked.Spec.Lock = &openchoreov1alpha1.DeploymentLock{
+			Reason:    "incident",
+			ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour)),
+		}
+		objs := append(baseObjects(fanOutPipeline(nil)), binding("staging", "api-r2", testNow.Add(-time.Hour)), locked)
+		svc, _ := newService(t, objs...)
+
+		status, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", nil)
+		require.NoError(t, err)
+		assert.Equal(t, models.PromotionPhasePartiallyFailed, status.Phase)
+		assert.Equal(t, models.PromotionPhaseProgressing, status.Targets[0].Phase)
+		assert.Equal(t, models.PromotionPhaseBlocked, status.Targets[1].Phase)
+		assert.Contains(t, status.Targets[1].Message, "incident")
+		assert.Equal(t, "api-r1", status.Targets[1].Release)
+	})
+
+	t.Run("up to date targets are reported, not updated", func(t *testing.T) {
+		objs := append(baseObjects(fanOutPipeline(nil)),
+			binding("staging", "api-r2", testNow.Add(-time.Hour)),
+			binding("prod-us", "api-r2", testNow.Add(-time.Minute)),
+		)
+		svc, _ := newService(t, objs...)
+
+		status, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", []string{"prod-us"})
+		require.NoError(t, err)
+		assert.Equal(t, models.PromotionPhaseSucceeded, status.Phase)
+		assert.Equal(t, models.PromotionPhaseSucceeded, status.Targets[0].Phase)
+	})
+
+	t.Run("source not deployed", func(t *testing.T) {
+		svc, _ := newService(t, baseObjects(fanOutPipeline(nil))...)
+
+		_, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", nil)
+		require.ErrorIs(t, err, ErrSourceNotDeployed)
+	})
+
+	t.Run("unknown target", func(t *testing.T) {
+		objs := append(baseObjects(fanOutPipeline(nil)), binding("staging", "api-r2", testNow.Add(-time.Hour)))
+		svc, _ := newService(t, objs...)
+
+		_, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", []string{"dev"})
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("not a promotion source", func(t *testing.T) {
+		svc, _ := newService(t, baseObjects(fanOutPipeline(nil))...)
+
+		_, err := svc.Promote(ctx, testNamespace, testComponentName, "prod-us", nil)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		svc, _ := newService(t)
+
+		_, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", nil)
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+
+	t.Run("pipeline not found", func(t *testing.T) {
+		svc, _ := newService(t,
+			testutil.NewProject(testNamespace, testProjectName),
+			testutil.NewComponent(testNamespace, testProjectName, testComponentName),
+		)
+
+		_, err := svc.Promote(ctx, testNamespace, testComponentName, "staging", nil)
+		require.ErrorIs(t, err, ErrDeploymentPipelineNotFound)
+	})
+}
+
+func TestGetPromotionStatus(t *testing.T) {
+	ctx := context.Background()
+
+	gate := &openchoreov1alpha1.PromotionGate{RequireSourceReady: true}
+	prodUS := binding("prod-us", "api-r2", time.Time{})
+	prodUS.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, Reason: "ResourcesDegraded", Message: "pods crashing"}}
+	objs := append(baseObjects(fanOutPipeline(gate)),
+		binding("staging", "api-r2", time.Time{}),
+		prodUS,
+	)
+	svc, _ := newService(t, objs...)
+
+	status, err := svc.GetPromotionStatus(ctx, testNamespace, testComponentName, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, "api-r2", status.Release)
+	assert.Equal(t, models.PromotionPhaseFailed, status.Phase)
+	require.Len(t, status.Targets, 2)
+	assert.Equal(t, models.PromotionTarget{
+		Environment:    "prod-us",
+		ReleaseBinding: "api-prod-us",
+		Release:        "api-r2",
+		Phase:          models.PromotionPhaseFailed,
+		Message:        "pods crashing",
+	}, status.Targets[0])
+	assert.Equal(t, models.PromotionPhaseBlocked, status.Targets[1].Phase)
+	assert.Empty(t, status.Targets[1].ReleaseBinding)
+}
+
+func TestPromoteProject(t *testing.T) {
+	ctx := context.Background()
+
+	// webBinding returns a ready release binding of a second component of the test project.
+	webBinding := func(env, release string) *openchoreov1alpha1.ReleaseBinding {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, "web", env, "web-"+env)
+		rb.Spec.ReleaseName = release
+		return rb
+	}
+	projectObjects := func(pipeline *openchoreov1alpha1.DeploymentPipeline, objs ...client.Object) []client.Object {
+		return append(baseObjects(pipeline), append(objs,
+			testutil.NewComponent(testNamespace, testProjectName, "web"),
+			testutil.NewComponent(testNamespace, "other-project", "worker"),
+		)...)
+	}
+
+	t.Run("promotes every component and skips the undeployed ones", func(t *testing.T) {
+		svc, k8sClient := newService(t, projectObjects(fanOutPipeline(nil),
+			binding("staging", "api-r2", testNow.Add(-time.Hour)))...)
+
+		status, err := svc.PromoteProject(ctx, testNamespace, testProjectName, &models.ProjectPromotionRequest{SourceEnvironment: "staging"})
+		require.NoError(t, err)
+		assert.Equal(t, models.PromotionPhaseProgressing, status.Phase)
+		require.Len(t, status.Components, 2)
+		assert.Equal(t, testComponentName, status.Components[0].Component)
+		assert.Equal(t, models.PromotionPhaseProgressing, status.Components[0].Phase)
+		assert.Len(t, status.Components[0].Targets, 2)
+		assert.Equal(t, "web", status.Components[1].Component)
+		assert.Equal(t, models.PromotionPhaseSkipped, status.Components[1].Phase)
+		assert.Empty(t, status.Components[1].Targets)
+
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "api-prod-eu"}, rb))
+		assert.Equal(t, "api-r2", rb.Spec.ReleaseName)
+	})
+
+	t.Run("selected component without a source release", func(t *testing.T) {
+		svc, k8sClient := newService(t, projectObjects(fanOutPipeline(nil),
+			binding("staging", "api-r2", testNow.Add(-time.Hour)))...)
+
+		_, err := svc.PromoteProject(ctx, testNamespace, testProjectName, &models.ProjectPromotionRequest{
+			SourceEnvironment: "staging",
+			Components:        []string{testComponentName, "web"},
+		})
+		require.ErrorIs(t, err, ErrSourceNotDeployed)
+
+		err = k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "api-prod-us"}, &openchoreov1alpha1.ReleaseBinding{})
+		assert.True(t, apierrors.IsNotFound(err), "no component may be promoted")
+	})
+
+	t.Run("component of another project", func(t *testing.T) {
+		svc, _ := newService(t, projectObjects(fanOutPipeline(nil))...)
+
+		_, err := svc.PromoteProject(ctx, testNamespace, testProjectName, &models.ProjectPromotionRequest{
+			SourceEnvironment: "staging",
+			Components:        []string{"worker"},
+		})
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		svc, _ := newService(t)
+
+		_, err := svc.PromoteProject(ctx, testNamespace, testProjectName, &models.ProjectPromotionRequest{SourceEnvironment: "staging"})
+		require.ErrorIs(t, err, ErrProjectNotFound)
+	})
+
+	t.Run("rolls back on partial failure", func(t *testing.T) {
+		gate := &openchoreov1alpha1.PromotionGate{SoakTime: &metav1.Duration{Duration: 2 * time.Hour}}
+		svc, k8sClient := newService(t, projectObjects(fanOutPipeline(gate),
+			binding("staging", "api-r2", testNow.Add(-time.Hour)),
+			binding("prod-us", "api-r1", testNow.Add(-time.Hour)),
+			webBinding("staging", "web-r2"),
+		)...)
+
+		status, err := svc.PromoteProject(ctx, testNamespace, testProjectName, &models.ProjectPromotionRequest{
+			SourceEnvironment: "staging",
+			RollbackOnFailure: true,
+		})
+		require.NoError(t, err)
+		assert.True(t, status.RolledBack)
+		assert.Equal(t, models.PromotionPhaseRolledBack, status.Phase)
+		require.Len(t, status.Components, 2)
+
+		api := status.Components[0]
+		assert.Equal(t, models.PromotionPhaseRolledBack, api.Phase)
+		assert.Equal(t, models.PromotionPhaseRolledBack, api.Targets[0].Phase)
+		assert.Equal(t, "api-r1", api.Targets[0].Release)
+		assert.Equal(t, models.PromotionPhaseBlocked, api.Targets[1].Phase)
+
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "api-prod-us"}, rb))
+		assert.Equal(t, "api-r1", rb.Spec.ReleaseName)
+		// The web bindings were created by the promotion, so the rollback deletes them.
+		for _, name := range []string{"web-prod-us", "web-prod-eu"} {
+			err := k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: name}, &openchoreov1alpha1.ReleaseBinding{})
+			assert.True(t, apierrors.IsNotFound(err), name)
+		}
+	})
+
+	t.Run("keeps the promoted targets without rollback", func(t *testing.T) {
+		gate := &openchoreov1alpha1.PromotionGate{SoakTime: &metav1.Duration{Duration: 2 * time.Hour}}
+		svc, k8sClient := newService(t, projectObjects(fanOutPipeline(gate),
+			binding("staging", "api-r2", testNow.Add(-time.Hour)))...)
+
+		status, err := svc.PromoteProject(ctx, testNamespace, testProjectName, &models.ProjectPromotionRequest{SourceEnvironment: "staging"})
+		require.NoError(t, err)
+		assert.False(t, status.RolledBack)
+		assert.Equal(t, models.PromotionPhasePartiallyFailed, status.Phase)
+
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "api-prod-us"}, rb))
+		assert.Equal(t, "api-r2", rb.Spec.ReleaseName)
+	})
+}
+
+func TestAggregatePhase(t *testing.T) {
+	phases := func(p ...string) []models.PromotionTarget {
+		out := make([]models.PromotionTarget, len(p))
+		for i := range p {
+			out[i].Phase = p[i]
+		}
+		return out
+	}
+	tests := []struct {
+		targets []models.PromotionTarget
+		want    string
+	}{
+		{phases(models.PromotionPhaseSucceeded, models.PromotionPhaseSucceeded), models.PromotionPhaseSucceeded},
+		{phases(models.PromotionPhaseSucceeded, models.PromotionPhaseProgressing), models.PromotionPhaseProgressing},
+		{phases(models.PromotionPhaseSucceeded, models.PromotionPhasePending), models.PromotionPhasePending},
+		{phases(models.PromotionPhaseSucceeded, models.PromotionPhaseBlocked), models.PromotionPhasePartiallyFailed},
+		{phases(models.PromotionPhaseFailed, models.PromotionPhaseBlocked), models.PromotionPhaseFailed},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, aggregatePhase(tt.targets))
+	}
+}
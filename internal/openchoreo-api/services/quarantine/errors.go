@@ -0,0 +1,12 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+import "errors"
+
+var (
+	ErrUnsupportedKind  = errors.New("unsupported quarantined resource kind")
+	ErrResourceNotFound = errors.New("resource not found")
+	ErrNotQuarantined   = errors.New("resource is not quarantined")
+)
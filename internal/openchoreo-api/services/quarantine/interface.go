@@ -0,0 +1,20 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Service defines the quarantined resource service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	ListQuarantinedResources(ctx context.Context, namespaceName string) ([]models.QuarantinedResource, error)
+	GetQuarantinedResource(ctx context.Context, namespaceName, kind, name string) (*models.QuarantinedResource, error)
+	// RequeueQuarantinedResource requests the controller manager to reconcile a quarantined
+	// resource again.
+	RequeueQuarantinedResource(ctx context.Context, namespaceName, kind, name string) (*models.QuarantinedResource, error)
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Kinds of the resources the controller manager quarantines.
+const (
+	KindComponent              = "Component"
+	KindReleaseBinding         = "ReleaseBinding"
+	KindResource               = "Resource"
+	KindResourceReleaseBinding = "ResourceReleaseBinding"
+)
+
+var kinds = []string{KindComponent, KindReleaseBinding, KindResource, KindResourceReleaseBinding}
+
+// quarantineService serves quarantined resources without authorization checks.
+type quarantineService struct {
+	k8sClient client.Client
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+var _ Service = (*quarantineService)(nil)
+
+// NewService creates a new quarantine service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &quarantineService{
+		k8sClient: k8sClient,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+func (s *quarantineService) ListQuarantinedResources(ctx context.Context, namespaceName string) ([]models.QuarantinedResource, error) {
+	s.logger.Debug("Listing quarantined resources", "namespace", namespaceName)
+
+	out := []models.QuarantinedResource{}
+	for _, kind := range kinds {
+		objs, err := s.list(ctx, namespaceName, kind)
+		if err != nil {
+			s.logger.Error("Failed to list resources", "kind", kind, "error", err)
+			return nil, fmt.Errorf("failed to list %s resources: %w", kind, err)
+		}
+		for _, obj := range objs {
+			if controller.IsQuarantined(obj) {
+				out = append(out, toModel(kind, obj))
+			}
+		}
+	}
+	return out, nil
+}
+
+func (s *quarantineService) GetQuarantinedResource(ctx context.Context, namespaceName, kind, name string) (*models.QuarantinedResource, error) {
+	obj, err := s.get(ctx, namespaceName, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	if !controller.IsQuarantined(obj) {
+		return nil, ErrNotQuarantined
+	}
+	r := toModel(kind, obj)
+	return &r, nil
+}
+
+func (s *quarantineService) RequeueQuarantinedResource(ctx context.Context, namespaceName, kind, name string) (*models.QuarantinedResource, error) {
+	obj, err := s.get(ctx, namespaceName, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	if !controller.IsQuarantined(obj) {
+		return nil, ErrNotQuarantined
+	}
+	// The model is built before the annotation is set, as the resource is no longer
+	// quarantined once it is.
+	r := toModel(kind, obj)
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	requestedAt := s.now().UTC().Truncate(time.Second)
+	annotations[controller.AnnotationKeyRequeueRequestedAt] = requestedAt.Format(time.RFC3339)
+	obj.SetAnnotations(annotations)
+	if err := s.k8sClient.Patch(ctx, obj, patch); err != nil {
+		s.logger.Error("Failed to request requeue", "kind", kind, "name", name, "error", err)
+		return nil, fmt.Errorf("failed to request requeue: %w", err)
+	}
+
+	s.logger.Info("Requested requeue of quarantined resource", "namespace", namespaceName, "kind", kind, "name", name)
+	r.RequeueRequestedAt = &requestedAt
+	return &r, nil
+}
+
+func (s *quarantineService) get(ctx context.Context, namespaceName, kind, name string) (controller.ConditionedObject, error) {
+	obj, err := newObject(kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: name}, obj); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrResourceNotFound
+		}
+		s.logger.Error("Failed to get resource", "kind", kind, "name", name, "error", err)
+		return nil, fmt.Errorf("failed to get %s: %w", kind, err)
+	}
+	return obj, nil
+}
+
+func (s *quarantineService) list(ctx context.Context, namespaceName, kind string) ([]controller.ConditionedObject, error) {
+	switch kind {
+	case KindComponent:
+		var list openchoreov1alpha1.ComponentList
+		if err := s.k8sClient.List(ctx, &list, client.InNamespace(namespaceName)); err != nil {
+			return nil, err
+		}
+		return conditioned(list.Items), nil
+	case KindReleaseBinding:
+		var list openchoreov1alpha1.ReleaseBindingList
+		if err := s.k8sClient.List(ctx, &list, client.InNamespace(namespaceName)); err != nil {
+			return nil, err
+		}
+		return conditioned(list.Items), nil
+	case KindResource:
+		var list openchoreov1alpha1.ResourceList
+		if err := s.k8sClient.List(ctx, &list, client.InNamespace(namespaceName)); err != nil {
+			return nil, err
+		}
+		return conditioned(list.Items), nil
+	case KindResourceReleaseBinding:
+		var list openchoreov1alpha1.ResourceReleaseBindingList
+		if err := s.k8sClient.List(ctx, &list, client.InNamespace(namespaceName)); err != nil {
+			return nil, err
+		}
+		return conditioned(list.Items), nil
+	}
+	return nil, ErrUnsupportedKind
+}
+
+// newObject returns an empty object of a quarantined resource kind.
+func newObject(kind string) (controller.ConditionedObject, error) {
+	switch kind {
+	case KindComponent:
+		return &openchoreov1alpha1.Component{}, nil
+	case KindReleaseBinding:
+		return &openchoreov1alpha1.ReleaseBinding{}, nil
+	case KindResource:
+		return &openchoreov1alpha1.Resource{}, nil
+	case KindResourceReleaseBinding:
+		return &openchoreov1alpha1.ResourceReleaseBinding{}, nil
+	}
+	return nil, ErrUnsupportedKind
+}
+
+func conditioned[T any, PT interface {
+	*T
+	controller.ConditionedObject
+}](items []T) []controller.ConditionedObject {
+	objs := make([]controller.ConditionedObject, len(items))
+	for i := range items {
+		objs[i] = PT(&items[i])
+	}
+	return objs
+}
+
+// toModel converts a quarantined object to its API model, including the owners the
+// authorization checks are scoped to.
+func toModel(kind string, obj controller.ConditionedObject) models.QuarantinedResource {
+	r := models.QuarantinedResource{Kind: kind, Name: obj.GetName()}
+	if cond := meta.FindStatusCondition(obj.GetConditions(), controller.ConditionQuarantined.String()); cond != nil {
+		r.QuarantinedAt = cond.LastTransitionTime.Time
+		r.Message = cond.Message
+	}
+	if requestedAt, err := time.Parse(time.RFC3339, obj.GetAnnotations()[controller.AnnotationKeyRequeueRequestedAt]); err == nil {
+		r.RequeueRequestedAt = &requestedAt
+	}
+
+	switch o := obj.(type) {
+	case *openchoreov1alpha1.Component:
+		r.Project = o.Spec.Owner.ProjectName
+		r.Component = o.Name
+	case *openchoreov1alpha1.ReleaseBinding:
+		r.Project = o.Spec.Owner.ProjectName
+		r.Component = o.Spec.Owner.ComponentName
+		r.Environment = o.Spec.Environment
+	case *openchoreov1alpha1.Resource:
+		r.Project = o.Spec.Owner.ProjectName
+		r.Resource = o.Name
+	case *openchoreov1alpha1.ResourceReleaseBinding:
+		r.Project = o.Spec.Owner.ProjectName
+		r.Resource = o.Spec.Owner.ResourceName
+		r.Environment = o.Spec.Environment
+	}
+	return r
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// kindActions are the view and update actions and the authz resource type of each
+// quarantined resource kind.
+var kindActions = map[string]struct {
+	view, update, resourceType string
+}{
+	KindComponent:              {authz.ActionViewComponent, authz.ActionUpdateComponent, "component"},
+	KindReleaseBinding:         {authz.ActionViewReleaseBinding, authz.ActionUpdateReleaseBinding, "releasebinding"},
+	KindResource:               {authz.ActionViewResource, authz.ActionUpdateResource, "resource"},
+	KindResourceReleaseBinding: {authz.ActionViewResourceReleaseBinding, authz.ActionUpdateResourceReleaseBinding, "resourcereleasebinding"},
+}
+
+// quarantineServiceWithAuthz wraps a Service and adds authorization checks.
+type quarantineServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*quarantineServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a quarantine service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &quarantineServiceWithAuthz{
+		internal: NewService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// ListQuarantinedResources returns the quarantined resources the caller can view.
+func (s *quarantineServiceWithAuthz) ListQuarantinedResources(ctx context.Context, namespaceName string) ([]models.QuarantinedResource, error) {
+	resources, err := s.internal.ListQuarantinedResources(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]services.CheckRequest, len(resources))
+	for i, r := range resources {
+		requests[i] = checkRequest(namespaceName, r, kindActions[r.Kind].view)
+	}
+	allowed, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]models.QuarantinedResource, 0, len(resources))
+	for i, r := range resources {
+		if i < len(allowed) && allowed[i] {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (s *quarantineServiceWithAuthz) GetQuarantinedResource(ctx context.Context, namespaceName, kind, name string) (*models.QuarantinedResource, error) {
+	r, err := s.internal.GetQuarantinedResource(ctx, namespaceName, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, checkRequest(namespaceName, *r, kindActions[kind].view)); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// RequeueQuarantinedResource requires the update action of the resource's kind, checked
+// against the owners of the stored resource.
+func (s *quarantineServiceWithAuthz) RequeueQuarantinedResource(ctx context.Context, namespaceName, kind, name string) (*models.QuarantinedResource, error) {
+	r, err := s.internal.GetQuarantinedResource(ctx, namespaceName, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, checkRequest(namespaceName, *r, kindActions[kind].update)); err != nil {
+		return nil, err
+	}
+	return s.internal.RequeueQuarantinedResource(ctx, namespaceName, kind, name)
+}
+
+func checkRequest(namespaceName string, r models.QuarantinedResource, action string) services.CheckRequest {
+	req := services.CheckRequest{
+		Action:       action,
+		ResourceType: kindActions[r.Kind].resourceType,
+		ResourceID:   r.Name,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   r.Project,
+			Component: r.Component,
+			Resource:  r.Resource,
+		},
+	}
+	if r.Environment != "" {
+		req.Context = authz.Context{
+			Resource: authz.ResourceAttribute{
+				Environment: services.FormatDualScopedResourceName(namespaceName, r.Environment, false),
+			},
+		}
+	}
+	return req
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quarantine/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newQuarantineAuthzSvc(pdp authzcore.PDP, internal Service) Service {
+	return &quarantineServiceWithAuthz{
+		internal: internal,
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+var quarantinedBinding = &models.QuarantinedResource{
+	Kind:        KindReleaseBinding,
+	Name:        "api-production",
+	Project:     "shop",
+	Component:   "api",
+	Environment: "production",
+}
+
+func TestRequeueQuarantinedResource_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetQuarantinedResource", mock.Anything, "ns-1", KindReleaseBinding, "api-production").Return(quarantinedBinding, nil)
+		mockSvc.On("RequeueQuarantinedResource", mock.Anything, "ns-1", KindReleaseBinding, "api-production").Return(quarantinedBinding, nil)
+		svc := newQuarantineAuthzSvc(pdp, mockSvc)
+
+		result, err := svc.RequeueQuarantinedResource(testutil.AuthzContext(), "ns-1", KindReleaseBinding, "api-production")
+		require.NoError(t, err)
+		require.Equal(t, quarantinedBinding, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:update", "releasebinding", "api-production",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "shop", Component: "api"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetQuarantinedResource", mock.Anything, "ns-1", KindReleaseBinding, "api-production").Return(quarantinedBinding, nil)
+		svc := newQuarantineAuthzSvc(testutil.DenyPDP(), mockSvc)
+		_, err := svc.RequeueQuarantinedResource(testutil.AuthzContext(), "ns-1", KindReleaseBinding, "api-production")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// projectPDP allows access to the resources of a single project.
+type projectPDP struct {
+	*testutil.CapturingPDP
+	project string
+}
+
+func (p projectPDP) BatchEvaluate(_ context.Context, req *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
+	resp := &authzcore.BatchEvaluateResponse{}
+	for _, r := range req.Requests {
+		resp.Decisions = append(resp.Decisions, authzcore.Decision{Decision: r.Resource.Hierarchy.Project == p.project})
+	}
+	return resp, nil
+}
+
+func TestListQuarantinedResources_AuthzFilter(t *testing.T) {
+	mockSvc := mocks.NewMockService(t)
+	mockSvc.On("ListQuarantinedResources", mock.Anything, "ns-1").Return([]models.QuarantinedResource{
+		{Kind: KindComponent, Name: "admin", Project: "internal", Component: "admin"},
+		*quarantinedBinding,
+	}, nil)
+	svc := newQuarantineAuthzSvc(projectPDP{CapturingPDP: testutil.AllowPDP(), project: "shop"}, mockSvc)
+
+	result, err := svc.ListQuarantinedResources(testutil.AuthzContext(), "ns-1")
+	require.NoError(t, err)
+	assert.Equal(t, []models.QuarantinedResource{*quarantinedBinding}, result)
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quarantine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testNamespace = "test-ns"
+
+var quarantinedAt = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func quarantine(obj controller.ConditionedObject) {
+	obj.SetConditions([]metav1.Condition{{
+		Type:               controller.ConditionQuarantined.String(),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(controller.ReasonReconcileFailing),
+		Message:            "Reconcile failed 20 consecutive times",
+		ObservedGeneration: obj.GetGeneration(),
+		LastTransitionTime: metav1.NewTime(quarantinedAt),
+	}})
+}
+
+func newTestService(objs ...client.Object) (*quarantineService, client.Client) {
+	k8sClient := testutil.NewFakeClient(objs...)
+	return &quarantineService{
+		k8sClient: k8sClient,
+		logger:    testutil.TestLogger(),
+		now:       func() time.Time { return quarantinedAt.Add(time.Hour) },
+	}, k8sClient
+}
+
+func TestListQuarantinedResources(t *testing.T) {
+	component := testutil.NewComponent(testNamespace, "shop", "api")
+	quarantine(component)
+	binding := testutil.NewReleaseBinding(testNamespace, "shop", "api", "production", "api-production")
+	quarantine(binding)
+	resource := testutil.NewResource(testNamespace, "shop", "orders-db")
+	healthy := testutil.NewReleaseBinding(testNamespace, "shop", "api", "staging", "api-staging")
+	requeued := testutil.NewResourceReleaseBinding(testNamespace, "shop", "orders-db", "production", "orders-db-production")
+	quarantine(requeued)
+	requeued.Annotations = map[string]string{
+		controller.AnnotationKeyRequeueRequestedAt: quarantinedAt.Add(time.Minute).Format(time.RFC3339),
+	}
+
+	svc, _ := newTestService(component, binding, resource, healthy, requeued)
+	result, err := svc.ListQuarantinedResources(context.Background(), testNamespace)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	assert.Equal(t, KindComponent, result[0].Kind)
+	assert.Equal(t, "api", result[0].Component)
+	assert.Equal(t, KindReleaseBinding, result[1].Kind)
+	assert.Equal(t, "api-production", result[1].Name)
+	assert.Equal(t, "shop", result[1].Project)
+	assert.Equal(t, "api", result[1].Component)
+	assert.Equal(t, "production", result[1].Environment)
+	assert.True(t, result[1].QuarantinedAt.Equal(quarantinedAt))
+	assert.Equal(t, "Reconcile failed 20 consecutive times", result[1].Message)
+}
+
+func TestRequeueQuarantinedResource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requests a requeue", func(t *testing.T) {
+		binding := testutil.NewReleaseBinding(testNamespace, "shop", "api", "production", "api-production")
+		quarantine(binding)
+		svc, k8sClient := newTestService(binding)
+
+		result, err := svc.RequeueQuarantinedResource(ctx, testNamespace, KindReleaseBinding, "api-production")
+		require.NoError(t, err)
+		require.NotNil(t, result.RequeueRequestedAt)
+		assert.True(t, result.RequeueRequestedAt.Equal(quarantinedAt.Add(time.Hour)))
+
+		stored := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "api-production"}, stored))
+		assert.Equal(t, "2026-05-01T13:00:00Z", stored.Annotations[controller.AnnotationKeyRequeueRequestedAt])
+		assert.False(t, controller.IsQuarantined(stored))
+	})
+
+	t.Run("resource that is not quarantined", func(t *testing.T) {
+		svc, _ := newTestService(testutil.NewComponent(testNamespace, "shop", "api"))
+		_, err := svc.RequeueQuarantinedResource(ctx, testNamespace, KindComponent, "api")
+		require.ErrorIs(t, err, ErrNotQuarantined)
+	})
+
+	t.Run("resource not found", func(t *testing.T) {
+		svc, _ := newTestService()
+		_, err := svc.RequeueQuarantinedResource(ctx, testNamespace, KindResource, "missing")
+		require.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		svc, _ := newTestService()
+		_, err := svc.RequeueQuarantinedResource(ctx, testNamespace, "Project", "shop")
+		require.ErrorIs(t, err, ErrUnsupportedKind)
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/changelog"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func (s *releaseBindingService) GetConfigDiff(ctx context.Context, namespaceName, releaseBindingName string) (*models.ConfigDiff, error) {
+	s.logger.Debug("Getting config diff", "namespace", namespaceName, "releaseBinding", releaseBindingName)
+
+	rb, err := s.GetReleaseBinding(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &models.ConfigDiff{
+		ReleaseBinding: rb.Name,
+		Environment:    rb.Spec.Environment,
+		Changes:        []models.ConfigChange{},
+	}
+	diff.FromRelease, diff.ToRelease = appliedReleases(rb)
+	if diff.ToRelease == "" {
+		return diff, nil
+	}
+
+	to, err := s.getComponentRelease(ctx, namespaceName, diff.ToRelease)
+	if err != nil {
+		return nil, err
+	}
+	var from *openchoreov1alpha1.ComponentRelease
+	if diff.FromRelease != "" {
+		if from, err = s.getComponentRelease(ctx, namespaceName, diff.FromRelease); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range changelog.DiffConfig(from, to, rb) {
+		diff.Changes = append(diff.Changes, models.ConfigChange{
+			Path:   c.Path,
+			Type:   string(c.Type),
+			From:   c.From,
+			To:     c.To,
+			Masked: c.Masked,
+		})
+	}
+	return diff, nil
+}
+
+// appliedReleases returns the ComponentRelease currently deployed by the binding and the one
+// it replaced, from the deployment history. The previous release is empty when the current
+// one is the first deployed. Bindings without history fall back to their spec and the
+// recorded promotion.
+func appliedReleases(rb *openchoreov1alpha1.ReleaseBinding) (previous, current string) {
+	history := rb.Status.DeploymentHistory
+	if len(history) == 0 {
+		if rb.Status.Promotion != nil && rb.Status.Promotion.ToRelease == rb.Spec.ReleaseName {
+			return rb.Status.Promotion.FromRelease, rb.Spec.ReleaseName
+		}
+		return "", rb.Spec.ReleaseName
+	}
+
+	current = history[len(history)-1].Release
+	for i := len(history) - 2; i >= 0; i-- {
+		if history[i].Release != current {
+			return history[i].Release, current
+		}
+	}
+	return "", current
+}
+
+func (s *releaseBindingService) getComponentRelease(ctx context.Context, namespaceName, name string) (*openchoreov1alpha1.ComponentRelease, error) {
+	cr := &openchoreov1alpha1.ComponentRelease{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: name}, cr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrComponentReleaseNotFound, name)
+		}
+		s.logger.Error("Failed to get component release", "error", err)
+		return nil, fmt.Errorf("failed to get component release: %w", err)
+	}
+	return cr, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/changelog"
+)
+
+func newConfigRelease(name string, env ...openchoreov1alpha1.EnvVar) *openchoreov1alpha1.ComponentRelease {
+	cr := &openchoreov1alpha1.ComponentRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+	}
+	cr.Spec.Workload.Container.Image = "app:" + name
+	cr.Spec.Workload.Container.Env = env
+	return cr
+}
+
+func TestGetConfigDiff(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("diffs the current release against the one it replaced", func(t *testing.T) {
+		rb := newRolloutBinding(now)
+		// A redeploy of the current release does not hide the release it replaced.
+		rb.Status.DeploymentHistory = append(rb.Status.DeploymentHistory,
+			openchoreov1alpha1.DeploymentRecord{Release: "rel-2", DeployedAt: metav1.NewTime(now.Add(time.Minute))})
+		svc := newService(t, rb,
+			newConfigRelease("rel-1", openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"},
+				openchoreov1alpha1.EnvVar{Key: "DB_PASSWORD", Value: "old"}),
+			newConfigRelease("rel-2", openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "debug"},
+				openchoreov1alpha1.EnvVar{Key: "DB_PASSWORD", Value: "new"}))
+
+		diff, err := svc.GetConfigDiff(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Equal(t, testEnvironmentName, diff.Environment)
+		assert.Equal(t, "rel-1", diff.FromRelease)
+		assert.Equal(t, "rel-2", diff.ToRelease)
+		require.Len(t, diff.Changes, 2)
+		assert.Equal(t, "env.DB_PASSWORD", diff.Changes[0].Path)
+		assert.Equal(t, changelog.MaskedValue, diff.Changes[0].From)
+		assert.Equal(t, changelog.MaskedValue, diff.Changes[0].To)
+		assert.True(t, diff.Changes[0].Masked)
+		assert.Equal(t, "env.LOG_LEVEL", diff.Changes[1].Path)
+		assert.Equal(t, "Modified", diff.Changes[1].Type)
+		assert.Equal(t, `"debug"`, diff.Changes[1].To)
+	})
+
+	t.Run("first release", func(t *testing.T) {
+		rb := newRolloutBinding(now)
+		rb.Status.DeploymentHistory = rb.Status.DeploymentHistory[1:]
+		svc := newService(t, rb, newConfigRelease("rel-2", openchoreov1alpha1.EnvVar{Key: "LOG_LEVEL", Value: "info"}))
+
+		diff, err := svc.GetConfigDiff(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Empty(t, diff.FromRelease)
+		require.Len(t, diff.Changes, 1)
+		assert.Equal(t, "Added", diff.Changes[0].Type)
+	})
+
+	t.Run("no release deployed", func(t *testing.T) {
+		rb := newRolloutBinding(now)
+		rb.Spec.ReleaseName = ""
+		rb.Status.DeploymentHistory = nil
+		svc := newService(t, rb)
+
+		diff, err := svc.GetConfigDiff(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Empty(t, diff.ToRelease)
+		assert.Empty(t, diff.Changes)
+	})
+
+	t.Run("previous release deleted", func(t *testing.T) {
+		svc := newService(t, newRolloutBinding(now), newConfigRelease("rel-2"))
+
+		_, err := svc.GetConfigDiff(ctx, testNamespace, testRBName)
+		require.ErrorIs(t, err, ErrComponentReleaseNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.GetConfigDiff(ctx, testNamespace, testRBName)
+		require.ErrorIs(t, err, ErrReleaseBindingNotFound)
+	})
+}
+
+func TestAppliedReleases(t *testing.T) {
+	rb := &openchoreov1alpha1.ReleaseBinding{}
+	rb.Spec.ReleaseName = "rel-3"
+	rb.Status.Promotion = &openchoreov1alpha1.ReleaseBindingPromotion{FromRelease: "rel-2", ToRelease: "rel-3"}
+
+	previous, current := appliedReleases(rb)
+	assert.Equal(t, "rel-2", previous)
+	assert.Equal(t, "rel-3", current)
+
+	// A rollback deploys an older release than spec.releaseName; the history is authoritative.
+	rb.Status.DeploymentHistory = []openchoreov1alpha1.DeploymentRecord{
+		{Release: "rel-2"}, {Release: "rel-3", RolledBack: true}, {Release: "rel-2"},
+	}
+	previous, current = appliedReleases(rb)
+	assert.Equal(t, "rel-3", previous)
+	assert.Equal(t, "rel-2", current)
+}
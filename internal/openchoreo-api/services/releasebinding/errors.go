@@ -9,4 +9,5 @@ var (
 	ErrReleaseBindingNotFound      = errors.New("release binding not found")
 	ErrReleaseBindingAlreadyExists = errors.New("release binding already exists")
 	ErrComponentNotFound           = errors.New("component not found")
+	ErrComponentReleaseNotFound    = errors.New("component release not found")
 )
@@ -0,0 +1,68 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func (s *releaseBindingService) GetStatusHistory(ctx context.Context, namespaceName, releaseBindingName string) (*models.StatusHistory, error) {
+	s.logger.Debug("Getting status history", "namespace", namespaceName, "releaseBinding", releaseBindingName)
+
+	rb, err := s.GetReleaseBinding(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := historyEntries("ReleaseBinding", rb.Name, rb.Status.ConditionHistory)
+
+	// The data plane release is named {component}-{environment} by the release binding controller.
+	release := &openchoreov1alpha1.RenderedRelease{}
+	key := client.ObjectKey{Namespace: namespaceName, Name: fmt.Sprintf("%s-%s", rb.Spec.Owner.ComponentName, rb.Spec.Environment)}
+	if err := s.k8sClient.Get(ctx, key, release); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			s.logger.Error("Failed to get rendered release", "error", err)
+			return nil, fmt.Errorf("failed to get rendered release: %w", err)
+		}
+	} else if metav1.IsControlledBy(release, rb) {
+		transitions = append(transitions, historyEntries("RenderedRelease", release.Name, release.Status.ConditionHistory)...)
+	}
+
+	// Newest first; the stable sort keeps the recorded order of transitions observed together.
+	slices.Reverse(transitions)
+	slices.SortStableFunc(transitions, func(a, b models.ConditionHistoryEntry) int {
+		return b.TransitionTime.Compare(a.TransitionTime)
+	})
+
+	return &models.StatusHistory{
+		ReleaseBinding: rb.Name,
+		Environment:    rb.Spec.Environment,
+		Transitions:    transitions,
+	}, nil
+}
+
+// historyEntries converts the recorded condition transitions of a resource to history entries.
+func historyEntries(kind, name string, history []openchoreov1alpha1.ConditionTransition) []models.ConditionHistoryEntry {
+	entries := make([]models.ConditionHistoryEntry, 0, len(history))
+	for _, t := range history {
+		entries = append(entries, models.ConditionHistoryEntry{
+			SourceKind:     kind,
+			SourceName:     name,
+			Type:           t.Type,
+			Status:         string(t.Status),
+			Reason:         t.Reason,
+			Message:        t.Message,
+			TransitionTime: t.TransitionTime.Time,
+		})
+	}
+	return entries
+}
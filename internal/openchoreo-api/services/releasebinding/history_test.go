@@ -0,0 +1,67 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestGetStatusHistory(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	transition := func(offset time.Duration, status metav1.ConditionStatus, reason string) openchoreov1alpha1.ConditionTransition {
+		return openchoreov1alpha1.ConditionTransition{Type: "Ready", Status: status, Reason: reason, TransitionTime: metav1.NewTime(base.Add(offset))}
+	}
+
+	t.Run("merges the binding and release history newest first", func(t *testing.T) {
+		rb := newRolloutBinding(base)
+		rb.Status.ConditionHistory = []openchoreov1alpha1.ConditionTransition{
+			transition(0, metav1.ConditionTrue, "Ready"),
+			transition(2*time.Minute, metav1.ConditionFalse, "CrashLoopBackOff"),
+		}
+		release := newRolloutRelease(rb)
+		release.Status.ConditionHistory = []openchoreov1alpha1.ConditionTransition{
+			{Type: "ResourcesApplied", Status: metav1.ConditionFalse, Reason: "ApplyFailed", TransitionTime: metav1.NewTime(base.Add(time.Minute))},
+		}
+		svc := newService(t, rb, release)
+
+		history, err := svc.GetStatusHistory(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Equal(t, testEnvironmentName, history.Environment)
+		require.Len(t, history.Transitions, 3)
+		assert.Equal(t, "CrashLoopBackOff", history.Transitions[0].Reason)
+		assert.Equal(t, "ReleaseBinding", history.Transitions[0].SourceKind)
+		assert.Equal(t, "ApplyFailed", history.Transitions[1].Reason)
+		assert.Equal(t, "RenderedRelease", history.Transitions[1].SourceKind)
+		assert.Equal(t, release.Name, history.Transitions[1].SourceName)
+		assert.Equal(t, "Ready", history.Transitions[2].Reason)
+	})
+
+	t.Run("ignores a release owned by another binding", func(t *testing.T) {
+		rb := newRolloutBinding(base)
+		release := newRolloutRelease(rb)
+		release.OwnerReferences[0].UID = "other-uid"
+		release.Status.ConditionHistory = []openchoreov1alpha1.ConditionTransition{transition(0, metav1.ConditionTrue, "ApplySucceeded")}
+		svc := newService(t, rb, release)
+
+		history, err := svc.GetStatusHistory(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Empty(t, history.Transitions)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.GetStatusHistory(ctx, testNamespace, testRBName)
+		require.ErrorIs(t, err, ErrReleaseBindingNotFound)
+	})
+}
@@ -6,7 +6,10 @@ package releasebinding
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -17,4 +20,14 @@ type Service interface {
 	ListReleaseBindings(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ReleaseBinding], error)
 	GetReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error)
 	DeleteReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) error
+	ApplyResourceRecommendation(ctx context.Context, namespaceName, releaseBindingName string, resources corev1.ResourceRequirements) (*openchoreov1alpha1.ReleaseBinding, error)
+	SuspendReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error)
+	LockReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string, lock openchoreov1alpha1.DeploymentLock) (*openchoreov1alpha1.ReleaseBinding, error)
+	UnlockReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error)
+	EnableMaintenance(ctx context.Context, namespaceName, releaseBindingName string, maintenance openchoreov1alpha1.MaintenanceMode) (*openchoreov1alpha1.ReleaseBinding, error)
+	DisableMaintenance(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error)
+	GetRolloutProgress(ctx context.Context, namespaceName, releaseBindingName string) (*models.RolloutProgress, error)
+	GetStatusHistory(ctx context.Context, namespaceName, releaseBindingName string) (*models.StatusHistory, error)
+	GetConfigDiff(ctx context.Context, namespaceName, releaseBindingName string) (*models.ConfigDiff, error)
+	GetReleaseBindingsRuntime(ctx context.Context, namespaceName string, bindings []openchoreov1alpha1.ReleaseBinding) (map[string]*models.ReleaseBindingRuntime, error)
 }
@@ -0,0 +1,180 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/deploymentlock"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func lockedReleaseBinding() *openchoreov1alpha1.ReleaseBinding {
+	rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+	rb.Spec.ReleaseName = "release-1"
+	rb.Spec.Lock = &openchoreov1alpha1.DeploymentLock{
+		Reason:    "incident",
+		LockedBy:  "alice",
+		ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour)),
+	}
+	return rb
+}
+
+func TestLockReleaseBinding(t *testing.T) {
+	ctx := testutil.AuthzContext()
+
+	t.Run("records the caller and time", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		svc := newService(t, rb)
+		expiresAt := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+
+		result, err := svc.LockReleaseBinding(ctx, testNamespace, testRBName, openchoreov1alpha1.DeploymentLock{
+			Reason:    "  release freeze ",
+			ExpiresAt: expiresAt,
+			LockedBy:  "spoofed",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result.Spec.Lock)
+		assert.Equal(t, "release freeze", result.Spec.Lock.Reason)
+		assert.Equal(t, "user-1", result.Spec.Lock.LockedBy)
+		assert.NotNil(t, result.Spec.Lock.LockedAt)
+		assert.True(t, expiresAt.Equal(&result.Spec.Lock.ExpiresAt))
+		assert.Equal(t, releaseBindingTypeMeta, result.TypeMeta)
+
+		stored, err := svc.GetReleaseBinding(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		require.NotNil(t, stored.Spec.Lock)
+		assert.Equal(t, "release freeze", stored.Spec.Lock.Reason)
+	})
+
+	t.Run("reason required", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		svc := newService(t, rb)
+
+		_, err := svc.LockReleaseBinding(ctx, testNamespace, testRBName, openchoreov1alpha1.DeploymentLock{
+			Reason:    " ",
+			ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour)),
+		})
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("expiry in the past", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		svc := newService(t, rb)
+
+		_, err := svc.LockReleaseBinding(ctx, testNamespace, testRBName, openchoreov1alpha1.DeploymentLock{
+			Reason:    "freeze",
+			ExpiresAt: metav1.NewTime(time.Now().Add(-time.Minute)),
+		})
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.LockReleaseBinding(ctx, testNamespace, testRBName, openchoreov1alpha1.DeploymentLock{
+			Reason:    "freeze",
+			ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour)),
+		})
+		require.ErrorIs(t, err, ErrReleaseBindingNotFound)
+	})
+}
+
+func TestUnlockReleaseBinding(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removes the lock", func(t *testing.T) {
+		svc := newService(t, lockedReleaseBinding())
+
+		result, err := svc.UnlockReleaseBinding(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Nil(t, result.Spec.Lock)
+
+		stored, err := svc.GetReleaseBinding(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Nil(t, stored.Spec.Lock)
+	})
+
+	t.Run("not locked", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		svc := newService(t, rb)
+
+		result, err := svc.UnlockReleaseBinding(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Nil(t, result.Spec.Lock)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.UnlockReleaseBinding(ctx, testNamespace, testRBName)
+		require.ErrorIs(t, err, ErrReleaseBindingNotFound)
+	})
+}
+
+func TestLockedReleaseBindingRejectsChanges(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("update to a new release", func(t *testing.T) {
+		existing := lockedReleaseBinding()
+		svc := newService(t, existing)
+
+		update := &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: testRBName},
+			Spec:       existing.Spec,
+		}
+		update.Spec.Lock = nil
+		update.Spec.ReleaseName = "release-2"
+
+		_, err := svc.UpdateReleaseBinding(ctx, testNamespace, update)
+		var lockedErr *deploymentlock.LockedError
+		require.True(t, errors.As(err, &lockedErr), "expected LockedError, got %v", err)
+		assert.Equal(t, "incident", lockedErr.Lock.Reason)
+	})
+
+	t.Run("update without spec changes keeps the lock", func(t *testing.T) {
+		existing := lockedReleaseBinding()
+		svc := newService(t, existing)
+
+		update := &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: testRBName, Labels: map[string]string{"custom": "value"}},
+			Spec:       existing.Spec,
+		}
+		update.Spec.Lock = nil
+
+		result, err := svc.UpdateReleaseBinding(ctx, testNamespace, update)
+		require.NoError(t, err)
+		require.NotNil(t, result.Spec.Lock)
+		assert.Equal(t, "incident", result.Spec.Lock.Reason)
+	})
+
+	t.Run("suspend", func(t *testing.T) {
+		svc := newService(t, lockedReleaseBinding())
+
+		_, err := svc.SuspendReleaseBinding(ctx, testNamespace, testRBName)
+		var lockedErr *deploymentlock.LockedError
+		require.ErrorAs(t, err, &lockedErr)
+	})
+
+	t.Run("expired lock", func(t *testing.T) {
+		existing := lockedReleaseBinding()
+		existing.Spec.Lock.ExpiresAt = metav1.NewTime(time.Now().Add(-time.Minute))
+		svc := newService(t, existing)
+
+		result, err := svc.SuspendReleaseBinding(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.ReleaseStateUndeploy, result.Spec.State)
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// EnableMaintenance puts the component of the release binding in maintenance, so that the
+// gateway answers its requests with the given response instead of routing them to the
+// workload. The caller and the current time are recorded as enabledBy and enabledAt.
+// Enabling maintenance on a binding in maintenance replaces its response. Deployment locks
+// do not block maintenance.
+func (s *releaseBindingService) EnableMaintenance(ctx context.Context, namespaceName, releaseBindingName string, maintenance openchoreov1alpha1.MaintenanceMode) (*openchoreov1alpha1.ReleaseBinding, error) {
+	maintenance.Reason = strings.TrimSpace(maintenance.Reason)
+	if err := validateMaintenance(&maintenance); err != nil {
+		return nil, err
+	}
+
+	rb, err := s.getReleaseBinding(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	enabledAt := metav1.NewTime(time.Now().Truncate(time.Second))
+	maintenance.EnabledAt = &enabledAt
+	maintenance.EnabledBy = ""
+	if subject, ok := auth.GetSubjectContextFromContext(ctx); ok && subject != nil {
+		maintenance.EnabledBy = subject.ID
+	}
+	rb.Spec.Maintenance = &maintenance
+	if err := s.updateSpec(ctx, rb); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Enabled maintenance for release binding",
+		"namespace", namespaceName,
+		"releaseBinding", releaseBindingName,
+		"enabledBy", maintenance.EnabledBy,
+		"statusCode", maintenance.ResponseStatusCode(),
+	)
+	rb.TypeMeta = releaseBindingTypeMeta
+	return rb, nil
+}
+
+// DisableMaintenance ends the maintenance of the release binding, restoring its traffic.
+// Disabling maintenance on a binding that is not in maintenance is a no-op.
+func (s *releaseBindingService) DisableMaintenance(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	rb, err := s.getReleaseBinding(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	if rb.Spec.Maintenance != nil {
+		rb.Spec.Maintenance = nil
+		if err := s.updateSpec(ctx, rb); err != nil {
+			return nil, err
+		}
+		s.logger.Info("Disabled maintenance for release binding", "namespace", namespaceName, "releaseBinding", releaseBindingName)
+	}
+
+	rb.TypeMeta = releaseBindingTypeMeta
+	return rb, nil
+}
+
+// validateMaintenance applies the rules of the ReleaseBinding CRD, so that API callers get a
+// bad request instead of a rejected update.
+func validateMaintenance(m *openchoreov1alpha1.MaintenanceMode) error {
+	if m.RedirectURL != "" {
+		target, err := url.Parse(m.RedirectURL)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return &services.ValidationError{Msg: "maintenance redirectURL must be an absolute http or https URL"}
+		}
+		if m.Body != "" {
+			return &services.ValidationError{Msg: "maintenance body cannot be set together with redirectURL"}
+		}
+		if m.StatusCode != 0 && m.StatusCode != 301 && m.StatusCode != 302 {
+			return &services.ValidationError{Msg: "maintenance statusCode must be 301 or 302 when redirectURL is set"}
+		}
+	}
+	if m.StatusCode != 0 && (m.StatusCode < 200 || m.StatusCode > 599) {
+		return &services.ValidationError{Msg: "maintenance statusCode must be between 200 and 599"}
+	}
+	if len(m.Body) > 4096 {
+		return &services.ValidationError{Msg: "maintenance body must be at most 4096 bytes"}
+	}
+	if len(m.Reason) > 1024 {
+		return &services.ValidationError{Msg: "maintenance reason must be at most 1024 characters"}
+	}
+	return nil
+}
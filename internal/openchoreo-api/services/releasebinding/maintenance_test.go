@@ -0,0 +1,104 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func TestEnableMaintenance(t *testing.T) {
+	ctx := testutil.AuthzContext()
+
+	t.Run("records the caller and time", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		svc := newService(t, rb)
+
+		result, err := svc.EnableMaintenance(ctx, testNamespace, testRBName, openchoreov1alpha1.MaintenanceMode{
+			Body:      "Back soon",
+			Reason:    " database migration ",
+			EnabledBy: "spoofed",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result.Spec.Maintenance)
+		assert.Equal(t, "database migration", result.Spec.Maintenance.Reason)
+		assert.Equal(t, "user-1", result.Spec.Maintenance.EnabledBy)
+		assert.NotNil(t, result.Spec.Maintenance.EnabledAt)
+		assert.Equal(t, releaseBindingTypeMeta, result.TypeMeta)
+
+		stored, err := svc.GetReleaseBinding(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		require.NotNil(t, stored.Spec.Maintenance)
+		assert.Equal(t, "Back soon", stored.Spec.Maintenance.Body)
+	})
+
+	t.Run("allowed while locked", func(t *testing.T) {
+		svc := newService(t, lockedReleaseBinding())
+
+		result, err := svc.EnableMaintenance(ctx, testNamespace, testRBName, openchoreov1alpha1.MaintenanceMode{
+			RedirectURL: "https://status.example.com",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(302), result.Spec.Maintenance.ResponseStatusCode())
+	})
+
+	invalid := map[string]openchoreov1alpha1.MaintenanceMode{
+		"relative redirect":      {RedirectURL: "/maintenance"},
+		"body with redirect":     {RedirectURL: "https://status.example.com", Body: "down"},
+		"non-redirect status":    {RedirectURL: "https://status.example.com", StatusCode: 503},
+		"status out of range":    {StatusCode: 99},
+		"unsupported url scheme": {RedirectURL: "ftp://status.example.com"},
+	}
+	for name, maintenance := range invalid {
+		t.Run(name, func(t *testing.T) {
+			rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+			svc := newService(t, rb)
+
+			_, err := svc.EnableMaintenance(ctx, testNamespace, testRBName, maintenance)
+			var validationErr *services.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.EnableMaintenance(ctx, testNamespace, testRBName, openchoreov1alpha1.MaintenanceMode{})
+		require.ErrorIs(t, err, ErrReleaseBindingNotFound)
+	})
+}
+
+func TestDisableMaintenance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("restores traffic", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		rb.Spec.Maintenance = &openchoreov1alpha1.MaintenanceMode{Reason: "upgrade"}
+		svc := newService(t, rb)
+
+		result, err := svc.DisableMaintenance(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Nil(t, result.Spec.Maintenance)
+
+		stored, err := svc.GetReleaseBinding(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Nil(t, stored.Spec.Maintenance)
+	})
+
+	t.Run("not in maintenance", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		svc := newService(t, rb)
+
+		result, err := svc.DisableMaintenance(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Nil(t, result.Spec.Maintenance)
+	})
+}
@@ -5,10 +5,13 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	mock "github.com/stretchr/testify/mock"
 
 	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 
+	v1 "k8s.io/api/core/v1"
+
 	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
@@ -25,6 +28,67 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// ApplyResourceRecommendation provides a mock function with given fields: ctx, namespaceName, releaseBindingName, resources
+func (_m *MockService) ApplyResourceRecommendation(ctx context.Context, namespaceName string, releaseBindingName string, resources v1.ResourceRequirements) (*v1alpha1.ReleaseBinding, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName, resources)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyResourceRecommendation")
+	}
+
+	var r0 *v1alpha1.ReleaseBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, v1.ResourceRequirements) (*v1alpha1.ReleaseBinding, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName, resources)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, v1.ResourceRequirements) *v1alpha1.ReleaseBinding); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName, resources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReleaseBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, v1.ResourceRequirements) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName, resources)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ApplyResourceRecommendation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyResourceRecommendation'
+type MockService_ApplyResourceRecommendation_Call struct {
+	*mock.Call
+}
+
+// ApplyResourceRecommendation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+//   - resources v1.ResourceRequirements
+func (_e *MockService_Expecter) ApplyResourceRecommendation(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}, resources interface{}) *MockService_ApplyResourceRecommendation_Call {
+	return &MockService_ApplyResourceRecommendation_Call{Call: _e.mock.On("ApplyResourceRecommendation", ctx, namespaceName, releaseBindingName, resources)}
+}
+
+func (_c *MockService_ApplyResourceRecommendation_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string, resources v1.ResourceRequirements)) *MockService_ApplyResourceRecommendation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(v1.ResourceRequirements))
+	})
+	return _c
+}
+
+func (_c *MockService_ApplyResourceRecommendation_Call) Return(_a0 *v1alpha1.ReleaseBinding, _a1 error) *MockService_ApplyResourceRecommendation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ApplyResourceRecommendation_Call) RunAndReturn(run func(context.Context, string, string, v1.ResourceRequirements) (*v1alpha1.ReleaseBinding, error)) *MockService_ApplyResourceRecommendation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateReleaseBinding provides a mock function with given fields: ctx, namespaceName, rb
 func (_m *MockService) CreateReleaseBinding(ctx context.Context, namespaceName string, rb *v1alpha1.ReleaseBinding) (*v1alpha1.ReleaseBinding, error) {
 	ret := _m.Called(ctx, namespaceName, rb)
@@ -133,6 +197,187 @@ func (_c *MockService_DeleteReleaseBinding_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// DisableMaintenance provides a mock function with given fields: ctx, namespaceName, releaseBindingName
+func (_m *MockService) DisableMaintenance(ctx context.Context, namespaceName string, releaseBindingName string) (*v1alpha1.ReleaseBinding, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableMaintenance")
+	}
+
+	var r0 *v1alpha1.ReleaseBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.ReleaseBinding, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.ReleaseBinding); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReleaseBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_DisableMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableMaintenance'
+type MockService_DisableMaintenance_Call struct {
+	*mock.Call
+}
+
+// DisableMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+func (_e *MockService_Expecter) DisableMaintenance(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}) *MockService_DisableMaintenance_Call {
+	return &MockService_DisableMaintenance_Call{Call: _e.mock.On("DisableMaintenance", ctx, namespaceName, releaseBindingName)}
+}
+
+func (_c *MockService_DisableMaintenance_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string)) *MockService_DisableMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DisableMaintenance_Call) Return(_a0 *v1alpha1.ReleaseBinding, _a1 error) *MockService_DisableMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_DisableMaintenance_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.ReleaseBinding, error)) *MockService_DisableMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableMaintenance provides a mock function with given fields: ctx, namespaceName, releaseBindingName, maintenance
+func (_m *MockService) EnableMaintenance(ctx context.Context, namespaceName string, releaseBindingName string, maintenance v1alpha1.MaintenanceMode) (*v1alpha1.ReleaseBinding, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName, maintenance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableMaintenance")
+	}
+
+	var r0 *v1alpha1.ReleaseBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, v1alpha1.MaintenanceMode) (*v1alpha1.ReleaseBinding, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName, maintenance)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, v1alpha1.MaintenanceMode) *v1alpha1.ReleaseBinding); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName, maintenance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReleaseBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, v1alpha1.MaintenanceMode) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName, maintenance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_EnableMaintenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableMaintenance'
+type MockService_EnableMaintenance_Call struct {
+	*mock.Call
+}
+
+// EnableMaintenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+//   - maintenance v1alpha1.MaintenanceMode
+func (_e *MockService_Expecter) EnableMaintenance(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}, maintenance interface{}) *MockService_EnableMaintenance_Call {
+	return &MockService_EnableMaintenance_Call{Call: _e.mock.On("EnableMaintenance", ctx, namespaceName, releaseBindingName, maintenance)}
+}
+
+func (_c *MockService_EnableMaintenance_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string, maintenance v1alpha1.MaintenanceMode)) *MockService_EnableMaintenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(v1alpha1.MaintenanceMode))
+	})
+	return _c
+}
+
+func (_c *MockService_EnableMaintenance_Call) Return(_a0 *v1alpha1.ReleaseBinding, _a1 error) *MockService_EnableMaintenance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_EnableMaintenance_Call) RunAndReturn(run func(context.Context, string, string, v1alpha1.MaintenanceMode) (*v1alpha1.ReleaseBinding, error)) *MockService_EnableMaintenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfigDiff provides a mock function with given fields: ctx, namespaceName, releaseBindingName
+func (_m *MockService) GetConfigDiff(ctx context.Context, namespaceName string, releaseBindingName string) (*models.ConfigDiff, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfigDiff")
+	}
+
+	var r0 *models.ConfigDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.ConfigDiff, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.ConfigDiff); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ConfigDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetConfigDiff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfigDiff'
+type MockService_GetConfigDiff_Call struct {
+	*mock.Call
+}
+
+// GetConfigDiff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+func (_e *MockService_Expecter) GetConfigDiff(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}) *MockService_GetConfigDiff_Call {
+	return &MockService_GetConfigDiff_Call{Call: _e.mock.On("GetConfigDiff", ctx, namespaceName, releaseBindingName)}
+}
+
+func (_c *MockService_GetConfigDiff_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string)) *MockService_GetConfigDiff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetConfigDiff_Call) Return(_a0 *models.ConfigDiff, _a1 error) *MockService_GetConfigDiff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetConfigDiff_Call) RunAndReturn(run func(context.Context, string, string) (*models.ConfigDiff, error)) *MockService_GetConfigDiff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReleaseBinding provides a mock function with given fields: ctx, namespaceName, releaseBindingName
 func (_m *MockService) GetReleaseBinding(ctx context.Context, namespaceName string, releaseBindingName string) (*v1alpha1.ReleaseBinding, error) {
 	ret := _m.Called(ctx, namespaceName, releaseBindingName)
@@ -193,6 +438,186 @@ func (_c *MockService_GetReleaseBinding_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetReleaseBindingsRuntime provides a mock function with given fields: ctx, namespaceName, bindings
+func (_m *MockService) GetReleaseBindingsRuntime(ctx context.Context, namespaceName string, bindings []v1alpha1.ReleaseBinding) (map[string]*models.ReleaseBindingRuntime, error) {
+	ret := _m.Called(ctx, namespaceName, bindings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReleaseBindingsRuntime")
+	}
+
+	var r0 map[string]*models.ReleaseBindingRuntime
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []v1alpha1.ReleaseBinding) (map[string]*models.ReleaseBindingRuntime, error)); ok {
+		return rf(ctx, namespaceName, bindings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []v1alpha1.ReleaseBinding) map[string]*models.ReleaseBindingRuntime); ok {
+		r0 = rf(ctx, namespaceName, bindings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*models.ReleaseBindingRuntime)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []v1alpha1.ReleaseBinding) error); ok {
+		r1 = rf(ctx, namespaceName, bindings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetReleaseBindingsRuntime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReleaseBindingsRuntime'
+type MockService_GetReleaseBindingsRuntime_Call struct {
+	*mock.Call
+}
+
+// GetReleaseBindingsRuntime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - bindings []v1alpha1.ReleaseBinding
+func (_e *MockService_Expecter) GetReleaseBindingsRuntime(ctx interface{}, namespaceName interface{}, bindings interface{}) *MockService_GetReleaseBindingsRuntime_Call {
+	return &MockService_GetReleaseBindingsRuntime_Call{Call: _e.mock.On("GetReleaseBindingsRuntime", ctx, namespaceName, bindings)}
+}
+
+func (_c *MockService_GetReleaseBindingsRuntime_Call) Run(run func(ctx context.Context, namespaceName string, bindings []v1alpha1.ReleaseBinding)) *MockService_GetReleaseBindingsRuntime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]v1alpha1.ReleaseBinding))
+	})
+	return _c
+}
+
+func (_c *MockService_GetReleaseBindingsRuntime_Call) Return(_a0 map[string]*models.ReleaseBindingRuntime, _a1 error) *MockService_GetReleaseBindingsRuntime_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetReleaseBindingsRuntime_Call) RunAndReturn(run func(context.Context, string, []v1alpha1.ReleaseBinding) (map[string]*models.ReleaseBindingRuntime, error)) *MockService_GetReleaseBindingsRuntime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRolloutProgress provides a mock function with given fields: ctx, namespaceName, releaseBindingName
+func (_m *MockService) GetRolloutProgress(ctx context.Context, namespaceName string, releaseBindingName string) (*models.RolloutProgress, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRolloutProgress")
+	}
+
+	var r0 *models.RolloutProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.RolloutProgress, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.RolloutProgress); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RolloutProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetRolloutProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRolloutProgress'
+type MockService_GetRolloutProgress_Call struct {
+	*mock.Call
+}
+
+// GetRolloutProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+func (_e *MockService_Expecter) GetRolloutProgress(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}) *MockService_GetRolloutProgress_Call {
+	return &MockService_GetRolloutProgress_Call{Call: _e.mock.On("GetRolloutProgress", ctx, namespaceName, releaseBindingName)}
+}
+
+func (_c *MockService_GetRolloutProgress_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string)) *MockService_GetRolloutProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetRolloutProgress_Call) Return(_a0 *models.RolloutProgress, _a1 error) *MockService_GetRolloutProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetRolloutProgress_Call) RunAndReturn(run func(context.Context, string, string) (*models.RolloutProgress, error)) *MockService_GetRolloutProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatusHistory provides a mock function with given fields: ctx, namespaceName, releaseBindingName
+func (_m *MockService) GetStatusHistory(ctx context.Context, namespaceName string, releaseBindingName string) (*models.StatusHistory, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusHistory")
+	}
+
+	var r0 *models.StatusHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.StatusHistory, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.StatusHistory); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StatusHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatusHistory'
+type MockService_GetStatusHistory_Call struct {
+	*mock.Call
+}
+
+// GetStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+func (_e *MockService_Expecter) GetStatusHistory(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}) *MockService_GetStatusHistory_Call {
+	return &MockService_GetStatusHistory_Call{Call: _e.mock.On("GetStatusHistory", ctx, namespaceName, releaseBindingName)}
+}
+
+func (_c *MockService_GetStatusHistory_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string)) *MockService_GetStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetStatusHistory_Call) Return(_a0 *models.StatusHistory, _a1 error) *MockService_GetStatusHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetStatusHistory_Call) RunAndReturn(run func(context.Context, string, string) (*models.StatusHistory, error)) *MockService_GetStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListReleaseBindings provides a mock function with given fields: ctx, namespaceName, componentName, opts
 func (_m *MockService) ListReleaseBindings(ctx context.Context, namespaceName string, componentName string, opts services.ListOptions) (*services.ListResult[v1alpha1.ReleaseBinding], error) {
 	ret := _m.Called(ctx, namespaceName, componentName, opts)
@@ -254,6 +679,187 @@ func (_c *MockService_ListReleaseBindings_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// LockReleaseBinding provides a mock function with given fields: ctx, namespaceName, releaseBindingName, lock
+func (_m *MockService) LockReleaseBinding(ctx context.Context, namespaceName string, releaseBindingName string, lock v1alpha1.DeploymentLock) (*v1alpha1.ReleaseBinding, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName, lock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockReleaseBinding")
+	}
+
+	var r0 *v1alpha1.ReleaseBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, v1alpha1.DeploymentLock) (*v1alpha1.ReleaseBinding, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName, lock)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, v1alpha1.DeploymentLock) *v1alpha1.ReleaseBinding); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName, lock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReleaseBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, v1alpha1.DeploymentLock) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName, lock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_LockReleaseBinding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockReleaseBinding'
+type MockService_LockReleaseBinding_Call struct {
+	*mock.Call
+}
+
+// LockReleaseBinding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+//   - lock v1alpha1.DeploymentLock
+func (_e *MockService_Expecter) LockReleaseBinding(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}, lock interface{}) *MockService_LockReleaseBinding_Call {
+	return &MockService_LockReleaseBinding_Call{Call: _e.mock.On("LockReleaseBinding", ctx, namespaceName, releaseBindingName, lock)}
+}
+
+func (_c *MockService_LockReleaseBinding_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string, lock v1alpha1.DeploymentLock)) *MockService_LockReleaseBinding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(v1alpha1.DeploymentLock))
+	})
+	return _c
+}
+
+func (_c *MockService_LockReleaseBinding_Call) Return(_a0 *v1alpha1.ReleaseBinding, _a1 error) *MockService_LockReleaseBinding_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_LockReleaseBinding_Call) RunAndReturn(run func(context.Context, string, string, v1alpha1.DeploymentLock) (*v1alpha1.ReleaseBinding, error)) *MockService_LockReleaseBinding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuspendReleaseBinding provides a mock function with given fields: ctx, namespaceName, releaseBindingName
+func (_m *MockService) SuspendReleaseBinding(ctx context.Context, namespaceName string, releaseBindingName string) (*v1alpha1.ReleaseBinding, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuspendReleaseBinding")
+	}
+
+	var r0 *v1alpha1.ReleaseBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.ReleaseBinding, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.ReleaseBinding); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReleaseBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_SuspendReleaseBinding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendReleaseBinding'
+type MockService_SuspendReleaseBinding_Call struct {
+	*mock.Call
+}
+
+// SuspendReleaseBinding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+func (_e *MockService_Expecter) SuspendReleaseBinding(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}) *MockService_SuspendReleaseBinding_Call {
+	return &MockService_SuspendReleaseBinding_Call{Call: _e.mock.On("SuspendReleaseBinding", ctx, namespaceName, releaseBindingName)}
+}
+
+func (_c *MockService_SuspendReleaseBinding_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string)) *MockService_SuspendReleaseBinding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_SuspendReleaseBinding_Call) Return(_a0 *v1alpha1.ReleaseBinding, _a1 error) *MockService_SuspendReleaseBinding_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_SuspendReleaseBinding_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.ReleaseBinding, error)) *MockService_SuspendReleaseBinding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlockReleaseBinding provides a mock function with given fields: ctx, namespaceName, releaseBindingName
+func (_m *MockService) UnlockReleaseBinding(ctx context.Context, namespaceName string, releaseBindingName string) (*v1alpha1.ReleaseBinding, error) {
+	ret := _m.Called(ctx, namespaceName, releaseBindingName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockReleaseBinding")
+	}
+
+	var r0 *v1alpha1.ReleaseBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.ReleaseBinding, error)); ok {
+		return rf(ctx, namespaceName, releaseBindingName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.ReleaseBinding); ok {
+		r0 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReleaseBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, releaseBindingName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UnlockReleaseBinding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockReleaseBinding'
+type MockService_UnlockReleaseBinding_Call struct {
+	*mock.Call
+}
+
+// UnlockReleaseBinding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - releaseBindingName string
+func (_e *MockService_Expecter) UnlockReleaseBinding(ctx interface{}, namespaceName interface{}, releaseBindingName interface{}) *MockService_UnlockReleaseBinding_Call {
+	return &MockService_UnlockReleaseBinding_Call{Call: _e.mock.On("UnlockReleaseBinding", ctx, namespaceName, releaseBindingName)}
+}
+
+func (_c *MockService_UnlockReleaseBinding_Call) Run(run func(ctx context.Context, namespaceName string, releaseBindingName string)) *MockService_UnlockReleaseBinding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_UnlockReleaseBinding_Call) Return(_a0 *v1alpha1.ReleaseBinding, _a1 error) *MockService_UnlockReleaseBinding_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UnlockReleaseBinding_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.ReleaseBinding, error)) *MockService_UnlockReleaseBinding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateReleaseBinding provides a mock function with given fields: ctx, namespaceName, rb
 func (_m *MockService) UpdateReleaseBinding(ctx context.Context, namespaceName string, rb *v1alpha1.ReleaseBinding) (*v1alpha1.ReleaseBinding, error) {
 	ret := _m.Called(ctx, namespaceName, rb)
@@ -0,0 +1,160 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// AnnotationKeyResourceRecommendation records the last resource recommendation applied to
+// a ReleaseBinding: who applied it, when, and the values it replaced.
+const AnnotationKeyResourceRecommendation = "openchoreo.dev/resource-recommendation"
+
+// ResourceQuantities holds CPU and memory in Kubernetes quantity notation. Empty values
+// are left unchanged when a recommendation is applied.
+type ResourceQuantities struct {
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+}
+
+// ResourceSettings pairs container requests with limits.
+type ResourceSettings struct {
+	Requests ResourceQuantities `json:"requests"`
+	Limits   ResourceQuantities `json:"limits"`
+}
+
+// AppliedRecommendation is the audit record stored in AnnotationKeyResourceRecommendation.
+type AppliedRecommendation struct {
+	AppliedAt time.Time        `json:"appliedAt"`
+	AppliedBy string           `json:"appliedBy,omitempty"`
+	Previous  ResourceSettings `json:"previous"`
+	Applied   ResourceSettings `json:"applied"`
+}
+
+func (s *releaseBindingService) ApplyResourceRecommendation(ctx context.Context, namespaceName, releaseBindingName string, resources corev1.ResourceRequirements) (*openchoreov1alpha1.ReleaseBinding, error) {
+	settings := ResourceSettings{
+		Requests: quantitiesFromList(resources.Requests),
+		Limits:   quantitiesFromList(resources.Limits),
+	}
+	if settings == (ResourceSettings{}) {
+		return nil, &services.ValidationError{Msg: "at least one CPU or memory request or limit is required"}
+	}
+
+	rb := &openchoreov1alpha1.ReleaseBinding{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: releaseBindingName, Namespace: namespaceName}, rb); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrReleaseBindingNotFound
+		}
+		return nil, fmt.Errorf("failed to get release binding: %w", err)
+	}
+	old := rb.DeepCopy()
+
+	configs := map[string]any{}
+	if rb.Spec.ComponentTypeEnvironmentConfigs != nil && len(rb.Spec.ComponentTypeEnvironmentConfigs.Raw) > 0 {
+		if err := json.Unmarshal(rb.Spec.ComponentTypeEnvironmentConfigs.Raw, &configs); err != nil {
+			return nil, fmt.Errorf("failed to parse componentTypeEnvironmentConfigs: %w", err)
+		}
+	}
+
+	// Resource settings live under resources.requests and resources.limits, the layout
+	// used by the default component types.
+	resourceConfigs := childMap(configs, "resources")
+	record := AppliedRecommendation{
+		AppliedAt: time.Now().UTC(),
+		Applied:   settings,
+		Previous: ResourceSettings{
+			Requests: setQuantities(childMap(resourceConfigs, "requests"), settings.Requests),
+			Limits:   setQuantities(childMap(resourceConfigs, "limits"), settings.Limits),
+		},
+	}
+	if subject, ok := auth.GetSubjectContextFromContext(ctx); ok && subject != nil {
+		record.AppliedBy = subject.ID
+	}
+
+	raw, err := json.Marshal(configs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode componentTypeEnvironmentConfigs: %w", err)
+	}
+	rb.Spec.ComponentTypeEnvironmentConfigs = &runtime.RawExtension{Raw: raw}
+
+	audit, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode recommendation record: %w", err)
+	}
+	if rb.Annotations == nil {
+		rb.Annotations = make(map[string]string)
+	}
+	rb.Annotations[AnnotationKeyResourceRecommendation] = string(audit)
+	if err := checkLock(old, rb); err != nil {
+		return nil, err
+	}
+
+	if err := s.k8sClient.Update(ctx, rb); err != nil {
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return nil, vErr
+		}
+		return nil, fmt.Errorf("failed to update release binding: %w", err)
+	}
+
+	s.logger.Info("Applied resource recommendation",
+		"namespace", namespaceName,
+		"releaseBinding", releaseBindingName,
+		"appliedBy", record.AppliedBy,
+	)
+	rb.TypeMeta = releaseBindingTypeMeta
+	return rb, nil
+}
+
+func quantitiesFromList(list corev1.ResourceList) ResourceQuantities {
+	var q ResourceQuantities
+	if v, ok := list[corev1.ResourceCPU]; ok {
+		q.CPU = v.String()
+	}
+	if v, ok := list[corev1.ResourceMemory]; ok {
+		q.Memory = v.String()
+	}
+	return q
+}
+
+// childMap returns m[key] as a map, creating it when missing or not a map.
+func childMap(m map[string]any, key string) map[string]any {
+	child, ok := m[key].(map[string]any)
+	if !ok {
+		child = map[string]any{}
+		m[key] = child
+	}
+	return child
+}
+
+// setQuantities writes the non-empty quantities into m and returns the values they replaced.
+func setQuantities(m map[string]any, q ResourceQuantities) ResourceQuantities {
+	var previous ResourceQuantities
+	if q.CPU != "" {
+		previous.CPU = fmt.Sprint(valueOrEmpty(m["cpu"]))
+		m["cpu"] = q.CPU
+	}
+	if q.Memory != "" {
+		previous.Memory = fmt.Sprint(valueOrEmpty(m["memory"]))
+		m["memory"] = q.Memory
+	}
+	return previous
+}
+
+func valueOrEmpty(v any) any {
+	if v == nil {
+		return ""
+	}
+	return v
+}
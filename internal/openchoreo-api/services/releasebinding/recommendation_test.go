@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+func TestApplyResourceRecommendation(t *testing.T) {
+	ctx := auth.SetSubjectContext(context.Background(), &auth.SubjectContext{ID: "alice", Type: "user"})
+
+	t.Run("merges into existing overrides and records the change", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		rb.Spec.ComponentTypeEnvironmentConfigs = &runtime.RawExtension{
+			Raw: []byte(`{"replicas":2,"resources":{"requests":{"cpu":"500m","memory":"512Mi"}}}`),
+		}
+		svc := newService(t, rb)
+
+		result, err := svc.ApplyResourceRecommendation(ctx, testNamespace, testRBName, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("120m"),
+				corev1.ResourceMemory: resource.MustParse("200Mi"),
+			},
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, releaseBindingTypeMeta, result.TypeMeta)
+
+		var configs map[string]any
+		require.NoError(t, json.Unmarshal(result.Spec.ComponentTypeEnvironmentConfigs.Raw, &configs))
+		assert.Equal(t, map[string]any{
+			"replicas": float64(2),
+			"resources": map[string]any{
+				"requests": map[string]any{"cpu": "120m", "memory": "200Mi"},
+				"limits":   map[string]any{"memory": "256Mi"},
+			},
+		}, configs)
+
+		var record AppliedRecommendation
+		require.NoError(t, json.Unmarshal([]byte(result.Annotations[AnnotationKeyResourceRecommendation]), &record))
+		assert.Equal(t, "alice", record.AppliedBy)
+		assert.Equal(t, ResourceSettings{
+			Requests: ResourceQuantities{CPU: "500m", Memory: "512Mi"},
+			Limits:   ResourceQuantities{Memory: ""},
+		}, record.Previous)
+		assert.Equal(t, "256Mi", record.Applied.Limits.Memory)
+	})
+
+	t.Run("empty settings", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.ApplyResourceRecommendation(ctx, testNamespace, testRBName, corev1.ResourceRequirements{})
+		var vErr *services.ValidationError
+		require.ErrorAs(t, err, &vErr)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.ApplyResourceRecommendation(ctx, testNamespace, testRBName, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+		})
+		require.ErrorIs(t, err, ErrReleaseBindingNotFound)
+	})
+}
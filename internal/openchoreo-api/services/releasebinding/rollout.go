@@ -0,0 +1,175 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// rolloutWorkloadKinds are the rendered kinds whose replicas make up the rollout progress.
+var rolloutWorkloadKinds = map[schema.GroupKind]bool{
+	{Group: "apps", Kind: "Deployment"}:     true,
+	{Group: "apps", Kind: "StatefulSet"}:    true,
+	{Group: "argoproj.io", Kind: "Rollout"}: true,
+}
+
+// workloadObject holds the fields of a rendered workload that define its desired replicas
+// and, for progressive rollouts, its canary steps.
+type workloadObject struct {
+	Spec struct {
+		Replicas *int32 `json:"replicas"`
+		Strategy struct {
+			Canary *struct {
+				Steps []json.RawMessage `json:"steps"`
+			} `json:"canary"`
+		} `json:"strategy"`
+	} `json:"spec"`
+}
+
+// workloadStatus holds the replica counts reported in the live status of a workload.
+type workloadStatus struct {
+	UpdatedReplicas  int32  `json:"updatedReplicas"`
+	ReadyReplicas    int32  `json:"readyReplicas"`
+	CurrentStepIndex *int32 `json:"currentStepIndex"`
+}
+
+func (s *releaseBindingService) GetRolloutProgress(ctx context.Context, namespaceName, releaseBindingName string) (*models.RolloutProgress, error) {
+	s.logger.Debug("Getting rollout progress", "namespace", namespaceName, "releaseBinding", releaseBindingName)
+
+	rb, err := s.GetReleaseBinding(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &models.RolloutProgress{
+		ReleaseBinding: rb.Name,
+		Environment:    rb.Spec.Environment,
+		Release:        rb.Spec.ReleaseName,
+		Workloads:      []models.WorkloadRollout{},
+	}
+	var startedAt time.Time
+	if n := len(rb.Status.DeploymentHistory); n > 0 {
+		current := rb.Status.DeploymentHistory[n-1]
+		progress.Release = current.Release
+		startedAt = current.DeployedAt.Time
+	}
+
+	// The data plane release is named {component}-{environment} by the release binding controller.
+	release := &openchoreov1alpha1.RenderedRelease{}
+	key := client.ObjectKey{Namespace: namespaceName, Name: fmt.Sprintf("%s-%s", rb.Spec.Owner.ComponentName, rb.Spec.Environment)}
+	if err := s.k8sClient.Get(ctx, key, release); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return progress, nil
+		}
+		s.logger.Error("Failed to get rendered release", "error", err)
+		return nil, fmt.Errorf("failed to get rendered release: %w", err)
+	}
+	if !metav1.IsControlledBy(release, rb) {
+		return progress, nil
+	}
+
+	workloads, err := rolloutWorkloads(release)
+	if err != nil {
+		return nil, err
+	}
+	progress.Workloads = workloads
+	summarizeRollout(progress, startedAt, time.Now())
+	return progress, nil
+}
+
+// rolloutWorkloads returns the rollout progress of each workload applied by the release.
+// Workloads without a reported status yet count as not updated.
+func rolloutWorkloads(release *openchoreov1alpha1.RenderedRelease) ([]models.WorkloadRollout, error) {
+	objects := make(map[string][]byte, len(release.Spec.Resources))
+	for _, r := range release.Spec.Resources {
+		if r.Object != nil {
+			objects[r.ID] = r.Object.Raw
+		}
+	}
+
+	workloads := []models.WorkloadRollout{}
+	for _, r := range release.Status.Resources {
+		if !rolloutWorkloadKinds[schema.GroupKind{Group: r.Group, Kind: r.Kind}] {
+			continue
+		}
+
+		var object workloadObject
+		if raw := objects[r.ID]; len(raw) > 0 {
+			if err := json.Unmarshal(raw, &object); err != nil {
+				return nil, fmt.Errorf("failed to parse rendered %s %q: %w", r.Kind, r.Name, err)
+			}
+		}
+		var status workloadStatus
+		if r.Status != nil && len(r.Status.Raw) > 0 {
+			if err := json.Unmarshal(r.Status.Raw, &status); err != nil {
+				return nil, fmt.Errorf("failed to parse status of %s %q: %w", r.Kind, r.Name, err)
+			}
+		}
+
+		w := models.WorkloadRollout{
+			Kind:            r.Kind,
+			Name:            r.Name,
+			Namespace:       r.Namespace,
+			DesiredReplicas: 1,
+			UpdatedReplicas: status.UpdatedReplicas,
+			ReadyReplicas:   status.ReadyReplicas,
+		}
+		if object.Spec.Replicas != nil {
+			w.DesiredReplicas = *object.Spec.Replicas
+		}
+		if canary := object.Spec.Strategy.Canary; canary != nil && len(canary.Steps) > 0 {
+			total := int32(len(canary.Steps))
+			current := int32(0)
+			if status.CurrentStepIndex != nil {
+				current = *status.CurrentStepIndex
+			}
+			w.CurrentStep, w.TotalSteps = &current, &total
+		}
+		workloads = append(workloads, w)
+	}
+	return workloads, nil
+}
+
+// summarizeRollout totals the workload replicas of the progress. A replica counts as done
+// once it is both updated and ready. The ETA extrapolates the rate at which replicas became
+// done since the rollout started at startedAt, so it is only known once a replica is done.
+func summarizeRollout(progress *models.RolloutProgress, startedAt, now time.Time) {
+	var done int32
+	for _, w := range progress.Workloads {
+		progress.DesiredReplicas += w.DesiredReplicas
+		progress.UpdatedReplicas += w.UpdatedReplicas
+		progress.ReadyReplicas += w.ReadyReplicas
+		done += min(w.UpdatedReplicas, w.ReadyReplicas, w.DesiredReplicas)
+	}
+	if len(progress.Workloads) == 0 {
+		return
+	}
+
+	remaining := progress.DesiredReplicas - done
+	if progress.DesiredReplicas == 0 || remaining == 0 {
+		progress.Percentage = 100
+		progress.Complete = true
+		eta := int64(0)
+		progress.ETASeconds = &eta
+		return
+	}
+	progress.Percentage = done * 100 / progress.DesiredReplicas
+
+	elapsed := now.Sub(startedAt)
+	if done == 0 || startedAt.IsZero() || elapsed <= 0 {
+		return
+	}
+	eta := int64((elapsed * time.Duration(remaining) / time.Duration(done)).Round(time.Second).Seconds())
+	progress.ETASeconds = &eta
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newRolloutBinding(deployedAt time.Time) *openchoreov1alpha1.ReleaseBinding {
+	rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+	rb.UID = "rb-uid"
+	rb.Spec.ReleaseName = "rel-2"
+	rb.Status.DeploymentHistory = []openchoreov1alpha1.DeploymentRecord{
+		{Release: "rel-1", DeployedAt: metav1.NewTime(deployedAt.Add(-time.Hour))},
+		{Release: "rel-2", DeployedAt: metav1.NewTime(deployedAt)},
+	}
+	return rb
+}
+
+func newRolloutRelease(rb *openchoreov1alpha1.ReleaseBinding) *openchoreov1alpha1.RenderedRelease {
+	return &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testComponentName + "-" + testEnvironmentName,
+			Namespace: testNamespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: openchoreov1alpha1.GroupVersion.String(),
+				Kind:       "ReleaseBinding",
+				Name:       rb.Name,
+				UID:        rb.UID,
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{
+			EnvironmentName: testEnvironmentName,
+			Resources: []openchoreov1alpha1.RenderedManifest{
+				{ID: "deployment", Object: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment","spec":{"replicas":3}}`)}},
+				{ID: "rollout", Object: &runtime.RawExtension{Raw: []byte(
+					`{"apiVersion":"argoproj.io/v1alpha1","kind":"Rollout","spec":{"strategy":{"canary":{"steps":[{"setWeight":20},{"pause":{}},{"setWeight":100}]}}}}`)}},
+				{ID: "service", Object: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Service"}`)}},
+			},
+		},
+		Status: openchoreov1alpha1.RenderedReleaseStatus{
+			Resources: []openchoreov1alpha1.RenderedManifestStatus{
+				{ID: "deployment", Group: "apps", Version: "v1", Kind: "Deployment", Name: "api", Namespace: "dp",
+					Status: &runtime.RawExtension{Raw: []byte(`{"replicas":4,"updatedReplicas":2,"readyReplicas":3}`)}},
+				{ID: "rollout", Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout", Name: "canary", Namespace: "dp",
+					Status: &runtime.RawExtension{Raw: []byte(`{"updatedReplicas":0,"readyReplicas":1,"currentStepIndex":1}`)}},
+				{ID: "service", Version: "v1", Kind: "Service", Name: "api", Namespace: "dp"},
+			},
+		},
+	}
+}
+
+func TestGetRolloutProgress(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports the rendered workloads", func(t *testing.T) {
+		rb := newRolloutBinding(time.Now().Add(-time.Minute))
+		svc := newService(t, rb, newRolloutRelease(rb))
+
+		progress, err := svc.GetRolloutProgress(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Equal(t, "rel-2", progress.Release)
+		assert.Equal(t, testEnvironmentName, progress.Environment)
+		require.Len(t, progress.Workloads, 2)
+
+		deployment := progress.Workloads[0]
+		assert.Equal(t, models.WorkloadRollout{
+			Kind: "Deployment", Name: "api", Namespace: "dp",
+			DesiredReplicas: 3, UpdatedReplicas: 2, ReadyReplicas: 3,
+		}, deployment)
+
+		rollout := progress.Workloads[1]
+		assert.Equal(t, int32(1), rollout.DesiredReplicas)
+		require.NotNil(t, rollout.CurrentStep)
+		require.NotNil(t, rollout.TotalSteps)
+		assert.Equal(t, int32(1), *rollout.CurrentStep)
+		assert.Equal(t, int32(3), *rollout.TotalSteps)
+
+		assert.Equal(t, int32(4), progress.DesiredReplicas)
+		assert.Equal(t, int32(50), progress.Percentage)
+		assert.False(t, progress.Complete)
+		require.NotNil(t, progress.ETASeconds)
+		assert.InDelta(t, 60, *progress.ETASeconds, 2)
+	})
+
+	t.Run("no rendered release yet", func(t *testing.T) {
+		rb := newRolloutBinding(time.Now())
+		svc := newService(t, rb)
+
+		progress, err := svc.GetRolloutProgress(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Empty(t, progress.Workloads)
+		assert.False(t, progress.Complete)
+		assert.Nil(t, progress.ETASeconds)
+	})
+
+	t.Run("ignores a release owned by another binding", func(t *testing.T) {
+		rb := newRolloutBinding(time.Now())
+		release := newRolloutRelease(rb)
+		release.OwnerReferences[0].UID = "other-uid"
+		svc := newService(t, rb, release)
+
+		progress, err := svc.GetRolloutProgress(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Empty(t, progress.Workloads)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.GetRolloutProgress(ctx, testNamespace, testRBName)
+		require.ErrorIs(t, err, ErrReleaseBindingNotFound)
+	})
+}
+
+func TestSummarizeRollout(t *testing.T) {
+	startedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := startedAt.Add(2 * time.Minute)
+
+	t.Run("ETA is extrapolated from the ready rate", func(t *testing.T) {
+		progress := &models.RolloutProgress{Workloads: []models.WorkloadRollout{
+			{DesiredReplicas: 4, UpdatedReplicas: 2, ReadyReplicas: 2},
+			{DesiredReplicas: 2, UpdatedReplicas: 1, ReadyReplicas: 2},
+		}}
+		summarizeRollout(progress, startedAt, now)
+		assert.Equal(t, int32(50), progress.Percentage)
+		require.NotNil(t, progress.ETASeconds)
+		// 3 replicas done in 2 minutes, 3 to go.
+		assert.Equal(t, int64(120), *progress.ETASeconds)
+	})
+
+	t.Run("ETA is unknown before the first replica is done", func(t *testing.T) {
+		progress := &models.RolloutProgress{Workloads: []models.WorkloadRollout{
+			{DesiredReplicas: 2, UpdatedReplicas: 2},
+		}}
+		summarizeRollout(progress, startedAt, now)
+		assert.Zero(t, progress.Percentage)
+		assert.Nil(t, progress.ETASeconds)
+	})
+
+	t.Run("complete rollout", func(t *testing.T) {
+		progress := &models.RolloutProgress{Workloads: []models.WorkloadRollout{
+			{DesiredReplicas: 2, UpdatedReplicas: 3, ReadyReplicas: 3},
+		}}
+		summarizeRollout(progress, startedAt, now)
+		assert.Equal(t, int32(100), progress.Percentage)
+		assert.True(t, progress.Complete)
+		require.NotNil(t, progress.ETASeconds)
+		assert.Zero(t, *progress.ETASeconds)
+	})
+}
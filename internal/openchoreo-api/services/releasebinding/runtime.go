@@ -0,0 +1,239 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+const (
+	// trafficWindow is the window the request and error rates are averaged over.
+	trafficWindow = 5 * time.Minute
+	// observerTimeout bounds a single metrics query to an observer.
+	observerTimeout = 5 * time.Second
+	// maxTrafficQueries bounds the metrics queries running at once for one list.
+	maxTrafficQueries = 8
+
+	observerMetricsQueryPath = "/api/v1/metrics/query"
+)
+
+// httpMetricsQuery is the payload of an HTTP metrics query to the observer.
+type httpMetricsQuery struct {
+	Metric      string           `json:"metric"`
+	SearchScope httpMetricsScope `json:"searchScope"`
+	StartTime   time.Time        `json:"startTime"`
+	EndTime     time.Time        `json:"endTime"`
+	Step        string           `json:"step"`
+}
+
+type httpMetricsScope struct {
+	Namespace   string `json:"namespace"`
+	Project     string `json:"project"`
+	Component   string `json:"component"`
+	Environment string `json:"environment"`
+}
+
+// httpMetrics holds the series of an HTTP metrics query response used for the traffic rates.
+// Both series are request rates in requests per second.
+type httpMetrics struct {
+	RequestCount             []metricPoint `json:"requestCount"`
+	UnsuccessfulRequestCount []metricPoint `json:"unsuccessfulRequestCount"`
+}
+
+type metricPoint struct {
+	Value float64 `json:"value"`
+}
+
+// GetReleaseBindingsRuntime returns the runtime state of the given release bindings keyed by
+// binding name. Replica counts come from the status model. Request and error rates are queried
+// from the observer of each environment with the caller's token; they are left out for
+// environments without an observer and for queries that fail, so a slow or unavailable
+// observer never fails the list.
+func (s *releaseBindingService) GetReleaseBindingsRuntime(ctx context.Context, namespaceName string, bindings []openchoreov1alpha1.ReleaseBinding) (map[string]*models.ReleaseBindingRuntime, error) {
+	runtimes := make(map[string]*models.ReleaseBindingRuntime, len(bindings))
+	if len(bindings) == 0 {
+		return runtimes, nil
+	}
+
+	reader, err := s.readerFor(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	projects := map[string]*statusmodel.ProjectStatus{}
+	for _, rb := range bindings {
+		project := rb.Spec.Owner.ProjectName
+		if _, ok := projects[project]; !ok {
+			projects[project] = reader.ProjectStatus(namespaceName, project)
+		}
+		runtimes[rb.Name] = &models.ReleaseBindingRuntime{Replicas: bindingReplicas(projects[project], rb)}
+	}
+
+	observerURLs := map[string]string{}
+	for _, rb := range bindings {
+		if _, ok := observerURLs[rb.Spec.Environment]; !ok {
+			observerURLs[rb.Spec.Environment] = s.environmentObserverURL(ctx, namespaceName, rb.Spec.Environment)
+		}
+	}
+
+	now := time.Now()
+	sem := make(chan struct{}, maxTrafficQueries)
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for _, rb := range bindings {
+		observerURL := observerURLs[rb.Spec.Environment]
+		if observerURL == "" {
+			continue
+		}
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			requestRate, errorRate, err := s.queryTraffic(ctx, observerURL, namespaceName, rb, now)
+			if err != nil {
+				s.logger.Warn("Failed to query release binding traffic", "releaseBinding", rb.Name, "error", err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			runtimes[rb.Name].RequestRate = requestRate
+			runtimes[rb.Name].ErrorRate = errorRate
+		})
+	}
+	wg.Wait()
+	return runtimes, nil
+}
+
+// readerFor returns the status model, or a one-off model of the namespace built from live LIST
+// calls when the status model is disabled.
+func (s *releaseBindingService) readerFor(ctx context.Context, namespaceName string) (statusmodel.Reader, error) {
+	if s.reader != nil {
+		return s.reader, nil
+	}
+	store, err := statusmodel.List(ctx, s.k8sClient, namespaceName)
+	if err != nil {
+		s.logger.Error("Failed to build status model", "error", err)
+		return nil, fmt.Errorf("failed to build status model: %w", err)
+	}
+	return store, nil
+}
+
+// bindingReplicas returns the replica counts of a binding's environment cell when the cell
+// belongs to the binding.
+func bindingReplicas(status *statusmodel.ProjectStatus, rb openchoreov1alpha1.ReleaseBinding) *models.ReplicaCounts {
+	for _, component := range status.Components {
+		if component.Name != rb.Spec.Owner.ComponentName {
+			continue
+		}
+		env, ok := component.Environments[rb.Spec.Environment]
+		if !ok || env.ReleaseBinding != rb.Name || env.Replicas == nil {
+			return nil
+		}
+		return &models.ReplicaCounts{
+			Replicas:          env.Replicas.Replicas,
+			ReadyReplicas:     env.Replicas.Ready,
+			AvailableReplicas: env.Replicas.Available,
+		}
+	}
+	return nil
+}
+
+// environmentObserverURL returns the URL of the observer that serves an environment's metrics,
+// or an empty string when the environment has none.
+func (s *releaseBindingService) environmentObserverURL(ctx context.Context, namespaceName, environmentName string) string {
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: environmentName}, env); err != nil {
+		s.logger.Debug("Failed to get environment", "environment", environmentName, "error", err)
+		return ""
+	}
+	dpResult, err := controller.GetDataPlaneFromRef(ctx, s.k8sClient, env.Namespace, env.Spec.DataPlaneRef)
+	if err != nil {
+		s.logger.Debug("Failed to resolve data plane for environment", "environment", environmentName, "error", err)
+		return ""
+	}
+	obsResult, err := dpResult.GetObservabilityPlane(ctx, s.k8sClient)
+	if err != nil {
+		s.logger.Debug("Failed to resolve observability plane for environment", "environment", environmentName, "error", err)
+		return ""
+	}
+	return obsResult.GetObserverURL()
+}
+
+// queryTraffic returns the mean request rate of a binding over the traffic window and the ratio
+// of unsuccessful requests. The error rate is nil when there were no requests.
+func (s *releaseBindingService) queryTraffic(ctx context.Context, observerURL, namespaceName string, rb openchoreov1alpha1.ReleaseBinding, now time.Time) (*float64, *float64, error) {
+	body, err := json.Marshal(httpMetricsQuery{
+		Metric: "http",
+		SearchScope: httpMetricsScope{
+			Namespace:   namespaceName,
+			Project:     rb.Spec.Owner.ProjectName,
+			Component:   rb.Spec.Owner.ComponentName,
+			Environment: rb.Spec.Environment,
+		},
+		StartTime: now.Add(-trafficWindow),
+		EndTime:   now,
+		Step:      "1m",
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal metrics query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(observerURL, "/")+observerMetricsQueryPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token := jwt.GetTokenFromContext(ctx); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("metrics query failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("observer metrics API returned status %d", resp.StatusCode)
+	}
+
+	var metrics httpMetrics
+	if err := json.NewDecoder(resp.Body).Decode(&metrics); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode metrics response: %w", err)
+	}
+	if len(metrics.RequestCount) == 0 {
+		return nil, nil, nil
+	}
+
+	requests := sumPoints(metrics.RequestCount)
+	requestRate := requests / float64(len(metrics.RequestCount))
+	if requests == 0 {
+		return &requestRate, nil, nil
+	}
+	errorRate := min(sumPoints(metrics.UnsuccessfulRequestCount)/requests, 1)
+	return &requestRate, &errorRate, nil
+}
+
+func sumPoints(points []metricPoint) float64 {
+	var sum float64
+	for _, p := range points {
+		sum += p.Value
+	}
+	return sum
+}
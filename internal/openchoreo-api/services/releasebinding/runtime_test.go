@@ -0,0 +1,118 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func runtimeTestObjects(observerURL string) []client.Object {
+	dev := testutil.NewEnvironment(testNamespace, "dev")
+	dev.Spec.DataPlaneRef = &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane, Name: "dp"}
+	dp := testutil.NewDataPlane(testNamespace, "dp")
+	dp.Spec.ObservabilityPlaneRef = &openchoreov1alpha1.ObservabilityPlaneRef{
+		Kind: openchoreov1alpha1.ObservabilityPlaneRefKindObservabilityPlane,
+		Name: "obs",
+	}
+	obs := testutil.NewObservabilityPlane(testNamespace, "obs")
+	obs.Spec.ObserverURL = observerURL
+
+	rr := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-component-dev", Namespace: testNamespace},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{
+			Owner:           openchoreov1alpha1.RenderedReleaseOwner{ProjectName: testProjectName, ComponentName: testComponentName},
+			EnvironmentName: "dev",
+		},
+		Status: openchoreov1alpha1.RenderedReleaseStatus{Resources: []openchoreov1alpha1.RenderedManifestStatus{{
+			Group: "apps", Kind: "Deployment", HealthStatus: openchoreov1alpha1.HealthStatusHealthy,
+			Status: &runtime.RawExtension{Raw: []byte(`{"replicas":2,"readyReplicas":2,"availableReplicas":1}`)},
+		}}},
+	}
+
+	return []client.Object{
+		dev, dp, obs, rr,
+		testutil.NewEnvironment(testNamespace, "prod"),
+		testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "dev", "rb-dev"),
+		testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "prod", "rb-prod"),
+	}
+}
+
+func TestGetReleaseBindingsRuntime(t *testing.T) {
+	ctx := context.Background()
+	bindings := []openchoreov1alpha1.ReleaseBinding{
+		*testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "dev", "rb-dev"),
+		*testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "prod", "rb-prod"),
+	}
+
+	t.Run("replicas and traffic", func(t *testing.T) {
+		var query httpMetricsQuery
+		observer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, observerMetricsQueryPath, r.URL.Path)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+			_, _ = w.Write([]byte(`{"requestCount":[{"value":10},{"value":30}],"unsuccessfulRequestCount":[{"value":1},{"value":3}]}`))
+		}))
+		defer observer.Close()
+		svc := newService(t, runtimeTestObjects(observer.URL)...)
+
+		runtimes, err := svc.GetReleaseBindingsRuntime(ctx, testNamespace, bindings)
+		require.NoError(t, err)
+		require.Len(t, runtimes, 2)
+
+		dev := runtimes["rb-dev"]
+		assert.Equal(t, &models.ReplicaCounts{Replicas: 2, ReadyReplicas: 2, AvailableReplicas: 1}, dev.Replicas)
+		require.NotNil(t, dev.RequestRate)
+		assert.InDelta(t, 20.0, *dev.RequestRate, 1e-9)
+		require.NotNil(t, dev.ErrorRate)
+		assert.InDelta(t, 0.1, *dev.ErrorRate, 1e-9)
+		assert.Equal(t, "http", query.Metric)
+		assert.Equal(t, httpMetricsScope{Namespace: testNamespace, Project: testProjectName, Component: testComponentName, Environment: "dev"}, query.SearchScope)
+		assert.Equal(t, trafficWindow, query.EndTime.Sub(query.StartTime))
+
+		// prod has no RenderedRelease and no observability plane
+		assert.Equal(t, &models.ReleaseBindingRuntime{}, runtimes["rb-prod"])
+	})
+
+	t.Run("unavailable observer leaves traffic out", func(t *testing.T) {
+		observer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer observer.Close()
+		svc := newService(t, runtimeTestObjects(observer.URL)...)
+
+		runtimes, err := svc.GetReleaseBindingsRuntime(ctx, testNamespace, bindings[:1])
+		require.NoError(t, err)
+		dev := runtimes["rb-dev"]
+		assert.NotNil(t, dev.Replicas)
+		assert.Nil(t, dev.RequestRate)
+		assert.Nil(t, dev.ErrorRate)
+	})
+
+	t.Run("no traffic has no error rate", func(t *testing.T) {
+		observer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"requestCount":[{"value":0}],"unsuccessfulRequestCount":[{"value":0}]}`))
+		}))
+		defer observer.Close()
+		svc := newService(t, runtimeTestObjects(observer.URL)...)
+
+		runtimes, err := svc.GetReleaseBindingsRuntime(ctx, testNamespace, bindings[:1])
+		require.NoError(t, err)
+		require.NotNil(t, runtimes["rb-dev"].RequestRate)
+		assert.Zero(t, *runtimes["rb-dev"].RequestRate)
+		assert.Nil(t, runtimes["rb-dev"].ErrorRate)
+	})
+}
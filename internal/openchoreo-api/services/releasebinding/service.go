@@ -7,21 +7,26 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
 )
 
 // releaseBindingService handles release binding business logic without authorization checks.
 // Other services within this layer should use this directly to avoid double authz.
 type releaseBindingService struct {
-	k8sClient client.Client
-	logger    *slog.Logger
+	k8sClient  client.Client
+	reader     statusmodel.Reader
+	httpClient *http.Client
+	logger     *slog.Logger
 }
 
 var releaseBindingTypeMeta = metav1.TypeMeta{
@@ -31,11 +36,14 @@ var releaseBindingTypeMeta = metav1.TypeMeta{
 
 var _ Service = (*releaseBindingService)(nil)
 
-// NewService creates a new release binding service without authorization.
-func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+// NewService creates a new release binding service without authorization. reader may be nil,
+// in which case the replica counts of release bindings are read with live LIST calls.
+func NewService(k8sClient client.Client, reader statusmodel.Reader, logger *slog.Logger) Service {
 	return &releaseBindingService{
-		k8sClient: k8sClient,
-		logger:    logger,
+		k8sClient:  k8sClient,
+		reader:     reader,
+		httpClient: &http.Client{Timeout: observerTimeout},
+		logger:     logger,
 	}
 }
 
@@ -61,6 +69,10 @@ func (s *releaseBindingService) CreateReleaseBinding(ctx context.Context, namesp
 		return nil, ErrReleaseBindingAlreadyExists
 	}
 
+	if err := s.checkProjectQuota(ctx, namespaceName, rb); err != nil {
+		return nil, err
+	}
+
 	// Set defaults
 	rb.Namespace = namespaceName
 	rb.Status = openchoreov1alpha1.ReleaseBindingStatus{}
@@ -107,6 +119,14 @@ func (s *releaseBindingService) UpdateReleaseBinding(ctx context.Context, namesp
 	// Clear status from user input — status is server-managed
 	rb.Status = openchoreov1alpha1.ReleaseBindingStatus{}
 
+	// Locks are managed through the lock endpoints; an update without a lock keeps the existing one
+	if rb.Spec.Lock == nil {
+		rb.Spec.Lock = existing.Spec.Lock
+	}
+	if err := checkLock(existing, rb); err != nil {
+		return nil, err
+	}
+
 	// Only apply user-mutable fields to the existing object, preserving server-managed fields
 	existing.Spec = rb.Spec
 	existing.Labels = rb.Labels
@@ -251,3 +271,32 @@ func (s *releaseBindingService) validateComponentExists(ctx context.Context, nam
 	}
 	return nil
 }
+
+// checkProjectQuota rejects a release binding that would deploy its project to more
+// environments than the project quota allows, or while the CPU or memory budget of the
+// project is used up. Bindings of projects without a quota are not checked.
+func (s *releaseBindingService) checkProjectQuota(ctx context.Context, namespaceName string, rb *openchoreov1alpha1.ReleaseBinding) error {
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: rb.Spec.Owner.ProjectName, Namespace: namespaceName}, project); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil
+		}
+		s.logger.Error("Failed to get project", "error", err, "project", rb.Spec.Owner.ProjectName)
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	if project.Spec.Quota == nil {
+		return nil
+	}
+
+	usage, err := controller.GetProjectQuotaUsage(ctx, s.k8sClient, namespaceName, project.Name)
+	if err != nil {
+		s.logger.Error("Failed to get project quota usage", "error", err, "project", project.Name)
+		return fmt.Errorf("failed to get project quota usage: %w", err)
+	}
+	if err := usage.CheckDeploy(project, rb.Spec.Environment); err != nil {
+		s.logger.Info("Rejecting release binding, project quota exhausted",
+			"namespace", namespaceName, "releaseBinding", rb.Name, "error", err)
+		return err
+	}
+	return nil
+}
@@ -7,11 +7,14 @@ import (
 	"context"
 	"log/slog"
 
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
 )
 
 const (
@@ -29,9 +32,9 @@ type releaseBindingServiceWithAuthz struct {
 var _ Service = (*releaseBindingServiceWithAuthz)(nil)
 
 // NewServiceWithAuthz creates a release binding service with authorization checks.
-func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+func NewServiceWithAuthz(k8sClient client.Client, reader statusmodel.Reader, authzPDP authz.PDP, logger *slog.Logger) Service {
 	return &releaseBindingServiceWithAuthz{
-		internal:  NewService(k8sClient, logger),
+		internal:  NewService(k8sClient, reader, logger),
 		k8sClient: k8sClient,
 		authz:     services.NewAuthzChecker(authzPDP, logger),
 	}
@@ -90,25 +93,49 @@ func (s *releaseBindingServiceWithAuthz) ListReleaseBindings(ctx context.Context
 			return s.internal.ListReleaseBindings(ctx, namespaceName, componentName, pageOpts)
 		},
 		func(rb openchoreov1alpha1.ReleaseBinding) services.CheckRequest {
-			return services.CheckRequest{
-				Action:       authz.ActionViewReleaseBinding,
-				ResourceType: resourceTypeReleaseBinding,
-				ResourceID:   rb.Name,
-				Hierarchy: authz.ResourceHierarchy{
-					Namespace: namespaceName,
-					Project:   rb.Spec.Owner.ProjectName,
-					Component: rb.Spec.Owner.ComponentName,
-				},
-				Context: authz.Context{
-					// TODO: pass kind discriminator once ReleaseBindingSpec.Environment gains a kind field
-					Resource: authz.ResourceAttribute{
-						Environment: services.FormatDualScopedResourceName(namespaceName, rb.Spec.Environment, false)},
-				},
-			}
+			return viewCheckRequest(namespaceName, rb)
 		},
 	)
 }
 
+func (s *releaseBindingServiceWithAuthz) GetReleaseBindingsRuntime(ctx context.Context, namespaceName string, bindings []openchoreov1alpha1.ReleaseBinding) (map[string]*models.ReleaseBindingRuntime, error) {
+	requests := make([]services.CheckRequest, len(bindings))
+	for i, rb := range bindings {
+		requests[i] = viewCheckRequest(namespaceName, rb)
+	}
+	allowed, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]openchoreov1alpha1.ReleaseBinding, 0, len(bindings))
+	for i, rb := range bindings {
+		if allowed[i] {
+			visible = append(visible, rb)
+		}
+	}
+	return s.internal.GetReleaseBindingsRuntime(ctx, namespaceName, visible)
+}
+
+// viewCheckRequest builds the request that authorizes viewing a release binding.
+func viewCheckRequest(namespaceName string, rb openchoreov1alpha1.ReleaseBinding) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       authz.ActionViewReleaseBinding,
+		ResourceType: resourceTypeReleaseBinding,
+		ResourceID:   rb.Name,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   rb.Spec.Owner.ProjectName,
+			Component: rb.Spec.Owner.ComponentName,
+		},
+		Context: authz.Context{
+			// TODO: pass kind discriminator once ReleaseBindingSpec.Environment gains a kind field
+			Resource: authz.ResourceAttribute{
+				Environment: services.FormatDualScopedResourceName(namespaceName, rb.Spec.Environment, false)},
+		},
+	}
+}
+
 func (s *releaseBindingServiceWithAuthz) GetReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error) {
 	// Fetch the release binding first to get owner info for authz
 	rb, err := s.internal.GetReleaseBinding(ctx, namespaceName, releaseBindingName)
@@ -162,3 +189,125 @@ func (s *releaseBindingServiceWithAuthz) DeleteReleaseBinding(ctx context.Contex
 	}
 	return s.internal.DeleteReleaseBinding(ctx, namespaceName, releaseBindingName)
 }
+
+func (s *releaseBindingServiceWithAuthz) ApplyResourceRecommendation(ctx context.Context, namespaceName, releaseBindingName string, resources corev1.ResourceRequirements) (*openchoreov1alpha1.ReleaseBinding, error) {
+	existing, err := s.internal.GetReleaseBinding(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateReleaseBinding,
+		ResourceType: resourceTypeReleaseBinding,
+		ResourceID:   releaseBindingName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   existing.Spec.Owner.ProjectName,
+			Component: existing.Spec.Owner.ComponentName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{Environment: services.FormatDualScopedResourceName(namespaceName, existing.Spec.Environment, false)},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.ApplyResourceRecommendation(ctx, namespaceName, releaseBindingName, resources)
+}
+
+func (s *releaseBindingServiceWithAuthz) SuspendReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	existing, err := s.internal.GetReleaseBinding(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateReleaseBinding,
+		ResourceType: resourceTypeReleaseBinding,
+		ResourceID:   releaseBindingName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   existing.Spec.Owner.ProjectName,
+			Component: existing.Spec.Owner.ComponentName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{Environment: services.FormatDualScopedResourceName(namespaceName, existing.Spec.Environment, false)},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.SuspendReleaseBinding(ctx, namespaceName, releaseBindingName)
+}
+
+func (s *releaseBindingServiceWithAuthz) LockReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string, lock openchoreov1alpha1.DeploymentLock) (*openchoreov1alpha1.ReleaseBinding, error) {
+	if err := s.checkUpdate(ctx, namespaceName, releaseBindingName); err != nil {
+		return nil, err
+	}
+	return s.internal.LockReleaseBinding(ctx, namespaceName, releaseBindingName, lock)
+}
+
+func (s *releaseBindingServiceWithAuthz) UnlockReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	if err := s.checkUpdate(ctx, namespaceName, releaseBindingName); err != nil {
+		return nil, err
+	}
+	return s.internal.UnlockReleaseBinding(ctx, namespaceName, releaseBindingName)
+}
+
+func (s *releaseBindingServiceWithAuthz) EnableMaintenance(ctx context.Context, namespaceName, releaseBindingName string, maintenance openchoreov1alpha1.MaintenanceMode) (*openchoreov1alpha1.ReleaseBinding, error) {
+	if err := s.checkUpdate(ctx, namespaceName, releaseBindingName); err != nil {
+		return nil, err
+	}
+	return s.internal.EnableMaintenance(ctx, namespaceName, releaseBindingName, maintenance)
+}
+
+func (s *releaseBindingServiceWithAuthz) DisableMaintenance(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	if err := s.checkUpdate(ctx, namespaceName, releaseBindingName); err != nil {
+		return nil, err
+	}
+	return s.internal.DisableMaintenance(ctx, namespaceName, releaseBindingName)
+}
+
+// GetRolloutProgress requires the same permission as viewing the release binding.
+func (s *releaseBindingServiceWithAuthz) GetRolloutProgress(ctx context.Context, namespaceName, releaseBindingName string) (*models.RolloutProgress, error) {
+	if _, err := s.GetReleaseBinding(ctx, namespaceName, releaseBindingName); err != nil {
+		return nil, err
+	}
+	return s.internal.GetRolloutProgress(ctx, namespaceName, releaseBindingName)
+}
+
+// GetStatusHistory requires the same permission as viewing the release binding.
+func (s *releaseBindingServiceWithAuthz) GetStatusHistory(ctx context.Context, namespaceName, releaseBindingName string) (*models.StatusHistory, error) {
+	if _, err := s.GetReleaseBinding(ctx, namespaceName, releaseBindingName); err != nil {
+		return nil, err
+	}
+	return s.internal.GetStatusHistory(ctx, namespaceName, releaseBindingName)
+}
+
+// GetConfigDiff requires the same permission as viewing the release binding.
+func (s *releaseBindingServiceWithAuthz) GetConfigDiff(ctx context.Context, namespaceName, releaseBindingName string) (*models.ConfigDiff, error) {
+	if _, err := s.GetReleaseBinding(ctx, namespaceName, releaseBindingName); err != nil {
+		return nil, err
+	}
+	return s.internal.GetConfigDiff(ctx, namespaceName, releaseBindingName)
+}
+
+// checkUpdate authorizes an update of an existing release binding.
+func (s *releaseBindingServiceWithAuthz) checkUpdate(ctx context.Context, namespaceName, releaseBindingName string) error {
+	existing, err := s.internal.GetReleaseBinding(ctx, namespaceName, releaseBindingName)
+	if err != nil {
+		return err
+	}
+
+	return s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateReleaseBinding,
+		ResourceType: resourceTypeReleaseBinding,
+		ResourceID:   releaseBindingName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   existing.Spec.Owner.ProjectName,
+			Component: existing.Spec.Owner.ComponentName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{Environment: services.FormatDualScopedResourceName(namespaceName, existing.Spec.Environment, false)},
+		},
+	})
+}
@@ -13,6 +13,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding/mocks"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
@@ -253,3 +254,218 @@ func TestDeleteReleaseBinding_AuthzCheck(t *testing.T) {
 		require.Empty(t, pdp.Captured, "authz should not be called when fetch fails")
 	})
 }
+
+// --- LockReleaseBinding / UnlockReleaseBinding ---
+
+func TestLockReleaseBinding_AuthzCheck(t *testing.T) {
+	rb := testRB()
+	lock := openchoreov1alpha1.DeploymentLock{Reason: "freeze"}
+
+	t.Run("lock allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		mockSvc.On("LockReleaseBinding", mock.Anything, "ns-1", "my-rb", lock).Return(rb, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.LockReleaseBinding(testutil.AuthzContext(), "ns-1", "my-rb", lock)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:update", "releasebinding", "my-rb", rbHierarchy)
+	})
+
+	t.Run("lock denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.LockReleaseBinding(testutil.AuthzContext(), "ns-1", "my-rb", lock)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("unlock denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.UnlockReleaseBinding(testutil.AuthzContext(), "ns-1", "my-rb")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// --- EnableMaintenance / DisableMaintenance ---
+
+func TestMaintenance_AuthzCheck(t *testing.T) {
+	rb := testRB()
+	maintenance := openchoreov1alpha1.MaintenanceMode{Reason: "upgrade"}
+
+	t.Run("enable allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		mockSvc.On("EnableMaintenance", mock.Anything, "ns-1", "my-rb", maintenance).Return(rb, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.EnableMaintenance(testutil.AuthzContext(), "ns-1", "my-rb", maintenance)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:update", "releasebinding", "my-rb", rbHierarchy)
+	})
+
+	t.Run("disable denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.DisableMaintenance(testutil.AuthzContext(), "ns-1", "my-rb")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestGetRolloutProgress_AuthzCheck(t *testing.T) {
+	rb := testRB()
+	progress := &models.RolloutProgress{ReleaseBinding: "my-rb", Environment: "dev"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		mockSvc.On("GetRolloutProgress", mock.Anything, "ns-1", "my-rb").Return(progress, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.GetRolloutProgress(testutil.AuthzContext(), "ns-1", "my-rb")
+		require.NoError(t, err)
+		require.Equal(t, progress, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:view", "releasebinding", "my-rb", rbHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.GetRolloutProgress(testutil.AuthzContext(), "ns-1", "my-rb")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestGetStatusHistory_AuthzCheck(t *testing.T) {
+	rb := testRB()
+	history := &models.StatusHistory{ReleaseBinding: "my-rb", Environment: "dev"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		mockSvc.On("GetStatusHistory", mock.Anything, "ns-1", "my-rb").Return(history, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.GetStatusHistory(testutil.AuthzContext(), "ns-1", "my-rb")
+		require.NoError(t, err)
+		require.Equal(t, history, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:view", "releasebinding", "my-rb", rbHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.GetStatusHistory(testutil.AuthzContext(), "ns-1", "my-rb")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestGetConfigDiff_AuthzCheck(t *testing.T) {
+	rb := testRB()
+	diff := &models.ConfigDiff{ReleaseBinding: "my-rb", Environment: "dev", ToRelease: "rel-2"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		mockSvc.On("GetConfigDiff", mock.Anything, "ns-1", "my-rb").Return(diff, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.GetConfigDiff(testutil.AuthzContext(), "ns-1", "my-rb")
+		require.NoError(t, err)
+		require.Equal(t, diff, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:view", "releasebinding", "my-rb", rbHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBinding", mock.Anything, "ns-1", "my-rb").Return(rb, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.GetConfigDiff(testutil.AuthzContext(), "ns-1", "my-rb")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// --- GetReleaseBindingsRuntime ---
+
+func TestGetReleaseBindingsRuntime_AuthzCheck(t *testing.T) {
+	rbs := []openchoreov1alpha1.ReleaseBinding{*testRB()}
+	runtimes := map[string]*models.ReleaseBindingRuntime{"my-rb": {}}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBindingsRuntime", mock.Anything, "ns-1", rbs).Return(runtimes, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.GetReleaseBindingsRuntime(testutil.AuthzContext(), "ns-1", rbs)
+		require.NoError(t, err)
+		require.Equal(t, runtimes, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:view", "releasebinding", "my-rb", rbHierarchy)
+	})
+
+	t.Run("denied bindings are left out", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetReleaseBindingsRuntime", mock.Anything, "ns-1", []openchoreov1alpha1.ReleaseBinding{}).
+			Return(map[string]*models.ReleaseBindingRuntime{}, nil)
+		svc := &releaseBindingServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.GetReleaseBindingsRuntime(testutil.AuthzContext(), "ns-1", rbs)
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+}
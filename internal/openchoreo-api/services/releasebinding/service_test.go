@@ -5,14 +5,17 @@ package releasebinding
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
@@ -28,7 +31,7 @@ const (
 
 func newService(t *testing.T, objs ...client.Object) Service {
 	t.Helper()
-	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
+	return NewService(testutil.NewFakeClient(objs...), nil, testutil.TestLogger())
 }
 
 func TestCreateReleaseBinding(t *testing.T) {
@@ -75,6 +78,26 @@ func TestCreateReleaseBinding(t *testing.T) {
 		require.ErrorIs(t, err, ErrComponentNotFound)
 	})
 
+	t.Run("project environment quota exhausted", func(t *testing.T) {
+		comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+		project := testutil.NewProject(testNamespace, testProjectName)
+		project.Spec.Quota = &openchoreov1alpha1.ProjectQuota{MaxEnvironments: ptr.To[int32](1)}
+		existing := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "prod", "rb-prod")
+		svc := newService(t, comp, project, existing)
+
+		// Another binding in an environment the project is deployed to is within the quota
+		_, err := svc.CreateReleaseBinding(ctx, testNamespace,
+			testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "prod", "rb-prod-2"))
+		require.NoError(t, err)
+
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		_, err = svc.CreateReleaseBinding(ctx, testNamespace, rb)
+		quotaErr, ok := errors.AsType[*controller.ProjectQuotaExceededError](err)
+		require.True(t, ok, "expected a project quota error, got %v", err)
+		assert.Equal(t, controller.ProjectQuotaEnvironments, quotaErr.Resource)
+		assert.Equal(t, testProjectName, quotaErr.Project)
+	})
+
 	t.Run("label normalization", func(t *testing.T) {
 		comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
 		svc := newService(t, comp)
@@ -0,0 +1,45 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// SuspendReleaseBinding sets the release binding state to Undeploy so that its resources
+// are removed from the data plane while the binding itself is kept for a later redeploy.
+// Suspending an already suspended binding is a no-op.
+func (s *releaseBindingService) SuspendReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	rb := &openchoreov1alpha1.ReleaseBinding{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: releaseBindingName, Namespace: namespaceName}, rb); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrReleaseBindingNotFound
+		}
+		return nil, fmt.Errorf("failed to get release binding: %w", err)
+	}
+
+	if rb.Spec.State != openchoreov1alpha1.ReleaseStateUndeploy {
+		old := rb.DeepCopy()
+		rb.Spec.State = openchoreov1alpha1.ReleaseStateUndeploy
+		if err := checkLock(old, rb); err != nil {
+			return nil, err
+		}
+		if err := s.k8sClient.Update(ctx, rb); err != nil {
+			if vErr := services.ExtractValidationError(err); vErr != nil {
+				return nil, vErr
+			}
+			return nil, fmt.Errorf("failed to update release binding: %w", err)
+		}
+		s.logger.Info("Suspended release binding", "namespace", namespaceName, "releaseBinding", releaseBindingName)
+	}
+
+	rb.TypeMeta = releaseBindingTypeMeta
+	return rb, nil
+}
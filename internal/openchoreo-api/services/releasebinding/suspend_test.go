@@ -0,0 +1,41 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func TestSuspendReleaseBinding(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sets the state to Undeploy", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, testEnvironmentName, testRBName)
+		rb.Spec.State = openchoreov1alpha1.ReleaseStateActive
+		svc := newService(t, rb)
+
+		result, err := svc.SuspendReleaseBinding(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.ReleaseStateUndeploy, result.Spec.State)
+		assert.Equal(t, releaseBindingTypeMeta, result.TypeMeta)
+
+		stored, err := svc.GetReleaseBinding(ctx, testNamespace, testRBName)
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.ReleaseStateUndeploy, stored.Spec.State)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.SuspendReleaseBinding(ctx, testNamespace, testRBName)
+		require.ErrorIs(t, err, ErrReleaseBindingNotFound)
+	})
+}
@@ -0,0 +1,11 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import "errors"
+
+var (
+	ErrUnsupportedKind  = errors.New("unsupported search kind")
+	ErrUnsupportedFacet = errors.New("unsupported facet")
+)
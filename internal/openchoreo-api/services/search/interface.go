@@ -0,0 +1,16 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Service defines the search service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	Search(ctx context.Context, req models.SearchRequest) (*models.SearchResponse, error)
+}
@@ -0,0 +1,278 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+// Supported kinds and facets.
+const (
+	KindComponent = "component"
+
+	FacetType    = "type"
+	FacetProject = "project"
+	FacetEnv     = "env"
+	FacetTeam    = "team"
+	FacetDomain  = "domain"
+	FacetTier    = "tier"
+)
+
+// Searchable fields, as reported in SearchHit.MatchedFields.
+const (
+	fieldName        = "name"
+	fieldDisplayName = "displayName"
+	fieldDescription = "description"
+	fieldLabels      = "labels"
+	fieldHosts       = "hosts"
+)
+
+// Match weights. A match on the name ranks above a match on the display name, which ranks
+// above matches on labels, hosts and finally the free-form description.
+const (
+	weightExact    = 10
+	weightPrefix   = 6
+	weightContains = 4
+
+	displayNameFactor = 0.8
+	labelWeight       = 2
+	hostWeight        = 2
+	descriptionWeight = 1
+)
+
+// validate checks the kind and facets of a request.
+func validate(req models.SearchRequest) error {
+	if req.Kind != "" && req.Kind != KindComponent {
+		return fmt.Errorf("%w: %q", ErrUnsupportedKind, req.Kind)
+	}
+	for _, f := range req.Facets {
+		switch f {
+		case FacetType, FacetProject, FacetEnv, FacetTeam, FacetDomain, FacetTier:
+		default:
+			return fmt.Errorf("%w: %q", ErrUnsupportedFacet, f)
+		}
+	}
+	return nil
+}
+
+// match returns the component hits of the projects that match the request's query and filters,
+// ranked by score and then by namespace, project and name.
+func match(projects []*statusmodel.ProjectStatus, req models.SearchRequest) []models.SearchHit {
+	terms := strings.Fields(strings.ToLower(req.Query))
+
+	var hits []models.SearchHit
+	for _, p := range projects {
+		if req.Project != "" && p.Project != req.Project {
+			continue
+		}
+		for _, c := range p.Components {
+			if req.Type != "" && c.Metadata.Type != req.Type {
+				continue
+			}
+			if !matchesCatalog(c.Metadata, req) {
+				continue
+			}
+			if _, ok := c.Environments[req.Environment]; req.Environment != "" && !ok {
+				continue
+			}
+			score, fields, ok := scoreComponent(c, terms)
+			if !ok {
+				continue
+			}
+			hits = append(hits, componentHit(p, c, score, fields))
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		a, b := hits[i], hits[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Project != b.Project {
+			return a.Project < b.Project
+		}
+		return a.Name < b.Name
+	})
+	return hits
+}
+
+// matchesCatalog reports whether a component matches the request's team, domain and tier filters.
+func matchesCatalog(m statusmodel.ComponentMetadata, req models.SearchRequest) bool {
+	return (req.Team == "" || m.Team == req.Team) &&
+		(req.Domain == "" || m.Domain == req.Domain) &&
+		(req.Tier == "" || m.Tier == req.Tier)
+}
+
+// scoreComponent scores a component against the query terms. Every term must match at least
+// one field; ok is false otherwise.
+func scoreComponent(c statusmodel.ComponentStatus, terms []string) (score float64, fields []string, ok bool) {
+	labels := make([]string, 0, len(c.Metadata.Labels))
+	for k, v := range c.Metadata.Labels {
+		labels = append(labels, strings.ToLower(k+"="+v))
+	}
+	var hosts []string
+	for _, env := range c.Environments {
+		for _, h := range env.Hosts {
+			hosts = append(hosts, strings.ToLower(h))
+		}
+	}
+
+	matched := map[string]bool{}
+	for _, term := range terms {
+		var termScore float64
+		if s := textScore(strings.ToLower(c.Name), term); s > 0 {
+			termScore += s
+			matched[fieldName] = true
+		}
+		if s := textScore(strings.ToLower(c.Metadata.DisplayName), term); s > 0 {
+			termScore += s * displayNameFactor
+			matched[fieldDisplayName] = true
+		}
+		if anyContains(labels, term) {
+			termScore += labelWeight
+			matched[fieldLabels] = true
+		}
+		if anyContains(hosts, term) {
+			termScore += hostWeight
+			matched[fieldHosts] = true
+		}
+		if strings.Contains(strings.ToLower(c.Metadata.Description), term) {
+			termScore += descriptionWeight
+			matched[fieldDescription] = true
+		}
+		if termScore == 0 {
+			return 0, nil, false
+		}
+		score += termScore
+	}
+
+	for _, f := range []string{fieldName, fieldDisplayName, fieldLabels, fieldHosts, fieldDescription} {
+		if matched[f] {
+			fields = append(fields, f)
+		}
+	}
+	return score, fields, true
+}
+
+func textScore(text, term string) float64 {
+	switch {
+	case text == "":
+		return 0
+	case text == term:
+		return weightExact
+	case strings.HasPrefix(text, term):
+		return weightPrefix
+	case strings.Contains(text, term):
+		return weightContains
+	}
+	return 0
+}
+
+func anyContains(values []string, term string) bool {
+	for _, v := range values {
+		if strings.Contains(v, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func componentHit(p *statusmodel.ProjectStatus, c statusmodel.ComponentStatus, score float64, fields []string) models.SearchHit {
+	hit := models.SearchHit{
+		Kind:          KindComponent,
+		Namespace:     p.Namespace,
+		Project:       p.Project,
+		Name:          c.Name,
+		DisplayName:   c.Metadata.DisplayName,
+		Description:   c.Metadata.Description,
+		Type:          c.Metadata.Type,
+		Team:          c.Metadata.Team,
+		Domain:        c.Metadata.Domain,
+		Tier:          c.Metadata.Tier,
+		Archived:      c.Metadata.Archived,
+		Score:         score,
+		MatchedFields: fields,
+	}
+	hosts := map[string]bool{}
+	for env, status := range c.Environments {
+		hit.Environments = append(hit.Environments, env)
+		for _, h := range status.Hosts {
+			hosts[h] = true
+		}
+	}
+	sort.Strings(hit.Environments)
+	for h := range hosts {
+		hit.Hosts = append(hit.Hosts, h)
+	}
+	sort.Strings(hit.Hosts)
+	return hit
+}
+
+// respond counts the requested facets over all hits and applies the limit.
+func respond(hits []models.SearchHit, req models.SearchRequest) *models.SearchResponse {
+	resp := &models.SearchResponse{Items: hits, Total: len(hits)}
+	if len(req.Facets) > 0 {
+		resp.Facets = make(map[string][]models.FacetCount, len(req.Facets))
+		for _, f := range req.Facets {
+			resp.Facets[f] = facetCounts(hits, f)
+		}
+	}
+	if req.Limit > 0 && len(resp.Items) > req.Limit {
+		resp.Items = resp.Items[:req.Limit]
+	}
+	if resp.Items == nil {
+		resp.Items = []models.SearchHit{}
+	}
+	return resp
+}
+
+// facetCounts counts the hits per facet value, most frequent first.
+func facetCounts(hits []models.SearchHit, facet string) []models.FacetCount {
+	counts := map[string]int{}
+	for _, h := range hits {
+		switch facet {
+		case FacetType:
+			if h.Type != "" {
+				counts[h.Type]++
+			}
+		case FacetProject:
+			counts[h.Project]++
+		case FacetTeam:
+			if h.Team != "" {
+				counts[h.Team]++
+			}
+		case FacetDomain:
+			if h.Domain != "" {
+				counts[h.Domain]++
+			}
+		case FacetTier:
+			if h.Tier != "" {
+				counts[h.Tier]++
+			}
+		case FacetEnv:
+			for _, env := range h.Environments {
+				counts[env]++
+			}
+		}
+	}
+	out := make([]models.FacetCount, 0, len(counts))
+	for v, n := range counts {
+		out = append(out, models.FacetCount{Value: v, Count: n})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Value < out[j].Value
+	})
+	return out
+}
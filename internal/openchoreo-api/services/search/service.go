@@ -0,0 +1,53 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+// searchService searches the in-memory status model without authorization checks.
+type searchService struct {
+	k8sClient client.Client
+	reader    statusmodel.Reader
+	logger    *slog.Logger
+}
+
+var _ Service = (*searchService)(nil)
+
+// NewService creates a new search service without authorization.
+// When reader is nil the status model is disabled, and every request lists the
+// searched resources instead.
+func NewService(k8sClient client.Client, reader statusmodel.Reader, logger *slog.Logger) Service {
+	return &searchService{
+		k8sClient: k8sClient,
+		reader:    reader,
+		logger:    logger,
+	}
+}
+
+func (s *searchService) Search(ctx context.Context, req models.SearchRequest) (*models.SearchResponse, error) {
+	s.logger.Debug("Searching", "query", req.Query, "kind", req.Kind, "namespace", req.Namespace)
+
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	reader := s.reader
+	if reader == nil {
+		store, err := statusmodel.List(ctx, s.k8sClient, req.Namespace)
+		if err != nil {
+			s.logger.Error("Failed to build search index", "error", err)
+			return nil, err
+		}
+		reader = store
+	}
+	return respond(match(reader.ProjectStatuses(req.Namespace), req), req), nil
+}
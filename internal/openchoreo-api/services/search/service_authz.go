@@ -0,0 +1,74 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/statusmodel"
+)
+
+const (
+	resourceTypeComponent = "component"
+)
+
+// searchServiceWithAuthz wraps a Service and drops the results the caller cannot view.
+type searchServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*searchServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a search service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, reader statusmodel.Reader, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &searchServiceWithAuthz{
+		internal: NewService(k8sClient, reader, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// Search filters the results before the facets are counted and the limit is applied, so
+// neither reveals resources the caller cannot view.
+func (s *searchServiceWithAuthz) Search(ctx context.Context, req models.SearchRequest) (*models.SearchResponse, error) {
+	all := req
+	all.Facets = nil
+	all.Limit = 0
+	resp, err := s.internal.Search(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]services.CheckRequest, len(resp.Items))
+	for i, hit := range resp.Items {
+		requests[i] = services.CheckRequest{
+			Action:       authz.ActionViewComponent,
+			ResourceType: resourceTypeComponent,
+			ResourceID:   hit.Name,
+			Hierarchy: authz.ResourceHierarchy{
+				Namespace: hit.Namespace,
+				Project:   hit.Project,
+				Component: hit.Name,
+			},
+		}
+	}
+	allowed, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]models.SearchHit, 0, len(resp.Items))
+	for i, hit := range resp.Items {
+		if i < len(allowed) && allowed[i] {
+			hits = append(hits, hit)
+		}
+	}
+	return respond(hits, req), nil
+}
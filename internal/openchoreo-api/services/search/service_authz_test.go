@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/search/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// projectPDP allows viewing the components of a single project.
+type projectPDP struct {
+	*testutil.CapturingPDP
+	project string
+}
+
+func (p projectPDP) BatchEvaluate(_ context.Context, req *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
+	resp := &authzcore.BatchEvaluateResponse{}
+	for _, r := range req.Requests {
+		resp.Decisions = append(resp.Decisions, authzcore.Decision{Decision: r.Resource.Hierarchy.Project == p.project})
+	}
+	return resp, nil
+}
+
+func TestSearch_AuthzFilter(t *testing.T) {
+	mockSvc := mocks.NewMockService(t)
+	// The wrapper asks for every hit so that facets and limits only cover visible results.
+	mockSvc.On("Search", mock.Anything, models.SearchRequest{Query: "api"}).Return(&models.SearchResponse{
+		Items: []models.SearchHit{
+			{Namespace: "acme", Project: "hidden", Name: "api", Type: "deployment/service"},
+			{Namespace: "acme", Project: "shop", Name: "api", Type: "deployment/service"},
+			{Namespace: "acme", Project: "shop", Name: "api-gateway", Type: "deployment/proxy"},
+		},
+		Total: 3,
+	}, nil)
+	svc := &searchServiceWithAuthz{
+		internal: mockSvc,
+		authz:    testutil.NewTestAuthzChecker(projectPDP{CapturingPDP: testutil.AllowPDP(), project: "shop"}),
+	}
+
+	resp, err := svc.Search(testutil.AuthzContext(), models.SearchRequest{Query: "api", Facets: []string{FacetProject}, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Total)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "shop", resp.Items[0].Project)
+	assert.Equal(t, []models.FacetCount{{Value: "shop", Count: 2}}, resp.Facets[FacetProject])
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newComponent(namespace, project, name, componentType, displayName, description string) *openchoreov1alpha1.Component {
+	c := testutil.NewComponent(namespace, project, name)
+	c.Spec.ComponentType.Name = componentType
+	c.Annotations = map[string]string{
+		controller.AnnotationKeyDisplayName: displayName,
+		controller.AnnotationKeyDescription: description,
+	}
+	return c
+}
+
+func newBinding(namespace, project, component, env, host string) *openchoreov1alpha1.ReleaseBinding {
+	rb := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: component + "-" + env, Namespace: namespace},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: project, ComponentName: component},
+			Environment: env,
+		},
+	}
+	if host != "" {
+		rb.Status.Endpoints = []openchoreov1alpha1.EndpointURLStatus{{Name: "http", InvokeURL: "https://" + host + "/api"}}
+	}
+	return rb
+}
+
+func newSearchService(t *testing.T) Service {
+	t.Helper()
+	checkout := newComponent("acme", "shop", "checkout", "deployment/service", "Checkout Service", "Handles payments")
+	checkout.Labels = map[string]string{"team": "payments"}
+	checkout.Spec.Catalog = &openchoreov1alpha1.ComponentCatalog{Team: "payments", Domain: "commerce", Tier: "tier-1"}
+	cart := newComponent("acme", "shop", "cart", "deployment/service", "Shopping Cart", "Keeps the checkout basket")
+	cart.Spec.Catalog = &openchoreov1alpha1.ComponentCatalog{Team: "storefront", Domain: "commerce"}
+	k8sClient := testutil.NewFakeClient(
+		checkout,
+		cart,
+		newComponent("acme", "shop", "storefront", "deployment/web-app", "Storefront", ""),
+		newComponent("acme", "billing", "invoices", "deployment/service", "", "Invoice payments"),
+		newComponent("other", "shop", "checkout", "deployment/service", "", ""),
+		newBinding("acme", "shop", "checkout", "dev", "checkout.dev.acme.example"),
+		newBinding("acme", "shop", "checkout", "prod", "pay.acme.example"),
+		newBinding("acme", "shop", "storefront", "dev", ""),
+	)
+	return NewService(k8sClient, nil, testutil.TestLogger())
+}
+
+func hitNames(resp *models.SearchResponse) []string {
+	names := make([]string, 0, len(resp.Items))
+	for _, h := range resp.Items {
+		names = append(names, h.Namespace+"/"+h.Name)
+	}
+	return names
+}
+
+func TestSearch(t *testing.T) {
+	svc := newSearchService(t)
+	ctx := context.Background()
+
+	t.Run("ranks name matches above description matches", func(t *testing.T) {
+		resp, err := svc.Search(ctx, models.SearchRequest{Query: "checkout", Namespace: "acme"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme/checkout", "acme/cart"}, hitNames(resp))
+		assert.Equal(t, []string{"name", "displayName", "hosts"}, resp.Items[0].MatchedFields)
+		assert.Equal(t, []string{"description"}, resp.Items[1].MatchedFields)
+		assert.Equal(t, []string{"dev", "prod"}, resp.Items[0].Environments)
+		assert.Equal(t, []string{"checkout.dev.acme.example", "pay.acme.example"}, resp.Items[0].Hosts)
+	})
+
+	t.Run("every term must match", func(t *testing.T) {
+		resp, err := svc.Search(ctx, models.SearchRequest{Query: "payments team=pay", Namespace: "acme"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme/checkout"}, hitNames(resp))
+	})
+
+	t.Run("matches endpoint hosts", func(t *testing.T) {
+		resp, err := svc.Search(ctx, models.SearchRequest{Query: "pay.acme"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme/checkout"}, hitNames(resp))
+	})
+
+	t.Run("searches all namespaces", func(t *testing.T) {
+		resp, err := svc.Search(ctx, models.SearchRequest{Query: "checkout"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme/checkout", "other/checkout", "acme/cart"}, hitNames(resp))
+	})
+
+	t.Run("filters, facets and limit", func(t *testing.T) {
+		resp, err := svc.Search(ctx, models.SearchRequest{
+			Namespace: "acme",
+			Type:      "deployment/service",
+			Facets:    []string{FacetProject, FacetEnv, FacetType},
+			Limit:     1,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, resp.Total)
+		assert.Equal(t, []string{"acme/invoices"}, hitNames(resp))
+		assert.Equal(t, []models.FacetCount{{Value: "shop", Count: 2}, {Value: "billing", Count: 1}}, resp.Facets[FacetProject])
+		assert.Equal(t, []models.FacetCount{{Value: "dev", Count: 1}, {Value: "prod", Count: 1}}, resp.Facets[FacetEnv])
+		assert.Equal(t, []models.FacetCount{{Value: "deployment/service", Count: 3}}, resp.Facets[FacetType])
+	})
+
+	t.Run("environment filter", func(t *testing.T) {
+		resp, err := svc.Search(ctx, models.SearchRequest{Namespace: "acme", Environment: "dev"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme/checkout", "acme/storefront"}, hitNames(resp))
+	})
+
+	t.Run("catalog filters and facets", func(t *testing.T) {
+		resp, err := svc.Search(ctx, models.SearchRequest{
+			Namespace: "acme",
+			Domain:    "commerce",
+			Facets:    []string{FacetTeam, FacetTier},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme/cart", "acme/checkout"}, hitNames(resp))
+		assert.Equal(t, []models.FacetCount{{Value: "payments", Count: 1}, {Value: "storefront", Count: 1}}, resp.Facets[FacetTeam])
+		assert.Equal(t, []models.FacetCount{{Value: "tier-1", Count: 1}}, resp.Facets[FacetTier])
+
+		resp, err = svc.Search(ctx, models.SearchRequest{Namespace: "acme", Team: "payments", Tier: "tier-1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme/checkout"}, hitNames(resp))
+		assert.Equal(t, "commerce", resp.Items[0].Domain)
+	})
+
+	t.Run("no results", func(t *testing.T) {
+		resp, err := svc.Search(ctx, models.SearchRequest{Query: "nothing-matches"})
+		require.NoError(t, err)
+		assert.NotNil(t, resp.Items)
+		assert.Empty(t, resp.Items)
+	})
+
+	t.Run("unsupported kind and facet", func(t *testing.T) {
+		_, err := svc.Search(ctx, models.SearchRequest{Kind: "project"})
+		require.ErrorIs(t, err, ErrUnsupportedKind)
+		_, err = svc.Search(ctx, models.SearchRequest{Facets: []string{"owner"}})
+		require.ErrorIs(t, err, ErrUnsupportedFacet)
+	})
+}
+
+func TestSearch_Archived(t *testing.T) {
+	archived := newComponent("acme", "shop", "legacy", "deployment/service", "", "")
+	archived.Spec.State = openchoreov1alpha1.ComponentStateArchived
+	svc := NewService(testutil.NewFakeClient(archived), nil, testutil.TestLogger())
+
+	resp, err := svc.Search(context.Background(), models.SearchRequest{Query: "legacy"})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.True(t, resp.Items[0].Archived)
+}
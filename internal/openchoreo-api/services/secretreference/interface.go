@@ -6,6 +6,8 @@ package secretreference
 import (
 	"context"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
@@ -17,4 +19,15 @@ type Service interface {
 	ListSecretReferences(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.SecretReference], error)
 	GetSecretReference(ctx context.Context, namespaceName, secretReferenceName string) (*openchoreov1alpha1.SecretReference, error)
 	DeleteSecretReference(ctx context.Context, namespaceName, secretReferenceName string) error
+	ListSecretExpiryFindings(ctx context.Context, namespaceName string) ([]SecretExpiryFinding, error)
+}
+
+// SecretExpiryFinding is a certificate found by a SecretExpiryScanner in the namespace.
+type SecretExpiryFinding struct {
+	openchoreov1alpha1.SecretExpiryFinding
+
+	// Scanner is the name of the SecretExpiryScanner that found the certificate.
+	Scanner string
+	// ScannedAt is the time of the scan that found the certificate.
+	ScannedAt *metav1.Time
 }
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	secretreference "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secretreference"
+
 	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 
 	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -193,6 +195,65 @@ func (_c *MockService_GetSecretReference_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListSecretExpiryFindings provides a mock function with given fields: ctx, namespaceName
+func (_m *MockService) ListSecretExpiryFindings(ctx context.Context, namespaceName string) ([]secretreference.SecretExpiryFinding, error) {
+	ret := _m.Called(ctx, namespaceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecretExpiryFindings")
+	}
+
+	var r0 []secretreference.SecretExpiryFinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]secretreference.SecretExpiryFinding, error)); ok {
+		return rf(ctx, namespaceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []secretreference.SecretExpiryFinding); ok {
+		r0 = rf(ctx, namespaceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]secretreference.SecretExpiryFinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListSecretExpiryFindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecretExpiryFindings'
+type MockService_ListSecretExpiryFindings_Call struct {
+	*mock.Call
+}
+
+// ListSecretExpiryFindings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+func (_e *MockService_Expecter) ListSecretExpiryFindings(ctx interface{}, namespaceName interface{}) *MockService_ListSecretExpiryFindings_Call {
+	return &MockService_ListSecretExpiryFindings_Call{Call: _e.mock.On("ListSecretExpiryFindings", ctx, namespaceName)}
+}
+
+func (_c *MockService_ListSecretExpiryFindings_Call) Run(run func(ctx context.Context, namespaceName string)) *MockService_ListSecretExpiryFindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListSecretExpiryFindings_Call) Return(_a0 []secretreference.SecretExpiryFinding, _a1 error) *MockService_ListSecretExpiryFindings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListSecretExpiryFindings_Call) RunAndReturn(run func(context.Context, string) ([]secretreference.SecretExpiryFinding, error)) *MockService_ListSecretExpiryFindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSecretReferences provides a mock function with given fields: ctx, namespaceName, opts
 func (_m *MockService) ListSecretReferences(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[v1alpha1.SecretReference], error) {
 	ret := _m.Called(ctx, namespaceName, opts)
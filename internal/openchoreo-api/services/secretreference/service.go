@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -186,6 +187,35 @@ func (s *secretReferenceService) DeleteSecretReference(ctx context.Context, name
 	return nil
 }
 
+// ListSecretExpiryFindings returns the findings of the last scan of every SecretExpiryScanner
+// in the namespace, soonest expiry first.
+func (s *secretReferenceService) ListSecretExpiryFindings(ctx context.Context, namespaceName string) ([]SecretExpiryFinding, error) {
+	s.logger.Debug("Listing secret expiry findings", "namespace", namespaceName)
+
+	var scanners openchoreov1alpha1.SecretExpiryScannerList
+	if err := s.k8sClient.List(ctx, &scanners, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list secret expiry scanners", "error", err)
+		return nil, fmt.Errorf("failed to list secret expiry scanners: %w", err)
+	}
+
+	findings := []SecretExpiryFinding{}
+	for _, scanner := range scanners.Items {
+		for _, f := range scanner.Status.Findings {
+			findings = append(findings, SecretExpiryFinding{
+				SecretExpiryFinding: f,
+				Scanner:             scanner.Name,
+				ScannedAt:           scanner.Status.LastScanTime,
+			})
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].NotAfter.Before(&findings[j].NotAfter)
+	})
+
+	s.logger.Debug("Listed secret expiry findings", "namespace", namespaceName, "count", len(findings))
+	return findings, nil
+}
+
 func (s *secretReferenceService) secretReferenceExists(ctx context.Context, namespaceName, secretReferenceName string) (bool, error) {
 	sr := &openchoreov1alpha1.SecretReference{}
 	key := client.ObjectKey{
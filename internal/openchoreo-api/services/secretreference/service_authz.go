@@ -5,6 +5,7 @@ package secretreference
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -98,3 +99,33 @@ func (s *secretReferenceServiceWithAuthz) DeleteSecretReference(ctx context.Cont
 	}
 	return s.internal.DeleteSecretReference(ctx, namespaceName, secretReferenceName)
 }
+
+// ListSecretExpiryFindings returns the findings the caller can view. Findings attributed to a
+// SecretReference are checked against it; other findings against the name of the secret.
+func (s *secretReferenceServiceWithAuthz) ListSecretExpiryFindings(ctx context.Context, namespaceName string) ([]SecretExpiryFinding, error) {
+	findings, err := s.internal.ListSecretExpiryFindings(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]SecretExpiryFinding, 0, len(findings))
+	for _, f := range findings {
+		resourceID := f.SecretReference
+		if resourceID == "" {
+			resourceID = f.SecretName
+		}
+		if err := s.authz.Check(ctx, services.CheckRequest{
+			Action:       authz.ActionViewSecretReference,
+			ResourceType: resourceTypeSecretReference,
+			ResourceID:   resourceID,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+		}); err != nil {
+			if errors.Is(err, services.ErrForbidden) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
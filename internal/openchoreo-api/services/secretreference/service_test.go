@@ -6,6 +6,7 @@ package secretreference
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -191,3 +192,53 @@ func TestDeleteSecretReference(t *testing.T) {
 		require.ErrorIs(t, err, ErrSecretReferenceNotFound)
 	})
 }
+
+func TestListSecretExpiryFindings(t *testing.T) {
+	ctx := context.Background()
+	plane := openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "default"}
+	scannedAt := metav1.NewTime(time.Date(2026, 1, 28, 6, 0, 0, 0, time.UTC))
+	scanner := func(name, namespace string, findings ...openchoreov1alpha1.SecretExpiryFinding) *openchoreov1alpha1.SecretExpiryScanner {
+		return &openchoreov1alpha1.SecretExpiryScanner{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       openchoreov1alpha1.SecretExpiryScannerSpec{Planes: []openchoreov1alpha1.TargetPlaneRef{plane}},
+			Status: openchoreov1alpha1.SecretExpiryScannerStatus{
+				LastScanTime: &scannedAt,
+				Findings:     findings,
+			},
+		}
+	}
+	finding := func(secret string, notAfter time.Time) openchoreov1alpha1.SecretExpiryFinding {
+		return openchoreov1alpha1.SecretExpiryFinding{
+			Plane:      plane,
+			Namespace:  "dp-ns",
+			SecretName: secret,
+			NotAfter:   metav1.NewTime(notAfter),
+			Severity:   openchoreov1alpha1.SecretExpirySeverityWarning,
+		}
+	}
+
+	t.Run("merges scanners soonest first", func(t *testing.T) {
+		svc := newService(t,
+			scanner("a", testNamespace, finding("later", scannedAt.Add(20*24*time.Hour))),
+			scanner("b", testNamespace, finding("sooner", scannedAt.Add(2*24*time.Hour))),
+			scanner("c", "other-ns", finding("elsewhere", scannedAt.Add(time.Hour))),
+		)
+
+		findings, err := svc.ListSecretExpiryFindings(ctx, testNamespace)
+		require.NoError(t, err)
+		require.Len(t, findings, 2)
+		assert.Equal(t, "sooner", findings[0].SecretName)
+		assert.Equal(t, "b", findings[0].Scanner)
+		assert.Equal(t, "later", findings[1].SecretName)
+		require.NotNil(t, findings[1].ScannedAt)
+		assert.True(t, findings[1].ScannedAt.Equal(&scannedAt))
+	})
+
+	t.Run("no scanners", func(t *testing.T) {
+		svc := newService(t)
+
+		findings, err := svc.ListSecretExpiryFindings(ctx, testNamespace)
+		require.NoError(t, err)
+		assert.Empty(t, findings)
+	})
+}
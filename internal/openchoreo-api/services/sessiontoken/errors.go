@@ -0,0 +1,13 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sessiontoken
+
+import "errors"
+
+var (
+	// ErrNotExchangeable is returned when the request is already authenticated with a session token.
+	ErrNotExchangeable = errors.New("session tokens cannot be exchanged; use an identity provider access token")
+	// ErrUnauthenticated is returned when the request has no authenticated subject.
+	ErrUnauthenticated = errors.New("an authenticated subject is required")
+)
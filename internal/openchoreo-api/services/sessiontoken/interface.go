@@ -0,0 +1,19 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sessiontoken
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Service defines the console session token exchange.
+// It has no authorization wrapper: any authenticated subject may exchange its IdP token, and
+// the embedded namespaces are exactly those the subject is allowed to view.
+type Service interface {
+	// Exchange issues a session token for the subject of the request, embedding the
+	// namespaces the subject may view.
+	Exchange(ctx context.Context) (*models.SessionToken, error)
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sessiontoken
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/session"
+)
+
+const resourceTypeNamespace = "namespace"
+
+type sessionTokenService struct {
+	k8sClient client.Client
+	authz     *services.AuthzChecker
+	issuer    *session.Issuer
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+var _ Service = (*sessionTokenService)(nil)
+
+// NewService creates a session token service. Namespace visibility is evaluated with pdp
+// at exchange time.
+func NewService(k8sClient client.Client, issuer *session.Issuer, pdp authz.PDP, logger *slog.Logger) Service {
+	return &sessionTokenService{
+		k8sClient: k8sClient,
+		authz:     services.NewAuthzChecker(pdp, logger),
+		issuer:    issuer,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+func (s *sessionTokenService) Exchange(ctx context.Context) (*models.SessionToken, error) {
+	if _, ok := session.NamespacesFromContext(ctx); ok {
+		return nil, ErrNotExchangeable
+	}
+	subject, ok := auth.GetSubjectContextFromContext(ctx)
+	if !ok || subject == nil || subject.ID == "" {
+		return nil, ErrUnauthenticated
+	}
+
+	var nsList corev1.NamespaceList
+	if err := s.k8sClient.List(ctx, &nsList, client.MatchingLabels{
+		labels.LabelKeyControlPlaneNamespace: labels.LabelValueTrue,
+	}); err != nil {
+		s.logger.Error("Failed to list namespaces", "error", err)
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	checks := make([]services.CheckRequest, len(nsList.Items))
+	for i := range nsList.Items {
+		name := nsList.Items[i].Name
+		checks[i] = services.CheckRequest{
+			Action:       authz.ActionViewNamespace,
+			ResourceType: resourceTypeNamespace,
+			ResourceID:   name,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: name},
+		}
+	}
+	decisions, err := s.authz.BatchCheck(ctx, checks)
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make([]string, 0, len(checks))
+	for i, allowed := range decisions {
+		if allowed {
+			namespaces = append(namespaces, checks[i].ResourceID)
+		}
+	}
+
+	token, expiresAt, err := s.issuer.Issue(subject, namespaces)
+	if err != nil {
+		s.logger.Error("Failed to issue session token", "error", err)
+		return nil, fmt.Errorf("failed to issue session token: %w", err)
+	}
+	s.logger.Debug("Issued session token", "subject", subject.ID, "namespaces", len(namespaces), "expiresAt", expiresAt)
+
+	return &models.SessionToken{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresAt:   expiresAt,
+		ExpiresIn:   int64(expiresAt.Sub(s.now()).Round(time.Second) / time.Second),
+		Namespaces:  namespaces,
+	}, nil
+}
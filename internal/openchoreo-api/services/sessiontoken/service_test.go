@@ -0,0 +1,102 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sessiontoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	authzmocks "github.com/openchoreo/openchoreo/internal/authz/core/mocks"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/session"
+)
+
+func newTestIssuer(t *testing.T) *session.Issuer {
+	t.Helper()
+	issuer, err := session.NewIssuer("openchoreo-api-session", []byte("0123456789abcdef0123456789abcdef"), 5*time.Minute)
+	require.NoError(t, err)
+	return issuer
+}
+
+func controlPlaneNamespace(name string) *corev1.Namespace {
+	ns := testutil.NewNamespace(name)
+	ns.Labels = map[string]string{labels.LabelKeyControlPlaneNamespace: labels.LabelValueTrue}
+	return ns
+}
+
+// allowNamespaces returns a PDP that allows namespace view for the given namespaces only.
+func allowNamespaces(t *testing.T, allowed ...string) *authzmocks.MockPDP {
+	pdp := authzmocks.NewMockPDP(t)
+	pdp.EXPECT().BatchEvaluate(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *authz.BatchEvaluateRequest) (*authz.BatchEvaluateResponse, error) {
+			decisions := make([]authz.Decision, len(req.Requests))
+			for i, r := range req.Requests {
+				require.Equal(t, authz.ActionViewNamespace, r.Action)
+				for _, ns := range allowed {
+					if r.Resource.Hierarchy.Namespace == ns {
+						decisions[i].Decision = true
+					}
+				}
+			}
+			return &authz.BatchEvaluateResponse{Decisions: decisions}, nil
+		})
+	return pdp
+}
+
+func TestExchange(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	t.Run("embeds viewable namespaces", func(t *testing.T) {
+		k8sClient := testutil.NewFakeClient(
+			controlPlaneNamespace("acme"),
+			controlPlaneNamespace("payments"),
+			testutil.NewNamespace("kube-system"),
+		)
+		svc := NewService(k8sClient, issuer, allowNamespaces(t, "payments"), testutil.TestLogger())
+
+		token, err := svc.Exchange(testutil.AuthzContext())
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer", token.TokenType)
+		assert.Equal(t, []string{"payments"}, token.Namespaces)
+		assert.InDelta(t, 300, token.ExpiresIn, 1)
+
+		claims, err := issuer.Verify(token.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"payments"}, claims.Namespaces)
+		assert.Equal(t, "user-1", claims.Subject)
+	})
+
+	t.Run("session token cannot be exchanged", func(t *testing.T) {
+		svc := NewService(testutil.NewFakeClient(), issuer, authzmocks.NewMockPDP(t), testutil.TestLogger())
+
+		_, err := svc.Exchange(session.WithNamespaces(testutil.AuthzContext(), []string{"acme"}))
+		require.ErrorIs(t, err, ErrNotExchangeable)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		svc := NewService(testutil.NewFakeClient(), issuer, authzmocks.NewMockPDP(t), testutil.TestLogger())
+
+		_, err := svc.Exchange(context.Background())
+		require.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("PDP error", func(t *testing.T) {
+		pdpErr := errors.New("pdp unavailable")
+		pdp := authzmocks.NewMockPDP(t)
+		pdp.EXPECT().BatchEvaluate(mock.Anything, mock.Anything).Return(nil, pdpErr)
+		svc := NewService(testutil.NewFakeClient(controlPlaneNamespace("acme")), issuer, pdp, testutil.TestLogger())
+
+		_, err := svc.Exchange(testutil.AuthzContext())
+		require.ErrorIs(t, err, pdpErr)
+	})
+}
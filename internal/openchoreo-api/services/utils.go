@@ -4,8 +4,11 @@
 package services
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -58,3 +61,53 @@ func ExtractValidationError(err error) *ValidationError {
 		StatusCode: int(statusErr.ErrStatus.Code),
 	}
 }
+
+var workflowVersionPattern = regexp.MustCompile(`^v([0-9]+)(?:\.([0-9]+))?(?:\.([0-9]+))?$`)
+
+// ValidateWorkflowVersion checks that version is a workflow version label such as "v1" or "v1.2.0".
+func ValidateWorkflowVersion(version string) error {
+	if !workflowVersionPattern.MatchString(version) {
+		return &ValidationError{Msg: fmt.Sprintf("invalid workflow version %q: expected v<major>[.<minor>[.<patch>]]", version)}
+	}
+	return nil
+}
+
+// CompareWorkflowVersions orders workflow version labels numerically, so that "v2" sorts
+// before "v10". Labels that are not valid versions sort after valid ones.
+func CompareWorkflowVersions(a, b string) int {
+	pa, okA := parseWorkflowVersion(a)
+	pb, okB := parseWorkflowVersion(b)
+	switch {
+	case okA && !okB:
+		return -1
+	case !okA && okB:
+		return 1
+	case !okA && !okB:
+		return strings.Compare(a, b)
+	}
+	for i := range pa {
+		if c := cmp.Compare(pa[i], pb[i]); c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func parseWorkflowVersion(version string) ([3]int, bool) {
+	var parts [3]int
+	m := workflowVersionPattern.FindStringSubmatch(version)
+	if m == nil {
+		return parts, false
+	}
+	for i, p := range m[1:] {
+		if p == "" {
+			continue
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return parts, false
+		}
+		parts[i] = n
+	}
+	return parts, true
+}
@@ -6,6 +6,7 @@ package services
 import (
 	"errors"
 	"net/http"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -65,3 +66,19 @@ func TestExtractValidationMessage_JoinsMultipleFieldErrors(t *testing.T) {
 	require.Contains(t, msg, "spec.bar: ")
 	require.Contains(t, msg, "; ")
 }
+
+func TestValidateWorkflowVersion(t *testing.T) {
+	for _, v := range []string{"v1", "v1.2", "v1.2.3", "v10"} {
+		require.NoError(t, ValidateWorkflowVersion(v), v)
+	}
+	for _, v := range []string{"", "1", "v", "v1.2.3.4", "v1-beta", "V1"} {
+		var vErr *ValidationError
+		require.ErrorAs(t, ValidateWorkflowVersion(v), &vErr, v)
+	}
+}
+
+func TestCompareWorkflowVersions(t *testing.T) {
+	versions := []string{"v10", "latest", "v2", "v1.1", "v1", "v1.0.1"}
+	slices.SortFunc(versions, CompareWorkflowVersions)
+	require.Equal(t, []string{"v1", "v1.0.1", "v1.1", "v2", "v10", "latest"}, versions)
+}
@@ -9,4 +9,7 @@ var (
 	ErrWorkflowNil           = errors.New("workflow is nil")
 	ErrWorkflowNotFound      = errors.New("workflow not found")
 	ErrWorkflowAlreadyExists = errors.New("workflow already exists")
+
+	ErrWorkflowVersionNotFound      = errors.New("workflow version not found")
+	ErrWorkflowVersionAlreadyExists = errors.New("workflow version already exists")
 )
@@ -21,4 +21,8 @@ type Service interface {
 	CreateWorkflow(ctx context.Context, namespaceName string, wf *openchoreov1alpha1.Workflow) (*openchoreov1alpha1.Workflow, error)
 	UpdateWorkflow(ctx context.Context, namespaceName string, wf *openchoreov1alpha1.Workflow) (*openchoreov1alpha1.Workflow, error)
 	DeleteWorkflow(ctx context.Context, namespaceName, workflowName string) error
+
+	ListWorkflowVersions(ctx context.Context, namespaceName, workflowName string) ([]openchoreov1alpha1.WorkflowVersion, error)
+	PublishWorkflowVersion(ctx context.Context, namespaceName, workflowName, version string) (*openchoreov1alpha1.WorkflowVersion, error)
+	DeprecateWorkflowVersion(ctx context.Context, namespaceName, workflowName, version, message string) (*openchoreov1alpha1.WorkflowVersion, error)
 }
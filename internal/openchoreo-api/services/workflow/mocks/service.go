@@ -132,6 +132,68 @@ func (_c *MockService_DeleteWorkflow_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DeprecateWorkflowVersion provides a mock function with given fields: ctx, namespaceName, workflowName, version, message
+func (_m *MockService) DeprecateWorkflowVersion(ctx context.Context, namespaceName string, workflowName string, version string, message string) (*v1alpha1.WorkflowVersion, error) {
+	ret := _m.Called(ctx, namespaceName, workflowName, version, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeprecateWorkflowVersion")
+	}
+
+	var r0 *v1alpha1.WorkflowVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*v1alpha1.WorkflowVersion, error)); ok {
+		return rf(ctx, namespaceName, workflowName, version, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *v1alpha1.WorkflowVersion); ok {
+		r0 = rf(ctx, namespaceName, workflowName, version, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, workflowName, version, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_DeprecateWorkflowVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeprecateWorkflowVersion'
+type MockService_DeprecateWorkflowVersion_Call struct {
+	*mock.Call
+}
+
+// DeprecateWorkflowVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - workflowName string
+//   - version string
+//   - message string
+func (_e *MockService_Expecter) DeprecateWorkflowVersion(ctx interface{}, namespaceName interface{}, workflowName interface{}, version interface{}, message interface{}) *MockService_DeprecateWorkflowVersion_Call {
+	return &MockService_DeprecateWorkflowVersion_Call{Call: _e.mock.On("DeprecateWorkflowVersion", ctx, namespaceName, workflowName, version, message)}
+}
+
+func (_c *MockService_DeprecateWorkflowVersion_Call) Run(run func(ctx context.Context, namespaceName string, workflowName string, version string, message string)) *MockService_DeprecateWorkflowVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeprecateWorkflowVersion_Call) Return(_a0 *v1alpha1.WorkflowVersion, _a1 error) *MockService_DeprecateWorkflowVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_DeprecateWorkflowVersion_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*v1alpha1.WorkflowVersion, error)) *MockService_DeprecateWorkflowVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflow provides a mock function with given fields: ctx, namespaceName, workflowName
 func (_m *MockService) GetWorkflow(ctx context.Context, namespaceName string, workflowName string) (*v1alpha1.Workflow, error) {
 	ret := _m.Called(ctx, namespaceName, workflowName)
@@ -252,6 +314,66 @@ func (_c *MockService_GetWorkflowSchema_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ListWorkflowVersions provides a mock function with given fields: ctx, namespaceName, workflowName
+func (_m *MockService) ListWorkflowVersions(ctx context.Context, namespaceName string, workflowName string) ([]v1alpha1.WorkflowVersion, error) {
+	ret := _m.Called(ctx, namespaceName, workflowName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkflowVersions")
+	}
+
+	var r0 []v1alpha1.WorkflowVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]v1alpha1.WorkflowVersion, error)); ok {
+		return rf(ctx, namespaceName, workflowName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []v1alpha1.WorkflowVersion); ok {
+		r0 = rf(ctx, namespaceName, workflowName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v1alpha1.WorkflowVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, workflowName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListWorkflowVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkflowVersions'
+type MockService_ListWorkflowVersions_Call struct {
+	*mock.Call
+}
+
+// ListWorkflowVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - workflowName string
+func (_e *MockService_Expecter) ListWorkflowVersions(ctx interface{}, namespaceName interface{}, workflowName interface{}) *MockService_ListWorkflowVersions_Call {
+	return &MockService_ListWorkflowVersions_Call{Call: _e.mock.On("ListWorkflowVersions", ctx, namespaceName, workflowName)}
+}
+
+func (_c *MockService_ListWorkflowVersions_Call) Run(run func(ctx context.Context, namespaceName string, workflowName string)) *MockService_ListWorkflowVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListWorkflowVersions_Call) Return(_a0 []v1alpha1.WorkflowVersion, _a1 error) *MockService_ListWorkflowVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListWorkflowVersions_Call) RunAndReturn(run func(context.Context, string, string) ([]v1alpha1.WorkflowVersion, error)) *MockService_ListWorkflowVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflows provides a mock function with given fields: ctx, namespaceName, opts
 func (_m *MockService) ListWorkflows(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[v1alpha1.Workflow], error) {
 	ret := _m.Called(ctx, namespaceName, opts)
@@ -312,6 +434,67 @@ func (_c *MockService_ListWorkflows_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// PublishWorkflowVersion provides a mock function with given fields: ctx, namespaceName, workflowName, version
+func (_m *MockService) PublishWorkflowVersion(ctx context.Context, namespaceName string, workflowName string, version string) (*v1alpha1.WorkflowVersion, error) {
+	ret := _m.Called(ctx, namespaceName, workflowName, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishWorkflowVersion")
+	}
+
+	var r0 *v1alpha1.WorkflowVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*v1alpha1.WorkflowVersion, error)); ok {
+		return rf(ctx, namespaceName, workflowName, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *v1alpha1.WorkflowVersion); ok {
+		r0 = rf(ctx, namespaceName, workflowName, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, workflowName, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_PublishWorkflowVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishWorkflowVersion'
+type MockService_PublishWorkflowVersion_Call struct {
+	*mock.Call
+}
+
+// PublishWorkflowVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - workflowName string
+//   - version string
+func (_e *MockService_Expecter) PublishWorkflowVersion(ctx interface{}, namespaceName interface{}, workflowName interface{}, version interface{}) *MockService_PublishWorkflowVersion_Call {
+	return &MockService_PublishWorkflowVersion_Call{Call: _e.mock.On("PublishWorkflowVersion", ctx, namespaceName, workflowName, version)}
+}
+
+func (_c *MockService_PublishWorkflowVersion_Call) Run(run func(ctx context.Context, namespaceName string, workflowName string, version string)) *MockService_PublishWorkflowVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_PublishWorkflowVersion_Call) Return(_a0 *v1alpha1.WorkflowVersion, _a1 error) *MockService_PublishWorkflowVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_PublishWorkflowVersion_Call) RunAndReturn(run func(context.Context, string, string, string) (*v1alpha1.WorkflowVersion, error)) *MockService_PublishWorkflowVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateWorkflow provides a mock function with given fields: ctx, namespaceName, wf
 func (_m *MockService) UpdateWorkflow(ctx context.Context, namespaceName string, wf *v1alpha1.Workflow) (*v1alpha1.Workflow, error) {
 	ret := _m.Called(ctx, namespaceName, wf)
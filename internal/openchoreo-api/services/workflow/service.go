@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,6 +23,11 @@ var workflowTypeMeta = metav1.TypeMeta{
 	Kind:       "Workflow",
 }
 
+var workflowVersionTypeMeta = metav1.TypeMeta{
+	APIVersion: openchoreov1alpha1.GroupVersion.String(),
+	Kind:       "WorkflowVersion",
+}
+
 // workflowService handles workflow-related business logic without authorization checks.
 type workflowService struct {
 	k8sClient client.Client
@@ -199,3 +205,114 @@ func (s *workflowService) DeleteWorkflow(ctx context.Context, namespaceName, wor
 	s.logger.Debug("Workflow deleted successfully", "namespace", namespaceName, "workflow", workflowName)
 	return nil
 }
+
+func (s *workflowService) ListWorkflowVersions(ctx context.Context, namespaceName, workflowName string) ([]openchoreov1alpha1.WorkflowVersion, error) {
+	s.logger.Debug("Listing workflow versions", "namespace", namespaceName, "workflow", workflowName)
+
+	var list openchoreov1alpha1.WorkflowVersionList
+	if err := s.k8sClient.List(ctx, &list, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list workflow versions", "error", err)
+		return nil, fmt.Errorf("failed to list workflow versions: %w", err)
+	}
+
+	versions := make([]openchoreov1alpha1.WorkflowVersion, 0, len(list.Items))
+	for _, wv := range list.Items {
+		if wv.Spec.WorkflowName != workflowName {
+			continue
+		}
+		wv.TypeMeta = workflowVersionTypeMeta
+		versions = append(versions, wv)
+	}
+	slices.SortFunc(versions, func(a, b openchoreov1alpha1.WorkflowVersion) int {
+		return services.CompareWorkflowVersions(a.Spec.Version, b.Spec.Version)
+	})
+
+	s.logger.Debug("Listed workflow versions", "namespace", namespaceName, "workflow", workflowName, "count", len(versions))
+	return versions, nil
+}
+
+// PublishWorkflowVersion freezes the current template of the workflow as an immutable version.
+func (s *workflowService) PublishWorkflowVersion(ctx context.Context, namespaceName, workflowName, version string) (*openchoreov1alpha1.WorkflowVersion, error) {
+	s.logger.Debug("Publishing workflow version", "namespace", namespaceName, "workflow", workflowName, "version", version)
+
+	if err := services.ValidateWorkflowVersion(version); err != nil {
+		return nil, err
+	}
+
+	wf, err := s.GetWorkflow(ctx, namespaceName, workflowName)
+	if err != nil {
+		return nil, err
+	}
+
+	wv := &openchoreov1alpha1.WorkflowVersion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      openchoreov1alpha1.WorkflowVersionName(workflowName, version),
+			Namespace: namespaceName,
+		},
+		Spec: openchoreov1alpha1.WorkflowVersionSpec{
+			WorkflowName: workflowName,
+			Version:      version,
+			Template: openchoreov1alpha1.WorkflowTemplate{
+				Parameters:         wf.Spec.Parameters,
+				RunTemplate:        wf.Spec.RunTemplate,
+				Resources:          wf.Spec.Resources,
+				ExternalRefs:       wf.Spec.ExternalRefs,
+				TTLAfterCompletion: wf.Spec.TTLAfterCompletion,
+				Tests:              wf.Spec.Tests,
+				Analysis:           wf.Spec.Analysis,
+				SBOM:               wf.Spec.SBOM,
+				Timeout:            wf.Spec.Timeout,
+				RetryPolicy:        wf.Spec.RetryPolicy,
+				Credentials:        wf.Spec.Credentials,
+			},
+		},
+	}
+
+	if err := s.k8sClient.Create(ctx, wv); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, ErrWorkflowVersionAlreadyExists
+		}
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return nil, vErr
+		}
+		s.logger.Error("Failed to create workflow version CR", "error", err)
+		return nil, fmt.Errorf("failed to create workflow version: %w", err)
+	}
+
+	wv.TypeMeta = workflowVersionTypeMeta
+	s.logger.Debug("Workflow version published successfully", "namespace", namespaceName, "workflow", workflowName, "version", version)
+	return wv, nil
+}
+
+// DeprecateWorkflowVersion marks a published version as deprecated. The version keeps
+// working for components and runs that still pin it.
+func (s *workflowService) DeprecateWorkflowVersion(ctx context.Context, namespaceName, workflowName, version, message string) (*openchoreov1alpha1.WorkflowVersion, error) {
+	s.logger.Debug("Deprecating workflow version", "namespace", namespaceName, "workflow", workflowName, "version", version)
+
+	wv := &openchoreov1alpha1.WorkflowVersion{}
+	key := client.ObjectKey{
+		Name:      openchoreov1alpha1.WorkflowVersionName(workflowName, version),
+		Namespace: namespaceName,
+	}
+	if err := s.k8sClient.Get(ctx, key, wv); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrWorkflowVersionNotFound
+		}
+		s.logger.Error("Failed to get workflow version", "error", err)
+		return nil, fmt.Errorf("failed to get workflow version: %w", err)
+	}
+
+	wv.Spec.Deprecated = true
+	wv.Spec.DeprecationMessage = message
+	if err := s.k8sClient.Update(ctx, wv); err != nil {
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return nil, vErr
+		}
+		s.logger.Error("Failed to update workflow version CR", "error", err)
+		return nil, fmt.Errorf("failed to deprecate workflow version: %w", err)
+	}
+
+	wv.TypeMeta = workflowVersionTypeMeta
+	s.logger.Debug("Workflow version deprecated successfully", "namespace", namespaceName, "workflow", workflowName, "version", version)
+	return wv, nil
+}
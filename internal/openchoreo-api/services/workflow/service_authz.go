@@ -113,3 +113,41 @@ func (s *workflowServiceWithAuthz) DeleteWorkflow(ctx context.Context, namespace
 	}
 	return s.internal.DeleteWorkflow(ctx, namespaceName, workflowName)
 }
+
+func (s *workflowServiceWithAuthz) ListWorkflowVersions(ctx context.Context, namespaceName, workflowName string) ([]openchoreov1alpha1.WorkflowVersion, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflow,
+		ResourceType: resourceTypeWorkflow,
+		ResourceID:   workflowName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.ListWorkflowVersions(ctx, namespaceName, workflowName)
+}
+
+// PublishWorkflowVersion requires update permission on the workflow, since a published
+// version changes what pinned components build with.
+func (s *workflowServiceWithAuthz) PublishWorkflowVersion(ctx context.Context, namespaceName, workflowName, version string) (*openchoreov1alpha1.WorkflowVersion, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateWorkflow,
+		ResourceType: resourceTypeWorkflow,
+		ResourceID:   workflowName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.PublishWorkflowVersion(ctx, namespaceName, workflowName, version)
+}
+
+func (s *workflowServiceWithAuthz) DeprecateWorkflowVersion(ctx context.Context, namespaceName, workflowName, version, message string) (*openchoreov1alpha1.WorkflowVersion, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateWorkflow,
+		ResourceType: resourceTypeWorkflow,
+		ResourceID:   workflowName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.DeprecateWorkflowVersion(ctx, namespaceName, workflowName, version, message)
+}
@@ -240,3 +240,98 @@ func TestGetWorkflowSchema(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestPublishWorkflowVersion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success snapshots the current template", func(t *testing.T) {
+		wf := testutil.NewWorkflow(testNamespace, "test-wf")
+		wf.Spec.TTLAfterCompletion = "1d"
+		svc := newService(t, wf)
+
+		result, err := svc.PublishWorkflowVersion(ctx, testNamespace, "test-wf", "v1")
+		require.NoError(t, err)
+		assert.Equal(t, workflowVersionTypeMeta, result.TypeMeta)
+		assert.Equal(t, "test-wf.v1", result.Name)
+		assert.Equal(t, testNamespace, result.Namespace)
+		assert.Equal(t, "test-wf", result.Spec.WorkflowName)
+		assert.Equal(t, "v1", result.Spec.Version)
+		assert.Equal(t, wf.Spec.RunTemplate, result.Spec.Template.RunTemplate)
+		assert.Equal(t, "1d", result.Spec.Template.TTLAfterCompletion)
+		assert.False(t, result.Spec.Deprecated)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		svc := newService(t, testutil.NewWorkflow(testNamespace, "test-wf"))
+
+		_, err := svc.PublishWorkflowVersion(ctx, testNamespace, "test-wf", "1.0")
+		var vErr *services.ValidationError
+		require.ErrorAs(t, err, &vErr)
+	})
+
+	t.Run("workflow not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.PublishWorkflowVersion(ctx, testNamespace, "missing", "v1")
+		require.ErrorIs(t, err, ErrWorkflowNotFound)
+	})
+
+	t.Run("version already published", func(t *testing.T) {
+		svc := newService(t, testutil.NewWorkflow(testNamespace, "test-wf"))
+
+		_, err := svc.PublishWorkflowVersion(ctx, testNamespace, "test-wf", "v1")
+		require.NoError(t, err)
+		_, err = svc.PublishWorkflowVersion(ctx, testNamespace, "test-wf", "v1")
+		require.ErrorIs(t, err, ErrWorkflowVersionAlreadyExists)
+	})
+}
+
+func TestListWorkflowVersions(t *testing.T) {
+	ctx := context.Background()
+	newVersion := func(ns, workflowName, version string) *openchoreov1alpha1.WorkflowVersion {
+		return &openchoreov1alpha1.WorkflowVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: openchoreov1alpha1.WorkflowVersionName(workflowName, version), Namespace: ns},
+			Spec:       openchoreov1alpha1.WorkflowVersionSpec{WorkflowName: workflowName, Version: version},
+		}
+	}
+	svc := newService(t,
+		newVersion(testNamespace, "test-wf", "v10"),
+		newVersion(testNamespace, "test-wf", "v2"),
+		newVersion(testNamespace, "other-wf", "v1"),
+		newVersion("other-ns", "test-wf", "v1"),
+	)
+
+	result, err := svc.ListWorkflowVersions(ctx, testNamespace, "test-wf")
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "v2", result[0].Spec.Version)
+	assert.Equal(t, "v10", result[1].Spec.Version)
+	assert.Equal(t, workflowVersionTypeMeta, result[0].TypeMeta)
+}
+
+func TestDeprecateWorkflowVersion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc := newService(t, testutil.NewWorkflow(testNamespace, "test-wf"))
+		_, err := svc.PublishWorkflowVersion(ctx, testNamespace, "test-wf", "v1")
+		require.NoError(t, err)
+
+		result, err := svc.DeprecateWorkflowVersion(ctx, testNamespace, "test-wf", "v1", "use v2")
+		require.NoError(t, err)
+		assert.True(t, result.Spec.Deprecated)
+		assert.Equal(t, "use v2", result.Spec.DeprecationMessage)
+
+		versions, err := svc.ListWorkflowVersions(ctx, testNamespace, "test-wf")
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.True(t, versions[0].Spec.Deprecated)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.DeprecateWorkflowVersion(ctx, testNamespace, "test-wf", "v1", "")
+		require.ErrorIs(t, err, ErrWorkflowVersionNotFound)
+	})
+}
@@ -0,0 +1,16 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowcredential
+
+import "errors"
+
+var (
+	// ErrInvalidToken is returned when the broker token does not match an active workflow run.
+	// Unknown runs, expired tokens and completed runs are not distinguished.
+	ErrInvalidToken = errors.New("invalid or expired workflow run token")
+	// ErrCredentialNotFound is returned when the workflow run declares no credential of the given name.
+	ErrCredentialNotFound = errors.New("credential not found")
+	// ErrCredentialTypeNotConfigured is returned when no issuer is configured for the credential type.
+	ErrCredentialTypeNotConfigured = errors.New("credential type is not configured on this server")
+)
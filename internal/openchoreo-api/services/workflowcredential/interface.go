@@ -0,0 +1,19 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowcredential
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Service defines the credential broker for workflow runs.
+// It has no authorization wrapper: callers are workflow run steps, which authenticate with the
+// broker token issued to their run instead of an IdP token.
+type Service interface {
+	// Exchange returns a short-lived credential declared by the workflow run in exchange for the
+	// run's broker token.
+	Exchange(ctx context.Context, namespaceName, runName, credentialName, token string) (*models.WorkflowCredential, error)
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowcredential
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/workflowcredentials"
+)
+
+// conditionWorkflowCompleted is set by the workflow run controller once a run has finished.
+const conditionWorkflowCompleted = "WorkflowCompleted"
+
+// gitUsername is the username that accompanies GitHub App installation tokens over HTTPS.
+const gitUsername = "x-access-token"
+
+// RegistryTokenIssuer issues registry tokens that can push to a single image repository.
+type RegistryTokenIssuer interface {
+	Issue(subject, repository string) (string, time.Time, error)
+}
+
+// GitTokenIssuer issues tokens that can read a single git repository.
+type GitTokenIssuer interface {
+	Issue(ctx context.Context, repository string) (string, time.Time, error)
+}
+
+type workflowCredentialService struct {
+	k8sClient client.Client
+	registry  RegistryTokenIssuer
+	git       GitTokenIssuer
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+var _ Service = (*workflowCredentialService)(nil)
+
+// NewService creates a workflow credential service. A nil issuer disables its credential type.
+func NewService(k8sClient client.Client, registry RegistryTokenIssuer, git GitTokenIssuer, logger *slog.Logger) Service {
+	return &workflowCredentialService{
+		k8sClient: k8sClient,
+		registry:  registry,
+		git:       git,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+func (s *workflowCredentialService) Exchange(
+	ctx context.Context,
+	namespaceName, runName, credentialName, token string,
+) (*models.WorkflowCredential, error) {
+	var run openchoreov1alpha1.WorkflowRun
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: runName}, &run); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrInvalidToken
+		}
+		s.logger.Error("Failed to get workflow run", "namespace", namespaceName, "run", runName, "error", err)
+		return nil, fmt.Errorf("failed to get workflow run: %w", err)
+	}
+
+	status := run.Status.Credentials
+	if status == nil || !workflowcredentials.VerifyBrokerToken(token, status.TokenHash) {
+		s.logger.Warn("Rejected workflow credential exchange with an invalid token", "namespace", namespaceName, "run", runName)
+		return nil, ErrInvalidToken
+	}
+	if !s.now().Before(status.ExpiresAt.Time) ||
+		meta.IsStatusConditionTrue(run.Status.Conditions, conditionWorkflowCompleted) {
+		s.logger.Info("Rejected workflow credential exchange for an inactive run", "namespace", namespaceName, "run", runName)
+		return nil, ErrInvalidToken
+	}
+
+	var credential *openchoreov1alpha1.WorkflowCredential
+	for i := range status.Credentials {
+		if status.Credentials[i].Name == credentialName {
+			credential = &status.Credentials[i]
+			break
+		}
+	}
+	if credential == nil {
+		return nil, ErrCredentialNotFound
+	}
+
+	result := &models.WorkflowCredential{
+		Name:       credential.Name,
+		Type:       string(credential.Type),
+		Repository: credential.Repository,
+	}
+	var err error
+	switch credential.Type {
+	case openchoreov1alpha1.WorkflowCredentialTypeGitRead:
+		if s.git == nil {
+			return nil, ErrCredentialTypeNotConfigured
+		}
+		result.Username = gitUsername
+		result.Token, result.ExpiresAt, err = s.git.Issue(ctx, credential.Repository)
+	case openchoreov1alpha1.WorkflowCredentialTypeRegistryPush:
+		if s.registry == nil {
+			return nil, ErrCredentialTypeNotConfigured
+		}
+		subject := fmt.Sprintf("workflowrun:%s/%s", namespaceName, runName)
+		result.Token, result.ExpiresAt, err = s.registry.Issue(subject, credential.Repository)
+		if err == nil {
+			if host, _ := workflowcredentials.SplitImageRepository(credential.Repository); host != "" {
+				result.DockerConfigJSON, err = workflowcredentials.DockerConfigJSON(host, result.Token)
+			}
+		}
+	default:
+		return nil, ErrCredentialTypeNotConfigured
+	}
+	if err != nil {
+		s.logger.Error("Failed to issue workflow credential",
+			"namespace", namespaceName, "run", runName, "credential", credentialName, "error", err)
+		return nil, fmt.Errorf("failed to issue %s credential: %w", credential.Type, err)
+	}
+
+	s.logger.Info("Issued workflow credential",
+		"namespace", namespaceName, "run", runName, "credential", credentialName,
+		"type", credential.Type, "repository", credential.Repository, "expiresAt", result.ExpiresAt)
+	return result, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowcredential
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+	"github.com/openchoreo/openchoreo/internal/workflowcredentials"
+)
+
+const brokerToken = "broker-token"
+
+type fakeRegistryIssuer struct {
+	subject    string
+	repository string
+	err        error
+}
+
+func (f *fakeRegistryIssuer) Issue(subject, repository string) (string, time.Time, error) {
+	f.subject, f.repository = subject, repository
+	return "registry-token", time.Date(2026, 3, 2, 10, 15, 0, 0, time.UTC), f.err
+}
+
+type fakeGitIssuer struct {
+	repository string
+}
+
+func (f *fakeGitIssuer) Issue(_ context.Context, repository string) (string, time.Time, error) {
+	f.repository = repository
+	return "ghs_token", time.Date(2026, 3, 2, 11, 0, 0, 0, time.UTC), nil
+}
+
+func newRun(name string, expiresAt time.Time, completed bool) *openchoreov1alpha1.WorkflowRun {
+	run := &openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status: openchoreov1alpha1.WorkflowRunStatus{
+			Credentials: &openchoreov1alpha1.WorkflowRunCredentialsStatus{
+				SecretName: name + "-credentials",
+				TokenHash:  workflowcredentials.HashBrokerToken(brokerToken),
+				ExpiresAt:  metav1.NewTime(expiresAt),
+				Credentials: []openchoreov1alpha1.WorkflowCredential{
+					{Name: "source", Type: openchoreov1alpha1.WorkflowCredentialTypeGitRead, Repository: "https://github.com/acme/api"},
+					{Name: "image", Type: openchoreov1alpha1.WorkflowCredentialTypeRegistryPush, Repository: "registry.example.com/acme/api"},
+				},
+			},
+		},
+	}
+	if completed {
+		run.Status.Conditions = []metav1.Condition{{Type: conditionWorkflowCompleted, Status: metav1.ConditionTrue, Reason: "WorkflowSucceeded"}}
+	}
+	return run
+}
+
+func TestExchange(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	k8sClient := testutil.NewFakeClient(
+		newRun("active", now.Add(time.Hour), false),
+		newRun("expired", now.Add(-time.Minute), false),
+		newRun("completed", now.Add(time.Hour), true),
+		&openchoreov1alpha1.WorkflowRun{ObjectMeta: metav1.ObjectMeta{Name: "unbrokered", Namespace: "default"}},
+	)
+	newService := func(registry RegistryTokenIssuer, git GitTokenIssuer) Service {
+		svc := NewService(k8sClient, registry, git, testutil.TestLogger()).(*workflowCredentialService)
+		svc.now = func() time.Time { return now }
+		return svc
+	}
+
+	t.Run("issues a git read token", func(t *testing.T) {
+		git := &fakeGitIssuer{}
+		svc := newService(nil, git)
+
+		credential, err := svc.Exchange(ctx, "default", "active", "source", brokerToken)
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/acme/api", git.repository)
+		assert.Equal(t, "GitRead", credential.Type)
+		assert.Equal(t, "x-access-token", credential.Username)
+		assert.Equal(t, "ghs_token", credential.Token)
+		assert.Empty(t, credential.DockerConfigJSON)
+	})
+
+	t.Run("issues a registry push token with a docker config", func(t *testing.T) {
+		registry := &fakeRegistryIssuer{}
+		svc := newService(registry, nil)
+
+		credential, err := svc.Exchange(ctx, "default", "active", "image", brokerToken)
+		require.NoError(t, err)
+		assert.Equal(t, "workflowrun:default/active", registry.subject)
+		assert.Equal(t, "registry.example.com/acme/api", registry.repository)
+		assert.Equal(t, "registry-token", credential.Token)
+		assert.JSONEq(t, `{"auths":{"registry.example.com":{"registrytoken":"registry-token"}}}`, credential.DockerConfigJSON)
+	})
+
+	t.Run("rejects invalid tokens and inactive runs", func(t *testing.T) {
+		svc := newService(&fakeRegistryIssuer{}, &fakeGitIssuer{})
+		tests := []struct {
+			name  string
+			run   string
+			token string
+		}{
+			{"wrong token", "active", "guess"},
+			{"unknown run", "missing", brokerToken},
+			{"run without credentials", "unbrokered", brokerToken},
+			{"expired token", "expired", brokerToken},
+			{"completed run", "completed", brokerToken},
+		}
+		for _, tt := range tests {
+			_, err := svc.Exchange(ctx, "default", tt.run, "source", tt.token)
+			assert.ErrorIs(t, err, ErrInvalidToken, tt.name)
+		}
+	})
+
+	t.Run("unknown credential", func(t *testing.T) {
+		svc := newService(&fakeRegistryIssuer{}, &fakeGitIssuer{})
+		_, err := svc.Exchange(ctx, "default", "active", "deploy-key", brokerToken)
+		assert.ErrorIs(t, err, ErrCredentialNotFound)
+	})
+
+	t.Run("credential type without an issuer", func(t *testing.T) {
+		svc := newService(nil, &fakeGitIssuer{})
+		_, err := svc.Exchange(ctx, "default", "active", "image", brokerToken)
+		assert.ErrorIs(t, err, ErrCredentialTypeNotConfigured)
+	})
+
+	t.Run("issuer errors are returned", func(t *testing.T) {
+		svc := newService(&fakeRegistryIssuer{err: errors.New("signing failed")}, nil)
+		_, err := svc.Exchange(ctx, "default", "active", "image", brokerToken)
+		assert.ErrorContains(t, err, "signing failed")
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/validation/workflowparams"
+)
+
+// ValidateWorkflowParameters rejects workflow parameters that carry plaintext credentials, except
+// under properties the parameters schema of the referenced workflow allows plaintext values for.
+// The admission webhooks enforce the same rule; checking it here answers with a 400 before any
+// resource is written.
+func ValidateWorkflowParameters(ctx context.Context, c client.Client, namespace string, kind openchoreov1alpha1.WorkflowRefKind, name, version string, parameters *runtime.RawExtension) error {
+	if parameters == nil {
+		return nil
+	}
+	schema, err := workflowparams.ResolveParametersSchema(ctx, c, namespace, kind, name, version)
+	if err != nil {
+		return fmt.Errorf("failed to resolve workflow parameters schema: %w", err)
+	}
+	return PlaintextCredentialsError(parameters, schema)
+}
+
+// PlaintextCredentialsError returns a *ValidationError naming the workflow parameters that carry
+// plaintext credentials given the parameters schema of the workflow, or nil when there are none.
+func PlaintextCredentialsError(parameters, schema *runtime.RawExtension) error {
+	errs := workflowparams.ValidatePlaintextCredentials(field.NewPath("spec", "workflow", "parameters"), parameters, schema)
+	if len(errs) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.Field, e.Detail))
+	}
+	return &ValidationError{Msg: strings.Join(msgs, "; ")}
+}
@@ -11,4 +11,6 @@ var (
 	ErrWorkflowNotFound             = errors.New("workflow not found")
 	ErrWorkflowRunReferenceNotFound = errors.New("workflow run reference not found")
 	ErrInvalidCommitSHA             = errors.New("invalid commit SHA format")
+	ErrWorkflowRunAlreadyCompleted  = errors.New("workflow run has already completed")
+	ErrWorkflowRunQuotaExceeded     = errors.New("workflow run quota exceeded")
 )
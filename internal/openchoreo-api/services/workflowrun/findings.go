@@ -0,0 +1,46 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"fmt"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/analysisreport"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// GetWorkflowRunFindings retrieves the static analysis findings of a WorkflowRun. When a
+// minimum severity is given, only findings of at least that severity are listed; the counts
+// always cover all findings.
+func (s *workflowRunService) GetWorkflowRunFindings(ctx context.Context, namespaceName, runName string, minSeverity openchoreov1alpha1.AnalysisSeverity) (*models.WorkflowRunFindingsResponse, error) {
+	s.logger.Debug("Getting workflow run findings", "namespace", namespaceName, "run", runName, "severity", minSeverity)
+
+	if minSeverity != "" && !analysisreport.IsSeverity(minSeverity) {
+		return nil, &services.ValidationError{Msg: fmt.Sprintf("unknown severity %q", minSeverity)}
+	}
+
+	wfRun, err := s.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+
+	results := wfRun.Status.AnalysisResults
+	if results != nil && minSeverity != "" {
+		results = results.DeepCopy()
+		findings := results.Findings[:0]
+		for _, f := range results.Findings {
+			if analysisreport.AtLeast(f.Severity, minSeverity) {
+				findings = append(findings, f)
+			}
+		}
+		results.Findings = findings
+	}
+	return &models.WorkflowRunFindingsResponse{
+		RunName: wfRun.Name,
+		Results: results,
+	}, nil
+}
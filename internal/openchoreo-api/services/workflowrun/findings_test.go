@@ -0,0 +1,77 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func TestGetWorkflowRunFindings(t *testing.T) {
+	ctx := context.Background()
+	newAnalyzedRun := func() *openchoreov1alpha1.WorkflowRun {
+		run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName)
+		run.Status.AnalysisResults = &openchoreov1alpha1.WorkflowAnalysisResults{
+			Step:  "lint",
+			Tools: []string{"semgrep"},
+			High:  1,
+			Low:   1,
+			Findings: []openchoreov1alpha1.AnalysisFinding{
+				{Tool: "semgrep", RuleID: "sql-injection", Severity: openchoreov1alpha1.AnalysisSeverityHigh},
+				{Tool: "semgrep", RuleID: "weak-hash", Severity: openchoreov1alpha1.AnalysisSeverityLow},
+			},
+		}
+		return run
+	}
+
+	t.Run("all findings", func(t *testing.T) {
+		svc := newService(t, newAnalyzedRun())
+
+		result, err := svc.GetWorkflowRunFindings(ctx, testNamespace, testRunName, "")
+		require.NoError(t, err)
+		assert.Equal(t, testRunName, result.RunName)
+		require.NotNil(t, result.Results)
+		assert.Len(t, result.Results.Findings, 2)
+	})
+
+	t.Run("filters by minimum severity", func(t *testing.T) {
+		svc := newService(t, newAnalyzedRun())
+
+		result, err := svc.GetWorkflowRunFindings(ctx, testNamespace, testRunName, openchoreov1alpha1.AnalysisSeverityMedium)
+		require.NoError(t, err)
+		require.Len(t, result.Results.Findings, 1)
+		assert.Equal(t, "sql-injection", result.Results.Findings[0].RuleID)
+		assert.Equal(t, int32(1), result.Results.Low, "counts cover all findings")
+	})
+
+	t.Run("run without analysis", func(t *testing.T) {
+		svc := newService(t, testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName))
+
+		result, err := svc.GetWorkflowRunFindings(ctx, testNamespace, testRunName, openchoreov1alpha1.AnalysisSeverityHigh)
+		require.NoError(t, err)
+		assert.Nil(t, result.Results)
+	})
+
+	t.Run("unknown severity", func(t *testing.T) {
+		svc := newService(t, newAnalyzedRun())
+
+		_, err := svc.GetWorkflowRunFindings(ctx, testNamespace, testRunName, "Blocker")
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.GetWorkflowRunFindings(ctx, testNamespace, "nonexistent", "")
+		require.ErrorIs(t, err, ErrWorkflowRunNotFound)
+	})
+}
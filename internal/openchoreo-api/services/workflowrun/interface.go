@@ -18,10 +18,21 @@ type Service interface {
 	ListWorkflowRuns(ctx context.Context, namespaceName, projectName, componentName, workflowName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.WorkflowRun], error)
 	GetWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error)
 	GetWorkflowRunStatus(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunStatusResponse, error)
+	// GetWorkflowRunProgress returns the run's steps mapped to pipeline stages with per-step durations.
+	GetWorkflowRunProgress(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunProgressResponse, error)
+	// GetWorkflowRunTestResults returns the run's test results and the flaky tests of its component.
+	GetWorkflowRunTestResults(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunTestResultsResponse, error)
+	// GetWorkflowRunFindings returns the run's static analysis findings of at least the given severity.
+	GetWorkflowRunFindings(ctx context.Context, namespaceName, runName string, minSeverity openchoreov1alpha1.AnalysisSeverity) (*models.WorkflowRunFindingsResponse, error)
 	GetWorkflowRunLogs(ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64) ([]models.WorkflowRunLogEntry, error)
 	GetWorkflowRunEvents(ctx context.Context, namespaceName, runName, taskName string) ([]models.WorkflowRunEventEntry, error)
 	DeleteWorkflowRun(ctx context.Context, namespaceName, runName string) error
+	// CancelWorkflowRun requests a run to be cancelled on behalf of the calling subject.
+	CancelWorkflowRun(ctx context.Context, namespaceName, runName, reason string) (*openchoreov1alpha1.WorkflowRun, error)
 	// TriggerWorkflow creates a WorkflowRun from a component's workflow configuration.
 	// The authorized version is used by API handlers; the unauthz version is used by webhook processing.
 	TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error)
+	// GetBuildQuota returns the namespace's concurrent build usage and the build queue state of
+	// the workflow planes available to it.
+	GetBuildQuota(ctx context.Context, namespaceName string) (*models.BuildQuotaResponse, error)
 }
@@ -26,6 +26,67 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// CancelWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName, reason
+func (_m *MockService) CancelWorkflowRun(ctx context.Context, namespaceName string, runName string, reason string) (*v1alpha1.WorkflowRun, error) {
+	ret := _m.Called(ctx, namespaceName, runName, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelWorkflowRun")
+	}
+
+	var r0 *v1alpha1.WorkflowRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*v1alpha1.WorkflowRun, error)); ok {
+		return rf(ctx, namespaceName, runName, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *v1alpha1.WorkflowRun); ok {
+		r0 = rf(ctx, namespaceName, runName, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CancelWorkflowRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelWorkflowRun'
+type MockService_CancelWorkflowRun_Call struct {
+	*mock.Call
+}
+
+// CancelWorkflowRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+//   - reason string
+func (_e *MockService_Expecter) CancelWorkflowRun(ctx interface{}, namespaceName interface{}, runName interface{}, reason interface{}) *MockService_CancelWorkflowRun_Call {
+	return &MockService_CancelWorkflowRun_Call{Call: _e.mock.On("CancelWorkflowRun", ctx, namespaceName, runName, reason)}
+}
+
+func (_c *MockService_CancelWorkflowRun_Call) Run(run func(ctx context.Context, namespaceName string, runName string, reason string)) *MockService_CancelWorkflowRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_CancelWorkflowRun_Call) Return(_a0 *v1alpha1.WorkflowRun, _a1 error) *MockService_CancelWorkflowRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CancelWorkflowRun_Call) RunAndReturn(run func(context.Context, string, string, string) (*v1alpha1.WorkflowRun, error)) *MockService_CancelWorkflowRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWorkflowRun provides a mock function with given fields: ctx, namespaceName, wfRun
 func (_m *MockService) CreateWorkflowRun(ctx context.Context, namespaceName string, wfRun *v1alpha1.WorkflowRun) (*v1alpha1.WorkflowRun, error) {
 	ret := _m.Called(ctx, namespaceName, wfRun)
@@ -134,6 +195,65 @@ func (_c *MockService_DeleteWorkflowRun_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetBuildQuota provides a mock function with given fields: ctx, namespaceName
+func (_m *MockService) GetBuildQuota(ctx context.Context, namespaceName string) (*models.BuildQuotaResponse, error) {
+	ret := _m.Called(ctx, namespaceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBuildQuota")
+	}
+
+	var r0 *models.BuildQuotaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.BuildQuotaResponse, error)); ok {
+		return rf(ctx, namespaceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.BuildQuotaResponse); ok {
+		r0 = rf(ctx, namespaceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BuildQuotaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetBuildQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBuildQuota'
+type MockService_GetBuildQuota_Call struct {
+	*mock.Call
+}
+
+// GetBuildQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+func (_e *MockService_Expecter) GetBuildQuota(ctx interface{}, namespaceName interface{}) *MockService_GetBuildQuota_Call {
+	return &MockService_GetBuildQuota_Call{Call: _e.mock.On("GetBuildQuota", ctx, namespaceName)}
+}
+
+func (_c *MockService_GetBuildQuota_Call) Run(run func(ctx context.Context, namespaceName string)) *MockService_GetBuildQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetBuildQuota_Call) Return(_a0 *models.BuildQuotaResponse, _a1 error) *MockService_GetBuildQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetBuildQuota_Call) RunAndReturn(run func(context.Context, string) (*models.BuildQuotaResponse, error)) *MockService_GetBuildQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName
 func (_m *MockService) GetWorkflowRun(ctx context.Context, namespaceName string, runName string) (*v1alpha1.WorkflowRun, error) {
 	ret := _m.Called(ctx, namespaceName, runName)
@@ -255,6 +375,67 @@ func (_c *MockService_GetWorkflowRunEvents_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetWorkflowRunFindings provides a mock function with given fields: ctx, namespaceName, runName, minSeverity
+func (_m *MockService) GetWorkflowRunFindings(ctx context.Context, namespaceName string, runName string, minSeverity v1alpha1.AnalysisSeverity) (*models.WorkflowRunFindingsResponse, error) {
+	ret := _m.Called(ctx, namespaceName, runName, minSeverity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowRunFindings")
+	}
+
+	var r0 *models.WorkflowRunFindingsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, v1alpha1.AnalysisSeverity) (*models.WorkflowRunFindingsResponse, error)); ok {
+		return rf(ctx, namespaceName, runName, minSeverity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, v1alpha1.AnalysisSeverity) *models.WorkflowRunFindingsResponse); ok {
+		r0 = rf(ctx, namespaceName, runName, minSeverity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WorkflowRunFindingsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, v1alpha1.AnalysisSeverity) error); ok {
+		r1 = rf(ctx, namespaceName, runName, minSeverity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetWorkflowRunFindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowRunFindings'
+type MockService_GetWorkflowRunFindings_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowRunFindings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+//   - minSeverity v1alpha1.AnalysisSeverity
+func (_e *MockService_Expecter) GetWorkflowRunFindings(ctx interface{}, namespaceName interface{}, runName interface{}, minSeverity interface{}) *MockService_GetWorkflowRunFindings_Call {
+	return &MockService_GetWorkflowRunFindings_Call{Call: _e.mock.On("GetWorkflowRunFindings", ctx, namespaceName, runName, minSeverity)}
+}
+
+func (_c *MockService_GetWorkflowRunFindings_Call) Run(run func(ctx context.Context, namespaceName string, runName string, minSeverity v1alpha1.AnalysisSeverity)) *MockService_GetWorkflowRunFindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(v1alpha1.AnalysisSeverity))
+	})
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunFindings_Call) Return(_a0 *models.WorkflowRunFindingsResponse, _a1 error) *MockService_GetWorkflowRunFindings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunFindings_Call) RunAndReturn(run func(context.Context, string, string, v1alpha1.AnalysisSeverity) (*models.WorkflowRunFindingsResponse, error)) *MockService_GetWorkflowRunFindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflowRunLogs provides a mock function with given fields: ctx, namespaceName, runName, taskName, sinceSeconds
 func (_m *MockService) GetWorkflowRunLogs(ctx context.Context, namespaceName string, runName string, taskName string, sinceSeconds *int64) ([]models.WorkflowRunLogEntry, error) {
 	ret := _m.Called(ctx, namespaceName, runName, taskName, sinceSeconds)
@@ -317,6 +498,66 @@ func (_c *MockService_GetWorkflowRunLogs_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetWorkflowRunProgress provides a mock function with given fields: ctx, namespaceName, runName
+func (_m *MockService) GetWorkflowRunProgress(ctx context.Context, namespaceName string, runName string) (*models.WorkflowRunProgressResponse, error) {
+	ret := _m.Called(ctx, namespaceName, runName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowRunProgress")
+	}
+
+	var r0 *models.WorkflowRunProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.WorkflowRunProgressResponse, error)); ok {
+		return rf(ctx, namespaceName, runName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.WorkflowRunProgressResponse); ok {
+		r0 = rf(ctx, namespaceName, runName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WorkflowRunProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetWorkflowRunProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowRunProgress'
+type MockService_GetWorkflowRunProgress_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowRunProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+func (_e *MockService_Expecter) GetWorkflowRunProgress(ctx interface{}, namespaceName interface{}, runName interface{}) *MockService_GetWorkflowRunProgress_Call {
+	return &MockService_GetWorkflowRunProgress_Call{Call: _e.mock.On("GetWorkflowRunProgress", ctx, namespaceName, runName)}
+}
+
+func (_c *MockService_GetWorkflowRunProgress_Call) Run(run func(ctx context.Context, namespaceName string, runName string)) *MockService_GetWorkflowRunProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunProgress_Call) Return(_a0 *models.WorkflowRunProgressResponse, _a1 error) *MockService_GetWorkflowRunProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunProgress_Call) RunAndReturn(run func(context.Context, string, string) (*models.WorkflowRunProgressResponse, error)) *MockService_GetWorkflowRunProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflowRunStatus provides a mock function with given fields: ctx, namespaceName, runName
 func (_m *MockService) GetWorkflowRunStatus(ctx context.Context, namespaceName string, runName string) (*models.WorkflowRunStatusResponse, error) {
 	ret := _m.Called(ctx, namespaceName, runName)
@@ -377,6 +618,66 @@ func (_c *MockService_GetWorkflowRunStatus_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetWorkflowRunTestResults provides a mock function with given fields: ctx, namespaceName, runName
+func (_m *MockService) GetWorkflowRunTestResults(ctx context.Context, namespaceName string, runName string) (*models.WorkflowRunTestResultsResponse, error) {
+	ret := _m.Called(ctx, namespaceName, runName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowRunTestResults")
+	}
+
+	var r0 *models.WorkflowRunTestResultsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.WorkflowRunTestResultsResponse, error)); ok {
+		return rf(ctx, namespaceName, runName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.WorkflowRunTestResultsResponse); ok {
+		r0 = rf(ctx, namespaceName, runName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WorkflowRunTestResultsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetWorkflowRunTestResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowRunTestResults'
+type MockService_GetWorkflowRunTestResults_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowRunTestResults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+func (_e *MockService_Expecter) GetWorkflowRunTestResults(ctx interface{}, namespaceName interface{}, runName interface{}) *MockService_GetWorkflowRunTestResults_Call {
+	return &MockService_GetWorkflowRunTestResults_Call{Call: _e.mock.On("GetWorkflowRunTestResults", ctx, namespaceName, runName)}
+}
+
+func (_c *MockService_GetWorkflowRunTestResults_Call) Run(run func(ctx context.Context, namespaceName string, runName string)) *MockService_GetWorkflowRunTestResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunTestResults_Call) Return(_a0 *models.WorkflowRunTestResultsResponse, _a1 error) *MockService_GetWorkflowRunTestResults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunTestResults_Call) RunAndReturn(run func(context.Context, string, string) (*models.WorkflowRunTestResultsResponse, error)) *MockService_GetWorkflowRunTestResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflowRuns provides a mock function with given fields: ctx, namespaceName, projectName, componentName, workflowName, opts
 func (_m *MockService) ListWorkflowRuns(ctx context.Context, namespaceName string, projectName string, componentName string, workflowName string, opts services.ListOptions) (*services.ListResult[v1alpha1.WorkflowRun], error) {
 	ret := _m.Called(ctx, namespaceName, projectName, componentName, workflowName, opts)
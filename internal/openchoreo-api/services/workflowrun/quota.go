@@ -0,0 +1,255 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// Reasons a component build is rejected by the build quota.
+const (
+	QuotaReasonNamespaceConcurrentBuilds = "NamespaceConcurrentBuilds"
+	QuotaReasonWorkflowPlaneQueueFull    = "WorkflowPlaneQueueFull"
+)
+
+// quotaRetryAfter is how long clients are asked to wait before retrying a rejected build.
+// Builds typically take minutes, so retrying sooner rarely finds free capacity.
+const quotaRetryAfter = time.Minute
+
+// QuotaExceededError is returned when a component build is rejected because the namespace or
+// the target workflow plane has no room for another run.
+type QuotaExceededError struct {
+	Reason        string
+	Limit         int32
+	Usage         int32
+	WorkflowPlane string
+	RetryAfter    time.Duration
+}
+
+func (e *QuotaExceededError) Error() string {
+	switch e.Reason {
+	case QuotaReasonWorkflowPlaneQueueFull:
+		return fmt.Sprintf("build queue of workflow plane %s is full (%d/%d queued runs)", e.WorkflowPlane, e.Usage, e.Limit)
+	default:
+		return fmt.Sprintf("namespace concurrent build quota exceeded (%d/%d active builds)", e.Usage, e.Limit)
+	}
+}
+
+// Is makes QuotaExceededError match ErrWorkflowRunQuotaExceeded.
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == ErrWorkflowRunQuotaExceeded
+}
+
+// checkBuildQuota rejects a component build when the namespace is at its concurrent build limit
+// or the build queue of the plane the workflow runs on is full. A plane that cannot be resolved
+// is not checked; the workflow run controller reports it once the run is created.
+func (s *workflowRunService) checkBuildQuota(ctx context.Context, namespaceName string, kind openchoreov1alpha1.WorkflowRefKind, workflowName string) error {
+	limit, err := s.namespaceBuildLimit(ctx, namespaceName)
+	if err != nil {
+		return err
+	}
+	if limit > 0 {
+		active, err := s.countActiveBuilds(ctx, namespaceName)
+		if err != nil {
+			return err
+		}
+		if active >= limit {
+			s.logger.Info("Rejecting build, namespace is at its concurrent build limit",
+				"namespace", namespaceName, "limit", limit, "active", active)
+			return &QuotaExceededError{
+				Reason:     QuotaReasonNamespaceConcurrentBuilds,
+				Limit:      limit,
+				Usage:      active,
+				RetryAfter: quotaRetryAfter,
+			}
+		}
+	}
+
+	workflow, err := controller.ResolveWorkflow(ctx, s.k8sClient, namespaceName, kind, workflowName)
+	if err != nil {
+		s.logger.Debug("Skipping build queue check, workflow could not be resolved", "error", err, "workflow", workflowName)
+		return nil
+	}
+	plane, err := controller.GetWorkflowPlaneFromRef(ctx, s.k8sClient, namespaceName, workflow.GetWorkflowSpec().WorkflowPlaneRef)
+	if err != nil || plane == nil {
+		s.logger.Debug("Skipping build queue check, workflow plane could not be resolved", "error", err, "workflow", workflowName)
+		return nil
+	}
+	maxQueued := plane.GetScheduling().GetMaxQueuedRuns()
+	if maxQueued == 0 {
+		return nil
+	}
+	usage, err := s.queueUsage(ctx, namespaceName, plane)
+	if err != nil {
+		return err
+	}
+	if usage.QueuedRuns >= maxQueued {
+		key := planeQueueKey(plane)
+		s.logger.Info("Rejecting build, workflow plane build queue is full",
+			"namespace", namespaceName, "workflowPlane", key, "limit", maxQueued, "queued", usage.QueuedRuns)
+		return &QuotaExceededError{
+			Reason:        QuotaReasonWorkflowPlaneQueueFull,
+			Limit:         maxQueued,
+			Usage:         usage.QueuedRuns,
+			WorkflowPlane: key,
+			RetryAfter:    quotaRetryAfter,
+		}
+	}
+	return nil
+}
+
+// GetBuildQuota returns the concurrent build usage of a namespace and the queue state of the
+// workflow planes available to it.
+func (s *workflowRunService) GetBuildQuota(ctx context.Context, namespaceName string) (*models.BuildQuotaResponse, error) {
+	resp := &models.BuildQuotaResponse{
+		Namespace:      namespaceName,
+		WorkflowPlanes: []models.WorkflowPlaneQueueUsage{},
+	}
+
+	limit, err := s.namespaceBuildLimit(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 {
+		resp.MaxConcurrent = &limit
+	}
+	if resp.ActiveBuilds, err = s.countActiveBuilds(ctx, namespaceName); err != nil {
+		return nil, err
+	}
+
+	var planes []*controller.WorkflowPlaneResult
+	wpList := &openchoreov1alpha1.WorkflowPlaneList{}
+	if err := s.k8sClient.List(ctx, wpList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list workflow planes", "error", err, "namespace", namespaceName)
+		return nil, fmt.Errorf("failed to list workflow planes: %w", err)
+	}
+	for i := range wpList.Items {
+		planes = append(planes, &controller.WorkflowPlaneResult{WorkflowPlane: &wpList.Items[i]})
+	}
+	cwpList := &openchoreov1alpha1.ClusterWorkflowPlaneList{}
+	if err := s.k8sClient.List(ctx, cwpList); err != nil {
+		s.logger.Error("Failed to list cluster workflow planes", "error", err)
+		return nil, fmt.Errorf("failed to list cluster workflow planes: %w", err)
+	}
+	for i := range cwpList.Items {
+		planes = append(planes, &controller.WorkflowPlaneResult{ClusterWorkflowPlane: &cwpList.Items[i]})
+	}
+
+	for _, plane := range planes {
+		usage, err := s.queueUsage(ctx, namespaceName, plane)
+		if err != nil {
+			return nil, err
+		}
+		resp.WorkflowPlanes = append(resp.WorkflowPlanes, *usage)
+	}
+	return resp, nil
+}
+
+// namespaceBuildLimit returns the concurrent build limit set on a namespace, or 0 when builds
+// are not limited. An invalid limit is ignored so that it does not block every build.
+func (s *workflowRunService) namespaceBuildLimit(ctx context.Context, namespaceName string) (int32, error) {
+	ns := &corev1.Namespace{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: namespaceName}, ns); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return 0, nil
+		}
+		s.logger.Error("Failed to get namespace", "error", err, "namespace", namespaceName)
+		return 0, fmt.Errorf("failed to get namespace: %w", err)
+	}
+	raw, ok := ns.Annotations[controller.AnnotationKeyMaxConcurrentBuilds]
+	if !ok {
+		return 0, nil
+	}
+	limit, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || limit < 1 {
+		s.logger.Warn("Ignoring invalid concurrent build limit", "namespace", namespaceName, "value", raw)
+		return 0, nil
+	}
+	return int32(limit), nil
+}
+
+// countActiveBuilds returns the number of component builds of a namespace that are pending or running.
+func (s *workflowRunService) countActiveBuilds(ctx context.Context, namespaceName string) (int32, error) {
+	runList := &openchoreov1alpha1.WorkflowRunList{}
+	if err := s.k8sClient.List(ctx, runList,
+		client.InNamespace(namespaceName),
+		client.HasLabels{ocLabels.LabelKeyComponentName},
+	); err != nil {
+		s.logger.Error("Failed to list workflow runs", "error", err, "namespace", namespaceName)
+		return 0, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+	var active int32
+	for i := range runList.Items {
+		if isRunActive(&runList.Items[i]) {
+			active++
+		}
+	}
+	return active, nil
+}
+
+// queueUsage counts the runs holding or waiting for capacity in a plane's build queue. The queue
+// of a cluster workflow plane is shared by all namespaces, so runs of every namespace are counted.
+func (s *workflowRunService) queueUsage(ctx context.Context, namespaceName string, plane *controller.WorkflowPlaneResult) (*models.WorkflowPlaneQueueUsage, error) {
+	scheduling := plane.GetScheduling()
+	usage := &models.WorkflowPlaneQueueUsage{
+		Kind: string(openchoreov1alpha1.WorkflowPlaneRefKindWorkflowPlane),
+		Name: plane.GetName(),
+	}
+	var opts []client.ListOption
+	if plane.ClusterWorkflowPlane != nil {
+		usage.Kind = string(openchoreov1alpha1.WorkflowPlaneRefKindClusterWorkflowPlane)
+	} else {
+		opts = append(opts, client.InNamespace(namespaceName))
+	}
+	if scheduling != nil {
+		usage.MaxConcurrentRuns = scheduling.MaxConcurrentRuns
+		usage.MaxQueuedRuns = scheduling.MaxQueuedRuns
+	}
+
+	runList := &openchoreov1alpha1.WorkflowRunList{}
+	if err := s.k8sClient.List(ctx, runList, opts...); err != nil {
+		s.logger.Error("Failed to list workflow runs", "error", err, "workflowPlane", plane.GetName())
+		return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+	key := planeQueueKey(plane)
+	for i := range runList.Items {
+		run := &runList.Items[i]
+		queue := run.Status.Queue
+		if queue == nil || queue.WorkflowPlane != key || !isRunActive(run) {
+			continue
+		}
+		if queue.AdmittedAt != nil {
+			usage.RunningRuns++
+		} else {
+			usage.QueuedRuns++
+		}
+	}
+	return usage, nil
+}
+
+// planeQueueKey identifies the build queue of a workflow plane the same way the workflow run
+// controller records it in the run's queue status.
+func planeQueueKey(plane *controller.WorkflowPlaneResult) string {
+	if plane.ClusterWorkflowPlane != nil {
+		return string(openchoreov1alpha1.WorkflowPlaneRefKindClusterWorkflowPlane) + "/" + plane.GetName()
+	}
+	return string(openchoreov1alpha1.WorkflowPlaneRefKindWorkflowPlane) + "/" + plane.GetName()
+}
+
+// isRunActive reports whether a run is pending or running.
+func isRunActive(run *openchoreov1alpha1.WorkflowRun) bool {
+	return run.DeletionTimestamp.IsZero() && !meta.IsStatusConditionTrue(run.Status.Conditions, "WorkflowCompleted")
+}
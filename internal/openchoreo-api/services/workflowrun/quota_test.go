@@ -0,0 +1,167 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testQueueKey = "WorkflowPlane/default"
+
+func newBuildRun(name string, queue *openchoreov1alpha1.WorkflowRunQueueStatus, completed bool) *openchoreov1alpha1.WorkflowRun {
+	run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, name)
+	run.Labels = map[string]string{ocLabels.LabelKeyComponentName: "my-comp"}
+	run.Status.Queue = queue
+	if completed {
+		run.Status.Conditions = []metav1.Condition{
+			{Type: "WorkflowCompleted", Status: metav1.ConditionTrue, Reason: "WorkflowSucceeded", LastTransitionTime: metav1.Now()},
+		}
+	}
+	return run
+}
+
+func newLimitedNamespace(limit string) *corev1.Namespace {
+	ns := testutil.NewNamespace(testNamespace)
+	ns.Annotations = map[string]string{controller.AnnotationKeyMaxConcurrentBuilds: limit}
+	return ns
+}
+
+func newScheduledPlane(maxConcurrent, maxQueued int32) *openchoreov1alpha1.WorkflowPlane {
+	wp := testutil.NewWorkflowPlane(testNamespace, "default")
+	wp.Spec.Scheduling = &openchoreov1alpha1.WorkflowSchedulingSpec{
+		MaxConcurrentRuns: ptr.To(maxConcurrent),
+		MaxQueuedRuns:     ptr.To(maxQueued),
+	}
+	return wp
+}
+
+func TestCreateWorkflowRun_BuildQuota(t *testing.T) {
+	ctx := context.Background()
+	newRun := func() *openchoreov1alpha1.WorkflowRun {
+		return newBuildRun("new-run", nil, false)
+	}
+
+	t.Run("rejects when namespace is at its concurrent build limit", func(t *testing.T) {
+		svc := newService(t,
+			testutil.NewWorkflow(testNamespace, testWorkflowName),
+			newLimitedNamespace("2"),
+			newBuildRun("run-1", nil, false),
+			newBuildRun("run-2", nil, false),
+		)
+
+		_, err := svc.CreateWorkflowRun(ctx, testNamespace, newRun())
+		require.ErrorIs(t, err, ErrWorkflowRunQuotaExceeded)
+		var qErr *QuotaExceededError
+		require.ErrorAs(t, err, &qErr)
+		assert.Equal(t, QuotaReasonNamespaceConcurrentBuilds, qErr.Reason)
+		assert.Equal(t, int32(2), qErr.Limit)
+		assert.Equal(t, int32(2), qErr.Usage)
+		assert.Equal(t, quotaRetryAfter, qErr.RetryAfter)
+	})
+
+	t.Run("completed builds do not count", func(t *testing.T) {
+		svc := newService(t,
+			testutil.NewWorkflow(testNamespace, testWorkflowName),
+			newLimitedNamespace("2"),
+			newBuildRun("run-1", nil, false),
+			newBuildRun("run-2", nil, true),
+		)
+
+		_, err := svc.CreateWorkflowRun(ctx, testNamespace, newRun())
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid namespace limit is ignored", func(t *testing.T) {
+		svc := newService(t,
+			testutil.NewWorkflow(testNamespace, testWorkflowName),
+			newLimitedNamespace("zero"),
+			newBuildRun("run-1", nil, false),
+		)
+
+		_, err := svc.CreateWorkflowRun(ctx, testNamespace, newRun())
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects when workflow plane queue is full", func(t *testing.T) {
+		svc := newService(t,
+			testutil.NewWorkflow(testNamespace, testWorkflowName),
+			newScheduledPlane(1, 1),
+			newBuildRun("running", &openchoreov1alpha1.WorkflowRunQueueStatus{WorkflowPlane: testQueueKey, AdmittedAt: ptr.To(metav1.Now())}, false),
+			newBuildRun("queued", &openchoreov1alpha1.WorkflowRunQueueStatus{WorkflowPlane: testQueueKey}, false),
+		)
+
+		_, err := svc.CreateWorkflowRun(ctx, testNamespace, newRun())
+		var qErr *QuotaExceededError
+		require.ErrorAs(t, err, &qErr)
+		assert.Equal(t, QuotaReasonWorkflowPlaneQueueFull, qErr.Reason)
+		assert.Equal(t, testQueueKey, qErr.WorkflowPlane)
+		assert.Equal(t, int32(1), qErr.Usage)
+	})
+
+	t.Run("admits when workflow plane queue has room", func(t *testing.T) {
+		svc := newService(t,
+			testutil.NewWorkflow(testNamespace, testWorkflowName),
+			newScheduledPlane(1, 2),
+			newBuildRun("queued", &openchoreov1alpha1.WorkflowRunQueueStatus{WorkflowPlane: testQueueKey}, false),
+		)
+
+		_, err := svc.CreateWorkflowRun(ctx, testNamespace, newRun())
+		require.NoError(t, err)
+	})
+
+	t.Run("runs without a component are not limited", func(t *testing.T) {
+		svc := newService(t,
+			testutil.NewWorkflow(testNamespace, testWorkflowName),
+			newLimitedNamespace("1"),
+			newBuildRun("run-1", nil, false),
+		)
+		run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, "generic-run")
+
+		_, err := svc.CreateWorkflowRun(ctx, testNamespace, run)
+		require.NoError(t, err)
+	})
+}
+
+func TestGetBuildQuota(t *testing.T) {
+	cwp := testutil.NewClusterWorkflowPlane("shared")
+	svc := newService(t,
+		newLimitedNamespace("5"),
+		newScheduledPlane(2, 10),
+		cwp,
+		newBuildRun("running", &openchoreov1alpha1.WorkflowRunQueueStatus{WorkflowPlane: testQueueKey, AdmittedAt: ptr.To(metav1.Now())}, false),
+		newBuildRun("queued", &openchoreov1alpha1.WorkflowRunQueueStatus{WorkflowPlane: testQueueKey}, false),
+		newBuildRun("done", &openchoreov1alpha1.WorkflowRunQueueStatus{WorkflowPlane: testQueueKey, AdmittedAt: ptr.To(metav1.Now())}, true),
+	)
+
+	quota, err := svc.GetBuildQuota(context.Background(), testNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, testNamespace, quota.Namespace)
+	assert.Equal(t, ptr.To(int32(5)), quota.MaxConcurrent)
+	assert.Equal(t, int32(2), quota.ActiveBuilds)
+	require.Len(t, quota.WorkflowPlanes, 2)
+
+	wp := quota.WorkflowPlanes[0]
+	assert.Equal(t, "WorkflowPlane", wp.Kind)
+	assert.Equal(t, "default", wp.Name)
+	assert.Equal(t, ptr.To(int32(10)), wp.MaxQueuedRuns)
+	assert.Equal(t, int32(1), wp.RunningRuns)
+	assert.Equal(t, int32(1), wp.QueuedRuns)
+
+	shared := quota.WorkflowPlanes[1]
+	assert.Equal(t, "ClusterWorkflowPlane", shared.Kind)
+	assert.Nil(t, shared.MaxQueuedRuns)
+	assert.Zero(t, shared.QueuedRuns)
+}
@@ -33,6 +33,7 @@ import (
 	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
 )
 
 // workflowRunService handles workflow run business logic without authorization checks.
@@ -96,6 +97,21 @@ func (s *workflowRunService) CreateWorkflowRun(ctx context.Context, namespaceNam
 		}
 	}
 
+	if err := services.ValidateWorkflowParameters(ctx, s.k8sClient, namespaceName, wfRun.Spec.Workflow.Kind,
+		wfRun.Spec.Workflow.Name, wfRun.Spec.Workflow.Version, wfRun.Spec.Workflow.Parameters); err != nil {
+		return nil, err
+	}
+
+	// Builds of archived components are not allowed, and component builds are subject to the build quota
+	if componentName := wfRun.Labels[ocLabels.LabelKeyComponentName]; componentName != "" {
+		if err := s.ensureComponentNotArchived(ctx, namespaceName, componentName); err != nil {
+			return nil, err
+		}
+		if err := s.checkBuildQuota(ctx, namespaceName, wfRun.Spec.Workflow.Kind, wfRun.Spec.Workflow.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	// Ensure namespace is set
 	wfRun.Namespace = namespaceName
 	wfRun.Status = openchoreov1alpha1.WorkflowRunStatus{}
@@ -261,6 +277,60 @@ func (s *workflowRunService) DeleteWorkflowRun(ctx context.Context, namespaceNam
 	return nil
 }
 
+// CancelWorkflowRun records a cancellation request on a workflow run. The controller aborts the run on
+// the workflow plane. Cancelling an already cancelled run returns it unchanged.
+func (s *workflowRunService) CancelWorkflowRun(ctx context.Context, namespaceName, runName, reason string) (*openchoreov1alpha1.WorkflowRun, error) {
+	s.logger.Debug("Cancelling workflow run", "namespace", namespaceName, "run", runName)
+
+	var requestedBy string
+	if subject, ok := auth.GetSubjectContextFromContext(ctx); ok && subject != nil {
+		requestedBy = subject.ID
+	}
+
+	var wfRun *openchoreov1alpha1.WorkflowRun
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		wfRun = &openchoreov1alpha1.WorkflowRun{}
+		if err := s.k8sClient.Get(ctx, client.ObjectKey{
+			Name:      runName,
+			Namespace: namespaceName,
+		}, wfRun); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return ErrWorkflowRunNotFound
+			}
+			return fmt.Errorf("failed to get workflow run: %w", err)
+		}
+
+		if wfRun.Spec.Cancellation != nil {
+			return nil
+		}
+		switch computeWorkflowRunStatus(wfRun.Status.Conditions) {
+		case workflowRunStatusSucceeded, workflowRunStatusFailed:
+			return ErrWorkflowRunAlreadyCompleted
+		}
+
+		wfRun.Spec.Cancellation = &openchoreov1alpha1.WorkflowRunCancellation{
+			RequestedBy: requestedBy,
+			Reason:      reason,
+		}
+		return s.k8sClient.Update(ctx, wfRun)
+	})
+	if err != nil {
+		if errors.Is(err, ErrWorkflowRunNotFound) || errors.Is(err, ErrWorkflowRunAlreadyCompleted) {
+			return nil, err
+		}
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			s.logger.Error("Workflow run cancellation rejected by validation", "error", err)
+			return nil, vErr
+		}
+		s.logger.Error("Failed to cancel workflow run", "error", err)
+		return nil, fmt.Errorf("failed to cancel workflow run: %w", err)
+	}
+
+	wfRun.TypeMeta = workflowRunTypeMeta
+	s.logger.Debug("Workflow run cancellation requested", "namespace", namespaceName, "run", runName, "requestedBy", requestedBy)
+	return wfRun, nil
+}
+
 // GetWorkflowRunLogs retrieves logs from a workflow run.
 func (s *workflowRunService) GetWorkflowRunLogs(ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64) ([]models.WorkflowRunLogEntry, error) {
 	logger := s.logger.With("namespace", namespaceName, "run", runName, "task", taskName, "sinceSeconds", sinceSeconds)
@@ -287,7 +357,7 @@ func (s *workflowRunService) GetWorkflowRunLogs(ctx context.Context, namespaceNa
 	}
 
 	// Resolve the workflow's workflowPlaneRef
-	workflowPlaneRef, err := s.resolveWorkflowPlaneRef(ctx, namespaceName, workflowRun.Spec.Workflow)
+	workflowPlaneRef, err := s.resolveWorkflowPlaneRef(ctx, namespaceName, &workflowRun)
 	if err != nil {
 		logger.Error("Failed to resolve workflow plane ref", "error", err)
 		return nil, fmt.Errorf("failed to resolve workflow plane ref: %w", err)
@@ -419,8 +489,13 @@ func (s *workflowRunService) resolveWorkflowPlane(ctx context.Context, namespace
 	return nil, fmt.Errorf("no workflow plane found for namespace: %s", namespaceName)
 }
 
-// resolveWorkflowPlaneRef resolves the WorkflowPlaneRef for a given WorkflowRun by looking up its workflow.
-func (s *workflowRunService) resolveWorkflowPlaneRef(ctx context.Context, namespaceName string, workflowRef openchoreov1alpha1.WorkflowRunConfig) (*openchoreov1alpha1.WorkflowPlaneRef, error) {
+// resolveWorkflowPlaneRef resolves the WorkflowPlaneRef for a given WorkflowRun: the plane the run was
+// scheduled on when recorded in its status, otherwise the plane referenced by its workflow.
+func (s *workflowRunService) resolveWorkflowPlaneRef(ctx context.Context, namespaceName string, workflowRun *openchoreov1alpha1.WorkflowRun) (*openchoreov1alpha1.WorkflowPlaneRef, error) {
+	if workflowRun.Status.WorkflowPlane != nil {
+		return workflowRun.Status.WorkflowPlane, nil
+	}
+	workflowRef := workflowRun.Spec.Workflow
 	workflowResult, err := controller.ResolveWorkflow(ctx, s.k8sClient, namespaceName, workflowRef.Kind, workflowRef.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve workflow '%s' (kind: %s): %w", workflowRef.Name, workflowRef.Kind, err)
@@ -579,7 +654,7 @@ func (s *workflowRunService) GetWorkflowRunEvents(ctx context.Context, namespace
 	}
 
 	// Resolve the workflow's workflowPlaneRef
-	workflowPlaneRef, err := s.resolveWorkflowPlaneRef(ctx, namespaceName, workflowRun.Spec.Workflow)
+	workflowPlaneRef, err := s.resolveWorkflowPlaneRef(ctx, namespaceName, &workflowRun)
 	if err != nil {
 		logger.Error("Failed to resolve workflow plane ref", "error", err)
 		return nil, fmt.Errorf("failed to resolve workflow plane ref: %w", err)
@@ -708,6 +783,7 @@ const (
 	workflowRunStatusRunning   = "Running"
 	workflowRunStatusSucceeded = "Succeeded"
 	workflowRunStatusFailed    = "Failed"
+	workflowRunStatusCancelled = "Cancelled"
 )
 
 // GetWorkflowRunStatus retrieves the status and step information for a specific WorkflowRun.
@@ -756,6 +832,117 @@ func (s *workflowRunService) GetWorkflowRunStatus(ctx context.Context, namespace
 	}, nil
 }
 
+// Pipeline stages that workflow steps are mapped to.
+const (
+	workflowStageClone = "clone"
+	workflowStageBuild = "build"
+	workflowStageTest  = "test"
+	workflowStagePush  = "push"
+	workflowStageOther = "other"
+)
+
+// workflowStageKeywords maps step name keywords to pipeline stages. Entries are checked in order,
+// so "build-and-push" is a push step and "publish-image" is not mistaken for a build step.
+var workflowStageKeywords = []struct {
+	stage    string
+	keywords []string
+}{
+	{workflowStageTest, []string{"test"}},
+	{workflowStagePush, []string{"push", "publish", "upload"}},
+	{workflowStageClone, []string{"clone", "checkout", "git", "source"}},
+	{workflowStageBuild, []string{"build", "compile", "image", "pack"}},
+}
+
+// GetWorkflowRunProgress retrieves the step timeline of a specific WorkflowRun.
+func (s *workflowRunService) GetWorkflowRunProgress(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunProgressResponse, error) {
+	logger := s.logger.With("namespace", namespaceName, "run", runName)
+	logger.Debug("Getting workflow run progress")
+
+	wfRun := &openchoreov1alpha1.WorkflowRun{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{
+		Name:      runName,
+		Namespace: namespaceName,
+	}, wfRun); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			logger.Warn("WorkflowRun not found")
+			return nil, ErrWorkflowRunNotFound
+		}
+		logger.Error("Failed to get WorkflowRun", "error", err)
+		return nil, fmt.Errorf("failed to get WorkflowRun: %w", err)
+	}
+
+	return buildWorkflowRunProgress(wfRun, time.Now()), nil
+}
+
+// buildWorkflowRunProgress maps the workflow run tasks to pipeline steps. Durations of steps that
+// have started but not finished are measured up to now.
+func buildWorkflowRunProgress(wfRun *openchoreov1alpha1.WorkflowRun, now time.Time) *models.WorkflowRunProgressResponse {
+	progress := &models.WorkflowRunProgressResponse{
+		Status:     computeWorkflowRunStatus(wfRun.Status.Conditions),
+		Steps:      make([]models.WorkflowStepProgress, 0, len(wfRun.Status.Tasks)),
+		TotalSteps: len(wfRun.Status.Tasks),
+	}
+	progress.StartedAt, progress.FinishedAt, progress.DurationSeconds =
+		timeSpan(wfRun.Status.StartedAt, wfRun.Status.CompletedAt, now)
+
+	for _, task := range wfRun.Status.Tasks {
+		step := models.WorkflowStepProgress{
+			Name:  task.Name,
+			Stage: workflowStepStage(task.Name),
+			Phase: task.Phase,
+		}
+		step.StartedAt, step.FinishedAt, step.DurationSeconds = timeSpan(task.StartedAt, task.CompletedAt, now)
+		if isTerminalStepPhase(task.Phase) {
+			progress.CompletedSteps++
+		}
+		progress.Steps = append(progress.Steps, step)
+	}
+	return progress
+}
+
+// workflowStepStage returns the pipeline stage of a workflow step based on its name.
+func workflowStepStage(name string) string {
+	lower := strings.ToLower(name)
+	for _, entry := range workflowStageKeywords {
+		for _, keyword := range entry.keywords {
+			if strings.Contains(lower, keyword) {
+				return entry.stage
+			}
+		}
+	}
+	return workflowStageOther
+}
+
+// isTerminalStepPhase reports whether a step phase will not change anymore.
+func isTerminalStepPhase(phase string) bool {
+	switch phase {
+	case "Succeeded", "Failed", "Skipped", "Error", "Omitted":
+		return true
+	}
+	return false
+}
+
+// timeSpan converts start and end timestamps into response fields. The duration of a span that
+// has started but not ended is measured up to now.
+func timeSpan(start, end *metav1.Time, now time.Time) (*time.Time, *time.Time, *float64) {
+	if start == nil {
+		return nil, nil, nil
+	}
+	startedAt := start.Time
+	until := now
+	var finishedAt *time.Time
+	if end != nil {
+		t := end.Time
+		finishedAt = &t
+		until = t
+	}
+	duration := until.Sub(startedAt).Seconds()
+	if duration < 0 {
+		duration = 0
+	}
+	return &startedAt, finishedAt, &duration
+}
+
 // argoWorkflowExists checks whether the Argo Workflow referenced by the given WorkflowRun
 // still exists on the workflow plane. Returns true if it exists.
 func (s *workflowRunService) argoWorkflowExists(ctx context.Context, namespaceName string, wfRun *openchoreov1alpha1.WorkflowRun) bool {
@@ -764,7 +951,7 @@ func (s *workflowRunService) argoWorkflowExists(ctx context.Context, namespaceNa
 		return false
 	}
 
-	workflowPlaneRef, err := s.resolveWorkflowPlaneRef(ctx, namespaceName, wfRun.Spec.Workflow)
+	workflowPlaneRef, err := s.resolveWorkflowPlaneRef(ctx, namespaceName, wfRun)
 	if err != nil {
 		s.logger.Debug("Failed to resolve workflow plane ref for existence check", "error", err)
 		return false
@@ -797,6 +984,12 @@ func computeWorkflowRunStatus(conditions []metav1.Condition) string {
 		return workflowRunStatusPending
 	}
 
+	for _, condition := range conditions {
+		if condition.Type == "WorkflowCompleted" && condition.Reason == "WorkflowCancelled" {
+			return workflowRunStatusCancelled
+		}
+	}
+
 	for _, condition := range conditions {
 		if condition.Type == "WorkflowFailed" && condition.Status == metav1.ConditionTrue {
 			return workflowRunStatusFailed
@@ -818,6 +1011,26 @@ func computeWorkflowRunStatus(conditions []metav1.Condition) string {
 	return workflowRunStatusPending
 }
 
+// ensureComponentNotArchived returns a validation error when the named component is archived.
+// A component that does not exist is left for the workflow run controller to report.
+func (s *workflowRunService) ensureComponentNotArchived(ctx context.Context, namespaceName, componentName string) error {
+	var component openchoreov1alpha1.Component
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{
+		Name:      componentName,
+		Namespace: namespaceName,
+	}, &component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil
+		}
+		s.logger.Error("Failed to get component", "error", err, "namespace", namespaceName, "component", componentName)
+		return fmt.Errorf("failed to get component: %w", err)
+	}
+	if component.Spec.IsArchived() {
+		return &services.ValidationError{Msg: fmt.Sprintf("component %q is archived; unarchive it to trigger builds", componentName)}
+	}
+	return nil
+}
+
 // TriggerWorkflow creates a new WorkflowRun from a component's workflow configuration.
 // This is used by both the authorized API handler path and the webhook path (unauthz).
 func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error) {
@@ -833,6 +1046,10 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 		return nil, fmt.Errorf("failed to get component: %w", err)
 	}
 
+	if component.Spec.IsArchived() {
+		return nil, &services.ValidationError{Msg: fmt.Sprintf("component %q is archived; unarchive it to trigger builds", componentName)}
+	}
+
 	// Derive the canonical project from the component's owner and validate against the caller.
 	canonicalProject := component.Spec.Owner.ProjectName
 	if projectName != "" && projectName != canonicalProject {
@@ -846,9 +1063,17 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 		return nil, fmt.Errorf("component %s does not have a workflow configured", componentName)
 	}
 
-	// Fetch the Workflow or ClusterWorkflow CR to get the schema
+	// Fetch the pinned workflow version, or the Workflow or ClusterWorkflow CR, to get the schema
 	var workflowParameters *openchoreov1alpha1.SchemaSection
-	if component.Spec.Workflow.Kind == openchoreov1alpha1.WorkflowRefKindClusterWorkflow {
+	if version := component.Spec.Workflow.Version; version != "" {
+		versionSpec, err := controller.ResolveWorkflowVersion(ctx, s.k8sClient, namespaceName,
+			component.Spec.Workflow.Kind, component.Spec.Workflow.Name, version)
+		if err != nil {
+			s.logger.Error("Failed to get workflow version", "error", err, "workflow", component.Spec.Workflow.Name, "version", version)
+			return nil, fmt.Errorf("failed to get version %s of workflow %s: %w", version, component.Spec.Workflow.Name, err)
+		}
+		workflowParameters = versionSpec.Template.Parameters
+	} else if component.Spec.Workflow.Kind == openchoreov1alpha1.WorkflowRefKindClusterWorkflow {
 		cw := &openchoreov1alpha1.ClusterWorkflow{}
 		if err := s.k8sClient.Get(ctx, client.ObjectKey{
 			Name: component.Spec.Workflow.Name,
@@ -911,6 +1136,16 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 		}
 	}
 
+	// Components that stored credentials in their parameters before they were rejected must
+	// move them to a SecretReference before they can be built
+	if err := services.PlaintextCredentialsError(parameters, workflowParameters.GetRaw()); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkBuildQuota(ctx, namespaceName, component.Spec.Workflow.Kind, component.Spec.Workflow.Name); err != nil {
+		return nil, err
+	}
+
 	// Generate a unique workflow run name
 	workflowRunName, err := generateWorkflowRunName(componentName)
 	if err != nil {
@@ -932,6 +1167,7 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 			Workflow: openchoreov1alpha1.WorkflowRunConfig{
 				Kind:       component.Spec.Workflow.Kind,
 				Name:       component.Spec.Workflow.Name,
+				Version:    component.Spec.Workflow.Version,
 				Parameters: parameters,
 			},
 		},
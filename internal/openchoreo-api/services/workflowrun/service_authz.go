@@ -157,6 +157,27 @@ func (s *workflowRunServiceWithAuthz) DeleteWorkflowRun(ctx context.Context, nam
 	return s.internal.DeleteWorkflowRun(ctx, namespaceName, runName)
 }
 
+func (s *workflowRunServiceWithAuthz) CancelWorkflowRun(ctx context.Context, namespaceName, runName, reason string) (*openchoreov1alpha1.WorkflowRun, error) {
+	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   runName,
+		Hierarchy:    constructHierarchyForAuthzCheck(namespaceName, wr.Labels),
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				Workflow: formatWorkflowAttr(namespaceName, wr.Spec.Workflow.Kind, wr.Spec.Workflow.Name),
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.CancelWorkflowRun(ctx, namespaceName, runName, reason)
+}
+
 func (s *workflowRunServiceWithAuthz) GetWorkflowRunLogs(ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64) ([]models.WorkflowRunLogEntry, error) {
 	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
 	if err != nil {
@@ -205,6 +226,54 @@ func (s *workflowRunServiceWithAuthz) GetWorkflowRunStatus(ctx context.Context,
 	return s.internal.GetWorkflowRunStatus(ctx, namespaceName, runName)
 }
 
+func (s *workflowRunServiceWithAuthz) GetWorkflowRunProgress(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunProgressResponse, error) {
+	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   runName,
+		Hierarchy:    constructHierarchyForAuthzCheck(namespaceName, wr.Labels),
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetWorkflowRunProgress(ctx, namespaceName, runName)
+}
+
+func (s *workflowRunServiceWithAuthz) GetWorkflowRunTestResults(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunTestResultsResponse, error) {
+	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   runName,
+		Hierarchy:    constructHierarchyForAuthzCheck(namespaceName, wr.Labels),
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetWorkflowRunTestResults(ctx, namespaceName, runName)
+}
+
+func (s *workflowRunServiceWithAuthz) GetWorkflowRunFindings(ctx context.Context, namespaceName, runName string, minSeverity openchoreov1alpha1.AnalysisSeverity) (*models.WorkflowRunFindingsResponse, error) {
+	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   runName,
+		Hierarchy:    constructHierarchyForAuthzCheck(namespaceName, wr.Labels),
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetWorkflowRunFindings(ctx, namespaceName, runName, minSeverity)
+}
+
 func (s *workflowRunServiceWithAuthz) TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error) {
 	// Resolve the component's workflow reference for the authz check
 	var workflowAttr string
@@ -238,3 +307,14 @@ func (s *workflowRunServiceWithAuthz) TriggerWorkflow(ctx context.Context, names
 	}
 	return s.internal.TriggerWorkflow(ctx, namespaceName, projectName, componentName, commit)
 }
+
+func (s *workflowRunServiceWithAuthz) GetBuildQuota(ctx context.Context, namespaceName string) (*models.BuildQuotaResponse, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetBuildQuota(ctx, namespaceName)
+}
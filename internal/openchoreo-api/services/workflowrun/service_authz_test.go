@@ -417,6 +417,47 @@ func TestDeleteWorkflowRun_Authz(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// CancelWorkflowRun authz tests
+// ---------------------------------------------------------------------------
+
+func TestCancelWorkflowRun_Authz(t *testing.T) {
+	run := newWorkflowRun(testRunName, testProjectName, testComponentName)
+
+	t.Run("checks update action and delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.MatchedBy(func(req *authz.EvaluateRequest) bool {
+			return req.Action == authz.ActionUpdateWorkflowRun &&
+				req.Resource.Type == workflowrun.ExportResourceType &&
+				req.Resource.ID == testRunName &&
+				req.Resource.Hierarchy.Project == testProjectName &&
+				req.Resource.Hierarchy.Component == testComponentName &&
+				req.Context.Resource.Workflow == testNamespace+"/"+testWorkflowName
+		})).Return(allowDecision(), nil)
+		mockSvc.EXPECT().CancelWorkflowRun(mock.Anything, testNamespace, testRunName, "wrong branch").Return(run, nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		result, err := svc.CancelWorkflowRun(ctxWithSubject(), testNamespace, testRunName, "wrong branch")
+		require.NoError(t, err)
+		assert.Equal(t, run, result)
+	})
+
+	t.Run("denied returns forbidden without cancelling", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		_, err := svc.CancelWorkflowRun(ctxWithSubject(), testNamespace, testRunName, "")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
 // ---------------------------------------------------------------------------
 // GetWorkflowRunLogs authz tests
 // ---------------------------------------------------------------------------
@@ -564,6 +605,142 @@ func TestGetWorkflowRunStatus_Authz(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// GetBuildQuota authz tests
+// ---------------------------------------------------------------------------
+
+func TestGetBuildQuota_Authz(t *testing.T) {
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		_, err := svc.GetBuildQuota(ctxWithSubject(), testNamespace)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		quota := &models.BuildQuotaResponse{Namespace: testNamespace, ActiveBuilds: 2}
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil)
+		mockSvc.EXPECT().GetBuildQuota(mock.Anything, testNamespace).Return(quota, nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		result, err := svc.GetBuildQuota(ctxWithSubject(), testNamespace)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), result.ActiveBuilds)
+	})
+}
+
+// ---------------------------------------------------------------------------
+// GetWorkflowRunProgress authz tests
+// ---------------------------------------------------------------------------
+
+func TestGetWorkflowRunProgress_Authz(t *testing.T) {
+	run := newWorkflowRun(testRunName, testProjectName, testComponentName)
+
+	t.Run("denied before fetching progress", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		_, err := svc.GetWorkflowRunProgress(ctxWithSubject(), testNamespace, testRunName)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		progressResp := &models.WorkflowRunProgressResponse{Status: workflowrun.ExportStatusPending}
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil)
+		mockSvc.EXPECT().GetWorkflowRunProgress(mock.Anything, testNamespace, testRunName).Return(progressResp, nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		result, err := svc.GetWorkflowRunProgress(ctxWithSubject(), testNamespace, testRunName)
+		require.NoError(t, err)
+		assert.Equal(t, workflowrun.ExportStatusPending, result.Status)
+	})
+}
+
+// ---------------------------------------------------------------------------
+// GetWorkflowRunTestResults authz tests
+// ---------------------------------------------------------------------------
+
+func TestGetWorkflowRunTestResults_Authz(t *testing.T) {
+	run := newWorkflowRun(testRunName, testProjectName, testComponentName)
+
+	t.Run("denied before fetching test results", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		_, err := svc.GetWorkflowRunTestResults(ctxWithSubject(), testNamespace, testRunName)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		resp := &models.WorkflowRunTestResultsResponse{RunName: testRunName, HistoryRuns: 1}
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil)
+		mockSvc.EXPECT().GetWorkflowRunTestResults(mock.Anything, testNamespace, testRunName).Return(resp, nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		result, err := svc.GetWorkflowRunTestResults(ctxWithSubject(), testNamespace, testRunName)
+		require.NoError(t, err)
+		assert.Equal(t, testRunName, result.RunName)
+	})
+}
+
+// ---------------------------------------------------------------------------
+// GetWorkflowRunFindings authz tests
+// ---------------------------------------------------------------------------
+
+func TestGetWorkflowRunFindings_Authz(t *testing.T) {
+	run := newWorkflowRun(testRunName, testProjectName, testComponentName)
+
+	t.Run("denied before fetching findings", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		_, err := svc.GetWorkflowRunFindings(ctxWithSubject(), testNamespace, testRunName, "")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		resp := &models.WorkflowRunFindingsResponse{RunName: testRunName}
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil)
+		mockSvc.EXPECT().GetWorkflowRunFindings(mock.Anything, testNamespace, testRunName, openchoreov1alpha1.AnalysisSeverityHigh).Return(resp, nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		result, err := svc.GetWorkflowRunFindings(ctxWithSubject(), testNamespace, testRunName, openchoreov1alpha1.AnalysisSeverityHigh)
+		require.NoError(t, err)
+		assert.Equal(t, testRunName, result.RunName)
+	})
+}
+
 // ---------------------------------------------------------------------------
 // TriggerWorkflow authz tests
 // ---------------------------------------------------------------------------
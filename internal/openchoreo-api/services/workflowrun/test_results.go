@@ -0,0 +1,118 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// maxFlakyHistoryRuns is the number of recent runs of a component inspected for flaky tests.
+const maxFlakyHistoryRuns = 10
+
+// GetWorkflowRunTestResults retrieves the test results of a WorkflowRun together with the
+// flaky tests observed across the recent runs of the same component.
+func (s *workflowRunService) GetWorkflowRunTestResults(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunTestResultsResponse, error) {
+	logger := s.logger.With("namespace", namespaceName, "run", runName)
+	logger.Debug("Getting workflow run test results")
+
+	wfRun, err := s.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+
+	history := []openchoreov1alpha1.WorkflowRun{*wfRun}
+	projectName := wfRun.Labels[ocLabels.LabelKeyProjectName]
+	componentName := wfRun.Labels[ocLabels.LabelKeyComponentName]
+	if projectName != "" && componentName != "" {
+		var runs openchoreov1alpha1.WorkflowRunList
+		if err := s.k8sClient.List(ctx, &runs, client.InNamespace(namespaceName), client.MatchingLabels{
+			ocLabels.LabelKeyProjectName:   projectName,
+			ocLabels.LabelKeyComponentName: componentName,
+		}); err != nil {
+			logger.Error("Failed to list workflow runs", "error", err)
+			return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+		}
+		history = runs.Items
+	}
+
+	flaky, historyRuns := findFlakyTests(wfRun, history)
+	return &models.WorkflowRunTestResultsResponse{
+		RunName:     wfRun.Name,
+		Results:     wfRun.Status.TestResults,
+		FlakyTests:  flaky,
+		HistoryRuns: historyRuns,
+	}, nil
+}
+
+// findFlakyTests returns the test cases that failed in some but not all of the most recent
+// runs with parsed test results, up to and including the given run. Only the failed cases
+// recorded in the run status are known, so a test case counts as passed in a run that does
+// not list it. Returns the flaky tests and the number of runs inspected.
+func findFlakyTests(current *openchoreov1alpha1.WorkflowRun, runs []openchoreov1alpha1.WorkflowRun) ([]models.FlakyTest, int) {
+	var candidates []openchoreov1alpha1.WorkflowRun
+	for _, run := range runs {
+		if run.Status.TestResults == nil || run.Status.TestResults.Message != "" {
+			continue
+		}
+		if current.CreationTimestamp.Before(&run.CreationTimestamp) {
+			continue
+		}
+		candidates = append(candidates, run)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if !candidates[i].CreationTimestamp.Equal(&candidates[j].CreationTimestamp) {
+			return candidates[j].CreationTimestamp.Before(&candidates[i].CreationTimestamp)
+		}
+		return candidates[i].Name > candidates[j].Name
+	})
+	if len(candidates) > maxFlakyHistoryRuns {
+		candidates = candidates[:maxFlakyHistoryRuns]
+	}
+
+	type caseKey struct{ suite, name string }
+	failures := make(map[caseKey]*models.FlakyTest)
+	for _, run := range candidates {
+		seen := make(map[caseKey]bool)
+		for _, tc := range run.Status.TestResults.FailedCases {
+			key := caseKey{suite: tc.Suite, name: tc.Name}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			entry, ok := failures[key]
+			if !ok {
+				// Candidates are ordered newest first, so the first failure is the most recent
+				entry = &models.FlakyTest{Suite: tc.Suite, Name: tc.Name, LastFailedRun: run.Name}
+				failures[key] = entry
+			}
+			entry.FailedRuns++
+		}
+	}
+
+	flaky := make([]models.FlakyTest, 0, len(failures))
+	for _, entry := range failures {
+		if entry.FailedRuns < len(candidates) {
+			entry.TotalRuns = len(candidates)
+			flaky = append(flaky, *entry)
+		}
+	}
+	sort.Slice(flaky, func(i, j int) bool {
+		if flaky[i].FailedRuns != flaky[j].FailedRuns {
+			return flaky[i].FailedRuns > flaky[j].FailedRuns
+		}
+		if flaky[i].Suite != flaky[j].Suite {
+			return flaky[i].Suite < flaky[j].Suite
+		}
+		return flaky[i].Name < flaky[j].Name
+	})
+	return flaky, len(candidates)
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+var testResultsBase = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+func newTestedRun(name string, offset time.Duration, failed ...string) *openchoreov1alpha1.WorkflowRun {
+	run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, name)
+	run.CreationTimestamp = metav1.NewTime(testResultsBase.Add(offset))
+	run.Labels = map[string]string{
+		ocLabels.LabelKeyProjectName:   "my-project",
+		ocLabels.LabelKeyComponentName: "my-comp",
+	}
+	results := &openchoreov1alpha1.WorkflowTestResults{Step: "unit-tests", Tests: 3, Passed: len(failed) == 0}
+	for _, name := range failed {
+		results.Failures++
+		results.FailedCases = append(results.FailedCases, openchoreov1alpha1.TestCaseFailure{Suite: "unit", Name: name})
+	}
+	run.Status.TestResults = results
+	return run
+}
+
+func TestFindFlakyTests(t *testing.T) {
+	t.Run("tests failing in some runs are flaky", func(t *testing.T) {
+		runs := []openchoreov1alpha1.WorkflowRun{
+			*newTestedRun("run-1", 0, "a", "b"),
+			*newTestedRun("run-2", time.Hour, "a", "b"),
+			*newTestedRun("run-3", 2*time.Hour, "b"),
+		}
+
+		flaky, historyRuns := findFlakyTests(&runs[2], runs)
+		assert.Equal(t, 3, historyRuns)
+		assert.Equal(t, []models.FlakyTest{
+			{Suite: "unit", Name: "a", FailedRuns: 2, TotalRuns: 3, LastFailedRun: "run-2"},
+		}, flaky)
+	})
+
+	t.Run("newer runs and runs without results are ignored", func(t *testing.T) {
+		invalid := newTestedRun("run-2", time.Hour)
+		invalid.Status.TestResults = &openchoreov1alpha1.WorkflowTestResults{Message: "no report"}
+		untested := newTestedRun("run-3", 2*time.Hour)
+		untested.Status.TestResults = nil
+		runs := []openchoreov1alpha1.WorkflowRun{
+			*newTestedRun("run-1", 0, "a"),
+			*invalid,
+			*untested,
+			*newTestedRun("run-4", 3*time.Hour, "a"),
+			*newTestedRun("run-5", 4*time.Hour),
+		}
+
+		flaky, historyRuns := findFlakyTests(&runs[3], runs)
+		assert.Equal(t, 2, historyRuns)
+		assert.Empty(t, flaky)
+	})
+
+	t.Run("history is limited to the most recent runs", func(t *testing.T) {
+		var runs []openchoreov1alpha1.WorkflowRun
+		runs = append(runs, *newTestedRun("run-00", 0, "a"))
+		for i := 1; i <= maxFlakyHistoryRuns; i++ {
+			runs = append(runs, *newTestedRun(fmt.Sprintf("run-%02d", i), time.Duration(i)*time.Hour))
+		}
+
+		flaky, historyRuns := findFlakyTests(&runs[len(runs)-1], runs)
+		assert.Equal(t, maxFlakyHistoryRuns, historyRuns)
+		assert.Empty(t, flaky)
+	})
+}
+
+func TestGetWorkflowRunTestResults(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("includes flaky tests of the component", func(t *testing.T) {
+		other := newTestedRun("other-run", 0, "a")
+		other.Labels[ocLabels.LabelKeyComponentName] = "other-comp"
+		svc := newService(t,
+			newTestedRun("run-1", 0, "a"),
+			newTestedRun("run-2", time.Hour),
+			other,
+		)
+
+		result, err := svc.GetWorkflowRunTestResults(ctx, testNamespace, "run-2")
+		require.NoError(t, err)
+		assert.Equal(t, "run-2", result.RunName)
+		require.NotNil(t, result.Results)
+		assert.True(t, result.Results.Passed)
+		assert.Equal(t, 2, result.HistoryRuns)
+		require.Len(t, result.FlakyTests, 1)
+		assert.Equal(t, "a", result.FlakyTests[0].Name)
+		assert.Equal(t, "run-1", result.FlakyTests[0].LastFailedRun)
+	})
+
+	t.Run("run without component labels", func(t *testing.T) {
+		run := newTestedRun("run-1", 0, "a")
+		run.Labels = nil
+		svc := newService(t, run)
+
+		result, err := svc.GetWorkflowRunTestResults(ctx, testNamespace, "run-1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.HistoryRuns)
+		assert.Empty(t, result.FlakyTests)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+
+		_, err := svc.GetWorkflowRunTestResults(ctx, testNamespace, "nonexistent")
+		require.ErrorIs(t, err, ErrWorkflowRunNotFound)
+	})
+}
@@ -336,11 +336,12 @@ func workloadSpecSchema() *extv1.JSONSchemaProps {
 			"type": {
 				Type:        stringType,
 				Description: "Protocol/technology of the endpoint.",
-				Enum:        []extv1.JSON{{Raw: []byte(`"HTTP"`)}, {Raw: []byte(`"gRPC"`)}, {Raw: []byte(`"GraphQL"`)}, {Raw: []byte(`"Websocket"`)}, {Raw: []byte(`"TCP"`)}, {Raw: []byte(`"UDP"`)}},
+				Enum: []extv1.JSON{{Raw: []byte(`"HTTP"`)}, {Raw: []byte(`"gRPC"`)}, {Raw: []byte(`"GraphQL"`)}, {Raw: []byte(`"Websocket"`)}, {Raw: []byte(`"TCP"`)}, {Raw: []byte(`"UDP"`)},
+					{Raw: []byte(`"Kafka"`)}, {Raw: []byte(`"NATS"`)}, {Raw: []byte(`"AMQP"`)}},
 			},
 			"port": {
 				Type:        intType,
-				Description: "Port exposed by the endpoint.",
+				Description: "Port exposed by the endpoint, or the broker port of an async endpoint.",
 				Minimum:     &minPort,
 				Maximum:     &maxPort,
 			},
@@ -376,6 +377,36 @@ func workloadSpecSchema() *extv1.JSONSchemaProps {
 					"content": {Type: stringType},
 				},
 			},
+			"async": {
+				Type:        objectType,
+				Description: "Message channel of a Kafka, NATS or AMQP endpoint.",
+				Required:    []string{"channel", "broker"},
+				Properties: map[string]extv1.JSONSchemaProps{
+					"channel": {
+						Type:        stringType,
+						Description: "Kafka topic, NATS subject or AMQP queue.",
+					},
+					"broker": {
+						Type:        stringType,
+						Description: "Host of the message broker.",
+					},
+					"kafkaTopic": {
+						Type:        objectType,
+						Description: "Provisions the topic on the data plane as a Strimzi KafkaTopic.",
+						Required:    []string{"cluster"},
+						Properties: map[string]extv1.JSONSchemaProps{
+							"cluster":    {Type: stringType, Description: "Name of the Strimzi Kafka cluster that owns the topic."},
+							"namespace":  {Type: stringType, Description: "Namespace watched by the Strimzi topic operator."},
+							"partitions": {Type: intType, Minimum: &minPort},
+							"replicas":   {Type: intType, Minimum: &minPort},
+							"config": {
+								Type:                 objectType,
+								AdditionalProperties: &extv1.JSONSchemaPropsOrBool{Schema: &extv1.JSONSchemaProps{Type: stringType}},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -421,6 +452,10 @@ func workloadSpecSchema() *extv1.JSONSchemaProps {
 						Type:        stringType,
 						Description: "Env var name for just the port number.",
 					},
+					"channel": {
+						Type:        stringType,
+						Description: "Env var name for the channel of an async endpoint.",
+					},
 				},
 			},
 		},
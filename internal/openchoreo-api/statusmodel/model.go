@@ -0,0 +1,458 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package statusmodel maintains a denormalized project/component/environment deployment
+// status matrix from Components, ReleaseBindings and RenderedReleases, so that status
+// views can be served without listing those resources on every request.
+package statusmodel
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Status is the deployment status of a component in an environment.
+type Status string
+
+const (
+	// StatusReady means the binding is reconciled and all of its resources are ready.
+	StatusReady Status = "Ready"
+	// StatusProgressing means the binding has not been reconciled yet or its resources are rolling out.
+	StatusProgressing Status = "Progressing"
+	// StatusFailed means the binding or its resources failed.
+	StatusFailed Status = "Failed"
+	// StatusUndeployed means the binding exists but its state is Undeploy.
+	StatusUndeployed Status = "Undeployed"
+	// StatusNotDeployed means the component has no binding for the environment.
+	StatusNotDeployed Status = "NotDeployed"
+)
+
+// conditionReady is the ReleaseBinding condition that summarizes its state.
+const conditionReady = "Ready"
+
+// progressingReasons are the reasons of a false Ready condition that describe a rollout
+// in progress rather than a failure. The binding controller copies the reason of the
+// first unmet sub-condition into Ready; any other reason is treated as a failure.
+var progressingReasons = map[string]bool{
+	"ReleaseCreated":              true,
+	"ReleaseSynced":               true,
+	"ResourcesProgressing":        true,
+	"ConnectionsPending":          true,
+	"ResourceDependenciesPending": true,
+	"JobRunning":                  true,
+	"Finalizing":                  true,
+}
+
+// ResourceHealth counts the resources of a RenderedRelease by health status.
+type ResourceHealth struct {
+	Total       int
+	Healthy     int
+	Progressing int
+	Degraded    int
+	Suspended   int
+	Unknown     int
+}
+
+// ReplicaCounts counts the pods of the Deployments and StatefulSets of a RenderedRelease, as
+// reported in their status.
+type ReplicaCounts struct {
+	Replicas  int
+	Ready     int
+	Available int
+}
+
+// EnvironmentStatus is the status of one component in one environment.
+type EnvironmentStatus struct {
+	Status Status
+	// ReleaseBinding is the name of the ReleaseBinding that deploys the component.
+	ReleaseBinding string
+	// Release is the name of the ComponentRelease the binding points to.
+	Release string
+	// Reason and Message come from the binding's Ready condition.
+	Reason  string
+	Message string
+	// LastTransitionTime is when the Ready condition last changed.
+	LastTransitionTime *time.Time
+	// Resources is the health of the rendered resources; nil when no RenderedRelease exists yet.
+	Resources *ResourceHealth
+	// Replicas counts the pods of the rendered workloads; nil when the RenderedRelease has none
+	// or does not exist yet.
+	Replicas *ReplicaCounts
+	// Hosts are the distinct hosts of the endpoint URLs resolved for the binding, sorted.
+	Hosts []string
+	// Lock is the deployment lock of the binding, nil when it has none. Locks past their
+	// expiry are kept until removed from the binding; use Lock.Active.
+	Lock *Lock
+}
+
+// Lock is the deployment lock of a binding.
+type Lock struct {
+	Reason    string
+	LockedBy  string
+	ExpiresAt time.Time
+}
+
+// Active reports whether the lock is enforced at now.
+func (l *Lock) Active(now time.Time) bool {
+	return l != nil && now.Before(l.ExpiresAt)
+}
+
+// ComponentMetadata is the descriptive metadata of a component.
+type ComponentMetadata struct {
+	DisplayName string
+	Description string
+	// Type is the component type name, for example "deployment/web-app".
+	Type   string
+	Labels map[string]string
+	// Team, Domain and Tier come from the component's catalog metadata.
+	Team   string
+	Domain string
+	Tier   string
+	// Archived is set when the component is archived.
+	Archived bool
+}
+
+// ComponentStatus is one row of the matrix.
+type ComponentStatus struct {
+	Name string
+	// Metadata is empty when the component is only known from its bindings.
+	Metadata ComponentMetadata
+	// Environments holds the status per environment. Environments without a binding are absent.
+	Environments map[string]EnvironmentStatus
+}
+
+// StatusCounts counts components by status within an environment.
+type StatusCounts struct {
+	Ready       int
+	Progressing int
+	Failed      int
+	Undeployed  int
+	NotDeployed int
+}
+
+func (c *StatusCounts) add(s Status) {
+	switch s {
+	case StatusReady:
+		c.Ready++
+	case StatusProgressing:
+		c.Progressing++
+	case StatusFailed:
+		c.Failed++
+	case StatusUndeployed:
+		c.Undeployed++
+	default:
+		c.NotDeployed++
+	}
+}
+
+// ProjectStatus is the status matrix of a project. Values handed out by a Store are shared
+// snapshots and must not be modified.
+type ProjectStatus struct {
+	Namespace string
+	Project   string
+	// Environments are the environments any component of the project is bound to, sorted by name.
+	Environments []string
+	// Components are sorted by name.
+	Components []ComponentStatus
+	// Summary counts the components of each environment by status.
+	Summary map[string]StatusCounts
+}
+
+// ProjectSummary is the per-environment summary of a project, without the component rows.
+type ProjectSummary struct {
+	Project      string
+	Components   int
+	Environments []string
+	Summary      map[string]StatusCounts
+	// Locked counts the components with an active deployment lock per environment.
+	// Environments without locked components are absent.
+	Locked map[string]int
+}
+
+// Reader serves status matrices.
+type Reader interface {
+	// ProjectStatuses returns the matrices of the projects in a namespace, or of all
+	// projects when namespace is empty, sorted by namespace and project.
+	ProjectStatuses(namespace string) []*ProjectStatus
+	// ProjectStatus returns the matrix of a project. Unknown projects yield an empty matrix.
+	ProjectStatus(namespace, project string) *ProjectStatus
+	// NamespaceSummaries returns the summaries of all projects in a namespace that have
+	// components or bindings, sorted by project name.
+	NamespaceSummaries(namespace string) []ProjectSummary
+}
+
+// cellKey identifies a matrix cell.
+type cellKey struct {
+	component   string
+	environment string
+}
+
+// projectState holds the contributions of individual objects to a project's matrix.
+type projectState struct {
+	components map[string]ComponentMetadata
+	bindings   map[string]bindingEntry // by ReleaseBinding name
+	releases   map[string]releaseEntry // by RenderedRelease name
+}
+
+type bindingEntry struct {
+	cell   cellKey
+	status EnvironmentStatus
+}
+
+type releaseEntry struct {
+	cell     cellKey
+	health   ResourceHealth
+	replicas *ReplicaCounts
+}
+
+func newProjectState() *projectState {
+	return &projectState{
+		components: map[string]ComponentMetadata{},
+		bindings:   map[string]bindingEntry{},
+		releases:   map[string]releaseEntry{},
+	}
+}
+
+func (p *projectState) empty() bool {
+	return len(p.components) == 0 && len(p.bindings) == 0 && len(p.releases) == 0
+}
+
+// snapshot builds the matrix of the project.
+func (p *projectState) snapshot(namespace, project string) *ProjectStatus {
+	health := make(map[cellKey]ResourceHealth, len(p.releases))
+	replicas := make(map[cellKey]*ReplicaCounts, len(p.releases))
+	for _, r := range p.releases {
+		health[r.cell] = r.health
+		replicas[r.cell] = r.replicas
+	}
+
+	rows := map[string]map[string]EnvironmentStatus{}
+	for name := range p.components {
+		rows[name] = map[string]EnvironmentStatus{}
+	}
+	envSet := map[string]bool{}
+	for _, b := range p.bindings {
+		envSet[b.cell.environment] = true
+		status := b.status
+		if h, ok := health[b.cell]; ok {
+			status.Resources = &h
+		}
+		status.Replicas = replicas[b.cell]
+		if rows[b.cell.component] == nil {
+			rows[b.cell.component] = map[string]EnvironmentStatus{}
+		}
+		rows[b.cell.component][b.cell.environment] = status
+	}
+
+	out := &ProjectStatus{
+		Namespace:    namespace,
+		Project:      project,
+		Environments: sortedKeys(envSet),
+		Components:   make([]ComponentStatus, 0, len(rows)),
+		Summary:      make(map[string]StatusCounts, len(envSet)),
+	}
+	for _, name := range sortedKeys(rows) {
+		out.Components = append(out.Components, ComponentStatus{
+			Name:         name,
+			Metadata:     p.components[name],
+			Environments: rows[name],
+		})
+	}
+	for _, env := range out.Environments {
+		var counts StatusCounts
+		for _, c := range out.Components {
+			cell, ok := c.Environments[env]
+			if !ok {
+				counts.add(StatusNotDeployed)
+				continue
+			}
+			counts.add(cell.Status)
+		}
+		out.Summary[env] = counts
+	}
+	return out
+}
+
+// ProjectSummary returns the summary of the matrix.
+func (p *ProjectStatus) ProjectSummary() ProjectSummary {
+	return ProjectSummary{
+		Project:      p.Project,
+		Components:   len(p.Components),
+		Environments: p.Environments,
+		Summary:      p.Summary,
+		Locked:       p.LockedCounts(time.Now()),
+	}
+}
+
+// LockedCounts counts the components with a deployment lock active at now per environment.
+// Locks expire without the binding changing, so they are counted when read rather than
+// when the matrix is built.
+func (p *ProjectStatus) LockedCounts(now time.Time) map[string]int {
+	counts := map[string]int{}
+	for _, c := range p.Components {
+		for env, cell := range c.Environments {
+			if cell.Lock.Active(now) {
+				counts[env]++
+			}
+		}
+	}
+	return counts
+}
+
+// componentMetadata extracts the descriptive metadata of a component.
+func componentMetadata(c *openchoreov1alpha1.Component) ComponentMetadata {
+	m := ComponentMetadata{
+		DisplayName: c.Annotations[controller.AnnotationKeyDisplayName],
+		Description: c.Annotations[controller.AnnotationKeyDescription],
+		Type:        c.Spec.ComponentType.Name,
+		Labels:      c.Labels,
+		Archived:    c.Spec.IsArchived(),
+	}
+	if catalog := c.Spec.Catalog; catalog != nil {
+		m.Team = catalog.Team
+		m.Domain = catalog.Domain
+		m.Tier = catalog.Tier
+	}
+	return m
+}
+
+// BindingStatus derives the status of a ReleaseBinding from its state and Ready condition.
+func BindingStatus(rb *openchoreov1alpha1.ReleaseBinding) EnvironmentStatus {
+	status := EnvironmentStatus{
+		ReleaseBinding: rb.Name,
+		Release:        rb.Spec.ReleaseName,
+		Hosts:          endpointHosts(rb.Status.Endpoints),
+	}
+	if lock := rb.Spec.Lock; lock != nil {
+		status.Lock = &Lock{Reason: lock.Reason, LockedBy: lock.LockedBy, ExpiresAt: lock.ExpiresAt.Time}
+	}
+	if rb.Spec.State == openchoreov1alpha1.ReleaseStateUndeploy {
+		status.Status = StatusUndeployed
+		return status
+	}
+
+	cond := apimeta.FindStatusCondition(rb.Status.Conditions, conditionReady)
+	if cond == nil {
+		status.Status = StatusProgressing
+		return status
+	}
+	status.Reason = cond.Reason
+	status.Message = cond.Message
+	t := cond.LastTransitionTime.Time
+	status.LastTransitionTime = &t
+
+	switch {
+	case rb.Status.ObservedGeneration < rb.Generation || cond.ObservedGeneration < rb.Generation:
+		status.Status = StatusProgressing
+	case cond.Status == metav1.ConditionTrue:
+		status.Status = StatusReady
+	case cond.Status == metav1.ConditionUnknown || progressingReasons[cond.Reason]:
+		status.Status = StatusProgressing
+	default:
+		status.Status = StatusFailed
+	}
+	return status
+}
+
+// endpointHosts returns the distinct hosts of the resolved endpoint URLs, sorted.
+func endpointHosts(endpoints []openchoreov1alpha1.EndpointURLStatus) []string {
+	hosts := map[string]bool{}
+	addGateway := func(g *openchoreov1alpha1.EndpointGatewayURLs) {
+		if g == nil {
+			return
+		}
+		for _, u := range []*openchoreov1alpha1.EndpointURL{g.HTTP, g.HTTPS, g.TLS} {
+			if u != nil && u.Host != "" {
+				hosts[u.Host] = true
+			}
+		}
+	}
+	for _, ep := range endpoints {
+		if ep.ServiceURL != nil && ep.ServiceURL.Host != "" {
+			hosts[ep.ServiceURL.Host] = true
+		}
+		if u, err := url.Parse(ep.InvokeURL); err == nil && u.Hostname() != "" {
+			hosts[u.Hostname()] = true
+		}
+		addGateway(ep.InternalURLs)
+		addGateway(ep.ExternalURLs)
+	}
+	if len(hosts) == 0 {
+		return nil
+	}
+	return sortedKeys(hosts)
+}
+
+// releaseHealth counts the resources of a RenderedRelease by health status.
+func releaseHealth(rr *openchoreov1alpha1.RenderedRelease) ResourceHealth {
+	h := ResourceHealth{Total: len(rr.Status.Resources)}
+	for _, r := range rr.Status.Resources {
+		switch r.HealthStatus {
+		case openchoreov1alpha1.HealthStatusHealthy:
+			h.Healthy++
+		case openchoreov1alpha1.HealthStatusProgressing:
+			h.Progressing++
+		case openchoreov1alpha1.HealthStatusDegraded:
+			h.Degraded++
+		case openchoreov1alpha1.HealthStatusSuspended:
+			h.Suspended++
+		default:
+			h.Unknown++
+		}
+	}
+	return h
+}
+
+// workloadStatus holds the replica counts of the status of a Deployment or StatefulSet.
+type workloadStatus struct {
+	Replicas          int `json:"replicas,omitempty"`
+	ReadyReplicas     int `json:"readyReplicas,omitempty"`
+	AvailableReplicas int `json:"availableReplicas,omitempty"`
+}
+
+// isWorkload reports whether a rendered resource is a Deployment or StatefulSet.
+func isWorkload(r openchoreov1alpha1.RenderedManifestStatus) bool {
+	return r.Group == "apps" && (r.Kind == "Deployment" || r.Kind == "StatefulSet")
+}
+
+// releaseReplicas sums the replica counts of the workloads of a RenderedRelease. It returns
+// nil when the release has no workloads.
+func releaseReplicas(rr *openchoreov1alpha1.RenderedRelease) *ReplicaCounts {
+	var counts *ReplicaCounts
+	for _, r := range rr.Status.Resources {
+		if !isWorkload(r) {
+			continue
+		}
+		if counts == nil {
+			counts = &ReplicaCounts{}
+		}
+		if r.Status == nil {
+			continue
+		}
+		var status workloadStatus
+		if err := json.Unmarshal(r.Status.Raw, &status); err != nil {
+			continue
+		}
+		counts.Replicas += status.Replicas
+		counts.Ready += status.ReadyReplicas
+		counts.Available += status.AvailableReplicas
+	}
+	return counts
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
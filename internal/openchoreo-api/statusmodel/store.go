@@ -0,0 +1,343 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statusmodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// projectKey identifies a project.
+type projectKey struct {
+	namespace string
+	project   string
+}
+
+// Store keeps the status matrix of every project up to date from informer events.
+// Each event rebuilds the matrix of the affected project only, so reads are a map lookup.
+type Store struct {
+	mu       sync.RWMutex
+	projects map[projectKey]*projectState
+	// snapshots holds the built matrices by namespace and project.
+	snapshots map[string]map[string]*ProjectStatus
+}
+
+var _ Reader = (*Store)(nil)
+
+// NewStore creates an empty store. Register it with an informer cache to populate it.
+func NewStore() *Store {
+	return &Store{
+		projects:  map[projectKey]*projectState{},
+		snapshots: map[string]map[string]*ProjectStatus{},
+	}
+}
+
+// NewCache creates an informer cache for the store. RenderedReleases are cached without their
+// rendered manifests and resource status payloads, which the store does not use and which
+// make up most of their size. Only the replica counts of workload statuses are kept.
+func NewCache(config *rest.Config, scheme *runtime.Scheme) (cache.Cache, error) {
+	return cache.New(config, cache.Options{
+		Scheme:           scheme,
+		DefaultTransform: cache.TransformStripManagedFields(),
+		ByObject: map[client.Object]cache.ByObject{
+			&openchoreov1alpha1.RenderedRelease{}: {Transform: stripRenderedRelease},
+		},
+	})
+}
+
+func stripRenderedRelease(obj any) (any, error) {
+	rr, ok := obj.(*openchoreov1alpha1.RenderedRelease)
+	if !ok {
+		return obj, nil
+	}
+	rr.ManagedFields = nil
+	rr.Spec.Resources = nil
+	for i := range rr.Status.Resources {
+		r := &rr.Status.Resources[i]
+		if !isWorkload(*r) || r.Status == nil {
+			r.Status = nil
+			continue
+		}
+		// A payload that does not parse is cached without counts
+		var status workloadStatus
+		_ = json.Unmarshal(r.Status.Raw, &status)
+		raw, err := json.Marshal(status)
+		if err != nil {
+			return nil, err
+		}
+		r.Status = &runtime.RawExtension{Raw: raw}
+	}
+	return rr, nil
+}
+
+// Register adds the store's event handlers to the Component, ReleaseBinding and
+// RenderedRelease informers of the given cache.
+func (s *Store) Register(ctx context.Context, informers cache.Informers) error {
+	handlers := []struct {
+		obj     client.Object
+		handler toolscache.ResourceEventHandler
+	}{
+		{&openchoreov1alpha1.Component{}, s.handler(func(old, cur client.Object) {
+			s.applyComponent(asComponent(old), asComponent(cur))
+		})},
+		{&openchoreov1alpha1.ReleaseBinding{}, s.handler(func(old, cur client.Object) {
+			s.applyBinding(asBinding(old), asBinding(cur))
+		})},
+		{&openchoreov1alpha1.RenderedRelease{}, s.handler(func(old, cur client.Object) {
+			s.applyRelease(asRelease(old), asRelease(cur))
+		})},
+	}
+	for _, h := range handlers {
+		informer, err := informers.GetInformer(ctx, h.obj)
+		if err != nil {
+			return fmt.Errorf("failed to get informer for %T: %w", h.obj, err)
+		}
+		if _, err := informer.AddEventHandler(h.handler); err != nil {
+			return fmt.Errorf("failed to add event handler for %T: %w", h.obj, err)
+		}
+	}
+	return nil
+}
+
+// handler adapts apply, which receives the previous and current object (either may be nil),
+// to informer callbacks.
+func (s *Store) handler(apply func(old, cur client.Object)) toolscache.ResourceEventHandler {
+	return toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if cur, ok := obj.(client.Object); ok {
+				apply(nil, cur)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			old, _ := oldObj.(client.Object)
+			cur, _ := newObj.(client.Object)
+			apply(old, cur)
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if old, ok := obj.(client.Object); ok {
+				apply(old, nil)
+			}
+		},
+	}
+}
+
+// ProjectStatus returns the matrix of a project.
+func (s *Store) ProjectStatus(namespace, project string) *ProjectStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if snap := s.snapshots[namespace][project]; snap != nil {
+		return snap
+	}
+	return newProjectState().snapshot(namespace, project)
+}
+
+// ProjectStatuses returns the matrices of the projects in a namespace, or of all projects
+// when namespace is empty.
+func (s *Store) ProjectStatuses(namespace string) []*ProjectStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	namespaces := []string{namespace}
+	if namespace == "" {
+		namespaces = sortedKeys(s.snapshots)
+	}
+	var out []*ProjectStatus
+	for _, ns := range namespaces {
+		byProject := s.snapshots[ns]
+		for _, name := range sortedKeys(byProject) {
+			out = append(out, byProject[name])
+		}
+	}
+	return out
+}
+
+// NamespaceSummaries returns the summaries of the projects in a namespace.
+func (s *Store) NamespaceSummaries(namespace string) []ProjectSummary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	byProject := s.snapshots[namespace]
+	out := make([]ProjectSummary, 0, len(byProject))
+	for _, name := range sortedKeys(byProject) {
+		out = append(out, byProject[name].ProjectSummary())
+	}
+	return out
+}
+
+func (s *Store) applyComponent(old, cur *openchoreov1alpha1.Component) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	touched := map[projectKey]bool{}
+	s.putComponent(old, false, touched)
+	s.putComponent(cur, true, touched)
+	s.rebuild(touched)
+}
+
+func (s *Store) applyBinding(old, cur *openchoreov1alpha1.ReleaseBinding) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	touched := map[projectKey]bool{}
+	s.putBinding(old, false, touched)
+	s.putBinding(cur, true, touched)
+	s.rebuild(touched)
+}
+
+func (s *Store) applyRelease(old, cur *openchoreov1alpha1.RenderedRelease) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	touched := map[projectKey]bool{}
+	s.putRelease(old, false, touched)
+	s.putRelease(cur, true, touched)
+	s.rebuild(touched)
+}
+
+// putComponent adds (or, with add=false, removes) the contribution of a component and records
+// the affected project in touched. Callers must hold mu.
+func (s *Store) putComponent(c *openchoreov1alpha1.Component, add bool, touched map[projectKey]bool) {
+	if c == nil {
+		return
+	}
+	key := projectKey{c.Namespace, c.Spec.Owner.ProjectName}
+	if add {
+		s.state(key).components[c.Name] = componentMetadata(c)
+	} else {
+		delete(s.state(key).components, c.Name)
+	}
+	touched[key] = true
+}
+
+// putBinding adds or removes the contribution of a ReleaseBinding. Callers must hold mu.
+func (s *Store) putBinding(rb *openchoreov1alpha1.ReleaseBinding, add bool, touched map[projectKey]bool) {
+	if rb == nil {
+		return
+	}
+	key := projectKey{rb.Namespace, rb.Spec.Owner.ProjectName}
+	if add {
+		s.state(key).bindings[rb.Name] = bindingEntry{
+			cell:   cellKey{component: rb.Spec.Owner.ComponentName, environment: rb.Spec.Environment},
+			status: BindingStatus(rb),
+		}
+	} else {
+		delete(s.state(key).bindings, rb.Name)
+	}
+	touched[key] = true
+}
+
+// putRelease adds or removes the contribution of a RenderedRelease. Only component releases
+// belong in the matrix; project- and resource-level ones are skipped. Callers must hold mu.
+func (s *Store) putRelease(rr *openchoreov1alpha1.RenderedRelease, add bool, touched map[projectKey]bool) {
+	if rr == nil || rr.Spec.Owner.ComponentName == "" {
+		return
+	}
+	key := projectKey{rr.Namespace, rr.Spec.Owner.ProjectName}
+	if add {
+		s.state(key).releases[rr.Name] = releaseEntry{
+			cell:     cellKey{component: rr.Spec.Owner.ComponentName, environment: rr.Spec.EnvironmentName},
+			health:   releaseHealth(rr),
+			replicas: releaseReplicas(rr),
+		}
+	} else {
+		delete(s.state(key).releases, rr.Name)
+	}
+	touched[key] = true
+}
+
+// state returns the state of a project, creating it if needed. Callers must hold mu.
+func (s *Store) state(key projectKey) *projectState {
+	p, ok := s.projects[key]
+	if !ok {
+		p = newProjectState()
+		s.projects[key] = p
+	}
+	return p
+}
+
+// rebuild replaces the snapshots of the given projects. Callers must hold mu.
+func (s *Store) rebuild(keys map[projectKey]bool) {
+	for key := range keys {
+		p := s.projects[key]
+		if p == nil || p.empty() {
+			delete(s.projects, key)
+			delete(s.snapshots[key.namespace], key.project)
+			if len(s.snapshots[key.namespace]) == 0 {
+				delete(s.snapshots, key.namespace)
+			}
+			continue
+		}
+		if s.snapshots[key.namespace] == nil {
+			s.snapshots[key.namespace] = map[string]*ProjectStatus{}
+		}
+		s.snapshots[key.namespace][key.project] = p.snapshot(key.namespace, key.project)
+	}
+}
+
+// FromObjects builds a store from listed objects. It is used to serve status without
+// informers.
+func FromObjects(
+	components []openchoreov1alpha1.Component,
+	bindings []openchoreov1alpha1.ReleaseBinding,
+	releases []openchoreov1alpha1.RenderedRelease,
+) *Store {
+	s := NewStore()
+	touched := map[projectKey]bool{}
+	for i := range components {
+		s.putComponent(&components[i], true, touched)
+	}
+	for i := range bindings {
+		s.putBinding(&bindings[i], true, touched)
+	}
+	for i := range releases {
+		s.putRelease(&releases[i], true, touched)
+	}
+	s.rebuild(touched)
+	return s
+}
+
+// List builds a store from Components, ReleaseBindings and RenderedReleases listed from c,
+// in a single namespace or in all namespaces when namespace is empty.
+func List(ctx context.Context, c client.Reader, namespace string) (*Store, error) {
+	var opts []client.ListOption
+	if namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	var components openchoreov1alpha1.ComponentList
+	if err := c.List(ctx, &components, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := c.List(ctx, &bindings, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	var releases openchoreov1alpha1.RenderedReleaseList
+	if err := c.List(ctx, &releases, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list rendered releases: %w", err)
+	}
+	return FromObjects(components.Items, bindings.Items, releases.Items), nil
+}
+
+func asComponent(obj client.Object) *openchoreov1alpha1.Component {
+	c, _ := obj.(*openchoreov1alpha1.Component)
+	return c
+}
+
+func asBinding(obj client.Object) *openchoreov1alpha1.ReleaseBinding {
+	b, _ := obj.(*openchoreov1alpha1.ReleaseBinding)
+	return b
+}
+
+func asRelease(obj client.Object) *openchoreov1alpha1.RenderedRelease {
+	r, _ := obj.(*openchoreov1alpha1.RenderedRelease)
+	return r
+}
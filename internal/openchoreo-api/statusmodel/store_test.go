@@ -0,0 +1,267 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statusmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const ns = "acme"
+
+func component(project, name string) *openchoreov1alpha1.Component {
+	return &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: project}},
+	}
+}
+
+func binding(project, comp, env string, ready metav1.ConditionStatus, reason string) *openchoreov1alpha1.ReleaseBinding {
+	rb := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: comp + "-" + env, Namespace: ns, Generation: 1},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: project, ComponentName: comp},
+			Environment: env,
+			ReleaseName: comp + "-r1",
+		},
+		Status: openchoreov1alpha1.ReleaseBindingStatus{ObservedGeneration: 1},
+	}
+	if ready != "" {
+		rb.Status.Conditions = []metav1.Condition{{
+			Type: "Ready", Status: ready, Reason: reason, ObservedGeneration: 1, LastTransitionTime: metav1.Now(),
+		}}
+	}
+	return rb
+}
+
+func release(project, comp, env string, health ...openchoreov1alpha1.HealthStatus) *openchoreov1alpha1.RenderedRelease {
+	rr := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: comp + "-" + env, Namespace: ns},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{
+			Owner:           openchoreov1alpha1.RenderedReleaseOwner{ProjectName: project, ComponentName: comp},
+			EnvironmentName: env,
+		},
+	}
+	for _, h := range health {
+		rr.Status.Resources = append(rr.Status.Resources, openchoreov1alpha1.RenderedManifestStatus{HealthStatus: h})
+	}
+	return rr
+}
+
+func TestBindingStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		rb    *openchoreov1alpha1.ReleaseBinding
+		want  Status
+		setup func(*openchoreov1alpha1.ReleaseBinding)
+	}{
+		{name: "ready", rb: binding("p", "api", "dev", metav1.ConditionTrue, "Ready"), want: StatusReady},
+		{name: "no condition yet", rb: binding("p", "api", "dev", "", ""), want: StatusProgressing},
+		{name: "rolling out", rb: binding("p", "api", "dev", metav1.ConditionFalse, "ResourcesProgressing"), want: StatusProgressing},
+		{name: "failed", rb: binding("p", "api", "dev", metav1.ConditionFalse, "ResourcesDegraded"), want: StatusFailed},
+		{
+			name: "stale generation", rb: binding("p", "api", "dev", metav1.ConditionTrue, "Ready"), want: StatusProgressing,
+			setup: func(rb *openchoreov1alpha1.ReleaseBinding) { rb.Generation = 2 },
+		},
+		{
+			name: "undeployed", rb: binding("p", "api", "dev", metav1.ConditionTrue, "Ready"), want: StatusUndeployed,
+			setup: func(rb *openchoreov1alpha1.ReleaseBinding) { rb.Spec.State = openchoreov1alpha1.ReleaseStateUndeploy },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(tt.rb)
+			}
+			assert.Equal(t, tt.want, BindingStatus(tt.rb).Status)
+		})
+	}
+}
+
+func TestStore_BuildsMatrixFromEvents(t *testing.T) {
+	s := NewStore()
+	s.applyComponent(nil, component("shop", "api"))
+	s.applyComponent(nil, component("shop", "web"))
+	s.applyComponent(nil, component("other", "worker"))
+	s.applyBinding(nil, binding("shop", "api", "dev", metav1.ConditionTrue, "Ready"))
+	s.applyBinding(nil, binding("shop", "api", "prod", metav1.ConditionFalse, "ResourcesDegraded"))
+	s.applyBinding(nil, binding("shop", "web", "dev", metav1.ConditionFalse, "ResourcesProgressing"))
+	s.applyRelease(nil, release("shop", "api", "dev",
+		openchoreov1alpha1.HealthStatusHealthy, openchoreov1alpha1.HealthStatusHealthy))
+	// Project-level releases have no component and are not part of the matrix.
+	s.applyRelease(nil, &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop-dev", Namespace: ns},
+		Spec:       openchoreov1alpha1.RenderedReleaseSpec{Owner: openchoreov1alpha1.RenderedReleaseOwner{ProjectName: "shop"}},
+	})
+
+	got := s.ProjectStatus(ns, "shop")
+	assert.Equal(t, []string{"dev", "prod"}, got.Environments)
+	require.Len(t, got.Components, 2)
+	assert.Equal(t, "api", got.Components[0].Name)
+	assert.Equal(t, "web", got.Components[1].Name)
+
+	apiDev := got.Components[0].Environments["dev"]
+	assert.Equal(t, StatusReady, apiDev.Status)
+	assert.Equal(t, "api-r1", apiDev.Release)
+	assert.Equal(t, &ResourceHealth{Total: 2, Healthy: 2}, apiDev.Resources)
+	assert.Equal(t, StatusFailed, got.Components[0].Environments["prod"].Status)
+	assert.Nil(t, got.Components[0].Environments["prod"].Resources)
+
+	assert.Equal(t, StatusCounts{Ready: 1, Progressing: 1}, got.Summary["dev"])
+	assert.Equal(t, StatusCounts{Failed: 1, NotDeployed: 1}, got.Summary["prod"])
+
+	summaries := s.NamespaceSummaries(ns)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, "other", summaries[0].Project)
+	assert.Equal(t, "shop", summaries[1].Project)
+	assert.Equal(t, 2, summaries[1].Components)
+}
+
+func TestStore_UpdatesAndDeletes(t *testing.T) {
+	s := NewStore()
+	api := component("shop", "api")
+	progressing := binding("shop", "api", "dev", metav1.ConditionFalse, "ResourcesProgressing")
+	s.applyComponent(nil, api)
+	s.applyBinding(nil, progressing)
+	before := s.ProjectStatus(ns, "shop")
+
+	ready := binding("shop", "api", "dev", metav1.ConditionTrue, "Ready")
+	s.applyBinding(progressing, ready)
+	after := s.ProjectStatus(ns, "shop")
+	assert.Equal(t, StatusReady, after.Components[0].Environments["dev"].Status)
+	// Snapshots handed out earlier are not modified.
+	assert.Equal(t, StatusProgressing, before.Components[0].Environments["dev"].Status)
+
+	s.applyBinding(ready, nil)
+	s.applyComponent(api, nil)
+	assert.Empty(t, s.ProjectStatus(ns, "shop").Components)
+	assert.Empty(t, s.NamespaceSummaries(ns))
+	assert.Empty(t, s.projects)
+}
+
+func TestStore_HandlerUnwrapsTombstones(t *testing.T) {
+	s := NewStore()
+	h := s.handler(func(old, cur client.Object) { s.applyComponent(asComponent(old), asComponent(cur)) })
+
+	api := component("shop", "api")
+	h.OnAdd(api, true)
+	require.Len(t, s.ProjectStatus(ns, "shop").Components, 1)
+
+	h.OnDelete(toolscache.DeletedFinalStateUnknown{Key: ns + "/api", Obj: api})
+	assert.Empty(t, s.ProjectStatus(ns, "shop").Components)
+}
+
+func TestFromObjects(t *testing.T) {
+	s := FromObjects(
+		[]openchoreov1alpha1.Component{*component("shop", "api")},
+		[]openchoreov1alpha1.ReleaseBinding{*binding("shop", "api", "dev", metav1.ConditionTrue, "Ready")},
+		[]openchoreov1alpha1.RenderedRelease{*release("shop", "api", "dev", openchoreov1alpha1.HealthStatusDegraded)},
+	)
+	got := s.ProjectStatus(ns, "shop")
+	require.Len(t, got.Components, 1)
+	assert.Equal(t, &ResourceHealth{Total: 1, Degraded: 1}, got.Components[0].Environments["dev"].Resources)
+}
+
+func TestStripRenderedRelease(t *testing.T) {
+	rr := release("shop", "api", "dev", openchoreov1alpha1.HealthStatusHealthy)
+	rr.Spec.Resources = []openchoreov1alpha1.RenderedManifest{{ID: "deployment"}}
+	out, err := stripRenderedRelease(rr)
+	require.NoError(t, err)
+	stripped := out.(*openchoreov1alpha1.RenderedRelease)
+	assert.Nil(t, stripped.Spec.Resources)
+	assert.Equal(t, ResourceHealth{Total: 1, Healthy: 1}, releaseHealth(stripped))
+}
+
+func TestReleaseReplicas(t *testing.T) {
+	rr := release("shop", "api", "dev", openchoreov1alpha1.HealthStatusProgressing, openchoreov1alpha1.HealthStatusHealthy)
+	rr.Status.Resources[0] = openchoreov1alpha1.RenderedManifestStatus{
+		Group: "apps", Kind: "Deployment", HealthStatus: openchoreov1alpha1.HealthStatusProgressing,
+		Status: &runtime.RawExtension{Raw: []byte(`{"replicas":3,"readyReplicas":2,"availableReplicas":2,"conditions":[{"type":"Available"}]}`)},
+	}
+	rr.Status.Resources[1].Kind = "Service"
+	rr.Status.Resources[1].Status = &runtime.RawExtension{Raw: []byte(`{"loadBalancer":{}}`)}
+
+	out, err := stripRenderedRelease(rr)
+	require.NoError(t, err)
+	stripped := out.(*openchoreov1alpha1.RenderedRelease)
+	assert.JSONEq(t, `{"replicas":3,"readyReplicas":2,"availableReplicas":2}`, string(stripped.Status.Resources[0].Status.Raw))
+	assert.Nil(t, stripped.Status.Resources[1].Status)
+	assert.Equal(t, &ReplicaCounts{Replicas: 3, Ready: 2, Available: 2}, releaseReplicas(stripped))
+	assert.Nil(t, releaseReplicas(release("shop", "api", "dev", openchoreov1alpha1.HealthStatusHealthy)))
+
+	s := FromObjects(nil,
+		[]openchoreov1alpha1.ReleaseBinding{*binding("shop", "api", "dev", metav1.ConditionTrue, "Ready")},
+		[]openchoreov1alpha1.RenderedRelease{*stripped},
+	)
+	got := s.ProjectStatus(ns, "shop")
+	require.Len(t, got.Components, 1)
+	assert.Equal(t, &ReplicaCounts{Replicas: 3, Ready: 2, Available: 2}, got.Components[0].Environments["dev"].Replicas)
+}
+
+func TestStore_MetadataHostsAndProjectStatuses(t *testing.T) {
+	api := component("shop", "api")
+	api.Annotations = map[string]string{"openchoreo.dev/display-name": "Orders API"}
+	api.Spec.ComponentType.Name = "deployment/service"
+	rb := binding("shop", "api", "dev", metav1.ConditionTrue, "Ready")
+	rb.Status.Endpoints = []openchoreov1alpha1.EndpointURLStatus{{
+		Name:         "http",
+		ServiceURL:   &openchoreov1alpha1.EndpointURL{Host: "api.shop.svc.cluster.local"},
+		InvokeURL:    "https://api.dev.example.com/orders",
+		ExternalURLs: &openchoreov1alpha1.EndpointGatewayURLs{HTTPS: &openchoreov1alpha1.EndpointURL{Host: "api.dev.example.com"}},
+	}}
+
+	s := NewStore()
+	s.applyComponent(nil, api)
+	s.applyBinding(nil, rb)
+	other := component("billing", "invoices")
+	other.Namespace = "other"
+	s.applyComponent(nil, other)
+
+	got := s.ProjectStatus(ns, "shop").Components[0]
+	assert.Equal(t, ComponentMetadata{DisplayName: "Orders API", Type: "deployment/service"}, got.Metadata)
+	assert.Equal(t, []string{"api.dev.example.com", "api.shop.svc.cluster.local"}, got.Environments["dev"].Hosts)
+
+	require.Len(t, s.ProjectStatuses(ns), 1)
+	all := s.ProjectStatuses("")
+	require.Len(t, all, 2)
+	assert.Equal(t, "acme", all[0].Namespace)
+	assert.Equal(t, "other", all[1].Namespace)
+}
+
+func TestStore_Locks(t *testing.T) {
+	now := time.Now()
+	locked := binding("shop", "api", "prod", metav1.ConditionTrue, "Ready")
+	locked.Spec.Lock = &openchoreov1alpha1.DeploymentLock{
+		Reason: "INC-42", LockedBy: "alice", ExpiresAt: metav1.NewTime(now.Add(time.Hour)),
+	}
+	expired := binding("shop", "web", "prod", metav1.ConditionTrue, "Ready")
+	expired.Spec.Lock = &openchoreov1alpha1.DeploymentLock{
+		Reason: "INC-41", ExpiresAt: metav1.NewTime(now.Add(-time.Hour)),
+	}
+	s := FromObjects(nil, []openchoreov1alpha1.ReleaseBinding{*locked, *expired}, nil)
+
+	got := s.ProjectStatus(ns, "shop")
+	require.Len(t, got.Components, 2)
+	lock := got.Components[0].Environments["prod"].Lock
+	require.NotNil(t, lock)
+	assert.Equal(t, "INC-42", lock.Reason)
+	assert.Equal(t, "alice", lock.LockedBy)
+	assert.True(t, lock.Active(now))
+	assert.False(t, got.Components[1].Environments["prod"].Lock.Active(now))
+	assert.Equal(t, map[string]int{"prod": 1}, got.LockedCounts(now))
+	assert.Equal(t, map[string]int{}, got.LockedCounts(now.Add(2*time.Hour)))
+
+	summaries := s.NamespaceSummaries(ns)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, map[string]int{"prod": 1}, summaries[0].Locked)
+}
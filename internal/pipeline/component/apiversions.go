@@ -0,0 +1,59 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"strings"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// applyAPIVersionPreferences rewrites the apiVersion of the resources rendered to the data
+// plane according to the data plane's API version preferences. A preference for the kind of
+// a resource takes precedence over a preference for its whole group. Only the version is
+// changed; the templates are expected to render fields that are valid in both versions.
+func applyAPIVersionPreferences(resources []renderer.RenderedResource, prefs []v1alpha1.APIVersionPreference) {
+	if len(prefs) == 0 {
+		return
+	}
+	for _, rr := range resources {
+		if rr.TargetPlane != v1alpha1.TargetPlaneDataPlane {
+			continue
+		}
+		apiVersion, _ := rr.Resource["apiVersion"].(string)
+		kind, _ := rr.Resource["kind"].(string)
+		if apiVersion == "" || kind == "" {
+			continue
+		}
+		group := ""
+		if i := strings.LastIndex(apiVersion, "/"); i >= 0 {
+			group = apiVersion[:i]
+		}
+		if version, ok := preferredVersion(prefs, group, kind); ok {
+			if group == "" {
+				rr.Resource["apiVersion"] = version
+			} else {
+				rr.Resource["apiVersion"] = group + "/" + version
+			}
+		}
+	}
+}
+
+// preferredVersion returns the preferred version for a kind of a group, if any.
+func preferredVersion(prefs []v1alpha1.APIVersionPreference, group, kind string) (string, bool) {
+	groupVersion, groupMatched := "", false
+	for _, pref := range prefs {
+		if pref.Group != group {
+			continue
+		}
+		if pref.Kind == kind {
+			return pref.Version, true
+		}
+		if pref.Kind == "" && !groupMatched {
+			groupVersion, groupMatched = pref.Version, true
+		}
+	}
+	return groupVersion, groupMatched
+}
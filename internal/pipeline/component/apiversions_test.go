@@ -0,0 +1,93 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+func TestApplyAPIVersionPreferences(t *testing.T) {
+	prefs := []v1alpha1.APIVersionPreference{
+		{Group: "autoscaling", Version: "v2beta2"},
+		{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute", Version: "v1beta1"},
+		{Group: "gateway.networking.k8s.io", Version: "v1alpha2"},
+	}
+
+	tests := []struct {
+		name        string
+		apiVersion  string
+		kind        string
+		targetPlane string
+		want        string
+	}{
+		{name: "group preference", apiVersion: "autoscaling/v2", kind: "HorizontalPodAutoscaler", want: "autoscaling/v2beta2"},
+		{name: "kind preference wins over group", apiVersion: "gateway.networking.k8s.io/v1", kind: "HTTPRoute", want: "gateway.networking.k8s.io/v1beta1"},
+		{name: "other kind of the group", apiVersion: "gateway.networking.k8s.io/v1", kind: "GRPCRoute", want: "gateway.networking.k8s.io/v1alpha2"},
+		{name: "unmatched group", apiVersion: "apps/v1", kind: "Deployment", want: "apps/v1"},
+		{name: "core group is not matched by named groups", apiVersion: "v1", kind: "Service", want: "v1"},
+		{
+			name:        "observability plane resources are left alone",
+			apiVersion:  "autoscaling/v2",
+			kind:        "HorizontalPodAutoscaler",
+			targetPlane: v1alpha1.TargetPlaneObservabilityPlane,
+			want:        "autoscaling/v2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targetPlane := tt.targetPlane
+			if targetPlane == "" {
+				targetPlane = v1alpha1.TargetPlaneDataPlane
+			}
+			resources := []renderer.RenderedResource{{
+				Resource:    map[string]any{"apiVersion": tt.apiVersion, "kind": tt.kind},
+				TargetPlane: targetPlane,
+			}}
+			applyAPIVersionPreferences(resources, prefs)
+			if got := resources[0].Resource["apiVersion"]; got != tt.want {
+				t.Errorf("apiVersion = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender_APIVersionPreferences(t *testing.T) {
+	var componentType v1alpha1.ComponentType
+	if err := yaml.Unmarshal([]byte(`
+spec:
+  resources:
+    - id: hpa
+      template:
+        apiVersion: autoscaling/v2
+        kind: HorizontalPodAutoscaler
+        metadata:
+          name: app
+`), &componentType); err != nil {
+		t.Fatalf("Failed to parse componentType: %v", err)
+	}
+	input := &RenderInput{
+		ComponentType: &componentType,
+		Component:     &v1alpha1.Component{},
+		Workload:      &v1alpha1.Workload{},
+		Environment:   &v1alpha1.Environment{},
+		DataPlane: &v1alpha1.DataPlane{Spec: v1alpha1.DataPlaneSpec{
+			APIVersions: []v1alpha1.APIVersionPreference{{Group: "autoscaling", Version: "v2beta2"}},
+		}},
+		Metadata: postRenderTestMetadata(),
+	}
+
+	output, err := NewPipeline().Render(t.Context(), input)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if got := output.Resources[0].Resource["apiVersion"]; got != "autoscaling/v2beta2" {
+		t.Errorf("apiVersion = %v, want autoscaling/v2beta2", got)
+	}
+}
@@ -68,7 +68,14 @@ metadata:
 				},
 				"environmentConfigs": map[string]any{}, // No environmentConfigs schema defined
 				"dataplane":          map[string]any{},
-				"environment":        map[string]any{},
+				"environment": map[string]any{
+					"name":         "",
+					"labels":       map[string]any{},
+					"isProduction": false,
+					"tier":         "non-production",
+					"region":       "",
+					"dnsSuffix":    "",
+				},
 				"metadata": map[string]any{
 					"name":               "test-component-dev-12345678",
 					"namespace":          "test-namespace",
@@ -88,8 +95,9 @@ metadata:
 					},
 				},
 				"workload": map[string]any{
-					"container": map[string]any{},
-					"endpoints": map[string]any{},
+					"container":      map[string]any{},
+					"endpoints":      map[string]any{},
+					"asyncEndpoints": map[string]any{},
 				},
 				"configurations": map[string]any{
 					"configs": map[string]any{
@@ -166,8 +174,15 @@ spec:
 				"environmentConfigs": map[string]any{
 					"replicas": float64(5), // From ReleaseBinding.Spec.ComponentTypeEnvironmentConfigs
 				},
-				"dataplane":   map[string]any{},
-				"environment": map[string]any{},
+				"dataplane": map[string]any{},
+				"environment": map[string]any{
+					"name":         "",
+					"labels":       map[string]any{},
+					"isProduction": false,
+					"tier":         "non-production",
+					"region":       "",
+					"dnsSuffix":    "",
+				},
 				"metadata": map[string]any{
 					"name":               "test-component-dev-12345678",
 					"namespace":          "test-namespace",
@@ -187,8 +202,9 @@ spec:
 					},
 				},
 				"workload": map[string]any{
-					"container": map[string]any{},
-					"endpoints": map[string]any{},
+					"container":      map[string]any{},
+					"endpoints":      map[string]any{},
+					"asyncEndpoints": map[string]any{},
 				},
 				"configurations": map[string]any{
 					"configs": map[string]any{
@@ -253,7 +269,8 @@ metadata:
 					"container": map[string]any{
 						"image": "myapp:latest",
 					},
-					"endpoints": map[string]any{},
+					"endpoints":      map[string]any{},
+					"asyncEndpoints": map[string]any{},
 				},
 				"configurations": map[string]any{
 					"configs": map[string]any{
@@ -265,8 +282,15 @@ metadata:
 						"files": []any{},
 					},
 				},
-				"dataplane":   map[string]any{},
-				"environment": map[string]any{},
+				"dataplane": map[string]any{},
+				"environment": map[string]any{
+					"name":         "",
+					"labels":       map[string]any{},
+					"isProduction": false,
+					"tier":         "non-production",
+					"region":       "",
+					"dnsSuffix":    "",
+				},
 				"metadata": map[string]any{
 					"name":               "test-component-dev-12345678",
 					"namespace":          "test-namespace",
@@ -461,7 +485,14 @@ metadata:
 				"dataplane": map[string]any{
 					"secretStore": "test-secret-store",
 				},
-				"environment": map[string]any{},
+				"environment": map[string]any{
+					"name":         "",
+					"labels":       map[string]any{},
+					"isProduction": false,
+					"tier":         "non-production",
+					"region":       "",
+					"dnsSuffix":    "",
+				},
 				"metadata": map[string]any{
 					"name":               "test-component-dev-12345678",
 					"namespace":          "test-namespace",
@@ -481,8 +512,9 @@ metadata:
 					},
 				},
 				"workload": map[string]any{
-					"container": map[string]any{},
-					"endpoints": map[string]any{},
+					"container":      map[string]any{},
+					"endpoints":      map[string]any{},
+					"asyncEndpoints": map[string]any{},
 				},
 				"configurations": map[string]any{
 					"configs": map[string]any{
@@ -570,7 +602,14 @@ spec:
 				"dataplane": map[string]any{
 					"secretStore": "test-secret-store",
 				},
-				"environment": map[string]any{},
+				"environment": map[string]any{
+					"name":         "",
+					"labels":       map[string]any{},
+					"isProduction": false,
+					"tier":         "non-production",
+					"region":       "",
+					"dnsSuffix":    "",
+				},
 				"metadata": map[string]any{
 					"name":               "test-component-dev-12345678",
 					"namespace":          "test-namespace",
@@ -590,8 +629,9 @@ spec:
 					},
 				},
 				"workload": map[string]any{
-					"container": map[string]any{},
-					"endpoints": map[string]any{},
+					"container":      map[string]any{},
+					"endpoints":      map[string]any{},
+					"asyncEndpoints": map[string]any{},
 				},
 				"configurations": map[string]any{
 					"configs": map[string]any{
@@ -254,11 +254,56 @@ func toGatewayEndpointData(e *v1alpha1.GatewayEndpointSpec) *GatewayEndpointData
 // extractEnvironmentData extracts EnvironmentData from Environment and DataPlane resources.
 // Gateway configuration is merged at each dimension: ingress/egress and external/internal.
 // Environment-level values take precedence; missing values fall back to the DataPlane level.
+//
+// Region falls back to the DataPlane region, the DNS suffix to the host of the effective
+// external ingress gateway, and the tier to "production" or "non-production" depending on
+// isProduction.
 func extractEnvironmentData(env *v1alpha1.Environment, dp *v1alpha1.DataPlane, defaultNotificationChannel string) EnvironmentData {
-	return EnvironmentData{
+	data := EnvironmentData{
+		Name:                       env.Name,
+		Labels:                     env.Labels,
+		IsProduction:               env.Spec.IsProduction,
+		Tier:                       env.Spec.Tier,
+		Region:                     env.Spec.Region,
+		DNSSuffix:                  env.Spec.DNSSuffix,
 		Gateway:                    mergeGatewayData(&env.Spec.Gateway, &dp.Spec.Gateway),
 		DefaultNotificationChannel: defaultNotificationChannel,
 	}
+	if data.Labels == nil {
+		data.Labels = map[string]string{}
+	}
+	if data.Tier == "" {
+		data.Tier = environmentTierNonProduction
+		if data.IsProduction {
+			data.Tier = environmentTierProduction
+		}
+	}
+	if data.Region == "" {
+		data.Region = dp.Spec.Region
+	}
+	if data.DNSSuffix == "" {
+		data.DNSSuffix = externalIngressHost(data.Gateway)
+	}
+	return data
+}
+
+const (
+	environmentTierProduction    = "production"
+	environmentTierNonProduction = "non-production"
+)
+
+// externalIngressHost returns the host of the external ingress gateway, preferring the
+// HTTPS listener over HTTP and TLS. Returns an empty string when none is configured.
+func externalIngressHost(gw *GatewayData) string {
+	if gw == nil || gw.Ingress == nil || gw.Ingress.External == nil {
+		return ""
+	}
+	for _, l := range []*GatewayListenerData{gw.Ingress.External.HTTPS, gw.Ingress.External.HTTP, gw.Ingress.External.TLS} {
+		if l != nil && l.Host != "" {
+			return l.Host
+		}
+	}
+	return ""
 }
 
 // mergeGatewayData merges environment-level and dataplane-level gateway specs.
@@ -331,7 +376,8 @@ func mergeGatewayNetworkData(envNetwork, dpNetwork *v1alpha1.GatewayNetworkSpec)
 // it across multiple context builds (ComponentContext and TraitContexts).
 func ExtractWorkloadData(workload *v1alpha1.Workload) WorkloadData {
 	data := WorkloadData{
-		Endpoints: make(map[string]EndpointData),
+		Endpoints:      make(map[string]EndpointData),
+		AsyncEndpoints: make(map[string]AsyncEndpointData),
 	}
 
 	if workload == nil {
@@ -345,6 +391,19 @@ func ExtractWorkloadData(workload *v1alpha1.Workload) WorkloadData {
 	}
 
 	for name, endpoint := range workload.Spec.Endpoints {
+		if endpoint.Type.IsAsync() {
+			if endpoint.Async != nil {
+				data.AsyncEndpoints[name] = AsyncEndpointData{
+					DisplayName: endpoint.DisplayName,
+					Type:        string(endpoint.Type),
+					Channel:     endpoint.Async.Channel,
+					Broker:      endpoint.Async.Broker,
+					Port:        endpoint.Port,
+				}
+			}
+			continue
+		}
+
 		targetPort := endpoint.TargetPort
 		if targetPort == 0 {
 			targetPort = endpoint.Port
@@ -390,6 +449,9 @@ func ExtractEndpointResources(workload *v1alpha1.Workload) EndpointResourceMap {
 		return result
 	}
 	for name, endpoint := range workload.Spec.Endpoints {
+		if endpoint.Type.IsAsync() {
+			continue
+		}
 		resources, err := schemaextract.Extract(endpoint.Type, endpoint.Schema)
 		if err != nil {
 			logf.Log.WithName("schemaextract").Info(
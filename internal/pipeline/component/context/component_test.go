@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -635,6 +636,84 @@ func TestExtractEnvironmentData_GatewayMerge(t *testing.T) {
 	assert.Equal(t, "slack-channel", data.DefaultNotificationChannel)
 }
 
+func TestExtractEnvironmentData_Metadata(t *testing.T) {
+	dpWithHost := &v1alpha1.DataPlane{
+		Spec: v1alpha1.DataPlaneSpec{
+			Region: "us-east-1",
+			Gateway: v1alpha1.GatewaySpec{
+				Ingress: &v1alpha1.GatewayNetworkSpec{
+					External: &v1alpha1.GatewayEndpointSpec{
+						Name:  "dp-ext-gw",
+						HTTP:  &v1alpha1.GatewayListenerSpec{Port: 80, Host: "http.dp.example.com"},
+						HTTPS: &v1alpha1.GatewayListenerSpec{Port: 443, Host: "dp.example.com"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		env           *v1alpha1.Environment
+		dp            *v1alpha1.DataPlane
+		wantLabels    map[string]string
+		wantTier      string
+		wantRegion    string
+		wantDNSSuffix string
+	}{
+		{
+			name: "defaults from dataplane and isProduction",
+			env: &v1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+				Spec:       v1alpha1.EnvironmentSpec{IsProduction: true},
+			},
+			dp:            dpWithHost,
+			wantLabels:    map[string]string{},
+			wantTier:      "production",
+			wantRegion:    "us-east-1",
+			wantDNSSuffix: "dp.example.com",
+		},
+		{
+			name: "environment values take precedence",
+			env: &v1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"team": "payments"}},
+				Spec: v1alpha1.EnvironmentSpec{
+					IsProduction: true,
+					Tier:         "critical",
+					Region:       "eu-west-1",
+					DNSSuffix:    "eu.example.com",
+				},
+			},
+			dp:            dpWithHost,
+			wantLabels:    map[string]string{"team": "payments"},
+			wantTier:      "critical",
+			wantRegion:    "eu-west-1",
+			wantDNSSuffix: "eu.example.com",
+		},
+		{
+			name: "no dataplane region or gateway",
+			env: &v1alpha1.Environment{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			},
+			dp:         &v1alpha1.DataPlane{},
+			wantLabels: map[string]string{},
+			wantTier:   "non-production",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := extractEnvironmentData(tt.env, tt.dp, "")
+			assert.Equal(t, tt.env.Name, data.Name)
+			assert.Equal(t, tt.env.Spec.IsProduction, data.IsProduction)
+			assert.Equal(t, tt.wantLabels, data.Labels)
+			assert.Equal(t, tt.wantTier, data.Tier)
+			assert.Equal(t, tt.wantRegion, data.Region)
+			assert.Equal(t, tt.wantDNSSuffix, data.DNSSuffix)
+		})
+	}
+}
+
 func TestMergeGatewayData_BothNil(t *testing.T) {
 	data := mergeGatewayData(nil, nil)
 	assert.Nil(t, data)
@@ -765,6 +844,34 @@ func TestExtractWorkloadData_WithEndpoints(t *testing.T) {
 	assert.Contains(t, grpcEp.Visibility, "namespace")
 }
 
+func TestExtractWorkloadData_AsyncEndpoints(t *testing.T) {
+	workload := &v1alpha1.Workload{
+		Spec: v1alpha1.WorkloadSpec{
+			WorkloadTemplateSpec: v1alpha1.WorkloadTemplateSpec{
+				Endpoints: map[string]v1alpha1.WorkloadEndpoint{
+					"http": {Type: v1alpha1.EndpointTypeHTTP, Port: 8080},
+					"orders": {
+						DisplayName: "Order events",
+						Type:        v1alpha1.EndpointTypeKafka,
+						Port:        9092,
+						Async:       &v1alpha1.AsyncEndpoint{Channel: "orders", Broker: "kafka-bootstrap.kafka"},
+					},
+				},
+			},
+		},
+	}
+
+	data := ExtractWorkloadData(workload)
+
+	// Async endpoints are kept out of endpoints so that no Service port or route is rendered.
+	assert.Len(t, data.Endpoints, 1)
+	assert.Contains(t, data.Endpoints, "http")
+	assert.Equal(t, map[string]AsyncEndpointData{
+		"orders": {DisplayName: "Order events", Type: "Kafka", Channel: "orders", Broker: "kafka-bootstrap.kafka", Port: 9092},
+	}, data.AsyncEndpoints)
+	assert.Empty(t, ExtractEndpointResources(workload))
+}
+
 func TestExtractWorkloadData_NilWorkload(t *testing.T) {
 	data := ExtractWorkloadData(nil)
 	assert.NotNil(t, data.Endpoints, "Endpoints map should be initialized, not nil")
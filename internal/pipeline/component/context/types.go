@@ -303,9 +303,24 @@ type ObservabilityPlaneRefData struct {
 	Name string `json:"name,omitempty"`
 }
 
-// EnvironmentData provides environment-specific gateway configuration in templates.
+// EnvironmentData provides environment metadata and environment-specific gateway
+// configuration in templates, so that templates can vary their output per environment,
+// e.g. ${environment.region} or ${"api." + environment.dnsSuffix}.
 // If the environment does not have gateway configuration, values fallback to DataPlane gateway.
 type EnvironmentData struct {
+	// Name is the name of the Environment resource.
+	Name string `json:"name"`
+	// Labels are the labels of the Environment resource. Never nil.
+	Labels map[string]string `json:"labels"`
+	// IsProduction reports whether the environment is marked as production.
+	IsProduction bool `json:"isProduction"`
+	// Tier is the environment tier, defaulting to "production" or "non-production".
+	Tier string `json:"tier"`
+	// Region is the environment region, falling back to the DataPlane region.
+	Region string `json:"region"`
+	// DNSSuffix is the environment DNS suffix, falling back to the external ingress host.
+	DNSSuffix string `json:"dnsSuffix"`
+
 	Gateway                    *GatewayData `json:"gateway,omitempty"`
 	DefaultNotificationChannel string       `json:"defaultNotificationChannel,omitempty"`
 }
@@ -314,6 +329,9 @@ type EnvironmentData struct {
 type WorkloadData struct {
 	Container ContainerData           `json:"container"`
 	Endpoints map[string]EndpointData `json:"endpoints"`
+	// AsyncEndpoints holds the Kafka, NATS and AMQP endpoints. They are kept apart from
+	// Endpoints so that templates do not render Service ports or routes for them.
+	AsyncEndpoints map[string]AsyncEndpointData `json:"asyncEndpoints"`
 }
 
 // ContainerData contains container information.
@@ -333,6 +351,15 @@ type EndpointData struct {
 	Visibility  []string `json:"visibility"`
 }
 
+// AsyncEndpointData contains asynchronous endpoint information.
+type AsyncEndpointData struct {
+	DisplayName string `json:"displayName,omitempty"`
+	Type        string `json:"type"`
+	Channel     string `json:"channel"`
+	Broker      string `json:"broker"`
+	Port        int32  `json:"port"`
+}
+
 // EndpointResourceMap maps an endpoint name to the routes extracted from its API
 // schema. It is computed only when a template opts in via the
 // workload.toEndpointResources() macro, so schema-less or unused endpoints incur
@@ -0,0 +1,242 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+const (
+	kindCertificate      = "Certificate"
+	kindXListenerSet     = "XListenerSet"
+	certManagerVersion   = "cert-manager.io/v1"
+	listenerSetAPIGroup  = "gateway.networking.x-k8s.io"
+	listenerSetVersion   = listenerSetAPIGroup + "/v1alpha1"
+	domainListenerName   = "https"
+	defaultHTTPSPort     = 443
+	domainResourcePrefix = "domain"
+)
+
+// DomainMappingTarget is a verified custom domain of a public endpoint of the component.
+type DomainMappingTarget struct {
+	// Name is the name of the DomainMapping.
+	Name string
+
+	// Endpoint is the name of the workload endpoint served under the domain.
+	Endpoint string
+
+	// Domain is the custom domain.
+	Domain string
+}
+
+// DomainMappingInput describes the custom domains to serve next to the rendered resources.
+type DomainMappingInput struct {
+	// Resources are the rendered resources of the release.
+	Resources []renderer.RenderedResource
+
+	// Mappings are the verified custom domains of the component in the environment.
+	Mappings []DomainMappingTarget
+
+	// Gateway is the external ingress gateway the domains are attached to.
+	Gateway *v1alpha1.GatewayEndpointSpec
+
+	// IssuerRef is the cert-manager issuer that issues the certificates of the domains.
+	IssuerRef v1alpha1.CertificateIssuerRef
+}
+
+// DomainMappingOutput holds the resources that serve the custom domains.
+type DomainMappingOutput struct {
+	// Resources are the rendered resources followed by the resources of each served domain,
+	// sorted like the output of Render.
+	Resources []renderer.RenderedResource
+
+	// Served are the names of the mappings whose endpoint has an external route to copy.
+	Served []string
+}
+
+// DomainMappingResourceName returns the name of the Certificate, listener set and route
+// rendered for a DomainMapping.
+func DomainMappingResourceName(mappingName string) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxServiceNameLength, domainResourcePrefix, mappingName)
+}
+
+// DomainMappingSecretName returns the name of the secret the certificate of a DomainMapping
+// is stored in.
+func DomainMappingSecretName(mappingName string) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxServiceNameLength, domainResourcePrefix, mappingName, "tls")
+}
+
+// RenderDomainMappings adds the resources that serve the endpoints of the component under
+// their custom domains.
+//
+// For each mapping, the external HTTPRoute rendered for its endpoint is copied with the
+// custom domain as its only hostname. The copy is attached to an XListenerSet that adds an
+// HTTPS listener for the domain to the external gateway, which terminates TLS with a
+// cert-manager Certificate issued for the domain. Mappings whose endpoint has no external
+// HTTPRoute, for example because the endpoint is not public, are left out of Served.
+func RenderDomainMappings(input *DomainMappingInput) *DomainMappingOutput {
+	out := &DomainMappingOutput{
+		Resources: make([]renderer.RenderedResource, 0, len(input.Resources)+3*len(input.Mappings)),
+	}
+	out.Resources = append(out.Resources, input.Resources...)
+	if input.Gateway == nil {
+		return out
+	}
+
+	for _, mapping := range input.Mappings {
+		route := externalEndpointRoute(input.Resources, mapping.Endpoint)
+		if route == nil {
+			continue
+		}
+		namespace, _ := route["metadata"].(map[string]any)["namespace"].(string)
+		name := DomainMappingResourceName(mapping.Name)
+
+		out.Resources = append(out.Resources,
+			renderer.RenderedResource{
+				Resource:    makeDomainCertificate(mapping, name, namespace, input.IssuerRef),
+				TargetPlane: v1alpha1.TargetPlaneDataPlane,
+			},
+			renderer.RenderedResource{
+				Resource:    makeDomainListenerSet(mapping, name, namespace, input.Gateway),
+				TargetPlane: v1alpha1.TargetPlaneDataPlane,
+			},
+			renderer.RenderedResource{
+				Resource:    makeDomainRoute(route, mapping, name),
+				TargetPlane: v1alpha1.TargetPlaneDataPlane,
+			},
+		)
+		out.Served = append(out.Served, mapping.Name)
+	}
+
+	sortRenderedResources(out.Resources)
+	return out
+}
+
+// externalEndpointRoute returns the external HTTPRoute of the running release rendered for
+// an endpoint, or nil.
+func externalEndpointRoute(resources []renderer.RenderedResource, endpoint string) map[string]any {
+	for _, rr := range resources {
+		if rr.TargetPlane != v1alpha1.TargetPlaneDataPlane || rr.Resource["kind"] != kindHTTPRoute || !isTrafficRoute(rr.Resource) {
+			continue
+		}
+		metadata, _ := rr.Resource["metadata"].(map[string]any)
+		routeLabels, _ := metadata["labels"].(map[string]any)
+		if routeLabels[labels.LabelKeyEndpointName] != endpoint ||
+			routeLabels[labels.LabelKeyEndpointVisibility] != string(v1alpha1.EndpointVisibilityExternal) {
+			continue
+		}
+		if track := rolloutTrack(rr.Resource); track == labels.LabelValueRolloutTrackCanary || track == labels.LabelValueRolloutTrackPreview {
+			continue
+		}
+		if _, isCopy := routeLabels[labels.LabelKeyDomainMapping]; isCopy {
+			continue
+		}
+		return rr.Resource
+	}
+	return nil
+}
+
+// domainMappingMetadata returns the metadata of a resource rendered for a mapping.
+func domainMappingMetadata(mapping DomainMappingTarget, name, namespace string) map[string]any {
+	return map[string]any{
+		"name":      name,
+		"namespace": namespace,
+		"labels": map[string]any{
+			labels.LabelKeyDomainMapping: mapping.Name,
+			labels.LabelKeyEndpointName:  mapping.Endpoint,
+		},
+	}
+}
+
+func makeDomainCertificate(mapping DomainMappingTarget, name, namespace string, issuerRef v1alpha1.CertificateIssuerRef) map[string]any {
+	issuerKind := issuerRef.Kind
+	if issuerKind == "" {
+		issuerKind = "ClusterIssuer"
+	}
+	return map[string]any{
+		"apiVersion": certManagerVersion,
+		"kind":       kindCertificate,
+		"metadata":   domainMappingMetadata(mapping, name, namespace),
+		"spec": map[string]any{
+			"secretName": DomainMappingSecretName(mapping.Name),
+			"dnsNames":   []any{mapping.Domain},
+			"issuerRef": map[string]any{
+				"name":  issuerRef.Name,
+				"kind":  issuerKind,
+				"group": "cert-manager.io",
+			},
+		},
+	}
+}
+
+func makeDomainListenerSet(mapping DomainMappingTarget, name, namespace string, gateway *v1alpha1.GatewayEndpointSpec) map[string]any {
+	port := int64(defaultHTTPSPort)
+	if gateway.HTTPS != nil && gateway.HTTPS.Port > 0 {
+		port = int64(gateway.HTTPS.Port)
+	}
+	return map[string]any{
+		"apiVersion": listenerSetVersion,
+		"kind":       kindXListenerSet,
+		"metadata":   domainMappingMetadata(mapping, name, namespace),
+		"spec": map[string]any{
+			"parentRef": map[string]any{
+				"group":     gatewayAPIGroup,
+				"kind":      "Gateway",
+				"name":      gateway.Name,
+				"namespace": gateway.Namespace,
+			},
+			"listeners": []any{
+				map[string]any{
+					"name":     domainListenerName,
+					"hostname": mapping.Domain,
+					"port":     port,
+					"protocol": "HTTPS",
+					"tls": map[string]any{
+						"mode": "Terminate",
+						"certificateRefs": []any{
+							map[string]any{
+								"group": "",
+								"kind":  "Secret",
+								"name":  DomainMappingSecretName(mapping.Name),
+							},
+						},
+					},
+					"allowedRoutes": map[string]any{
+						"namespaces": map[string]any{"from": "Same"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// makeDomainRoute copies the external route of the endpoint so that it serves the domain
+// through the listener set of the mapping.
+func makeDomainRoute(route map[string]any, mapping DomainMappingTarget, name string) map[string]any {
+	domainRoute := runtime.DeepCopyJSON(route)
+	metadata, _ := domainRoute["metadata"].(map[string]any)
+	metadata["name"] = name
+	setStringField(metadata, "labels", labels.LabelKeyDomainMapping, mapping.Name)
+
+	spec, _ := domainRoute["spec"].(map[string]any)
+	if spec == nil {
+		spec = map[string]any{}
+		domainRoute["spec"] = spec
+	}
+	spec["hostnames"] = []any{mapping.Domain}
+	spec["parentRefs"] = []any{
+		map[string]any{
+			"group":       listenerSetAPIGroup,
+			"kind":        kindXListenerSet,
+			"name":        name,
+			"sectionName": domainListenerName,
+		},
+	}
+	return domainRoute
+}
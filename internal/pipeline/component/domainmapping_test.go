@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"reflect"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+const domainMappingRelease = `
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: api
+    namespace: dp-shop
+  spec:
+    ports:
+    - port: 80
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRoute
+  metadata:
+    name: api-external
+    namespace: dp-shop
+    labels:
+      openchoreo.dev/endpoint-name: api
+      openchoreo.dev/endpoint-visibility: external
+  spec:
+    parentRefs:
+    - name: gateway-default
+      namespace: openchoreo-data-plane
+    hostnames:
+    - api-shop.example.com
+    rules:
+    - backendRefs:
+      - name: api
+        port: 80
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRoute
+  metadata:
+    name: api-internal
+    namespace: dp-shop
+    labels:
+      openchoreo.dev/endpoint-name: admin
+      openchoreo.dev/endpoint-visibility: internal
+  spec:
+    hostnames:
+    - admin.internal
+`
+
+func domainMappingResources(t *testing.T) []renderer.RenderedResource {
+	t.Helper()
+	var objs []map[string]any
+	if err := yaml.Unmarshal([]byte(domainMappingRelease), &objs); err != nil {
+		t.Fatalf("failed to parse resources: %v", err)
+	}
+	resources := make([]renderer.RenderedResource, 0, len(objs))
+	for _, obj := range objs {
+		resources = append(resources, renderer.RenderedResource{Resource: obj, TargetPlane: v1alpha1.TargetPlaneDataPlane})
+	}
+	return resources
+}
+
+func TestRenderDomainMappings(t *testing.T) {
+	out := RenderDomainMappings(&DomainMappingInput{
+		Resources: domainMappingResources(t),
+		Mappings: []DomainMappingTarget{
+			{Name: "shop", Endpoint: "api", Domain: "shop.example.com"},
+			{Name: "admin", Endpoint: "admin", Domain: "admin.example.com"},
+		},
+		Gateway: &v1alpha1.GatewayEndpointSpec{
+			Name:      "gateway-default",
+			Namespace: "openchoreo-data-plane",
+			HTTPS:     &v1alpha1.GatewayListenerSpec{Port: 19443},
+		},
+		IssuerRef: v1alpha1.CertificateIssuerRef{Name: "letsencrypt"},
+	})
+
+	// The internal endpoint cannot be served under a custom domain.
+	if !reflect.DeepEqual(out.Served, []string{"shop"}) {
+		t.Fatalf("Served = %v, want [shop]", out.Served)
+	}
+	if len(out.Resources) != 6 {
+		t.Fatalf("got %d resources, want 6", len(out.Resources))
+	}
+
+	name := DomainMappingResourceName("shop")
+	certificate := findRolloutResource(t, out.Resources, "Certificate", name)
+	if got := nestedValue(certificate, "spec", "dnsNames"); !reflect.DeepEqual(got, []any{"shop.example.com"}) {
+		t.Errorf("Certificate dnsNames = %v", got)
+	}
+	if got := nestedValue(certificate, "spec", "issuerRef", "kind"); got != "ClusterIssuer" {
+		t.Errorf("Certificate issuer kind = %v, want ClusterIssuer", got)
+	}
+	if got := nestedValue(certificate, "spec", "secretName"); got != DomainMappingSecretName("shop") {
+		t.Errorf("Certificate secretName = %v", got)
+	}
+
+	listenerSet := findRolloutResource(t, out.Resources, "XListenerSet", name)
+	if got := nestedValue(listenerSet, "spec", "parentRef", "name"); got != "gateway-default" {
+		t.Errorf("XListenerSet parent = %v", got)
+	}
+	listener := nestedValue(listenerSet, "spec", "listeners").([]any)[0].(map[string]any)
+	if listener["hostname"] != "shop.example.com" || listener["port"] != int64(19443) {
+		t.Errorf("listener = %v", listener)
+	}
+
+	route := findRolloutResource(t, out.Resources, "HTTPRoute", name)
+	if got := nestedValue(route, "spec", "hostnames"); !reflect.DeepEqual(got, []any{"shop.example.com"}) {
+		t.Errorf("route hostnames = %v", got)
+	}
+	parent := nestedValue(route, "spec", "parentRefs").([]any)[0].(map[string]any)
+	if parent["kind"] != "XListenerSet" || parent["name"] != name {
+		t.Errorf("route parentRef = %v", parent)
+	}
+	if got := nestedValue(route, "metadata", "labels", labels.LabelKeyDomainMapping); got != "shop" {
+		t.Errorf("route domain-mapping label = %v", got)
+	}
+
+	// The endpoint's own route keeps its hostname.
+	original := findRolloutResource(t, out.Resources, "HTTPRoute", "api-external")
+	if got := nestedValue(original, "spec", "hostnames"); !reflect.DeepEqual(got, []any{"api-shop.example.com"}) {
+		t.Errorf("original route hostnames = %v", got)
+	}
+}
+
+func TestRenderDomainMappingsWithoutGateway(t *testing.T) {
+	resources := domainMappingResources(t)
+	out := RenderDomainMappings(&DomainMappingInput{
+		Resources: resources,
+		Mappings:  []DomainMappingTarget{{Name: "shop", Endpoint: "api", Domain: "shop.example.com"}},
+	})
+	if len(out.Served) != 0 || len(out.Resources) != len(resources) {
+		t.Errorf("RenderDomainMappings() without a gateway served %v", out.Served)
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package harness renders ComponentTypes and Traits from local YAML files with the
+// component pipeline, so that they can be developed and tested without a cluster.
+//
+// A render test is a YAML file that names the input files (the ComponentType, the
+// Traits, a sample Component and its Workload), the environment to render for and
+// the environment configs a ReleaseBinding would carry. The rendered resources are
+// checked against the expected kinds and names and, optionally, a golden file:
+//
+//	inputs:
+//	  - ../componenttype.yaml
+//	  - ../samples/component.yaml
+//	  - ../samples/workload.yaml
+//	environment: development
+//	componentTypeEnvironmentConfigs:
+//	  replicas: 2
+//	expect:
+//	  - kind: Deployment
+//	  - kind: Service
+//	golden: default.golden.yaml
+//
+// The environment, data plane and metadata are synthesized the same way the
+// ReleaseBinding controller builds them, with fixed UIDs so that the rendered output
+// is stable.
+package harness
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+const (
+	// DefaultEnvironment is the environment rendered for when a test does not name one
+	DefaultEnvironment = "development"
+
+	defaultNamespace = "default"
+	defaultDataPlane = "default"
+
+	componentUID   = "00000000-0000-0000-0000-000000000001"
+	projectUID     = "00000000-0000-0000-0000-000000000002"
+	environmentUID = "00000000-0000-0000-0000-000000000003"
+	dataPlaneUID   = "00000000-0000-0000-0000-000000000004"
+)
+
+// TestCase is a render test loaded from a YAML file
+type TestCase struct {
+	// Name identifies the test in reports. Defaults to the file name without extension.
+	Name string `json:"name,omitempty"`
+
+	// Inputs are the YAML files, relative to the test file, that hold the ComponentType,
+	// the Traits, the Component and its Workload. A file may hold several documents.
+	Inputs []string `json:"inputs"`
+
+	// Environment is the name of the environment rendered for
+	Environment string `json:"environment,omitempty"`
+
+	// ComponentTypeEnvironmentConfigs are the ComponentType environment configs set by the
+	// ReleaseBinding of the environment.
+	ComponentTypeEnvironmentConfigs *runtime.RawExtension `json:"componentTypeEnvironmentConfigs,omitempty"`
+
+	// TraitEnvironmentConfigs are the trait environment configs set by the ReleaseBinding of
+	// the environment, keyed by trait instance name.
+	TraitEnvironmentConfigs map[string]runtime.RawExtension `json:"traitEnvironmentConfigs,omitempty"`
+
+	// Expect lists resources that must be among the rendered ones
+	Expect []ExpectedResource `json:"expect,omitempty"`
+
+	// Golden is the file, relative to the test file, holding the expected rendered output
+	Golden string `json:"golden,omitempty"`
+
+	// path is the file the test was loaded from
+	path string
+}
+
+// ExpectedResource identifies a rendered resource by kind and, optionally, name
+type ExpectedResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name,omitempty"`
+}
+
+// Inputs holds the objects a test renders
+type Inputs struct {
+	ComponentType *openchoreov1alpha1.ComponentType
+	Traits        []openchoreov1alpha1.Trait
+	Component     *openchoreov1alpha1.Component
+	Workload      *openchoreov1alpha1.Workload
+}
+
+// LoadTestCase reads the render test at path
+func LoadTestCase(path string) (*TestCase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read render test: %w", err)
+	}
+	tc := &TestCase{}
+	if err := yaml.UnmarshalStrict(data, tc); err != nil {
+		return nil, fmt.Errorf("failed to parse render test %s: %w", path, err)
+	}
+	if len(tc.Inputs) == 0 {
+		return nil, fmt.Errorf("render test %s has no inputs", path)
+	}
+	if tc.Name == "" {
+		tc.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if tc.Environment == "" {
+		tc.Environment = DefaultEnvironment
+	}
+	tc.path = path
+	return tc, nil
+}
+
+// Path returns the file the test was loaded from
+func (tc *TestCase) Path() string {
+	return tc.path
+}
+
+// GoldenPath returns the path of the golden file, or "" if the test has none
+func (tc *TestCase) GoldenPath() string {
+	if tc.Golden == "" {
+		return ""
+	}
+	return tc.resolve(tc.Golden)
+}
+
+func (tc *TestCase) resolve(rel string) string {
+	if filepath.IsAbs(rel) {
+		return rel
+	}
+	return filepath.Join(filepath.Dir(tc.path), rel)
+}
+
+// LoadInputs reads the input files of the test
+func (tc *TestCase) LoadInputs() (*Inputs, error) {
+	in := &Inputs{}
+	for _, rel := range tc.Inputs {
+		if err := in.addFile(tc.resolve(rel)); err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case in.ComponentType == nil:
+		return nil, errors.New("no ComponentType found in the inputs")
+	case in.Component == nil:
+		return nil, errors.New("no Component found in the inputs")
+	case in.Workload == nil:
+		return nil, errors.New("no Workload found in the inputs")
+	}
+	return in, nil
+}
+
+func (in *Inputs) addFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open input: %w", err)
+	}
+	defer f.Close()
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(f))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		if err := in.addDocument(doc); err != nil {
+			return fmt.Errorf("invalid document in %s: %w", path, err)
+		}
+	}
+}
+
+func (in *Inputs) addDocument(doc []byte) error {
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal(doc, &typeMeta); err != nil {
+		return err
+	}
+	if typeMeta.Kind == "" {
+		// Comment-only documents carry no object.
+		if isEmptyDocument(doc) {
+			return nil
+		}
+		return errors.New("missing kind")
+	}
+
+	switch typeMeta.Kind {
+	case "ComponentType":
+		if in.ComponentType != nil {
+			return errors.New("more than one ComponentType in the inputs")
+		}
+		in.ComponentType = &openchoreov1alpha1.ComponentType{}
+		return yaml.UnmarshalStrict(doc, in.ComponentType)
+	case "Trait":
+		trait := openchoreov1alpha1.Trait{}
+		if err := yaml.UnmarshalStrict(doc, &trait); err != nil {
+			return err
+		}
+		in.Traits = append(in.Traits, trait)
+		return nil
+	case "Component":
+		if in.Component != nil {
+			return errors.New("more than one Component in the inputs")
+		}
+		in.Component = &openchoreov1alpha1.Component{}
+		return yaml.UnmarshalStrict(doc, in.Component)
+	case "Workload":
+		if in.Workload != nil {
+			return errors.New("more than one Workload in the inputs")
+		}
+		in.Workload = &openchoreov1alpha1.Workload{}
+		return yaml.UnmarshalStrict(doc, in.Workload)
+	default:
+		return fmt.Errorf("unsupported kind %q; inputs hold a ComponentType, Traits, a Component and a Workload", typeMeta.Kind)
+	}
+}
+
+func isEmptyDocument(doc []byte) bool {
+	var v any
+	return yaml.Unmarshal(doc, &v) == nil && v == nil
+}
+
+// Render renders the inputs of the test with the component pipeline
+func (tc *TestCase) Render() (*componentpipeline.RenderOutput, error) {
+	in, err := tc.LoadInputs()
+	if err != nil {
+		return nil, err
+	}
+	return componentpipeline.NewPipeline().Render(context.Background(), tc.renderInput(in))
+}
+
+func (tc *TestCase) renderInput(in *Inputs) *componentpipeline.RenderInput {
+	namespaceName := in.Component.Namespace
+	if namespaceName == "" {
+		namespaceName = defaultNamespace
+	}
+	projectName := in.Component.Spec.Owner.ProjectName
+	componentName := in.Component.Name
+
+	environment := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: tc.Environment, Namespace: namespaceName, UID: environmentUID},
+		Spec: openchoreov1alpha1.EnvironmentSpec{
+			DataPlaneRef: &openchoreov1alpha1.DataPlaneRef{
+				Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane,
+				Name: defaultDataPlane,
+			},
+		},
+	}
+	dataPlane := &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: defaultDataPlane, Namespace: namespaceName, UID: dataPlaneUID},
+	}
+	binding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      componentName + "-" + tc.Environment,
+			Namespace: namespaceName,
+		},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner: openchoreov1alpha1.ReleaseBindingOwner{
+				ProjectName:   projectName,
+				ComponentName: componentName,
+			},
+			Environment:                     tc.Environment,
+			ComponentTypeEnvironmentConfigs: tc.ComponentTypeEnvironmentConfigs,
+			TraitEnvironmentConfigs:         tc.TraitEnvironmentConfigs,
+		},
+	}
+
+	podSelectors := map[string]string{
+		labels.LabelKeyNamespaceName:   namespaceName,
+		labels.LabelKeyProjectName:     projectName,
+		labels.LabelKeyComponentName:   componentName,
+		labels.LabelKeyEnvironmentName: tc.Environment,
+		labels.LabelKeyComponentUID:    componentUID,
+		labels.LabelKeyEnvironmentUID:  environmentUID,
+		labels.LabelKeyProjectUID:      projectUID,
+	}
+	standardLabels := make(map[string]string, len(podSelectors))
+	for k, v := range podSelectors {
+		standardLabels[k] = v
+	}
+
+	return &componentpipeline.RenderInput{
+		ComponentType:  in.ComponentType,
+		Component:      in.Component,
+		Traits:         in.Traits,
+		Workload:       in.Workload,
+		Environment:    environment,
+		ReleaseBinding: binding,
+		DataPlane:      dataPlane,
+		Metadata: pipelinecontext.MetadataContext{
+			Name: dpkubernetes.GenerateK8sName(componentName, tc.Environment),
+			Namespace: dpkubernetes.GenerateK8sNameWithLengthLimit(
+				dpkubernetes.MaxNamespaceNameLength,
+				"dp", namespaceName, projectName, tc.Environment,
+			),
+			ComponentNamespace: namespaceName,
+			Labels:             standardLabels,
+			Annotations:        map[string]string{},
+			PodSelectors:       podSelectors,
+			ComponentName:      componentName,
+			ComponentUID:       componentUID,
+			ProjectName:        projectName,
+			ProjectUID:         projectUID,
+			DataPlaneName:      dataPlane.Name,
+			DataPlaneUID:       dataPlaneUID,
+			EnvironmentName:    tc.Environment,
+			EnvironmentUID:     environmentUID,
+		},
+	}
+}
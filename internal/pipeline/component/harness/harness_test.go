@@ -0,0 +1,150 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package harness
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// copyTestdata copies the web-service testdata to a temporary directory
+func copyTestdata(t *testing.T) string {
+	t.Helper()
+	dst := t.TempDir()
+	src := filepath.Join("testdata", "web-service")
+	err := filepath.WalkDir(src, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return os.MkdirAll(filepath.Join(dst, rel), 0750)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dst, rel), data, 0600)
+	})
+	require.NoError(t, err)
+	return dst
+}
+
+func TestRunDir(t *testing.T) {
+	results, err := RunDir(filepath.Join("testdata", "web-service"), false)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "default", results[0].Name)
+	assert.True(t, results[0].Passed(), "failures: %v", results[0].Failures)
+}
+
+func TestRunDir_NoTests(t *testing.T) {
+	_, err := RunDir(t.TempDir(), false)
+	assert.ErrorContains(t, err, "no render tests found")
+}
+
+func TestLoadTestCase_Defaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "smoke.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("inputs: [a.yaml]\n"), 0600))
+
+	tc, err := LoadTestCase(path)
+	require.NoError(t, err)
+	assert.Equal(t, "smoke", tc.Name)
+	assert.Equal(t, DefaultEnvironment, tc.Environment)
+	assert.Empty(t, tc.GoldenPath())
+}
+
+func TestLoadTestCase_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	noInputs := filepath.Join(dir, "no-inputs.yaml")
+	require.NoError(t, os.WriteFile(noInputs, []byte("name: x\n"), 0600))
+	_, err := LoadTestCase(noInputs)
+	assert.ErrorContains(t, err, "has no inputs")
+
+	unknownField := filepath.Join(dir, "unknown.yaml")
+	require.NoError(t, os.WriteFile(unknownField, []byte("inputs: [a.yaml]\nexpected: []\n"), 0600))
+	_, err = LoadTestCase(unknownField)
+	assert.ErrorContains(t, err, "failed to parse render test")
+}
+
+func TestRun_ExpectationFailure(t *testing.T) {
+	tc, err := LoadTestCase(filepath.Join("testdata", "web-service", "tests", "default.yaml"))
+	require.NoError(t, err)
+	tc.Golden = ""
+	tc.Expect = []ExpectedResource{{Kind: "Service"}, {Kind: "Ingress"}, {Kind: "Deployment", Name: "other"}}
+
+	result := Run(tc, false)
+	assert.False(t, result.Passed())
+	assert.Equal(t, []string{
+		"expected a Ingress to be rendered",
+		`expected a Deployment named "other" to be rendered`,
+	}, result.Failures)
+}
+
+func TestRun_GoldenFile(t *testing.T) {
+	dir := copyTestdata(t)
+	testPath := filepath.Join(dir, "tests", "default.yaml")
+	goldenPath := filepath.Join(dir, "tests", "default.golden.yaml")
+
+	// A changed environment config changes the rendered output
+	data, err := os.ReadFile(testPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(testPath, []byte(strings.Replace(string(data), "memory: 512Mi", "memory: 1Gi", 1)), 0600))
+
+	tc, err := LoadTestCase(testPath)
+	require.NoError(t, err)
+	result := Run(tc, false)
+	require.Len(t, result.Failures, 1)
+	assert.Contains(t, result.Failures[0], "rendered output differs from")
+	assert.Contains(t, result.Failures[0], "want:             memory: 512Mi")
+	assert.Contains(t, result.Failures[0], "got:              memory: 1Gi")
+
+	result = Run(tc, true)
+	assert.True(t, result.Passed(), "failures: %v", result.Failures)
+	assert.True(t, result.Updated)
+	assert.True(t, Run(tc, false).Passed())
+
+	require.NoError(t, os.Remove(goldenPath))
+	result = Run(tc, false)
+	require.Len(t, result.Failures, 1)
+	assert.Contains(t, result.Failures[0], "run with --update to create it")
+}
+
+func TestRun_InvalidInputs(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+	write("test.yaml", "inputs: [inputs.yaml]\n")
+
+	tests := []struct {
+		name   string
+		inputs string
+		want   string
+	}{
+		{"unsupported kind", "apiVersion: v1\nkind: ConfigMap\n", `unsupported kind "ConfigMap"`},
+		{"missing kind", "metadata:\n  name: x\n", "missing kind"},
+		{"no component type", "# only a comment\n", "no ComponentType found in the inputs"},
+		{"duplicate component", "kind: ComponentType\n---\nkind: Component\n---\nkind: Component\n", "more than one Component"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			write("inputs.yaml", tt.inputs)
+			tc, err := LoadTestCase(filepath.Join(dir, "test.yaml"))
+			require.NoError(t, err)
+			result := Run(tc, false)
+			require.Len(t, result.Failures, 1)
+			assert.Contains(t, result.Failures[0], tt.want)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package harness
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+const (
+	// TestsDir is the directory of a ComponentType or Trait that holds its render tests
+	TestsDir = "tests"
+
+	goldenSuffix = ".golden.yaml"
+)
+
+// Result is the outcome of a render test
+type Result struct {
+	Name string
+	Path string
+	// Failures describes why the test failed; it is empty when the test passed
+	Failures []string
+	// GoldenPath is the golden file of the test, if it has one
+	GoldenPath string
+	// Updated is set when the golden file was written
+	Updated bool
+}
+
+// Passed reports whether the test passed
+func (r Result) Passed() bool {
+	return len(r.Failures) == 0
+}
+
+func (r *Result) failf(format string, args ...any) {
+	r.Failures = append(r.Failures, fmt.Sprintf(format, args...))
+}
+
+// Run renders the test and checks the output. When update is set, the golden file is
+// rewritten with the rendered output instead of being compared.
+func Run(tc *TestCase, update bool) Result {
+	result := Result{Name: tc.Name, Path: tc.path}
+	output, err := tc.Render()
+	if err != nil {
+		result.failf("render failed: %v", err)
+		return result
+	}
+
+	for _, want := range tc.Expect {
+		if !containsResource(output.Resources, want) {
+			if want.Name != "" {
+				result.failf("expected a %s named %q to be rendered", want.Kind, want.Name)
+			} else {
+				result.failf("expected a %s to be rendered", want.Kind)
+			}
+		}
+	}
+
+	goldenPath := tc.GoldenPath()
+	if goldenPath == "" {
+		return result
+	}
+	result.GoldenPath = goldenPath
+	rendered, err := MarshalResources(output.Resources)
+	if err != nil {
+		result.failf("%v", err)
+		return result
+	}
+	if update {
+		if err := os.WriteFile(goldenPath, rendered, 0600); err != nil {
+			result.failf("failed to write golden file: %v", err)
+			return result
+		}
+		result.Updated = true
+		return result
+	}
+
+	golden, err := os.ReadFile(goldenPath)
+	if errors.Is(err, os.ErrNotExist) {
+		result.failf("golden file %s does not exist; run with --update to create it", goldenPath)
+		return result
+	}
+	if err != nil {
+		result.failf("failed to read golden file: %v", err)
+		return result
+	}
+	if line, want, got, differs := firstDifference(golden, rendered); differs {
+		result.failf("rendered output differs from %s at line %d:\n  want: %s\n  got:  %s\nrun with --update if the change is intended",
+			goldenPath, line, want, got)
+	}
+	return result
+}
+
+// RunDir runs the render tests in the tests directory of dir, in file name order
+func RunDir(dir string, update bool) ([]Result, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, TestsDir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	paths = slices.DeleteFunc(paths, func(p string) bool {
+		return strings.HasSuffix(p, goldenSuffix)
+	})
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no render tests found in %s", filepath.Join(dir, TestsDir))
+	}
+	slices.Sort(paths)
+
+	results := make([]Result, 0, len(paths))
+	for _, path := range paths {
+		tc, err := LoadTestCase(path)
+		if err != nil {
+			results = append(results, Result{Name: filepath.Base(path), Path: path, Failures: []string{err.Error()}})
+			continue
+		}
+		results = append(results, Run(tc, update))
+	}
+	return results, nil
+}
+
+// MarshalResources renders resources as a multi-document YAML stream. Resources for a
+// plane other than the data plane are preceded by a comment naming the plane.
+func MarshalResources(resources []renderer.RenderedResource) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, r := range resources {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		if r.TargetPlane != "" && r.TargetPlane != openchoreov1alpha1.TargetPlaneDataPlane {
+			fmt.Fprintf(&buf, "# targetPlane: %s\n", r.TargetPlane)
+		}
+		out, err := yaml.Marshal(r.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal rendered resource: %w", err)
+		}
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
+
+func containsResource(resources []renderer.RenderedResource, want ExpectedResource) bool {
+	for _, r := range resources {
+		obj := unstructured.Unstructured{Object: r.Resource}
+		if obj.GetKind() == want.Kind && (want.Name == "" || obj.GetName() == want.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstDifference returns the first line, 1-based, at which want and got differ
+func firstDifference(want, got []byte) (int, string, string, bool) {
+	if bytes.Equal(want, got) {
+		return 0, "", "", false
+	}
+	wantLines := strings.Split(string(want), "\n")
+	gotLines := strings.Split(string(got), "\n")
+	for i := 0; ; i++ {
+		w, g := "<end of file>", "<end of file>"
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return i + 1, w, g, true
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// defaultHookTimeoutSeconds is used when a lifecycle hook sets no timeout.
+const defaultHookTimeoutSeconds = 600
+
+// RenderedHook is a lifecycle hook of the ComponentType rendered for a release.
+type RenderedHook struct {
+	// Name is the name of the hook in the ComponentType.
+	Name string
+
+	// Phase is when the hook runs.
+	Phase v1alpha1.LifecycleHookPhase
+
+	// FailurePolicy decides how a failed hook affects the rollout.
+	FailurePolicy v1alpha1.LifecycleHookFailurePolicy
+
+	// Resource is the rendered batch/v1 Job. Its name is the template's; the release
+	// controller makes it unique per rollout.
+	Resource map[string]any
+}
+
+// renderHooks renders the lifecycle hooks of the ComponentType with the component context.
+// Hooks whose includeWhen evaluates to false are skipped.
+func (p *Pipeline) renderHooks(hooks []v1alpha1.LifecycleHook, componentContextMap map[string]any, input *RenderInput) ([]RenderedHook, error) {
+	if len(hooks) == 0 {
+		return nil, nil
+	}
+
+	resourceRenderer := renderer.NewRenderer(p.templateEngine)
+	rendered := make([]RenderedHook, 0, len(hooks))
+	for _, hook := range hooks {
+		resources, err := resourceRenderer.RenderResources([]v1alpha1.ResourceTemplate{{
+			ID:          hook.Name,
+			IncludeWhen: hook.IncludeWhen,
+			Template:    hook.Template,
+		}}, componentContextMap)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render hook %s: %w", hook.Name, err)
+		}
+		if len(resources) == 0 {
+			continue
+		}
+
+		job := resources[0].Resource
+		if err := normalizeHookJob(job, hook); err != nil {
+			return nil, fmt.Errorf("hook %s: %w", hook.Name, err)
+		}
+		if err := addLabels(job, input.Metadata.Labels); err != nil {
+			return nil, fmt.Errorf("failed to add labels to hook %s: %w", hook.Name, err)
+		}
+		if err := addAnnotations(job, input.Metadata.Annotations); err != nil {
+			return nil, fmt.Errorf("failed to add annotations to hook %s: %w", hook.Name, err)
+		}
+
+		failurePolicy := hook.FailurePolicy
+		if failurePolicy == "" {
+			failurePolicy = v1alpha1.LifecycleHookFailurePolicyFail
+		}
+		rendered = append(rendered, RenderedHook{
+			Name:          hook.Name,
+			Phase:         hook.Phase,
+			FailurePolicy: failurePolicy,
+			Resource:      job,
+		})
+	}
+	return rendered, nil
+}
+
+// normalizeHookJob checks that a rendered hook is a named batch/v1 Job, bounds its run time
+// by the hook timeout and removes ttlSecondsAfterFinished: the Job stays in the release for
+// the rollout it ran for, and a Job deleted by its TTL would be created and run again.
+func normalizeHookJob(job map[string]any, hook v1alpha1.LifecycleHook) error {
+	if apiVersion, _ := job["apiVersion"].(string); apiVersion != "batch/v1" {
+		return fmt.Errorf("template must be a batch/v1 Job, got apiVersion %q", apiVersion)
+	}
+	if kind, _ := job["kind"].(string); kind != "Job" {
+		return fmt.Errorf("template must be a batch/v1 Job, got kind %q", kind)
+	}
+	metadata, ok := job["metadata"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("template is missing metadata")
+	}
+	if name, _ := metadata["name"].(string); name == "" {
+		return fmt.Errorf("template is missing metadata.name")
+	}
+	spec, ok := job["spec"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("template is missing spec")
+	}
+
+	if _, set := spec["activeDeadlineSeconds"]; !set {
+		timeout := hook.TimeoutSeconds
+		if timeout <= 0 {
+			timeout = defaultHookTimeoutSeconds
+		}
+		spec["activeDeadlineSeconds"] = timeout
+	}
+	delete(spec, "ttlSecondsAfterFinished")
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestRender_Hooks(t *testing.T) {
+	var componentType v1alpha1.ComponentType
+	if err := yaml.Unmarshal([]byte(`
+spec:
+  resources:
+    - id: deployment
+      template:
+        apiVersion: apps/v1
+        kind: Deployment
+        metadata:
+          name: app
+  hooks:
+    - name: migrate
+      phase: PreDeploy
+      template:
+        apiVersion: batch/v1
+        kind: Job
+        metadata:
+          name: ${metadata.name}-migrate
+        spec:
+          ttlSecondsAfterFinished: 60
+          template:
+            spec:
+              restartPolicy: Never
+              containers:
+                - name: migrate
+                  image: migrate:latest
+    - name: warmup
+      phase: PostDeploy
+      includeWhen: ${false}
+      template:
+        apiVersion: batch/v1
+        kind: Job
+        metadata:
+          name: warmup
+`), &componentType); err != nil {
+		t.Fatalf("Failed to parse componentType: %v", err)
+	}
+	metadata := postRenderTestMetadata()
+	metadata.Labels = map[string]string{"openchoreo.dev/component": "app"}
+	input := &RenderInput{
+		ComponentType: &componentType,
+		Component:     &v1alpha1.Component{},
+		Workload:      &v1alpha1.Workload{},
+		Environment:   &v1alpha1.Environment{},
+		DataPlane:     &v1alpha1.DataPlane{},
+		Metadata:      metadata,
+	}
+
+	output, err := NewPipeline().Render(t.Context(), input)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if len(output.Resources) != 1 {
+		t.Fatalf("hooks must not be rendered as resources, got %d resources", len(output.Resources))
+	}
+	if len(output.Hooks) != 1 {
+		t.Fatalf("expected only the included hook, got %d", len(output.Hooks))
+	}
+
+	hook := output.Hooks[0]
+	if hook.Name != "migrate" || hook.Phase != v1alpha1.LifecycleHookPhasePreDeploy ||
+		hook.FailurePolicy != v1alpha1.LifecycleHookFailurePolicyFail {
+		t.Errorf("unexpected hook: %+v", hook)
+	}
+	jobMeta := hook.Resource["metadata"].(map[string]any)
+	if jobMeta["name"] != "test-migrate" {
+		t.Errorf("metadata.name = %v, want the rendered template name", jobMeta["name"])
+	}
+	if labels, _ := jobMeta["labels"].(map[string]any); labels["openchoreo.dev/component"] != "app" {
+		t.Errorf("expected the release labels on the hook Job, got %v", jobMeta["labels"])
+	}
+	spec := hook.Resource["spec"].(map[string]any)
+	if spec["activeDeadlineSeconds"] != int64(defaultHookTimeoutSeconds) {
+		t.Errorf("activeDeadlineSeconds = %v, want the default timeout", spec["activeDeadlineSeconds"])
+	}
+	if _, ok := spec["ttlSecondsAfterFinished"]; ok {
+		t.Errorf("ttlSecondsAfterFinished should be removed")
+	}
+}
+
+func TestRender_HookMustBeJob(t *testing.T) {
+	var componentType v1alpha1.ComponentType
+	if err := yaml.Unmarshal([]byte(`
+spec:
+  resources:
+    - id: deployment
+      template:
+        apiVersion: apps/v1
+        kind: Deployment
+        metadata:
+          name: app
+  hooks:
+    - name: migrate
+      phase: PreDeploy
+      template:
+        apiVersion: v1
+        kind: Pod
+        metadata:
+          name: migrate
+`), &componentType); err != nil {
+		t.Fatalf("Failed to parse componentType: %v", err)
+	}
+	input := &RenderInput{
+		ComponentType: &componentType,
+		Component:     &v1alpha1.Component{},
+		Workload:      &v1alpha1.Workload{},
+		Environment:   &v1alpha1.Environment{},
+		DataPlane:     &v1alpha1.DataPlane{},
+		Metadata:      postRenderTestMetadata(),
+	}
+
+	if _, err := NewPipeline().Render(t.Context(), input); err == nil || !strings.Contains(err.Error(), "batch/v1 Job") {
+		t.Fatalf("expected a batch/v1 Job error, got %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// Default render limits. They are generous enough for any reasonable ComponentType and
+// stop a malformed template, e.g. a forEach over an unbounded list, before the release
+// reaches a data plane.
+const (
+	DefaultMaxResources = 1000
+	// DefaultMaxRenderedBytes stays below the ~1.5MiB etcd object size limit, above which
+	// the rendered release could not be stored anyway.
+	DefaultMaxRenderedBytes             = 1536 * 1024
+	DefaultMaxCustomResourceDefinitions = 10
+)
+
+// Limit names reported by LimitExceededError.
+const (
+	LimitResources                 = "resources"
+	LimitRenderedBytes             = "renderedBytes"
+	LimitCustomResourceDefinitions = "customResourceDefinitions"
+)
+
+const kindCustomResourceDefinition = "CustomResourceDefinition"
+
+// Limits bound the size of a rendered release. A zero value disables the corresponding limit.
+type Limits struct {
+	// MaxResources is the maximum number of resources in a rendered release.
+	MaxResources int
+	// MaxRenderedBytes is the maximum total JSON size of the rendered resources.
+	MaxRenderedBytes int
+	// MaxCustomResourceDefinitions is the maximum number of CustomResourceDefinitions a
+	// rendered release may create.
+	MaxCustomResourceDefinitions int
+}
+
+// DefaultLimits returns the limits used by NewPipeline unless WithLimits is given.
+func DefaultLimits() Limits {
+	return Limits{
+		MaxResources:                 DefaultMaxResources,
+		MaxRenderedBytes:             DefaultMaxRenderedBytes,
+		MaxCustomResourceDefinitions: DefaultMaxCustomResourceDefinitions,
+	}
+}
+
+// WithLimits sets the render limits of the pipeline.
+func WithLimits(limits Limits) Option {
+	return func(p *Pipeline) {
+		p.limits = limits
+	}
+}
+
+// LimitExceededError is returned by Render when the rendered release exceeds a limit.
+type LimitExceededError struct {
+	// Limit is the name of the exceeded limit, e.g. LimitResources.
+	Limit string
+	// Actual is the rendered value and Max the configured limit.
+	Actual int
+	Max    int
+}
+
+func (e *LimitExceededError) Error() string {
+	switch e.Limit {
+	case LimitRenderedBytes:
+		return fmt.Sprintf("rendered release is %d bytes, exceeding the limit of %d bytes", e.Actual, e.Max)
+	case LimitCustomResourceDefinitions:
+		return fmt.Sprintf("rendered release creates %d CustomResourceDefinitions, exceeding the limit of %d", e.Actual, e.Max)
+	default:
+		return fmt.Sprintf("rendered release has %d resources, exceeding the limit of %d", e.Actual, e.Max)
+	}
+}
+
+// checkResourceCount enforces MaxResources. It is cheap and runs after every rendering
+// step, so that a runaway template fails before the remaining steps process its output.
+func (l Limits) checkResourceCount(resources []renderer.RenderedResource) error {
+	if l.MaxResources > 0 && len(resources) > l.MaxResources {
+		return &LimitExceededError{Limit: LimitResources, Actual: len(resources), Max: l.MaxResources}
+	}
+	return nil
+}
+
+// check enforces all limits on the final resource set and returns its total JSON size.
+func (l Limits) check(resources []renderer.RenderedResource) (int, error) {
+	if err := l.checkResourceCount(resources); err != nil {
+		return 0, err
+	}
+
+	crds := 0
+	size := 0
+	for _, rr := range resources {
+		if kind, _ := rr.Resource["kind"].(string); kind == kindCustomResourceDefinition {
+			crds++
+		}
+		b, err := json.Marshal(rr.Resource)
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal rendered resource: %w", err)
+		}
+		size += len(b)
+	}
+
+	if l.MaxCustomResourceDefinitions > 0 && crds > l.MaxCustomResourceDefinitions {
+		return size, &LimitExceededError{Limit: LimitCustomResourceDefinitions, Actual: crds, Max: l.MaxCustomResourceDefinitions}
+	}
+	if l.MaxRenderedBytes > 0 && size > l.MaxRenderedBytes {
+		return size, &LimitExceededError{Limit: LimitRenderedBytes, Actual: size, Max: l.MaxRenderedBytes}
+	}
+	return size, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"errors"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// renderWithLimits renders the given ComponentType with the given render limits.
+func renderWithLimits(t *testing.T, limits Limits, componentTypeYAML string) (*RenderOutput, error) {
+	t.Helper()
+	var componentType v1alpha1.ComponentType
+	if err := yaml.Unmarshal([]byte(componentTypeYAML), &componentType); err != nil {
+		t.Fatalf("Failed to parse componentType: %v", err)
+	}
+	input := &RenderInput{
+		ComponentType: &componentType,
+		Component:     &v1alpha1.Component{},
+		Workload:      &v1alpha1.Workload{},
+		Environment:   &v1alpha1.Environment{},
+		DataPlane:     &v1alpha1.DataPlane{},
+		Metadata:      postRenderTestMetadata(),
+	}
+	return NewPipeline(WithLimits(limits)).Render(t.Context(), input)
+}
+
+const limitsComponentTypeYAML = `
+spec:
+  resources:
+    - id: configs
+      forEach: ${["a", "b", "c", "d"]}
+      var: name
+      template:
+        apiVersion: v1
+        kind: ConfigMap
+        metadata:
+          name: ${name}
+        data:
+          key: value
+    - id: crds
+      forEach: ${["one", "two"]}
+      var: name
+      template:
+        apiVersion: apiextensions.k8s.io/v1
+        kind: CustomResourceDefinition
+        metadata:
+          name: ${name + ".example.com"}
+`
+
+func TestRender_Limits(t *testing.T) {
+	tests := []struct {
+		name      string
+		limits    Limits
+		wantLimit string
+	}{
+		{
+			name:   "within limits",
+			limits: Limits{MaxResources: 6, MaxRenderedBytes: 1 << 20, MaxCustomResourceDefinitions: 2},
+		},
+		{
+			name:   "zero disables limits",
+			limits: Limits{},
+		},
+		{
+			name:      "too many resources",
+			limits:    Limits{MaxResources: 5},
+			wantLimit: LimitResources,
+		},
+		{
+			name:      "too many CRDs",
+			limits:    Limits{MaxCustomResourceDefinitions: 1},
+			wantLimit: LimitCustomResourceDefinitions,
+		},
+		{
+			name:      "too many bytes",
+			limits:    Limits{MaxRenderedBytes: 100},
+			wantLimit: LimitRenderedBytes,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := renderWithLimits(t, tt.limits, limitsComponentTypeYAML)
+			if tt.wantLimit == "" {
+				if err != nil {
+					t.Fatalf("Render() error = %v", err)
+				}
+				if out.Metadata.ResourceCount != 6 {
+					t.Errorf("ResourceCount = %d, want 6", out.Metadata.ResourceCount)
+				}
+				if out.Metadata.RenderedBytes <= 0 {
+					t.Errorf("RenderedBytes = %d, want > 0", out.Metadata.RenderedBytes)
+				}
+				return
+			}
+			limitErr, ok := errors.AsType[*LimitExceededError](err)
+			if !ok {
+				t.Fatalf("Render() error = %v, want LimitExceededError", err)
+			}
+			if limitErr.Limit != tt.wantLimit {
+				t.Errorf("Limit = %q, want %q", limitErr.Limit, tt.wantLimit)
+			}
+			if limitErr.Actual <= limitErr.Max {
+				t.Errorf("Actual = %d, want more than Max = %d", limitErr.Actual, limitErr.Max)
+			}
+		})
+	}
+}
+
+func TestNewPipeline_DefaultLimits(t *testing.T) {
+	if got := NewPipeline().limits; got != DefaultLimits() {
+		t.Errorf("limits = %+v, want %+v", got, DefaultLimits())
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"maps"
+	"net/url"
+	"strconv"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+const (
+	kindDirectResponse     = "DirectResponse"
+	kgatewayAPIGroup       = "gateway.kgateway.dev"
+	kgatewayVersion        = kgatewayAPIGroup + "/v1alpha1"
+	maintenanceResourceTag = "maintenance"
+)
+
+// MaintenanceResourceName returns the name of the DirectResponse rendered for a route of a
+// component in maintenance.
+func MaintenanceResourceName(routeName string) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxResourceNameLength, routeName, maintenanceResourceTag)
+}
+
+// RenderMaintenance rewrites the HTTPRoutes of the rendered resources so that the gateway
+// answers every request itself while the component is in maintenance.
+//
+// The backendRefs and filters of every rule are replaced. With a redirect URL, the rule gets
+// a RequestRedirect filter to the URL; its query string is dropped because Gateway API cannot
+// express it. Otherwise, the rule gets an ExtensionRef filter to a kgateway DirectResponse
+// rendered next to the route with the status and body of the maintenance. The matches of the
+// rules, and all other resources, are left unchanged so that the workload keeps running.
+// GRPCRoutes are left unchanged too, since gRPC clients cannot use either response.
+func RenderMaintenance(resources []renderer.RenderedResource, maintenance *v1alpha1.MaintenanceMode) []renderer.RenderedResource {
+	if maintenance == nil {
+		return resources
+	}
+	out := make([]renderer.RenderedResource, 0, len(resources))
+	for _, rr := range resources {
+		out = append(out, rr)
+		if rr.TargetPlane != v1alpha1.TargetPlaneDataPlane || rr.Resource["kind"] != kindHTTPRoute || !isTrafficRoute(rr.Resource) {
+			continue
+		}
+
+		var filter map[string]any
+		if maintenance.RedirectURL != "" {
+			filter = maintenanceRedirectFilter(maintenance)
+		} else {
+			directResponse := makeDirectResponse(rr.Resource, maintenance)
+			filter = map[string]any{
+				"type": "ExtensionRef",
+				"extensionRef": map[string]any{
+					"group": kgatewayAPIGroup,
+					"kind":  kindDirectResponse,
+					"name":  resourceName(directResponse),
+				},
+			}
+			out = append(out, renderer.RenderedResource{
+				Resource:    directResponse,
+				TargetPlane: v1alpha1.TargetPlaneDataPlane,
+			})
+		}
+
+		spec, _ := rr.Resource["spec"].(map[string]any)
+		if rules, _ := spec["rules"].([]any); len(rules) == 0 && spec != nil {
+			spec["rules"] = []any{map[string]any{}}
+		}
+		forEachRule(rr.Resource, func(rule map[string]any) {
+			delete(rule, "backendRefs")
+			rule["filters"] = []any{maps.Clone(filter)}
+		})
+	}
+
+	sortRenderedResources(out)
+	return out
+}
+
+// maintenanceRedirectFilter returns a RequestRedirect filter to the redirect URL of the
+// maintenance.
+func maintenanceRedirectFilter(maintenance *v1alpha1.MaintenanceMode) map[string]any {
+	redirect := map[string]any{
+		"statusCode": int64(maintenance.ResponseStatusCode()),
+	}
+	if target, err := url.Parse(maintenance.RedirectURL); err == nil {
+		if target.Scheme != "" {
+			redirect["scheme"] = target.Scheme
+		}
+		if host := target.Hostname(); host != "" {
+			redirect["hostname"] = host
+		}
+		if port, err := strconv.ParseInt(target.Port(), 10, 32); err == nil {
+			redirect["port"] = port
+		}
+		if target.Path != "" {
+			redirect["path"] = map[string]any{
+				"type":            "ReplaceFullPath",
+				"replaceFullPath": target.Path,
+			}
+		}
+	}
+	return map[string]any{
+		"type":            "RequestRedirect",
+		"requestRedirect": redirect,
+	}
+}
+
+// makeDirectResponse returns the DirectResponse that answers the requests of a route.
+func makeDirectResponse(route map[string]any, maintenance *v1alpha1.MaintenanceMode) map[string]any {
+	metadata, _ := route["metadata"].(map[string]any)
+	namespace, _ := metadata["namespace"].(string)
+	responseMetadata := map[string]any{
+		"name":      MaintenanceResourceName(resourceName(route)),
+		"namespace": namespace,
+	}
+	if routeLabels, ok := metadata["labels"].(map[string]any); ok {
+		responseMetadata["labels"] = maps.Clone(routeLabels)
+	}
+
+	spec := map[string]any{
+		"status": int64(maintenance.ResponseStatusCode()),
+	}
+	if maintenance.Body != "" {
+		spec["body"] = maintenance.Body
+	}
+	return map[string]any{
+		"apiVersion": kgatewayVersion,
+		"kind":       kindDirectResponse,
+		"metadata":   responseMetadata,
+		"spec":       spec,
+	}
+}
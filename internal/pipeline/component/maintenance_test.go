@@ -0,0 +1,100 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestRenderMaintenance_DirectResponse(t *testing.T) {
+	out := RenderMaintenance(domainMappingResources(t), &v1alpha1.MaintenanceMode{Body: "Back soon"})
+
+	// One DirectResponse per HTTPRoute.
+	if len(out) != 5 {
+		t.Fatalf("got %d resources, want 5", len(out))
+	}
+
+	name := MaintenanceResourceName("api-external")
+	response := findRolloutResource(t, out, "DirectResponse", name)
+	if got := nestedValue(response, "spec", "status"); got != int64(503) {
+		t.Errorf("DirectResponse status = %v, want 503", got)
+	}
+	if got := nestedValue(response, "spec", "body"); got != "Back soon" {
+		t.Errorf("DirectResponse body = %v", got)
+	}
+	if got := nestedValue(response, "metadata", "namespace"); got != "dp-shop" {
+		t.Errorf("DirectResponse namespace = %v", got)
+	}
+
+	route := findRolloutResource(t, out, "HTTPRoute", "api-external")
+	rule := nestedValue(route, "spec", "rules").([]any)[0].(map[string]any)
+	if _, ok := rule["backendRefs"]; ok {
+		t.Errorf("rule still has backendRefs: %v", rule)
+	}
+	want := []any{map[string]any{
+		"type": "ExtensionRef",
+		"extensionRef": map[string]any{
+			"group": "gateway.kgateway.dev",
+			"kind":  "DirectResponse",
+			"name":  name,
+		},
+	}}
+	if !reflect.DeepEqual(rule["filters"], want) {
+		t.Errorf("rule filters = %v", rule["filters"])
+	}
+
+	// A route without rules gets one that matches every request.
+	internal := findRolloutResource(t, out, "HTTPRoute", "api-internal")
+	if rules := nestedValue(internal, "spec", "rules").([]any); len(rules) != 1 {
+		t.Errorf("internal route rules = %v", rules)
+	}
+
+	// The Service of the workload is kept.
+	findRolloutResource(t, out, "Service", "api")
+}
+
+func TestRenderMaintenance_Redirect(t *testing.T) {
+	out := RenderMaintenance(domainMappingResources(t), &v1alpha1.MaintenanceMode{
+		RedirectURL: "https://status.example.com:8443/shop?from=api",
+	})
+
+	if len(out) != 3 {
+		t.Fatalf("got %d resources, want 3", len(out))
+	}
+	route := findRolloutResource(t, out, "HTTPRoute", "api-external")
+	rule := nestedValue(route, "spec", "rules").([]any)[0].(map[string]any)
+	want := []any{map[string]any{
+		"type": "RequestRedirect",
+		"requestRedirect": map[string]any{
+			"statusCode": int64(302),
+			"scheme":     "https",
+			"hostname":   "status.example.com",
+			"port":       int64(8443),
+			"path": map[string]any{
+				"type":            "ReplaceFullPath",
+				"replaceFullPath": "/shop",
+			},
+		},
+	}}
+	if !reflect.DeepEqual(rule["filters"], want) {
+		t.Errorf("rule filters = %v", rule["filters"])
+	}
+}
+
+func TestRenderMaintenance_Disabled(t *testing.T) {
+	resources := domainMappingResources(t)
+	out := RenderMaintenance(resources, nil)
+
+	route := findRolloutResource(t, out, "HTTPRoute", "api-external")
+	rule := nestedValue(route, "spec", "rules").([]any)[0].(map[string]any)
+	if _, ok := rule["backendRefs"]; !ok {
+		t.Errorf("rule lost its backendRefs: %v", rule)
+	}
+	if len(out) != len(resources) {
+		t.Errorf("got %d resources, want %d", len(out), len(resources))
+	}
+}
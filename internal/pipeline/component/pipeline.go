@@ -8,11 +8,13 @@
 //   - Building CEL evaluation contexts with parameters, overrides, and defaults
 //   - Rendering base resources from ComponentType
 //   - Processing traits (creates and patches)
+//   - Running custom render stages registered by operators
 //   - Post-processing (validation, labels, annotations)
 package component
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -21,7 +23,7 @@ import (
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/labels"
-	"github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/trait"
 	"github.com/openchoreo/openchoreo/internal/template"
@@ -47,13 +49,13 @@ type Option func(*Pipeline)
 
 // NewPipeline creates a new component rendering pipeline.
 func NewPipeline(opts ...Option) *Pipeline {
-	p := &Pipeline{}
+	p := &Pipeline{limits: DefaultLimits(), stages: defaultStages()}
 	for _, opt := range opts {
 		opt(p)
 	}
 	if p.templateEngine == nil {
 		p.templateEngine = template.NewEngineWithOptions(
-			template.WithCELExtensions(context.CELExtensions()...),
+			template.WithCELExtensions(pipelinecontext.CELExtensions()...),
 		)
 	}
 	return p
@@ -66,11 +68,15 @@ func NewPipeline(opts ...Option) *Pipeline {
 //   - Build component context (parameters + overrides + defaults)
 //   - Render base resources from ComponentType
 //   - Process traits (creates and patches)
+//   - Run the custom render stages
 //   - Post-process (validate, add labels/annotations, sort)
+//   - Enforce the render limits
 //   - Return output
 //
-// Returns an error if any step fails.
-func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
+// ctx is passed to the custom render stages, so a stage that calls out to a
+// webhook is cancelled with the caller. Returns an error if any step fails, and
+// a *LimitExceededError if the rendered release exceeds the pipeline's limits.
+func (p *Pipeline) Render(ctx context.Context, input *RenderInput) (*RenderOutput, error) {
 	// Validate input
 	if err := p.validateInput(input); err != nil {
 		return nil, fmt.Errorf("invalid input: %w", err)
@@ -83,13 +89,13 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	// Apply workload overrides from ReleaseBinding if present
 	workload := input.Workload
 	if input.Workload != nil && input.ReleaseBinding != nil && input.ReleaseBinding.Spec.WorkloadOverrides != nil {
-		workload = context.MergeWorkloadOverrides(input.Workload, input.ReleaseBinding.Spec.WorkloadOverrides)
+		workload = pipelinecontext.MergeWorkloadOverrides(input.Workload, input.ReleaseBinding.Spec.WorkloadOverrides)
 	}
 
 	// Pre-compute workload data and configurations once and share across all contexts
-	workloadData := context.ExtractWorkloadData(workload)
-	configurations := context.ExtractConfigurationsFromWorkload(input.SecretReferences, workload)
-	dependenciesData := context.ConnectionsData{
+	workloadData := pipelinecontext.ExtractWorkloadData(workload)
+	configurations := pipelinecontext.ExtractConfigurationsFromWorkload(input.SecretReferences, workload)
+	dependenciesData := pipelinecontext.ConnectionsData{
 		Items:     input.DependencyItems,
 		Resources: input.ResourceDependencyItems,
 	}
@@ -97,14 +103,14 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	// Endpoint API schema parsing is opt-in: only extract resources when a template
 	// references the workload.toEndpointResources() macro. This keeps large schemas
 	// out of the render context (and skips parsing) for the common case.
-	var endpointResources context.EndpointResourceMap
+	var endpointResources pipelinecontext.EndpointResourceMap
 	if usesEndpointResources(input) {
-		endpointResources = context.ExtractEndpointResources(workload)
+		endpointResources = pipelinecontext.ExtractEndpointResources(workload)
 	}
 
 	// Build the trait context base once and reuse it for every trait built in this render.
 	// Both regular and embedded trait inputs embed TraitContextBase.
-	traitBase := context.TraitContextBase{
+	traitBase := pipelinecontext.TraitContextBase{
 		Metadata:                   input.Metadata,
 		DataPlane:                  input.DataPlane,
 		Environment:                input.Environment,
@@ -116,7 +122,7 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	}
 
 	// Build component context
-	componentContext, err := context.BuildComponentContext(&context.ComponentContextInput{
+	componentContext, err := pipelinecontext.BuildComponentContext(&pipelinecontext.ComponentContextInput{
 		Component:                  input.Component,
 		ComponentType:              input.ComponentType,
 		ReleaseBinding:             input.ReleaseBinding,
@@ -162,6 +168,9 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to render base resources: %w", err)
 	}
+	if err := p.limits.checkResourceCount(renderedResources); err != nil {
+		return nil, err
+	}
 	metadata.BaseResourceCount = len(renderedResources)
 
 	// Process traits
@@ -179,7 +188,7 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	}
 
 	// Create schema cache for trait reuse within this render
-	schemaCache := make(map[string]*context.SchemaBundle)
+	schemaCache := make(map[string]*pipelinecontext.SchemaBundle)
 
 	// Process embedded traits from ComponentType (before component-level traits)
 	for _, embeddedTrait := range input.ComponentType.Spec.Traits {
@@ -193,7 +202,7 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 		}
 
 		// Resolve CEL bindings against component context
-		resolvedParams, resolvedEnvironmentConfigs, err := context.ResolveEmbeddedTraitBindings(
+		resolvedParams, resolvedEnvironmentConfigs, err := pipelinecontext.ResolveEmbeddedTraitBindings(
 			p.templateEngine,
 			embeddedTrait,
 			componentContextMap,
@@ -204,7 +213,7 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 		}
 
 		// Build embedded trait context
-		traitContext, err := context.BuildTraitContext(&context.TraitContextInput{
+		traitContext, err := pipelinecontext.BuildTraitContext(&pipelinecontext.TraitContextInput{
 			TraitContextBase:           traitBase,
 			Trait:                      t,
 			InstanceName:               embeddedTrait.InstanceName,
@@ -229,6 +238,9 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 			return nil, fmt.Errorf("failed to process embedded trait %s/%s: %w",
 				embeddedTrait.Name, embeddedTrait.InstanceName, err)
 		}
+		if err := p.limits.checkResourceCount(renderedResources); err != nil {
+			return nil, err
+		}
 
 		if len(t.Spec.PostRenderValidations) > 0 {
 			pendingPostRenders = append(pendingPostRenders, pendingPostRender{
@@ -254,14 +266,14 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 		}
 
 		// Resolve the component-level trait's instance bindings (just JSON deserialization)
-		resolvedParams, resolvedEnvironmentConfigs, err := context.ExtractTraitInstanceBindings(traitInstance, input.ReleaseBinding)
+		resolvedParams, resolvedEnvironmentConfigs, err := pipelinecontext.ExtractTraitInstanceBindings(traitInstance, input.ReleaseBinding)
 		if err != nil {
 			return nil, fmt.Errorf("failed to extract trait bindings for %s/%s: %w",
 				traitInstance.Name, traitInstance.InstanceName, err)
 		}
 
 		// Build trait context (BuildTraitContext will handle schema caching)
-		traitContext, err := context.BuildTraitContext(&context.TraitContextInput{
+		traitContext, err := pipelinecontext.BuildTraitContext(&pipelinecontext.TraitContextInput{
 			TraitContextBase:           traitBase,
 			Trait:                      t,
 			InstanceName:               traitInstance.InstanceName,
@@ -286,6 +298,9 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 			return nil, fmt.Errorf("failed to process trait %s/%s: %w",
 				traitInstance.Name, traitInstance.InstanceName, err)
 		}
+		if err := p.limits.checkResourceCount(renderedResources); err != nil {
+			return nil, err
+		}
 
 		if len(t.Spec.PostRenderValidations) > 0 {
 			pendingPostRenders = append(pendingPostRenders, pendingPostRender{
@@ -305,6 +320,13 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 		return nil, fmt.Errorf("post-render validation failed: %w", err)
 	}
 
+	// Custom stages see the final resource set and their output is post-processed and
+	// validated like the resources rendered by the pipeline.
+	renderedResources, err = p.runStages(ctx, renderedResources, input, metadata)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := p.postProcessResources(renderedResources, input); err != nil {
 		return nil, fmt.Errorf("failed to post-process resources: %w", err)
 	}
@@ -313,13 +335,31 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	renderedBytes, err := p.limits.check(renderedResources)
+	if err != nil {
+		return nil, err
+	}
+
 	sortRenderedResources(renderedResources)
 
 	metadata.ResourceCount = len(renderedResources)
+	metadata.RenderedBytes = renderedBytes
+
+	hooks, err := p.renderHooks(input.ComponentType.Spec.Hooks, componentContextMap, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render lifecycle hooks: %w", err)
+	}
+
+	workloadIdentity, err := p.renderWorkloadIdentity(input.ComponentType.Spec.WorkloadIdentity, componentContextMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render workload identity: %w", err)
+	}
 
 	return &RenderOutput{
-		Resources: renderedResources,
-		Metadata:  metadata,
+		Resources:        renderedResources,
+		Hooks:            hooks,
+		WorkloadIdentity: workloadIdentity,
+		Metadata:         metadata,
 	}, nil
 }
 
@@ -391,8 +431,14 @@ func (p *Pipeline) postProcessResources(resources []renderer.RenderedResource, i
 		if err := addLabels(rr.Resource, input.Metadata.Labels); err != nil {
 			return fmt.Errorf("failed to add labels: %w", err)
 		}
+		if err := addAnnotations(rr.Resource, input.Metadata.Annotations); err != nil {
+			return fmt.Errorf("failed to add annotations: %w", err)
+		}
 	}
 
+	// Pin API versions before hashing so that a preference change rolls out like any other change.
+	applyAPIVersionPreferences(resources, input.DataPlane.Spec.APIVersions)
+
 	if err := p.addDPResourceHashAnnotation(resources, input); err != nil {
 		return fmt.Errorf("failed to add dp-resource-hash annotation: %w", err)
 	}
@@ -417,6 +463,29 @@ func addLabels(resource map[string]any, labelsToAdd map[string]string) error {
 	return nil
 }
 
+// addAnnotations adds annotations to a resource's metadata.
+// Annotations already set by the template take precedence.
+func addAnnotations(resource map[string]any, annotationsToAdd map[string]string) error {
+	if len(annotationsToAdd) == 0 {
+		return nil
+	}
+	metadata, ok := resource["metadata"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("resource missing metadata")
+	}
+	existing, _ := metadata["annotations"].(map[string]any)
+	if existing == nil {
+		existing = make(map[string]any)
+	}
+	for k, v := range annotationsToAdd {
+		if _, set := existing[k]; !set {
+			existing[k] = v
+		}
+	}
+	metadata["annotations"] = existing
+	return nil
+}
+
 // validateResources performs basic validation on rendered resources.
 func (p *Pipeline) validateResources(resources []renderer.RenderedResource) error {
 	for i, rr := range resources {
@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/yaml"
 
@@ -953,7 +954,7 @@ spec:
 
 			// Create pipeline and render
 			pipeline := NewPipeline()
-			output, err := pipeline.Render(input)
+			output, err := pipeline.Render(t.Context(), input)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Render() error = %v, wantErr %v", err, tt.wantErr)
@@ -968,7 +969,8 @@ spec:
 			}
 
 			if tt.wantMetadata != nil {
-				if diff := cmp.Diff(tt.wantMetadata, output.Metadata); diff != "" {
+				// RenderedBytes depends on the exact rendered content and is covered by the limits tests.
+				if diff := cmp.Diff(tt.wantMetadata, output.Metadata, cmpopts.IgnoreFields(RenderMetadata{}, "RenderedBytes")); diff != "" {
 					t.Errorf("Metadata mismatch (-want +got):\n%s", diff)
 				}
 			}
@@ -1091,6 +1093,35 @@ func TestValidateResources(t *testing.T) {
 	}
 }
 
+func TestAddAnnotations(t *testing.T) {
+	resource := map[string]any{
+		"metadata": map[string]any{
+			"name":        "app",
+			"annotations": map[string]any{"openchoreo.dev/repository": "https://example.com/override"},
+		},
+	}
+	err := addAnnotations(resource, map[string]string{
+		"openchoreo.dev/oncall":     "https://oncall.example.com/payments",
+		"openchoreo.dev/repository": "https://github.com/example/checkout",
+	})
+	if err != nil {
+		t.Fatalf("addAnnotations() error = %v", err)
+	}
+
+	want := map[string]any{
+		"openchoreo.dev/oncall":     "https://oncall.example.com/payments",
+		"openchoreo.dev/repository": "https://example.com/override",
+	}
+	got := resource["metadata"].(map[string]any)["annotations"]
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("annotations mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := addAnnotations(map[string]any{}, map[string]string{"a": "b"}); err == nil {
+		t.Error("addAnnotations() expected error for a resource without metadata")
+	}
+}
+
 func TestPipeline_SchemaValidation(t *testing.T) {
 	baseMetadata := context.MetadataContext{
 		Name:               "test",
@@ -1250,7 +1281,7 @@ spec:
 				Metadata:       baseMetadata,
 			}
 
-			_, err := NewPipeline().Render(input)
+			_, err := NewPipeline().Render(t.Context(), input)
 			if err == nil {
 				t.Fatal("expected validation error, got nil")
 			}
@@ -1474,7 +1505,7 @@ spec:
 				Metadata:      baseMetadata,
 			}
 
-			_, err := NewPipeline().Render(input)
+			_, err := NewPipeline().Render(t.Context(), input)
 			if tt.wantErr {
 				if err == nil {
 					t.Fatal("expected error, got nil")
@@ -2145,7 +2176,7 @@ func renderPipelineYAML(t *testing.T, componentTypeYAML, componentYAML, traitsYA
 		DataPlane:     &v1alpha1.DataPlane{},
 		Metadata:      postRenderTestMetadata(),
 	}
-	return NewPipeline().Render(input)
+	return NewPipeline().Render(t.Context(), input)
 }
 
 // renderWithTraitPostValidation renders a ComponentType that emits a single Deployment
@@ -0,0 +1,340 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+const (
+	kindService     = "Service"
+	kindHTTPRoute   = "HTTPRoute"
+	kindGRPCRoute   = "GRPCRoute"
+	gatewayAPIGroup = "gateway.networking.k8s.io"
+)
+
+// RolloutInput describes a step of a canary or blue-green rollout of a ReleaseBinding.
+type RolloutInput struct {
+	// WorkloadType is the workload type of the ComponentType. Rollouts require deployments.
+	WorkloadType string
+
+	// Strategy is the rollout strategy of the ReleaseBinding.
+	Strategy v1alpha1.RolloutStrategyType
+
+	// Stable are the resources rendered for the release that runs in the environment.
+	Stable []renderer.RenderedResource
+
+	// Candidate are the resources rendered for the release being rolled out, or nil when no
+	// rollout is in progress.
+	Candidate []renderer.RenderedResource
+
+	// CandidateWeight is the percentage of the traffic routed to the candidate release.
+	CandidateWeight int32
+
+	// PreviewHostnamePrefix is prepended to the route hostnames of the candidate release of a
+	// blue-green rollout to form its preview hostnames.
+	PreviewHostnamePrefix string
+}
+
+// RolloutOutput holds the resources of a rollout step.
+type RolloutOutput struct {
+	// Resources are the resources of both releases, sorted like the output of Render.
+	Resources []renderer.RenderedResource
+
+	// PreviewHostnames are the hostnames of the preview routes of a blue-green rollout.
+	PreviewHostnames []string
+}
+
+// RenderRollout combines the resources rendered for the stable and the candidate release of a
+// rollout so that both run side by side in the data plane.
+//
+// The pods and Services of each release are told apart by the rollout-track label. The
+// Deployments and Services of the candidate are renamed; its HTTPRoutes and GRPCRoutes are not
+// applied. Instead, the backends of the stable routes that point to a Service of the candidate
+// are split between the stable and the candidate Service by CandidateWeight. A blue-green
+// rollout additionally gets a preview copy of each candidate route under a prefixed hostname.
+// The other resources of the candidate are only added when the stable release has no resource
+// of the same name, so that resources shared by both releases keep their stable content.
+//
+// Without a candidate, the stable resources are only labeled, so that starting a rollout does
+// not change the stable Deployment.
+func RenderRollout(input *RolloutInput) (*RolloutOutput, error) {
+	if input.WorkloadType != workloadTypeDeployment {
+		return nil, fmt.Errorf("rollout strategies require the %s workload type, got %q",
+			workloadTypeDeployment, input.WorkloadType)
+	}
+
+	out := &RolloutOutput{
+		Resources: make([]renderer.RenderedResource, 0, len(input.Stable)+len(input.Candidate)),
+	}
+	stableKeys := make(map[string]bool, len(input.Stable))
+	for _, rr := range input.Stable {
+		if rr.TargetPlane == v1alpha1.TargetPlaneDataPlane {
+			setRolloutTrack(rr.Resource, labels.LabelValueRolloutTrackStable, false)
+		}
+		stableKeys[resourceKey(rr.Resource)] = true
+		out.Resources = append(out.Resources, rr)
+	}
+	if input.Candidate == nil {
+		return out, nil
+	}
+
+	track := labels.LabelValueRolloutTrackCanary
+	if input.Strategy == v1alpha1.RolloutStrategyBlueGreen {
+		track = labels.LabelValueRolloutTrackPreview
+	}
+
+	// Services of the candidate by their rendered name, mapped to their rollout name.
+	candidateServices := map[string]string{}
+	var candidateRoutes []renderer.RenderedResource
+	for _, rr := range input.Candidate {
+		if rr.TargetPlane != v1alpha1.TargetPlaneDataPlane {
+			continue
+		}
+		kind, _ := rr.Resource["kind"].(string)
+		switch {
+		case isMainWorkloadKind(kind, input.WorkloadType):
+			renameResource(rr.Resource, dpkubernetes.MaxResourceNameLength, track)
+			setRolloutTrack(rr.Resource, track, true)
+		case isCoreService(rr.Resource):
+			name := renameResource(rr.Resource, dpkubernetes.MaxServiceNameLength, track)
+			candidateServices[name] = resourceName(rr.Resource)
+			setRolloutTrack(rr.Resource, track, true)
+		case isTrafficRoute(rr.Resource):
+			candidateRoutes = append(candidateRoutes, rr)
+			continue
+		case stableKeys[resourceKey(rr.Resource)]:
+			continue
+		}
+		out.Resources = append(out.Resources, rr)
+	}
+
+	weight := min(max(input.CandidateWeight, 0), 100)
+	if weight > 0 {
+		for _, rr := range out.Resources {
+			if isTrafficRoute(rr.Resource) && rolloutTrack(rr.Resource) == labels.LabelValueRolloutTrackStable {
+				splitBackendRefs(rr.Resource, candidateServices, int64(weight))
+			}
+		}
+	}
+
+	if input.Strategy == v1alpha1.RolloutStrategyBlueGreen {
+		for _, rr := range candidateRoutes {
+			hostnames := makePreviewRoute(rr.Resource, candidateServices, input.PreviewHostnamePrefix, track)
+			out.PreviewHostnames = append(out.PreviewHostnames, hostnames...)
+			out.Resources = append(out.Resources, rr)
+		}
+	}
+
+	sortRenderedResources(out.Resources)
+	return out, nil
+}
+
+// setRolloutTrack labels a resource with its rollout track. The pod template of a Deployment
+// and the selector of a Service are labeled too. The selector of a Deployment is immutable, so
+// it is only labeled when the Deployment is created for the rollout.
+func setRolloutTrack(resource map[string]any, track string, selector bool) {
+	kind, _ := resource["kind"].(string)
+	switch {
+	case kind == kindDeployment:
+		spec, _ := resource["spec"].(map[string]any)
+		template, _ := spec["template"].(map[string]any)
+		if template == nil {
+			return
+		}
+		templateMeta, _ := template["metadata"].(map[string]any)
+		if templateMeta == nil {
+			templateMeta = map[string]any{}
+			template["metadata"] = templateMeta
+		}
+		setStringField(templateMeta, "labels", labels.LabelKeyRolloutTrack, track)
+		if selector {
+			if podSelector, ok := spec["selector"].(map[string]any); ok {
+				setStringField(podSelector, "matchLabels", labels.LabelKeyRolloutTrack, track)
+			}
+		}
+	case isCoreService(resource):
+		spec, _ := resource["spec"].(map[string]any)
+		if podSelector, ok := spec["selector"].(map[string]any); ok && len(podSelector) > 0 {
+			podSelector[labels.LabelKeyRolloutTrack] = track
+		}
+	case isTrafficRoute(resource):
+	default:
+		return
+	}
+	if metadata, ok := resource["metadata"].(map[string]any); ok {
+		setStringField(metadata, "labels", labels.LabelKeyRolloutTrack, track)
+	}
+}
+
+// rolloutTrack returns the rollout-track label of a resource.
+func rolloutTrack(resource map[string]any) string {
+	metadata, _ := resource["metadata"].(map[string]any)
+	resourceLabels, _ := metadata["labels"].(map[string]any)
+	track, _ := resourceLabels[labels.LabelKeyRolloutTrack].(string)
+	return track
+}
+
+// splitBackendRefs splits each backend of a route that points to a Service of the candidate
+// between the stable Service and the candidate Service. An explicit backend weight is kept as
+// the ratio between that backend and the other backends of the rule.
+func splitBackendRefs(route map[string]any, candidateServices map[string]string, weight int64) {
+	forEachRule(route, func(rule map[string]any) {
+		refs, _ := rule["backendRefs"].([]any)
+		if len(refs) == 0 {
+			return
+		}
+		split := make([]any, 0, 2*len(refs))
+		for _, r := range refs {
+			ref, ok := r.(map[string]any)
+			candidate, found := candidateServices[serviceBackendName(ref)]
+			if !ok || !found {
+				split = append(split, r)
+				continue
+			}
+			base := backendWeight(ref)
+			stableRef := maps.Clone(ref)
+			stableRef["weight"] = base * (100 - weight)
+			candidateRef := maps.Clone(ref)
+			candidateRef["name"] = candidate
+			candidateRef["weight"] = base * weight
+			split = append(split, stableRef, candidateRef)
+		}
+		rule["backendRefs"] = split
+	})
+}
+
+// makePreviewRoute turns a route of the candidate of a blue-green rollout into its preview
+// route: it is renamed, its hostnames are prefixed and its backends point to the Services of
+// the candidate. Returns the preview hostnames.
+func makePreviewRoute(route map[string]any, candidateServices map[string]string, prefix, track string) []string {
+	renameResource(route, dpkubernetes.MaxResourceNameLength, track)
+	setRolloutTrack(route, track, false)
+
+	spec, _ := route["spec"].(map[string]any)
+	var hostnames []string
+	if hosts, ok := spec["hostnames"].([]any); ok {
+		for i, h := range hosts {
+			host, ok := h.(string)
+			if !ok || host == "" || strings.HasPrefix(host, "*") {
+				continue
+			}
+			hosts[i] = prefix + host
+			hostnames = append(hostnames, prefix+host)
+		}
+	}
+
+	forEachRule(route, func(rule map[string]any) {
+		refs, _ := rule["backendRefs"].([]any)
+		for _, r := range refs {
+			ref, ok := r.(map[string]any)
+			if !ok {
+				continue
+			}
+			if candidate, found := candidateServices[serviceBackendName(ref)]; found {
+				ref["name"] = candidate
+			}
+		}
+	})
+	return hostnames
+}
+
+// forEachRule calls fn for every rule of a route.
+func forEachRule(route map[string]any, fn func(rule map[string]any)) {
+	spec, _ := route["spec"].(map[string]any)
+	rules, _ := spec["rules"].([]any)
+	for _, r := range rules {
+		if rule, ok := r.(map[string]any); ok {
+			fn(rule)
+		}
+	}
+}
+
+// serviceBackendName returns the name of the Service a backendRef points to, or "" when it
+// points to another kind of backend.
+func serviceBackendName(ref map[string]any) string {
+	if group, _ := ref["group"].(string); group != "" {
+		return ""
+	}
+	if kind, _ := ref["kind"].(string); kind != "" && kind != kindService {
+		return ""
+	}
+	name, _ := ref["name"].(string)
+	return name
+}
+
+// backendWeight returns the weight of a backendRef, which Gateway API defaults to 1.
+func backendWeight(ref map[string]any) int64 {
+	switch v := ref["weight"].(type) {
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	default:
+		return 1
+	}
+}
+
+// renameResource appends the rollout track to the name of a resource within the given length
+// limit and returns the original name.
+func renameResource(resource map[string]any, limit int, track string) string {
+	metadata, _ := resource["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	if metadata != nil && name != "" {
+		metadata["name"] = dpkubernetes.GenerateK8sNameWithLengthLimit(limit, name, track)
+	}
+	return name
+}
+
+// resourceName returns metadata.name of a resource.
+func resourceName(resource map[string]any) string {
+	metadata, _ := resource["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	return name
+}
+
+// resourceKey identifies a resource by its API group, kind, namespace and name.
+func resourceKey(resource map[string]any) string {
+	apiVersion, _ := resource["apiVersion"].(string)
+	group := ""
+	if i := strings.LastIndex(apiVersion, "/"); i >= 0 {
+		group = apiVersion[:i]
+	}
+	kind, _ := resource["kind"].(string)
+	metadata, _ := resource["metadata"].(map[string]any)
+	namespace, _ := metadata["namespace"].(string)
+	name, _ := metadata["name"].(string)
+	return strings.Join([]string{group, kind, namespace, name}, "/")
+}
+
+func isCoreService(resource map[string]any) bool {
+	return resource["apiVersion"] == "v1" && resource["kind"] == kindService
+}
+
+func isTrafficRoute(resource map[string]any) bool {
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+	return strings.HasPrefix(apiVersion, gatewayAPIGroup+"/") && (kind == kindHTTPRoute || kind == kindGRPCRoute)
+}
+
+// setStringField sets key in the string map stored under field of obj, creating the map.
+func setStringField(obj map[string]any, field, key, value string) {
+	m, _ := obj[field].(map[string]any)
+	if m == nil {
+		m = map[string]any{}
+		obj[field] = m
+	}
+	m[key] = value
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+const rolloutRelease = `
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: api
+    namespace: dp-shop
+  spec:
+    selector:
+      matchLabels:
+        app: api
+    template:
+      metadata:
+        labels:
+          app: api
+      spec:
+        containers:
+        - name: main
+          image: IMAGE
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: api
+    namespace: dp-shop
+  spec:
+    selector:
+      app: api
+    ports:
+    - port: 80
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: api-config
+    namespace: dp-shop
+  data:
+    image: IMAGE
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRoute
+  metadata:
+    name: api-http
+    namespace: dp-shop
+  spec:
+    hostnames:
+    - api.example.com
+    rules:
+    - backendRefs:
+      - name: api
+        port: 80
+      - name: legacy
+        port: 80
+`
+
+func rolloutResources(t *testing.T, image string) []renderer.RenderedResource {
+	t.Helper()
+	var objs []map[string]any
+	if err := yaml.Unmarshal([]byte(strings.ReplaceAll(rolloutRelease, "IMAGE", image)), &objs); err != nil {
+		t.Fatalf("failed to parse resources: %v", err)
+	}
+	resources := make([]renderer.RenderedResource, 0, len(objs))
+	for _, obj := range objs {
+		resources = append(resources, renderer.RenderedResource{Resource: obj, TargetPlane: v1alpha1.TargetPlaneDataPlane})
+	}
+	return resources
+}
+
+func findRolloutResource(t *testing.T, resources []renderer.RenderedResource, kind, name string) map[string]any {
+	t.Helper()
+	for _, rr := range resources {
+		if rr.Resource["kind"] == kind && resourceName(rr.Resource) == name {
+			return rr.Resource
+		}
+	}
+	t.Fatalf("%s %q not found", kind, name)
+	return nil
+}
+
+func nestedValue(obj map[string]any, path ...string) any {
+	var cur any = obj
+	for _, p := range path {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = m[p]
+	}
+	return cur
+}
+
+func TestRenderRolloutWithoutCandidate(t *testing.T) {
+	out, err := RenderRollout(&RolloutInput{
+		WorkloadType: workloadTypeDeployment,
+		Strategy:     v1alpha1.RolloutStrategyCanary,
+		Stable:       rolloutResources(t, "v1"),
+	})
+	if err != nil {
+		t.Fatalf("RenderRollout() error = %v", err)
+	}
+	if len(out.Resources) != 4 {
+		t.Fatalf("got %d resources, want 4", len(out.Resources))
+	}
+
+	deployment := findRolloutResource(t, out.Resources, "Deployment", "api")
+	if got := nestedValue(deployment, "spec", "template", "metadata", "labels", labels.LabelKeyRolloutTrack); got != "stable" {
+		t.Errorf("pod template track = %v, want stable", got)
+	}
+	if got := nestedValue(deployment, "spec", "selector", "matchLabels", labels.LabelKeyRolloutTrack); got != nil {
+		t.Errorf("the immutable Deployment selector was changed: %v", got)
+	}
+	service := findRolloutResource(t, out.Resources, "Service", "api")
+	if got := nestedValue(service, "spec", "selector", labels.LabelKeyRolloutTrack); got != "stable" {
+		t.Errorf("Service selector track = %v, want stable", got)
+	}
+	route := findRolloutResource(t, out.Resources, "HTTPRoute", "api-http")
+	refs := nestedValue(route, "spec", "rules").([]any)[0].(map[string]any)["backendRefs"].([]any)
+	if len(refs) != 2 {
+		t.Errorf("backends of the route changed without a candidate: %v", refs)
+	}
+}
+
+func TestRenderRolloutCanary(t *testing.T) {
+	out, err := RenderRollout(&RolloutInput{
+		WorkloadType:    workloadTypeDeployment,
+		Strategy:        v1alpha1.RolloutStrategyCanary,
+		Stable:          rolloutResources(t, "v1"),
+		Candidate:       rolloutResources(t, "v2"),
+		CandidateWeight: 20,
+	})
+	if err != nil {
+		t.Fatalf("RenderRollout() error = %v", err)
+	}
+	// The candidate adds its Deployment and Service; its ConfigMap and route are not applied.
+	if len(out.Resources) != 6 {
+		t.Fatalf("got %d resources, want 6", len(out.Resources))
+	}
+
+	var canaryDeployment, canaryService map[string]any
+	for _, rr := range out.Resources {
+		if rolloutTrack(rr.Resource) != "canary" {
+			continue
+		}
+		switch rr.Resource["kind"] {
+		case "Deployment":
+			canaryDeployment = rr.Resource
+		case "Service":
+			canaryService = rr.Resource
+		default:
+			t.Errorf("unexpected canary %s", rr.Resource["kind"])
+		}
+	}
+	if canaryDeployment == nil || canaryService == nil {
+		t.Fatalf("canary Deployment or Service missing")
+	}
+	if name := resourceName(canaryDeployment); !strings.HasPrefix(name, "api-canary-") {
+		t.Errorf("canary Deployment name = %q", name)
+	}
+	if got := nestedValue(canaryDeployment, "spec", "selector", "matchLabels", labels.LabelKeyRolloutTrack); got != "canary" {
+		t.Errorf("canary Deployment selector track = %v, want canary", got)
+	}
+	if got := nestedValue(canaryService, "spec", "selector", labels.LabelKeyRolloutTrack); got != "canary" {
+		t.Errorf("canary Service selector track = %v, want canary", got)
+	}
+	if got := nestedValue(findRolloutResource(t, out.Resources, "ConfigMap", "api-config"), "data", "image"); got != "v1" {
+		t.Errorf("shared ConfigMap image = %v, want the stable v1", got)
+	}
+
+	route := findRolloutResource(t, out.Resources, "HTTPRoute", "api-http")
+	refs := nestedValue(route, "spec", "rules").([]any)[0].(map[string]any)["backendRefs"]
+	want := []any{
+		map[string]any{"name": "api", "port": float64(80), "weight": int64(80)},
+		map[string]any{"name": resourceName(canaryService), "port": float64(80), "weight": int64(20)},
+		map[string]any{"name": "legacy", "port": float64(80)},
+	}
+	if !reflect.DeepEqual(refs, want) {
+		t.Errorf("backendRefs = %v, want %v", refs, want)
+	}
+}
+
+func TestRenderRolloutBlueGreen(t *testing.T) {
+	out, err := RenderRollout(&RolloutInput{
+		WorkloadType:          workloadTypeDeployment,
+		Strategy:              v1alpha1.RolloutStrategyBlueGreen,
+		Stable:                rolloutResources(t, "v1"),
+		Candidate:             rolloutResources(t, "v2"),
+		PreviewHostnamePrefix: "preview-",
+	})
+	if err != nil {
+		t.Fatalf("RenderRollout() error = %v", err)
+	}
+	if want := []string{"preview-api.example.com"}; !reflect.DeepEqual(out.PreviewHostnames, want) {
+		t.Errorf("PreviewHostnames = %v, want %v", out.PreviewHostnames, want)
+	}
+
+	stableRoute := findRolloutResource(t, out.Resources, "HTTPRoute", "api-http")
+	if refs := nestedValue(stableRoute, "spec", "rules").([]any)[0].(map[string]any)["backendRefs"].([]any); len(refs) != 2 {
+		t.Errorf("stable route backends changed before promotion: %v", refs)
+	}
+
+	var preview map[string]any
+	for _, rr := range out.Resources {
+		if rr.Resource["kind"] == "HTTPRoute" && rolloutTrack(rr.Resource) == "preview" {
+			preview = rr.Resource
+		}
+	}
+	if preview == nil {
+		t.Fatalf("preview route missing")
+	}
+	ref := nestedValue(preview, "spec", "rules").([]any)[0].(map[string]any)["backendRefs"].([]any)[0].(map[string]any)
+	if name, _ := ref["name"].(string); !strings.HasPrefix(name, "api-preview-") {
+		t.Errorf("preview route backend = %q, want the preview Service", name)
+	}
+}
+
+func TestRenderRolloutRequiresDeployment(t *testing.T) {
+	_, err := RenderRollout(&RolloutInput{WorkloadType: workloadTypeStatefulSet, Strategy: v1alpha1.RolloutStrategyCanary})
+	if err == nil {
+		t.Fatal("expected an error for a statefulset workload type")
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// Stage is a custom render stage. Stages run after every trait has been applied and the
+// post-render validations have passed, in registration order, and can adjust, add or remove
+// rendered resources. Their output then goes through the same post-processing as the output
+// of the pipeline itself: OpenChoreo labels and annotations are added, the resources are
+// validated and the render limits are enforced.
+type Stage interface {
+	// Name identifies the stage in errors and render warnings.
+	Name() string
+
+	// Process returns the resources of the release after the stage. It must not modify the
+	// resources of the request in place when it fails.
+	Process(ctx context.Context, req *StageRequest) ([]renderer.RenderedResource, error)
+}
+
+// IgnorableStage is implemented by stages whose failures are reported as render warnings
+// instead of failing the render.
+type IgnorableStage interface {
+	Stage
+
+	// IgnoreFailures reports whether a failure of the stage leaves the resources unchanged
+	// and lets the render continue.
+	IgnoreFailures() bool
+}
+
+// StageRequest is what a Stage sees of a render.
+type StageRequest struct {
+	// Namespace is the control plane namespace of the component.
+	Namespace string
+	// Project, Component and Environment identify the release being rendered.
+	Project     string
+	Component   string
+	Environment string
+	// ComponentType is the "workloadType/name" of the ComponentType of the component.
+	ComponentType string
+	// Resources are the rendered resources with their target planes.
+	Resources []renderer.RenderedResource
+}
+
+// StageError is returned by Render when a stage fails.
+type StageError struct {
+	// Stage is the name of the failed stage.
+	Stage string
+	Err   error
+}
+
+func (e *StageError) Error() string {
+	return fmt.Sprintf("render stage %s failed: %v", e.Stage, e.Err)
+}
+
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	registeredStagesMu sync.Mutex
+	registeredStages   []Stage
+)
+
+// RegisterStage adds a stage to every pipeline created afterwards by NewPipeline. It is meant
+// to be called from the init function of a package that is compiled into the controller
+// manager, so that custom in-process stages need no change to the pipeline itself.
+func RegisterStage(stage Stage) {
+	registeredStagesMu.Lock()
+	defer registeredStagesMu.Unlock()
+	registeredStages = append(registeredStages, stage)
+}
+
+// WithStages adds stages to the pipeline. They run after the stages added with RegisterStage.
+func WithStages(stages ...Stage) Option {
+	return func(p *Pipeline) {
+		p.stages = append(p.stages, stages...)
+	}
+}
+
+// defaultStages returns the stages added with RegisterStage.
+func defaultStages() []Stage {
+	registeredStagesMu.Lock()
+	defer registeredStagesMu.Unlock()
+	return append([]Stage(nil), registeredStages...)
+}
+
+// runStages passes the rendered resources through the stages of the pipeline in order.
+// Failures of ignorable stages are added to the warnings of the render metadata.
+func (p *Pipeline) runStages(ctx context.Context, resources []renderer.RenderedResource, input *RenderInput, metadata *RenderMetadata) ([]renderer.RenderedResource, error) {
+	if len(p.stages) == 0 {
+		return resources, nil
+	}
+
+	req := &StageRequest{
+		Namespace:     input.Metadata.ComponentNamespace,
+		Project:       input.Metadata.ProjectName,
+		Component:     input.Metadata.ComponentName,
+		Environment:   input.Metadata.EnvironmentName,
+		ComponentType: input.Component.Spec.ComponentType.Name,
+	}
+	for _, stage := range p.stages {
+		req.Resources = resources
+		out, err := stage.Process(ctx, req)
+		if err != nil {
+			if ignorable, ok := stage.(IgnorableStage); ok && ignorable.IgnoreFailures() {
+				metadata.Warnings = append(metadata.Warnings, (&StageError{Stage: stage.Name(), Err: err}).Error())
+				continue
+			}
+			return nil, &StageError{Stage: stage.Name(), Err: err}
+		}
+		if err := p.limits.checkResourceCount(out); err != nil {
+			return nil, err
+		}
+		resources = out
+	}
+	return resources, nil
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+const stagesComponentTypeYAML = `
+spec:
+  resources:
+    - id: config
+      template:
+        apiVersion: v1
+        kind: ConfigMap
+        metadata:
+          name: app-config
+        data:
+          key: value
+    - id: secret-config
+      template:
+        apiVersion: v1
+        kind: ConfigMap
+        metadata:
+          name: app-secret-config
+        data:
+          key: value
+`
+
+// stageFunc adapts a function to the Stage interface.
+type stageFunc struct {
+	name    string
+	ignore  bool
+	process func(req *StageRequest) ([]renderer.RenderedResource, error)
+}
+
+func (s stageFunc) Name() string         { return s.name }
+func (s stageFunc) IgnoreFailures() bool { return s.ignore }
+func (s stageFunc) Process(_ context.Context, req *StageRequest) ([]renderer.RenderedResource, error) {
+	return s.process(req)
+}
+
+func renderWithStages(t *testing.T, opts ...Option) (*RenderOutput, error) {
+	t.Helper()
+	var componentType v1alpha1.ComponentType
+	if err := yaml.Unmarshal([]byte(stagesComponentTypeYAML), &componentType); err != nil {
+		t.Fatalf("Failed to parse componentType: %v", err)
+	}
+	input := &RenderInput{
+		ComponentType: &componentType,
+		Component: &v1alpha1.Component{
+			Spec: v1alpha1.ComponentSpec{ComponentType: v1alpha1.ComponentTypeRef{Name: "deployment/service"}},
+		},
+		Workload:    &v1alpha1.Workload{},
+		Environment: &v1alpha1.Environment{},
+		DataPlane:   &v1alpha1.DataPlane{},
+		Metadata:    postRenderTestMetadata(),
+	}
+	input.Metadata.Labels["openchoreo.dev/component"] = "app"
+	return NewPipeline(opts...).Render(t.Context(), input)
+}
+
+// dropSecretConfigStage removes app-secret-config and adds a NetworkPolicy.
+var dropSecretConfigStage = stageFunc{
+	name: "drop-secret-config",
+	process: func(req *StageRequest) ([]renderer.RenderedResource, error) {
+		var out []renderer.RenderedResource
+		for _, r := range req.Resources {
+			if nestedValue(r.Resource, "metadata", "name") != "app-secret-config" {
+				out = append(out, r)
+			}
+		}
+		return append(out, renderer.RenderedResource{
+			TargetPlane: v1alpha1.TargetPlaneDataPlane,
+			Resource: map[string]any{
+				"apiVersion": "networking.k8s.io/v1",
+				"kind":       "NetworkPolicy",
+				"metadata":   map[string]any{"name": "deny-egress"},
+				"spec":       map[string]any{"policyTypes": []any{"Egress"}},
+			},
+		}), nil
+	},
+}
+
+func TestRender_Stages(t *testing.T) {
+	var seen *StageRequest
+	inspect := stageFunc{
+		name: "inspect",
+		process: func(req *StageRequest) ([]renderer.RenderedResource, error) {
+			seen = req
+			return req.Resources, nil
+		},
+	}
+
+	out, err := renderWithStages(t, WithStages(dropSecretConfigStage, inspect))
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if seen.Namespace != "ns" || seen.ComponentType != "deployment/service" || len(seen.Resources) != 2 {
+		t.Errorf("second stage saw %+v", seen)
+	}
+	if len(out.Resources) != 2 {
+		t.Fatalf("got %d resources, want 2", len(out.Resources))
+	}
+
+	// Resources added by a stage are post-processed like the rendered ones.
+	policy := findRolloutResource(t, out.Resources, "NetworkPolicy", "deny-egress")
+	if got := nestedValue(policy, "metadata", "labels", "openchoreo.dev/component"); got != "app" {
+		t.Errorf("stage resource labels = %v", nestedValue(policy, "metadata", "labels"))
+	}
+}
+
+func TestRender_StageFailure(t *testing.T) {
+	failing := stageFunc{
+		name: "policy-check",
+		process: func(*StageRequest) ([]renderer.RenderedResource, error) {
+			return nil, errors.New("missing cost-center label")
+		},
+	}
+
+	_, err := renderWithStages(t, WithStages(failing))
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) || stageErr.Stage != "policy-check" {
+		t.Fatalf("Render() error = %v, want StageError for policy-check", err)
+	}
+
+	failing.ignore = true
+	out, err := renderWithStages(t, WithStages(failing))
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if len(out.Resources) != 2 || len(out.Metadata.Warnings) != 1 {
+		t.Errorf("got %d resources and warnings %v", len(out.Resources), out.Metadata.Warnings)
+	}
+}
+
+// contextStage records the context it is called with.
+type contextStage struct{ ctx context.Context }
+
+func (s *contextStage) Name() string         { return "context" }
+func (s *contextStage) IgnoreFailures() bool { return false }
+func (s *contextStage) Process(ctx context.Context, req *StageRequest) ([]renderer.RenderedResource, error) {
+	s.ctx = ctx
+	return req.Resources, nil
+}
+
+func TestRender_StageContext(t *testing.T) {
+	type key struct{}
+	stage := &contextStage{}
+	var componentType v1alpha1.ComponentType
+	if err := yaml.Unmarshal([]byte(stagesComponentTypeYAML), &componentType); err != nil {
+		t.Fatalf("Failed to parse componentType: %v", err)
+	}
+	input := &RenderInput{
+		ComponentType: &componentType,
+		Component: &v1alpha1.Component{
+			Spec: v1alpha1.ComponentSpec{ComponentType: v1alpha1.ComponentTypeRef{Name: "deployment/service"}},
+		},
+		Workload:    &v1alpha1.Workload{},
+		Environment: &v1alpha1.Environment{},
+		DataPlane:   &v1alpha1.DataPlane{},
+		Metadata:    postRenderTestMetadata(),
+	}
+
+	ctx := context.WithValue(t.Context(), key{}, "caller")
+	if _, err := NewPipeline(WithStages(stage)).Render(ctx, input); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if stage.ctx == nil || stage.ctx.Value(key{}) != "caller" {
+		t.Errorf("stage was not called with the caller's context")
+	}
+}
+
+func TestRegisterStage(t *testing.T) {
+	saved := registeredStages
+	t.Cleanup(func() { registeredStages = saved })
+
+	RegisterStage(dropSecretConfigStage)
+	out, err := renderWithStages(t)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	findRolloutResource(t, out.Resources, "NetworkPolicy", "deny-egress")
+}
+
+func TestWebhookStage(t *testing.T) {
+	var review StageReview
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var answer StageReviewResponse
+		switch review.Request.Environment {
+		case "reject":
+			answer.Error = "images must come from registry.example.com"
+		case "unchanged":
+		default:
+			for _, r := range review.Request.Resources {
+				r.Resource["metadata"].(map[string]any)["annotations"] = map[string]any{"cost-center": "42"}
+				answer.Resources = append(answer.Resources, r)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(StageReview{Response: &answer})
+	}))
+	defer server.Close()
+
+	stage, err := NewWebhookStage(WebhookStageConfig{Name: "cost-center", URL: server.URL})
+	if err != nil {
+		t.Fatalf("NewWebhookStage() error = %v", err)
+	}
+	resources := []renderer.RenderedResource{{
+		TargetPlane: v1alpha1.TargetPlaneDataPlane,
+		Resource:    map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]any{"name": "app-config"}},
+	}}
+
+	out, err := stage.Process(context.Background(), &StageRequest{Project: "shop", Environment: "dev", Resources: resources})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if review.Request.Project != "shop" || len(review.Request.Resources) != 1 {
+		t.Errorf("webhook got %+v", review.Request)
+	}
+	if got := nestedValue(out[0].Resource, "metadata", "annotations", "cost-center"); got != "42" {
+		t.Errorf("cost-center annotation = %v", got)
+	}
+
+	out, err = stage.Process(context.Background(), &StageRequest{Environment: "unchanged", Resources: resources})
+	if err != nil || len(out) != 1 {
+		t.Errorf("Process() = %v, %v, want the resources unchanged", out, err)
+	}
+
+	if _, err := stage.Process(context.Background(), &StageRequest{Environment: "reject", Resources: resources}); err == nil {
+		t.Error("Process() error = nil, want the rejection")
+	}
+}
+
+func TestNewStages(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     StagesConfig
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			cfg: StagesConfig{Webhooks: []WebhookStageConfig{
+				{Name: "a", URL: "https://mutator.example.com/a", TimeoutSeconds: 5},
+				{Name: "b", URL: "http://mutator.example.com/b", FailurePolicy: WebhookFailurePolicyIgnore},
+			}},
+		},
+		{
+			name:    "missing name",
+			cfg:     StagesConfig{Webhooks: []WebhookStageConfig{{URL: "https://mutator.example.com"}}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			cfg: StagesConfig{Webhooks: []WebhookStageConfig{
+				{Name: "a", URL: "https://mutator.example.com/a"},
+				{Name: "a", URL: "https://mutator.example.com/b"},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "relative url",
+			cfg:     StagesConfig{Webhooks: []WebhookStageConfig{{Name: "a", URL: "/mutate"}}},
+			wantErr: true,
+		},
+		{
+			name:    "timeout too long",
+			cfg:     StagesConfig{Webhooks: []WebhookStageConfig{{Name: "a", URL: "https://mutator.example.com", TimeoutSeconds: 60}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown failure policy",
+			cfg:     StagesConfig{Webhooks: []WebhookStageConfig{{Name: "a", URL: "https://mutator.example.com", FailurePolicy: "Retry"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stages, err := NewStages(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewStages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(stages) != len(tt.cfg.Webhooks) {
+				t.Errorf("got %d stages, want %d", len(stages), len(tt.cfg.Webhooks))
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 // to generate fully resolved Kubernetes resource manifests.
 type Pipeline struct {
 	templateEngine *template.Engine
+	limits         Limits
+	stages         []Stage
 }
 
 // RenderInput contains all inputs needed to render a component's resources.
@@ -102,6 +104,14 @@ type RenderOutput struct {
 	// Resources is the list of fully rendered Kubernetes resource manifests with their target planes.
 	Resources []renderer.RenderedResource
 
+	// Hooks are the lifecycle hook Jobs of the ComponentType, rendered separately from
+	// Resources because the release controller decides when each of them is applied.
+	Hooks []RenderedHook
+
+	// WorkloadIdentity is the cloud identity requested by the ComponentType, with its
+	// template expressions resolved. It is empty when the type requests none.
+	WorkloadIdentity string
+
 	// Metadata contains information about the rendering process.
 	Metadata *RenderMetadata
 }
@@ -120,6 +130,9 @@ type RenderMetadata struct {
 	// TraitResourceCount is the number of resources created by traits.
 	TraitResourceCount int
 
+	// RenderedBytes is the total JSON size of the rendered resources.
+	RenderedBytes int
+
 	// Warnings contains non-fatal issues encountered during rendering.
 	Warnings []string
 }
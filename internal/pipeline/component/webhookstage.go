@@ -0,0 +1,262 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
+)
+
+// Failure policies of a webhook stage.
+const (
+	// WebhookFailurePolicyFail fails the render when the webhook cannot be called or rejects
+	// the release.
+	WebhookFailurePolicyFail = "Fail"
+	// WebhookFailurePolicyIgnore leaves the resources unchanged and reports a render warning.
+	WebhookFailurePolicyIgnore = "Ignore"
+)
+
+const (
+	// DefaultWebhookTimeout is the timeout of a webhook stage that sets none.
+	DefaultWebhookTimeout = 10 * time.Second
+	// maxWebhookTimeout bounds the timeout of a webhook stage so that a slow mutator cannot
+	// stall the release binding controller.
+	maxWebhookTimeout = 30 * time.Second
+	// maxWebhookResponseBytes bounds the response of a webhook stage.
+	maxWebhookResponseBytes = 8 << 20
+)
+
+// StagesConfig is the render stage configuration loaded from the render stages config file.
+type StagesConfig struct {
+	// Webhooks are external mutators called, in order, after the in-process stages.
+	Webhooks []WebhookStageConfig `json:"webhooks,omitempty"`
+}
+
+// WebhookStageConfig configures a render stage that sends the rendered resources to an
+// external HTTP service and replaces them with the resources it returns.
+type WebhookStageConfig struct {
+	// Name identifies the stage in errors and render warnings.
+	Name string `json:"name"`
+	// URL is the http or https endpoint the resources are POSTed to.
+	URL string `json:"url"`
+	// TimeoutSeconds bounds each call. Defaults to 10, at most 30.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+	// FailurePolicy is Fail (default) or Ignore.
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+	// CABundleFile is a PEM file with the CAs that verify the certificate of an https URL.
+	// The system roots are used when it is empty.
+	CABundleFile string `json:"caBundleFile,omitempty"`
+}
+
+// LoadStagesConfig reads a StagesConfig from a YAML or JSON file.
+func LoadStagesConfig(path string) (StagesConfig, error) {
+	var cfg StagesConfig
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read render stages config: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse render stages config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// NewStages creates the stages of the configuration.
+func NewStages(cfg StagesConfig) ([]Stage, error) {
+	names := make(map[string]bool, len(cfg.Webhooks))
+	stages := make([]Stage, 0, len(cfg.Webhooks))
+	for i, webhook := range cfg.Webhooks {
+		if names[webhook.Name] {
+			return nil, fmt.Errorf("webhooks[%d]: duplicate name %q", i, webhook.Name)
+		}
+		names[webhook.Name] = true
+		stage, err := NewWebhookStage(webhook)
+		if err != nil {
+			return nil, fmt.Errorf("webhooks[%d]: %w", i, err)
+		}
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+// WebhookStage is a Stage backed by an external HTTP service.
+//
+// The service receives a POST with a JSON StageReview whose request holds the release and
+// its resources, and answers with a StageReview whose response holds the resources that
+// replace them. A response without resources leaves them unchanged; a response with an
+// error message rejects the release.
+type WebhookStage struct {
+	name          string
+	url           string
+	ignoreFailure bool
+	client        *http.Client
+}
+
+var _ IgnorableStage = (*WebhookStage)(nil)
+
+// NewWebhookStage validates the configuration and creates a WebhookStage.
+func NewWebhookStage(cfg WebhookStageConfig) (*WebhookStage, error) {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	target, err := url.Parse(cfg.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, fmt.Errorf("stage %s: url must be an absolute http or https URL", cfg.Name)
+	}
+
+	timeout := DefaultWebhookTimeout
+	if cfg.TimeoutSeconds != 0 {
+		timeout = time.Duration(cfg.TimeoutSeconds) * time.Second
+		if timeout < 0 || timeout > maxWebhookTimeout {
+			return nil, fmt.Errorf("stage %s: timeoutSeconds must be between 1 and %d", cfg.Name, int(maxWebhookTimeout.Seconds()))
+		}
+	}
+
+	var ignoreFailure bool
+	switch cfg.FailurePolicy {
+	case "", WebhookFailurePolicyFail:
+	case WebhookFailurePolicyIgnore:
+		ignoreFailure = true
+	default:
+		return nil, fmt.Errorf("stage %s: failurePolicy must be %q or %q", cfg.Name, WebhookFailurePolicyFail, WebhookFailurePolicyIgnore)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.CABundleFile != "" {
+		pem, err := os.ReadFile(cfg.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("stage %s: failed to read CA bundle: %w", cfg.Name, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("stage %s: CA bundle %s holds no PEM certificate", cfg.Name, cfg.CABundleFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &WebhookStage{
+		name:          cfg.Name,
+		url:           cfg.URL,
+		ignoreFailure: ignoreFailure,
+		client:        &http.Client{Timeout: timeout, Transport: transport},
+	}, nil
+}
+
+// Name returns the configured name of the stage.
+func (s *WebhookStage) Name() string {
+	return s.name
+}
+
+// IgnoreFailures reports whether the failure policy of the stage is Ignore.
+func (s *WebhookStage) IgnoreFailures() bool {
+	return s.ignoreFailure
+}
+
+// StageReview is the body exchanged with a webhook stage.
+type StageReview struct {
+	Request  *StageReviewRequest  `json:"request,omitempty"`
+	Response *StageReviewResponse `json:"response,omitempty"`
+}
+
+// StageReviewRequest describes the release sent to a webhook stage.
+type StageReviewRequest struct {
+	Namespace     string          `json:"namespace"`
+	Project       string          `json:"project"`
+	Component     string          `json:"component"`
+	Environment   string          `json:"environment"`
+	ComponentType string          `json:"componentType"`
+	Resources     []StageResource `json:"resources"`
+}
+
+// StageReviewResponse is the answer of a webhook stage.
+type StageReviewResponse struct {
+	// Resources replace the resources of the release. Nil leaves them unchanged.
+	Resources []StageResource `json:"resources,omitempty"`
+	// Error rejects the release with the given message.
+	Error string `json:"error,omitempty"`
+}
+
+// StageResource is a rendered resource with the plane it targets.
+type StageResource struct {
+	TargetPlane string         `json:"targetPlane"`
+	Resource    map[string]any `json:"resource"`
+}
+
+// Process sends the resources to the webhook and returns the resources it answers with.
+func (s *WebhookStage) Process(ctx context.Context, req *StageRequest) ([]renderer.RenderedResource, error) {
+	review := StageReview{Request: &StageReviewRequest{
+		Namespace:     req.Namespace,
+		Project:       req.Project,
+		Component:     req.Component,
+		Environment:   req.Environment,
+		ComponentType: req.ComponentType,
+		Resources:     make([]StageResource, 0, len(req.Resources)),
+	}}
+	for _, r := range req.Resources {
+		review.Request.Resources = append(review.Request.Resources, StageResource{TargetPlane: r.TargetPlane, Resource: r.Resource})
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode review: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpResp, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(httpResp.Body, maxWebhookResponseBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if len(data) > maxWebhookResponseBytes {
+		return nil, fmt.Errorf("response exceeds %d bytes", maxWebhookResponseBytes)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("webhook returned %s", httpResp.Status)
+	}
+
+	var answer StageReview
+	if err := json.Unmarshal(data, &answer); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if answer.Response == nil {
+		return nil, fmt.Errorf("response is missing")
+	}
+	if answer.Response.Error != "" {
+		return nil, fmt.Errorf("rejected: %s", answer.Response.Error)
+	}
+	if answer.Response.Resources == nil {
+		return req.Resources, nil
+	}
+
+	resources := make([]renderer.RenderedResource, 0, len(answer.Response.Resources))
+	for i, r := range answer.Response.Resources {
+		if r.TargetPlane == "" || len(r.Resource) == 0 {
+			return nil, fmt.Errorf("resources[%d]: targetPlane and resource are required", i)
+		}
+		resources = append(resources, renderer.RenderedResource{TargetPlane: r.TargetPlane, Resource: r.Resource})
+	}
+	return resources, nil
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// renderWorkloadIdentity resolves the cloud identity requested by the ComponentType with the
+// component context. It returns an empty string when the type requests none.
+func (p *Pipeline) renderWorkloadIdentity(request *v1alpha1.WorkloadIdentityRequest, componentContextMap map[string]any) (string, error) {
+	if request == nil {
+		return "", nil
+	}
+	rendered, err := p.templateEngine.Render(request.Identity, componentContextMap)
+	if err != nil {
+		return "", err
+	}
+	identity, ok := rendered.(string)
+	if !ok {
+		return "", fmt.Errorf("identity must evaluate to a string, got %T", rendered)
+	}
+	identity = strings.TrimSpace(identity)
+	if identity == "" {
+		return "", fmt.Errorf("identity evaluated to an empty string")
+	}
+	return identity, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestRender_WorkloadIdentity(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *v1alpha1.WorkloadIdentityRequest
+		want    string
+		wantErr string
+	}{
+		{
+			name: "no request",
+		},
+		{
+			name:    "literal identity",
+			request: &v1alpha1.WorkloadIdentityRequest{Identity: "payments-reader"},
+			want:    "payments-reader",
+		},
+		{
+			name:    "templated identity",
+			request: &v1alpha1.WorkloadIdentityRequest{Identity: "${metadata.componentName}-${metadata.environmentName}"},
+			want:    "app-dev",
+		},
+		{
+			name:    "non-string identity",
+			request: &v1alpha1.WorkloadIdentityRequest{Identity: "${1 + 1}"},
+			wantErr: "identity must evaluate to a string",
+		},
+		{
+			name:    "empty identity",
+			request: &v1alpha1.WorkloadIdentityRequest{Identity: "${''}"},
+			wantErr: "identity evaluated to an empty string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			componentType := &v1alpha1.ComponentType{Spec: v1alpha1.ComponentTypeSpec{
+				Resources: []v1alpha1.ResourceTemplate{{
+					ID:       "deployment",
+					Template: &runtime.RawExtension{Raw: []byte(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "app"}}`)},
+				}},
+				WorkloadIdentity: tt.request,
+			}}
+			output, err := NewPipeline().Render(t.Context(), &RenderInput{
+				ComponentType: componentType,
+				Component:     &v1alpha1.Component{},
+				Workload:      &v1alpha1.Workload{},
+				Environment:   &v1alpha1.Environment{},
+				DataPlane:     &v1alpha1.DataPlane{},
+				Metadata:      postRenderTestMetadata(),
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Render() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if output.WorkloadIdentity != tt.want {
+				t.Errorf("WorkloadIdentity = %q, want %q", output.WorkloadIdentity, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package podspec locates the pod spec inside rendered workload resources so that
+// platform-level settings can be injected after rendering.
+package podspec
+
+// Find returns the pod spec map of a core, apps or batch workload resource, or nil when
+// the resource carries no pod spec. The returned map aliases the resource, so changes to
+// it are reflected in the resource.
+func Find(resource map[string]any) map[string]any {
+	pod := findPod(resource)
+	if pod == nil {
+		return nil
+	}
+	spec, _ := pod["spec"].(map[string]any)
+	return spec
+}
+
+// FindMetadata returns the metadata map of the pods of a workload resource that carries a
+// pod spec, creating it when the template sets none. It returns nil for other resources.
+// The returned map aliases the resource, like the one returned by Find.
+func FindMetadata(resource map[string]any) map[string]any {
+	pod := findPod(resource)
+	if pod == nil {
+		return nil
+	}
+	if _, ok := pod["spec"].(map[string]any); !ok {
+		return nil
+	}
+	metadata, ok := pod["metadata"].(map[string]any)
+	if !ok {
+		metadata = map[string]any{}
+		pod["metadata"] = metadata
+	}
+	return metadata
+}
+
+// findPod returns the map holding the pod metadata and spec: the resource itself for a
+// Pod, or the pod template of a workload.
+func findPod(resource map[string]any) map[string]any {
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+
+	var path []string
+	switch {
+	case apiVersion == "v1" && kind == "Pod":
+		path = nil
+	case apiVersion == "apps/v1" && (kind == "Deployment" || kind == "StatefulSet" || kind == "DaemonSet" || kind == "ReplicaSet"):
+		path = []string{"spec", "template"}
+	case apiVersion == "batch/v1" && kind == "Job":
+		path = []string{"spec", "template"}
+	case apiVersion == "batch/v1" && kind == "CronJob":
+		path = []string{"spec", "jobTemplate", "spec", "template"}
+	default:
+		return nil
+	}
+
+	current := resource
+	for _, key := range path {
+		next, ok := current[key].(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package podspec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	podSpec := map[string]any{"containers": []any{}}
+	templated := func(apiVersion, kind string) map[string]any {
+		return map[string]any{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"spec":       map[string]any{"template": map[string]any{"spec": podSpec}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		resource map[string]any
+		want     map[string]any
+	}{
+		{"pod", map[string]any{"apiVersion": "v1", "kind": "Pod", "spec": podSpec}, podSpec},
+		{"deployment", templated("apps/v1", "Deployment"), podSpec},
+		{"statefulset", templated("apps/v1", "StatefulSet"), podSpec},
+		{"job", templated("batch/v1", "Job"), podSpec},
+		{"cronjob", map[string]any{
+			"apiVersion": "batch/v1",
+			"kind":       "CronJob",
+			"spec": map[string]any{"jobTemplate": map[string]any{
+				"spec": map[string]any{"template": map[string]any{"spec": podSpec}},
+			}},
+		}, podSpec},
+		{"service", map[string]any{"apiVersion": "v1", "kind": "Service", "spec": map[string]any{}}, nil},
+		{"deployment without template", map[string]any{"apiVersion": "apps/v1", "kind": "Deployment"}, nil},
+		{"unknown group", templated("example.com/v1", "Deployment"), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Find(tt.resource))
+		})
+	}
+}
+
+func TestFindMetadata(t *testing.T) {
+	labels := map[string]any{"app": "web"}
+	deployment := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec": map[string]any{"template": map[string]any{
+			"metadata": map[string]any{"labels": labels},
+			"spec":     map[string]any{},
+		}},
+	}
+	assert.Equal(t, map[string]any{"labels": labels}, FindMetadata(deployment))
+
+	job := map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"spec":       map[string]any{"template": map[string]any{"spec": map[string]any{}}},
+	}
+	metadata := FindMetadata(job)
+	assert.NotNil(t, metadata)
+	metadata["labels"] = labels
+	assert.Equal(t, map[string]any{"labels": labels}, job["spec"].(map[string]any)["template"].(map[string]any)["metadata"],
+		"the created metadata aliases the resource")
+
+	assert.Nil(t, FindMetadata(map[string]any{"apiVersion": "apps/v1", "kind": "Deployment", "spec": map[string]any{}}))
+	assert.Nil(t, FindMetadata(map[string]any{"apiVersion": "v1", "kind": "ConfigMap"}))
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package recommender derives container resource requests and limits from observed
+// CPU and memory usage.
+package recommender
+
+import (
+	"math"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// MinSamples is the minimum number of usage samples per resource needed for a
+	// recommendation. Shorter histories are too noisy to size workloads from.
+	MinSamples = 12
+
+	// CPURequestPercentile and MemoryRequestPercentile are the usage percentiles requests
+	// are sized to. Memory is sized closer to the peak because running out of it kills
+	// the container, while running out of CPU only throttles it.
+	CPURequestPercentile    = 0.90
+	MemoryRequestPercentile = 0.95
+
+	// RequestMargin is the headroom added on top of the request percentile.
+	RequestMargin = 0.15
+	// CPULimitMargin and MemoryLimitMargin are the headroom added on top of peak usage.
+	CPULimitMargin    = 0.15
+	MemoryLimitMargin = 0.25
+
+	minCPUMillis   = 10
+	minMemoryBytes = 16 << 20
+	mebibyte       = 1 << 20
+)
+
+// Recommendation holds suggested requests and limits for a component's containers.
+type Recommendation struct {
+	CPURequest    resource.Quantity
+	CPULimit      resource.Quantity
+	MemoryRequest resource.Quantity
+	MemoryLimit   resource.Quantity
+}
+
+// Recommend sizes requests from a high percentile of usage and limits from peak usage,
+// each with headroom. cpu samples are in cores and memory samples in bytes. It returns
+// false when either series has fewer than MinSamples samples.
+func Recommend(cpu, memory []float64) (Recommendation, bool) {
+	if len(cpu) < MinSamples || len(memory) < MinSamples {
+		return Recommendation{}, false
+	}
+
+	cpuRequest := max(cpuMillis(Percentile(cpu, CPURequestPercentile)*(1+RequestMargin)), minCPUMillis)
+	cpuLimit := max(cpuMillis(Percentile(cpu, 1)*(1+CPULimitMargin)), cpuRequest)
+	memoryRequest := max(memoryBytes(Percentile(memory, MemoryRequestPercentile)*(1+RequestMargin)), minMemoryBytes)
+	memoryLimit := max(memoryBytes(Percentile(memory, 1)*(1+MemoryLimitMargin)), memoryRequest)
+
+	return Recommendation{
+		CPURequest:    *resource.NewMilliQuantity(cpuRequest, resource.DecimalSI),
+		CPULimit:      *resource.NewMilliQuantity(cpuLimit, resource.DecimalSI),
+		MemoryRequest: *resource.NewQuantity(memoryRequest, resource.BinarySI),
+		MemoryLimit:   *resource.NewQuantity(memoryLimit, resource.BinarySI),
+	}, true
+}
+
+// Percentile returns the p-th percentile (0 to 1) of values using the nearest-rank
+// method. It returns 0 for an empty slice and does not modify values.
+func Percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
+
+// cpuMillis rounds cores up to whole millicores.
+func cpuMillis(cores float64) int64 {
+	return int64(math.Ceil(cores * 1000))
+}
+
+// memoryBytes rounds bytes up to whole mebibytes so recommendations read as Mi values.
+func memoryBytes(bytes float64) int64 {
+	return int64(math.Ceil(bytes/mebibyte)) * mebibyte
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package recommender
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentile(t *testing.T) {
+	values := []float64{5, 1, 4, 2, 3, 6, 7, 8, 9, 10}
+	assert.InDelta(t, 9, Percentile(values, 0.9), 1e-9)
+	assert.InDelta(t, 10, Percentile(values, 1), 1e-9)
+	assert.InDelta(t, 1, Percentile(values, 0), 1e-9)
+	assert.InDelta(t, 0, Percentile(nil, 0.5), 1e-9)
+	assert.InDelta(t, 5, values[0], 1e-9, "input is not sorted in place")
+}
+
+func TestRecommend(t *testing.T) {
+	cpu := make([]float64, 20)
+	memory := make([]float64, 20)
+	for i := range cpu {
+		cpu[i] = 0.1 + 0.01*float64(i) // 0.10 .. 0.29 cores
+		memory[i] = float64(100+i) * mebibyte
+	}
+
+	rec, ok := Recommend(cpu, memory)
+	require.True(t, ok)
+	assert.Equal(t, "311m", rec.CPURequest.String())     // p90 0.27 * 1.15
+	assert.Equal(t, "334m", rec.CPULimit.String())       // peak 0.29 * 1.15
+	assert.Equal(t, "136Mi", rec.MemoryRequest.String()) // p95 118Mi * 1.15
+	assert.Equal(t, "149Mi", rec.MemoryLimit.String())   // peak 119Mi * 1.25
+}
+
+func TestRecommendFloorsAndInsufficientData(t *testing.T) {
+	idle := make([]float64, MinSamples)
+	rec, ok := Recommend(idle, idle)
+	require.True(t, ok)
+	assert.Equal(t, "10m", rec.CPURequest.String())
+	assert.Equal(t, "10m", rec.CPULimit.String())
+	assert.Equal(t, "16Mi", rec.MemoryRequest.String())
+	assert.Equal(t, "16Mi", rec.MemoryLimit.String())
+
+	_, ok = Recommend(idle[:MinSamples-1], idle)
+	assert.False(t, ok)
+}
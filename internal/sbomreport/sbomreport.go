@@ -0,0 +1,112 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sbomreport parses the software bill of materials produced by the SBOM step of
+// component workflows, such as the SBOM Cloud Native Buildpacks generate for the built image.
+package sbomreport
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// maxComponents is the number of components kept in the summary.
+const maxComponents = 100
+
+// document holds the fields of the CycloneDX and SPDX JSON formats needed to tell them apart.
+type document struct {
+	// CycloneDX
+	BOMFormat   string               `json:"bomFormat"`
+	SpecVersion string               `json:"specVersion"`
+	Components  []cycloneDXComponent `json:"components"`
+
+	// SPDX
+	SPDXVersion string        `json:"spdxVersion"`
+	Packages    []spdxPackage `json:"packages"`
+}
+
+type cycloneDXComponent struct {
+	Name       string               `json:"name"`
+	Version    string               `json:"version"`
+	PURL       string               `json:"purl"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type spdxPackage struct {
+	Name         string `json:"name"`
+	VersionInfo  string `json:"versionInfo"`
+	ExternalRefs []struct {
+		ReferenceType    string `json:"referenceType"`
+		ReferenceLocator string `json:"referenceLocator"`
+	} `json:"externalRefs"`
+}
+
+// Parse summarizes a CycloneDX or SPDX JSON document. Components that appear several times,
+// for example because more than one buildpack reports them, are counted once.
+func Parse(data []byte) (*openchoreov1alpha1.WorkflowSBOM, error) {
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid SBOM: %w", err)
+	}
+
+	sbom := &openchoreov1alpha1.WorkflowSBOM{Digest: Digest(data)}
+	var components []openchoreov1alpha1.SBOMComponent
+	switch {
+	case doc.BOMFormat == "CycloneDX":
+		sbom.Format = openchoreov1alpha1.SBOMFormatCycloneDX
+		sbom.SpecVersion = doc.SpecVersion
+		components = flattenCycloneDX(doc.Components, nil)
+	case doc.SPDXVersion != "":
+		sbom.Format = openchoreov1alpha1.SBOMFormatSPDX
+		sbom.SpecVersion = doc.SPDXVersion
+		for _, pkg := range doc.Packages {
+			component := openchoreov1alpha1.SBOMComponent{Name: pkg.Name, Version: pkg.VersionInfo}
+			for _, ref := range pkg.ExternalRefs {
+				if ref.ReferenceType == "purl" {
+					component.PURL = ref.ReferenceLocator
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	default:
+		return nil, errors.New("invalid SBOM: not a CycloneDX or SPDX JSON document")
+	}
+
+	components = slices.DeleteFunc(components, func(c openchoreov1alpha1.SBOMComponent) bool {
+		return c.Name == ""
+	})
+	slices.SortFunc(components, func(a, b openchoreov1alpha1.SBOMComponent) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Version, b.Version), cmp.Compare(a.PURL, b.PURL))
+	})
+	components = slices.Compact(components)
+
+	sbom.ComponentCount = int32(len(components))
+	if len(components) > maxComponents {
+		components = components[:maxComponents]
+	}
+	sbom.Components = components
+	return sbom, nil
+}
+
+// flattenCycloneDX appends the components and their nested components to out.
+func flattenCycloneDX(components []cycloneDXComponent, out []openchoreov1alpha1.SBOMComponent) []openchoreov1alpha1.SBOMComponent {
+	for _, c := range components {
+		out = append(out, openchoreov1alpha1.SBOMComponent{Name: c.Name, Version: c.Version, PURL: c.PURL})
+		out = flattenCycloneDX(c.Components, out)
+	}
+	return out
+}
+
+// Digest returns the sha256 digest of an SBOM document.
+func Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sbomreport
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const cycloneDXReport = `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.4",
+  "components": [
+    {"type": "library", "name": "express", "version": "4.19.2", "purl": "pkg:npm/express@4.19.2",
+     "components": [{"type": "library", "name": "body-parser", "version": "1.20.2", "purl": "pkg:npm/body-parser@1.20.2"}]},
+    {"type": "application", "name": "node", "version": "20.11.1"},
+    {"type": "library", "name": "express", "version": "4.19.2", "purl": "pkg:npm/express@4.19.2"},
+    {"type": "library", "name": ""}
+  ]
+}`
+
+const spdxReport = `{
+  "spdxVersion": "SPDX-2.3",
+  "packages": [
+    {"name": "requests", "versionInfo": "2.31.0",
+     "externalRefs": [
+       {"referenceType": "cpe23Type", "referenceLocator": "cpe:2.3:a:python:requests:2.31.0"},
+       {"referenceType": "purl", "referenceLocator": "pkg:pypi/requests@2.31.0"}
+     ]},
+    {"name": "certifi", "versionInfo": "2024.2.2"}
+  ]
+}`
+
+func TestParse_CycloneDX(t *testing.T) {
+	sbom, err := Parse([]byte(cycloneDXReport))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if sbom.Format != openchoreov1alpha1.SBOMFormatCycloneDX || sbom.SpecVersion != "1.4" {
+		t.Errorf("format = %s %s, want CycloneDX 1.4", sbom.Format, sbom.SpecVersion)
+	}
+	if sbom.ComponentCount != 3 {
+		t.Errorf("ComponentCount = %d, want 3", sbom.ComponentCount)
+	}
+	var names []string
+	for _, c := range sbom.Components {
+		names = append(names, c.Name)
+	}
+	if got := strings.Join(names, ","); got != "body-parser,express,node" {
+		t.Errorf("Components = %q, want nested and duplicate components resolved and sorted", got)
+	}
+	if sbom.Components[1].PURL != "pkg:npm/express@4.19.2" {
+		t.Errorf("Components[1].PURL = %q, want pkg:npm/express@4.19.2", sbom.Components[1].PURL)
+	}
+	if sbom.Digest != Digest([]byte(cycloneDXReport)) || !strings.HasPrefix(sbom.Digest, "sha256:") {
+		t.Errorf("Digest = %q, want the sha256 digest of the document", sbom.Digest)
+	}
+}
+
+func TestParse_SPDX(t *testing.T) {
+	sbom, err := Parse([]byte(spdxReport))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if sbom.Format != openchoreov1alpha1.SBOMFormatSPDX || sbom.SpecVersion != "SPDX-2.3" {
+		t.Errorf("format = %s %s, want SPDX SPDX-2.3", sbom.Format, sbom.SpecVersion)
+	}
+	if sbom.ComponentCount != 2 {
+		t.Fatalf("ComponentCount = %d, want 2", sbom.ComponentCount)
+	}
+	requests := sbom.Components[1]
+	if requests.Name != "requests" || requests.Version != "2.31.0" || requests.PURL != "pkg:pypi/requests@2.31.0" {
+		t.Errorf("Components[1] = %+v, want requests with its purl", requests)
+	}
+}
+
+func TestParse_ComponentsAreCapped(t *testing.T) {
+	var b strings.Builder
+	b.WriteString(`{"bomFormat": "CycloneDX", "specVersion": "1.5", "components": [`)
+	for i := range maxComponents + 5 {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"name": "lib-%03d", "version": "1.0.0"}`, i)
+	}
+	b.WriteString(`]}`)
+
+	sbom, err := Parse([]byte(b.String()))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if sbom.ComponentCount != maxComponents+5 {
+		t.Errorf("ComponentCount = %d, want %d", sbom.ComponentCount, maxComponents+5)
+	}
+	if len(sbom.Components) != maxComponents {
+		t.Errorf("len(Components) = %d, want %d", len(sbom.Components), maxComponents)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for name, report := range map[string]string{
+		"not json":       "express 4.19.2",
+		"unknown format": `{"artifacts": [], "schema": {"version": "16.0.0"}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse([]byte(report)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
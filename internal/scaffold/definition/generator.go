@@ -0,0 +1,224 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package definition scaffolds new ComponentTypes and Traits for local development.
+//
+// A scaffold is a directory holding the definition, a sample Component (with its
+// Workload, and for Traits a minimal ComponentType to attach to) and a render test
+// run by the harness in internal/pipeline/component/harness:
+//
+//	<name>/
+//	  componenttype.yaml | trait.yaml
+//	  samples/
+//	    component.yaml
+//	    workload.yaml
+//	    componenttype.yaml     (Traits only)
+//	  tests/
+//	    default.yaml
+package definition
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+const (
+	// DefaultNamespace is the namespace of the generated resources when none is given
+	DefaultNamespace = "default"
+	// DefaultWorkloadType is the workload type of generated ComponentTypes when none is given
+	DefaultWorkloadType = "deployment"
+
+	sampleName          = "sample"
+	sampleProject       = "sample-project"
+	sampleComponentType = "sample-service"
+	sampleTraitInstance = "data"
+)
+
+// WorkloadTypes lists the workload types ComponentTypes can be scaffolded for
+var WorkloadTypes = []string{"deployment", "statefulset", "cronjob", "job"}
+
+// workloadKinds maps a workload type to the kinds its scaffolded ComponentType renders
+var workloadKinds = map[string][]string{
+	"deployment":  {"Deployment", "Service"},
+	"statefulset": {"StatefulSet", "Service"},
+	"cronjob":     {"CronJob"},
+	"job":         {"Job"},
+}
+
+// Options configures the generators
+type Options struct {
+	// Name is the name of the ComponentType or Trait
+	Name string
+	// Namespace is the namespace of the generated resources. Defaults to DefaultNamespace.
+	Namespace string
+	// WorkloadType is the workload type of a ComponentType. Defaults to DefaultWorkloadType.
+	WorkloadType string
+}
+
+// File is a generated file
+type File struct {
+	// Path is relative to the scaffold directory
+	Path    string
+	Content []byte
+}
+
+// templateData is the data the templates are executed with
+type templateData struct {
+	Kind              string
+	Command           string
+	Name              string
+	Namespace         string
+	WorkloadType      string
+	ComponentTypeName string
+	TraitName         string
+	TraitInstanceName string
+	SampleName        string
+	SampleProject     string
+	Inputs            []string
+	Expect            []string
+}
+
+func (o Options) validate() error {
+	if errs := validation.IsDNS1123Label(o.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid name %q: %s", o.Name, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsDNS1123Label(o.Namespace); len(errs) > 0 {
+		return fmt.Errorf("invalid namespace %q: %s", o.Namespace, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (o Options) withDefaults() Options {
+	if o.Namespace == "" {
+		o.Namespace = DefaultNamespace
+	}
+	if o.WorkloadType == "" {
+		o.WorkloadType = DefaultWorkloadType
+	}
+	return o
+}
+
+// ComponentType generates the scaffold of a ComponentType
+func ComponentType(opts Options) ([]File, error) {
+	opts = opts.withDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	kinds, ok := workloadKinds[opts.WorkloadType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported workload type %q; supported types are %s",
+			opts.WorkloadType, strings.Join(WorkloadTypes, ", "))
+	}
+
+	data := templateData{
+		Kind:              "ComponentType",
+		Command:           "componenttype",
+		Name:              opts.Name,
+		Namespace:         opts.Namespace,
+		WorkloadType:      opts.WorkloadType,
+		ComponentTypeName: opts.Name,
+		SampleName:        sampleName,
+		SampleProject:     sampleProject,
+		Inputs:            []string{"../componenttype.yaml", "../samples/component.yaml", "../samples/workload.yaml"},
+		Expect:            kinds,
+	}
+	return execute(data, []fileTemplate{
+		{"componenttype.yaml", "componenttype.yaml.tmpl"},
+		{"samples/component.yaml", "component.yaml.tmpl"},
+		{"samples/workload.yaml", "workload.yaml.tmpl"},
+		{"tests/default.yaml", "test.yaml.tmpl"},
+	})
+}
+
+// Trait generates the scaffold of a Trait, with a sample ComponentType allowing it
+func Trait(opts Options) ([]File, error) {
+	opts = opts.withDefaults()
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	data := templateData{
+		Kind:              "Trait",
+		Command:           "trait",
+		Name:              opts.Name,
+		Namespace:         opts.Namespace,
+		WorkloadType:      DefaultWorkloadType,
+		ComponentTypeName: sampleComponentType,
+		TraitName:         opts.Name,
+		TraitInstanceName: sampleTraitInstance,
+		SampleName:        sampleName,
+		SampleProject:     sampleProject,
+		Inputs: []string{"../trait.yaml", "../samples/componenttype.yaml",
+			"../samples/component.yaml", "../samples/workload.yaml"},
+		Expect: []string{"Deployment", "PersistentVolumeClaim"},
+	}
+	return execute(data, []fileTemplate{
+		{"trait.yaml", "trait.yaml.tmpl"},
+		{"samples/componenttype.yaml", "trait-componenttype.yaml.tmpl"},
+		{"samples/component.yaml", "component.yaml.tmpl"},
+		{"samples/workload.yaml", "workload.yaml.tmpl"},
+		{"tests/default.yaml", "test.yaml.tmpl"},
+	})
+}
+
+type fileTemplate struct {
+	path     string
+	template string
+}
+
+func execute(data templateData, files []fileTemplate) ([]File, error) {
+	out := make([]File, 0, len(files))
+	for _, f := range files {
+		var buf bytes.Buffer
+		if err := templates.ExecuteTemplate(&buf, f.template, data); err != nil {
+			return nil, fmt.Errorf("failed to generate %s: %w", f.path, err)
+		}
+		out = append(out, File{Path: f.path, Content: buf.Bytes()})
+	}
+	return out, nil
+}
+
+// WriteFiles writes the files under dir. Unless force is set, it fails without writing
+// anything if any of the files already exists.
+func WriteFiles(dir string, files []File, force bool) error {
+	if !force {
+		var existing []string
+		for _, f := range files {
+			path := filepath.Join(dir, f.Path)
+			if _, err := os.Stat(path); err == nil {
+				existing = append(existing, path)
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+		if len(existing) > 0 {
+			slices.Sort(existing)
+			return fmt.Errorf("refusing to overwrite existing files (use --force): %s", strings.Join(existing, ", "))
+		}
+	}
+
+	for _, f := range files {
+		path := filepath.Join(dir, f.Path)
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.WriteFile(path, f.Content, 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	return nil
+}
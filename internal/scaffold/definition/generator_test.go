@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package definition
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/harness"
+)
+
+// requireRenderTestsPass writes the scaffold, records its golden files and checks that
+// its render tests pass against them
+func requireRenderTestsPass(t *testing.T, files []File) {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, WriteFiles(dir, files, false))
+
+	for _, update := range []bool{true, false} {
+		results, err := harness.RunDir(dir, update)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Passed(), "update=%v failures: %v", update, results[0].Failures)
+	}
+}
+
+func TestComponentType(t *testing.T) {
+	for _, workloadType := range WorkloadTypes {
+		t.Run(workloadType, func(t *testing.T) {
+			files, err := ComponentType(Options{Name: "web-app", WorkloadType: workloadType})
+			require.NoError(t, err)
+
+			paths := make([]string, 0, len(files))
+			for _, f := range files {
+				paths = append(paths, f.Path)
+			}
+			assert.Equal(t, []string{
+				"componenttype.yaml", "samples/component.yaml", "samples/workload.yaml", "tests/default.yaml",
+			}, paths)
+			assert.Contains(t, string(files[0].Content), "workloadType: "+workloadType)
+			assert.Contains(t, string(files[1].Content), "name: "+workloadType+"/web-app")
+
+			requireRenderTestsPass(t, files)
+		})
+	}
+}
+
+func TestComponentType_Invalid(t *testing.T) {
+	_, err := ComponentType(Options{Name: "Web_App"})
+	assert.ErrorContains(t, err, `invalid name "Web_App"`)
+
+	_, err = ComponentType(Options{Name: "web-app", Namespace: "Acme"})
+	assert.ErrorContains(t, err, `invalid namespace "Acme"`)
+
+	_, err = ComponentType(Options{Name: "gateway", WorkloadType: "proxy"})
+	assert.EqualError(t, err, `unsupported workload type "proxy"; supported types are deployment, statefulset, cronjob, job`)
+}
+
+func TestTrait(t *testing.T) {
+	files, err := Trait(Options{Name: "persistent-volume", Namespace: "acme"})
+	require.NoError(t, err)
+
+	byPath := make(map[string]string, len(files))
+	for _, f := range files {
+		byPath[f.Path] = string(f.Content)
+	}
+	assert.Contains(t, byPath["trait.yaml"], "name: persistent-volume\n  namespace: acme")
+	assert.Contains(t, byPath["samples/componenttype.yaml"], "allowedTraits:\n    - kind: Trait\n      name: persistent-volume")
+	assert.Contains(t, byPath["samples/component.yaml"], "instanceName: data")
+	assert.Contains(t, byPath["tests/default.yaml"], "  - ../trait.yaml\n")
+
+	requireRenderTestsPass(t, files)
+}
+
+func TestWriteFiles_RefusesOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	files := []File{{Path: "a.yaml", Content: []byte("a: 1\n")}, {Path: "sub/b.yaml", Content: []byte("b: 1\n")}}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("kept\n"), 0600))
+
+	err := WriteFiles(dir, files, false)
+	assert.ErrorContains(t, err, "refusing to overwrite existing files (use --force): "+filepath.Join(dir, "a.yaml"))
+	_, statErr := os.Stat(filepath.Join(dir, "sub", "b.yaml"))
+	assert.ErrorIs(t, statErr, os.ErrNotExist, "nothing is written when a file exists")
+
+	require.NoError(t, WriteFiles(dir, files, true))
+	data, err := os.ReadFile(filepath.Join(dir, "a.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "a: 1\n", string(data))
+}
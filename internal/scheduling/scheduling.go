@@ -0,0 +1,233 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scheduling merges the scheduling policies of a data plane, environment and
+// release binding, validates them against the data plane's node labels, and injects them
+// into rendered pod specs.
+package scheduling
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/podspec"
+)
+
+// MaxNodeLabelValues caps the number of distinct values recorded per node label key.
+// Keys with more values (for example kubernetes.io/hostname) are recorded without values.
+const MaxNodeLabelValues = 32
+
+// Merge combines policies given in increasing order of precedence. nodeSelector entries
+// are merged key by key, tolerations are accumulated without duplicates, and a topology
+// spread constraint replaces an earlier one with the same topologyKey. Nil policies are
+// skipped; the result is nil when no policy sets anything.
+func Merge(policies ...*openchoreov1alpha1.SchedulingPolicy) *openchoreov1alpha1.SchedulingPolicy {
+	merged := &openchoreov1alpha1.SchedulingPolicy{}
+	for _, p := range policies {
+		if p == nil {
+			continue
+		}
+		for k, v := range p.NodeSelector {
+			if merged.NodeSelector == nil {
+				merged.NodeSelector = make(map[string]string)
+			}
+			merged.NodeSelector[k] = v
+		}
+		for i := range p.Tolerations {
+			if !containsToleration(merged.Tolerations, &p.Tolerations[i]) {
+				merged.Tolerations = append(merged.Tolerations, p.Tolerations[i])
+			}
+		}
+		for _, c := range p.TopologySpreadConstraints {
+			idx := slices.IndexFunc(merged.TopologySpreadConstraints, func(existing corev1.TopologySpreadConstraint) bool {
+				return existing.TopologyKey == c.TopologyKey
+			})
+			if idx >= 0 {
+				merged.TopologySpreadConstraints[idx] = c
+			} else {
+				merged.TopologySpreadConstraints = append(merged.TopologySpreadConstraints, c)
+			}
+		}
+	}
+	if len(merged.NodeSelector) == 0 && len(merged.Tolerations) == 0 && len(merged.TopologySpreadConstraints) == 0 {
+		return nil
+	}
+	return merged
+}
+
+func containsToleration(tolerations []corev1.Toleration, t *corev1.Toleration) bool {
+	for i := range tolerations {
+		if tolerations[i].MatchToleration(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the policy can be satisfied by the data plane's nodes: every
+// nodeSelector entry must match a discovered label and every topologyKey must be a
+// discovered label key. Validation is skipped when no node labels have been discovered.
+func Validate(policy *openchoreov1alpha1.SchedulingPolicy, nodeLabels []openchoreov1alpha1.NodeLabel) error {
+	if policy == nil || len(nodeLabels) == 0 {
+		return nil
+	}
+	byKey := make(map[string]openchoreov1alpha1.NodeLabel, len(nodeLabels))
+	for _, l := range nodeLabels {
+		byKey[l.Key] = l
+	}
+
+	var errs []string
+	keys := make([]string, 0, len(policy.NodeSelector))
+	for k := range policy.NodeSelector {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := policy.NodeSelector[k]
+		label, ok := byKey[k]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("nodeSelector label %q is not set on any node", k))
+			continue
+		}
+		if len(label.Values) > 0 && !slices.Contains(label.Values, v) {
+			errs = append(errs, fmt.Sprintf("nodeSelector %s=%s matches no node; known values: %s",
+				k, v, strings.Join(label.Values, ", ")))
+		}
+	}
+	for _, c := range policy.TopologySpreadConstraints {
+		if _, ok := byKey[c.TopologyKey]; !ok {
+			errs = append(errs, fmt.Sprintf("topologyKey %q is not set on any node", c.TopologyKey))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("scheduling policy does not match the data plane nodes: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Apply injects the policy into the pod spec of every workload resource. Policy
+// nodeSelector entries override the template's; tolerations are appended unless already
+// present; topology spread constraints are added unless the template already spreads on
+// the same topologyKey. Constraints without a labelSelector select the component's pods
+// through podSelectors.
+func Apply(resources []map[string]any, policy *openchoreov1alpha1.SchedulingPolicy, podSelectors map[string]string) error {
+	if policy == nil {
+		return nil
+	}
+
+	tolerations := make([]map[string]any, 0, len(policy.Tolerations))
+	for i := range policy.Tolerations {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&policy.Tolerations[i])
+		if err != nil {
+			return fmt.Errorf("failed to convert toleration: %w", err)
+		}
+		tolerations = append(tolerations, u)
+	}
+	constraints := make([]map[string]any, 0, len(policy.TopologySpreadConstraints))
+	for _, c := range policy.TopologySpreadConstraints {
+		if c.LabelSelector == nil && len(podSelectors) > 0 {
+			c.LabelSelector = &metav1.LabelSelector{MatchLabels: podSelectors}
+		}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&c)
+		if err != nil {
+			return fmt.Errorf("failed to convert topology spread constraint: %w", err)
+		}
+		constraints = append(constraints, u)
+	}
+
+	for _, resource := range resources {
+		podSpec := podspec.Find(resource)
+		if podSpec == nil {
+			continue
+		}
+		if len(policy.NodeSelector) > 0 {
+			nodeSelector, _ := podSpec["nodeSelector"].(map[string]any)
+			if nodeSelector == nil {
+				nodeSelector = make(map[string]any, len(policy.NodeSelector))
+			}
+			for k, v := range policy.NodeSelector {
+				nodeSelector[k] = v
+			}
+			podSpec["nodeSelector"] = nodeSelector
+		}
+		if len(tolerations) > 0 {
+			existing, _ := podSpec["tolerations"].([]any)
+			for _, t := range tolerations {
+				if !slices.ContainsFunc(existing, func(e any) bool { return reflect.DeepEqual(e, t) }) {
+					existing = append(existing, runtime.DeepCopyJSON(t))
+				}
+			}
+			podSpec["tolerations"] = existing
+		}
+		if len(constraints) > 0 {
+			existing, _ := podSpec["topologySpreadConstraints"].([]any)
+			for _, c := range constraints {
+				if !slices.ContainsFunc(existing, func(e any) bool { return sameTopologyKey(e, c) }) {
+					existing = append(existing, runtime.DeepCopyJSON(c))
+				}
+			}
+			podSpec["topologySpreadConstraints"] = existing
+		}
+	}
+	return nil
+}
+
+// SetPriorityClassName sets priorityClassName on the pod spec of every workload resource
+// that does not already set one.
+func SetPriorityClassName(resources []map[string]any, name string) {
+	if name == "" {
+		return
+	}
+	for _, resource := range resources {
+		podSpec := podspec.Find(resource)
+		if podSpec == nil {
+			continue
+		}
+		if existing, _ := podSpec["priorityClassName"].(string); existing != "" {
+			continue
+		}
+		podSpec["priorityClassName"] = name
+	}
+}
+
+func sameTopologyKey(existing any, c map[string]any) bool {
+	m, ok := existing.(map[string]any)
+	return ok && m["topologyKey"] == c["topologyKey"]
+}
+
+// NodeLabelsFromNodes summarizes the labels of the given nodes, sorted by key. Values
+// are recorded only for keys with at most MaxNodeLabelValues distinct values.
+func NodeLabelsFromNodes(nodes []corev1.Node) []openchoreov1alpha1.NodeLabel {
+	values := make(map[string]map[string]struct{})
+	for i := range nodes {
+		for k, v := range nodes[i].Labels {
+			if values[k] == nil {
+				values[k] = make(map[string]struct{})
+			}
+			values[k][v] = struct{}{}
+		}
+	}
+
+	labels := make([]openchoreov1alpha1.NodeLabel, 0, len(values))
+	for k, vs := range values {
+		label := openchoreov1alpha1.NodeLabel{Key: k}
+		if len(vs) <= MaxNodeLabelValues {
+			label.Values = make([]string, 0, len(vs))
+			for v := range vs {
+				label.Values = append(label.Values, v)
+			}
+			sort.Strings(label.Values)
+		}
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].Key < labels[j].Key })
+	return labels
+}
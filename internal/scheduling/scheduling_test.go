@@ -0,0 +1,199 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/podspec"
+)
+
+var spotToleration = corev1.Toleration{
+	Key:      "cloud.example.com/spot",
+	Operator: corev1.TolerationOpExists,
+	Effect:   corev1.TaintEffectNoSchedule,
+}
+
+func zoneSpread(maxSkew int32) corev1.TopologySpreadConstraint {
+	return corev1.TopologySpreadConstraint{
+		MaxSkew:           maxSkew,
+		TopologyKey:       corev1.LabelTopologyZone,
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+	}
+}
+
+func TestMerge(t *testing.T) {
+	dataPlane := &openchoreov1alpha1.SchedulingPolicy{
+		NodeSelector:              map[string]string{"pool": "general", "arch": "amd64"},
+		Tolerations:               []corev1.Toleration{spotToleration},
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{zoneSpread(2)},
+	}
+	environment := &openchoreov1alpha1.SchedulingPolicy{
+		NodeSelector:              map[string]string{"pool": "prod"},
+		Tolerations:               []corev1.Toleration{spotToleration},
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{zoneSpread(1)},
+	}
+
+	got := Merge(dataPlane, nil, environment)
+	assert.Equal(t, &openchoreov1alpha1.SchedulingPolicy{
+		NodeSelector:              map[string]string{"pool": "prod", "arch": "amd64"},
+		Tolerations:               []corev1.Toleration{spotToleration},
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{zoneSpread(1)},
+	}, got)
+
+	assert.Nil(t, Merge(nil, &openchoreov1alpha1.SchedulingPolicy{}))
+}
+
+func TestValidate(t *testing.T) {
+	nodeLabels := []openchoreov1alpha1.NodeLabel{
+		{Key: corev1.LabelHostname},
+		{Key: corev1.LabelTopologyZone, Values: []string{"us-east-1a", "us-east-1b"}},
+		{Key: "pool", Values: []string{"general", "prod"}},
+	}
+
+	tests := []struct {
+		name    string
+		policy  *openchoreov1alpha1.SchedulingPolicy
+		wantErr string
+	}{
+		{"nil policy", nil, ""},
+		{"known label and value", &openchoreov1alpha1.SchedulingPolicy{
+			NodeSelector:              map[string]string{"pool": "prod", corev1.LabelHostname: "node-1"},
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{zoneSpread(1)},
+		}, ""},
+		{"unknown label", &openchoreov1alpha1.SchedulingPolicy{
+			NodeSelector: map[string]string{"gpu": "true"},
+		}, `nodeSelector label "gpu" is not set on any node`},
+		{"unknown value", &openchoreov1alpha1.SchedulingPolicy{
+			NodeSelector: map[string]string{"pool": "spot"},
+		}, "nodeSelector pool=spot matches no node; known values: general, prod"},
+		{"unknown topology key", &openchoreov1alpha1.SchedulingPolicy{
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{TopologyKey: "rack"}},
+		}, `topologyKey "rack" is not set on any node`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.policy, nodeLabels)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("skipped without discovered labels", func(t *testing.T) {
+		require.NoError(t, Validate(&openchoreov1alpha1.SchedulingPolicy{
+			NodeSelector: map[string]string{"gpu": "true"},
+		}, nil))
+	})
+}
+
+func TestApply(t *testing.T) {
+	deployment := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+			"nodeSelector": map[string]any{"pool": "general", "disk": "ssd"},
+			"topologySpreadConstraints": []any{map[string]any{
+				"maxSkew":           int64(3),
+				"topologyKey":       corev1.LabelTopologyZone,
+				"whenUnsatisfiable": "DoNotSchedule",
+			}},
+		}}},
+	}
+	job := map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"spec":       map[string]any{"template": map[string]any{"spec": map[string]any{}}},
+	}
+	service := map[string]any{"apiVersion": "v1", "kind": "Service", "spec": map[string]any{}}
+
+	policy := &openchoreov1alpha1.SchedulingPolicy{
+		NodeSelector: map[string]string{"pool": "prod"},
+		Tolerations:  []corev1.Toleration{spotToleration},
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+			zoneSpread(1),
+			{MaxSkew: 1, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.ScheduleAnyway,
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "custom"}}},
+		},
+	}
+	require.NoError(t, Apply([]map[string]any{deployment, job, service}, policy,
+		map[string]string{"openchoreo.dev/component-uid": "abc"}))
+
+	deploySpec := podspec.Find(deployment)
+	assert.Equal(t, map[string]any{"pool": "prod", "disk": "ssd"}, deploySpec["nodeSelector"])
+	assert.Equal(t, []any{map[string]any{
+		"key":      "cloud.example.com/spot",
+		"operator": "Exists",
+		"effect":   "NoSchedule",
+	}}, deploySpec["tolerations"])
+	constraints := deploySpec["topologySpreadConstraints"].([]any)
+	require.Len(t, constraints, 2, "the template's zone constraint is kept")
+	assert.Equal(t, "DoNotSchedule", constraints[0].(map[string]any)["whenUnsatisfiable"])
+	assert.Equal(t, map[string]any{"matchLabels": map[string]any{"app": "custom"}},
+		constraints[1].(map[string]any)["labelSelector"])
+
+	jobSpec := podspec.Find(job)
+	assert.Equal(t, map[string]any{"pool": "prod"}, jobSpec["nodeSelector"])
+	jobConstraints := jobSpec["topologySpreadConstraints"].([]any)
+	require.Len(t, jobConstraints, 2)
+	assert.Equal(t, map[string]any{"matchLabels": map[string]any{"openchoreo.dev/component-uid": "abc"}},
+		jobConstraints[0].(map[string]any)["labelSelector"], "defaults to the component pod selectors")
+
+	assert.Empty(t, service["spec"])
+
+	// Applying twice must not duplicate tolerations or constraints.
+	require.NoError(t, Apply([]map[string]any{job}, policy, nil))
+	assert.Len(t, podspec.Find(job)["tolerations"], 1)
+	assert.Len(t, podspec.Find(job)["topologySpreadConstraints"], 2)
+}
+
+func TestSetPriorityClassName(t *testing.T) {
+	deployment := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec":       map[string]any{"template": map[string]any{"spec": map[string]any{}}},
+	}
+	pinned := map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"spec": map[string]any{"template": map[string]any{"spec": map[string]any{
+			"priorityClassName": "batch-low",
+		}}},
+	}
+	configMap := map[string]any{"apiVersion": "v1", "kind": "ConfigMap"}
+
+	SetPriorityClassName([]map[string]any{deployment, pinned, configMap}, "production-high")
+
+	assert.Equal(t, "production-high", podspec.Find(deployment)["priorityClassName"])
+	assert.Equal(t, "batch-low", podspec.Find(pinned)["priorityClassName"], "template value is kept")
+	assert.NotContains(t, configMap, "spec")
+}
+
+func TestNodeLabelsFromNodes(t *testing.T) {
+	nodes := make([]corev1.Node, 0, MaxNodeLabelValues+1)
+	for i := 0; i <= MaxNodeLabelValues; i++ {
+		zone := "zone-a"
+		if i%2 == 1 {
+			zone = "zone-b"
+		}
+		nodes = append(nodes, corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			corev1.LabelHostname:     fmt.Sprintf("node-%d", i),
+			corev1.LabelTopologyZone: zone,
+		}}})
+	}
+
+	assert.Equal(t, []openchoreov1alpha1.NodeLabel{
+		{Key: corev1.LabelHostname},
+		{Key: corev1.LabelTopologyZone, Values: []string{"zone-a", "zone-b"}},
+	}, NodeLabelsFromNodes(nodes))
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secretexpiry finds TLS certificates that are about to expire and decides when
+// the planes are scanned for them.
+package secretexpiry
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Severity classifies how close a certificate is to its expiry.
+type Severity string
+
+const (
+	// SeverityNone means the certificate is valid beyond the warning threshold.
+	SeverityNone Severity = ""
+	// SeverityWarning means the certificate expires within the warning threshold.
+	SeverityWarning Severity = "Warning"
+	// SeverityCritical means the certificate expires within the critical threshold.
+	SeverityCritical Severity = "Critical"
+	// SeverityExpired means the certificate has expired.
+	SeverityExpired Severity = "Expired"
+)
+
+// Thresholds are how long before its expiry a certificate is reported.
+type Thresholds struct {
+	Warning  time.Duration
+	Critical time.Duration
+}
+
+// ErrNoCertificate is returned when PEM data holds no certificate.
+var ErrNoCertificate = errors.New("no PEM encoded certificate found")
+
+// LeafCertificate parses the first certificate of PEM data, such as the tls.crt key of a
+// kubernetes.io/tls Secret. The first certificate is the leaf; any that follow are the
+// chain.
+func LeafCertificate(data []byte) (*x509.Certificate, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, ErrNoCertificate
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		return cert, nil
+	}
+}
+
+// Classify returns the severity of a certificate that expires at notAfter.
+func Classify(notAfter, now time.Time, thresholds Thresholds) Severity {
+	remaining := notAfter.Sub(now)
+	switch {
+	case remaining <= 0:
+		return SeverityExpired
+	case remaining <= thresholds.Critical:
+		return SeverityCritical
+	case remaining <= thresholds.Warning:
+		return SeverityWarning
+	default:
+		return SeverityNone
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretexpiry
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule_Next(t *testing.T) {
+	from := time.Date(2026, 3, 14, 10, 17, 42, 0, time.UTC) // a Saturday
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, 3, 14, 10, 18, 0, 0, time.UTC)},
+		{"0 */6 * * *", time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)},
+		{"30 9 * * *", time.Date(2026, 3, 15, 9, 30, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2026, 3, 14, 10, 25, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Day of month and day of week are combined with OR when both are restricted.
+		{"0 0 20 * 1", time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{"0,45 10 14 3 *", time.Date(2026, 3, 14, 10, 45, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			s, err := ParseSchedule(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s.Next(from))
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"0 0 30 2 *",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := ParseSchedule(expr)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLeafCertificate(t *testing.T) {
+	notAfter := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	leaf := testCertificatePEM(t, "api.example.com", notAfter)
+	chain := testCertificatePEM(t, "Example CA", notAfter.AddDate(5, 0, 0))
+	key := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("not a key")})
+
+	cert, err := LeafCertificate(append(append(key, leaf...), chain...))
+	require.NoError(t, err)
+	assert.Equal(t, "api.example.com", cert.Subject.CommonName)
+	assert.Equal(t, notAfter, cert.NotAfter.UTC())
+
+	_, err = LeafCertificate([]byte("garbage"))
+	require.ErrorIs(t, err, ErrNoCertificate)
+
+	_, err = LeafCertificate(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("bad")}))
+	require.Error(t, err)
+}
+
+func TestClassify(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	thresholds := Thresholds{Warning: 30 * 24 * time.Hour, Critical: 7 * 24 * time.Hour}
+	tests := []struct {
+		name     string
+		notAfter time.Time
+		want     Severity
+	}{
+		{"valid", now.AddDate(0, 2, 0), SeverityNone},
+		{"warning", now.AddDate(0, 0, 20), SeverityWarning},
+		{"critical", now.AddDate(0, 0, 3), SeverityCritical},
+		{"expired", now.Add(-time.Minute), SeverityExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Classify(tt.notAfter, now, thresholds))
+		})
+	}
+}
+
+func testCertificatePEM(t *testing.T, commonName string, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
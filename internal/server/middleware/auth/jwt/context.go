@@ -20,9 +20,19 @@ const (
 	tokenContextKey contextKey = "jwt_token"
 )
 
+// WithClaims stores the validated JWT claims in the context
+func WithClaims(ctx context.Context, claims jwt.MapClaims) context.Context {
+	return context.WithValue(ctx, claimsContextKey, claims)
+}
+
 // GetClaims retrieves the JWT claims from the request context
 func GetClaims(r *http.Request) (jwt.MapClaims, bool) {
-	claims, ok := r.Context().Value(claimsContextKey).(jwt.MapClaims)
+	return GetClaimsFromContext(r.Context())
+}
+
+// GetClaimsFromContext retrieves the JWT claims from a context.Context
+func GetClaimsFromContext(ctx context.Context) (jwt.MapClaims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(jwt.MapClaims)
 	return claims, ok
 }
 
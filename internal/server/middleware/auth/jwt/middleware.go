@@ -178,7 +178,7 @@ func Middleware(config Config) func(http.Handler) http.Handler {
 			}
 
 			// Add claims and token to request context
-			ctx := context.WithValue(r.Context(), claimsContextKey, claims)
+			ctx := WithClaims(r.Context(), claims)
 			ctx = context.WithValue(ctx, tokenContextKey, tokenString)
 
 			// Resolve SubjectContext if detector is provided
@@ -0,0 +1,46 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package session
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// Middleware authenticates requests that carry a session token of the issuer in the
+// Authorization header and passes every other request to fallback, typically the IdP JWT
+// middleware. For session tokens, the subject and the viewable namespaces are stored in the
+// request context.
+func Middleware(issuer *Issuer, fallback func(http.Handler) http.Handler, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		idp := fallback(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || !issuer.IssuedBy(token) {
+				idp.ServeHTTP(w, r)
+				return
+			}
+
+			claims, err := issuer.Verify(token)
+			if err != nil {
+				logger.Debug("Session token validation failed", "error", err, "path", r.URL.Path, "method", r.Method)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]string{
+					"error":   "INVALID_TOKEN",
+					"message": ErrInvalidToken.Error(),
+				})
+				return
+			}
+
+			ctx := auth.SetSubjectContext(r.Context(), claims.SubjectContext())
+			ctx = WithNamespaces(ctx, claims.Namespaces)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
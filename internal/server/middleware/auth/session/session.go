@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package session issues and verifies short-lived OpenChoreo session tokens.
+//
+// A session token is exchanged for an IdP access token. It carries the subject resolved from
+// the IdP token and the namespaces the subject was allowed to view when the token was issued,
+// so that namespace visibility checks can be answered without calling the policy decision point.
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// MinSigningKeyLength is the minimum HMAC signing key length in bytes.
+const MinSigningKeyLength = 32
+
+var (
+	// ErrInvalidToken is returned when a session token is malformed, expired or not signed by the issuer.
+	ErrInvalidToken = errors.New("invalid or expired session token")
+	// ErrMissingSubject is returned when a token is requested without an authenticated subject.
+	ErrMissingSubject = errors.New("session token requires an authenticated subject")
+)
+
+// Claims are the claims of a session token.
+type Claims struct {
+	jwt.RegisteredClaims
+	// SubjectType is the resolved subject type, e.g. "user".
+	SubjectType string `json:"oc_subject_type"`
+	// EntitlementClaim and Entitlements are the entitlements resolved from the IdP token.
+	EntitlementClaim string   `json:"oc_entitlement_claim"`
+	Entitlements     []string `json:"oc_entitlements"`
+	// Namespaces are the namespaces the subject was allowed to view at issue time.
+	Namespaces []string `json:"oc_namespaces"`
+}
+
+// SubjectContext returns the subject the token was issued to.
+func (c *Claims) SubjectContext() *auth.SubjectContext {
+	return &auth.SubjectContext{
+		ID:                c.Subject,
+		Type:              c.SubjectType,
+		EntitlementClaim:  c.EntitlementClaim,
+		EntitlementValues: c.Entitlements,
+	}
+}
+
+// Issuer issues and verifies session tokens signed with an HMAC key.
+type Issuer struct {
+	name string
+	key  []byte
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+// NewIssuer creates an issuer. name is used as the iss claim and identifies session tokens.
+func NewIssuer(name string, key []byte, ttl time.Duration) (*Issuer, error) {
+	if name == "" {
+		return nil, fmt.Errorf("session token issuer name is required")
+	}
+	if len(key) < MinSigningKeyLength {
+		return nil, fmt.Errorf("session token signing key must be at least %d bytes", MinSigningKeyLength)
+	}
+	if ttl <= 0 {
+		return nil, fmt.Errorf("session token TTL must be positive")
+	}
+	return &Issuer{name: name, key: key, ttl: ttl, now: time.Now}, nil
+}
+
+// Issue returns a signed token for the subject with the given viewable namespaces, and its expiry.
+func (i *Issuer) Issue(subject *auth.SubjectContext, namespaces []string) (string, time.Time, error) {
+	if subject == nil || subject.ID == "" {
+		return "", time.Time{}, ErrMissingSubject
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate token id: %w", err)
+	}
+
+	now := i.now().Truncate(time.Second)
+	expiresAt := now.Add(i.ttl)
+	ns := slices.Clone(namespaces)
+	slices.Sort(ns)
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.name,
+			Subject:   subject.ID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			ID:        hex.EncodeToString(id),
+		},
+		SubjectType:      subject.Type,
+		EntitlementClaim: subject.EntitlementClaim,
+		Entitlements:     subject.EntitlementValues,
+		Namespaces:       ns,
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.key)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign session token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+// IssuedBy reports whether an (unverified) token claims to be a session token of this issuer.
+func (i *Issuer) IssuedBy(token string) bool {
+	var claims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil {
+		return false
+	}
+	return claims.Issuer == i.name
+}
+
+// Verify validates the signature, issuer and lifetime of a session token and returns its claims.
+func (i *Issuer) Verify(token string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return i.key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(i.name),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(i.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	return claims, nil
+}
+
+type contextKey struct{}
+
+// WithNamespaces stores the viewable namespaces of a session token in the context.
+func WithNamespaces(ctx context.Context, namespaces []string) context.Context {
+	return context.WithValue(ctx, contextKey{}, namespaces)
+}
+
+// NamespacesFromContext returns the viewable namespaces of the session token that
+// authenticated the request. ok is false when the request was not authenticated with a
+// session token.
+func NamespacesFromContext(ctx context.Context) (namespaces []string, ok bool) {
+	namespaces, ok = ctx.Value(contextKey{}).([]string)
+	return namespaces, ok
+}
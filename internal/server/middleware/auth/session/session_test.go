@@ -0,0 +1,211 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package session
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestIssuer(t *testing.T, name string, key []byte) *Issuer {
+	t.Helper()
+	issuer, err := NewIssuer(name, key, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("NewIssuer() error = %v", err)
+	}
+	return issuer
+}
+
+func testSubject() *auth.SubjectContext {
+	return &auth.SubjectContext{
+		ID:                "alice",
+		Type:              "user",
+		EntitlementClaim:  "groups",
+		EntitlementValues: []string{"developers"},
+	}
+}
+
+func TestNewIssuer(t *testing.T) {
+	if _, err := NewIssuer("", testKey, time.Minute); err == nil {
+		t.Error("expected error for empty name")
+	}
+	if _, err := NewIssuer("oc", testKey[:16], time.Minute); err == nil {
+		t.Error("expected error for short key")
+	}
+	if _, err := NewIssuer("oc", testKey, 0); err == nil {
+		t.Error("expected error for zero TTL")
+	}
+}
+
+func TestIssueAndVerify(t *testing.T) {
+	issuer := newTestIssuer(t, "oc", testKey)
+
+	token, expiresAt, err := issuer.Issue(testSubject(), []string{"payments", "acme"})
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if d := time.Until(expiresAt); d <= 4*time.Minute || d > 5*time.Minute {
+		t.Errorf("expiresAt in %v, want ~5m", d)
+	}
+	if !issuer.IssuedBy(token) {
+		t.Error("IssuedBy() = false for own token")
+	}
+
+	claims, err := issuer.Verify(token)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if want := []string{"acme", "payments"}; !slices.Equal(claims.Namespaces, want) {
+		t.Errorf("Namespaces = %v, want %v", claims.Namespaces, want)
+	}
+	subject := claims.SubjectContext()
+	if subject.ID != "alice" || subject.Type != "user" || subject.EntitlementClaim != "groups" ||
+		!slices.Equal(subject.EntitlementValues, []string{"developers"}) {
+		t.Errorf("SubjectContext() = %+v", subject)
+	}
+}
+
+func TestIssueRequiresSubject(t *testing.T) {
+	issuer := newTestIssuer(t, "oc", testKey)
+	if _, _, err := issuer.Issue(&auth.SubjectContext{}, nil); !errors.Is(err, ErrMissingSubject) {
+		t.Errorf("Issue() error = %v, want ErrMissingSubject", err)
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	issuer := newTestIssuer(t, "oc", testKey)
+
+	otherKey := newTestIssuer(t, "oc", []byte("fedcba9876543210fedcba9876543210"))
+	forged, _, err := otherKey.Issue(testSubject(), []string{"acme"})
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	expiredIssuer := newTestIssuer(t, "oc", testKey)
+	expiredIssuer.now = func() time.Time { return time.Now().Add(-time.Hour) }
+	expired, _, err := expiredIssuer.Issue(testSubject(), []string{"acme"})
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	noneAlg, err := jwt.NewWithClaims(jwt.SigningMethodNone, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "oc",
+			Subject:   "alice",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatalf("SignedString() error = %v", err)
+	}
+
+	otherIssuer := newTestIssuer(t, "idp", testKey)
+	wrongIssuer, _, err := otherIssuer.Issue(testSubject(), []string{"acme"})
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	tests := map[string]string{
+		"wrong key":    forged,
+		"expired":      expired,
+		"none alg":     noneAlg,
+		"wrong issuer": wrongIssuer,
+		"malformed":    "not-a-token",
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := issuer.Verify(token); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Verify() error = %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	issuer := newTestIssuer(t, "oc", testKey)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	var fallbackCalled bool
+	fallback := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fallbackCalled = true
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	var gotSubject *auth.SubjectContext
+	var gotNamespaces []string
+	var gotSession bool
+	handler := Middleware(issuer, fallback, logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSubject, _ = auth.GetSubjectContextFromContext(r.Context())
+		gotNamespaces, gotSession = NamespacesFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(authorization string) *httptest.ResponseRecorder {
+		fallbackCalled, gotSubject, gotNamespaces, gotSession = false, nil, nil, false
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("session token is authenticated", func(t *testing.T) {
+		token, _, err := issuer.Issue(testSubject(), []string{"acme"})
+		if err != nil {
+			t.Fatalf("Issue() error = %v", err)
+		}
+		rec := serve("Bearer " + token)
+		if rec.Code != http.StatusOK || fallbackCalled {
+			t.Fatalf("status = %d, fallbackCalled = %v", rec.Code, fallbackCalled)
+		}
+		if gotSubject == nil || gotSubject.ID != "alice" {
+			t.Errorf("subject = %+v, want alice", gotSubject)
+		}
+		if !gotSession || !slices.Equal(gotNamespaces, []string{"acme"}) {
+			t.Errorf("namespaces = %v (session %v), want [acme]", gotNamespaces, gotSession)
+		}
+	})
+
+	t.Run("other tokens go to the fallback", func(t *testing.T) {
+		idpIssuer := newTestIssuer(t, "https://idp.example.com", testKey)
+		token, _, err := idpIssuer.Issue(testSubject(), nil)
+		if err != nil {
+			t.Fatalf("Issue() error = %v", err)
+		}
+		for _, authorization := range []string{"Bearer " + token, "Bearer opaque", ""} {
+			rec := serve(authorization)
+			if rec.Code != http.StatusOK || !fallbackCalled || gotSession {
+				t.Errorf("%q: status = %d, fallbackCalled = %v, session = %v", authorization, rec.Code, fallbackCalled, gotSession)
+			}
+		}
+	})
+
+	t.Run("invalid session token is rejected", func(t *testing.T) {
+		forger := newTestIssuer(t, "oc", []byte("fedcba9876543210fedcba9876543210"))
+		token, _, err := forger.Issue(testSubject(), []string{"acme"})
+		if err != nil {
+			t.Fatalf("Issue() error = %v", err)
+		}
+		rec := serve("Bearer " + token)
+		if rec.Code != http.StatusUnauthorized || fallbackCalled {
+			t.Errorf("status = %d, fallbackCalled = %v, want 401 without fallback", rec.Code, fallbackCalled)
+		}
+	})
+}
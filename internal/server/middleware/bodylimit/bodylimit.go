@@ -0,0 +1,193 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bodylimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Code is the error code of the 413 responses.
+const Code = "PAYLOAD_TOO_LARGE"
+
+// Config configures request body size limits.
+type Config struct {
+	// MaxBytes is the body size limit of requests that match no route. Zero disables it.
+	MaxBytes int64
+	// Routes override the limit for the requests they match.
+	Routes []Route
+	// Guidance is added to the 413 responses of routes without their own guidance.
+	Guidance string
+}
+
+// Route is the body size limit of the requests that match a pattern.
+type Route struct {
+	// Pattern is an http.ServeMux pattern such as
+	// "PUT /api/v1/namespaces/{namespaceName}/workloads/{workloadName}". When several
+	// patterns match a request, the most specific one wins.
+	Pattern string
+	// MaxBytes is the body size limit of the matching requests. Zero disables it.
+	MaxBytes int64
+	// Guidance is added to the 413 responses, such as how to send a smaller payload.
+	Guidance string
+}
+
+// Limit is the body size limit applied to a request.
+type Limit struct {
+	// Pattern is the route pattern that set the limit, empty for the default limit.
+	Pattern  string
+	MaxBytes int64
+	Guidance string
+}
+
+// Enabled reports whether the config limits any request body.
+func (c Config) Enabled() bool {
+	if c.MaxBytes > 0 {
+		return true
+	}
+	for _, route := range c.Routes {
+		if route.MaxBytes > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the route patterns are valid and do not conflict.
+func (c Config) Validate() error {
+	_, err := newMatcher(c)
+	return err
+}
+
+type contextKey struct{}
+
+// FromContext returns the body size limit the middleware applied to a request.
+func FromContext(ctx context.Context) (Limit, bool) {
+	limit, ok := ctx.Value(contextKey{}).(Limit)
+	return limit, ok
+}
+
+// Middleware returns an HTTP middleware that limits the size of request bodies. Requests
+// that declare a larger Content-Length get a 413 without their body being read; other
+// bodies are wrapped with http.MaxBytesReader so handlers that stream or decode them fail
+// with an *http.MaxBytesError once the limit is crossed. Handlers can answer such errors
+// with WriteError and the Limit from FromContext.
+//
+// Middleware panics if the config does not pass Validate.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	if !cfg.Enabled() {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	m, err := newMatcher(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("bodylimit: %v", err))
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limit := m.match(r)
+			if limit.MaxBytes <= 0 || r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if r.ContentLength > limit.MaxBytes {
+				WriteError(w, limit)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, limit.MaxBytes)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey{}, limit)))
+		})
+	}
+}
+
+// Message returns the error message of a request body over a limit.
+func Message(limit Limit) string {
+	msg := "request body exceeds the limit of " + formatBytes(limit.MaxBytes)
+	if limit.Pattern != "" {
+		msg += " for " + limit.Pattern
+	}
+	if limit.Guidance != "" {
+		msg += ". " + limit.Guidance
+	}
+	return msg
+}
+
+// WriteError writes the 413 response of a request body over a limit.
+func WriteError(w http.ResponseWriter, limit Limit) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": Message(limit), "code": Code})
+}
+
+// formatBytes formats a byte count in the largest binary unit that divides it.
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20 && n%(1<<20) == 0:
+		return fmt.Sprintf("%d MiB", n>>20)
+	case n >= 1<<10 && n%(1<<10) == 0:
+		return fmt.Sprintf("%d KiB", n>>10)
+	default:
+		return fmt.Sprintf("%d bytes", n)
+	}
+}
+
+// matcher finds the limit of a request with an http.ServeMux, so route patterns follow
+// the same syntax and precedence rules as the routes of the server.
+type matcher struct {
+	mux      *http.ServeMux
+	routes   map[string]Route
+	fallback Limit
+}
+
+func newMatcher(cfg Config) (m *matcher, err error) {
+	m = &matcher{
+		mux:      http.NewServeMux(),
+		routes:   make(map[string]Route, len(cfg.Routes)),
+		fallback: Limit{MaxBytes: cfg.MaxBytes, Guidance: cfg.Guidance},
+	}
+	for _, route := range cfg.Routes {
+		if route.MaxBytes < 0 {
+			return nil, fmt.Errorf("route %q: max bytes must be non-negative", route.Pattern)
+		}
+		if err := m.handle(route.Pattern); err != nil {
+			return nil, err
+		}
+		m.routes[route.Pattern] = route
+	}
+	return m, nil
+}
+
+// handle registers a pattern, turning the panic of an invalid or conflicting pattern
+// into an error.
+func (m *matcher) handle(pattern string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			// Conflict panics name the source lines of both registrations before
+			// explaining the conflict on the last line, which is all that matters here.
+			msg := fmt.Sprint(r)
+			if i := strings.LastIndex(msg, "\n"); i >= 0 {
+				msg = msg[i+1:]
+			}
+			err = fmt.Errorf("route %q: %s", pattern, msg)
+		}
+	}()
+	m.mux.Handle(pattern, http.NotFoundHandler())
+	return nil
+}
+
+func (m *matcher) match(r *http.Request) Limit {
+	_, pattern := m.mux.Handler(r)
+	route, ok := m.routes[pattern]
+	if !ok {
+		return m.fallback
+	}
+	guidance := route.Guidance
+	if guidance == "" {
+		guidance = m.fallback.Guidance
+	}
+	return Limit{Pattern: route.Pattern, MaxBytes: route.MaxBytes, Guidance: guidance}
+}
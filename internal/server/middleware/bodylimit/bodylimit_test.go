@@ -0,0 +1,112 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bodylimit
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readAll is a handler that reads the whole body and answers read errors like the API
+// request error handler does.
+var readAll = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if _, err := io.ReadAll(r.Body); err != nil {
+		if _, ok := errors.AsType[*http.MaxBytesError](err); ok {
+			limit, _ := FromContext(r.Context())
+			WriteError(w, limit)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+})
+
+func TestMiddleware(t *testing.T) {
+	cfg := Config{
+		MaxBytes: 8,
+		Guidance: "Reduce the request size.",
+		Routes: []Route{
+			{Pattern: "PUT /workloads/{name}", MaxBytes: 16, Guidance: "Mount large files from a secret."},
+			{Pattern: "POST /imports", MaxBytes: 0},
+		},
+	}
+	handler := Middleware(cfg)(readAll)
+
+	serve := func(method, path, body string, chunked bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if chunked {
+			// Streamed bodies have no Content-Length, so only the reader enforces the limit
+			req.ContentLength = -1
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		size    int
+		chunked bool
+		want    int
+		wantMsg string
+	}{
+		{name: "default limit allows small bodies", method: http.MethodPost, path: "/other", size: 8, want: http.StatusOK},
+		{name: "default limit rejects declared length", method: http.MethodPost, path: "/other", size: 9, want: http.StatusRequestEntityTooLarge,
+			wantMsg: "request body exceeds the limit of 8 bytes. Reduce the request size."},
+		{name: "default limit rejects streamed bodies", method: http.MethodPost, path: "/other", size: 9, chunked: true, want: http.StatusRequestEntityTooLarge},
+		{name: "route raises the limit", method: http.MethodPut, path: "/workloads/api", size: 16, want: http.StatusOK},
+		{name: "route limit rejects with route guidance", method: http.MethodPut, path: "/workloads/api", size: 17, chunked: true, want: http.StatusRequestEntityTooLarge,
+			wantMsg: "request body exceeds the limit of 16 bytes for PUT /workloads/{name}. Mount large files from a secret."},
+		{name: "route method must match", method: http.MethodPost, path: "/workloads/api", size: 9, want: http.StatusRequestEntityTooLarge},
+		{name: "zero route limit disables the limit", method: http.MethodPost, path: "/imports", size: 1 << 10, want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(tt.method, tt.path, strings.Repeat("x", tt.size), tt.chunked)
+			require.Equal(t, tt.want, w.Code)
+			if tt.want != http.StatusRequestEntityTooLarge {
+				return
+			}
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			var body map[string]string
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, Code, body["code"])
+			if tt.wantMsg != "" {
+				assert.Equal(t, tt.wantMsg, body["error"])
+			}
+		})
+	}
+
+	t.Run("disabled passes every request through", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		Middleware(Config{})(readAll).ServeHTTP(w,
+			httptest.NewRequest(http.MethodPost, "/", strings.NewReader(strings.Repeat("x", 1<<20))))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, Config{MaxBytes: 1, Routes: []Route{{Pattern: "PUT /a/{b}", MaxBytes: 2}}}.Validate())
+	assert.ErrorContains(t, Config{Routes: []Route{{Pattern: "PUT /a/{b", MaxBytes: 2}}}.Validate(), `route "PUT /a/{b"`)
+	assert.ErrorContains(t, Config{Routes: []Route{{Pattern: "/a", MaxBytes: 1}, {Pattern: "/a", MaxBytes: 2}}}.Validate(),
+		`route "/a": /a matches the same requests as /a`)
+	assert.ErrorContains(t, Config{Routes: []Route{{Pattern: "/a", MaxBytes: -1}}}.Validate(), "non-negative")
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "2 MiB", formatBytes(2<<20))
+	assert.Equal(t, "512 KiB", formatBytes(512<<10))
+	assert.Equal(t, "1000 bytes", formatBytes(1000))
+}
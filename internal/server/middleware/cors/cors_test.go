@@ -1,7 +1,7 @@
 // Copyright 2025 The OpenChoreo Authors
 // SPDX-License-Identifier: Apache-2.0
 
-package middleware
+package cors
 
 import (
 	"net/http"
@@ -11,7 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestCORS(t *testing.T) {
+func TestMiddleware(t *testing.T) {
 	// nextCalled tracks whether the wrapped handler was invoked.
 	newNext := func(called *bool) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -93,7 +93,7 @@ func TestCORS(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var nextCalled bool
-			handler := CORS(tt.allowedOrigins)(newNext(&nextCalled))
+			handler := Middleware(tt.allowedOrigins)(newNext(&nextCalled))
 
 			req := httptest.NewRequest(tt.method, "/test", nil)
 			for k, v := range tt.headers {
@@ -8,7 +8,7 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/requestid"
 )
 
 // responseWriter wraps http.ResponseWriter to capture status code and bytes written
@@ -29,26 +29,27 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 	return n, err
 }
 
+// Unwrap returns the wrapped ResponseWriter so that http.ResponseController can reach
+// optional interfaces such as http.Flusher for streaming responses.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // Middleware returns an HTTP middleware that logs access logs and enriches context with request ID
 func Middleware(baseLogger *slog.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
 
-			// Get or generate request ID (UUID v7 for time-ordered tracing)
-			requestID := r.Header.Get("X-Request-ID")
+			// Reuse the request ID set by the requestid middleware, or generate one when the
+			// logger runs on its own
+			requestID := r.Header.Get(requestid.Header)
 			if requestID == "" {
-				if id, err := uuid.NewV7(); err == nil {
-					requestID = id.String()
-				} else {
-					// Fallback to v4 if v7 generation fails
-					requestID = uuid.New().String()
-				}
+				requestID = requestid.New()
+				// Set X-Request-ID header for downstream middleware
+				r.Header.Set(requestid.Header, requestID)
 			}
 
-			// Set X-Request-ID header for downstream middleware
-			r.Header.Set("X-Request-ID", requestID)
-
 			// Wrap response writer to capture status and bytes
 			rw := &responseWriter{
 				ResponseWriter: w,
@@ -61,7 +62,7 @@ func Middleware(baseLogger *slog.Logger) func(http.Handler) http.Handler {
 				slog.String("request_id", requestID),
 			)
 
-			ctx := WithLogger(r.Context(), reqLogger)
+			ctx := WithLogger(requestid.WithRequestID(r.Context(), requestID), reqLogger)
 			next.ServeHTTP(rw, r.WithContext(ctx))
 
 			// Log access log with additional fields after request completes
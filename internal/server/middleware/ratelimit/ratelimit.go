@@ -0,0 +1,122 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// idleTimeout is how long the limiter of a client is kept after its last request.
+const idleTimeout = 10 * time.Minute
+
+// Config configures per-client rate limiting.
+type Config struct {
+	// RequestsPerSecond is the sustained request rate allowed per client. Zero disables
+	// rate limiting.
+	RequestsPerSecond float64
+	// Burst is the number of requests a client may make at once. It defaults to the
+	// requests per second, rounded up.
+	Burst int
+}
+
+// Enabled reports whether the config limits requests.
+func (c Config) Enabled() bool {
+	return c.RequestsPerSecond > 0
+}
+
+// Middleware returns an HTTP middleware that limits the request rate of each client, as
+// identified by its remote IP address, with a token bucket. Requests over the limit get a
+// 429 with a Retry-After header. The middleware passes every request through when rate
+// limiting is disabled.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	if !cfg.Enabled() {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	limiters := newClientLimiters(cfg, time.Now)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !limiters.allow(clientKey(r)) {
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(cfg)))
+				http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientKey returns the IP address of the client of a request.
+func clientKey(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// retryAfterSeconds returns the time until a client over the limit has a token again.
+func retryAfterSeconds(cfg Config) int {
+	return max(1, int(math.Ceil(1/cfg.RequestsPerSecond)))
+}
+
+// clientLimiters keeps a token bucket per client and evicts the buckets of clients that
+// have been idle for longer than idleTimeout.
+type clientLimiters struct {
+	mu        sync.Mutex
+	limit     rate.Limit
+	burst     int
+	now       func() time.Time
+	lastSweep time.Time
+	clients   map[string]*clientLimiter
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newClientLimiters(cfg Config, now func() time.Time) *clientLimiters {
+	burst := cfg.Burst
+	if burst <= 0 {
+		burst = int(math.Ceil(cfg.RequestsPerSecond))
+	}
+	return &clientLimiters{
+		limit:     rate.Limit(cfg.RequestsPerSecond),
+		burst:     burst,
+		now:       now,
+		lastSweep: now(),
+		clients:   make(map[string]*clientLimiter),
+	}
+}
+
+func (l *clientLimiters) allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) > idleTimeout {
+		for k, c := range l.clients {
+			if now.Sub(c.lastSeen) > idleTimeout {
+				delete(l.clients, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	c, ok := l.clients[key]
+	if !ok {
+		c = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[key] = c
+	}
+	c.lastSeen = now
+	return c.limiter.AllowN(now, 1)
+}
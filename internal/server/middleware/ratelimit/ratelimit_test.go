@@ -0,0 +1,65 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	request := func(remoteAddr string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		return req
+	}
+
+	t.Run("disabled passes every request through", func(t *testing.T) {
+		handler := Middleware(Config{})(ok)
+		for range 100 {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, request("10.0.0.1:1234"))
+			assert.Equal(t, http.StatusOK, w.Code)
+		}
+	})
+
+	t.Run("limits each client to its burst", func(t *testing.T) {
+		handler := Middleware(Config{RequestsPerSecond: 0.5, Burst: 2})(ok)
+
+		for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, request("10.0.0.1:1234"))
+			assert.Equal(t, want, w.Code, "request %d", i)
+			if want == http.StatusTooManyRequests {
+				assert.Equal(t, "2", w.Header().Get("Retry-After"))
+			}
+		}
+
+		// Another client has its own bucket
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, request("10.0.0.2:1234"))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestClientLimiters_EvictIdleClients(t *testing.T) {
+	now := time.Now()
+	limiters := newClientLimiters(Config{RequestsPerSecond: 1}, func() time.Time { return now })
+
+	assert.True(t, limiters.allow("a"))
+	assert.False(t, limiters.allow("a"))
+	assert.Equal(t, 1, limiters.burst)
+
+	now = now.Add(idleTimeout + time.Second)
+	assert.True(t, limiters.allow("b"))
+	assert.NotContains(t, limiters.clients, "a")
+	assert.Contains(t, limiters.clients, "b")
+}
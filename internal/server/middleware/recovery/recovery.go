@@ -0,0 +1,42 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package recovery
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/requestid"
+)
+
+// Middleware returns an HTTP middleware that recovers from panics in the handler, logs them
+// with the stack trace and responds with a 500. http.ErrAbortHandler is re-panicked, as it
+// deliberately aborts the response.
+func Middleware(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				err := recover()
+				if err == nil {
+					return
+				}
+				if e, ok := err.(error); ok && errors.Is(e, http.ErrAbortHandler) {
+					panic(err)
+				}
+				logger.Error("Panic recovered",
+					"error", err,
+					"method", r.Method,
+					"path", r.URL.Path,
+					"request_id", requestid.FromContext(r.Context()),
+					"stack", string(debug.Stack()),
+				)
+				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Header is the header that carries the request ID.
+const Header = "X-Request-ID"
+
+type contextKey struct{}
+
+// Middleware returns an HTTP middleware that assigns every request an ID. An ID sent by the
+// client is kept; otherwise a UUID v7 is generated so IDs are time-ordered. The ID is set on
+// the request header for downstream middleware, on the response header for the client, and
+// in the request context.
+func Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(Header)
+			if id == "" {
+				id = New()
+				r.Header.Set(Header, id)
+			}
+			w.Header().Set(Header, id)
+			next.ServeHTTP(w, r.WithContext(WithRequestID(r.Context(), id)))
+		})
+	}
+}
+
+// New generates a request ID.
+func New() string {
+	if id, err := uuid.NewV7(); err == nil {
+		return id.String()
+	}
+	// Fall back to v4 if v7 generation fails
+	return uuid.New().String()
+}
+
+// WithRequestID returns a context carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID in the context, or an empty string.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package requestid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	var ctxID, headerID string
+	handler := Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctxID = FromContext(r.Context())
+		headerID = r.Header.Get(Header)
+	}))
+
+	t.Run("generates an ID when none is sent", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.NotEmpty(t, ctxID)
+		assert.Equal(t, ctxID, headerID)
+		assert.Equal(t, ctxID, w.Header().Get(Header))
+	})
+
+	t.Run("keeps the ID sent by the client", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(Header, "req-1")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, "req-1", ctxID)
+		assert.Equal(t, "req-1", w.Header().Get(Header))
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"log/slog"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/bodylimit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/metrics"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/ratelimit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/recovery"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/requestid"
+)
+
+// StackConfig configures the standard middleware stack of an OpenChoreo HTTP service.
+type StackConfig struct {
+	// Logger writes the access logs and recovered panics.
+	Logger *slog.Logger
+	// Metrics records the HTTP server metrics. Nil disables metrics.
+	Metrics *metrics.Metrics
+	// RateLimit limits the request rate per client. The zero value disables it.
+	RateLimit ratelimit.Config
+	// BodyLimit limits the size of request bodies. The zero value disables it.
+	BodyLimit bodylimit.Config
+}
+
+// Stack returns the standard middleware stack shared by the OpenChoreo HTTP services, in
+// execution order: request ID → access log → metrics → panic recovery → rate limit → body
+// limit. The access log and metrics are outside recovery so they record the 500 of a
+// recovered panic, and rate limiting and body limits are innermost so rejected requests
+// are still logged and counted.
+// Service-specific middleware such as authentication goes after the stack.
+func Stack(cfg StackConfig) []Middleware {
+	stack := []Middleware{
+		requestid.Middleware(),
+		logger.Middleware(cfg.Logger),
+	}
+	if cfg.Metrics != nil {
+		stack = append(stack, cfg.Metrics.Middleware())
+	}
+	stack = append(stack, recovery.Middleware(cfg.Logger))
+	if cfg.RateLimit.Enabled() {
+		stack = append(stack, ratelimit.Middleware(cfg.RateLimit))
+	}
+	if cfg.BodyLimit.Enabled() {
+		stack = append(stack, bodylimit.Middleware(cfg.BodyLimit))
+	}
+	return stack
+}
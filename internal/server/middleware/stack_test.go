@@ -0,0 +1,44 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/bodylimit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/metrics"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/ratelimit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/requestid"
+)
+
+func TestStack(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("optional middleware is left out when not configured", func(t *testing.T) {
+		assert.Len(t, Stack(StackConfig{Logger: logger}), 3)
+		assert.Len(t, Stack(StackConfig{
+			Logger:    logger,
+			Metrics:   metrics.New("test"),
+			RateLimit: ratelimit.Config{RequestsPerSecond: 10},
+			BodyLimit: bodylimit.Config{MaxBytes: 1 << 20},
+		}), 6)
+	})
+
+	t.Run("recovered panics get a request ID", func(t *testing.T) {
+		handler := Chain(Stack(StackConfig{Logger: logger, Metrics: metrics.New("test")})...)(
+			http.HandlerFunc(func(http.ResponseWriter, *http.Request) { panic("boom") }))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.NotEmpty(t, w.Header().Get(requestid.Header))
+	})
+}
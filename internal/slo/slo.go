@@ -0,0 +1,82 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package slo computes error budgets and burn rates for service level objectives.
+package slo
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Counts are the request counts observed over a time range.
+type Counts struct {
+	Total  float64
+	Failed float64
+}
+
+// Budget is the error budget of an availability objective over a window.
+type Budget struct {
+	// Availability is the observed fraction of successful requests in the window.
+	// It is nil when no requests were observed.
+	Availability *float64
+	// Remaining is the fraction of the error budget left. It is 1 when no budget was
+	// consumed and drops to 0 or below once the budget is exhausted.
+	Remaining float64
+	// BurnRate is the error rate over the burn rate window divided by the allowed
+	// error rate. A burn rate of 1 exhausts the budget exactly at the end of the window.
+	BurnRate float64
+	// Exhausted is true when no budget is left.
+	Exhausted bool
+}
+
+// ParseTarget parses a percentage such as "99.9" into a fraction in (0, 1].
+func ParseTarget(target string) (float64, error) {
+	pct, err := strconv.ParseFloat(target, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid target %q: %w", target, err)
+	}
+	if pct <= 0 || pct > 100 {
+		return 0, fmt.Errorf("invalid target %q: must be greater than 0 and at most 100", target)
+	}
+	return pct / 100, nil
+}
+
+// Evaluate computes the error budget for target (a fraction) from the counts observed
+// over the whole window and over the shorter burn rate window.
+func Evaluate(target float64, window, burn Counts) Budget {
+	var b Budget
+	if window.Total > 0 {
+		availability := 1 - window.Failed/window.Total
+		b.Availability = &availability
+	}
+
+	allowed := 1 - target
+	if allowed <= 0 {
+		// A 100% target has no budget; any failure exhausts it.
+		b.Remaining = 1
+		if window.Failed > 0 {
+			b.Remaining = 0
+		}
+		if burn.Failed > 0 {
+			b.BurnRate = 1
+		}
+		b.Exhausted = window.Failed > 0
+		return b
+	}
+
+	b.Remaining = 1
+	if window.Total > 0 {
+		b.Remaining = 1 - (window.Failed/window.Total)/allowed
+	}
+	if burn.Total > 0 {
+		b.BurnRate = (burn.Failed / burn.Total) / allowed
+	}
+	b.Exhausted = b.Remaining <= 0
+	return b
+}
+
+// FormatRatio formats a ratio for CRD status fields and API responses.
+func FormatRatio(v float64) string {
+	return strconv.FormatFloat(v, 'f', 4, 64)
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package slo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTarget(t *testing.T) {
+	v, err := ParseTarget("99.9")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.999, v, 1e-9)
+
+	v, err = ParseTarget("100")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, v, 1e-9)
+
+	for _, bad := range []string{"", "abc", "0", "-1", "100.1"} {
+		_, err := ParseTarget(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        float64
+		window, burn  Counts
+		wantRemaining float64
+		wantBurnRate  float64
+		wantExhausted bool
+	}{
+		{
+			name:          "no traffic leaves the budget untouched",
+			target:        0.999,
+			wantRemaining: 1,
+		},
+		{
+			name:          "half the budget consumed",
+			target:        0.99,
+			window:        Counts{Total: 1000, Failed: 5},
+			burn:          Counts{Total: 100, Failed: 1},
+			wantRemaining: 0.5,
+			wantBurnRate:  1,
+		},
+		{
+			name:          "budget exhausted",
+			target:        0.99,
+			window:        Counts{Total: 1000, Failed: 20},
+			burn:          Counts{Total: 100, Failed: 10},
+			wantRemaining: -1,
+			wantBurnRate:  10,
+			wantExhausted: true,
+		},
+		{
+			name:          "100% target with a failure",
+			target:        1,
+			window:        Counts{Total: 1000, Failed: 1},
+			burn:          Counts{Total: 10, Failed: 1},
+			wantRemaining: 0,
+			wantBurnRate:  1,
+			wantExhausted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := Evaluate(tt.target, tt.window, tt.burn)
+			assert.InDelta(t, tt.wantRemaining, b.Remaining, 1e-9)
+			assert.InDelta(t, tt.wantBurnRate, b.BurnRate, 1e-9)
+			assert.Equal(t, tt.wantExhausted, b.Exhausted)
+			if tt.window.Total == 0 {
+				assert.Nil(t, b.Availability)
+			} else {
+				require.NotNil(t, b.Availability)
+				assert.InDelta(t, 1-tt.window.Failed/tt.window.Total, *b.Availability, 1e-9)
+			}
+		})
+	}
+}
+
+func TestFormatRatio(t *testing.T) {
+	assert.Equal(t, "0.5000", FormatRatio(0.5))
+	assert.Equal(t, "-1.2500", FormatRatio(-1.25))
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tenancy evaluates the tenancy policy of shared data planes and parses the
+// per-tenant capacity reservations recorded on them.
+package tenancy
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// CapacityReservationAnnotationPrefix prefixes the annotations that reserve capacity for a
+// tenant namespace on a shared data plane, e.g.
+// "capacity-reservation.openchoreo.dev/team-a: cpu=8,memory=32Gi".
+const CapacityReservationAnnotationPrefix = "capacity-reservation.openchoreo.dev/"
+
+// CheckNamespace returns an error when the policy does not let environments of the
+// namespace reference the data plane. A nil policy allows every namespace.
+func CheckNamespace(policy *openchoreov1alpha1.TenancyPolicy, namespace string) error {
+	if policy == nil {
+		return nil
+	}
+	return check("namespace", namespace, policy.AllowedNamespaces, policy.DeniedNamespaces)
+}
+
+// CheckProject returns an error when the policy does not let the project deploy to the
+// data plane. The namespace rules apply to the project's namespace as well.
+func CheckProject(policy *openchoreov1alpha1.TenancyPolicy, namespace, project string) error {
+	if err := CheckNamespace(policy, namespace); err != nil {
+		return err
+	}
+	if policy == nil {
+		return nil
+	}
+	return check("project", namespace+"/"+project, policy.AllowedProjects, policy.DeniedProjects)
+}
+
+func check(kind, name string, allowed, denied []string) error {
+	if pattern, ok := matchAny(denied, name); ok {
+		return fmt.Errorf("%s %q is denied by tenancy rule %q", kind, name, pattern)
+	}
+	if len(allowed) == 0 {
+		return nil
+	}
+	if _, ok := matchAny(allowed, name); ok {
+		return nil
+	}
+	return fmt.Errorf("%s %q is not in the allowed %ss", kind, name, kind)
+}
+
+// matchAny returns the first pattern that matches name. Malformed patterns never match.
+func matchAny(patterns []string, name string) (string, bool) {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, name); err == nil && ok {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+// ValidatePolicy returns an error for malformed glob patterns in the policy.
+func ValidatePolicy(policy *openchoreov1alpha1.TenancyPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	lists := [][]string{policy.AllowedNamespaces, policy.DeniedNamespaces, policy.AllowedProjects, policy.DeniedProjects}
+	for _, patterns := range lists {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid tenancy pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseCapacityReservations parses the capacity reservation annotations into reservations
+// sorted by namespace. The value of each annotation is a comma-separated list of
+// <resource>=<quantity> pairs.
+func ParseCapacityReservations(annotations map[string]string) ([]openchoreov1alpha1.TenantCapacityReservation, error) {
+	var reservations []openchoreov1alpha1.TenantCapacityReservation
+	for key, value := range annotations {
+		namespace, ok := strings.CutPrefix(key, CapacityReservationAnnotationPrefix)
+		if !ok {
+			continue
+		}
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return nil, fmt.Errorf("annotation %q: invalid namespace: %s", key, strings.Join(errs, "; "))
+		}
+		resources, err := parseResourceList(value)
+		if err != nil {
+			return nil, fmt.Errorf("annotation %q: %w", key, err)
+		}
+		reservations = append(reservations, openchoreov1alpha1.TenantCapacityReservation{
+			Namespace: namespace,
+			Resources: resources,
+		})
+	}
+	sort.Slice(reservations, func(i, j int) bool {
+		return reservations[i].Namespace < reservations[j].Namespace
+	})
+	return reservations, nil
+}
+
+func parseResourceList(value string) (corev1.ResourceList, error) {
+	resources := corev1.ResourceList{}
+	for _, entry := range strings.Split(value, ",") {
+		name, quantity, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid entry %q: must be <resource>=<quantity>", entry)
+		}
+		q, err := resource.ParseQuantity(quantity)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity for %s: %w", name, err)
+		}
+		resources[corev1.ResourceName(name)] = q
+	}
+	return resources, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tenancy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestCheckProject(t *testing.T) {
+	policy := &openchoreov1alpha1.TenancyPolicy{
+		AllowedNamespaces: []string{"team-*"},
+		DeniedNamespaces:  []string{"team-legacy"},
+		AllowedProjects:   []string{"team-a/*", "team-b/payments"},
+		DeniedProjects:    []string{"team-a/sandbox"},
+	}
+
+	tests := []struct {
+		name      string
+		policy    *openchoreov1alpha1.TenancyPolicy
+		namespace string
+		project   string
+		wantErr   string
+	}{
+		{name: "nil policy", namespace: "anything", project: "anything"},
+		{name: "empty policy", policy: &openchoreov1alpha1.TenancyPolicy{}, namespace: "anything", project: "anything"},
+		{name: "allowed by wildcard", policy: policy, namespace: "team-a", project: "checkout"},
+		{name: "allowed by name", policy: policy, namespace: "team-b", project: "payments"},
+		{name: "project not allowed", policy: policy, namespace: "team-b", project: "search",
+			wantErr: `project "team-b/search" is not in the allowed projects`},
+		{name: "project denied", policy: policy, namespace: "team-a", project: "sandbox",
+			wantErr: `project "team-a/sandbox" is denied by tenancy rule "team-a/sandbox"`},
+		{name: "namespace not allowed", policy: policy, namespace: "other", project: "checkout",
+			wantErr: `namespace "other" is not in the allowed namespaces`},
+		{name: "namespace denied", policy: policy, namespace: "team-legacy", project: "checkout",
+			wantErr: `namespace "team-legacy" is denied by tenancy rule "team-legacy"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckProject(tt.policy, tt.namespace, tt.project)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidatePolicy(t *testing.T) {
+	assert.NoError(t, ValidatePolicy(nil))
+	assert.NoError(t, ValidatePolicy(&openchoreov1alpha1.TenancyPolicy{AllowedNamespaces: []string{"team-*"}}))
+	assert.ErrorContains(t, ValidatePolicy(&openchoreov1alpha1.TenancyPolicy{DeniedProjects: []string{"team-[a"}}),
+		`invalid tenancy pattern "team-[a"`)
+}
+
+func TestParseCapacityReservations(t *testing.T) {
+	reservations, err := ParseCapacityReservations(map[string]string{
+		"capacity-reservation.openchoreo.dev/team-b": "cpu=500m",
+		"capacity-reservation.openchoreo.dev/team-a": "cpu=8, memory=32Gi",
+		"openchoreo.dev/display-name":                "Shared",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []openchoreov1alpha1.TenantCapacityReservation{
+		{Namespace: "team-a", Resources: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("8"),
+			corev1.ResourceMemory: resource.MustParse("32Gi"),
+		}},
+		{Namespace: "team-b", Resources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}},
+	}, reservations)
+
+	_, err = ParseCapacityReservations(map[string]string{"capacity-reservation.openchoreo.dev/team-a": "cpu"})
+	assert.ErrorContains(t, err, "must be <resource>=<quantity>")
+
+	_, err = ParseCapacityReservations(map[string]string{"capacity-reservation.openchoreo.dev/team-a": "cpu=lots"})
+	assert.ErrorContains(t, err, "invalid quantity for cpu")
+
+	_, err = ParseCapacityReservations(map[string]string{"capacity-reservation.openchoreo.dev/Team_A": "cpu=1"})
+	assert.ErrorContains(t, err, "invalid namespace")
+}
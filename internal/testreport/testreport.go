@@ -0,0 +1,209 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testreport parses JUnit XML reports produced by the test step of component
+// workflows and selects the workflow run whose results gate release creation.
+package testreport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// maxSuites is the number of suites kept in the summary.
+	maxSuites = 100
+	// maxFailedCases is the number of failed test cases kept in the summary.
+	maxFailedCases = 50
+	// maxMessageLength is the length failure messages are truncated to.
+	maxMessageLength = 1024
+)
+
+// junitSuite is a <testsuite> element. Suites may be nested, as some tools group them.
+type junitSuite struct {
+	Name     string       `xml:"name,attr"`
+	Tests    string       `xml:"tests,attr"`
+	Failures string       `xml:"failures,attr"`
+	Errors   string       `xml:"errors,attr"`
+	Skipped  string       `xml:"skipped,attr"`
+	Time     string       `xml:"time,attr"`
+	Cases    []junitCase  `xml:"testcase"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitProblem `xml:"failure"`
+	Error     *junitProblem `xml:"error"`
+	Skipped   *struct{}     `xml:"skipped"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// Parse summarizes a JUnit XML report. Both a <testsuites> root and a single <testsuite>
+// root are accepted. Counts are taken from the test cases of a suite, or from its attributes
+// when the suite lists no test cases.
+func Parse(data []byte) (*openchoreov1alpha1.WorkflowTestResults, error) {
+	var root struct {
+		XMLName xml.Name
+		junitSuite
+	}
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid JUnit report: %w", err)
+	}
+
+	var suites []junitSuite
+	switch root.XMLName.Local {
+	case "testsuites":
+		suites = root.Suites
+	case "testsuite":
+		suites = []junitSuite{root.junitSuite}
+	default:
+		return nil, fmt.Errorf("invalid JUnit report: unexpected root element <%s>", root.XMLName.Local)
+	}
+
+	results := &openchoreov1alpha1.WorkflowTestResults{}
+	for _, suite := range flatten(suites) {
+		summary := summarizeSuite(suite, results)
+		results.Tests += summary.Tests
+		results.Failures += summary.Failures
+		results.Errors += summary.Errors
+		results.Skipped += summary.Skipped
+		results.DurationMillis += summary.DurationMillis
+		if len(results.Suites) < maxSuites {
+			results.Suites = append(results.Suites, summary)
+		}
+	}
+	results.Passed = results.Failures == 0 && results.Errors == 0
+	return results, nil
+}
+
+// flatten returns the suites that contain test cases, in document order.
+func flatten(suites []junitSuite) []junitSuite {
+	var flat []junitSuite
+	for _, suite := range suites {
+		if len(suite.Suites) > 0 {
+			flat = append(flat, flatten(suite.Suites)...)
+		}
+		if len(suite.Cases) > 0 || len(suite.Suites) == 0 {
+			flat = append(flat, suite)
+		}
+	}
+	return flat
+}
+
+// summarizeSuite counts the results of a suite and records its failed test cases.
+func summarizeSuite(suite junitSuite, results *openchoreov1alpha1.WorkflowTestResults) openchoreov1alpha1.TestSuiteResult {
+	summary := openchoreov1alpha1.TestSuiteResult{
+		Name:           suite.Name,
+		DurationMillis: parseSeconds(suite.Time),
+	}
+	if len(suite.Cases) == 0 {
+		summary.Tests = parseCount(suite.Tests)
+		summary.Failures = parseCount(suite.Failures)
+		summary.Errors = parseCount(suite.Errors)
+		summary.Skipped = parseCount(suite.Skipped)
+		return summary
+	}
+
+	for _, tc := range suite.Cases {
+		summary.Tests++
+		var problem *junitProblem
+		switch {
+		case tc.Error != nil:
+			summary.Errors++
+			problem = tc.Error
+		case tc.Failure != nil:
+			summary.Failures++
+			problem = tc.Failure
+		case tc.Skipped != nil:
+			summary.Skipped++
+		}
+		if problem != nil && len(results.FailedCases) < maxFailedCases {
+			results.FailedCases = append(results.FailedCases, openchoreov1alpha1.TestCaseFailure{
+				Suite:   suite.Name,
+				Name:    CaseName(tc.ClassName, tc.Name),
+				Error:   tc.Error != nil,
+				Message: truncate(problemMessage(problem)),
+			})
+		}
+	}
+	return summary
+}
+
+// CaseName qualifies a test case name with its class name when one is reported.
+func CaseName(className, name string) string {
+	if className == "" || strings.HasPrefix(name, className+".") {
+		return name
+	}
+	return className + "." + name
+}
+
+func problemMessage(p *junitProblem) string {
+	if msg := strings.TrimSpace(p.Message); msg != "" {
+		return msg
+	}
+	return strings.TrimSpace(p.Body)
+}
+
+func truncate(s string) string {
+	if len(s) <= maxMessageLength {
+		return s
+	}
+	return s[:maxMessageLength] + "..."
+}
+
+func parseCount(s string) int32 {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return int32(n)
+}
+
+func parseSeconds(s string) int64 {
+	seconds, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(s), ",", ""), 64)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return int64(seconds * 1000)
+}
+
+// LatestCompletedRun returns the most recently created run that has completed, or nil
+// when none of the runs has completed.
+func LatestCompletedRun(runs []openchoreov1alpha1.WorkflowRun) *openchoreov1alpha1.WorkflowRun {
+	var latest *openchoreov1alpha1.WorkflowRun
+	for i := range runs {
+		run := &runs[i]
+		if run.Status.CompletedAt == nil {
+			continue
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&run.CreationTimestamp) ||
+			(latest.CreationTimestamp.Equal(&run.CreationTimestamp) && latest.Name < run.Name) {
+			latest = run
+		}
+	}
+	return latest
+}
+
+// ReleaseBlockedMessage returns a message explaining why release creation is blocked by the
+// test results of the given run, or an empty string when the run does not block releases.
+func ReleaseBlockedMessage(run *openchoreov1alpha1.WorkflowRun) string {
+	if run == nil || run.Status.TestResults == nil || !run.Status.TestResults.BlocksRelease {
+		return ""
+	}
+	results := run.Status.TestResults
+	if results.Message != "" {
+		return fmt.Sprintf("Tests of workflow run %q could not be evaluated: %s", run.Name, results.Message)
+	}
+	return fmt.Sprintf("Tests of workflow run %q failed (%d failed, %d errored of %d)",
+		run.Name, results.Failures, results.Errors, results.Tests)
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package testreport
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const suitesReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="orders" tests="4" time="1.5">
+    <testcase classname="orders.OrderTest" name="createsOrder" time="0.5"/>
+    <testcase classname="orders.OrderTest" name="rejectsEmptyCart" time="0.5">
+      <failure message="expected 400, got 500" type="AssertionError">stack trace</failure>
+    </testcase>
+    <testcase classname="orders.OrderTest" name="cancelsOrder">
+      <skipped/>
+    </testcase>
+    <testcase classname="orders.OrderTest" name="connectsToDatabase">
+      <error type="IOException">connection refused</error>
+    </testcase>
+  </testsuite>
+  <testsuite name="payments" tests="1" time="0.25">
+    <testcase classname="payments.PaymentTest" name="payments.PaymentTest.charges"/>
+  </testsuite>
+</testsuites>`
+
+func TestParse(t *testing.T) {
+	results, err := Parse([]byte(suitesReport))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if results.Passed {
+		t.Error("Passed = true, want false")
+	}
+	if results.Tests != 5 || results.Failures != 1 || results.Errors != 1 || results.Skipped != 1 {
+		t.Errorf("counts = %d tests, %d failures, %d errors, %d skipped, want 5, 1, 1, 1",
+			results.Tests, results.Failures, results.Errors, results.Skipped)
+	}
+	if results.DurationMillis != 1750 {
+		t.Errorf("DurationMillis = %d, want 1750", results.DurationMillis)
+	}
+	if len(results.Suites) != 2 || results.Suites[0].Name != "orders" || results.Suites[1].Tests != 1 {
+		t.Errorf("Suites = %+v, want orders and payments", results.Suites)
+	}
+
+	want := []openchoreov1alpha1.TestCaseFailure{
+		{Suite: "orders", Name: "orders.OrderTest.rejectsEmptyCart", Message: "expected 400, got 500"},
+		{Suite: "orders", Name: "orders.OrderTest.connectsToDatabase", Error: true, Message: "connection refused"},
+	}
+	if len(results.FailedCases) != len(want) {
+		t.Fatalf("FailedCases = %+v, want %+v", results.FailedCases, want)
+	}
+	for i := range want {
+		if results.FailedCases[i] != want[i] {
+			t.Errorf("FailedCases[%d] = %+v, want %+v", i, results.FailedCases[i], want[i])
+		}
+	}
+}
+
+func TestParse_SingleSuiteRoot(t *testing.T) {
+	results, err := Parse([]byte(`<testsuite name="unit"><testcase name="a"/><testcase name="b"/></testsuite>`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !results.Passed || results.Tests != 2 {
+		t.Errorf("results = %+v, want 2 passing tests", results)
+	}
+}
+
+func TestParse_NestedSuitesAndAttributeCounts(t *testing.T) {
+	report := `<testsuites>
+  <testsuite name="parent">
+    <testsuite name="child"><testcase name="a"/></testsuite>
+  </testsuite>
+  <testsuite name="summary-only" tests="3" failures="1" errors="0" skipped="1" time="2"/>
+</testsuites>`
+	results, err := Parse([]byte(report))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(results.Suites) != 2 || results.Suites[0].Name != "child" || results.Suites[1].Name != "summary-only" {
+		t.Fatalf("Suites = %+v, want child and summary-only", results.Suites)
+	}
+	if results.Tests != 4 || results.Failures != 1 || results.Skipped != 1 || results.Passed {
+		t.Errorf("results = %+v, want 4 tests with 1 failure and 1 skipped", results)
+	}
+}
+
+func TestParse_FailedCasesAreCapped(t *testing.T) {
+	var b strings.Builder
+	b.WriteString(`<testsuite name="many">`)
+	for range maxFailedCases + 5 {
+		b.WriteString(`<testcase name="t"><failure message="` + strings.Repeat("x", maxMessageLength+1) + `"/></testcase>`)
+	}
+	b.WriteString(`</testsuite>`)
+
+	results, err := Parse([]byte(b.String()))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if results.Failures != maxFailedCases+5 {
+		t.Errorf("Failures = %d, want %d", results.Failures, maxFailedCases+5)
+	}
+	if len(results.FailedCases) != maxFailedCases {
+		t.Errorf("len(FailedCases) = %d, want %d", len(results.FailedCases), maxFailedCases)
+	}
+	if got := len(results.FailedCases[0].Message); got != maxMessageLength+3 {
+		t.Errorf("message length = %d, want %d", got, maxMessageLength+3)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for name, report := range map[string]string{
+		"not xml":      "PASS ok 12 tests",
+		"unknown root": "<report><testcase name=\"a\"/></report>",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse([]byte(report)); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
+
+func completedRun(name string, created time.Time, results *openchoreov1alpha1.WorkflowTestResults) openchoreov1alpha1.WorkflowRun {
+	completed := metav1.NewTime(created.Add(time.Minute))
+	return openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+		Status:     openchoreov1alpha1.WorkflowRunStatus{CompletedAt: &completed, TestResults: results},
+	}
+}
+
+func TestLatestCompletedRun(t *testing.T) {
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	running := completedRun("run-4", base.Add(3*time.Hour), nil)
+	running.Status.CompletedAt = nil
+	runs := []openchoreov1alpha1.WorkflowRun{
+		completedRun("run-1", base, nil),
+		completedRun("run-3", base.Add(2*time.Hour), nil),
+		running,
+		completedRun("run-2", base.Add(time.Hour), nil),
+	}
+
+	if got := LatestCompletedRun(runs); got == nil || got.Name != "run-3" {
+		t.Errorf("LatestCompletedRun() = %v, want run-3", got)
+	}
+	if got := LatestCompletedRun([]openchoreov1alpha1.WorkflowRun{running}); got != nil {
+		t.Errorf("LatestCompletedRun() = %s, want nil", got.Name)
+	}
+}
+
+func TestReleaseBlockedMessage(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		run  *openchoreov1alpha1.WorkflowRun
+		want string
+	}{
+		{name: "no run"},
+		{name: "run without tests", run: ptrTo(completedRun("run-1", now, nil))},
+		{
+			name: "failed tests that do not block releases",
+			run:  ptrTo(completedRun("run-1", now, &openchoreov1alpha1.WorkflowTestResults{Tests: 3, Failures: 1})),
+		},
+		{
+			name: "failed tests that block releases",
+			run: ptrTo(completedRun("run-1", now, &openchoreov1alpha1.WorkflowTestResults{
+				Tests: 3, Failures: 1, BlocksRelease: true,
+			})),
+			want: `Tests of workflow run "run-1" failed (1 failed, 0 errored of 3)`,
+		},
+		{
+			name: "missing report",
+			run: ptrTo(completedRun("run-1", now, &openchoreov1alpha1.WorkflowTestResults{
+				BlocksRelease: true, Message: "no report",
+			})),
+			want: `Tests of workflow run "run-1" could not be evaluated: no report`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReleaseBlockedMessage(tt.run); got != tt.want {
+				t.Errorf("ReleaseBlockedMessage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -34,6 +34,14 @@ const allowedNamespaceValue = "${metadata.namespace}"
 func ValidateWorkloadResources(workloadType string, resources []v1alpha1.ResourceTemplate, basePath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	// Library components are never deployed, so their types render no resources
+	if workloadType == v1alpha1.WorkloadTypeLibrary {
+		if len(resources) > 0 {
+			allErrs = append(allErrs, field.Forbidden(basePath, "library ComponentType must not contain resources"))
+		}
+		return allErrs
+	}
+
 	// Proxy workload type doesn't require workload resource validation
 	if workloadType == "proxy" {
 		// Still validate template structure and reject workload resources
@@ -54,6 +54,26 @@ func TestValidateWorkloadResources_Proxy(t *testing.T) {
 	})
 }
 
+func TestValidateWorkloadResources_Library(t *testing.T) {
+	basePath := field.NewPath("spec", "resources")
+
+	t.Run("library without resources", func(t *testing.T) {
+		assert.Empty(t, ValidateWorkloadResources("library", nil, basePath))
+	})
+
+	t.Run("library with resources rejected", func(t *testing.T) {
+		resources := []v1alpha1.ResourceTemplate{
+			{
+				ID:       "configmap",
+				Template: rawJSON(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test-config"}}`),
+			},
+		}
+		errs := ValidateWorkloadResources("library", resources, basePath)
+		require.NotEmpty(t, errs)
+		assert.Contains(t, errs.ToAggregate().Error(), "library ComponentType must not contain resources")
+	})
+}
+
 func TestValidateWorkloadResources_Normal(t *testing.T) {
 	basePath := field.NewPath("spec", "resources")
 
@@ -0,0 +1,189 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package workflowparams validates the parameters developers pass to component workflows.
+//
+// Parameters are stored verbatim in Component and WorkflowRun resources, so credentials such as
+// registry tokens or deploy keys must not be passed in them. Credentials belong in a
+// SecretReference that the workflow reads through a secretRef parameter.
+package workflowparams
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// credentialPatterns match values that are credentials whatever parameter they are passed in.
+var credentialPatterns = []struct {
+	kind    string
+	pattern *regexp.Regexp
+}{
+	{"a private key", regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY-----`)},
+	{"a GitHub token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})`)},
+	{"a GitLab token", regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}`)},
+	{"an AWS access key", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"a Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{"a Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`)},
+	{"a JSON web token", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+	{"a URL with credentials", regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://[^/\s:@]+:[^/\s@]+@`)},
+}
+
+var (
+	// credentialName matches parameter and environment variable names that hold credentials,
+	// such as "password", "apiKey" or "NPM_TOKEN".
+	credentialName = regexp.MustCompile(`(?i)(passw(or)?d|secret|token|api[_-]?key|access[_-]?key|private[_-]?key|credential)`)
+	// referenceName matches names that refer to a credential instead of holding it,
+	// such as "secretRef", "tokenFile", "passwordPath" or "credentialsSecret", the name of
+	// the Secret that holds the credentials.
+	referenceName = regexp.MustCompile(`(?i)(ref|refs|name|path|file|url|uri|endpoint|(credentials?|token|passw(or)?d|key)[_-]?secret)$`)
+)
+
+// Finding is a parameter value that looks like a plaintext credential.
+type Finding struct {
+	// Path is the path of the value, such as spec.workflow.parameters.buildEnv[0].value.
+	Path *field.Path
+	// Reason explains why the value looks like a credential, without repeating it.
+	Reason string
+}
+
+// FindPlaintextCredentials returns the values of parameters under path that look like plaintext
+// credentials. Values under properties the parameters schema marks with the
+// x-openchoreo-allow-plaintext extension are skipped. The schema may be nil.
+func FindPlaintextCredentials(path *field.Path, parameters, schema *runtime.RawExtension) ([]Finding, error) {
+	if parameters == nil || len(parameters.Raw) == 0 {
+		return nil, nil
+	}
+	var values any
+	if err := json.Unmarshal(parameters.Raw, &values); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal parameters: %w", err)
+	}
+	var schemaObj map[string]any
+	if schema != nil && len(schema.Raw) > 0 {
+		if err := json.Unmarshal(schema.Raw, &schemaObj); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal parameters schema: %w", err)
+		}
+	}
+	var findings []Finding
+	walk(path, "", values, schemaObj, &findings)
+	return findings, nil
+}
+
+// walk appends the findings of value, the value of the parameter named name, to findings.
+// schema is the schema of the value, nil when the schema does not describe it.
+func walk(path *field.Path, name string, value any, schema map[string]any, findings *[]Finding) {
+	if allowed, _ := schema[controller.SchemaExtensionAllowPlaintext].(bool); allowed {
+		return
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		// Lists of name/value pairs, such as build environment variables, are checked
+		// with the name of the pair
+		pairName, isPair := v["name"].(string)
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			childName := key
+			if isPair && key == "value" {
+				childName = pairName
+			}
+			walk(path.Child(key), childName, v[key], propertySchema(schema, key), findings)
+		}
+	case []any:
+		items, _ := schema["items"].(map[string]any)
+		for i, item := range v {
+			walk(path.Index(i), name, item, items, findings)
+		}
+	case string:
+		if reason := credentialReason(name, v); reason != "" {
+			*findings = append(*findings, Finding{Path: path, Reason: reason})
+		}
+	}
+}
+
+// propertySchema returns the schema of the property key of an object schema.
+func propertySchema(schema map[string]any, key string) map[string]any {
+	if props, ok := schema["properties"].(map[string]any); ok {
+		if prop, ok := props[key].(map[string]any); ok {
+			return prop
+		}
+	}
+	additional, _ := schema["additionalProperties"].(map[string]any)
+	return additional
+}
+
+// credentialReason returns why the value of the parameter named name looks like a plaintext
+// credential, or an empty string when it does not.
+func credentialReason(name, value string) string {
+	for _, p := range credentialPatterns {
+		if p.pattern.MatchString(value) {
+			return "looks like " + p.kind
+		}
+	}
+	if strings.TrimSpace(value) != "" && credentialName.MatchString(name) && !referenceName.MatchString(name) {
+		return fmt.Sprintf("is a plaintext value for the credential %q", name)
+	}
+	return ""
+}
+
+// ValidatePlaintextCredentials returns a Forbidden error for each value of parameters that looks
+// like a plaintext credential. See FindPlaintextCredentials.
+func ValidatePlaintextCredentials(path *field.Path, parameters, schema *runtime.RawExtension) field.ErrorList {
+	allErrs := field.ErrorList{}
+	findings, err := FindPlaintextCredentials(path, parameters, schema)
+	if err != nil {
+		return append(allErrs, field.Invalid(path, field.OmitValueType{}, err.Error()))
+	}
+	for _, f := range findings {
+		allErrs = append(allErrs, field.Forbidden(f.Path, Message(f)))
+	}
+	return allErrs
+}
+
+// Message describes a finding and how to pass the credential instead.
+func Message(f Finding) string {
+	return f.Reason + "; store the credential in a SecretReference and pass its name through a secretRef parameter, " +
+		"or mark the parameter with " + controller.SchemaExtensionAllowPlaintext + " in the workflow schema if it is not secret"
+}
+
+// ResolveParametersSchema returns the parameters schema of the workflow a component or workflow
+// run references, taken from the published version when one is set. It returns nil when the
+// workflow or version does not exist yet, so parameters are then checked without an allowlist.
+func ResolveParametersSchema(ctx context.Context, c client.Client, namespace string, kind openchoreov1alpha1.WorkflowRefKind, name, version string) (*runtime.RawExtension, error) {
+	if c == nil || name == "" {
+		return nil, nil
+	}
+	if version != "" {
+		spec, err := controller.ResolveWorkflowVersion(ctx, c, namespace, kind, name, version)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return spec.Template.Parameters.GetRaw(), nil
+	}
+	result, err := controller.ResolveWorkflow(ctx, c, namespace, kind, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	spec := result.GetWorkflowSpec()
+	return spec.Parameters.GetRaw(), nil
+}
@@ -0,0 +1,519 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vaultsecret delivers the values of SecretReferences that point directly at a
+// HashiCorp Vault KV secrets engine. The rendered ExternalSecrets read every secret from the
+// data plane's ClusterSecretStore; the entries that resolve to a Vault-backed SecretReference
+// are redirected to a namespaced SecretStore generated for the component or, when the
+// reference is delivered through the Secrets Store CSI driver, the ExternalSecret is replaced
+// by a SecretProviderClass that syncs the same Kubernetes Secret.
+package vaultsecret
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/podspec"
+)
+
+const (
+	// CSIDriver is the name of the Secrets Store CSI driver.
+	CSIDriver = "secrets-store.csi.k8s.io"
+	// CSIMountRoot is the directory under which the CSI volumes are mounted in the containers,
+	// one subdirectory per SecretProviderClass.
+	CSIMountRoot = "/mnt/secrets-store"
+
+	defaultAuthMountPath   = "kubernetes"
+	defaultRefreshInterval = time.Hour
+
+	keySeparator = "\x00"
+)
+
+// Params holds parameters for delivering the Vault-backed secrets of a component.
+type Params struct {
+	Namespace     string // data plane namespace name
+	ComponentName string // for naming the generated resources
+
+	// SecretReferences are all SecretReferences resolved for the component. Only the ones
+	// with spec.vault are delivered from Vault.
+	SecretReferences []*openchoreov1alpha1.SecretReference
+}
+
+// StoreName returns the name of the SecretStore generated for a component from the named
+// Vault-backed SecretReference.
+func StoreName(componentName, secretReferenceName string) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxResourceNameLength,
+		componentName, secretReferenceName, "vault")
+}
+
+// Validate checks a Vault source beyond what the CRD schema enforces.
+func Validate(source *openchoreov1alpha1.VaultSource) error {
+	u, err := url.Parse(source.Server)
+	if err != nil {
+		return fmt.Errorf("invalid Vault server address %q: %w", source.Server, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid Vault server address %q: must be an http or https URL with a host", source.Server)
+	}
+	if strings.Trim(source.Path, "/") == "" {
+		return fmt.Errorf("the KV secrets engine path must not be empty")
+	}
+	if strings.TrimSpace(source.Auth.Role) == "" {
+		return fmt.Errorf("a Vault role is required for Kubernetes authentication")
+	}
+	return nil
+}
+
+// Apply redirects the rendered ExternalSecrets to Vault and returns the resulting resource
+// list. ExternalSecret entries backed by a Vault SecretReference delivered through
+// ExternalSecrets read from the SecretStore generated for that reference, which is appended
+// to the list, and the ExternalSecret is refreshed at least as often as the reference asks.
+// ExternalSecrets backed by a reference delivered through CSI are replaced by a
+// SecretProviderClass, and the pods consuming their Secret mount the CSI volume so that the
+// driver syncs it. Entries that match no Vault-backed reference are left untouched.
+func Apply(resources, podResources []map[string]any, params Params) ([]map[string]any, error) {
+	index, err := newRefIndex(params.SecretReferences)
+	if err != nil {
+		return nil, err
+	}
+	if index.empty() {
+		return resources, nil
+	}
+
+	out := make([]map[string]any, 0, len(resources))
+	stores := map[string]*openchoreov1alpha1.SecretReference{}
+	for _, resource := range resources {
+		if !isExternalSecret(resource) {
+			out = append(out, resource)
+			continue
+		}
+		replacement, used, err := redirect(resource, podResources, index, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range used {
+			stores[ref.Name] = ref
+		}
+		out = append(out, replacement)
+	}
+
+	names := make([]string, 0, len(stores))
+	for name := range stores {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		out = append(out, MakeSecretStore(params.Namespace, StoreName(params.ComponentName, name), stores[name].Spec.Vault))
+	}
+	return out, nil
+}
+
+// MakeSecretStore returns a namespaced External Secrets SecretStore that reads from the
+// Vault KV secrets engine with Kubernetes authentication.
+func MakeSecretStore(namespace, name string, source *openchoreov1alpha1.VaultSource) map[string]any {
+	kubernetesAuth := map[string]any{
+		"mountPath": authMountPath(source),
+		"role":      source.Auth.Role,
+	}
+	if source.Auth.ServiceAccountName != "" {
+		kubernetesAuth["serviceAccountRef"] = map[string]any{"name": source.Auth.ServiceAccountName}
+	}
+	vault := map[string]any{
+		"server":  source.Server,
+		"path":    strings.Trim(source.Path, "/"),
+		"version": string(kvVersion(source)),
+		"auth":    map[string]any{"kubernetes": kubernetesAuth},
+	}
+	if source.Namespace != "" {
+		vault["namespace"] = source.Namespace
+	}
+	return map[string]any{
+		"apiVersion": "external-secrets.io/v1",
+		"kind":       "SecretStore",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]any{
+			"provider": map[string]any{"vault": vault},
+		},
+	}
+}
+
+// match is an ExternalSecret data entry resolved to a Vault-backed SecretReference.
+type match struct {
+	entry map[string]any
+	ref   *openchoreov1alpha1.SecretReference
+}
+
+// redirect rewrites a single ExternalSecret and returns it, or the SecretProviderClass that
+// replaces it, together with the references whose SecretStore it reads from.
+func redirect(es map[string]any, podResources []map[string]any, index *refIndex,
+	params Params) (map[string]any, []*openchoreov1alpha1.SecretReference, error) {
+	name := nestedString(es, "metadata", "name")
+	spec, _ := es["spec"].(map[string]any)
+	if spec == nil {
+		return es, nil, nil
+	}
+	data, _ := spec["data"].([]any)
+	_, hasDataFrom := spec["dataFrom"]
+
+	var matches, csi []match
+	for _, d := range data {
+		entry, ok := d.(map[string]any)
+		if !ok {
+			continue
+		}
+		ref, err := index.lookup(nestedString(entry, "remoteRef", "key"),
+			nestedString(entry, "remoteRef", "property"), nestedString(entry, "remoteRef", "version"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("ExternalSecret %q: %w", name, err)
+		}
+		if ref == nil {
+			continue
+		}
+		matches = append(matches, match{entry: entry, ref: ref})
+		if delivery(ref.Spec.Vault) == openchoreov1alpha1.VaultDeliveryCSI {
+			csi = append(csi, match{entry: entry, ref: ref})
+		}
+	}
+	if len(matches) == 0 {
+		return es, nil, nil
+	}
+
+	if len(csi) > 0 {
+		// The CSI driver syncs a whole Secret from one SecretProviderClass, so every key of the
+		// Secret must come from the same Vault source.
+		if len(csi) != len(data) || hasDataFrom {
+			return nil, nil, fmt.Errorf("ExternalSecret %q: SecretReference %q is delivered through CSI and cannot share a secret with other sources",
+				name, csi[0].ref.Name)
+		}
+		for _, m := range csi[1:] {
+			if !reflect.DeepEqual(*m.ref.Spec.Vault, *csi[0].ref.Spec.Vault) {
+				return nil, nil, fmt.Errorf("ExternalSecret %q: SecretReferences %q and %q are delivered through CSI from different Vault sources",
+					name, csi[0].ref.Name, m.ref.Name)
+			}
+		}
+		secretName := nestedString(spec, "target", "name")
+		if secretName == "" {
+			secretName = name
+		}
+		spc, err := makeSecretProviderClass(name, params.Namespace, secretName,
+			nestedString(spec, "target", "template", "type"), csi)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ExternalSecret %q: %w", name, err)
+		}
+		attachCSIVolume(podResources, secretName, name)
+		return spc, nil, nil
+	}
+
+	used := make([]*openchoreov1alpha1.SecretReference, 0, len(matches))
+	sameRef := true
+	interval := time.Duration(0)
+	for _, m := range matches {
+		if !slices.Contains(used, m.ref) {
+			used = append(used, m.ref)
+		}
+		sameRef = sameRef && m.ref == matches[0].ref
+		if refresh := refreshInterval(m.ref); interval == 0 || refresh < interval {
+			interval = refresh
+		}
+	}
+
+	if sameRef && len(matches) == len(data) && !hasDataFrom {
+		spec["secretStoreRef"] = storeRef(params.ComponentName, matches[0].ref)
+	} else {
+		for _, m := range matches {
+			m.entry["sourceRef"] = map[string]any{"storeRef": storeRef(params.ComponentName, m.ref)}
+		}
+	}
+	if current, err := time.ParseDuration(nestedString(spec, "refreshInterval")); err != nil || current == 0 || current > interval {
+		spec["refreshInterval"] = interval.String()
+	}
+	return es, used, nil
+}
+
+// makeSecretProviderClass returns a SecretProviderClass for the Vault CSI provider that
+// mounts one file per matched entry, named after the key of the Secret, and syncs the files
+// into the Secret the ExternalSecret would have created.
+func makeSecretProviderClass(name, namespace, secretName, secretType string, matches []match) (map[string]any, error) {
+	source := matches[0].ref.Spec.Vault
+	objects := make([]map[string]string, 0, len(matches))
+	secretData := make([]any, 0, len(matches))
+	for _, m := range matches {
+		key := nestedString(m.entry, "secretKey")
+		path := secretPath(source, nestedString(m.entry, "remoteRef", "key"))
+		if version := nestedString(m.entry, "remoteRef", "version"); version != "" {
+			path += "?version=" + version
+		}
+		object := map[string]string{"objectName": key, "secretPath": path}
+		if property := nestedString(m.entry, "remoteRef", "property"); property != "" {
+			object["secretKey"] = property
+		}
+		objects = append(objects, object)
+		secretData = append(secretData, map[string]any{"objectName": key, "key": key})
+	}
+	objectsYAML, err := yaml.Marshal(objects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the Vault objects: %w", err)
+	}
+
+	parameters := map[string]any{
+		"vaultAddress":             source.Server,
+		"roleName":                 source.Auth.Role,
+		"vaultKubernetesMountPath": authMountPath(source),
+		"objects":                  string(objectsYAML),
+	}
+	if source.Namespace != "" {
+		parameters["vaultNamespace"] = source.Namespace
+	}
+	if secretType == "" {
+		secretType = string(corev1.SecretTypeOpaque)
+	}
+	return map[string]any{
+		"apiVersion": "secrets-store.csi.x-k8s.io/v1",
+		"kind":       "SecretProviderClass",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]any{
+			"provider":   "vault",
+			"parameters": parameters,
+			"secretObjects": []any{
+				map[string]any{
+					"secretName": secretName,
+					"type":       secretType,
+					"data":       secretData,
+				},
+			},
+		},
+	}, nil
+}
+
+// attachCSIVolume mounts the SecretProviderClass into the pods that consume the named Secret.
+// The CSI driver only syncs the Secret while a pod mounts the volume.
+func attachCSIVolume(podResources []map[string]any, secretName, providerClassName string) {
+	volumeName := dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxVolumeNameLength, providerClassName, "csi")
+	for _, resource := range podResources {
+		podSpec := podspec.Find(resource)
+		if podSpec == nil || !consumesSecret(podSpec, secretName) {
+			continue
+		}
+		volumes, _ := podSpec["volumes"].([]any)
+		if hasNamed(volumes, volumeName) {
+			continue
+		}
+		podSpec["volumes"] = append(volumes, map[string]any{
+			"name": volumeName,
+			"csi": map[string]any{
+				"driver":           CSIDriver,
+				"readOnly":         true,
+				"volumeAttributes": map[string]any{"secretProviderClass": providerClassName},
+			},
+		})
+		containers, _ := podSpec["containers"].([]any)
+		for _, c := range containers {
+			container, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+			mounts, _ := container["volumeMounts"].([]any)
+			container["volumeMounts"] = append(mounts, map[string]any{
+				"name":      volumeName,
+				"mountPath": CSIMountRoot + "/" + providerClassName,
+				"readOnly":  true,
+			})
+		}
+	}
+}
+
+// consumesSecret reports whether a pod spec reads the named Secret through environment
+// variables or volumes.
+func consumesSecret(podSpec map[string]any, secretName string) bool {
+	volumes, _ := podSpec["volumes"].([]any)
+	for _, v := range volumes {
+		volume, _ := v.(map[string]any)
+		if nestedString(volume, "secret", "secretName") == secretName {
+			return true
+		}
+		sources, _ := nestedAny(volume, "projected", "sources").([]any)
+		for _, s := range sources {
+			source, _ := s.(map[string]any)
+			if nestedString(source, "secret", "name") == secretName {
+				return true
+			}
+		}
+	}
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _ := podSpec[field].([]any)
+		for _, c := range containers {
+			container, _ := c.(map[string]any)
+			envFrom, _ := container["envFrom"].([]any)
+			for _, e := range envFrom {
+				entry, _ := e.(map[string]any)
+				if nestedString(entry, "secretRef", "name") == secretName {
+					return true
+				}
+			}
+			env, _ := container["env"].([]any)
+			for _, e := range env {
+				entry, _ := e.(map[string]any)
+				if nestedString(entry, "valueFrom", "secretKeyRef", "name") == secretName {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// refIndex finds the SecretReference an ExternalSecret data entry was rendered from by its
+// remote reference.
+type refIndex struct {
+	refs  map[string][]*openchoreov1alpha1.SecretReference
+	vault bool
+}
+
+func newRefIndex(refs []*openchoreov1alpha1.SecretReference) (*refIndex, error) {
+	sorted := slices.Clone(refs)
+	slices.SortFunc(sorted, func(a, b *openchoreov1alpha1.SecretReference) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	index := &refIndex{refs: map[string][]*openchoreov1alpha1.SecretReference{}}
+	for _, ref := range sorted {
+		if ref == nil {
+			continue
+		}
+		if ref.Spec.Vault != nil {
+			if err := Validate(ref.Spec.Vault); err != nil {
+				return nil, fmt.Errorf("SecretReference %q: %w", ref.Name, err)
+			}
+			index.vault = true
+		}
+		for _, d := range ref.Spec.Data {
+			key := remoteRefKey(d.RemoteRef.Key, d.RemoteRef.Property, d.RemoteRef.Version)
+			if !slices.Contains(index.refs[key], ref) {
+				index.refs[key] = append(index.refs[key], ref)
+			}
+		}
+	}
+	return index, nil
+}
+
+func (x *refIndex) empty() bool {
+	return !x.vault
+}
+
+// lookup returns the Vault-backed SecretReference of a remote reference, or nil when it
+// belongs to the data plane's secret store. A remote reference shared by references that
+// resolve to different stores is ambiguous.
+func (x *refIndex) lookup(key, property, version string) (*openchoreov1alpha1.SecretReference, error) {
+	candidates := x.refs[remoteRefKey(key, property, version)]
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	first := candidates[0]
+	for _, ref := range candidates[1:] {
+		if (first.Spec.Vault == nil) != (ref.Spec.Vault == nil) ||
+			(first.Spec.Vault != nil && !reflect.DeepEqual(*first.Spec.Vault, *ref.Spec.Vault)) {
+			return nil, fmt.Errorf("remote reference %q is used by SecretReferences %q and %q with different secret sources",
+				key, first.Name, ref.Name)
+		}
+	}
+	if first.Spec.Vault == nil {
+		return nil, nil
+	}
+	return first, nil
+}
+
+func remoteRefKey(key, property, version string) string {
+	return strings.Join([]string{key, property, version}, keySeparator)
+}
+
+func storeRef(componentName string, ref *openchoreov1alpha1.SecretReference) map[string]any {
+	return map[string]any{
+		"name": StoreName(componentName, ref.Name),
+		"kind": "SecretStore",
+	}
+}
+
+// secretPath returns the API path of a secret for the Vault CSI provider, which unlike
+// External Secrets expects the data segment of KV v2 engines in the path.
+func secretPath(source *openchoreov1alpha1.VaultSource, key string) string {
+	mount := strings.Trim(source.Path, "/")
+	key = strings.TrimPrefix(key, "/")
+	if kvVersion(source) == openchoreov1alpha1.VaultKVVersion2 {
+		return mount + "/data/" + key
+	}
+	return mount + "/" + key
+}
+
+func kvVersion(source *openchoreov1alpha1.VaultSource) openchoreov1alpha1.VaultKVVersion {
+	if source.Version == "" {
+		return openchoreov1alpha1.VaultKVVersion2
+	}
+	return source.Version
+}
+
+func delivery(source *openchoreov1alpha1.VaultSource) openchoreov1alpha1.VaultDelivery {
+	if source.Delivery == "" {
+		return openchoreov1alpha1.VaultDeliveryExternalSecrets
+	}
+	return source.Delivery
+}
+
+func authMountPath(source *openchoreov1alpha1.VaultSource) string {
+	if source.Auth.MountPath == "" {
+		return defaultAuthMountPath
+	}
+	return source.Auth.MountPath
+}
+
+func refreshInterval(ref *openchoreov1alpha1.SecretReference) time.Duration {
+	if ref.Spec.RefreshInterval == nil || ref.Spec.RefreshInterval.Duration <= 0 {
+		return defaultRefreshInterval
+	}
+	return ref.Spec.RefreshInterval.Duration
+}
+
+func isExternalSecret(resource map[string]any) bool {
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+	return kind == "ExternalSecret" && strings.HasPrefix(apiVersion, "external-secrets.io/")
+}
+
+func hasNamed(items []any, name string) bool {
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok && m["name"] == name {
+			return true
+		}
+	}
+	return false
+}
+
+func nestedAny(obj map[string]any, fields ...string) any {
+	var cur any = obj
+	for _, f := range fields {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = m[f]
+	}
+	return cur
+}
+
+func nestedString(obj map[string]any, fields ...string) string {
+	s, _ := nestedAny(obj, fields...).(string)
+	return s
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultsecret
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/podspec"
+)
+
+func secretRef(name string, vault *openchoreov1alpha1.VaultSource, data ...openchoreov1alpha1.SecretDataSource) *openchoreov1alpha1.SecretReference {
+	return &openchoreov1alpha1.SecretReference{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme"},
+		Spec:       openchoreov1alpha1.SecretReferenceSpec{Vault: vault, Data: data},
+	}
+}
+
+func dataSource(secretKey, key, property string) openchoreov1alpha1.SecretDataSource {
+	return openchoreov1alpha1.SecretDataSource{
+		SecretKey: secretKey,
+		RemoteRef: openchoreov1alpha1.RemoteReference{Key: key, Property: property},
+	}
+}
+
+func vaultSource(delivery openchoreov1alpha1.VaultDelivery) *openchoreov1alpha1.VaultSource {
+	return &openchoreov1alpha1.VaultSource{
+		Server:   "https://vault.example.com:8200",
+		Path:     "secret",
+		Auth:     openchoreov1alpha1.VaultAuth{Role: "reading-list"},
+		Delivery: delivery,
+	}
+}
+
+// externalSecret mirrors the ExternalSecrets rendered by the default component types.
+func externalSecret(name string, entries ...[2]string) map[string]any {
+	data := make([]any, 0, len(entries))
+	for _, e := range entries {
+		data = append(data, map[string]any{
+			"secretKey": e[0],
+			"remoteRef": map[string]any{"key": e[1], "property": "value"},
+		})
+	}
+	return map[string]any{
+		"apiVersion": "external-secrets.io/v1",
+		"kind":       "ExternalSecret",
+		"metadata":   map[string]any{"name": name, "namespace": "dp-acme-dev"},
+		"spec": map[string]any{
+			"refreshInterval": "15s",
+			"secretStoreRef":  map[string]any{"name": "default", "kind": "ClusterSecretStore"},
+			"target":          map[string]any{"name": name, "creationPolicy": "Owner"},
+			"data":            data,
+		},
+	}
+}
+
+func deployment(envSecret string) map[string]any {
+	return map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "reading-list"},
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{
+							"name":    "main",
+							"envFrom": []any{map[string]any{"secretRef": map[string]any{"name": envSecret}}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestApplyExternalSecrets(t *testing.T) {
+	db := secretRef("db", vaultSource(""), dataSource("password", "db/creds", "value"))
+	db.Spec.RefreshInterval = &metav1.Duration{Duration: 5 * time.Second}
+	api := secretRef("api", nil, dataSource("token", "api/token", "value"))
+
+	params := Params{
+		Namespace:        "dp-acme-dev",
+		ComponentName:    "reading-list",
+		SecretReferences: []*openchoreov1alpha1.SecretReference{db, api},
+	}
+	vaultOnly := externalSecret("vault-only", [2]string{"DB_PASSWORD", "db/creds"})
+	mixed := externalSecret("mixed", [2]string{"DB_PASSWORD", "db/creds"}, [2]string{"API_TOKEN", "api/token"})
+	plain := externalSecret("plain", [2]string{"API_TOKEN", "api/token"})
+
+	out, err := Apply([]map[string]any{vaultOnly, mixed, plain}, nil, params)
+	require.NoError(t, err)
+	require.Len(t, out, 4)
+
+	store := StoreName("reading-list", "db")
+	storeRef := map[string]any{"name": store, "kind": "SecretStore"}
+
+	// An ExternalSecret read entirely from Vault switches its store and rotates as often as
+	// the reference asks.
+	assert.Equal(t, storeRef, out[0]["spec"].(map[string]any)["secretStoreRef"])
+	assert.Equal(t, "5s", out[0]["spec"].(map[string]any)["refreshInterval"])
+
+	// Mixed ExternalSecrets keep the data plane store and redirect the Vault entries only.
+	mixedSpec := out[1]["spec"].(map[string]any)
+	assert.Equal(t, "default", mixedSpec["secretStoreRef"].(map[string]any)["name"])
+	entries := mixedSpec["data"].([]any)
+	assert.Equal(t, map[string]any{"storeRef": storeRef}, entries[0].(map[string]any)["sourceRef"])
+	assert.NotContains(t, entries[1], "sourceRef")
+
+	assert.Equal(t, plain, out[2])
+
+	assert.Equal(t, map[string]any{
+		"apiVersion": "external-secrets.io/v1",
+		"kind":       "SecretStore",
+		"metadata":   map[string]any{"name": store, "namespace": "dp-acme-dev"},
+		"spec": map[string]any{
+			"provider": map[string]any{
+				"vault": map[string]any{
+					"server":  "https://vault.example.com:8200",
+					"path":    "secret",
+					"version": "v2",
+					"auth": map[string]any{
+						"kubernetes": map[string]any{"mountPath": "kubernetes", "role": "reading-list"},
+					},
+				},
+			},
+		},
+	}, out[3])
+}
+
+func TestApplyCSI(t *testing.T) {
+	db := secretRef("db", vaultSource(openchoreov1alpha1.VaultDeliveryCSI), dataSource("password", "db/creds", "value"))
+	es := externalSecret("reading-list-env", [2]string{"DB_PASSWORD", "db/creds"})
+	workload := deployment("reading-list-env")
+	other := deployment("unrelated")
+
+	out, err := Apply([]map[string]any{es, workload}, []map[string]any{workload, other}, Params{
+		Namespace:        "dp-acme-dev",
+		ComponentName:    "reading-list",
+		SecretReferences: []*openchoreov1alpha1.SecretReference{db},
+	})
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+
+	spc := out[0]
+	assert.Equal(t, "SecretProviderClass", spc["kind"])
+	spec := spc["spec"].(map[string]any)
+	assert.Equal(t, "vault", spec["provider"])
+	params := spec["parameters"].(map[string]any)
+	assert.Equal(t, "https://vault.example.com:8200", params["vaultAddress"])
+	assert.Equal(t, "reading-list", params["roleName"])
+
+	var objects []map[string]string
+	require.NoError(t, yaml.Unmarshal([]byte(params["objects"].(string)), &objects))
+	assert.Equal(t, []map[string]string{
+		{"objectName": "DB_PASSWORD", "secretPath": "secret/data/db/creds", "secretKey": "value"},
+	}, objects)
+	assert.Equal(t, []any{map[string]any{
+		"secretName": "reading-list-env",
+		"type":       "Opaque",
+		"data":       []any{map[string]any{"objectName": "DB_PASSWORD", "key": "DB_PASSWORD"}},
+	}}, spec["secretObjects"])
+
+	// Only the pods that consume the synced Secret mount the CSI volume.
+	volumes := podspec.Find(workload)["volumes"].([]any)
+	require.Len(t, volumes, 1)
+	assert.Equal(t, CSIDriver, volumes[0].(map[string]any)["csi"].(map[string]any)["driver"])
+	container := podspec.Find(workload)["containers"].([]any)[0].(map[string]any)
+	assert.Equal(t, CSIMountRoot+"/reading-list-env", container["volumeMounts"].([]any)[0].(map[string]any)["mountPath"])
+	assert.NotContains(t, podspec.Find(other), "volumes")
+}
+
+func TestApplyErrors(t *testing.T) {
+	t.Run("CSI reference sharing a secret", func(t *testing.T) {
+		db := secretRef("db", vaultSource(openchoreov1alpha1.VaultDeliveryCSI), dataSource("password", "db/creds", "value"))
+		api := secretRef("api", nil, dataSource("token", "api/token", "value"))
+		es := externalSecret("env", [2]string{"DB_PASSWORD", "db/creds"}, [2]string{"API_TOKEN", "api/token"})
+		_, err := Apply([]map[string]any{es}, nil, Params{SecretReferences: []*openchoreov1alpha1.SecretReference{db, api}})
+		require.ErrorContains(t, err, "cannot share a secret with other sources")
+	})
+
+	t.Run("ambiguous remote reference", func(t *testing.T) {
+		db := secretRef("db", vaultSource(""), dataSource("password", "db/creds", "value"))
+		legacy := secretRef("legacy-db", nil, dataSource("password", "db/creds", "value"))
+		es := externalSecret("env", [2]string{"DB_PASSWORD", "db/creds"})
+		_, err := Apply([]map[string]any{es}, nil, Params{SecretReferences: []*openchoreov1alpha1.SecretReference{db, legacy}})
+		require.ErrorContains(t, err, "different secret sources")
+	})
+
+	t.Run("invalid vault source", func(t *testing.T) {
+		source := vaultSource("")
+		source.Server = "vault.example.com"
+		db := secretRef("db", source, dataSource("password", "db/creds", "value"))
+		_, err := Apply(nil, nil, Params{SecretReferences: []*openchoreov1alpha1.SecretReference{db}})
+		require.ErrorContains(t, err, `SecretReference "db": invalid Vault server address`)
+	})
+}
+
+func TestSecretPath(t *testing.T) {
+	source := vaultSource("")
+	assert.Equal(t, "secret/data/db/creds", secretPath(source, "db/creds"))
+	source.Version = openchoreov1alpha1.VaultKVVersion1
+	source.Path = "/kv/"
+	assert.Equal(t, "kv/db/creds", secretPath(source, "/db/creds"))
+}
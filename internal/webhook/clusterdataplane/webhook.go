@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterdataplane
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// log is for logging in this package.
+var clusterdataplanelog = logf.Log.WithName("clusterdataplane-resource")
+
+// SetupClusterDataPlaneWebhookWithManager registers the webhook for ClusterDataPlane in the manager.
+func SetupClusterDataPlaneWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &openchoreov1alpha1.ClusterDataPlane{}).
+		WithCustomValidator(&Validator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-clusterdataplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=clusterdataplanes,verbs=create;update,versions=v1alpha1,name=vclusterdataplane-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Validator validates the connection details of ClusterDataPlane resources
+// +kubebuilder:object:generate=false
+type Validator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &Validator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ClusterDataPlane.
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	clusterDataPlane, ok := obj.(*openchoreov1alpha1.ClusterDataPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterDataPlane object but got %T", obj)
+	}
+	clusterdataplanelog.Info("Validation for ClusterDataPlane upon creation", "name", clusterDataPlane.GetName())
+
+	return v.validateClusterAgent(ctx, clusterDataPlane)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ClusterDataPlane.
+// The connection details are only checked when they change, so that a rotated or deleted secret
+// does not block unrelated updates.
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldClusterDataPlane, ok := oldObj.(*openchoreov1alpha1.ClusterDataPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterDataPlane object for the oldObj but got %T", oldObj)
+	}
+	clusterDataPlane, ok := newObj.(*openchoreov1alpha1.ClusterDataPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterDataPlane object for the newObj but got %T", newObj)
+	}
+	clusterdataplanelog.Info("Validation for ClusterDataPlane upon update", "name", clusterDataPlane.GetName())
+
+	if reflect.DeepEqual(oldClusterDataPlane.Spec.ClusterAgent, clusterDataPlane.Spec.ClusterAgent) {
+		return nil, nil
+	}
+	return v.validateClusterAgent(ctx, clusterDataPlane)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ClusterDataPlane.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateClusterAgent rejects malformed connection details. A client CA secret that does not
+// exist yet only produces a warning, since it may be applied together with the ClusterDataPlane; the
+// controller reports it on the Validated condition until it appears.
+func (v *Validator) validateClusterAgent(ctx context.Context, clusterDataPlane *openchoreov1alpha1.ClusterDataPlane) (admission.Warnings, error) {
+	err := controller.ValidateClusterAgent(ctx, v.Client, "", &clusterDataPlane.Spec.ClusterAgent)
+	if err == nil {
+		return nil, nil
+	}
+	if apierrors.IsNotFound(err) {
+		return admission.Warnings{err.Error()}, nil
+	}
+	return nil, err
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterworkflowplane
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// log is for logging in this package.
+var clusterworkflowplanelog = logf.Log.WithName("clusterworkflowplane-resource")
+
+// SetupClusterWorkflowPlaneWebhookWithManager registers the webhook for ClusterWorkflowPlane in the manager.
+func SetupClusterWorkflowPlaneWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &openchoreov1alpha1.ClusterWorkflowPlane{}).
+		WithCustomValidator(&Validator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-clusterworkflowplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=clusterworkflowplanes,verbs=create;update,versions=v1alpha1,name=vclusterworkflowplane-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Validator validates the connection details of ClusterWorkflowPlane resources
+// +kubebuilder:object:generate=false
+type Validator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &Validator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ClusterWorkflowPlane.
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	clusterWorkflowPlane, ok := obj.(*openchoreov1alpha1.ClusterWorkflowPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterWorkflowPlane object but got %T", obj)
+	}
+	clusterworkflowplanelog.Info("Validation for ClusterWorkflowPlane upon creation", "name", clusterWorkflowPlane.GetName())
+
+	return v.validateClusterAgent(ctx, clusterWorkflowPlane)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ClusterWorkflowPlane.
+// The connection details are only checked when they change, so that a rotated or deleted secret
+// does not block unrelated updates.
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldClusterWorkflowPlane, ok := oldObj.(*openchoreov1alpha1.ClusterWorkflowPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterWorkflowPlane object for the oldObj but got %T", oldObj)
+	}
+	clusterWorkflowPlane, ok := newObj.(*openchoreov1alpha1.ClusterWorkflowPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterWorkflowPlane object for the newObj but got %T", newObj)
+	}
+	clusterworkflowplanelog.Info("Validation for ClusterWorkflowPlane upon update", "name", clusterWorkflowPlane.GetName())
+
+	if reflect.DeepEqual(oldClusterWorkflowPlane.Spec.ClusterAgent, clusterWorkflowPlane.Spec.ClusterAgent) {
+		return nil, nil
+	}
+	return v.validateClusterAgent(ctx, clusterWorkflowPlane)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ClusterWorkflowPlane.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateClusterAgent rejects malformed connection details. A client CA secret that does not
+// exist yet only produces a warning, since it may be applied together with the ClusterWorkflowPlane; the
+// controller reports it on the Validated condition until it appears.
+func (v *Validator) validateClusterAgent(ctx context.Context, clusterWorkflowPlane *openchoreov1alpha1.ClusterWorkflowPlane) (admission.Warnings, error) {
+	err := controller.ValidateClusterAgent(ctx, v.Client, "", &clusterWorkflowPlane.Spec.ClusterAgent)
+	if err == nil {
+		return nil, nil
+	}
+	if apierrors.IsNotFound(err) {
+		return admission.Warnings{err.Error()}, nil
+	}
+	return nil, err
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterworkflowplane
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var _ = Describe("ClusterWorkflowPlane Webhook", func() {
+	var (
+		validator Validator
+		caPEM     string
+	)
+
+	BeforeEach(func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "Test CA"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(24 * time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).NotTo(HaveOccurred())
+		caPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent-ca", Namespace: "default"},
+			Data:       map[string][]byte{"ca.crt": []byte(caPEM)},
+		}
+		validator = Validator{
+			Client: fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(secret).Build(),
+		}
+	})
+
+	newPlane := func(clientCA openchoreov1alpha1.ValueFrom) *openchoreov1alpha1.ClusterWorkflowPlane {
+		plane := &openchoreov1alpha1.ClusterWorkflowPlane{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		plane.Spec.ClusterAgent.ClientCA = clientCA
+		return plane
+	}
+
+	secretRef := func(name string) openchoreov1alpha1.ValueFrom {
+		return openchoreov1alpha1.ValueFrom{
+			SecretKeyRef: &openchoreov1alpha1.SecretKeyReference{Namespace: "default", Name: name, Key: "ca.crt"},
+		}
+	}
+
+	Context("ValidateCreate", func() {
+		It("should allow an inline PEM client CA", func() {
+			warnings, err := validator.ValidateCreate(ctx, newPlane(openchoreov1alpha1.ValueFrom{Value: caPEM}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should allow a client CA from an existing secret", func() {
+			warnings, err := validator.ValidateCreate(ctx, newPlane(secretRef("agent-ca")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should reject an inline client CA that is not PEM", func() {
+			_, err := validator.ValidateCreate(ctx, newPlane(openchoreov1alpha1.ValueFrom{Value: "not a certificate"}))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no PEM encoded certificate found"))
+		})
+
+		It("should warn about a client CA secret that does not exist yet", func() {
+			warnings, err := validator.ValidateCreate(ctx, newPlane(secretRef("missing")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0]).To(ContainSubstring("failed to get secret"))
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should not re-check unchanged connection details", func() {
+			oldPlane := newPlane(openchoreov1alpha1.ValueFrom{Value: "not a certificate"})
+			updated := oldPlane.DeepCopy()
+			updated.Labels = map[string]string{"team": "platform"}
+			_, err := validator.ValidateUpdate(ctx, oldPlane, updated)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should check changed connection details", func() {
+			_, err := validator.ValidateUpdate(ctx,
+				newPlane(openchoreov1alpha1.ValueFrom{Value: caPEM}),
+				newPlane(openchoreov1alpha1.ValueFrom{Value: "not a certificate"}))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
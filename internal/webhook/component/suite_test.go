@@ -96,7 +96,7 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
-	err = SetupComponentWebhookWithManager(mgr)
+	err = SetupComponentWebhookWithManager(mgr, "")
 	Expect(err).NotTo(HaveOccurred())
 
 	go func() {
@@ -6,9 +6,12 @@ package component
 import (
 	"context"
 	"fmt"
+	"net/url"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,6 +20,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/logparsing"
+	"github.com/openchoreo/openchoreo/internal/validation/workflowparams"
 )
 
 // nolint:unused
@@ -24,9 +31,11 @@ import (
 var componentlog = logf.Log.WithName("component-resource")
 
 // SetupComponentWebhookWithManager registers the webhook for Component in the manager.
-func SetupComponentWebhookWithManager(mgr ctrl.Manager) error {
+// transferUser is the user allowed to move components between projects; when empty,
+// spec.owner.projectName cannot be changed.
+func SetupComponentWebhookWithManager(mgr ctrl.Manager, transferUser string) error {
 	return ctrl.NewWebhookManagedBy(mgr, &openchoreodevv1alpha1.Component{}).
-		WithCustomValidator(&Validator{Client: mgr.GetClient()}).
+		WithCustomValidator(&Validator{Client: mgr.GetClient(), TransferUser: transferUser}).
 		WithCustomDefaulter(&Defaulter{}).
 		Complete()
 }
@@ -48,10 +57,51 @@ var _ webhook.CustomDefaulter = &Defaulter{}
 
 // Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind Component.
 func (d *Defaulter) Default(_ context.Context, obj runtime.Object) error {
-	// No-op: Defaulting logic disabled for now
+	component, ok := obj.(*openchoreodevv1alpha1.Component)
+	if !ok {
+		return fmt.Errorf("expected a Component object but got %T", obj)
+	}
+	syncCatalogLabels(component)
+	syncArchivedLabel(component)
 	return nil
 }
 
+// syncCatalogLabels mirrors the team, domain and tier of spec.catalog onto the component's labels
+// so components can be filtered with label selectors. Labels of unset fields are removed.
+func syncCatalogLabels(component *openchoreodevv1alpha1.Component) {
+	catalog := component.Spec.Catalog
+	if catalog == nil {
+		catalog = &openchoreodevv1alpha1.ComponentCatalog{}
+	}
+	values := map[string]string{
+		labels.LabelKeyTeam:   catalog.Team,
+		labels.LabelKeyDomain: catalog.Domain,
+		labels.LabelKeyTier:   catalog.Tier,
+	}
+	for key, value := range values {
+		if value == "" {
+			delete(component.Labels, key)
+			continue
+		}
+		if component.Labels == nil {
+			component.Labels = map[string]string{}
+		}
+		component.Labels[key] = value
+	}
+}
+
+// syncArchivedLabel labels an archived component so that component lists can leave it out.
+func syncArchivedLabel(component *openchoreodevv1alpha1.Component) {
+	if !component.Spec.IsArchived() {
+		delete(component.Labels, labels.LabelKeyArchived)
+		return
+	}
+	if component.Labels == nil {
+		component.Labels = map[string]string{}
+	}
+	component.Labels[labels.LabelKeyArchived] = labels.LabelValueTrue
+}
+
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion component.
 // NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
 // Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
@@ -64,6 +114,12 @@ func (d *Defaulter) Default(_ context.Context, obj runtime.Object) error {
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type Validator struct {
 	Client client.Client
+
+	// TransferUser is the user, typically the openchoreo-api service account, that moves
+	// components between projects after checking the caller's permissions and the target
+	// project's deployment pipeline. Only it may change spec.owner.projectName; when empty,
+	// spec.owner.projectName is immutable.
+	TransferUser string
 }
 
 var _ webhook.CustomValidator = &Validator{}
@@ -84,6 +140,15 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 
 	// Validate unique trait instance names
 	allErrs = append(allErrs, validateUniqueTraitInstanceNames(component)...)
+	allErrs = append(allErrs, validateCatalog(component.Spec.Catalog)...)
+	allErrs = append(allErrs, validateLogParsing(component.Spec.LogParsing)...)
+	allErrs = append(allErrs, validateLibraries(component)...)
+
+	paramErrs, err := v.validateWorkflowParameters(ctx, component)
+	if err != nil {
+		return warnings, err
+	}
+	allErrs = append(allErrs, paramErrs...)
 
 	if len(allErrs) > 0 {
 		return warnings, apierrors.NewInvalid(component.GroupVersionKind().GroupKind(), component.GetName(), allErrs)
@@ -94,7 +159,7 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Component.
 func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
-	_, ok := oldObj.(*openchoreodevv1alpha1.Component)
+	oldComponent, ok := oldObj.(*openchoreodevv1alpha1.Component)
 	if !ok {
 		return nil, fmt.Errorf("expected a Component object for the oldObj but got %T", oldObj)
 	}
@@ -110,10 +175,25 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 
 	// Note: Required field validations (componentType, owner.projectName, traits.name, traits.instanceName) are enforced by the CRD schema
 	// Note: spec.componentType immutability is enforced by CEL rules in the CRD schema
+	// Note: spec.owner.projectName may only change through a component transfer by the transfer user
 	// Note: Cross-resource validation (ComponentType, Trait, schema validation) is handled by the controller
 
 	// Validate unique trait instance names
 	allErrs = append(allErrs, validateUniqueTraitInstanceNames(newComponent)...)
+	allErrs = append(allErrs, validateCatalog(newComponent.Spec.Catalog)...)
+	allErrs = append(allErrs, validateLogParsing(newComponent.Spec.LogParsing)...)
+	allErrs = append(allErrs, validateLibraries(newComponent)...)
+	allErrs = append(allErrs, v.validateOwnerTransfer(ctx, oldComponent, newComponent)...)
+
+	// Workflow parameters stored before plaintext credentials were rejected are only checked
+	// once they change, so that other fields of such components can still be updated
+	if !equality.Semantic.DeepEqual(oldComponent.Spec.Workflow, newComponent.Spec.Workflow) {
+		paramErrs, err := v.validateWorkflowParameters(ctx, newComponent)
+		if err != nil {
+			return warnings, err
+		}
+		allErrs = append(allErrs, paramErrs...)
+	}
 
 	if len(allErrs) > 0 {
 		return warnings, apierrors.NewInvalid(newComponent.GroupVersionKind().GroupKind(), newComponent.GetName(), allErrs)
@@ -134,6 +214,20 @@ func (v *Validator) ValidateDelete(ctx context.Context, obj runtime.Object) (adm
 	return nil, nil
 }
 
+// validateWorkflowParameters rejects workflow parameters that carry plaintext credentials,
+// except under properties the workflow schema allows plaintext values for
+func (v *Validator) validateWorkflowParameters(ctx context.Context, component *openchoreodevv1alpha1.Component) (field.ErrorList, error) {
+	wf := component.Spec.Workflow
+	if wf == nil || wf.Parameters == nil {
+		return nil, nil
+	}
+	schema, err := workflowparams.ResolveParametersSchema(ctx, v.Client, component.Namespace, wf.Kind, wf.Name, wf.Version)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to resolve workflow parameters schema: %w", err))
+	}
+	return workflowparams.ValidatePlaintextCredentials(field.NewPath("spec", "workflow", "parameters"), wf.Parameters, schema), nil
+}
+
 // validateUniqueTraitInstanceNames validates that trait instance names are unique within a component
 func validateUniqueTraitInstanceNames(component *openchoreodevv1alpha1.Component) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -150,3 +244,92 @@ func validateUniqueTraitInstanceNames(component *openchoreodevv1alpha1.Component
 
 	return allErrs
 }
+
+// validateOwnerTransfer validates that the project of a component only changes as part of a
+// component transfer: the update must be made by the transfer user and record the project it
+// moves the component from in an annotation. The annotation alone is not enough, since any
+// caller can set it.
+func (v *Validator) validateOwnerTransfer(ctx context.Context, oldComponent, newComponent *openchoreodevv1alpha1.Component) field.ErrorList {
+	allErrs := field.ErrorList{}
+	oldProject := oldComponent.Spec.Owner.ProjectName
+	if newComponent.Spec.Owner.ProjectName == oldProject {
+		return allErrs
+	}
+	username := ""
+	if req, err := admission.RequestFromContext(ctx); err == nil {
+		username = req.UserInfo.Username
+	}
+	if v.TransferUser == "" || username != v.TransferUser ||
+		newComponent.Annotations[controller.AnnotationKeyTransferredFrom] != oldProject {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "owner", "projectName"),
+			"spec.owner.projectName can only be changed by transferring the component"))
+	}
+	return allErrs
+}
+
+// validateLibraries validates that a component depends on each library once and that
+// library components, which are never deployed, do not enable autoDeploy
+func validateLibraries(component *openchoreodevv1alpha1.Component) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if component.Spec.IsLibrary() && component.Spec.AutoDeploy {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "autoDeploy"),
+			"library components are not deployable"))
+	}
+
+	libraries := make(map[string]bool)
+	for i, lib := range component.Spec.Libraries {
+		if libraries[lib.Component] {
+			allErrs = append(allErrs, field.Duplicate(
+				field.NewPath("spec", "libraries").Index(i).Child("component"),
+				lib.Component))
+		}
+		libraries[lib.Component] = true
+	}
+	return allErrs
+}
+
+// validateCatalog validates that the catalog team, domain and tier are valid label values
+// and that the on-call and repository links are absolute http(s) URLs
+func validateCatalog(catalog *openchoreodevv1alpha1.ComponentCatalog) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if catalog == nil {
+		return allErrs
+	}
+	path := field.NewPath("spec", "catalog")
+
+	for _, f := range []struct{ name, value string }{
+		{"team", catalog.Team}, {"domain", catalog.Domain}, {"tier", catalog.Tier},
+	} {
+		if f.value == "" {
+			continue
+		}
+		for _, msg := range validation.IsDNS1123Label(f.value) {
+			allErrs = append(allErrs, field.Invalid(path.Child(f.name), f.value, msg))
+		}
+	}
+	for _, f := range []struct{ name, value string }{
+		{"onCall", catalog.OnCall}, {"repository", catalog.Repository},
+	} {
+		if f.value == "" {
+			continue
+		}
+		u, err := url.Parse(f.value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child(f.name), f.value, "must be an absolute http or https URL"))
+		}
+	}
+	return allErrs
+}
+
+// validateLogParsing validates that the log parsing profile patterns compile, that mapped
+// fields refer to capture groups of the pattern and that severity mappings use known levels
+func validateLogParsing(profile *openchoreodevv1alpha1.LogParsingProfile) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if profile == nil {
+		return allErrs
+	}
+	if _, err := logparsing.Compile(profile); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "logParsing"), profile.Format, err.Error()))
+	}
+	return allErrs
+}
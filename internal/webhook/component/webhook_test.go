@@ -4,8 +4,14 @@
 package component
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
@@ -25,6 +31,13 @@ var _ = Describe("Component Webhook", func() {
 		defaulter = Defaulter{}
 	})
 
+	const transferUser = "system:serviceaccount:openchoreo-control-plane:openchoreo-api"
+	requestBy := func(username string) context.Context {
+		return admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: username},
+		}})
+	}
+
 	componentWithTraits := func(traits []openchoreodevv1alpha1.ComponentTrait) *openchoreodevv1alpha1.Component {
 		c := &openchoreodevv1alpha1.Component{}
 		c.Spec.Traits = traits
@@ -32,11 +45,37 @@ var _ = Describe("Component Webhook", func() {
 	}
 
 	Context("Defaulter webhook", func() {
-		It("should return nil for a valid Component (no-op defaulter)", func() {
+		It("should return nil for a valid Component", func() {
 			err := defaulter.Default(ctx, obj)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should mirror catalog team, domain and tier onto labels", func() {
+			obj.Labels = map[string]string{"openchoreo.dev/tier": "tier-3", "app": "web"}
+			obj.Spec.Catalog = &openchoreodevv1alpha1.ComponentCatalog{Team: "payments", Domain: "checkout"}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).To(Equal(map[string]string{
+				"openchoreo.dev/team":   "payments",
+				"openchoreo.dev/domain": "checkout",
+				"app":                   "web",
+			}))
+		})
+
+		It("should label archived components", func() {
+			obj.Spec.State = openchoreodevv1alpha1.ComponentStateArchived
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).To(HaveKeyWithValue("openchoreo.dev/archived", "true"))
+
+			obj.Spec.State = openchoreodevv1alpha1.ComponentStateActive
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Labels).NotTo(HaveKey("openchoreo.dev/archived"))
+		})
+
+		It("should return an error when given a non-Component object", func() {
+			err := defaulter.Default(ctx, &openchoreodevv1alpha1.Project{})
+			Expect(err).To(HaveOccurred())
+		})
+
 	})
 
 	Context("ValidateCreate", func() {
@@ -64,6 +103,78 @@ var _ = Describe("Component Webhook", func() {
 			Expect(err.Error()).To(ContainSubstring("my-sidecar"))
 		})
 
+		It("should admit a Component with valid catalog metadata", func() {
+			obj.Spec.Catalog = &openchoreodevv1alpha1.ComponentCatalog{
+				Team:       "payments",
+				Tier:       "tier-1",
+				OnCall:     "https://oncall.example.com/schedules/payments",
+				Repository: "https://github.com/example/checkout",
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject a Component with invalid catalog metadata", func() {
+			obj.Spec.Catalog = &openchoreodevv1alpha1.ComponentCatalog{
+				Team:       "Payments Team",
+				Repository: "git@github.com:example/checkout.git",
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.catalog.team"))
+			Expect(err.Error()).To(ContainSubstring("spec.catalog.repository"))
+		})
+
+		It("should reject a Component whose log parsing profile does not compile", func() {
+			obj.Spec.LogParsing = &openchoreodevv1alpha1.LogParsingProfile{
+				Format:  openchoreodevv1alpha1.LogFormatRegex,
+				Pattern: `^(?P<level>\w+) (?P<msg>.*)$`,
+				Fields:  map[string]string{"logger": "logger"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.logParsing"))
+			Expect(err.Error()).To(ContainSubstring(`no capture group named "logger"`))
+		})
+
+		It("should reject a library Component with autoDeploy enabled", func() {
+			obj.Spec.ComponentType = openchoreodevv1alpha1.ComponentTypeRef{Name: "library/go-module"}
+			obj.Spec.AutoDeploy = true
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.autoDeploy"))
+		})
+
+		It("should reject a Component depending on the same library twice", func() {
+			obj.Spec.Libraries = []openchoreodevv1alpha1.LibraryDependency{
+				{Component: "shared-auth", Version: "1.0.0"},
+				{Component: "shared-auth", Version: "1.1.0"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.libraries[1].component"))
+		})
+
+		It("should reject workflow parameters carrying a plaintext credential", func() {
+			obj.Spec.Workflow = &openchoreodevv1alpha1.ComponentWorkflowConfig{
+				Name:       "dockerfile-builder",
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"buildEnv":[{"name":"NPM_TOKEN","value":"npm-token-value"}]}`)},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.workflow.parameters.buildEnv[0].value"))
+			Expect(err.Error()).NotTo(ContainSubstring("npm-token-value"))
+		})
+
+		It("should admit workflow parameters that reference a secret", func() {
+			obj.Spec.Workflow = &openchoreodevv1alpha1.ComponentWorkflowConfig{
+				Name:       "dockerfile-builder",
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"repository":{"url":"https://github.com/org/repo","secretRef":"git-credentials"}}`)},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		It("should return an error when given a non-Component object", func() {
 			wrongObj := &openchoreodevv1alpha1.Project{}
 			_, err := validator.ValidateCreate(ctx, wrongObj)
@@ -97,6 +208,57 @@ var _ = Describe("Component Webhook", func() {
 			Expect(err.Error()).To(ContainSubstring("dup"))
 		})
 
+		It("should reject a project change that is not a transfer", func() {
+			oldObj.Spec.Owner.ProjectName = "team-a"
+			obj.Spec.Owner.ProjectName = "team-b"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("transferring the component"))
+		})
+
+		It("should reject a transfer annotation set by another user", func() {
+			validator.TransferUser = transferUser
+			oldObj.Spec.Owner.ProjectName = "team-a"
+			obj.Spec.Owner.ProjectName = "team-b"
+			obj.Annotations = map[string]string{"openchoreo.dev/transferred-from": "team-a"}
+			_, err := validator.ValidateUpdate(requestBy("alice"), oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("transferring the component"))
+		})
+
+		It("should reject a project change when no transfer user is configured", func() {
+			oldObj.Spec.Owner.ProjectName = "team-a"
+			obj.Spec.Owner.ProjectName = "team-b"
+			obj.Annotations = map[string]string{"openchoreo.dev/transferred-from": "team-a"}
+			_, err := validator.ValidateUpdate(requestBy(transferUser), oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should admit a project change recorded as a transfer by the transfer user", func() {
+			validator.TransferUser = transferUser
+			oldObj.Spec.Owner.ProjectName = "team-a"
+			obj.Spec.Owner.ProjectName = "team-b"
+			obj.Annotations = map[string]string{"openchoreo.dev/transferred-from": "team-a"}
+			_, err := validator.ValidateUpdate(requestBy(transferUser), oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should only check workflow parameters when they change", func() {
+			oldObj.Spec.Workflow = &openchoreodevv1alpha1.ComponentWorkflowConfig{
+				Name:       "dockerfile-builder",
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"registry":{"password":"hunter2"}}`)},
+			}
+			obj.Spec.Workflow = oldObj.Spec.Workflow.DeepCopy()
+			obj.Spec.Catalog = &openchoreodevv1alpha1.ComponentCatalog{Team: "payments"}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Workflow.Parameters = &runtime.RawExtension{Raw: []byte(`{"registry":{"password":"hunter3"}}`)}
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.workflow.parameters.registry.password"))
+		})
+
 		It("should return an error when oldObj is not a Component", func() {
 			wrongObj := &openchoreodevv1alpha1.Project{}
 			_, err := validator.ValidateUpdate(ctx, wrongObj, obj)
@@ -0,0 +1,45 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentowner
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// projectNamePath is the path of the project of a resource owned by a component.
+var projectNamePath = field.NewPath("spec", "owner", "projectName")
+
+// ValidateProjectChange validates a change of spec.owner.projectName of a resource owned by a
+// component. The project of such a resource may only follow its component: it may change to the
+// project the component currently belongs to, which is how a component transfer moves the
+// resources of a component after moving the component itself. Returns an error when the
+// component cannot be read.
+func ValidateProjectChange(ctx context.Context, c client.Reader, namespace, componentName, oldProject, newProject string) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+	if newProject == oldProject {
+		return allErrs, nil
+	}
+
+	component := &openchoreodevv1alpha1.Component{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: componentName}, component); err != nil {
+		if apierrors.IsNotFound(err) {
+			return append(allErrs, field.Forbidden(projectNamePath,
+				fmt.Sprintf("component %q not found; spec.owner.projectName can only follow the project of the component", componentName))), nil
+		}
+		return nil, fmt.Errorf("failed to get Component %q: %w", componentName, err)
+	}
+	if component.Spec.Owner.ProjectName != newProject {
+		allErrs = append(allErrs, field.Forbidden(projectNamePath,
+			fmt.Sprintf("spec.owner.projectName can only change to %q, the project of component %q",
+				component.Spec.Owner.ProjectName, componentName)))
+	}
+	return allErrs, nil
+}
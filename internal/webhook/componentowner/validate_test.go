@@ -0,0 +1,106 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentowner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreodevv1alpha1.AddToScheme(s))
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+}
+
+func testComponent(project string) *openchoreodevv1alpha1.Component {
+	return &openchoreodevv1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "default"},
+		Spec: openchoreodevv1alpha1.ComponentSpec{
+			Owner: openchoreodevv1alpha1.ComponentOwner{ProjectName: project},
+		},
+	}
+}
+
+func TestValidateProjectChange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unchanged project needs no component", func(t *testing.T) {
+		errs, err := ValidateProjectChange(ctx, newTestClient(t), "default", "checkout", "team-a", "team-a")
+		require.NoError(t, err)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("follows the component to its project", func(t *testing.T) {
+		c := newTestClient(t, testComponent("team-b"))
+		errs, err := ValidateProjectChange(ctx, c, "default", "checkout", "team-a", "team-b")
+		require.NoError(t, err)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("rejects a project other than the component's", func(t *testing.T) {
+		c := newTestClient(t, testComponent("team-a"))
+		errs, err := ValidateProjectChange(ctx, c, "default", "checkout", "team-a", "team-b")
+		require.NoError(t, err)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.owner.projectName", errs[0].Field)
+		assert.Contains(t, errs[0].Detail, `can only change to "team-a"`)
+	})
+
+	t.Run("rejects a change when the component is missing", func(t *testing.T) {
+		errs, err := ValidateProjectChange(ctx, newTestClient(t), "default", "checkout", "team-a", "team-b")
+		require.NoError(t, err)
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Detail, "not found")
+	})
+}
+
+func TestValidatorValidateUpdate(t *testing.T) {
+	ctx := context.Background()
+	workload := func(project string) *openchoreodevv1alpha1.Workload {
+		return &openchoreodevv1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{Name: "checkout-workload", Namespace: "default"},
+			Spec: openchoreodevv1alpha1.WorkloadSpec{
+				Owner: openchoreodevv1alpha1.WorkloadOwner{ProjectName: project, ComponentName: "checkout"},
+			},
+		}
+	}
+
+	t.Run("admits a workload following its component", func(t *testing.T) {
+		v := &Validator{Client: newTestClient(t, testComponent("team-b"))}
+		_, err := v.ValidateUpdate(ctx, workload("team-a"), workload("team-b"))
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects a workload moved away from its component", func(t *testing.T) {
+		v := &Validator{Client: newTestClient(t, testComponent("team-a"))}
+		_, err := v.ValidateUpdate(ctx, workload("team-a"), workload("team-b"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "spec.owner.projectName")
+	})
+
+	t.Run("ignores rendered releases owned by a resource", func(t *testing.T) {
+		rendered := func(project string) *openchoreodevv1alpha1.RenderedRelease {
+			return &openchoreodevv1alpha1.RenderedRelease{
+				ObjectMeta: metav1.ObjectMeta{Name: "db-dev", Namespace: "default"},
+				Spec: openchoreodevv1alpha1.RenderedReleaseSpec{
+					Owner: openchoreodevv1alpha1.RenderedReleaseOwner{ProjectName: project, ResourceName: "db"},
+				},
+			}
+		}
+		v := &Validator{Client: newTestClient(t)}
+		_, err := v.ValidateUpdate(ctx, rendered("team-a"), rendered("team-a"))
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentowner
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// log is for logging in this package.
+var componentownerlog = logf.Log.WithName("componentowner-resource")
+
+// SetupComponentOwnerWebhooksWithManager registers the webhooks that guard spec.owner.projectName
+// of the resources owned by a component that have no webhook of their own. ReleaseBindings run
+// the same check in their own webhook, and ComponentReleases keep their whole owner immutable.
+func SetupComponentOwnerWebhooksWithManager(mgr ctrl.Manager) error {
+	objs := []runtime.Object{
+		&openchoreodevv1alpha1.Workload{},
+		&openchoreodevv1alpha1.RenderedRelease{},
+		&openchoreodevv1alpha1.DomainMapping{},
+		&openchoreodevv1alpha1.LogMetric{},
+		&openchoreodevv1alpha1.ServiceLevelObjective{},
+		&openchoreodevv1alpha1.AnomalyDetector{},
+	}
+	for _, obj := range objs {
+		if err := ctrl.NewWebhookManagedBy(mgr, obj).
+			WithCustomValidator(&Validator{Client: mgr.GetClient()}).
+			Complete(); err != nil {
+			return fmt.Errorf("failed to set up webhook for %T: %w", obj, err)
+		}
+	}
+	return nil
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-workload,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=workloads,verbs=update,versions=v1alpha1,name=vworkload-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-renderedrelease,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=renderedreleases,verbs=update,versions=v1alpha1,name=vrenderedrelease-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-domainmapping,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=domainmappings,verbs=update,versions=v1alpha1,name=vdomainmapping-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-logmetric,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=logmetrics,verbs=update,versions=v1alpha1,name=vlogmetric-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-servicelevelobjective,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=servicelevelobjectives,verbs=update,versions=v1alpha1,name=vservicelevelobjective-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-anomalydetector,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=anomalydetectors,verbs=update,versions=v1alpha1,name=vanomalydetector-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Validator validates updates of the resources owned by a component.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as this struct is used only for temporary operations and does not need to be deeply copied.
+type Validator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &Validator{}
+
+// ValidateCreate implements webhook.CustomValidator. Creation is not validated.
+func (v *Validator) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator and validates that spec.owner.projectName
+// only follows the project of the owning component.
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	// Note: spec.owner.componentName immutability is enforced by CEL rules in the CRD schema
+	oldComponent, oldProject, ok := componentOwner(oldObj)
+	if !ok {
+		return nil, nil
+	}
+	_, newProject, ok := componentOwner(newObj)
+	if !ok {
+		return nil, fmt.Errorf("expected a %T object for the newObj but got %T", oldObj, newObj)
+	}
+
+	newClientObj, ok := newObj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("expected a client.Object but got %T", newObj)
+	}
+	allErrs, err := ValidateProjectChange(ctx, v.Client, newClientObj.GetNamespace(), oldComponent, oldProject, newProject)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if len(allErrs) > 0 {
+		componentownerlog.Info("Rejected project change", "kind", fmt.Sprintf("%T", newObj),
+			"name", newClientObj.GetName(), "namespace", newClientObj.GetNamespace())
+		gvk := newObj.GetObjectKind().GroupVersionKind()
+		return nil, apierrors.NewInvalid(gvk.GroupKind(), newClientObj.GetName(), allErrs)
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator. Deletion is not validated.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// componentOwner returns the owning component and project of a resource owned by a component.
+// It reports false for other objects, including RenderedReleases owned by a resource.
+func componentOwner(obj runtime.Object) (componentName, projectName string, ok bool) {
+	switch o := obj.(type) {
+	case *openchoreodevv1alpha1.Workload:
+		return o.Spec.Owner.ComponentName, o.Spec.Owner.ProjectName, true
+	case *openchoreodevv1alpha1.RenderedRelease:
+		return o.Spec.Owner.ComponentName, o.Spec.Owner.ProjectName, o.Spec.Owner.ComponentName != ""
+	case *openchoreodevv1alpha1.DomainMapping:
+		return o.Spec.Owner.ComponentName, o.Spec.Owner.ProjectName, true
+	case *openchoreodevv1alpha1.LogMetric:
+		return o.Spec.Owner.ComponentName, o.Spec.Owner.ProjectName, true
+	case *openchoreodevv1alpha1.ServiceLevelObjective:
+		return o.Spec.Owner.ComponentName, o.Spec.Owner.ProjectName, true
+	case *openchoreodevv1alpha1.AnomalyDetector:
+		return o.Spec.Owner.ComponentName, o.Spec.Owner.ProjectName, true
+	default:
+		return "", "", false
+	}
+}
@@ -96,7 +96,7 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
-	err = SetupComponentReleaseWebhookWithManager(mgr)
+	err = SetupComponentReleaseWebhookWithManager(mgr, 0)
 	Expect(err).NotTo(HaveOccurred())
 
 	go func() {
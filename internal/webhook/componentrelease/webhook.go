@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -30,9 +31,13 @@ var componentreleaselog = logf.Log.WithName("componentrelease-resource")
 var omitValue = field.OmitValueType{}
 
 // SetupComponentReleaseWebhookWithManager registers the webhook for ComponentRelease in the manager.
-func SetupComponentReleaseWebhookWithManager(mgr ctrl.Manager) error {
+// A component may have at most maxReleasesPerComponent ComponentReleases; 0 disables the cap.
+func SetupComponentReleaseWebhookWithManager(mgr ctrl.Manager, maxReleasesPerComponent int) error {
 	return ctrl.NewWebhookManagedBy(mgr, &openchoreodevv1alpha1.ComponentRelease{}).
-		WithCustomValidator(&Validator{}).
+		WithCustomValidator(&Validator{
+			Client:                  mgr.GetClient(),
+			MaxReleasesPerComponent: maxReleasesPerComponent,
+		}).
 		WithCustomDefaulter(&Defaulter{}).
 		Complete()
 }
@@ -69,13 +74,19 @@ func (d *Defaulter) Default(_ context.Context, obj runtime.Object) error {
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type Validator struct {
-	// TODO(user): Add more fields as needed for validation
+	Client client.Client
+
+	// MaxReleasesPerComponent is the maximum number of ComponentReleases a component may
+	// have. Creating one more is rejected. Each release embeds the ComponentType, Traits and
+	// Workload it was built from, so an automation creating releases in a loop quickly fills
+	// etcd and slows every list. 0 disables the cap.
+	MaxReleasesPerComponent int
 }
 
 var _ webhook.CustomValidator = &Validator{}
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ComponentRelease.
-func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	componentrelease, ok := obj.(*openchoreodevv1alpha1.ComponentRelease)
 	if !ok {
 		return nil, fmt.Errorf("expected a ComponentRelease object but got %T", obj)
@@ -133,9 +144,47 @@ func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admis
 		return nil, apierrors.NewInvalid(componentrelease.GroupVersionKind().GroupKind(), componentrelease.GetName(), allErrs)
 	}
 
+	if err := v.checkReleaseQuota(ctx, componentrelease); err != nil {
+		return nil, err
+	}
+
 	return nil, nil
 }
 
+// checkReleaseQuota rejects the release when its component already has the maximum number
+// of ComponentReleases. Releases being deleted do not count.
+func (v *Validator) checkReleaseQuota(ctx context.Context, release *openchoreodevv1alpha1.ComponentRelease) error {
+	if v.MaxReleasesPerComponent <= 0 || v.Client == nil {
+		return nil
+	}
+
+	var releases openchoreodevv1alpha1.ComponentReleaseList
+	if err := v.Client.List(ctx, &releases, client.InNamespace(release.Namespace)); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to list ComponentReleases: %w", err))
+	}
+	owner := release.Spec.Owner
+	count := 0
+	for i := range releases.Items {
+		existing := &releases.Items[i]
+		if existing.Spec.Owner.ProjectName == owner.ProjectName &&
+			existing.Spec.Owner.ComponentName == owner.ComponentName &&
+			existing.DeletionTimestamp == nil {
+			count++
+		}
+	}
+	if count < v.MaxReleasesPerComponent {
+		return nil
+	}
+
+	return apierrors.NewForbidden(
+		openchoreodevv1alpha1.GroupVersion.WithResource("componentreleases").GroupResource(),
+		release.Name,
+		fmt.Errorf("exceeded quota: component %s/%s already has %d ComponentReleases, the maximum per component is %d; "+
+			"prune the releases no ReleaseBinding uses with POST /api/v1/namespaces/%s/components/%s/prune-releases "+
+			"before creating another",
+			owner.ProjectName, owner.ComponentName, count, v.MaxReleasesPerComponent, release.Namespace, owner.ComponentName))
+}
+
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ComponentRelease.
 func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	_, ok := oldObj.(*openchoreodevv1alpha1.ComponentRelease)
@@ -8,7 +8,11 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
@@ -1188,4 +1192,71 @@ var _ = Describe("ComponentRelease Webhook", func() {
 		})
 	})
 
+	Context("Release Quota", func() {
+		existingRelease := func(name, project, component string) client.Object {
+			release := validComponentRelease()
+			release.Name = name
+			release.Namespace = "default"
+			release.Spec.Owner.ProjectName = project
+			release.Spec.Owner.ComponentName = component
+			return release
+		}
+
+		quotaValidator := func(maxReleases int, existing ...client.Object) Validator {
+			scheme := runtime.NewScheme()
+			Expect(openchoreodevv1alpha1.AddToScheme(scheme)).To(Succeed())
+			return Validator{
+				Client:                  fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing...).Build(),
+				MaxReleasesPerComponent: maxReleases,
+			}
+		}
+
+		newRelease := func() *openchoreodevv1alpha1.ComponentRelease {
+			release := validComponentRelease()
+			release.Name = "test-component-v3"
+			release.Namespace = "default"
+			return release
+		}
+
+		It("should admit a release below the cap", func() {
+			validator = quotaValidator(2,
+				existingRelease("test-component-v1", "test-project", "test-component"),
+				existingRelease("other-v1", "test-project", "other"),
+				existingRelease("other-project-test-component-v1", "other-project", "test-component"))
+
+			_, err := validator.ValidateCreate(ctx, newRelease())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject a release at the cap and point at pruning unused releases", func() {
+			validator = quotaValidator(2,
+				existingRelease("test-component-v1", "test-project", "test-component"),
+				existingRelease("test-component-v2", "test-project", "test-component"))
+
+			_, err := validator.ValidateCreate(ctx, newRelease())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue(), "got %v", err)
+			Expect(err.Error()).To(ContainSubstring("component test-project/test-component already has 2 ComponentReleases"))
+			Expect(err.Error()).To(ContainSubstring("POST /api/v1/namespaces/default/components/test-component/prune-releases"))
+		})
+
+		It("should not count releases being deleted", func() {
+			deleting := existingRelease("test-component-v2", "test-project", "test-component")
+			now := metav1.Now()
+			deleting.SetDeletionTimestamp(&now)
+			deleting.SetFinalizers([]string{"openchoreo.dev/test"})
+			validator = quotaValidator(2,
+				existingRelease("test-component-v1", "test-project", "test-component"), deleting)
+
+			_, err := validator.ValidateCreate(ctx, newRelease())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not cap releases when the limit is 0", func() {
+			validator = quotaValidator(0,
+				existingRelease("test-component-v1", "test-project", "test-component"))
+
+			_, err := validator.ValidateCreate(ctx, newRelease())
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// log is for logging in this package.
+var dataplanelog = logf.Log.WithName("dataplane-resource")
+
+// SetupDataPlaneWebhookWithManager registers the webhook for DataPlane in the manager.
+func SetupDataPlaneWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &openchoreov1alpha1.DataPlane{}).
+		WithCustomValidator(&Validator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-dataplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=dataplanes,verbs=create;update,versions=v1alpha1,name=vdataplane-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Validator validates the connection details of DataPlane resources
+// +kubebuilder:object:generate=false
+type Validator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &Validator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type DataPlane.
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	dataPlane, ok := obj.(*openchoreov1alpha1.DataPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a DataPlane object but got %T", obj)
+	}
+	dataplanelog.Info("Validation for DataPlane upon creation", "name", dataPlane.GetName())
+
+	return v.validateClusterAgent(ctx, dataPlane)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type DataPlane.
+// The connection details are only checked when they change, so that a rotated or deleted secret
+// does not block unrelated updates.
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldDataPlane, ok := oldObj.(*openchoreov1alpha1.DataPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a DataPlane object for the oldObj but got %T", oldObj)
+	}
+	dataPlane, ok := newObj.(*openchoreov1alpha1.DataPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a DataPlane object for the newObj but got %T", newObj)
+	}
+	dataplanelog.Info("Validation for DataPlane upon update", "name", dataPlane.GetName())
+
+	if reflect.DeepEqual(oldDataPlane.Spec.ClusterAgent, dataPlane.Spec.ClusterAgent) {
+		return nil, nil
+	}
+	return v.validateClusterAgent(ctx, dataPlane)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type DataPlane.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateClusterAgent rejects malformed connection details. A client CA secret that does not
+// exist yet only produces a warning, since it may be applied together with the DataPlane; the
+// controller reports it on the Validated condition until it appears.
+func (v *Validator) validateClusterAgent(ctx context.Context, dataPlane *openchoreov1alpha1.DataPlane) (admission.Warnings, error) {
+	err := controller.ValidateClusterAgent(ctx, v.Client, dataPlane.Namespace, &dataPlane.Spec.ClusterAgent)
+	if err == nil {
+		return nil, nil
+	}
+	if apierrors.IsNotFound(err) {
+		return admission.Warnings{err.Error()}, nil
+	}
+	return nil, err
+}
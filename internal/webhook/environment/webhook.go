@@ -0,0 +1,103 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/tenancy"
+)
+
+// log is for logging in this package.
+var environmentlog = logf.Log.WithName("environment-resource")
+
+// SetupEnvironmentWebhookWithManager registers the webhook for Environment in the manager.
+func SetupEnvironmentWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &openchoreov1alpha1.Environment{}).
+		WithCustomValidator(&Validator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-environment,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=environments,verbs=create;update,versions=v1alpha1,name=venvironment-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Validator struct is responsible for validating the Environment resource
+// when it is created or updated.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as this struct is used only for temporary operations and does not need to be deeply copied.
+type Validator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &Validator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Environment.
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	environment, ok := obj.(*openchoreov1alpha1.Environment)
+	if !ok {
+		return nil, fmt.Errorf("expected an Environment object but got %T", obj)
+	}
+	environmentlog.Info("Validation for Environment upon creation", "name", environment.GetName())
+
+	return nil, v.validateTenancy(ctx, environment)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Environment.
+// The tenancy policy is only checked when the data plane reference changes, so that tightening
+// a policy does not block updates of environments that are already placed on the data plane.
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldEnvironment, ok := oldObj.(*openchoreov1alpha1.Environment)
+	if !ok {
+		return nil, fmt.Errorf("expected an Environment object for the oldObj but got %T", oldObj)
+	}
+	environment, ok := newObj.(*openchoreov1alpha1.Environment)
+	if !ok {
+		return nil, fmt.Errorf("expected an Environment object for the newObj but got %T", newObj)
+	}
+	environmentlog.Info("Validation for Environment upon update", "name", environment.GetName())
+
+	if reflect.DeepEqual(oldEnvironment.Spec.DataPlaneRef, environment.Spec.DataPlaneRef) {
+		return nil, nil
+	}
+	return nil, v.validateTenancy(ctx, environment)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Environment.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateTenancy rejects environments whose namespace may not use the referenced ClusterDataPlane.
+// Namespace-scoped DataPlanes belong to the tenant and have no tenancy policy.
+func (v *Validator) validateTenancy(ctx context.Context, environment *openchoreov1alpha1.Environment) error {
+	result, err := controller.GetDataPlaneFromRef(ctx, v.Client, environment.Namespace, environment.Spec.DataPlaneRef)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The environment controller reports missing data planes
+			return nil
+		}
+		return fmt.Errorf("failed to resolve data plane: %w", err)
+	}
+	if result.ClusterDataPlane == nil {
+		return nil
+	}
+	if err := tenancy.CheckNamespace(result.ClusterDataPlane.Spec.Tenancy, environment.Namespace); err != nil {
+		return fmt.Errorf("ClusterDataPlane %q does not admit this environment: %w", result.ClusterDataPlane.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+var _ = Describe("Environment Webhook", func() {
+	var validator Validator
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(openchoreov1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		shared := &openchoreov1alpha1.ClusterDataPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+			Spec: openchoreov1alpha1.ClusterDataPlaneSpec{
+				Tenancy: &openchoreov1alpha1.TenancyPolicy{
+					AllowedNamespaces: []string{"team-*"},
+					DeniedNamespaces:  []string{"team-blocked"},
+				},
+			},
+		}
+		open := &openchoreov1alpha1.ClusterDataPlane{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		validator = Validator{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(shared, open).Build(),
+		}
+	})
+
+	newEnvironment := func(namespace, clusterDataPlane string) *openchoreov1alpha1.Environment {
+		env := &openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: namespace},
+		}
+		if clusterDataPlane != "" {
+			env.Spec.DataPlaneRef = &openchoreov1alpha1.DataPlaneRef{
+				Kind: openchoreov1alpha1.DataPlaneRefKindClusterDataPlane,
+				Name: clusterDataPlane,
+			}
+		}
+		return env
+	}
+
+	Context("ValidateCreate", func() {
+		It("should allow an environment of an allowed namespace", func() {
+			_, err := validator.ValidateCreate(ctx, newEnvironment("team-a", "shared"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject an environment of a namespace outside the allow list", func() {
+			_, err := validator.ValidateCreate(ctx, newEnvironment("other", "shared"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`ClusterDataPlane "shared" does not admit this environment`))
+		})
+
+		It("should reject an environment of a denied namespace", func() {
+			_, err := validator.ValidateCreate(ctx, newEnvironment("team-blocked", "shared"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`denied by tenancy rule "team-blocked"`))
+		})
+
+		It("should allow any namespace on a ClusterDataPlane without a tenancy policy", func() {
+			_, err := validator.ValidateCreate(ctx, newEnvironment("other", ""))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should allow an environment whose data plane does not exist yet", func() {
+			_, err := validator.ValidateCreate(ctx, newEnvironment("other", "missing"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should not re-check an unchanged data plane reference", func() {
+			oldEnv := newEnvironment("other", "shared")
+			newEnv := oldEnv.DeepCopy()
+			newEnv.Spec.IsProduction = true
+			_, err := validator.ValidateUpdate(ctx, oldEnv, newEnv)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should check a changed data plane reference", func() {
+			_, err := validator.ValidateUpdate(ctx, newEnvironment("other", "default"), newEnvironment("other", "shared"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -6,14 +6,20 @@ package project
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 )
 
 // nolint:unused
@@ -23,7 +29,7 @@ var projectlog = logf.Log.WithName("project-resource")
 // SetupProjectWebhookWithManager registers the webhook for Project in the manager.
 func SetupProjectWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &openchoreov1alpha1.Project{}).
-		WithCustomValidator(&Validator{}).
+		WithCustomValidator(&Validator{Client: mgr.GetClient()}).
 		WithCustomDefaulter(&Defaulter{}).
 		Complete()
 }
@@ -68,7 +74,9 @@ func (d *Defaulter) Default(ctx context.Context, obj runtime.Object) error {
 //
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as this struct is used only for temporary operations and does not need to be deeply copied.
-type Validator struct{}
+type Validator struct {
+	Client client.Client
+}
 
 var _ webhook.CustomValidator = &Validator{}
 
@@ -91,9 +99,50 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 	}
 	projectlog.Info("Validation for Project upon update", "name", project.GetName())
 
+	oldProject, ok := oldObj.(*openchoreov1alpha1.Project)
+	if !ok {
+		return nil, fmt.Errorf("expected a Project object for the oldObj but got %T", oldObj)
+	}
+	if equality.Semantic.DeepEqual(oldProject.Spec.Quota, project.Spec.Quota) {
+		return nil, nil
+	}
+	allErrs, err := v.validateQuota(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(project.GroupVersionKind().GroupKind(), project.GetName(), allErrs)
+	}
 	return nil, nil
 }
 
+// validateQuota rejects a changed quota with limits below what the project already uses, so
+// that a quota never starts out exceeded.
+func (v *Validator) validateQuota(ctx context.Context, project *openchoreov1alpha1.Project) (field.ErrorList, error) {
+	if project.Spec.Quota == nil {
+		return nil, nil
+	}
+	usage, err := controller.GetProjectQuotaUsage(ctx, v.Client, project.Namespace, project.Name)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to get quota usage of project %s: %w", project.Name, err))
+	}
+
+	quotaPath := field.NewPath("spec", "quota")
+	allErrs := field.ErrorList{}
+	for _, exceeded := range usage.Exceeded(project.Name, project.Spec.Quota) {
+		var path *field.Path
+		switch exceeded.Resource {
+		case controller.ProjectQuotaComponents, controller.ProjectQuotaEnvironments:
+			path = quotaPath.Child("max" + string(exceeded.Resource))
+		default:
+			path = quotaPath.Child(strings.ToLower(string(exceeded.Resource)))
+		}
+		allErrs = append(allErrs, field.Invalid(path, exceeded.Limit.String(),
+			fmt.Sprintf("is below the current usage of %s", exceeded.Usage.String())))
+	}
+	return allErrs, nil
+}
+
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Project.
 func (v *Validator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	project, ok := obj.(*openchoreov1alpha1.Project)
@@ -6,7 +6,12 @@ package project
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
@@ -102,6 +107,43 @@ var _ = Describe("Project Webhook", func() {
 		})
 	})
 
+	Context("Quota", func() {
+		BeforeEach(func() {
+			s := runtime.NewScheme()
+			Expect(openchoreov1alpha1.AddToScheme(s)).To(Succeed())
+			component := func(name string) *openchoreov1alpha1.Component {
+				return &openchoreov1alpha1.Component{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+					Spec:       openchoreov1alpha1.ComponentSpec{Owner: openchoreov1alpha1.ComponentOwner{ProjectName: "test-project"}},
+				}
+			}
+			validator = Validator{Client: fake.NewClientBuilder().WithScheme(s).WithObjects(component("api"), component("web")).Build()}
+			oldObj = createValidProject("test-project", testNamespace, testPipeline)
+			obj = createValidProject("test-project", testNamespace, testPipeline)
+		})
+
+		It("should allow a quota at or above the current usage", func() {
+			obj.Spec.Quota = &openchoreov1alpha1.ProjectQuota{MaxComponents: ptr.To[int32](2), CPU: ptr.To(resource.MustParse("1"))}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject a quota below the current usage", func() {
+			obj.Spec.Quota = &openchoreov1alpha1.ProjectQuota{MaxComponents: ptr.To[int32](1)}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.quota.maxComponents"))
+			Expect(err.Error()).To(ContainSubstring("current usage of 2"))
+		})
+
+		It("should not check an unchanged quota", func() {
+			oldObj.Spec.Quota = &openchoreov1alpha1.ProjectQuota{MaxComponents: ptr.To[int32](1)}
+			obj.Spec.Quota = &openchoreov1alpha1.ProjectQuota{MaxComponents: ptr.To[int32](1)}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
 	Context("ValidateDelete", func() {
 		It("should allow deletion of a valid project", func() {
 			obj = createValidProject("test-project", testNamespace, testPipeline)
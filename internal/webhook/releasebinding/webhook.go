@@ -6,8 +6,11 @@ package releasebinding
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,9 +19,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/deploymentlock"
+	"github.com/openchoreo/openchoreo/internal/webhook/componentowner"
 )
 
-// nolint:unused
 // log is for logging in this package.
 var releasebindinglog = logf.Log.WithName("releasebinding-resource")
 
@@ -118,9 +122,35 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ReleaseBinding.
 func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	// Note: Required field validations (owner, environment) are enforced by the CRD schema
-	// Note: spec.environment, spec.owner immutability is enforced by CEL rules in the CRD schema
+	// Note: spec.environment, spec.owner.componentName immutability is enforced by CEL rules in the CRD schema
 	// Note: Cross-resource validation (ComponentRelease, schema validation) is handled by the controller
 
+	oldBinding, ok := oldObj.(*openchoreodevv1alpha1.ReleaseBinding)
+	if !ok {
+		return nil, fmt.Errorf("expected a ReleaseBinding object but got %T", oldObj)
+	}
+	newBinding, ok := newObj.(*openchoreodevv1alpha1.ReleaseBinding)
+	if !ok {
+		return nil, fmt.Errorf("expected a ReleaseBinding object but got %T", newObj)
+	}
+
+	// Reject deploys, promotions and restarts while the binding is locked
+	if err := deploymentlock.Check(oldBinding, newBinding, time.Now()); err != nil {
+		releasebindinglog.Info("Rejected update of locked release binding", "name", newBinding.Name, "namespace", newBinding.Namespace)
+		return nil, apierrors.NewForbidden(
+			openchoreodevv1alpha1.GroupVersion.WithResource("releasebindings").GroupResource(), newBinding.Name, err)
+	}
+
+	// spec.owner.projectName may only follow the project of the component
+	allErrs, err := componentowner.ValidateProjectChange(ctx, v.Client, newBinding.Namespace,
+		oldBinding.Spec.Owner.ComponentName, oldBinding.Spec.Owner.ProjectName, newBinding.Spec.Owner.ProjectName)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(newBinding.GroupVersionKind().GroupKind(), newBinding.Name, allErrs)
+	}
+
 	return nil, nil
 }
 
@@ -5,12 +5,16 @@ package releasebinding
 
 import (
 	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -142,6 +146,53 @@ var _ = Describe("ReleaseBinding Webhook", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should reject a promotion of a locked ReleaseBinding", func() {
+			oldObj := &openchoreodevv1alpha1.ReleaseBinding{}
+			oldObj.Spec.ReleaseName = "v1"
+			oldObj.Spec.Lock = &openchoreodevv1alpha1.DeploymentLock{
+				Reason:    "incident",
+				ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour)),
+			}
+			newObj := oldObj.DeepCopy()
+			newObj.Spec.ReleaseName = "v2"
+			_, err := validator.ValidateUpdate(ctx, oldObj, newObj)
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("incident"))
+		})
+
+		It("should admit removing the lock of a locked ReleaseBinding", func() {
+			oldObj := &openchoreodevv1alpha1.ReleaseBinding{}
+			oldObj.Spec.Lock = &openchoreodevv1alpha1.DeploymentLock{
+				Reason:    "incident",
+				ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour)),
+			}
+			newObj := oldObj.DeepCopy()
+			newObj.Spec.Lock = nil
+			_, err := validator.ValidateUpdate(ctx, oldObj, newObj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should only admit a project change that follows the component", func() {
+			component := &openchoreodevv1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "default"},
+			}
+			component.Spec.Owner.ProjectName = "team-b"
+			validator.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(component).Build()
+
+			oldObj := &openchoreodevv1alpha1.ReleaseBinding{ObjectMeta: metav1.ObjectMeta{Name: "checkout-dev", Namespace: "default"}}
+			oldObj.Spec.Owner = openchoreodevv1alpha1.ReleaseBindingOwner{ProjectName: "team-a", ComponentName: "checkout"}
+			newObj := oldObj.DeepCopy()
+			newObj.Spec.Owner.ProjectName = "team-b"
+			_, err := validator.ValidateUpdate(ctx, oldObj, newObj)
+			Expect(err).NotTo(HaveOccurred())
+
+			newObj.Spec.Owner.ProjectName = "team-c"
+			_, err = validator.ValidateUpdate(ctx, oldObj, newObj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`can only change to "team-b"`))
+		})
+
 		It("should admit ReleaseBinding deletion (no-op validator)", func() {
 			obj := &openchoreodevv1alpha1.ReleaseBinding{}
 			_, err := validator.ValidateDelete(ctx, obj)
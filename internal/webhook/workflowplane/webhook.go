@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowplane
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// log is for logging in this package.
+var workflowplanelog = logf.Log.WithName("workflowplane-resource")
+
+// SetupWorkflowPlaneWebhookWithManager registers the webhook for WorkflowPlane in the manager.
+func SetupWorkflowPlaneWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &openchoreov1alpha1.WorkflowPlane{}).
+		WithCustomValidator(&Validator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-workflowplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=workflowplanes,verbs=create;update,versions=v1alpha1,name=vworkflowplane-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Validator validates the connection details of WorkflowPlane resources
+// +kubebuilder:object:generate=false
+type Validator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &Validator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type WorkflowPlane.
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	workflowPlane, ok := obj.(*openchoreov1alpha1.WorkflowPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a WorkflowPlane object but got %T", obj)
+	}
+	workflowplanelog.Info("Validation for WorkflowPlane upon creation", "name", workflowPlane.GetName())
+
+	return v.validateClusterAgent(ctx, workflowPlane)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type WorkflowPlane.
+// The connection details are only checked when they change, so that a rotated or deleted secret
+// does not block unrelated updates.
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldWorkflowPlane, ok := oldObj.(*openchoreov1alpha1.WorkflowPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a WorkflowPlane object for the oldObj but got %T", oldObj)
+	}
+	workflowPlane, ok := newObj.(*openchoreov1alpha1.WorkflowPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a WorkflowPlane object for the newObj but got %T", newObj)
+	}
+	workflowplanelog.Info("Validation for WorkflowPlane upon update", "name", workflowPlane.GetName())
+
+	if reflect.DeepEqual(oldWorkflowPlane.Spec.ClusterAgent, workflowPlane.Spec.ClusterAgent) {
+		return nil, nil
+	}
+	return v.validateClusterAgent(ctx, workflowPlane)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type WorkflowPlane.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateClusterAgent rejects malformed connection details. A client CA secret that does not
+// exist yet only produces a warning, since it may be applied together with the WorkflowPlane; the
+// controller reports it on the Validated condition until it appears.
+func (v *Validator) validateClusterAgent(ctx context.Context, workflowPlane *openchoreov1alpha1.WorkflowPlane) (admission.Warnings, error) {
+	err := controller.ValidateClusterAgent(ctx, v.Client, workflowPlane.Namespace, &workflowPlane.Spec.ClusterAgent)
+	if err == nil {
+		return nil, nil
+	}
+	if apierrors.IsNotFound(err) {
+		return admission.Warnings{err.Error()}, nil
+	}
+	return nil, err
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowrun
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/validation/workflowparams"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var workflowrunlog = logf.Log.WithName("workflowrun-resource")
+
+// SetupWorkflowRunWebhookWithManager registers the webhook for WorkflowRun in the manager.
+func SetupWorkflowRunWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &openchoreodevv1alpha1.WorkflowRun{}).
+		WithCustomValidator(&Validator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-workflowrun,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=workflowruns,verbs=create;update,versions=v1alpha1,name=vworkflowrun-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Validator validates WorkflowRun resources
+// +kubebuilder:object:generate=false
+type Validator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &Validator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type WorkflowRun.
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	run, ok := obj.(*openchoreodevv1alpha1.WorkflowRun)
+	if !ok {
+		return nil, fmt.Errorf("expected a WorkflowRun object but got %T", obj)
+	}
+	workflowrunlog.Info("Validation for WorkflowRun upon creation", "name", run.GetName())
+
+	allErrs, err := v.validateParameters(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(run.GroupVersionKind().GroupKind(), run.GetName(), allErrs)
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type WorkflowRun.
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldRun, ok := oldObj.(*openchoreodevv1alpha1.WorkflowRun)
+	if !ok {
+		return nil, fmt.Errorf("expected a WorkflowRun object for the oldObj but got %T", oldObj)
+	}
+	newRun, ok := newObj.(*openchoreodevv1alpha1.WorkflowRun)
+	if !ok {
+		return nil, fmt.Errorf("expected a WorkflowRun object for the newObj but got %T", newObj)
+	}
+	workflowrunlog.Info("Validation for WorkflowRun upon update", "name", newRun.GetName())
+
+	// Runs created before plaintext credentials were rejected can still be updated, for
+	// example to remove their finalizers, as long as their parameters do not change
+	if equality.Semantic.DeepEqual(oldRun.Spec.Workflow.Parameters, newRun.Spec.Workflow.Parameters) {
+		return nil, nil
+	}
+
+	allErrs, err := v.validateParameters(ctx, newRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(newRun.GroupVersionKind().GroupKind(), newRun.GetName(), allErrs)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type WorkflowRun.
+func (v *Validator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	run, ok := obj.(*openchoreodevv1alpha1.WorkflowRun)
+	if !ok {
+		return nil, fmt.Errorf("expected a WorkflowRun object but got %T", obj)
+	}
+	workflowrunlog.Info("Validation for WorkflowRun upon deletion", "name", run.GetName())
+
+	return nil, nil
+}
+
+// validateParameters rejects run parameters that carry plaintext credentials, except under
+// properties the workflow schema allows plaintext values for
+func (v *Validator) validateParameters(ctx context.Context, run *openchoreodevv1alpha1.WorkflowRun) (field.ErrorList, error) {
+	wf := run.Spec.Workflow
+	if wf.Parameters == nil {
+		return nil, nil
+	}
+	schema, err := workflowparams.ResolveParametersSchema(ctx, v.Client, run.Namespace, wf.Kind, wf.Name, wf.Version)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to resolve workflow parameters schema: %w", err))
+	}
+	return workflowparams.ValidatePlaintextCredentials(field.NewPath("spec", "workflow", "parameters"), wf.Parameters, schema), nil
+}
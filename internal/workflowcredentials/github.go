@@ -0,0 +1,165 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowcredentials
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultGitHubAPIURL is the API URL of github.com.
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+// gitHubAppJWTTTL is the lifetime of the app JWT used to request installation tokens.
+// GitHub accepts at most ten minutes.
+const gitHubAppJWTTTL = 5 * time.Minute
+
+// GitHubAppIssuerConfig configures the GitHub App installation token issuer.
+type GitHubAppIssuerConfig struct {
+	// APIURL is the GitHub REST API URL, for example https://github.example.com/api/v3 for
+	// GitHub Enterprise Server. Defaults to DefaultGitHubAPIURL.
+	APIURL string
+	// AppID is the ID of the GitHub App.
+	AppID int64
+	// InstallationID is the installation of the app on the organization that owns the repositories.
+	InstallationID int64
+	// Timeout bounds each request to the GitHub API.
+	Timeout time.Duration
+}
+
+// GitHubAppIssuer issues GitHub App installation tokens that can only read the contents of a
+// single repository. GitHub expires them after one hour.
+type GitHubAppIssuer struct {
+	cfg        GitHubAppIssuerConfig
+	key        *rsa.PrivateKey
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewGitHubAppIssuer creates an installation token issuer that authenticates as the app with
+// the PEM-encoded RSA private key.
+func NewGitHubAppIssuer(cfg GitHubAppIssuerConfig, keyPEM []byte) (*GitHubAppIssuer, error) {
+	if cfg.AppID == 0 || cfg.InstallationID == 0 {
+		return nil, fmt.Errorf("GitHub App ID and installation ID are required")
+	}
+	if cfg.APIURL == "" {
+		cfg.APIURL = DefaultGitHubAPIURL
+	}
+	cfg.APIURL = strings.TrimSuffix(cfg.APIURL, "/")
+	signer, err := ParsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := signer.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("GitHub App private key must be an RSA key, got %T", signer)
+	}
+	return &GitHubAppIssuer{
+		cfg:        cfg,
+		key:        key,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		now:        time.Now,
+	}, nil
+}
+
+type installationTokenRequest struct {
+	Repositories []string          `json:"repositories"`
+	Permissions  map[string]string `json:"permissions"`
+}
+
+type installationTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Issue returns an installation token that can read the given repository, for example
+// "https://github.com/acme/api.git", and its expiry.
+func (i *GitHubAppIssuer) Issue(ctx context.Context, repository string) (string, time.Time, error) {
+	_, repo, err := ParseGitHubRepository(repository)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	appJWT, err := i.appJWT()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	body, err := json.Marshal(installationTokenRequest{
+		Repositories: []string{repo},
+		Permissions:  map[string]string{"contents": "read"},
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	endpoint := fmt.Sprintf("%s/app/installations/%d/access_tokens", i.cfg.APIURL, i.cfg.InstallationID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+appJWT)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to request GitHub installation token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", time.Time{}, fmt.Errorf("GitHub installation token request failed with status %d: %s",
+			resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	var token installationTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode GitHub installation token: %w", err)
+	}
+	if token.Token == "" {
+		return "", time.Time{}, fmt.Errorf("GitHub returned an empty installation token")
+	}
+	return token.Token, token.ExpiresAt, nil
+}
+
+// appJWT returns the JWT that authenticates requests as the GitHub App.
+func (i *GitHubAppIssuer) appJWT() (string, error) {
+	// Backdate the token to tolerate clock drift, as recommended by GitHub.
+	now := i.now().Truncate(time.Second).Add(-time.Minute)
+	claims := jwt.RegisteredClaims{
+		Issuer:    fmt.Sprintf("%d", i.cfg.AppID),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(gitHubAppJWTTTL)),
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(i.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign GitHub App JWT: %w", err)
+	}
+	return signed, nil
+}
+
+// ParseGitHubRepository returns the owner and name of a repository given by its HTTPS or SSH
+// URL, for example "https://github.com/acme/api.git" or "git@github.com:acme/api.git".
+func ParseGitHubRepository(repository string) (owner, name string, err error) {
+	path := repository
+	if u, parseErr := url.Parse(repository); parseErr == nil && u.Host != "" {
+		path = u.Path
+	} else if _, after, found := strings.Cut(repository, ":"); found {
+		path = after
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	owner, name, found := strings.Cut(path, "/")
+	if !found || owner == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("invalid GitHub repository %q", repository)
+	}
+	return owner, name, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowcredentials
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRSAKeyPEM(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func TestGitHubAppIssuer(t *testing.T) {
+	key, keyPEM := newRSAKeyPEM(t)
+	expiresAt := time.Date(2026, 3, 2, 11, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/app/installations/42/access_tokens", r.URL.Path)
+		appJWT := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		claims := &jwt.RegisteredClaims{}
+		_, err := jwt.ParseWithClaims(appJWT, claims, func(*jwt.Token) (any, error) {
+			return &key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"RS256"}))
+		assert.NoError(t, err)
+		assert.Equal(t, "7", claims.Issuer)
+
+		var body installationTokenRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body.Repositories[0] == "missing" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"message":"There is at least one repository that does not exist"}`))
+			return
+		}
+		assert.Equal(t, installationTokenRequest{
+			Repositories: []string{"api"},
+			Permissions:  map[string]string{"contents": "read"},
+		}, body)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(installationTokenResponse{Token: "ghs_token", ExpiresAt: expiresAt})
+	}))
+	defer server.Close()
+
+	issuer, err := NewGitHubAppIssuer(GitHubAppIssuerConfig{
+		APIURL:         server.URL + "/",
+		AppID:          7,
+		InstallationID: 42,
+		Timeout:        5 * time.Second,
+	}, keyPEM)
+	require.NoError(t, err)
+
+	token, gotExpiry, err := issuer.Issue(context.Background(), "https://github.com/acme/api.git")
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_token", token)
+	assert.Equal(t, expiresAt, gotExpiry)
+
+	_, _, err = issuer.Issue(context.Background(), "https://github.com/acme/missing")
+	assert.ErrorContains(t, err, "status 422")
+}
+
+func TestParseGitHubRepository(t *testing.T) {
+	for _, repository := range []string{
+		"https://github.com/acme/api",
+		"https://github.com/acme/api.git",
+		"git@github.com:acme/api.git",
+		"acme/api",
+	} {
+		owner, name, err := ParseGitHubRepository(repository)
+		require.NoError(t, err, repository)
+		assert.Equal(t, "acme", owner, repository)
+		assert.Equal(t, "api", name, repository)
+	}
+
+	_, _, err := ParseGitHubRepository("https://github.com/acme")
+	assert.ErrorContains(t, err, "invalid GitHub repository")
+}
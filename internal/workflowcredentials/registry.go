@@ -0,0 +1,174 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowcredentials
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// RegistryIssuerConfig configures the registry token issuer.
+type RegistryIssuerConfig struct {
+	// Issuer is the iss claim. It must match the issuer the registry trusts.
+	Issuer string
+	// Service is the aud claim, the service name of the registry.
+	Service string
+	// KeyID is the kid header the registry uses to select the verification key.
+	KeyID string
+	// TTL is the lifetime of issued tokens.
+	TTL time.Duration
+}
+
+// RegistryIssuer issues bearer tokens in the format of the Docker registry token
+// authentication specification, scoped to push and pull a single image repository.
+type RegistryIssuer struct {
+	cfg    RegistryIssuerConfig
+	key    crypto.Signer
+	method jwt.SigningMethod
+	now    func() time.Time
+}
+
+// registryClaims are the claims of a registry token.
+type registryClaims struct {
+	jwt.RegisteredClaims
+	Access []registryAccess `json:"access"`
+}
+
+type registryAccess struct {
+	Type    string   `json:"type"`
+	Name    string   `json:"name"`
+	Actions []string `json:"actions"`
+}
+
+// NewRegistryIssuer creates a registry token issuer that signs with the PEM-encoded RSA or
+// ECDSA private key.
+func NewRegistryIssuer(cfg RegistryIssuerConfig, keyPEM []byte) (*RegistryIssuer, error) {
+	if cfg.Issuer == "" || cfg.Service == "" {
+		return nil, fmt.Errorf("registry token issuer and service are required")
+	}
+	if cfg.TTL <= 0 {
+		return nil, fmt.Errorf("registry token TTL must be positive")
+	}
+	key, err := ParsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	var method jwt.SigningMethod
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		method = jwt.SigningMethodRS256
+	case *ecdsa.PrivateKey:
+		switch k.Curve.Params().BitSize {
+		case 256:
+			method = jwt.SigningMethodES256
+		case 384:
+			method = jwt.SigningMethodES384
+		default:
+			return nil, fmt.Errorf("unsupported ECDSA curve %s", k.Curve.Params().Name)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported registry signing key type %T", key)
+	}
+	return &RegistryIssuer{cfg: cfg, key: key, method: method, now: time.Now}, nil
+}
+
+// Issue returns a token that allows subject to push and pull the given image repository, for
+// example "registry.example.com/acme/api", and its expiry.
+func (i *RegistryIssuer) Issue(subject, repository string) (string, time.Time, error) {
+	_, name := SplitImageRepository(repository)
+	if name == "" {
+		return "", time.Time{}, fmt.Errorf("invalid image repository %q", repository)
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate token id: %w", err)
+	}
+
+	now := i.now().Truncate(time.Second)
+	expiresAt := now.Add(i.cfg.TTL)
+	claims := registryClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.cfg.Issuer,
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{i.cfg.Service},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			ID:        hex.EncodeToString(id),
+		},
+		Access: []registryAccess{{Type: "repository", Name: name, Actions: []string{"pull", "push"}}},
+	}
+	token := jwt.NewWithClaims(i.method, claims)
+	if i.cfg.KeyID != "" {
+		token.Header["kid"] = i.cfg.KeyID
+	}
+	signed, err := token.SignedString(i.key)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign registry token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+// SplitImageRepository splits an image repository into its registry host and repository name.
+// The host is empty when the repository does not start with one.
+func SplitImageRepository(repository string) (host, name string) {
+	repository = strings.TrimSuffix(repository, "/")
+	first, rest, found := strings.Cut(repository, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		return first, rest
+	}
+	return "", repository
+}
+
+// DockerConfigJSON returns a Docker config.json that presents token to the registry host.
+func DockerConfigJSON(host, token string) (string, error) {
+	if host == "" {
+		return "", errors.New("image repository has no registry host")
+	}
+	cfg := map[string]any{
+		"auths": map[string]any{
+			host: map[string]string{"registrytoken": token},
+		},
+	}
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ParsePrivateKey parses a PEM-encoded PKCS#1, PKCS#8 or SEC 1 private key.
+func ParsePrivateKey(keyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
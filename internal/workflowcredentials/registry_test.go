@@ -0,0 +1,81 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowcredentials
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newECKeyPEM(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestRegistryIssuer(t *testing.T) {
+	key, keyPEM := newECKeyPEM(t)
+	issuer, err := NewRegistryIssuer(RegistryIssuerConfig{
+		Issuer:  "openchoreo",
+		Service: "registry.example.com",
+		KeyID:   "key-1",
+		TTL:     10 * time.Minute,
+	}, keyPEM)
+	require.NoError(t, err)
+
+	token, expiresAt, err := issuer.Issue("workflowrun:default/build-1", "registry.example.com/acme/api")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), expiresAt, 2*time.Second)
+
+	claims := &registryClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return &key.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"ES256"}), jwt.WithAudience("registry.example.com"), jwt.WithIssuer("openchoreo"))
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", parsed.Header["kid"])
+	assert.Equal(t, "workflowrun:default/build-1", claims.Subject)
+	assert.Equal(t, []registryAccess{{Type: "repository", Name: "acme/api", Actions: []string{"pull", "push"}}}, claims.Access)
+
+	_, err = NewRegistryIssuer(RegistryIssuerConfig{Issuer: "openchoreo", Service: "registry", TTL: time.Minute}, []byte("not a key"))
+	assert.ErrorContains(t, err, "not PEM encoded")
+}
+
+func TestSplitImageRepository(t *testing.T) {
+	tests := []struct {
+		repository string
+		host       string
+		name       string
+	}{
+		{"registry.example.com/acme/api", "registry.example.com", "acme/api"},
+		{"localhost:5000/api", "localhost:5000", "api"},
+		{"localhost/api", "localhost", "api"},
+		{"acme/api", "", "acme/api"},
+	}
+	for _, tt := range tests {
+		host, name := SplitImageRepository(tt.repository)
+		assert.Equal(t, tt.host, host, tt.repository)
+		assert.Equal(t, tt.name, name, tt.repository)
+	}
+}
+
+func TestDockerConfigJSON(t *testing.T) {
+	cfg, err := DockerConfigJSON("registry.example.com", "token")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"auths":{"registry.example.com":{"registrytoken":"token"}}}`, cfg)
+
+	_, err = DockerConfigJSON("", "token")
+	assert.Error(t, err)
+}
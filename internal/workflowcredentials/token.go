@@ -0,0 +1,54 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package workflowcredentials implements the credential broker for workflow runs.
+//
+// Instead of mounting long-lived git and registry secrets into build pods, the workflow run
+// controller issues every run a random broker token and stores only its hash on the
+// WorkflowRun. A step of the run exchanges the token at the openchoreo-api for short-lived
+// credentials scoped to a single repository: a GitHub App installation token for GitRead and
+// a registry bearer token for RegistryPush. Tokens stop working once the run completes.
+package workflowcredentials
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const brokerTokenBytes = 32
+
+// NewBrokerToken returns a random broker token and its hash.
+func NewBrokerToken() (token, hash string, err error) {
+	b := make([]byte, brokerTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate broker token: %w", err)
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashBrokerToken(token), nil
+}
+
+// HashBrokerToken returns the hex-encoded SHA-256 hash of a broker token.
+func HashBrokerToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyBrokerToken reports whether token matches the recorded hash.
+func VerifyBrokerToken(token, hash string) bool {
+	if token == "" || hash == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(HashBrokerToken(token)), []byte(hash)) == 1
+}
+
+// ExchangeURL returns the openchoreo-api URL at which a workflow run exchanges its broker token.
+func ExchangeURL(apiURL, namespace, workflowRunName string) string {
+	return fmt.Sprintf("%s/api/v1/namespaces/%s/workflowruns/%s/credentials",
+		strings.TrimSuffix(apiURL, "/"), url.PathEscape(namespace), url.PathEscape(workflowRunName))
+}
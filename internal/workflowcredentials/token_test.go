@@ -0,0 +1,32 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowcredentials
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrokerToken(t *testing.T) {
+	token, hash, err := NewBrokerToken()
+	require.NoError(t, err)
+	assert.Len(t, token, 43)
+	assert.Equal(t, HashBrokerToken(token), hash)
+
+	assert.True(t, VerifyBrokerToken(token, hash))
+	assert.False(t, VerifyBrokerToken(token+"x", hash))
+	assert.False(t, VerifyBrokerToken("", HashBrokerToken("")), "an empty token must never verify")
+
+	other, _, err := NewBrokerToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func TestExchangeURL(t *testing.T) {
+	assert.Equal(t,
+		"https://api.openchoreo.example/api/v1/namespaces/default/workflowruns/build-1/credentials",
+		ExchangeURL("https://api.openchoreo.example/", "default", "build-1"))
+}
@@ -0,0 +1,228 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package workloadidentity binds the workloads of a component to a cloud identity through the
+// workload identity federation of the data plane, so that they obtain short-lived cloud
+// credentials instead of long-lived keys. A component gets a dedicated ServiceAccount that
+// carries the provider's annotations, and its pods run as that ServiceAccount.
+package workloadidentity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/podspec"
+)
+
+const (
+	// AnnotationAWSRoleARN is read by the EKS pod identity webhook to inject credentials for
+	// the IAM role.
+	AnnotationAWSRoleARN = "eks.amazonaws.com/role-arn"
+	// AnnotationGCPServiceAccount names the Google service account GKE impersonates.
+	AnnotationGCPServiceAccount = "iam.gke.io/gcp-service-account"
+	// AnnotationAzureClientID is the client ID of the identity the Azure webhook injects.
+	AnnotationAzureClientID = "azure.workload.identity/client-id"
+	// AnnotationAzureTenantID is the tenant of the Azure identity.
+	AnnotationAzureTenantID = "azure.workload.identity/tenant-id"
+	// LabelAzureUse opts pods in to the Azure workload identity webhook.
+	LabelAzureUse = "azure.workload.identity/use"
+
+	defaultAWSPartition = "aws"
+)
+
+var (
+	// ErrNotConfigured is returned when a component requests a workload identity from a data
+	// plane without a workload identity provider.
+	ErrNotConfigured = errors.New("the data plane does not configure a workload identity provider")
+
+	awsRoleARNPattern  = regexp.MustCompile(`^arn:aws(-[a-z]+)*:iam::([0-9]{12}):role/[\w+=,.@/-]+$`)
+	awsRoleNamePattern = regexp.MustCompile(`^([\w+=,.@-]+/)*[\w+=,.@-]{1,64}$`)
+
+	gcpServiceAccountNamePattern  = regexp.MustCompile(`^[a-z][-a-z0-9]{4,28}[a-z0-9]$`)
+	gcpServiceAccountEmailPattern = regexp.MustCompile(`^[a-z][-a-z0-9]{4,28}[a-z0-9]@[a-z][-a-z0-9.:]*\.iam\.gserviceaccount\.com$`)
+
+	azureClientIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-([0-9a-fA-F]{4}-){3}[0-9a-fA-F]{12}$`)
+)
+
+// Binding is the binding of a component's ServiceAccount to a cloud identity.
+type Binding struct {
+	Provider openchoreov1alpha1.WorkloadIdentityProvider
+
+	// CloudIdentity is the fully qualified cloud identity: an IAM role ARN, a Google service
+	// account email or the client ID of an Azure identity.
+	CloudIdentity string
+
+	Namespace          string
+	ServiceAccountName string
+
+	// Subject is the principal the cloud identity must trust: the service account subject of
+	// the OIDC token on AWS and Azure, or the workload identity pool member on GCP.
+	Subject string
+
+	// Annotations are set on the ServiceAccount
+	Annotations map[string]string
+	// PodLabels are set on the pods running as the ServiceAccount
+	PodLabels map[string]string
+}
+
+// ServiceAccountName returns the name of the ServiceAccount created for a component. It is
+// named per component so that the Releases of components sharing a namespace never own the
+// same object.
+func ServiceAccountName(componentName string) string {
+	return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxResourceNameLength, componentName, "identity")
+}
+
+// Resolve validates the identity requested for a component against the workload identity
+// configuration of the data plane and returns the binding of the component's ServiceAccount
+// in namespace. It returns ErrNotConfigured when config is nil.
+func Resolve(config *openchoreov1alpha1.WorkloadIdentityConfig, identity, namespace, componentName string) (*Binding, error) {
+	if config == nil {
+		return nil, ErrNotConfigured
+	}
+	identity = strings.TrimSpace(identity)
+	if identity == "" {
+		return nil, fmt.Errorf("workload identity is empty")
+	}
+
+	b := &Binding{
+		Provider:           config.Provider,
+		Namespace:          namespace,
+		ServiceAccountName: ServiceAccountName(componentName),
+	}
+	serviceAccountSubject := fmt.Sprintf("system:serviceaccount:%s:%s", namespace, b.ServiceAccountName)
+
+	switch config.Provider {
+	case openchoreov1alpha1.WorkloadIdentityProviderAWS:
+		arn, err := awsRoleARN(config.AWS, identity)
+		if err != nil {
+			return nil, err
+		}
+		b.CloudIdentity = arn
+		b.Subject = serviceAccountSubject
+		b.Annotations = map[string]string{AnnotationAWSRoleARN: arn}
+
+	case openchoreov1alpha1.WorkloadIdentityProviderGCP:
+		email, err := gcpServiceAccountEmail(config.GCP, identity)
+		if err != nil {
+			return nil, err
+		}
+		pool := config.GCP.WorkloadPool
+		if pool == "" {
+			pool = config.GCP.ProjectID + ".svc.id.goog"
+		}
+		b.CloudIdentity = email
+		b.Subject = fmt.Sprintf("serviceAccount:%s[%s/%s]", pool, namespace, b.ServiceAccountName)
+		b.Annotations = map[string]string{AnnotationGCPServiceAccount: email}
+
+	case openchoreov1alpha1.WorkloadIdentityProviderAzure:
+		if !azureClientIDPattern.MatchString(identity) {
+			return nil, fmt.Errorf("invalid Azure client ID %q: must be a GUID", identity)
+		}
+		b.CloudIdentity = identity
+		b.Subject = serviceAccountSubject
+		b.Annotations = map[string]string{AnnotationAzureClientID: identity}
+		if config.Azure != nil && config.Azure.TenantID != "" {
+			b.Annotations[AnnotationAzureTenantID] = config.Azure.TenantID
+		}
+		b.PodLabels = map[string]string{LabelAzureUse: "true"}
+
+	default:
+		return nil, fmt.Errorf("unsupported workload identity provider %q", config.Provider)
+	}
+	return b, nil
+}
+
+// awsRoleARN returns the ARN of the IAM role requested by name or ARN. ARNs must belong to the
+// federated account.
+func awsRoleARN(config *openchoreov1alpha1.AWSWorkloadIdentity, identity string) (string, error) {
+	if config == nil {
+		return "", fmt.Errorf("the data plane configures the AWS provider without an account")
+	}
+	if strings.HasPrefix(identity, "arn:") {
+		match := awsRoleARNPattern.FindStringSubmatch(identity)
+		if match == nil {
+			return "", fmt.Errorf("invalid IAM role ARN %q", identity)
+		}
+		if account := match[2]; account != config.AccountID {
+			return "", fmt.Errorf("IAM role %q belongs to account %s, but the data plane federates account %s",
+				identity, account, config.AccountID)
+		}
+		return identity, nil
+	}
+	if !awsRoleNamePattern.MatchString(identity) {
+		return "", fmt.Errorf("invalid IAM role name %q", identity)
+	}
+	partition := config.Partition
+	if partition == "" {
+		partition = defaultAWSPartition
+	}
+	return fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, config.AccountID, identity), nil
+}
+
+// gcpServiceAccountEmail returns the email of the Google service account requested by name or
+// email.
+func gcpServiceAccountEmail(config *openchoreov1alpha1.GCPWorkloadIdentity, identity string) (string, error) {
+	if config == nil {
+		return "", fmt.Errorf("the data plane configures the GCP provider without a project")
+	}
+	if strings.Contains(identity, "@") {
+		if !gcpServiceAccountEmailPattern.MatchString(identity) {
+			return "", fmt.Errorf("invalid Google service account email %q", identity)
+		}
+		return identity, nil
+	}
+	if !gcpServiceAccountNamePattern.MatchString(identity) {
+		return "", fmt.Errorf("invalid Google service account name %q: must be 6 to 30 lowercase letters, digits or hyphens", identity)
+	}
+	return fmt.Sprintf("%s@%s.iam.gserviceaccount.com", identity, config.ProjectID), nil
+}
+
+// MakeServiceAccount returns the annotated ServiceAccount of the binding.
+func MakeServiceAccount(b *Binding) map[string]any {
+	return map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ServiceAccount",
+		"metadata": map[string]any{
+			"name":        b.ServiceAccountName,
+			"namespace":   b.Namespace,
+			"annotations": toAnyMap(b.Annotations),
+		},
+	}
+}
+
+// Attach makes the pods of every workload resource run as the binding's ServiceAccount,
+// replacing any service account the template set, and adds the provider's pod labels.
+// Resources that carry no pod spec are left untouched.
+func Attach(resources []map[string]any, b *Binding) {
+	for _, resource := range resources {
+		podSpec := podspec.Find(resource)
+		if podSpec == nil {
+			continue
+		}
+		podSpec["serviceAccountName"] = b.ServiceAccountName
+		if len(b.PodLabels) == 0 {
+			continue
+		}
+		metadata := podspec.FindMetadata(resource)
+		labels, _ := metadata["labels"].(map[string]any)
+		if labels == nil {
+			labels = make(map[string]any, len(b.PodLabels))
+		}
+		for k, v := range b.PodLabels {
+			labels[k] = v
+		}
+		metadata["labels"] = labels
+	}
+}
+
+func toAnyMap(m map[string]string) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadidentity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/podspec"
+)
+
+var (
+	awsConfig = &openchoreov1alpha1.WorkloadIdentityConfig{
+		Provider: openchoreov1alpha1.WorkloadIdentityProviderAWS,
+		AWS:      &openchoreov1alpha1.AWSWorkloadIdentity{AccountID: "123456789012"},
+	}
+	gcpConfig = &openchoreov1alpha1.WorkloadIdentityConfig{
+		Provider: openchoreov1alpha1.WorkloadIdentityProviderGCP,
+		GCP:      &openchoreov1alpha1.GCPWorkloadIdentity{ProjectID: "acme-prod"},
+	}
+	azureConfig = &openchoreov1alpha1.WorkloadIdentityConfig{
+		Provider: openchoreov1alpha1.WorkloadIdentityProviderAzure,
+		Azure:    &openchoreov1alpha1.AzureWorkloadIdentity{TenantID: "tenant"},
+	}
+)
+
+const azureClientID = "0b6f2a1e-5d3c-4c6f-9e1a-2f3b4c5d6e7f"
+
+func TestResolve(t *testing.T) {
+	saName := ServiceAccountName("payments")
+	serviceAccountSubject := "system:serviceaccount:dp-acme-dev:" + saName
+
+	tests := []struct {
+		name         string
+		config       *openchoreov1alpha1.WorkloadIdentityConfig
+		identity     string
+		wantIdentity string
+		wantSubject  string
+		wantAnnots   map[string]string
+		wantLabels   map[string]string
+	}{
+		{
+			name:         "AWS role name",
+			config:       awsConfig,
+			identity:     "payments-dev",
+			wantIdentity: "arn:aws:iam::123456789012:role/payments-dev",
+			wantSubject:  serviceAccountSubject,
+			wantAnnots:   map[string]string{AnnotationAWSRoleARN: "arn:aws:iam::123456789012:role/payments-dev"},
+		},
+		{
+			name: "AWS role name in another partition",
+			config: &openchoreov1alpha1.WorkloadIdentityConfig{
+				Provider: openchoreov1alpha1.WorkloadIdentityProviderAWS,
+				AWS:      &openchoreov1alpha1.AWSWorkloadIdentity{AccountID: "123456789012", Partition: "aws-cn"},
+			},
+			identity:     "apps/payments",
+			wantIdentity: "arn:aws-cn:iam::123456789012:role/apps/payments",
+			wantSubject:  serviceAccountSubject,
+			wantAnnots:   map[string]string{AnnotationAWSRoleARN: "arn:aws-cn:iam::123456789012:role/apps/payments"},
+		},
+		{
+			name:         "AWS role ARN",
+			config:       awsConfig,
+			identity:     "arn:aws:iam::123456789012:role/payments",
+			wantIdentity: "arn:aws:iam::123456789012:role/payments",
+			wantSubject:  serviceAccountSubject,
+			wantAnnots:   map[string]string{AnnotationAWSRoleARN: "arn:aws:iam::123456789012:role/payments"},
+		},
+		{
+			name:         "GCP service account name",
+			config:       gcpConfig,
+			identity:     "payments",
+			wantIdentity: "payments@acme-prod.iam.gserviceaccount.com",
+			wantSubject:  "serviceAccount:acme-prod.svc.id.goog[dp-acme-dev/" + saName + "]",
+			wantAnnots:   map[string]string{AnnotationGCPServiceAccount: "payments@acme-prod.iam.gserviceaccount.com"},
+		},
+		{
+			name: "GCP service account email with a separate workload pool",
+			config: &openchoreov1alpha1.WorkloadIdentityConfig{
+				Provider: openchoreov1alpha1.WorkloadIdentityProviderGCP,
+				GCP:      &openchoreov1alpha1.GCPWorkloadIdentity{ProjectID: "acme-prod", WorkloadPool: "acme-clusters.svc.id.goog"},
+			},
+			identity:     "payments@acme-shared.iam.gserviceaccount.com",
+			wantIdentity: "payments@acme-shared.iam.gserviceaccount.com",
+			wantSubject:  "serviceAccount:acme-clusters.svc.id.goog[dp-acme-dev/" + saName + "]",
+			wantAnnots:   map[string]string{AnnotationGCPServiceAccount: "payments@acme-shared.iam.gserviceaccount.com"},
+		},
+		{
+			name:         "Azure client ID",
+			config:       azureConfig,
+			identity:     azureClientID,
+			wantIdentity: azureClientID,
+			wantSubject:  serviceAccountSubject,
+			wantAnnots:   map[string]string{AnnotationAzureClientID: azureClientID, AnnotationAzureTenantID: "tenant"},
+			wantLabels:   map[string]string{LabelAzureUse: "true"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := Resolve(tt.config, tt.identity, "dp-acme-dev", "payments")
+			require.NoError(t, err)
+			assert.Equal(t, tt.config.Provider, b.Provider)
+			assert.Equal(t, tt.wantIdentity, b.CloudIdentity)
+			assert.Equal(t, saName, b.ServiceAccountName)
+			assert.Equal(t, tt.wantSubject, b.Subject)
+			assert.Equal(t, tt.wantAnnots, b.Annotations)
+			assert.Equal(t, tt.wantLabels, b.PodLabels)
+		})
+	}
+}
+
+func TestResolve_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *openchoreov1alpha1.WorkloadIdentityConfig
+		identity string
+		wantErr  string
+	}{
+		{"no provider", nil, "payments", ErrNotConfigured.Error()},
+		{"empty identity", awsConfig, "  ", "workload identity is empty"},
+		{"AWS role in another account", awsConfig, "arn:aws:iam::210987654321:role/payments",
+			`IAM role "arn:aws:iam::210987654321:role/payments" belongs to account 210987654321, but the data plane federates account 123456789012`},
+		{"AWS ARN of another resource", awsConfig, "arn:aws:s3:::bucket", `invalid IAM role ARN "arn:aws:s3:::bucket"`},
+		{"AWS invalid role name", awsConfig, "payments role", `invalid IAM role name "payments role"`},
+		{"AWS without account", &openchoreov1alpha1.WorkloadIdentityConfig{Provider: openchoreov1alpha1.WorkloadIdentityProviderAWS},
+			"payments", "the data plane configures the AWS provider without an account"},
+		{"GCP email outside IAM", gcpConfig, "payments@example.com", `invalid Google service account email "payments@example.com"`},
+		{"GCP name too short", gcpConfig, "pay", `invalid Google service account name "pay"`},
+		{"Azure client ID not a GUID", azureConfig, "payments", `invalid Azure client ID "payments": must be a GUID`},
+		{"unknown provider", &openchoreov1alpha1.WorkloadIdentityConfig{Provider: "Oracle"}, "payments",
+			`unsupported workload identity provider "Oracle"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Resolve(tt.config, tt.identity, "dp-acme-dev", "payments")
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+	_, err := Resolve(nil, "payments", "dp-acme-dev", "payments")
+	assert.ErrorIs(t, err, ErrNotConfigured)
+}
+
+func TestMakeServiceAccount(t *testing.T) {
+	b, err := Resolve(awsConfig, "payments", "dp-acme-dev", "payments")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ServiceAccount",
+		"metadata": map[string]any{
+			"name":        b.ServiceAccountName,
+			"namespace":   "dp-acme-dev",
+			"annotations": map[string]any{AnnotationAWSRoleARN: "arn:aws:iam::123456789012:role/payments"},
+		},
+	}, MakeServiceAccount(b))
+}
+
+func TestAttach(t *testing.T) {
+	b, err := Resolve(azureConfig, azureClientID, "dp-acme-dev", "payments")
+	require.NoError(t, err)
+
+	deployment := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec": map[string]any{"template": map[string]any{
+			"metadata": map[string]any{"labels": map[string]any{"app": "payments"}},
+			"spec":     map[string]any{"serviceAccountName": "default"},
+		}},
+	}
+	cronJob := map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "CronJob",
+		"spec": map[string]any{"jobTemplate": map[string]any{"spec": map[string]any{
+			"template": map[string]any{"spec": map[string]any{}},
+		}}},
+	}
+	service := map[string]any{"apiVersion": "v1", "kind": "Service", "spec": map[string]any{}}
+
+	Attach([]map[string]any{deployment, cronJob, service}, b)
+
+	assert.Equal(t, b.ServiceAccountName, podspec.Find(deployment)["serviceAccountName"])
+	assert.Equal(t, map[string]any{"app": "payments", LabelAzureUse: "true"}, podspec.FindMetadata(deployment)["labels"])
+	assert.Equal(t, b.ServiceAccountName, podspec.Find(cronJob)["serviceAccountName"])
+	assert.Equal(t, map[string]any{LabelAzureUse: "true"}, podspec.FindMetadata(cronJob)["labels"])
+	assert.Equal(t, map[string]any{}, service["spec"])
+}
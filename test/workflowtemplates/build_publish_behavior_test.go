@@ -147,6 +147,8 @@ func buildReplacements(root string) []string {
 		"{{inputs.parameters.build-env}}", `[{"name":"FOO","value":"bar"},{"name":"HELLO","value":"world"}]`,
 		"{{workflow.parameters.build-env}}", `[{"name":"FOO","value":"bar"},{"name":"HELLO","value":"world"}]`,
 		"{{inputs.parameters.build-args}}", `[{"name":"HTTP_PROXY","value":"http://proxy"}]`,
+		"{{inputs.parameters.builder-image}}", "",
+		"{{inputs.parameters.run-image}}", "",
 		"/mnt/vol", vol,
 		"/storage/run", filepath.Join(root, "storage", "run"),
 		"/storage/graph", filepath.Join(root, "storage", "graph"),
@@ -264,6 +266,51 @@ func TestBuildpackBuild_MissingAppPathFailsBeforePack(t *testing.T) {
 		"buildpack build must not run pack build when app-path validation fails")
 }
 
+// sbomPackStub records the call and writes a launch layer SBOM into --sbom-output-dir
+// like pack does.
+const sbomPackStub = `#!/bin/sh
+echo "pack $*" >> "$CALLS"
+while [ "$#" -gt 0 ]; do
+  if [ "$1" = "--sbom-output-dir" ]; then
+    mkdir -p "$2/launch/paketo-buildpacks_node-engine/node"
+    echo '{"bomFormat":"CycloneDX","specVersion":"1.4","components":[{"name":"node"}]}' \
+      > "$2/launch/paketo-buildpacks_node-engine/node/sbom.cdx.json"
+  fi
+  shift
+done
+exit 0
+`
+
+func TestPaketoBuild_CustomBuilderAndSBOM(t *testing.T) {
+	script := scriptForTemplate(t, "paketo-buildpacks-build.yaml", "build-image")
+	env := envForTemplate(t, "paketo-buildpacks-build.yaml", "build-image")
+	res := runScriptWithEnv(t, script, env, map[string]string{
+		"podman": buildPodmanStub,
+		"pack":   sbomPackStub,
+		"jq":     buildJQStub,
+	}, func(root string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "mnt-vol", "source", "service"), 0o755))
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "storage"), 0o755))
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "containers"), 0o755))
+	}, func(root string) []string {
+		return append([]string{
+			"{{inputs.parameters.builder-image}}", "example/builder@sha256:1234",
+		}, buildReplacements(root)...)
+	})
+
+	requireScriptSuccess(t, res, "paketo build should complete with a custom builder")
+	requireCallContains(t, res, "pack build example/app:dev-abcdef12", "--builder example/builder@sha256:1234",
+		"paketo build must build with the configured builder image")
+	requireNoCallContains(t, res, "pack build example/app:dev-abcdef12", "--run-image",
+		"paketo build must use the run image of a custom builder when no run image is configured")
+	requireCallContains(t, res, "pack build example/app:dev-abcdef12", "--sbom-output-dir "+filepath.Join(res.root, "mnt-vol", "sbom"),
+		"paketo build must export the SBOM of the image")
+	requireCallContains(t, res, "jq-stdin", `"components":[{"name":"node"}]`,
+		"paketo build must merge the launch layer SBOMs with jq")
+	_, err := os.Stat(filepath.Join(res.root, "mnt-vol", "sbom.cdx.json"))
+	require.NoError(t, err, "paketo build must write the merged SBOM to the sbom output parameter path")
+}
+
 func publishReplacements(root string) []string {
 	return []string{
 		"{{inputs.parameters.git-revision}}", "abcdef12",
@@ -208,6 +208,41 @@ func TestCIWorkflows_SecretResources(t *testing.T) {
 	}
 }
 
+func TestCIWorkflows_PaketoBuildpacksConfiguration(t *testing.T) {
+	wf := loadCIWorkflow(t, "paketo-buildpacks-builder.yaml")
+
+	buildpacks := requireSchemaProperty(t, wf.Spec.Parameters.OpenAPIV3Schema, "buildpacks",
+		"paketo CI workflow schema must expose buildpacks configuration")
+	for _, name := range []string{"builder", "runImage"} {
+		prop := requireSchemaProperty(t, buildpacks, name,
+			"buildpacks schema must expose "+name)
+		requireEqualContract(t, fmt.Sprint(prop.Default), "",
+			"buildpacks."+name+" must default to empty so the pinned Paketo images are used")
+	}
+
+	args := wf.Spec.RunTemplate.Spec.Arguments
+	requireParameterValueParts(t, args, "builder-image", []string{"parameters", "buildpacks", "builder"},
+		"paketo CI workflow must expose buildpacks.builder as workflow parameter builder-image")
+	requireParameterValueParts(t, args, "run-image", []string{"parameters", "buildpacks", "runImage"},
+		"paketo CI workflow must expose buildpacks.runImage as workflow parameter run-image")
+
+	build := requireStep(t, requireBuildWorkflowSteps(t, wf), "build-image")
+	requireParameterValue(t, build.Arguments, "builder-image", "{{workflow.parameters.builder-image}}",
+		"build step must receive the builder-image workflow parameter")
+	requireParameterValue(t, build.Arguments, "run-image", "{{workflow.parameters.run-image}}",
+		"build step must receive the run-image workflow parameter")
+
+	requireTrueContract(t, wf.Spec.SBOM != nil && wf.Spec.SBOM.Step == "build-image",
+		"paketo CI workflow must collect the SBOM of the build-image step")
+	tmpl := workflowTemplateByName(t, "paketo-buildpacks-build.yaml", "build-image")
+	found := false
+	for _, p := range tmpl.Outputs.Parameters {
+		found = found || (p.Name == "sbom" && p.ValueFrom.Path == "/mnt/vol/sbom.cdx.json")
+	}
+	requireTrueContract(t, found,
+		"paketo build template must expose the merged SBOM as the sbom output parameter")
+}
+
 func requireSchemaProperty(t *testing.T, schema schemaNode, name string, contract string) schemaNode {
 	t.Helper()
 	prop, ok := schema.Properties[name]
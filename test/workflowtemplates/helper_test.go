@@ -39,6 +39,14 @@ type workflowTemplateStep struct {
 			Default string `yaml:"default"`
 		} `yaml:"parameters"`
 	} `yaml:"inputs"`
+	Outputs struct {
+		Parameters []struct {
+			Name      string `yaml:"name"`
+			ValueFrom struct {
+				Path string `yaml:"path"`
+			} `yaml:"valueFrom"`
+		} `yaml:"parameters"`
+	} `yaml:"outputs"`
 	Container struct {
 		Image        string   `yaml:"image"`
 		Args         []string `yaml:"args"`
@@ -94,6 +102,9 @@ type clusterWorkflow struct {
 		} `yaml:"runTemplate"`
 		ExternalRefs []externalRef      `yaml:"externalRefs"`
 		Resources    []workflowResource `yaml:"resources"`
+		SBOM         *struct {
+			Step string `yaml:"step"`
+		} `yaml:"sbom"`
 	} `yaml:"spec"`
 }
 